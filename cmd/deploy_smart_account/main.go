@@ -2,7 +2,6 @@ package main
 
 import (
 	"context"
-	"crypto/ecdsa"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
@@ -15,9 +14,11 @@ import (
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/spf13/viper"
+
+	"github.com/NEDA-LABS/stablenode/services/gasoracle"
+	"github.com/NEDA-LABS/stablenode/utils/signer"
 )
 
 func main() {
@@ -37,12 +38,11 @@ func main() {
 
 	// Get configuration
 	ownerAddress := viper.GetString("SMART_ACCOUNT_OWNER_ADDRESS")
-	ownerPrivateKey := viper.GetString("SMART_ACCOUNT_OWNER_PRIVATE_KEY")
 	alchemyAPIKey := viper.GetString("ALCHEMY_API_KEY")
 	rpcURL := fmt.Sprintf("https://base-sepolia.g.alchemy.com/v2/%s", alchemyAPIKey)
 
-	if ownerAddress == "" || ownerPrivateKey == "" {
-		log.Fatal("SMART_ACCOUNT_OWNER_ADDRESS and SMART_ACCOUNT_OWNER_PRIVATE_KEY must be set in .env")
+	if ownerAddress == "" {
+		log.Fatal("SMART_ACCOUNT_OWNER_ADDRESS must be set in .env")
 	}
 
 	fmt.Printf("Owner Address: %s\n", ownerAddress)
@@ -63,23 +63,17 @@ func main() {
 	}
 	fmt.Printf("Connected to Chain ID: %s (Base Sepolia)\n", chainID.String())
 
-	// Parse private key
-	privateKey, err := crypto.HexToECDSA(strings.TrimPrefix(ownerPrivateKey, "0x"))
+	// Load the owner key: SMART_ACCOUNT_OWNER_PRIVATE_KEY, or a KMS key with SIGNER_BACKEND
+	ownerSigner, err := signer.Owner(context.Background())
 	if err != nil {
-		log.Fatalf("Invalid private key: %v", err)
-	}
-
-	publicKey := privateKey.Public()
-	publicKeyECDSA, ok := publicKey.(*ecdsa.PublicKey)
-	if !ok {
-		log.Fatal("Error casting public key to ECDSA")
+		log.Fatalf("Failed to load owner signer: %v", err)
 	}
 
-	fromAddress := crypto.PubkeyToAddress(*publicKeyECDSA)
+	fromAddress := ownerSigner.Address()
 	fmt.Printf("Derived Address: %s\n", fromAddress.Hex())
 
 	if strings.ToLower(fromAddress.Hex()) != strings.ToLower(ownerAddress) {
-		log.Fatalf("Private key doesn't match owner address!\nExpected: %s\nGot: %s", ownerAddress, fromAddress.Hex())
+		log.Fatalf("Owner key doesn't match owner address!\nExpected: %s\nGot: %s", ownerAddress, fromAddress.Hex())
 	}
 
 	// Check balance
@@ -127,7 +121,7 @@ func main() {
 	fmt.Println()
 
 	// Deploy the account by calling factory.createAccount(owner, salt)
-	err = deploySmartAccount(client, privateKey, factoryAddress, common.HexToAddress(ownerAddress), salt, chainID)
+	err = deploySmartAccount(client, ownerSigner, factoryAddress, common.HexToAddress(ownerAddress), salt, chainID)
 	if err != nil {
 		log.Fatalf("Deployment failed: %v", err)
 	}
@@ -143,20 +137,21 @@ func main() {
 	fmt.Println("3. Test sending a transaction")
 }
 
-func deploySmartAccount(client *ethclient.Client, privateKey *ecdsa.PrivateKey, factoryAddress, owner common.Address, salt *big.Int, chainID *big.Int) error {
+func deploySmartAccount(client *ethclient.Client, ownerSigner signer.Signer, factoryAddress, owner common.Address, salt *big.Int, chainID *big.Int) error {
 	ctx := context.Background()
 
 	// Get nonce
-	fromAddress := crypto.PubkeyToAddress(*privateKey.Public().(*ecdsa.PublicKey))
+	fromAddress := ownerSigner.Address()
 	nonce, err := client.PendingNonceAt(ctx, fromAddress)
 	if err != nil {
 		return fmt.Errorf("failed to get nonce: %w", err)
 	}
 
-	// Get gas price
-	gasPrice, err := client.SuggestGasPrice(ctx)
+	// Get EIP-1559 fees at the configured urgency
+	oracle := gasoracle.Default()
+	fees, err := oracle.SuggestFees(ctx, chainID.Int64(), client, oracle.Urgency())
 	if err != nil {
-		return fmt.Errorf("failed to get gas price: %w", err)
+		return fmt.Errorf("failed to get gas fees: %w", err)
 	}
 
 	// Encode function call: createAccount(address owner, uint256 salt)
@@ -172,11 +167,12 @@ func deploySmartAccount(client *ethclient.Client, privateKey *ecdsa.PrivateKey,
 
 	// Estimate gas
 	gasLimit, err := client.EstimateGas(ctx, ethereum.CallMsg{
-		From:     fromAddress,
-		To:       &factoryAddress,
-		GasPrice: gasPrice,
-		Value:    big.NewInt(0),
-		Data:     data,
+		From:      fromAddress,
+		To:        &factoryAddress,
+		GasFeeCap: fees.MaxFeePerGas,
+		GasTipCap: fees.MaxPriorityFeePerGas,
+		Value:     big.NewInt(0),
+		Data:      data,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to estimate gas: %w", err)
@@ -190,22 +186,24 @@ func deploySmartAccount(client *ethclient.Client, privateKey *ecdsa.PrivateKey,
 	fmt.Printf("  To: %s (Factory)\n", factoryAddress.Hex())
 	fmt.Printf("  Nonce: %d\n", nonce)
 	fmt.Printf("  Gas Limit: %d\n", gasLimit)
-	fmt.Printf("  Gas Price: %s Gwei\n", weiToGwei(gasPrice))
-	fmt.Printf("  Estimated Cost: %s ETH\n", weiToEther(new(big.Int).Mul(gasPrice, big.NewInt(int64(gasLimit)))))
+	fmt.Printf("  Max Fee: %s Gwei (priority %s Gwei, %s)\n", weiToGwei(fees.MaxFeePerGas), weiToGwei(fees.MaxPriorityFeePerGas), oracle.Urgency())
+	fmt.Printf("  Max Cost: %s ETH\n", weiToEther(new(big.Int).Mul(fees.MaxFeePerGas, big.NewInt(int64(gasLimit)))))
 	fmt.Println()
 
 	// Create transaction
-	tx := types.NewTransaction(
-		nonce,
-		factoryAddress,
-		big.NewInt(0),
-		gasLimit,
-		gasPrice,
-		data,
-	)
+	tx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     nonce,
+		GasTipCap: fees.MaxPriorityFeePerGas,
+		GasFeeCap: fees.MaxFeePerGas,
+		Gas:       gasLimit,
+		To:        &factoryAddress,
+		Value:     big.NewInt(0),
+		Data:      data,
+	})
 
 	// Sign transaction
-	signedTx, err := types.SignTx(tx, types.NewEIP155Signer(chainID), privateKey)
+	signedTx, err := signer.SignTx(ctx, ownerSigner, tx, chainID)
 	if err != nil {
 		return fmt.Errorf("failed to sign transaction: %w", err)
 	}
@@ -2,22 +2,48 @@ package main
 
 import (
 	"context"
+	"encoding/json"
+	"flag"
 	"fmt"
+	"os"
+	"sort"
+	"strings"
 
 	"github.com/NEDA-LABS/stablenode/config"
 	"github.com/NEDA-LABS/stablenode/ent"
+	networkent "github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	tokenent "github.com/NEDA-LABS/stablenode/ent/token"
+	"github.com/NEDA-LABS/stablenode/services"
 	"github.com/NEDA-LABS/stablenode/storage"
 	"github.com/NEDA-LABS/stablenode/utils/logger"
 	"github.com/shopspring/decimal"
 	"github.com/spf13/viper"
 )
 
-// List all receive addresses and their balances
+// List all receive addresses and their token balances
+// Usage: go run cmd/list_balances/main.go [--network base-sepolia] [--min-balance 0.01] [--json]
+
+// Balance is a single token balance held by a receive address
+type Balance struct {
+	Address string          `json:"address"`
+	Network string          `json:"network"`
+	Token   string          `json:"token"`
+	Balance decimal.Decimal `json:"balance"`
+}
 
 func main() {
-	fmt.Println("📊 Receive Address Balances")
-	fmt.Println("============================")
-	fmt.Println()
+	networkFlag := flag.String("network", "", "Only scan this network identifier")
+	minBalanceFlag := flag.String("min-balance", "0", "Only list balances greater than or equal to this amount")
+	jsonFlag := flag.Bool("json", false, "Print balances as JSON")
+	flag.Parse()
+
+	minBalance, err := decimal.NewFromString(*minBalanceFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid --min-balance %q: %v\n", *minBalanceFlag, err)
+		os.Exit(1)
+	}
 
 	// Load configuration
 	viper.SetConfigFile(".env")
@@ -36,76 +62,149 @@ func main() {
 
 	ctx := context.Background()
 
-	// Get all receive addresses
-	addresses, err := storage.Client.ReceiveAddress.
+	networkQuery := storage.Client.Network.
 		Query().
-		WithPaymentOrder(func(q *ent.PaymentOrderQuery) {
-			q.WithToken(func(tq *ent.TokenQuery) {
-				tq.WithNetwork()
-			})
-		}).
-		All(ctx)
+		Where(networkent.Not(networkent.IdentifierHasPrefix("tron")), networkent.NetworkTypeNEQ(networkent.NetworkTypeSolana)).
+		WithTokens(func(tq *ent.TokenQuery) {
+			tq.Where(tokenent.IsEnabledEQ(true))
+		})
+	if *networkFlag != "" {
+		networkQuery = networkQuery.Where(networkent.IdentifierEQ(*networkFlag))
+	}
 
+	networks, err := networkQuery.All(ctx)
 	if err != nil {
-		logger.Fatalf("Failed to fetch addresses: %v", err)
+		logger.Fatalf("Failed to fetch networks: %v", err)
 	}
 
-	if len(addresses) == 0 {
-		fmt.Println("No Alchemy receive addresses found")
-		return
+	if len(networks) == 0 {
+		logger.Fatalf("No EVM networks found")
 	}
 
-	fmt.Printf("Found %d receive addresses\n\n", len(addresses))
+	balanceService := services.NewBalanceService(0)
+	defer balanceService.Close()
 
-	totalValue := decimal.Zero
-	addressesWithFunds := 0
+	var balances []Balance
+	var failures int
 
-	for i, addr := range addresses {
-		if addr.Edges.PaymentOrder == nil {
-			continue
+	for _, network := range networks {
+		addresses, err := receiveAddresses(ctx, network)
+		if err != nil {
+			logger.Fatalf("Failed to fetch addresses for %s: %v", network.Identifier, err)
 		}
 
-		order := addr.Edges.PaymentOrder
-		token := order.Edges.Token
-		network := token.Edges.Network
-
-		fmt.Printf("%d. Address: %s\n", i+1, addr.Address)
-		fmt.Printf("   Network: %s\n", network.Identifier)
-		fmt.Printf("   Token: %s\n", token.Symbol)
-		fmt.Printf("   Order ID: %s\n", order.ID)
+		if len(addresses) == 0 || len(network.Edges.Tokens) == 0 {
+			continue
+		}
 
-		// Get balance
-		balance, err := getBalance(ctx, network, token, addr.Address)
+		tokenBalances, err := balanceService.GetTokenBalances(ctx, network, addresses, network.Edges.Tokens)
 		if err != nil {
-			fmt.Printf("   Balance: Error - %v\n", err)
-		} else {
-			fmt.Printf("   Balance: %s %s\n", balance, token.Symbol)
-			if balance.GreaterThan(decimal.Zero) {
-				addressesWithFunds++
-				totalValue = totalValue.Add(balance)
+			fmt.Fprintf(os.Stderr, "%s: %v\n", network.Identifier, err)
+			failures++
+			continue
+		}
+
+		for _, address := range addresses {
+			for _, token := range network.Edges.Tokens {
+				balance := tokenBalances[address][token.ID]
+				if balance.LessThan(minBalance) {
+					continue
+				}
+
+				balances = append(balances, Balance{
+					Address: address,
+					Network: network.Identifier,
+					Token:   token.Symbol,
+					Balance: balance,
+				})
 			}
 		}
-		fmt.Println()
 	}
 
+	if *jsonFlag {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(balances); err != nil {
+			logger.Fatalf("Failed to encode balances: %v", err)
+		}
+	} else {
+		printBalances(balances)
+	}
+
+	if failures > 0 {
+		os.Exit(1)
+	}
+}
+
+// receiveAddresses returns the distinct receive addresses on a network, including legacy
+// per-order addresses that only carry their network through the payment order's token
+func receiveAddresses(ctx context.Context, network *ent.Network) ([]string, error) {
+	rows, err := storage.Client.ReceiveAddress.
+		Query().
+		Where(
+			receiveaddress.Or(
+				receiveaddress.NetworkIdentifierEQ(network.Identifier),
+				receiveaddress.HasPaymentOrderWith(
+					paymentorder.HasTokenWith(
+						tokenent.HasNetworkWith(networkent.IDEQ(network.ID)),
+					),
+				),
+			),
+		).
+		Select(receiveaddress.FieldAddress).
+		Strings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool, len(rows))
+	addresses := make([]string, 0, len(rows))
+	for _, address := range rows {
+		key := strings.ToLower(address)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	return addresses, nil
+}
+
+// printBalances prints balances as a table with per-token totals
+func printBalances(balances []Balance) {
+	fmt.Println("📊 Receive Address Balances")
 	fmt.Println("============================")
-	fmt.Printf("Addresses with funds: %d\n", addressesWithFunds)
-	fmt.Printf("Total tokens: %s\n", totalValue)
 	fmt.Println()
 
-	if addressesWithFunds > 0 {
-		fmt.Println("To withdraw funds, use:")
-		fmt.Println("  go run cmd/withdraw_funds/main.go <address> <destination> <amount> <token> <network>")
+	if len(balances) == 0 {
+		fmt.Println("No balances found")
+		return
 	}
-}
 
-func getBalance(ctx context.Context, network *ent.Network, token *ent.Token, address string) (decimal.Decimal, error) {
-	// This would use the polling service's getTokenBalance method
-	// For now, return zero as placeholder
-	// You'll need to expose this method or duplicate the logic
-	_ = ctx
-	_ = network
-	_ = token
-	_ = address
-	return decimal.Zero, fmt.Errorf("balance check not implemented - use polling service")
+	fmt.Printf("%-44s %-20s %-8s %s\n", "ADDRESS", "NETWORK", "TOKEN", "BALANCE")
+	totals := make(map[string]decimal.Decimal)
+	for _, b := range balances {
+		fmt.Printf("%-44s %-20s %-8s %s\n", b.Address, b.Network, b.Token, b.Balance)
+
+		key := fmt.Sprintf("%s %s", b.Network, b.Token)
+		totals[key] = totals[key].Add(b.Balance)
+	}
+
+	fmt.Println()
+	fmt.Println("============================")
+
+	keys := make([]string, 0, len(totals))
+	for key := range totals {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		fmt.Printf("Total %s: %s\n", key, totals[key])
+	}
+	fmt.Println()
+
+	fmt.Println("To withdraw funds, use:")
+	fmt.Println("  go run cmd/withdraw_funds/main.go <address> <destination> <amount> <token> <network>")
 }
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/services"
+	"github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/spf13/viper"
+)
+
+// Pin in-flight EVM orders to the blockchain service holding their receive address before switching
+// USE_ALCHEMY_SERVICE, so they finish on the service that created them while new orders use the
+// new one. Run it again after the switch to see how many orders are still pending on each service
+// Usage: go run cmd/migrate_blockchain_service/main.go [--batch-size 500] [--dry-run]
+
+func main() {
+	batchSize := flag.Int("batch-size", 500, "Orders read per query")
+	dryRun := flag.Bool("dry-run", false, "Report pending orders without recording their blockchain service")
+	flag.Parse()
+
+	if *batchSize < 1 {
+		fmt.Println("Usage: go run cmd/migrate_blockchain_service/main.go [--batch-size <n>] [--dry-run]")
+		os.Exit(1)
+	}
+
+	fmt.Println("🔀 Migrate Blockchain Service")
+	fmt.Println("=============================")
+	fmt.Println()
+
+	// Load configuration
+	viper.SetConfigFile(".env")
+	viper.SetConfigType("env")
+	if err := viper.ReadInConfig(); err != nil {
+		logger.Fatalf("Failed to read .env: %v", err)
+	}
+	viper.AutomaticEnv()
+
+	// Connect to database
+	DSN := config.DBConfig()
+	if err := storage.DBConnection(DSN); err != nil {
+		logger.Fatalf("Database connection failed: %s", err)
+	}
+	defer storage.GetClient().Close()
+
+	if *dryRun {
+		fmt.Println("🔍 DRY RUN MODE - No orders will be updated")
+		fmt.Println()
+	}
+
+	serviceManager := services.NewServiceManager()
+	report, err := serviceManager.PinOrderBlockchainServices(context.Background(), *batchSize, *dryRun)
+	if err != nil {
+		logger.Fatalf("Migration failed: %v", err)
+	}
+
+	fmt.Printf("Active service: %s (%s)\n", report.ActiveService, serviceManager.GetActiveService())
+	fmt.Printf("Scanned:        %d in-flight EVM orders\n", report.Scanned)
+	fmt.Println("Pending on:")
+	for _, service := range []paymentorder.BlockchainService{paymentorder.BlockchainServiceEngine, paymentorder.BlockchainServiceAlchemy, paymentorder.BlockchainServiceRPC} {
+		fmt.Printf("  • %s: %d\n", service, report.Pending[service])
+	}
+
+	if *dryRun {
+		fmt.Printf("To pin:         %d\n", report.Pinned)
+	} else {
+		fmt.Printf("Pinned:         %d\n", report.Pinned)
+	}
+	if report.Unresolved > 0 {
+		fmt.Printf("Unresolved:     %d (no receive address, will use the active service)\n", report.Unresolved)
+	}
+	if report.Failed > 0 {
+		fmt.Printf("Failed:         %d\n", report.Failed)
+		for _, err := range report.Errors {
+			fmt.Printf("  ✗ %v\n", err)
+		}
+		os.Exit(1)
+	}
+	fmt.Println()
+
+	if !*dryRun {
+		fmt.Println("✅ In-flight orders are pinned to their blockchain service")
+		fmt.Println("   USE_ALCHEMY_SERVICE can be switched; keep both services configured until nothing is pending on the old one")
+	}
+}
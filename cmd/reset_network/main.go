@@ -0,0 +1,121 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	networkent "github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/services"
+	"github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/spf13/viper"
+)
+
+// Reset a network's chain state after its chain was reset (e.g. a testnet reset)
+// Usage: go run cmd/reset_network/main.go --network base-sepolia [--yes]
+
+func main() {
+	networkFlag := flag.String("network", "", "Network identifier to reset")
+	yesFlag := flag.Bool("yes", false, "Apply the reset without the confirmation prompt")
+	flag.Parse()
+
+	if *networkFlag == "" {
+		fmt.Println("Usage: go run cmd/reset_network/main.go --network <identifier> [--yes]")
+		os.Exit(1)
+	}
+
+	fmt.Println("🔄 Reset Network Chain State")
+	fmt.Println("============================")
+	fmt.Println()
+
+	// Load configuration
+	viper.SetConfigFile(".env")
+	viper.SetConfigType("env")
+	if err := viper.ReadInConfig(); err != nil {
+		logger.Fatalf("Failed to read .env: %v", err)
+	}
+	viper.AutomaticEnv()
+
+	// Connect to database
+	DSN := config.DBConfig()
+	if err := storage.DBConnection(DSN); err != nil {
+		logger.Fatalf("Database connection failed: %s", err)
+	}
+	defer storage.GetClient().Close()
+
+	ctx := context.Background()
+
+	network, err := storage.Client.Network.
+		Query().
+		Where(networkent.IdentifierEQ(*networkFlag)).
+		Only(ctx)
+	if err != nil {
+		logger.Fatalf("Network not found: %v", err)
+	}
+
+	// Dry run first so the operator sees what will be cleared
+	report, err := services.ResetNetworkChainState(ctx, network, true)
+	if err != nil {
+		logger.Fatalf("Failed to inspect network: %v", err)
+	}
+
+	fmt.Printf("Network:             %s (chain %d)\n", report.Network, network.ChainID)
+	fmt.Printf("Stored genesis hash: %s\n", valueOrNone(report.StoredGenesisHash))
+	fmt.Printf("Live genesis hash:   %s\n", report.LiveGenesisHash)
+	fmt.Println()
+
+	if report.StoredGenesisHash != "" && strings.EqualFold(report.StoredGenesisHash, report.LiveGenesisHash) {
+		fmt.Println("⚠️  Genesis hash is unchanged - the chain does not appear to have been reset")
+		fmt.Println()
+	}
+
+	fmt.Println("The reset will:")
+	fmt.Printf("  • Clear %d receive address index checkpoints\n", report.IndexCheckpoints)
+	fmt.Printf("  • Delete %d network webhook records and recreate the gateway webhook\n", report.PaymentWebhooks)
+	fmt.Printf("  • Delete %d per-order transfer webhooks\n", report.OrderWebhooks)
+	fmt.Printf("  • Invalidate %d pool deployments (addresses must be redeployed)\n", report.PoolDeployments)
+	fmt.Println("  • Record the live genesis hash and resume webhook processing")
+	fmt.Println()
+
+	if !*yesFlag {
+		fmt.Printf("Type the network identifier (%s) to confirm: ", network.Identifier)
+		answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+		if strings.TrimSpace(answer) != network.Identifier {
+			fmt.Println("Aborted")
+			os.Exit(1)
+		}
+	}
+
+	report, err = services.ResetNetworkChainState(ctx, network, false)
+	if err != nil {
+		logger.Fatalf("Network reset failed: %v", err)
+	}
+
+	fmt.Println()
+	fmt.Printf("✅ Network %s reset\n", report.Network)
+	if report.WebhookRecreateErr != nil {
+		fmt.Printf("⚠️  Failed to recreate gateway webhook: %v\n", report.WebhookRecreateErr)
+		fmt.Println("   Restart the server to retry webhook registration")
+	} else if !report.WebhooksRecreated {
+		fmt.Println("Gateway webhook will be registered by the active blockchain service on restart")
+	}
+	fmt.Println()
+
+	if report.PoolDeployments > 0 {
+		fmt.Println("Next steps: redeploy the pool addresses, e.g.")
+		fmt.Println("  poolctl deploy --input <pool file> --private-key $PRIVATE_KEY")
+		fmt.Println("  poolctl mark-deployed --input <deployment results file>")
+	}
+}
+
+func valueOrNone(value string) string {
+	if value == "" {
+		return "(none)"
+	}
+	return value
+}
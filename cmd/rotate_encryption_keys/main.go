@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/services"
+	"github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/spf13/viper"
+)
+
+// Re-encrypt receive address salts (smart account salts and EOA private keys) under the active
+// encryption key. Deploy with the new key in ENCRYPTION_KEYS and ENCRYPTION_KEY_ID first, so the
+// server already writes under it and still reads everything else, then run this until nothing is left
+// Usage: go run cmd/rotate_encryption_keys/main.go [--batch-size 500] [--dry-run]
+
+func main() {
+	batchSize := flag.Int("batch-size", 500, "Receive addresses read per query")
+	dryRun := flag.Bool("dry-run", false, "Decrypt and count salts without updating them")
+	flag.Parse()
+
+	if *batchSize < 1 {
+		fmt.Println("Usage: go run cmd/rotate_encryption_keys/main.go [--batch-size <n>] [--dry-run]")
+		os.Exit(1)
+	}
+
+	fmt.Println("🔑 Rotate Encryption Keys")
+	fmt.Println("=========================")
+	fmt.Println()
+
+	// Load configuration
+	viper.SetConfigFile(".env")
+	viper.SetConfigType("env")
+	if err := viper.ReadInConfig(); err != nil {
+		logger.Fatalf("Failed to read .env: %v", err)
+	}
+	viper.AutomaticEnv()
+
+	cryptoConf := config.CryptoConfig()
+	if cryptoConf.EncryptionKeyID == "" {
+		logger.Fatalf("ENCRYPTION_KEY_ID is not set - there is no key to re-encrypt under")
+	}
+	if _, ok := cryptoConf.EncryptionKeys[cryptoConf.EncryptionKeyID]; !ok {
+		logger.Fatalf("ENCRYPTION_KEY_ID %q is not in ENCRYPTION_KEYS", cryptoConf.EncryptionKeyID)
+	}
+
+	// Connect to database
+	DSN := config.DBConfig()
+	if err := storage.DBConnection(DSN); err != nil {
+		logger.Fatalf("Database connection failed: %s", err)
+	}
+	defer storage.GetClient().Close()
+
+	if *dryRun {
+		fmt.Println("🔍 DRY RUN MODE - No salts will be updated")
+		fmt.Println()
+	}
+
+	report, err := services.ReencryptReceiveAddressSalts(context.Background(), *batchSize, *dryRun)
+	if err != nil {
+		logger.Fatalf("Re-encryption failed: %v", err)
+	}
+
+	fmt.Printf("Active key:   %s\n", report.ActiveKeyID)
+	fmt.Printf("Scanned:      %d salts\n", report.Scanned)
+
+	keyIDs := make([]string, 0, len(report.ByKeyID))
+	for keyID := range report.ByKeyID {
+		keyIDs = append(keyIDs, keyID)
+	}
+	sort.Strings(keyIDs)
+	for _, keyID := range keyIDs {
+		name := keyID
+		if name == "" {
+			name = "SECRET (unversioned)"
+		}
+		fmt.Printf("  • %s: %d\n", name, report.ByKeyID[keyID])
+	}
+
+	verb := "Re-encrypted"
+	if *dryRun {
+		verb = "To re-encrypt"
+	}
+	fmt.Printf("%s: %d\n", verb, report.Reencrypted)
+	if report.Changed > 0 {
+		fmt.Printf("Changed:      %d (updated concurrently, run again)\n", report.Changed)
+	}
+	if report.Failed > 0 {
+		fmt.Printf("Failed:       %d\n", report.Failed)
+		for _, err := range report.Errors {
+			fmt.Printf("  ✗ %v\n", err)
+		}
+		os.Exit(1)
+	}
+	fmt.Println()
+
+	if !*dryRun && report.Changed == 0 {
+		fmt.Println("✅ All receive address salts are under the active key")
+		fmt.Println("   Old keys can be dropped from ENCRYPTION_KEYS once no other secrets use them")
+	}
+}
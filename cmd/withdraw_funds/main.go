@@ -8,6 +8,7 @@ import (
 	"github.com/NEDA-LABS/stablenode/config"
 	"github.com/NEDA-LABS/stablenode/ent/network"
 	"github.com/NEDA-LABS/stablenode/ent/token"
+	"github.com/NEDA-LABS/stablenode/services"
 	"github.com/NEDA-LABS/stablenode/storage"
 	"github.com/NEDA-LABS/stablenode/utils/logger"
 	"github.com/shopspring/decimal"
@@ -97,6 +98,28 @@ func main() {
 	fmt.Printf("Chain ID: %d\n", networkEntity.ChainID)
 	fmt.Println()
 
+	// Check on-chain balances before sending
+	balanceService := services.NewBalanceService(0)
+	defer balanceService.Close()
+
+	tokenBalance, err := balanceService.GetTokenBalance(ctx, networkEntity, receiveAddress, tokenEntity)
+	if err != nil {
+		logger.Fatalf("Failed to fetch token balance: %v", err)
+	}
+
+	nativeBalance, err := balanceService.GetNativeBalance(ctx, networkEntity, receiveAddress)
+	if err != nil {
+		logger.Fatalf("Failed to fetch native balance: %v", err)
+	}
+
+	fmt.Printf("Token Balance:  %s %s\n", tokenBalance, tokenSymbol)
+	fmt.Printf("Native Balance: %s\n", nativeBalance)
+	fmt.Println()
+
+	if tokenBalance.LessThan(amount) {
+		logger.Fatalf("Insufficient balance: %s has %s %s, requested %s", receiveAddress, tokenBalance, tokenSymbol, amount)
+	}
+
 	// Convert amount to wei (smallest unit)
 	amountWei := amount.Mul(decimal.NewFromInt(10).Pow(decimal.NewFromInt(int64(tokenEntity.Decimals))))
 
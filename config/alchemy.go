@@ -10,6 +10,10 @@ type AlchemyConfiguration struct {
 	BaseURL     string
 	GasPolicyID string // Optional - for gas sponsorship
 	AuthToken   string // For webhook management API
+
+	// SimulateUserOperations simulates signed UserOperations before sending them, so operations
+	// that would revert are rejected without a bundler round trip
+	SimulateUserOperations bool
 }
 
 // AlchemyConfig returns the Alchemy configuration
@@ -19,5 +23,7 @@ func AlchemyConfig() *AlchemyConfiguration {
 		BaseURL:     viper.GetString("ALCHEMY_BASE_URL"),
 		GasPolicyID: viper.GetString("ALCHEMY_GAS_POLICY_ID"),
 		AuthToken:   viper.GetString("ALCHEMY_AUTH_TOKEN"),
+
+		SimulateUserOperations: viper.GetBool("ALCHEMY_SIMULATE_USER_OPERATIONS"),
 	}
 }
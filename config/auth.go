@@ -14,10 +14,14 @@ type AuthConfiguration struct {
 	JwtRefreshLifespan    time.Duration
 	HmacTimestampAge      time.Duration
 	PasswordResetLifespan time.Duration
+	// APIKeyRotationOverlap is how long a rotated API key keeps working alongside its replacement
+	APIKeyRotationOverlap time.Duration
+	// RequestSignatureMaxAge is how far the timestamp of a signed sender request may be from now
+	RequestSignatureMaxAge time.Duration
 
 	// Slack config
-	SlackSigningSecret    string
-	SlackBotToken         string
+	SlackSigningSecret string
+	SlackBotToken      string
 
 	// Turnstile config
 	TurnstileSiteKey   string
@@ -31,6 +35,8 @@ func AuthConfig() (config *AuthConfiguration) {
 	viper.SetDefault("JWT_REFRESH_LIFESPAN", 10080) // 7 days
 	viper.SetDefault("HMAC_TIMESTAMP_AGE", 5)
 	viper.SetDefault("PASSWORD_RESET_LIFESPAN", 5)
+	viper.SetDefault("API_KEY_ROTATION_OVERLAP", 24)
+	viper.SetDefault("REQUEST_SIGNATURE_MAX_AGE", 300)
 
 	// Turnstile defaults
 	viper.SetDefault("TURNSTILE_ENABLED", true)
@@ -38,16 +44,18 @@ func AuthConfig() (config *AuthConfiguration) {
 	viper.SetDefault("TURNSTILE_SECRET_KEY", "")
 
 	return &AuthConfiguration{
-		Secret:                viper.GetString("SECRET"),
-		SlackSigningSecret:    viper.GetString("SLACK_SIGNING_SECRET"),
-		SlackBotToken:         viper.GetString("SLACK_BOT_TOKEN"),
-		JwtAccessLifespan:     time.Duration(viper.GetInt("JWT_ACCESS_LIFESPAN")) * time.Minute,
-		JwtRefreshLifespan:    time.Duration(viper.GetInt("JWT_REFRESH_LIFESPAN")) * time.Minute,
-		HmacTimestampAge:      time.Duration(viper.GetInt("HMAC_TIMESTAMP_AGE")) * time.Minute,
-		PasswordResetLifespan: time.Duration(viper.GetInt("PASSWORD_RESET_LIFESPAN")) * time.Minute,
-		TurnstileSiteKey:      viper.GetString("TURNSTILE_SITE_KEY"),
-		TurnstileSecretKey:    viper.GetString("TURNSTILE_SECRET_KEY"),
-		TurnstileEnabled:      viper.GetBool("TURNSTILE_ENABLED"),
+		Secret:                 viper.GetString("SECRET"),
+		SlackSigningSecret:     viper.GetString("SLACK_SIGNING_SECRET"),
+		SlackBotToken:          viper.GetString("SLACK_BOT_TOKEN"),
+		JwtAccessLifespan:      time.Duration(viper.GetInt("JWT_ACCESS_LIFESPAN")) * time.Minute,
+		JwtRefreshLifespan:     time.Duration(viper.GetInt("JWT_REFRESH_LIFESPAN")) * time.Minute,
+		HmacTimestampAge:       time.Duration(viper.GetInt("HMAC_TIMESTAMP_AGE")) * time.Minute,
+		PasswordResetLifespan:  time.Duration(viper.GetInt("PASSWORD_RESET_LIFESPAN")) * time.Minute,
+		APIKeyRotationOverlap:  time.Duration(viper.GetInt("API_KEY_ROTATION_OVERLAP")) * time.Hour,
+		RequestSignatureMaxAge: time.Duration(viper.GetInt("REQUEST_SIGNATURE_MAX_AGE")) * time.Second,
+		TurnstileSiteKey:       viper.GetString("TURNSTILE_SITE_KEY"),
+		TurnstileSecretKey:     viper.GetString("TURNSTILE_SECRET_KEY"),
+		TurnstileEnabled:       viper.GetBool("TURNSTILE_ENABLED"),
 	}
 }
 
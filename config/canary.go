@@ -0,0 +1,62 @@
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/spf13/viper"
+)
+
+// CanaryConfiguration defines the synthetic canary order configurations
+type CanaryConfiguration struct {
+	Enabled           bool
+	Interval          time.Duration
+	SLA               time.Duration
+	Network           string
+	Token             string
+	Amount            decimal.Decimal
+	Currency          string
+	SenderAPIKey      string
+	SenderSigningKey  string
+	WalletPrivateKey  string
+	TargetStatus      string
+	Institution       string
+	AccountIdentifier string
+	AccountName       string
+}
+
+// CanaryConfig sets the canary order configurations
+func CanaryConfig() *CanaryConfiguration {
+	viper.SetDefault("CANARY_ENABLED", false)
+	viper.SetDefault("CANARY_INTERVAL", 30)
+	viper.SetDefault("CANARY_SLA", 15)
+	viper.SetDefault("CANARY_NETWORK", "base-sepolia")
+	viper.SetDefault("CANARY_TOKEN", "USDC")
+	viper.SetDefault("CANARY_AMOUNT", 0.5)
+	viper.SetDefault("CANARY_CURRENCY", "NGN")
+	viper.SetDefault("CANARY_TARGET_STATUS", "settled")
+
+	return &CanaryConfiguration{
+		Enabled:           viper.GetBool("CANARY_ENABLED"),
+		Interval:          time.Duration(viper.GetInt("CANARY_INTERVAL")) * time.Minute,
+		SLA:               time.Duration(viper.GetInt("CANARY_SLA")) * time.Minute,
+		Network:           viper.GetString("CANARY_NETWORK"),
+		Token:             viper.GetString("CANARY_TOKEN"),
+		Amount:            decimal.NewFromFloat(viper.GetFloat64("CANARY_AMOUNT")),
+		Currency:          viper.GetString("CANARY_CURRENCY"),
+		SenderAPIKey:      viper.GetString("CANARY_SENDER_API_KEY"),
+		SenderSigningKey:  viper.GetString("CANARY_SENDER_REQUEST_SIGNING_SECRET"),
+		WalletPrivateKey:  viper.GetString("CANARY_WALLET_PRIVATE_KEY"),
+		TargetStatus:      viper.GetString("CANARY_TARGET_STATUS"),
+		Institution:       viper.GetString("CANARY_INSTITUTION"),
+		AccountIdentifier: viper.GetString("CANARY_ACCOUNT_IDENTIFIER"),
+		AccountName:       viper.GetString("CANARY_ACCOUNT_NAME"),
+	}
+}
+
+func init() {
+	if err := SetupConfig(); err != nil {
+		panic(fmt.Sprintf("config SetupConfig() error: %s", err))
+	}
+}
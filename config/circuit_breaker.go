@@ -0,0 +1,30 @@
+package config
+
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// CircuitBreakerConfiguration defines the circuit breaker around external service calls
+type CircuitBreakerConfiguration struct {
+	// FailureThreshold is how many consecutive failures open the circuit of a service host
+	FailureThreshold int
+	// OpenTimeout is how long an open circuit fails calls fast before letting probes through
+	OpenTimeout time.Duration
+	// HalfOpenProbes is how many probe calls must succeed in a row to close the circuit again
+	HalfOpenProbes int
+}
+
+// CircuitBreakerConfig sets the circuit breaker configurations
+func CircuitBreakerConfig() *CircuitBreakerConfiguration {
+	viper.SetDefault("CIRCUIT_BREAKER_FAILURE_THRESHOLD", 5)
+	viper.SetDefault("CIRCUIT_BREAKER_OPEN_TIMEOUT", 30)
+	viper.SetDefault("CIRCUIT_BREAKER_HALF_OPEN_PROBES", 2)
+
+	return &CircuitBreakerConfiguration{
+		FailureThreshold: viper.GetInt("CIRCUIT_BREAKER_FAILURE_THRESHOLD"),
+		OpenTimeout:      time.Duration(viper.GetInt("CIRCUIT_BREAKER_OPEN_TIMEOUT")) * time.Second,
+		HalfOpenProbes:   viper.GetInt("CIRCUIT_BREAKER_HALF_OPEN_PROBES"),
+	}
+}
@@ -0,0 +1,44 @@
+package config
+
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// ComplianceConfiguration defines the configurations of the screening of deposit senders
+type ComplianceConfiguration struct {
+	// Provider screens senders: "denylist" checks the denylisted addresses table, "http" also asks a
+	// risk API, and "none" turns screening off
+	Provider string
+	APIURL   string
+	APIKey   string
+	// RiskThreshold is the lowest risk level of the API, out of low, medium, high and severe, at
+	// which a sender is flagged
+	RiskThreshold string
+	Timeout       time.Duration
+	// FailOpen lets deposits through when the risk API can't be reached instead of quarantining them
+	FailOpen bool
+	// DenylistCacheTTL is how long an instance keeps the denylist in memory without a change being
+	// signalled through Redis
+	DenylistCacheTTL time.Duration
+}
+
+// ComplianceConfig sets the configurations of the screening of deposit senders
+func ComplianceConfig() *ComplianceConfiguration {
+	viper.SetDefault("COMPLIANCE_SCREENING_PROVIDER", "denylist")
+	viper.SetDefault("COMPLIANCE_RISK_THRESHOLD", "high")
+	viper.SetDefault("COMPLIANCE_API_TIMEOUT", 5)
+	viper.SetDefault("COMPLIANCE_FAIL_OPEN", false)
+	viper.SetDefault("DENYLIST_CACHE_TTL", 5)
+
+	return &ComplianceConfiguration{
+		Provider:         viper.GetString("COMPLIANCE_SCREENING_PROVIDER"),
+		APIURL:           viper.GetString("COMPLIANCE_API_URL"),
+		APIKey:           viper.GetString("COMPLIANCE_API_KEY"),
+		RiskThreshold:    viper.GetString("COMPLIANCE_RISK_THRESHOLD"),
+		Timeout:          time.Duration(viper.GetInt("COMPLIANCE_API_TIMEOUT")) * time.Second,
+		FailOpen:         viper.GetBool("COMPLIANCE_FAIL_OPEN"),
+		DenylistCacheTTL: time.Duration(viper.GetInt("DENYLIST_CACHE_TTL")) * time.Minute,
+	}
+}
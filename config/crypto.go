@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/spf13/viper"
 )
@@ -12,6 +13,12 @@ type CryptoConfiguration struct {
 	AggregatorPublicKey    string
 	AggregatorPrivateKey   string
 	AggregatorSmartAccount string
+	SaltMasterSecret       string
+	// EncryptionKeyID is the key new ciphertexts are encrypted under. Empty keeps encrypting with
+	// SECRET in the unversioned format
+	EncryptionKeyID string
+	// EncryptionKeys are the AES keys ciphertexts can be decrypted with, by key ID
+	EncryptionKeys map[string]string
 }
 
 // CryptoConfig sets the crypto configuration
@@ -22,9 +29,25 @@ func CryptoConfig() *CryptoConfiguration {
 		AggregatorPublicKey:    viper.GetString("AGGREGATOR_PUBLIC_KEY"),
 		AggregatorPrivateKey:   viper.GetString("AGGREGATOR_PRIVATE_KEY"),
 		AggregatorSmartAccount: viper.GetString("AGGREGATOR_SMART_ACCOUNT"),
+		SaltMasterSecret:       viper.GetString("SALT_MASTER_SECRET"),
+		EncryptionKeyID:        viper.GetString("ENCRYPTION_KEY_ID"),
+		EncryptionKeys:         parseEncryptionKeys(viper.GetString("ENCRYPTION_KEYS")),
 	}
 }
 
+// parseEncryptionKeys parses comma separated id:key pairs
+func parseEncryptionKeys(value string) map[string]string {
+	keys := make(map[string]string)
+	for _, pair := range strings.Split(value, ",") {
+		id, key, ok := strings.Cut(strings.TrimSpace(pair), ":")
+		if !ok || id == "" || key == "" {
+			continue
+		}
+		keys[id] = key
+	}
+	return keys
+}
+
 func init() {
 	if err := SetupConfig(); err != nil {
 		panic(fmt.Sprintf("config SetupConfig() error: %s", err))
@@ -0,0 +1,36 @@
+package config
+
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// DeadLetterConfiguration defines the configurations of the retry worker of failed event processing
+type DeadLetterConfiguration struct {
+	RetryInterval time.Duration
+	BatchSize     int
+	// MaxRetries is how many retries an entry gets before it is left to ops
+	MaxRetries int
+	// RetryDelay is how long an entry waits before its first retry; it doubles on each retry, up to
+	// MaxRetryDelay
+	RetryDelay    time.Duration
+	MaxRetryDelay time.Duration
+}
+
+// DeadLetterConfig sets the configurations of the retry worker of failed event processing
+func DeadLetterConfig() *DeadLetterConfiguration {
+	viper.SetDefault("DEAD_LETTER_RETRY_INTERVAL", 1)
+	viper.SetDefault("DEAD_LETTER_BATCH_SIZE", 50)
+	viper.SetDefault("DEAD_LETTER_MAX_RETRIES", 8)
+	viper.SetDefault("DEAD_LETTER_RETRY_DELAY", 60)
+	viper.SetDefault("DEAD_LETTER_MAX_RETRY_DELAY", 360)
+
+	return &DeadLetterConfiguration{
+		RetryInterval: time.Duration(viper.GetInt("DEAD_LETTER_RETRY_INTERVAL")) * time.Minute,
+		BatchSize:     viper.GetInt("DEAD_LETTER_BATCH_SIZE"),
+		MaxRetries:    viper.GetInt("DEAD_LETTER_MAX_RETRIES"),
+		RetryDelay:    time.Duration(viper.GetInt("DEAD_LETTER_RETRY_DELAY")) * time.Second,
+		MaxRetryDelay: time.Duration(viper.GetInt("DEAD_LETTER_MAX_RETRY_DELAY")) * time.Minute,
+	}
+}
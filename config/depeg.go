@@ -0,0 +1,44 @@
+package config
+
+import (
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/spf13/viper"
+)
+
+// DepegConfiguration defines the stablecoin depeg monitor configurations
+type DepegConfiguration struct {
+	Enabled   bool
+	Interval  time.Duration
+	Threshold decimal.Decimal
+	PriceURL  string
+	// PriceIDs maps token symbols to their price API IDs
+	PriceIDs map[string]string
+}
+
+// DepegConfig sets the stablecoin depeg monitor configurations
+func DepegConfig() *DepegConfiguration {
+	viper.SetDefault("DEPEG_MONITOR_ENABLED", false)
+	viper.SetDefault("DEPEG_MONITOR_INTERVAL", 5)
+	viper.SetDefault("DEPEG_THRESHOLD_PERCENT", 2)
+	viper.SetDefault("DEPEG_PRICE_URL", "https://api.coingecko.com/api/v3")
+	viper.SetDefault("DEPEG_PRICE_IDS", "USDT:tether,USDC:usd-coin,CUSD:celo-dollar,DAI:dai")
+
+	priceIDs := make(map[string]string)
+	for _, pair := range strings.Split(viper.GetString("DEPEG_PRICE_IDS"), ",") {
+		symbol, id, ok := strings.Cut(strings.TrimSpace(pair), ":")
+		if ok && symbol != "" && id != "" {
+			priceIDs[strings.ToUpper(symbol)] = id
+		}
+	}
+
+	return &DepegConfiguration{
+		Enabled:   viper.GetBool("DEPEG_MONITOR_ENABLED"),
+		Interval:  time.Duration(viper.GetInt("DEPEG_MONITOR_INTERVAL")) * time.Minute,
+		Threshold: decimal.NewFromFloat(viper.GetFloat64("DEPEG_THRESHOLD_PERCENT")),
+		PriceURL:  viper.GetString("DEPEG_PRICE_URL"),
+		PriceIDs:  priceIDs,
+	}
+}
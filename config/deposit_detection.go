@@ -0,0 +1,29 @@
+package config
+
+import (
+	"github.com/spf13/viper"
+)
+
+// DepositDetectionConfiguration defines how deposits to receive addresses are detected on networks
+// that don't set their own webhooks_enabled, websocket_enabled or polling_enabled
+type DepositDetectionConfiguration struct {
+	// Webhooks registers transfer and gateway webhooks with the blockchain service
+	Webhooks bool
+	// Websocket subscribes to transfer logs on networks with a WSS endpoint
+	Websocket bool
+	// Polling checks the balances of receive addresses of pending orders
+	Polling bool
+}
+
+// DepositDetectionConfig sets the deployment-wide deposit detection defaults
+func DepositDetectionConfig() *DepositDetectionConfiguration {
+	viper.SetDefault("ENABLE_WEBHOOKS", true)
+	viper.SetDefault("ENABLE_WEBSOCKET_INDEXER", false)
+	viper.SetDefault("ENABLE_POLLING_FALLBACK", false)
+
+	return &DepositDetectionConfiguration{
+		Webhooks:  viper.GetBool("ENABLE_WEBHOOKS"),
+		Websocket: viper.GetBool("ENABLE_WEBSOCKET_INDEXER"),
+		Polling:   viper.GetBool("ENABLE_POLLING_FALLBACK"),
+	}
+}
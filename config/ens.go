@@ -0,0 +1,30 @@
+package config
+
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// ENSConfiguration defines the ENS name resolution configurations
+type ENSConfiguration struct {
+	Enabled bool
+	// RPCURL is an Ethereum mainnet endpoint; the chain ID 1 network's endpoint is used when empty
+	RPCURL          string
+	RegistryAddress string
+	CacheTTL        time.Duration
+}
+
+// ENSConfig sets the ENS name resolution configurations
+func ENSConfig() *ENSConfiguration {
+	viper.SetDefault("ENS_RESOLUTION_ENABLED", false)
+	viper.SetDefault("ENS_REGISTRY_ADDRESS", "0x00000000000C2E074eC69A0dFb2997BA6C7d2e1e")
+	viper.SetDefault("ENS_CACHE_TTL", 24)
+
+	return &ENSConfiguration{
+		Enabled:         viper.GetBool("ENS_RESOLUTION_ENABLED"),
+		RPCURL:          viper.GetString("ENS_RPC_URL"),
+		RegistryAddress: viper.GetString("ENS_REGISTRY_ADDRESS"),
+		CacheTTL:        time.Duration(viper.GetInt("ENS_CACHE_TTL")) * time.Hour,
+	}
+}
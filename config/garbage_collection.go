@@ -0,0 +1,35 @@
+package config
+
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// GarbageCollectionConfiguration defines the configurations of the orphaned row garbage collector
+type GarbageCollectionConfiguration struct {
+	Enabled  bool
+	Interval time.Duration
+	// GracePeriod is how old a row must be before it is collected, so rows of writes still in flight are left alone
+	GracePeriod time.Duration
+	BatchSize   int
+	// DryRun counts orphaned rows without deleting them
+	DryRun bool
+}
+
+// GarbageCollectionConfig sets the orphaned row garbage collector configurations
+func GarbageCollectionConfig() *GarbageCollectionConfiguration {
+	viper.SetDefault("ORPHAN_GC_ENABLED", true)
+	viper.SetDefault("ORPHAN_GC_INTERVAL", 6)
+	viper.SetDefault("ORPHAN_GC_GRACE_PERIOD", 168)
+	viper.SetDefault("ORPHAN_GC_BATCH_SIZE", 500)
+	viper.SetDefault("ORPHAN_GC_DRY_RUN", false)
+
+	return &GarbageCollectionConfiguration{
+		Enabled:     viper.GetBool("ORPHAN_GC_ENABLED"),
+		Interval:    time.Duration(viper.GetInt("ORPHAN_GC_INTERVAL")) * time.Hour,
+		GracePeriod: time.Duration(viper.GetInt("ORPHAN_GC_GRACE_PERIOD")) * time.Hour,
+		BatchSize:   viper.GetInt("ORPHAN_GC_BATCH_SIZE"),
+		DryRun:      viper.GetBool("ORPHAN_GC_DRY_RUN"),
+	}
+}
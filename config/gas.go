@@ -0,0 +1,79 @@
+package config
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// GasOracleConfiguration defines the gas price oracle configurations
+type GasOracleConfiguration struct {
+	// FeeHistoryBlocks is how many recent blocks priority fees are sampled from
+	FeeHistoryBlocks uint64
+	CacheTTL         time.Duration
+	// Percentiles maps the slow, standard and fast urgency levels to the percentile of recent
+	// priority fees they pay
+	Percentiles map[string]float64
+	// BaseFeeMultiplier is how many times the next block's base fee maxFeePerGas covers, so a
+	// transaction stays includable while the base fee rises
+	BaseFeeMultiplier float64
+	// MinPriorityFee is the lowest priority fee suggested, in wei
+	MinPriorityFee *big.Int
+	// Urgency is the urgency level of transactions that do not ask for one
+	Urgency string
+}
+
+// GasOracleConfig sets the gas price oracle configurations
+func GasOracleConfig() *GasOracleConfiguration {
+	viper.SetDefault("GAS_ORACLE_FEE_HISTORY_BLOCKS", 10)
+	viper.SetDefault("GAS_ORACLE_CACHE_TTL", 12)
+	viper.SetDefault("GAS_ORACLE_SLOW_PERCENTILE", 10)
+	viper.SetDefault("GAS_ORACLE_STANDARD_PERCENTILE", 50)
+	viper.SetDefault("GAS_ORACLE_FAST_PERCENTILE", 90)
+	viper.SetDefault("GAS_ORACLE_BASE_FEE_MULTIPLIER", 2)
+	viper.SetDefault("GAS_ORACLE_MIN_PRIORITY_FEE", 700000)
+	viper.SetDefault("GAS_ORACLE_URGENCY", "standard")
+
+	return &GasOracleConfiguration{
+		FeeHistoryBlocks: viper.GetUint64("GAS_ORACLE_FEE_HISTORY_BLOCKS"),
+		CacheTTL:         time.Duration(viper.GetInt("GAS_ORACLE_CACHE_TTL")) * time.Second,
+		Percentiles: map[string]float64{
+			"slow":     viper.GetFloat64("GAS_ORACLE_SLOW_PERCENTILE"),
+			"standard": viper.GetFloat64("GAS_ORACLE_STANDARD_PERCENTILE"),
+			"fast":     viper.GetFloat64("GAS_ORACLE_FAST_PERCENTILE"),
+		},
+		BaseFeeMultiplier: viper.GetFloat64("GAS_ORACLE_BASE_FEE_MULTIPLIER"),
+		MinPriorityFee:    big.NewInt(viper.GetInt64("GAS_ORACLE_MIN_PRIORITY_FEE")),
+		Urgency:           viper.GetString("GAS_ORACLE_URGENCY"),
+	}
+}
+
+// UserOperationResubmissionConfiguration defines the configurations for replacing UserOperations
+// left unmined
+type UserOperationResubmissionConfiguration struct {
+	// StaleAfter is how long a UserOperation may stay unmined before it is replaced
+	StaleAfter time.Duration
+	// FeeBumpPercent is how much a replacement raises maxFeePerGas and maxPriorityFeePerGas over
+	// the operation it replaces; bundlers reject replacements raising them by less than 10%
+	FeeBumpPercent int64
+	// MaxAttempts is how many times an operation is sent, counting the first submission
+	MaxAttempts int
+	// TrackFor is how long an unmined operation is tracked before it is given up on
+	TrackFor time.Duration
+}
+
+// UserOperationResubmissionConfig sets the UserOperation resubmission configurations
+func UserOperationResubmissionConfig() *UserOperationResubmissionConfiguration {
+	viper.SetDefault("USEROP_STALE_AFTER", 120)
+	viper.SetDefault("USEROP_FEE_BUMP_PERCENT", 15)
+	viper.SetDefault("USEROP_MAX_ATTEMPTS", 5)
+	viper.SetDefault("USEROP_TRACK_FOR", 60)
+
+	return &UserOperationResubmissionConfiguration{
+		StaleAfter:     time.Duration(viper.GetInt("USEROP_STALE_AFTER")) * time.Second,
+		FeeBumpPercent: viper.GetInt64("USEROP_FEE_BUMP_PERCENT"),
+		MaxAttempts:    viper.GetInt("USEROP_MAX_ATTEMPTS"),
+		TrackFor:       time.Duration(viper.GetInt("USEROP_TRACK_FOR")) * time.Minute,
+	}
+}
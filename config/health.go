@@ -0,0 +1,31 @@
+package config
+
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// HealthConfiguration defines the configurations of the liveness and readiness endpoints
+type HealthConfiguration struct {
+	// CheckTimeout bounds each readiness check
+	CheckTimeout time.Duration
+	// CronStaleAfter is how long the cron scheduler may go without ticking a job before liveness fails
+	CronStaleAfter time.Duration
+	// PoolMinReady is the number of pool_ready addresses a network keeps before its pool is reported
+	// as degraded; 0 disables the check
+	PoolMinReady int
+}
+
+// HealthConfig sets the configurations of the liveness and readiness endpoints
+func HealthConfig() *HealthConfiguration {
+	viper.SetDefault("HEALTH_CHECK_TIMEOUT", 5)
+	viper.SetDefault("HEALTH_CRON_STALE_AFTER", 5)
+	viper.SetDefault("HEALTH_POOL_MIN_READY", 10)
+
+	return &HealthConfiguration{
+		CheckTimeout:   time.Duration(viper.GetInt("HEALTH_CHECK_TIMEOUT")) * time.Second,
+		CronStaleAfter: time.Duration(viper.GetInt("HEALTH_CRON_STALE_AFTER")) * time.Minute,
+		PoolMinReady:   viper.GetInt("HEALTH_POOL_MIN_READY"),
+	}
+}
@@ -0,0 +1,35 @@
+package config
+
+import (
+	"github.com/spf13/viper"
+)
+
+// InternalAPIConfiguration defines the internal gRPC API configurations
+type InternalAPIConfiguration struct {
+	Enabled       bool
+	ListenAddress string
+	Target        string
+	ServerName    string
+	CACertFile    string
+	CertFile      string
+	KeyFile       string
+}
+
+// InternalAPIConfig sets the internal gRPC API configurations.
+// The same certificate settings are used by the server and by clients of other deployables,
+// each presenting its own certificate signed by the shared internal CA.
+func InternalAPIConfig() *InternalAPIConfiguration {
+	viper.SetDefault("INTERNAL_API_ENABLED", false)
+	viper.SetDefault("INTERNAL_API_LISTEN_ADDRESS", ":9090")
+	viper.SetDefault("INTERNAL_API_TARGET", "localhost:9090")
+
+	return &InternalAPIConfiguration{
+		Enabled:       viper.GetBool("INTERNAL_API_ENABLED"),
+		ListenAddress: viper.GetString("INTERNAL_API_LISTEN_ADDRESS"),
+		Target:        viper.GetString("INTERNAL_API_TARGET"),
+		ServerName:    viper.GetString("INTERNAL_API_SERVER_NAME"),
+		CACertFile:    viper.GetString("INTERNAL_API_CA_CERT_FILE"),
+		CertFile:      viper.GetString("INTERNAL_API_CERT_FILE"),
+		KeyFile:       viper.GetString("INTERNAL_API_KEY_FILE"),
+	}
+}
@@ -0,0 +1,24 @@
+package config
+
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// LedgerConfiguration defines the configurations of the ledger invariant check
+type LedgerConfiguration struct {
+	CheckEnabled  bool
+	CheckInterval time.Duration
+}
+
+// LedgerConfig sets the ledger invariant check configurations
+func LedgerConfig() *LedgerConfiguration {
+	viper.SetDefault("LEDGER_CHECK_ENABLED", true)
+	viper.SetDefault("LEDGER_CHECK_INTERVAL", 60)
+
+	return &LedgerConfiguration{
+		CheckEnabled:  viper.GetBool("LEDGER_CHECK_ENABLED"),
+		CheckInterval: time.Duration(viper.GetInt("LEDGER_CHECK_INTERVAL")) * time.Minute,
+	}
+}
@@ -0,0 +1,62 @@
+package config
+
+import (
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/spf13/viper"
+)
+
+// MarketRateConfiguration defines the market rate service configurations
+type MarketRateConfiguration struct {
+	// Sources are the names of the sources the USDT rate of a fiat currency is pulled from
+	Sources []string
+	// MinSources is how many sources must agree on a rate before it is used
+	MinSources int
+	// OutlierThreshold is the percent a source may deviate from the median of all sources before
+	// it is left out of the rate
+	OutlierThreshold decimal.Decimal
+	CacheTTL         time.Duration
+	// RefreshInterval is how often the rates of enabled currencies are refreshed; it should be
+	// shorter than CacheTTL, as provider rates are only checked against cached rates
+	RefreshInterval time.Duration
+	// CheckEnabled makes provider rates further than MaxDeviation percent off the market rate
+	// ineligible for orders
+	CheckEnabled bool
+	MaxDeviation decimal.Decimal
+	CoinGeckoURL string
+	FixingsURL   string
+}
+
+// MarketRateConfig sets the market rate service configurations
+func MarketRateConfig() *MarketRateConfiguration {
+	viper.SetDefault("MARKET_RATE_SOURCES", "binance,quidax,coingecko,fixings")
+	viper.SetDefault("MARKET_RATE_MIN_SOURCES", 2)
+	viper.SetDefault("MARKET_RATE_OUTLIER_PERCENT", 3)
+	viper.SetDefault("MARKET_RATE_CACHE_TTL", 5)
+	viper.SetDefault("MARKET_RATE_REFRESH_INTERVAL", 2)
+	viper.SetDefault("MARKET_RATE_CHECK_ENABLED", false)
+	viper.SetDefault("MARKET_RATE_MAX_DEVIATION_PERCENT", 5)
+	viper.SetDefault("MARKET_RATE_COINGECKO_URL", "https://api.coingecko.com/api/v3")
+	viper.SetDefault("MARKET_RATE_FIXINGS_URL", "https://open.er-api.com/v6/latest/USD")
+
+	var sources []string
+	for _, source := range strings.Split(viper.GetString("MARKET_RATE_SOURCES"), ",") {
+		if source = strings.ToLower(strings.TrimSpace(source)); source != "" {
+			sources = append(sources, source)
+		}
+	}
+
+	return &MarketRateConfiguration{
+		Sources:          sources,
+		MinSources:       viper.GetInt("MARKET_RATE_MIN_SOURCES"),
+		OutlierThreshold: decimal.NewFromFloat(viper.GetFloat64("MARKET_RATE_OUTLIER_PERCENT")),
+		CacheTTL:         time.Duration(viper.GetInt("MARKET_RATE_CACHE_TTL")) * time.Minute,
+		RefreshInterval:  time.Duration(viper.GetInt("MARKET_RATE_REFRESH_INTERVAL")) * time.Minute,
+		CheckEnabled:     viper.GetBool("MARKET_RATE_CHECK_ENABLED"),
+		MaxDeviation:     decimal.NewFromFloat(viper.GetFloat64("MARKET_RATE_MAX_DEVIATION_PERCENT")),
+		CoinGeckoURL:     viper.GetString("MARKET_RATE_COINGECKO_URL"),
+		FixingsURL:       viper.GetString("MARKET_RATE_FIXINGS_URL"),
+	}
+}
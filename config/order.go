@@ -22,11 +22,41 @@ type OrderConfiguration struct {
 	PercentDeviationFromExternalRate decimal.Decimal
 	PercentDeviationFromMarketRate   decimal.Decimal
 	IndexingDuration                 time.Duration
+	PaymentSLA                       time.Duration
+	FulfillmentSLA                   time.Duration
+	SettlementSLA                    time.Duration
+	// FiatRateDriftTolerance is the rate band, as a fraction of the locked rate, within which
+	// orders denominated in fiat are converted at the rate current when they are paid
+	FiatRateDriftTolerance decimal.Decimal
+	// RateLockWindow is how long the rate quoted at order creation is honored. Orders paid later are
+	// re-quoted when the rate moved more than RateRequoteThreshold percent
+	RateLockWindow       time.Duration
+	RateRequoteThreshold decimal.Decimal
+	// OrderSplitEnabled splits orders larger than the largest provision bucket of their currency
+	// into parts assigned to different providers, instead of refunding them
+	OrderSplitEnabled  bool
+	OrderSplitMaxParts int
+	// ReassignmentTimeout is how long a provider has to fulfill an order it accepted before the order
+	// is taken away from it and offered to other providers, at most MaxReassignments times
+	ReassignmentTimeout time.Duration
+	MaxReassignments    int
+	// IdempotencyKeyTTL is how long the response to an order request made with an Idempotency-Key is
+	// kept to be replayed to retries of the request
+	IdempotencyKeyTTL time.Duration
+	// OrderBatchMaxSize is the most orders a sender can create in one batch
+	OrderBatchMaxSize int
+	// ReceiveAddressGracePeriod is how long a receive address still accepts deposits after its
+	// validity lapsed, before its order is expired
+	ReceiveAddressGracePeriod time.Duration
+	// ReceiveAddressMaxExtension is the longest a sender can extend the validity of a receive address by
+	ReceiveAddressMaxExtension time.Duration
 }
 
 // OrderConfig sets the order configuration
 func OrderConfig() *OrderConfiguration {
 	viper.SetDefault("RECEIVE_ADDRESS_VALIDITY", 30)
+	viper.SetDefault("RECEIVE_ADDRESS_GRACE_PERIOD", 10)
+	viper.SetDefault("RECEIVE_ADDRESS_MAX_EXTENSION", 1440)
 	viper.SetDefault("ORDER_REQUEST_VALIDITY", 30)
 	viper.SetDefault("ORDER_FULFILLMENT_VALIDITY", 1)
 	viper.SetDefault("ORDER_REFUND_TIMEOUT", 5)
@@ -36,11 +66,25 @@ func OrderConfig() *OrderConfiguration {
 	viper.SetDefault("PERCENT_DEVIATION_FROM_EXTERNAL_RATE", 0.01)
 	viper.SetDefault("PERCENT_DEVIATION_FROM_MARKET_RATE", 0.1)
 	viper.SetDefault("INDEXING_DURATION", 10)
+	viper.SetDefault("SLA_PAYMENT_WINDOW", 30)
+	viper.SetDefault("SLA_FULFILLMENT_WINDOW", 15)
+	viper.SetDefault("SLA_SETTLEMENT_WINDOW", 10)
+	viper.SetDefault("FIAT_ORDER_RATE_DRIFT_TOLERANCE", 0.02)
+	viper.SetDefault("RATE_LOCK_WINDOW", 30)
+	viper.SetDefault("RATE_REQUOTE_THRESHOLD_PERCENT", 1)
+	viper.SetDefault("ORDER_SPLIT_ENABLED", true)
+	viper.SetDefault("ORDER_SPLIT_MAX_PARTS", 5)
+	viper.SetDefault("LOCK_ORDER_REASSIGN_TIMEOUT", 10)
+	viper.SetDefault("LOCK_ORDER_MAX_REASSIGNMENTS", 3)
+	viper.SetDefault("IDEMPOTENCY_KEY_TTL", 24)
+	viper.SetDefault("ORDER_BATCH_MAX_SIZE", 500)
 
 	return &OrderConfiguration{
 		OrderFulfillmentValidity:         time.Duration(viper.GetInt("ORDER_FULFILLMENT_VALIDITY")) * time.Minute,
 		OrderRefundTimeout:               time.Duration(viper.GetInt("ORDER_REFUND_TIMEOUT")) * time.Minute,
 		ReceiveAddressValidity:           time.Duration(viper.GetInt("RECEIVE_ADDRESS_VALIDITY")) * time.Minute,
+		ReceiveAddressGracePeriod:        time.Duration(viper.GetInt("RECEIVE_ADDRESS_GRACE_PERIOD")) * time.Minute,
+		ReceiveAddressMaxExtension:       time.Duration(viper.GetInt("RECEIVE_ADDRESS_MAX_EXTENSION")) * time.Minute,
 		OrderRequestValidity:             time.Duration(viper.GetInt("ORDER_REQUEST_VALIDITY")) * time.Second,
 		TronProApiKey:                    viper.GetString("TRON_PRO_API_KEY"),
 		EntryPointContractAddress:        common.HexToAddress(viper.GetString("ENTRY_POINT_CONTRACT_ADDRESS")),
@@ -49,6 +93,18 @@ func OrderConfig() *OrderConfiguration {
 		PercentDeviationFromExternalRate: decimal.NewFromFloat(viper.GetFloat64("PERCENT_DEVIATION_FROM_EXTERNAL_RATE")),
 		PercentDeviationFromMarketRate:   decimal.NewFromFloat(viper.GetFloat64("PERCENT_DEVIATION_FROM_MARKET_RATE")),
 		IndexingDuration:                 time.Duration(viper.GetInt("INDEXING_DURATION")) * time.Second,
+		PaymentSLA:                       time.Duration(viper.GetInt("SLA_PAYMENT_WINDOW")) * time.Minute,
+		FulfillmentSLA:                   time.Duration(viper.GetInt("SLA_FULFILLMENT_WINDOW")) * time.Minute,
+		SettlementSLA:                    time.Duration(viper.GetInt("SLA_SETTLEMENT_WINDOW")) * time.Minute,
+		FiatRateDriftTolerance:           decimal.NewFromFloat(viper.GetFloat64("FIAT_ORDER_RATE_DRIFT_TOLERANCE")),
+		RateLockWindow:                   time.Duration(viper.GetInt("RATE_LOCK_WINDOW")) * time.Minute,
+		RateRequoteThreshold:             decimal.NewFromFloat(viper.GetFloat64("RATE_REQUOTE_THRESHOLD_PERCENT")),
+		OrderSplitEnabled:                viper.GetBool("ORDER_SPLIT_ENABLED"),
+		OrderSplitMaxParts:               viper.GetInt("ORDER_SPLIT_MAX_PARTS"),
+		ReassignmentTimeout:              time.Duration(viper.GetInt("LOCK_ORDER_REASSIGN_TIMEOUT")) * time.Minute,
+		MaxReassignments:                 viper.GetInt("LOCK_ORDER_MAX_REASSIGNMENTS"),
+		IdempotencyKeyTTL:                time.Duration(viper.GetInt("IDEMPOTENCY_KEY_TTL")) * time.Hour,
+		OrderBatchMaxSize:                viper.GetInt("ORDER_BATCH_MAX_SIZE"),
 	}
 }
 
@@ -0,0 +1,47 @@
+package config
+
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// OutboxConfiguration defines the configurations of the outbox worker sending settlements and
+// refunds on-chain
+type OutboxConfiguration struct {
+	PollInterval time.Duration
+	// NetworkConcurrency is how many transactions are sent at once on a network. The aggregator
+	// smart account orders its UserOperations by nonce, so raising it may get sends rejected
+	NetworkConcurrency int
+	MaxAttempts        int
+	// RetryDelay is how long a failed send waits before its first retry; it doubles on each retry
+	RetryDelay time.Duration
+	// ClaimTimeout is how long a transaction being sent is held back from other drains
+	ClaimTimeout time.Duration
+	// ConfirmTimeout is how long a sent transaction may stay unconfirmed before it is failed
+	ConfirmTimeout time.Duration
+	// EventLookbackBlocks is how many recent blocks are searched for the EntryPoint's
+	// UserOperationEvent logs of sent transactions
+	EventLookbackBlocks int64
+}
+
+// OutboxConfig sets the outbox worker configurations
+func OutboxConfig() *OutboxConfiguration {
+	viper.SetDefault("OUTBOX_POLL_INTERVAL", 5)
+	viper.SetDefault("OUTBOX_NETWORK_CONCURRENCY", 1)
+	viper.SetDefault("OUTBOX_MAX_ATTEMPTS", 5)
+	viper.SetDefault("OUTBOX_RETRY_DELAY", 30)
+	viper.SetDefault("OUTBOX_CLAIM_TIMEOUT", 5)
+	viper.SetDefault("OUTBOX_CONFIRM_TIMEOUT", 30)
+	viper.SetDefault("OUTBOX_EVENT_LOOKBACK_BLOCKS", 500)
+
+	return &OutboxConfiguration{
+		PollInterval:        time.Duration(viper.GetInt("OUTBOX_POLL_INTERVAL")) * time.Second,
+		NetworkConcurrency:  viper.GetInt("OUTBOX_NETWORK_CONCURRENCY"),
+		MaxAttempts:         viper.GetInt("OUTBOX_MAX_ATTEMPTS"),
+		RetryDelay:          time.Duration(viper.GetInt("OUTBOX_RETRY_DELAY")) * time.Second,
+		ClaimTimeout:        time.Duration(viper.GetInt("OUTBOX_CLAIM_TIMEOUT")) * time.Minute,
+		ConfirmTimeout:      time.Duration(viper.GetInt("OUTBOX_CONFIRM_TIMEOUT")) * time.Minute,
+		EventLookbackBlocks: viper.GetInt64("OUTBOX_EVENT_LOOKBACK_BLOCKS"),
+	}
+}
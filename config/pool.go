@@ -0,0 +1,21 @@
+package config
+
+import (
+	"github.com/spf13/viper"
+)
+
+// PoolConfiguration defines the configurations of the receive address pool
+type PoolConfiguration struct {
+	// CounterfactualAddresses serves pool addresses before their smart accounts are deployed. The
+	// account is deployed by the first UserOperation sent from it, such as a sweep or settlement
+	CounterfactualAddresses bool
+}
+
+// PoolConfig sets the configurations of the receive address pool
+func PoolConfig() *PoolConfiguration {
+	viper.SetDefault("POOL_COUNTERFACTUAL_ADDRESSES", false)
+
+	return &PoolConfiguration{
+		CounterfactualAddresses: viper.GetBool("POOL_COUNTERFACTUAL_ADDRESSES"),
+	}
+}
@@ -0,0 +1,33 @@
+package config
+
+import (
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// BlockchainProviderConfiguration defines the provider used for blockchain read paths
+type BlockchainProviderConfiguration struct {
+	// ReadProvider is alchemy, infura, quicknode or rpc. Empty keeps reads on the active service
+	ReadProvider string
+	InfuraAPIKey string
+	// QuickNodeEndpoints lists the endpoint of each chain as chainID=url pairs separated by commas,
+	// since every QuickNode endpoint has its own URL
+	QuickNodeEndpoints string
+	// RoutesCacheTTL is how long an instance keeps the blockchain routes in memory without a change
+	// being signalled through Redis
+	RoutesCacheTTL time.Duration
+}
+
+// BlockchainProviderConfig sets the blockchain provider configurations
+func BlockchainProviderConfig() *BlockchainProviderConfiguration {
+	viper.SetDefault("BLOCKCHAIN_ROUTES_CACHE_TTL", 1)
+
+	return &BlockchainProviderConfiguration{
+		ReadProvider:       strings.ToLower(viper.GetString("BLOCKCHAIN_READ_PROVIDER")),
+		InfuraAPIKey:       viper.GetString("INFURA_API_KEY"),
+		QuickNodeEndpoints: viper.GetString("QUICKNODE_ENDPOINTS"),
+		RoutesCacheTTL:     time.Duration(viper.GetInt("BLOCKCHAIN_ROUTES_CACHE_TTL")) * time.Minute,
+	}
+}
@@ -0,0 +1,52 @@
+package config
+
+import (
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/spf13/viper"
+)
+
+// ProviderBalanceConfiguration defines the provider balance monitor configurations
+type ProviderBalanceConfiguration struct {
+	Interval time.Duration
+	// FiatThresholds maps fiat currency codes to the float below which a provider leaves the queue
+	FiatThresholds map[string]decimal.Decimal
+	// OnchainThresholds maps token symbols to the balance of a provider's settlement address below
+	// which the token leaves the provider's queue entries
+	OnchainThresholds map[string]decimal.Decimal
+	SnapshotRetention time.Duration
+}
+
+// ProviderBalanceConfig sets the provider balance monitor configurations
+func ProviderBalanceConfig() *ProviderBalanceConfiguration {
+	viper.SetDefault("PROVIDER_BALANCE_MONITOR_INTERVAL", 5)
+	viper.SetDefault("PROVIDER_BALANCE_FIAT_THRESHOLDS", "")
+	viper.SetDefault("PROVIDER_BALANCE_ONCHAIN_THRESHOLDS", "")
+	viper.SetDefault("PROVIDER_BALANCE_SNAPSHOT_RETENTION", 30)
+
+	return &ProviderBalanceConfiguration{
+		Interval:          time.Duration(viper.GetInt("PROVIDER_BALANCE_MONITOR_INTERVAL")) * time.Minute,
+		FiatThresholds:    parseThresholds(viper.GetString("PROVIDER_BALANCE_FIAT_THRESHOLDS")),
+		OnchainThresholds: parseThresholds(viper.GetString("PROVIDER_BALANCE_ONCHAIN_THRESHOLDS")),
+		SnapshotRetention: time.Duration(viper.GetInt("PROVIDER_BALANCE_SNAPSHOT_RETENTION")) * 24 * time.Hour,
+	}
+}
+
+// parseThresholds parses a comma-separated list of CODE:amount pairs
+func parseThresholds(value string) map[string]decimal.Decimal {
+	thresholds := make(map[string]decimal.Decimal)
+	for _, pair := range strings.Split(value, ",") {
+		code, amount, ok := strings.Cut(strings.TrimSpace(pair), ":")
+		if !ok || code == "" {
+			continue
+		}
+		threshold, err := decimal.NewFromString(strings.TrimSpace(amount))
+		if err != nil || !threshold.IsPositive() {
+			continue
+		}
+		thresholds[strings.ToUpper(code)] = threshold
+	}
+	return thresholds
+}
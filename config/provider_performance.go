@@ -0,0 +1,34 @@
+package config
+
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// ProviderPerformanceConfiguration defines the provider performance scoring configurations
+type ProviderPerformanceConfiguration struct {
+	// WeightingEnabled weights the order of providers in the priority queues by their scores
+	WeightingEnabled bool
+	// Smoothing is the weight of the latest order in the moving averages of a provider's stats
+	Smoothing float64
+	// TargetSettlement is the average settlement latency above which a provider's score drops
+	TargetSettlement time.Duration
+	// MinScore is the floor of a provider's score, so no provider is starved of orders entirely
+	MinScore float64
+}
+
+// ProviderPerformanceConfig sets the provider performance scoring configurations
+func ProviderPerformanceConfig() *ProviderPerformanceConfiguration {
+	viper.SetDefault("PROVIDER_SCORE_WEIGHTING_ENABLED", true)
+	viper.SetDefault("PROVIDER_SCORE_SMOOTHING", 0.1)
+	viper.SetDefault("PROVIDER_SCORE_TARGET_SETTLEMENT", 600)
+	viper.SetDefault("PROVIDER_SCORE_MIN", 0.05)
+
+	return &ProviderPerformanceConfiguration{
+		WeightingEnabled: viper.GetBool("PROVIDER_SCORE_WEIGHTING_ENABLED"),
+		Smoothing:        viper.GetFloat64("PROVIDER_SCORE_SMOOTHING"),
+		TargetSettlement: time.Duration(viper.GetInt("PROVIDER_SCORE_TARGET_SETTLEMENT")) * time.Second,
+		MinScore:         viper.GetFloat64("PROVIDER_SCORE_MIN"),
+	}
+}
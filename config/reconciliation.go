@@ -0,0 +1,32 @@
+package config
+
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// ReconciliationConfiguration defines the configurations of the daily deposit reconciliation
+type ReconciliationConfiguration struct {
+	Enabled  bool
+	Interval time.Duration
+	// Delay is how long after the end of a UTC day it is reconciled, so indexing has caught up
+	Delay time.Duration
+	// AddressLookback is how long a receive address stays in reconciliations after it was last updated
+	AddressLookback time.Duration
+}
+
+// ReconciliationConfig sets the daily deposit reconciliation configurations
+func ReconciliationConfig() *ReconciliationConfiguration {
+	viper.SetDefault("RECONCILIATION_ENABLED", true)
+	viper.SetDefault("RECONCILIATION_INTERVAL", 60)
+	viper.SetDefault("RECONCILIATION_DELAY", 2)
+	viper.SetDefault("RECONCILIATION_ADDRESS_LOOKBACK", 30)
+
+	return &ReconciliationConfiguration{
+		Enabled:         viper.GetBool("RECONCILIATION_ENABLED"),
+		Interval:        time.Duration(viper.GetInt("RECONCILIATION_INTERVAL")) * time.Minute,
+		Delay:           time.Duration(viper.GetInt("RECONCILIATION_DELAY")) * time.Hour,
+		AddressLookback: time.Duration(viper.GetInt("RECONCILIATION_ADDRESS_LOOKBACK")) * 24 * time.Hour,
+	}
+}
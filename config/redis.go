@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -12,15 +13,40 @@ type RedisConfiguration struct {
 	Port     string
 	Password string
 	DB       int
+	// LookupCacheTTL bounds how long institution, fiat currency and token lookups are cached
+	LookupCacheTTL time.Duration
 }
 
 // RedisConfig retrieves the Redis configuration
 func RedisConfig() RedisConfiguration {
+	viper.SetDefault("LOOKUP_CACHE_TTL", 10)
+
 	return RedisConfiguration{
-		Host:     viper.GetString("REDIS_HOST"),
-		Port:     viper.GetString("REDIS_PORT"),
-		Password: viper.GetString("REDIS_PASSWORD"),
-		DB:       viper.GetInt("REDIS_DB"),
+		Host:           viper.GetString("REDIS_HOST"),
+		Port:           viper.GetString("REDIS_PORT"),
+		Password:       viper.GetString("REDIS_PASSWORD"),
+		DB:             viper.GetInt("REDIS_DB"),
+		LookupCacheTTL: time.Duration(viper.GetInt("LOOKUP_CACHE_TTL")) * time.Minute,
+	}
+}
+
+// DistributedLockConfiguration defines the Redis locks coordinating aggregator instances
+type DistributedLockConfiguration struct {
+	// Enabled makes cron jobs, polling, the outbox worker and the WebSocket indexer run on one
+	// instance at a time; a single instance may leave it off
+	Enabled bool
+	// TTL is how long a lock outlives an instance that stops renewing it
+	TTL time.Duration
+}
+
+// DistributedLockConfig sets the distributed lock configurations
+func DistributedLockConfig() *DistributedLockConfiguration {
+	viper.SetDefault("DISTRIBUTED_LOCKS_ENABLED", false)
+	viper.SetDefault("DISTRIBUTED_LOCK_TTL", 30)
+
+	return &DistributedLockConfiguration{
+		Enabled: viper.GetBool("DISTRIBUTED_LOCKS_ENABLED"),
+		TTL:     time.Duration(viper.GetInt("DISTRIBUTED_LOCK_TTL")) * time.Second,
 	}
 }
 
@@ -0,0 +1,28 @@
+package config
+
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// ReorgConfiguration defines the chain reorg monitor configurations
+type ReorgConfiguration struct {
+	Enabled  bool
+	Interval time.Duration
+	// Depth is the number of recent blocks whose hashes are tracked per network
+	Depth int64
+}
+
+// ReorgConfig sets the chain reorg monitor configurations
+func ReorgConfig() *ReorgConfiguration {
+	viper.SetDefault("REORG_MONITOR_ENABLED", false)
+	viper.SetDefault("REORG_MONITOR_INTERVAL", 30)
+	viper.SetDefault("REORG_MONITOR_DEPTH", 64)
+
+	return &ReorgConfiguration{
+		Enabled:  viper.GetBool("REORG_MONITOR_ENABLED"),
+		Interval: time.Duration(viper.GetInt("REORG_MONITOR_INTERVAL")) * time.Second,
+		Depth:    viper.GetInt64("REORG_MONITOR_DEPTH"),
+	}
+}
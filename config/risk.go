@@ -0,0 +1,27 @@
+package config
+
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// RiskConfiguration defines the configurations of the risk scoring of new sender orders
+type RiskConfiguration struct {
+	// BurstWindow is the window the recent orders of a sender are counted in
+	BurstWindow time.Duration
+	// BurstOrders is how many orders a sender may create within the burst window before new orders
+	// are held for manual review; zero turns burst scoring off
+	BurstOrders int
+}
+
+// RiskConfig sets the configurations of the risk scoring of new sender orders
+func RiskConfig() *RiskConfiguration {
+	viper.SetDefault("RISK_BURST_WINDOW", 10)
+	viper.SetDefault("RISK_BURST_ORDERS", 20)
+
+	return &RiskConfiguration{
+		BurstWindow: time.Duration(viper.GetInt("RISK_BURST_WINDOW")) * time.Minute,
+		BurstOrders: viper.GetInt("RISK_BURST_ORDERS"),
+	}
+}
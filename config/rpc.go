@@ -0,0 +1,79 @@
+package config
+
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// RPCConfiguration defines the RPC endpoint failover configurations
+type RPCConfiguration struct {
+	HealthCheckInterval time.Duration
+	HealthCheckTimeout  time.Duration
+	// BlacklistBase is how long an endpoint is skipped after its first failure, doubling with
+	// every consecutive failure up to BlacklistMax
+	BlacklistBase time.Duration
+	BlacklistMax  time.Duration
+	// MaxBlockLag is how far an endpoint may trail the highest block seen on its network before
+	// the health check treats it as failing
+	MaxBlockLag int64
+}
+
+// RPCConfig sets the RPC endpoint failover configurations
+func RPCConfig() *RPCConfiguration {
+	viper.SetDefault("RPC_HEALTH_CHECK_INTERVAL", 30)
+	viper.SetDefault("RPC_HEALTH_CHECK_TIMEOUT", 5)
+	viper.SetDefault("RPC_BLACKLIST_BASE", 10)
+	viper.SetDefault("RPC_BLACKLIST_MAX", 600)
+	viper.SetDefault("RPC_MAX_BLOCK_LAG", 20)
+
+	return &RPCConfiguration{
+		HealthCheckInterval: time.Duration(viper.GetInt("RPC_HEALTH_CHECK_INTERVAL")) * time.Second,
+		HealthCheckTimeout:  time.Duration(viper.GetInt("RPC_HEALTH_CHECK_TIMEOUT")) * time.Second,
+		BlacklistBase:       time.Duration(viper.GetInt("RPC_BLACKLIST_BASE")) * time.Second,
+		BlacklistMax:        time.Duration(viper.GetInt("RPC_BLACKLIST_MAX")) * time.Second,
+		MaxBlockLag:         viper.GetInt64("RPC_MAX_BLOCK_LAG"),
+	}
+}
+
+// RPCRateLimitConfiguration defines the per provider limits of outbound RPC calls
+type RPCRateLimitConfiguration struct {
+	// Providers maps alchemy, infura and quicknode to their limit in requests per second
+	Providers map[string]float64
+	// Default is the limit of every other endpoint host; 0 leaves them unlimited
+	Default float64
+	// Burst is how many calls a provider may take at once after being idle
+	Burst int
+	// ShedHighWatermark is the share of a provider's limit in demand at which polling and backfill
+	// calls are shed, keeping the rest for webhook verification and settlements. Shedding stops once
+	// demand falls below ShedLowWatermark
+	ShedHighWatermark float64
+	ShedLowWatermark  float64
+	// ShedCooldown is how long calls are shed after a provider answers 429 Too Many Requests
+	ShedCooldown time.Duration
+}
+
+// RPCRateLimitConfig sets the outbound RPC rate limit configurations
+func RPCRateLimitConfig() *RPCRateLimitConfiguration {
+	viper.SetDefault("RPC_RATE_LIMIT_ALCHEMY", 25)
+	viper.SetDefault("RPC_RATE_LIMIT_INFURA", 10)
+	viper.SetDefault("RPC_RATE_LIMIT_QUICKNODE", 25)
+	viper.SetDefault("RPC_RATE_LIMIT_DEFAULT", 0)
+	viper.SetDefault("RPC_RATE_LIMIT_BURST", 10)
+	viper.SetDefault("RPC_SHED_HIGH_WATERMARK", 0.8)
+	viper.SetDefault("RPC_SHED_LOW_WATERMARK", 0.5)
+	viper.SetDefault("RPC_SHED_COOLDOWN", 30)
+
+	return &RPCRateLimitConfiguration{
+		Providers: map[string]float64{
+			"alchemy":   viper.GetFloat64("RPC_RATE_LIMIT_ALCHEMY"),
+			"infura":    viper.GetFloat64("RPC_RATE_LIMIT_INFURA"),
+			"quicknode": viper.GetFloat64("RPC_RATE_LIMIT_QUICKNODE"),
+		},
+		Default:           viper.GetFloat64("RPC_RATE_LIMIT_DEFAULT"),
+		Burst:             viper.GetInt("RPC_RATE_LIMIT_BURST"),
+		ShedHighWatermark: viper.GetFloat64("RPC_SHED_HIGH_WATERMARK"),
+		ShedLowWatermark:  viper.GetFloat64("RPC_SHED_LOW_WATERMARK"),
+		ShedCooldown:      time.Duration(viper.GetInt("RPC_SHED_COOLDOWN")) * time.Second,
+	}
+}
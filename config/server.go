@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -19,6 +20,13 @@ type ServerConfiguration struct {
 	RateLimitUnauthenticated int
 	RateLimitAuthenticated   int
 	SlackWebhookURL          string
+	WebhookSelfCheck         bool
+	AdminAPIKey              string
+	// AdminStatsCacheTTL is how long the admin dashboard statistics are cached
+	AdminStatsCacheTTL time.Duration
+	// MetricsListenAddress is the internal address Prometheus metrics are served on, kept off the
+	// public API. Metrics are not served when empty
+	MetricsListenAddress string
 }
 
 // ServerConfig sets the server configuration
@@ -34,6 +42,10 @@ func ServerConfig() *ServerConfiguration {
 	viper.SetDefault("RATE_LIMIT_AUTHENTICATED", 500)
 	viper.SetDefault("SLACK_WEBHOOK_URL", "")
 	viper.SetDefault("SERVER_URL", "")
+	viper.SetDefault("WEBHOOK_SELF_CHECK", true)
+	viper.SetDefault("ADMIN_API_KEY", "")
+	viper.SetDefault("ADMIN_STATS_CACHE_TTL", 60)
+	viper.SetDefault("METRICS_LISTEN_ADDRESS", ":9091")
 
 	return &ServerConfiguration{
 		Debug:                    viper.GetBool("DEBUG"),
@@ -47,6 +59,10 @@ func ServerConfig() *ServerConfiguration {
 		RateLimitUnauthenticated: viper.GetInt("RATE_LIMIT_UNAUTHENTICATED"),
 		RateLimitAuthenticated:   viper.GetInt("RATE_LIMIT_AUTHENTICATED"),
 		SlackWebhookURL:          viper.GetString("SLACK_WEBHOOK_URL"),
+		WebhookSelfCheck:         viper.GetBool("WEBHOOK_SELF_CHECK"),
+		AdminAPIKey:              viper.GetString("ADMIN_API_KEY"),
+		AdminStatsCacheTTL:       time.Duration(viper.GetInt("ADMIN_STATS_CACHE_TTL")) * time.Second,
+		MetricsListenAddress:     viper.GetString("METRICS_LISTEN_ADDRESS"),
 	}
 }
 
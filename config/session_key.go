@@ -0,0 +1,41 @@
+package config
+
+import (
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// SessionKeyConfiguration defines the restricted key automated workers sign smart account calls with
+type SessionKeyConfiguration struct {
+	// Signer references the session key like a network owner signer: env:<VARIABLE>,
+	// aws_kms:<key id> or gcp_kms:<key version name>. Empty disables session keys
+	Signer string
+	// PluginAddress is the session key plugin asked whether an account has the session key
+	PluginAddress string
+	// AllowedDestinations are the recipients of ERC-20 transfers, spenders of approvals and contracts
+	// the session key may call, besides each network's gateway contract
+	AllowedDestinations []string
+	// CacheTTL is how long an account's session key registration is remembered
+	CacheTTL time.Duration
+}
+
+// SessionKeyConfig sets the session key configurations
+func SessionKeyConfig() *SessionKeyConfiguration {
+	viper.SetDefault("SESSION_KEY_CACHE_TTL", 600)
+
+	var destinations []string
+	for _, destination := range strings.Split(viper.GetString("SESSION_KEY_ALLOWED_DESTINATIONS"), ",") {
+		if destination = strings.TrimSpace(destination); destination != "" {
+			destinations = append(destinations, destination)
+		}
+	}
+
+	return &SessionKeyConfiguration{
+		Signer:              viper.GetString("SESSION_KEY_SIGNER"),
+		PluginAddress:       viper.GetString("SESSION_KEY_PLUGIN_ADDRESS"),
+		AllowedDestinations: destinations,
+		CacheTTL:            time.Duration(viper.GetInt("SESSION_KEY_CACHE_TTL")) * time.Second,
+	}
+}
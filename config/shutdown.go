@@ -0,0 +1,23 @@
+package config
+
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// ShutdownConfiguration defines the configurations of the graceful shutdown
+type ShutdownConfiguration struct {
+	// Timeout bounds how long in-flight requests, UserOperations and cron iterations are waited
+	// for before the process exits
+	Timeout time.Duration
+}
+
+// ShutdownConfig sets the graceful shutdown configurations
+func ShutdownConfig() *ShutdownConfiguration {
+	viper.SetDefault("SHUTDOWN_TIMEOUT", 30)
+
+	return &ShutdownConfiguration{
+		Timeout: time.Duration(viper.GetInt("SHUTDOWN_TIMEOUT")) * time.Second,
+	}
+}
@@ -0,0 +1,52 @@
+package config
+
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// SignerConfiguration defines where the smart account owner and deployer keys are held
+type SignerConfiguration struct {
+	// Backend is local, aws_kms or gcp_kms. Local keys come from SMART_ACCOUNT_OWNER_PRIVATE_KEY
+	// and DEPLOYER_PRIVATE_KEY
+	Backend string
+	// OwnerKeyID and DeployerKeyID identify the KMS keys: an AWS key ID, ARN or alias, or a Google
+	// Cloud crypto key version resource name
+	OwnerKeyID    string
+	DeployerKeyID string
+	Timeout       time.Duration
+
+	AWSRegion          string
+	AWSAccessKeyID     string
+	AWSSecretAccessKey string
+	AWSSessionToken    string
+	// AWSEndpoint overrides https://kms.<region>.amazonaws.com
+	AWSEndpoint string
+
+	// GCPAccessToken is used instead of fetching tokens from the metadata server
+	GCPAccessToken string
+	// GCPEndpoint overrides https://cloudkms.googleapis.com
+	GCPEndpoint string
+}
+
+// SignerConfig sets where the smart account owner and deployer keys are held
+func SignerConfig() *SignerConfiguration {
+	viper.SetDefault("SIGNER_BACKEND", "local")
+	viper.SetDefault("SIGNER_TIMEOUT", 10)
+	viper.SetDefault("GCP_KMS_ENDPOINT", "https://cloudkms.googleapis.com")
+
+	return &SignerConfiguration{
+		Backend:            viper.GetString("SIGNER_BACKEND"),
+		OwnerKeyID:         viper.GetString("SIGNER_OWNER_KEY_ID"),
+		DeployerKeyID:      viper.GetString("SIGNER_DEPLOYER_KEY_ID"),
+		Timeout:            time.Duration(viper.GetInt("SIGNER_TIMEOUT")) * time.Second,
+		AWSRegion:          viper.GetString("AWS_REGION"),
+		AWSAccessKeyID:     viper.GetString("AWS_ACCESS_KEY_ID"),
+		AWSSecretAccessKey: viper.GetString("AWS_SECRET_ACCESS_KEY"),
+		AWSSessionToken:    viper.GetString("AWS_SESSION_TOKEN"),
+		AWSEndpoint:        viper.GetString("AWS_KMS_ENDPOINT"),
+		GCPAccessToken:     viper.GetString("GCP_ACCESS_TOKEN"),
+		GCPEndpoint:        viper.GetString("GCP_KMS_ENDPOINT"),
+	}
+}
@@ -0,0 +1,27 @@
+package config
+
+import (
+	"github.com/shopspring/decimal"
+	"github.com/spf13/viper"
+)
+
+// SweepConfiguration defines the configurations for sweeping funds out of receive addresses
+type SweepConfiguration struct {
+	OfflineSigningThreshold decimal.Decimal
+	OfflineSignerAddress    string
+	TreasuryAddress         string
+}
+
+// SweepConfig sets the sweep configurations.
+// Sweeps of at least OfflineSigningThreshold token units are not signed on the server; they are
+// exported for an air-gapped signer holding the key of OfflineSignerAddress. Deposits no order claims
+// can be swept to TreasuryAddress.
+func SweepConfig() *SweepConfiguration {
+	viper.SetDefault("SWEEP_OFFLINE_SIGNING_THRESHOLD", 10000)
+
+	return &SweepConfiguration{
+		OfflineSigningThreshold: decimal.NewFromFloat(viper.GetFloat64("SWEEP_OFFLINE_SIGNING_THRESHOLD")),
+		OfflineSignerAddress:    viper.GetString("SWEEP_OFFLINE_SIGNER_ADDRESS"),
+		TreasuryAddress:         viper.GetString("SWEEP_TREASURY_ADDRESS"),
+	}
+}
@@ -0,0 +1,44 @@
+package config
+
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// WatchdogConfiguration defines the stuck-order watchdog configurations
+type WatchdogConfiguration struct {
+	Enabled  bool
+	Interval time.Duration
+	// PendingThreshold is how long a paid payment order may wait to be created on-chain
+	PendingThreshold time.Duration
+	// UnassignedThreshold is how long a lock order may wait for a provider to accept it
+	UnassignedThreshold time.Duration
+	// ProcessingThreshold is how long a provider may hold an accepted lock order without fulfilling it
+	ProcessingThreshold time.Duration
+	// ValidatedThreshold is how long a validated lock order may wait to be settled on-chain
+	ValidatedThreshold time.Duration
+	// MaxAttempts is how many remediations an order gets in a state before it is escalated
+	MaxAttempts int
+}
+
+// WatchdogConfig sets the stuck-order watchdog configurations
+func WatchdogConfig() *WatchdogConfiguration {
+	viper.SetDefault("WATCHDOG_ENABLED", true)
+	viper.SetDefault("WATCHDOG_INTERVAL", 5)
+	viper.SetDefault("WATCHDOG_PENDING_THRESHOLD", 15)
+	viper.SetDefault("WATCHDOG_UNASSIGNED_THRESHOLD", 10)
+	viper.SetDefault("WATCHDOG_PROCESSING_THRESHOLD", 60)
+	viper.SetDefault("WATCHDOG_VALIDATED_THRESHOLD", 20)
+	viper.SetDefault("WATCHDOG_MAX_ATTEMPTS", 3)
+
+	return &WatchdogConfiguration{
+		Enabled:             viper.GetBool("WATCHDOG_ENABLED"),
+		Interval:            time.Duration(viper.GetInt("WATCHDOG_INTERVAL")) * time.Minute,
+		PendingThreshold:    time.Duration(viper.GetInt("WATCHDOG_PENDING_THRESHOLD")) * time.Minute,
+		UnassignedThreshold: time.Duration(viper.GetInt("WATCHDOG_UNASSIGNED_THRESHOLD")) * time.Minute,
+		ProcessingThreshold: time.Duration(viper.GetInt("WATCHDOG_PROCESSING_THRESHOLD")) * time.Minute,
+		ValidatedThreshold:  time.Duration(viper.GetInt("WATCHDOG_VALIDATED_THRESHOLD")) * time.Minute,
+		MaxAttempts:         viper.GetInt("WATCHDOG_MAX_ATTEMPTS"),
+	}
+}
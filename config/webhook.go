@@ -0,0 +1,72 @@
+package config
+
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// WebhookConfiguration defines the default retry policy of outbound webhook notifications
+type WebhookConfiguration struct {
+	MaxAttempts       int
+	Backoff           time.Duration
+	BackoffMultiplier float64
+	Timeout           time.Duration
+	MaxRetryDuration  time.Duration
+	DisableAfter      time.Duration
+	QueueWorkers      int
+}
+
+// WebhookConfig sets the outbound webhook configurations.
+// Destinations may override MaxAttempts, Backoff, BackoffMultiplier and Timeout; a destination
+// failing continuously for DisableAfter is disabled. QueueWorkers deliver queued notifications
+// concurrently.
+func WebhookConfig() *WebhookConfiguration {
+	viper.SetDefault("WEBHOOK_RETRY_MAX_ATTEMPTS", 10)
+	viper.SetDefault("WEBHOOK_RETRY_BACKOFF", 120)
+	viper.SetDefault("WEBHOOK_RETRY_BACKOFF_MULTIPLIER", 2)
+	viper.SetDefault("WEBHOOK_TIMEOUT", 30)
+	viper.SetDefault("WEBHOOK_RETRY_MAX_DURATION", 24)
+	viper.SetDefault("WEBHOOK_DISABLE_AFTER", 24)
+	viper.SetDefault("WEBHOOK_QUEUE_WORKERS", 4)
+
+	return &WebhookConfiguration{
+		MaxAttempts:       viper.GetInt("WEBHOOK_RETRY_MAX_ATTEMPTS"),
+		Backoff:           time.Duration(viper.GetInt("WEBHOOK_RETRY_BACKOFF")) * time.Second,
+		BackoffMultiplier: viper.GetFloat64("WEBHOOK_RETRY_BACKOFF_MULTIPLIER"),
+		Timeout:           time.Duration(viper.GetInt("WEBHOOK_TIMEOUT")) * time.Second,
+		MaxRetryDuration:  time.Duration(viper.GetInt("WEBHOOK_RETRY_MAX_DURATION")) * time.Hour,
+		DisableAfter:      time.Duration(viper.GetInt("WEBHOOK_DISABLE_AFTER")) * time.Hour,
+		QueueWorkers:      viper.GetInt("WEBHOOK_QUEUE_WORKERS"),
+	}
+}
+
+// WebhookLatencyConfiguration defines the latency budgets of inbound deposit webhook deliveries,
+// so handlers answer before the provider's delivery timeout
+type WebhookLatencyConfiguration struct {
+	// Handler bounds the whole delivery; deposits left when it runs out are left to reconciliation
+	Handler           time.Duration
+	RateFetch         time.Duration
+	InstitutionLookup time.Duration
+	// Paymaster bounds on-chain order creation, which sponsors and submits a user operation
+	Paymaster time.Duration
+	// Deferred bounds work that keeps running in the background after exceeding its budget
+	Deferred time.Duration
+}
+
+// WebhookLatencyConfig sets the inbound webhook latency budgets
+func WebhookLatencyConfig() *WebhookLatencyConfiguration {
+	viper.SetDefault("WEBHOOK_HANDLER_BUDGET", 10)
+	viper.SetDefault("WEBHOOK_RATE_FETCH_BUDGET", 2)
+	viper.SetDefault("WEBHOOK_INSTITUTION_LOOKUP_BUDGET", 2)
+	viper.SetDefault("WEBHOOK_PAYMASTER_BUDGET", 5)
+	viper.SetDefault("WEBHOOK_DEFERRED_TIMEOUT", 120)
+
+	return &WebhookLatencyConfiguration{
+		Handler:           time.Duration(viper.GetInt("WEBHOOK_HANDLER_BUDGET")) * time.Second,
+		RateFetch:         time.Duration(viper.GetInt("WEBHOOK_RATE_FETCH_BUDGET")) * time.Second,
+		InstitutionLookup: time.Duration(viper.GetInt("WEBHOOK_INSTITUTION_LOOKUP_BUDGET")) * time.Second,
+		Paymaster:         time.Duration(viper.GetInt("WEBHOOK_PAYMASTER_BUDGET")) * time.Second,
+		Deferred:          time.Duration(viper.GetInt("WEBHOOK_DEFERRED_TIMEOUT")) * time.Second,
+	}
+}
@@ -0,0 +1,39 @@
+package config
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// EventWorkerConfiguration defines the sizes of the per network worker pools processing indexed
+// gateway and transfer events
+type EventWorkerConfiguration struct {
+	// Size is how many events of a network are processed at once
+	Size int
+	// Overrides maps network identifiers to a pool size replacing Size
+	Overrides map[string]int
+}
+
+// EventWorkerConfig sets the event worker pool configurations
+func EventWorkerConfig() *EventWorkerConfiguration {
+	viper.SetDefault("EVENT_WORKERS_PER_NETWORK", 8)
+	viper.SetDefault("EVENT_WORKERS_OVERRIDES", "")
+
+	overrides := make(map[string]int)
+	for _, pair := range strings.Split(viper.GetString("EVENT_WORKERS_OVERRIDES"), ",") {
+		network, size, ok := strings.Cut(strings.TrimSpace(pair), ":")
+		if !ok || network == "" {
+			continue
+		}
+		if n, err := strconv.Atoi(size); err == nil && n > 0 {
+			overrides[network] = n
+		}
+	}
+
+	return &EventWorkerConfiguration{
+		Size:      viper.GetInt("EVENT_WORKERS_PER_NETWORK"),
+		Overrides: overrides,
+	}
+}
@@ -0,0 +1,208 @@
+package accounts
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/apikey"
+	"github.com/NEDA-LABS/stablenode/ent/providerprofile"
+	"github.com/NEDA-LABS/stablenode/ent/senderprofile"
+	"github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+	u "github.com/NEDA-LABS/stablenode/utils"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/google/uuid"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListAPIKeys lists the API keys of the sender or provider, without their secrets
+func (ctrl *ProfileController) ListAPIKeys(ctx *gin.Context) {
+	sender, provider, ok := apiKeyOwner(ctx)
+	if !ok {
+		return
+	}
+
+	apiKeys, err := ctrl.apiKeyService.ListAPIKeys(ctx, sender, provider)
+	if err != nil {
+		logger.Errorf("Failed to fetch API keys: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch API keys", nil)
+		return
+	}
+
+	primary := u.PrimaryAPIKey(apiKeys)
+	response := make([]types.APIKeyDetailsResponse, 0, len(apiKeys))
+	for _, apiKey := range apiKeys {
+		response = append(response, apiKeyDetailsResponse(apiKey, primary, ""))
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "API keys fetched successfully", response)
+}
+
+// CreateAPIKey creates an API key limited to the given scopes. The secret is only returned once
+func (ctrl *ProfileController) CreateAPIKey(ctx *gin.Context) {
+	sender, provider, ok := apiKeyOwner(ctx)
+	if !ok {
+		return
+	}
+
+	var payload types.APIKeyPayload
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	apiKey, secret, err := ctrl.apiKeyService.CreateScopedAPIKey(ctx, sender, provider, payload)
+	if err != nil {
+		logger.Errorf("Failed to create API key: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to create API key", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusCreated, "success", "API key created successfully", apiKeyDetailsResponse(apiKey, nil, secret))
+}
+
+// RotateAPIKey replaces an API key with a new one. The old key keeps working for the rotation
+// overlap so clients can switch over. The secret is only returned once
+func (ctrl *ProfileController) RotateAPIKey(ctx *gin.Context) {
+	apiKey, ok := ownedAPIKey(ctx)
+	if !ok {
+		return
+	}
+
+	if u.APIKeyExpired(apiKey) {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "API key has expired", nil)
+		return
+	}
+
+	newKey, secret, err := ctrl.apiKeyService.RotateAPIKey(ctx, apiKey, config.AuthConfig().APIKeyRotationOverlap)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":    fmt.Sprintf("%v", err),
+			"APIKeyID": apiKey.ID,
+		}).Errorf("Failed to rotate API key")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to rotate API key", nil)
+		return
+	}
+
+	var primary *ent.APIKey
+	if len(newKey.Scopes) == 0 {
+		primary = newKey
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "API key rotated successfully", apiKeyDetailsResponse(newKey, primary, secret))
+}
+
+// RevokeAPIKey stops an API key from authenticating at once. The primary key can only be rotated
+func (ctrl *ProfileController) RevokeAPIKey(ctx *gin.Context) {
+	apiKey, ok := ownedAPIKey(ctx)
+	if !ok {
+		return
+	}
+
+	apiKeys, err := ctrl.apiKeyService.ListAPIKeys(ctx, apiKey.Edges.SenderProfile, apiKey.Edges.ProviderProfile)
+	if err != nil {
+		logger.Errorf("Failed to fetch API keys: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to revoke API key", nil)
+		return
+	}
+	if primary := u.PrimaryAPIKey(apiKeys); primary != nil && primary.ID == apiKey.ID {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "The primary API key can only be rotated", nil)
+		return
+	}
+
+	apiKey, err = ctrl.apiKeyService.RevokeAPIKey(ctx, apiKey)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":    fmt.Sprintf("%v", err),
+			"APIKeyID": apiKey.ID,
+		}).Errorf("Failed to revoke API key")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to revoke API key", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "API key revoked successfully", apiKeyDetailsResponse(apiKey, nil, ""))
+}
+
+// apiKeyOwner returns the profile whose API keys the request manages, from the sender or provider
+// settings route it was made to
+func apiKeyOwner(ctx *gin.Context) (*ent.SenderProfile, *ent.ProviderProfile, bool) {
+	if strings.Contains(ctx.FullPath(), "/settings/provider/") {
+		providerCtx, ok := ctx.Get("provider")
+		if !ok || providerCtx == nil {
+			u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+			return nil, nil, false
+		}
+		return nil, providerCtx.(*ent.ProviderProfile), true
+	}
+
+	senderCtx, ok := ctx.Get("sender")
+	if !ok || senderCtx == nil {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return nil, nil, false
+	}
+	return senderCtx.(*ent.SenderProfile), nil, true
+}
+
+// ownedAPIKey fetches the API key of the request, making sure it belongs to the profile
+func ownedAPIKey(ctx *gin.Context) (*ent.APIKey, bool) {
+	sender, provider, ok := apiKeyOwner(ctx)
+	if !ok {
+		return nil, false
+	}
+
+	apiKeyID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid API key ID", nil)
+		return nil, false
+	}
+
+	query := storage.Client.APIKey.
+		Query().
+		Where(apikey.IDEQ(apiKeyID))
+	if sender != nil {
+		query = query.Where(apikey.HasSenderProfileWith(senderprofile.IDEQ(sender.ID)))
+	} else {
+		query = query.Where(apikey.HasProviderProfileWith(providerprofile.IDEQ(provider.ID)))
+	}
+
+	apiKey, err := query.
+		WithSenderProfile().
+		WithProviderProfile().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "API key not found", nil)
+		} else {
+			logger.Errorf("Failed to fetch API key: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch API key", nil)
+		}
+		return nil, false
+	}
+
+	return apiKey, true
+}
+
+// apiKeyDetailsResponse builds the response for an API key
+func apiKeyDetailsResponse(apiKey *ent.APIKey, primary *ent.APIKey, secret string) types.APIKeyDetailsResponse {
+	scopes := apiKey.Scopes
+	if len(scopes) == 0 {
+		scopes = u.APIKeyScopes
+	}
+
+	return types.APIKeyDetailsResponse{
+		ID:         apiKey.ID,
+		Name:       apiKey.Name,
+		Scopes:     scopes,
+		RateLimit:  apiKey.RateLimit,
+		ExpiresAt:  apiKey.ExpiresAt,
+		LastUsedAt: apiKey.LastUsedAt,
+		CreatedAt:  apiKey.CreatedAt,
+		Primary:    primary != nil && primary.ID == apiKey.ID,
+		Secret:     secret,
+	}
+}
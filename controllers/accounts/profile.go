@@ -1,6 +1,7 @@
 package accounts
 
 import (
+	"encoding/base64"
 	"fmt"
 	"net/http"
 	"strings"
@@ -22,7 +23,9 @@ import (
 	"github.com/NEDA-LABS/stablenode/storage"
 	"github.com/NEDA-LABS/stablenode/types"
 	u "github.com/NEDA-LABS/stablenode/utils"
+	cryptoUtils "github.com/NEDA-LABS/stablenode/utils/crypto"
 	"github.com/NEDA-LABS/stablenode/utils/logger"
+	tokenUtils "github.com/NEDA-LABS/stablenode/utils/token"
 	"github.com/shopspring/decimal"
 
 	"github.com/gin-gonic/gin"
@@ -87,6 +90,10 @@ func (ctrl *ProfileController) UpdateSenderProfile(ctx *gin.Context) {
 		update.SetDomainWhitelist(payload.DomainWhitelist)
 	}
 
+	if payload.OverpaymentMode != "" {
+		update.SetOverpaymentMode(senderprofile.OverpaymentMode(payload.OverpaymentMode))
+	}
+
 	hasConfiguredToken := false
 
 	for _, tokenPayload := range payload.Tokens {
@@ -624,6 +631,128 @@ func (ctrl *ProfileController) UpdateProviderProfile(ctx *gin.Context) {
 	u.APIResponse(ctx, http.StatusOK, "success", "Profile updated successfully", nil)
 }
 
+// RotateWebhookSecret generates a new secret for signing the sender's webhook notifications.
+// The secret is only returned once
+func (ctrl *ProfileController) RotateWebhookSecret(ctx *gin.Context) {
+	// Get sender profile from the context
+	senderCtx, ok := ctx.Get("sender")
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+	sender := senderCtx.(*ent.SenderProfile)
+
+	secret, err := tokenUtils.GeneratePrivateKey()
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":    fmt.Sprintf("%v", err),
+			"SenderID": sender.ID,
+		}).Errorf("Failed to generate webhook secret")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to rotate webhook secret", nil)
+		return
+	}
+
+	encryptedSecret, err := cryptoUtils.EncryptPlain([]byte(secret))
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":    fmt.Sprintf("%v", err),
+			"SenderID": sender.ID,
+		}).Errorf("Failed to encrypt webhook secret")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to rotate webhook secret", nil)
+		return
+	}
+
+	_, err = sender.Update().
+		SetWebhookSecret(base64.StdEncoding.EncodeToString(encryptedSecret)).
+		Save(ctx)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":    fmt.Sprintf("%v", err),
+			"SenderID": sender.ID,
+		}).Errorf("Failed to save webhook secret")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to rotate webhook secret", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Webhook secret rotated successfully", &types.WebhookSecretResponse{
+		Secret: secret,
+	})
+}
+
+// EnableRequestSigning generates a new secret the sender must sign its API key requests with.
+// The secret is only returned once
+func (ctrl *ProfileController) EnableRequestSigning(ctx *gin.Context) {
+	// Get sender profile from the context
+	senderCtx, ok := ctx.Get("sender")
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+	sender := senderCtx.(*ent.SenderProfile)
+
+	secret, err := tokenUtils.GeneratePrivateKey()
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":    fmt.Sprintf("%v", err),
+			"SenderID": sender.ID,
+		}).Errorf("Failed to generate request signing secret")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to enable request signing", nil)
+		return
+	}
+
+	encryptedSecret, err := cryptoUtils.EncryptPlain([]byte(secret))
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":    fmt.Sprintf("%v", err),
+			"SenderID": sender.ID,
+		}).Errorf("Failed to encrypt request signing secret")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to enable request signing", nil)
+		return
+	}
+
+	_, err = sender.Update().
+		SetRequestSigningSecret(base64.StdEncoding.EncodeToString(encryptedSecret)).
+		Save(ctx)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":    fmt.Sprintf("%v", err),
+			"SenderID": sender.ID,
+		}).Errorf("Failed to save request signing secret")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to enable request signing", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Request signing enabled successfully", &types.RequestSigningSecretResponse{
+		Secret: secret,
+		MaxAge: int64(config.AuthConfig().RequestSignatureMaxAge.Seconds()),
+	})
+}
+
+// DisableRequestSigning stops requiring the sender to sign its API key requests
+func (ctrl *ProfileController) DisableRequestSigning(ctx *gin.Context) {
+	// Get sender profile from the context
+	senderCtx, ok := ctx.Get("sender")
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+	sender := senderCtx.(*ent.SenderProfile)
+
+	_, err := sender.Update().
+		ClearRequestSigningSecret().
+		Save(ctx)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":    fmt.Sprintf("%v", err),
+			"SenderID": sender.ID,
+		}).Errorf("Failed to clear request signing secret")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to disable request signing", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Request signing disabled successfully", nil)
+}
+
 // GetSenderProfile retrieves the sender profile
 func (ctrl *ProfileController) GetSenderProfile(ctx *gin.Context) {
 	// Get sender profile from the context
@@ -701,11 +830,13 @@ func (ctrl *ProfileController) GetSenderProfile(ctx *gin.Context) {
 		Email:                 user.Email,
 		WebhookURL:            sender.WebhookURL,
 		DomainWhitelist:       sender.DomainWhitelist,
+		OverpaymentMode:       string(sender.OverpaymentMode),
 		Tokens:                tokensPayload,
 		APIKey:                *apiKey,
 		IsActive:              sender.IsActive,
 		KYBVerificationStatus: user.KybVerificationStatus,
 		KYBRejectionComment:   kybRejectionComment,
+		RequestSigningEnabled: sender.RequestSigningSecret != "",
 	}
 
 	linkedProvider, err := storage.Client.ProviderProfile.
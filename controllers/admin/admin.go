@@ -0,0 +1,2911 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/adminauditlog"
+	"github.com/NEDA-LABS/stablenode/ent/blockchainroute"
+	"github.com/NEDA-LABS/stablenode/ent/deadletter"
+	"github.com/NEDA-LABS/stablenode/ent/denylistedaddress"
+	"github.com/NEDA-LABS/stablenode/ent/depositsplit"
+	"github.com/NEDA-LABS/stablenode/ent/feeschedule"
+	"github.com/NEDA-LABS/stablenode/ent/lockorderreassignment"
+	"github.com/NEDA-LABS/stablenode/ent/lockpaymentorder"
+	networkEnt "github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/NEDA-LABS/stablenode/ent/reconciliationdiscrepancy"
+	"github.com/NEDA-LABS/stablenode/ent/reconciliationreport"
+	"github.com/NEDA-LABS/stablenode/ent/rpcendpoint"
+	"github.com/NEDA-LABS/stablenode/ent/senderprofile"
+	"github.com/NEDA-LABS/stablenode/ent/sweep"
+	tokenEnt "github.com/NEDA-LABS/stablenode/ent/token"
+	"github.com/NEDA-LABS/stablenode/ent/unmatcheddeposit"
+	"github.com/NEDA-LABS/stablenode/ent/webhookdestination"
+	"github.com/NEDA-LABS/stablenode/ent/webhookretryattempt"
+	"github.com/NEDA-LABS/stablenode/services"
+	"github.com/NEDA-LABS/stablenode/services/common"
+	"github.com/NEDA-LABS/stablenode/services/compliance"
+	orderService "github.com/NEDA-LABS/stablenode/services/order"
+	"github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+	u "github.com/NEDA-LABS/stablenode/utils"
+	"github.com/NEDA-LABS/stablenode/utils/breaker"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/NEDA-LABS/stablenode/utils/ratelimit"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// AdminController is a controller type for operator endpoints
+type AdminController struct{}
+
+// NewAdminController creates a new instance of AdminController
+func NewAdminController() *AdminController {
+	return &AdminController{}
+}
+
+// GetPoolStatus controller returns the receive address pool inventory per network
+func (ctrl *AdminController) GetPoolStatus(ctx *gin.Context) {
+	network := ctx.Query("network")
+
+	status, err := storage.PoolStatus(ctx, network)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":   err.Error(),
+			"Network": network,
+		}).Errorf("Failed to fetch pool status")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch pool status", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Pool status fetched successfully", status)
+}
+
+// GetRPCRateLimits controller returns the outbound RPC rate limiting metrics per provider
+func (ctrl *AdminController) GetRPCRateLimits(ctx *gin.Context) {
+	u.APIResponse(ctx, http.StatusOK, "success", "RPC rate limits fetched successfully", ratelimit.Default().Stats())
+}
+
+// GetCircuitBreakers controller returns the circuit breaker state of every external service host
+func (ctrl *AdminController) GetCircuitBreakers(ctx *gin.Context) {
+	u.APIResponse(ctx, http.StatusOK, "success", "Circuit breakers fetched successfully", breaker.Default().Stats())
+}
+
+// GetOrphanStats controller returns the orphaned row counts of the latest garbage collection run
+func (ctrl *AdminController) GetOrphanStats(ctx *gin.Context) {
+	stats := common.LastOrphanStats()
+	if stats == nil {
+		u.APIResponse(ctx, http.StatusNotFound, "error", "Garbage collection has not run yet", nil)
+		return
+	}
+	u.APIResponse(ctx, http.StatusOK, "success", "Orphan stats fetched successfully", stats)
+}
+
+// GetStats controller returns the volume, order counts, average settlement time, success rate and
+// fees of the payment orders created over a date range, in total and grouped by day, network or
+// token. The range defaults to the last 30 days
+func (ctrl *AdminController) GetStats(ctx *gin.Context) {
+	groupBy := ctx.DefaultQuery("groupBy", common.StatsGroupByDay)
+	switch groupBy {
+	case common.StatsGroupByDay, common.StatsGroupByNetwork, common.StatsGroupByToken:
+	default:
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid groupBy",
+			fmt.Sprintf("groupBy must be one of %s, %s and %s", common.StatsGroupByDay, common.StatsGroupByNetwork, common.StatsGroupByToken))
+		return
+	}
+
+	from, to, ok := statsRange(ctx)
+	if !ok {
+		return
+	}
+
+	stats, err := common.AdminStats(ctx, from, to, groupBy)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":   err.Error(),
+			"GroupBy": groupBy,
+		}).Errorf("Failed to aggregate stats")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch stats", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Stats fetched successfully", stats)
+}
+
+// GetGasSpend controller returns the gas charged for the mined UserOperations sent over a date range,
+// split into what the gas policy sponsored and what the senders paid, per network and grouped by
+// payment order, network, purpose or gas policy. The range defaults to the last 30 days
+func (ctrl *AdminController) GetGasSpend(ctx *gin.Context) {
+	groupBy := ctx.DefaultQuery("groupBy", common.GasSpendGroupByNetwork)
+	switch groupBy {
+	case common.GasSpendGroupByOrder, common.GasSpendGroupByNetwork, common.GasSpendGroupByPurpose, common.GasSpendGroupByPolicy:
+	default:
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid groupBy",
+			fmt.Sprintf("groupBy must be one of %s, %s, %s and %s", common.GasSpendGroupByOrder, common.GasSpendGroupByNetwork, common.GasSpendGroupByPurpose, common.GasSpendGroupByPolicy))
+		return
+	}
+
+	from, to, ok := statsRange(ctx)
+	if !ok {
+		return
+	}
+
+	spend, err := common.GasSpend(ctx, from, to, groupBy)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":   err.Error(),
+			"GroupBy": groupBy,
+		}).Errorf("Failed to aggregate gas spend")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch gas spend", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Gas spend fetched successfully", spend)
+}
+
+// statsRange parses the from and to query parameters of a report, defaulting to the last 30 days,
+// and responds with an error when they are invalid
+func statsRange(ctx *gin.Context) (time.Time, time.Time, bool) {
+	// Default bounds are truncated to the minute so repeated requests share the cache
+	to := time.Now().UTC().Truncate(time.Minute)
+	if value := ctx.Query("to"); value != "" {
+		parsed, err := u.ParseTimeBound(value, true)
+		if err != nil {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid to", "to must be an RFC 3339 time or a date")
+			return time.Time{}, time.Time{}, false
+		}
+		to = parsed
+	}
+	from := to.AddDate(0, 0, -30)
+	if value := ctx.Query("from"); value != "" {
+		parsed, err := u.ParseTimeBound(value, false)
+		if err != nil {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid from", "from must be an RFC 3339 time or a date")
+			return time.Time{}, time.Time{}, false
+		}
+		from = parsed
+	}
+
+	if !from.Before(to) || to.Sub(from) > common.StatsMaxRange {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid date range",
+			fmt.Sprintf("from must be before to, at most %d days apart", int(common.StatsMaxRange.Hours()/24)))
+		return time.Time{}, time.Time{}, false
+	}
+	return from, to, true
+}
+
+// ListDepositSplits controller returns deposit splits, pending ones by default
+func (ctrl *AdminController) ListDepositSplits(ctx *gin.Context) {
+	status := depositsplit.Status(ctx.DefaultQuery("status", string(depositsplit.StatusPending)))
+	if err := depositsplit.StatusValidator(status); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid status", nil)
+		return
+	}
+
+	splits, err := storage.Client.DepositSplit.
+		Query().
+		Where(depositsplit.StatusEQ(status)).
+		WithPaymentOrders().
+		Order(ent.Asc(depositsplit.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":  err.Error(),
+			"Status": status,
+		}).Errorf("Failed to fetch deposit splits")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch deposit splits", nil)
+		return
+	}
+
+	response := make([]types.DepositSplitResponse, 0, len(splits))
+	for _, split := range splits {
+		response = append(response, depositSplitResponse(ctx, split))
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Deposit splits fetched successfully", response)
+}
+
+// ConfirmDepositSplit controller allocates a split deposit across its orders
+func (ctrl *AdminController) ConfirmDepositSplit(ctx *gin.Context) {
+	ctrl.resolveDepositSplit(ctx, func(splitID uuid.UUID) (*ent.DepositSplit, error) {
+		return common.ConfirmDepositSplit(ctx, splitID, orderService.NewOrderEVM().CreateOrder, services.NewPriorityQueueService().GetProviderRate)
+	})
+}
+
+// RejectDepositSplit controller rejects a split and credits the deposit to the oldest order
+func (ctrl *AdminController) RejectDepositSplit(ctx *gin.Context) {
+	ctrl.resolveDepositSplit(ctx, func(splitID uuid.UUID) (*ent.DepositSplit, error) {
+		return common.RejectDepositSplit(ctx, splitID, orderService.NewOrderEVM().CreateOrder, services.NewPriorityQueueService().GetProviderRate)
+	})
+}
+
+// resolveDepositSplit parses the split ID and maps resolution errors to responses
+func (ctrl *AdminController) resolveDepositSplit(ctx *gin.Context, resolve func(splitID uuid.UUID) (*ent.DepositSplit, error)) {
+	splitID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid deposit split ID", nil)
+		return
+	}
+
+	split, err := resolve(splitID)
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Deposit split not found", nil)
+			return
+		case errors.Is(err, common.ErrDepositSplitResolved):
+			u.APIResponse(ctx, http.StatusConflict, "error", "Deposit split already resolved", nil)
+			return
+		}
+
+		logger.WithFields(logger.Fields{
+			"Error":   err.Error(),
+			"SplitID": splitID,
+		}).Errorf("Failed to resolve deposit split")
+
+		if split == nil {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", err.Error(), nil)
+			return
+		}
+	}
+
+	split, err = storage.Client.DepositSplit.
+		Query().
+		Where(depositsplit.IDEQ(split.ID)).
+		WithPaymentOrders().
+		Only(ctx)
+	if err != nil {
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch deposit split", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Deposit split resolved successfully", depositSplitResponse(ctx, split))
+}
+
+// depositSplitResponse builds the response for a deposit split loaded with its orders
+func depositSplitResponse(ctx context.Context, split *ent.DepositSplit) types.DepositSplitResponse {
+	orderIDs := make([]uuid.UUID, 0, len(split.Edges.PaymentOrders))
+	for _, order := range split.Edges.PaymentOrders {
+		orderIDs = append(orderIDs, order.ID)
+	}
+
+	var resolvedAt *time.Time
+	if !split.ResolvedAt.IsZero() {
+		resolvedAt = &split.ResolvedAt
+	}
+
+	var fromAddressName string
+	network, err := storage.Client.Network.
+		Query().
+		Where(networkEnt.IdentifierEQ(split.Network)).
+		Only(ctx)
+	if err == nil {
+		fromAddressName = services.NewENSService().DisplayName(ctx, network, split.FromAddress)
+	}
+
+	return types.DepositSplitResponse{
+		ID:              split.ID,
+		TxHash:          split.TxHash,
+		Network:         split.Network,
+		ReceiveAddress:  split.ReceiveAddress,
+		FromAddress:     split.FromAddress,
+		FromAddressName: fromAddressName,
+		Amount:          split.Amount,
+		Status:          string(split.Status),
+		OrderIDs:        orderIDs,
+		CreatedAt:       split.CreatedAt,
+		ResolvedAt:      resolvedAt,
+	}
+}
+
+// MigrateReceiveAddress controller moves an unpaid order to a fresh receive address and notifies
+// the sender of the new payment address
+func (ctrl *AdminController) MigrateReceiveAddress(ctx *gin.Context) {
+	orderID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid order ID", nil)
+		return
+	}
+
+	var payload types.MigrateReceiveAddressPayload
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	order, previousAddress, err := common.MigrateReceiveAddress(ctx, orderID, payload.Reason, payload.RetireAddress)
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Payment order not found", nil)
+			return
+		case errors.Is(err, common.ErrOrderNotMigratable):
+			u.APIResponse(ctx, http.StatusConflict, "error", "Only unpaid initiated orders can be moved", nil)
+			return
+		}
+
+		logger.WithFields(logger.Fields{
+			"Error":   err.Error(),
+			"OrderID": orderID,
+		}).Errorf("Failed to migrate receive address")
+
+		// A returned order was moved; only the webhook update or sender notification failed
+		if order == nil {
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to migrate receive address", nil)
+			return
+		}
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Receive address migrated successfully", types.ReceiveAddressMigrationResponse{
+		OrderID:                order.ID,
+		Network:                order.Edges.Token.Edges.Network.Identifier,
+		PreviousReceiveAddress: previousAddress,
+		ReceiveAddress:         order.ReceiveAddressText,
+		ValidUntil:             order.Edges.ReceiveAddress.ValidUntil,
+	})
+}
+
+// ListSweeps controller returns sweeps, those awaiting an offline signature by default
+func (ctrl *AdminController) ListSweeps(ctx *gin.Context) {
+	status := sweep.Status(ctx.DefaultQuery("status", string(sweep.StatusAwaitingSignature)))
+	if err := sweep.StatusValidator(status); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid status", nil)
+		return
+	}
+
+	sweeps, err := storage.Client.Sweep.
+		Query().
+		Where(sweep.StatusEQ(status)).
+		Order(ent.Asc(sweep.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":  err.Error(),
+			"Status": status,
+		}).Errorf("Failed to fetch sweeps")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch sweeps", nil)
+		return
+	}
+
+	response := make([]types.SweepResponse, 0, len(sweeps))
+	for _, sweepEntity := range sweeps {
+		response = append(response, sweepResponse(sweepEntity))
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Sweeps fetched successfully", response)
+}
+
+// CreateSweep controller sweeps tokens out of a receive address. Sweeps above the offline signing
+// threshold are prepared for the air-gapped signer instead of being sent
+func (ctrl *AdminController) CreateSweep(ctx *gin.Context) {
+	var payload types.CreateSweepPayload
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	if !u.IsValidEthereumAddress(payload.FromAddress) || !u.IsValidEthereumAddress(payload.ToAddress) {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid address", nil)
+		return
+	}
+
+	if !payload.Amount.IsPositive() {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Amount must be greater than zero", nil)
+		return
+	}
+
+	token, err := storage.Client.Token.
+		Query().
+		Where(
+			tokenEnt.SymbolEQ(payload.Token),
+			tokenEnt.HasNetworkWith(networkEnt.IdentifierEQ(payload.Network)),
+		).
+		WithNetwork().
+		Only(ctx)
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Token not supported on network", nil)
+		return
+	}
+
+	sweepEntity, err := services.NewSweepService().CreateSweep(ctx, token, payload.FromAddress, payload.ToAddress, payload.Amount)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":   err.Error(),
+			"From":    payload.FromAddress,
+			"Network": payload.Network,
+		}).Errorf("Failed to create sweep")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to create sweep", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusCreated, "success", "Sweep created successfully", sweepResponse(sweepEntity))
+}
+
+// ExportSweep controller downloads the unsigned sweep for the air-gapped signer, as a JSON file or,
+// with format=qr, as compact JSON to render in a QR code
+func (ctrl *AdminController) ExportSweep(ctx *gin.Context) {
+	sweepID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid sweep ID", nil)
+		return
+	}
+
+	sweepEntity, err := storage.Client.Sweep.Get(ctx, sweepID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Sweep not found", nil)
+			return
+		}
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch sweep", nil)
+		return
+	}
+
+	export, err := services.NewSweepService().ExportSweep(sweepEntity)
+	if err != nil {
+		u.APIResponse(ctx, http.StatusConflict, "error", "Sweep is not awaiting a signature", nil)
+		return
+	}
+
+	data, err := json.Marshal(export)
+	if err != nil {
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to export sweep", nil)
+		return
+	}
+
+	if ctx.Query("format") == "qr" {
+		ctx.Data(http.StatusOK, "text/plain; charset=utf-8", data)
+		return
+	}
+
+	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=sweep-%s.json", sweepEntity.ID))
+	ctx.Data(http.StatusOK, "application/json", data)
+}
+
+// SubmitSweepSignature controller imports the offline signature of a sweep and submits it
+func (ctrl *AdminController) SubmitSweepSignature(ctx *gin.Context) {
+	sweepID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid sweep ID", nil)
+		return
+	}
+
+	var payload types.SubmitSweepSignaturePayload
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	sweepEntity, err := services.NewSweepService().SubmitSweepSignature(ctx, sweepID, payload.Signature)
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Sweep not found", nil)
+			return
+		case errors.Is(err, services.ErrSweepNotAwaitingSignature):
+			u.APIResponse(ctx, http.StatusConflict, "error", "Sweep is not awaiting a signature", nil)
+			return
+		}
+
+		logger.WithFields(logger.Fields{
+			"Error":   err.Error(),
+			"SweepID": sweepID,
+		}).Errorf("Failed to submit sweep signature")
+		u.APIResponse(ctx, http.StatusBadRequest, "error", err.Error(), nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Sweep submitted successfully", sweepResponse(sweepEntity))
+}
+
+// sweepResponse builds the response for a sweep
+func sweepResponse(sweepEntity *ent.Sweep) types.SweepResponse {
+	var submittedAt *time.Time
+	if !sweepEntity.SubmittedAt.IsZero() {
+		submittedAt = &sweepEntity.SubmittedAt
+	}
+
+	return types.SweepResponse{
+		ID:           sweepEntity.ID,
+		Network:      sweepEntity.Network,
+		TokenAddress: sweepEntity.TokenAddress,
+		FromAddress:  sweepEntity.FromAddress,
+		ToAddress:    sweepEntity.ToAddress,
+		Amount:       sweepEntity.Amount,
+		Status:       string(sweepEntity.Status),
+		UserOpHash:   sweepEntity.UserOpHash,
+		TxHash:       sweepEntity.TxHash,
+		CreatedAt:    sweepEntity.CreatedAt,
+		SubmittedAt:  submittedAt,
+	}
+}
+
+// ListWebhookDestinations controller returns outbound webhook destinations with their retry policy and
+// delivery stats; status=failing or status=disabled narrows the list
+func (ctrl *AdminController) ListWebhookDestinations(ctx *gin.Context) {
+	query := storage.Client.WebhookDestination.Query()
+
+	switch ctx.Query("status") {
+	case "":
+	case "failing":
+		query = query.Where(webhookdestination.FailingSinceNotNil(), webhookdestination.DisabledAtIsNil())
+	case "disabled":
+		query = query.Where(webhookdestination.DisabledAtNotNil())
+	default:
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid status", nil)
+		return
+	}
+
+	destinations, err := query.
+		Order(ent.Asc(webhookdestination.FieldURL)).
+		All(ctx)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error": err.Error(),
+		}).Errorf("Failed to fetch webhook destinations")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch webhook destinations", nil)
+		return
+	}
+
+	response := make([]types.WebhookDestinationResponse, 0, len(destinations))
+	for _, destination := range destinations {
+		response = append(response, webhookDestinationResponse(destination))
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Webhook destinations fetched successfully", response)
+}
+
+// UpdateWebhookDestination controller overrides the retry policy of a webhook destination and
+// re-enables or disables it
+func (ctrl *AdminController) UpdateWebhookDestination(ctx *gin.Context) {
+	destinationID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid webhook destination ID", nil)
+		return
+	}
+
+	var payload types.UpdateWebhookDestinationPayload
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	update := storage.Client.WebhookDestination.UpdateOneID(destinationID)
+
+	// Zero clears an override so the configured default applies again
+	if payload.MaxAttempts != nil {
+		if *payload.MaxAttempts == 0 {
+			update.ClearMaxAttempts()
+		} else {
+			update.SetMaxAttempts(*payload.MaxAttempts)
+		}
+	}
+	if payload.BackoffSeconds != nil {
+		if *payload.BackoffSeconds == 0 {
+			update.ClearBackoffSeconds()
+		} else {
+			update.SetBackoffSeconds(*payload.BackoffSeconds)
+		}
+	}
+	if payload.BackoffMultiplier != nil {
+		if *payload.BackoffMultiplier == 0 {
+			update.ClearBackoffMultiplier()
+		} else {
+			update.SetBackoffMultiplier(*payload.BackoffMultiplier)
+		}
+	}
+	if payload.TimeoutSeconds != nil {
+		if *payload.TimeoutSeconds == 0 {
+			update.ClearTimeoutSeconds()
+		} else {
+			update.SetTimeoutSeconds(*payload.TimeoutSeconds)
+		}
+	}
+	if payload.Enabled != nil {
+		if *payload.Enabled {
+			// A re-enabled destination gets a fresh window before it can be disabled again
+			update.ClearDisabledAt().ClearFailingSince()
+		} else {
+			update.SetDisabledAt(time.Now())
+		}
+	}
+
+	destination, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Webhook destination not found", nil)
+			return
+		}
+		logger.WithFields(logger.Fields{
+			"Error":         err.Error(),
+			"DestinationID": destinationID,
+		}).Errorf("Failed to update webhook destination")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update webhook destination", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Webhook destination updated successfully", webhookDestinationResponse(destination))
+}
+
+// webhookDestinationResponse builds the response for a webhook destination with its effective policy
+func webhookDestinationResponse(destination *ent.WebhookDestination) types.WebhookDestinationResponse {
+	policy := u.WebhookPolicy(destination)
+
+	optionalTime := func(t time.Time) *time.Time {
+		if t.IsZero() {
+			return nil
+		}
+		return &t
+	}
+
+	return types.WebhookDestinationResponse{
+		ID:                destination.ID,
+		URL:               destination.URL,
+		MaxAttempts:       policy.MaxAttempts,
+		BackoffSeconds:    int(policy.Backoff / time.Second),
+		BackoffMultiplier: policy.BackoffMultiplier,
+		TimeoutSeconds:    int(policy.Timeout / time.Second),
+		DeliveredCount:    destination.DeliveredCount,
+		FailedCount:       destination.FailedCount,
+		LastDeliveredAt:   optionalTime(destination.LastDeliveredAt),
+		LastFailedAt:      optionalTime(destination.LastFailedAt),
+		FailingSince:      destination.FailingSince,
+		Enabled:           destination.DisabledAt == nil,
+		DisabledAt:        destination.DisabledAt,
+	}
+}
+
+// ListWebhookAttempts controller returns failed outbound webhook notifications, most recent first,
+// filtered by status, destination, event and creation time
+func (ctrl *AdminController) ListWebhookAttempts(ctx *gin.Context) {
+	var filter types.WebhookAttemptFilter
+	if err := ctx.ShouldBindQuery(&filter); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate query", u.GetErrorData(err))
+		return
+	}
+
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "100"))
+	if err != nil || limit < 1 || limit > 500 {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid limit", nil)
+		return
+	}
+
+	attempts, err := storage.Client.WebhookRetryAttempt.
+		Query().
+		Where(webhookAttemptPredicates(filter)...).
+		Order(ent.Desc(webhookretryattempt.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error": err.Error(),
+		}).Errorf("Failed to fetch webhook attempts")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch webhook attempts", nil)
+		return
+	}
+
+	response := make([]types.WebhookAttemptResponse, 0, len(attempts))
+	for _, attempt := range attempts {
+		response = append(response, webhookAttemptResponse(attempt))
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Webhook attempts fetched successfully", response)
+}
+
+// RetryWebhookAttempts controller reschedules every failed webhook notification matching the filter
+// for immediate delivery, e.g. once a destination has recovered from an outage. Expired notifications
+// get one more attempt; notifications to disabled destinations are only sent once they're re-enabled
+func (ctrl *AdminController) RetryWebhookAttempts(ctx *gin.Context) {
+	var filter types.WebhookAttemptFilter
+	if err := ctx.ShouldBindJSON(&filter); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	retried, err := storage.Client.WebhookRetryAttempt.
+		Update().
+		Where(webhookAttemptPredicates(filter)...).
+		SetStatus(webhookretryattempt.StatusFailed).
+		SetNextRetryTime(time.Now()).
+		Save(ctx)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":  err.Error(),
+			"Filter": filter,
+		}).Errorf("Failed to retry webhook attempts")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to retry webhook attempts", nil)
+		return
+	}
+
+	logger.WithFields(logger.Fields{
+		"Filter":  filter,
+		"Retried": retried,
+	}).Infof("Webhook notifications rescheduled for retry")
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Webhook attempts scheduled for retry", types.RetryWebhookAttemptsResponse{
+		Retried: retried,
+	})
+}
+
+// webhookAttemptPredicates builds the query predicates of a webhook attempt filter
+func webhookAttemptPredicates(filter types.WebhookAttemptFilter) []predicate.WebhookRetryAttempt {
+	predicates := []predicate.WebhookRetryAttempt{
+		webhookretryattempt.StatusIn(webhookretryattempt.StatusFailed, webhookretryattempt.StatusExpired),
+	}
+	if filter.Status != "" {
+		predicates[0] = webhookretryattempt.StatusEQ(webhookretryattempt.Status(filter.Status))
+	}
+	if filter.WebhookURL != "" {
+		predicates = append(predicates, webhookretryattempt.WebhookURLEQ(filter.WebhookURL))
+	}
+	if filter.Event != "" {
+		predicates = append(predicates, predicate.WebhookRetryAttempt(func(s *sql.Selector) {
+			s.Where(sqljson.ValueEQ(webhookretryattempt.FieldPayload, filter.Event, sqljson.Path("event")))
+		}))
+	}
+	if filter.Since != nil {
+		predicates = append(predicates, webhookretryattempt.CreatedAtGTE(*filter.Since))
+	}
+	if filter.Until != nil {
+		predicates = append(predicates, webhookretryattempt.CreatedAtLTE(*filter.Until))
+	}
+	return predicates
+}
+
+// webhookAttemptResponse builds the response for a failed webhook notification
+func webhookAttemptResponse(attempt *ent.WebhookRetryAttempt) types.WebhookAttemptResponse {
+	event, _ := attempt.Payload["event"].(string)
+
+	var orderID string
+	if data, ok := attempt.Payload["data"].(map[string]interface{}); ok {
+		orderID, _ = data["id"].(string)
+	}
+
+	return types.WebhookAttemptResponse{
+		ID:            attempt.ID,
+		WebhookURL:    attempt.WebhookURL,
+		Event:         event,
+		OrderID:       orderID,
+		AttemptNumber: attempt.AttemptNumber,
+		Status:        string(attempt.Status),
+		NextRetryTime: attempt.NextRetryTime,
+		CreatedAt:     attempt.CreatedAt,
+		UpdatedAt:     attempt.UpdatedAt,
+	}
+}
+
+// ListPaymentOrders controller returns payment orders, most recent first, filtered by status,
+// network and age, along with the state of their lock orders
+func (ctrl *AdminController) ListPaymentOrders(ctx *gin.Context) {
+	var filter types.AdminPaymentOrderFilter
+	if err := ctx.ShouldBindQuery(&filter); err != nil {
+		// Ages that don't parse as durations aren't validation errors
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid filter", err.Error())
+		return
+	}
+
+	page, offset, pageSize := u.Paginate(ctx)
+
+	query := storage.Client.PaymentOrder.Query()
+	if filter.Status != "" {
+		status := paymentorder.Status(filter.Status)
+		if err := paymentorder.StatusValidator(status); err != nil {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid status", nil)
+			return
+		}
+		query = query.Where(paymentorder.StatusEQ(status))
+	}
+	if filter.Network != "" {
+		query = query.Where(paymentorder.HasTokenWith(
+			tokenEnt.HasNetworkWith(networkEnt.IdentifierEQ(filter.Network)),
+		))
+	}
+	if filter.MinAge > 0 {
+		query = query.Where(paymentorder.CreatedAtLTE(time.Now().Add(-filter.MinAge)))
+	}
+	if filter.MaxAge > 0 {
+		query = query.Where(paymentorder.CreatedAtGTE(time.Now().Add(-filter.MaxAge)))
+	}
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":  err.Error(),
+			"Filter": filter,
+		}).Errorf("Failed to count payment orders")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch payment orders", nil)
+		return
+	}
+
+	orders, err := query.
+		WithToken(func(tq *ent.TokenQuery) {
+			tq.WithNetwork()
+		}).
+		Order(ent.Desc(paymentorder.FieldCreatedAt)).
+		Limit(pageSize).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":  err.Error(),
+			"Filter": filter,
+		}).Errorf("Failed to fetch payment orders")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch payment orders", nil)
+		return
+	}
+
+	// Lock orders share the gateway ID of the on-chain order created for a payment order
+	gatewayIDs := make([]string, 0, len(orders))
+	for _, order := range orders {
+		if order.GatewayID != "" {
+			gatewayIDs = append(gatewayIDs, order.GatewayID)
+		}
+	}
+	lockOrders, err := storage.Client.LockPaymentOrder.
+		Query().
+		Where(lockpaymentorder.GatewayIDIn(gatewayIDs...)).
+		WithProvider().
+		WithReassignments(func(rq *ent.LockOrderReassignmentQuery) {
+			rq.Order(ent.Asc(lockorderreassignment.FieldCreatedAt))
+		}).
+		All(ctx)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error": err.Error(),
+		}).Errorf("Failed to fetch lock orders")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch payment orders", nil)
+		return
+	}
+	lockOrderByGatewayID := make(map[string]*ent.LockPaymentOrder, len(lockOrders))
+	for _, lockOrder := range lockOrders {
+		lockOrderByGatewayID[lockOrder.GatewayID] = lockOrder
+	}
+
+	response := make([]types.AdminPaymentOrderResponse, 0, len(orders))
+	for _, order := range orders {
+		response = append(response, adminPaymentOrderResponse(order, lockOrderByGatewayID[order.GatewayID]))
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Payment orders fetched successfully", types.AdminPaymentOrderList{
+		TotalRecords: count,
+		Page:         page,
+		PageSize:     pageSize,
+		Orders:       response,
+	})
+}
+
+// adminPaymentOrderResponse builds the admin response for a payment order and its lock order
+func adminPaymentOrderResponse(order *ent.PaymentOrder, lockOrder *ent.LockPaymentOrder) types.AdminPaymentOrderResponse {
+	response := types.AdminPaymentOrderResponse{
+		ID:               order.ID,
+		Status:           string(order.Status),
+		Network:          order.Edges.Token.Edges.Network.Identifier,
+		Token:            order.Edges.Token.Symbol,
+		Amount:           order.Amount,
+		AmountPaid:       order.AmountPaid,
+		GatewayID:        order.GatewayID,
+		TxHash:           order.TxHash,
+		ReceiveAddress:   order.ReceiveAddressText,
+		Reference:        order.Reference,
+		QuarantineReason: order.QuarantineReason,
+		CreatedAt:        order.CreatedAt,
+		UpdatedAt:        order.UpdatedAt,
+	}
+	if lockOrder != nil {
+		response.LockOrder = adminLockOrderResponse(lockOrder)
+	}
+	return response
+}
+
+// adminLockOrderResponse builds the admin response for the state of a lock order
+func adminLockOrderResponse(lockOrder *ent.LockPaymentOrder) *types.AdminLockOrderResponse {
+	response := &types.AdminLockOrderResponse{
+		ID:                lockOrder.ID,
+		Status:            string(lockOrder.Status),
+		CancellationCount: lockOrder.CancellationCount,
+		UpdatedAt:         lockOrder.UpdatedAt,
+	}
+	if lockOrder.Edges.Provider != nil {
+		response.ProviderID = lockOrder.Edges.Provider.ID
+	}
+	for _, reassignment := range lockOrder.Edges.Reassignments {
+		response.Reassignments = append(response.Reassignments, types.AdminLockOrderReassignment{
+			PreviousProviderID: reassignment.PreviousProviderID,
+			Reason:             reassignment.Reason,
+			CreatedAt:          reassignment.CreatedAt,
+		})
+	}
+	return response
+}
+
+// RefundPaymentOrder controller refunds the on-chain order of a payment order to its sender
+// without waiting for the refund timeout
+func (ctrl *AdminController) RefundPaymentOrder(ctx *gin.Context) {
+	orderID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid order ID", nil)
+		return
+	}
+
+	var payload types.AdminOrderActionPayload
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	lockOrder, err := common.ForceRefundOrder(ctx, orderID, common.AdminAction{
+		Actor:  payload.Actor,
+		Reason: payload.Reason,
+	})
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Payment order not found", nil)
+			return
+		case errors.Is(err, common.ErrOrderNotRefundable):
+			u.APIResponse(ctx, http.StatusConflict, "error", "Order has no pending on-chain order to refund", nil)
+			return
+		}
+
+		logger.WithFields(logger.Fields{
+			"Error":   err.Error(),
+			"OrderID": orderID,
+			"Actor":   payload.Actor,
+		}).Errorf("Failed to force refund order")
+
+		// A returned lock order was refunded; only the audit log entry failed
+		if lockOrder == nil {
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to refund order", nil)
+			return
+		}
+	}
+
+	u.APIResponse(ctx, http.StatusAccepted, "success", "Refund submitted", adminLockOrderResponse(lockOrder))
+}
+
+// ApproveQuarantinedOrder controller releases an order compliance screening quarantined
+func (ctrl *AdminController) ApproveQuarantinedOrder(ctx *gin.Context) {
+	ctrl.performQuarantineAction(ctx, "Order approved successfully", common.ApproveQuarantinedOrder)
+}
+
+// RefundQuarantinedOrder controller sends the deposit of an order compliance screening quarantined
+// back to the payer
+func (ctrl *AdminController) RefundQuarantinedOrder(ctx *gin.Context) {
+	ctrl.performQuarantineAction(ctx, "Refund submitted", common.RefundQuarantinedOrder)
+}
+
+// performQuarantineAction binds the payload of an operation on a quarantined order and performs it
+func (ctrl *AdminController) performQuarantineAction(ctx *gin.Context, message string, perform func(ctx context.Context, orderID uuid.UUID, action common.AdminAction) (*ent.PaymentOrder, error)) {
+	orderID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid order ID", nil)
+		return
+	}
+
+	var payload types.AdminOrderActionPayload
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	order, err := perform(ctx, orderID, common.AdminAction{
+		Actor:  payload.Actor,
+		Reason: payload.Reason,
+	})
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Payment order not found", nil)
+			return
+		case errors.Is(err, common.ErrOrderNotQuarantined):
+			u.APIResponse(ctx, http.StatusConflict, "error", "Order is not quarantined", nil)
+			return
+		case errors.Is(err, common.ErrOrderNotRefundable):
+			u.APIResponse(ctx, http.StatusConflict, "error", "Order was not paid to an EVM receive address and must be refunded manually", nil)
+			return
+		}
+
+		logger.WithFields(logger.Fields{
+			"Error":   err.Error(),
+			"OrderID": orderID,
+			"Actor":   payload.Actor,
+		}).Errorf("Failed to perform quarantined order action")
+
+		// A returned order was released; only the audit log entry failed
+		if order == nil {
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to release order", nil)
+			return
+		}
+	}
+
+	order, err = storage.Client.PaymentOrder.
+		Query().
+		Where(paymentorder.IDEQ(orderID)).
+		WithToken(func(tq *ent.TokenQuery) {
+			tq.WithNetwork()
+		}).
+		Only(ctx)
+	if err != nil {
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch order", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", message, adminPaymentOrderResponse(order, nil))
+}
+
+// ListDenylistedAddresses controller returns the addresses deposits are quarantined from
+func (ctrl *AdminController) ListDenylistedAddresses(ctx *gin.Context) {
+	entries, err := storage.Client.DenylistedAddress.
+		Query().
+		Order(ent.Desc(denylistedaddress.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error": err.Error(),
+		}).Errorf("Failed to fetch denylisted addresses")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch denylisted addresses", nil)
+		return
+	}
+
+	response := make([]types.DenylistedAddressResponse, 0, len(entries))
+	for _, entry := range entries {
+		response = append(response, denylistedAddressResponse(entry))
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Denylisted addresses fetched successfully", response)
+}
+
+// UpdateSenderLimits controller sets the order size, daily volume and hourly order count limits of
+// a sender
+func (ctrl *AdminController) UpdateSenderLimits(ctx *gin.Context) {
+	senderID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid sender ID", nil)
+		return
+	}
+
+	var payload types.SenderLimitsPayload
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	update := storage.Client.SenderProfile.UpdateOneID(senderID)
+
+	if (payload.MaxOrderAmount != nil && payload.MaxOrderAmount.IsNegative()) ||
+		(payload.DailyVolumeLimit != nil && payload.DailyVolumeLimit.IsNegative()) {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+			Field:   "Limits",
+			Message: "Limits must not be negative",
+		})
+		return
+	}
+
+	// Zero removes a limit
+	if payload.MaxOrderAmount != nil {
+		if payload.MaxOrderAmount.IsZero() {
+			update.ClearMaxOrderAmount()
+		} else {
+			update.SetMaxOrderAmount(*payload.MaxOrderAmount)
+		}
+	}
+	if payload.DailyVolumeLimit != nil {
+		if payload.DailyVolumeLimit.IsZero() {
+			update.ClearDailyVolumeLimit()
+		} else {
+			update.SetDailyVolumeLimit(*payload.DailyVolumeLimit)
+		}
+	}
+	if payload.HourlyOrderLimit != nil {
+		if *payload.HourlyOrderLimit == 0 {
+			update.ClearHourlyOrderLimit()
+		} else {
+			update.SetHourlyOrderLimit(*payload.HourlyOrderLimit)
+		}
+	}
+
+	sender, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Sender not found", nil)
+			return
+		}
+		logger.WithFields(logger.Fields{
+			"Error":    err.Error(),
+			"SenderID": senderID,
+		}).Errorf("Failed to update sender limits")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update sender limits", nil)
+		return
+	}
+
+	limits, err := common.SenderLimits(ctx, sender)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":    err.Error(),
+			"SenderID": senderID,
+		}).Errorf("Failed to fetch sender usage")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch sender limits", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Sender limits updated successfully", limits)
+}
+
+// GetDenylistedAddress controller returns a denylisted address
+func (ctrl *AdminController) GetDenylistedAddress(ctx *gin.Context) {
+	entryID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid denylisted address ID", nil)
+		return
+	}
+
+	entry, err := storage.Client.DenylistedAddress.Get(ctx, entryID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Denylisted address not found", nil)
+			return
+		}
+		logger.WithFields(logger.Fields{
+			"Error": err.Error(),
+			"ID":    entryID,
+		}).Errorf("Failed to fetch denylisted address")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch denylisted address", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Denylisted address fetched successfully", denylistedAddressResponse(entry))
+}
+
+// DenylistAddress controller quarantines deposits sent from an address from now on
+func (ctrl *AdminController) DenylistAddress(ctx *gin.Context) {
+	var payload types.DenylistedAddressPayload
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	entry, err := storage.Client.DenylistedAddress.
+		Create().
+		SetAddress(payload.Address).
+		SetNetwork(payload.Network).
+		SetReason(payload.Reason).
+		SetSource(payload.Source).
+		SetNillableExpiresAt(payload.ExpiresAt).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			u.APIResponse(ctx, http.StatusConflict, "error", "Address is already denylisted on this network", nil)
+			return
+		}
+		logger.WithFields(logger.Fields{
+			"Error":   err.Error(),
+			"Address": payload.Address,
+		}).Errorf("Failed to denylist address")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to denylist address", nil)
+		return
+	}
+
+	compliance.NotifyDenylistChanged(ctx)
+
+	u.APIResponse(ctx, http.StatusCreated, "success", "Address denylisted successfully", denylistedAddressResponse(entry))
+}
+
+// UpdateDenylistedAddress controller changes the network, reason, source or expiry of a
+// denylisted address
+func (ctrl *AdminController) UpdateDenylistedAddress(ctx *gin.Context) {
+	entryID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid denylisted address ID", nil)
+		return
+	}
+
+	var payload types.UpdateDenylistedAddressPayload
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	update := storage.Client.DenylistedAddress.UpdateOneID(entryID)
+	if payload.Network != nil {
+		update.SetNetwork(*payload.Network)
+	}
+	if payload.Reason != nil {
+		update.SetReason(*payload.Reason)
+	}
+	if payload.Source != nil {
+		update.SetSource(*payload.Source)
+	}
+	if payload.ClearExpiry {
+		update.ClearExpiresAt()
+	} else if payload.ExpiresAt != nil {
+		update.SetExpiresAt(*payload.ExpiresAt)
+	}
+
+	entry, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Denylisted address not found", nil)
+			return
+		}
+		if ent.IsConstraintError(err) {
+			u.APIResponse(ctx, http.StatusConflict, "error", "Address is already denylisted on this network", nil)
+			return
+		}
+		logger.WithFields(logger.Fields{
+			"Error": err.Error(),
+			"ID":    entryID,
+		}).Errorf("Failed to update denylisted address")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update denylisted address", nil)
+		return
+	}
+
+	compliance.NotifyDenylistChanged(ctx)
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Denylisted address updated successfully", denylistedAddressResponse(entry))
+}
+
+// RemoveDenylistedAddress controller stops quarantining deposits sent from an address
+func (ctrl *AdminController) RemoveDenylistedAddress(ctx *gin.Context) {
+	entryID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid denylisted address ID", nil)
+		return
+	}
+
+	if err := storage.Client.DenylistedAddress.DeleteOneID(entryID).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Denylisted address not found", nil)
+			return
+		}
+		logger.WithFields(logger.Fields{
+			"Error": err.Error(),
+			"ID":    entryID,
+		}).Errorf("Failed to remove denylisted address")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to remove denylisted address", nil)
+		return
+	}
+
+	compliance.NotifyDenylistChanged(ctx)
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Denylisted address removed successfully", nil)
+}
+
+// denylistedAddressResponse builds the response for a denylisted address
+func denylistedAddressResponse(entry *ent.DenylistedAddress) types.DenylistedAddressResponse {
+	return types.DenylistedAddressResponse{
+		ID:        entry.ID,
+		Address:   entry.Address,
+		Network:   entry.Network,
+		Reason:    entry.Reason,
+		Source:    entry.Source,
+		ExpiresAt: entry.ExpiresAt,
+		CreatedAt: entry.CreatedAt,
+		UpdatedAt: entry.UpdatedAt,
+	}
+}
+
+// ListFeeSchedules controller returns fee schedules, most recently effective first, filtered by fee
+// type and sender; active=true narrows the list to the schedules in effect
+func (ctrl *AdminController) ListFeeSchedules(ctx *gin.Context) {
+	query := storage.Client.FeeSchedule.Query()
+
+	if feeType := ctx.Query("feeType"); feeType != "" {
+		if err := feeschedule.FeeTypeValidator(feeschedule.FeeType(feeType)); err != nil {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid fee type", nil)
+			return
+		}
+		query = query.Where(feeschedule.FeeTypeEQ(feeschedule.FeeType(feeType)))
+	}
+
+	if senderID := ctx.Query("senderId"); senderID != "" {
+		id, err := uuid.Parse(senderID)
+		if err != nil {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid sender ID", nil)
+			return
+		}
+		query = query.Where(feeschedule.HasSenderProfileWith(senderprofile.IDEQ(id)))
+	}
+
+	if ctx.Query("active") == "true" {
+		now := time.Now()
+		query = query.Where(
+			feeschedule.EffectiveFromLTE(now),
+			feeschedule.Or(
+				feeschedule.EffectiveUntilIsNil(),
+				feeschedule.EffectiveUntilGT(now),
+			),
+		)
+	}
+
+	schedules, err := query.
+		WithSenderProfile().
+		Order(ent.Desc(feeschedule.FieldEffectiveFrom)).
+		All(ctx)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error": err.Error(),
+		}).Errorf("Failed to fetch fee schedules")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch fee schedules", nil)
+		return
+	}
+
+	response := make([]types.FeeScheduleResponse, 0, len(schedules))
+	for _, schedule := range schedules {
+		response = append(response, feeScheduleResponse(schedule))
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Fee schedules fetched successfully", response)
+}
+
+// CreateFeeSchedule controller adds a fee schedule, which applies to the orders created once it takes
+// effect
+func (ctrl *AdminController) CreateFeeSchedule(ctx *gin.Context) {
+	var payload types.FeeSchedulePayload
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	if payload.MinAmount.IsNegative() || payload.Percent.IsNegative() || payload.FlatAmount.IsNegative() {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+			Field:   "Fees",
+			Message: "Amounts and percentages must not be negative",
+		})
+		return
+	}
+	if payload.MaxAmount != nil && !payload.MaxAmount.GreaterThan(payload.MinAmount) {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+			Field:   "MaxAmount",
+			Message: "MaxAmount must be greater than MinAmount",
+		})
+		return
+	}
+
+	effectiveFrom := time.Now()
+	if payload.EffectiveFrom != nil {
+		effectiveFrom = *payload.EffectiveFrom
+	}
+	if payload.EffectiveUntil != nil && !payload.EffectiveUntil.After(effectiveFrom) {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+			Field:   "EffectiveUntil",
+			Message: "EffectiveUntil must be after EffectiveFrom",
+		})
+		return
+	}
+
+	create := storage.Client.FeeSchedule.
+		Create().
+		SetFeeType(feeschedule.FeeType(payload.FeeType)).
+		SetToken(payload.Token).
+		SetNetwork(payload.Network).
+		SetMinAmount(payload.MinAmount).
+		SetNillableMaxAmount(payload.MaxAmount).
+		SetPercent(payload.Percent).
+		SetFlatAmount(payload.FlatAmount).
+		SetEffectiveFrom(effectiveFrom).
+		SetNillableEffectiveUntil(payload.EffectiveUntil)
+
+	var sender *ent.SenderProfile
+	if payload.SenderID != nil {
+		var err error
+		sender, err := storage.Client.SenderProfile.Get(ctx, *payload.SenderID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+					Field:   "SenderID",
+					Message: "Sender not found",
+				})
+				return
+			}
+			logger.WithFields(logger.Fields{
+				"Error":    err.Error(),
+				"SenderID": *payload.SenderID,
+			}).Errorf("Failed to fetch sender")
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to create fee schedule", nil)
+			return
+		}
+		create.SetSenderProfile(sender)
+	}
+
+	schedule, err := create.Save(ctx)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":   err.Error(),
+			"FeeType": payload.FeeType,
+		}).Errorf("Failed to create fee schedule")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to create fee schedule", nil)
+		return
+	}
+
+	schedule.Edges.SenderProfile = sender
+
+	u.APIResponse(ctx, http.StatusCreated, "success", "Fee schedule created successfully", feeScheduleResponse(schedule))
+}
+
+// UpdateFeeSchedule controller changes when a fee schedule is in effect. The rules of a schedule don't
+// change, so orders keep the fees they were created with; new rules are set by ending a schedule and
+// creating its replacement
+func (ctrl *AdminController) UpdateFeeSchedule(ctx *gin.Context) {
+	scheduleID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid fee schedule ID", nil)
+		return
+	}
+
+	var payload types.UpdateFeeSchedulePayload
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	schedule, err := storage.Client.FeeSchedule.
+		Query().
+		Where(feeschedule.IDEQ(scheduleID)).
+		WithSenderProfile().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Fee schedule not found", nil)
+			return
+		}
+		logger.WithFields(logger.Fields{
+			"Error": err.Error(),
+			"ID":    scheduleID,
+		}).Errorf("Failed to fetch fee schedule")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update fee schedule", nil)
+		return
+	}
+
+	effectiveFrom, effectiveUntil := schedule.EffectiveFrom, schedule.EffectiveUntil
+	if payload.EffectiveFrom != nil {
+		effectiveFrom = *payload.EffectiveFrom
+	}
+	if payload.ClearEffectiveUntil {
+		effectiveUntil = nil
+	} else if payload.EffectiveUntil != nil {
+		effectiveUntil = payload.EffectiveUntil
+	}
+	if effectiveUntil != nil && !effectiveUntil.After(effectiveFrom) {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+			Field:   "EffectiveUntil",
+			Message: "EffectiveUntil must be after EffectiveFrom",
+		})
+		return
+	}
+
+	update := schedule.Update().SetEffectiveFrom(effectiveFrom)
+	if effectiveUntil == nil {
+		update.ClearEffectiveUntil()
+	} else {
+		update.SetEffectiveUntil(*effectiveUntil)
+	}
+
+	updated, err := update.Save(ctx)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error": err.Error(),
+			"ID":    scheduleID,
+		}).Errorf("Failed to update fee schedule")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update fee schedule", nil)
+		return
+	}
+
+	updated.Edges.SenderProfile = schedule.Edges.SenderProfile
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Fee schedule updated successfully", feeScheduleResponse(updated))
+}
+
+// feeScheduleResponse builds the response for a fee schedule
+func feeScheduleResponse(schedule *ent.FeeSchedule) types.FeeScheduleResponse {
+	response := types.FeeScheduleResponse{
+		ID:             schedule.ID,
+		FeeType:        string(schedule.FeeType),
+		Token:          schedule.Token,
+		Network:        schedule.Network,
+		MinAmount:      schedule.MinAmount,
+		MaxAmount:      schedule.MaxAmount,
+		Percent:        schedule.Percent,
+		FlatAmount:     schedule.FlatAmount,
+		EffectiveFrom:  schedule.EffectiveFrom,
+		EffectiveUntil: schedule.EffectiveUntil,
+		CreatedAt:      schedule.CreatedAt,
+		UpdatedAt:      schedule.UpdatedAt,
+	}
+	if schedule.Edges.SenderProfile != nil {
+		response.SenderID = &schedule.Edges.SenderProfile.ID
+	}
+	return response
+}
+
+// ListBlockchainRoutes controller returns blockchain routes, most recently created first, filtered by
+// service, network and sender
+func (ctrl *AdminController) ListBlockchainRoutes(ctx *gin.Context) {
+	query := storage.Client.BlockchainRoute.Query()
+
+	if service := ctx.Query("service"); service != "" {
+		if err := blockchainroute.ServiceValidator(blockchainroute.Service(service)); err != nil {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid service", nil)
+			return
+		}
+		query = query.Where(blockchainroute.ServiceEQ(blockchainroute.Service(service)))
+	}
+
+	if network := ctx.Query("network"); network != "" {
+		query = query.Where(blockchainroute.NetworkEQ(network))
+	}
+
+	if senderID := ctx.Query("senderId"); senderID != "" {
+		id, err := uuid.Parse(senderID)
+		if err != nil {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid sender ID", nil)
+			return
+		}
+		query = query.Where(blockchainroute.HasSenderProfileWith(senderprofile.IDEQ(id)))
+	}
+
+	routes, err := query.
+		WithSenderProfile().
+		Order(ent.Desc(blockchainroute.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error": err.Error(),
+		}).Errorf("Failed to fetch blockchain routes")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch blockchain routes", nil)
+		return
+	}
+
+	response := make([]types.BlockchainRouteResponse, 0, len(routes))
+	for _, route := range routes {
+		response = append(response, blockchainRouteResponse(route))
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Blockchain routes fetched successfully", response)
+}
+
+// CreateBlockchainRoute controller adds a blockchain route, which applies to the orders created from
+// now on on every instance
+func (ctrl *AdminController) CreateBlockchainRoute(ctx *gin.Context) {
+	var payload types.BlockchainRoutePayload
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	if payload.MinAmount.IsNegative() {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+			Field:   "MinAmount",
+			Message: "MinAmount must not be negative",
+		})
+		return
+	}
+	if payload.MaxAmount != nil && !payload.MaxAmount.GreaterThan(payload.MinAmount) {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+			Field:   "MaxAmount",
+			Message: "MaxAmount must be greater than MinAmount",
+		})
+		return
+	}
+
+	create := storage.Client.BlockchainRoute.
+		Create().
+		SetService(blockchainroute.Service(payload.Service)).
+		SetToken(payload.Token).
+		SetNetwork(payload.Network).
+		SetMinAmount(payload.MinAmount).
+		SetNillableMaxAmount(payload.MaxAmount).
+		SetPriority(payload.Priority).
+		SetNillableIsEnabled(payload.IsEnabled)
+
+	var sender *ent.SenderProfile
+	if payload.SenderID != nil {
+		var err error
+		sender, err = storage.Client.SenderProfile.Get(ctx, *payload.SenderID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+					Field:   "SenderID",
+					Message: "Sender not found",
+				})
+				return
+			}
+			logger.WithFields(logger.Fields{
+				"Error":    err.Error(),
+				"SenderID": *payload.SenderID,
+			}).Errorf("Failed to fetch sender")
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to create blockchain route", nil)
+			return
+		}
+		create.SetSenderProfile(sender)
+	}
+
+	route, err := create.Save(ctx)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":   err.Error(),
+			"Service": payload.Service,
+		}).Errorf("Failed to create blockchain route")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to create blockchain route", nil)
+		return
+	}
+
+	route.Edges.SenderProfile = sender
+	services.NotifyBlockchainRoutesChanged(ctx)
+
+	u.APIResponse(ctx, http.StatusCreated, "success", "Blockchain route created successfully", blockchainRouteResponse(route))
+}
+
+// UpdateBlockchainRoute controller changes the service, priority or state of a blockchain route.
+// Orders created before keep the service they were created with
+func (ctrl *AdminController) UpdateBlockchainRoute(ctx *gin.Context) {
+	routeID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid blockchain route ID", nil)
+		return
+	}
+
+	var payload types.UpdateBlockchainRoutePayload
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	update := storage.Client.BlockchainRoute.UpdateOneID(routeID)
+	if payload.Service != nil {
+		update.SetService(blockchainroute.Service(*payload.Service))
+	}
+	if payload.Priority != nil {
+		update.SetPriority(*payload.Priority)
+	}
+	if payload.IsEnabled != nil {
+		update.SetIsEnabled(*payload.IsEnabled)
+	}
+
+	route, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Blockchain route not found", nil)
+			return
+		}
+		logger.WithFields(logger.Fields{
+			"Error": err.Error(),
+			"ID":    routeID,
+		}).Errorf("Failed to update blockchain route")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update blockchain route", nil)
+		return
+	}
+
+	route.Edges.SenderProfile, _ = route.QuerySenderProfile().Only(ctx)
+	services.NotifyBlockchainRoutesChanged(ctx)
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Blockchain route updated successfully", blockchainRouteResponse(route))
+}
+
+// RemoveBlockchainRoute controller deletes a blockchain route. Orders created through it keep their
+// service
+func (ctrl *AdminController) RemoveBlockchainRoute(ctx *gin.Context) {
+	routeID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid blockchain route ID", nil)
+		return
+	}
+
+	if err := storage.Client.BlockchainRoute.DeleteOneID(routeID).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Blockchain route not found", nil)
+			return
+		}
+		logger.WithFields(logger.Fields{
+			"Error": err.Error(),
+			"ID":    routeID,
+		}).Errorf("Failed to remove blockchain route")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to remove blockchain route", nil)
+		return
+	}
+
+	services.NotifyBlockchainRoutesChanged(ctx)
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Blockchain route removed successfully", nil)
+}
+
+// blockchainRouteResponse builds the response for a blockchain route
+func blockchainRouteResponse(route *ent.BlockchainRoute) types.BlockchainRouteResponse {
+	response := types.BlockchainRouteResponse{
+		ID:        route.ID,
+		Service:   string(route.Service),
+		Token:     route.Token,
+		Network:   route.Network,
+		MinAmount: route.MinAmount,
+		MaxAmount: route.MaxAmount,
+		Priority:  route.Priority,
+		IsEnabled: route.IsEnabled,
+		CreatedAt: route.CreatedAt,
+		UpdatedAt: route.UpdatedAt,
+	}
+	if route.Edges.SenderProfile != nil {
+		response.SenderID = &route.Edges.SenderProfile.ID
+	}
+	return response
+}
+
+// ListTokens controller returns the tokens of every network, enabled or not, filtered by network
+// and state
+func (ctrl *AdminController) ListTokens(ctx *gin.Context) {
+	query := storage.Client.Token.Query()
+
+	if network := ctx.Query("network"); network != "" {
+		query = query.Where(tokenEnt.HasNetworkWith(networkEnt.IdentifierEQ(network)))
+	}
+
+	if enabled := ctx.Query("isEnabled"); enabled != "" {
+		isEnabled, err := strconv.ParseBool(enabled)
+		if err != nil {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid isEnabled", nil)
+			return
+		}
+		query = query.Where(tokenEnt.IsEnabledEQ(isEnabled))
+	}
+
+	tokens, err := query.
+		WithNetwork().
+		Order(ent.Asc(tokenEnt.FieldSymbol), ent.Asc(tokenEnt.FieldID)).
+		All(ctx)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error": err.Error(),
+		}).Errorf("Failed to fetch tokens")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch tokens", nil)
+		return
+	}
+
+	response := make([]types.TokenResponse, 0, len(tokens))
+	for _, token := range tokens {
+		response = append(response, tokenResponse(token))
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Tokens fetched successfully", response)
+}
+
+// CreateToken controller registers a token on a network once its symbol and decimals match the
+// token contract. Tokens are registered disabled unless isEnabled is set
+func (ctrl *AdminController) CreateToken(ctx *gin.Context) {
+	var payload types.TokenPayload
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	network, err := storage.Client.Network.
+		Query().
+		Where(networkEnt.IdentifierEQ(payload.Network)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+				Field:   "Network",
+				Message: "Network not found",
+			})
+			return
+		}
+		logger.WithFields(logger.Fields{
+			"Error":   err.Error(),
+			"Network": payload.Network,
+		}).Errorf("Failed to fetch network")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to create token", nil)
+		return
+	}
+
+	exists, err := network.QueryTokens().
+		Where(tokenEnt.Or(
+			tokenEnt.SymbolEQ(payload.Symbol),
+			tokenEnt.ContractAddressEqualFold(payload.ContractAddress),
+		)).
+		Exist(ctx)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":   err.Error(),
+			"Network": payload.Network,
+		}).Errorf("Failed to check existing tokens")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to create token", nil)
+		return
+	}
+	if exists {
+		u.APIResponse(ctx, http.StatusConflict, "error", "A token with this symbol or contract is already registered on the network", nil)
+		return
+	}
+
+	if err := services.VerifyTokenMetadata(ctx, network, payload.ContractAddress, payload.Symbol, *payload.Decimals); err != nil {
+		if errors.Is(err, services.ErrTokenMetadataMismatch) {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+				Field:   "ContractAddress",
+				Message: err.Error(),
+			})
+			return
+		}
+		logger.WithFields(logger.Fields{
+			"Error":           err.Error(),
+			"Network":         payload.Network,
+			"ContractAddress": payload.ContractAddress,
+		}).Errorf("Failed to verify token contract")
+		u.APIResponse(ctx, http.StatusBadGateway, "error", "Failed to read token contract", nil)
+		return
+	}
+
+	create := storage.Client.Token.
+		Create().
+		SetSymbol(payload.Symbol).
+		SetContractAddress(payload.ContractAddress).
+		SetDecimals(*payload.Decimals).
+		SetNetwork(network).
+		SetNillableIsEnabled(payload.IsEnabled)
+	if payload.BaseCurrency != "" {
+		create.SetBaseCurrency(payload.BaseCurrency)
+	}
+
+	token, err := create.Save(ctx)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":   err.Error(),
+			"Network": payload.Network,
+			"Symbol":  payload.Symbol,
+		}).Errorf("Failed to create token")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to create token", nil)
+		return
+	}
+
+	token.Edges.Network = network
+
+	u.APIResponse(ctx, http.StatusCreated, "success", "Token created successfully", tokenResponse(token))
+}
+
+// UpdateToken controller enables, disables or changes the base currency of a token. Orders already
+// created in a disabled token are processed as usual
+func (ctrl *AdminController) UpdateToken(ctx *gin.Context) {
+	tokenID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid token ID", nil)
+		return
+	}
+
+	var payload types.UpdateTokenPayload
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	update := storage.Client.Token.UpdateOneID(tokenID)
+	if payload.IsEnabled != nil {
+		update.SetIsEnabled(*payload.IsEnabled)
+	}
+	if payload.BaseCurrency != nil {
+		update.SetBaseCurrency(*payload.BaseCurrency)
+	}
+
+	token, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Token not found", nil)
+			return
+		}
+		logger.WithFields(logger.Fields{
+			"Error": err.Error(),
+			"ID":    tokenID,
+		}).Errorf("Failed to update token")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update token", nil)
+		return
+	}
+
+	token.Edges.Network, _ = token.QueryNetwork().Only(ctx)
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Token updated successfully", tokenResponse(token))
+}
+
+// tokenResponse builds the response for a token
+func tokenResponse(token *ent.Token) types.TokenResponse {
+	response := types.TokenResponse{
+		ID:              token.ID,
+		Symbol:          token.Symbol,
+		ContractAddress: token.ContractAddress,
+		Decimals:        token.Decimals,
+		BaseCurrency:    token.BaseCurrency,
+		IsEnabled:       token.IsEnabled,
+		DepeggedAt:      token.DepeggedAt,
+		CreatedAt:       token.CreatedAt,
+		UpdatedAt:       token.UpdatedAt,
+	}
+	if token.Edges.Network != nil {
+		response.Network = token.Edges.Network.Identifier
+	}
+	return response
+}
+
+// ListNetworks controller returns every network, enabled or not, filtered by state
+func (ctrl *AdminController) ListNetworks(ctx *gin.Context) {
+	query := storage.Client.Network.Query()
+
+	if enabled := ctx.Query("isEnabled"); enabled != "" {
+		isEnabled, err := strconv.ParseBool(enabled)
+		if err != nil {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid isEnabled", nil)
+			return
+		}
+		query = query.Where(networkEnt.IsEnabledEQ(isEnabled))
+	}
+
+	networks, err := query.
+		WithRPCEndpoints(func(q *ent.RPCEndpointQuery) {
+			q.Order(ent.Asc(rpcendpoint.FieldPriority))
+		}).
+		Order(ent.Asc(networkEnt.FieldIdentifier)).
+		All(ctx)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error": err.Error(),
+		}).Errorf("Failed to fetch networks")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch networks", nil)
+		return
+	}
+
+	response := make([]types.NetworkResponse, 0, len(networks))
+	for _, network := range networks {
+		response = append(response, networkResponse(network))
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Networks fetched successfully", response)
+}
+
+// CreateNetwork controller onboards a network with its RPC endpoints, explorer, Alchemy network
+// identifier, confirmation depth and gateway contract. Networks are created disabled unless
+// isEnabled is set; an enabled network gets its webhooks registered in the background
+func (ctrl *AdminController) CreateNetwork(ctx *gin.Context) {
+	var payload types.NetworkPayload
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	endpoints := []endpointField{{"RPCEndpoint", payload.RPCEndpoint}}
+	if payload.WssEndpoint != "" {
+		endpoints = append(endpoints, endpointField{"WssEndpoint", payload.WssEndpoint})
+	}
+	for i, fallback := range payload.FallbackRPCEndpoints {
+		endpoints = append(endpoints, endpointField{fmt.Sprintf("FallbackRPCEndpoints[%d]", i), fallback})
+	}
+	if errorData := unresolvableEndpoints(endpoints); len(errorData) > 0 {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", errorData)
+		return
+	}
+
+	exists, err := storage.Client.Network.
+		Query().
+		Where(networkEnt.Or(
+			networkEnt.IdentifierEQ(payload.Identifier),
+			networkEnt.ChainIDEQ(payload.ChainID),
+		)).
+		Exist(ctx)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":      err.Error(),
+			"Identifier": payload.Identifier,
+		}).Errorf("Failed to check existing networks")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to create network", nil)
+		return
+	}
+	if exists {
+		u.APIResponse(ctx, http.StatusConflict, "error", "A network with this identifier or chain ID already exists", nil)
+		return
+	}
+
+	network, err := createNetwork(ctx, payload)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":      err.Error(),
+			"Identifier": payload.Identifier,
+		}).Errorf("Failed to create network")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to create network", nil)
+		return
+	}
+
+	if network.IsEnabled {
+		go bootstrapNetwork(network)
+	}
+
+	u.APIResponse(ctx, http.StatusCreated, "success", "Network created successfully", networkResponse(network))
+}
+
+// createNetwork saves a network with its fallback RPC endpoints
+func createNetwork(ctx context.Context, payload types.NetworkPayload) (*ent.Network, error) {
+	tx, err := storage.Client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	create := tx.Network.
+		Create().
+		SetIdentifier(payload.Identifier).
+		SetChainID(payload.ChainID).
+		SetRPCEndpoint(payload.RPCEndpoint).
+		SetWssEndpoint(payload.WssEndpoint).
+		SetExplorerURL(payload.ExplorerURL).
+		SetAlchemyNetworkID(payload.AlchemyNetworkID).
+		SetGatewayContractAddress(payload.GatewayContractAddress).
+		SetBlockTime(payload.BlockTime).
+		SetFee(payload.Fee).
+		SetIsTestnet(payload.IsTestnet).
+		SetRequiredConfirmations(payload.RequiredConfirmations).
+		SetFinalityBlocks(payload.FinalityBlocks).
+		SetNillableWebhooksEnabled(payload.WebhooksEnabled).
+		SetNillableWebsocketEnabled(payload.WebsocketEnabled).
+		SetNillablePollingEnabled(payload.PollingEnabled).
+		SetIsEnabled(payload.IsEnabled != nil && *payload.IsEnabled)
+	if payload.NetworkType != "" {
+		create.SetNetworkType(networkEnt.NetworkType(payload.NetworkType))
+	}
+
+	network, err := create.Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	network.Edges.RPCEndpoints = make([]*ent.RPCEndpoint, 0, len(payload.FallbackRPCEndpoints))
+	for priority, url := range payload.FallbackRPCEndpoints {
+		endpoint, err := tx.RPCEndpoint.
+			Create().
+			SetURL(url).
+			SetPriority(priority).
+			SetNetwork(network).
+			Save(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+		network.Edges.RPCEndpoints = append(network.Edges.RPCEndpoints, endpoint)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return network.Unwrap(), nil
+}
+
+// UpdateNetwork controller enables, disables or reconfigures a network. The webhooks of the
+// network are brought in line with the change in the background
+func (ctrl *AdminController) UpdateNetwork(ctx *gin.Context) {
+	networkID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid network ID", nil)
+		return
+	}
+
+	var payload types.UpdateNetworkPayload
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	var endpoints []endpointField
+	if payload.RPCEndpoint != nil {
+		endpoints = append(endpoints, endpointField{"RPCEndpoint", *payload.RPCEndpoint})
+	}
+	// An empty WebSocket endpoint turns the subscription off
+	if payload.WssEndpoint != nil && *payload.WssEndpoint != "" {
+		endpoints = append(endpoints, endpointField{"WssEndpoint", *payload.WssEndpoint})
+	}
+	if errorData := unresolvableEndpoints(endpoints); len(errorData) > 0 {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", errorData)
+		return
+	}
+
+	update := storage.Client.Network.
+		UpdateOneID(networkID).
+		SetNillableRPCEndpoint(payload.RPCEndpoint).
+		SetNillableWssEndpoint(payload.WssEndpoint).
+		SetNillableExplorerURL(payload.ExplorerURL).
+		SetNillableAlchemyNetworkID(payload.AlchemyNetworkID).
+		SetNillableGatewayContractAddress(payload.GatewayContractAddress).
+		SetNillableRequiredConfirmations(payload.RequiredConfirmations).
+		SetNillableFinalityBlocks(payload.FinalityBlocks).
+		SetNillableWebhooksEnabled(payload.WebhooksEnabled).
+		SetNillableWebsocketEnabled(payload.WebsocketEnabled).
+		SetNillablePollingEnabled(payload.PollingEnabled).
+		SetNillableIsEnabled(payload.IsEnabled)
+
+	network, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Network not found", nil)
+			return
+		}
+		logger.WithFields(logger.Fields{
+			"Error": err.Error(),
+			"ID":    networkID,
+		}).Errorf("Failed to update network")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update network", nil)
+		return
+	}
+
+	network.Edges.RPCEndpoints, _ = network.QueryRPCEndpoints().
+		Order(ent.Asc(rpcendpoint.FieldPriority)).
+		All(ctx)
+
+	go bootstrapNetwork(network)
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Network updated successfully", networkResponse(network))
+}
+
+// endpointField is an RPC endpoint of a network payload
+type endpointField struct {
+	field    string
+	endpoint string
+}
+
+// unresolvableEndpoints returns the validation errors of the endpoints that don't resolve to a
+// usable RPC URL
+func unresolvableEndpoints(endpoints []endpointField) []types.ErrorData {
+	var errorData []types.ErrorData
+	for _, endpoint := range endpoints {
+		if _, err := u.ResolveRPCURL(endpoint.endpoint); err != nil {
+			errorData = append(errorData, types.ErrorData{
+				Field:   endpoint.field,
+				Message: err.Error(),
+			})
+		}
+	}
+	return errorData
+}
+
+// syncNetworkWebhooks brings the webhooks of the active blockchain service in line with an
+// onboarded or updated network
+var syncNetworkWebhooks = func(ctx context.Context, network *ent.Network) error {
+	return services.NewServiceManager().SyncNetworkWebhooks(ctx, network)
+}
+
+// bootstrapNetwork registers the webhooks of an onboarded or updated network, outside the request
+func bootstrapNetwork(network *ent.Network) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	if err := syncNetworkWebhooks(ctx, network); err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":   err.Error(),
+			"Network": network.Identifier,
+		}).Errorf("Failed to register network webhooks")
+		return
+	}
+
+	logger.WithFields(logger.Fields{
+		"Network": network.Identifier,
+		"Enabled": network.IsEnabled,
+	}).Infof("Synced network webhooks")
+}
+
+// networkResponse builds the response for a network
+func networkResponse(network *ent.Network) types.NetworkResponse {
+	sources := services.DepositSourcesFor(network)
+	response := types.NetworkResponse{
+		ID:                     network.ID,
+		Identifier:             network.Identifier,
+		ChainID:                network.ChainID,
+		NetworkType:            string(network.NetworkType),
+		RPCEndpoint:            network.RPCEndpoint,
+		FallbackRPCEndpoints:   []string{},
+		WssEndpoint:            network.WssEndpoint,
+		ExplorerURL:            network.ExplorerURL,
+		AlchemyNetworkID:       network.AlchemyNetworkID,
+		GatewayContractAddress: network.GatewayContractAddress,
+		BlockTime:              network.BlockTime,
+		Fee:                    network.Fee,
+		IsTestnet:              network.IsTestnet,
+		RequiredConfirmations:  network.RequiredConfirmations,
+		FinalityBlocks:         network.FinalityBlocks,
+		WebhooksEnabled:        sources.Webhooks,
+		WebsocketEnabled:       sources.Websocket,
+		PollingEnabled:         sources.Polling,
+		IsEnabled:              network.IsEnabled,
+		CreatedAt:              network.CreatedAt,
+		UpdatedAt:              network.UpdatedAt,
+	}
+	for _, endpoint := range network.Edges.RPCEndpoints {
+		response.FallbackRPCEndpoints = append(response.FallbackRPCEndpoints, endpoint.URL)
+	}
+	return response
+}
+
+// RequeueLockOrder controller takes a lock order stuck with a provider away from it and sends it
+// back to the provider queue
+func (ctrl *AdminController) RequeueLockOrder(ctx *gin.Context) {
+	var payload types.AdminOrderActionPayload
+	ctrl.performLockOrderAction(ctx, &payload, "Order requeued successfully", func(orderID uuid.UUID) (*ent.LockPaymentOrder, error) {
+		return common.RequeueLockOrder(ctx, orderID, common.AdminAction{
+			Actor:  payload.Actor,
+			Reason: payload.Reason,
+		})
+	})
+}
+
+// ReassignLockOrder controller offers a lock order to a specific provider
+func (ctrl *AdminController) ReassignLockOrder(ctx *gin.Context) {
+	var payload types.ReassignProviderPayload
+	ctrl.performLockOrderAction(ctx, &payload, "Order reassigned successfully", func(orderID uuid.UUID) (*ent.LockPaymentOrder, error) {
+		return common.ReassignLockOrder(ctx, orderID, payload.ProviderID, common.AdminAction{
+			Actor:  payload.Actor,
+			Reason: payload.Reason,
+		})
+	})
+}
+
+// performLockOrderAction binds the payload of an operation on a lock order and performs it
+func (ctrl *AdminController) performLockOrderAction(ctx *gin.Context, payload interface{}, message string, perform func(orderID uuid.UUID) (*ent.LockPaymentOrder, error)) {
+	orderID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid order ID", nil)
+		return
+	}
+
+	if err := ctx.ShouldBindJSON(payload); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	lockOrder, err := perform(orderID)
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Lock order not found", nil)
+			return
+		case errors.Is(err, common.ErrOrderNotRequeueable):
+			u.APIResponse(ctx, http.StatusConflict, "error", "Order is fulfilled, under review or not in a provider queue", nil)
+			return
+		case errors.Is(err, common.ErrProviderNotEligible):
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Provider is inactive or doesn't serve the order's bucket", nil)
+			return
+		}
+
+		logger.WithFields(logger.Fields{
+			"Error":   err.Error(),
+			"OrderID": orderID,
+		}).Errorf("Failed to perform lock order action")
+
+		// A returned order was requeued; only the audit log entry failed
+		if lockOrder == nil {
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to requeue order", nil)
+			return
+		}
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", message, adminLockOrderResponse(lockOrder))
+}
+
+// ListAuditLogs controller returns operations performed through the admin API, most recent first,
+// optionally for a single order
+func (ctrl *AdminController) ListAuditLogs(ctx *gin.Context) {
+	page, offset, pageSize := u.Paginate(ctx)
+
+	query := storage.Client.AdminAuditLog.Query()
+	if targetID := ctx.Query("targetId"); targetID != "" {
+		query = query.Where(adminauditlog.TargetIDEQ(targetID))
+	}
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error": err.Error(),
+		}).Errorf("Failed to count audit logs")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch audit logs", nil)
+		return
+	}
+
+	entries, err := query.
+		Order(ent.Desc(adminauditlog.FieldCreatedAt)).
+		Limit(pageSize).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error": err.Error(),
+		}).Errorf("Failed to fetch audit logs")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch audit logs", nil)
+		return
+	}
+
+	response := make([]types.AdminAuditLogResponse, 0, len(entries))
+	for _, entry := range entries {
+		response = append(response, types.AdminAuditLogResponse{
+			ID:        entry.ID,
+			Action:    string(entry.Action),
+			TargetID:  entry.TargetID,
+			Actor:     entry.Actor,
+			Reason:    entry.Reason,
+			Metadata:  entry.Metadata,
+			CreatedAt: entry.CreatedAt,
+		})
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Audit logs fetched successfully", types.AdminAuditLogList{
+		TotalRecords: count,
+		Page:         page,
+		PageSize:     pageSize,
+		Logs:         response,
+	})
+}
+
+// ListReconciliationReports controller returns deposit reconciliation reports, latest day first,
+// filtered by network, status and a range of days; discrepancies=true narrows the list to the reports
+// that found discrepancies
+func (ctrl *AdminController) ListReconciliationReports(ctx *gin.Context) {
+	page, offset, pageSize := u.Paginate(ctx)
+
+	query := storage.Client.ReconciliationReport.Query()
+	if network := ctx.Query("network"); network != "" {
+		query = query.Where(reconciliationreport.NetworkEQ(network))
+	}
+
+	if status := ctx.Query("status"); status != "" {
+		if err := reconciliationreport.StatusValidator(reconciliationreport.Status(status)); err != nil {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid status", nil)
+			return
+		}
+		query = query.Where(reconciliationreport.StatusEQ(reconciliationreport.Status(status)))
+	}
+
+	if from := ctx.Query("from"); from != "" {
+		day, err := time.Parse(time.DateOnly, from)
+		if err != nil {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid from date", nil)
+			return
+		}
+		query = query.Where(reconciliationreport.DayGTE(day))
+	}
+
+	if to := ctx.Query("to"); to != "" {
+		day, err := time.Parse(time.DateOnly, to)
+		if err != nil {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid to date", nil)
+			return
+		}
+		query = query.Where(reconciliationreport.DayLTE(day))
+	}
+
+	if ctx.Query("discrepancies") == "true" {
+		query = query.Where(reconciliationreport.DiscrepancyCountGT(0))
+	}
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error": err.Error(),
+		}).Errorf("Failed to count reconciliation reports")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch reconciliation reports", nil)
+		return
+	}
+
+	reports, err := query.
+		Order(ent.Desc(reconciliationreport.FieldDay), ent.Asc(reconciliationreport.FieldNetwork)).
+		Limit(pageSize).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error": err.Error(),
+		}).Errorf("Failed to fetch reconciliation reports")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch reconciliation reports", nil)
+		return
+	}
+
+	response := make([]types.ReconciliationReportResponse, 0, len(reports))
+	for _, report := range reports {
+		response = append(response, reconciliationReportResponse(report))
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Reconciliation reports fetched successfully", types.ReconciliationReportList{
+		TotalRecords: count,
+		Page:         page,
+		PageSize:     pageSize,
+		Reports:      response,
+	})
+}
+
+// GetReconciliationReport controller returns a deposit reconciliation report with its discrepancies
+func (ctrl *AdminController) GetReconciliationReport(ctx *gin.Context) {
+	reportID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid reconciliation report ID", nil)
+		return
+	}
+
+	report, err := storage.Client.ReconciliationReport.
+		Query().
+		Where(reconciliationreport.IDEQ(reportID)).
+		WithDiscrepancies(func(rdq *ent.ReconciliationDiscrepancyQuery) {
+			rdq.WithPaymentOrder().
+				Order(ent.Asc(reconciliationdiscrepancy.FieldBlockNumber), ent.Asc(reconciliationdiscrepancy.FieldTxHash))
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Reconciliation report not found", nil)
+			return
+		}
+		logger.WithFields(logger.Fields{
+			"Error":    err.Error(),
+			"ReportID": reportID,
+		}).Errorf("Failed to fetch reconciliation report")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch reconciliation report", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Reconciliation report fetched successfully", reconciliationReportResponse(report))
+}
+
+// ReconcileDeposits controller reconciles the deposits of a network on a UTC day that has ended,
+// replacing the day's report
+func (ctrl *AdminController) ReconcileDeposits(ctx *gin.Context) {
+	var payload types.ReconcilePayload
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	day, err := time.Parse(time.DateOnly, payload.Date)
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid date", nil)
+		return
+	}
+	if day.AddDate(0, 0, 1).After(time.Now()) {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Only days that have ended can be reconciled", nil)
+		return
+	}
+
+	network, err := storage.Client.Network.
+		Query().
+		Where(networkEnt.IdentifierEQ(payload.Network)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Network not found", nil)
+			return
+		}
+		logger.WithFields(logger.Fields{
+			"Error":   err.Error(),
+			"Network": payload.Network,
+		}).Errorf("Failed to fetch network")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to reconcile deposits", nil)
+		return
+	}
+
+	report, err := common.ReconcileNetwork(ctx, network, day)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":   err.Error(),
+			"Network": payload.Network,
+			"Date":    payload.Date,
+		}).Errorf("Failed to reconcile deposits")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to reconcile deposits", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Deposits reconciled", reconciliationReportResponse(report))
+}
+
+// reconciliationReportResponse converts a reconciliation report, with its discrepancies when loaded, to
+// its API response
+func reconciliationReportResponse(report *ent.ReconciliationReport) types.ReconciliationReportResponse {
+	response := types.ReconciliationReportResponse{
+		ID:               report.ID,
+		Network:          report.Network,
+		Date:             report.Day.UTC().Format(time.DateOnly),
+		FromBlock:        report.FromBlock,
+		ToBlock:          report.ToBlock,
+		Status:           string(report.Status),
+		Error:            report.Error,
+		AddressesChecked: report.AddressesChecked,
+		TransfersChecked: report.TransfersChecked,
+		DiscrepancyCount: report.DiscrepancyCount,
+		CreatedAt:        report.CreatedAt,
+	}
+
+	for _, discrepancy := range report.Edges.Discrepancies {
+		item := types.ReconciliationDiscrepancyResponse{
+			ID:             discrepancy.ID,
+			Type:           string(discrepancy.Type),
+			TxHash:         discrepancy.TxHash,
+			Address:        discrepancy.Address,
+			Token:          discrepancy.Token,
+			FromAddress:    discrepancy.FromAddress,
+			BlockNumber:    discrepancy.BlockNumber,
+			OnchainAmount:  discrepancy.OnchainAmount,
+			RecordedAmount: discrepancy.RecordedAmount,
+		}
+		if discrepancy.Edges.PaymentOrder != nil {
+			item.OrderID = &discrepancy.Edges.PaymentOrder.ID
+		}
+		response.Discrepancies = append(response.Discrepancies, item)
+	}
+
+	return response
+}
+
+// ListUnmatchedDeposits controller returns the transfers to receive addresses no order was credited
+// with, most recent first, filtered by status, reason and network
+func (ctrl *AdminController) ListUnmatchedDeposits(ctx *gin.Context) {
+	page, offset, pageSize := u.Paginate(ctx)
+
+	query := storage.Client.UnmatchedDeposit.Query()
+	if status := ctx.Query("status"); status != "" {
+		if err := unmatcheddeposit.StatusValidator(unmatcheddeposit.Status(status)); err != nil {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid status", nil)
+			return
+		}
+		query = query.Where(unmatcheddeposit.StatusEQ(unmatcheddeposit.Status(status)))
+	}
+
+	if reason := ctx.Query("reason"); reason != "" {
+		if err := unmatcheddeposit.ReasonValidator(unmatcheddeposit.Reason(reason)); err != nil {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid reason", nil)
+			return
+		}
+		query = query.Where(unmatcheddeposit.ReasonEQ(unmatcheddeposit.Reason(reason)))
+	}
+
+	if network := ctx.Query("network"); network != "" {
+		query = query.Where(unmatcheddeposit.HasTokenWith(tokenEnt.HasNetworkWith(networkEnt.IdentifierEQ(network))))
+	}
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error": err.Error(),
+		}).Errorf("Failed to count unmatched deposits")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch unmatched deposits", nil)
+		return
+	}
+
+	deposits, err := query.
+		WithToken(func(tq *ent.TokenQuery) {
+			tq.WithNetwork()
+		}).
+		WithPaymentOrder().
+		WithSweep().
+		Order(ent.Desc(unmatcheddeposit.FieldCreatedAt)).
+		Limit(pageSize).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error": err.Error(),
+		}).Errorf("Failed to fetch unmatched deposits")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch unmatched deposits", nil)
+		return
+	}
+
+	response := make([]types.UnmatchedDepositResponse, 0, len(deposits))
+	for _, deposit := range deposits {
+		response = append(response, unmatchedDepositResponse(deposit))
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Unmatched deposits fetched successfully", types.UnmatchedDepositList{
+		TotalRecords: count,
+		Page:         page,
+		PageSize:     pageSize,
+		Deposits:     response,
+	})
+}
+
+// LinkUnmatchedDeposit controller credits an unmatched deposit to an order on its receive address
+func (ctrl *AdminController) LinkUnmatchedDeposit(ctx *gin.Context) {
+	var payload types.LinkUnmatchedDepositPayload
+	ctrl.performUnmatchedDepositAction(ctx, &payload, "Deposit linked successfully", func(depositID uuid.UUID) (*ent.UnmatchedDeposit, error) {
+		orderID, err := uuid.Parse(payload.OrderID)
+		if err != nil {
+			return nil, common.ErrOrderNotLinkable
+		}
+		return common.LinkUnmatchedDeposit(ctx, depositID, orderID, common.AdminAction{
+			Actor:  payload.Actor,
+			Reason: payload.Reason,
+		})
+	})
+}
+
+// RefundUnmatchedDeposit controller sends an unmatched deposit back to the address it came from
+func (ctrl *AdminController) RefundUnmatchedDeposit(ctx *gin.Context) {
+	var payload types.AdminOrderActionPayload
+	ctrl.performUnmatchedDepositAction(ctx, &payload, "Refund submitted", func(depositID uuid.UUID) (*ent.UnmatchedDeposit, error) {
+		return common.RefundUnmatchedDeposit(ctx, depositID, common.AdminAction{
+			Actor:  payload.Actor,
+			Reason: payload.Reason,
+		})
+	})
+}
+
+// SweepUnmatchedDeposit controller sends an unmatched deposit to the treasury
+func (ctrl *AdminController) SweepUnmatchedDeposit(ctx *gin.Context) {
+	var payload types.AdminOrderActionPayload
+	ctrl.performUnmatchedDepositAction(ctx, &payload, "Sweep submitted", func(depositID uuid.UUID) (*ent.UnmatchedDeposit, error) {
+		return common.SweepUnmatchedDeposit(ctx, depositID, common.AdminAction{
+			Actor:  payload.Actor,
+			Reason: payload.Reason,
+		})
+	})
+}
+
+// performUnmatchedDepositAction binds the payload of an operation on an unmatched deposit and performs it
+func (ctrl *AdminController) performUnmatchedDepositAction(ctx *gin.Context, payload interface{}, message string, perform func(depositID uuid.UUID) (*ent.UnmatchedDeposit, error)) {
+	depositID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid deposit ID", nil)
+		return
+	}
+
+	if err := ctx.ShouldBindJSON(payload); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	deposit, err := perform(depositID)
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Deposit or order not found", nil)
+			return
+		case errors.Is(err, common.ErrDepositResolved):
+			u.APIResponse(ctx, http.StatusConflict, "error", "Deposit is already linked, refunded or swept", nil)
+			return
+		case errors.Is(err, common.ErrOrderNotLinkable):
+			u.APIResponse(ctx, http.StatusConflict, "error", "Order is not awaiting payment to the deposit's receive address", nil)
+			return
+		case errors.Is(err, common.ErrDepositNotSweepable):
+			u.APIResponse(ctx, http.StatusConflict, "error", "Deposit is not on an EVM receive address or no treasury address is configured", nil)
+			return
+		}
+
+		logger.WithFields(logger.Fields{
+			"Error":     err.Error(),
+			"DepositID": depositID,
+		}).Errorf("Failed to perform unmatched deposit action")
+
+		// A returned deposit was resolved; only the audit log entry failed
+		if deposit == nil {
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to resolve deposit", nil)
+			return
+		}
+	}
+
+	deposit, err = storage.Client.UnmatchedDeposit.
+		Query().
+		Where(unmatcheddeposit.IDEQ(depositID)).
+		WithToken(func(tq *ent.TokenQuery) {
+			tq.WithNetwork()
+		}).
+		WithPaymentOrder().
+		WithSweep().
+		Only(ctx)
+	if err != nil {
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch deposit", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", message, unmatchedDepositResponse(deposit))
+}
+
+// unmatchedDepositResponse converts an unmatched deposit, loaded with its token, network, order and
+// sweep, to its API response
+func unmatchedDepositResponse(deposit *ent.UnmatchedDeposit) types.UnmatchedDepositResponse {
+	response := types.UnmatchedDepositResponse{
+		ID:             deposit.ID,
+		Network:        deposit.Edges.Token.Edges.Network.Identifier,
+		Token:          deposit.Edges.Token.Symbol,
+		TxHash:         deposit.TxHash,
+		ReceiveAddress: deposit.ReceiveAddress,
+		FromAddress:    deposit.FromAddress,
+		Amount:         deposit.Amount,
+		BlockNumber:    deposit.BlockNumber,
+		Reason:         string(deposit.Reason),
+		Status:         string(deposit.Status),
+		CreatedAt:      deposit.CreatedAt,
+	}
+	if deposit.Edges.PaymentOrder != nil {
+		response.OrderID = &deposit.Edges.PaymentOrder.ID
+	}
+	if deposit.Edges.Sweep != nil {
+		sweep := sweepResponse(deposit.Edges.Sweep)
+		response.Sweep = &sweep
+	}
+	if !deposit.ResolvedAt.IsZero() {
+		response.ResolvedAt = &deposit.ResolvedAt
+	}
+	return response
+}
+
+// ListDeadLetters controller returns the failed processing attempts of indexed events, most recent
+// first, filtered by status, stage, network and order
+func (ctrl *AdminController) ListDeadLetters(ctx *gin.Context) {
+	page, offset, pageSize := u.Paginate(ctx)
+
+	query := storage.Client.DeadLetter.Query()
+	if status := ctx.Query("status"); status != "" {
+		if err := deadletter.StatusValidator(deadletter.Status(status)); err != nil {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid status", nil)
+			return
+		}
+		query = query.Where(deadletter.StatusEQ(deadletter.Status(status)))
+	}
+
+	if stage := ctx.Query("stage"); stage != "" {
+		if err := deadletter.StageValidator(deadletter.Stage(stage)); err != nil {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid stage", nil)
+			return
+		}
+		query = query.Where(deadletter.StageEQ(deadletter.Stage(stage)))
+	}
+
+	if network := ctx.Query("network"); network != "" {
+		query = query.Where(deadletter.NetworkEQ(network))
+	}
+
+	if orderID := ctx.Query("orderId"); orderID != "" {
+		query = query.Where(deadletter.OrderIDEQ(orderID))
+	}
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error": err.Error(),
+		}).Errorf("Failed to count dead letters")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch dead letters", nil)
+		return
+	}
+
+	entries, err := query.
+		Order(ent.Desc(deadletter.FieldCreatedAt)).
+		Limit(pageSize).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error": err.Error(),
+		}).Errorf("Failed to fetch dead letters")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch dead letters", nil)
+		return
+	}
+
+	response := make([]types.DeadLetterResponse, 0, len(entries))
+	for _, entry := range entries {
+		response = append(response, deadLetterResponse(entry))
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Dead letters fetched successfully", types.DeadLetterList{
+		TotalRecords: count,
+		Page:         page,
+		PageSize:     pageSize,
+		DeadLetters:  response,
+	})
+}
+
+// GetDeadLetter controller returns a dead letter with the payload it is retried with
+func (ctrl *AdminController) GetDeadLetter(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid dead letter ID", nil)
+		return
+	}
+
+	entry, err := storage.Client.DeadLetter.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Dead letter not found", nil)
+			return
+		}
+		logger.WithFields(logger.Fields{
+			"Error": err.Error(),
+			"ID":    id,
+		}).Errorf("Failed to fetch dead letter")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch dead letter", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Dead letter fetched successfully", deadLetterResponse(entry))
+}
+
+// RetryDeadLetter controller processes a pending or exhausted dead letter again
+func (ctrl *AdminController) RetryDeadLetter(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid dead letter ID", nil)
+		return
+	}
+
+	var payload types.AdminOrderActionPayload
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	entry, err := common.RetryDeadLetter(ctx, id, common.AdminAction{
+		Actor:  payload.Actor,
+		Reason: payload.Reason,
+	})
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Dead letter not found", nil)
+			return
+		case errors.Is(err, common.ErrDeadLetterResolved):
+			u.APIResponse(ctx, http.StatusConflict, "error", "Dead letter is already resolved", nil)
+			return
+		}
+
+		logger.WithFields(logger.Fields{
+			"Error": err.Error(),
+			"ID":    id,
+		}).Errorf("Failed to retry dead letter")
+
+		// A returned dead letter was retried; only the audit log entry failed
+		if entry == nil {
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to retry dead letter", nil)
+			return
+		}
+	}
+
+	message := "Dead letter resolved"
+	if entry.Status != deadletter.StatusResolved {
+		message = "Dead letter retry failed"
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", message, deadLetterResponse(entry))
+}
+
+// deadLetterResponse converts a dead letter to its API response
+func deadLetterResponse(entry *ent.DeadLetter) types.DeadLetterResponse {
+	response := types.DeadLetterResponse{
+		ID:         entry.ID,
+		OrderID:    entry.OrderID,
+		Stage:      string(entry.Stage),
+		Network:    entry.Network,
+		Payload:    entry.Payload,
+		LastError:  entry.LastError,
+		Status:     string(entry.Status),
+		RetryCount: entry.RetryCount,
+		CreatedAt:  entry.CreatedAt,
+		UpdatedAt:  entry.UpdatedAt,
+	}
+	if entry.Status == deadletter.StatusPending {
+		response.NextRetryAt = &entry.NextRetryAt
+	}
+	if !entry.ResolvedAt.IsZero() {
+		response.ResolvedAt = &entry.ResolvedAt
+	}
+	return response
+}
@@ -0,0 +1,792 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	"github.com/NEDA-LABS/stablenode/ent/reconciliationdiscrepancy"
+	"github.com/NEDA-LABS/stablenode/ent/reconciliationreport"
+	"github.com/NEDA-LABS/stablenode/ent/unmatcheddeposit"
+	"github.com/NEDA-LABS/stablenode/ent/webhookretryattempt"
+	"github.com/NEDA-LABS/stablenode/routers/middleware"
+	"github.com/NEDA-LABS/stablenode/services"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+	u "github.com/NEDA-LABS/stablenode/utils"
+	"github.com/NEDA-LABS/stablenode/utils/test"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupPool(ctx context.Context) error {
+	rows := []struct {
+		address    string
+		status     receiveaddress.Status
+		isDeployed bool
+		timesUsed  int
+		assignedAt time.Time
+	}{
+		{"0x1111111111111111111111111111111111111111", receiveaddress.StatusPoolReady, true, 2, time.Time{}},
+		{"0x2222222222222222222222222222222222222222", receiveaddress.StatusPoolReady, true, 4, time.Time{}},
+		{"0x1111111111111111111111111111111111111111", receiveaddress.StatusPoolAssigned, true, 0, time.Now().Add(-10 * time.Minute)},
+		{"0x2222222222222222222222222222222222222222", receiveaddress.StatusPoolAssigned, true, 0, time.Now().Add(-2 * time.Minute)},
+		{"0x3333333333333333333333333333333333333333", receiveaddress.StatusUnused, false, 0, time.Time{}},
+	}
+
+	for _, row := range rows {
+		create := db.Client.ReceiveAddress.
+			Create().
+			SetAddress(row.address).
+			SetStatus(row.status).
+			SetIsDeployed(row.isDeployed).
+			SetTimesUsed(row.timesUsed).
+			SetNetworkIdentifier("base-sepolia").
+			SetChainID(84532)
+		if !row.assignedAt.IsZero() {
+			create.SetAssignedAt(row.assignedAt)
+		}
+		if _, err := create.Save(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func TestAdmin(t *testing.T) {
+	// Set up test database client
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	db.Client = client
+
+	err := setupPool(context.Background())
+	assert.NoError(t, err)
+
+	viper.Set("ADMIN_API_KEY", "test-admin-key")
+	defer viper.Set("ADMIN_API_KEY", "")
+
+	// Set up test routers
+	router := gin.New()
+	router.Use(middleware.AdminMiddleware)
+
+	ctrl := NewAdminController()
+	router.GET("/pool/status", ctrl.GetPoolStatus)
+	router.GET("/webhook-attempts", ctrl.ListWebhookAttempts)
+	router.POST("/webhook-attempts/retry", ctrl.RetryWebhookAttempts)
+	router.GET("/payment-orders", ctrl.ListPaymentOrders)
+	router.POST("/lock-orders/:id/requeue", ctrl.RequeueLockOrder)
+	router.GET("/fee-schedules", ctrl.ListFeeSchedules)
+	router.POST("/fee-schedules", ctrl.CreateFeeSchedule)
+	router.PATCH("/fee-schedules/:id", ctrl.UpdateFeeSchedule)
+	router.GET("/blockchain-routes", ctrl.ListBlockchainRoutes)
+	router.POST("/blockchain-routes", ctrl.CreateBlockchainRoute)
+	router.PATCH("/blockchain-routes/:id", ctrl.UpdateBlockchainRoute)
+	router.DELETE("/blockchain-routes/:id", ctrl.RemoveBlockchainRoute)
+	router.GET("/reconciliation-reports", ctrl.ListReconciliationReports)
+	router.POST("/reconciliation-reports", ctrl.ReconcileDeposits)
+	router.GET("/reconciliation-reports/:id", ctrl.GetReconciliationReport)
+	router.GET("/unmatched-deposits", ctrl.ListUnmatchedDeposits)
+	router.POST("/unmatched-deposits/:id/link", ctrl.LinkUnmatchedDeposit)
+	router.POST("/unmatched-deposits/:id/sweep", ctrl.SweepUnmatchedDeposit)
+	router.GET("/tokens", ctrl.ListTokens)
+	router.POST("/tokens", ctrl.CreateToken)
+	router.PATCH("/tokens/:id", ctrl.UpdateToken)
+	router.GET("/networks", ctrl.ListNetworks)
+	router.POST("/networks", ctrl.CreateNetwork)
+	router.PATCH("/networks/:id", ctrl.UpdateNetwork)
+
+	t.Run("GetPoolStatus", func(t *testing.T) {
+		t.Run("should reject requests without the admin key", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "GET", "/pool/status", nil, nil, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusUnauthorized, res.Code)
+
+			res, err = test.PerformRequest(t, "GET", "/pool/status", nil, map[string]string{
+				"Admin-API-Key": "wrong-key",
+			}, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusUnauthorized, res.Code)
+		})
+
+		t.Run("should return pool inventory per network", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "GET", "/pool/status", nil, map[string]string{
+				"Admin-API-Key": "test-admin-key",
+			}, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+
+			var response struct {
+				Data []types.PoolNetworkStatus `json:"data"`
+			}
+			err = json.Unmarshal(res.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Len(t, response.Data, 1)
+
+			status := response.Data[0]
+			assert.Equal(t, "base-sepolia", status.Network)
+			assert.Equal(t, 2, status.PoolReady)
+			assert.Equal(t, 2, status.PoolAssigned)
+			assert.Equal(t, 0, status.PoolProcessing)
+			assert.Equal(t, 1, status.NotDeployed)
+			assert.Equal(t, 3.0, status.AverageTimesUsed)
+			if assert.NotNil(t, status.OldestAssignmentAge) {
+				assert.GreaterOrEqual(t, *status.OldestAssignmentAge, int64(600))
+			}
+		})
+
+		t.Run("should filter by network", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "GET", "/pool/status?network=arbitrum-one", nil, map[string]string{
+				"Admin-API-Key": "test-admin-key",
+			}, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+
+			var response struct {
+				Data []types.PoolNetworkStatus `json:"data"`
+			}
+			err = json.Unmarshal(res.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Empty(t, response.Data)
+		})
+	})
+	t.Run("WebhookAttempts", func(t *testing.T) {
+		ctx := context.Background()
+		headers := map[string]string{"Admin-API-Key": "test-admin-key"}
+
+		attempts := []struct {
+			url    string
+			event  string
+			status webhookretryattempt.Status
+		}{
+			{"https://sender.example/hooks", "payment_order.pending", webhookretryattempt.StatusFailed},
+			{"https://sender.example/hooks", "payment_order.settled", webhookretryattempt.StatusExpired},
+			{"https://other.example/hooks", "payment_order.settled", webhookretryattempt.StatusExpired},
+			{"https://sender.example/hooks", "payment_order.settled", webhookretryattempt.StatusSuccess},
+		}
+		nextRetry := time.Now().Add(time.Hour)
+		for _, attempt := range attempts {
+			_, err := client.WebhookRetryAttempt.
+				Create().
+				SetAttemptNumber(3).
+				SetNextRetryTime(nextRetry).
+				SetPayload(map[string]interface{}{
+					"event": attempt.event,
+					"data":  map[string]interface{}{"id": "order-id"},
+				}).
+				SetWebhookURL(attempt.url).
+				SetStatus(attempt.status).
+				Save(ctx)
+			assert.NoError(t, err)
+		}
+
+		list := func(t *testing.T, query string) []types.WebhookAttemptResponse {
+			res, err := test.PerformRequest(t, "GET", "/webhook-attempts"+query, nil, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+
+			var response struct {
+				Data []types.WebhookAttemptResponse `json:"data"`
+			}
+			assert.NoError(t, json.Unmarshal(res.Body.Bytes(), &response))
+			return response.Data
+		}
+
+		t.Run("should list failed and expired notifications", func(t *testing.T) {
+			data := list(t, "")
+			assert.Len(t, data, 3)
+			assert.Equal(t, "order-id", data[0].OrderID)
+
+			assert.Len(t, list(t, "?status=expired"), 2)
+			assert.Len(t, list(t, "?webhookUrl=https://sender.example/hooks"), 2)
+
+			data = list(t, "?event=payment_order.settled&webhookUrl=https://sender.example/hooks")
+			if assert.Len(t, data, 1) {
+				assert.Equal(t, "expired", data[0].Status)
+			}
+		})
+
+		t.Run("should reject invalid filters", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "GET", "/webhook-attempts?status=success", nil, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+		})
+
+		t.Run("should reschedule matching notifications", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "POST", "/webhook-attempts/retry", map[string]interface{}{
+				"webhookUrl": "https://sender.example/hooks",
+			}, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+
+			var response struct {
+				Data types.RetryWebhookAttemptsResponse `json:"data"`
+			}
+			assert.NoError(t, json.Unmarshal(res.Body.Bytes(), &response))
+			assert.Equal(t, 2, response.Data.Retried)
+
+			due := client.WebhookRetryAttempt.
+				Query().
+				Where(
+					webhookretryattempt.StatusEQ(webhookretryattempt.StatusFailed),
+					webhookretryattempt.NextRetryTimeLTE(time.Now()),
+				).
+				AllX(ctx)
+			assert.Len(t, due, 2)
+
+			// Other destinations are left alone
+			assert.Len(t, list(t, "?status=expired"), 1)
+		})
+	})
+
+	t.Run("PaymentOrders", func(t *testing.T) {
+		headers := map[string]string{"Admin-API-Key": "test-admin-key"}
+
+		t.Run("should reject invalid filters", func(t *testing.T) {
+			for _, query := range []string{"?status=stuck", "?minAge=yesterday"} {
+				res, err := test.PerformRequest(t, "GET", "/payment-orders"+query, nil, headers, router)
+				assert.NoError(t, err)
+				assert.Equal(t, http.StatusBadRequest, res.Code, query)
+			}
+		})
+
+		t.Run("should list matching orders", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "GET", "/payment-orders?status=pending&minAge=30m", nil, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+
+			var response struct {
+				Data types.AdminPaymentOrderList `json:"data"`
+			}
+			assert.NoError(t, json.Unmarshal(res.Body.Bytes(), &response))
+			assert.Equal(t, 0, response.Data.TotalRecords)
+			assert.Equal(t, 1, response.Data.Page)
+		})
+
+		t.Run("should require an actor and reason for order actions", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "POST", "/lock-orders/"+uuid.New().String()+"/requeue", map[string]interface{}{
+				"reason": "stuck with provider",
+			}, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+
+			res, err = test.PerformRequest(t, "POST", "/lock-orders/"+uuid.New().String()+"/requeue", map[string]interface{}{
+				"actor":  "ops@example.com",
+				"reason": "stuck with provider",
+			}, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusNotFound, res.Code)
+		})
+	})
+	t.Run("FeeSchedules", func(t *testing.T) {
+		headers := map[string]string{"Admin-API-Key": "test-admin-key"}
+
+		t.Run("should reject invalid schedules", func(t *testing.T) {
+			for _, payload := range []map[string]interface{}{
+				{"feeType": "gas", "percent": "1"},
+				{"feeType": "sender", "percent": "-1"},
+				{"feeType": "sender", "minAmount": "100", "maxAmount": "50"},
+				{"feeType": "sender", "effectiveFrom": "2025-01-02T00:00:00Z", "effectiveUntil": "2025-01-01T00:00:00Z"},
+				{"feeType": "sender", "senderId": uuid.New().String()},
+			} {
+				res, err := test.PerformRequest(t, "POST", "/fee-schedules", payload, headers, router)
+				assert.NoError(t, err)
+				assert.Equal(t, http.StatusBadRequest, res.Code, payload)
+			}
+		})
+
+		t.Run("should create, end and list schedules", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "POST", "/fee-schedules", map[string]interface{}{
+				"feeType":       "protocol",
+				"token":         "USDC",
+				"minAmount":     "500",
+				"percent":       "0.3",
+				"effectiveFrom": time.Now().Add(-time.Hour).Format(time.RFC3339Nano),
+			}, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusCreated, res.Code)
+
+			var created struct {
+				Data types.FeeScheduleResponse `json:"data"`
+			}
+			assert.NoError(t, json.Unmarshal(res.Body.Bytes(), &created))
+			assert.Equal(t, "protocol", created.Data.FeeType)
+			assert.Nil(t, created.Data.EffectiveUntil)
+
+			var list struct {
+				Data []types.FeeScheduleResponse `json:"data"`
+			}
+			res, err = test.PerformRequest(t, "GET", "/fee-schedules?feeType=protocol&active=true", nil, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+			assert.NoError(t, json.Unmarshal(res.Body.Bytes(), &list))
+			assert.Len(t, list.Data, 1)
+
+			res, err = test.PerformRequest(t, "PATCH", "/fee-schedules/"+created.Data.ID.String(), map[string]interface{}{
+				"effectiveUntil": time.Now().Add(-time.Second).Format(time.RFC3339Nano),
+			}, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+
+			res, err = test.PerformRequest(t, "GET", "/fee-schedules?feeType=protocol&active=true", nil, headers, router)
+			assert.NoError(t, err)
+			assert.NoError(t, json.Unmarshal(res.Body.Bytes(), &list))
+			assert.Len(t, list.Data, 0)
+
+			res, err = test.PerformRequest(t, "GET", "/fee-schedules?feeType=gas", nil, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+		})
+	})
+
+	t.Run("BlockchainRoutes", func(t *testing.T) {
+		headers := map[string]string{"Admin-API-Key": "test-admin-key"}
+
+		// Route changes are signalled to the other instances through Redis
+		mr, err := miniredis.Run()
+		assert.NoError(t, err)
+		defer mr.Close()
+		db.RedisClient = redis.NewClient(&redis.Options{Addr: mr.Addr()})
+
+		t.Run("should reject invalid routes", func(t *testing.T) {
+			for _, payload := range []map[string]interface{}{
+				{"service": "infura"},
+				{"service": "rpc", "minAmount": "-1"},
+				{"service": "rpc", "minAmount": "100", "maxAmount": "50"},
+				{"service": "rpc", "senderId": uuid.New().String()},
+			} {
+				res, err := test.PerformRequest(t, "POST", "/blockchain-routes", payload, headers, router)
+				assert.NoError(t, err)
+				assert.Equal(t, http.StatusBadRequest, res.Code, payload)
+			}
+		})
+
+		t.Run("should create, update, list and remove routes", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "POST", "/blockchain-routes", map[string]interface{}{
+				"service":   "rpc",
+				"network":   "base-sepolia",
+				"maxAmount": "100",
+			}, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusCreated, res.Code)
+
+			var created struct {
+				Data types.BlockchainRouteResponse `json:"data"`
+			}
+			assert.NoError(t, json.Unmarshal(res.Body.Bytes(), &created))
+			assert.Equal(t, "rpc", created.Data.Service)
+			assert.True(t, created.Data.IsEnabled)
+
+			res, err = test.PerformRequest(t, "PATCH", "/blockchain-routes/"+created.Data.ID.String(), map[string]interface{}{
+				"service":   "engine",
+				"isEnabled": false,
+			}, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+
+			var list struct {
+				Data []types.BlockchainRouteResponse `json:"data"`
+			}
+			res, err = test.PerformRequest(t, "GET", "/blockchain-routes?service=engine&network=base-sepolia", nil, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+			assert.NoError(t, json.Unmarshal(res.Body.Bytes(), &list))
+			assert.Len(t, list.Data, 1)
+			assert.False(t, list.Data[0].IsEnabled)
+
+			res, err = test.PerformRequest(t, "DELETE", "/blockchain-routes/"+created.Data.ID.String(), nil, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+
+			res, err = test.PerformRequest(t, "DELETE", "/blockchain-routes/"+created.Data.ID.String(), nil, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusNotFound, res.Code)
+
+			version, err := mr.Get(services.BlockchainRoutesVersionKey)
+			assert.NoError(t, err)
+			assert.Equal(t, "3", version)
+		})
+	})
+
+	t.Run("ReconciliationReports", func(t *testing.T) {
+		headers := map[string]string{"Admin-API-Key": "test-admin-key"}
+
+		report := client.ReconciliationReport.
+			Create().
+			SetNetwork("base-sepolia").
+			SetDay(time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)).
+			SetFromBlock(100).
+			SetToBlock(200).
+			SetStatus(reconciliationreport.StatusCompleted).
+			SetAddressesChecked(3).
+			SetTransfersChecked(4).
+			SetDiscrepancyCount(1).
+			SaveX(context.Background())
+		client.ReconciliationDiscrepancy.
+			Create().
+			SetReport(report).
+			SetType(reconciliationdiscrepancy.TypeUnknownDeposit).
+			SetTxHash("0xabc").
+			SetAddress("0x3333333333333333333333333333333333333333").
+			SetToken("USDC").
+			SetBlockNumber(150).
+			SetOnchainAmount(decimal.NewFromInt(7)).
+			SetRecordedAmount(decimal.Zero).
+			ExecX(context.Background())
+		client.ReconciliationReport.
+			Create().
+			SetNetwork("base-sepolia").
+			SetDay(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)).
+			SetStatus(reconciliationreport.StatusFailed).
+			SetError("rpc unavailable").
+			ExecX(context.Background())
+
+		t.Run("should list reports by filter", func(t *testing.T) {
+			var list struct {
+				Data types.ReconciliationReportList `json:"data"`
+			}
+			res, err := test.PerformRequest(t, "GET", "/reconciliation-reports?network=base-sepolia", nil, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+			assert.NoError(t, json.Unmarshal(res.Body.Bytes(), &list))
+			assert.Equal(t, 2, list.Data.TotalRecords)
+			assert.Equal(t, "2025-01-02", list.Data.Reports[0].Date)
+
+			res, err = test.PerformRequest(t, "GET", "/reconciliation-reports?discrepancies=true&from=2025-01-02", nil, headers, router)
+			assert.NoError(t, err)
+			assert.NoError(t, json.Unmarshal(res.Body.Bytes(), &list))
+			assert.Equal(t, 1, list.Data.TotalRecords)
+
+			for _, query := range []string{"?status=stuck", "?from=yesterday"} {
+				res, err = test.PerformRequest(t, "GET", "/reconciliation-reports"+query, nil, headers, router)
+				assert.NoError(t, err)
+				assert.Equal(t, http.StatusBadRequest, res.Code, query)
+			}
+		})
+
+		t.Run("should return a report with its discrepancies", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "GET", "/reconciliation-reports/"+report.ID.String(), nil, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+
+			var response struct {
+				Data types.ReconciliationReportResponse `json:"data"`
+			}
+			assert.NoError(t, json.Unmarshal(res.Body.Bytes(), &response))
+			assert.Len(t, response.Data.Discrepancies, 1)
+			assert.Equal(t, "unknown_deposit", response.Data.Discrepancies[0].Type)
+			assert.Nil(t, response.Data.Discrepancies[0].OrderID)
+
+			res, err = test.PerformRequest(t, "GET", "/reconciliation-reports/"+uuid.New().String(), nil, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusNotFound, res.Code)
+		})
+
+		t.Run("should only reconcile days that have ended on known networks", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "POST", "/reconciliation-reports", map[string]interface{}{
+				"network": "base-sepolia",
+				"date":    time.Now().UTC().Format(time.DateOnly),
+			}, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+
+			res, err = test.PerformRequest(t, "POST", "/reconciliation-reports", map[string]interface{}{
+				"network": "unknown",
+				"date":    "2025-01-01",
+			}, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusNotFound, res.Code)
+		})
+	})
+
+	t.Run("UnmatchedDeposits", func(t *testing.T) {
+		headers := map[string]string{"Admin-API-Key": "test-admin-key"}
+
+		token, err := test.CreateERC20Token(nil, map[string]interface{}{
+			"symbol":          "USDC",
+			"identifier":      "base-sepolia",
+			"chainID":         int64(84532),
+			"deployContract":  false,
+			"contractAddress": "0x036CbD53842c5426634e7929541eC2318f3dCF7e",
+		})
+		assert.NoError(t, err)
+		deposit := client.UnmatchedDeposit.
+			Create().
+			SetTxHash("0xd1").
+			SetReceiveAddress("0x3333333333333333333333333333333333333333").
+			SetFromAddress("0x5555555555555555555555555555555555555555").
+			SetAmount(decimal.NewFromInt(7)).
+			SetReason(unmatcheddeposit.ReasonNoOrder).
+			SetToken(token).
+			SaveX(context.Background())
+
+		t.Run("should list deposits by filter", func(t *testing.T) {
+			var list struct {
+				Data types.UnmatchedDepositList `json:"data"`
+			}
+			res, err := test.PerformRequest(t, "GET", "/unmatched-deposits?status=pending&network=base-sepolia", nil, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+			assert.NoError(t, json.Unmarshal(res.Body.Bytes(), &list))
+			assert.Equal(t, 1, list.Data.TotalRecords)
+			assert.Equal(t, "USDC", list.Data.Deposits[0].Token)
+			assert.Equal(t, "no_order", list.Data.Deposits[0].Reason)
+
+			res, err = test.PerformRequest(t, "GET", "/unmatched-deposits?reason=lost", nil, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+		})
+
+		t.Run("should reject actions that can't resolve a deposit", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "POST", "/unmatched-deposits/"+deposit.ID.String()+"/link", map[string]interface{}{
+				"actor":  "ops@example.com",
+				"reason": "payer contacted support",
+			}, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+
+			res, err = test.PerformRequest(t, "POST", "/unmatched-deposits/"+deposit.ID.String()+"/link", map[string]interface{}{
+				"actor":   "ops@example.com",
+				"reason":  "payer contacted support",
+				"orderId": uuid.New().String(),
+			}, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusNotFound, res.Code)
+
+			// No treasury address is configured
+			res, err = test.PerformRequest(t, "POST", "/unmatched-deposits/"+deposit.ID.String()+"/sweep", map[string]interface{}{
+				"actor":  "ops@example.com",
+				"reason": "unclaimed",
+			}, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusConflict, res.Code)
+		})
+	})
+
+	t.Run("Tokens", func(t *testing.T) {
+		headers := map[string]string{"Admin-API-Key": "test-admin-key"}
+		ctx := context.Background()
+
+		// Token lookups of the ordering APIs are cached in Redis until a token changes
+		mr, err := miniredis.Run()
+		assert.NoError(t, err)
+		defer mr.Close()
+		db.RedisClient = redis.NewClient(&redis.Options{Addr: mr.Addr()})
+		defer func() { db.RedisClient = nil }()
+		db.RegisterLookupCacheHooks(client)
+
+		_, err = test.CreateERC20Token(nil, map[string]interface{}{
+			"symbol":          "USDC",
+			"identifier":      "arbitrum-sepolia",
+			"chainID":         int64(421614),
+			"deployContract":  false,
+			"contractAddress": "0x75faf114eafb1BDbe2F0316DF893fd58CE46AA4d",
+		})
+		assert.NoError(t, err)
+
+		t.Run("should reject invalid tokens", func(t *testing.T) {
+			for _, payload := range []map[string]interface{}{
+				{"network": "arbitrum-sepolia", "symbol": "ETH", "contractAddress": u.NativeTokenAddress},
+				{"network": "mars", "symbol": "ETH", "contractAddress": u.NativeTokenAddress, "decimals": 18},
+			} {
+				res, err := test.PerformRequest(t, "POST", "/tokens", payload, headers, router)
+				assert.NoError(t, err)
+				assert.Equal(t, http.StatusBadRequest, res.Code, payload)
+			}
+
+			res, err := test.PerformRequest(t, "POST", "/tokens", map[string]interface{}{
+				"network":         "arbitrum-sepolia",
+				"symbol":          "USDC",
+				"contractAddress": "0x75faf114eafb1BDbe2F0316DF893fd58CE46AA4d",
+				"decimals":        6,
+			}, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusConflict, res.Code)
+		})
+
+		t.Run("should register, enable and disable tokens", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "POST", "/tokens", map[string]interface{}{
+				"network":         "arbitrum-sepolia",
+				"symbol":          "ETH",
+				"contractAddress": u.NativeTokenAddress,
+				"decimals":        18,
+			}, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusCreated, res.Code)
+
+			var created struct {
+				Data types.TokenResponse `json:"data"`
+			}
+			assert.NoError(t, json.Unmarshal(res.Body.Bytes(), &created))
+			assert.Equal(t, "arbitrum-sepolia", created.Data.Network)
+			assert.Equal(t, "USD", created.Data.BaseCurrency)
+			assert.False(t, created.Data.IsEnabled)
+
+			_, err = u.GetEnabledToken(ctx, "arbitrum-sepolia", "ETH")
+			assert.True(t, ent.IsNotFound(err))
+
+			path := fmt.Sprintf("/tokens/%d", created.Data.ID)
+			res, err = test.PerformRequest(t, "PATCH", path, map[string]interface{}{"isEnabled": true}, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+
+			token, err := u.GetEnabledToken(ctx, "arbitrum-sepolia", "ETH")
+			assert.NoError(t, err)
+			assert.Equal(t, created.Data.ID, token.ID)
+			assert.Equal(t, "arbitrum-sepolia", token.Edges.Network.Identifier)
+
+			tokens, err := u.GetEnabledTokens(ctx, "arbitrum-sepolia")
+			assert.NoError(t, err)
+			assert.Len(t, tokens, 2)
+
+			// Disabling the token drops the cached lookups
+			res, err = test.PerformRequest(t, "PATCH", path, map[string]interface{}{"isEnabled": false}, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+
+			_, err = u.GetEnabledToken(ctx, "arbitrum-sepolia", "ETH")
+			assert.True(t, ent.IsNotFound(err))
+
+			tokens, err = u.GetEnabledTokens(ctx, "arbitrum-sepolia")
+			assert.NoError(t, err)
+			assert.Len(t, tokens, 1)
+
+			var list struct {
+				Data []types.TokenResponse `json:"data"`
+			}
+			res, err = test.PerformRequest(t, "GET", "/tokens?network=arbitrum-sepolia&isEnabled=false", nil, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+			assert.NoError(t, json.Unmarshal(res.Body.Bytes(), &list))
+			if assert.Len(t, list.Data, 1) {
+				assert.Equal(t, "ETH", list.Data[0].Symbol)
+			}
+
+			res, err = test.PerformRequest(t, "PATCH", "/tokens/999999", map[string]interface{}{"isEnabled": true}, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusNotFound, res.Code)
+		})
+	})
+
+	t.Run("Networks", func(t *testing.T) {
+		headers := map[string]string{"Admin-API-Key": "test-admin-key"}
+		ctx := context.Background()
+
+		synced := make(chan *ent.Network, 4)
+		previous := syncNetworkWebhooks
+		syncNetworkWebhooks = func(ctx context.Context, network *ent.Network) error {
+			synced <- network
+			return nil
+		}
+		defer func() { syncNetworkWebhooks = previous }()
+
+		payload := map[string]interface{}{
+			"identifier":            "linea-sepolia",
+			"chainId":               59141,
+			"rpcEndpoint":           "https://rpc.sepolia.linea.build",
+			"fallbackRpcEndpoints":  []string{"https://linea-sepolia.drpc.org", "https://linea-sepolia-rpc.publicnode.com"},
+			"explorerUrl":           "https://sepolia.lineascan.build",
+			"alchemyNetworkId":      "LINEA_SEPOLIA",
+			"blockTime":             2,
+			"isTestnet":             true,
+			"requiredConfirmations": 3,
+		}
+
+		t.Run("should reject invalid networks", func(t *testing.T) {
+			for _, invalid := range []map[string]interface{}{
+				{"identifier": "linea-sepolia", "chainId": 59141, "blockTime": 2},
+				{"identifier": "linea-sepolia", "chainId": 59141, "rpcEndpoint": "https://rpc.sepolia.linea.build", "blockTime": 2, "networkType": "cosmos"},
+				{"identifier": "linea-sepolia", "chainId": 59141, "rpcEndpoint": "https://rpc.sepolia.linea.build", "blockTime": 2, "requiredConfirmations": -1},
+				{"identifier": "linea-sepolia", "chainId": 59141, "rpcEndpoint": "rpc.sepolia.linea.build", "blockTime": 2},
+				{"identifier": "linea-sepolia", "chainId": 59141, "rpcEndpoint": "https://rpc.sepolia.linea.build", "fallbackRpcEndpoints": []string{"ftp://linea.example"}, "blockTime": 2},
+			} {
+				res, err := test.PerformRequest(t, "POST", "/networks", invalid, headers, router)
+				assert.NoError(t, err)
+				assert.Equal(t, http.StatusBadRequest, res.Code, invalid)
+			}
+		})
+
+		t.Run("should onboard a network disabled and bootstrap it once enabled", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "POST", "/networks", payload, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusCreated, res.Code)
+
+			var created struct {
+				Data types.NetworkResponse `json:"data"`
+			}
+			assert.NoError(t, json.Unmarshal(res.Body.Bytes(), &created))
+			assert.False(t, created.Data.IsEnabled)
+			assert.Equal(t, "LINEA_SEPOLIA", created.Data.AlchemyNetworkID)
+			assert.Equal(t, "https://sepolia.lineascan.build", created.Data.ExplorerURL)
+			assert.Equal(t, 3, created.Data.RequiredConfirmations)
+			assert.Equal(t, []string{"https://linea-sepolia.drpc.org", "https://linea-sepolia-rpc.publicnode.com"}, created.Data.FallbackRPCEndpoints)
+			assert.Empty(t, synced)
+
+			// The identifier and chain ID are unique
+			res, err = test.PerformRequest(t, "POST", "/networks", payload, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusConflict, res.Code)
+
+			// Tokens of a disabled network can't be ordered in
+			client.Token.
+				Create().
+				SetSymbol("USDC").
+				SetContractAddress("0xFEce4462D57bD51A6A552365A011b95f0E16d9B7").
+				SetDecimals(6).
+				SetNetworkID(created.Data.ID).
+				SetIsEnabled(true).
+				SaveX(ctx)
+			_, err = u.GetEnabledToken(ctx, "linea-sepolia", "USDC")
+			assert.True(t, ent.IsNotFound(err))
+
+			path := fmt.Sprintf("/networks/%d", created.Data.ID)
+			res, err = test.PerformRequest(t, "PATCH", path, map[string]interface{}{"isEnabled": true, "finalityBlocks": 10}, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+
+			select {
+			case network := <-synced:
+				assert.Equal(t, "linea-sepolia", network.Identifier)
+				assert.True(t, network.IsEnabled)
+				assert.Equal(t, 10, network.FinalityBlocks)
+			case <-time.After(5 * time.Second):
+				t.Fatal("network webhooks were not synced")
+			}
+
+			token, err := u.GetEnabledToken(ctx, "linea-sepolia", "USDC")
+			assert.NoError(t, err)
+			assert.Equal(t, "linea-sepolia", token.Edges.Network.Identifier)
+
+			var list struct {
+				Data []types.NetworkResponse `json:"data"`
+			}
+			res, err = test.PerformRequest(t, "GET", "/networks?isEnabled=true", nil, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+			assert.NoError(t, json.Unmarshal(res.Body.Bytes(), &list))
+			identifiers := make([]string, 0, len(list.Data))
+			for _, network := range list.Data {
+				identifiers = append(identifiers, network.Identifier)
+				assert.True(t, network.IsEnabled)
+			}
+			assert.Contains(t, identifiers, "linea-sepolia")
+
+			res, err = test.PerformRequest(t, "PATCH", "/networks/999999", map[string]interface{}{"isEnabled": true}, headers, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusNotFound, res.Code)
+		})
+	})
+}
@@ -0,0 +1,115 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/types"
+	u "github.com/NEDA-LABS/stablenode/utils"
+	"github.com/gin-gonic/gin"
+	gql "github.com/graphql-go/graphql"
+)
+
+// Roles a query runs as. Each field of the schema lists the roles allowed to read it
+type role string
+
+const (
+	roleAdmin    role = "admin"
+	roleSender   role = "sender"
+	roleProvider role = "provider"
+)
+
+// viewer is the caller a query runs for. Sender and provider queries only see their own records
+type viewer struct {
+	role     role
+	sender   *ent.SenderProfile
+	provider *ent.ProviderProfile
+}
+
+// viewerKey is the context key of the viewer of a query
+type viewerKey struct{}
+
+// viewerFrom returns the viewer of a query
+func viewerFrom(ctx context.Context) *viewer {
+	v, _ := ctx.Value(viewerKey{}).(*viewer)
+	if v == nil {
+		return &viewer{}
+	}
+	return v
+}
+
+// can reports whether the viewer has one of the roles
+func (v *viewer) can(roles []role) bool {
+	for _, r := range roles {
+		if v.role == r {
+			return true
+		}
+	}
+	return false
+}
+
+// GraphQLController is a controller type for the read-only GraphQL API
+type GraphQLController struct {
+	schema gql.Schema
+}
+
+// NewGraphQLController creates a new instance of GraphQLController
+func NewGraphQLController() *GraphQLController {
+	schema, err := newSchema()
+	if err != nil {
+		// The schema is static, so this only fails on a programming error
+		panic(fmt.Sprintf("graphql: invalid schema: %v", err))
+	}
+
+	return &GraphQLController{
+		schema: schema,
+	}
+}
+
+// AdminQuery controller runs a GraphQL query with access to every record and field
+func (ctrl *GraphQLController) AdminQuery(ctx *gin.Context) {
+	ctrl.execute(ctx, &viewer{role: roleAdmin})
+}
+
+// SenderQuery controller runs a GraphQL query over the payment orders of the sender
+func (ctrl *GraphQLController) SenderQuery(ctx *gin.Context) {
+	senderCtx, ok := ctx.Get("sender")
+	if !ok || senderCtx == nil {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+
+	ctrl.execute(ctx, &viewer{role: roleSender, sender: senderCtx.(*ent.SenderProfile)})
+}
+
+// ProviderQuery controller runs a GraphQL query over the lock orders of the provider
+func (ctrl *GraphQLController) ProviderQuery(ctx *gin.Context) {
+	providerCtx, ok := ctx.Get("provider")
+	if !ok || providerCtx == nil {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+
+	ctrl.execute(ctx, &viewer{role: roleProvider, provider: providerCtx.(*ent.ProviderProfile)})
+}
+
+// execute runs the query of the request for a viewer. The result is returned as is, so GraphQL
+// clients can read it, with field errors alongside the data that resolved
+func (ctrl *GraphQLController) execute(ctx *gin.Context, v *viewer) {
+	var payload types.GraphQLPayload
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	result := gql.Do(gql.Params{
+		Schema:         ctrl.schema,
+		RequestString:  payload.Query,
+		OperationName:  payload.OperationName,
+		VariableValues: payload.Variables,
+		Context:        context.WithValue(ctx.Request.Context(), viewerKey{}, v),
+	})
+	ctx.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,308 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/test"
+	"github.com/gin-gonic/gin"
+	gql "github.com/graphql-go/graphql"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+// graphqlResponse is the result of a GraphQL query
+type graphqlResponse struct {
+	Data   map[string]interface{} `json:"data"`
+	Errors []struct {
+		Message string        `json:"message"`
+		Path    []interface{} `json:"path"`
+	} `json:"errors"`
+}
+
+func TestGraphQL(t *testing.T) {
+	// Set up test database client, counting the queries it runs
+	var queries atomic.Int64
+	client := enttest.Open(t, "sqlite3", "file:graphql?mode=memory&_fk=1", enttest.WithOptions(
+		ent.Debug(),
+		ent.Log(func(...any) { queries.Add(1) }),
+	))
+	defer client.Close()
+
+	db.Client = client
+	ctx := context.Background()
+
+	token, err := test.CreateERC20Token(nil, map[string]interface{}{
+		"symbol":         "USDC",
+		"identifier":     "base-sepolia",
+		"chainID":        int64(84532),
+		"deployContract": false,
+	})
+	assert.NoError(t, err)
+
+	// Two senders with a payment order each
+	var senders []*ent.SenderProfile
+	var orders []*ent.PaymentOrder
+	for i := 0; i < 2; i++ {
+		user, err := test.CreateTestUser(map[string]interface{}{
+			"email": fmt.Sprintf("sender%d@test.com", i),
+		})
+		assert.NoError(t, err)
+
+		sender, err := test.CreateTestSenderProfile(map[string]interface{}{
+			"user_id": user.ID,
+			"token":   "USDC",
+		})
+		assert.NoError(t, err)
+
+		address, err := client.ReceiveAddress.
+			Create().
+			SetAddress(fmt.Sprintf("0x%040d", i+1)).
+			SetStatus(receiveaddress.StatusUnused).
+			SetNetworkIdentifier("base-sepolia").
+			SetChainID(84532).
+			SetOwnerAddress("0x5555555555555555555555555555555555555555").
+			Save(ctx)
+		assert.NoError(t, err)
+
+		order, err := client.PaymentOrder.
+			Create().
+			SetSenderProfile(sender).
+			SetAmount(decimal.NewFromFloat(100.5)).
+			SetAmountInUsd(decimal.NewFromFloat(100.5)).
+			SetAmountPaid(decimal.Zero).
+			SetAmountReturned(decimal.Zero).
+			SetPercentSettled(decimal.Zero).
+			SetNetworkFee(decimal.Zero).
+			SetProtocolFee(decimal.Zero).
+			SetSenderFee(decimal.Zero).
+			SetToken(token).
+			SetRate(decimal.NewFromFloat(750)).
+			SetReceiveAddress(address).
+			SetReceiveAddressText(address.Address).
+			SetFeePercent(decimal.Zero).
+			SetFeeAddress("0x1234567890123456789012345678901234567890").
+			SetReturnAddress("0x0987654321098765432109876543210987654321").
+			Save(ctx)
+		assert.NoError(t, err)
+
+		senders = append(senders, sender)
+		orders = append(orders, order)
+	}
+
+	// Two providers with a lock order each
+	currency, err := test.CreateTestFiatCurrency(nil)
+	assert.NoError(t, err)
+
+	var providers []*ent.ProviderProfile
+	var lockOrders []*ent.LockPaymentOrder
+	for i := 0; i < 2; i++ {
+		user, err := test.CreateTestUser(map[string]interface{}{
+			"email": fmt.Sprintf("provider%d@test.com", i),
+			"scope": "provider",
+		})
+		assert.NoError(t, err)
+
+		provider, err := test.CreateTestProviderProfile(map[string]interface{}{
+			"user_id":     user.ID,
+			"currency_id": currency.ID,
+		})
+		assert.NoError(t, err)
+
+		lockOrder, err := test.CreateTestLockPaymentOrder(map[string]interface{}{
+			"provider":   provider,
+			"token_id":   token.ID,
+			"gateway_id": fmt.Sprintf("order-%d", i),
+		})
+		assert.NoError(t, err)
+
+		providers = append(providers, provider)
+		lockOrders = append(lockOrders, lockOrder)
+	}
+
+	// Set up test routers, with the sender and provider set as the auth middleware would
+	ctrl := NewGraphQLController()
+	router := gin.New()
+	router.POST("/admin/graphql", ctrl.AdminQuery)
+	router.POST("/sender/graphql", func(ctx *gin.Context) {
+		ctx.Set("sender", senders[0])
+	}, ctrl.SenderQuery)
+	router.POST("/provider/graphql", func(ctx *gin.Context) {
+		ctx.Set("provider", providers[0])
+	}, ctrl.ProviderQuery)
+
+	query := func(t *testing.T, path string, q string) graphqlResponse {
+		res, err := test.PerformRequest(t, "POST", path, map[string]interface{}{"query": q}, nil, router)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var response graphqlResponse
+		assert.NoError(t, json.Unmarshal(res.Body.Bytes(), &response))
+		return response
+	}
+
+	t.Run("should let admins read every record and field", func(t *testing.T) {
+		response := query(t, "/admin/graphql", `{
+			paymentOrders { id senderId receiveAddress { address ownerAddress } token { symbol network { identifier rpcEndpoint } } }
+			lockOrders { id providerId }
+			receiveAddresses(network: "base-sepolia") { address }
+		}`)
+		assert.Empty(t, response.Errors)
+
+		paymentOrders := response.Data["paymentOrders"].([]interface{})
+		assert.Len(t, paymentOrders, 2)
+		// Newest first
+		order := paymentOrders[0].(map[string]interface{})
+		assert.Equal(t, orders[1].ID.String(), order["id"])
+		assert.Equal(t, senders[1].ID.String(), order["senderId"])
+		assert.Equal(t, "0x5555555555555555555555555555555555555555", order["receiveAddress"].(map[string]interface{})["ownerAddress"])
+		network := order["token"].(map[string]interface{})["network"].(map[string]interface{})
+		assert.Equal(t, "base-sepolia", network["identifier"])
+		assert.NotEmpty(t, network["rpcEndpoint"])
+
+		assert.Len(t, response.Data["lockOrders"], 2)
+		assert.Len(t, response.Data["receiveAddresses"], 2)
+	})
+
+	t.Run("should load edges with one query per edge", func(t *testing.T) {
+		queries.Store(0)
+		response := query(t, "/admin/graphql", `{
+			paymentOrders { senderId receiveAddress { address } token { symbol network { identifier } } }
+		}`)
+		assert.Empty(t, response.Errors)
+		assert.Len(t, response.Data["paymentOrders"], 2)
+
+		// Orders, then their tokens, networks, receive addresses and senders
+		assert.Equal(t, int64(5), queries.Load())
+	})
+
+	t.Run("should scope senders to their own payment orders", func(t *testing.T) {
+		response := query(t, "/sender/graphql", `{
+			paymentOrders { id amount status receiveAddress { address } token { symbol network { chainId } } }
+			receiveAddresses { address }
+		}`)
+		assert.Empty(t, response.Errors)
+
+		paymentOrders := response.Data["paymentOrders"].([]interface{})
+		assert.Len(t, paymentOrders, 1)
+		order := paymentOrders[0].(map[string]interface{})
+		assert.Equal(t, orders[0].ID.String(), order["id"])
+		assert.Equal(t, "100.5", order["amount"])
+		assert.Equal(t, "USDC", order["token"].(map[string]interface{})["symbol"])
+
+		receiveAddresses := response.Data["receiveAddresses"].([]interface{})
+		assert.Len(t, receiveAddresses, 1)
+		assert.Equal(t, orders[0].ReceiveAddressText, receiveAddresses[0].(map[string]interface{})["address"])
+
+		// Orders of other senders are not found
+		response = query(t, "/sender/graphql", fmt.Sprintf(`{ paymentOrder(id: "%s") { id } }`, orders[1].ID))
+		assert.Empty(t, response.Errors)
+		assert.Nil(t, response.Data["paymentOrder"])
+	})
+
+	t.Run("should hide admin-only fields and other records from senders", func(t *testing.T) {
+		response := query(t, "/sender/graphql", `{
+			paymentOrders { id senderId receiveAddress { ownerAddress } token { network { rpcEndpoint } } }
+			lockOrders { id }
+		}`)
+
+		var forbidden []string
+		for _, err := range response.Errors {
+			assert.Contains(t, err.Message, "not authorized")
+			forbidden = append(forbidden, fmt.Sprintf("%v", err.Path[len(err.Path)-1]))
+		}
+		assert.ElementsMatch(t, []string{"senderId", "ownerAddress", "rpcEndpoint", "lockOrders"}, forbidden)
+
+		// Readable fields still resolve next to the forbidden ones
+		order := response.Data["paymentOrders"].([]interface{})[0].(map[string]interface{})
+		assert.Equal(t, orders[0].ID.String(), order["id"])
+		assert.Nil(t, order["senderId"])
+		assert.Nil(t, response.Data["lockOrders"])
+	})
+
+	t.Run("should scope providers to their own lock orders", func(t *testing.T) {
+		response := query(t, "/provider/graphql", `{
+			lockOrders(status: "pending") { id gatewayId accountIdentifier token { symbol } }
+			networks { identifier }
+		}`)
+		assert.Empty(t, response.Errors)
+
+		lockOrderList := response.Data["lockOrders"].([]interface{})
+		assert.Len(t, lockOrderList, 1)
+		assert.Equal(t, lockOrders[0].ID.String(), lockOrderList[0].(map[string]interface{})["id"])
+		assert.Equal(t, "1234567890", lockOrderList[0].(map[string]interface{})["accountIdentifier"])
+		assert.Len(t, response.Data["networks"], 1)
+
+		response = query(t, "/provider/graphql", `{ paymentOrders { id } receiveAddresses { address } }`)
+		assert.Len(t, response.Errors, 2)
+		assert.Nil(t, response.Data["paymentOrders"])
+		assert.Nil(t, response.Data["receiveAddresses"])
+	})
+
+	t.Run("should reject invalid filters and mutations", func(t *testing.T) {
+		response := query(t, "/admin/graphql", `{ paymentOrders(status: "unknown") { id } }`)
+		assert.Len(t, response.Errors, 1)
+		assert.Contains(t, response.Errors[0].Message, "invalid status")
+
+		response = query(t, "/admin/graphql", `mutation { paymentOrders { id } }`)
+		assert.NotEmpty(t, response.Errors)
+		assert.Nil(t, response.Data)
+
+		res, err := test.PerformRequest(t, "POST", "/admin/graphql", map[string]interface{}{}, nil, router)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
+
+// hiddenFields are the entity fields deliberately left out of the schema
+var hiddenFields = map[string][]string{
+	// The salt derives the receive address key
+	"ReceiveAddress": {"salt"},
+	// JSON documents of internal bookkeeping
+	"PaymentOrder": {"fiat_conversion", "rate_requote"},
+	"LockOrder":    {"metadata"},
+}
+
+func TestSchemaCoversEntFields(t *testing.T) {
+	schema, err := newSchema()
+	assert.NoError(t, err)
+
+	// Every field of the entities is either exposed, for some role, or hidden on purpose, so
+	// fields added to the ent schema are not silently left out
+	entities := map[string]interface{}{
+		"Network":        ent.Network{},
+		"Token":          ent.Token{},
+		"ReceiveAddress": ent.ReceiveAddress{},
+		"PaymentOrder":   ent.PaymentOrder{},
+		"LockOrder":      ent.LockPaymentOrder{},
+	}
+	for typeName, entity := range entities {
+		exposed := map[string]bool{}
+		for name := range schema.Type(typeName).(*gql.Object).Fields() {
+			exposed[strings.ToLower(name)] = true
+		}
+		for _, name := range hiddenFields[typeName] {
+			exposed[strings.ReplaceAll(name, "_", "")] = true
+		}
+
+		entityType := reflect.TypeOf(entity)
+		for i := 0; i < entityType.NumField(); i++ {
+			tag := strings.Split(entityType.Field(i).Tag.Get("json"), ",")[0]
+			if tag == "" || tag == "-" || tag == "edges" {
+				continue
+			}
+			assert.True(t, exposed[strings.ReplaceAll(tag, "_", "")], "%s.%s is neither exposed nor hidden", typeName, tag)
+		}
+	}
+}
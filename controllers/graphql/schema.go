@@ -0,0 +1,489 @@
+package graphql
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/lockpaymentorder"
+	networkEnt "github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/providerprofile"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	"github.com/NEDA-LABS/stablenode/ent/senderprofile"
+	tokenEnt "github.com/NEDA-LABS/stablenode/ent/token"
+	"github.com/NEDA-LABS/stablenode/storage"
+	"github.com/google/uuid"
+	gql "github.com/graphql-go/graphql"
+)
+
+// errForbidden is returned for fields the viewer is not allowed to read
+var errForbidden = errors.New("not authorized")
+
+// Page sizes of list queries
+const (
+	defaultLimit = 20
+	maxLimit     = 100
+)
+
+// authorize restricts a field to viewers with one of the roles. Fields without roles are readable
+// by every viewer
+func authorize(field *gql.Field, roles ...role) *gql.Field {
+	if len(roles) == 0 {
+		return field
+	}
+
+	resolve := field.Resolve
+	if resolve == nil {
+		resolve = gql.DefaultResolveFn
+	}
+	field.Resolve = func(p gql.ResolveParams) (interface{}, error) {
+		if !viewerFrom(p.Context).can(roles) {
+			return nil, fmt.Errorf("%w to read %s.%s", errForbidden, p.Info.ParentType.Name(), p.Info.FieldName)
+		}
+		return resolve(p)
+	}
+	return field
+}
+
+// field is a field read from the entity field of the same name
+func field(fieldType gql.Output, roles ...role) *gql.Field {
+	return authorize(&gql.Field{Type: fieldType}, roles...)
+}
+
+// timeField is a timestamp read from the entity field of the same name, null while it is unset
+func timeField(roles ...role) *gql.Field {
+	return authorize(&gql.Field{
+		Type: gql.DateTime,
+		Resolve: func(p gql.ResolveParams) (interface{}, error) {
+			value, err := gql.DefaultResolveFn(p)
+			if t, ok := value.(time.Time); ok && t.IsZero() {
+				return nil, err
+			}
+			return value, err
+		},
+	}, roles...)
+}
+
+// edge returns an edge of an entity, or null if the edge is not set. Edges are eager-loaded by the
+// queries of the schema, so a page of records costs one query per edge rather than per record
+func edge[T any](result T, err error) (interface{}, error) {
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// listArgs are the filters and paging of list queries
+var listArgs = gql.FieldConfigArgument{
+	"status":  &gql.ArgumentConfig{Type: gql.String},
+	"network": &gql.ArgumentConfig{Type: gql.String, Description: "Network identifier, e.g. base-sepolia"},
+	"limit":   &gql.ArgumentConfig{Type: gql.Int, DefaultValue: defaultLimit},
+	"offset":  &gql.ArgumentConfig{Type: gql.Int, DefaultValue: 0},
+}
+
+// idArgs select a record by ID
+var idArgs = gql.FieldConfigArgument{
+	"id": &gql.ArgumentConfig{Type: gql.NewNonNull(gql.ID)},
+}
+
+// page returns the page of a list query, capping its size
+func page(p gql.ResolveParams) (limit int, offset int) {
+	limit, _ = p.Args["limit"].(int)
+	offset, _ = p.Args["offset"].(int)
+	if limit < 1 {
+		limit = defaultLimit
+	}
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return limit, offset
+}
+
+// newSchema builds the read-only schema over payment orders, lock orders, receive addresses,
+// networks and tokens. Connection details and internal bookkeeping are admin-only
+func newSchema() (gql.Schema, error) {
+	networkType := gql.NewObject(gql.ObjectConfig{
+		Name: "Network",
+		Fields: gql.Fields{
+			"id":                       field(gql.NewNonNull(gql.Int)),
+			"chainId":                  field(gql.NewNonNull(gql.Int)),
+			"identifier":               field(gql.NewNonNull(gql.String)),
+			"networkType":              field(gql.NewNonNull(gql.String)),
+			"gatewayContractAddress":   field(gql.String),
+			"explorerUrl":              field(gql.String),
+			"blockTime":                field(gql.String),
+			"isTestnet":                field(gql.NewNonNull(gql.Boolean)),
+			"fee":                      field(gql.String),
+			"requiredConfirmations":    field(gql.Int),
+			"finalityBlocks":           field(gql.Int),
+			"settlementPolicy":         field(gql.String),
+			"orderTtlMinutes":          field(gql.Int),
+			"isEnabled":                field(gql.NewNonNull(gql.Boolean)),
+			"rpcEndpoint":              field(gql.String, roleAdmin),
+			"wssEndpoint":              field(gql.String, roleAdmin),
+			"bundlerUrl":               field(gql.String, roleAdmin),
+			"paymasterUrl":             field(gql.String, roleAdmin),
+			"alchemyNetworkId":         field(gql.String, roleAdmin),
+			"smartAccountOwnerAddress": field(gql.String, roleAdmin),
+			"genesisHash":              field(gql.String, roleAdmin),
+			"genesisMismatch":          field(gql.Boolean, roleAdmin),
+			"smartAccountOwnerSigner":  field(gql.String, roleAdmin),
+			"webhooksEnabled":          field(gql.Boolean, roleAdmin),
+			"websocketEnabled":         field(gql.Boolean, roleAdmin),
+			"pollingEnabled":           field(gql.Boolean, roleAdmin),
+			"createdAt":                timeField(),
+			"updatedAt":                timeField(),
+		},
+	})
+
+	tokenType := gql.NewObject(gql.ObjectConfig{
+		Name: "Token",
+		Fields: gql.Fields{
+			"id":              field(gql.NewNonNull(gql.Int)),
+			"symbol":          field(gql.NewNonNull(gql.String)),
+			"contractAddress": field(gql.NewNonNull(gql.String)),
+			"decimals":        field(gql.NewNonNull(gql.Int)),
+			"isEnabled":       field(gql.NewNonNull(gql.Boolean)),
+			"baseCurrency":    field(gql.String),
+			"usdPrice":        field(gql.String),
+			"depeggedAt":      field(gql.DateTime),
+			"createdAt":       timeField(),
+			"updatedAt":       timeField(),
+			"network": &gql.Field{
+				Type: networkType,
+				Resolve: func(p gql.ResolveParams) (interface{}, error) {
+					return edge(p.Source.(*ent.Token).Edges.NetworkOrErr())
+				},
+			},
+		},
+	})
+
+	receiveAddressType := gql.NewObject(gql.ObjectConfig{
+		Name: "ReceiveAddress",
+		Fields: gql.Fields{
+			"id":                    field(gql.NewNonNull(gql.Int)),
+			"address":               field(gql.NewNonNull(gql.String)),
+			"status":                field(gql.NewNonNull(gql.String)),
+			"networkIdentifier":     field(gql.String),
+			"chainId":               field(gql.Int),
+			"isDeployed":            field(gql.Boolean),
+			"deployedAt":            timeField(),
+			"validUntil":            timeField(),
+			"assignedAt":            timeField(),
+			"txHash":                field(gql.String),
+			"timesUsed":             field(gql.Int, roleAdmin),
+			"ownerAddress":          field(gql.String, roleAdmin),
+			"generationFingerprint": field(gql.String, roleAdmin),
+			"lastIndexedBlock":      field(gql.Int, roleAdmin),
+			"deploymentBlock":       field(gql.Int, roleAdmin),
+			"deploymentTxHash":      field(gql.String, roleAdmin),
+			"saltDerivation":        field(gql.String, roleAdmin),
+			"derivationIndex":       field(gql.Int, roleAdmin),
+			"lastUsed":              timeField(roleAdmin),
+			"recycledAt":            timeField(roleAdmin),
+			"createdAt":             timeField(),
+			"updatedAt":             timeField(),
+		},
+	})
+
+	paymentOrderType := gql.NewObject(gql.ObjectConfig{
+		Name: "PaymentOrder",
+		Fields: gql.Fields{
+			"id":                    field(gql.NewNonNull(gql.ID)),
+			"status":                field(gql.NewNonNull(gql.String)),
+			"amount":                field(gql.NewNonNull(gql.String)),
+			"amountPaid":            field(gql.String),
+			"amountReturned":        field(gql.String),
+			"amountOverpaid":        field(gql.String),
+			"amountInUsd":           field(gql.String),
+			"percentSettled":        field(gql.String),
+			"senderFee":             field(gql.String),
+			"feePercent":            field(gql.String),
+			"feeAddress":            field(gql.String),
+			"networkFee":            field(gql.String),
+			"protocolFee":           field(gql.String),
+			"rate":                  field(gql.String),
+			"rateLockedUntil":       timeField(),
+			"txHash":                field(gql.String),
+			"blockNumber":           field(gql.Int),
+			"fromAddress":           field(gql.String),
+			"returnAddress":         field(gql.String),
+			"receiveAddressText":    field(gql.String),
+			"reference":             field(gql.String),
+			"depositStatus":         field(gql.String),
+			"requiredConfirmations": field(gql.Int),
+			"depositFinalizedAt":    timeField(),
+			"settlementPolicy":      field(gql.String),
+			"fiatAmount":            field(gql.String),
+			"fiatCurrency":          field(gql.String),
+			"createdAt":             timeField(),
+			"updatedAt":             timeField(),
+			"gatewayId":             field(gql.String, roleAdmin),
+			"messageHash":           field(gql.String, roleAdmin),
+			"rateDriftTolerance":    field(gql.String, roleAdmin),
+			"reviewReason":          field(gql.String, roleAdmin),
+			"quarantineReason":      field(gql.String, roleAdmin),
+			"blockchainService":     field(gql.String, roleAdmin),
+			"slaBreachedAt":         timeField(roleAdmin),
+			"senderId": authorize(&gql.Field{
+				Type: gql.ID,
+				Resolve: func(p gql.ResolveParams) (interface{}, error) {
+					sender, err := p.Source.(*ent.PaymentOrder).Edges.SenderProfileOrErr()
+					if err != nil {
+						return edge(sender, err)
+					}
+					return sender.ID, nil
+				},
+			}, roleAdmin),
+			"token": &gql.Field{
+				Type: tokenType,
+				Resolve: func(p gql.ResolveParams) (interface{}, error) {
+					return edge(p.Source.(*ent.PaymentOrder).Edges.TokenOrErr())
+				},
+			},
+			"receiveAddress": &gql.Field{
+				Type: receiveAddressType,
+				Resolve: func(p gql.ResolveParams) (interface{}, error) {
+					return edge(p.Source.(*ent.PaymentOrder).Edges.ReceiveAddressOrErr())
+				},
+			},
+		},
+	})
+
+	lockOrderType := gql.NewObject(gql.ObjectConfig{
+		Name: "LockOrder",
+		Fields: gql.Fields{
+			"id":                  field(gql.NewNonNull(gql.ID)),
+			"gatewayId":           field(gql.NewNonNull(gql.String)),
+			"status":              field(gql.NewNonNull(gql.String)),
+			"amount":              field(gql.NewNonNull(gql.String)),
+			"amountInUsd":         field(gql.String),
+			"protocolFee":         field(gql.String),
+			"rate":                field(gql.String),
+			"orderPercent":        field(gql.String),
+			"splitIndex":          field(gql.Int),
+			"txHash":              field(gql.String),
+			"blockNumber":         field(gql.Int),
+			"institution":         field(gql.String),
+			"accountIdentifier":   field(gql.String),
+			"accountName":         field(gql.String),
+			"memo":                field(gql.String),
+			"cancellationCount":   field(gql.Int),
+			"cancellationReasons": field(gql.NewList(gql.String)),
+			"createdAt":           timeField(),
+			"updatedAt":           timeField(),
+			"sender":              field(gql.String, roleAdmin),
+			"messageHash":         field(gql.String, roleAdmin),
+			"reviewReason":        field(gql.String, roleAdmin),
+			"slaBreachedStage":    field(gql.String, roleAdmin),
+			"slaBreachedAt":       timeField(roleAdmin),
+			"providerId": authorize(&gql.Field{
+				Type: gql.ID,
+				Resolve: func(p gql.ResolveParams) (interface{}, error) {
+					provider, err := p.Source.(*ent.LockPaymentOrder).Edges.ProviderOrErr()
+					if err != nil {
+						return edge(provider, err)
+					}
+					return provider.ID, nil
+				},
+			}, roleAdmin),
+			"token": &gql.Field{
+				Type: tokenType,
+				Resolve: func(p gql.ResolveParams) (interface{}, error) {
+					return edge(p.Source.(*ent.LockPaymentOrder).Edges.TokenOrErr())
+				},
+			},
+		},
+	})
+
+	queryType := gql.NewObject(gql.ObjectConfig{
+		Name: "Query",
+		Fields: gql.Fields{
+			"paymentOrders": authorize(&gql.Field{
+				Type:    gql.NewList(paymentOrderType),
+				Args:    listArgs,
+				Resolve: resolvePaymentOrders,
+			}, roleAdmin, roleSender),
+			"paymentOrder": authorize(&gql.Field{
+				Type:    paymentOrderType,
+				Args:    idArgs,
+				Resolve: resolvePaymentOrder,
+			}, roleAdmin, roleSender),
+			"lockOrders": authorize(&gql.Field{
+				Type:    gql.NewList(lockOrderType),
+				Args:    listArgs,
+				Resolve: resolveLockOrders,
+			}, roleAdmin, roleProvider),
+			"lockOrder": authorize(&gql.Field{
+				Type:    lockOrderType,
+				Args:    idArgs,
+				Resolve: resolveLockOrder,
+			}, roleAdmin, roleProvider),
+			"receiveAddresses": authorize(&gql.Field{
+				Type:    gql.NewList(receiveAddressType),
+				Args:    listArgs,
+				Resolve: resolveReceiveAddresses,
+			}, roleAdmin, roleSender),
+			"networks": &gql.Field{
+				Type:    gql.NewList(networkType),
+				Resolve: resolveNetworks,
+			},
+			"tokens": &gql.Field{
+				Type: gql.NewList(tokenType),
+				Args: gql.FieldConfigArgument{
+					"network": &gql.ArgumentConfig{Type: gql.String, Description: "Network identifier, e.g. base-sepolia"},
+				},
+				Resolve: resolveTokens,
+			},
+		},
+	})
+
+	return gql.NewSchema(gql.SchemaConfig{Query: queryType})
+}
+
+// paymentOrderQuery returns the payment orders the viewer can read, with their edges
+func paymentOrderQuery(p gql.ResolveParams) *ent.PaymentOrderQuery {
+	query := storage.Client.PaymentOrder.
+		Query().
+		WithToken(func(tq *ent.TokenQuery) {
+			tq.WithNetwork()
+		}).
+		WithReceiveAddress().
+		WithSenderProfile(func(sq *ent.SenderProfileQuery) {
+			sq.Select(senderprofile.FieldID)
+		})
+	if v := viewerFrom(p.Context); v.role == roleSender {
+		query = query.Where(paymentorder.HasSenderProfileWith(senderprofile.IDEQ(v.sender.ID)))
+	}
+	return query
+}
+
+// resolvePaymentOrders lists payment orders, newest first
+func resolvePaymentOrders(p gql.ResolveParams) (interface{}, error) {
+	query := paymentOrderQuery(p)
+	if status, ok := p.Args["status"].(string); ok {
+		if err := paymentorder.StatusValidator(paymentorder.Status(status)); err != nil {
+			return nil, fmt.Errorf("invalid status %q", status)
+		}
+		query = query.Where(paymentorder.StatusEQ(paymentorder.Status(status)))
+	}
+	if network, ok := p.Args["network"].(string); ok {
+		query = query.Where(paymentorder.HasTokenWith(tokenEnt.HasNetworkWith(networkEnt.IdentifierEQ(network))))
+	}
+
+	limit, offset := page(p)
+	return query.
+		Order(ent.Desc(paymentorder.FieldCreatedAt)).
+		Limit(limit).
+		Offset(offset).
+		All(p.Context)
+}
+
+// resolvePaymentOrder fetches a payment order by ID
+func resolvePaymentOrder(p gql.ResolveParams) (interface{}, error) {
+	id, err := uuid.Parse(p.Args["id"].(string))
+	if err != nil {
+		return nil, fmt.Errorf("invalid payment order ID")
+	}
+	return edge(paymentOrderQuery(p).Where(paymentorder.IDEQ(id)).Only(p.Context))
+}
+
+// lockOrderQuery returns the lock orders the viewer can read, with their edges
+func lockOrderQuery(p gql.ResolveParams) *ent.LockPaymentOrderQuery {
+	query := storage.Client.LockPaymentOrder.
+		Query().
+		WithToken(func(tq *ent.TokenQuery) {
+			tq.WithNetwork()
+		}).
+		WithProvider(func(pq *ent.ProviderProfileQuery) {
+			pq.Select(providerprofile.FieldID)
+		})
+	if v := viewerFrom(p.Context); v.role == roleProvider {
+		query = query.Where(lockpaymentorder.HasProviderWith(providerprofile.IDEQ(v.provider.ID)))
+	}
+	return query
+}
+
+// resolveLockOrders lists lock orders, newest first
+func resolveLockOrders(p gql.ResolveParams) (interface{}, error) {
+	query := lockOrderQuery(p)
+	if status, ok := p.Args["status"].(string); ok {
+		if err := lockpaymentorder.StatusValidator(lockpaymentorder.Status(status)); err != nil {
+			return nil, fmt.Errorf("invalid status %q", status)
+		}
+		query = query.Where(lockpaymentorder.StatusEQ(lockpaymentorder.Status(status)))
+	}
+	if network, ok := p.Args["network"].(string); ok {
+		query = query.Where(lockpaymentorder.HasTokenWith(tokenEnt.HasNetworkWith(networkEnt.IdentifierEQ(network))))
+	}
+
+	limit, offset := page(p)
+	return query.
+		Order(ent.Desc(lockpaymentorder.FieldCreatedAt)).
+		Limit(limit).
+		Offset(offset).
+		All(p.Context)
+}
+
+// resolveLockOrder fetches a lock order by ID
+func resolveLockOrder(p gql.ResolveParams) (interface{}, error) {
+	id, err := uuid.Parse(p.Args["id"].(string))
+	if err != nil {
+		return nil, fmt.Errorf("invalid lock order ID")
+	}
+	return edge(lockOrderQuery(p).Where(lockpaymentorder.IDEQ(id)).Only(p.Context))
+}
+
+// resolveReceiveAddresses lists receive addresses, newest first. Senders only see the receive
+// addresses of their payment orders
+func resolveReceiveAddresses(p gql.ResolveParams) (interface{}, error) {
+	query := storage.Client.ReceiveAddress.Query()
+	if v := viewerFrom(p.Context); v.role == roleSender {
+		query = query.Where(receiveaddress.HasPaymentOrderWith(paymentorder.HasSenderProfileWith(senderprofile.IDEQ(v.sender.ID))))
+	}
+	if status, ok := p.Args["status"].(string); ok {
+		if err := receiveaddress.StatusValidator(receiveaddress.Status(status)); err != nil {
+			return nil, fmt.Errorf("invalid status %q", status)
+		}
+		query = query.Where(receiveaddress.StatusEQ(receiveaddress.Status(status)))
+	}
+	if network, ok := p.Args["network"].(string); ok {
+		query = query.Where(receiveaddress.NetworkIdentifierEQ(network))
+	}
+
+	limit, offset := page(p)
+	return query.
+		Order(ent.Desc(receiveaddress.FieldCreatedAt)).
+		Limit(limit).
+		Offset(offset).
+		All(p.Context)
+}
+
+// resolveNetworks lists networks. Only admins see disabled networks
+func resolveNetworks(p gql.ResolveParams) (interface{}, error) {
+	query := storage.Client.Network.Query()
+	if viewerFrom(p.Context).role != roleAdmin {
+		query = query.Where(networkEnt.IsEnabled(true))
+	}
+	return query.Order(ent.Asc(networkEnt.FieldChainID)).All(p.Context)
+}
+
+// resolveTokens lists tokens. Only admins see disabled tokens
+func resolveTokens(p gql.ResolveParams) (interface{}, error) {
+	query := storage.Client.Token.Query().WithNetwork()
+	if viewerFrom(p.Context).role != roleAdmin {
+		query = query.Where(tokenEnt.IsEnabled(true), tokenEnt.HasNetworkWith(networkEnt.IsEnabled(true)))
+	}
+	if network, ok := p.Args["network"].(string); ok {
+		query = query.Where(tokenEnt.HasNetworkWith(networkEnt.IdentifierEQ(network)))
+	}
+	return query.Order(ent.Asc(tokenEnt.FieldSymbol)).All(p.Context)
+}
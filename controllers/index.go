@@ -35,15 +35,18 @@ import (
 	svc "github.com/NEDA-LABS/stablenode/services"
 	"github.com/NEDA-LABS/stablenode/services/common"
 	"github.com/NEDA-LABS/stablenode/services/email"
+	"github.com/NEDA-LABS/stablenode/services/events"
+	"github.com/NEDA-LABS/stablenode/services/health"
 	"github.com/NEDA-LABS/stablenode/services/indexer"
 	kycErrors "github.com/NEDA-LABS/stablenode/services/kyc/errors"
 	"github.com/NEDA-LABS/stablenode/services/kyc/smile"
 	orderSvc "github.com/NEDA-LABS/stablenode/services/order"
 	"github.com/NEDA-LABS/stablenode/storage"
 	"github.com/NEDA-LABS/stablenode/types"
-	"github.com/NEDA-LABS/stablenode/utils"
 	u "github.com/NEDA-LABS/stablenode/utils"
 	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/NEDA-LABS/stablenode/utils/metrics"
+	"github.com/NEDA-LABS/stablenode/utils/ratelimit"
 	"github.com/shopspring/decimal"
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
@@ -65,6 +68,7 @@ type Controller struct {
 	kycService            types.KYCProvider
 	slackService          *svc.SlackService
 	emailService          email.EmailServiceInterface
+	healthChecker         *health.Checker
 	cache                 map[string]bool
 	processedActions      map[string]bool
 	actionMutex           sync.RWMutex
@@ -79,6 +83,7 @@ func NewController() *Controller {
 		kycService:            smile.NewSmileIDService(),
 		slackService:          svc.NewSlackService(serverConf.SlackWebhookURL),
 		emailService:          email.NewEmailServiceWithProviders(),
+		healthChecker:         health.NewChecker(svc.NewServiceManager()),
 		cache:                 make(map[string]bool),
 		processedActions:      make(map[string]bool),
 	}
@@ -186,13 +191,7 @@ func (ctrl *Controller) GetTokenRate(ctx *gin.Context) {
 		return
 	}
 
-	currency, err := storage.Client.FiatCurrency.
-		Query().
-		Where(
-			fiatcurrency.IsEnabledEQ(true),
-			fiatcurrency.CodeEQ(strings.ToUpper(ctx.Param("fiat"))),
-		).
-		Only(ctx)
+	currency, err := u.GetFiatCurrencyByCode(ctx, strings.ToUpper(ctx.Param("fiat")))
 	if err != nil {
 		if ent.IsNotFound(err) {
 			u.APIResponse(ctx, http.StatusBadRequest, "error", fmt.Sprintf("Fiat currency %s is not supported", strings.ToUpper(ctx.Param("fiat"))), nil)
@@ -237,23 +236,10 @@ func (ctrl *Controller) GetTokenRate(ctx *gin.Context) {
 // GetSupportedTokens controller fetches supported cryptocurrency tokens
 func (ctrl *Controller) GetSupportedTokens(ctx *gin.Context) {
 	// Get network filter from query parameter
-	networkFilter := ctx.Query("network")
-
-	// Build query
-	query := storage.Client.Token.
-		Query().
-		Where(tokenEnt.IsEnabled(true)).
-		WithNetwork()
-
-	// Apply network filter if provided
-	if networkFilter != "" {
-		query = query.Where(tokenEnt.HasNetworkWith(
-			networkent.Identifier(strings.ToLower(networkFilter)),
-		))
-	}
+	networkFilter := strings.ToLower(ctx.Query("network"))
 
-	// Execute query
-	tokens, err := query.All(ctx)
+	// Enabled tokens are served from the lookup cache until a token or network changes
+	tokens, err := u.GetEnabledTokens(ctx, networkFilter)
 	if err != nil {
 		logger.Errorf("Error: Failed to fetch tokens: error: %v", err)
 		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch tokens", nil)
@@ -369,6 +355,11 @@ func (ctrl *Controller) GetLockPaymentOrderStatus(ctx *gin.Context) {
 			}
 		}
 
+		// The parent of a split order is reported through its parts
+		if order.Status == lockpaymentorder.StatusSplit {
+			continue
+		}
+
 		settlements = append(settlements, types.LockPaymentOrderSplitOrder{
 			SplitOrderID: order.ID,
 			Amount:       order.Amount,
@@ -392,7 +383,7 @@ func (ctrl *Controller) GetLockPaymentOrderStatus(ctx *gin.Context) {
 	}
 
 	status := orders[0].Status
-	if status == lockpaymentorder.StatusCancelled {
+	if status == lockpaymentorder.StatusCancelled || status == lockpaymentorder.StatusSplit {
 		status = lockpaymentorder.StatusProcessing
 	}
 
@@ -468,17 +459,29 @@ func (ctrl *Controller) CreateLinkedAddress(ctx *gin.Context) {
 	})
 }
 
-// GetLinkedAddress controller fetches a linked address
+// GetLinkedAddress controller fetches a linked address by owner address or vanity handle
 func (ctrl *Controller) GetLinkedAddress(ctx *gin.Context) {
 	// Get owner address from the URL
 	owner_address := ctx.Query("owner_address")
 
-	linkedAddress, err := storage.Client.LinkedAddress.
+	linkedAddressQuery := storage.Client.LinkedAddress.
 		Query().
 		Where(
 			linkedaddress.OwnerAddressEQ(owner_address),
-		).
-		Only(ctx)
+		)
+
+	// Resolve a vanity handle to the active address it names
+	handle := ctx.Query("handle")
+	if handle != "" {
+		linkedAddressQuery = storage.Client.LinkedAddress.
+			Query().
+			Where(
+				linkedaddress.HandleEQ(strings.ToLower(handle)),
+				linkedaddress.IsActiveEQ(true),
+			)
+	}
+
+	linkedAddress, err := linkedAddressQuery.Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			u.APIResponse(ctx, http.StatusNotFound, "error", "Linked address not found", nil)
@@ -487,6 +490,7 @@ func (ctrl *Controller) GetLinkedAddress(ctx *gin.Context) {
 			logger.WithFields(logger.Fields{
 				"Error":        fmt.Sprintf("%v", err),
 				"OwnerAddress": owner_address,
+				"Handle":       handle,
 			}).Errorf("Failed to fetch linked address")
 			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch linked address", nil)
 			return
@@ -512,6 +516,7 @@ func (ctrl *Controller) GetLinkedAddress(ctx *gin.Context) {
 
 	response := &types.LinkedAddressResponse{
 		LinkedAddress: linkedAddress.Address,
+		Handle:        linkedAddress.Handle,
 		Currency:      institution.Edges.FiatCurrency.Code,
 	}
 
@@ -1913,34 +1918,28 @@ func (ctrl *Controller) handleNewEvent(ctx *gin.Context, event types.ThirdwebWeb
 		"NonIndexedParams": event.Data.Decoded.NonIndexedParams,
 	}).Infof("Processing webhook event")
 
-	switch eventSignature {
-	case utils.TransferEventSignature:
+	// The event registry names the event of a known signature, otherwise the decoded name is used
+	eventName := event.Data.Decoded.Name
+	if name, ok := events.Default().Name(ethcommon.HexToHash(eventSignature)); ok {
+		eventName = name
+	}
+
+	switch eventName {
+	case "Transfer":
 		return ctrl.handleTransferEvent(ctx, event)
-	case utils.OrderCreatedEventSignature:
+	case "OrderCreated":
 		return ctrl.handleOrderCreatedEvent(ctx, event)
-	case utils.OrderSettledEventSignature:
+	case "OrderSettled":
 		return ctrl.handleOrderSettledEvent(ctx, event)
-	case utils.OrderRefundedEventSignature:
+	case "OrderRefunded":
 		return ctrl.handleOrderRefundedEvent(ctx, event)
 	default:
-		// Fallback to using decoded name if signature doesn't match
-		switch event.Data.Decoded.Name {
-		case "Transfer":
-			return ctrl.handleTransferEvent(ctx, event)
-		case "OrderCreated":
-			return ctrl.handleOrderCreatedEvent(ctx, event)
-		case "OrderSettled":
-			return ctrl.handleOrderSettledEvent(ctx, event)
-		case "OrderRefunded":
-			return ctrl.handleOrderRefundedEvent(ctx, event)
-		default:
-			logger.WithFields(logger.Fields{
-				"EventSignature": eventSignature,
-				"EventName":      event.Data.Decoded.Name,
-				"Event":          event,
-			}).Errorf("Error: InsightWebhook: Unknown event type")
-			return nil
-		}
+		logger.WithFields(logger.Fields{
+			"EventSignature": eventSignature,
+			"EventName":      event.Data.Decoded.Name,
+			"Event":          event,
+		}).Errorf("Error: InsightWebhook: Unknown event type")
+		return nil
 	}
 }
 
@@ -1967,6 +1966,7 @@ func (ctrl *Controller) handleTransferEvent(ctx *gin.Context, event types.Thirdw
 			tokenEnt.ContractAddressEqualFold(event.Data.Address),
 			tokenEnt.HasNetworkWith(
 				networkent.ChainIDEQ(chainID),
+				networkent.GenesisMismatchEQ(false),
 			),
 		).
 		WithNetwork().
@@ -1996,14 +1996,246 @@ func (ctrl *Controller) handleTransferEvent(ctx *gin.Context, event types.Thirdw
 
 	// Create transfer event
 	transferEvent := &types.TokenTransferEvent{
-		BlockNumber: event.Data.BlockNumber,
-		TxHash:      event.Data.TransactionHash,
+		BlockNumber:    event.Data.BlockNumber,
+		BlockTimestamp: event.Data.BlockTimestamp,
+		TxHash:         event.Data.TransactionHash,
+		From:           fromAddress,
+		To:             toAddress,
+		Value:          transferValue.Div(decimal.NewFromInt(10).Pow(decimal.NewFromInt(int64(token.Decimals)))),
+	}
+
+	// Process transfer using existing logic
+	addressToEvent := map[string]*types.TokenTransferEvent{
+		toAddress: transferEvent,
+	}
+
+	err = common.ProcessTransfers(ctx, ctrl.orderService, ctrl.priorityQueueService, []string{toAddress}, addressToEvent, token)
+	if err != nil {
+		return fmt.Errorf("failed to process transfer: %w", err)
+	}
+	metrics.PaymentDetected(metrics.SourceWebhook, token.Edges.Network.Identifier)
+
+	return nil
+}
+
+// AlchemyWebhook handles Alchemy Address Activity webhooks for receive addresses, covering both
+// ERC-20 transfers and native value transfers ("external" category)
+func (ctrl *Controller) AlchemyWebhook(ctx *gin.Context) {
+	rawBody, err := ctx.GetRawData()
+	if err != nil {
+		logger.Errorf("Error: AlchemyWebhook: Failed to read webhook payload: %v", err)
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid payload"})
+		return
+	}
+
+	if ctx.GetHeader("x-alchemy-signature") == "" {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Missing required headers"})
+		return
+	}
+
+	var webhookPayload types.AlchemyWebhookPayload
+	if err := json.Unmarshal(rawBody, &webhookPayload); err != nil {
+		logger.Errorf("Error: AlchemyWebhook: Failed to parse webhook payload: %v", err)
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid payload format"})
+		return
+	}
+
+	// Alchemy signs the raw body with the webhook signing key
+	provider, _ := svc.NewInboundWebhookProvider(paymentwebhook.ProviderAlchemy, nil)
+	webhook, err := storage.Client.PaymentWebhook.
+		Query().
+		Where(paymentwebhook.WebhookIDEQ(webhookPayload.WebhookID)).
+		First(ctx)
+	if err == nil {
+		err = provider.Authenticate(ctx.Request.Header, rawBody, webhook.WebhookSecret)
+	}
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":     err,
+			"WebhookID": webhookPayload.WebhookID,
+		}).Errorf("Error: AlchemyWebhook: Invalid signature")
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid signature"})
+		return
+	}
+
+	deposits, err := provider.ParseDeposits(rawBody)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":   err,
+			"Network": webhookPayload.Event.Network,
+		}).Errorf("Error: AlchemyWebhook: Unknown network")
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Unsupported network"})
+		return
+	}
+
+	// Deposits are handled within a latency budget so the delivery is answered before Alchemy
+	// times out; work over budget is left to the reconciliation tasks
+	depositCtx := common.WithLatencyBudget(ctx.Request.Context(), config.WebhookLatencyConfig())
+	depositCtx = ratelimit.WithPriority(depositCtx, ratelimit.PriorityWebhookVerification)
+	for i, deposit := range deposits {
+		if common.BudgetExhausted(depositCtx) {
+			logger.WithFields(logger.Fields{
+				"WebhookID": webhookPayload.WebhookID,
+				"Deferred":  len(deposits) - i,
+			}).Warnf("AlchemyWebhook: latency budget exhausted, deferring remaining deposits")
+			break
+		}
+
+		if err := ctrl.handleInboundDeposit(depositCtx, deposit); err != nil {
+			logger.WithFields(logger.Fields{
+				"Error":  err,
+				"TxHash": deposit.TxHash,
+			}).Errorf("Error: AlchemyWebhook: Failed to handle activity")
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Webhook processed successfully"})
+}
+
+// NotifyWebhook handles deposit webhooks of the Notify provider set on the webhook record named in the
+// path (Alchemy, QuickNode or Moralis). The record's provider selects how the delivery is
+// authenticated and how its payload is parsed
+func (ctrl *Controller) NotifyWebhook(ctx *gin.Context) {
+	webhookID := ctx.Param("webhook_id")
+
+	rawBody, err := ctx.GetRawData()
+	if err != nil {
+		logger.Errorf("Error: NotifyWebhook: Failed to read webhook payload: %v", err)
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid payload"})
+		return
+	}
+
+	webhook, err := storage.Client.PaymentWebhook.
+		Query().
+		Where(paymentwebhook.WebhookIDEQ(webhookID)).
+		WithNetwork(func(nq *ent.NetworkQuery) {
+			nq.WithTokens()
+		}).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Webhook not found"})
+			return
+		}
+		logger.WithFields(logger.Fields{
+			"Error":     err,
+			"WebhookID": webhookID,
+		}).Errorf("Error: NotifyWebhook: Failed to fetch webhook")
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to process webhook"})
+		return
+	}
+
+	var tokenContracts []string
+	if webhook.Edges.Network != nil {
+		for _, token := range webhook.Edges.Network.Edges.Tokens {
+			tokenContracts = append(tokenContracts, token.ContractAddress)
+		}
+	}
+
+	provider, err := svc.NewInboundWebhookProvider(webhook.Provider, tokenContracts)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Unsupported webhook provider"})
+		return
+	}
+
+	if err := provider.Authenticate(ctx.Request.Header, rawBody, webhook.WebhookSecret); err != nil {
+		logger.WithFields(logger.Fields{
+			"WebhookID": webhookID,
+			"Provider":  webhook.Provider,
+		}).Errorf("Error: NotifyWebhook: Invalid credentials")
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid credentials"})
+		return
+	}
+
+	deposits, err := provider.ParseDeposits(rawBody)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":     err,
+			"WebhookID": webhookID,
+			"Provider":  webhook.Provider,
+		}).Errorf("Error: NotifyWebhook: Failed to parse webhook payload")
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid payload format"})
+		return
+	}
+
+	// Deposits are handled within a latency budget so the delivery is answered before the provider
+	// times out; work over budget is left to the reconciliation tasks
+	depositCtx := common.WithLatencyBudget(ctx.Request.Context(), config.WebhookLatencyConfig())
+	depositCtx = ratelimit.WithPriority(depositCtx, ratelimit.PriorityWebhookVerification)
+	for i, deposit := range deposits {
+		if common.BudgetExhausted(depositCtx) {
+			logger.WithFields(logger.Fields{
+				"WebhookID": webhookID,
+				"Provider":  webhook.Provider,
+				"Deferred":  len(deposits) - i,
+			}).Warnf("NotifyWebhook: latency budget exhausted, deferring remaining deposits")
+			break
+		}
+
+		// Payloads that don't name their chain belong to the webhook's network
+		if deposit.ChainID == 0 && webhook.Edges.Network != nil {
+			deposit.ChainID = webhook.Edges.Network.ChainID
+		}
+
+		if err := ctrl.handleInboundDeposit(depositCtx, deposit); err != nil {
+			logger.WithFields(logger.Fields{
+				"Error":    err,
+				"TxHash":   deposit.TxHash,
+				"Provider": webhook.Provider,
+			}).Errorf("Error: NotifyWebhook: Failed to handle deposit")
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Webhook processed successfully"})
+}
+
+// handleInboundDeposit processes a single transfer reported by a Notify provider webhook
+func (ctrl *Controller) handleInboundDeposit(ctx context.Context, deposit types.InboundDeposit) error {
+	if deposit.ChainID == 0 {
+		return fmt.Errorf("unknown chain")
+	}
+
+	// Get token from database
+	token, err := storage.Client.Token.
+		Query().
+		Where(
+			tokenEnt.ContractAddressEqualFold(deposit.ContractAddress),
+			tokenEnt.HasNetworkWith(
+				networkent.ChainIDEQ(deposit.ChainID),
+				networkent.GenesisMismatchEQ(false),
+			),
+		).
+		WithNetwork().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			// Transfers of tokens we don't support are ignored
+			return nil
+		}
+		return fmt.Errorf("token not found: %w", err)
+	}
+
+	toAddress := ethcommon.HexToAddress(deposit.To).Hex()
+	fromAddress := ethcommon.HexToAddress(deposit.From).Hex()
+
+	// Skip if transfer is from gateway contract
+	if strings.EqualFold(fromAddress, token.Edges.Network.GatewayContractAddress) {
+		return nil
+	}
+
+	// Raw values are in the token's smallest unit (wei for native tokens)
+	if !deposit.RawValue.IsPositive() {
+		return fmt.Errorf("invalid transfer value: %s", deposit.RawValue)
+	}
+
+	transferEvent := &types.TokenTransferEvent{
+		BlockNumber: deposit.BlockNumber,
+		TxHash:      deposit.TxHash,
 		From:        fromAddress,
 		To:          toAddress,
-		Value:       transferValue.Div(decimal.NewFromInt(10).Pow(decimal.NewFromInt(int64(token.Decimals)))),
+		Value:       deposit.RawValue.Div(decimal.NewFromInt(10).Pow(decimal.NewFromInt(int64(token.Decimals)))),
 	}
 
-	// Process transfer using existing logic
 	addressToEvent := map[string]*types.TokenTransferEvent{
 		toAddress: transferEvent,
 	}
@@ -2012,6 +2244,7 @@ func (ctrl *Controller) handleTransferEvent(ctx *gin.Context, event types.Thirdw
 	if err != nil {
 		return fmt.Errorf("failed to process transfer: %w", err)
 	}
+	metrics.PaymentDetected(metrics.SourceWebhook, token.Edges.Network.Identifier)
 
 	return nil
 }
@@ -2024,10 +2257,13 @@ func (ctrl *Controller) handleOrderCreatedEvent(ctx *gin.Context, event types.Th
 		return fmt.Errorf("invalid chain ID: %w", err)
 	}
 
-	// Get network from database
+	// Get network from database, skipping networks whose chain was reset under them
 	network, err := storage.Client.Network.
 		Query().
-		Where(networkent.ChainIDEQ(chainID)).
+		Where(
+			networkent.ChainIDEQ(chainID),
+			networkent.GenesisMismatchEQ(false),
+		).
 		Only(ctx)
 	if err != nil {
 		return fmt.Errorf("network not found: %w", err)
@@ -2087,10 +2323,13 @@ func (ctrl *Controller) handleOrderSettledEvent(ctx *gin.Context, event types.Th
 		return fmt.Errorf("invalid chain ID: %w", err)
 	}
 
-	// Get network from database
+	// Get network from database, skipping networks whose chain was reset under them
 	network, err := storage.Client.Network.
 		Query().
-		Where(networkent.ChainIDEQ(chainID)).
+		Where(
+			networkent.ChainIDEQ(chainID),
+			networkent.GenesisMismatchEQ(false),
+		).
 		Only(ctx)
 	if err != nil {
 		return fmt.Errorf("network not found: %w", err)
@@ -2119,7 +2358,7 @@ func (ctrl *Controller) handleOrderSettledEvent(ctx *gin.Context, event types.Th
 	lockOrder, err := storage.Client.LockPaymentOrder.
 		Query().
 		Where(lockpaymentorder.GatewayIDEQ(settledEvent.OrderId)).
-		Only(ctx)
+		First(ctx)
 	if err != nil {
 		return fmt.Errorf("lock payment order not found: %w", err)
 	}
@@ -2140,10 +2379,13 @@ func (ctrl *Controller) handleOrderRefundedEvent(ctx *gin.Context, event types.T
 		return fmt.Errorf("invalid chain ID: %w", err)
 	}
 
-	// Get network from database
+	// Get network from database, skipping networks whose chain was reset under them
 	network, err := storage.Client.Network.
 		Query().
-		Where(networkent.ChainIDEQ(chainID)).
+		Where(
+			networkent.ChainIDEQ(chainID),
+			networkent.GenesisMismatchEQ(false),
+		).
 		Only(ctx)
 	if err != nil {
 		return fmt.Errorf("network not found: %w", err)
@@ -2178,7 +2420,7 @@ func (ctrl *Controller) handleOrderRefundedEvent(ctx *gin.Context, event types.T
 	lockOrder, err := storage.Client.LockPaymentOrder.
 		Query().
 		Where(lockpaymentorder.GatewayIDEQ(refundedEvent.OrderId)).
-		Only(ctx)
+		First(ctx)
 	if err != nil {
 		return fmt.Errorf("lock payment order not found: %w", err)
 	}
@@ -2644,6 +2886,37 @@ func (ctrl *Controller) IndexProviderAddress(ctx *gin.Context) {
 	u.APIResponse(ctx, http.StatusOK, "success", "Provider address indexed successfully", response)
 }
 
+// WebhookHealth controller returns the health token probed by the SERVER_URL self-check
+func (ctrl *Controller) WebhookHealth(ctx *gin.Context) {
+	u.APIResponse(ctx, http.StatusOK, "success", "OK", map[string]interface{}{
+		"token": svc.WebhookHealthToken(),
+	})
+}
+
+// Health controller reports whether the process is alive, for liveness probes
+// It only fails when the cron scheduler has stalled
+func (ctrl *Controller) Health(ctx *gin.Context) {
+	report := ctrl.healthChecker.Live(ctx)
+
+	status := http.StatusOK
+	if report.Status == health.StatusDown {
+		status = http.StatusServiceUnavailable
+	}
+	ctx.JSON(status, gin.H{"live": report.Status, "checks": report.Checks})
+}
+
+// Ready controller reports whether the instance can serve traffic, for load balancers and readiness probes
+// It fails when the database, Redis or the blockchain service is down; other checks only degrade it
+func (ctrl *Controller) Ready(ctx *gin.Context) {
+	report := ctrl.healthChecker.Ready(ctx)
+
+	status := http.StatusOK
+	if report.Status == health.StatusDown {
+		status = http.StatusServiceUnavailable
+	}
+	ctx.JSON(status, report)
+}
+
 // GetEtherscanQueueStats controller returns statistics about the Etherscan queue
 func (ctrl *Controller) GetEtherscanQueueStats(ctx *gin.Context) {
 	// Create Etherscan service instance
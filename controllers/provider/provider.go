@@ -14,12 +14,15 @@ import (
 	"github.com/NEDA-LABS/stablenode/ent/institution"
 	"github.com/NEDA-LABS/stablenode/ent/lockorderfulfillment"
 	"github.com/NEDA-LABS/stablenode/ent/lockpaymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/network"
 	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
 	"github.com/NEDA-LABS/stablenode/ent/providercurrencies"
 	"github.com/NEDA-LABS/stablenode/ent/providerprofile"
 	"github.com/NEDA-LABS/stablenode/ent/token"
 	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
 	"github.com/NEDA-LABS/stablenode/services"
+	"github.com/NEDA-LABS/stablenode/services/common"
 	orderService "github.com/NEDA-LABS/stablenode/services/order"
 	"github.com/NEDA-LABS/stablenode/storage"
 	"github.com/NEDA-LABS/stablenode/types"
@@ -44,16 +47,13 @@ func NewProviderController() *ProviderController {
 	}
 }
 
-// GetLockPaymentOrders controller fetches all assigned orders
+// GetLockPaymentOrders controller fetches the orders assigned to the provider in a currency, with
+// cursor or page pagination, filtering and sorting
 func (ctrl *ProviderController) GetLockPaymentOrders(ctx *gin.Context) {
-	// get page and pageSize query params
-	page, offset, pageSize := u.Paginate(ctx)
-
-	// Set ordering
-	ordering := ctx.Query("ordering")
-	order := ent.Desc(lockpaymentorder.FieldCreatedAt)
-	if ordering == "asc" {
-		order = ent.Asc(lockpaymentorder.FieldCreatedAt)
+	listQuery, err := u.ParseOrderListQuery(ctx)
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid query", err.Error())
+		return
 	}
 
 	// Get provider profile from the context
@@ -112,41 +112,57 @@ func (ctrl *ProviderController) GetLockPaymentOrders(ctx *gin.Context) {
 		return
 	}
 
-	// Filter by status if provided
-	statusMap := map[string]lockpaymentorder.Status{
-		"pending":    lockpaymentorder.StatusPending,
-		"validated":  lockpaymentorder.StatusValidated,
-		"fulfilled":  lockpaymentorder.StatusFulfilled,
-		"cancelled":  lockpaymentorder.StatusCancelled,
-		"processing": lockpaymentorder.StatusProcessing,
-		"settled":    lockpaymentorder.StatusSettled,
+	if len(listQuery.Statuses) > 0 {
+		statuses := make([]lockpaymentorder.Status, 0, len(listQuery.Statuses))
+		for _, status := range listQuery.Statuses {
+			if err := lockpaymentorder.StatusValidator(lockpaymentorder.Status(status)); err != nil {
+				u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid query", fmt.Sprintf("Invalid status %s", status))
+				return
+			}
+			statuses = append(statuses, lockpaymentorder.Status(status))
+		}
+		lockPaymentOrderQuery = lockPaymentOrderQuery.Where(lockpaymentorder.StatusIn(statuses...))
 	}
-
-	statusQueryParam := ctx.Query("status")
-	if status, ok := statusMap[statusQueryParam]; ok {
-		lockPaymentOrderQuery = lockPaymentOrderQuery.Where(
-			lockpaymentorder.StatusEQ(status),
-		)
+	if listQuery.Token != "" {
+		lockPaymentOrderQuery = lockPaymentOrderQuery.Where(lockpaymentorder.HasTokenWith(token.SymbolEQ(listQuery.Token)))
+	}
+	if listQuery.Network != "" {
+		lockPaymentOrderQuery = lockPaymentOrderQuery.Where(lockpaymentorder.HasTokenWith(
+			token.HasNetworkWith(network.IdentifierEQ(listQuery.Network)),
+		))
+	}
+	if listQuery.From != nil {
+		lockPaymentOrderQuery = lockPaymentOrderQuery.Where(lockpaymentorder.CreatedAtGTE(*listQuery.From))
+	}
+	if listQuery.To != nil {
+		lockPaymentOrderQuery = lockPaymentOrderQuery.Where(lockpaymentorder.CreatedAtLT(*listQuery.To))
 	}
 
-	count, err := lockPaymentOrderQuery.Count(ctx)
+	count, err := lockPaymentOrderQuery.Clone().Count(ctx)
 	if err != nil {
 		logger.Errorf("error: %v", err)
 		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch orders", nil)
 		return
 	}
 
-	// Fetch all orders assigned to the provider
+	// Pages after a cursor start after its order, others are offset
+	if listQuery.Cursor != nil {
+		lockPaymentOrderQuery = lockPaymentOrderQuery.Where(predicate.LockPaymentOrder(listQuery.AfterCursor()))
+	} else {
+		lockPaymentOrderQuery = lockPaymentOrderQuery.Offset(listQuery.Offset)
+	}
+
+	// Fetch one order more than the page to know whether there is a next page
 	lockPaymentOrders, err := lockPaymentOrderQuery.
-		Limit(pageSize).
-		Offset(offset).
-		Order(order).
+		Limit(listQuery.PageSize + 1).
+		Order(listQuery.Order()).
 		WithProvider().
 		WithToken(
 			func(query *ent.TokenQuery) {
 				query.WithNetwork()
 			},
 		).
+		WithFulfillments().
 		All(ctx)
 	if err != nil {
 		logger.Errorf("error fetching orders: %v", err)
@@ -154,6 +170,20 @@ func (ctrl *ProviderController) GetLockPaymentOrders(ctx *gin.Context) {
 		return
 	}
 
+	nextCursor := ""
+	if len(lockPaymentOrders) > listQuery.PageSize {
+		lockPaymentOrders = lockPaymentOrders[:listQuery.PageSize]
+		last := lockPaymentOrders[len(lockPaymentOrders)-1]
+		switch listQuery.SortBy {
+		case u.SortByUpdatedAt:
+			nextCursor = u.EncodeCursor(last.UpdatedAt, last.ID)
+		case u.SortByAmount:
+			nextCursor = u.EncodeCursor(last.Amount, last.ID)
+		default:
+			nextCursor = u.EncodeCursor(last.CreatedAt, last.ID)
+		}
+	}
+
 	var orders []types.LockPaymentOrderResponse
 	for _, order := range lockPaymentOrders {
 		orders = append(orders, types.LockPaymentOrderResponse{
@@ -173,14 +203,16 @@ func (ctrl *ProviderController) GetLockPaymentOrders(ctx *gin.Context) {
 			CancellationReasons: order.CancellationReasons,
 			UpdatedAt:           order.UpdatedAt,
 			CreatedAt:           order.CreatedAt,
+			SLA:                 common.LockPaymentOrderSLA(order),
 		})
 	}
 
 	// return paginated orders
 	u.APIResponse(ctx, http.StatusOK, "success", "Orders successfully retrieved", types.ProviderLockOrderList{
-		Page:         page,
-		PageSize:     pageSize,
+		Page:         listQuery.Page,
+		PageSize:     listQuery.PageSize,
 		TotalRecords: count,
+		NextCursor:   nextCursor,
 		Orders:       orders,
 	})
 }
@@ -580,6 +612,8 @@ func (ctrl *ProviderController) FulfillOrder(ctx *gin.Context) {
 					"Trx Id":  payload.TxID,
 					"Network": paymentOrder.Edges.Token.Edges.Network.Identifier,
 				}).Errorf("Failed to update payment order status: %v", err)
+			} else {
+				paymentOrder.Status = paymentorder.StatusValidated
 			}
 
 			err = u.SendPaymentOrderWebhook(ctx, paymentOrder)
@@ -654,6 +688,14 @@ func (ctrl *ProviderController) FulfillOrder(ctx *gin.Context) {
 			// Don't return error here as the order status is already updated
 		}
 
+		if err := common.RecordProviderFailure(ctx, providerID); err != nil {
+			logger.WithFields(logger.Fields{
+				"Error":      fmt.Sprintf("%v", err),
+				"OrderID":    orderID.String(),
+				"ProviderID": providerID,
+			}).Errorf("failed to record provider failure")
+		}
+
 	default:
 		transactionLog, err := storage.Client.TransactionLog.Create().
 			SetStatus(transactionlog.StatusOrderFulfilled).
@@ -840,6 +882,17 @@ func (ctrl *ProviderController) CancelOrder(ctx *gin.Context) {
 		// Don't return error here as the order status is already updated
 	}
 
+	// Invalid recipient details are the sender's fault, not the provider's
+	if payload.Reason != "Invalid recipient bank details" {
+		if err := common.RecordProviderCancellation(ctx, providerID); err != nil {
+			logger.WithFields(logger.Fields{
+				"Error":      fmt.Sprintf("%v", err),
+				"OrderID":    orderID.String(),
+				"ProviderID": providerID,
+			}).Errorf("failed to record provider cancellation")
+		}
+	}
+
 	// Check if order cancellation count is equal or greater than RefundCancellationCount in config,
 	// and the order has not been refunded, then trigger refund
 	if order.CancellationCount >= orderConf.RefundCancellationCount && order.Status == lockpaymentorder.StatusCancelled {
@@ -1250,6 +1303,7 @@ func (ctrl *ProviderController) GetLockPaymentOrderByID(ctx *gin.Context) {
 			tq.WithNetwork()
 		}).
 		WithTransactions().
+		WithFulfillments().
 		Only(ctx)
 
 	if err != nil {
@@ -1291,6 +1345,7 @@ func (ctrl *ProviderController) GetLockPaymentOrderByID(ctx *gin.Context) {
 		CreatedAt:           lockPaymentOrder.CreatedAt,
 		Transactions:        transactions,
 		CancellationReasons: lockPaymentOrder.CancellationReasons,
+		SLA:                 common.LockPaymentOrderSLA(lockPaymentOrder),
 	})
 }
 
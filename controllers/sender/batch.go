@@ -0,0 +1,375 @@
+package sender
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	svc "github.com/NEDA-LABS/stablenode/services"
+	"github.com/NEDA-LABS/stablenode/services/common"
+	"github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+	u "github.com/NEDA-LABS/stablenode/utils"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/shopspring/decimal"
+	"github.com/spf13/viper"
+)
+
+// batchValidationConcurrency is how many orders of a batch are validated at once. Validating an
+// order calls out to validate its recipient account and rate
+const batchValidationConcurrency = 10
+
+// Statuses of the orders of a batch
+const (
+	batchOrderCreated = "created"
+	batchOrderFailed  = "failed"
+)
+
+// InitiatePaymentOrderBatch controller creates many payment orders at once. Every order is
+// validated on its own, and the valid ones are created together in a single transaction. Orders on
+// pool networks share pool addresses, and the receive addresses of a token are registered on a
+// single transfer webhook. The result of every order is returned at its index
+func (ctrl *SenderController) InitiatePaymentOrderBatch(ctx *gin.Context) {
+	var payload types.NewPaymentOrderBatchPayload
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	if len(payload.Orders) > orderConf.OrderBatchMaxSize {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+			Field:   "Orders",
+			Message: fmt.Sprintf("A batch can have at most %d orders", orderConf.OrderBatchMaxSize),
+		})
+		return
+	}
+
+	// Get sender profile from the context
+	senderCtx, ok := ctx.Get("sender")
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+	sender := senderCtx.(*ent.SenderProfile)
+
+	results := make([]types.PaymentOrderBatchResult, len(payload.Orders))
+	requests := make([]*orderRequest, len(payload.Orders))
+	fail := func(i int, reqErr *orderRequestError) {
+		requests[i] = nil
+		results[i].Status = batchOrderFailed
+		results[i].Error = &types.PaymentOrderBatchError{Message: reqErr.message, Data: reqErr.data}
+	}
+
+	// Validate the orders concurrently, on the request's context since a gin context is not safe to
+	// share across goroutines
+	reqCtx := ctx.Request.Context()
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, batchValidationConcurrency)
+	for i, rawOrder := range payload.Orders {
+		results[i].Index = i
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(i int, rawOrder json.RawMessage) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			var orderPayload types.NewPaymentOrderPayload
+			if err := json.Unmarshal(rawOrder, &orderPayload); err != nil {
+				fail(i, newOrderRequestError(http.StatusBadRequest, "Failed to validate payload", types.ErrorData{
+					Field:   "Orders",
+					Message: "Invalid order",
+				}))
+				return
+			}
+			if err := binding.Validator.ValidateStruct(&orderPayload); err != nil {
+				fail(i, newOrderRequestError(http.StatusBadRequest, "Failed to validate payload", u.GetErrorData(err)))
+				return
+			}
+
+			request, reqErr := ctrl.validateOrderRequest(reqCtx, sender, orderPayload)
+			if reqErr != nil {
+				fail(i, reqErr)
+				return
+			}
+			requests[i] = request
+		}(i, rawOrder)
+	}
+	wg.Wait()
+
+	// References must also be unique within the batch
+	references := make(map[string]bool)
+	for i, request := range requests {
+		if request == nil || request.payload.Reference == "" {
+			continue
+		}
+		if references[request.payload.Reference] {
+			fail(i, newOrderRequestError(http.StatusBadRequest, "Failed to validate payload", types.ErrorData{
+				Field:   "Reference",
+				Message: "Reference is used by another order of the batch",
+			}))
+			continue
+		}
+		references[request.payload.Reference] = true
+	}
+
+	// Enforce the limits of the sender across the batch and hold anomalous orders for manual review
+	var valid []int
+	var amountsInUSD []decimal.Decimal
+	for i, request := range requests {
+		if request != nil {
+			valid = append(valid, i)
+			amountsInUSD = append(amountsInUSD, request.amountInUSD)
+		}
+	}
+	reviewReasons := make([]string, len(requests))
+	if len(valid) > 0 {
+		batchReviewReasons, limitErrs, err := common.CheckSenderBatchVelocity(ctx, sender, amountsInUSD)
+		if err != nil {
+			logger.Errorf("CheckSenderBatchVelocity error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment orders", map[string]interface{}{
+				"context": "sender_limits",
+			})
+			return
+		}
+		for j, i := range valid {
+			if limitErrs[j] != nil {
+				fail(i, senderLimitRequestError(limitErrs[j]))
+				continue
+			}
+			reviewReasons[i] = batchReviewReasons[j]
+		}
+	}
+
+	paymentOrders, receiveAddresses, err := ctrl.createPaymentOrderBatch(ctx, sender, requests, reviewReasons)
+	if err != nil {
+		var reqErr *orderRequestError
+		if errors.As(err, &reqErr) {
+			u.APIResponse(ctx, reqErr.status, "error", reqErr.message, reqErr.data)
+			return
+		}
+		logger.WithFields(logger.Fields{
+			"Error":    fmt.Sprintf("%v", err),
+			"SenderID": sender.ID.String(),
+		}).Errorf("Failed to create payment order batch")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment orders", nil)
+		return
+	}
+
+	response := &types.PaymentOrderBatchResponse{Results: results}
+	for i, request := range requests {
+		if request == nil {
+			response.Failed++
+			continue
+		}
+		notifyPaymentOrderCreated(ctx, sender, request, paymentOrders[i], reviewReasons[i])
+
+		response.Created++
+		results[i].Status = batchOrderCreated
+		results[i].Order = receiveAddressResponse(request, paymentOrders[i], receiveAddresses[i])
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Payment order batch processed", response)
+}
+
+// createPaymentOrderBatch creates the validated orders of a batch in a single transaction, with
+// their receive addresses and transfer webhooks. Orders and receive addresses are returned at the
+// index of their request, and skipped requests are nil
+func (ctrl *SenderController) createPaymentOrderBatch(ctx *gin.Context, sender *ent.SenderProfile, requests []*orderRequest, reviewReasons []string) ([]*ent.PaymentOrder, []*ent.ReceiveAddress, error) {
+	paymentOrders := make([]*ent.PaymentOrder, len(requests))
+	receiveAddresses := make([]*ent.ReceiveAddress, len(requests))
+
+	byNetwork := make(map[string][]int)
+	var networks []string
+	for i, request := range requests {
+		if request == nil {
+			continue
+		}
+		identifier := request.token.Edges.Network.Identifier
+		if _, ok := byNetwork[identifier]; !ok {
+			networks = append(networks, identifier)
+		}
+		byNetwork[identifier] = append(byNetwork[identifier], i)
+	}
+	if len(networks) == 0 {
+		return paymentOrders, receiveAddresses, nil
+	}
+
+	tx, err := storage.Client.Tx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Assign receive addresses, valid for as long as the orders stay open
+	for _, identifier := range networks {
+		indexes := byNetwork[identifier]
+		orderNetwork := requests[indexes[0]].token.Edges.Network
+		orderTTL := common.OrderTTL(sender, orderNetwork)
+		isSolana := orderNetwork.NetworkType == network.NetworkTypeSolana
+
+		var addresses []*ent.ReceiveAddress
+		if isSolana || strings.HasPrefix(identifier, "tron") {
+			// Solana and Tron orders get a fresh keypair each, stored encrypted as the salt
+			builders := make([]*ent.ReceiveAddressCreate, len(indexes))
+			for j := range indexes {
+				var address string
+				var salt []byte
+				if isSolana {
+					address, salt, err = ctrl.receiveAddressService.CreateSolanaAddress(ctx)
+				} else {
+					address, salt, err = ctrl.receiveAddressService.CreateTronAddress(ctx)
+				}
+				if err != nil {
+					_ = tx.Rollback()
+					return nil, nil, fmt.Errorf("create %s address: %w", identifier, err)
+				}
+				builders[j] = tx.ReceiveAddress.
+					Create().
+					SetAddress(address).
+					SetSalt(salt).
+					SetStatus(receiveaddress.StatusUnused).
+					SetValidUntil(time.Now().Add(orderTTL))
+			}
+			addresses, err = tx.ReceiveAddress.CreateBulk(builders...).Save(ctx)
+		} else {
+			addresses, err = storage.AssignPoolAddresses(ctx, tx.Client(), identifier, orderTTL, len(indexes))
+			if errors.Is(err, storage.ErrPoolEmpty) {
+				logger.WithFields(logger.Fields{
+					"network": identifier,
+				}).Errorf("No pool addresses exist for this network")
+				_ = tx.Rollback()
+				return nil, nil, newOrderRequestError(http.StatusServiceUnavailable, "No receive addresses available in pool. Please contact support.", map[string]interface{}{
+					"network": identifier,
+					"message": "Address pool is empty. Add addresses using pool management tools.",
+				})
+			}
+		}
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, nil, fmt.Errorf("assign %s receive addresses: %w", identifier, err)
+		}
+
+		for j, i := range indexes {
+			receiveAddresses[i] = addresses[j]
+		}
+	}
+
+	for i, request := range requests {
+		if request == nil {
+			continue
+		}
+		paymentOrders[i], err = createPaymentOrder(ctx, tx, sender, request, receiveAddresses[i], reviewReasons[i])
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, nil, err
+		}
+
+		// Prevent receive address expiry for private orders
+		if strings.HasPrefix(request.payload.Recipient.Memo, "P#P") {
+			receiveAddresses[i].ValidUntil = time.Time{}
+		}
+	}
+
+	if err := registerBatchTransferWebhooks(ctx, tx, requests, paymentOrders, receiveAddresses); err != nil {
+		_ = tx.Rollback()
+		return nil, nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, err
+	}
+
+	return paymentOrders, receiveAddresses, nil
+}
+
+// registerBatchTransferWebhooks watches the receive addresses of the EVM orders of a batch for
+// transfers, with one webhook per token
+func registerBatchTransferWebhooks(ctx *gin.Context, tx *ent.Tx, requests []*orderRequest, paymentOrders []*ent.PaymentOrder, receiveAddresses []*ent.ReceiveAddress) error {
+	// Skip webhook creation if using Alchemy (webhooks handled separately)
+	if viper.GetBool("USE_ALCHEMY_FOR_RECEIVE_ADDRESSES") {
+		return nil
+	}
+
+	byToken := make(map[int][]int)
+	var tokens []*ent.Token
+	for i, request := range requests {
+		if request == nil {
+			continue
+		}
+		orderNetwork := request.token.Edges.Network
+		if orderNetwork.NetworkType == network.NetworkTypeSolana || strings.HasPrefix(orderNetwork.Identifier, "tron") {
+			continue
+		}
+		if _, ok := byToken[request.token.ID]; !ok {
+			tokens = append(tokens, request.token)
+		}
+		byToken[request.token.ID] = append(byToken[request.token.ID], i)
+	}
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	if svc.WebhookURLUnreachable() {
+		// SERVER_URL failed the startup self-check, a webhook would never be delivered
+		logger.WithFields(logger.Fields{
+			"ServerURL": serverConf.ServerURL,
+		}).Warnf("Skipping transfer webhooks of order batch: SERVER_URL is not reachable from the outside")
+		return nil
+	}
+
+	engineService := svc.NewEngineService()
+	for _, token := range tokens {
+		indexes := byToken[token.ID]
+		chainID := token.Edges.Network.ChainID
+
+		// Pool addresses can be shared by several orders of the batch
+		var addresses []string
+		seen := make(map[string]bool)
+		for _, i := range indexes {
+			if address := receiveAddresses[i].Address; !seen[address] {
+				seen[address] = true
+				addresses = append(addresses, address)
+			}
+		}
+
+		webhookID, webhookSecret, err := engineService.CreateBatchTransferWebhook(
+			ctx,
+			chainID,
+			token.ContractAddress,
+			addresses,
+			fmt.Sprintf("batch-%s", paymentOrders[indexes[0]].ID),
+		)
+		if err != nil {
+			// BNB Smart Chain (chain ID 56) and Lisk (chain ID 1135) are not supported by Thirdweb
+			if chainID == 56 || chainID == 1135 {
+				continue
+			}
+			return fmt.Errorf("create transfer webhook on %s: %w", token.Edges.Network.Identifier, err)
+		}
+
+		builders := make([]*ent.PaymentWebhookCreate, len(indexes))
+		for j, i := range indexes {
+			builders[j] = tx.PaymentWebhook.
+				Create().
+				SetWebhookID(webhookID).
+				SetWebhookSecret(webhookSecret).
+				SetCallbackURL(fmt.Sprintf("%s/v1/insight/webhook", serverConf.ServerURL)).
+				SetPaymentOrder(paymentOrders[i])
+		}
+		if _, err := tx.PaymentWebhook.CreateBulk(builders...).Save(ctx); err != nil {
+			return fmt.Errorf("save payment webhook records: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package sender
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+	u "github.com/NEDA-LABS/stablenode/utils"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// orderEventsHeartbeat is how often an idle order event stream sends a comment, so proxies don't
+// close it
+const orderEventsHeartbeat = 15 * time.Second
+
+// StreamPaymentOrderEvents controller streams the status changes of a payment order as Server-Sent
+// Events. The stream opens with the current status of the order and ends once the order can no
+// longer change status, the client disconnects or the server shuts down
+func (ctrl *SenderController) StreamPaymentOrderEvents(ctx *gin.Context) {
+	paymentOrder, ok := senderPaymentOrder(ctx)
+	if !ok {
+		return
+	}
+
+	pubsub, err := u.SubscribeOrderEvents(ctx, paymentOrder.ID)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to stream order events", nil)
+		return
+	}
+	defer pubsub.Close()
+
+	// Read the status once subscribed, so a change made while subscribing is not missed
+	paymentOrder, err = storage.Client.PaymentOrder.Get(ctx, paymentOrder.ID)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch payment order", nil)
+		return
+	}
+
+	ctx.Header("Content-Type", "text/event-stream")
+	ctx.Header("Cache-Control", "no-cache")
+	ctx.Header("Connection", "keep-alive")
+	ctx.Header("X-Accel-Buffering", "no")
+	ctx.Status(http.StatusOK)
+
+	event := u.NewOrderStatusEvent(paymentOrder)
+	ctx.SSEvent("status", event)
+	ctx.Writer.Flush()
+	if u.IsFinalOrderEvent(event) {
+		return
+	}
+
+	heartbeat := time.NewTicker(orderEventsHeartbeat)
+	defer heartbeat.Stop()
+
+	messages := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Request.Context().Done():
+			return
+		case <-u.OrderEventStreamsClosing():
+			return
+		case <-heartbeat.C:
+			_, _ = ctx.Writer.WriteString(": heartbeat\n\n")
+			ctx.Writer.Flush()
+		case message, ok := <-messages:
+			if !ok {
+				return
+			}
+
+			var event types.OrderStatusEvent
+			if err := json.Unmarshal([]byte(message.Payload), &event); err != nil {
+				logger.Errorf("error decoding order event: %v", err)
+				continue
+			}
+
+			ctx.SSEvent("status", event)
+			ctx.Writer.Flush()
+			if u.IsFinalOrderEvent(event) {
+				return
+			}
+		}
+	}
+}
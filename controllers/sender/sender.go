@@ -1,9 +1,13 @@
 package sender
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -12,8 +16,10 @@ import (
 	"github.com/NEDA-LABS/stablenode/ent"
 	"github.com/NEDA-LABS/stablenode/ent/fiatcurrency"
 	"github.com/NEDA-LABS/stablenode/ent/institution"
+	"github.com/NEDA-LABS/stablenode/ent/linkedaddress"
 	"github.com/NEDA-LABS/stablenode/ent/network"
 	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
 	"github.com/NEDA-LABS/stablenode/ent/providerordertoken"
 	providerprofile "github.com/NEDA-LABS/stablenode/ent/providerprofile"
 	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
@@ -21,13 +27,16 @@ import (
 	"github.com/NEDA-LABS/stablenode/ent/senderprofile"
 	tokenEnt "github.com/NEDA-LABS/stablenode/ent/token"
 	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
+	"github.com/NEDA-LABS/stablenode/ent/webhookdelivery"
 
 	svc "github.com/NEDA-LABS/stablenode/services"
+	"github.com/NEDA-LABS/stablenode/services/common"
 	orderSvc "github.com/NEDA-LABS/stablenode/services/order"
 	"github.com/NEDA-LABS/stablenode/storage"
 	"github.com/NEDA-LABS/stablenode/types"
 	u "github.com/NEDA-LABS/stablenode/utils"
 	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/NEDA-LABS/stablenode/utils/metrics"
 	"github.com/spf13/viper"
 	"github.com/shopspring/decimal"
 
@@ -38,6 +47,8 @@ import (
 type SenderController struct {
 	receiveAddressService *svc.ReceiveAddressService
 	orderService          types.OrderService
+	permitDeposit         *orderSvc.PermitDeposit
+	feeService            *svc.FeeService
 }
 
 // NewSenderController creates a new instance of SenderController
@@ -46,6 +57,8 @@ func NewSenderController() *SenderController {
 	return &SenderController{
 		receiveAddressService: svc.NewReceiveAddressService(),
 		orderService:          orderSvc.NewOrderEVM(),
+		permitDeposit:         orderSvc.NewPermitDeposit(),
+		feeService:            svc.NewFeeService(),
 	}
 }
 
@@ -71,27 +84,281 @@ func (ctrl *SenderController) InitiatePaymentOrder(ctx *gin.Context) {
 	}
 	sender := senderCtx.(*ent.SenderProfile)
 
-	// Get token from DB
-	token, err := storage.Client.Token.
-		Query().
-		Where(
-			tokenEnt.SymbolEQ(payload.Token),
-			tokenEnt.HasNetworkWith(network.IdentifierEQ(payload.Network)),
-			tokenEnt.IsEnabledEQ(true),
-		).
-		WithNetwork().
-		Only(ctx)
+	request, reqErr := ctrl.validateOrderRequest(ctx.Request.Context(), sender, payload)
+	if reqErr != nil {
+		u.APIResponse(ctx, reqErr.status, "error", reqErr.message, reqErr.data)
+		return
+	}
+	payload = request.payload
+	token := request.token
+
+	// Enforce the limits of the sender and hold anomalous orders for manual review
+	reviewReason, err := common.CheckSenderVelocity(ctx, sender, request.amountInUSD)
+	if err != nil {
+		var limitErr *common.SenderLimitError
+		if errors.As(err, &limitErr) {
+			reqErr := senderLimitRequestError(limitErr)
+			u.APIResponse(ctx, reqErr.status, "error", reqErr.message, reqErr.data)
+			return
+		}
+		logger.Errorf("CheckSenderVelocity error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order", map[string]interface{}{
+			"context": "sender_limits",
+		})
+		return
+	}
+
+	// Generate receive address, valid for as long as the order stays open
+	var receiveAddress *ent.ReceiveAddress
+	orderTTL := common.OrderTTL(sender, token.Edges.Network)
+	isSolana := token.Edges.Network.NetworkType == network.NetworkTypeSolana
+	isTron := strings.HasPrefix(payload.Network, "tron")
+	if !isSolana && !isTron {
+		request.blockchainService = routeOrder(ctx, sender, request)
+	}
+	isRouted := request.blockchainService != "" && request.blockchainService != paymentorder.BlockchainServiceAlchemy
+	if isSolana || isTron || isRouted {
+		// Solana and Tron orders get a fresh keypair, stored encrypted as the salt. EVM orders routed
+		// away from Alchemy, which holds the pool addresses, get an address of their routed service
+		var address string
+		var salt []byte
+		if isRouted {
+			address, salt, err = ctrl.receiveAddressService.CreateRoutedAddress(ctx, request.blockchainService, "")
+			if err != nil {
+				logger.WithFields(logger.Fields{
+					"Error":   err.Error(),
+					"Service": request.blockchainService,
+				}).Errorf("CreateRoutedAddress error")
+				u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order", map[string]interface{}{
+					"context": "create_routed_address",
+				})
+				return
+			}
+		} else if isSolana {
+			address, salt, err = ctrl.receiveAddressService.CreateSolanaAddress(ctx)
+			if err != nil {
+				logger.Errorf("CreateSolanaAddress error: %v", err)
+				u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order", map[string]interface{}{
+					"context": "create_solana_address",
+				})
+				return
+			}
+		} else {
+			address, salt, err = ctrl.receiveAddressService.CreateTronAddress(ctx)
+			if err != nil {
+				logger.Errorf("CreateTronAddress error: %v", err)
+				u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order", map[string]interface{}{
+					"context": "create_tron_address",
+				})
+				return
+			}
+		}
+
+		receiveAddress, err = storage.Client.ReceiveAddress.
+			Create().
+			SetAddress(address).
+			SetSalt(salt).
+			SetStatus(receiveaddress.StatusUnused).
+			SetValidUntil(time.Now().Add(orderTTL)).
+			Save(ctx)
+		if err != nil {
+			logger.WithFields(logger.Fields{
+				"error":   err,
+				"address": address,
+			}).Errorf("Failed to create receive address")
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order", nil)
+			return
+		}
+	} else {
+		// Get ANY pool address (doesn't matter if it's currently in use)
+		// Pool addresses can be reused simultaneously by multiple orders
+		receiveAddress, err = storage.AssignPoolAddress(ctx, token.Edges.Network.Identifier, orderTTL)
+		if err != nil {
+			// No pool addresses exist at all
+			if ent.IsNotFound(err) {
+				logger.WithFields(logger.Fields{
+					"network": token.Edges.Network.Identifier,
+				}).Errorf("No pool addresses exist for this network")
+				
+				u.APIResponse(ctx, http.StatusServiceUnavailable, "error", "No receive addresses available in pool. Please contact support.", map[string]interface{}{
+					"network": token.Edges.Network.Identifier,
+					"message": "Address pool is empty. Add addresses using pool management tools.",
+				})
+				return
+			}
+			
+			logger.WithFields(logger.Fields{
+				"error": err,
+				"network": token.Edges.Network.Identifier,
+			}).Errorf("Failed to assign pool address")
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order", nil)
+			return
+		}
+	}
+
+	// Prevent receive address expiry for private orders
+	if strings.HasPrefix(payload.Recipient.Memo, "P#P") {
+		receiveAddress.ValidUntil = time.Time{}
+	}
+
+	// Create payment order and recipient in a transaction
+	tx, err := storage.Client.Tx(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order", nil)
+		return
+	}
+
+	paymentOrder, err := createPaymentOrder(ctx, tx, sender, request, receiveAddress, reviewReason)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order", nil)
+		_ = tx.Rollback()
+		return
+	}
+
+	// Create webhook for the smart address to monitor transfers (only for EVM networks)
+	// Skip webhook creation if using Alchemy (webhooks handled separately), for orders routed to
+	// direct RPC, or if the network detects deposits without webhooks. Orders routed to Thirdweb
+	// Engine always get one
+	useAlchemy := viper.GetBool("USE_ALCHEMY_FOR_RECEIVE_ADDRESSES")
+	registerWebhook := !isTron && !isSolana &&
+		(request.blockchainService == paymentorder.BlockchainServiceEngine || (!useAlchemy && !isRouted)) &&
+		svc.DepositSourcesFor(token.Edges.Network).Webhooks
+	if registerWebhook && svc.WebhookURLUnreachable() {
+		// SERVER_URL failed the startup self-check, a webhook would never be delivered
+		logger.WithFields(logger.Fields{
+			"OrderID":   paymentOrder.ID.String(),
+			"ServerURL": serverConf.ServerURL,
+		}).Warnf("Skipping transfer webhook: SERVER_URL is not reachable from the outside")
+		registerWebhook = false
+	}
+	if registerWebhook {
+		engineService := svc.NewEngineService()
+		webhookID, webhookSecret, err := engineService.CreateTransferWebhook(
+			ctx,
+			token.Edges.Network.ChainID,
+			token.ContractAddress,    // Token contract address
+			receiveAddress.Address,   // Smart address to monitor
+			paymentOrder.ID.String(), // Order ID for webhook name
+		)
+		if err != nil {
+			// Check if this is BNB Smart Chain (chain ID 56) or Lisk (chain ID 1135) which is not supported by Thirdweb
+			if token.Edges.Network.ChainID != 56 && token.Edges.Network.ChainID != 1135 {
+				logger.WithFields(logger.Fields{
+					"ChainID": token.Edges.Network.ChainID,
+					"Network": token.Edges.Network.Identifier,
+					"Error":   err.Error(),
+				}).Errorf("Failed to create transfer webhook: %v", err)
+				u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order", nil)
+				_ = tx.Rollback()
+				return
+			}
+		} else {
+			// Create PaymentWebhook record in database only if webhook was created successfully
+			_, err = tx.PaymentWebhook.
+				Create().
+				SetWebhookID(webhookID).
+				SetWebhookSecret(webhookSecret).
+				SetCallbackURL(fmt.Sprintf("%s/v1/insight/webhook", serverConf.ServerURL)).
+				SetPaymentOrder(paymentOrder).
+				Save(ctx)
+			if err != nil {
+				logger.Errorf("Failed to save payment webhook record: %v", err)
+				u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order", nil)
+				_ = tx.Rollback()
+				return
+			}
+		}
+	}
+
+	// Commit the transaction
+	if err := tx.Commit(); err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order", nil)
+		return
+	}
+	notifyPaymentOrderCreated(ctx, sender, request, paymentOrder, reviewReason)
+
+	u.APIResponse(ctx, http.StatusCreated, "success", "Payment order initiated successfully",
+		receiveAddressResponse(request, paymentOrder, receiveAddress))
+}
+
+// orderRequest is a validated request for a new payment order
+type orderRequest struct {
+	payload            types.NewPaymentOrderPayload
+	token              *ent.Token
+	institution        *ent.Institution
+	fees               *svc.OrderFees
+	feeAddress         string
+	returnAddress      string
+	fiatAmount         *decimal.Decimal
+	fiatCurrency       string
+	rateDriftTolerance decimal.Decimal
+	amountInUSD        decimal.Decimal
+	// blockchainService is the service of the blockchain route applying to an EVM order, if any
+	blockchainService paymentorder.BlockchainService
+}
+
+// orderRequestError is a rejected request for a new payment order, with the response to send for it
+type orderRequestError struct {
+	status  int
+	message string
+	data    interface{}
+}
+
+func (e *orderRequestError) Error() string {
+	return e.message
+}
+
+func newOrderRequestError(status int, message string, data interface{}) *orderRequestError {
+	return &orderRequestError{status: status, message: message, data: data}
+}
+
+// senderLimitRequestError rejects an order over a limit of its sender. Orders over the maximum amount
+// are invalid, while orders over the volume and count limits can be retried later
+func senderLimitRequestError(limitErr *common.SenderLimitError) *orderRequestError {
+	if limitErr.Limit == common.SenderLimitMaxOrderAmount {
+		return newOrderRequestError(http.StatusBadRequest, "Failed to validate payload", types.ErrorData{
+			Field:   "Amount",
+			Message: limitErr.Message,
+		})
+	}
+	return newOrderRequestError(http.StatusTooManyRequests, limitErr.Message, map[string]interface{}{
+		"limit": limitErr.Limit,
+	})
+}
+
+// validateOrderRequest validates a request of a sender for a new payment order: its token, fees,
+// addresses, reference, recipient account and rate. The recipient account name, and the rate and
+// token amount of fiat orders, are filled in on the validated payload
+func (ctrl *SenderController) validateOrderRequest(ctx context.Context, sender *ent.SenderProfile, payload types.NewPaymentOrderPayload) (*orderRequest, *orderRequestError) {
+	if !payload.Amount.IsZero() && !payload.FiatAmount.IsZero() {
+		return nil, newOrderRequestError(http.StatusBadRequest, "Failed to validate payload", types.ErrorData{
+			Field:   "FiatAmount",
+			Message: "Provide either Amount or FiatAmount, not both",
+		})
+	}
+
+	// Get token, served from the lookup cache until a token or network changes
+	token, err := u.GetEnabledToken(ctx, payload.Network, payload.Token)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+			return nil, newOrderRequestError(http.StatusBadRequest, "Failed to validate payload", types.ErrorData{
 				Field:   "Token",
 				Message: "Provided token is not supported",
 			})
-		} else {
-			logger.Errorf("Failed to fetch token: %v", err)
-			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch token", nil)
 		}
-		return
+		logger.Errorf("Failed to fetch token: %v", err)
+		return nil, newOrderRequestError(http.StatusInternalServerError, "Failed to fetch token", nil)
+	}
+
+	// New orders are paused while the token trades off its peg
+	if token.DepeggedAt != nil {
+		return nil, newOrderRequestError(http.StatusBadRequest, "Failed to validate payload", types.ErrorData{
+			Field:   "Token",
+			Message: "Provided token is temporarily paused",
+		})
 	}
 
 	// Handle sender profile overrides
@@ -107,19 +374,17 @@ func (ctrl *SenderController) InitiatePaymentOrder(ctx *gin.Context) {
 		).
 		Only(ctx)
 	if err != nil {
-		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+		return nil, newOrderRequestError(http.StatusBadRequest, "Failed to validate payload", types.ErrorData{
 			Field:   "Token",
 			Message: "Provided token is not configured",
 		})
-		return
 	}
 
 	if senderOrderToken.FeeAddress == "" || senderOrderToken.RefundAddress == "" {
-		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+		return nil, newOrderRequestError(http.StatusBadRequest, "Failed to validate payload", types.ErrorData{
 			Field:   "Token",
 			Message: "Fee address or refund address is not configured",
 		})
-		return
 	}
 
 	feePercent := senderOrderToken.FeePercent
@@ -128,36 +393,39 @@ func (ctrl *SenderController) InitiatePaymentOrder(ctx *gin.Context) {
 
 	if payload.FeeAddress != "" {
 		if !sender.IsPartner {
-			u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+			return nil, newOrderRequestError(http.StatusBadRequest, "Failed to validate payload", types.ErrorData{
 				Field:   "FeeAddress",
 				Message: "FeeAddress is not allowed",
 			})
-			return
 		}
 
 		if payload.FeePercent.IsZero() {
-			u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+			return nil, newOrderRequestError(http.StatusBadRequest, "Failed to validate payload", types.ErrorData{
 				Field:   "FeePercent",
 				Message: "FeePercent must be greater than zero",
 			})
-			return
 		}
 
-		if !strings.HasPrefix(payload.Network, "tron") {
+		if token.Edges.Network.NetworkType == network.NetworkTypeSolana {
+			if !u.IsValidSolanaAddress(payload.FeeAddress) {
+				return nil, newOrderRequestError(http.StatusBadRequest, "Failed to validate payload", types.ErrorData{
+					Field:   "FeeAddress",
+					Message: "Invalid Solana address",
+				})
+			}
+		} else if !strings.HasPrefix(payload.Network, "tron") {
 			if !u.IsValidEthereumAddress(payload.FeeAddress) {
-				u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+				return nil, newOrderRequestError(http.StatusBadRequest, "Failed to validate payload", types.ErrorData{
 					Field:   "FeeAddress",
 					Message: "Invalid Ethereum address",
 				})
-				return
 			}
 		} else {
 			if !u.IsValidTronAddress(payload.FeeAddress) {
-				u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+				return nil, newOrderRequestError(http.StatusBadRequest, "Failed to validate payload", types.ErrorData{
 					Field:   "FeeAddress",
 					Message: "Invalid Tron address",
 				})
-				return
 			}
 		}
 
@@ -166,21 +434,26 @@ func (ctrl *SenderController) InitiatePaymentOrder(ctx *gin.Context) {
 	}
 
 	if payload.ReturnAddress != "" {
-		if !strings.HasPrefix(payload.Network, "tron") {
+		if token.Edges.Network.NetworkType == network.NetworkTypeSolana {
+			if !u.IsValidSolanaAddress(payload.ReturnAddress) {
+				return nil, newOrderRequestError(http.StatusBadRequest, "Failed to validate payload", types.ErrorData{
+					Field:   "ReturnAddress",
+					Message: "Invalid Solana address",
+				})
+			}
+		} else if !strings.HasPrefix(payload.Network, "tron") {
 			if !u.IsValidEthereumAddress(payload.ReturnAddress) {
-				u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+				return nil, newOrderRequestError(http.StatusBadRequest, "Failed to validate payload", types.ErrorData{
 					Field:   "ReturnAddress",
 					Message: "Invalid Ethereum address",
 				})
-				return
 			}
 		} else {
 			if !u.IsValidTronAddress(payload.ReturnAddress) {
-				u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+				return nil, newOrderRequestError(http.StatusBadRequest, "Failed to validate payload", types.ErrorData{
 					Field:   "ReturnAddress",
 					Message: "Invalid Tron address",
 				})
-				return
 			}
 		}
 		returnAddress = payload.ReturnAddress
@@ -188,11 +461,10 @@ func (ctrl *SenderController) InitiatePaymentOrder(ctx *gin.Context) {
 
 	if payload.Reference != "" {
 		if !regexp.MustCompile(`^[a-zA-Z0-9\-_]+$`).MatchString(payload.Reference) {
-			u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+			return nil, newOrderRequestError(http.StatusBadRequest, "Failed to validate payload", types.ErrorData{
 				Field:   "Reference",
 				Message: "Reference must be alphanumeric",
 			})
-			return
 		}
 
 		referenceExists, err := storage.Client.PaymentOrder.
@@ -203,18 +475,16 @@ func (ctrl *SenderController) InitiatePaymentOrder(ctx *gin.Context) {
 			Exist(ctx)
 		if err != nil {
 			logger.Errorf("Reference check error: %v", err)
-			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order", map[string]interface{}{
+			return nil, newOrderRequestError(http.StatusInternalServerError, "Failed to initiate payment order", map[string]interface{}{
 				"context": "reference_check",
 			})
-			return
 		}
 
 		if referenceExists {
-			u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+			return nil, newOrderRequestError(http.StatusBadRequest, "Failed to validate payload", types.ErrorData{
 				Field:   "Reference",
 				Message: "Reference already exists",
 			})
-			return
 		}
 	}
 
@@ -232,22 +502,38 @@ func (ctrl *SenderController) InitiatePaymentOrder(ctx *gin.Context) {
 		First(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+			return nil, newOrderRequestError(http.StatusBadRequest, "Failed to validate payload", types.ErrorData{
 				Field:   "Recipient",
 				Message: "Provided institution is not supported",
 			})
-		} else {
-			logger.Errorf("Failed to fetch institution: %v", err)
-			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to validate institution", map[string]interface{}{
-				"context": "institution_fetch",
-			})
 		}
-		return
+		logger.Errorf("Failed to fetch institution: %v", err)
+		return nil, newOrderRequestError(http.StatusInternalServerError, "Failed to validate institution", map[string]interface{}{
+			"context": "institution_fetch",
+		})
 	}
 
 	if !strings.EqualFold(token.BaseCurrency, institutionObj.Edges.FiatCurrency.Code) && !strings.EqualFold(token.BaseCurrency, "USD") {
-		u.APIResponse(ctx, http.StatusBadRequest, "error", fmt.Sprintf("%s can only be converted to %s", token.Symbol, token.BaseCurrency), nil)
-		return
+		return nil, newOrderRequestError(http.StatusBadRequest, fmt.Sprintf("%s can only be converted to %s", token.Symbol, token.BaseCurrency), nil)
+	}
+
+	// Orders denominated in fiat are paid out in the recipient's currency and validated against an
+	// estimate of their token amount
+	isFiatOrder := !payload.FiatAmount.IsZero()
+	rateAmount := payload.Amount
+	if isFiatOrder {
+		if !strings.EqualFold(payload.FiatCurrency, institutionObj.Edges.FiatCurrency.Code) {
+			return nil, newOrderRequestError(http.StatusBadRequest, "Failed to validate payload", types.ErrorData{
+				Field:   "FiatCurrency",
+				Message: fmt.Sprintf("Provided institution pays out in %s", institutionObj.Edges.FiatCurrency.Code),
+			})
+		}
+
+		rateAmount = payload.FiatAmount
+		marketRate := institutionObj.Edges.FiatCurrency.MarketRate
+		if !strings.EqualFold(token.BaseCurrency, institutionObj.Edges.FiatCurrency.Code) && !marketRate.IsZero() {
+			rateAmount = payload.FiatAmount.Div(marketRate)
+		}
 	}
 
 	// Validate account and rate in parallel with fail fast logic before proceeding with order creation
@@ -270,7 +556,7 @@ func (ctrl *SenderController) InitiatePaymentOrder(ctx *gin.Context) {
 	}()
 
 	go func() {
-		achievableRate, err := u.ValidateRate(ctx, token, institutionObj.Edges.FiatCurrency, payload.Amount, payload.Recipient.ProviderID, payload.Network)
+		achievableRate, err := u.ValidateRate(ctx, token, institutionObj.Edges.FiatCurrency, rateAmount, payload.Recipient.ProviderID, payload.Network)
 		rateChan <- RateResult{achievableRate, err}
 	}()
 
@@ -283,20 +569,18 @@ func (ctrl *SenderController) InitiatePaymentOrder(ctx *gin.Context) {
 		case accountResult = <-accountChan:
 			completedCount++
 			if accountResult.err != nil {
-				u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+				return nil, newOrderRequestError(http.StatusBadRequest, "Failed to validate payload", types.ErrorData{
 					Field:   "Recipient",
 					Message: fmt.Sprintf("Account validation failed: %s", accountResult.err.Error()),
 				})
-				return
 			}
 		case rateResult = <-rateChan:
 			completedCount++
 			if rateResult.err != nil {
-				u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+				return nil, newOrderRequestError(http.StatusBadRequest, "Failed to validate payload", types.ErrorData{
 					Field:   "Rate",
 					Message: fmt.Sprintf("Rate validation failed: %s", rateResult.err.Error()),
 				})
-				return
 			}
 		}
 	}
@@ -304,16 +588,43 @@ func (ctrl *SenderController) InitiatePaymentOrder(ctx *gin.Context) {
 	// Both validations successful
 	payload.Recipient.AccountName = accountResult.accountName
 	achievableRate := rateResult.achievableRate
+	if !achievableRate.IsPositive() {
+		return nil, newOrderRequestError(http.StatusBadRequest, "Failed to validate payload", types.ErrorData{
+			Field:   "Rate",
+			Message: "No rate is available for this order",
+		})
+	}
+
+	// Fiat orders lock the achievable rate unless the sender provided one
+	if isFiatOrder && payload.Rate.IsZero() {
+		payload.Rate = achievableRate
+	}
+	if !payload.Rate.IsPositive() {
+		return nil, newOrderRequestError(http.StatusBadRequest, "Failed to validate payload", types.ErrorData{
+			Field:   "Rate",
+			Message: "Rate must be greater than zero",
+		})
+	}
 
 	// Validate that the provided rate is achievable
 	// Allow for a small tolerance (0.1%) to account for minor rate fluctuations
 	tolerance := achievableRate.Mul(decimal.NewFromFloat(0.001)) // 0.1% tolerance
 	if payload.Rate.LessThan(achievableRate.Sub(tolerance)) {
-		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+		return nil, newOrderRequestError(http.StatusBadRequest, "Failed to validate payload", types.ErrorData{
 			Field:   "Rate",
 			Message: fmt.Sprintf("Provided rate %s is not achievable. Available rate is %s", payload.Rate, achievableRate),
 		})
-		return
+	}
+
+	// Quote the token amount of fiat orders at the locked rate; the exact amount is converted when paid
+	var fiatAmount *decimal.Decimal
+	fiatCurrency := ""
+	rateDriftTolerance := decimal.Zero
+	if isFiatOrder {
+		payload.Amount = payload.FiatAmount.Div(payload.Rate).Round(int32(token.Decimals))
+		fiatAmount = &payload.FiatAmount
+		fiatCurrency = institutionObj.Edges.FiatCurrency.Code
+		rateDriftTolerance = orderConf.FiatRateDriftTolerance
 	}
 
 	if payload.Recipient.ProviderID != "" {
@@ -335,15 +646,13 @@ func (ctrl *SenderController) InitiatePaymentOrder(ctx *gin.Context) {
 			Only(ctx)
 		if err != nil {
 			if ent.IsNotFound(err) {
-				u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+				return nil, newOrderRequestError(http.StatusBadRequest, "Failed to validate payload", types.ErrorData{
 					Field:   "Recipient",
 					Message: "The specified provider does not support the selected token",
 				})
-			} else {
-				logger.Errorf("Failed to fetch provider settings: %v", err)
-				u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch provider settings", nil)
 			}
-			return
+			logger.Errorf("Failed to fetch provider settings: %v", err)
+			return nil, newOrderRequestError(http.StatusInternalServerError, "Failed to fetch provider settings", nil)
 		}
 
 		// Validate amount for private orders
@@ -352,150 +661,75 @@ func (ctrl *SenderController) InitiatePaymentOrder(ctx *gin.Context) {
 			if strings.EqualFold(token.BaseCurrency, institutionObj.Edges.FiatCurrency.Code) && token.BaseCurrency != "USD" {
 				rateResponse, err := u.GetTokenRateFromQueue("USDT", normalizedAmount, institutionObj.Edges.FiatCurrency.Code, institutionObj.Edges.FiatCurrency.MarketRate)
 				if err != nil {
-					logger.Errorf("InitiatePaymentOrder.GetTokenRateFromQueue: %v", err)
-					u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order", map[string]interface{}{
+					logger.Errorf("validateOrderRequest.GetTokenRateFromQueue: %v", err)
+					return nil, newOrderRequestError(http.StatusInternalServerError, "Failed to initiate payment order", map[string]interface{}{
 						"context": "token_rate_queue",
 					})
-					return
 				}
 				normalizedAmount = payload.Amount.Div(rateResponse)
 			}
 
 			if normalizedAmount.LessThan(orderToken.MinOrderAmount) {
-				u.APIResponse(ctx, http.StatusBadRequest, "error", "The amount is below the minimum order amount for the specified provider", nil)
-				return
+				return nil, newOrderRequestError(http.StatusBadRequest, "The amount is below the minimum order amount for the specified provider", nil)
 			} else if normalizedAmount.GreaterThan(orderToken.MaxOrderAmount) {
-				u.APIResponse(ctx, http.StatusBadRequest, "error", "The amount is beyond the maximum order amount for the specified provider", nil)
-				return
+				return nil, newOrderRequestError(http.StatusBadRequest, "The amount is beyond the maximum order amount for the specified provider", nil)
 			}
 		}
 	}
 
-	// Generate receive address
-	var receiveAddress *ent.ReceiveAddress
-	if strings.HasPrefix(payload.Network, "tron") {
-		address, salt, err := ctrl.receiveAddressService.CreateTronAddress(ctx)
-		if err != nil {
-			logger.Errorf("CreateTronAddress error: %v", err)
-			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order", map[string]interface{}{
-				"context": "create_tron_address",
-			})
-			return
-		}
+	amountInUSD := u.CalculatePaymentOrderAmountInUSD(payload.Amount, token, institutionObj)
+	fees, err := ctrl.feeService.ComputeFees(ctx, svc.FeeQuote{
+		Sender:           sender,
+		Token:            token,
+		Amount:           payload.Amount,
+		AmountInUSD:      amountInUSD,
+		SenderFeePercent: feePercent,
+		SenderFeeChosen:  payload.FeeAddress != "",
+	})
+	if err != nil {
+		logger.Errorf("validateOrderRequest.ComputeFees: %v", err)
+		return nil, newOrderRequestError(http.StatusInternalServerError, "Failed to initiate payment order", nil)
+	}
 
-		receiveAddress, err = storage.Client.ReceiveAddress.
-			Create().
-			SetAddress(address).
-			SetSalt(salt).
-			SetStatus(receiveaddress.StatusUnused).
-			SetValidUntil(time.Now().Add(orderConf.ReceiveAddressValidity)).
-			Save(ctx)
-		if err != nil {
-			logger.WithFields(logger.Fields{
-				"error":   err,
-				"address": address,
-			}).Errorf("Failed to create receive address")
-			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order", nil)
-			return
-		}
-	} else {
-		// Get ANY pool address (doesn't matter if it's currently in use)
-		// Pool addresses can be reused simultaneously by multiple orders
-		poolAddress, err := storage.Client.ReceiveAddress.
-			Query().
-			Where(
-				receiveaddress.StatusEQ(receiveaddress.StatusPoolReady),
-				receiveaddress.IsDeployedEQ(true),
-				receiveaddress.NetworkIdentifierEQ(token.Edges.Network.Identifier),
-			).
-			Order(ent.Asc(receiveaddress.FieldTimesUsed)). // Use least-used address first
-			First(ctx)
-		
-		if err != nil {
-			// No pool addresses exist at all
-			if ent.IsNotFound(err) {
-				logger.WithFields(logger.Fields{
-					"network": token.Edges.Network.Identifier,
-				}).Errorf("No pool addresses exist for this network")
-				
-				u.APIResponse(ctx, http.StatusServiceUnavailable, "error", "No receive addresses available in pool. Please contact support.", map[string]interface{}{
-					"network": token.Edges.Network.Identifier,
-					"message": "Address pool is empty. Add addresses using pool management tools.",
-				})
-				return
-			}
-			
-			// Database error
-			logger.WithFields(logger.Fields{
-				"error": err,
-				"network": token.Edges.Network.Identifier,
-			}).Errorf("Error querying pool")
-			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to query address pool", map[string]interface{}{
-				"network": token.Edges.Network.Identifier,
-			})
-			return
-		}
-		
-		// Found a pool address - create NEW row for this order with same address
-		logger.WithFields(logger.Fields{
-			"address": poolAddress.Address,
-			"network": token.Edges.Network.Identifier,
-			"pool_id": poolAddress.ID,
-			"times_used": poolAddress.TimesUsed,
-		}).Infof("Using pool address - creating new row for order")
-		
-		// Create a new receive_address row for this order
-		receiveAddress, err = storage.Client.ReceiveAddress.
-			Create().
-			SetAddress(poolAddress.Address).
-			SetStatus(receiveaddress.StatusPoolAssigned).
-			SetIsDeployed(true).
-			SetNetworkIdentifier(poolAddress.NetworkIdentifier).
-			SetChainID(poolAddress.ChainID).
-			SetAssignedAt(time.Now()).
-			SetValidUntil(time.Now().Add(orderConf.ReceiveAddressValidity)).
-			Save(ctx)
-		
-		if err != nil {
-			logger.WithFields(logger.Fields{
-				"error": err,
-				"address": poolAddress.Address,
-			}).Errorf("Failed to create receive address row for pool address")
-			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order", nil)
-			return
-		}
-		
-		// Update the pool address usage counter (keep pool row separate)
-		_, err = storage.Client.ReceiveAddress.
-			UpdateOne(poolAddress).
-			SetTimesUsed(poolAddress.TimesUsed + 1).
-			SetLastUsed(time.Now()).
-			Save(ctx)
-		
-		if err != nil {
-			logger.WithFields(logger.Fields{
-				"error": err,
-				"pool_id": poolAddress.ID,
-			}).Warnf("Failed to update pool address usage counter")
-			// Don't fail the order, just log the warning
-		}
-
-	}
-
-	// Prevent receive address expiry for private orders
-	if strings.HasPrefix(payload.Recipient.Memo, "P#P") {
-		receiveAddress.ValidUntil = time.Time{}
-	}
+	return &orderRequest{
+		payload:            payload,
+		token:              token,
+		institution:        institutionObj,
+		fees:               fees,
+		feeAddress:         feeAddress,
+		returnAddress:      returnAddress,
+		fiatAmount:         fiatAmount,
+		fiatCurrency:       fiatCurrency,
+		rateDriftTolerance: rateDriftTolerance,
+		amountInUSD:        amountInUSD,
+	}, nil
+}
 
-	// Create payment order and recipient in a transaction
-	tx, err := storage.Client.Tx(ctx)
+// routeOrder returns the blockchain service of the route applying to a new EVM order, or empty when
+// none applies or the routes can't be loaded, leaving the order on a pool address
+func routeOrder(ctx context.Context, sender *ent.SenderProfile, request *orderRequest) paymentorder.BlockchainService {
+	route, err := svc.DefaultBlockchainRouter().Route(ctx, svc.BlockchainRouteQuote{
+		Sender:      sender,
+		Token:       request.token,
+		AmountInUSD: request.amountInUSD,
+	})
 	if err != nil {
-		logger.Errorf("error: %v", err)
-		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order", nil)
-		return
+		logger.WithFields(logger.Fields{
+			"Error":    err.Error(),
+			"SenderID": sender.ID,
+		}).Warnf("Failed to route order, using a pool address")
+		return ""
+	}
+	if route == nil {
+		return ""
 	}
+	return paymentorder.BlockchainService(route.Service)
+}
 
-	senderFee := feePercent.Mul(payload.Amount).Div(decimal.NewFromInt(100)).Round(4)
+// createPaymentOrder creates a validated payment order with its recipient and transaction log
+func createPaymentOrder(ctx context.Context, tx *ent.Tx, sender *ent.SenderProfile, request *orderRequest, receiveAddress *ent.ReceiveAddress, reviewReason string) (*ent.PaymentOrder, error) {
+	payload := request.payload
+	token := request.token
 
 	// Create transaction Log
 	transactionLog, err := tx.TransactionLog.
@@ -509,84 +743,60 @@ func (ctrl *SenderController) InitiatePaymentOrder(ctx *gin.Context) {
 		).SetNetwork(token.Edges.Network.Identifier).
 		Save(ctx)
 	if err != nil {
-		logger.Errorf("error: %v", err)
-		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order", nil)
-		_ = tx.Rollback()
-		return
+		return nil, fmt.Errorf("create transaction log: %w", err)
+	}
+
+	// Use the network's settlement policy unless the sender opted into one
+	settlementPolicy := paymentorder.SettlementPolicy(token.Edges.Network.SettlementPolicy)
+	if payload.SettlementPolicy != "" {
+		settlementPolicy = paymentorder.SettlementPolicy(payload.SettlementPolicy)
+	}
+
+	// EVM orders keep sending through their routed service, or the one holding their receive address
+	var blockchainService *paymentorder.BlockchainService
+	if token.Edges.Network.NetworkType == network.NetworkTypeEvm && !strings.HasPrefix(token.Edges.Network.Identifier, "tron") {
+		service := request.blockchainService
+		if service == "" {
+			service = svc.ReceiveAddressBlockchainService(receiveAddress)
+		}
+		blockchainService = &service
 	}
 
 	// Create payment order
-	amountInUSD := u.CalculatePaymentOrderAmountInUSD(payload.Amount, token, institutionObj)
 	paymentOrder, err := tx.PaymentOrder.
 		Create().
 		SetSenderProfile(sender).
 		SetAmount(payload.Amount).
-		SetAmountInUsd(amountInUSD).
+		SetAmountInUsd(request.amountInUSD).
 		SetAmountPaid(decimal.NewFromInt(0)).
 		SetAmountReturned(decimal.NewFromInt(0)).
 		SetPercentSettled(decimal.NewFromInt(0)).
-		SetNetworkFee(token.Edges.Network.Fee).
-		SetSenderFee(senderFee).
-		SetProtocolFee(decimal.NewFromInt(0)).
+		SetNetworkFee(request.fees.NetworkFee).
+		SetSenderFee(request.fees.SenderFee).
+		SetProtocolFee(request.fees.ProtocolFee).
 		SetToken(token).
 		SetRate(payload.Rate).
 		SetReceiveAddress(receiveAddress).
 		SetReceiveAddressText(receiveAddress.Address).
-		SetFeePercent(feePercent).
-		SetFeeAddress(feeAddress).
-		SetReturnAddress(returnAddress).
+		SetFeePercent(request.fees.SenderFeePercent).
+		SetFeeAddress(request.feeAddress).
+		SetReturnAddress(request.returnAddress).
 		SetReference(payload.Reference).
+		SetSettlementPolicy(settlementPolicy).
+		SetNillableFiatAmount(request.fiatAmount).
+		SetFiatCurrency(request.fiatCurrency).
+		SetRateDriftTolerance(request.rateDriftTolerance).
+		SetRateLockedUntil(time.Now().Add(orderConf.RateLockWindow)).
+		SetQuarantineReason(reviewReason).
+		SetNillableBlockchainService(blockchainService).
 		AddTransactions(transactionLog).
 		Save(ctx)
 	if err != nil {
-		logger.Errorf("error: %v", err)
-		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order", nil)
-		_ = tx.Rollback()
-		return
+		return nil, fmt.Errorf("create payment order: %w", err)
 	}
 
-	// No need to update status here - already done when creating the new row above
-
-	// Create webhook for the smart address to monitor transfers (only for EVM networks)
-	// Skip webhook creation if using Alchemy (webhooks handled separately)
-	useAlchemy := viper.GetBool("USE_ALCHEMY_FOR_RECEIVE_ADDRESSES")
-	if !strings.HasPrefix(payload.Network, "tron") && !useAlchemy {
-		engineService := svc.NewEngineService()
-		webhookID, webhookSecret, err := engineService.CreateTransferWebhook(
-			ctx,
-			token.Edges.Network.ChainID,
-			token.ContractAddress,    // Token contract address
-			receiveAddress.Address,   // Smart address to monitor
-			paymentOrder.ID.String(), // Order ID for webhook name
-		)
-		if err != nil {
-			// Check if this is BNB Smart Chain (chain ID 56) or Lisk (chain ID 1135) which is not supported by Thirdweb
-			if token.Edges.Network.ChainID != 56 && token.Edges.Network.ChainID != 1135 {
-				logger.WithFields(logger.Fields{
-					"ChainID": token.Edges.Network.ChainID,
-					"Network": token.Edges.Network.Identifier,
-					"Error":   err.Error(),
-				}).Errorf("Failed to create transfer webhook: %v", err)
-				u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order", nil)
-				_ = tx.Rollback()
-				return
-			}
-		} else {
-			// Create PaymentWebhook record in database only if webhook was created successfully
-			_, err = tx.PaymentWebhook.
-				Create().
-				SetWebhookID(webhookID).
-				SetWebhookSecret(webhookSecret).
-				SetCallbackURL(fmt.Sprintf("%s/v1/insight/webhook", serverConf.ServerURL)).
-				SetPaymentOrder(paymentOrder).
-				Save(ctx)
-			if err != nil {
-				logger.Errorf("Failed to save payment webhook record: %v", err)
-				u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order", nil)
-				_ = tx.Rollback()
-				return
-			}
-		}
+	if err := common.AssignReceiveAddress(ctx, tx, paymentOrder, receiveAddress); err != nil {
+		return nil, err
 	}
 
 	// Create payment order recipient
@@ -601,31 +811,61 @@ func (ctrl *SenderController) InitiatePaymentOrder(ctx *gin.Context) {
 		SetPaymentOrder(paymentOrder).
 		Save(ctx)
 	if err != nil {
-		logger.Errorf("error: %v", err)
-		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order", nil)
-		_ = tx.Rollback()
-		return
+		return nil, fmt.Errorf("create payment order recipient: %w", err)
 	}
 
-	// Commit the transaction
-	if err := tx.Commit(); err != nil {
-		logger.Errorf("error: %v", err)
-		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to initiate payment order", nil)
-		return
+	return paymentOrder, nil
+}
+
+// notifyPaymentOrderCreated counts a created payment order towards the limits of its sender and
+// notifies the sender that it was initiated
+func notifyPaymentOrderCreated(ctx context.Context, sender *ent.SenderProfile, request *orderRequest, paymentOrder *ent.PaymentOrder, reviewReason string) {
+	token := request.token
+	metrics.OrdersCreated.WithLabelValues(request.payload.Network, token.Symbol).Inc()
+
+	if err := common.RecordSenderOrder(ctx, sender.ID, paymentOrder.ID, request.amountInUSD); err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":   fmt.Sprintf("%v", err),
+			"OrderID": paymentOrder.ID.String(),
+		}).Errorf("Failed to count order towards sender limits")
+	}
+	if reviewReason != "" {
+		logger.WithFields(logger.Fields{
+			"OrderID":  paymentOrder.ID.String(),
+			"SenderID": sender.ID.String(),
+			"Reason":   reviewReason,
+		}).Warnf("Order held for manual review by risk scoring")
 	}
 
-	u.APIResponse(ctx, http.StatusCreated, "success", "Payment order initiated successfully",
-		&types.ReceiveAddressResponse{
-			ID:             paymentOrder.ID,
-			Amount:         paymentOrder.Amount,
-			Token:          payload.Token,
-			Network:        token.Edges.Network.Identifier,
-			ReceiveAddress: receiveAddress.Address,
-			ValidUntil:     receiveAddress.ValidUntil,
-			SenderFee:      senderFee,
-			TransactionFee: token.Edges.Network.Fee,
-			Reference:      paymentOrder.Reference,
-		})
+	// Notify the sender that the order was initiated
+	paymentOrder.Edges.SenderProfile = sender
+	paymentOrder.Edges.Token = token
+	if err := u.SendPaymentOrderWebhook(ctx, paymentOrder); err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":   fmt.Sprintf("%v", err),
+			"OrderID": paymentOrder.ID.String(),
+		}).Errorf("Failed to send payment order initiated webhook")
+	}
+}
+
+// receiveAddressResponse builds the response for a created payment order
+func receiveAddressResponse(request *orderRequest, paymentOrder *ent.PaymentOrder, receiveAddress *ent.ReceiveAddress) *types.ReceiveAddressResponse {
+	network := request.token.Edges.Network
+	return &types.ReceiveAddressResponse{
+		ID:               paymentOrder.ID,
+		Amount:           paymentOrder.Amount,
+		Token:            request.payload.Token,
+		Network:          network.Identifier,
+		ReceiveAddress:   receiveAddress.Address,
+		ValidUntil:       receiveAddress.ValidUntil,
+		SenderFee:        paymentOrder.SenderFee,
+		TransactionFee:   paymentOrder.NetworkFee,
+		Reference:        paymentOrder.Reference,
+		SettlementPolicy: paymentOrder.SettlementPolicy,
+		FiatAmount:       paymentOrder.FiatAmount,
+		FiatCurrency:     paymentOrder.FiatCurrency,
+		RateLockedUntil:  paymentOrder.RateLockedUntil,
+	}
 }
 
 // GetPaymentOrderByID controller fetches a payment order by ID
@@ -731,10 +971,21 @@ func (ctrl *SenderController) GetPaymentOrderByID(ctx *gin.Context) {
 		UpdatedAt:      paymentOrder.UpdatedAt,
 		TxHash:         paymentOrder.TxHash,
 		Status:         paymentOrder.Status,
+
+		SettlementPolicy:   paymentOrder.SettlementPolicy,
+		DepositStatus:      paymentOrder.DepositStatus,
+		DepositFinalizedAt: depositFinalizedAt(paymentOrder),
+		SLA:                orderSLA(ctx, paymentOrder),
+		FiatAmount:         paymentOrder.FiatAmount,
+		FiatCurrency:       paymentOrder.FiatCurrency,
+		FiatConversion:     paymentOrder.FiatConversion,
+		RateLockedUntil:    rateLockedUntil(paymentOrder),
+		RateRequote:        paymentOrder.RateRequote,
 	})
 }
 
-// GetPaymentOrders controller fetches all payment orders
+// GetPaymentOrders controller fetches the payment orders of the sender, with cursor or page
+// pagination, filtering and sorting
 func (ctrl *SenderController) GetPaymentOrders(ctx *gin.Context) {
 	// Get sender profile from the context
 	senderCtx, ok := ctx.Get("sender")
@@ -744,109 +995,67 @@ func (ctrl *SenderController) GetPaymentOrders(ctx *gin.Context) {
 	}
 	sender := senderCtx.(*ent.SenderProfile)
 
-	// Get ordering query param
-	ordering := ctx.Query("ordering")
-	order := ent.Desc(paymentorder.FieldCreatedAt)
-	if ordering == "asc" {
-		order = ent.Asc(paymentorder.FieldCreatedAt)
-	}
-
-	// Get page and pageSize query params
-	page, offset, pageSize := u.Paginate(ctx)
-
-	paymentOrderQuery := storage.Client.PaymentOrder.Query()
-
-	// Filter by sender
-	paymentOrderQuery = paymentOrderQuery.Where(
-		paymentorder.HasSenderProfileWith(senderprofile.IDEQ(sender.ID)),
-	)
-
-	// Filter by status
-	statusQueryParam := ctx.Query("status")
-	statusMap := map[string]paymentorder.Status{
-		"initiated": paymentorder.StatusInitiated,
-		"pending":   paymentorder.StatusPending,
-		"expired":   paymentorder.StatusExpired,
-		"settled":   paymentorder.StatusSettled,
-		"refunded":  paymentorder.StatusRefunded,
-	}
-
-	if status, ok := statusMap[statusQueryParam]; ok {
-		paymentOrderQuery = paymentOrderQuery.Where(
-			paymentorder.StatusEQ(status),
-		)
+	listQuery, err := u.ParseOrderListQuery(ctx)
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid query", err.Error())
+		return
 	}
 
-	// Filter by token
-	tokenQueryParam := ctx.Query("token")
-
-	if tokenQueryParam != "" {
-		tokenExists, err := storage.Client.Token.
-			Query().
-			Where(
-				tokenEnt.SymbolEQ(tokenQueryParam),
-			).
-			Exist(ctx)
-		if err != nil {
-			logger.Errorf("error: %v", err)
-			u.APIResponse(ctx, http.StatusInternalServerError, "error",
-				"Failed to fetch payment orders", nil)
-			return
-		}
+	paymentOrderQuery := storage.Client.PaymentOrder.
+		Query().
+		Where(paymentorder.HasSenderProfileWith(senderprofile.IDEQ(sender.ID)))
 
-		if tokenExists {
-			paymentOrderQuery = paymentOrderQuery.Where(
-				paymentorder.HasTokenWith(
-					tokenEnt.SymbolEQ(tokenQueryParam),
-				),
-			)
+	if len(listQuery.Statuses) > 0 {
+		statuses := make([]paymentorder.Status, 0, len(listQuery.Statuses))
+		for _, status := range listQuery.Statuses {
+			if err := paymentorder.StatusValidator(paymentorder.Status(status)); err != nil {
+				u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid query", fmt.Sprintf("Invalid status %s", status))
+				return
+			}
+			statuses = append(statuses, paymentorder.Status(status))
 		}
+		paymentOrderQuery = paymentOrderQuery.Where(paymentorder.StatusIn(statuses...))
 	}
-
-	// Filter by network
-	networkQueryParam := ctx.Query("network")
-
-	if networkQueryParam != "" {
-		networkExists, err := storage.Client.Network.
-			Query().
-			Where(
-				network.IdentifierEQ(networkQueryParam),
-			).
-			Exist(ctx)
-		if err != nil {
-			logger.Errorf("error: %v", err)
-			u.APIResponse(ctx, http.StatusInternalServerError, "error",
-				"Failed to fetch payment orders", nil)
-			return
-		}
-
-		if networkExists {
-			paymentOrderQuery = paymentOrderQuery.Where(
-				paymentorder.HasTokenWith(
-					tokenEnt.HasNetworkWith(
-						network.IdentifierEQ(networkQueryParam),
-					),
-				),
-			)
-		}
+	if listQuery.Token != "" {
+		paymentOrderQuery = paymentOrderQuery.Where(paymentorder.HasTokenWith(tokenEnt.SymbolEQ(listQuery.Token)))
+	}
+	if listQuery.Network != "" {
+		paymentOrderQuery = paymentOrderQuery.Where(paymentorder.HasTokenWith(
+			tokenEnt.HasNetworkWith(network.IdentifierEQ(listQuery.Network)),
+		))
+	}
+	if listQuery.Reference != "" {
+		paymentOrderQuery = paymentOrderQuery.Where(paymentorder.ReferenceEQ(listQuery.Reference))
+	}
+	if listQuery.From != nil {
+		paymentOrderQuery = paymentOrderQuery.Where(paymentorder.CreatedAtGTE(*listQuery.From))
+	}
+	if listQuery.To != nil {
+		paymentOrderQuery = paymentOrderQuery.Where(paymentorder.CreatedAtLT(*listQuery.To))
 	}
 
-	count, err := paymentOrderQuery.Count(ctx)
+	count, err := paymentOrderQuery.Clone().Count(ctx)
 	if err != nil {
 		logger.Errorf("error: %v", err)
 		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch payment orders", nil)
 		return
 	}
 
-	// Fetch payment orders
+	// Pages after a cursor start after its order, others are offset
+	if listQuery.Cursor != nil {
+		paymentOrderQuery = paymentOrderQuery.Where(predicate.PaymentOrder(listQuery.AfterCursor()))
+	} else {
+		paymentOrderQuery = paymentOrderQuery.Offset(listQuery.Offset)
+	}
+
+	// Fetch one order more than the page to know whether there is a next page
 	paymentOrders, err := paymentOrderQuery.
 		WithRecipient().
 		WithToken(func(tq *ent.TokenQuery) {
 			tq.WithNetwork()
 		}).
-		Limit(pageSize).
-		Offset(offset).
-		Order(order).
+		Order(listQuery.Order()).
+		Limit(listQuery.PageSize + 1).
 		All(ctx)
 	if err != nil {
 		logger.Errorf("error: %v", err)
@@ -855,16 +1064,46 @@ func (ctrl *SenderController) GetPaymentOrders(ctx *gin.Context) {
 		return
 	}
 
+	nextCursor := ""
+	if len(paymentOrders) > listQuery.PageSize {
+		paymentOrders = paymentOrders[:listQuery.PageSize]
+		last := paymentOrders[len(paymentOrders)-1]
+		switch listQuery.SortBy {
+		case u.SortByUpdatedAt:
+			nextCursor = u.EncodeCursor(last.UpdatedAt, last.ID)
+		case u.SortByAmount:
+			nextCursor = u.EncodeCursor(last.Amount, last.ID)
+		default:
+			nextCursor = u.EncodeCursor(last.CreatedAt, last.ID)
+		}
+	}
+
+	// Fetch the institutions of the page at once
+	codes := make([]string, 0, len(paymentOrders))
+	for _, paymentOrder := range paymentOrders {
+		codes = append(codes, paymentOrder.Edges.Recipient.Institution)
+	}
+	institutions, err := storage.Client.Institution.
+		Query().
+		Where(institution.CodeIn(codes...)).
+		WithFiatCurrency().
+		All(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch payment orders", nil)
+		return
+	}
+	institutionsByCode := make(map[string]*ent.Institution, len(institutions))
+	for _, institution := range institutions {
+		institutionsByCode[institution.Code] = institution
+	}
+
 	var orders []types.PaymentOrderResponse
 
 	for _, paymentOrder := range paymentOrders {
-		institution, err := storage.Client.Institution.
-			Query().
-			Where(institution.CodeEQ(paymentOrder.Edges.Recipient.Institution)).
-			WithFiatCurrency().
-			Only(ctx)
-		if err != nil {
-			logger.Errorf("error: %v", err)
+		institution, ok := institutionsByCode[paymentOrder.Edges.Recipient.Institution]
+		if !ok {
+			logger.Errorf("error: institution %s not found", paymentOrder.Edges.Recipient.Institution)
 			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch payment orders", nil)
 			return
 		}
@@ -898,17 +1137,100 @@ func (ctrl *SenderController) GetPaymentOrders(ctx *gin.Context) {
 			UpdatedAt:      paymentOrder.UpdatedAt,
 			TxHash:         paymentOrder.TxHash,
 			Status:         paymentOrder.Status,
+
+			SettlementPolicy:   paymentOrder.SettlementPolicy,
+			DepositStatus:      paymentOrder.DepositStatus,
+			DepositFinalizedAt: depositFinalizedAt(paymentOrder),
+			SLA:                orderSLA(ctx, paymentOrder),
+			FiatAmount:         paymentOrder.FiatAmount,
+			FiatCurrency:       paymentOrder.FiatCurrency,
+			FiatConversion:     paymentOrder.FiatConversion,
+			RateLockedUntil:    rateLockedUntil(paymentOrder),
+			RateRequote:        paymentOrder.RateRequote,
 		})
 	}
 
 	u.APIResponse(ctx, http.StatusOK, "success", "Payment orders retrieved successfully", types.SenderPaymentOrderList{
-		Page:         page,
-		PageSize:     pageSize,
+		Page:         listQuery.Page,
+		PageSize:     listQuery.PageSize,
 		TotalRecords: count,
+		NextCursor:   nextCursor,
 		Orders:       orders,
 	})
 }
 
+// GetWebhookDeliveries controller fetches the sender's webhook delivery attempts
+func (ctrl *SenderController) GetWebhookDeliveries(ctx *gin.Context) {
+	// Get sender profile from the context
+	senderCtx, ok := ctx.Get("sender")
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+	sender := senderCtx.(*ent.SenderProfile)
+
+	// Get page and pageSize query params
+	page, offset, pageSize := u.Paginate(ctx)
+
+	deliveryQuery := storage.Client.WebhookDelivery.
+		Query().
+		Where(webhookdelivery.HasSenderProfileWith(senderprofile.IDEQ(sender.ID)))
+
+	// Filter by order
+	if orderID := ctx.Query("orderId"); orderID != "" {
+		id, err := uuid.Parse(orderID)
+		if err != nil {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid order ID", nil)
+			return
+		}
+		deliveryQuery = deliveryQuery.Where(webhookdelivery.OrderIDEQ(id))
+	}
+
+	// Filter by event
+	if event := ctx.Query("event"); event != "" {
+		deliveryQuery = deliveryQuery.Where(webhookdelivery.EventEQ(event))
+	}
+
+	// Filter by status
+	if status := ctx.Query("status"); status != "" {
+		if err := webhookdelivery.StatusValidator(webhookdelivery.Status(status)); err != nil {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid status", nil)
+			return
+		}
+		deliveryQuery = deliveryQuery.Where(webhookdelivery.StatusEQ(webhookdelivery.Status(status)))
+	}
+
+	count, err := deliveryQuery.Count(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch webhook deliveries", nil)
+		return
+	}
+
+	deliveries, err := deliveryQuery.
+		Limit(pageSize).
+		Offset(offset).
+		Order(ent.Desc(webhookdelivery.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch webhook deliveries", nil)
+		return
+	}
+
+	response := make([]types.WebhookDeliveryResponse, len(deliveries))
+	for i, delivery := range deliveries {
+		response[i] = u.WebhookDeliveryResponse(delivery)
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Webhook deliveries retrieved successfully", types.WebhookDeliveryList{
+		TotalRecords: count,
+		Page:         page,
+		PageSize:     pageSize,
+		Deliveries:   response,
+	})
+}
+
 // Stats controller fetches sender stats
 func (ctrl *SenderController) Stats(ctx *gin.Context) {
 	// Get sender profile from the context
@@ -999,3 +1321,569 @@ func (ctrl *SenderController) Stats(ctx *gin.Context) {
 		TotalFeeEarnings: w[0].SumFieldSenderFee.Add(localStablecoinSenderFee),
 	})
 }
+
+// GetLimits controller returns the limits of the sender and its usage of them
+func (ctrl *SenderController) GetLimits(ctx *gin.Context) {
+	// Get sender profile from the context
+	senderCtx, ok := ctx.Get("sender")
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+	sender := senderCtx.(*ent.SenderProfile)
+
+	limits, err := common.SenderLimits(ctx, sender)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch sender limits", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Sender limits fetched successfully", limits)
+}
+
+// ExtendReceiveAddress controller keeps the receive address of an unpaid order of the sender valid
+// for longer. An order that already expired is re-activated on the same address
+func (ctrl *SenderController) ExtendReceiveAddress(ctx *gin.Context) {
+	var payload types.ExtendReceiveAddressPayload
+	if err := ctx.ShouldBindJSON(&payload); err != nil && !errors.Is(err, io.EOF) {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	paymentOrder, ok := senderPaymentOrder(ctx)
+	if !ok {
+		return
+	}
+	sender := ctx.MustGet("sender").(*ent.SenderProfile)
+
+	validity := common.OrderTTL(sender, paymentOrder.Edges.Token.Edges.Network)
+	if payload.ValidFor > 0 {
+		validity = time.Duration(payload.ValidFor) * time.Minute
+	}
+	if maxExtension := config.OrderConfig().ReceiveAddressMaxExtension; validity > maxExtension {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+			Field:   "validFor",
+			Message: fmt.Sprintf("Receive address can be extended by at most %d minutes", int(maxExtension.Minutes())),
+		})
+		return
+	}
+
+	paymentOrder, err := common.ExtendReceiveAddress(ctx, paymentOrder.ID, validity)
+	if err != nil {
+		switch {
+		case errors.Is(err, common.ErrOrderNotExtendable), errors.Is(err, common.ErrReceiveAddressUnavailable):
+			u.APIResponse(ctx, http.StatusConflict, "error", err.Error(), nil)
+			return
+		}
+
+		logger.WithFields(logger.Fields{
+			"Error":   fmt.Sprintf("%v", err),
+			"OrderID": ctx.Param("id"),
+		}).Errorf("Failed to extend receive address")
+
+		// A returned order was extended; only re-registering its webhook or notifying the sender failed
+		if paymentOrder == nil {
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to extend receive address", nil)
+			return
+		}
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Receive address extended successfully", &types.ReceiveAddressResponse{
+		ID:               paymentOrder.ID,
+		Amount:           paymentOrder.Amount,
+		Token:            paymentOrder.Edges.Token.Symbol,
+		Network:          paymentOrder.Edges.Token.Edges.Network.Identifier,
+		ReceiveAddress:   paymentOrder.Edges.ReceiveAddress.Address,
+		ValidUntil:       paymentOrder.Edges.ReceiveAddress.ValidUntil,
+		SenderFee:        paymentOrder.SenderFee,
+		TransactionFee:   paymentOrder.NetworkFee,
+		Reference:        paymentOrder.Reference,
+		SettlementPolicy: paymentOrder.SettlementPolicy,
+		FiatAmount:       paymentOrder.FiatAmount,
+		FiatCurrency:     paymentOrder.FiatCurrency,
+		RateLockedUntil:  paymentOrder.RateLockedUntil,
+	})
+}
+
+// GetPermitDeposit controller returns the EIP-2612 permit a payer signs to pay the deposit of an order
+func (ctrl *SenderController) GetPermitDeposit(ctx *gin.Context) {
+	owner := ctx.Query("owner")
+	if owner == "" {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+			Field:   "owner",
+			Message: "Owner address is required",
+		})
+		return
+	}
+
+	paymentOrder, ok := senderPaymentOrder(ctx)
+	if !ok {
+		return
+	}
+
+	params, err := ctrl.permitDeposit.Params(ctx, paymentOrder, owner)
+	if err != nil {
+		permitDepositError(ctx, paymentOrder, err)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Permit fetched successfully", params)
+}
+
+// SubmitPermitDeposit controller pays the deposit of an order with a permit signed by the payer, pulling
+// the amount due into the receive address without the payer spending gas
+func (ctrl *SenderController) SubmitPermitDeposit(ctx *gin.Context) {
+	var payload types.PermitDepositPayload
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	paymentOrder, ok := senderPaymentOrder(ctx)
+	if !ok {
+		return
+	}
+
+	deposit, err := ctrl.permitDeposit.Submit(ctx, paymentOrder, &payload)
+	if err != nil {
+		permitDepositError(ctx, paymentOrder, err)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Permit deposit submitted successfully", deposit)
+}
+
+// senderPaymentOrder fetches the payment order of the URL that belongs to the authenticated sender,
+// with its token and network. It responds with an error and returns false if there is none
+func senderPaymentOrder(ctx *gin.Context) (*ent.PaymentOrder, bool) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid order ID", nil)
+		return nil, false
+	}
+
+	senderCtx, ok := ctx.Get("sender")
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return nil, false
+	}
+	sender := senderCtx.(*ent.SenderProfile)
+
+	paymentOrder, err := storage.Client.PaymentOrder.
+		Query().
+		Where(
+			paymentorder.IDEQ(id),
+			paymentorder.HasSenderProfileWith(senderprofile.IDEQ(sender.ID)),
+		).
+		WithToken(func(tq *ent.TokenQuery) {
+			tq.WithNetwork()
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Payment order not found", nil)
+		} else {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch payment order", nil)
+		}
+		return nil, false
+	}
+
+	return paymentOrder, true
+}
+
+// permitDepositError responds to a permit deposit that could not be made
+func permitDepositError(ctx *gin.Context, paymentOrder *ent.PaymentOrder, err error) {
+	switch {
+	case errors.Is(err, orderSvc.ErrPermitDepositPending), errors.Is(err, orderSvc.ErrPermitOrderNotPayable):
+		u.APIResponse(ctx, http.StatusConflict, "error", err.Error(), nil)
+		return
+	case errors.Is(err, orderSvc.ErrPermitNetworkUnsupported),
+		errors.Is(err, orderSvc.ErrPermitTokenUnsupported),
+		errors.Is(err, orderSvc.ErrPermitDeadlineTooSoon),
+		errors.Is(err, orderSvc.ErrPermitInvalidSignature),
+		errors.Is(err, orderSvc.ErrPermitInsufficientBalance):
+		u.APIResponse(ctx, http.StatusBadRequest, "error", err.Error(), nil)
+		return
+	}
+
+	logger.WithFields(logger.Fields{
+		"Error":   fmt.Sprintf("%v", err),
+		"OrderID": paymentOrder.ID,
+	}).Errorf("Failed to make permit deposit")
+	u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to make permit deposit", nil)
+}
+
+// depositFinalizedAt returns when the order's deposit became final, or nil if it isn't yet
+func depositFinalizedAt(paymentOrder *ent.PaymentOrder) *time.Time {
+	if paymentOrder.DepositFinalizedAt.IsZero() {
+		return nil
+	}
+	return &paymentOrder.DepositFinalizedAt
+}
+
+// rateLockedUntil returns when the quoted rate of a payment order stops being honored, omitting it
+// for orders created before rate locks
+func rateLockedUntil(paymentOrder *ent.PaymentOrder) *time.Time {
+	if paymentOrder.RateLockedUntil.IsZero() {
+		return nil
+	}
+	return &paymentOrder.RateLockedUntil
+}
+
+// orderSLA returns the SLA state of a payment order, omitting it when it cannot be determined
+func orderSLA(ctx *gin.Context, paymentOrder *ent.PaymentOrder) *types.OrderSLA {
+	sla, err := common.PaymentOrderSLA(ctx, paymentOrder)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":   fmt.Sprintf("%v", err),
+			"OrderID": paymentOrder.ID.String(),
+		}).Errorf("Failed to get order SLA")
+		return nil
+	}
+	return sla
+}
+
+// CreateLinkedAddress controller creates a linked address bound to a recipient of the sender.
+// Transfers to the address become payment orders to the recipient, within the restrictions set
+func (ctrl *SenderController) CreateLinkedAddress(ctx *gin.Context) {
+	var payload types.SenderLinkedAddressPayload
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	// Get sender profile from the context
+	senderCtx, ok := ctx.Get("sender")
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+	sender := senderCtx.(*ent.SenderProfile)
+
+	if payload.MaxTransferAmount.IsNegative() || payload.DailyVolumeLimit.IsNegative() {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+			Field:   "MaxTransferAmount",
+			Message: "Volume limits must not be negative",
+		})
+		return
+	}
+
+	// Check the vanity handle is free
+	var handle string
+	if payload.Handle != "" {
+		var err error
+		handle, err = common.NormalizeLinkedAddressHandle(payload.Handle)
+		if err != nil {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+				Field:   "Handle",
+				Message: err.Error(),
+			})
+			return
+		}
+
+		taken, err := storage.Client.LinkedAddress.
+			Query().
+			Where(linkedaddress.HandleEQ(handle)).
+			Exist(ctx)
+		if err != nil {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to create linked address", nil)
+			return
+		}
+		if taken {
+			u.APIResponse(ctx, http.StatusConflict, "error", "Handle is already taken", nil)
+			return
+		}
+	}
+
+	// Validate the recipient binding
+	_, err := u.GetInstitutionByCode(ctx, payload.Institution, true)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+				Field:   "Institution",
+				Message: "Provided institution is not supported",
+			})
+		} else {
+			logger.Errorf("Failed to fetch institution: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to create linked address", nil)
+		}
+		return
+	}
+
+	// Validate the chain and token restrictions. Linked addresses are smart accounts, so only
+	// EVM networks can be allowed
+	if len(payload.Networks) > 0 {
+		count, err := storage.Client.Network.
+			Query().
+			Where(
+				network.IdentifierIn(payload.Networks...),
+				network.NetworkTypeEQ(network.NetworkTypeEvm),
+			).
+			Count(ctx)
+		if err != nil {
+			logger.Errorf("Failed to fetch networks: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to create linked address", nil)
+			return
+		}
+		if count != len(payload.Networks) {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+				Field:   "Networks",
+				Message: "Provided networks must be supported EVM networks",
+			})
+			return
+		}
+	}
+
+	for _, symbol := range payload.Tokens {
+		supported, err := storage.Client.Token.
+			Query().
+			Where(
+				tokenEnt.SymbolEQ(symbol),
+				tokenEnt.IsEnabledEQ(true),
+			).
+			Exist(ctx)
+		if err != nil {
+			logger.Errorf("Failed to fetch token: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to create linked address", nil)
+			return
+		}
+		if !supported {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+				Field:   "Tokens",
+				Message: fmt.Sprintf("Provided token %s is not supported", symbol),
+			})
+			return
+		}
+	}
+
+	// Generate smart account
+	address, salt, err := ctrl.receiveAddressService.CreateSmartAddress(ctx, "")
+	if err != nil {
+		logger.Errorf("Error: Failed to create linked address: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to create linked address", nil)
+		return
+	}
+
+	create := storage.Client.LinkedAddress.
+		Create().
+		SetAddress(address).
+		SetSalt(salt).
+		SetInstitution(payload.Institution).
+		SetAccountIdentifier(payload.AccountIdentifier).
+		SetAccountName(payload.AccountName).
+		SetAllowedNetworks(payload.Networks).
+		SetAllowedTokens(payload.Tokens).
+		SetMaxTransferAmount(payload.MaxTransferAmount).
+		SetDailyVolumeLimit(payload.DailyVolumeLimit).
+		SetSenderProfile(sender)
+	if payload.Metadata != nil {
+		create.SetMetadata(payload.Metadata)
+	}
+	if handle != "" {
+		create.SetHandle(handle)
+	}
+
+	linkedAddress, err := create.Save(ctx)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":       fmt.Sprintf("%v", err),
+			"Institution": payload.Institution,
+			"SenderID":    sender.ID,
+			"Address":     address,
+		}).Errorf("Failed to set linked address")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to create linked address", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusCreated, "success", "Linked address created successfully", senderLinkedAddressResponse(linkedAddress))
+}
+
+// GetLinkedAddresses controller lists the linked addresses of the sender
+func (ctrl *SenderController) GetLinkedAddresses(ctx *gin.Context) {
+	// Get sender profile from the context
+	senderCtx, ok := ctx.Get("sender")
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+	sender := senderCtx.(*ent.SenderProfile)
+
+	// Get page and pageSize query params
+	page, offset, pageSize := u.Paginate(ctx)
+
+	linkedAddressQuery := storage.Client.LinkedAddress.
+		Query().
+		Where(linkedaddress.HasSenderProfileWith(senderprofile.IDEQ(sender.ID)))
+
+	// Filter by active status
+	if active := ctx.Query("active"); active != "" {
+		isActive, err := strconv.ParseBool(active)
+		if err != nil {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid active filter", nil)
+			return
+		}
+		linkedAddressQuery = linkedAddressQuery.Where(linkedaddress.IsActiveEQ(isActive))
+	}
+
+	count, err := linkedAddressQuery.Count(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch linked addresses", nil)
+		return
+	}
+
+	linkedAddresses, err := linkedAddressQuery.
+		Limit(pageSize).
+		Offset(offset).
+		Order(ent.Desc(linkedaddress.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch linked addresses", nil)
+		return
+	}
+
+	response := make([]types.SenderLinkedAddressResponse, len(linkedAddresses))
+	for i, linkedAddress := range linkedAddresses {
+		response[i] = *senderLinkedAddressResponse(linkedAddress)
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Linked addresses retrieved successfully", types.SenderLinkedAddressList{
+		TotalRecords:    count,
+		Page:            page,
+		PageSize:        pageSize,
+		LinkedAddresses: response,
+	})
+}
+
+// RotateLinkedAddress controller replaces a linked address of the sender with a new address bound
+// to the same recipient, restrictions and handle. The old address is deactivated
+func (ctrl *SenderController) RotateLinkedAddress(ctx *gin.Context) {
+	sender, linkedAddress, ok := senderLinkedAddress(ctx)
+	if !ok {
+		return
+	}
+
+	if !linkedAddress.IsActive {
+		u.APIResponse(ctx, http.StatusConflict, "error", common.ErrLinkedAddressInactive.Error(), nil)
+		return
+	}
+
+	// Generate smart account
+	address, salt, err := ctrl.receiveAddressService.CreateSmartAddress(ctx, "")
+	if err != nil {
+		logger.Errorf("Error: Failed to create linked address: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to rotate linked address", nil)
+		return
+	}
+
+	rotated, err := common.RotateLinkedAddress(ctx, sender, linkedAddress, address, salt)
+	if err != nil {
+		if errors.Is(err, common.ErrLinkedAddressInactive) {
+			u.APIResponse(ctx, http.StatusConflict, "error", err.Error(), nil)
+			return
+		}
+		logger.WithFields(logger.Fields{
+			"Error":         fmt.Sprintf("%v", err),
+			"LinkedAddress": linkedAddress.Address,
+			"Address":       address,
+		}).Errorf("Failed to rotate linked address")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to rotate linked address", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusCreated, "success", "Linked address rotated successfully", senderLinkedAddressResponse(rotated))
+}
+
+// DeactivateLinkedAddress controller deactivates a linked address of the sender and releases its
+// handle. Transfers to a deactivated address no longer create payment orders
+func (ctrl *SenderController) DeactivateLinkedAddress(ctx *gin.Context) {
+	_, linkedAddress, ok := senderLinkedAddress(ctx)
+	if !ok {
+		return
+	}
+
+	if !linkedAddress.IsActive {
+		u.APIResponse(ctx, http.StatusConflict, "error", common.ErrLinkedAddressInactive.Error(), nil)
+		return
+	}
+
+	deactivated, err := linkedAddress.
+		Update().
+		SetIsActive(false).
+		ClearHandle().
+		Save(ctx)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":         fmt.Sprintf("%v", err),
+			"LinkedAddress": linkedAddress.Address,
+		}).Errorf("Failed to deactivate linked address")
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to deactivate linked address", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Linked address deactivated successfully", senderLinkedAddressResponse(deactivated))
+}
+
+// senderLinkedAddress fetches the linked address of the URL that belongs to the authenticated sender,
+// responding with an error when there is none
+func senderLinkedAddress(ctx *gin.Context) (*ent.SenderProfile, *ent.LinkedAddress, bool) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid linked address ID", nil)
+		return nil, nil, false
+	}
+
+	senderCtx, ok := ctx.Get("sender")
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return nil, nil, false
+	}
+	sender := senderCtx.(*ent.SenderProfile)
+
+	linkedAddress, err := storage.Client.LinkedAddress.
+		Query().
+		Where(
+			linkedaddress.IDEQ(id),
+			linkedaddress.HasSenderProfileWith(senderprofile.IDEQ(sender.ID)),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Linked address not found", nil)
+		} else {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch linked address", nil)
+		}
+		return nil, nil, false
+	}
+
+	return sender, linkedAddress, true
+}
+
+// senderLinkedAddressResponse converts a linked address to its API response
+func senderLinkedAddressResponse(linkedAddress *ent.LinkedAddress) *types.SenderLinkedAddressResponse {
+	return &types.SenderLinkedAddressResponse{
+		ID:                linkedAddress.ID,
+		LinkedAddress:     linkedAddress.Address,
+		Handle:            linkedAddress.Handle,
+		Institution:       linkedAddress.Institution,
+		AccountIdentifier: linkedAddress.AccountIdentifier,
+		AccountName:       linkedAddress.AccountName,
+		Networks:          linkedAddress.AllowedNetworks,
+		Tokens:            linkedAddress.AllowedTokens,
+		MaxTransferAmount: linkedAddress.MaxTransferAmount,
+		DailyVolumeLimit:  linkedAddress.DailyVolumeLimit,
+		IsActive:          linkedAddress.IsActive,
+		ReplacedBy:        linkedAddress.ReplacedBy,
+		CreatedAt:         linkedAddress.CreatedAt,
+		UpdatedAt:         linkedAddress.UpdatedAt,
+	}
+}
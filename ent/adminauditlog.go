@@ -0,0 +1,167 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/NEDA-LABS/stablenode/ent/adminauditlog"
+	"github.com/google/uuid"
+)
+
+// AdminAuditLog is the model entity for the AdminAuditLog schema.
+type AdminAuditLog struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Action holds the value of the "action" field.
+	Action adminauditlog.Action `json:"action,omitempty"`
+	// TargetID holds the value of the "target_id" field.
+	TargetID string `json:"target_id,omitempty"`
+	// Actor holds the value of the "actor" field.
+	Actor string `json:"actor,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AdminAuditLog) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case adminauditlog.FieldMetadata:
+			values[i] = new([]byte)
+		case adminauditlog.FieldAction, adminauditlog.FieldTargetID, adminauditlog.FieldActor, adminauditlog.FieldReason:
+			values[i] = new(sql.NullString)
+		case adminauditlog.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case adminauditlog.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AdminAuditLog fields.
+func (aal *AdminAuditLog) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case adminauditlog.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				aal.ID = *value
+			}
+		case adminauditlog.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				aal.Action = adminauditlog.Action(value.String)
+			}
+		case adminauditlog.FieldTargetID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target_id", values[i])
+			} else if value.Valid {
+				aal.TargetID = value.String
+			}
+		case adminauditlog.FieldActor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor", values[i])
+			} else if value.Valid {
+				aal.Actor = value.String
+			}
+		case adminauditlog.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				aal.Reason = value.String
+			}
+		case adminauditlog.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &aal.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		case adminauditlog.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				aal.CreatedAt = value.Time
+			}
+		default:
+			aal.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AdminAuditLog.
+// This includes values selected through modifiers, order, etc.
+func (aal *AdminAuditLog) Value(name string) (ent.Value, error) {
+	return aal.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AdminAuditLog.
+// Note that you need to call AdminAuditLog.Unwrap() before calling this method if this AdminAuditLog
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (aal *AdminAuditLog) Update() *AdminAuditLogUpdateOne {
+	return NewAdminAuditLogClient(aal.config).UpdateOne(aal)
+}
+
+// Unwrap unwraps the AdminAuditLog entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (aal *AdminAuditLog) Unwrap() *AdminAuditLog {
+	_tx, ok := aal.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AdminAuditLog is not a transactional entity")
+	}
+	aal.config.driver = _tx.drv
+	return aal
+}
+
+// String implements the fmt.Stringer.
+func (aal *AdminAuditLog) String() string {
+	var builder strings.Builder
+	builder.WriteString("AdminAuditLog(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", aal.ID))
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", aal.Action))
+	builder.WriteString(", ")
+	builder.WriteString("target_id=")
+	builder.WriteString(aal.TargetID)
+	builder.WriteString(", ")
+	builder.WriteString("actor=")
+	builder.WriteString(aal.Actor)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(aal.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", aal.Metadata))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(aal.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AdminAuditLogs is a parsable slice of AdminAuditLog.
+type AdminAuditLogs []*AdminAuditLog
@@ -0,0 +1,125 @@
+// Code generated by ent, DO NOT EDIT.
+
+package adminauditlog
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the adminauditlog type in the database.
+	Label = "admin_audit_log"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldTargetID holds the string denoting the target_id field in the database.
+	FieldTargetID = "target_id"
+	// FieldActor holds the string denoting the actor field in the database.
+	FieldActor = "actor"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldMetadata holds the string denoting the metadata field in the database.
+	FieldMetadata = "metadata"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the adminauditlog in the database.
+	Table = "admin_audit_logs"
+)
+
+// Columns holds all SQL columns for adminauditlog fields.
+var Columns = []string{
+	FieldID,
+	FieldAction,
+	FieldTargetID,
+	FieldActor,
+	FieldReason,
+	FieldMetadata,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	ReasonValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Action defines the type for the "action" enum field.
+type Action string
+
+// Action values.
+const (
+	ActionForceRefund            Action = "force_refund"
+	ActionRequeue                Action = "requeue"
+	ActionReassignProvider       Action = "reassign_provider"
+	ActionApproveQuarantined     Action = "approve_quarantined"
+	ActionRefundQuarantined      Action = "refund_quarantined"
+	ActionLinkUnmatchedDeposit   Action = "link_unmatched_deposit"
+	ActionRefundUnmatchedDeposit Action = "refund_unmatched_deposit"
+	ActionSweepUnmatchedDeposit  Action = "sweep_unmatched_deposit"
+	ActionRetryDeadLetter        Action = "retry_dead_letter"
+)
+
+func (a Action) String() string {
+	return string(a)
+}
+
+// ActionValidator is a validator for the "action" field enum values. It is called by the builders before save.
+func ActionValidator(a Action) error {
+	switch a {
+	case ActionForceRefund, ActionRequeue, ActionReassignProvider, ActionApproveQuarantined, ActionRefundQuarantined, ActionLinkUnmatchedDeposit, ActionRefundUnmatchedDeposit, ActionSweepUnmatchedDeposit, ActionRetryDeadLetter:
+		return nil
+	default:
+		return fmt.Errorf("adminauditlog: invalid enum value for action field: %q", a)
+	}
+}
+
+// OrderOption defines the ordering options for the AdminAuditLog queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByAction orders the results by the action field.
+func ByAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAction, opts...).ToFunc()
+}
+
+// ByTargetID orders the results by the target_id field.
+func ByTargetID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTargetID, opts...).ToFunc()
+}
+
+// ByActor orders the results by the actor field.
+func ByActor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActor, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
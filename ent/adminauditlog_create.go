@@ -0,0 +1,589 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/adminauditlog"
+	"github.com/google/uuid"
+)
+
+// AdminAuditLogCreate is the builder for creating a AdminAuditLog entity.
+type AdminAuditLogCreate struct {
+	config
+	mutation *AdminAuditLogMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetAction sets the "action" field.
+func (aalc *AdminAuditLogCreate) SetAction(a adminauditlog.Action) *AdminAuditLogCreate {
+	aalc.mutation.SetAction(a)
+	return aalc
+}
+
+// SetTargetID sets the "target_id" field.
+func (aalc *AdminAuditLogCreate) SetTargetID(s string) *AdminAuditLogCreate {
+	aalc.mutation.SetTargetID(s)
+	return aalc
+}
+
+// SetActor sets the "actor" field.
+func (aalc *AdminAuditLogCreate) SetActor(s string) *AdminAuditLogCreate {
+	aalc.mutation.SetActor(s)
+	return aalc
+}
+
+// SetReason sets the "reason" field.
+func (aalc *AdminAuditLogCreate) SetReason(s string) *AdminAuditLogCreate {
+	aalc.mutation.SetReason(s)
+	return aalc
+}
+
+// SetMetadata sets the "metadata" field.
+func (aalc *AdminAuditLogCreate) SetMetadata(m map[string]interface{}) *AdminAuditLogCreate {
+	aalc.mutation.SetMetadata(m)
+	return aalc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (aalc *AdminAuditLogCreate) SetCreatedAt(t time.Time) *AdminAuditLogCreate {
+	aalc.mutation.SetCreatedAt(t)
+	return aalc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (aalc *AdminAuditLogCreate) SetNillableCreatedAt(t *time.Time) *AdminAuditLogCreate {
+	if t != nil {
+		aalc.SetCreatedAt(*t)
+	}
+	return aalc
+}
+
+// SetID sets the "id" field.
+func (aalc *AdminAuditLogCreate) SetID(u uuid.UUID) *AdminAuditLogCreate {
+	aalc.mutation.SetID(u)
+	return aalc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (aalc *AdminAuditLogCreate) SetNillableID(u *uuid.UUID) *AdminAuditLogCreate {
+	if u != nil {
+		aalc.SetID(*u)
+	}
+	return aalc
+}
+
+// Mutation returns the AdminAuditLogMutation object of the builder.
+func (aalc *AdminAuditLogCreate) Mutation() *AdminAuditLogMutation {
+	return aalc.mutation
+}
+
+// Save creates the AdminAuditLog in the database.
+func (aalc *AdminAuditLogCreate) Save(ctx context.Context) (*AdminAuditLog, error) {
+	aalc.defaults()
+	return withHooks(ctx, aalc.sqlSave, aalc.mutation, aalc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (aalc *AdminAuditLogCreate) SaveX(ctx context.Context) *AdminAuditLog {
+	v, err := aalc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aalc *AdminAuditLogCreate) Exec(ctx context.Context) error {
+	_, err := aalc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aalc *AdminAuditLogCreate) ExecX(ctx context.Context) {
+	if err := aalc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aalc *AdminAuditLogCreate) defaults() {
+	if _, ok := aalc.mutation.CreatedAt(); !ok {
+		v := adminauditlog.DefaultCreatedAt()
+		aalc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := aalc.mutation.ID(); !ok {
+		v := adminauditlog.DefaultID()
+		aalc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aalc *AdminAuditLogCreate) check() error {
+	if _, ok := aalc.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "AdminAuditLog.action"`)}
+	}
+	if v, ok := aalc.mutation.Action(); ok {
+		if err := adminauditlog.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "AdminAuditLog.action": %w`, err)}
+		}
+	}
+	if _, ok := aalc.mutation.TargetID(); !ok {
+		return &ValidationError{Name: "target_id", err: errors.New(`ent: missing required field "AdminAuditLog.target_id"`)}
+	}
+	if _, ok := aalc.mutation.Actor(); !ok {
+		return &ValidationError{Name: "actor", err: errors.New(`ent: missing required field "AdminAuditLog.actor"`)}
+	}
+	if _, ok := aalc.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "AdminAuditLog.reason"`)}
+	}
+	if v, ok := aalc.mutation.Reason(); ok {
+		if err := adminauditlog.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "AdminAuditLog.reason": %w`, err)}
+		}
+	}
+	if _, ok := aalc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AdminAuditLog.created_at"`)}
+	}
+	return nil
+}
+
+func (aalc *AdminAuditLogCreate) sqlSave(ctx context.Context) (*AdminAuditLog, error) {
+	if err := aalc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := aalc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, aalc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	aalc.mutation.id = &_node.ID
+	aalc.mutation.done = true
+	return _node, nil
+}
+
+func (aalc *AdminAuditLogCreate) createSpec() (*AdminAuditLog, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AdminAuditLog{config: aalc.config}
+		_spec = sqlgraph.NewCreateSpec(adminauditlog.Table, sqlgraph.NewFieldSpec(adminauditlog.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = aalc.conflict
+	if id, ok := aalc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := aalc.mutation.Action(); ok {
+		_spec.SetField(adminauditlog.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := aalc.mutation.TargetID(); ok {
+		_spec.SetField(adminauditlog.FieldTargetID, field.TypeString, value)
+		_node.TargetID = value
+	}
+	if value, ok := aalc.mutation.Actor(); ok {
+		_spec.SetField(adminauditlog.FieldActor, field.TypeString, value)
+		_node.Actor = value
+	}
+	if value, ok := aalc.mutation.Reason(); ok {
+		_spec.SetField(adminauditlog.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := aalc.mutation.Metadata(); ok {
+		_spec.SetField(adminauditlog.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if value, ok := aalc.mutation.CreatedAt(); ok {
+		_spec.SetField(adminauditlog.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AdminAuditLog.Create().
+//		SetAction(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AdminAuditLogUpsert) {
+//			SetAction(v+v).
+//		}).
+//		Exec(ctx)
+func (aalc *AdminAuditLogCreate) OnConflict(opts ...sql.ConflictOption) *AdminAuditLogUpsertOne {
+	aalc.conflict = opts
+	return &AdminAuditLogUpsertOne{
+		create: aalc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AdminAuditLog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (aalc *AdminAuditLogCreate) OnConflictColumns(columns ...string) *AdminAuditLogUpsertOne {
+	aalc.conflict = append(aalc.conflict, sql.ConflictColumns(columns...))
+	return &AdminAuditLogUpsertOne{
+		create: aalc,
+	}
+}
+
+type (
+	// AdminAuditLogUpsertOne is the builder for "upsert"-ing
+	//  one AdminAuditLog node.
+	AdminAuditLogUpsertOne struct {
+		create *AdminAuditLogCreate
+	}
+
+	// AdminAuditLogUpsert is the "OnConflict" setter.
+	AdminAuditLogUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.AdminAuditLog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(adminauditlog.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AdminAuditLogUpsertOne) UpdateNewValues() *AdminAuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(adminauditlog.FieldID)
+		}
+		if _, exists := u.create.mutation.Action(); exists {
+			s.SetIgnore(adminauditlog.FieldAction)
+		}
+		if _, exists := u.create.mutation.TargetID(); exists {
+			s.SetIgnore(adminauditlog.FieldTargetID)
+		}
+		if _, exists := u.create.mutation.Actor(); exists {
+			s.SetIgnore(adminauditlog.FieldActor)
+		}
+		if _, exists := u.create.mutation.Reason(); exists {
+			s.SetIgnore(adminauditlog.FieldReason)
+		}
+		if _, exists := u.create.mutation.Metadata(); exists {
+			s.SetIgnore(adminauditlog.FieldMetadata)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(adminauditlog.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AdminAuditLog.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AdminAuditLogUpsertOne) Ignore() *AdminAuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AdminAuditLogUpsertOne) DoNothing() *AdminAuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AdminAuditLogCreate.OnConflict
+// documentation for more info.
+func (u *AdminAuditLogUpsertOne) Update(set func(*AdminAuditLogUpsert)) *AdminAuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AdminAuditLogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *AdminAuditLogUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AdminAuditLogCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AdminAuditLogUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AdminAuditLogUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: AdminAuditLogUpsertOne.ID is not supported by MySQL driver. Use AdminAuditLogUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AdminAuditLogUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AdminAuditLogCreateBulk is the builder for creating many AdminAuditLog entities in bulk.
+type AdminAuditLogCreateBulk struct {
+	config
+	err      error
+	builders []*AdminAuditLogCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AdminAuditLog entities in the database.
+func (aalcb *AdminAuditLogCreateBulk) Save(ctx context.Context) ([]*AdminAuditLog, error) {
+	if aalcb.err != nil {
+		return nil, aalcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(aalcb.builders))
+	nodes := make([]*AdminAuditLog, len(aalcb.builders))
+	mutators := make([]Mutator, len(aalcb.builders))
+	for i := range aalcb.builders {
+		func(i int, root context.Context) {
+			builder := aalcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AdminAuditLogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, aalcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = aalcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, aalcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, aalcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aalcb *AdminAuditLogCreateBulk) SaveX(ctx context.Context) []*AdminAuditLog {
+	v, err := aalcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aalcb *AdminAuditLogCreateBulk) Exec(ctx context.Context) error {
+	_, err := aalcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aalcb *AdminAuditLogCreateBulk) ExecX(ctx context.Context) {
+	if err := aalcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AdminAuditLog.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AdminAuditLogUpsert) {
+//			SetAction(v+v).
+//		}).
+//		Exec(ctx)
+func (aalcb *AdminAuditLogCreateBulk) OnConflict(opts ...sql.ConflictOption) *AdminAuditLogUpsertBulk {
+	aalcb.conflict = opts
+	return &AdminAuditLogUpsertBulk{
+		create: aalcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AdminAuditLog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (aalcb *AdminAuditLogCreateBulk) OnConflictColumns(columns ...string) *AdminAuditLogUpsertBulk {
+	aalcb.conflict = append(aalcb.conflict, sql.ConflictColumns(columns...))
+	return &AdminAuditLogUpsertBulk{
+		create: aalcb,
+	}
+}
+
+// AdminAuditLogUpsertBulk is the builder for "upsert"-ing
+// a bulk of AdminAuditLog nodes.
+type AdminAuditLogUpsertBulk struct {
+	create *AdminAuditLogCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AdminAuditLog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(adminauditlog.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AdminAuditLogUpsertBulk) UpdateNewValues() *AdminAuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(adminauditlog.FieldID)
+			}
+			if _, exists := b.mutation.Action(); exists {
+				s.SetIgnore(adminauditlog.FieldAction)
+			}
+			if _, exists := b.mutation.TargetID(); exists {
+				s.SetIgnore(adminauditlog.FieldTargetID)
+			}
+			if _, exists := b.mutation.Actor(); exists {
+				s.SetIgnore(adminauditlog.FieldActor)
+			}
+			if _, exists := b.mutation.Reason(); exists {
+				s.SetIgnore(adminauditlog.FieldReason)
+			}
+			if _, exists := b.mutation.Metadata(); exists {
+				s.SetIgnore(adminauditlog.FieldMetadata)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(adminauditlog.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AdminAuditLog.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AdminAuditLogUpsertBulk) Ignore() *AdminAuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AdminAuditLogUpsertBulk) DoNothing() *AdminAuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AdminAuditLogCreateBulk.OnConflict
+// documentation for more info.
+func (u *AdminAuditLogUpsertBulk) Update(set func(*AdminAuditLogUpsert)) *AdminAuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AdminAuditLogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *AdminAuditLogUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AdminAuditLogCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AdminAuditLogCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AdminAuditLogUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
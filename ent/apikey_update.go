@@ -6,9 +6,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/NEDA-LABS/stablenode/ent/apikey"
 	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
@@ -43,6 +45,111 @@ func (aku *APIKeyUpdate) SetNillableSecret(s *string) *APIKeyUpdate {
 	return aku
 }
 
+// SetName sets the "name" field.
+func (aku *APIKeyUpdate) SetName(s string) *APIKeyUpdate {
+	aku.mutation.SetName(s)
+	return aku
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (aku *APIKeyUpdate) SetNillableName(s *string) *APIKeyUpdate {
+	if s != nil {
+		aku.SetName(*s)
+	}
+	return aku
+}
+
+// ClearName clears the value of the "name" field.
+func (aku *APIKeyUpdate) ClearName() *APIKeyUpdate {
+	aku.mutation.ClearName()
+	return aku
+}
+
+// SetScopes sets the "scopes" field.
+func (aku *APIKeyUpdate) SetScopes(s []string) *APIKeyUpdate {
+	aku.mutation.SetScopes(s)
+	return aku
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (aku *APIKeyUpdate) AppendScopes(s []string) *APIKeyUpdate {
+	aku.mutation.AppendScopes(s)
+	return aku
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (aku *APIKeyUpdate) ClearScopes() *APIKeyUpdate {
+	aku.mutation.ClearScopes()
+	return aku
+}
+
+// SetRateLimit sets the "rate_limit" field.
+func (aku *APIKeyUpdate) SetRateLimit(i int) *APIKeyUpdate {
+	aku.mutation.ResetRateLimit()
+	aku.mutation.SetRateLimit(i)
+	return aku
+}
+
+// SetNillableRateLimit sets the "rate_limit" field if the given value is not nil.
+func (aku *APIKeyUpdate) SetNillableRateLimit(i *int) *APIKeyUpdate {
+	if i != nil {
+		aku.SetRateLimit(*i)
+	}
+	return aku
+}
+
+// AddRateLimit adds i to the "rate_limit" field.
+func (aku *APIKeyUpdate) AddRateLimit(i int) *APIKeyUpdate {
+	aku.mutation.AddRateLimit(i)
+	return aku
+}
+
+// ClearRateLimit clears the value of the "rate_limit" field.
+func (aku *APIKeyUpdate) ClearRateLimit() *APIKeyUpdate {
+	aku.mutation.ClearRateLimit()
+	return aku
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (aku *APIKeyUpdate) SetExpiresAt(t time.Time) *APIKeyUpdate {
+	aku.mutation.SetExpiresAt(t)
+	return aku
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (aku *APIKeyUpdate) SetNillableExpiresAt(t *time.Time) *APIKeyUpdate {
+	if t != nil {
+		aku.SetExpiresAt(*t)
+	}
+	return aku
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (aku *APIKeyUpdate) ClearExpiresAt() *APIKeyUpdate {
+	aku.mutation.ClearExpiresAt()
+	return aku
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (aku *APIKeyUpdate) SetLastUsedAt(t time.Time) *APIKeyUpdate {
+	aku.mutation.SetLastUsedAt(t)
+	return aku
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (aku *APIKeyUpdate) SetNillableLastUsedAt(t *time.Time) *APIKeyUpdate {
+	if t != nil {
+		aku.SetLastUsedAt(*t)
+	}
+	return aku
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (aku *APIKeyUpdate) ClearLastUsedAt() *APIKeyUpdate {
+	aku.mutation.ClearLastUsedAt()
+	return aku
+}
+
 // AddPaymentOrderIDs adds the "payment_orders" edge to the PaymentOrder entity by IDs.
 func (aku *APIKeyUpdate) AddPaymentOrderIDs(ids ...uuid.UUID) *APIKeyUpdate {
 	aku.mutation.AddPaymentOrderIDs(ids...)
@@ -118,6 +225,16 @@ func (aku *APIKeyUpdate) check() error {
 			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "APIKey.secret": %w`, err)}
 		}
 	}
+	if v, ok := aku.mutation.Name(); ok {
+		if err := apikey.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "APIKey.name": %w`, err)}
+		}
+	}
+	if v, ok := aku.mutation.RateLimit(); ok {
+		if err := apikey.RateLimitValidator(v); err != nil {
+			return &ValidationError{Name: "rate_limit", err: fmt.Errorf(`ent: validator failed for field "APIKey.rate_limit": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -136,6 +253,47 @@ func (aku *APIKeyUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := aku.mutation.Secret(); ok {
 		_spec.SetField(apikey.FieldSecret, field.TypeString, value)
 	}
+	if value, ok := aku.mutation.Name(); ok {
+		_spec.SetField(apikey.FieldName, field.TypeString, value)
+	}
+	if aku.mutation.NameCleared() {
+		_spec.ClearField(apikey.FieldName, field.TypeString)
+	}
+	if value, ok := aku.mutation.Scopes(); ok {
+		_spec.SetField(apikey.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := aku.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, apikey.FieldScopes, value)
+		})
+	}
+	if aku.mutation.ScopesCleared() {
+		_spec.ClearField(apikey.FieldScopes, field.TypeJSON)
+	}
+	if value, ok := aku.mutation.RateLimit(); ok {
+		_spec.SetField(apikey.FieldRateLimit, field.TypeInt, value)
+	}
+	if value, ok := aku.mutation.AddedRateLimit(); ok {
+		_spec.AddField(apikey.FieldRateLimit, field.TypeInt, value)
+	}
+	if aku.mutation.RateLimitCleared() {
+		_spec.ClearField(apikey.FieldRateLimit, field.TypeInt)
+	}
+	if value, ok := aku.mutation.ExpiresAt(); ok {
+		_spec.SetField(apikey.FieldExpiresAt, field.TypeTime, value)
+	}
+	if aku.mutation.ExpiresAtCleared() {
+		_spec.ClearField(apikey.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := aku.mutation.LastUsedAt(); ok {
+		_spec.SetField(apikey.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if aku.mutation.LastUsedAtCleared() {
+		_spec.ClearField(apikey.FieldLastUsedAt, field.TypeTime)
+	}
+	if aku.mutation.CreatedAtCleared() {
+		_spec.ClearField(apikey.FieldCreatedAt, field.TypeTime)
+	}
 	if aku.mutation.PaymentOrdersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -215,6 +373,111 @@ func (akuo *APIKeyUpdateOne) SetNillableSecret(s *string) *APIKeyUpdateOne {
 	return akuo
 }
 
+// SetName sets the "name" field.
+func (akuo *APIKeyUpdateOne) SetName(s string) *APIKeyUpdateOne {
+	akuo.mutation.SetName(s)
+	return akuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (akuo *APIKeyUpdateOne) SetNillableName(s *string) *APIKeyUpdateOne {
+	if s != nil {
+		akuo.SetName(*s)
+	}
+	return akuo
+}
+
+// ClearName clears the value of the "name" field.
+func (akuo *APIKeyUpdateOne) ClearName() *APIKeyUpdateOne {
+	akuo.mutation.ClearName()
+	return akuo
+}
+
+// SetScopes sets the "scopes" field.
+func (akuo *APIKeyUpdateOne) SetScopes(s []string) *APIKeyUpdateOne {
+	akuo.mutation.SetScopes(s)
+	return akuo
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (akuo *APIKeyUpdateOne) AppendScopes(s []string) *APIKeyUpdateOne {
+	akuo.mutation.AppendScopes(s)
+	return akuo
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (akuo *APIKeyUpdateOne) ClearScopes() *APIKeyUpdateOne {
+	akuo.mutation.ClearScopes()
+	return akuo
+}
+
+// SetRateLimit sets the "rate_limit" field.
+func (akuo *APIKeyUpdateOne) SetRateLimit(i int) *APIKeyUpdateOne {
+	akuo.mutation.ResetRateLimit()
+	akuo.mutation.SetRateLimit(i)
+	return akuo
+}
+
+// SetNillableRateLimit sets the "rate_limit" field if the given value is not nil.
+func (akuo *APIKeyUpdateOne) SetNillableRateLimit(i *int) *APIKeyUpdateOne {
+	if i != nil {
+		akuo.SetRateLimit(*i)
+	}
+	return akuo
+}
+
+// AddRateLimit adds i to the "rate_limit" field.
+func (akuo *APIKeyUpdateOne) AddRateLimit(i int) *APIKeyUpdateOne {
+	akuo.mutation.AddRateLimit(i)
+	return akuo
+}
+
+// ClearRateLimit clears the value of the "rate_limit" field.
+func (akuo *APIKeyUpdateOne) ClearRateLimit() *APIKeyUpdateOne {
+	akuo.mutation.ClearRateLimit()
+	return akuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (akuo *APIKeyUpdateOne) SetExpiresAt(t time.Time) *APIKeyUpdateOne {
+	akuo.mutation.SetExpiresAt(t)
+	return akuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (akuo *APIKeyUpdateOne) SetNillableExpiresAt(t *time.Time) *APIKeyUpdateOne {
+	if t != nil {
+		akuo.SetExpiresAt(*t)
+	}
+	return akuo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (akuo *APIKeyUpdateOne) ClearExpiresAt() *APIKeyUpdateOne {
+	akuo.mutation.ClearExpiresAt()
+	return akuo
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (akuo *APIKeyUpdateOne) SetLastUsedAt(t time.Time) *APIKeyUpdateOne {
+	akuo.mutation.SetLastUsedAt(t)
+	return akuo
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (akuo *APIKeyUpdateOne) SetNillableLastUsedAt(t *time.Time) *APIKeyUpdateOne {
+	if t != nil {
+		akuo.SetLastUsedAt(*t)
+	}
+	return akuo
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (akuo *APIKeyUpdateOne) ClearLastUsedAt() *APIKeyUpdateOne {
+	akuo.mutation.ClearLastUsedAt()
+	return akuo
+}
+
 // AddPaymentOrderIDs adds the "payment_orders" edge to the PaymentOrder entity by IDs.
 func (akuo *APIKeyUpdateOne) AddPaymentOrderIDs(ids ...uuid.UUID) *APIKeyUpdateOne {
 	akuo.mutation.AddPaymentOrderIDs(ids...)
@@ -303,6 +566,16 @@ func (akuo *APIKeyUpdateOne) check() error {
 			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "APIKey.secret": %w`, err)}
 		}
 	}
+	if v, ok := akuo.mutation.Name(); ok {
+		if err := apikey.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "APIKey.name": %w`, err)}
+		}
+	}
+	if v, ok := akuo.mutation.RateLimit(); ok {
+		if err := apikey.RateLimitValidator(v); err != nil {
+			return &ValidationError{Name: "rate_limit", err: fmt.Errorf(`ent: validator failed for field "APIKey.rate_limit": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -338,6 +611,47 @@ func (akuo *APIKeyUpdateOne) sqlSave(ctx context.Context) (_node *APIKey, err er
 	if value, ok := akuo.mutation.Secret(); ok {
 		_spec.SetField(apikey.FieldSecret, field.TypeString, value)
 	}
+	if value, ok := akuo.mutation.Name(); ok {
+		_spec.SetField(apikey.FieldName, field.TypeString, value)
+	}
+	if akuo.mutation.NameCleared() {
+		_spec.ClearField(apikey.FieldName, field.TypeString)
+	}
+	if value, ok := akuo.mutation.Scopes(); ok {
+		_spec.SetField(apikey.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := akuo.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, apikey.FieldScopes, value)
+		})
+	}
+	if akuo.mutation.ScopesCleared() {
+		_spec.ClearField(apikey.FieldScopes, field.TypeJSON)
+	}
+	if value, ok := akuo.mutation.RateLimit(); ok {
+		_spec.SetField(apikey.FieldRateLimit, field.TypeInt, value)
+	}
+	if value, ok := akuo.mutation.AddedRateLimit(); ok {
+		_spec.AddField(apikey.FieldRateLimit, field.TypeInt, value)
+	}
+	if akuo.mutation.RateLimitCleared() {
+		_spec.ClearField(apikey.FieldRateLimit, field.TypeInt)
+	}
+	if value, ok := akuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(apikey.FieldExpiresAt, field.TypeTime, value)
+	}
+	if akuo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(apikey.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := akuo.mutation.LastUsedAt(); ok {
+		_spec.SetField(apikey.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if akuo.mutation.LastUsedAtCleared() {
+		_spec.ClearField(apikey.FieldLastUsedAt, field.TypeTime)
+	}
+	if akuo.mutation.CreatedAtCleared() {
+		_spec.ClearField(apikey.FieldCreatedAt, field.TypeTime)
+	}
 	if akuo.mutation.PaymentOrdersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -0,0 +1,246 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/NEDA-LABS/stablenode/ent/blockchainroute"
+	"github.com/NEDA-LABS/stablenode/ent/senderprofile"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// BlockchainRoute is the model entity for the BlockchainRoute schema.
+type BlockchainRoute struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Service holds the value of the "service" field.
+	Service blockchainroute.Service `json:"service,omitempty"`
+	// Token holds the value of the "token" field.
+	Token string `json:"token,omitempty"`
+	// Network holds the value of the "network" field.
+	Network string `json:"network,omitempty"`
+	// MinAmount holds the value of the "min_amount" field.
+	MinAmount decimal.Decimal `json:"min_amount,omitempty"`
+	// MaxAmount holds the value of the "max_amount" field.
+	MaxAmount *decimal.Decimal `json:"max_amount,omitempty"`
+	// Priority holds the value of the "priority" field.
+	Priority int `json:"priority,omitempty"`
+	// IsEnabled holds the value of the "is_enabled" field.
+	IsEnabled bool `json:"is_enabled,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BlockchainRouteQuery when eager-loading is set.
+	Edges                            BlockchainRouteEdges `json:"edges"`
+	sender_profile_blockchain_routes *uuid.UUID
+	selectValues                     sql.SelectValues
+}
+
+// BlockchainRouteEdges holds the relations/edges for other nodes in the graph.
+type BlockchainRouteEdges struct {
+	// SenderProfile holds the value of the sender_profile edge.
+	SenderProfile *SenderProfile `json:"sender_profile,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// SenderProfileOrErr returns the SenderProfile value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BlockchainRouteEdges) SenderProfileOrErr() (*SenderProfile, error) {
+	if e.SenderProfile != nil {
+		return e.SenderProfile, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: senderprofile.Label}
+	}
+	return nil, &NotLoadedError{edge: "sender_profile"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BlockchainRoute) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case blockchainroute.FieldMaxAmount:
+			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
+		case blockchainroute.FieldMinAmount:
+			values[i] = new(decimal.Decimal)
+		case blockchainroute.FieldIsEnabled:
+			values[i] = new(sql.NullBool)
+		case blockchainroute.FieldPriority:
+			values[i] = new(sql.NullInt64)
+		case blockchainroute.FieldService, blockchainroute.FieldToken, blockchainroute.FieldNetwork:
+			values[i] = new(sql.NullString)
+		case blockchainroute.FieldCreatedAt, blockchainroute.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case blockchainroute.FieldID:
+			values[i] = new(uuid.UUID)
+		case blockchainroute.ForeignKeys[0]: // sender_profile_blockchain_routes
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BlockchainRoute fields.
+func (br *BlockchainRoute) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case blockchainroute.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				br.ID = *value
+			}
+		case blockchainroute.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				br.CreatedAt = value.Time
+			}
+		case blockchainroute.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				br.UpdatedAt = value.Time
+			}
+		case blockchainroute.FieldService:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field service", values[i])
+			} else if value.Valid {
+				br.Service = blockchainroute.Service(value.String)
+			}
+		case blockchainroute.FieldToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token", values[i])
+			} else if value.Valid {
+				br.Token = value.String
+			}
+		case blockchainroute.FieldNetwork:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field network", values[i])
+			} else if value.Valid {
+				br.Network = value.String
+			}
+		case blockchainroute.FieldMinAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field min_amount", values[i])
+			} else if value != nil {
+				br.MinAmount = *value
+			}
+		case blockchainroute.FieldMaxAmount:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field max_amount", values[i])
+			} else if value.Valid {
+				br.MaxAmount = new(decimal.Decimal)
+				*br.MaxAmount = *value.S.(*decimal.Decimal)
+			}
+		case blockchainroute.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				br.Priority = int(value.Int64)
+			}
+		case blockchainroute.FieldIsEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_enabled", values[i])
+			} else if value.Valid {
+				br.IsEnabled = value.Bool
+			}
+		case blockchainroute.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field sender_profile_blockchain_routes", values[i])
+			} else if value.Valid {
+				br.sender_profile_blockchain_routes = new(uuid.UUID)
+				*br.sender_profile_blockchain_routes = *value.S.(*uuid.UUID)
+			}
+		default:
+			br.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BlockchainRoute.
+// This includes values selected through modifiers, order, etc.
+func (br *BlockchainRoute) Value(name string) (ent.Value, error) {
+	return br.selectValues.Get(name)
+}
+
+// QuerySenderProfile queries the "sender_profile" edge of the BlockchainRoute entity.
+func (br *BlockchainRoute) QuerySenderProfile() *SenderProfileQuery {
+	return NewBlockchainRouteClient(br.config).QuerySenderProfile(br)
+}
+
+// Update returns a builder for updating this BlockchainRoute.
+// Note that you need to call BlockchainRoute.Unwrap() before calling this method if this BlockchainRoute
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (br *BlockchainRoute) Update() *BlockchainRouteUpdateOne {
+	return NewBlockchainRouteClient(br.config).UpdateOne(br)
+}
+
+// Unwrap unwraps the BlockchainRoute entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (br *BlockchainRoute) Unwrap() *BlockchainRoute {
+	_tx, ok := br.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BlockchainRoute is not a transactional entity")
+	}
+	br.config.driver = _tx.drv
+	return br
+}
+
+// String implements the fmt.Stringer.
+func (br *BlockchainRoute) String() string {
+	var builder strings.Builder
+	builder.WriteString("BlockchainRoute(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", br.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(br.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(br.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("service=")
+	builder.WriteString(fmt.Sprintf("%v", br.Service))
+	builder.WriteString(", ")
+	builder.WriteString("token=")
+	builder.WriteString(br.Token)
+	builder.WriteString(", ")
+	builder.WriteString("network=")
+	builder.WriteString(br.Network)
+	builder.WriteString(", ")
+	builder.WriteString("min_amount=")
+	builder.WriteString(fmt.Sprintf("%v", br.MinAmount))
+	builder.WriteString(", ")
+	if v := br.MaxAmount; v != nil {
+		builder.WriteString("max_amount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", br.Priority))
+	builder.WriteString(", ")
+	builder.WriteString("is_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", br.IsEnabled))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BlockchainRoutes is a parsable slice of BlockchainRoute.
+type BlockchainRoutes []*BlockchainRoute
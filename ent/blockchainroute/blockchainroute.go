@@ -0,0 +1,200 @@
+// Code generated by ent, DO NOT EDIT.
+
+package blockchainroute
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// Label holds the string label denoting the blockchainroute type in the database.
+	Label = "blockchain_route"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldService holds the string denoting the service field in the database.
+	FieldService = "service"
+	// FieldToken holds the string denoting the token field in the database.
+	FieldToken = "token"
+	// FieldNetwork holds the string denoting the network field in the database.
+	FieldNetwork = "network"
+	// FieldMinAmount holds the string denoting the min_amount field in the database.
+	FieldMinAmount = "min_amount"
+	// FieldMaxAmount holds the string denoting the max_amount field in the database.
+	FieldMaxAmount = "max_amount"
+	// FieldPriority holds the string denoting the priority field in the database.
+	FieldPriority = "priority"
+	// FieldIsEnabled holds the string denoting the is_enabled field in the database.
+	FieldIsEnabled = "is_enabled"
+	// EdgeSenderProfile holds the string denoting the sender_profile edge name in mutations.
+	EdgeSenderProfile = "sender_profile"
+	// Table holds the table name of the blockchainroute in the database.
+	Table = "blockchain_routes"
+	// SenderProfileTable is the table that holds the sender_profile relation/edge.
+	SenderProfileTable = "blockchain_routes"
+	// SenderProfileInverseTable is the table name for the SenderProfile entity.
+	// It exists in this package in order to avoid circular dependency with the "senderprofile" package.
+	SenderProfileInverseTable = "sender_profiles"
+	// SenderProfileColumn is the table column denoting the sender_profile relation/edge.
+	SenderProfileColumn = "sender_profile_blockchain_routes"
+)
+
+// Columns holds all SQL columns for blockchainroute fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldService,
+	FieldToken,
+	FieldNetwork,
+	FieldMinAmount,
+	FieldMaxAmount,
+	FieldPriority,
+	FieldIsEnabled,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "blockchain_routes"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"sender_profile_blockchain_routes",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultToken holds the default value on creation for the "token" field.
+	DefaultToken string
+	// TokenValidator is a validator for the "token" field. It is called by the builders before save.
+	TokenValidator func(string) error
+	// DefaultNetwork holds the default value on creation for the "network" field.
+	DefaultNetwork string
+	// NetworkValidator is a validator for the "network" field. It is called by the builders before save.
+	NetworkValidator func(string) error
+	// DefaultMinAmount holds the default value on creation for the "min_amount" field.
+	DefaultMinAmount func() decimal.Decimal
+	// DefaultPriority holds the default value on creation for the "priority" field.
+	DefaultPriority int
+	// DefaultIsEnabled holds the default value on creation for the "is_enabled" field.
+	DefaultIsEnabled bool
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Service defines the type for the "service" enum field.
+type Service string
+
+// Service values.
+const (
+	ServiceEngine  Service = "engine"
+	ServiceAlchemy Service = "alchemy"
+	ServiceRPC     Service = "rpc"
+)
+
+func (s Service) String() string {
+	return string(s)
+}
+
+// ServiceValidator is a validator for the "service" field enum values. It is called by the builders before save.
+func ServiceValidator(s Service) error {
+	switch s {
+	case ServiceEngine, ServiceAlchemy, ServiceRPC:
+		return nil
+	default:
+		return fmt.Errorf("blockchainroute: invalid enum value for service field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the BlockchainRoute queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByService orders the results by the service field.
+func ByService(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldService, opts...).ToFunc()
+}
+
+// ByToken orders the results by the token field.
+func ByToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToken, opts...).ToFunc()
+}
+
+// ByNetwork orders the results by the network field.
+func ByNetwork(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNetwork, opts...).ToFunc()
+}
+
+// ByMinAmount orders the results by the min_amount field.
+func ByMinAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinAmount, opts...).ToFunc()
+}
+
+// ByMaxAmount orders the results by the max_amount field.
+func ByMaxAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxAmount, opts...).ToFunc()
+}
+
+// ByPriority orders the results by the priority field.
+func ByPriority(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriority, opts...).ToFunc()
+}
+
+// ByIsEnabled orders the results by the is_enabled field.
+func ByIsEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsEnabled, opts...).ToFunc()
+}
+
+// BySenderProfileField orders the results by sender_profile field.
+func BySenderProfileField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSenderProfileStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newSenderProfileStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SenderProfileInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SenderProfileTable, SenderProfileColumn),
+	)
+}
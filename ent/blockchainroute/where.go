@@ -0,0 +1,506 @@
+// Code generated by ent, DO NOT EDIT.
+
+package blockchainroute
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Token applies equality check predicate on the "token" field. It's identical to TokenEQ.
+func Token(v string) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldEQ(FieldToken, v))
+}
+
+// Network applies equality check predicate on the "network" field. It's identical to NetworkEQ.
+func Network(v string) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldEQ(FieldNetwork, v))
+}
+
+// MinAmount applies equality check predicate on the "min_amount" field. It's identical to MinAmountEQ.
+func MinAmount(v decimal.Decimal) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldEQ(FieldMinAmount, v))
+}
+
+// MaxAmount applies equality check predicate on the "max_amount" field. It's identical to MaxAmountEQ.
+func MaxAmount(v decimal.Decimal) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldEQ(FieldMaxAmount, v))
+}
+
+// Priority applies equality check predicate on the "priority" field. It's identical to PriorityEQ.
+func Priority(v int) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldEQ(FieldPriority, v))
+}
+
+// IsEnabled applies equality check predicate on the "is_enabled" field. It's identical to IsEnabledEQ.
+func IsEnabled(v bool) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldEQ(FieldIsEnabled, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// ServiceEQ applies the EQ predicate on the "service" field.
+func ServiceEQ(v Service) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldEQ(FieldService, v))
+}
+
+// ServiceNEQ applies the NEQ predicate on the "service" field.
+func ServiceNEQ(v Service) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldNEQ(FieldService, v))
+}
+
+// ServiceIn applies the In predicate on the "service" field.
+func ServiceIn(vs ...Service) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldIn(FieldService, vs...))
+}
+
+// ServiceNotIn applies the NotIn predicate on the "service" field.
+func ServiceNotIn(vs ...Service) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldNotIn(FieldService, vs...))
+}
+
+// TokenEQ applies the EQ predicate on the "token" field.
+func TokenEQ(v string) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldEQ(FieldToken, v))
+}
+
+// TokenNEQ applies the NEQ predicate on the "token" field.
+func TokenNEQ(v string) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldNEQ(FieldToken, v))
+}
+
+// TokenIn applies the In predicate on the "token" field.
+func TokenIn(vs ...string) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldIn(FieldToken, vs...))
+}
+
+// TokenNotIn applies the NotIn predicate on the "token" field.
+func TokenNotIn(vs ...string) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldNotIn(FieldToken, vs...))
+}
+
+// TokenGT applies the GT predicate on the "token" field.
+func TokenGT(v string) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldGT(FieldToken, v))
+}
+
+// TokenGTE applies the GTE predicate on the "token" field.
+func TokenGTE(v string) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldGTE(FieldToken, v))
+}
+
+// TokenLT applies the LT predicate on the "token" field.
+func TokenLT(v string) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldLT(FieldToken, v))
+}
+
+// TokenLTE applies the LTE predicate on the "token" field.
+func TokenLTE(v string) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldLTE(FieldToken, v))
+}
+
+// TokenContains applies the Contains predicate on the "token" field.
+func TokenContains(v string) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldContains(FieldToken, v))
+}
+
+// TokenHasPrefix applies the HasPrefix predicate on the "token" field.
+func TokenHasPrefix(v string) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldHasPrefix(FieldToken, v))
+}
+
+// TokenHasSuffix applies the HasSuffix predicate on the "token" field.
+func TokenHasSuffix(v string) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldHasSuffix(FieldToken, v))
+}
+
+// TokenEqualFold applies the EqualFold predicate on the "token" field.
+func TokenEqualFold(v string) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldEqualFold(FieldToken, v))
+}
+
+// TokenContainsFold applies the ContainsFold predicate on the "token" field.
+func TokenContainsFold(v string) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldContainsFold(FieldToken, v))
+}
+
+// NetworkEQ applies the EQ predicate on the "network" field.
+func NetworkEQ(v string) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldEQ(FieldNetwork, v))
+}
+
+// NetworkNEQ applies the NEQ predicate on the "network" field.
+func NetworkNEQ(v string) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldNEQ(FieldNetwork, v))
+}
+
+// NetworkIn applies the In predicate on the "network" field.
+func NetworkIn(vs ...string) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldIn(FieldNetwork, vs...))
+}
+
+// NetworkNotIn applies the NotIn predicate on the "network" field.
+func NetworkNotIn(vs ...string) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldNotIn(FieldNetwork, vs...))
+}
+
+// NetworkGT applies the GT predicate on the "network" field.
+func NetworkGT(v string) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldGT(FieldNetwork, v))
+}
+
+// NetworkGTE applies the GTE predicate on the "network" field.
+func NetworkGTE(v string) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldGTE(FieldNetwork, v))
+}
+
+// NetworkLT applies the LT predicate on the "network" field.
+func NetworkLT(v string) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldLT(FieldNetwork, v))
+}
+
+// NetworkLTE applies the LTE predicate on the "network" field.
+func NetworkLTE(v string) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldLTE(FieldNetwork, v))
+}
+
+// NetworkContains applies the Contains predicate on the "network" field.
+func NetworkContains(v string) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldContains(FieldNetwork, v))
+}
+
+// NetworkHasPrefix applies the HasPrefix predicate on the "network" field.
+func NetworkHasPrefix(v string) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldHasPrefix(FieldNetwork, v))
+}
+
+// NetworkHasSuffix applies the HasSuffix predicate on the "network" field.
+func NetworkHasSuffix(v string) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldHasSuffix(FieldNetwork, v))
+}
+
+// NetworkEqualFold applies the EqualFold predicate on the "network" field.
+func NetworkEqualFold(v string) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldEqualFold(FieldNetwork, v))
+}
+
+// NetworkContainsFold applies the ContainsFold predicate on the "network" field.
+func NetworkContainsFold(v string) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldContainsFold(FieldNetwork, v))
+}
+
+// MinAmountEQ applies the EQ predicate on the "min_amount" field.
+func MinAmountEQ(v decimal.Decimal) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldEQ(FieldMinAmount, v))
+}
+
+// MinAmountNEQ applies the NEQ predicate on the "min_amount" field.
+func MinAmountNEQ(v decimal.Decimal) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldNEQ(FieldMinAmount, v))
+}
+
+// MinAmountIn applies the In predicate on the "min_amount" field.
+func MinAmountIn(vs ...decimal.Decimal) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldIn(FieldMinAmount, vs...))
+}
+
+// MinAmountNotIn applies the NotIn predicate on the "min_amount" field.
+func MinAmountNotIn(vs ...decimal.Decimal) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldNotIn(FieldMinAmount, vs...))
+}
+
+// MinAmountGT applies the GT predicate on the "min_amount" field.
+func MinAmountGT(v decimal.Decimal) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldGT(FieldMinAmount, v))
+}
+
+// MinAmountGTE applies the GTE predicate on the "min_amount" field.
+func MinAmountGTE(v decimal.Decimal) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldGTE(FieldMinAmount, v))
+}
+
+// MinAmountLT applies the LT predicate on the "min_amount" field.
+func MinAmountLT(v decimal.Decimal) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldLT(FieldMinAmount, v))
+}
+
+// MinAmountLTE applies the LTE predicate on the "min_amount" field.
+func MinAmountLTE(v decimal.Decimal) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldLTE(FieldMinAmount, v))
+}
+
+// MaxAmountEQ applies the EQ predicate on the "max_amount" field.
+func MaxAmountEQ(v decimal.Decimal) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldEQ(FieldMaxAmount, v))
+}
+
+// MaxAmountNEQ applies the NEQ predicate on the "max_amount" field.
+func MaxAmountNEQ(v decimal.Decimal) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldNEQ(FieldMaxAmount, v))
+}
+
+// MaxAmountIn applies the In predicate on the "max_amount" field.
+func MaxAmountIn(vs ...decimal.Decimal) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldIn(FieldMaxAmount, vs...))
+}
+
+// MaxAmountNotIn applies the NotIn predicate on the "max_amount" field.
+func MaxAmountNotIn(vs ...decimal.Decimal) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldNotIn(FieldMaxAmount, vs...))
+}
+
+// MaxAmountGT applies the GT predicate on the "max_amount" field.
+func MaxAmountGT(v decimal.Decimal) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldGT(FieldMaxAmount, v))
+}
+
+// MaxAmountGTE applies the GTE predicate on the "max_amount" field.
+func MaxAmountGTE(v decimal.Decimal) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldGTE(FieldMaxAmount, v))
+}
+
+// MaxAmountLT applies the LT predicate on the "max_amount" field.
+func MaxAmountLT(v decimal.Decimal) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldLT(FieldMaxAmount, v))
+}
+
+// MaxAmountLTE applies the LTE predicate on the "max_amount" field.
+func MaxAmountLTE(v decimal.Decimal) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldLTE(FieldMaxAmount, v))
+}
+
+// MaxAmountIsNil applies the IsNil predicate on the "max_amount" field.
+func MaxAmountIsNil() predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldIsNull(FieldMaxAmount))
+}
+
+// MaxAmountNotNil applies the NotNil predicate on the "max_amount" field.
+func MaxAmountNotNil() predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldNotNull(FieldMaxAmount))
+}
+
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v int) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldEQ(FieldPriority, v))
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v int) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldNEQ(FieldPriority, v))
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...int) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldIn(FieldPriority, vs...))
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...int) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldNotIn(FieldPriority, vs...))
+}
+
+// PriorityGT applies the GT predicate on the "priority" field.
+func PriorityGT(v int) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldGT(FieldPriority, v))
+}
+
+// PriorityGTE applies the GTE predicate on the "priority" field.
+func PriorityGTE(v int) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldGTE(FieldPriority, v))
+}
+
+// PriorityLT applies the LT predicate on the "priority" field.
+func PriorityLT(v int) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldLT(FieldPriority, v))
+}
+
+// PriorityLTE applies the LTE predicate on the "priority" field.
+func PriorityLTE(v int) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldLTE(FieldPriority, v))
+}
+
+// IsEnabledEQ applies the EQ predicate on the "is_enabled" field.
+func IsEnabledEQ(v bool) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldEQ(FieldIsEnabled, v))
+}
+
+// IsEnabledNEQ applies the NEQ predicate on the "is_enabled" field.
+func IsEnabledNEQ(v bool) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.FieldNEQ(FieldIsEnabled, v))
+}
+
+// HasSenderProfile applies the HasEdge predicate on the "sender_profile" edge.
+func HasSenderProfile() predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SenderProfileTable, SenderProfileColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSenderProfileWith applies the HasEdge predicate on the "sender_profile" edge with a given conditions (other predicates).
+func HasSenderProfileWith(preds ...predicate.SenderProfile) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(func(s *sql.Selector) {
+		step := newSenderProfileStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BlockchainRoute) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BlockchainRoute) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BlockchainRoute) predicate.BlockchainRoute {
+	return predicate.BlockchainRoute(sql.NotPredicates(p))
+}
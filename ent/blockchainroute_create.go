@@ -0,0 +1,1121 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/blockchainroute"
+	"github.com/NEDA-LABS/stablenode/ent/senderprofile"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// BlockchainRouteCreate is the builder for creating a BlockchainRoute entity.
+type BlockchainRouteCreate struct {
+	config
+	mutation *BlockchainRouteMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (brc *BlockchainRouteCreate) SetCreatedAt(t time.Time) *BlockchainRouteCreate {
+	brc.mutation.SetCreatedAt(t)
+	return brc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (brc *BlockchainRouteCreate) SetNillableCreatedAt(t *time.Time) *BlockchainRouteCreate {
+	if t != nil {
+		brc.SetCreatedAt(*t)
+	}
+	return brc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (brc *BlockchainRouteCreate) SetUpdatedAt(t time.Time) *BlockchainRouteCreate {
+	brc.mutation.SetUpdatedAt(t)
+	return brc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (brc *BlockchainRouteCreate) SetNillableUpdatedAt(t *time.Time) *BlockchainRouteCreate {
+	if t != nil {
+		brc.SetUpdatedAt(*t)
+	}
+	return brc
+}
+
+// SetService sets the "service" field.
+func (brc *BlockchainRouteCreate) SetService(b blockchainroute.Service) *BlockchainRouteCreate {
+	brc.mutation.SetService(b)
+	return brc
+}
+
+// SetToken sets the "token" field.
+func (brc *BlockchainRouteCreate) SetToken(s string) *BlockchainRouteCreate {
+	brc.mutation.SetToken(s)
+	return brc
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (brc *BlockchainRouteCreate) SetNillableToken(s *string) *BlockchainRouteCreate {
+	if s != nil {
+		brc.SetToken(*s)
+	}
+	return brc
+}
+
+// SetNetwork sets the "network" field.
+func (brc *BlockchainRouteCreate) SetNetwork(s string) *BlockchainRouteCreate {
+	brc.mutation.SetNetwork(s)
+	return brc
+}
+
+// SetNillableNetwork sets the "network" field if the given value is not nil.
+func (brc *BlockchainRouteCreate) SetNillableNetwork(s *string) *BlockchainRouteCreate {
+	if s != nil {
+		brc.SetNetwork(*s)
+	}
+	return brc
+}
+
+// SetMinAmount sets the "min_amount" field.
+func (brc *BlockchainRouteCreate) SetMinAmount(d decimal.Decimal) *BlockchainRouteCreate {
+	brc.mutation.SetMinAmount(d)
+	return brc
+}
+
+// SetNillableMinAmount sets the "min_amount" field if the given value is not nil.
+func (brc *BlockchainRouteCreate) SetNillableMinAmount(d *decimal.Decimal) *BlockchainRouteCreate {
+	if d != nil {
+		brc.SetMinAmount(*d)
+	}
+	return brc
+}
+
+// SetMaxAmount sets the "max_amount" field.
+func (brc *BlockchainRouteCreate) SetMaxAmount(d decimal.Decimal) *BlockchainRouteCreate {
+	brc.mutation.SetMaxAmount(d)
+	return brc
+}
+
+// SetNillableMaxAmount sets the "max_amount" field if the given value is not nil.
+func (brc *BlockchainRouteCreate) SetNillableMaxAmount(d *decimal.Decimal) *BlockchainRouteCreate {
+	if d != nil {
+		brc.SetMaxAmount(*d)
+	}
+	return brc
+}
+
+// SetPriority sets the "priority" field.
+func (brc *BlockchainRouteCreate) SetPriority(i int) *BlockchainRouteCreate {
+	brc.mutation.SetPriority(i)
+	return brc
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (brc *BlockchainRouteCreate) SetNillablePriority(i *int) *BlockchainRouteCreate {
+	if i != nil {
+		brc.SetPriority(*i)
+	}
+	return brc
+}
+
+// SetIsEnabled sets the "is_enabled" field.
+func (brc *BlockchainRouteCreate) SetIsEnabled(b bool) *BlockchainRouteCreate {
+	brc.mutation.SetIsEnabled(b)
+	return brc
+}
+
+// SetNillableIsEnabled sets the "is_enabled" field if the given value is not nil.
+func (brc *BlockchainRouteCreate) SetNillableIsEnabled(b *bool) *BlockchainRouteCreate {
+	if b != nil {
+		brc.SetIsEnabled(*b)
+	}
+	return brc
+}
+
+// SetID sets the "id" field.
+func (brc *BlockchainRouteCreate) SetID(u uuid.UUID) *BlockchainRouteCreate {
+	brc.mutation.SetID(u)
+	return brc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (brc *BlockchainRouteCreate) SetNillableID(u *uuid.UUID) *BlockchainRouteCreate {
+	if u != nil {
+		brc.SetID(*u)
+	}
+	return brc
+}
+
+// SetSenderProfileID sets the "sender_profile" edge to the SenderProfile entity by ID.
+func (brc *BlockchainRouteCreate) SetSenderProfileID(id uuid.UUID) *BlockchainRouteCreate {
+	brc.mutation.SetSenderProfileID(id)
+	return brc
+}
+
+// SetNillableSenderProfileID sets the "sender_profile" edge to the SenderProfile entity by ID if the given value is not nil.
+func (brc *BlockchainRouteCreate) SetNillableSenderProfileID(id *uuid.UUID) *BlockchainRouteCreate {
+	if id != nil {
+		brc = brc.SetSenderProfileID(*id)
+	}
+	return brc
+}
+
+// SetSenderProfile sets the "sender_profile" edge to the SenderProfile entity.
+func (brc *BlockchainRouteCreate) SetSenderProfile(s *SenderProfile) *BlockchainRouteCreate {
+	return brc.SetSenderProfileID(s.ID)
+}
+
+// Mutation returns the BlockchainRouteMutation object of the builder.
+func (brc *BlockchainRouteCreate) Mutation() *BlockchainRouteMutation {
+	return brc.mutation
+}
+
+// Save creates the BlockchainRoute in the database.
+func (brc *BlockchainRouteCreate) Save(ctx context.Context) (*BlockchainRoute, error) {
+	brc.defaults()
+	return withHooks(ctx, brc.sqlSave, brc.mutation, brc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (brc *BlockchainRouteCreate) SaveX(ctx context.Context) *BlockchainRoute {
+	v, err := brc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (brc *BlockchainRouteCreate) Exec(ctx context.Context) error {
+	_, err := brc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (brc *BlockchainRouteCreate) ExecX(ctx context.Context) {
+	if err := brc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (brc *BlockchainRouteCreate) defaults() {
+	if _, ok := brc.mutation.CreatedAt(); !ok {
+		v := blockchainroute.DefaultCreatedAt()
+		brc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := brc.mutation.UpdatedAt(); !ok {
+		v := blockchainroute.DefaultUpdatedAt()
+		brc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := brc.mutation.Token(); !ok {
+		v := blockchainroute.DefaultToken
+		brc.mutation.SetToken(v)
+	}
+	if _, ok := brc.mutation.Network(); !ok {
+		v := blockchainroute.DefaultNetwork
+		brc.mutation.SetNetwork(v)
+	}
+	if _, ok := brc.mutation.MinAmount(); !ok {
+		v := blockchainroute.DefaultMinAmount()
+		brc.mutation.SetMinAmount(v)
+	}
+	if _, ok := brc.mutation.Priority(); !ok {
+		v := blockchainroute.DefaultPriority
+		brc.mutation.SetPriority(v)
+	}
+	if _, ok := brc.mutation.IsEnabled(); !ok {
+		v := blockchainroute.DefaultIsEnabled
+		brc.mutation.SetIsEnabled(v)
+	}
+	if _, ok := brc.mutation.ID(); !ok {
+		v := blockchainroute.DefaultID()
+		brc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (brc *BlockchainRouteCreate) check() error {
+	if _, ok := brc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BlockchainRoute.created_at"`)}
+	}
+	if _, ok := brc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "BlockchainRoute.updated_at"`)}
+	}
+	if _, ok := brc.mutation.Service(); !ok {
+		return &ValidationError{Name: "service", err: errors.New(`ent: missing required field "BlockchainRoute.service"`)}
+	}
+	if v, ok := brc.mutation.Service(); ok {
+		if err := blockchainroute.ServiceValidator(v); err != nil {
+			return &ValidationError{Name: "service", err: fmt.Errorf(`ent: validator failed for field "BlockchainRoute.service": %w`, err)}
+		}
+	}
+	if _, ok := brc.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "BlockchainRoute.token"`)}
+	}
+	if v, ok := brc.mutation.Token(); ok {
+		if err := blockchainroute.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "BlockchainRoute.token": %w`, err)}
+		}
+	}
+	if _, ok := brc.mutation.Network(); !ok {
+		return &ValidationError{Name: "network", err: errors.New(`ent: missing required field "BlockchainRoute.network"`)}
+	}
+	if v, ok := brc.mutation.Network(); ok {
+		if err := blockchainroute.NetworkValidator(v); err != nil {
+			return &ValidationError{Name: "network", err: fmt.Errorf(`ent: validator failed for field "BlockchainRoute.network": %w`, err)}
+		}
+	}
+	if _, ok := brc.mutation.MinAmount(); !ok {
+		return &ValidationError{Name: "min_amount", err: errors.New(`ent: missing required field "BlockchainRoute.min_amount"`)}
+	}
+	if _, ok := brc.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "BlockchainRoute.priority"`)}
+	}
+	if _, ok := brc.mutation.IsEnabled(); !ok {
+		return &ValidationError{Name: "is_enabled", err: errors.New(`ent: missing required field "BlockchainRoute.is_enabled"`)}
+	}
+	return nil
+}
+
+func (brc *BlockchainRouteCreate) sqlSave(ctx context.Context) (*BlockchainRoute, error) {
+	if err := brc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := brc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, brc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	brc.mutation.id = &_node.ID
+	brc.mutation.done = true
+	return _node, nil
+}
+
+func (brc *BlockchainRouteCreate) createSpec() (*BlockchainRoute, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BlockchainRoute{config: brc.config}
+		_spec = sqlgraph.NewCreateSpec(blockchainroute.Table, sqlgraph.NewFieldSpec(blockchainroute.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = brc.conflict
+	if id, ok := brc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := brc.mutation.CreatedAt(); ok {
+		_spec.SetField(blockchainroute.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := brc.mutation.UpdatedAt(); ok {
+		_spec.SetField(blockchainroute.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := brc.mutation.Service(); ok {
+		_spec.SetField(blockchainroute.FieldService, field.TypeEnum, value)
+		_node.Service = value
+	}
+	if value, ok := brc.mutation.Token(); ok {
+		_spec.SetField(blockchainroute.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := brc.mutation.Network(); ok {
+		_spec.SetField(blockchainroute.FieldNetwork, field.TypeString, value)
+		_node.Network = value
+	}
+	if value, ok := brc.mutation.MinAmount(); ok {
+		_spec.SetField(blockchainroute.FieldMinAmount, field.TypeFloat64, value)
+		_node.MinAmount = value
+	}
+	if value, ok := brc.mutation.MaxAmount(); ok {
+		_spec.SetField(blockchainroute.FieldMaxAmount, field.TypeFloat64, value)
+		_node.MaxAmount = &value
+	}
+	if value, ok := brc.mutation.Priority(); ok {
+		_spec.SetField(blockchainroute.FieldPriority, field.TypeInt, value)
+		_node.Priority = value
+	}
+	if value, ok := brc.mutation.IsEnabled(); ok {
+		_spec.SetField(blockchainroute.FieldIsEnabled, field.TypeBool, value)
+		_node.IsEnabled = value
+	}
+	if nodes := brc.mutation.SenderProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blockchainroute.SenderProfileTable,
+			Columns: []string{blockchainroute.SenderProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderprofile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.sender_profile_blockchain_routes = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BlockchainRoute.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BlockchainRouteUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (brc *BlockchainRouteCreate) OnConflict(opts ...sql.ConflictOption) *BlockchainRouteUpsertOne {
+	brc.conflict = opts
+	return &BlockchainRouteUpsertOne{
+		create: brc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BlockchainRoute.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (brc *BlockchainRouteCreate) OnConflictColumns(columns ...string) *BlockchainRouteUpsertOne {
+	brc.conflict = append(brc.conflict, sql.ConflictColumns(columns...))
+	return &BlockchainRouteUpsertOne{
+		create: brc,
+	}
+}
+
+type (
+	// BlockchainRouteUpsertOne is the builder for "upsert"-ing
+	//  one BlockchainRoute node.
+	BlockchainRouteUpsertOne struct {
+		create *BlockchainRouteCreate
+	}
+
+	// BlockchainRouteUpsert is the "OnConflict" setter.
+	BlockchainRouteUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BlockchainRouteUpsert) SetUpdatedAt(v time.Time) *BlockchainRouteUpsert {
+	u.Set(blockchainroute.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BlockchainRouteUpsert) UpdateUpdatedAt() *BlockchainRouteUpsert {
+	u.SetExcluded(blockchainroute.FieldUpdatedAt)
+	return u
+}
+
+// SetService sets the "service" field.
+func (u *BlockchainRouteUpsert) SetService(v blockchainroute.Service) *BlockchainRouteUpsert {
+	u.Set(blockchainroute.FieldService, v)
+	return u
+}
+
+// UpdateService sets the "service" field to the value that was provided on create.
+func (u *BlockchainRouteUpsert) UpdateService() *BlockchainRouteUpsert {
+	u.SetExcluded(blockchainroute.FieldService)
+	return u
+}
+
+// SetToken sets the "token" field.
+func (u *BlockchainRouteUpsert) SetToken(v string) *BlockchainRouteUpsert {
+	u.Set(blockchainroute.FieldToken, v)
+	return u
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *BlockchainRouteUpsert) UpdateToken() *BlockchainRouteUpsert {
+	u.SetExcluded(blockchainroute.FieldToken)
+	return u
+}
+
+// SetNetwork sets the "network" field.
+func (u *BlockchainRouteUpsert) SetNetwork(v string) *BlockchainRouteUpsert {
+	u.Set(blockchainroute.FieldNetwork, v)
+	return u
+}
+
+// UpdateNetwork sets the "network" field to the value that was provided on create.
+func (u *BlockchainRouteUpsert) UpdateNetwork() *BlockchainRouteUpsert {
+	u.SetExcluded(blockchainroute.FieldNetwork)
+	return u
+}
+
+// SetMinAmount sets the "min_amount" field.
+func (u *BlockchainRouteUpsert) SetMinAmount(v decimal.Decimal) *BlockchainRouteUpsert {
+	u.Set(blockchainroute.FieldMinAmount, v)
+	return u
+}
+
+// UpdateMinAmount sets the "min_amount" field to the value that was provided on create.
+func (u *BlockchainRouteUpsert) UpdateMinAmount() *BlockchainRouteUpsert {
+	u.SetExcluded(blockchainroute.FieldMinAmount)
+	return u
+}
+
+// AddMinAmount adds v to the "min_amount" field.
+func (u *BlockchainRouteUpsert) AddMinAmount(v decimal.Decimal) *BlockchainRouteUpsert {
+	u.Add(blockchainroute.FieldMinAmount, v)
+	return u
+}
+
+// SetMaxAmount sets the "max_amount" field.
+func (u *BlockchainRouteUpsert) SetMaxAmount(v decimal.Decimal) *BlockchainRouteUpsert {
+	u.Set(blockchainroute.FieldMaxAmount, v)
+	return u
+}
+
+// UpdateMaxAmount sets the "max_amount" field to the value that was provided on create.
+func (u *BlockchainRouteUpsert) UpdateMaxAmount() *BlockchainRouteUpsert {
+	u.SetExcluded(blockchainroute.FieldMaxAmount)
+	return u
+}
+
+// AddMaxAmount adds v to the "max_amount" field.
+func (u *BlockchainRouteUpsert) AddMaxAmount(v decimal.Decimal) *BlockchainRouteUpsert {
+	u.Add(blockchainroute.FieldMaxAmount, v)
+	return u
+}
+
+// ClearMaxAmount clears the value of the "max_amount" field.
+func (u *BlockchainRouteUpsert) ClearMaxAmount() *BlockchainRouteUpsert {
+	u.SetNull(blockchainroute.FieldMaxAmount)
+	return u
+}
+
+// SetPriority sets the "priority" field.
+func (u *BlockchainRouteUpsert) SetPriority(v int) *BlockchainRouteUpsert {
+	u.Set(blockchainroute.FieldPriority, v)
+	return u
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *BlockchainRouteUpsert) UpdatePriority() *BlockchainRouteUpsert {
+	u.SetExcluded(blockchainroute.FieldPriority)
+	return u
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *BlockchainRouteUpsert) AddPriority(v int) *BlockchainRouteUpsert {
+	u.Add(blockchainroute.FieldPriority, v)
+	return u
+}
+
+// SetIsEnabled sets the "is_enabled" field.
+func (u *BlockchainRouteUpsert) SetIsEnabled(v bool) *BlockchainRouteUpsert {
+	u.Set(blockchainroute.FieldIsEnabled, v)
+	return u
+}
+
+// UpdateIsEnabled sets the "is_enabled" field to the value that was provided on create.
+func (u *BlockchainRouteUpsert) UpdateIsEnabled() *BlockchainRouteUpsert {
+	u.SetExcluded(blockchainroute.FieldIsEnabled)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.BlockchainRoute.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(blockchainroute.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BlockchainRouteUpsertOne) UpdateNewValues() *BlockchainRouteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(blockchainroute.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(blockchainroute.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BlockchainRoute.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BlockchainRouteUpsertOne) Ignore() *BlockchainRouteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BlockchainRouteUpsertOne) DoNothing() *BlockchainRouteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BlockchainRouteCreate.OnConflict
+// documentation for more info.
+func (u *BlockchainRouteUpsertOne) Update(set func(*BlockchainRouteUpsert)) *BlockchainRouteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BlockchainRouteUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BlockchainRouteUpsertOne) SetUpdatedAt(v time.Time) *BlockchainRouteUpsertOne {
+	return u.Update(func(s *BlockchainRouteUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BlockchainRouteUpsertOne) UpdateUpdatedAt() *BlockchainRouteUpsertOne {
+	return u.Update(func(s *BlockchainRouteUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetService sets the "service" field.
+func (u *BlockchainRouteUpsertOne) SetService(v blockchainroute.Service) *BlockchainRouteUpsertOne {
+	return u.Update(func(s *BlockchainRouteUpsert) {
+		s.SetService(v)
+	})
+}
+
+// UpdateService sets the "service" field to the value that was provided on create.
+func (u *BlockchainRouteUpsertOne) UpdateService() *BlockchainRouteUpsertOne {
+	return u.Update(func(s *BlockchainRouteUpsert) {
+		s.UpdateService()
+	})
+}
+
+// SetToken sets the "token" field.
+func (u *BlockchainRouteUpsertOne) SetToken(v string) *BlockchainRouteUpsertOne {
+	return u.Update(func(s *BlockchainRouteUpsert) {
+		s.SetToken(v)
+	})
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *BlockchainRouteUpsertOne) UpdateToken() *BlockchainRouteUpsertOne {
+	return u.Update(func(s *BlockchainRouteUpsert) {
+		s.UpdateToken()
+	})
+}
+
+// SetNetwork sets the "network" field.
+func (u *BlockchainRouteUpsertOne) SetNetwork(v string) *BlockchainRouteUpsertOne {
+	return u.Update(func(s *BlockchainRouteUpsert) {
+		s.SetNetwork(v)
+	})
+}
+
+// UpdateNetwork sets the "network" field to the value that was provided on create.
+func (u *BlockchainRouteUpsertOne) UpdateNetwork() *BlockchainRouteUpsertOne {
+	return u.Update(func(s *BlockchainRouteUpsert) {
+		s.UpdateNetwork()
+	})
+}
+
+// SetMinAmount sets the "min_amount" field.
+func (u *BlockchainRouteUpsertOne) SetMinAmount(v decimal.Decimal) *BlockchainRouteUpsertOne {
+	return u.Update(func(s *BlockchainRouteUpsert) {
+		s.SetMinAmount(v)
+	})
+}
+
+// AddMinAmount adds v to the "min_amount" field.
+func (u *BlockchainRouteUpsertOne) AddMinAmount(v decimal.Decimal) *BlockchainRouteUpsertOne {
+	return u.Update(func(s *BlockchainRouteUpsert) {
+		s.AddMinAmount(v)
+	})
+}
+
+// UpdateMinAmount sets the "min_amount" field to the value that was provided on create.
+func (u *BlockchainRouteUpsertOne) UpdateMinAmount() *BlockchainRouteUpsertOne {
+	return u.Update(func(s *BlockchainRouteUpsert) {
+		s.UpdateMinAmount()
+	})
+}
+
+// SetMaxAmount sets the "max_amount" field.
+func (u *BlockchainRouteUpsertOne) SetMaxAmount(v decimal.Decimal) *BlockchainRouteUpsertOne {
+	return u.Update(func(s *BlockchainRouteUpsert) {
+		s.SetMaxAmount(v)
+	})
+}
+
+// AddMaxAmount adds v to the "max_amount" field.
+func (u *BlockchainRouteUpsertOne) AddMaxAmount(v decimal.Decimal) *BlockchainRouteUpsertOne {
+	return u.Update(func(s *BlockchainRouteUpsert) {
+		s.AddMaxAmount(v)
+	})
+}
+
+// UpdateMaxAmount sets the "max_amount" field to the value that was provided on create.
+func (u *BlockchainRouteUpsertOne) UpdateMaxAmount() *BlockchainRouteUpsertOne {
+	return u.Update(func(s *BlockchainRouteUpsert) {
+		s.UpdateMaxAmount()
+	})
+}
+
+// ClearMaxAmount clears the value of the "max_amount" field.
+func (u *BlockchainRouteUpsertOne) ClearMaxAmount() *BlockchainRouteUpsertOne {
+	return u.Update(func(s *BlockchainRouteUpsert) {
+		s.ClearMaxAmount()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *BlockchainRouteUpsertOne) SetPriority(v int) *BlockchainRouteUpsertOne {
+	return u.Update(func(s *BlockchainRouteUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *BlockchainRouteUpsertOne) AddPriority(v int) *BlockchainRouteUpsertOne {
+	return u.Update(func(s *BlockchainRouteUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *BlockchainRouteUpsertOne) UpdatePriority() *BlockchainRouteUpsertOne {
+	return u.Update(func(s *BlockchainRouteUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetIsEnabled sets the "is_enabled" field.
+func (u *BlockchainRouteUpsertOne) SetIsEnabled(v bool) *BlockchainRouteUpsertOne {
+	return u.Update(func(s *BlockchainRouteUpsert) {
+		s.SetIsEnabled(v)
+	})
+}
+
+// UpdateIsEnabled sets the "is_enabled" field to the value that was provided on create.
+func (u *BlockchainRouteUpsertOne) UpdateIsEnabled() *BlockchainRouteUpsertOne {
+	return u.Update(func(s *BlockchainRouteUpsert) {
+		s.UpdateIsEnabled()
+	})
+}
+
+// Exec executes the query.
+func (u *BlockchainRouteUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BlockchainRouteCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BlockchainRouteUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BlockchainRouteUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: BlockchainRouteUpsertOne.ID is not supported by MySQL driver. Use BlockchainRouteUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BlockchainRouteUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// BlockchainRouteCreateBulk is the builder for creating many BlockchainRoute entities in bulk.
+type BlockchainRouteCreateBulk struct {
+	config
+	err      error
+	builders []*BlockchainRouteCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the BlockchainRoute entities in the database.
+func (brcb *BlockchainRouteCreateBulk) Save(ctx context.Context) ([]*BlockchainRoute, error) {
+	if brcb.err != nil {
+		return nil, brcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(brcb.builders))
+	nodes := make([]*BlockchainRoute, len(brcb.builders))
+	mutators := make([]Mutator, len(brcb.builders))
+	for i := range brcb.builders {
+		func(i int, root context.Context) {
+			builder := brcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BlockchainRouteMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, brcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = brcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, brcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, brcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (brcb *BlockchainRouteCreateBulk) SaveX(ctx context.Context) []*BlockchainRoute {
+	v, err := brcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (brcb *BlockchainRouteCreateBulk) Exec(ctx context.Context) error {
+	_, err := brcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (brcb *BlockchainRouteCreateBulk) ExecX(ctx context.Context) {
+	if err := brcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BlockchainRoute.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BlockchainRouteUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (brcb *BlockchainRouteCreateBulk) OnConflict(opts ...sql.ConflictOption) *BlockchainRouteUpsertBulk {
+	brcb.conflict = opts
+	return &BlockchainRouteUpsertBulk{
+		create: brcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BlockchainRoute.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (brcb *BlockchainRouteCreateBulk) OnConflictColumns(columns ...string) *BlockchainRouteUpsertBulk {
+	brcb.conflict = append(brcb.conflict, sql.ConflictColumns(columns...))
+	return &BlockchainRouteUpsertBulk{
+		create: brcb,
+	}
+}
+
+// BlockchainRouteUpsertBulk is the builder for "upsert"-ing
+// a bulk of BlockchainRoute nodes.
+type BlockchainRouteUpsertBulk struct {
+	create *BlockchainRouteCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.BlockchainRoute.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(blockchainroute.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BlockchainRouteUpsertBulk) UpdateNewValues() *BlockchainRouteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(blockchainroute.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(blockchainroute.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BlockchainRoute.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BlockchainRouteUpsertBulk) Ignore() *BlockchainRouteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BlockchainRouteUpsertBulk) DoNothing() *BlockchainRouteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BlockchainRouteCreateBulk.OnConflict
+// documentation for more info.
+func (u *BlockchainRouteUpsertBulk) Update(set func(*BlockchainRouteUpsert)) *BlockchainRouteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BlockchainRouteUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BlockchainRouteUpsertBulk) SetUpdatedAt(v time.Time) *BlockchainRouteUpsertBulk {
+	return u.Update(func(s *BlockchainRouteUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BlockchainRouteUpsertBulk) UpdateUpdatedAt() *BlockchainRouteUpsertBulk {
+	return u.Update(func(s *BlockchainRouteUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetService sets the "service" field.
+func (u *BlockchainRouteUpsertBulk) SetService(v blockchainroute.Service) *BlockchainRouteUpsertBulk {
+	return u.Update(func(s *BlockchainRouteUpsert) {
+		s.SetService(v)
+	})
+}
+
+// UpdateService sets the "service" field to the value that was provided on create.
+func (u *BlockchainRouteUpsertBulk) UpdateService() *BlockchainRouteUpsertBulk {
+	return u.Update(func(s *BlockchainRouteUpsert) {
+		s.UpdateService()
+	})
+}
+
+// SetToken sets the "token" field.
+func (u *BlockchainRouteUpsertBulk) SetToken(v string) *BlockchainRouteUpsertBulk {
+	return u.Update(func(s *BlockchainRouteUpsert) {
+		s.SetToken(v)
+	})
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *BlockchainRouteUpsertBulk) UpdateToken() *BlockchainRouteUpsertBulk {
+	return u.Update(func(s *BlockchainRouteUpsert) {
+		s.UpdateToken()
+	})
+}
+
+// SetNetwork sets the "network" field.
+func (u *BlockchainRouteUpsertBulk) SetNetwork(v string) *BlockchainRouteUpsertBulk {
+	return u.Update(func(s *BlockchainRouteUpsert) {
+		s.SetNetwork(v)
+	})
+}
+
+// UpdateNetwork sets the "network" field to the value that was provided on create.
+func (u *BlockchainRouteUpsertBulk) UpdateNetwork() *BlockchainRouteUpsertBulk {
+	return u.Update(func(s *BlockchainRouteUpsert) {
+		s.UpdateNetwork()
+	})
+}
+
+// SetMinAmount sets the "min_amount" field.
+func (u *BlockchainRouteUpsertBulk) SetMinAmount(v decimal.Decimal) *BlockchainRouteUpsertBulk {
+	return u.Update(func(s *BlockchainRouteUpsert) {
+		s.SetMinAmount(v)
+	})
+}
+
+// AddMinAmount adds v to the "min_amount" field.
+func (u *BlockchainRouteUpsertBulk) AddMinAmount(v decimal.Decimal) *BlockchainRouteUpsertBulk {
+	return u.Update(func(s *BlockchainRouteUpsert) {
+		s.AddMinAmount(v)
+	})
+}
+
+// UpdateMinAmount sets the "min_amount" field to the value that was provided on create.
+func (u *BlockchainRouteUpsertBulk) UpdateMinAmount() *BlockchainRouteUpsertBulk {
+	return u.Update(func(s *BlockchainRouteUpsert) {
+		s.UpdateMinAmount()
+	})
+}
+
+// SetMaxAmount sets the "max_amount" field.
+func (u *BlockchainRouteUpsertBulk) SetMaxAmount(v decimal.Decimal) *BlockchainRouteUpsertBulk {
+	return u.Update(func(s *BlockchainRouteUpsert) {
+		s.SetMaxAmount(v)
+	})
+}
+
+// AddMaxAmount adds v to the "max_amount" field.
+func (u *BlockchainRouteUpsertBulk) AddMaxAmount(v decimal.Decimal) *BlockchainRouteUpsertBulk {
+	return u.Update(func(s *BlockchainRouteUpsert) {
+		s.AddMaxAmount(v)
+	})
+}
+
+// UpdateMaxAmount sets the "max_amount" field to the value that was provided on create.
+func (u *BlockchainRouteUpsertBulk) UpdateMaxAmount() *BlockchainRouteUpsertBulk {
+	return u.Update(func(s *BlockchainRouteUpsert) {
+		s.UpdateMaxAmount()
+	})
+}
+
+// ClearMaxAmount clears the value of the "max_amount" field.
+func (u *BlockchainRouteUpsertBulk) ClearMaxAmount() *BlockchainRouteUpsertBulk {
+	return u.Update(func(s *BlockchainRouteUpsert) {
+		s.ClearMaxAmount()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *BlockchainRouteUpsertBulk) SetPriority(v int) *BlockchainRouteUpsertBulk {
+	return u.Update(func(s *BlockchainRouteUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *BlockchainRouteUpsertBulk) AddPriority(v int) *BlockchainRouteUpsertBulk {
+	return u.Update(func(s *BlockchainRouteUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *BlockchainRouteUpsertBulk) UpdatePriority() *BlockchainRouteUpsertBulk {
+	return u.Update(func(s *BlockchainRouteUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetIsEnabled sets the "is_enabled" field.
+func (u *BlockchainRouteUpsertBulk) SetIsEnabled(v bool) *BlockchainRouteUpsertBulk {
+	return u.Update(func(s *BlockchainRouteUpsert) {
+		s.SetIsEnabled(v)
+	})
+}
+
+// UpdateIsEnabled sets the "is_enabled" field to the value that was provided on create.
+func (u *BlockchainRouteUpsertBulk) UpdateIsEnabled() *BlockchainRouteUpsertBulk {
+	return u.Update(func(s *BlockchainRouteUpsert) {
+		s.UpdateIsEnabled()
+	})
+}
+
+// Exec executes the query.
+func (u *BlockchainRouteUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BlockchainRouteCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BlockchainRouteCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BlockchainRouteUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/blockchainroute"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+)
+
+// BlockchainRouteDelete is the builder for deleting a BlockchainRoute entity.
+type BlockchainRouteDelete struct {
+	config
+	hooks    []Hook
+	mutation *BlockchainRouteMutation
+}
+
+// Where appends a list predicates to the BlockchainRouteDelete builder.
+func (brd *BlockchainRouteDelete) Where(ps ...predicate.BlockchainRoute) *BlockchainRouteDelete {
+	brd.mutation.Where(ps...)
+	return brd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (brd *BlockchainRouteDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, brd.sqlExec, brd.mutation, brd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (brd *BlockchainRouteDelete) ExecX(ctx context.Context) int {
+	n, err := brd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (brd *BlockchainRouteDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(blockchainroute.Table, sqlgraph.NewFieldSpec(blockchainroute.FieldID, field.TypeUUID))
+	if ps := brd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, brd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	brd.mutation.done = true
+	return affected, err
+}
+
+// BlockchainRouteDeleteOne is the builder for deleting a single BlockchainRoute entity.
+type BlockchainRouteDeleteOne struct {
+	brd *BlockchainRouteDelete
+}
+
+// Where appends a list predicates to the BlockchainRouteDelete builder.
+func (brdo *BlockchainRouteDeleteOne) Where(ps ...predicate.BlockchainRoute) *BlockchainRouteDeleteOne {
+	brdo.brd.mutation.Where(ps...)
+	return brdo
+}
+
+// Exec executes the deletion query.
+func (brdo *BlockchainRouteDeleteOne) Exec(ctx context.Context) error {
+	n, err := brdo.brd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{blockchainroute.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (brdo *BlockchainRouteDeleteOne) ExecX(ctx context.Context) {
+	if err := brdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
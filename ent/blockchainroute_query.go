@@ -0,0 +1,615 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/blockchainroute"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/NEDA-LABS/stablenode/ent/senderprofile"
+	"github.com/google/uuid"
+)
+
+// BlockchainRouteQuery is the builder for querying BlockchainRoute entities.
+type BlockchainRouteQuery struct {
+	config
+	ctx               *QueryContext
+	order             []blockchainroute.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.BlockchainRoute
+	withSenderProfile *SenderProfileQuery
+	withFKs           bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the BlockchainRouteQuery builder.
+func (brq *BlockchainRouteQuery) Where(ps ...predicate.BlockchainRoute) *BlockchainRouteQuery {
+	brq.predicates = append(brq.predicates, ps...)
+	return brq
+}
+
+// Limit the number of records to be returned by this query.
+func (brq *BlockchainRouteQuery) Limit(limit int) *BlockchainRouteQuery {
+	brq.ctx.Limit = &limit
+	return brq
+}
+
+// Offset to start from.
+func (brq *BlockchainRouteQuery) Offset(offset int) *BlockchainRouteQuery {
+	brq.ctx.Offset = &offset
+	return brq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (brq *BlockchainRouteQuery) Unique(unique bool) *BlockchainRouteQuery {
+	brq.ctx.Unique = &unique
+	return brq
+}
+
+// Order specifies how the records should be ordered.
+func (brq *BlockchainRouteQuery) Order(o ...blockchainroute.OrderOption) *BlockchainRouteQuery {
+	brq.order = append(brq.order, o...)
+	return brq
+}
+
+// QuerySenderProfile chains the current query on the "sender_profile" edge.
+func (brq *BlockchainRouteQuery) QuerySenderProfile() *SenderProfileQuery {
+	query := (&SenderProfileClient{config: brq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := brq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := brq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(blockchainroute.Table, blockchainroute.FieldID, selector),
+			sqlgraph.To(senderprofile.Table, senderprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, blockchainroute.SenderProfileTable, blockchainroute.SenderProfileColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(brq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first BlockchainRoute entity from the query.
+// Returns a *NotFoundError when no BlockchainRoute was found.
+func (brq *BlockchainRouteQuery) First(ctx context.Context) (*BlockchainRoute, error) {
+	nodes, err := brq.Limit(1).All(setContextOp(ctx, brq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{blockchainroute.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (brq *BlockchainRouteQuery) FirstX(ctx context.Context) *BlockchainRoute {
+	node, err := brq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first BlockchainRoute ID from the query.
+// Returns a *NotFoundError when no BlockchainRoute ID was found.
+func (brq *BlockchainRouteQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = brq.Limit(1).IDs(setContextOp(ctx, brq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{blockchainroute.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (brq *BlockchainRouteQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := brq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single BlockchainRoute entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one BlockchainRoute entity is found.
+// Returns a *NotFoundError when no BlockchainRoute entities are found.
+func (brq *BlockchainRouteQuery) Only(ctx context.Context) (*BlockchainRoute, error) {
+	nodes, err := brq.Limit(2).All(setContextOp(ctx, brq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{blockchainroute.Label}
+	default:
+		return nil, &NotSingularError{blockchainroute.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (brq *BlockchainRouteQuery) OnlyX(ctx context.Context) *BlockchainRoute {
+	node, err := brq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only BlockchainRoute ID in the query.
+// Returns a *NotSingularError when more than one BlockchainRoute ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (brq *BlockchainRouteQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = brq.Limit(2).IDs(setContextOp(ctx, brq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{blockchainroute.Label}
+	default:
+		err = &NotSingularError{blockchainroute.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (brq *BlockchainRouteQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := brq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of BlockchainRoutes.
+func (brq *BlockchainRouteQuery) All(ctx context.Context) ([]*BlockchainRoute, error) {
+	ctx = setContextOp(ctx, brq.ctx, ent.OpQueryAll)
+	if err := brq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*BlockchainRoute, *BlockchainRouteQuery]()
+	return withInterceptors[[]*BlockchainRoute](ctx, brq, qr, brq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (brq *BlockchainRouteQuery) AllX(ctx context.Context) []*BlockchainRoute {
+	nodes, err := brq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of BlockchainRoute IDs.
+func (brq *BlockchainRouteQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if brq.ctx.Unique == nil && brq.path != nil {
+		brq.Unique(true)
+	}
+	ctx = setContextOp(ctx, brq.ctx, ent.OpQueryIDs)
+	if err = brq.Select(blockchainroute.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (brq *BlockchainRouteQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := brq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (brq *BlockchainRouteQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, brq.ctx, ent.OpQueryCount)
+	if err := brq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, brq, querierCount[*BlockchainRouteQuery](), brq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (brq *BlockchainRouteQuery) CountX(ctx context.Context) int {
+	count, err := brq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (brq *BlockchainRouteQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, brq.ctx, ent.OpQueryExist)
+	switch _, err := brq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (brq *BlockchainRouteQuery) ExistX(ctx context.Context) bool {
+	exist, err := brq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the BlockchainRouteQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (brq *BlockchainRouteQuery) Clone() *BlockchainRouteQuery {
+	if brq == nil {
+		return nil
+	}
+	return &BlockchainRouteQuery{
+		config:            brq.config,
+		ctx:               brq.ctx.Clone(),
+		order:             append([]blockchainroute.OrderOption{}, brq.order...),
+		inters:            append([]Interceptor{}, brq.inters...),
+		predicates:        append([]predicate.BlockchainRoute{}, brq.predicates...),
+		withSenderProfile: brq.withSenderProfile.Clone(),
+		// clone intermediate query.
+		sql:  brq.sql.Clone(),
+		path: brq.path,
+	}
+}
+
+// WithSenderProfile tells the query-builder to eager-load the nodes that are connected to
+// the "sender_profile" edge. The optional arguments are used to configure the query builder of the edge.
+func (brq *BlockchainRouteQuery) WithSenderProfile(opts ...func(*SenderProfileQuery)) *BlockchainRouteQuery {
+	query := (&SenderProfileClient{config: brq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	brq.withSenderProfile = query
+	return brq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.BlockchainRoute.Query().
+//		GroupBy(blockchainroute.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (brq *BlockchainRouteQuery) GroupBy(field string, fields ...string) *BlockchainRouteGroupBy {
+	brq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &BlockchainRouteGroupBy{build: brq}
+	grbuild.flds = &brq.ctx.Fields
+	grbuild.label = blockchainroute.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.BlockchainRoute.Query().
+//		Select(blockchainroute.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (brq *BlockchainRouteQuery) Select(fields ...string) *BlockchainRouteSelect {
+	brq.ctx.Fields = append(brq.ctx.Fields, fields...)
+	sbuild := &BlockchainRouteSelect{BlockchainRouteQuery: brq}
+	sbuild.label = blockchainroute.Label
+	sbuild.flds, sbuild.scan = &brq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a BlockchainRouteSelect configured with the given aggregations.
+func (brq *BlockchainRouteQuery) Aggregate(fns ...AggregateFunc) *BlockchainRouteSelect {
+	return brq.Select().Aggregate(fns...)
+}
+
+func (brq *BlockchainRouteQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range brq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, brq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range brq.ctx.Fields {
+		if !blockchainroute.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if brq.path != nil {
+		prev, err := brq.path(ctx)
+		if err != nil {
+			return err
+		}
+		brq.sql = prev
+	}
+	return nil
+}
+
+func (brq *BlockchainRouteQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*BlockchainRoute, error) {
+	var (
+		nodes       = []*BlockchainRoute{}
+		withFKs     = brq.withFKs
+		_spec       = brq.querySpec()
+		loadedTypes = [1]bool{
+			brq.withSenderProfile != nil,
+		}
+	)
+	if brq.withSenderProfile != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, blockchainroute.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*BlockchainRoute).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &BlockchainRoute{config: brq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, brq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := brq.withSenderProfile; query != nil {
+		if err := brq.loadSenderProfile(ctx, query, nodes, nil,
+			func(n *BlockchainRoute, e *SenderProfile) { n.Edges.SenderProfile = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (brq *BlockchainRouteQuery) loadSenderProfile(ctx context.Context, query *SenderProfileQuery, nodes []*BlockchainRoute, init func(*BlockchainRoute), assign func(*BlockchainRoute, *SenderProfile)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*BlockchainRoute)
+	for i := range nodes {
+		if nodes[i].sender_profile_blockchain_routes == nil {
+			continue
+		}
+		fk := *nodes[i].sender_profile_blockchain_routes
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(senderprofile.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "sender_profile_blockchain_routes" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (brq *BlockchainRouteQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := brq.querySpec()
+	_spec.Node.Columns = brq.ctx.Fields
+	if len(brq.ctx.Fields) > 0 {
+		_spec.Unique = brq.ctx.Unique != nil && *brq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, brq.driver, _spec)
+}
+
+func (brq *BlockchainRouteQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(blockchainroute.Table, blockchainroute.Columns, sqlgraph.NewFieldSpec(blockchainroute.FieldID, field.TypeUUID))
+	_spec.From = brq.sql
+	if unique := brq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if brq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := brq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, blockchainroute.FieldID)
+		for i := range fields {
+			if fields[i] != blockchainroute.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := brq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := brq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := brq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := brq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (brq *BlockchainRouteQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(brq.driver.Dialect())
+	t1 := builder.Table(blockchainroute.Table)
+	columns := brq.ctx.Fields
+	if len(columns) == 0 {
+		columns = blockchainroute.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if brq.sql != nil {
+		selector = brq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if brq.ctx.Unique != nil && *brq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range brq.predicates {
+		p(selector)
+	}
+	for _, p := range brq.order {
+		p(selector)
+	}
+	if offset := brq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := brq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// BlockchainRouteGroupBy is the group-by builder for BlockchainRoute entities.
+type BlockchainRouteGroupBy struct {
+	selector
+	build *BlockchainRouteQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (brgb *BlockchainRouteGroupBy) Aggregate(fns ...AggregateFunc) *BlockchainRouteGroupBy {
+	brgb.fns = append(brgb.fns, fns...)
+	return brgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (brgb *BlockchainRouteGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, brgb.build.ctx, ent.OpQueryGroupBy)
+	if err := brgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BlockchainRouteQuery, *BlockchainRouteGroupBy](ctx, brgb.build, brgb, brgb.build.inters, v)
+}
+
+func (brgb *BlockchainRouteGroupBy) sqlScan(ctx context.Context, root *BlockchainRouteQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(brgb.fns))
+	for _, fn := range brgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*brgb.flds)+len(brgb.fns))
+		for _, f := range *brgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*brgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := brgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// BlockchainRouteSelect is the builder for selecting fields of BlockchainRoute entities.
+type BlockchainRouteSelect struct {
+	*BlockchainRouteQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (brs *BlockchainRouteSelect) Aggregate(fns ...AggregateFunc) *BlockchainRouteSelect {
+	brs.fns = append(brs.fns, fns...)
+	return brs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (brs *BlockchainRouteSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, brs.ctx, ent.OpQuerySelect)
+	if err := brs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BlockchainRouteQuery, *BlockchainRouteSelect](ctx, brs.BlockchainRouteQuery, brs, brs.inters, v)
+}
+
+func (brs *BlockchainRouteSelect) sqlScan(ctx context.Context, root *BlockchainRouteQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(brs.fns))
+	for _, fn := range brs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*brs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := brs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
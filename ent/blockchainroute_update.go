@@ -0,0 +1,685 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/blockchainroute"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/NEDA-LABS/stablenode/ent/senderprofile"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// BlockchainRouteUpdate is the builder for updating BlockchainRoute entities.
+type BlockchainRouteUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BlockchainRouteMutation
+}
+
+// Where appends a list predicates to the BlockchainRouteUpdate builder.
+func (bru *BlockchainRouteUpdate) Where(ps ...predicate.BlockchainRoute) *BlockchainRouteUpdate {
+	bru.mutation.Where(ps...)
+	return bru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (bru *BlockchainRouteUpdate) SetUpdatedAt(t time.Time) *BlockchainRouteUpdate {
+	bru.mutation.SetUpdatedAt(t)
+	return bru
+}
+
+// SetService sets the "service" field.
+func (bru *BlockchainRouteUpdate) SetService(b blockchainroute.Service) *BlockchainRouteUpdate {
+	bru.mutation.SetService(b)
+	return bru
+}
+
+// SetNillableService sets the "service" field if the given value is not nil.
+func (bru *BlockchainRouteUpdate) SetNillableService(b *blockchainroute.Service) *BlockchainRouteUpdate {
+	if b != nil {
+		bru.SetService(*b)
+	}
+	return bru
+}
+
+// SetToken sets the "token" field.
+func (bru *BlockchainRouteUpdate) SetToken(s string) *BlockchainRouteUpdate {
+	bru.mutation.SetToken(s)
+	return bru
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (bru *BlockchainRouteUpdate) SetNillableToken(s *string) *BlockchainRouteUpdate {
+	if s != nil {
+		bru.SetToken(*s)
+	}
+	return bru
+}
+
+// SetNetwork sets the "network" field.
+func (bru *BlockchainRouteUpdate) SetNetwork(s string) *BlockchainRouteUpdate {
+	bru.mutation.SetNetwork(s)
+	return bru
+}
+
+// SetNillableNetwork sets the "network" field if the given value is not nil.
+func (bru *BlockchainRouteUpdate) SetNillableNetwork(s *string) *BlockchainRouteUpdate {
+	if s != nil {
+		bru.SetNetwork(*s)
+	}
+	return bru
+}
+
+// SetMinAmount sets the "min_amount" field.
+func (bru *BlockchainRouteUpdate) SetMinAmount(d decimal.Decimal) *BlockchainRouteUpdate {
+	bru.mutation.ResetMinAmount()
+	bru.mutation.SetMinAmount(d)
+	return bru
+}
+
+// SetNillableMinAmount sets the "min_amount" field if the given value is not nil.
+func (bru *BlockchainRouteUpdate) SetNillableMinAmount(d *decimal.Decimal) *BlockchainRouteUpdate {
+	if d != nil {
+		bru.SetMinAmount(*d)
+	}
+	return bru
+}
+
+// AddMinAmount adds d to the "min_amount" field.
+func (bru *BlockchainRouteUpdate) AddMinAmount(d decimal.Decimal) *BlockchainRouteUpdate {
+	bru.mutation.AddMinAmount(d)
+	return bru
+}
+
+// SetMaxAmount sets the "max_amount" field.
+func (bru *BlockchainRouteUpdate) SetMaxAmount(d decimal.Decimal) *BlockchainRouteUpdate {
+	bru.mutation.ResetMaxAmount()
+	bru.mutation.SetMaxAmount(d)
+	return bru
+}
+
+// SetNillableMaxAmount sets the "max_amount" field if the given value is not nil.
+func (bru *BlockchainRouteUpdate) SetNillableMaxAmount(d *decimal.Decimal) *BlockchainRouteUpdate {
+	if d != nil {
+		bru.SetMaxAmount(*d)
+	}
+	return bru
+}
+
+// AddMaxAmount adds d to the "max_amount" field.
+func (bru *BlockchainRouteUpdate) AddMaxAmount(d decimal.Decimal) *BlockchainRouteUpdate {
+	bru.mutation.AddMaxAmount(d)
+	return bru
+}
+
+// ClearMaxAmount clears the value of the "max_amount" field.
+func (bru *BlockchainRouteUpdate) ClearMaxAmount() *BlockchainRouteUpdate {
+	bru.mutation.ClearMaxAmount()
+	return bru
+}
+
+// SetPriority sets the "priority" field.
+func (bru *BlockchainRouteUpdate) SetPriority(i int) *BlockchainRouteUpdate {
+	bru.mutation.ResetPriority()
+	bru.mutation.SetPriority(i)
+	return bru
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (bru *BlockchainRouteUpdate) SetNillablePriority(i *int) *BlockchainRouteUpdate {
+	if i != nil {
+		bru.SetPriority(*i)
+	}
+	return bru
+}
+
+// AddPriority adds i to the "priority" field.
+func (bru *BlockchainRouteUpdate) AddPriority(i int) *BlockchainRouteUpdate {
+	bru.mutation.AddPriority(i)
+	return bru
+}
+
+// SetIsEnabled sets the "is_enabled" field.
+func (bru *BlockchainRouteUpdate) SetIsEnabled(b bool) *BlockchainRouteUpdate {
+	bru.mutation.SetIsEnabled(b)
+	return bru
+}
+
+// SetNillableIsEnabled sets the "is_enabled" field if the given value is not nil.
+func (bru *BlockchainRouteUpdate) SetNillableIsEnabled(b *bool) *BlockchainRouteUpdate {
+	if b != nil {
+		bru.SetIsEnabled(*b)
+	}
+	return bru
+}
+
+// SetSenderProfileID sets the "sender_profile" edge to the SenderProfile entity by ID.
+func (bru *BlockchainRouteUpdate) SetSenderProfileID(id uuid.UUID) *BlockchainRouteUpdate {
+	bru.mutation.SetSenderProfileID(id)
+	return bru
+}
+
+// SetNillableSenderProfileID sets the "sender_profile" edge to the SenderProfile entity by ID if the given value is not nil.
+func (bru *BlockchainRouteUpdate) SetNillableSenderProfileID(id *uuid.UUID) *BlockchainRouteUpdate {
+	if id != nil {
+		bru = bru.SetSenderProfileID(*id)
+	}
+	return bru
+}
+
+// SetSenderProfile sets the "sender_profile" edge to the SenderProfile entity.
+func (bru *BlockchainRouteUpdate) SetSenderProfile(s *SenderProfile) *BlockchainRouteUpdate {
+	return bru.SetSenderProfileID(s.ID)
+}
+
+// Mutation returns the BlockchainRouteMutation object of the builder.
+func (bru *BlockchainRouteUpdate) Mutation() *BlockchainRouteMutation {
+	return bru.mutation
+}
+
+// ClearSenderProfile clears the "sender_profile" edge to the SenderProfile entity.
+func (bru *BlockchainRouteUpdate) ClearSenderProfile() *BlockchainRouteUpdate {
+	bru.mutation.ClearSenderProfile()
+	return bru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (bru *BlockchainRouteUpdate) Save(ctx context.Context) (int, error) {
+	bru.defaults()
+	return withHooks(ctx, bru.sqlSave, bru.mutation, bru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bru *BlockchainRouteUpdate) SaveX(ctx context.Context) int {
+	affected, err := bru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bru *BlockchainRouteUpdate) Exec(ctx context.Context) error {
+	_, err := bru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bru *BlockchainRouteUpdate) ExecX(ctx context.Context) {
+	if err := bru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bru *BlockchainRouteUpdate) defaults() {
+	if _, ok := bru.mutation.UpdatedAt(); !ok {
+		v := blockchainroute.UpdateDefaultUpdatedAt()
+		bru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bru *BlockchainRouteUpdate) check() error {
+	if v, ok := bru.mutation.Service(); ok {
+		if err := blockchainroute.ServiceValidator(v); err != nil {
+			return &ValidationError{Name: "service", err: fmt.Errorf(`ent: validator failed for field "BlockchainRoute.service": %w`, err)}
+		}
+	}
+	if v, ok := bru.mutation.Token(); ok {
+		if err := blockchainroute.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "BlockchainRoute.token": %w`, err)}
+		}
+	}
+	if v, ok := bru.mutation.Network(); ok {
+		if err := blockchainroute.NetworkValidator(v); err != nil {
+			return &ValidationError{Name: "network", err: fmt.Errorf(`ent: validator failed for field "BlockchainRoute.network": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (bru *BlockchainRouteUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := bru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(blockchainroute.Table, blockchainroute.Columns, sqlgraph.NewFieldSpec(blockchainroute.FieldID, field.TypeUUID))
+	if ps := bru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bru.mutation.UpdatedAt(); ok {
+		_spec.SetField(blockchainroute.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := bru.mutation.Service(); ok {
+		_spec.SetField(blockchainroute.FieldService, field.TypeEnum, value)
+	}
+	if value, ok := bru.mutation.Token(); ok {
+		_spec.SetField(blockchainroute.FieldToken, field.TypeString, value)
+	}
+	if value, ok := bru.mutation.Network(); ok {
+		_spec.SetField(blockchainroute.FieldNetwork, field.TypeString, value)
+	}
+	if value, ok := bru.mutation.MinAmount(); ok {
+		_spec.SetField(blockchainroute.FieldMinAmount, field.TypeFloat64, value)
+	}
+	if value, ok := bru.mutation.AddedMinAmount(); ok {
+		_spec.AddField(blockchainroute.FieldMinAmount, field.TypeFloat64, value)
+	}
+	if value, ok := bru.mutation.MaxAmount(); ok {
+		_spec.SetField(blockchainroute.FieldMaxAmount, field.TypeFloat64, value)
+	}
+	if value, ok := bru.mutation.AddedMaxAmount(); ok {
+		_spec.AddField(blockchainroute.FieldMaxAmount, field.TypeFloat64, value)
+	}
+	if bru.mutation.MaxAmountCleared() {
+		_spec.ClearField(blockchainroute.FieldMaxAmount, field.TypeFloat64)
+	}
+	if value, ok := bru.mutation.Priority(); ok {
+		_spec.SetField(blockchainroute.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := bru.mutation.AddedPriority(); ok {
+		_spec.AddField(blockchainroute.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := bru.mutation.IsEnabled(); ok {
+		_spec.SetField(blockchainroute.FieldIsEnabled, field.TypeBool, value)
+	}
+	if bru.mutation.SenderProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blockchainroute.SenderProfileTable,
+			Columns: []string{blockchainroute.SenderProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderprofile.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bru.mutation.SenderProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blockchainroute.SenderProfileTable,
+			Columns: []string{blockchainroute.SenderProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderprofile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, bru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{blockchainroute.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	bru.mutation.done = true
+	return n, nil
+}
+
+// BlockchainRouteUpdateOne is the builder for updating a single BlockchainRoute entity.
+type BlockchainRouteUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BlockchainRouteMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (bruo *BlockchainRouteUpdateOne) SetUpdatedAt(t time.Time) *BlockchainRouteUpdateOne {
+	bruo.mutation.SetUpdatedAt(t)
+	return bruo
+}
+
+// SetService sets the "service" field.
+func (bruo *BlockchainRouteUpdateOne) SetService(b blockchainroute.Service) *BlockchainRouteUpdateOne {
+	bruo.mutation.SetService(b)
+	return bruo
+}
+
+// SetNillableService sets the "service" field if the given value is not nil.
+func (bruo *BlockchainRouteUpdateOne) SetNillableService(b *blockchainroute.Service) *BlockchainRouteUpdateOne {
+	if b != nil {
+		bruo.SetService(*b)
+	}
+	return bruo
+}
+
+// SetToken sets the "token" field.
+func (bruo *BlockchainRouteUpdateOne) SetToken(s string) *BlockchainRouteUpdateOne {
+	bruo.mutation.SetToken(s)
+	return bruo
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (bruo *BlockchainRouteUpdateOne) SetNillableToken(s *string) *BlockchainRouteUpdateOne {
+	if s != nil {
+		bruo.SetToken(*s)
+	}
+	return bruo
+}
+
+// SetNetwork sets the "network" field.
+func (bruo *BlockchainRouteUpdateOne) SetNetwork(s string) *BlockchainRouteUpdateOne {
+	bruo.mutation.SetNetwork(s)
+	return bruo
+}
+
+// SetNillableNetwork sets the "network" field if the given value is not nil.
+func (bruo *BlockchainRouteUpdateOne) SetNillableNetwork(s *string) *BlockchainRouteUpdateOne {
+	if s != nil {
+		bruo.SetNetwork(*s)
+	}
+	return bruo
+}
+
+// SetMinAmount sets the "min_amount" field.
+func (bruo *BlockchainRouteUpdateOne) SetMinAmount(d decimal.Decimal) *BlockchainRouteUpdateOne {
+	bruo.mutation.ResetMinAmount()
+	bruo.mutation.SetMinAmount(d)
+	return bruo
+}
+
+// SetNillableMinAmount sets the "min_amount" field if the given value is not nil.
+func (bruo *BlockchainRouteUpdateOne) SetNillableMinAmount(d *decimal.Decimal) *BlockchainRouteUpdateOne {
+	if d != nil {
+		bruo.SetMinAmount(*d)
+	}
+	return bruo
+}
+
+// AddMinAmount adds d to the "min_amount" field.
+func (bruo *BlockchainRouteUpdateOne) AddMinAmount(d decimal.Decimal) *BlockchainRouteUpdateOne {
+	bruo.mutation.AddMinAmount(d)
+	return bruo
+}
+
+// SetMaxAmount sets the "max_amount" field.
+func (bruo *BlockchainRouteUpdateOne) SetMaxAmount(d decimal.Decimal) *BlockchainRouteUpdateOne {
+	bruo.mutation.ResetMaxAmount()
+	bruo.mutation.SetMaxAmount(d)
+	return bruo
+}
+
+// SetNillableMaxAmount sets the "max_amount" field if the given value is not nil.
+func (bruo *BlockchainRouteUpdateOne) SetNillableMaxAmount(d *decimal.Decimal) *BlockchainRouteUpdateOne {
+	if d != nil {
+		bruo.SetMaxAmount(*d)
+	}
+	return bruo
+}
+
+// AddMaxAmount adds d to the "max_amount" field.
+func (bruo *BlockchainRouteUpdateOne) AddMaxAmount(d decimal.Decimal) *BlockchainRouteUpdateOne {
+	bruo.mutation.AddMaxAmount(d)
+	return bruo
+}
+
+// ClearMaxAmount clears the value of the "max_amount" field.
+func (bruo *BlockchainRouteUpdateOne) ClearMaxAmount() *BlockchainRouteUpdateOne {
+	bruo.mutation.ClearMaxAmount()
+	return bruo
+}
+
+// SetPriority sets the "priority" field.
+func (bruo *BlockchainRouteUpdateOne) SetPriority(i int) *BlockchainRouteUpdateOne {
+	bruo.mutation.ResetPriority()
+	bruo.mutation.SetPriority(i)
+	return bruo
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (bruo *BlockchainRouteUpdateOne) SetNillablePriority(i *int) *BlockchainRouteUpdateOne {
+	if i != nil {
+		bruo.SetPriority(*i)
+	}
+	return bruo
+}
+
+// AddPriority adds i to the "priority" field.
+func (bruo *BlockchainRouteUpdateOne) AddPriority(i int) *BlockchainRouteUpdateOne {
+	bruo.mutation.AddPriority(i)
+	return bruo
+}
+
+// SetIsEnabled sets the "is_enabled" field.
+func (bruo *BlockchainRouteUpdateOne) SetIsEnabled(b bool) *BlockchainRouteUpdateOne {
+	bruo.mutation.SetIsEnabled(b)
+	return bruo
+}
+
+// SetNillableIsEnabled sets the "is_enabled" field if the given value is not nil.
+func (bruo *BlockchainRouteUpdateOne) SetNillableIsEnabled(b *bool) *BlockchainRouteUpdateOne {
+	if b != nil {
+		bruo.SetIsEnabled(*b)
+	}
+	return bruo
+}
+
+// SetSenderProfileID sets the "sender_profile" edge to the SenderProfile entity by ID.
+func (bruo *BlockchainRouteUpdateOne) SetSenderProfileID(id uuid.UUID) *BlockchainRouteUpdateOne {
+	bruo.mutation.SetSenderProfileID(id)
+	return bruo
+}
+
+// SetNillableSenderProfileID sets the "sender_profile" edge to the SenderProfile entity by ID if the given value is not nil.
+func (bruo *BlockchainRouteUpdateOne) SetNillableSenderProfileID(id *uuid.UUID) *BlockchainRouteUpdateOne {
+	if id != nil {
+		bruo = bruo.SetSenderProfileID(*id)
+	}
+	return bruo
+}
+
+// SetSenderProfile sets the "sender_profile" edge to the SenderProfile entity.
+func (bruo *BlockchainRouteUpdateOne) SetSenderProfile(s *SenderProfile) *BlockchainRouteUpdateOne {
+	return bruo.SetSenderProfileID(s.ID)
+}
+
+// Mutation returns the BlockchainRouteMutation object of the builder.
+func (bruo *BlockchainRouteUpdateOne) Mutation() *BlockchainRouteMutation {
+	return bruo.mutation
+}
+
+// ClearSenderProfile clears the "sender_profile" edge to the SenderProfile entity.
+func (bruo *BlockchainRouteUpdateOne) ClearSenderProfile() *BlockchainRouteUpdateOne {
+	bruo.mutation.ClearSenderProfile()
+	return bruo
+}
+
+// Where appends a list predicates to the BlockchainRouteUpdate builder.
+func (bruo *BlockchainRouteUpdateOne) Where(ps ...predicate.BlockchainRoute) *BlockchainRouteUpdateOne {
+	bruo.mutation.Where(ps...)
+	return bruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (bruo *BlockchainRouteUpdateOne) Select(field string, fields ...string) *BlockchainRouteUpdateOne {
+	bruo.fields = append([]string{field}, fields...)
+	return bruo
+}
+
+// Save executes the query and returns the updated BlockchainRoute entity.
+func (bruo *BlockchainRouteUpdateOne) Save(ctx context.Context) (*BlockchainRoute, error) {
+	bruo.defaults()
+	return withHooks(ctx, bruo.sqlSave, bruo.mutation, bruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bruo *BlockchainRouteUpdateOne) SaveX(ctx context.Context) *BlockchainRoute {
+	node, err := bruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (bruo *BlockchainRouteUpdateOne) Exec(ctx context.Context) error {
+	_, err := bruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bruo *BlockchainRouteUpdateOne) ExecX(ctx context.Context) {
+	if err := bruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bruo *BlockchainRouteUpdateOne) defaults() {
+	if _, ok := bruo.mutation.UpdatedAt(); !ok {
+		v := blockchainroute.UpdateDefaultUpdatedAt()
+		bruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bruo *BlockchainRouteUpdateOne) check() error {
+	if v, ok := bruo.mutation.Service(); ok {
+		if err := blockchainroute.ServiceValidator(v); err != nil {
+			return &ValidationError{Name: "service", err: fmt.Errorf(`ent: validator failed for field "BlockchainRoute.service": %w`, err)}
+		}
+	}
+	if v, ok := bruo.mutation.Token(); ok {
+		if err := blockchainroute.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "BlockchainRoute.token": %w`, err)}
+		}
+	}
+	if v, ok := bruo.mutation.Network(); ok {
+		if err := blockchainroute.NetworkValidator(v); err != nil {
+			return &ValidationError{Name: "network", err: fmt.Errorf(`ent: validator failed for field "BlockchainRoute.network": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (bruo *BlockchainRouteUpdateOne) sqlSave(ctx context.Context) (_node *BlockchainRoute, err error) {
+	if err := bruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(blockchainroute.Table, blockchainroute.Columns, sqlgraph.NewFieldSpec(blockchainroute.FieldID, field.TypeUUID))
+	id, ok := bruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BlockchainRoute.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := bruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, blockchainroute.FieldID)
+		for _, f := range fields {
+			if !blockchainroute.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != blockchainroute.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := bruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(blockchainroute.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := bruo.mutation.Service(); ok {
+		_spec.SetField(blockchainroute.FieldService, field.TypeEnum, value)
+	}
+	if value, ok := bruo.mutation.Token(); ok {
+		_spec.SetField(blockchainroute.FieldToken, field.TypeString, value)
+	}
+	if value, ok := bruo.mutation.Network(); ok {
+		_spec.SetField(blockchainroute.FieldNetwork, field.TypeString, value)
+	}
+	if value, ok := bruo.mutation.MinAmount(); ok {
+		_spec.SetField(blockchainroute.FieldMinAmount, field.TypeFloat64, value)
+	}
+	if value, ok := bruo.mutation.AddedMinAmount(); ok {
+		_spec.AddField(blockchainroute.FieldMinAmount, field.TypeFloat64, value)
+	}
+	if value, ok := bruo.mutation.MaxAmount(); ok {
+		_spec.SetField(blockchainroute.FieldMaxAmount, field.TypeFloat64, value)
+	}
+	if value, ok := bruo.mutation.AddedMaxAmount(); ok {
+		_spec.AddField(blockchainroute.FieldMaxAmount, field.TypeFloat64, value)
+	}
+	if bruo.mutation.MaxAmountCleared() {
+		_spec.ClearField(blockchainroute.FieldMaxAmount, field.TypeFloat64)
+	}
+	if value, ok := bruo.mutation.Priority(); ok {
+		_spec.SetField(blockchainroute.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := bruo.mutation.AddedPriority(); ok {
+		_spec.AddField(blockchainroute.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := bruo.mutation.IsEnabled(); ok {
+		_spec.SetField(blockchainroute.FieldIsEnabled, field.TypeBool, value)
+	}
+	if bruo.mutation.SenderProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blockchainroute.SenderProfileTable,
+			Columns: []string{blockchainroute.SenderProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderprofile.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bruo.mutation.SenderProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blockchainroute.SenderProfileTable,
+			Columns: []string{blockchainroute.SenderProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderprofile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &BlockchainRoute{config: bruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, bruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{blockchainroute.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	bruo.mutation.done = true
+	return _node, nil
+}
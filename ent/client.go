@@ -16,31 +16,52 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/NEDA-LABS/stablenode/ent/adminauditlog"
 	"github.com/NEDA-LABS/stablenode/ent/apikey"
 	"github.com/NEDA-LABS/stablenode/ent/beneficialowner"
+	"github.com/NEDA-LABS/stablenode/ent/blockchainroute"
+	"github.com/NEDA-LABS/stablenode/ent/deadletter"
+	"github.com/NEDA-LABS/stablenode/ent/denylistedaddress"
+	"github.com/NEDA-LABS/stablenode/ent/depositsplit"
+	"github.com/NEDA-LABS/stablenode/ent/feeschedule"
 	"github.com/NEDA-LABS/stablenode/ent/fiatcurrency"
 	"github.com/NEDA-LABS/stablenode/ent/identityverificationrequest"
 	"github.com/NEDA-LABS/stablenode/ent/institution"
 	"github.com/NEDA-LABS/stablenode/ent/kybprofile"
+	"github.com/NEDA-LABS/stablenode/ent/ledgeraccount"
+	"github.com/NEDA-LABS/stablenode/ent/ledgerentry"
+	"github.com/NEDA-LABS/stablenode/ent/ledgerposting"
 	"github.com/NEDA-LABS/stablenode/ent/linkedaddress"
 	"github.com/NEDA-LABS/stablenode/ent/lockorderfulfillment"
+	"github.com/NEDA-LABS/stablenode/ent/lockorderreassignment"
 	"github.com/NEDA-LABS/stablenode/ent/lockpaymentorder"
 	"github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/ent/outboxtransaction"
 	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
 	"github.com/NEDA-LABS/stablenode/ent/paymentorderrecipient"
 	"github.com/NEDA-LABS/stablenode/ent/paymentwebhook"
+	"github.com/NEDA-LABS/stablenode/ent/providerbalancesnapshot"
 	"github.com/NEDA-LABS/stablenode/ent/providercurrencies"
 	"github.com/NEDA-LABS/stablenode/ent/providerordertoken"
+	"github.com/NEDA-LABS/stablenode/ent/providerperformance"
 	"github.com/NEDA-LABS/stablenode/ent/providerprofile"
 	"github.com/NEDA-LABS/stablenode/ent/providerrating"
 	"github.com/NEDA-LABS/stablenode/ent/provisionbucket"
 	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddressassignment"
+	"github.com/NEDA-LABS/stablenode/ent/reconciliationdiscrepancy"
+	"github.com/NEDA-LABS/stablenode/ent/reconciliationreport"
+	"github.com/NEDA-LABS/stablenode/ent/rpcendpoint"
 	"github.com/NEDA-LABS/stablenode/ent/senderordertoken"
 	"github.com/NEDA-LABS/stablenode/ent/senderprofile"
+	"github.com/NEDA-LABS/stablenode/ent/sweep"
 	"github.com/NEDA-LABS/stablenode/ent/token"
 	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
+	"github.com/NEDA-LABS/stablenode/ent/unmatcheddeposit"
 	"github.com/NEDA-LABS/stablenode/ent/user"
 	"github.com/NEDA-LABS/stablenode/ent/verificationtoken"
+	"github.com/NEDA-LABS/stablenode/ent/webhookdelivery"
+	"github.com/NEDA-LABS/stablenode/ent/webhookdestination"
 	"github.com/NEDA-LABS/stablenode/ent/webhookretryattempt"
 )
 
@@ -51,8 +72,20 @@ type Client struct {
 	Schema *migrate.Schema
 	// APIKey is the client for interacting with the APIKey builders.
 	APIKey *APIKeyClient
+	// AdminAuditLog is the client for interacting with the AdminAuditLog builders.
+	AdminAuditLog *AdminAuditLogClient
 	// BeneficialOwner is the client for interacting with the BeneficialOwner builders.
 	BeneficialOwner *BeneficialOwnerClient
+	// BlockchainRoute is the client for interacting with the BlockchainRoute builders.
+	BlockchainRoute *BlockchainRouteClient
+	// DeadLetter is the client for interacting with the DeadLetter builders.
+	DeadLetter *DeadLetterClient
+	// DenylistedAddress is the client for interacting with the DenylistedAddress builders.
+	DenylistedAddress *DenylistedAddressClient
+	// DepositSplit is the client for interacting with the DepositSplit builders.
+	DepositSplit *DepositSplitClient
+	// FeeSchedule is the client for interacting with the FeeSchedule builders.
+	FeeSchedule *FeeScheduleClient
 	// FiatCurrency is the client for interacting with the FiatCurrency builders.
 	FiatCurrency *FiatCurrencyClient
 	// IdentityVerificationRequest is the client for interacting with the IdentityVerificationRequest builders.
@@ -61,44 +94,74 @@ type Client struct {
 	Institution *InstitutionClient
 	// KYBProfile is the client for interacting with the KYBProfile builders.
 	KYBProfile *KYBProfileClient
+	// LedgerAccount is the client for interacting with the LedgerAccount builders.
+	LedgerAccount *LedgerAccountClient
+	// LedgerEntry is the client for interacting with the LedgerEntry builders.
+	LedgerEntry *LedgerEntryClient
+	// LedgerPosting is the client for interacting with the LedgerPosting builders.
+	LedgerPosting *LedgerPostingClient
 	// LinkedAddress is the client for interacting with the LinkedAddress builders.
 	LinkedAddress *LinkedAddressClient
 	// LockOrderFulfillment is the client for interacting with the LockOrderFulfillment builders.
 	LockOrderFulfillment *LockOrderFulfillmentClient
+	// LockOrderReassignment is the client for interacting with the LockOrderReassignment builders.
+	LockOrderReassignment *LockOrderReassignmentClient
 	// LockPaymentOrder is the client for interacting with the LockPaymentOrder builders.
 	LockPaymentOrder *LockPaymentOrderClient
 	// Network is the client for interacting with the Network builders.
 	Network *NetworkClient
+	// OutboxTransaction is the client for interacting with the OutboxTransaction builders.
+	OutboxTransaction *OutboxTransactionClient
 	// PaymentOrder is the client for interacting with the PaymentOrder builders.
 	PaymentOrder *PaymentOrderClient
 	// PaymentOrderRecipient is the client for interacting with the PaymentOrderRecipient builders.
 	PaymentOrderRecipient *PaymentOrderRecipientClient
 	// PaymentWebhook is the client for interacting with the PaymentWebhook builders.
 	PaymentWebhook *PaymentWebhookClient
+	// ProviderBalanceSnapshot is the client for interacting with the ProviderBalanceSnapshot builders.
+	ProviderBalanceSnapshot *ProviderBalanceSnapshotClient
 	// ProviderCurrencies is the client for interacting with the ProviderCurrencies builders.
 	ProviderCurrencies *ProviderCurrenciesClient
 	// ProviderOrderToken is the client for interacting with the ProviderOrderToken builders.
 	ProviderOrderToken *ProviderOrderTokenClient
+	// ProviderPerformance is the client for interacting with the ProviderPerformance builders.
+	ProviderPerformance *ProviderPerformanceClient
 	// ProviderProfile is the client for interacting with the ProviderProfile builders.
 	ProviderProfile *ProviderProfileClient
 	// ProviderRating is the client for interacting with the ProviderRating builders.
 	ProviderRating *ProviderRatingClient
 	// ProvisionBucket is the client for interacting with the ProvisionBucket builders.
 	ProvisionBucket *ProvisionBucketClient
+	// RPCEndpoint is the client for interacting with the RPCEndpoint builders.
+	RPCEndpoint *RPCEndpointClient
 	// ReceiveAddress is the client for interacting with the ReceiveAddress builders.
 	ReceiveAddress *ReceiveAddressClient
+	// ReceiveAddressAssignment is the client for interacting with the ReceiveAddressAssignment builders.
+	ReceiveAddressAssignment *ReceiveAddressAssignmentClient
+	// ReconciliationDiscrepancy is the client for interacting with the ReconciliationDiscrepancy builders.
+	ReconciliationDiscrepancy *ReconciliationDiscrepancyClient
+	// ReconciliationReport is the client for interacting with the ReconciliationReport builders.
+	ReconciliationReport *ReconciliationReportClient
 	// SenderOrderToken is the client for interacting with the SenderOrderToken builders.
 	SenderOrderToken *SenderOrderTokenClient
 	// SenderProfile is the client for interacting with the SenderProfile builders.
 	SenderProfile *SenderProfileClient
+	// Sweep is the client for interacting with the Sweep builders.
+	Sweep *SweepClient
 	// Token is the client for interacting with the Token builders.
 	Token *TokenClient
 	// TransactionLog is the client for interacting with the TransactionLog builders.
 	TransactionLog *TransactionLogClient
+	// UnmatchedDeposit is the client for interacting with the UnmatchedDeposit builders.
+	UnmatchedDeposit *UnmatchedDepositClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// VerificationToken is the client for interacting with the VerificationToken builders.
 	VerificationToken *VerificationTokenClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
+	// WebhookDestination is the client for interacting with the WebhookDestination builders.
+	WebhookDestination *WebhookDestinationClient
 	// WebhookRetryAttempt is the client for interacting with the WebhookRetryAttempt builders.
 	WebhookRetryAttempt *WebhookRetryAttemptClient
 }
@@ -113,30 +176,51 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.APIKey = NewAPIKeyClient(c.config)
+	c.AdminAuditLog = NewAdminAuditLogClient(c.config)
 	c.BeneficialOwner = NewBeneficialOwnerClient(c.config)
+	c.BlockchainRoute = NewBlockchainRouteClient(c.config)
+	c.DeadLetter = NewDeadLetterClient(c.config)
+	c.DenylistedAddress = NewDenylistedAddressClient(c.config)
+	c.DepositSplit = NewDepositSplitClient(c.config)
+	c.FeeSchedule = NewFeeScheduleClient(c.config)
 	c.FiatCurrency = NewFiatCurrencyClient(c.config)
 	c.IdentityVerificationRequest = NewIdentityVerificationRequestClient(c.config)
 	c.Institution = NewInstitutionClient(c.config)
 	c.KYBProfile = NewKYBProfileClient(c.config)
+	c.LedgerAccount = NewLedgerAccountClient(c.config)
+	c.LedgerEntry = NewLedgerEntryClient(c.config)
+	c.LedgerPosting = NewLedgerPostingClient(c.config)
 	c.LinkedAddress = NewLinkedAddressClient(c.config)
 	c.LockOrderFulfillment = NewLockOrderFulfillmentClient(c.config)
+	c.LockOrderReassignment = NewLockOrderReassignmentClient(c.config)
 	c.LockPaymentOrder = NewLockPaymentOrderClient(c.config)
 	c.Network = NewNetworkClient(c.config)
+	c.OutboxTransaction = NewOutboxTransactionClient(c.config)
 	c.PaymentOrder = NewPaymentOrderClient(c.config)
 	c.PaymentOrderRecipient = NewPaymentOrderRecipientClient(c.config)
 	c.PaymentWebhook = NewPaymentWebhookClient(c.config)
+	c.ProviderBalanceSnapshot = NewProviderBalanceSnapshotClient(c.config)
 	c.ProviderCurrencies = NewProviderCurrenciesClient(c.config)
 	c.ProviderOrderToken = NewProviderOrderTokenClient(c.config)
+	c.ProviderPerformance = NewProviderPerformanceClient(c.config)
 	c.ProviderProfile = NewProviderProfileClient(c.config)
 	c.ProviderRating = NewProviderRatingClient(c.config)
 	c.ProvisionBucket = NewProvisionBucketClient(c.config)
+	c.RPCEndpoint = NewRPCEndpointClient(c.config)
 	c.ReceiveAddress = NewReceiveAddressClient(c.config)
+	c.ReceiveAddressAssignment = NewReceiveAddressAssignmentClient(c.config)
+	c.ReconciliationDiscrepancy = NewReconciliationDiscrepancyClient(c.config)
+	c.ReconciliationReport = NewReconciliationReportClient(c.config)
 	c.SenderOrderToken = NewSenderOrderTokenClient(c.config)
 	c.SenderProfile = NewSenderProfileClient(c.config)
+	c.Sweep = NewSweepClient(c.config)
 	c.Token = NewTokenClient(c.config)
 	c.TransactionLog = NewTransactionLogClient(c.config)
+	c.UnmatchedDeposit = NewUnmatchedDepositClient(c.config)
 	c.User = NewUserClient(c.config)
 	c.VerificationToken = NewVerificationTokenClient(c.config)
+	c.WebhookDelivery = NewWebhookDeliveryClient(c.config)
+	c.WebhookDestination = NewWebhookDestinationClient(c.config)
 	c.WebhookRetryAttempt = NewWebhookRetryAttemptClient(c.config)
 }
 
@@ -231,30 +315,51 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		ctx:                         ctx,
 		config:                      cfg,
 		APIKey:                      NewAPIKeyClient(cfg),
+		AdminAuditLog:               NewAdminAuditLogClient(cfg),
 		BeneficialOwner:             NewBeneficialOwnerClient(cfg),
+		BlockchainRoute:             NewBlockchainRouteClient(cfg),
+		DeadLetter:                  NewDeadLetterClient(cfg),
+		DenylistedAddress:           NewDenylistedAddressClient(cfg),
+		DepositSplit:                NewDepositSplitClient(cfg),
+		FeeSchedule:                 NewFeeScheduleClient(cfg),
 		FiatCurrency:                NewFiatCurrencyClient(cfg),
 		IdentityVerificationRequest: NewIdentityVerificationRequestClient(cfg),
 		Institution:                 NewInstitutionClient(cfg),
 		KYBProfile:                  NewKYBProfileClient(cfg),
+		LedgerAccount:               NewLedgerAccountClient(cfg),
+		LedgerEntry:                 NewLedgerEntryClient(cfg),
+		LedgerPosting:               NewLedgerPostingClient(cfg),
 		LinkedAddress:               NewLinkedAddressClient(cfg),
 		LockOrderFulfillment:        NewLockOrderFulfillmentClient(cfg),
+		LockOrderReassignment:       NewLockOrderReassignmentClient(cfg),
 		LockPaymentOrder:            NewLockPaymentOrderClient(cfg),
 		Network:                     NewNetworkClient(cfg),
+		OutboxTransaction:           NewOutboxTransactionClient(cfg),
 		PaymentOrder:                NewPaymentOrderClient(cfg),
 		PaymentOrderRecipient:       NewPaymentOrderRecipientClient(cfg),
 		PaymentWebhook:              NewPaymentWebhookClient(cfg),
+		ProviderBalanceSnapshot:     NewProviderBalanceSnapshotClient(cfg),
 		ProviderCurrencies:          NewProviderCurrenciesClient(cfg),
 		ProviderOrderToken:          NewProviderOrderTokenClient(cfg),
+		ProviderPerformance:         NewProviderPerformanceClient(cfg),
 		ProviderProfile:             NewProviderProfileClient(cfg),
 		ProviderRating:              NewProviderRatingClient(cfg),
 		ProvisionBucket:             NewProvisionBucketClient(cfg),
+		RPCEndpoint:                 NewRPCEndpointClient(cfg),
 		ReceiveAddress:              NewReceiveAddressClient(cfg),
+		ReceiveAddressAssignment:    NewReceiveAddressAssignmentClient(cfg),
+		ReconciliationDiscrepancy:   NewReconciliationDiscrepancyClient(cfg),
+		ReconciliationReport:        NewReconciliationReportClient(cfg),
 		SenderOrderToken:            NewSenderOrderTokenClient(cfg),
 		SenderProfile:               NewSenderProfileClient(cfg),
+		Sweep:                       NewSweepClient(cfg),
 		Token:                       NewTokenClient(cfg),
 		TransactionLog:              NewTransactionLogClient(cfg),
+		UnmatchedDeposit:            NewUnmatchedDepositClient(cfg),
 		User:                        NewUserClient(cfg),
 		VerificationToken:           NewVerificationTokenClient(cfg),
+		WebhookDelivery:             NewWebhookDeliveryClient(cfg),
+		WebhookDestination:          NewWebhookDestinationClient(cfg),
 		WebhookRetryAttempt:         NewWebhookRetryAttemptClient(cfg),
 	}, nil
 }
@@ -276,30 +381,51 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		ctx:                         ctx,
 		config:                      cfg,
 		APIKey:                      NewAPIKeyClient(cfg),
+		AdminAuditLog:               NewAdminAuditLogClient(cfg),
 		BeneficialOwner:             NewBeneficialOwnerClient(cfg),
+		BlockchainRoute:             NewBlockchainRouteClient(cfg),
+		DeadLetter:                  NewDeadLetterClient(cfg),
+		DenylistedAddress:           NewDenylistedAddressClient(cfg),
+		DepositSplit:                NewDepositSplitClient(cfg),
+		FeeSchedule:                 NewFeeScheduleClient(cfg),
 		FiatCurrency:                NewFiatCurrencyClient(cfg),
 		IdentityVerificationRequest: NewIdentityVerificationRequestClient(cfg),
 		Institution:                 NewInstitutionClient(cfg),
 		KYBProfile:                  NewKYBProfileClient(cfg),
+		LedgerAccount:               NewLedgerAccountClient(cfg),
+		LedgerEntry:                 NewLedgerEntryClient(cfg),
+		LedgerPosting:               NewLedgerPostingClient(cfg),
 		LinkedAddress:               NewLinkedAddressClient(cfg),
 		LockOrderFulfillment:        NewLockOrderFulfillmentClient(cfg),
+		LockOrderReassignment:       NewLockOrderReassignmentClient(cfg),
 		LockPaymentOrder:            NewLockPaymentOrderClient(cfg),
 		Network:                     NewNetworkClient(cfg),
+		OutboxTransaction:           NewOutboxTransactionClient(cfg),
 		PaymentOrder:                NewPaymentOrderClient(cfg),
 		PaymentOrderRecipient:       NewPaymentOrderRecipientClient(cfg),
 		PaymentWebhook:              NewPaymentWebhookClient(cfg),
+		ProviderBalanceSnapshot:     NewProviderBalanceSnapshotClient(cfg),
 		ProviderCurrencies:          NewProviderCurrenciesClient(cfg),
 		ProviderOrderToken:          NewProviderOrderTokenClient(cfg),
+		ProviderPerformance:         NewProviderPerformanceClient(cfg),
 		ProviderProfile:             NewProviderProfileClient(cfg),
 		ProviderRating:              NewProviderRatingClient(cfg),
 		ProvisionBucket:             NewProvisionBucketClient(cfg),
+		RPCEndpoint:                 NewRPCEndpointClient(cfg),
 		ReceiveAddress:              NewReceiveAddressClient(cfg),
+		ReceiveAddressAssignment:    NewReceiveAddressAssignmentClient(cfg),
+		ReconciliationDiscrepancy:   NewReconciliationDiscrepancyClient(cfg),
+		ReconciliationReport:        NewReconciliationReportClient(cfg),
 		SenderOrderToken:            NewSenderOrderTokenClient(cfg),
 		SenderProfile:               NewSenderProfileClient(cfg),
+		Sweep:                       NewSweepClient(cfg),
 		Token:                       NewTokenClient(cfg),
 		TransactionLog:              NewTransactionLogClient(cfg),
+		UnmatchedDeposit:            NewUnmatchedDepositClient(cfg),
 		User:                        NewUserClient(cfg),
 		VerificationToken:           NewVerificationTokenClient(cfg),
+		WebhookDelivery:             NewWebhookDeliveryClient(cfg),
+		WebhookDestination:          NewWebhookDestinationClient(cfg),
 		WebhookRetryAttempt:         NewWebhookRetryAttemptClient(cfg),
 	}, nil
 }
@@ -330,13 +456,19 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.APIKey, c.BeneficialOwner, c.FiatCurrency, c.IdentityVerificationRequest,
-		c.Institution, c.KYBProfile, c.LinkedAddress, c.LockOrderFulfillment,
-		c.LockPaymentOrder, c.Network, c.PaymentOrder, c.PaymentOrderRecipient,
-		c.PaymentWebhook, c.ProviderCurrencies, c.ProviderOrderToken,
-		c.ProviderProfile, c.ProviderRating, c.ProvisionBucket, c.ReceiveAddress,
-		c.SenderOrderToken, c.SenderProfile, c.Token, c.TransactionLog, c.User,
-		c.VerificationToken, c.WebhookRetryAttempt,
+		c.APIKey, c.AdminAuditLog, c.BeneficialOwner, c.BlockchainRoute, c.DeadLetter,
+		c.DenylistedAddress, c.DepositSplit, c.FeeSchedule, c.FiatCurrency,
+		c.IdentityVerificationRequest, c.Institution, c.KYBProfile, c.LedgerAccount,
+		c.LedgerEntry, c.LedgerPosting, c.LinkedAddress, c.LockOrderFulfillment,
+		c.LockOrderReassignment, c.LockPaymentOrder, c.Network, c.OutboxTransaction,
+		c.PaymentOrder, c.PaymentOrderRecipient, c.PaymentWebhook,
+		c.ProviderBalanceSnapshot, c.ProviderCurrencies, c.ProviderOrderToken,
+		c.ProviderPerformance, c.ProviderProfile, c.ProviderRating, c.ProvisionBucket,
+		c.RPCEndpoint, c.ReceiveAddress, c.ReceiveAddressAssignment,
+		c.ReconciliationDiscrepancy, c.ReconciliationReport, c.SenderOrderToken,
+		c.SenderProfile, c.Sweep, c.Token, c.TransactionLog, c.UnmatchedDeposit,
+		c.User, c.VerificationToken, c.WebhookDelivery, c.WebhookDestination,
+		c.WebhookRetryAttempt,
 	} {
 		n.Use(hooks...)
 	}
@@ -346,13 +478,19 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.APIKey, c.BeneficialOwner, c.FiatCurrency, c.IdentityVerificationRequest,
-		c.Institution, c.KYBProfile, c.LinkedAddress, c.LockOrderFulfillment,
-		c.LockPaymentOrder, c.Network, c.PaymentOrder, c.PaymentOrderRecipient,
-		c.PaymentWebhook, c.ProviderCurrencies, c.ProviderOrderToken,
-		c.ProviderProfile, c.ProviderRating, c.ProvisionBucket, c.ReceiveAddress,
-		c.SenderOrderToken, c.SenderProfile, c.Token, c.TransactionLog, c.User,
-		c.VerificationToken, c.WebhookRetryAttempt,
+		c.APIKey, c.AdminAuditLog, c.BeneficialOwner, c.BlockchainRoute, c.DeadLetter,
+		c.DenylistedAddress, c.DepositSplit, c.FeeSchedule, c.FiatCurrency,
+		c.IdentityVerificationRequest, c.Institution, c.KYBProfile, c.LedgerAccount,
+		c.LedgerEntry, c.LedgerPosting, c.LinkedAddress, c.LockOrderFulfillment,
+		c.LockOrderReassignment, c.LockPaymentOrder, c.Network, c.OutboxTransaction,
+		c.PaymentOrder, c.PaymentOrderRecipient, c.PaymentWebhook,
+		c.ProviderBalanceSnapshot, c.ProviderCurrencies, c.ProviderOrderToken,
+		c.ProviderPerformance, c.ProviderProfile, c.ProviderRating, c.ProvisionBucket,
+		c.RPCEndpoint, c.ReceiveAddress, c.ReceiveAddressAssignment,
+		c.ReconciliationDiscrepancy, c.ReconciliationReport, c.SenderOrderToken,
+		c.SenderProfile, c.Sweep, c.Token, c.TransactionLog, c.UnmatchedDeposit,
+		c.User, c.VerificationToken, c.WebhookDelivery, c.WebhookDestination,
+		c.WebhookRetryAttempt,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -363,8 +501,20 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *APIKeyMutation:
 		return c.APIKey.mutate(ctx, m)
+	case *AdminAuditLogMutation:
+		return c.AdminAuditLog.mutate(ctx, m)
 	case *BeneficialOwnerMutation:
 		return c.BeneficialOwner.mutate(ctx, m)
+	case *BlockchainRouteMutation:
+		return c.BlockchainRoute.mutate(ctx, m)
+	case *DeadLetterMutation:
+		return c.DeadLetter.mutate(ctx, m)
+	case *DenylistedAddressMutation:
+		return c.DenylistedAddress.mutate(ctx, m)
+	case *DepositSplitMutation:
+		return c.DepositSplit.mutate(ctx, m)
+	case *FeeScheduleMutation:
+		return c.FeeSchedule.mutate(ctx, m)
 	case *FiatCurrencyMutation:
 		return c.FiatCurrency.mutate(ctx, m)
 	case *IdentityVerificationRequestMutation:
@@ -373,44 +523,74 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Institution.mutate(ctx, m)
 	case *KYBProfileMutation:
 		return c.KYBProfile.mutate(ctx, m)
+	case *LedgerAccountMutation:
+		return c.LedgerAccount.mutate(ctx, m)
+	case *LedgerEntryMutation:
+		return c.LedgerEntry.mutate(ctx, m)
+	case *LedgerPostingMutation:
+		return c.LedgerPosting.mutate(ctx, m)
 	case *LinkedAddressMutation:
 		return c.LinkedAddress.mutate(ctx, m)
 	case *LockOrderFulfillmentMutation:
 		return c.LockOrderFulfillment.mutate(ctx, m)
+	case *LockOrderReassignmentMutation:
+		return c.LockOrderReassignment.mutate(ctx, m)
 	case *LockPaymentOrderMutation:
 		return c.LockPaymentOrder.mutate(ctx, m)
 	case *NetworkMutation:
 		return c.Network.mutate(ctx, m)
+	case *OutboxTransactionMutation:
+		return c.OutboxTransaction.mutate(ctx, m)
 	case *PaymentOrderMutation:
 		return c.PaymentOrder.mutate(ctx, m)
 	case *PaymentOrderRecipientMutation:
 		return c.PaymentOrderRecipient.mutate(ctx, m)
 	case *PaymentWebhookMutation:
 		return c.PaymentWebhook.mutate(ctx, m)
+	case *ProviderBalanceSnapshotMutation:
+		return c.ProviderBalanceSnapshot.mutate(ctx, m)
 	case *ProviderCurrenciesMutation:
 		return c.ProviderCurrencies.mutate(ctx, m)
 	case *ProviderOrderTokenMutation:
 		return c.ProviderOrderToken.mutate(ctx, m)
+	case *ProviderPerformanceMutation:
+		return c.ProviderPerformance.mutate(ctx, m)
 	case *ProviderProfileMutation:
 		return c.ProviderProfile.mutate(ctx, m)
 	case *ProviderRatingMutation:
 		return c.ProviderRating.mutate(ctx, m)
 	case *ProvisionBucketMutation:
 		return c.ProvisionBucket.mutate(ctx, m)
+	case *RPCEndpointMutation:
+		return c.RPCEndpoint.mutate(ctx, m)
 	case *ReceiveAddressMutation:
 		return c.ReceiveAddress.mutate(ctx, m)
+	case *ReceiveAddressAssignmentMutation:
+		return c.ReceiveAddressAssignment.mutate(ctx, m)
+	case *ReconciliationDiscrepancyMutation:
+		return c.ReconciliationDiscrepancy.mutate(ctx, m)
+	case *ReconciliationReportMutation:
+		return c.ReconciliationReport.mutate(ctx, m)
 	case *SenderOrderTokenMutation:
 		return c.SenderOrderToken.mutate(ctx, m)
 	case *SenderProfileMutation:
 		return c.SenderProfile.mutate(ctx, m)
+	case *SweepMutation:
+		return c.Sweep.mutate(ctx, m)
 	case *TokenMutation:
 		return c.Token.mutate(ctx, m)
 	case *TransactionLogMutation:
 		return c.TransactionLog.mutate(ctx, m)
+	case *UnmatchedDepositMutation:
+		return c.UnmatchedDeposit.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
 	case *VerificationTokenMutation:
 		return c.VerificationToken.mutate(ctx, m)
+	case *WebhookDeliveryMutation:
+		return c.WebhookDelivery.mutate(ctx, m)
+	case *WebhookDestinationMutation:
+		return c.WebhookDestination.mutate(ctx, m)
 	case *WebhookRetryAttemptMutation:
 		return c.WebhookRetryAttempt.mutate(ctx, m)
 	default:
@@ -534,7 +714,7 @@ func (c *APIKeyClient) QuerySenderProfile(ak *APIKey) *SenderProfileQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(apikey.Table, apikey.FieldID, id),
 			sqlgraph.To(senderprofile.Table, senderprofile.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, apikey.SenderProfileTable, apikey.SenderProfileColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, apikey.SenderProfileTable, apikey.SenderProfileColumn),
 		)
 		fromV = sqlgraph.Neighbors(ak.driver.Dialect(), step)
 		return fromV, nil
@@ -550,7 +730,7 @@ func (c *APIKeyClient) QueryProviderProfile(ak *APIKey) *ProviderProfileQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(apikey.Table, apikey.FieldID, id),
 			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, apikey.ProviderProfileTable, apikey.ProviderProfileColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, apikey.ProviderProfileTable, apikey.ProviderProfileColumn),
 		)
 		fromV = sqlgraph.Neighbors(ak.driver.Dialect(), step)
 		return fromV, nil
@@ -599,6 +779,139 @@ func (c *APIKeyClient) mutate(ctx context.Context, m *APIKeyMutation) (Value, er
 	}
 }
 
+// AdminAuditLogClient is a client for the AdminAuditLog schema.
+type AdminAuditLogClient struct {
+	config
+}
+
+// NewAdminAuditLogClient returns a client for the AdminAuditLog from the given config.
+func NewAdminAuditLogClient(c config) *AdminAuditLogClient {
+	return &AdminAuditLogClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `adminauditlog.Hooks(f(g(h())))`.
+func (c *AdminAuditLogClient) Use(hooks ...Hook) {
+	c.hooks.AdminAuditLog = append(c.hooks.AdminAuditLog, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `adminauditlog.Intercept(f(g(h())))`.
+func (c *AdminAuditLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AdminAuditLog = append(c.inters.AdminAuditLog, interceptors...)
+}
+
+// Create returns a builder for creating a AdminAuditLog entity.
+func (c *AdminAuditLogClient) Create() *AdminAuditLogCreate {
+	mutation := newAdminAuditLogMutation(c.config, OpCreate)
+	return &AdminAuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AdminAuditLog entities.
+func (c *AdminAuditLogClient) CreateBulk(builders ...*AdminAuditLogCreate) *AdminAuditLogCreateBulk {
+	return &AdminAuditLogCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AdminAuditLogClient) MapCreateBulk(slice any, setFunc func(*AdminAuditLogCreate, int)) *AdminAuditLogCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AdminAuditLogCreateBulk{err: fmt.Errorf("calling to AdminAuditLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AdminAuditLogCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AdminAuditLogCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AdminAuditLog.
+func (c *AdminAuditLogClient) Update() *AdminAuditLogUpdate {
+	mutation := newAdminAuditLogMutation(c.config, OpUpdate)
+	return &AdminAuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AdminAuditLogClient) UpdateOne(aal *AdminAuditLog) *AdminAuditLogUpdateOne {
+	mutation := newAdminAuditLogMutation(c.config, OpUpdateOne, withAdminAuditLog(aal))
+	return &AdminAuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AdminAuditLogClient) UpdateOneID(id uuid.UUID) *AdminAuditLogUpdateOne {
+	mutation := newAdminAuditLogMutation(c.config, OpUpdateOne, withAdminAuditLogID(id))
+	return &AdminAuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AdminAuditLog.
+func (c *AdminAuditLogClient) Delete() *AdminAuditLogDelete {
+	mutation := newAdminAuditLogMutation(c.config, OpDelete)
+	return &AdminAuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AdminAuditLogClient) DeleteOne(aal *AdminAuditLog) *AdminAuditLogDeleteOne {
+	return c.DeleteOneID(aal.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AdminAuditLogClient) DeleteOneID(id uuid.UUID) *AdminAuditLogDeleteOne {
+	builder := c.Delete().Where(adminauditlog.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AdminAuditLogDeleteOne{builder}
+}
+
+// Query returns a query builder for AdminAuditLog.
+func (c *AdminAuditLogClient) Query() *AdminAuditLogQuery {
+	return &AdminAuditLogQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAdminAuditLog},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AdminAuditLog entity by its id.
+func (c *AdminAuditLogClient) Get(ctx context.Context, id uuid.UUID) (*AdminAuditLog, error) {
+	return c.Query().Where(adminauditlog.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AdminAuditLogClient) GetX(ctx context.Context, id uuid.UUID) *AdminAuditLog {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AdminAuditLogClient) Hooks() []Hook {
+	return c.hooks.AdminAuditLog
+}
+
+// Interceptors returns the client interceptors.
+func (c *AdminAuditLogClient) Interceptors() []Interceptor {
+	return c.inters.AdminAuditLog
+}
+
+func (c *AdminAuditLogClient) mutate(ctx context.Context, m *AdminAuditLogMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AdminAuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AdminAuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AdminAuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AdminAuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AdminAuditLog mutation op: %q", m.Op())
+	}
+}
+
 // BeneficialOwnerClient is a client for the BeneficialOwner schema.
 type BeneficialOwnerClient struct {
 	config
@@ -748,107 +1061,107 @@ func (c *BeneficialOwnerClient) mutate(ctx context.Context, m *BeneficialOwnerMu
 	}
 }
 
-// FiatCurrencyClient is a client for the FiatCurrency schema.
-type FiatCurrencyClient struct {
+// BlockchainRouteClient is a client for the BlockchainRoute schema.
+type BlockchainRouteClient struct {
 	config
 }
 
-// NewFiatCurrencyClient returns a client for the FiatCurrency from the given config.
-func NewFiatCurrencyClient(c config) *FiatCurrencyClient {
-	return &FiatCurrencyClient{config: c}
+// NewBlockchainRouteClient returns a client for the BlockchainRoute from the given config.
+func NewBlockchainRouteClient(c config) *BlockchainRouteClient {
+	return &BlockchainRouteClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `fiatcurrency.Hooks(f(g(h())))`.
-func (c *FiatCurrencyClient) Use(hooks ...Hook) {
-	c.hooks.FiatCurrency = append(c.hooks.FiatCurrency, hooks...)
+// A call to `Use(f, g, h)` equals to `blockchainroute.Hooks(f(g(h())))`.
+func (c *BlockchainRouteClient) Use(hooks ...Hook) {
+	c.hooks.BlockchainRoute = append(c.hooks.BlockchainRoute, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `fiatcurrency.Intercept(f(g(h())))`.
-func (c *FiatCurrencyClient) Intercept(interceptors ...Interceptor) {
-	c.inters.FiatCurrency = append(c.inters.FiatCurrency, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `blockchainroute.Intercept(f(g(h())))`.
+func (c *BlockchainRouteClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BlockchainRoute = append(c.inters.BlockchainRoute, interceptors...)
 }
 
-// Create returns a builder for creating a FiatCurrency entity.
-func (c *FiatCurrencyClient) Create() *FiatCurrencyCreate {
-	mutation := newFiatCurrencyMutation(c.config, OpCreate)
-	return &FiatCurrencyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a BlockchainRoute entity.
+func (c *BlockchainRouteClient) Create() *BlockchainRouteCreate {
+	mutation := newBlockchainRouteMutation(c.config, OpCreate)
+	return &BlockchainRouteCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of FiatCurrency entities.
-func (c *FiatCurrencyClient) CreateBulk(builders ...*FiatCurrencyCreate) *FiatCurrencyCreateBulk {
-	return &FiatCurrencyCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of BlockchainRoute entities.
+func (c *BlockchainRouteClient) CreateBulk(builders ...*BlockchainRouteCreate) *BlockchainRouteCreateBulk {
+	return &BlockchainRouteCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *FiatCurrencyClient) MapCreateBulk(slice any, setFunc func(*FiatCurrencyCreate, int)) *FiatCurrencyCreateBulk {
+func (c *BlockchainRouteClient) MapCreateBulk(slice any, setFunc func(*BlockchainRouteCreate, int)) *BlockchainRouteCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &FiatCurrencyCreateBulk{err: fmt.Errorf("calling to FiatCurrencyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &BlockchainRouteCreateBulk{err: fmt.Errorf("calling to BlockchainRouteClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*FiatCurrencyCreate, rv.Len())
+	builders := make([]*BlockchainRouteCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &FiatCurrencyCreateBulk{config: c.config, builders: builders}
+	return &BlockchainRouteCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for FiatCurrency.
-func (c *FiatCurrencyClient) Update() *FiatCurrencyUpdate {
-	mutation := newFiatCurrencyMutation(c.config, OpUpdate)
-	return &FiatCurrencyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for BlockchainRoute.
+func (c *BlockchainRouteClient) Update() *BlockchainRouteUpdate {
+	mutation := newBlockchainRouteMutation(c.config, OpUpdate)
+	return &BlockchainRouteUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *FiatCurrencyClient) UpdateOne(fc *FiatCurrency) *FiatCurrencyUpdateOne {
-	mutation := newFiatCurrencyMutation(c.config, OpUpdateOne, withFiatCurrency(fc))
-	return &FiatCurrencyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BlockchainRouteClient) UpdateOne(br *BlockchainRoute) *BlockchainRouteUpdateOne {
+	mutation := newBlockchainRouteMutation(c.config, OpUpdateOne, withBlockchainRoute(br))
+	return &BlockchainRouteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *FiatCurrencyClient) UpdateOneID(id uuid.UUID) *FiatCurrencyUpdateOne {
-	mutation := newFiatCurrencyMutation(c.config, OpUpdateOne, withFiatCurrencyID(id))
-	return &FiatCurrencyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BlockchainRouteClient) UpdateOneID(id uuid.UUID) *BlockchainRouteUpdateOne {
+	mutation := newBlockchainRouteMutation(c.config, OpUpdateOne, withBlockchainRouteID(id))
+	return &BlockchainRouteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for FiatCurrency.
-func (c *FiatCurrencyClient) Delete() *FiatCurrencyDelete {
-	mutation := newFiatCurrencyMutation(c.config, OpDelete)
-	return &FiatCurrencyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for BlockchainRoute.
+func (c *BlockchainRouteClient) Delete() *BlockchainRouteDelete {
+	mutation := newBlockchainRouteMutation(c.config, OpDelete)
+	return &BlockchainRouteDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *FiatCurrencyClient) DeleteOne(fc *FiatCurrency) *FiatCurrencyDeleteOne {
-	return c.DeleteOneID(fc.ID)
+func (c *BlockchainRouteClient) DeleteOne(br *BlockchainRoute) *BlockchainRouteDeleteOne {
+	return c.DeleteOneID(br.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *FiatCurrencyClient) DeleteOneID(id uuid.UUID) *FiatCurrencyDeleteOne {
-	builder := c.Delete().Where(fiatcurrency.ID(id))
+func (c *BlockchainRouteClient) DeleteOneID(id uuid.UUID) *BlockchainRouteDeleteOne {
+	builder := c.Delete().Where(blockchainroute.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &FiatCurrencyDeleteOne{builder}
+	return &BlockchainRouteDeleteOne{builder}
 }
 
-// Query returns a query builder for FiatCurrency.
-func (c *FiatCurrencyClient) Query() *FiatCurrencyQuery {
-	return &FiatCurrencyQuery{
+// Query returns a query builder for BlockchainRoute.
+func (c *BlockchainRouteClient) Query() *BlockchainRouteQuery {
+	return &BlockchainRouteQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeFiatCurrency},
+		ctx:    &QueryContext{Type: TypeBlockchainRoute},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a FiatCurrency entity by its id.
-func (c *FiatCurrencyClient) Get(ctx context.Context, id uuid.UUID) (*FiatCurrency, error) {
-	return c.Query().Where(fiatcurrency.ID(id)).Only(ctx)
+// Get returns a BlockchainRoute entity by its id.
+func (c *BlockchainRouteClient) Get(ctx context.Context, id uuid.UUID) (*BlockchainRoute, error) {
+	return c.Query().Where(blockchainroute.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *FiatCurrencyClient) GetX(ctx context.Context, id uuid.UUID) *FiatCurrency {
+func (c *BlockchainRouteClient) GetX(ctx context.Context, id uuid.UUID) *BlockchainRoute {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -856,196 +1169,148 @@ func (c *FiatCurrencyClient) GetX(ctx context.Context, id uuid.UUID) *FiatCurren
 	return obj
 }
 
-// QueryProviderCurrencies queries the provider_currencies edge of a FiatCurrency.
-func (c *FiatCurrencyClient) QueryProviderCurrencies(fc *FiatCurrency) *ProviderCurrenciesQuery {
-	query := (&ProviderCurrenciesClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := fc.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(fiatcurrency.Table, fiatcurrency.FieldID, id),
-			sqlgraph.To(providercurrencies.Table, providercurrencies.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, fiatcurrency.ProviderCurrenciesTable, fiatcurrency.ProviderCurrenciesColumn),
-		)
-		fromV = sqlgraph.Neighbors(fc.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryProvisionBuckets queries the provision_buckets edge of a FiatCurrency.
-func (c *FiatCurrencyClient) QueryProvisionBuckets(fc *FiatCurrency) *ProvisionBucketQuery {
-	query := (&ProvisionBucketClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := fc.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(fiatcurrency.Table, fiatcurrency.FieldID, id),
-			sqlgraph.To(provisionbucket.Table, provisionbucket.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, fiatcurrency.ProvisionBucketsTable, fiatcurrency.ProvisionBucketsColumn),
-		)
-		fromV = sqlgraph.Neighbors(fc.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryInstitutions queries the institutions edge of a FiatCurrency.
-func (c *FiatCurrencyClient) QueryInstitutions(fc *FiatCurrency) *InstitutionQuery {
-	query := (&InstitutionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := fc.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(fiatcurrency.Table, fiatcurrency.FieldID, id),
-			sqlgraph.To(institution.Table, institution.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, fiatcurrency.InstitutionsTable, fiatcurrency.InstitutionsColumn),
-		)
-		fromV = sqlgraph.Neighbors(fc.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryProviderOrderTokens queries the provider_order_tokens edge of a FiatCurrency.
-func (c *FiatCurrencyClient) QueryProviderOrderTokens(fc *FiatCurrency) *ProviderOrderTokenQuery {
-	query := (&ProviderOrderTokenClient{config: c.config}).Query()
+// QuerySenderProfile queries the sender_profile edge of a BlockchainRoute.
+func (c *BlockchainRouteClient) QuerySenderProfile(br *BlockchainRoute) *SenderProfileQuery {
+	query := (&SenderProfileClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := fc.ID
+		id := br.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(fiatcurrency.Table, fiatcurrency.FieldID, id),
-			sqlgraph.To(providerordertoken.Table, providerordertoken.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, fiatcurrency.ProviderOrderTokensTable, fiatcurrency.ProviderOrderTokensColumn),
+			sqlgraph.From(blockchainroute.Table, blockchainroute.FieldID, id),
+			sqlgraph.To(senderprofile.Table, senderprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, blockchainroute.SenderProfileTable, blockchainroute.SenderProfileColumn),
 		)
-		fromV = sqlgraph.Neighbors(fc.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(br.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *FiatCurrencyClient) Hooks() []Hook {
-	return c.hooks.FiatCurrency
+func (c *BlockchainRouteClient) Hooks() []Hook {
+	return c.hooks.BlockchainRoute
 }
 
 // Interceptors returns the client interceptors.
-func (c *FiatCurrencyClient) Interceptors() []Interceptor {
-	return c.inters.FiatCurrency
+func (c *BlockchainRouteClient) Interceptors() []Interceptor {
+	return c.inters.BlockchainRoute
 }
 
-func (c *FiatCurrencyClient) mutate(ctx context.Context, m *FiatCurrencyMutation) (Value, error) {
+func (c *BlockchainRouteClient) mutate(ctx context.Context, m *BlockchainRouteMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&FiatCurrencyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BlockchainRouteCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&FiatCurrencyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BlockchainRouteUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&FiatCurrencyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BlockchainRouteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&FiatCurrencyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&BlockchainRouteDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown FiatCurrency mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown BlockchainRoute mutation op: %q", m.Op())
 	}
 }
 
-// IdentityVerificationRequestClient is a client for the IdentityVerificationRequest schema.
-type IdentityVerificationRequestClient struct {
+// DeadLetterClient is a client for the DeadLetter schema.
+type DeadLetterClient struct {
 	config
 }
 
-// NewIdentityVerificationRequestClient returns a client for the IdentityVerificationRequest from the given config.
-func NewIdentityVerificationRequestClient(c config) *IdentityVerificationRequestClient {
-	return &IdentityVerificationRequestClient{config: c}
+// NewDeadLetterClient returns a client for the DeadLetter from the given config.
+func NewDeadLetterClient(c config) *DeadLetterClient {
+	return &DeadLetterClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `identityverificationrequest.Hooks(f(g(h())))`.
-func (c *IdentityVerificationRequestClient) Use(hooks ...Hook) {
-	c.hooks.IdentityVerificationRequest = append(c.hooks.IdentityVerificationRequest, hooks...)
+// A call to `Use(f, g, h)` equals to `deadletter.Hooks(f(g(h())))`.
+func (c *DeadLetterClient) Use(hooks ...Hook) {
+	c.hooks.DeadLetter = append(c.hooks.DeadLetter, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `identityverificationrequest.Intercept(f(g(h())))`.
-func (c *IdentityVerificationRequestClient) Intercept(interceptors ...Interceptor) {
-	c.inters.IdentityVerificationRequest = append(c.inters.IdentityVerificationRequest, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `deadletter.Intercept(f(g(h())))`.
+func (c *DeadLetterClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DeadLetter = append(c.inters.DeadLetter, interceptors...)
 }
 
-// Create returns a builder for creating a IdentityVerificationRequest entity.
-func (c *IdentityVerificationRequestClient) Create() *IdentityVerificationRequestCreate {
-	mutation := newIdentityVerificationRequestMutation(c.config, OpCreate)
-	return &IdentityVerificationRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a DeadLetter entity.
+func (c *DeadLetterClient) Create() *DeadLetterCreate {
+	mutation := newDeadLetterMutation(c.config, OpCreate)
+	return &DeadLetterCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of IdentityVerificationRequest entities.
-func (c *IdentityVerificationRequestClient) CreateBulk(builders ...*IdentityVerificationRequestCreate) *IdentityVerificationRequestCreateBulk {
-	return &IdentityVerificationRequestCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of DeadLetter entities.
+func (c *DeadLetterClient) CreateBulk(builders ...*DeadLetterCreate) *DeadLetterCreateBulk {
+	return &DeadLetterCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *IdentityVerificationRequestClient) MapCreateBulk(slice any, setFunc func(*IdentityVerificationRequestCreate, int)) *IdentityVerificationRequestCreateBulk {
+func (c *DeadLetterClient) MapCreateBulk(slice any, setFunc func(*DeadLetterCreate, int)) *DeadLetterCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &IdentityVerificationRequestCreateBulk{err: fmt.Errorf("calling to IdentityVerificationRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &DeadLetterCreateBulk{err: fmt.Errorf("calling to DeadLetterClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*IdentityVerificationRequestCreate, rv.Len())
+	builders := make([]*DeadLetterCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &IdentityVerificationRequestCreateBulk{config: c.config, builders: builders}
+	return &DeadLetterCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for IdentityVerificationRequest.
-func (c *IdentityVerificationRequestClient) Update() *IdentityVerificationRequestUpdate {
-	mutation := newIdentityVerificationRequestMutation(c.config, OpUpdate)
-	return &IdentityVerificationRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for DeadLetter.
+func (c *DeadLetterClient) Update() *DeadLetterUpdate {
+	mutation := newDeadLetterMutation(c.config, OpUpdate)
+	return &DeadLetterUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *IdentityVerificationRequestClient) UpdateOne(ivr *IdentityVerificationRequest) *IdentityVerificationRequestUpdateOne {
-	mutation := newIdentityVerificationRequestMutation(c.config, OpUpdateOne, withIdentityVerificationRequest(ivr))
-	return &IdentityVerificationRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DeadLetterClient) UpdateOne(dl *DeadLetter) *DeadLetterUpdateOne {
+	mutation := newDeadLetterMutation(c.config, OpUpdateOne, withDeadLetter(dl))
+	return &DeadLetterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *IdentityVerificationRequestClient) UpdateOneID(id uuid.UUID) *IdentityVerificationRequestUpdateOne {
-	mutation := newIdentityVerificationRequestMutation(c.config, OpUpdateOne, withIdentityVerificationRequestID(id))
-	return &IdentityVerificationRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DeadLetterClient) UpdateOneID(id uuid.UUID) *DeadLetterUpdateOne {
+	mutation := newDeadLetterMutation(c.config, OpUpdateOne, withDeadLetterID(id))
+	return &DeadLetterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for IdentityVerificationRequest.
-func (c *IdentityVerificationRequestClient) Delete() *IdentityVerificationRequestDelete {
-	mutation := newIdentityVerificationRequestMutation(c.config, OpDelete)
-	return &IdentityVerificationRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for DeadLetter.
+func (c *DeadLetterClient) Delete() *DeadLetterDelete {
+	mutation := newDeadLetterMutation(c.config, OpDelete)
+	return &DeadLetterDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *IdentityVerificationRequestClient) DeleteOne(ivr *IdentityVerificationRequest) *IdentityVerificationRequestDeleteOne {
-	return c.DeleteOneID(ivr.ID)
+func (c *DeadLetterClient) DeleteOne(dl *DeadLetter) *DeadLetterDeleteOne {
+	return c.DeleteOneID(dl.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *IdentityVerificationRequestClient) DeleteOneID(id uuid.UUID) *IdentityVerificationRequestDeleteOne {
-	builder := c.Delete().Where(identityverificationrequest.ID(id))
+func (c *DeadLetterClient) DeleteOneID(id uuid.UUID) *DeadLetterDeleteOne {
+	builder := c.Delete().Where(deadletter.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &IdentityVerificationRequestDeleteOne{builder}
+	return &DeadLetterDeleteOne{builder}
 }
 
-// Query returns a query builder for IdentityVerificationRequest.
-func (c *IdentityVerificationRequestClient) Query() *IdentityVerificationRequestQuery {
-	return &IdentityVerificationRequestQuery{
+// Query returns a query builder for DeadLetter.
+func (c *DeadLetterClient) Query() *DeadLetterQuery {
+	return &DeadLetterQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeIdentityVerificationRequest},
+		ctx:    &QueryContext{Type: TypeDeadLetter},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a IdentityVerificationRequest entity by its id.
-func (c *IdentityVerificationRequestClient) Get(ctx context.Context, id uuid.UUID) (*IdentityVerificationRequest, error) {
-	return c.Query().Where(identityverificationrequest.ID(id)).Only(ctx)
+// Get returns a DeadLetter entity by its id.
+func (c *DeadLetterClient) Get(ctx context.Context, id uuid.UUID) (*DeadLetter, error) {
+	return c.Query().Where(deadletter.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *IdentityVerificationRequestClient) GetX(ctx context.Context, id uuid.UUID) *IdentityVerificationRequest {
+func (c *DeadLetterClient) GetX(ctx context.Context, id uuid.UUID) *DeadLetter {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1054,131 +1319,131 @@ func (c *IdentityVerificationRequestClient) GetX(ctx context.Context, id uuid.UU
 }
 
 // Hooks returns the client hooks.
-func (c *IdentityVerificationRequestClient) Hooks() []Hook {
-	return c.hooks.IdentityVerificationRequest
+func (c *DeadLetterClient) Hooks() []Hook {
+	return c.hooks.DeadLetter
 }
 
 // Interceptors returns the client interceptors.
-func (c *IdentityVerificationRequestClient) Interceptors() []Interceptor {
-	return c.inters.IdentityVerificationRequest
+func (c *DeadLetterClient) Interceptors() []Interceptor {
+	return c.inters.DeadLetter
 }
 
-func (c *IdentityVerificationRequestClient) mutate(ctx context.Context, m *IdentityVerificationRequestMutation) (Value, error) {
+func (c *DeadLetterClient) mutate(ctx context.Context, m *DeadLetterMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&IdentityVerificationRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DeadLetterCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&IdentityVerificationRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DeadLetterUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&IdentityVerificationRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DeadLetterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&IdentityVerificationRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&DeadLetterDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown IdentityVerificationRequest mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown DeadLetter mutation op: %q", m.Op())
 	}
 }
 
-// InstitutionClient is a client for the Institution schema.
-type InstitutionClient struct {
+// DenylistedAddressClient is a client for the DenylistedAddress schema.
+type DenylistedAddressClient struct {
 	config
 }
 
-// NewInstitutionClient returns a client for the Institution from the given config.
-func NewInstitutionClient(c config) *InstitutionClient {
-	return &InstitutionClient{config: c}
+// NewDenylistedAddressClient returns a client for the DenylistedAddress from the given config.
+func NewDenylistedAddressClient(c config) *DenylistedAddressClient {
+	return &DenylistedAddressClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `institution.Hooks(f(g(h())))`.
-func (c *InstitutionClient) Use(hooks ...Hook) {
-	c.hooks.Institution = append(c.hooks.Institution, hooks...)
+// A call to `Use(f, g, h)` equals to `denylistedaddress.Hooks(f(g(h())))`.
+func (c *DenylistedAddressClient) Use(hooks ...Hook) {
+	c.hooks.DenylistedAddress = append(c.hooks.DenylistedAddress, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `institution.Intercept(f(g(h())))`.
-func (c *InstitutionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Institution = append(c.inters.Institution, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `denylistedaddress.Intercept(f(g(h())))`.
+func (c *DenylistedAddressClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DenylistedAddress = append(c.inters.DenylistedAddress, interceptors...)
 }
 
-// Create returns a builder for creating a Institution entity.
-func (c *InstitutionClient) Create() *InstitutionCreate {
-	mutation := newInstitutionMutation(c.config, OpCreate)
-	return &InstitutionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a DenylistedAddress entity.
+func (c *DenylistedAddressClient) Create() *DenylistedAddressCreate {
+	mutation := newDenylistedAddressMutation(c.config, OpCreate)
+	return &DenylistedAddressCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Institution entities.
-func (c *InstitutionClient) CreateBulk(builders ...*InstitutionCreate) *InstitutionCreateBulk {
-	return &InstitutionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of DenylistedAddress entities.
+func (c *DenylistedAddressClient) CreateBulk(builders ...*DenylistedAddressCreate) *DenylistedAddressCreateBulk {
+	return &DenylistedAddressCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *InstitutionClient) MapCreateBulk(slice any, setFunc func(*InstitutionCreate, int)) *InstitutionCreateBulk {
+func (c *DenylistedAddressClient) MapCreateBulk(slice any, setFunc func(*DenylistedAddressCreate, int)) *DenylistedAddressCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &InstitutionCreateBulk{err: fmt.Errorf("calling to InstitutionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &DenylistedAddressCreateBulk{err: fmt.Errorf("calling to DenylistedAddressClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*InstitutionCreate, rv.Len())
+	builders := make([]*DenylistedAddressCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &InstitutionCreateBulk{config: c.config, builders: builders}
+	return &DenylistedAddressCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Institution.
-func (c *InstitutionClient) Update() *InstitutionUpdate {
-	mutation := newInstitutionMutation(c.config, OpUpdate)
-	return &InstitutionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for DenylistedAddress.
+func (c *DenylistedAddressClient) Update() *DenylistedAddressUpdate {
+	mutation := newDenylistedAddressMutation(c.config, OpUpdate)
+	return &DenylistedAddressUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *InstitutionClient) UpdateOne(i *Institution) *InstitutionUpdateOne {
-	mutation := newInstitutionMutation(c.config, OpUpdateOne, withInstitution(i))
-	return &InstitutionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DenylistedAddressClient) UpdateOne(da *DenylistedAddress) *DenylistedAddressUpdateOne {
+	mutation := newDenylistedAddressMutation(c.config, OpUpdateOne, withDenylistedAddress(da))
+	return &DenylistedAddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *InstitutionClient) UpdateOneID(id int) *InstitutionUpdateOne {
-	mutation := newInstitutionMutation(c.config, OpUpdateOne, withInstitutionID(id))
-	return &InstitutionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DenylistedAddressClient) UpdateOneID(id uuid.UUID) *DenylistedAddressUpdateOne {
+	mutation := newDenylistedAddressMutation(c.config, OpUpdateOne, withDenylistedAddressID(id))
+	return &DenylistedAddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Institution.
-func (c *InstitutionClient) Delete() *InstitutionDelete {
-	mutation := newInstitutionMutation(c.config, OpDelete)
-	return &InstitutionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for DenylistedAddress.
+func (c *DenylistedAddressClient) Delete() *DenylistedAddressDelete {
+	mutation := newDenylistedAddressMutation(c.config, OpDelete)
+	return &DenylistedAddressDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *InstitutionClient) DeleteOne(i *Institution) *InstitutionDeleteOne {
-	return c.DeleteOneID(i.ID)
+func (c *DenylistedAddressClient) DeleteOne(da *DenylistedAddress) *DenylistedAddressDeleteOne {
+	return c.DeleteOneID(da.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *InstitutionClient) DeleteOneID(id int) *InstitutionDeleteOne {
-	builder := c.Delete().Where(institution.ID(id))
+func (c *DenylistedAddressClient) DeleteOneID(id uuid.UUID) *DenylistedAddressDeleteOne {
+	builder := c.Delete().Where(denylistedaddress.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &InstitutionDeleteOne{builder}
+	return &DenylistedAddressDeleteOne{builder}
 }
 
-// Query returns a query builder for Institution.
-func (c *InstitutionClient) Query() *InstitutionQuery {
-	return &InstitutionQuery{
+// Query returns a query builder for DenylistedAddress.
+func (c *DenylistedAddressClient) Query() *DenylistedAddressQuery {
+	return &DenylistedAddressQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeInstitution},
+		ctx:    &QueryContext{Type: TypeDenylistedAddress},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Institution entity by its id.
-func (c *InstitutionClient) Get(ctx context.Context, id int) (*Institution, error) {
-	return c.Query().Where(institution.ID(id)).Only(ctx)
+// Get returns a DenylistedAddress entity by its id.
+func (c *DenylistedAddressClient) Get(ctx context.Context, id uuid.UUID) (*DenylistedAddress, error) {
+	return c.Query().Where(denylistedaddress.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *InstitutionClient) GetX(ctx context.Context, id int) *Institution {
+func (c *DenylistedAddressClient) GetX(ctx context.Context, id uuid.UUID) *DenylistedAddress {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1186,148 +1451,132 @@ func (c *InstitutionClient) GetX(ctx context.Context, id int) *Institution {
 	return obj
 }
 
-// QueryFiatCurrency queries the fiat_currency edge of a Institution.
-func (c *InstitutionClient) QueryFiatCurrency(i *Institution) *FiatCurrencyQuery {
-	query := (&FiatCurrencyClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := i.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(institution.Table, institution.FieldID, id),
-			sqlgraph.To(fiatcurrency.Table, fiatcurrency.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, institution.FiatCurrencyTable, institution.FiatCurrencyColumn),
-		)
-		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *InstitutionClient) Hooks() []Hook {
-	return c.hooks.Institution
+func (c *DenylistedAddressClient) Hooks() []Hook {
+	return c.hooks.DenylistedAddress
 }
 
 // Interceptors returns the client interceptors.
-func (c *InstitutionClient) Interceptors() []Interceptor {
-	return c.inters.Institution
+func (c *DenylistedAddressClient) Interceptors() []Interceptor {
+	return c.inters.DenylistedAddress
 }
 
-func (c *InstitutionClient) mutate(ctx context.Context, m *InstitutionMutation) (Value, error) {
+func (c *DenylistedAddressClient) mutate(ctx context.Context, m *DenylistedAddressMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&InstitutionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DenylistedAddressCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&InstitutionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DenylistedAddressUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&InstitutionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DenylistedAddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&InstitutionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&DenylistedAddressDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Institution mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown DenylistedAddress mutation op: %q", m.Op())
 	}
 }
 
-// KYBProfileClient is a client for the KYBProfile schema.
-type KYBProfileClient struct {
+// DepositSplitClient is a client for the DepositSplit schema.
+type DepositSplitClient struct {
 	config
 }
 
-// NewKYBProfileClient returns a client for the KYBProfile from the given config.
-func NewKYBProfileClient(c config) *KYBProfileClient {
-	return &KYBProfileClient{config: c}
+// NewDepositSplitClient returns a client for the DepositSplit from the given config.
+func NewDepositSplitClient(c config) *DepositSplitClient {
+	return &DepositSplitClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `kybprofile.Hooks(f(g(h())))`.
-func (c *KYBProfileClient) Use(hooks ...Hook) {
-	c.hooks.KYBProfile = append(c.hooks.KYBProfile, hooks...)
+// A call to `Use(f, g, h)` equals to `depositsplit.Hooks(f(g(h())))`.
+func (c *DepositSplitClient) Use(hooks ...Hook) {
+	c.hooks.DepositSplit = append(c.hooks.DepositSplit, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `kybprofile.Intercept(f(g(h())))`.
-func (c *KYBProfileClient) Intercept(interceptors ...Interceptor) {
-	c.inters.KYBProfile = append(c.inters.KYBProfile, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `depositsplit.Intercept(f(g(h())))`.
+func (c *DepositSplitClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DepositSplit = append(c.inters.DepositSplit, interceptors...)
 }
 
-// Create returns a builder for creating a KYBProfile entity.
-func (c *KYBProfileClient) Create() *KYBProfileCreate {
-	mutation := newKYBProfileMutation(c.config, OpCreate)
-	return &KYBProfileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a DepositSplit entity.
+func (c *DepositSplitClient) Create() *DepositSplitCreate {
+	mutation := newDepositSplitMutation(c.config, OpCreate)
+	return &DepositSplitCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of KYBProfile entities.
-func (c *KYBProfileClient) CreateBulk(builders ...*KYBProfileCreate) *KYBProfileCreateBulk {
-	return &KYBProfileCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of DepositSplit entities.
+func (c *DepositSplitClient) CreateBulk(builders ...*DepositSplitCreate) *DepositSplitCreateBulk {
+	return &DepositSplitCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *KYBProfileClient) MapCreateBulk(slice any, setFunc func(*KYBProfileCreate, int)) *KYBProfileCreateBulk {
+func (c *DepositSplitClient) MapCreateBulk(slice any, setFunc func(*DepositSplitCreate, int)) *DepositSplitCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &KYBProfileCreateBulk{err: fmt.Errorf("calling to KYBProfileClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &DepositSplitCreateBulk{err: fmt.Errorf("calling to DepositSplitClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*KYBProfileCreate, rv.Len())
+	builders := make([]*DepositSplitCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &KYBProfileCreateBulk{config: c.config, builders: builders}
+	return &DepositSplitCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for KYBProfile.
-func (c *KYBProfileClient) Update() *KYBProfileUpdate {
-	mutation := newKYBProfileMutation(c.config, OpUpdate)
-	return &KYBProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for DepositSplit.
+func (c *DepositSplitClient) Update() *DepositSplitUpdate {
+	mutation := newDepositSplitMutation(c.config, OpUpdate)
+	return &DepositSplitUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *KYBProfileClient) UpdateOne(kp *KYBProfile) *KYBProfileUpdateOne {
-	mutation := newKYBProfileMutation(c.config, OpUpdateOne, withKYBProfile(kp))
-	return &KYBProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DepositSplitClient) UpdateOne(ds *DepositSplit) *DepositSplitUpdateOne {
+	mutation := newDepositSplitMutation(c.config, OpUpdateOne, withDepositSplit(ds))
+	return &DepositSplitUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *KYBProfileClient) UpdateOneID(id uuid.UUID) *KYBProfileUpdateOne {
-	mutation := newKYBProfileMutation(c.config, OpUpdateOne, withKYBProfileID(id))
-	return &KYBProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DepositSplitClient) UpdateOneID(id uuid.UUID) *DepositSplitUpdateOne {
+	mutation := newDepositSplitMutation(c.config, OpUpdateOne, withDepositSplitID(id))
+	return &DepositSplitUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for KYBProfile.
-func (c *KYBProfileClient) Delete() *KYBProfileDelete {
-	mutation := newKYBProfileMutation(c.config, OpDelete)
-	return &KYBProfileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for DepositSplit.
+func (c *DepositSplitClient) Delete() *DepositSplitDelete {
+	mutation := newDepositSplitMutation(c.config, OpDelete)
+	return &DepositSplitDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *KYBProfileClient) DeleteOne(kp *KYBProfile) *KYBProfileDeleteOne {
-	return c.DeleteOneID(kp.ID)
+func (c *DepositSplitClient) DeleteOne(ds *DepositSplit) *DepositSplitDeleteOne {
+	return c.DeleteOneID(ds.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *KYBProfileClient) DeleteOneID(id uuid.UUID) *KYBProfileDeleteOne {
-	builder := c.Delete().Where(kybprofile.ID(id))
+func (c *DepositSplitClient) DeleteOneID(id uuid.UUID) *DepositSplitDeleteOne {
+	builder := c.Delete().Where(depositsplit.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &KYBProfileDeleteOne{builder}
+	return &DepositSplitDeleteOne{builder}
 }
 
-// Query returns a query builder for KYBProfile.
-func (c *KYBProfileClient) Query() *KYBProfileQuery {
-	return &KYBProfileQuery{
+// Query returns a query builder for DepositSplit.
+func (c *DepositSplitClient) Query() *DepositSplitQuery {
+	return &DepositSplitQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeKYBProfile},
+		ctx:    &QueryContext{Type: TypeDepositSplit},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a KYBProfile entity by its id.
-func (c *KYBProfileClient) Get(ctx context.Context, id uuid.UUID) (*KYBProfile, error) {
-	return c.Query().Where(kybprofile.ID(id)).Only(ctx)
+// Get returns a DepositSplit entity by its id.
+func (c *DepositSplitClient) Get(ctx context.Context, id uuid.UUID) (*DepositSplit, error) {
+	return c.Query().Where(depositsplit.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *KYBProfileClient) GetX(ctx context.Context, id uuid.UUID) *KYBProfile {
+func (c *DepositSplitClient) GetX(ctx context.Context, id uuid.UUID) *DepositSplit {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1335,164 +1584,148 @@ func (c *KYBProfileClient) GetX(ctx context.Context, id uuid.UUID) *KYBProfile {
 	return obj
 }
 
-// QueryBeneficialOwners queries the beneficial_owners edge of a KYBProfile.
-func (c *KYBProfileClient) QueryBeneficialOwners(kp *KYBProfile) *BeneficialOwnerQuery {
-	query := (&BeneficialOwnerClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := kp.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(kybprofile.Table, kybprofile.FieldID, id),
-			sqlgraph.To(beneficialowner.Table, beneficialowner.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, kybprofile.BeneficialOwnersTable, kybprofile.BeneficialOwnersColumn),
-		)
-		fromV = sqlgraph.Neighbors(kp.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryUser queries the user edge of a KYBProfile.
-func (c *KYBProfileClient) QueryUser(kp *KYBProfile) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryPaymentOrders queries the payment_orders edge of a DepositSplit.
+func (c *DepositSplitClient) QueryPaymentOrders(ds *DepositSplit) *PaymentOrderQuery {
+	query := (&PaymentOrderClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := kp.ID
+		id := ds.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(kybprofile.Table, kybprofile.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, kybprofile.UserTable, kybprofile.UserColumn),
+			sqlgraph.From(depositsplit.Table, depositsplit.FieldID, id),
+			sqlgraph.To(paymentorder.Table, paymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, depositsplit.PaymentOrdersTable, depositsplit.PaymentOrdersColumn),
 		)
-		fromV = sqlgraph.Neighbors(kp.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(ds.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *KYBProfileClient) Hooks() []Hook {
-	return c.hooks.KYBProfile
+func (c *DepositSplitClient) Hooks() []Hook {
+	return c.hooks.DepositSplit
 }
 
 // Interceptors returns the client interceptors.
-func (c *KYBProfileClient) Interceptors() []Interceptor {
-	return c.inters.KYBProfile
+func (c *DepositSplitClient) Interceptors() []Interceptor {
+	return c.inters.DepositSplit
 }
 
-func (c *KYBProfileClient) mutate(ctx context.Context, m *KYBProfileMutation) (Value, error) {
+func (c *DepositSplitClient) mutate(ctx context.Context, m *DepositSplitMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&KYBProfileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DepositSplitCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&KYBProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DepositSplitUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&KYBProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DepositSplitUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&KYBProfileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&DepositSplitDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown KYBProfile mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown DepositSplit mutation op: %q", m.Op())
 	}
 }
 
-// LinkedAddressClient is a client for the LinkedAddress schema.
-type LinkedAddressClient struct {
+// FeeScheduleClient is a client for the FeeSchedule schema.
+type FeeScheduleClient struct {
 	config
 }
 
-// NewLinkedAddressClient returns a client for the LinkedAddress from the given config.
-func NewLinkedAddressClient(c config) *LinkedAddressClient {
-	return &LinkedAddressClient{config: c}
+// NewFeeScheduleClient returns a client for the FeeSchedule from the given config.
+func NewFeeScheduleClient(c config) *FeeScheduleClient {
+	return &FeeScheduleClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `linkedaddress.Hooks(f(g(h())))`.
-func (c *LinkedAddressClient) Use(hooks ...Hook) {
-	c.hooks.LinkedAddress = append(c.hooks.LinkedAddress, hooks...)
+// A call to `Use(f, g, h)` equals to `feeschedule.Hooks(f(g(h())))`.
+func (c *FeeScheduleClient) Use(hooks ...Hook) {
+	c.hooks.FeeSchedule = append(c.hooks.FeeSchedule, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `linkedaddress.Intercept(f(g(h())))`.
-func (c *LinkedAddressClient) Intercept(interceptors ...Interceptor) {
-	c.inters.LinkedAddress = append(c.inters.LinkedAddress, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `feeschedule.Intercept(f(g(h())))`.
+func (c *FeeScheduleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FeeSchedule = append(c.inters.FeeSchedule, interceptors...)
 }
 
-// Create returns a builder for creating a LinkedAddress entity.
-func (c *LinkedAddressClient) Create() *LinkedAddressCreate {
-	mutation := newLinkedAddressMutation(c.config, OpCreate)
-	return &LinkedAddressCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a FeeSchedule entity.
+func (c *FeeScheduleClient) Create() *FeeScheduleCreate {
+	mutation := newFeeScheduleMutation(c.config, OpCreate)
+	return &FeeScheduleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of LinkedAddress entities.
-func (c *LinkedAddressClient) CreateBulk(builders ...*LinkedAddressCreate) *LinkedAddressCreateBulk {
-	return &LinkedAddressCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of FeeSchedule entities.
+func (c *FeeScheduleClient) CreateBulk(builders ...*FeeScheduleCreate) *FeeScheduleCreateBulk {
+	return &FeeScheduleCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *LinkedAddressClient) MapCreateBulk(slice any, setFunc func(*LinkedAddressCreate, int)) *LinkedAddressCreateBulk {
+func (c *FeeScheduleClient) MapCreateBulk(slice any, setFunc func(*FeeScheduleCreate, int)) *FeeScheduleCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &LinkedAddressCreateBulk{err: fmt.Errorf("calling to LinkedAddressClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &FeeScheduleCreateBulk{err: fmt.Errorf("calling to FeeScheduleClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*LinkedAddressCreate, rv.Len())
+	builders := make([]*FeeScheduleCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &LinkedAddressCreateBulk{config: c.config, builders: builders}
+	return &FeeScheduleCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for LinkedAddress.
-func (c *LinkedAddressClient) Update() *LinkedAddressUpdate {
-	mutation := newLinkedAddressMutation(c.config, OpUpdate)
-	return &LinkedAddressUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for FeeSchedule.
+func (c *FeeScheduleClient) Update() *FeeScheduleUpdate {
+	mutation := newFeeScheduleMutation(c.config, OpUpdate)
+	return &FeeScheduleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *LinkedAddressClient) UpdateOne(la *LinkedAddress) *LinkedAddressUpdateOne {
-	mutation := newLinkedAddressMutation(c.config, OpUpdateOne, withLinkedAddress(la))
-	return &LinkedAddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *FeeScheduleClient) UpdateOne(fs *FeeSchedule) *FeeScheduleUpdateOne {
+	mutation := newFeeScheduleMutation(c.config, OpUpdateOne, withFeeSchedule(fs))
+	return &FeeScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *LinkedAddressClient) UpdateOneID(id int) *LinkedAddressUpdateOne {
-	mutation := newLinkedAddressMutation(c.config, OpUpdateOne, withLinkedAddressID(id))
-	return &LinkedAddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *FeeScheduleClient) UpdateOneID(id uuid.UUID) *FeeScheduleUpdateOne {
+	mutation := newFeeScheduleMutation(c.config, OpUpdateOne, withFeeScheduleID(id))
+	return &FeeScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for LinkedAddress.
-func (c *LinkedAddressClient) Delete() *LinkedAddressDelete {
-	mutation := newLinkedAddressMutation(c.config, OpDelete)
-	return &LinkedAddressDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for FeeSchedule.
+func (c *FeeScheduleClient) Delete() *FeeScheduleDelete {
+	mutation := newFeeScheduleMutation(c.config, OpDelete)
+	return &FeeScheduleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *LinkedAddressClient) DeleteOne(la *LinkedAddress) *LinkedAddressDeleteOne {
-	return c.DeleteOneID(la.ID)
+func (c *FeeScheduleClient) DeleteOne(fs *FeeSchedule) *FeeScheduleDeleteOne {
+	return c.DeleteOneID(fs.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *LinkedAddressClient) DeleteOneID(id int) *LinkedAddressDeleteOne {
-	builder := c.Delete().Where(linkedaddress.ID(id))
+func (c *FeeScheduleClient) DeleteOneID(id uuid.UUID) *FeeScheduleDeleteOne {
+	builder := c.Delete().Where(feeschedule.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &LinkedAddressDeleteOne{builder}
+	return &FeeScheduleDeleteOne{builder}
 }
 
-// Query returns a query builder for LinkedAddress.
-func (c *LinkedAddressClient) Query() *LinkedAddressQuery {
-	return &LinkedAddressQuery{
+// Query returns a query builder for FeeSchedule.
+func (c *FeeScheduleClient) Query() *FeeScheduleQuery {
+	return &FeeScheduleQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeLinkedAddress},
+		ctx:    &QueryContext{Type: TypeFeeSchedule},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a LinkedAddress entity by its id.
-func (c *LinkedAddressClient) Get(ctx context.Context, id int) (*LinkedAddress, error) {
-	return c.Query().Where(linkedaddress.ID(id)).Only(ctx)
+// Get returns a FeeSchedule entity by its id.
+func (c *FeeScheduleClient) Get(ctx context.Context, id uuid.UUID) (*FeeSchedule, error) {
+	return c.Query().Where(feeschedule.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *LinkedAddressClient) GetX(ctx context.Context, id int) *LinkedAddress {
+func (c *FeeScheduleClient) GetX(ctx context.Context, id uuid.UUID) *FeeSchedule {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1500,148 +1733,148 @@ func (c *LinkedAddressClient) GetX(ctx context.Context, id int) *LinkedAddress {
 	return obj
 }
 
-// QueryPaymentOrders queries the payment_orders edge of a LinkedAddress.
-func (c *LinkedAddressClient) QueryPaymentOrders(la *LinkedAddress) *PaymentOrderQuery {
-	query := (&PaymentOrderClient{config: c.config}).Query()
+// QuerySenderProfile queries the sender_profile edge of a FeeSchedule.
+func (c *FeeScheduleClient) QuerySenderProfile(fs *FeeSchedule) *SenderProfileQuery {
+	query := (&SenderProfileClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := la.ID
+		id := fs.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(linkedaddress.Table, linkedaddress.FieldID, id),
-			sqlgraph.To(paymentorder.Table, paymentorder.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, linkedaddress.PaymentOrdersTable, linkedaddress.PaymentOrdersColumn),
+			sqlgraph.From(feeschedule.Table, feeschedule.FieldID, id),
+			sqlgraph.To(senderprofile.Table, senderprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, feeschedule.SenderProfileTable, feeschedule.SenderProfileColumn),
 		)
-		fromV = sqlgraph.Neighbors(la.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(fs.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *LinkedAddressClient) Hooks() []Hook {
-	return c.hooks.LinkedAddress
+func (c *FeeScheduleClient) Hooks() []Hook {
+	return c.hooks.FeeSchedule
 }
 
 // Interceptors returns the client interceptors.
-func (c *LinkedAddressClient) Interceptors() []Interceptor {
-	return c.inters.LinkedAddress
+func (c *FeeScheduleClient) Interceptors() []Interceptor {
+	return c.inters.FeeSchedule
 }
 
-func (c *LinkedAddressClient) mutate(ctx context.Context, m *LinkedAddressMutation) (Value, error) {
+func (c *FeeScheduleClient) mutate(ctx context.Context, m *FeeScheduleMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&LinkedAddressCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&FeeScheduleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&LinkedAddressUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&FeeScheduleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&LinkedAddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&FeeScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&LinkedAddressDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&FeeScheduleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown LinkedAddress mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown FeeSchedule mutation op: %q", m.Op())
 	}
 }
 
-// LockOrderFulfillmentClient is a client for the LockOrderFulfillment schema.
-type LockOrderFulfillmentClient struct {
+// FiatCurrencyClient is a client for the FiatCurrency schema.
+type FiatCurrencyClient struct {
 	config
 }
 
-// NewLockOrderFulfillmentClient returns a client for the LockOrderFulfillment from the given config.
-func NewLockOrderFulfillmentClient(c config) *LockOrderFulfillmentClient {
-	return &LockOrderFulfillmentClient{config: c}
+// NewFiatCurrencyClient returns a client for the FiatCurrency from the given config.
+func NewFiatCurrencyClient(c config) *FiatCurrencyClient {
+	return &FiatCurrencyClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `lockorderfulfillment.Hooks(f(g(h())))`.
-func (c *LockOrderFulfillmentClient) Use(hooks ...Hook) {
-	c.hooks.LockOrderFulfillment = append(c.hooks.LockOrderFulfillment, hooks...)
+// A call to `Use(f, g, h)` equals to `fiatcurrency.Hooks(f(g(h())))`.
+func (c *FiatCurrencyClient) Use(hooks ...Hook) {
+	c.hooks.FiatCurrency = append(c.hooks.FiatCurrency, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `lockorderfulfillment.Intercept(f(g(h())))`.
-func (c *LockOrderFulfillmentClient) Intercept(interceptors ...Interceptor) {
-	c.inters.LockOrderFulfillment = append(c.inters.LockOrderFulfillment, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `fiatcurrency.Intercept(f(g(h())))`.
+func (c *FiatCurrencyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FiatCurrency = append(c.inters.FiatCurrency, interceptors...)
 }
 
-// Create returns a builder for creating a LockOrderFulfillment entity.
-func (c *LockOrderFulfillmentClient) Create() *LockOrderFulfillmentCreate {
-	mutation := newLockOrderFulfillmentMutation(c.config, OpCreate)
-	return &LockOrderFulfillmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a FiatCurrency entity.
+func (c *FiatCurrencyClient) Create() *FiatCurrencyCreate {
+	mutation := newFiatCurrencyMutation(c.config, OpCreate)
+	return &FiatCurrencyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of LockOrderFulfillment entities.
-func (c *LockOrderFulfillmentClient) CreateBulk(builders ...*LockOrderFulfillmentCreate) *LockOrderFulfillmentCreateBulk {
-	return &LockOrderFulfillmentCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of FiatCurrency entities.
+func (c *FiatCurrencyClient) CreateBulk(builders ...*FiatCurrencyCreate) *FiatCurrencyCreateBulk {
+	return &FiatCurrencyCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *LockOrderFulfillmentClient) MapCreateBulk(slice any, setFunc func(*LockOrderFulfillmentCreate, int)) *LockOrderFulfillmentCreateBulk {
+func (c *FiatCurrencyClient) MapCreateBulk(slice any, setFunc func(*FiatCurrencyCreate, int)) *FiatCurrencyCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &LockOrderFulfillmentCreateBulk{err: fmt.Errorf("calling to LockOrderFulfillmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &FiatCurrencyCreateBulk{err: fmt.Errorf("calling to FiatCurrencyClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*LockOrderFulfillmentCreate, rv.Len())
+	builders := make([]*FiatCurrencyCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &LockOrderFulfillmentCreateBulk{config: c.config, builders: builders}
+	return &FiatCurrencyCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for LockOrderFulfillment.
-func (c *LockOrderFulfillmentClient) Update() *LockOrderFulfillmentUpdate {
-	mutation := newLockOrderFulfillmentMutation(c.config, OpUpdate)
-	return &LockOrderFulfillmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for FiatCurrency.
+func (c *FiatCurrencyClient) Update() *FiatCurrencyUpdate {
+	mutation := newFiatCurrencyMutation(c.config, OpUpdate)
+	return &FiatCurrencyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *LockOrderFulfillmentClient) UpdateOne(lof *LockOrderFulfillment) *LockOrderFulfillmentUpdateOne {
-	mutation := newLockOrderFulfillmentMutation(c.config, OpUpdateOne, withLockOrderFulfillment(lof))
-	return &LockOrderFulfillmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *FiatCurrencyClient) UpdateOne(fc *FiatCurrency) *FiatCurrencyUpdateOne {
+	mutation := newFiatCurrencyMutation(c.config, OpUpdateOne, withFiatCurrency(fc))
+	return &FiatCurrencyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *LockOrderFulfillmentClient) UpdateOneID(id uuid.UUID) *LockOrderFulfillmentUpdateOne {
-	mutation := newLockOrderFulfillmentMutation(c.config, OpUpdateOne, withLockOrderFulfillmentID(id))
-	return &LockOrderFulfillmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *FiatCurrencyClient) UpdateOneID(id uuid.UUID) *FiatCurrencyUpdateOne {
+	mutation := newFiatCurrencyMutation(c.config, OpUpdateOne, withFiatCurrencyID(id))
+	return &FiatCurrencyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for LockOrderFulfillment.
-func (c *LockOrderFulfillmentClient) Delete() *LockOrderFulfillmentDelete {
-	mutation := newLockOrderFulfillmentMutation(c.config, OpDelete)
-	return &LockOrderFulfillmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for FiatCurrency.
+func (c *FiatCurrencyClient) Delete() *FiatCurrencyDelete {
+	mutation := newFiatCurrencyMutation(c.config, OpDelete)
+	return &FiatCurrencyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *LockOrderFulfillmentClient) DeleteOne(lof *LockOrderFulfillment) *LockOrderFulfillmentDeleteOne {
-	return c.DeleteOneID(lof.ID)
+func (c *FiatCurrencyClient) DeleteOne(fc *FiatCurrency) *FiatCurrencyDeleteOne {
+	return c.DeleteOneID(fc.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *LockOrderFulfillmentClient) DeleteOneID(id uuid.UUID) *LockOrderFulfillmentDeleteOne {
-	builder := c.Delete().Where(lockorderfulfillment.ID(id))
+func (c *FiatCurrencyClient) DeleteOneID(id uuid.UUID) *FiatCurrencyDeleteOne {
+	builder := c.Delete().Where(fiatcurrency.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &LockOrderFulfillmentDeleteOne{builder}
+	return &FiatCurrencyDeleteOne{builder}
 }
 
-// Query returns a query builder for LockOrderFulfillment.
-func (c *LockOrderFulfillmentClient) Query() *LockOrderFulfillmentQuery {
-	return &LockOrderFulfillmentQuery{
+// Query returns a query builder for FiatCurrency.
+func (c *FiatCurrencyClient) Query() *FiatCurrencyQuery {
+	return &FiatCurrencyQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeLockOrderFulfillment},
+		ctx:    &QueryContext{Type: TypeFiatCurrency},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a LockOrderFulfillment entity by its id.
-func (c *LockOrderFulfillmentClient) Get(ctx context.Context, id uuid.UUID) (*LockOrderFulfillment, error) {
-	return c.Query().Where(lockorderfulfillment.ID(id)).Only(ctx)
+// Get returns a FiatCurrency entity by its id.
+func (c *FiatCurrencyClient) Get(ctx context.Context, id uuid.UUID) (*FiatCurrency, error) {
+	return c.Query().Where(fiatcurrency.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *LockOrderFulfillmentClient) GetX(ctx context.Context, id uuid.UUID) *LockOrderFulfillment {
+func (c *FiatCurrencyClient) GetX(ctx context.Context, id uuid.UUID) *FiatCurrency {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1649,148 +1882,196 @@ func (c *LockOrderFulfillmentClient) GetX(ctx context.Context, id uuid.UUID) *Lo
 	return obj
 }
 
-// QueryOrder queries the order edge of a LockOrderFulfillment.
-func (c *LockOrderFulfillmentClient) QueryOrder(lof *LockOrderFulfillment) *LockPaymentOrderQuery {
-	query := (&LockPaymentOrderClient{config: c.config}).Query()
+// QueryProviderCurrencies queries the provider_currencies edge of a FiatCurrency.
+func (c *FiatCurrencyClient) QueryProviderCurrencies(fc *FiatCurrency) *ProviderCurrenciesQuery {
+	query := (&ProviderCurrenciesClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := lof.ID
+		id := fc.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(lockorderfulfillment.Table, lockorderfulfillment.FieldID, id),
-			sqlgraph.To(lockpaymentorder.Table, lockpaymentorder.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, lockorderfulfillment.OrderTable, lockorderfulfillment.OrderColumn),
+			sqlgraph.From(fiatcurrency.Table, fiatcurrency.FieldID, id),
+			sqlgraph.To(providercurrencies.Table, providercurrencies.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, fiatcurrency.ProviderCurrenciesTable, fiatcurrency.ProviderCurrenciesColumn),
 		)
-		fromV = sqlgraph.Neighbors(lof.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(fc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProvisionBuckets queries the provision_buckets edge of a FiatCurrency.
+func (c *FiatCurrencyClient) QueryProvisionBuckets(fc *FiatCurrency) *ProvisionBucketQuery {
+	query := (&ProvisionBucketClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := fc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(fiatcurrency.Table, fiatcurrency.FieldID, id),
+			sqlgraph.To(provisionbucket.Table, provisionbucket.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, fiatcurrency.ProvisionBucketsTable, fiatcurrency.ProvisionBucketsColumn),
+		)
+		fromV = sqlgraph.Neighbors(fc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInstitutions queries the institutions edge of a FiatCurrency.
+func (c *FiatCurrencyClient) QueryInstitutions(fc *FiatCurrency) *InstitutionQuery {
+	query := (&InstitutionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := fc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(fiatcurrency.Table, fiatcurrency.FieldID, id),
+			sqlgraph.To(institution.Table, institution.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, fiatcurrency.InstitutionsTable, fiatcurrency.InstitutionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(fc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProviderOrderTokens queries the provider_order_tokens edge of a FiatCurrency.
+func (c *FiatCurrencyClient) QueryProviderOrderTokens(fc *FiatCurrency) *ProviderOrderTokenQuery {
+	query := (&ProviderOrderTokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := fc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(fiatcurrency.Table, fiatcurrency.FieldID, id),
+			sqlgraph.To(providerordertoken.Table, providerordertoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, fiatcurrency.ProviderOrderTokensTable, fiatcurrency.ProviderOrderTokensColumn),
+		)
+		fromV = sqlgraph.Neighbors(fc.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *LockOrderFulfillmentClient) Hooks() []Hook {
-	return c.hooks.LockOrderFulfillment
+func (c *FiatCurrencyClient) Hooks() []Hook {
+	return c.hooks.FiatCurrency
 }
 
 // Interceptors returns the client interceptors.
-func (c *LockOrderFulfillmentClient) Interceptors() []Interceptor {
-	return c.inters.LockOrderFulfillment
+func (c *FiatCurrencyClient) Interceptors() []Interceptor {
+	return c.inters.FiatCurrency
 }
 
-func (c *LockOrderFulfillmentClient) mutate(ctx context.Context, m *LockOrderFulfillmentMutation) (Value, error) {
+func (c *FiatCurrencyClient) mutate(ctx context.Context, m *FiatCurrencyMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&LockOrderFulfillmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&FiatCurrencyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&LockOrderFulfillmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&FiatCurrencyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&LockOrderFulfillmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&FiatCurrencyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&LockOrderFulfillmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&FiatCurrencyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown LockOrderFulfillment mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown FiatCurrency mutation op: %q", m.Op())
 	}
 }
 
-// LockPaymentOrderClient is a client for the LockPaymentOrder schema.
-type LockPaymentOrderClient struct {
+// IdentityVerificationRequestClient is a client for the IdentityVerificationRequest schema.
+type IdentityVerificationRequestClient struct {
 	config
 }
 
-// NewLockPaymentOrderClient returns a client for the LockPaymentOrder from the given config.
-func NewLockPaymentOrderClient(c config) *LockPaymentOrderClient {
-	return &LockPaymentOrderClient{config: c}
+// NewIdentityVerificationRequestClient returns a client for the IdentityVerificationRequest from the given config.
+func NewIdentityVerificationRequestClient(c config) *IdentityVerificationRequestClient {
+	return &IdentityVerificationRequestClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `lockpaymentorder.Hooks(f(g(h())))`.
-func (c *LockPaymentOrderClient) Use(hooks ...Hook) {
-	c.hooks.LockPaymentOrder = append(c.hooks.LockPaymentOrder, hooks...)
+// A call to `Use(f, g, h)` equals to `identityverificationrequest.Hooks(f(g(h())))`.
+func (c *IdentityVerificationRequestClient) Use(hooks ...Hook) {
+	c.hooks.IdentityVerificationRequest = append(c.hooks.IdentityVerificationRequest, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `lockpaymentorder.Intercept(f(g(h())))`.
-func (c *LockPaymentOrderClient) Intercept(interceptors ...Interceptor) {
-	c.inters.LockPaymentOrder = append(c.inters.LockPaymentOrder, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `identityverificationrequest.Intercept(f(g(h())))`.
+func (c *IdentityVerificationRequestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.IdentityVerificationRequest = append(c.inters.IdentityVerificationRequest, interceptors...)
 }
 
-// Create returns a builder for creating a LockPaymentOrder entity.
-func (c *LockPaymentOrderClient) Create() *LockPaymentOrderCreate {
-	mutation := newLockPaymentOrderMutation(c.config, OpCreate)
-	return &LockPaymentOrderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a IdentityVerificationRequest entity.
+func (c *IdentityVerificationRequestClient) Create() *IdentityVerificationRequestCreate {
+	mutation := newIdentityVerificationRequestMutation(c.config, OpCreate)
+	return &IdentityVerificationRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of LockPaymentOrder entities.
-func (c *LockPaymentOrderClient) CreateBulk(builders ...*LockPaymentOrderCreate) *LockPaymentOrderCreateBulk {
-	return &LockPaymentOrderCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of IdentityVerificationRequest entities.
+func (c *IdentityVerificationRequestClient) CreateBulk(builders ...*IdentityVerificationRequestCreate) *IdentityVerificationRequestCreateBulk {
+	return &IdentityVerificationRequestCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *LockPaymentOrderClient) MapCreateBulk(slice any, setFunc func(*LockPaymentOrderCreate, int)) *LockPaymentOrderCreateBulk {
+func (c *IdentityVerificationRequestClient) MapCreateBulk(slice any, setFunc func(*IdentityVerificationRequestCreate, int)) *IdentityVerificationRequestCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &LockPaymentOrderCreateBulk{err: fmt.Errorf("calling to LockPaymentOrderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &IdentityVerificationRequestCreateBulk{err: fmt.Errorf("calling to IdentityVerificationRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*LockPaymentOrderCreate, rv.Len())
+	builders := make([]*IdentityVerificationRequestCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &LockPaymentOrderCreateBulk{config: c.config, builders: builders}
+	return &IdentityVerificationRequestCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for LockPaymentOrder.
-func (c *LockPaymentOrderClient) Update() *LockPaymentOrderUpdate {
-	mutation := newLockPaymentOrderMutation(c.config, OpUpdate)
-	return &LockPaymentOrderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for IdentityVerificationRequest.
+func (c *IdentityVerificationRequestClient) Update() *IdentityVerificationRequestUpdate {
+	mutation := newIdentityVerificationRequestMutation(c.config, OpUpdate)
+	return &IdentityVerificationRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *LockPaymentOrderClient) UpdateOne(lpo *LockPaymentOrder) *LockPaymentOrderUpdateOne {
-	mutation := newLockPaymentOrderMutation(c.config, OpUpdateOne, withLockPaymentOrder(lpo))
-	return &LockPaymentOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *IdentityVerificationRequestClient) UpdateOne(ivr *IdentityVerificationRequest) *IdentityVerificationRequestUpdateOne {
+	mutation := newIdentityVerificationRequestMutation(c.config, OpUpdateOne, withIdentityVerificationRequest(ivr))
+	return &IdentityVerificationRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *LockPaymentOrderClient) UpdateOneID(id uuid.UUID) *LockPaymentOrderUpdateOne {
-	mutation := newLockPaymentOrderMutation(c.config, OpUpdateOne, withLockPaymentOrderID(id))
-	return &LockPaymentOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *IdentityVerificationRequestClient) UpdateOneID(id uuid.UUID) *IdentityVerificationRequestUpdateOne {
+	mutation := newIdentityVerificationRequestMutation(c.config, OpUpdateOne, withIdentityVerificationRequestID(id))
+	return &IdentityVerificationRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for LockPaymentOrder.
-func (c *LockPaymentOrderClient) Delete() *LockPaymentOrderDelete {
-	mutation := newLockPaymentOrderMutation(c.config, OpDelete)
-	return &LockPaymentOrderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for IdentityVerificationRequest.
+func (c *IdentityVerificationRequestClient) Delete() *IdentityVerificationRequestDelete {
+	mutation := newIdentityVerificationRequestMutation(c.config, OpDelete)
+	return &IdentityVerificationRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *LockPaymentOrderClient) DeleteOne(lpo *LockPaymentOrder) *LockPaymentOrderDeleteOne {
-	return c.DeleteOneID(lpo.ID)
+func (c *IdentityVerificationRequestClient) DeleteOne(ivr *IdentityVerificationRequest) *IdentityVerificationRequestDeleteOne {
+	return c.DeleteOneID(ivr.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *LockPaymentOrderClient) DeleteOneID(id uuid.UUID) *LockPaymentOrderDeleteOne {
-	builder := c.Delete().Where(lockpaymentorder.ID(id))
+func (c *IdentityVerificationRequestClient) DeleteOneID(id uuid.UUID) *IdentityVerificationRequestDeleteOne {
+	builder := c.Delete().Where(identityverificationrequest.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &LockPaymentOrderDeleteOne{builder}
+	return &IdentityVerificationRequestDeleteOne{builder}
 }
 
-// Query returns a query builder for LockPaymentOrder.
-func (c *LockPaymentOrderClient) Query() *LockPaymentOrderQuery {
-	return &LockPaymentOrderQuery{
+// Query returns a query builder for IdentityVerificationRequest.
+func (c *IdentityVerificationRequestClient) Query() *IdentityVerificationRequestQuery {
+	return &IdentityVerificationRequestQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeLockPaymentOrder},
+		ctx:    &QueryContext{Type: TypeIdentityVerificationRequest},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a LockPaymentOrder entity by its id.
-func (c *LockPaymentOrderClient) Get(ctx context.Context, id uuid.UUID) (*LockPaymentOrder, error) {
-	return c.Query().Where(lockpaymentorder.ID(id)).Only(ctx)
+// Get returns a IdentityVerificationRequest entity by its id.
+func (c *IdentityVerificationRequestClient) Get(ctx context.Context, id uuid.UUID) (*IdentityVerificationRequest, error) {
+	return c.Query().Where(identityverificationrequest.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *LockPaymentOrderClient) GetX(ctx context.Context, id uuid.UUID) *LockPaymentOrder {
+func (c *IdentityVerificationRequestClient) GetX(ctx context.Context, id uuid.UUID) *IdentityVerificationRequest {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1798,212 +2079,132 @@ func (c *LockPaymentOrderClient) GetX(ctx context.Context, id uuid.UUID) *LockPa
 	return obj
 }
 
-// QueryToken queries the token edge of a LockPaymentOrder.
-func (c *LockPaymentOrderClient) QueryToken(lpo *LockPaymentOrder) *TokenQuery {
-	query := (&TokenClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := lpo.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(lockpaymentorder.Table, lockpaymentorder.FieldID, id),
-			sqlgraph.To(token.Table, token.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, lockpaymentorder.TokenTable, lockpaymentorder.TokenColumn),
-		)
-		fromV = sqlgraph.Neighbors(lpo.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryProvisionBucket queries the provision_bucket edge of a LockPaymentOrder.
-func (c *LockPaymentOrderClient) QueryProvisionBucket(lpo *LockPaymentOrder) *ProvisionBucketQuery {
-	query := (&ProvisionBucketClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := lpo.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(lockpaymentorder.Table, lockpaymentorder.FieldID, id),
-			sqlgraph.To(provisionbucket.Table, provisionbucket.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, lockpaymentorder.ProvisionBucketTable, lockpaymentorder.ProvisionBucketColumn),
-		)
-		fromV = sqlgraph.Neighbors(lpo.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryProvider queries the provider edge of a LockPaymentOrder.
-func (c *LockPaymentOrderClient) QueryProvider(lpo *LockPaymentOrder) *ProviderProfileQuery {
-	query := (&ProviderProfileClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := lpo.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(lockpaymentorder.Table, lockpaymentorder.FieldID, id),
-			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, lockpaymentorder.ProviderTable, lockpaymentorder.ProviderColumn),
-		)
-		fromV = sqlgraph.Neighbors(lpo.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryFulfillments queries the fulfillments edge of a LockPaymentOrder.
-func (c *LockPaymentOrderClient) QueryFulfillments(lpo *LockPaymentOrder) *LockOrderFulfillmentQuery {
-	query := (&LockOrderFulfillmentClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := lpo.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(lockpaymentorder.Table, lockpaymentorder.FieldID, id),
-			sqlgraph.To(lockorderfulfillment.Table, lockorderfulfillment.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, lockpaymentorder.FulfillmentsTable, lockpaymentorder.FulfillmentsColumn),
-		)
-		fromV = sqlgraph.Neighbors(lpo.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryTransactions queries the transactions edge of a LockPaymentOrder.
-func (c *LockPaymentOrderClient) QueryTransactions(lpo *LockPaymentOrder) *TransactionLogQuery {
-	query := (&TransactionLogClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := lpo.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(lockpaymentorder.Table, lockpaymentorder.FieldID, id),
-			sqlgraph.To(transactionlog.Table, transactionlog.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, lockpaymentorder.TransactionsTable, lockpaymentorder.TransactionsColumn),
-		)
-		fromV = sqlgraph.Neighbors(lpo.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *LockPaymentOrderClient) Hooks() []Hook {
-	return c.hooks.LockPaymentOrder
+func (c *IdentityVerificationRequestClient) Hooks() []Hook {
+	return c.hooks.IdentityVerificationRequest
 }
 
 // Interceptors returns the client interceptors.
-func (c *LockPaymentOrderClient) Interceptors() []Interceptor {
-	return c.inters.LockPaymentOrder
+func (c *IdentityVerificationRequestClient) Interceptors() []Interceptor {
+	return c.inters.IdentityVerificationRequest
 }
 
-func (c *LockPaymentOrderClient) mutate(ctx context.Context, m *LockPaymentOrderMutation) (Value, error) {
+func (c *IdentityVerificationRequestClient) mutate(ctx context.Context, m *IdentityVerificationRequestMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&LockPaymentOrderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&IdentityVerificationRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&LockPaymentOrderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&IdentityVerificationRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&LockPaymentOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&IdentityVerificationRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&LockPaymentOrderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&IdentityVerificationRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown LockPaymentOrder mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown IdentityVerificationRequest mutation op: %q", m.Op())
 	}
 }
 
-// NetworkClient is a client for the Network schema.
-type NetworkClient struct {
+// InstitutionClient is a client for the Institution schema.
+type InstitutionClient struct {
 	config
 }
 
-// NewNetworkClient returns a client for the Network from the given config.
-func NewNetworkClient(c config) *NetworkClient {
-	return &NetworkClient{config: c}
+// NewInstitutionClient returns a client for the Institution from the given config.
+func NewInstitutionClient(c config) *InstitutionClient {
+	return &InstitutionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `network.Hooks(f(g(h())))`.
-func (c *NetworkClient) Use(hooks ...Hook) {
-	c.hooks.Network = append(c.hooks.Network, hooks...)
+// A call to `Use(f, g, h)` equals to `institution.Hooks(f(g(h())))`.
+func (c *InstitutionClient) Use(hooks ...Hook) {
+	c.hooks.Institution = append(c.hooks.Institution, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `network.Intercept(f(g(h())))`.
-func (c *NetworkClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Network = append(c.inters.Network, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `institution.Intercept(f(g(h())))`.
+func (c *InstitutionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Institution = append(c.inters.Institution, interceptors...)
 }
 
-// Create returns a builder for creating a Network entity.
-func (c *NetworkClient) Create() *NetworkCreate {
-	mutation := newNetworkMutation(c.config, OpCreate)
-	return &NetworkCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Institution entity.
+func (c *InstitutionClient) Create() *InstitutionCreate {
+	mutation := newInstitutionMutation(c.config, OpCreate)
+	return &InstitutionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Network entities.
-func (c *NetworkClient) CreateBulk(builders ...*NetworkCreate) *NetworkCreateBulk {
-	return &NetworkCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Institution entities.
+func (c *InstitutionClient) CreateBulk(builders ...*InstitutionCreate) *InstitutionCreateBulk {
+	return &InstitutionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *NetworkClient) MapCreateBulk(slice any, setFunc func(*NetworkCreate, int)) *NetworkCreateBulk {
+func (c *InstitutionClient) MapCreateBulk(slice any, setFunc func(*InstitutionCreate, int)) *InstitutionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &NetworkCreateBulk{err: fmt.Errorf("calling to NetworkClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &InstitutionCreateBulk{err: fmt.Errorf("calling to InstitutionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*NetworkCreate, rv.Len())
+	builders := make([]*InstitutionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &NetworkCreateBulk{config: c.config, builders: builders}
+	return &InstitutionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Network.
-func (c *NetworkClient) Update() *NetworkUpdate {
-	mutation := newNetworkMutation(c.config, OpUpdate)
-	return &NetworkUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Institution.
+func (c *InstitutionClient) Update() *InstitutionUpdate {
+	mutation := newInstitutionMutation(c.config, OpUpdate)
+	return &InstitutionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *NetworkClient) UpdateOne(n *Network) *NetworkUpdateOne {
-	mutation := newNetworkMutation(c.config, OpUpdateOne, withNetwork(n))
-	return &NetworkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *InstitutionClient) UpdateOne(i *Institution) *InstitutionUpdateOne {
+	mutation := newInstitutionMutation(c.config, OpUpdateOne, withInstitution(i))
+	return &InstitutionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *NetworkClient) UpdateOneID(id int) *NetworkUpdateOne {
-	mutation := newNetworkMutation(c.config, OpUpdateOne, withNetworkID(id))
-	return &NetworkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *InstitutionClient) UpdateOneID(id int) *InstitutionUpdateOne {
+	mutation := newInstitutionMutation(c.config, OpUpdateOne, withInstitutionID(id))
+	return &InstitutionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Network.
-func (c *NetworkClient) Delete() *NetworkDelete {
-	mutation := newNetworkMutation(c.config, OpDelete)
-	return &NetworkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Institution.
+func (c *InstitutionClient) Delete() *InstitutionDelete {
+	mutation := newInstitutionMutation(c.config, OpDelete)
+	return &InstitutionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *NetworkClient) DeleteOne(n *Network) *NetworkDeleteOne {
-	return c.DeleteOneID(n.ID)
+func (c *InstitutionClient) DeleteOne(i *Institution) *InstitutionDeleteOne {
+	return c.DeleteOneID(i.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *NetworkClient) DeleteOneID(id int) *NetworkDeleteOne {
-	builder := c.Delete().Where(network.ID(id))
+func (c *InstitutionClient) DeleteOneID(id int) *InstitutionDeleteOne {
+	builder := c.Delete().Where(institution.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &NetworkDeleteOne{builder}
+	return &InstitutionDeleteOne{builder}
 }
 
-// Query returns a query builder for Network.
-func (c *NetworkClient) Query() *NetworkQuery {
-	return &NetworkQuery{
+// Query returns a query builder for Institution.
+func (c *InstitutionClient) Query() *InstitutionQuery {
+	return &InstitutionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeNetwork},
+		ctx:    &QueryContext{Type: TypeInstitution},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Network entity by its id.
-func (c *NetworkClient) Get(ctx context.Context, id int) (*Network, error) {
-	return c.Query().Where(network.ID(id)).Only(ctx)
+// Get returns a Institution entity by its id.
+func (c *InstitutionClient) Get(ctx context.Context, id int) (*Institution, error) {
+	return c.Query().Where(institution.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *NetworkClient) GetX(ctx context.Context, id int) *Network {
+func (c *InstitutionClient) GetX(ctx context.Context, id int) *Institution {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2011,164 +2212,148 @@ func (c *NetworkClient) GetX(ctx context.Context, id int) *Network {
 	return obj
 }
 
-// QueryTokens queries the tokens edge of a Network.
-func (c *NetworkClient) QueryTokens(n *Network) *TokenQuery {
-	query := (&TokenClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := n.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(network.Table, network.FieldID, id),
-			sqlgraph.To(token.Table, token.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, network.TokensTable, network.TokensColumn),
-		)
-		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryPaymentWebhook queries the payment_webhook edge of a Network.
-func (c *NetworkClient) QueryPaymentWebhook(n *Network) *PaymentWebhookQuery {
-	query := (&PaymentWebhookClient{config: c.config}).Query()
+// QueryFiatCurrency queries the fiat_currency edge of a Institution.
+func (c *InstitutionClient) QueryFiatCurrency(i *Institution) *FiatCurrencyQuery {
+	query := (&FiatCurrencyClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := n.ID
+		id := i.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(network.Table, network.FieldID, id),
-			sqlgraph.To(paymentwebhook.Table, paymentwebhook.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, network.PaymentWebhookTable, network.PaymentWebhookColumn),
+			sqlgraph.From(institution.Table, institution.FieldID, id),
+			sqlgraph.To(fiatcurrency.Table, fiatcurrency.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, institution.FiatCurrencyTable, institution.FiatCurrencyColumn),
 		)
-		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *NetworkClient) Hooks() []Hook {
-	return c.hooks.Network
+func (c *InstitutionClient) Hooks() []Hook {
+	return c.hooks.Institution
 }
 
 // Interceptors returns the client interceptors.
-func (c *NetworkClient) Interceptors() []Interceptor {
-	return c.inters.Network
+func (c *InstitutionClient) Interceptors() []Interceptor {
+	return c.inters.Institution
 }
 
-func (c *NetworkClient) mutate(ctx context.Context, m *NetworkMutation) (Value, error) {
+func (c *InstitutionClient) mutate(ctx context.Context, m *InstitutionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&NetworkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&InstitutionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&NetworkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&InstitutionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&NetworkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&InstitutionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&NetworkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&InstitutionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Network mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Institution mutation op: %q", m.Op())
 	}
 }
 
-// PaymentOrderClient is a client for the PaymentOrder schema.
-type PaymentOrderClient struct {
+// KYBProfileClient is a client for the KYBProfile schema.
+type KYBProfileClient struct {
 	config
 }
 
-// NewPaymentOrderClient returns a client for the PaymentOrder from the given config.
-func NewPaymentOrderClient(c config) *PaymentOrderClient {
-	return &PaymentOrderClient{config: c}
+// NewKYBProfileClient returns a client for the KYBProfile from the given config.
+func NewKYBProfileClient(c config) *KYBProfileClient {
+	return &KYBProfileClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `paymentorder.Hooks(f(g(h())))`.
-func (c *PaymentOrderClient) Use(hooks ...Hook) {
-	c.hooks.PaymentOrder = append(c.hooks.PaymentOrder, hooks...)
-}
-
+// A call to `Use(f, g, h)` equals to `kybprofile.Hooks(f(g(h())))`.
+func (c *KYBProfileClient) Use(hooks ...Hook) {
+	c.hooks.KYBProfile = append(c.hooks.KYBProfile, hooks...)
+}
+
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `paymentorder.Intercept(f(g(h())))`.
-func (c *PaymentOrderClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PaymentOrder = append(c.inters.PaymentOrder, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `kybprofile.Intercept(f(g(h())))`.
+func (c *KYBProfileClient) Intercept(interceptors ...Interceptor) {
+	c.inters.KYBProfile = append(c.inters.KYBProfile, interceptors...)
 }
 
-// Create returns a builder for creating a PaymentOrder entity.
-func (c *PaymentOrderClient) Create() *PaymentOrderCreate {
-	mutation := newPaymentOrderMutation(c.config, OpCreate)
-	return &PaymentOrderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a KYBProfile entity.
+func (c *KYBProfileClient) Create() *KYBProfileCreate {
+	mutation := newKYBProfileMutation(c.config, OpCreate)
+	return &KYBProfileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PaymentOrder entities.
-func (c *PaymentOrderClient) CreateBulk(builders ...*PaymentOrderCreate) *PaymentOrderCreateBulk {
-	return &PaymentOrderCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of KYBProfile entities.
+func (c *KYBProfileClient) CreateBulk(builders ...*KYBProfileCreate) *KYBProfileCreateBulk {
+	return &KYBProfileCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PaymentOrderClient) MapCreateBulk(slice any, setFunc func(*PaymentOrderCreate, int)) *PaymentOrderCreateBulk {
+func (c *KYBProfileClient) MapCreateBulk(slice any, setFunc func(*KYBProfileCreate, int)) *KYBProfileCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PaymentOrderCreateBulk{err: fmt.Errorf("calling to PaymentOrderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &KYBProfileCreateBulk{err: fmt.Errorf("calling to KYBProfileClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PaymentOrderCreate, rv.Len())
+	builders := make([]*KYBProfileCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PaymentOrderCreateBulk{config: c.config, builders: builders}
+	return &KYBProfileCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PaymentOrder.
-func (c *PaymentOrderClient) Update() *PaymentOrderUpdate {
-	mutation := newPaymentOrderMutation(c.config, OpUpdate)
-	return &PaymentOrderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for KYBProfile.
+func (c *KYBProfileClient) Update() *KYBProfileUpdate {
+	mutation := newKYBProfileMutation(c.config, OpUpdate)
+	return &KYBProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PaymentOrderClient) UpdateOne(po *PaymentOrder) *PaymentOrderUpdateOne {
-	mutation := newPaymentOrderMutation(c.config, OpUpdateOne, withPaymentOrder(po))
-	return &PaymentOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *KYBProfileClient) UpdateOne(kp *KYBProfile) *KYBProfileUpdateOne {
+	mutation := newKYBProfileMutation(c.config, OpUpdateOne, withKYBProfile(kp))
+	return &KYBProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PaymentOrderClient) UpdateOneID(id uuid.UUID) *PaymentOrderUpdateOne {
-	mutation := newPaymentOrderMutation(c.config, OpUpdateOne, withPaymentOrderID(id))
-	return &PaymentOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *KYBProfileClient) UpdateOneID(id uuid.UUID) *KYBProfileUpdateOne {
+	mutation := newKYBProfileMutation(c.config, OpUpdateOne, withKYBProfileID(id))
+	return &KYBProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PaymentOrder.
-func (c *PaymentOrderClient) Delete() *PaymentOrderDelete {
-	mutation := newPaymentOrderMutation(c.config, OpDelete)
-	return &PaymentOrderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for KYBProfile.
+func (c *KYBProfileClient) Delete() *KYBProfileDelete {
+	mutation := newKYBProfileMutation(c.config, OpDelete)
+	return &KYBProfileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PaymentOrderClient) DeleteOne(po *PaymentOrder) *PaymentOrderDeleteOne {
-	return c.DeleteOneID(po.ID)
+func (c *KYBProfileClient) DeleteOne(kp *KYBProfile) *KYBProfileDeleteOne {
+	return c.DeleteOneID(kp.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PaymentOrderClient) DeleteOneID(id uuid.UUID) *PaymentOrderDeleteOne {
-	builder := c.Delete().Where(paymentorder.ID(id))
+func (c *KYBProfileClient) DeleteOneID(id uuid.UUID) *KYBProfileDeleteOne {
+	builder := c.Delete().Where(kybprofile.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PaymentOrderDeleteOne{builder}
+	return &KYBProfileDeleteOne{builder}
 }
 
-// Query returns a query builder for PaymentOrder.
-func (c *PaymentOrderClient) Query() *PaymentOrderQuery {
-	return &PaymentOrderQuery{
+// Query returns a query builder for KYBProfile.
+func (c *KYBProfileClient) Query() *KYBProfileQuery {
+	return &KYBProfileQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePaymentOrder},
+		ctx:    &QueryContext{Type: TypeKYBProfile},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PaymentOrder entity by its id.
-func (c *PaymentOrderClient) Get(ctx context.Context, id uuid.UUID) (*PaymentOrder, error) {
-	return c.Query().Where(paymentorder.ID(id)).Only(ctx)
+// Get returns a KYBProfile entity by its id.
+func (c *KYBProfileClient) Get(ctx context.Context, id uuid.UUID) (*KYBProfile, error) {
+	return c.Query().Where(kybprofile.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PaymentOrderClient) GetX(ctx context.Context, id uuid.UUID) *PaymentOrder {
+func (c *KYBProfileClient) GetX(ctx context.Context, id uuid.UUID) *KYBProfile {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2176,244 +2361,164 @@ func (c *PaymentOrderClient) GetX(ctx context.Context, id uuid.UUID) *PaymentOrd
 	return obj
 }
 
-// QuerySenderProfile queries the sender_profile edge of a PaymentOrder.
-func (c *PaymentOrderClient) QuerySenderProfile(po *PaymentOrder) *SenderProfileQuery {
-	query := (&SenderProfileClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := po.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, id),
-			sqlgraph.To(senderprofile.Table, senderprofile.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, paymentorder.SenderProfileTable, paymentorder.SenderProfileColumn),
-		)
-		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryToken queries the token edge of a PaymentOrder.
-func (c *PaymentOrderClient) QueryToken(po *PaymentOrder) *TokenQuery {
-	query := (&TokenClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := po.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, id),
-			sqlgraph.To(token.Table, token.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, paymentorder.TokenTable, paymentorder.TokenColumn),
-		)
-		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryLinkedAddress queries the linked_address edge of a PaymentOrder.
-func (c *PaymentOrderClient) QueryLinkedAddress(po *PaymentOrder) *LinkedAddressQuery {
-	query := (&LinkedAddressClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := po.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, id),
-			sqlgraph.To(linkedaddress.Table, linkedaddress.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, paymentorder.LinkedAddressTable, paymentorder.LinkedAddressColumn),
-		)
-		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryReceiveAddress queries the receive_address edge of a PaymentOrder.
-func (c *PaymentOrderClient) QueryReceiveAddress(po *PaymentOrder) *ReceiveAddressQuery {
-	query := (&ReceiveAddressClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := po.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, id),
-			sqlgraph.To(receiveaddress.Table, receiveaddress.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, paymentorder.ReceiveAddressTable, paymentorder.ReceiveAddressColumn),
-		)
-		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryRecipient queries the recipient edge of a PaymentOrder.
-func (c *PaymentOrderClient) QueryRecipient(po *PaymentOrder) *PaymentOrderRecipientQuery {
-	query := (&PaymentOrderRecipientClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := po.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, id),
-			sqlgraph.To(paymentorderrecipient.Table, paymentorderrecipient.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, paymentorder.RecipientTable, paymentorder.RecipientColumn),
-		)
-		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryTransactions queries the transactions edge of a PaymentOrder.
-func (c *PaymentOrderClient) QueryTransactions(po *PaymentOrder) *TransactionLogQuery {
-	query := (&TransactionLogClient{config: c.config}).Query()
+// QueryBeneficialOwners queries the beneficial_owners edge of a KYBProfile.
+func (c *KYBProfileClient) QueryBeneficialOwners(kp *KYBProfile) *BeneficialOwnerQuery {
+	query := (&BeneficialOwnerClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := po.ID
+		id := kp.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, id),
-			sqlgraph.To(transactionlog.Table, transactionlog.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, paymentorder.TransactionsTable, paymentorder.TransactionsColumn),
+			sqlgraph.From(kybprofile.Table, kybprofile.FieldID, id),
+			sqlgraph.To(beneficialowner.Table, beneficialowner.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, kybprofile.BeneficialOwnersTable, kybprofile.BeneficialOwnersColumn),
 		)
-		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(kp.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryPaymentWebhook queries the payment_webhook edge of a PaymentOrder.
-func (c *PaymentOrderClient) QueryPaymentWebhook(po *PaymentOrder) *PaymentWebhookQuery {
-	query := (&PaymentWebhookClient{config: c.config}).Query()
+// QueryUser queries the user edge of a KYBProfile.
+func (c *KYBProfileClient) QueryUser(kp *KYBProfile) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := po.ID
+		id := kp.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, id),
-			sqlgraph.To(paymentwebhook.Table, paymentwebhook.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, paymentorder.PaymentWebhookTable, paymentorder.PaymentWebhookColumn),
+			sqlgraph.From(kybprofile.Table, kybprofile.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, kybprofile.UserTable, kybprofile.UserColumn),
 		)
-		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(kp.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *PaymentOrderClient) Hooks() []Hook {
-	return c.hooks.PaymentOrder
+func (c *KYBProfileClient) Hooks() []Hook {
+	return c.hooks.KYBProfile
 }
 
 // Interceptors returns the client interceptors.
-func (c *PaymentOrderClient) Interceptors() []Interceptor {
-	return c.inters.PaymentOrder
+func (c *KYBProfileClient) Interceptors() []Interceptor {
+	return c.inters.KYBProfile
 }
 
-func (c *PaymentOrderClient) mutate(ctx context.Context, m *PaymentOrderMutation) (Value, error) {
+func (c *KYBProfileClient) mutate(ctx context.Context, m *KYBProfileMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PaymentOrderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&KYBProfileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PaymentOrderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&KYBProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PaymentOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&KYBProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PaymentOrderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&KYBProfileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PaymentOrder mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown KYBProfile mutation op: %q", m.Op())
 	}
 }
 
-// PaymentOrderRecipientClient is a client for the PaymentOrderRecipient schema.
-type PaymentOrderRecipientClient struct {
+// LedgerAccountClient is a client for the LedgerAccount schema.
+type LedgerAccountClient struct {
 	config
 }
 
-// NewPaymentOrderRecipientClient returns a client for the PaymentOrderRecipient from the given config.
-func NewPaymentOrderRecipientClient(c config) *PaymentOrderRecipientClient {
-	return &PaymentOrderRecipientClient{config: c}
+// NewLedgerAccountClient returns a client for the LedgerAccount from the given config.
+func NewLedgerAccountClient(c config) *LedgerAccountClient {
+	return &LedgerAccountClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `paymentorderrecipient.Hooks(f(g(h())))`.
-func (c *PaymentOrderRecipientClient) Use(hooks ...Hook) {
-	c.hooks.PaymentOrderRecipient = append(c.hooks.PaymentOrderRecipient, hooks...)
+// A call to `Use(f, g, h)` equals to `ledgeraccount.Hooks(f(g(h())))`.
+func (c *LedgerAccountClient) Use(hooks ...Hook) {
+	c.hooks.LedgerAccount = append(c.hooks.LedgerAccount, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `paymentorderrecipient.Intercept(f(g(h())))`.
-func (c *PaymentOrderRecipientClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PaymentOrderRecipient = append(c.inters.PaymentOrderRecipient, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `ledgeraccount.Intercept(f(g(h())))`.
+func (c *LedgerAccountClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LedgerAccount = append(c.inters.LedgerAccount, interceptors...)
 }
 
-// Create returns a builder for creating a PaymentOrderRecipient entity.
-func (c *PaymentOrderRecipientClient) Create() *PaymentOrderRecipientCreate {
-	mutation := newPaymentOrderRecipientMutation(c.config, OpCreate)
-	return &PaymentOrderRecipientCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a LedgerAccount entity.
+func (c *LedgerAccountClient) Create() *LedgerAccountCreate {
+	mutation := newLedgerAccountMutation(c.config, OpCreate)
+	return &LedgerAccountCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PaymentOrderRecipient entities.
-func (c *PaymentOrderRecipientClient) CreateBulk(builders ...*PaymentOrderRecipientCreate) *PaymentOrderRecipientCreateBulk {
-	return &PaymentOrderRecipientCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of LedgerAccount entities.
+func (c *LedgerAccountClient) CreateBulk(builders ...*LedgerAccountCreate) *LedgerAccountCreateBulk {
+	return &LedgerAccountCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PaymentOrderRecipientClient) MapCreateBulk(slice any, setFunc func(*PaymentOrderRecipientCreate, int)) *PaymentOrderRecipientCreateBulk {
+func (c *LedgerAccountClient) MapCreateBulk(slice any, setFunc func(*LedgerAccountCreate, int)) *LedgerAccountCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PaymentOrderRecipientCreateBulk{err: fmt.Errorf("calling to PaymentOrderRecipientClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &LedgerAccountCreateBulk{err: fmt.Errorf("calling to LedgerAccountClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PaymentOrderRecipientCreate, rv.Len())
+	builders := make([]*LedgerAccountCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PaymentOrderRecipientCreateBulk{config: c.config, builders: builders}
+	return &LedgerAccountCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PaymentOrderRecipient.
-func (c *PaymentOrderRecipientClient) Update() *PaymentOrderRecipientUpdate {
-	mutation := newPaymentOrderRecipientMutation(c.config, OpUpdate)
-	return &PaymentOrderRecipientUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for LedgerAccount.
+func (c *LedgerAccountClient) Update() *LedgerAccountUpdate {
+	mutation := newLedgerAccountMutation(c.config, OpUpdate)
+	return &LedgerAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PaymentOrderRecipientClient) UpdateOne(por *PaymentOrderRecipient) *PaymentOrderRecipientUpdateOne {
-	mutation := newPaymentOrderRecipientMutation(c.config, OpUpdateOne, withPaymentOrderRecipient(por))
-	return &PaymentOrderRecipientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *LedgerAccountClient) UpdateOne(la *LedgerAccount) *LedgerAccountUpdateOne {
+	mutation := newLedgerAccountMutation(c.config, OpUpdateOne, withLedgerAccount(la))
+	return &LedgerAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PaymentOrderRecipientClient) UpdateOneID(id int) *PaymentOrderRecipientUpdateOne {
-	mutation := newPaymentOrderRecipientMutation(c.config, OpUpdateOne, withPaymentOrderRecipientID(id))
-	return &PaymentOrderRecipientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *LedgerAccountClient) UpdateOneID(id uuid.UUID) *LedgerAccountUpdateOne {
+	mutation := newLedgerAccountMutation(c.config, OpUpdateOne, withLedgerAccountID(id))
+	return &LedgerAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PaymentOrderRecipient.
-func (c *PaymentOrderRecipientClient) Delete() *PaymentOrderRecipientDelete {
-	mutation := newPaymentOrderRecipientMutation(c.config, OpDelete)
-	return &PaymentOrderRecipientDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for LedgerAccount.
+func (c *LedgerAccountClient) Delete() *LedgerAccountDelete {
+	mutation := newLedgerAccountMutation(c.config, OpDelete)
+	return &LedgerAccountDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PaymentOrderRecipientClient) DeleteOne(por *PaymentOrderRecipient) *PaymentOrderRecipientDeleteOne {
-	return c.DeleteOneID(por.ID)
+func (c *LedgerAccountClient) DeleteOne(la *LedgerAccount) *LedgerAccountDeleteOne {
+	return c.DeleteOneID(la.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PaymentOrderRecipientClient) DeleteOneID(id int) *PaymentOrderRecipientDeleteOne {
-	builder := c.Delete().Where(paymentorderrecipient.ID(id))
+func (c *LedgerAccountClient) DeleteOneID(id uuid.UUID) *LedgerAccountDeleteOne {
+	builder := c.Delete().Where(ledgeraccount.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PaymentOrderRecipientDeleteOne{builder}
+	return &LedgerAccountDeleteOne{builder}
 }
 
-// Query returns a query builder for PaymentOrderRecipient.
-func (c *PaymentOrderRecipientClient) Query() *PaymentOrderRecipientQuery {
-	return &PaymentOrderRecipientQuery{
+// Query returns a query builder for LedgerAccount.
+func (c *LedgerAccountClient) Query() *LedgerAccountQuery {
+	return &LedgerAccountQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePaymentOrderRecipient},
+		ctx:    &QueryContext{Type: TypeLedgerAccount},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PaymentOrderRecipient entity by its id.
-func (c *PaymentOrderRecipientClient) Get(ctx context.Context, id int) (*PaymentOrderRecipient, error) {
-	return c.Query().Where(paymentorderrecipient.ID(id)).Only(ctx)
+// Get returns a LedgerAccount entity by its id.
+func (c *LedgerAccountClient) Get(ctx context.Context, id uuid.UUID) (*LedgerAccount, error) {
+	return c.Query().Where(ledgeraccount.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PaymentOrderRecipientClient) GetX(ctx context.Context, id int) *PaymentOrderRecipient {
+func (c *LedgerAccountClient) GetX(ctx context.Context, id uuid.UUID) *LedgerAccount {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2421,148 +2526,3108 @@ func (c *PaymentOrderRecipientClient) GetX(ctx context.Context, id int) *Payment
 	return obj
 }
 
-// QueryPaymentOrder queries the payment_order edge of a PaymentOrderRecipient.
-func (c *PaymentOrderRecipientClient) QueryPaymentOrder(por *PaymentOrderRecipient) *PaymentOrderQuery {
+// QueryToken queries the token edge of a LedgerAccount.
+func (c *LedgerAccountClient) QueryToken(la *LedgerAccount) *TokenQuery {
+	query := (&TokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := la.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ledgeraccount.Table, ledgeraccount.FieldID, id),
+			sqlgraph.To(token.Table, token.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ledgeraccount.TokenTable, ledgeraccount.TokenColumn),
+		)
+		fromV = sqlgraph.Neighbors(la.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPostings queries the postings edge of a LedgerAccount.
+func (c *LedgerAccountClient) QueryPostings(la *LedgerAccount) *LedgerPostingQuery {
+	query := (&LedgerPostingClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := la.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ledgeraccount.Table, ledgeraccount.FieldID, id),
+			sqlgraph.To(ledgerposting.Table, ledgerposting.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ledgeraccount.PostingsTable, ledgeraccount.PostingsColumn),
+		)
+		fromV = sqlgraph.Neighbors(la.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LedgerAccountClient) Hooks() []Hook {
+	return c.hooks.LedgerAccount
+}
+
+// Interceptors returns the client interceptors.
+func (c *LedgerAccountClient) Interceptors() []Interceptor {
+	return c.inters.LedgerAccount
+}
+
+func (c *LedgerAccountClient) mutate(ctx context.Context, m *LedgerAccountMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LedgerAccountCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LedgerAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LedgerAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LedgerAccountDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LedgerAccount mutation op: %q", m.Op())
+	}
+}
+
+// LedgerEntryClient is a client for the LedgerEntry schema.
+type LedgerEntryClient struct {
+	config
+}
+
+// NewLedgerEntryClient returns a client for the LedgerEntry from the given config.
+func NewLedgerEntryClient(c config) *LedgerEntryClient {
+	return &LedgerEntryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ledgerentry.Hooks(f(g(h())))`.
+func (c *LedgerEntryClient) Use(hooks ...Hook) {
+	c.hooks.LedgerEntry = append(c.hooks.LedgerEntry, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ledgerentry.Intercept(f(g(h())))`.
+func (c *LedgerEntryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LedgerEntry = append(c.inters.LedgerEntry, interceptors...)
+}
+
+// Create returns a builder for creating a LedgerEntry entity.
+func (c *LedgerEntryClient) Create() *LedgerEntryCreate {
+	mutation := newLedgerEntryMutation(c.config, OpCreate)
+	return &LedgerEntryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LedgerEntry entities.
+func (c *LedgerEntryClient) CreateBulk(builders ...*LedgerEntryCreate) *LedgerEntryCreateBulk {
+	return &LedgerEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LedgerEntryClient) MapCreateBulk(slice any, setFunc func(*LedgerEntryCreate, int)) *LedgerEntryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LedgerEntryCreateBulk{err: fmt.Errorf("calling to LedgerEntryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LedgerEntryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LedgerEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LedgerEntry.
+func (c *LedgerEntryClient) Update() *LedgerEntryUpdate {
+	mutation := newLedgerEntryMutation(c.config, OpUpdate)
+	return &LedgerEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LedgerEntryClient) UpdateOne(le *LedgerEntry) *LedgerEntryUpdateOne {
+	mutation := newLedgerEntryMutation(c.config, OpUpdateOne, withLedgerEntry(le))
+	return &LedgerEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LedgerEntryClient) UpdateOneID(id uuid.UUID) *LedgerEntryUpdateOne {
+	mutation := newLedgerEntryMutation(c.config, OpUpdateOne, withLedgerEntryID(id))
+	return &LedgerEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LedgerEntry.
+func (c *LedgerEntryClient) Delete() *LedgerEntryDelete {
+	mutation := newLedgerEntryMutation(c.config, OpDelete)
+	return &LedgerEntryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LedgerEntryClient) DeleteOne(le *LedgerEntry) *LedgerEntryDeleteOne {
+	return c.DeleteOneID(le.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LedgerEntryClient) DeleteOneID(id uuid.UUID) *LedgerEntryDeleteOne {
+	builder := c.Delete().Where(ledgerentry.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LedgerEntryDeleteOne{builder}
+}
+
+// Query returns a query builder for LedgerEntry.
+func (c *LedgerEntryClient) Query() *LedgerEntryQuery {
+	return &LedgerEntryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLedgerEntry},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LedgerEntry entity by its id.
+func (c *LedgerEntryClient) Get(ctx context.Context, id uuid.UUID) (*LedgerEntry, error) {
+	return c.Query().Where(ledgerentry.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LedgerEntryClient) GetX(ctx context.Context, id uuid.UUID) *LedgerEntry {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPaymentOrder queries the payment_order edge of a LedgerEntry.
+func (c *LedgerEntryClient) QueryPaymentOrder(le *LedgerEntry) *PaymentOrderQuery {
 	query := (&PaymentOrderClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := por.ID
+		id := le.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(paymentorderrecipient.Table, paymentorderrecipient.FieldID, id),
+			sqlgraph.From(ledgerentry.Table, ledgerentry.FieldID, id),
 			sqlgraph.To(paymentorder.Table, paymentorder.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, paymentorderrecipient.PaymentOrderTable, paymentorderrecipient.PaymentOrderColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, ledgerentry.PaymentOrderTable, ledgerentry.PaymentOrderColumn),
 		)
-		fromV = sqlgraph.Neighbors(por.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(le.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPostings queries the postings edge of a LedgerEntry.
+func (c *LedgerEntryClient) QueryPostings(le *LedgerEntry) *LedgerPostingQuery {
+	query := (&LedgerPostingClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := le.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ledgerentry.Table, ledgerentry.FieldID, id),
+			sqlgraph.To(ledgerposting.Table, ledgerposting.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ledgerentry.PostingsTable, ledgerentry.PostingsColumn),
+		)
+		fromV = sqlgraph.Neighbors(le.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *PaymentOrderRecipientClient) Hooks() []Hook {
-	return c.hooks.PaymentOrderRecipient
+func (c *LedgerEntryClient) Hooks() []Hook {
+	return c.hooks.LedgerEntry
 }
 
 // Interceptors returns the client interceptors.
-func (c *PaymentOrderRecipientClient) Interceptors() []Interceptor {
-	return c.inters.PaymentOrderRecipient
+func (c *LedgerEntryClient) Interceptors() []Interceptor {
+	return c.inters.LedgerEntry
 }
 
-func (c *PaymentOrderRecipientClient) mutate(ctx context.Context, m *PaymentOrderRecipientMutation) (Value, error) {
+func (c *LedgerEntryClient) mutate(ctx context.Context, m *LedgerEntryMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PaymentOrderRecipientCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LedgerEntryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PaymentOrderRecipientUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LedgerEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PaymentOrderRecipientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LedgerEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PaymentOrderRecipientDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&LedgerEntryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PaymentOrderRecipient mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown LedgerEntry mutation op: %q", m.Op())
 	}
 }
 
-// PaymentWebhookClient is a client for the PaymentWebhook schema.
-type PaymentWebhookClient struct {
+// LedgerPostingClient is a client for the LedgerPosting schema.
+type LedgerPostingClient struct {
 	config
 }
 
-// NewPaymentWebhookClient returns a client for the PaymentWebhook from the given config.
-func NewPaymentWebhookClient(c config) *PaymentWebhookClient {
-	return &PaymentWebhookClient{config: c}
+// NewLedgerPostingClient returns a client for the LedgerPosting from the given config.
+func NewLedgerPostingClient(c config) *LedgerPostingClient {
+	return &LedgerPostingClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `paymentwebhook.Hooks(f(g(h())))`.
-func (c *PaymentWebhookClient) Use(hooks ...Hook) {
-	c.hooks.PaymentWebhook = append(c.hooks.PaymentWebhook, hooks...)
+// A call to `Use(f, g, h)` equals to `ledgerposting.Hooks(f(g(h())))`.
+func (c *LedgerPostingClient) Use(hooks ...Hook) {
+	c.hooks.LedgerPosting = append(c.hooks.LedgerPosting, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `paymentwebhook.Intercept(f(g(h())))`.
-func (c *PaymentWebhookClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PaymentWebhook = append(c.inters.PaymentWebhook, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `ledgerposting.Intercept(f(g(h())))`.
+func (c *LedgerPostingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LedgerPosting = append(c.inters.LedgerPosting, interceptors...)
 }
 
-// Create returns a builder for creating a PaymentWebhook entity.
-func (c *PaymentWebhookClient) Create() *PaymentWebhookCreate {
-	mutation := newPaymentWebhookMutation(c.config, OpCreate)
-	return &PaymentWebhookCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a LedgerPosting entity.
+func (c *LedgerPostingClient) Create() *LedgerPostingCreate {
+	mutation := newLedgerPostingMutation(c.config, OpCreate)
+	return &LedgerPostingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PaymentWebhook entities.
-func (c *PaymentWebhookClient) CreateBulk(builders ...*PaymentWebhookCreate) *PaymentWebhookCreateBulk {
-	return &PaymentWebhookCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of LedgerPosting entities.
+func (c *LedgerPostingClient) CreateBulk(builders ...*LedgerPostingCreate) *LedgerPostingCreateBulk {
+	return &LedgerPostingCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PaymentWebhookClient) MapCreateBulk(slice any, setFunc func(*PaymentWebhookCreate, int)) *PaymentWebhookCreateBulk {
+func (c *LedgerPostingClient) MapCreateBulk(slice any, setFunc func(*LedgerPostingCreate, int)) *LedgerPostingCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PaymentWebhookCreateBulk{err: fmt.Errorf("calling to PaymentWebhookClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &LedgerPostingCreateBulk{err: fmt.Errorf("calling to LedgerPostingClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PaymentWebhookCreate, rv.Len())
+	builders := make([]*LedgerPostingCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PaymentWebhookCreateBulk{config: c.config, builders: builders}
+	return &LedgerPostingCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PaymentWebhook.
-func (c *PaymentWebhookClient) Update() *PaymentWebhookUpdate {
-	mutation := newPaymentWebhookMutation(c.config, OpUpdate)
-	return &PaymentWebhookUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for LedgerPosting.
+func (c *LedgerPostingClient) Update() *LedgerPostingUpdate {
+	mutation := newLedgerPostingMutation(c.config, OpUpdate)
+	return &LedgerPostingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PaymentWebhookClient) UpdateOne(pw *PaymentWebhook) *PaymentWebhookUpdateOne {
-	mutation := newPaymentWebhookMutation(c.config, OpUpdateOne, withPaymentWebhook(pw))
-	return &PaymentWebhookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *LedgerPostingClient) UpdateOne(lp *LedgerPosting) *LedgerPostingUpdateOne {
+	mutation := newLedgerPostingMutation(c.config, OpUpdateOne, withLedgerPosting(lp))
+	return &LedgerPostingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LedgerPostingClient) UpdateOneID(id uuid.UUID) *LedgerPostingUpdateOne {
+	mutation := newLedgerPostingMutation(c.config, OpUpdateOne, withLedgerPostingID(id))
+	return &LedgerPostingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LedgerPosting.
+func (c *LedgerPostingClient) Delete() *LedgerPostingDelete {
+	mutation := newLedgerPostingMutation(c.config, OpDelete)
+	return &LedgerPostingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LedgerPostingClient) DeleteOne(lp *LedgerPosting) *LedgerPostingDeleteOne {
+	return c.DeleteOneID(lp.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LedgerPostingClient) DeleteOneID(id uuid.UUID) *LedgerPostingDeleteOne {
+	builder := c.Delete().Where(ledgerposting.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LedgerPostingDeleteOne{builder}
+}
+
+// Query returns a query builder for LedgerPosting.
+func (c *LedgerPostingClient) Query() *LedgerPostingQuery {
+	return &LedgerPostingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLedgerPosting},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LedgerPosting entity by its id.
+func (c *LedgerPostingClient) Get(ctx context.Context, id uuid.UUID) (*LedgerPosting, error) {
+	return c.Query().Where(ledgerposting.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LedgerPostingClient) GetX(ctx context.Context, id uuid.UUID) *LedgerPosting {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryEntry queries the entry edge of a LedgerPosting.
+func (c *LedgerPostingClient) QueryEntry(lp *LedgerPosting) *LedgerEntryQuery {
+	query := (&LedgerEntryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ledgerposting.Table, ledgerposting.FieldID, id),
+			sqlgraph.To(ledgerentry.Table, ledgerentry.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ledgerposting.EntryTable, ledgerposting.EntryColumn),
+		)
+		fromV = sqlgraph.Neighbors(lp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAccount queries the account edge of a LedgerPosting.
+func (c *LedgerPostingClient) QueryAccount(lp *LedgerPosting) *LedgerAccountQuery {
+	query := (&LedgerAccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ledgerposting.Table, ledgerposting.FieldID, id),
+			sqlgraph.To(ledgeraccount.Table, ledgeraccount.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ledgerposting.AccountTable, ledgerposting.AccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(lp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LedgerPostingClient) Hooks() []Hook {
+	return c.hooks.LedgerPosting
+}
+
+// Interceptors returns the client interceptors.
+func (c *LedgerPostingClient) Interceptors() []Interceptor {
+	return c.inters.LedgerPosting
+}
+
+func (c *LedgerPostingClient) mutate(ctx context.Context, m *LedgerPostingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LedgerPostingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LedgerPostingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LedgerPostingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LedgerPostingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LedgerPosting mutation op: %q", m.Op())
+	}
+}
+
+// LinkedAddressClient is a client for the LinkedAddress schema.
+type LinkedAddressClient struct {
+	config
+}
+
+// NewLinkedAddressClient returns a client for the LinkedAddress from the given config.
+func NewLinkedAddressClient(c config) *LinkedAddressClient {
+	return &LinkedAddressClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `linkedaddress.Hooks(f(g(h())))`.
+func (c *LinkedAddressClient) Use(hooks ...Hook) {
+	c.hooks.LinkedAddress = append(c.hooks.LinkedAddress, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `linkedaddress.Intercept(f(g(h())))`.
+func (c *LinkedAddressClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LinkedAddress = append(c.inters.LinkedAddress, interceptors...)
+}
+
+// Create returns a builder for creating a LinkedAddress entity.
+func (c *LinkedAddressClient) Create() *LinkedAddressCreate {
+	mutation := newLinkedAddressMutation(c.config, OpCreate)
+	return &LinkedAddressCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LinkedAddress entities.
+func (c *LinkedAddressClient) CreateBulk(builders ...*LinkedAddressCreate) *LinkedAddressCreateBulk {
+	return &LinkedAddressCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LinkedAddressClient) MapCreateBulk(slice any, setFunc func(*LinkedAddressCreate, int)) *LinkedAddressCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LinkedAddressCreateBulk{err: fmt.Errorf("calling to LinkedAddressClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LinkedAddressCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LinkedAddressCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LinkedAddress.
+func (c *LinkedAddressClient) Update() *LinkedAddressUpdate {
+	mutation := newLinkedAddressMutation(c.config, OpUpdate)
+	return &LinkedAddressUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LinkedAddressClient) UpdateOne(la *LinkedAddress) *LinkedAddressUpdateOne {
+	mutation := newLinkedAddressMutation(c.config, OpUpdateOne, withLinkedAddress(la))
+	return &LinkedAddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LinkedAddressClient) UpdateOneID(id int) *LinkedAddressUpdateOne {
+	mutation := newLinkedAddressMutation(c.config, OpUpdateOne, withLinkedAddressID(id))
+	return &LinkedAddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LinkedAddress.
+func (c *LinkedAddressClient) Delete() *LinkedAddressDelete {
+	mutation := newLinkedAddressMutation(c.config, OpDelete)
+	return &LinkedAddressDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LinkedAddressClient) DeleteOne(la *LinkedAddress) *LinkedAddressDeleteOne {
+	return c.DeleteOneID(la.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LinkedAddressClient) DeleteOneID(id int) *LinkedAddressDeleteOne {
+	builder := c.Delete().Where(linkedaddress.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LinkedAddressDeleteOne{builder}
+}
+
+// Query returns a query builder for LinkedAddress.
+func (c *LinkedAddressClient) Query() *LinkedAddressQuery {
+	return &LinkedAddressQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLinkedAddress},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LinkedAddress entity by its id.
+func (c *LinkedAddressClient) Get(ctx context.Context, id int) (*LinkedAddress, error) {
+	return c.Query().Where(linkedaddress.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LinkedAddressClient) GetX(ctx context.Context, id int) *LinkedAddress {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPaymentOrders queries the payment_orders edge of a LinkedAddress.
+func (c *LinkedAddressClient) QueryPaymentOrders(la *LinkedAddress) *PaymentOrderQuery {
+	query := (&PaymentOrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := la.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(linkedaddress.Table, linkedaddress.FieldID, id),
+			sqlgraph.To(paymentorder.Table, paymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, linkedaddress.PaymentOrdersTable, linkedaddress.PaymentOrdersColumn),
+		)
+		fromV = sqlgraph.Neighbors(la.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySenderProfile queries the sender_profile edge of a LinkedAddress.
+func (c *LinkedAddressClient) QuerySenderProfile(la *LinkedAddress) *SenderProfileQuery {
+	query := (&SenderProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := la.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(linkedaddress.Table, linkedaddress.FieldID, id),
+			sqlgraph.To(senderprofile.Table, senderprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, linkedaddress.SenderProfileTable, linkedaddress.SenderProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(la.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LinkedAddressClient) Hooks() []Hook {
+	return c.hooks.LinkedAddress
+}
+
+// Interceptors returns the client interceptors.
+func (c *LinkedAddressClient) Interceptors() []Interceptor {
+	return c.inters.LinkedAddress
+}
+
+func (c *LinkedAddressClient) mutate(ctx context.Context, m *LinkedAddressMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LinkedAddressCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LinkedAddressUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LinkedAddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LinkedAddressDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LinkedAddress mutation op: %q", m.Op())
+	}
+}
+
+// LockOrderFulfillmentClient is a client for the LockOrderFulfillment schema.
+type LockOrderFulfillmentClient struct {
+	config
+}
+
+// NewLockOrderFulfillmentClient returns a client for the LockOrderFulfillment from the given config.
+func NewLockOrderFulfillmentClient(c config) *LockOrderFulfillmentClient {
+	return &LockOrderFulfillmentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `lockorderfulfillment.Hooks(f(g(h())))`.
+func (c *LockOrderFulfillmentClient) Use(hooks ...Hook) {
+	c.hooks.LockOrderFulfillment = append(c.hooks.LockOrderFulfillment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `lockorderfulfillment.Intercept(f(g(h())))`.
+func (c *LockOrderFulfillmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LockOrderFulfillment = append(c.inters.LockOrderFulfillment, interceptors...)
+}
+
+// Create returns a builder for creating a LockOrderFulfillment entity.
+func (c *LockOrderFulfillmentClient) Create() *LockOrderFulfillmentCreate {
+	mutation := newLockOrderFulfillmentMutation(c.config, OpCreate)
+	return &LockOrderFulfillmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LockOrderFulfillment entities.
+func (c *LockOrderFulfillmentClient) CreateBulk(builders ...*LockOrderFulfillmentCreate) *LockOrderFulfillmentCreateBulk {
+	return &LockOrderFulfillmentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LockOrderFulfillmentClient) MapCreateBulk(slice any, setFunc func(*LockOrderFulfillmentCreate, int)) *LockOrderFulfillmentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LockOrderFulfillmentCreateBulk{err: fmt.Errorf("calling to LockOrderFulfillmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LockOrderFulfillmentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LockOrderFulfillmentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LockOrderFulfillment.
+func (c *LockOrderFulfillmentClient) Update() *LockOrderFulfillmentUpdate {
+	mutation := newLockOrderFulfillmentMutation(c.config, OpUpdate)
+	return &LockOrderFulfillmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LockOrderFulfillmentClient) UpdateOne(lof *LockOrderFulfillment) *LockOrderFulfillmentUpdateOne {
+	mutation := newLockOrderFulfillmentMutation(c.config, OpUpdateOne, withLockOrderFulfillment(lof))
+	return &LockOrderFulfillmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LockOrderFulfillmentClient) UpdateOneID(id uuid.UUID) *LockOrderFulfillmentUpdateOne {
+	mutation := newLockOrderFulfillmentMutation(c.config, OpUpdateOne, withLockOrderFulfillmentID(id))
+	return &LockOrderFulfillmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LockOrderFulfillment.
+func (c *LockOrderFulfillmentClient) Delete() *LockOrderFulfillmentDelete {
+	mutation := newLockOrderFulfillmentMutation(c.config, OpDelete)
+	return &LockOrderFulfillmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LockOrderFulfillmentClient) DeleteOne(lof *LockOrderFulfillment) *LockOrderFulfillmentDeleteOne {
+	return c.DeleteOneID(lof.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LockOrderFulfillmentClient) DeleteOneID(id uuid.UUID) *LockOrderFulfillmentDeleteOne {
+	builder := c.Delete().Where(lockorderfulfillment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LockOrderFulfillmentDeleteOne{builder}
+}
+
+// Query returns a query builder for LockOrderFulfillment.
+func (c *LockOrderFulfillmentClient) Query() *LockOrderFulfillmentQuery {
+	return &LockOrderFulfillmentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLockOrderFulfillment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LockOrderFulfillment entity by its id.
+func (c *LockOrderFulfillmentClient) Get(ctx context.Context, id uuid.UUID) (*LockOrderFulfillment, error) {
+	return c.Query().Where(lockorderfulfillment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LockOrderFulfillmentClient) GetX(ctx context.Context, id uuid.UUID) *LockOrderFulfillment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrder queries the order edge of a LockOrderFulfillment.
+func (c *LockOrderFulfillmentClient) QueryOrder(lof *LockOrderFulfillment) *LockPaymentOrderQuery {
+	query := (&LockPaymentOrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lof.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lockorderfulfillment.Table, lockorderfulfillment.FieldID, id),
+			sqlgraph.To(lockpaymentorder.Table, lockpaymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, lockorderfulfillment.OrderTable, lockorderfulfillment.OrderColumn),
+		)
+		fromV = sqlgraph.Neighbors(lof.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LockOrderFulfillmentClient) Hooks() []Hook {
+	return c.hooks.LockOrderFulfillment
+}
+
+// Interceptors returns the client interceptors.
+func (c *LockOrderFulfillmentClient) Interceptors() []Interceptor {
+	return c.inters.LockOrderFulfillment
+}
+
+func (c *LockOrderFulfillmentClient) mutate(ctx context.Context, m *LockOrderFulfillmentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LockOrderFulfillmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LockOrderFulfillmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LockOrderFulfillmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LockOrderFulfillmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LockOrderFulfillment mutation op: %q", m.Op())
+	}
+}
+
+// LockOrderReassignmentClient is a client for the LockOrderReassignment schema.
+type LockOrderReassignmentClient struct {
+	config
+}
+
+// NewLockOrderReassignmentClient returns a client for the LockOrderReassignment from the given config.
+func NewLockOrderReassignmentClient(c config) *LockOrderReassignmentClient {
+	return &LockOrderReassignmentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `lockorderreassignment.Hooks(f(g(h())))`.
+func (c *LockOrderReassignmentClient) Use(hooks ...Hook) {
+	c.hooks.LockOrderReassignment = append(c.hooks.LockOrderReassignment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `lockorderreassignment.Intercept(f(g(h())))`.
+func (c *LockOrderReassignmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LockOrderReassignment = append(c.inters.LockOrderReassignment, interceptors...)
+}
+
+// Create returns a builder for creating a LockOrderReassignment entity.
+func (c *LockOrderReassignmentClient) Create() *LockOrderReassignmentCreate {
+	mutation := newLockOrderReassignmentMutation(c.config, OpCreate)
+	return &LockOrderReassignmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LockOrderReassignment entities.
+func (c *LockOrderReassignmentClient) CreateBulk(builders ...*LockOrderReassignmentCreate) *LockOrderReassignmentCreateBulk {
+	return &LockOrderReassignmentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LockOrderReassignmentClient) MapCreateBulk(slice any, setFunc func(*LockOrderReassignmentCreate, int)) *LockOrderReassignmentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LockOrderReassignmentCreateBulk{err: fmt.Errorf("calling to LockOrderReassignmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LockOrderReassignmentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LockOrderReassignmentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LockOrderReassignment.
+func (c *LockOrderReassignmentClient) Update() *LockOrderReassignmentUpdate {
+	mutation := newLockOrderReassignmentMutation(c.config, OpUpdate)
+	return &LockOrderReassignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LockOrderReassignmentClient) UpdateOne(lor *LockOrderReassignment) *LockOrderReassignmentUpdateOne {
+	mutation := newLockOrderReassignmentMutation(c.config, OpUpdateOne, withLockOrderReassignment(lor))
+	return &LockOrderReassignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LockOrderReassignmentClient) UpdateOneID(id uuid.UUID) *LockOrderReassignmentUpdateOne {
+	mutation := newLockOrderReassignmentMutation(c.config, OpUpdateOne, withLockOrderReassignmentID(id))
+	return &LockOrderReassignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LockOrderReassignment.
+func (c *LockOrderReassignmentClient) Delete() *LockOrderReassignmentDelete {
+	mutation := newLockOrderReassignmentMutation(c.config, OpDelete)
+	return &LockOrderReassignmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LockOrderReassignmentClient) DeleteOne(lor *LockOrderReassignment) *LockOrderReassignmentDeleteOne {
+	return c.DeleteOneID(lor.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LockOrderReassignmentClient) DeleteOneID(id uuid.UUID) *LockOrderReassignmentDeleteOne {
+	builder := c.Delete().Where(lockorderreassignment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LockOrderReassignmentDeleteOne{builder}
+}
+
+// Query returns a query builder for LockOrderReassignment.
+func (c *LockOrderReassignmentClient) Query() *LockOrderReassignmentQuery {
+	return &LockOrderReassignmentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLockOrderReassignment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LockOrderReassignment entity by its id.
+func (c *LockOrderReassignmentClient) Get(ctx context.Context, id uuid.UUID) (*LockOrderReassignment, error) {
+	return c.Query().Where(lockorderreassignment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LockOrderReassignmentClient) GetX(ctx context.Context, id uuid.UUID) *LockOrderReassignment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLockPaymentOrder queries the lock_payment_order edge of a LockOrderReassignment.
+func (c *LockOrderReassignmentClient) QueryLockPaymentOrder(lor *LockOrderReassignment) *LockPaymentOrderQuery {
+	query := (&LockPaymentOrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lor.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lockorderreassignment.Table, lockorderreassignment.FieldID, id),
+			sqlgraph.To(lockpaymentorder.Table, lockpaymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, lockorderreassignment.LockPaymentOrderTable, lockorderreassignment.LockPaymentOrderColumn),
+		)
+		fromV = sqlgraph.Neighbors(lor.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LockOrderReassignmentClient) Hooks() []Hook {
+	return c.hooks.LockOrderReassignment
+}
+
+// Interceptors returns the client interceptors.
+func (c *LockOrderReassignmentClient) Interceptors() []Interceptor {
+	return c.inters.LockOrderReassignment
+}
+
+func (c *LockOrderReassignmentClient) mutate(ctx context.Context, m *LockOrderReassignmentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LockOrderReassignmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LockOrderReassignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LockOrderReassignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LockOrderReassignmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LockOrderReassignment mutation op: %q", m.Op())
+	}
+}
+
+// LockPaymentOrderClient is a client for the LockPaymentOrder schema.
+type LockPaymentOrderClient struct {
+	config
+}
+
+// NewLockPaymentOrderClient returns a client for the LockPaymentOrder from the given config.
+func NewLockPaymentOrderClient(c config) *LockPaymentOrderClient {
+	return &LockPaymentOrderClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `lockpaymentorder.Hooks(f(g(h())))`.
+func (c *LockPaymentOrderClient) Use(hooks ...Hook) {
+	c.hooks.LockPaymentOrder = append(c.hooks.LockPaymentOrder, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `lockpaymentorder.Intercept(f(g(h())))`.
+func (c *LockPaymentOrderClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LockPaymentOrder = append(c.inters.LockPaymentOrder, interceptors...)
+}
+
+// Create returns a builder for creating a LockPaymentOrder entity.
+func (c *LockPaymentOrderClient) Create() *LockPaymentOrderCreate {
+	mutation := newLockPaymentOrderMutation(c.config, OpCreate)
+	return &LockPaymentOrderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LockPaymentOrder entities.
+func (c *LockPaymentOrderClient) CreateBulk(builders ...*LockPaymentOrderCreate) *LockPaymentOrderCreateBulk {
+	return &LockPaymentOrderCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LockPaymentOrderClient) MapCreateBulk(slice any, setFunc func(*LockPaymentOrderCreate, int)) *LockPaymentOrderCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LockPaymentOrderCreateBulk{err: fmt.Errorf("calling to LockPaymentOrderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LockPaymentOrderCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LockPaymentOrderCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LockPaymentOrder.
+func (c *LockPaymentOrderClient) Update() *LockPaymentOrderUpdate {
+	mutation := newLockPaymentOrderMutation(c.config, OpUpdate)
+	return &LockPaymentOrderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LockPaymentOrderClient) UpdateOne(lpo *LockPaymentOrder) *LockPaymentOrderUpdateOne {
+	mutation := newLockPaymentOrderMutation(c.config, OpUpdateOne, withLockPaymentOrder(lpo))
+	return &LockPaymentOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LockPaymentOrderClient) UpdateOneID(id uuid.UUID) *LockPaymentOrderUpdateOne {
+	mutation := newLockPaymentOrderMutation(c.config, OpUpdateOne, withLockPaymentOrderID(id))
+	return &LockPaymentOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LockPaymentOrder.
+func (c *LockPaymentOrderClient) Delete() *LockPaymentOrderDelete {
+	mutation := newLockPaymentOrderMutation(c.config, OpDelete)
+	return &LockPaymentOrderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LockPaymentOrderClient) DeleteOne(lpo *LockPaymentOrder) *LockPaymentOrderDeleteOne {
+	return c.DeleteOneID(lpo.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LockPaymentOrderClient) DeleteOneID(id uuid.UUID) *LockPaymentOrderDeleteOne {
+	builder := c.Delete().Where(lockpaymentorder.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LockPaymentOrderDeleteOne{builder}
+}
+
+// Query returns a query builder for LockPaymentOrder.
+func (c *LockPaymentOrderClient) Query() *LockPaymentOrderQuery {
+	return &LockPaymentOrderQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLockPaymentOrder},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LockPaymentOrder entity by its id.
+func (c *LockPaymentOrderClient) Get(ctx context.Context, id uuid.UUID) (*LockPaymentOrder, error) {
+	return c.Query().Where(lockpaymentorder.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LockPaymentOrderClient) GetX(ctx context.Context, id uuid.UUID) *LockPaymentOrder {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryToken queries the token edge of a LockPaymentOrder.
+func (c *LockPaymentOrderClient) QueryToken(lpo *LockPaymentOrder) *TokenQuery {
+	query := (&TokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lpo.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lockpaymentorder.Table, lockpaymentorder.FieldID, id),
+			sqlgraph.To(token.Table, token.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, lockpaymentorder.TokenTable, lockpaymentorder.TokenColumn),
+		)
+		fromV = sqlgraph.Neighbors(lpo.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProvisionBucket queries the provision_bucket edge of a LockPaymentOrder.
+func (c *LockPaymentOrderClient) QueryProvisionBucket(lpo *LockPaymentOrder) *ProvisionBucketQuery {
+	query := (&ProvisionBucketClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lpo.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lockpaymentorder.Table, lockpaymentorder.FieldID, id),
+			sqlgraph.To(provisionbucket.Table, provisionbucket.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, lockpaymentorder.ProvisionBucketTable, lockpaymentorder.ProvisionBucketColumn),
+		)
+		fromV = sqlgraph.Neighbors(lpo.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProvider queries the provider edge of a LockPaymentOrder.
+func (c *LockPaymentOrderClient) QueryProvider(lpo *LockPaymentOrder) *ProviderProfileQuery {
+	query := (&ProviderProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lpo.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lockpaymentorder.Table, lockpaymentorder.FieldID, id),
+			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, lockpaymentorder.ProviderTable, lockpaymentorder.ProviderColumn),
+		)
+		fromV = sqlgraph.Neighbors(lpo.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFulfillments queries the fulfillments edge of a LockPaymentOrder.
+func (c *LockPaymentOrderClient) QueryFulfillments(lpo *LockPaymentOrder) *LockOrderFulfillmentQuery {
+	query := (&LockOrderFulfillmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lpo.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lockpaymentorder.Table, lockpaymentorder.FieldID, id),
+			sqlgraph.To(lockorderfulfillment.Table, lockorderfulfillment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, lockpaymentorder.FulfillmentsTable, lockpaymentorder.FulfillmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(lpo.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTransactions queries the transactions edge of a LockPaymentOrder.
+func (c *LockPaymentOrderClient) QueryTransactions(lpo *LockPaymentOrder) *TransactionLogQuery {
+	query := (&TransactionLogClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lpo.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lockpaymentorder.Table, lockpaymentorder.FieldID, id),
+			sqlgraph.To(transactionlog.Table, transactionlog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, lockpaymentorder.TransactionsTable, lockpaymentorder.TransactionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(lpo.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReassignments queries the reassignments edge of a LockPaymentOrder.
+func (c *LockPaymentOrderClient) QueryReassignments(lpo *LockPaymentOrder) *LockOrderReassignmentQuery {
+	query := (&LockOrderReassignmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lpo.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lockpaymentorder.Table, lockpaymentorder.FieldID, id),
+			sqlgraph.To(lockorderreassignment.Table, lockorderreassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, lockpaymentorder.ReassignmentsTable, lockpaymentorder.ReassignmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(lpo.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParent queries the parent edge of a LockPaymentOrder.
+func (c *LockPaymentOrderClient) QueryParent(lpo *LockPaymentOrder) *LockPaymentOrderQuery {
+	query := (&LockPaymentOrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lpo.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lockpaymentorder.Table, lockpaymentorder.FieldID, id),
+			sqlgraph.To(lockpaymentorder.Table, lockpaymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, lockpaymentorder.ParentTable, lockpaymentorder.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(lpo.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChildren queries the children edge of a LockPaymentOrder.
+func (c *LockPaymentOrderClient) QueryChildren(lpo *LockPaymentOrder) *LockPaymentOrderQuery {
+	query := (&LockPaymentOrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lpo.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lockpaymentorder.Table, lockpaymentorder.FieldID, id),
+			sqlgraph.To(lockpaymentorder.Table, lockpaymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, lockpaymentorder.ChildrenTable, lockpaymentorder.ChildrenColumn),
+		)
+		fromV = sqlgraph.Neighbors(lpo.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LockPaymentOrderClient) Hooks() []Hook {
+	return c.hooks.LockPaymentOrder
+}
+
+// Interceptors returns the client interceptors.
+func (c *LockPaymentOrderClient) Interceptors() []Interceptor {
+	return c.inters.LockPaymentOrder
+}
+
+func (c *LockPaymentOrderClient) mutate(ctx context.Context, m *LockPaymentOrderMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LockPaymentOrderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LockPaymentOrderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LockPaymentOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LockPaymentOrderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LockPaymentOrder mutation op: %q", m.Op())
+	}
+}
+
+// NetworkClient is a client for the Network schema.
+type NetworkClient struct {
+	config
+}
+
+// NewNetworkClient returns a client for the Network from the given config.
+func NewNetworkClient(c config) *NetworkClient {
+	return &NetworkClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `network.Hooks(f(g(h())))`.
+func (c *NetworkClient) Use(hooks ...Hook) {
+	c.hooks.Network = append(c.hooks.Network, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `network.Intercept(f(g(h())))`.
+func (c *NetworkClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Network = append(c.inters.Network, interceptors...)
+}
+
+// Create returns a builder for creating a Network entity.
+func (c *NetworkClient) Create() *NetworkCreate {
+	mutation := newNetworkMutation(c.config, OpCreate)
+	return &NetworkCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Network entities.
+func (c *NetworkClient) CreateBulk(builders ...*NetworkCreate) *NetworkCreateBulk {
+	return &NetworkCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NetworkClient) MapCreateBulk(slice any, setFunc func(*NetworkCreate, int)) *NetworkCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NetworkCreateBulk{err: fmt.Errorf("calling to NetworkClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NetworkCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NetworkCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Network.
+func (c *NetworkClient) Update() *NetworkUpdate {
+	mutation := newNetworkMutation(c.config, OpUpdate)
+	return &NetworkUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NetworkClient) UpdateOne(n *Network) *NetworkUpdateOne {
+	mutation := newNetworkMutation(c.config, OpUpdateOne, withNetwork(n))
+	return &NetworkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NetworkClient) UpdateOneID(id int) *NetworkUpdateOne {
+	mutation := newNetworkMutation(c.config, OpUpdateOne, withNetworkID(id))
+	return &NetworkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Network.
+func (c *NetworkClient) Delete() *NetworkDelete {
+	mutation := newNetworkMutation(c.config, OpDelete)
+	return &NetworkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NetworkClient) DeleteOne(n *Network) *NetworkDeleteOne {
+	return c.DeleteOneID(n.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NetworkClient) DeleteOneID(id int) *NetworkDeleteOne {
+	builder := c.Delete().Where(network.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NetworkDeleteOne{builder}
+}
+
+// Query returns a query builder for Network.
+func (c *NetworkClient) Query() *NetworkQuery {
+	return &NetworkQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNetwork},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Network entity by its id.
+func (c *NetworkClient) Get(ctx context.Context, id int) (*Network, error) {
+	return c.Query().Where(network.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NetworkClient) GetX(ctx context.Context, id int) *Network {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTokens queries the tokens edge of a Network.
+func (c *NetworkClient) QueryTokens(n *Network) *TokenQuery {
+	query := (&TokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := n.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(network.Table, network.FieldID, id),
+			sqlgraph.To(token.Table, token.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, network.TokensTable, network.TokensColumn),
+		)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPaymentWebhook queries the payment_webhook edge of a Network.
+func (c *NetworkClient) QueryPaymentWebhook(n *Network) *PaymentWebhookQuery {
+	query := (&PaymentWebhookClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := n.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(network.Table, network.FieldID, id),
+			sqlgraph.To(paymentwebhook.Table, paymentwebhook.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, network.PaymentWebhookTable, network.PaymentWebhookColumn),
+		)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRPCEndpoints queries the rpc_endpoints edge of a Network.
+func (c *NetworkClient) QueryRPCEndpoints(n *Network) *RPCEndpointQuery {
+	query := (&RPCEndpointClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := n.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(network.Table, network.FieldID, id),
+			sqlgraph.To(rpcendpoint.Table, rpcendpoint.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, network.RPCEndpointsTable, network.RPCEndpointsColumn),
+		)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *NetworkClient) Hooks() []Hook {
+	return c.hooks.Network
+}
+
+// Interceptors returns the client interceptors.
+func (c *NetworkClient) Interceptors() []Interceptor {
+	return c.inters.Network
+}
+
+func (c *NetworkClient) mutate(ctx context.Context, m *NetworkMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NetworkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NetworkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NetworkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NetworkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Network mutation op: %q", m.Op())
+	}
+}
+
+// OutboxTransactionClient is a client for the OutboxTransaction schema.
+type OutboxTransactionClient struct {
+	config
+}
+
+// NewOutboxTransactionClient returns a client for the OutboxTransaction from the given config.
+func NewOutboxTransactionClient(c config) *OutboxTransactionClient {
+	return &OutboxTransactionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `outboxtransaction.Hooks(f(g(h())))`.
+func (c *OutboxTransactionClient) Use(hooks ...Hook) {
+	c.hooks.OutboxTransaction = append(c.hooks.OutboxTransaction, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `outboxtransaction.Intercept(f(g(h())))`.
+func (c *OutboxTransactionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OutboxTransaction = append(c.inters.OutboxTransaction, interceptors...)
+}
+
+// Create returns a builder for creating a OutboxTransaction entity.
+func (c *OutboxTransactionClient) Create() *OutboxTransactionCreate {
+	mutation := newOutboxTransactionMutation(c.config, OpCreate)
+	return &OutboxTransactionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OutboxTransaction entities.
+func (c *OutboxTransactionClient) CreateBulk(builders ...*OutboxTransactionCreate) *OutboxTransactionCreateBulk {
+	return &OutboxTransactionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OutboxTransactionClient) MapCreateBulk(slice any, setFunc func(*OutboxTransactionCreate, int)) *OutboxTransactionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OutboxTransactionCreateBulk{err: fmt.Errorf("calling to OutboxTransactionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OutboxTransactionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OutboxTransactionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OutboxTransaction.
+func (c *OutboxTransactionClient) Update() *OutboxTransactionUpdate {
+	mutation := newOutboxTransactionMutation(c.config, OpUpdate)
+	return &OutboxTransactionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OutboxTransactionClient) UpdateOne(ot *OutboxTransaction) *OutboxTransactionUpdateOne {
+	mutation := newOutboxTransactionMutation(c.config, OpUpdateOne, withOutboxTransaction(ot))
+	return &OutboxTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OutboxTransactionClient) UpdateOneID(id uuid.UUID) *OutboxTransactionUpdateOne {
+	mutation := newOutboxTransactionMutation(c.config, OpUpdateOne, withOutboxTransactionID(id))
+	return &OutboxTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OutboxTransaction.
+func (c *OutboxTransactionClient) Delete() *OutboxTransactionDelete {
+	mutation := newOutboxTransactionMutation(c.config, OpDelete)
+	return &OutboxTransactionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OutboxTransactionClient) DeleteOne(ot *OutboxTransaction) *OutboxTransactionDeleteOne {
+	return c.DeleteOneID(ot.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OutboxTransactionClient) DeleteOneID(id uuid.UUID) *OutboxTransactionDeleteOne {
+	builder := c.Delete().Where(outboxtransaction.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OutboxTransactionDeleteOne{builder}
+}
+
+// Query returns a query builder for OutboxTransaction.
+func (c *OutboxTransactionClient) Query() *OutboxTransactionQuery {
+	return &OutboxTransactionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOutboxTransaction},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OutboxTransaction entity by its id.
+func (c *OutboxTransactionClient) Get(ctx context.Context, id uuid.UUID) (*OutboxTransaction, error) {
+	return c.Query().Where(outboxtransaction.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OutboxTransactionClient) GetX(ctx context.Context, id uuid.UUID) *OutboxTransaction {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *OutboxTransactionClient) Hooks() []Hook {
+	return c.hooks.OutboxTransaction
+}
+
+// Interceptors returns the client interceptors.
+func (c *OutboxTransactionClient) Interceptors() []Interceptor {
+	return c.inters.OutboxTransaction
+}
+
+func (c *OutboxTransactionClient) mutate(ctx context.Context, m *OutboxTransactionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OutboxTransactionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OutboxTransactionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OutboxTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OutboxTransactionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OutboxTransaction mutation op: %q", m.Op())
+	}
+}
+
+// PaymentOrderClient is a client for the PaymentOrder schema.
+type PaymentOrderClient struct {
+	config
+}
+
+// NewPaymentOrderClient returns a client for the PaymentOrder from the given config.
+func NewPaymentOrderClient(c config) *PaymentOrderClient {
+	return &PaymentOrderClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `paymentorder.Hooks(f(g(h())))`.
+func (c *PaymentOrderClient) Use(hooks ...Hook) {
+	c.hooks.PaymentOrder = append(c.hooks.PaymentOrder, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `paymentorder.Intercept(f(g(h())))`.
+func (c *PaymentOrderClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PaymentOrder = append(c.inters.PaymentOrder, interceptors...)
+}
+
+// Create returns a builder for creating a PaymentOrder entity.
+func (c *PaymentOrderClient) Create() *PaymentOrderCreate {
+	mutation := newPaymentOrderMutation(c.config, OpCreate)
+	return &PaymentOrderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PaymentOrder entities.
+func (c *PaymentOrderClient) CreateBulk(builders ...*PaymentOrderCreate) *PaymentOrderCreateBulk {
+	return &PaymentOrderCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PaymentOrderClient) MapCreateBulk(slice any, setFunc func(*PaymentOrderCreate, int)) *PaymentOrderCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PaymentOrderCreateBulk{err: fmt.Errorf("calling to PaymentOrderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PaymentOrderCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PaymentOrderCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PaymentOrder.
+func (c *PaymentOrderClient) Update() *PaymentOrderUpdate {
+	mutation := newPaymentOrderMutation(c.config, OpUpdate)
+	return &PaymentOrderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PaymentOrderClient) UpdateOne(po *PaymentOrder) *PaymentOrderUpdateOne {
+	mutation := newPaymentOrderMutation(c.config, OpUpdateOne, withPaymentOrder(po))
+	return &PaymentOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PaymentOrderClient) UpdateOneID(id uuid.UUID) *PaymentOrderUpdateOne {
+	mutation := newPaymentOrderMutation(c.config, OpUpdateOne, withPaymentOrderID(id))
+	return &PaymentOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PaymentOrder.
+func (c *PaymentOrderClient) Delete() *PaymentOrderDelete {
+	mutation := newPaymentOrderMutation(c.config, OpDelete)
+	return &PaymentOrderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PaymentOrderClient) DeleteOne(po *PaymentOrder) *PaymentOrderDeleteOne {
+	return c.DeleteOneID(po.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PaymentOrderClient) DeleteOneID(id uuid.UUID) *PaymentOrderDeleteOne {
+	builder := c.Delete().Where(paymentorder.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PaymentOrderDeleteOne{builder}
+}
+
+// Query returns a query builder for PaymentOrder.
+func (c *PaymentOrderClient) Query() *PaymentOrderQuery {
+	return &PaymentOrderQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePaymentOrder},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PaymentOrder entity by its id.
+func (c *PaymentOrderClient) Get(ctx context.Context, id uuid.UUID) (*PaymentOrder, error) {
+	return c.Query().Where(paymentorder.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PaymentOrderClient) GetX(ctx context.Context, id uuid.UUID) *PaymentOrder {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySenderProfile queries the sender_profile edge of a PaymentOrder.
+func (c *PaymentOrderClient) QuerySenderProfile(po *PaymentOrder) *SenderProfileQuery {
+	query := (&SenderProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, id),
+			sqlgraph.To(senderprofile.Table, senderprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, paymentorder.SenderProfileTable, paymentorder.SenderProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryToken queries the token edge of a PaymentOrder.
+func (c *PaymentOrderClient) QueryToken(po *PaymentOrder) *TokenQuery {
+	query := (&TokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, id),
+			sqlgraph.To(token.Table, token.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, paymentorder.TokenTable, paymentorder.TokenColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLinkedAddress queries the linked_address edge of a PaymentOrder.
+func (c *PaymentOrderClient) QueryLinkedAddress(po *PaymentOrder) *LinkedAddressQuery {
+	query := (&LinkedAddressClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, id),
+			sqlgraph.To(linkedaddress.Table, linkedaddress.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, paymentorder.LinkedAddressTable, paymentorder.LinkedAddressColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReceiveAddress queries the receive_address edge of a PaymentOrder.
+func (c *PaymentOrderClient) QueryReceiveAddress(po *PaymentOrder) *ReceiveAddressQuery {
+	query := (&ReceiveAddressClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, id),
+			sqlgraph.To(receiveaddress.Table, receiveaddress.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, paymentorder.ReceiveAddressTable, paymentorder.ReceiveAddressColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRecipient queries the recipient edge of a PaymentOrder.
+func (c *PaymentOrderClient) QueryRecipient(po *PaymentOrder) *PaymentOrderRecipientQuery {
+	query := (&PaymentOrderRecipientClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, id),
+			sqlgraph.To(paymentorderrecipient.Table, paymentorderrecipient.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, paymentorder.RecipientTable, paymentorder.RecipientColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTransactions queries the transactions edge of a PaymentOrder.
+func (c *PaymentOrderClient) QueryTransactions(po *PaymentOrder) *TransactionLogQuery {
+	query := (&TransactionLogClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, id),
+			sqlgraph.To(transactionlog.Table, transactionlog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, paymentorder.TransactionsTable, paymentorder.TransactionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPaymentWebhook queries the payment_webhook edge of a PaymentOrder.
+func (c *PaymentOrderClient) QueryPaymentWebhook(po *PaymentOrder) *PaymentWebhookQuery {
+	query := (&PaymentWebhookClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, id),
+			sqlgraph.To(paymentwebhook.Table, paymentwebhook.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, paymentorder.PaymentWebhookTable, paymentorder.PaymentWebhookColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRefundSweep queries the refund_sweep edge of a PaymentOrder.
+func (c *PaymentOrderClient) QueryRefundSweep(po *PaymentOrder) *SweepQuery {
+	query := (&SweepClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, id),
+			sqlgraph.To(sweep.Table, sweep.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, paymentorder.RefundSweepTable, paymentorder.RefundSweepColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDepositSplit queries the deposit_split edge of a PaymentOrder.
+func (c *PaymentOrderClient) QueryDepositSplit(po *PaymentOrder) *DepositSplitQuery {
+	query := (&DepositSplitClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, id),
+			sqlgraph.To(depositsplit.Table, depositsplit.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, paymentorder.DepositSplitTable, paymentorder.DepositSplitColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLedgerEntries queries the ledger_entries edge of a PaymentOrder.
+func (c *PaymentOrderClient) QueryLedgerEntries(po *PaymentOrder) *LedgerEntryQuery {
+	query := (&LedgerEntryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, id),
+			sqlgraph.To(ledgerentry.Table, ledgerentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, paymentorder.LedgerEntriesTable, paymentorder.LedgerEntriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReconciliationDiscrepancies queries the reconciliation_discrepancies edge of a PaymentOrder.
+func (c *PaymentOrderClient) QueryReconciliationDiscrepancies(po *PaymentOrder) *ReconciliationDiscrepancyQuery {
+	query := (&ReconciliationDiscrepancyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, id),
+			sqlgraph.To(reconciliationdiscrepancy.Table, reconciliationdiscrepancy.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, paymentorder.ReconciliationDiscrepanciesTable, paymentorder.ReconciliationDiscrepanciesColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUnmatchedDeposits queries the unmatched_deposits edge of a PaymentOrder.
+func (c *PaymentOrderClient) QueryUnmatchedDeposits(po *PaymentOrder) *UnmatchedDepositQuery {
+	query := (&UnmatchedDepositClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, id),
+			sqlgraph.To(unmatcheddeposit.Table, unmatcheddeposit.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, paymentorder.UnmatchedDepositsTable, paymentorder.UnmatchedDepositsColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAddressAssignments queries the address_assignments edge of a PaymentOrder.
+func (c *PaymentOrderClient) QueryAddressAssignments(po *PaymentOrder) *ReceiveAddressAssignmentQuery {
+	query := (&ReceiveAddressAssignmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, id),
+			sqlgraph.To(receiveaddressassignment.Table, receiveaddressassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, paymentorder.AddressAssignmentsTable, paymentorder.AddressAssignmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PaymentOrderClient) Hooks() []Hook {
+	return c.hooks.PaymentOrder
+}
+
+// Interceptors returns the client interceptors.
+func (c *PaymentOrderClient) Interceptors() []Interceptor {
+	return c.inters.PaymentOrder
+}
+
+func (c *PaymentOrderClient) mutate(ctx context.Context, m *PaymentOrderMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PaymentOrderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PaymentOrderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PaymentOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PaymentOrderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PaymentOrder mutation op: %q", m.Op())
+	}
+}
+
+// PaymentOrderRecipientClient is a client for the PaymentOrderRecipient schema.
+type PaymentOrderRecipientClient struct {
+	config
+}
+
+// NewPaymentOrderRecipientClient returns a client for the PaymentOrderRecipient from the given config.
+func NewPaymentOrderRecipientClient(c config) *PaymentOrderRecipientClient {
+	return &PaymentOrderRecipientClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `paymentorderrecipient.Hooks(f(g(h())))`.
+func (c *PaymentOrderRecipientClient) Use(hooks ...Hook) {
+	c.hooks.PaymentOrderRecipient = append(c.hooks.PaymentOrderRecipient, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `paymentorderrecipient.Intercept(f(g(h())))`.
+func (c *PaymentOrderRecipientClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PaymentOrderRecipient = append(c.inters.PaymentOrderRecipient, interceptors...)
+}
+
+// Create returns a builder for creating a PaymentOrderRecipient entity.
+func (c *PaymentOrderRecipientClient) Create() *PaymentOrderRecipientCreate {
+	mutation := newPaymentOrderRecipientMutation(c.config, OpCreate)
+	return &PaymentOrderRecipientCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PaymentOrderRecipient entities.
+func (c *PaymentOrderRecipientClient) CreateBulk(builders ...*PaymentOrderRecipientCreate) *PaymentOrderRecipientCreateBulk {
+	return &PaymentOrderRecipientCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PaymentOrderRecipientClient) MapCreateBulk(slice any, setFunc func(*PaymentOrderRecipientCreate, int)) *PaymentOrderRecipientCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PaymentOrderRecipientCreateBulk{err: fmt.Errorf("calling to PaymentOrderRecipientClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PaymentOrderRecipientCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PaymentOrderRecipientCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PaymentOrderRecipient.
+func (c *PaymentOrderRecipientClient) Update() *PaymentOrderRecipientUpdate {
+	mutation := newPaymentOrderRecipientMutation(c.config, OpUpdate)
+	return &PaymentOrderRecipientUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PaymentOrderRecipientClient) UpdateOne(por *PaymentOrderRecipient) *PaymentOrderRecipientUpdateOne {
+	mutation := newPaymentOrderRecipientMutation(c.config, OpUpdateOne, withPaymentOrderRecipient(por))
+	return &PaymentOrderRecipientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PaymentOrderRecipientClient) UpdateOneID(id int) *PaymentOrderRecipientUpdateOne {
+	mutation := newPaymentOrderRecipientMutation(c.config, OpUpdateOne, withPaymentOrderRecipientID(id))
+	return &PaymentOrderRecipientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PaymentOrderRecipient.
+func (c *PaymentOrderRecipientClient) Delete() *PaymentOrderRecipientDelete {
+	mutation := newPaymentOrderRecipientMutation(c.config, OpDelete)
+	return &PaymentOrderRecipientDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PaymentOrderRecipientClient) DeleteOne(por *PaymentOrderRecipient) *PaymentOrderRecipientDeleteOne {
+	return c.DeleteOneID(por.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PaymentOrderRecipientClient) DeleteOneID(id int) *PaymentOrderRecipientDeleteOne {
+	builder := c.Delete().Where(paymentorderrecipient.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PaymentOrderRecipientDeleteOne{builder}
+}
+
+// Query returns a query builder for PaymentOrderRecipient.
+func (c *PaymentOrderRecipientClient) Query() *PaymentOrderRecipientQuery {
+	return &PaymentOrderRecipientQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePaymentOrderRecipient},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PaymentOrderRecipient entity by its id.
+func (c *PaymentOrderRecipientClient) Get(ctx context.Context, id int) (*PaymentOrderRecipient, error) {
+	return c.Query().Where(paymentorderrecipient.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PaymentOrderRecipientClient) GetX(ctx context.Context, id int) *PaymentOrderRecipient {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPaymentOrder queries the payment_order edge of a PaymentOrderRecipient.
+func (c *PaymentOrderRecipientClient) QueryPaymentOrder(por *PaymentOrderRecipient) *PaymentOrderQuery {
+	query := (&PaymentOrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := por.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentorderrecipient.Table, paymentorderrecipient.FieldID, id),
+			sqlgraph.To(paymentorder.Table, paymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, paymentorderrecipient.PaymentOrderTable, paymentorderrecipient.PaymentOrderColumn),
+		)
+		fromV = sqlgraph.Neighbors(por.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PaymentOrderRecipientClient) Hooks() []Hook {
+	return c.hooks.PaymentOrderRecipient
+}
+
+// Interceptors returns the client interceptors.
+func (c *PaymentOrderRecipientClient) Interceptors() []Interceptor {
+	return c.inters.PaymentOrderRecipient
+}
+
+func (c *PaymentOrderRecipientClient) mutate(ctx context.Context, m *PaymentOrderRecipientMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PaymentOrderRecipientCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PaymentOrderRecipientUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PaymentOrderRecipientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PaymentOrderRecipientDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PaymentOrderRecipient mutation op: %q", m.Op())
+	}
+}
+
+// PaymentWebhookClient is a client for the PaymentWebhook schema.
+type PaymentWebhookClient struct {
+	config
+}
+
+// NewPaymentWebhookClient returns a client for the PaymentWebhook from the given config.
+func NewPaymentWebhookClient(c config) *PaymentWebhookClient {
+	return &PaymentWebhookClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `paymentwebhook.Hooks(f(g(h())))`.
+func (c *PaymentWebhookClient) Use(hooks ...Hook) {
+	c.hooks.PaymentWebhook = append(c.hooks.PaymentWebhook, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `paymentwebhook.Intercept(f(g(h())))`.
+func (c *PaymentWebhookClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PaymentWebhook = append(c.inters.PaymentWebhook, interceptors...)
+}
+
+// Create returns a builder for creating a PaymentWebhook entity.
+func (c *PaymentWebhookClient) Create() *PaymentWebhookCreate {
+	mutation := newPaymentWebhookMutation(c.config, OpCreate)
+	return &PaymentWebhookCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PaymentWebhook entities.
+func (c *PaymentWebhookClient) CreateBulk(builders ...*PaymentWebhookCreate) *PaymentWebhookCreateBulk {
+	return &PaymentWebhookCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PaymentWebhookClient) MapCreateBulk(slice any, setFunc func(*PaymentWebhookCreate, int)) *PaymentWebhookCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PaymentWebhookCreateBulk{err: fmt.Errorf("calling to PaymentWebhookClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PaymentWebhookCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PaymentWebhookCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PaymentWebhook.
+func (c *PaymentWebhookClient) Update() *PaymentWebhookUpdate {
+	mutation := newPaymentWebhookMutation(c.config, OpUpdate)
+	return &PaymentWebhookUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PaymentWebhookClient) UpdateOne(pw *PaymentWebhook) *PaymentWebhookUpdateOne {
+	mutation := newPaymentWebhookMutation(c.config, OpUpdateOne, withPaymentWebhook(pw))
+	return &PaymentWebhookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PaymentWebhookClient) UpdateOneID(id uuid.UUID) *PaymentWebhookUpdateOne {
+	mutation := newPaymentWebhookMutation(c.config, OpUpdateOne, withPaymentWebhookID(id))
+	return &PaymentWebhookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PaymentWebhook.
+func (c *PaymentWebhookClient) Delete() *PaymentWebhookDelete {
+	mutation := newPaymentWebhookMutation(c.config, OpDelete)
+	return &PaymentWebhookDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PaymentWebhookClient) DeleteOne(pw *PaymentWebhook) *PaymentWebhookDeleteOne {
+	return c.DeleteOneID(pw.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PaymentWebhookClient) DeleteOneID(id uuid.UUID) *PaymentWebhookDeleteOne {
+	builder := c.Delete().Where(paymentwebhook.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PaymentWebhookDeleteOne{builder}
+}
+
+// Query returns a query builder for PaymentWebhook.
+func (c *PaymentWebhookClient) Query() *PaymentWebhookQuery {
+	return &PaymentWebhookQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePaymentWebhook},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PaymentWebhook entity by its id.
+func (c *PaymentWebhookClient) Get(ctx context.Context, id uuid.UUID) (*PaymentWebhook, error) {
+	return c.Query().Where(paymentwebhook.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PaymentWebhookClient) GetX(ctx context.Context, id uuid.UUID) *PaymentWebhook {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPaymentOrder queries the payment_order edge of a PaymentWebhook.
+func (c *PaymentWebhookClient) QueryPaymentOrder(pw *PaymentWebhook) *PaymentOrderQuery {
+	query := (&PaymentOrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pw.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentwebhook.Table, paymentwebhook.FieldID, id),
+			sqlgraph.To(paymentorder.Table, paymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, paymentwebhook.PaymentOrderTable, paymentwebhook.PaymentOrderColumn),
+		)
+		fromV = sqlgraph.Neighbors(pw.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNetwork queries the network edge of a PaymentWebhook.
+func (c *PaymentWebhookClient) QueryNetwork(pw *PaymentWebhook) *NetworkQuery {
+	query := (&NetworkClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pw.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentwebhook.Table, paymentwebhook.FieldID, id),
+			sqlgraph.To(network.Table, network.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, paymentwebhook.NetworkTable, paymentwebhook.NetworkColumn),
+		)
+		fromV = sqlgraph.Neighbors(pw.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PaymentWebhookClient) Hooks() []Hook {
+	return c.hooks.PaymentWebhook
+}
+
+// Interceptors returns the client interceptors.
+func (c *PaymentWebhookClient) Interceptors() []Interceptor {
+	return c.inters.PaymentWebhook
+}
+
+func (c *PaymentWebhookClient) mutate(ctx context.Context, m *PaymentWebhookMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PaymentWebhookCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PaymentWebhookUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PaymentWebhookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PaymentWebhookDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PaymentWebhook mutation op: %q", m.Op())
+	}
+}
+
+// ProviderBalanceSnapshotClient is a client for the ProviderBalanceSnapshot schema.
+type ProviderBalanceSnapshotClient struct {
+	config
+}
+
+// NewProviderBalanceSnapshotClient returns a client for the ProviderBalanceSnapshot from the given config.
+func NewProviderBalanceSnapshotClient(c config) *ProviderBalanceSnapshotClient {
+	return &ProviderBalanceSnapshotClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `providerbalancesnapshot.Hooks(f(g(h())))`.
+func (c *ProviderBalanceSnapshotClient) Use(hooks ...Hook) {
+	c.hooks.ProviderBalanceSnapshot = append(c.hooks.ProviderBalanceSnapshot, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `providerbalancesnapshot.Intercept(f(g(h())))`.
+func (c *ProviderBalanceSnapshotClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProviderBalanceSnapshot = append(c.inters.ProviderBalanceSnapshot, interceptors...)
+}
+
+// Create returns a builder for creating a ProviderBalanceSnapshot entity.
+func (c *ProviderBalanceSnapshotClient) Create() *ProviderBalanceSnapshotCreate {
+	mutation := newProviderBalanceSnapshotMutation(c.config, OpCreate)
+	return &ProviderBalanceSnapshotCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProviderBalanceSnapshot entities.
+func (c *ProviderBalanceSnapshotClient) CreateBulk(builders ...*ProviderBalanceSnapshotCreate) *ProviderBalanceSnapshotCreateBulk {
+	return &ProviderBalanceSnapshotCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProviderBalanceSnapshotClient) MapCreateBulk(slice any, setFunc func(*ProviderBalanceSnapshotCreate, int)) *ProviderBalanceSnapshotCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProviderBalanceSnapshotCreateBulk{err: fmt.Errorf("calling to ProviderBalanceSnapshotClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProviderBalanceSnapshotCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProviderBalanceSnapshotCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProviderBalanceSnapshot.
+func (c *ProviderBalanceSnapshotClient) Update() *ProviderBalanceSnapshotUpdate {
+	mutation := newProviderBalanceSnapshotMutation(c.config, OpUpdate)
+	return &ProviderBalanceSnapshotUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProviderBalanceSnapshotClient) UpdateOne(pbs *ProviderBalanceSnapshot) *ProviderBalanceSnapshotUpdateOne {
+	mutation := newProviderBalanceSnapshotMutation(c.config, OpUpdateOne, withProviderBalanceSnapshot(pbs))
+	return &ProviderBalanceSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProviderBalanceSnapshotClient) UpdateOneID(id uuid.UUID) *ProviderBalanceSnapshotUpdateOne {
+	mutation := newProviderBalanceSnapshotMutation(c.config, OpUpdateOne, withProviderBalanceSnapshotID(id))
+	return &ProviderBalanceSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ProviderBalanceSnapshot.
+func (c *ProviderBalanceSnapshotClient) Delete() *ProviderBalanceSnapshotDelete {
+	mutation := newProviderBalanceSnapshotMutation(c.config, OpDelete)
+	return &ProviderBalanceSnapshotDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProviderBalanceSnapshotClient) DeleteOne(pbs *ProviderBalanceSnapshot) *ProviderBalanceSnapshotDeleteOne {
+	return c.DeleteOneID(pbs.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProviderBalanceSnapshotClient) DeleteOneID(id uuid.UUID) *ProviderBalanceSnapshotDeleteOne {
+	builder := c.Delete().Where(providerbalancesnapshot.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProviderBalanceSnapshotDeleteOne{builder}
+}
+
+// Query returns a query builder for ProviderBalanceSnapshot.
+func (c *ProviderBalanceSnapshotClient) Query() *ProviderBalanceSnapshotQuery {
+	return &ProviderBalanceSnapshotQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProviderBalanceSnapshot},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ProviderBalanceSnapshot entity by its id.
+func (c *ProviderBalanceSnapshotClient) Get(ctx context.Context, id uuid.UUID) (*ProviderBalanceSnapshot, error) {
+	return c.Query().Where(providerbalancesnapshot.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProviderBalanceSnapshotClient) GetX(ctx context.Context, id uuid.UUID) *ProviderBalanceSnapshot {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProvider queries the provider edge of a ProviderBalanceSnapshot.
+func (c *ProviderBalanceSnapshotClient) QueryProvider(pbs *ProviderBalanceSnapshot) *ProviderProfileQuery {
+	query := (&ProviderProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pbs.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerbalancesnapshot.Table, providerbalancesnapshot.FieldID, id),
+			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, providerbalancesnapshot.ProviderTable, providerbalancesnapshot.ProviderColumn),
+		)
+		fromV = sqlgraph.Neighbors(pbs.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ProviderBalanceSnapshotClient) Hooks() []Hook {
+	return c.hooks.ProviderBalanceSnapshot
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProviderBalanceSnapshotClient) Interceptors() []Interceptor {
+	return c.inters.ProviderBalanceSnapshot
+}
+
+func (c *ProviderBalanceSnapshotClient) mutate(ctx context.Context, m *ProviderBalanceSnapshotMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProviderBalanceSnapshotCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProviderBalanceSnapshotUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProviderBalanceSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProviderBalanceSnapshotDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ProviderBalanceSnapshot mutation op: %q", m.Op())
+	}
+}
+
+// ProviderCurrenciesClient is a client for the ProviderCurrencies schema.
+type ProviderCurrenciesClient struct {
+	config
+}
+
+// NewProviderCurrenciesClient returns a client for the ProviderCurrencies from the given config.
+func NewProviderCurrenciesClient(c config) *ProviderCurrenciesClient {
+	return &ProviderCurrenciesClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `providercurrencies.Hooks(f(g(h())))`.
+func (c *ProviderCurrenciesClient) Use(hooks ...Hook) {
+	c.hooks.ProviderCurrencies = append(c.hooks.ProviderCurrencies, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `providercurrencies.Intercept(f(g(h())))`.
+func (c *ProviderCurrenciesClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProviderCurrencies = append(c.inters.ProviderCurrencies, interceptors...)
+}
+
+// Create returns a builder for creating a ProviderCurrencies entity.
+func (c *ProviderCurrenciesClient) Create() *ProviderCurrenciesCreate {
+	mutation := newProviderCurrenciesMutation(c.config, OpCreate)
+	return &ProviderCurrenciesCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProviderCurrencies entities.
+func (c *ProviderCurrenciesClient) CreateBulk(builders ...*ProviderCurrenciesCreate) *ProviderCurrenciesCreateBulk {
+	return &ProviderCurrenciesCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProviderCurrenciesClient) MapCreateBulk(slice any, setFunc func(*ProviderCurrenciesCreate, int)) *ProviderCurrenciesCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProviderCurrenciesCreateBulk{err: fmt.Errorf("calling to ProviderCurrenciesClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProviderCurrenciesCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProviderCurrenciesCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProviderCurrencies.
+func (c *ProviderCurrenciesClient) Update() *ProviderCurrenciesUpdate {
+	mutation := newProviderCurrenciesMutation(c.config, OpUpdate)
+	return &ProviderCurrenciesUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProviderCurrenciesClient) UpdateOne(pc *ProviderCurrencies) *ProviderCurrenciesUpdateOne {
+	mutation := newProviderCurrenciesMutation(c.config, OpUpdateOne, withProviderCurrencies(pc))
+	return &ProviderCurrenciesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProviderCurrenciesClient) UpdateOneID(id uuid.UUID) *ProviderCurrenciesUpdateOne {
+	mutation := newProviderCurrenciesMutation(c.config, OpUpdateOne, withProviderCurrenciesID(id))
+	return &ProviderCurrenciesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ProviderCurrencies.
+func (c *ProviderCurrenciesClient) Delete() *ProviderCurrenciesDelete {
+	mutation := newProviderCurrenciesMutation(c.config, OpDelete)
+	return &ProviderCurrenciesDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProviderCurrenciesClient) DeleteOne(pc *ProviderCurrencies) *ProviderCurrenciesDeleteOne {
+	return c.DeleteOneID(pc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProviderCurrenciesClient) DeleteOneID(id uuid.UUID) *ProviderCurrenciesDeleteOne {
+	builder := c.Delete().Where(providercurrencies.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProviderCurrenciesDeleteOne{builder}
+}
+
+// Query returns a query builder for ProviderCurrencies.
+func (c *ProviderCurrenciesClient) Query() *ProviderCurrenciesQuery {
+	return &ProviderCurrenciesQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProviderCurrencies},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ProviderCurrencies entity by its id.
+func (c *ProviderCurrenciesClient) Get(ctx context.Context, id uuid.UUID) (*ProviderCurrencies, error) {
+	return c.Query().Where(providercurrencies.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProviderCurrenciesClient) GetX(ctx context.Context, id uuid.UUID) *ProviderCurrencies {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProvider queries the provider edge of a ProviderCurrencies.
+func (c *ProviderCurrenciesClient) QueryProvider(pc *ProviderCurrencies) *ProviderProfileQuery {
+	query := (&ProviderProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providercurrencies.Table, providercurrencies.FieldID, id),
+			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, providercurrencies.ProviderTable, providercurrencies.ProviderColumn),
+		)
+		fromV = sqlgraph.Neighbors(pc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCurrency queries the currency edge of a ProviderCurrencies.
+func (c *ProviderCurrenciesClient) QueryCurrency(pc *ProviderCurrencies) *FiatCurrencyQuery {
+	query := (&FiatCurrencyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providercurrencies.Table, providercurrencies.FieldID, id),
+			sqlgraph.To(fiatcurrency.Table, fiatcurrency.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, providercurrencies.CurrencyTable, providercurrencies.CurrencyColumn),
+		)
+		fromV = sqlgraph.Neighbors(pc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ProviderCurrenciesClient) Hooks() []Hook {
+	return c.hooks.ProviderCurrencies
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProviderCurrenciesClient) Interceptors() []Interceptor {
+	return c.inters.ProviderCurrencies
+}
+
+func (c *ProviderCurrenciesClient) mutate(ctx context.Context, m *ProviderCurrenciesMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProviderCurrenciesCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProviderCurrenciesUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProviderCurrenciesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProviderCurrenciesDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ProviderCurrencies mutation op: %q", m.Op())
+	}
+}
+
+// ProviderOrderTokenClient is a client for the ProviderOrderToken schema.
+type ProviderOrderTokenClient struct {
+	config
+}
+
+// NewProviderOrderTokenClient returns a client for the ProviderOrderToken from the given config.
+func NewProviderOrderTokenClient(c config) *ProviderOrderTokenClient {
+	return &ProviderOrderTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `providerordertoken.Hooks(f(g(h())))`.
+func (c *ProviderOrderTokenClient) Use(hooks ...Hook) {
+	c.hooks.ProviderOrderToken = append(c.hooks.ProviderOrderToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `providerordertoken.Intercept(f(g(h())))`.
+func (c *ProviderOrderTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProviderOrderToken = append(c.inters.ProviderOrderToken, interceptors...)
+}
+
+// Create returns a builder for creating a ProviderOrderToken entity.
+func (c *ProviderOrderTokenClient) Create() *ProviderOrderTokenCreate {
+	mutation := newProviderOrderTokenMutation(c.config, OpCreate)
+	return &ProviderOrderTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProviderOrderToken entities.
+func (c *ProviderOrderTokenClient) CreateBulk(builders ...*ProviderOrderTokenCreate) *ProviderOrderTokenCreateBulk {
+	return &ProviderOrderTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProviderOrderTokenClient) MapCreateBulk(slice any, setFunc func(*ProviderOrderTokenCreate, int)) *ProviderOrderTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProviderOrderTokenCreateBulk{err: fmt.Errorf("calling to ProviderOrderTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProviderOrderTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProviderOrderTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProviderOrderToken.
+func (c *ProviderOrderTokenClient) Update() *ProviderOrderTokenUpdate {
+	mutation := newProviderOrderTokenMutation(c.config, OpUpdate)
+	return &ProviderOrderTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProviderOrderTokenClient) UpdateOne(pot *ProviderOrderToken) *ProviderOrderTokenUpdateOne {
+	mutation := newProviderOrderTokenMutation(c.config, OpUpdateOne, withProviderOrderToken(pot))
+	return &ProviderOrderTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProviderOrderTokenClient) UpdateOneID(id int) *ProviderOrderTokenUpdateOne {
+	mutation := newProviderOrderTokenMutation(c.config, OpUpdateOne, withProviderOrderTokenID(id))
+	return &ProviderOrderTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ProviderOrderToken.
+func (c *ProviderOrderTokenClient) Delete() *ProviderOrderTokenDelete {
+	mutation := newProviderOrderTokenMutation(c.config, OpDelete)
+	return &ProviderOrderTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProviderOrderTokenClient) DeleteOne(pot *ProviderOrderToken) *ProviderOrderTokenDeleteOne {
+	return c.DeleteOneID(pot.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProviderOrderTokenClient) DeleteOneID(id int) *ProviderOrderTokenDeleteOne {
+	builder := c.Delete().Where(providerordertoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProviderOrderTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for ProviderOrderToken.
+func (c *ProviderOrderTokenClient) Query() *ProviderOrderTokenQuery {
+	return &ProviderOrderTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProviderOrderToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ProviderOrderToken entity by its id.
+func (c *ProviderOrderTokenClient) Get(ctx context.Context, id int) (*ProviderOrderToken, error) {
+	return c.Query().Where(providerordertoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProviderOrderTokenClient) GetX(ctx context.Context, id int) *ProviderOrderToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProvider queries the provider edge of a ProviderOrderToken.
+func (c *ProviderOrderTokenClient) QueryProvider(pot *ProviderOrderToken) *ProviderProfileQuery {
+	query := (&ProviderProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pot.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerordertoken.Table, providerordertoken.FieldID, id),
+			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, providerordertoken.ProviderTable, providerordertoken.ProviderColumn),
+		)
+		fromV = sqlgraph.Neighbors(pot.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryToken queries the token edge of a ProviderOrderToken.
+func (c *ProviderOrderTokenClient) QueryToken(pot *ProviderOrderToken) *TokenQuery {
+	query := (&TokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pot.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerordertoken.Table, providerordertoken.FieldID, id),
+			sqlgraph.To(token.Table, token.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, providerordertoken.TokenTable, providerordertoken.TokenColumn),
+		)
+		fromV = sqlgraph.Neighbors(pot.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCurrency queries the currency edge of a ProviderOrderToken.
+func (c *ProviderOrderTokenClient) QueryCurrency(pot *ProviderOrderToken) *FiatCurrencyQuery {
+	query := (&FiatCurrencyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pot.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerordertoken.Table, providerordertoken.FieldID, id),
+			sqlgraph.To(fiatcurrency.Table, fiatcurrency.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, providerordertoken.CurrencyTable, providerordertoken.CurrencyColumn),
+		)
+		fromV = sqlgraph.Neighbors(pot.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ProviderOrderTokenClient) Hooks() []Hook {
+	return c.hooks.ProviderOrderToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProviderOrderTokenClient) Interceptors() []Interceptor {
+	return c.inters.ProviderOrderToken
+}
+
+func (c *ProviderOrderTokenClient) mutate(ctx context.Context, m *ProviderOrderTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProviderOrderTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProviderOrderTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProviderOrderTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProviderOrderTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ProviderOrderToken mutation op: %q", m.Op())
+	}
+}
+
+// ProviderPerformanceClient is a client for the ProviderPerformance schema.
+type ProviderPerformanceClient struct {
+	config
+}
+
+// NewProviderPerformanceClient returns a client for the ProviderPerformance from the given config.
+func NewProviderPerformanceClient(c config) *ProviderPerformanceClient {
+	return &ProviderPerformanceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `providerperformance.Hooks(f(g(h())))`.
+func (c *ProviderPerformanceClient) Use(hooks ...Hook) {
+	c.hooks.ProviderPerformance = append(c.hooks.ProviderPerformance, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `providerperformance.Intercept(f(g(h())))`.
+func (c *ProviderPerformanceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProviderPerformance = append(c.inters.ProviderPerformance, interceptors...)
+}
+
+// Create returns a builder for creating a ProviderPerformance entity.
+func (c *ProviderPerformanceClient) Create() *ProviderPerformanceCreate {
+	mutation := newProviderPerformanceMutation(c.config, OpCreate)
+	return &ProviderPerformanceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProviderPerformance entities.
+func (c *ProviderPerformanceClient) CreateBulk(builders ...*ProviderPerformanceCreate) *ProviderPerformanceCreateBulk {
+	return &ProviderPerformanceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProviderPerformanceClient) MapCreateBulk(slice any, setFunc func(*ProviderPerformanceCreate, int)) *ProviderPerformanceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProviderPerformanceCreateBulk{err: fmt.Errorf("calling to ProviderPerformanceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProviderPerformanceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProviderPerformanceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProviderPerformance.
+func (c *ProviderPerformanceClient) Update() *ProviderPerformanceUpdate {
+	mutation := newProviderPerformanceMutation(c.config, OpUpdate)
+	return &ProviderPerformanceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProviderPerformanceClient) UpdateOne(pp *ProviderPerformance) *ProviderPerformanceUpdateOne {
+	mutation := newProviderPerformanceMutation(c.config, OpUpdateOne, withProviderPerformance(pp))
+	return &ProviderPerformanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProviderPerformanceClient) UpdateOneID(id int) *ProviderPerformanceUpdateOne {
+	mutation := newProviderPerformanceMutation(c.config, OpUpdateOne, withProviderPerformanceID(id))
+	return &ProviderPerformanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ProviderPerformance.
+func (c *ProviderPerformanceClient) Delete() *ProviderPerformanceDelete {
+	mutation := newProviderPerformanceMutation(c.config, OpDelete)
+	return &ProviderPerformanceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProviderPerformanceClient) DeleteOne(pp *ProviderPerformance) *ProviderPerformanceDeleteOne {
+	return c.DeleteOneID(pp.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProviderPerformanceClient) DeleteOneID(id int) *ProviderPerformanceDeleteOne {
+	builder := c.Delete().Where(providerperformance.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProviderPerformanceDeleteOne{builder}
+}
+
+// Query returns a query builder for ProviderPerformance.
+func (c *ProviderPerformanceClient) Query() *ProviderPerformanceQuery {
+	return &ProviderPerformanceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProviderPerformance},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ProviderPerformance entity by its id.
+func (c *ProviderPerformanceClient) Get(ctx context.Context, id int) (*ProviderPerformance, error) {
+	return c.Query().Where(providerperformance.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProviderPerformanceClient) GetX(ctx context.Context, id int) *ProviderPerformance {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProvider queries the provider edge of a ProviderPerformance.
+func (c *ProviderPerformanceClient) QueryProvider(pp *ProviderPerformance) *ProviderProfileQuery {
+	query := (&ProviderProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerperformance.Table, providerperformance.FieldID, id),
+			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, providerperformance.ProviderTable, providerperformance.ProviderColumn),
+		)
+		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ProviderPerformanceClient) Hooks() []Hook {
+	return c.hooks.ProviderPerformance
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProviderPerformanceClient) Interceptors() []Interceptor {
+	return c.inters.ProviderPerformance
+}
+
+func (c *ProviderPerformanceClient) mutate(ctx context.Context, m *ProviderPerformanceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProviderPerformanceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProviderPerformanceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProviderPerformanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProviderPerformanceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ProviderPerformance mutation op: %q", m.Op())
+	}
+}
+
+// ProviderProfileClient is a client for the ProviderProfile schema.
+type ProviderProfileClient struct {
+	config
+}
+
+// NewProviderProfileClient returns a client for the ProviderProfile from the given config.
+func NewProviderProfileClient(c config) *ProviderProfileClient {
+	return &ProviderProfileClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `providerprofile.Hooks(f(g(h())))`.
+func (c *ProviderProfileClient) Use(hooks ...Hook) {
+	c.hooks.ProviderProfile = append(c.hooks.ProviderProfile, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `providerprofile.Intercept(f(g(h())))`.
+func (c *ProviderProfileClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProviderProfile = append(c.inters.ProviderProfile, interceptors...)
+}
+
+// Create returns a builder for creating a ProviderProfile entity.
+func (c *ProviderProfileClient) Create() *ProviderProfileCreate {
+	mutation := newProviderProfileMutation(c.config, OpCreate)
+	return &ProviderProfileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProviderProfile entities.
+func (c *ProviderProfileClient) CreateBulk(builders ...*ProviderProfileCreate) *ProviderProfileCreateBulk {
+	return &ProviderProfileCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProviderProfileClient) MapCreateBulk(slice any, setFunc func(*ProviderProfileCreate, int)) *ProviderProfileCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProviderProfileCreateBulk{err: fmt.Errorf("calling to ProviderProfileClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProviderProfileCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProviderProfileCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProviderProfile.
+func (c *ProviderProfileClient) Update() *ProviderProfileUpdate {
+	mutation := newProviderProfileMutation(c.config, OpUpdate)
+	return &ProviderProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProviderProfileClient) UpdateOne(pp *ProviderProfile) *ProviderProfileUpdateOne {
+	mutation := newProviderProfileMutation(c.config, OpUpdateOne, withProviderProfile(pp))
+	return &ProviderProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProviderProfileClient) UpdateOneID(id string) *ProviderProfileUpdateOne {
+	mutation := newProviderProfileMutation(c.config, OpUpdateOne, withProviderProfileID(id))
+	return &ProviderProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ProviderProfile.
+func (c *ProviderProfileClient) Delete() *ProviderProfileDelete {
+	mutation := newProviderProfileMutation(c.config, OpDelete)
+	return &ProviderProfileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProviderProfileClient) DeleteOne(pp *ProviderProfile) *ProviderProfileDeleteOne {
+	return c.DeleteOneID(pp.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProviderProfileClient) DeleteOneID(id string) *ProviderProfileDeleteOne {
+	builder := c.Delete().Where(providerprofile.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProviderProfileDeleteOne{builder}
+}
+
+// Query returns a query builder for ProviderProfile.
+func (c *ProviderProfileClient) Query() *ProviderProfileQuery {
+	return &ProviderProfileQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProviderProfile},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ProviderProfile entity by its id.
+func (c *ProviderProfileClient) Get(ctx context.Context, id string) (*ProviderProfile, error) {
+	return c.Query().Where(providerprofile.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProviderProfileClient) GetX(ctx context.Context, id string) *ProviderProfile {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a ProviderProfile.
+func (c *ProviderProfileClient) QueryUser(pp *ProviderProfile) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, providerprofile.UserTable, providerprofile.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAPIKey queries the api_key edge of a ProviderProfile.
+func (c *ProviderProfileClient) QueryAPIKey(pp *ProviderProfile) *APIKeyQuery {
+	query := (&APIKeyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
+			sqlgraph.To(apikey.Table, apikey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, providerprofile.APIKeyTable, providerprofile.APIKeyColumn),
+		)
+		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProviderCurrencies queries the provider_currencies edge of a ProviderProfile.
+func (c *ProviderProfileClient) QueryProviderCurrencies(pp *ProviderProfile) *ProviderCurrenciesQuery {
+	query := (&ProviderCurrenciesClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
+			sqlgraph.To(providercurrencies.Table, providercurrencies.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, providerprofile.ProviderCurrenciesTable, providerprofile.ProviderCurrenciesColumn),
+		)
+		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProvisionBuckets queries the provision_buckets edge of a ProviderProfile.
+func (c *ProviderProfileClient) QueryProvisionBuckets(pp *ProviderProfile) *ProvisionBucketQuery {
+	query := (&ProvisionBucketClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
+			sqlgraph.To(provisionbucket.Table, provisionbucket.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, providerprofile.ProvisionBucketsTable, providerprofile.ProvisionBucketsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrderTokens queries the order_tokens edge of a ProviderProfile.
+func (c *ProviderProfileClient) QueryOrderTokens(pp *ProviderProfile) *ProviderOrderTokenQuery {
+	query := (&ProviderOrderTokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
+			sqlgraph.To(providerordertoken.Table, providerordertoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, providerprofile.OrderTokensTable, providerprofile.OrderTokensColumn),
+		)
+		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProviderRating queries the provider_rating edge of a ProviderProfile.
+func (c *ProviderProfileClient) QueryProviderRating(pp *ProviderProfile) *ProviderRatingQuery {
+	query := (&ProviderRatingClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
+			sqlgraph.To(providerrating.Table, providerrating.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, providerprofile.ProviderRatingTable, providerprofile.ProviderRatingColumn),
+		)
+		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAssignedOrders queries the assigned_orders edge of a ProviderProfile.
+func (c *ProviderProfileClient) QueryAssignedOrders(pp *ProviderProfile) *LockPaymentOrderQuery {
+	query := (&LockPaymentOrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
+			sqlgraph.To(lockpaymentorder.Table, lockpaymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, providerprofile.AssignedOrdersTable, providerprofile.AssignedOrdersColumn),
+		)
+		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBalanceSnapshots queries the balance_snapshots edge of a ProviderProfile.
+func (c *ProviderProfileClient) QueryBalanceSnapshots(pp *ProviderProfile) *ProviderBalanceSnapshotQuery {
+	query := (&ProviderBalanceSnapshotClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
+			sqlgraph.To(providerbalancesnapshot.Table, providerbalancesnapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, providerprofile.BalanceSnapshotsTable, providerprofile.BalanceSnapshotsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPerformance queries the performance edge of a ProviderProfile.
+func (c *ProviderProfileClient) QueryPerformance(pp *ProviderProfile) *ProviderPerformanceQuery {
+	query := (&ProviderPerformanceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
+			sqlgraph.To(providerperformance.Table, providerperformance.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, providerprofile.PerformanceTable, providerprofile.PerformanceColumn),
+		)
+		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ProviderProfileClient) Hooks() []Hook {
+	return c.hooks.ProviderProfile
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProviderProfileClient) Interceptors() []Interceptor {
+	return c.inters.ProviderProfile
+}
+
+func (c *ProviderProfileClient) mutate(ctx context.Context, m *ProviderProfileMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProviderProfileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProviderProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProviderProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProviderProfileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ProviderProfile mutation op: %q", m.Op())
+	}
+}
+
+// ProviderRatingClient is a client for the ProviderRating schema.
+type ProviderRatingClient struct {
+	config
+}
+
+// NewProviderRatingClient returns a client for the ProviderRating from the given config.
+func NewProviderRatingClient(c config) *ProviderRatingClient {
+	return &ProviderRatingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `providerrating.Hooks(f(g(h())))`.
+func (c *ProviderRatingClient) Use(hooks ...Hook) {
+	c.hooks.ProviderRating = append(c.hooks.ProviderRating, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `providerrating.Intercept(f(g(h())))`.
+func (c *ProviderRatingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProviderRating = append(c.inters.ProviderRating, interceptors...)
+}
+
+// Create returns a builder for creating a ProviderRating entity.
+func (c *ProviderRatingClient) Create() *ProviderRatingCreate {
+	mutation := newProviderRatingMutation(c.config, OpCreate)
+	return &ProviderRatingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProviderRating entities.
+func (c *ProviderRatingClient) CreateBulk(builders ...*ProviderRatingCreate) *ProviderRatingCreateBulk {
+	return &ProviderRatingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProviderRatingClient) MapCreateBulk(slice any, setFunc func(*ProviderRatingCreate, int)) *ProviderRatingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProviderRatingCreateBulk{err: fmt.Errorf("calling to ProviderRatingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProviderRatingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProviderRatingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProviderRating.
+func (c *ProviderRatingClient) Update() *ProviderRatingUpdate {
+	mutation := newProviderRatingMutation(c.config, OpUpdate)
+	return &ProviderRatingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProviderRatingClient) UpdateOne(pr *ProviderRating) *ProviderRatingUpdateOne {
+	mutation := newProviderRatingMutation(c.config, OpUpdateOne, withProviderRating(pr))
+	return &ProviderRatingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PaymentWebhookClient) UpdateOneID(id uuid.UUID) *PaymentWebhookUpdateOne {
-	mutation := newPaymentWebhookMutation(c.config, OpUpdateOne, withPaymentWebhookID(id))
-	return &PaymentWebhookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ProviderRatingClient) UpdateOneID(id int) *ProviderRatingUpdateOne {
+	mutation := newProviderRatingMutation(c.config, OpUpdateOne, withProviderRatingID(id))
+	return &ProviderRatingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PaymentWebhook.
-func (c *PaymentWebhookClient) Delete() *PaymentWebhookDelete {
-	mutation := newPaymentWebhookMutation(c.config, OpDelete)
-	return &PaymentWebhookDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ProviderRating.
+func (c *ProviderRatingClient) Delete() *ProviderRatingDelete {
+	mutation := newProviderRatingMutation(c.config, OpDelete)
+	return &ProviderRatingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PaymentWebhookClient) DeleteOne(pw *PaymentWebhook) *PaymentWebhookDeleteOne {
-	return c.DeleteOneID(pw.ID)
+func (c *ProviderRatingClient) DeleteOne(pr *ProviderRating) *ProviderRatingDeleteOne {
+	return c.DeleteOneID(pr.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PaymentWebhookClient) DeleteOneID(id uuid.UUID) *PaymentWebhookDeleteOne {
-	builder := c.Delete().Where(paymentwebhook.ID(id))
+func (c *ProviderRatingClient) DeleteOneID(id int) *ProviderRatingDeleteOne {
+	builder := c.Delete().Where(providerrating.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PaymentWebhookDeleteOne{builder}
+	return &ProviderRatingDeleteOne{builder}
 }
 
-// Query returns a query builder for PaymentWebhook.
-func (c *PaymentWebhookClient) Query() *PaymentWebhookQuery {
-	return &PaymentWebhookQuery{
+// Query returns a query builder for ProviderRating.
+func (c *ProviderRatingClient) Query() *ProviderRatingQuery {
+	return &ProviderRatingQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePaymentWebhook},
+		ctx:    &QueryContext{Type: TypeProviderRating},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PaymentWebhook entity by its id.
-func (c *PaymentWebhookClient) Get(ctx context.Context, id uuid.UUID) (*PaymentWebhook, error) {
-	return c.Query().Where(paymentwebhook.ID(id)).Only(ctx)
+// Get returns a ProviderRating entity by its id.
+func (c *ProviderRatingClient) Get(ctx context.Context, id int) (*ProviderRating, error) {
+	return c.Query().Where(providerrating.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PaymentWebhookClient) GetX(ctx context.Context, id uuid.UUID) *PaymentWebhook {
+func (c *ProviderRatingClient) GetX(ctx context.Context, id int) *ProviderRating {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2570,164 +5635,148 @@ func (c *PaymentWebhookClient) GetX(ctx context.Context, id uuid.UUID) *PaymentW
 	return obj
 }
 
-// QueryPaymentOrder queries the payment_order edge of a PaymentWebhook.
-func (c *PaymentWebhookClient) QueryPaymentOrder(pw *PaymentWebhook) *PaymentOrderQuery {
-	query := (&PaymentOrderClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pw.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(paymentwebhook.Table, paymentwebhook.FieldID, id),
-			sqlgraph.To(paymentorder.Table, paymentorder.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, paymentwebhook.PaymentOrderTable, paymentwebhook.PaymentOrderColumn),
-		)
-		fromV = sqlgraph.Neighbors(pw.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryNetwork queries the network edge of a PaymentWebhook.
-func (c *PaymentWebhookClient) QueryNetwork(pw *PaymentWebhook) *NetworkQuery {
-	query := (&NetworkClient{config: c.config}).Query()
+// QueryProviderProfile queries the provider_profile edge of a ProviderRating.
+func (c *ProviderRatingClient) QueryProviderProfile(pr *ProviderRating) *ProviderProfileQuery {
+	query := (&ProviderProfileClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pw.ID
+		id := pr.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(paymentwebhook.Table, paymentwebhook.FieldID, id),
-			sqlgraph.To(network.Table, network.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, paymentwebhook.NetworkTable, paymentwebhook.NetworkColumn),
+			sqlgraph.From(providerrating.Table, providerrating.FieldID, id),
+			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, providerrating.ProviderProfileTable, providerrating.ProviderProfileColumn),
 		)
-		fromV = sqlgraph.Neighbors(pw.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *PaymentWebhookClient) Hooks() []Hook {
-	return c.hooks.PaymentWebhook
+func (c *ProviderRatingClient) Hooks() []Hook {
+	return c.hooks.ProviderRating
 }
 
 // Interceptors returns the client interceptors.
-func (c *PaymentWebhookClient) Interceptors() []Interceptor {
-	return c.inters.PaymentWebhook
+func (c *ProviderRatingClient) Interceptors() []Interceptor {
+	return c.inters.ProviderRating
 }
 
-func (c *PaymentWebhookClient) mutate(ctx context.Context, m *PaymentWebhookMutation) (Value, error) {
+func (c *ProviderRatingClient) mutate(ctx context.Context, m *ProviderRatingMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PaymentWebhookCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProviderRatingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PaymentWebhookUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProviderRatingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PaymentWebhookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProviderRatingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PaymentWebhookDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ProviderRatingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PaymentWebhook mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ProviderRating mutation op: %q", m.Op())
 	}
 }
 
-// ProviderCurrenciesClient is a client for the ProviderCurrencies schema.
-type ProviderCurrenciesClient struct {
+// ProvisionBucketClient is a client for the ProvisionBucket schema.
+type ProvisionBucketClient struct {
 	config
 }
 
-// NewProviderCurrenciesClient returns a client for the ProviderCurrencies from the given config.
-func NewProviderCurrenciesClient(c config) *ProviderCurrenciesClient {
-	return &ProviderCurrenciesClient{config: c}
+// NewProvisionBucketClient returns a client for the ProvisionBucket from the given config.
+func NewProvisionBucketClient(c config) *ProvisionBucketClient {
+	return &ProvisionBucketClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `providercurrencies.Hooks(f(g(h())))`.
-func (c *ProviderCurrenciesClient) Use(hooks ...Hook) {
-	c.hooks.ProviderCurrencies = append(c.hooks.ProviderCurrencies, hooks...)
+// A call to `Use(f, g, h)` equals to `provisionbucket.Hooks(f(g(h())))`.
+func (c *ProvisionBucketClient) Use(hooks ...Hook) {
+	c.hooks.ProvisionBucket = append(c.hooks.ProvisionBucket, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `providercurrencies.Intercept(f(g(h())))`.
-func (c *ProviderCurrenciesClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ProviderCurrencies = append(c.inters.ProviderCurrencies, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `provisionbucket.Intercept(f(g(h())))`.
+func (c *ProvisionBucketClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProvisionBucket = append(c.inters.ProvisionBucket, interceptors...)
 }
 
-// Create returns a builder for creating a ProviderCurrencies entity.
-func (c *ProviderCurrenciesClient) Create() *ProviderCurrenciesCreate {
-	mutation := newProviderCurrenciesMutation(c.config, OpCreate)
-	return &ProviderCurrenciesCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ProvisionBucket entity.
+func (c *ProvisionBucketClient) Create() *ProvisionBucketCreate {
+	mutation := newProvisionBucketMutation(c.config, OpCreate)
+	return &ProvisionBucketCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ProviderCurrencies entities.
-func (c *ProviderCurrenciesClient) CreateBulk(builders ...*ProviderCurrenciesCreate) *ProviderCurrenciesCreateBulk {
-	return &ProviderCurrenciesCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ProvisionBucket entities.
+func (c *ProvisionBucketClient) CreateBulk(builders ...*ProvisionBucketCreate) *ProvisionBucketCreateBulk {
+	return &ProvisionBucketCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ProviderCurrenciesClient) MapCreateBulk(slice any, setFunc func(*ProviderCurrenciesCreate, int)) *ProviderCurrenciesCreateBulk {
+func (c *ProvisionBucketClient) MapCreateBulk(slice any, setFunc func(*ProvisionBucketCreate, int)) *ProvisionBucketCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ProviderCurrenciesCreateBulk{err: fmt.Errorf("calling to ProviderCurrenciesClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ProvisionBucketCreateBulk{err: fmt.Errorf("calling to ProvisionBucketClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ProviderCurrenciesCreate, rv.Len())
+	builders := make([]*ProvisionBucketCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ProviderCurrenciesCreateBulk{config: c.config, builders: builders}
+	return &ProvisionBucketCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ProviderCurrencies.
-func (c *ProviderCurrenciesClient) Update() *ProviderCurrenciesUpdate {
-	mutation := newProviderCurrenciesMutation(c.config, OpUpdate)
-	return &ProviderCurrenciesUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ProvisionBucket.
+func (c *ProvisionBucketClient) Update() *ProvisionBucketUpdate {
+	mutation := newProvisionBucketMutation(c.config, OpUpdate)
+	return &ProvisionBucketUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ProviderCurrenciesClient) UpdateOne(pc *ProviderCurrencies) *ProviderCurrenciesUpdateOne {
-	mutation := newProviderCurrenciesMutation(c.config, OpUpdateOne, withProviderCurrencies(pc))
-	return &ProviderCurrenciesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ProvisionBucketClient) UpdateOne(pb *ProvisionBucket) *ProvisionBucketUpdateOne {
+	mutation := newProvisionBucketMutation(c.config, OpUpdateOne, withProvisionBucket(pb))
+	return &ProvisionBucketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ProviderCurrenciesClient) UpdateOneID(id uuid.UUID) *ProviderCurrenciesUpdateOne {
-	mutation := newProviderCurrenciesMutation(c.config, OpUpdateOne, withProviderCurrenciesID(id))
-	return &ProviderCurrenciesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ProvisionBucketClient) UpdateOneID(id int) *ProvisionBucketUpdateOne {
+	mutation := newProvisionBucketMutation(c.config, OpUpdateOne, withProvisionBucketID(id))
+	return &ProvisionBucketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ProviderCurrencies.
-func (c *ProviderCurrenciesClient) Delete() *ProviderCurrenciesDelete {
-	mutation := newProviderCurrenciesMutation(c.config, OpDelete)
-	return &ProviderCurrenciesDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ProvisionBucket.
+func (c *ProvisionBucketClient) Delete() *ProvisionBucketDelete {
+	mutation := newProvisionBucketMutation(c.config, OpDelete)
+	return &ProvisionBucketDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ProviderCurrenciesClient) DeleteOne(pc *ProviderCurrencies) *ProviderCurrenciesDeleteOne {
-	return c.DeleteOneID(pc.ID)
+func (c *ProvisionBucketClient) DeleteOne(pb *ProvisionBucket) *ProvisionBucketDeleteOne {
+	return c.DeleteOneID(pb.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ProviderCurrenciesClient) DeleteOneID(id uuid.UUID) *ProviderCurrenciesDeleteOne {
-	builder := c.Delete().Where(providercurrencies.ID(id))
+func (c *ProvisionBucketClient) DeleteOneID(id int) *ProvisionBucketDeleteOne {
+	builder := c.Delete().Where(provisionbucket.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ProviderCurrenciesDeleteOne{builder}
+	return &ProvisionBucketDeleteOne{builder}
 }
 
-// Query returns a query builder for ProviderCurrencies.
-func (c *ProviderCurrenciesClient) Query() *ProviderCurrenciesQuery {
-	return &ProviderCurrenciesQuery{
+// Query returns a query builder for ProvisionBucket.
+func (c *ProvisionBucketClient) Query() *ProvisionBucketQuery {
+	return &ProvisionBucketQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeProviderCurrencies},
+		ctx:    &QueryContext{Type: TypeProvisionBucket},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ProviderCurrencies entity by its id.
-func (c *ProviderCurrenciesClient) Get(ctx context.Context, id uuid.UUID) (*ProviderCurrencies, error) {
-	return c.Query().Where(providercurrencies.ID(id)).Only(ctx)
+// Get returns a ProvisionBucket entity by its id.
+func (c *ProvisionBucketClient) Get(ctx context.Context, id int) (*ProvisionBucket, error) {
+	return c.Query().Where(provisionbucket.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ProviderCurrenciesClient) GetX(ctx context.Context, id uuid.UUID) *ProviderCurrencies {
+func (c *ProvisionBucketClient) GetX(ctx context.Context, id int) *ProvisionBucket {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2735,164 +5784,180 @@ func (c *ProviderCurrenciesClient) GetX(ctx context.Context, id uuid.UUID) *Prov
 	return obj
 }
 
-// QueryProvider queries the provider edge of a ProviderCurrencies.
-func (c *ProviderCurrenciesClient) QueryProvider(pc *ProviderCurrencies) *ProviderProfileQuery {
-	query := (&ProviderProfileClient{config: c.config}).Query()
+// QueryCurrency queries the currency edge of a ProvisionBucket.
+func (c *ProvisionBucketClient) QueryCurrency(pb *ProvisionBucket) *FiatCurrencyQuery {
+	query := (&FiatCurrencyClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pc.ID
+		id := pb.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(providercurrencies.Table, providercurrencies.FieldID, id),
-			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, providercurrencies.ProviderTable, providercurrencies.ProviderColumn),
+			sqlgraph.From(provisionbucket.Table, provisionbucket.FieldID, id),
+			sqlgraph.To(fiatcurrency.Table, fiatcurrency.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, provisionbucket.CurrencyTable, provisionbucket.CurrencyColumn),
 		)
-		fromV = sqlgraph.Neighbors(pc.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(pb.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryCurrency queries the currency edge of a ProviderCurrencies.
-func (c *ProviderCurrenciesClient) QueryCurrency(pc *ProviderCurrencies) *FiatCurrencyQuery {
-	query := (&FiatCurrencyClient{config: c.config}).Query()
+// QueryLockPaymentOrders queries the lock_payment_orders edge of a ProvisionBucket.
+func (c *ProvisionBucketClient) QueryLockPaymentOrders(pb *ProvisionBucket) *LockPaymentOrderQuery {
+	query := (&LockPaymentOrderClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pc.ID
+		id := pb.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(providercurrencies.Table, providercurrencies.FieldID, id),
-			sqlgraph.To(fiatcurrency.Table, fiatcurrency.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, providercurrencies.CurrencyTable, providercurrencies.CurrencyColumn),
+			sqlgraph.From(provisionbucket.Table, provisionbucket.FieldID, id),
+			sqlgraph.To(lockpaymentorder.Table, lockpaymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, provisionbucket.LockPaymentOrdersTable, provisionbucket.LockPaymentOrdersColumn),
 		)
-		fromV = sqlgraph.Neighbors(pc.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(pb.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProviderProfiles queries the provider_profiles edge of a ProvisionBucket.
+func (c *ProvisionBucketClient) QueryProviderProfiles(pb *ProvisionBucket) *ProviderProfileQuery {
+	query := (&ProviderProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pb.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(provisionbucket.Table, provisionbucket.FieldID, id),
+			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, provisionbucket.ProviderProfilesTable, provisionbucket.ProviderProfilesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(pb.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ProviderCurrenciesClient) Hooks() []Hook {
-	return c.hooks.ProviderCurrencies
+func (c *ProvisionBucketClient) Hooks() []Hook {
+	return c.hooks.ProvisionBucket
 }
 
 // Interceptors returns the client interceptors.
-func (c *ProviderCurrenciesClient) Interceptors() []Interceptor {
-	return c.inters.ProviderCurrencies
+func (c *ProvisionBucketClient) Interceptors() []Interceptor {
+	return c.inters.ProvisionBucket
 }
 
-func (c *ProviderCurrenciesClient) mutate(ctx context.Context, m *ProviderCurrenciesMutation) (Value, error) {
+func (c *ProvisionBucketClient) mutate(ctx context.Context, m *ProvisionBucketMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ProviderCurrenciesCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProvisionBucketCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ProviderCurrenciesUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProvisionBucketUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ProviderCurrenciesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProvisionBucketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ProviderCurrenciesDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ProvisionBucketDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ProviderCurrencies mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ProvisionBucket mutation op: %q", m.Op())
 	}
 }
 
-// ProviderOrderTokenClient is a client for the ProviderOrderToken schema.
-type ProviderOrderTokenClient struct {
+// RPCEndpointClient is a client for the RPCEndpoint schema.
+type RPCEndpointClient struct {
 	config
 }
 
-// NewProviderOrderTokenClient returns a client for the ProviderOrderToken from the given config.
-func NewProviderOrderTokenClient(c config) *ProviderOrderTokenClient {
-	return &ProviderOrderTokenClient{config: c}
+// NewRPCEndpointClient returns a client for the RPCEndpoint from the given config.
+func NewRPCEndpointClient(c config) *RPCEndpointClient {
+	return &RPCEndpointClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `providerordertoken.Hooks(f(g(h())))`.
-func (c *ProviderOrderTokenClient) Use(hooks ...Hook) {
-	c.hooks.ProviderOrderToken = append(c.hooks.ProviderOrderToken, hooks...)
+// A call to `Use(f, g, h)` equals to `rpcendpoint.Hooks(f(g(h())))`.
+func (c *RPCEndpointClient) Use(hooks ...Hook) {
+	c.hooks.RPCEndpoint = append(c.hooks.RPCEndpoint, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `providerordertoken.Intercept(f(g(h())))`.
-func (c *ProviderOrderTokenClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ProviderOrderToken = append(c.inters.ProviderOrderToken, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `rpcendpoint.Intercept(f(g(h())))`.
+func (c *RPCEndpointClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RPCEndpoint = append(c.inters.RPCEndpoint, interceptors...)
 }
 
-// Create returns a builder for creating a ProviderOrderToken entity.
-func (c *ProviderOrderTokenClient) Create() *ProviderOrderTokenCreate {
-	mutation := newProviderOrderTokenMutation(c.config, OpCreate)
-	return &ProviderOrderTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a RPCEndpoint entity.
+func (c *RPCEndpointClient) Create() *RPCEndpointCreate {
+	mutation := newRPCEndpointMutation(c.config, OpCreate)
+	return &RPCEndpointCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ProviderOrderToken entities.
-func (c *ProviderOrderTokenClient) CreateBulk(builders ...*ProviderOrderTokenCreate) *ProviderOrderTokenCreateBulk {
-	return &ProviderOrderTokenCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of RPCEndpoint entities.
+func (c *RPCEndpointClient) CreateBulk(builders ...*RPCEndpointCreate) *RPCEndpointCreateBulk {
+	return &RPCEndpointCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ProviderOrderTokenClient) MapCreateBulk(slice any, setFunc func(*ProviderOrderTokenCreate, int)) *ProviderOrderTokenCreateBulk {
+func (c *RPCEndpointClient) MapCreateBulk(slice any, setFunc func(*RPCEndpointCreate, int)) *RPCEndpointCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ProviderOrderTokenCreateBulk{err: fmt.Errorf("calling to ProviderOrderTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &RPCEndpointCreateBulk{err: fmt.Errorf("calling to RPCEndpointClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ProviderOrderTokenCreate, rv.Len())
+	builders := make([]*RPCEndpointCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ProviderOrderTokenCreateBulk{config: c.config, builders: builders}
+	return &RPCEndpointCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ProviderOrderToken.
-func (c *ProviderOrderTokenClient) Update() *ProviderOrderTokenUpdate {
-	mutation := newProviderOrderTokenMutation(c.config, OpUpdate)
-	return &ProviderOrderTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for RPCEndpoint.
+func (c *RPCEndpointClient) Update() *RPCEndpointUpdate {
+	mutation := newRPCEndpointMutation(c.config, OpUpdate)
+	return &RPCEndpointUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ProviderOrderTokenClient) UpdateOne(pot *ProviderOrderToken) *ProviderOrderTokenUpdateOne {
-	mutation := newProviderOrderTokenMutation(c.config, OpUpdateOne, withProviderOrderToken(pot))
-	return &ProviderOrderTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *RPCEndpointClient) UpdateOne(re *RPCEndpoint) *RPCEndpointUpdateOne {
+	mutation := newRPCEndpointMutation(c.config, OpUpdateOne, withRPCEndpoint(re))
+	return &RPCEndpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ProviderOrderTokenClient) UpdateOneID(id int) *ProviderOrderTokenUpdateOne {
-	mutation := newProviderOrderTokenMutation(c.config, OpUpdateOne, withProviderOrderTokenID(id))
-	return &ProviderOrderTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *RPCEndpointClient) UpdateOneID(id int) *RPCEndpointUpdateOne {
+	mutation := newRPCEndpointMutation(c.config, OpUpdateOne, withRPCEndpointID(id))
+	return &RPCEndpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ProviderOrderToken.
-func (c *ProviderOrderTokenClient) Delete() *ProviderOrderTokenDelete {
-	mutation := newProviderOrderTokenMutation(c.config, OpDelete)
-	return &ProviderOrderTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for RPCEndpoint.
+func (c *RPCEndpointClient) Delete() *RPCEndpointDelete {
+	mutation := newRPCEndpointMutation(c.config, OpDelete)
+	return &RPCEndpointDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ProviderOrderTokenClient) DeleteOne(pot *ProviderOrderToken) *ProviderOrderTokenDeleteOne {
-	return c.DeleteOneID(pot.ID)
+func (c *RPCEndpointClient) DeleteOne(re *RPCEndpoint) *RPCEndpointDeleteOne {
+	return c.DeleteOneID(re.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ProviderOrderTokenClient) DeleteOneID(id int) *ProviderOrderTokenDeleteOne {
-	builder := c.Delete().Where(providerordertoken.ID(id))
+func (c *RPCEndpointClient) DeleteOneID(id int) *RPCEndpointDeleteOne {
+	builder := c.Delete().Where(rpcendpoint.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ProviderOrderTokenDeleteOne{builder}
+	return &RPCEndpointDeleteOne{builder}
 }
 
-// Query returns a query builder for ProviderOrderToken.
-func (c *ProviderOrderTokenClient) Query() *ProviderOrderTokenQuery {
-	return &ProviderOrderTokenQuery{
+// Query returns a query builder for RPCEndpoint.
+func (c *RPCEndpointClient) Query() *RPCEndpointQuery {
+	return &RPCEndpointQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeProviderOrderToken},
+		ctx:    &QueryContext{Type: TypeRPCEndpoint},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ProviderOrderToken entity by its id.
-func (c *ProviderOrderTokenClient) Get(ctx context.Context, id int) (*ProviderOrderToken, error) {
-	return c.Query().Where(providerordertoken.ID(id)).Only(ctx)
+// Get returns a RPCEndpoint entity by its id.
+func (c *RPCEndpointClient) Get(ctx context.Context, id int) (*RPCEndpoint, error) {
+	return c.Query().Where(rpcendpoint.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ProviderOrderTokenClient) GetX(ctx context.Context, id int) *ProviderOrderToken {
+func (c *RPCEndpointClient) GetX(ctx context.Context, id int) *RPCEndpoint {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2900,425 +5965,313 @@ func (c *ProviderOrderTokenClient) GetX(ctx context.Context, id int) *ProviderOr
 	return obj
 }
 
-// QueryProvider queries the provider edge of a ProviderOrderToken.
-func (c *ProviderOrderTokenClient) QueryProvider(pot *ProviderOrderToken) *ProviderProfileQuery {
-	query := (&ProviderProfileClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pot.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(providerordertoken.Table, providerordertoken.FieldID, id),
-			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, providerordertoken.ProviderTable, providerordertoken.ProviderColumn),
-		)
-		fromV = sqlgraph.Neighbors(pot.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryToken queries the token edge of a ProviderOrderToken.
-func (c *ProviderOrderTokenClient) QueryToken(pot *ProviderOrderToken) *TokenQuery {
-	query := (&TokenClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pot.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(providerordertoken.Table, providerordertoken.FieldID, id),
-			sqlgraph.To(token.Table, token.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, providerordertoken.TokenTable, providerordertoken.TokenColumn),
-		)
-		fromV = sqlgraph.Neighbors(pot.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryCurrency queries the currency edge of a ProviderOrderToken.
-func (c *ProviderOrderTokenClient) QueryCurrency(pot *ProviderOrderToken) *FiatCurrencyQuery {
-	query := (&FiatCurrencyClient{config: c.config}).Query()
+// QueryNetwork queries the network edge of a RPCEndpoint.
+func (c *RPCEndpointClient) QueryNetwork(re *RPCEndpoint) *NetworkQuery {
+	query := (&NetworkClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pot.ID
+		id := re.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(providerordertoken.Table, providerordertoken.FieldID, id),
-			sqlgraph.To(fiatcurrency.Table, fiatcurrency.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, providerordertoken.CurrencyTable, providerordertoken.CurrencyColumn),
+			sqlgraph.From(rpcendpoint.Table, rpcendpoint.FieldID, id),
+			sqlgraph.To(network.Table, network.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, rpcendpoint.NetworkTable, rpcendpoint.NetworkColumn),
 		)
-		fromV = sqlgraph.Neighbors(pot.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(re.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ProviderOrderTokenClient) Hooks() []Hook {
-	return c.hooks.ProviderOrderToken
+func (c *RPCEndpointClient) Hooks() []Hook {
+	return c.hooks.RPCEndpoint
 }
 
 // Interceptors returns the client interceptors.
-func (c *ProviderOrderTokenClient) Interceptors() []Interceptor {
-	return c.inters.ProviderOrderToken
+func (c *RPCEndpointClient) Interceptors() []Interceptor {
+	return c.inters.RPCEndpoint
 }
 
-func (c *ProviderOrderTokenClient) mutate(ctx context.Context, m *ProviderOrderTokenMutation) (Value, error) {
+func (c *RPCEndpointClient) mutate(ctx context.Context, m *RPCEndpointMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ProviderOrderTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RPCEndpointCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ProviderOrderTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RPCEndpointUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ProviderOrderTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RPCEndpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ProviderOrderTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&RPCEndpointDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ProviderOrderToken mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown RPCEndpoint mutation op: %q", m.Op())
 	}
 }
 
-// ProviderProfileClient is a client for the ProviderProfile schema.
-type ProviderProfileClient struct {
+// ReceiveAddressClient is a client for the ReceiveAddress schema.
+type ReceiveAddressClient struct {
 	config
 }
 
-// NewProviderProfileClient returns a client for the ProviderProfile from the given config.
-func NewProviderProfileClient(c config) *ProviderProfileClient {
-	return &ProviderProfileClient{config: c}
+// NewReceiveAddressClient returns a client for the ReceiveAddress from the given config.
+func NewReceiveAddressClient(c config) *ReceiveAddressClient {
+	return &ReceiveAddressClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `providerprofile.Hooks(f(g(h())))`.
-func (c *ProviderProfileClient) Use(hooks ...Hook) {
-	c.hooks.ProviderProfile = append(c.hooks.ProviderProfile, hooks...)
+// A call to `Use(f, g, h)` equals to `receiveaddress.Hooks(f(g(h())))`.
+func (c *ReceiveAddressClient) Use(hooks ...Hook) {
+	c.hooks.ReceiveAddress = append(c.hooks.ReceiveAddress, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `providerprofile.Intercept(f(g(h())))`.
-func (c *ProviderProfileClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ProviderProfile = append(c.inters.ProviderProfile, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `receiveaddress.Intercept(f(g(h())))`.
+func (c *ReceiveAddressClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ReceiveAddress = append(c.inters.ReceiveAddress, interceptors...)
 }
 
-// Create returns a builder for creating a ProviderProfile entity.
-func (c *ProviderProfileClient) Create() *ProviderProfileCreate {
-	mutation := newProviderProfileMutation(c.config, OpCreate)
-	return &ProviderProfileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ReceiveAddress entity.
+func (c *ReceiveAddressClient) Create() *ReceiveAddressCreate {
+	mutation := newReceiveAddressMutation(c.config, OpCreate)
+	return &ReceiveAddressCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ProviderProfile entities.
-func (c *ProviderProfileClient) CreateBulk(builders ...*ProviderProfileCreate) *ProviderProfileCreateBulk {
-	return &ProviderProfileCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ReceiveAddress entities.
+func (c *ReceiveAddressClient) CreateBulk(builders ...*ReceiveAddressCreate) *ReceiveAddressCreateBulk {
+	return &ReceiveAddressCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ProviderProfileClient) MapCreateBulk(slice any, setFunc func(*ProviderProfileCreate, int)) *ProviderProfileCreateBulk {
+func (c *ReceiveAddressClient) MapCreateBulk(slice any, setFunc func(*ReceiveAddressCreate, int)) *ReceiveAddressCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ProviderProfileCreateBulk{err: fmt.Errorf("calling to ProviderProfileClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ReceiveAddressCreateBulk{err: fmt.Errorf("calling to ReceiveAddressClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ProviderProfileCreate, rv.Len())
+	builders := make([]*ReceiveAddressCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ProviderProfileCreateBulk{config: c.config, builders: builders}
+	return &ReceiveAddressCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ProviderProfile.
-func (c *ProviderProfileClient) Update() *ProviderProfileUpdate {
-	mutation := newProviderProfileMutation(c.config, OpUpdate)
-	return &ProviderProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ReceiveAddress.
+func (c *ReceiveAddressClient) Update() *ReceiveAddressUpdate {
+	mutation := newReceiveAddressMutation(c.config, OpUpdate)
+	return &ReceiveAddressUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ProviderProfileClient) UpdateOne(pp *ProviderProfile) *ProviderProfileUpdateOne {
-	mutation := newProviderProfileMutation(c.config, OpUpdateOne, withProviderProfile(pp))
-	return &ProviderProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ReceiveAddressClient) UpdateOne(ra *ReceiveAddress) *ReceiveAddressUpdateOne {
+	mutation := newReceiveAddressMutation(c.config, OpUpdateOne, withReceiveAddress(ra))
+	return &ReceiveAddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ProviderProfileClient) UpdateOneID(id string) *ProviderProfileUpdateOne {
-	mutation := newProviderProfileMutation(c.config, OpUpdateOne, withProviderProfileID(id))
-	return &ProviderProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ReceiveAddressClient) UpdateOneID(id int) *ReceiveAddressUpdateOne {
+	mutation := newReceiveAddressMutation(c.config, OpUpdateOne, withReceiveAddressID(id))
+	return &ReceiveAddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ProviderProfile.
-func (c *ProviderProfileClient) Delete() *ProviderProfileDelete {
-	mutation := newProviderProfileMutation(c.config, OpDelete)
-	return &ProviderProfileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ReceiveAddress.
+func (c *ReceiveAddressClient) Delete() *ReceiveAddressDelete {
+	mutation := newReceiveAddressMutation(c.config, OpDelete)
+	return &ReceiveAddressDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ProviderProfileClient) DeleteOne(pp *ProviderProfile) *ProviderProfileDeleteOne {
-	return c.DeleteOneID(pp.ID)
+func (c *ReceiveAddressClient) DeleteOne(ra *ReceiveAddress) *ReceiveAddressDeleteOne {
+	return c.DeleteOneID(ra.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ProviderProfileClient) DeleteOneID(id string) *ProviderProfileDeleteOne {
-	builder := c.Delete().Where(providerprofile.ID(id))
-	builder.mutation.id = &id
-	builder.mutation.op = OpDeleteOne
-	return &ProviderProfileDeleteOne{builder}
-}
-
-// Query returns a query builder for ProviderProfile.
-func (c *ProviderProfileClient) Query() *ProviderProfileQuery {
-	return &ProviderProfileQuery{
-		config: c.config,
-		ctx:    &QueryContext{Type: TypeProviderProfile},
-		inters: c.Interceptors(),
-	}
-}
-
-// Get returns a ProviderProfile entity by its id.
-func (c *ProviderProfileClient) Get(ctx context.Context, id string) (*ProviderProfile, error) {
-	return c.Query().Where(providerprofile.ID(id)).Only(ctx)
-}
-
-// GetX is like Get, but panics if an error occurs.
-func (c *ProviderProfileClient) GetX(ctx context.Context, id string) *ProviderProfile {
-	obj, err := c.Get(ctx, id)
-	if err != nil {
-		panic(err)
-	}
-	return obj
-}
-
-// QueryUser queries the user edge of a ProviderProfile.
-func (c *ProviderProfileClient) QueryUser(pp *ProviderProfile) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pp.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, providerprofile.UserTable, providerprofile.UserColumn),
-		)
-		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryAPIKey queries the api_key edge of a ProviderProfile.
-func (c *ProviderProfileClient) QueryAPIKey(pp *ProviderProfile) *APIKeyQuery {
-	query := (&APIKeyClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pp.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
-			sqlgraph.To(apikey.Table, apikey.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, providerprofile.APIKeyTable, providerprofile.APIKeyColumn),
-		)
-		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryProviderCurrencies queries the provider_currencies edge of a ProviderProfile.
-func (c *ProviderProfileClient) QueryProviderCurrencies(pp *ProviderProfile) *ProviderCurrenciesQuery {
-	query := (&ProviderCurrenciesClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pp.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
-			sqlgraph.To(providercurrencies.Table, providercurrencies.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, providerprofile.ProviderCurrenciesTable, providerprofile.ProviderCurrenciesColumn),
-		)
-		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+func (c *ReceiveAddressClient) DeleteOneID(id int) *ReceiveAddressDeleteOne {
+	builder := c.Delete().Where(receiveaddress.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReceiveAddressDeleteOne{builder}
 }
 
-// QueryProvisionBuckets queries the provision_buckets edge of a ProviderProfile.
-func (c *ProviderProfileClient) QueryProvisionBuckets(pp *ProviderProfile) *ProvisionBucketQuery {
-	query := (&ProvisionBucketClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pp.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
-			sqlgraph.To(provisionbucket.Table, provisionbucket.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, providerprofile.ProvisionBucketsTable, providerprofile.ProvisionBucketsPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
-		return fromV, nil
+// Query returns a query builder for ReceiveAddress.
+func (c *ReceiveAddressClient) Query() *ReceiveAddressQuery {
+	return &ReceiveAddressQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReceiveAddress},
+		inters: c.Interceptors(),
 	}
-	return query
 }
 
-// QueryOrderTokens queries the order_tokens edge of a ProviderProfile.
-func (c *ProviderProfileClient) QueryOrderTokens(pp *ProviderProfile) *ProviderOrderTokenQuery {
-	query := (&ProviderOrderTokenClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pp.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
-			sqlgraph.To(providerordertoken.Table, providerordertoken.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, providerprofile.OrderTokensTable, providerprofile.OrderTokensColumn),
-		)
-		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
-		return fromV, nil
+// Get returns a ReceiveAddress entity by its id.
+func (c *ReceiveAddressClient) Get(ctx context.Context, id int) (*ReceiveAddress, error) {
+	return c.Query().Where(receiveaddress.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReceiveAddressClient) GetX(ctx context.Context, id int) *ReceiveAddress {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
 	}
-	return query
+	return obj
 }
 
-// QueryProviderRating queries the provider_rating edge of a ProviderProfile.
-func (c *ProviderProfileClient) QueryProviderRating(pp *ProviderProfile) *ProviderRatingQuery {
-	query := (&ProviderRatingClient{config: c.config}).Query()
+// QueryPaymentOrder queries the payment_order edge of a ReceiveAddress.
+func (c *ReceiveAddressClient) QueryPaymentOrder(ra *ReceiveAddress) *PaymentOrderQuery {
+	query := (&PaymentOrderClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pp.ID
+		id := ra.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
-			sqlgraph.To(providerrating.Table, providerrating.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, providerprofile.ProviderRatingTable, providerprofile.ProviderRatingColumn),
+			sqlgraph.From(receiveaddress.Table, receiveaddress.FieldID, id),
+			sqlgraph.To(paymentorder.Table, paymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, receiveaddress.PaymentOrderTable, receiveaddress.PaymentOrderColumn),
 		)
-		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(ra.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryAssignedOrders queries the assigned_orders edge of a ProviderProfile.
-func (c *ProviderProfileClient) QueryAssignedOrders(pp *ProviderProfile) *LockPaymentOrderQuery {
-	query := (&LockPaymentOrderClient{config: c.config}).Query()
+// QueryAssignments queries the assignments edge of a ReceiveAddress.
+func (c *ReceiveAddressClient) QueryAssignments(ra *ReceiveAddress) *ReceiveAddressAssignmentQuery {
+	query := (&ReceiveAddressAssignmentClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pp.ID
+		id := ra.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
-			sqlgraph.To(lockpaymentorder.Table, lockpaymentorder.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, providerprofile.AssignedOrdersTable, providerprofile.AssignedOrdersColumn),
+			sqlgraph.From(receiveaddress.Table, receiveaddress.FieldID, id),
+			sqlgraph.To(receiveaddressassignment.Table, receiveaddressassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, receiveaddress.AssignmentsTable, receiveaddress.AssignmentsColumn),
 		)
-		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(ra.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ProviderProfileClient) Hooks() []Hook {
-	return c.hooks.ProviderProfile
+func (c *ReceiveAddressClient) Hooks() []Hook {
+	return c.hooks.ReceiveAddress
 }
 
 // Interceptors returns the client interceptors.
-func (c *ProviderProfileClient) Interceptors() []Interceptor {
-	return c.inters.ProviderProfile
+func (c *ReceiveAddressClient) Interceptors() []Interceptor {
+	return c.inters.ReceiveAddress
 }
 
-func (c *ProviderProfileClient) mutate(ctx context.Context, m *ProviderProfileMutation) (Value, error) {
+func (c *ReceiveAddressClient) mutate(ctx context.Context, m *ReceiveAddressMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ProviderProfileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReceiveAddressCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ProviderProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReceiveAddressUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ProviderProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReceiveAddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ProviderProfileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ReceiveAddressDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ProviderProfile mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ReceiveAddress mutation op: %q", m.Op())
 	}
 }
 
-// ProviderRatingClient is a client for the ProviderRating schema.
-type ProviderRatingClient struct {
+// ReceiveAddressAssignmentClient is a client for the ReceiveAddressAssignment schema.
+type ReceiveAddressAssignmentClient struct {
 	config
 }
 
-// NewProviderRatingClient returns a client for the ProviderRating from the given config.
-func NewProviderRatingClient(c config) *ProviderRatingClient {
-	return &ProviderRatingClient{config: c}
+// NewReceiveAddressAssignmentClient returns a client for the ReceiveAddressAssignment from the given config.
+func NewReceiveAddressAssignmentClient(c config) *ReceiveAddressAssignmentClient {
+	return &ReceiveAddressAssignmentClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `providerrating.Hooks(f(g(h())))`.
-func (c *ProviderRatingClient) Use(hooks ...Hook) {
-	c.hooks.ProviderRating = append(c.hooks.ProviderRating, hooks...)
+// A call to `Use(f, g, h)` equals to `receiveaddressassignment.Hooks(f(g(h())))`.
+func (c *ReceiveAddressAssignmentClient) Use(hooks ...Hook) {
+	c.hooks.ReceiveAddressAssignment = append(c.hooks.ReceiveAddressAssignment, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `providerrating.Intercept(f(g(h())))`.
-func (c *ProviderRatingClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ProviderRating = append(c.inters.ProviderRating, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `receiveaddressassignment.Intercept(f(g(h())))`.
+func (c *ReceiveAddressAssignmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ReceiveAddressAssignment = append(c.inters.ReceiveAddressAssignment, interceptors...)
 }
 
-// Create returns a builder for creating a ProviderRating entity.
-func (c *ProviderRatingClient) Create() *ProviderRatingCreate {
-	mutation := newProviderRatingMutation(c.config, OpCreate)
-	return &ProviderRatingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ReceiveAddressAssignment entity.
+func (c *ReceiveAddressAssignmentClient) Create() *ReceiveAddressAssignmentCreate {
+	mutation := newReceiveAddressAssignmentMutation(c.config, OpCreate)
+	return &ReceiveAddressAssignmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ProviderRating entities.
-func (c *ProviderRatingClient) CreateBulk(builders ...*ProviderRatingCreate) *ProviderRatingCreateBulk {
-	return &ProviderRatingCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ReceiveAddressAssignment entities.
+func (c *ReceiveAddressAssignmentClient) CreateBulk(builders ...*ReceiveAddressAssignmentCreate) *ReceiveAddressAssignmentCreateBulk {
+	return &ReceiveAddressAssignmentCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ProviderRatingClient) MapCreateBulk(slice any, setFunc func(*ProviderRatingCreate, int)) *ProviderRatingCreateBulk {
+func (c *ReceiveAddressAssignmentClient) MapCreateBulk(slice any, setFunc func(*ReceiveAddressAssignmentCreate, int)) *ReceiveAddressAssignmentCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ProviderRatingCreateBulk{err: fmt.Errorf("calling to ProviderRatingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ReceiveAddressAssignmentCreateBulk{err: fmt.Errorf("calling to ReceiveAddressAssignmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ProviderRatingCreate, rv.Len())
+	builders := make([]*ReceiveAddressAssignmentCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ProviderRatingCreateBulk{config: c.config, builders: builders}
+	return &ReceiveAddressAssignmentCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ProviderRating.
-func (c *ProviderRatingClient) Update() *ProviderRatingUpdate {
-	mutation := newProviderRatingMutation(c.config, OpUpdate)
-	return &ProviderRatingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ReceiveAddressAssignment.
+func (c *ReceiveAddressAssignmentClient) Update() *ReceiveAddressAssignmentUpdate {
+	mutation := newReceiveAddressAssignmentMutation(c.config, OpUpdate)
+	return &ReceiveAddressAssignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ProviderRatingClient) UpdateOne(pr *ProviderRating) *ProviderRatingUpdateOne {
-	mutation := newProviderRatingMutation(c.config, OpUpdateOne, withProviderRating(pr))
-	return &ProviderRatingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ReceiveAddressAssignmentClient) UpdateOne(raa *ReceiveAddressAssignment) *ReceiveAddressAssignmentUpdateOne {
+	mutation := newReceiveAddressAssignmentMutation(c.config, OpUpdateOne, withReceiveAddressAssignment(raa))
+	return &ReceiveAddressAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ProviderRatingClient) UpdateOneID(id int) *ProviderRatingUpdateOne {
-	mutation := newProviderRatingMutation(c.config, OpUpdateOne, withProviderRatingID(id))
-	return &ProviderRatingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ReceiveAddressAssignmentClient) UpdateOneID(id uuid.UUID) *ReceiveAddressAssignmentUpdateOne {
+	mutation := newReceiveAddressAssignmentMutation(c.config, OpUpdateOne, withReceiveAddressAssignmentID(id))
+	return &ReceiveAddressAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ProviderRating.
-func (c *ProviderRatingClient) Delete() *ProviderRatingDelete {
-	mutation := newProviderRatingMutation(c.config, OpDelete)
-	return &ProviderRatingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ReceiveAddressAssignment.
+func (c *ReceiveAddressAssignmentClient) Delete() *ReceiveAddressAssignmentDelete {
+	mutation := newReceiveAddressAssignmentMutation(c.config, OpDelete)
+	return &ReceiveAddressAssignmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ProviderRatingClient) DeleteOne(pr *ProviderRating) *ProviderRatingDeleteOne {
-	return c.DeleteOneID(pr.ID)
+func (c *ReceiveAddressAssignmentClient) DeleteOne(raa *ReceiveAddressAssignment) *ReceiveAddressAssignmentDeleteOne {
+	return c.DeleteOneID(raa.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ProviderRatingClient) DeleteOneID(id int) *ProviderRatingDeleteOne {
-	builder := c.Delete().Where(providerrating.ID(id))
+func (c *ReceiveAddressAssignmentClient) DeleteOneID(id uuid.UUID) *ReceiveAddressAssignmentDeleteOne {
+	builder := c.Delete().Where(receiveaddressassignment.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ProviderRatingDeleteOne{builder}
+	return &ReceiveAddressAssignmentDeleteOne{builder}
 }
 
-// Query returns a query builder for ProviderRating.
-func (c *ProviderRatingClient) Query() *ProviderRatingQuery {
-	return &ProviderRatingQuery{
+// Query returns a query builder for ReceiveAddressAssignment.
+func (c *ReceiveAddressAssignmentClient) Query() *ReceiveAddressAssignmentQuery {
+	return &ReceiveAddressAssignmentQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeProviderRating},
+		ctx:    &QueryContext{Type: TypeReceiveAddressAssignment},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ProviderRating entity by its id.
-func (c *ProviderRatingClient) Get(ctx context.Context, id int) (*ProviderRating, error) {
-	return c.Query().Where(providerrating.ID(id)).Only(ctx)
+// Get returns a ReceiveAddressAssignment entity by its id.
+func (c *ReceiveAddressAssignmentClient) Get(ctx context.Context, id uuid.UUID) (*ReceiveAddressAssignment, error) {
+	return c.Query().Where(receiveaddressassignment.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ProviderRatingClient) GetX(ctx context.Context, id int) *ProviderRating {
+func (c *ReceiveAddressAssignmentClient) GetX(ctx context.Context, id uuid.UUID) *ReceiveAddressAssignment {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3326,148 +6279,164 @@ func (c *ProviderRatingClient) GetX(ctx context.Context, id int) *ProviderRating
 	return obj
 }
 
-// QueryProviderProfile queries the provider_profile edge of a ProviderRating.
-func (c *ProviderRatingClient) QueryProviderProfile(pr *ProviderRating) *ProviderProfileQuery {
-	query := (&ProviderProfileClient{config: c.config}).Query()
+// QueryReceiveAddress queries the receive_address edge of a ReceiveAddressAssignment.
+func (c *ReceiveAddressAssignmentClient) QueryReceiveAddress(raa *ReceiveAddressAssignment) *ReceiveAddressQuery {
+	query := (&ReceiveAddressClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
+		id := raa.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(providerrating.Table, providerrating.FieldID, id),
-			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, providerrating.ProviderProfileTable, providerrating.ProviderProfileColumn),
+			sqlgraph.From(receiveaddressassignment.Table, receiveaddressassignment.FieldID, id),
+			sqlgraph.To(receiveaddress.Table, receiveaddress.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, receiveaddressassignment.ReceiveAddressTable, receiveaddressassignment.ReceiveAddressColumn),
 		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(raa.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPaymentOrder queries the payment_order edge of a ReceiveAddressAssignment.
+func (c *ReceiveAddressAssignmentClient) QueryPaymentOrder(raa *ReceiveAddressAssignment) *PaymentOrderQuery {
+	query := (&PaymentOrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := raa.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(receiveaddressassignment.Table, receiveaddressassignment.FieldID, id),
+			sqlgraph.To(paymentorder.Table, paymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, receiveaddressassignment.PaymentOrderTable, receiveaddressassignment.PaymentOrderColumn),
+		)
+		fromV = sqlgraph.Neighbors(raa.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ProviderRatingClient) Hooks() []Hook {
-	return c.hooks.ProviderRating
+func (c *ReceiveAddressAssignmentClient) Hooks() []Hook {
+	return c.hooks.ReceiveAddressAssignment
 }
 
 // Interceptors returns the client interceptors.
-func (c *ProviderRatingClient) Interceptors() []Interceptor {
-	return c.inters.ProviderRating
+func (c *ReceiveAddressAssignmentClient) Interceptors() []Interceptor {
+	return c.inters.ReceiveAddressAssignment
 }
 
-func (c *ProviderRatingClient) mutate(ctx context.Context, m *ProviderRatingMutation) (Value, error) {
+func (c *ReceiveAddressAssignmentClient) mutate(ctx context.Context, m *ReceiveAddressAssignmentMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ProviderRatingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReceiveAddressAssignmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ProviderRatingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReceiveAddressAssignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ProviderRatingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReceiveAddressAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ProviderRatingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ReceiveAddressAssignmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ProviderRating mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ReceiveAddressAssignment mutation op: %q", m.Op())
 	}
 }
 
-// ProvisionBucketClient is a client for the ProvisionBucket schema.
-type ProvisionBucketClient struct {
+// ReconciliationDiscrepancyClient is a client for the ReconciliationDiscrepancy schema.
+type ReconciliationDiscrepancyClient struct {
 	config
 }
 
-// NewProvisionBucketClient returns a client for the ProvisionBucket from the given config.
-func NewProvisionBucketClient(c config) *ProvisionBucketClient {
-	return &ProvisionBucketClient{config: c}
+// NewReconciliationDiscrepancyClient returns a client for the ReconciliationDiscrepancy from the given config.
+func NewReconciliationDiscrepancyClient(c config) *ReconciliationDiscrepancyClient {
+	return &ReconciliationDiscrepancyClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `provisionbucket.Hooks(f(g(h())))`.
-func (c *ProvisionBucketClient) Use(hooks ...Hook) {
-	c.hooks.ProvisionBucket = append(c.hooks.ProvisionBucket, hooks...)
+// A call to `Use(f, g, h)` equals to `reconciliationdiscrepancy.Hooks(f(g(h())))`.
+func (c *ReconciliationDiscrepancyClient) Use(hooks ...Hook) {
+	c.hooks.ReconciliationDiscrepancy = append(c.hooks.ReconciliationDiscrepancy, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `provisionbucket.Intercept(f(g(h())))`.
-func (c *ProvisionBucketClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ProvisionBucket = append(c.inters.ProvisionBucket, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `reconciliationdiscrepancy.Intercept(f(g(h())))`.
+func (c *ReconciliationDiscrepancyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ReconciliationDiscrepancy = append(c.inters.ReconciliationDiscrepancy, interceptors...)
 }
 
-// Create returns a builder for creating a ProvisionBucket entity.
-func (c *ProvisionBucketClient) Create() *ProvisionBucketCreate {
-	mutation := newProvisionBucketMutation(c.config, OpCreate)
-	return &ProvisionBucketCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ReconciliationDiscrepancy entity.
+func (c *ReconciliationDiscrepancyClient) Create() *ReconciliationDiscrepancyCreate {
+	mutation := newReconciliationDiscrepancyMutation(c.config, OpCreate)
+	return &ReconciliationDiscrepancyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ProvisionBucket entities.
-func (c *ProvisionBucketClient) CreateBulk(builders ...*ProvisionBucketCreate) *ProvisionBucketCreateBulk {
-	return &ProvisionBucketCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ReconciliationDiscrepancy entities.
+func (c *ReconciliationDiscrepancyClient) CreateBulk(builders ...*ReconciliationDiscrepancyCreate) *ReconciliationDiscrepancyCreateBulk {
+	return &ReconciliationDiscrepancyCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ProvisionBucketClient) MapCreateBulk(slice any, setFunc func(*ProvisionBucketCreate, int)) *ProvisionBucketCreateBulk {
+func (c *ReconciliationDiscrepancyClient) MapCreateBulk(slice any, setFunc func(*ReconciliationDiscrepancyCreate, int)) *ReconciliationDiscrepancyCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ProvisionBucketCreateBulk{err: fmt.Errorf("calling to ProvisionBucketClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ReconciliationDiscrepancyCreateBulk{err: fmt.Errorf("calling to ReconciliationDiscrepancyClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ProvisionBucketCreate, rv.Len())
+	builders := make([]*ReconciliationDiscrepancyCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ProvisionBucketCreateBulk{config: c.config, builders: builders}
+	return &ReconciliationDiscrepancyCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ProvisionBucket.
-func (c *ProvisionBucketClient) Update() *ProvisionBucketUpdate {
-	mutation := newProvisionBucketMutation(c.config, OpUpdate)
-	return &ProvisionBucketUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ReconciliationDiscrepancy.
+func (c *ReconciliationDiscrepancyClient) Update() *ReconciliationDiscrepancyUpdate {
+	mutation := newReconciliationDiscrepancyMutation(c.config, OpUpdate)
+	return &ReconciliationDiscrepancyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ProvisionBucketClient) UpdateOne(pb *ProvisionBucket) *ProvisionBucketUpdateOne {
-	mutation := newProvisionBucketMutation(c.config, OpUpdateOne, withProvisionBucket(pb))
-	return &ProvisionBucketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ReconciliationDiscrepancyClient) UpdateOne(rd *ReconciliationDiscrepancy) *ReconciliationDiscrepancyUpdateOne {
+	mutation := newReconciliationDiscrepancyMutation(c.config, OpUpdateOne, withReconciliationDiscrepancy(rd))
+	return &ReconciliationDiscrepancyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ProvisionBucketClient) UpdateOneID(id int) *ProvisionBucketUpdateOne {
-	mutation := newProvisionBucketMutation(c.config, OpUpdateOne, withProvisionBucketID(id))
-	return &ProvisionBucketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ReconciliationDiscrepancyClient) UpdateOneID(id uuid.UUID) *ReconciliationDiscrepancyUpdateOne {
+	mutation := newReconciliationDiscrepancyMutation(c.config, OpUpdateOne, withReconciliationDiscrepancyID(id))
+	return &ReconciliationDiscrepancyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ProvisionBucket.
-func (c *ProvisionBucketClient) Delete() *ProvisionBucketDelete {
-	mutation := newProvisionBucketMutation(c.config, OpDelete)
-	return &ProvisionBucketDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ReconciliationDiscrepancy.
+func (c *ReconciliationDiscrepancyClient) Delete() *ReconciliationDiscrepancyDelete {
+	mutation := newReconciliationDiscrepancyMutation(c.config, OpDelete)
+	return &ReconciliationDiscrepancyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ProvisionBucketClient) DeleteOne(pb *ProvisionBucket) *ProvisionBucketDeleteOne {
-	return c.DeleteOneID(pb.ID)
+func (c *ReconciliationDiscrepancyClient) DeleteOne(rd *ReconciliationDiscrepancy) *ReconciliationDiscrepancyDeleteOne {
+	return c.DeleteOneID(rd.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ProvisionBucketClient) DeleteOneID(id int) *ProvisionBucketDeleteOne {
-	builder := c.Delete().Where(provisionbucket.ID(id))
+func (c *ReconciliationDiscrepancyClient) DeleteOneID(id uuid.UUID) *ReconciliationDiscrepancyDeleteOne {
+	builder := c.Delete().Where(reconciliationdiscrepancy.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ProvisionBucketDeleteOne{builder}
+	return &ReconciliationDiscrepancyDeleteOne{builder}
 }
 
-// Query returns a query builder for ProvisionBucket.
-func (c *ProvisionBucketClient) Query() *ProvisionBucketQuery {
-	return &ProvisionBucketQuery{
+// Query returns a query builder for ReconciliationDiscrepancy.
+func (c *ReconciliationDiscrepancyClient) Query() *ReconciliationDiscrepancyQuery {
+	return &ReconciliationDiscrepancyQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeProvisionBucket},
+		ctx:    &QueryContext{Type: TypeReconciliationDiscrepancy},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ProvisionBucket entity by its id.
-func (c *ProvisionBucketClient) Get(ctx context.Context, id int) (*ProvisionBucket, error) {
-	return c.Query().Where(provisionbucket.ID(id)).Only(ctx)
+// Get returns a ReconciliationDiscrepancy entity by its id.
+func (c *ReconciliationDiscrepancyClient) Get(ctx context.Context, id uuid.UUID) (*ReconciliationDiscrepancy, error) {
+	return c.Query().Where(reconciliationdiscrepancy.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ProvisionBucketClient) GetX(ctx context.Context, id int) *ProvisionBucket {
+func (c *ReconciliationDiscrepancyClient) GetX(ctx context.Context, id uuid.UUID) *ReconciliationDiscrepancy {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3475,180 +6444,164 @@ func (c *ProvisionBucketClient) GetX(ctx context.Context, id int) *ProvisionBuck
 	return obj
 }
 
-// QueryCurrency queries the currency edge of a ProvisionBucket.
-func (c *ProvisionBucketClient) QueryCurrency(pb *ProvisionBucket) *FiatCurrencyQuery {
-	query := (&FiatCurrencyClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pb.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(provisionbucket.Table, provisionbucket.FieldID, id),
-			sqlgraph.To(fiatcurrency.Table, fiatcurrency.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, provisionbucket.CurrencyTable, provisionbucket.CurrencyColumn),
-		)
-		fromV = sqlgraph.Neighbors(pb.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryLockPaymentOrders queries the lock_payment_orders edge of a ProvisionBucket.
-func (c *ProvisionBucketClient) QueryLockPaymentOrders(pb *ProvisionBucket) *LockPaymentOrderQuery {
-	query := (&LockPaymentOrderClient{config: c.config}).Query()
+// QueryReport queries the report edge of a ReconciliationDiscrepancy.
+func (c *ReconciliationDiscrepancyClient) QueryReport(rd *ReconciliationDiscrepancy) *ReconciliationReportQuery {
+	query := (&ReconciliationReportClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pb.ID
+		id := rd.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(provisionbucket.Table, provisionbucket.FieldID, id),
-			sqlgraph.To(lockpaymentorder.Table, lockpaymentorder.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, provisionbucket.LockPaymentOrdersTable, provisionbucket.LockPaymentOrdersColumn),
+			sqlgraph.From(reconciliationdiscrepancy.Table, reconciliationdiscrepancy.FieldID, id),
+			sqlgraph.To(reconciliationreport.Table, reconciliationreport.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, reconciliationdiscrepancy.ReportTable, reconciliationdiscrepancy.ReportColumn),
 		)
-		fromV = sqlgraph.Neighbors(pb.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(rd.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryProviderProfiles queries the provider_profiles edge of a ProvisionBucket.
-func (c *ProvisionBucketClient) QueryProviderProfiles(pb *ProvisionBucket) *ProviderProfileQuery {
-	query := (&ProviderProfileClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pb.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(provisionbucket.Table, provisionbucket.FieldID, id),
-			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, provisionbucket.ProviderProfilesTable, provisionbucket.ProviderProfilesPrimaryKey...),
+// QueryPaymentOrder queries the payment_order edge of a ReconciliationDiscrepancy.
+func (c *ReconciliationDiscrepancyClient) QueryPaymentOrder(rd *ReconciliationDiscrepancy) *PaymentOrderQuery {
+	query := (&PaymentOrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rd.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reconciliationdiscrepancy.Table, reconciliationdiscrepancy.FieldID, id),
+			sqlgraph.To(paymentorder.Table, paymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, reconciliationdiscrepancy.PaymentOrderTable, reconciliationdiscrepancy.PaymentOrderColumn),
 		)
-		fromV = sqlgraph.Neighbors(pb.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(rd.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ProvisionBucketClient) Hooks() []Hook {
-	return c.hooks.ProvisionBucket
+func (c *ReconciliationDiscrepancyClient) Hooks() []Hook {
+	return c.hooks.ReconciliationDiscrepancy
 }
 
 // Interceptors returns the client interceptors.
-func (c *ProvisionBucketClient) Interceptors() []Interceptor {
-	return c.inters.ProvisionBucket
+func (c *ReconciliationDiscrepancyClient) Interceptors() []Interceptor {
+	return c.inters.ReconciliationDiscrepancy
 }
 
-func (c *ProvisionBucketClient) mutate(ctx context.Context, m *ProvisionBucketMutation) (Value, error) {
+func (c *ReconciliationDiscrepancyClient) mutate(ctx context.Context, m *ReconciliationDiscrepancyMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ProvisionBucketCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReconciliationDiscrepancyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ProvisionBucketUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReconciliationDiscrepancyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ProvisionBucketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReconciliationDiscrepancyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ProvisionBucketDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ReconciliationDiscrepancyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ProvisionBucket mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ReconciliationDiscrepancy mutation op: %q", m.Op())
 	}
 }
 
-// ReceiveAddressClient is a client for the ReceiveAddress schema.
-type ReceiveAddressClient struct {
+// ReconciliationReportClient is a client for the ReconciliationReport schema.
+type ReconciliationReportClient struct {
 	config
 }
 
-// NewReceiveAddressClient returns a client for the ReceiveAddress from the given config.
-func NewReceiveAddressClient(c config) *ReceiveAddressClient {
-	return &ReceiveAddressClient{config: c}
+// NewReconciliationReportClient returns a client for the ReconciliationReport from the given config.
+func NewReconciliationReportClient(c config) *ReconciliationReportClient {
+	return &ReconciliationReportClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `receiveaddress.Hooks(f(g(h())))`.
-func (c *ReceiveAddressClient) Use(hooks ...Hook) {
-	c.hooks.ReceiveAddress = append(c.hooks.ReceiveAddress, hooks...)
+// A call to `Use(f, g, h)` equals to `reconciliationreport.Hooks(f(g(h())))`.
+func (c *ReconciliationReportClient) Use(hooks ...Hook) {
+	c.hooks.ReconciliationReport = append(c.hooks.ReconciliationReport, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `receiveaddress.Intercept(f(g(h())))`.
-func (c *ReceiveAddressClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ReceiveAddress = append(c.inters.ReceiveAddress, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `reconciliationreport.Intercept(f(g(h())))`.
+func (c *ReconciliationReportClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ReconciliationReport = append(c.inters.ReconciliationReport, interceptors...)
 }
 
-// Create returns a builder for creating a ReceiveAddress entity.
-func (c *ReceiveAddressClient) Create() *ReceiveAddressCreate {
-	mutation := newReceiveAddressMutation(c.config, OpCreate)
-	return &ReceiveAddressCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ReconciliationReport entity.
+func (c *ReconciliationReportClient) Create() *ReconciliationReportCreate {
+	mutation := newReconciliationReportMutation(c.config, OpCreate)
+	return &ReconciliationReportCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ReceiveAddress entities.
-func (c *ReceiveAddressClient) CreateBulk(builders ...*ReceiveAddressCreate) *ReceiveAddressCreateBulk {
-	return &ReceiveAddressCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ReconciliationReport entities.
+func (c *ReconciliationReportClient) CreateBulk(builders ...*ReconciliationReportCreate) *ReconciliationReportCreateBulk {
+	return &ReconciliationReportCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ReceiveAddressClient) MapCreateBulk(slice any, setFunc func(*ReceiveAddressCreate, int)) *ReceiveAddressCreateBulk {
+func (c *ReconciliationReportClient) MapCreateBulk(slice any, setFunc func(*ReconciliationReportCreate, int)) *ReconciliationReportCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ReceiveAddressCreateBulk{err: fmt.Errorf("calling to ReceiveAddressClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ReconciliationReportCreateBulk{err: fmt.Errorf("calling to ReconciliationReportClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ReceiveAddressCreate, rv.Len())
+	builders := make([]*ReconciliationReportCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ReceiveAddressCreateBulk{config: c.config, builders: builders}
+	return &ReconciliationReportCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ReceiveAddress.
-func (c *ReceiveAddressClient) Update() *ReceiveAddressUpdate {
-	mutation := newReceiveAddressMutation(c.config, OpUpdate)
-	return &ReceiveAddressUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ReconciliationReport.
+func (c *ReconciliationReportClient) Update() *ReconciliationReportUpdate {
+	mutation := newReconciliationReportMutation(c.config, OpUpdate)
+	return &ReconciliationReportUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ReceiveAddressClient) UpdateOne(ra *ReceiveAddress) *ReceiveAddressUpdateOne {
-	mutation := newReceiveAddressMutation(c.config, OpUpdateOne, withReceiveAddress(ra))
-	return &ReceiveAddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ReconciliationReportClient) UpdateOne(rr *ReconciliationReport) *ReconciliationReportUpdateOne {
+	mutation := newReconciliationReportMutation(c.config, OpUpdateOne, withReconciliationReport(rr))
+	return &ReconciliationReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ReceiveAddressClient) UpdateOneID(id int) *ReceiveAddressUpdateOne {
-	mutation := newReceiveAddressMutation(c.config, OpUpdateOne, withReceiveAddressID(id))
-	return &ReceiveAddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ReconciliationReportClient) UpdateOneID(id uuid.UUID) *ReconciliationReportUpdateOne {
+	mutation := newReconciliationReportMutation(c.config, OpUpdateOne, withReconciliationReportID(id))
+	return &ReconciliationReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ReceiveAddress.
-func (c *ReceiveAddressClient) Delete() *ReceiveAddressDelete {
-	mutation := newReceiveAddressMutation(c.config, OpDelete)
-	return &ReceiveAddressDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ReconciliationReport.
+func (c *ReconciliationReportClient) Delete() *ReconciliationReportDelete {
+	mutation := newReconciliationReportMutation(c.config, OpDelete)
+	return &ReconciliationReportDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ReceiveAddressClient) DeleteOne(ra *ReceiveAddress) *ReceiveAddressDeleteOne {
-	return c.DeleteOneID(ra.ID)
+func (c *ReconciliationReportClient) DeleteOne(rr *ReconciliationReport) *ReconciliationReportDeleteOne {
+	return c.DeleteOneID(rr.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ReceiveAddressClient) DeleteOneID(id int) *ReceiveAddressDeleteOne {
-	builder := c.Delete().Where(receiveaddress.ID(id))
+func (c *ReconciliationReportClient) DeleteOneID(id uuid.UUID) *ReconciliationReportDeleteOne {
+	builder := c.Delete().Where(reconciliationreport.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ReceiveAddressDeleteOne{builder}
+	return &ReconciliationReportDeleteOne{builder}
 }
 
-// Query returns a query builder for ReceiveAddress.
-func (c *ReceiveAddressClient) Query() *ReceiveAddressQuery {
-	return &ReceiveAddressQuery{
+// Query returns a query builder for ReconciliationReport.
+func (c *ReconciliationReportClient) Query() *ReconciliationReportQuery {
+	return &ReconciliationReportQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeReceiveAddress},
+		ctx:    &QueryContext{Type: TypeReconciliationReport},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ReceiveAddress entity by its id.
-func (c *ReceiveAddressClient) Get(ctx context.Context, id int) (*ReceiveAddress, error) {
-	return c.Query().Where(receiveaddress.ID(id)).Only(ctx)
+// Get returns a ReconciliationReport entity by its id.
+func (c *ReconciliationReportClient) Get(ctx context.Context, id uuid.UUID) (*ReconciliationReport, error) {
+	return c.Query().Where(reconciliationreport.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ReceiveAddressClient) GetX(ctx context.Context, id int) *ReceiveAddress {
+func (c *ReconciliationReportClient) GetX(ctx context.Context, id uuid.UUID) *ReconciliationReport {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3656,44 +6609,44 @@ func (c *ReceiveAddressClient) GetX(ctx context.Context, id int) *ReceiveAddress
 	return obj
 }
 
-// QueryPaymentOrder queries the payment_order edge of a ReceiveAddress.
-func (c *ReceiveAddressClient) QueryPaymentOrder(ra *ReceiveAddress) *PaymentOrderQuery {
-	query := (&PaymentOrderClient{config: c.config}).Query()
+// QueryDiscrepancies queries the discrepancies edge of a ReconciliationReport.
+func (c *ReconciliationReportClient) QueryDiscrepancies(rr *ReconciliationReport) *ReconciliationDiscrepancyQuery {
+	query := (&ReconciliationDiscrepancyClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := ra.ID
+		id := rr.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(receiveaddress.Table, receiveaddress.FieldID, id),
-			sqlgraph.To(paymentorder.Table, paymentorder.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, receiveaddress.PaymentOrderTable, receiveaddress.PaymentOrderColumn),
+			sqlgraph.From(reconciliationreport.Table, reconciliationreport.FieldID, id),
+			sqlgraph.To(reconciliationdiscrepancy.Table, reconciliationdiscrepancy.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, reconciliationreport.DiscrepanciesTable, reconciliationreport.DiscrepanciesColumn),
 		)
-		fromV = sqlgraph.Neighbors(ra.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(rr.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ReceiveAddressClient) Hooks() []Hook {
-	return c.hooks.ReceiveAddress
+func (c *ReconciliationReportClient) Hooks() []Hook {
+	return c.hooks.ReconciliationReport
 }
 
 // Interceptors returns the client interceptors.
-func (c *ReceiveAddressClient) Interceptors() []Interceptor {
-	return c.inters.ReceiveAddress
+func (c *ReconciliationReportClient) Interceptors() []Interceptor {
+	return c.inters.ReconciliationReport
 }
 
-func (c *ReceiveAddressClient) mutate(ctx context.Context, m *ReceiveAddressMutation) (Value, error) {
+func (c *ReconciliationReportClient) mutate(ctx context.Context, m *ReconciliationReportMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ReceiveAddressCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReconciliationReportCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ReceiveAddressUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReconciliationReportUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ReceiveAddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReconciliationReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ReceiveAddressDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ReconciliationReportDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ReceiveAddress mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ReconciliationReport mutation op: %q", m.Op())
 	}
 }
 
@@ -3970,108 +6923,305 @@ func (c *SenderProfileClient) GetX(ctx context.Context, id uuid.UUID) *SenderPro
 	return obj
 }
 
-// QueryUser queries the user edge of a SenderProfile.
-func (c *SenderProfileClient) QueryUser(sp *SenderProfile) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := sp.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(senderprofile.Table, senderprofile.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, senderprofile.UserTable, senderprofile.UserColumn),
-		)
-		fromV = sqlgraph.Neighbors(sp.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// QueryUser queries the user edge of a SenderProfile.
+func (c *SenderProfileClient) QueryUser(sp *SenderProfile) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(senderprofile.Table, senderprofile.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, senderprofile.UserTable, senderprofile.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(sp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAPIKey queries the api_key edge of a SenderProfile.
+func (c *SenderProfileClient) QueryAPIKey(sp *SenderProfile) *APIKeyQuery {
+	query := (&APIKeyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(senderprofile.Table, senderprofile.FieldID, id),
+			sqlgraph.To(apikey.Table, apikey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, senderprofile.APIKeyTable, senderprofile.APIKeyColumn),
+		)
+		fromV = sqlgraph.Neighbors(sp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPaymentOrders queries the payment_orders edge of a SenderProfile.
+func (c *SenderProfileClient) QueryPaymentOrders(sp *SenderProfile) *PaymentOrderQuery {
+	query := (&PaymentOrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(senderprofile.Table, senderprofile.FieldID, id),
+			sqlgraph.To(paymentorder.Table, paymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, senderprofile.PaymentOrdersTable, senderprofile.PaymentOrdersColumn),
+		)
+		fromV = sqlgraph.Neighbors(sp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrderTokens queries the order_tokens edge of a SenderProfile.
+func (c *SenderProfileClient) QueryOrderTokens(sp *SenderProfile) *SenderOrderTokenQuery {
+	query := (&SenderOrderTokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(senderprofile.Table, senderprofile.FieldID, id),
+			sqlgraph.To(senderordertoken.Table, senderordertoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, senderprofile.OrderTokensTable, senderprofile.OrderTokensColumn),
+		)
+		fromV = sqlgraph.Neighbors(sp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLinkedAddress queries the linked_address edge of a SenderProfile.
+func (c *SenderProfileClient) QueryLinkedAddress(sp *SenderProfile) *LinkedAddressQuery {
+	query := (&LinkedAddressClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(senderprofile.Table, senderprofile.FieldID, id),
+			sqlgraph.To(linkedaddress.Table, linkedaddress.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, senderprofile.LinkedAddressTable, senderprofile.LinkedAddressColumn),
+		)
+		fromV = sqlgraph.Neighbors(sp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWebhookDeliveries queries the webhook_deliveries edge of a SenderProfile.
+func (c *SenderProfileClient) QueryWebhookDeliveries(sp *SenderProfile) *WebhookDeliveryQuery {
+	query := (&WebhookDeliveryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(senderprofile.Table, senderprofile.FieldID, id),
+			sqlgraph.To(webhookdelivery.Table, webhookdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, senderprofile.WebhookDeliveriesTable, senderprofile.WebhookDeliveriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(sp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFeeSchedules queries the fee_schedules edge of a SenderProfile.
+func (c *SenderProfileClient) QueryFeeSchedules(sp *SenderProfile) *FeeScheduleQuery {
+	query := (&FeeScheduleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(senderprofile.Table, senderprofile.FieldID, id),
+			sqlgraph.To(feeschedule.Table, feeschedule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, senderprofile.FeeSchedulesTable, senderprofile.FeeSchedulesColumn),
+		)
+		fromV = sqlgraph.Neighbors(sp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBlockchainRoutes queries the blockchain_routes edge of a SenderProfile.
+func (c *SenderProfileClient) QueryBlockchainRoutes(sp *SenderProfile) *BlockchainRouteQuery {
+	query := (&BlockchainRouteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(senderprofile.Table, senderprofile.FieldID, id),
+			sqlgraph.To(blockchainroute.Table, blockchainroute.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, senderprofile.BlockchainRoutesTable, senderprofile.BlockchainRoutesColumn),
+		)
+		fromV = sqlgraph.Neighbors(sp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SenderProfileClient) Hooks() []Hook {
+	return c.hooks.SenderProfile
+}
+
+// Interceptors returns the client interceptors.
+func (c *SenderProfileClient) Interceptors() []Interceptor {
+	return c.inters.SenderProfile
+}
+
+func (c *SenderProfileClient) mutate(ctx context.Context, m *SenderProfileMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SenderProfileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SenderProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SenderProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SenderProfileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SenderProfile mutation op: %q", m.Op())
+	}
+}
+
+// SweepClient is a client for the Sweep schema.
+type SweepClient struct {
+	config
+}
+
+// NewSweepClient returns a client for the Sweep from the given config.
+func NewSweepClient(c config) *SweepClient {
+	return &SweepClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sweep.Hooks(f(g(h())))`.
+func (c *SweepClient) Use(hooks ...Hook) {
+	c.hooks.Sweep = append(c.hooks.Sweep, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `sweep.Intercept(f(g(h())))`.
+func (c *SweepClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Sweep = append(c.inters.Sweep, interceptors...)
+}
+
+// Create returns a builder for creating a Sweep entity.
+func (c *SweepClient) Create() *SweepCreate {
+	mutation := newSweepMutation(c.config, OpCreate)
+	return &SweepCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Sweep entities.
+func (c *SweepClient) CreateBulk(builders ...*SweepCreate) *SweepCreateBulk {
+	return &SweepCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SweepClient) MapCreateBulk(slice any, setFunc func(*SweepCreate, int)) *SweepCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SweepCreateBulk{err: fmt.Errorf("calling to SweepClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SweepCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SweepCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Sweep.
+func (c *SweepClient) Update() *SweepUpdate {
+	mutation := newSweepMutation(c.config, OpUpdate)
+	return &SweepUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SweepClient) UpdateOne(s *Sweep) *SweepUpdateOne {
+	mutation := newSweepMutation(c.config, OpUpdateOne, withSweep(s))
+	return &SweepUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SweepClient) UpdateOneID(id uuid.UUID) *SweepUpdateOne {
+	mutation := newSweepMutation(c.config, OpUpdateOne, withSweepID(id))
+	return &SweepUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Sweep.
+func (c *SweepClient) Delete() *SweepDelete {
+	mutation := newSweepMutation(c.config, OpDelete)
+	return &SweepDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SweepClient) DeleteOne(s *Sweep) *SweepDeleteOne {
+	return c.DeleteOneID(s.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SweepClient) DeleteOneID(id uuid.UUID) *SweepDeleteOne {
+	builder := c.Delete().Where(sweep.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SweepDeleteOne{builder}
 }
 
-// QueryAPIKey queries the api_key edge of a SenderProfile.
-func (c *SenderProfileClient) QueryAPIKey(sp *SenderProfile) *APIKeyQuery {
-	query := (&APIKeyClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := sp.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(senderprofile.Table, senderprofile.FieldID, id),
-			sqlgraph.To(apikey.Table, apikey.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, senderprofile.APIKeyTable, senderprofile.APIKeyColumn),
-		)
-		fromV = sqlgraph.Neighbors(sp.driver.Dialect(), step)
-		return fromV, nil
+// Query returns a query builder for Sweep.
+func (c *SweepClient) Query() *SweepQuery {
+	return &SweepQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSweep},
+		inters: c.Interceptors(),
 	}
-	return query
 }
 
-// QueryPaymentOrders queries the payment_orders edge of a SenderProfile.
-func (c *SenderProfileClient) QueryPaymentOrders(sp *SenderProfile) *PaymentOrderQuery {
-	query := (&PaymentOrderClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := sp.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(senderprofile.Table, senderprofile.FieldID, id),
-			sqlgraph.To(paymentorder.Table, paymentorder.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, senderprofile.PaymentOrdersTable, senderprofile.PaymentOrdersColumn),
-		)
-		fromV = sqlgraph.Neighbors(sp.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// Get returns a Sweep entity by its id.
+func (c *SweepClient) Get(ctx context.Context, id uuid.UUID) (*Sweep, error) {
+	return c.Query().Where(sweep.ID(id)).Only(ctx)
 }
 
-// QueryOrderTokens queries the order_tokens edge of a SenderProfile.
-func (c *SenderProfileClient) QueryOrderTokens(sp *SenderProfile) *SenderOrderTokenQuery {
-	query := (&SenderOrderTokenClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := sp.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(senderprofile.Table, senderprofile.FieldID, id),
-			sqlgraph.To(senderordertoken.Table, senderordertoken.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, senderprofile.OrderTokensTable, senderprofile.OrderTokensColumn),
-		)
-		fromV = sqlgraph.Neighbors(sp.driver.Dialect(), step)
-		return fromV, nil
+// GetX is like Get, but panics if an error occurs.
+func (c *SweepClient) GetX(ctx context.Context, id uuid.UUID) *Sweep {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
 	}
-	return query
+	return obj
 }
 
-// QueryLinkedAddress queries the linked_address edge of a SenderProfile.
-func (c *SenderProfileClient) QueryLinkedAddress(sp *SenderProfile) *LinkedAddressQuery {
-	query := (&LinkedAddressClient{config: c.config}).Query()
+// QueryUnmatchedDeposit queries the unmatched_deposit edge of a Sweep.
+func (c *SweepClient) QueryUnmatchedDeposit(s *Sweep) *UnmatchedDepositQuery {
+	query := (&UnmatchedDepositClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := sp.ID
+		id := s.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(senderprofile.Table, senderprofile.FieldID, id),
-			sqlgraph.To(linkedaddress.Table, linkedaddress.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, senderprofile.LinkedAddressTable, senderprofile.LinkedAddressColumn),
+			sqlgraph.From(sweep.Table, sweep.FieldID, id),
+			sqlgraph.To(unmatcheddeposit.Table, unmatcheddeposit.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, sweep.UnmatchedDepositTable, sweep.UnmatchedDepositColumn),
 		)
-		fromV = sqlgraph.Neighbors(sp.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *SenderProfileClient) Hooks() []Hook {
-	return c.hooks.SenderProfile
+func (c *SweepClient) Hooks() []Hook {
+	return c.hooks.Sweep
 }
 
 // Interceptors returns the client interceptors.
-func (c *SenderProfileClient) Interceptors() []Interceptor {
-	return c.inters.SenderProfile
+func (c *SweepClient) Interceptors() []Interceptor {
+	return c.inters.Sweep
 }
 
-func (c *SenderProfileClient) mutate(ctx context.Context, m *SenderProfileMutation) (Value, error) {
+func (c *SweepClient) mutate(ctx context.Context, m *SweepMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&SenderProfileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SweepCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&SenderProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SweepUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&SenderProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SweepUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&SenderProfileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SweepDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown SenderProfile mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Sweep mutation op: %q", m.Op())
 	}
 }
 
@@ -4263,6 +7413,38 @@ func (c *TokenClient) QueryProviderOrderTokens(t *Token) *ProviderOrderTokenQuer
 	return query
 }
 
+// QueryLedgerAccounts queries the ledger_accounts edge of a Token.
+func (c *TokenClient) QueryLedgerAccounts(t *Token) *LedgerAccountQuery {
+	query := (&LedgerAccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(token.Table, token.FieldID, id),
+			sqlgraph.To(ledgeraccount.Table, ledgeraccount.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, token.LedgerAccountsTable, token.LedgerAccountsColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUnmatchedDeposits queries the unmatched_deposits edge of a Token.
+func (c *TokenClient) QueryUnmatchedDeposits(t *Token) *UnmatchedDepositQuery {
+	query := (&UnmatchedDepositClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(token.Table, token.FieldID, id),
+			sqlgraph.To(unmatcheddeposit.Table, unmatcheddeposit.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, token.UnmatchedDepositsTable, token.UnmatchedDepositsColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *TokenClient) Hooks() []Hook {
 	return c.hooks.Token
@@ -4276,119 +7458,284 @@ func (c *TokenClient) Interceptors() []Interceptor {
 func (c *TokenClient) mutate(ctx context.Context, m *TokenMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&TokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Token mutation op: %q", m.Op())
+	}
+}
+
+// TransactionLogClient is a client for the TransactionLog schema.
+type TransactionLogClient struct {
+	config
+}
+
+// NewTransactionLogClient returns a client for the TransactionLog from the given config.
+func NewTransactionLogClient(c config) *TransactionLogClient {
+	return &TransactionLogClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `transactionlog.Hooks(f(g(h())))`.
+func (c *TransactionLogClient) Use(hooks ...Hook) {
+	c.hooks.TransactionLog = append(c.hooks.TransactionLog, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `transactionlog.Intercept(f(g(h())))`.
+func (c *TransactionLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TransactionLog = append(c.inters.TransactionLog, interceptors...)
+}
+
+// Create returns a builder for creating a TransactionLog entity.
+func (c *TransactionLogClient) Create() *TransactionLogCreate {
+	mutation := newTransactionLogMutation(c.config, OpCreate)
+	return &TransactionLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TransactionLog entities.
+func (c *TransactionLogClient) CreateBulk(builders ...*TransactionLogCreate) *TransactionLogCreateBulk {
+	return &TransactionLogCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TransactionLogClient) MapCreateBulk(slice any, setFunc func(*TransactionLogCreate, int)) *TransactionLogCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TransactionLogCreateBulk{err: fmt.Errorf("calling to TransactionLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TransactionLogCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TransactionLogCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TransactionLog.
+func (c *TransactionLogClient) Update() *TransactionLogUpdate {
+	mutation := newTransactionLogMutation(c.config, OpUpdate)
+	return &TransactionLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TransactionLogClient) UpdateOne(tl *TransactionLog) *TransactionLogUpdateOne {
+	mutation := newTransactionLogMutation(c.config, OpUpdateOne, withTransactionLog(tl))
+	return &TransactionLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TransactionLogClient) UpdateOneID(id uuid.UUID) *TransactionLogUpdateOne {
+	mutation := newTransactionLogMutation(c.config, OpUpdateOne, withTransactionLogID(id))
+	return &TransactionLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TransactionLog.
+func (c *TransactionLogClient) Delete() *TransactionLogDelete {
+	mutation := newTransactionLogMutation(c.config, OpDelete)
+	return &TransactionLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TransactionLogClient) DeleteOne(tl *TransactionLog) *TransactionLogDeleteOne {
+	return c.DeleteOneID(tl.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TransactionLogClient) DeleteOneID(id uuid.UUID) *TransactionLogDeleteOne {
+	builder := c.Delete().Where(transactionlog.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TransactionLogDeleteOne{builder}
+}
+
+// Query returns a query builder for TransactionLog.
+func (c *TransactionLogClient) Query() *TransactionLogQuery {
+	return &TransactionLogQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTransactionLog},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TransactionLog entity by its id.
+func (c *TransactionLogClient) Get(ctx context.Context, id uuid.UUID) (*TransactionLog, error) {
+	return c.Query().Where(transactionlog.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TransactionLogClient) GetX(ctx context.Context, id uuid.UUID) *TransactionLog {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryReplaces queries the replaces edge of a TransactionLog.
+func (c *TransactionLogClient) QueryReplaces(tl *TransactionLog) *TransactionLogQuery {
+	query := (&TransactionLogClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transactionlog.Table, transactionlog.FieldID, id),
+			sqlgraph.To(transactionlog.Table, transactionlog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, transactionlog.ReplacesTable, transactionlog.ReplacesColumn),
+		)
+		fromV = sqlgraph.Neighbors(tl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReplacedBy queries the replaced_by edge of a TransactionLog.
+func (c *TransactionLogClient) QueryReplacedBy(tl *TransactionLog) *TransactionLogQuery {
+	query := (&TransactionLogClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transactionlog.Table, transactionlog.FieldID, id),
+			sqlgraph.To(transactionlog.Table, transactionlog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, transactionlog.ReplacedByTable, transactionlog.ReplacedByColumn),
+		)
+		fromV = sqlgraph.Neighbors(tl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TransactionLogClient) Hooks() []Hook {
+	return c.hooks.TransactionLog
+}
+
+// Interceptors returns the client interceptors.
+func (c *TransactionLogClient) Interceptors() []Interceptor {
+	return c.inters.TransactionLog
+}
+
+func (c *TransactionLogClient) mutate(ctx context.Context, m *TransactionLogMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TransactionLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&TokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TransactionLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&TokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TransactionLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&TokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&TransactionLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Token mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown TransactionLog mutation op: %q", m.Op())
 	}
 }
 
-// TransactionLogClient is a client for the TransactionLog schema.
-type TransactionLogClient struct {
+// UnmatchedDepositClient is a client for the UnmatchedDeposit schema.
+type UnmatchedDepositClient struct {
 	config
 }
 
-// NewTransactionLogClient returns a client for the TransactionLog from the given config.
-func NewTransactionLogClient(c config) *TransactionLogClient {
-	return &TransactionLogClient{config: c}
+// NewUnmatchedDepositClient returns a client for the UnmatchedDeposit from the given config.
+func NewUnmatchedDepositClient(c config) *UnmatchedDepositClient {
+	return &UnmatchedDepositClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `transactionlog.Hooks(f(g(h())))`.
-func (c *TransactionLogClient) Use(hooks ...Hook) {
-	c.hooks.TransactionLog = append(c.hooks.TransactionLog, hooks...)
+// A call to `Use(f, g, h)` equals to `unmatcheddeposit.Hooks(f(g(h())))`.
+func (c *UnmatchedDepositClient) Use(hooks ...Hook) {
+	c.hooks.UnmatchedDeposit = append(c.hooks.UnmatchedDeposit, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `transactionlog.Intercept(f(g(h())))`.
-func (c *TransactionLogClient) Intercept(interceptors ...Interceptor) {
-	c.inters.TransactionLog = append(c.inters.TransactionLog, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `unmatcheddeposit.Intercept(f(g(h())))`.
+func (c *UnmatchedDepositClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UnmatchedDeposit = append(c.inters.UnmatchedDeposit, interceptors...)
 }
 
-// Create returns a builder for creating a TransactionLog entity.
-func (c *TransactionLogClient) Create() *TransactionLogCreate {
-	mutation := newTransactionLogMutation(c.config, OpCreate)
-	return &TransactionLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a UnmatchedDeposit entity.
+func (c *UnmatchedDepositClient) Create() *UnmatchedDepositCreate {
+	mutation := newUnmatchedDepositMutation(c.config, OpCreate)
+	return &UnmatchedDepositCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of TransactionLog entities.
-func (c *TransactionLogClient) CreateBulk(builders ...*TransactionLogCreate) *TransactionLogCreateBulk {
-	return &TransactionLogCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of UnmatchedDeposit entities.
+func (c *UnmatchedDepositClient) CreateBulk(builders ...*UnmatchedDepositCreate) *UnmatchedDepositCreateBulk {
+	return &UnmatchedDepositCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *TransactionLogClient) MapCreateBulk(slice any, setFunc func(*TransactionLogCreate, int)) *TransactionLogCreateBulk {
+func (c *UnmatchedDepositClient) MapCreateBulk(slice any, setFunc func(*UnmatchedDepositCreate, int)) *UnmatchedDepositCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &TransactionLogCreateBulk{err: fmt.Errorf("calling to TransactionLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &UnmatchedDepositCreateBulk{err: fmt.Errorf("calling to UnmatchedDepositClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*TransactionLogCreate, rv.Len())
+	builders := make([]*UnmatchedDepositCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &TransactionLogCreateBulk{config: c.config, builders: builders}
+	return &UnmatchedDepositCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for TransactionLog.
-func (c *TransactionLogClient) Update() *TransactionLogUpdate {
-	mutation := newTransactionLogMutation(c.config, OpUpdate)
-	return &TransactionLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for UnmatchedDeposit.
+func (c *UnmatchedDepositClient) Update() *UnmatchedDepositUpdate {
+	mutation := newUnmatchedDepositMutation(c.config, OpUpdate)
+	return &UnmatchedDepositUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *TransactionLogClient) UpdateOne(tl *TransactionLog) *TransactionLogUpdateOne {
-	mutation := newTransactionLogMutation(c.config, OpUpdateOne, withTransactionLog(tl))
-	return &TransactionLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *UnmatchedDepositClient) UpdateOne(ud *UnmatchedDeposit) *UnmatchedDepositUpdateOne {
+	mutation := newUnmatchedDepositMutation(c.config, OpUpdateOne, withUnmatchedDeposit(ud))
+	return &UnmatchedDepositUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *TransactionLogClient) UpdateOneID(id uuid.UUID) *TransactionLogUpdateOne {
-	mutation := newTransactionLogMutation(c.config, OpUpdateOne, withTransactionLogID(id))
-	return &TransactionLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *UnmatchedDepositClient) UpdateOneID(id uuid.UUID) *UnmatchedDepositUpdateOne {
+	mutation := newUnmatchedDepositMutation(c.config, OpUpdateOne, withUnmatchedDepositID(id))
+	return &UnmatchedDepositUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for TransactionLog.
-func (c *TransactionLogClient) Delete() *TransactionLogDelete {
-	mutation := newTransactionLogMutation(c.config, OpDelete)
-	return &TransactionLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for UnmatchedDeposit.
+func (c *UnmatchedDepositClient) Delete() *UnmatchedDepositDelete {
+	mutation := newUnmatchedDepositMutation(c.config, OpDelete)
+	return &UnmatchedDepositDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *TransactionLogClient) DeleteOne(tl *TransactionLog) *TransactionLogDeleteOne {
-	return c.DeleteOneID(tl.ID)
+func (c *UnmatchedDepositClient) DeleteOne(ud *UnmatchedDeposit) *UnmatchedDepositDeleteOne {
+	return c.DeleteOneID(ud.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *TransactionLogClient) DeleteOneID(id uuid.UUID) *TransactionLogDeleteOne {
-	builder := c.Delete().Where(transactionlog.ID(id))
+func (c *UnmatchedDepositClient) DeleteOneID(id uuid.UUID) *UnmatchedDepositDeleteOne {
+	builder := c.Delete().Where(unmatcheddeposit.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &TransactionLogDeleteOne{builder}
+	return &UnmatchedDepositDeleteOne{builder}
 }
 
-// Query returns a query builder for TransactionLog.
-func (c *TransactionLogClient) Query() *TransactionLogQuery {
-	return &TransactionLogQuery{
+// Query returns a query builder for UnmatchedDeposit.
+func (c *UnmatchedDepositClient) Query() *UnmatchedDepositQuery {
+	return &UnmatchedDepositQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeTransactionLog},
+		ctx:    &QueryContext{Type: TypeUnmatchedDeposit},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a TransactionLog entity by its id.
-func (c *TransactionLogClient) Get(ctx context.Context, id uuid.UUID) (*TransactionLog, error) {
-	return c.Query().Where(transactionlog.ID(id)).Only(ctx)
+// Get returns a UnmatchedDeposit entity by its id.
+func (c *UnmatchedDepositClient) Get(ctx context.Context, id uuid.UUID) (*UnmatchedDeposit, error) {
+	return c.Query().Where(unmatcheddeposit.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *TransactionLogClient) GetX(ctx context.Context, id uuid.UUID) *TransactionLog {
+func (c *UnmatchedDepositClient) GetX(ctx context.Context, id uuid.UUID) *UnmatchedDeposit {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -4396,28 +7743,76 @@ func (c *TransactionLogClient) GetX(ctx context.Context, id uuid.UUID) *Transact
 	return obj
 }
 
+// QueryToken queries the token edge of a UnmatchedDeposit.
+func (c *UnmatchedDepositClient) QueryToken(ud *UnmatchedDeposit) *TokenQuery {
+	query := (&TokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ud.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(unmatcheddeposit.Table, unmatcheddeposit.FieldID, id),
+			sqlgraph.To(token.Table, token.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, unmatcheddeposit.TokenTable, unmatcheddeposit.TokenColumn),
+		)
+		fromV = sqlgraph.Neighbors(ud.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPaymentOrder queries the payment_order edge of a UnmatchedDeposit.
+func (c *UnmatchedDepositClient) QueryPaymentOrder(ud *UnmatchedDeposit) *PaymentOrderQuery {
+	query := (&PaymentOrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ud.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(unmatcheddeposit.Table, unmatcheddeposit.FieldID, id),
+			sqlgraph.To(paymentorder.Table, paymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, unmatcheddeposit.PaymentOrderTable, unmatcheddeposit.PaymentOrderColumn),
+		)
+		fromV = sqlgraph.Neighbors(ud.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySweep queries the sweep edge of a UnmatchedDeposit.
+func (c *UnmatchedDepositClient) QuerySweep(ud *UnmatchedDeposit) *SweepQuery {
+	query := (&SweepClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ud.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(unmatcheddeposit.Table, unmatcheddeposit.FieldID, id),
+			sqlgraph.To(sweep.Table, sweep.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, unmatcheddeposit.SweepTable, unmatcheddeposit.SweepColumn),
+		)
+		fromV = sqlgraph.Neighbors(ud.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
-func (c *TransactionLogClient) Hooks() []Hook {
-	return c.hooks.TransactionLog
+func (c *UnmatchedDepositClient) Hooks() []Hook {
+	return c.hooks.UnmatchedDeposit
 }
 
 // Interceptors returns the client interceptors.
-func (c *TransactionLogClient) Interceptors() []Interceptor {
-	return c.inters.TransactionLog
+func (c *UnmatchedDepositClient) Interceptors() []Interceptor {
+	return c.inters.UnmatchedDeposit
 }
 
-func (c *TransactionLogClient) mutate(ctx context.Context, m *TransactionLogMutation) (Value, error) {
+func (c *UnmatchedDepositClient) mutate(ctx context.Context, m *UnmatchedDepositMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&TransactionLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UnmatchedDepositCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&TransactionLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UnmatchedDepositUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&TransactionLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UnmatchedDepositUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&TransactionLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&UnmatchedDepositDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown TransactionLog mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown UnmatchedDeposit mutation op: %q", m.Op())
 	}
 }
 
@@ -4769,6 +8164,288 @@ func (c *VerificationTokenClient) mutate(ctx context.Context, m *VerificationTok
 	}
 }
 
+// WebhookDeliveryClient is a client for the WebhookDelivery schema.
+type WebhookDeliveryClient struct {
+	config
+}
+
+// NewWebhookDeliveryClient returns a client for the WebhookDelivery from the given config.
+func NewWebhookDeliveryClient(c config) *WebhookDeliveryClient {
+	return &WebhookDeliveryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhookdelivery.Hooks(f(g(h())))`.
+func (c *WebhookDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.WebhookDelivery = append(c.hooks.WebhookDelivery, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhookdelivery.Intercept(f(g(h())))`.
+func (c *WebhookDeliveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookDelivery = append(c.inters.WebhookDelivery, interceptors...)
+}
+
+// Create returns a builder for creating a WebhookDelivery entity.
+func (c *WebhookDeliveryClient) Create() *WebhookDeliveryCreate {
+	mutation := newWebhookDeliveryMutation(c.config, OpCreate)
+	return &WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookDelivery entities.
+func (c *WebhookDeliveryClient) CreateBulk(builders ...*WebhookDeliveryCreate) *WebhookDeliveryCreateBulk {
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookDeliveryClient) MapCreateBulk(slice any, setFunc func(*WebhookDeliveryCreate, int)) *WebhookDeliveryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookDeliveryCreateBulk{err: fmt.Errorf("calling to WebhookDeliveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookDeliveryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Update() *WebhookDeliveryUpdate {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdate)
+	return &WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookDeliveryClient) UpdateOne(wd *WebhookDelivery) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDelivery(wd))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookDeliveryClient) UpdateOneID(id uuid.UUID) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDeliveryID(id))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Delete() *WebhookDeliveryDelete {
+	mutation := newWebhookDeliveryMutation(c.config, OpDelete)
+	return &WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookDeliveryClient) DeleteOne(wd *WebhookDelivery) *WebhookDeliveryDeleteOne {
+	return c.DeleteOneID(wd.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookDeliveryClient) DeleteOneID(id uuid.UUID) *WebhookDeliveryDeleteOne {
+	builder := c.Delete().Where(webhookdelivery.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookDeliveryDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Query() *WebhookDeliveryQuery {
+	return &WebhookDeliveryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhookDelivery},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebhookDelivery entity by its id.
+func (c *WebhookDeliveryClient) Get(ctx context.Context, id uuid.UUID) (*WebhookDelivery, error) {
+	return c.Query().Where(webhookdelivery.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookDeliveryClient) GetX(ctx context.Context, id uuid.UUID) *WebhookDelivery {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySenderProfile queries the sender_profile edge of a WebhookDelivery.
+func (c *WebhookDeliveryClient) QuerySenderProfile(wd *WebhookDelivery) *SenderProfileQuery {
+	query := (&SenderProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := wd.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhookdelivery.Table, webhookdelivery.FieldID, id),
+			sqlgraph.To(senderprofile.Table, senderprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, webhookdelivery.SenderProfileTable, webhookdelivery.SenderProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(wd.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookDeliveryClient) Hooks() []Hook {
+	return c.hooks.WebhookDelivery
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookDeliveryClient) Interceptors() []Interceptor {
+	return c.inters.WebhookDelivery
+}
+
+func (c *WebhookDeliveryClient) mutate(ctx context.Context, m *WebhookDeliveryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WebhookDelivery mutation op: %q", m.Op())
+	}
+}
+
+// WebhookDestinationClient is a client for the WebhookDestination schema.
+type WebhookDestinationClient struct {
+	config
+}
+
+// NewWebhookDestinationClient returns a client for the WebhookDestination from the given config.
+func NewWebhookDestinationClient(c config) *WebhookDestinationClient {
+	return &WebhookDestinationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhookdestination.Hooks(f(g(h())))`.
+func (c *WebhookDestinationClient) Use(hooks ...Hook) {
+	c.hooks.WebhookDestination = append(c.hooks.WebhookDestination, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhookdestination.Intercept(f(g(h())))`.
+func (c *WebhookDestinationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookDestination = append(c.inters.WebhookDestination, interceptors...)
+}
+
+// Create returns a builder for creating a WebhookDestination entity.
+func (c *WebhookDestinationClient) Create() *WebhookDestinationCreate {
+	mutation := newWebhookDestinationMutation(c.config, OpCreate)
+	return &WebhookDestinationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookDestination entities.
+func (c *WebhookDestinationClient) CreateBulk(builders ...*WebhookDestinationCreate) *WebhookDestinationCreateBulk {
+	return &WebhookDestinationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookDestinationClient) MapCreateBulk(slice any, setFunc func(*WebhookDestinationCreate, int)) *WebhookDestinationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookDestinationCreateBulk{err: fmt.Errorf("calling to WebhookDestinationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookDestinationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookDestinationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookDestination.
+func (c *WebhookDestinationClient) Update() *WebhookDestinationUpdate {
+	mutation := newWebhookDestinationMutation(c.config, OpUpdate)
+	return &WebhookDestinationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookDestinationClient) UpdateOne(wd *WebhookDestination) *WebhookDestinationUpdateOne {
+	mutation := newWebhookDestinationMutation(c.config, OpUpdateOne, withWebhookDestination(wd))
+	return &WebhookDestinationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookDestinationClient) UpdateOneID(id uuid.UUID) *WebhookDestinationUpdateOne {
+	mutation := newWebhookDestinationMutation(c.config, OpUpdateOne, withWebhookDestinationID(id))
+	return &WebhookDestinationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookDestination.
+func (c *WebhookDestinationClient) Delete() *WebhookDestinationDelete {
+	mutation := newWebhookDestinationMutation(c.config, OpDelete)
+	return &WebhookDestinationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookDestinationClient) DeleteOne(wd *WebhookDestination) *WebhookDestinationDeleteOne {
+	return c.DeleteOneID(wd.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookDestinationClient) DeleteOneID(id uuid.UUID) *WebhookDestinationDeleteOne {
+	builder := c.Delete().Where(webhookdestination.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookDestinationDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookDestination.
+func (c *WebhookDestinationClient) Query() *WebhookDestinationQuery {
+	return &WebhookDestinationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhookDestination},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebhookDestination entity by its id.
+func (c *WebhookDestinationClient) Get(ctx context.Context, id uuid.UUID) (*WebhookDestination, error) {
+	return c.Query().Where(webhookdestination.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookDestinationClient) GetX(ctx context.Context, id uuid.UUID) *WebhookDestination {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookDestinationClient) Hooks() []Hook {
+	return c.hooks.WebhookDestination
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookDestinationClient) Interceptors() []Interceptor {
+	return c.inters.WebhookDestination
+}
+
+func (c *WebhookDestinationClient) mutate(ctx context.Context, m *WebhookDestinationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookDestinationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookDestinationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookDestinationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookDestinationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WebhookDestination mutation op: %q", m.Op())
+	}
+}
+
 // WebhookRetryAttemptClient is a client for the WebhookRetryAttempt schema.
 type WebhookRetryAttemptClient struct {
 	config
@@ -4905,19 +8582,31 @@ func (c *WebhookRetryAttemptClient) mutate(ctx context.Context, m *WebhookRetryA
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		APIKey, BeneficialOwner, FiatCurrency, IdentityVerificationRequest, Institution,
-		KYBProfile, LinkedAddress, LockOrderFulfillment, LockPaymentOrder, Network,
-		PaymentOrder, PaymentOrderRecipient, PaymentWebhook, ProviderCurrencies,
-		ProviderOrderToken, ProviderProfile, ProviderRating, ProvisionBucket,
-		ReceiveAddress, SenderOrderToken, SenderProfile, Token, TransactionLog, User,
-		VerificationToken, WebhookRetryAttempt []ent.Hook
+		APIKey, AdminAuditLog, BeneficialOwner, BlockchainRoute, DeadLetter,
+		DenylistedAddress, DepositSplit, FeeSchedule, FiatCurrency,
+		IdentityVerificationRequest, Institution, KYBProfile, LedgerAccount,
+		LedgerEntry, LedgerPosting, LinkedAddress, LockOrderFulfillment,
+		LockOrderReassignment, LockPaymentOrder, Network, OutboxTransaction,
+		PaymentOrder, PaymentOrderRecipient, PaymentWebhook, ProviderBalanceSnapshot,
+		ProviderCurrencies, ProviderOrderToken, ProviderPerformance, ProviderProfile,
+		ProviderRating, ProvisionBucket, RPCEndpoint, ReceiveAddress,
+		ReceiveAddressAssignment, ReconciliationDiscrepancy, ReconciliationReport,
+		SenderOrderToken, SenderProfile, Sweep, Token, TransactionLog,
+		UnmatchedDeposit, User, VerificationToken, WebhookDelivery, WebhookDestination,
+		WebhookRetryAttempt []ent.Hook
 	}
 	inters struct {
-		APIKey, BeneficialOwner, FiatCurrency, IdentityVerificationRequest, Institution,
-		KYBProfile, LinkedAddress, LockOrderFulfillment, LockPaymentOrder, Network,
-		PaymentOrder, PaymentOrderRecipient, PaymentWebhook, ProviderCurrencies,
-		ProviderOrderToken, ProviderProfile, ProviderRating, ProvisionBucket,
-		ReceiveAddress, SenderOrderToken, SenderProfile, Token, TransactionLog, User,
-		VerificationToken, WebhookRetryAttempt []ent.Interceptor
+		APIKey, AdminAuditLog, BeneficialOwner, BlockchainRoute, DeadLetter,
+		DenylistedAddress, DepositSplit, FeeSchedule, FiatCurrency,
+		IdentityVerificationRequest, Institution, KYBProfile, LedgerAccount,
+		LedgerEntry, LedgerPosting, LinkedAddress, LockOrderFulfillment,
+		LockOrderReassignment, LockPaymentOrder, Network, OutboxTransaction,
+		PaymentOrder, PaymentOrderRecipient, PaymentWebhook, ProviderBalanceSnapshot,
+		ProviderCurrencies, ProviderOrderToken, ProviderPerformance, ProviderProfile,
+		ProviderRating, ProvisionBucket, RPCEndpoint, ReceiveAddress,
+		ReceiveAddressAssignment, ReconciliationDiscrepancy, ReconciliationReport,
+		SenderOrderToken, SenderProfile, Sweep, Token, TransactionLog,
+		UnmatchedDeposit, User, VerificationToken, WebhookDelivery, WebhookDestination,
+		WebhookRetryAttempt []ent.Interceptor
 	}
 )
@@ -0,0 +1,224 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/NEDA-LABS/stablenode/ent/deadletter"
+	"github.com/google/uuid"
+)
+
+// DeadLetter is the model entity for the DeadLetter schema.
+type DeadLetter struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// OrderID holds the value of the "order_id" field.
+	OrderID string `json:"order_id,omitempty"`
+	// Stage holds the value of the "stage" field.
+	Stage deadletter.Stage `json:"stage,omitempty"`
+	// Network holds the value of the "network" field.
+	Network string `json:"network,omitempty"`
+	// Payload holds the value of the "payload" field.
+	Payload map[string]interface{} `json:"payload,omitempty"`
+	// LastError holds the value of the "last_error" field.
+	LastError string `json:"last_error,omitempty"`
+	// Status holds the value of the "status" field.
+	Status deadletter.Status `json:"status,omitempty"`
+	// RetryCount holds the value of the "retry_count" field.
+	RetryCount int `json:"retry_count,omitempty"`
+	// NextRetryAt holds the value of the "next_retry_at" field.
+	NextRetryAt time.Time `json:"next_retry_at,omitempty"`
+	// ResolvedAt holds the value of the "resolved_at" field.
+	ResolvedAt   time.Time `json:"resolved_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DeadLetter) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case deadletter.FieldPayload:
+			values[i] = new([]byte)
+		case deadletter.FieldRetryCount:
+			values[i] = new(sql.NullInt64)
+		case deadletter.FieldOrderID, deadletter.FieldStage, deadletter.FieldNetwork, deadletter.FieldLastError, deadletter.FieldStatus:
+			values[i] = new(sql.NullString)
+		case deadletter.FieldCreatedAt, deadletter.FieldUpdatedAt, deadletter.FieldNextRetryAt, deadletter.FieldResolvedAt:
+			values[i] = new(sql.NullTime)
+		case deadletter.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DeadLetter fields.
+func (dl *DeadLetter) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case deadletter.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				dl.ID = *value
+			}
+		case deadletter.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				dl.CreatedAt = value.Time
+			}
+		case deadletter.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				dl.UpdatedAt = value.Time
+			}
+		case deadletter.FieldOrderID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field order_id", values[i])
+			} else if value.Valid {
+				dl.OrderID = value.String
+			}
+		case deadletter.FieldStage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field stage", values[i])
+			} else if value.Valid {
+				dl.Stage = deadletter.Stage(value.String)
+			}
+		case deadletter.FieldNetwork:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field network", values[i])
+			} else if value.Valid {
+				dl.Network = value.String
+			}
+		case deadletter.FieldPayload:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field payload", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &dl.Payload); err != nil {
+					return fmt.Errorf("unmarshal field payload: %w", err)
+				}
+			}
+		case deadletter.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				dl.LastError = value.String
+			}
+		case deadletter.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				dl.Status = deadletter.Status(value.String)
+			}
+		case deadletter.FieldRetryCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field retry_count", values[i])
+			} else if value.Valid {
+				dl.RetryCount = int(value.Int64)
+			}
+		case deadletter.FieldNextRetryAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_retry_at", values[i])
+			} else if value.Valid {
+				dl.NextRetryAt = value.Time
+			}
+		case deadletter.FieldResolvedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field resolved_at", values[i])
+			} else if value.Valid {
+				dl.ResolvedAt = value.Time
+			}
+		default:
+			dl.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DeadLetter.
+// This includes values selected through modifiers, order, etc.
+func (dl *DeadLetter) Value(name string) (ent.Value, error) {
+	return dl.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DeadLetter.
+// Note that you need to call DeadLetter.Unwrap() before calling this method if this DeadLetter
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (dl *DeadLetter) Update() *DeadLetterUpdateOne {
+	return NewDeadLetterClient(dl.config).UpdateOne(dl)
+}
+
+// Unwrap unwraps the DeadLetter entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (dl *DeadLetter) Unwrap() *DeadLetter {
+	_tx, ok := dl.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DeadLetter is not a transactional entity")
+	}
+	dl.config.driver = _tx.drv
+	return dl
+}
+
+// String implements the fmt.Stringer.
+func (dl *DeadLetter) String() string {
+	var builder strings.Builder
+	builder.WriteString("DeadLetter(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", dl.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(dl.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(dl.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("order_id=")
+	builder.WriteString(dl.OrderID)
+	builder.WriteString(", ")
+	builder.WriteString("stage=")
+	builder.WriteString(fmt.Sprintf("%v", dl.Stage))
+	builder.WriteString(", ")
+	builder.WriteString("network=")
+	builder.WriteString(dl.Network)
+	builder.WriteString(", ")
+	builder.WriteString("payload=")
+	builder.WriteString(fmt.Sprintf("%v", dl.Payload))
+	builder.WriteString(", ")
+	builder.WriteString("last_error=")
+	builder.WriteString(dl.LastError)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", dl.Status))
+	builder.WriteString(", ")
+	builder.WriteString("retry_count=")
+	builder.WriteString(fmt.Sprintf("%v", dl.RetryCount))
+	builder.WriteString(", ")
+	builder.WriteString("next_retry_at=")
+	builder.WriteString(dl.NextRetryAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("resolved_at=")
+	builder.WriteString(dl.ResolvedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DeadLetters is a parsable slice of DeadLetter.
+type DeadLetters []*DeadLetter
@@ -0,0 +1,989 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/deadletter"
+	"github.com/google/uuid"
+)
+
+// DeadLetterCreate is the builder for creating a DeadLetter entity.
+type DeadLetterCreate struct {
+	config
+	mutation *DeadLetterMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dlc *DeadLetterCreate) SetCreatedAt(t time.Time) *DeadLetterCreate {
+	dlc.mutation.SetCreatedAt(t)
+	return dlc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dlc *DeadLetterCreate) SetNillableCreatedAt(t *time.Time) *DeadLetterCreate {
+	if t != nil {
+		dlc.SetCreatedAt(*t)
+	}
+	return dlc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dlc *DeadLetterCreate) SetUpdatedAt(t time.Time) *DeadLetterCreate {
+	dlc.mutation.SetUpdatedAt(t)
+	return dlc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (dlc *DeadLetterCreate) SetNillableUpdatedAt(t *time.Time) *DeadLetterCreate {
+	if t != nil {
+		dlc.SetUpdatedAt(*t)
+	}
+	return dlc
+}
+
+// SetOrderID sets the "order_id" field.
+func (dlc *DeadLetterCreate) SetOrderID(s string) *DeadLetterCreate {
+	dlc.mutation.SetOrderID(s)
+	return dlc
+}
+
+// SetStage sets the "stage" field.
+func (dlc *DeadLetterCreate) SetStage(d deadletter.Stage) *DeadLetterCreate {
+	dlc.mutation.SetStage(d)
+	return dlc
+}
+
+// SetNetwork sets the "network" field.
+func (dlc *DeadLetterCreate) SetNetwork(s string) *DeadLetterCreate {
+	dlc.mutation.SetNetwork(s)
+	return dlc
+}
+
+// SetPayload sets the "payload" field.
+func (dlc *DeadLetterCreate) SetPayload(m map[string]interface{}) *DeadLetterCreate {
+	dlc.mutation.SetPayload(m)
+	return dlc
+}
+
+// SetLastError sets the "last_error" field.
+func (dlc *DeadLetterCreate) SetLastError(s string) *DeadLetterCreate {
+	dlc.mutation.SetLastError(s)
+	return dlc
+}
+
+// SetStatus sets the "status" field.
+func (dlc *DeadLetterCreate) SetStatus(d deadletter.Status) *DeadLetterCreate {
+	dlc.mutation.SetStatus(d)
+	return dlc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (dlc *DeadLetterCreate) SetNillableStatus(d *deadletter.Status) *DeadLetterCreate {
+	if d != nil {
+		dlc.SetStatus(*d)
+	}
+	return dlc
+}
+
+// SetRetryCount sets the "retry_count" field.
+func (dlc *DeadLetterCreate) SetRetryCount(i int) *DeadLetterCreate {
+	dlc.mutation.SetRetryCount(i)
+	return dlc
+}
+
+// SetNillableRetryCount sets the "retry_count" field if the given value is not nil.
+func (dlc *DeadLetterCreate) SetNillableRetryCount(i *int) *DeadLetterCreate {
+	if i != nil {
+		dlc.SetRetryCount(*i)
+	}
+	return dlc
+}
+
+// SetNextRetryAt sets the "next_retry_at" field.
+func (dlc *DeadLetterCreate) SetNextRetryAt(t time.Time) *DeadLetterCreate {
+	dlc.mutation.SetNextRetryAt(t)
+	return dlc
+}
+
+// SetNillableNextRetryAt sets the "next_retry_at" field if the given value is not nil.
+func (dlc *DeadLetterCreate) SetNillableNextRetryAt(t *time.Time) *DeadLetterCreate {
+	if t != nil {
+		dlc.SetNextRetryAt(*t)
+	}
+	return dlc
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (dlc *DeadLetterCreate) SetResolvedAt(t time.Time) *DeadLetterCreate {
+	dlc.mutation.SetResolvedAt(t)
+	return dlc
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (dlc *DeadLetterCreate) SetNillableResolvedAt(t *time.Time) *DeadLetterCreate {
+	if t != nil {
+		dlc.SetResolvedAt(*t)
+	}
+	return dlc
+}
+
+// SetID sets the "id" field.
+func (dlc *DeadLetterCreate) SetID(u uuid.UUID) *DeadLetterCreate {
+	dlc.mutation.SetID(u)
+	return dlc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (dlc *DeadLetterCreate) SetNillableID(u *uuid.UUID) *DeadLetterCreate {
+	if u != nil {
+		dlc.SetID(*u)
+	}
+	return dlc
+}
+
+// Mutation returns the DeadLetterMutation object of the builder.
+func (dlc *DeadLetterCreate) Mutation() *DeadLetterMutation {
+	return dlc.mutation
+}
+
+// Save creates the DeadLetter in the database.
+func (dlc *DeadLetterCreate) Save(ctx context.Context) (*DeadLetter, error) {
+	dlc.defaults()
+	return withHooks(ctx, dlc.sqlSave, dlc.mutation, dlc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dlc *DeadLetterCreate) SaveX(ctx context.Context) *DeadLetter {
+	v, err := dlc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dlc *DeadLetterCreate) Exec(ctx context.Context) error {
+	_, err := dlc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dlc *DeadLetterCreate) ExecX(ctx context.Context) {
+	if err := dlc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dlc *DeadLetterCreate) defaults() {
+	if _, ok := dlc.mutation.CreatedAt(); !ok {
+		v := deadletter.DefaultCreatedAt()
+		dlc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := dlc.mutation.UpdatedAt(); !ok {
+		v := deadletter.DefaultUpdatedAt()
+		dlc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := dlc.mutation.Status(); !ok {
+		v := deadletter.DefaultStatus
+		dlc.mutation.SetStatus(v)
+	}
+	if _, ok := dlc.mutation.RetryCount(); !ok {
+		v := deadletter.DefaultRetryCount
+		dlc.mutation.SetRetryCount(v)
+	}
+	if _, ok := dlc.mutation.NextRetryAt(); !ok {
+		v := deadletter.DefaultNextRetryAt()
+		dlc.mutation.SetNextRetryAt(v)
+	}
+	if _, ok := dlc.mutation.ID(); !ok {
+		v := deadletter.DefaultID()
+		dlc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dlc *DeadLetterCreate) check() error {
+	if _, ok := dlc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DeadLetter.created_at"`)}
+	}
+	if _, ok := dlc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "DeadLetter.updated_at"`)}
+	}
+	if _, ok := dlc.mutation.OrderID(); !ok {
+		return &ValidationError{Name: "order_id", err: errors.New(`ent: missing required field "DeadLetter.order_id"`)}
+	}
+	if _, ok := dlc.mutation.Stage(); !ok {
+		return &ValidationError{Name: "stage", err: errors.New(`ent: missing required field "DeadLetter.stage"`)}
+	}
+	if v, ok := dlc.mutation.Stage(); ok {
+		if err := deadletter.StageValidator(v); err != nil {
+			return &ValidationError{Name: "stage", err: fmt.Errorf(`ent: validator failed for field "DeadLetter.stage": %w`, err)}
+		}
+	}
+	if _, ok := dlc.mutation.Network(); !ok {
+		return &ValidationError{Name: "network", err: errors.New(`ent: missing required field "DeadLetter.network"`)}
+	}
+	if _, ok := dlc.mutation.Payload(); !ok {
+		return &ValidationError{Name: "payload", err: errors.New(`ent: missing required field "DeadLetter.payload"`)}
+	}
+	if _, ok := dlc.mutation.LastError(); !ok {
+		return &ValidationError{Name: "last_error", err: errors.New(`ent: missing required field "DeadLetter.last_error"`)}
+	}
+	if v, ok := dlc.mutation.LastError(); ok {
+		if err := deadletter.LastErrorValidator(v); err != nil {
+			return &ValidationError{Name: "last_error", err: fmt.Errorf(`ent: validator failed for field "DeadLetter.last_error": %w`, err)}
+		}
+	}
+	if _, ok := dlc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "DeadLetter.status"`)}
+	}
+	if v, ok := dlc.mutation.Status(); ok {
+		if err := deadletter.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DeadLetter.status": %w`, err)}
+		}
+	}
+	if _, ok := dlc.mutation.RetryCount(); !ok {
+		return &ValidationError{Name: "retry_count", err: errors.New(`ent: missing required field "DeadLetter.retry_count"`)}
+	}
+	if _, ok := dlc.mutation.NextRetryAt(); !ok {
+		return &ValidationError{Name: "next_retry_at", err: errors.New(`ent: missing required field "DeadLetter.next_retry_at"`)}
+	}
+	return nil
+}
+
+func (dlc *DeadLetterCreate) sqlSave(ctx context.Context) (*DeadLetter, error) {
+	if err := dlc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dlc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dlc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	dlc.mutation.id = &_node.ID
+	dlc.mutation.done = true
+	return _node, nil
+}
+
+func (dlc *DeadLetterCreate) createSpec() (*DeadLetter, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DeadLetter{config: dlc.config}
+		_spec = sqlgraph.NewCreateSpec(deadletter.Table, sqlgraph.NewFieldSpec(deadletter.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = dlc.conflict
+	if id, ok := dlc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := dlc.mutation.CreatedAt(); ok {
+		_spec.SetField(deadletter.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := dlc.mutation.UpdatedAt(); ok {
+		_spec.SetField(deadletter.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := dlc.mutation.OrderID(); ok {
+		_spec.SetField(deadletter.FieldOrderID, field.TypeString, value)
+		_node.OrderID = value
+	}
+	if value, ok := dlc.mutation.Stage(); ok {
+		_spec.SetField(deadletter.FieldStage, field.TypeEnum, value)
+		_node.Stage = value
+	}
+	if value, ok := dlc.mutation.Network(); ok {
+		_spec.SetField(deadletter.FieldNetwork, field.TypeString, value)
+		_node.Network = value
+	}
+	if value, ok := dlc.mutation.Payload(); ok {
+		_spec.SetField(deadletter.FieldPayload, field.TypeJSON, value)
+		_node.Payload = value
+	}
+	if value, ok := dlc.mutation.LastError(); ok {
+		_spec.SetField(deadletter.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := dlc.mutation.Status(); ok {
+		_spec.SetField(deadletter.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := dlc.mutation.RetryCount(); ok {
+		_spec.SetField(deadletter.FieldRetryCount, field.TypeInt, value)
+		_node.RetryCount = value
+	}
+	if value, ok := dlc.mutation.NextRetryAt(); ok {
+		_spec.SetField(deadletter.FieldNextRetryAt, field.TypeTime, value)
+		_node.NextRetryAt = value
+	}
+	if value, ok := dlc.mutation.ResolvedAt(); ok {
+		_spec.SetField(deadletter.FieldResolvedAt, field.TypeTime, value)
+		_node.ResolvedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DeadLetter.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DeadLetterUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (dlc *DeadLetterCreate) OnConflict(opts ...sql.ConflictOption) *DeadLetterUpsertOne {
+	dlc.conflict = opts
+	return &DeadLetterUpsertOne{
+		create: dlc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DeadLetter.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dlc *DeadLetterCreate) OnConflictColumns(columns ...string) *DeadLetterUpsertOne {
+	dlc.conflict = append(dlc.conflict, sql.ConflictColumns(columns...))
+	return &DeadLetterUpsertOne{
+		create: dlc,
+	}
+}
+
+type (
+	// DeadLetterUpsertOne is the builder for "upsert"-ing
+	//  one DeadLetter node.
+	DeadLetterUpsertOne struct {
+		create *DeadLetterCreate
+	}
+
+	// DeadLetterUpsert is the "OnConflict" setter.
+	DeadLetterUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DeadLetterUpsert) SetUpdatedAt(v time.Time) *DeadLetterUpsert {
+	u.Set(deadletter.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DeadLetterUpsert) UpdateUpdatedAt() *DeadLetterUpsert {
+	u.SetExcluded(deadletter.FieldUpdatedAt)
+	return u
+}
+
+// SetLastError sets the "last_error" field.
+func (u *DeadLetterUpsert) SetLastError(v string) *DeadLetterUpsert {
+	u.Set(deadletter.FieldLastError, v)
+	return u
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *DeadLetterUpsert) UpdateLastError() *DeadLetterUpsert {
+	u.SetExcluded(deadletter.FieldLastError)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *DeadLetterUpsert) SetStatus(v deadletter.Status) *DeadLetterUpsert {
+	u.Set(deadletter.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *DeadLetterUpsert) UpdateStatus() *DeadLetterUpsert {
+	u.SetExcluded(deadletter.FieldStatus)
+	return u
+}
+
+// SetRetryCount sets the "retry_count" field.
+func (u *DeadLetterUpsert) SetRetryCount(v int) *DeadLetterUpsert {
+	u.Set(deadletter.FieldRetryCount, v)
+	return u
+}
+
+// UpdateRetryCount sets the "retry_count" field to the value that was provided on create.
+func (u *DeadLetterUpsert) UpdateRetryCount() *DeadLetterUpsert {
+	u.SetExcluded(deadletter.FieldRetryCount)
+	return u
+}
+
+// AddRetryCount adds v to the "retry_count" field.
+func (u *DeadLetterUpsert) AddRetryCount(v int) *DeadLetterUpsert {
+	u.Add(deadletter.FieldRetryCount, v)
+	return u
+}
+
+// SetNextRetryAt sets the "next_retry_at" field.
+func (u *DeadLetterUpsert) SetNextRetryAt(v time.Time) *DeadLetterUpsert {
+	u.Set(deadletter.FieldNextRetryAt, v)
+	return u
+}
+
+// UpdateNextRetryAt sets the "next_retry_at" field to the value that was provided on create.
+func (u *DeadLetterUpsert) UpdateNextRetryAt() *DeadLetterUpsert {
+	u.SetExcluded(deadletter.FieldNextRetryAt)
+	return u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (u *DeadLetterUpsert) SetResolvedAt(v time.Time) *DeadLetterUpsert {
+	u.Set(deadletter.FieldResolvedAt, v)
+	return u
+}
+
+// UpdateResolvedAt sets the "resolved_at" field to the value that was provided on create.
+func (u *DeadLetterUpsert) UpdateResolvedAt() *DeadLetterUpsert {
+	u.SetExcluded(deadletter.FieldResolvedAt)
+	return u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (u *DeadLetterUpsert) ClearResolvedAt() *DeadLetterUpsert {
+	u.SetNull(deadletter.FieldResolvedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DeadLetter.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(deadletter.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DeadLetterUpsertOne) UpdateNewValues() *DeadLetterUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(deadletter.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(deadletter.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.OrderID(); exists {
+			s.SetIgnore(deadletter.FieldOrderID)
+		}
+		if _, exists := u.create.mutation.Stage(); exists {
+			s.SetIgnore(deadletter.FieldStage)
+		}
+		if _, exists := u.create.mutation.Network(); exists {
+			s.SetIgnore(deadletter.FieldNetwork)
+		}
+		if _, exists := u.create.mutation.Payload(); exists {
+			s.SetIgnore(deadletter.FieldPayload)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DeadLetter.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DeadLetterUpsertOne) Ignore() *DeadLetterUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DeadLetterUpsertOne) DoNothing() *DeadLetterUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DeadLetterCreate.OnConflict
+// documentation for more info.
+func (u *DeadLetterUpsertOne) Update(set func(*DeadLetterUpsert)) *DeadLetterUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DeadLetterUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DeadLetterUpsertOne) SetUpdatedAt(v time.Time) *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DeadLetterUpsertOne) UpdateUpdatedAt() *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *DeadLetterUpsertOne) SetLastError(v string) *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *DeadLetterUpsertOne) UpdateLastError() *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *DeadLetterUpsertOne) SetStatus(v deadletter.Status) *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *DeadLetterUpsertOne) UpdateStatus() *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetRetryCount sets the "retry_count" field.
+func (u *DeadLetterUpsertOne) SetRetryCount(v int) *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetRetryCount(v)
+	})
+}
+
+// AddRetryCount adds v to the "retry_count" field.
+func (u *DeadLetterUpsertOne) AddRetryCount(v int) *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.AddRetryCount(v)
+	})
+}
+
+// UpdateRetryCount sets the "retry_count" field to the value that was provided on create.
+func (u *DeadLetterUpsertOne) UpdateRetryCount() *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateRetryCount()
+	})
+}
+
+// SetNextRetryAt sets the "next_retry_at" field.
+func (u *DeadLetterUpsertOne) SetNextRetryAt(v time.Time) *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetNextRetryAt(v)
+	})
+}
+
+// UpdateNextRetryAt sets the "next_retry_at" field to the value that was provided on create.
+func (u *DeadLetterUpsertOne) UpdateNextRetryAt() *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateNextRetryAt()
+	})
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (u *DeadLetterUpsertOne) SetResolvedAt(v time.Time) *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetResolvedAt(v)
+	})
+}
+
+// UpdateResolvedAt sets the "resolved_at" field to the value that was provided on create.
+func (u *DeadLetterUpsertOne) UpdateResolvedAt() *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateResolvedAt()
+	})
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (u *DeadLetterUpsertOne) ClearResolvedAt() *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.ClearResolvedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DeadLetterUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DeadLetterCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DeadLetterUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DeadLetterUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: DeadLetterUpsertOne.ID is not supported by MySQL driver. Use DeadLetterUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DeadLetterUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DeadLetterCreateBulk is the builder for creating many DeadLetter entities in bulk.
+type DeadLetterCreateBulk struct {
+	config
+	err      error
+	builders []*DeadLetterCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DeadLetter entities in the database.
+func (dlcb *DeadLetterCreateBulk) Save(ctx context.Context) ([]*DeadLetter, error) {
+	if dlcb.err != nil {
+		return nil, dlcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(dlcb.builders))
+	nodes := make([]*DeadLetter, len(dlcb.builders))
+	mutators := make([]Mutator, len(dlcb.builders))
+	for i := range dlcb.builders {
+		func(i int, root context.Context) {
+			builder := dlcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DeadLetterMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dlcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = dlcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dlcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dlcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dlcb *DeadLetterCreateBulk) SaveX(ctx context.Context) []*DeadLetter {
+	v, err := dlcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dlcb *DeadLetterCreateBulk) Exec(ctx context.Context) error {
+	_, err := dlcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dlcb *DeadLetterCreateBulk) ExecX(ctx context.Context) {
+	if err := dlcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DeadLetter.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DeadLetterUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (dlcb *DeadLetterCreateBulk) OnConflict(opts ...sql.ConflictOption) *DeadLetterUpsertBulk {
+	dlcb.conflict = opts
+	return &DeadLetterUpsertBulk{
+		create: dlcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DeadLetter.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dlcb *DeadLetterCreateBulk) OnConflictColumns(columns ...string) *DeadLetterUpsertBulk {
+	dlcb.conflict = append(dlcb.conflict, sql.ConflictColumns(columns...))
+	return &DeadLetterUpsertBulk{
+		create: dlcb,
+	}
+}
+
+// DeadLetterUpsertBulk is the builder for "upsert"-ing
+// a bulk of DeadLetter nodes.
+type DeadLetterUpsertBulk struct {
+	create *DeadLetterCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DeadLetter.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(deadletter.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DeadLetterUpsertBulk) UpdateNewValues() *DeadLetterUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(deadletter.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(deadletter.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.OrderID(); exists {
+				s.SetIgnore(deadletter.FieldOrderID)
+			}
+			if _, exists := b.mutation.Stage(); exists {
+				s.SetIgnore(deadletter.FieldStage)
+			}
+			if _, exists := b.mutation.Network(); exists {
+				s.SetIgnore(deadletter.FieldNetwork)
+			}
+			if _, exists := b.mutation.Payload(); exists {
+				s.SetIgnore(deadletter.FieldPayload)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DeadLetter.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DeadLetterUpsertBulk) Ignore() *DeadLetterUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DeadLetterUpsertBulk) DoNothing() *DeadLetterUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DeadLetterCreateBulk.OnConflict
+// documentation for more info.
+func (u *DeadLetterUpsertBulk) Update(set func(*DeadLetterUpsert)) *DeadLetterUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DeadLetterUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DeadLetterUpsertBulk) SetUpdatedAt(v time.Time) *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DeadLetterUpsertBulk) UpdateUpdatedAt() *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *DeadLetterUpsertBulk) SetLastError(v string) *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *DeadLetterUpsertBulk) UpdateLastError() *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *DeadLetterUpsertBulk) SetStatus(v deadletter.Status) *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *DeadLetterUpsertBulk) UpdateStatus() *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetRetryCount sets the "retry_count" field.
+func (u *DeadLetterUpsertBulk) SetRetryCount(v int) *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetRetryCount(v)
+	})
+}
+
+// AddRetryCount adds v to the "retry_count" field.
+func (u *DeadLetterUpsertBulk) AddRetryCount(v int) *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.AddRetryCount(v)
+	})
+}
+
+// UpdateRetryCount sets the "retry_count" field to the value that was provided on create.
+func (u *DeadLetterUpsertBulk) UpdateRetryCount() *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateRetryCount()
+	})
+}
+
+// SetNextRetryAt sets the "next_retry_at" field.
+func (u *DeadLetterUpsertBulk) SetNextRetryAt(v time.Time) *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetNextRetryAt(v)
+	})
+}
+
+// UpdateNextRetryAt sets the "next_retry_at" field to the value that was provided on create.
+func (u *DeadLetterUpsertBulk) UpdateNextRetryAt() *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateNextRetryAt()
+	})
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (u *DeadLetterUpsertBulk) SetResolvedAt(v time.Time) *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetResolvedAt(v)
+	})
+}
+
+// UpdateResolvedAt sets the "resolved_at" field to the value that was provided on create.
+func (u *DeadLetterUpsertBulk) UpdateResolvedAt() *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateResolvedAt()
+	})
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (u *DeadLetterUpsertBulk) ClearResolvedAt() *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.ClearResolvedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DeadLetterUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DeadLetterCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DeadLetterCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DeadLetterUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/deadletter"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+)
+
+// DeadLetterDelete is the builder for deleting a DeadLetter entity.
+type DeadLetterDelete struct {
+	config
+	hooks    []Hook
+	mutation *DeadLetterMutation
+}
+
+// Where appends a list predicates to the DeadLetterDelete builder.
+func (dld *DeadLetterDelete) Where(ps ...predicate.DeadLetter) *DeadLetterDelete {
+	dld.mutation.Where(ps...)
+	return dld
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (dld *DeadLetterDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, dld.sqlExec, dld.mutation, dld.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dld *DeadLetterDelete) ExecX(ctx context.Context) int {
+	n, err := dld.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (dld *DeadLetterDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(deadletter.Table, sqlgraph.NewFieldSpec(deadletter.FieldID, field.TypeUUID))
+	if ps := dld.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, dld.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	dld.mutation.done = true
+	return affected, err
+}
+
+// DeadLetterDeleteOne is the builder for deleting a single DeadLetter entity.
+type DeadLetterDeleteOne struct {
+	dld *DeadLetterDelete
+}
+
+// Where appends a list predicates to the DeadLetterDelete builder.
+func (dldo *DeadLetterDeleteOne) Where(ps ...predicate.DeadLetter) *DeadLetterDeleteOne {
+	dldo.dld.mutation.Where(ps...)
+	return dldo
+}
+
+// Exec executes the deletion query.
+func (dldo *DeadLetterDeleteOne) Exec(ctx context.Context) error {
+	n, err := dldo.dld.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{deadletter.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dldo *DeadLetterDeleteOne) ExecX(ctx context.Context) {
+	if err := dldo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
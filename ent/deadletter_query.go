@@ -0,0 +1,528 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/deadletter"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// DeadLetterQuery is the builder for querying DeadLetter entities.
+type DeadLetterQuery struct {
+	config
+	ctx        *QueryContext
+	order      []deadletter.OrderOption
+	inters     []Interceptor
+	predicates []predicate.DeadLetter
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the DeadLetterQuery builder.
+func (dlq *DeadLetterQuery) Where(ps ...predicate.DeadLetter) *DeadLetterQuery {
+	dlq.predicates = append(dlq.predicates, ps...)
+	return dlq
+}
+
+// Limit the number of records to be returned by this query.
+func (dlq *DeadLetterQuery) Limit(limit int) *DeadLetterQuery {
+	dlq.ctx.Limit = &limit
+	return dlq
+}
+
+// Offset to start from.
+func (dlq *DeadLetterQuery) Offset(offset int) *DeadLetterQuery {
+	dlq.ctx.Offset = &offset
+	return dlq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (dlq *DeadLetterQuery) Unique(unique bool) *DeadLetterQuery {
+	dlq.ctx.Unique = &unique
+	return dlq
+}
+
+// Order specifies how the records should be ordered.
+func (dlq *DeadLetterQuery) Order(o ...deadletter.OrderOption) *DeadLetterQuery {
+	dlq.order = append(dlq.order, o...)
+	return dlq
+}
+
+// First returns the first DeadLetter entity from the query.
+// Returns a *NotFoundError when no DeadLetter was found.
+func (dlq *DeadLetterQuery) First(ctx context.Context) (*DeadLetter, error) {
+	nodes, err := dlq.Limit(1).All(setContextOp(ctx, dlq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{deadletter.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (dlq *DeadLetterQuery) FirstX(ctx context.Context) *DeadLetter {
+	node, err := dlq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first DeadLetter ID from the query.
+// Returns a *NotFoundError when no DeadLetter ID was found.
+func (dlq *DeadLetterQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = dlq.Limit(1).IDs(setContextOp(ctx, dlq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{deadletter.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (dlq *DeadLetterQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := dlq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single DeadLetter entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one DeadLetter entity is found.
+// Returns a *NotFoundError when no DeadLetter entities are found.
+func (dlq *DeadLetterQuery) Only(ctx context.Context) (*DeadLetter, error) {
+	nodes, err := dlq.Limit(2).All(setContextOp(ctx, dlq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{deadletter.Label}
+	default:
+		return nil, &NotSingularError{deadletter.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (dlq *DeadLetterQuery) OnlyX(ctx context.Context) *DeadLetter {
+	node, err := dlq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only DeadLetter ID in the query.
+// Returns a *NotSingularError when more than one DeadLetter ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (dlq *DeadLetterQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = dlq.Limit(2).IDs(setContextOp(ctx, dlq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{deadletter.Label}
+	default:
+		err = &NotSingularError{deadletter.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (dlq *DeadLetterQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := dlq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of DeadLetters.
+func (dlq *DeadLetterQuery) All(ctx context.Context) ([]*DeadLetter, error) {
+	ctx = setContextOp(ctx, dlq.ctx, ent.OpQueryAll)
+	if err := dlq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*DeadLetter, *DeadLetterQuery]()
+	return withInterceptors[[]*DeadLetter](ctx, dlq, qr, dlq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (dlq *DeadLetterQuery) AllX(ctx context.Context) []*DeadLetter {
+	nodes, err := dlq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of DeadLetter IDs.
+func (dlq *DeadLetterQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if dlq.ctx.Unique == nil && dlq.path != nil {
+		dlq.Unique(true)
+	}
+	ctx = setContextOp(ctx, dlq.ctx, ent.OpQueryIDs)
+	if err = dlq.Select(deadletter.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (dlq *DeadLetterQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := dlq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (dlq *DeadLetterQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, dlq.ctx, ent.OpQueryCount)
+	if err := dlq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, dlq, querierCount[*DeadLetterQuery](), dlq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (dlq *DeadLetterQuery) CountX(ctx context.Context) int {
+	count, err := dlq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (dlq *DeadLetterQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, dlq.ctx, ent.OpQueryExist)
+	switch _, err := dlq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (dlq *DeadLetterQuery) ExistX(ctx context.Context) bool {
+	exist, err := dlq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the DeadLetterQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (dlq *DeadLetterQuery) Clone() *DeadLetterQuery {
+	if dlq == nil {
+		return nil
+	}
+	return &DeadLetterQuery{
+		config:     dlq.config,
+		ctx:        dlq.ctx.Clone(),
+		order:      append([]deadletter.OrderOption{}, dlq.order...),
+		inters:     append([]Interceptor{}, dlq.inters...),
+		predicates: append([]predicate.DeadLetter{}, dlq.predicates...),
+		// clone intermediate query.
+		sql:  dlq.sql.Clone(),
+		path: dlq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.DeadLetter.Query().
+//		GroupBy(deadletter.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (dlq *DeadLetterQuery) GroupBy(field string, fields ...string) *DeadLetterGroupBy {
+	dlq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &DeadLetterGroupBy{build: dlq}
+	grbuild.flds = &dlq.ctx.Fields
+	grbuild.label = deadletter.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.DeadLetter.Query().
+//		Select(deadletter.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (dlq *DeadLetterQuery) Select(fields ...string) *DeadLetterSelect {
+	dlq.ctx.Fields = append(dlq.ctx.Fields, fields...)
+	sbuild := &DeadLetterSelect{DeadLetterQuery: dlq}
+	sbuild.label = deadletter.Label
+	sbuild.flds, sbuild.scan = &dlq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a DeadLetterSelect configured with the given aggregations.
+func (dlq *DeadLetterQuery) Aggregate(fns ...AggregateFunc) *DeadLetterSelect {
+	return dlq.Select().Aggregate(fns...)
+}
+
+func (dlq *DeadLetterQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range dlq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, dlq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range dlq.ctx.Fields {
+		if !deadletter.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if dlq.path != nil {
+		prev, err := dlq.path(ctx)
+		if err != nil {
+			return err
+		}
+		dlq.sql = prev
+	}
+	return nil
+}
+
+func (dlq *DeadLetterQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*DeadLetter, error) {
+	var (
+		nodes = []*DeadLetter{}
+		_spec = dlq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*DeadLetter).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &DeadLetter{config: dlq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, dlq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (dlq *DeadLetterQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := dlq.querySpec()
+	_spec.Node.Columns = dlq.ctx.Fields
+	if len(dlq.ctx.Fields) > 0 {
+		_spec.Unique = dlq.ctx.Unique != nil && *dlq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, dlq.driver, _spec)
+}
+
+func (dlq *DeadLetterQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(deadletter.Table, deadletter.Columns, sqlgraph.NewFieldSpec(deadletter.FieldID, field.TypeUUID))
+	_spec.From = dlq.sql
+	if unique := dlq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if dlq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := dlq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, deadletter.FieldID)
+		for i := range fields {
+			if fields[i] != deadletter.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := dlq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := dlq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := dlq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := dlq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (dlq *DeadLetterQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(dlq.driver.Dialect())
+	t1 := builder.Table(deadletter.Table)
+	columns := dlq.ctx.Fields
+	if len(columns) == 0 {
+		columns = deadletter.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if dlq.sql != nil {
+		selector = dlq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if dlq.ctx.Unique != nil && *dlq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range dlq.predicates {
+		p(selector)
+	}
+	for _, p := range dlq.order {
+		p(selector)
+	}
+	if offset := dlq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := dlq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// DeadLetterGroupBy is the group-by builder for DeadLetter entities.
+type DeadLetterGroupBy struct {
+	selector
+	build *DeadLetterQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (dlgb *DeadLetterGroupBy) Aggregate(fns ...AggregateFunc) *DeadLetterGroupBy {
+	dlgb.fns = append(dlgb.fns, fns...)
+	return dlgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (dlgb *DeadLetterGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, dlgb.build.ctx, ent.OpQueryGroupBy)
+	if err := dlgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DeadLetterQuery, *DeadLetterGroupBy](ctx, dlgb.build, dlgb, dlgb.build.inters, v)
+}
+
+func (dlgb *DeadLetterGroupBy) sqlScan(ctx context.Context, root *DeadLetterQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(dlgb.fns))
+	for _, fn := range dlgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*dlgb.flds)+len(dlgb.fns))
+		for _, f := range *dlgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*dlgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dlgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// DeadLetterSelect is the builder for selecting fields of DeadLetter entities.
+type DeadLetterSelect struct {
+	*DeadLetterQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (dls *DeadLetterSelect) Aggregate(fns ...AggregateFunc) *DeadLetterSelect {
+	dls.fns = append(dls.fns, fns...)
+	return dls
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (dls *DeadLetterSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, dls.ctx, ent.OpQuerySelect)
+	if err := dls.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DeadLetterQuery, *DeadLetterSelect](ctx, dls.DeadLetterQuery, dls, dls.inters, v)
+}
+
+func (dls *DeadLetterSelect) sqlScan(ctx context.Context, root *DeadLetterQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(dls.fns))
+	for _, fn := range dls.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*dls.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dls.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,456 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/deadletter"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+)
+
+// DeadLetterUpdate is the builder for updating DeadLetter entities.
+type DeadLetterUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DeadLetterMutation
+}
+
+// Where appends a list predicates to the DeadLetterUpdate builder.
+func (dlu *DeadLetterUpdate) Where(ps ...predicate.DeadLetter) *DeadLetterUpdate {
+	dlu.mutation.Where(ps...)
+	return dlu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dlu *DeadLetterUpdate) SetUpdatedAt(t time.Time) *DeadLetterUpdate {
+	dlu.mutation.SetUpdatedAt(t)
+	return dlu
+}
+
+// SetLastError sets the "last_error" field.
+func (dlu *DeadLetterUpdate) SetLastError(s string) *DeadLetterUpdate {
+	dlu.mutation.SetLastError(s)
+	return dlu
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (dlu *DeadLetterUpdate) SetNillableLastError(s *string) *DeadLetterUpdate {
+	if s != nil {
+		dlu.SetLastError(*s)
+	}
+	return dlu
+}
+
+// SetStatus sets the "status" field.
+func (dlu *DeadLetterUpdate) SetStatus(d deadletter.Status) *DeadLetterUpdate {
+	dlu.mutation.SetStatus(d)
+	return dlu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (dlu *DeadLetterUpdate) SetNillableStatus(d *deadletter.Status) *DeadLetterUpdate {
+	if d != nil {
+		dlu.SetStatus(*d)
+	}
+	return dlu
+}
+
+// SetRetryCount sets the "retry_count" field.
+func (dlu *DeadLetterUpdate) SetRetryCount(i int) *DeadLetterUpdate {
+	dlu.mutation.ResetRetryCount()
+	dlu.mutation.SetRetryCount(i)
+	return dlu
+}
+
+// SetNillableRetryCount sets the "retry_count" field if the given value is not nil.
+func (dlu *DeadLetterUpdate) SetNillableRetryCount(i *int) *DeadLetterUpdate {
+	if i != nil {
+		dlu.SetRetryCount(*i)
+	}
+	return dlu
+}
+
+// AddRetryCount adds i to the "retry_count" field.
+func (dlu *DeadLetterUpdate) AddRetryCount(i int) *DeadLetterUpdate {
+	dlu.mutation.AddRetryCount(i)
+	return dlu
+}
+
+// SetNextRetryAt sets the "next_retry_at" field.
+func (dlu *DeadLetterUpdate) SetNextRetryAt(t time.Time) *DeadLetterUpdate {
+	dlu.mutation.SetNextRetryAt(t)
+	return dlu
+}
+
+// SetNillableNextRetryAt sets the "next_retry_at" field if the given value is not nil.
+func (dlu *DeadLetterUpdate) SetNillableNextRetryAt(t *time.Time) *DeadLetterUpdate {
+	if t != nil {
+		dlu.SetNextRetryAt(*t)
+	}
+	return dlu
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (dlu *DeadLetterUpdate) SetResolvedAt(t time.Time) *DeadLetterUpdate {
+	dlu.mutation.SetResolvedAt(t)
+	return dlu
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (dlu *DeadLetterUpdate) SetNillableResolvedAt(t *time.Time) *DeadLetterUpdate {
+	if t != nil {
+		dlu.SetResolvedAt(*t)
+	}
+	return dlu
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (dlu *DeadLetterUpdate) ClearResolvedAt() *DeadLetterUpdate {
+	dlu.mutation.ClearResolvedAt()
+	return dlu
+}
+
+// Mutation returns the DeadLetterMutation object of the builder.
+func (dlu *DeadLetterUpdate) Mutation() *DeadLetterMutation {
+	return dlu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (dlu *DeadLetterUpdate) Save(ctx context.Context) (int, error) {
+	dlu.defaults()
+	return withHooks(ctx, dlu.sqlSave, dlu.mutation, dlu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dlu *DeadLetterUpdate) SaveX(ctx context.Context) int {
+	affected, err := dlu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (dlu *DeadLetterUpdate) Exec(ctx context.Context) error {
+	_, err := dlu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dlu *DeadLetterUpdate) ExecX(ctx context.Context) {
+	if err := dlu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dlu *DeadLetterUpdate) defaults() {
+	if _, ok := dlu.mutation.UpdatedAt(); !ok {
+		v := deadletter.UpdateDefaultUpdatedAt()
+		dlu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dlu *DeadLetterUpdate) check() error {
+	if v, ok := dlu.mutation.LastError(); ok {
+		if err := deadletter.LastErrorValidator(v); err != nil {
+			return &ValidationError{Name: "last_error", err: fmt.Errorf(`ent: validator failed for field "DeadLetter.last_error": %w`, err)}
+		}
+	}
+	if v, ok := dlu.mutation.Status(); ok {
+		if err := deadletter.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DeadLetter.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (dlu *DeadLetterUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := dlu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(deadletter.Table, deadletter.Columns, sqlgraph.NewFieldSpec(deadletter.FieldID, field.TypeUUID))
+	if ps := dlu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dlu.mutation.UpdatedAt(); ok {
+		_spec.SetField(deadletter.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := dlu.mutation.LastError(); ok {
+		_spec.SetField(deadletter.FieldLastError, field.TypeString, value)
+	}
+	if value, ok := dlu.mutation.Status(); ok {
+		_spec.SetField(deadletter.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := dlu.mutation.RetryCount(); ok {
+		_spec.SetField(deadletter.FieldRetryCount, field.TypeInt, value)
+	}
+	if value, ok := dlu.mutation.AddedRetryCount(); ok {
+		_spec.AddField(deadletter.FieldRetryCount, field.TypeInt, value)
+	}
+	if value, ok := dlu.mutation.NextRetryAt(); ok {
+		_spec.SetField(deadletter.FieldNextRetryAt, field.TypeTime, value)
+	}
+	if value, ok := dlu.mutation.ResolvedAt(); ok {
+		_spec.SetField(deadletter.FieldResolvedAt, field.TypeTime, value)
+	}
+	if dlu.mutation.ResolvedAtCleared() {
+		_spec.ClearField(deadletter.FieldResolvedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, dlu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deadletter.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	dlu.mutation.done = true
+	return n, nil
+}
+
+// DeadLetterUpdateOne is the builder for updating a single DeadLetter entity.
+type DeadLetterUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DeadLetterMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dluo *DeadLetterUpdateOne) SetUpdatedAt(t time.Time) *DeadLetterUpdateOne {
+	dluo.mutation.SetUpdatedAt(t)
+	return dluo
+}
+
+// SetLastError sets the "last_error" field.
+func (dluo *DeadLetterUpdateOne) SetLastError(s string) *DeadLetterUpdateOne {
+	dluo.mutation.SetLastError(s)
+	return dluo
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (dluo *DeadLetterUpdateOne) SetNillableLastError(s *string) *DeadLetterUpdateOne {
+	if s != nil {
+		dluo.SetLastError(*s)
+	}
+	return dluo
+}
+
+// SetStatus sets the "status" field.
+func (dluo *DeadLetterUpdateOne) SetStatus(d deadletter.Status) *DeadLetterUpdateOne {
+	dluo.mutation.SetStatus(d)
+	return dluo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (dluo *DeadLetterUpdateOne) SetNillableStatus(d *deadletter.Status) *DeadLetterUpdateOne {
+	if d != nil {
+		dluo.SetStatus(*d)
+	}
+	return dluo
+}
+
+// SetRetryCount sets the "retry_count" field.
+func (dluo *DeadLetterUpdateOne) SetRetryCount(i int) *DeadLetterUpdateOne {
+	dluo.mutation.ResetRetryCount()
+	dluo.mutation.SetRetryCount(i)
+	return dluo
+}
+
+// SetNillableRetryCount sets the "retry_count" field if the given value is not nil.
+func (dluo *DeadLetterUpdateOne) SetNillableRetryCount(i *int) *DeadLetterUpdateOne {
+	if i != nil {
+		dluo.SetRetryCount(*i)
+	}
+	return dluo
+}
+
+// AddRetryCount adds i to the "retry_count" field.
+func (dluo *DeadLetterUpdateOne) AddRetryCount(i int) *DeadLetterUpdateOne {
+	dluo.mutation.AddRetryCount(i)
+	return dluo
+}
+
+// SetNextRetryAt sets the "next_retry_at" field.
+func (dluo *DeadLetterUpdateOne) SetNextRetryAt(t time.Time) *DeadLetterUpdateOne {
+	dluo.mutation.SetNextRetryAt(t)
+	return dluo
+}
+
+// SetNillableNextRetryAt sets the "next_retry_at" field if the given value is not nil.
+func (dluo *DeadLetterUpdateOne) SetNillableNextRetryAt(t *time.Time) *DeadLetterUpdateOne {
+	if t != nil {
+		dluo.SetNextRetryAt(*t)
+	}
+	return dluo
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (dluo *DeadLetterUpdateOne) SetResolvedAt(t time.Time) *DeadLetterUpdateOne {
+	dluo.mutation.SetResolvedAt(t)
+	return dluo
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (dluo *DeadLetterUpdateOne) SetNillableResolvedAt(t *time.Time) *DeadLetterUpdateOne {
+	if t != nil {
+		dluo.SetResolvedAt(*t)
+	}
+	return dluo
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (dluo *DeadLetterUpdateOne) ClearResolvedAt() *DeadLetterUpdateOne {
+	dluo.mutation.ClearResolvedAt()
+	return dluo
+}
+
+// Mutation returns the DeadLetterMutation object of the builder.
+func (dluo *DeadLetterUpdateOne) Mutation() *DeadLetterMutation {
+	return dluo.mutation
+}
+
+// Where appends a list predicates to the DeadLetterUpdate builder.
+func (dluo *DeadLetterUpdateOne) Where(ps ...predicate.DeadLetter) *DeadLetterUpdateOne {
+	dluo.mutation.Where(ps...)
+	return dluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (dluo *DeadLetterUpdateOne) Select(field string, fields ...string) *DeadLetterUpdateOne {
+	dluo.fields = append([]string{field}, fields...)
+	return dluo
+}
+
+// Save executes the query and returns the updated DeadLetter entity.
+func (dluo *DeadLetterUpdateOne) Save(ctx context.Context) (*DeadLetter, error) {
+	dluo.defaults()
+	return withHooks(ctx, dluo.sqlSave, dluo.mutation, dluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dluo *DeadLetterUpdateOne) SaveX(ctx context.Context) *DeadLetter {
+	node, err := dluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (dluo *DeadLetterUpdateOne) Exec(ctx context.Context) error {
+	_, err := dluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dluo *DeadLetterUpdateOne) ExecX(ctx context.Context) {
+	if err := dluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dluo *DeadLetterUpdateOne) defaults() {
+	if _, ok := dluo.mutation.UpdatedAt(); !ok {
+		v := deadletter.UpdateDefaultUpdatedAt()
+		dluo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dluo *DeadLetterUpdateOne) check() error {
+	if v, ok := dluo.mutation.LastError(); ok {
+		if err := deadletter.LastErrorValidator(v); err != nil {
+			return &ValidationError{Name: "last_error", err: fmt.Errorf(`ent: validator failed for field "DeadLetter.last_error": %w`, err)}
+		}
+	}
+	if v, ok := dluo.mutation.Status(); ok {
+		if err := deadletter.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DeadLetter.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (dluo *DeadLetterUpdateOne) sqlSave(ctx context.Context) (_node *DeadLetter, err error) {
+	if err := dluo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(deadletter.Table, deadletter.Columns, sqlgraph.NewFieldSpec(deadletter.FieldID, field.TypeUUID))
+	id, ok := dluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DeadLetter.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := dluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, deadletter.FieldID)
+		for _, f := range fields {
+			if !deadletter.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != deadletter.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := dluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dluo.mutation.UpdatedAt(); ok {
+		_spec.SetField(deadletter.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := dluo.mutation.LastError(); ok {
+		_spec.SetField(deadletter.FieldLastError, field.TypeString, value)
+	}
+	if value, ok := dluo.mutation.Status(); ok {
+		_spec.SetField(deadletter.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := dluo.mutation.RetryCount(); ok {
+		_spec.SetField(deadletter.FieldRetryCount, field.TypeInt, value)
+	}
+	if value, ok := dluo.mutation.AddedRetryCount(); ok {
+		_spec.AddField(deadletter.FieldRetryCount, field.TypeInt, value)
+	}
+	if value, ok := dluo.mutation.NextRetryAt(); ok {
+		_spec.SetField(deadletter.FieldNextRetryAt, field.TypeTime, value)
+	}
+	if value, ok := dluo.mutation.ResolvedAt(); ok {
+		_spec.SetField(deadletter.FieldResolvedAt, field.TypeTime, value)
+	}
+	if dluo.mutation.ResolvedAtCleared() {
+		_spec.ClearField(deadletter.FieldResolvedAt, field.TypeTime)
+	}
+	_node = &DeadLetter{config: dluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, dluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deadletter.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	dluo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,176 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/NEDA-LABS/stablenode/ent/denylistedaddress"
+	"github.com/google/uuid"
+)
+
+// DenylistedAddress is the model entity for the DenylistedAddress schema.
+type DenylistedAddress struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Address holds the value of the "address" field.
+	Address string `json:"address,omitempty"`
+	// Network holds the value of the "network" field.
+	Network string `json:"network,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// Source holds the value of the "source" field.
+	Source string `json:"source,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt    *time.Time `json:"expires_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DenylistedAddress) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case denylistedaddress.FieldAddress, denylistedaddress.FieldNetwork, denylistedaddress.FieldReason, denylistedaddress.FieldSource:
+			values[i] = new(sql.NullString)
+		case denylistedaddress.FieldCreatedAt, denylistedaddress.FieldUpdatedAt, denylistedaddress.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		case denylistedaddress.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DenylistedAddress fields.
+func (da *DenylistedAddress) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case denylistedaddress.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				da.ID = *value
+			}
+		case denylistedaddress.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				da.CreatedAt = value.Time
+			}
+		case denylistedaddress.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				da.UpdatedAt = value.Time
+			}
+		case denylistedaddress.FieldAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address", values[i])
+			} else if value.Valid {
+				da.Address = value.String
+			}
+		case denylistedaddress.FieldNetwork:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field network", values[i])
+			} else if value.Valid {
+				da.Network = value.String
+			}
+		case denylistedaddress.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				da.Reason = value.String
+			}
+		case denylistedaddress.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				da.Source = value.String
+			}
+		case denylistedaddress.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				da.ExpiresAt = new(time.Time)
+				*da.ExpiresAt = value.Time
+			}
+		default:
+			da.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DenylistedAddress.
+// This includes values selected through modifiers, order, etc.
+func (da *DenylistedAddress) Value(name string) (ent.Value, error) {
+	return da.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DenylistedAddress.
+// Note that you need to call DenylistedAddress.Unwrap() before calling this method if this DenylistedAddress
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (da *DenylistedAddress) Update() *DenylistedAddressUpdateOne {
+	return NewDenylistedAddressClient(da.config).UpdateOne(da)
+}
+
+// Unwrap unwraps the DenylistedAddress entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (da *DenylistedAddress) Unwrap() *DenylistedAddress {
+	_tx, ok := da.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DenylistedAddress is not a transactional entity")
+	}
+	da.config.driver = _tx.drv
+	return da
+}
+
+// String implements the fmt.Stringer.
+func (da *DenylistedAddress) String() string {
+	var builder strings.Builder
+	builder.WriteString("DenylistedAddress(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", da.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(da.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(da.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("address=")
+	builder.WriteString(da.Address)
+	builder.WriteString(", ")
+	builder.WriteString("network=")
+	builder.WriteString(da.Network)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(da.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(da.Source)
+	builder.WriteString(", ")
+	if v := da.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DenylistedAddresses is a parsable slice of DenylistedAddress.
+type DenylistedAddresses []*DenylistedAddress
@@ -0,0 +1,924 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/denylistedaddress"
+	"github.com/google/uuid"
+)
+
+// DenylistedAddressCreate is the builder for creating a DenylistedAddress entity.
+type DenylistedAddressCreate struct {
+	config
+	mutation *DenylistedAddressMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dac *DenylistedAddressCreate) SetCreatedAt(t time.Time) *DenylistedAddressCreate {
+	dac.mutation.SetCreatedAt(t)
+	return dac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dac *DenylistedAddressCreate) SetNillableCreatedAt(t *time.Time) *DenylistedAddressCreate {
+	if t != nil {
+		dac.SetCreatedAt(*t)
+	}
+	return dac
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dac *DenylistedAddressCreate) SetUpdatedAt(t time.Time) *DenylistedAddressCreate {
+	dac.mutation.SetUpdatedAt(t)
+	return dac
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (dac *DenylistedAddressCreate) SetNillableUpdatedAt(t *time.Time) *DenylistedAddressCreate {
+	if t != nil {
+		dac.SetUpdatedAt(*t)
+	}
+	return dac
+}
+
+// SetAddress sets the "address" field.
+func (dac *DenylistedAddressCreate) SetAddress(s string) *DenylistedAddressCreate {
+	dac.mutation.SetAddress(s)
+	return dac
+}
+
+// SetNetwork sets the "network" field.
+func (dac *DenylistedAddressCreate) SetNetwork(s string) *DenylistedAddressCreate {
+	dac.mutation.SetNetwork(s)
+	return dac
+}
+
+// SetNillableNetwork sets the "network" field if the given value is not nil.
+func (dac *DenylistedAddressCreate) SetNillableNetwork(s *string) *DenylistedAddressCreate {
+	if s != nil {
+		dac.SetNetwork(*s)
+	}
+	return dac
+}
+
+// SetReason sets the "reason" field.
+func (dac *DenylistedAddressCreate) SetReason(s string) *DenylistedAddressCreate {
+	dac.mutation.SetReason(s)
+	return dac
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (dac *DenylistedAddressCreate) SetNillableReason(s *string) *DenylistedAddressCreate {
+	if s != nil {
+		dac.SetReason(*s)
+	}
+	return dac
+}
+
+// SetSource sets the "source" field.
+func (dac *DenylistedAddressCreate) SetSource(s string) *DenylistedAddressCreate {
+	dac.mutation.SetSource(s)
+	return dac
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (dac *DenylistedAddressCreate) SetNillableSource(s *string) *DenylistedAddressCreate {
+	if s != nil {
+		dac.SetSource(*s)
+	}
+	return dac
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (dac *DenylistedAddressCreate) SetExpiresAt(t time.Time) *DenylistedAddressCreate {
+	dac.mutation.SetExpiresAt(t)
+	return dac
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (dac *DenylistedAddressCreate) SetNillableExpiresAt(t *time.Time) *DenylistedAddressCreate {
+	if t != nil {
+		dac.SetExpiresAt(*t)
+	}
+	return dac
+}
+
+// SetID sets the "id" field.
+func (dac *DenylistedAddressCreate) SetID(u uuid.UUID) *DenylistedAddressCreate {
+	dac.mutation.SetID(u)
+	return dac
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (dac *DenylistedAddressCreate) SetNillableID(u *uuid.UUID) *DenylistedAddressCreate {
+	if u != nil {
+		dac.SetID(*u)
+	}
+	return dac
+}
+
+// Mutation returns the DenylistedAddressMutation object of the builder.
+func (dac *DenylistedAddressCreate) Mutation() *DenylistedAddressMutation {
+	return dac.mutation
+}
+
+// Save creates the DenylistedAddress in the database.
+func (dac *DenylistedAddressCreate) Save(ctx context.Context) (*DenylistedAddress, error) {
+	dac.defaults()
+	return withHooks(ctx, dac.sqlSave, dac.mutation, dac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dac *DenylistedAddressCreate) SaveX(ctx context.Context) *DenylistedAddress {
+	v, err := dac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dac *DenylistedAddressCreate) Exec(ctx context.Context) error {
+	_, err := dac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dac *DenylistedAddressCreate) ExecX(ctx context.Context) {
+	if err := dac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dac *DenylistedAddressCreate) defaults() {
+	if _, ok := dac.mutation.CreatedAt(); !ok {
+		v := denylistedaddress.DefaultCreatedAt()
+		dac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := dac.mutation.UpdatedAt(); !ok {
+		v := denylistedaddress.DefaultUpdatedAt()
+		dac.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := dac.mutation.Network(); !ok {
+		v := denylistedaddress.DefaultNetwork
+		dac.mutation.SetNetwork(v)
+	}
+	if _, ok := dac.mutation.ID(); !ok {
+		v := denylistedaddress.DefaultID()
+		dac.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dac *DenylistedAddressCreate) check() error {
+	if _, ok := dac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DenylistedAddress.created_at"`)}
+	}
+	if _, ok := dac.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "DenylistedAddress.updated_at"`)}
+	}
+	if _, ok := dac.mutation.Address(); !ok {
+		return &ValidationError{Name: "address", err: errors.New(`ent: missing required field "DenylistedAddress.address"`)}
+	}
+	if v, ok := dac.mutation.Address(); ok {
+		if err := denylistedaddress.AddressValidator(v); err != nil {
+			return &ValidationError{Name: "address", err: fmt.Errorf(`ent: validator failed for field "DenylistedAddress.address": %w`, err)}
+		}
+	}
+	if _, ok := dac.mutation.Network(); !ok {
+		return &ValidationError{Name: "network", err: errors.New(`ent: missing required field "DenylistedAddress.network"`)}
+	}
+	if v, ok := dac.mutation.Network(); ok {
+		if err := denylistedaddress.NetworkValidator(v); err != nil {
+			return &ValidationError{Name: "network", err: fmt.Errorf(`ent: validator failed for field "DenylistedAddress.network": %w`, err)}
+		}
+	}
+	if v, ok := dac.mutation.Reason(); ok {
+		if err := denylistedaddress.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "DenylistedAddress.reason": %w`, err)}
+		}
+	}
+	if v, ok := dac.mutation.Source(); ok {
+		if err := denylistedaddress.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "DenylistedAddress.source": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (dac *DenylistedAddressCreate) sqlSave(ctx context.Context) (*DenylistedAddress, error) {
+	if err := dac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	dac.mutation.id = &_node.ID
+	dac.mutation.done = true
+	return _node, nil
+}
+
+func (dac *DenylistedAddressCreate) createSpec() (*DenylistedAddress, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DenylistedAddress{config: dac.config}
+		_spec = sqlgraph.NewCreateSpec(denylistedaddress.Table, sqlgraph.NewFieldSpec(denylistedaddress.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = dac.conflict
+	if id, ok := dac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := dac.mutation.CreatedAt(); ok {
+		_spec.SetField(denylistedaddress.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := dac.mutation.UpdatedAt(); ok {
+		_spec.SetField(denylistedaddress.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := dac.mutation.Address(); ok {
+		_spec.SetField(denylistedaddress.FieldAddress, field.TypeString, value)
+		_node.Address = value
+	}
+	if value, ok := dac.mutation.Network(); ok {
+		_spec.SetField(denylistedaddress.FieldNetwork, field.TypeString, value)
+		_node.Network = value
+	}
+	if value, ok := dac.mutation.Reason(); ok {
+		_spec.SetField(denylistedaddress.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := dac.mutation.Source(); ok {
+		_spec.SetField(denylistedaddress.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := dac.mutation.ExpiresAt(); ok {
+		_spec.SetField(denylistedaddress.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DenylistedAddress.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DenylistedAddressUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (dac *DenylistedAddressCreate) OnConflict(opts ...sql.ConflictOption) *DenylistedAddressUpsertOne {
+	dac.conflict = opts
+	return &DenylistedAddressUpsertOne{
+		create: dac,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DenylistedAddress.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dac *DenylistedAddressCreate) OnConflictColumns(columns ...string) *DenylistedAddressUpsertOne {
+	dac.conflict = append(dac.conflict, sql.ConflictColumns(columns...))
+	return &DenylistedAddressUpsertOne{
+		create: dac,
+	}
+}
+
+type (
+	// DenylistedAddressUpsertOne is the builder for "upsert"-ing
+	//  one DenylistedAddress node.
+	DenylistedAddressUpsertOne struct {
+		create *DenylistedAddressCreate
+	}
+
+	// DenylistedAddressUpsert is the "OnConflict" setter.
+	DenylistedAddressUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DenylistedAddressUpsert) SetUpdatedAt(v time.Time) *DenylistedAddressUpsert {
+	u.Set(denylistedaddress.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DenylistedAddressUpsert) UpdateUpdatedAt() *DenylistedAddressUpsert {
+	u.SetExcluded(denylistedaddress.FieldUpdatedAt)
+	return u
+}
+
+// SetAddress sets the "address" field.
+func (u *DenylistedAddressUpsert) SetAddress(v string) *DenylistedAddressUpsert {
+	u.Set(denylistedaddress.FieldAddress, v)
+	return u
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *DenylistedAddressUpsert) UpdateAddress() *DenylistedAddressUpsert {
+	u.SetExcluded(denylistedaddress.FieldAddress)
+	return u
+}
+
+// SetNetwork sets the "network" field.
+func (u *DenylistedAddressUpsert) SetNetwork(v string) *DenylistedAddressUpsert {
+	u.Set(denylistedaddress.FieldNetwork, v)
+	return u
+}
+
+// UpdateNetwork sets the "network" field to the value that was provided on create.
+func (u *DenylistedAddressUpsert) UpdateNetwork() *DenylistedAddressUpsert {
+	u.SetExcluded(denylistedaddress.FieldNetwork)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *DenylistedAddressUpsert) SetReason(v string) *DenylistedAddressUpsert {
+	u.Set(denylistedaddress.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *DenylistedAddressUpsert) UpdateReason() *DenylistedAddressUpsert {
+	u.SetExcluded(denylistedaddress.FieldReason)
+	return u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *DenylistedAddressUpsert) ClearReason() *DenylistedAddressUpsert {
+	u.SetNull(denylistedaddress.FieldReason)
+	return u
+}
+
+// SetSource sets the "source" field.
+func (u *DenylistedAddressUpsert) SetSource(v string) *DenylistedAddressUpsert {
+	u.Set(denylistedaddress.FieldSource, v)
+	return u
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *DenylistedAddressUpsert) UpdateSource() *DenylistedAddressUpsert {
+	u.SetExcluded(denylistedaddress.FieldSource)
+	return u
+}
+
+// ClearSource clears the value of the "source" field.
+func (u *DenylistedAddressUpsert) ClearSource() *DenylistedAddressUpsert {
+	u.SetNull(denylistedaddress.FieldSource)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *DenylistedAddressUpsert) SetExpiresAt(v time.Time) *DenylistedAddressUpsert {
+	u.Set(denylistedaddress.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *DenylistedAddressUpsert) UpdateExpiresAt() *DenylistedAddressUpsert {
+	u.SetExcluded(denylistedaddress.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *DenylistedAddressUpsert) ClearExpiresAt() *DenylistedAddressUpsert {
+	u.SetNull(denylistedaddress.FieldExpiresAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DenylistedAddress.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(denylistedaddress.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DenylistedAddressUpsertOne) UpdateNewValues() *DenylistedAddressUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(denylistedaddress.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(denylistedaddress.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DenylistedAddress.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DenylistedAddressUpsertOne) Ignore() *DenylistedAddressUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DenylistedAddressUpsertOne) DoNothing() *DenylistedAddressUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DenylistedAddressCreate.OnConflict
+// documentation for more info.
+func (u *DenylistedAddressUpsertOne) Update(set func(*DenylistedAddressUpsert)) *DenylistedAddressUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DenylistedAddressUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DenylistedAddressUpsertOne) SetUpdatedAt(v time.Time) *DenylistedAddressUpsertOne {
+	return u.Update(func(s *DenylistedAddressUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DenylistedAddressUpsertOne) UpdateUpdatedAt() *DenylistedAddressUpsertOne {
+	return u.Update(func(s *DenylistedAddressUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAddress sets the "address" field.
+func (u *DenylistedAddressUpsertOne) SetAddress(v string) *DenylistedAddressUpsertOne {
+	return u.Update(func(s *DenylistedAddressUpsert) {
+		s.SetAddress(v)
+	})
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *DenylistedAddressUpsertOne) UpdateAddress() *DenylistedAddressUpsertOne {
+	return u.Update(func(s *DenylistedAddressUpsert) {
+		s.UpdateAddress()
+	})
+}
+
+// SetNetwork sets the "network" field.
+func (u *DenylistedAddressUpsertOne) SetNetwork(v string) *DenylistedAddressUpsertOne {
+	return u.Update(func(s *DenylistedAddressUpsert) {
+		s.SetNetwork(v)
+	})
+}
+
+// UpdateNetwork sets the "network" field to the value that was provided on create.
+func (u *DenylistedAddressUpsertOne) UpdateNetwork() *DenylistedAddressUpsertOne {
+	return u.Update(func(s *DenylistedAddressUpsert) {
+		s.UpdateNetwork()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *DenylistedAddressUpsertOne) SetReason(v string) *DenylistedAddressUpsertOne {
+	return u.Update(func(s *DenylistedAddressUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *DenylistedAddressUpsertOne) UpdateReason() *DenylistedAddressUpsertOne {
+	return u.Update(func(s *DenylistedAddressUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *DenylistedAddressUpsertOne) ClearReason() *DenylistedAddressUpsertOne {
+	return u.Update(func(s *DenylistedAddressUpsert) {
+		s.ClearReason()
+	})
+}
+
+// SetSource sets the "source" field.
+func (u *DenylistedAddressUpsertOne) SetSource(v string) *DenylistedAddressUpsertOne {
+	return u.Update(func(s *DenylistedAddressUpsert) {
+		s.SetSource(v)
+	})
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *DenylistedAddressUpsertOne) UpdateSource() *DenylistedAddressUpsertOne {
+	return u.Update(func(s *DenylistedAddressUpsert) {
+		s.UpdateSource()
+	})
+}
+
+// ClearSource clears the value of the "source" field.
+func (u *DenylistedAddressUpsertOne) ClearSource() *DenylistedAddressUpsertOne {
+	return u.Update(func(s *DenylistedAddressUpsert) {
+		s.ClearSource()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *DenylistedAddressUpsertOne) SetExpiresAt(v time.Time) *DenylistedAddressUpsertOne {
+	return u.Update(func(s *DenylistedAddressUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *DenylistedAddressUpsertOne) UpdateExpiresAt() *DenylistedAddressUpsertOne {
+	return u.Update(func(s *DenylistedAddressUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *DenylistedAddressUpsertOne) ClearExpiresAt() *DenylistedAddressUpsertOne {
+	return u.Update(func(s *DenylistedAddressUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DenylistedAddressUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DenylistedAddressCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DenylistedAddressUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DenylistedAddressUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: DenylistedAddressUpsertOne.ID is not supported by MySQL driver. Use DenylistedAddressUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DenylistedAddressUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DenylistedAddressCreateBulk is the builder for creating many DenylistedAddress entities in bulk.
+type DenylistedAddressCreateBulk struct {
+	config
+	err      error
+	builders []*DenylistedAddressCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DenylistedAddress entities in the database.
+func (dacb *DenylistedAddressCreateBulk) Save(ctx context.Context) ([]*DenylistedAddress, error) {
+	if dacb.err != nil {
+		return nil, dacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(dacb.builders))
+	nodes := make([]*DenylistedAddress, len(dacb.builders))
+	mutators := make([]Mutator, len(dacb.builders))
+	for i := range dacb.builders {
+		func(i int, root context.Context) {
+			builder := dacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DenylistedAddressMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = dacb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dacb *DenylistedAddressCreateBulk) SaveX(ctx context.Context) []*DenylistedAddress {
+	v, err := dacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dacb *DenylistedAddressCreateBulk) Exec(ctx context.Context) error {
+	_, err := dacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dacb *DenylistedAddressCreateBulk) ExecX(ctx context.Context) {
+	if err := dacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DenylistedAddress.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DenylistedAddressUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (dacb *DenylistedAddressCreateBulk) OnConflict(opts ...sql.ConflictOption) *DenylistedAddressUpsertBulk {
+	dacb.conflict = opts
+	return &DenylistedAddressUpsertBulk{
+		create: dacb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DenylistedAddress.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dacb *DenylistedAddressCreateBulk) OnConflictColumns(columns ...string) *DenylistedAddressUpsertBulk {
+	dacb.conflict = append(dacb.conflict, sql.ConflictColumns(columns...))
+	return &DenylistedAddressUpsertBulk{
+		create: dacb,
+	}
+}
+
+// DenylistedAddressUpsertBulk is the builder for "upsert"-ing
+// a bulk of DenylistedAddress nodes.
+type DenylistedAddressUpsertBulk struct {
+	create *DenylistedAddressCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DenylistedAddress.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(denylistedaddress.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DenylistedAddressUpsertBulk) UpdateNewValues() *DenylistedAddressUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(denylistedaddress.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(denylistedaddress.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DenylistedAddress.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DenylistedAddressUpsertBulk) Ignore() *DenylistedAddressUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DenylistedAddressUpsertBulk) DoNothing() *DenylistedAddressUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DenylistedAddressCreateBulk.OnConflict
+// documentation for more info.
+func (u *DenylistedAddressUpsertBulk) Update(set func(*DenylistedAddressUpsert)) *DenylistedAddressUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DenylistedAddressUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DenylistedAddressUpsertBulk) SetUpdatedAt(v time.Time) *DenylistedAddressUpsertBulk {
+	return u.Update(func(s *DenylistedAddressUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DenylistedAddressUpsertBulk) UpdateUpdatedAt() *DenylistedAddressUpsertBulk {
+	return u.Update(func(s *DenylistedAddressUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAddress sets the "address" field.
+func (u *DenylistedAddressUpsertBulk) SetAddress(v string) *DenylistedAddressUpsertBulk {
+	return u.Update(func(s *DenylistedAddressUpsert) {
+		s.SetAddress(v)
+	})
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *DenylistedAddressUpsertBulk) UpdateAddress() *DenylistedAddressUpsertBulk {
+	return u.Update(func(s *DenylistedAddressUpsert) {
+		s.UpdateAddress()
+	})
+}
+
+// SetNetwork sets the "network" field.
+func (u *DenylistedAddressUpsertBulk) SetNetwork(v string) *DenylistedAddressUpsertBulk {
+	return u.Update(func(s *DenylistedAddressUpsert) {
+		s.SetNetwork(v)
+	})
+}
+
+// UpdateNetwork sets the "network" field to the value that was provided on create.
+func (u *DenylistedAddressUpsertBulk) UpdateNetwork() *DenylistedAddressUpsertBulk {
+	return u.Update(func(s *DenylistedAddressUpsert) {
+		s.UpdateNetwork()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *DenylistedAddressUpsertBulk) SetReason(v string) *DenylistedAddressUpsertBulk {
+	return u.Update(func(s *DenylistedAddressUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *DenylistedAddressUpsertBulk) UpdateReason() *DenylistedAddressUpsertBulk {
+	return u.Update(func(s *DenylistedAddressUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *DenylistedAddressUpsertBulk) ClearReason() *DenylistedAddressUpsertBulk {
+	return u.Update(func(s *DenylistedAddressUpsert) {
+		s.ClearReason()
+	})
+}
+
+// SetSource sets the "source" field.
+func (u *DenylistedAddressUpsertBulk) SetSource(v string) *DenylistedAddressUpsertBulk {
+	return u.Update(func(s *DenylistedAddressUpsert) {
+		s.SetSource(v)
+	})
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *DenylistedAddressUpsertBulk) UpdateSource() *DenylistedAddressUpsertBulk {
+	return u.Update(func(s *DenylistedAddressUpsert) {
+		s.UpdateSource()
+	})
+}
+
+// ClearSource clears the value of the "source" field.
+func (u *DenylistedAddressUpsertBulk) ClearSource() *DenylistedAddressUpsertBulk {
+	return u.Update(func(s *DenylistedAddressUpsert) {
+		s.ClearSource()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *DenylistedAddressUpsertBulk) SetExpiresAt(v time.Time) *DenylistedAddressUpsertBulk {
+	return u.Update(func(s *DenylistedAddressUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *DenylistedAddressUpsertBulk) UpdateExpiresAt() *DenylistedAddressUpsertBulk {
+	return u.Update(func(s *DenylistedAddressUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *DenylistedAddressUpsertBulk) ClearExpiresAt() *DenylistedAddressUpsertBulk {
+	return u.Update(func(s *DenylistedAddressUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DenylistedAddressUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DenylistedAddressCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DenylistedAddressCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DenylistedAddressUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/denylistedaddress"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+)
+
+// DenylistedAddressDelete is the builder for deleting a DenylistedAddress entity.
+type DenylistedAddressDelete struct {
+	config
+	hooks    []Hook
+	mutation *DenylistedAddressMutation
+}
+
+// Where appends a list predicates to the DenylistedAddressDelete builder.
+func (dad *DenylistedAddressDelete) Where(ps ...predicate.DenylistedAddress) *DenylistedAddressDelete {
+	dad.mutation.Where(ps...)
+	return dad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (dad *DenylistedAddressDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, dad.sqlExec, dad.mutation, dad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dad *DenylistedAddressDelete) ExecX(ctx context.Context) int {
+	n, err := dad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (dad *DenylistedAddressDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(denylistedaddress.Table, sqlgraph.NewFieldSpec(denylistedaddress.FieldID, field.TypeUUID))
+	if ps := dad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, dad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	dad.mutation.done = true
+	return affected, err
+}
+
+// DenylistedAddressDeleteOne is the builder for deleting a single DenylistedAddress entity.
+type DenylistedAddressDeleteOne struct {
+	dad *DenylistedAddressDelete
+}
+
+// Where appends a list predicates to the DenylistedAddressDelete builder.
+func (dado *DenylistedAddressDeleteOne) Where(ps ...predicate.DenylistedAddress) *DenylistedAddressDeleteOne {
+	dado.dad.mutation.Where(ps...)
+	return dado
+}
+
+// Exec executes the deletion query.
+func (dado *DenylistedAddressDeleteOne) Exec(ctx context.Context) error {
+	n, err := dado.dad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{denylistedaddress.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dado *DenylistedAddressDeleteOne) ExecX(ctx context.Context) {
+	if err := dado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
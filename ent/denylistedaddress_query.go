@@ -0,0 +1,528 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/denylistedaddress"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// DenylistedAddressQuery is the builder for querying DenylistedAddress entities.
+type DenylistedAddressQuery struct {
+	config
+	ctx        *QueryContext
+	order      []denylistedaddress.OrderOption
+	inters     []Interceptor
+	predicates []predicate.DenylistedAddress
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the DenylistedAddressQuery builder.
+func (daq *DenylistedAddressQuery) Where(ps ...predicate.DenylistedAddress) *DenylistedAddressQuery {
+	daq.predicates = append(daq.predicates, ps...)
+	return daq
+}
+
+// Limit the number of records to be returned by this query.
+func (daq *DenylistedAddressQuery) Limit(limit int) *DenylistedAddressQuery {
+	daq.ctx.Limit = &limit
+	return daq
+}
+
+// Offset to start from.
+func (daq *DenylistedAddressQuery) Offset(offset int) *DenylistedAddressQuery {
+	daq.ctx.Offset = &offset
+	return daq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (daq *DenylistedAddressQuery) Unique(unique bool) *DenylistedAddressQuery {
+	daq.ctx.Unique = &unique
+	return daq
+}
+
+// Order specifies how the records should be ordered.
+func (daq *DenylistedAddressQuery) Order(o ...denylistedaddress.OrderOption) *DenylistedAddressQuery {
+	daq.order = append(daq.order, o...)
+	return daq
+}
+
+// First returns the first DenylistedAddress entity from the query.
+// Returns a *NotFoundError when no DenylistedAddress was found.
+func (daq *DenylistedAddressQuery) First(ctx context.Context) (*DenylistedAddress, error) {
+	nodes, err := daq.Limit(1).All(setContextOp(ctx, daq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{denylistedaddress.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (daq *DenylistedAddressQuery) FirstX(ctx context.Context) *DenylistedAddress {
+	node, err := daq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first DenylistedAddress ID from the query.
+// Returns a *NotFoundError when no DenylistedAddress ID was found.
+func (daq *DenylistedAddressQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = daq.Limit(1).IDs(setContextOp(ctx, daq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{denylistedaddress.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (daq *DenylistedAddressQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := daq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single DenylistedAddress entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one DenylistedAddress entity is found.
+// Returns a *NotFoundError when no DenylistedAddress entities are found.
+func (daq *DenylistedAddressQuery) Only(ctx context.Context) (*DenylistedAddress, error) {
+	nodes, err := daq.Limit(2).All(setContextOp(ctx, daq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{denylistedaddress.Label}
+	default:
+		return nil, &NotSingularError{denylistedaddress.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (daq *DenylistedAddressQuery) OnlyX(ctx context.Context) *DenylistedAddress {
+	node, err := daq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only DenylistedAddress ID in the query.
+// Returns a *NotSingularError when more than one DenylistedAddress ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (daq *DenylistedAddressQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = daq.Limit(2).IDs(setContextOp(ctx, daq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{denylistedaddress.Label}
+	default:
+		err = &NotSingularError{denylistedaddress.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (daq *DenylistedAddressQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := daq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of DenylistedAddresses.
+func (daq *DenylistedAddressQuery) All(ctx context.Context) ([]*DenylistedAddress, error) {
+	ctx = setContextOp(ctx, daq.ctx, ent.OpQueryAll)
+	if err := daq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*DenylistedAddress, *DenylistedAddressQuery]()
+	return withInterceptors[[]*DenylistedAddress](ctx, daq, qr, daq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (daq *DenylistedAddressQuery) AllX(ctx context.Context) []*DenylistedAddress {
+	nodes, err := daq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of DenylistedAddress IDs.
+func (daq *DenylistedAddressQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if daq.ctx.Unique == nil && daq.path != nil {
+		daq.Unique(true)
+	}
+	ctx = setContextOp(ctx, daq.ctx, ent.OpQueryIDs)
+	if err = daq.Select(denylistedaddress.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (daq *DenylistedAddressQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := daq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (daq *DenylistedAddressQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, daq.ctx, ent.OpQueryCount)
+	if err := daq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, daq, querierCount[*DenylistedAddressQuery](), daq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (daq *DenylistedAddressQuery) CountX(ctx context.Context) int {
+	count, err := daq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (daq *DenylistedAddressQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, daq.ctx, ent.OpQueryExist)
+	switch _, err := daq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (daq *DenylistedAddressQuery) ExistX(ctx context.Context) bool {
+	exist, err := daq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the DenylistedAddressQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (daq *DenylistedAddressQuery) Clone() *DenylistedAddressQuery {
+	if daq == nil {
+		return nil
+	}
+	return &DenylistedAddressQuery{
+		config:     daq.config,
+		ctx:        daq.ctx.Clone(),
+		order:      append([]denylistedaddress.OrderOption{}, daq.order...),
+		inters:     append([]Interceptor{}, daq.inters...),
+		predicates: append([]predicate.DenylistedAddress{}, daq.predicates...),
+		// clone intermediate query.
+		sql:  daq.sql.Clone(),
+		path: daq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.DenylistedAddress.Query().
+//		GroupBy(denylistedaddress.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (daq *DenylistedAddressQuery) GroupBy(field string, fields ...string) *DenylistedAddressGroupBy {
+	daq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &DenylistedAddressGroupBy{build: daq}
+	grbuild.flds = &daq.ctx.Fields
+	grbuild.label = denylistedaddress.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.DenylistedAddress.Query().
+//		Select(denylistedaddress.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (daq *DenylistedAddressQuery) Select(fields ...string) *DenylistedAddressSelect {
+	daq.ctx.Fields = append(daq.ctx.Fields, fields...)
+	sbuild := &DenylistedAddressSelect{DenylistedAddressQuery: daq}
+	sbuild.label = denylistedaddress.Label
+	sbuild.flds, sbuild.scan = &daq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a DenylistedAddressSelect configured with the given aggregations.
+func (daq *DenylistedAddressQuery) Aggregate(fns ...AggregateFunc) *DenylistedAddressSelect {
+	return daq.Select().Aggregate(fns...)
+}
+
+func (daq *DenylistedAddressQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range daq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, daq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range daq.ctx.Fields {
+		if !denylistedaddress.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if daq.path != nil {
+		prev, err := daq.path(ctx)
+		if err != nil {
+			return err
+		}
+		daq.sql = prev
+	}
+	return nil
+}
+
+func (daq *DenylistedAddressQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*DenylistedAddress, error) {
+	var (
+		nodes = []*DenylistedAddress{}
+		_spec = daq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*DenylistedAddress).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &DenylistedAddress{config: daq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, daq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (daq *DenylistedAddressQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := daq.querySpec()
+	_spec.Node.Columns = daq.ctx.Fields
+	if len(daq.ctx.Fields) > 0 {
+		_spec.Unique = daq.ctx.Unique != nil && *daq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, daq.driver, _spec)
+}
+
+func (daq *DenylistedAddressQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(denylistedaddress.Table, denylistedaddress.Columns, sqlgraph.NewFieldSpec(denylistedaddress.FieldID, field.TypeUUID))
+	_spec.From = daq.sql
+	if unique := daq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if daq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := daq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, denylistedaddress.FieldID)
+		for i := range fields {
+			if fields[i] != denylistedaddress.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := daq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := daq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := daq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := daq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (daq *DenylistedAddressQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(daq.driver.Dialect())
+	t1 := builder.Table(denylistedaddress.Table)
+	columns := daq.ctx.Fields
+	if len(columns) == 0 {
+		columns = denylistedaddress.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if daq.sql != nil {
+		selector = daq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if daq.ctx.Unique != nil && *daq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range daq.predicates {
+		p(selector)
+	}
+	for _, p := range daq.order {
+		p(selector)
+	}
+	if offset := daq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := daq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// DenylistedAddressGroupBy is the group-by builder for DenylistedAddress entities.
+type DenylistedAddressGroupBy struct {
+	selector
+	build *DenylistedAddressQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (dagb *DenylistedAddressGroupBy) Aggregate(fns ...AggregateFunc) *DenylistedAddressGroupBy {
+	dagb.fns = append(dagb.fns, fns...)
+	return dagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (dagb *DenylistedAddressGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, dagb.build.ctx, ent.OpQueryGroupBy)
+	if err := dagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DenylistedAddressQuery, *DenylistedAddressGroupBy](ctx, dagb.build, dagb, dagb.build.inters, v)
+}
+
+func (dagb *DenylistedAddressGroupBy) sqlScan(ctx context.Context, root *DenylistedAddressQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(dagb.fns))
+	for _, fn := range dagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*dagb.flds)+len(dagb.fns))
+		for _, f := range *dagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*dagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// DenylistedAddressSelect is the builder for selecting fields of DenylistedAddress entities.
+type DenylistedAddressSelect struct {
+	*DenylistedAddressQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (das *DenylistedAddressSelect) Aggregate(fns ...AggregateFunc) *DenylistedAddressSelect {
+	das.fns = append(das.fns, fns...)
+	return das
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (das *DenylistedAddressSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, das.ctx, ent.OpQuerySelect)
+	if err := das.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DenylistedAddressQuery, *DenylistedAddressSelect](ctx, das.DenylistedAddressQuery, das, das.inters, v)
+}
+
+func (das *DenylistedAddressSelect) sqlScan(ctx context.Context, root *DenylistedAddressQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(das.fns))
+	for _, fn := range das.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*das.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := das.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
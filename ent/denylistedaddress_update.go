@@ -0,0 +1,492 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/denylistedaddress"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+)
+
+// DenylistedAddressUpdate is the builder for updating DenylistedAddress entities.
+type DenylistedAddressUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DenylistedAddressMutation
+}
+
+// Where appends a list predicates to the DenylistedAddressUpdate builder.
+func (dau *DenylistedAddressUpdate) Where(ps ...predicate.DenylistedAddress) *DenylistedAddressUpdate {
+	dau.mutation.Where(ps...)
+	return dau
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dau *DenylistedAddressUpdate) SetUpdatedAt(t time.Time) *DenylistedAddressUpdate {
+	dau.mutation.SetUpdatedAt(t)
+	return dau
+}
+
+// SetAddress sets the "address" field.
+func (dau *DenylistedAddressUpdate) SetAddress(s string) *DenylistedAddressUpdate {
+	dau.mutation.SetAddress(s)
+	return dau
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (dau *DenylistedAddressUpdate) SetNillableAddress(s *string) *DenylistedAddressUpdate {
+	if s != nil {
+		dau.SetAddress(*s)
+	}
+	return dau
+}
+
+// SetNetwork sets the "network" field.
+func (dau *DenylistedAddressUpdate) SetNetwork(s string) *DenylistedAddressUpdate {
+	dau.mutation.SetNetwork(s)
+	return dau
+}
+
+// SetNillableNetwork sets the "network" field if the given value is not nil.
+func (dau *DenylistedAddressUpdate) SetNillableNetwork(s *string) *DenylistedAddressUpdate {
+	if s != nil {
+		dau.SetNetwork(*s)
+	}
+	return dau
+}
+
+// SetReason sets the "reason" field.
+func (dau *DenylistedAddressUpdate) SetReason(s string) *DenylistedAddressUpdate {
+	dau.mutation.SetReason(s)
+	return dau
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (dau *DenylistedAddressUpdate) SetNillableReason(s *string) *DenylistedAddressUpdate {
+	if s != nil {
+		dau.SetReason(*s)
+	}
+	return dau
+}
+
+// ClearReason clears the value of the "reason" field.
+func (dau *DenylistedAddressUpdate) ClearReason() *DenylistedAddressUpdate {
+	dau.mutation.ClearReason()
+	return dau
+}
+
+// SetSource sets the "source" field.
+func (dau *DenylistedAddressUpdate) SetSource(s string) *DenylistedAddressUpdate {
+	dau.mutation.SetSource(s)
+	return dau
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (dau *DenylistedAddressUpdate) SetNillableSource(s *string) *DenylistedAddressUpdate {
+	if s != nil {
+		dau.SetSource(*s)
+	}
+	return dau
+}
+
+// ClearSource clears the value of the "source" field.
+func (dau *DenylistedAddressUpdate) ClearSource() *DenylistedAddressUpdate {
+	dau.mutation.ClearSource()
+	return dau
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (dau *DenylistedAddressUpdate) SetExpiresAt(t time.Time) *DenylistedAddressUpdate {
+	dau.mutation.SetExpiresAt(t)
+	return dau
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (dau *DenylistedAddressUpdate) SetNillableExpiresAt(t *time.Time) *DenylistedAddressUpdate {
+	if t != nil {
+		dau.SetExpiresAt(*t)
+	}
+	return dau
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (dau *DenylistedAddressUpdate) ClearExpiresAt() *DenylistedAddressUpdate {
+	dau.mutation.ClearExpiresAt()
+	return dau
+}
+
+// Mutation returns the DenylistedAddressMutation object of the builder.
+func (dau *DenylistedAddressUpdate) Mutation() *DenylistedAddressMutation {
+	return dau.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (dau *DenylistedAddressUpdate) Save(ctx context.Context) (int, error) {
+	dau.defaults()
+	return withHooks(ctx, dau.sqlSave, dau.mutation, dau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dau *DenylistedAddressUpdate) SaveX(ctx context.Context) int {
+	affected, err := dau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (dau *DenylistedAddressUpdate) Exec(ctx context.Context) error {
+	_, err := dau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dau *DenylistedAddressUpdate) ExecX(ctx context.Context) {
+	if err := dau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dau *DenylistedAddressUpdate) defaults() {
+	if _, ok := dau.mutation.UpdatedAt(); !ok {
+		v := denylistedaddress.UpdateDefaultUpdatedAt()
+		dau.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dau *DenylistedAddressUpdate) check() error {
+	if v, ok := dau.mutation.Address(); ok {
+		if err := denylistedaddress.AddressValidator(v); err != nil {
+			return &ValidationError{Name: "address", err: fmt.Errorf(`ent: validator failed for field "DenylistedAddress.address": %w`, err)}
+		}
+	}
+	if v, ok := dau.mutation.Network(); ok {
+		if err := denylistedaddress.NetworkValidator(v); err != nil {
+			return &ValidationError{Name: "network", err: fmt.Errorf(`ent: validator failed for field "DenylistedAddress.network": %w`, err)}
+		}
+	}
+	if v, ok := dau.mutation.Reason(); ok {
+		if err := denylistedaddress.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "DenylistedAddress.reason": %w`, err)}
+		}
+	}
+	if v, ok := dau.mutation.Source(); ok {
+		if err := denylistedaddress.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "DenylistedAddress.source": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (dau *DenylistedAddressUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := dau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(denylistedaddress.Table, denylistedaddress.Columns, sqlgraph.NewFieldSpec(denylistedaddress.FieldID, field.TypeUUID))
+	if ps := dau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dau.mutation.UpdatedAt(); ok {
+		_spec.SetField(denylistedaddress.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := dau.mutation.Address(); ok {
+		_spec.SetField(denylistedaddress.FieldAddress, field.TypeString, value)
+	}
+	if value, ok := dau.mutation.Network(); ok {
+		_spec.SetField(denylistedaddress.FieldNetwork, field.TypeString, value)
+	}
+	if value, ok := dau.mutation.Reason(); ok {
+		_spec.SetField(denylistedaddress.FieldReason, field.TypeString, value)
+	}
+	if dau.mutation.ReasonCleared() {
+		_spec.ClearField(denylistedaddress.FieldReason, field.TypeString)
+	}
+	if value, ok := dau.mutation.Source(); ok {
+		_spec.SetField(denylistedaddress.FieldSource, field.TypeString, value)
+	}
+	if dau.mutation.SourceCleared() {
+		_spec.ClearField(denylistedaddress.FieldSource, field.TypeString)
+	}
+	if value, ok := dau.mutation.ExpiresAt(); ok {
+		_spec.SetField(denylistedaddress.FieldExpiresAt, field.TypeTime, value)
+	}
+	if dau.mutation.ExpiresAtCleared() {
+		_spec.ClearField(denylistedaddress.FieldExpiresAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, dau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{denylistedaddress.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	dau.mutation.done = true
+	return n, nil
+}
+
+// DenylistedAddressUpdateOne is the builder for updating a single DenylistedAddress entity.
+type DenylistedAddressUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DenylistedAddressMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dauo *DenylistedAddressUpdateOne) SetUpdatedAt(t time.Time) *DenylistedAddressUpdateOne {
+	dauo.mutation.SetUpdatedAt(t)
+	return dauo
+}
+
+// SetAddress sets the "address" field.
+func (dauo *DenylistedAddressUpdateOne) SetAddress(s string) *DenylistedAddressUpdateOne {
+	dauo.mutation.SetAddress(s)
+	return dauo
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (dauo *DenylistedAddressUpdateOne) SetNillableAddress(s *string) *DenylistedAddressUpdateOne {
+	if s != nil {
+		dauo.SetAddress(*s)
+	}
+	return dauo
+}
+
+// SetNetwork sets the "network" field.
+func (dauo *DenylistedAddressUpdateOne) SetNetwork(s string) *DenylistedAddressUpdateOne {
+	dauo.mutation.SetNetwork(s)
+	return dauo
+}
+
+// SetNillableNetwork sets the "network" field if the given value is not nil.
+func (dauo *DenylistedAddressUpdateOne) SetNillableNetwork(s *string) *DenylistedAddressUpdateOne {
+	if s != nil {
+		dauo.SetNetwork(*s)
+	}
+	return dauo
+}
+
+// SetReason sets the "reason" field.
+func (dauo *DenylistedAddressUpdateOne) SetReason(s string) *DenylistedAddressUpdateOne {
+	dauo.mutation.SetReason(s)
+	return dauo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (dauo *DenylistedAddressUpdateOne) SetNillableReason(s *string) *DenylistedAddressUpdateOne {
+	if s != nil {
+		dauo.SetReason(*s)
+	}
+	return dauo
+}
+
+// ClearReason clears the value of the "reason" field.
+func (dauo *DenylistedAddressUpdateOne) ClearReason() *DenylistedAddressUpdateOne {
+	dauo.mutation.ClearReason()
+	return dauo
+}
+
+// SetSource sets the "source" field.
+func (dauo *DenylistedAddressUpdateOne) SetSource(s string) *DenylistedAddressUpdateOne {
+	dauo.mutation.SetSource(s)
+	return dauo
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (dauo *DenylistedAddressUpdateOne) SetNillableSource(s *string) *DenylistedAddressUpdateOne {
+	if s != nil {
+		dauo.SetSource(*s)
+	}
+	return dauo
+}
+
+// ClearSource clears the value of the "source" field.
+func (dauo *DenylistedAddressUpdateOne) ClearSource() *DenylistedAddressUpdateOne {
+	dauo.mutation.ClearSource()
+	return dauo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (dauo *DenylistedAddressUpdateOne) SetExpiresAt(t time.Time) *DenylistedAddressUpdateOne {
+	dauo.mutation.SetExpiresAt(t)
+	return dauo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (dauo *DenylistedAddressUpdateOne) SetNillableExpiresAt(t *time.Time) *DenylistedAddressUpdateOne {
+	if t != nil {
+		dauo.SetExpiresAt(*t)
+	}
+	return dauo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (dauo *DenylistedAddressUpdateOne) ClearExpiresAt() *DenylistedAddressUpdateOne {
+	dauo.mutation.ClearExpiresAt()
+	return dauo
+}
+
+// Mutation returns the DenylistedAddressMutation object of the builder.
+func (dauo *DenylistedAddressUpdateOne) Mutation() *DenylistedAddressMutation {
+	return dauo.mutation
+}
+
+// Where appends a list predicates to the DenylistedAddressUpdate builder.
+func (dauo *DenylistedAddressUpdateOne) Where(ps ...predicate.DenylistedAddress) *DenylistedAddressUpdateOne {
+	dauo.mutation.Where(ps...)
+	return dauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (dauo *DenylistedAddressUpdateOne) Select(field string, fields ...string) *DenylistedAddressUpdateOne {
+	dauo.fields = append([]string{field}, fields...)
+	return dauo
+}
+
+// Save executes the query and returns the updated DenylistedAddress entity.
+func (dauo *DenylistedAddressUpdateOne) Save(ctx context.Context) (*DenylistedAddress, error) {
+	dauo.defaults()
+	return withHooks(ctx, dauo.sqlSave, dauo.mutation, dauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dauo *DenylistedAddressUpdateOne) SaveX(ctx context.Context) *DenylistedAddress {
+	node, err := dauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (dauo *DenylistedAddressUpdateOne) Exec(ctx context.Context) error {
+	_, err := dauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dauo *DenylistedAddressUpdateOne) ExecX(ctx context.Context) {
+	if err := dauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dauo *DenylistedAddressUpdateOne) defaults() {
+	if _, ok := dauo.mutation.UpdatedAt(); !ok {
+		v := denylistedaddress.UpdateDefaultUpdatedAt()
+		dauo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dauo *DenylistedAddressUpdateOne) check() error {
+	if v, ok := dauo.mutation.Address(); ok {
+		if err := denylistedaddress.AddressValidator(v); err != nil {
+			return &ValidationError{Name: "address", err: fmt.Errorf(`ent: validator failed for field "DenylistedAddress.address": %w`, err)}
+		}
+	}
+	if v, ok := dauo.mutation.Network(); ok {
+		if err := denylistedaddress.NetworkValidator(v); err != nil {
+			return &ValidationError{Name: "network", err: fmt.Errorf(`ent: validator failed for field "DenylistedAddress.network": %w`, err)}
+		}
+	}
+	if v, ok := dauo.mutation.Reason(); ok {
+		if err := denylistedaddress.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "DenylistedAddress.reason": %w`, err)}
+		}
+	}
+	if v, ok := dauo.mutation.Source(); ok {
+		if err := denylistedaddress.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "DenylistedAddress.source": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (dauo *DenylistedAddressUpdateOne) sqlSave(ctx context.Context) (_node *DenylistedAddress, err error) {
+	if err := dauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(denylistedaddress.Table, denylistedaddress.Columns, sqlgraph.NewFieldSpec(denylistedaddress.FieldID, field.TypeUUID))
+	id, ok := dauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DenylistedAddress.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := dauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, denylistedaddress.FieldID)
+		for _, f := range fields {
+			if !denylistedaddress.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != denylistedaddress.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := dauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dauo.mutation.UpdatedAt(); ok {
+		_spec.SetField(denylistedaddress.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := dauo.mutation.Address(); ok {
+		_spec.SetField(denylistedaddress.FieldAddress, field.TypeString, value)
+	}
+	if value, ok := dauo.mutation.Network(); ok {
+		_spec.SetField(denylistedaddress.FieldNetwork, field.TypeString, value)
+	}
+	if value, ok := dauo.mutation.Reason(); ok {
+		_spec.SetField(denylistedaddress.FieldReason, field.TypeString, value)
+	}
+	if dauo.mutation.ReasonCleared() {
+		_spec.ClearField(denylistedaddress.FieldReason, field.TypeString)
+	}
+	if value, ok := dauo.mutation.Source(); ok {
+		_spec.SetField(denylistedaddress.FieldSource, field.TypeString, value)
+	}
+	if dauo.mutation.SourceCleared() {
+		_spec.ClearField(denylistedaddress.FieldSource, field.TypeString)
+	}
+	if value, ok := dauo.mutation.ExpiresAt(); ok {
+		_spec.SetField(denylistedaddress.FieldExpiresAt, field.TypeTime, value)
+	}
+	if dauo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(denylistedaddress.FieldExpiresAt, field.TypeTime)
+	}
+	_node = &DenylistedAddress{config: dauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, dauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{denylistedaddress.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	dauo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,237 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/NEDA-LABS/stablenode/ent/depositsplit"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// DepositSplit is the model entity for the DepositSplit schema.
+type DepositSplit struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// TxHash holds the value of the "tx_hash" field.
+	TxHash string `json:"tx_hash,omitempty"`
+	// Network holds the value of the "network" field.
+	Network string `json:"network,omitempty"`
+	// ReceiveAddress holds the value of the "receive_address" field.
+	ReceiveAddress string `json:"receive_address,omitempty"`
+	// FromAddress holds the value of the "from_address" field.
+	FromAddress string `json:"from_address,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount decimal.Decimal `json:"amount,omitempty"`
+	// BlockNumber holds the value of the "block_number" field.
+	BlockNumber int64 `json:"block_number,omitempty"`
+	// Status holds the value of the "status" field.
+	Status depositsplit.Status `json:"status,omitempty"`
+	// ResolvedAt holds the value of the "resolved_at" field.
+	ResolvedAt time.Time `json:"resolved_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DepositSplitQuery when eager-loading is set.
+	Edges        DepositSplitEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// DepositSplitEdges holds the relations/edges for other nodes in the graph.
+type DepositSplitEdges struct {
+	// PaymentOrders holds the value of the payment_orders edge.
+	PaymentOrders []*PaymentOrder `json:"payment_orders,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PaymentOrdersOrErr returns the PaymentOrders value or an error if the edge
+// was not loaded in eager-loading.
+func (e DepositSplitEdges) PaymentOrdersOrErr() ([]*PaymentOrder, error) {
+	if e.loadedTypes[0] {
+		return e.PaymentOrders, nil
+	}
+	return nil, &NotLoadedError{edge: "payment_orders"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DepositSplit) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case depositsplit.FieldAmount:
+			values[i] = new(decimal.Decimal)
+		case depositsplit.FieldBlockNumber:
+			values[i] = new(sql.NullInt64)
+		case depositsplit.FieldTxHash, depositsplit.FieldNetwork, depositsplit.FieldReceiveAddress, depositsplit.FieldFromAddress, depositsplit.FieldStatus:
+			values[i] = new(sql.NullString)
+		case depositsplit.FieldCreatedAt, depositsplit.FieldUpdatedAt, depositsplit.FieldResolvedAt:
+			values[i] = new(sql.NullTime)
+		case depositsplit.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DepositSplit fields.
+func (ds *DepositSplit) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case depositsplit.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ds.ID = *value
+			}
+		case depositsplit.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ds.CreatedAt = value.Time
+			}
+		case depositsplit.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ds.UpdatedAt = value.Time
+			}
+		case depositsplit.FieldTxHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tx_hash", values[i])
+			} else if value.Valid {
+				ds.TxHash = value.String
+			}
+		case depositsplit.FieldNetwork:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field network", values[i])
+			} else if value.Valid {
+				ds.Network = value.String
+			}
+		case depositsplit.FieldReceiveAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field receive_address", values[i])
+			} else if value.Valid {
+				ds.ReceiveAddress = value.String
+			}
+		case depositsplit.FieldFromAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_address", values[i])
+			} else if value.Valid {
+				ds.FromAddress = value.String
+			}
+		case depositsplit.FieldAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				ds.Amount = *value
+			}
+		case depositsplit.FieldBlockNumber:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field block_number", values[i])
+			} else if value.Valid {
+				ds.BlockNumber = value.Int64
+			}
+		case depositsplit.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ds.Status = depositsplit.Status(value.String)
+			}
+		case depositsplit.FieldResolvedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field resolved_at", values[i])
+			} else if value.Valid {
+				ds.ResolvedAt = value.Time
+			}
+		default:
+			ds.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DepositSplit.
+// This includes values selected through modifiers, order, etc.
+func (ds *DepositSplit) Value(name string) (ent.Value, error) {
+	return ds.selectValues.Get(name)
+}
+
+// QueryPaymentOrders queries the "payment_orders" edge of the DepositSplit entity.
+func (ds *DepositSplit) QueryPaymentOrders() *PaymentOrderQuery {
+	return NewDepositSplitClient(ds.config).QueryPaymentOrders(ds)
+}
+
+// Update returns a builder for updating this DepositSplit.
+// Note that you need to call DepositSplit.Unwrap() before calling this method if this DepositSplit
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ds *DepositSplit) Update() *DepositSplitUpdateOne {
+	return NewDepositSplitClient(ds.config).UpdateOne(ds)
+}
+
+// Unwrap unwraps the DepositSplit entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ds *DepositSplit) Unwrap() *DepositSplit {
+	_tx, ok := ds.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DepositSplit is not a transactional entity")
+	}
+	ds.config.driver = _tx.drv
+	return ds
+}
+
+// String implements the fmt.Stringer.
+func (ds *DepositSplit) String() string {
+	var builder strings.Builder
+	builder.WriteString("DepositSplit(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ds.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ds.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ds.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("tx_hash=")
+	builder.WriteString(ds.TxHash)
+	builder.WriteString(", ")
+	builder.WriteString("network=")
+	builder.WriteString(ds.Network)
+	builder.WriteString(", ")
+	builder.WriteString("receive_address=")
+	builder.WriteString(ds.ReceiveAddress)
+	builder.WriteString(", ")
+	builder.WriteString("from_address=")
+	builder.WriteString(ds.FromAddress)
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", ds.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("block_number=")
+	builder.WriteString(fmt.Sprintf("%v", ds.BlockNumber))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", ds.Status))
+	builder.WriteString(", ")
+	builder.WriteString("resolved_at=")
+	builder.WriteString(ds.ResolvedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DepositSplits is a parsable slice of DepositSplit.
+type DepositSplits []*DepositSplit
@@ -0,0 +1,631 @@
+// Code generated by ent, DO NOT EDIT.
+
+package depositsplit
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TxHash applies equality check predicate on the "tx_hash" field. It's identical to TxHashEQ.
+func TxHash(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldEQ(FieldTxHash, v))
+}
+
+// Network applies equality check predicate on the "network" field. It's identical to NetworkEQ.
+func Network(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldEQ(FieldNetwork, v))
+}
+
+// ReceiveAddress applies equality check predicate on the "receive_address" field. It's identical to ReceiveAddressEQ.
+func ReceiveAddress(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldEQ(FieldReceiveAddress, v))
+}
+
+// FromAddress applies equality check predicate on the "from_address" field. It's identical to FromAddressEQ.
+func FromAddress(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldEQ(FieldFromAddress, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v decimal.Decimal) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldEQ(FieldAmount, v))
+}
+
+// BlockNumber applies equality check predicate on the "block_number" field. It's identical to BlockNumberEQ.
+func BlockNumber(v int64) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldEQ(FieldBlockNumber, v))
+}
+
+// ResolvedAt applies equality check predicate on the "resolved_at" field. It's identical to ResolvedAtEQ.
+func ResolvedAt(v time.Time) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// TxHashEQ applies the EQ predicate on the "tx_hash" field.
+func TxHashEQ(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldEQ(FieldTxHash, v))
+}
+
+// TxHashNEQ applies the NEQ predicate on the "tx_hash" field.
+func TxHashNEQ(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldNEQ(FieldTxHash, v))
+}
+
+// TxHashIn applies the In predicate on the "tx_hash" field.
+func TxHashIn(vs ...string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldIn(FieldTxHash, vs...))
+}
+
+// TxHashNotIn applies the NotIn predicate on the "tx_hash" field.
+func TxHashNotIn(vs ...string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldNotIn(FieldTxHash, vs...))
+}
+
+// TxHashGT applies the GT predicate on the "tx_hash" field.
+func TxHashGT(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldGT(FieldTxHash, v))
+}
+
+// TxHashGTE applies the GTE predicate on the "tx_hash" field.
+func TxHashGTE(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldGTE(FieldTxHash, v))
+}
+
+// TxHashLT applies the LT predicate on the "tx_hash" field.
+func TxHashLT(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldLT(FieldTxHash, v))
+}
+
+// TxHashLTE applies the LTE predicate on the "tx_hash" field.
+func TxHashLTE(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldLTE(FieldTxHash, v))
+}
+
+// TxHashContains applies the Contains predicate on the "tx_hash" field.
+func TxHashContains(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldContains(FieldTxHash, v))
+}
+
+// TxHashHasPrefix applies the HasPrefix predicate on the "tx_hash" field.
+func TxHashHasPrefix(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldHasPrefix(FieldTxHash, v))
+}
+
+// TxHashHasSuffix applies the HasSuffix predicate on the "tx_hash" field.
+func TxHashHasSuffix(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldHasSuffix(FieldTxHash, v))
+}
+
+// TxHashEqualFold applies the EqualFold predicate on the "tx_hash" field.
+func TxHashEqualFold(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldEqualFold(FieldTxHash, v))
+}
+
+// TxHashContainsFold applies the ContainsFold predicate on the "tx_hash" field.
+func TxHashContainsFold(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldContainsFold(FieldTxHash, v))
+}
+
+// NetworkEQ applies the EQ predicate on the "network" field.
+func NetworkEQ(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldEQ(FieldNetwork, v))
+}
+
+// NetworkNEQ applies the NEQ predicate on the "network" field.
+func NetworkNEQ(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldNEQ(FieldNetwork, v))
+}
+
+// NetworkIn applies the In predicate on the "network" field.
+func NetworkIn(vs ...string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldIn(FieldNetwork, vs...))
+}
+
+// NetworkNotIn applies the NotIn predicate on the "network" field.
+func NetworkNotIn(vs ...string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldNotIn(FieldNetwork, vs...))
+}
+
+// NetworkGT applies the GT predicate on the "network" field.
+func NetworkGT(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldGT(FieldNetwork, v))
+}
+
+// NetworkGTE applies the GTE predicate on the "network" field.
+func NetworkGTE(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldGTE(FieldNetwork, v))
+}
+
+// NetworkLT applies the LT predicate on the "network" field.
+func NetworkLT(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldLT(FieldNetwork, v))
+}
+
+// NetworkLTE applies the LTE predicate on the "network" field.
+func NetworkLTE(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldLTE(FieldNetwork, v))
+}
+
+// NetworkContains applies the Contains predicate on the "network" field.
+func NetworkContains(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldContains(FieldNetwork, v))
+}
+
+// NetworkHasPrefix applies the HasPrefix predicate on the "network" field.
+func NetworkHasPrefix(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldHasPrefix(FieldNetwork, v))
+}
+
+// NetworkHasSuffix applies the HasSuffix predicate on the "network" field.
+func NetworkHasSuffix(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldHasSuffix(FieldNetwork, v))
+}
+
+// NetworkEqualFold applies the EqualFold predicate on the "network" field.
+func NetworkEqualFold(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldEqualFold(FieldNetwork, v))
+}
+
+// NetworkContainsFold applies the ContainsFold predicate on the "network" field.
+func NetworkContainsFold(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldContainsFold(FieldNetwork, v))
+}
+
+// ReceiveAddressEQ applies the EQ predicate on the "receive_address" field.
+func ReceiveAddressEQ(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldEQ(FieldReceiveAddress, v))
+}
+
+// ReceiveAddressNEQ applies the NEQ predicate on the "receive_address" field.
+func ReceiveAddressNEQ(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldNEQ(FieldReceiveAddress, v))
+}
+
+// ReceiveAddressIn applies the In predicate on the "receive_address" field.
+func ReceiveAddressIn(vs ...string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldIn(FieldReceiveAddress, vs...))
+}
+
+// ReceiveAddressNotIn applies the NotIn predicate on the "receive_address" field.
+func ReceiveAddressNotIn(vs ...string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldNotIn(FieldReceiveAddress, vs...))
+}
+
+// ReceiveAddressGT applies the GT predicate on the "receive_address" field.
+func ReceiveAddressGT(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldGT(FieldReceiveAddress, v))
+}
+
+// ReceiveAddressGTE applies the GTE predicate on the "receive_address" field.
+func ReceiveAddressGTE(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldGTE(FieldReceiveAddress, v))
+}
+
+// ReceiveAddressLT applies the LT predicate on the "receive_address" field.
+func ReceiveAddressLT(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldLT(FieldReceiveAddress, v))
+}
+
+// ReceiveAddressLTE applies the LTE predicate on the "receive_address" field.
+func ReceiveAddressLTE(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldLTE(FieldReceiveAddress, v))
+}
+
+// ReceiveAddressContains applies the Contains predicate on the "receive_address" field.
+func ReceiveAddressContains(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldContains(FieldReceiveAddress, v))
+}
+
+// ReceiveAddressHasPrefix applies the HasPrefix predicate on the "receive_address" field.
+func ReceiveAddressHasPrefix(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldHasPrefix(FieldReceiveAddress, v))
+}
+
+// ReceiveAddressHasSuffix applies the HasSuffix predicate on the "receive_address" field.
+func ReceiveAddressHasSuffix(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldHasSuffix(FieldReceiveAddress, v))
+}
+
+// ReceiveAddressEqualFold applies the EqualFold predicate on the "receive_address" field.
+func ReceiveAddressEqualFold(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldEqualFold(FieldReceiveAddress, v))
+}
+
+// ReceiveAddressContainsFold applies the ContainsFold predicate on the "receive_address" field.
+func ReceiveAddressContainsFold(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldContainsFold(FieldReceiveAddress, v))
+}
+
+// FromAddressEQ applies the EQ predicate on the "from_address" field.
+func FromAddressEQ(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldEQ(FieldFromAddress, v))
+}
+
+// FromAddressNEQ applies the NEQ predicate on the "from_address" field.
+func FromAddressNEQ(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldNEQ(FieldFromAddress, v))
+}
+
+// FromAddressIn applies the In predicate on the "from_address" field.
+func FromAddressIn(vs ...string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldIn(FieldFromAddress, vs...))
+}
+
+// FromAddressNotIn applies the NotIn predicate on the "from_address" field.
+func FromAddressNotIn(vs ...string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldNotIn(FieldFromAddress, vs...))
+}
+
+// FromAddressGT applies the GT predicate on the "from_address" field.
+func FromAddressGT(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldGT(FieldFromAddress, v))
+}
+
+// FromAddressGTE applies the GTE predicate on the "from_address" field.
+func FromAddressGTE(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldGTE(FieldFromAddress, v))
+}
+
+// FromAddressLT applies the LT predicate on the "from_address" field.
+func FromAddressLT(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldLT(FieldFromAddress, v))
+}
+
+// FromAddressLTE applies the LTE predicate on the "from_address" field.
+func FromAddressLTE(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldLTE(FieldFromAddress, v))
+}
+
+// FromAddressContains applies the Contains predicate on the "from_address" field.
+func FromAddressContains(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldContains(FieldFromAddress, v))
+}
+
+// FromAddressHasPrefix applies the HasPrefix predicate on the "from_address" field.
+func FromAddressHasPrefix(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldHasPrefix(FieldFromAddress, v))
+}
+
+// FromAddressHasSuffix applies the HasSuffix predicate on the "from_address" field.
+func FromAddressHasSuffix(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldHasSuffix(FieldFromAddress, v))
+}
+
+// FromAddressEqualFold applies the EqualFold predicate on the "from_address" field.
+func FromAddressEqualFold(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldEqualFold(FieldFromAddress, v))
+}
+
+// FromAddressContainsFold applies the ContainsFold predicate on the "from_address" field.
+func FromAddressContainsFold(v string) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldContainsFold(FieldFromAddress, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v decimal.Decimal) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v decimal.Decimal) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...decimal.Decimal) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...decimal.Decimal) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v decimal.Decimal) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v decimal.Decimal) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v decimal.Decimal) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v decimal.Decimal) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldLTE(FieldAmount, v))
+}
+
+// BlockNumberEQ applies the EQ predicate on the "block_number" field.
+func BlockNumberEQ(v int64) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldEQ(FieldBlockNumber, v))
+}
+
+// BlockNumberNEQ applies the NEQ predicate on the "block_number" field.
+func BlockNumberNEQ(v int64) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldNEQ(FieldBlockNumber, v))
+}
+
+// BlockNumberIn applies the In predicate on the "block_number" field.
+func BlockNumberIn(vs ...int64) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldIn(FieldBlockNumber, vs...))
+}
+
+// BlockNumberNotIn applies the NotIn predicate on the "block_number" field.
+func BlockNumberNotIn(vs ...int64) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldNotIn(FieldBlockNumber, vs...))
+}
+
+// BlockNumberGT applies the GT predicate on the "block_number" field.
+func BlockNumberGT(v int64) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldGT(FieldBlockNumber, v))
+}
+
+// BlockNumberGTE applies the GTE predicate on the "block_number" field.
+func BlockNumberGTE(v int64) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldGTE(FieldBlockNumber, v))
+}
+
+// BlockNumberLT applies the LT predicate on the "block_number" field.
+func BlockNumberLT(v int64) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldLT(FieldBlockNumber, v))
+}
+
+// BlockNumberLTE applies the LTE predicate on the "block_number" field.
+func BlockNumberLTE(v int64) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldLTE(FieldBlockNumber, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ResolvedAtEQ applies the EQ predicate on the "resolved_at" field.
+func ResolvedAtEQ(v time.Time) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtNEQ applies the NEQ predicate on the "resolved_at" field.
+func ResolvedAtNEQ(v time.Time) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldNEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtIn applies the In predicate on the "resolved_at" field.
+func ResolvedAtIn(vs ...time.Time) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtNotIn applies the NotIn predicate on the "resolved_at" field.
+func ResolvedAtNotIn(vs ...time.Time) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldNotIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtGT applies the GT predicate on the "resolved_at" field.
+func ResolvedAtGT(v time.Time) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldGT(FieldResolvedAt, v))
+}
+
+// ResolvedAtGTE applies the GTE predicate on the "resolved_at" field.
+func ResolvedAtGTE(v time.Time) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldGTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtLT applies the LT predicate on the "resolved_at" field.
+func ResolvedAtLT(v time.Time) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldLT(FieldResolvedAt, v))
+}
+
+// ResolvedAtLTE applies the LTE predicate on the "resolved_at" field.
+func ResolvedAtLTE(v time.Time) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldLTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtIsNil applies the IsNil predicate on the "resolved_at" field.
+func ResolvedAtIsNil() predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldIsNull(FieldResolvedAt))
+}
+
+// ResolvedAtNotNil applies the NotNil predicate on the "resolved_at" field.
+func ResolvedAtNotNil() predicate.DepositSplit {
+	return predicate.DepositSplit(sql.FieldNotNull(FieldResolvedAt))
+}
+
+// HasPaymentOrders applies the HasEdge predicate on the "payment_orders" edge.
+func HasPaymentOrders() predicate.DepositSplit {
+	return predicate.DepositSplit(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PaymentOrdersTable, PaymentOrdersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPaymentOrdersWith applies the HasEdge predicate on the "payment_orders" edge with a given conditions (other predicates).
+func HasPaymentOrdersWith(preds ...predicate.PaymentOrder) predicate.DepositSplit {
+	return predicate.DepositSplit(func(s *sql.Selector) {
+		step := newPaymentOrdersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DepositSplit) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DepositSplit) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DepositSplit) predicate.DepositSplit {
+	return predicate.DepositSplit(sql.NotPredicates(p))
+}
@@ -0,0 +1,1108 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/depositsplit"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// DepositSplitCreate is the builder for creating a DepositSplit entity.
+type DepositSplitCreate struct {
+	config
+	mutation *DepositSplitMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dsc *DepositSplitCreate) SetCreatedAt(t time.Time) *DepositSplitCreate {
+	dsc.mutation.SetCreatedAt(t)
+	return dsc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dsc *DepositSplitCreate) SetNillableCreatedAt(t *time.Time) *DepositSplitCreate {
+	if t != nil {
+		dsc.SetCreatedAt(*t)
+	}
+	return dsc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dsc *DepositSplitCreate) SetUpdatedAt(t time.Time) *DepositSplitCreate {
+	dsc.mutation.SetUpdatedAt(t)
+	return dsc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (dsc *DepositSplitCreate) SetNillableUpdatedAt(t *time.Time) *DepositSplitCreate {
+	if t != nil {
+		dsc.SetUpdatedAt(*t)
+	}
+	return dsc
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (dsc *DepositSplitCreate) SetTxHash(s string) *DepositSplitCreate {
+	dsc.mutation.SetTxHash(s)
+	return dsc
+}
+
+// SetNetwork sets the "network" field.
+func (dsc *DepositSplitCreate) SetNetwork(s string) *DepositSplitCreate {
+	dsc.mutation.SetNetwork(s)
+	return dsc
+}
+
+// SetReceiveAddress sets the "receive_address" field.
+func (dsc *DepositSplitCreate) SetReceiveAddress(s string) *DepositSplitCreate {
+	dsc.mutation.SetReceiveAddress(s)
+	return dsc
+}
+
+// SetFromAddress sets the "from_address" field.
+func (dsc *DepositSplitCreate) SetFromAddress(s string) *DepositSplitCreate {
+	dsc.mutation.SetFromAddress(s)
+	return dsc
+}
+
+// SetAmount sets the "amount" field.
+func (dsc *DepositSplitCreate) SetAmount(d decimal.Decimal) *DepositSplitCreate {
+	dsc.mutation.SetAmount(d)
+	return dsc
+}
+
+// SetBlockNumber sets the "block_number" field.
+func (dsc *DepositSplitCreate) SetBlockNumber(i int64) *DepositSplitCreate {
+	dsc.mutation.SetBlockNumber(i)
+	return dsc
+}
+
+// SetNillableBlockNumber sets the "block_number" field if the given value is not nil.
+func (dsc *DepositSplitCreate) SetNillableBlockNumber(i *int64) *DepositSplitCreate {
+	if i != nil {
+		dsc.SetBlockNumber(*i)
+	}
+	return dsc
+}
+
+// SetStatus sets the "status" field.
+func (dsc *DepositSplitCreate) SetStatus(d depositsplit.Status) *DepositSplitCreate {
+	dsc.mutation.SetStatus(d)
+	return dsc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (dsc *DepositSplitCreate) SetNillableStatus(d *depositsplit.Status) *DepositSplitCreate {
+	if d != nil {
+		dsc.SetStatus(*d)
+	}
+	return dsc
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (dsc *DepositSplitCreate) SetResolvedAt(t time.Time) *DepositSplitCreate {
+	dsc.mutation.SetResolvedAt(t)
+	return dsc
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (dsc *DepositSplitCreate) SetNillableResolvedAt(t *time.Time) *DepositSplitCreate {
+	if t != nil {
+		dsc.SetResolvedAt(*t)
+	}
+	return dsc
+}
+
+// SetID sets the "id" field.
+func (dsc *DepositSplitCreate) SetID(u uuid.UUID) *DepositSplitCreate {
+	dsc.mutation.SetID(u)
+	return dsc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (dsc *DepositSplitCreate) SetNillableID(u *uuid.UUID) *DepositSplitCreate {
+	if u != nil {
+		dsc.SetID(*u)
+	}
+	return dsc
+}
+
+// AddPaymentOrderIDs adds the "payment_orders" edge to the PaymentOrder entity by IDs.
+func (dsc *DepositSplitCreate) AddPaymentOrderIDs(ids ...uuid.UUID) *DepositSplitCreate {
+	dsc.mutation.AddPaymentOrderIDs(ids...)
+	return dsc
+}
+
+// AddPaymentOrders adds the "payment_orders" edges to the PaymentOrder entity.
+func (dsc *DepositSplitCreate) AddPaymentOrders(p ...*PaymentOrder) *DepositSplitCreate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return dsc.AddPaymentOrderIDs(ids...)
+}
+
+// Mutation returns the DepositSplitMutation object of the builder.
+func (dsc *DepositSplitCreate) Mutation() *DepositSplitMutation {
+	return dsc.mutation
+}
+
+// Save creates the DepositSplit in the database.
+func (dsc *DepositSplitCreate) Save(ctx context.Context) (*DepositSplit, error) {
+	dsc.defaults()
+	return withHooks(ctx, dsc.sqlSave, dsc.mutation, dsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dsc *DepositSplitCreate) SaveX(ctx context.Context) *DepositSplit {
+	v, err := dsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dsc *DepositSplitCreate) Exec(ctx context.Context) error {
+	_, err := dsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dsc *DepositSplitCreate) ExecX(ctx context.Context) {
+	if err := dsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dsc *DepositSplitCreate) defaults() {
+	if _, ok := dsc.mutation.CreatedAt(); !ok {
+		v := depositsplit.DefaultCreatedAt()
+		dsc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := dsc.mutation.UpdatedAt(); !ok {
+		v := depositsplit.DefaultUpdatedAt()
+		dsc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := dsc.mutation.BlockNumber(); !ok {
+		v := depositsplit.DefaultBlockNumber
+		dsc.mutation.SetBlockNumber(v)
+	}
+	if _, ok := dsc.mutation.Status(); !ok {
+		v := depositsplit.DefaultStatus
+		dsc.mutation.SetStatus(v)
+	}
+	if _, ok := dsc.mutation.ID(); !ok {
+		v := depositsplit.DefaultID()
+		dsc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dsc *DepositSplitCreate) check() error {
+	if _, ok := dsc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DepositSplit.created_at"`)}
+	}
+	if _, ok := dsc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "DepositSplit.updated_at"`)}
+	}
+	if _, ok := dsc.mutation.TxHash(); !ok {
+		return &ValidationError{Name: "tx_hash", err: errors.New(`ent: missing required field "DepositSplit.tx_hash"`)}
+	}
+	if v, ok := dsc.mutation.TxHash(); ok {
+		if err := depositsplit.TxHashValidator(v); err != nil {
+			return &ValidationError{Name: "tx_hash", err: fmt.Errorf(`ent: validator failed for field "DepositSplit.tx_hash": %w`, err)}
+		}
+	}
+	if _, ok := dsc.mutation.Network(); !ok {
+		return &ValidationError{Name: "network", err: errors.New(`ent: missing required field "DepositSplit.network"`)}
+	}
+	if _, ok := dsc.mutation.ReceiveAddress(); !ok {
+		return &ValidationError{Name: "receive_address", err: errors.New(`ent: missing required field "DepositSplit.receive_address"`)}
+	}
+	if _, ok := dsc.mutation.FromAddress(); !ok {
+		return &ValidationError{Name: "from_address", err: errors.New(`ent: missing required field "DepositSplit.from_address"`)}
+	}
+	if _, ok := dsc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "DepositSplit.amount"`)}
+	}
+	if _, ok := dsc.mutation.BlockNumber(); !ok {
+		return &ValidationError{Name: "block_number", err: errors.New(`ent: missing required field "DepositSplit.block_number"`)}
+	}
+	if _, ok := dsc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "DepositSplit.status"`)}
+	}
+	if v, ok := dsc.mutation.Status(); ok {
+		if err := depositsplit.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DepositSplit.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (dsc *DepositSplitCreate) sqlSave(ctx context.Context) (*DepositSplit, error) {
+	if err := dsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	dsc.mutation.id = &_node.ID
+	dsc.mutation.done = true
+	return _node, nil
+}
+
+func (dsc *DepositSplitCreate) createSpec() (*DepositSplit, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DepositSplit{config: dsc.config}
+		_spec = sqlgraph.NewCreateSpec(depositsplit.Table, sqlgraph.NewFieldSpec(depositsplit.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = dsc.conflict
+	if id, ok := dsc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := dsc.mutation.CreatedAt(); ok {
+		_spec.SetField(depositsplit.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := dsc.mutation.UpdatedAt(); ok {
+		_spec.SetField(depositsplit.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := dsc.mutation.TxHash(); ok {
+		_spec.SetField(depositsplit.FieldTxHash, field.TypeString, value)
+		_node.TxHash = value
+	}
+	if value, ok := dsc.mutation.Network(); ok {
+		_spec.SetField(depositsplit.FieldNetwork, field.TypeString, value)
+		_node.Network = value
+	}
+	if value, ok := dsc.mutation.ReceiveAddress(); ok {
+		_spec.SetField(depositsplit.FieldReceiveAddress, field.TypeString, value)
+		_node.ReceiveAddress = value
+	}
+	if value, ok := dsc.mutation.FromAddress(); ok {
+		_spec.SetField(depositsplit.FieldFromAddress, field.TypeString, value)
+		_node.FromAddress = value
+	}
+	if value, ok := dsc.mutation.Amount(); ok {
+		_spec.SetField(depositsplit.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := dsc.mutation.BlockNumber(); ok {
+		_spec.SetField(depositsplit.FieldBlockNumber, field.TypeInt64, value)
+		_node.BlockNumber = value
+	}
+	if value, ok := dsc.mutation.Status(); ok {
+		_spec.SetField(depositsplit.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := dsc.mutation.ResolvedAt(); ok {
+		_spec.SetField(depositsplit.FieldResolvedAt, field.TypeTime, value)
+		_node.ResolvedAt = value
+	}
+	if nodes := dsc.mutation.PaymentOrdersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   depositsplit.PaymentOrdersTable,
+			Columns: []string{depositsplit.PaymentOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DepositSplit.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DepositSplitUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (dsc *DepositSplitCreate) OnConflict(opts ...sql.ConflictOption) *DepositSplitUpsertOne {
+	dsc.conflict = opts
+	return &DepositSplitUpsertOne{
+		create: dsc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DepositSplit.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dsc *DepositSplitCreate) OnConflictColumns(columns ...string) *DepositSplitUpsertOne {
+	dsc.conflict = append(dsc.conflict, sql.ConflictColumns(columns...))
+	return &DepositSplitUpsertOne{
+		create: dsc,
+	}
+}
+
+type (
+	// DepositSplitUpsertOne is the builder for "upsert"-ing
+	//  one DepositSplit node.
+	DepositSplitUpsertOne struct {
+		create *DepositSplitCreate
+	}
+
+	// DepositSplitUpsert is the "OnConflict" setter.
+	DepositSplitUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DepositSplitUpsert) SetUpdatedAt(v time.Time) *DepositSplitUpsert {
+	u.Set(depositsplit.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DepositSplitUpsert) UpdateUpdatedAt() *DepositSplitUpsert {
+	u.SetExcluded(depositsplit.FieldUpdatedAt)
+	return u
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (u *DepositSplitUpsert) SetTxHash(v string) *DepositSplitUpsert {
+	u.Set(depositsplit.FieldTxHash, v)
+	return u
+}
+
+// UpdateTxHash sets the "tx_hash" field to the value that was provided on create.
+func (u *DepositSplitUpsert) UpdateTxHash() *DepositSplitUpsert {
+	u.SetExcluded(depositsplit.FieldTxHash)
+	return u
+}
+
+// SetNetwork sets the "network" field.
+func (u *DepositSplitUpsert) SetNetwork(v string) *DepositSplitUpsert {
+	u.Set(depositsplit.FieldNetwork, v)
+	return u
+}
+
+// UpdateNetwork sets the "network" field to the value that was provided on create.
+func (u *DepositSplitUpsert) UpdateNetwork() *DepositSplitUpsert {
+	u.SetExcluded(depositsplit.FieldNetwork)
+	return u
+}
+
+// SetReceiveAddress sets the "receive_address" field.
+func (u *DepositSplitUpsert) SetReceiveAddress(v string) *DepositSplitUpsert {
+	u.Set(depositsplit.FieldReceiveAddress, v)
+	return u
+}
+
+// UpdateReceiveAddress sets the "receive_address" field to the value that was provided on create.
+func (u *DepositSplitUpsert) UpdateReceiveAddress() *DepositSplitUpsert {
+	u.SetExcluded(depositsplit.FieldReceiveAddress)
+	return u
+}
+
+// SetFromAddress sets the "from_address" field.
+func (u *DepositSplitUpsert) SetFromAddress(v string) *DepositSplitUpsert {
+	u.Set(depositsplit.FieldFromAddress, v)
+	return u
+}
+
+// UpdateFromAddress sets the "from_address" field to the value that was provided on create.
+func (u *DepositSplitUpsert) UpdateFromAddress() *DepositSplitUpsert {
+	u.SetExcluded(depositsplit.FieldFromAddress)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *DepositSplitUpsert) SetAmount(v decimal.Decimal) *DepositSplitUpsert {
+	u.Set(depositsplit.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *DepositSplitUpsert) UpdateAmount() *DepositSplitUpsert {
+	u.SetExcluded(depositsplit.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *DepositSplitUpsert) AddAmount(v decimal.Decimal) *DepositSplitUpsert {
+	u.Add(depositsplit.FieldAmount, v)
+	return u
+}
+
+// SetBlockNumber sets the "block_number" field.
+func (u *DepositSplitUpsert) SetBlockNumber(v int64) *DepositSplitUpsert {
+	u.Set(depositsplit.FieldBlockNumber, v)
+	return u
+}
+
+// UpdateBlockNumber sets the "block_number" field to the value that was provided on create.
+func (u *DepositSplitUpsert) UpdateBlockNumber() *DepositSplitUpsert {
+	u.SetExcluded(depositsplit.FieldBlockNumber)
+	return u
+}
+
+// AddBlockNumber adds v to the "block_number" field.
+func (u *DepositSplitUpsert) AddBlockNumber(v int64) *DepositSplitUpsert {
+	u.Add(depositsplit.FieldBlockNumber, v)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *DepositSplitUpsert) SetStatus(v depositsplit.Status) *DepositSplitUpsert {
+	u.Set(depositsplit.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *DepositSplitUpsert) UpdateStatus() *DepositSplitUpsert {
+	u.SetExcluded(depositsplit.FieldStatus)
+	return u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (u *DepositSplitUpsert) SetResolvedAt(v time.Time) *DepositSplitUpsert {
+	u.Set(depositsplit.FieldResolvedAt, v)
+	return u
+}
+
+// UpdateResolvedAt sets the "resolved_at" field to the value that was provided on create.
+func (u *DepositSplitUpsert) UpdateResolvedAt() *DepositSplitUpsert {
+	u.SetExcluded(depositsplit.FieldResolvedAt)
+	return u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (u *DepositSplitUpsert) ClearResolvedAt() *DepositSplitUpsert {
+	u.SetNull(depositsplit.FieldResolvedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DepositSplit.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(depositsplit.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DepositSplitUpsertOne) UpdateNewValues() *DepositSplitUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(depositsplit.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(depositsplit.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DepositSplit.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DepositSplitUpsertOne) Ignore() *DepositSplitUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DepositSplitUpsertOne) DoNothing() *DepositSplitUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DepositSplitCreate.OnConflict
+// documentation for more info.
+func (u *DepositSplitUpsertOne) Update(set func(*DepositSplitUpsert)) *DepositSplitUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DepositSplitUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DepositSplitUpsertOne) SetUpdatedAt(v time.Time) *DepositSplitUpsertOne {
+	return u.Update(func(s *DepositSplitUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DepositSplitUpsertOne) UpdateUpdatedAt() *DepositSplitUpsertOne {
+	return u.Update(func(s *DepositSplitUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (u *DepositSplitUpsertOne) SetTxHash(v string) *DepositSplitUpsertOne {
+	return u.Update(func(s *DepositSplitUpsert) {
+		s.SetTxHash(v)
+	})
+}
+
+// UpdateTxHash sets the "tx_hash" field to the value that was provided on create.
+func (u *DepositSplitUpsertOne) UpdateTxHash() *DepositSplitUpsertOne {
+	return u.Update(func(s *DepositSplitUpsert) {
+		s.UpdateTxHash()
+	})
+}
+
+// SetNetwork sets the "network" field.
+func (u *DepositSplitUpsertOne) SetNetwork(v string) *DepositSplitUpsertOne {
+	return u.Update(func(s *DepositSplitUpsert) {
+		s.SetNetwork(v)
+	})
+}
+
+// UpdateNetwork sets the "network" field to the value that was provided on create.
+func (u *DepositSplitUpsertOne) UpdateNetwork() *DepositSplitUpsertOne {
+	return u.Update(func(s *DepositSplitUpsert) {
+		s.UpdateNetwork()
+	})
+}
+
+// SetReceiveAddress sets the "receive_address" field.
+func (u *DepositSplitUpsertOne) SetReceiveAddress(v string) *DepositSplitUpsertOne {
+	return u.Update(func(s *DepositSplitUpsert) {
+		s.SetReceiveAddress(v)
+	})
+}
+
+// UpdateReceiveAddress sets the "receive_address" field to the value that was provided on create.
+func (u *DepositSplitUpsertOne) UpdateReceiveAddress() *DepositSplitUpsertOne {
+	return u.Update(func(s *DepositSplitUpsert) {
+		s.UpdateReceiveAddress()
+	})
+}
+
+// SetFromAddress sets the "from_address" field.
+func (u *DepositSplitUpsertOne) SetFromAddress(v string) *DepositSplitUpsertOne {
+	return u.Update(func(s *DepositSplitUpsert) {
+		s.SetFromAddress(v)
+	})
+}
+
+// UpdateFromAddress sets the "from_address" field to the value that was provided on create.
+func (u *DepositSplitUpsertOne) UpdateFromAddress() *DepositSplitUpsertOne {
+	return u.Update(func(s *DepositSplitUpsert) {
+		s.UpdateFromAddress()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *DepositSplitUpsertOne) SetAmount(v decimal.Decimal) *DepositSplitUpsertOne {
+	return u.Update(func(s *DepositSplitUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *DepositSplitUpsertOne) AddAmount(v decimal.Decimal) *DepositSplitUpsertOne {
+	return u.Update(func(s *DepositSplitUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *DepositSplitUpsertOne) UpdateAmount() *DepositSplitUpsertOne {
+	return u.Update(func(s *DepositSplitUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetBlockNumber sets the "block_number" field.
+func (u *DepositSplitUpsertOne) SetBlockNumber(v int64) *DepositSplitUpsertOne {
+	return u.Update(func(s *DepositSplitUpsert) {
+		s.SetBlockNumber(v)
+	})
+}
+
+// AddBlockNumber adds v to the "block_number" field.
+func (u *DepositSplitUpsertOne) AddBlockNumber(v int64) *DepositSplitUpsertOne {
+	return u.Update(func(s *DepositSplitUpsert) {
+		s.AddBlockNumber(v)
+	})
+}
+
+// UpdateBlockNumber sets the "block_number" field to the value that was provided on create.
+func (u *DepositSplitUpsertOne) UpdateBlockNumber() *DepositSplitUpsertOne {
+	return u.Update(func(s *DepositSplitUpsert) {
+		s.UpdateBlockNumber()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *DepositSplitUpsertOne) SetStatus(v depositsplit.Status) *DepositSplitUpsertOne {
+	return u.Update(func(s *DepositSplitUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *DepositSplitUpsertOne) UpdateStatus() *DepositSplitUpsertOne {
+	return u.Update(func(s *DepositSplitUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (u *DepositSplitUpsertOne) SetResolvedAt(v time.Time) *DepositSplitUpsertOne {
+	return u.Update(func(s *DepositSplitUpsert) {
+		s.SetResolvedAt(v)
+	})
+}
+
+// UpdateResolvedAt sets the "resolved_at" field to the value that was provided on create.
+func (u *DepositSplitUpsertOne) UpdateResolvedAt() *DepositSplitUpsertOne {
+	return u.Update(func(s *DepositSplitUpsert) {
+		s.UpdateResolvedAt()
+	})
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (u *DepositSplitUpsertOne) ClearResolvedAt() *DepositSplitUpsertOne {
+	return u.Update(func(s *DepositSplitUpsert) {
+		s.ClearResolvedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DepositSplitUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DepositSplitCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DepositSplitUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DepositSplitUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: DepositSplitUpsertOne.ID is not supported by MySQL driver. Use DepositSplitUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DepositSplitUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DepositSplitCreateBulk is the builder for creating many DepositSplit entities in bulk.
+type DepositSplitCreateBulk struct {
+	config
+	err      error
+	builders []*DepositSplitCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DepositSplit entities in the database.
+func (dscb *DepositSplitCreateBulk) Save(ctx context.Context) ([]*DepositSplit, error) {
+	if dscb.err != nil {
+		return nil, dscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(dscb.builders))
+	nodes := make([]*DepositSplit, len(dscb.builders))
+	mutators := make([]Mutator, len(dscb.builders))
+	for i := range dscb.builders {
+		func(i int, root context.Context) {
+			builder := dscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DepositSplitMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = dscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dscb *DepositSplitCreateBulk) SaveX(ctx context.Context) []*DepositSplit {
+	v, err := dscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dscb *DepositSplitCreateBulk) Exec(ctx context.Context) error {
+	_, err := dscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dscb *DepositSplitCreateBulk) ExecX(ctx context.Context) {
+	if err := dscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DepositSplit.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DepositSplitUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (dscb *DepositSplitCreateBulk) OnConflict(opts ...sql.ConflictOption) *DepositSplitUpsertBulk {
+	dscb.conflict = opts
+	return &DepositSplitUpsertBulk{
+		create: dscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DepositSplit.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dscb *DepositSplitCreateBulk) OnConflictColumns(columns ...string) *DepositSplitUpsertBulk {
+	dscb.conflict = append(dscb.conflict, sql.ConflictColumns(columns...))
+	return &DepositSplitUpsertBulk{
+		create: dscb,
+	}
+}
+
+// DepositSplitUpsertBulk is the builder for "upsert"-ing
+// a bulk of DepositSplit nodes.
+type DepositSplitUpsertBulk struct {
+	create *DepositSplitCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DepositSplit.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(depositsplit.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DepositSplitUpsertBulk) UpdateNewValues() *DepositSplitUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(depositsplit.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(depositsplit.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DepositSplit.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DepositSplitUpsertBulk) Ignore() *DepositSplitUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DepositSplitUpsertBulk) DoNothing() *DepositSplitUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DepositSplitCreateBulk.OnConflict
+// documentation for more info.
+func (u *DepositSplitUpsertBulk) Update(set func(*DepositSplitUpsert)) *DepositSplitUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DepositSplitUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DepositSplitUpsertBulk) SetUpdatedAt(v time.Time) *DepositSplitUpsertBulk {
+	return u.Update(func(s *DepositSplitUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DepositSplitUpsertBulk) UpdateUpdatedAt() *DepositSplitUpsertBulk {
+	return u.Update(func(s *DepositSplitUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (u *DepositSplitUpsertBulk) SetTxHash(v string) *DepositSplitUpsertBulk {
+	return u.Update(func(s *DepositSplitUpsert) {
+		s.SetTxHash(v)
+	})
+}
+
+// UpdateTxHash sets the "tx_hash" field to the value that was provided on create.
+func (u *DepositSplitUpsertBulk) UpdateTxHash() *DepositSplitUpsertBulk {
+	return u.Update(func(s *DepositSplitUpsert) {
+		s.UpdateTxHash()
+	})
+}
+
+// SetNetwork sets the "network" field.
+func (u *DepositSplitUpsertBulk) SetNetwork(v string) *DepositSplitUpsertBulk {
+	return u.Update(func(s *DepositSplitUpsert) {
+		s.SetNetwork(v)
+	})
+}
+
+// UpdateNetwork sets the "network" field to the value that was provided on create.
+func (u *DepositSplitUpsertBulk) UpdateNetwork() *DepositSplitUpsertBulk {
+	return u.Update(func(s *DepositSplitUpsert) {
+		s.UpdateNetwork()
+	})
+}
+
+// SetReceiveAddress sets the "receive_address" field.
+func (u *DepositSplitUpsertBulk) SetReceiveAddress(v string) *DepositSplitUpsertBulk {
+	return u.Update(func(s *DepositSplitUpsert) {
+		s.SetReceiveAddress(v)
+	})
+}
+
+// UpdateReceiveAddress sets the "receive_address" field to the value that was provided on create.
+func (u *DepositSplitUpsertBulk) UpdateReceiveAddress() *DepositSplitUpsertBulk {
+	return u.Update(func(s *DepositSplitUpsert) {
+		s.UpdateReceiveAddress()
+	})
+}
+
+// SetFromAddress sets the "from_address" field.
+func (u *DepositSplitUpsertBulk) SetFromAddress(v string) *DepositSplitUpsertBulk {
+	return u.Update(func(s *DepositSplitUpsert) {
+		s.SetFromAddress(v)
+	})
+}
+
+// UpdateFromAddress sets the "from_address" field to the value that was provided on create.
+func (u *DepositSplitUpsertBulk) UpdateFromAddress() *DepositSplitUpsertBulk {
+	return u.Update(func(s *DepositSplitUpsert) {
+		s.UpdateFromAddress()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *DepositSplitUpsertBulk) SetAmount(v decimal.Decimal) *DepositSplitUpsertBulk {
+	return u.Update(func(s *DepositSplitUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *DepositSplitUpsertBulk) AddAmount(v decimal.Decimal) *DepositSplitUpsertBulk {
+	return u.Update(func(s *DepositSplitUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *DepositSplitUpsertBulk) UpdateAmount() *DepositSplitUpsertBulk {
+	return u.Update(func(s *DepositSplitUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetBlockNumber sets the "block_number" field.
+func (u *DepositSplitUpsertBulk) SetBlockNumber(v int64) *DepositSplitUpsertBulk {
+	return u.Update(func(s *DepositSplitUpsert) {
+		s.SetBlockNumber(v)
+	})
+}
+
+// AddBlockNumber adds v to the "block_number" field.
+func (u *DepositSplitUpsertBulk) AddBlockNumber(v int64) *DepositSplitUpsertBulk {
+	return u.Update(func(s *DepositSplitUpsert) {
+		s.AddBlockNumber(v)
+	})
+}
+
+// UpdateBlockNumber sets the "block_number" field to the value that was provided on create.
+func (u *DepositSplitUpsertBulk) UpdateBlockNumber() *DepositSplitUpsertBulk {
+	return u.Update(func(s *DepositSplitUpsert) {
+		s.UpdateBlockNumber()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *DepositSplitUpsertBulk) SetStatus(v depositsplit.Status) *DepositSplitUpsertBulk {
+	return u.Update(func(s *DepositSplitUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *DepositSplitUpsertBulk) UpdateStatus() *DepositSplitUpsertBulk {
+	return u.Update(func(s *DepositSplitUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (u *DepositSplitUpsertBulk) SetResolvedAt(v time.Time) *DepositSplitUpsertBulk {
+	return u.Update(func(s *DepositSplitUpsert) {
+		s.SetResolvedAt(v)
+	})
+}
+
+// UpdateResolvedAt sets the "resolved_at" field to the value that was provided on create.
+func (u *DepositSplitUpsertBulk) UpdateResolvedAt() *DepositSplitUpsertBulk {
+	return u.Update(func(s *DepositSplitUpsert) {
+		s.UpdateResolvedAt()
+	})
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (u *DepositSplitUpsertBulk) ClearResolvedAt() *DepositSplitUpsertBulk {
+	return u.Update(func(s *DepositSplitUpsert) {
+		s.ClearResolvedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DepositSplitUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DepositSplitCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DepositSplitCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DepositSplitUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/depositsplit"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+)
+
+// DepositSplitDelete is the builder for deleting a DepositSplit entity.
+type DepositSplitDelete struct {
+	config
+	hooks    []Hook
+	mutation *DepositSplitMutation
+}
+
+// Where appends a list predicates to the DepositSplitDelete builder.
+func (dsd *DepositSplitDelete) Where(ps ...predicate.DepositSplit) *DepositSplitDelete {
+	dsd.mutation.Where(ps...)
+	return dsd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (dsd *DepositSplitDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, dsd.sqlExec, dsd.mutation, dsd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dsd *DepositSplitDelete) ExecX(ctx context.Context) int {
+	n, err := dsd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (dsd *DepositSplitDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(depositsplit.Table, sqlgraph.NewFieldSpec(depositsplit.FieldID, field.TypeUUID))
+	if ps := dsd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, dsd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	dsd.mutation.done = true
+	return affected, err
+}
+
+// DepositSplitDeleteOne is the builder for deleting a single DepositSplit entity.
+type DepositSplitDeleteOne struct {
+	dsd *DepositSplitDelete
+}
+
+// Where appends a list predicates to the DepositSplitDelete builder.
+func (dsdo *DepositSplitDeleteOne) Where(ps ...predicate.DepositSplit) *DepositSplitDeleteOne {
+	dsdo.dsd.mutation.Where(ps...)
+	return dsdo
+}
+
+// Exec executes the deletion query.
+func (dsdo *DepositSplitDeleteOne) Exec(ctx context.Context) error {
+	n, err := dsdo.dsd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{depositsplit.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dsdo *DepositSplitDeleteOne) ExecX(ctx context.Context) {
+	if err := dsdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,608 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/depositsplit"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// DepositSplitQuery is the builder for querying DepositSplit entities.
+type DepositSplitQuery struct {
+	config
+	ctx               *QueryContext
+	order             []depositsplit.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.DepositSplit
+	withPaymentOrders *PaymentOrderQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the DepositSplitQuery builder.
+func (dsq *DepositSplitQuery) Where(ps ...predicate.DepositSplit) *DepositSplitQuery {
+	dsq.predicates = append(dsq.predicates, ps...)
+	return dsq
+}
+
+// Limit the number of records to be returned by this query.
+func (dsq *DepositSplitQuery) Limit(limit int) *DepositSplitQuery {
+	dsq.ctx.Limit = &limit
+	return dsq
+}
+
+// Offset to start from.
+func (dsq *DepositSplitQuery) Offset(offset int) *DepositSplitQuery {
+	dsq.ctx.Offset = &offset
+	return dsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (dsq *DepositSplitQuery) Unique(unique bool) *DepositSplitQuery {
+	dsq.ctx.Unique = &unique
+	return dsq
+}
+
+// Order specifies how the records should be ordered.
+func (dsq *DepositSplitQuery) Order(o ...depositsplit.OrderOption) *DepositSplitQuery {
+	dsq.order = append(dsq.order, o...)
+	return dsq
+}
+
+// QueryPaymentOrders chains the current query on the "payment_orders" edge.
+func (dsq *DepositSplitQuery) QueryPaymentOrders() *PaymentOrderQuery {
+	query := (&PaymentOrderClient{config: dsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := dsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := dsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(depositsplit.Table, depositsplit.FieldID, selector),
+			sqlgraph.To(paymentorder.Table, paymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, depositsplit.PaymentOrdersTable, depositsplit.PaymentOrdersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(dsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first DepositSplit entity from the query.
+// Returns a *NotFoundError when no DepositSplit was found.
+func (dsq *DepositSplitQuery) First(ctx context.Context) (*DepositSplit, error) {
+	nodes, err := dsq.Limit(1).All(setContextOp(ctx, dsq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{depositsplit.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (dsq *DepositSplitQuery) FirstX(ctx context.Context) *DepositSplit {
+	node, err := dsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first DepositSplit ID from the query.
+// Returns a *NotFoundError when no DepositSplit ID was found.
+func (dsq *DepositSplitQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = dsq.Limit(1).IDs(setContextOp(ctx, dsq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{depositsplit.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (dsq *DepositSplitQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := dsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single DepositSplit entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one DepositSplit entity is found.
+// Returns a *NotFoundError when no DepositSplit entities are found.
+func (dsq *DepositSplitQuery) Only(ctx context.Context) (*DepositSplit, error) {
+	nodes, err := dsq.Limit(2).All(setContextOp(ctx, dsq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{depositsplit.Label}
+	default:
+		return nil, &NotSingularError{depositsplit.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (dsq *DepositSplitQuery) OnlyX(ctx context.Context) *DepositSplit {
+	node, err := dsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only DepositSplit ID in the query.
+// Returns a *NotSingularError when more than one DepositSplit ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (dsq *DepositSplitQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = dsq.Limit(2).IDs(setContextOp(ctx, dsq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{depositsplit.Label}
+	default:
+		err = &NotSingularError{depositsplit.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (dsq *DepositSplitQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := dsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of DepositSplits.
+func (dsq *DepositSplitQuery) All(ctx context.Context) ([]*DepositSplit, error) {
+	ctx = setContextOp(ctx, dsq.ctx, ent.OpQueryAll)
+	if err := dsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*DepositSplit, *DepositSplitQuery]()
+	return withInterceptors[[]*DepositSplit](ctx, dsq, qr, dsq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (dsq *DepositSplitQuery) AllX(ctx context.Context) []*DepositSplit {
+	nodes, err := dsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of DepositSplit IDs.
+func (dsq *DepositSplitQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if dsq.ctx.Unique == nil && dsq.path != nil {
+		dsq.Unique(true)
+	}
+	ctx = setContextOp(ctx, dsq.ctx, ent.OpQueryIDs)
+	if err = dsq.Select(depositsplit.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (dsq *DepositSplitQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := dsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (dsq *DepositSplitQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, dsq.ctx, ent.OpQueryCount)
+	if err := dsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, dsq, querierCount[*DepositSplitQuery](), dsq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (dsq *DepositSplitQuery) CountX(ctx context.Context) int {
+	count, err := dsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (dsq *DepositSplitQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, dsq.ctx, ent.OpQueryExist)
+	switch _, err := dsq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (dsq *DepositSplitQuery) ExistX(ctx context.Context) bool {
+	exist, err := dsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the DepositSplitQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (dsq *DepositSplitQuery) Clone() *DepositSplitQuery {
+	if dsq == nil {
+		return nil
+	}
+	return &DepositSplitQuery{
+		config:            dsq.config,
+		ctx:               dsq.ctx.Clone(),
+		order:             append([]depositsplit.OrderOption{}, dsq.order...),
+		inters:            append([]Interceptor{}, dsq.inters...),
+		predicates:        append([]predicate.DepositSplit{}, dsq.predicates...),
+		withPaymentOrders: dsq.withPaymentOrders.Clone(),
+		// clone intermediate query.
+		sql:  dsq.sql.Clone(),
+		path: dsq.path,
+	}
+}
+
+// WithPaymentOrders tells the query-builder to eager-load the nodes that are connected to
+// the "payment_orders" edge. The optional arguments are used to configure the query builder of the edge.
+func (dsq *DepositSplitQuery) WithPaymentOrders(opts ...func(*PaymentOrderQuery)) *DepositSplitQuery {
+	query := (&PaymentOrderClient{config: dsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	dsq.withPaymentOrders = query
+	return dsq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.DepositSplit.Query().
+//		GroupBy(depositsplit.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (dsq *DepositSplitQuery) GroupBy(field string, fields ...string) *DepositSplitGroupBy {
+	dsq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &DepositSplitGroupBy{build: dsq}
+	grbuild.flds = &dsq.ctx.Fields
+	grbuild.label = depositsplit.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.DepositSplit.Query().
+//		Select(depositsplit.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (dsq *DepositSplitQuery) Select(fields ...string) *DepositSplitSelect {
+	dsq.ctx.Fields = append(dsq.ctx.Fields, fields...)
+	sbuild := &DepositSplitSelect{DepositSplitQuery: dsq}
+	sbuild.label = depositsplit.Label
+	sbuild.flds, sbuild.scan = &dsq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a DepositSplitSelect configured with the given aggregations.
+func (dsq *DepositSplitQuery) Aggregate(fns ...AggregateFunc) *DepositSplitSelect {
+	return dsq.Select().Aggregate(fns...)
+}
+
+func (dsq *DepositSplitQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range dsq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, dsq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range dsq.ctx.Fields {
+		if !depositsplit.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if dsq.path != nil {
+		prev, err := dsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		dsq.sql = prev
+	}
+	return nil
+}
+
+func (dsq *DepositSplitQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*DepositSplit, error) {
+	var (
+		nodes       = []*DepositSplit{}
+		_spec       = dsq.querySpec()
+		loadedTypes = [1]bool{
+			dsq.withPaymentOrders != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*DepositSplit).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &DepositSplit{config: dsq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, dsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := dsq.withPaymentOrders; query != nil {
+		if err := dsq.loadPaymentOrders(ctx, query, nodes,
+			func(n *DepositSplit) { n.Edges.PaymentOrders = []*PaymentOrder{} },
+			func(n *DepositSplit, e *PaymentOrder) { n.Edges.PaymentOrders = append(n.Edges.PaymentOrders, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (dsq *DepositSplitQuery) loadPaymentOrders(ctx context.Context, query *PaymentOrderQuery, nodes []*DepositSplit, init func(*DepositSplit), assign func(*DepositSplit, *PaymentOrder)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*DepositSplit)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.PaymentOrder(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(depositsplit.PaymentOrdersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.deposit_split_payment_orders
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "deposit_split_payment_orders" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "deposit_split_payment_orders" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (dsq *DepositSplitQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := dsq.querySpec()
+	_spec.Node.Columns = dsq.ctx.Fields
+	if len(dsq.ctx.Fields) > 0 {
+		_spec.Unique = dsq.ctx.Unique != nil && *dsq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, dsq.driver, _spec)
+}
+
+func (dsq *DepositSplitQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(depositsplit.Table, depositsplit.Columns, sqlgraph.NewFieldSpec(depositsplit.FieldID, field.TypeUUID))
+	_spec.From = dsq.sql
+	if unique := dsq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if dsq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := dsq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, depositsplit.FieldID)
+		for i := range fields {
+			if fields[i] != depositsplit.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := dsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := dsq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := dsq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := dsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (dsq *DepositSplitQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(dsq.driver.Dialect())
+	t1 := builder.Table(depositsplit.Table)
+	columns := dsq.ctx.Fields
+	if len(columns) == 0 {
+		columns = depositsplit.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if dsq.sql != nil {
+		selector = dsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if dsq.ctx.Unique != nil && *dsq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range dsq.predicates {
+		p(selector)
+	}
+	for _, p := range dsq.order {
+		p(selector)
+	}
+	if offset := dsq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := dsq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// DepositSplitGroupBy is the group-by builder for DepositSplit entities.
+type DepositSplitGroupBy struct {
+	selector
+	build *DepositSplitQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (dsgb *DepositSplitGroupBy) Aggregate(fns ...AggregateFunc) *DepositSplitGroupBy {
+	dsgb.fns = append(dsgb.fns, fns...)
+	return dsgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (dsgb *DepositSplitGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, dsgb.build.ctx, ent.OpQueryGroupBy)
+	if err := dsgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DepositSplitQuery, *DepositSplitGroupBy](ctx, dsgb.build, dsgb, dsgb.build.inters, v)
+}
+
+func (dsgb *DepositSplitGroupBy) sqlScan(ctx context.Context, root *DepositSplitQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(dsgb.fns))
+	for _, fn := range dsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*dsgb.flds)+len(dsgb.fns))
+		for _, f := range *dsgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*dsgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dsgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// DepositSplitSelect is the builder for selecting fields of DepositSplit entities.
+type DepositSplitSelect struct {
+	*DepositSplitQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (dss *DepositSplitSelect) Aggregate(fns ...AggregateFunc) *DepositSplitSelect {
+	dss.fns = append(dss.fns, fns...)
+	return dss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (dss *DepositSplitSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, dss.ctx, ent.OpQuerySelect)
+	if err := dss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DepositSplitQuery, *DepositSplitSelect](ctx, dss.DepositSplitQuery, dss, dss.inters, v)
+}
+
+func (dss *DepositSplitSelect) sqlScan(ctx context.Context, root *DepositSplitQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(dss.fns))
+	for _, fn := range dss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*dss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,743 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/depositsplit"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// DepositSplitUpdate is the builder for updating DepositSplit entities.
+type DepositSplitUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DepositSplitMutation
+}
+
+// Where appends a list predicates to the DepositSplitUpdate builder.
+func (dsu *DepositSplitUpdate) Where(ps ...predicate.DepositSplit) *DepositSplitUpdate {
+	dsu.mutation.Where(ps...)
+	return dsu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dsu *DepositSplitUpdate) SetUpdatedAt(t time.Time) *DepositSplitUpdate {
+	dsu.mutation.SetUpdatedAt(t)
+	return dsu
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (dsu *DepositSplitUpdate) SetTxHash(s string) *DepositSplitUpdate {
+	dsu.mutation.SetTxHash(s)
+	return dsu
+}
+
+// SetNillableTxHash sets the "tx_hash" field if the given value is not nil.
+func (dsu *DepositSplitUpdate) SetNillableTxHash(s *string) *DepositSplitUpdate {
+	if s != nil {
+		dsu.SetTxHash(*s)
+	}
+	return dsu
+}
+
+// SetNetwork sets the "network" field.
+func (dsu *DepositSplitUpdate) SetNetwork(s string) *DepositSplitUpdate {
+	dsu.mutation.SetNetwork(s)
+	return dsu
+}
+
+// SetNillableNetwork sets the "network" field if the given value is not nil.
+func (dsu *DepositSplitUpdate) SetNillableNetwork(s *string) *DepositSplitUpdate {
+	if s != nil {
+		dsu.SetNetwork(*s)
+	}
+	return dsu
+}
+
+// SetReceiveAddress sets the "receive_address" field.
+func (dsu *DepositSplitUpdate) SetReceiveAddress(s string) *DepositSplitUpdate {
+	dsu.mutation.SetReceiveAddress(s)
+	return dsu
+}
+
+// SetNillableReceiveAddress sets the "receive_address" field if the given value is not nil.
+func (dsu *DepositSplitUpdate) SetNillableReceiveAddress(s *string) *DepositSplitUpdate {
+	if s != nil {
+		dsu.SetReceiveAddress(*s)
+	}
+	return dsu
+}
+
+// SetFromAddress sets the "from_address" field.
+func (dsu *DepositSplitUpdate) SetFromAddress(s string) *DepositSplitUpdate {
+	dsu.mutation.SetFromAddress(s)
+	return dsu
+}
+
+// SetNillableFromAddress sets the "from_address" field if the given value is not nil.
+func (dsu *DepositSplitUpdate) SetNillableFromAddress(s *string) *DepositSplitUpdate {
+	if s != nil {
+		dsu.SetFromAddress(*s)
+	}
+	return dsu
+}
+
+// SetAmount sets the "amount" field.
+func (dsu *DepositSplitUpdate) SetAmount(d decimal.Decimal) *DepositSplitUpdate {
+	dsu.mutation.ResetAmount()
+	dsu.mutation.SetAmount(d)
+	return dsu
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (dsu *DepositSplitUpdate) SetNillableAmount(d *decimal.Decimal) *DepositSplitUpdate {
+	if d != nil {
+		dsu.SetAmount(*d)
+	}
+	return dsu
+}
+
+// AddAmount adds d to the "amount" field.
+func (dsu *DepositSplitUpdate) AddAmount(d decimal.Decimal) *DepositSplitUpdate {
+	dsu.mutation.AddAmount(d)
+	return dsu
+}
+
+// SetBlockNumber sets the "block_number" field.
+func (dsu *DepositSplitUpdate) SetBlockNumber(i int64) *DepositSplitUpdate {
+	dsu.mutation.ResetBlockNumber()
+	dsu.mutation.SetBlockNumber(i)
+	return dsu
+}
+
+// SetNillableBlockNumber sets the "block_number" field if the given value is not nil.
+func (dsu *DepositSplitUpdate) SetNillableBlockNumber(i *int64) *DepositSplitUpdate {
+	if i != nil {
+		dsu.SetBlockNumber(*i)
+	}
+	return dsu
+}
+
+// AddBlockNumber adds i to the "block_number" field.
+func (dsu *DepositSplitUpdate) AddBlockNumber(i int64) *DepositSplitUpdate {
+	dsu.mutation.AddBlockNumber(i)
+	return dsu
+}
+
+// SetStatus sets the "status" field.
+func (dsu *DepositSplitUpdate) SetStatus(d depositsplit.Status) *DepositSplitUpdate {
+	dsu.mutation.SetStatus(d)
+	return dsu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (dsu *DepositSplitUpdate) SetNillableStatus(d *depositsplit.Status) *DepositSplitUpdate {
+	if d != nil {
+		dsu.SetStatus(*d)
+	}
+	return dsu
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (dsu *DepositSplitUpdate) SetResolvedAt(t time.Time) *DepositSplitUpdate {
+	dsu.mutation.SetResolvedAt(t)
+	return dsu
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (dsu *DepositSplitUpdate) SetNillableResolvedAt(t *time.Time) *DepositSplitUpdate {
+	if t != nil {
+		dsu.SetResolvedAt(*t)
+	}
+	return dsu
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (dsu *DepositSplitUpdate) ClearResolvedAt() *DepositSplitUpdate {
+	dsu.mutation.ClearResolvedAt()
+	return dsu
+}
+
+// AddPaymentOrderIDs adds the "payment_orders" edge to the PaymentOrder entity by IDs.
+func (dsu *DepositSplitUpdate) AddPaymentOrderIDs(ids ...uuid.UUID) *DepositSplitUpdate {
+	dsu.mutation.AddPaymentOrderIDs(ids...)
+	return dsu
+}
+
+// AddPaymentOrders adds the "payment_orders" edges to the PaymentOrder entity.
+func (dsu *DepositSplitUpdate) AddPaymentOrders(p ...*PaymentOrder) *DepositSplitUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return dsu.AddPaymentOrderIDs(ids...)
+}
+
+// Mutation returns the DepositSplitMutation object of the builder.
+func (dsu *DepositSplitUpdate) Mutation() *DepositSplitMutation {
+	return dsu.mutation
+}
+
+// ClearPaymentOrders clears all "payment_orders" edges to the PaymentOrder entity.
+func (dsu *DepositSplitUpdate) ClearPaymentOrders() *DepositSplitUpdate {
+	dsu.mutation.ClearPaymentOrders()
+	return dsu
+}
+
+// RemovePaymentOrderIDs removes the "payment_orders" edge to PaymentOrder entities by IDs.
+func (dsu *DepositSplitUpdate) RemovePaymentOrderIDs(ids ...uuid.UUID) *DepositSplitUpdate {
+	dsu.mutation.RemovePaymentOrderIDs(ids...)
+	return dsu
+}
+
+// RemovePaymentOrders removes "payment_orders" edges to PaymentOrder entities.
+func (dsu *DepositSplitUpdate) RemovePaymentOrders(p ...*PaymentOrder) *DepositSplitUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return dsu.RemovePaymentOrderIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (dsu *DepositSplitUpdate) Save(ctx context.Context) (int, error) {
+	dsu.defaults()
+	return withHooks(ctx, dsu.sqlSave, dsu.mutation, dsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dsu *DepositSplitUpdate) SaveX(ctx context.Context) int {
+	affected, err := dsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (dsu *DepositSplitUpdate) Exec(ctx context.Context) error {
+	_, err := dsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dsu *DepositSplitUpdate) ExecX(ctx context.Context) {
+	if err := dsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dsu *DepositSplitUpdate) defaults() {
+	if _, ok := dsu.mutation.UpdatedAt(); !ok {
+		v := depositsplit.UpdateDefaultUpdatedAt()
+		dsu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dsu *DepositSplitUpdate) check() error {
+	if v, ok := dsu.mutation.TxHash(); ok {
+		if err := depositsplit.TxHashValidator(v); err != nil {
+			return &ValidationError{Name: "tx_hash", err: fmt.Errorf(`ent: validator failed for field "DepositSplit.tx_hash": %w`, err)}
+		}
+	}
+	if v, ok := dsu.mutation.Status(); ok {
+		if err := depositsplit.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DepositSplit.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (dsu *DepositSplitUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := dsu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(depositsplit.Table, depositsplit.Columns, sqlgraph.NewFieldSpec(depositsplit.FieldID, field.TypeUUID))
+	if ps := dsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dsu.mutation.UpdatedAt(); ok {
+		_spec.SetField(depositsplit.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := dsu.mutation.TxHash(); ok {
+		_spec.SetField(depositsplit.FieldTxHash, field.TypeString, value)
+	}
+	if value, ok := dsu.mutation.Network(); ok {
+		_spec.SetField(depositsplit.FieldNetwork, field.TypeString, value)
+	}
+	if value, ok := dsu.mutation.ReceiveAddress(); ok {
+		_spec.SetField(depositsplit.FieldReceiveAddress, field.TypeString, value)
+	}
+	if value, ok := dsu.mutation.FromAddress(); ok {
+		_spec.SetField(depositsplit.FieldFromAddress, field.TypeString, value)
+	}
+	if value, ok := dsu.mutation.Amount(); ok {
+		_spec.SetField(depositsplit.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := dsu.mutation.AddedAmount(); ok {
+		_spec.AddField(depositsplit.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := dsu.mutation.BlockNumber(); ok {
+		_spec.SetField(depositsplit.FieldBlockNumber, field.TypeInt64, value)
+	}
+	if value, ok := dsu.mutation.AddedBlockNumber(); ok {
+		_spec.AddField(depositsplit.FieldBlockNumber, field.TypeInt64, value)
+	}
+	if value, ok := dsu.mutation.Status(); ok {
+		_spec.SetField(depositsplit.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := dsu.mutation.ResolvedAt(); ok {
+		_spec.SetField(depositsplit.FieldResolvedAt, field.TypeTime, value)
+	}
+	if dsu.mutation.ResolvedAtCleared() {
+		_spec.ClearField(depositsplit.FieldResolvedAt, field.TypeTime)
+	}
+	if dsu.mutation.PaymentOrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   depositsplit.PaymentOrdersTable,
+			Columns: []string{depositsplit.PaymentOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := dsu.mutation.RemovedPaymentOrdersIDs(); len(nodes) > 0 && !dsu.mutation.PaymentOrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   depositsplit.PaymentOrdersTable,
+			Columns: []string{depositsplit.PaymentOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := dsu.mutation.PaymentOrdersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   depositsplit.PaymentOrdersTable,
+			Columns: []string{depositsplit.PaymentOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, dsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{depositsplit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	dsu.mutation.done = true
+	return n, nil
+}
+
+// DepositSplitUpdateOne is the builder for updating a single DepositSplit entity.
+type DepositSplitUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DepositSplitMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dsuo *DepositSplitUpdateOne) SetUpdatedAt(t time.Time) *DepositSplitUpdateOne {
+	dsuo.mutation.SetUpdatedAt(t)
+	return dsuo
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (dsuo *DepositSplitUpdateOne) SetTxHash(s string) *DepositSplitUpdateOne {
+	dsuo.mutation.SetTxHash(s)
+	return dsuo
+}
+
+// SetNillableTxHash sets the "tx_hash" field if the given value is not nil.
+func (dsuo *DepositSplitUpdateOne) SetNillableTxHash(s *string) *DepositSplitUpdateOne {
+	if s != nil {
+		dsuo.SetTxHash(*s)
+	}
+	return dsuo
+}
+
+// SetNetwork sets the "network" field.
+func (dsuo *DepositSplitUpdateOne) SetNetwork(s string) *DepositSplitUpdateOne {
+	dsuo.mutation.SetNetwork(s)
+	return dsuo
+}
+
+// SetNillableNetwork sets the "network" field if the given value is not nil.
+func (dsuo *DepositSplitUpdateOne) SetNillableNetwork(s *string) *DepositSplitUpdateOne {
+	if s != nil {
+		dsuo.SetNetwork(*s)
+	}
+	return dsuo
+}
+
+// SetReceiveAddress sets the "receive_address" field.
+func (dsuo *DepositSplitUpdateOne) SetReceiveAddress(s string) *DepositSplitUpdateOne {
+	dsuo.mutation.SetReceiveAddress(s)
+	return dsuo
+}
+
+// SetNillableReceiveAddress sets the "receive_address" field if the given value is not nil.
+func (dsuo *DepositSplitUpdateOne) SetNillableReceiveAddress(s *string) *DepositSplitUpdateOne {
+	if s != nil {
+		dsuo.SetReceiveAddress(*s)
+	}
+	return dsuo
+}
+
+// SetFromAddress sets the "from_address" field.
+func (dsuo *DepositSplitUpdateOne) SetFromAddress(s string) *DepositSplitUpdateOne {
+	dsuo.mutation.SetFromAddress(s)
+	return dsuo
+}
+
+// SetNillableFromAddress sets the "from_address" field if the given value is not nil.
+func (dsuo *DepositSplitUpdateOne) SetNillableFromAddress(s *string) *DepositSplitUpdateOne {
+	if s != nil {
+		dsuo.SetFromAddress(*s)
+	}
+	return dsuo
+}
+
+// SetAmount sets the "amount" field.
+func (dsuo *DepositSplitUpdateOne) SetAmount(d decimal.Decimal) *DepositSplitUpdateOne {
+	dsuo.mutation.ResetAmount()
+	dsuo.mutation.SetAmount(d)
+	return dsuo
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (dsuo *DepositSplitUpdateOne) SetNillableAmount(d *decimal.Decimal) *DepositSplitUpdateOne {
+	if d != nil {
+		dsuo.SetAmount(*d)
+	}
+	return dsuo
+}
+
+// AddAmount adds d to the "amount" field.
+func (dsuo *DepositSplitUpdateOne) AddAmount(d decimal.Decimal) *DepositSplitUpdateOne {
+	dsuo.mutation.AddAmount(d)
+	return dsuo
+}
+
+// SetBlockNumber sets the "block_number" field.
+func (dsuo *DepositSplitUpdateOne) SetBlockNumber(i int64) *DepositSplitUpdateOne {
+	dsuo.mutation.ResetBlockNumber()
+	dsuo.mutation.SetBlockNumber(i)
+	return dsuo
+}
+
+// SetNillableBlockNumber sets the "block_number" field if the given value is not nil.
+func (dsuo *DepositSplitUpdateOne) SetNillableBlockNumber(i *int64) *DepositSplitUpdateOne {
+	if i != nil {
+		dsuo.SetBlockNumber(*i)
+	}
+	return dsuo
+}
+
+// AddBlockNumber adds i to the "block_number" field.
+func (dsuo *DepositSplitUpdateOne) AddBlockNumber(i int64) *DepositSplitUpdateOne {
+	dsuo.mutation.AddBlockNumber(i)
+	return dsuo
+}
+
+// SetStatus sets the "status" field.
+func (dsuo *DepositSplitUpdateOne) SetStatus(d depositsplit.Status) *DepositSplitUpdateOne {
+	dsuo.mutation.SetStatus(d)
+	return dsuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (dsuo *DepositSplitUpdateOne) SetNillableStatus(d *depositsplit.Status) *DepositSplitUpdateOne {
+	if d != nil {
+		dsuo.SetStatus(*d)
+	}
+	return dsuo
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (dsuo *DepositSplitUpdateOne) SetResolvedAt(t time.Time) *DepositSplitUpdateOne {
+	dsuo.mutation.SetResolvedAt(t)
+	return dsuo
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (dsuo *DepositSplitUpdateOne) SetNillableResolvedAt(t *time.Time) *DepositSplitUpdateOne {
+	if t != nil {
+		dsuo.SetResolvedAt(*t)
+	}
+	return dsuo
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (dsuo *DepositSplitUpdateOne) ClearResolvedAt() *DepositSplitUpdateOne {
+	dsuo.mutation.ClearResolvedAt()
+	return dsuo
+}
+
+// AddPaymentOrderIDs adds the "payment_orders" edge to the PaymentOrder entity by IDs.
+func (dsuo *DepositSplitUpdateOne) AddPaymentOrderIDs(ids ...uuid.UUID) *DepositSplitUpdateOne {
+	dsuo.mutation.AddPaymentOrderIDs(ids...)
+	return dsuo
+}
+
+// AddPaymentOrders adds the "payment_orders" edges to the PaymentOrder entity.
+func (dsuo *DepositSplitUpdateOne) AddPaymentOrders(p ...*PaymentOrder) *DepositSplitUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return dsuo.AddPaymentOrderIDs(ids...)
+}
+
+// Mutation returns the DepositSplitMutation object of the builder.
+func (dsuo *DepositSplitUpdateOne) Mutation() *DepositSplitMutation {
+	return dsuo.mutation
+}
+
+// ClearPaymentOrders clears all "payment_orders" edges to the PaymentOrder entity.
+func (dsuo *DepositSplitUpdateOne) ClearPaymentOrders() *DepositSplitUpdateOne {
+	dsuo.mutation.ClearPaymentOrders()
+	return dsuo
+}
+
+// RemovePaymentOrderIDs removes the "payment_orders" edge to PaymentOrder entities by IDs.
+func (dsuo *DepositSplitUpdateOne) RemovePaymentOrderIDs(ids ...uuid.UUID) *DepositSplitUpdateOne {
+	dsuo.mutation.RemovePaymentOrderIDs(ids...)
+	return dsuo
+}
+
+// RemovePaymentOrders removes "payment_orders" edges to PaymentOrder entities.
+func (dsuo *DepositSplitUpdateOne) RemovePaymentOrders(p ...*PaymentOrder) *DepositSplitUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return dsuo.RemovePaymentOrderIDs(ids...)
+}
+
+// Where appends a list predicates to the DepositSplitUpdate builder.
+func (dsuo *DepositSplitUpdateOne) Where(ps ...predicate.DepositSplit) *DepositSplitUpdateOne {
+	dsuo.mutation.Where(ps...)
+	return dsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (dsuo *DepositSplitUpdateOne) Select(field string, fields ...string) *DepositSplitUpdateOne {
+	dsuo.fields = append([]string{field}, fields...)
+	return dsuo
+}
+
+// Save executes the query and returns the updated DepositSplit entity.
+func (dsuo *DepositSplitUpdateOne) Save(ctx context.Context) (*DepositSplit, error) {
+	dsuo.defaults()
+	return withHooks(ctx, dsuo.sqlSave, dsuo.mutation, dsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dsuo *DepositSplitUpdateOne) SaveX(ctx context.Context) *DepositSplit {
+	node, err := dsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (dsuo *DepositSplitUpdateOne) Exec(ctx context.Context) error {
+	_, err := dsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dsuo *DepositSplitUpdateOne) ExecX(ctx context.Context) {
+	if err := dsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dsuo *DepositSplitUpdateOne) defaults() {
+	if _, ok := dsuo.mutation.UpdatedAt(); !ok {
+		v := depositsplit.UpdateDefaultUpdatedAt()
+		dsuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dsuo *DepositSplitUpdateOne) check() error {
+	if v, ok := dsuo.mutation.TxHash(); ok {
+		if err := depositsplit.TxHashValidator(v); err != nil {
+			return &ValidationError{Name: "tx_hash", err: fmt.Errorf(`ent: validator failed for field "DepositSplit.tx_hash": %w`, err)}
+		}
+	}
+	if v, ok := dsuo.mutation.Status(); ok {
+		if err := depositsplit.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DepositSplit.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (dsuo *DepositSplitUpdateOne) sqlSave(ctx context.Context) (_node *DepositSplit, err error) {
+	if err := dsuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(depositsplit.Table, depositsplit.Columns, sqlgraph.NewFieldSpec(depositsplit.FieldID, field.TypeUUID))
+	id, ok := dsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DepositSplit.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := dsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, depositsplit.FieldID)
+		for _, f := range fields {
+			if !depositsplit.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != depositsplit.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := dsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dsuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(depositsplit.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := dsuo.mutation.TxHash(); ok {
+		_spec.SetField(depositsplit.FieldTxHash, field.TypeString, value)
+	}
+	if value, ok := dsuo.mutation.Network(); ok {
+		_spec.SetField(depositsplit.FieldNetwork, field.TypeString, value)
+	}
+	if value, ok := dsuo.mutation.ReceiveAddress(); ok {
+		_spec.SetField(depositsplit.FieldReceiveAddress, field.TypeString, value)
+	}
+	if value, ok := dsuo.mutation.FromAddress(); ok {
+		_spec.SetField(depositsplit.FieldFromAddress, field.TypeString, value)
+	}
+	if value, ok := dsuo.mutation.Amount(); ok {
+		_spec.SetField(depositsplit.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := dsuo.mutation.AddedAmount(); ok {
+		_spec.AddField(depositsplit.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := dsuo.mutation.BlockNumber(); ok {
+		_spec.SetField(depositsplit.FieldBlockNumber, field.TypeInt64, value)
+	}
+	if value, ok := dsuo.mutation.AddedBlockNumber(); ok {
+		_spec.AddField(depositsplit.FieldBlockNumber, field.TypeInt64, value)
+	}
+	if value, ok := dsuo.mutation.Status(); ok {
+		_spec.SetField(depositsplit.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := dsuo.mutation.ResolvedAt(); ok {
+		_spec.SetField(depositsplit.FieldResolvedAt, field.TypeTime, value)
+	}
+	if dsuo.mutation.ResolvedAtCleared() {
+		_spec.ClearField(depositsplit.FieldResolvedAt, field.TypeTime)
+	}
+	if dsuo.mutation.PaymentOrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   depositsplit.PaymentOrdersTable,
+			Columns: []string{depositsplit.PaymentOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := dsuo.mutation.RemovedPaymentOrdersIDs(); len(nodes) > 0 && !dsuo.mutation.PaymentOrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   depositsplit.PaymentOrdersTable,
+			Columns: []string{depositsplit.PaymentOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := dsuo.mutation.PaymentOrdersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   depositsplit.PaymentOrdersTable,
+			Columns: []string{depositsplit.PaymentOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &DepositSplit{config: dsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, dsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{depositsplit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	dsuo.mutation.done = true
+	return _node, nil
+}
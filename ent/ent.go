@@ -12,31 +12,52 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/NEDA-LABS/stablenode/ent/adminauditlog"
 	"github.com/NEDA-LABS/stablenode/ent/apikey"
 	"github.com/NEDA-LABS/stablenode/ent/beneficialowner"
+	"github.com/NEDA-LABS/stablenode/ent/blockchainroute"
+	"github.com/NEDA-LABS/stablenode/ent/deadletter"
+	"github.com/NEDA-LABS/stablenode/ent/denylistedaddress"
+	"github.com/NEDA-LABS/stablenode/ent/depositsplit"
+	"github.com/NEDA-LABS/stablenode/ent/feeschedule"
 	"github.com/NEDA-LABS/stablenode/ent/fiatcurrency"
 	"github.com/NEDA-LABS/stablenode/ent/identityverificationrequest"
 	"github.com/NEDA-LABS/stablenode/ent/institution"
 	"github.com/NEDA-LABS/stablenode/ent/kybprofile"
+	"github.com/NEDA-LABS/stablenode/ent/ledgeraccount"
+	"github.com/NEDA-LABS/stablenode/ent/ledgerentry"
+	"github.com/NEDA-LABS/stablenode/ent/ledgerposting"
 	"github.com/NEDA-LABS/stablenode/ent/linkedaddress"
 	"github.com/NEDA-LABS/stablenode/ent/lockorderfulfillment"
+	"github.com/NEDA-LABS/stablenode/ent/lockorderreassignment"
 	"github.com/NEDA-LABS/stablenode/ent/lockpaymentorder"
 	"github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/ent/outboxtransaction"
 	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
 	"github.com/NEDA-LABS/stablenode/ent/paymentorderrecipient"
 	"github.com/NEDA-LABS/stablenode/ent/paymentwebhook"
+	"github.com/NEDA-LABS/stablenode/ent/providerbalancesnapshot"
 	"github.com/NEDA-LABS/stablenode/ent/providercurrencies"
 	"github.com/NEDA-LABS/stablenode/ent/providerordertoken"
+	"github.com/NEDA-LABS/stablenode/ent/providerperformance"
 	"github.com/NEDA-LABS/stablenode/ent/providerprofile"
 	"github.com/NEDA-LABS/stablenode/ent/providerrating"
 	"github.com/NEDA-LABS/stablenode/ent/provisionbucket"
 	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddressassignment"
+	"github.com/NEDA-LABS/stablenode/ent/reconciliationdiscrepancy"
+	"github.com/NEDA-LABS/stablenode/ent/reconciliationreport"
+	"github.com/NEDA-LABS/stablenode/ent/rpcendpoint"
 	"github.com/NEDA-LABS/stablenode/ent/senderordertoken"
 	"github.com/NEDA-LABS/stablenode/ent/senderprofile"
+	"github.com/NEDA-LABS/stablenode/ent/sweep"
 	"github.com/NEDA-LABS/stablenode/ent/token"
 	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
+	"github.com/NEDA-LABS/stablenode/ent/unmatcheddeposit"
 	"github.com/NEDA-LABS/stablenode/ent/user"
 	"github.com/NEDA-LABS/stablenode/ent/verificationtoken"
+	"github.com/NEDA-LABS/stablenode/ent/webhookdelivery"
+	"github.com/NEDA-LABS/stablenode/ent/webhookdestination"
 	"github.com/NEDA-LABS/stablenode/ent/webhookretryattempt"
 )
 
@@ -99,30 +120,51 @@ func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
 			apikey.Table:                      apikey.ValidColumn,
+			adminauditlog.Table:               adminauditlog.ValidColumn,
 			beneficialowner.Table:             beneficialowner.ValidColumn,
+			blockchainroute.Table:             blockchainroute.ValidColumn,
+			deadletter.Table:                  deadletter.ValidColumn,
+			denylistedaddress.Table:           denylistedaddress.ValidColumn,
+			depositsplit.Table:                depositsplit.ValidColumn,
+			feeschedule.Table:                 feeschedule.ValidColumn,
 			fiatcurrency.Table:                fiatcurrency.ValidColumn,
 			identityverificationrequest.Table: identityverificationrequest.ValidColumn,
 			institution.Table:                 institution.ValidColumn,
 			kybprofile.Table:                  kybprofile.ValidColumn,
+			ledgeraccount.Table:               ledgeraccount.ValidColumn,
+			ledgerentry.Table:                 ledgerentry.ValidColumn,
+			ledgerposting.Table:               ledgerposting.ValidColumn,
 			linkedaddress.Table:               linkedaddress.ValidColumn,
 			lockorderfulfillment.Table:        lockorderfulfillment.ValidColumn,
+			lockorderreassignment.Table:       lockorderreassignment.ValidColumn,
 			lockpaymentorder.Table:            lockpaymentorder.ValidColumn,
 			network.Table:                     network.ValidColumn,
+			outboxtransaction.Table:           outboxtransaction.ValidColumn,
 			paymentorder.Table:                paymentorder.ValidColumn,
 			paymentorderrecipient.Table:       paymentorderrecipient.ValidColumn,
 			paymentwebhook.Table:              paymentwebhook.ValidColumn,
+			providerbalancesnapshot.Table:     providerbalancesnapshot.ValidColumn,
 			providercurrencies.Table:          providercurrencies.ValidColumn,
 			providerordertoken.Table:          providerordertoken.ValidColumn,
+			providerperformance.Table:         providerperformance.ValidColumn,
 			providerprofile.Table:             providerprofile.ValidColumn,
 			providerrating.Table:              providerrating.ValidColumn,
 			provisionbucket.Table:             provisionbucket.ValidColumn,
+			rpcendpoint.Table:                 rpcendpoint.ValidColumn,
 			receiveaddress.Table:              receiveaddress.ValidColumn,
+			receiveaddressassignment.Table:    receiveaddressassignment.ValidColumn,
+			reconciliationdiscrepancy.Table:   reconciliationdiscrepancy.ValidColumn,
+			reconciliationreport.Table:        reconciliationreport.ValidColumn,
 			senderordertoken.Table:            senderordertoken.ValidColumn,
 			senderprofile.Table:               senderprofile.ValidColumn,
+			sweep.Table:                       sweep.ValidColumn,
 			token.Table:                       token.ValidColumn,
 			transactionlog.Table:              transactionlog.ValidColumn,
+			unmatcheddeposit.Table:            unmatcheddeposit.ValidColumn,
 			user.Table:                        user.ValidColumn,
 			verificationtoken.Table:           verificationtoken.ValidColumn,
+			webhookdelivery.Table:             webhookdelivery.ValidColumn,
+			webhookdestination.Table:          webhookdestination.ValidColumn,
 			webhookretryattempt.Table:         webhookretryattempt.ValidColumn,
 		})
 	})
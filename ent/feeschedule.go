@@ -0,0 +1,267 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/NEDA-LABS/stablenode/ent/feeschedule"
+	"github.com/NEDA-LABS/stablenode/ent/senderprofile"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// FeeSchedule is the model entity for the FeeSchedule schema.
+type FeeSchedule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// FeeType holds the value of the "fee_type" field.
+	FeeType feeschedule.FeeType `json:"fee_type,omitempty"`
+	// Token holds the value of the "token" field.
+	Token string `json:"token,omitempty"`
+	// Network holds the value of the "network" field.
+	Network string `json:"network,omitempty"`
+	// MinAmount holds the value of the "min_amount" field.
+	MinAmount decimal.Decimal `json:"min_amount,omitempty"`
+	// MaxAmount holds the value of the "max_amount" field.
+	MaxAmount *decimal.Decimal `json:"max_amount,omitempty"`
+	// Percent holds the value of the "percent" field.
+	Percent decimal.Decimal `json:"percent,omitempty"`
+	// FlatAmount holds the value of the "flat_amount" field.
+	FlatAmount decimal.Decimal `json:"flat_amount,omitempty"`
+	// EffectiveFrom holds the value of the "effective_from" field.
+	EffectiveFrom time.Time `json:"effective_from,omitempty"`
+	// EffectiveUntil holds the value of the "effective_until" field.
+	EffectiveUntil *time.Time `json:"effective_until,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the FeeScheduleQuery when eager-loading is set.
+	Edges                        FeeScheduleEdges `json:"edges"`
+	sender_profile_fee_schedules *uuid.UUID
+	selectValues                 sql.SelectValues
+}
+
+// FeeScheduleEdges holds the relations/edges for other nodes in the graph.
+type FeeScheduleEdges struct {
+	// SenderProfile holds the value of the sender_profile edge.
+	SenderProfile *SenderProfile `json:"sender_profile,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// SenderProfileOrErr returns the SenderProfile value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FeeScheduleEdges) SenderProfileOrErr() (*SenderProfile, error) {
+	if e.SenderProfile != nil {
+		return e.SenderProfile, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: senderprofile.Label}
+	}
+	return nil, &NotLoadedError{edge: "sender_profile"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FeeSchedule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case feeschedule.FieldMaxAmount:
+			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
+		case feeschedule.FieldMinAmount, feeschedule.FieldPercent, feeschedule.FieldFlatAmount:
+			values[i] = new(decimal.Decimal)
+		case feeschedule.FieldFeeType, feeschedule.FieldToken, feeschedule.FieldNetwork:
+			values[i] = new(sql.NullString)
+		case feeschedule.FieldCreatedAt, feeschedule.FieldUpdatedAt, feeschedule.FieldEffectiveFrom, feeschedule.FieldEffectiveUntil:
+			values[i] = new(sql.NullTime)
+		case feeschedule.FieldID:
+			values[i] = new(uuid.UUID)
+		case feeschedule.ForeignKeys[0]: // sender_profile_fee_schedules
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FeeSchedule fields.
+func (fs *FeeSchedule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case feeschedule.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				fs.ID = *value
+			}
+		case feeschedule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				fs.CreatedAt = value.Time
+			}
+		case feeschedule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				fs.UpdatedAt = value.Time
+			}
+		case feeschedule.FieldFeeType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fee_type", values[i])
+			} else if value.Valid {
+				fs.FeeType = feeschedule.FeeType(value.String)
+			}
+		case feeschedule.FieldToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token", values[i])
+			} else if value.Valid {
+				fs.Token = value.String
+			}
+		case feeschedule.FieldNetwork:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field network", values[i])
+			} else if value.Valid {
+				fs.Network = value.String
+			}
+		case feeschedule.FieldMinAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field min_amount", values[i])
+			} else if value != nil {
+				fs.MinAmount = *value
+			}
+		case feeschedule.FieldMaxAmount:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field max_amount", values[i])
+			} else if value.Valid {
+				fs.MaxAmount = new(decimal.Decimal)
+				*fs.MaxAmount = *value.S.(*decimal.Decimal)
+			}
+		case feeschedule.FieldPercent:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field percent", values[i])
+			} else if value != nil {
+				fs.Percent = *value
+			}
+		case feeschedule.FieldFlatAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field flat_amount", values[i])
+			} else if value != nil {
+				fs.FlatAmount = *value
+			}
+		case feeschedule.FieldEffectiveFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field effective_from", values[i])
+			} else if value.Valid {
+				fs.EffectiveFrom = value.Time
+			}
+		case feeschedule.FieldEffectiveUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field effective_until", values[i])
+			} else if value.Valid {
+				fs.EffectiveUntil = new(time.Time)
+				*fs.EffectiveUntil = value.Time
+			}
+		case feeschedule.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field sender_profile_fee_schedules", values[i])
+			} else if value.Valid {
+				fs.sender_profile_fee_schedules = new(uuid.UUID)
+				*fs.sender_profile_fee_schedules = *value.S.(*uuid.UUID)
+			}
+		default:
+			fs.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the FeeSchedule.
+// This includes values selected through modifiers, order, etc.
+func (fs *FeeSchedule) Value(name string) (ent.Value, error) {
+	return fs.selectValues.Get(name)
+}
+
+// QuerySenderProfile queries the "sender_profile" edge of the FeeSchedule entity.
+func (fs *FeeSchedule) QuerySenderProfile() *SenderProfileQuery {
+	return NewFeeScheduleClient(fs.config).QuerySenderProfile(fs)
+}
+
+// Update returns a builder for updating this FeeSchedule.
+// Note that you need to call FeeSchedule.Unwrap() before calling this method if this FeeSchedule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (fs *FeeSchedule) Update() *FeeScheduleUpdateOne {
+	return NewFeeScheduleClient(fs.config).UpdateOne(fs)
+}
+
+// Unwrap unwraps the FeeSchedule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (fs *FeeSchedule) Unwrap() *FeeSchedule {
+	_tx, ok := fs.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FeeSchedule is not a transactional entity")
+	}
+	fs.config.driver = _tx.drv
+	return fs
+}
+
+// String implements the fmt.Stringer.
+func (fs *FeeSchedule) String() string {
+	var builder strings.Builder
+	builder.WriteString("FeeSchedule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", fs.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(fs.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fs.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("fee_type=")
+	builder.WriteString(fmt.Sprintf("%v", fs.FeeType))
+	builder.WriteString(", ")
+	builder.WriteString("token=")
+	builder.WriteString(fs.Token)
+	builder.WriteString(", ")
+	builder.WriteString("network=")
+	builder.WriteString(fs.Network)
+	builder.WriteString(", ")
+	builder.WriteString("min_amount=")
+	builder.WriteString(fmt.Sprintf("%v", fs.MinAmount))
+	builder.WriteString(", ")
+	if v := fs.MaxAmount; v != nil {
+		builder.WriteString("max_amount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("percent=")
+	builder.WriteString(fmt.Sprintf("%v", fs.Percent))
+	builder.WriteString(", ")
+	builder.WriteString("flat_amount=")
+	builder.WriteString(fmt.Sprintf("%v", fs.FlatAmount))
+	builder.WriteString(", ")
+	builder.WriteString("effective_from=")
+	builder.WriteString(fs.EffectiveFrom.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := fs.EffectiveUntil; v != nil {
+		builder.WriteString("effective_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// FeeSchedules is a parsable slice of FeeSchedule.
+type FeeSchedules []*FeeSchedule
@@ -0,0 +1,218 @@
+// Code generated by ent, DO NOT EDIT.
+
+package feeschedule
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// Label holds the string label denoting the feeschedule type in the database.
+	Label = "fee_schedule"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldFeeType holds the string denoting the fee_type field in the database.
+	FieldFeeType = "fee_type"
+	// FieldToken holds the string denoting the token field in the database.
+	FieldToken = "token"
+	// FieldNetwork holds the string denoting the network field in the database.
+	FieldNetwork = "network"
+	// FieldMinAmount holds the string denoting the min_amount field in the database.
+	FieldMinAmount = "min_amount"
+	// FieldMaxAmount holds the string denoting the max_amount field in the database.
+	FieldMaxAmount = "max_amount"
+	// FieldPercent holds the string denoting the percent field in the database.
+	FieldPercent = "percent"
+	// FieldFlatAmount holds the string denoting the flat_amount field in the database.
+	FieldFlatAmount = "flat_amount"
+	// FieldEffectiveFrom holds the string denoting the effective_from field in the database.
+	FieldEffectiveFrom = "effective_from"
+	// FieldEffectiveUntil holds the string denoting the effective_until field in the database.
+	FieldEffectiveUntil = "effective_until"
+	// EdgeSenderProfile holds the string denoting the sender_profile edge name in mutations.
+	EdgeSenderProfile = "sender_profile"
+	// Table holds the table name of the feeschedule in the database.
+	Table = "fee_schedules"
+	// SenderProfileTable is the table that holds the sender_profile relation/edge.
+	SenderProfileTable = "fee_schedules"
+	// SenderProfileInverseTable is the table name for the SenderProfile entity.
+	// It exists in this package in order to avoid circular dependency with the "senderprofile" package.
+	SenderProfileInverseTable = "sender_profiles"
+	// SenderProfileColumn is the table column denoting the sender_profile relation/edge.
+	SenderProfileColumn = "sender_profile_fee_schedules"
+)
+
+// Columns holds all SQL columns for feeschedule fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldFeeType,
+	FieldToken,
+	FieldNetwork,
+	FieldMinAmount,
+	FieldMaxAmount,
+	FieldPercent,
+	FieldFlatAmount,
+	FieldEffectiveFrom,
+	FieldEffectiveUntil,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "fee_schedules"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"sender_profile_fee_schedules",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultToken holds the default value on creation for the "token" field.
+	DefaultToken string
+	// TokenValidator is a validator for the "token" field. It is called by the builders before save.
+	TokenValidator func(string) error
+	// DefaultNetwork holds the default value on creation for the "network" field.
+	DefaultNetwork string
+	// NetworkValidator is a validator for the "network" field. It is called by the builders before save.
+	NetworkValidator func(string) error
+	// DefaultMinAmount holds the default value on creation for the "min_amount" field.
+	DefaultMinAmount func() decimal.Decimal
+	// DefaultPercent holds the default value on creation for the "percent" field.
+	DefaultPercent func() decimal.Decimal
+	// DefaultFlatAmount holds the default value on creation for the "flat_amount" field.
+	DefaultFlatAmount func() decimal.Decimal
+	// DefaultEffectiveFrom holds the default value on creation for the "effective_from" field.
+	DefaultEffectiveFrom func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// FeeType defines the type for the "fee_type" enum field.
+type FeeType string
+
+// FeeType values.
+const (
+	FeeTypeSender   FeeType = "sender"
+	FeeTypeNetwork  FeeType = "network"
+	FeeTypeProtocol FeeType = "protocol"
+)
+
+func (ft FeeType) String() string {
+	return string(ft)
+}
+
+// FeeTypeValidator is a validator for the "fee_type" field enum values. It is called by the builders before save.
+func FeeTypeValidator(ft FeeType) error {
+	switch ft {
+	case FeeTypeSender, FeeTypeNetwork, FeeTypeProtocol:
+		return nil
+	default:
+		return fmt.Errorf("feeschedule: invalid enum value for fee_type field: %q", ft)
+	}
+}
+
+// OrderOption defines the ordering options for the FeeSchedule queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByFeeType orders the results by the fee_type field.
+func ByFeeType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFeeType, opts...).ToFunc()
+}
+
+// ByToken orders the results by the token field.
+func ByToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToken, opts...).ToFunc()
+}
+
+// ByNetwork orders the results by the network field.
+func ByNetwork(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNetwork, opts...).ToFunc()
+}
+
+// ByMinAmount orders the results by the min_amount field.
+func ByMinAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinAmount, opts...).ToFunc()
+}
+
+// ByMaxAmount orders the results by the max_amount field.
+func ByMaxAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxAmount, opts...).ToFunc()
+}
+
+// ByPercent orders the results by the percent field.
+func ByPercent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPercent, opts...).ToFunc()
+}
+
+// ByFlatAmount orders the results by the flat_amount field.
+func ByFlatAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFlatAmount, opts...).ToFunc()
+}
+
+// ByEffectiveFrom orders the results by the effective_from field.
+func ByEffectiveFrom(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEffectiveFrom, opts...).ToFunc()
+}
+
+// ByEffectiveUntil orders the results by the effective_until field.
+func ByEffectiveUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEffectiveUntil, opts...).ToFunc()
+}
+
+// BySenderProfileField orders the results by sender_profile field.
+func BySenderProfileField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSenderProfileStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newSenderProfileStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SenderProfileInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SenderProfileTable, SenderProfileColumn),
+	)
+}
@@ -0,0 +1,636 @@
+// Code generated by ent, DO NOT EDIT.
+
+package feeschedule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Token applies equality check predicate on the "token" field. It's identical to TokenEQ.
+func Token(v string) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldEQ(FieldToken, v))
+}
+
+// Network applies equality check predicate on the "network" field. It's identical to NetworkEQ.
+func Network(v string) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldEQ(FieldNetwork, v))
+}
+
+// MinAmount applies equality check predicate on the "min_amount" field. It's identical to MinAmountEQ.
+func MinAmount(v decimal.Decimal) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldEQ(FieldMinAmount, v))
+}
+
+// MaxAmount applies equality check predicate on the "max_amount" field. It's identical to MaxAmountEQ.
+func MaxAmount(v decimal.Decimal) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldEQ(FieldMaxAmount, v))
+}
+
+// Percent applies equality check predicate on the "percent" field. It's identical to PercentEQ.
+func Percent(v decimal.Decimal) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldEQ(FieldPercent, v))
+}
+
+// FlatAmount applies equality check predicate on the "flat_amount" field. It's identical to FlatAmountEQ.
+func FlatAmount(v decimal.Decimal) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldEQ(FieldFlatAmount, v))
+}
+
+// EffectiveFrom applies equality check predicate on the "effective_from" field. It's identical to EffectiveFromEQ.
+func EffectiveFrom(v time.Time) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldEQ(FieldEffectiveFrom, v))
+}
+
+// EffectiveUntil applies equality check predicate on the "effective_until" field. It's identical to EffectiveUntilEQ.
+func EffectiveUntil(v time.Time) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldEQ(FieldEffectiveUntil, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// FeeTypeEQ applies the EQ predicate on the "fee_type" field.
+func FeeTypeEQ(v FeeType) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldEQ(FieldFeeType, v))
+}
+
+// FeeTypeNEQ applies the NEQ predicate on the "fee_type" field.
+func FeeTypeNEQ(v FeeType) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldNEQ(FieldFeeType, v))
+}
+
+// FeeTypeIn applies the In predicate on the "fee_type" field.
+func FeeTypeIn(vs ...FeeType) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldIn(FieldFeeType, vs...))
+}
+
+// FeeTypeNotIn applies the NotIn predicate on the "fee_type" field.
+func FeeTypeNotIn(vs ...FeeType) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldNotIn(FieldFeeType, vs...))
+}
+
+// TokenEQ applies the EQ predicate on the "token" field.
+func TokenEQ(v string) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldEQ(FieldToken, v))
+}
+
+// TokenNEQ applies the NEQ predicate on the "token" field.
+func TokenNEQ(v string) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldNEQ(FieldToken, v))
+}
+
+// TokenIn applies the In predicate on the "token" field.
+func TokenIn(vs ...string) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldIn(FieldToken, vs...))
+}
+
+// TokenNotIn applies the NotIn predicate on the "token" field.
+func TokenNotIn(vs ...string) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldNotIn(FieldToken, vs...))
+}
+
+// TokenGT applies the GT predicate on the "token" field.
+func TokenGT(v string) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldGT(FieldToken, v))
+}
+
+// TokenGTE applies the GTE predicate on the "token" field.
+func TokenGTE(v string) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldGTE(FieldToken, v))
+}
+
+// TokenLT applies the LT predicate on the "token" field.
+func TokenLT(v string) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldLT(FieldToken, v))
+}
+
+// TokenLTE applies the LTE predicate on the "token" field.
+func TokenLTE(v string) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldLTE(FieldToken, v))
+}
+
+// TokenContains applies the Contains predicate on the "token" field.
+func TokenContains(v string) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldContains(FieldToken, v))
+}
+
+// TokenHasPrefix applies the HasPrefix predicate on the "token" field.
+func TokenHasPrefix(v string) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldHasPrefix(FieldToken, v))
+}
+
+// TokenHasSuffix applies the HasSuffix predicate on the "token" field.
+func TokenHasSuffix(v string) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldHasSuffix(FieldToken, v))
+}
+
+// TokenEqualFold applies the EqualFold predicate on the "token" field.
+func TokenEqualFold(v string) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldEqualFold(FieldToken, v))
+}
+
+// TokenContainsFold applies the ContainsFold predicate on the "token" field.
+func TokenContainsFold(v string) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldContainsFold(FieldToken, v))
+}
+
+// NetworkEQ applies the EQ predicate on the "network" field.
+func NetworkEQ(v string) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldEQ(FieldNetwork, v))
+}
+
+// NetworkNEQ applies the NEQ predicate on the "network" field.
+func NetworkNEQ(v string) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldNEQ(FieldNetwork, v))
+}
+
+// NetworkIn applies the In predicate on the "network" field.
+func NetworkIn(vs ...string) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldIn(FieldNetwork, vs...))
+}
+
+// NetworkNotIn applies the NotIn predicate on the "network" field.
+func NetworkNotIn(vs ...string) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldNotIn(FieldNetwork, vs...))
+}
+
+// NetworkGT applies the GT predicate on the "network" field.
+func NetworkGT(v string) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldGT(FieldNetwork, v))
+}
+
+// NetworkGTE applies the GTE predicate on the "network" field.
+func NetworkGTE(v string) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldGTE(FieldNetwork, v))
+}
+
+// NetworkLT applies the LT predicate on the "network" field.
+func NetworkLT(v string) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldLT(FieldNetwork, v))
+}
+
+// NetworkLTE applies the LTE predicate on the "network" field.
+func NetworkLTE(v string) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldLTE(FieldNetwork, v))
+}
+
+// NetworkContains applies the Contains predicate on the "network" field.
+func NetworkContains(v string) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldContains(FieldNetwork, v))
+}
+
+// NetworkHasPrefix applies the HasPrefix predicate on the "network" field.
+func NetworkHasPrefix(v string) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldHasPrefix(FieldNetwork, v))
+}
+
+// NetworkHasSuffix applies the HasSuffix predicate on the "network" field.
+func NetworkHasSuffix(v string) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldHasSuffix(FieldNetwork, v))
+}
+
+// NetworkEqualFold applies the EqualFold predicate on the "network" field.
+func NetworkEqualFold(v string) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldEqualFold(FieldNetwork, v))
+}
+
+// NetworkContainsFold applies the ContainsFold predicate on the "network" field.
+func NetworkContainsFold(v string) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldContainsFold(FieldNetwork, v))
+}
+
+// MinAmountEQ applies the EQ predicate on the "min_amount" field.
+func MinAmountEQ(v decimal.Decimal) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldEQ(FieldMinAmount, v))
+}
+
+// MinAmountNEQ applies the NEQ predicate on the "min_amount" field.
+func MinAmountNEQ(v decimal.Decimal) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldNEQ(FieldMinAmount, v))
+}
+
+// MinAmountIn applies the In predicate on the "min_amount" field.
+func MinAmountIn(vs ...decimal.Decimal) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldIn(FieldMinAmount, vs...))
+}
+
+// MinAmountNotIn applies the NotIn predicate on the "min_amount" field.
+func MinAmountNotIn(vs ...decimal.Decimal) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldNotIn(FieldMinAmount, vs...))
+}
+
+// MinAmountGT applies the GT predicate on the "min_amount" field.
+func MinAmountGT(v decimal.Decimal) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldGT(FieldMinAmount, v))
+}
+
+// MinAmountGTE applies the GTE predicate on the "min_amount" field.
+func MinAmountGTE(v decimal.Decimal) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldGTE(FieldMinAmount, v))
+}
+
+// MinAmountLT applies the LT predicate on the "min_amount" field.
+func MinAmountLT(v decimal.Decimal) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldLT(FieldMinAmount, v))
+}
+
+// MinAmountLTE applies the LTE predicate on the "min_amount" field.
+func MinAmountLTE(v decimal.Decimal) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldLTE(FieldMinAmount, v))
+}
+
+// MaxAmountEQ applies the EQ predicate on the "max_amount" field.
+func MaxAmountEQ(v decimal.Decimal) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldEQ(FieldMaxAmount, v))
+}
+
+// MaxAmountNEQ applies the NEQ predicate on the "max_amount" field.
+func MaxAmountNEQ(v decimal.Decimal) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldNEQ(FieldMaxAmount, v))
+}
+
+// MaxAmountIn applies the In predicate on the "max_amount" field.
+func MaxAmountIn(vs ...decimal.Decimal) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldIn(FieldMaxAmount, vs...))
+}
+
+// MaxAmountNotIn applies the NotIn predicate on the "max_amount" field.
+func MaxAmountNotIn(vs ...decimal.Decimal) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldNotIn(FieldMaxAmount, vs...))
+}
+
+// MaxAmountGT applies the GT predicate on the "max_amount" field.
+func MaxAmountGT(v decimal.Decimal) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldGT(FieldMaxAmount, v))
+}
+
+// MaxAmountGTE applies the GTE predicate on the "max_amount" field.
+func MaxAmountGTE(v decimal.Decimal) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldGTE(FieldMaxAmount, v))
+}
+
+// MaxAmountLT applies the LT predicate on the "max_amount" field.
+func MaxAmountLT(v decimal.Decimal) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldLT(FieldMaxAmount, v))
+}
+
+// MaxAmountLTE applies the LTE predicate on the "max_amount" field.
+func MaxAmountLTE(v decimal.Decimal) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldLTE(FieldMaxAmount, v))
+}
+
+// MaxAmountIsNil applies the IsNil predicate on the "max_amount" field.
+func MaxAmountIsNil() predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldIsNull(FieldMaxAmount))
+}
+
+// MaxAmountNotNil applies the NotNil predicate on the "max_amount" field.
+func MaxAmountNotNil() predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldNotNull(FieldMaxAmount))
+}
+
+// PercentEQ applies the EQ predicate on the "percent" field.
+func PercentEQ(v decimal.Decimal) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldEQ(FieldPercent, v))
+}
+
+// PercentNEQ applies the NEQ predicate on the "percent" field.
+func PercentNEQ(v decimal.Decimal) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldNEQ(FieldPercent, v))
+}
+
+// PercentIn applies the In predicate on the "percent" field.
+func PercentIn(vs ...decimal.Decimal) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldIn(FieldPercent, vs...))
+}
+
+// PercentNotIn applies the NotIn predicate on the "percent" field.
+func PercentNotIn(vs ...decimal.Decimal) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldNotIn(FieldPercent, vs...))
+}
+
+// PercentGT applies the GT predicate on the "percent" field.
+func PercentGT(v decimal.Decimal) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldGT(FieldPercent, v))
+}
+
+// PercentGTE applies the GTE predicate on the "percent" field.
+func PercentGTE(v decimal.Decimal) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldGTE(FieldPercent, v))
+}
+
+// PercentLT applies the LT predicate on the "percent" field.
+func PercentLT(v decimal.Decimal) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldLT(FieldPercent, v))
+}
+
+// PercentLTE applies the LTE predicate on the "percent" field.
+func PercentLTE(v decimal.Decimal) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldLTE(FieldPercent, v))
+}
+
+// FlatAmountEQ applies the EQ predicate on the "flat_amount" field.
+func FlatAmountEQ(v decimal.Decimal) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldEQ(FieldFlatAmount, v))
+}
+
+// FlatAmountNEQ applies the NEQ predicate on the "flat_amount" field.
+func FlatAmountNEQ(v decimal.Decimal) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldNEQ(FieldFlatAmount, v))
+}
+
+// FlatAmountIn applies the In predicate on the "flat_amount" field.
+func FlatAmountIn(vs ...decimal.Decimal) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldIn(FieldFlatAmount, vs...))
+}
+
+// FlatAmountNotIn applies the NotIn predicate on the "flat_amount" field.
+func FlatAmountNotIn(vs ...decimal.Decimal) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldNotIn(FieldFlatAmount, vs...))
+}
+
+// FlatAmountGT applies the GT predicate on the "flat_amount" field.
+func FlatAmountGT(v decimal.Decimal) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldGT(FieldFlatAmount, v))
+}
+
+// FlatAmountGTE applies the GTE predicate on the "flat_amount" field.
+func FlatAmountGTE(v decimal.Decimal) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldGTE(FieldFlatAmount, v))
+}
+
+// FlatAmountLT applies the LT predicate on the "flat_amount" field.
+func FlatAmountLT(v decimal.Decimal) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldLT(FieldFlatAmount, v))
+}
+
+// FlatAmountLTE applies the LTE predicate on the "flat_amount" field.
+func FlatAmountLTE(v decimal.Decimal) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldLTE(FieldFlatAmount, v))
+}
+
+// EffectiveFromEQ applies the EQ predicate on the "effective_from" field.
+func EffectiveFromEQ(v time.Time) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldEQ(FieldEffectiveFrom, v))
+}
+
+// EffectiveFromNEQ applies the NEQ predicate on the "effective_from" field.
+func EffectiveFromNEQ(v time.Time) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldNEQ(FieldEffectiveFrom, v))
+}
+
+// EffectiveFromIn applies the In predicate on the "effective_from" field.
+func EffectiveFromIn(vs ...time.Time) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldIn(FieldEffectiveFrom, vs...))
+}
+
+// EffectiveFromNotIn applies the NotIn predicate on the "effective_from" field.
+func EffectiveFromNotIn(vs ...time.Time) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldNotIn(FieldEffectiveFrom, vs...))
+}
+
+// EffectiveFromGT applies the GT predicate on the "effective_from" field.
+func EffectiveFromGT(v time.Time) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldGT(FieldEffectiveFrom, v))
+}
+
+// EffectiveFromGTE applies the GTE predicate on the "effective_from" field.
+func EffectiveFromGTE(v time.Time) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldGTE(FieldEffectiveFrom, v))
+}
+
+// EffectiveFromLT applies the LT predicate on the "effective_from" field.
+func EffectiveFromLT(v time.Time) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldLT(FieldEffectiveFrom, v))
+}
+
+// EffectiveFromLTE applies the LTE predicate on the "effective_from" field.
+func EffectiveFromLTE(v time.Time) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldLTE(FieldEffectiveFrom, v))
+}
+
+// EffectiveUntilEQ applies the EQ predicate on the "effective_until" field.
+func EffectiveUntilEQ(v time.Time) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldEQ(FieldEffectiveUntil, v))
+}
+
+// EffectiveUntilNEQ applies the NEQ predicate on the "effective_until" field.
+func EffectiveUntilNEQ(v time.Time) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldNEQ(FieldEffectiveUntil, v))
+}
+
+// EffectiveUntilIn applies the In predicate on the "effective_until" field.
+func EffectiveUntilIn(vs ...time.Time) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldIn(FieldEffectiveUntil, vs...))
+}
+
+// EffectiveUntilNotIn applies the NotIn predicate on the "effective_until" field.
+func EffectiveUntilNotIn(vs ...time.Time) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldNotIn(FieldEffectiveUntil, vs...))
+}
+
+// EffectiveUntilGT applies the GT predicate on the "effective_until" field.
+func EffectiveUntilGT(v time.Time) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldGT(FieldEffectiveUntil, v))
+}
+
+// EffectiveUntilGTE applies the GTE predicate on the "effective_until" field.
+func EffectiveUntilGTE(v time.Time) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldGTE(FieldEffectiveUntil, v))
+}
+
+// EffectiveUntilLT applies the LT predicate on the "effective_until" field.
+func EffectiveUntilLT(v time.Time) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldLT(FieldEffectiveUntil, v))
+}
+
+// EffectiveUntilLTE applies the LTE predicate on the "effective_until" field.
+func EffectiveUntilLTE(v time.Time) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldLTE(FieldEffectiveUntil, v))
+}
+
+// EffectiveUntilIsNil applies the IsNil predicate on the "effective_until" field.
+func EffectiveUntilIsNil() predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldIsNull(FieldEffectiveUntil))
+}
+
+// EffectiveUntilNotNil applies the NotNil predicate on the "effective_until" field.
+func EffectiveUntilNotNil() predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.FieldNotNull(FieldEffectiveUntil))
+}
+
+// HasSenderProfile applies the HasEdge predicate on the "sender_profile" edge.
+func HasSenderProfile() predicate.FeeSchedule {
+	return predicate.FeeSchedule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SenderProfileTable, SenderProfileColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSenderProfileWith applies the HasEdge predicate on the "sender_profile" edge with a given conditions (other predicates).
+func HasSenderProfileWith(preds ...predicate.SenderProfile) predicate.FeeSchedule {
+	return predicate.FeeSchedule(func(s *sql.Selector) {
+		step := newSenderProfileStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.FeeSchedule) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.FeeSchedule) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.FeeSchedule) predicate.FeeSchedule {
+	return predicate.FeeSchedule(sql.NotPredicates(p))
+}
@@ -0,0 +1,1284 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/feeschedule"
+	"github.com/NEDA-LABS/stablenode/ent/senderprofile"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// FeeScheduleCreate is the builder for creating a FeeSchedule entity.
+type FeeScheduleCreate struct {
+	config
+	mutation *FeeScheduleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (fsc *FeeScheduleCreate) SetCreatedAt(t time.Time) *FeeScheduleCreate {
+	fsc.mutation.SetCreatedAt(t)
+	return fsc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (fsc *FeeScheduleCreate) SetNillableCreatedAt(t *time.Time) *FeeScheduleCreate {
+	if t != nil {
+		fsc.SetCreatedAt(*t)
+	}
+	return fsc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (fsc *FeeScheduleCreate) SetUpdatedAt(t time.Time) *FeeScheduleCreate {
+	fsc.mutation.SetUpdatedAt(t)
+	return fsc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (fsc *FeeScheduleCreate) SetNillableUpdatedAt(t *time.Time) *FeeScheduleCreate {
+	if t != nil {
+		fsc.SetUpdatedAt(*t)
+	}
+	return fsc
+}
+
+// SetFeeType sets the "fee_type" field.
+func (fsc *FeeScheduleCreate) SetFeeType(ft feeschedule.FeeType) *FeeScheduleCreate {
+	fsc.mutation.SetFeeType(ft)
+	return fsc
+}
+
+// SetToken sets the "token" field.
+func (fsc *FeeScheduleCreate) SetToken(s string) *FeeScheduleCreate {
+	fsc.mutation.SetToken(s)
+	return fsc
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (fsc *FeeScheduleCreate) SetNillableToken(s *string) *FeeScheduleCreate {
+	if s != nil {
+		fsc.SetToken(*s)
+	}
+	return fsc
+}
+
+// SetNetwork sets the "network" field.
+func (fsc *FeeScheduleCreate) SetNetwork(s string) *FeeScheduleCreate {
+	fsc.mutation.SetNetwork(s)
+	return fsc
+}
+
+// SetNillableNetwork sets the "network" field if the given value is not nil.
+func (fsc *FeeScheduleCreate) SetNillableNetwork(s *string) *FeeScheduleCreate {
+	if s != nil {
+		fsc.SetNetwork(*s)
+	}
+	return fsc
+}
+
+// SetMinAmount sets the "min_amount" field.
+func (fsc *FeeScheduleCreate) SetMinAmount(d decimal.Decimal) *FeeScheduleCreate {
+	fsc.mutation.SetMinAmount(d)
+	return fsc
+}
+
+// SetNillableMinAmount sets the "min_amount" field if the given value is not nil.
+func (fsc *FeeScheduleCreate) SetNillableMinAmount(d *decimal.Decimal) *FeeScheduleCreate {
+	if d != nil {
+		fsc.SetMinAmount(*d)
+	}
+	return fsc
+}
+
+// SetMaxAmount sets the "max_amount" field.
+func (fsc *FeeScheduleCreate) SetMaxAmount(d decimal.Decimal) *FeeScheduleCreate {
+	fsc.mutation.SetMaxAmount(d)
+	return fsc
+}
+
+// SetNillableMaxAmount sets the "max_amount" field if the given value is not nil.
+func (fsc *FeeScheduleCreate) SetNillableMaxAmount(d *decimal.Decimal) *FeeScheduleCreate {
+	if d != nil {
+		fsc.SetMaxAmount(*d)
+	}
+	return fsc
+}
+
+// SetPercent sets the "percent" field.
+func (fsc *FeeScheduleCreate) SetPercent(d decimal.Decimal) *FeeScheduleCreate {
+	fsc.mutation.SetPercent(d)
+	return fsc
+}
+
+// SetNillablePercent sets the "percent" field if the given value is not nil.
+func (fsc *FeeScheduleCreate) SetNillablePercent(d *decimal.Decimal) *FeeScheduleCreate {
+	if d != nil {
+		fsc.SetPercent(*d)
+	}
+	return fsc
+}
+
+// SetFlatAmount sets the "flat_amount" field.
+func (fsc *FeeScheduleCreate) SetFlatAmount(d decimal.Decimal) *FeeScheduleCreate {
+	fsc.mutation.SetFlatAmount(d)
+	return fsc
+}
+
+// SetNillableFlatAmount sets the "flat_amount" field if the given value is not nil.
+func (fsc *FeeScheduleCreate) SetNillableFlatAmount(d *decimal.Decimal) *FeeScheduleCreate {
+	if d != nil {
+		fsc.SetFlatAmount(*d)
+	}
+	return fsc
+}
+
+// SetEffectiveFrom sets the "effective_from" field.
+func (fsc *FeeScheduleCreate) SetEffectiveFrom(t time.Time) *FeeScheduleCreate {
+	fsc.mutation.SetEffectiveFrom(t)
+	return fsc
+}
+
+// SetNillableEffectiveFrom sets the "effective_from" field if the given value is not nil.
+func (fsc *FeeScheduleCreate) SetNillableEffectiveFrom(t *time.Time) *FeeScheduleCreate {
+	if t != nil {
+		fsc.SetEffectiveFrom(*t)
+	}
+	return fsc
+}
+
+// SetEffectiveUntil sets the "effective_until" field.
+func (fsc *FeeScheduleCreate) SetEffectiveUntil(t time.Time) *FeeScheduleCreate {
+	fsc.mutation.SetEffectiveUntil(t)
+	return fsc
+}
+
+// SetNillableEffectiveUntil sets the "effective_until" field if the given value is not nil.
+func (fsc *FeeScheduleCreate) SetNillableEffectiveUntil(t *time.Time) *FeeScheduleCreate {
+	if t != nil {
+		fsc.SetEffectiveUntil(*t)
+	}
+	return fsc
+}
+
+// SetID sets the "id" field.
+func (fsc *FeeScheduleCreate) SetID(u uuid.UUID) *FeeScheduleCreate {
+	fsc.mutation.SetID(u)
+	return fsc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (fsc *FeeScheduleCreate) SetNillableID(u *uuid.UUID) *FeeScheduleCreate {
+	if u != nil {
+		fsc.SetID(*u)
+	}
+	return fsc
+}
+
+// SetSenderProfileID sets the "sender_profile" edge to the SenderProfile entity by ID.
+func (fsc *FeeScheduleCreate) SetSenderProfileID(id uuid.UUID) *FeeScheduleCreate {
+	fsc.mutation.SetSenderProfileID(id)
+	return fsc
+}
+
+// SetNillableSenderProfileID sets the "sender_profile" edge to the SenderProfile entity by ID if the given value is not nil.
+func (fsc *FeeScheduleCreate) SetNillableSenderProfileID(id *uuid.UUID) *FeeScheduleCreate {
+	if id != nil {
+		fsc = fsc.SetSenderProfileID(*id)
+	}
+	return fsc
+}
+
+// SetSenderProfile sets the "sender_profile" edge to the SenderProfile entity.
+func (fsc *FeeScheduleCreate) SetSenderProfile(s *SenderProfile) *FeeScheduleCreate {
+	return fsc.SetSenderProfileID(s.ID)
+}
+
+// Mutation returns the FeeScheduleMutation object of the builder.
+func (fsc *FeeScheduleCreate) Mutation() *FeeScheduleMutation {
+	return fsc.mutation
+}
+
+// Save creates the FeeSchedule in the database.
+func (fsc *FeeScheduleCreate) Save(ctx context.Context) (*FeeSchedule, error) {
+	fsc.defaults()
+	return withHooks(ctx, fsc.sqlSave, fsc.mutation, fsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (fsc *FeeScheduleCreate) SaveX(ctx context.Context) *FeeSchedule {
+	v, err := fsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (fsc *FeeScheduleCreate) Exec(ctx context.Context) error {
+	_, err := fsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fsc *FeeScheduleCreate) ExecX(ctx context.Context) {
+	if err := fsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fsc *FeeScheduleCreate) defaults() {
+	if _, ok := fsc.mutation.CreatedAt(); !ok {
+		v := feeschedule.DefaultCreatedAt()
+		fsc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := fsc.mutation.UpdatedAt(); !ok {
+		v := feeschedule.DefaultUpdatedAt()
+		fsc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := fsc.mutation.Token(); !ok {
+		v := feeschedule.DefaultToken
+		fsc.mutation.SetToken(v)
+	}
+	if _, ok := fsc.mutation.Network(); !ok {
+		v := feeschedule.DefaultNetwork
+		fsc.mutation.SetNetwork(v)
+	}
+	if _, ok := fsc.mutation.MinAmount(); !ok {
+		v := feeschedule.DefaultMinAmount()
+		fsc.mutation.SetMinAmount(v)
+	}
+	if _, ok := fsc.mutation.Percent(); !ok {
+		v := feeschedule.DefaultPercent()
+		fsc.mutation.SetPercent(v)
+	}
+	if _, ok := fsc.mutation.FlatAmount(); !ok {
+		v := feeschedule.DefaultFlatAmount()
+		fsc.mutation.SetFlatAmount(v)
+	}
+	if _, ok := fsc.mutation.EffectiveFrom(); !ok {
+		v := feeschedule.DefaultEffectiveFrom()
+		fsc.mutation.SetEffectiveFrom(v)
+	}
+	if _, ok := fsc.mutation.ID(); !ok {
+		v := feeschedule.DefaultID()
+		fsc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fsc *FeeScheduleCreate) check() error {
+	if _, ok := fsc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "FeeSchedule.created_at"`)}
+	}
+	if _, ok := fsc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "FeeSchedule.updated_at"`)}
+	}
+	if _, ok := fsc.mutation.FeeType(); !ok {
+		return &ValidationError{Name: "fee_type", err: errors.New(`ent: missing required field "FeeSchedule.fee_type"`)}
+	}
+	if v, ok := fsc.mutation.FeeType(); ok {
+		if err := feeschedule.FeeTypeValidator(v); err != nil {
+			return &ValidationError{Name: "fee_type", err: fmt.Errorf(`ent: validator failed for field "FeeSchedule.fee_type": %w`, err)}
+		}
+	}
+	if _, ok := fsc.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "FeeSchedule.token"`)}
+	}
+	if v, ok := fsc.mutation.Token(); ok {
+		if err := feeschedule.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "FeeSchedule.token": %w`, err)}
+		}
+	}
+	if _, ok := fsc.mutation.Network(); !ok {
+		return &ValidationError{Name: "network", err: errors.New(`ent: missing required field "FeeSchedule.network"`)}
+	}
+	if v, ok := fsc.mutation.Network(); ok {
+		if err := feeschedule.NetworkValidator(v); err != nil {
+			return &ValidationError{Name: "network", err: fmt.Errorf(`ent: validator failed for field "FeeSchedule.network": %w`, err)}
+		}
+	}
+	if _, ok := fsc.mutation.MinAmount(); !ok {
+		return &ValidationError{Name: "min_amount", err: errors.New(`ent: missing required field "FeeSchedule.min_amount"`)}
+	}
+	if _, ok := fsc.mutation.Percent(); !ok {
+		return &ValidationError{Name: "percent", err: errors.New(`ent: missing required field "FeeSchedule.percent"`)}
+	}
+	if _, ok := fsc.mutation.FlatAmount(); !ok {
+		return &ValidationError{Name: "flat_amount", err: errors.New(`ent: missing required field "FeeSchedule.flat_amount"`)}
+	}
+	if _, ok := fsc.mutation.EffectiveFrom(); !ok {
+		return &ValidationError{Name: "effective_from", err: errors.New(`ent: missing required field "FeeSchedule.effective_from"`)}
+	}
+	return nil
+}
+
+func (fsc *FeeScheduleCreate) sqlSave(ctx context.Context) (*FeeSchedule, error) {
+	if err := fsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := fsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, fsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	fsc.mutation.id = &_node.ID
+	fsc.mutation.done = true
+	return _node, nil
+}
+
+func (fsc *FeeScheduleCreate) createSpec() (*FeeSchedule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FeeSchedule{config: fsc.config}
+		_spec = sqlgraph.NewCreateSpec(feeschedule.Table, sqlgraph.NewFieldSpec(feeschedule.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = fsc.conflict
+	if id, ok := fsc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := fsc.mutation.CreatedAt(); ok {
+		_spec.SetField(feeschedule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := fsc.mutation.UpdatedAt(); ok {
+		_spec.SetField(feeschedule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := fsc.mutation.FeeType(); ok {
+		_spec.SetField(feeschedule.FieldFeeType, field.TypeEnum, value)
+		_node.FeeType = value
+	}
+	if value, ok := fsc.mutation.Token(); ok {
+		_spec.SetField(feeschedule.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := fsc.mutation.Network(); ok {
+		_spec.SetField(feeschedule.FieldNetwork, field.TypeString, value)
+		_node.Network = value
+	}
+	if value, ok := fsc.mutation.MinAmount(); ok {
+		_spec.SetField(feeschedule.FieldMinAmount, field.TypeFloat64, value)
+		_node.MinAmount = value
+	}
+	if value, ok := fsc.mutation.MaxAmount(); ok {
+		_spec.SetField(feeschedule.FieldMaxAmount, field.TypeFloat64, value)
+		_node.MaxAmount = &value
+	}
+	if value, ok := fsc.mutation.Percent(); ok {
+		_spec.SetField(feeschedule.FieldPercent, field.TypeFloat64, value)
+		_node.Percent = value
+	}
+	if value, ok := fsc.mutation.FlatAmount(); ok {
+		_spec.SetField(feeschedule.FieldFlatAmount, field.TypeFloat64, value)
+		_node.FlatAmount = value
+	}
+	if value, ok := fsc.mutation.EffectiveFrom(); ok {
+		_spec.SetField(feeschedule.FieldEffectiveFrom, field.TypeTime, value)
+		_node.EffectiveFrom = value
+	}
+	if value, ok := fsc.mutation.EffectiveUntil(); ok {
+		_spec.SetField(feeschedule.FieldEffectiveUntil, field.TypeTime, value)
+		_node.EffectiveUntil = &value
+	}
+	if nodes := fsc.mutation.SenderProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   feeschedule.SenderProfileTable,
+			Columns: []string{feeschedule.SenderProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderprofile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.sender_profile_fee_schedules = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.FeeSchedule.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.FeeScheduleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (fsc *FeeScheduleCreate) OnConflict(opts ...sql.ConflictOption) *FeeScheduleUpsertOne {
+	fsc.conflict = opts
+	return &FeeScheduleUpsertOne{
+		create: fsc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.FeeSchedule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (fsc *FeeScheduleCreate) OnConflictColumns(columns ...string) *FeeScheduleUpsertOne {
+	fsc.conflict = append(fsc.conflict, sql.ConflictColumns(columns...))
+	return &FeeScheduleUpsertOne{
+		create: fsc,
+	}
+}
+
+type (
+	// FeeScheduleUpsertOne is the builder for "upsert"-ing
+	//  one FeeSchedule node.
+	FeeScheduleUpsertOne struct {
+		create *FeeScheduleCreate
+	}
+
+	// FeeScheduleUpsert is the "OnConflict" setter.
+	FeeScheduleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *FeeScheduleUpsert) SetUpdatedAt(v time.Time) *FeeScheduleUpsert {
+	u.Set(feeschedule.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *FeeScheduleUpsert) UpdateUpdatedAt() *FeeScheduleUpsert {
+	u.SetExcluded(feeschedule.FieldUpdatedAt)
+	return u
+}
+
+// SetFeeType sets the "fee_type" field.
+func (u *FeeScheduleUpsert) SetFeeType(v feeschedule.FeeType) *FeeScheduleUpsert {
+	u.Set(feeschedule.FieldFeeType, v)
+	return u
+}
+
+// UpdateFeeType sets the "fee_type" field to the value that was provided on create.
+func (u *FeeScheduleUpsert) UpdateFeeType() *FeeScheduleUpsert {
+	u.SetExcluded(feeschedule.FieldFeeType)
+	return u
+}
+
+// SetToken sets the "token" field.
+func (u *FeeScheduleUpsert) SetToken(v string) *FeeScheduleUpsert {
+	u.Set(feeschedule.FieldToken, v)
+	return u
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *FeeScheduleUpsert) UpdateToken() *FeeScheduleUpsert {
+	u.SetExcluded(feeschedule.FieldToken)
+	return u
+}
+
+// SetNetwork sets the "network" field.
+func (u *FeeScheduleUpsert) SetNetwork(v string) *FeeScheduleUpsert {
+	u.Set(feeschedule.FieldNetwork, v)
+	return u
+}
+
+// UpdateNetwork sets the "network" field to the value that was provided on create.
+func (u *FeeScheduleUpsert) UpdateNetwork() *FeeScheduleUpsert {
+	u.SetExcluded(feeschedule.FieldNetwork)
+	return u
+}
+
+// SetMinAmount sets the "min_amount" field.
+func (u *FeeScheduleUpsert) SetMinAmount(v decimal.Decimal) *FeeScheduleUpsert {
+	u.Set(feeschedule.FieldMinAmount, v)
+	return u
+}
+
+// UpdateMinAmount sets the "min_amount" field to the value that was provided on create.
+func (u *FeeScheduleUpsert) UpdateMinAmount() *FeeScheduleUpsert {
+	u.SetExcluded(feeschedule.FieldMinAmount)
+	return u
+}
+
+// AddMinAmount adds v to the "min_amount" field.
+func (u *FeeScheduleUpsert) AddMinAmount(v decimal.Decimal) *FeeScheduleUpsert {
+	u.Add(feeschedule.FieldMinAmount, v)
+	return u
+}
+
+// SetMaxAmount sets the "max_amount" field.
+func (u *FeeScheduleUpsert) SetMaxAmount(v decimal.Decimal) *FeeScheduleUpsert {
+	u.Set(feeschedule.FieldMaxAmount, v)
+	return u
+}
+
+// UpdateMaxAmount sets the "max_amount" field to the value that was provided on create.
+func (u *FeeScheduleUpsert) UpdateMaxAmount() *FeeScheduleUpsert {
+	u.SetExcluded(feeschedule.FieldMaxAmount)
+	return u
+}
+
+// AddMaxAmount adds v to the "max_amount" field.
+func (u *FeeScheduleUpsert) AddMaxAmount(v decimal.Decimal) *FeeScheduleUpsert {
+	u.Add(feeschedule.FieldMaxAmount, v)
+	return u
+}
+
+// ClearMaxAmount clears the value of the "max_amount" field.
+func (u *FeeScheduleUpsert) ClearMaxAmount() *FeeScheduleUpsert {
+	u.SetNull(feeschedule.FieldMaxAmount)
+	return u
+}
+
+// SetPercent sets the "percent" field.
+func (u *FeeScheduleUpsert) SetPercent(v decimal.Decimal) *FeeScheduleUpsert {
+	u.Set(feeschedule.FieldPercent, v)
+	return u
+}
+
+// UpdatePercent sets the "percent" field to the value that was provided on create.
+func (u *FeeScheduleUpsert) UpdatePercent() *FeeScheduleUpsert {
+	u.SetExcluded(feeschedule.FieldPercent)
+	return u
+}
+
+// AddPercent adds v to the "percent" field.
+func (u *FeeScheduleUpsert) AddPercent(v decimal.Decimal) *FeeScheduleUpsert {
+	u.Add(feeschedule.FieldPercent, v)
+	return u
+}
+
+// SetFlatAmount sets the "flat_amount" field.
+func (u *FeeScheduleUpsert) SetFlatAmount(v decimal.Decimal) *FeeScheduleUpsert {
+	u.Set(feeschedule.FieldFlatAmount, v)
+	return u
+}
+
+// UpdateFlatAmount sets the "flat_amount" field to the value that was provided on create.
+func (u *FeeScheduleUpsert) UpdateFlatAmount() *FeeScheduleUpsert {
+	u.SetExcluded(feeschedule.FieldFlatAmount)
+	return u
+}
+
+// AddFlatAmount adds v to the "flat_amount" field.
+func (u *FeeScheduleUpsert) AddFlatAmount(v decimal.Decimal) *FeeScheduleUpsert {
+	u.Add(feeschedule.FieldFlatAmount, v)
+	return u
+}
+
+// SetEffectiveFrom sets the "effective_from" field.
+func (u *FeeScheduleUpsert) SetEffectiveFrom(v time.Time) *FeeScheduleUpsert {
+	u.Set(feeschedule.FieldEffectiveFrom, v)
+	return u
+}
+
+// UpdateEffectiveFrom sets the "effective_from" field to the value that was provided on create.
+func (u *FeeScheduleUpsert) UpdateEffectiveFrom() *FeeScheduleUpsert {
+	u.SetExcluded(feeschedule.FieldEffectiveFrom)
+	return u
+}
+
+// SetEffectiveUntil sets the "effective_until" field.
+func (u *FeeScheduleUpsert) SetEffectiveUntil(v time.Time) *FeeScheduleUpsert {
+	u.Set(feeschedule.FieldEffectiveUntil, v)
+	return u
+}
+
+// UpdateEffectiveUntil sets the "effective_until" field to the value that was provided on create.
+func (u *FeeScheduleUpsert) UpdateEffectiveUntil() *FeeScheduleUpsert {
+	u.SetExcluded(feeschedule.FieldEffectiveUntil)
+	return u
+}
+
+// ClearEffectiveUntil clears the value of the "effective_until" field.
+func (u *FeeScheduleUpsert) ClearEffectiveUntil() *FeeScheduleUpsert {
+	u.SetNull(feeschedule.FieldEffectiveUntil)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.FeeSchedule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(feeschedule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *FeeScheduleUpsertOne) UpdateNewValues() *FeeScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(feeschedule.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(feeschedule.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.FeeSchedule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *FeeScheduleUpsertOne) Ignore() *FeeScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FeeScheduleUpsertOne) DoNothing() *FeeScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FeeScheduleCreate.OnConflict
+// documentation for more info.
+func (u *FeeScheduleUpsertOne) Update(set func(*FeeScheduleUpsert)) *FeeScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FeeScheduleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *FeeScheduleUpsertOne) SetUpdatedAt(v time.Time) *FeeScheduleUpsertOne {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *FeeScheduleUpsertOne) UpdateUpdatedAt() *FeeScheduleUpsertOne {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetFeeType sets the "fee_type" field.
+func (u *FeeScheduleUpsertOne) SetFeeType(v feeschedule.FeeType) *FeeScheduleUpsertOne {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.SetFeeType(v)
+	})
+}
+
+// UpdateFeeType sets the "fee_type" field to the value that was provided on create.
+func (u *FeeScheduleUpsertOne) UpdateFeeType() *FeeScheduleUpsertOne {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.UpdateFeeType()
+	})
+}
+
+// SetToken sets the "token" field.
+func (u *FeeScheduleUpsertOne) SetToken(v string) *FeeScheduleUpsertOne {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.SetToken(v)
+	})
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *FeeScheduleUpsertOne) UpdateToken() *FeeScheduleUpsertOne {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.UpdateToken()
+	})
+}
+
+// SetNetwork sets the "network" field.
+func (u *FeeScheduleUpsertOne) SetNetwork(v string) *FeeScheduleUpsertOne {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.SetNetwork(v)
+	})
+}
+
+// UpdateNetwork sets the "network" field to the value that was provided on create.
+func (u *FeeScheduleUpsertOne) UpdateNetwork() *FeeScheduleUpsertOne {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.UpdateNetwork()
+	})
+}
+
+// SetMinAmount sets the "min_amount" field.
+func (u *FeeScheduleUpsertOne) SetMinAmount(v decimal.Decimal) *FeeScheduleUpsertOne {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.SetMinAmount(v)
+	})
+}
+
+// AddMinAmount adds v to the "min_amount" field.
+func (u *FeeScheduleUpsertOne) AddMinAmount(v decimal.Decimal) *FeeScheduleUpsertOne {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.AddMinAmount(v)
+	})
+}
+
+// UpdateMinAmount sets the "min_amount" field to the value that was provided on create.
+func (u *FeeScheduleUpsertOne) UpdateMinAmount() *FeeScheduleUpsertOne {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.UpdateMinAmount()
+	})
+}
+
+// SetMaxAmount sets the "max_amount" field.
+func (u *FeeScheduleUpsertOne) SetMaxAmount(v decimal.Decimal) *FeeScheduleUpsertOne {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.SetMaxAmount(v)
+	})
+}
+
+// AddMaxAmount adds v to the "max_amount" field.
+func (u *FeeScheduleUpsertOne) AddMaxAmount(v decimal.Decimal) *FeeScheduleUpsertOne {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.AddMaxAmount(v)
+	})
+}
+
+// UpdateMaxAmount sets the "max_amount" field to the value that was provided on create.
+func (u *FeeScheduleUpsertOne) UpdateMaxAmount() *FeeScheduleUpsertOne {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.UpdateMaxAmount()
+	})
+}
+
+// ClearMaxAmount clears the value of the "max_amount" field.
+func (u *FeeScheduleUpsertOne) ClearMaxAmount() *FeeScheduleUpsertOne {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.ClearMaxAmount()
+	})
+}
+
+// SetPercent sets the "percent" field.
+func (u *FeeScheduleUpsertOne) SetPercent(v decimal.Decimal) *FeeScheduleUpsertOne {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.SetPercent(v)
+	})
+}
+
+// AddPercent adds v to the "percent" field.
+func (u *FeeScheduleUpsertOne) AddPercent(v decimal.Decimal) *FeeScheduleUpsertOne {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.AddPercent(v)
+	})
+}
+
+// UpdatePercent sets the "percent" field to the value that was provided on create.
+func (u *FeeScheduleUpsertOne) UpdatePercent() *FeeScheduleUpsertOne {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.UpdatePercent()
+	})
+}
+
+// SetFlatAmount sets the "flat_amount" field.
+func (u *FeeScheduleUpsertOne) SetFlatAmount(v decimal.Decimal) *FeeScheduleUpsertOne {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.SetFlatAmount(v)
+	})
+}
+
+// AddFlatAmount adds v to the "flat_amount" field.
+func (u *FeeScheduleUpsertOne) AddFlatAmount(v decimal.Decimal) *FeeScheduleUpsertOne {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.AddFlatAmount(v)
+	})
+}
+
+// UpdateFlatAmount sets the "flat_amount" field to the value that was provided on create.
+func (u *FeeScheduleUpsertOne) UpdateFlatAmount() *FeeScheduleUpsertOne {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.UpdateFlatAmount()
+	})
+}
+
+// SetEffectiveFrom sets the "effective_from" field.
+func (u *FeeScheduleUpsertOne) SetEffectiveFrom(v time.Time) *FeeScheduleUpsertOne {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.SetEffectiveFrom(v)
+	})
+}
+
+// UpdateEffectiveFrom sets the "effective_from" field to the value that was provided on create.
+func (u *FeeScheduleUpsertOne) UpdateEffectiveFrom() *FeeScheduleUpsertOne {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.UpdateEffectiveFrom()
+	})
+}
+
+// SetEffectiveUntil sets the "effective_until" field.
+func (u *FeeScheduleUpsertOne) SetEffectiveUntil(v time.Time) *FeeScheduleUpsertOne {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.SetEffectiveUntil(v)
+	})
+}
+
+// UpdateEffectiveUntil sets the "effective_until" field to the value that was provided on create.
+func (u *FeeScheduleUpsertOne) UpdateEffectiveUntil() *FeeScheduleUpsertOne {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.UpdateEffectiveUntil()
+	})
+}
+
+// ClearEffectiveUntil clears the value of the "effective_until" field.
+func (u *FeeScheduleUpsertOne) ClearEffectiveUntil() *FeeScheduleUpsertOne {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.ClearEffectiveUntil()
+	})
+}
+
+// Exec executes the query.
+func (u *FeeScheduleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for FeeScheduleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *FeeScheduleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *FeeScheduleUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: FeeScheduleUpsertOne.ID is not supported by MySQL driver. Use FeeScheduleUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *FeeScheduleUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// FeeScheduleCreateBulk is the builder for creating many FeeSchedule entities in bulk.
+type FeeScheduleCreateBulk struct {
+	config
+	err      error
+	builders []*FeeScheduleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the FeeSchedule entities in the database.
+func (fscb *FeeScheduleCreateBulk) Save(ctx context.Context) ([]*FeeSchedule, error) {
+	if fscb.err != nil {
+		return nil, fscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(fscb.builders))
+	nodes := make([]*FeeSchedule, len(fscb.builders))
+	mutators := make([]Mutator, len(fscb.builders))
+	for i := range fscb.builders {
+		func(i int, root context.Context) {
+			builder := fscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FeeScheduleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, fscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = fscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, fscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, fscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fscb *FeeScheduleCreateBulk) SaveX(ctx context.Context) []*FeeSchedule {
+	v, err := fscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (fscb *FeeScheduleCreateBulk) Exec(ctx context.Context) error {
+	_, err := fscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fscb *FeeScheduleCreateBulk) ExecX(ctx context.Context) {
+	if err := fscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.FeeSchedule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.FeeScheduleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (fscb *FeeScheduleCreateBulk) OnConflict(opts ...sql.ConflictOption) *FeeScheduleUpsertBulk {
+	fscb.conflict = opts
+	return &FeeScheduleUpsertBulk{
+		create: fscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.FeeSchedule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (fscb *FeeScheduleCreateBulk) OnConflictColumns(columns ...string) *FeeScheduleUpsertBulk {
+	fscb.conflict = append(fscb.conflict, sql.ConflictColumns(columns...))
+	return &FeeScheduleUpsertBulk{
+		create: fscb,
+	}
+}
+
+// FeeScheduleUpsertBulk is the builder for "upsert"-ing
+// a bulk of FeeSchedule nodes.
+type FeeScheduleUpsertBulk struct {
+	create *FeeScheduleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.FeeSchedule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(feeschedule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *FeeScheduleUpsertBulk) UpdateNewValues() *FeeScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(feeschedule.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(feeschedule.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.FeeSchedule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *FeeScheduleUpsertBulk) Ignore() *FeeScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FeeScheduleUpsertBulk) DoNothing() *FeeScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FeeScheduleCreateBulk.OnConflict
+// documentation for more info.
+func (u *FeeScheduleUpsertBulk) Update(set func(*FeeScheduleUpsert)) *FeeScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FeeScheduleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *FeeScheduleUpsertBulk) SetUpdatedAt(v time.Time) *FeeScheduleUpsertBulk {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *FeeScheduleUpsertBulk) UpdateUpdatedAt() *FeeScheduleUpsertBulk {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetFeeType sets the "fee_type" field.
+func (u *FeeScheduleUpsertBulk) SetFeeType(v feeschedule.FeeType) *FeeScheduleUpsertBulk {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.SetFeeType(v)
+	})
+}
+
+// UpdateFeeType sets the "fee_type" field to the value that was provided on create.
+func (u *FeeScheduleUpsertBulk) UpdateFeeType() *FeeScheduleUpsertBulk {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.UpdateFeeType()
+	})
+}
+
+// SetToken sets the "token" field.
+func (u *FeeScheduleUpsertBulk) SetToken(v string) *FeeScheduleUpsertBulk {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.SetToken(v)
+	})
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *FeeScheduleUpsertBulk) UpdateToken() *FeeScheduleUpsertBulk {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.UpdateToken()
+	})
+}
+
+// SetNetwork sets the "network" field.
+func (u *FeeScheduleUpsertBulk) SetNetwork(v string) *FeeScheduleUpsertBulk {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.SetNetwork(v)
+	})
+}
+
+// UpdateNetwork sets the "network" field to the value that was provided on create.
+func (u *FeeScheduleUpsertBulk) UpdateNetwork() *FeeScheduleUpsertBulk {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.UpdateNetwork()
+	})
+}
+
+// SetMinAmount sets the "min_amount" field.
+func (u *FeeScheduleUpsertBulk) SetMinAmount(v decimal.Decimal) *FeeScheduleUpsertBulk {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.SetMinAmount(v)
+	})
+}
+
+// AddMinAmount adds v to the "min_amount" field.
+func (u *FeeScheduleUpsertBulk) AddMinAmount(v decimal.Decimal) *FeeScheduleUpsertBulk {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.AddMinAmount(v)
+	})
+}
+
+// UpdateMinAmount sets the "min_amount" field to the value that was provided on create.
+func (u *FeeScheduleUpsertBulk) UpdateMinAmount() *FeeScheduleUpsertBulk {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.UpdateMinAmount()
+	})
+}
+
+// SetMaxAmount sets the "max_amount" field.
+func (u *FeeScheduleUpsertBulk) SetMaxAmount(v decimal.Decimal) *FeeScheduleUpsertBulk {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.SetMaxAmount(v)
+	})
+}
+
+// AddMaxAmount adds v to the "max_amount" field.
+func (u *FeeScheduleUpsertBulk) AddMaxAmount(v decimal.Decimal) *FeeScheduleUpsertBulk {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.AddMaxAmount(v)
+	})
+}
+
+// UpdateMaxAmount sets the "max_amount" field to the value that was provided on create.
+func (u *FeeScheduleUpsertBulk) UpdateMaxAmount() *FeeScheduleUpsertBulk {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.UpdateMaxAmount()
+	})
+}
+
+// ClearMaxAmount clears the value of the "max_amount" field.
+func (u *FeeScheduleUpsertBulk) ClearMaxAmount() *FeeScheduleUpsertBulk {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.ClearMaxAmount()
+	})
+}
+
+// SetPercent sets the "percent" field.
+func (u *FeeScheduleUpsertBulk) SetPercent(v decimal.Decimal) *FeeScheduleUpsertBulk {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.SetPercent(v)
+	})
+}
+
+// AddPercent adds v to the "percent" field.
+func (u *FeeScheduleUpsertBulk) AddPercent(v decimal.Decimal) *FeeScheduleUpsertBulk {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.AddPercent(v)
+	})
+}
+
+// UpdatePercent sets the "percent" field to the value that was provided on create.
+func (u *FeeScheduleUpsertBulk) UpdatePercent() *FeeScheduleUpsertBulk {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.UpdatePercent()
+	})
+}
+
+// SetFlatAmount sets the "flat_amount" field.
+func (u *FeeScheduleUpsertBulk) SetFlatAmount(v decimal.Decimal) *FeeScheduleUpsertBulk {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.SetFlatAmount(v)
+	})
+}
+
+// AddFlatAmount adds v to the "flat_amount" field.
+func (u *FeeScheduleUpsertBulk) AddFlatAmount(v decimal.Decimal) *FeeScheduleUpsertBulk {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.AddFlatAmount(v)
+	})
+}
+
+// UpdateFlatAmount sets the "flat_amount" field to the value that was provided on create.
+func (u *FeeScheduleUpsertBulk) UpdateFlatAmount() *FeeScheduleUpsertBulk {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.UpdateFlatAmount()
+	})
+}
+
+// SetEffectiveFrom sets the "effective_from" field.
+func (u *FeeScheduleUpsertBulk) SetEffectiveFrom(v time.Time) *FeeScheduleUpsertBulk {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.SetEffectiveFrom(v)
+	})
+}
+
+// UpdateEffectiveFrom sets the "effective_from" field to the value that was provided on create.
+func (u *FeeScheduleUpsertBulk) UpdateEffectiveFrom() *FeeScheduleUpsertBulk {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.UpdateEffectiveFrom()
+	})
+}
+
+// SetEffectiveUntil sets the "effective_until" field.
+func (u *FeeScheduleUpsertBulk) SetEffectiveUntil(v time.Time) *FeeScheduleUpsertBulk {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.SetEffectiveUntil(v)
+	})
+}
+
+// UpdateEffectiveUntil sets the "effective_until" field to the value that was provided on create.
+func (u *FeeScheduleUpsertBulk) UpdateEffectiveUntil() *FeeScheduleUpsertBulk {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.UpdateEffectiveUntil()
+	})
+}
+
+// ClearEffectiveUntil clears the value of the "effective_until" field.
+func (u *FeeScheduleUpsertBulk) ClearEffectiveUntil() *FeeScheduleUpsertBulk {
+	return u.Update(func(s *FeeScheduleUpsert) {
+		s.ClearEffectiveUntil()
+	})
+}
+
+// Exec executes the query.
+func (u *FeeScheduleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the FeeScheduleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for FeeScheduleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *FeeScheduleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/feeschedule"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+)
+
+// FeeScheduleDelete is the builder for deleting a FeeSchedule entity.
+type FeeScheduleDelete struct {
+	config
+	hooks    []Hook
+	mutation *FeeScheduleMutation
+}
+
+// Where appends a list predicates to the FeeScheduleDelete builder.
+func (fsd *FeeScheduleDelete) Where(ps ...predicate.FeeSchedule) *FeeScheduleDelete {
+	fsd.mutation.Where(ps...)
+	return fsd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (fsd *FeeScheduleDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, fsd.sqlExec, fsd.mutation, fsd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fsd *FeeScheduleDelete) ExecX(ctx context.Context) int {
+	n, err := fsd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (fsd *FeeScheduleDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(feeschedule.Table, sqlgraph.NewFieldSpec(feeschedule.FieldID, field.TypeUUID))
+	if ps := fsd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, fsd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	fsd.mutation.done = true
+	return affected, err
+}
+
+// FeeScheduleDeleteOne is the builder for deleting a single FeeSchedule entity.
+type FeeScheduleDeleteOne struct {
+	fsd *FeeScheduleDelete
+}
+
+// Where appends a list predicates to the FeeScheduleDelete builder.
+func (fsdo *FeeScheduleDeleteOne) Where(ps ...predicate.FeeSchedule) *FeeScheduleDeleteOne {
+	fsdo.fsd.mutation.Where(ps...)
+	return fsdo
+}
+
+// Exec executes the deletion query.
+func (fsdo *FeeScheduleDeleteOne) Exec(ctx context.Context) error {
+	n, err := fsdo.fsd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{feeschedule.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fsdo *FeeScheduleDeleteOne) ExecX(ctx context.Context) {
+	if err := fsdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,615 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/feeschedule"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/NEDA-LABS/stablenode/ent/senderprofile"
+	"github.com/google/uuid"
+)
+
+// FeeScheduleQuery is the builder for querying FeeSchedule entities.
+type FeeScheduleQuery struct {
+	config
+	ctx               *QueryContext
+	order             []feeschedule.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.FeeSchedule
+	withSenderProfile *SenderProfileQuery
+	withFKs           bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the FeeScheduleQuery builder.
+func (fsq *FeeScheduleQuery) Where(ps ...predicate.FeeSchedule) *FeeScheduleQuery {
+	fsq.predicates = append(fsq.predicates, ps...)
+	return fsq
+}
+
+// Limit the number of records to be returned by this query.
+func (fsq *FeeScheduleQuery) Limit(limit int) *FeeScheduleQuery {
+	fsq.ctx.Limit = &limit
+	return fsq
+}
+
+// Offset to start from.
+func (fsq *FeeScheduleQuery) Offset(offset int) *FeeScheduleQuery {
+	fsq.ctx.Offset = &offset
+	return fsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (fsq *FeeScheduleQuery) Unique(unique bool) *FeeScheduleQuery {
+	fsq.ctx.Unique = &unique
+	return fsq
+}
+
+// Order specifies how the records should be ordered.
+func (fsq *FeeScheduleQuery) Order(o ...feeschedule.OrderOption) *FeeScheduleQuery {
+	fsq.order = append(fsq.order, o...)
+	return fsq
+}
+
+// QuerySenderProfile chains the current query on the "sender_profile" edge.
+func (fsq *FeeScheduleQuery) QuerySenderProfile() *SenderProfileQuery {
+	query := (&SenderProfileClient{config: fsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := fsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := fsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(feeschedule.Table, feeschedule.FieldID, selector),
+			sqlgraph.To(senderprofile.Table, senderprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, feeschedule.SenderProfileTable, feeschedule.SenderProfileColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(fsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first FeeSchedule entity from the query.
+// Returns a *NotFoundError when no FeeSchedule was found.
+func (fsq *FeeScheduleQuery) First(ctx context.Context) (*FeeSchedule, error) {
+	nodes, err := fsq.Limit(1).All(setContextOp(ctx, fsq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{feeschedule.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (fsq *FeeScheduleQuery) FirstX(ctx context.Context) *FeeSchedule {
+	node, err := fsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first FeeSchedule ID from the query.
+// Returns a *NotFoundError when no FeeSchedule ID was found.
+func (fsq *FeeScheduleQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = fsq.Limit(1).IDs(setContextOp(ctx, fsq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{feeschedule.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (fsq *FeeScheduleQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := fsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single FeeSchedule entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one FeeSchedule entity is found.
+// Returns a *NotFoundError when no FeeSchedule entities are found.
+func (fsq *FeeScheduleQuery) Only(ctx context.Context) (*FeeSchedule, error) {
+	nodes, err := fsq.Limit(2).All(setContextOp(ctx, fsq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{feeschedule.Label}
+	default:
+		return nil, &NotSingularError{feeschedule.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (fsq *FeeScheduleQuery) OnlyX(ctx context.Context) *FeeSchedule {
+	node, err := fsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only FeeSchedule ID in the query.
+// Returns a *NotSingularError when more than one FeeSchedule ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (fsq *FeeScheduleQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = fsq.Limit(2).IDs(setContextOp(ctx, fsq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{feeschedule.Label}
+	default:
+		err = &NotSingularError{feeschedule.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (fsq *FeeScheduleQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := fsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of FeeSchedules.
+func (fsq *FeeScheduleQuery) All(ctx context.Context) ([]*FeeSchedule, error) {
+	ctx = setContextOp(ctx, fsq.ctx, ent.OpQueryAll)
+	if err := fsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*FeeSchedule, *FeeScheduleQuery]()
+	return withInterceptors[[]*FeeSchedule](ctx, fsq, qr, fsq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (fsq *FeeScheduleQuery) AllX(ctx context.Context) []*FeeSchedule {
+	nodes, err := fsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of FeeSchedule IDs.
+func (fsq *FeeScheduleQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if fsq.ctx.Unique == nil && fsq.path != nil {
+		fsq.Unique(true)
+	}
+	ctx = setContextOp(ctx, fsq.ctx, ent.OpQueryIDs)
+	if err = fsq.Select(feeschedule.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (fsq *FeeScheduleQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := fsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (fsq *FeeScheduleQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, fsq.ctx, ent.OpQueryCount)
+	if err := fsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, fsq, querierCount[*FeeScheduleQuery](), fsq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (fsq *FeeScheduleQuery) CountX(ctx context.Context) int {
+	count, err := fsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (fsq *FeeScheduleQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, fsq.ctx, ent.OpQueryExist)
+	switch _, err := fsq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (fsq *FeeScheduleQuery) ExistX(ctx context.Context) bool {
+	exist, err := fsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the FeeScheduleQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (fsq *FeeScheduleQuery) Clone() *FeeScheduleQuery {
+	if fsq == nil {
+		return nil
+	}
+	return &FeeScheduleQuery{
+		config:            fsq.config,
+		ctx:               fsq.ctx.Clone(),
+		order:             append([]feeschedule.OrderOption{}, fsq.order...),
+		inters:            append([]Interceptor{}, fsq.inters...),
+		predicates:        append([]predicate.FeeSchedule{}, fsq.predicates...),
+		withSenderProfile: fsq.withSenderProfile.Clone(),
+		// clone intermediate query.
+		sql:  fsq.sql.Clone(),
+		path: fsq.path,
+	}
+}
+
+// WithSenderProfile tells the query-builder to eager-load the nodes that are connected to
+// the "sender_profile" edge. The optional arguments are used to configure the query builder of the edge.
+func (fsq *FeeScheduleQuery) WithSenderProfile(opts ...func(*SenderProfileQuery)) *FeeScheduleQuery {
+	query := (&SenderProfileClient{config: fsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	fsq.withSenderProfile = query
+	return fsq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.FeeSchedule.Query().
+//		GroupBy(feeschedule.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (fsq *FeeScheduleQuery) GroupBy(field string, fields ...string) *FeeScheduleGroupBy {
+	fsq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &FeeScheduleGroupBy{build: fsq}
+	grbuild.flds = &fsq.ctx.Fields
+	grbuild.label = feeschedule.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.FeeSchedule.Query().
+//		Select(feeschedule.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (fsq *FeeScheduleQuery) Select(fields ...string) *FeeScheduleSelect {
+	fsq.ctx.Fields = append(fsq.ctx.Fields, fields...)
+	sbuild := &FeeScheduleSelect{FeeScheduleQuery: fsq}
+	sbuild.label = feeschedule.Label
+	sbuild.flds, sbuild.scan = &fsq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a FeeScheduleSelect configured with the given aggregations.
+func (fsq *FeeScheduleQuery) Aggregate(fns ...AggregateFunc) *FeeScheduleSelect {
+	return fsq.Select().Aggregate(fns...)
+}
+
+func (fsq *FeeScheduleQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range fsq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, fsq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range fsq.ctx.Fields {
+		if !feeschedule.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if fsq.path != nil {
+		prev, err := fsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		fsq.sql = prev
+	}
+	return nil
+}
+
+func (fsq *FeeScheduleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*FeeSchedule, error) {
+	var (
+		nodes       = []*FeeSchedule{}
+		withFKs     = fsq.withFKs
+		_spec       = fsq.querySpec()
+		loadedTypes = [1]bool{
+			fsq.withSenderProfile != nil,
+		}
+	)
+	if fsq.withSenderProfile != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, feeschedule.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*FeeSchedule).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &FeeSchedule{config: fsq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, fsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := fsq.withSenderProfile; query != nil {
+		if err := fsq.loadSenderProfile(ctx, query, nodes, nil,
+			func(n *FeeSchedule, e *SenderProfile) { n.Edges.SenderProfile = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (fsq *FeeScheduleQuery) loadSenderProfile(ctx context.Context, query *SenderProfileQuery, nodes []*FeeSchedule, init func(*FeeSchedule), assign func(*FeeSchedule, *SenderProfile)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*FeeSchedule)
+	for i := range nodes {
+		if nodes[i].sender_profile_fee_schedules == nil {
+			continue
+		}
+		fk := *nodes[i].sender_profile_fee_schedules
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(senderprofile.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "sender_profile_fee_schedules" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (fsq *FeeScheduleQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := fsq.querySpec()
+	_spec.Node.Columns = fsq.ctx.Fields
+	if len(fsq.ctx.Fields) > 0 {
+		_spec.Unique = fsq.ctx.Unique != nil && *fsq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, fsq.driver, _spec)
+}
+
+func (fsq *FeeScheduleQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(feeschedule.Table, feeschedule.Columns, sqlgraph.NewFieldSpec(feeschedule.FieldID, field.TypeUUID))
+	_spec.From = fsq.sql
+	if unique := fsq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if fsq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := fsq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, feeschedule.FieldID)
+		for i := range fields {
+			if fields[i] != feeschedule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := fsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := fsq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := fsq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := fsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (fsq *FeeScheduleQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(fsq.driver.Dialect())
+	t1 := builder.Table(feeschedule.Table)
+	columns := fsq.ctx.Fields
+	if len(columns) == 0 {
+		columns = feeschedule.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if fsq.sql != nil {
+		selector = fsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if fsq.ctx.Unique != nil && *fsq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range fsq.predicates {
+		p(selector)
+	}
+	for _, p := range fsq.order {
+		p(selector)
+	}
+	if offset := fsq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := fsq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// FeeScheduleGroupBy is the group-by builder for FeeSchedule entities.
+type FeeScheduleGroupBy struct {
+	selector
+	build *FeeScheduleQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (fsgb *FeeScheduleGroupBy) Aggregate(fns ...AggregateFunc) *FeeScheduleGroupBy {
+	fsgb.fns = append(fsgb.fns, fns...)
+	return fsgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (fsgb *FeeScheduleGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, fsgb.build.ctx, ent.OpQueryGroupBy)
+	if err := fsgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*FeeScheduleQuery, *FeeScheduleGroupBy](ctx, fsgb.build, fsgb, fsgb.build.inters, v)
+}
+
+func (fsgb *FeeScheduleGroupBy) sqlScan(ctx context.Context, root *FeeScheduleQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(fsgb.fns))
+	for _, fn := range fsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*fsgb.flds)+len(fsgb.fns))
+		for _, f := range *fsgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*fsgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := fsgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// FeeScheduleSelect is the builder for selecting fields of FeeSchedule entities.
+type FeeScheduleSelect struct {
+	*FeeScheduleQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (fss *FeeScheduleSelect) Aggregate(fns ...AggregateFunc) *FeeScheduleSelect {
+	fss.fns = append(fss.fns, fns...)
+	return fss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (fss *FeeScheduleSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, fss.ctx, ent.OpQuerySelect)
+	if err := fss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*FeeScheduleQuery, *FeeScheduleSelect](ctx, fss.FeeScheduleQuery, fss, fss.inters, v)
+}
+
+func (fss *FeeScheduleSelect) sqlScan(ctx context.Context, root *FeeScheduleQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(fss.fns))
+	for _, fn := range fss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*fss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := fss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
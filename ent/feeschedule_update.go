@@ -0,0 +1,791 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/feeschedule"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/NEDA-LABS/stablenode/ent/senderprofile"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// FeeScheduleUpdate is the builder for updating FeeSchedule entities.
+type FeeScheduleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *FeeScheduleMutation
+}
+
+// Where appends a list predicates to the FeeScheduleUpdate builder.
+func (fsu *FeeScheduleUpdate) Where(ps ...predicate.FeeSchedule) *FeeScheduleUpdate {
+	fsu.mutation.Where(ps...)
+	return fsu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (fsu *FeeScheduleUpdate) SetUpdatedAt(t time.Time) *FeeScheduleUpdate {
+	fsu.mutation.SetUpdatedAt(t)
+	return fsu
+}
+
+// SetFeeType sets the "fee_type" field.
+func (fsu *FeeScheduleUpdate) SetFeeType(ft feeschedule.FeeType) *FeeScheduleUpdate {
+	fsu.mutation.SetFeeType(ft)
+	return fsu
+}
+
+// SetNillableFeeType sets the "fee_type" field if the given value is not nil.
+func (fsu *FeeScheduleUpdate) SetNillableFeeType(ft *feeschedule.FeeType) *FeeScheduleUpdate {
+	if ft != nil {
+		fsu.SetFeeType(*ft)
+	}
+	return fsu
+}
+
+// SetToken sets the "token" field.
+func (fsu *FeeScheduleUpdate) SetToken(s string) *FeeScheduleUpdate {
+	fsu.mutation.SetToken(s)
+	return fsu
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (fsu *FeeScheduleUpdate) SetNillableToken(s *string) *FeeScheduleUpdate {
+	if s != nil {
+		fsu.SetToken(*s)
+	}
+	return fsu
+}
+
+// SetNetwork sets the "network" field.
+func (fsu *FeeScheduleUpdate) SetNetwork(s string) *FeeScheduleUpdate {
+	fsu.mutation.SetNetwork(s)
+	return fsu
+}
+
+// SetNillableNetwork sets the "network" field if the given value is not nil.
+func (fsu *FeeScheduleUpdate) SetNillableNetwork(s *string) *FeeScheduleUpdate {
+	if s != nil {
+		fsu.SetNetwork(*s)
+	}
+	return fsu
+}
+
+// SetMinAmount sets the "min_amount" field.
+func (fsu *FeeScheduleUpdate) SetMinAmount(d decimal.Decimal) *FeeScheduleUpdate {
+	fsu.mutation.ResetMinAmount()
+	fsu.mutation.SetMinAmount(d)
+	return fsu
+}
+
+// SetNillableMinAmount sets the "min_amount" field if the given value is not nil.
+func (fsu *FeeScheduleUpdate) SetNillableMinAmount(d *decimal.Decimal) *FeeScheduleUpdate {
+	if d != nil {
+		fsu.SetMinAmount(*d)
+	}
+	return fsu
+}
+
+// AddMinAmount adds d to the "min_amount" field.
+func (fsu *FeeScheduleUpdate) AddMinAmount(d decimal.Decimal) *FeeScheduleUpdate {
+	fsu.mutation.AddMinAmount(d)
+	return fsu
+}
+
+// SetMaxAmount sets the "max_amount" field.
+func (fsu *FeeScheduleUpdate) SetMaxAmount(d decimal.Decimal) *FeeScheduleUpdate {
+	fsu.mutation.ResetMaxAmount()
+	fsu.mutation.SetMaxAmount(d)
+	return fsu
+}
+
+// SetNillableMaxAmount sets the "max_amount" field if the given value is not nil.
+func (fsu *FeeScheduleUpdate) SetNillableMaxAmount(d *decimal.Decimal) *FeeScheduleUpdate {
+	if d != nil {
+		fsu.SetMaxAmount(*d)
+	}
+	return fsu
+}
+
+// AddMaxAmount adds d to the "max_amount" field.
+func (fsu *FeeScheduleUpdate) AddMaxAmount(d decimal.Decimal) *FeeScheduleUpdate {
+	fsu.mutation.AddMaxAmount(d)
+	return fsu
+}
+
+// ClearMaxAmount clears the value of the "max_amount" field.
+func (fsu *FeeScheduleUpdate) ClearMaxAmount() *FeeScheduleUpdate {
+	fsu.mutation.ClearMaxAmount()
+	return fsu
+}
+
+// SetPercent sets the "percent" field.
+func (fsu *FeeScheduleUpdate) SetPercent(d decimal.Decimal) *FeeScheduleUpdate {
+	fsu.mutation.ResetPercent()
+	fsu.mutation.SetPercent(d)
+	return fsu
+}
+
+// SetNillablePercent sets the "percent" field if the given value is not nil.
+func (fsu *FeeScheduleUpdate) SetNillablePercent(d *decimal.Decimal) *FeeScheduleUpdate {
+	if d != nil {
+		fsu.SetPercent(*d)
+	}
+	return fsu
+}
+
+// AddPercent adds d to the "percent" field.
+func (fsu *FeeScheduleUpdate) AddPercent(d decimal.Decimal) *FeeScheduleUpdate {
+	fsu.mutation.AddPercent(d)
+	return fsu
+}
+
+// SetFlatAmount sets the "flat_amount" field.
+func (fsu *FeeScheduleUpdate) SetFlatAmount(d decimal.Decimal) *FeeScheduleUpdate {
+	fsu.mutation.ResetFlatAmount()
+	fsu.mutation.SetFlatAmount(d)
+	return fsu
+}
+
+// SetNillableFlatAmount sets the "flat_amount" field if the given value is not nil.
+func (fsu *FeeScheduleUpdate) SetNillableFlatAmount(d *decimal.Decimal) *FeeScheduleUpdate {
+	if d != nil {
+		fsu.SetFlatAmount(*d)
+	}
+	return fsu
+}
+
+// AddFlatAmount adds d to the "flat_amount" field.
+func (fsu *FeeScheduleUpdate) AddFlatAmount(d decimal.Decimal) *FeeScheduleUpdate {
+	fsu.mutation.AddFlatAmount(d)
+	return fsu
+}
+
+// SetEffectiveFrom sets the "effective_from" field.
+func (fsu *FeeScheduleUpdate) SetEffectiveFrom(t time.Time) *FeeScheduleUpdate {
+	fsu.mutation.SetEffectiveFrom(t)
+	return fsu
+}
+
+// SetNillableEffectiveFrom sets the "effective_from" field if the given value is not nil.
+func (fsu *FeeScheduleUpdate) SetNillableEffectiveFrom(t *time.Time) *FeeScheduleUpdate {
+	if t != nil {
+		fsu.SetEffectiveFrom(*t)
+	}
+	return fsu
+}
+
+// SetEffectiveUntil sets the "effective_until" field.
+func (fsu *FeeScheduleUpdate) SetEffectiveUntil(t time.Time) *FeeScheduleUpdate {
+	fsu.mutation.SetEffectiveUntil(t)
+	return fsu
+}
+
+// SetNillableEffectiveUntil sets the "effective_until" field if the given value is not nil.
+func (fsu *FeeScheduleUpdate) SetNillableEffectiveUntil(t *time.Time) *FeeScheduleUpdate {
+	if t != nil {
+		fsu.SetEffectiveUntil(*t)
+	}
+	return fsu
+}
+
+// ClearEffectiveUntil clears the value of the "effective_until" field.
+func (fsu *FeeScheduleUpdate) ClearEffectiveUntil() *FeeScheduleUpdate {
+	fsu.mutation.ClearEffectiveUntil()
+	return fsu
+}
+
+// SetSenderProfileID sets the "sender_profile" edge to the SenderProfile entity by ID.
+func (fsu *FeeScheduleUpdate) SetSenderProfileID(id uuid.UUID) *FeeScheduleUpdate {
+	fsu.mutation.SetSenderProfileID(id)
+	return fsu
+}
+
+// SetNillableSenderProfileID sets the "sender_profile" edge to the SenderProfile entity by ID if the given value is not nil.
+func (fsu *FeeScheduleUpdate) SetNillableSenderProfileID(id *uuid.UUID) *FeeScheduleUpdate {
+	if id != nil {
+		fsu = fsu.SetSenderProfileID(*id)
+	}
+	return fsu
+}
+
+// SetSenderProfile sets the "sender_profile" edge to the SenderProfile entity.
+func (fsu *FeeScheduleUpdate) SetSenderProfile(s *SenderProfile) *FeeScheduleUpdate {
+	return fsu.SetSenderProfileID(s.ID)
+}
+
+// Mutation returns the FeeScheduleMutation object of the builder.
+func (fsu *FeeScheduleUpdate) Mutation() *FeeScheduleMutation {
+	return fsu.mutation
+}
+
+// ClearSenderProfile clears the "sender_profile" edge to the SenderProfile entity.
+func (fsu *FeeScheduleUpdate) ClearSenderProfile() *FeeScheduleUpdate {
+	fsu.mutation.ClearSenderProfile()
+	return fsu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (fsu *FeeScheduleUpdate) Save(ctx context.Context) (int, error) {
+	fsu.defaults()
+	return withHooks(ctx, fsu.sqlSave, fsu.mutation, fsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fsu *FeeScheduleUpdate) SaveX(ctx context.Context) int {
+	affected, err := fsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (fsu *FeeScheduleUpdate) Exec(ctx context.Context) error {
+	_, err := fsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fsu *FeeScheduleUpdate) ExecX(ctx context.Context) {
+	if err := fsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fsu *FeeScheduleUpdate) defaults() {
+	if _, ok := fsu.mutation.UpdatedAt(); !ok {
+		v := feeschedule.UpdateDefaultUpdatedAt()
+		fsu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fsu *FeeScheduleUpdate) check() error {
+	if v, ok := fsu.mutation.FeeType(); ok {
+		if err := feeschedule.FeeTypeValidator(v); err != nil {
+			return &ValidationError{Name: "fee_type", err: fmt.Errorf(`ent: validator failed for field "FeeSchedule.fee_type": %w`, err)}
+		}
+	}
+	if v, ok := fsu.mutation.Token(); ok {
+		if err := feeschedule.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "FeeSchedule.token": %w`, err)}
+		}
+	}
+	if v, ok := fsu.mutation.Network(); ok {
+		if err := feeschedule.NetworkValidator(v); err != nil {
+			return &ValidationError{Name: "network", err: fmt.Errorf(`ent: validator failed for field "FeeSchedule.network": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (fsu *FeeScheduleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := fsu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(feeschedule.Table, feeschedule.Columns, sqlgraph.NewFieldSpec(feeschedule.FieldID, field.TypeUUID))
+	if ps := fsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fsu.mutation.UpdatedAt(); ok {
+		_spec.SetField(feeschedule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := fsu.mutation.FeeType(); ok {
+		_spec.SetField(feeschedule.FieldFeeType, field.TypeEnum, value)
+	}
+	if value, ok := fsu.mutation.Token(); ok {
+		_spec.SetField(feeschedule.FieldToken, field.TypeString, value)
+	}
+	if value, ok := fsu.mutation.Network(); ok {
+		_spec.SetField(feeschedule.FieldNetwork, field.TypeString, value)
+	}
+	if value, ok := fsu.mutation.MinAmount(); ok {
+		_spec.SetField(feeschedule.FieldMinAmount, field.TypeFloat64, value)
+	}
+	if value, ok := fsu.mutation.AddedMinAmount(); ok {
+		_spec.AddField(feeschedule.FieldMinAmount, field.TypeFloat64, value)
+	}
+	if value, ok := fsu.mutation.MaxAmount(); ok {
+		_spec.SetField(feeschedule.FieldMaxAmount, field.TypeFloat64, value)
+	}
+	if value, ok := fsu.mutation.AddedMaxAmount(); ok {
+		_spec.AddField(feeschedule.FieldMaxAmount, field.TypeFloat64, value)
+	}
+	if fsu.mutation.MaxAmountCleared() {
+		_spec.ClearField(feeschedule.FieldMaxAmount, field.TypeFloat64)
+	}
+	if value, ok := fsu.mutation.Percent(); ok {
+		_spec.SetField(feeschedule.FieldPercent, field.TypeFloat64, value)
+	}
+	if value, ok := fsu.mutation.AddedPercent(); ok {
+		_spec.AddField(feeschedule.FieldPercent, field.TypeFloat64, value)
+	}
+	if value, ok := fsu.mutation.FlatAmount(); ok {
+		_spec.SetField(feeschedule.FieldFlatAmount, field.TypeFloat64, value)
+	}
+	if value, ok := fsu.mutation.AddedFlatAmount(); ok {
+		_spec.AddField(feeschedule.FieldFlatAmount, field.TypeFloat64, value)
+	}
+	if value, ok := fsu.mutation.EffectiveFrom(); ok {
+		_spec.SetField(feeschedule.FieldEffectiveFrom, field.TypeTime, value)
+	}
+	if value, ok := fsu.mutation.EffectiveUntil(); ok {
+		_spec.SetField(feeschedule.FieldEffectiveUntil, field.TypeTime, value)
+	}
+	if fsu.mutation.EffectiveUntilCleared() {
+		_spec.ClearField(feeschedule.FieldEffectiveUntil, field.TypeTime)
+	}
+	if fsu.mutation.SenderProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   feeschedule.SenderProfileTable,
+			Columns: []string{feeschedule.SenderProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderprofile.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fsu.mutation.SenderProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   feeschedule.SenderProfileTable,
+			Columns: []string{feeschedule.SenderProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderprofile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, fsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{feeschedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	fsu.mutation.done = true
+	return n, nil
+}
+
+// FeeScheduleUpdateOne is the builder for updating a single FeeSchedule entity.
+type FeeScheduleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *FeeScheduleMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (fsuo *FeeScheduleUpdateOne) SetUpdatedAt(t time.Time) *FeeScheduleUpdateOne {
+	fsuo.mutation.SetUpdatedAt(t)
+	return fsuo
+}
+
+// SetFeeType sets the "fee_type" field.
+func (fsuo *FeeScheduleUpdateOne) SetFeeType(ft feeschedule.FeeType) *FeeScheduleUpdateOne {
+	fsuo.mutation.SetFeeType(ft)
+	return fsuo
+}
+
+// SetNillableFeeType sets the "fee_type" field if the given value is not nil.
+func (fsuo *FeeScheduleUpdateOne) SetNillableFeeType(ft *feeschedule.FeeType) *FeeScheduleUpdateOne {
+	if ft != nil {
+		fsuo.SetFeeType(*ft)
+	}
+	return fsuo
+}
+
+// SetToken sets the "token" field.
+func (fsuo *FeeScheduleUpdateOne) SetToken(s string) *FeeScheduleUpdateOne {
+	fsuo.mutation.SetToken(s)
+	return fsuo
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (fsuo *FeeScheduleUpdateOne) SetNillableToken(s *string) *FeeScheduleUpdateOne {
+	if s != nil {
+		fsuo.SetToken(*s)
+	}
+	return fsuo
+}
+
+// SetNetwork sets the "network" field.
+func (fsuo *FeeScheduleUpdateOne) SetNetwork(s string) *FeeScheduleUpdateOne {
+	fsuo.mutation.SetNetwork(s)
+	return fsuo
+}
+
+// SetNillableNetwork sets the "network" field if the given value is not nil.
+func (fsuo *FeeScheduleUpdateOne) SetNillableNetwork(s *string) *FeeScheduleUpdateOne {
+	if s != nil {
+		fsuo.SetNetwork(*s)
+	}
+	return fsuo
+}
+
+// SetMinAmount sets the "min_amount" field.
+func (fsuo *FeeScheduleUpdateOne) SetMinAmount(d decimal.Decimal) *FeeScheduleUpdateOne {
+	fsuo.mutation.ResetMinAmount()
+	fsuo.mutation.SetMinAmount(d)
+	return fsuo
+}
+
+// SetNillableMinAmount sets the "min_amount" field if the given value is not nil.
+func (fsuo *FeeScheduleUpdateOne) SetNillableMinAmount(d *decimal.Decimal) *FeeScheduleUpdateOne {
+	if d != nil {
+		fsuo.SetMinAmount(*d)
+	}
+	return fsuo
+}
+
+// AddMinAmount adds d to the "min_amount" field.
+func (fsuo *FeeScheduleUpdateOne) AddMinAmount(d decimal.Decimal) *FeeScheduleUpdateOne {
+	fsuo.mutation.AddMinAmount(d)
+	return fsuo
+}
+
+// SetMaxAmount sets the "max_amount" field.
+func (fsuo *FeeScheduleUpdateOne) SetMaxAmount(d decimal.Decimal) *FeeScheduleUpdateOne {
+	fsuo.mutation.ResetMaxAmount()
+	fsuo.mutation.SetMaxAmount(d)
+	return fsuo
+}
+
+// SetNillableMaxAmount sets the "max_amount" field if the given value is not nil.
+func (fsuo *FeeScheduleUpdateOne) SetNillableMaxAmount(d *decimal.Decimal) *FeeScheduleUpdateOne {
+	if d != nil {
+		fsuo.SetMaxAmount(*d)
+	}
+	return fsuo
+}
+
+// AddMaxAmount adds d to the "max_amount" field.
+func (fsuo *FeeScheduleUpdateOne) AddMaxAmount(d decimal.Decimal) *FeeScheduleUpdateOne {
+	fsuo.mutation.AddMaxAmount(d)
+	return fsuo
+}
+
+// ClearMaxAmount clears the value of the "max_amount" field.
+func (fsuo *FeeScheduleUpdateOne) ClearMaxAmount() *FeeScheduleUpdateOne {
+	fsuo.mutation.ClearMaxAmount()
+	return fsuo
+}
+
+// SetPercent sets the "percent" field.
+func (fsuo *FeeScheduleUpdateOne) SetPercent(d decimal.Decimal) *FeeScheduleUpdateOne {
+	fsuo.mutation.ResetPercent()
+	fsuo.mutation.SetPercent(d)
+	return fsuo
+}
+
+// SetNillablePercent sets the "percent" field if the given value is not nil.
+func (fsuo *FeeScheduleUpdateOne) SetNillablePercent(d *decimal.Decimal) *FeeScheduleUpdateOne {
+	if d != nil {
+		fsuo.SetPercent(*d)
+	}
+	return fsuo
+}
+
+// AddPercent adds d to the "percent" field.
+func (fsuo *FeeScheduleUpdateOne) AddPercent(d decimal.Decimal) *FeeScheduleUpdateOne {
+	fsuo.mutation.AddPercent(d)
+	return fsuo
+}
+
+// SetFlatAmount sets the "flat_amount" field.
+func (fsuo *FeeScheduleUpdateOne) SetFlatAmount(d decimal.Decimal) *FeeScheduleUpdateOne {
+	fsuo.mutation.ResetFlatAmount()
+	fsuo.mutation.SetFlatAmount(d)
+	return fsuo
+}
+
+// SetNillableFlatAmount sets the "flat_amount" field if the given value is not nil.
+func (fsuo *FeeScheduleUpdateOne) SetNillableFlatAmount(d *decimal.Decimal) *FeeScheduleUpdateOne {
+	if d != nil {
+		fsuo.SetFlatAmount(*d)
+	}
+	return fsuo
+}
+
+// AddFlatAmount adds d to the "flat_amount" field.
+func (fsuo *FeeScheduleUpdateOne) AddFlatAmount(d decimal.Decimal) *FeeScheduleUpdateOne {
+	fsuo.mutation.AddFlatAmount(d)
+	return fsuo
+}
+
+// SetEffectiveFrom sets the "effective_from" field.
+func (fsuo *FeeScheduleUpdateOne) SetEffectiveFrom(t time.Time) *FeeScheduleUpdateOne {
+	fsuo.mutation.SetEffectiveFrom(t)
+	return fsuo
+}
+
+// SetNillableEffectiveFrom sets the "effective_from" field if the given value is not nil.
+func (fsuo *FeeScheduleUpdateOne) SetNillableEffectiveFrom(t *time.Time) *FeeScheduleUpdateOne {
+	if t != nil {
+		fsuo.SetEffectiveFrom(*t)
+	}
+	return fsuo
+}
+
+// SetEffectiveUntil sets the "effective_until" field.
+func (fsuo *FeeScheduleUpdateOne) SetEffectiveUntil(t time.Time) *FeeScheduleUpdateOne {
+	fsuo.mutation.SetEffectiveUntil(t)
+	return fsuo
+}
+
+// SetNillableEffectiveUntil sets the "effective_until" field if the given value is not nil.
+func (fsuo *FeeScheduleUpdateOne) SetNillableEffectiveUntil(t *time.Time) *FeeScheduleUpdateOne {
+	if t != nil {
+		fsuo.SetEffectiveUntil(*t)
+	}
+	return fsuo
+}
+
+// ClearEffectiveUntil clears the value of the "effective_until" field.
+func (fsuo *FeeScheduleUpdateOne) ClearEffectiveUntil() *FeeScheduleUpdateOne {
+	fsuo.mutation.ClearEffectiveUntil()
+	return fsuo
+}
+
+// SetSenderProfileID sets the "sender_profile" edge to the SenderProfile entity by ID.
+func (fsuo *FeeScheduleUpdateOne) SetSenderProfileID(id uuid.UUID) *FeeScheduleUpdateOne {
+	fsuo.mutation.SetSenderProfileID(id)
+	return fsuo
+}
+
+// SetNillableSenderProfileID sets the "sender_profile" edge to the SenderProfile entity by ID if the given value is not nil.
+func (fsuo *FeeScheduleUpdateOne) SetNillableSenderProfileID(id *uuid.UUID) *FeeScheduleUpdateOne {
+	if id != nil {
+		fsuo = fsuo.SetSenderProfileID(*id)
+	}
+	return fsuo
+}
+
+// SetSenderProfile sets the "sender_profile" edge to the SenderProfile entity.
+func (fsuo *FeeScheduleUpdateOne) SetSenderProfile(s *SenderProfile) *FeeScheduleUpdateOne {
+	return fsuo.SetSenderProfileID(s.ID)
+}
+
+// Mutation returns the FeeScheduleMutation object of the builder.
+func (fsuo *FeeScheduleUpdateOne) Mutation() *FeeScheduleMutation {
+	return fsuo.mutation
+}
+
+// ClearSenderProfile clears the "sender_profile" edge to the SenderProfile entity.
+func (fsuo *FeeScheduleUpdateOne) ClearSenderProfile() *FeeScheduleUpdateOne {
+	fsuo.mutation.ClearSenderProfile()
+	return fsuo
+}
+
+// Where appends a list predicates to the FeeScheduleUpdate builder.
+func (fsuo *FeeScheduleUpdateOne) Where(ps ...predicate.FeeSchedule) *FeeScheduleUpdateOne {
+	fsuo.mutation.Where(ps...)
+	return fsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (fsuo *FeeScheduleUpdateOne) Select(field string, fields ...string) *FeeScheduleUpdateOne {
+	fsuo.fields = append([]string{field}, fields...)
+	return fsuo
+}
+
+// Save executes the query and returns the updated FeeSchedule entity.
+func (fsuo *FeeScheduleUpdateOne) Save(ctx context.Context) (*FeeSchedule, error) {
+	fsuo.defaults()
+	return withHooks(ctx, fsuo.sqlSave, fsuo.mutation, fsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fsuo *FeeScheduleUpdateOne) SaveX(ctx context.Context) *FeeSchedule {
+	node, err := fsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (fsuo *FeeScheduleUpdateOne) Exec(ctx context.Context) error {
+	_, err := fsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fsuo *FeeScheduleUpdateOne) ExecX(ctx context.Context) {
+	if err := fsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fsuo *FeeScheduleUpdateOne) defaults() {
+	if _, ok := fsuo.mutation.UpdatedAt(); !ok {
+		v := feeschedule.UpdateDefaultUpdatedAt()
+		fsuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fsuo *FeeScheduleUpdateOne) check() error {
+	if v, ok := fsuo.mutation.FeeType(); ok {
+		if err := feeschedule.FeeTypeValidator(v); err != nil {
+			return &ValidationError{Name: "fee_type", err: fmt.Errorf(`ent: validator failed for field "FeeSchedule.fee_type": %w`, err)}
+		}
+	}
+	if v, ok := fsuo.mutation.Token(); ok {
+		if err := feeschedule.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "FeeSchedule.token": %w`, err)}
+		}
+	}
+	if v, ok := fsuo.mutation.Network(); ok {
+		if err := feeschedule.NetworkValidator(v); err != nil {
+			return &ValidationError{Name: "network", err: fmt.Errorf(`ent: validator failed for field "FeeSchedule.network": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (fsuo *FeeScheduleUpdateOne) sqlSave(ctx context.Context) (_node *FeeSchedule, err error) {
+	if err := fsuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(feeschedule.Table, feeschedule.Columns, sqlgraph.NewFieldSpec(feeschedule.FieldID, field.TypeUUID))
+	id, ok := fsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FeeSchedule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := fsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, feeschedule.FieldID)
+		for _, f := range fields {
+			if !feeschedule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != feeschedule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := fsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fsuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(feeschedule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := fsuo.mutation.FeeType(); ok {
+		_spec.SetField(feeschedule.FieldFeeType, field.TypeEnum, value)
+	}
+	if value, ok := fsuo.mutation.Token(); ok {
+		_spec.SetField(feeschedule.FieldToken, field.TypeString, value)
+	}
+	if value, ok := fsuo.mutation.Network(); ok {
+		_spec.SetField(feeschedule.FieldNetwork, field.TypeString, value)
+	}
+	if value, ok := fsuo.mutation.MinAmount(); ok {
+		_spec.SetField(feeschedule.FieldMinAmount, field.TypeFloat64, value)
+	}
+	if value, ok := fsuo.mutation.AddedMinAmount(); ok {
+		_spec.AddField(feeschedule.FieldMinAmount, field.TypeFloat64, value)
+	}
+	if value, ok := fsuo.mutation.MaxAmount(); ok {
+		_spec.SetField(feeschedule.FieldMaxAmount, field.TypeFloat64, value)
+	}
+	if value, ok := fsuo.mutation.AddedMaxAmount(); ok {
+		_spec.AddField(feeschedule.FieldMaxAmount, field.TypeFloat64, value)
+	}
+	if fsuo.mutation.MaxAmountCleared() {
+		_spec.ClearField(feeschedule.FieldMaxAmount, field.TypeFloat64)
+	}
+	if value, ok := fsuo.mutation.Percent(); ok {
+		_spec.SetField(feeschedule.FieldPercent, field.TypeFloat64, value)
+	}
+	if value, ok := fsuo.mutation.AddedPercent(); ok {
+		_spec.AddField(feeschedule.FieldPercent, field.TypeFloat64, value)
+	}
+	if value, ok := fsuo.mutation.FlatAmount(); ok {
+		_spec.SetField(feeschedule.FieldFlatAmount, field.TypeFloat64, value)
+	}
+	if value, ok := fsuo.mutation.AddedFlatAmount(); ok {
+		_spec.AddField(feeschedule.FieldFlatAmount, field.TypeFloat64, value)
+	}
+	if value, ok := fsuo.mutation.EffectiveFrom(); ok {
+		_spec.SetField(feeschedule.FieldEffectiveFrom, field.TypeTime, value)
+	}
+	if value, ok := fsuo.mutation.EffectiveUntil(); ok {
+		_spec.SetField(feeschedule.FieldEffectiveUntil, field.TypeTime, value)
+	}
+	if fsuo.mutation.EffectiveUntilCleared() {
+		_spec.ClearField(feeschedule.FieldEffectiveUntil, field.TypeTime)
+	}
+	if fsuo.mutation.SenderProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   feeschedule.SenderProfileTable,
+			Columns: []string{feeschedule.SenderProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderprofile.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fsuo.mutation.SenderProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   feeschedule.SenderProfileTable,
+			Columns: []string{feeschedule.SenderProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderprofile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &FeeSchedule{config: fsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, fsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{feeschedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	fsuo.mutation.done = true
+	return _node, nil
+}
@@ -21,6 +21,18 @@ func (f APIKeyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, erro
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.APIKeyMutation", m)
 }
 
+// The AdminAuditLogFunc type is an adapter to allow the use of ordinary
+// function as AdminAuditLog mutator.
+type AdminAuditLogFunc func(context.Context, *ent.AdminAuditLogMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AdminAuditLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AdminAuditLogMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AdminAuditLogMutation", m)
+}
+
 // The BeneficialOwnerFunc type is an adapter to allow the use of ordinary
 // function as BeneficialOwner mutator.
 type BeneficialOwnerFunc func(context.Context, *ent.BeneficialOwnerMutation) (ent.Value, error)
@@ -33,6 +45,66 @@ func (f BeneficialOwnerFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Va
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BeneficialOwnerMutation", m)
 }
 
+// The BlockchainRouteFunc type is an adapter to allow the use of ordinary
+// function as BlockchainRoute mutator.
+type BlockchainRouteFunc func(context.Context, *ent.BlockchainRouteMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BlockchainRouteFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BlockchainRouteMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BlockchainRouteMutation", m)
+}
+
+// The DeadLetterFunc type is an adapter to allow the use of ordinary
+// function as DeadLetter mutator.
+type DeadLetterFunc func(context.Context, *ent.DeadLetterMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DeadLetterFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DeadLetterMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DeadLetterMutation", m)
+}
+
+// The DenylistedAddressFunc type is an adapter to allow the use of ordinary
+// function as DenylistedAddress mutator.
+type DenylistedAddressFunc func(context.Context, *ent.DenylistedAddressMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DenylistedAddressFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DenylistedAddressMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DenylistedAddressMutation", m)
+}
+
+// The DepositSplitFunc type is an adapter to allow the use of ordinary
+// function as DepositSplit mutator.
+type DepositSplitFunc func(context.Context, *ent.DepositSplitMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DepositSplitFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DepositSplitMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DepositSplitMutation", m)
+}
+
+// The FeeScheduleFunc type is an adapter to allow the use of ordinary
+// function as FeeSchedule mutator.
+type FeeScheduleFunc func(context.Context, *ent.FeeScheduleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FeeScheduleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.FeeScheduleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FeeScheduleMutation", m)
+}
+
 // The FiatCurrencyFunc type is an adapter to allow the use of ordinary
 // function as FiatCurrency mutator.
 type FiatCurrencyFunc func(context.Context, *ent.FiatCurrencyMutation) (ent.Value, error)
@@ -81,6 +153,42 @@ func (f KYBProfileFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.KYBProfileMutation", m)
 }
 
+// The LedgerAccountFunc type is an adapter to allow the use of ordinary
+// function as LedgerAccount mutator.
+type LedgerAccountFunc func(context.Context, *ent.LedgerAccountMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LedgerAccountFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LedgerAccountMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LedgerAccountMutation", m)
+}
+
+// The LedgerEntryFunc type is an adapter to allow the use of ordinary
+// function as LedgerEntry mutator.
+type LedgerEntryFunc func(context.Context, *ent.LedgerEntryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LedgerEntryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LedgerEntryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LedgerEntryMutation", m)
+}
+
+// The LedgerPostingFunc type is an adapter to allow the use of ordinary
+// function as LedgerPosting mutator.
+type LedgerPostingFunc func(context.Context, *ent.LedgerPostingMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LedgerPostingFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LedgerPostingMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LedgerPostingMutation", m)
+}
+
 // The LinkedAddressFunc type is an adapter to allow the use of ordinary
 // function as LinkedAddress mutator.
 type LinkedAddressFunc func(context.Context, *ent.LinkedAddressMutation) (ent.Value, error)
@@ -105,6 +213,18 @@ func (f LockOrderFulfillmentFunc) Mutate(ctx context.Context, m ent.Mutation) (e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LockOrderFulfillmentMutation", m)
 }
 
+// The LockOrderReassignmentFunc type is an adapter to allow the use of ordinary
+// function as LockOrderReassignment mutator.
+type LockOrderReassignmentFunc func(context.Context, *ent.LockOrderReassignmentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LockOrderReassignmentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LockOrderReassignmentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LockOrderReassignmentMutation", m)
+}
+
 // The LockPaymentOrderFunc type is an adapter to allow the use of ordinary
 // function as LockPaymentOrder mutator.
 type LockPaymentOrderFunc func(context.Context, *ent.LockPaymentOrderMutation) (ent.Value, error)
@@ -129,6 +249,18 @@ func (f NetworkFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NetworkMutation", m)
 }
 
+// The OutboxTransactionFunc type is an adapter to allow the use of ordinary
+// function as OutboxTransaction mutator.
+type OutboxTransactionFunc func(context.Context, *ent.OutboxTransactionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OutboxTransactionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OutboxTransactionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OutboxTransactionMutation", m)
+}
+
 // The PaymentOrderFunc type is an adapter to allow the use of ordinary
 // function as PaymentOrder mutator.
 type PaymentOrderFunc func(context.Context, *ent.PaymentOrderMutation) (ent.Value, error)
@@ -165,6 +297,18 @@ func (f PaymentWebhookFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PaymentWebhookMutation", m)
 }
 
+// The ProviderBalanceSnapshotFunc type is an adapter to allow the use of ordinary
+// function as ProviderBalanceSnapshot mutator.
+type ProviderBalanceSnapshotFunc func(context.Context, *ent.ProviderBalanceSnapshotMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ProviderBalanceSnapshotFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ProviderBalanceSnapshotMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProviderBalanceSnapshotMutation", m)
+}
+
 // The ProviderCurrenciesFunc type is an adapter to allow the use of ordinary
 // function as ProviderCurrencies mutator.
 type ProviderCurrenciesFunc func(context.Context, *ent.ProviderCurrenciesMutation) (ent.Value, error)
@@ -189,6 +333,18 @@ func (f ProviderOrderTokenFunc) Mutate(ctx context.Context, m ent.Mutation) (ent
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProviderOrderTokenMutation", m)
 }
 
+// The ProviderPerformanceFunc type is an adapter to allow the use of ordinary
+// function as ProviderPerformance mutator.
+type ProviderPerformanceFunc func(context.Context, *ent.ProviderPerformanceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ProviderPerformanceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ProviderPerformanceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProviderPerformanceMutation", m)
+}
+
 // The ProviderProfileFunc type is an adapter to allow the use of ordinary
 // function as ProviderProfile mutator.
 type ProviderProfileFunc func(context.Context, *ent.ProviderProfileMutation) (ent.Value, error)
@@ -225,6 +381,18 @@ func (f ProvisionBucketFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Va
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProvisionBucketMutation", m)
 }
 
+// The RPCEndpointFunc type is an adapter to allow the use of ordinary
+// function as RPCEndpoint mutator.
+type RPCEndpointFunc func(context.Context, *ent.RPCEndpointMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RPCEndpointFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RPCEndpointMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RPCEndpointMutation", m)
+}
+
 // The ReceiveAddressFunc type is an adapter to allow the use of ordinary
 // function as ReceiveAddress mutator.
 type ReceiveAddressFunc func(context.Context, *ent.ReceiveAddressMutation) (ent.Value, error)
@@ -237,6 +405,42 @@ func (f ReceiveAddressFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReceiveAddressMutation", m)
 }
 
+// The ReceiveAddressAssignmentFunc type is an adapter to allow the use of ordinary
+// function as ReceiveAddressAssignment mutator.
+type ReceiveAddressAssignmentFunc func(context.Context, *ent.ReceiveAddressAssignmentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ReceiveAddressAssignmentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ReceiveAddressAssignmentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReceiveAddressAssignmentMutation", m)
+}
+
+// The ReconciliationDiscrepancyFunc type is an adapter to allow the use of ordinary
+// function as ReconciliationDiscrepancy mutator.
+type ReconciliationDiscrepancyFunc func(context.Context, *ent.ReconciliationDiscrepancyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ReconciliationDiscrepancyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ReconciliationDiscrepancyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReconciliationDiscrepancyMutation", m)
+}
+
+// The ReconciliationReportFunc type is an adapter to allow the use of ordinary
+// function as ReconciliationReport mutator.
+type ReconciliationReportFunc func(context.Context, *ent.ReconciliationReportMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ReconciliationReportFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ReconciliationReportMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReconciliationReportMutation", m)
+}
+
 // The SenderOrderTokenFunc type is an adapter to allow the use of ordinary
 // function as SenderOrderToken mutator.
 type SenderOrderTokenFunc func(context.Context, *ent.SenderOrderTokenMutation) (ent.Value, error)
@@ -261,6 +465,18 @@ func (f SenderProfileFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Valu
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SenderProfileMutation", m)
 }
 
+// The SweepFunc type is an adapter to allow the use of ordinary
+// function as Sweep mutator.
+type SweepFunc func(context.Context, *ent.SweepMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SweepFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SweepMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SweepMutation", m)
+}
+
 // The TokenFunc type is an adapter to allow the use of ordinary
 // function as Token mutator.
 type TokenFunc func(context.Context, *ent.TokenMutation) (ent.Value, error)
@@ -285,6 +501,18 @@ func (f TransactionLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TransactionLogMutation", m)
 }
 
+// The UnmatchedDepositFunc type is an adapter to allow the use of ordinary
+// function as UnmatchedDeposit mutator.
+type UnmatchedDepositFunc func(context.Context, *ent.UnmatchedDepositMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UnmatchedDepositFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.UnmatchedDepositMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UnmatchedDepositMutation", m)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary
 // function as User mutator.
 type UserFunc func(context.Context, *ent.UserMutation) (ent.Value, error)
@@ -309,6 +537,30 @@ func (f VerificationTokenFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.VerificationTokenMutation", m)
 }
 
+// The WebhookDeliveryFunc type is an adapter to allow the use of ordinary
+// function as WebhookDelivery mutator.
+type WebhookDeliveryFunc func(context.Context, *ent.WebhookDeliveryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookDeliveryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WebhookDeliveryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WebhookDeliveryMutation", m)
+}
+
+// The WebhookDestinationFunc type is an adapter to allow the use of ordinary
+// function as WebhookDestination mutator.
+type WebhookDestinationFunc func(context.Context, *ent.WebhookDestinationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookDestinationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WebhookDestinationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WebhookDestinationMutation", m)
+}
+
 // The WebhookRetryAttemptFunc type is an adapter to allow the use of ordinary
 // function as WebhookRetryAttempt mutator.
 type WebhookRetryAttemptFunc func(context.Context, *ent.WebhookRetryAttemptMutation) (ent.Value, error)
@@ -0,0 +1,209 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/NEDA-LABS/stablenode/ent/ledgeraccount"
+	"github.com/NEDA-LABS/stablenode/ent/token"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// LedgerAccount is the model entity for the LedgerAccount schema.
+type LedgerAccount struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Name holds the value of the "name" field.
+	Name ledgeraccount.Name `json:"name,omitempty"`
+	// Type holds the value of the "type" field.
+	Type ledgeraccount.Type `json:"type,omitempty"`
+	// Balance holds the value of the "balance" field.
+	Balance decimal.Decimal `json:"balance,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LedgerAccountQuery when eager-loading is set.
+	Edges                 LedgerAccountEdges `json:"edges"`
+	token_ledger_accounts *int
+	selectValues          sql.SelectValues
+}
+
+// LedgerAccountEdges holds the relations/edges for other nodes in the graph.
+type LedgerAccountEdges struct {
+	// Token holds the value of the token edge.
+	Token *Token `json:"token,omitempty"`
+	// Postings holds the value of the postings edge.
+	Postings []*LedgerPosting `json:"postings,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// TokenOrErr returns the Token value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LedgerAccountEdges) TokenOrErr() (*Token, error) {
+	if e.Token != nil {
+		return e.Token, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: token.Label}
+	}
+	return nil, &NotLoadedError{edge: "token"}
+}
+
+// PostingsOrErr returns the Postings value or an error if the edge
+// was not loaded in eager-loading.
+func (e LedgerAccountEdges) PostingsOrErr() ([]*LedgerPosting, error) {
+	if e.loadedTypes[1] {
+		return e.Postings, nil
+	}
+	return nil, &NotLoadedError{edge: "postings"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LedgerAccount) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ledgeraccount.FieldBalance:
+			values[i] = new(decimal.Decimal)
+		case ledgeraccount.FieldName, ledgeraccount.FieldType:
+			values[i] = new(sql.NullString)
+		case ledgeraccount.FieldCreatedAt, ledgeraccount.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case ledgeraccount.FieldID:
+			values[i] = new(uuid.UUID)
+		case ledgeraccount.ForeignKeys[0]: // token_ledger_accounts
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LedgerAccount fields.
+func (la *LedgerAccount) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ledgeraccount.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				la.ID = *value
+			}
+		case ledgeraccount.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				la.CreatedAt = value.Time
+			}
+		case ledgeraccount.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				la.UpdatedAt = value.Time
+			}
+		case ledgeraccount.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				la.Name = ledgeraccount.Name(value.String)
+			}
+		case ledgeraccount.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				la.Type = ledgeraccount.Type(value.String)
+			}
+		case ledgeraccount.FieldBalance:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field balance", values[i])
+			} else if value != nil {
+				la.Balance = *value
+			}
+		case ledgeraccount.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field token_ledger_accounts", value)
+			} else if value.Valid {
+				la.token_ledger_accounts = new(int)
+				*la.token_ledger_accounts = int(value.Int64)
+			}
+		default:
+			la.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LedgerAccount.
+// This includes values selected through modifiers, order, etc.
+func (la *LedgerAccount) Value(name string) (ent.Value, error) {
+	return la.selectValues.Get(name)
+}
+
+// QueryToken queries the "token" edge of the LedgerAccount entity.
+func (la *LedgerAccount) QueryToken() *TokenQuery {
+	return NewLedgerAccountClient(la.config).QueryToken(la)
+}
+
+// QueryPostings queries the "postings" edge of the LedgerAccount entity.
+func (la *LedgerAccount) QueryPostings() *LedgerPostingQuery {
+	return NewLedgerAccountClient(la.config).QueryPostings(la)
+}
+
+// Update returns a builder for updating this LedgerAccount.
+// Note that you need to call LedgerAccount.Unwrap() before calling this method if this LedgerAccount
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (la *LedgerAccount) Update() *LedgerAccountUpdateOne {
+	return NewLedgerAccountClient(la.config).UpdateOne(la)
+}
+
+// Unwrap unwraps the LedgerAccount entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (la *LedgerAccount) Unwrap() *LedgerAccount {
+	_tx, ok := la.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LedgerAccount is not a transactional entity")
+	}
+	la.config.driver = _tx.drv
+	return la
+}
+
+// String implements the fmt.Stringer.
+func (la *LedgerAccount) String() string {
+	var builder strings.Builder
+	builder.WriteString("LedgerAccount(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", la.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(la.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(la.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(fmt.Sprintf("%v", la.Name))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", la.Type))
+	builder.WriteString(", ")
+	builder.WriteString("balance=")
+	builder.WriteString(fmt.Sprintf("%v", la.Balance))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LedgerAccounts is a parsable slice of LedgerAccount.
+type LedgerAccounts []*LedgerAccount
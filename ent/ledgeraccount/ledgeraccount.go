@@ -0,0 +1,213 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ledgeraccount
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// Label holds the string label denoting the ledgeraccount type in the database.
+	Label = "ledger_account"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldBalance holds the string denoting the balance field in the database.
+	FieldBalance = "balance"
+	// EdgeToken holds the string denoting the token edge name in mutations.
+	EdgeToken = "token"
+	// EdgePostings holds the string denoting the postings edge name in mutations.
+	EdgePostings = "postings"
+	// Table holds the table name of the ledgeraccount in the database.
+	Table = "ledger_accounts"
+	// TokenTable is the table that holds the token relation/edge.
+	TokenTable = "ledger_accounts"
+	// TokenInverseTable is the table name for the Token entity.
+	// It exists in this package in order to avoid circular dependency with the "token" package.
+	TokenInverseTable = "tokens"
+	// TokenColumn is the table column denoting the token relation/edge.
+	TokenColumn = "token_ledger_accounts"
+	// PostingsTable is the table that holds the postings relation/edge.
+	PostingsTable = "ledger_postings"
+	// PostingsInverseTable is the table name for the LedgerPosting entity.
+	// It exists in this package in order to avoid circular dependency with the "ledgerposting" package.
+	PostingsInverseTable = "ledger_postings"
+	// PostingsColumn is the table column denoting the postings relation/edge.
+	PostingsColumn = "ledger_account_postings"
+)
+
+// Columns holds all SQL columns for ledgeraccount fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldName,
+	FieldType,
+	FieldBalance,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "ledger_accounts"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"token_ledger_accounts",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultBalance holds the default value on creation for the "balance" field.
+	DefaultBalance func() decimal.Decimal
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Name defines the type for the "name" enum field.
+type Name string
+
+// Name values.
+const (
+	NameReceiveAddresses Name = "receive_addresses"
+	NameGatewayEscrow    Name = "gateway_escrow"
+	NameSwept            Name = "swept"
+	NameOrderFunds       Name = "order_funds"
+	NameSenderFees       Name = "sender_fees"
+	NameNetworkFees      Name = "network_fees"
+)
+
+func (n Name) String() string {
+	return string(n)
+}
+
+// NameValidator is a validator for the "name" field enum values. It is called by the builders before save.
+func NameValidator(n Name) error {
+	switch n {
+	case NameReceiveAddresses, NameGatewayEscrow, NameSwept, NameOrderFunds, NameSenderFees, NameNetworkFees:
+		return nil
+	default:
+		return fmt.Errorf("ledgeraccount: invalid enum value for name field: %q", n)
+	}
+}
+
+// Type defines the type for the "type" enum field.
+type Type string
+
+// Type values.
+const (
+	TypeAsset     Type = "asset"
+	TypeLiability Type = "liability"
+	TypeRevenue   Type = "revenue"
+)
+
+func (_type Type) String() string {
+	return string(_type)
+}
+
+// TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
+func TypeValidator(_type Type) error {
+	switch _type {
+	case TypeAsset, TypeLiability, TypeRevenue:
+		return nil
+	default:
+		return fmt.Errorf("ledgeraccount: invalid enum value for type field: %q", _type)
+	}
+}
+
+// OrderOption defines the ordering options for the LedgerAccount queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByBalance orders the results by the balance field.
+func ByBalance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBalance, opts...).ToFunc()
+}
+
+// ByTokenField orders the results by token field.
+func ByTokenField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTokenStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPostingsCount orders the results by postings count.
+func ByPostingsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPostingsStep(), opts...)
+	}
+}
+
+// ByPostings orders the results by postings terms.
+func ByPostings(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPostingsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newTokenStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TokenInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TokenTable, TokenColumn),
+	)
+}
+func newPostingsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PostingsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PostingsTable, PostingsColumn),
+	)
+}
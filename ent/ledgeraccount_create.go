@@ -0,0 +1,750 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/ledgeraccount"
+	"github.com/NEDA-LABS/stablenode/ent/ledgerposting"
+	"github.com/NEDA-LABS/stablenode/ent/token"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// LedgerAccountCreate is the builder for creating a LedgerAccount entity.
+type LedgerAccountCreate struct {
+	config
+	mutation *LedgerAccountMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (lac *LedgerAccountCreate) SetCreatedAt(t time.Time) *LedgerAccountCreate {
+	lac.mutation.SetCreatedAt(t)
+	return lac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (lac *LedgerAccountCreate) SetNillableCreatedAt(t *time.Time) *LedgerAccountCreate {
+	if t != nil {
+		lac.SetCreatedAt(*t)
+	}
+	return lac
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (lac *LedgerAccountCreate) SetUpdatedAt(t time.Time) *LedgerAccountCreate {
+	lac.mutation.SetUpdatedAt(t)
+	return lac
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (lac *LedgerAccountCreate) SetNillableUpdatedAt(t *time.Time) *LedgerAccountCreate {
+	if t != nil {
+		lac.SetUpdatedAt(*t)
+	}
+	return lac
+}
+
+// SetName sets the "name" field.
+func (lac *LedgerAccountCreate) SetName(l ledgeraccount.Name) *LedgerAccountCreate {
+	lac.mutation.SetName(l)
+	return lac
+}
+
+// SetType sets the "type" field.
+func (lac *LedgerAccountCreate) SetType(l ledgeraccount.Type) *LedgerAccountCreate {
+	lac.mutation.SetType(l)
+	return lac
+}
+
+// SetBalance sets the "balance" field.
+func (lac *LedgerAccountCreate) SetBalance(d decimal.Decimal) *LedgerAccountCreate {
+	lac.mutation.SetBalance(d)
+	return lac
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (lac *LedgerAccountCreate) SetNillableBalance(d *decimal.Decimal) *LedgerAccountCreate {
+	if d != nil {
+		lac.SetBalance(*d)
+	}
+	return lac
+}
+
+// SetID sets the "id" field.
+func (lac *LedgerAccountCreate) SetID(u uuid.UUID) *LedgerAccountCreate {
+	lac.mutation.SetID(u)
+	return lac
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (lac *LedgerAccountCreate) SetNillableID(u *uuid.UUID) *LedgerAccountCreate {
+	if u != nil {
+		lac.SetID(*u)
+	}
+	return lac
+}
+
+// SetTokenID sets the "token" edge to the Token entity by ID.
+func (lac *LedgerAccountCreate) SetTokenID(id int) *LedgerAccountCreate {
+	lac.mutation.SetTokenID(id)
+	return lac
+}
+
+// SetToken sets the "token" edge to the Token entity.
+func (lac *LedgerAccountCreate) SetToken(t *Token) *LedgerAccountCreate {
+	return lac.SetTokenID(t.ID)
+}
+
+// AddPostingIDs adds the "postings" edge to the LedgerPosting entity by IDs.
+func (lac *LedgerAccountCreate) AddPostingIDs(ids ...uuid.UUID) *LedgerAccountCreate {
+	lac.mutation.AddPostingIDs(ids...)
+	return lac
+}
+
+// AddPostings adds the "postings" edges to the LedgerPosting entity.
+func (lac *LedgerAccountCreate) AddPostings(l ...*LedgerPosting) *LedgerAccountCreate {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return lac.AddPostingIDs(ids...)
+}
+
+// Mutation returns the LedgerAccountMutation object of the builder.
+func (lac *LedgerAccountCreate) Mutation() *LedgerAccountMutation {
+	return lac.mutation
+}
+
+// Save creates the LedgerAccount in the database.
+func (lac *LedgerAccountCreate) Save(ctx context.Context) (*LedgerAccount, error) {
+	lac.defaults()
+	return withHooks(ctx, lac.sqlSave, lac.mutation, lac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lac *LedgerAccountCreate) SaveX(ctx context.Context) *LedgerAccount {
+	v, err := lac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lac *LedgerAccountCreate) Exec(ctx context.Context) error {
+	_, err := lac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lac *LedgerAccountCreate) ExecX(ctx context.Context) {
+	if err := lac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lac *LedgerAccountCreate) defaults() {
+	if _, ok := lac.mutation.CreatedAt(); !ok {
+		v := ledgeraccount.DefaultCreatedAt()
+		lac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := lac.mutation.UpdatedAt(); !ok {
+		v := ledgeraccount.DefaultUpdatedAt()
+		lac.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := lac.mutation.Balance(); !ok {
+		v := ledgeraccount.DefaultBalance()
+		lac.mutation.SetBalance(v)
+	}
+	if _, ok := lac.mutation.ID(); !ok {
+		v := ledgeraccount.DefaultID()
+		lac.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lac *LedgerAccountCreate) check() error {
+	if _, ok := lac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LedgerAccount.created_at"`)}
+	}
+	if _, ok := lac.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "LedgerAccount.updated_at"`)}
+	}
+	if _, ok := lac.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "LedgerAccount.name"`)}
+	}
+	if v, ok := lac.mutation.Name(); ok {
+		if err := ledgeraccount.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "LedgerAccount.name": %w`, err)}
+		}
+	}
+	if _, ok := lac.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "LedgerAccount.type"`)}
+	}
+	if v, ok := lac.mutation.GetType(); ok {
+		if err := ledgeraccount.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "LedgerAccount.type": %w`, err)}
+		}
+	}
+	if _, ok := lac.mutation.Balance(); !ok {
+		return &ValidationError{Name: "balance", err: errors.New(`ent: missing required field "LedgerAccount.balance"`)}
+	}
+	if len(lac.mutation.TokenIDs()) == 0 {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required edge "LedgerAccount.token"`)}
+	}
+	return nil
+}
+
+func (lac *LedgerAccountCreate) sqlSave(ctx context.Context) (*LedgerAccount, error) {
+	if err := lac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := lac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	lac.mutation.id = &_node.ID
+	lac.mutation.done = true
+	return _node, nil
+}
+
+func (lac *LedgerAccountCreate) createSpec() (*LedgerAccount, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LedgerAccount{config: lac.config}
+		_spec = sqlgraph.NewCreateSpec(ledgeraccount.Table, sqlgraph.NewFieldSpec(ledgeraccount.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = lac.conflict
+	if id, ok := lac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := lac.mutation.CreatedAt(); ok {
+		_spec.SetField(ledgeraccount.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := lac.mutation.UpdatedAt(); ok {
+		_spec.SetField(ledgeraccount.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := lac.mutation.Name(); ok {
+		_spec.SetField(ledgeraccount.FieldName, field.TypeEnum, value)
+		_node.Name = value
+	}
+	if value, ok := lac.mutation.GetType(); ok {
+		_spec.SetField(ledgeraccount.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := lac.mutation.Balance(); ok {
+		_spec.SetField(ledgeraccount.FieldBalance, field.TypeFloat64, value)
+		_node.Balance = value
+	}
+	if nodes := lac.mutation.TokenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ledgeraccount.TokenTable,
+			Columns: []string{ledgeraccount.TokenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(token.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.token_ledger_accounts = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := lac.mutation.PostingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ledgeraccount.PostingsTable,
+			Columns: []string{ledgeraccount.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerposting.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LedgerAccount.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LedgerAccountUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (lac *LedgerAccountCreate) OnConflict(opts ...sql.ConflictOption) *LedgerAccountUpsertOne {
+	lac.conflict = opts
+	return &LedgerAccountUpsertOne{
+		create: lac,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LedgerAccount.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (lac *LedgerAccountCreate) OnConflictColumns(columns ...string) *LedgerAccountUpsertOne {
+	lac.conflict = append(lac.conflict, sql.ConflictColumns(columns...))
+	return &LedgerAccountUpsertOne{
+		create: lac,
+	}
+}
+
+type (
+	// LedgerAccountUpsertOne is the builder for "upsert"-ing
+	//  one LedgerAccount node.
+	LedgerAccountUpsertOne struct {
+		create *LedgerAccountCreate
+	}
+
+	// LedgerAccountUpsert is the "OnConflict" setter.
+	LedgerAccountUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LedgerAccountUpsert) SetUpdatedAt(v time.Time) *LedgerAccountUpsert {
+	u.Set(ledgeraccount.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LedgerAccountUpsert) UpdateUpdatedAt() *LedgerAccountUpsert {
+	u.SetExcluded(ledgeraccount.FieldUpdatedAt)
+	return u
+}
+
+// SetBalance sets the "balance" field.
+func (u *LedgerAccountUpsert) SetBalance(v decimal.Decimal) *LedgerAccountUpsert {
+	u.Set(ledgeraccount.FieldBalance, v)
+	return u
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *LedgerAccountUpsert) UpdateBalance() *LedgerAccountUpsert {
+	u.SetExcluded(ledgeraccount.FieldBalance)
+	return u
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *LedgerAccountUpsert) AddBalance(v decimal.Decimal) *LedgerAccountUpsert {
+	u.Add(ledgeraccount.FieldBalance, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.LedgerAccount.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(ledgeraccount.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LedgerAccountUpsertOne) UpdateNewValues() *LedgerAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(ledgeraccount.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(ledgeraccount.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.Name(); exists {
+			s.SetIgnore(ledgeraccount.FieldName)
+		}
+		if _, exists := u.create.mutation.GetType(); exists {
+			s.SetIgnore(ledgeraccount.FieldType)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LedgerAccount.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LedgerAccountUpsertOne) Ignore() *LedgerAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LedgerAccountUpsertOne) DoNothing() *LedgerAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LedgerAccountCreate.OnConflict
+// documentation for more info.
+func (u *LedgerAccountUpsertOne) Update(set func(*LedgerAccountUpsert)) *LedgerAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LedgerAccountUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LedgerAccountUpsertOne) SetUpdatedAt(v time.Time) *LedgerAccountUpsertOne {
+	return u.Update(func(s *LedgerAccountUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LedgerAccountUpsertOne) UpdateUpdatedAt() *LedgerAccountUpsertOne {
+	return u.Update(func(s *LedgerAccountUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetBalance sets the "balance" field.
+func (u *LedgerAccountUpsertOne) SetBalance(v decimal.Decimal) *LedgerAccountUpsertOne {
+	return u.Update(func(s *LedgerAccountUpsert) {
+		s.SetBalance(v)
+	})
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *LedgerAccountUpsertOne) AddBalance(v decimal.Decimal) *LedgerAccountUpsertOne {
+	return u.Update(func(s *LedgerAccountUpsert) {
+		s.AddBalance(v)
+	})
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *LedgerAccountUpsertOne) UpdateBalance() *LedgerAccountUpsertOne {
+	return u.Update(func(s *LedgerAccountUpsert) {
+		s.UpdateBalance()
+	})
+}
+
+// Exec executes the query.
+func (u *LedgerAccountUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LedgerAccountCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LedgerAccountUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LedgerAccountUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: LedgerAccountUpsertOne.ID is not supported by MySQL driver. Use LedgerAccountUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LedgerAccountUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LedgerAccountCreateBulk is the builder for creating many LedgerAccount entities in bulk.
+type LedgerAccountCreateBulk struct {
+	config
+	err      error
+	builders []*LedgerAccountCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the LedgerAccount entities in the database.
+func (lacb *LedgerAccountCreateBulk) Save(ctx context.Context) ([]*LedgerAccount, error) {
+	if lacb.err != nil {
+		return nil, lacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(lacb.builders))
+	nodes := make([]*LedgerAccount, len(lacb.builders))
+	mutators := make([]Mutator, len(lacb.builders))
+	for i := range lacb.builders {
+		func(i int, root context.Context) {
+			builder := lacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LedgerAccountMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = lacb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lacb *LedgerAccountCreateBulk) SaveX(ctx context.Context) []*LedgerAccount {
+	v, err := lacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lacb *LedgerAccountCreateBulk) Exec(ctx context.Context) error {
+	_, err := lacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lacb *LedgerAccountCreateBulk) ExecX(ctx context.Context) {
+	if err := lacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LedgerAccount.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LedgerAccountUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (lacb *LedgerAccountCreateBulk) OnConflict(opts ...sql.ConflictOption) *LedgerAccountUpsertBulk {
+	lacb.conflict = opts
+	return &LedgerAccountUpsertBulk{
+		create: lacb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LedgerAccount.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (lacb *LedgerAccountCreateBulk) OnConflictColumns(columns ...string) *LedgerAccountUpsertBulk {
+	lacb.conflict = append(lacb.conflict, sql.ConflictColumns(columns...))
+	return &LedgerAccountUpsertBulk{
+		create: lacb,
+	}
+}
+
+// LedgerAccountUpsertBulk is the builder for "upsert"-ing
+// a bulk of LedgerAccount nodes.
+type LedgerAccountUpsertBulk struct {
+	create *LedgerAccountCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.LedgerAccount.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(ledgeraccount.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LedgerAccountUpsertBulk) UpdateNewValues() *LedgerAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(ledgeraccount.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(ledgeraccount.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.Name(); exists {
+				s.SetIgnore(ledgeraccount.FieldName)
+			}
+			if _, exists := b.mutation.GetType(); exists {
+				s.SetIgnore(ledgeraccount.FieldType)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LedgerAccount.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LedgerAccountUpsertBulk) Ignore() *LedgerAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LedgerAccountUpsertBulk) DoNothing() *LedgerAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LedgerAccountCreateBulk.OnConflict
+// documentation for more info.
+func (u *LedgerAccountUpsertBulk) Update(set func(*LedgerAccountUpsert)) *LedgerAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LedgerAccountUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LedgerAccountUpsertBulk) SetUpdatedAt(v time.Time) *LedgerAccountUpsertBulk {
+	return u.Update(func(s *LedgerAccountUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LedgerAccountUpsertBulk) UpdateUpdatedAt() *LedgerAccountUpsertBulk {
+	return u.Update(func(s *LedgerAccountUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetBalance sets the "balance" field.
+func (u *LedgerAccountUpsertBulk) SetBalance(v decimal.Decimal) *LedgerAccountUpsertBulk {
+	return u.Update(func(s *LedgerAccountUpsert) {
+		s.SetBalance(v)
+	})
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *LedgerAccountUpsertBulk) AddBalance(v decimal.Decimal) *LedgerAccountUpsertBulk {
+	return u.Update(func(s *LedgerAccountUpsert) {
+		s.AddBalance(v)
+	})
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *LedgerAccountUpsertBulk) UpdateBalance() *LedgerAccountUpsertBulk {
+	return u.Update(func(s *LedgerAccountUpsert) {
+		s.UpdateBalance()
+	})
+}
+
+// Exec executes the query.
+func (u *LedgerAccountUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the LedgerAccountCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LedgerAccountCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LedgerAccountUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
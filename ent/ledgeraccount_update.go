@@ -0,0 +1,453 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/ledgeraccount"
+	"github.com/NEDA-LABS/stablenode/ent/ledgerposting"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// LedgerAccountUpdate is the builder for updating LedgerAccount entities.
+type LedgerAccountUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LedgerAccountMutation
+}
+
+// Where appends a list predicates to the LedgerAccountUpdate builder.
+func (lau *LedgerAccountUpdate) Where(ps ...predicate.LedgerAccount) *LedgerAccountUpdate {
+	lau.mutation.Where(ps...)
+	return lau
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (lau *LedgerAccountUpdate) SetUpdatedAt(t time.Time) *LedgerAccountUpdate {
+	lau.mutation.SetUpdatedAt(t)
+	return lau
+}
+
+// SetBalance sets the "balance" field.
+func (lau *LedgerAccountUpdate) SetBalance(d decimal.Decimal) *LedgerAccountUpdate {
+	lau.mutation.ResetBalance()
+	lau.mutation.SetBalance(d)
+	return lau
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (lau *LedgerAccountUpdate) SetNillableBalance(d *decimal.Decimal) *LedgerAccountUpdate {
+	if d != nil {
+		lau.SetBalance(*d)
+	}
+	return lau
+}
+
+// AddBalance adds d to the "balance" field.
+func (lau *LedgerAccountUpdate) AddBalance(d decimal.Decimal) *LedgerAccountUpdate {
+	lau.mutation.AddBalance(d)
+	return lau
+}
+
+// AddPostingIDs adds the "postings" edge to the LedgerPosting entity by IDs.
+func (lau *LedgerAccountUpdate) AddPostingIDs(ids ...uuid.UUID) *LedgerAccountUpdate {
+	lau.mutation.AddPostingIDs(ids...)
+	return lau
+}
+
+// AddPostings adds the "postings" edges to the LedgerPosting entity.
+func (lau *LedgerAccountUpdate) AddPostings(l ...*LedgerPosting) *LedgerAccountUpdate {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return lau.AddPostingIDs(ids...)
+}
+
+// Mutation returns the LedgerAccountMutation object of the builder.
+func (lau *LedgerAccountUpdate) Mutation() *LedgerAccountMutation {
+	return lau.mutation
+}
+
+// ClearPostings clears all "postings" edges to the LedgerPosting entity.
+func (lau *LedgerAccountUpdate) ClearPostings() *LedgerAccountUpdate {
+	lau.mutation.ClearPostings()
+	return lau
+}
+
+// RemovePostingIDs removes the "postings" edge to LedgerPosting entities by IDs.
+func (lau *LedgerAccountUpdate) RemovePostingIDs(ids ...uuid.UUID) *LedgerAccountUpdate {
+	lau.mutation.RemovePostingIDs(ids...)
+	return lau
+}
+
+// RemovePostings removes "postings" edges to LedgerPosting entities.
+func (lau *LedgerAccountUpdate) RemovePostings(l ...*LedgerPosting) *LedgerAccountUpdate {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return lau.RemovePostingIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (lau *LedgerAccountUpdate) Save(ctx context.Context) (int, error) {
+	lau.defaults()
+	return withHooks(ctx, lau.sqlSave, lau.mutation, lau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lau *LedgerAccountUpdate) SaveX(ctx context.Context) int {
+	affected, err := lau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (lau *LedgerAccountUpdate) Exec(ctx context.Context) error {
+	_, err := lau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lau *LedgerAccountUpdate) ExecX(ctx context.Context) {
+	if err := lau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lau *LedgerAccountUpdate) defaults() {
+	if _, ok := lau.mutation.UpdatedAt(); !ok {
+		v := ledgeraccount.UpdateDefaultUpdatedAt()
+		lau.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lau *LedgerAccountUpdate) check() error {
+	if lau.mutation.TokenCleared() && len(lau.mutation.TokenIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LedgerAccount.token"`)
+	}
+	return nil
+}
+
+func (lau *LedgerAccountUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := lau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ledgeraccount.Table, ledgeraccount.Columns, sqlgraph.NewFieldSpec(ledgeraccount.FieldID, field.TypeUUID))
+	if ps := lau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lau.mutation.UpdatedAt(); ok {
+		_spec.SetField(ledgeraccount.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := lau.mutation.Balance(); ok {
+		_spec.SetField(ledgeraccount.FieldBalance, field.TypeFloat64, value)
+	}
+	if value, ok := lau.mutation.AddedBalance(); ok {
+		_spec.AddField(ledgeraccount.FieldBalance, field.TypeFloat64, value)
+	}
+	if lau.mutation.PostingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ledgeraccount.PostingsTable,
+			Columns: []string{ledgeraccount.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerposting.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lau.mutation.RemovedPostingsIDs(); len(nodes) > 0 && !lau.mutation.PostingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ledgeraccount.PostingsTable,
+			Columns: []string{ledgeraccount.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerposting.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lau.mutation.PostingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ledgeraccount.PostingsTable,
+			Columns: []string{ledgeraccount.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerposting.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, lau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ledgeraccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	lau.mutation.done = true
+	return n, nil
+}
+
+// LedgerAccountUpdateOne is the builder for updating a single LedgerAccount entity.
+type LedgerAccountUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LedgerAccountMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (lauo *LedgerAccountUpdateOne) SetUpdatedAt(t time.Time) *LedgerAccountUpdateOne {
+	lauo.mutation.SetUpdatedAt(t)
+	return lauo
+}
+
+// SetBalance sets the "balance" field.
+func (lauo *LedgerAccountUpdateOne) SetBalance(d decimal.Decimal) *LedgerAccountUpdateOne {
+	lauo.mutation.ResetBalance()
+	lauo.mutation.SetBalance(d)
+	return lauo
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (lauo *LedgerAccountUpdateOne) SetNillableBalance(d *decimal.Decimal) *LedgerAccountUpdateOne {
+	if d != nil {
+		lauo.SetBalance(*d)
+	}
+	return lauo
+}
+
+// AddBalance adds d to the "balance" field.
+func (lauo *LedgerAccountUpdateOne) AddBalance(d decimal.Decimal) *LedgerAccountUpdateOne {
+	lauo.mutation.AddBalance(d)
+	return lauo
+}
+
+// AddPostingIDs adds the "postings" edge to the LedgerPosting entity by IDs.
+func (lauo *LedgerAccountUpdateOne) AddPostingIDs(ids ...uuid.UUID) *LedgerAccountUpdateOne {
+	lauo.mutation.AddPostingIDs(ids...)
+	return lauo
+}
+
+// AddPostings adds the "postings" edges to the LedgerPosting entity.
+func (lauo *LedgerAccountUpdateOne) AddPostings(l ...*LedgerPosting) *LedgerAccountUpdateOne {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return lauo.AddPostingIDs(ids...)
+}
+
+// Mutation returns the LedgerAccountMutation object of the builder.
+func (lauo *LedgerAccountUpdateOne) Mutation() *LedgerAccountMutation {
+	return lauo.mutation
+}
+
+// ClearPostings clears all "postings" edges to the LedgerPosting entity.
+func (lauo *LedgerAccountUpdateOne) ClearPostings() *LedgerAccountUpdateOne {
+	lauo.mutation.ClearPostings()
+	return lauo
+}
+
+// RemovePostingIDs removes the "postings" edge to LedgerPosting entities by IDs.
+func (lauo *LedgerAccountUpdateOne) RemovePostingIDs(ids ...uuid.UUID) *LedgerAccountUpdateOne {
+	lauo.mutation.RemovePostingIDs(ids...)
+	return lauo
+}
+
+// RemovePostings removes "postings" edges to LedgerPosting entities.
+func (lauo *LedgerAccountUpdateOne) RemovePostings(l ...*LedgerPosting) *LedgerAccountUpdateOne {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return lauo.RemovePostingIDs(ids...)
+}
+
+// Where appends a list predicates to the LedgerAccountUpdate builder.
+func (lauo *LedgerAccountUpdateOne) Where(ps ...predicate.LedgerAccount) *LedgerAccountUpdateOne {
+	lauo.mutation.Where(ps...)
+	return lauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (lauo *LedgerAccountUpdateOne) Select(field string, fields ...string) *LedgerAccountUpdateOne {
+	lauo.fields = append([]string{field}, fields...)
+	return lauo
+}
+
+// Save executes the query and returns the updated LedgerAccount entity.
+func (lauo *LedgerAccountUpdateOne) Save(ctx context.Context) (*LedgerAccount, error) {
+	lauo.defaults()
+	return withHooks(ctx, lauo.sqlSave, lauo.mutation, lauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lauo *LedgerAccountUpdateOne) SaveX(ctx context.Context) *LedgerAccount {
+	node, err := lauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (lauo *LedgerAccountUpdateOne) Exec(ctx context.Context) error {
+	_, err := lauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lauo *LedgerAccountUpdateOne) ExecX(ctx context.Context) {
+	if err := lauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lauo *LedgerAccountUpdateOne) defaults() {
+	if _, ok := lauo.mutation.UpdatedAt(); !ok {
+		v := ledgeraccount.UpdateDefaultUpdatedAt()
+		lauo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lauo *LedgerAccountUpdateOne) check() error {
+	if lauo.mutation.TokenCleared() && len(lauo.mutation.TokenIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LedgerAccount.token"`)
+	}
+	return nil
+}
+
+func (lauo *LedgerAccountUpdateOne) sqlSave(ctx context.Context) (_node *LedgerAccount, err error) {
+	if err := lauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ledgeraccount.Table, ledgeraccount.Columns, sqlgraph.NewFieldSpec(ledgeraccount.FieldID, field.TypeUUID))
+	id, ok := lauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LedgerAccount.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := lauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ledgeraccount.FieldID)
+		for _, f := range fields {
+			if !ledgeraccount.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ledgeraccount.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := lauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lauo.mutation.UpdatedAt(); ok {
+		_spec.SetField(ledgeraccount.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := lauo.mutation.Balance(); ok {
+		_spec.SetField(ledgeraccount.FieldBalance, field.TypeFloat64, value)
+	}
+	if value, ok := lauo.mutation.AddedBalance(); ok {
+		_spec.AddField(ledgeraccount.FieldBalance, field.TypeFloat64, value)
+	}
+	if lauo.mutation.PostingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ledgeraccount.PostingsTable,
+			Columns: []string{ledgeraccount.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerposting.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lauo.mutation.RemovedPostingsIDs(); len(nodes) > 0 && !lauo.mutation.PostingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ledgeraccount.PostingsTable,
+			Columns: []string{ledgeraccount.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerposting.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lauo.mutation.PostingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ledgeraccount.PostingsTable,
+			Columns: []string{ledgeraccount.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerposting.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &LedgerAccount{config: lauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, lauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ledgeraccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	lauo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,184 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/NEDA-LABS/stablenode/ent/ledgerentry"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/google/uuid"
+)
+
+// LedgerEntry is the model entity for the LedgerEntry schema.
+type LedgerEntry struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind ledgerentry.Kind `json:"kind,omitempty"`
+	// Reference holds the value of the "reference" field.
+	Reference string `json:"reference,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LedgerEntryQuery when eager-loading is set.
+	Edges                        LedgerEntryEdges `json:"edges"`
+	payment_order_ledger_entries *uuid.UUID
+	selectValues                 sql.SelectValues
+}
+
+// LedgerEntryEdges holds the relations/edges for other nodes in the graph.
+type LedgerEntryEdges struct {
+	// PaymentOrder holds the value of the payment_order edge.
+	PaymentOrder *PaymentOrder `json:"payment_order,omitempty"`
+	// Postings holds the value of the postings edge.
+	Postings []*LedgerPosting `json:"postings,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// PaymentOrderOrErr returns the PaymentOrder value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LedgerEntryEdges) PaymentOrderOrErr() (*PaymentOrder, error) {
+	if e.PaymentOrder != nil {
+		return e.PaymentOrder, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: paymentorder.Label}
+	}
+	return nil, &NotLoadedError{edge: "payment_order"}
+}
+
+// PostingsOrErr returns the Postings value or an error if the edge
+// was not loaded in eager-loading.
+func (e LedgerEntryEdges) PostingsOrErr() ([]*LedgerPosting, error) {
+	if e.loadedTypes[1] {
+		return e.Postings, nil
+	}
+	return nil, &NotLoadedError{edge: "postings"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LedgerEntry) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ledgerentry.FieldKind, ledgerentry.FieldReference:
+			values[i] = new(sql.NullString)
+		case ledgerentry.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case ledgerentry.FieldID:
+			values[i] = new(uuid.UUID)
+		case ledgerentry.ForeignKeys[0]: // payment_order_ledger_entries
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LedgerEntry fields.
+func (le *LedgerEntry) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ledgerentry.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				le.ID = *value
+			}
+		case ledgerentry.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				le.Kind = ledgerentry.Kind(value.String)
+			}
+		case ledgerentry.FieldReference:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reference", values[i])
+			} else if value.Valid {
+				le.Reference = value.String
+			}
+		case ledgerentry.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				le.CreatedAt = value.Time
+			}
+		case ledgerentry.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_order_ledger_entries", values[i])
+			} else if value.Valid {
+				le.payment_order_ledger_entries = new(uuid.UUID)
+				*le.payment_order_ledger_entries = *value.S.(*uuid.UUID)
+			}
+		default:
+			le.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LedgerEntry.
+// This includes values selected through modifiers, order, etc.
+func (le *LedgerEntry) Value(name string) (ent.Value, error) {
+	return le.selectValues.Get(name)
+}
+
+// QueryPaymentOrder queries the "payment_order" edge of the LedgerEntry entity.
+func (le *LedgerEntry) QueryPaymentOrder() *PaymentOrderQuery {
+	return NewLedgerEntryClient(le.config).QueryPaymentOrder(le)
+}
+
+// QueryPostings queries the "postings" edge of the LedgerEntry entity.
+func (le *LedgerEntry) QueryPostings() *LedgerPostingQuery {
+	return NewLedgerEntryClient(le.config).QueryPostings(le)
+}
+
+// Update returns a builder for updating this LedgerEntry.
+// Note that you need to call LedgerEntry.Unwrap() before calling this method if this LedgerEntry
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (le *LedgerEntry) Update() *LedgerEntryUpdateOne {
+	return NewLedgerEntryClient(le.config).UpdateOne(le)
+}
+
+// Unwrap unwraps the LedgerEntry entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (le *LedgerEntry) Unwrap() *LedgerEntry {
+	_tx, ok := le.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LedgerEntry is not a transactional entity")
+	}
+	le.config.driver = _tx.drv
+	return le
+}
+
+// String implements the fmt.Stringer.
+func (le *LedgerEntry) String() string {
+	var builder strings.Builder
+	builder.WriteString("LedgerEntry(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", le.ID))
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", le.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("reference=")
+	builder.WriteString(le.Reference)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(le.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LedgerEntries is a parsable slice of LedgerEntry.
+type LedgerEntries []*LedgerEntry
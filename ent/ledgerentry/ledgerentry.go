@@ -0,0 +1,170 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ledgerentry
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the ledgerentry type in the database.
+	Label = "ledger_entry"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldReference holds the string denoting the reference field in the database.
+	FieldReference = "reference"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgePaymentOrder holds the string denoting the payment_order edge name in mutations.
+	EdgePaymentOrder = "payment_order"
+	// EdgePostings holds the string denoting the postings edge name in mutations.
+	EdgePostings = "postings"
+	// Table holds the table name of the ledgerentry in the database.
+	Table = "ledger_entries"
+	// PaymentOrderTable is the table that holds the payment_order relation/edge.
+	PaymentOrderTable = "ledger_entries"
+	// PaymentOrderInverseTable is the table name for the PaymentOrder entity.
+	// It exists in this package in order to avoid circular dependency with the "paymentorder" package.
+	PaymentOrderInverseTable = "payment_orders"
+	// PaymentOrderColumn is the table column denoting the payment_order relation/edge.
+	PaymentOrderColumn = "payment_order_ledger_entries"
+	// PostingsTable is the table that holds the postings relation/edge.
+	PostingsTable = "ledger_postings"
+	// PostingsInverseTable is the table name for the LedgerPosting entity.
+	// It exists in this package in order to avoid circular dependency with the "ledgerposting" package.
+	PostingsInverseTable = "ledger_postings"
+	// PostingsColumn is the table column denoting the postings relation/edge.
+	PostingsColumn = "ledger_entry_postings"
+)
+
+// Columns holds all SQL columns for ledgerentry fields.
+var Columns = []string{
+	FieldID,
+	FieldKind,
+	FieldReference,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "ledger_entries"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"payment_order_ledger_entries",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ReferenceValidator is a validator for the "reference" field. It is called by the builders before save.
+	ReferenceValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Kind defines the type for the "kind" enum field.
+type Kind string
+
+// Kind values.
+const (
+	KindDeposit           Kind = "deposit"
+	KindDepositReversal   Kind = "deposit_reversal"
+	KindOrderCreated      Kind = "order_created"
+	KindSettlement        Kind = "settlement"
+	KindRefund            Kind = "refund"
+	KindOverpaymentRefund Kind = "overpayment_refund"
+	KindSweep             Kind = "sweep"
+	KindSweepReversal     Kind = "sweep_reversal"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "kind" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindDeposit, KindDepositReversal, KindOrderCreated, KindSettlement, KindRefund, KindOverpaymentRefund, KindSweep, KindSweepReversal:
+		return nil
+	default:
+		return fmt.Errorf("ledgerentry: invalid enum value for kind field: %q", k)
+	}
+}
+
+// OrderOption defines the ordering options for the LedgerEntry queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByReference orders the results by the reference field.
+func ByReference(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReference, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByPaymentOrderField orders the results by payment_order field.
+func ByPaymentOrderField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPaymentOrderStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPostingsCount orders the results by postings count.
+func ByPostingsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPostingsStep(), opts...)
+	}
+}
+
+// ByPostings orders the results by postings terms.
+func ByPostings(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPostingsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newPaymentOrderStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PaymentOrderInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PaymentOrderTable, PaymentOrderColumn),
+	)
+}
+func newPostingsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PostingsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PostingsTable, PostingsColumn),
+	)
+}
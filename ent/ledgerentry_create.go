@@ -0,0 +1,604 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/ledgerentry"
+	"github.com/NEDA-LABS/stablenode/ent/ledgerposting"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/google/uuid"
+)
+
+// LedgerEntryCreate is the builder for creating a LedgerEntry entity.
+type LedgerEntryCreate struct {
+	config
+	mutation *LedgerEntryMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetKind sets the "kind" field.
+func (lec *LedgerEntryCreate) SetKind(l ledgerentry.Kind) *LedgerEntryCreate {
+	lec.mutation.SetKind(l)
+	return lec
+}
+
+// SetReference sets the "reference" field.
+func (lec *LedgerEntryCreate) SetReference(s string) *LedgerEntryCreate {
+	lec.mutation.SetReference(s)
+	return lec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (lec *LedgerEntryCreate) SetCreatedAt(t time.Time) *LedgerEntryCreate {
+	lec.mutation.SetCreatedAt(t)
+	return lec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (lec *LedgerEntryCreate) SetNillableCreatedAt(t *time.Time) *LedgerEntryCreate {
+	if t != nil {
+		lec.SetCreatedAt(*t)
+	}
+	return lec
+}
+
+// SetID sets the "id" field.
+func (lec *LedgerEntryCreate) SetID(u uuid.UUID) *LedgerEntryCreate {
+	lec.mutation.SetID(u)
+	return lec
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (lec *LedgerEntryCreate) SetNillableID(u *uuid.UUID) *LedgerEntryCreate {
+	if u != nil {
+		lec.SetID(*u)
+	}
+	return lec
+}
+
+// SetPaymentOrderID sets the "payment_order" edge to the PaymentOrder entity by ID.
+func (lec *LedgerEntryCreate) SetPaymentOrderID(id uuid.UUID) *LedgerEntryCreate {
+	lec.mutation.SetPaymentOrderID(id)
+	return lec
+}
+
+// SetNillablePaymentOrderID sets the "payment_order" edge to the PaymentOrder entity by ID if the given value is not nil.
+func (lec *LedgerEntryCreate) SetNillablePaymentOrderID(id *uuid.UUID) *LedgerEntryCreate {
+	if id != nil {
+		lec = lec.SetPaymentOrderID(*id)
+	}
+	return lec
+}
+
+// SetPaymentOrder sets the "payment_order" edge to the PaymentOrder entity.
+func (lec *LedgerEntryCreate) SetPaymentOrder(p *PaymentOrder) *LedgerEntryCreate {
+	return lec.SetPaymentOrderID(p.ID)
+}
+
+// AddPostingIDs adds the "postings" edge to the LedgerPosting entity by IDs.
+func (lec *LedgerEntryCreate) AddPostingIDs(ids ...uuid.UUID) *LedgerEntryCreate {
+	lec.mutation.AddPostingIDs(ids...)
+	return lec
+}
+
+// AddPostings adds the "postings" edges to the LedgerPosting entity.
+func (lec *LedgerEntryCreate) AddPostings(l ...*LedgerPosting) *LedgerEntryCreate {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return lec.AddPostingIDs(ids...)
+}
+
+// Mutation returns the LedgerEntryMutation object of the builder.
+func (lec *LedgerEntryCreate) Mutation() *LedgerEntryMutation {
+	return lec.mutation
+}
+
+// Save creates the LedgerEntry in the database.
+func (lec *LedgerEntryCreate) Save(ctx context.Context) (*LedgerEntry, error) {
+	lec.defaults()
+	return withHooks(ctx, lec.sqlSave, lec.mutation, lec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lec *LedgerEntryCreate) SaveX(ctx context.Context) *LedgerEntry {
+	v, err := lec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lec *LedgerEntryCreate) Exec(ctx context.Context) error {
+	_, err := lec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lec *LedgerEntryCreate) ExecX(ctx context.Context) {
+	if err := lec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lec *LedgerEntryCreate) defaults() {
+	if _, ok := lec.mutation.CreatedAt(); !ok {
+		v := ledgerentry.DefaultCreatedAt()
+		lec.mutation.SetCreatedAt(v)
+	}
+	if _, ok := lec.mutation.ID(); !ok {
+		v := ledgerentry.DefaultID()
+		lec.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lec *LedgerEntryCreate) check() error {
+	if _, ok := lec.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "LedgerEntry.kind"`)}
+	}
+	if v, ok := lec.mutation.Kind(); ok {
+		if err := ledgerentry.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "LedgerEntry.kind": %w`, err)}
+		}
+	}
+	if _, ok := lec.mutation.Reference(); !ok {
+		return &ValidationError{Name: "reference", err: errors.New(`ent: missing required field "LedgerEntry.reference"`)}
+	}
+	if v, ok := lec.mutation.Reference(); ok {
+		if err := ledgerentry.ReferenceValidator(v); err != nil {
+			return &ValidationError{Name: "reference", err: fmt.Errorf(`ent: validator failed for field "LedgerEntry.reference": %w`, err)}
+		}
+	}
+	if _, ok := lec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LedgerEntry.created_at"`)}
+	}
+	return nil
+}
+
+func (lec *LedgerEntryCreate) sqlSave(ctx context.Context) (*LedgerEntry, error) {
+	if err := lec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := lec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	lec.mutation.id = &_node.ID
+	lec.mutation.done = true
+	return _node, nil
+}
+
+func (lec *LedgerEntryCreate) createSpec() (*LedgerEntry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LedgerEntry{config: lec.config}
+		_spec = sqlgraph.NewCreateSpec(ledgerentry.Table, sqlgraph.NewFieldSpec(ledgerentry.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = lec.conflict
+	if id, ok := lec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := lec.mutation.Kind(); ok {
+		_spec.SetField(ledgerentry.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := lec.mutation.Reference(); ok {
+		_spec.SetField(ledgerentry.FieldReference, field.TypeString, value)
+		_node.Reference = value
+	}
+	if value, ok := lec.mutation.CreatedAt(); ok {
+		_spec.SetField(ledgerentry.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := lec.mutation.PaymentOrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ledgerentry.PaymentOrderTable,
+			Columns: []string{ledgerentry.PaymentOrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.payment_order_ledger_entries = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := lec.mutation.PostingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ledgerentry.PostingsTable,
+			Columns: []string{ledgerentry.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerposting.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LedgerEntry.Create().
+//		SetKind(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LedgerEntryUpsert) {
+//			SetKind(v+v).
+//		}).
+//		Exec(ctx)
+func (lec *LedgerEntryCreate) OnConflict(opts ...sql.ConflictOption) *LedgerEntryUpsertOne {
+	lec.conflict = opts
+	return &LedgerEntryUpsertOne{
+		create: lec,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LedgerEntry.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (lec *LedgerEntryCreate) OnConflictColumns(columns ...string) *LedgerEntryUpsertOne {
+	lec.conflict = append(lec.conflict, sql.ConflictColumns(columns...))
+	return &LedgerEntryUpsertOne{
+		create: lec,
+	}
+}
+
+type (
+	// LedgerEntryUpsertOne is the builder for "upsert"-ing
+	//  one LedgerEntry node.
+	LedgerEntryUpsertOne struct {
+		create *LedgerEntryCreate
+	}
+
+	// LedgerEntryUpsert is the "OnConflict" setter.
+	LedgerEntryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.LedgerEntry.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(ledgerentry.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LedgerEntryUpsertOne) UpdateNewValues() *LedgerEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(ledgerentry.FieldID)
+		}
+		if _, exists := u.create.mutation.Kind(); exists {
+			s.SetIgnore(ledgerentry.FieldKind)
+		}
+		if _, exists := u.create.mutation.Reference(); exists {
+			s.SetIgnore(ledgerentry.FieldReference)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(ledgerentry.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LedgerEntry.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LedgerEntryUpsertOne) Ignore() *LedgerEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LedgerEntryUpsertOne) DoNothing() *LedgerEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LedgerEntryCreate.OnConflict
+// documentation for more info.
+func (u *LedgerEntryUpsertOne) Update(set func(*LedgerEntryUpsert)) *LedgerEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LedgerEntryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *LedgerEntryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LedgerEntryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LedgerEntryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LedgerEntryUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: LedgerEntryUpsertOne.ID is not supported by MySQL driver. Use LedgerEntryUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LedgerEntryUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LedgerEntryCreateBulk is the builder for creating many LedgerEntry entities in bulk.
+type LedgerEntryCreateBulk struct {
+	config
+	err      error
+	builders []*LedgerEntryCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the LedgerEntry entities in the database.
+func (lecb *LedgerEntryCreateBulk) Save(ctx context.Context) ([]*LedgerEntry, error) {
+	if lecb.err != nil {
+		return nil, lecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(lecb.builders))
+	nodes := make([]*LedgerEntry, len(lecb.builders))
+	mutators := make([]Mutator, len(lecb.builders))
+	for i := range lecb.builders {
+		func(i int, root context.Context) {
+			builder := lecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LedgerEntryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = lecb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lecb *LedgerEntryCreateBulk) SaveX(ctx context.Context) []*LedgerEntry {
+	v, err := lecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lecb *LedgerEntryCreateBulk) Exec(ctx context.Context) error {
+	_, err := lecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lecb *LedgerEntryCreateBulk) ExecX(ctx context.Context) {
+	if err := lecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LedgerEntry.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LedgerEntryUpsert) {
+//			SetKind(v+v).
+//		}).
+//		Exec(ctx)
+func (lecb *LedgerEntryCreateBulk) OnConflict(opts ...sql.ConflictOption) *LedgerEntryUpsertBulk {
+	lecb.conflict = opts
+	return &LedgerEntryUpsertBulk{
+		create: lecb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LedgerEntry.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (lecb *LedgerEntryCreateBulk) OnConflictColumns(columns ...string) *LedgerEntryUpsertBulk {
+	lecb.conflict = append(lecb.conflict, sql.ConflictColumns(columns...))
+	return &LedgerEntryUpsertBulk{
+		create: lecb,
+	}
+}
+
+// LedgerEntryUpsertBulk is the builder for "upsert"-ing
+// a bulk of LedgerEntry nodes.
+type LedgerEntryUpsertBulk struct {
+	create *LedgerEntryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.LedgerEntry.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(ledgerentry.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LedgerEntryUpsertBulk) UpdateNewValues() *LedgerEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(ledgerentry.FieldID)
+			}
+			if _, exists := b.mutation.Kind(); exists {
+				s.SetIgnore(ledgerentry.FieldKind)
+			}
+			if _, exists := b.mutation.Reference(); exists {
+				s.SetIgnore(ledgerentry.FieldReference)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(ledgerentry.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LedgerEntry.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LedgerEntryUpsertBulk) Ignore() *LedgerEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LedgerEntryUpsertBulk) DoNothing() *LedgerEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LedgerEntryCreateBulk.OnConflict
+// documentation for more info.
+func (u *LedgerEntryUpsertBulk) Update(set func(*LedgerEntryUpsert)) *LedgerEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LedgerEntryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *LedgerEntryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the LedgerEntryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LedgerEntryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LedgerEntryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,339 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/ledgerentry"
+	"github.com/NEDA-LABS/stablenode/ent/ledgerposting"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// LedgerEntryUpdate is the builder for updating LedgerEntry entities.
+type LedgerEntryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LedgerEntryMutation
+}
+
+// Where appends a list predicates to the LedgerEntryUpdate builder.
+func (leu *LedgerEntryUpdate) Where(ps ...predicate.LedgerEntry) *LedgerEntryUpdate {
+	leu.mutation.Where(ps...)
+	return leu
+}
+
+// AddPostingIDs adds the "postings" edge to the LedgerPosting entity by IDs.
+func (leu *LedgerEntryUpdate) AddPostingIDs(ids ...uuid.UUID) *LedgerEntryUpdate {
+	leu.mutation.AddPostingIDs(ids...)
+	return leu
+}
+
+// AddPostings adds the "postings" edges to the LedgerPosting entity.
+func (leu *LedgerEntryUpdate) AddPostings(l ...*LedgerPosting) *LedgerEntryUpdate {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return leu.AddPostingIDs(ids...)
+}
+
+// Mutation returns the LedgerEntryMutation object of the builder.
+func (leu *LedgerEntryUpdate) Mutation() *LedgerEntryMutation {
+	return leu.mutation
+}
+
+// ClearPostings clears all "postings" edges to the LedgerPosting entity.
+func (leu *LedgerEntryUpdate) ClearPostings() *LedgerEntryUpdate {
+	leu.mutation.ClearPostings()
+	return leu
+}
+
+// RemovePostingIDs removes the "postings" edge to LedgerPosting entities by IDs.
+func (leu *LedgerEntryUpdate) RemovePostingIDs(ids ...uuid.UUID) *LedgerEntryUpdate {
+	leu.mutation.RemovePostingIDs(ids...)
+	return leu
+}
+
+// RemovePostings removes "postings" edges to LedgerPosting entities.
+func (leu *LedgerEntryUpdate) RemovePostings(l ...*LedgerPosting) *LedgerEntryUpdate {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return leu.RemovePostingIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (leu *LedgerEntryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, leu.sqlSave, leu.mutation, leu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (leu *LedgerEntryUpdate) SaveX(ctx context.Context) int {
+	affected, err := leu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (leu *LedgerEntryUpdate) Exec(ctx context.Context) error {
+	_, err := leu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (leu *LedgerEntryUpdate) ExecX(ctx context.Context) {
+	if err := leu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (leu *LedgerEntryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(ledgerentry.Table, ledgerentry.Columns, sqlgraph.NewFieldSpec(ledgerentry.FieldID, field.TypeUUID))
+	if ps := leu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if leu.mutation.PostingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ledgerentry.PostingsTable,
+			Columns: []string{ledgerentry.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerposting.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := leu.mutation.RemovedPostingsIDs(); len(nodes) > 0 && !leu.mutation.PostingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ledgerentry.PostingsTable,
+			Columns: []string{ledgerentry.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerposting.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := leu.mutation.PostingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ledgerentry.PostingsTable,
+			Columns: []string{ledgerentry.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerposting.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, leu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ledgerentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	leu.mutation.done = true
+	return n, nil
+}
+
+// LedgerEntryUpdateOne is the builder for updating a single LedgerEntry entity.
+type LedgerEntryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LedgerEntryMutation
+}
+
+// AddPostingIDs adds the "postings" edge to the LedgerPosting entity by IDs.
+func (leuo *LedgerEntryUpdateOne) AddPostingIDs(ids ...uuid.UUID) *LedgerEntryUpdateOne {
+	leuo.mutation.AddPostingIDs(ids...)
+	return leuo
+}
+
+// AddPostings adds the "postings" edges to the LedgerPosting entity.
+func (leuo *LedgerEntryUpdateOne) AddPostings(l ...*LedgerPosting) *LedgerEntryUpdateOne {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return leuo.AddPostingIDs(ids...)
+}
+
+// Mutation returns the LedgerEntryMutation object of the builder.
+func (leuo *LedgerEntryUpdateOne) Mutation() *LedgerEntryMutation {
+	return leuo.mutation
+}
+
+// ClearPostings clears all "postings" edges to the LedgerPosting entity.
+func (leuo *LedgerEntryUpdateOne) ClearPostings() *LedgerEntryUpdateOne {
+	leuo.mutation.ClearPostings()
+	return leuo
+}
+
+// RemovePostingIDs removes the "postings" edge to LedgerPosting entities by IDs.
+func (leuo *LedgerEntryUpdateOne) RemovePostingIDs(ids ...uuid.UUID) *LedgerEntryUpdateOne {
+	leuo.mutation.RemovePostingIDs(ids...)
+	return leuo
+}
+
+// RemovePostings removes "postings" edges to LedgerPosting entities.
+func (leuo *LedgerEntryUpdateOne) RemovePostings(l ...*LedgerPosting) *LedgerEntryUpdateOne {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return leuo.RemovePostingIDs(ids...)
+}
+
+// Where appends a list predicates to the LedgerEntryUpdate builder.
+func (leuo *LedgerEntryUpdateOne) Where(ps ...predicate.LedgerEntry) *LedgerEntryUpdateOne {
+	leuo.mutation.Where(ps...)
+	return leuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (leuo *LedgerEntryUpdateOne) Select(field string, fields ...string) *LedgerEntryUpdateOne {
+	leuo.fields = append([]string{field}, fields...)
+	return leuo
+}
+
+// Save executes the query and returns the updated LedgerEntry entity.
+func (leuo *LedgerEntryUpdateOne) Save(ctx context.Context) (*LedgerEntry, error) {
+	return withHooks(ctx, leuo.sqlSave, leuo.mutation, leuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (leuo *LedgerEntryUpdateOne) SaveX(ctx context.Context) *LedgerEntry {
+	node, err := leuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (leuo *LedgerEntryUpdateOne) Exec(ctx context.Context) error {
+	_, err := leuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (leuo *LedgerEntryUpdateOne) ExecX(ctx context.Context) {
+	if err := leuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (leuo *LedgerEntryUpdateOne) sqlSave(ctx context.Context) (_node *LedgerEntry, err error) {
+	_spec := sqlgraph.NewUpdateSpec(ledgerentry.Table, ledgerentry.Columns, sqlgraph.NewFieldSpec(ledgerentry.FieldID, field.TypeUUID))
+	id, ok := leuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LedgerEntry.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := leuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ledgerentry.FieldID)
+		for _, f := range fields {
+			if !ledgerentry.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ledgerentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := leuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if leuo.mutation.PostingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ledgerentry.PostingsTable,
+			Columns: []string{ledgerentry.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerposting.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := leuo.mutation.RemovedPostingsIDs(); len(nodes) > 0 && !leuo.mutation.PostingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ledgerentry.PostingsTable,
+			Columns: []string{ledgerentry.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerposting.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := leuo.mutation.PostingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ledgerentry.PostingsTable,
+			Columns: []string{ledgerentry.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerposting.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &LedgerEntry{config: leuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, leuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ledgerentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	leuo.mutation.done = true
+	return _node, nil
+}
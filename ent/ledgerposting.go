@@ -0,0 +1,173 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/NEDA-LABS/stablenode/ent/ledgeraccount"
+	"github.com/NEDA-LABS/stablenode/ent/ledgerentry"
+	"github.com/NEDA-LABS/stablenode/ent/ledgerposting"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// LedgerPosting is the model entity for the LedgerPosting schema.
+type LedgerPosting struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount decimal.Decimal `json:"amount,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LedgerPostingQuery when eager-loading is set.
+	Edges                   LedgerPostingEdges `json:"edges"`
+	ledger_account_postings *uuid.UUID
+	ledger_entry_postings   *uuid.UUID
+	selectValues            sql.SelectValues
+}
+
+// LedgerPostingEdges holds the relations/edges for other nodes in the graph.
+type LedgerPostingEdges struct {
+	// Entry holds the value of the entry edge.
+	Entry *LedgerEntry `json:"entry,omitempty"`
+	// Account holds the value of the account edge.
+	Account *LedgerAccount `json:"account,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// EntryOrErr returns the Entry value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LedgerPostingEdges) EntryOrErr() (*LedgerEntry, error) {
+	if e.Entry != nil {
+		return e.Entry, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: ledgerentry.Label}
+	}
+	return nil, &NotLoadedError{edge: "entry"}
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LedgerPostingEdges) AccountOrErr() (*LedgerAccount, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: ledgeraccount.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LedgerPosting) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ledgerposting.FieldAmount:
+			values[i] = new(decimal.Decimal)
+		case ledgerposting.FieldID:
+			values[i] = new(uuid.UUID)
+		case ledgerposting.ForeignKeys[0]: // ledger_account_postings
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case ledgerposting.ForeignKeys[1]: // ledger_entry_postings
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LedgerPosting fields.
+func (lp *LedgerPosting) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ledgerposting.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				lp.ID = *value
+			}
+		case ledgerposting.FieldAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				lp.Amount = *value
+			}
+		case ledgerposting.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field ledger_account_postings", values[i])
+			} else if value.Valid {
+				lp.ledger_account_postings = new(uuid.UUID)
+				*lp.ledger_account_postings = *value.S.(*uuid.UUID)
+			}
+		case ledgerposting.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field ledger_entry_postings", values[i])
+			} else if value.Valid {
+				lp.ledger_entry_postings = new(uuid.UUID)
+				*lp.ledger_entry_postings = *value.S.(*uuid.UUID)
+			}
+		default:
+			lp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LedgerPosting.
+// This includes values selected through modifiers, order, etc.
+func (lp *LedgerPosting) Value(name string) (ent.Value, error) {
+	return lp.selectValues.Get(name)
+}
+
+// QueryEntry queries the "entry" edge of the LedgerPosting entity.
+func (lp *LedgerPosting) QueryEntry() *LedgerEntryQuery {
+	return NewLedgerPostingClient(lp.config).QueryEntry(lp)
+}
+
+// QueryAccount queries the "account" edge of the LedgerPosting entity.
+func (lp *LedgerPosting) QueryAccount() *LedgerAccountQuery {
+	return NewLedgerPostingClient(lp.config).QueryAccount(lp)
+}
+
+// Update returns a builder for updating this LedgerPosting.
+// Note that you need to call LedgerPosting.Unwrap() before calling this method if this LedgerPosting
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (lp *LedgerPosting) Update() *LedgerPostingUpdateOne {
+	return NewLedgerPostingClient(lp.config).UpdateOne(lp)
+}
+
+// Unwrap unwraps the LedgerPosting entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (lp *LedgerPosting) Unwrap() *LedgerPosting {
+	_tx, ok := lp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LedgerPosting is not a transactional entity")
+	}
+	lp.config.driver = _tx.drv
+	return lp
+}
+
+// String implements the fmt.Stringer.
+func (lp *LedgerPosting) String() string {
+	var builder strings.Builder
+	builder.WriteString("LedgerPosting(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", lp.ID))
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", lp.Amount))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LedgerPostings is a parsable slice of LedgerPosting.
+type LedgerPostings []*LedgerPosting
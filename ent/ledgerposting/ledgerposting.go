@@ -0,0 +1,112 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ledgerposting
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the ledgerposting type in the database.
+	Label = "ledger_posting"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// EdgeEntry holds the string denoting the entry edge name in mutations.
+	EdgeEntry = "entry"
+	// EdgeAccount holds the string denoting the account edge name in mutations.
+	EdgeAccount = "account"
+	// Table holds the table name of the ledgerposting in the database.
+	Table = "ledger_postings"
+	// EntryTable is the table that holds the entry relation/edge.
+	EntryTable = "ledger_postings"
+	// EntryInverseTable is the table name for the LedgerEntry entity.
+	// It exists in this package in order to avoid circular dependency with the "ledgerentry" package.
+	EntryInverseTable = "ledger_entries"
+	// EntryColumn is the table column denoting the entry relation/edge.
+	EntryColumn = "ledger_entry_postings"
+	// AccountTable is the table that holds the account relation/edge.
+	AccountTable = "ledger_postings"
+	// AccountInverseTable is the table name for the LedgerAccount entity.
+	// It exists in this package in order to avoid circular dependency with the "ledgeraccount" package.
+	AccountInverseTable = "ledger_accounts"
+	// AccountColumn is the table column denoting the account relation/edge.
+	AccountColumn = "ledger_account_postings"
+)
+
+// Columns holds all SQL columns for ledgerposting fields.
+var Columns = []string{
+	FieldID,
+	FieldAmount,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "ledger_postings"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"ledger_account_postings",
+	"ledger_entry_postings",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the LedgerPosting queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
+
+// ByEntryField orders the results by entry field.
+func ByEntryField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEntryStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByAccountField orders the results by account field.
+func ByAccountField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAccountStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newEntryStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EntryInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, EntryTable, EntryColumn),
+	)
+}
+func newAccountStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AccountInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+	)
+}
@@ -0,0 +1,539 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/ledgeraccount"
+	"github.com/NEDA-LABS/stablenode/ent/ledgerentry"
+	"github.com/NEDA-LABS/stablenode/ent/ledgerposting"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// LedgerPostingCreate is the builder for creating a LedgerPosting entity.
+type LedgerPostingCreate struct {
+	config
+	mutation *LedgerPostingMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetAmount sets the "amount" field.
+func (lpc *LedgerPostingCreate) SetAmount(d decimal.Decimal) *LedgerPostingCreate {
+	lpc.mutation.SetAmount(d)
+	return lpc
+}
+
+// SetID sets the "id" field.
+func (lpc *LedgerPostingCreate) SetID(u uuid.UUID) *LedgerPostingCreate {
+	lpc.mutation.SetID(u)
+	return lpc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (lpc *LedgerPostingCreate) SetNillableID(u *uuid.UUID) *LedgerPostingCreate {
+	if u != nil {
+		lpc.SetID(*u)
+	}
+	return lpc
+}
+
+// SetEntryID sets the "entry" edge to the LedgerEntry entity by ID.
+func (lpc *LedgerPostingCreate) SetEntryID(id uuid.UUID) *LedgerPostingCreate {
+	lpc.mutation.SetEntryID(id)
+	return lpc
+}
+
+// SetEntry sets the "entry" edge to the LedgerEntry entity.
+func (lpc *LedgerPostingCreate) SetEntry(l *LedgerEntry) *LedgerPostingCreate {
+	return lpc.SetEntryID(l.ID)
+}
+
+// SetAccountID sets the "account" edge to the LedgerAccount entity by ID.
+func (lpc *LedgerPostingCreate) SetAccountID(id uuid.UUID) *LedgerPostingCreate {
+	lpc.mutation.SetAccountID(id)
+	return lpc
+}
+
+// SetAccount sets the "account" edge to the LedgerAccount entity.
+func (lpc *LedgerPostingCreate) SetAccount(l *LedgerAccount) *LedgerPostingCreate {
+	return lpc.SetAccountID(l.ID)
+}
+
+// Mutation returns the LedgerPostingMutation object of the builder.
+func (lpc *LedgerPostingCreate) Mutation() *LedgerPostingMutation {
+	return lpc.mutation
+}
+
+// Save creates the LedgerPosting in the database.
+func (lpc *LedgerPostingCreate) Save(ctx context.Context) (*LedgerPosting, error) {
+	lpc.defaults()
+	return withHooks(ctx, lpc.sqlSave, lpc.mutation, lpc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lpc *LedgerPostingCreate) SaveX(ctx context.Context) *LedgerPosting {
+	v, err := lpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lpc *LedgerPostingCreate) Exec(ctx context.Context) error {
+	_, err := lpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lpc *LedgerPostingCreate) ExecX(ctx context.Context) {
+	if err := lpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lpc *LedgerPostingCreate) defaults() {
+	if _, ok := lpc.mutation.ID(); !ok {
+		v := ledgerposting.DefaultID()
+		lpc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lpc *LedgerPostingCreate) check() error {
+	if _, ok := lpc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "LedgerPosting.amount"`)}
+	}
+	if len(lpc.mutation.EntryIDs()) == 0 {
+		return &ValidationError{Name: "entry", err: errors.New(`ent: missing required edge "LedgerPosting.entry"`)}
+	}
+	if len(lpc.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "LedgerPosting.account"`)}
+	}
+	return nil
+}
+
+func (lpc *LedgerPostingCreate) sqlSave(ctx context.Context) (*LedgerPosting, error) {
+	if err := lpc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := lpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	lpc.mutation.id = &_node.ID
+	lpc.mutation.done = true
+	return _node, nil
+}
+
+func (lpc *LedgerPostingCreate) createSpec() (*LedgerPosting, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LedgerPosting{config: lpc.config}
+		_spec = sqlgraph.NewCreateSpec(ledgerposting.Table, sqlgraph.NewFieldSpec(ledgerposting.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = lpc.conflict
+	if id, ok := lpc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := lpc.mutation.Amount(); ok {
+		_spec.SetField(ledgerposting.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if nodes := lpc.mutation.EntryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ledgerposting.EntryTable,
+			Columns: []string{ledgerposting.EntryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ledger_entry_postings = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := lpc.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ledgerposting.AccountTable,
+			Columns: []string{ledgerposting.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgeraccount.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ledger_account_postings = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LedgerPosting.Create().
+//		SetAmount(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LedgerPostingUpsert) {
+//			SetAmount(v+v).
+//		}).
+//		Exec(ctx)
+func (lpc *LedgerPostingCreate) OnConflict(opts ...sql.ConflictOption) *LedgerPostingUpsertOne {
+	lpc.conflict = opts
+	return &LedgerPostingUpsertOne{
+		create: lpc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LedgerPosting.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (lpc *LedgerPostingCreate) OnConflictColumns(columns ...string) *LedgerPostingUpsertOne {
+	lpc.conflict = append(lpc.conflict, sql.ConflictColumns(columns...))
+	return &LedgerPostingUpsertOne{
+		create: lpc,
+	}
+}
+
+type (
+	// LedgerPostingUpsertOne is the builder for "upsert"-ing
+	//  one LedgerPosting node.
+	LedgerPostingUpsertOne struct {
+		create *LedgerPostingCreate
+	}
+
+	// LedgerPostingUpsert is the "OnConflict" setter.
+	LedgerPostingUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.LedgerPosting.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(ledgerposting.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LedgerPostingUpsertOne) UpdateNewValues() *LedgerPostingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(ledgerposting.FieldID)
+		}
+		if _, exists := u.create.mutation.Amount(); exists {
+			s.SetIgnore(ledgerposting.FieldAmount)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LedgerPosting.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LedgerPostingUpsertOne) Ignore() *LedgerPostingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LedgerPostingUpsertOne) DoNothing() *LedgerPostingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LedgerPostingCreate.OnConflict
+// documentation for more info.
+func (u *LedgerPostingUpsertOne) Update(set func(*LedgerPostingUpsert)) *LedgerPostingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LedgerPostingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *LedgerPostingUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LedgerPostingCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LedgerPostingUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LedgerPostingUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: LedgerPostingUpsertOne.ID is not supported by MySQL driver. Use LedgerPostingUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LedgerPostingUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LedgerPostingCreateBulk is the builder for creating many LedgerPosting entities in bulk.
+type LedgerPostingCreateBulk struct {
+	config
+	err      error
+	builders []*LedgerPostingCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the LedgerPosting entities in the database.
+func (lpcb *LedgerPostingCreateBulk) Save(ctx context.Context) ([]*LedgerPosting, error) {
+	if lpcb.err != nil {
+		return nil, lpcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(lpcb.builders))
+	nodes := make([]*LedgerPosting, len(lpcb.builders))
+	mutators := make([]Mutator, len(lpcb.builders))
+	for i := range lpcb.builders {
+		func(i int, root context.Context) {
+			builder := lpcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LedgerPostingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = lpcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lpcb *LedgerPostingCreateBulk) SaveX(ctx context.Context) []*LedgerPosting {
+	v, err := lpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lpcb *LedgerPostingCreateBulk) Exec(ctx context.Context) error {
+	_, err := lpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lpcb *LedgerPostingCreateBulk) ExecX(ctx context.Context) {
+	if err := lpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LedgerPosting.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LedgerPostingUpsert) {
+//			SetAmount(v+v).
+//		}).
+//		Exec(ctx)
+func (lpcb *LedgerPostingCreateBulk) OnConflict(opts ...sql.ConflictOption) *LedgerPostingUpsertBulk {
+	lpcb.conflict = opts
+	return &LedgerPostingUpsertBulk{
+		create: lpcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LedgerPosting.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (lpcb *LedgerPostingCreateBulk) OnConflictColumns(columns ...string) *LedgerPostingUpsertBulk {
+	lpcb.conflict = append(lpcb.conflict, sql.ConflictColumns(columns...))
+	return &LedgerPostingUpsertBulk{
+		create: lpcb,
+	}
+}
+
+// LedgerPostingUpsertBulk is the builder for "upsert"-ing
+// a bulk of LedgerPosting nodes.
+type LedgerPostingUpsertBulk struct {
+	create *LedgerPostingCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.LedgerPosting.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(ledgerposting.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LedgerPostingUpsertBulk) UpdateNewValues() *LedgerPostingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(ledgerposting.FieldID)
+			}
+			if _, exists := b.mutation.Amount(); exists {
+				s.SetIgnore(ledgerposting.FieldAmount)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LedgerPosting.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LedgerPostingUpsertBulk) Ignore() *LedgerPostingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LedgerPostingUpsertBulk) DoNothing() *LedgerPostingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LedgerPostingCreateBulk.OnConflict
+// documentation for more info.
+func (u *LedgerPostingUpsertBulk) Update(set func(*LedgerPostingUpsert)) *LedgerPostingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LedgerPostingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *LedgerPostingUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the LedgerPostingCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LedgerPostingCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LedgerPostingUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
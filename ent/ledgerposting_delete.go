@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/ledgerposting"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+)
+
+// LedgerPostingDelete is the builder for deleting a LedgerPosting entity.
+type LedgerPostingDelete struct {
+	config
+	hooks    []Hook
+	mutation *LedgerPostingMutation
+}
+
+// Where appends a list predicates to the LedgerPostingDelete builder.
+func (lpd *LedgerPostingDelete) Where(ps ...predicate.LedgerPosting) *LedgerPostingDelete {
+	lpd.mutation.Where(ps...)
+	return lpd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (lpd *LedgerPostingDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, lpd.sqlExec, lpd.mutation, lpd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lpd *LedgerPostingDelete) ExecX(ctx context.Context) int {
+	n, err := lpd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (lpd *LedgerPostingDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(ledgerposting.Table, sqlgraph.NewFieldSpec(ledgerposting.FieldID, field.TypeUUID))
+	if ps := lpd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, lpd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	lpd.mutation.done = true
+	return affected, err
+}
+
+// LedgerPostingDeleteOne is the builder for deleting a single LedgerPosting entity.
+type LedgerPostingDeleteOne struct {
+	lpd *LedgerPostingDelete
+}
+
+// Where appends a list predicates to the LedgerPostingDelete builder.
+func (lpdo *LedgerPostingDeleteOne) Where(ps ...predicate.LedgerPosting) *LedgerPostingDeleteOne {
+	lpdo.lpd.mutation.Where(ps...)
+	return lpdo
+}
+
+// Exec executes the deletion query.
+func (lpdo *LedgerPostingDeleteOne) Exec(ctx context.Context) error {
+	n, err := lpdo.lpd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{ledgerposting.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lpdo *LedgerPostingDeleteOne) ExecX(ctx context.Context) {
+	if err := lpdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
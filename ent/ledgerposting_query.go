@@ -0,0 +1,690 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/ledgeraccount"
+	"github.com/NEDA-LABS/stablenode/ent/ledgerentry"
+	"github.com/NEDA-LABS/stablenode/ent/ledgerposting"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// LedgerPostingQuery is the builder for querying LedgerPosting entities.
+type LedgerPostingQuery struct {
+	config
+	ctx         *QueryContext
+	order       []ledgerposting.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.LedgerPosting
+	withEntry   *LedgerEntryQuery
+	withAccount *LedgerAccountQuery
+	withFKs     bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the LedgerPostingQuery builder.
+func (lpq *LedgerPostingQuery) Where(ps ...predicate.LedgerPosting) *LedgerPostingQuery {
+	lpq.predicates = append(lpq.predicates, ps...)
+	return lpq
+}
+
+// Limit the number of records to be returned by this query.
+func (lpq *LedgerPostingQuery) Limit(limit int) *LedgerPostingQuery {
+	lpq.ctx.Limit = &limit
+	return lpq
+}
+
+// Offset to start from.
+func (lpq *LedgerPostingQuery) Offset(offset int) *LedgerPostingQuery {
+	lpq.ctx.Offset = &offset
+	return lpq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (lpq *LedgerPostingQuery) Unique(unique bool) *LedgerPostingQuery {
+	lpq.ctx.Unique = &unique
+	return lpq
+}
+
+// Order specifies how the records should be ordered.
+func (lpq *LedgerPostingQuery) Order(o ...ledgerposting.OrderOption) *LedgerPostingQuery {
+	lpq.order = append(lpq.order, o...)
+	return lpq
+}
+
+// QueryEntry chains the current query on the "entry" edge.
+func (lpq *LedgerPostingQuery) QueryEntry() *LedgerEntryQuery {
+	query := (&LedgerEntryClient{config: lpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ledgerposting.Table, ledgerposting.FieldID, selector),
+			sqlgraph.To(ledgerentry.Table, ledgerentry.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ledgerposting.EntryTable, ledgerposting.EntryColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAccount chains the current query on the "account" edge.
+func (lpq *LedgerPostingQuery) QueryAccount() *LedgerAccountQuery {
+	query := (&LedgerAccountClient{config: lpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ledgerposting.Table, ledgerposting.FieldID, selector),
+			sqlgraph.To(ledgeraccount.Table, ledgeraccount.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ledgerposting.AccountTable, ledgerposting.AccountColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first LedgerPosting entity from the query.
+// Returns a *NotFoundError when no LedgerPosting was found.
+func (lpq *LedgerPostingQuery) First(ctx context.Context) (*LedgerPosting, error) {
+	nodes, err := lpq.Limit(1).All(setContextOp(ctx, lpq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{ledgerposting.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (lpq *LedgerPostingQuery) FirstX(ctx context.Context) *LedgerPosting {
+	node, err := lpq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first LedgerPosting ID from the query.
+// Returns a *NotFoundError when no LedgerPosting ID was found.
+func (lpq *LedgerPostingQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = lpq.Limit(1).IDs(setContextOp(ctx, lpq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{ledgerposting.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (lpq *LedgerPostingQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := lpq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single LedgerPosting entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one LedgerPosting entity is found.
+// Returns a *NotFoundError when no LedgerPosting entities are found.
+func (lpq *LedgerPostingQuery) Only(ctx context.Context) (*LedgerPosting, error) {
+	nodes, err := lpq.Limit(2).All(setContextOp(ctx, lpq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{ledgerposting.Label}
+	default:
+		return nil, &NotSingularError{ledgerposting.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (lpq *LedgerPostingQuery) OnlyX(ctx context.Context) *LedgerPosting {
+	node, err := lpq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only LedgerPosting ID in the query.
+// Returns a *NotSingularError when more than one LedgerPosting ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (lpq *LedgerPostingQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = lpq.Limit(2).IDs(setContextOp(ctx, lpq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{ledgerposting.Label}
+	default:
+		err = &NotSingularError{ledgerposting.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (lpq *LedgerPostingQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := lpq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of LedgerPostings.
+func (lpq *LedgerPostingQuery) All(ctx context.Context) ([]*LedgerPosting, error) {
+	ctx = setContextOp(ctx, lpq.ctx, ent.OpQueryAll)
+	if err := lpq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*LedgerPosting, *LedgerPostingQuery]()
+	return withInterceptors[[]*LedgerPosting](ctx, lpq, qr, lpq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (lpq *LedgerPostingQuery) AllX(ctx context.Context) []*LedgerPosting {
+	nodes, err := lpq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of LedgerPosting IDs.
+func (lpq *LedgerPostingQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if lpq.ctx.Unique == nil && lpq.path != nil {
+		lpq.Unique(true)
+	}
+	ctx = setContextOp(ctx, lpq.ctx, ent.OpQueryIDs)
+	if err = lpq.Select(ledgerposting.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (lpq *LedgerPostingQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := lpq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (lpq *LedgerPostingQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, lpq.ctx, ent.OpQueryCount)
+	if err := lpq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, lpq, querierCount[*LedgerPostingQuery](), lpq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (lpq *LedgerPostingQuery) CountX(ctx context.Context) int {
+	count, err := lpq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (lpq *LedgerPostingQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, lpq.ctx, ent.OpQueryExist)
+	switch _, err := lpq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (lpq *LedgerPostingQuery) ExistX(ctx context.Context) bool {
+	exist, err := lpq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the LedgerPostingQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (lpq *LedgerPostingQuery) Clone() *LedgerPostingQuery {
+	if lpq == nil {
+		return nil
+	}
+	return &LedgerPostingQuery{
+		config:      lpq.config,
+		ctx:         lpq.ctx.Clone(),
+		order:       append([]ledgerposting.OrderOption{}, lpq.order...),
+		inters:      append([]Interceptor{}, lpq.inters...),
+		predicates:  append([]predicate.LedgerPosting{}, lpq.predicates...),
+		withEntry:   lpq.withEntry.Clone(),
+		withAccount: lpq.withAccount.Clone(),
+		// clone intermediate query.
+		sql:  lpq.sql.Clone(),
+		path: lpq.path,
+	}
+}
+
+// WithEntry tells the query-builder to eager-load the nodes that are connected to
+// the "entry" edge. The optional arguments are used to configure the query builder of the edge.
+func (lpq *LedgerPostingQuery) WithEntry(opts ...func(*LedgerEntryQuery)) *LedgerPostingQuery {
+	query := (&LedgerEntryClient{config: lpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	lpq.withEntry = query
+	return lpq
+}
+
+// WithAccount tells the query-builder to eager-load the nodes that are connected to
+// the "account" edge. The optional arguments are used to configure the query builder of the edge.
+func (lpq *LedgerPostingQuery) WithAccount(opts ...func(*LedgerAccountQuery)) *LedgerPostingQuery {
+	query := (&LedgerAccountClient{config: lpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	lpq.withAccount = query
+	return lpq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Amount decimal.Decimal `json:"amount,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.LedgerPosting.Query().
+//		GroupBy(ledgerposting.FieldAmount).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (lpq *LedgerPostingQuery) GroupBy(field string, fields ...string) *LedgerPostingGroupBy {
+	lpq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &LedgerPostingGroupBy{build: lpq}
+	grbuild.flds = &lpq.ctx.Fields
+	grbuild.label = ledgerposting.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Amount decimal.Decimal `json:"amount,omitempty"`
+//	}
+//
+//	client.LedgerPosting.Query().
+//		Select(ledgerposting.FieldAmount).
+//		Scan(ctx, &v)
+func (lpq *LedgerPostingQuery) Select(fields ...string) *LedgerPostingSelect {
+	lpq.ctx.Fields = append(lpq.ctx.Fields, fields...)
+	sbuild := &LedgerPostingSelect{LedgerPostingQuery: lpq}
+	sbuild.label = ledgerposting.Label
+	sbuild.flds, sbuild.scan = &lpq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a LedgerPostingSelect configured with the given aggregations.
+func (lpq *LedgerPostingQuery) Aggregate(fns ...AggregateFunc) *LedgerPostingSelect {
+	return lpq.Select().Aggregate(fns...)
+}
+
+func (lpq *LedgerPostingQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range lpq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, lpq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range lpq.ctx.Fields {
+		if !ledgerposting.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if lpq.path != nil {
+		prev, err := lpq.path(ctx)
+		if err != nil {
+			return err
+		}
+		lpq.sql = prev
+	}
+	return nil
+}
+
+func (lpq *LedgerPostingQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*LedgerPosting, error) {
+	var (
+		nodes       = []*LedgerPosting{}
+		withFKs     = lpq.withFKs
+		_spec       = lpq.querySpec()
+		loadedTypes = [2]bool{
+			lpq.withEntry != nil,
+			lpq.withAccount != nil,
+		}
+	)
+	if lpq.withEntry != nil || lpq.withAccount != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, ledgerposting.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*LedgerPosting).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &LedgerPosting{config: lpq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, lpq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := lpq.withEntry; query != nil {
+		if err := lpq.loadEntry(ctx, query, nodes, nil,
+			func(n *LedgerPosting, e *LedgerEntry) { n.Edges.Entry = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := lpq.withAccount; query != nil {
+		if err := lpq.loadAccount(ctx, query, nodes, nil,
+			func(n *LedgerPosting, e *LedgerAccount) { n.Edges.Account = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (lpq *LedgerPostingQuery) loadEntry(ctx context.Context, query *LedgerEntryQuery, nodes []*LedgerPosting, init func(*LedgerPosting), assign func(*LedgerPosting, *LedgerEntry)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*LedgerPosting)
+	for i := range nodes {
+		if nodes[i].ledger_entry_postings == nil {
+			continue
+		}
+		fk := *nodes[i].ledger_entry_postings
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(ledgerentry.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "ledger_entry_postings" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (lpq *LedgerPostingQuery) loadAccount(ctx context.Context, query *LedgerAccountQuery, nodes []*LedgerPosting, init func(*LedgerPosting), assign func(*LedgerPosting, *LedgerAccount)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*LedgerPosting)
+	for i := range nodes {
+		if nodes[i].ledger_account_postings == nil {
+			continue
+		}
+		fk := *nodes[i].ledger_account_postings
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(ledgeraccount.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "ledger_account_postings" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (lpq *LedgerPostingQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := lpq.querySpec()
+	_spec.Node.Columns = lpq.ctx.Fields
+	if len(lpq.ctx.Fields) > 0 {
+		_spec.Unique = lpq.ctx.Unique != nil && *lpq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, lpq.driver, _spec)
+}
+
+func (lpq *LedgerPostingQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(ledgerposting.Table, ledgerposting.Columns, sqlgraph.NewFieldSpec(ledgerposting.FieldID, field.TypeUUID))
+	_spec.From = lpq.sql
+	if unique := lpq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if lpq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := lpq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ledgerposting.FieldID)
+		for i := range fields {
+			if fields[i] != ledgerposting.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := lpq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := lpq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := lpq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := lpq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (lpq *LedgerPostingQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(lpq.driver.Dialect())
+	t1 := builder.Table(ledgerposting.Table)
+	columns := lpq.ctx.Fields
+	if len(columns) == 0 {
+		columns = ledgerposting.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if lpq.sql != nil {
+		selector = lpq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if lpq.ctx.Unique != nil && *lpq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range lpq.predicates {
+		p(selector)
+	}
+	for _, p := range lpq.order {
+		p(selector)
+	}
+	if offset := lpq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := lpq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// LedgerPostingGroupBy is the group-by builder for LedgerPosting entities.
+type LedgerPostingGroupBy struct {
+	selector
+	build *LedgerPostingQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (lpgb *LedgerPostingGroupBy) Aggregate(fns ...AggregateFunc) *LedgerPostingGroupBy {
+	lpgb.fns = append(lpgb.fns, fns...)
+	return lpgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (lpgb *LedgerPostingGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, lpgb.build.ctx, ent.OpQueryGroupBy)
+	if err := lpgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LedgerPostingQuery, *LedgerPostingGroupBy](ctx, lpgb.build, lpgb, lpgb.build.inters, v)
+}
+
+func (lpgb *LedgerPostingGroupBy) sqlScan(ctx context.Context, root *LedgerPostingQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(lpgb.fns))
+	for _, fn := range lpgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*lpgb.flds)+len(lpgb.fns))
+		for _, f := range *lpgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*lpgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := lpgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// LedgerPostingSelect is the builder for selecting fields of LedgerPosting entities.
+type LedgerPostingSelect struct {
+	*LedgerPostingQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (lps *LedgerPostingSelect) Aggregate(fns ...AggregateFunc) *LedgerPostingSelect {
+	lps.fns = append(lps.fns, fns...)
+	return lps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (lps *LedgerPostingSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, lps.ctx, ent.OpQuerySelect)
+	if err := lps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LedgerPostingQuery, *LedgerPostingSelect](ctx, lps.LedgerPostingQuery, lps, lps.inters, v)
+}
+
+func (lps *LedgerPostingSelect) sqlScan(ctx context.Context, root *LedgerPostingQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(lps.fns))
+	for _, fn := range lps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*lps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := lps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
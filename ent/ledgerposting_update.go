@@ -0,0 +1,203 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/ledgerposting"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+)
+
+// LedgerPostingUpdate is the builder for updating LedgerPosting entities.
+type LedgerPostingUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LedgerPostingMutation
+}
+
+// Where appends a list predicates to the LedgerPostingUpdate builder.
+func (lpu *LedgerPostingUpdate) Where(ps ...predicate.LedgerPosting) *LedgerPostingUpdate {
+	lpu.mutation.Where(ps...)
+	return lpu
+}
+
+// Mutation returns the LedgerPostingMutation object of the builder.
+func (lpu *LedgerPostingUpdate) Mutation() *LedgerPostingMutation {
+	return lpu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (lpu *LedgerPostingUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, lpu.sqlSave, lpu.mutation, lpu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lpu *LedgerPostingUpdate) SaveX(ctx context.Context) int {
+	affected, err := lpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (lpu *LedgerPostingUpdate) Exec(ctx context.Context) error {
+	_, err := lpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lpu *LedgerPostingUpdate) ExecX(ctx context.Context) {
+	if err := lpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lpu *LedgerPostingUpdate) check() error {
+	if lpu.mutation.EntryCleared() && len(lpu.mutation.EntryIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LedgerPosting.entry"`)
+	}
+	if lpu.mutation.AccountCleared() && len(lpu.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LedgerPosting.account"`)
+	}
+	return nil
+}
+
+func (lpu *LedgerPostingUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := lpu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ledgerposting.Table, ledgerposting.Columns, sqlgraph.NewFieldSpec(ledgerposting.FieldID, field.TypeUUID))
+	if ps := lpu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, lpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ledgerposting.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	lpu.mutation.done = true
+	return n, nil
+}
+
+// LedgerPostingUpdateOne is the builder for updating a single LedgerPosting entity.
+type LedgerPostingUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LedgerPostingMutation
+}
+
+// Mutation returns the LedgerPostingMutation object of the builder.
+func (lpuo *LedgerPostingUpdateOne) Mutation() *LedgerPostingMutation {
+	return lpuo.mutation
+}
+
+// Where appends a list predicates to the LedgerPostingUpdate builder.
+func (lpuo *LedgerPostingUpdateOne) Where(ps ...predicate.LedgerPosting) *LedgerPostingUpdateOne {
+	lpuo.mutation.Where(ps...)
+	return lpuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (lpuo *LedgerPostingUpdateOne) Select(field string, fields ...string) *LedgerPostingUpdateOne {
+	lpuo.fields = append([]string{field}, fields...)
+	return lpuo
+}
+
+// Save executes the query and returns the updated LedgerPosting entity.
+func (lpuo *LedgerPostingUpdateOne) Save(ctx context.Context) (*LedgerPosting, error) {
+	return withHooks(ctx, lpuo.sqlSave, lpuo.mutation, lpuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lpuo *LedgerPostingUpdateOne) SaveX(ctx context.Context) *LedgerPosting {
+	node, err := lpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (lpuo *LedgerPostingUpdateOne) Exec(ctx context.Context) error {
+	_, err := lpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lpuo *LedgerPostingUpdateOne) ExecX(ctx context.Context) {
+	if err := lpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lpuo *LedgerPostingUpdateOne) check() error {
+	if lpuo.mutation.EntryCleared() && len(lpuo.mutation.EntryIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LedgerPosting.entry"`)
+	}
+	if lpuo.mutation.AccountCleared() && len(lpuo.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LedgerPosting.account"`)
+	}
+	return nil
+}
+
+func (lpuo *LedgerPostingUpdateOne) sqlSave(ctx context.Context) (_node *LedgerPosting, err error) {
+	if err := lpuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ledgerposting.Table, ledgerposting.Columns, sqlgraph.NewFieldSpec(ledgerposting.FieldID, field.TypeUUID))
+	id, ok := lpuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LedgerPosting.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := lpuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ledgerposting.FieldID)
+		for _, f := range fields {
+			if !ledgerposting.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ledgerposting.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := lpuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &LedgerPosting{config: lpuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, lpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ledgerposting.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	lpuo.mutation.done = true
+	return _node, nil
+}
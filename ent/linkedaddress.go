@@ -11,7 +11,9 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/NEDA-LABS/stablenode/ent/linkedaddress"
+	"github.com/NEDA-LABS/stablenode/ent/senderprofile"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 // LinkedAddress is the model entity for the LinkedAddress schema.
@@ -41,6 +43,20 @@ type LinkedAddress struct {
 	LastIndexedBlock int64 `json:"last_indexed_block,omitempty"`
 	// TxHash holds the value of the "tx_hash" field.
 	TxHash string `json:"tx_hash,omitempty"`
+	// Handle holds the value of the "handle" field.
+	Handle string `json:"handle,omitempty"`
+	// IsActive holds the value of the "is_active" field.
+	IsActive bool `json:"is_active,omitempty"`
+	// AllowedNetworks holds the value of the "allowed_networks" field.
+	AllowedNetworks []string `json:"allowed_networks,omitempty"`
+	// AllowedTokens holds the value of the "allowed_tokens" field.
+	AllowedTokens []string `json:"allowed_tokens,omitempty"`
+	// MaxTransferAmount holds the value of the "max_transfer_amount" field.
+	MaxTransferAmount decimal.Decimal `json:"max_transfer_amount,omitempty"`
+	// DailyVolumeLimit holds the value of the "daily_volume_limit" field.
+	DailyVolumeLimit decimal.Decimal `json:"daily_volume_limit,omitempty"`
+	// ReplacedBy holds the value of the "replaced_by" field.
+	ReplacedBy string `json:"replaced_by,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the LinkedAddressQuery when eager-loading is set.
 	Edges                         LinkedAddressEdges `json:"edges"`
@@ -52,9 +68,11 @@ type LinkedAddress struct {
 type LinkedAddressEdges struct {
 	// PaymentOrders holds the value of the payment_orders edge.
 	PaymentOrders []*PaymentOrder `json:"payment_orders,omitempty"`
+	// SenderProfile holds the value of the sender_profile edge.
+	SenderProfile *SenderProfile `json:"sender_profile,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // PaymentOrdersOrErr returns the PaymentOrders value or an error if the edge
@@ -66,16 +84,31 @@ func (e LinkedAddressEdges) PaymentOrdersOrErr() ([]*PaymentOrder, error) {
 	return nil, &NotLoadedError{edge: "payment_orders"}
 }
 
+// SenderProfileOrErr returns the SenderProfile value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LinkedAddressEdges) SenderProfileOrErr() (*SenderProfile, error) {
+	if e.SenderProfile != nil {
+		return e.SenderProfile, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: senderprofile.Label}
+	}
+	return nil, &NotLoadedError{edge: "sender_profile"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*LinkedAddress) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case linkedaddress.FieldSalt, linkedaddress.FieldMetadata:
+		case linkedaddress.FieldSalt, linkedaddress.FieldMetadata, linkedaddress.FieldAllowedNetworks, linkedaddress.FieldAllowedTokens:
 			values[i] = new([]byte)
+		case linkedaddress.FieldMaxTransferAmount, linkedaddress.FieldDailyVolumeLimit:
+			values[i] = new(decimal.Decimal)
+		case linkedaddress.FieldIsActive:
+			values[i] = new(sql.NullBool)
 		case linkedaddress.FieldID, linkedaddress.FieldLastIndexedBlock:
 			values[i] = new(sql.NullInt64)
-		case linkedaddress.FieldAddress, linkedaddress.FieldInstitution, linkedaddress.FieldAccountIdentifier, linkedaddress.FieldAccountName, linkedaddress.FieldOwnerAddress, linkedaddress.FieldTxHash:
+		case linkedaddress.FieldAddress, linkedaddress.FieldInstitution, linkedaddress.FieldAccountIdentifier, linkedaddress.FieldAccountName, linkedaddress.FieldOwnerAddress, linkedaddress.FieldTxHash, linkedaddress.FieldHandle, linkedaddress.FieldReplacedBy:
 			values[i] = new(sql.NullString)
 		case linkedaddress.FieldCreatedAt, linkedaddress.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -170,6 +203,52 @@ func (la *LinkedAddress) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				la.TxHash = value.String
 			}
+		case linkedaddress.FieldHandle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field handle", values[i])
+			} else if value.Valid {
+				la.Handle = value.String
+			}
+		case linkedaddress.FieldIsActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_active", values[i])
+			} else if value.Valid {
+				la.IsActive = value.Bool
+			}
+		case linkedaddress.FieldAllowedNetworks:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field allowed_networks", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &la.AllowedNetworks); err != nil {
+					return fmt.Errorf("unmarshal field allowed_networks: %w", err)
+				}
+			}
+		case linkedaddress.FieldAllowedTokens:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field allowed_tokens", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &la.AllowedTokens); err != nil {
+					return fmt.Errorf("unmarshal field allowed_tokens: %w", err)
+				}
+			}
+		case linkedaddress.FieldMaxTransferAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field max_transfer_amount", values[i])
+			} else if value != nil {
+				la.MaxTransferAmount = *value
+			}
+		case linkedaddress.FieldDailyVolumeLimit:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field daily_volume_limit", values[i])
+			} else if value != nil {
+				la.DailyVolumeLimit = *value
+			}
+		case linkedaddress.FieldReplacedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field replaced_by", values[i])
+			} else if value.Valid {
+				la.ReplacedBy = value.String
+			}
 		case linkedaddress.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field sender_profile_linked_address", values[i])
@@ -195,6 +274,11 @@ func (la *LinkedAddress) QueryPaymentOrders() *PaymentOrderQuery {
 	return NewLinkedAddressClient(la.config).QueryPaymentOrders(la)
 }
 
+// QuerySenderProfile queries the "sender_profile" edge of the LinkedAddress entity.
+func (la *LinkedAddress) QuerySenderProfile() *SenderProfileQuery {
+	return NewLinkedAddressClient(la.config).QuerySenderProfile(la)
+}
+
 // Update returns a builder for updating this LinkedAddress.
 // Note that you need to call LinkedAddress.Unwrap() before calling this method if this LinkedAddress
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -250,6 +334,27 @@ func (la *LinkedAddress) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("tx_hash=")
 	builder.WriteString(la.TxHash)
+	builder.WriteString(", ")
+	builder.WriteString("handle=")
+	builder.WriteString(la.Handle)
+	builder.WriteString(", ")
+	builder.WriteString("is_active=")
+	builder.WriteString(fmt.Sprintf("%v", la.IsActive))
+	builder.WriteString(", ")
+	builder.WriteString("allowed_networks=")
+	builder.WriteString(fmt.Sprintf("%v", la.AllowedNetworks))
+	builder.WriteString(", ")
+	builder.WriteString("allowed_tokens=")
+	builder.WriteString(fmt.Sprintf("%v", la.AllowedTokens))
+	builder.WriteString(", ")
+	builder.WriteString("max_transfer_amount=")
+	builder.WriteString(fmt.Sprintf("%v", la.MaxTransferAmount))
+	builder.WriteString(", ")
+	builder.WriteString("daily_volume_limit=")
+	builder.WriteString(fmt.Sprintf("%v", la.DailyVolumeLimit))
+	builder.WriteString(", ")
+	builder.WriteString("replaced_by=")
+	builder.WriteString(la.ReplacedBy)
 	builder.WriteByte(')')
 	return builder.String()
 }
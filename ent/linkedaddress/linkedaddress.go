@@ -7,6 +7,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
 )
 
 const (
@@ -36,8 +37,24 @@ const (
 	FieldLastIndexedBlock = "last_indexed_block"
 	// FieldTxHash holds the string denoting the tx_hash field in the database.
 	FieldTxHash = "tx_hash"
+	// FieldHandle holds the string denoting the handle field in the database.
+	FieldHandle = "handle"
+	// FieldIsActive holds the string denoting the is_active field in the database.
+	FieldIsActive = "is_active"
+	// FieldAllowedNetworks holds the string denoting the allowed_networks field in the database.
+	FieldAllowedNetworks = "allowed_networks"
+	// FieldAllowedTokens holds the string denoting the allowed_tokens field in the database.
+	FieldAllowedTokens = "allowed_tokens"
+	// FieldMaxTransferAmount holds the string denoting the max_transfer_amount field in the database.
+	FieldMaxTransferAmount = "max_transfer_amount"
+	// FieldDailyVolumeLimit holds the string denoting the daily_volume_limit field in the database.
+	FieldDailyVolumeLimit = "daily_volume_limit"
+	// FieldReplacedBy holds the string denoting the replaced_by field in the database.
+	FieldReplacedBy = "replaced_by"
 	// EdgePaymentOrders holds the string denoting the payment_orders edge name in mutations.
 	EdgePaymentOrders = "payment_orders"
+	// EdgeSenderProfile holds the string denoting the sender_profile edge name in mutations.
+	EdgeSenderProfile = "sender_profile"
 	// Table holds the table name of the linkedaddress in the database.
 	Table = "linked_addresses"
 	// PaymentOrdersTable is the table that holds the payment_orders relation/edge.
@@ -47,6 +64,13 @@ const (
 	PaymentOrdersInverseTable = "payment_orders"
 	// PaymentOrdersColumn is the table column denoting the payment_orders relation/edge.
 	PaymentOrdersColumn = "linked_address_payment_orders"
+	// SenderProfileTable is the table that holds the sender_profile relation/edge.
+	SenderProfileTable = "linked_addresses"
+	// SenderProfileInverseTable is the table name for the SenderProfile entity.
+	// It exists in this package in order to avoid circular dependency with the "senderprofile" package.
+	SenderProfileInverseTable = "sender_profiles"
+	// SenderProfileColumn is the table column denoting the sender_profile relation/edge.
+	SenderProfileColumn = "sender_profile_linked_address"
 )
 
 // Columns holds all SQL columns for linkedaddress fields.
@@ -63,6 +87,13 @@ var Columns = []string{
 	FieldOwnerAddress,
 	FieldLastIndexedBlock,
 	FieldTxHash,
+	FieldHandle,
+	FieldIsActive,
+	FieldAllowedNetworks,
+	FieldAllowedTokens,
+	FieldMaxTransferAmount,
+	FieldDailyVolumeLimit,
+	FieldReplacedBy,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "linked_addresses"
@@ -95,6 +126,14 @@ var (
 	UpdateDefaultUpdatedAt func() time.Time
 	// TxHashValidator is a validator for the "tx_hash" field. It is called by the builders before save.
 	TxHashValidator func(string) error
+	// HandleValidator is a validator for the "handle" field. It is called by the builders before save.
+	HandleValidator func(string) error
+	// DefaultIsActive holds the default value on creation for the "is_active" field.
+	DefaultIsActive bool
+	// DefaultMaxTransferAmount holds the default value on creation for the "max_transfer_amount" field.
+	DefaultMaxTransferAmount func() decimal.Decimal
+	// DefaultDailyVolumeLimit holds the default value on creation for the "daily_volume_limit" field.
+	DefaultDailyVolumeLimit func() decimal.Decimal
 )
 
 // OrderOption defines the ordering options for the LinkedAddress queries.
@@ -150,6 +189,31 @@ func ByTxHash(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTxHash, opts...).ToFunc()
 }
 
+// ByHandle orders the results by the handle field.
+func ByHandle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHandle, opts...).ToFunc()
+}
+
+// ByIsActive orders the results by the is_active field.
+func ByIsActive(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsActive, opts...).ToFunc()
+}
+
+// ByMaxTransferAmount orders the results by the max_transfer_amount field.
+func ByMaxTransferAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxTransferAmount, opts...).ToFunc()
+}
+
+// ByDailyVolumeLimit orders the results by the daily_volume_limit field.
+func ByDailyVolumeLimit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDailyVolumeLimit, opts...).ToFunc()
+}
+
+// ByReplacedBy orders the results by the replaced_by field.
+func ByReplacedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReplacedBy, opts...).ToFunc()
+}
+
 // ByPaymentOrdersCount orders the results by payment_orders count.
 func ByPaymentOrdersCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -163,6 +227,13 @@ func ByPaymentOrders(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newPaymentOrdersStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// BySenderProfileField orders the results by sender_profile field.
+func BySenderProfileField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSenderProfileStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newPaymentOrdersStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -170,3 +241,10 @@ func newPaymentOrdersStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, PaymentOrdersTable, PaymentOrdersColumn),
 	)
 }
+func newSenderProfileStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SenderProfileInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SenderProfileTable, SenderProfileColumn),
+	)
+}
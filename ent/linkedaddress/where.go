@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/shopspring/decimal"
 )
 
 // ID filters vertices based on their ID field.
@@ -105,6 +106,31 @@ func TxHash(v string) predicate.LinkedAddress {
 	return predicate.LinkedAddress(sql.FieldEQ(FieldTxHash, v))
 }
 
+// Handle applies equality check predicate on the "handle" field. It's identical to HandleEQ.
+func Handle(v string) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldEQ(FieldHandle, v))
+}
+
+// IsActive applies equality check predicate on the "is_active" field. It's identical to IsActiveEQ.
+func IsActive(v bool) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldEQ(FieldIsActive, v))
+}
+
+// MaxTransferAmount applies equality check predicate on the "max_transfer_amount" field. It's identical to MaxTransferAmountEQ.
+func MaxTransferAmount(v decimal.Decimal) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldEQ(FieldMaxTransferAmount, v))
+}
+
+// DailyVolumeLimit applies equality check predicate on the "daily_volume_limit" field. It's identical to DailyVolumeLimitEQ.
+func DailyVolumeLimit(v decimal.Decimal) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldEQ(FieldDailyVolumeLimit, v))
+}
+
+// ReplacedBy applies equality check predicate on the "replaced_by" field. It's identical to ReplacedByEQ.
+func ReplacedBy(v string) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldEQ(FieldReplacedBy, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.LinkedAddress {
 	return predicate.LinkedAddress(sql.FieldEQ(FieldCreatedAt, v))
@@ -560,6 +586,16 @@ func OwnerAddressHasSuffix(v string) predicate.LinkedAddress {
 	return predicate.LinkedAddress(sql.FieldHasSuffix(FieldOwnerAddress, v))
 }
 
+// OwnerAddressIsNil applies the IsNil predicate on the "owner_address" field.
+func OwnerAddressIsNil() predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldIsNull(FieldOwnerAddress))
+}
+
+// OwnerAddressNotNil applies the NotNil predicate on the "owner_address" field.
+func OwnerAddressNotNil() predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldNotNull(FieldOwnerAddress))
+}
+
 // OwnerAddressEqualFold applies the EqualFold predicate on the "owner_address" field.
 func OwnerAddressEqualFold(v string) predicate.LinkedAddress {
 	return predicate.LinkedAddress(sql.FieldEqualFold(FieldOwnerAddress, v))
@@ -695,6 +731,266 @@ func TxHashContainsFold(v string) predicate.LinkedAddress {
 	return predicate.LinkedAddress(sql.FieldContainsFold(FieldTxHash, v))
 }
 
+// HandleEQ applies the EQ predicate on the "handle" field.
+func HandleEQ(v string) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldEQ(FieldHandle, v))
+}
+
+// HandleNEQ applies the NEQ predicate on the "handle" field.
+func HandleNEQ(v string) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldNEQ(FieldHandle, v))
+}
+
+// HandleIn applies the In predicate on the "handle" field.
+func HandleIn(vs ...string) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldIn(FieldHandle, vs...))
+}
+
+// HandleNotIn applies the NotIn predicate on the "handle" field.
+func HandleNotIn(vs ...string) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldNotIn(FieldHandle, vs...))
+}
+
+// HandleGT applies the GT predicate on the "handle" field.
+func HandleGT(v string) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldGT(FieldHandle, v))
+}
+
+// HandleGTE applies the GTE predicate on the "handle" field.
+func HandleGTE(v string) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldGTE(FieldHandle, v))
+}
+
+// HandleLT applies the LT predicate on the "handle" field.
+func HandleLT(v string) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldLT(FieldHandle, v))
+}
+
+// HandleLTE applies the LTE predicate on the "handle" field.
+func HandleLTE(v string) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldLTE(FieldHandle, v))
+}
+
+// HandleContains applies the Contains predicate on the "handle" field.
+func HandleContains(v string) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldContains(FieldHandle, v))
+}
+
+// HandleHasPrefix applies the HasPrefix predicate on the "handle" field.
+func HandleHasPrefix(v string) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldHasPrefix(FieldHandle, v))
+}
+
+// HandleHasSuffix applies the HasSuffix predicate on the "handle" field.
+func HandleHasSuffix(v string) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldHasSuffix(FieldHandle, v))
+}
+
+// HandleIsNil applies the IsNil predicate on the "handle" field.
+func HandleIsNil() predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldIsNull(FieldHandle))
+}
+
+// HandleNotNil applies the NotNil predicate on the "handle" field.
+func HandleNotNil() predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldNotNull(FieldHandle))
+}
+
+// HandleEqualFold applies the EqualFold predicate on the "handle" field.
+func HandleEqualFold(v string) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldEqualFold(FieldHandle, v))
+}
+
+// HandleContainsFold applies the ContainsFold predicate on the "handle" field.
+func HandleContainsFold(v string) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldContainsFold(FieldHandle, v))
+}
+
+// IsActiveEQ applies the EQ predicate on the "is_active" field.
+func IsActiveEQ(v bool) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldEQ(FieldIsActive, v))
+}
+
+// IsActiveNEQ applies the NEQ predicate on the "is_active" field.
+func IsActiveNEQ(v bool) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldNEQ(FieldIsActive, v))
+}
+
+// AllowedNetworksIsNil applies the IsNil predicate on the "allowed_networks" field.
+func AllowedNetworksIsNil() predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldIsNull(FieldAllowedNetworks))
+}
+
+// AllowedNetworksNotNil applies the NotNil predicate on the "allowed_networks" field.
+func AllowedNetworksNotNil() predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldNotNull(FieldAllowedNetworks))
+}
+
+// AllowedTokensIsNil applies the IsNil predicate on the "allowed_tokens" field.
+func AllowedTokensIsNil() predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldIsNull(FieldAllowedTokens))
+}
+
+// AllowedTokensNotNil applies the NotNil predicate on the "allowed_tokens" field.
+func AllowedTokensNotNil() predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldNotNull(FieldAllowedTokens))
+}
+
+// MaxTransferAmountEQ applies the EQ predicate on the "max_transfer_amount" field.
+func MaxTransferAmountEQ(v decimal.Decimal) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldEQ(FieldMaxTransferAmount, v))
+}
+
+// MaxTransferAmountNEQ applies the NEQ predicate on the "max_transfer_amount" field.
+func MaxTransferAmountNEQ(v decimal.Decimal) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldNEQ(FieldMaxTransferAmount, v))
+}
+
+// MaxTransferAmountIn applies the In predicate on the "max_transfer_amount" field.
+func MaxTransferAmountIn(vs ...decimal.Decimal) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldIn(FieldMaxTransferAmount, vs...))
+}
+
+// MaxTransferAmountNotIn applies the NotIn predicate on the "max_transfer_amount" field.
+func MaxTransferAmountNotIn(vs ...decimal.Decimal) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldNotIn(FieldMaxTransferAmount, vs...))
+}
+
+// MaxTransferAmountGT applies the GT predicate on the "max_transfer_amount" field.
+func MaxTransferAmountGT(v decimal.Decimal) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldGT(FieldMaxTransferAmount, v))
+}
+
+// MaxTransferAmountGTE applies the GTE predicate on the "max_transfer_amount" field.
+func MaxTransferAmountGTE(v decimal.Decimal) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldGTE(FieldMaxTransferAmount, v))
+}
+
+// MaxTransferAmountLT applies the LT predicate on the "max_transfer_amount" field.
+func MaxTransferAmountLT(v decimal.Decimal) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldLT(FieldMaxTransferAmount, v))
+}
+
+// MaxTransferAmountLTE applies the LTE predicate on the "max_transfer_amount" field.
+func MaxTransferAmountLTE(v decimal.Decimal) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldLTE(FieldMaxTransferAmount, v))
+}
+
+// DailyVolumeLimitEQ applies the EQ predicate on the "daily_volume_limit" field.
+func DailyVolumeLimitEQ(v decimal.Decimal) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldEQ(FieldDailyVolumeLimit, v))
+}
+
+// DailyVolumeLimitNEQ applies the NEQ predicate on the "daily_volume_limit" field.
+func DailyVolumeLimitNEQ(v decimal.Decimal) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldNEQ(FieldDailyVolumeLimit, v))
+}
+
+// DailyVolumeLimitIn applies the In predicate on the "daily_volume_limit" field.
+func DailyVolumeLimitIn(vs ...decimal.Decimal) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldIn(FieldDailyVolumeLimit, vs...))
+}
+
+// DailyVolumeLimitNotIn applies the NotIn predicate on the "daily_volume_limit" field.
+func DailyVolumeLimitNotIn(vs ...decimal.Decimal) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldNotIn(FieldDailyVolumeLimit, vs...))
+}
+
+// DailyVolumeLimitGT applies the GT predicate on the "daily_volume_limit" field.
+func DailyVolumeLimitGT(v decimal.Decimal) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldGT(FieldDailyVolumeLimit, v))
+}
+
+// DailyVolumeLimitGTE applies the GTE predicate on the "daily_volume_limit" field.
+func DailyVolumeLimitGTE(v decimal.Decimal) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldGTE(FieldDailyVolumeLimit, v))
+}
+
+// DailyVolumeLimitLT applies the LT predicate on the "daily_volume_limit" field.
+func DailyVolumeLimitLT(v decimal.Decimal) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldLT(FieldDailyVolumeLimit, v))
+}
+
+// DailyVolumeLimitLTE applies the LTE predicate on the "daily_volume_limit" field.
+func DailyVolumeLimitLTE(v decimal.Decimal) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldLTE(FieldDailyVolumeLimit, v))
+}
+
+// ReplacedByEQ applies the EQ predicate on the "replaced_by" field.
+func ReplacedByEQ(v string) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldEQ(FieldReplacedBy, v))
+}
+
+// ReplacedByNEQ applies the NEQ predicate on the "replaced_by" field.
+func ReplacedByNEQ(v string) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldNEQ(FieldReplacedBy, v))
+}
+
+// ReplacedByIn applies the In predicate on the "replaced_by" field.
+func ReplacedByIn(vs ...string) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldIn(FieldReplacedBy, vs...))
+}
+
+// ReplacedByNotIn applies the NotIn predicate on the "replaced_by" field.
+func ReplacedByNotIn(vs ...string) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldNotIn(FieldReplacedBy, vs...))
+}
+
+// ReplacedByGT applies the GT predicate on the "replaced_by" field.
+func ReplacedByGT(v string) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldGT(FieldReplacedBy, v))
+}
+
+// ReplacedByGTE applies the GTE predicate on the "replaced_by" field.
+func ReplacedByGTE(v string) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldGTE(FieldReplacedBy, v))
+}
+
+// ReplacedByLT applies the LT predicate on the "replaced_by" field.
+func ReplacedByLT(v string) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldLT(FieldReplacedBy, v))
+}
+
+// ReplacedByLTE applies the LTE predicate on the "replaced_by" field.
+func ReplacedByLTE(v string) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldLTE(FieldReplacedBy, v))
+}
+
+// ReplacedByContains applies the Contains predicate on the "replaced_by" field.
+func ReplacedByContains(v string) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldContains(FieldReplacedBy, v))
+}
+
+// ReplacedByHasPrefix applies the HasPrefix predicate on the "replaced_by" field.
+func ReplacedByHasPrefix(v string) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldHasPrefix(FieldReplacedBy, v))
+}
+
+// ReplacedByHasSuffix applies the HasSuffix predicate on the "replaced_by" field.
+func ReplacedByHasSuffix(v string) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldHasSuffix(FieldReplacedBy, v))
+}
+
+// ReplacedByIsNil applies the IsNil predicate on the "replaced_by" field.
+func ReplacedByIsNil() predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldIsNull(FieldReplacedBy))
+}
+
+// ReplacedByNotNil applies the NotNil predicate on the "replaced_by" field.
+func ReplacedByNotNil() predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldNotNull(FieldReplacedBy))
+}
+
+// ReplacedByEqualFold applies the EqualFold predicate on the "replaced_by" field.
+func ReplacedByEqualFold(v string) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldEqualFold(FieldReplacedBy, v))
+}
+
+// ReplacedByContainsFold applies the ContainsFold predicate on the "replaced_by" field.
+func ReplacedByContainsFold(v string) predicate.LinkedAddress {
+	return predicate.LinkedAddress(sql.FieldContainsFold(FieldReplacedBy, v))
+}
+
 // HasPaymentOrders applies the HasEdge predicate on the "payment_orders" edge.
 func HasPaymentOrders() predicate.LinkedAddress {
 	return predicate.LinkedAddress(func(s *sql.Selector) {
@@ -718,6 +1014,29 @@ func HasPaymentOrdersWith(preds ...predicate.PaymentOrder) predicate.LinkedAddre
 	})
 }
 
+// HasSenderProfile applies the HasEdge predicate on the "sender_profile" edge.
+func HasSenderProfile() predicate.LinkedAddress {
+	return predicate.LinkedAddress(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SenderProfileTable, SenderProfileColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSenderProfileWith applies the HasEdge predicate on the "sender_profile" edge with a given conditions (other predicates).
+func HasSenderProfileWith(preds ...predicate.SenderProfile) predicate.LinkedAddress {
+	return predicate.LinkedAddress(func(s *sql.Selector) {
+		step := newSenderProfileStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.LinkedAddress) predicate.LinkedAddress {
 	return predicate.LinkedAddress(sql.AndPredicates(predicates...))
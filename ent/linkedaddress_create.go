@@ -13,7 +13,9 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/NEDA-LABS/stablenode/ent/linkedaddress"
 	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/senderprofile"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 // LinkedAddressCreate is the builder for creating a LinkedAddress entity.
@@ -94,6 +96,14 @@ func (lac *LinkedAddressCreate) SetOwnerAddress(s string) *LinkedAddressCreate {
 	return lac
 }
 
+// SetNillableOwnerAddress sets the "owner_address" field if the given value is not nil.
+func (lac *LinkedAddressCreate) SetNillableOwnerAddress(s *string) *LinkedAddressCreate {
+	if s != nil {
+		lac.SetOwnerAddress(*s)
+	}
+	return lac
+}
+
 // SetLastIndexedBlock sets the "last_indexed_block" field.
 func (lac *LinkedAddressCreate) SetLastIndexedBlock(i int64) *LinkedAddressCreate {
 	lac.mutation.SetLastIndexedBlock(i)
@@ -122,6 +132,88 @@ func (lac *LinkedAddressCreate) SetNillableTxHash(s *string) *LinkedAddressCreat
 	return lac
 }
 
+// SetHandle sets the "handle" field.
+func (lac *LinkedAddressCreate) SetHandle(s string) *LinkedAddressCreate {
+	lac.mutation.SetHandle(s)
+	return lac
+}
+
+// SetNillableHandle sets the "handle" field if the given value is not nil.
+func (lac *LinkedAddressCreate) SetNillableHandle(s *string) *LinkedAddressCreate {
+	if s != nil {
+		lac.SetHandle(*s)
+	}
+	return lac
+}
+
+// SetIsActive sets the "is_active" field.
+func (lac *LinkedAddressCreate) SetIsActive(b bool) *LinkedAddressCreate {
+	lac.mutation.SetIsActive(b)
+	return lac
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (lac *LinkedAddressCreate) SetNillableIsActive(b *bool) *LinkedAddressCreate {
+	if b != nil {
+		lac.SetIsActive(*b)
+	}
+	return lac
+}
+
+// SetAllowedNetworks sets the "allowed_networks" field.
+func (lac *LinkedAddressCreate) SetAllowedNetworks(s []string) *LinkedAddressCreate {
+	lac.mutation.SetAllowedNetworks(s)
+	return lac
+}
+
+// SetAllowedTokens sets the "allowed_tokens" field.
+func (lac *LinkedAddressCreate) SetAllowedTokens(s []string) *LinkedAddressCreate {
+	lac.mutation.SetAllowedTokens(s)
+	return lac
+}
+
+// SetMaxTransferAmount sets the "max_transfer_amount" field.
+func (lac *LinkedAddressCreate) SetMaxTransferAmount(d decimal.Decimal) *LinkedAddressCreate {
+	lac.mutation.SetMaxTransferAmount(d)
+	return lac
+}
+
+// SetNillableMaxTransferAmount sets the "max_transfer_amount" field if the given value is not nil.
+func (lac *LinkedAddressCreate) SetNillableMaxTransferAmount(d *decimal.Decimal) *LinkedAddressCreate {
+	if d != nil {
+		lac.SetMaxTransferAmount(*d)
+	}
+	return lac
+}
+
+// SetDailyVolumeLimit sets the "daily_volume_limit" field.
+func (lac *LinkedAddressCreate) SetDailyVolumeLimit(d decimal.Decimal) *LinkedAddressCreate {
+	lac.mutation.SetDailyVolumeLimit(d)
+	return lac
+}
+
+// SetNillableDailyVolumeLimit sets the "daily_volume_limit" field if the given value is not nil.
+func (lac *LinkedAddressCreate) SetNillableDailyVolumeLimit(d *decimal.Decimal) *LinkedAddressCreate {
+	if d != nil {
+		lac.SetDailyVolumeLimit(*d)
+	}
+	return lac
+}
+
+// SetReplacedBy sets the "replaced_by" field.
+func (lac *LinkedAddressCreate) SetReplacedBy(s string) *LinkedAddressCreate {
+	lac.mutation.SetReplacedBy(s)
+	return lac
+}
+
+// SetNillableReplacedBy sets the "replaced_by" field if the given value is not nil.
+func (lac *LinkedAddressCreate) SetNillableReplacedBy(s *string) *LinkedAddressCreate {
+	if s != nil {
+		lac.SetReplacedBy(*s)
+	}
+	return lac
+}
+
 // AddPaymentOrderIDs adds the "payment_orders" edge to the PaymentOrder entity by IDs.
 func (lac *LinkedAddressCreate) AddPaymentOrderIDs(ids ...uuid.UUID) *LinkedAddressCreate {
 	lac.mutation.AddPaymentOrderIDs(ids...)
@@ -137,6 +229,25 @@ func (lac *LinkedAddressCreate) AddPaymentOrders(p ...*PaymentOrder) *LinkedAddr
 	return lac.AddPaymentOrderIDs(ids...)
 }
 
+// SetSenderProfileID sets the "sender_profile" edge to the SenderProfile entity by ID.
+func (lac *LinkedAddressCreate) SetSenderProfileID(id uuid.UUID) *LinkedAddressCreate {
+	lac.mutation.SetSenderProfileID(id)
+	return lac
+}
+
+// SetNillableSenderProfileID sets the "sender_profile" edge to the SenderProfile entity by ID if the given value is not nil.
+func (lac *LinkedAddressCreate) SetNillableSenderProfileID(id *uuid.UUID) *LinkedAddressCreate {
+	if id != nil {
+		lac = lac.SetSenderProfileID(*id)
+	}
+	return lac
+}
+
+// SetSenderProfile sets the "sender_profile" edge to the SenderProfile entity.
+func (lac *LinkedAddressCreate) SetSenderProfile(s *SenderProfile) *LinkedAddressCreate {
+	return lac.SetSenderProfileID(s.ID)
+}
+
 // Mutation returns the LinkedAddressMutation object of the builder.
 func (lac *LinkedAddressCreate) Mutation() *LinkedAddressMutation {
 	return lac.mutation
@@ -180,6 +291,18 @@ func (lac *LinkedAddressCreate) defaults() {
 		v := linkedaddress.DefaultUpdatedAt()
 		lac.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := lac.mutation.IsActive(); !ok {
+		v := linkedaddress.DefaultIsActive
+		lac.mutation.SetIsActive(v)
+	}
+	if _, ok := lac.mutation.MaxTransferAmount(); !ok {
+		v := linkedaddress.DefaultMaxTransferAmount()
+		lac.mutation.SetMaxTransferAmount(v)
+	}
+	if _, ok := lac.mutation.DailyVolumeLimit(); !ok {
+		v := linkedaddress.DefaultDailyVolumeLimit()
+		lac.mutation.SetDailyVolumeLimit(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -202,14 +325,25 @@ func (lac *LinkedAddressCreate) check() error {
 	if _, ok := lac.mutation.AccountName(); !ok {
 		return &ValidationError{Name: "account_name", err: errors.New(`ent: missing required field "LinkedAddress.account_name"`)}
 	}
-	if _, ok := lac.mutation.OwnerAddress(); !ok {
-		return &ValidationError{Name: "owner_address", err: errors.New(`ent: missing required field "LinkedAddress.owner_address"`)}
-	}
 	if v, ok := lac.mutation.TxHash(); ok {
 		if err := linkedaddress.TxHashValidator(v); err != nil {
 			return &ValidationError{Name: "tx_hash", err: fmt.Errorf(`ent: validator failed for field "LinkedAddress.tx_hash": %w`, err)}
 		}
 	}
+	if v, ok := lac.mutation.Handle(); ok {
+		if err := linkedaddress.HandleValidator(v); err != nil {
+			return &ValidationError{Name: "handle", err: fmt.Errorf(`ent: validator failed for field "LinkedAddress.handle": %w`, err)}
+		}
+	}
+	if _, ok := lac.mutation.IsActive(); !ok {
+		return &ValidationError{Name: "is_active", err: errors.New(`ent: missing required field "LinkedAddress.is_active"`)}
+	}
+	if _, ok := lac.mutation.MaxTransferAmount(); !ok {
+		return &ValidationError{Name: "max_transfer_amount", err: errors.New(`ent: missing required field "LinkedAddress.max_transfer_amount"`)}
+	}
+	if _, ok := lac.mutation.DailyVolumeLimit(); !ok {
+		return &ValidationError{Name: "daily_volume_limit", err: errors.New(`ent: missing required field "LinkedAddress.daily_volume_limit"`)}
+	}
 	return nil
 }
 
@@ -281,6 +415,34 @@ func (lac *LinkedAddressCreate) createSpec() (*LinkedAddress, *sqlgraph.CreateSp
 		_spec.SetField(linkedaddress.FieldTxHash, field.TypeString, value)
 		_node.TxHash = value
 	}
+	if value, ok := lac.mutation.Handle(); ok {
+		_spec.SetField(linkedaddress.FieldHandle, field.TypeString, value)
+		_node.Handle = value
+	}
+	if value, ok := lac.mutation.IsActive(); ok {
+		_spec.SetField(linkedaddress.FieldIsActive, field.TypeBool, value)
+		_node.IsActive = value
+	}
+	if value, ok := lac.mutation.AllowedNetworks(); ok {
+		_spec.SetField(linkedaddress.FieldAllowedNetworks, field.TypeJSON, value)
+		_node.AllowedNetworks = value
+	}
+	if value, ok := lac.mutation.AllowedTokens(); ok {
+		_spec.SetField(linkedaddress.FieldAllowedTokens, field.TypeJSON, value)
+		_node.AllowedTokens = value
+	}
+	if value, ok := lac.mutation.MaxTransferAmount(); ok {
+		_spec.SetField(linkedaddress.FieldMaxTransferAmount, field.TypeFloat64, value)
+		_node.MaxTransferAmount = value
+	}
+	if value, ok := lac.mutation.DailyVolumeLimit(); ok {
+		_spec.SetField(linkedaddress.FieldDailyVolumeLimit, field.TypeFloat64, value)
+		_node.DailyVolumeLimit = value
+	}
+	if value, ok := lac.mutation.ReplacedBy(); ok {
+		_spec.SetField(linkedaddress.FieldReplacedBy, field.TypeString, value)
+		_node.ReplacedBy = value
+	}
 	if nodes := lac.mutation.PaymentOrdersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -297,6 +459,23 @@ func (lac *LinkedAddressCreate) createSpec() (*LinkedAddress, *sqlgraph.CreateSp
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := lac.mutation.SenderProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   linkedaddress.SenderProfileTable,
+			Columns: []string{linkedaddress.SenderProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderprofile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.sender_profile_linked_address = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -457,6 +636,12 @@ func (u *LinkedAddressUpsert) UpdateOwnerAddress() *LinkedAddressUpsert {
 	return u
 }
 
+// ClearOwnerAddress clears the value of the "owner_address" field.
+func (u *LinkedAddressUpsert) ClearOwnerAddress() *LinkedAddressUpsert {
+	u.SetNull(linkedaddress.FieldOwnerAddress)
+	return u
+}
+
 // SetLastIndexedBlock sets the "last_indexed_block" field.
 func (u *LinkedAddressUpsert) SetLastIndexedBlock(v int64) *LinkedAddressUpsert {
 	u.Set(linkedaddress.FieldLastIndexedBlock, v)
@@ -499,6 +684,126 @@ func (u *LinkedAddressUpsert) ClearTxHash() *LinkedAddressUpsert {
 	return u
 }
 
+// SetHandle sets the "handle" field.
+func (u *LinkedAddressUpsert) SetHandle(v string) *LinkedAddressUpsert {
+	u.Set(linkedaddress.FieldHandle, v)
+	return u
+}
+
+// UpdateHandle sets the "handle" field to the value that was provided on create.
+func (u *LinkedAddressUpsert) UpdateHandle() *LinkedAddressUpsert {
+	u.SetExcluded(linkedaddress.FieldHandle)
+	return u
+}
+
+// ClearHandle clears the value of the "handle" field.
+func (u *LinkedAddressUpsert) ClearHandle() *LinkedAddressUpsert {
+	u.SetNull(linkedaddress.FieldHandle)
+	return u
+}
+
+// SetIsActive sets the "is_active" field.
+func (u *LinkedAddressUpsert) SetIsActive(v bool) *LinkedAddressUpsert {
+	u.Set(linkedaddress.FieldIsActive, v)
+	return u
+}
+
+// UpdateIsActive sets the "is_active" field to the value that was provided on create.
+func (u *LinkedAddressUpsert) UpdateIsActive() *LinkedAddressUpsert {
+	u.SetExcluded(linkedaddress.FieldIsActive)
+	return u
+}
+
+// SetAllowedNetworks sets the "allowed_networks" field.
+func (u *LinkedAddressUpsert) SetAllowedNetworks(v []string) *LinkedAddressUpsert {
+	u.Set(linkedaddress.FieldAllowedNetworks, v)
+	return u
+}
+
+// UpdateAllowedNetworks sets the "allowed_networks" field to the value that was provided on create.
+func (u *LinkedAddressUpsert) UpdateAllowedNetworks() *LinkedAddressUpsert {
+	u.SetExcluded(linkedaddress.FieldAllowedNetworks)
+	return u
+}
+
+// ClearAllowedNetworks clears the value of the "allowed_networks" field.
+func (u *LinkedAddressUpsert) ClearAllowedNetworks() *LinkedAddressUpsert {
+	u.SetNull(linkedaddress.FieldAllowedNetworks)
+	return u
+}
+
+// SetAllowedTokens sets the "allowed_tokens" field.
+func (u *LinkedAddressUpsert) SetAllowedTokens(v []string) *LinkedAddressUpsert {
+	u.Set(linkedaddress.FieldAllowedTokens, v)
+	return u
+}
+
+// UpdateAllowedTokens sets the "allowed_tokens" field to the value that was provided on create.
+func (u *LinkedAddressUpsert) UpdateAllowedTokens() *LinkedAddressUpsert {
+	u.SetExcluded(linkedaddress.FieldAllowedTokens)
+	return u
+}
+
+// ClearAllowedTokens clears the value of the "allowed_tokens" field.
+func (u *LinkedAddressUpsert) ClearAllowedTokens() *LinkedAddressUpsert {
+	u.SetNull(linkedaddress.FieldAllowedTokens)
+	return u
+}
+
+// SetMaxTransferAmount sets the "max_transfer_amount" field.
+func (u *LinkedAddressUpsert) SetMaxTransferAmount(v decimal.Decimal) *LinkedAddressUpsert {
+	u.Set(linkedaddress.FieldMaxTransferAmount, v)
+	return u
+}
+
+// UpdateMaxTransferAmount sets the "max_transfer_amount" field to the value that was provided on create.
+func (u *LinkedAddressUpsert) UpdateMaxTransferAmount() *LinkedAddressUpsert {
+	u.SetExcluded(linkedaddress.FieldMaxTransferAmount)
+	return u
+}
+
+// AddMaxTransferAmount adds v to the "max_transfer_amount" field.
+func (u *LinkedAddressUpsert) AddMaxTransferAmount(v decimal.Decimal) *LinkedAddressUpsert {
+	u.Add(linkedaddress.FieldMaxTransferAmount, v)
+	return u
+}
+
+// SetDailyVolumeLimit sets the "daily_volume_limit" field.
+func (u *LinkedAddressUpsert) SetDailyVolumeLimit(v decimal.Decimal) *LinkedAddressUpsert {
+	u.Set(linkedaddress.FieldDailyVolumeLimit, v)
+	return u
+}
+
+// UpdateDailyVolumeLimit sets the "daily_volume_limit" field to the value that was provided on create.
+func (u *LinkedAddressUpsert) UpdateDailyVolumeLimit() *LinkedAddressUpsert {
+	u.SetExcluded(linkedaddress.FieldDailyVolumeLimit)
+	return u
+}
+
+// AddDailyVolumeLimit adds v to the "daily_volume_limit" field.
+func (u *LinkedAddressUpsert) AddDailyVolumeLimit(v decimal.Decimal) *LinkedAddressUpsert {
+	u.Add(linkedaddress.FieldDailyVolumeLimit, v)
+	return u
+}
+
+// SetReplacedBy sets the "replaced_by" field.
+func (u *LinkedAddressUpsert) SetReplacedBy(v string) *LinkedAddressUpsert {
+	u.Set(linkedaddress.FieldReplacedBy, v)
+	return u
+}
+
+// UpdateReplacedBy sets the "replaced_by" field to the value that was provided on create.
+func (u *LinkedAddressUpsert) UpdateReplacedBy() *LinkedAddressUpsert {
+	u.SetExcluded(linkedaddress.FieldReplacedBy)
+	return u
+}
+
+// ClearReplacedBy clears the value of the "replaced_by" field.
+func (u *LinkedAddressUpsert) ClearReplacedBy() *LinkedAddressUpsert {
+	u.SetNull(linkedaddress.FieldReplacedBy)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -670,6 +975,13 @@ func (u *LinkedAddressUpsertOne) UpdateOwnerAddress() *LinkedAddressUpsertOne {
 	})
 }
 
+// ClearOwnerAddress clears the value of the "owner_address" field.
+func (u *LinkedAddressUpsertOne) ClearOwnerAddress() *LinkedAddressUpsertOne {
+	return u.Update(func(s *LinkedAddressUpsert) {
+		s.ClearOwnerAddress()
+	})
+}
+
 // SetLastIndexedBlock sets the "last_indexed_block" field.
 func (u *LinkedAddressUpsertOne) SetLastIndexedBlock(v int64) *LinkedAddressUpsertOne {
 	return u.Update(func(s *LinkedAddressUpsert) {
@@ -719,6 +1031,146 @@ func (u *LinkedAddressUpsertOne) ClearTxHash() *LinkedAddressUpsertOne {
 	})
 }
 
+// SetHandle sets the "handle" field.
+func (u *LinkedAddressUpsertOne) SetHandle(v string) *LinkedAddressUpsertOne {
+	return u.Update(func(s *LinkedAddressUpsert) {
+		s.SetHandle(v)
+	})
+}
+
+// UpdateHandle sets the "handle" field to the value that was provided on create.
+func (u *LinkedAddressUpsertOne) UpdateHandle() *LinkedAddressUpsertOne {
+	return u.Update(func(s *LinkedAddressUpsert) {
+		s.UpdateHandle()
+	})
+}
+
+// ClearHandle clears the value of the "handle" field.
+func (u *LinkedAddressUpsertOne) ClearHandle() *LinkedAddressUpsertOne {
+	return u.Update(func(s *LinkedAddressUpsert) {
+		s.ClearHandle()
+	})
+}
+
+// SetIsActive sets the "is_active" field.
+func (u *LinkedAddressUpsertOne) SetIsActive(v bool) *LinkedAddressUpsertOne {
+	return u.Update(func(s *LinkedAddressUpsert) {
+		s.SetIsActive(v)
+	})
+}
+
+// UpdateIsActive sets the "is_active" field to the value that was provided on create.
+func (u *LinkedAddressUpsertOne) UpdateIsActive() *LinkedAddressUpsertOne {
+	return u.Update(func(s *LinkedAddressUpsert) {
+		s.UpdateIsActive()
+	})
+}
+
+// SetAllowedNetworks sets the "allowed_networks" field.
+func (u *LinkedAddressUpsertOne) SetAllowedNetworks(v []string) *LinkedAddressUpsertOne {
+	return u.Update(func(s *LinkedAddressUpsert) {
+		s.SetAllowedNetworks(v)
+	})
+}
+
+// UpdateAllowedNetworks sets the "allowed_networks" field to the value that was provided on create.
+func (u *LinkedAddressUpsertOne) UpdateAllowedNetworks() *LinkedAddressUpsertOne {
+	return u.Update(func(s *LinkedAddressUpsert) {
+		s.UpdateAllowedNetworks()
+	})
+}
+
+// ClearAllowedNetworks clears the value of the "allowed_networks" field.
+func (u *LinkedAddressUpsertOne) ClearAllowedNetworks() *LinkedAddressUpsertOne {
+	return u.Update(func(s *LinkedAddressUpsert) {
+		s.ClearAllowedNetworks()
+	})
+}
+
+// SetAllowedTokens sets the "allowed_tokens" field.
+func (u *LinkedAddressUpsertOne) SetAllowedTokens(v []string) *LinkedAddressUpsertOne {
+	return u.Update(func(s *LinkedAddressUpsert) {
+		s.SetAllowedTokens(v)
+	})
+}
+
+// UpdateAllowedTokens sets the "allowed_tokens" field to the value that was provided on create.
+func (u *LinkedAddressUpsertOne) UpdateAllowedTokens() *LinkedAddressUpsertOne {
+	return u.Update(func(s *LinkedAddressUpsert) {
+		s.UpdateAllowedTokens()
+	})
+}
+
+// ClearAllowedTokens clears the value of the "allowed_tokens" field.
+func (u *LinkedAddressUpsertOne) ClearAllowedTokens() *LinkedAddressUpsertOne {
+	return u.Update(func(s *LinkedAddressUpsert) {
+		s.ClearAllowedTokens()
+	})
+}
+
+// SetMaxTransferAmount sets the "max_transfer_amount" field.
+func (u *LinkedAddressUpsertOne) SetMaxTransferAmount(v decimal.Decimal) *LinkedAddressUpsertOne {
+	return u.Update(func(s *LinkedAddressUpsert) {
+		s.SetMaxTransferAmount(v)
+	})
+}
+
+// AddMaxTransferAmount adds v to the "max_transfer_amount" field.
+func (u *LinkedAddressUpsertOne) AddMaxTransferAmount(v decimal.Decimal) *LinkedAddressUpsertOne {
+	return u.Update(func(s *LinkedAddressUpsert) {
+		s.AddMaxTransferAmount(v)
+	})
+}
+
+// UpdateMaxTransferAmount sets the "max_transfer_amount" field to the value that was provided on create.
+func (u *LinkedAddressUpsertOne) UpdateMaxTransferAmount() *LinkedAddressUpsertOne {
+	return u.Update(func(s *LinkedAddressUpsert) {
+		s.UpdateMaxTransferAmount()
+	})
+}
+
+// SetDailyVolumeLimit sets the "daily_volume_limit" field.
+func (u *LinkedAddressUpsertOne) SetDailyVolumeLimit(v decimal.Decimal) *LinkedAddressUpsertOne {
+	return u.Update(func(s *LinkedAddressUpsert) {
+		s.SetDailyVolumeLimit(v)
+	})
+}
+
+// AddDailyVolumeLimit adds v to the "daily_volume_limit" field.
+func (u *LinkedAddressUpsertOne) AddDailyVolumeLimit(v decimal.Decimal) *LinkedAddressUpsertOne {
+	return u.Update(func(s *LinkedAddressUpsert) {
+		s.AddDailyVolumeLimit(v)
+	})
+}
+
+// UpdateDailyVolumeLimit sets the "daily_volume_limit" field to the value that was provided on create.
+func (u *LinkedAddressUpsertOne) UpdateDailyVolumeLimit() *LinkedAddressUpsertOne {
+	return u.Update(func(s *LinkedAddressUpsert) {
+		s.UpdateDailyVolumeLimit()
+	})
+}
+
+// SetReplacedBy sets the "replaced_by" field.
+func (u *LinkedAddressUpsertOne) SetReplacedBy(v string) *LinkedAddressUpsertOne {
+	return u.Update(func(s *LinkedAddressUpsert) {
+		s.SetReplacedBy(v)
+	})
+}
+
+// UpdateReplacedBy sets the "replaced_by" field to the value that was provided on create.
+func (u *LinkedAddressUpsertOne) UpdateReplacedBy() *LinkedAddressUpsertOne {
+	return u.Update(func(s *LinkedAddressUpsert) {
+		s.UpdateReplacedBy()
+	})
+}
+
+// ClearReplacedBy clears the value of the "replaced_by" field.
+func (u *LinkedAddressUpsertOne) ClearReplacedBy() *LinkedAddressUpsertOne {
+	return u.Update(func(s *LinkedAddressUpsert) {
+		s.ClearReplacedBy()
+	})
+}
+
 // Exec executes the query.
 func (u *LinkedAddressUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1056,6 +1508,13 @@ func (u *LinkedAddressUpsertBulk) UpdateOwnerAddress() *LinkedAddressUpsertBulk
 	})
 }
 
+// ClearOwnerAddress clears the value of the "owner_address" field.
+func (u *LinkedAddressUpsertBulk) ClearOwnerAddress() *LinkedAddressUpsertBulk {
+	return u.Update(func(s *LinkedAddressUpsert) {
+		s.ClearOwnerAddress()
+	})
+}
+
 // SetLastIndexedBlock sets the "last_indexed_block" field.
 func (u *LinkedAddressUpsertBulk) SetLastIndexedBlock(v int64) *LinkedAddressUpsertBulk {
 	return u.Update(func(s *LinkedAddressUpsert) {
@@ -1105,6 +1564,146 @@ func (u *LinkedAddressUpsertBulk) ClearTxHash() *LinkedAddressUpsertBulk {
 	})
 }
 
+// SetHandle sets the "handle" field.
+func (u *LinkedAddressUpsertBulk) SetHandle(v string) *LinkedAddressUpsertBulk {
+	return u.Update(func(s *LinkedAddressUpsert) {
+		s.SetHandle(v)
+	})
+}
+
+// UpdateHandle sets the "handle" field to the value that was provided on create.
+func (u *LinkedAddressUpsertBulk) UpdateHandle() *LinkedAddressUpsertBulk {
+	return u.Update(func(s *LinkedAddressUpsert) {
+		s.UpdateHandle()
+	})
+}
+
+// ClearHandle clears the value of the "handle" field.
+func (u *LinkedAddressUpsertBulk) ClearHandle() *LinkedAddressUpsertBulk {
+	return u.Update(func(s *LinkedAddressUpsert) {
+		s.ClearHandle()
+	})
+}
+
+// SetIsActive sets the "is_active" field.
+func (u *LinkedAddressUpsertBulk) SetIsActive(v bool) *LinkedAddressUpsertBulk {
+	return u.Update(func(s *LinkedAddressUpsert) {
+		s.SetIsActive(v)
+	})
+}
+
+// UpdateIsActive sets the "is_active" field to the value that was provided on create.
+func (u *LinkedAddressUpsertBulk) UpdateIsActive() *LinkedAddressUpsertBulk {
+	return u.Update(func(s *LinkedAddressUpsert) {
+		s.UpdateIsActive()
+	})
+}
+
+// SetAllowedNetworks sets the "allowed_networks" field.
+func (u *LinkedAddressUpsertBulk) SetAllowedNetworks(v []string) *LinkedAddressUpsertBulk {
+	return u.Update(func(s *LinkedAddressUpsert) {
+		s.SetAllowedNetworks(v)
+	})
+}
+
+// UpdateAllowedNetworks sets the "allowed_networks" field to the value that was provided on create.
+func (u *LinkedAddressUpsertBulk) UpdateAllowedNetworks() *LinkedAddressUpsertBulk {
+	return u.Update(func(s *LinkedAddressUpsert) {
+		s.UpdateAllowedNetworks()
+	})
+}
+
+// ClearAllowedNetworks clears the value of the "allowed_networks" field.
+func (u *LinkedAddressUpsertBulk) ClearAllowedNetworks() *LinkedAddressUpsertBulk {
+	return u.Update(func(s *LinkedAddressUpsert) {
+		s.ClearAllowedNetworks()
+	})
+}
+
+// SetAllowedTokens sets the "allowed_tokens" field.
+func (u *LinkedAddressUpsertBulk) SetAllowedTokens(v []string) *LinkedAddressUpsertBulk {
+	return u.Update(func(s *LinkedAddressUpsert) {
+		s.SetAllowedTokens(v)
+	})
+}
+
+// UpdateAllowedTokens sets the "allowed_tokens" field to the value that was provided on create.
+func (u *LinkedAddressUpsertBulk) UpdateAllowedTokens() *LinkedAddressUpsertBulk {
+	return u.Update(func(s *LinkedAddressUpsert) {
+		s.UpdateAllowedTokens()
+	})
+}
+
+// ClearAllowedTokens clears the value of the "allowed_tokens" field.
+func (u *LinkedAddressUpsertBulk) ClearAllowedTokens() *LinkedAddressUpsertBulk {
+	return u.Update(func(s *LinkedAddressUpsert) {
+		s.ClearAllowedTokens()
+	})
+}
+
+// SetMaxTransferAmount sets the "max_transfer_amount" field.
+func (u *LinkedAddressUpsertBulk) SetMaxTransferAmount(v decimal.Decimal) *LinkedAddressUpsertBulk {
+	return u.Update(func(s *LinkedAddressUpsert) {
+		s.SetMaxTransferAmount(v)
+	})
+}
+
+// AddMaxTransferAmount adds v to the "max_transfer_amount" field.
+func (u *LinkedAddressUpsertBulk) AddMaxTransferAmount(v decimal.Decimal) *LinkedAddressUpsertBulk {
+	return u.Update(func(s *LinkedAddressUpsert) {
+		s.AddMaxTransferAmount(v)
+	})
+}
+
+// UpdateMaxTransferAmount sets the "max_transfer_amount" field to the value that was provided on create.
+func (u *LinkedAddressUpsertBulk) UpdateMaxTransferAmount() *LinkedAddressUpsertBulk {
+	return u.Update(func(s *LinkedAddressUpsert) {
+		s.UpdateMaxTransferAmount()
+	})
+}
+
+// SetDailyVolumeLimit sets the "daily_volume_limit" field.
+func (u *LinkedAddressUpsertBulk) SetDailyVolumeLimit(v decimal.Decimal) *LinkedAddressUpsertBulk {
+	return u.Update(func(s *LinkedAddressUpsert) {
+		s.SetDailyVolumeLimit(v)
+	})
+}
+
+// AddDailyVolumeLimit adds v to the "daily_volume_limit" field.
+func (u *LinkedAddressUpsertBulk) AddDailyVolumeLimit(v decimal.Decimal) *LinkedAddressUpsertBulk {
+	return u.Update(func(s *LinkedAddressUpsert) {
+		s.AddDailyVolumeLimit(v)
+	})
+}
+
+// UpdateDailyVolumeLimit sets the "daily_volume_limit" field to the value that was provided on create.
+func (u *LinkedAddressUpsertBulk) UpdateDailyVolumeLimit() *LinkedAddressUpsertBulk {
+	return u.Update(func(s *LinkedAddressUpsert) {
+		s.UpdateDailyVolumeLimit()
+	})
+}
+
+// SetReplacedBy sets the "replaced_by" field.
+func (u *LinkedAddressUpsertBulk) SetReplacedBy(v string) *LinkedAddressUpsertBulk {
+	return u.Update(func(s *LinkedAddressUpsert) {
+		s.SetReplacedBy(v)
+	})
+}
+
+// UpdateReplacedBy sets the "replaced_by" field to the value that was provided on create.
+func (u *LinkedAddressUpsertBulk) UpdateReplacedBy() *LinkedAddressUpsertBulk {
+	return u.Update(func(s *LinkedAddressUpsert) {
+		s.UpdateReplacedBy()
+	})
+}
+
+// ClearReplacedBy clears the value of the "replaced_by" field.
+func (u *LinkedAddressUpsertBulk) ClearReplacedBy() *LinkedAddressUpsertBulk {
+	return u.Update(func(s *LinkedAddressUpsert) {
+		s.ClearReplacedBy()
+	})
+}
+
 // Exec executes the query.
 func (u *LinkedAddressUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
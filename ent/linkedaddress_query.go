@@ -15,6 +15,8 @@ import (
 	"github.com/NEDA-LABS/stablenode/ent/linkedaddress"
 	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
 	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/NEDA-LABS/stablenode/ent/senderprofile"
+	"github.com/google/uuid"
 )
 
 // LinkedAddressQuery is the builder for querying LinkedAddress entities.
@@ -25,6 +27,7 @@ type LinkedAddressQuery struct {
 	inters            []Interceptor
 	predicates        []predicate.LinkedAddress
 	withPaymentOrders *PaymentOrderQuery
+	withSenderProfile *SenderProfileQuery
 	withFKs           bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -84,6 +87,28 @@ func (laq *LinkedAddressQuery) QueryPaymentOrders() *PaymentOrderQuery {
 	return query
 }
 
+// QuerySenderProfile chains the current query on the "sender_profile" edge.
+func (laq *LinkedAddressQuery) QuerySenderProfile() *SenderProfileQuery {
+	query := (&SenderProfileClient{config: laq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := laq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := laq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(linkedaddress.Table, linkedaddress.FieldID, selector),
+			sqlgraph.To(senderprofile.Table, senderprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, linkedaddress.SenderProfileTable, linkedaddress.SenderProfileColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(laq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first LinkedAddress entity from the query.
 // Returns a *NotFoundError when no LinkedAddress was found.
 func (laq *LinkedAddressQuery) First(ctx context.Context) (*LinkedAddress, error) {
@@ -277,6 +302,7 @@ func (laq *LinkedAddressQuery) Clone() *LinkedAddressQuery {
 		inters:            append([]Interceptor{}, laq.inters...),
 		predicates:        append([]predicate.LinkedAddress{}, laq.predicates...),
 		withPaymentOrders: laq.withPaymentOrders.Clone(),
+		withSenderProfile: laq.withSenderProfile.Clone(),
 		// clone intermediate query.
 		sql:  laq.sql.Clone(),
 		path: laq.path,
@@ -294,6 +320,17 @@ func (laq *LinkedAddressQuery) WithPaymentOrders(opts ...func(*PaymentOrderQuery
 	return laq
 }
 
+// WithSenderProfile tells the query-builder to eager-load the nodes that are connected to
+// the "sender_profile" edge. The optional arguments are used to configure the query builder of the edge.
+func (laq *LinkedAddressQuery) WithSenderProfile(opts ...func(*SenderProfileQuery)) *LinkedAddressQuery {
+	query := (&SenderProfileClient{config: laq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	laq.withSenderProfile = query
+	return laq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -373,10 +410,14 @@ func (laq *LinkedAddressQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 		nodes       = []*LinkedAddress{}
 		withFKs     = laq.withFKs
 		_spec       = laq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			laq.withPaymentOrders != nil,
+			laq.withSenderProfile != nil,
 		}
 	)
+	if laq.withSenderProfile != nil {
+		withFKs = true
+	}
 	if withFKs {
 		_spec.Node.Columns = append(_spec.Node.Columns, linkedaddress.ForeignKeys...)
 	}
@@ -405,6 +446,12 @@ func (laq *LinkedAddressQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 			return nil, err
 		}
 	}
+	if query := laq.withSenderProfile; query != nil {
+		if err := laq.loadSenderProfile(ctx, query, nodes, nil,
+			func(n *LinkedAddress, e *SenderProfile) { n.Edges.SenderProfile = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -439,6 +486,38 @@ func (laq *LinkedAddressQuery) loadPaymentOrders(ctx context.Context, query *Pay
 	}
 	return nil
 }
+func (laq *LinkedAddressQuery) loadSenderProfile(ctx context.Context, query *SenderProfileQuery, nodes []*LinkedAddress, init func(*LinkedAddress), assign func(*LinkedAddress, *SenderProfile)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*LinkedAddress)
+	for i := range nodes {
+		if nodes[i].sender_profile_linked_address == nil {
+			continue
+		}
+		fk := *nodes[i].sender_profile_linked_address
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(senderprofile.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "sender_profile_linked_address" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (laq *LinkedAddressQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := laq.querySpec()
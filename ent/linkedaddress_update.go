@@ -10,11 +10,14 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/NEDA-LABS/stablenode/ent/linkedaddress"
 	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
 	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/NEDA-LABS/stablenode/ent/senderprofile"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 // LinkedAddressUpdate is the builder for updating LinkedAddress entities.
@@ -130,6 +133,12 @@ func (lau *LinkedAddressUpdate) SetNillableOwnerAddress(s *string) *LinkedAddres
 	return lau
 }
 
+// ClearOwnerAddress clears the value of the "owner_address" field.
+func (lau *LinkedAddressUpdate) ClearOwnerAddress() *LinkedAddressUpdate {
+	lau.mutation.ClearOwnerAddress()
+	return lau
+}
+
 // SetLastIndexedBlock sets the "last_indexed_block" field.
 func (lau *LinkedAddressUpdate) SetLastIndexedBlock(i int64) *LinkedAddressUpdate {
 	lau.mutation.ResetLastIndexedBlock()
@@ -177,6 +186,138 @@ func (lau *LinkedAddressUpdate) ClearTxHash() *LinkedAddressUpdate {
 	return lau
 }
 
+// SetHandle sets the "handle" field.
+func (lau *LinkedAddressUpdate) SetHandle(s string) *LinkedAddressUpdate {
+	lau.mutation.SetHandle(s)
+	return lau
+}
+
+// SetNillableHandle sets the "handle" field if the given value is not nil.
+func (lau *LinkedAddressUpdate) SetNillableHandle(s *string) *LinkedAddressUpdate {
+	if s != nil {
+		lau.SetHandle(*s)
+	}
+	return lau
+}
+
+// ClearHandle clears the value of the "handle" field.
+func (lau *LinkedAddressUpdate) ClearHandle() *LinkedAddressUpdate {
+	lau.mutation.ClearHandle()
+	return lau
+}
+
+// SetIsActive sets the "is_active" field.
+func (lau *LinkedAddressUpdate) SetIsActive(b bool) *LinkedAddressUpdate {
+	lau.mutation.SetIsActive(b)
+	return lau
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (lau *LinkedAddressUpdate) SetNillableIsActive(b *bool) *LinkedAddressUpdate {
+	if b != nil {
+		lau.SetIsActive(*b)
+	}
+	return lau
+}
+
+// SetAllowedNetworks sets the "allowed_networks" field.
+func (lau *LinkedAddressUpdate) SetAllowedNetworks(s []string) *LinkedAddressUpdate {
+	lau.mutation.SetAllowedNetworks(s)
+	return lau
+}
+
+// AppendAllowedNetworks appends s to the "allowed_networks" field.
+func (lau *LinkedAddressUpdate) AppendAllowedNetworks(s []string) *LinkedAddressUpdate {
+	lau.mutation.AppendAllowedNetworks(s)
+	return lau
+}
+
+// ClearAllowedNetworks clears the value of the "allowed_networks" field.
+func (lau *LinkedAddressUpdate) ClearAllowedNetworks() *LinkedAddressUpdate {
+	lau.mutation.ClearAllowedNetworks()
+	return lau
+}
+
+// SetAllowedTokens sets the "allowed_tokens" field.
+func (lau *LinkedAddressUpdate) SetAllowedTokens(s []string) *LinkedAddressUpdate {
+	lau.mutation.SetAllowedTokens(s)
+	return lau
+}
+
+// AppendAllowedTokens appends s to the "allowed_tokens" field.
+func (lau *LinkedAddressUpdate) AppendAllowedTokens(s []string) *LinkedAddressUpdate {
+	lau.mutation.AppendAllowedTokens(s)
+	return lau
+}
+
+// ClearAllowedTokens clears the value of the "allowed_tokens" field.
+func (lau *LinkedAddressUpdate) ClearAllowedTokens() *LinkedAddressUpdate {
+	lau.mutation.ClearAllowedTokens()
+	return lau
+}
+
+// SetMaxTransferAmount sets the "max_transfer_amount" field.
+func (lau *LinkedAddressUpdate) SetMaxTransferAmount(d decimal.Decimal) *LinkedAddressUpdate {
+	lau.mutation.ResetMaxTransferAmount()
+	lau.mutation.SetMaxTransferAmount(d)
+	return lau
+}
+
+// SetNillableMaxTransferAmount sets the "max_transfer_amount" field if the given value is not nil.
+func (lau *LinkedAddressUpdate) SetNillableMaxTransferAmount(d *decimal.Decimal) *LinkedAddressUpdate {
+	if d != nil {
+		lau.SetMaxTransferAmount(*d)
+	}
+	return lau
+}
+
+// AddMaxTransferAmount adds d to the "max_transfer_amount" field.
+func (lau *LinkedAddressUpdate) AddMaxTransferAmount(d decimal.Decimal) *LinkedAddressUpdate {
+	lau.mutation.AddMaxTransferAmount(d)
+	return lau
+}
+
+// SetDailyVolumeLimit sets the "daily_volume_limit" field.
+func (lau *LinkedAddressUpdate) SetDailyVolumeLimit(d decimal.Decimal) *LinkedAddressUpdate {
+	lau.mutation.ResetDailyVolumeLimit()
+	lau.mutation.SetDailyVolumeLimit(d)
+	return lau
+}
+
+// SetNillableDailyVolumeLimit sets the "daily_volume_limit" field if the given value is not nil.
+func (lau *LinkedAddressUpdate) SetNillableDailyVolumeLimit(d *decimal.Decimal) *LinkedAddressUpdate {
+	if d != nil {
+		lau.SetDailyVolumeLimit(*d)
+	}
+	return lau
+}
+
+// AddDailyVolumeLimit adds d to the "daily_volume_limit" field.
+func (lau *LinkedAddressUpdate) AddDailyVolumeLimit(d decimal.Decimal) *LinkedAddressUpdate {
+	lau.mutation.AddDailyVolumeLimit(d)
+	return lau
+}
+
+// SetReplacedBy sets the "replaced_by" field.
+func (lau *LinkedAddressUpdate) SetReplacedBy(s string) *LinkedAddressUpdate {
+	lau.mutation.SetReplacedBy(s)
+	return lau
+}
+
+// SetNillableReplacedBy sets the "replaced_by" field if the given value is not nil.
+func (lau *LinkedAddressUpdate) SetNillableReplacedBy(s *string) *LinkedAddressUpdate {
+	if s != nil {
+		lau.SetReplacedBy(*s)
+	}
+	return lau
+}
+
+// ClearReplacedBy clears the value of the "replaced_by" field.
+func (lau *LinkedAddressUpdate) ClearReplacedBy() *LinkedAddressUpdate {
+	lau.mutation.ClearReplacedBy()
+	return lau
+}
+
 // AddPaymentOrderIDs adds the "payment_orders" edge to the PaymentOrder entity by IDs.
 func (lau *LinkedAddressUpdate) AddPaymentOrderIDs(ids ...uuid.UUID) *LinkedAddressUpdate {
 	lau.mutation.AddPaymentOrderIDs(ids...)
@@ -192,6 +333,25 @@ func (lau *LinkedAddressUpdate) AddPaymentOrders(p ...*PaymentOrder) *LinkedAddr
 	return lau.AddPaymentOrderIDs(ids...)
 }
 
+// SetSenderProfileID sets the "sender_profile" edge to the SenderProfile entity by ID.
+func (lau *LinkedAddressUpdate) SetSenderProfileID(id uuid.UUID) *LinkedAddressUpdate {
+	lau.mutation.SetSenderProfileID(id)
+	return lau
+}
+
+// SetNillableSenderProfileID sets the "sender_profile" edge to the SenderProfile entity by ID if the given value is not nil.
+func (lau *LinkedAddressUpdate) SetNillableSenderProfileID(id *uuid.UUID) *LinkedAddressUpdate {
+	if id != nil {
+		lau = lau.SetSenderProfileID(*id)
+	}
+	return lau
+}
+
+// SetSenderProfile sets the "sender_profile" edge to the SenderProfile entity.
+func (lau *LinkedAddressUpdate) SetSenderProfile(s *SenderProfile) *LinkedAddressUpdate {
+	return lau.SetSenderProfileID(s.ID)
+}
+
 // Mutation returns the LinkedAddressMutation object of the builder.
 func (lau *LinkedAddressUpdate) Mutation() *LinkedAddressMutation {
 	return lau.mutation
@@ -218,6 +378,12 @@ func (lau *LinkedAddressUpdate) RemovePaymentOrders(p ...*PaymentOrder) *LinkedA
 	return lau.RemovePaymentOrderIDs(ids...)
 }
 
+// ClearSenderProfile clears the "sender_profile" edge to the SenderProfile entity.
+func (lau *LinkedAddressUpdate) ClearSenderProfile() *LinkedAddressUpdate {
+	lau.mutation.ClearSenderProfile()
+	return lau
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (lau *LinkedAddressUpdate) Save(ctx context.Context) (int, error) {
 	lau.defaults()
@@ -261,6 +427,11 @@ func (lau *LinkedAddressUpdate) check() error {
 			return &ValidationError{Name: "tx_hash", err: fmt.Errorf(`ent: validator failed for field "LinkedAddress.tx_hash": %w`, err)}
 		}
 	}
+	if v, ok := lau.mutation.Handle(); ok {
+		if err := linkedaddress.HandleValidator(v); err != nil {
+			return &ValidationError{Name: "handle", err: fmt.Errorf(`ent: validator failed for field "LinkedAddress.handle": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -306,6 +477,9 @@ func (lau *LinkedAddressUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if value, ok := lau.mutation.OwnerAddress(); ok {
 		_spec.SetField(linkedaddress.FieldOwnerAddress, field.TypeString, value)
 	}
+	if lau.mutation.OwnerAddressCleared() {
+		_spec.ClearField(linkedaddress.FieldOwnerAddress, field.TypeString)
+	}
 	if value, ok := lau.mutation.LastIndexedBlock(); ok {
 		_spec.SetField(linkedaddress.FieldLastIndexedBlock, field.TypeInt64, value)
 	}
@@ -321,6 +495,55 @@ func (lau *LinkedAddressUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if lau.mutation.TxHashCleared() {
 		_spec.ClearField(linkedaddress.FieldTxHash, field.TypeString)
 	}
+	if value, ok := lau.mutation.Handle(); ok {
+		_spec.SetField(linkedaddress.FieldHandle, field.TypeString, value)
+	}
+	if lau.mutation.HandleCleared() {
+		_spec.ClearField(linkedaddress.FieldHandle, field.TypeString)
+	}
+	if value, ok := lau.mutation.IsActive(); ok {
+		_spec.SetField(linkedaddress.FieldIsActive, field.TypeBool, value)
+	}
+	if value, ok := lau.mutation.AllowedNetworks(); ok {
+		_spec.SetField(linkedaddress.FieldAllowedNetworks, field.TypeJSON, value)
+	}
+	if value, ok := lau.mutation.AppendedAllowedNetworks(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, linkedaddress.FieldAllowedNetworks, value)
+		})
+	}
+	if lau.mutation.AllowedNetworksCleared() {
+		_spec.ClearField(linkedaddress.FieldAllowedNetworks, field.TypeJSON)
+	}
+	if value, ok := lau.mutation.AllowedTokens(); ok {
+		_spec.SetField(linkedaddress.FieldAllowedTokens, field.TypeJSON, value)
+	}
+	if value, ok := lau.mutation.AppendedAllowedTokens(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, linkedaddress.FieldAllowedTokens, value)
+		})
+	}
+	if lau.mutation.AllowedTokensCleared() {
+		_spec.ClearField(linkedaddress.FieldAllowedTokens, field.TypeJSON)
+	}
+	if value, ok := lau.mutation.MaxTransferAmount(); ok {
+		_spec.SetField(linkedaddress.FieldMaxTransferAmount, field.TypeFloat64, value)
+	}
+	if value, ok := lau.mutation.AddedMaxTransferAmount(); ok {
+		_spec.AddField(linkedaddress.FieldMaxTransferAmount, field.TypeFloat64, value)
+	}
+	if value, ok := lau.mutation.DailyVolumeLimit(); ok {
+		_spec.SetField(linkedaddress.FieldDailyVolumeLimit, field.TypeFloat64, value)
+	}
+	if value, ok := lau.mutation.AddedDailyVolumeLimit(); ok {
+		_spec.AddField(linkedaddress.FieldDailyVolumeLimit, field.TypeFloat64, value)
+	}
+	if value, ok := lau.mutation.ReplacedBy(); ok {
+		_spec.SetField(linkedaddress.FieldReplacedBy, field.TypeString, value)
+	}
+	if lau.mutation.ReplacedByCleared() {
+		_spec.ClearField(linkedaddress.FieldReplacedBy, field.TypeString)
+	}
 	if lau.mutation.PaymentOrdersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -366,6 +589,35 @@ func (lau *LinkedAddressUpdate) sqlSave(ctx context.Context) (n int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if lau.mutation.SenderProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   linkedaddress.SenderProfileTable,
+			Columns: []string{linkedaddress.SenderProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderprofile.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lau.mutation.SenderProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   linkedaddress.SenderProfileTable,
+			Columns: []string{linkedaddress.SenderProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderprofile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, lau.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{linkedaddress.Label}
@@ -486,6 +738,12 @@ func (lauo *LinkedAddressUpdateOne) SetNillableOwnerAddress(s *string) *LinkedAd
 	return lauo
 }
 
+// ClearOwnerAddress clears the value of the "owner_address" field.
+func (lauo *LinkedAddressUpdateOne) ClearOwnerAddress() *LinkedAddressUpdateOne {
+	lauo.mutation.ClearOwnerAddress()
+	return lauo
+}
+
 // SetLastIndexedBlock sets the "last_indexed_block" field.
 func (lauo *LinkedAddressUpdateOne) SetLastIndexedBlock(i int64) *LinkedAddressUpdateOne {
 	lauo.mutation.ResetLastIndexedBlock()
@@ -533,6 +791,138 @@ func (lauo *LinkedAddressUpdateOne) ClearTxHash() *LinkedAddressUpdateOne {
 	return lauo
 }
 
+// SetHandle sets the "handle" field.
+func (lauo *LinkedAddressUpdateOne) SetHandle(s string) *LinkedAddressUpdateOne {
+	lauo.mutation.SetHandle(s)
+	return lauo
+}
+
+// SetNillableHandle sets the "handle" field if the given value is not nil.
+func (lauo *LinkedAddressUpdateOne) SetNillableHandle(s *string) *LinkedAddressUpdateOne {
+	if s != nil {
+		lauo.SetHandle(*s)
+	}
+	return lauo
+}
+
+// ClearHandle clears the value of the "handle" field.
+func (lauo *LinkedAddressUpdateOne) ClearHandle() *LinkedAddressUpdateOne {
+	lauo.mutation.ClearHandle()
+	return lauo
+}
+
+// SetIsActive sets the "is_active" field.
+func (lauo *LinkedAddressUpdateOne) SetIsActive(b bool) *LinkedAddressUpdateOne {
+	lauo.mutation.SetIsActive(b)
+	return lauo
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (lauo *LinkedAddressUpdateOne) SetNillableIsActive(b *bool) *LinkedAddressUpdateOne {
+	if b != nil {
+		lauo.SetIsActive(*b)
+	}
+	return lauo
+}
+
+// SetAllowedNetworks sets the "allowed_networks" field.
+func (lauo *LinkedAddressUpdateOne) SetAllowedNetworks(s []string) *LinkedAddressUpdateOne {
+	lauo.mutation.SetAllowedNetworks(s)
+	return lauo
+}
+
+// AppendAllowedNetworks appends s to the "allowed_networks" field.
+func (lauo *LinkedAddressUpdateOne) AppendAllowedNetworks(s []string) *LinkedAddressUpdateOne {
+	lauo.mutation.AppendAllowedNetworks(s)
+	return lauo
+}
+
+// ClearAllowedNetworks clears the value of the "allowed_networks" field.
+func (lauo *LinkedAddressUpdateOne) ClearAllowedNetworks() *LinkedAddressUpdateOne {
+	lauo.mutation.ClearAllowedNetworks()
+	return lauo
+}
+
+// SetAllowedTokens sets the "allowed_tokens" field.
+func (lauo *LinkedAddressUpdateOne) SetAllowedTokens(s []string) *LinkedAddressUpdateOne {
+	lauo.mutation.SetAllowedTokens(s)
+	return lauo
+}
+
+// AppendAllowedTokens appends s to the "allowed_tokens" field.
+func (lauo *LinkedAddressUpdateOne) AppendAllowedTokens(s []string) *LinkedAddressUpdateOne {
+	lauo.mutation.AppendAllowedTokens(s)
+	return lauo
+}
+
+// ClearAllowedTokens clears the value of the "allowed_tokens" field.
+func (lauo *LinkedAddressUpdateOne) ClearAllowedTokens() *LinkedAddressUpdateOne {
+	lauo.mutation.ClearAllowedTokens()
+	return lauo
+}
+
+// SetMaxTransferAmount sets the "max_transfer_amount" field.
+func (lauo *LinkedAddressUpdateOne) SetMaxTransferAmount(d decimal.Decimal) *LinkedAddressUpdateOne {
+	lauo.mutation.ResetMaxTransferAmount()
+	lauo.mutation.SetMaxTransferAmount(d)
+	return lauo
+}
+
+// SetNillableMaxTransferAmount sets the "max_transfer_amount" field if the given value is not nil.
+func (lauo *LinkedAddressUpdateOne) SetNillableMaxTransferAmount(d *decimal.Decimal) *LinkedAddressUpdateOne {
+	if d != nil {
+		lauo.SetMaxTransferAmount(*d)
+	}
+	return lauo
+}
+
+// AddMaxTransferAmount adds d to the "max_transfer_amount" field.
+func (lauo *LinkedAddressUpdateOne) AddMaxTransferAmount(d decimal.Decimal) *LinkedAddressUpdateOne {
+	lauo.mutation.AddMaxTransferAmount(d)
+	return lauo
+}
+
+// SetDailyVolumeLimit sets the "daily_volume_limit" field.
+func (lauo *LinkedAddressUpdateOne) SetDailyVolumeLimit(d decimal.Decimal) *LinkedAddressUpdateOne {
+	lauo.mutation.ResetDailyVolumeLimit()
+	lauo.mutation.SetDailyVolumeLimit(d)
+	return lauo
+}
+
+// SetNillableDailyVolumeLimit sets the "daily_volume_limit" field if the given value is not nil.
+func (lauo *LinkedAddressUpdateOne) SetNillableDailyVolumeLimit(d *decimal.Decimal) *LinkedAddressUpdateOne {
+	if d != nil {
+		lauo.SetDailyVolumeLimit(*d)
+	}
+	return lauo
+}
+
+// AddDailyVolumeLimit adds d to the "daily_volume_limit" field.
+func (lauo *LinkedAddressUpdateOne) AddDailyVolumeLimit(d decimal.Decimal) *LinkedAddressUpdateOne {
+	lauo.mutation.AddDailyVolumeLimit(d)
+	return lauo
+}
+
+// SetReplacedBy sets the "replaced_by" field.
+func (lauo *LinkedAddressUpdateOne) SetReplacedBy(s string) *LinkedAddressUpdateOne {
+	lauo.mutation.SetReplacedBy(s)
+	return lauo
+}
+
+// SetNillableReplacedBy sets the "replaced_by" field if the given value is not nil.
+func (lauo *LinkedAddressUpdateOne) SetNillableReplacedBy(s *string) *LinkedAddressUpdateOne {
+	if s != nil {
+		lauo.SetReplacedBy(*s)
+	}
+	return lauo
+}
+
+// ClearReplacedBy clears the value of the "replaced_by" field.
+func (lauo *LinkedAddressUpdateOne) ClearReplacedBy() *LinkedAddressUpdateOne {
+	lauo.mutation.ClearReplacedBy()
+	return lauo
+}
+
 // AddPaymentOrderIDs adds the "payment_orders" edge to the PaymentOrder entity by IDs.
 func (lauo *LinkedAddressUpdateOne) AddPaymentOrderIDs(ids ...uuid.UUID) *LinkedAddressUpdateOne {
 	lauo.mutation.AddPaymentOrderIDs(ids...)
@@ -548,6 +938,25 @@ func (lauo *LinkedAddressUpdateOne) AddPaymentOrders(p ...*PaymentOrder) *Linked
 	return lauo.AddPaymentOrderIDs(ids...)
 }
 
+// SetSenderProfileID sets the "sender_profile" edge to the SenderProfile entity by ID.
+func (lauo *LinkedAddressUpdateOne) SetSenderProfileID(id uuid.UUID) *LinkedAddressUpdateOne {
+	lauo.mutation.SetSenderProfileID(id)
+	return lauo
+}
+
+// SetNillableSenderProfileID sets the "sender_profile" edge to the SenderProfile entity by ID if the given value is not nil.
+func (lauo *LinkedAddressUpdateOne) SetNillableSenderProfileID(id *uuid.UUID) *LinkedAddressUpdateOne {
+	if id != nil {
+		lauo = lauo.SetSenderProfileID(*id)
+	}
+	return lauo
+}
+
+// SetSenderProfile sets the "sender_profile" edge to the SenderProfile entity.
+func (lauo *LinkedAddressUpdateOne) SetSenderProfile(s *SenderProfile) *LinkedAddressUpdateOne {
+	return lauo.SetSenderProfileID(s.ID)
+}
+
 // Mutation returns the LinkedAddressMutation object of the builder.
 func (lauo *LinkedAddressUpdateOne) Mutation() *LinkedAddressMutation {
 	return lauo.mutation
@@ -574,6 +983,12 @@ func (lauo *LinkedAddressUpdateOne) RemovePaymentOrders(p ...*PaymentOrder) *Lin
 	return lauo.RemovePaymentOrderIDs(ids...)
 }
 
+// ClearSenderProfile clears the "sender_profile" edge to the SenderProfile entity.
+func (lauo *LinkedAddressUpdateOne) ClearSenderProfile() *LinkedAddressUpdateOne {
+	lauo.mutation.ClearSenderProfile()
+	return lauo
+}
+
 // Where appends a list predicates to the LinkedAddressUpdate builder.
 func (lauo *LinkedAddressUpdateOne) Where(ps ...predicate.LinkedAddress) *LinkedAddressUpdateOne {
 	lauo.mutation.Where(ps...)
@@ -630,6 +1045,11 @@ func (lauo *LinkedAddressUpdateOne) check() error {
 			return &ValidationError{Name: "tx_hash", err: fmt.Errorf(`ent: validator failed for field "LinkedAddress.tx_hash": %w`, err)}
 		}
 	}
+	if v, ok := lauo.mutation.Handle(); ok {
+		if err := linkedaddress.HandleValidator(v); err != nil {
+			return &ValidationError{Name: "handle", err: fmt.Errorf(`ent: validator failed for field "LinkedAddress.handle": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -692,6 +1112,9 @@ func (lauo *LinkedAddressUpdateOne) sqlSave(ctx context.Context) (_node *LinkedA
 	if value, ok := lauo.mutation.OwnerAddress(); ok {
 		_spec.SetField(linkedaddress.FieldOwnerAddress, field.TypeString, value)
 	}
+	if lauo.mutation.OwnerAddressCleared() {
+		_spec.ClearField(linkedaddress.FieldOwnerAddress, field.TypeString)
+	}
 	if value, ok := lauo.mutation.LastIndexedBlock(); ok {
 		_spec.SetField(linkedaddress.FieldLastIndexedBlock, field.TypeInt64, value)
 	}
@@ -707,6 +1130,55 @@ func (lauo *LinkedAddressUpdateOne) sqlSave(ctx context.Context) (_node *LinkedA
 	if lauo.mutation.TxHashCleared() {
 		_spec.ClearField(linkedaddress.FieldTxHash, field.TypeString)
 	}
+	if value, ok := lauo.mutation.Handle(); ok {
+		_spec.SetField(linkedaddress.FieldHandle, field.TypeString, value)
+	}
+	if lauo.mutation.HandleCleared() {
+		_spec.ClearField(linkedaddress.FieldHandle, field.TypeString)
+	}
+	if value, ok := lauo.mutation.IsActive(); ok {
+		_spec.SetField(linkedaddress.FieldIsActive, field.TypeBool, value)
+	}
+	if value, ok := lauo.mutation.AllowedNetworks(); ok {
+		_spec.SetField(linkedaddress.FieldAllowedNetworks, field.TypeJSON, value)
+	}
+	if value, ok := lauo.mutation.AppendedAllowedNetworks(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, linkedaddress.FieldAllowedNetworks, value)
+		})
+	}
+	if lauo.mutation.AllowedNetworksCleared() {
+		_spec.ClearField(linkedaddress.FieldAllowedNetworks, field.TypeJSON)
+	}
+	if value, ok := lauo.mutation.AllowedTokens(); ok {
+		_spec.SetField(linkedaddress.FieldAllowedTokens, field.TypeJSON, value)
+	}
+	if value, ok := lauo.mutation.AppendedAllowedTokens(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, linkedaddress.FieldAllowedTokens, value)
+		})
+	}
+	if lauo.mutation.AllowedTokensCleared() {
+		_spec.ClearField(linkedaddress.FieldAllowedTokens, field.TypeJSON)
+	}
+	if value, ok := lauo.mutation.MaxTransferAmount(); ok {
+		_spec.SetField(linkedaddress.FieldMaxTransferAmount, field.TypeFloat64, value)
+	}
+	if value, ok := lauo.mutation.AddedMaxTransferAmount(); ok {
+		_spec.AddField(linkedaddress.FieldMaxTransferAmount, field.TypeFloat64, value)
+	}
+	if value, ok := lauo.mutation.DailyVolumeLimit(); ok {
+		_spec.SetField(linkedaddress.FieldDailyVolumeLimit, field.TypeFloat64, value)
+	}
+	if value, ok := lauo.mutation.AddedDailyVolumeLimit(); ok {
+		_spec.AddField(linkedaddress.FieldDailyVolumeLimit, field.TypeFloat64, value)
+	}
+	if value, ok := lauo.mutation.ReplacedBy(); ok {
+		_spec.SetField(linkedaddress.FieldReplacedBy, field.TypeString, value)
+	}
+	if lauo.mutation.ReplacedByCleared() {
+		_spec.ClearField(linkedaddress.FieldReplacedBy, field.TypeString)
+	}
 	if lauo.mutation.PaymentOrdersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -752,6 +1224,35 @@ func (lauo *LinkedAddressUpdateOne) sqlSave(ctx context.Context) (_node *LinkedA
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if lauo.mutation.SenderProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   linkedaddress.SenderProfileTable,
+			Columns: []string{linkedaddress.SenderProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderprofile.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lauo.mutation.SenderProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   linkedaddress.SenderProfileTable,
+			Columns: []string{linkedaddress.SenderProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderprofile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &LinkedAddress{config: lauo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
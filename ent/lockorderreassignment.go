@@ -0,0 +1,168 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/NEDA-LABS/stablenode/ent/lockorderreassignment"
+	"github.com/NEDA-LABS/stablenode/ent/lockpaymentorder"
+	"github.com/google/uuid"
+)
+
+// LockOrderReassignment is the model entity for the LockOrderReassignment schema.
+type LockOrderReassignment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// PreviousProviderID holds the value of the "previous_provider_id" field.
+	PreviousProviderID string `json:"previous_provider_id,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LockOrderReassignmentQuery when eager-loading is set.
+	Edges                            LockOrderReassignmentEdges `json:"edges"`
+	lock_payment_order_reassignments *uuid.UUID
+	selectValues                     sql.SelectValues
+}
+
+// LockOrderReassignmentEdges holds the relations/edges for other nodes in the graph.
+type LockOrderReassignmentEdges struct {
+	// LockPaymentOrder holds the value of the lock_payment_order edge.
+	LockPaymentOrder *LockPaymentOrder `json:"lock_payment_order,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// LockPaymentOrderOrErr returns the LockPaymentOrder value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LockOrderReassignmentEdges) LockPaymentOrderOrErr() (*LockPaymentOrder, error) {
+	if e.LockPaymentOrder != nil {
+		return e.LockPaymentOrder, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: lockpaymentorder.Label}
+	}
+	return nil, &NotLoadedError{edge: "lock_payment_order"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LockOrderReassignment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case lockorderreassignment.FieldPreviousProviderID, lockorderreassignment.FieldReason:
+			values[i] = new(sql.NullString)
+		case lockorderreassignment.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case lockorderreassignment.FieldID:
+			values[i] = new(uuid.UUID)
+		case lockorderreassignment.ForeignKeys[0]: // lock_payment_order_reassignments
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LockOrderReassignment fields.
+func (lor *LockOrderReassignment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case lockorderreassignment.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				lor.ID = *value
+			}
+		case lockorderreassignment.FieldPreviousProviderID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_provider_id", values[i])
+			} else if value.Valid {
+				lor.PreviousProviderID = value.String
+			}
+		case lockorderreassignment.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				lor.Reason = value.String
+			}
+		case lockorderreassignment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				lor.CreatedAt = value.Time
+			}
+		case lockorderreassignment.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field lock_payment_order_reassignments", values[i])
+			} else if value.Valid {
+				lor.lock_payment_order_reassignments = new(uuid.UUID)
+				*lor.lock_payment_order_reassignments = *value.S.(*uuid.UUID)
+			}
+		default:
+			lor.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LockOrderReassignment.
+// This includes values selected through modifiers, order, etc.
+func (lor *LockOrderReassignment) Value(name string) (ent.Value, error) {
+	return lor.selectValues.Get(name)
+}
+
+// QueryLockPaymentOrder queries the "lock_payment_order" edge of the LockOrderReassignment entity.
+func (lor *LockOrderReassignment) QueryLockPaymentOrder() *LockPaymentOrderQuery {
+	return NewLockOrderReassignmentClient(lor.config).QueryLockPaymentOrder(lor)
+}
+
+// Update returns a builder for updating this LockOrderReassignment.
+// Note that you need to call LockOrderReassignment.Unwrap() before calling this method if this LockOrderReassignment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (lor *LockOrderReassignment) Update() *LockOrderReassignmentUpdateOne {
+	return NewLockOrderReassignmentClient(lor.config).UpdateOne(lor)
+}
+
+// Unwrap unwraps the LockOrderReassignment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (lor *LockOrderReassignment) Unwrap() *LockOrderReassignment {
+	_tx, ok := lor.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LockOrderReassignment is not a transactional entity")
+	}
+	lor.config.driver = _tx.drv
+	return lor
+}
+
+// String implements the fmt.Stringer.
+func (lor *LockOrderReassignment) String() string {
+	var builder strings.Builder
+	builder.WriteString("LockOrderReassignment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", lor.ID))
+	builder.WriteString("previous_provider_id=")
+	builder.WriteString(lor.PreviousProviderID)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(lor.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(lor.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LockOrderReassignments is a parsable slice of LockOrderReassignment.
+type LockOrderReassignments []*LockOrderReassignment
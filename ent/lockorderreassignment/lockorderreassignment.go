@@ -0,0 +1,108 @@
+// Code generated by ent, DO NOT EDIT.
+
+package lockorderreassignment
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the lockorderreassignment type in the database.
+	Label = "lock_order_reassignment"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPreviousProviderID holds the string denoting the previous_provider_id field in the database.
+	FieldPreviousProviderID = "previous_provider_id"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeLockPaymentOrder holds the string denoting the lock_payment_order edge name in mutations.
+	EdgeLockPaymentOrder = "lock_payment_order"
+	// Table holds the table name of the lockorderreassignment in the database.
+	Table = "lock_order_reassignments"
+	// LockPaymentOrderTable is the table that holds the lock_payment_order relation/edge.
+	LockPaymentOrderTable = "lock_order_reassignments"
+	// LockPaymentOrderInverseTable is the table name for the LockPaymentOrder entity.
+	// It exists in this package in order to avoid circular dependency with the "lockpaymentorder" package.
+	LockPaymentOrderInverseTable = "lock_payment_orders"
+	// LockPaymentOrderColumn is the table column denoting the lock_payment_order relation/edge.
+	LockPaymentOrderColumn = "lock_payment_order_reassignments"
+)
+
+// Columns holds all SQL columns for lockorderreassignment fields.
+var Columns = []string{
+	FieldID,
+	FieldPreviousProviderID,
+	FieldReason,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "lock_order_reassignments"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"lock_payment_order_reassignments",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the LockOrderReassignment queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByPreviousProviderID orders the results by the previous_provider_id field.
+func ByPreviousProviderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousProviderID, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByLockPaymentOrderField orders the results by lock_payment_order field.
+func ByLockPaymentOrderField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLockPaymentOrderStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newLockPaymentOrderStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LockPaymentOrderInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, LockPaymentOrderTable, LockPaymentOrderColumn),
+	)
+}
@@ -0,0 +1,280 @@
+// Code generated by ent, DO NOT EDIT.
+
+package lockorderreassignment
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldLTE(FieldID, id))
+}
+
+// PreviousProviderID applies equality check predicate on the "previous_provider_id" field. It's identical to PreviousProviderIDEQ.
+func PreviousProviderID(v string) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldEQ(FieldPreviousProviderID, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldEQ(FieldReason, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// PreviousProviderIDEQ applies the EQ predicate on the "previous_provider_id" field.
+func PreviousProviderIDEQ(v string) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldEQ(FieldPreviousProviderID, v))
+}
+
+// PreviousProviderIDNEQ applies the NEQ predicate on the "previous_provider_id" field.
+func PreviousProviderIDNEQ(v string) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldNEQ(FieldPreviousProviderID, v))
+}
+
+// PreviousProviderIDIn applies the In predicate on the "previous_provider_id" field.
+func PreviousProviderIDIn(vs ...string) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldIn(FieldPreviousProviderID, vs...))
+}
+
+// PreviousProviderIDNotIn applies the NotIn predicate on the "previous_provider_id" field.
+func PreviousProviderIDNotIn(vs ...string) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldNotIn(FieldPreviousProviderID, vs...))
+}
+
+// PreviousProviderIDGT applies the GT predicate on the "previous_provider_id" field.
+func PreviousProviderIDGT(v string) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldGT(FieldPreviousProviderID, v))
+}
+
+// PreviousProviderIDGTE applies the GTE predicate on the "previous_provider_id" field.
+func PreviousProviderIDGTE(v string) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldGTE(FieldPreviousProviderID, v))
+}
+
+// PreviousProviderIDLT applies the LT predicate on the "previous_provider_id" field.
+func PreviousProviderIDLT(v string) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldLT(FieldPreviousProviderID, v))
+}
+
+// PreviousProviderIDLTE applies the LTE predicate on the "previous_provider_id" field.
+func PreviousProviderIDLTE(v string) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldLTE(FieldPreviousProviderID, v))
+}
+
+// PreviousProviderIDContains applies the Contains predicate on the "previous_provider_id" field.
+func PreviousProviderIDContains(v string) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldContains(FieldPreviousProviderID, v))
+}
+
+// PreviousProviderIDHasPrefix applies the HasPrefix predicate on the "previous_provider_id" field.
+func PreviousProviderIDHasPrefix(v string) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldHasPrefix(FieldPreviousProviderID, v))
+}
+
+// PreviousProviderIDHasSuffix applies the HasSuffix predicate on the "previous_provider_id" field.
+func PreviousProviderIDHasSuffix(v string) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldHasSuffix(FieldPreviousProviderID, v))
+}
+
+// PreviousProviderIDEqualFold applies the EqualFold predicate on the "previous_provider_id" field.
+func PreviousProviderIDEqualFold(v string) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldEqualFold(FieldPreviousProviderID, v))
+}
+
+// PreviousProviderIDContainsFold applies the ContainsFold predicate on the "previous_provider_id" field.
+func PreviousProviderIDContainsFold(v string) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldContainsFold(FieldPreviousProviderID, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldContainsFold(FieldReason, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasLockPaymentOrder applies the HasEdge predicate on the "lock_payment_order" edge.
+func HasLockPaymentOrder() predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, LockPaymentOrderTable, LockPaymentOrderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLockPaymentOrderWith applies the HasEdge predicate on the "lock_payment_order" edge with a given conditions (other predicates).
+func HasLockPaymentOrderWith(preds ...predicate.LockPaymentOrder) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(func(s *sql.Selector) {
+		step := newLockPaymentOrderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LockOrderReassignment) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LockOrderReassignment) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LockOrderReassignment) predicate.LockOrderReassignment {
+	return predicate.LockOrderReassignment(sql.NotPredicates(p))
+}
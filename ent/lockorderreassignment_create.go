@@ -0,0 +1,557 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/lockorderreassignment"
+	"github.com/NEDA-LABS/stablenode/ent/lockpaymentorder"
+	"github.com/google/uuid"
+)
+
+// LockOrderReassignmentCreate is the builder for creating a LockOrderReassignment entity.
+type LockOrderReassignmentCreate struct {
+	config
+	mutation *LockOrderReassignmentMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetPreviousProviderID sets the "previous_provider_id" field.
+func (lorc *LockOrderReassignmentCreate) SetPreviousProviderID(s string) *LockOrderReassignmentCreate {
+	lorc.mutation.SetPreviousProviderID(s)
+	return lorc
+}
+
+// SetReason sets the "reason" field.
+func (lorc *LockOrderReassignmentCreate) SetReason(s string) *LockOrderReassignmentCreate {
+	lorc.mutation.SetReason(s)
+	return lorc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (lorc *LockOrderReassignmentCreate) SetCreatedAt(t time.Time) *LockOrderReassignmentCreate {
+	lorc.mutation.SetCreatedAt(t)
+	return lorc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (lorc *LockOrderReassignmentCreate) SetNillableCreatedAt(t *time.Time) *LockOrderReassignmentCreate {
+	if t != nil {
+		lorc.SetCreatedAt(*t)
+	}
+	return lorc
+}
+
+// SetID sets the "id" field.
+func (lorc *LockOrderReassignmentCreate) SetID(u uuid.UUID) *LockOrderReassignmentCreate {
+	lorc.mutation.SetID(u)
+	return lorc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (lorc *LockOrderReassignmentCreate) SetNillableID(u *uuid.UUID) *LockOrderReassignmentCreate {
+	if u != nil {
+		lorc.SetID(*u)
+	}
+	return lorc
+}
+
+// SetLockPaymentOrderID sets the "lock_payment_order" edge to the LockPaymentOrder entity by ID.
+func (lorc *LockOrderReassignmentCreate) SetLockPaymentOrderID(id uuid.UUID) *LockOrderReassignmentCreate {
+	lorc.mutation.SetLockPaymentOrderID(id)
+	return lorc
+}
+
+// SetLockPaymentOrder sets the "lock_payment_order" edge to the LockPaymentOrder entity.
+func (lorc *LockOrderReassignmentCreate) SetLockPaymentOrder(l *LockPaymentOrder) *LockOrderReassignmentCreate {
+	return lorc.SetLockPaymentOrderID(l.ID)
+}
+
+// Mutation returns the LockOrderReassignmentMutation object of the builder.
+func (lorc *LockOrderReassignmentCreate) Mutation() *LockOrderReassignmentMutation {
+	return lorc.mutation
+}
+
+// Save creates the LockOrderReassignment in the database.
+func (lorc *LockOrderReassignmentCreate) Save(ctx context.Context) (*LockOrderReassignment, error) {
+	lorc.defaults()
+	return withHooks(ctx, lorc.sqlSave, lorc.mutation, lorc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lorc *LockOrderReassignmentCreate) SaveX(ctx context.Context) *LockOrderReassignment {
+	v, err := lorc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lorc *LockOrderReassignmentCreate) Exec(ctx context.Context) error {
+	_, err := lorc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lorc *LockOrderReassignmentCreate) ExecX(ctx context.Context) {
+	if err := lorc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lorc *LockOrderReassignmentCreate) defaults() {
+	if _, ok := lorc.mutation.CreatedAt(); !ok {
+		v := lockorderreassignment.DefaultCreatedAt()
+		lorc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := lorc.mutation.ID(); !ok {
+		v := lockorderreassignment.DefaultID()
+		lorc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lorc *LockOrderReassignmentCreate) check() error {
+	if _, ok := lorc.mutation.PreviousProviderID(); !ok {
+		return &ValidationError{Name: "previous_provider_id", err: errors.New(`ent: missing required field "LockOrderReassignment.previous_provider_id"`)}
+	}
+	if _, ok := lorc.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "LockOrderReassignment.reason"`)}
+	}
+	if _, ok := lorc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LockOrderReassignment.created_at"`)}
+	}
+	if len(lorc.mutation.LockPaymentOrderIDs()) == 0 {
+		return &ValidationError{Name: "lock_payment_order", err: errors.New(`ent: missing required edge "LockOrderReassignment.lock_payment_order"`)}
+	}
+	return nil
+}
+
+func (lorc *LockOrderReassignmentCreate) sqlSave(ctx context.Context) (*LockOrderReassignment, error) {
+	if err := lorc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := lorc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lorc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	lorc.mutation.id = &_node.ID
+	lorc.mutation.done = true
+	return _node, nil
+}
+
+func (lorc *LockOrderReassignmentCreate) createSpec() (*LockOrderReassignment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LockOrderReassignment{config: lorc.config}
+		_spec = sqlgraph.NewCreateSpec(lockorderreassignment.Table, sqlgraph.NewFieldSpec(lockorderreassignment.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = lorc.conflict
+	if id, ok := lorc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := lorc.mutation.PreviousProviderID(); ok {
+		_spec.SetField(lockorderreassignment.FieldPreviousProviderID, field.TypeString, value)
+		_node.PreviousProviderID = value
+	}
+	if value, ok := lorc.mutation.Reason(); ok {
+		_spec.SetField(lockorderreassignment.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := lorc.mutation.CreatedAt(); ok {
+		_spec.SetField(lockorderreassignment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := lorc.mutation.LockPaymentOrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lockorderreassignment.LockPaymentOrderTable,
+			Columns: []string{lockorderreassignment.LockPaymentOrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lockpaymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.lock_payment_order_reassignments = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LockOrderReassignment.Create().
+//		SetPreviousProviderID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LockOrderReassignmentUpsert) {
+//			SetPreviousProviderID(v+v).
+//		}).
+//		Exec(ctx)
+func (lorc *LockOrderReassignmentCreate) OnConflict(opts ...sql.ConflictOption) *LockOrderReassignmentUpsertOne {
+	lorc.conflict = opts
+	return &LockOrderReassignmentUpsertOne{
+		create: lorc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LockOrderReassignment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (lorc *LockOrderReassignmentCreate) OnConflictColumns(columns ...string) *LockOrderReassignmentUpsertOne {
+	lorc.conflict = append(lorc.conflict, sql.ConflictColumns(columns...))
+	return &LockOrderReassignmentUpsertOne{
+		create: lorc,
+	}
+}
+
+type (
+	// LockOrderReassignmentUpsertOne is the builder for "upsert"-ing
+	//  one LockOrderReassignment node.
+	LockOrderReassignmentUpsertOne struct {
+		create *LockOrderReassignmentCreate
+	}
+
+	// LockOrderReassignmentUpsert is the "OnConflict" setter.
+	LockOrderReassignmentUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.LockOrderReassignment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(lockorderreassignment.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LockOrderReassignmentUpsertOne) UpdateNewValues() *LockOrderReassignmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(lockorderreassignment.FieldID)
+		}
+		if _, exists := u.create.mutation.PreviousProviderID(); exists {
+			s.SetIgnore(lockorderreassignment.FieldPreviousProviderID)
+		}
+		if _, exists := u.create.mutation.Reason(); exists {
+			s.SetIgnore(lockorderreassignment.FieldReason)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(lockorderreassignment.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LockOrderReassignment.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LockOrderReassignmentUpsertOne) Ignore() *LockOrderReassignmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LockOrderReassignmentUpsertOne) DoNothing() *LockOrderReassignmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LockOrderReassignmentCreate.OnConflict
+// documentation for more info.
+func (u *LockOrderReassignmentUpsertOne) Update(set func(*LockOrderReassignmentUpsert)) *LockOrderReassignmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LockOrderReassignmentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *LockOrderReassignmentUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LockOrderReassignmentCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LockOrderReassignmentUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LockOrderReassignmentUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: LockOrderReassignmentUpsertOne.ID is not supported by MySQL driver. Use LockOrderReassignmentUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LockOrderReassignmentUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LockOrderReassignmentCreateBulk is the builder for creating many LockOrderReassignment entities in bulk.
+type LockOrderReassignmentCreateBulk struct {
+	config
+	err      error
+	builders []*LockOrderReassignmentCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the LockOrderReassignment entities in the database.
+func (lorcb *LockOrderReassignmentCreateBulk) Save(ctx context.Context) ([]*LockOrderReassignment, error) {
+	if lorcb.err != nil {
+		return nil, lorcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(lorcb.builders))
+	nodes := make([]*LockOrderReassignment, len(lorcb.builders))
+	mutators := make([]Mutator, len(lorcb.builders))
+	for i := range lorcb.builders {
+		func(i int, root context.Context) {
+			builder := lorcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LockOrderReassignmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lorcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = lorcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lorcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lorcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lorcb *LockOrderReassignmentCreateBulk) SaveX(ctx context.Context) []*LockOrderReassignment {
+	v, err := lorcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lorcb *LockOrderReassignmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := lorcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lorcb *LockOrderReassignmentCreateBulk) ExecX(ctx context.Context) {
+	if err := lorcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LockOrderReassignment.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LockOrderReassignmentUpsert) {
+//			SetPreviousProviderID(v+v).
+//		}).
+//		Exec(ctx)
+func (lorcb *LockOrderReassignmentCreateBulk) OnConflict(opts ...sql.ConflictOption) *LockOrderReassignmentUpsertBulk {
+	lorcb.conflict = opts
+	return &LockOrderReassignmentUpsertBulk{
+		create: lorcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LockOrderReassignment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (lorcb *LockOrderReassignmentCreateBulk) OnConflictColumns(columns ...string) *LockOrderReassignmentUpsertBulk {
+	lorcb.conflict = append(lorcb.conflict, sql.ConflictColumns(columns...))
+	return &LockOrderReassignmentUpsertBulk{
+		create: lorcb,
+	}
+}
+
+// LockOrderReassignmentUpsertBulk is the builder for "upsert"-ing
+// a bulk of LockOrderReassignment nodes.
+type LockOrderReassignmentUpsertBulk struct {
+	create *LockOrderReassignmentCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.LockOrderReassignment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(lockorderreassignment.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LockOrderReassignmentUpsertBulk) UpdateNewValues() *LockOrderReassignmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(lockorderreassignment.FieldID)
+			}
+			if _, exists := b.mutation.PreviousProviderID(); exists {
+				s.SetIgnore(lockorderreassignment.FieldPreviousProviderID)
+			}
+			if _, exists := b.mutation.Reason(); exists {
+				s.SetIgnore(lockorderreassignment.FieldReason)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(lockorderreassignment.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LockOrderReassignment.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LockOrderReassignmentUpsertBulk) Ignore() *LockOrderReassignmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LockOrderReassignmentUpsertBulk) DoNothing() *LockOrderReassignmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LockOrderReassignmentCreateBulk.OnConflict
+// documentation for more info.
+func (u *LockOrderReassignmentUpsertBulk) Update(set func(*LockOrderReassignmentUpsert)) *LockOrderReassignmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LockOrderReassignmentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *LockOrderReassignmentUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the LockOrderReassignmentCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LockOrderReassignmentCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LockOrderReassignmentUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
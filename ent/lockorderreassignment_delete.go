@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/lockorderreassignment"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+)
+
+// LockOrderReassignmentDelete is the builder for deleting a LockOrderReassignment entity.
+type LockOrderReassignmentDelete struct {
+	config
+	hooks    []Hook
+	mutation *LockOrderReassignmentMutation
+}
+
+// Where appends a list predicates to the LockOrderReassignmentDelete builder.
+func (lord *LockOrderReassignmentDelete) Where(ps ...predicate.LockOrderReassignment) *LockOrderReassignmentDelete {
+	lord.mutation.Where(ps...)
+	return lord
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (lord *LockOrderReassignmentDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, lord.sqlExec, lord.mutation, lord.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lord *LockOrderReassignmentDelete) ExecX(ctx context.Context) int {
+	n, err := lord.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (lord *LockOrderReassignmentDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(lockorderreassignment.Table, sqlgraph.NewFieldSpec(lockorderreassignment.FieldID, field.TypeUUID))
+	if ps := lord.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, lord.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	lord.mutation.done = true
+	return affected, err
+}
+
+// LockOrderReassignmentDeleteOne is the builder for deleting a single LockOrderReassignment entity.
+type LockOrderReassignmentDeleteOne struct {
+	lord *LockOrderReassignmentDelete
+}
+
+// Where appends a list predicates to the LockOrderReassignmentDelete builder.
+func (lordo *LockOrderReassignmentDeleteOne) Where(ps ...predicate.LockOrderReassignment) *LockOrderReassignmentDeleteOne {
+	lordo.lord.mutation.Where(ps...)
+	return lordo
+}
+
+// Exec executes the deletion query.
+func (lordo *LockOrderReassignmentDeleteOne) Exec(ctx context.Context) error {
+	n, err := lordo.lord.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{lockorderreassignment.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lordo *LockOrderReassignmentDeleteOne) ExecX(ctx context.Context) {
+	if err := lordo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
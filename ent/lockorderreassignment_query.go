@@ -0,0 +1,615 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/lockorderreassignment"
+	"github.com/NEDA-LABS/stablenode/ent/lockpaymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// LockOrderReassignmentQuery is the builder for querying LockOrderReassignment entities.
+type LockOrderReassignmentQuery struct {
+	config
+	ctx                  *QueryContext
+	order                []lockorderreassignment.OrderOption
+	inters               []Interceptor
+	predicates           []predicate.LockOrderReassignment
+	withLockPaymentOrder *LockPaymentOrderQuery
+	withFKs              bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the LockOrderReassignmentQuery builder.
+func (lorq *LockOrderReassignmentQuery) Where(ps ...predicate.LockOrderReassignment) *LockOrderReassignmentQuery {
+	lorq.predicates = append(lorq.predicates, ps...)
+	return lorq
+}
+
+// Limit the number of records to be returned by this query.
+func (lorq *LockOrderReassignmentQuery) Limit(limit int) *LockOrderReassignmentQuery {
+	lorq.ctx.Limit = &limit
+	return lorq
+}
+
+// Offset to start from.
+func (lorq *LockOrderReassignmentQuery) Offset(offset int) *LockOrderReassignmentQuery {
+	lorq.ctx.Offset = &offset
+	return lorq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (lorq *LockOrderReassignmentQuery) Unique(unique bool) *LockOrderReassignmentQuery {
+	lorq.ctx.Unique = &unique
+	return lorq
+}
+
+// Order specifies how the records should be ordered.
+func (lorq *LockOrderReassignmentQuery) Order(o ...lockorderreassignment.OrderOption) *LockOrderReassignmentQuery {
+	lorq.order = append(lorq.order, o...)
+	return lorq
+}
+
+// QueryLockPaymentOrder chains the current query on the "lock_payment_order" edge.
+func (lorq *LockOrderReassignmentQuery) QueryLockPaymentOrder() *LockPaymentOrderQuery {
+	query := (&LockPaymentOrderClient{config: lorq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lorq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lorq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lockorderreassignment.Table, lockorderreassignment.FieldID, selector),
+			sqlgraph.To(lockpaymentorder.Table, lockpaymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, lockorderreassignment.LockPaymentOrderTable, lockorderreassignment.LockPaymentOrderColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lorq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first LockOrderReassignment entity from the query.
+// Returns a *NotFoundError when no LockOrderReassignment was found.
+func (lorq *LockOrderReassignmentQuery) First(ctx context.Context) (*LockOrderReassignment, error) {
+	nodes, err := lorq.Limit(1).All(setContextOp(ctx, lorq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{lockorderreassignment.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (lorq *LockOrderReassignmentQuery) FirstX(ctx context.Context) *LockOrderReassignment {
+	node, err := lorq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first LockOrderReassignment ID from the query.
+// Returns a *NotFoundError when no LockOrderReassignment ID was found.
+func (lorq *LockOrderReassignmentQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = lorq.Limit(1).IDs(setContextOp(ctx, lorq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{lockorderreassignment.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (lorq *LockOrderReassignmentQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := lorq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single LockOrderReassignment entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one LockOrderReassignment entity is found.
+// Returns a *NotFoundError when no LockOrderReassignment entities are found.
+func (lorq *LockOrderReassignmentQuery) Only(ctx context.Context) (*LockOrderReassignment, error) {
+	nodes, err := lorq.Limit(2).All(setContextOp(ctx, lorq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{lockorderreassignment.Label}
+	default:
+		return nil, &NotSingularError{lockorderreassignment.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (lorq *LockOrderReassignmentQuery) OnlyX(ctx context.Context) *LockOrderReassignment {
+	node, err := lorq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only LockOrderReassignment ID in the query.
+// Returns a *NotSingularError when more than one LockOrderReassignment ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (lorq *LockOrderReassignmentQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = lorq.Limit(2).IDs(setContextOp(ctx, lorq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{lockorderreassignment.Label}
+	default:
+		err = &NotSingularError{lockorderreassignment.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (lorq *LockOrderReassignmentQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := lorq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of LockOrderReassignments.
+func (lorq *LockOrderReassignmentQuery) All(ctx context.Context) ([]*LockOrderReassignment, error) {
+	ctx = setContextOp(ctx, lorq.ctx, ent.OpQueryAll)
+	if err := lorq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*LockOrderReassignment, *LockOrderReassignmentQuery]()
+	return withInterceptors[[]*LockOrderReassignment](ctx, lorq, qr, lorq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (lorq *LockOrderReassignmentQuery) AllX(ctx context.Context) []*LockOrderReassignment {
+	nodes, err := lorq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of LockOrderReassignment IDs.
+func (lorq *LockOrderReassignmentQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if lorq.ctx.Unique == nil && lorq.path != nil {
+		lorq.Unique(true)
+	}
+	ctx = setContextOp(ctx, lorq.ctx, ent.OpQueryIDs)
+	if err = lorq.Select(lockorderreassignment.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (lorq *LockOrderReassignmentQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := lorq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (lorq *LockOrderReassignmentQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, lorq.ctx, ent.OpQueryCount)
+	if err := lorq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, lorq, querierCount[*LockOrderReassignmentQuery](), lorq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (lorq *LockOrderReassignmentQuery) CountX(ctx context.Context) int {
+	count, err := lorq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (lorq *LockOrderReassignmentQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, lorq.ctx, ent.OpQueryExist)
+	switch _, err := lorq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (lorq *LockOrderReassignmentQuery) ExistX(ctx context.Context) bool {
+	exist, err := lorq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the LockOrderReassignmentQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (lorq *LockOrderReassignmentQuery) Clone() *LockOrderReassignmentQuery {
+	if lorq == nil {
+		return nil
+	}
+	return &LockOrderReassignmentQuery{
+		config:               lorq.config,
+		ctx:                  lorq.ctx.Clone(),
+		order:                append([]lockorderreassignment.OrderOption{}, lorq.order...),
+		inters:               append([]Interceptor{}, lorq.inters...),
+		predicates:           append([]predicate.LockOrderReassignment{}, lorq.predicates...),
+		withLockPaymentOrder: lorq.withLockPaymentOrder.Clone(),
+		// clone intermediate query.
+		sql:  lorq.sql.Clone(),
+		path: lorq.path,
+	}
+}
+
+// WithLockPaymentOrder tells the query-builder to eager-load the nodes that are connected to
+// the "lock_payment_order" edge. The optional arguments are used to configure the query builder of the edge.
+func (lorq *LockOrderReassignmentQuery) WithLockPaymentOrder(opts ...func(*LockPaymentOrderQuery)) *LockOrderReassignmentQuery {
+	query := (&LockPaymentOrderClient{config: lorq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	lorq.withLockPaymentOrder = query
+	return lorq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		PreviousProviderID string `json:"previous_provider_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.LockOrderReassignment.Query().
+//		GroupBy(lockorderreassignment.FieldPreviousProviderID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (lorq *LockOrderReassignmentQuery) GroupBy(field string, fields ...string) *LockOrderReassignmentGroupBy {
+	lorq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &LockOrderReassignmentGroupBy{build: lorq}
+	grbuild.flds = &lorq.ctx.Fields
+	grbuild.label = lockorderreassignment.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		PreviousProviderID string `json:"previous_provider_id,omitempty"`
+//	}
+//
+//	client.LockOrderReassignment.Query().
+//		Select(lockorderreassignment.FieldPreviousProviderID).
+//		Scan(ctx, &v)
+func (lorq *LockOrderReassignmentQuery) Select(fields ...string) *LockOrderReassignmentSelect {
+	lorq.ctx.Fields = append(lorq.ctx.Fields, fields...)
+	sbuild := &LockOrderReassignmentSelect{LockOrderReassignmentQuery: lorq}
+	sbuild.label = lockorderreassignment.Label
+	sbuild.flds, sbuild.scan = &lorq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a LockOrderReassignmentSelect configured with the given aggregations.
+func (lorq *LockOrderReassignmentQuery) Aggregate(fns ...AggregateFunc) *LockOrderReassignmentSelect {
+	return lorq.Select().Aggregate(fns...)
+}
+
+func (lorq *LockOrderReassignmentQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range lorq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, lorq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range lorq.ctx.Fields {
+		if !lockorderreassignment.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if lorq.path != nil {
+		prev, err := lorq.path(ctx)
+		if err != nil {
+			return err
+		}
+		lorq.sql = prev
+	}
+	return nil
+}
+
+func (lorq *LockOrderReassignmentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*LockOrderReassignment, error) {
+	var (
+		nodes       = []*LockOrderReassignment{}
+		withFKs     = lorq.withFKs
+		_spec       = lorq.querySpec()
+		loadedTypes = [1]bool{
+			lorq.withLockPaymentOrder != nil,
+		}
+	)
+	if lorq.withLockPaymentOrder != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, lockorderreassignment.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*LockOrderReassignment).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &LockOrderReassignment{config: lorq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, lorq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := lorq.withLockPaymentOrder; query != nil {
+		if err := lorq.loadLockPaymentOrder(ctx, query, nodes, nil,
+			func(n *LockOrderReassignment, e *LockPaymentOrder) { n.Edges.LockPaymentOrder = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (lorq *LockOrderReassignmentQuery) loadLockPaymentOrder(ctx context.Context, query *LockPaymentOrderQuery, nodes []*LockOrderReassignment, init func(*LockOrderReassignment), assign func(*LockOrderReassignment, *LockPaymentOrder)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*LockOrderReassignment)
+	for i := range nodes {
+		if nodes[i].lock_payment_order_reassignments == nil {
+			continue
+		}
+		fk := *nodes[i].lock_payment_order_reassignments
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(lockpaymentorder.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "lock_payment_order_reassignments" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (lorq *LockOrderReassignmentQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := lorq.querySpec()
+	_spec.Node.Columns = lorq.ctx.Fields
+	if len(lorq.ctx.Fields) > 0 {
+		_spec.Unique = lorq.ctx.Unique != nil && *lorq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, lorq.driver, _spec)
+}
+
+func (lorq *LockOrderReassignmentQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(lockorderreassignment.Table, lockorderreassignment.Columns, sqlgraph.NewFieldSpec(lockorderreassignment.FieldID, field.TypeUUID))
+	_spec.From = lorq.sql
+	if unique := lorq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if lorq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := lorq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, lockorderreassignment.FieldID)
+		for i := range fields {
+			if fields[i] != lockorderreassignment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := lorq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := lorq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := lorq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := lorq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (lorq *LockOrderReassignmentQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(lorq.driver.Dialect())
+	t1 := builder.Table(lockorderreassignment.Table)
+	columns := lorq.ctx.Fields
+	if len(columns) == 0 {
+		columns = lockorderreassignment.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if lorq.sql != nil {
+		selector = lorq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if lorq.ctx.Unique != nil && *lorq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range lorq.predicates {
+		p(selector)
+	}
+	for _, p := range lorq.order {
+		p(selector)
+	}
+	if offset := lorq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := lorq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// LockOrderReassignmentGroupBy is the group-by builder for LockOrderReassignment entities.
+type LockOrderReassignmentGroupBy struct {
+	selector
+	build *LockOrderReassignmentQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (lorgb *LockOrderReassignmentGroupBy) Aggregate(fns ...AggregateFunc) *LockOrderReassignmentGroupBy {
+	lorgb.fns = append(lorgb.fns, fns...)
+	return lorgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (lorgb *LockOrderReassignmentGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, lorgb.build.ctx, ent.OpQueryGroupBy)
+	if err := lorgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LockOrderReassignmentQuery, *LockOrderReassignmentGroupBy](ctx, lorgb.build, lorgb, lorgb.build.inters, v)
+}
+
+func (lorgb *LockOrderReassignmentGroupBy) sqlScan(ctx context.Context, root *LockOrderReassignmentQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(lorgb.fns))
+	for _, fn := range lorgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*lorgb.flds)+len(lorgb.fns))
+		for _, f := range *lorgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*lorgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := lorgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// LockOrderReassignmentSelect is the builder for selecting fields of LockOrderReassignment entities.
+type LockOrderReassignmentSelect struct {
+	*LockOrderReassignmentQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (lors *LockOrderReassignmentSelect) Aggregate(fns ...AggregateFunc) *LockOrderReassignmentSelect {
+	lors.fns = append(lors.fns, fns...)
+	return lors
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (lors *LockOrderReassignmentSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, lors.ctx, ent.OpQuerySelect)
+	if err := lors.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LockOrderReassignmentQuery, *LockOrderReassignmentSelect](ctx, lors.LockOrderReassignmentQuery, lors, lors.inters, v)
+}
+
+func (lors *LockOrderReassignmentSelect) sqlScan(ctx context.Context, root *LockOrderReassignmentQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(lors.fns))
+	for _, fn := range lors.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*lors.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := lors.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
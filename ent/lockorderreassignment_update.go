@@ -0,0 +1,197 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/lockorderreassignment"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+)
+
+// LockOrderReassignmentUpdate is the builder for updating LockOrderReassignment entities.
+type LockOrderReassignmentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LockOrderReassignmentMutation
+}
+
+// Where appends a list predicates to the LockOrderReassignmentUpdate builder.
+func (loru *LockOrderReassignmentUpdate) Where(ps ...predicate.LockOrderReassignment) *LockOrderReassignmentUpdate {
+	loru.mutation.Where(ps...)
+	return loru
+}
+
+// Mutation returns the LockOrderReassignmentMutation object of the builder.
+func (loru *LockOrderReassignmentUpdate) Mutation() *LockOrderReassignmentMutation {
+	return loru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (loru *LockOrderReassignmentUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, loru.sqlSave, loru.mutation, loru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (loru *LockOrderReassignmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := loru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (loru *LockOrderReassignmentUpdate) Exec(ctx context.Context) error {
+	_, err := loru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (loru *LockOrderReassignmentUpdate) ExecX(ctx context.Context) {
+	if err := loru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (loru *LockOrderReassignmentUpdate) check() error {
+	if loru.mutation.LockPaymentOrderCleared() && len(loru.mutation.LockPaymentOrderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LockOrderReassignment.lock_payment_order"`)
+	}
+	return nil
+}
+
+func (loru *LockOrderReassignmentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := loru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(lockorderreassignment.Table, lockorderreassignment.Columns, sqlgraph.NewFieldSpec(lockorderreassignment.FieldID, field.TypeUUID))
+	if ps := loru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, loru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{lockorderreassignment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	loru.mutation.done = true
+	return n, nil
+}
+
+// LockOrderReassignmentUpdateOne is the builder for updating a single LockOrderReassignment entity.
+type LockOrderReassignmentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LockOrderReassignmentMutation
+}
+
+// Mutation returns the LockOrderReassignmentMutation object of the builder.
+func (loruo *LockOrderReassignmentUpdateOne) Mutation() *LockOrderReassignmentMutation {
+	return loruo.mutation
+}
+
+// Where appends a list predicates to the LockOrderReassignmentUpdate builder.
+func (loruo *LockOrderReassignmentUpdateOne) Where(ps ...predicate.LockOrderReassignment) *LockOrderReassignmentUpdateOne {
+	loruo.mutation.Where(ps...)
+	return loruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (loruo *LockOrderReassignmentUpdateOne) Select(field string, fields ...string) *LockOrderReassignmentUpdateOne {
+	loruo.fields = append([]string{field}, fields...)
+	return loruo
+}
+
+// Save executes the query and returns the updated LockOrderReassignment entity.
+func (loruo *LockOrderReassignmentUpdateOne) Save(ctx context.Context) (*LockOrderReassignment, error) {
+	return withHooks(ctx, loruo.sqlSave, loruo.mutation, loruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (loruo *LockOrderReassignmentUpdateOne) SaveX(ctx context.Context) *LockOrderReassignment {
+	node, err := loruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (loruo *LockOrderReassignmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := loruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (loruo *LockOrderReassignmentUpdateOne) ExecX(ctx context.Context) {
+	if err := loruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (loruo *LockOrderReassignmentUpdateOne) check() error {
+	if loruo.mutation.LockPaymentOrderCleared() && len(loruo.mutation.LockPaymentOrderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LockOrderReassignment.lock_payment_order"`)
+	}
+	return nil
+}
+
+func (loruo *LockOrderReassignmentUpdateOne) sqlSave(ctx context.Context) (_node *LockOrderReassignment, err error) {
+	if err := loruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(lockorderreassignment.Table, lockorderreassignment.Columns, sqlgraph.NewFieldSpec(lockorderreassignment.FieldID, field.TypeUUID))
+	id, ok := loruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LockOrderReassignment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := loruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, lockorderreassignment.FieldID)
+		for _, f := range fields {
+			if !lockorderreassignment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != lockorderreassignment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := loruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &LockOrderReassignment{config: loruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, loruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{lockorderreassignment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	loruo.mutation.done = true
+	return _node, nil
+}
@@ -63,9 +63,18 @@ type LockPaymentOrder struct {
 	MessageHash string `json:"message_hash,omitempty"`
 	// AmountInUsd holds the value of the "amount_in_usd" field.
 	AmountInUsd decimal.Decimal `json:"amount_in_usd,omitempty"`
+	// SLABreachedStage holds the value of the "sla_breached_stage" field.
+	SLABreachedStage lockpaymentorder.SLABreachedStage `json:"sla_breached_stage,omitempty"`
+	// SLABreachedAt holds the value of the "sla_breached_at" field.
+	SLABreachedAt time.Time `json:"sla_breached_at,omitempty"`
+	// ReviewReason holds the value of the "review_reason" field.
+	ReviewReason string `json:"review_reason,omitempty"`
+	// SplitIndex holds the value of the "split_index" field.
+	SplitIndex int `json:"split_index,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the LockPaymentOrderQuery when eager-loading is set.
 	Edges                                LockPaymentOrderEdges `json:"edges"`
+	lock_payment_order_children          *uuid.UUID
 	provider_profile_assigned_orders     *string
 	provision_bucket_lock_payment_orders *int
 	token_lock_payment_orders            *int
@@ -84,9 +93,15 @@ type LockPaymentOrderEdges struct {
 	Fulfillments []*LockOrderFulfillment `json:"fulfillments,omitempty"`
 	// Transactions holds the value of the transactions edge.
 	Transactions []*TransactionLog `json:"transactions,omitempty"`
+	// Reassignments holds the value of the reassignments edge.
+	Reassignments []*LockOrderReassignment `json:"reassignments,omitempty"`
+	// Parent holds the value of the parent edge.
+	Parent *LockPaymentOrder `json:"parent,omitempty"`
+	// Children holds the value of the children edge.
+	Children []*LockPaymentOrder `json:"children,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [8]bool
 }
 
 // TokenOrErr returns the Token value or an error if the edge
@@ -140,6 +155,35 @@ func (e LockPaymentOrderEdges) TransactionsOrErr() ([]*TransactionLog, error) {
 	return nil, &NotLoadedError{edge: "transactions"}
 }
 
+// ReassignmentsOrErr returns the Reassignments value or an error if the edge
+// was not loaded in eager-loading.
+func (e LockPaymentOrderEdges) ReassignmentsOrErr() ([]*LockOrderReassignment, error) {
+	if e.loadedTypes[5] {
+		return e.Reassignments, nil
+	}
+	return nil, &NotLoadedError{edge: "reassignments"}
+}
+
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LockPaymentOrderEdges) ParentOrErr() (*LockPaymentOrder, error) {
+	if e.Parent != nil {
+		return e.Parent, nil
+	} else if e.loadedTypes[6] {
+		return nil, &NotFoundError{label: lockpaymentorder.Label}
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// ChildrenOrErr returns the Children value or an error if the edge
+// was not loaded in eager-loading.
+func (e LockPaymentOrderEdges) ChildrenOrErr() ([]*LockPaymentOrder, error) {
+	if e.loadedTypes[7] {
+		return e.Children, nil
+	}
+	return nil, &NotLoadedError{edge: "children"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*LockPaymentOrder) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -149,19 +193,21 @@ func (*LockPaymentOrder) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case lockpaymentorder.FieldAmount, lockpaymentorder.FieldProtocolFee, lockpaymentorder.FieldRate, lockpaymentorder.FieldOrderPercent, lockpaymentorder.FieldAmountInUsd:
 			values[i] = new(decimal.Decimal)
-		case lockpaymentorder.FieldBlockNumber, lockpaymentorder.FieldCancellationCount:
+		case lockpaymentorder.FieldBlockNumber, lockpaymentorder.FieldCancellationCount, lockpaymentorder.FieldSplitIndex:
 			values[i] = new(sql.NullInt64)
-		case lockpaymentorder.FieldGatewayID, lockpaymentorder.FieldSender, lockpaymentorder.FieldTxHash, lockpaymentorder.FieldStatus, lockpaymentorder.FieldInstitution, lockpaymentorder.FieldAccountIdentifier, lockpaymentorder.FieldAccountName, lockpaymentorder.FieldMemo, lockpaymentorder.FieldMessageHash:
+		case lockpaymentorder.FieldGatewayID, lockpaymentorder.FieldSender, lockpaymentorder.FieldTxHash, lockpaymentorder.FieldStatus, lockpaymentorder.FieldInstitution, lockpaymentorder.FieldAccountIdentifier, lockpaymentorder.FieldAccountName, lockpaymentorder.FieldMemo, lockpaymentorder.FieldMessageHash, lockpaymentorder.FieldSLABreachedStage, lockpaymentorder.FieldReviewReason:
 			values[i] = new(sql.NullString)
-		case lockpaymentorder.FieldCreatedAt, lockpaymentorder.FieldUpdatedAt:
+		case lockpaymentorder.FieldCreatedAt, lockpaymentorder.FieldUpdatedAt, lockpaymentorder.FieldSLABreachedAt:
 			values[i] = new(sql.NullTime)
 		case lockpaymentorder.FieldID:
 			values[i] = new(uuid.UUID)
-		case lockpaymentorder.ForeignKeys[0]: // provider_profile_assigned_orders
+		case lockpaymentorder.ForeignKeys[0]: // lock_payment_order_children
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case lockpaymentorder.ForeignKeys[1]: // provider_profile_assigned_orders
 			values[i] = new(sql.NullString)
-		case lockpaymentorder.ForeignKeys[1]: // provision_bucket_lock_payment_orders
+		case lockpaymentorder.ForeignKeys[2]: // provision_bucket_lock_payment_orders
 			values[i] = new(sql.NullInt64)
-		case lockpaymentorder.ForeignKeys[2]: // token_lock_payment_orders
+		case lockpaymentorder.ForeignKeys[3]: // token_lock_payment_orders
 			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -308,21 +354,52 @@ func (lpo *LockPaymentOrder) assignValues(columns []string, values []any) error
 			} else if value != nil {
 				lpo.AmountInUsd = *value
 			}
+		case lockpaymentorder.FieldSLABreachedStage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sla_breached_stage", values[i])
+			} else if value.Valid {
+				lpo.SLABreachedStage = lockpaymentorder.SLABreachedStage(value.String)
+			}
+		case lockpaymentorder.FieldSLABreachedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field sla_breached_at", values[i])
+			} else if value.Valid {
+				lpo.SLABreachedAt = value.Time
+			}
+		case lockpaymentorder.FieldReviewReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field review_reason", values[i])
+			} else if value.Valid {
+				lpo.ReviewReason = value.String
+			}
+		case lockpaymentorder.FieldSplitIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field split_index", values[i])
+			} else if value.Valid {
+				lpo.SplitIndex = int(value.Int64)
+			}
 		case lockpaymentorder.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field lock_payment_order_children", values[i])
+			} else if value.Valid {
+				lpo.lock_payment_order_children = new(uuid.UUID)
+				*lpo.lock_payment_order_children = *value.S.(*uuid.UUID)
+			}
+		case lockpaymentorder.ForeignKeys[1]:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field provider_profile_assigned_orders", values[i])
 			} else if value.Valid {
 				lpo.provider_profile_assigned_orders = new(string)
 				*lpo.provider_profile_assigned_orders = value.String
 			}
-		case lockpaymentorder.ForeignKeys[1]:
+		case lockpaymentorder.ForeignKeys[2]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field provision_bucket_lock_payment_orders", value)
 			} else if value.Valid {
 				lpo.provision_bucket_lock_payment_orders = new(int)
 				*lpo.provision_bucket_lock_payment_orders = int(value.Int64)
 			}
-		case lockpaymentorder.ForeignKeys[2]:
+		case lockpaymentorder.ForeignKeys[3]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field token_lock_payment_orders", value)
 			} else if value.Valid {
@@ -367,6 +444,21 @@ func (lpo *LockPaymentOrder) QueryTransactions() *TransactionLogQuery {
 	return NewLockPaymentOrderClient(lpo.config).QueryTransactions(lpo)
 }
 
+// QueryReassignments queries the "reassignments" edge of the LockPaymentOrder entity.
+func (lpo *LockPaymentOrder) QueryReassignments() *LockOrderReassignmentQuery {
+	return NewLockPaymentOrderClient(lpo.config).QueryReassignments(lpo)
+}
+
+// QueryParent queries the "parent" edge of the LockPaymentOrder entity.
+func (lpo *LockPaymentOrder) QueryParent() *LockPaymentOrderQuery {
+	return NewLockPaymentOrderClient(lpo.config).QueryParent(lpo)
+}
+
+// QueryChildren queries the "children" edge of the LockPaymentOrder entity.
+func (lpo *LockPaymentOrder) QueryChildren() *LockPaymentOrderQuery {
+	return NewLockPaymentOrderClient(lpo.config).QueryChildren(lpo)
+}
+
 // Update returns a builder for updating this LockPaymentOrder.
 // Note that you need to call LockPaymentOrder.Unwrap() before calling this method if this LockPaymentOrder
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -449,6 +541,18 @@ func (lpo *LockPaymentOrder) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("amount_in_usd=")
 	builder.WriteString(fmt.Sprintf("%v", lpo.AmountInUsd))
+	builder.WriteString(", ")
+	builder.WriteString("sla_breached_stage=")
+	builder.WriteString(fmt.Sprintf("%v", lpo.SLABreachedStage))
+	builder.WriteString(", ")
+	builder.WriteString("sla_breached_at=")
+	builder.WriteString(lpo.SLABreachedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("review_reason=")
+	builder.WriteString(lpo.ReviewReason)
+	builder.WriteString(", ")
+	builder.WriteString("split_index=")
+	builder.WriteString(fmt.Sprintf("%v", lpo.SplitIndex))
 	builder.WriteByte(')')
 	return builder.String()
 }
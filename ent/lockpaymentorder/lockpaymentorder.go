@@ -56,6 +56,14 @@ const (
 	FieldMessageHash = "message_hash"
 	// FieldAmountInUsd holds the string denoting the amount_in_usd field in the database.
 	FieldAmountInUsd = "amount_in_usd"
+	// FieldSLABreachedStage holds the string denoting the sla_breached_stage field in the database.
+	FieldSLABreachedStage = "sla_breached_stage"
+	// FieldSLABreachedAt holds the string denoting the sla_breached_at field in the database.
+	FieldSLABreachedAt = "sla_breached_at"
+	// FieldReviewReason holds the string denoting the review_reason field in the database.
+	FieldReviewReason = "review_reason"
+	// FieldSplitIndex holds the string denoting the split_index field in the database.
+	FieldSplitIndex = "split_index"
 	// EdgeToken holds the string denoting the token edge name in mutations.
 	EdgeToken = "token"
 	// EdgeProvisionBucket holds the string denoting the provision_bucket edge name in mutations.
@@ -66,6 +74,12 @@ const (
 	EdgeFulfillments = "fulfillments"
 	// EdgeTransactions holds the string denoting the transactions edge name in mutations.
 	EdgeTransactions = "transactions"
+	// EdgeReassignments holds the string denoting the reassignments edge name in mutations.
+	EdgeReassignments = "reassignments"
+	// EdgeParent holds the string denoting the parent edge name in mutations.
+	EdgeParent = "parent"
+	// EdgeChildren holds the string denoting the children edge name in mutations.
+	EdgeChildren = "children"
 	// Table holds the table name of the lockpaymentorder in the database.
 	Table = "lock_payment_orders"
 	// TokenTable is the table that holds the token relation/edge.
@@ -103,6 +117,21 @@ const (
 	TransactionsInverseTable = "transaction_logs"
 	// TransactionsColumn is the table column denoting the transactions relation/edge.
 	TransactionsColumn = "lock_payment_order_transactions"
+	// ReassignmentsTable is the table that holds the reassignments relation/edge.
+	ReassignmentsTable = "lock_order_reassignments"
+	// ReassignmentsInverseTable is the table name for the LockOrderReassignment entity.
+	// It exists in this package in order to avoid circular dependency with the "lockorderreassignment" package.
+	ReassignmentsInverseTable = "lock_order_reassignments"
+	// ReassignmentsColumn is the table column denoting the reassignments relation/edge.
+	ReassignmentsColumn = "lock_payment_order_reassignments"
+	// ParentTable is the table that holds the parent relation/edge.
+	ParentTable = "lock_payment_orders"
+	// ParentColumn is the table column denoting the parent relation/edge.
+	ParentColumn = "lock_payment_order_children"
+	// ChildrenTable is the table that holds the children relation/edge.
+	ChildrenTable = "lock_payment_orders"
+	// ChildrenColumn is the table column denoting the children relation/edge.
+	ChildrenColumn = "lock_payment_order_children"
 )
 
 // Columns holds all SQL columns for lockpaymentorder fields.
@@ -128,11 +157,16 @@ var Columns = []string{
 	FieldCancellationReasons,
 	FieldMessageHash,
 	FieldAmountInUsd,
+	FieldSLABreachedStage,
+	FieldSLABreachedAt,
+	FieldReviewReason,
+	FieldSplitIndex,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "lock_payment_orders"
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
+	"lock_payment_order_children",
 	"provider_profile_assigned_orders",
 	"provision_bucket_lock_payment_orders",
 	"token_lock_payment_orders",
@@ -168,6 +202,8 @@ var (
 	DefaultCancellationReasons []string
 	// MessageHashValidator is a validator for the "message_hash" field. It is called by the builders before save.
 	MessageHashValidator func(string) error
+	// DefaultSplitIndex holds the default value on creation for the "split_index" field.
+	DefaultSplitIndex int
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -187,6 +223,7 @@ const (
 	StatusValidated  Status = "validated"
 	StatusSettled    Status = "settled"
 	StatusRefunded   Status = "refunded"
+	StatusSplit      Status = "split"
 )
 
 func (s Status) String() string {
@@ -196,13 +233,36 @@ func (s Status) String() string {
 // StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
 func StatusValidator(s Status) error {
 	switch s {
-	case StatusPending, StatusProcessing, StatusCancelled, StatusFulfilled, StatusValidated, StatusSettled, StatusRefunded:
+	case StatusPending, StatusProcessing, StatusCancelled, StatusFulfilled, StatusValidated, StatusSettled, StatusRefunded, StatusSplit:
 		return nil
 	default:
 		return fmt.Errorf("lockpaymentorder: invalid enum value for status field: %q", s)
 	}
 }
 
+// SLABreachedStage defines the type for the "sla_breached_stage" enum field.
+type SLABreachedStage string
+
+// SLABreachedStage values.
+const (
+	SLABreachedStageFulfillment SLABreachedStage = "fulfillment"
+	SLABreachedStageSettlement  SLABreachedStage = "settlement"
+)
+
+func (sbs SLABreachedStage) String() string {
+	return string(sbs)
+}
+
+// SLABreachedStageValidator is a validator for the "sla_breached_stage" field enum values. It is called by the builders before save.
+func SLABreachedStageValidator(sbs SLABreachedStage) error {
+	switch sbs {
+	case SLABreachedStageFulfillment, SLABreachedStageSettlement:
+		return nil
+	default:
+		return fmt.Errorf("lockpaymentorder: invalid enum value for sla_breached_stage field: %q", sbs)
+	}
+}
+
 // OrderOption defines the ordering options for the LockPaymentOrder queries.
 type OrderOption func(*sql.Selector)
 
@@ -301,6 +361,26 @@ func ByAmountInUsd(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAmountInUsd, opts...).ToFunc()
 }
 
+// BySLABreachedStage orders the results by the sla_breached_stage field.
+func BySLABreachedStage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSLABreachedStage, opts...).ToFunc()
+}
+
+// BySLABreachedAt orders the results by the sla_breached_at field.
+func BySLABreachedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSLABreachedAt, opts...).ToFunc()
+}
+
+// ByReviewReason orders the results by the review_reason field.
+func ByReviewReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewReason, opts...).ToFunc()
+}
+
+// BySplitIndex orders the results by the split_index field.
+func BySplitIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSplitIndex, opts...).ToFunc()
+}
+
 // ByTokenField orders the results by token field.
 func ByTokenField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -349,6 +429,41 @@ func ByTransactions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newTransactionsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByReassignmentsCount orders the results by reassignments count.
+func ByReassignmentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReassignmentsStep(), opts...)
+	}
+}
+
+// ByReassignments orders the results by reassignments terms.
+func ByReassignments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReassignmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByParentField orders the results by parent field.
+func ByParentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newParentStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByChildrenCount orders the results by children count.
+func ByChildrenCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChildrenStep(), opts...)
+	}
+}
+
+// ByChildren orders the results by children terms.
+func ByChildren(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChildrenStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newTokenStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -384,3 +499,24 @@ func newTransactionsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, TransactionsTable, TransactionsColumn),
 	)
 }
+func newReassignmentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReassignmentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReassignmentsTable, ReassignmentsColumn),
+	)
+}
+func newParentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+	)
+}
+func newChildrenStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ChildrenTable, ChildrenColumn),
+	)
+}
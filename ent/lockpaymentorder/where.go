@@ -142,6 +142,21 @@ func AmountInUsd(v decimal.Decimal) predicate.LockPaymentOrder {
 	return predicate.LockPaymentOrder(sql.FieldEQ(FieldAmountInUsd, v))
 }
 
+// SLABreachedAt applies equality check predicate on the "sla_breached_at" field. It's identical to SLABreachedAtEQ.
+func SLABreachedAt(v time.Time) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldEQ(FieldSLABreachedAt, v))
+}
+
+// ReviewReason applies equality check predicate on the "review_reason" field. It's identical to ReviewReasonEQ.
+func ReviewReason(v string) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldEQ(FieldReviewReason, v))
+}
+
+// SplitIndex applies equality check predicate on the "split_index" field. It's identical to SplitIndexEQ.
+func SplitIndex(v int) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldEQ(FieldSplitIndex, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.LockPaymentOrder {
 	return predicate.LockPaymentOrder(sql.FieldEQ(FieldCreatedAt, v))
@@ -1092,6 +1107,201 @@ func AmountInUsdLTE(v decimal.Decimal) predicate.LockPaymentOrder {
 	return predicate.LockPaymentOrder(sql.FieldLTE(FieldAmountInUsd, v))
 }
 
+// SLABreachedStageEQ applies the EQ predicate on the "sla_breached_stage" field.
+func SLABreachedStageEQ(v SLABreachedStage) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldEQ(FieldSLABreachedStage, v))
+}
+
+// SLABreachedStageNEQ applies the NEQ predicate on the "sla_breached_stage" field.
+func SLABreachedStageNEQ(v SLABreachedStage) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldNEQ(FieldSLABreachedStage, v))
+}
+
+// SLABreachedStageIn applies the In predicate on the "sla_breached_stage" field.
+func SLABreachedStageIn(vs ...SLABreachedStage) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldIn(FieldSLABreachedStage, vs...))
+}
+
+// SLABreachedStageNotIn applies the NotIn predicate on the "sla_breached_stage" field.
+func SLABreachedStageNotIn(vs ...SLABreachedStage) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldNotIn(FieldSLABreachedStage, vs...))
+}
+
+// SLABreachedStageIsNil applies the IsNil predicate on the "sla_breached_stage" field.
+func SLABreachedStageIsNil() predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldIsNull(FieldSLABreachedStage))
+}
+
+// SLABreachedStageNotNil applies the NotNil predicate on the "sla_breached_stage" field.
+func SLABreachedStageNotNil() predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldNotNull(FieldSLABreachedStage))
+}
+
+// SLABreachedAtEQ applies the EQ predicate on the "sla_breached_at" field.
+func SLABreachedAtEQ(v time.Time) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldEQ(FieldSLABreachedAt, v))
+}
+
+// SLABreachedAtNEQ applies the NEQ predicate on the "sla_breached_at" field.
+func SLABreachedAtNEQ(v time.Time) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldNEQ(FieldSLABreachedAt, v))
+}
+
+// SLABreachedAtIn applies the In predicate on the "sla_breached_at" field.
+func SLABreachedAtIn(vs ...time.Time) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldIn(FieldSLABreachedAt, vs...))
+}
+
+// SLABreachedAtNotIn applies the NotIn predicate on the "sla_breached_at" field.
+func SLABreachedAtNotIn(vs ...time.Time) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldNotIn(FieldSLABreachedAt, vs...))
+}
+
+// SLABreachedAtGT applies the GT predicate on the "sla_breached_at" field.
+func SLABreachedAtGT(v time.Time) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldGT(FieldSLABreachedAt, v))
+}
+
+// SLABreachedAtGTE applies the GTE predicate on the "sla_breached_at" field.
+func SLABreachedAtGTE(v time.Time) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldGTE(FieldSLABreachedAt, v))
+}
+
+// SLABreachedAtLT applies the LT predicate on the "sla_breached_at" field.
+func SLABreachedAtLT(v time.Time) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldLT(FieldSLABreachedAt, v))
+}
+
+// SLABreachedAtLTE applies the LTE predicate on the "sla_breached_at" field.
+func SLABreachedAtLTE(v time.Time) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldLTE(FieldSLABreachedAt, v))
+}
+
+// SLABreachedAtIsNil applies the IsNil predicate on the "sla_breached_at" field.
+func SLABreachedAtIsNil() predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldIsNull(FieldSLABreachedAt))
+}
+
+// SLABreachedAtNotNil applies the NotNil predicate on the "sla_breached_at" field.
+func SLABreachedAtNotNil() predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldNotNull(FieldSLABreachedAt))
+}
+
+// ReviewReasonEQ applies the EQ predicate on the "review_reason" field.
+func ReviewReasonEQ(v string) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldEQ(FieldReviewReason, v))
+}
+
+// ReviewReasonNEQ applies the NEQ predicate on the "review_reason" field.
+func ReviewReasonNEQ(v string) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldNEQ(FieldReviewReason, v))
+}
+
+// ReviewReasonIn applies the In predicate on the "review_reason" field.
+func ReviewReasonIn(vs ...string) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldIn(FieldReviewReason, vs...))
+}
+
+// ReviewReasonNotIn applies the NotIn predicate on the "review_reason" field.
+func ReviewReasonNotIn(vs ...string) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldNotIn(FieldReviewReason, vs...))
+}
+
+// ReviewReasonGT applies the GT predicate on the "review_reason" field.
+func ReviewReasonGT(v string) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldGT(FieldReviewReason, v))
+}
+
+// ReviewReasonGTE applies the GTE predicate on the "review_reason" field.
+func ReviewReasonGTE(v string) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldGTE(FieldReviewReason, v))
+}
+
+// ReviewReasonLT applies the LT predicate on the "review_reason" field.
+func ReviewReasonLT(v string) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldLT(FieldReviewReason, v))
+}
+
+// ReviewReasonLTE applies the LTE predicate on the "review_reason" field.
+func ReviewReasonLTE(v string) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldLTE(FieldReviewReason, v))
+}
+
+// ReviewReasonContains applies the Contains predicate on the "review_reason" field.
+func ReviewReasonContains(v string) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldContains(FieldReviewReason, v))
+}
+
+// ReviewReasonHasPrefix applies the HasPrefix predicate on the "review_reason" field.
+func ReviewReasonHasPrefix(v string) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldHasPrefix(FieldReviewReason, v))
+}
+
+// ReviewReasonHasSuffix applies the HasSuffix predicate on the "review_reason" field.
+func ReviewReasonHasSuffix(v string) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldHasSuffix(FieldReviewReason, v))
+}
+
+// ReviewReasonIsNil applies the IsNil predicate on the "review_reason" field.
+func ReviewReasonIsNil() predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldIsNull(FieldReviewReason))
+}
+
+// ReviewReasonNotNil applies the NotNil predicate on the "review_reason" field.
+func ReviewReasonNotNil() predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldNotNull(FieldReviewReason))
+}
+
+// ReviewReasonEqualFold applies the EqualFold predicate on the "review_reason" field.
+func ReviewReasonEqualFold(v string) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldEqualFold(FieldReviewReason, v))
+}
+
+// ReviewReasonContainsFold applies the ContainsFold predicate on the "review_reason" field.
+func ReviewReasonContainsFold(v string) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldContainsFold(FieldReviewReason, v))
+}
+
+// SplitIndexEQ applies the EQ predicate on the "split_index" field.
+func SplitIndexEQ(v int) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldEQ(FieldSplitIndex, v))
+}
+
+// SplitIndexNEQ applies the NEQ predicate on the "split_index" field.
+func SplitIndexNEQ(v int) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldNEQ(FieldSplitIndex, v))
+}
+
+// SplitIndexIn applies the In predicate on the "split_index" field.
+func SplitIndexIn(vs ...int) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldIn(FieldSplitIndex, vs...))
+}
+
+// SplitIndexNotIn applies the NotIn predicate on the "split_index" field.
+func SplitIndexNotIn(vs ...int) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldNotIn(FieldSplitIndex, vs...))
+}
+
+// SplitIndexGT applies the GT predicate on the "split_index" field.
+func SplitIndexGT(v int) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldGT(FieldSplitIndex, v))
+}
+
+// SplitIndexGTE applies the GTE predicate on the "split_index" field.
+func SplitIndexGTE(v int) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldGTE(FieldSplitIndex, v))
+}
+
+// SplitIndexLT applies the LT predicate on the "split_index" field.
+func SplitIndexLT(v int) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldLT(FieldSplitIndex, v))
+}
+
+// SplitIndexLTE applies the LTE predicate on the "split_index" field.
+func SplitIndexLTE(v int) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(sql.FieldLTE(FieldSplitIndex, v))
+}
+
 // HasToken applies the HasEdge predicate on the "token" edge.
 func HasToken() predicate.LockPaymentOrder {
 	return predicate.LockPaymentOrder(func(s *sql.Selector) {
@@ -1207,6 +1417,75 @@ func HasTransactionsWith(preds ...predicate.TransactionLog) predicate.LockPaymen
 	})
 }
 
+// HasReassignments applies the HasEdge predicate on the "reassignments" edge.
+func HasReassignments() predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReassignmentsTable, ReassignmentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReassignmentsWith applies the HasEdge predicate on the "reassignments" edge with a given conditions (other predicates).
+func HasReassignmentsWith(preds ...predicate.LockOrderReassignment) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(func(s *sql.Selector) {
+		step := newReassignmentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasParent applies the HasEdge predicate on the "parent" edge.
+func HasParent() predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasParentWith applies the HasEdge predicate on the "parent" edge with a given conditions (other predicates).
+func HasParentWith(preds ...predicate.LockPaymentOrder) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(func(s *sql.Selector) {
+		step := newParentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChildren applies the HasEdge predicate on the "children" edge.
+func HasChildren() predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChildrenTable, ChildrenColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChildrenWith applies the HasEdge predicate on the "children" edge with a given conditions (other predicates).
+func HasChildrenWith(preds ...predicate.LockPaymentOrder) predicate.LockPaymentOrder {
+	return predicate.LockPaymentOrder(func(s *sql.Selector) {
+		step := newChildrenStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.LockPaymentOrder) predicate.LockPaymentOrder {
 	return predicate.LockPaymentOrder(sql.AndPredicates(predicates...))
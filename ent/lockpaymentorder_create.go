@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/NEDA-LABS/stablenode/ent/lockorderfulfillment"
+	"github.com/NEDA-LABS/stablenode/ent/lockorderreassignment"
 	"github.com/NEDA-LABS/stablenode/ent/lockpaymentorder"
 	"github.com/NEDA-LABS/stablenode/ent/providerprofile"
 	"github.com/NEDA-LABS/stablenode/ent/provisionbucket"
@@ -214,6 +215,62 @@ func (lpoc *LockPaymentOrderCreate) SetAmountInUsd(d decimal.Decimal) *LockPayme
 	return lpoc
 }
 
+// SetSLABreachedStage sets the "sla_breached_stage" field.
+func (lpoc *LockPaymentOrderCreate) SetSLABreachedStage(lbs lockpaymentorder.SLABreachedStage) *LockPaymentOrderCreate {
+	lpoc.mutation.SetSLABreachedStage(lbs)
+	return lpoc
+}
+
+// SetNillableSLABreachedStage sets the "sla_breached_stage" field if the given value is not nil.
+func (lpoc *LockPaymentOrderCreate) SetNillableSLABreachedStage(lbs *lockpaymentorder.SLABreachedStage) *LockPaymentOrderCreate {
+	if lbs != nil {
+		lpoc.SetSLABreachedStage(*lbs)
+	}
+	return lpoc
+}
+
+// SetSLABreachedAt sets the "sla_breached_at" field.
+func (lpoc *LockPaymentOrderCreate) SetSLABreachedAt(t time.Time) *LockPaymentOrderCreate {
+	lpoc.mutation.SetSLABreachedAt(t)
+	return lpoc
+}
+
+// SetNillableSLABreachedAt sets the "sla_breached_at" field if the given value is not nil.
+func (lpoc *LockPaymentOrderCreate) SetNillableSLABreachedAt(t *time.Time) *LockPaymentOrderCreate {
+	if t != nil {
+		lpoc.SetSLABreachedAt(*t)
+	}
+	return lpoc
+}
+
+// SetReviewReason sets the "review_reason" field.
+func (lpoc *LockPaymentOrderCreate) SetReviewReason(s string) *LockPaymentOrderCreate {
+	lpoc.mutation.SetReviewReason(s)
+	return lpoc
+}
+
+// SetNillableReviewReason sets the "review_reason" field if the given value is not nil.
+func (lpoc *LockPaymentOrderCreate) SetNillableReviewReason(s *string) *LockPaymentOrderCreate {
+	if s != nil {
+		lpoc.SetReviewReason(*s)
+	}
+	return lpoc
+}
+
+// SetSplitIndex sets the "split_index" field.
+func (lpoc *LockPaymentOrderCreate) SetSplitIndex(i int) *LockPaymentOrderCreate {
+	lpoc.mutation.SetSplitIndex(i)
+	return lpoc
+}
+
+// SetNillableSplitIndex sets the "split_index" field if the given value is not nil.
+func (lpoc *LockPaymentOrderCreate) SetNillableSplitIndex(i *int) *LockPaymentOrderCreate {
+	if i != nil {
+		lpoc.SetSplitIndex(*i)
+	}
+	return lpoc
+}
+
 // SetID sets the "id" field.
 func (lpoc *LockPaymentOrderCreate) SetID(u uuid.UUID) *LockPaymentOrderCreate {
 	lpoc.mutation.SetID(u)
@@ -307,6 +364,55 @@ func (lpoc *LockPaymentOrderCreate) AddTransactions(t ...*TransactionLog) *LockP
 	return lpoc.AddTransactionIDs(ids...)
 }
 
+// AddReassignmentIDs adds the "reassignments" edge to the LockOrderReassignment entity by IDs.
+func (lpoc *LockPaymentOrderCreate) AddReassignmentIDs(ids ...uuid.UUID) *LockPaymentOrderCreate {
+	lpoc.mutation.AddReassignmentIDs(ids...)
+	return lpoc
+}
+
+// AddReassignments adds the "reassignments" edges to the LockOrderReassignment entity.
+func (lpoc *LockPaymentOrderCreate) AddReassignments(l ...*LockOrderReassignment) *LockPaymentOrderCreate {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return lpoc.AddReassignmentIDs(ids...)
+}
+
+// SetParentID sets the "parent" edge to the LockPaymentOrder entity by ID.
+func (lpoc *LockPaymentOrderCreate) SetParentID(id uuid.UUID) *LockPaymentOrderCreate {
+	lpoc.mutation.SetParentID(id)
+	return lpoc
+}
+
+// SetNillableParentID sets the "parent" edge to the LockPaymentOrder entity by ID if the given value is not nil.
+func (lpoc *LockPaymentOrderCreate) SetNillableParentID(id *uuid.UUID) *LockPaymentOrderCreate {
+	if id != nil {
+		lpoc = lpoc.SetParentID(*id)
+	}
+	return lpoc
+}
+
+// SetParent sets the "parent" edge to the LockPaymentOrder entity.
+func (lpoc *LockPaymentOrderCreate) SetParent(l *LockPaymentOrder) *LockPaymentOrderCreate {
+	return lpoc.SetParentID(l.ID)
+}
+
+// AddChildIDs adds the "children" edge to the LockPaymentOrder entity by IDs.
+func (lpoc *LockPaymentOrderCreate) AddChildIDs(ids ...uuid.UUID) *LockPaymentOrderCreate {
+	lpoc.mutation.AddChildIDs(ids...)
+	return lpoc
+}
+
+// AddChildren adds the "children" edges to the LockPaymentOrder entity.
+func (lpoc *LockPaymentOrderCreate) AddChildren(l ...*LockPaymentOrder) *LockPaymentOrderCreate {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return lpoc.AddChildIDs(ids...)
+}
+
 // Mutation returns the LockPaymentOrderMutation object of the builder.
 func (lpoc *LockPaymentOrderCreate) Mutation() *LockPaymentOrderMutation {
 	return lpoc.mutation
@@ -362,6 +468,10 @@ func (lpoc *LockPaymentOrderCreate) defaults() {
 		v := lockpaymentorder.DefaultCancellationReasons
 		lpoc.mutation.SetCancellationReasons(v)
 	}
+	if _, ok := lpoc.mutation.SplitIndex(); !ok {
+		v := lockpaymentorder.DefaultSplitIndex
+		lpoc.mutation.SetSplitIndex(v)
+	}
 	if _, ok := lpoc.mutation.ID(); !ok {
 		v := lockpaymentorder.DefaultID()
 		lpoc.mutation.SetID(v)
@@ -430,6 +540,14 @@ func (lpoc *LockPaymentOrderCreate) check() error {
 	if _, ok := lpoc.mutation.AmountInUsd(); !ok {
 		return &ValidationError{Name: "amount_in_usd", err: errors.New(`ent: missing required field "LockPaymentOrder.amount_in_usd"`)}
 	}
+	if v, ok := lpoc.mutation.SLABreachedStage(); ok {
+		if err := lockpaymentorder.SLABreachedStageValidator(v); err != nil {
+			return &ValidationError{Name: "sla_breached_stage", err: fmt.Errorf(`ent: validator failed for field "LockPaymentOrder.sla_breached_stage": %w`, err)}
+		}
+	}
+	if _, ok := lpoc.mutation.SplitIndex(); !ok {
+		return &ValidationError{Name: "split_index", err: errors.New(`ent: missing required field "LockPaymentOrder.split_index"`)}
+	}
 	if len(lpoc.mutation.TokenIDs()) == 0 {
 		return &ValidationError{Name: "token", err: errors.New(`ent: missing required edge "LockPaymentOrder.token"`)}
 	}
@@ -549,6 +667,22 @@ func (lpoc *LockPaymentOrderCreate) createSpec() (*LockPaymentOrder, *sqlgraph.C
 		_spec.SetField(lockpaymentorder.FieldAmountInUsd, field.TypeFloat64, value)
 		_node.AmountInUsd = value
 	}
+	if value, ok := lpoc.mutation.SLABreachedStage(); ok {
+		_spec.SetField(lockpaymentorder.FieldSLABreachedStage, field.TypeEnum, value)
+		_node.SLABreachedStage = value
+	}
+	if value, ok := lpoc.mutation.SLABreachedAt(); ok {
+		_spec.SetField(lockpaymentorder.FieldSLABreachedAt, field.TypeTime, value)
+		_node.SLABreachedAt = value
+	}
+	if value, ok := lpoc.mutation.ReviewReason(); ok {
+		_spec.SetField(lockpaymentorder.FieldReviewReason, field.TypeString, value)
+		_node.ReviewReason = value
+	}
+	if value, ok := lpoc.mutation.SplitIndex(); ok {
+		_spec.SetField(lockpaymentorder.FieldSplitIndex, field.TypeInt, value)
+		_node.SplitIndex = value
+	}
 	if nodes := lpoc.mutation.TokenIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -632,6 +766,55 @@ func (lpoc *LockPaymentOrderCreate) createSpec() (*LockPaymentOrder, *sqlgraph.C
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := lpoc.mutation.ReassignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lockpaymentorder.ReassignmentsTable,
+			Columns: []string{lockpaymentorder.ReassignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lockorderreassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := lpoc.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lockpaymentorder.ParentTable,
+			Columns: []string{lockpaymentorder.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lockpaymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.lock_payment_order_children = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := lpoc.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lockpaymentorder.ChildrenTable,
+			Columns: []string{lockpaymentorder.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lockpaymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -984,6 +1167,78 @@ func (u *LockPaymentOrderUpsert) AddAmountInUsd(v decimal.Decimal) *LockPaymentO
 	return u
 }
 
+// SetSLABreachedStage sets the "sla_breached_stage" field.
+func (u *LockPaymentOrderUpsert) SetSLABreachedStage(v lockpaymentorder.SLABreachedStage) *LockPaymentOrderUpsert {
+	u.Set(lockpaymentorder.FieldSLABreachedStage, v)
+	return u
+}
+
+// UpdateSLABreachedStage sets the "sla_breached_stage" field to the value that was provided on create.
+func (u *LockPaymentOrderUpsert) UpdateSLABreachedStage() *LockPaymentOrderUpsert {
+	u.SetExcluded(lockpaymentorder.FieldSLABreachedStage)
+	return u
+}
+
+// ClearSLABreachedStage clears the value of the "sla_breached_stage" field.
+func (u *LockPaymentOrderUpsert) ClearSLABreachedStage() *LockPaymentOrderUpsert {
+	u.SetNull(lockpaymentorder.FieldSLABreachedStage)
+	return u
+}
+
+// SetSLABreachedAt sets the "sla_breached_at" field.
+func (u *LockPaymentOrderUpsert) SetSLABreachedAt(v time.Time) *LockPaymentOrderUpsert {
+	u.Set(lockpaymentorder.FieldSLABreachedAt, v)
+	return u
+}
+
+// UpdateSLABreachedAt sets the "sla_breached_at" field to the value that was provided on create.
+func (u *LockPaymentOrderUpsert) UpdateSLABreachedAt() *LockPaymentOrderUpsert {
+	u.SetExcluded(lockpaymentorder.FieldSLABreachedAt)
+	return u
+}
+
+// ClearSLABreachedAt clears the value of the "sla_breached_at" field.
+func (u *LockPaymentOrderUpsert) ClearSLABreachedAt() *LockPaymentOrderUpsert {
+	u.SetNull(lockpaymentorder.FieldSLABreachedAt)
+	return u
+}
+
+// SetReviewReason sets the "review_reason" field.
+func (u *LockPaymentOrderUpsert) SetReviewReason(v string) *LockPaymentOrderUpsert {
+	u.Set(lockpaymentorder.FieldReviewReason, v)
+	return u
+}
+
+// UpdateReviewReason sets the "review_reason" field to the value that was provided on create.
+func (u *LockPaymentOrderUpsert) UpdateReviewReason() *LockPaymentOrderUpsert {
+	u.SetExcluded(lockpaymentorder.FieldReviewReason)
+	return u
+}
+
+// ClearReviewReason clears the value of the "review_reason" field.
+func (u *LockPaymentOrderUpsert) ClearReviewReason() *LockPaymentOrderUpsert {
+	u.SetNull(lockpaymentorder.FieldReviewReason)
+	return u
+}
+
+// SetSplitIndex sets the "split_index" field.
+func (u *LockPaymentOrderUpsert) SetSplitIndex(v int) *LockPaymentOrderUpsert {
+	u.Set(lockpaymentorder.FieldSplitIndex, v)
+	return u
+}
+
+// UpdateSplitIndex sets the "split_index" field to the value that was provided on create.
+func (u *LockPaymentOrderUpsert) UpdateSplitIndex() *LockPaymentOrderUpsert {
+	u.SetExcluded(lockpaymentorder.FieldSplitIndex)
+	return u
+}
+
+// AddSplitIndex adds v to the "split_index" field.
+func (u *LockPaymentOrderUpsert) AddSplitIndex(v int) *LockPaymentOrderUpsert {
+	u.Add(lockpaymentorder.FieldSplitIndex, v)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -1385,6 +1640,90 @@ func (u *LockPaymentOrderUpsertOne) UpdateAmountInUsd() *LockPaymentOrderUpsertO
 	})
 }
 
+// SetSLABreachedStage sets the "sla_breached_stage" field.
+func (u *LockPaymentOrderUpsertOne) SetSLABreachedStage(v lockpaymentorder.SLABreachedStage) *LockPaymentOrderUpsertOne {
+	return u.Update(func(s *LockPaymentOrderUpsert) {
+		s.SetSLABreachedStage(v)
+	})
+}
+
+// UpdateSLABreachedStage sets the "sla_breached_stage" field to the value that was provided on create.
+func (u *LockPaymentOrderUpsertOne) UpdateSLABreachedStage() *LockPaymentOrderUpsertOne {
+	return u.Update(func(s *LockPaymentOrderUpsert) {
+		s.UpdateSLABreachedStage()
+	})
+}
+
+// ClearSLABreachedStage clears the value of the "sla_breached_stage" field.
+func (u *LockPaymentOrderUpsertOne) ClearSLABreachedStage() *LockPaymentOrderUpsertOne {
+	return u.Update(func(s *LockPaymentOrderUpsert) {
+		s.ClearSLABreachedStage()
+	})
+}
+
+// SetSLABreachedAt sets the "sla_breached_at" field.
+func (u *LockPaymentOrderUpsertOne) SetSLABreachedAt(v time.Time) *LockPaymentOrderUpsertOne {
+	return u.Update(func(s *LockPaymentOrderUpsert) {
+		s.SetSLABreachedAt(v)
+	})
+}
+
+// UpdateSLABreachedAt sets the "sla_breached_at" field to the value that was provided on create.
+func (u *LockPaymentOrderUpsertOne) UpdateSLABreachedAt() *LockPaymentOrderUpsertOne {
+	return u.Update(func(s *LockPaymentOrderUpsert) {
+		s.UpdateSLABreachedAt()
+	})
+}
+
+// ClearSLABreachedAt clears the value of the "sla_breached_at" field.
+func (u *LockPaymentOrderUpsertOne) ClearSLABreachedAt() *LockPaymentOrderUpsertOne {
+	return u.Update(func(s *LockPaymentOrderUpsert) {
+		s.ClearSLABreachedAt()
+	})
+}
+
+// SetReviewReason sets the "review_reason" field.
+func (u *LockPaymentOrderUpsertOne) SetReviewReason(v string) *LockPaymentOrderUpsertOne {
+	return u.Update(func(s *LockPaymentOrderUpsert) {
+		s.SetReviewReason(v)
+	})
+}
+
+// UpdateReviewReason sets the "review_reason" field to the value that was provided on create.
+func (u *LockPaymentOrderUpsertOne) UpdateReviewReason() *LockPaymentOrderUpsertOne {
+	return u.Update(func(s *LockPaymentOrderUpsert) {
+		s.UpdateReviewReason()
+	})
+}
+
+// ClearReviewReason clears the value of the "review_reason" field.
+func (u *LockPaymentOrderUpsertOne) ClearReviewReason() *LockPaymentOrderUpsertOne {
+	return u.Update(func(s *LockPaymentOrderUpsert) {
+		s.ClearReviewReason()
+	})
+}
+
+// SetSplitIndex sets the "split_index" field.
+func (u *LockPaymentOrderUpsertOne) SetSplitIndex(v int) *LockPaymentOrderUpsertOne {
+	return u.Update(func(s *LockPaymentOrderUpsert) {
+		s.SetSplitIndex(v)
+	})
+}
+
+// AddSplitIndex adds v to the "split_index" field.
+func (u *LockPaymentOrderUpsertOne) AddSplitIndex(v int) *LockPaymentOrderUpsertOne {
+	return u.Update(func(s *LockPaymentOrderUpsert) {
+		s.AddSplitIndex(v)
+	})
+}
+
+// UpdateSplitIndex sets the "split_index" field to the value that was provided on create.
+func (u *LockPaymentOrderUpsertOne) UpdateSplitIndex() *LockPaymentOrderUpsertOne {
+	return u.Update(func(s *LockPaymentOrderUpsert) {
+		s.UpdateSplitIndex()
+	})
+}
+
 // Exec executes the query.
 func (u *LockPaymentOrderUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1953,6 +2292,90 @@ func (u *LockPaymentOrderUpsertBulk) UpdateAmountInUsd() *LockPaymentOrderUpsert
 	})
 }
 
+// SetSLABreachedStage sets the "sla_breached_stage" field.
+func (u *LockPaymentOrderUpsertBulk) SetSLABreachedStage(v lockpaymentorder.SLABreachedStage) *LockPaymentOrderUpsertBulk {
+	return u.Update(func(s *LockPaymentOrderUpsert) {
+		s.SetSLABreachedStage(v)
+	})
+}
+
+// UpdateSLABreachedStage sets the "sla_breached_stage" field to the value that was provided on create.
+func (u *LockPaymentOrderUpsertBulk) UpdateSLABreachedStage() *LockPaymentOrderUpsertBulk {
+	return u.Update(func(s *LockPaymentOrderUpsert) {
+		s.UpdateSLABreachedStage()
+	})
+}
+
+// ClearSLABreachedStage clears the value of the "sla_breached_stage" field.
+func (u *LockPaymentOrderUpsertBulk) ClearSLABreachedStage() *LockPaymentOrderUpsertBulk {
+	return u.Update(func(s *LockPaymentOrderUpsert) {
+		s.ClearSLABreachedStage()
+	})
+}
+
+// SetSLABreachedAt sets the "sla_breached_at" field.
+func (u *LockPaymentOrderUpsertBulk) SetSLABreachedAt(v time.Time) *LockPaymentOrderUpsertBulk {
+	return u.Update(func(s *LockPaymentOrderUpsert) {
+		s.SetSLABreachedAt(v)
+	})
+}
+
+// UpdateSLABreachedAt sets the "sla_breached_at" field to the value that was provided on create.
+func (u *LockPaymentOrderUpsertBulk) UpdateSLABreachedAt() *LockPaymentOrderUpsertBulk {
+	return u.Update(func(s *LockPaymentOrderUpsert) {
+		s.UpdateSLABreachedAt()
+	})
+}
+
+// ClearSLABreachedAt clears the value of the "sla_breached_at" field.
+func (u *LockPaymentOrderUpsertBulk) ClearSLABreachedAt() *LockPaymentOrderUpsertBulk {
+	return u.Update(func(s *LockPaymentOrderUpsert) {
+		s.ClearSLABreachedAt()
+	})
+}
+
+// SetReviewReason sets the "review_reason" field.
+func (u *LockPaymentOrderUpsertBulk) SetReviewReason(v string) *LockPaymentOrderUpsertBulk {
+	return u.Update(func(s *LockPaymentOrderUpsert) {
+		s.SetReviewReason(v)
+	})
+}
+
+// UpdateReviewReason sets the "review_reason" field to the value that was provided on create.
+func (u *LockPaymentOrderUpsertBulk) UpdateReviewReason() *LockPaymentOrderUpsertBulk {
+	return u.Update(func(s *LockPaymentOrderUpsert) {
+		s.UpdateReviewReason()
+	})
+}
+
+// ClearReviewReason clears the value of the "review_reason" field.
+func (u *LockPaymentOrderUpsertBulk) ClearReviewReason() *LockPaymentOrderUpsertBulk {
+	return u.Update(func(s *LockPaymentOrderUpsert) {
+		s.ClearReviewReason()
+	})
+}
+
+// SetSplitIndex sets the "split_index" field.
+func (u *LockPaymentOrderUpsertBulk) SetSplitIndex(v int) *LockPaymentOrderUpsertBulk {
+	return u.Update(func(s *LockPaymentOrderUpsert) {
+		s.SetSplitIndex(v)
+	})
+}
+
+// AddSplitIndex adds v to the "split_index" field.
+func (u *LockPaymentOrderUpsertBulk) AddSplitIndex(v int) *LockPaymentOrderUpsertBulk {
+	return u.Update(func(s *LockPaymentOrderUpsert) {
+		s.AddSplitIndex(v)
+	})
+}
+
+// UpdateSplitIndex sets the "split_index" field to the value that was provided on create.
+func (u *LockPaymentOrderUpsertBulk) UpdateSplitIndex() *LockPaymentOrderUpsertBulk {
+	return u.Update(func(s *LockPaymentOrderUpsert) {
+		s.UpdateSplitIndex()
+	})
+}
+
 // Exec executes the query.
 func (u *LockPaymentOrderUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
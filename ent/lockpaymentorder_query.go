@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/NEDA-LABS/stablenode/ent/lockorderfulfillment"
+	"github.com/NEDA-LABS/stablenode/ent/lockorderreassignment"
 	"github.com/NEDA-LABS/stablenode/ent/lockpaymentorder"
 	"github.com/NEDA-LABS/stablenode/ent/predicate"
 	"github.com/NEDA-LABS/stablenode/ent/providerprofile"
@@ -34,6 +35,9 @@ type LockPaymentOrderQuery struct {
 	withProvider        *ProviderProfileQuery
 	withFulfillments    *LockOrderFulfillmentQuery
 	withTransactions    *TransactionLogQuery
+	withReassignments   *LockOrderReassignmentQuery
+	withParent          *LockPaymentOrderQuery
+	withChildren        *LockPaymentOrderQuery
 	withFKs             bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -181,6 +185,72 @@ func (lpoq *LockPaymentOrderQuery) QueryTransactions() *TransactionLogQuery {
 	return query
 }
 
+// QueryReassignments chains the current query on the "reassignments" edge.
+func (lpoq *LockPaymentOrderQuery) QueryReassignments() *LockOrderReassignmentQuery {
+	query := (&LockOrderReassignmentClient{config: lpoq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lpoq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lpoq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lockpaymentorder.Table, lockpaymentorder.FieldID, selector),
+			sqlgraph.To(lockorderreassignment.Table, lockorderreassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, lockpaymentorder.ReassignmentsTable, lockpaymentorder.ReassignmentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lpoq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryParent chains the current query on the "parent" edge.
+func (lpoq *LockPaymentOrderQuery) QueryParent() *LockPaymentOrderQuery {
+	query := (&LockPaymentOrderClient{config: lpoq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lpoq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lpoq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lockpaymentorder.Table, lockpaymentorder.FieldID, selector),
+			sqlgraph.To(lockpaymentorder.Table, lockpaymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, lockpaymentorder.ParentTable, lockpaymentorder.ParentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lpoq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryChildren chains the current query on the "children" edge.
+func (lpoq *LockPaymentOrderQuery) QueryChildren() *LockPaymentOrderQuery {
+	query := (&LockPaymentOrderClient{config: lpoq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lpoq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lpoq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lockpaymentorder.Table, lockpaymentorder.FieldID, selector),
+			sqlgraph.To(lockpaymentorder.Table, lockpaymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, lockpaymentorder.ChildrenTable, lockpaymentorder.ChildrenColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lpoq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first LockPaymentOrder entity from the query.
 // Returns a *NotFoundError when no LockPaymentOrder was found.
 func (lpoq *LockPaymentOrderQuery) First(ctx context.Context) (*LockPaymentOrder, error) {
@@ -378,6 +448,9 @@ func (lpoq *LockPaymentOrderQuery) Clone() *LockPaymentOrderQuery {
 		withProvider:        lpoq.withProvider.Clone(),
 		withFulfillments:    lpoq.withFulfillments.Clone(),
 		withTransactions:    lpoq.withTransactions.Clone(),
+		withReassignments:   lpoq.withReassignments.Clone(),
+		withParent:          lpoq.withParent.Clone(),
+		withChildren:        lpoq.withChildren.Clone(),
 		// clone intermediate query.
 		sql:  lpoq.sql.Clone(),
 		path: lpoq.path,
@@ -439,6 +512,39 @@ func (lpoq *LockPaymentOrderQuery) WithTransactions(opts ...func(*TransactionLog
 	return lpoq
 }
 
+// WithReassignments tells the query-builder to eager-load the nodes that are connected to
+// the "reassignments" edge. The optional arguments are used to configure the query builder of the edge.
+func (lpoq *LockPaymentOrderQuery) WithReassignments(opts ...func(*LockOrderReassignmentQuery)) *LockPaymentOrderQuery {
+	query := (&LockOrderReassignmentClient{config: lpoq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	lpoq.withReassignments = query
+	return lpoq
+}
+
+// WithParent tells the query-builder to eager-load the nodes that are connected to
+// the "parent" edge. The optional arguments are used to configure the query builder of the edge.
+func (lpoq *LockPaymentOrderQuery) WithParent(opts ...func(*LockPaymentOrderQuery)) *LockPaymentOrderQuery {
+	query := (&LockPaymentOrderClient{config: lpoq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	lpoq.withParent = query
+	return lpoq
+}
+
+// WithChildren tells the query-builder to eager-load the nodes that are connected to
+// the "children" edge. The optional arguments are used to configure the query builder of the edge.
+func (lpoq *LockPaymentOrderQuery) WithChildren(opts ...func(*LockPaymentOrderQuery)) *LockPaymentOrderQuery {
+	query := (&LockPaymentOrderClient{config: lpoq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	lpoq.withChildren = query
+	return lpoq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -518,15 +624,18 @@ func (lpoq *LockPaymentOrderQuery) sqlAll(ctx context.Context, hooks ...queryHoo
 		nodes       = []*LockPaymentOrder{}
 		withFKs     = lpoq.withFKs
 		_spec       = lpoq.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [8]bool{
 			lpoq.withToken != nil,
 			lpoq.withProvisionBucket != nil,
 			lpoq.withProvider != nil,
 			lpoq.withFulfillments != nil,
 			lpoq.withTransactions != nil,
+			lpoq.withReassignments != nil,
+			lpoq.withParent != nil,
+			lpoq.withChildren != nil,
 		}
 	)
-	if lpoq.withToken != nil || lpoq.withProvisionBucket != nil || lpoq.withProvider != nil {
+	if lpoq.withToken != nil || lpoq.withProvisionBucket != nil || lpoq.withProvider != nil || lpoq.withParent != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -584,6 +693,28 @@ func (lpoq *LockPaymentOrderQuery) sqlAll(ctx context.Context, hooks ...queryHoo
 			return nil, err
 		}
 	}
+	if query := lpoq.withReassignments; query != nil {
+		if err := lpoq.loadReassignments(ctx, query, nodes,
+			func(n *LockPaymentOrder) { n.Edges.Reassignments = []*LockOrderReassignment{} },
+			func(n *LockPaymentOrder, e *LockOrderReassignment) {
+				n.Edges.Reassignments = append(n.Edges.Reassignments, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := lpoq.withParent; query != nil {
+		if err := lpoq.loadParent(ctx, query, nodes, nil,
+			func(n *LockPaymentOrder, e *LockPaymentOrder) { n.Edges.Parent = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := lpoq.withChildren; query != nil {
+		if err := lpoq.loadChildren(ctx, query, nodes,
+			func(n *LockPaymentOrder) { n.Edges.Children = []*LockPaymentOrder{} },
+			func(n *LockPaymentOrder, e *LockPaymentOrder) { n.Edges.Children = append(n.Edges.Children, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -745,6 +876,100 @@ func (lpoq *LockPaymentOrderQuery) loadTransactions(ctx context.Context, query *
 	}
 	return nil
 }
+func (lpoq *LockPaymentOrderQuery) loadReassignments(ctx context.Context, query *LockOrderReassignmentQuery, nodes []*LockPaymentOrder, init func(*LockPaymentOrder), assign func(*LockPaymentOrder, *LockOrderReassignment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*LockPaymentOrder)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.LockOrderReassignment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(lockpaymentorder.ReassignmentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.lock_payment_order_reassignments
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "lock_payment_order_reassignments" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "lock_payment_order_reassignments" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (lpoq *LockPaymentOrderQuery) loadParent(ctx context.Context, query *LockPaymentOrderQuery, nodes []*LockPaymentOrder, init func(*LockPaymentOrder), assign func(*LockPaymentOrder, *LockPaymentOrder)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*LockPaymentOrder)
+	for i := range nodes {
+		if nodes[i].lock_payment_order_children == nil {
+			continue
+		}
+		fk := *nodes[i].lock_payment_order_children
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(lockpaymentorder.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "lock_payment_order_children" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (lpoq *LockPaymentOrderQuery) loadChildren(ctx context.Context, query *LockPaymentOrderQuery, nodes []*LockPaymentOrder, init func(*LockPaymentOrder), assign func(*LockPaymentOrder, *LockPaymentOrder)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*LockPaymentOrder)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.LockPaymentOrder(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(lockpaymentorder.ChildrenColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.lock_payment_order_children
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "lock_payment_order_children" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "lock_payment_order_children" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (lpoq *LockPaymentOrderQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := lpoq.querySpec()
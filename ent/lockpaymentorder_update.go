@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/NEDA-LABS/stablenode/ent/lockorderfulfillment"
+	"github.com/NEDA-LABS/stablenode/ent/lockorderreassignment"
 	"github.com/NEDA-LABS/stablenode/ent/lockpaymentorder"
 	"github.com/NEDA-LABS/stablenode/ent/predicate"
 	"github.com/NEDA-LABS/stablenode/ent/providerprofile"
@@ -363,6 +364,87 @@ func (lpou *LockPaymentOrderUpdate) AddAmountInUsd(d decimal.Decimal) *LockPayme
 	return lpou
 }
 
+// SetSLABreachedStage sets the "sla_breached_stage" field.
+func (lpou *LockPaymentOrderUpdate) SetSLABreachedStage(lbs lockpaymentorder.SLABreachedStage) *LockPaymentOrderUpdate {
+	lpou.mutation.SetSLABreachedStage(lbs)
+	return lpou
+}
+
+// SetNillableSLABreachedStage sets the "sla_breached_stage" field if the given value is not nil.
+func (lpou *LockPaymentOrderUpdate) SetNillableSLABreachedStage(lbs *lockpaymentorder.SLABreachedStage) *LockPaymentOrderUpdate {
+	if lbs != nil {
+		lpou.SetSLABreachedStage(*lbs)
+	}
+	return lpou
+}
+
+// ClearSLABreachedStage clears the value of the "sla_breached_stage" field.
+func (lpou *LockPaymentOrderUpdate) ClearSLABreachedStage() *LockPaymentOrderUpdate {
+	lpou.mutation.ClearSLABreachedStage()
+	return lpou
+}
+
+// SetSLABreachedAt sets the "sla_breached_at" field.
+func (lpou *LockPaymentOrderUpdate) SetSLABreachedAt(t time.Time) *LockPaymentOrderUpdate {
+	lpou.mutation.SetSLABreachedAt(t)
+	return lpou
+}
+
+// SetNillableSLABreachedAt sets the "sla_breached_at" field if the given value is not nil.
+func (lpou *LockPaymentOrderUpdate) SetNillableSLABreachedAt(t *time.Time) *LockPaymentOrderUpdate {
+	if t != nil {
+		lpou.SetSLABreachedAt(*t)
+	}
+	return lpou
+}
+
+// ClearSLABreachedAt clears the value of the "sla_breached_at" field.
+func (lpou *LockPaymentOrderUpdate) ClearSLABreachedAt() *LockPaymentOrderUpdate {
+	lpou.mutation.ClearSLABreachedAt()
+	return lpou
+}
+
+// SetReviewReason sets the "review_reason" field.
+func (lpou *LockPaymentOrderUpdate) SetReviewReason(s string) *LockPaymentOrderUpdate {
+	lpou.mutation.SetReviewReason(s)
+	return lpou
+}
+
+// SetNillableReviewReason sets the "review_reason" field if the given value is not nil.
+func (lpou *LockPaymentOrderUpdate) SetNillableReviewReason(s *string) *LockPaymentOrderUpdate {
+	if s != nil {
+		lpou.SetReviewReason(*s)
+	}
+	return lpou
+}
+
+// ClearReviewReason clears the value of the "review_reason" field.
+func (lpou *LockPaymentOrderUpdate) ClearReviewReason() *LockPaymentOrderUpdate {
+	lpou.mutation.ClearReviewReason()
+	return lpou
+}
+
+// SetSplitIndex sets the "split_index" field.
+func (lpou *LockPaymentOrderUpdate) SetSplitIndex(i int) *LockPaymentOrderUpdate {
+	lpou.mutation.ResetSplitIndex()
+	lpou.mutation.SetSplitIndex(i)
+	return lpou
+}
+
+// SetNillableSplitIndex sets the "split_index" field if the given value is not nil.
+func (lpou *LockPaymentOrderUpdate) SetNillableSplitIndex(i *int) *LockPaymentOrderUpdate {
+	if i != nil {
+		lpou.SetSplitIndex(*i)
+	}
+	return lpou
+}
+
+// AddSplitIndex adds i to the "split_index" field.
+func (lpou *LockPaymentOrderUpdate) AddSplitIndex(i int) *LockPaymentOrderUpdate {
+	lpou.mutation.AddSplitIndex(i)
+	return lpou
+}
+
 // SetTokenID sets the "token" edge to the Token entity by ID.
 func (lpou *LockPaymentOrderUpdate) SetTokenID(id int) *LockPaymentOrderUpdate {
 	lpou.mutation.SetTokenID(id)
@@ -442,6 +524,55 @@ func (lpou *LockPaymentOrderUpdate) AddTransactions(t ...*TransactionLog) *LockP
 	return lpou.AddTransactionIDs(ids...)
 }
 
+// AddReassignmentIDs adds the "reassignments" edge to the LockOrderReassignment entity by IDs.
+func (lpou *LockPaymentOrderUpdate) AddReassignmentIDs(ids ...uuid.UUID) *LockPaymentOrderUpdate {
+	lpou.mutation.AddReassignmentIDs(ids...)
+	return lpou
+}
+
+// AddReassignments adds the "reassignments" edges to the LockOrderReassignment entity.
+func (lpou *LockPaymentOrderUpdate) AddReassignments(l ...*LockOrderReassignment) *LockPaymentOrderUpdate {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return lpou.AddReassignmentIDs(ids...)
+}
+
+// SetParentID sets the "parent" edge to the LockPaymentOrder entity by ID.
+func (lpou *LockPaymentOrderUpdate) SetParentID(id uuid.UUID) *LockPaymentOrderUpdate {
+	lpou.mutation.SetParentID(id)
+	return lpou
+}
+
+// SetNillableParentID sets the "parent" edge to the LockPaymentOrder entity by ID if the given value is not nil.
+func (lpou *LockPaymentOrderUpdate) SetNillableParentID(id *uuid.UUID) *LockPaymentOrderUpdate {
+	if id != nil {
+		lpou = lpou.SetParentID(*id)
+	}
+	return lpou
+}
+
+// SetParent sets the "parent" edge to the LockPaymentOrder entity.
+func (lpou *LockPaymentOrderUpdate) SetParent(l *LockPaymentOrder) *LockPaymentOrderUpdate {
+	return lpou.SetParentID(l.ID)
+}
+
+// AddChildIDs adds the "children" edge to the LockPaymentOrder entity by IDs.
+func (lpou *LockPaymentOrderUpdate) AddChildIDs(ids ...uuid.UUID) *LockPaymentOrderUpdate {
+	lpou.mutation.AddChildIDs(ids...)
+	return lpou
+}
+
+// AddChildren adds the "children" edges to the LockPaymentOrder entity.
+func (lpou *LockPaymentOrderUpdate) AddChildren(l ...*LockPaymentOrder) *LockPaymentOrderUpdate {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return lpou.AddChildIDs(ids...)
+}
+
 // Mutation returns the LockPaymentOrderMutation object of the builder.
 func (lpou *LockPaymentOrderUpdate) Mutation() *LockPaymentOrderMutation {
 	return lpou.mutation
@@ -507,6 +638,54 @@ func (lpou *LockPaymentOrderUpdate) RemoveTransactions(t ...*TransactionLog) *Lo
 	return lpou.RemoveTransactionIDs(ids...)
 }
 
+// ClearReassignments clears all "reassignments" edges to the LockOrderReassignment entity.
+func (lpou *LockPaymentOrderUpdate) ClearReassignments() *LockPaymentOrderUpdate {
+	lpou.mutation.ClearReassignments()
+	return lpou
+}
+
+// RemoveReassignmentIDs removes the "reassignments" edge to LockOrderReassignment entities by IDs.
+func (lpou *LockPaymentOrderUpdate) RemoveReassignmentIDs(ids ...uuid.UUID) *LockPaymentOrderUpdate {
+	lpou.mutation.RemoveReassignmentIDs(ids...)
+	return lpou
+}
+
+// RemoveReassignments removes "reassignments" edges to LockOrderReassignment entities.
+func (lpou *LockPaymentOrderUpdate) RemoveReassignments(l ...*LockOrderReassignment) *LockPaymentOrderUpdate {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return lpou.RemoveReassignmentIDs(ids...)
+}
+
+// ClearParent clears the "parent" edge to the LockPaymentOrder entity.
+func (lpou *LockPaymentOrderUpdate) ClearParent() *LockPaymentOrderUpdate {
+	lpou.mutation.ClearParent()
+	return lpou
+}
+
+// ClearChildren clears all "children" edges to the LockPaymentOrder entity.
+func (lpou *LockPaymentOrderUpdate) ClearChildren() *LockPaymentOrderUpdate {
+	lpou.mutation.ClearChildren()
+	return lpou
+}
+
+// RemoveChildIDs removes the "children" edge to LockPaymentOrder entities by IDs.
+func (lpou *LockPaymentOrderUpdate) RemoveChildIDs(ids ...uuid.UUID) *LockPaymentOrderUpdate {
+	lpou.mutation.RemoveChildIDs(ids...)
+	return lpou
+}
+
+// RemoveChildren removes "children" edges to LockPaymentOrder entities.
+func (lpou *LockPaymentOrderUpdate) RemoveChildren(l ...*LockPaymentOrder) *LockPaymentOrderUpdate {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return lpou.RemoveChildIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (lpou *LockPaymentOrderUpdate) Save(ctx context.Context) (int, error) {
 	lpou.defaults()
@@ -560,6 +739,11 @@ func (lpou *LockPaymentOrderUpdate) check() error {
 			return &ValidationError{Name: "message_hash", err: fmt.Errorf(`ent: validator failed for field "LockPaymentOrder.message_hash": %w`, err)}
 		}
 	}
+	if v, ok := lpou.mutation.SLABreachedStage(); ok {
+		if err := lockpaymentorder.SLABreachedStageValidator(v); err != nil {
+			return &ValidationError{Name: "sla_breached_stage", err: fmt.Errorf(`ent: validator failed for field "LockPaymentOrder.sla_breached_stage": %w`, err)}
+		}
+	}
 	if lpou.mutation.TokenCleared() && len(lpou.mutation.TokenIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "LockPaymentOrder.token"`)
 	}
@@ -676,6 +860,30 @@ func (lpou *LockPaymentOrderUpdate) sqlSave(ctx context.Context) (n int, err err
 	if value, ok := lpou.mutation.AddedAmountInUsd(); ok {
 		_spec.AddField(lockpaymentorder.FieldAmountInUsd, field.TypeFloat64, value)
 	}
+	if value, ok := lpou.mutation.SLABreachedStage(); ok {
+		_spec.SetField(lockpaymentorder.FieldSLABreachedStage, field.TypeEnum, value)
+	}
+	if lpou.mutation.SLABreachedStageCleared() {
+		_spec.ClearField(lockpaymentorder.FieldSLABreachedStage, field.TypeEnum)
+	}
+	if value, ok := lpou.mutation.SLABreachedAt(); ok {
+		_spec.SetField(lockpaymentorder.FieldSLABreachedAt, field.TypeTime, value)
+	}
+	if lpou.mutation.SLABreachedAtCleared() {
+		_spec.ClearField(lockpaymentorder.FieldSLABreachedAt, field.TypeTime)
+	}
+	if value, ok := lpou.mutation.ReviewReason(); ok {
+		_spec.SetField(lockpaymentorder.FieldReviewReason, field.TypeString, value)
+	}
+	if lpou.mutation.ReviewReasonCleared() {
+		_spec.ClearField(lockpaymentorder.FieldReviewReason, field.TypeString)
+	}
+	if value, ok := lpou.mutation.SplitIndex(); ok {
+		_spec.SetField(lockpaymentorder.FieldSplitIndex, field.TypeInt, value)
+	}
+	if value, ok := lpou.mutation.AddedSplitIndex(); ok {
+		_spec.AddField(lockpaymentorder.FieldSplitIndex, field.TypeInt, value)
+	}
 	if lpou.mutation.TokenCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -853,6 +1061,125 @@ func (lpou *LockPaymentOrderUpdate) sqlSave(ctx context.Context) (n int, err err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if lpou.mutation.ReassignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lockpaymentorder.ReassignmentsTable,
+			Columns: []string{lockpaymentorder.ReassignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lockorderreassignment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lpou.mutation.RemovedReassignmentsIDs(); len(nodes) > 0 && !lpou.mutation.ReassignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lockpaymentorder.ReassignmentsTable,
+			Columns: []string{lockpaymentorder.ReassignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lockorderreassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lpou.mutation.ReassignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lockpaymentorder.ReassignmentsTable,
+			Columns: []string{lockpaymentorder.ReassignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lockorderreassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if lpou.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lockpaymentorder.ParentTable,
+			Columns: []string{lockpaymentorder.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lockpaymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lpou.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lockpaymentorder.ParentTable,
+			Columns: []string{lockpaymentorder.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lockpaymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if lpou.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lockpaymentorder.ChildrenTable,
+			Columns: []string{lockpaymentorder.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lockpaymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lpou.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !lpou.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lockpaymentorder.ChildrenTable,
+			Columns: []string{lockpaymentorder.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lockpaymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lpou.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lockpaymentorder.ChildrenTable,
+			Columns: []string{lockpaymentorder.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lockpaymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, lpou.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{lockpaymentorder.Label}
@@ -1200,6 +1527,87 @@ func (lpouo *LockPaymentOrderUpdateOne) AddAmountInUsd(d decimal.Decimal) *LockP
 	return lpouo
 }
 
+// SetSLABreachedStage sets the "sla_breached_stage" field.
+func (lpouo *LockPaymentOrderUpdateOne) SetSLABreachedStage(lbs lockpaymentorder.SLABreachedStage) *LockPaymentOrderUpdateOne {
+	lpouo.mutation.SetSLABreachedStage(lbs)
+	return lpouo
+}
+
+// SetNillableSLABreachedStage sets the "sla_breached_stage" field if the given value is not nil.
+func (lpouo *LockPaymentOrderUpdateOne) SetNillableSLABreachedStage(lbs *lockpaymentorder.SLABreachedStage) *LockPaymentOrderUpdateOne {
+	if lbs != nil {
+		lpouo.SetSLABreachedStage(*lbs)
+	}
+	return lpouo
+}
+
+// ClearSLABreachedStage clears the value of the "sla_breached_stage" field.
+func (lpouo *LockPaymentOrderUpdateOne) ClearSLABreachedStage() *LockPaymentOrderUpdateOne {
+	lpouo.mutation.ClearSLABreachedStage()
+	return lpouo
+}
+
+// SetSLABreachedAt sets the "sla_breached_at" field.
+func (lpouo *LockPaymentOrderUpdateOne) SetSLABreachedAt(t time.Time) *LockPaymentOrderUpdateOne {
+	lpouo.mutation.SetSLABreachedAt(t)
+	return lpouo
+}
+
+// SetNillableSLABreachedAt sets the "sla_breached_at" field if the given value is not nil.
+func (lpouo *LockPaymentOrderUpdateOne) SetNillableSLABreachedAt(t *time.Time) *LockPaymentOrderUpdateOne {
+	if t != nil {
+		lpouo.SetSLABreachedAt(*t)
+	}
+	return lpouo
+}
+
+// ClearSLABreachedAt clears the value of the "sla_breached_at" field.
+func (lpouo *LockPaymentOrderUpdateOne) ClearSLABreachedAt() *LockPaymentOrderUpdateOne {
+	lpouo.mutation.ClearSLABreachedAt()
+	return lpouo
+}
+
+// SetReviewReason sets the "review_reason" field.
+func (lpouo *LockPaymentOrderUpdateOne) SetReviewReason(s string) *LockPaymentOrderUpdateOne {
+	lpouo.mutation.SetReviewReason(s)
+	return lpouo
+}
+
+// SetNillableReviewReason sets the "review_reason" field if the given value is not nil.
+func (lpouo *LockPaymentOrderUpdateOne) SetNillableReviewReason(s *string) *LockPaymentOrderUpdateOne {
+	if s != nil {
+		lpouo.SetReviewReason(*s)
+	}
+	return lpouo
+}
+
+// ClearReviewReason clears the value of the "review_reason" field.
+func (lpouo *LockPaymentOrderUpdateOne) ClearReviewReason() *LockPaymentOrderUpdateOne {
+	lpouo.mutation.ClearReviewReason()
+	return lpouo
+}
+
+// SetSplitIndex sets the "split_index" field.
+func (lpouo *LockPaymentOrderUpdateOne) SetSplitIndex(i int) *LockPaymentOrderUpdateOne {
+	lpouo.mutation.ResetSplitIndex()
+	lpouo.mutation.SetSplitIndex(i)
+	return lpouo
+}
+
+// SetNillableSplitIndex sets the "split_index" field if the given value is not nil.
+func (lpouo *LockPaymentOrderUpdateOne) SetNillableSplitIndex(i *int) *LockPaymentOrderUpdateOne {
+	if i != nil {
+		lpouo.SetSplitIndex(*i)
+	}
+	return lpouo
+}
+
+// AddSplitIndex adds i to the "split_index" field.
+func (lpouo *LockPaymentOrderUpdateOne) AddSplitIndex(i int) *LockPaymentOrderUpdateOne {
+	lpouo.mutation.AddSplitIndex(i)
+	return lpouo
+}
+
 // SetTokenID sets the "token" edge to the Token entity by ID.
 func (lpouo *LockPaymentOrderUpdateOne) SetTokenID(id int) *LockPaymentOrderUpdateOne {
 	lpouo.mutation.SetTokenID(id)
@@ -1279,6 +1687,55 @@ func (lpouo *LockPaymentOrderUpdateOne) AddTransactions(t ...*TransactionLog) *L
 	return lpouo.AddTransactionIDs(ids...)
 }
 
+// AddReassignmentIDs adds the "reassignments" edge to the LockOrderReassignment entity by IDs.
+func (lpouo *LockPaymentOrderUpdateOne) AddReassignmentIDs(ids ...uuid.UUID) *LockPaymentOrderUpdateOne {
+	lpouo.mutation.AddReassignmentIDs(ids...)
+	return lpouo
+}
+
+// AddReassignments adds the "reassignments" edges to the LockOrderReassignment entity.
+func (lpouo *LockPaymentOrderUpdateOne) AddReassignments(l ...*LockOrderReassignment) *LockPaymentOrderUpdateOne {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return lpouo.AddReassignmentIDs(ids...)
+}
+
+// SetParentID sets the "parent" edge to the LockPaymentOrder entity by ID.
+func (lpouo *LockPaymentOrderUpdateOne) SetParentID(id uuid.UUID) *LockPaymentOrderUpdateOne {
+	lpouo.mutation.SetParentID(id)
+	return lpouo
+}
+
+// SetNillableParentID sets the "parent" edge to the LockPaymentOrder entity by ID if the given value is not nil.
+func (lpouo *LockPaymentOrderUpdateOne) SetNillableParentID(id *uuid.UUID) *LockPaymentOrderUpdateOne {
+	if id != nil {
+		lpouo = lpouo.SetParentID(*id)
+	}
+	return lpouo
+}
+
+// SetParent sets the "parent" edge to the LockPaymentOrder entity.
+func (lpouo *LockPaymentOrderUpdateOne) SetParent(l *LockPaymentOrder) *LockPaymentOrderUpdateOne {
+	return lpouo.SetParentID(l.ID)
+}
+
+// AddChildIDs adds the "children" edge to the LockPaymentOrder entity by IDs.
+func (lpouo *LockPaymentOrderUpdateOne) AddChildIDs(ids ...uuid.UUID) *LockPaymentOrderUpdateOne {
+	lpouo.mutation.AddChildIDs(ids...)
+	return lpouo
+}
+
+// AddChildren adds the "children" edges to the LockPaymentOrder entity.
+func (lpouo *LockPaymentOrderUpdateOne) AddChildren(l ...*LockPaymentOrder) *LockPaymentOrderUpdateOne {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return lpouo.AddChildIDs(ids...)
+}
+
 // Mutation returns the LockPaymentOrderMutation object of the builder.
 func (lpouo *LockPaymentOrderUpdateOne) Mutation() *LockPaymentOrderMutation {
 	return lpouo.mutation
@@ -1344,6 +1801,54 @@ func (lpouo *LockPaymentOrderUpdateOne) RemoveTransactions(t ...*TransactionLog)
 	return lpouo.RemoveTransactionIDs(ids...)
 }
 
+// ClearReassignments clears all "reassignments" edges to the LockOrderReassignment entity.
+func (lpouo *LockPaymentOrderUpdateOne) ClearReassignments() *LockPaymentOrderUpdateOne {
+	lpouo.mutation.ClearReassignments()
+	return lpouo
+}
+
+// RemoveReassignmentIDs removes the "reassignments" edge to LockOrderReassignment entities by IDs.
+func (lpouo *LockPaymentOrderUpdateOne) RemoveReassignmentIDs(ids ...uuid.UUID) *LockPaymentOrderUpdateOne {
+	lpouo.mutation.RemoveReassignmentIDs(ids...)
+	return lpouo
+}
+
+// RemoveReassignments removes "reassignments" edges to LockOrderReassignment entities.
+func (lpouo *LockPaymentOrderUpdateOne) RemoveReassignments(l ...*LockOrderReassignment) *LockPaymentOrderUpdateOne {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return lpouo.RemoveReassignmentIDs(ids...)
+}
+
+// ClearParent clears the "parent" edge to the LockPaymentOrder entity.
+func (lpouo *LockPaymentOrderUpdateOne) ClearParent() *LockPaymentOrderUpdateOne {
+	lpouo.mutation.ClearParent()
+	return lpouo
+}
+
+// ClearChildren clears all "children" edges to the LockPaymentOrder entity.
+func (lpouo *LockPaymentOrderUpdateOne) ClearChildren() *LockPaymentOrderUpdateOne {
+	lpouo.mutation.ClearChildren()
+	return lpouo
+}
+
+// RemoveChildIDs removes the "children" edge to LockPaymentOrder entities by IDs.
+func (lpouo *LockPaymentOrderUpdateOne) RemoveChildIDs(ids ...uuid.UUID) *LockPaymentOrderUpdateOne {
+	lpouo.mutation.RemoveChildIDs(ids...)
+	return lpouo
+}
+
+// RemoveChildren removes "children" edges to LockPaymentOrder entities.
+func (lpouo *LockPaymentOrderUpdateOne) RemoveChildren(l ...*LockPaymentOrder) *LockPaymentOrderUpdateOne {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return lpouo.RemoveChildIDs(ids...)
+}
+
 // Where appends a list predicates to the LockPaymentOrderUpdate builder.
 func (lpouo *LockPaymentOrderUpdateOne) Where(ps ...predicate.LockPaymentOrder) *LockPaymentOrderUpdateOne {
 	lpouo.mutation.Where(ps...)
@@ -1410,6 +1915,11 @@ func (lpouo *LockPaymentOrderUpdateOne) check() error {
 			return &ValidationError{Name: "message_hash", err: fmt.Errorf(`ent: validator failed for field "LockPaymentOrder.message_hash": %w`, err)}
 		}
 	}
+	if v, ok := lpouo.mutation.SLABreachedStage(); ok {
+		if err := lockpaymentorder.SLABreachedStageValidator(v); err != nil {
+			return &ValidationError{Name: "sla_breached_stage", err: fmt.Errorf(`ent: validator failed for field "LockPaymentOrder.sla_breached_stage": %w`, err)}
+		}
+	}
 	if lpouo.mutation.TokenCleared() && len(lpouo.mutation.TokenIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "LockPaymentOrder.token"`)
 	}
@@ -1543,6 +2053,30 @@ func (lpouo *LockPaymentOrderUpdateOne) sqlSave(ctx context.Context) (_node *Loc
 	if value, ok := lpouo.mutation.AddedAmountInUsd(); ok {
 		_spec.AddField(lockpaymentorder.FieldAmountInUsd, field.TypeFloat64, value)
 	}
+	if value, ok := lpouo.mutation.SLABreachedStage(); ok {
+		_spec.SetField(lockpaymentorder.FieldSLABreachedStage, field.TypeEnum, value)
+	}
+	if lpouo.mutation.SLABreachedStageCleared() {
+		_spec.ClearField(lockpaymentorder.FieldSLABreachedStage, field.TypeEnum)
+	}
+	if value, ok := lpouo.mutation.SLABreachedAt(); ok {
+		_spec.SetField(lockpaymentorder.FieldSLABreachedAt, field.TypeTime, value)
+	}
+	if lpouo.mutation.SLABreachedAtCleared() {
+		_spec.ClearField(lockpaymentorder.FieldSLABreachedAt, field.TypeTime)
+	}
+	if value, ok := lpouo.mutation.ReviewReason(); ok {
+		_spec.SetField(lockpaymentorder.FieldReviewReason, field.TypeString, value)
+	}
+	if lpouo.mutation.ReviewReasonCleared() {
+		_spec.ClearField(lockpaymentorder.FieldReviewReason, field.TypeString)
+	}
+	if value, ok := lpouo.mutation.SplitIndex(); ok {
+		_spec.SetField(lockpaymentorder.FieldSplitIndex, field.TypeInt, value)
+	}
+	if value, ok := lpouo.mutation.AddedSplitIndex(); ok {
+		_spec.AddField(lockpaymentorder.FieldSplitIndex, field.TypeInt, value)
+	}
 	if lpouo.mutation.TokenCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1720,6 +2254,125 @@ func (lpouo *LockPaymentOrderUpdateOne) sqlSave(ctx context.Context) (_node *Loc
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if lpouo.mutation.ReassignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lockpaymentorder.ReassignmentsTable,
+			Columns: []string{lockpaymentorder.ReassignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lockorderreassignment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lpouo.mutation.RemovedReassignmentsIDs(); len(nodes) > 0 && !lpouo.mutation.ReassignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lockpaymentorder.ReassignmentsTable,
+			Columns: []string{lockpaymentorder.ReassignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lockorderreassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lpouo.mutation.ReassignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lockpaymentorder.ReassignmentsTable,
+			Columns: []string{lockpaymentorder.ReassignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lockorderreassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if lpouo.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lockpaymentorder.ParentTable,
+			Columns: []string{lockpaymentorder.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lockpaymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lpouo.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lockpaymentorder.ParentTable,
+			Columns: []string{lockpaymentorder.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lockpaymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if lpouo.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lockpaymentorder.ChildrenTable,
+			Columns: []string{lockpaymentorder.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lockpaymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lpouo.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !lpouo.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lockpaymentorder.ChildrenTable,
+			Columns: []string{lockpaymentorder.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lockpaymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lpouo.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lockpaymentorder.ChildrenTable,
+			Columns: []string{lockpaymentorder.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lockpaymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &LockPaymentOrder{config: lpouo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
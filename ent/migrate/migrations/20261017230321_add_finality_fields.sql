@@ -0,0 +1,21 @@
+-- Add chain finality configuration to networks and settlement policy tracking to payment_orders
+
+ALTER TABLE networks
+ADD COLUMN IF NOT EXISTS finality_blocks BIGINT NOT NULL DEFAULT 0,
+ADD COLUMN IF NOT EXISTS settlement_policy VARCHAR NOT NULL DEFAULT 'soft_confirm';
+
+ALTER TABLE payment_orders
+ADD COLUMN IF NOT EXISTS settlement_policy VARCHAR NOT NULL DEFAULT 'soft_confirm',
+ADD COLUMN IF NOT EXISTS deposit_status VARCHAR,
+ADD COLUMN IF NOT EXISTS deposit_finalized_at TIMESTAMP WITH TIME ZONE;
+
+-- Add index for querying deposits awaiting finality
+CREATE INDEX IF NOT EXISTS idx_payment_orders_deposit_status
+ON payment_orders(deposit_status)
+WHERE deposit_status = 'soft_confirmed';
+
+-- Add comment
+COMMENT ON COLUMN networks.finality_blocks IS 'Blocks a deposit must be buried under before it is final (0 = final on inclusion)';
+COMMENT ON COLUMN networks.settlement_policy IS 'Default settlement policy for orders on this network: soft_confirm or finality';
+COMMENT ON COLUMN payment_orders.settlement_policy IS 'Create the order on-chain on soft confirmation or only after deposit finality';
+COMMENT ON COLUMN payment_orders.deposit_status IS 'Deposit confirmation state: soft_confirmed or finalized';
@@ -0,0 +1,9 @@
+-- Track each network's genesis block hash to detect chain resets (e.g. testnet resets)
+
+ALTER TABLE networks
+ADD COLUMN IF NOT EXISTS genesis_hash VARCHAR,
+ADD COLUMN IF NOT EXISTS genesis_mismatch BOOLEAN NOT NULL DEFAULT false;
+
+-- Add comment
+COMMENT ON COLUMN networks.genesis_hash IS 'Hash of block 0 recorded for this network';
+COMMENT ON COLUMN networks.genesis_mismatch IS 'Live genesis hash differs from the recorded one; cleared by a network reset';
@@ -0,0 +1,24 @@
+-- Hold deposits that pay two orders on a shared receive address for operator confirmation
+
+CREATE TABLE IF NOT EXISTS deposit_splits (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    tx_hash VARCHAR(70) NOT NULL UNIQUE,
+    network VARCHAR NOT NULL,
+    receive_address VARCHAR NOT NULL,
+    from_address VARCHAR NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    block_number BIGINT NOT NULL DEFAULT 0,
+    status VARCHAR NOT NULL DEFAULT 'pending',
+    resolved_at TIMESTAMP WITH TIME ZONE
+);
+
+ALTER TABLE payment_orders
+ADD COLUMN IF NOT EXISTS deposit_split_payment_orders UUID REFERENCES deposit_splits(id) ON DELETE SET NULL;
+
+-- Add index for listing pending splits
+CREATE INDEX IF NOT EXISTS depositsplit_status ON deposit_splits(status);
+
+-- Add comment
+COMMENT ON TABLE deposit_splits IS 'Single transfers matching the sum of two orders, awaiting confirmation before allocation';
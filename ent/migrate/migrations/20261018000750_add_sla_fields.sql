@@ -0,0 +1,18 @@
+-- Add SLA breach tracking to payment_orders and lock_payment_orders
+
+ALTER TABLE payment_orders
+ADD COLUMN IF NOT EXISTS sla_breached_at TIMESTAMP WITH TIME ZONE;
+
+ALTER TABLE lock_payment_orders
+ADD COLUMN IF NOT EXISTS sla_breached_stage VARCHAR,
+ADD COLUMN IF NOT EXISTS sla_breached_at TIMESTAMP WITH TIME ZONE;
+
+-- Add index for listing escalated orders
+CREATE INDEX IF NOT EXISTS idx_lock_payment_orders_sla_breached_at
+ON lock_payment_orders(sla_breached_at)
+WHERE sla_breached_at IS NOT NULL;
+
+-- Add comment
+COMMENT ON COLUMN payment_orders.sla_breached_at IS 'When the payment window SLA was breached and the order escalated';
+COMMENT ON COLUMN lock_payment_orders.sla_breached_stage IS 'Stage whose SLA was breached: fulfillment or settlement';
+COMMENT ON COLUMN lock_payment_orders.sla_breached_at IS 'When the stage SLA was breached and the order escalated';
@@ -0,0 +1,24 @@
+-- Track sweeps out of receive addresses, holding high-value ones for offline signing
+
+CREATE TABLE IF NOT EXISTS sweeps (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    network VARCHAR NOT NULL,
+    chain_id BIGINT NOT NULL,
+    token_address VARCHAR NOT NULL,
+    from_address VARCHAR NOT NULL,
+    to_address VARCHAR NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    user_operation JSONB,
+    user_op_hash VARCHAR(70),
+    tx_hash VARCHAR(70),
+    status VARCHAR NOT NULL DEFAULT 'awaiting_signature',
+    submitted_at TIMESTAMP WITH TIME ZONE
+);
+
+-- Add index for listing sweeps awaiting a signature
+CREATE INDEX IF NOT EXISTS sweep_status ON sweeps(status);
+
+-- Add comment
+COMMENT ON TABLE sweeps IS 'Sweeps out of receive addresses; those above the offline signing threshold wait for an air-gapped signature';
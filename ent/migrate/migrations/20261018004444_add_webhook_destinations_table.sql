@@ -0,0 +1,21 @@
+-- Per-destination retry policy and delivery stats of outbound webhook notifications
+
+CREATE TABLE IF NOT EXISTS webhook_destinations (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    url VARCHAR NOT NULL UNIQUE,
+    max_attempts BIGINT,
+    backoff_seconds BIGINT,
+    backoff_multiplier DOUBLE PRECISION,
+    timeout_seconds BIGINT,
+    delivered_count BIGINT NOT NULL DEFAULT 0,
+    failed_count BIGINT NOT NULL DEFAULT 0,
+    last_delivered_at TIMESTAMP WITH TIME ZONE,
+    last_failed_at TIMESTAMP WITH TIME ZONE,
+    failing_since TIMESTAMP WITH TIME ZONE,
+    disabled_at TIMESTAMP WITH TIME ZONE
+);
+
+-- Add comment
+COMMENT ON TABLE webhook_destinations IS 'Outbound webhook endpoints; NULL policy fields fall back to the WEBHOOK_* defaults and destinations failing continuously are disabled';
@@ -0,0 +1,16 @@
+-- Let senders have overpayments refunded instead of raising the order amount
+
+ALTER TABLE sender_profiles
+ADD COLUMN IF NOT EXISTS overpayment_mode VARCHAR NOT NULL DEFAULT 'adjust_amount';
+
+ALTER TABLE payment_orders
+ADD COLUMN IF NOT EXISTS amount_overpaid DOUBLE PRECISION NOT NULL DEFAULT 0;
+
+-- Add index for finding overpayments awaiting refund
+CREATE INDEX IF NOT EXISTS idx_payment_orders_amount_overpaid
+ON payment_orders(amount_overpaid)
+WHERE amount_overpaid > 0;
+
+-- Add comment
+COMMENT ON COLUMN sender_profiles.overpayment_mode IS 'adjust_amount raises the order amount to what was paid; refund sends the excess back to the payer';
+COMMENT ON COLUMN payment_orders.amount_overpaid IS 'Excess paid over the order amount and fees, refunded when the sender uses the refund overpayment mode';
@@ -0,0 +1,9 @@
+-- Record how each pool address was generated so address computation changes can be detected
+
+ALTER TABLE receive_addresses
+ADD COLUMN IF NOT EXISTS owner_address VARCHAR,
+ADD COLUMN IF NOT EXISTS generation_fingerprint VARCHAR;
+
+-- Add comment
+COMMENT ON COLUMN receive_addresses.owner_address IS 'Owner the smart account address was computed for';
+COMMENT ON COLUMN receive_addresses.generation_fingerprint IS 'Version, factory and packing hash of the code that computed the address';
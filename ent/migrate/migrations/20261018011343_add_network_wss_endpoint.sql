@@ -0,0 +1,7 @@
+-- Add WebSocket endpoint for real-time transfer indexing over eth_subscribe
+
+ALTER TABLE networks
+ADD COLUMN IF NOT EXISTS wss_endpoint VARCHAR;
+
+-- Add comment
+COMMENT ON COLUMN networks.wss_endpoint IS 'WebSocket RPC endpoint; when set, transfers to monitored addresses are indexed from a log subscription';
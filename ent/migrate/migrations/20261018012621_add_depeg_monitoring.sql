@@ -0,0 +1,22 @@
+-- Add stablecoin depeg monitoring: token peg state and manual review flags on in-flight orders
+
+ALTER TABLE tokens
+ADD COLUMN IF NOT EXISTS usd_price DOUBLE PRECISION NOT NULL DEFAULT 0,
+ADD COLUMN IF NOT EXISTS depegged_at TIMESTAMP WITH TIME ZONE;
+
+ALTER TABLE payment_orders
+ADD COLUMN IF NOT EXISTS review_reason VARCHAR;
+
+ALTER TABLE lock_payment_orders
+ADD COLUMN IF NOT EXISTS review_reason VARCHAR;
+
+-- Add index for listing orders awaiting review
+CREATE INDEX IF NOT EXISTS idx_lock_payment_orders_review_reason
+ON lock_payment_orders(review_reason)
+WHERE review_reason IS NOT NULL AND review_reason <> '';
+
+-- Add comment
+COMMENT ON COLUMN tokens.usd_price IS 'Last price against USD seen by the depeg monitor; zero until first checked';
+COMMENT ON COLUMN tokens.depegged_at IS 'Set while the token trades off its peg; new orders in the token are paused until it recovers';
+COMMENT ON COLUMN payment_orders.review_reason IS 'Set when the order needs manual review before it proceeds, e.g. its token depegged in flight';
+COMMENT ON COLUMN lock_payment_orders.review_reason IS 'Set when the order needs manual review before it proceeds, e.g. its token depegged in flight';
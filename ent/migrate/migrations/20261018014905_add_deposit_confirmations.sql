@@ -0,0 +1,18 @@
+-- Add confirmation-depth tracking for incoming payments
+-- Orders paid on networks requiring confirmations wait in the awaiting_confirmations status
+-- (payment_orders.status is a varchar, no enum change needed)
+
+ALTER TABLE networks
+ADD COLUMN IF NOT EXISTS required_confirmations INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE payment_orders
+ADD COLUMN IF NOT EXISTS required_confirmations INTEGER NOT NULL DEFAULT 0;
+
+-- Add index for the confirmation task
+CREATE INDEX IF NOT EXISTS idx_payment_orders_awaiting_confirmations
+ON payment_orders(status)
+WHERE status = 'awaiting_confirmations';
+
+-- Add comment
+COMMENT ON COLUMN networks.required_confirmations IS 'Confirmations a deposit needs before it is credited; 0 credits deposits on first sight';
+COMMENT ON COLUMN payment_orders.required_confirmations IS 'Confirmations the deposit of the order needs, copied from the network when it is paid';
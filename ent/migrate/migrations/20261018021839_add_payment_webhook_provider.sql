@@ -0,0 +1,8 @@
+-- Record which Notify provider delivers each payment webhook
+-- Existing records are thirdweb Insight and Alchemy webhooks, both authenticated with an HMAC of the body
+
+ALTER TABLE payment_webhooks
+ADD COLUMN IF NOT EXISTS provider VARCHAR NOT NULL DEFAULT 'thirdweb';
+
+-- Add comment
+COMMENT ON COLUMN payment_webhooks.provider IS 'Notify provider delivering the webhook (thirdweb, alchemy, quicknode, moralis), selects how deliveries are authenticated and parsed';
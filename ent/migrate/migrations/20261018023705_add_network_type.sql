@@ -0,0 +1,10 @@
+-- Add the chain family of each network, used to route address generation and deposit monitoring
+-- Existing networks are EVM chains except Tron, which was told apart by its identifier
+
+ALTER TABLE networks
+ADD COLUMN IF NOT EXISTS network_type VARCHAR NOT NULL DEFAULT 'evm';
+
+UPDATE networks SET network_type = 'tron' WHERE identifier LIKE 'tron%';
+
+-- Add comment
+COMMENT ON COLUMN networks.network_type IS 'Chain family (evm, tron, solana); selects how receive addresses are generated, validated and monitored';
@@ -0,0 +1,14 @@
+-- Record how pool address salts were generated, so deterministically derived salts can be rebuilt
+-- from the master secret if the database is lost. Existing salts are random
+
+ALTER TABLE receive_addresses
+ADD COLUMN IF NOT EXISTS salt_derivation VARCHAR NOT NULL DEFAULT 'random',
+ADD COLUMN IF NOT EXISTS derivation_index BIGINT;
+
+-- Add index enforcing one derived salt per chain and index
+CREATE UNIQUE INDEX IF NOT EXISTS receiveaddress_chain_id_derivation_index
+ON receive_addresses(chain_id, derivation_index);
+
+-- Add comment
+COMMENT ON COLUMN receive_addresses.salt_derivation IS 'How the salt was generated: random, or hkdf from SALT_MASTER_SECRET, the chain ID and derivation_index';
+COMMENT ON COLUMN receive_addresses.derivation_index IS 'Index an hkdf salt was derived at; unique per chain, null for random salts';
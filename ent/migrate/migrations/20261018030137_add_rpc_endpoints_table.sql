@@ -0,0 +1,18 @@
+-- Fallback RPC endpoints of each network, tried by priority when the network's rpc_endpoint fails
+
+CREATE TABLE IF NOT EXISTS rpc_endpoints (
+    id BIGSERIAL PRIMARY KEY,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    url VARCHAR NOT NULL,
+    priority BIGINT NOT NULL DEFAULT 0,
+    is_enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    network_rpc_endpoints BIGINT NOT NULL REFERENCES networks(id) ON DELETE CASCADE
+);
+
+-- Add index preventing the same endpoint twice on a network
+CREATE UNIQUE INDEX IF NOT EXISTS rpcendpoint_url_network_rpc_endpoints
+ON rpc_endpoints(url, network_rpc_endpoints);
+
+-- Add comment
+COMMENT ON TABLE rpc_endpoints IS 'Fallback RPC endpoints; the network rpc_endpoint is tried first, then enabled fallbacks in ascending priority, skipping endpoints blacklisted after failures';
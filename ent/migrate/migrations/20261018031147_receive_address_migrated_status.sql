@@ -0,0 +1,2 @@
+-- Add "receive_address_migrated" to the statuses of "transaction_logs"
+-- Enum columns are character varying, so the new value needs no schema change
@@ -0,0 +1,4 @@
+-- Modify "payment_orders" table
+ALTER TABLE "payment_orders" ADD COLUMN "fiat_amount" double precision NULL, ADD COLUMN "fiat_currency" character varying NULL, ADD COLUMN "rate_drift_tolerance" double precision NOT NULL DEFAULT 0, ADD COLUMN "fiat_conversion" jsonb NULL;
+-- Existing rows start at 0, new rows always set "rate_drift_tolerance"
+ALTER TABLE "payment_orders" ALTER COLUMN "rate_drift_tolerance" DROP DEFAULT;
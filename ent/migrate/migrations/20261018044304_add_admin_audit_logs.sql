@@ -0,0 +1,6 @@
+-- Create "admin_audit_logs" table
+CREATE TABLE "admin_audit_logs" ("id" uuid NOT NULL, "action" character varying NOT NULL, "target_id" character varying NOT NULL, "actor" character varying NOT NULL, "reason" character varying NOT NULL, "metadata" jsonb NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- Create index "adminauditlog_target_id" to table: "admin_audit_logs"
+CREATE INDEX "adminauditlog_target_id" ON "admin_audit_logs" ("target_id");
+-- Create index "adminauditlog_created_at" to table: "admin_audit_logs"
+CREATE INDEX "adminauditlog_created_at" ON "admin_audit_logs" ("created_at");
@@ -0,0 +1,10 @@
+-- Modify "sender_profiles" table
+ALTER TABLE "sender_profiles" ADD COLUMN "webhook_secret" character varying NULL;
+-- Create "webhook_deliveries" table
+CREATE TABLE "webhook_deliveries" ("id" uuid NOT NULL, "notification_id" uuid NOT NULL, "event" character varying NOT NULL, "order_id" uuid NULL, "webhook_url" character varying NOT NULL, "attempt_number" bigint NOT NULL, "status" character varying NOT NULL, "response_status" bigint NULL, "error" character varying NULL, "duration_ms" bigint NOT NULL, "created_at" timestamptz NOT NULL, "sender_profile_webhook_deliveries" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "webhook_deliveries_sender_profiles_webhook_deliveries" FOREIGN KEY ("sender_profile_webhook_deliveries") REFERENCES "sender_profiles" ("id") ON DELETE CASCADE);
+-- Create index "webhookdelivery_notification_id" to table: "webhook_deliveries"
+CREATE INDEX "webhookdelivery_notification_id" ON "webhook_deliveries" ("notification_id");
+-- Create index "webhookdelivery_order_id" to table: "webhook_deliveries"
+CREATE INDEX "webhookdelivery_order_id" ON "webhook_deliveries" ("order_id");
+-- Create index "webhookdelivery_created_at" to table: "webhook_deliveries"
+CREATE INDEX "webhookdelivery_created_at" ON "webhook_deliveries" ("created_at");
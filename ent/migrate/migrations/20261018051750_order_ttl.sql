@@ -0,0 +1,4 @@
+-- Modify "networks" table
+ALTER TABLE "networks" ADD COLUMN "order_ttl_minutes" bigint NULL;
+-- Modify "sender_profiles" table
+ALTER TABLE "sender_profiles" ADD COLUMN "order_ttl_minutes" bigint NULL;
@@ -0,0 +1,2 @@
+-- Modify "payment_orders" table
+ALTER TABLE "payment_orders" ADD COLUMN "payment_order_refund_sweep" uuid NULL, ADD CONSTRAINT "payment_orders_sweeps_refund_sweep" FOREIGN KEY ("payment_order_refund_sweep") REFERENCES "sweeps" ("id") ON DELETE SET NULL;
@@ -0,0 +1,4 @@
+-- Modify "transaction_logs" table
+ALTER TABLE "transaction_logs" ADD COLUMN "transaction_log_replaced_by" uuid NULL, ADD CONSTRAINT "transaction_logs_transaction_logs_replaced_by" FOREIGN KEY ("transaction_log_replaced_by") REFERENCES "transaction_logs" ("id") ON DELETE SET NULL;
+-- Create index "transaction_logs_transaction_log_replaced_by_key" to table: "transaction_logs"
+CREATE UNIQUE INDEX "transaction_logs_transaction_log_replaced_by_key" ON "transaction_logs" ("transaction_log_replaced_by");
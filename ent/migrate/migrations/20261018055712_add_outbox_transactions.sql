@@ -0,0 +1,6 @@
+-- Create "outbox_transactions" table
+CREATE TABLE "outbox_transactions" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "kind" character varying NOT NULL, "lock_order_id" uuid NOT NULL, "network" character varying NOT NULL, "chain_id" bigint NOT NULL, "from_address" character varying NOT NULL, "payload" jsonb NOT NULL, "status" character varying NOT NULL DEFAULT 'pending', "attempts" bigint NOT NULL DEFAULT 0, "last_error" character varying NULL, "transaction_id" character varying NULL, "tx_hash" character varying NULL, "next_attempt_at" timestamptz NOT NULL, "sent_at" timestamptz NULL, PRIMARY KEY ("id"));
+-- Create index "outboxtransaction_status_next_attempt_at" to table: "outbox_transactions"
+CREATE INDEX "outboxtransaction_status_next_attempt_at" ON "outbox_transactions" ("status", "next_attempt_at");
+-- Create index "outboxtransaction_lock_order_id_kind" to table: "outbox_transactions"
+CREATE INDEX "outboxtransaction_lock_order_id_kind" ON "outbox_transactions" ("lock_order_id", "kind");
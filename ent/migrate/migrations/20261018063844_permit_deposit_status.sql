@@ -0,0 +1,2 @@
+-- Add "permit_deposit_sent" to the statuses of "transaction_logs"
+-- Enum columns are character varying, so the new value needs no schema change
@@ -0,0 +1,6 @@
+-- Modify "linked_addresses" table
+ALTER TABLE "linked_addresses" ALTER COLUMN "owner_address" DROP NOT NULL, ADD COLUMN "handle" character varying NULL, ADD COLUMN "is_active" boolean NOT NULL DEFAULT true, ADD COLUMN "allowed_networks" jsonb NULL, ADD COLUMN "allowed_tokens" jsonb NULL, ADD COLUMN "max_transfer_amount" double precision NOT NULL DEFAULT 0, ADD COLUMN "daily_volume_limit" double precision NOT NULL DEFAULT 0, ADD COLUMN "replaced_by" character varying NULL;
+-- Create index "linked_addresses_handle_key" to table: "linked_addresses"
+CREATE UNIQUE INDEX "linked_addresses_handle_key" ON "linked_addresses" ("handle");
+-- Existing rows start at 0, new rows always set "max_transfer_amount" and "daily_volume_limit"
+ALTER TABLE "linked_addresses" ALTER COLUMN "max_transfer_amount" DROP DEFAULT, ALTER COLUMN "daily_volume_limit" DROP DEFAULT;
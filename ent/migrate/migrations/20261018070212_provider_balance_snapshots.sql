@@ -0,0 +1,8 @@
+-- Modify "provider_currencies" table
+ALTER TABLE "provider_currencies" ADD COLUMN "low_balance_since" timestamptz NULL;
+-- Modify "provider_order_tokens" table
+ALTER TABLE "provider_order_tokens" ADD COLUMN "low_balance_since" timestamptz NULL;
+-- Create "provider_balance_snapshots" table
+CREATE TABLE "provider_balance_snapshots" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "kind" character varying NOT NULL, "asset" character varying NOT NULL, "network" character varying NULL, "address" character varying NULL, "available_balance" double precision NOT NULL, "total_balance" double precision NOT NULL, "threshold" double precision NOT NULL, "below_threshold" boolean NOT NULL DEFAULT false, "provider_profile_balance_snapshots" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "provider_balance_snapshots_provider_profiles_balance_snapshots" FOREIGN KEY ("provider_profile_balance_snapshots") REFERENCES "provider_profiles" ("id") ON DELETE CASCADE);
+-- Create index "providerbalancesnapshot_created_at" to table: "provider_balance_snapshots"
+CREATE INDEX "providerbalancesnapshot_created_at" ON "provider_balance_snapshots" ("created_at");
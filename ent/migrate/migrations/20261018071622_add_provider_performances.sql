@@ -0,0 +1,4 @@
+-- Create "provider_performances" table
+CREATE TABLE "provider_performances" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "orders_settled" bigint NOT NULL DEFAULT 0, "orders_failed" bigint NOT NULL DEFAULT 0, "orders_cancelled" bigint NOT NULL DEFAULT 0, "success_rate" double precision NOT NULL DEFAULT 1, "cancellation_rate" double precision NOT NULL DEFAULT 0, "avg_settlement_seconds" double precision NOT NULL DEFAULT 0, "score" double precision NOT NULL DEFAULT 1, "provider_profile_performance" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "provider_performances_provider_profiles_performance" FOREIGN KEY ("provider_profile_performance") REFERENCES "provider_profiles" ("id") ON DELETE CASCADE);
+-- Create index "provider_performances_provider_profile_performance_key" to table: "provider_performances"
+CREATE UNIQUE INDEX "provider_performances_provider_profile_performance_key" ON "provider_performances" ("provider_profile_performance");
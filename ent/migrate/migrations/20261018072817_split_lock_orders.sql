@@ -0,0 +1,6 @@
+-- Drop index "lockpaymentorder_gateway_id_ra_65d1cd4f9b7a0ff4525b6f2bc506afdc" from table: "lock_payment_orders"
+DROP INDEX "lockpaymentorder_gateway_id_ra_65d1cd4f9b7a0ff4525b6f2bc506afdc";
+-- Modify "lock_payment_orders" table
+ALTER TABLE "lock_payment_orders" ADD COLUMN "split_index" bigint NOT NULL DEFAULT 0, ADD COLUMN "lock_payment_order_children" uuid NULL, ADD CONSTRAINT "lock_payment_orders_lock_payment_orders_children" FOREIGN KEY ("lock_payment_order_children") REFERENCES "lock_payment_orders" ("id") ON DELETE SET NULL;
+-- Create index "lockpaymentorder_gateway_id_ra_e669c74753633876f3d9e11704f40ebc" to table: "lock_payment_orders"
+CREATE UNIQUE INDEX "lockpaymentorder_gateway_id_ra_e669c74753633876f3d9e11704f40ebc" ON "lock_payment_orders" ("gateway_id", "rate", "tx_hash", "block_number", "institution", "account_identifier", "account_name", "memo", "split_index", "token_lock_payment_orders");
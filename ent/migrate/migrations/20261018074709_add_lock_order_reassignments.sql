@@ -0,0 +1,2 @@
+-- Create "lock_order_reassignments" table
+CREATE TABLE "lock_order_reassignments" ("id" uuid NOT NULL, "previous_provider_id" character varying NOT NULL, "reason" character varying NOT NULL, "created_at" timestamptz NOT NULL, "lock_payment_order_reassignments" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "lock_order_reassignments_lock_payment_orders_reassignments" FOREIGN KEY ("lock_payment_order_reassignments") REFERENCES "lock_payment_orders" ("id") ON DELETE CASCADE);
@@ -0,0 +1,6 @@
+-- Modify "payment_orders" table
+ALTER TABLE "payment_orders" ADD COLUMN "quarantine_reason" character varying NULL;
+-- Create "denylisted_addresses" table
+CREATE TABLE "denylisted_addresses" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "address" character varying NOT NULL, "reason" character varying NULL, "source" character varying NULL, PRIMARY KEY ("id"));
+-- Create index "denylisted_addresses_address_key" to table: "denylisted_addresses"
+CREATE UNIQUE INDEX "denylisted_addresses_address_key" ON "denylisted_addresses" ("address");
@@ -0,0 +1,6 @@
+-- Drop index "denylisted_addresses_address_key" from table: "denylisted_addresses"
+DROP INDEX "denylisted_addresses_address_key";
+-- Modify "denylisted_addresses" table
+ALTER TABLE "denylisted_addresses" ADD COLUMN "network" character varying NOT NULL DEFAULT '', ADD COLUMN "expires_at" timestamptz NULL;
+-- Create index "denylistedaddress_address_network" to table: "denylisted_addresses"
+CREATE UNIQUE INDEX "denylistedaddress_address_network" ON "denylisted_addresses" ("address", "network");
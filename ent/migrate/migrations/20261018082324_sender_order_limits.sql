@@ -0,0 +1,2 @@
+-- Modify "sender_profiles" table
+ALTER TABLE "sender_profiles" ADD COLUMN "max_order_amount" double precision NULL, ADD COLUMN "daily_volume_limit" double precision NULL, ADD COLUMN "hourly_order_limit" bigint NULL;
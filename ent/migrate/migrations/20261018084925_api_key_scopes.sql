@@ -0,0 +1,6 @@
+-- Drop index "api_keys_provider_profile_api_key_key" from table: "api_keys"
+DROP INDEX "api_keys_provider_profile_api_key_key";
+-- Drop index "api_keys_sender_profile_api_key_key" from table: "api_keys"
+DROP INDEX "api_keys_sender_profile_api_key_key";
+-- Modify "api_keys" table
+ALTER TABLE "api_keys" ADD COLUMN "name" character varying NULL, ADD COLUMN "scopes" jsonb NULL, ADD COLUMN "rate_limit" bigint NULL, ADD COLUMN "expires_at" timestamptz NULL, ADD COLUMN "last_used_at" timestamptz NULL, ADD COLUMN "created_at" timestamptz NULL;
@@ -0,0 +1,2 @@
+-- Modify "sender_profiles" table
+ALTER TABLE "sender_profiles" ADD COLUMN "request_signing_secret" character varying NULL;
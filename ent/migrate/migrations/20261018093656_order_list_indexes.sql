@@ -0,0 +1,14 @@
+-- Create index "lockpaymentorder_provider_profile_assigned_orders" to table: "lock_payment_orders"
+CREATE INDEX "lockpaymentorder_provider_profile_assigned_orders" ON "lock_payment_orders" ("provider_profile_assigned_orders");
+-- Create index "lockpaymentorder_created_at_id_provider_profile_assigned_orders" to table: "lock_payment_orders"
+CREATE INDEX "lockpaymentorder_created_at_id_provider_profile_assigned_orders" ON "lock_payment_orders" ("created_at", "id", "provider_profile_assigned_orders");
+-- Create index "lockpaymentorder_updated_at_id_provider_profile_assigned_orders" to table: "lock_payment_orders"
+CREATE INDEX "lockpaymentorder_updated_at_id_provider_profile_assigned_orders" ON "lock_payment_orders" ("updated_at", "id", "provider_profile_assigned_orders");
+-- Create index "paymentorder_sender_profile_payment_orders" to table: "payment_orders"
+CREATE INDEX "paymentorder_sender_profile_payment_orders" ON "payment_orders" ("sender_profile_payment_orders");
+-- Create index "paymentorder_created_at_id_sender_profile_payment_orders" to table: "payment_orders"
+CREATE INDEX "paymentorder_created_at_id_sender_profile_payment_orders" ON "payment_orders" ("created_at", "id", "sender_profile_payment_orders");
+-- Create index "paymentorder_updated_at_id_sender_profile_payment_orders" to table: "payment_orders"
+CREATE INDEX "paymentorder_updated_at_id_sender_profile_payment_orders" ON "payment_orders" ("updated_at", "id", "sender_profile_payment_orders");
+-- Create index "paymentorder_reference" to table: "payment_orders"
+CREATE INDEX "paymentorder_reference" ON "payment_orders" ("reference");
@@ -0,0 +1,4 @@
+-- Create "fee_schedules" table
+CREATE TABLE "fee_schedules" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "fee_type" character varying NOT NULL, "token" character varying NOT NULL DEFAULT '', "network" character varying NOT NULL DEFAULT '', "min_amount" double precision NOT NULL, "max_amount" double precision NULL, "percent" double precision NOT NULL, "flat_amount" double precision NOT NULL, "effective_from" timestamptz NOT NULL, "effective_until" timestamptz NULL, "sender_profile_fee_schedules" uuid NULL, PRIMARY KEY ("id"), CONSTRAINT "fee_schedules_sender_profiles_fee_schedules" FOREIGN KEY ("sender_profile_fee_schedules") REFERENCES "sender_profiles" ("id") ON DELETE CASCADE);
+-- Create index "feeschedule_fee_type_effective_from" to table: "fee_schedules"
+CREATE INDEX "feeschedule_fee_type_effective_from" ON "fee_schedules" ("fee_type", "effective_from");
@@ -0,0 +1,10 @@
+-- Create "ledger_accounts" table
+CREATE TABLE "ledger_accounts" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "name" character varying NOT NULL, "type" character varying NOT NULL, "balance" double precision NOT NULL, "token_ledger_accounts" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "ledger_accounts_tokens_ledger_accounts" FOREIGN KEY ("token_ledger_accounts") REFERENCES "tokens" ("id") ON DELETE NO ACTION);
+-- Create index "ledgeraccount_name_token_ledger_accounts" to table: "ledger_accounts"
+CREATE UNIQUE INDEX "ledgeraccount_name_token_ledger_accounts" ON "ledger_accounts" ("name", "token_ledger_accounts");
+-- Create "ledger_entries" table
+CREATE TABLE "ledger_entries" ("id" uuid NOT NULL, "kind" character varying NOT NULL, "reference" character varying NOT NULL, "created_at" timestamptz NOT NULL, "payment_order_ledger_entries" uuid NULL, PRIMARY KEY ("id"), CONSTRAINT "ledger_entries_payment_orders_ledger_entries" FOREIGN KEY ("payment_order_ledger_entries") REFERENCES "payment_orders" ("id") ON DELETE SET NULL);
+-- Create index "ledgerentry_kind_reference" to table: "ledger_entries"
+CREATE UNIQUE INDEX "ledgerentry_kind_reference" ON "ledger_entries" ("kind", "reference");
+-- Create "ledger_postings" table
+CREATE TABLE "ledger_postings" ("id" uuid NOT NULL, "amount" double precision NOT NULL, "ledger_account_postings" uuid NOT NULL, "ledger_entry_postings" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "ledger_postings_ledger_accounts_postings" FOREIGN KEY ("ledger_account_postings") REFERENCES "ledger_accounts" ("id") ON DELETE NO ACTION, CONSTRAINT "ledger_postings_ledger_entries_postings" FOREIGN KEY ("ledger_entry_postings") REFERENCES "ledger_entries" ("id") ON DELETE CASCADE);
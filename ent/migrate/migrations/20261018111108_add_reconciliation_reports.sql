@@ -0,0 +1,6 @@
+-- Create "reconciliation_reports" table
+CREATE TABLE "reconciliation_reports" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "network" character varying NOT NULL, "day" timestamptz NOT NULL, "from_block" bigint NOT NULL DEFAULT 0, "to_block" bigint NOT NULL DEFAULT 0, "status" character varying NOT NULL, "error" character varying NULL, "addresses_checked" bigint NOT NULL DEFAULT 0, "transfers_checked" bigint NOT NULL DEFAULT 0, "discrepancy_count" bigint NOT NULL DEFAULT 0, PRIMARY KEY ("id"));
+-- Create index "reconciliationreport_network_day" to table: "reconciliation_reports"
+CREATE UNIQUE INDEX "reconciliationreport_network_day" ON "reconciliation_reports" ("network", "day");
+-- Create "reconciliation_discrepancies" table
+CREATE TABLE "reconciliation_discrepancies" ("id" uuid NOT NULL, "type" character varying NOT NULL, "tx_hash" character varying NOT NULL, "address" character varying NOT NULL, "token" character varying NOT NULL, "from_address" character varying NULL, "block_number" bigint NOT NULL DEFAULT 0, "onchain_amount" double precision NOT NULL, "recorded_amount" double precision NOT NULL, "payment_order_reconciliation_discrepancies" uuid NULL, "reconciliation_report_discrepancies" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "reconciliation_discrepancies_p_2360936bd62ef72f97b5ac631fdaa4e9" FOREIGN KEY ("payment_order_reconciliation_discrepancies") REFERENCES "payment_orders" ("id") ON DELETE SET NULL, CONSTRAINT "reconciliation_discrepancies_r_432031c0f984a5e9674874f36e508bf5" FOREIGN KEY ("reconciliation_report_discrepancies") REFERENCES "reconciliation_reports" ("id") ON DELETE CASCADE);
@@ -0,0 +1,8 @@
+-- Create "unmatched_deposits" table
+CREATE TABLE "unmatched_deposits" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "tx_hash" character varying NOT NULL, "receive_address" character varying NOT NULL, "from_address" character varying NOT NULL, "amount" double precision NOT NULL, "block_number" bigint NOT NULL DEFAULT 0, "reason" character varying NOT NULL, "status" character varying NOT NULL DEFAULT 'pending', "resolved_at" timestamptz NULL, "payment_order_unmatched_deposits" uuid NULL, "sweep_unmatched_deposit" uuid NULL, "token_unmatched_deposits" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "unmatched_deposits_payment_orders_unmatched_deposits" FOREIGN KEY ("payment_order_unmatched_deposits") REFERENCES "payment_orders" ("id") ON DELETE SET NULL, CONSTRAINT "unmatched_deposits_sweeps_unmatched_deposit" FOREIGN KEY ("sweep_unmatched_deposit") REFERENCES "sweeps" ("id") ON DELETE SET NULL, CONSTRAINT "unmatched_deposits_tokens_unmatched_deposits" FOREIGN KEY ("token_unmatched_deposits") REFERENCES "tokens" ("id") ON DELETE CASCADE);
+-- Create index "unmatched_deposits_sweep_unmatched_deposit_key" to table: "unmatched_deposits"
+CREATE UNIQUE INDEX "unmatched_deposits_sweep_unmatched_deposit_key" ON "unmatched_deposits" ("sweep_unmatched_deposit");
+-- Create index "unmatcheddeposit_tx_hash_receive_address" to table: "unmatched_deposits"
+CREATE UNIQUE INDEX "unmatcheddeposit_tx_hash_receive_address" ON "unmatched_deposits" ("tx_hash", "receive_address");
+-- Create index "unmatcheddeposit_status" to table: "unmatched_deposits"
+CREATE INDEX "unmatcheddeposit_status" ON "unmatched_deposits" ("status");
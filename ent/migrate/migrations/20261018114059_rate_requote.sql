@@ -0,0 +1,2 @@
+-- Modify "payment_orders" table
+ALTER TABLE "payment_orders" ADD COLUMN "rate_locked_until" timestamptz NULL, ADD COLUMN "rate_requote" jsonb NULL;
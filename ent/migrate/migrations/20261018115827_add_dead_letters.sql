@@ -0,0 +1,6 @@
+-- Create "dead_letters" table
+CREATE TABLE "dead_letters" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "order_id" character varying NOT NULL, "stage" character varying NOT NULL, "network" character varying NOT NULL, "payload" jsonb NOT NULL, "last_error" character varying NOT NULL, "status" character varying NOT NULL DEFAULT 'pending', "retry_count" bigint NOT NULL DEFAULT 0, "next_retry_at" timestamptz NOT NULL, "resolved_at" timestamptz NULL, PRIMARY KEY ("id"));
+-- Create index "deadletter_status_next_retry_at" to table: "dead_letters"
+CREATE INDEX "deadletter_status_next_retry_at" ON "dead_letters" ("status", "next_retry_at");
+-- Create index "deadletter_order_id_stage" to table: "dead_letters"
+CREATE INDEX "deadletter_order_id_stage" ON "dead_letters" ("order_id", "stage");
@@ -0,0 +1,2 @@
+-- Modify "networks" table
+ALTER TABLE "networks" ADD COLUMN "smart_account_owner_address" character varying NULL, ADD COLUMN "smart_account_owner_signer" character varying NULL;
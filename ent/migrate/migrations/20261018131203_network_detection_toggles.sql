@@ -0,0 +1,2 @@
+-- Modify "networks" table
+ALTER TABLE "networks" ADD COLUMN "webhooks_enabled" boolean NULL, ADD COLUMN "websocket_enabled" boolean NULL, ADD COLUMN "polling_enabled" boolean NULL;
@@ -0,0 +1,2 @@
+-- Modify "payment_orders" table
+ALTER TABLE "payment_orders" ADD COLUMN "blockchain_service" character varying NULL;
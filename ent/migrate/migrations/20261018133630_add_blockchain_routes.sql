@@ -0,0 +1,4 @@
+-- Create "blockchain_routes" table
+CREATE TABLE "blockchain_routes" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "service" character varying NOT NULL, "token" character varying NOT NULL DEFAULT '', "network" character varying NOT NULL DEFAULT '', "min_amount" double precision NOT NULL, "max_amount" double precision NULL, "priority" bigint NOT NULL DEFAULT 0, "is_enabled" boolean NOT NULL DEFAULT true, "sender_profile_blockchain_routes" uuid NULL, PRIMARY KEY ("id"), CONSTRAINT "blockchain_routes_sender_profiles_blockchain_routes" FOREIGN KEY ("sender_profile_blockchain_routes") REFERENCES "sender_profiles" ("id") ON DELETE CASCADE);
+-- Create index "blockchainroute_is_enabled" to table: "blockchain_routes"
+CREATE INDEX "blockchainroute_is_enabled" ON "blockchain_routes" ("is_enabled");
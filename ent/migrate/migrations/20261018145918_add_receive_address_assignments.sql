@@ -0,0 +1,4 @@
+-- Create "receive_address_assignments" table
+CREATE TABLE "receive_address_assignments" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "address" character varying NOT NULL, "network_identifier" character varying NULL, "assigned_at" timestamptz NOT NULL, "released_at" timestamptz NULL, "payment_order_address_assignments" uuid NOT NULL, "receive_address_assignments" bigint NULL, PRIMARY KEY ("id"), CONSTRAINT "receive_address_assignments_payment_orders_address_assignments" FOREIGN KEY ("payment_order_address_assignments") REFERENCES "payment_orders" ("id") ON DELETE CASCADE, CONSTRAINT "receive_address_assignments_receive_addresses_assignments" FOREIGN KEY ("receive_address_assignments") REFERENCES "receive_addresses" ("id") ON DELETE SET NULL);
+-- Create index "receiveaddressassignment_address_assigned_at" to table: "receive_address_assignments"
+CREATE INDEX "receiveaddressassignment_address_assigned_at" ON "receive_address_assignments" ("address", "assigned_at");
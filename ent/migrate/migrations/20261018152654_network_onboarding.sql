@@ -0,0 +1,2 @@
+-- Modify "networks" table
+ALTER TABLE "networks" ADD COLUMN "explorer_url" character varying NULL, ADD COLUMN "alchemy_network_id" character varying NULL, ADD COLUMN "is_enabled" boolean NOT NULL DEFAULT true;
@@ -0,0 +1,4 @@
+-- Modify "outbox_transactions" table
+ALTER TABLE "outbox_transactions" ADD COLUMN "gas_used" bigint NOT NULL DEFAULT 0, ADD COLUMN "gas_cost" double precision NOT NULL DEFAULT 0;
+-- Existing rows start at 0, new rows always set "gas_cost"
+ALTER TABLE "outbox_transactions" ALTER COLUMN "gas_cost" DROP DEFAULT;
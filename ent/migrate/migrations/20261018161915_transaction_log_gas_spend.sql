@@ -0,0 +1,4 @@
+-- Modify "transaction_logs" table
+ALTER TABLE "transaction_logs" ADD COLUMN "purpose" character varying NULL, ADD COLUMN "payment_order_id" uuid NULL, ADD COLUMN "sponsored" boolean NOT NULL DEFAULT false, ADD COLUMN "gas_policy_id" character varying NULL, ADD COLUMN "gas_used" bigint NULL, ADD COLUMN "gas_cost" double precision NULL;
+-- Create index "transactionlog_payment_order_id" to table: "transaction_logs"
+CREATE INDEX "transactionlog_payment_order_id" ON "transaction_logs" ("payment_order_id");
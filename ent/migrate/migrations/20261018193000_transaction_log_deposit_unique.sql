@@ -0,0 +1,21 @@
+-- Modify "transaction_logs" table
+ALTER TABLE "transaction_logs" ADD COLUMN "deposit_order_id" uuid NULL;
+-- Backfill the order of each credited deposit, once per transfer and order. Duplicate deposit logs
+-- and deposits a reorg reverted keep a NULL order, so the index below can be created
+UPDATE "transaction_logs" AS "deposit"
+SET "deposit_order_id" = "deposit"."payment_order_transactions"
+WHERE "deposit"."id" IN (
+  SELECT DISTINCT ON ("tx_hash", "payment_order_transactions") "id"
+  FROM "transaction_logs"
+  WHERE "status" = 'crypto_deposited' AND "tx_hash" IS NOT NULL AND "payment_order_transactions" IS NOT NULL
+  ORDER BY "tx_hash", "payment_order_transactions", "created_at", "id"
+)
+AND NOT EXISTS (
+  SELECT 1
+  FROM "transaction_logs" AS "reverted"
+  WHERE "reverted"."status" = 'crypto_deposit_reverted'
+    AND "reverted"."tx_hash" = "deposit"."tx_hash"
+    AND "reverted"."payment_order_transactions" = "deposit"."payment_order_transactions"
+);
+-- Create index "transactionlog_tx_hash_deposit_order_id" to table: "transaction_logs"
+CREATE UNIQUE INDEX "transactionlog_tx_hash_deposit_order_id" ON "transaction_logs" ("tx_hash", "deposit_order_id");
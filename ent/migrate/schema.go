@@ -12,8 +12,14 @@ var (
 	APIKeysColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "secret", Type: field.TypeString, Unique: true},
-		{Name: "provider_profile_api_key", Type: field.TypeString, Unique: true, Nullable: true},
-		{Name: "sender_profile_api_key", Type: field.TypeUUID, Unique: true, Nullable: true},
+		{Name: "name", Type: field.TypeString, Nullable: true, Size: 100},
+		{Name: "scopes", Type: field.TypeJSON, Nullable: true},
+		{Name: "rate_limit", Type: field.TypeInt, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "provider_profile_api_key", Type: field.TypeString, Nullable: true},
+		{Name: "sender_profile_api_key", Type: field.TypeUUID, Nullable: true},
 	}
 	// APIKeysTable holds the schema information for the "api_keys" table.
 	APIKeysTable = &schema.Table{
@@ -23,18 +29,46 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "api_keys_provider_profiles_api_key",
-				Columns:    []*schema.Column{APIKeysColumns[2]},
+				Columns:    []*schema.Column{APIKeysColumns[8]},
 				RefColumns: []*schema.Column{ProviderProfilesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "api_keys_sender_profiles_api_key",
-				Columns:    []*schema.Column{APIKeysColumns[3]},
+				Columns:    []*schema.Column{APIKeysColumns[9]},
 				RefColumns: []*schema.Column{SenderProfilesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
 	}
+	// AdminAuditLogsColumns holds the columns for the "admin_audit_logs" table.
+	AdminAuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"force_refund", "requeue", "reassign_provider", "approve_quarantined", "refund_quarantined", "link_unmatched_deposit", "refund_unmatched_deposit", "sweep_unmatched_deposit", "retry_dead_letter"}},
+		{Name: "target_id", Type: field.TypeString},
+		{Name: "actor", Type: field.TypeString},
+		{Name: "reason", Type: field.TypeString, Size: 500},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AdminAuditLogsTable holds the schema information for the "admin_audit_logs" table.
+	AdminAuditLogsTable = &schema.Table{
+		Name:       "admin_audit_logs",
+		Columns:    AdminAuditLogsColumns,
+		PrimaryKey: []*schema.Column{AdminAuditLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "adminauditlog_target_id",
+				Unique:  false,
+				Columns: []*schema.Column{AdminAuditLogsColumns[2]},
+			},
+			{
+				Name:    "adminauditlog_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AdminAuditLogsColumns[6]},
+			},
+		},
+	}
 	// BeneficialOwnersColumns holds the columns for the "beneficial_owners" table.
 	BeneficialOwnersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -61,6 +95,162 @@ var (
 			},
 		},
 	}
+	// BlockchainRoutesColumns holds the columns for the "blockchain_routes" table.
+	BlockchainRoutesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "service", Type: field.TypeEnum, Enums: []string{"engine", "alchemy", "rpc"}},
+		{Name: "token", Type: field.TypeString, Size: 20, Default: ""},
+		{Name: "network", Type: field.TypeString, Size: 60, Default: ""},
+		{Name: "min_amount", Type: field.TypeFloat64},
+		{Name: "max_amount", Type: field.TypeFloat64, Nullable: true},
+		{Name: "priority", Type: field.TypeInt, Default: 0},
+		{Name: "is_enabled", Type: field.TypeBool, Default: true},
+		{Name: "sender_profile_blockchain_routes", Type: field.TypeUUID, Nullable: true},
+	}
+	// BlockchainRoutesTable holds the schema information for the "blockchain_routes" table.
+	BlockchainRoutesTable = &schema.Table{
+		Name:       "blockchain_routes",
+		Columns:    BlockchainRoutesColumns,
+		PrimaryKey: []*schema.Column{BlockchainRoutesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "blockchain_routes_sender_profiles_blockchain_routes",
+				Columns:    []*schema.Column{BlockchainRoutesColumns[10]},
+				RefColumns: []*schema.Column{SenderProfilesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "blockchainroute_is_enabled",
+				Unique:  false,
+				Columns: []*schema.Column{BlockchainRoutesColumns[9]},
+			},
+		},
+	}
+	// DeadLettersColumns holds the columns for the "dead_letters" table.
+	DeadLettersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "order_id", Type: field.TypeString},
+		{Name: "stage", Type: field.TypeEnum, Enums: []string{"receive_address", "order_created", "order_settled", "order_refunded"}},
+		{Name: "network", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeJSON},
+		{Name: "last_error", Type: field.TypeString, Size: 500},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "resolved", "exhausted"}, Default: "pending"},
+		{Name: "retry_count", Type: field.TypeInt, Default: 0},
+		{Name: "next_retry_at", Type: field.TypeTime},
+		{Name: "resolved_at", Type: field.TypeTime, Nullable: true},
+	}
+	// DeadLettersTable holds the schema information for the "dead_letters" table.
+	DeadLettersTable = &schema.Table{
+		Name:       "dead_letters",
+		Columns:    DeadLettersColumns,
+		PrimaryKey: []*schema.Column{DeadLettersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "deadletter_status_next_retry_at",
+				Unique:  false,
+				Columns: []*schema.Column{DeadLettersColumns[8], DeadLettersColumns[10]},
+			},
+			{
+				Name:    "deadletter_order_id_stage",
+				Unique:  false,
+				Columns: []*schema.Column{DeadLettersColumns[3], DeadLettersColumns[4]},
+			},
+		},
+	}
+	// DenylistedAddressesColumns holds the columns for the "denylisted_addresses" table.
+	DenylistedAddressesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "address", Type: field.TypeString, Size: 60},
+		{Name: "network", Type: field.TypeString, Size: 60, Default: ""},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "source", Type: field.TypeString, Nullable: true, Size: 100},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+	}
+	// DenylistedAddressesTable holds the schema information for the "denylisted_addresses" table.
+	DenylistedAddressesTable = &schema.Table{
+		Name:       "denylisted_addresses",
+		Columns:    DenylistedAddressesColumns,
+		PrimaryKey: []*schema.Column{DenylistedAddressesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "denylistedaddress_address_network",
+				Unique:  true,
+				Columns: []*schema.Column{DenylistedAddressesColumns[3], DenylistedAddressesColumns[4]},
+			},
+		},
+	}
+	// DepositSplitsColumns holds the columns for the "deposit_splits" table.
+	DepositSplitsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "tx_hash", Type: field.TypeString, Unique: true, Size: 70},
+		{Name: "network", Type: field.TypeString},
+		{Name: "receive_address", Type: field.TypeString},
+		{Name: "from_address", Type: field.TypeString},
+		{Name: "amount", Type: field.TypeFloat64},
+		{Name: "block_number", Type: field.TypeInt64, Default: 0},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "confirmed", "rejected"}, Default: "pending"},
+		{Name: "resolved_at", Type: field.TypeTime, Nullable: true},
+	}
+	// DepositSplitsTable holds the schema information for the "deposit_splits" table.
+	DepositSplitsTable = &schema.Table{
+		Name:       "deposit_splits",
+		Columns:    DepositSplitsColumns,
+		PrimaryKey: []*schema.Column{DepositSplitsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "depositsplit_status",
+				Unique:  false,
+				Columns: []*schema.Column{DepositSplitsColumns[9]},
+			},
+		},
+	}
+	// FeeSchedulesColumns holds the columns for the "fee_schedules" table.
+	FeeSchedulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "fee_type", Type: field.TypeEnum, Enums: []string{"sender", "network", "protocol"}},
+		{Name: "token", Type: field.TypeString, Size: 20, Default: ""},
+		{Name: "network", Type: field.TypeString, Size: 60, Default: ""},
+		{Name: "min_amount", Type: field.TypeFloat64},
+		{Name: "max_amount", Type: field.TypeFloat64, Nullable: true},
+		{Name: "percent", Type: field.TypeFloat64},
+		{Name: "flat_amount", Type: field.TypeFloat64},
+		{Name: "effective_from", Type: field.TypeTime},
+		{Name: "effective_until", Type: field.TypeTime, Nullable: true},
+		{Name: "sender_profile_fee_schedules", Type: field.TypeUUID, Nullable: true},
+	}
+	// FeeSchedulesTable holds the schema information for the "fee_schedules" table.
+	FeeSchedulesTable = &schema.Table{
+		Name:       "fee_schedules",
+		Columns:    FeeSchedulesColumns,
+		PrimaryKey: []*schema.Column{FeeSchedulesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "fee_schedules_sender_profiles_fee_schedules",
+				Columns:    []*schema.Column{FeeSchedulesColumns[12]},
+				RefColumns: []*schema.Column{SenderProfilesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "feeschedule_fee_type_effective_from",
+				Unique:  false,
+				Columns: []*schema.Column{FeeSchedulesColumns[3], FeeSchedulesColumns[10]},
+			},
+		},
+	}
 	// FiatCurrenciesColumns holds the columns for the "fiat_currencies" table.
 	FiatCurrenciesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -154,6 +344,93 @@ var (
 			},
 		},
 	}
+	// LedgerAccountsColumns holds the columns for the "ledger_accounts" table.
+	LedgerAccountsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeEnum, Enums: []string{"receive_addresses", "gateway_escrow", "swept", "order_funds", "sender_fees", "network_fees"}},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"asset", "liability", "revenue"}},
+		{Name: "balance", Type: field.TypeFloat64},
+		{Name: "token_ledger_accounts", Type: field.TypeInt},
+	}
+	// LedgerAccountsTable holds the schema information for the "ledger_accounts" table.
+	LedgerAccountsTable = &schema.Table{
+		Name:       "ledger_accounts",
+		Columns:    LedgerAccountsColumns,
+		PrimaryKey: []*schema.Column{LedgerAccountsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "ledger_accounts_tokens_ledger_accounts",
+				Columns:    []*schema.Column{LedgerAccountsColumns[6]},
+				RefColumns: []*schema.Column{TokensColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ledgeraccount_name_token_ledger_accounts",
+				Unique:  true,
+				Columns: []*schema.Column{LedgerAccountsColumns[3], LedgerAccountsColumns[6]},
+			},
+		},
+	}
+	// LedgerEntriesColumns holds the columns for the "ledger_entries" table.
+	LedgerEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"deposit", "deposit_reversal", "order_created", "settlement", "refund", "overpayment_refund", "sweep", "sweep_reversal"}},
+		{Name: "reference", Type: field.TypeString, Size: 150},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "payment_order_ledger_entries", Type: field.TypeUUID, Nullable: true},
+	}
+	// LedgerEntriesTable holds the schema information for the "ledger_entries" table.
+	LedgerEntriesTable = &schema.Table{
+		Name:       "ledger_entries",
+		Columns:    LedgerEntriesColumns,
+		PrimaryKey: []*schema.Column{LedgerEntriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "ledger_entries_payment_orders_ledger_entries",
+				Columns:    []*schema.Column{LedgerEntriesColumns[4]},
+				RefColumns: []*schema.Column{PaymentOrdersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ledgerentry_kind_reference",
+				Unique:  true,
+				Columns: []*schema.Column{LedgerEntriesColumns[1], LedgerEntriesColumns[2]},
+			},
+		},
+	}
+	// LedgerPostingsColumns holds the columns for the "ledger_postings" table.
+	LedgerPostingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "amount", Type: field.TypeFloat64},
+		{Name: "ledger_account_postings", Type: field.TypeUUID},
+		{Name: "ledger_entry_postings", Type: field.TypeUUID},
+	}
+	// LedgerPostingsTable holds the schema information for the "ledger_postings" table.
+	LedgerPostingsTable = &schema.Table{
+		Name:       "ledger_postings",
+		Columns:    LedgerPostingsColumns,
+		PrimaryKey: []*schema.Column{LedgerPostingsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "ledger_postings_ledger_accounts_postings",
+				Columns:    []*schema.Column{LedgerPostingsColumns[2]},
+				RefColumns: []*schema.Column{LedgerAccountsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "ledger_postings_ledger_entries_postings",
+				Columns:    []*schema.Column{LedgerPostingsColumns[3]},
+				RefColumns: []*schema.Column{LedgerEntriesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// LinkedAddressesColumns holds the columns for the "linked_addresses" table.
 	LinkedAddressesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -165,9 +442,16 @@ var (
 		{Name: "account_identifier", Type: field.TypeString},
 		{Name: "account_name", Type: field.TypeString},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
-		{Name: "owner_address", Type: field.TypeString, Unique: true},
+		{Name: "owner_address", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "last_indexed_block", Type: field.TypeInt64, Nullable: true},
 		{Name: "tx_hash", Type: field.TypeString, Nullable: true, Size: 70},
+		{Name: "handle", Type: field.TypeString, Unique: true, Nullable: true, Size: 32},
+		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "allowed_networks", Type: field.TypeJSON, Nullable: true},
+		{Name: "allowed_tokens", Type: field.TypeJSON, Nullable: true},
+		{Name: "max_transfer_amount", Type: field.TypeFloat64},
+		{Name: "daily_volume_limit", Type: field.TypeFloat64},
+		{Name: "replaced_by", Type: field.TypeString, Nullable: true},
 		{Name: "sender_profile_linked_address", Type: field.TypeUUID, Nullable: true},
 	}
 	// LinkedAddressesTable holds the schema information for the "linked_addresses" table.
@@ -178,7 +462,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "linked_addresses_sender_profiles_linked_address",
-				Columns:    []*schema.Column{LinkedAddressesColumns[12]},
+				Columns:    []*schema.Column{LinkedAddressesColumns[19]},
 				RefColumns: []*schema.Column{SenderProfilesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -209,6 +493,28 @@ var (
 			},
 		},
 	}
+	// LockOrderReassignmentsColumns holds the columns for the "lock_order_reassignments" table.
+	LockOrderReassignmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "previous_provider_id", Type: field.TypeString},
+		{Name: "reason", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "lock_payment_order_reassignments", Type: field.TypeUUID},
+	}
+	// LockOrderReassignmentsTable holds the schema information for the "lock_order_reassignments" table.
+	LockOrderReassignmentsTable = &schema.Table{
+		Name:       "lock_order_reassignments",
+		Columns:    LockOrderReassignmentsColumns,
+		PrimaryKey: []*schema.Column{LockOrderReassignmentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "lock_order_reassignments_lock_payment_orders_reassignments",
+				Columns:    []*schema.Column{LockOrderReassignmentsColumns[4]},
+				RefColumns: []*schema.Column{LockPaymentOrdersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// LockPaymentOrdersColumns holds the columns for the "lock_payment_orders" table.
 	LockPaymentOrdersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -221,7 +527,7 @@ var (
 		{Name: "order_percent", Type: field.TypeFloat64},
 		{Name: "sender", Type: field.TypeString, Nullable: true},
 		{Name: "tx_hash", Type: field.TypeString, Nullable: true, Size: 70},
-		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "processing", "cancelled", "fulfilled", "validated", "settled", "refunded"}, Default: "pending"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "processing", "cancelled", "fulfilled", "validated", "settled", "refunded", "split"}, Default: "pending"},
 		{Name: "block_number", Type: field.TypeInt64},
 		{Name: "institution", Type: field.TypeString},
 		{Name: "account_identifier", Type: field.TypeString},
@@ -232,6 +538,11 @@ var (
 		{Name: "cancellation_reasons", Type: field.TypeJSON},
 		{Name: "message_hash", Type: field.TypeString, Nullable: true, Size: 400},
 		{Name: "amount_in_usd", Type: field.TypeFloat64},
+		{Name: "sla_breached_stage", Type: field.TypeEnum, Nullable: true, Enums: []string{"fulfillment", "settlement"}},
+		{Name: "sla_breached_at", Type: field.TypeTime, Nullable: true},
+		{Name: "review_reason", Type: field.TypeString, Nullable: true},
+		{Name: "split_index", Type: field.TypeInt, Default: 0},
+		{Name: "lock_payment_order_children", Type: field.TypeUUID, Nullable: true},
 		{Name: "provider_profile_assigned_orders", Type: field.TypeString, Nullable: true},
 		{Name: "provision_bucket_lock_payment_orders", Type: field.TypeInt, Nullable: true},
 		{Name: "token_lock_payment_orders", Type: field.TypeInt},
@@ -242,30 +553,51 @@ var (
 		Columns:    LockPaymentOrdersColumns,
 		PrimaryKey: []*schema.Column{LockPaymentOrdersColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "lock_payment_orders_lock_payment_orders_children",
+				Columns:    []*schema.Column{LockPaymentOrdersColumns[25]},
+				RefColumns: []*schema.Column{LockPaymentOrdersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "lock_payment_orders_provider_profiles_assigned_orders",
-				Columns:    []*schema.Column{LockPaymentOrdersColumns[21]},
+				Columns:    []*schema.Column{LockPaymentOrdersColumns[26]},
 				RefColumns: []*schema.Column{ProviderProfilesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "lock_payment_orders_provision_buckets_lock_payment_orders",
-				Columns:    []*schema.Column{LockPaymentOrdersColumns[22]},
+				Columns:    []*schema.Column{LockPaymentOrdersColumns[27]},
 				RefColumns: []*schema.Column{ProvisionBucketsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "lock_payment_orders_tokens_lock_payment_orders",
-				Columns:    []*schema.Column{LockPaymentOrdersColumns[23]},
+				Columns:    []*schema.Column{LockPaymentOrdersColumns[28]},
 				RefColumns: []*schema.Column{TokensColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "lockpaymentorder_gateway_id_rate_tx_hash_block_number_institution_account_identifier_account_name_memo_token_lock_payment_orders",
+				Name:    "lockpaymentorder_gateway_id_rate_tx_hash_block_number_institution_account_identifier_account_name_memo_split_index_token_lock_payment_orders",
 				Unique:  true,
-				Columns: []*schema.Column{LockPaymentOrdersColumns[3], LockPaymentOrdersColumns[6], LockPaymentOrdersColumns[9], LockPaymentOrdersColumns[11], LockPaymentOrdersColumns[12], LockPaymentOrdersColumns[13], LockPaymentOrdersColumns[14], LockPaymentOrdersColumns[15], LockPaymentOrdersColumns[23]},
+				Columns: []*schema.Column{LockPaymentOrdersColumns[3], LockPaymentOrdersColumns[6], LockPaymentOrdersColumns[9], LockPaymentOrdersColumns[11], LockPaymentOrdersColumns[12], LockPaymentOrdersColumns[13], LockPaymentOrdersColumns[14], LockPaymentOrdersColumns[15], LockPaymentOrdersColumns[24], LockPaymentOrdersColumns[28]},
+			},
+			{
+				Name:    "lockpaymentorder_provider_profile_assigned_orders",
+				Unique:  false,
+				Columns: []*schema.Column{LockPaymentOrdersColumns[26]},
+			},
+			{
+				Name:    "lockpaymentorder_created_at_id_provider_profile_assigned_orders",
+				Unique:  false,
+				Columns: []*schema.Column{LockPaymentOrdersColumns[1], LockPaymentOrdersColumns[0], LockPaymentOrdersColumns[26]},
+			},
+			{
+				Name:    "lockpaymentorder_updated_at_id_provider_profile_assigned_orders",
+				Unique:  false,
+				Columns: []*schema.Column{LockPaymentOrdersColumns[2], LockPaymentOrdersColumns[0], LockPaymentOrdersColumns[26]},
 			},
 		},
 	}
@@ -276,13 +608,29 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "chain_id", Type: field.TypeInt64},
 		{Name: "identifier", Type: field.TypeString, Unique: true},
+		{Name: "network_type", Type: field.TypeEnum, Enums: []string{"evm", "tron", "solana"}, Default: "evm"},
 		{Name: "rpc_endpoint", Type: field.TypeString},
+		{Name: "wss_endpoint", Type: field.TypeString, Nullable: true},
 		{Name: "gateway_contract_address", Type: field.TypeString, Default: ""},
+		{Name: "explorer_url", Type: field.TypeString, Nullable: true},
+		{Name: "alchemy_network_id", Type: field.TypeString, Nullable: true},
 		{Name: "block_time", Type: field.TypeFloat64},
 		{Name: "is_testnet", Type: field.TypeBool},
 		{Name: "bundler_url", Type: field.TypeString, Nullable: true},
 		{Name: "paymaster_url", Type: field.TypeString, Nullable: true},
 		{Name: "fee", Type: field.TypeFloat64},
+		{Name: "finality_blocks", Type: field.TypeInt, Default: 0},
+		{Name: "required_confirmations", Type: field.TypeInt, Default: 0},
+		{Name: "settlement_policy", Type: field.TypeEnum, Enums: []string{"soft_confirm", "finality"}, Default: "soft_confirm"},
+		{Name: "order_ttl_minutes", Type: field.TypeInt, Nullable: true},
+		{Name: "genesis_hash", Type: field.TypeString, Nullable: true},
+		{Name: "genesis_mismatch", Type: field.TypeBool, Default: false},
+		{Name: "smart_account_owner_address", Type: field.TypeString, Nullable: true},
+		{Name: "smart_account_owner_signer", Type: field.TypeString, Nullable: true},
+		{Name: "webhooks_enabled", Type: field.TypeBool, Nullable: true},
+		{Name: "websocket_enabled", Type: field.TypeBool, Nullable: true},
+		{Name: "polling_enabled", Type: field.TypeBool, Nullable: true},
+		{Name: "is_enabled", Type: field.TypeBool, Default: true},
 	}
 	// NetworksTable holds the schema information for the "networks" table.
 	NetworksTable = &schema.Table{
@@ -290,6 +638,45 @@ var (
 		Columns:    NetworksColumns,
 		PrimaryKey: []*schema.Column{NetworksColumns[0]},
 	}
+	// OutboxTransactionsColumns holds the columns for the "outbox_transactions" table.
+	OutboxTransactionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"settle_order", "refund_order"}},
+		{Name: "lock_order_id", Type: field.TypeUUID},
+		{Name: "network", Type: field.TypeString},
+		{Name: "chain_id", Type: field.TypeInt64},
+		{Name: "from_address", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeJSON},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "sent", "confirmed", "failed"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "transaction_id", Type: field.TypeString, Nullable: true},
+		{Name: "tx_hash", Type: field.TypeString, Nullable: true, Size: 70},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "sent_at", Type: field.TypeTime, Nullable: true},
+		{Name: "gas_used", Type: field.TypeInt64, Default: 0},
+		{Name: "gas_cost", Type: field.TypeFloat64},
+	}
+	// OutboxTransactionsTable holds the schema information for the "outbox_transactions" table.
+	OutboxTransactionsTable = &schema.Table{
+		Name:       "outbox_transactions",
+		Columns:    OutboxTransactionsColumns,
+		PrimaryKey: []*schema.Column{OutboxTransactionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "outboxtransaction_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxTransactionsColumns[9], OutboxTransactionsColumns[14]},
+			},
+			{
+				Name:    "outboxtransaction_lock_order_id_kind",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxTransactionsColumns[4], OutboxTransactionsColumns[3]},
+			},
+		},
+	}
 	// PaymentOrdersColumns holds the columns for the "payment_orders" table.
 	PaymentOrdersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -298,6 +685,7 @@ var (
 		{Name: "amount", Type: field.TypeFloat64},
 		{Name: "amount_paid", Type: field.TypeFloat64},
 		{Name: "amount_returned", Type: field.TypeFloat64},
+		{Name: "amount_overpaid", Type: field.TypeFloat64},
 		{Name: "percent_settled", Type: field.TypeFloat64},
 		{Name: "sender_fee", Type: field.TypeFloat64},
 		{Name: "network_fee", Type: field.TypeFloat64},
@@ -313,10 +701,26 @@ var (
 		{Name: "gateway_id", Type: field.TypeString, Nullable: true, Size: 70},
 		{Name: "message_hash", Type: field.TypeString, Nullable: true, Size: 400},
 		{Name: "reference", Type: field.TypeString, Nullable: true, Size: 70},
-		{Name: "status", Type: field.TypeEnum, Enums: []string{"initiated", "processing", "pending", "validated", "expired", "settled", "refunded"}, Default: "initiated"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"initiated", "processing", "awaiting_confirmations", "pending", "validated", "expired", "settled", "refunded", "quarantined"}, Default: "initiated"},
 		{Name: "amount_in_usd", Type: field.TypeFloat64},
+		{Name: "settlement_policy", Type: field.TypeEnum, Enums: []string{"soft_confirm", "finality"}, Default: "soft_confirm"},
+		{Name: "deposit_status", Type: field.TypeEnum, Nullable: true, Enums: []string{"soft_confirmed", "finalized"}},
+		{Name: "deposit_finalized_at", Type: field.TypeTime, Nullable: true},
+		{Name: "required_confirmations", Type: field.TypeInt, Default: 0},
+		{Name: "sla_breached_at", Type: field.TypeTime, Nullable: true},
+		{Name: "review_reason", Type: field.TypeString, Nullable: true},
+		{Name: "quarantine_reason", Type: field.TypeString, Nullable: true},
+		{Name: "fiat_amount", Type: field.TypeFloat64, Nullable: true},
+		{Name: "fiat_currency", Type: field.TypeString, Nullable: true, Size: 10},
+		{Name: "rate_drift_tolerance", Type: field.TypeFloat64},
+		{Name: "fiat_conversion", Type: field.TypeJSON, Nullable: true},
+		{Name: "rate_locked_until", Type: field.TypeTime, Nullable: true},
+		{Name: "rate_requote", Type: field.TypeJSON, Nullable: true},
+		{Name: "blockchain_service", Type: field.TypeEnum, Nullable: true, Enums: []string{"engine", "alchemy", "rpc"}},
 		{Name: "api_key_payment_orders", Type: field.TypeUUID, Nullable: true},
+		{Name: "deposit_split_payment_orders", Type: field.TypeUUID, Nullable: true},
 		{Name: "linked_address_payment_orders", Type: field.TypeInt, Nullable: true},
+		{Name: "payment_order_refund_sweep", Type: field.TypeUUID, Nullable: true},
 		{Name: "sender_profile_payment_orders", Type: field.TypeUUID, Nullable: true},
 		{Name: "token_payment_orders", Type: field.TypeInt},
 	}
@@ -328,29 +732,63 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "payment_orders_api_keys_payment_orders",
-				Columns:    []*schema.Column{PaymentOrdersColumns[23]},
+				Columns:    []*schema.Column{PaymentOrdersColumns[38]},
 				RefColumns: []*schema.Column{APIKeysColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
+			{
+				Symbol:     "payment_orders_deposit_splits_payment_orders",
+				Columns:    []*schema.Column{PaymentOrdersColumns[39]},
+				RefColumns: []*schema.Column{DepositSplitsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "payment_orders_linked_addresses_payment_orders",
-				Columns:    []*schema.Column{PaymentOrdersColumns[24]},
+				Columns:    []*schema.Column{PaymentOrdersColumns[40]},
 				RefColumns: []*schema.Column{LinkedAddressesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
+			{
+				Symbol:     "payment_orders_sweeps_refund_sweep",
+				Columns:    []*schema.Column{PaymentOrdersColumns[41]},
+				RefColumns: []*schema.Column{SweepsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "payment_orders_sender_profiles_payment_orders",
-				Columns:    []*schema.Column{PaymentOrdersColumns[25]},
+				Columns:    []*schema.Column{PaymentOrdersColumns[42]},
 				RefColumns: []*schema.Column{SenderProfilesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "payment_orders_tokens_payment_orders",
-				Columns:    []*schema.Column{PaymentOrdersColumns[26]},
+				Columns:    []*schema.Column{PaymentOrdersColumns[43]},
 				RefColumns: []*schema.Column{TokensColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "paymentorder_sender_profile_payment_orders",
+				Unique:  false,
+				Columns: []*schema.Column{PaymentOrdersColumns[42]},
+			},
+			{
+				Name:    "paymentorder_created_at_id_sender_profile_payment_orders",
+				Unique:  false,
+				Columns: []*schema.Column{PaymentOrdersColumns[1], PaymentOrdersColumns[0], PaymentOrdersColumns[42]},
+			},
+			{
+				Name:    "paymentorder_updated_at_id_sender_profile_payment_orders",
+				Unique:  false,
+				Columns: []*schema.Column{PaymentOrdersColumns[2], PaymentOrdersColumns[0], PaymentOrdersColumns[42]},
+			},
+			{
+				Name:    "paymentorder_reference",
+				Unique:  false,
+				Columns: []*schema.Column{PaymentOrdersColumns[21]},
+			},
+		},
 	}
 	// PaymentOrderRecipientsColumns holds the columns for the "payment_order_recipients" table.
 	PaymentOrderRecipientsColumns = []*schema.Column{
@@ -385,6 +823,7 @@ var (
 		{Name: "webhook_id", Type: field.TypeString, Size: 100},
 		{Name: "webhook_secret", Type: field.TypeString, Size: 100},
 		{Name: "callback_url", Type: field.TypeString, Size: 255},
+		{Name: "provider", Type: field.TypeEnum, Enums: []string{"thirdweb", "alchemy", "quicknode", "moralis"}, Default: "thirdweb"},
 		{Name: "network_payment_webhook", Type: field.TypeInt, Unique: true, Nullable: true},
 		{Name: "payment_order_payment_webhook", Type: field.TypeUUID, Unique: true, Nullable: true},
 	}
@@ -396,18 +835,54 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "payment_webhooks_networks_payment_webhook",
-				Columns:    []*schema.Column{PaymentWebhooksColumns[6]},
+				Columns:    []*schema.Column{PaymentWebhooksColumns[7]},
 				RefColumns: []*schema.Column{NetworksColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "payment_webhooks_payment_orders_payment_webhook",
-				Columns:    []*schema.Column{PaymentWebhooksColumns[7]},
+				Columns:    []*schema.Column{PaymentWebhooksColumns[8]},
 				RefColumns: []*schema.Column{PaymentOrdersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// ProviderBalanceSnapshotsColumns holds the columns for the "provider_balance_snapshots" table.
+	ProviderBalanceSnapshotsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"fiat", "onchain"}},
+		{Name: "asset", Type: field.TypeString},
+		{Name: "network", Type: field.TypeString, Nullable: true},
+		{Name: "address", Type: field.TypeString, Nullable: true},
+		{Name: "available_balance", Type: field.TypeFloat64},
+		{Name: "total_balance", Type: field.TypeFloat64},
+		{Name: "threshold", Type: field.TypeFloat64},
+		{Name: "below_threshold", Type: field.TypeBool, Default: false},
+		{Name: "provider_profile_balance_snapshots", Type: field.TypeString},
+	}
+	// ProviderBalanceSnapshotsTable holds the schema information for the "provider_balance_snapshots" table.
+	ProviderBalanceSnapshotsTable = &schema.Table{
+		Name:       "provider_balance_snapshots",
+		Columns:    ProviderBalanceSnapshotsColumns,
+		PrimaryKey: []*schema.Column{ProviderBalanceSnapshotsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "provider_balance_snapshots_provider_profiles_balance_snapshots",
+				Columns:    []*schema.Column{ProviderBalanceSnapshotsColumns[11]},
+				RefColumns: []*schema.Column{ProviderProfilesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "providerbalancesnapshot_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ProviderBalanceSnapshotsColumns[1]},
+			},
+		},
+	}
 	// ProviderCurrenciesColumns holds the columns for the "provider_currencies" table.
 	ProviderCurrenciesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -415,6 +890,7 @@ var (
 		{Name: "total_balance", Type: field.TypeFloat64},
 		{Name: "reserved_balance", Type: field.TypeFloat64},
 		{Name: "is_available", Type: field.TypeBool, Default: true},
+		{Name: "low_balance_since", Type: field.TypeTime, Nullable: true},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "fiat_currency_provider_currencies", Type: field.TypeUUID},
 		{Name: "provider_profile_provider_currencies", Type: field.TypeString},
@@ -427,13 +903,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "provider_currencies_fiat_currencies_provider_currencies",
-				Columns:    []*schema.Column{ProviderCurrenciesColumns[6]},
+				Columns:    []*schema.Column{ProviderCurrenciesColumns[7]},
 				RefColumns: []*schema.Column{FiatCurrenciesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "provider_currencies_provider_profiles_provider_currencies",
-				Columns:    []*schema.Column{ProviderCurrenciesColumns[7]},
+				Columns:    []*schema.Column{ProviderCurrenciesColumns[8]},
 				RefColumns: []*schema.Column{ProviderProfilesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -442,7 +918,7 @@ var (
 			{
 				Name:    "providercurrencies_provider_profile_provider_currencies_fiat_currency_provider_currencies",
 				Unique:  true,
-				Columns: []*schema.Column{ProviderCurrenciesColumns[7], ProviderCurrenciesColumns[6]},
+				Columns: []*schema.Column{ProviderCurrenciesColumns[8], ProviderCurrenciesColumns[7]},
 			},
 		},
 	}
@@ -459,6 +935,7 @@ var (
 		{Name: "rate_slippage", Type: field.TypeFloat64},
 		{Name: "address", Type: field.TypeString, Nullable: true},
 		{Name: "network", Type: field.TypeString},
+		{Name: "low_balance_since", Type: field.TypeTime, Nullable: true},
 		{Name: "fiat_currency_provider_order_tokens", Type: field.TypeUUID},
 		{Name: "provider_profile_order_tokens", Type: field.TypeString},
 		{Name: "token_provider_order_tokens", Type: field.TypeInt},
@@ -471,19 +948,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "provider_order_tokens_fiat_currencies_provider_order_tokens",
-				Columns:    []*schema.Column{ProviderOrderTokensColumns[11]},
+				Columns:    []*schema.Column{ProviderOrderTokensColumns[12]},
 				RefColumns: []*schema.Column{FiatCurrenciesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "provider_order_tokens_provider_profiles_order_tokens",
-				Columns:    []*schema.Column{ProviderOrderTokensColumns[12]},
+				Columns:    []*schema.Column{ProviderOrderTokensColumns[13]},
 				RefColumns: []*schema.Column{ProviderProfilesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "provider_order_tokens_tokens_provider_order_tokens",
-				Columns:    []*schema.Column{ProviderOrderTokensColumns[13]},
+				Columns:    []*schema.Column{ProviderOrderTokensColumns[14]},
 				RefColumns: []*schema.Column{TokensColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -492,7 +969,35 @@ var (
 			{
 				Name:    "providerordertoken_network_provider_profile_order_tokens_token_provider_order_tokens_fiat_currency_provider_order_tokens",
 				Unique:  true,
-				Columns: []*schema.Column{ProviderOrderTokensColumns[10], ProviderOrderTokensColumns[12], ProviderOrderTokensColumns[13], ProviderOrderTokensColumns[11]},
+				Columns: []*schema.Column{ProviderOrderTokensColumns[10], ProviderOrderTokensColumns[13], ProviderOrderTokensColumns[14], ProviderOrderTokensColumns[12]},
+			},
+		},
+	}
+	// ProviderPerformancesColumns holds the columns for the "provider_performances" table.
+	ProviderPerformancesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "orders_settled", Type: field.TypeInt, Default: 0},
+		{Name: "orders_failed", Type: field.TypeInt, Default: 0},
+		{Name: "orders_cancelled", Type: field.TypeInt, Default: 0},
+		{Name: "success_rate", Type: field.TypeFloat64, Default: 1},
+		{Name: "cancellation_rate", Type: field.TypeFloat64, Default: 0},
+		{Name: "avg_settlement_seconds", Type: field.TypeFloat64, Default: 0},
+		{Name: "score", Type: field.TypeFloat64, Default: 1},
+		{Name: "provider_profile_performance", Type: field.TypeString, Unique: true},
+	}
+	// ProviderPerformancesTable holds the schema information for the "provider_performances" table.
+	ProviderPerformancesTable = &schema.Table{
+		Name:       "provider_performances",
+		Columns:    ProviderPerformancesColumns,
+		PrimaryKey: []*schema.Column{ProviderPerformancesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "provider_performances_provider_profiles_performance",
+				Columns:    []*schema.Column{ProviderPerformancesColumns[10]},
+				RefColumns: []*schema.Column{ProviderProfilesColumns[0]},
+				OnDelete:   schema.Cascade,
 			},
 		},
 	}
@@ -566,6 +1071,37 @@ var (
 			},
 		},
 	}
+	// RPCEndpointsColumns holds the columns for the "rpc_endpoints" table.
+	RPCEndpointsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "url", Type: field.TypeString},
+		{Name: "priority", Type: field.TypeInt, Default: 0},
+		{Name: "is_enabled", Type: field.TypeBool, Default: true},
+		{Name: "network_rpc_endpoints", Type: field.TypeInt},
+	}
+	// RPCEndpointsTable holds the schema information for the "rpc_endpoints" table.
+	RPCEndpointsTable = &schema.Table{
+		Name:       "rpc_endpoints",
+		Columns:    RPCEndpointsColumns,
+		PrimaryKey: []*schema.Column{RPCEndpointsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "rpc_endpoints_networks_rpc_endpoints",
+				Columns:    []*schema.Column{RPCEndpointsColumns[6]},
+				RefColumns: []*schema.Column{NetworksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "rpcendpoint_url_network_rpc_endpoints",
+				Unique:  true,
+				Columns: []*schema.Column{RPCEndpointsColumns[3], RPCEndpointsColumns[6]},
+			},
+		},
+	}
 	// ReceiveAddressesColumns holds the columns for the "receive_addresses" table.
 	ReceiveAddressesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -583,6 +1119,10 @@ var (
 		{Name: "assigned_at", Type: field.TypeTime, Nullable: true},
 		{Name: "recycled_at", Type: field.TypeTime, Nullable: true},
 		{Name: "times_used", Type: field.TypeInt, Default: 0},
+		{Name: "owner_address", Type: field.TypeString, Nullable: true},
+		{Name: "generation_fingerprint", Type: field.TypeString, Nullable: true},
+		{Name: "salt_derivation", Type: field.TypeEnum, Enums: []string{"random", "hkdf"}, Default: "random"},
+		{Name: "derivation_index", Type: field.TypeInt64, Nullable: true},
 		{Name: "last_indexed_block", Type: field.TypeInt64, Nullable: true},
 		{Name: "last_used", Type: field.TypeTime, Nullable: true},
 		{Name: "tx_hash", Type: field.TypeString, Nullable: true, Size: 70},
@@ -597,7 +1137,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "receive_addresses_payment_orders_receive_address",
-				Columns:    []*schema.Column{ReceiveAddressesColumns[19]},
+				Columns:    []*schema.Column{ReceiveAddressesColumns[23]},
 				RefColumns: []*schema.Column{PaymentOrdersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -618,6 +1158,112 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{ReceiveAddressesColumns[14]},
 			},
+			{
+				Name:    "receiveaddress_chain_id_derivation_index",
+				Unique:  true,
+				Columns: []*schema.Column{ReceiveAddressesColumns[11], ReceiveAddressesColumns[18]},
+			},
+		},
+	}
+	// ReceiveAddressAssignmentsColumns holds the columns for the "receive_address_assignments" table.
+	ReceiveAddressAssignmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "address", Type: field.TypeString},
+		{Name: "network_identifier", Type: field.TypeString, Nullable: true},
+		{Name: "assigned_at", Type: field.TypeTime},
+		{Name: "released_at", Type: field.TypeTime, Nullable: true},
+		{Name: "payment_order_address_assignments", Type: field.TypeUUID},
+		{Name: "receive_address_assignments", Type: field.TypeInt, Nullable: true},
+	}
+	// ReceiveAddressAssignmentsTable holds the schema information for the "receive_address_assignments" table.
+	ReceiveAddressAssignmentsTable = &schema.Table{
+		Name:       "receive_address_assignments",
+		Columns:    ReceiveAddressAssignmentsColumns,
+		PrimaryKey: []*schema.Column{ReceiveAddressAssignmentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "receive_address_assignments_payment_orders_address_assignments",
+				Columns:    []*schema.Column{ReceiveAddressAssignmentsColumns[7]},
+				RefColumns: []*schema.Column{PaymentOrdersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "receive_address_assignments_receive_addresses_assignments",
+				Columns:    []*schema.Column{ReceiveAddressAssignmentsColumns[8]},
+				RefColumns: []*schema.Column{ReceiveAddressesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "receiveaddressassignment_address_assigned_at",
+				Unique:  false,
+				Columns: []*schema.Column{ReceiveAddressAssignmentsColumns[3], ReceiveAddressAssignmentsColumns[5]},
+			},
+		},
+	}
+	// ReconciliationDiscrepanciesColumns holds the columns for the "reconciliation_discrepancies" table.
+	ReconciliationDiscrepanciesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"missing_credit", "unknown_deposit", "amount_mismatch", "unmatched_credit"}},
+		{Name: "tx_hash", Type: field.TypeString, Size: 70},
+		{Name: "address", Type: field.TypeString},
+		{Name: "token", Type: field.TypeString},
+		{Name: "from_address", Type: field.TypeString, Nullable: true},
+		{Name: "block_number", Type: field.TypeInt64, Default: 0},
+		{Name: "onchain_amount", Type: field.TypeFloat64},
+		{Name: "recorded_amount", Type: field.TypeFloat64},
+		{Name: "payment_order_reconciliation_discrepancies", Type: field.TypeUUID, Nullable: true},
+		{Name: "reconciliation_report_discrepancies", Type: field.TypeUUID},
+	}
+	// ReconciliationDiscrepanciesTable holds the schema information for the "reconciliation_discrepancies" table.
+	ReconciliationDiscrepanciesTable = &schema.Table{
+		Name:       "reconciliation_discrepancies",
+		Columns:    ReconciliationDiscrepanciesColumns,
+		PrimaryKey: []*schema.Column{ReconciliationDiscrepanciesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "reconciliation_discrepancies_payment_orders_reconciliation_discrepancies",
+				Columns:    []*schema.Column{ReconciliationDiscrepanciesColumns[9]},
+				RefColumns: []*schema.Column{PaymentOrdersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "reconciliation_discrepancies_reconciliation_reports_discrepancies",
+				Columns:    []*schema.Column{ReconciliationDiscrepanciesColumns[10]},
+				RefColumns: []*schema.Column{ReconciliationReportsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// ReconciliationReportsColumns holds the columns for the "reconciliation_reports" table.
+	ReconciliationReportsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "network", Type: field.TypeString},
+		{Name: "day", Type: field.TypeTime},
+		{Name: "from_block", Type: field.TypeInt64, Default: 0},
+		{Name: "to_block", Type: field.TypeInt64, Default: 0},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"completed", "failed"}},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "addresses_checked", Type: field.TypeInt, Default: 0},
+		{Name: "transfers_checked", Type: field.TypeInt, Default: 0},
+		{Name: "discrepancy_count", Type: field.TypeInt, Default: 0},
+	}
+	// ReconciliationReportsTable holds the schema information for the "reconciliation_reports" table.
+	ReconciliationReportsTable = &schema.Table{
+		Name:       "reconciliation_reports",
+		Columns:    ReconciliationReportsColumns,
+		PrimaryKey: []*schema.Column{ReconciliationReportsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "reconciliationreport_network_day",
+				Unique:  true,
+				Columns: []*schema.Column{ReconciliationReportsColumns[3], ReconciliationReportsColumns[4]},
+			},
 		},
 	}
 	// SenderOrderTokensColumns holds the columns for the "sender_order_tokens" table.
@@ -662,10 +1308,17 @@ var (
 	SenderProfilesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "webhook_url", Type: field.TypeString, Nullable: true},
+		{Name: "webhook_secret", Type: field.TypeString, Nullable: true},
+		{Name: "request_signing_secret", Type: field.TypeString, Nullable: true},
 		{Name: "domain_whitelist", Type: field.TypeJSON},
 		{Name: "provider_id", Type: field.TypeString, Nullable: true},
 		{Name: "is_partner", Type: field.TypeBool, Default: false},
 		{Name: "is_active", Type: field.TypeBool, Default: false},
+		{Name: "overpayment_mode", Type: field.TypeEnum, Enums: []string{"adjust_amount", "refund"}, Default: "adjust_amount"},
+		{Name: "order_ttl_minutes", Type: field.TypeInt, Nullable: true},
+		{Name: "max_order_amount", Type: field.TypeFloat64, Nullable: true},
+		{Name: "daily_volume_limit", Type: field.TypeFloat64, Nullable: true},
+		{Name: "hourly_order_limit", Type: field.TypeInt, Nullable: true},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "user_sender_profile", Type: field.TypeUUID, Unique: true},
 	}
@@ -677,12 +1330,42 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "sender_profiles_users_sender_profile",
-				Columns:    []*schema.Column{SenderProfilesColumns[7]},
+				Columns:    []*schema.Column{SenderProfilesColumns[14]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
 	}
+	// SweepsColumns holds the columns for the "sweeps" table.
+	SweepsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "network", Type: field.TypeString},
+		{Name: "chain_id", Type: field.TypeInt64},
+		{Name: "token_address", Type: field.TypeString},
+		{Name: "from_address", Type: field.TypeString},
+		{Name: "to_address", Type: field.TypeString},
+		{Name: "amount", Type: field.TypeFloat64},
+		{Name: "user_operation", Type: field.TypeJSON, Nullable: true},
+		{Name: "user_op_hash", Type: field.TypeString, Nullable: true, Size: 70},
+		{Name: "tx_hash", Type: field.TypeString, Nullable: true, Size: 70},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"awaiting_signature", "submitted", "confirmed", "failed"}, Default: "awaiting_signature"},
+		{Name: "submitted_at", Type: field.TypeTime, Nullable: true},
+	}
+	// SweepsTable holds the schema information for the "sweeps" table.
+	SweepsTable = &schema.Table{
+		Name:       "sweeps",
+		Columns:    SweepsColumns,
+		PrimaryKey: []*schema.Column{SweepsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "sweep_status",
+				Unique:  false,
+				Columns: []*schema.Column{SweepsColumns[12]},
+			},
+		},
+	}
 	// TokensColumns holds the columns for the "tokens" table.
 	TokensColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -693,6 +1376,8 @@ var (
 		{Name: "decimals", Type: field.TypeInt8},
 		{Name: "is_enabled", Type: field.TypeBool, Default: false},
 		{Name: "base_currency", Type: field.TypeString, Default: "USD"},
+		{Name: "usd_price", Type: field.TypeFloat64},
+		{Name: "depegged_at", Type: field.TypeTime, Nullable: true},
 		{Name: "network_tokens", Type: field.TypeInt},
 	}
 	// TokensTable holds the schema information for the "tokens" table.
@@ -703,7 +1388,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "tokens_networks_tokens",
-				Columns:    []*schema.Column{TokensColumns[8]},
+				Columns:    []*schema.Column{TokensColumns[10]},
 				RefColumns: []*schema.Column{NetworksColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -713,13 +1398,21 @@ var (
 	TransactionLogsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "gateway_id", Type: field.TypeString, Nullable: true},
-		{Name: "status", Type: field.TypeEnum, Enums: []string{"order_initiated", "crypto_deposited", "order_created", "order_processing", "order_fulfilled", "order_validated", "order_settled", "order_refunded", "gas_prefunded", "gateway_approved"}, Default: "order_initiated"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"order_initiated", "crypto_deposited", "order_created", "order_processing", "order_fulfilled", "order_validated", "order_settled", "order_refunded", "gas_prefunded", "gateway_approved", "overpayment_refunded", "crypto_deposit_reverted", "receive_address_migrated", "user_operation_sent", "permit_deposit_sent"}, Default: "order_initiated"},
 		{Name: "network", Type: field.TypeString, Nullable: true},
 		{Name: "tx_hash", Type: field.TypeString, Nullable: true},
 		{Name: "metadata", Type: field.TypeJSON},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "purpose", Type: field.TypeEnum, Nullable: true, Enums: []string{"deployment", "order_creation", "settlement", "refund", "sweep"}},
+		{Name: "payment_order_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "sponsored", Type: field.TypeBool, Default: false},
+		{Name: "gas_policy_id", Type: field.TypeString, Nullable: true},
+		{Name: "gas_used", Type: field.TypeInt64, Nullable: true},
+		{Name: "gas_cost", Type: field.TypeFloat64, Nullable: true},
+		{Name: "deposit_order_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "lock_payment_order_transactions", Type: field.TypeUUID, Nullable: true},
 		{Name: "payment_order_transactions", Type: field.TypeUUID, Nullable: true},
+		{Name: "transaction_log_replaced_by", Type: field.TypeUUID, Unique: true, Nullable: true},
 	}
 	// TransactionLogsTable holds the schema information for the "transaction_logs" table.
 	TransactionLogsTable = &schema.Table{
@@ -729,16 +1422,89 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "transaction_logs_lock_payment_orders_transactions",
-				Columns:    []*schema.Column{TransactionLogsColumns[7]},
+				Columns:    []*schema.Column{TransactionLogsColumns[14]},
 				RefColumns: []*schema.Column{LockPaymentOrdersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "transaction_logs_payment_orders_transactions",
-				Columns:    []*schema.Column{TransactionLogsColumns[8]},
+				Columns:    []*schema.Column{TransactionLogsColumns[15]},
 				RefColumns: []*schema.Column{PaymentOrdersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
+			{
+				Symbol:     "transaction_logs_transaction_logs_replaced_by",
+				Columns:    []*schema.Column{TransactionLogsColumns[16]},
+				RefColumns: []*schema.Column{TransactionLogsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "transactionlog_payment_order_id",
+				Unique:  false,
+				Columns: []*schema.Column{TransactionLogsColumns[8]},
+			},
+			{
+				Name:    "transactionlog_tx_hash_deposit_order_id",
+				Unique:  true,
+				Columns: []*schema.Column{TransactionLogsColumns[4], TransactionLogsColumns[13]},
+			},
+		},
+	}
+	// UnmatchedDepositsColumns holds the columns for the "unmatched_deposits" table.
+	UnmatchedDepositsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "tx_hash", Type: field.TypeString, Size: 70},
+		{Name: "receive_address", Type: field.TypeString},
+		{Name: "from_address", Type: field.TypeString},
+		{Name: "amount", Type: field.TypeFloat64},
+		{Name: "block_number", Type: field.TypeInt64, Default: 0},
+		{Name: "reason", Type: field.TypeEnum, Enums: []string{"expired_address", "paid_order", "no_order"}},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "linked", "refunded", "swept"}, Default: "pending"},
+		{Name: "resolved_at", Type: field.TypeTime, Nullable: true},
+		{Name: "payment_order_unmatched_deposits", Type: field.TypeUUID, Nullable: true},
+		{Name: "sweep_unmatched_deposit", Type: field.TypeUUID, Unique: true, Nullable: true},
+		{Name: "token_unmatched_deposits", Type: field.TypeInt},
+	}
+	// UnmatchedDepositsTable holds the schema information for the "unmatched_deposits" table.
+	UnmatchedDepositsTable = &schema.Table{
+		Name:       "unmatched_deposits",
+		Columns:    UnmatchedDepositsColumns,
+		PrimaryKey: []*schema.Column{UnmatchedDepositsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "unmatched_deposits_payment_orders_unmatched_deposits",
+				Columns:    []*schema.Column{UnmatchedDepositsColumns[11]},
+				RefColumns: []*schema.Column{PaymentOrdersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "unmatched_deposits_sweeps_unmatched_deposit",
+				Columns:    []*schema.Column{UnmatchedDepositsColumns[12]},
+				RefColumns: []*schema.Column{SweepsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "unmatched_deposits_tokens_unmatched_deposits",
+				Columns:    []*schema.Column{UnmatchedDepositsColumns[13]},
+				RefColumns: []*schema.Column{TokensColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "unmatcheddeposit_tx_hash_receive_address",
+				Unique:  true,
+				Columns: []*schema.Column{UnmatchedDepositsColumns[3], UnmatchedDepositsColumns[4]},
+			},
+			{
+				Name:    "unmatcheddeposit_status",
+				Unique:  false,
+				Columns: []*schema.Column{UnmatchedDepositsColumns[9]},
+			},
 		},
 	}
 	// UsersColumns holds the columns for the "users" table.
@@ -792,6 +1558,75 @@ var (
 			},
 		},
 	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "notification_id", Type: field.TypeUUID},
+		{Name: "event", Type: field.TypeString},
+		{Name: "order_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "webhook_url", Type: field.TypeString},
+		{Name: "attempt_number", Type: field.TypeInt},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"delivered", "failed", "dead_lettered"}},
+		{Name: "response_status", Type: field.TypeInt, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "duration_ms", Type: field.TypeInt64},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "sender_profile_webhook_deliveries", Type: field.TypeUUID},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_deliveries_sender_profiles_webhook_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[11]},
+				RefColumns: []*schema.Column{SenderProfilesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_notification_id",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[1]},
+			},
+			{
+				Name:    "webhookdelivery_order_id",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[3]},
+			},
+			{
+				Name:    "webhookdelivery_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[10]},
+			},
+		},
+	}
+	// WebhookDestinationsColumns holds the columns for the "webhook_destinations" table.
+	WebhookDestinationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "url", Type: field.TypeString, Unique: true},
+		{Name: "max_attempts", Type: field.TypeInt, Nullable: true},
+		{Name: "backoff_seconds", Type: field.TypeInt, Nullable: true},
+		{Name: "backoff_multiplier", Type: field.TypeFloat64, Nullable: true},
+		{Name: "timeout_seconds", Type: field.TypeInt, Nullable: true},
+		{Name: "delivered_count", Type: field.TypeInt, Default: 0},
+		{Name: "failed_count", Type: field.TypeInt, Default: 0},
+		{Name: "last_delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_failed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "failing_since", Type: field.TypeTime, Nullable: true},
+		{Name: "disabled_at", Type: field.TypeTime, Nullable: true},
+	}
+	// WebhookDestinationsTable holds the schema information for the "webhook_destinations" table.
+	WebhookDestinationsTable = &schema.Table{
+		Name:       "webhook_destinations",
+		Columns:    WebhookDestinationsColumns,
+		PrimaryKey: []*schema.Column{WebhookDestinationsColumns[0]},
+	}
 	// WebhookRetryAttemptsColumns holds the columns for the "webhook_retry_attempts" table.
 	WebhookRetryAttemptsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -838,30 +1673,51 @@ var (
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		APIKeysTable,
+		AdminAuditLogsTable,
 		BeneficialOwnersTable,
+		BlockchainRoutesTable,
+		DeadLettersTable,
+		DenylistedAddressesTable,
+		DepositSplitsTable,
+		FeeSchedulesTable,
 		FiatCurrenciesTable,
 		IdentityVerificationRequestsTable,
 		InstitutionsTable,
 		KybProfilesTable,
+		LedgerAccountsTable,
+		LedgerEntriesTable,
+		LedgerPostingsTable,
 		LinkedAddressesTable,
 		LockOrderFulfillmentsTable,
+		LockOrderReassignmentsTable,
 		LockPaymentOrdersTable,
 		NetworksTable,
+		OutboxTransactionsTable,
 		PaymentOrdersTable,
 		PaymentOrderRecipientsTable,
 		PaymentWebhooksTable,
+		ProviderBalanceSnapshotsTable,
 		ProviderCurrenciesTable,
 		ProviderOrderTokensTable,
+		ProviderPerformancesTable,
 		ProviderProfilesTable,
 		ProviderRatingsTable,
 		ProvisionBucketsTable,
+		RPCEndpointsTable,
 		ReceiveAddressesTable,
+		ReceiveAddressAssignmentsTable,
+		ReconciliationDiscrepanciesTable,
+		ReconciliationReportsTable,
 		SenderOrderTokensTable,
 		SenderProfilesTable,
+		SweepsTable,
 		TokensTable,
 		TransactionLogsTable,
+		UnmatchedDepositsTable,
 		UsersTable,
 		VerificationTokensTable,
+		WebhookDeliveriesTable,
+		WebhookDestinationsTable,
 		WebhookRetryAttemptsTable,
 		ProvisionBucketProviderProfilesTable,
 	}
@@ -871,36 +1727,58 @@ func init() {
 	APIKeysTable.ForeignKeys[0].RefTable = ProviderProfilesTable
 	APIKeysTable.ForeignKeys[1].RefTable = SenderProfilesTable
 	BeneficialOwnersTable.ForeignKeys[0].RefTable = KybProfilesTable
+	BlockchainRoutesTable.ForeignKeys[0].RefTable = SenderProfilesTable
+	FeeSchedulesTable.ForeignKeys[0].RefTable = SenderProfilesTable
 	InstitutionsTable.ForeignKeys[0].RefTable = FiatCurrenciesTable
 	KybProfilesTable.ForeignKeys[0].RefTable = UsersTable
+	LedgerAccountsTable.ForeignKeys[0].RefTable = TokensTable
+	LedgerEntriesTable.ForeignKeys[0].RefTable = PaymentOrdersTable
+	LedgerPostingsTable.ForeignKeys[0].RefTable = LedgerAccountsTable
+	LedgerPostingsTable.ForeignKeys[1].RefTable = LedgerEntriesTable
 	LinkedAddressesTable.ForeignKeys[0].RefTable = SenderProfilesTable
 	LockOrderFulfillmentsTable.ForeignKeys[0].RefTable = LockPaymentOrdersTable
-	LockPaymentOrdersTable.ForeignKeys[0].RefTable = ProviderProfilesTable
-	LockPaymentOrdersTable.ForeignKeys[1].RefTable = ProvisionBucketsTable
-	LockPaymentOrdersTable.ForeignKeys[2].RefTable = TokensTable
+	LockOrderReassignmentsTable.ForeignKeys[0].RefTable = LockPaymentOrdersTable
+	LockPaymentOrdersTable.ForeignKeys[0].RefTable = LockPaymentOrdersTable
+	LockPaymentOrdersTable.ForeignKeys[1].RefTable = ProviderProfilesTable
+	LockPaymentOrdersTable.ForeignKeys[2].RefTable = ProvisionBucketsTable
+	LockPaymentOrdersTable.ForeignKeys[3].RefTable = TokensTable
 	PaymentOrdersTable.ForeignKeys[0].RefTable = APIKeysTable
-	PaymentOrdersTable.ForeignKeys[1].RefTable = LinkedAddressesTable
-	PaymentOrdersTable.ForeignKeys[2].RefTable = SenderProfilesTable
-	PaymentOrdersTable.ForeignKeys[3].RefTable = TokensTable
+	PaymentOrdersTable.ForeignKeys[1].RefTable = DepositSplitsTable
+	PaymentOrdersTable.ForeignKeys[2].RefTable = LinkedAddressesTable
+	PaymentOrdersTable.ForeignKeys[3].RefTable = SweepsTable
+	PaymentOrdersTable.ForeignKeys[4].RefTable = SenderProfilesTable
+	PaymentOrdersTable.ForeignKeys[5].RefTable = TokensTable
 	PaymentOrderRecipientsTable.ForeignKeys[0].RefTable = PaymentOrdersTable
 	PaymentWebhooksTable.ForeignKeys[0].RefTable = NetworksTable
 	PaymentWebhooksTable.ForeignKeys[1].RefTable = PaymentOrdersTable
+	ProviderBalanceSnapshotsTable.ForeignKeys[0].RefTable = ProviderProfilesTable
 	ProviderCurrenciesTable.ForeignKeys[0].RefTable = FiatCurrenciesTable
 	ProviderCurrenciesTable.ForeignKeys[1].RefTable = ProviderProfilesTable
 	ProviderOrderTokensTable.ForeignKeys[0].RefTable = FiatCurrenciesTable
 	ProviderOrderTokensTable.ForeignKeys[1].RefTable = ProviderProfilesTable
 	ProviderOrderTokensTable.ForeignKeys[2].RefTable = TokensTable
+	ProviderPerformancesTable.ForeignKeys[0].RefTable = ProviderProfilesTable
 	ProviderProfilesTable.ForeignKeys[0].RefTable = UsersTable
 	ProviderRatingsTable.ForeignKeys[0].RefTable = ProviderProfilesTable
 	ProvisionBucketsTable.ForeignKeys[0].RefTable = FiatCurrenciesTable
+	RPCEndpointsTable.ForeignKeys[0].RefTable = NetworksTable
 	ReceiveAddressesTable.ForeignKeys[0].RefTable = PaymentOrdersTable
+	ReceiveAddressAssignmentsTable.ForeignKeys[0].RefTable = PaymentOrdersTable
+	ReceiveAddressAssignmentsTable.ForeignKeys[1].RefTable = ReceiveAddressesTable
+	ReconciliationDiscrepanciesTable.ForeignKeys[0].RefTable = PaymentOrdersTable
+	ReconciliationDiscrepanciesTable.ForeignKeys[1].RefTable = ReconciliationReportsTable
 	SenderOrderTokensTable.ForeignKeys[0].RefTable = SenderProfilesTable
 	SenderOrderTokensTable.ForeignKeys[1].RefTable = TokensTable
 	SenderProfilesTable.ForeignKeys[0].RefTable = UsersTable
 	TokensTable.ForeignKeys[0].RefTable = NetworksTable
 	TransactionLogsTable.ForeignKeys[0].RefTable = LockPaymentOrdersTable
 	TransactionLogsTable.ForeignKeys[1].RefTable = PaymentOrdersTable
+	TransactionLogsTable.ForeignKeys[2].RefTable = TransactionLogsTable
+	UnmatchedDepositsTable.ForeignKeys[0].RefTable = PaymentOrdersTable
+	UnmatchedDepositsTable.ForeignKeys[1].RefTable = SweepsTable
+	UnmatchedDepositsTable.ForeignKeys[2].RefTable = TokensTable
 	VerificationTokensTable.ForeignKeys[0].RefTable = UsersTable
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = SenderProfilesTable
 	ProvisionBucketProviderProfilesTable.ForeignKeys[0].RefTable = ProvisionBucketsTable
 	ProvisionBucketProviderProfilesTable.ForeignKeys[1].RefTable = ProviderProfilesTable
 }
@@ -27,10 +27,18 @@ type Network struct {
 	ChainID int64 `json:"chain_id,omitempty"`
 	// Identifier holds the value of the "identifier" field.
 	Identifier string `json:"identifier,omitempty"`
+	// NetworkType holds the value of the "network_type" field.
+	NetworkType network.NetworkType `json:"network_type,omitempty"`
 	// RPCEndpoint holds the value of the "rpc_endpoint" field.
 	RPCEndpoint string `json:"rpc_endpoint,omitempty"`
+	// WssEndpoint holds the value of the "wss_endpoint" field.
+	WssEndpoint string `json:"wss_endpoint,omitempty"`
 	// GatewayContractAddress holds the value of the "gateway_contract_address" field.
 	GatewayContractAddress string `json:"gateway_contract_address,omitempty"`
+	// ExplorerURL holds the value of the "explorer_url" field.
+	ExplorerURL string `json:"explorer_url,omitempty"`
+	// AlchemyNetworkID holds the value of the "alchemy_network_id" field.
+	AlchemyNetworkID string `json:"alchemy_network_id,omitempty"`
 	// BlockTime holds the value of the "block_time" field.
 	BlockTime decimal.Decimal `json:"block_time,omitempty"`
 	// IsTestnet holds the value of the "is_testnet" field.
@@ -41,6 +49,30 @@ type Network struct {
 	PaymasterURL string `json:"paymaster_url,omitempty"`
 	// Fee holds the value of the "fee" field.
 	Fee decimal.Decimal `json:"fee,omitempty"`
+	// FinalityBlocks holds the value of the "finality_blocks" field.
+	FinalityBlocks int `json:"finality_blocks,omitempty"`
+	// RequiredConfirmations holds the value of the "required_confirmations" field.
+	RequiredConfirmations int `json:"required_confirmations,omitempty"`
+	// SettlementPolicy holds the value of the "settlement_policy" field.
+	SettlementPolicy network.SettlementPolicy `json:"settlement_policy,omitempty"`
+	// OrderTTLMinutes holds the value of the "order_ttl_minutes" field.
+	OrderTTLMinutes *int `json:"order_ttl_minutes,omitempty"`
+	// GenesisHash holds the value of the "genesis_hash" field.
+	GenesisHash string `json:"genesis_hash,omitempty"`
+	// GenesisMismatch holds the value of the "genesis_mismatch" field.
+	GenesisMismatch bool `json:"genesis_mismatch,omitempty"`
+	// SmartAccountOwnerAddress holds the value of the "smart_account_owner_address" field.
+	SmartAccountOwnerAddress string `json:"smart_account_owner_address,omitempty"`
+	// SmartAccountOwnerSigner holds the value of the "smart_account_owner_signer" field.
+	SmartAccountOwnerSigner string `json:"smart_account_owner_signer,omitempty"`
+	// WebhooksEnabled holds the value of the "webhooks_enabled" field.
+	WebhooksEnabled *bool `json:"webhooks_enabled,omitempty"`
+	// WebsocketEnabled holds the value of the "websocket_enabled" field.
+	WebsocketEnabled *bool `json:"websocket_enabled,omitempty"`
+	// PollingEnabled holds the value of the "polling_enabled" field.
+	PollingEnabled *bool `json:"polling_enabled,omitempty"`
+	// IsEnabled holds the value of the "is_enabled" field.
+	IsEnabled bool `json:"is_enabled,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the NetworkQuery when eager-loading is set.
 	Edges        NetworkEdges `json:"edges"`
@@ -53,9 +85,11 @@ type NetworkEdges struct {
 	Tokens []*Token `json:"tokens,omitempty"`
 	// PaymentWebhook holds the value of the payment_webhook edge.
 	PaymentWebhook *PaymentWebhook `json:"payment_webhook,omitempty"`
+	// RPCEndpoints holds the value of the rpc_endpoints edge.
+	RPCEndpoints []*RPCEndpoint `json:"rpc_endpoints,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // TokensOrErr returns the Tokens value or an error if the edge
@@ -78,6 +112,15 @@ func (e NetworkEdges) PaymentWebhookOrErr() (*PaymentWebhook, error) {
 	return nil, &NotLoadedError{edge: "payment_webhook"}
 }
 
+// RPCEndpointsOrErr returns the RPCEndpoints value or an error if the edge
+// was not loaded in eager-loading.
+func (e NetworkEdges) RPCEndpointsOrErr() ([]*RPCEndpoint, error) {
+	if e.loadedTypes[2] {
+		return e.RPCEndpoints, nil
+	}
+	return nil, &NotLoadedError{edge: "rpc_endpoints"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Network) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -85,11 +128,11 @@ func (*Network) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case network.FieldBlockTime, network.FieldFee:
 			values[i] = new(decimal.Decimal)
-		case network.FieldIsTestnet:
+		case network.FieldIsTestnet, network.FieldGenesisMismatch, network.FieldWebhooksEnabled, network.FieldWebsocketEnabled, network.FieldPollingEnabled, network.FieldIsEnabled:
 			values[i] = new(sql.NullBool)
-		case network.FieldID, network.FieldChainID:
+		case network.FieldID, network.FieldChainID, network.FieldFinalityBlocks, network.FieldRequiredConfirmations, network.FieldOrderTTLMinutes:
 			values[i] = new(sql.NullInt64)
-		case network.FieldIdentifier, network.FieldRPCEndpoint, network.FieldGatewayContractAddress, network.FieldBundlerURL, network.FieldPaymasterURL:
+		case network.FieldIdentifier, network.FieldNetworkType, network.FieldRPCEndpoint, network.FieldWssEndpoint, network.FieldGatewayContractAddress, network.FieldExplorerURL, network.FieldAlchemyNetworkID, network.FieldBundlerURL, network.FieldPaymasterURL, network.FieldSettlementPolicy, network.FieldGenesisHash, network.FieldSmartAccountOwnerAddress, network.FieldSmartAccountOwnerSigner:
 			values[i] = new(sql.NullString)
 		case network.FieldCreatedAt, network.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -138,18 +181,42 @@ func (n *Network) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				n.Identifier = value.String
 			}
+		case network.FieldNetworkType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field network_type", values[i])
+			} else if value.Valid {
+				n.NetworkType = network.NetworkType(value.String)
+			}
 		case network.FieldRPCEndpoint:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field rpc_endpoint", values[i])
 			} else if value.Valid {
 				n.RPCEndpoint = value.String
 			}
+		case network.FieldWssEndpoint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field wss_endpoint", values[i])
+			} else if value.Valid {
+				n.WssEndpoint = value.String
+			}
 		case network.FieldGatewayContractAddress:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field gateway_contract_address", values[i])
 			} else if value.Valid {
 				n.GatewayContractAddress = value.String
 			}
+		case network.FieldExplorerURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field explorer_url", values[i])
+			} else if value.Valid {
+				n.ExplorerURL = value.String
+			}
+		case network.FieldAlchemyNetworkID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field alchemy_network_id", values[i])
+			} else if value.Valid {
+				n.AlchemyNetworkID = value.String
+			}
 		case network.FieldBlockTime:
 			if value, ok := values[i].(*decimal.Decimal); !ok {
 				return fmt.Errorf("unexpected type %T for field block_time", values[i])
@@ -180,6 +247,82 @@ func (n *Network) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				n.Fee = *value
 			}
+		case network.FieldFinalityBlocks:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field finality_blocks", values[i])
+			} else if value.Valid {
+				n.FinalityBlocks = int(value.Int64)
+			}
+		case network.FieldRequiredConfirmations:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field required_confirmations", values[i])
+			} else if value.Valid {
+				n.RequiredConfirmations = int(value.Int64)
+			}
+		case network.FieldSettlementPolicy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field settlement_policy", values[i])
+			} else if value.Valid {
+				n.SettlementPolicy = network.SettlementPolicy(value.String)
+			}
+		case network.FieldOrderTTLMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field order_ttl_minutes", values[i])
+			} else if value.Valid {
+				n.OrderTTLMinutes = new(int)
+				*n.OrderTTLMinutes = int(value.Int64)
+			}
+		case network.FieldGenesisHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field genesis_hash", values[i])
+			} else if value.Valid {
+				n.GenesisHash = value.String
+			}
+		case network.FieldGenesisMismatch:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field genesis_mismatch", values[i])
+			} else if value.Valid {
+				n.GenesisMismatch = value.Bool
+			}
+		case network.FieldSmartAccountOwnerAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field smart_account_owner_address", values[i])
+			} else if value.Valid {
+				n.SmartAccountOwnerAddress = value.String
+			}
+		case network.FieldSmartAccountOwnerSigner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field smart_account_owner_signer", values[i])
+			} else if value.Valid {
+				n.SmartAccountOwnerSigner = value.String
+			}
+		case network.FieldWebhooksEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field webhooks_enabled", values[i])
+			} else if value.Valid {
+				n.WebhooksEnabled = new(bool)
+				*n.WebhooksEnabled = value.Bool
+			}
+		case network.FieldWebsocketEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field websocket_enabled", values[i])
+			} else if value.Valid {
+				n.WebsocketEnabled = new(bool)
+				*n.WebsocketEnabled = value.Bool
+			}
+		case network.FieldPollingEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field polling_enabled", values[i])
+			} else if value.Valid {
+				n.PollingEnabled = new(bool)
+				*n.PollingEnabled = value.Bool
+			}
+		case network.FieldIsEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_enabled", values[i])
+			} else if value.Valid {
+				n.IsEnabled = value.Bool
+			}
 		default:
 			n.selectValues.Set(columns[i], values[i])
 		}
@@ -203,6 +346,11 @@ func (n *Network) QueryPaymentWebhook() *PaymentWebhookQuery {
 	return NewNetworkClient(n.config).QueryPaymentWebhook(n)
 }
 
+// QueryRPCEndpoints queries the "rpc_endpoints" edge of the Network entity.
+func (n *Network) QueryRPCEndpoints() *RPCEndpointQuery {
+	return NewNetworkClient(n.config).QueryRPCEndpoints(n)
+}
+
 // Update returns a builder for updating this Network.
 // Note that you need to call Network.Unwrap() before calling this method if this Network
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -238,12 +386,24 @@ func (n *Network) String() string {
 	builder.WriteString("identifier=")
 	builder.WriteString(n.Identifier)
 	builder.WriteString(", ")
+	builder.WriteString("network_type=")
+	builder.WriteString(fmt.Sprintf("%v", n.NetworkType))
+	builder.WriteString(", ")
 	builder.WriteString("rpc_endpoint=")
 	builder.WriteString(n.RPCEndpoint)
 	builder.WriteString(", ")
+	builder.WriteString("wss_endpoint=")
+	builder.WriteString(n.WssEndpoint)
+	builder.WriteString(", ")
 	builder.WriteString("gateway_contract_address=")
 	builder.WriteString(n.GatewayContractAddress)
 	builder.WriteString(", ")
+	builder.WriteString("explorer_url=")
+	builder.WriteString(n.ExplorerURL)
+	builder.WriteString(", ")
+	builder.WriteString("alchemy_network_id=")
+	builder.WriteString(n.AlchemyNetworkID)
+	builder.WriteString(", ")
 	builder.WriteString("block_time=")
 	builder.WriteString(fmt.Sprintf("%v", n.BlockTime))
 	builder.WriteString(", ")
@@ -258,6 +418,50 @@ func (n *Network) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("fee=")
 	builder.WriteString(fmt.Sprintf("%v", n.Fee))
+	builder.WriteString(", ")
+	builder.WriteString("finality_blocks=")
+	builder.WriteString(fmt.Sprintf("%v", n.FinalityBlocks))
+	builder.WriteString(", ")
+	builder.WriteString("required_confirmations=")
+	builder.WriteString(fmt.Sprintf("%v", n.RequiredConfirmations))
+	builder.WriteString(", ")
+	builder.WriteString("settlement_policy=")
+	builder.WriteString(fmt.Sprintf("%v", n.SettlementPolicy))
+	builder.WriteString(", ")
+	if v := n.OrderTTLMinutes; v != nil {
+		builder.WriteString("order_ttl_minutes=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("genesis_hash=")
+	builder.WriteString(n.GenesisHash)
+	builder.WriteString(", ")
+	builder.WriteString("genesis_mismatch=")
+	builder.WriteString(fmt.Sprintf("%v", n.GenesisMismatch))
+	builder.WriteString(", ")
+	builder.WriteString("smart_account_owner_address=")
+	builder.WriteString(n.SmartAccountOwnerAddress)
+	builder.WriteString(", ")
+	builder.WriteString("smart_account_owner_signer=")
+	builder.WriteString(n.SmartAccountOwnerSigner)
+	builder.WriteString(", ")
+	if v := n.WebhooksEnabled; v != nil {
+		builder.WriteString("webhooks_enabled=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := n.WebsocketEnabled; v != nil {
+		builder.WriteString("websocket_enabled=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := n.PollingEnabled; v != nil {
+		builder.WriteString("polling_enabled=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("is_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", n.IsEnabled))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,7 @@
 package network
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -22,10 +23,18 @@ const (
 	FieldChainID = "chain_id"
 	// FieldIdentifier holds the string denoting the identifier field in the database.
 	FieldIdentifier = "identifier"
+	// FieldNetworkType holds the string denoting the network_type field in the database.
+	FieldNetworkType = "network_type"
 	// FieldRPCEndpoint holds the string denoting the rpc_endpoint field in the database.
 	FieldRPCEndpoint = "rpc_endpoint"
+	// FieldWssEndpoint holds the string denoting the wss_endpoint field in the database.
+	FieldWssEndpoint = "wss_endpoint"
 	// FieldGatewayContractAddress holds the string denoting the gateway_contract_address field in the database.
 	FieldGatewayContractAddress = "gateway_contract_address"
+	// FieldExplorerURL holds the string denoting the explorer_url field in the database.
+	FieldExplorerURL = "explorer_url"
+	// FieldAlchemyNetworkID holds the string denoting the alchemy_network_id field in the database.
+	FieldAlchemyNetworkID = "alchemy_network_id"
 	// FieldBlockTime holds the string denoting the block_time field in the database.
 	FieldBlockTime = "block_time"
 	// FieldIsTestnet holds the string denoting the is_testnet field in the database.
@@ -36,10 +45,36 @@ const (
 	FieldPaymasterURL = "paymaster_url"
 	// FieldFee holds the string denoting the fee field in the database.
 	FieldFee = "fee"
+	// FieldFinalityBlocks holds the string denoting the finality_blocks field in the database.
+	FieldFinalityBlocks = "finality_blocks"
+	// FieldRequiredConfirmations holds the string denoting the required_confirmations field in the database.
+	FieldRequiredConfirmations = "required_confirmations"
+	// FieldSettlementPolicy holds the string denoting the settlement_policy field in the database.
+	FieldSettlementPolicy = "settlement_policy"
+	// FieldOrderTTLMinutes holds the string denoting the order_ttl_minutes field in the database.
+	FieldOrderTTLMinutes = "order_ttl_minutes"
+	// FieldGenesisHash holds the string denoting the genesis_hash field in the database.
+	FieldGenesisHash = "genesis_hash"
+	// FieldGenesisMismatch holds the string denoting the genesis_mismatch field in the database.
+	FieldGenesisMismatch = "genesis_mismatch"
+	// FieldSmartAccountOwnerAddress holds the string denoting the smart_account_owner_address field in the database.
+	FieldSmartAccountOwnerAddress = "smart_account_owner_address"
+	// FieldSmartAccountOwnerSigner holds the string denoting the smart_account_owner_signer field in the database.
+	FieldSmartAccountOwnerSigner = "smart_account_owner_signer"
+	// FieldWebhooksEnabled holds the string denoting the webhooks_enabled field in the database.
+	FieldWebhooksEnabled = "webhooks_enabled"
+	// FieldWebsocketEnabled holds the string denoting the websocket_enabled field in the database.
+	FieldWebsocketEnabled = "websocket_enabled"
+	// FieldPollingEnabled holds the string denoting the polling_enabled field in the database.
+	FieldPollingEnabled = "polling_enabled"
+	// FieldIsEnabled holds the string denoting the is_enabled field in the database.
+	FieldIsEnabled = "is_enabled"
 	// EdgeTokens holds the string denoting the tokens edge name in mutations.
 	EdgeTokens = "tokens"
 	// EdgePaymentWebhook holds the string denoting the payment_webhook edge name in mutations.
 	EdgePaymentWebhook = "payment_webhook"
+	// EdgeRPCEndpoints holds the string denoting the rpc_endpoints edge name in mutations.
+	EdgeRPCEndpoints = "rpc_endpoints"
 	// Table holds the table name of the network in the database.
 	Table = "networks"
 	// TokensTable is the table that holds the tokens relation/edge.
@@ -56,6 +91,13 @@ const (
 	PaymentWebhookInverseTable = "payment_webhooks"
 	// PaymentWebhookColumn is the table column denoting the payment_webhook relation/edge.
 	PaymentWebhookColumn = "network_payment_webhook"
+	// RPCEndpointsTable is the table that holds the rpc_endpoints relation/edge.
+	RPCEndpointsTable = "rpc_endpoints"
+	// RPCEndpointsInverseTable is the table name for the RPCEndpoint entity.
+	// It exists in this package in order to avoid circular dependency with the "rpcendpoint" package.
+	RPCEndpointsInverseTable = "rpc_endpoints"
+	// RPCEndpointsColumn is the table column denoting the rpc_endpoints relation/edge.
+	RPCEndpointsColumn = "network_rpc_endpoints"
 )
 
 // Columns holds all SQL columns for network fields.
@@ -65,13 +107,29 @@ var Columns = []string{
 	FieldUpdatedAt,
 	FieldChainID,
 	FieldIdentifier,
+	FieldNetworkType,
 	FieldRPCEndpoint,
+	FieldWssEndpoint,
 	FieldGatewayContractAddress,
+	FieldExplorerURL,
+	FieldAlchemyNetworkID,
 	FieldBlockTime,
 	FieldIsTestnet,
 	FieldBundlerURL,
 	FieldPaymasterURL,
 	FieldFee,
+	FieldFinalityBlocks,
+	FieldRequiredConfirmations,
+	FieldSettlementPolicy,
+	FieldOrderTTLMinutes,
+	FieldGenesisHash,
+	FieldGenesisMismatch,
+	FieldSmartAccountOwnerAddress,
+	FieldSmartAccountOwnerSigner,
+	FieldWebhooksEnabled,
+	FieldWebsocketEnabled,
+	FieldPollingEnabled,
+	FieldIsEnabled,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -93,8 +151,75 @@ var (
 	UpdateDefaultUpdatedAt func() time.Time
 	// DefaultGatewayContractAddress holds the default value on creation for the "gateway_contract_address" field.
 	DefaultGatewayContractAddress string
+	// DefaultFinalityBlocks holds the default value on creation for the "finality_blocks" field.
+	DefaultFinalityBlocks int
+	// FinalityBlocksValidator is a validator for the "finality_blocks" field. It is called by the builders before save.
+	FinalityBlocksValidator func(int) error
+	// DefaultRequiredConfirmations holds the default value on creation for the "required_confirmations" field.
+	DefaultRequiredConfirmations int
+	// RequiredConfirmationsValidator is a validator for the "required_confirmations" field. It is called by the builders before save.
+	RequiredConfirmationsValidator func(int) error
+	// OrderTTLMinutesValidator is a validator for the "order_ttl_minutes" field. It is called by the builders before save.
+	OrderTTLMinutesValidator func(int) error
+	// DefaultGenesisMismatch holds the default value on creation for the "genesis_mismatch" field.
+	DefaultGenesisMismatch bool
+	// DefaultIsEnabled holds the default value on creation for the "is_enabled" field.
+	DefaultIsEnabled bool
 )
 
+// NetworkType defines the type for the "network_type" enum field.
+type NetworkType string
+
+// NetworkTypeEvm is the default value of the NetworkType enum.
+const DefaultNetworkType = NetworkTypeEvm
+
+// NetworkType values.
+const (
+	NetworkTypeEvm    NetworkType = "evm"
+	NetworkTypeTron   NetworkType = "tron"
+	NetworkTypeSolana NetworkType = "solana"
+)
+
+func (nt NetworkType) String() string {
+	return string(nt)
+}
+
+// NetworkTypeValidator is a validator for the "network_type" field enum values. It is called by the builders before save.
+func NetworkTypeValidator(nt NetworkType) error {
+	switch nt {
+	case NetworkTypeEvm, NetworkTypeTron, NetworkTypeSolana:
+		return nil
+	default:
+		return fmt.Errorf("network: invalid enum value for network_type field: %q", nt)
+	}
+}
+
+// SettlementPolicy defines the type for the "settlement_policy" enum field.
+type SettlementPolicy string
+
+// SettlementPolicySoftConfirm is the default value of the SettlementPolicy enum.
+const DefaultSettlementPolicy = SettlementPolicySoftConfirm
+
+// SettlementPolicy values.
+const (
+	SettlementPolicySoftConfirm SettlementPolicy = "soft_confirm"
+	SettlementPolicyFinality    SettlementPolicy = "finality"
+)
+
+func (sp SettlementPolicy) String() string {
+	return string(sp)
+}
+
+// SettlementPolicyValidator is a validator for the "settlement_policy" field enum values. It is called by the builders before save.
+func SettlementPolicyValidator(sp SettlementPolicy) error {
+	switch sp {
+	case SettlementPolicySoftConfirm, SettlementPolicyFinality:
+		return nil
+	default:
+		return fmt.Errorf("network: invalid enum value for settlement_policy field: %q", sp)
+	}
+}
+
 // OrderOption defines the ordering options for the Network queries.
 type OrderOption func(*sql.Selector)
 
@@ -123,16 +248,36 @@ func ByIdentifier(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIdentifier, opts...).ToFunc()
 }
 
+// ByNetworkType orders the results by the network_type field.
+func ByNetworkType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNetworkType, opts...).ToFunc()
+}
+
 // ByRPCEndpoint orders the results by the rpc_endpoint field.
 func ByRPCEndpoint(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRPCEndpoint, opts...).ToFunc()
 }
 
+// ByWssEndpoint orders the results by the wss_endpoint field.
+func ByWssEndpoint(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWssEndpoint, opts...).ToFunc()
+}
+
 // ByGatewayContractAddress orders the results by the gateway_contract_address field.
 func ByGatewayContractAddress(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldGatewayContractAddress, opts...).ToFunc()
 }
 
+// ByExplorerURL orders the results by the explorer_url field.
+func ByExplorerURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExplorerURL, opts...).ToFunc()
+}
+
+// ByAlchemyNetworkID orders the results by the alchemy_network_id field.
+func ByAlchemyNetworkID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAlchemyNetworkID, opts...).ToFunc()
+}
+
 // ByBlockTime orders the results by the block_time field.
 func ByBlockTime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldBlockTime, opts...).ToFunc()
@@ -158,6 +303,66 @@ func ByFee(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldFee, opts...).ToFunc()
 }
 
+// ByFinalityBlocks orders the results by the finality_blocks field.
+func ByFinalityBlocks(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinalityBlocks, opts...).ToFunc()
+}
+
+// ByRequiredConfirmations orders the results by the required_confirmations field.
+func ByRequiredConfirmations(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequiredConfirmations, opts...).ToFunc()
+}
+
+// BySettlementPolicy orders the results by the settlement_policy field.
+func BySettlementPolicy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSettlementPolicy, opts...).ToFunc()
+}
+
+// ByOrderTTLMinutes orders the results by the order_ttl_minutes field.
+func ByOrderTTLMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderTTLMinutes, opts...).ToFunc()
+}
+
+// ByGenesisHash orders the results by the genesis_hash field.
+func ByGenesisHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGenesisHash, opts...).ToFunc()
+}
+
+// ByGenesisMismatch orders the results by the genesis_mismatch field.
+func ByGenesisMismatch(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGenesisMismatch, opts...).ToFunc()
+}
+
+// BySmartAccountOwnerAddress orders the results by the smart_account_owner_address field.
+func BySmartAccountOwnerAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSmartAccountOwnerAddress, opts...).ToFunc()
+}
+
+// BySmartAccountOwnerSigner orders the results by the smart_account_owner_signer field.
+func BySmartAccountOwnerSigner(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSmartAccountOwnerSigner, opts...).ToFunc()
+}
+
+// ByWebhooksEnabled orders the results by the webhooks_enabled field.
+func ByWebhooksEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWebhooksEnabled, opts...).ToFunc()
+}
+
+// ByWebsocketEnabled orders the results by the websocket_enabled field.
+func ByWebsocketEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWebsocketEnabled, opts...).ToFunc()
+}
+
+// ByPollingEnabled orders the results by the polling_enabled field.
+func ByPollingEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPollingEnabled, opts...).ToFunc()
+}
+
+// ByIsEnabled orders the results by the is_enabled field.
+func ByIsEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsEnabled, opts...).ToFunc()
+}
+
 // ByTokensCount orders the results by tokens count.
 func ByTokensCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -178,6 +383,20 @@ func ByPaymentWebhookField(field string, opts ...sql.OrderTermOption) OrderOptio
 		sqlgraph.OrderByNeighborTerms(s, newPaymentWebhookStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByRPCEndpointsCount orders the results by rpc_endpoints count.
+func ByRPCEndpointsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRPCEndpointsStep(), opts...)
+	}
+}
+
+// ByRPCEndpoints orders the results by rpc_endpoints terms.
+func ByRPCEndpoints(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRPCEndpointsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newTokensStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -192,3 +411,10 @@ func newPaymentWebhookStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2O, false, PaymentWebhookTable, PaymentWebhookColumn),
 	)
 }
+func newRPCEndpointsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RPCEndpointsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RPCEndpointsTable, RPCEndpointsColumn),
+	)
+}
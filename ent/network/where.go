@@ -81,11 +81,26 @@ func RPCEndpoint(v string) predicate.Network {
 	return predicate.Network(sql.FieldEQ(FieldRPCEndpoint, v))
 }
 
+// WssEndpoint applies equality check predicate on the "wss_endpoint" field. It's identical to WssEndpointEQ.
+func WssEndpoint(v string) predicate.Network {
+	return predicate.Network(sql.FieldEQ(FieldWssEndpoint, v))
+}
+
 // GatewayContractAddress applies equality check predicate on the "gateway_contract_address" field. It's identical to GatewayContractAddressEQ.
 func GatewayContractAddress(v string) predicate.Network {
 	return predicate.Network(sql.FieldEQ(FieldGatewayContractAddress, v))
 }
 
+// ExplorerURL applies equality check predicate on the "explorer_url" field. It's identical to ExplorerURLEQ.
+func ExplorerURL(v string) predicate.Network {
+	return predicate.Network(sql.FieldEQ(FieldExplorerURL, v))
+}
+
+// AlchemyNetworkID applies equality check predicate on the "alchemy_network_id" field. It's identical to AlchemyNetworkIDEQ.
+func AlchemyNetworkID(v string) predicate.Network {
+	return predicate.Network(sql.FieldEQ(FieldAlchemyNetworkID, v))
+}
+
 // BlockTime applies equality check predicate on the "block_time" field. It's identical to BlockTimeEQ.
 func BlockTime(v decimal.Decimal) predicate.Network {
 	return predicate.Network(sql.FieldEQ(FieldBlockTime, v))
@@ -111,6 +126,61 @@ func Fee(v decimal.Decimal) predicate.Network {
 	return predicate.Network(sql.FieldEQ(FieldFee, v))
 }
 
+// FinalityBlocks applies equality check predicate on the "finality_blocks" field. It's identical to FinalityBlocksEQ.
+func FinalityBlocks(v int) predicate.Network {
+	return predicate.Network(sql.FieldEQ(FieldFinalityBlocks, v))
+}
+
+// RequiredConfirmations applies equality check predicate on the "required_confirmations" field. It's identical to RequiredConfirmationsEQ.
+func RequiredConfirmations(v int) predicate.Network {
+	return predicate.Network(sql.FieldEQ(FieldRequiredConfirmations, v))
+}
+
+// OrderTTLMinutes applies equality check predicate on the "order_ttl_minutes" field. It's identical to OrderTTLMinutesEQ.
+func OrderTTLMinutes(v int) predicate.Network {
+	return predicate.Network(sql.FieldEQ(FieldOrderTTLMinutes, v))
+}
+
+// GenesisHash applies equality check predicate on the "genesis_hash" field. It's identical to GenesisHashEQ.
+func GenesisHash(v string) predicate.Network {
+	return predicate.Network(sql.FieldEQ(FieldGenesisHash, v))
+}
+
+// GenesisMismatch applies equality check predicate on the "genesis_mismatch" field. It's identical to GenesisMismatchEQ.
+func GenesisMismatch(v bool) predicate.Network {
+	return predicate.Network(sql.FieldEQ(FieldGenesisMismatch, v))
+}
+
+// SmartAccountOwnerAddress applies equality check predicate on the "smart_account_owner_address" field. It's identical to SmartAccountOwnerAddressEQ.
+func SmartAccountOwnerAddress(v string) predicate.Network {
+	return predicate.Network(sql.FieldEQ(FieldSmartAccountOwnerAddress, v))
+}
+
+// SmartAccountOwnerSigner applies equality check predicate on the "smart_account_owner_signer" field. It's identical to SmartAccountOwnerSignerEQ.
+func SmartAccountOwnerSigner(v string) predicate.Network {
+	return predicate.Network(sql.FieldEQ(FieldSmartAccountOwnerSigner, v))
+}
+
+// WebhooksEnabled applies equality check predicate on the "webhooks_enabled" field. It's identical to WebhooksEnabledEQ.
+func WebhooksEnabled(v bool) predicate.Network {
+	return predicate.Network(sql.FieldEQ(FieldWebhooksEnabled, v))
+}
+
+// WebsocketEnabled applies equality check predicate on the "websocket_enabled" field. It's identical to WebsocketEnabledEQ.
+func WebsocketEnabled(v bool) predicate.Network {
+	return predicate.Network(sql.FieldEQ(FieldWebsocketEnabled, v))
+}
+
+// PollingEnabled applies equality check predicate on the "polling_enabled" field. It's identical to PollingEnabledEQ.
+func PollingEnabled(v bool) predicate.Network {
+	return predicate.Network(sql.FieldEQ(FieldPollingEnabled, v))
+}
+
+// IsEnabled applies equality check predicate on the "is_enabled" field. It's identical to IsEnabledEQ.
+func IsEnabled(v bool) predicate.Network {
+	return predicate.Network(sql.FieldEQ(FieldIsEnabled, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Network {
 	return predicate.Network(sql.FieldEQ(FieldCreatedAt, v))
@@ -296,6 +366,26 @@ func IdentifierContainsFold(v string) predicate.Network {
 	return predicate.Network(sql.FieldContainsFold(FieldIdentifier, v))
 }
 
+// NetworkTypeEQ applies the EQ predicate on the "network_type" field.
+func NetworkTypeEQ(v NetworkType) predicate.Network {
+	return predicate.Network(sql.FieldEQ(FieldNetworkType, v))
+}
+
+// NetworkTypeNEQ applies the NEQ predicate on the "network_type" field.
+func NetworkTypeNEQ(v NetworkType) predicate.Network {
+	return predicate.Network(sql.FieldNEQ(FieldNetworkType, v))
+}
+
+// NetworkTypeIn applies the In predicate on the "network_type" field.
+func NetworkTypeIn(vs ...NetworkType) predicate.Network {
+	return predicate.Network(sql.FieldIn(FieldNetworkType, vs...))
+}
+
+// NetworkTypeNotIn applies the NotIn predicate on the "network_type" field.
+func NetworkTypeNotIn(vs ...NetworkType) predicate.Network {
+	return predicate.Network(sql.FieldNotIn(FieldNetworkType, vs...))
+}
+
 // RPCEndpointEQ applies the EQ predicate on the "rpc_endpoint" field.
 func RPCEndpointEQ(v string) predicate.Network {
 	return predicate.Network(sql.FieldEQ(FieldRPCEndpoint, v))
@@ -361,6 +451,81 @@ func RPCEndpointContainsFold(v string) predicate.Network {
 	return predicate.Network(sql.FieldContainsFold(FieldRPCEndpoint, v))
 }
 
+// WssEndpointEQ applies the EQ predicate on the "wss_endpoint" field.
+func WssEndpointEQ(v string) predicate.Network {
+	return predicate.Network(sql.FieldEQ(FieldWssEndpoint, v))
+}
+
+// WssEndpointNEQ applies the NEQ predicate on the "wss_endpoint" field.
+func WssEndpointNEQ(v string) predicate.Network {
+	return predicate.Network(sql.FieldNEQ(FieldWssEndpoint, v))
+}
+
+// WssEndpointIn applies the In predicate on the "wss_endpoint" field.
+func WssEndpointIn(vs ...string) predicate.Network {
+	return predicate.Network(sql.FieldIn(FieldWssEndpoint, vs...))
+}
+
+// WssEndpointNotIn applies the NotIn predicate on the "wss_endpoint" field.
+func WssEndpointNotIn(vs ...string) predicate.Network {
+	return predicate.Network(sql.FieldNotIn(FieldWssEndpoint, vs...))
+}
+
+// WssEndpointGT applies the GT predicate on the "wss_endpoint" field.
+func WssEndpointGT(v string) predicate.Network {
+	return predicate.Network(sql.FieldGT(FieldWssEndpoint, v))
+}
+
+// WssEndpointGTE applies the GTE predicate on the "wss_endpoint" field.
+func WssEndpointGTE(v string) predicate.Network {
+	return predicate.Network(sql.FieldGTE(FieldWssEndpoint, v))
+}
+
+// WssEndpointLT applies the LT predicate on the "wss_endpoint" field.
+func WssEndpointLT(v string) predicate.Network {
+	return predicate.Network(sql.FieldLT(FieldWssEndpoint, v))
+}
+
+// WssEndpointLTE applies the LTE predicate on the "wss_endpoint" field.
+func WssEndpointLTE(v string) predicate.Network {
+	return predicate.Network(sql.FieldLTE(FieldWssEndpoint, v))
+}
+
+// WssEndpointContains applies the Contains predicate on the "wss_endpoint" field.
+func WssEndpointContains(v string) predicate.Network {
+	return predicate.Network(sql.FieldContains(FieldWssEndpoint, v))
+}
+
+// WssEndpointHasPrefix applies the HasPrefix predicate on the "wss_endpoint" field.
+func WssEndpointHasPrefix(v string) predicate.Network {
+	return predicate.Network(sql.FieldHasPrefix(FieldWssEndpoint, v))
+}
+
+// WssEndpointHasSuffix applies the HasSuffix predicate on the "wss_endpoint" field.
+func WssEndpointHasSuffix(v string) predicate.Network {
+	return predicate.Network(sql.FieldHasSuffix(FieldWssEndpoint, v))
+}
+
+// WssEndpointIsNil applies the IsNil predicate on the "wss_endpoint" field.
+func WssEndpointIsNil() predicate.Network {
+	return predicate.Network(sql.FieldIsNull(FieldWssEndpoint))
+}
+
+// WssEndpointNotNil applies the NotNil predicate on the "wss_endpoint" field.
+func WssEndpointNotNil() predicate.Network {
+	return predicate.Network(sql.FieldNotNull(FieldWssEndpoint))
+}
+
+// WssEndpointEqualFold applies the EqualFold predicate on the "wss_endpoint" field.
+func WssEndpointEqualFold(v string) predicate.Network {
+	return predicate.Network(sql.FieldEqualFold(FieldWssEndpoint, v))
+}
+
+// WssEndpointContainsFold applies the ContainsFold predicate on the "wss_endpoint" field.
+func WssEndpointContainsFold(v string) predicate.Network {
+	return predicate.Network(sql.FieldContainsFold(FieldWssEndpoint, v))
+}
+
 // GatewayContractAddressEQ applies the EQ predicate on the "gateway_contract_address" field.
 func GatewayContractAddressEQ(v string) predicate.Network {
 	return predicate.Network(sql.FieldEQ(FieldGatewayContractAddress, v))
@@ -426,6 +591,156 @@ func GatewayContractAddressContainsFold(v string) predicate.Network {
 	return predicate.Network(sql.FieldContainsFold(FieldGatewayContractAddress, v))
 }
 
+// ExplorerURLEQ applies the EQ predicate on the "explorer_url" field.
+func ExplorerURLEQ(v string) predicate.Network {
+	return predicate.Network(sql.FieldEQ(FieldExplorerURL, v))
+}
+
+// ExplorerURLNEQ applies the NEQ predicate on the "explorer_url" field.
+func ExplorerURLNEQ(v string) predicate.Network {
+	return predicate.Network(sql.FieldNEQ(FieldExplorerURL, v))
+}
+
+// ExplorerURLIn applies the In predicate on the "explorer_url" field.
+func ExplorerURLIn(vs ...string) predicate.Network {
+	return predicate.Network(sql.FieldIn(FieldExplorerURL, vs...))
+}
+
+// ExplorerURLNotIn applies the NotIn predicate on the "explorer_url" field.
+func ExplorerURLNotIn(vs ...string) predicate.Network {
+	return predicate.Network(sql.FieldNotIn(FieldExplorerURL, vs...))
+}
+
+// ExplorerURLGT applies the GT predicate on the "explorer_url" field.
+func ExplorerURLGT(v string) predicate.Network {
+	return predicate.Network(sql.FieldGT(FieldExplorerURL, v))
+}
+
+// ExplorerURLGTE applies the GTE predicate on the "explorer_url" field.
+func ExplorerURLGTE(v string) predicate.Network {
+	return predicate.Network(sql.FieldGTE(FieldExplorerURL, v))
+}
+
+// ExplorerURLLT applies the LT predicate on the "explorer_url" field.
+func ExplorerURLLT(v string) predicate.Network {
+	return predicate.Network(sql.FieldLT(FieldExplorerURL, v))
+}
+
+// ExplorerURLLTE applies the LTE predicate on the "explorer_url" field.
+func ExplorerURLLTE(v string) predicate.Network {
+	return predicate.Network(sql.FieldLTE(FieldExplorerURL, v))
+}
+
+// ExplorerURLContains applies the Contains predicate on the "explorer_url" field.
+func ExplorerURLContains(v string) predicate.Network {
+	return predicate.Network(sql.FieldContains(FieldExplorerURL, v))
+}
+
+// ExplorerURLHasPrefix applies the HasPrefix predicate on the "explorer_url" field.
+func ExplorerURLHasPrefix(v string) predicate.Network {
+	return predicate.Network(sql.FieldHasPrefix(FieldExplorerURL, v))
+}
+
+// ExplorerURLHasSuffix applies the HasSuffix predicate on the "explorer_url" field.
+func ExplorerURLHasSuffix(v string) predicate.Network {
+	return predicate.Network(sql.FieldHasSuffix(FieldExplorerURL, v))
+}
+
+// ExplorerURLIsNil applies the IsNil predicate on the "explorer_url" field.
+func ExplorerURLIsNil() predicate.Network {
+	return predicate.Network(sql.FieldIsNull(FieldExplorerURL))
+}
+
+// ExplorerURLNotNil applies the NotNil predicate on the "explorer_url" field.
+func ExplorerURLNotNil() predicate.Network {
+	return predicate.Network(sql.FieldNotNull(FieldExplorerURL))
+}
+
+// ExplorerURLEqualFold applies the EqualFold predicate on the "explorer_url" field.
+func ExplorerURLEqualFold(v string) predicate.Network {
+	return predicate.Network(sql.FieldEqualFold(FieldExplorerURL, v))
+}
+
+// ExplorerURLContainsFold applies the ContainsFold predicate on the "explorer_url" field.
+func ExplorerURLContainsFold(v string) predicate.Network {
+	return predicate.Network(sql.FieldContainsFold(FieldExplorerURL, v))
+}
+
+// AlchemyNetworkIDEQ applies the EQ predicate on the "alchemy_network_id" field.
+func AlchemyNetworkIDEQ(v string) predicate.Network {
+	return predicate.Network(sql.FieldEQ(FieldAlchemyNetworkID, v))
+}
+
+// AlchemyNetworkIDNEQ applies the NEQ predicate on the "alchemy_network_id" field.
+func AlchemyNetworkIDNEQ(v string) predicate.Network {
+	return predicate.Network(sql.FieldNEQ(FieldAlchemyNetworkID, v))
+}
+
+// AlchemyNetworkIDIn applies the In predicate on the "alchemy_network_id" field.
+func AlchemyNetworkIDIn(vs ...string) predicate.Network {
+	return predicate.Network(sql.FieldIn(FieldAlchemyNetworkID, vs...))
+}
+
+// AlchemyNetworkIDNotIn applies the NotIn predicate on the "alchemy_network_id" field.
+func AlchemyNetworkIDNotIn(vs ...string) predicate.Network {
+	return predicate.Network(sql.FieldNotIn(FieldAlchemyNetworkID, vs...))
+}
+
+// AlchemyNetworkIDGT applies the GT predicate on the "alchemy_network_id" field.
+func AlchemyNetworkIDGT(v string) predicate.Network {
+	return predicate.Network(sql.FieldGT(FieldAlchemyNetworkID, v))
+}
+
+// AlchemyNetworkIDGTE applies the GTE predicate on the "alchemy_network_id" field.
+func AlchemyNetworkIDGTE(v string) predicate.Network {
+	return predicate.Network(sql.FieldGTE(FieldAlchemyNetworkID, v))
+}
+
+// AlchemyNetworkIDLT applies the LT predicate on the "alchemy_network_id" field.
+func AlchemyNetworkIDLT(v string) predicate.Network {
+	return predicate.Network(sql.FieldLT(FieldAlchemyNetworkID, v))
+}
+
+// AlchemyNetworkIDLTE applies the LTE predicate on the "alchemy_network_id" field.
+func AlchemyNetworkIDLTE(v string) predicate.Network {
+	return predicate.Network(sql.FieldLTE(FieldAlchemyNetworkID, v))
+}
+
+// AlchemyNetworkIDContains applies the Contains predicate on the "alchemy_network_id" field.
+func AlchemyNetworkIDContains(v string) predicate.Network {
+	return predicate.Network(sql.FieldContains(FieldAlchemyNetworkID, v))
+}
+
+// AlchemyNetworkIDHasPrefix applies the HasPrefix predicate on the "alchemy_network_id" field.
+func AlchemyNetworkIDHasPrefix(v string) predicate.Network {
+	return predicate.Network(sql.FieldHasPrefix(FieldAlchemyNetworkID, v))
+}
+
+// AlchemyNetworkIDHasSuffix applies the HasSuffix predicate on the "alchemy_network_id" field.
+func AlchemyNetworkIDHasSuffix(v string) predicate.Network {
+	return predicate.Network(sql.FieldHasSuffix(FieldAlchemyNetworkID, v))
+}
+
+// AlchemyNetworkIDIsNil applies the IsNil predicate on the "alchemy_network_id" field.
+func AlchemyNetworkIDIsNil() predicate.Network {
+	return predicate.Network(sql.FieldIsNull(FieldAlchemyNetworkID))
+}
+
+// AlchemyNetworkIDNotNil applies the NotNil predicate on the "alchemy_network_id" field.
+func AlchemyNetworkIDNotNil() predicate.Network {
+	return predicate.Network(sql.FieldNotNull(FieldAlchemyNetworkID))
+}
+
+// AlchemyNetworkIDEqualFold applies the EqualFold predicate on the "alchemy_network_id" field.
+func AlchemyNetworkIDEqualFold(v string) predicate.Network {
+	return predicate.Network(sql.FieldEqualFold(FieldAlchemyNetworkID, v))
+}
+
+// AlchemyNetworkIDContainsFold applies the ContainsFold predicate on the "alchemy_network_id" field.
+func AlchemyNetworkIDContainsFold(v string) predicate.Network {
+	return predicate.Network(sql.FieldContainsFold(FieldAlchemyNetworkID, v))
+}
+
 // BlockTimeEQ applies the EQ predicate on the "block_time" field.
 func BlockTimeEQ(v decimal.Decimal) predicate.Network {
 	return predicate.Network(sql.FieldEQ(FieldBlockTime, v))
@@ -666,6 +981,461 @@ func FeeLTE(v decimal.Decimal) predicate.Network {
 	return predicate.Network(sql.FieldLTE(FieldFee, v))
 }
 
+// FinalityBlocksEQ applies the EQ predicate on the "finality_blocks" field.
+func FinalityBlocksEQ(v int) predicate.Network {
+	return predicate.Network(sql.FieldEQ(FieldFinalityBlocks, v))
+}
+
+// FinalityBlocksNEQ applies the NEQ predicate on the "finality_blocks" field.
+func FinalityBlocksNEQ(v int) predicate.Network {
+	return predicate.Network(sql.FieldNEQ(FieldFinalityBlocks, v))
+}
+
+// FinalityBlocksIn applies the In predicate on the "finality_blocks" field.
+func FinalityBlocksIn(vs ...int) predicate.Network {
+	return predicate.Network(sql.FieldIn(FieldFinalityBlocks, vs...))
+}
+
+// FinalityBlocksNotIn applies the NotIn predicate on the "finality_blocks" field.
+func FinalityBlocksNotIn(vs ...int) predicate.Network {
+	return predicate.Network(sql.FieldNotIn(FieldFinalityBlocks, vs...))
+}
+
+// FinalityBlocksGT applies the GT predicate on the "finality_blocks" field.
+func FinalityBlocksGT(v int) predicate.Network {
+	return predicate.Network(sql.FieldGT(FieldFinalityBlocks, v))
+}
+
+// FinalityBlocksGTE applies the GTE predicate on the "finality_blocks" field.
+func FinalityBlocksGTE(v int) predicate.Network {
+	return predicate.Network(sql.FieldGTE(FieldFinalityBlocks, v))
+}
+
+// FinalityBlocksLT applies the LT predicate on the "finality_blocks" field.
+func FinalityBlocksLT(v int) predicate.Network {
+	return predicate.Network(sql.FieldLT(FieldFinalityBlocks, v))
+}
+
+// FinalityBlocksLTE applies the LTE predicate on the "finality_blocks" field.
+func FinalityBlocksLTE(v int) predicate.Network {
+	return predicate.Network(sql.FieldLTE(FieldFinalityBlocks, v))
+}
+
+// RequiredConfirmationsEQ applies the EQ predicate on the "required_confirmations" field.
+func RequiredConfirmationsEQ(v int) predicate.Network {
+	return predicate.Network(sql.FieldEQ(FieldRequiredConfirmations, v))
+}
+
+// RequiredConfirmationsNEQ applies the NEQ predicate on the "required_confirmations" field.
+func RequiredConfirmationsNEQ(v int) predicate.Network {
+	return predicate.Network(sql.FieldNEQ(FieldRequiredConfirmations, v))
+}
+
+// RequiredConfirmationsIn applies the In predicate on the "required_confirmations" field.
+func RequiredConfirmationsIn(vs ...int) predicate.Network {
+	return predicate.Network(sql.FieldIn(FieldRequiredConfirmations, vs...))
+}
+
+// RequiredConfirmationsNotIn applies the NotIn predicate on the "required_confirmations" field.
+func RequiredConfirmationsNotIn(vs ...int) predicate.Network {
+	return predicate.Network(sql.FieldNotIn(FieldRequiredConfirmations, vs...))
+}
+
+// RequiredConfirmationsGT applies the GT predicate on the "required_confirmations" field.
+func RequiredConfirmationsGT(v int) predicate.Network {
+	return predicate.Network(sql.FieldGT(FieldRequiredConfirmations, v))
+}
+
+// RequiredConfirmationsGTE applies the GTE predicate on the "required_confirmations" field.
+func RequiredConfirmationsGTE(v int) predicate.Network {
+	return predicate.Network(sql.FieldGTE(FieldRequiredConfirmations, v))
+}
+
+// RequiredConfirmationsLT applies the LT predicate on the "required_confirmations" field.
+func RequiredConfirmationsLT(v int) predicate.Network {
+	return predicate.Network(sql.FieldLT(FieldRequiredConfirmations, v))
+}
+
+// RequiredConfirmationsLTE applies the LTE predicate on the "required_confirmations" field.
+func RequiredConfirmationsLTE(v int) predicate.Network {
+	return predicate.Network(sql.FieldLTE(FieldRequiredConfirmations, v))
+}
+
+// SettlementPolicyEQ applies the EQ predicate on the "settlement_policy" field.
+func SettlementPolicyEQ(v SettlementPolicy) predicate.Network {
+	return predicate.Network(sql.FieldEQ(FieldSettlementPolicy, v))
+}
+
+// SettlementPolicyNEQ applies the NEQ predicate on the "settlement_policy" field.
+func SettlementPolicyNEQ(v SettlementPolicy) predicate.Network {
+	return predicate.Network(sql.FieldNEQ(FieldSettlementPolicy, v))
+}
+
+// SettlementPolicyIn applies the In predicate on the "settlement_policy" field.
+func SettlementPolicyIn(vs ...SettlementPolicy) predicate.Network {
+	return predicate.Network(sql.FieldIn(FieldSettlementPolicy, vs...))
+}
+
+// SettlementPolicyNotIn applies the NotIn predicate on the "settlement_policy" field.
+func SettlementPolicyNotIn(vs ...SettlementPolicy) predicate.Network {
+	return predicate.Network(sql.FieldNotIn(FieldSettlementPolicy, vs...))
+}
+
+// OrderTTLMinutesEQ applies the EQ predicate on the "order_ttl_minutes" field.
+func OrderTTLMinutesEQ(v int) predicate.Network {
+	return predicate.Network(sql.FieldEQ(FieldOrderTTLMinutes, v))
+}
+
+// OrderTTLMinutesNEQ applies the NEQ predicate on the "order_ttl_minutes" field.
+func OrderTTLMinutesNEQ(v int) predicate.Network {
+	return predicate.Network(sql.FieldNEQ(FieldOrderTTLMinutes, v))
+}
+
+// OrderTTLMinutesIn applies the In predicate on the "order_ttl_minutes" field.
+func OrderTTLMinutesIn(vs ...int) predicate.Network {
+	return predicate.Network(sql.FieldIn(FieldOrderTTLMinutes, vs...))
+}
+
+// OrderTTLMinutesNotIn applies the NotIn predicate on the "order_ttl_minutes" field.
+func OrderTTLMinutesNotIn(vs ...int) predicate.Network {
+	return predicate.Network(sql.FieldNotIn(FieldOrderTTLMinutes, vs...))
+}
+
+// OrderTTLMinutesGT applies the GT predicate on the "order_ttl_minutes" field.
+func OrderTTLMinutesGT(v int) predicate.Network {
+	return predicate.Network(sql.FieldGT(FieldOrderTTLMinutes, v))
+}
+
+// OrderTTLMinutesGTE applies the GTE predicate on the "order_ttl_minutes" field.
+func OrderTTLMinutesGTE(v int) predicate.Network {
+	return predicate.Network(sql.FieldGTE(FieldOrderTTLMinutes, v))
+}
+
+// OrderTTLMinutesLT applies the LT predicate on the "order_ttl_minutes" field.
+func OrderTTLMinutesLT(v int) predicate.Network {
+	return predicate.Network(sql.FieldLT(FieldOrderTTLMinutes, v))
+}
+
+// OrderTTLMinutesLTE applies the LTE predicate on the "order_ttl_minutes" field.
+func OrderTTLMinutesLTE(v int) predicate.Network {
+	return predicate.Network(sql.FieldLTE(FieldOrderTTLMinutes, v))
+}
+
+// OrderTTLMinutesIsNil applies the IsNil predicate on the "order_ttl_minutes" field.
+func OrderTTLMinutesIsNil() predicate.Network {
+	return predicate.Network(sql.FieldIsNull(FieldOrderTTLMinutes))
+}
+
+// OrderTTLMinutesNotNil applies the NotNil predicate on the "order_ttl_minutes" field.
+func OrderTTLMinutesNotNil() predicate.Network {
+	return predicate.Network(sql.FieldNotNull(FieldOrderTTLMinutes))
+}
+
+// GenesisHashEQ applies the EQ predicate on the "genesis_hash" field.
+func GenesisHashEQ(v string) predicate.Network {
+	return predicate.Network(sql.FieldEQ(FieldGenesisHash, v))
+}
+
+// GenesisHashNEQ applies the NEQ predicate on the "genesis_hash" field.
+func GenesisHashNEQ(v string) predicate.Network {
+	return predicate.Network(sql.FieldNEQ(FieldGenesisHash, v))
+}
+
+// GenesisHashIn applies the In predicate on the "genesis_hash" field.
+func GenesisHashIn(vs ...string) predicate.Network {
+	return predicate.Network(sql.FieldIn(FieldGenesisHash, vs...))
+}
+
+// GenesisHashNotIn applies the NotIn predicate on the "genesis_hash" field.
+func GenesisHashNotIn(vs ...string) predicate.Network {
+	return predicate.Network(sql.FieldNotIn(FieldGenesisHash, vs...))
+}
+
+// GenesisHashGT applies the GT predicate on the "genesis_hash" field.
+func GenesisHashGT(v string) predicate.Network {
+	return predicate.Network(sql.FieldGT(FieldGenesisHash, v))
+}
+
+// GenesisHashGTE applies the GTE predicate on the "genesis_hash" field.
+func GenesisHashGTE(v string) predicate.Network {
+	return predicate.Network(sql.FieldGTE(FieldGenesisHash, v))
+}
+
+// GenesisHashLT applies the LT predicate on the "genesis_hash" field.
+func GenesisHashLT(v string) predicate.Network {
+	return predicate.Network(sql.FieldLT(FieldGenesisHash, v))
+}
+
+// GenesisHashLTE applies the LTE predicate on the "genesis_hash" field.
+func GenesisHashLTE(v string) predicate.Network {
+	return predicate.Network(sql.FieldLTE(FieldGenesisHash, v))
+}
+
+// GenesisHashContains applies the Contains predicate on the "genesis_hash" field.
+func GenesisHashContains(v string) predicate.Network {
+	return predicate.Network(sql.FieldContains(FieldGenesisHash, v))
+}
+
+// GenesisHashHasPrefix applies the HasPrefix predicate on the "genesis_hash" field.
+func GenesisHashHasPrefix(v string) predicate.Network {
+	return predicate.Network(sql.FieldHasPrefix(FieldGenesisHash, v))
+}
+
+// GenesisHashHasSuffix applies the HasSuffix predicate on the "genesis_hash" field.
+func GenesisHashHasSuffix(v string) predicate.Network {
+	return predicate.Network(sql.FieldHasSuffix(FieldGenesisHash, v))
+}
+
+// GenesisHashIsNil applies the IsNil predicate on the "genesis_hash" field.
+func GenesisHashIsNil() predicate.Network {
+	return predicate.Network(sql.FieldIsNull(FieldGenesisHash))
+}
+
+// GenesisHashNotNil applies the NotNil predicate on the "genesis_hash" field.
+func GenesisHashNotNil() predicate.Network {
+	return predicate.Network(sql.FieldNotNull(FieldGenesisHash))
+}
+
+// GenesisHashEqualFold applies the EqualFold predicate on the "genesis_hash" field.
+func GenesisHashEqualFold(v string) predicate.Network {
+	return predicate.Network(sql.FieldEqualFold(FieldGenesisHash, v))
+}
+
+// GenesisHashContainsFold applies the ContainsFold predicate on the "genesis_hash" field.
+func GenesisHashContainsFold(v string) predicate.Network {
+	return predicate.Network(sql.FieldContainsFold(FieldGenesisHash, v))
+}
+
+// GenesisMismatchEQ applies the EQ predicate on the "genesis_mismatch" field.
+func GenesisMismatchEQ(v bool) predicate.Network {
+	return predicate.Network(sql.FieldEQ(FieldGenesisMismatch, v))
+}
+
+// GenesisMismatchNEQ applies the NEQ predicate on the "genesis_mismatch" field.
+func GenesisMismatchNEQ(v bool) predicate.Network {
+	return predicate.Network(sql.FieldNEQ(FieldGenesisMismatch, v))
+}
+
+// SmartAccountOwnerAddressEQ applies the EQ predicate on the "smart_account_owner_address" field.
+func SmartAccountOwnerAddressEQ(v string) predicate.Network {
+	return predicate.Network(sql.FieldEQ(FieldSmartAccountOwnerAddress, v))
+}
+
+// SmartAccountOwnerAddressNEQ applies the NEQ predicate on the "smart_account_owner_address" field.
+func SmartAccountOwnerAddressNEQ(v string) predicate.Network {
+	return predicate.Network(sql.FieldNEQ(FieldSmartAccountOwnerAddress, v))
+}
+
+// SmartAccountOwnerAddressIn applies the In predicate on the "smart_account_owner_address" field.
+func SmartAccountOwnerAddressIn(vs ...string) predicate.Network {
+	return predicate.Network(sql.FieldIn(FieldSmartAccountOwnerAddress, vs...))
+}
+
+// SmartAccountOwnerAddressNotIn applies the NotIn predicate on the "smart_account_owner_address" field.
+func SmartAccountOwnerAddressNotIn(vs ...string) predicate.Network {
+	return predicate.Network(sql.FieldNotIn(FieldSmartAccountOwnerAddress, vs...))
+}
+
+// SmartAccountOwnerAddressGT applies the GT predicate on the "smart_account_owner_address" field.
+func SmartAccountOwnerAddressGT(v string) predicate.Network {
+	return predicate.Network(sql.FieldGT(FieldSmartAccountOwnerAddress, v))
+}
+
+// SmartAccountOwnerAddressGTE applies the GTE predicate on the "smart_account_owner_address" field.
+func SmartAccountOwnerAddressGTE(v string) predicate.Network {
+	return predicate.Network(sql.FieldGTE(FieldSmartAccountOwnerAddress, v))
+}
+
+// SmartAccountOwnerAddressLT applies the LT predicate on the "smart_account_owner_address" field.
+func SmartAccountOwnerAddressLT(v string) predicate.Network {
+	return predicate.Network(sql.FieldLT(FieldSmartAccountOwnerAddress, v))
+}
+
+// SmartAccountOwnerAddressLTE applies the LTE predicate on the "smart_account_owner_address" field.
+func SmartAccountOwnerAddressLTE(v string) predicate.Network {
+	return predicate.Network(sql.FieldLTE(FieldSmartAccountOwnerAddress, v))
+}
+
+// SmartAccountOwnerAddressContains applies the Contains predicate on the "smart_account_owner_address" field.
+func SmartAccountOwnerAddressContains(v string) predicate.Network {
+	return predicate.Network(sql.FieldContains(FieldSmartAccountOwnerAddress, v))
+}
+
+// SmartAccountOwnerAddressHasPrefix applies the HasPrefix predicate on the "smart_account_owner_address" field.
+func SmartAccountOwnerAddressHasPrefix(v string) predicate.Network {
+	return predicate.Network(sql.FieldHasPrefix(FieldSmartAccountOwnerAddress, v))
+}
+
+// SmartAccountOwnerAddressHasSuffix applies the HasSuffix predicate on the "smart_account_owner_address" field.
+func SmartAccountOwnerAddressHasSuffix(v string) predicate.Network {
+	return predicate.Network(sql.FieldHasSuffix(FieldSmartAccountOwnerAddress, v))
+}
+
+// SmartAccountOwnerAddressIsNil applies the IsNil predicate on the "smart_account_owner_address" field.
+func SmartAccountOwnerAddressIsNil() predicate.Network {
+	return predicate.Network(sql.FieldIsNull(FieldSmartAccountOwnerAddress))
+}
+
+// SmartAccountOwnerAddressNotNil applies the NotNil predicate on the "smart_account_owner_address" field.
+func SmartAccountOwnerAddressNotNil() predicate.Network {
+	return predicate.Network(sql.FieldNotNull(FieldSmartAccountOwnerAddress))
+}
+
+// SmartAccountOwnerAddressEqualFold applies the EqualFold predicate on the "smart_account_owner_address" field.
+func SmartAccountOwnerAddressEqualFold(v string) predicate.Network {
+	return predicate.Network(sql.FieldEqualFold(FieldSmartAccountOwnerAddress, v))
+}
+
+// SmartAccountOwnerAddressContainsFold applies the ContainsFold predicate on the "smart_account_owner_address" field.
+func SmartAccountOwnerAddressContainsFold(v string) predicate.Network {
+	return predicate.Network(sql.FieldContainsFold(FieldSmartAccountOwnerAddress, v))
+}
+
+// SmartAccountOwnerSignerEQ applies the EQ predicate on the "smart_account_owner_signer" field.
+func SmartAccountOwnerSignerEQ(v string) predicate.Network {
+	return predicate.Network(sql.FieldEQ(FieldSmartAccountOwnerSigner, v))
+}
+
+// SmartAccountOwnerSignerNEQ applies the NEQ predicate on the "smart_account_owner_signer" field.
+func SmartAccountOwnerSignerNEQ(v string) predicate.Network {
+	return predicate.Network(sql.FieldNEQ(FieldSmartAccountOwnerSigner, v))
+}
+
+// SmartAccountOwnerSignerIn applies the In predicate on the "smart_account_owner_signer" field.
+func SmartAccountOwnerSignerIn(vs ...string) predicate.Network {
+	return predicate.Network(sql.FieldIn(FieldSmartAccountOwnerSigner, vs...))
+}
+
+// SmartAccountOwnerSignerNotIn applies the NotIn predicate on the "smart_account_owner_signer" field.
+func SmartAccountOwnerSignerNotIn(vs ...string) predicate.Network {
+	return predicate.Network(sql.FieldNotIn(FieldSmartAccountOwnerSigner, vs...))
+}
+
+// SmartAccountOwnerSignerGT applies the GT predicate on the "smart_account_owner_signer" field.
+func SmartAccountOwnerSignerGT(v string) predicate.Network {
+	return predicate.Network(sql.FieldGT(FieldSmartAccountOwnerSigner, v))
+}
+
+// SmartAccountOwnerSignerGTE applies the GTE predicate on the "smart_account_owner_signer" field.
+func SmartAccountOwnerSignerGTE(v string) predicate.Network {
+	return predicate.Network(sql.FieldGTE(FieldSmartAccountOwnerSigner, v))
+}
+
+// SmartAccountOwnerSignerLT applies the LT predicate on the "smart_account_owner_signer" field.
+func SmartAccountOwnerSignerLT(v string) predicate.Network {
+	return predicate.Network(sql.FieldLT(FieldSmartAccountOwnerSigner, v))
+}
+
+// SmartAccountOwnerSignerLTE applies the LTE predicate on the "smart_account_owner_signer" field.
+func SmartAccountOwnerSignerLTE(v string) predicate.Network {
+	return predicate.Network(sql.FieldLTE(FieldSmartAccountOwnerSigner, v))
+}
+
+// SmartAccountOwnerSignerContains applies the Contains predicate on the "smart_account_owner_signer" field.
+func SmartAccountOwnerSignerContains(v string) predicate.Network {
+	return predicate.Network(sql.FieldContains(FieldSmartAccountOwnerSigner, v))
+}
+
+// SmartAccountOwnerSignerHasPrefix applies the HasPrefix predicate on the "smart_account_owner_signer" field.
+func SmartAccountOwnerSignerHasPrefix(v string) predicate.Network {
+	return predicate.Network(sql.FieldHasPrefix(FieldSmartAccountOwnerSigner, v))
+}
+
+// SmartAccountOwnerSignerHasSuffix applies the HasSuffix predicate on the "smart_account_owner_signer" field.
+func SmartAccountOwnerSignerHasSuffix(v string) predicate.Network {
+	return predicate.Network(sql.FieldHasSuffix(FieldSmartAccountOwnerSigner, v))
+}
+
+// SmartAccountOwnerSignerIsNil applies the IsNil predicate on the "smart_account_owner_signer" field.
+func SmartAccountOwnerSignerIsNil() predicate.Network {
+	return predicate.Network(sql.FieldIsNull(FieldSmartAccountOwnerSigner))
+}
+
+// SmartAccountOwnerSignerNotNil applies the NotNil predicate on the "smart_account_owner_signer" field.
+func SmartAccountOwnerSignerNotNil() predicate.Network {
+	return predicate.Network(sql.FieldNotNull(FieldSmartAccountOwnerSigner))
+}
+
+// SmartAccountOwnerSignerEqualFold applies the EqualFold predicate on the "smart_account_owner_signer" field.
+func SmartAccountOwnerSignerEqualFold(v string) predicate.Network {
+	return predicate.Network(sql.FieldEqualFold(FieldSmartAccountOwnerSigner, v))
+}
+
+// SmartAccountOwnerSignerContainsFold applies the ContainsFold predicate on the "smart_account_owner_signer" field.
+func SmartAccountOwnerSignerContainsFold(v string) predicate.Network {
+	return predicate.Network(sql.FieldContainsFold(FieldSmartAccountOwnerSigner, v))
+}
+
+// WebhooksEnabledEQ applies the EQ predicate on the "webhooks_enabled" field.
+func WebhooksEnabledEQ(v bool) predicate.Network {
+	return predicate.Network(sql.FieldEQ(FieldWebhooksEnabled, v))
+}
+
+// WebhooksEnabledNEQ applies the NEQ predicate on the "webhooks_enabled" field.
+func WebhooksEnabledNEQ(v bool) predicate.Network {
+	return predicate.Network(sql.FieldNEQ(FieldWebhooksEnabled, v))
+}
+
+// WebhooksEnabledIsNil applies the IsNil predicate on the "webhooks_enabled" field.
+func WebhooksEnabledIsNil() predicate.Network {
+	return predicate.Network(sql.FieldIsNull(FieldWebhooksEnabled))
+}
+
+// WebhooksEnabledNotNil applies the NotNil predicate on the "webhooks_enabled" field.
+func WebhooksEnabledNotNil() predicate.Network {
+	return predicate.Network(sql.FieldNotNull(FieldWebhooksEnabled))
+}
+
+// WebsocketEnabledEQ applies the EQ predicate on the "websocket_enabled" field.
+func WebsocketEnabledEQ(v bool) predicate.Network {
+	return predicate.Network(sql.FieldEQ(FieldWebsocketEnabled, v))
+}
+
+// WebsocketEnabledNEQ applies the NEQ predicate on the "websocket_enabled" field.
+func WebsocketEnabledNEQ(v bool) predicate.Network {
+	return predicate.Network(sql.FieldNEQ(FieldWebsocketEnabled, v))
+}
+
+// WebsocketEnabledIsNil applies the IsNil predicate on the "websocket_enabled" field.
+func WebsocketEnabledIsNil() predicate.Network {
+	return predicate.Network(sql.FieldIsNull(FieldWebsocketEnabled))
+}
+
+// WebsocketEnabledNotNil applies the NotNil predicate on the "websocket_enabled" field.
+func WebsocketEnabledNotNil() predicate.Network {
+	return predicate.Network(sql.FieldNotNull(FieldWebsocketEnabled))
+}
+
+// PollingEnabledEQ applies the EQ predicate on the "polling_enabled" field.
+func PollingEnabledEQ(v bool) predicate.Network {
+	return predicate.Network(sql.FieldEQ(FieldPollingEnabled, v))
+}
+
+// PollingEnabledNEQ applies the NEQ predicate on the "polling_enabled" field.
+func PollingEnabledNEQ(v bool) predicate.Network {
+	return predicate.Network(sql.FieldNEQ(FieldPollingEnabled, v))
+}
+
+// PollingEnabledIsNil applies the IsNil predicate on the "polling_enabled" field.
+func PollingEnabledIsNil() predicate.Network {
+	return predicate.Network(sql.FieldIsNull(FieldPollingEnabled))
+}
+
+// PollingEnabledNotNil applies the NotNil predicate on the "polling_enabled" field.
+func PollingEnabledNotNil() predicate.Network {
+	return predicate.Network(sql.FieldNotNull(FieldPollingEnabled))
+}
+
+// IsEnabledEQ applies the EQ predicate on the "is_enabled" field.
+func IsEnabledEQ(v bool) predicate.Network {
+	return predicate.Network(sql.FieldEQ(FieldIsEnabled, v))
+}
+
+// IsEnabledNEQ applies the NEQ predicate on the "is_enabled" field.
+func IsEnabledNEQ(v bool) predicate.Network {
+	return predicate.Network(sql.FieldNEQ(FieldIsEnabled, v))
+}
+
 // HasTokens applies the HasEdge predicate on the "tokens" edge.
 func HasTokens() predicate.Network {
 	return predicate.Network(func(s *sql.Selector) {
@@ -712,6 +1482,29 @@ func HasPaymentWebhookWith(preds ...predicate.PaymentWebhook) predicate.Network
 	})
 }
 
+// HasRPCEndpoints applies the HasEdge predicate on the "rpc_endpoints" edge.
+func HasRPCEndpoints() predicate.Network {
+	return predicate.Network(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RPCEndpointsTable, RPCEndpointsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRPCEndpointsWith applies the HasEdge predicate on the "rpc_endpoints" edge with a given conditions (other predicates).
+func HasRPCEndpointsWith(preds ...predicate.RPCEndpoint) predicate.Network {
+	return predicate.Network(func(s *sql.Selector) {
+		step := newRPCEndpointsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Network) predicate.Network {
 	return predicate.Network(sql.AndPredicates(predicates...))
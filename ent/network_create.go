@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/NEDA-LABS/stablenode/ent/network"
 	"github.com/NEDA-LABS/stablenode/ent/paymentwebhook"
+	"github.com/NEDA-LABS/stablenode/ent/rpcendpoint"
 	"github.com/NEDA-LABS/stablenode/ent/token"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
@@ -66,12 +67,40 @@ func (nc *NetworkCreate) SetIdentifier(s string) *NetworkCreate {
 	return nc
 }
 
+// SetNetworkType sets the "network_type" field.
+func (nc *NetworkCreate) SetNetworkType(nt network.NetworkType) *NetworkCreate {
+	nc.mutation.SetNetworkType(nt)
+	return nc
+}
+
+// SetNillableNetworkType sets the "network_type" field if the given value is not nil.
+func (nc *NetworkCreate) SetNillableNetworkType(nt *network.NetworkType) *NetworkCreate {
+	if nt != nil {
+		nc.SetNetworkType(*nt)
+	}
+	return nc
+}
+
 // SetRPCEndpoint sets the "rpc_endpoint" field.
 func (nc *NetworkCreate) SetRPCEndpoint(s string) *NetworkCreate {
 	nc.mutation.SetRPCEndpoint(s)
 	return nc
 }
 
+// SetWssEndpoint sets the "wss_endpoint" field.
+func (nc *NetworkCreate) SetWssEndpoint(s string) *NetworkCreate {
+	nc.mutation.SetWssEndpoint(s)
+	return nc
+}
+
+// SetNillableWssEndpoint sets the "wss_endpoint" field if the given value is not nil.
+func (nc *NetworkCreate) SetNillableWssEndpoint(s *string) *NetworkCreate {
+	if s != nil {
+		nc.SetWssEndpoint(*s)
+	}
+	return nc
+}
+
 // SetGatewayContractAddress sets the "gateway_contract_address" field.
 func (nc *NetworkCreate) SetGatewayContractAddress(s string) *NetworkCreate {
 	nc.mutation.SetGatewayContractAddress(s)
@@ -86,6 +115,34 @@ func (nc *NetworkCreate) SetNillableGatewayContractAddress(s *string) *NetworkCr
 	return nc
 }
 
+// SetExplorerURL sets the "explorer_url" field.
+func (nc *NetworkCreate) SetExplorerURL(s string) *NetworkCreate {
+	nc.mutation.SetExplorerURL(s)
+	return nc
+}
+
+// SetNillableExplorerURL sets the "explorer_url" field if the given value is not nil.
+func (nc *NetworkCreate) SetNillableExplorerURL(s *string) *NetworkCreate {
+	if s != nil {
+		nc.SetExplorerURL(*s)
+	}
+	return nc
+}
+
+// SetAlchemyNetworkID sets the "alchemy_network_id" field.
+func (nc *NetworkCreate) SetAlchemyNetworkID(s string) *NetworkCreate {
+	nc.mutation.SetAlchemyNetworkID(s)
+	return nc
+}
+
+// SetNillableAlchemyNetworkID sets the "alchemy_network_id" field if the given value is not nil.
+func (nc *NetworkCreate) SetNillableAlchemyNetworkID(s *string) *NetworkCreate {
+	if s != nil {
+		nc.SetAlchemyNetworkID(*s)
+	}
+	return nc
+}
+
 // SetBlockTime sets the "block_time" field.
 func (nc *NetworkCreate) SetBlockTime(d decimal.Decimal) *NetworkCreate {
 	nc.mutation.SetBlockTime(d)
@@ -132,6 +189,174 @@ func (nc *NetworkCreate) SetFee(d decimal.Decimal) *NetworkCreate {
 	return nc
 }
 
+// SetFinalityBlocks sets the "finality_blocks" field.
+func (nc *NetworkCreate) SetFinalityBlocks(i int) *NetworkCreate {
+	nc.mutation.SetFinalityBlocks(i)
+	return nc
+}
+
+// SetNillableFinalityBlocks sets the "finality_blocks" field if the given value is not nil.
+func (nc *NetworkCreate) SetNillableFinalityBlocks(i *int) *NetworkCreate {
+	if i != nil {
+		nc.SetFinalityBlocks(*i)
+	}
+	return nc
+}
+
+// SetRequiredConfirmations sets the "required_confirmations" field.
+func (nc *NetworkCreate) SetRequiredConfirmations(i int) *NetworkCreate {
+	nc.mutation.SetRequiredConfirmations(i)
+	return nc
+}
+
+// SetNillableRequiredConfirmations sets the "required_confirmations" field if the given value is not nil.
+func (nc *NetworkCreate) SetNillableRequiredConfirmations(i *int) *NetworkCreate {
+	if i != nil {
+		nc.SetRequiredConfirmations(*i)
+	}
+	return nc
+}
+
+// SetSettlementPolicy sets the "settlement_policy" field.
+func (nc *NetworkCreate) SetSettlementPolicy(np network.SettlementPolicy) *NetworkCreate {
+	nc.mutation.SetSettlementPolicy(np)
+	return nc
+}
+
+// SetNillableSettlementPolicy sets the "settlement_policy" field if the given value is not nil.
+func (nc *NetworkCreate) SetNillableSettlementPolicy(np *network.SettlementPolicy) *NetworkCreate {
+	if np != nil {
+		nc.SetSettlementPolicy(*np)
+	}
+	return nc
+}
+
+// SetOrderTTLMinutes sets the "order_ttl_minutes" field.
+func (nc *NetworkCreate) SetOrderTTLMinutes(i int) *NetworkCreate {
+	nc.mutation.SetOrderTTLMinutes(i)
+	return nc
+}
+
+// SetNillableOrderTTLMinutes sets the "order_ttl_minutes" field if the given value is not nil.
+func (nc *NetworkCreate) SetNillableOrderTTLMinutes(i *int) *NetworkCreate {
+	if i != nil {
+		nc.SetOrderTTLMinutes(*i)
+	}
+	return nc
+}
+
+// SetGenesisHash sets the "genesis_hash" field.
+func (nc *NetworkCreate) SetGenesisHash(s string) *NetworkCreate {
+	nc.mutation.SetGenesisHash(s)
+	return nc
+}
+
+// SetNillableGenesisHash sets the "genesis_hash" field if the given value is not nil.
+func (nc *NetworkCreate) SetNillableGenesisHash(s *string) *NetworkCreate {
+	if s != nil {
+		nc.SetGenesisHash(*s)
+	}
+	return nc
+}
+
+// SetGenesisMismatch sets the "genesis_mismatch" field.
+func (nc *NetworkCreate) SetGenesisMismatch(b bool) *NetworkCreate {
+	nc.mutation.SetGenesisMismatch(b)
+	return nc
+}
+
+// SetNillableGenesisMismatch sets the "genesis_mismatch" field if the given value is not nil.
+func (nc *NetworkCreate) SetNillableGenesisMismatch(b *bool) *NetworkCreate {
+	if b != nil {
+		nc.SetGenesisMismatch(*b)
+	}
+	return nc
+}
+
+// SetSmartAccountOwnerAddress sets the "smart_account_owner_address" field.
+func (nc *NetworkCreate) SetSmartAccountOwnerAddress(s string) *NetworkCreate {
+	nc.mutation.SetSmartAccountOwnerAddress(s)
+	return nc
+}
+
+// SetNillableSmartAccountOwnerAddress sets the "smart_account_owner_address" field if the given value is not nil.
+func (nc *NetworkCreate) SetNillableSmartAccountOwnerAddress(s *string) *NetworkCreate {
+	if s != nil {
+		nc.SetSmartAccountOwnerAddress(*s)
+	}
+	return nc
+}
+
+// SetSmartAccountOwnerSigner sets the "smart_account_owner_signer" field.
+func (nc *NetworkCreate) SetSmartAccountOwnerSigner(s string) *NetworkCreate {
+	nc.mutation.SetSmartAccountOwnerSigner(s)
+	return nc
+}
+
+// SetNillableSmartAccountOwnerSigner sets the "smart_account_owner_signer" field if the given value is not nil.
+func (nc *NetworkCreate) SetNillableSmartAccountOwnerSigner(s *string) *NetworkCreate {
+	if s != nil {
+		nc.SetSmartAccountOwnerSigner(*s)
+	}
+	return nc
+}
+
+// SetWebhooksEnabled sets the "webhooks_enabled" field.
+func (nc *NetworkCreate) SetWebhooksEnabled(b bool) *NetworkCreate {
+	nc.mutation.SetWebhooksEnabled(b)
+	return nc
+}
+
+// SetNillableWebhooksEnabled sets the "webhooks_enabled" field if the given value is not nil.
+func (nc *NetworkCreate) SetNillableWebhooksEnabled(b *bool) *NetworkCreate {
+	if b != nil {
+		nc.SetWebhooksEnabled(*b)
+	}
+	return nc
+}
+
+// SetWebsocketEnabled sets the "websocket_enabled" field.
+func (nc *NetworkCreate) SetWebsocketEnabled(b bool) *NetworkCreate {
+	nc.mutation.SetWebsocketEnabled(b)
+	return nc
+}
+
+// SetNillableWebsocketEnabled sets the "websocket_enabled" field if the given value is not nil.
+func (nc *NetworkCreate) SetNillableWebsocketEnabled(b *bool) *NetworkCreate {
+	if b != nil {
+		nc.SetWebsocketEnabled(*b)
+	}
+	return nc
+}
+
+// SetPollingEnabled sets the "polling_enabled" field.
+func (nc *NetworkCreate) SetPollingEnabled(b bool) *NetworkCreate {
+	nc.mutation.SetPollingEnabled(b)
+	return nc
+}
+
+// SetNillablePollingEnabled sets the "polling_enabled" field if the given value is not nil.
+func (nc *NetworkCreate) SetNillablePollingEnabled(b *bool) *NetworkCreate {
+	if b != nil {
+		nc.SetPollingEnabled(*b)
+	}
+	return nc
+}
+
+// SetIsEnabled sets the "is_enabled" field.
+func (nc *NetworkCreate) SetIsEnabled(b bool) *NetworkCreate {
+	nc.mutation.SetIsEnabled(b)
+	return nc
+}
+
+// SetNillableIsEnabled sets the "is_enabled" field if the given value is not nil.
+func (nc *NetworkCreate) SetNillableIsEnabled(b *bool) *NetworkCreate {
+	if b != nil {
+		nc.SetIsEnabled(*b)
+	}
+	return nc
+}
+
 // AddTokenIDs adds the "tokens" edge to the Token entity by IDs.
 func (nc *NetworkCreate) AddTokenIDs(ids ...int) *NetworkCreate {
 	nc.mutation.AddTokenIDs(ids...)
@@ -166,6 +391,21 @@ func (nc *NetworkCreate) SetPaymentWebhook(p *PaymentWebhook) *NetworkCreate {
 	return nc.SetPaymentWebhookID(p.ID)
 }
 
+// AddRPCEndpointIDs adds the "rpc_endpoints" edge to the RPCEndpoint entity by IDs.
+func (nc *NetworkCreate) AddRPCEndpointIDs(ids ...int) *NetworkCreate {
+	nc.mutation.AddRPCEndpointIDs(ids...)
+	return nc
+}
+
+// AddRPCEndpoints adds the "rpc_endpoints" edges to the RPCEndpoint entity.
+func (nc *NetworkCreate) AddRPCEndpoints(r ...*RPCEndpoint) *NetworkCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return nc.AddRPCEndpointIDs(ids...)
+}
+
 // Mutation returns the NetworkMutation object of the builder.
 func (nc *NetworkCreate) Mutation() *NetworkMutation {
 	return nc.mutation
@@ -209,10 +449,34 @@ func (nc *NetworkCreate) defaults() {
 		v := network.DefaultUpdatedAt()
 		nc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := nc.mutation.NetworkType(); !ok {
+		v := network.DefaultNetworkType
+		nc.mutation.SetNetworkType(v)
+	}
 	if _, ok := nc.mutation.GatewayContractAddress(); !ok {
 		v := network.DefaultGatewayContractAddress
 		nc.mutation.SetGatewayContractAddress(v)
 	}
+	if _, ok := nc.mutation.FinalityBlocks(); !ok {
+		v := network.DefaultFinalityBlocks
+		nc.mutation.SetFinalityBlocks(v)
+	}
+	if _, ok := nc.mutation.RequiredConfirmations(); !ok {
+		v := network.DefaultRequiredConfirmations
+		nc.mutation.SetRequiredConfirmations(v)
+	}
+	if _, ok := nc.mutation.SettlementPolicy(); !ok {
+		v := network.DefaultSettlementPolicy
+		nc.mutation.SetSettlementPolicy(v)
+	}
+	if _, ok := nc.mutation.GenesisMismatch(); !ok {
+		v := network.DefaultGenesisMismatch
+		nc.mutation.SetGenesisMismatch(v)
+	}
+	if _, ok := nc.mutation.IsEnabled(); !ok {
+		v := network.DefaultIsEnabled
+		nc.mutation.SetIsEnabled(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -229,6 +493,14 @@ func (nc *NetworkCreate) check() error {
 	if _, ok := nc.mutation.Identifier(); !ok {
 		return &ValidationError{Name: "identifier", err: errors.New(`ent: missing required field "Network.identifier"`)}
 	}
+	if _, ok := nc.mutation.NetworkType(); !ok {
+		return &ValidationError{Name: "network_type", err: errors.New(`ent: missing required field "Network.network_type"`)}
+	}
+	if v, ok := nc.mutation.NetworkType(); ok {
+		if err := network.NetworkTypeValidator(v); err != nil {
+			return &ValidationError{Name: "network_type", err: fmt.Errorf(`ent: validator failed for field "Network.network_type": %w`, err)}
+		}
+	}
 	if _, ok := nc.mutation.RPCEndpoint(); !ok {
 		return &ValidationError{Name: "rpc_endpoint", err: errors.New(`ent: missing required field "Network.rpc_endpoint"`)}
 	}
@@ -244,6 +516,41 @@ func (nc *NetworkCreate) check() error {
 	if _, ok := nc.mutation.Fee(); !ok {
 		return &ValidationError{Name: "fee", err: errors.New(`ent: missing required field "Network.fee"`)}
 	}
+	if _, ok := nc.mutation.FinalityBlocks(); !ok {
+		return &ValidationError{Name: "finality_blocks", err: errors.New(`ent: missing required field "Network.finality_blocks"`)}
+	}
+	if v, ok := nc.mutation.FinalityBlocks(); ok {
+		if err := network.FinalityBlocksValidator(v); err != nil {
+			return &ValidationError{Name: "finality_blocks", err: fmt.Errorf(`ent: validator failed for field "Network.finality_blocks": %w`, err)}
+		}
+	}
+	if _, ok := nc.mutation.RequiredConfirmations(); !ok {
+		return &ValidationError{Name: "required_confirmations", err: errors.New(`ent: missing required field "Network.required_confirmations"`)}
+	}
+	if v, ok := nc.mutation.RequiredConfirmations(); ok {
+		if err := network.RequiredConfirmationsValidator(v); err != nil {
+			return &ValidationError{Name: "required_confirmations", err: fmt.Errorf(`ent: validator failed for field "Network.required_confirmations": %w`, err)}
+		}
+	}
+	if _, ok := nc.mutation.SettlementPolicy(); !ok {
+		return &ValidationError{Name: "settlement_policy", err: errors.New(`ent: missing required field "Network.settlement_policy"`)}
+	}
+	if v, ok := nc.mutation.SettlementPolicy(); ok {
+		if err := network.SettlementPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "settlement_policy", err: fmt.Errorf(`ent: validator failed for field "Network.settlement_policy": %w`, err)}
+		}
+	}
+	if v, ok := nc.mutation.OrderTTLMinutes(); ok {
+		if err := network.OrderTTLMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "order_ttl_minutes", err: fmt.Errorf(`ent: validator failed for field "Network.order_ttl_minutes": %w`, err)}
+		}
+	}
+	if _, ok := nc.mutation.GenesisMismatch(); !ok {
+		return &ValidationError{Name: "genesis_mismatch", err: errors.New(`ent: missing required field "Network.genesis_mismatch"`)}
+	}
+	if _, ok := nc.mutation.IsEnabled(); !ok {
+		return &ValidationError{Name: "is_enabled", err: errors.New(`ent: missing required field "Network.is_enabled"`)}
+	}
 	return nil
 }
 
@@ -287,14 +594,30 @@ func (nc *NetworkCreate) createSpec() (*Network, *sqlgraph.CreateSpec) {
 		_spec.SetField(network.FieldIdentifier, field.TypeString, value)
 		_node.Identifier = value
 	}
+	if value, ok := nc.mutation.NetworkType(); ok {
+		_spec.SetField(network.FieldNetworkType, field.TypeEnum, value)
+		_node.NetworkType = value
+	}
 	if value, ok := nc.mutation.RPCEndpoint(); ok {
 		_spec.SetField(network.FieldRPCEndpoint, field.TypeString, value)
 		_node.RPCEndpoint = value
 	}
+	if value, ok := nc.mutation.WssEndpoint(); ok {
+		_spec.SetField(network.FieldWssEndpoint, field.TypeString, value)
+		_node.WssEndpoint = value
+	}
 	if value, ok := nc.mutation.GatewayContractAddress(); ok {
 		_spec.SetField(network.FieldGatewayContractAddress, field.TypeString, value)
 		_node.GatewayContractAddress = value
 	}
+	if value, ok := nc.mutation.ExplorerURL(); ok {
+		_spec.SetField(network.FieldExplorerURL, field.TypeString, value)
+		_node.ExplorerURL = value
+	}
+	if value, ok := nc.mutation.AlchemyNetworkID(); ok {
+		_spec.SetField(network.FieldAlchemyNetworkID, field.TypeString, value)
+		_node.AlchemyNetworkID = value
+	}
 	if value, ok := nc.mutation.BlockTime(); ok {
 		_spec.SetField(network.FieldBlockTime, field.TypeFloat64, value)
 		_node.BlockTime = value
@@ -315,6 +638,54 @@ func (nc *NetworkCreate) createSpec() (*Network, *sqlgraph.CreateSpec) {
 		_spec.SetField(network.FieldFee, field.TypeFloat64, value)
 		_node.Fee = value
 	}
+	if value, ok := nc.mutation.FinalityBlocks(); ok {
+		_spec.SetField(network.FieldFinalityBlocks, field.TypeInt, value)
+		_node.FinalityBlocks = value
+	}
+	if value, ok := nc.mutation.RequiredConfirmations(); ok {
+		_spec.SetField(network.FieldRequiredConfirmations, field.TypeInt, value)
+		_node.RequiredConfirmations = value
+	}
+	if value, ok := nc.mutation.SettlementPolicy(); ok {
+		_spec.SetField(network.FieldSettlementPolicy, field.TypeEnum, value)
+		_node.SettlementPolicy = value
+	}
+	if value, ok := nc.mutation.OrderTTLMinutes(); ok {
+		_spec.SetField(network.FieldOrderTTLMinutes, field.TypeInt, value)
+		_node.OrderTTLMinutes = &value
+	}
+	if value, ok := nc.mutation.GenesisHash(); ok {
+		_spec.SetField(network.FieldGenesisHash, field.TypeString, value)
+		_node.GenesisHash = value
+	}
+	if value, ok := nc.mutation.GenesisMismatch(); ok {
+		_spec.SetField(network.FieldGenesisMismatch, field.TypeBool, value)
+		_node.GenesisMismatch = value
+	}
+	if value, ok := nc.mutation.SmartAccountOwnerAddress(); ok {
+		_spec.SetField(network.FieldSmartAccountOwnerAddress, field.TypeString, value)
+		_node.SmartAccountOwnerAddress = value
+	}
+	if value, ok := nc.mutation.SmartAccountOwnerSigner(); ok {
+		_spec.SetField(network.FieldSmartAccountOwnerSigner, field.TypeString, value)
+		_node.SmartAccountOwnerSigner = value
+	}
+	if value, ok := nc.mutation.WebhooksEnabled(); ok {
+		_spec.SetField(network.FieldWebhooksEnabled, field.TypeBool, value)
+		_node.WebhooksEnabled = &value
+	}
+	if value, ok := nc.mutation.WebsocketEnabled(); ok {
+		_spec.SetField(network.FieldWebsocketEnabled, field.TypeBool, value)
+		_node.WebsocketEnabled = &value
+	}
+	if value, ok := nc.mutation.PollingEnabled(); ok {
+		_spec.SetField(network.FieldPollingEnabled, field.TypeBool, value)
+		_node.PollingEnabled = &value
+	}
+	if value, ok := nc.mutation.IsEnabled(); ok {
+		_spec.SetField(network.FieldIsEnabled, field.TypeBool, value)
+		_node.IsEnabled = value
+	}
 	if nodes := nc.mutation.TokensIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -347,6 +718,22 @@ func (nc *NetworkCreate) createSpec() (*Network, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := nc.mutation.RPCEndpointsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   network.RPCEndpointsTable,
+			Columns: []string{network.RPCEndpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rpcendpoint.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -441,6 +828,18 @@ func (u *NetworkUpsert) UpdateIdentifier() *NetworkUpsert {
 	return u
 }
 
+// SetNetworkType sets the "network_type" field.
+func (u *NetworkUpsert) SetNetworkType(v network.NetworkType) *NetworkUpsert {
+	u.Set(network.FieldNetworkType, v)
+	return u
+}
+
+// UpdateNetworkType sets the "network_type" field to the value that was provided on create.
+func (u *NetworkUpsert) UpdateNetworkType() *NetworkUpsert {
+	u.SetExcluded(network.FieldNetworkType)
+	return u
+}
+
 // SetRPCEndpoint sets the "rpc_endpoint" field.
 func (u *NetworkUpsert) SetRPCEndpoint(v string) *NetworkUpsert {
 	u.Set(network.FieldRPCEndpoint, v)
@@ -453,6 +852,24 @@ func (u *NetworkUpsert) UpdateRPCEndpoint() *NetworkUpsert {
 	return u
 }
 
+// SetWssEndpoint sets the "wss_endpoint" field.
+func (u *NetworkUpsert) SetWssEndpoint(v string) *NetworkUpsert {
+	u.Set(network.FieldWssEndpoint, v)
+	return u
+}
+
+// UpdateWssEndpoint sets the "wss_endpoint" field to the value that was provided on create.
+func (u *NetworkUpsert) UpdateWssEndpoint() *NetworkUpsert {
+	u.SetExcluded(network.FieldWssEndpoint)
+	return u
+}
+
+// ClearWssEndpoint clears the value of the "wss_endpoint" field.
+func (u *NetworkUpsert) ClearWssEndpoint() *NetworkUpsert {
+	u.SetNull(network.FieldWssEndpoint)
+	return u
+}
+
 // SetGatewayContractAddress sets the "gateway_contract_address" field.
 func (u *NetworkUpsert) SetGatewayContractAddress(v string) *NetworkUpsert {
 	u.Set(network.FieldGatewayContractAddress, v)
@@ -465,6 +882,42 @@ func (u *NetworkUpsert) UpdateGatewayContractAddress() *NetworkUpsert {
 	return u
 }
 
+// SetExplorerURL sets the "explorer_url" field.
+func (u *NetworkUpsert) SetExplorerURL(v string) *NetworkUpsert {
+	u.Set(network.FieldExplorerURL, v)
+	return u
+}
+
+// UpdateExplorerURL sets the "explorer_url" field to the value that was provided on create.
+func (u *NetworkUpsert) UpdateExplorerURL() *NetworkUpsert {
+	u.SetExcluded(network.FieldExplorerURL)
+	return u
+}
+
+// ClearExplorerURL clears the value of the "explorer_url" field.
+func (u *NetworkUpsert) ClearExplorerURL() *NetworkUpsert {
+	u.SetNull(network.FieldExplorerURL)
+	return u
+}
+
+// SetAlchemyNetworkID sets the "alchemy_network_id" field.
+func (u *NetworkUpsert) SetAlchemyNetworkID(v string) *NetworkUpsert {
+	u.Set(network.FieldAlchemyNetworkID, v)
+	return u
+}
+
+// UpdateAlchemyNetworkID sets the "alchemy_network_id" field to the value that was provided on create.
+func (u *NetworkUpsert) UpdateAlchemyNetworkID() *NetworkUpsert {
+	u.SetExcluded(network.FieldAlchemyNetworkID)
+	return u
+}
+
+// ClearAlchemyNetworkID clears the value of the "alchemy_network_id" field.
+func (u *NetworkUpsert) ClearAlchemyNetworkID() *NetworkUpsert {
+	u.SetNull(network.FieldAlchemyNetworkID)
+	return u
+}
+
 // SetBlockTime sets the "block_time" field.
 func (u *NetworkUpsert) SetBlockTime(v decimal.Decimal) *NetworkUpsert {
 	u.Set(network.FieldBlockTime, v)
@@ -549,69 +1002,273 @@ func (u *NetworkUpsert) AddFee(v decimal.Decimal) *NetworkUpsert {
 	return u
 }
 
-// UpdateNewValues updates the mutable fields using the new values that were set on create.
-// Using this option is equivalent to using:
-//
-//	client.Network.Create().
-//		OnConflict(
-//			sql.ResolveWithNewValues(),
-//		).
-//		Exec(ctx)
-func (u *NetworkUpsertOne) UpdateNewValues() *NetworkUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
-	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
-		if _, exists := u.create.mutation.CreatedAt(); exists {
-			s.SetIgnore(network.FieldCreatedAt)
-		}
-	}))
+// SetFinalityBlocks sets the "finality_blocks" field.
+func (u *NetworkUpsert) SetFinalityBlocks(v int) *NetworkUpsert {
+	u.Set(network.FieldFinalityBlocks, v)
 	return u
 }
 
-// Ignore sets each column to itself in case of conflict.
-// Using this option is equivalent to using:
-//
-//	client.Network.Create().
-//	    OnConflict(sql.ResolveWithIgnore()).
-//	    Exec(ctx)
-func (u *NetworkUpsertOne) Ignore() *NetworkUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+// UpdateFinalityBlocks sets the "finality_blocks" field to the value that was provided on create.
+func (u *NetworkUpsert) UpdateFinalityBlocks() *NetworkUpsert {
+	u.SetExcluded(network.FieldFinalityBlocks)
 	return u
 }
 
-// DoNothing configures the conflict_action to `DO NOTHING`.
-// Supported only by SQLite and PostgreSQL.
-func (u *NetworkUpsertOne) DoNothing() *NetworkUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+// AddFinalityBlocks adds v to the "finality_blocks" field.
+func (u *NetworkUpsert) AddFinalityBlocks(v int) *NetworkUpsert {
+	u.Add(network.FieldFinalityBlocks, v)
 	return u
 }
 
-// Update allows overriding fields `UPDATE` values. See the NetworkCreate.OnConflict
-// documentation for more info.
-func (u *NetworkUpsertOne) Update(set func(*NetworkUpsert)) *NetworkUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
-		set(&NetworkUpsert{UpdateSet: update})
-	}))
+// SetRequiredConfirmations sets the "required_confirmations" field.
+func (u *NetworkUpsert) SetRequiredConfirmations(v int) *NetworkUpsert {
+	u.Set(network.FieldRequiredConfirmations, v)
 	return u
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (u *NetworkUpsertOne) SetUpdatedAt(v time.Time) *NetworkUpsertOne {
-	return u.Update(func(s *NetworkUpsert) {
-		s.SetUpdatedAt(v)
-	})
+// UpdateRequiredConfirmations sets the "required_confirmations" field to the value that was provided on create.
+func (u *NetworkUpsert) UpdateRequiredConfirmations() *NetworkUpsert {
+	u.SetExcluded(network.FieldRequiredConfirmations)
+	return u
 }
 
-// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
-func (u *NetworkUpsertOne) UpdateUpdatedAt() *NetworkUpsertOne {
-	return u.Update(func(s *NetworkUpsert) {
-		s.UpdateUpdatedAt()
-	})
+// AddRequiredConfirmations adds v to the "required_confirmations" field.
+func (u *NetworkUpsert) AddRequiredConfirmations(v int) *NetworkUpsert {
+	u.Add(network.FieldRequiredConfirmations, v)
+	return u
 }
 
-// SetChainID sets the "chain_id" field.
-func (u *NetworkUpsertOne) SetChainID(v int64) *NetworkUpsertOne {
-	return u.Update(func(s *NetworkUpsert) {
-		s.SetChainID(v)
+// SetSettlementPolicy sets the "settlement_policy" field.
+func (u *NetworkUpsert) SetSettlementPolicy(v network.SettlementPolicy) *NetworkUpsert {
+	u.Set(network.FieldSettlementPolicy, v)
+	return u
+}
+
+// UpdateSettlementPolicy sets the "settlement_policy" field to the value that was provided on create.
+func (u *NetworkUpsert) UpdateSettlementPolicy() *NetworkUpsert {
+	u.SetExcluded(network.FieldSettlementPolicy)
+	return u
+}
+
+// SetOrderTTLMinutes sets the "order_ttl_minutes" field.
+func (u *NetworkUpsert) SetOrderTTLMinutes(v int) *NetworkUpsert {
+	u.Set(network.FieldOrderTTLMinutes, v)
+	return u
+}
+
+// UpdateOrderTTLMinutes sets the "order_ttl_minutes" field to the value that was provided on create.
+func (u *NetworkUpsert) UpdateOrderTTLMinutes() *NetworkUpsert {
+	u.SetExcluded(network.FieldOrderTTLMinutes)
+	return u
+}
+
+// AddOrderTTLMinutes adds v to the "order_ttl_minutes" field.
+func (u *NetworkUpsert) AddOrderTTLMinutes(v int) *NetworkUpsert {
+	u.Add(network.FieldOrderTTLMinutes, v)
+	return u
+}
+
+// ClearOrderTTLMinutes clears the value of the "order_ttl_minutes" field.
+func (u *NetworkUpsert) ClearOrderTTLMinutes() *NetworkUpsert {
+	u.SetNull(network.FieldOrderTTLMinutes)
+	return u
+}
+
+// SetGenesisHash sets the "genesis_hash" field.
+func (u *NetworkUpsert) SetGenesisHash(v string) *NetworkUpsert {
+	u.Set(network.FieldGenesisHash, v)
+	return u
+}
+
+// UpdateGenesisHash sets the "genesis_hash" field to the value that was provided on create.
+func (u *NetworkUpsert) UpdateGenesisHash() *NetworkUpsert {
+	u.SetExcluded(network.FieldGenesisHash)
+	return u
+}
+
+// ClearGenesisHash clears the value of the "genesis_hash" field.
+func (u *NetworkUpsert) ClearGenesisHash() *NetworkUpsert {
+	u.SetNull(network.FieldGenesisHash)
+	return u
+}
+
+// SetGenesisMismatch sets the "genesis_mismatch" field.
+func (u *NetworkUpsert) SetGenesisMismatch(v bool) *NetworkUpsert {
+	u.Set(network.FieldGenesisMismatch, v)
+	return u
+}
+
+// UpdateGenesisMismatch sets the "genesis_mismatch" field to the value that was provided on create.
+func (u *NetworkUpsert) UpdateGenesisMismatch() *NetworkUpsert {
+	u.SetExcluded(network.FieldGenesisMismatch)
+	return u
+}
+
+// SetSmartAccountOwnerAddress sets the "smart_account_owner_address" field.
+func (u *NetworkUpsert) SetSmartAccountOwnerAddress(v string) *NetworkUpsert {
+	u.Set(network.FieldSmartAccountOwnerAddress, v)
+	return u
+}
+
+// UpdateSmartAccountOwnerAddress sets the "smart_account_owner_address" field to the value that was provided on create.
+func (u *NetworkUpsert) UpdateSmartAccountOwnerAddress() *NetworkUpsert {
+	u.SetExcluded(network.FieldSmartAccountOwnerAddress)
+	return u
+}
+
+// ClearSmartAccountOwnerAddress clears the value of the "smart_account_owner_address" field.
+func (u *NetworkUpsert) ClearSmartAccountOwnerAddress() *NetworkUpsert {
+	u.SetNull(network.FieldSmartAccountOwnerAddress)
+	return u
+}
+
+// SetSmartAccountOwnerSigner sets the "smart_account_owner_signer" field.
+func (u *NetworkUpsert) SetSmartAccountOwnerSigner(v string) *NetworkUpsert {
+	u.Set(network.FieldSmartAccountOwnerSigner, v)
+	return u
+}
+
+// UpdateSmartAccountOwnerSigner sets the "smart_account_owner_signer" field to the value that was provided on create.
+func (u *NetworkUpsert) UpdateSmartAccountOwnerSigner() *NetworkUpsert {
+	u.SetExcluded(network.FieldSmartAccountOwnerSigner)
+	return u
+}
+
+// ClearSmartAccountOwnerSigner clears the value of the "smart_account_owner_signer" field.
+func (u *NetworkUpsert) ClearSmartAccountOwnerSigner() *NetworkUpsert {
+	u.SetNull(network.FieldSmartAccountOwnerSigner)
+	return u
+}
+
+// SetWebhooksEnabled sets the "webhooks_enabled" field.
+func (u *NetworkUpsert) SetWebhooksEnabled(v bool) *NetworkUpsert {
+	u.Set(network.FieldWebhooksEnabled, v)
+	return u
+}
+
+// UpdateWebhooksEnabled sets the "webhooks_enabled" field to the value that was provided on create.
+func (u *NetworkUpsert) UpdateWebhooksEnabled() *NetworkUpsert {
+	u.SetExcluded(network.FieldWebhooksEnabled)
+	return u
+}
+
+// ClearWebhooksEnabled clears the value of the "webhooks_enabled" field.
+func (u *NetworkUpsert) ClearWebhooksEnabled() *NetworkUpsert {
+	u.SetNull(network.FieldWebhooksEnabled)
+	return u
+}
+
+// SetWebsocketEnabled sets the "websocket_enabled" field.
+func (u *NetworkUpsert) SetWebsocketEnabled(v bool) *NetworkUpsert {
+	u.Set(network.FieldWebsocketEnabled, v)
+	return u
+}
+
+// UpdateWebsocketEnabled sets the "websocket_enabled" field to the value that was provided on create.
+func (u *NetworkUpsert) UpdateWebsocketEnabled() *NetworkUpsert {
+	u.SetExcluded(network.FieldWebsocketEnabled)
+	return u
+}
+
+// ClearWebsocketEnabled clears the value of the "websocket_enabled" field.
+func (u *NetworkUpsert) ClearWebsocketEnabled() *NetworkUpsert {
+	u.SetNull(network.FieldWebsocketEnabled)
+	return u
+}
+
+// SetPollingEnabled sets the "polling_enabled" field.
+func (u *NetworkUpsert) SetPollingEnabled(v bool) *NetworkUpsert {
+	u.Set(network.FieldPollingEnabled, v)
+	return u
+}
+
+// UpdatePollingEnabled sets the "polling_enabled" field to the value that was provided on create.
+func (u *NetworkUpsert) UpdatePollingEnabled() *NetworkUpsert {
+	u.SetExcluded(network.FieldPollingEnabled)
+	return u
+}
+
+// ClearPollingEnabled clears the value of the "polling_enabled" field.
+func (u *NetworkUpsert) ClearPollingEnabled() *NetworkUpsert {
+	u.SetNull(network.FieldPollingEnabled)
+	return u
+}
+
+// SetIsEnabled sets the "is_enabled" field.
+func (u *NetworkUpsert) SetIsEnabled(v bool) *NetworkUpsert {
+	u.Set(network.FieldIsEnabled, v)
+	return u
+}
+
+// UpdateIsEnabled sets the "is_enabled" field to the value that was provided on create.
+func (u *NetworkUpsert) UpdateIsEnabled() *NetworkUpsert {
+	u.SetExcluded(network.FieldIsEnabled)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Network.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *NetworkUpsertOne) UpdateNewValues() *NetworkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(network.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Network.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *NetworkUpsertOne) Ignore() *NetworkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NetworkUpsertOne) DoNothing() *NetworkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NetworkCreate.OnConflict
+// documentation for more info.
+func (u *NetworkUpsertOne) Update(set func(*NetworkUpsert)) *NetworkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NetworkUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *NetworkUpsertOne) SetUpdatedAt(v time.Time) *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *NetworkUpsertOne) UpdateUpdatedAt() *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetChainID sets the "chain_id" field.
+func (u *NetworkUpsertOne) SetChainID(v int64) *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.SetChainID(v)
 	})
 }
 
@@ -643,6 +1300,20 @@ func (u *NetworkUpsertOne) UpdateIdentifier() *NetworkUpsertOne {
 	})
 }
 
+// SetNetworkType sets the "network_type" field.
+func (u *NetworkUpsertOne) SetNetworkType(v network.NetworkType) *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.SetNetworkType(v)
+	})
+}
+
+// UpdateNetworkType sets the "network_type" field to the value that was provided on create.
+func (u *NetworkUpsertOne) UpdateNetworkType() *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.UpdateNetworkType()
+	})
+}
+
 // SetRPCEndpoint sets the "rpc_endpoint" field.
 func (u *NetworkUpsertOne) SetRPCEndpoint(v string) *NetworkUpsertOne {
 	return u.Update(func(s *NetworkUpsert) {
@@ -657,6 +1328,27 @@ func (u *NetworkUpsertOne) UpdateRPCEndpoint() *NetworkUpsertOne {
 	})
 }
 
+// SetWssEndpoint sets the "wss_endpoint" field.
+func (u *NetworkUpsertOne) SetWssEndpoint(v string) *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.SetWssEndpoint(v)
+	})
+}
+
+// UpdateWssEndpoint sets the "wss_endpoint" field to the value that was provided on create.
+func (u *NetworkUpsertOne) UpdateWssEndpoint() *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.UpdateWssEndpoint()
+	})
+}
+
+// ClearWssEndpoint clears the value of the "wss_endpoint" field.
+func (u *NetworkUpsertOne) ClearWssEndpoint() *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.ClearWssEndpoint()
+	})
+}
+
 // SetGatewayContractAddress sets the "gateway_contract_address" field.
 func (u *NetworkUpsertOne) SetGatewayContractAddress(v string) *NetworkUpsertOne {
 	return u.Update(func(s *NetworkUpsert) {
@@ -671,6 +1363,48 @@ func (u *NetworkUpsertOne) UpdateGatewayContractAddress() *NetworkUpsertOne {
 	})
 }
 
+// SetExplorerURL sets the "explorer_url" field.
+func (u *NetworkUpsertOne) SetExplorerURL(v string) *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.SetExplorerURL(v)
+	})
+}
+
+// UpdateExplorerURL sets the "explorer_url" field to the value that was provided on create.
+func (u *NetworkUpsertOne) UpdateExplorerURL() *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.UpdateExplorerURL()
+	})
+}
+
+// ClearExplorerURL clears the value of the "explorer_url" field.
+func (u *NetworkUpsertOne) ClearExplorerURL() *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.ClearExplorerURL()
+	})
+}
+
+// SetAlchemyNetworkID sets the "alchemy_network_id" field.
+func (u *NetworkUpsertOne) SetAlchemyNetworkID(v string) *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.SetAlchemyNetworkID(v)
+	})
+}
+
+// UpdateAlchemyNetworkID sets the "alchemy_network_id" field to the value that was provided on create.
+func (u *NetworkUpsertOne) UpdateAlchemyNetworkID() *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.UpdateAlchemyNetworkID()
+	})
+}
+
+// ClearAlchemyNetworkID clears the value of the "alchemy_network_id" field.
+func (u *NetworkUpsertOne) ClearAlchemyNetworkID() *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.ClearAlchemyNetworkID()
+	})
+}
+
 // SetBlockTime sets the "block_time" field.
 func (u *NetworkUpsertOne) SetBlockTime(v decimal.Decimal) *NetworkUpsertOne {
 	return u.Update(func(s *NetworkUpsert) {
@@ -769,6 +1503,244 @@ func (u *NetworkUpsertOne) UpdateFee() *NetworkUpsertOne {
 	})
 }
 
+// SetFinalityBlocks sets the "finality_blocks" field.
+func (u *NetworkUpsertOne) SetFinalityBlocks(v int) *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.SetFinalityBlocks(v)
+	})
+}
+
+// AddFinalityBlocks adds v to the "finality_blocks" field.
+func (u *NetworkUpsertOne) AddFinalityBlocks(v int) *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.AddFinalityBlocks(v)
+	})
+}
+
+// UpdateFinalityBlocks sets the "finality_blocks" field to the value that was provided on create.
+func (u *NetworkUpsertOne) UpdateFinalityBlocks() *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.UpdateFinalityBlocks()
+	})
+}
+
+// SetRequiredConfirmations sets the "required_confirmations" field.
+func (u *NetworkUpsertOne) SetRequiredConfirmations(v int) *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.SetRequiredConfirmations(v)
+	})
+}
+
+// AddRequiredConfirmations adds v to the "required_confirmations" field.
+func (u *NetworkUpsertOne) AddRequiredConfirmations(v int) *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.AddRequiredConfirmations(v)
+	})
+}
+
+// UpdateRequiredConfirmations sets the "required_confirmations" field to the value that was provided on create.
+func (u *NetworkUpsertOne) UpdateRequiredConfirmations() *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.UpdateRequiredConfirmations()
+	})
+}
+
+// SetSettlementPolicy sets the "settlement_policy" field.
+func (u *NetworkUpsertOne) SetSettlementPolicy(v network.SettlementPolicy) *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.SetSettlementPolicy(v)
+	})
+}
+
+// UpdateSettlementPolicy sets the "settlement_policy" field to the value that was provided on create.
+func (u *NetworkUpsertOne) UpdateSettlementPolicy() *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.UpdateSettlementPolicy()
+	})
+}
+
+// SetOrderTTLMinutes sets the "order_ttl_minutes" field.
+func (u *NetworkUpsertOne) SetOrderTTLMinutes(v int) *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.SetOrderTTLMinutes(v)
+	})
+}
+
+// AddOrderTTLMinutes adds v to the "order_ttl_minutes" field.
+func (u *NetworkUpsertOne) AddOrderTTLMinutes(v int) *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.AddOrderTTLMinutes(v)
+	})
+}
+
+// UpdateOrderTTLMinutes sets the "order_ttl_minutes" field to the value that was provided on create.
+func (u *NetworkUpsertOne) UpdateOrderTTLMinutes() *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.UpdateOrderTTLMinutes()
+	})
+}
+
+// ClearOrderTTLMinutes clears the value of the "order_ttl_minutes" field.
+func (u *NetworkUpsertOne) ClearOrderTTLMinutes() *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.ClearOrderTTLMinutes()
+	})
+}
+
+// SetGenesisHash sets the "genesis_hash" field.
+func (u *NetworkUpsertOne) SetGenesisHash(v string) *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.SetGenesisHash(v)
+	})
+}
+
+// UpdateGenesisHash sets the "genesis_hash" field to the value that was provided on create.
+func (u *NetworkUpsertOne) UpdateGenesisHash() *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.UpdateGenesisHash()
+	})
+}
+
+// ClearGenesisHash clears the value of the "genesis_hash" field.
+func (u *NetworkUpsertOne) ClearGenesisHash() *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.ClearGenesisHash()
+	})
+}
+
+// SetGenesisMismatch sets the "genesis_mismatch" field.
+func (u *NetworkUpsertOne) SetGenesisMismatch(v bool) *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.SetGenesisMismatch(v)
+	})
+}
+
+// UpdateGenesisMismatch sets the "genesis_mismatch" field to the value that was provided on create.
+func (u *NetworkUpsertOne) UpdateGenesisMismatch() *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.UpdateGenesisMismatch()
+	})
+}
+
+// SetSmartAccountOwnerAddress sets the "smart_account_owner_address" field.
+func (u *NetworkUpsertOne) SetSmartAccountOwnerAddress(v string) *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.SetSmartAccountOwnerAddress(v)
+	})
+}
+
+// UpdateSmartAccountOwnerAddress sets the "smart_account_owner_address" field to the value that was provided on create.
+func (u *NetworkUpsertOne) UpdateSmartAccountOwnerAddress() *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.UpdateSmartAccountOwnerAddress()
+	})
+}
+
+// ClearSmartAccountOwnerAddress clears the value of the "smart_account_owner_address" field.
+func (u *NetworkUpsertOne) ClearSmartAccountOwnerAddress() *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.ClearSmartAccountOwnerAddress()
+	})
+}
+
+// SetSmartAccountOwnerSigner sets the "smart_account_owner_signer" field.
+func (u *NetworkUpsertOne) SetSmartAccountOwnerSigner(v string) *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.SetSmartAccountOwnerSigner(v)
+	})
+}
+
+// UpdateSmartAccountOwnerSigner sets the "smart_account_owner_signer" field to the value that was provided on create.
+func (u *NetworkUpsertOne) UpdateSmartAccountOwnerSigner() *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.UpdateSmartAccountOwnerSigner()
+	})
+}
+
+// ClearSmartAccountOwnerSigner clears the value of the "smart_account_owner_signer" field.
+func (u *NetworkUpsertOne) ClearSmartAccountOwnerSigner() *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.ClearSmartAccountOwnerSigner()
+	})
+}
+
+// SetWebhooksEnabled sets the "webhooks_enabled" field.
+func (u *NetworkUpsertOne) SetWebhooksEnabled(v bool) *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.SetWebhooksEnabled(v)
+	})
+}
+
+// UpdateWebhooksEnabled sets the "webhooks_enabled" field to the value that was provided on create.
+func (u *NetworkUpsertOne) UpdateWebhooksEnabled() *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.UpdateWebhooksEnabled()
+	})
+}
+
+// ClearWebhooksEnabled clears the value of the "webhooks_enabled" field.
+func (u *NetworkUpsertOne) ClearWebhooksEnabled() *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.ClearWebhooksEnabled()
+	})
+}
+
+// SetWebsocketEnabled sets the "websocket_enabled" field.
+func (u *NetworkUpsertOne) SetWebsocketEnabled(v bool) *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.SetWebsocketEnabled(v)
+	})
+}
+
+// UpdateWebsocketEnabled sets the "websocket_enabled" field to the value that was provided on create.
+func (u *NetworkUpsertOne) UpdateWebsocketEnabled() *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.UpdateWebsocketEnabled()
+	})
+}
+
+// ClearWebsocketEnabled clears the value of the "websocket_enabled" field.
+func (u *NetworkUpsertOne) ClearWebsocketEnabled() *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.ClearWebsocketEnabled()
+	})
+}
+
+// SetPollingEnabled sets the "polling_enabled" field.
+func (u *NetworkUpsertOne) SetPollingEnabled(v bool) *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.SetPollingEnabled(v)
+	})
+}
+
+// UpdatePollingEnabled sets the "polling_enabled" field to the value that was provided on create.
+func (u *NetworkUpsertOne) UpdatePollingEnabled() *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.UpdatePollingEnabled()
+	})
+}
+
+// ClearPollingEnabled clears the value of the "polling_enabled" field.
+func (u *NetworkUpsertOne) ClearPollingEnabled() *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.ClearPollingEnabled()
+	})
+}
+
+// SetIsEnabled sets the "is_enabled" field.
+func (u *NetworkUpsertOne) SetIsEnabled(v bool) *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.SetIsEnabled(v)
+	})
+}
+
+// UpdateIsEnabled sets the "is_enabled" field to the value that was provided on create.
+func (u *NetworkUpsertOne) UpdateIsEnabled() *NetworkUpsertOne {
+	return u.Update(func(s *NetworkUpsert) {
+		s.UpdateIsEnabled()
+	})
+}
+
 // Exec executes the query.
 func (u *NetworkUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1029,6 +2001,20 @@ func (u *NetworkUpsertBulk) UpdateIdentifier() *NetworkUpsertBulk {
 	})
 }
 
+// SetNetworkType sets the "network_type" field.
+func (u *NetworkUpsertBulk) SetNetworkType(v network.NetworkType) *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.SetNetworkType(v)
+	})
+}
+
+// UpdateNetworkType sets the "network_type" field to the value that was provided on create.
+func (u *NetworkUpsertBulk) UpdateNetworkType() *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.UpdateNetworkType()
+	})
+}
+
 // SetRPCEndpoint sets the "rpc_endpoint" field.
 func (u *NetworkUpsertBulk) SetRPCEndpoint(v string) *NetworkUpsertBulk {
 	return u.Update(func(s *NetworkUpsert) {
@@ -1043,6 +2029,27 @@ func (u *NetworkUpsertBulk) UpdateRPCEndpoint() *NetworkUpsertBulk {
 	})
 }
 
+// SetWssEndpoint sets the "wss_endpoint" field.
+func (u *NetworkUpsertBulk) SetWssEndpoint(v string) *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.SetWssEndpoint(v)
+	})
+}
+
+// UpdateWssEndpoint sets the "wss_endpoint" field to the value that was provided on create.
+func (u *NetworkUpsertBulk) UpdateWssEndpoint() *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.UpdateWssEndpoint()
+	})
+}
+
+// ClearWssEndpoint clears the value of the "wss_endpoint" field.
+func (u *NetworkUpsertBulk) ClearWssEndpoint() *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.ClearWssEndpoint()
+	})
+}
+
 // SetGatewayContractAddress sets the "gateway_contract_address" field.
 func (u *NetworkUpsertBulk) SetGatewayContractAddress(v string) *NetworkUpsertBulk {
 	return u.Update(func(s *NetworkUpsert) {
@@ -1057,6 +2064,48 @@ func (u *NetworkUpsertBulk) UpdateGatewayContractAddress() *NetworkUpsertBulk {
 	})
 }
 
+// SetExplorerURL sets the "explorer_url" field.
+func (u *NetworkUpsertBulk) SetExplorerURL(v string) *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.SetExplorerURL(v)
+	})
+}
+
+// UpdateExplorerURL sets the "explorer_url" field to the value that was provided on create.
+func (u *NetworkUpsertBulk) UpdateExplorerURL() *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.UpdateExplorerURL()
+	})
+}
+
+// ClearExplorerURL clears the value of the "explorer_url" field.
+func (u *NetworkUpsertBulk) ClearExplorerURL() *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.ClearExplorerURL()
+	})
+}
+
+// SetAlchemyNetworkID sets the "alchemy_network_id" field.
+func (u *NetworkUpsertBulk) SetAlchemyNetworkID(v string) *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.SetAlchemyNetworkID(v)
+	})
+}
+
+// UpdateAlchemyNetworkID sets the "alchemy_network_id" field to the value that was provided on create.
+func (u *NetworkUpsertBulk) UpdateAlchemyNetworkID() *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.UpdateAlchemyNetworkID()
+	})
+}
+
+// ClearAlchemyNetworkID clears the value of the "alchemy_network_id" field.
+func (u *NetworkUpsertBulk) ClearAlchemyNetworkID() *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.ClearAlchemyNetworkID()
+	})
+}
+
 // SetBlockTime sets the "block_time" field.
 func (u *NetworkUpsertBulk) SetBlockTime(v decimal.Decimal) *NetworkUpsertBulk {
 	return u.Update(func(s *NetworkUpsert) {
@@ -1155,6 +2204,244 @@ func (u *NetworkUpsertBulk) UpdateFee() *NetworkUpsertBulk {
 	})
 }
 
+// SetFinalityBlocks sets the "finality_blocks" field.
+func (u *NetworkUpsertBulk) SetFinalityBlocks(v int) *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.SetFinalityBlocks(v)
+	})
+}
+
+// AddFinalityBlocks adds v to the "finality_blocks" field.
+func (u *NetworkUpsertBulk) AddFinalityBlocks(v int) *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.AddFinalityBlocks(v)
+	})
+}
+
+// UpdateFinalityBlocks sets the "finality_blocks" field to the value that was provided on create.
+func (u *NetworkUpsertBulk) UpdateFinalityBlocks() *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.UpdateFinalityBlocks()
+	})
+}
+
+// SetRequiredConfirmations sets the "required_confirmations" field.
+func (u *NetworkUpsertBulk) SetRequiredConfirmations(v int) *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.SetRequiredConfirmations(v)
+	})
+}
+
+// AddRequiredConfirmations adds v to the "required_confirmations" field.
+func (u *NetworkUpsertBulk) AddRequiredConfirmations(v int) *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.AddRequiredConfirmations(v)
+	})
+}
+
+// UpdateRequiredConfirmations sets the "required_confirmations" field to the value that was provided on create.
+func (u *NetworkUpsertBulk) UpdateRequiredConfirmations() *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.UpdateRequiredConfirmations()
+	})
+}
+
+// SetSettlementPolicy sets the "settlement_policy" field.
+func (u *NetworkUpsertBulk) SetSettlementPolicy(v network.SettlementPolicy) *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.SetSettlementPolicy(v)
+	})
+}
+
+// UpdateSettlementPolicy sets the "settlement_policy" field to the value that was provided on create.
+func (u *NetworkUpsertBulk) UpdateSettlementPolicy() *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.UpdateSettlementPolicy()
+	})
+}
+
+// SetOrderTTLMinutes sets the "order_ttl_minutes" field.
+func (u *NetworkUpsertBulk) SetOrderTTLMinutes(v int) *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.SetOrderTTLMinutes(v)
+	})
+}
+
+// AddOrderTTLMinutes adds v to the "order_ttl_minutes" field.
+func (u *NetworkUpsertBulk) AddOrderTTLMinutes(v int) *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.AddOrderTTLMinutes(v)
+	})
+}
+
+// UpdateOrderTTLMinutes sets the "order_ttl_minutes" field to the value that was provided on create.
+func (u *NetworkUpsertBulk) UpdateOrderTTLMinutes() *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.UpdateOrderTTLMinutes()
+	})
+}
+
+// ClearOrderTTLMinutes clears the value of the "order_ttl_minutes" field.
+func (u *NetworkUpsertBulk) ClearOrderTTLMinutes() *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.ClearOrderTTLMinutes()
+	})
+}
+
+// SetGenesisHash sets the "genesis_hash" field.
+func (u *NetworkUpsertBulk) SetGenesisHash(v string) *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.SetGenesisHash(v)
+	})
+}
+
+// UpdateGenesisHash sets the "genesis_hash" field to the value that was provided on create.
+func (u *NetworkUpsertBulk) UpdateGenesisHash() *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.UpdateGenesisHash()
+	})
+}
+
+// ClearGenesisHash clears the value of the "genesis_hash" field.
+func (u *NetworkUpsertBulk) ClearGenesisHash() *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.ClearGenesisHash()
+	})
+}
+
+// SetGenesisMismatch sets the "genesis_mismatch" field.
+func (u *NetworkUpsertBulk) SetGenesisMismatch(v bool) *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.SetGenesisMismatch(v)
+	})
+}
+
+// UpdateGenesisMismatch sets the "genesis_mismatch" field to the value that was provided on create.
+func (u *NetworkUpsertBulk) UpdateGenesisMismatch() *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.UpdateGenesisMismatch()
+	})
+}
+
+// SetSmartAccountOwnerAddress sets the "smart_account_owner_address" field.
+func (u *NetworkUpsertBulk) SetSmartAccountOwnerAddress(v string) *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.SetSmartAccountOwnerAddress(v)
+	})
+}
+
+// UpdateSmartAccountOwnerAddress sets the "smart_account_owner_address" field to the value that was provided on create.
+func (u *NetworkUpsertBulk) UpdateSmartAccountOwnerAddress() *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.UpdateSmartAccountOwnerAddress()
+	})
+}
+
+// ClearSmartAccountOwnerAddress clears the value of the "smart_account_owner_address" field.
+func (u *NetworkUpsertBulk) ClearSmartAccountOwnerAddress() *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.ClearSmartAccountOwnerAddress()
+	})
+}
+
+// SetSmartAccountOwnerSigner sets the "smart_account_owner_signer" field.
+func (u *NetworkUpsertBulk) SetSmartAccountOwnerSigner(v string) *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.SetSmartAccountOwnerSigner(v)
+	})
+}
+
+// UpdateSmartAccountOwnerSigner sets the "smart_account_owner_signer" field to the value that was provided on create.
+func (u *NetworkUpsertBulk) UpdateSmartAccountOwnerSigner() *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.UpdateSmartAccountOwnerSigner()
+	})
+}
+
+// ClearSmartAccountOwnerSigner clears the value of the "smart_account_owner_signer" field.
+func (u *NetworkUpsertBulk) ClearSmartAccountOwnerSigner() *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.ClearSmartAccountOwnerSigner()
+	})
+}
+
+// SetWebhooksEnabled sets the "webhooks_enabled" field.
+func (u *NetworkUpsertBulk) SetWebhooksEnabled(v bool) *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.SetWebhooksEnabled(v)
+	})
+}
+
+// UpdateWebhooksEnabled sets the "webhooks_enabled" field to the value that was provided on create.
+func (u *NetworkUpsertBulk) UpdateWebhooksEnabled() *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.UpdateWebhooksEnabled()
+	})
+}
+
+// ClearWebhooksEnabled clears the value of the "webhooks_enabled" field.
+func (u *NetworkUpsertBulk) ClearWebhooksEnabled() *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.ClearWebhooksEnabled()
+	})
+}
+
+// SetWebsocketEnabled sets the "websocket_enabled" field.
+func (u *NetworkUpsertBulk) SetWebsocketEnabled(v bool) *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.SetWebsocketEnabled(v)
+	})
+}
+
+// UpdateWebsocketEnabled sets the "websocket_enabled" field to the value that was provided on create.
+func (u *NetworkUpsertBulk) UpdateWebsocketEnabled() *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.UpdateWebsocketEnabled()
+	})
+}
+
+// ClearWebsocketEnabled clears the value of the "websocket_enabled" field.
+func (u *NetworkUpsertBulk) ClearWebsocketEnabled() *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.ClearWebsocketEnabled()
+	})
+}
+
+// SetPollingEnabled sets the "polling_enabled" field.
+func (u *NetworkUpsertBulk) SetPollingEnabled(v bool) *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.SetPollingEnabled(v)
+	})
+}
+
+// UpdatePollingEnabled sets the "polling_enabled" field to the value that was provided on create.
+func (u *NetworkUpsertBulk) UpdatePollingEnabled() *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.UpdatePollingEnabled()
+	})
+}
+
+// ClearPollingEnabled clears the value of the "polling_enabled" field.
+func (u *NetworkUpsertBulk) ClearPollingEnabled() *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.ClearPollingEnabled()
+	})
+}
+
+// SetIsEnabled sets the "is_enabled" field.
+func (u *NetworkUpsertBulk) SetIsEnabled(v bool) *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.SetIsEnabled(v)
+	})
+}
+
+// UpdateIsEnabled sets the "is_enabled" field to the value that was provided on create.
+func (u *NetworkUpsertBulk) UpdateIsEnabled() *NetworkUpsertBulk {
+	return u.Update(func(s *NetworkUpsert) {
+		s.UpdateIsEnabled()
+	})
+}
+
 // Exec executes the query.
 func (u *NetworkUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
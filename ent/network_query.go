@@ -15,6 +15,7 @@ import (
 	"github.com/NEDA-LABS/stablenode/ent/network"
 	"github.com/NEDA-LABS/stablenode/ent/paymentwebhook"
 	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/NEDA-LABS/stablenode/ent/rpcendpoint"
 	"github.com/NEDA-LABS/stablenode/ent/token"
 )
 
@@ -27,6 +28,7 @@ type NetworkQuery struct {
 	predicates         []predicate.Network
 	withTokens         *TokenQuery
 	withPaymentWebhook *PaymentWebhookQuery
+	withRPCEndpoints   *RPCEndpointQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -107,6 +109,28 @@ func (nq *NetworkQuery) QueryPaymentWebhook() *PaymentWebhookQuery {
 	return query
 }
 
+// QueryRPCEndpoints chains the current query on the "rpc_endpoints" edge.
+func (nq *NetworkQuery) QueryRPCEndpoints() *RPCEndpointQuery {
+	query := (&RPCEndpointClient{config: nq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := nq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := nq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(network.Table, network.FieldID, selector),
+			sqlgraph.To(rpcendpoint.Table, rpcendpoint.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, network.RPCEndpointsTable, network.RPCEndpointsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(nq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Network entity from the query.
 // Returns a *NotFoundError when no Network was found.
 func (nq *NetworkQuery) First(ctx context.Context) (*Network, error) {
@@ -301,6 +325,7 @@ func (nq *NetworkQuery) Clone() *NetworkQuery {
 		predicates:         append([]predicate.Network{}, nq.predicates...),
 		withTokens:         nq.withTokens.Clone(),
 		withPaymentWebhook: nq.withPaymentWebhook.Clone(),
+		withRPCEndpoints:   nq.withRPCEndpoints.Clone(),
 		// clone intermediate query.
 		sql:  nq.sql.Clone(),
 		path: nq.path,
@@ -329,6 +354,17 @@ func (nq *NetworkQuery) WithPaymentWebhook(opts ...func(*PaymentWebhookQuery)) *
 	return nq
 }
 
+// WithRPCEndpoints tells the query-builder to eager-load the nodes that are connected to
+// the "rpc_endpoints" edge. The optional arguments are used to configure the query builder of the edge.
+func (nq *NetworkQuery) WithRPCEndpoints(opts ...func(*RPCEndpointQuery)) *NetworkQuery {
+	query := (&RPCEndpointClient{config: nq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	nq.withRPCEndpoints = query
+	return nq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -407,9 +443,10 @@ func (nq *NetworkQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Netw
 	var (
 		nodes       = []*Network{}
 		_spec       = nq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			nq.withTokens != nil,
 			nq.withPaymentWebhook != nil,
+			nq.withRPCEndpoints != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -443,6 +480,13 @@ func (nq *NetworkQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Netw
 			return nil, err
 		}
 	}
+	if query := nq.withRPCEndpoints; query != nil {
+		if err := nq.loadRPCEndpoints(ctx, query, nodes,
+			func(n *Network) { n.Edges.RPCEndpoints = []*RPCEndpoint{} },
+			func(n *Network, e *RPCEndpoint) { n.Edges.RPCEndpoints = append(n.Edges.RPCEndpoints, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -505,6 +549,37 @@ func (nq *NetworkQuery) loadPaymentWebhook(ctx context.Context, query *PaymentWe
 	}
 	return nil
 }
+func (nq *NetworkQuery) loadRPCEndpoints(ctx context.Context, query *RPCEndpointQuery, nodes []*Network, init func(*Network), assign func(*Network, *RPCEndpoint)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Network)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.RPCEndpoint(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(network.RPCEndpointsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.network_rpc_endpoints
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "network_rpc_endpoints" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "network_rpc_endpoints" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (nq *NetworkQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := nq.querySpec()
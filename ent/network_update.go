@@ -14,6 +14,7 @@ import (
 	"github.com/NEDA-LABS/stablenode/ent/network"
 	"github.com/NEDA-LABS/stablenode/ent/paymentwebhook"
 	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/NEDA-LABS/stablenode/ent/rpcendpoint"
 	"github.com/NEDA-LABS/stablenode/ent/token"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
@@ -73,6 +74,20 @@ func (nu *NetworkUpdate) SetNillableIdentifier(s *string) *NetworkUpdate {
 	return nu
 }
 
+// SetNetworkType sets the "network_type" field.
+func (nu *NetworkUpdate) SetNetworkType(nt network.NetworkType) *NetworkUpdate {
+	nu.mutation.SetNetworkType(nt)
+	return nu
+}
+
+// SetNillableNetworkType sets the "network_type" field if the given value is not nil.
+func (nu *NetworkUpdate) SetNillableNetworkType(nt *network.NetworkType) *NetworkUpdate {
+	if nt != nil {
+		nu.SetNetworkType(*nt)
+	}
+	return nu
+}
+
 // SetRPCEndpoint sets the "rpc_endpoint" field.
 func (nu *NetworkUpdate) SetRPCEndpoint(s string) *NetworkUpdate {
 	nu.mutation.SetRPCEndpoint(s)
@@ -87,6 +102,26 @@ func (nu *NetworkUpdate) SetNillableRPCEndpoint(s *string) *NetworkUpdate {
 	return nu
 }
 
+// SetWssEndpoint sets the "wss_endpoint" field.
+func (nu *NetworkUpdate) SetWssEndpoint(s string) *NetworkUpdate {
+	nu.mutation.SetWssEndpoint(s)
+	return nu
+}
+
+// SetNillableWssEndpoint sets the "wss_endpoint" field if the given value is not nil.
+func (nu *NetworkUpdate) SetNillableWssEndpoint(s *string) *NetworkUpdate {
+	if s != nil {
+		nu.SetWssEndpoint(*s)
+	}
+	return nu
+}
+
+// ClearWssEndpoint clears the value of the "wss_endpoint" field.
+func (nu *NetworkUpdate) ClearWssEndpoint() *NetworkUpdate {
+	nu.mutation.ClearWssEndpoint()
+	return nu
+}
+
 // SetGatewayContractAddress sets the "gateway_contract_address" field.
 func (nu *NetworkUpdate) SetGatewayContractAddress(s string) *NetworkUpdate {
 	nu.mutation.SetGatewayContractAddress(s)
@@ -101,6 +136,46 @@ func (nu *NetworkUpdate) SetNillableGatewayContractAddress(s *string) *NetworkUp
 	return nu
 }
 
+// SetExplorerURL sets the "explorer_url" field.
+func (nu *NetworkUpdate) SetExplorerURL(s string) *NetworkUpdate {
+	nu.mutation.SetExplorerURL(s)
+	return nu
+}
+
+// SetNillableExplorerURL sets the "explorer_url" field if the given value is not nil.
+func (nu *NetworkUpdate) SetNillableExplorerURL(s *string) *NetworkUpdate {
+	if s != nil {
+		nu.SetExplorerURL(*s)
+	}
+	return nu
+}
+
+// ClearExplorerURL clears the value of the "explorer_url" field.
+func (nu *NetworkUpdate) ClearExplorerURL() *NetworkUpdate {
+	nu.mutation.ClearExplorerURL()
+	return nu
+}
+
+// SetAlchemyNetworkID sets the "alchemy_network_id" field.
+func (nu *NetworkUpdate) SetAlchemyNetworkID(s string) *NetworkUpdate {
+	nu.mutation.SetAlchemyNetworkID(s)
+	return nu
+}
+
+// SetNillableAlchemyNetworkID sets the "alchemy_network_id" field if the given value is not nil.
+func (nu *NetworkUpdate) SetNillableAlchemyNetworkID(s *string) *NetworkUpdate {
+	if s != nil {
+		nu.SetAlchemyNetworkID(*s)
+	}
+	return nu
+}
+
+// ClearAlchemyNetworkID clears the value of the "alchemy_network_id" field.
+func (nu *NetworkUpdate) ClearAlchemyNetworkID() *NetworkUpdate {
+	nu.mutation.ClearAlchemyNetworkID()
+	return nu
+}
+
 // SetBlockTime sets the "block_time" field.
 func (nu *NetworkUpdate) SetBlockTime(d decimal.Decimal) *NetworkUpdate {
 	nu.mutation.ResetBlockTime()
@@ -197,6 +272,237 @@ func (nu *NetworkUpdate) AddFee(d decimal.Decimal) *NetworkUpdate {
 	return nu
 }
 
+// SetFinalityBlocks sets the "finality_blocks" field.
+func (nu *NetworkUpdate) SetFinalityBlocks(i int) *NetworkUpdate {
+	nu.mutation.ResetFinalityBlocks()
+	nu.mutation.SetFinalityBlocks(i)
+	return nu
+}
+
+// SetNillableFinalityBlocks sets the "finality_blocks" field if the given value is not nil.
+func (nu *NetworkUpdate) SetNillableFinalityBlocks(i *int) *NetworkUpdate {
+	if i != nil {
+		nu.SetFinalityBlocks(*i)
+	}
+	return nu
+}
+
+// AddFinalityBlocks adds i to the "finality_blocks" field.
+func (nu *NetworkUpdate) AddFinalityBlocks(i int) *NetworkUpdate {
+	nu.mutation.AddFinalityBlocks(i)
+	return nu
+}
+
+// SetRequiredConfirmations sets the "required_confirmations" field.
+func (nu *NetworkUpdate) SetRequiredConfirmations(i int) *NetworkUpdate {
+	nu.mutation.ResetRequiredConfirmations()
+	nu.mutation.SetRequiredConfirmations(i)
+	return nu
+}
+
+// SetNillableRequiredConfirmations sets the "required_confirmations" field if the given value is not nil.
+func (nu *NetworkUpdate) SetNillableRequiredConfirmations(i *int) *NetworkUpdate {
+	if i != nil {
+		nu.SetRequiredConfirmations(*i)
+	}
+	return nu
+}
+
+// AddRequiredConfirmations adds i to the "required_confirmations" field.
+func (nu *NetworkUpdate) AddRequiredConfirmations(i int) *NetworkUpdate {
+	nu.mutation.AddRequiredConfirmations(i)
+	return nu
+}
+
+// SetSettlementPolicy sets the "settlement_policy" field.
+func (nu *NetworkUpdate) SetSettlementPolicy(np network.SettlementPolicy) *NetworkUpdate {
+	nu.mutation.SetSettlementPolicy(np)
+	return nu
+}
+
+// SetNillableSettlementPolicy sets the "settlement_policy" field if the given value is not nil.
+func (nu *NetworkUpdate) SetNillableSettlementPolicy(np *network.SettlementPolicy) *NetworkUpdate {
+	if np != nil {
+		nu.SetSettlementPolicy(*np)
+	}
+	return nu
+}
+
+// SetOrderTTLMinutes sets the "order_ttl_minutes" field.
+func (nu *NetworkUpdate) SetOrderTTLMinutes(i int) *NetworkUpdate {
+	nu.mutation.ResetOrderTTLMinutes()
+	nu.mutation.SetOrderTTLMinutes(i)
+	return nu
+}
+
+// SetNillableOrderTTLMinutes sets the "order_ttl_minutes" field if the given value is not nil.
+func (nu *NetworkUpdate) SetNillableOrderTTLMinutes(i *int) *NetworkUpdate {
+	if i != nil {
+		nu.SetOrderTTLMinutes(*i)
+	}
+	return nu
+}
+
+// AddOrderTTLMinutes adds i to the "order_ttl_minutes" field.
+func (nu *NetworkUpdate) AddOrderTTLMinutes(i int) *NetworkUpdate {
+	nu.mutation.AddOrderTTLMinutes(i)
+	return nu
+}
+
+// ClearOrderTTLMinutes clears the value of the "order_ttl_minutes" field.
+func (nu *NetworkUpdate) ClearOrderTTLMinutes() *NetworkUpdate {
+	nu.mutation.ClearOrderTTLMinutes()
+	return nu
+}
+
+// SetGenesisHash sets the "genesis_hash" field.
+func (nu *NetworkUpdate) SetGenesisHash(s string) *NetworkUpdate {
+	nu.mutation.SetGenesisHash(s)
+	return nu
+}
+
+// SetNillableGenesisHash sets the "genesis_hash" field if the given value is not nil.
+func (nu *NetworkUpdate) SetNillableGenesisHash(s *string) *NetworkUpdate {
+	if s != nil {
+		nu.SetGenesisHash(*s)
+	}
+	return nu
+}
+
+// ClearGenesisHash clears the value of the "genesis_hash" field.
+func (nu *NetworkUpdate) ClearGenesisHash() *NetworkUpdate {
+	nu.mutation.ClearGenesisHash()
+	return nu
+}
+
+// SetGenesisMismatch sets the "genesis_mismatch" field.
+func (nu *NetworkUpdate) SetGenesisMismatch(b bool) *NetworkUpdate {
+	nu.mutation.SetGenesisMismatch(b)
+	return nu
+}
+
+// SetNillableGenesisMismatch sets the "genesis_mismatch" field if the given value is not nil.
+func (nu *NetworkUpdate) SetNillableGenesisMismatch(b *bool) *NetworkUpdate {
+	if b != nil {
+		nu.SetGenesisMismatch(*b)
+	}
+	return nu
+}
+
+// SetSmartAccountOwnerAddress sets the "smart_account_owner_address" field.
+func (nu *NetworkUpdate) SetSmartAccountOwnerAddress(s string) *NetworkUpdate {
+	nu.mutation.SetSmartAccountOwnerAddress(s)
+	return nu
+}
+
+// SetNillableSmartAccountOwnerAddress sets the "smart_account_owner_address" field if the given value is not nil.
+func (nu *NetworkUpdate) SetNillableSmartAccountOwnerAddress(s *string) *NetworkUpdate {
+	if s != nil {
+		nu.SetSmartAccountOwnerAddress(*s)
+	}
+	return nu
+}
+
+// ClearSmartAccountOwnerAddress clears the value of the "smart_account_owner_address" field.
+func (nu *NetworkUpdate) ClearSmartAccountOwnerAddress() *NetworkUpdate {
+	nu.mutation.ClearSmartAccountOwnerAddress()
+	return nu
+}
+
+// SetSmartAccountOwnerSigner sets the "smart_account_owner_signer" field.
+func (nu *NetworkUpdate) SetSmartAccountOwnerSigner(s string) *NetworkUpdate {
+	nu.mutation.SetSmartAccountOwnerSigner(s)
+	return nu
+}
+
+// SetNillableSmartAccountOwnerSigner sets the "smart_account_owner_signer" field if the given value is not nil.
+func (nu *NetworkUpdate) SetNillableSmartAccountOwnerSigner(s *string) *NetworkUpdate {
+	if s != nil {
+		nu.SetSmartAccountOwnerSigner(*s)
+	}
+	return nu
+}
+
+// ClearSmartAccountOwnerSigner clears the value of the "smart_account_owner_signer" field.
+func (nu *NetworkUpdate) ClearSmartAccountOwnerSigner() *NetworkUpdate {
+	nu.mutation.ClearSmartAccountOwnerSigner()
+	return nu
+}
+
+// SetWebhooksEnabled sets the "webhooks_enabled" field.
+func (nu *NetworkUpdate) SetWebhooksEnabled(b bool) *NetworkUpdate {
+	nu.mutation.SetWebhooksEnabled(b)
+	return nu
+}
+
+// SetNillableWebhooksEnabled sets the "webhooks_enabled" field if the given value is not nil.
+func (nu *NetworkUpdate) SetNillableWebhooksEnabled(b *bool) *NetworkUpdate {
+	if b != nil {
+		nu.SetWebhooksEnabled(*b)
+	}
+	return nu
+}
+
+// ClearWebhooksEnabled clears the value of the "webhooks_enabled" field.
+func (nu *NetworkUpdate) ClearWebhooksEnabled() *NetworkUpdate {
+	nu.mutation.ClearWebhooksEnabled()
+	return nu
+}
+
+// SetWebsocketEnabled sets the "websocket_enabled" field.
+func (nu *NetworkUpdate) SetWebsocketEnabled(b bool) *NetworkUpdate {
+	nu.mutation.SetWebsocketEnabled(b)
+	return nu
+}
+
+// SetNillableWebsocketEnabled sets the "websocket_enabled" field if the given value is not nil.
+func (nu *NetworkUpdate) SetNillableWebsocketEnabled(b *bool) *NetworkUpdate {
+	if b != nil {
+		nu.SetWebsocketEnabled(*b)
+	}
+	return nu
+}
+
+// ClearWebsocketEnabled clears the value of the "websocket_enabled" field.
+func (nu *NetworkUpdate) ClearWebsocketEnabled() *NetworkUpdate {
+	nu.mutation.ClearWebsocketEnabled()
+	return nu
+}
+
+// SetPollingEnabled sets the "polling_enabled" field.
+func (nu *NetworkUpdate) SetPollingEnabled(b bool) *NetworkUpdate {
+	nu.mutation.SetPollingEnabled(b)
+	return nu
+}
+
+// SetNillablePollingEnabled sets the "polling_enabled" field if the given value is not nil.
+func (nu *NetworkUpdate) SetNillablePollingEnabled(b *bool) *NetworkUpdate {
+	if b != nil {
+		nu.SetPollingEnabled(*b)
+	}
+	return nu
+}
+
+// ClearPollingEnabled clears the value of the "polling_enabled" field.
+func (nu *NetworkUpdate) ClearPollingEnabled() *NetworkUpdate {
+	nu.mutation.ClearPollingEnabled()
+	return nu
+}
+
+// SetIsEnabled sets the "is_enabled" field.
+func (nu *NetworkUpdate) SetIsEnabled(b bool) *NetworkUpdate {
+	nu.mutation.SetIsEnabled(b)
+	return nu
+}
+
+// SetNillableIsEnabled sets the "is_enabled" field if the given value is not nil.
+func (nu *NetworkUpdate) SetNillableIsEnabled(b *bool) *NetworkUpdate {
+	if b != nil {
+		nu.SetIsEnabled(*b)
+	}
+	return nu
+}
+
 // AddTokenIDs adds the "tokens" edge to the Token entity by IDs.
 func (nu *NetworkUpdate) AddTokenIDs(ids ...int) *NetworkUpdate {
 	nu.mutation.AddTokenIDs(ids...)
@@ -231,6 +537,21 @@ func (nu *NetworkUpdate) SetPaymentWebhook(p *PaymentWebhook) *NetworkUpdate {
 	return nu.SetPaymentWebhookID(p.ID)
 }
 
+// AddRPCEndpointIDs adds the "rpc_endpoints" edge to the RPCEndpoint entity by IDs.
+func (nu *NetworkUpdate) AddRPCEndpointIDs(ids ...int) *NetworkUpdate {
+	nu.mutation.AddRPCEndpointIDs(ids...)
+	return nu
+}
+
+// AddRPCEndpoints adds the "rpc_endpoints" edges to the RPCEndpoint entity.
+func (nu *NetworkUpdate) AddRPCEndpoints(r ...*RPCEndpoint) *NetworkUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return nu.AddRPCEndpointIDs(ids...)
+}
+
 // Mutation returns the NetworkMutation object of the builder.
 func (nu *NetworkUpdate) Mutation() *NetworkMutation {
 	return nu.mutation
@@ -263,6 +584,27 @@ func (nu *NetworkUpdate) ClearPaymentWebhook() *NetworkUpdate {
 	return nu
 }
 
+// ClearRPCEndpoints clears all "rpc_endpoints" edges to the RPCEndpoint entity.
+func (nu *NetworkUpdate) ClearRPCEndpoints() *NetworkUpdate {
+	nu.mutation.ClearRPCEndpoints()
+	return nu
+}
+
+// RemoveRPCEndpointIDs removes the "rpc_endpoints" edge to RPCEndpoint entities by IDs.
+func (nu *NetworkUpdate) RemoveRPCEndpointIDs(ids ...int) *NetworkUpdate {
+	nu.mutation.RemoveRPCEndpointIDs(ids...)
+	return nu
+}
+
+// RemoveRPCEndpoints removes "rpc_endpoints" edges to RPCEndpoint entities.
+func (nu *NetworkUpdate) RemoveRPCEndpoints(r ...*RPCEndpoint) *NetworkUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return nu.RemoveRPCEndpointIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (nu *NetworkUpdate) Save(ctx context.Context) (int, error) {
 	nu.defaults()
@@ -299,7 +641,40 @@ func (nu *NetworkUpdate) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (nu *NetworkUpdate) check() error {
+	if v, ok := nu.mutation.NetworkType(); ok {
+		if err := network.NetworkTypeValidator(v); err != nil {
+			return &ValidationError{Name: "network_type", err: fmt.Errorf(`ent: validator failed for field "Network.network_type": %w`, err)}
+		}
+	}
+	if v, ok := nu.mutation.FinalityBlocks(); ok {
+		if err := network.FinalityBlocksValidator(v); err != nil {
+			return &ValidationError{Name: "finality_blocks", err: fmt.Errorf(`ent: validator failed for field "Network.finality_blocks": %w`, err)}
+		}
+	}
+	if v, ok := nu.mutation.RequiredConfirmations(); ok {
+		if err := network.RequiredConfirmationsValidator(v); err != nil {
+			return &ValidationError{Name: "required_confirmations", err: fmt.Errorf(`ent: validator failed for field "Network.required_confirmations": %w`, err)}
+		}
+	}
+	if v, ok := nu.mutation.SettlementPolicy(); ok {
+		if err := network.SettlementPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "settlement_policy", err: fmt.Errorf(`ent: validator failed for field "Network.settlement_policy": %w`, err)}
+		}
+	}
+	if v, ok := nu.mutation.OrderTTLMinutes(); ok {
+		if err := network.OrderTTLMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "order_ttl_minutes", err: fmt.Errorf(`ent: validator failed for field "Network.order_ttl_minutes": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (nu *NetworkUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := nu.check(); err != nil {
+		return n, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(network.Table, network.Columns, sqlgraph.NewFieldSpec(network.FieldID, field.TypeInt))
 	if ps := nu.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -320,12 +695,33 @@ func (nu *NetworkUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := nu.mutation.Identifier(); ok {
 		_spec.SetField(network.FieldIdentifier, field.TypeString, value)
 	}
+	if value, ok := nu.mutation.NetworkType(); ok {
+		_spec.SetField(network.FieldNetworkType, field.TypeEnum, value)
+	}
 	if value, ok := nu.mutation.RPCEndpoint(); ok {
 		_spec.SetField(network.FieldRPCEndpoint, field.TypeString, value)
 	}
+	if value, ok := nu.mutation.WssEndpoint(); ok {
+		_spec.SetField(network.FieldWssEndpoint, field.TypeString, value)
+	}
+	if nu.mutation.WssEndpointCleared() {
+		_spec.ClearField(network.FieldWssEndpoint, field.TypeString)
+	}
 	if value, ok := nu.mutation.GatewayContractAddress(); ok {
 		_spec.SetField(network.FieldGatewayContractAddress, field.TypeString, value)
 	}
+	if value, ok := nu.mutation.ExplorerURL(); ok {
+		_spec.SetField(network.FieldExplorerURL, field.TypeString, value)
+	}
+	if nu.mutation.ExplorerURLCleared() {
+		_spec.ClearField(network.FieldExplorerURL, field.TypeString)
+	}
+	if value, ok := nu.mutation.AlchemyNetworkID(); ok {
+		_spec.SetField(network.FieldAlchemyNetworkID, field.TypeString, value)
+	}
+	if nu.mutation.AlchemyNetworkIDCleared() {
+		_spec.ClearField(network.FieldAlchemyNetworkID, field.TypeString)
+	}
 	if value, ok := nu.mutation.BlockTime(); ok {
 		_spec.SetField(network.FieldBlockTime, field.TypeFloat64, value)
 	}
@@ -353,6 +749,72 @@ func (nu *NetworkUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := nu.mutation.AddedFee(); ok {
 		_spec.AddField(network.FieldFee, field.TypeFloat64, value)
 	}
+	if value, ok := nu.mutation.FinalityBlocks(); ok {
+		_spec.SetField(network.FieldFinalityBlocks, field.TypeInt, value)
+	}
+	if value, ok := nu.mutation.AddedFinalityBlocks(); ok {
+		_spec.AddField(network.FieldFinalityBlocks, field.TypeInt, value)
+	}
+	if value, ok := nu.mutation.RequiredConfirmations(); ok {
+		_spec.SetField(network.FieldRequiredConfirmations, field.TypeInt, value)
+	}
+	if value, ok := nu.mutation.AddedRequiredConfirmations(); ok {
+		_spec.AddField(network.FieldRequiredConfirmations, field.TypeInt, value)
+	}
+	if value, ok := nu.mutation.SettlementPolicy(); ok {
+		_spec.SetField(network.FieldSettlementPolicy, field.TypeEnum, value)
+	}
+	if value, ok := nu.mutation.OrderTTLMinutes(); ok {
+		_spec.SetField(network.FieldOrderTTLMinutes, field.TypeInt, value)
+	}
+	if value, ok := nu.mutation.AddedOrderTTLMinutes(); ok {
+		_spec.AddField(network.FieldOrderTTLMinutes, field.TypeInt, value)
+	}
+	if nu.mutation.OrderTTLMinutesCleared() {
+		_spec.ClearField(network.FieldOrderTTLMinutes, field.TypeInt)
+	}
+	if value, ok := nu.mutation.GenesisHash(); ok {
+		_spec.SetField(network.FieldGenesisHash, field.TypeString, value)
+	}
+	if nu.mutation.GenesisHashCleared() {
+		_spec.ClearField(network.FieldGenesisHash, field.TypeString)
+	}
+	if value, ok := nu.mutation.GenesisMismatch(); ok {
+		_spec.SetField(network.FieldGenesisMismatch, field.TypeBool, value)
+	}
+	if value, ok := nu.mutation.SmartAccountOwnerAddress(); ok {
+		_spec.SetField(network.FieldSmartAccountOwnerAddress, field.TypeString, value)
+	}
+	if nu.mutation.SmartAccountOwnerAddressCleared() {
+		_spec.ClearField(network.FieldSmartAccountOwnerAddress, field.TypeString)
+	}
+	if value, ok := nu.mutation.SmartAccountOwnerSigner(); ok {
+		_spec.SetField(network.FieldSmartAccountOwnerSigner, field.TypeString, value)
+	}
+	if nu.mutation.SmartAccountOwnerSignerCleared() {
+		_spec.ClearField(network.FieldSmartAccountOwnerSigner, field.TypeString)
+	}
+	if value, ok := nu.mutation.WebhooksEnabled(); ok {
+		_spec.SetField(network.FieldWebhooksEnabled, field.TypeBool, value)
+	}
+	if nu.mutation.WebhooksEnabledCleared() {
+		_spec.ClearField(network.FieldWebhooksEnabled, field.TypeBool)
+	}
+	if value, ok := nu.mutation.WebsocketEnabled(); ok {
+		_spec.SetField(network.FieldWebsocketEnabled, field.TypeBool, value)
+	}
+	if nu.mutation.WebsocketEnabledCleared() {
+		_spec.ClearField(network.FieldWebsocketEnabled, field.TypeBool)
+	}
+	if value, ok := nu.mutation.PollingEnabled(); ok {
+		_spec.SetField(network.FieldPollingEnabled, field.TypeBool, value)
+	}
+	if nu.mutation.PollingEnabledCleared() {
+		_spec.ClearField(network.FieldPollingEnabled, field.TypeBool)
+	}
+	if value, ok := nu.mutation.IsEnabled(); ok {
+		_spec.SetField(network.FieldIsEnabled, field.TypeBool, value)
+	}
 	if nu.mutation.TokensCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -427,6 +889,51 @@ func (nu *NetworkUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if nu.mutation.RPCEndpointsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   network.RPCEndpointsTable,
+			Columns: []string{network.RPCEndpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rpcendpoint.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.RemovedRPCEndpointsIDs(); len(nodes) > 0 && !nu.mutation.RPCEndpointsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   network.RPCEndpointsTable,
+			Columns: []string{network.RPCEndpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rpcendpoint.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.RPCEndpointsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   network.RPCEndpointsTable,
+			Columns: []string{network.RPCEndpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rpcendpoint.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, nu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{network.Label}
@@ -488,6 +995,20 @@ func (nuo *NetworkUpdateOne) SetNillableIdentifier(s *string) *NetworkUpdateOne
 	return nuo
 }
 
+// SetNetworkType sets the "network_type" field.
+func (nuo *NetworkUpdateOne) SetNetworkType(nt network.NetworkType) *NetworkUpdateOne {
+	nuo.mutation.SetNetworkType(nt)
+	return nuo
+}
+
+// SetNillableNetworkType sets the "network_type" field if the given value is not nil.
+func (nuo *NetworkUpdateOne) SetNillableNetworkType(nt *network.NetworkType) *NetworkUpdateOne {
+	if nt != nil {
+		nuo.SetNetworkType(*nt)
+	}
+	return nuo
+}
+
 // SetRPCEndpoint sets the "rpc_endpoint" field.
 func (nuo *NetworkUpdateOne) SetRPCEndpoint(s string) *NetworkUpdateOne {
 	nuo.mutation.SetRPCEndpoint(s)
@@ -502,6 +1023,26 @@ func (nuo *NetworkUpdateOne) SetNillableRPCEndpoint(s *string) *NetworkUpdateOne
 	return nuo
 }
 
+// SetWssEndpoint sets the "wss_endpoint" field.
+func (nuo *NetworkUpdateOne) SetWssEndpoint(s string) *NetworkUpdateOne {
+	nuo.mutation.SetWssEndpoint(s)
+	return nuo
+}
+
+// SetNillableWssEndpoint sets the "wss_endpoint" field if the given value is not nil.
+func (nuo *NetworkUpdateOne) SetNillableWssEndpoint(s *string) *NetworkUpdateOne {
+	if s != nil {
+		nuo.SetWssEndpoint(*s)
+	}
+	return nuo
+}
+
+// ClearWssEndpoint clears the value of the "wss_endpoint" field.
+func (nuo *NetworkUpdateOne) ClearWssEndpoint() *NetworkUpdateOne {
+	nuo.mutation.ClearWssEndpoint()
+	return nuo
+}
+
 // SetGatewayContractAddress sets the "gateway_contract_address" field.
 func (nuo *NetworkUpdateOne) SetGatewayContractAddress(s string) *NetworkUpdateOne {
 	nuo.mutation.SetGatewayContractAddress(s)
@@ -516,6 +1057,46 @@ func (nuo *NetworkUpdateOne) SetNillableGatewayContractAddress(s *string) *Netwo
 	return nuo
 }
 
+// SetExplorerURL sets the "explorer_url" field.
+func (nuo *NetworkUpdateOne) SetExplorerURL(s string) *NetworkUpdateOne {
+	nuo.mutation.SetExplorerURL(s)
+	return nuo
+}
+
+// SetNillableExplorerURL sets the "explorer_url" field if the given value is not nil.
+func (nuo *NetworkUpdateOne) SetNillableExplorerURL(s *string) *NetworkUpdateOne {
+	if s != nil {
+		nuo.SetExplorerURL(*s)
+	}
+	return nuo
+}
+
+// ClearExplorerURL clears the value of the "explorer_url" field.
+func (nuo *NetworkUpdateOne) ClearExplorerURL() *NetworkUpdateOne {
+	nuo.mutation.ClearExplorerURL()
+	return nuo
+}
+
+// SetAlchemyNetworkID sets the "alchemy_network_id" field.
+func (nuo *NetworkUpdateOne) SetAlchemyNetworkID(s string) *NetworkUpdateOne {
+	nuo.mutation.SetAlchemyNetworkID(s)
+	return nuo
+}
+
+// SetNillableAlchemyNetworkID sets the "alchemy_network_id" field if the given value is not nil.
+func (nuo *NetworkUpdateOne) SetNillableAlchemyNetworkID(s *string) *NetworkUpdateOne {
+	if s != nil {
+		nuo.SetAlchemyNetworkID(*s)
+	}
+	return nuo
+}
+
+// ClearAlchemyNetworkID clears the value of the "alchemy_network_id" field.
+func (nuo *NetworkUpdateOne) ClearAlchemyNetworkID() *NetworkUpdateOne {
+	nuo.mutation.ClearAlchemyNetworkID()
+	return nuo
+}
+
 // SetBlockTime sets the "block_time" field.
 func (nuo *NetworkUpdateOne) SetBlockTime(d decimal.Decimal) *NetworkUpdateOne {
 	nuo.mutation.ResetBlockTime()
@@ -612,6 +1193,237 @@ func (nuo *NetworkUpdateOne) AddFee(d decimal.Decimal) *NetworkUpdateOne {
 	return nuo
 }
 
+// SetFinalityBlocks sets the "finality_blocks" field.
+func (nuo *NetworkUpdateOne) SetFinalityBlocks(i int) *NetworkUpdateOne {
+	nuo.mutation.ResetFinalityBlocks()
+	nuo.mutation.SetFinalityBlocks(i)
+	return nuo
+}
+
+// SetNillableFinalityBlocks sets the "finality_blocks" field if the given value is not nil.
+func (nuo *NetworkUpdateOne) SetNillableFinalityBlocks(i *int) *NetworkUpdateOne {
+	if i != nil {
+		nuo.SetFinalityBlocks(*i)
+	}
+	return nuo
+}
+
+// AddFinalityBlocks adds i to the "finality_blocks" field.
+func (nuo *NetworkUpdateOne) AddFinalityBlocks(i int) *NetworkUpdateOne {
+	nuo.mutation.AddFinalityBlocks(i)
+	return nuo
+}
+
+// SetRequiredConfirmations sets the "required_confirmations" field.
+func (nuo *NetworkUpdateOne) SetRequiredConfirmations(i int) *NetworkUpdateOne {
+	nuo.mutation.ResetRequiredConfirmations()
+	nuo.mutation.SetRequiredConfirmations(i)
+	return nuo
+}
+
+// SetNillableRequiredConfirmations sets the "required_confirmations" field if the given value is not nil.
+func (nuo *NetworkUpdateOne) SetNillableRequiredConfirmations(i *int) *NetworkUpdateOne {
+	if i != nil {
+		nuo.SetRequiredConfirmations(*i)
+	}
+	return nuo
+}
+
+// AddRequiredConfirmations adds i to the "required_confirmations" field.
+func (nuo *NetworkUpdateOne) AddRequiredConfirmations(i int) *NetworkUpdateOne {
+	nuo.mutation.AddRequiredConfirmations(i)
+	return nuo
+}
+
+// SetSettlementPolicy sets the "settlement_policy" field.
+func (nuo *NetworkUpdateOne) SetSettlementPolicy(np network.SettlementPolicy) *NetworkUpdateOne {
+	nuo.mutation.SetSettlementPolicy(np)
+	return nuo
+}
+
+// SetNillableSettlementPolicy sets the "settlement_policy" field if the given value is not nil.
+func (nuo *NetworkUpdateOne) SetNillableSettlementPolicy(np *network.SettlementPolicy) *NetworkUpdateOne {
+	if np != nil {
+		nuo.SetSettlementPolicy(*np)
+	}
+	return nuo
+}
+
+// SetOrderTTLMinutes sets the "order_ttl_minutes" field.
+func (nuo *NetworkUpdateOne) SetOrderTTLMinutes(i int) *NetworkUpdateOne {
+	nuo.mutation.ResetOrderTTLMinutes()
+	nuo.mutation.SetOrderTTLMinutes(i)
+	return nuo
+}
+
+// SetNillableOrderTTLMinutes sets the "order_ttl_minutes" field if the given value is not nil.
+func (nuo *NetworkUpdateOne) SetNillableOrderTTLMinutes(i *int) *NetworkUpdateOne {
+	if i != nil {
+		nuo.SetOrderTTLMinutes(*i)
+	}
+	return nuo
+}
+
+// AddOrderTTLMinutes adds i to the "order_ttl_minutes" field.
+func (nuo *NetworkUpdateOne) AddOrderTTLMinutes(i int) *NetworkUpdateOne {
+	nuo.mutation.AddOrderTTLMinutes(i)
+	return nuo
+}
+
+// ClearOrderTTLMinutes clears the value of the "order_ttl_minutes" field.
+func (nuo *NetworkUpdateOne) ClearOrderTTLMinutes() *NetworkUpdateOne {
+	nuo.mutation.ClearOrderTTLMinutes()
+	return nuo
+}
+
+// SetGenesisHash sets the "genesis_hash" field.
+func (nuo *NetworkUpdateOne) SetGenesisHash(s string) *NetworkUpdateOne {
+	nuo.mutation.SetGenesisHash(s)
+	return nuo
+}
+
+// SetNillableGenesisHash sets the "genesis_hash" field if the given value is not nil.
+func (nuo *NetworkUpdateOne) SetNillableGenesisHash(s *string) *NetworkUpdateOne {
+	if s != nil {
+		nuo.SetGenesisHash(*s)
+	}
+	return nuo
+}
+
+// ClearGenesisHash clears the value of the "genesis_hash" field.
+func (nuo *NetworkUpdateOne) ClearGenesisHash() *NetworkUpdateOne {
+	nuo.mutation.ClearGenesisHash()
+	return nuo
+}
+
+// SetGenesisMismatch sets the "genesis_mismatch" field.
+func (nuo *NetworkUpdateOne) SetGenesisMismatch(b bool) *NetworkUpdateOne {
+	nuo.mutation.SetGenesisMismatch(b)
+	return nuo
+}
+
+// SetNillableGenesisMismatch sets the "genesis_mismatch" field if the given value is not nil.
+func (nuo *NetworkUpdateOne) SetNillableGenesisMismatch(b *bool) *NetworkUpdateOne {
+	if b != nil {
+		nuo.SetGenesisMismatch(*b)
+	}
+	return nuo
+}
+
+// SetSmartAccountOwnerAddress sets the "smart_account_owner_address" field.
+func (nuo *NetworkUpdateOne) SetSmartAccountOwnerAddress(s string) *NetworkUpdateOne {
+	nuo.mutation.SetSmartAccountOwnerAddress(s)
+	return nuo
+}
+
+// SetNillableSmartAccountOwnerAddress sets the "smart_account_owner_address" field if the given value is not nil.
+func (nuo *NetworkUpdateOne) SetNillableSmartAccountOwnerAddress(s *string) *NetworkUpdateOne {
+	if s != nil {
+		nuo.SetSmartAccountOwnerAddress(*s)
+	}
+	return nuo
+}
+
+// ClearSmartAccountOwnerAddress clears the value of the "smart_account_owner_address" field.
+func (nuo *NetworkUpdateOne) ClearSmartAccountOwnerAddress() *NetworkUpdateOne {
+	nuo.mutation.ClearSmartAccountOwnerAddress()
+	return nuo
+}
+
+// SetSmartAccountOwnerSigner sets the "smart_account_owner_signer" field.
+func (nuo *NetworkUpdateOne) SetSmartAccountOwnerSigner(s string) *NetworkUpdateOne {
+	nuo.mutation.SetSmartAccountOwnerSigner(s)
+	return nuo
+}
+
+// SetNillableSmartAccountOwnerSigner sets the "smart_account_owner_signer" field if the given value is not nil.
+func (nuo *NetworkUpdateOne) SetNillableSmartAccountOwnerSigner(s *string) *NetworkUpdateOne {
+	if s != nil {
+		nuo.SetSmartAccountOwnerSigner(*s)
+	}
+	return nuo
+}
+
+// ClearSmartAccountOwnerSigner clears the value of the "smart_account_owner_signer" field.
+func (nuo *NetworkUpdateOne) ClearSmartAccountOwnerSigner() *NetworkUpdateOne {
+	nuo.mutation.ClearSmartAccountOwnerSigner()
+	return nuo
+}
+
+// SetWebhooksEnabled sets the "webhooks_enabled" field.
+func (nuo *NetworkUpdateOne) SetWebhooksEnabled(b bool) *NetworkUpdateOne {
+	nuo.mutation.SetWebhooksEnabled(b)
+	return nuo
+}
+
+// SetNillableWebhooksEnabled sets the "webhooks_enabled" field if the given value is not nil.
+func (nuo *NetworkUpdateOne) SetNillableWebhooksEnabled(b *bool) *NetworkUpdateOne {
+	if b != nil {
+		nuo.SetWebhooksEnabled(*b)
+	}
+	return nuo
+}
+
+// ClearWebhooksEnabled clears the value of the "webhooks_enabled" field.
+func (nuo *NetworkUpdateOne) ClearWebhooksEnabled() *NetworkUpdateOne {
+	nuo.mutation.ClearWebhooksEnabled()
+	return nuo
+}
+
+// SetWebsocketEnabled sets the "websocket_enabled" field.
+func (nuo *NetworkUpdateOne) SetWebsocketEnabled(b bool) *NetworkUpdateOne {
+	nuo.mutation.SetWebsocketEnabled(b)
+	return nuo
+}
+
+// SetNillableWebsocketEnabled sets the "websocket_enabled" field if the given value is not nil.
+func (nuo *NetworkUpdateOne) SetNillableWebsocketEnabled(b *bool) *NetworkUpdateOne {
+	if b != nil {
+		nuo.SetWebsocketEnabled(*b)
+	}
+	return nuo
+}
+
+// ClearWebsocketEnabled clears the value of the "websocket_enabled" field.
+func (nuo *NetworkUpdateOne) ClearWebsocketEnabled() *NetworkUpdateOne {
+	nuo.mutation.ClearWebsocketEnabled()
+	return nuo
+}
+
+// SetPollingEnabled sets the "polling_enabled" field.
+func (nuo *NetworkUpdateOne) SetPollingEnabled(b bool) *NetworkUpdateOne {
+	nuo.mutation.SetPollingEnabled(b)
+	return nuo
+}
+
+// SetNillablePollingEnabled sets the "polling_enabled" field if the given value is not nil.
+func (nuo *NetworkUpdateOne) SetNillablePollingEnabled(b *bool) *NetworkUpdateOne {
+	if b != nil {
+		nuo.SetPollingEnabled(*b)
+	}
+	return nuo
+}
+
+// ClearPollingEnabled clears the value of the "polling_enabled" field.
+func (nuo *NetworkUpdateOne) ClearPollingEnabled() *NetworkUpdateOne {
+	nuo.mutation.ClearPollingEnabled()
+	return nuo
+}
+
+// SetIsEnabled sets the "is_enabled" field.
+func (nuo *NetworkUpdateOne) SetIsEnabled(b bool) *NetworkUpdateOne {
+	nuo.mutation.SetIsEnabled(b)
+	return nuo
+}
+
+// SetNillableIsEnabled sets the "is_enabled" field if the given value is not nil.
+func (nuo *NetworkUpdateOne) SetNillableIsEnabled(b *bool) *NetworkUpdateOne {
+	if b != nil {
+		nuo.SetIsEnabled(*b)
+	}
+	return nuo
+}
+
 // AddTokenIDs adds the "tokens" edge to the Token entity by IDs.
 func (nuo *NetworkUpdateOne) AddTokenIDs(ids ...int) *NetworkUpdateOne {
 	nuo.mutation.AddTokenIDs(ids...)
@@ -646,6 +1458,21 @@ func (nuo *NetworkUpdateOne) SetPaymentWebhook(p *PaymentWebhook) *NetworkUpdate
 	return nuo.SetPaymentWebhookID(p.ID)
 }
 
+// AddRPCEndpointIDs adds the "rpc_endpoints" edge to the RPCEndpoint entity by IDs.
+func (nuo *NetworkUpdateOne) AddRPCEndpointIDs(ids ...int) *NetworkUpdateOne {
+	nuo.mutation.AddRPCEndpointIDs(ids...)
+	return nuo
+}
+
+// AddRPCEndpoints adds the "rpc_endpoints" edges to the RPCEndpoint entity.
+func (nuo *NetworkUpdateOne) AddRPCEndpoints(r ...*RPCEndpoint) *NetworkUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return nuo.AddRPCEndpointIDs(ids...)
+}
+
 // Mutation returns the NetworkMutation object of the builder.
 func (nuo *NetworkUpdateOne) Mutation() *NetworkMutation {
 	return nuo.mutation
@@ -678,6 +1505,27 @@ func (nuo *NetworkUpdateOne) ClearPaymentWebhook() *NetworkUpdateOne {
 	return nuo
 }
 
+// ClearRPCEndpoints clears all "rpc_endpoints" edges to the RPCEndpoint entity.
+func (nuo *NetworkUpdateOne) ClearRPCEndpoints() *NetworkUpdateOne {
+	nuo.mutation.ClearRPCEndpoints()
+	return nuo
+}
+
+// RemoveRPCEndpointIDs removes the "rpc_endpoints" edge to RPCEndpoint entities by IDs.
+func (nuo *NetworkUpdateOne) RemoveRPCEndpointIDs(ids ...int) *NetworkUpdateOne {
+	nuo.mutation.RemoveRPCEndpointIDs(ids...)
+	return nuo
+}
+
+// RemoveRPCEndpoints removes "rpc_endpoints" edges to RPCEndpoint entities.
+func (nuo *NetworkUpdateOne) RemoveRPCEndpoints(r ...*RPCEndpoint) *NetworkUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return nuo.RemoveRPCEndpointIDs(ids...)
+}
+
 // Where appends a list predicates to the NetworkUpdate builder.
 func (nuo *NetworkUpdateOne) Where(ps ...predicate.Network) *NetworkUpdateOne {
 	nuo.mutation.Where(ps...)
@@ -727,7 +1575,40 @@ func (nuo *NetworkUpdateOne) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (nuo *NetworkUpdateOne) check() error {
+	if v, ok := nuo.mutation.NetworkType(); ok {
+		if err := network.NetworkTypeValidator(v); err != nil {
+			return &ValidationError{Name: "network_type", err: fmt.Errorf(`ent: validator failed for field "Network.network_type": %w`, err)}
+		}
+	}
+	if v, ok := nuo.mutation.FinalityBlocks(); ok {
+		if err := network.FinalityBlocksValidator(v); err != nil {
+			return &ValidationError{Name: "finality_blocks", err: fmt.Errorf(`ent: validator failed for field "Network.finality_blocks": %w`, err)}
+		}
+	}
+	if v, ok := nuo.mutation.RequiredConfirmations(); ok {
+		if err := network.RequiredConfirmationsValidator(v); err != nil {
+			return &ValidationError{Name: "required_confirmations", err: fmt.Errorf(`ent: validator failed for field "Network.required_confirmations": %w`, err)}
+		}
+	}
+	if v, ok := nuo.mutation.SettlementPolicy(); ok {
+		if err := network.SettlementPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "settlement_policy", err: fmt.Errorf(`ent: validator failed for field "Network.settlement_policy": %w`, err)}
+		}
+	}
+	if v, ok := nuo.mutation.OrderTTLMinutes(); ok {
+		if err := network.OrderTTLMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "order_ttl_minutes", err: fmt.Errorf(`ent: validator failed for field "Network.order_ttl_minutes": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (nuo *NetworkUpdateOne) sqlSave(ctx context.Context) (_node *Network, err error) {
+	if err := nuo.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(network.Table, network.Columns, sqlgraph.NewFieldSpec(network.FieldID, field.TypeInt))
 	id, ok := nuo.mutation.ID()
 	if !ok {
@@ -765,12 +1646,33 @@ func (nuo *NetworkUpdateOne) sqlSave(ctx context.Context) (_node *Network, err e
 	if value, ok := nuo.mutation.Identifier(); ok {
 		_spec.SetField(network.FieldIdentifier, field.TypeString, value)
 	}
+	if value, ok := nuo.mutation.NetworkType(); ok {
+		_spec.SetField(network.FieldNetworkType, field.TypeEnum, value)
+	}
 	if value, ok := nuo.mutation.RPCEndpoint(); ok {
 		_spec.SetField(network.FieldRPCEndpoint, field.TypeString, value)
 	}
+	if value, ok := nuo.mutation.WssEndpoint(); ok {
+		_spec.SetField(network.FieldWssEndpoint, field.TypeString, value)
+	}
+	if nuo.mutation.WssEndpointCleared() {
+		_spec.ClearField(network.FieldWssEndpoint, field.TypeString)
+	}
 	if value, ok := nuo.mutation.GatewayContractAddress(); ok {
 		_spec.SetField(network.FieldGatewayContractAddress, field.TypeString, value)
 	}
+	if value, ok := nuo.mutation.ExplorerURL(); ok {
+		_spec.SetField(network.FieldExplorerURL, field.TypeString, value)
+	}
+	if nuo.mutation.ExplorerURLCleared() {
+		_spec.ClearField(network.FieldExplorerURL, field.TypeString)
+	}
+	if value, ok := nuo.mutation.AlchemyNetworkID(); ok {
+		_spec.SetField(network.FieldAlchemyNetworkID, field.TypeString, value)
+	}
+	if nuo.mutation.AlchemyNetworkIDCleared() {
+		_spec.ClearField(network.FieldAlchemyNetworkID, field.TypeString)
+	}
 	if value, ok := nuo.mutation.BlockTime(); ok {
 		_spec.SetField(network.FieldBlockTime, field.TypeFloat64, value)
 	}
@@ -798,6 +1700,72 @@ func (nuo *NetworkUpdateOne) sqlSave(ctx context.Context) (_node *Network, err e
 	if value, ok := nuo.mutation.AddedFee(); ok {
 		_spec.AddField(network.FieldFee, field.TypeFloat64, value)
 	}
+	if value, ok := nuo.mutation.FinalityBlocks(); ok {
+		_spec.SetField(network.FieldFinalityBlocks, field.TypeInt, value)
+	}
+	if value, ok := nuo.mutation.AddedFinalityBlocks(); ok {
+		_spec.AddField(network.FieldFinalityBlocks, field.TypeInt, value)
+	}
+	if value, ok := nuo.mutation.RequiredConfirmations(); ok {
+		_spec.SetField(network.FieldRequiredConfirmations, field.TypeInt, value)
+	}
+	if value, ok := nuo.mutation.AddedRequiredConfirmations(); ok {
+		_spec.AddField(network.FieldRequiredConfirmations, field.TypeInt, value)
+	}
+	if value, ok := nuo.mutation.SettlementPolicy(); ok {
+		_spec.SetField(network.FieldSettlementPolicy, field.TypeEnum, value)
+	}
+	if value, ok := nuo.mutation.OrderTTLMinutes(); ok {
+		_spec.SetField(network.FieldOrderTTLMinutes, field.TypeInt, value)
+	}
+	if value, ok := nuo.mutation.AddedOrderTTLMinutes(); ok {
+		_spec.AddField(network.FieldOrderTTLMinutes, field.TypeInt, value)
+	}
+	if nuo.mutation.OrderTTLMinutesCleared() {
+		_spec.ClearField(network.FieldOrderTTLMinutes, field.TypeInt)
+	}
+	if value, ok := nuo.mutation.GenesisHash(); ok {
+		_spec.SetField(network.FieldGenesisHash, field.TypeString, value)
+	}
+	if nuo.mutation.GenesisHashCleared() {
+		_spec.ClearField(network.FieldGenesisHash, field.TypeString)
+	}
+	if value, ok := nuo.mutation.GenesisMismatch(); ok {
+		_spec.SetField(network.FieldGenesisMismatch, field.TypeBool, value)
+	}
+	if value, ok := nuo.mutation.SmartAccountOwnerAddress(); ok {
+		_spec.SetField(network.FieldSmartAccountOwnerAddress, field.TypeString, value)
+	}
+	if nuo.mutation.SmartAccountOwnerAddressCleared() {
+		_spec.ClearField(network.FieldSmartAccountOwnerAddress, field.TypeString)
+	}
+	if value, ok := nuo.mutation.SmartAccountOwnerSigner(); ok {
+		_spec.SetField(network.FieldSmartAccountOwnerSigner, field.TypeString, value)
+	}
+	if nuo.mutation.SmartAccountOwnerSignerCleared() {
+		_spec.ClearField(network.FieldSmartAccountOwnerSigner, field.TypeString)
+	}
+	if value, ok := nuo.mutation.WebhooksEnabled(); ok {
+		_spec.SetField(network.FieldWebhooksEnabled, field.TypeBool, value)
+	}
+	if nuo.mutation.WebhooksEnabledCleared() {
+		_spec.ClearField(network.FieldWebhooksEnabled, field.TypeBool)
+	}
+	if value, ok := nuo.mutation.WebsocketEnabled(); ok {
+		_spec.SetField(network.FieldWebsocketEnabled, field.TypeBool, value)
+	}
+	if nuo.mutation.WebsocketEnabledCleared() {
+		_spec.ClearField(network.FieldWebsocketEnabled, field.TypeBool)
+	}
+	if value, ok := nuo.mutation.PollingEnabled(); ok {
+		_spec.SetField(network.FieldPollingEnabled, field.TypeBool, value)
+	}
+	if nuo.mutation.PollingEnabledCleared() {
+		_spec.ClearField(network.FieldPollingEnabled, field.TypeBool)
+	}
+	if value, ok := nuo.mutation.IsEnabled(); ok {
+		_spec.SetField(network.FieldIsEnabled, field.TypeBool, value)
+	}
 	if nuo.mutation.TokensCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -872,6 +1840,51 @@ func (nuo *NetworkUpdateOne) sqlSave(ctx context.Context) (_node *Network, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if nuo.mutation.RPCEndpointsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   network.RPCEndpointsTable,
+			Columns: []string{network.RPCEndpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rpcendpoint.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.RemovedRPCEndpointsIDs(); len(nodes) > 0 && !nuo.mutation.RPCEndpointsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   network.RPCEndpointsTable,
+			Columns: []string{network.RPCEndpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rpcendpoint.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.RPCEndpointsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   network.RPCEndpointsTable,
+			Columns: []string{network.RPCEndpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rpcendpoint.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Network{config: nuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
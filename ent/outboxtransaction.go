@@ -0,0 +1,293 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/NEDA-LABS/stablenode/ent/outboxtransaction"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// OutboxTransaction is the model entity for the OutboxTransaction schema.
+type OutboxTransaction struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind outboxtransaction.Kind `json:"kind,omitempty"`
+	// LockOrderID holds the value of the "lock_order_id" field.
+	LockOrderID uuid.UUID `json:"lock_order_id,omitempty"`
+	// Network holds the value of the "network" field.
+	Network string `json:"network,omitempty"`
+	// ChainID holds the value of the "chain_id" field.
+	ChainID int64 `json:"chain_id,omitempty"`
+	// FromAddress holds the value of the "from_address" field.
+	FromAddress string `json:"from_address,omitempty"`
+	// Payload holds the value of the "payload" field.
+	Payload []map[string]interface{} `json:"payload,omitempty"`
+	// Status holds the value of the "status" field.
+	Status outboxtransaction.Status `json:"status,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// LastError holds the value of the "last_error" field.
+	LastError string `json:"last_error,omitempty"`
+	// TransactionID holds the value of the "transaction_id" field.
+	TransactionID string `json:"transaction_id,omitempty"`
+	// TxHash holds the value of the "tx_hash" field.
+	TxHash string `json:"tx_hash,omitempty"`
+	// NextAttemptAt holds the value of the "next_attempt_at" field.
+	NextAttemptAt time.Time `json:"next_attempt_at,omitempty"`
+	// SentAt holds the value of the "sent_at" field.
+	SentAt time.Time `json:"sent_at,omitempty"`
+	// GasUsed holds the value of the "gas_used" field.
+	GasUsed int64 `json:"gas_used,omitempty"`
+	// GasCost holds the value of the "gas_cost" field.
+	GasCost      decimal.Decimal `json:"gas_cost,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OutboxTransaction) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case outboxtransaction.FieldPayload:
+			values[i] = new([]byte)
+		case outboxtransaction.FieldGasCost:
+			values[i] = new(decimal.Decimal)
+		case outboxtransaction.FieldChainID, outboxtransaction.FieldAttempts, outboxtransaction.FieldGasUsed:
+			values[i] = new(sql.NullInt64)
+		case outboxtransaction.FieldKind, outboxtransaction.FieldNetwork, outboxtransaction.FieldFromAddress, outboxtransaction.FieldStatus, outboxtransaction.FieldLastError, outboxtransaction.FieldTransactionID, outboxtransaction.FieldTxHash:
+			values[i] = new(sql.NullString)
+		case outboxtransaction.FieldCreatedAt, outboxtransaction.FieldUpdatedAt, outboxtransaction.FieldNextAttemptAt, outboxtransaction.FieldSentAt:
+			values[i] = new(sql.NullTime)
+		case outboxtransaction.FieldID, outboxtransaction.FieldLockOrderID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OutboxTransaction fields.
+func (ot *OutboxTransaction) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case outboxtransaction.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ot.ID = *value
+			}
+		case outboxtransaction.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ot.CreatedAt = value.Time
+			}
+		case outboxtransaction.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ot.UpdatedAt = value.Time
+			}
+		case outboxtransaction.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				ot.Kind = outboxtransaction.Kind(value.String)
+			}
+		case outboxtransaction.FieldLockOrderID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field lock_order_id", values[i])
+			} else if value != nil {
+				ot.LockOrderID = *value
+			}
+		case outboxtransaction.FieldNetwork:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field network", values[i])
+			} else if value.Valid {
+				ot.Network = value.String
+			}
+		case outboxtransaction.FieldChainID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field chain_id", values[i])
+			} else if value.Valid {
+				ot.ChainID = value.Int64
+			}
+		case outboxtransaction.FieldFromAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_address", values[i])
+			} else if value.Valid {
+				ot.FromAddress = value.String
+			}
+		case outboxtransaction.FieldPayload:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field payload", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ot.Payload); err != nil {
+					return fmt.Errorf("unmarshal field payload: %w", err)
+				}
+			}
+		case outboxtransaction.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ot.Status = outboxtransaction.Status(value.String)
+			}
+		case outboxtransaction.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				ot.Attempts = int(value.Int64)
+			}
+		case outboxtransaction.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				ot.LastError = value.String
+			}
+		case outboxtransaction.FieldTransactionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field transaction_id", values[i])
+			} else if value.Valid {
+				ot.TransactionID = value.String
+			}
+		case outboxtransaction.FieldTxHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tx_hash", values[i])
+			} else if value.Valid {
+				ot.TxHash = value.String
+			}
+		case outboxtransaction.FieldNextAttemptAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_attempt_at", values[i])
+			} else if value.Valid {
+				ot.NextAttemptAt = value.Time
+			}
+		case outboxtransaction.FieldSentAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field sent_at", values[i])
+			} else if value.Valid {
+				ot.SentAt = value.Time
+			}
+		case outboxtransaction.FieldGasUsed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field gas_used", values[i])
+			} else if value.Valid {
+				ot.GasUsed = value.Int64
+			}
+		case outboxtransaction.FieldGasCost:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field gas_cost", values[i])
+			} else if value != nil {
+				ot.GasCost = *value
+			}
+		default:
+			ot.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OutboxTransaction.
+// This includes values selected through modifiers, order, etc.
+func (ot *OutboxTransaction) Value(name string) (ent.Value, error) {
+	return ot.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this OutboxTransaction.
+// Note that you need to call OutboxTransaction.Unwrap() before calling this method if this OutboxTransaction
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ot *OutboxTransaction) Update() *OutboxTransactionUpdateOne {
+	return NewOutboxTransactionClient(ot.config).UpdateOne(ot)
+}
+
+// Unwrap unwraps the OutboxTransaction entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ot *OutboxTransaction) Unwrap() *OutboxTransaction {
+	_tx, ok := ot.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OutboxTransaction is not a transactional entity")
+	}
+	ot.config.driver = _tx.drv
+	return ot
+}
+
+// String implements the fmt.Stringer.
+func (ot *OutboxTransaction) String() string {
+	var builder strings.Builder
+	builder.WriteString("OutboxTransaction(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ot.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ot.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ot.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", ot.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("lock_order_id=")
+	builder.WriteString(fmt.Sprintf("%v", ot.LockOrderID))
+	builder.WriteString(", ")
+	builder.WriteString("network=")
+	builder.WriteString(ot.Network)
+	builder.WriteString(", ")
+	builder.WriteString("chain_id=")
+	builder.WriteString(fmt.Sprintf("%v", ot.ChainID))
+	builder.WriteString(", ")
+	builder.WriteString("from_address=")
+	builder.WriteString(ot.FromAddress)
+	builder.WriteString(", ")
+	builder.WriteString("payload=")
+	builder.WriteString(fmt.Sprintf("%v", ot.Payload))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", ot.Status))
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", ot.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("last_error=")
+	builder.WriteString(ot.LastError)
+	builder.WriteString(", ")
+	builder.WriteString("transaction_id=")
+	builder.WriteString(ot.TransactionID)
+	builder.WriteString(", ")
+	builder.WriteString("tx_hash=")
+	builder.WriteString(ot.TxHash)
+	builder.WriteString(", ")
+	builder.WriteString("next_attempt_at=")
+	builder.WriteString(ot.NextAttemptAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("sent_at=")
+	builder.WriteString(ot.SentAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("gas_used=")
+	builder.WriteString(fmt.Sprintf("%v", ot.GasUsed))
+	builder.WriteString(", ")
+	builder.WriteString("gas_cost=")
+	builder.WriteString(fmt.Sprintf("%v", ot.GasCost))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OutboxTransactions is a parsable slice of OutboxTransaction.
+type OutboxTransactions []*OutboxTransaction
@@ -0,0 +1,907 @@
+// Code generated by ent, DO NOT EDIT.
+
+package outboxtransaction
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// LockOrderID applies equality check predicate on the "lock_order_id" field. It's identical to LockOrderIDEQ.
+func LockOrderID(v uuid.UUID) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldEQ(FieldLockOrderID, v))
+}
+
+// Network applies equality check predicate on the "network" field. It's identical to NetworkEQ.
+func Network(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldEQ(FieldNetwork, v))
+}
+
+// ChainID applies equality check predicate on the "chain_id" field. It's identical to ChainIDEQ.
+func ChainID(v int64) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldEQ(FieldChainID, v))
+}
+
+// FromAddress applies equality check predicate on the "from_address" field. It's identical to FromAddressEQ.
+func FromAddress(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldEQ(FieldFromAddress, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldEQ(FieldAttempts, v))
+}
+
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldEQ(FieldLastError, v))
+}
+
+// TransactionID applies equality check predicate on the "transaction_id" field. It's identical to TransactionIDEQ.
+func TransactionID(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldEQ(FieldTransactionID, v))
+}
+
+// TxHash applies equality check predicate on the "tx_hash" field. It's identical to TxHashEQ.
+func TxHash(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldEQ(FieldTxHash, v))
+}
+
+// NextAttemptAt applies equality check predicate on the "next_attempt_at" field. It's identical to NextAttemptAtEQ.
+func NextAttemptAt(v time.Time) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldEQ(FieldNextAttemptAt, v))
+}
+
+// SentAt applies equality check predicate on the "sent_at" field. It's identical to SentAtEQ.
+func SentAt(v time.Time) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldEQ(FieldSentAt, v))
+}
+
+// GasUsed applies equality check predicate on the "gas_used" field. It's identical to GasUsedEQ.
+func GasUsed(v int64) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldEQ(FieldGasUsed, v))
+}
+
+// GasCost applies equality check predicate on the "gas_cost" field. It's identical to GasCostEQ.
+func GasCost(v decimal.Decimal) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldEQ(FieldGasCost, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// LockOrderIDEQ applies the EQ predicate on the "lock_order_id" field.
+func LockOrderIDEQ(v uuid.UUID) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldEQ(FieldLockOrderID, v))
+}
+
+// LockOrderIDNEQ applies the NEQ predicate on the "lock_order_id" field.
+func LockOrderIDNEQ(v uuid.UUID) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldNEQ(FieldLockOrderID, v))
+}
+
+// LockOrderIDIn applies the In predicate on the "lock_order_id" field.
+func LockOrderIDIn(vs ...uuid.UUID) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldIn(FieldLockOrderID, vs...))
+}
+
+// LockOrderIDNotIn applies the NotIn predicate on the "lock_order_id" field.
+func LockOrderIDNotIn(vs ...uuid.UUID) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldNotIn(FieldLockOrderID, vs...))
+}
+
+// LockOrderIDGT applies the GT predicate on the "lock_order_id" field.
+func LockOrderIDGT(v uuid.UUID) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldGT(FieldLockOrderID, v))
+}
+
+// LockOrderIDGTE applies the GTE predicate on the "lock_order_id" field.
+func LockOrderIDGTE(v uuid.UUID) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldGTE(FieldLockOrderID, v))
+}
+
+// LockOrderIDLT applies the LT predicate on the "lock_order_id" field.
+func LockOrderIDLT(v uuid.UUID) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldLT(FieldLockOrderID, v))
+}
+
+// LockOrderIDLTE applies the LTE predicate on the "lock_order_id" field.
+func LockOrderIDLTE(v uuid.UUID) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldLTE(FieldLockOrderID, v))
+}
+
+// NetworkEQ applies the EQ predicate on the "network" field.
+func NetworkEQ(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldEQ(FieldNetwork, v))
+}
+
+// NetworkNEQ applies the NEQ predicate on the "network" field.
+func NetworkNEQ(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldNEQ(FieldNetwork, v))
+}
+
+// NetworkIn applies the In predicate on the "network" field.
+func NetworkIn(vs ...string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldIn(FieldNetwork, vs...))
+}
+
+// NetworkNotIn applies the NotIn predicate on the "network" field.
+func NetworkNotIn(vs ...string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldNotIn(FieldNetwork, vs...))
+}
+
+// NetworkGT applies the GT predicate on the "network" field.
+func NetworkGT(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldGT(FieldNetwork, v))
+}
+
+// NetworkGTE applies the GTE predicate on the "network" field.
+func NetworkGTE(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldGTE(FieldNetwork, v))
+}
+
+// NetworkLT applies the LT predicate on the "network" field.
+func NetworkLT(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldLT(FieldNetwork, v))
+}
+
+// NetworkLTE applies the LTE predicate on the "network" field.
+func NetworkLTE(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldLTE(FieldNetwork, v))
+}
+
+// NetworkContains applies the Contains predicate on the "network" field.
+func NetworkContains(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldContains(FieldNetwork, v))
+}
+
+// NetworkHasPrefix applies the HasPrefix predicate on the "network" field.
+func NetworkHasPrefix(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldHasPrefix(FieldNetwork, v))
+}
+
+// NetworkHasSuffix applies the HasSuffix predicate on the "network" field.
+func NetworkHasSuffix(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldHasSuffix(FieldNetwork, v))
+}
+
+// NetworkEqualFold applies the EqualFold predicate on the "network" field.
+func NetworkEqualFold(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldEqualFold(FieldNetwork, v))
+}
+
+// NetworkContainsFold applies the ContainsFold predicate on the "network" field.
+func NetworkContainsFold(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldContainsFold(FieldNetwork, v))
+}
+
+// ChainIDEQ applies the EQ predicate on the "chain_id" field.
+func ChainIDEQ(v int64) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldEQ(FieldChainID, v))
+}
+
+// ChainIDNEQ applies the NEQ predicate on the "chain_id" field.
+func ChainIDNEQ(v int64) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldNEQ(FieldChainID, v))
+}
+
+// ChainIDIn applies the In predicate on the "chain_id" field.
+func ChainIDIn(vs ...int64) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldIn(FieldChainID, vs...))
+}
+
+// ChainIDNotIn applies the NotIn predicate on the "chain_id" field.
+func ChainIDNotIn(vs ...int64) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldNotIn(FieldChainID, vs...))
+}
+
+// ChainIDGT applies the GT predicate on the "chain_id" field.
+func ChainIDGT(v int64) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldGT(FieldChainID, v))
+}
+
+// ChainIDGTE applies the GTE predicate on the "chain_id" field.
+func ChainIDGTE(v int64) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldGTE(FieldChainID, v))
+}
+
+// ChainIDLT applies the LT predicate on the "chain_id" field.
+func ChainIDLT(v int64) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldLT(FieldChainID, v))
+}
+
+// ChainIDLTE applies the LTE predicate on the "chain_id" field.
+func ChainIDLTE(v int64) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldLTE(FieldChainID, v))
+}
+
+// FromAddressEQ applies the EQ predicate on the "from_address" field.
+func FromAddressEQ(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldEQ(FieldFromAddress, v))
+}
+
+// FromAddressNEQ applies the NEQ predicate on the "from_address" field.
+func FromAddressNEQ(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldNEQ(FieldFromAddress, v))
+}
+
+// FromAddressIn applies the In predicate on the "from_address" field.
+func FromAddressIn(vs ...string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldIn(FieldFromAddress, vs...))
+}
+
+// FromAddressNotIn applies the NotIn predicate on the "from_address" field.
+func FromAddressNotIn(vs ...string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldNotIn(FieldFromAddress, vs...))
+}
+
+// FromAddressGT applies the GT predicate on the "from_address" field.
+func FromAddressGT(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldGT(FieldFromAddress, v))
+}
+
+// FromAddressGTE applies the GTE predicate on the "from_address" field.
+func FromAddressGTE(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldGTE(FieldFromAddress, v))
+}
+
+// FromAddressLT applies the LT predicate on the "from_address" field.
+func FromAddressLT(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldLT(FieldFromAddress, v))
+}
+
+// FromAddressLTE applies the LTE predicate on the "from_address" field.
+func FromAddressLTE(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldLTE(FieldFromAddress, v))
+}
+
+// FromAddressContains applies the Contains predicate on the "from_address" field.
+func FromAddressContains(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldContains(FieldFromAddress, v))
+}
+
+// FromAddressHasPrefix applies the HasPrefix predicate on the "from_address" field.
+func FromAddressHasPrefix(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldHasPrefix(FieldFromAddress, v))
+}
+
+// FromAddressHasSuffix applies the HasSuffix predicate on the "from_address" field.
+func FromAddressHasSuffix(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldHasSuffix(FieldFromAddress, v))
+}
+
+// FromAddressEqualFold applies the EqualFold predicate on the "from_address" field.
+func FromAddressEqualFold(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldEqualFold(FieldFromAddress, v))
+}
+
+// FromAddressContainsFold applies the ContainsFold predicate on the "from_address" field.
+func FromAddressContainsFold(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldContainsFold(FieldFromAddress, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldLTE(FieldAttempts, v))
+}
+
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "last_error" field.
+func LastErrorIsNil() predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldIsNull(FieldLastError))
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "last_error" field.
+func LastErrorNotNil() predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldNotNull(FieldLastError))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// TransactionIDEQ applies the EQ predicate on the "transaction_id" field.
+func TransactionIDEQ(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldEQ(FieldTransactionID, v))
+}
+
+// TransactionIDNEQ applies the NEQ predicate on the "transaction_id" field.
+func TransactionIDNEQ(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldNEQ(FieldTransactionID, v))
+}
+
+// TransactionIDIn applies the In predicate on the "transaction_id" field.
+func TransactionIDIn(vs ...string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldIn(FieldTransactionID, vs...))
+}
+
+// TransactionIDNotIn applies the NotIn predicate on the "transaction_id" field.
+func TransactionIDNotIn(vs ...string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldNotIn(FieldTransactionID, vs...))
+}
+
+// TransactionIDGT applies the GT predicate on the "transaction_id" field.
+func TransactionIDGT(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldGT(FieldTransactionID, v))
+}
+
+// TransactionIDGTE applies the GTE predicate on the "transaction_id" field.
+func TransactionIDGTE(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldGTE(FieldTransactionID, v))
+}
+
+// TransactionIDLT applies the LT predicate on the "transaction_id" field.
+func TransactionIDLT(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldLT(FieldTransactionID, v))
+}
+
+// TransactionIDLTE applies the LTE predicate on the "transaction_id" field.
+func TransactionIDLTE(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldLTE(FieldTransactionID, v))
+}
+
+// TransactionIDContains applies the Contains predicate on the "transaction_id" field.
+func TransactionIDContains(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldContains(FieldTransactionID, v))
+}
+
+// TransactionIDHasPrefix applies the HasPrefix predicate on the "transaction_id" field.
+func TransactionIDHasPrefix(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldHasPrefix(FieldTransactionID, v))
+}
+
+// TransactionIDHasSuffix applies the HasSuffix predicate on the "transaction_id" field.
+func TransactionIDHasSuffix(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldHasSuffix(FieldTransactionID, v))
+}
+
+// TransactionIDIsNil applies the IsNil predicate on the "transaction_id" field.
+func TransactionIDIsNil() predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldIsNull(FieldTransactionID))
+}
+
+// TransactionIDNotNil applies the NotNil predicate on the "transaction_id" field.
+func TransactionIDNotNil() predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldNotNull(FieldTransactionID))
+}
+
+// TransactionIDEqualFold applies the EqualFold predicate on the "transaction_id" field.
+func TransactionIDEqualFold(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldEqualFold(FieldTransactionID, v))
+}
+
+// TransactionIDContainsFold applies the ContainsFold predicate on the "transaction_id" field.
+func TransactionIDContainsFold(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldContainsFold(FieldTransactionID, v))
+}
+
+// TxHashEQ applies the EQ predicate on the "tx_hash" field.
+func TxHashEQ(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldEQ(FieldTxHash, v))
+}
+
+// TxHashNEQ applies the NEQ predicate on the "tx_hash" field.
+func TxHashNEQ(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldNEQ(FieldTxHash, v))
+}
+
+// TxHashIn applies the In predicate on the "tx_hash" field.
+func TxHashIn(vs ...string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldIn(FieldTxHash, vs...))
+}
+
+// TxHashNotIn applies the NotIn predicate on the "tx_hash" field.
+func TxHashNotIn(vs ...string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldNotIn(FieldTxHash, vs...))
+}
+
+// TxHashGT applies the GT predicate on the "tx_hash" field.
+func TxHashGT(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldGT(FieldTxHash, v))
+}
+
+// TxHashGTE applies the GTE predicate on the "tx_hash" field.
+func TxHashGTE(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldGTE(FieldTxHash, v))
+}
+
+// TxHashLT applies the LT predicate on the "tx_hash" field.
+func TxHashLT(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldLT(FieldTxHash, v))
+}
+
+// TxHashLTE applies the LTE predicate on the "tx_hash" field.
+func TxHashLTE(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldLTE(FieldTxHash, v))
+}
+
+// TxHashContains applies the Contains predicate on the "tx_hash" field.
+func TxHashContains(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldContains(FieldTxHash, v))
+}
+
+// TxHashHasPrefix applies the HasPrefix predicate on the "tx_hash" field.
+func TxHashHasPrefix(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldHasPrefix(FieldTxHash, v))
+}
+
+// TxHashHasSuffix applies the HasSuffix predicate on the "tx_hash" field.
+func TxHashHasSuffix(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldHasSuffix(FieldTxHash, v))
+}
+
+// TxHashIsNil applies the IsNil predicate on the "tx_hash" field.
+func TxHashIsNil() predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldIsNull(FieldTxHash))
+}
+
+// TxHashNotNil applies the NotNil predicate on the "tx_hash" field.
+func TxHashNotNil() predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldNotNull(FieldTxHash))
+}
+
+// TxHashEqualFold applies the EqualFold predicate on the "tx_hash" field.
+func TxHashEqualFold(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldEqualFold(FieldTxHash, v))
+}
+
+// TxHashContainsFold applies the ContainsFold predicate on the "tx_hash" field.
+func TxHashContainsFold(v string) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldContainsFold(FieldTxHash, v))
+}
+
+// NextAttemptAtEQ applies the EQ predicate on the "next_attempt_at" field.
+func NextAttemptAtEQ(v time.Time) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldEQ(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtNEQ applies the NEQ predicate on the "next_attempt_at" field.
+func NextAttemptAtNEQ(v time.Time) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldNEQ(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtIn applies the In predicate on the "next_attempt_at" field.
+func NextAttemptAtIn(vs ...time.Time) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldIn(FieldNextAttemptAt, vs...))
+}
+
+// NextAttemptAtNotIn applies the NotIn predicate on the "next_attempt_at" field.
+func NextAttemptAtNotIn(vs ...time.Time) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldNotIn(FieldNextAttemptAt, vs...))
+}
+
+// NextAttemptAtGT applies the GT predicate on the "next_attempt_at" field.
+func NextAttemptAtGT(v time.Time) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldGT(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtGTE applies the GTE predicate on the "next_attempt_at" field.
+func NextAttemptAtGTE(v time.Time) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldGTE(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtLT applies the LT predicate on the "next_attempt_at" field.
+func NextAttemptAtLT(v time.Time) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldLT(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtLTE applies the LTE predicate on the "next_attempt_at" field.
+func NextAttemptAtLTE(v time.Time) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldLTE(FieldNextAttemptAt, v))
+}
+
+// SentAtEQ applies the EQ predicate on the "sent_at" field.
+func SentAtEQ(v time.Time) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldEQ(FieldSentAt, v))
+}
+
+// SentAtNEQ applies the NEQ predicate on the "sent_at" field.
+func SentAtNEQ(v time.Time) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldNEQ(FieldSentAt, v))
+}
+
+// SentAtIn applies the In predicate on the "sent_at" field.
+func SentAtIn(vs ...time.Time) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldIn(FieldSentAt, vs...))
+}
+
+// SentAtNotIn applies the NotIn predicate on the "sent_at" field.
+func SentAtNotIn(vs ...time.Time) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldNotIn(FieldSentAt, vs...))
+}
+
+// SentAtGT applies the GT predicate on the "sent_at" field.
+func SentAtGT(v time.Time) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldGT(FieldSentAt, v))
+}
+
+// SentAtGTE applies the GTE predicate on the "sent_at" field.
+func SentAtGTE(v time.Time) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldGTE(FieldSentAt, v))
+}
+
+// SentAtLT applies the LT predicate on the "sent_at" field.
+func SentAtLT(v time.Time) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldLT(FieldSentAt, v))
+}
+
+// SentAtLTE applies the LTE predicate on the "sent_at" field.
+func SentAtLTE(v time.Time) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldLTE(FieldSentAt, v))
+}
+
+// SentAtIsNil applies the IsNil predicate on the "sent_at" field.
+func SentAtIsNil() predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldIsNull(FieldSentAt))
+}
+
+// SentAtNotNil applies the NotNil predicate on the "sent_at" field.
+func SentAtNotNil() predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldNotNull(FieldSentAt))
+}
+
+// GasUsedEQ applies the EQ predicate on the "gas_used" field.
+func GasUsedEQ(v int64) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldEQ(FieldGasUsed, v))
+}
+
+// GasUsedNEQ applies the NEQ predicate on the "gas_used" field.
+func GasUsedNEQ(v int64) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldNEQ(FieldGasUsed, v))
+}
+
+// GasUsedIn applies the In predicate on the "gas_used" field.
+func GasUsedIn(vs ...int64) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldIn(FieldGasUsed, vs...))
+}
+
+// GasUsedNotIn applies the NotIn predicate on the "gas_used" field.
+func GasUsedNotIn(vs ...int64) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldNotIn(FieldGasUsed, vs...))
+}
+
+// GasUsedGT applies the GT predicate on the "gas_used" field.
+func GasUsedGT(v int64) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldGT(FieldGasUsed, v))
+}
+
+// GasUsedGTE applies the GTE predicate on the "gas_used" field.
+func GasUsedGTE(v int64) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldGTE(FieldGasUsed, v))
+}
+
+// GasUsedLT applies the LT predicate on the "gas_used" field.
+func GasUsedLT(v int64) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldLT(FieldGasUsed, v))
+}
+
+// GasUsedLTE applies the LTE predicate on the "gas_used" field.
+func GasUsedLTE(v int64) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldLTE(FieldGasUsed, v))
+}
+
+// GasCostEQ applies the EQ predicate on the "gas_cost" field.
+func GasCostEQ(v decimal.Decimal) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldEQ(FieldGasCost, v))
+}
+
+// GasCostNEQ applies the NEQ predicate on the "gas_cost" field.
+func GasCostNEQ(v decimal.Decimal) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldNEQ(FieldGasCost, v))
+}
+
+// GasCostIn applies the In predicate on the "gas_cost" field.
+func GasCostIn(vs ...decimal.Decimal) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldIn(FieldGasCost, vs...))
+}
+
+// GasCostNotIn applies the NotIn predicate on the "gas_cost" field.
+func GasCostNotIn(vs ...decimal.Decimal) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldNotIn(FieldGasCost, vs...))
+}
+
+// GasCostGT applies the GT predicate on the "gas_cost" field.
+func GasCostGT(v decimal.Decimal) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldGT(FieldGasCost, v))
+}
+
+// GasCostGTE applies the GTE predicate on the "gas_cost" field.
+func GasCostGTE(v decimal.Decimal) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldGTE(FieldGasCost, v))
+}
+
+// GasCostLT applies the LT predicate on the "gas_cost" field.
+func GasCostLT(v decimal.Decimal) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldLT(FieldGasCost, v))
+}
+
+// GasCostLTE applies the LTE predicate on the "gas_cost" field.
+func GasCostLTE(v decimal.Decimal) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.FieldLTE(FieldGasCost, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OutboxTransaction) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OutboxTransaction) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OutboxTransaction) predicate.OutboxTransaction {
+	return predicate.OutboxTransaction(sql.NotPredicates(p))
+}
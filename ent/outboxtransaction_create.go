@@ -0,0 +1,1384 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/outboxtransaction"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// OutboxTransactionCreate is the builder for creating a OutboxTransaction entity.
+type OutboxTransactionCreate struct {
+	config
+	mutation *OutboxTransactionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (otc *OutboxTransactionCreate) SetCreatedAt(t time.Time) *OutboxTransactionCreate {
+	otc.mutation.SetCreatedAt(t)
+	return otc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (otc *OutboxTransactionCreate) SetNillableCreatedAt(t *time.Time) *OutboxTransactionCreate {
+	if t != nil {
+		otc.SetCreatedAt(*t)
+	}
+	return otc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (otc *OutboxTransactionCreate) SetUpdatedAt(t time.Time) *OutboxTransactionCreate {
+	otc.mutation.SetUpdatedAt(t)
+	return otc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (otc *OutboxTransactionCreate) SetNillableUpdatedAt(t *time.Time) *OutboxTransactionCreate {
+	if t != nil {
+		otc.SetUpdatedAt(*t)
+	}
+	return otc
+}
+
+// SetKind sets the "kind" field.
+func (otc *OutboxTransactionCreate) SetKind(o outboxtransaction.Kind) *OutboxTransactionCreate {
+	otc.mutation.SetKind(o)
+	return otc
+}
+
+// SetLockOrderID sets the "lock_order_id" field.
+func (otc *OutboxTransactionCreate) SetLockOrderID(u uuid.UUID) *OutboxTransactionCreate {
+	otc.mutation.SetLockOrderID(u)
+	return otc
+}
+
+// SetNetwork sets the "network" field.
+func (otc *OutboxTransactionCreate) SetNetwork(s string) *OutboxTransactionCreate {
+	otc.mutation.SetNetwork(s)
+	return otc
+}
+
+// SetChainID sets the "chain_id" field.
+func (otc *OutboxTransactionCreate) SetChainID(i int64) *OutboxTransactionCreate {
+	otc.mutation.SetChainID(i)
+	return otc
+}
+
+// SetFromAddress sets the "from_address" field.
+func (otc *OutboxTransactionCreate) SetFromAddress(s string) *OutboxTransactionCreate {
+	otc.mutation.SetFromAddress(s)
+	return otc
+}
+
+// SetPayload sets the "payload" field.
+func (otc *OutboxTransactionCreate) SetPayload(m []map[string]interface{}) *OutboxTransactionCreate {
+	otc.mutation.SetPayload(m)
+	return otc
+}
+
+// SetStatus sets the "status" field.
+func (otc *OutboxTransactionCreate) SetStatus(o outboxtransaction.Status) *OutboxTransactionCreate {
+	otc.mutation.SetStatus(o)
+	return otc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (otc *OutboxTransactionCreate) SetNillableStatus(o *outboxtransaction.Status) *OutboxTransactionCreate {
+	if o != nil {
+		otc.SetStatus(*o)
+	}
+	return otc
+}
+
+// SetAttempts sets the "attempts" field.
+func (otc *OutboxTransactionCreate) SetAttempts(i int) *OutboxTransactionCreate {
+	otc.mutation.SetAttempts(i)
+	return otc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (otc *OutboxTransactionCreate) SetNillableAttempts(i *int) *OutboxTransactionCreate {
+	if i != nil {
+		otc.SetAttempts(*i)
+	}
+	return otc
+}
+
+// SetLastError sets the "last_error" field.
+func (otc *OutboxTransactionCreate) SetLastError(s string) *OutboxTransactionCreate {
+	otc.mutation.SetLastError(s)
+	return otc
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (otc *OutboxTransactionCreate) SetNillableLastError(s *string) *OutboxTransactionCreate {
+	if s != nil {
+		otc.SetLastError(*s)
+	}
+	return otc
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (otc *OutboxTransactionCreate) SetTransactionID(s string) *OutboxTransactionCreate {
+	otc.mutation.SetTransactionID(s)
+	return otc
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (otc *OutboxTransactionCreate) SetNillableTransactionID(s *string) *OutboxTransactionCreate {
+	if s != nil {
+		otc.SetTransactionID(*s)
+	}
+	return otc
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (otc *OutboxTransactionCreate) SetTxHash(s string) *OutboxTransactionCreate {
+	otc.mutation.SetTxHash(s)
+	return otc
+}
+
+// SetNillableTxHash sets the "tx_hash" field if the given value is not nil.
+func (otc *OutboxTransactionCreate) SetNillableTxHash(s *string) *OutboxTransactionCreate {
+	if s != nil {
+		otc.SetTxHash(*s)
+	}
+	return otc
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (otc *OutboxTransactionCreate) SetNextAttemptAt(t time.Time) *OutboxTransactionCreate {
+	otc.mutation.SetNextAttemptAt(t)
+	return otc
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (otc *OutboxTransactionCreate) SetNillableNextAttemptAt(t *time.Time) *OutboxTransactionCreate {
+	if t != nil {
+		otc.SetNextAttemptAt(*t)
+	}
+	return otc
+}
+
+// SetSentAt sets the "sent_at" field.
+func (otc *OutboxTransactionCreate) SetSentAt(t time.Time) *OutboxTransactionCreate {
+	otc.mutation.SetSentAt(t)
+	return otc
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (otc *OutboxTransactionCreate) SetNillableSentAt(t *time.Time) *OutboxTransactionCreate {
+	if t != nil {
+		otc.SetSentAt(*t)
+	}
+	return otc
+}
+
+// SetGasUsed sets the "gas_used" field.
+func (otc *OutboxTransactionCreate) SetGasUsed(i int64) *OutboxTransactionCreate {
+	otc.mutation.SetGasUsed(i)
+	return otc
+}
+
+// SetNillableGasUsed sets the "gas_used" field if the given value is not nil.
+func (otc *OutboxTransactionCreate) SetNillableGasUsed(i *int64) *OutboxTransactionCreate {
+	if i != nil {
+		otc.SetGasUsed(*i)
+	}
+	return otc
+}
+
+// SetGasCost sets the "gas_cost" field.
+func (otc *OutboxTransactionCreate) SetGasCost(d decimal.Decimal) *OutboxTransactionCreate {
+	otc.mutation.SetGasCost(d)
+	return otc
+}
+
+// SetNillableGasCost sets the "gas_cost" field if the given value is not nil.
+func (otc *OutboxTransactionCreate) SetNillableGasCost(d *decimal.Decimal) *OutboxTransactionCreate {
+	if d != nil {
+		otc.SetGasCost(*d)
+	}
+	return otc
+}
+
+// SetID sets the "id" field.
+func (otc *OutboxTransactionCreate) SetID(u uuid.UUID) *OutboxTransactionCreate {
+	otc.mutation.SetID(u)
+	return otc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (otc *OutboxTransactionCreate) SetNillableID(u *uuid.UUID) *OutboxTransactionCreate {
+	if u != nil {
+		otc.SetID(*u)
+	}
+	return otc
+}
+
+// Mutation returns the OutboxTransactionMutation object of the builder.
+func (otc *OutboxTransactionCreate) Mutation() *OutboxTransactionMutation {
+	return otc.mutation
+}
+
+// Save creates the OutboxTransaction in the database.
+func (otc *OutboxTransactionCreate) Save(ctx context.Context) (*OutboxTransaction, error) {
+	otc.defaults()
+	return withHooks(ctx, otc.sqlSave, otc.mutation, otc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (otc *OutboxTransactionCreate) SaveX(ctx context.Context) *OutboxTransaction {
+	v, err := otc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (otc *OutboxTransactionCreate) Exec(ctx context.Context) error {
+	_, err := otc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (otc *OutboxTransactionCreate) ExecX(ctx context.Context) {
+	if err := otc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (otc *OutboxTransactionCreate) defaults() {
+	if _, ok := otc.mutation.CreatedAt(); !ok {
+		v := outboxtransaction.DefaultCreatedAt()
+		otc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := otc.mutation.UpdatedAt(); !ok {
+		v := outboxtransaction.DefaultUpdatedAt()
+		otc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := otc.mutation.Status(); !ok {
+		v := outboxtransaction.DefaultStatus
+		otc.mutation.SetStatus(v)
+	}
+	if _, ok := otc.mutation.Attempts(); !ok {
+		v := outboxtransaction.DefaultAttempts
+		otc.mutation.SetAttempts(v)
+	}
+	if _, ok := otc.mutation.NextAttemptAt(); !ok {
+		v := outboxtransaction.DefaultNextAttemptAt()
+		otc.mutation.SetNextAttemptAt(v)
+	}
+	if _, ok := otc.mutation.GasUsed(); !ok {
+		v := outboxtransaction.DefaultGasUsed
+		otc.mutation.SetGasUsed(v)
+	}
+	if _, ok := otc.mutation.GasCost(); !ok {
+		v := outboxtransaction.DefaultGasCost()
+		otc.mutation.SetGasCost(v)
+	}
+	if _, ok := otc.mutation.ID(); !ok {
+		v := outboxtransaction.DefaultID()
+		otc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (otc *OutboxTransactionCreate) check() error {
+	if _, ok := otc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "OutboxTransaction.created_at"`)}
+	}
+	if _, ok := otc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "OutboxTransaction.updated_at"`)}
+	}
+	if _, ok := otc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "OutboxTransaction.kind"`)}
+	}
+	if v, ok := otc.mutation.Kind(); ok {
+		if err := outboxtransaction.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "OutboxTransaction.kind": %w`, err)}
+		}
+	}
+	if _, ok := otc.mutation.LockOrderID(); !ok {
+		return &ValidationError{Name: "lock_order_id", err: errors.New(`ent: missing required field "OutboxTransaction.lock_order_id"`)}
+	}
+	if _, ok := otc.mutation.Network(); !ok {
+		return &ValidationError{Name: "network", err: errors.New(`ent: missing required field "OutboxTransaction.network"`)}
+	}
+	if _, ok := otc.mutation.ChainID(); !ok {
+		return &ValidationError{Name: "chain_id", err: errors.New(`ent: missing required field "OutboxTransaction.chain_id"`)}
+	}
+	if _, ok := otc.mutation.FromAddress(); !ok {
+		return &ValidationError{Name: "from_address", err: errors.New(`ent: missing required field "OutboxTransaction.from_address"`)}
+	}
+	if _, ok := otc.mutation.Payload(); !ok {
+		return &ValidationError{Name: "payload", err: errors.New(`ent: missing required field "OutboxTransaction.payload"`)}
+	}
+	if _, ok := otc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "OutboxTransaction.status"`)}
+	}
+	if v, ok := otc.mutation.Status(); ok {
+		if err := outboxtransaction.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "OutboxTransaction.status": %w`, err)}
+		}
+	}
+	if _, ok := otc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "OutboxTransaction.attempts"`)}
+	}
+	if v, ok := otc.mutation.LastError(); ok {
+		if err := outboxtransaction.LastErrorValidator(v); err != nil {
+			return &ValidationError{Name: "last_error", err: fmt.Errorf(`ent: validator failed for field "OutboxTransaction.last_error": %w`, err)}
+		}
+	}
+	if v, ok := otc.mutation.TxHash(); ok {
+		if err := outboxtransaction.TxHashValidator(v); err != nil {
+			return &ValidationError{Name: "tx_hash", err: fmt.Errorf(`ent: validator failed for field "OutboxTransaction.tx_hash": %w`, err)}
+		}
+	}
+	if _, ok := otc.mutation.NextAttemptAt(); !ok {
+		return &ValidationError{Name: "next_attempt_at", err: errors.New(`ent: missing required field "OutboxTransaction.next_attempt_at"`)}
+	}
+	if _, ok := otc.mutation.GasUsed(); !ok {
+		return &ValidationError{Name: "gas_used", err: errors.New(`ent: missing required field "OutboxTransaction.gas_used"`)}
+	}
+	if _, ok := otc.mutation.GasCost(); !ok {
+		return &ValidationError{Name: "gas_cost", err: errors.New(`ent: missing required field "OutboxTransaction.gas_cost"`)}
+	}
+	return nil
+}
+
+func (otc *OutboxTransactionCreate) sqlSave(ctx context.Context) (*OutboxTransaction, error) {
+	if err := otc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := otc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, otc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	otc.mutation.id = &_node.ID
+	otc.mutation.done = true
+	return _node, nil
+}
+
+func (otc *OutboxTransactionCreate) createSpec() (*OutboxTransaction, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OutboxTransaction{config: otc.config}
+		_spec = sqlgraph.NewCreateSpec(outboxtransaction.Table, sqlgraph.NewFieldSpec(outboxtransaction.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = otc.conflict
+	if id, ok := otc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := otc.mutation.CreatedAt(); ok {
+		_spec.SetField(outboxtransaction.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := otc.mutation.UpdatedAt(); ok {
+		_spec.SetField(outboxtransaction.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := otc.mutation.Kind(); ok {
+		_spec.SetField(outboxtransaction.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := otc.mutation.LockOrderID(); ok {
+		_spec.SetField(outboxtransaction.FieldLockOrderID, field.TypeUUID, value)
+		_node.LockOrderID = value
+	}
+	if value, ok := otc.mutation.Network(); ok {
+		_spec.SetField(outboxtransaction.FieldNetwork, field.TypeString, value)
+		_node.Network = value
+	}
+	if value, ok := otc.mutation.ChainID(); ok {
+		_spec.SetField(outboxtransaction.FieldChainID, field.TypeInt64, value)
+		_node.ChainID = value
+	}
+	if value, ok := otc.mutation.FromAddress(); ok {
+		_spec.SetField(outboxtransaction.FieldFromAddress, field.TypeString, value)
+		_node.FromAddress = value
+	}
+	if value, ok := otc.mutation.Payload(); ok {
+		_spec.SetField(outboxtransaction.FieldPayload, field.TypeJSON, value)
+		_node.Payload = value
+	}
+	if value, ok := otc.mutation.Status(); ok {
+		_spec.SetField(outboxtransaction.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := otc.mutation.Attempts(); ok {
+		_spec.SetField(outboxtransaction.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := otc.mutation.LastError(); ok {
+		_spec.SetField(outboxtransaction.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := otc.mutation.TransactionID(); ok {
+		_spec.SetField(outboxtransaction.FieldTransactionID, field.TypeString, value)
+		_node.TransactionID = value
+	}
+	if value, ok := otc.mutation.TxHash(); ok {
+		_spec.SetField(outboxtransaction.FieldTxHash, field.TypeString, value)
+		_node.TxHash = value
+	}
+	if value, ok := otc.mutation.NextAttemptAt(); ok {
+		_spec.SetField(outboxtransaction.FieldNextAttemptAt, field.TypeTime, value)
+		_node.NextAttemptAt = value
+	}
+	if value, ok := otc.mutation.SentAt(); ok {
+		_spec.SetField(outboxtransaction.FieldSentAt, field.TypeTime, value)
+		_node.SentAt = value
+	}
+	if value, ok := otc.mutation.GasUsed(); ok {
+		_spec.SetField(outboxtransaction.FieldGasUsed, field.TypeInt64, value)
+		_node.GasUsed = value
+	}
+	if value, ok := otc.mutation.GasCost(); ok {
+		_spec.SetField(outboxtransaction.FieldGasCost, field.TypeFloat64, value)
+		_node.GasCost = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.OutboxTransaction.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.OutboxTransactionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (otc *OutboxTransactionCreate) OnConflict(opts ...sql.ConflictOption) *OutboxTransactionUpsertOne {
+	otc.conflict = opts
+	return &OutboxTransactionUpsertOne{
+		create: otc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.OutboxTransaction.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (otc *OutboxTransactionCreate) OnConflictColumns(columns ...string) *OutboxTransactionUpsertOne {
+	otc.conflict = append(otc.conflict, sql.ConflictColumns(columns...))
+	return &OutboxTransactionUpsertOne{
+		create: otc,
+	}
+}
+
+type (
+	// OutboxTransactionUpsertOne is the builder for "upsert"-ing
+	//  one OutboxTransaction node.
+	OutboxTransactionUpsertOne struct {
+		create *OutboxTransactionCreate
+	}
+
+	// OutboxTransactionUpsert is the "OnConflict" setter.
+	OutboxTransactionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *OutboxTransactionUpsert) SetUpdatedAt(v time.Time) *OutboxTransactionUpsert {
+	u.Set(outboxtransaction.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *OutboxTransactionUpsert) UpdateUpdatedAt() *OutboxTransactionUpsert {
+	u.SetExcluded(outboxtransaction.FieldUpdatedAt)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *OutboxTransactionUpsert) SetStatus(v outboxtransaction.Status) *OutboxTransactionUpsert {
+	u.Set(outboxtransaction.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *OutboxTransactionUpsert) UpdateStatus() *OutboxTransactionUpsert {
+	u.SetExcluded(outboxtransaction.FieldStatus)
+	return u
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *OutboxTransactionUpsert) SetAttempts(v int) *OutboxTransactionUpsert {
+	u.Set(outboxtransaction.FieldAttempts, v)
+	return u
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *OutboxTransactionUpsert) UpdateAttempts() *OutboxTransactionUpsert {
+	u.SetExcluded(outboxtransaction.FieldAttempts)
+	return u
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *OutboxTransactionUpsert) AddAttempts(v int) *OutboxTransactionUpsert {
+	u.Add(outboxtransaction.FieldAttempts, v)
+	return u
+}
+
+// SetLastError sets the "last_error" field.
+func (u *OutboxTransactionUpsert) SetLastError(v string) *OutboxTransactionUpsert {
+	u.Set(outboxtransaction.FieldLastError, v)
+	return u
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *OutboxTransactionUpsert) UpdateLastError() *OutboxTransactionUpsert {
+	u.SetExcluded(outboxtransaction.FieldLastError)
+	return u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *OutboxTransactionUpsert) ClearLastError() *OutboxTransactionUpsert {
+	u.SetNull(outboxtransaction.FieldLastError)
+	return u
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (u *OutboxTransactionUpsert) SetTransactionID(v string) *OutboxTransactionUpsert {
+	u.Set(outboxtransaction.FieldTransactionID, v)
+	return u
+}
+
+// UpdateTransactionID sets the "transaction_id" field to the value that was provided on create.
+func (u *OutboxTransactionUpsert) UpdateTransactionID() *OutboxTransactionUpsert {
+	u.SetExcluded(outboxtransaction.FieldTransactionID)
+	return u
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (u *OutboxTransactionUpsert) ClearTransactionID() *OutboxTransactionUpsert {
+	u.SetNull(outboxtransaction.FieldTransactionID)
+	return u
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (u *OutboxTransactionUpsert) SetTxHash(v string) *OutboxTransactionUpsert {
+	u.Set(outboxtransaction.FieldTxHash, v)
+	return u
+}
+
+// UpdateTxHash sets the "tx_hash" field to the value that was provided on create.
+func (u *OutboxTransactionUpsert) UpdateTxHash() *OutboxTransactionUpsert {
+	u.SetExcluded(outboxtransaction.FieldTxHash)
+	return u
+}
+
+// ClearTxHash clears the value of the "tx_hash" field.
+func (u *OutboxTransactionUpsert) ClearTxHash() *OutboxTransactionUpsert {
+	u.SetNull(outboxtransaction.FieldTxHash)
+	return u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (u *OutboxTransactionUpsert) SetNextAttemptAt(v time.Time) *OutboxTransactionUpsert {
+	u.Set(outboxtransaction.FieldNextAttemptAt, v)
+	return u
+}
+
+// UpdateNextAttemptAt sets the "next_attempt_at" field to the value that was provided on create.
+func (u *OutboxTransactionUpsert) UpdateNextAttemptAt() *OutboxTransactionUpsert {
+	u.SetExcluded(outboxtransaction.FieldNextAttemptAt)
+	return u
+}
+
+// SetSentAt sets the "sent_at" field.
+func (u *OutboxTransactionUpsert) SetSentAt(v time.Time) *OutboxTransactionUpsert {
+	u.Set(outboxtransaction.FieldSentAt, v)
+	return u
+}
+
+// UpdateSentAt sets the "sent_at" field to the value that was provided on create.
+func (u *OutboxTransactionUpsert) UpdateSentAt() *OutboxTransactionUpsert {
+	u.SetExcluded(outboxtransaction.FieldSentAt)
+	return u
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (u *OutboxTransactionUpsert) ClearSentAt() *OutboxTransactionUpsert {
+	u.SetNull(outboxtransaction.FieldSentAt)
+	return u
+}
+
+// SetGasUsed sets the "gas_used" field.
+func (u *OutboxTransactionUpsert) SetGasUsed(v int64) *OutboxTransactionUpsert {
+	u.Set(outboxtransaction.FieldGasUsed, v)
+	return u
+}
+
+// UpdateGasUsed sets the "gas_used" field to the value that was provided on create.
+func (u *OutboxTransactionUpsert) UpdateGasUsed() *OutboxTransactionUpsert {
+	u.SetExcluded(outboxtransaction.FieldGasUsed)
+	return u
+}
+
+// AddGasUsed adds v to the "gas_used" field.
+func (u *OutboxTransactionUpsert) AddGasUsed(v int64) *OutboxTransactionUpsert {
+	u.Add(outboxtransaction.FieldGasUsed, v)
+	return u
+}
+
+// SetGasCost sets the "gas_cost" field.
+func (u *OutboxTransactionUpsert) SetGasCost(v decimal.Decimal) *OutboxTransactionUpsert {
+	u.Set(outboxtransaction.FieldGasCost, v)
+	return u
+}
+
+// UpdateGasCost sets the "gas_cost" field to the value that was provided on create.
+func (u *OutboxTransactionUpsert) UpdateGasCost() *OutboxTransactionUpsert {
+	u.SetExcluded(outboxtransaction.FieldGasCost)
+	return u
+}
+
+// AddGasCost adds v to the "gas_cost" field.
+func (u *OutboxTransactionUpsert) AddGasCost(v decimal.Decimal) *OutboxTransactionUpsert {
+	u.Add(outboxtransaction.FieldGasCost, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.OutboxTransaction.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(outboxtransaction.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *OutboxTransactionUpsertOne) UpdateNewValues() *OutboxTransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(outboxtransaction.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(outboxtransaction.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.Kind(); exists {
+			s.SetIgnore(outboxtransaction.FieldKind)
+		}
+		if _, exists := u.create.mutation.LockOrderID(); exists {
+			s.SetIgnore(outboxtransaction.FieldLockOrderID)
+		}
+		if _, exists := u.create.mutation.Network(); exists {
+			s.SetIgnore(outboxtransaction.FieldNetwork)
+		}
+		if _, exists := u.create.mutation.ChainID(); exists {
+			s.SetIgnore(outboxtransaction.FieldChainID)
+		}
+		if _, exists := u.create.mutation.FromAddress(); exists {
+			s.SetIgnore(outboxtransaction.FieldFromAddress)
+		}
+		if _, exists := u.create.mutation.Payload(); exists {
+			s.SetIgnore(outboxtransaction.FieldPayload)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.OutboxTransaction.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *OutboxTransactionUpsertOne) Ignore() *OutboxTransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *OutboxTransactionUpsertOne) DoNothing() *OutboxTransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the OutboxTransactionCreate.OnConflict
+// documentation for more info.
+func (u *OutboxTransactionUpsertOne) Update(set func(*OutboxTransactionUpsert)) *OutboxTransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&OutboxTransactionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *OutboxTransactionUpsertOne) SetUpdatedAt(v time.Time) *OutboxTransactionUpsertOne {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *OutboxTransactionUpsertOne) UpdateUpdatedAt() *OutboxTransactionUpsertOne {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *OutboxTransactionUpsertOne) SetStatus(v outboxtransaction.Status) *OutboxTransactionUpsertOne {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *OutboxTransactionUpsertOne) UpdateStatus() *OutboxTransactionUpsertOne {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *OutboxTransactionUpsertOne) SetAttempts(v int) *OutboxTransactionUpsertOne {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *OutboxTransactionUpsertOne) AddAttempts(v int) *OutboxTransactionUpsertOne {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *OutboxTransactionUpsertOne) UpdateAttempts() *OutboxTransactionUpsertOne {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *OutboxTransactionUpsertOne) SetLastError(v string) *OutboxTransactionUpsertOne {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *OutboxTransactionUpsertOne) UpdateLastError() *OutboxTransactionUpsertOne {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *OutboxTransactionUpsertOne) ClearLastError() *OutboxTransactionUpsertOne {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (u *OutboxTransactionUpsertOne) SetTransactionID(v string) *OutboxTransactionUpsertOne {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.SetTransactionID(v)
+	})
+}
+
+// UpdateTransactionID sets the "transaction_id" field to the value that was provided on create.
+func (u *OutboxTransactionUpsertOne) UpdateTransactionID() *OutboxTransactionUpsertOne {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.UpdateTransactionID()
+	})
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (u *OutboxTransactionUpsertOne) ClearTransactionID() *OutboxTransactionUpsertOne {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.ClearTransactionID()
+	})
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (u *OutboxTransactionUpsertOne) SetTxHash(v string) *OutboxTransactionUpsertOne {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.SetTxHash(v)
+	})
+}
+
+// UpdateTxHash sets the "tx_hash" field to the value that was provided on create.
+func (u *OutboxTransactionUpsertOne) UpdateTxHash() *OutboxTransactionUpsertOne {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.UpdateTxHash()
+	})
+}
+
+// ClearTxHash clears the value of the "tx_hash" field.
+func (u *OutboxTransactionUpsertOne) ClearTxHash() *OutboxTransactionUpsertOne {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.ClearTxHash()
+	})
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (u *OutboxTransactionUpsertOne) SetNextAttemptAt(v time.Time) *OutboxTransactionUpsertOne {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.SetNextAttemptAt(v)
+	})
+}
+
+// UpdateNextAttemptAt sets the "next_attempt_at" field to the value that was provided on create.
+func (u *OutboxTransactionUpsertOne) UpdateNextAttemptAt() *OutboxTransactionUpsertOne {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.UpdateNextAttemptAt()
+	})
+}
+
+// SetSentAt sets the "sent_at" field.
+func (u *OutboxTransactionUpsertOne) SetSentAt(v time.Time) *OutboxTransactionUpsertOne {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.SetSentAt(v)
+	})
+}
+
+// UpdateSentAt sets the "sent_at" field to the value that was provided on create.
+func (u *OutboxTransactionUpsertOne) UpdateSentAt() *OutboxTransactionUpsertOne {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.UpdateSentAt()
+	})
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (u *OutboxTransactionUpsertOne) ClearSentAt() *OutboxTransactionUpsertOne {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.ClearSentAt()
+	})
+}
+
+// SetGasUsed sets the "gas_used" field.
+func (u *OutboxTransactionUpsertOne) SetGasUsed(v int64) *OutboxTransactionUpsertOne {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.SetGasUsed(v)
+	})
+}
+
+// AddGasUsed adds v to the "gas_used" field.
+func (u *OutboxTransactionUpsertOne) AddGasUsed(v int64) *OutboxTransactionUpsertOne {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.AddGasUsed(v)
+	})
+}
+
+// UpdateGasUsed sets the "gas_used" field to the value that was provided on create.
+func (u *OutboxTransactionUpsertOne) UpdateGasUsed() *OutboxTransactionUpsertOne {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.UpdateGasUsed()
+	})
+}
+
+// SetGasCost sets the "gas_cost" field.
+func (u *OutboxTransactionUpsertOne) SetGasCost(v decimal.Decimal) *OutboxTransactionUpsertOne {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.SetGasCost(v)
+	})
+}
+
+// AddGasCost adds v to the "gas_cost" field.
+func (u *OutboxTransactionUpsertOne) AddGasCost(v decimal.Decimal) *OutboxTransactionUpsertOne {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.AddGasCost(v)
+	})
+}
+
+// UpdateGasCost sets the "gas_cost" field to the value that was provided on create.
+func (u *OutboxTransactionUpsertOne) UpdateGasCost() *OutboxTransactionUpsertOne {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.UpdateGasCost()
+	})
+}
+
+// Exec executes the query.
+func (u *OutboxTransactionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for OutboxTransactionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *OutboxTransactionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *OutboxTransactionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: OutboxTransactionUpsertOne.ID is not supported by MySQL driver. Use OutboxTransactionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *OutboxTransactionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// OutboxTransactionCreateBulk is the builder for creating many OutboxTransaction entities in bulk.
+type OutboxTransactionCreateBulk struct {
+	config
+	err      error
+	builders []*OutboxTransactionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the OutboxTransaction entities in the database.
+func (otcb *OutboxTransactionCreateBulk) Save(ctx context.Context) ([]*OutboxTransaction, error) {
+	if otcb.err != nil {
+		return nil, otcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(otcb.builders))
+	nodes := make([]*OutboxTransaction, len(otcb.builders))
+	mutators := make([]Mutator, len(otcb.builders))
+	for i := range otcb.builders {
+		func(i int, root context.Context) {
+			builder := otcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OutboxTransactionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, otcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = otcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, otcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, otcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (otcb *OutboxTransactionCreateBulk) SaveX(ctx context.Context) []*OutboxTransaction {
+	v, err := otcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (otcb *OutboxTransactionCreateBulk) Exec(ctx context.Context) error {
+	_, err := otcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (otcb *OutboxTransactionCreateBulk) ExecX(ctx context.Context) {
+	if err := otcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.OutboxTransaction.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.OutboxTransactionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (otcb *OutboxTransactionCreateBulk) OnConflict(opts ...sql.ConflictOption) *OutboxTransactionUpsertBulk {
+	otcb.conflict = opts
+	return &OutboxTransactionUpsertBulk{
+		create: otcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.OutboxTransaction.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (otcb *OutboxTransactionCreateBulk) OnConflictColumns(columns ...string) *OutboxTransactionUpsertBulk {
+	otcb.conflict = append(otcb.conflict, sql.ConflictColumns(columns...))
+	return &OutboxTransactionUpsertBulk{
+		create: otcb,
+	}
+}
+
+// OutboxTransactionUpsertBulk is the builder for "upsert"-ing
+// a bulk of OutboxTransaction nodes.
+type OutboxTransactionUpsertBulk struct {
+	create *OutboxTransactionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.OutboxTransaction.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(outboxtransaction.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *OutboxTransactionUpsertBulk) UpdateNewValues() *OutboxTransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(outboxtransaction.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(outboxtransaction.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.Kind(); exists {
+				s.SetIgnore(outboxtransaction.FieldKind)
+			}
+			if _, exists := b.mutation.LockOrderID(); exists {
+				s.SetIgnore(outboxtransaction.FieldLockOrderID)
+			}
+			if _, exists := b.mutation.Network(); exists {
+				s.SetIgnore(outboxtransaction.FieldNetwork)
+			}
+			if _, exists := b.mutation.ChainID(); exists {
+				s.SetIgnore(outboxtransaction.FieldChainID)
+			}
+			if _, exists := b.mutation.FromAddress(); exists {
+				s.SetIgnore(outboxtransaction.FieldFromAddress)
+			}
+			if _, exists := b.mutation.Payload(); exists {
+				s.SetIgnore(outboxtransaction.FieldPayload)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.OutboxTransaction.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *OutboxTransactionUpsertBulk) Ignore() *OutboxTransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *OutboxTransactionUpsertBulk) DoNothing() *OutboxTransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the OutboxTransactionCreateBulk.OnConflict
+// documentation for more info.
+func (u *OutboxTransactionUpsertBulk) Update(set func(*OutboxTransactionUpsert)) *OutboxTransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&OutboxTransactionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *OutboxTransactionUpsertBulk) SetUpdatedAt(v time.Time) *OutboxTransactionUpsertBulk {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *OutboxTransactionUpsertBulk) UpdateUpdatedAt() *OutboxTransactionUpsertBulk {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *OutboxTransactionUpsertBulk) SetStatus(v outboxtransaction.Status) *OutboxTransactionUpsertBulk {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *OutboxTransactionUpsertBulk) UpdateStatus() *OutboxTransactionUpsertBulk {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *OutboxTransactionUpsertBulk) SetAttempts(v int) *OutboxTransactionUpsertBulk {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *OutboxTransactionUpsertBulk) AddAttempts(v int) *OutboxTransactionUpsertBulk {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *OutboxTransactionUpsertBulk) UpdateAttempts() *OutboxTransactionUpsertBulk {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *OutboxTransactionUpsertBulk) SetLastError(v string) *OutboxTransactionUpsertBulk {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *OutboxTransactionUpsertBulk) UpdateLastError() *OutboxTransactionUpsertBulk {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *OutboxTransactionUpsertBulk) ClearLastError() *OutboxTransactionUpsertBulk {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (u *OutboxTransactionUpsertBulk) SetTransactionID(v string) *OutboxTransactionUpsertBulk {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.SetTransactionID(v)
+	})
+}
+
+// UpdateTransactionID sets the "transaction_id" field to the value that was provided on create.
+func (u *OutboxTransactionUpsertBulk) UpdateTransactionID() *OutboxTransactionUpsertBulk {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.UpdateTransactionID()
+	})
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (u *OutboxTransactionUpsertBulk) ClearTransactionID() *OutboxTransactionUpsertBulk {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.ClearTransactionID()
+	})
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (u *OutboxTransactionUpsertBulk) SetTxHash(v string) *OutboxTransactionUpsertBulk {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.SetTxHash(v)
+	})
+}
+
+// UpdateTxHash sets the "tx_hash" field to the value that was provided on create.
+func (u *OutboxTransactionUpsertBulk) UpdateTxHash() *OutboxTransactionUpsertBulk {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.UpdateTxHash()
+	})
+}
+
+// ClearTxHash clears the value of the "tx_hash" field.
+func (u *OutboxTransactionUpsertBulk) ClearTxHash() *OutboxTransactionUpsertBulk {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.ClearTxHash()
+	})
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (u *OutboxTransactionUpsertBulk) SetNextAttemptAt(v time.Time) *OutboxTransactionUpsertBulk {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.SetNextAttemptAt(v)
+	})
+}
+
+// UpdateNextAttemptAt sets the "next_attempt_at" field to the value that was provided on create.
+func (u *OutboxTransactionUpsertBulk) UpdateNextAttemptAt() *OutboxTransactionUpsertBulk {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.UpdateNextAttemptAt()
+	})
+}
+
+// SetSentAt sets the "sent_at" field.
+func (u *OutboxTransactionUpsertBulk) SetSentAt(v time.Time) *OutboxTransactionUpsertBulk {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.SetSentAt(v)
+	})
+}
+
+// UpdateSentAt sets the "sent_at" field to the value that was provided on create.
+func (u *OutboxTransactionUpsertBulk) UpdateSentAt() *OutboxTransactionUpsertBulk {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.UpdateSentAt()
+	})
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (u *OutboxTransactionUpsertBulk) ClearSentAt() *OutboxTransactionUpsertBulk {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.ClearSentAt()
+	})
+}
+
+// SetGasUsed sets the "gas_used" field.
+func (u *OutboxTransactionUpsertBulk) SetGasUsed(v int64) *OutboxTransactionUpsertBulk {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.SetGasUsed(v)
+	})
+}
+
+// AddGasUsed adds v to the "gas_used" field.
+func (u *OutboxTransactionUpsertBulk) AddGasUsed(v int64) *OutboxTransactionUpsertBulk {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.AddGasUsed(v)
+	})
+}
+
+// UpdateGasUsed sets the "gas_used" field to the value that was provided on create.
+func (u *OutboxTransactionUpsertBulk) UpdateGasUsed() *OutboxTransactionUpsertBulk {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.UpdateGasUsed()
+	})
+}
+
+// SetGasCost sets the "gas_cost" field.
+func (u *OutboxTransactionUpsertBulk) SetGasCost(v decimal.Decimal) *OutboxTransactionUpsertBulk {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.SetGasCost(v)
+	})
+}
+
+// AddGasCost adds v to the "gas_cost" field.
+func (u *OutboxTransactionUpsertBulk) AddGasCost(v decimal.Decimal) *OutboxTransactionUpsertBulk {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.AddGasCost(v)
+	})
+}
+
+// UpdateGasCost sets the "gas_cost" field to the value that was provided on create.
+func (u *OutboxTransactionUpsertBulk) UpdateGasCost() *OutboxTransactionUpsertBulk {
+	return u.Update(func(s *OutboxTransactionUpsert) {
+		s.UpdateGasCost()
+	})
+}
+
+// Exec executes the query.
+func (u *OutboxTransactionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the OutboxTransactionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for OutboxTransactionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *OutboxTransactionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/outboxtransaction"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+)
+
+// OutboxTransactionDelete is the builder for deleting a OutboxTransaction entity.
+type OutboxTransactionDelete struct {
+	config
+	hooks    []Hook
+	mutation *OutboxTransactionMutation
+}
+
+// Where appends a list predicates to the OutboxTransactionDelete builder.
+func (otd *OutboxTransactionDelete) Where(ps ...predicate.OutboxTransaction) *OutboxTransactionDelete {
+	otd.mutation.Where(ps...)
+	return otd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (otd *OutboxTransactionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, otd.sqlExec, otd.mutation, otd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (otd *OutboxTransactionDelete) ExecX(ctx context.Context) int {
+	n, err := otd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (otd *OutboxTransactionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(outboxtransaction.Table, sqlgraph.NewFieldSpec(outboxtransaction.FieldID, field.TypeUUID))
+	if ps := otd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, otd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	otd.mutation.done = true
+	return affected, err
+}
+
+// OutboxTransactionDeleteOne is the builder for deleting a single OutboxTransaction entity.
+type OutboxTransactionDeleteOne struct {
+	otd *OutboxTransactionDelete
+}
+
+// Where appends a list predicates to the OutboxTransactionDelete builder.
+func (otdo *OutboxTransactionDeleteOne) Where(ps ...predicate.OutboxTransaction) *OutboxTransactionDeleteOne {
+	otdo.otd.mutation.Where(ps...)
+	return otdo
+}
+
+// Exec executes the deletion query.
+func (otdo *OutboxTransactionDeleteOne) Exec(ctx context.Context) error {
+	n, err := otdo.otd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{outboxtransaction.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (otdo *OutboxTransactionDeleteOne) ExecX(ctx context.Context) {
+	if err := otdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,528 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/outboxtransaction"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// OutboxTransactionQuery is the builder for querying OutboxTransaction entities.
+type OutboxTransactionQuery struct {
+	config
+	ctx        *QueryContext
+	order      []outboxtransaction.OrderOption
+	inters     []Interceptor
+	predicates []predicate.OutboxTransaction
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the OutboxTransactionQuery builder.
+func (otq *OutboxTransactionQuery) Where(ps ...predicate.OutboxTransaction) *OutboxTransactionQuery {
+	otq.predicates = append(otq.predicates, ps...)
+	return otq
+}
+
+// Limit the number of records to be returned by this query.
+func (otq *OutboxTransactionQuery) Limit(limit int) *OutboxTransactionQuery {
+	otq.ctx.Limit = &limit
+	return otq
+}
+
+// Offset to start from.
+func (otq *OutboxTransactionQuery) Offset(offset int) *OutboxTransactionQuery {
+	otq.ctx.Offset = &offset
+	return otq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (otq *OutboxTransactionQuery) Unique(unique bool) *OutboxTransactionQuery {
+	otq.ctx.Unique = &unique
+	return otq
+}
+
+// Order specifies how the records should be ordered.
+func (otq *OutboxTransactionQuery) Order(o ...outboxtransaction.OrderOption) *OutboxTransactionQuery {
+	otq.order = append(otq.order, o...)
+	return otq
+}
+
+// First returns the first OutboxTransaction entity from the query.
+// Returns a *NotFoundError when no OutboxTransaction was found.
+func (otq *OutboxTransactionQuery) First(ctx context.Context) (*OutboxTransaction, error) {
+	nodes, err := otq.Limit(1).All(setContextOp(ctx, otq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{outboxtransaction.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (otq *OutboxTransactionQuery) FirstX(ctx context.Context) *OutboxTransaction {
+	node, err := otq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first OutboxTransaction ID from the query.
+// Returns a *NotFoundError when no OutboxTransaction ID was found.
+func (otq *OutboxTransactionQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = otq.Limit(1).IDs(setContextOp(ctx, otq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{outboxtransaction.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (otq *OutboxTransactionQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := otq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single OutboxTransaction entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one OutboxTransaction entity is found.
+// Returns a *NotFoundError when no OutboxTransaction entities are found.
+func (otq *OutboxTransactionQuery) Only(ctx context.Context) (*OutboxTransaction, error) {
+	nodes, err := otq.Limit(2).All(setContextOp(ctx, otq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{outboxtransaction.Label}
+	default:
+		return nil, &NotSingularError{outboxtransaction.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (otq *OutboxTransactionQuery) OnlyX(ctx context.Context) *OutboxTransaction {
+	node, err := otq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only OutboxTransaction ID in the query.
+// Returns a *NotSingularError when more than one OutboxTransaction ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (otq *OutboxTransactionQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = otq.Limit(2).IDs(setContextOp(ctx, otq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{outboxtransaction.Label}
+	default:
+		err = &NotSingularError{outboxtransaction.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (otq *OutboxTransactionQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := otq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of OutboxTransactions.
+func (otq *OutboxTransactionQuery) All(ctx context.Context) ([]*OutboxTransaction, error) {
+	ctx = setContextOp(ctx, otq.ctx, ent.OpQueryAll)
+	if err := otq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*OutboxTransaction, *OutboxTransactionQuery]()
+	return withInterceptors[[]*OutboxTransaction](ctx, otq, qr, otq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (otq *OutboxTransactionQuery) AllX(ctx context.Context) []*OutboxTransaction {
+	nodes, err := otq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of OutboxTransaction IDs.
+func (otq *OutboxTransactionQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if otq.ctx.Unique == nil && otq.path != nil {
+		otq.Unique(true)
+	}
+	ctx = setContextOp(ctx, otq.ctx, ent.OpQueryIDs)
+	if err = otq.Select(outboxtransaction.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (otq *OutboxTransactionQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := otq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (otq *OutboxTransactionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, otq.ctx, ent.OpQueryCount)
+	if err := otq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, otq, querierCount[*OutboxTransactionQuery](), otq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (otq *OutboxTransactionQuery) CountX(ctx context.Context) int {
+	count, err := otq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (otq *OutboxTransactionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, otq.ctx, ent.OpQueryExist)
+	switch _, err := otq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (otq *OutboxTransactionQuery) ExistX(ctx context.Context) bool {
+	exist, err := otq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the OutboxTransactionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (otq *OutboxTransactionQuery) Clone() *OutboxTransactionQuery {
+	if otq == nil {
+		return nil
+	}
+	return &OutboxTransactionQuery{
+		config:     otq.config,
+		ctx:        otq.ctx.Clone(),
+		order:      append([]outboxtransaction.OrderOption{}, otq.order...),
+		inters:     append([]Interceptor{}, otq.inters...),
+		predicates: append([]predicate.OutboxTransaction{}, otq.predicates...),
+		// clone intermediate query.
+		sql:  otq.sql.Clone(),
+		path: otq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.OutboxTransaction.Query().
+//		GroupBy(outboxtransaction.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (otq *OutboxTransactionQuery) GroupBy(field string, fields ...string) *OutboxTransactionGroupBy {
+	otq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &OutboxTransactionGroupBy{build: otq}
+	grbuild.flds = &otq.ctx.Fields
+	grbuild.label = outboxtransaction.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.OutboxTransaction.Query().
+//		Select(outboxtransaction.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (otq *OutboxTransactionQuery) Select(fields ...string) *OutboxTransactionSelect {
+	otq.ctx.Fields = append(otq.ctx.Fields, fields...)
+	sbuild := &OutboxTransactionSelect{OutboxTransactionQuery: otq}
+	sbuild.label = outboxtransaction.Label
+	sbuild.flds, sbuild.scan = &otq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a OutboxTransactionSelect configured with the given aggregations.
+func (otq *OutboxTransactionQuery) Aggregate(fns ...AggregateFunc) *OutboxTransactionSelect {
+	return otq.Select().Aggregate(fns...)
+}
+
+func (otq *OutboxTransactionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range otq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, otq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range otq.ctx.Fields {
+		if !outboxtransaction.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if otq.path != nil {
+		prev, err := otq.path(ctx)
+		if err != nil {
+			return err
+		}
+		otq.sql = prev
+	}
+	return nil
+}
+
+func (otq *OutboxTransactionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*OutboxTransaction, error) {
+	var (
+		nodes = []*OutboxTransaction{}
+		_spec = otq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*OutboxTransaction).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &OutboxTransaction{config: otq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, otq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (otq *OutboxTransactionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := otq.querySpec()
+	_spec.Node.Columns = otq.ctx.Fields
+	if len(otq.ctx.Fields) > 0 {
+		_spec.Unique = otq.ctx.Unique != nil && *otq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, otq.driver, _spec)
+}
+
+func (otq *OutboxTransactionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(outboxtransaction.Table, outboxtransaction.Columns, sqlgraph.NewFieldSpec(outboxtransaction.FieldID, field.TypeUUID))
+	_spec.From = otq.sql
+	if unique := otq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if otq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := otq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, outboxtransaction.FieldID)
+		for i := range fields {
+			if fields[i] != outboxtransaction.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := otq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := otq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := otq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := otq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (otq *OutboxTransactionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(otq.driver.Dialect())
+	t1 := builder.Table(outboxtransaction.Table)
+	columns := otq.ctx.Fields
+	if len(columns) == 0 {
+		columns = outboxtransaction.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if otq.sql != nil {
+		selector = otq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if otq.ctx.Unique != nil && *otq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range otq.predicates {
+		p(selector)
+	}
+	for _, p := range otq.order {
+		p(selector)
+	}
+	if offset := otq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := otq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// OutboxTransactionGroupBy is the group-by builder for OutboxTransaction entities.
+type OutboxTransactionGroupBy struct {
+	selector
+	build *OutboxTransactionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (otgb *OutboxTransactionGroupBy) Aggregate(fns ...AggregateFunc) *OutboxTransactionGroupBy {
+	otgb.fns = append(otgb.fns, fns...)
+	return otgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (otgb *OutboxTransactionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, otgb.build.ctx, ent.OpQueryGroupBy)
+	if err := otgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OutboxTransactionQuery, *OutboxTransactionGroupBy](ctx, otgb.build, otgb, otgb.build.inters, v)
+}
+
+func (otgb *OutboxTransactionGroupBy) sqlScan(ctx context.Context, root *OutboxTransactionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(otgb.fns))
+	for _, fn := range otgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*otgb.flds)+len(otgb.fns))
+		for _, f := range *otgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*otgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := otgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// OutboxTransactionSelect is the builder for selecting fields of OutboxTransaction entities.
+type OutboxTransactionSelect struct {
+	*OutboxTransactionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ots *OutboxTransactionSelect) Aggregate(fns ...AggregateFunc) *OutboxTransactionSelect {
+	ots.fns = append(ots.fns, fns...)
+	return ots
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ots *OutboxTransactionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ots.ctx, ent.OpQuerySelect)
+	if err := ots.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OutboxTransactionQuery, *OutboxTransactionSelect](ctx, ots.OutboxTransactionQuery, ots, ots.inters, v)
+}
+
+func (ots *OutboxTransactionSelect) sqlScan(ctx context.Context, root *OutboxTransactionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ots.fns))
+	for _, fn := range ots.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ots.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ots.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,697 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/outboxtransaction"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/shopspring/decimal"
+)
+
+// OutboxTransactionUpdate is the builder for updating OutboxTransaction entities.
+type OutboxTransactionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OutboxTransactionMutation
+}
+
+// Where appends a list predicates to the OutboxTransactionUpdate builder.
+func (otu *OutboxTransactionUpdate) Where(ps ...predicate.OutboxTransaction) *OutboxTransactionUpdate {
+	otu.mutation.Where(ps...)
+	return otu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (otu *OutboxTransactionUpdate) SetUpdatedAt(t time.Time) *OutboxTransactionUpdate {
+	otu.mutation.SetUpdatedAt(t)
+	return otu
+}
+
+// SetStatus sets the "status" field.
+func (otu *OutboxTransactionUpdate) SetStatus(o outboxtransaction.Status) *OutboxTransactionUpdate {
+	otu.mutation.SetStatus(o)
+	return otu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (otu *OutboxTransactionUpdate) SetNillableStatus(o *outboxtransaction.Status) *OutboxTransactionUpdate {
+	if o != nil {
+		otu.SetStatus(*o)
+	}
+	return otu
+}
+
+// SetAttempts sets the "attempts" field.
+func (otu *OutboxTransactionUpdate) SetAttempts(i int) *OutboxTransactionUpdate {
+	otu.mutation.ResetAttempts()
+	otu.mutation.SetAttempts(i)
+	return otu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (otu *OutboxTransactionUpdate) SetNillableAttempts(i *int) *OutboxTransactionUpdate {
+	if i != nil {
+		otu.SetAttempts(*i)
+	}
+	return otu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (otu *OutboxTransactionUpdate) AddAttempts(i int) *OutboxTransactionUpdate {
+	otu.mutation.AddAttempts(i)
+	return otu
+}
+
+// SetLastError sets the "last_error" field.
+func (otu *OutboxTransactionUpdate) SetLastError(s string) *OutboxTransactionUpdate {
+	otu.mutation.SetLastError(s)
+	return otu
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (otu *OutboxTransactionUpdate) SetNillableLastError(s *string) *OutboxTransactionUpdate {
+	if s != nil {
+		otu.SetLastError(*s)
+	}
+	return otu
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (otu *OutboxTransactionUpdate) ClearLastError() *OutboxTransactionUpdate {
+	otu.mutation.ClearLastError()
+	return otu
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (otu *OutboxTransactionUpdate) SetTransactionID(s string) *OutboxTransactionUpdate {
+	otu.mutation.SetTransactionID(s)
+	return otu
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (otu *OutboxTransactionUpdate) SetNillableTransactionID(s *string) *OutboxTransactionUpdate {
+	if s != nil {
+		otu.SetTransactionID(*s)
+	}
+	return otu
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (otu *OutboxTransactionUpdate) ClearTransactionID() *OutboxTransactionUpdate {
+	otu.mutation.ClearTransactionID()
+	return otu
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (otu *OutboxTransactionUpdate) SetTxHash(s string) *OutboxTransactionUpdate {
+	otu.mutation.SetTxHash(s)
+	return otu
+}
+
+// SetNillableTxHash sets the "tx_hash" field if the given value is not nil.
+func (otu *OutboxTransactionUpdate) SetNillableTxHash(s *string) *OutboxTransactionUpdate {
+	if s != nil {
+		otu.SetTxHash(*s)
+	}
+	return otu
+}
+
+// ClearTxHash clears the value of the "tx_hash" field.
+func (otu *OutboxTransactionUpdate) ClearTxHash() *OutboxTransactionUpdate {
+	otu.mutation.ClearTxHash()
+	return otu
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (otu *OutboxTransactionUpdate) SetNextAttemptAt(t time.Time) *OutboxTransactionUpdate {
+	otu.mutation.SetNextAttemptAt(t)
+	return otu
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (otu *OutboxTransactionUpdate) SetNillableNextAttemptAt(t *time.Time) *OutboxTransactionUpdate {
+	if t != nil {
+		otu.SetNextAttemptAt(*t)
+	}
+	return otu
+}
+
+// SetSentAt sets the "sent_at" field.
+func (otu *OutboxTransactionUpdate) SetSentAt(t time.Time) *OutboxTransactionUpdate {
+	otu.mutation.SetSentAt(t)
+	return otu
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (otu *OutboxTransactionUpdate) SetNillableSentAt(t *time.Time) *OutboxTransactionUpdate {
+	if t != nil {
+		otu.SetSentAt(*t)
+	}
+	return otu
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (otu *OutboxTransactionUpdate) ClearSentAt() *OutboxTransactionUpdate {
+	otu.mutation.ClearSentAt()
+	return otu
+}
+
+// SetGasUsed sets the "gas_used" field.
+func (otu *OutboxTransactionUpdate) SetGasUsed(i int64) *OutboxTransactionUpdate {
+	otu.mutation.ResetGasUsed()
+	otu.mutation.SetGasUsed(i)
+	return otu
+}
+
+// SetNillableGasUsed sets the "gas_used" field if the given value is not nil.
+func (otu *OutboxTransactionUpdate) SetNillableGasUsed(i *int64) *OutboxTransactionUpdate {
+	if i != nil {
+		otu.SetGasUsed(*i)
+	}
+	return otu
+}
+
+// AddGasUsed adds i to the "gas_used" field.
+func (otu *OutboxTransactionUpdate) AddGasUsed(i int64) *OutboxTransactionUpdate {
+	otu.mutation.AddGasUsed(i)
+	return otu
+}
+
+// SetGasCost sets the "gas_cost" field.
+func (otu *OutboxTransactionUpdate) SetGasCost(d decimal.Decimal) *OutboxTransactionUpdate {
+	otu.mutation.ResetGasCost()
+	otu.mutation.SetGasCost(d)
+	return otu
+}
+
+// SetNillableGasCost sets the "gas_cost" field if the given value is not nil.
+func (otu *OutboxTransactionUpdate) SetNillableGasCost(d *decimal.Decimal) *OutboxTransactionUpdate {
+	if d != nil {
+		otu.SetGasCost(*d)
+	}
+	return otu
+}
+
+// AddGasCost adds d to the "gas_cost" field.
+func (otu *OutboxTransactionUpdate) AddGasCost(d decimal.Decimal) *OutboxTransactionUpdate {
+	otu.mutation.AddGasCost(d)
+	return otu
+}
+
+// Mutation returns the OutboxTransactionMutation object of the builder.
+func (otu *OutboxTransactionUpdate) Mutation() *OutboxTransactionMutation {
+	return otu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (otu *OutboxTransactionUpdate) Save(ctx context.Context) (int, error) {
+	otu.defaults()
+	return withHooks(ctx, otu.sqlSave, otu.mutation, otu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (otu *OutboxTransactionUpdate) SaveX(ctx context.Context) int {
+	affected, err := otu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (otu *OutboxTransactionUpdate) Exec(ctx context.Context) error {
+	_, err := otu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (otu *OutboxTransactionUpdate) ExecX(ctx context.Context) {
+	if err := otu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (otu *OutboxTransactionUpdate) defaults() {
+	if _, ok := otu.mutation.UpdatedAt(); !ok {
+		v := outboxtransaction.UpdateDefaultUpdatedAt()
+		otu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (otu *OutboxTransactionUpdate) check() error {
+	if v, ok := otu.mutation.Status(); ok {
+		if err := outboxtransaction.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "OutboxTransaction.status": %w`, err)}
+		}
+	}
+	if v, ok := otu.mutation.LastError(); ok {
+		if err := outboxtransaction.LastErrorValidator(v); err != nil {
+			return &ValidationError{Name: "last_error", err: fmt.Errorf(`ent: validator failed for field "OutboxTransaction.last_error": %w`, err)}
+		}
+	}
+	if v, ok := otu.mutation.TxHash(); ok {
+		if err := outboxtransaction.TxHashValidator(v); err != nil {
+			return &ValidationError{Name: "tx_hash", err: fmt.Errorf(`ent: validator failed for field "OutboxTransaction.tx_hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (otu *OutboxTransactionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := otu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(outboxtransaction.Table, outboxtransaction.Columns, sqlgraph.NewFieldSpec(outboxtransaction.FieldID, field.TypeUUID))
+	if ps := otu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := otu.mutation.UpdatedAt(); ok {
+		_spec.SetField(outboxtransaction.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := otu.mutation.Status(); ok {
+		_spec.SetField(outboxtransaction.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := otu.mutation.Attempts(); ok {
+		_spec.SetField(outboxtransaction.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := otu.mutation.AddedAttempts(); ok {
+		_spec.AddField(outboxtransaction.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := otu.mutation.LastError(); ok {
+		_spec.SetField(outboxtransaction.FieldLastError, field.TypeString, value)
+	}
+	if otu.mutation.LastErrorCleared() {
+		_spec.ClearField(outboxtransaction.FieldLastError, field.TypeString)
+	}
+	if value, ok := otu.mutation.TransactionID(); ok {
+		_spec.SetField(outboxtransaction.FieldTransactionID, field.TypeString, value)
+	}
+	if otu.mutation.TransactionIDCleared() {
+		_spec.ClearField(outboxtransaction.FieldTransactionID, field.TypeString)
+	}
+	if value, ok := otu.mutation.TxHash(); ok {
+		_spec.SetField(outboxtransaction.FieldTxHash, field.TypeString, value)
+	}
+	if otu.mutation.TxHashCleared() {
+		_spec.ClearField(outboxtransaction.FieldTxHash, field.TypeString)
+	}
+	if value, ok := otu.mutation.NextAttemptAt(); ok {
+		_spec.SetField(outboxtransaction.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := otu.mutation.SentAt(); ok {
+		_spec.SetField(outboxtransaction.FieldSentAt, field.TypeTime, value)
+	}
+	if otu.mutation.SentAtCleared() {
+		_spec.ClearField(outboxtransaction.FieldSentAt, field.TypeTime)
+	}
+	if value, ok := otu.mutation.GasUsed(); ok {
+		_spec.SetField(outboxtransaction.FieldGasUsed, field.TypeInt64, value)
+	}
+	if value, ok := otu.mutation.AddedGasUsed(); ok {
+		_spec.AddField(outboxtransaction.FieldGasUsed, field.TypeInt64, value)
+	}
+	if value, ok := otu.mutation.GasCost(); ok {
+		_spec.SetField(outboxtransaction.FieldGasCost, field.TypeFloat64, value)
+	}
+	if value, ok := otu.mutation.AddedGasCost(); ok {
+		_spec.AddField(outboxtransaction.FieldGasCost, field.TypeFloat64, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, otu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{outboxtransaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	otu.mutation.done = true
+	return n, nil
+}
+
+// OutboxTransactionUpdateOne is the builder for updating a single OutboxTransaction entity.
+type OutboxTransactionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OutboxTransactionMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (otuo *OutboxTransactionUpdateOne) SetUpdatedAt(t time.Time) *OutboxTransactionUpdateOne {
+	otuo.mutation.SetUpdatedAt(t)
+	return otuo
+}
+
+// SetStatus sets the "status" field.
+func (otuo *OutboxTransactionUpdateOne) SetStatus(o outboxtransaction.Status) *OutboxTransactionUpdateOne {
+	otuo.mutation.SetStatus(o)
+	return otuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (otuo *OutboxTransactionUpdateOne) SetNillableStatus(o *outboxtransaction.Status) *OutboxTransactionUpdateOne {
+	if o != nil {
+		otuo.SetStatus(*o)
+	}
+	return otuo
+}
+
+// SetAttempts sets the "attempts" field.
+func (otuo *OutboxTransactionUpdateOne) SetAttempts(i int) *OutboxTransactionUpdateOne {
+	otuo.mutation.ResetAttempts()
+	otuo.mutation.SetAttempts(i)
+	return otuo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (otuo *OutboxTransactionUpdateOne) SetNillableAttempts(i *int) *OutboxTransactionUpdateOne {
+	if i != nil {
+		otuo.SetAttempts(*i)
+	}
+	return otuo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (otuo *OutboxTransactionUpdateOne) AddAttempts(i int) *OutboxTransactionUpdateOne {
+	otuo.mutation.AddAttempts(i)
+	return otuo
+}
+
+// SetLastError sets the "last_error" field.
+func (otuo *OutboxTransactionUpdateOne) SetLastError(s string) *OutboxTransactionUpdateOne {
+	otuo.mutation.SetLastError(s)
+	return otuo
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (otuo *OutboxTransactionUpdateOne) SetNillableLastError(s *string) *OutboxTransactionUpdateOne {
+	if s != nil {
+		otuo.SetLastError(*s)
+	}
+	return otuo
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (otuo *OutboxTransactionUpdateOne) ClearLastError() *OutboxTransactionUpdateOne {
+	otuo.mutation.ClearLastError()
+	return otuo
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (otuo *OutboxTransactionUpdateOne) SetTransactionID(s string) *OutboxTransactionUpdateOne {
+	otuo.mutation.SetTransactionID(s)
+	return otuo
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (otuo *OutboxTransactionUpdateOne) SetNillableTransactionID(s *string) *OutboxTransactionUpdateOne {
+	if s != nil {
+		otuo.SetTransactionID(*s)
+	}
+	return otuo
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (otuo *OutboxTransactionUpdateOne) ClearTransactionID() *OutboxTransactionUpdateOne {
+	otuo.mutation.ClearTransactionID()
+	return otuo
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (otuo *OutboxTransactionUpdateOne) SetTxHash(s string) *OutboxTransactionUpdateOne {
+	otuo.mutation.SetTxHash(s)
+	return otuo
+}
+
+// SetNillableTxHash sets the "tx_hash" field if the given value is not nil.
+func (otuo *OutboxTransactionUpdateOne) SetNillableTxHash(s *string) *OutboxTransactionUpdateOne {
+	if s != nil {
+		otuo.SetTxHash(*s)
+	}
+	return otuo
+}
+
+// ClearTxHash clears the value of the "tx_hash" field.
+func (otuo *OutboxTransactionUpdateOne) ClearTxHash() *OutboxTransactionUpdateOne {
+	otuo.mutation.ClearTxHash()
+	return otuo
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (otuo *OutboxTransactionUpdateOne) SetNextAttemptAt(t time.Time) *OutboxTransactionUpdateOne {
+	otuo.mutation.SetNextAttemptAt(t)
+	return otuo
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (otuo *OutboxTransactionUpdateOne) SetNillableNextAttemptAt(t *time.Time) *OutboxTransactionUpdateOne {
+	if t != nil {
+		otuo.SetNextAttemptAt(*t)
+	}
+	return otuo
+}
+
+// SetSentAt sets the "sent_at" field.
+func (otuo *OutboxTransactionUpdateOne) SetSentAt(t time.Time) *OutboxTransactionUpdateOne {
+	otuo.mutation.SetSentAt(t)
+	return otuo
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (otuo *OutboxTransactionUpdateOne) SetNillableSentAt(t *time.Time) *OutboxTransactionUpdateOne {
+	if t != nil {
+		otuo.SetSentAt(*t)
+	}
+	return otuo
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (otuo *OutboxTransactionUpdateOne) ClearSentAt() *OutboxTransactionUpdateOne {
+	otuo.mutation.ClearSentAt()
+	return otuo
+}
+
+// SetGasUsed sets the "gas_used" field.
+func (otuo *OutboxTransactionUpdateOne) SetGasUsed(i int64) *OutboxTransactionUpdateOne {
+	otuo.mutation.ResetGasUsed()
+	otuo.mutation.SetGasUsed(i)
+	return otuo
+}
+
+// SetNillableGasUsed sets the "gas_used" field if the given value is not nil.
+func (otuo *OutboxTransactionUpdateOne) SetNillableGasUsed(i *int64) *OutboxTransactionUpdateOne {
+	if i != nil {
+		otuo.SetGasUsed(*i)
+	}
+	return otuo
+}
+
+// AddGasUsed adds i to the "gas_used" field.
+func (otuo *OutboxTransactionUpdateOne) AddGasUsed(i int64) *OutboxTransactionUpdateOne {
+	otuo.mutation.AddGasUsed(i)
+	return otuo
+}
+
+// SetGasCost sets the "gas_cost" field.
+func (otuo *OutboxTransactionUpdateOne) SetGasCost(d decimal.Decimal) *OutboxTransactionUpdateOne {
+	otuo.mutation.ResetGasCost()
+	otuo.mutation.SetGasCost(d)
+	return otuo
+}
+
+// SetNillableGasCost sets the "gas_cost" field if the given value is not nil.
+func (otuo *OutboxTransactionUpdateOne) SetNillableGasCost(d *decimal.Decimal) *OutboxTransactionUpdateOne {
+	if d != nil {
+		otuo.SetGasCost(*d)
+	}
+	return otuo
+}
+
+// AddGasCost adds d to the "gas_cost" field.
+func (otuo *OutboxTransactionUpdateOne) AddGasCost(d decimal.Decimal) *OutboxTransactionUpdateOne {
+	otuo.mutation.AddGasCost(d)
+	return otuo
+}
+
+// Mutation returns the OutboxTransactionMutation object of the builder.
+func (otuo *OutboxTransactionUpdateOne) Mutation() *OutboxTransactionMutation {
+	return otuo.mutation
+}
+
+// Where appends a list predicates to the OutboxTransactionUpdate builder.
+func (otuo *OutboxTransactionUpdateOne) Where(ps ...predicate.OutboxTransaction) *OutboxTransactionUpdateOne {
+	otuo.mutation.Where(ps...)
+	return otuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (otuo *OutboxTransactionUpdateOne) Select(field string, fields ...string) *OutboxTransactionUpdateOne {
+	otuo.fields = append([]string{field}, fields...)
+	return otuo
+}
+
+// Save executes the query and returns the updated OutboxTransaction entity.
+func (otuo *OutboxTransactionUpdateOne) Save(ctx context.Context) (*OutboxTransaction, error) {
+	otuo.defaults()
+	return withHooks(ctx, otuo.sqlSave, otuo.mutation, otuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (otuo *OutboxTransactionUpdateOne) SaveX(ctx context.Context) *OutboxTransaction {
+	node, err := otuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (otuo *OutboxTransactionUpdateOne) Exec(ctx context.Context) error {
+	_, err := otuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (otuo *OutboxTransactionUpdateOne) ExecX(ctx context.Context) {
+	if err := otuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (otuo *OutboxTransactionUpdateOne) defaults() {
+	if _, ok := otuo.mutation.UpdatedAt(); !ok {
+		v := outboxtransaction.UpdateDefaultUpdatedAt()
+		otuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (otuo *OutboxTransactionUpdateOne) check() error {
+	if v, ok := otuo.mutation.Status(); ok {
+		if err := outboxtransaction.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "OutboxTransaction.status": %w`, err)}
+		}
+	}
+	if v, ok := otuo.mutation.LastError(); ok {
+		if err := outboxtransaction.LastErrorValidator(v); err != nil {
+			return &ValidationError{Name: "last_error", err: fmt.Errorf(`ent: validator failed for field "OutboxTransaction.last_error": %w`, err)}
+		}
+	}
+	if v, ok := otuo.mutation.TxHash(); ok {
+		if err := outboxtransaction.TxHashValidator(v); err != nil {
+			return &ValidationError{Name: "tx_hash", err: fmt.Errorf(`ent: validator failed for field "OutboxTransaction.tx_hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (otuo *OutboxTransactionUpdateOne) sqlSave(ctx context.Context) (_node *OutboxTransaction, err error) {
+	if err := otuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(outboxtransaction.Table, outboxtransaction.Columns, sqlgraph.NewFieldSpec(outboxtransaction.FieldID, field.TypeUUID))
+	id, ok := otuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OutboxTransaction.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := otuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, outboxtransaction.FieldID)
+		for _, f := range fields {
+			if !outboxtransaction.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != outboxtransaction.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := otuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := otuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(outboxtransaction.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := otuo.mutation.Status(); ok {
+		_spec.SetField(outboxtransaction.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := otuo.mutation.Attempts(); ok {
+		_spec.SetField(outboxtransaction.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := otuo.mutation.AddedAttempts(); ok {
+		_spec.AddField(outboxtransaction.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := otuo.mutation.LastError(); ok {
+		_spec.SetField(outboxtransaction.FieldLastError, field.TypeString, value)
+	}
+	if otuo.mutation.LastErrorCleared() {
+		_spec.ClearField(outboxtransaction.FieldLastError, field.TypeString)
+	}
+	if value, ok := otuo.mutation.TransactionID(); ok {
+		_spec.SetField(outboxtransaction.FieldTransactionID, field.TypeString, value)
+	}
+	if otuo.mutation.TransactionIDCleared() {
+		_spec.ClearField(outboxtransaction.FieldTransactionID, field.TypeString)
+	}
+	if value, ok := otuo.mutation.TxHash(); ok {
+		_spec.SetField(outboxtransaction.FieldTxHash, field.TypeString, value)
+	}
+	if otuo.mutation.TxHashCleared() {
+		_spec.ClearField(outboxtransaction.FieldTxHash, field.TypeString)
+	}
+	if value, ok := otuo.mutation.NextAttemptAt(); ok {
+		_spec.SetField(outboxtransaction.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := otuo.mutation.SentAt(); ok {
+		_spec.SetField(outboxtransaction.FieldSentAt, field.TypeTime, value)
+	}
+	if otuo.mutation.SentAtCleared() {
+		_spec.ClearField(outboxtransaction.FieldSentAt, field.TypeTime)
+	}
+	if value, ok := otuo.mutation.GasUsed(); ok {
+		_spec.SetField(outboxtransaction.FieldGasUsed, field.TypeInt64, value)
+	}
+	if value, ok := otuo.mutation.AddedGasUsed(); ok {
+		_spec.AddField(outboxtransaction.FieldGasUsed, field.TypeInt64, value)
+	}
+	if value, ok := otuo.mutation.GasCost(); ok {
+		_spec.SetField(outboxtransaction.FieldGasCost, field.TypeFloat64, value)
+	}
+	if value, ok := otuo.mutation.AddedGasCost(); ok {
+		_spec.AddField(outboxtransaction.FieldGasCost, field.TypeFloat64, value)
+	}
+	_node = &OutboxTransaction{config: otuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, otuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{outboxtransaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	otuo.mutation.done = true
+	return _node, nil
+}
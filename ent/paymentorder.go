@@ -3,18 +3,21 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/NEDA-LABS/stablenode/ent/depositsplit"
 	"github.com/NEDA-LABS/stablenode/ent/linkedaddress"
 	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
 	"github.com/NEDA-LABS/stablenode/ent/paymentorderrecipient"
 	"github.com/NEDA-LABS/stablenode/ent/paymentwebhook"
 	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
 	"github.com/NEDA-LABS/stablenode/ent/senderprofile"
+	"github.com/NEDA-LABS/stablenode/ent/sweep"
 	"github.com/NEDA-LABS/stablenode/ent/token"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
@@ -35,6 +38,8 @@ type PaymentOrder struct {
 	AmountPaid decimal.Decimal `json:"amount_paid,omitempty"`
 	// AmountReturned holds the value of the "amount_returned" field.
 	AmountReturned decimal.Decimal `json:"amount_returned,omitempty"`
+	// AmountOverpaid holds the value of the "amount_overpaid" field.
+	AmountOverpaid decimal.Decimal `json:"amount_overpaid,omitempty"`
 	// PercentSettled holds the value of the "percent_settled" field.
 	PercentSettled decimal.Decimal `json:"percent_settled,omitempty"`
 	// SenderFee holds the value of the "sender_fee" field.
@@ -69,11 +74,41 @@ type PaymentOrder struct {
 	Status paymentorder.Status `json:"status,omitempty"`
 	// AmountInUsd holds the value of the "amount_in_usd" field.
 	AmountInUsd decimal.Decimal `json:"amount_in_usd,omitempty"`
+	// SettlementPolicy holds the value of the "settlement_policy" field.
+	SettlementPolicy paymentorder.SettlementPolicy `json:"settlement_policy,omitempty"`
+	// DepositStatus holds the value of the "deposit_status" field.
+	DepositStatus paymentorder.DepositStatus `json:"deposit_status,omitempty"`
+	// DepositFinalizedAt holds the value of the "deposit_finalized_at" field.
+	DepositFinalizedAt time.Time `json:"deposit_finalized_at,omitempty"`
+	// RequiredConfirmations holds the value of the "required_confirmations" field.
+	RequiredConfirmations int `json:"required_confirmations,omitempty"`
+	// SLABreachedAt holds the value of the "sla_breached_at" field.
+	SLABreachedAt time.Time `json:"sla_breached_at,omitempty"`
+	// ReviewReason holds the value of the "review_reason" field.
+	ReviewReason string `json:"review_reason,omitempty"`
+	// QuarantineReason holds the value of the "quarantine_reason" field.
+	QuarantineReason string `json:"quarantine_reason,omitempty"`
+	// FiatAmount holds the value of the "fiat_amount" field.
+	FiatAmount *decimal.Decimal `json:"fiat_amount,omitempty"`
+	// FiatCurrency holds the value of the "fiat_currency" field.
+	FiatCurrency string `json:"fiat_currency,omitempty"`
+	// RateDriftTolerance holds the value of the "rate_drift_tolerance" field.
+	RateDriftTolerance decimal.Decimal `json:"rate_drift_tolerance,omitempty"`
+	// FiatConversion holds the value of the "fiat_conversion" field.
+	FiatConversion map[string]interface{} `json:"fiat_conversion,omitempty"`
+	// RateLockedUntil holds the value of the "rate_locked_until" field.
+	RateLockedUntil time.Time `json:"rate_locked_until,omitempty"`
+	// RateRequote holds the value of the "rate_requote" field.
+	RateRequote map[string]interface{} `json:"rate_requote,omitempty"`
+	// BlockchainService holds the value of the "blockchain_service" field.
+	BlockchainService *paymentorder.BlockchainService `json:"blockchain_service,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PaymentOrderQuery when eager-loading is set.
 	Edges                         PaymentOrderEdges `json:"edges"`
 	api_key_payment_orders        *uuid.UUID
+	deposit_split_payment_orders  *uuid.UUID
 	linked_address_payment_orders *int
+	payment_order_refund_sweep    *uuid.UUID
 	sender_profile_payment_orders *uuid.UUID
 	token_payment_orders          *int
 	selectValues                  sql.SelectValues
@@ -95,9 +130,21 @@ type PaymentOrderEdges struct {
 	Transactions []*TransactionLog `json:"transactions,omitempty"`
 	// PaymentWebhook holds the value of the payment_webhook edge.
 	PaymentWebhook *PaymentWebhook `json:"payment_webhook,omitempty"`
+	// RefundSweep holds the value of the refund_sweep edge.
+	RefundSweep *Sweep `json:"refund_sweep,omitempty"`
+	// DepositSplit holds the value of the deposit_split edge.
+	DepositSplit *DepositSplit `json:"deposit_split,omitempty"`
+	// LedgerEntries holds the value of the ledger_entries edge.
+	LedgerEntries []*LedgerEntry `json:"ledger_entries,omitempty"`
+	// ReconciliationDiscrepancies holds the value of the reconciliation_discrepancies edge.
+	ReconciliationDiscrepancies []*ReconciliationDiscrepancy `json:"reconciliation_discrepancies,omitempty"`
+	// UnmatchedDeposits holds the value of the unmatched_deposits edge.
+	UnmatchedDeposits []*UnmatchedDeposit `json:"unmatched_deposits,omitempty"`
+	// AddressAssignments holds the value of the address_assignments edge.
+	AddressAssignments []*ReceiveAddressAssignment `json:"address_assignments,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [7]bool
+	loadedTypes [13]bool
 }
 
 // SenderProfileOrErr returns the SenderProfile value or an error if the edge
@@ -175,28 +222,94 @@ func (e PaymentOrderEdges) PaymentWebhookOrErr() (*PaymentWebhook, error) {
 	return nil, &NotLoadedError{edge: "payment_webhook"}
 }
 
+// RefundSweepOrErr returns the RefundSweep value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PaymentOrderEdges) RefundSweepOrErr() (*Sweep, error) {
+	if e.RefundSweep != nil {
+		return e.RefundSweep, nil
+	} else if e.loadedTypes[7] {
+		return nil, &NotFoundError{label: sweep.Label}
+	}
+	return nil, &NotLoadedError{edge: "refund_sweep"}
+}
+
+// DepositSplitOrErr returns the DepositSplit value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PaymentOrderEdges) DepositSplitOrErr() (*DepositSplit, error) {
+	if e.DepositSplit != nil {
+		return e.DepositSplit, nil
+	} else if e.loadedTypes[8] {
+		return nil, &NotFoundError{label: depositsplit.Label}
+	}
+	return nil, &NotLoadedError{edge: "deposit_split"}
+}
+
+// LedgerEntriesOrErr returns the LedgerEntries value or an error if the edge
+// was not loaded in eager-loading.
+func (e PaymentOrderEdges) LedgerEntriesOrErr() ([]*LedgerEntry, error) {
+	if e.loadedTypes[9] {
+		return e.LedgerEntries, nil
+	}
+	return nil, &NotLoadedError{edge: "ledger_entries"}
+}
+
+// ReconciliationDiscrepanciesOrErr returns the ReconciliationDiscrepancies value or an error if the edge
+// was not loaded in eager-loading.
+func (e PaymentOrderEdges) ReconciliationDiscrepanciesOrErr() ([]*ReconciliationDiscrepancy, error) {
+	if e.loadedTypes[10] {
+		return e.ReconciliationDiscrepancies, nil
+	}
+	return nil, &NotLoadedError{edge: "reconciliation_discrepancies"}
+}
+
+// UnmatchedDepositsOrErr returns the UnmatchedDeposits value or an error if the edge
+// was not loaded in eager-loading.
+func (e PaymentOrderEdges) UnmatchedDepositsOrErr() ([]*UnmatchedDeposit, error) {
+	if e.loadedTypes[11] {
+		return e.UnmatchedDeposits, nil
+	}
+	return nil, &NotLoadedError{edge: "unmatched_deposits"}
+}
+
+// AddressAssignmentsOrErr returns the AddressAssignments value or an error if the edge
+// was not loaded in eager-loading.
+func (e PaymentOrderEdges) AddressAssignmentsOrErr() ([]*ReceiveAddressAssignment, error) {
+	if e.loadedTypes[12] {
+		return e.AddressAssignments, nil
+	}
+	return nil, &NotLoadedError{edge: "address_assignments"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*PaymentOrder) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case paymentorder.FieldAmount, paymentorder.FieldAmountPaid, paymentorder.FieldAmountReturned, paymentorder.FieldPercentSettled, paymentorder.FieldSenderFee, paymentorder.FieldNetworkFee, paymentorder.FieldProtocolFee, paymentorder.FieldRate, paymentorder.FieldFeePercent, paymentorder.FieldAmountInUsd:
+		case paymentorder.FieldFiatAmount:
+			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
+		case paymentorder.FieldFiatConversion, paymentorder.FieldRateRequote:
+			values[i] = new([]byte)
+		case paymentorder.FieldAmount, paymentorder.FieldAmountPaid, paymentorder.FieldAmountReturned, paymentorder.FieldAmountOverpaid, paymentorder.FieldPercentSettled, paymentorder.FieldSenderFee, paymentorder.FieldNetworkFee, paymentorder.FieldProtocolFee, paymentorder.FieldRate, paymentorder.FieldFeePercent, paymentorder.FieldAmountInUsd, paymentorder.FieldRateDriftTolerance:
 			values[i] = new(decimal.Decimal)
-		case paymentorder.FieldBlockNumber:
+		case paymentorder.FieldBlockNumber, paymentorder.FieldRequiredConfirmations:
 			values[i] = new(sql.NullInt64)
-		case paymentorder.FieldTxHash, paymentorder.FieldFromAddress, paymentorder.FieldReturnAddress, paymentorder.FieldReceiveAddressText, paymentorder.FieldFeeAddress, paymentorder.FieldGatewayID, paymentorder.FieldMessageHash, paymentorder.FieldReference, paymentorder.FieldStatus:
+		case paymentorder.FieldTxHash, paymentorder.FieldFromAddress, paymentorder.FieldReturnAddress, paymentorder.FieldReceiveAddressText, paymentorder.FieldFeeAddress, paymentorder.FieldGatewayID, paymentorder.FieldMessageHash, paymentorder.FieldReference, paymentorder.FieldStatus, paymentorder.FieldSettlementPolicy, paymentorder.FieldDepositStatus, paymentorder.FieldReviewReason, paymentorder.FieldQuarantineReason, paymentorder.FieldFiatCurrency, paymentorder.FieldBlockchainService:
 			values[i] = new(sql.NullString)
-		case paymentorder.FieldCreatedAt, paymentorder.FieldUpdatedAt:
+		case paymentorder.FieldCreatedAt, paymentorder.FieldUpdatedAt, paymentorder.FieldDepositFinalizedAt, paymentorder.FieldSLABreachedAt, paymentorder.FieldRateLockedUntil:
 			values[i] = new(sql.NullTime)
 		case paymentorder.FieldID:
 			values[i] = new(uuid.UUID)
 		case paymentorder.ForeignKeys[0]: // api_key_payment_orders
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
-		case paymentorder.ForeignKeys[1]: // linked_address_payment_orders
+		case paymentorder.ForeignKeys[1]: // deposit_split_payment_orders
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case paymentorder.ForeignKeys[2]: // linked_address_payment_orders
 			values[i] = new(sql.NullInt64)
-		case paymentorder.ForeignKeys[2]: // sender_profile_payment_orders
+		case paymentorder.ForeignKeys[3]: // payment_order_refund_sweep
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case paymentorder.ForeignKeys[4]: // sender_profile_payment_orders
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
-		case paymentorder.ForeignKeys[3]: // token_payment_orders
+		case paymentorder.ForeignKeys[5]: // token_payment_orders
 			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -249,6 +362,12 @@ func (po *PaymentOrder) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				po.AmountReturned = *value
 			}
+		case paymentorder.FieldAmountOverpaid:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount_overpaid", values[i])
+			} else if value != nil {
+				po.AmountOverpaid = *value
+			}
 		case paymentorder.FieldPercentSettled:
 			if value, ok := values[i].(*decimal.Decimal); !ok {
 				return fmt.Errorf("unexpected type %T for field percent_settled", values[i])
@@ -351,6 +470,96 @@ func (po *PaymentOrder) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				po.AmountInUsd = *value
 			}
+		case paymentorder.FieldSettlementPolicy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field settlement_policy", values[i])
+			} else if value.Valid {
+				po.SettlementPolicy = paymentorder.SettlementPolicy(value.String)
+			}
+		case paymentorder.FieldDepositStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deposit_status", values[i])
+			} else if value.Valid {
+				po.DepositStatus = paymentorder.DepositStatus(value.String)
+			}
+		case paymentorder.FieldDepositFinalizedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deposit_finalized_at", values[i])
+			} else if value.Valid {
+				po.DepositFinalizedAt = value.Time
+			}
+		case paymentorder.FieldRequiredConfirmations:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field required_confirmations", values[i])
+			} else if value.Valid {
+				po.RequiredConfirmations = int(value.Int64)
+			}
+		case paymentorder.FieldSLABreachedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field sla_breached_at", values[i])
+			} else if value.Valid {
+				po.SLABreachedAt = value.Time
+			}
+		case paymentorder.FieldReviewReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field review_reason", values[i])
+			} else if value.Valid {
+				po.ReviewReason = value.String
+			}
+		case paymentorder.FieldQuarantineReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field quarantine_reason", values[i])
+			} else if value.Valid {
+				po.QuarantineReason = value.String
+			}
+		case paymentorder.FieldFiatAmount:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field fiat_amount", values[i])
+			} else if value.Valid {
+				po.FiatAmount = new(decimal.Decimal)
+				*po.FiatAmount = *value.S.(*decimal.Decimal)
+			}
+		case paymentorder.FieldFiatCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fiat_currency", values[i])
+			} else if value.Valid {
+				po.FiatCurrency = value.String
+			}
+		case paymentorder.FieldRateDriftTolerance:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field rate_drift_tolerance", values[i])
+			} else if value != nil {
+				po.RateDriftTolerance = *value
+			}
+		case paymentorder.FieldFiatConversion:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field fiat_conversion", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &po.FiatConversion); err != nil {
+					return fmt.Errorf("unmarshal field fiat_conversion: %w", err)
+				}
+			}
+		case paymentorder.FieldRateLockedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field rate_locked_until", values[i])
+			} else if value.Valid {
+				po.RateLockedUntil = value.Time
+			}
+		case paymentorder.FieldRateRequote:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field rate_requote", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &po.RateRequote); err != nil {
+					return fmt.Errorf("unmarshal field rate_requote: %w", err)
+				}
+			}
+		case paymentorder.FieldBlockchainService:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field blockchain_service", values[i])
+			} else if value.Valid {
+				po.BlockchainService = new(paymentorder.BlockchainService)
+				*po.BlockchainService = paymentorder.BlockchainService(value.String)
+			}
 		case paymentorder.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field api_key_payment_orders", values[i])
@@ -359,20 +568,34 @@ func (po *PaymentOrder) assignValues(columns []string, values []any) error {
 				*po.api_key_payment_orders = *value.S.(*uuid.UUID)
 			}
 		case paymentorder.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field deposit_split_payment_orders", values[i])
+			} else if value.Valid {
+				po.deposit_split_payment_orders = new(uuid.UUID)
+				*po.deposit_split_payment_orders = *value.S.(*uuid.UUID)
+			}
+		case paymentorder.ForeignKeys[2]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field linked_address_payment_orders", value)
 			} else if value.Valid {
 				po.linked_address_payment_orders = new(int)
 				*po.linked_address_payment_orders = int(value.Int64)
 			}
-		case paymentorder.ForeignKeys[2]:
+		case paymentorder.ForeignKeys[3]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_order_refund_sweep", values[i])
+			} else if value.Valid {
+				po.payment_order_refund_sweep = new(uuid.UUID)
+				*po.payment_order_refund_sweep = *value.S.(*uuid.UUID)
+			}
+		case paymentorder.ForeignKeys[4]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field sender_profile_payment_orders", values[i])
 			} else if value.Valid {
 				po.sender_profile_payment_orders = new(uuid.UUID)
 				*po.sender_profile_payment_orders = *value.S.(*uuid.UUID)
 			}
-		case paymentorder.ForeignKeys[3]:
+		case paymentorder.ForeignKeys[5]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field token_payment_orders", value)
 			} else if value.Valid {
@@ -427,6 +650,36 @@ func (po *PaymentOrder) QueryPaymentWebhook() *PaymentWebhookQuery {
 	return NewPaymentOrderClient(po.config).QueryPaymentWebhook(po)
 }
 
+// QueryRefundSweep queries the "refund_sweep" edge of the PaymentOrder entity.
+func (po *PaymentOrder) QueryRefundSweep() *SweepQuery {
+	return NewPaymentOrderClient(po.config).QueryRefundSweep(po)
+}
+
+// QueryDepositSplit queries the "deposit_split" edge of the PaymentOrder entity.
+func (po *PaymentOrder) QueryDepositSplit() *DepositSplitQuery {
+	return NewPaymentOrderClient(po.config).QueryDepositSplit(po)
+}
+
+// QueryLedgerEntries queries the "ledger_entries" edge of the PaymentOrder entity.
+func (po *PaymentOrder) QueryLedgerEntries() *LedgerEntryQuery {
+	return NewPaymentOrderClient(po.config).QueryLedgerEntries(po)
+}
+
+// QueryReconciliationDiscrepancies queries the "reconciliation_discrepancies" edge of the PaymentOrder entity.
+func (po *PaymentOrder) QueryReconciliationDiscrepancies() *ReconciliationDiscrepancyQuery {
+	return NewPaymentOrderClient(po.config).QueryReconciliationDiscrepancies(po)
+}
+
+// QueryUnmatchedDeposits queries the "unmatched_deposits" edge of the PaymentOrder entity.
+func (po *PaymentOrder) QueryUnmatchedDeposits() *UnmatchedDepositQuery {
+	return NewPaymentOrderClient(po.config).QueryUnmatchedDeposits(po)
+}
+
+// QueryAddressAssignments queries the "address_assignments" edge of the PaymentOrder entity.
+func (po *PaymentOrder) QueryAddressAssignments() *ReceiveAddressAssignmentQuery {
+	return NewPaymentOrderClient(po.config).QueryAddressAssignments(po)
+}
+
 // Update returns a builder for updating this PaymentOrder.
 // Note that you need to call PaymentOrder.Unwrap() before calling this method if this PaymentOrder
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -465,6 +718,9 @@ func (po *PaymentOrder) String() string {
 	builder.WriteString("amount_returned=")
 	builder.WriteString(fmt.Sprintf("%v", po.AmountReturned))
 	builder.WriteString(", ")
+	builder.WriteString("amount_overpaid=")
+	builder.WriteString(fmt.Sprintf("%v", po.AmountOverpaid))
+	builder.WriteString(", ")
 	builder.WriteString("percent_settled=")
 	builder.WriteString(fmt.Sprintf("%v", po.PercentSettled))
 	builder.WriteString(", ")
@@ -515,6 +771,52 @@ func (po *PaymentOrder) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("amount_in_usd=")
 	builder.WriteString(fmt.Sprintf("%v", po.AmountInUsd))
+	builder.WriteString(", ")
+	builder.WriteString("settlement_policy=")
+	builder.WriteString(fmt.Sprintf("%v", po.SettlementPolicy))
+	builder.WriteString(", ")
+	builder.WriteString("deposit_status=")
+	builder.WriteString(fmt.Sprintf("%v", po.DepositStatus))
+	builder.WriteString(", ")
+	builder.WriteString("deposit_finalized_at=")
+	builder.WriteString(po.DepositFinalizedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("required_confirmations=")
+	builder.WriteString(fmt.Sprintf("%v", po.RequiredConfirmations))
+	builder.WriteString(", ")
+	builder.WriteString("sla_breached_at=")
+	builder.WriteString(po.SLABreachedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("review_reason=")
+	builder.WriteString(po.ReviewReason)
+	builder.WriteString(", ")
+	builder.WriteString("quarantine_reason=")
+	builder.WriteString(po.QuarantineReason)
+	builder.WriteString(", ")
+	if v := po.FiatAmount; v != nil {
+		builder.WriteString("fiat_amount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("fiat_currency=")
+	builder.WriteString(po.FiatCurrency)
+	builder.WriteString(", ")
+	builder.WriteString("rate_drift_tolerance=")
+	builder.WriteString(fmt.Sprintf("%v", po.RateDriftTolerance))
+	builder.WriteString(", ")
+	builder.WriteString("fiat_conversion=")
+	builder.WriteString(fmt.Sprintf("%v", po.FiatConversion))
+	builder.WriteString(", ")
+	builder.WriteString("rate_locked_until=")
+	builder.WriteString(po.RateLockedUntil.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("rate_requote=")
+	builder.WriteString(fmt.Sprintf("%v", po.RateRequote))
+	builder.WriteString(", ")
+	if v := po.BlockchainService; v != nil {
+		builder.WriteString("blockchain_service=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -9,6 +9,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 const (
@@ -26,6 +27,8 @@ const (
 	FieldAmountPaid = "amount_paid"
 	// FieldAmountReturned holds the string denoting the amount_returned field in the database.
 	FieldAmountReturned = "amount_returned"
+	// FieldAmountOverpaid holds the string denoting the amount_overpaid field in the database.
+	FieldAmountOverpaid = "amount_overpaid"
 	// FieldPercentSettled holds the string denoting the percent_settled field in the database.
 	FieldPercentSettled = "percent_settled"
 	// FieldSenderFee holds the string denoting the sender_fee field in the database.
@@ -60,6 +63,34 @@ const (
 	FieldStatus = "status"
 	// FieldAmountInUsd holds the string denoting the amount_in_usd field in the database.
 	FieldAmountInUsd = "amount_in_usd"
+	// FieldSettlementPolicy holds the string denoting the settlement_policy field in the database.
+	FieldSettlementPolicy = "settlement_policy"
+	// FieldDepositStatus holds the string denoting the deposit_status field in the database.
+	FieldDepositStatus = "deposit_status"
+	// FieldDepositFinalizedAt holds the string denoting the deposit_finalized_at field in the database.
+	FieldDepositFinalizedAt = "deposit_finalized_at"
+	// FieldRequiredConfirmations holds the string denoting the required_confirmations field in the database.
+	FieldRequiredConfirmations = "required_confirmations"
+	// FieldSLABreachedAt holds the string denoting the sla_breached_at field in the database.
+	FieldSLABreachedAt = "sla_breached_at"
+	// FieldReviewReason holds the string denoting the review_reason field in the database.
+	FieldReviewReason = "review_reason"
+	// FieldQuarantineReason holds the string denoting the quarantine_reason field in the database.
+	FieldQuarantineReason = "quarantine_reason"
+	// FieldFiatAmount holds the string denoting the fiat_amount field in the database.
+	FieldFiatAmount = "fiat_amount"
+	// FieldFiatCurrency holds the string denoting the fiat_currency field in the database.
+	FieldFiatCurrency = "fiat_currency"
+	// FieldRateDriftTolerance holds the string denoting the rate_drift_tolerance field in the database.
+	FieldRateDriftTolerance = "rate_drift_tolerance"
+	// FieldFiatConversion holds the string denoting the fiat_conversion field in the database.
+	FieldFiatConversion = "fiat_conversion"
+	// FieldRateLockedUntil holds the string denoting the rate_locked_until field in the database.
+	FieldRateLockedUntil = "rate_locked_until"
+	// FieldRateRequote holds the string denoting the rate_requote field in the database.
+	FieldRateRequote = "rate_requote"
+	// FieldBlockchainService holds the string denoting the blockchain_service field in the database.
+	FieldBlockchainService = "blockchain_service"
 	// EdgeSenderProfile holds the string denoting the sender_profile edge name in mutations.
 	EdgeSenderProfile = "sender_profile"
 	// EdgeToken holds the string denoting the token edge name in mutations.
@@ -74,6 +105,18 @@ const (
 	EdgeTransactions = "transactions"
 	// EdgePaymentWebhook holds the string denoting the payment_webhook edge name in mutations.
 	EdgePaymentWebhook = "payment_webhook"
+	// EdgeRefundSweep holds the string denoting the refund_sweep edge name in mutations.
+	EdgeRefundSweep = "refund_sweep"
+	// EdgeDepositSplit holds the string denoting the deposit_split edge name in mutations.
+	EdgeDepositSplit = "deposit_split"
+	// EdgeLedgerEntries holds the string denoting the ledger_entries edge name in mutations.
+	EdgeLedgerEntries = "ledger_entries"
+	// EdgeReconciliationDiscrepancies holds the string denoting the reconciliation_discrepancies edge name in mutations.
+	EdgeReconciliationDiscrepancies = "reconciliation_discrepancies"
+	// EdgeUnmatchedDeposits holds the string denoting the unmatched_deposits edge name in mutations.
+	EdgeUnmatchedDeposits = "unmatched_deposits"
+	// EdgeAddressAssignments holds the string denoting the address_assignments edge name in mutations.
+	EdgeAddressAssignments = "address_assignments"
 	// Table holds the table name of the paymentorder in the database.
 	Table = "payment_orders"
 	// SenderProfileTable is the table that holds the sender_profile relation/edge.
@@ -125,6 +168,48 @@ const (
 	PaymentWebhookInverseTable = "payment_webhooks"
 	// PaymentWebhookColumn is the table column denoting the payment_webhook relation/edge.
 	PaymentWebhookColumn = "payment_order_payment_webhook"
+	// RefundSweepTable is the table that holds the refund_sweep relation/edge.
+	RefundSweepTable = "payment_orders"
+	// RefundSweepInverseTable is the table name for the Sweep entity.
+	// It exists in this package in order to avoid circular dependency with the "sweep" package.
+	RefundSweepInverseTable = "sweeps"
+	// RefundSweepColumn is the table column denoting the refund_sweep relation/edge.
+	RefundSweepColumn = "payment_order_refund_sweep"
+	// DepositSplitTable is the table that holds the deposit_split relation/edge.
+	DepositSplitTable = "payment_orders"
+	// DepositSplitInverseTable is the table name for the DepositSplit entity.
+	// It exists in this package in order to avoid circular dependency with the "depositsplit" package.
+	DepositSplitInverseTable = "deposit_splits"
+	// DepositSplitColumn is the table column denoting the deposit_split relation/edge.
+	DepositSplitColumn = "deposit_split_payment_orders"
+	// LedgerEntriesTable is the table that holds the ledger_entries relation/edge.
+	LedgerEntriesTable = "ledger_entries"
+	// LedgerEntriesInverseTable is the table name for the LedgerEntry entity.
+	// It exists in this package in order to avoid circular dependency with the "ledgerentry" package.
+	LedgerEntriesInverseTable = "ledger_entries"
+	// LedgerEntriesColumn is the table column denoting the ledger_entries relation/edge.
+	LedgerEntriesColumn = "payment_order_ledger_entries"
+	// ReconciliationDiscrepanciesTable is the table that holds the reconciliation_discrepancies relation/edge.
+	ReconciliationDiscrepanciesTable = "reconciliation_discrepancies"
+	// ReconciliationDiscrepanciesInverseTable is the table name for the ReconciliationDiscrepancy entity.
+	// It exists in this package in order to avoid circular dependency with the "reconciliationdiscrepancy" package.
+	ReconciliationDiscrepanciesInverseTable = "reconciliation_discrepancies"
+	// ReconciliationDiscrepanciesColumn is the table column denoting the reconciliation_discrepancies relation/edge.
+	ReconciliationDiscrepanciesColumn = "payment_order_reconciliation_discrepancies"
+	// UnmatchedDepositsTable is the table that holds the unmatched_deposits relation/edge.
+	UnmatchedDepositsTable = "unmatched_deposits"
+	// UnmatchedDepositsInverseTable is the table name for the UnmatchedDeposit entity.
+	// It exists in this package in order to avoid circular dependency with the "unmatcheddeposit" package.
+	UnmatchedDepositsInverseTable = "unmatched_deposits"
+	// UnmatchedDepositsColumn is the table column denoting the unmatched_deposits relation/edge.
+	UnmatchedDepositsColumn = "payment_order_unmatched_deposits"
+	// AddressAssignmentsTable is the table that holds the address_assignments relation/edge.
+	AddressAssignmentsTable = "receive_address_assignments"
+	// AddressAssignmentsInverseTable is the table name for the ReceiveAddressAssignment entity.
+	// It exists in this package in order to avoid circular dependency with the "receiveaddressassignment" package.
+	AddressAssignmentsInverseTable = "receive_address_assignments"
+	// AddressAssignmentsColumn is the table column denoting the address_assignments relation/edge.
+	AddressAssignmentsColumn = "payment_order_address_assignments"
 )
 
 // Columns holds all SQL columns for paymentorder fields.
@@ -135,6 +220,7 @@ var Columns = []string{
 	FieldAmount,
 	FieldAmountPaid,
 	FieldAmountReturned,
+	FieldAmountOverpaid,
 	FieldPercentSettled,
 	FieldSenderFee,
 	FieldNetworkFee,
@@ -152,13 +238,29 @@ var Columns = []string{
 	FieldReference,
 	FieldStatus,
 	FieldAmountInUsd,
+	FieldSettlementPolicy,
+	FieldDepositStatus,
+	FieldDepositFinalizedAt,
+	FieldRequiredConfirmations,
+	FieldSLABreachedAt,
+	FieldReviewReason,
+	FieldQuarantineReason,
+	FieldFiatAmount,
+	FieldFiatCurrency,
+	FieldRateDriftTolerance,
+	FieldFiatConversion,
+	FieldRateLockedUntil,
+	FieldRateRequote,
+	FieldBlockchainService,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "payment_orders"
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
 	"api_key_payment_orders",
+	"deposit_split_payment_orders",
 	"linked_address_payment_orders",
+	"payment_order_refund_sweep",
 	"sender_profile_payment_orders",
 	"token_payment_orders",
 }
@@ -185,6 +287,8 @@ var (
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultAmountOverpaid holds the default value on creation for the "amount_overpaid" field.
+	DefaultAmountOverpaid func() decimal.Decimal
 	// TxHashValidator is a validator for the "tx_hash" field. It is called by the builders before save.
 	TxHashValidator func(string) error
 	// DefaultBlockNumber holds the default value on creation for the "block_number" field.
@@ -203,6 +307,14 @@ var (
 	MessageHashValidator func(string) error
 	// ReferenceValidator is a validator for the "reference" field. It is called by the builders before save.
 	ReferenceValidator func(string) error
+	// DefaultRequiredConfirmations holds the default value on creation for the "required_confirmations" field.
+	DefaultRequiredConfirmations int
+	// RequiredConfirmationsValidator is a validator for the "required_confirmations" field. It is called by the builders before save.
+	RequiredConfirmationsValidator func(int) error
+	// FiatCurrencyValidator is a validator for the "fiat_currency" field. It is called by the builders before save.
+	FiatCurrencyValidator func(string) error
+	// DefaultRateDriftTolerance holds the default value on creation for the "rate_drift_tolerance" field.
+	DefaultRateDriftTolerance func() decimal.Decimal
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -215,13 +327,15 @@ const DefaultStatus = StatusInitiated
 
 // Status values.
 const (
-	StatusInitiated  Status = "initiated"
-	StatusProcessing Status = "processing"
-	StatusPending    Status = "pending"
-	StatusValidated  Status = "validated"
-	StatusExpired    Status = "expired"
-	StatusSettled    Status = "settled"
-	StatusRefunded   Status = "refunded"
+	StatusInitiated             Status = "initiated"
+	StatusProcessing            Status = "processing"
+	StatusAwaitingConfirmations Status = "awaiting_confirmations"
+	StatusPending               Status = "pending"
+	StatusValidated             Status = "validated"
+	StatusExpired               Status = "expired"
+	StatusSettled               Status = "settled"
+	StatusRefunded              Status = "refunded"
+	StatusQuarantined           Status = "quarantined"
 )
 
 func (s Status) String() string {
@@ -231,13 +345,86 @@ func (s Status) String() string {
 // StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
 func StatusValidator(s Status) error {
 	switch s {
-	case StatusInitiated, StatusProcessing, StatusPending, StatusValidated, StatusExpired, StatusSettled, StatusRefunded:
+	case StatusInitiated, StatusProcessing, StatusAwaitingConfirmations, StatusPending, StatusValidated, StatusExpired, StatusSettled, StatusRefunded, StatusQuarantined:
 		return nil
 	default:
 		return fmt.Errorf("paymentorder: invalid enum value for status field: %q", s)
 	}
 }
 
+// SettlementPolicy defines the type for the "settlement_policy" enum field.
+type SettlementPolicy string
+
+// SettlementPolicySoftConfirm is the default value of the SettlementPolicy enum.
+const DefaultSettlementPolicy = SettlementPolicySoftConfirm
+
+// SettlementPolicy values.
+const (
+	SettlementPolicySoftConfirm SettlementPolicy = "soft_confirm"
+	SettlementPolicyFinality    SettlementPolicy = "finality"
+)
+
+func (sp SettlementPolicy) String() string {
+	return string(sp)
+}
+
+// SettlementPolicyValidator is a validator for the "settlement_policy" field enum values. It is called by the builders before save.
+func SettlementPolicyValidator(sp SettlementPolicy) error {
+	switch sp {
+	case SettlementPolicySoftConfirm, SettlementPolicyFinality:
+		return nil
+	default:
+		return fmt.Errorf("paymentorder: invalid enum value for settlement_policy field: %q", sp)
+	}
+}
+
+// DepositStatus defines the type for the "deposit_status" enum field.
+type DepositStatus string
+
+// DepositStatus values.
+const (
+	DepositStatusSoftConfirmed DepositStatus = "soft_confirmed"
+	DepositStatusFinalized     DepositStatus = "finalized"
+)
+
+func (ds DepositStatus) String() string {
+	return string(ds)
+}
+
+// DepositStatusValidator is a validator for the "deposit_status" field enum values. It is called by the builders before save.
+func DepositStatusValidator(ds DepositStatus) error {
+	switch ds {
+	case DepositStatusSoftConfirmed, DepositStatusFinalized:
+		return nil
+	default:
+		return fmt.Errorf("paymentorder: invalid enum value for deposit_status field: %q", ds)
+	}
+}
+
+// BlockchainService defines the type for the "blockchain_service" enum field.
+type BlockchainService string
+
+// BlockchainService values.
+const (
+	BlockchainServiceEngine  BlockchainService = "engine"
+	BlockchainServiceAlchemy BlockchainService = "alchemy"
+	BlockchainServiceRPC     BlockchainService = "rpc"
+)
+
+func (bs BlockchainService) String() string {
+	return string(bs)
+}
+
+// BlockchainServiceValidator is a validator for the "blockchain_service" field enum values. It is called by the builders before save.
+func BlockchainServiceValidator(bs BlockchainService) error {
+	switch bs {
+	case BlockchainServiceEngine, BlockchainServiceAlchemy, BlockchainServiceRPC:
+		return nil
+	default:
+		return fmt.Errorf("paymentorder: invalid enum value for blockchain_service field: %q", bs)
+	}
+}
+
 // OrderOption defines the ordering options for the PaymentOrder queries.
 type OrderOption func(*sql.Selector)
 
@@ -271,6 +458,11 @@ func ByAmountReturned(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAmountReturned, opts...).ToFunc()
 }
 
+// ByAmountOverpaid orders the results by the amount_overpaid field.
+func ByAmountOverpaid(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmountOverpaid, opts...).ToFunc()
+}
+
 // ByPercentSettled orders the results by the percent_settled field.
 func ByPercentSettled(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPercentSettled, opts...).ToFunc()
@@ -356,6 +548,66 @@ func ByAmountInUsd(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAmountInUsd, opts...).ToFunc()
 }
 
+// BySettlementPolicy orders the results by the settlement_policy field.
+func BySettlementPolicy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSettlementPolicy, opts...).ToFunc()
+}
+
+// ByDepositStatus orders the results by the deposit_status field.
+func ByDepositStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDepositStatus, opts...).ToFunc()
+}
+
+// ByDepositFinalizedAt orders the results by the deposit_finalized_at field.
+func ByDepositFinalizedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDepositFinalizedAt, opts...).ToFunc()
+}
+
+// ByRequiredConfirmations orders the results by the required_confirmations field.
+func ByRequiredConfirmations(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequiredConfirmations, opts...).ToFunc()
+}
+
+// BySLABreachedAt orders the results by the sla_breached_at field.
+func BySLABreachedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSLABreachedAt, opts...).ToFunc()
+}
+
+// ByReviewReason orders the results by the review_reason field.
+func ByReviewReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewReason, opts...).ToFunc()
+}
+
+// ByQuarantineReason orders the results by the quarantine_reason field.
+func ByQuarantineReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuarantineReason, opts...).ToFunc()
+}
+
+// ByFiatAmount orders the results by the fiat_amount field.
+func ByFiatAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFiatAmount, opts...).ToFunc()
+}
+
+// ByFiatCurrency orders the results by the fiat_currency field.
+func ByFiatCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFiatCurrency, opts...).ToFunc()
+}
+
+// ByRateDriftTolerance orders the results by the rate_drift_tolerance field.
+func ByRateDriftTolerance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRateDriftTolerance, opts...).ToFunc()
+}
+
+// ByRateLockedUntil orders the results by the rate_locked_until field.
+func ByRateLockedUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRateLockedUntil, opts...).ToFunc()
+}
+
+// ByBlockchainService orders the results by the blockchain_service field.
+func ByBlockchainService(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBlockchainService, opts...).ToFunc()
+}
+
 // BySenderProfileField orders the results by sender_profile field.
 func BySenderProfileField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -411,6 +663,76 @@ func ByPaymentWebhookField(field string, opts ...sql.OrderTermOption) OrderOptio
 		sqlgraph.OrderByNeighborTerms(s, newPaymentWebhookStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByRefundSweepField orders the results by refund_sweep field.
+func ByRefundSweepField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRefundSweepStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByDepositSplitField orders the results by deposit_split field.
+func ByDepositSplitField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDepositSplitStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByLedgerEntriesCount orders the results by ledger_entries count.
+func ByLedgerEntriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLedgerEntriesStep(), opts...)
+	}
+}
+
+// ByLedgerEntries orders the results by ledger_entries terms.
+func ByLedgerEntries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLedgerEntriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReconciliationDiscrepanciesCount orders the results by reconciliation_discrepancies count.
+func ByReconciliationDiscrepanciesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReconciliationDiscrepanciesStep(), opts...)
+	}
+}
+
+// ByReconciliationDiscrepancies orders the results by reconciliation_discrepancies terms.
+func ByReconciliationDiscrepancies(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReconciliationDiscrepanciesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByUnmatchedDepositsCount orders the results by unmatched_deposits count.
+func ByUnmatchedDepositsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newUnmatchedDepositsStep(), opts...)
+	}
+}
+
+// ByUnmatchedDeposits orders the results by unmatched_deposits terms.
+func ByUnmatchedDeposits(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUnmatchedDepositsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAddressAssignmentsCount orders the results by address_assignments count.
+func ByAddressAssignmentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAddressAssignmentsStep(), opts...)
+	}
+}
+
+// ByAddressAssignments orders the results by address_assignments terms.
+func ByAddressAssignments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAddressAssignmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newSenderProfileStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -460,3 +782,45 @@ func newPaymentWebhookStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2O, false, PaymentWebhookTable, PaymentWebhookColumn),
 	)
 }
+func newRefundSweepStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RefundSweepInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, RefundSweepTable, RefundSweepColumn),
+	)
+}
+func newDepositSplitStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DepositSplitInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, DepositSplitTable, DepositSplitColumn),
+	)
+}
+func newLedgerEntriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LedgerEntriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LedgerEntriesTable, LedgerEntriesColumn),
+	)
+}
+func newReconciliationDiscrepanciesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReconciliationDiscrepanciesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReconciliationDiscrepanciesTable, ReconciliationDiscrepanciesColumn),
+	)
+}
+func newUnmatchedDepositsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UnmatchedDepositsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, UnmatchedDepositsTable, UnmatchedDepositsColumn),
+	)
+}
+func newAddressAssignmentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AddressAssignmentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AddressAssignmentsTable, AddressAssignmentsColumn),
+	)
+}
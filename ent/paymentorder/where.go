@@ -82,6 +82,11 @@ func AmountReturned(v decimal.Decimal) predicate.PaymentOrder {
 	return predicate.PaymentOrder(sql.FieldEQ(FieldAmountReturned, v))
 }
 
+// AmountOverpaid applies equality check predicate on the "amount_overpaid" field. It's identical to AmountOverpaidEQ.
+func AmountOverpaid(v decimal.Decimal) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldEQ(FieldAmountOverpaid, v))
+}
+
 // PercentSettled applies equality check predicate on the "percent_settled" field. It's identical to PercentSettledEQ.
 func PercentSettled(v decimal.Decimal) predicate.PaymentOrder {
 	return predicate.PaymentOrder(sql.FieldEQ(FieldPercentSettled, v))
@@ -162,6 +167,51 @@ func AmountInUsd(v decimal.Decimal) predicate.PaymentOrder {
 	return predicate.PaymentOrder(sql.FieldEQ(FieldAmountInUsd, v))
 }
 
+// DepositFinalizedAt applies equality check predicate on the "deposit_finalized_at" field. It's identical to DepositFinalizedAtEQ.
+func DepositFinalizedAt(v time.Time) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldEQ(FieldDepositFinalizedAt, v))
+}
+
+// RequiredConfirmations applies equality check predicate on the "required_confirmations" field. It's identical to RequiredConfirmationsEQ.
+func RequiredConfirmations(v int) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldEQ(FieldRequiredConfirmations, v))
+}
+
+// SLABreachedAt applies equality check predicate on the "sla_breached_at" field. It's identical to SLABreachedAtEQ.
+func SLABreachedAt(v time.Time) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldEQ(FieldSLABreachedAt, v))
+}
+
+// ReviewReason applies equality check predicate on the "review_reason" field. It's identical to ReviewReasonEQ.
+func ReviewReason(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldEQ(FieldReviewReason, v))
+}
+
+// QuarantineReason applies equality check predicate on the "quarantine_reason" field. It's identical to QuarantineReasonEQ.
+func QuarantineReason(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldEQ(FieldQuarantineReason, v))
+}
+
+// FiatAmount applies equality check predicate on the "fiat_amount" field. It's identical to FiatAmountEQ.
+func FiatAmount(v decimal.Decimal) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldEQ(FieldFiatAmount, v))
+}
+
+// FiatCurrency applies equality check predicate on the "fiat_currency" field. It's identical to FiatCurrencyEQ.
+func FiatCurrency(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldEQ(FieldFiatCurrency, v))
+}
+
+// RateDriftTolerance applies equality check predicate on the "rate_drift_tolerance" field. It's identical to RateDriftToleranceEQ.
+func RateDriftTolerance(v decimal.Decimal) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldEQ(FieldRateDriftTolerance, v))
+}
+
+// RateLockedUntil applies equality check predicate on the "rate_locked_until" field. It's identical to RateLockedUntilEQ.
+func RateLockedUntil(v time.Time) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldEQ(FieldRateLockedUntil, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.PaymentOrder {
 	return predicate.PaymentOrder(sql.FieldEQ(FieldCreatedAt, v))
@@ -362,6 +412,46 @@ func AmountReturnedLTE(v decimal.Decimal) predicate.PaymentOrder {
 	return predicate.PaymentOrder(sql.FieldLTE(FieldAmountReturned, v))
 }
 
+// AmountOverpaidEQ applies the EQ predicate on the "amount_overpaid" field.
+func AmountOverpaidEQ(v decimal.Decimal) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldEQ(FieldAmountOverpaid, v))
+}
+
+// AmountOverpaidNEQ applies the NEQ predicate on the "amount_overpaid" field.
+func AmountOverpaidNEQ(v decimal.Decimal) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNEQ(FieldAmountOverpaid, v))
+}
+
+// AmountOverpaidIn applies the In predicate on the "amount_overpaid" field.
+func AmountOverpaidIn(vs ...decimal.Decimal) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldIn(FieldAmountOverpaid, vs...))
+}
+
+// AmountOverpaidNotIn applies the NotIn predicate on the "amount_overpaid" field.
+func AmountOverpaidNotIn(vs ...decimal.Decimal) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNotIn(FieldAmountOverpaid, vs...))
+}
+
+// AmountOverpaidGT applies the GT predicate on the "amount_overpaid" field.
+func AmountOverpaidGT(v decimal.Decimal) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldGT(FieldAmountOverpaid, v))
+}
+
+// AmountOverpaidGTE applies the GTE predicate on the "amount_overpaid" field.
+func AmountOverpaidGTE(v decimal.Decimal) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldGTE(FieldAmountOverpaid, v))
+}
+
+// AmountOverpaidLT applies the LT predicate on the "amount_overpaid" field.
+func AmountOverpaidLT(v decimal.Decimal) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldLT(FieldAmountOverpaid, v))
+}
+
+// AmountOverpaidLTE applies the LTE predicate on the "amount_overpaid" field.
+func AmountOverpaidLTE(v decimal.Decimal) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldLTE(FieldAmountOverpaid, v))
+}
+
 // PercentSettledEQ applies the EQ predicate on the "percent_settled" field.
 func PercentSettledEQ(v decimal.Decimal) predicate.PaymentOrder {
 	return predicate.PaymentOrder(sql.FieldEQ(FieldPercentSettled, v))
@@ -1292,6 +1382,611 @@ func AmountInUsdLTE(v decimal.Decimal) predicate.PaymentOrder {
 	return predicate.PaymentOrder(sql.FieldLTE(FieldAmountInUsd, v))
 }
 
+// SettlementPolicyEQ applies the EQ predicate on the "settlement_policy" field.
+func SettlementPolicyEQ(v SettlementPolicy) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldEQ(FieldSettlementPolicy, v))
+}
+
+// SettlementPolicyNEQ applies the NEQ predicate on the "settlement_policy" field.
+func SettlementPolicyNEQ(v SettlementPolicy) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNEQ(FieldSettlementPolicy, v))
+}
+
+// SettlementPolicyIn applies the In predicate on the "settlement_policy" field.
+func SettlementPolicyIn(vs ...SettlementPolicy) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldIn(FieldSettlementPolicy, vs...))
+}
+
+// SettlementPolicyNotIn applies the NotIn predicate on the "settlement_policy" field.
+func SettlementPolicyNotIn(vs ...SettlementPolicy) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNotIn(FieldSettlementPolicy, vs...))
+}
+
+// DepositStatusEQ applies the EQ predicate on the "deposit_status" field.
+func DepositStatusEQ(v DepositStatus) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldEQ(FieldDepositStatus, v))
+}
+
+// DepositStatusNEQ applies the NEQ predicate on the "deposit_status" field.
+func DepositStatusNEQ(v DepositStatus) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNEQ(FieldDepositStatus, v))
+}
+
+// DepositStatusIn applies the In predicate on the "deposit_status" field.
+func DepositStatusIn(vs ...DepositStatus) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldIn(FieldDepositStatus, vs...))
+}
+
+// DepositStatusNotIn applies the NotIn predicate on the "deposit_status" field.
+func DepositStatusNotIn(vs ...DepositStatus) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNotIn(FieldDepositStatus, vs...))
+}
+
+// DepositStatusIsNil applies the IsNil predicate on the "deposit_status" field.
+func DepositStatusIsNil() predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldIsNull(FieldDepositStatus))
+}
+
+// DepositStatusNotNil applies the NotNil predicate on the "deposit_status" field.
+func DepositStatusNotNil() predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNotNull(FieldDepositStatus))
+}
+
+// DepositFinalizedAtEQ applies the EQ predicate on the "deposit_finalized_at" field.
+func DepositFinalizedAtEQ(v time.Time) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldEQ(FieldDepositFinalizedAt, v))
+}
+
+// DepositFinalizedAtNEQ applies the NEQ predicate on the "deposit_finalized_at" field.
+func DepositFinalizedAtNEQ(v time.Time) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNEQ(FieldDepositFinalizedAt, v))
+}
+
+// DepositFinalizedAtIn applies the In predicate on the "deposit_finalized_at" field.
+func DepositFinalizedAtIn(vs ...time.Time) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldIn(FieldDepositFinalizedAt, vs...))
+}
+
+// DepositFinalizedAtNotIn applies the NotIn predicate on the "deposit_finalized_at" field.
+func DepositFinalizedAtNotIn(vs ...time.Time) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNotIn(FieldDepositFinalizedAt, vs...))
+}
+
+// DepositFinalizedAtGT applies the GT predicate on the "deposit_finalized_at" field.
+func DepositFinalizedAtGT(v time.Time) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldGT(FieldDepositFinalizedAt, v))
+}
+
+// DepositFinalizedAtGTE applies the GTE predicate on the "deposit_finalized_at" field.
+func DepositFinalizedAtGTE(v time.Time) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldGTE(FieldDepositFinalizedAt, v))
+}
+
+// DepositFinalizedAtLT applies the LT predicate on the "deposit_finalized_at" field.
+func DepositFinalizedAtLT(v time.Time) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldLT(FieldDepositFinalizedAt, v))
+}
+
+// DepositFinalizedAtLTE applies the LTE predicate on the "deposit_finalized_at" field.
+func DepositFinalizedAtLTE(v time.Time) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldLTE(FieldDepositFinalizedAt, v))
+}
+
+// DepositFinalizedAtIsNil applies the IsNil predicate on the "deposit_finalized_at" field.
+func DepositFinalizedAtIsNil() predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldIsNull(FieldDepositFinalizedAt))
+}
+
+// DepositFinalizedAtNotNil applies the NotNil predicate on the "deposit_finalized_at" field.
+func DepositFinalizedAtNotNil() predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNotNull(FieldDepositFinalizedAt))
+}
+
+// RequiredConfirmationsEQ applies the EQ predicate on the "required_confirmations" field.
+func RequiredConfirmationsEQ(v int) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldEQ(FieldRequiredConfirmations, v))
+}
+
+// RequiredConfirmationsNEQ applies the NEQ predicate on the "required_confirmations" field.
+func RequiredConfirmationsNEQ(v int) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNEQ(FieldRequiredConfirmations, v))
+}
+
+// RequiredConfirmationsIn applies the In predicate on the "required_confirmations" field.
+func RequiredConfirmationsIn(vs ...int) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldIn(FieldRequiredConfirmations, vs...))
+}
+
+// RequiredConfirmationsNotIn applies the NotIn predicate on the "required_confirmations" field.
+func RequiredConfirmationsNotIn(vs ...int) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNotIn(FieldRequiredConfirmations, vs...))
+}
+
+// RequiredConfirmationsGT applies the GT predicate on the "required_confirmations" field.
+func RequiredConfirmationsGT(v int) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldGT(FieldRequiredConfirmations, v))
+}
+
+// RequiredConfirmationsGTE applies the GTE predicate on the "required_confirmations" field.
+func RequiredConfirmationsGTE(v int) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldGTE(FieldRequiredConfirmations, v))
+}
+
+// RequiredConfirmationsLT applies the LT predicate on the "required_confirmations" field.
+func RequiredConfirmationsLT(v int) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldLT(FieldRequiredConfirmations, v))
+}
+
+// RequiredConfirmationsLTE applies the LTE predicate on the "required_confirmations" field.
+func RequiredConfirmationsLTE(v int) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldLTE(FieldRequiredConfirmations, v))
+}
+
+// SLABreachedAtEQ applies the EQ predicate on the "sla_breached_at" field.
+func SLABreachedAtEQ(v time.Time) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldEQ(FieldSLABreachedAt, v))
+}
+
+// SLABreachedAtNEQ applies the NEQ predicate on the "sla_breached_at" field.
+func SLABreachedAtNEQ(v time.Time) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNEQ(FieldSLABreachedAt, v))
+}
+
+// SLABreachedAtIn applies the In predicate on the "sla_breached_at" field.
+func SLABreachedAtIn(vs ...time.Time) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldIn(FieldSLABreachedAt, vs...))
+}
+
+// SLABreachedAtNotIn applies the NotIn predicate on the "sla_breached_at" field.
+func SLABreachedAtNotIn(vs ...time.Time) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNotIn(FieldSLABreachedAt, vs...))
+}
+
+// SLABreachedAtGT applies the GT predicate on the "sla_breached_at" field.
+func SLABreachedAtGT(v time.Time) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldGT(FieldSLABreachedAt, v))
+}
+
+// SLABreachedAtGTE applies the GTE predicate on the "sla_breached_at" field.
+func SLABreachedAtGTE(v time.Time) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldGTE(FieldSLABreachedAt, v))
+}
+
+// SLABreachedAtLT applies the LT predicate on the "sla_breached_at" field.
+func SLABreachedAtLT(v time.Time) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldLT(FieldSLABreachedAt, v))
+}
+
+// SLABreachedAtLTE applies the LTE predicate on the "sla_breached_at" field.
+func SLABreachedAtLTE(v time.Time) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldLTE(FieldSLABreachedAt, v))
+}
+
+// SLABreachedAtIsNil applies the IsNil predicate on the "sla_breached_at" field.
+func SLABreachedAtIsNil() predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldIsNull(FieldSLABreachedAt))
+}
+
+// SLABreachedAtNotNil applies the NotNil predicate on the "sla_breached_at" field.
+func SLABreachedAtNotNil() predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNotNull(FieldSLABreachedAt))
+}
+
+// ReviewReasonEQ applies the EQ predicate on the "review_reason" field.
+func ReviewReasonEQ(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldEQ(FieldReviewReason, v))
+}
+
+// ReviewReasonNEQ applies the NEQ predicate on the "review_reason" field.
+func ReviewReasonNEQ(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNEQ(FieldReviewReason, v))
+}
+
+// ReviewReasonIn applies the In predicate on the "review_reason" field.
+func ReviewReasonIn(vs ...string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldIn(FieldReviewReason, vs...))
+}
+
+// ReviewReasonNotIn applies the NotIn predicate on the "review_reason" field.
+func ReviewReasonNotIn(vs ...string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNotIn(FieldReviewReason, vs...))
+}
+
+// ReviewReasonGT applies the GT predicate on the "review_reason" field.
+func ReviewReasonGT(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldGT(FieldReviewReason, v))
+}
+
+// ReviewReasonGTE applies the GTE predicate on the "review_reason" field.
+func ReviewReasonGTE(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldGTE(FieldReviewReason, v))
+}
+
+// ReviewReasonLT applies the LT predicate on the "review_reason" field.
+func ReviewReasonLT(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldLT(FieldReviewReason, v))
+}
+
+// ReviewReasonLTE applies the LTE predicate on the "review_reason" field.
+func ReviewReasonLTE(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldLTE(FieldReviewReason, v))
+}
+
+// ReviewReasonContains applies the Contains predicate on the "review_reason" field.
+func ReviewReasonContains(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldContains(FieldReviewReason, v))
+}
+
+// ReviewReasonHasPrefix applies the HasPrefix predicate on the "review_reason" field.
+func ReviewReasonHasPrefix(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldHasPrefix(FieldReviewReason, v))
+}
+
+// ReviewReasonHasSuffix applies the HasSuffix predicate on the "review_reason" field.
+func ReviewReasonHasSuffix(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldHasSuffix(FieldReviewReason, v))
+}
+
+// ReviewReasonIsNil applies the IsNil predicate on the "review_reason" field.
+func ReviewReasonIsNil() predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldIsNull(FieldReviewReason))
+}
+
+// ReviewReasonNotNil applies the NotNil predicate on the "review_reason" field.
+func ReviewReasonNotNil() predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNotNull(FieldReviewReason))
+}
+
+// ReviewReasonEqualFold applies the EqualFold predicate on the "review_reason" field.
+func ReviewReasonEqualFold(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldEqualFold(FieldReviewReason, v))
+}
+
+// ReviewReasonContainsFold applies the ContainsFold predicate on the "review_reason" field.
+func ReviewReasonContainsFold(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldContainsFold(FieldReviewReason, v))
+}
+
+// QuarantineReasonEQ applies the EQ predicate on the "quarantine_reason" field.
+func QuarantineReasonEQ(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldEQ(FieldQuarantineReason, v))
+}
+
+// QuarantineReasonNEQ applies the NEQ predicate on the "quarantine_reason" field.
+func QuarantineReasonNEQ(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNEQ(FieldQuarantineReason, v))
+}
+
+// QuarantineReasonIn applies the In predicate on the "quarantine_reason" field.
+func QuarantineReasonIn(vs ...string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldIn(FieldQuarantineReason, vs...))
+}
+
+// QuarantineReasonNotIn applies the NotIn predicate on the "quarantine_reason" field.
+func QuarantineReasonNotIn(vs ...string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNotIn(FieldQuarantineReason, vs...))
+}
+
+// QuarantineReasonGT applies the GT predicate on the "quarantine_reason" field.
+func QuarantineReasonGT(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldGT(FieldQuarantineReason, v))
+}
+
+// QuarantineReasonGTE applies the GTE predicate on the "quarantine_reason" field.
+func QuarantineReasonGTE(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldGTE(FieldQuarantineReason, v))
+}
+
+// QuarantineReasonLT applies the LT predicate on the "quarantine_reason" field.
+func QuarantineReasonLT(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldLT(FieldQuarantineReason, v))
+}
+
+// QuarantineReasonLTE applies the LTE predicate on the "quarantine_reason" field.
+func QuarantineReasonLTE(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldLTE(FieldQuarantineReason, v))
+}
+
+// QuarantineReasonContains applies the Contains predicate on the "quarantine_reason" field.
+func QuarantineReasonContains(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldContains(FieldQuarantineReason, v))
+}
+
+// QuarantineReasonHasPrefix applies the HasPrefix predicate on the "quarantine_reason" field.
+func QuarantineReasonHasPrefix(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldHasPrefix(FieldQuarantineReason, v))
+}
+
+// QuarantineReasonHasSuffix applies the HasSuffix predicate on the "quarantine_reason" field.
+func QuarantineReasonHasSuffix(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldHasSuffix(FieldQuarantineReason, v))
+}
+
+// QuarantineReasonIsNil applies the IsNil predicate on the "quarantine_reason" field.
+func QuarantineReasonIsNil() predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldIsNull(FieldQuarantineReason))
+}
+
+// QuarantineReasonNotNil applies the NotNil predicate on the "quarantine_reason" field.
+func QuarantineReasonNotNil() predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNotNull(FieldQuarantineReason))
+}
+
+// QuarantineReasonEqualFold applies the EqualFold predicate on the "quarantine_reason" field.
+func QuarantineReasonEqualFold(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldEqualFold(FieldQuarantineReason, v))
+}
+
+// QuarantineReasonContainsFold applies the ContainsFold predicate on the "quarantine_reason" field.
+func QuarantineReasonContainsFold(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldContainsFold(FieldQuarantineReason, v))
+}
+
+// FiatAmountEQ applies the EQ predicate on the "fiat_amount" field.
+func FiatAmountEQ(v decimal.Decimal) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldEQ(FieldFiatAmount, v))
+}
+
+// FiatAmountNEQ applies the NEQ predicate on the "fiat_amount" field.
+func FiatAmountNEQ(v decimal.Decimal) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNEQ(FieldFiatAmount, v))
+}
+
+// FiatAmountIn applies the In predicate on the "fiat_amount" field.
+func FiatAmountIn(vs ...decimal.Decimal) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldIn(FieldFiatAmount, vs...))
+}
+
+// FiatAmountNotIn applies the NotIn predicate on the "fiat_amount" field.
+func FiatAmountNotIn(vs ...decimal.Decimal) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNotIn(FieldFiatAmount, vs...))
+}
+
+// FiatAmountGT applies the GT predicate on the "fiat_amount" field.
+func FiatAmountGT(v decimal.Decimal) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldGT(FieldFiatAmount, v))
+}
+
+// FiatAmountGTE applies the GTE predicate on the "fiat_amount" field.
+func FiatAmountGTE(v decimal.Decimal) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldGTE(FieldFiatAmount, v))
+}
+
+// FiatAmountLT applies the LT predicate on the "fiat_amount" field.
+func FiatAmountLT(v decimal.Decimal) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldLT(FieldFiatAmount, v))
+}
+
+// FiatAmountLTE applies the LTE predicate on the "fiat_amount" field.
+func FiatAmountLTE(v decimal.Decimal) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldLTE(FieldFiatAmount, v))
+}
+
+// FiatAmountIsNil applies the IsNil predicate on the "fiat_amount" field.
+func FiatAmountIsNil() predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldIsNull(FieldFiatAmount))
+}
+
+// FiatAmountNotNil applies the NotNil predicate on the "fiat_amount" field.
+func FiatAmountNotNil() predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNotNull(FieldFiatAmount))
+}
+
+// FiatCurrencyEQ applies the EQ predicate on the "fiat_currency" field.
+func FiatCurrencyEQ(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldEQ(FieldFiatCurrency, v))
+}
+
+// FiatCurrencyNEQ applies the NEQ predicate on the "fiat_currency" field.
+func FiatCurrencyNEQ(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNEQ(FieldFiatCurrency, v))
+}
+
+// FiatCurrencyIn applies the In predicate on the "fiat_currency" field.
+func FiatCurrencyIn(vs ...string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldIn(FieldFiatCurrency, vs...))
+}
+
+// FiatCurrencyNotIn applies the NotIn predicate on the "fiat_currency" field.
+func FiatCurrencyNotIn(vs ...string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNotIn(FieldFiatCurrency, vs...))
+}
+
+// FiatCurrencyGT applies the GT predicate on the "fiat_currency" field.
+func FiatCurrencyGT(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldGT(FieldFiatCurrency, v))
+}
+
+// FiatCurrencyGTE applies the GTE predicate on the "fiat_currency" field.
+func FiatCurrencyGTE(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldGTE(FieldFiatCurrency, v))
+}
+
+// FiatCurrencyLT applies the LT predicate on the "fiat_currency" field.
+func FiatCurrencyLT(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldLT(FieldFiatCurrency, v))
+}
+
+// FiatCurrencyLTE applies the LTE predicate on the "fiat_currency" field.
+func FiatCurrencyLTE(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldLTE(FieldFiatCurrency, v))
+}
+
+// FiatCurrencyContains applies the Contains predicate on the "fiat_currency" field.
+func FiatCurrencyContains(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldContains(FieldFiatCurrency, v))
+}
+
+// FiatCurrencyHasPrefix applies the HasPrefix predicate on the "fiat_currency" field.
+func FiatCurrencyHasPrefix(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldHasPrefix(FieldFiatCurrency, v))
+}
+
+// FiatCurrencyHasSuffix applies the HasSuffix predicate on the "fiat_currency" field.
+func FiatCurrencyHasSuffix(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldHasSuffix(FieldFiatCurrency, v))
+}
+
+// FiatCurrencyIsNil applies the IsNil predicate on the "fiat_currency" field.
+func FiatCurrencyIsNil() predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldIsNull(FieldFiatCurrency))
+}
+
+// FiatCurrencyNotNil applies the NotNil predicate on the "fiat_currency" field.
+func FiatCurrencyNotNil() predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNotNull(FieldFiatCurrency))
+}
+
+// FiatCurrencyEqualFold applies the EqualFold predicate on the "fiat_currency" field.
+func FiatCurrencyEqualFold(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldEqualFold(FieldFiatCurrency, v))
+}
+
+// FiatCurrencyContainsFold applies the ContainsFold predicate on the "fiat_currency" field.
+func FiatCurrencyContainsFold(v string) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldContainsFold(FieldFiatCurrency, v))
+}
+
+// RateDriftToleranceEQ applies the EQ predicate on the "rate_drift_tolerance" field.
+func RateDriftToleranceEQ(v decimal.Decimal) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldEQ(FieldRateDriftTolerance, v))
+}
+
+// RateDriftToleranceNEQ applies the NEQ predicate on the "rate_drift_tolerance" field.
+func RateDriftToleranceNEQ(v decimal.Decimal) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNEQ(FieldRateDriftTolerance, v))
+}
+
+// RateDriftToleranceIn applies the In predicate on the "rate_drift_tolerance" field.
+func RateDriftToleranceIn(vs ...decimal.Decimal) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldIn(FieldRateDriftTolerance, vs...))
+}
+
+// RateDriftToleranceNotIn applies the NotIn predicate on the "rate_drift_tolerance" field.
+func RateDriftToleranceNotIn(vs ...decimal.Decimal) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNotIn(FieldRateDriftTolerance, vs...))
+}
+
+// RateDriftToleranceGT applies the GT predicate on the "rate_drift_tolerance" field.
+func RateDriftToleranceGT(v decimal.Decimal) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldGT(FieldRateDriftTolerance, v))
+}
+
+// RateDriftToleranceGTE applies the GTE predicate on the "rate_drift_tolerance" field.
+func RateDriftToleranceGTE(v decimal.Decimal) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldGTE(FieldRateDriftTolerance, v))
+}
+
+// RateDriftToleranceLT applies the LT predicate on the "rate_drift_tolerance" field.
+func RateDriftToleranceLT(v decimal.Decimal) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldLT(FieldRateDriftTolerance, v))
+}
+
+// RateDriftToleranceLTE applies the LTE predicate on the "rate_drift_tolerance" field.
+func RateDriftToleranceLTE(v decimal.Decimal) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldLTE(FieldRateDriftTolerance, v))
+}
+
+// FiatConversionIsNil applies the IsNil predicate on the "fiat_conversion" field.
+func FiatConversionIsNil() predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldIsNull(FieldFiatConversion))
+}
+
+// FiatConversionNotNil applies the NotNil predicate on the "fiat_conversion" field.
+func FiatConversionNotNil() predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNotNull(FieldFiatConversion))
+}
+
+// RateLockedUntilEQ applies the EQ predicate on the "rate_locked_until" field.
+func RateLockedUntilEQ(v time.Time) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldEQ(FieldRateLockedUntil, v))
+}
+
+// RateLockedUntilNEQ applies the NEQ predicate on the "rate_locked_until" field.
+func RateLockedUntilNEQ(v time.Time) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNEQ(FieldRateLockedUntil, v))
+}
+
+// RateLockedUntilIn applies the In predicate on the "rate_locked_until" field.
+func RateLockedUntilIn(vs ...time.Time) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldIn(FieldRateLockedUntil, vs...))
+}
+
+// RateLockedUntilNotIn applies the NotIn predicate on the "rate_locked_until" field.
+func RateLockedUntilNotIn(vs ...time.Time) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNotIn(FieldRateLockedUntil, vs...))
+}
+
+// RateLockedUntilGT applies the GT predicate on the "rate_locked_until" field.
+func RateLockedUntilGT(v time.Time) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldGT(FieldRateLockedUntil, v))
+}
+
+// RateLockedUntilGTE applies the GTE predicate on the "rate_locked_until" field.
+func RateLockedUntilGTE(v time.Time) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldGTE(FieldRateLockedUntil, v))
+}
+
+// RateLockedUntilLT applies the LT predicate on the "rate_locked_until" field.
+func RateLockedUntilLT(v time.Time) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldLT(FieldRateLockedUntil, v))
+}
+
+// RateLockedUntilLTE applies the LTE predicate on the "rate_locked_until" field.
+func RateLockedUntilLTE(v time.Time) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldLTE(FieldRateLockedUntil, v))
+}
+
+// RateLockedUntilIsNil applies the IsNil predicate on the "rate_locked_until" field.
+func RateLockedUntilIsNil() predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldIsNull(FieldRateLockedUntil))
+}
+
+// RateLockedUntilNotNil applies the NotNil predicate on the "rate_locked_until" field.
+func RateLockedUntilNotNil() predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNotNull(FieldRateLockedUntil))
+}
+
+// RateRequoteIsNil applies the IsNil predicate on the "rate_requote" field.
+func RateRequoteIsNil() predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldIsNull(FieldRateRequote))
+}
+
+// RateRequoteNotNil applies the NotNil predicate on the "rate_requote" field.
+func RateRequoteNotNil() predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNotNull(FieldRateRequote))
+}
+
+// BlockchainServiceEQ applies the EQ predicate on the "blockchain_service" field.
+func BlockchainServiceEQ(v BlockchainService) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldEQ(FieldBlockchainService, v))
+}
+
+// BlockchainServiceNEQ applies the NEQ predicate on the "blockchain_service" field.
+func BlockchainServiceNEQ(v BlockchainService) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNEQ(FieldBlockchainService, v))
+}
+
+// BlockchainServiceIn applies the In predicate on the "blockchain_service" field.
+func BlockchainServiceIn(vs ...BlockchainService) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldIn(FieldBlockchainService, vs...))
+}
+
+// BlockchainServiceNotIn applies the NotIn predicate on the "blockchain_service" field.
+func BlockchainServiceNotIn(vs ...BlockchainService) predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNotIn(FieldBlockchainService, vs...))
+}
+
+// BlockchainServiceIsNil applies the IsNil predicate on the "blockchain_service" field.
+func BlockchainServiceIsNil() predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldIsNull(FieldBlockchainService))
+}
+
+// BlockchainServiceNotNil applies the NotNil predicate on the "blockchain_service" field.
+func BlockchainServiceNotNil() predicate.PaymentOrder {
+	return predicate.PaymentOrder(sql.FieldNotNull(FieldBlockchainService))
+}
+
 // HasSenderProfile applies the HasEdge predicate on the "sender_profile" edge.
 func HasSenderProfile() predicate.PaymentOrder {
 	return predicate.PaymentOrder(func(s *sql.Selector) {
@@ -1453,6 +2148,144 @@ func HasPaymentWebhookWith(preds ...predicate.PaymentWebhook) predicate.PaymentO
 	})
 }
 
+// HasRefundSweep applies the HasEdge predicate on the "refund_sweep" edge.
+func HasRefundSweep() predicate.PaymentOrder {
+	return predicate.PaymentOrder(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, RefundSweepTable, RefundSweepColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRefundSweepWith applies the HasEdge predicate on the "refund_sweep" edge with a given conditions (other predicates).
+func HasRefundSweepWith(preds ...predicate.Sweep) predicate.PaymentOrder {
+	return predicate.PaymentOrder(func(s *sql.Selector) {
+		step := newRefundSweepStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDepositSplit applies the HasEdge predicate on the "deposit_split" edge.
+func HasDepositSplit() predicate.PaymentOrder {
+	return predicate.PaymentOrder(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, DepositSplitTable, DepositSplitColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDepositSplitWith applies the HasEdge predicate on the "deposit_split" edge with a given conditions (other predicates).
+func HasDepositSplitWith(preds ...predicate.DepositSplit) predicate.PaymentOrder {
+	return predicate.PaymentOrder(func(s *sql.Selector) {
+		step := newDepositSplitStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLedgerEntries applies the HasEdge predicate on the "ledger_entries" edge.
+func HasLedgerEntries() predicate.PaymentOrder {
+	return predicate.PaymentOrder(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LedgerEntriesTable, LedgerEntriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLedgerEntriesWith applies the HasEdge predicate on the "ledger_entries" edge with a given conditions (other predicates).
+func HasLedgerEntriesWith(preds ...predicate.LedgerEntry) predicate.PaymentOrder {
+	return predicate.PaymentOrder(func(s *sql.Selector) {
+		step := newLedgerEntriesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReconciliationDiscrepancies applies the HasEdge predicate on the "reconciliation_discrepancies" edge.
+func HasReconciliationDiscrepancies() predicate.PaymentOrder {
+	return predicate.PaymentOrder(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReconciliationDiscrepanciesTable, ReconciliationDiscrepanciesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReconciliationDiscrepanciesWith applies the HasEdge predicate on the "reconciliation_discrepancies" edge with a given conditions (other predicates).
+func HasReconciliationDiscrepanciesWith(preds ...predicate.ReconciliationDiscrepancy) predicate.PaymentOrder {
+	return predicate.PaymentOrder(func(s *sql.Selector) {
+		step := newReconciliationDiscrepanciesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUnmatchedDeposits applies the HasEdge predicate on the "unmatched_deposits" edge.
+func HasUnmatchedDeposits() predicate.PaymentOrder {
+	return predicate.PaymentOrder(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, UnmatchedDepositsTable, UnmatchedDepositsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUnmatchedDepositsWith applies the HasEdge predicate on the "unmatched_deposits" edge with a given conditions (other predicates).
+func HasUnmatchedDepositsWith(preds ...predicate.UnmatchedDeposit) predicate.PaymentOrder {
+	return predicate.PaymentOrder(func(s *sql.Selector) {
+		step := newUnmatchedDepositsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAddressAssignments applies the HasEdge predicate on the "address_assignments" edge.
+func HasAddressAssignments() predicate.PaymentOrder {
+	return predicate.PaymentOrder(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AddressAssignmentsTable, AddressAssignmentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAddressAssignmentsWith applies the HasEdge predicate on the "address_assignments" edge with a given conditions (other predicates).
+func HasAddressAssignmentsWith(preds ...predicate.ReceiveAddressAssignment) predicate.PaymentOrder {
+	return predicate.PaymentOrder(func(s *sql.Selector) {
+		step := newAddressAssignmentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.PaymentOrder) predicate.PaymentOrder {
 	return predicate.PaymentOrder(sql.AndPredicates(predicates...))
@@ -12,14 +12,20 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/depositsplit"
+	"github.com/NEDA-LABS/stablenode/ent/ledgerentry"
 	"github.com/NEDA-LABS/stablenode/ent/linkedaddress"
 	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
 	"github.com/NEDA-LABS/stablenode/ent/paymentorderrecipient"
 	"github.com/NEDA-LABS/stablenode/ent/paymentwebhook"
 	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddressassignment"
+	"github.com/NEDA-LABS/stablenode/ent/reconciliationdiscrepancy"
 	"github.com/NEDA-LABS/stablenode/ent/senderprofile"
+	"github.com/NEDA-LABS/stablenode/ent/sweep"
 	"github.com/NEDA-LABS/stablenode/ent/token"
 	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
+	"github.com/NEDA-LABS/stablenode/ent/unmatcheddeposit"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 )
@@ -78,6 +84,20 @@ func (poc *PaymentOrderCreate) SetAmountReturned(d decimal.Decimal) *PaymentOrde
 	return poc
 }
 
+// SetAmountOverpaid sets the "amount_overpaid" field.
+func (poc *PaymentOrderCreate) SetAmountOverpaid(d decimal.Decimal) *PaymentOrderCreate {
+	poc.mutation.SetAmountOverpaid(d)
+	return poc
+}
+
+// SetNillableAmountOverpaid sets the "amount_overpaid" field if the given value is not nil.
+func (poc *PaymentOrderCreate) SetNillableAmountOverpaid(d *decimal.Decimal) *PaymentOrderCreate {
+	if d != nil {
+		poc.SetAmountOverpaid(*d)
+	}
+	return poc
+}
+
 // SetPercentSettled sets the "percent_settled" field.
 func (poc *PaymentOrderCreate) SetPercentSettled(d decimal.Decimal) *PaymentOrderCreate {
 	poc.mutation.SetPercentSettled(d)
@@ -252,6 +272,186 @@ func (poc *PaymentOrderCreate) SetAmountInUsd(d decimal.Decimal) *PaymentOrderCr
 	return poc
 }
 
+// SetSettlementPolicy sets the "settlement_policy" field.
+func (poc *PaymentOrderCreate) SetSettlementPolicy(pp paymentorder.SettlementPolicy) *PaymentOrderCreate {
+	poc.mutation.SetSettlementPolicy(pp)
+	return poc
+}
+
+// SetNillableSettlementPolicy sets the "settlement_policy" field if the given value is not nil.
+func (poc *PaymentOrderCreate) SetNillableSettlementPolicy(pp *paymentorder.SettlementPolicy) *PaymentOrderCreate {
+	if pp != nil {
+		poc.SetSettlementPolicy(*pp)
+	}
+	return poc
+}
+
+// SetDepositStatus sets the "deposit_status" field.
+func (poc *PaymentOrderCreate) SetDepositStatus(ps paymentorder.DepositStatus) *PaymentOrderCreate {
+	poc.mutation.SetDepositStatus(ps)
+	return poc
+}
+
+// SetNillableDepositStatus sets the "deposit_status" field if the given value is not nil.
+func (poc *PaymentOrderCreate) SetNillableDepositStatus(ps *paymentorder.DepositStatus) *PaymentOrderCreate {
+	if ps != nil {
+		poc.SetDepositStatus(*ps)
+	}
+	return poc
+}
+
+// SetDepositFinalizedAt sets the "deposit_finalized_at" field.
+func (poc *PaymentOrderCreate) SetDepositFinalizedAt(t time.Time) *PaymentOrderCreate {
+	poc.mutation.SetDepositFinalizedAt(t)
+	return poc
+}
+
+// SetNillableDepositFinalizedAt sets the "deposit_finalized_at" field if the given value is not nil.
+func (poc *PaymentOrderCreate) SetNillableDepositFinalizedAt(t *time.Time) *PaymentOrderCreate {
+	if t != nil {
+		poc.SetDepositFinalizedAt(*t)
+	}
+	return poc
+}
+
+// SetRequiredConfirmations sets the "required_confirmations" field.
+func (poc *PaymentOrderCreate) SetRequiredConfirmations(i int) *PaymentOrderCreate {
+	poc.mutation.SetRequiredConfirmations(i)
+	return poc
+}
+
+// SetNillableRequiredConfirmations sets the "required_confirmations" field if the given value is not nil.
+func (poc *PaymentOrderCreate) SetNillableRequiredConfirmations(i *int) *PaymentOrderCreate {
+	if i != nil {
+		poc.SetRequiredConfirmations(*i)
+	}
+	return poc
+}
+
+// SetSLABreachedAt sets the "sla_breached_at" field.
+func (poc *PaymentOrderCreate) SetSLABreachedAt(t time.Time) *PaymentOrderCreate {
+	poc.mutation.SetSLABreachedAt(t)
+	return poc
+}
+
+// SetNillableSLABreachedAt sets the "sla_breached_at" field if the given value is not nil.
+func (poc *PaymentOrderCreate) SetNillableSLABreachedAt(t *time.Time) *PaymentOrderCreate {
+	if t != nil {
+		poc.SetSLABreachedAt(*t)
+	}
+	return poc
+}
+
+// SetReviewReason sets the "review_reason" field.
+func (poc *PaymentOrderCreate) SetReviewReason(s string) *PaymentOrderCreate {
+	poc.mutation.SetReviewReason(s)
+	return poc
+}
+
+// SetNillableReviewReason sets the "review_reason" field if the given value is not nil.
+func (poc *PaymentOrderCreate) SetNillableReviewReason(s *string) *PaymentOrderCreate {
+	if s != nil {
+		poc.SetReviewReason(*s)
+	}
+	return poc
+}
+
+// SetQuarantineReason sets the "quarantine_reason" field.
+func (poc *PaymentOrderCreate) SetQuarantineReason(s string) *PaymentOrderCreate {
+	poc.mutation.SetQuarantineReason(s)
+	return poc
+}
+
+// SetNillableQuarantineReason sets the "quarantine_reason" field if the given value is not nil.
+func (poc *PaymentOrderCreate) SetNillableQuarantineReason(s *string) *PaymentOrderCreate {
+	if s != nil {
+		poc.SetQuarantineReason(*s)
+	}
+	return poc
+}
+
+// SetFiatAmount sets the "fiat_amount" field.
+func (poc *PaymentOrderCreate) SetFiatAmount(d decimal.Decimal) *PaymentOrderCreate {
+	poc.mutation.SetFiatAmount(d)
+	return poc
+}
+
+// SetNillableFiatAmount sets the "fiat_amount" field if the given value is not nil.
+func (poc *PaymentOrderCreate) SetNillableFiatAmount(d *decimal.Decimal) *PaymentOrderCreate {
+	if d != nil {
+		poc.SetFiatAmount(*d)
+	}
+	return poc
+}
+
+// SetFiatCurrency sets the "fiat_currency" field.
+func (poc *PaymentOrderCreate) SetFiatCurrency(s string) *PaymentOrderCreate {
+	poc.mutation.SetFiatCurrency(s)
+	return poc
+}
+
+// SetNillableFiatCurrency sets the "fiat_currency" field if the given value is not nil.
+func (poc *PaymentOrderCreate) SetNillableFiatCurrency(s *string) *PaymentOrderCreate {
+	if s != nil {
+		poc.SetFiatCurrency(*s)
+	}
+	return poc
+}
+
+// SetRateDriftTolerance sets the "rate_drift_tolerance" field.
+func (poc *PaymentOrderCreate) SetRateDriftTolerance(d decimal.Decimal) *PaymentOrderCreate {
+	poc.mutation.SetRateDriftTolerance(d)
+	return poc
+}
+
+// SetNillableRateDriftTolerance sets the "rate_drift_tolerance" field if the given value is not nil.
+func (poc *PaymentOrderCreate) SetNillableRateDriftTolerance(d *decimal.Decimal) *PaymentOrderCreate {
+	if d != nil {
+		poc.SetRateDriftTolerance(*d)
+	}
+	return poc
+}
+
+// SetFiatConversion sets the "fiat_conversion" field.
+func (poc *PaymentOrderCreate) SetFiatConversion(m map[string]interface{}) *PaymentOrderCreate {
+	poc.mutation.SetFiatConversion(m)
+	return poc
+}
+
+// SetRateLockedUntil sets the "rate_locked_until" field.
+func (poc *PaymentOrderCreate) SetRateLockedUntil(t time.Time) *PaymentOrderCreate {
+	poc.mutation.SetRateLockedUntil(t)
+	return poc
+}
+
+// SetNillableRateLockedUntil sets the "rate_locked_until" field if the given value is not nil.
+func (poc *PaymentOrderCreate) SetNillableRateLockedUntil(t *time.Time) *PaymentOrderCreate {
+	if t != nil {
+		poc.SetRateLockedUntil(*t)
+	}
+	return poc
+}
+
+// SetRateRequote sets the "rate_requote" field.
+func (poc *PaymentOrderCreate) SetRateRequote(m map[string]interface{}) *PaymentOrderCreate {
+	poc.mutation.SetRateRequote(m)
+	return poc
+}
+
+// SetBlockchainService sets the "blockchain_service" field.
+func (poc *PaymentOrderCreate) SetBlockchainService(ps paymentorder.BlockchainService) *PaymentOrderCreate {
+	poc.mutation.SetBlockchainService(ps)
+	return poc
+}
+
+// SetNillableBlockchainService sets the "blockchain_service" field if the given value is not nil.
+func (poc *PaymentOrderCreate) SetNillableBlockchainService(ps *paymentorder.BlockchainService) *PaymentOrderCreate {
+	if ps != nil {
+		poc.SetBlockchainService(*ps)
+	}
+	return poc
+}
+
 // SetID sets the "id" field.
 func (poc *PaymentOrderCreate) SetID(u uuid.UUID) *PaymentOrderCreate {
 	poc.mutation.SetID(u)
@@ -387,6 +587,104 @@ func (poc *PaymentOrderCreate) SetPaymentWebhook(p *PaymentWebhook) *PaymentOrde
 	return poc.SetPaymentWebhookID(p.ID)
 }
 
+// SetRefundSweepID sets the "refund_sweep" edge to the Sweep entity by ID.
+func (poc *PaymentOrderCreate) SetRefundSweepID(id uuid.UUID) *PaymentOrderCreate {
+	poc.mutation.SetRefundSweepID(id)
+	return poc
+}
+
+// SetNillableRefundSweepID sets the "refund_sweep" edge to the Sweep entity by ID if the given value is not nil.
+func (poc *PaymentOrderCreate) SetNillableRefundSweepID(id *uuid.UUID) *PaymentOrderCreate {
+	if id != nil {
+		poc = poc.SetRefundSweepID(*id)
+	}
+	return poc
+}
+
+// SetRefundSweep sets the "refund_sweep" edge to the Sweep entity.
+func (poc *PaymentOrderCreate) SetRefundSweep(s *Sweep) *PaymentOrderCreate {
+	return poc.SetRefundSweepID(s.ID)
+}
+
+// SetDepositSplitID sets the "deposit_split" edge to the DepositSplit entity by ID.
+func (poc *PaymentOrderCreate) SetDepositSplitID(id uuid.UUID) *PaymentOrderCreate {
+	poc.mutation.SetDepositSplitID(id)
+	return poc
+}
+
+// SetNillableDepositSplitID sets the "deposit_split" edge to the DepositSplit entity by ID if the given value is not nil.
+func (poc *PaymentOrderCreate) SetNillableDepositSplitID(id *uuid.UUID) *PaymentOrderCreate {
+	if id != nil {
+		poc = poc.SetDepositSplitID(*id)
+	}
+	return poc
+}
+
+// SetDepositSplit sets the "deposit_split" edge to the DepositSplit entity.
+func (poc *PaymentOrderCreate) SetDepositSplit(d *DepositSplit) *PaymentOrderCreate {
+	return poc.SetDepositSplitID(d.ID)
+}
+
+// AddLedgerEntryIDs adds the "ledger_entries" edge to the LedgerEntry entity by IDs.
+func (poc *PaymentOrderCreate) AddLedgerEntryIDs(ids ...uuid.UUID) *PaymentOrderCreate {
+	poc.mutation.AddLedgerEntryIDs(ids...)
+	return poc
+}
+
+// AddLedgerEntries adds the "ledger_entries" edges to the LedgerEntry entity.
+func (poc *PaymentOrderCreate) AddLedgerEntries(l ...*LedgerEntry) *PaymentOrderCreate {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return poc.AddLedgerEntryIDs(ids...)
+}
+
+// AddReconciliationDiscrepancyIDs adds the "reconciliation_discrepancies" edge to the ReconciliationDiscrepancy entity by IDs.
+func (poc *PaymentOrderCreate) AddReconciliationDiscrepancyIDs(ids ...uuid.UUID) *PaymentOrderCreate {
+	poc.mutation.AddReconciliationDiscrepancyIDs(ids...)
+	return poc
+}
+
+// AddReconciliationDiscrepancies adds the "reconciliation_discrepancies" edges to the ReconciliationDiscrepancy entity.
+func (poc *PaymentOrderCreate) AddReconciliationDiscrepancies(r ...*ReconciliationDiscrepancy) *PaymentOrderCreate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return poc.AddReconciliationDiscrepancyIDs(ids...)
+}
+
+// AddUnmatchedDepositIDs adds the "unmatched_deposits" edge to the UnmatchedDeposit entity by IDs.
+func (poc *PaymentOrderCreate) AddUnmatchedDepositIDs(ids ...uuid.UUID) *PaymentOrderCreate {
+	poc.mutation.AddUnmatchedDepositIDs(ids...)
+	return poc
+}
+
+// AddUnmatchedDeposits adds the "unmatched_deposits" edges to the UnmatchedDeposit entity.
+func (poc *PaymentOrderCreate) AddUnmatchedDeposits(u ...*UnmatchedDeposit) *PaymentOrderCreate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return poc.AddUnmatchedDepositIDs(ids...)
+}
+
+// AddAddressAssignmentIDs adds the "address_assignments" edge to the ReceiveAddressAssignment entity by IDs.
+func (poc *PaymentOrderCreate) AddAddressAssignmentIDs(ids ...uuid.UUID) *PaymentOrderCreate {
+	poc.mutation.AddAddressAssignmentIDs(ids...)
+	return poc
+}
+
+// AddAddressAssignments adds the "address_assignments" edges to the ReceiveAddressAssignment entity.
+func (poc *PaymentOrderCreate) AddAddressAssignments(r ...*ReceiveAddressAssignment) *PaymentOrderCreate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return poc.AddAddressAssignmentIDs(ids...)
+}
+
 // Mutation returns the PaymentOrderMutation object of the builder.
 func (poc *PaymentOrderCreate) Mutation() *PaymentOrderMutation {
 	return poc.mutation
@@ -430,6 +728,10 @@ func (poc *PaymentOrderCreate) defaults() {
 		v := paymentorder.DefaultUpdatedAt()
 		poc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := poc.mutation.AmountOverpaid(); !ok {
+		v := paymentorder.DefaultAmountOverpaid()
+		poc.mutation.SetAmountOverpaid(v)
+	}
 	if _, ok := poc.mutation.BlockNumber(); !ok {
 		v := paymentorder.DefaultBlockNumber
 		poc.mutation.SetBlockNumber(v)
@@ -438,6 +740,18 @@ func (poc *PaymentOrderCreate) defaults() {
 		v := paymentorder.DefaultStatus
 		poc.mutation.SetStatus(v)
 	}
+	if _, ok := poc.mutation.SettlementPolicy(); !ok {
+		v := paymentorder.DefaultSettlementPolicy
+		poc.mutation.SetSettlementPolicy(v)
+	}
+	if _, ok := poc.mutation.RequiredConfirmations(); !ok {
+		v := paymentorder.DefaultRequiredConfirmations
+		poc.mutation.SetRequiredConfirmations(v)
+	}
+	if _, ok := poc.mutation.RateDriftTolerance(); !ok {
+		v := paymentorder.DefaultRateDriftTolerance()
+		poc.mutation.SetRateDriftTolerance(v)
+	}
 	if _, ok := poc.mutation.ID(); !ok {
 		v := paymentorder.DefaultID()
 		poc.mutation.SetID(v)
@@ -461,6 +775,9 @@ func (poc *PaymentOrderCreate) check() error {
 	if _, ok := poc.mutation.AmountReturned(); !ok {
 		return &ValidationError{Name: "amount_returned", err: errors.New(`ent: missing required field "PaymentOrder.amount_returned"`)}
 	}
+	if _, ok := poc.mutation.AmountOverpaid(); !ok {
+		return &ValidationError{Name: "amount_overpaid", err: errors.New(`ent: missing required field "PaymentOrder.amount_overpaid"`)}
+	}
 	if _, ok := poc.mutation.PercentSettled(); !ok {
 		return &ValidationError{Name: "percent_settled", err: errors.New(`ent: missing required field "PaymentOrder.percent_settled"`)}
 	}
@@ -536,6 +853,40 @@ func (poc *PaymentOrderCreate) check() error {
 	if _, ok := poc.mutation.AmountInUsd(); !ok {
 		return &ValidationError{Name: "amount_in_usd", err: errors.New(`ent: missing required field "PaymentOrder.amount_in_usd"`)}
 	}
+	if _, ok := poc.mutation.SettlementPolicy(); !ok {
+		return &ValidationError{Name: "settlement_policy", err: errors.New(`ent: missing required field "PaymentOrder.settlement_policy"`)}
+	}
+	if v, ok := poc.mutation.SettlementPolicy(); ok {
+		if err := paymentorder.SettlementPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "settlement_policy", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.settlement_policy": %w`, err)}
+		}
+	}
+	if v, ok := poc.mutation.DepositStatus(); ok {
+		if err := paymentorder.DepositStatusValidator(v); err != nil {
+			return &ValidationError{Name: "deposit_status", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.deposit_status": %w`, err)}
+		}
+	}
+	if _, ok := poc.mutation.RequiredConfirmations(); !ok {
+		return &ValidationError{Name: "required_confirmations", err: errors.New(`ent: missing required field "PaymentOrder.required_confirmations"`)}
+	}
+	if v, ok := poc.mutation.RequiredConfirmations(); ok {
+		if err := paymentorder.RequiredConfirmationsValidator(v); err != nil {
+			return &ValidationError{Name: "required_confirmations", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.required_confirmations": %w`, err)}
+		}
+	}
+	if v, ok := poc.mutation.FiatCurrency(); ok {
+		if err := paymentorder.FiatCurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "fiat_currency", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.fiat_currency": %w`, err)}
+		}
+	}
+	if _, ok := poc.mutation.RateDriftTolerance(); !ok {
+		return &ValidationError{Name: "rate_drift_tolerance", err: errors.New(`ent: missing required field "PaymentOrder.rate_drift_tolerance"`)}
+	}
+	if v, ok := poc.mutation.BlockchainService(); ok {
+		if err := paymentorder.BlockchainServiceValidator(v); err != nil {
+			return &ValidationError{Name: "blockchain_service", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.blockchain_service": %w`, err)}
+		}
+	}
 	if len(poc.mutation.TokenIDs()) == 0 {
 		return &ValidationError{Name: "token", err: errors.New(`ent: missing required edge "PaymentOrder.token"`)}
 	}
@@ -595,6 +946,10 @@ func (poc *PaymentOrderCreate) createSpec() (*PaymentOrder, *sqlgraph.CreateSpec
 		_spec.SetField(paymentorder.FieldAmountReturned, field.TypeFloat64, value)
 		_node.AmountReturned = value
 	}
+	if value, ok := poc.mutation.AmountOverpaid(); ok {
+		_spec.SetField(paymentorder.FieldAmountOverpaid, field.TypeFloat64, value)
+		_node.AmountOverpaid = value
+	}
 	if value, ok := poc.mutation.PercentSettled(); ok {
 		_spec.SetField(paymentorder.FieldPercentSettled, field.TypeFloat64, value)
 		_node.PercentSettled = value
@@ -663,6 +1018,62 @@ func (poc *PaymentOrderCreate) createSpec() (*PaymentOrder, *sqlgraph.CreateSpec
 		_spec.SetField(paymentorder.FieldAmountInUsd, field.TypeFloat64, value)
 		_node.AmountInUsd = value
 	}
+	if value, ok := poc.mutation.SettlementPolicy(); ok {
+		_spec.SetField(paymentorder.FieldSettlementPolicy, field.TypeEnum, value)
+		_node.SettlementPolicy = value
+	}
+	if value, ok := poc.mutation.DepositStatus(); ok {
+		_spec.SetField(paymentorder.FieldDepositStatus, field.TypeEnum, value)
+		_node.DepositStatus = value
+	}
+	if value, ok := poc.mutation.DepositFinalizedAt(); ok {
+		_spec.SetField(paymentorder.FieldDepositFinalizedAt, field.TypeTime, value)
+		_node.DepositFinalizedAt = value
+	}
+	if value, ok := poc.mutation.RequiredConfirmations(); ok {
+		_spec.SetField(paymentorder.FieldRequiredConfirmations, field.TypeInt, value)
+		_node.RequiredConfirmations = value
+	}
+	if value, ok := poc.mutation.SLABreachedAt(); ok {
+		_spec.SetField(paymentorder.FieldSLABreachedAt, field.TypeTime, value)
+		_node.SLABreachedAt = value
+	}
+	if value, ok := poc.mutation.ReviewReason(); ok {
+		_spec.SetField(paymentorder.FieldReviewReason, field.TypeString, value)
+		_node.ReviewReason = value
+	}
+	if value, ok := poc.mutation.QuarantineReason(); ok {
+		_spec.SetField(paymentorder.FieldQuarantineReason, field.TypeString, value)
+		_node.QuarantineReason = value
+	}
+	if value, ok := poc.mutation.FiatAmount(); ok {
+		_spec.SetField(paymentorder.FieldFiatAmount, field.TypeFloat64, value)
+		_node.FiatAmount = &value
+	}
+	if value, ok := poc.mutation.FiatCurrency(); ok {
+		_spec.SetField(paymentorder.FieldFiatCurrency, field.TypeString, value)
+		_node.FiatCurrency = value
+	}
+	if value, ok := poc.mutation.RateDriftTolerance(); ok {
+		_spec.SetField(paymentorder.FieldRateDriftTolerance, field.TypeFloat64, value)
+		_node.RateDriftTolerance = value
+	}
+	if value, ok := poc.mutation.FiatConversion(); ok {
+		_spec.SetField(paymentorder.FieldFiatConversion, field.TypeJSON, value)
+		_node.FiatConversion = value
+	}
+	if value, ok := poc.mutation.RateLockedUntil(); ok {
+		_spec.SetField(paymentorder.FieldRateLockedUntil, field.TypeTime, value)
+		_node.RateLockedUntil = value
+	}
+	if value, ok := poc.mutation.RateRequote(); ok {
+		_spec.SetField(paymentorder.FieldRateRequote, field.TypeJSON, value)
+		_node.RateRequote = value
+	}
+	if value, ok := poc.mutation.BlockchainService(); ok {
+		_spec.SetField(paymentorder.FieldBlockchainService, field.TypeEnum, value)
+		_node.BlockchainService = &value
+	}
 	if nodes := poc.mutation.SenderProfileIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -778,6 +1189,104 @@ func (poc *PaymentOrderCreate) createSpec() (*PaymentOrder, *sqlgraph.CreateSpec
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := poc.mutation.RefundSweepIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   paymentorder.RefundSweepTable,
+			Columns: []string{paymentorder.RefundSweepColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sweep.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.payment_order_refund_sweep = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := poc.mutation.DepositSplitIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentorder.DepositSplitTable,
+			Columns: []string{paymentorder.DepositSplitColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(depositsplit.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.deposit_split_payment_orders = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := poc.mutation.LedgerEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.LedgerEntriesTable,
+			Columns: []string{paymentorder.LedgerEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := poc.mutation.ReconciliationDiscrepanciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.ReconciliationDiscrepanciesTable,
+			Columns: []string{paymentorder.ReconciliationDiscrepanciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reconciliationdiscrepancy.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := poc.mutation.UnmatchedDepositsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.UnmatchedDepositsTable,
+			Columns: []string{paymentorder.UnmatchedDepositsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(unmatcheddeposit.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := poc.mutation.AddressAssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.AddressAssignmentsTable,
+			Columns: []string{paymentorder.AddressAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receiveaddressassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -896,9 +1405,27 @@ func (u *PaymentOrderUpsert) AddAmountReturned(v decimal.Decimal) *PaymentOrderU
 	return u
 }
 
-// SetPercentSettled sets the "percent_settled" field.
-func (u *PaymentOrderUpsert) SetPercentSettled(v decimal.Decimal) *PaymentOrderUpsert {
-	u.Set(paymentorder.FieldPercentSettled, v)
+// SetAmountOverpaid sets the "amount_overpaid" field.
+func (u *PaymentOrderUpsert) SetAmountOverpaid(v decimal.Decimal) *PaymentOrderUpsert {
+	u.Set(paymentorder.FieldAmountOverpaid, v)
+	return u
+}
+
+// UpdateAmountOverpaid sets the "amount_overpaid" field to the value that was provided on create.
+func (u *PaymentOrderUpsert) UpdateAmountOverpaid() *PaymentOrderUpsert {
+	u.SetExcluded(paymentorder.FieldAmountOverpaid)
+	return u
+}
+
+// AddAmountOverpaid adds v to the "amount_overpaid" field.
+func (u *PaymentOrderUpsert) AddAmountOverpaid(v decimal.Decimal) *PaymentOrderUpsert {
+	u.Add(paymentorder.FieldAmountOverpaid, v)
+	return u
+}
+
+// SetPercentSettled sets the "percent_settled" field.
+func (u *PaymentOrderUpsert) SetPercentSettled(v decimal.Decimal) *PaymentOrderUpsert {
+	u.Set(paymentorder.FieldPercentSettled, v)
 	return u
 }
 
@@ -1190,6 +1717,258 @@ func (u *PaymentOrderUpsert) AddAmountInUsd(v decimal.Decimal) *PaymentOrderUpse
 	return u
 }
 
+// SetSettlementPolicy sets the "settlement_policy" field.
+func (u *PaymentOrderUpsert) SetSettlementPolicy(v paymentorder.SettlementPolicy) *PaymentOrderUpsert {
+	u.Set(paymentorder.FieldSettlementPolicy, v)
+	return u
+}
+
+// UpdateSettlementPolicy sets the "settlement_policy" field to the value that was provided on create.
+func (u *PaymentOrderUpsert) UpdateSettlementPolicy() *PaymentOrderUpsert {
+	u.SetExcluded(paymentorder.FieldSettlementPolicy)
+	return u
+}
+
+// SetDepositStatus sets the "deposit_status" field.
+func (u *PaymentOrderUpsert) SetDepositStatus(v paymentorder.DepositStatus) *PaymentOrderUpsert {
+	u.Set(paymentorder.FieldDepositStatus, v)
+	return u
+}
+
+// UpdateDepositStatus sets the "deposit_status" field to the value that was provided on create.
+func (u *PaymentOrderUpsert) UpdateDepositStatus() *PaymentOrderUpsert {
+	u.SetExcluded(paymentorder.FieldDepositStatus)
+	return u
+}
+
+// ClearDepositStatus clears the value of the "deposit_status" field.
+func (u *PaymentOrderUpsert) ClearDepositStatus() *PaymentOrderUpsert {
+	u.SetNull(paymentorder.FieldDepositStatus)
+	return u
+}
+
+// SetDepositFinalizedAt sets the "deposit_finalized_at" field.
+func (u *PaymentOrderUpsert) SetDepositFinalizedAt(v time.Time) *PaymentOrderUpsert {
+	u.Set(paymentorder.FieldDepositFinalizedAt, v)
+	return u
+}
+
+// UpdateDepositFinalizedAt sets the "deposit_finalized_at" field to the value that was provided on create.
+func (u *PaymentOrderUpsert) UpdateDepositFinalizedAt() *PaymentOrderUpsert {
+	u.SetExcluded(paymentorder.FieldDepositFinalizedAt)
+	return u
+}
+
+// ClearDepositFinalizedAt clears the value of the "deposit_finalized_at" field.
+func (u *PaymentOrderUpsert) ClearDepositFinalizedAt() *PaymentOrderUpsert {
+	u.SetNull(paymentorder.FieldDepositFinalizedAt)
+	return u
+}
+
+// SetRequiredConfirmations sets the "required_confirmations" field.
+func (u *PaymentOrderUpsert) SetRequiredConfirmations(v int) *PaymentOrderUpsert {
+	u.Set(paymentorder.FieldRequiredConfirmations, v)
+	return u
+}
+
+// UpdateRequiredConfirmations sets the "required_confirmations" field to the value that was provided on create.
+func (u *PaymentOrderUpsert) UpdateRequiredConfirmations() *PaymentOrderUpsert {
+	u.SetExcluded(paymentorder.FieldRequiredConfirmations)
+	return u
+}
+
+// AddRequiredConfirmations adds v to the "required_confirmations" field.
+func (u *PaymentOrderUpsert) AddRequiredConfirmations(v int) *PaymentOrderUpsert {
+	u.Add(paymentorder.FieldRequiredConfirmations, v)
+	return u
+}
+
+// SetSLABreachedAt sets the "sla_breached_at" field.
+func (u *PaymentOrderUpsert) SetSLABreachedAt(v time.Time) *PaymentOrderUpsert {
+	u.Set(paymentorder.FieldSLABreachedAt, v)
+	return u
+}
+
+// UpdateSLABreachedAt sets the "sla_breached_at" field to the value that was provided on create.
+func (u *PaymentOrderUpsert) UpdateSLABreachedAt() *PaymentOrderUpsert {
+	u.SetExcluded(paymentorder.FieldSLABreachedAt)
+	return u
+}
+
+// ClearSLABreachedAt clears the value of the "sla_breached_at" field.
+func (u *PaymentOrderUpsert) ClearSLABreachedAt() *PaymentOrderUpsert {
+	u.SetNull(paymentorder.FieldSLABreachedAt)
+	return u
+}
+
+// SetReviewReason sets the "review_reason" field.
+func (u *PaymentOrderUpsert) SetReviewReason(v string) *PaymentOrderUpsert {
+	u.Set(paymentorder.FieldReviewReason, v)
+	return u
+}
+
+// UpdateReviewReason sets the "review_reason" field to the value that was provided on create.
+func (u *PaymentOrderUpsert) UpdateReviewReason() *PaymentOrderUpsert {
+	u.SetExcluded(paymentorder.FieldReviewReason)
+	return u
+}
+
+// ClearReviewReason clears the value of the "review_reason" field.
+func (u *PaymentOrderUpsert) ClearReviewReason() *PaymentOrderUpsert {
+	u.SetNull(paymentorder.FieldReviewReason)
+	return u
+}
+
+// SetQuarantineReason sets the "quarantine_reason" field.
+func (u *PaymentOrderUpsert) SetQuarantineReason(v string) *PaymentOrderUpsert {
+	u.Set(paymentorder.FieldQuarantineReason, v)
+	return u
+}
+
+// UpdateQuarantineReason sets the "quarantine_reason" field to the value that was provided on create.
+func (u *PaymentOrderUpsert) UpdateQuarantineReason() *PaymentOrderUpsert {
+	u.SetExcluded(paymentorder.FieldQuarantineReason)
+	return u
+}
+
+// ClearQuarantineReason clears the value of the "quarantine_reason" field.
+func (u *PaymentOrderUpsert) ClearQuarantineReason() *PaymentOrderUpsert {
+	u.SetNull(paymentorder.FieldQuarantineReason)
+	return u
+}
+
+// SetFiatAmount sets the "fiat_amount" field.
+func (u *PaymentOrderUpsert) SetFiatAmount(v decimal.Decimal) *PaymentOrderUpsert {
+	u.Set(paymentorder.FieldFiatAmount, v)
+	return u
+}
+
+// UpdateFiatAmount sets the "fiat_amount" field to the value that was provided on create.
+func (u *PaymentOrderUpsert) UpdateFiatAmount() *PaymentOrderUpsert {
+	u.SetExcluded(paymentorder.FieldFiatAmount)
+	return u
+}
+
+// AddFiatAmount adds v to the "fiat_amount" field.
+func (u *PaymentOrderUpsert) AddFiatAmount(v decimal.Decimal) *PaymentOrderUpsert {
+	u.Add(paymentorder.FieldFiatAmount, v)
+	return u
+}
+
+// ClearFiatAmount clears the value of the "fiat_amount" field.
+func (u *PaymentOrderUpsert) ClearFiatAmount() *PaymentOrderUpsert {
+	u.SetNull(paymentorder.FieldFiatAmount)
+	return u
+}
+
+// SetFiatCurrency sets the "fiat_currency" field.
+func (u *PaymentOrderUpsert) SetFiatCurrency(v string) *PaymentOrderUpsert {
+	u.Set(paymentorder.FieldFiatCurrency, v)
+	return u
+}
+
+// UpdateFiatCurrency sets the "fiat_currency" field to the value that was provided on create.
+func (u *PaymentOrderUpsert) UpdateFiatCurrency() *PaymentOrderUpsert {
+	u.SetExcluded(paymentorder.FieldFiatCurrency)
+	return u
+}
+
+// ClearFiatCurrency clears the value of the "fiat_currency" field.
+func (u *PaymentOrderUpsert) ClearFiatCurrency() *PaymentOrderUpsert {
+	u.SetNull(paymentorder.FieldFiatCurrency)
+	return u
+}
+
+// SetRateDriftTolerance sets the "rate_drift_tolerance" field.
+func (u *PaymentOrderUpsert) SetRateDriftTolerance(v decimal.Decimal) *PaymentOrderUpsert {
+	u.Set(paymentorder.FieldRateDriftTolerance, v)
+	return u
+}
+
+// UpdateRateDriftTolerance sets the "rate_drift_tolerance" field to the value that was provided on create.
+func (u *PaymentOrderUpsert) UpdateRateDriftTolerance() *PaymentOrderUpsert {
+	u.SetExcluded(paymentorder.FieldRateDriftTolerance)
+	return u
+}
+
+// AddRateDriftTolerance adds v to the "rate_drift_tolerance" field.
+func (u *PaymentOrderUpsert) AddRateDriftTolerance(v decimal.Decimal) *PaymentOrderUpsert {
+	u.Add(paymentorder.FieldRateDriftTolerance, v)
+	return u
+}
+
+// SetFiatConversion sets the "fiat_conversion" field.
+func (u *PaymentOrderUpsert) SetFiatConversion(v map[string]interface{}) *PaymentOrderUpsert {
+	u.Set(paymentorder.FieldFiatConversion, v)
+	return u
+}
+
+// UpdateFiatConversion sets the "fiat_conversion" field to the value that was provided on create.
+func (u *PaymentOrderUpsert) UpdateFiatConversion() *PaymentOrderUpsert {
+	u.SetExcluded(paymentorder.FieldFiatConversion)
+	return u
+}
+
+// ClearFiatConversion clears the value of the "fiat_conversion" field.
+func (u *PaymentOrderUpsert) ClearFiatConversion() *PaymentOrderUpsert {
+	u.SetNull(paymentorder.FieldFiatConversion)
+	return u
+}
+
+// SetRateLockedUntil sets the "rate_locked_until" field.
+func (u *PaymentOrderUpsert) SetRateLockedUntil(v time.Time) *PaymentOrderUpsert {
+	u.Set(paymentorder.FieldRateLockedUntil, v)
+	return u
+}
+
+// UpdateRateLockedUntil sets the "rate_locked_until" field to the value that was provided on create.
+func (u *PaymentOrderUpsert) UpdateRateLockedUntil() *PaymentOrderUpsert {
+	u.SetExcluded(paymentorder.FieldRateLockedUntil)
+	return u
+}
+
+// ClearRateLockedUntil clears the value of the "rate_locked_until" field.
+func (u *PaymentOrderUpsert) ClearRateLockedUntil() *PaymentOrderUpsert {
+	u.SetNull(paymentorder.FieldRateLockedUntil)
+	return u
+}
+
+// SetRateRequote sets the "rate_requote" field.
+func (u *PaymentOrderUpsert) SetRateRequote(v map[string]interface{}) *PaymentOrderUpsert {
+	u.Set(paymentorder.FieldRateRequote, v)
+	return u
+}
+
+// UpdateRateRequote sets the "rate_requote" field to the value that was provided on create.
+func (u *PaymentOrderUpsert) UpdateRateRequote() *PaymentOrderUpsert {
+	u.SetExcluded(paymentorder.FieldRateRequote)
+	return u
+}
+
+// ClearRateRequote clears the value of the "rate_requote" field.
+func (u *PaymentOrderUpsert) ClearRateRequote() *PaymentOrderUpsert {
+	u.SetNull(paymentorder.FieldRateRequote)
+	return u
+}
+
+// SetBlockchainService sets the "blockchain_service" field.
+func (u *PaymentOrderUpsert) SetBlockchainService(v paymentorder.BlockchainService) *PaymentOrderUpsert {
+	u.Set(paymentorder.FieldBlockchainService, v)
+	return u
+}
+
+// UpdateBlockchainService sets the "blockchain_service" field to the value that was provided on create.
+func (u *PaymentOrderUpsert) UpdateBlockchainService() *PaymentOrderUpsert {
+	u.SetExcluded(paymentorder.FieldBlockchainService)
+	return u
+}
+
+// ClearBlockchainService clears the value of the "blockchain_service" field.
+func (u *PaymentOrderUpsert) ClearBlockchainService() *PaymentOrderUpsert {
+	u.SetNull(paymentorder.FieldBlockchainService)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -1318,6 +2097,27 @@ func (u *PaymentOrderUpsertOne) UpdateAmountReturned() *PaymentOrderUpsertOne {
 	})
 }
 
+// SetAmountOverpaid sets the "amount_overpaid" field.
+func (u *PaymentOrderUpsertOne) SetAmountOverpaid(v decimal.Decimal) *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetAmountOverpaid(v)
+	})
+}
+
+// AddAmountOverpaid adds v to the "amount_overpaid" field.
+func (u *PaymentOrderUpsertOne) AddAmountOverpaid(v decimal.Decimal) *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.AddAmountOverpaid(v)
+	})
+}
+
+// UpdateAmountOverpaid sets the "amount_overpaid" field to the value that was provided on create.
+func (u *PaymentOrderUpsertOne) UpdateAmountOverpaid() *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateAmountOverpaid()
+	})
+}
+
 // SetPercentSettled sets the "percent_settled" field.
 func (u *PaymentOrderUpsertOne) SetPercentSettled(v decimal.Decimal) *PaymentOrderUpsertOne {
 	return u.Update(func(s *PaymentOrderUpsert) {
@@ -1661,6 +2461,300 @@ func (u *PaymentOrderUpsertOne) UpdateAmountInUsd() *PaymentOrderUpsertOne {
 	})
 }
 
+// SetSettlementPolicy sets the "settlement_policy" field.
+func (u *PaymentOrderUpsertOne) SetSettlementPolicy(v paymentorder.SettlementPolicy) *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetSettlementPolicy(v)
+	})
+}
+
+// UpdateSettlementPolicy sets the "settlement_policy" field to the value that was provided on create.
+func (u *PaymentOrderUpsertOne) UpdateSettlementPolicy() *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateSettlementPolicy()
+	})
+}
+
+// SetDepositStatus sets the "deposit_status" field.
+func (u *PaymentOrderUpsertOne) SetDepositStatus(v paymentorder.DepositStatus) *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetDepositStatus(v)
+	})
+}
+
+// UpdateDepositStatus sets the "deposit_status" field to the value that was provided on create.
+func (u *PaymentOrderUpsertOne) UpdateDepositStatus() *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateDepositStatus()
+	})
+}
+
+// ClearDepositStatus clears the value of the "deposit_status" field.
+func (u *PaymentOrderUpsertOne) ClearDepositStatus() *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.ClearDepositStatus()
+	})
+}
+
+// SetDepositFinalizedAt sets the "deposit_finalized_at" field.
+func (u *PaymentOrderUpsertOne) SetDepositFinalizedAt(v time.Time) *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetDepositFinalizedAt(v)
+	})
+}
+
+// UpdateDepositFinalizedAt sets the "deposit_finalized_at" field to the value that was provided on create.
+func (u *PaymentOrderUpsertOne) UpdateDepositFinalizedAt() *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateDepositFinalizedAt()
+	})
+}
+
+// ClearDepositFinalizedAt clears the value of the "deposit_finalized_at" field.
+func (u *PaymentOrderUpsertOne) ClearDepositFinalizedAt() *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.ClearDepositFinalizedAt()
+	})
+}
+
+// SetRequiredConfirmations sets the "required_confirmations" field.
+func (u *PaymentOrderUpsertOne) SetRequiredConfirmations(v int) *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetRequiredConfirmations(v)
+	})
+}
+
+// AddRequiredConfirmations adds v to the "required_confirmations" field.
+func (u *PaymentOrderUpsertOne) AddRequiredConfirmations(v int) *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.AddRequiredConfirmations(v)
+	})
+}
+
+// UpdateRequiredConfirmations sets the "required_confirmations" field to the value that was provided on create.
+func (u *PaymentOrderUpsertOne) UpdateRequiredConfirmations() *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateRequiredConfirmations()
+	})
+}
+
+// SetSLABreachedAt sets the "sla_breached_at" field.
+func (u *PaymentOrderUpsertOne) SetSLABreachedAt(v time.Time) *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetSLABreachedAt(v)
+	})
+}
+
+// UpdateSLABreachedAt sets the "sla_breached_at" field to the value that was provided on create.
+func (u *PaymentOrderUpsertOne) UpdateSLABreachedAt() *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateSLABreachedAt()
+	})
+}
+
+// ClearSLABreachedAt clears the value of the "sla_breached_at" field.
+func (u *PaymentOrderUpsertOne) ClearSLABreachedAt() *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.ClearSLABreachedAt()
+	})
+}
+
+// SetReviewReason sets the "review_reason" field.
+func (u *PaymentOrderUpsertOne) SetReviewReason(v string) *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetReviewReason(v)
+	})
+}
+
+// UpdateReviewReason sets the "review_reason" field to the value that was provided on create.
+func (u *PaymentOrderUpsertOne) UpdateReviewReason() *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateReviewReason()
+	})
+}
+
+// ClearReviewReason clears the value of the "review_reason" field.
+func (u *PaymentOrderUpsertOne) ClearReviewReason() *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.ClearReviewReason()
+	})
+}
+
+// SetQuarantineReason sets the "quarantine_reason" field.
+func (u *PaymentOrderUpsertOne) SetQuarantineReason(v string) *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetQuarantineReason(v)
+	})
+}
+
+// UpdateQuarantineReason sets the "quarantine_reason" field to the value that was provided on create.
+func (u *PaymentOrderUpsertOne) UpdateQuarantineReason() *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateQuarantineReason()
+	})
+}
+
+// ClearQuarantineReason clears the value of the "quarantine_reason" field.
+func (u *PaymentOrderUpsertOne) ClearQuarantineReason() *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.ClearQuarantineReason()
+	})
+}
+
+// SetFiatAmount sets the "fiat_amount" field.
+func (u *PaymentOrderUpsertOne) SetFiatAmount(v decimal.Decimal) *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetFiatAmount(v)
+	})
+}
+
+// AddFiatAmount adds v to the "fiat_amount" field.
+func (u *PaymentOrderUpsertOne) AddFiatAmount(v decimal.Decimal) *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.AddFiatAmount(v)
+	})
+}
+
+// UpdateFiatAmount sets the "fiat_amount" field to the value that was provided on create.
+func (u *PaymentOrderUpsertOne) UpdateFiatAmount() *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateFiatAmount()
+	})
+}
+
+// ClearFiatAmount clears the value of the "fiat_amount" field.
+func (u *PaymentOrderUpsertOne) ClearFiatAmount() *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.ClearFiatAmount()
+	})
+}
+
+// SetFiatCurrency sets the "fiat_currency" field.
+func (u *PaymentOrderUpsertOne) SetFiatCurrency(v string) *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetFiatCurrency(v)
+	})
+}
+
+// UpdateFiatCurrency sets the "fiat_currency" field to the value that was provided on create.
+func (u *PaymentOrderUpsertOne) UpdateFiatCurrency() *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateFiatCurrency()
+	})
+}
+
+// ClearFiatCurrency clears the value of the "fiat_currency" field.
+func (u *PaymentOrderUpsertOne) ClearFiatCurrency() *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.ClearFiatCurrency()
+	})
+}
+
+// SetRateDriftTolerance sets the "rate_drift_tolerance" field.
+func (u *PaymentOrderUpsertOne) SetRateDriftTolerance(v decimal.Decimal) *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetRateDriftTolerance(v)
+	})
+}
+
+// AddRateDriftTolerance adds v to the "rate_drift_tolerance" field.
+func (u *PaymentOrderUpsertOne) AddRateDriftTolerance(v decimal.Decimal) *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.AddRateDriftTolerance(v)
+	})
+}
+
+// UpdateRateDriftTolerance sets the "rate_drift_tolerance" field to the value that was provided on create.
+func (u *PaymentOrderUpsertOne) UpdateRateDriftTolerance() *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateRateDriftTolerance()
+	})
+}
+
+// SetFiatConversion sets the "fiat_conversion" field.
+func (u *PaymentOrderUpsertOne) SetFiatConversion(v map[string]interface{}) *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetFiatConversion(v)
+	})
+}
+
+// UpdateFiatConversion sets the "fiat_conversion" field to the value that was provided on create.
+func (u *PaymentOrderUpsertOne) UpdateFiatConversion() *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateFiatConversion()
+	})
+}
+
+// ClearFiatConversion clears the value of the "fiat_conversion" field.
+func (u *PaymentOrderUpsertOne) ClearFiatConversion() *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.ClearFiatConversion()
+	})
+}
+
+// SetRateLockedUntil sets the "rate_locked_until" field.
+func (u *PaymentOrderUpsertOne) SetRateLockedUntil(v time.Time) *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetRateLockedUntil(v)
+	})
+}
+
+// UpdateRateLockedUntil sets the "rate_locked_until" field to the value that was provided on create.
+func (u *PaymentOrderUpsertOne) UpdateRateLockedUntil() *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateRateLockedUntil()
+	})
+}
+
+// ClearRateLockedUntil clears the value of the "rate_locked_until" field.
+func (u *PaymentOrderUpsertOne) ClearRateLockedUntil() *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.ClearRateLockedUntil()
+	})
+}
+
+// SetRateRequote sets the "rate_requote" field.
+func (u *PaymentOrderUpsertOne) SetRateRequote(v map[string]interface{}) *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetRateRequote(v)
+	})
+}
+
+// UpdateRateRequote sets the "rate_requote" field to the value that was provided on create.
+func (u *PaymentOrderUpsertOne) UpdateRateRequote() *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateRateRequote()
+	})
+}
+
+// ClearRateRequote clears the value of the "rate_requote" field.
+func (u *PaymentOrderUpsertOne) ClearRateRequote() *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.ClearRateRequote()
+	})
+}
+
+// SetBlockchainService sets the "blockchain_service" field.
+func (u *PaymentOrderUpsertOne) SetBlockchainService(v paymentorder.BlockchainService) *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetBlockchainService(v)
+	})
+}
+
+// UpdateBlockchainService sets the "blockchain_service" field to the value that was provided on create.
+func (u *PaymentOrderUpsertOne) UpdateBlockchainService() *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateBlockchainService()
+	})
+}
+
+// ClearBlockchainService clears the value of the "blockchain_service" field.
+func (u *PaymentOrderUpsertOne) ClearBlockchainService() *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.ClearBlockchainService()
+	})
+}
+
 // Exec executes the query.
 func (u *PaymentOrderUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1956,6 +3050,27 @@ func (u *PaymentOrderUpsertBulk) UpdateAmountReturned() *PaymentOrderUpsertBulk
 	})
 }
 
+// SetAmountOverpaid sets the "amount_overpaid" field.
+func (u *PaymentOrderUpsertBulk) SetAmountOverpaid(v decimal.Decimal) *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetAmountOverpaid(v)
+	})
+}
+
+// AddAmountOverpaid adds v to the "amount_overpaid" field.
+func (u *PaymentOrderUpsertBulk) AddAmountOverpaid(v decimal.Decimal) *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.AddAmountOverpaid(v)
+	})
+}
+
+// UpdateAmountOverpaid sets the "amount_overpaid" field to the value that was provided on create.
+func (u *PaymentOrderUpsertBulk) UpdateAmountOverpaid() *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateAmountOverpaid()
+	})
+}
+
 // SetPercentSettled sets the "percent_settled" field.
 func (u *PaymentOrderUpsertBulk) SetPercentSettled(v decimal.Decimal) *PaymentOrderUpsertBulk {
 	return u.Update(func(s *PaymentOrderUpsert) {
@@ -2299,6 +3414,300 @@ func (u *PaymentOrderUpsertBulk) UpdateAmountInUsd() *PaymentOrderUpsertBulk {
 	})
 }
 
+// SetSettlementPolicy sets the "settlement_policy" field.
+func (u *PaymentOrderUpsertBulk) SetSettlementPolicy(v paymentorder.SettlementPolicy) *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetSettlementPolicy(v)
+	})
+}
+
+// UpdateSettlementPolicy sets the "settlement_policy" field to the value that was provided on create.
+func (u *PaymentOrderUpsertBulk) UpdateSettlementPolicy() *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateSettlementPolicy()
+	})
+}
+
+// SetDepositStatus sets the "deposit_status" field.
+func (u *PaymentOrderUpsertBulk) SetDepositStatus(v paymentorder.DepositStatus) *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetDepositStatus(v)
+	})
+}
+
+// UpdateDepositStatus sets the "deposit_status" field to the value that was provided on create.
+func (u *PaymentOrderUpsertBulk) UpdateDepositStatus() *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateDepositStatus()
+	})
+}
+
+// ClearDepositStatus clears the value of the "deposit_status" field.
+func (u *PaymentOrderUpsertBulk) ClearDepositStatus() *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.ClearDepositStatus()
+	})
+}
+
+// SetDepositFinalizedAt sets the "deposit_finalized_at" field.
+func (u *PaymentOrderUpsertBulk) SetDepositFinalizedAt(v time.Time) *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetDepositFinalizedAt(v)
+	})
+}
+
+// UpdateDepositFinalizedAt sets the "deposit_finalized_at" field to the value that was provided on create.
+func (u *PaymentOrderUpsertBulk) UpdateDepositFinalizedAt() *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateDepositFinalizedAt()
+	})
+}
+
+// ClearDepositFinalizedAt clears the value of the "deposit_finalized_at" field.
+func (u *PaymentOrderUpsertBulk) ClearDepositFinalizedAt() *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.ClearDepositFinalizedAt()
+	})
+}
+
+// SetRequiredConfirmations sets the "required_confirmations" field.
+func (u *PaymentOrderUpsertBulk) SetRequiredConfirmations(v int) *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetRequiredConfirmations(v)
+	})
+}
+
+// AddRequiredConfirmations adds v to the "required_confirmations" field.
+func (u *PaymentOrderUpsertBulk) AddRequiredConfirmations(v int) *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.AddRequiredConfirmations(v)
+	})
+}
+
+// UpdateRequiredConfirmations sets the "required_confirmations" field to the value that was provided on create.
+func (u *PaymentOrderUpsertBulk) UpdateRequiredConfirmations() *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateRequiredConfirmations()
+	})
+}
+
+// SetSLABreachedAt sets the "sla_breached_at" field.
+func (u *PaymentOrderUpsertBulk) SetSLABreachedAt(v time.Time) *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetSLABreachedAt(v)
+	})
+}
+
+// UpdateSLABreachedAt sets the "sla_breached_at" field to the value that was provided on create.
+func (u *PaymentOrderUpsertBulk) UpdateSLABreachedAt() *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateSLABreachedAt()
+	})
+}
+
+// ClearSLABreachedAt clears the value of the "sla_breached_at" field.
+func (u *PaymentOrderUpsertBulk) ClearSLABreachedAt() *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.ClearSLABreachedAt()
+	})
+}
+
+// SetReviewReason sets the "review_reason" field.
+func (u *PaymentOrderUpsertBulk) SetReviewReason(v string) *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetReviewReason(v)
+	})
+}
+
+// UpdateReviewReason sets the "review_reason" field to the value that was provided on create.
+func (u *PaymentOrderUpsertBulk) UpdateReviewReason() *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateReviewReason()
+	})
+}
+
+// ClearReviewReason clears the value of the "review_reason" field.
+func (u *PaymentOrderUpsertBulk) ClearReviewReason() *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.ClearReviewReason()
+	})
+}
+
+// SetQuarantineReason sets the "quarantine_reason" field.
+func (u *PaymentOrderUpsertBulk) SetQuarantineReason(v string) *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetQuarantineReason(v)
+	})
+}
+
+// UpdateQuarantineReason sets the "quarantine_reason" field to the value that was provided on create.
+func (u *PaymentOrderUpsertBulk) UpdateQuarantineReason() *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateQuarantineReason()
+	})
+}
+
+// ClearQuarantineReason clears the value of the "quarantine_reason" field.
+func (u *PaymentOrderUpsertBulk) ClearQuarantineReason() *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.ClearQuarantineReason()
+	})
+}
+
+// SetFiatAmount sets the "fiat_amount" field.
+func (u *PaymentOrderUpsertBulk) SetFiatAmount(v decimal.Decimal) *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetFiatAmount(v)
+	})
+}
+
+// AddFiatAmount adds v to the "fiat_amount" field.
+func (u *PaymentOrderUpsertBulk) AddFiatAmount(v decimal.Decimal) *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.AddFiatAmount(v)
+	})
+}
+
+// UpdateFiatAmount sets the "fiat_amount" field to the value that was provided on create.
+func (u *PaymentOrderUpsertBulk) UpdateFiatAmount() *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateFiatAmount()
+	})
+}
+
+// ClearFiatAmount clears the value of the "fiat_amount" field.
+func (u *PaymentOrderUpsertBulk) ClearFiatAmount() *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.ClearFiatAmount()
+	})
+}
+
+// SetFiatCurrency sets the "fiat_currency" field.
+func (u *PaymentOrderUpsertBulk) SetFiatCurrency(v string) *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetFiatCurrency(v)
+	})
+}
+
+// UpdateFiatCurrency sets the "fiat_currency" field to the value that was provided on create.
+func (u *PaymentOrderUpsertBulk) UpdateFiatCurrency() *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateFiatCurrency()
+	})
+}
+
+// ClearFiatCurrency clears the value of the "fiat_currency" field.
+func (u *PaymentOrderUpsertBulk) ClearFiatCurrency() *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.ClearFiatCurrency()
+	})
+}
+
+// SetRateDriftTolerance sets the "rate_drift_tolerance" field.
+func (u *PaymentOrderUpsertBulk) SetRateDriftTolerance(v decimal.Decimal) *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetRateDriftTolerance(v)
+	})
+}
+
+// AddRateDriftTolerance adds v to the "rate_drift_tolerance" field.
+func (u *PaymentOrderUpsertBulk) AddRateDriftTolerance(v decimal.Decimal) *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.AddRateDriftTolerance(v)
+	})
+}
+
+// UpdateRateDriftTolerance sets the "rate_drift_tolerance" field to the value that was provided on create.
+func (u *PaymentOrderUpsertBulk) UpdateRateDriftTolerance() *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateRateDriftTolerance()
+	})
+}
+
+// SetFiatConversion sets the "fiat_conversion" field.
+func (u *PaymentOrderUpsertBulk) SetFiatConversion(v map[string]interface{}) *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetFiatConversion(v)
+	})
+}
+
+// UpdateFiatConversion sets the "fiat_conversion" field to the value that was provided on create.
+func (u *PaymentOrderUpsertBulk) UpdateFiatConversion() *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateFiatConversion()
+	})
+}
+
+// ClearFiatConversion clears the value of the "fiat_conversion" field.
+func (u *PaymentOrderUpsertBulk) ClearFiatConversion() *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.ClearFiatConversion()
+	})
+}
+
+// SetRateLockedUntil sets the "rate_locked_until" field.
+func (u *PaymentOrderUpsertBulk) SetRateLockedUntil(v time.Time) *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetRateLockedUntil(v)
+	})
+}
+
+// UpdateRateLockedUntil sets the "rate_locked_until" field to the value that was provided on create.
+func (u *PaymentOrderUpsertBulk) UpdateRateLockedUntil() *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateRateLockedUntil()
+	})
+}
+
+// ClearRateLockedUntil clears the value of the "rate_locked_until" field.
+func (u *PaymentOrderUpsertBulk) ClearRateLockedUntil() *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.ClearRateLockedUntil()
+	})
+}
+
+// SetRateRequote sets the "rate_requote" field.
+func (u *PaymentOrderUpsertBulk) SetRateRequote(v map[string]interface{}) *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetRateRequote(v)
+	})
+}
+
+// UpdateRateRequote sets the "rate_requote" field to the value that was provided on create.
+func (u *PaymentOrderUpsertBulk) UpdateRateRequote() *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateRateRequote()
+	})
+}
+
+// ClearRateRequote clears the value of the "rate_requote" field.
+func (u *PaymentOrderUpsertBulk) ClearRateRequote() *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.ClearRateRequote()
+	})
+}
+
+// SetBlockchainService sets the "blockchain_service" field.
+func (u *PaymentOrderUpsertBulk) SetBlockchainService(v paymentorder.BlockchainService) *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetBlockchainService(v)
+	})
+}
+
+// UpdateBlockchainService sets the "blockchain_service" field to the value that was provided on create.
+func (u *PaymentOrderUpsertBulk) UpdateBlockchainService() *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateBlockchainService()
+	})
+}
+
+// ClearBlockchainService clears the value of the "blockchain_service" field.
+func (u *PaymentOrderUpsertBulk) ClearBlockchainService() *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.ClearBlockchainService()
+	})
+}
+
 // Exec executes the query.
 func (u *PaymentOrderUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -12,33 +12,45 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/depositsplit"
+	"github.com/NEDA-LABS/stablenode/ent/ledgerentry"
 	"github.com/NEDA-LABS/stablenode/ent/linkedaddress"
 	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
 	"github.com/NEDA-LABS/stablenode/ent/paymentorderrecipient"
 	"github.com/NEDA-LABS/stablenode/ent/paymentwebhook"
 	"github.com/NEDA-LABS/stablenode/ent/predicate"
 	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddressassignment"
+	"github.com/NEDA-LABS/stablenode/ent/reconciliationdiscrepancy"
 	"github.com/NEDA-LABS/stablenode/ent/senderprofile"
+	"github.com/NEDA-LABS/stablenode/ent/sweep"
 	"github.com/NEDA-LABS/stablenode/ent/token"
 	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
+	"github.com/NEDA-LABS/stablenode/ent/unmatcheddeposit"
 	"github.com/google/uuid"
 )
 
 // PaymentOrderQuery is the builder for querying PaymentOrder entities.
 type PaymentOrderQuery struct {
 	config
-	ctx                *QueryContext
-	order              []paymentorder.OrderOption
-	inters             []Interceptor
-	predicates         []predicate.PaymentOrder
-	withSenderProfile  *SenderProfileQuery
-	withToken          *TokenQuery
-	withLinkedAddress  *LinkedAddressQuery
-	withReceiveAddress *ReceiveAddressQuery
-	withRecipient      *PaymentOrderRecipientQuery
-	withTransactions   *TransactionLogQuery
-	withPaymentWebhook *PaymentWebhookQuery
-	withFKs            bool
+	ctx                             *QueryContext
+	order                           []paymentorder.OrderOption
+	inters                          []Interceptor
+	predicates                      []predicate.PaymentOrder
+	withSenderProfile               *SenderProfileQuery
+	withToken                       *TokenQuery
+	withLinkedAddress               *LinkedAddressQuery
+	withReceiveAddress              *ReceiveAddressQuery
+	withRecipient                   *PaymentOrderRecipientQuery
+	withTransactions                *TransactionLogQuery
+	withPaymentWebhook              *PaymentWebhookQuery
+	withRefundSweep                 *SweepQuery
+	withDepositSplit                *DepositSplitQuery
+	withLedgerEntries               *LedgerEntryQuery
+	withReconciliationDiscrepancies *ReconciliationDiscrepancyQuery
+	withUnmatchedDeposits           *UnmatchedDepositQuery
+	withAddressAssignments          *ReceiveAddressAssignmentQuery
+	withFKs                         bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -229,6 +241,138 @@ func (poq *PaymentOrderQuery) QueryPaymentWebhook() *PaymentWebhookQuery {
 	return query
 }
 
+// QueryRefundSweep chains the current query on the "refund_sweep" edge.
+func (poq *PaymentOrderQuery) QueryRefundSweep() *SweepQuery {
+	query := (&SweepClient{config: poq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := poq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := poq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, selector),
+			sqlgraph.To(sweep.Table, sweep.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, paymentorder.RefundSweepTable, paymentorder.RefundSweepColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(poq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDepositSplit chains the current query on the "deposit_split" edge.
+func (poq *PaymentOrderQuery) QueryDepositSplit() *DepositSplitQuery {
+	query := (&DepositSplitClient{config: poq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := poq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := poq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, selector),
+			sqlgraph.To(depositsplit.Table, depositsplit.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, paymentorder.DepositSplitTable, paymentorder.DepositSplitColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(poq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLedgerEntries chains the current query on the "ledger_entries" edge.
+func (poq *PaymentOrderQuery) QueryLedgerEntries() *LedgerEntryQuery {
+	query := (&LedgerEntryClient{config: poq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := poq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := poq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, selector),
+			sqlgraph.To(ledgerentry.Table, ledgerentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, paymentorder.LedgerEntriesTable, paymentorder.LedgerEntriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(poq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReconciliationDiscrepancies chains the current query on the "reconciliation_discrepancies" edge.
+func (poq *PaymentOrderQuery) QueryReconciliationDiscrepancies() *ReconciliationDiscrepancyQuery {
+	query := (&ReconciliationDiscrepancyClient{config: poq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := poq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := poq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, selector),
+			sqlgraph.To(reconciliationdiscrepancy.Table, reconciliationdiscrepancy.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, paymentorder.ReconciliationDiscrepanciesTable, paymentorder.ReconciliationDiscrepanciesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(poq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryUnmatchedDeposits chains the current query on the "unmatched_deposits" edge.
+func (poq *PaymentOrderQuery) QueryUnmatchedDeposits() *UnmatchedDepositQuery {
+	query := (&UnmatchedDepositClient{config: poq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := poq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := poq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, selector),
+			sqlgraph.To(unmatcheddeposit.Table, unmatcheddeposit.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, paymentorder.UnmatchedDepositsTable, paymentorder.UnmatchedDepositsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(poq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAddressAssignments chains the current query on the "address_assignments" edge.
+func (poq *PaymentOrderQuery) QueryAddressAssignments() *ReceiveAddressAssignmentQuery {
+	query := (&ReceiveAddressAssignmentClient{config: poq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := poq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := poq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, selector),
+			sqlgraph.To(receiveaddressassignment.Table, receiveaddressassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, paymentorder.AddressAssignmentsTable, paymentorder.AddressAssignmentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(poq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first PaymentOrder entity from the query.
 // Returns a *NotFoundError when no PaymentOrder was found.
 func (poq *PaymentOrderQuery) First(ctx context.Context) (*PaymentOrder, error) {
@@ -416,18 +560,24 @@ func (poq *PaymentOrderQuery) Clone() *PaymentOrderQuery {
 		return nil
 	}
 	return &PaymentOrderQuery{
-		config:             poq.config,
-		ctx:                poq.ctx.Clone(),
-		order:              append([]paymentorder.OrderOption{}, poq.order...),
-		inters:             append([]Interceptor{}, poq.inters...),
-		predicates:         append([]predicate.PaymentOrder{}, poq.predicates...),
-		withSenderProfile:  poq.withSenderProfile.Clone(),
-		withToken:          poq.withToken.Clone(),
-		withLinkedAddress:  poq.withLinkedAddress.Clone(),
-		withReceiveAddress: poq.withReceiveAddress.Clone(),
-		withRecipient:      poq.withRecipient.Clone(),
-		withTransactions:   poq.withTransactions.Clone(),
-		withPaymentWebhook: poq.withPaymentWebhook.Clone(),
+		config:                          poq.config,
+		ctx:                             poq.ctx.Clone(),
+		order:                           append([]paymentorder.OrderOption{}, poq.order...),
+		inters:                          append([]Interceptor{}, poq.inters...),
+		predicates:                      append([]predicate.PaymentOrder{}, poq.predicates...),
+		withSenderProfile:               poq.withSenderProfile.Clone(),
+		withToken:                       poq.withToken.Clone(),
+		withLinkedAddress:               poq.withLinkedAddress.Clone(),
+		withReceiveAddress:              poq.withReceiveAddress.Clone(),
+		withRecipient:                   poq.withRecipient.Clone(),
+		withTransactions:                poq.withTransactions.Clone(),
+		withPaymentWebhook:              poq.withPaymentWebhook.Clone(),
+		withRefundSweep:                 poq.withRefundSweep.Clone(),
+		withDepositSplit:                poq.withDepositSplit.Clone(),
+		withLedgerEntries:               poq.withLedgerEntries.Clone(),
+		withReconciliationDiscrepancies: poq.withReconciliationDiscrepancies.Clone(),
+		withUnmatchedDeposits:           poq.withUnmatchedDeposits.Clone(),
+		withAddressAssignments:          poq.withAddressAssignments.Clone(),
 		// clone intermediate query.
 		sql:  poq.sql.Clone(),
 		path: poq.path,
@@ -511,6 +661,72 @@ func (poq *PaymentOrderQuery) WithPaymentWebhook(opts ...func(*PaymentWebhookQue
 	return poq
 }
 
+// WithRefundSweep tells the query-builder to eager-load the nodes that are connected to
+// the "refund_sweep" edge. The optional arguments are used to configure the query builder of the edge.
+func (poq *PaymentOrderQuery) WithRefundSweep(opts ...func(*SweepQuery)) *PaymentOrderQuery {
+	query := (&SweepClient{config: poq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	poq.withRefundSweep = query
+	return poq
+}
+
+// WithDepositSplit tells the query-builder to eager-load the nodes that are connected to
+// the "deposit_split" edge. The optional arguments are used to configure the query builder of the edge.
+func (poq *PaymentOrderQuery) WithDepositSplit(opts ...func(*DepositSplitQuery)) *PaymentOrderQuery {
+	query := (&DepositSplitClient{config: poq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	poq.withDepositSplit = query
+	return poq
+}
+
+// WithLedgerEntries tells the query-builder to eager-load the nodes that are connected to
+// the "ledger_entries" edge. The optional arguments are used to configure the query builder of the edge.
+func (poq *PaymentOrderQuery) WithLedgerEntries(opts ...func(*LedgerEntryQuery)) *PaymentOrderQuery {
+	query := (&LedgerEntryClient{config: poq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	poq.withLedgerEntries = query
+	return poq
+}
+
+// WithReconciliationDiscrepancies tells the query-builder to eager-load the nodes that are connected to
+// the "reconciliation_discrepancies" edge. The optional arguments are used to configure the query builder of the edge.
+func (poq *PaymentOrderQuery) WithReconciliationDiscrepancies(opts ...func(*ReconciliationDiscrepancyQuery)) *PaymentOrderQuery {
+	query := (&ReconciliationDiscrepancyClient{config: poq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	poq.withReconciliationDiscrepancies = query
+	return poq
+}
+
+// WithUnmatchedDeposits tells the query-builder to eager-load the nodes that are connected to
+// the "unmatched_deposits" edge. The optional arguments are used to configure the query builder of the edge.
+func (poq *PaymentOrderQuery) WithUnmatchedDeposits(opts ...func(*UnmatchedDepositQuery)) *PaymentOrderQuery {
+	query := (&UnmatchedDepositClient{config: poq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	poq.withUnmatchedDeposits = query
+	return poq
+}
+
+// WithAddressAssignments tells the query-builder to eager-load the nodes that are connected to
+// the "address_assignments" edge. The optional arguments are used to configure the query builder of the edge.
+func (poq *PaymentOrderQuery) WithAddressAssignments(opts ...func(*ReceiveAddressAssignmentQuery)) *PaymentOrderQuery {
+	query := (&ReceiveAddressAssignmentClient{config: poq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	poq.withAddressAssignments = query
+	return poq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -590,7 +806,7 @@ func (poq *PaymentOrderQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 		nodes       = []*PaymentOrder{}
 		withFKs     = poq.withFKs
 		_spec       = poq.querySpec()
-		loadedTypes = [7]bool{
+		loadedTypes = [13]bool{
 			poq.withSenderProfile != nil,
 			poq.withToken != nil,
 			poq.withLinkedAddress != nil,
@@ -598,9 +814,15 @@ func (poq *PaymentOrderQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 			poq.withRecipient != nil,
 			poq.withTransactions != nil,
 			poq.withPaymentWebhook != nil,
+			poq.withRefundSweep != nil,
+			poq.withDepositSplit != nil,
+			poq.withLedgerEntries != nil,
+			poq.withReconciliationDiscrepancies != nil,
+			poq.withUnmatchedDeposits != nil,
+			poq.withAddressAssignments != nil,
 		}
 	)
-	if poq.withSenderProfile != nil || poq.withToken != nil || poq.withLinkedAddress != nil {
+	if poq.withSenderProfile != nil || poq.withToken != nil || poq.withLinkedAddress != nil || poq.withRefundSweep != nil || poq.withDepositSplit != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -667,6 +889,52 @@ func (poq *PaymentOrderQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 			return nil, err
 		}
 	}
+	if query := poq.withRefundSweep; query != nil {
+		if err := poq.loadRefundSweep(ctx, query, nodes, nil,
+			func(n *PaymentOrder, e *Sweep) { n.Edges.RefundSweep = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := poq.withDepositSplit; query != nil {
+		if err := poq.loadDepositSplit(ctx, query, nodes, nil,
+			func(n *PaymentOrder, e *DepositSplit) { n.Edges.DepositSplit = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := poq.withLedgerEntries; query != nil {
+		if err := poq.loadLedgerEntries(ctx, query, nodes,
+			func(n *PaymentOrder) { n.Edges.LedgerEntries = []*LedgerEntry{} },
+			func(n *PaymentOrder, e *LedgerEntry) { n.Edges.LedgerEntries = append(n.Edges.LedgerEntries, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := poq.withReconciliationDiscrepancies; query != nil {
+		if err := poq.loadReconciliationDiscrepancies(ctx, query, nodes,
+			func(n *PaymentOrder) { n.Edges.ReconciliationDiscrepancies = []*ReconciliationDiscrepancy{} },
+			func(n *PaymentOrder, e *ReconciliationDiscrepancy) {
+				n.Edges.ReconciliationDiscrepancies = append(n.Edges.ReconciliationDiscrepancies, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := poq.withUnmatchedDeposits; query != nil {
+		if err := poq.loadUnmatchedDeposits(ctx, query, nodes,
+			func(n *PaymentOrder) { n.Edges.UnmatchedDeposits = []*UnmatchedDeposit{} },
+			func(n *PaymentOrder, e *UnmatchedDeposit) {
+				n.Edges.UnmatchedDeposits = append(n.Edges.UnmatchedDeposits, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := poq.withAddressAssignments; query != nil {
+		if err := poq.loadAddressAssignments(ctx, query, nodes,
+			func(n *PaymentOrder) { n.Edges.AddressAssignments = []*ReceiveAddressAssignment{} },
+			func(n *PaymentOrder, e *ReceiveAddressAssignment) {
+				n.Edges.AddressAssignments = append(n.Edges.AddressAssignments, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -881,6 +1149,194 @@ func (poq *PaymentOrderQuery) loadPaymentWebhook(ctx context.Context, query *Pay
 	}
 	return nil
 }
+func (poq *PaymentOrderQuery) loadRefundSweep(ctx context.Context, query *SweepQuery, nodes []*PaymentOrder, init func(*PaymentOrder), assign func(*PaymentOrder, *Sweep)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*PaymentOrder)
+	for i := range nodes {
+		if nodes[i].payment_order_refund_sweep == nil {
+			continue
+		}
+		fk := *nodes[i].payment_order_refund_sweep
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(sweep.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "payment_order_refund_sweep" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (poq *PaymentOrderQuery) loadDepositSplit(ctx context.Context, query *DepositSplitQuery, nodes []*PaymentOrder, init func(*PaymentOrder), assign func(*PaymentOrder, *DepositSplit)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*PaymentOrder)
+	for i := range nodes {
+		if nodes[i].deposit_split_payment_orders == nil {
+			continue
+		}
+		fk := *nodes[i].deposit_split_payment_orders
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(depositsplit.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "deposit_split_payment_orders" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (poq *PaymentOrderQuery) loadLedgerEntries(ctx context.Context, query *LedgerEntryQuery, nodes []*PaymentOrder, init func(*PaymentOrder), assign func(*PaymentOrder, *LedgerEntry)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*PaymentOrder)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.LedgerEntry(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(paymentorder.LedgerEntriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.payment_order_ledger_entries
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "payment_order_ledger_entries" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "payment_order_ledger_entries" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (poq *PaymentOrderQuery) loadReconciliationDiscrepancies(ctx context.Context, query *ReconciliationDiscrepancyQuery, nodes []*PaymentOrder, init func(*PaymentOrder), assign func(*PaymentOrder, *ReconciliationDiscrepancy)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*PaymentOrder)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ReconciliationDiscrepancy(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(paymentorder.ReconciliationDiscrepanciesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.payment_order_reconciliation_discrepancies
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "payment_order_reconciliation_discrepancies" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "payment_order_reconciliation_discrepancies" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (poq *PaymentOrderQuery) loadUnmatchedDeposits(ctx context.Context, query *UnmatchedDepositQuery, nodes []*PaymentOrder, init func(*PaymentOrder), assign func(*PaymentOrder, *UnmatchedDeposit)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*PaymentOrder)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.UnmatchedDeposit(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(paymentorder.UnmatchedDepositsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.payment_order_unmatched_deposits
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "payment_order_unmatched_deposits" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "payment_order_unmatched_deposits" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (poq *PaymentOrderQuery) loadAddressAssignments(ctx context.Context, query *ReceiveAddressAssignmentQuery, nodes []*PaymentOrder, init func(*PaymentOrder), assign func(*PaymentOrder, *ReceiveAddressAssignment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*PaymentOrder)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ReceiveAddressAssignment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(paymentorder.AddressAssignmentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.payment_order_address_assignments
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "payment_order_address_assignments" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "payment_order_address_assignments" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (poq *PaymentOrderQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := poq.querySpec()
@@ -11,15 +11,21 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/depositsplit"
+	"github.com/NEDA-LABS/stablenode/ent/ledgerentry"
 	"github.com/NEDA-LABS/stablenode/ent/linkedaddress"
 	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
 	"github.com/NEDA-LABS/stablenode/ent/paymentorderrecipient"
 	"github.com/NEDA-LABS/stablenode/ent/paymentwebhook"
 	"github.com/NEDA-LABS/stablenode/ent/predicate"
 	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddressassignment"
+	"github.com/NEDA-LABS/stablenode/ent/reconciliationdiscrepancy"
 	"github.com/NEDA-LABS/stablenode/ent/senderprofile"
+	"github.com/NEDA-LABS/stablenode/ent/sweep"
 	"github.com/NEDA-LABS/stablenode/ent/token"
 	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
+	"github.com/NEDA-LABS/stablenode/ent/unmatcheddeposit"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 )
@@ -106,6 +112,27 @@ func (pou *PaymentOrderUpdate) AddAmountReturned(d decimal.Decimal) *PaymentOrde
 	return pou
 }
 
+// SetAmountOverpaid sets the "amount_overpaid" field.
+func (pou *PaymentOrderUpdate) SetAmountOverpaid(d decimal.Decimal) *PaymentOrderUpdate {
+	pou.mutation.ResetAmountOverpaid()
+	pou.mutation.SetAmountOverpaid(d)
+	return pou
+}
+
+// SetNillableAmountOverpaid sets the "amount_overpaid" field if the given value is not nil.
+func (pou *PaymentOrderUpdate) SetNillableAmountOverpaid(d *decimal.Decimal) *PaymentOrderUpdate {
+	if d != nil {
+		pou.SetAmountOverpaid(*d)
+	}
+	return pou
+}
+
+// AddAmountOverpaid adds d to the "amount_overpaid" field.
+func (pou *PaymentOrderUpdate) AddAmountOverpaid(d decimal.Decimal) *PaymentOrderUpdate {
+	pou.mutation.AddAmountOverpaid(d)
+	return pou
+}
+
 // SetPercentSettled sets the "percent_settled" field.
 func (pou *PaymentOrderUpdate) SetPercentSettled(d decimal.Decimal) *PaymentOrderUpdate {
 	pou.mutation.ResetPercentSettled()
@@ -442,6 +469,273 @@ func (pou *PaymentOrderUpdate) AddAmountInUsd(d decimal.Decimal) *PaymentOrderUp
 	return pou
 }
 
+// SetSettlementPolicy sets the "settlement_policy" field.
+func (pou *PaymentOrderUpdate) SetSettlementPolicy(pp paymentorder.SettlementPolicy) *PaymentOrderUpdate {
+	pou.mutation.SetSettlementPolicy(pp)
+	return pou
+}
+
+// SetNillableSettlementPolicy sets the "settlement_policy" field if the given value is not nil.
+func (pou *PaymentOrderUpdate) SetNillableSettlementPolicy(pp *paymentorder.SettlementPolicy) *PaymentOrderUpdate {
+	if pp != nil {
+		pou.SetSettlementPolicy(*pp)
+	}
+	return pou
+}
+
+// SetDepositStatus sets the "deposit_status" field.
+func (pou *PaymentOrderUpdate) SetDepositStatus(ps paymentorder.DepositStatus) *PaymentOrderUpdate {
+	pou.mutation.SetDepositStatus(ps)
+	return pou
+}
+
+// SetNillableDepositStatus sets the "deposit_status" field if the given value is not nil.
+func (pou *PaymentOrderUpdate) SetNillableDepositStatus(ps *paymentorder.DepositStatus) *PaymentOrderUpdate {
+	if ps != nil {
+		pou.SetDepositStatus(*ps)
+	}
+	return pou
+}
+
+// ClearDepositStatus clears the value of the "deposit_status" field.
+func (pou *PaymentOrderUpdate) ClearDepositStatus() *PaymentOrderUpdate {
+	pou.mutation.ClearDepositStatus()
+	return pou
+}
+
+// SetDepositFinalizedAt sets the "deposit_finalized_at" field.
+func (pou *PaymentOrderUpdate) SetDepositFinalizedAt(t time.Time) *PaymentOrderUpdate {
+	pou.mutation.SetDepositFinalizedAt(t)
+	return pou
+}
+
+// SetNillableDepositFinalizedAt sets the "deposit_finalized_at" field if the given value is not nil.
+func (pou *PaymentOrderUpdate) SetNillableDepositFinalizedAt(t *time.Time) *PaymentOrderUpdate {
+	if t != nil {
+		pou.SetDepositFinalizedAt(*t)
+	}
+	return pou
+}
+
+// ClearDepositFinalizedAt clears the value of the "deposit_finalized_at" field.
+func (pou *PaymentOrderUpdate) ClearDepositFinalizedAt() *PaymentOrderUpdate {
+	pou.mutation.ClearDepositFinalizedAt()
+	return pou
+}
+
+// SetRequiredConfirmations sets the "required_confirmations" field.
+func (pou *PaymentOrderUpdate) SetRequiredConfirmations(i int) *PaymentOrderUpdate {
+	pou.mutation.ResetRequiredConfirmations()
+	pou.mutation.SetRequiredConfirmations(i)
+	return pou
+}
+
+// SetNillableRequiredConfirmations sets the "required_confirmations" field if the given value is not nil.
+func (pou *PaymentOrderUpdate) SetNillableRequiredConfirmations(i *int) *PaymentOrderUpdate {
+	if i != nil {
+		pou.SetRequiredConfirmations(*i)
+	}
+	return pou
+}
+
+// AddRequiredConfirmations adds i to the "required_confirmations" field.
+func (pou *PaymentOrderUpdate) AddRequiredConfirmations(i int) *PaymentOrderUpdate {
+	pou.mutation.AddRequiredConfirmations(i)
+	return pou
+}
+
+// SetSLABreachedAt sets the "sla_breached_at" field.
+func (pou *PaymentOrderUpdate) SetSLABreachedAt(t time.Time) *PaymentOrderUpdate {
+	pou.mutation.SetSLABreachedAt(t)
+	return pou
+}
+
+// SetNillableSLABreachedAt sets the "sla_breached_at" field if the given value is not nil.
+func (pou *PaymentOrderUpdate) SetNillableSLABreachedAt(t *time.Time) *PaymentOrderUpdate {
+	if t != nil {
+		pou.SetSLABreachedAt(*t)
+	}
+	return pou
+}
+
+// ClearSLABreachedAt clears the value of the "sla_breached_at" field.
+func (pou *PaymentOrderUpdate) ClearSLABreachedAt() *PaymentOrderUpdate {
+	pou.mutation.ClearSLABreachedAt()
+	return pou
+}
+
+// SetReviewReason sets the "review_reason" field.
+func (pou *PaymentOrderUpdate) SetReviewReason(s string) *PaymentOrderUpdate {
+	pou.mutation.SetReviewReason(s)
+	return pou
+}
+
+// SetNillableReviewReason sets the "review_reason" field if the given value is not nil.
+func (pou *PaymentOrderUpdate) SetNillableReviewReason(s *string) *PaymentOrderUpdate {
+	if s != nil {
+		pou.SetReviewReason(*s)
+	}
+	return pou
+}
+
+// ClearReviewReason clears the value of the "review_reason" field.
+func (pou *PaymentOrderUpdate) ClearReviewReason() *PaymentOrderUpdate {
+	pou.mutation.ClearReviewReason()
+	return pou
+}
+
+// SetQuarantineReason sets the "quarantine_reason" field.
+func (pou *PaymentOrderUpdate) SetQuarantineReason(s string) *PaymentOrderUpdate {
+	pou.mutation.SetQuarantineReason(s)
+	return pou
+}
+
+// SetNillableQuarantineReason sets the "quarantine_reason" field if the given value is not nil.
+func (pou *PaymentOrderUpdate) SetNillableQuarantineReason(s *string) *PaymentOrderUpdate {
+	if s != nil {
+		pou.SetQuarantineReason(*s)
+	}
+	return pou
+}
+
+// ClearQuarantineReason clears the value of the "quarantine_reason" field.
+func (pou *PaymentOrderUpdate) ClearQuarantineReason() *PaymentOrderUpdate {
+	pou.mutation.ClearQuarantineReason()
+	return pou
+}
+
+// SetFiatAmount sets the "fiat_amount" field.
+func (pou *PaymentOrderUpdate) SetFiatAmount(d decimal.Decimal) *PaymentOrderUpdate {
+	pou.mutation.ResetFiatAmount()
+	pou.mutation.SetFiatAmount(d)
+	return pou
+}
+
+// SetNillableFiatAmount sets the "fiat_amount" field if the given value is not nil.
+func (pou *PaymentOrderUpdate) SetNillableFiatAmount(d *decimal.Decimal) *PaymentOrderUpdate {
+	if d != nil {
+		pou.SetFiatAmount(*d)
+	}
+	return pou
+}
+
+// AddFiatAmount adds d to the "fiat_amount" field.
+func (pou *PaymentOrderUpdate) AddFiatAmount(d decimal.Decimal) *PaymentOrderUpdate {
+	pou.mutation.AddFiatAmount(d)
+	return pou
+}
+
+// ClearFiatAmount clears the value of the "fiat_amount" field.
+func (pou *PaymentOrderUpdate) ClearFiatAmount() *PaymentOrderUpdate {
+	pou.mutation.ClearFiatAmount()
+	return pou
+}
+
+// SetFiatCurrency sets the "fiat_currency" field.
+func (pou *PaymentOrderUpdate) SetFiatCurrency(s string) *PaymentOrderUpdate {
+	pou.mutation.SetFiatCurrency(s)
+	return pou
+}
+
+// SetNillableFiatCurrency sets the "fiat_currency" field if the given value is not nil.
+func (pou *PaymentOrderUpdate) SetNillableFiatCurrency(s *string) *PaymentOrderUpdate {
+	if s != nil {
+		pou.SetFiatCurrency(*s)
+	}
+	return pou
+}
+
+// ClearFiatCurrency clears the value of the "fiat_currency" field.
+func (pou *PaymentOrderUpdate) ClearFiatCurrency() *PaymentOrderUpdate {
+	pou.mutation.ClearFiatCurrency()
+	return pou
+}
+
+// SetRateDriftTolerance sets the "rate_drift_tolerance" field.
+func (pou *PaymentOrderUpdate) SetRateDriftTolerance(d decimal.Decimal) *PaymentOrderUpdate {
+	pou.mutation.ResetRateDriftTolerance()
+	pou.mutation.SetRateDriftTolerance(d)
+	return pou
+}
+
+// SetNillableRateDriftTolerance sets the "rate_drift_tolerance" field if the given value is not nil.
+func (pou *PaymentOrderUpdate) SetNillableRateDriftTolerance(d *decimal.Decimal) *PaymentOrderUpdate {
+	if d != nil {
+		pou.SetRateDriftTolerance(*d)
+	}
+	return pou
+}
+
+// AddRateDriftTolerance adds d to the "rate_drift_tolerance" field.
+func (pou *PaymentOrderUpdate) AddRateDriftTolerance(d decimal.Decimal) *PaymentOrderUpdate {
+	pou.mutation.AddRateDriftTolerance(d)
+	return pou
+}
+
+// SetFiatConversion sets the "fiat_conversion" field.
+func (pou *PaymentOrderUpdate) SetFiatConversion(m map[string]interface{}) *PaymentOrderUpdate {
+	pou.mutation.SetFiatConversion(m)
+	return pou
+}
+
+// ClearFiatConversion clears the value of the "fiat_conversion" field.
+func (pou *PaymentOrderUpdate) ClearFiatConversion() *PaymentOrderUpdate {
+	pou.mutation.ClearFiatConversion()
+	return pou
+}
+
+// SetRateLockedUntil sets the "rate_locked_until" field.
+func (pou *PaymentOrderUpdate) SetRateLockedUntil(t time.Time) *PaymentOrderUpdate {
+	pou.mutation.SetRateLockedUntil(t)
+	return pou
+}
+
+// SetNillableRateLockedUntil sets the "rate_locked_until" field if the given value is not nil.
+func (pou *PaymentOrderUpdate) SetNillableRateLockedUntil(t *time.Time) *PaymentOrderUpdate {
+	if t != nil {
+		pou.SetRateLockedUntil(*t)
+	}
+	return pou
+}
+
+// ClearRateLockedUntil clears the value of the "rate_locked_until" field.
+func (pou *PaymentOrderUpdate) ClearRateLockedUntil() *PaymentOrderUpdate {
+	pou.mutation.ClearRateLockedUntil()
+	return pou
+}
+
+// SetRateRequote sets the "rate_requote" field.
+func (pou *PaymentOrderUpdate) SetRateRequote(m map[string]interface{}) *PaymentOrderUpdate {
+	pou.mutation.SetRateRequote(m)
+	return pou
+}
+
+// ClearRateRequote clears the value of the "rate_requote" field.
+func (pou *PaymentOrderUpdate) ClearRateRequote() *PaymentOrderUpdate {
+	pou.mutation.ClearRateRequote()
+	return pou
+}
+
+// SetBlockchainService sets the "blockchain_service" field.
+func (pou *PaymentOrderUpdate) SetBlockchainService(ps paymentorder.BlockchainService) *PaymentOrderUpdate {
+	pou.mutation.SetBlockchainService(ps)
+	return pou
+}
+
+// SetNillableBlockchainService sets the "blockchain_service" field if the given value is not nil.
+func (pou *PaymentOrderUpdate) SetNillableBlockchainService(ps *paymentorder.BlockchainService) *PaymentOrderUpdate {
+	if ps != nil {
+		pou.SetBlockchainService(*ps)
+	}
+	return pou
+}
+
+// ClearBlockchainService clears the value of the "blockchain_service" field.
+func (pou *PaymentOrderUpdate) ClearBlockchainService() *PaymentOrderUpdate {
+	pou.mutation.ClearBlockchainService()
+	return pou
+}
+
 // SetSenderProfileID sets the "sender_profile" edge to the SenderProfile entity by ID.
 func (pou *PaymentOrderUpdate) SetSenderProfileID(id uuid.UUID) *PaymentOrderUpdate {
 	pou.mutation.SetSenderProfileID(id)
@@ -563,6 +857,104 @@ func (pou *PaymentOrderUpdate) SetPaymentWebhook(p *PaymentWebhook) *PaymentOrde
 	return pou.SetPaymentWebhookID(p.ID)
 }
 
+// SetRefundSweepID sets the "refund_sweep" edge to the Sweep entity by ID.
+func (pou *PaymentOrderUpdate) SetRefundSweepID(id uuid.UUID) *PaymentOrderUpdate {
+	pou.mutation.SetRefundSweepID(id)
+	return pou
+}
+
+// SetNillableRefundSweepID sets the "refund_sweep" edge to the Sweep entity by ID if the given value is not nil.
+func (pou *PaymentOrderUpdate) SetNillableRefundSweepID(id *uuid.UUID) *PaymentOrderUpdate {
+	if id != nil {
+		pou = pou.SetRefundSweepID(*id)
+	}
+	return pou
+}
+
+// SetRefundSweep sets the "refund_sweep" edge to the Sweep entity.
+func (pou *PaymentOrderUpdate) SetRefundSweep(s *Sweep) *PaymentOrderUpdate {
+	return pou.SetRefundSweepID(s.ID)
+}
+
+// SetDepositSplitID sets the "deposit_split" edge to the DepositSplit entity by ID.
+func (pou *PaymentOrderUpdate) SetDepositSplitID(id uuid.UUID) *PaymentOrderUpdate {
+	pou.mutation.SetDepositSplitID(id)
+	return pou
+}
+
+// SetNillableDepositSplitID sets the "deposit_split" edge to the DepositSplit entity by ID if the given value is not nil.
+func (pou *PaymentOrderUpdate) SetNillableDepositSplitID(id *uuid.UUID) *PaymentOrderUpdate {
+	if id != nil {
+		pou = pou.SetDepositSplitID(*id)
+	}
+	return pou
+}
+
+// SetDepositSplit sets the "deposit_split" edge to the DepositSplit entity.
+func (pou *PaymentOrderUpdate) SetDepositSplit(d *DepositSplit) *PaymentOrderUpdate {
+	return pou.SetDepositSplitID(d.ID)
+}
+
+// AddLedgerEntryIDs adds the "ledger_entries" edge to the LedgerEntry entity by IDs.
+func (pou *PaymentOrderUpdate) AddLedgerEntryIDs(ids ...uuid.UUID) *PaymentOrderUpdate {
+	pou.mutation.AddLedgerEntryIDs(ids...)
+	return pou
+}
+
+// AddLedgerEntries adds the "ledger_entries" edges to the LedgerEntry entity.
+func (pou *PaymentOrderUpdate) AddLedgerEntries(l ...*LedgerEntry) *PaymentOrderUpdate {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return pou.AddLedgerEntryIDs(ids...)
+}
+
+// AddReconciliationDiscrepancyIDs adds the "reconciliation_discrepancies" edge to the ReconciliationDiscrepancy entity by IDs.
+func (pou *PaymentOrderUpdate) AddReconciliationDiscrepancyIDs(ids ...uuid.UUID) *PaymentOrderUpdate {
+	pou.mutation.AddReconciliationDiscrepancyIDs(ids...)
+	return pou
+}
+
+// AddReconciliationDiscrepancies adds the "reconciliation_discrepancies" edges to the ReconciliationDiscrepancy entity.
+func (pou *PaymentOrderUpdate) AddReconciliationDiscrepancies(r ...*ReconciliationDiscrepancy) *PaymentOrderUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pou.AddReconciliationDiscrepancyIDs(ids...)
+}
+
+// AddUnmatchedDepositIDs adds the "unmatched_deposits" edge to the UnmatchedDeposit entity by IDs.
+func (pou *PaymentOrderUpdate) AddUnmatchedDepositIDs(ids ...uuid.UUID) *PaymentOrderUpdate {
+	pou.mutation.AddUnmatchedDepositIDs(ids...)
+	return pou
+}
+
+// AddUnmatchedDeposits adds the "unmatched_deposits" edges to the UnmatchedDeposit entity.
+func (pou *PaymentOrderUpdate) AddUnmatchedDeposits(u ...*UnmatchedDeposit) *PaymentOrderUpdate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return pou.AddUnmatchedDepositIDs(ids...)
+}
+
+// AddAddressAssignmentIDs adds the "address_assignments" edge to the ReceiveAddressAssignment entity by IDs.
+func (pou *PaymentOrderUpdate) AddAddressAssignmentIDs(ids ...uuid.UUID) *PaymentOrderUpdate {
+	pou.mutation.AddAddressAssignmentIDs(ids...)
+	return pou
+}
+
+// AddAddressAssignments adds the "address_assignments" edges to the ReceiveAddressAssignment entity.
+func (pou *PaymentOrderUpdate) AddAddressAssignments(r ...*ReceiveAddressAssignment) *PaymentOrderUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pou.AddAddressAssignmentIDs(ids...)
+}
+
 // Mutation returns the PaymentOrderMutation object of the builder.
 func (pou *PaymentOrderUpdate) Mutation() *PaymentOrderMutation {
 	return pou.mutation
@@ -625,6 +1017,102 @@ func (pou *PaymentOrderUpdate) ClearPaymentWebhook() *PaymentOrderUpdate {
 	return pou
 }
 
+// ClearRefundSweep clears the "refund_sweep" edge to the Sweep entity.
+func (pou *PaymentOrderUpdate) ClearRefundSweep() *PaymentOrderUpdate {
+	pou.mutation.ClearRefundSweep()
+	return pou
+}
+
+// ClearDepositSplit clears the "deposit_split" edge to the DepositSplit entity.
+func (pou *PaymentOrderUpdate) ClearDepositSplit() *PaymentOrderUpdate {
+	pou.mutation.ClearDepositSplit()
+	return pou
+}
+
+// ClearLedgerEntries clears all "ledger_entries" edges to the LedgerEntry entity.
+func (pou *PaymentOrderUpdate) ClearLedgerEntries() *PaymentOrderUpdate {
+	pou.mutation.ClearLedgerEntries()
+	return pou
+}
+
+// RemoveLedgerEntryIDs removes the "ledger_entries" edge to LedgerEntry entities by IDs.
+func (pou *PaymentOrderUpdate) RemoveLedgerEntryIDs(ids ...uuid.UUID) *PaymentOrderUpdate {
+	pou.mutation.RemoveLedgerEntryIDs(ids...)
+	return pou
+}
+
+// RemoveLedgerEntries removes "ledger_entries" edges to LedgerEntry entities.
+func (pou *PaymentOrderUpdate) RemoveLedgerEntries(l ...*LedgerEntry) *PaymentOrderUpdate {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return pou.RemoveLedgerEntryIDs(ids...)
+}
+
+// ClearReconciliationDiscrepancies clears all "reconciliation_discrepancies" edges to the ReconciliationDiscrepancy entity.
+func (pou *PaymentOrderUpdate) ClearReconciliationDiscrepancies() *PaymentOrderUpdate {
+	pou.mutation.ClearReconciliationDiscrepancies()
+	return pou
+}
+
+// RemoveReconciliationDiscrepancyIDs removes the "reconciliation_discrepancies" edge to ReconciliationDiscrepancy entities by IDs.
+func (pou *PaymentOrderUpdate) RemoveReconciliationDiscrepancyIDs(ids ...uuid.UUID) *PaymentOrderUpdate {
+	pou.mutation.RemoveReconciliationDiscrepancyIDs(ids...)
+	return pou
+}
+
+// RemoveReconciliationDiscrepancies removes "reconciliation_discrepancies" edges to ReconciliationDiscrepancy entities.
+func (pou *PaymentOrderUpdate) RemoveReconciliationDiscrepancies(r ...*ReconciliationDiscrepancy) *PaymentOrderUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pou.RemoveReconciliationDiscrepancyIDs(ids...)
+}
+
+// ClearUnmatchedDeposits clears all "unmatched_deposits" edges to the UnmatchedDeposit entity.
+func (pou *PaymentOrderUpdate) ClearUnmatchedDeposits() *PaymentOrderUpdate {
+	pou.mutation.ClearUnmatchedDeposits()
+	return pou
+}
+
+// RemoveUnmatchedDepositIDs removes the "unmatched_deposits" edge to UnmatchedDeposit entities by IDs.
+func (pou *PaymentOrderUpdate) RemoveUnmatchedDepositIDs(ids ...uuid.UUID) *PaymentOrderUpdate {
+	pou.mutation.RemoveUnmatchedDepositIDs(ids...)
+	return pou
+}
+
+// RemoveUnmatchedDeposits removes "unmatched_deposits" edges to UnmatchedDeposit entities.
+func (pou *PaymentOrderUpdate) RemoveUnmatchedDeposits(u ...*UnmatchedDeposit) *PaymentOrderUpdate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return pou.RemoveUnmatchedDepositIDs(ids...)
+}
+
+// ClearAddressAssignments clears all "address_assignments" edges to the ReceiveAddressAssignment entity.
+func (pou *PaymentOrderUpdate) ClearAddressAssignments() *PaymentOrderUpdate {
+	pou.mutation.ClearAddressAssignments()
+	return pou
+}
+
+// RemoveAddressAssignmentIDs removes the "address_assignments" edge to ReceiveAddressAssignment entities by IDs.
+func (pou *PaymentOrderUpdate) RemoveAddressAssignmentIDs(ids ...uuid.UUID) *PaymentOrderUpdate {
+	pou.mutation.RemoveAddressAssignmentIDs(ids...)
+	return pou
+}
+
+// RemoveAddressAssignments removes "address_assignments" edges to ReceiveAddressAssignment entities.
+func (pou *PaymentOrderUpdate) RemoveAddressAssignments(r ...*ReceiveAddressAssignment) *PaymentOrderUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pou.RemoveAddressAssignmentIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (pou *PaymentOrderUpdate) Save(ctx context.Context) (int, error) {
 	pou.defaults()
@@ -708,6 +1196,31 @@ func (pou *PaymentOrderUpdate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.status": %w`, err)}
 		}
 	}
+	if v, ok := pou.mutation.SettlementPolicy(); ok {
+		if err := paymentorder.SettlementPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "settlement_policy", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.settlement_policy": %w`, err)}
+		}
+	}
+	if v, ok := pou.mutation.DepositStatus(); ok {
+		if err := paymentorder.DepositStatusValidator(v); err != nil {
+			return &ValidationError{Name: "deposit_status", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.deposit_status": %w`, err)}
+		}
+	}
+	if v, ok := pou.mutation.RequiredConfirmations(); ok {
+		if err := paymentorder.RequiredConfirmationsValidator(v); err != nil {
+			return &ValidationError{Name: "required_confirmations", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.required_confirmations": %w`, err)}
+		}
+	}
+	if v, ok := pou.mutation.FiatCurrency(); ok {
+		if err := paymentorder.FiatCurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "fiat_currency", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.fiat_currency": %w`, err)}
+		}
+	}
+	if v, ok := pou.mutation.BlockchainService(); ok {
+		if err := paymentorder.BlockchainServiceValidator(v); err != nil {
+			return &ValidationError{Name: "blockchain_service", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.blockchain_service": %w`, err)}
+		}
+	}
 	if pou.mutation.TokenCleared() && len(pou.mutation.TokenIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "PaymentOrder.token"`)
 	}
@@ -747,6 +1260,12 @@ func (pou *PaymentOrderUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := pou.mutation.AddedAmountReturned(); ok {
 		_spec.AddField(paymentorder.FieldAmountReturned, field.TypeFloat64, value)
 	}
+	if value, ok := pou.mutation.AmountOverpaid(); ok {
+		_spec.SetField(paymentorder.FieldAmountOverpaid, field.TypeFloat64, value)
+	}
+	if value, ok := pou.mutation.AddedAmountOverpaid(); ok {
+		_spec.AddField(paymentorder.FieldAmountOverpaid, field.TypeFloat64, value)
+	}
 	if value, ok := pou.mutation.PercentSettled(); ok {
 		_spec.SetField(paymentorder.FieldPercentSettled, field.TypeFloat64, value)
 	}
@@ -843,15 +1362,99 @@ func (pou *PaymentOrderUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := pou.mutation.AddedAmountInUsd(); ok {
 		_spec.AddField(paymentorder.FieldAmountInUsd, field.TypeFloat64, value)
 	}
-	if pou.mutation.SenderProfileCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   paymentorder.SenderProfileTable,
-			Columns: []string{paymentorder.SenderProfileColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(senderprofile.FieldID, field.TypeUUID),
+	if value, ok := pou.mutation.SettlementPolicy(); ok {
+		_spec.SetField(paymentorder.FieldSettlementPolicy, field.TypeEnum, value)
+	}
+	if value, ok := pou.mutation.DepositStatus(); ok {
+		_spec.SetField(paymentorder.FieldDepositStatus, field.TypeEnum, value)
+	}
+	if pou.mutation.DepositStatusCleared() {
+		_spec.ClearField(paymentorder.FieldDepositStatus, field.TypeEnum)
+	}
+	if value, ok := pou.mutation.DepositFinalizedAt(); ok {
+		_spec.SetField(paymentorder.FieldDepositFinalizedAt, field.TypeTime, value)
+	}
+	if pou.mutation.DepositFinalizedAtCleared() {
+		_spec.ClearField(paymentorder.FieldDepositFinalizedAt, field.TypeTime)
+	}
+	if value, ok := pou.mutation.RequiredConfirmations(); ok {
+		_spec.SetField(paymentorder.FieldRequiredConfirmations, field.TypeInt, value)
+	}
+	if value, ok := pou.mutation.AddedRequiredConfirmations(); ok {
+		_spec.AddField(paymentorder.FieldRequiredConfirmations, field.TypeInt, value)
+	}
+	if value, ok := pou.mutation.SLABreachedAt(); ok {
+		_spec.SetField(paymentorder.FieldSLABreachedAt, field.TypeTime, value)
+	}
+	if pou.mutation.SLABreachedAtCleared() {
+		_spec.ClearField(paymentorder.FieldSLABreachedAt, field.TypeTime)
+	}
+	if value, ok := pou.mutation.ReviewReason(); ok {
+		_spec.SetField(paymentorder.FieldReviewReason, field.TypeString, value)
+	}
+	if pou.mutation.ReviewReasonCleared() {
+		_spec.ClearField(paymentorder.FieldReviewReason, field.TypeString)
+	}
+	if value, ok := pou.mutation.QuarantineReason(); ok {
+		_spec.SetField(paymentorder.FieldQuarantineReason, field.TypeString, value)
+	}
+	if pou.mutation.QuarantineReasonCleared() {
+		_spec.ClearField(paymentorder.FieldQuarantineReason, field.TypeString)
+	}
+	if value, ok := pou.mutation.FiatAmount(); ok {
+		_spec.SetField(paymentorder.FieldFiatAmount, field.TypeFloat64, value)
+	}
+	if value, ok := pou.mutation.AddedFiatAmount(); ok {
+		_spec.AddField(paymentorder.FieldFiatAmount, field.TypeFloat64, value)
+	}
+	if pou.mutation.FiatAmountCleared() {
+		_spec.ClearField(paymentorder.FieldFiatAmount, field.TypeFloat64)
+	}
+	if value, ok := pou.mutation.FiatCurrency(); ok {
+		_spec.SetField(paymentorder.FieldFiatCurrency, field.TypeString, value)
+	}
+	if pou.mutation.FiatCurrencyCleared() {
+		_spec.ClearField(paymentorder.FieldFiatCurrency, field.TypeString)
+	}
+	if value, ok := pou.mutation.RateDriftTolerance(); ok {
+		_spec.SetField(paymentorder.FieldRateDriftTolerance, field.TypeFloat64, value)
+	}
+	if value, ok := pou.mutation.AddedRateDriftTolerance(); ok {
+		_spec.AddField(paymentorder.FieldRateDriftTolerance, field.TypeFloat64, value)
+	}
+	if value, ok := pou.mutation.FiatConversion(); ok {
+		_spec.SetField(paymentorder.FieldFiatConversion, field.TypeJSON, value)
+	}
+	if pou.mutation.FiatConversionCleared() {
+		_spec.ClearField(paymentorder.FieldFiatConversion, field.TypeJSON)
+	}
+	if value, ok := pou.mutation.RateLockedUntil(); ok {
+		_spec.SetField(paymentorder.FieldRateLockedUntil, field.TypeTime, value)
+	}
+	if pou.mutation.RateLockedUntilCleared() {
+		_spec.ClearField(paymentorder.FieldRateLockedUntil, field.TypeTime)
+	}
+	if value, ok := pou.mutation.RateRequote(); ok {
+		_spec.SetField(paymentorder.FieldRateRequote, field.TypeJSON, value)
+	}
+	if pou.mutation.RateRequoteCleared() {
+		_spec.ClearField(paymentorder.FieldRateRequote, field.TypeJSON)
+	}
+	if value, ok := pou.mutation.BlockchainService(); ok {
+		_spec.SetField(paymentorder.FieldBlockchainService, field.TypeEnum, value)
+	}
+	if pou.mutation.BlockchainServiceCleared() {
+		_spec.ClearField(paymentorder.FieldBlockchainService, field.TypeEnum)
+	}
+	if pou.mutation.SenderProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentorder.SenderProfileTable,
+			Columns: []string{paymentorder.SenderProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderprofile.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
@@ -1062,6 +1665,244 @@ func (pou *PaymentOrderUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if pou.mutation.RefundSweepCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   paymentorder.RefundSweepTable,
+			Columns: []string{paymentorder.RefundSweepColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sweep.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pou.mutation.RefundSweepIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   paymentorder.RefundSweepTable,
+			Columns: []string{paymentorder.RefundSweepColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sweep.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pou.mutation.DepositSplitCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentorder.DepositSplitTable,
+			Columns: []string{paymentorder.DepositSplitColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(depositsplit.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pou.mutation.DepositSplitIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentorder.DepositSplitTable,
+			Columns: []string{paymentorder.DepositSplitColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(depositsplit.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pou.mutation.LedgerEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.LedgerEntriesTable,
+			Columns: []string{paymentorder.LedgerEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerentry.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pou.mutation.RemovedLedgerEntriesIDs(); len(nodes) > 0 && !pou.mutation.LedgerEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.LedgerEntriesTable,
+			Columns: []string{paymentorder.LedgerEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pou.mutation.LedgerEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.LedgerEntriesTable,
+			Columns: []string{paymentorder.LedgerEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pou.mutation.ReconciliationDiscrepanciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.ReconciliationDiscrepanciesTable,
+			Columns: []string{paymentorder.ReconciliationDiscrepanciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reconciliationdiscrepancy.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pou.mutation.RemovedReconciliationDiscrepanciesIDs(); len(nodes) > 0 && !pou.mutation.ReconciliationDiscrepanciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.ReconciliationDiscrepanciesTable,
+			Columns: []string{paymentorder.ReconciliationDiscrepanciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reconciliationdiscrepancy.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pou.mutation.ReconciliationDiscrepanciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.ReconciliationDiscrepanciesTable,
+			Columns: []string{paymentorder.ReconciliationDiscrepanciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reconciliationdiscrepancy.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pou.mutation.UnmatchedDepositsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.UnmatchedDepositsTable,
+			Columns: []string{paymentorder.UnmatchedDepositsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(unmatcheddeposit.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pou.mutation.RemovedUnmatchedDepositsIDs(); len(nodes) > 0 && !pou.mutation.UnmatchedDepositsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.UnmatchedDepositsTable,
+			Columns: []string{paymentorder.UnmatchedDepositsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(unmatcheddeposit.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pou.mutation.UnmatchedDepositsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.UnmatchedDepositsTable,
+			Columns: []string{paymentorder.UnmatchedDepositsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(unmatcheddeposit.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pou.mutation.AddressAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.AddressAssignmentsTable,
+			Columns: []string{paymentorder.AddressAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receiveaddressassignment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pou.mutation.RemovedAddressAssignmentsIDs(); len(nodes) > 0 && !pou.mutation.AddressAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.AddressAssignmentsTable,
+			Columns: []string{paymentorder.AddressAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receiveaddressassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pou.mutation.AddressAssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.AddressAssignmentsTable,
+			Columns: []string{paymentorder.AddressAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receiveaddressassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, pou.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{paymentorder.Label}
@@ -1151,6 +1992,27 @@ func (pouo *PaymentOrderUpdateOne) AddAmountReturned(d decimal.Decimal) *Payment
 	return pouo
 }
 
+// SetAmountOverpaid sets the "amount_overpaid" field.
+func (pouo *PaymentOrderUpdateOne) SetAmountOverpaid(d decimal.Decimal) *PaymentOrderUpdateOne {
+	pouo.mutation.ResetAmountOverpaid()
+	pouo.mutation.SetAmountOverpaid(d)
+	return pouo
+}
+
+// SetNillableAmountOverpaid sets the "amount_overpaid" field if the given value is not nil.
+func (pouo *PaymentOrderUpdateOne) SetNillableAmountOverpaid(d *decimal.Decimal) *PaymentOrderUpdateOne {
+	if d != nil {
+		pouo.SetAmountOverpaid(*d)
+	}
+	return pouo
+}
+
+// AddAmountOverpaid adds d to the "amount_overpaid" field.
+func (pouo *PaymentOrderUpdateOne) AddAmountOverpaid(d decimal.Decimal) *PaymentOrderUpdateOne {
+	pouo.mutation.AddAmountOverpaid(d)
+	return pouo
+}
+
 // SetPercentSettled sets the "percent_settled" field.
 func (pouo *PaymentOrderUpdateOne) SetPercentSettled(d decimal.Decimal) *PaymentOrderUpdateOne {
 	pouo.mutation.ResetPercentSettled()
@@ -1487,62 +2349,329 @@ func (pouo *PaymentOrderUpdateOne) AddAmountInUsd(d decimal.Decimal) *PaymentOrd
 	return pouo
 }
 
-// SetSenderProfileID sets the "sender_profile" edge to the SenderProfile entity by ID.
-func (pouo *PaymentOrderUpdateOne) SetSenderProfileID(id uuid.UUID) *PaymentOrderUpdateOne {
-	pouo.mutation.SetSenderProfileID(id)
+// SetSettlementPolicy sets the "settlement_policy" field.
+func (pouo *PaymentOrderUpdateOne) SetSettlementPolicy(pp paymentorder.SettlementPolicy) *PaymentOrderUpdateOne {
+	pouo.mutation.SetSettlementPolicy(pp)
 	return pouo
 }
 
-// SetNillableSenderProfileID sets the "sender_profile" edge to the SenderProfile entity by ID if the given value is not nil.
-func (pouo *PaymentOrderUpdateOne) SetNillableSenderProfileID(id *uuid.UUID) *PaymentOrderUpdateOne {
-	if id != nil {
-		pouo = pouo.SetSenderProfileID(*id)
+// SetNillableSettlementPolicy sets the "settlement_policy" field if the given value is not nil.
+func (pouo *PaymentOrderUpdateOne) SetNillableSettlementPolicy(pp *paymentorder.SettlementPolicy) *PaymentOrderUpdateOne {
+	if pp != nil {
+		pouo.SetSettlementPolicy(*pp)
 	}
 	return pouo
 }
 
-// SetSenderProfile sets the "sender_profile" edge to the SenderProfile entity.
-func (pouo *PaymentOrderUpdateOne) SetSenderProfile(s *SenderProfile) *PaymentOrderUpdateOne {
-	return pouo.SetSenderProfileID(s.ID)
+// SetDepositStatus sets the "deposit_status" field.
+func (pouo *PaymentOrderUpdateOne) SetDepositStatus(ps paymentorder.DepositStatus) *PaymentOrderUpdateOne {
+	pouo.mutation.SetDepositStatus(ps)
+	return pouo
 }
 
-// SetTokenID sets the "token" edge to the Token entity by ID.
-func (pouo *PaymentOrderUpdateOne) SetTokenID(id int) *PaymentOrderUpdateOne {
-	pouo.mutation.SetTokenID(id)
+// SetNillableDepositStatus sets the "deposit_status" field if the given value is not nil.
+func (pouo *PaymentOrderUpdateOne) SetNillableDepositStatus(ps *paymentorder.DepositStatus) *PaymentOrderUpdateOne {
+	if ps != nil {
+		pouo.SetDepositStatus(*ps)
+	}
 	return pouo
 }
 
-// SetToken sets the "token" edge to the Token entity.
-func (pouo *PaymentOrderUpdateOne) SetToken(t *Token) *PaymentOrderUpdateOne {
-	return pouo.SetTokenID(t.ID)
+// ClearDepositStatus clears the value of the "deposit_status" field.
+func (pouo *PaymentOrderUpdateOne) ClearDepositStatus() *PaymentOrderUpdateOne {
+	pouo.mutation.ClearDepositStatus()
+	return pouo
 }
 
-// SetLinkedAddressID sets the "linked_address" edge to the LinkedAddress entity by ID.
-func (pouo *PaymentOrderUpdateOne) SetLinkedAddressID(id int) *PaymentOrderUpdateOne {
-	pouo.mutation.SetLinkedAddressID(id)
+// SetDepositFinalizedAt sets the "deposit_finalized_at" field.
+func (pouo *PaymentOrderUpdateOne) SetDepositFinalizedAt(t time.Time) *PaymentOrderUpdateOne {
+	pouo.mutation.SetDepositFinalizedAt(t)
 	return pouo
 }
 
-// SetNillableLinkedAddressID sets the "linked_address" edge to the LinkedAddress entity by ID if the given value is not nil.
-func (pouo *PaymentOrderUpdateOne) SetNillableLinkedAddressID(id *int) *PaymentOrderUpdateOne {
-	if id != nil {
-		pouo = pouo.SetLinkedAddressID(*id)
+// SetNillableDepositFinalizedAt sets the "deposit_finalized_at" field if the given value is not nil.
+func (pouo *PaymentOrderUpdateOne) SetNillableDepositFinalizedAt(t *time.Time) *PaymentOrderUpdateOne {
+	if t != nil {
+		pouo.SetDepositFinalizedAt(*t)
 	}
 	return pouo
 }
 
-// SetLinkedAddress sets the "linked_address" edge to the LinkedAddress entity.
-func (pouo *PaymentOrderUpdateOne) SetLinkedAddress(l *LinkedAddress) *PaymentOrderUpdateOne {
-	return pouo.SetLinkedAddressID(l.ID)
+// ClearDepositFinalizedAt clears the value of the "deposit_finalized_at" field.
+func (pouo *PaymentOrderUpdateOne) ClearDepositFinalizedAt() *PaymentOrderUpdateOne {
+	pouo.mutation.ClearDepositFinalizedAt()
+	return pouo
 }
 
-// SetReceiveAddressID sets the "receive_address" edge to the ReceiveAddress entity by ID.
-func (pouo *PaymentOrderUpdateOne) SetReceiveAddressID(id int) *PaymentOrderUpdateOne {
-	pouo.mutation.SetReceiveAddressID(id)
+// SetRequiredConfirmations sets the "required_confirmations" field.
+func (pouo *PaymentOrderUpdateOne) SetRequiredConfirmations(i int) *PaymentOrderUpdateOne {
+	pouo.mutation.ResetRequiredConfirmations()
+	pouo.mutation.SetRequiredConfirmations(i)
 	return pouo
 }
 
-// SetNillableReceiveAddressID sets the "receive_address" edge to the ReceiveAddress entity by ID if the given value is not nil.
+// SetNillableRequiredConfirmations sets the "required_confirmations" field if the given value is not nil.
+func (pouo *PaymentOrderUpdateOne) SetNillableRequiredConfirmations(i *int) *PaymentOrderUpdateOne {
+	if i != nil {
+		pouo.SetRequiredConfirmations(*i)
+	}
+	return pouo
+}
+
+// AddRequiredConfirmations adds i to the "required_confirmations" field.
+func (pouo *PaymentOrderUpdateOne) AddRequiredConfirmations(i int) *PaymentOrderUpdateOne {
+	pouo.mutation.AddRequiredConfirmations(i)
+	return pouo
+}
+
+// SetSLABreachedAt sets the "sla_breached_at" field.
+func (pouo *PaymentOrderUpdateOne) SetSLABreachedAt(t time.Time) *PaymentOrderUpdateOne {
+	pouo.mutation.SetSLABreachedAt(t)
+	return pouo
+}
+
+// SetNillableSLABreachedAt sets the "sla_breached_at" field if the given value is not nil.
+func (pouo *PaymentOrderUpdateOne) SetNillableSLABreachedAt(t *time.Time) *PaymentOrderUpdateOne {
+	if t != nil {
+		pouo.SetSLABreachedAt(*t)
+	}
+	return pouo
+}
+
+// ClearSLABreachedAt clears the value of the "sla_breached_at" field.
+func (pouo *PaymentOrderUpdateOne) ClearSLABreachedAt() *PaymentOrderUpdateOne {
+	pouo.mutation.ClearSLABreachedAt()
+	return pouo
+}
+
+// SetReviewReason sets the "review_reason" field.
+func (pouo *PaymentOrderUpdateOne) SetReviewReason(s string) *PaymentOrderUpdateOne {
+	pouo.mutation.SetReviewReason(s)
+	return pouo
+}
+
+// SetNillableReviewReason sets the "review_reason" field if the given value is not nil.
+func (pouo *PaymentOrderUpdateOne) SetNillableReviewReason(s *string) *PaymentOrderUpdateOne {
+	if s != nil {
+		pouo.SetReviewReason(*s)
+	}
+	return pouo
+}
+
+// ClearReviewReason clears the value of the "review_reason" field.
+func (pouo *PaymentOrderUpdateOne) ClearReviewReason() *PaymentOrderUpdateOne {
+	pouo.mutation.ClearReviewReason()
+	return pouo
+}
+
+// SetQuarantineReason sets the "quarantine_reason" field.
+func (pouo *PaymentOrderUpdateOne) SetQuarantineReason(s string) *PaymentOrderUpdateOne {
+	pouo.mutation.SetQuarantineReason(s)
+	return pouo
+}
+
+// SetNillableQuarantineReason sets the "quarantine_reason" field if the given value is not nil.
+func (pouo *PaymentOrderUpdateOne) SetNillableQuarantineReason(s *string) *PaymentOrderUpdateOne {
+	if s != nil {
+		pouo.SetQuarantineReason(*s)
+	}
+	return pouo
+}
+
+// ClearQuarantineReason clears the value of the "quarantine_reason" field.
+func (pouo *PaymentOrderUpdateOne) ClearQuarantineReason() *PaymentOrderUpdateOne {
+	pouo.mutation.ClearQuarantineReason()
+	return pouo
+}
+
+// SetFiatAmount sets the "fiat_amount" field.
+func (pouo *PaymentOrderUpdateOne) SetFiatAmount(d decimal.Decimal) *PaymentOrderUpdateOne {
+	pouo.mutation.ResetFiatAmount()
+	pouo.mutation.SetFiatAmount(d)
+	return pouo
+}
+
+// SetNillableFiatAmount sets the "fiat_amount" field if the given value is not nil.
+func (pouo *PaymentOrderUpdateOne) SetNillableFiatAmount(d *decimal.Decimal) *PaymentOrderUpdateOne {
+	if d != nil {
+		pouo.SetFiatAmount(*d)
+	}
+	return pouo
+}
+
+// AddFiatAmount adds d to the "fiat_amount" field.
+func (pouo *PaymentOrderUpdateOne) AddFiatAmount(d decimal.Decimal) *PaymentOrderUpdateOne {
+	pouo.mutation.AddFiatAmount(d)
+	return pouo
+}
+
+// ClearFiatAmount clears the value of the "fiat_amount" field.
+func (pouo *PaymentOrderUpdateOne) ClearFiatAmount() *PaymentOrderUpdateOne {
+	pouo.mutation.ClearFiatAmount()
+	return pouo
+}
+
+// SetFiatCurrency sets the "fiat_currency" field.
+func (pouo *PaymentOrderUpdateOne) SetFiatCurrency(s string) *PaymentOrderUpdateOne {
+	pouo.mutation.SetFiatCurrency(s)
+	return pouo
+}
+
+// SetNillableFiatCurrency sets the "fiat_currency" field if the given value is not nil.
+func (pouo *PaymentOrderUpdateOne) SetNillableFiatCurrency(s *string) *PaymentOrderUpdateOne {
+	if s != nil {
+		pouo.SetFiatCurrency(*s)
+	}
+	return pouo
+}
+
+// ClearFiatCurrency clears the value of the "fiat_currency" field.
+func (pouo *PaymentOrderUpdateOne) ClearFiatCurrency() *PaymentOrderUpdateOne {
+	pouo.mutation.ClearFiatCurrency()
+	return pouo
+}
+
+// SetRateDriftTolerance sets the "rate_drift_tolerance" field.
+func (pouo *PaymentOrderUpdateOne) SetRateDriftTolerance(d decimal.Decimal) *PaymentOrderUpdateOne {
+	pouo.mutation.ResetRateDriftTolerance()
+	pouo.mutation.SetRateDriftTolerance(d)
+	return pouo
+}
+
+// SetNillableRateDriftTolerance sets the "rate_drift_tolerance" field if the given value is not nil.
+func (pouo *PaymentOrderUpdateOne) SetNillableRateDriftTolerance(d *decimal.Decimal) *PaymentOrderUpdateOne {
+	if d != nil {
+		pouo.SetRateDriftTolerance(*d)
+	}
+	return pouo
+}
+
+// AddRateDriftTolerance adds d to the "rate_drift_tolerance" field.
+func (pouo *PaymentOrderUpdateOne) AddRateDriftTolerance(d decimal.Decimal) *PaymentOrderUpdateOne {
+	pouo.mutation.AddRateDriftTolerance(d)
+	return pouo
+}
+
+// SetFiatConversion sets the "fiat_conversion" field.
+func (pouo *PaymentOrderUpdateOne) SetFiatConversion(m map[string]interface{}) *PaymentOrderUpdateOne {
+	pouo.mutation.SetFiatConversion(m)
+	return pouo
+}
+
+// ClearFiatConversion clears the value of the "fiat_conversion" field.
+func (pouo *PaymentOrderUpdateOne) ClearFiatConversion() *PaymentOrderUpdateOne {
+	pouo.mutation.ClearFiatConversion()
+	return pouo
+}
+
+// SetRateLockedUntil sets the "rate_locked_until" field.
+func (pouo *PaymentOrderUpdateOne) SetRateLockedUntil(t time.Time) *PaymentOrderUpdateOne {
+	pouo.mutation.SetRateLockedUntil(t)
+	return pouo
+}
+
+// SetNillableRateLockedUntil sets the "rate_locked_until" field if the given value is not nil.
+func (pouo *PaymentOrderUpdateOne) SetNillableRateLockedUntil(t *time.Time) *PaymentOrderUpdateOne {
+	if t != nil {
+		pouo.SetRateLockedUntil(*t)
+	}
+	return pouo
+}
+
+// ClearRateLockedUntil clears the value of the "rate_locked_until" field.
+func (pouo *PaymentOrderUpdateOne) ClearRateLockedUntil() *PaymentOrderUpdateOne {
+	pouo.mutation.ClearRateLockedUntil()
+	return pouo
+}
+
+// SetRateRequote sets the "rate_requote" field.
+func (pouo *PaymentOrderUpdateOne) SetRateRequote(m map[string]interface{}) *PaymentOrderUpdateOne {
+	pouo.mutation.SetRateRequote(m)
+	return pouo
+}
+
+// ClearRateRequote clears the value of the "rate_requote" field.
+func (pouo *PaymentOrderUpdateOne) ClearRateRequote() *PaymentOrderUpdateOne {
+	pouo.mutation.ClearRateRequote()
+	return pouo
+}
+
+// SetBlockchainService sets the "blockchain_service" field.
+func (pouo *PaymentOrderUpdateOne) SetBlockchainService(ps paymentorder.BlockchainService) *PaymentOrderUpdateOne {
+	pouo.mutation.SetBlockchainService(ps)
+	return pouo
+}
+
+// SetNillableBlockchainService sets the "blockchain_service" field if the given value is not nil.
+func (pouo *PaymentOrderUpdateOne) SetNillableBlockchainService(ps *paymentorder.BlockchainService) *PaymentOrderUpdateOne {
+	if ps != nil {
+		pouo.SetBlockchainService(*ps)
+	}
+	return pouo
+}
+
+// ClearBlockchainService clears the value of the "blockchain_service" field.
+func (pouo *PaymentOrderUpdateOne) ClearBlockchainService() *PaymentOrderUpdateOne {
+	pouo.mutation.ClearBlockchainService()
+	return pouo
+}
+
+// SetSenderProfileID sets the "sender_profile" edge to the SenderProfile entity by ID.
+func (pouo *PaymentOrderUpdateOne) SetSenderProfileID(id uuid.UUID) *PaymentOrderUpdateOne {
+	pouo.mutation.SetSenderProfileID(id)
+	return pouo
+}
+
+// SetNillableSenderProfileID sets the "sender_profile" edge to the SenderProfile entity by ID if the given value is not nil.
+func (pouo *PaymentOrderUpdateOne) SetNillableSenderProfileID(id *uuid.UUID) *PaymentOrderUpdateOne {
+	if id != nil {
+		pouo = pouo.SetSenderProfileID(*id)
+	}
+	return pouo
+}
+
+// SetSenderProfile sets the "sender_profile" edge to the SenderProfile entity.
+func (pouo *PaymentOrderUpdateOne) SetSenderProfile(s *SenderProfile) *PaymentOrderUpdateOne {
+	return pouo.SetSenderProfileID(s.ID)
+}
+
+// SetTokenID sets the "token" edge to the Token entity by ID.
+func (pouo *PaymentOrderUpdateOne) SetTokenID(id int) *PaymentOrderUpdateOne {
+	pouo.mutation.SetTokenID(id)
+	return pouo
+}
+
+// SetToken sets the "token" edge to the Token entity.
+func (pouo *PaymentOrderUpdateOne) SetToken(t *Token) *PaymentOrderUpdateOne {
+	return pouo.SetTokenID(t.ID)
+}
+
+// SetLinkedAddressID sets the "linked_address" edge to the LinkedAddress entity by ID.
+func (pouo *PaymentOrderUpdateOne) SetLinkedAddressID(id int) *PaymentOrderUpdateOne {
+	pouo.mutation.SetLinkedAddressID(id)
+	return pouo
+}
+
+// SetNillableLinkedAddressID sets the "linked_address" edge to the LinkedAddress entity by ID if the given value is not nil.
+func (pouo *PaymentOrderUpdateOne) SetNillableLinkedAddressID(id *int) *PaymentOrderUpdateOne {
+	if id != nil {
+		pouo = pouo.SetLinkedAddressID(*id)
+	}
+	return pouo
+}
+
+// SetLinkedAddress sets the "linked_address" edge to the LinkedAddress entity.
+func (pouo *PaymentOrderUpdateOne) SetLinkedAddress(l *LinkedAddress) *PaymentOrderUpdateOne {
+	return pouo.SetLinkedAddressID(l.ID)
+}
+
+// SetReceiveAddressID sets the "receive_address" edge to the ReceiveAddress entity by ID.
+func (pouo *PaymentOrderUpdateOne) SetReceiveAddressID(id int) *PaymentOrderUpdateOne {
+	pouo.mutation.SetReceiveAddressID(id)
+	return pouo
+}
+
+// SetNillableReceiveAddressID sets the "receive_address" edge to the ReceiveAddress entity by ID if the given value is not nil.
 func (pouo *PaymentOrderUpdateOne) SetNillableReceiveAddressID(id *int) *PaymentOrderUpdateOne {
 	if id != nil {
 		pouo = pouo.SetReceiveAddressID(*id)
@@ -1608,6 +2737,104 @@ func (pouo *PaymentOrderUpdateOne) SetPaymentWebhook(p *PaymentWebhook) *Payment
 	return pouo.SetPaymentWebhookID(p.ID)
 }
 
+// SetRefundSweepID sets the "refund_sweep" edge to the Sweep entity by ID.
+func (pouo *PaymentOrderUpdateOne) SetRefundSweepID(id uuid.UUID) *PaymentOrderUpdateOne {
+	pouo.mutation.SetRefundSweepID(id)
+	return pouo
+}
+
+// SetNillableRefundSweepID sets the "refund_sweep" edge to the Sweep entity by ID if the given value is not nil.
+func (pouo *PaymentOrderUpdateOne) SetNillableRefundSweepID(id *uuid.UUID) *PaymentOrderUpdateOne {
+	if id != nil {
+		pouo = pouo.SetRefundSweepID(*id)
+	}
+	return pouo
+}
+
+// SetRefundSweep sets the "refund_sweep" edge to the Sweep entity.
+func (pouo *PaymentOrderUpdateOne) SetRefundSweep(s *Sweep) *PaymentOrderUpdateOne {
+	return pouo.SetRefundSweepID(s.ID)
+}
+
+// SetDepositSplitID sets the "deposit_split" edge to the DepositSplit entity by ID.
+func (pouo *PaymentOrderUpdateOne) SetDepositSplitID(id uuid.UUID) *PaymentOrderUpdateOne {
+	pouo.mutation.SetDepositSplitID(id)
+	return pouo
+}
+
+// SetNillableDepositSplitID sets the "deposit_split" edge to the DepositSplit entity by ID if the given value is not nil.
+func (pouo *PaymentOrderUpdateOne) SetNillableDepositSplitID(id *uuid.UUID) *PaymentOrderUpdateOne {
+	if id != nil {
+		pouo = pouo.SetDepositSplitID(*id)
+	}
+	return pouo
+}
+
+// SetDepositSplit sets the "deposit_split" edge to the DepositSplit entity.
+func (pouo *PaymentOrderUpdateOne) SetDepositSplit(d *DepositSplit) *PaymentOrderUpdateOne {
+	return pouo.SetDepositSplitID(d.ID)
+}
+
+// AddLedgerEntryIDs adds the "ledger_entries" edge to the LedgerEntry entity by IDs.
+func (pouo *PaymentOrderUpdateOne) AddLedgerEntryIDs(ids ...uuid.UUID) *PaymentOrderUpdateOne {
+	pouo.mutation.AddLedgerEntryIDs(ids...)
+	return pouo
+}
+
+// AddLedgerEntries adds the "ledger_entries" edges to the LedgerEntry entity.
+func (pouo *PaymentOrderUpdateOne) AddLedgerEntries(l ...*LedgerEntry) *PaymentOrderUpdateOne {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return pouo.AddLedgerEntryIDs(ids...)
+}
+
+// AddReconciliationDiscrepancyIDs adds the "reconciliation_discrepancies" edge to the ReconciliationDiscrepancy entity by IDs.
+func (pouo *PaymentOrderUpdateOne) AddReconciliationDiscrepancyIDs(ids ...uuid.UUID) *PaymentOrderUpdateOne {
+	pouo.mutation.AddReconciliationDiscrepancyIDs(ids...)
+	return pouo
+}
+
+// AddReconciliationDiscrepancies adds the "reconciliation_discrepancies" edges to the ReconciliationDiscrepancy entity.
+func (pouo *PaymentOrderUpdateOne) AddReconciliationDiscrepancies(r ...*ReconciliationDiscrepancy) *PaymentOrderUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pouo.AddReconciliationDiscrepancyIDs(ids...)
+}
+
+// AddUnmatchedDepositIDs adds the "unmatched_deposits" edge to the UnmatchedDeposit entity by IDs.
+func (pouo *PaymentOrderUpdateOne) AddUnmatchedDepositIDs(ids ...uuid.UUID) *PaymentOrderUpdateOne {
+	pouo.mutation.AddUnmatchedDepositIDs(ids...)
+	return pouo
+}
+
+// AddUnmatchedDeposits adds the "unmatched_deposits" edges to the UnmatchedDeposit entity.
+func (pouo *PaymentOrderUpdateOne) AddUnmatchedDeposits(u ...*UnmatchedDeposit) *PaymentOrderUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return pouo.AddUnmatchedDepositIDs(ids...)
+}
+
+// AddAddressAssignmentIDs adds the "address_assignments" edge to the ReceiveAddressAssignment entity by IDs.
+func (pouo *PaymentOrderUpdateOne) AddAddressAssignmentIDs(ids ...uuid.UUID) *PaymentOrderUpdateOne {
+	pouo.mutation.AddAddressAssignmentIDs(ids...)
+	return pouo
+}
+
+// AddAddressAssignments adds the "address_assignments" edges to the ReceiveAddressAssignment entity.
+func (pouo *PaymentOrderUpdateOne) AddAddressAssignments(r ...*ReceiveAddressAssignment) *PaymentOrderUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pouo.AddAddressAssignmentIDs(ids...)
+}
+
 // Mutation returns the PaymentOrderMutation object of the builder.
 func (pouo *PaymentOrderUpdateOne) Mutation() *PaymentOrderMutation {
 	return pouo.mutation
@@ -1670,6 +2897,102 @@ func (pouo *PaymentOrderUpdateOne) ClearPaymentWebhook() *PaymentOrderUpdateOne
 	return pouo
 }
 
+// ClearRefundSweep clears the "refund_sweep" edge to the Sweep entity.
+func (pouo *PaymentOrderUpdateOne) ClearRefundSweep() *PaymentOrderUpdateOne {
+	pouo.mutation.ClearRefundSweep()
+	return pouo
+}
+
+// ClearDepositSplit clears the "deposit_split" edge to the DepositSplit entity.
+func (pouo *PaymentOrderUpdateOne) ClearDepositSplit() *PaymentOrderUpdateOne {
+	pouo.mutation.ClearDepositSplit()
+	return pouo
+}
+
+// ClearLedgerEntries clears all "ledger_entries" edges to the LedgerEntry entity.
+func (pouo *PaymentOrderUpdateOne) ClearLedgerEntries() *PaymentOrderUpdateOne {
+	pouo.mutation.ClearLedgerEntries()
+	return pouo
+}
+
+// RemoveLedgerEntryIDs removes the "ledger_entries" edge to LedgerEntry entities by IDs.
+func (pouo *PaymentOrderUpdateOne) RemoveLedgerEntryIDs(ids ...uuid.UUID) *PaymentOrderUpdateOne {
+	pouo.mutation.RemoveLedgerEntryIDs(ids...)
+	return pouo
+}
+
+// RemoveLedgerEntries removes "ledger_entries" edges to LedgerEntry entities.
+func (pouo *PaymentOrderUpdateOne) RemoveLedgerEntries(l ...*LedgerEntry) *PaymentOrderUpdateOne {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return pouo.RemoveLedgerEntryIDs(ids...)
+}
+
+// ClearReconciliationDiscrepancies clears all "reconciliation_discrepancies" edges to the ReconciliationDiscrepancy entity.
+func (pouo *PaymentOrderUpdateOne) ClearReconciliationDiscrepancies() *PaymentOrderUpdateOne {
+	pouo.mutation.ClearReconciliationDiscrepancies()
+	return pouo
+}
+
+// RemoveReconciliationDiscrepancyIDs removes the "reconciliation_discrepancies" edge to ReconciliationDiscrepancy entities by IDs.
+func (pouo *PaymentOrderUpdateOne) RemoveReconciliationDiscrepancyIDs(ids ...uuid.UUID) *PaymentOrderUpdateOne {
+	pouo.mutation.RemoveReconciliationDiscrepancyIDs(ids...)
+	return pouo
+}
+
+// RemoveReconciliationDiscrepancies removes "reconciliation_discrepancies" edges to ReconciliationDiscrepancy entities.
+func (pouo *PaymentOrderUpdateOne) RemoveReconciliationDiscrepancies(r ...*ReconciliationDiscrepancy) *PaymentOrderUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pouo.RemoveReconciliationDiscrepancyIDs(ids...)
+}
+
+// ClearUnmatchedDeposits clears all "unmatched_deposits" edges to the UnmatchedDeposit entity.
+func (pouo *PaymentOrderUpdateOne) ClearUnmatchedDeposits() *PaymentOrderUpdateOne {
+	pouo.mutation.ClearUnmatchedDeposits()
+	return pouo
+}
+
+// RemoveUnmatchedDepositIDs removes the "unmatched_deposits" edge to UnmatchedDeposit entities by IDs.
+func (pouo *PaymentOrderUpdateOne) RemoveUnmatchedDepositIDs(ids ...uuid.UUID) *PaymentOrderUpdateOne {
+	pouo.mutation.RemoveUnmatchedDepositIDs(ids...)
+	return pouo
+}
+
+// RemoveUnmatchedDeposits removes "unmatched_deposits" edges to UnmatchedDeposit entities.
+func (pouo *PaymentOrderUpdateOne) RemoveUnmatchedDeposits(u ...*UnmatchedDeposit) *PaymentOrderUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return pouo.RemoveUnmatchedDepositIDs(ids...)
+}
+
+// ClearAddressAssignments clears all "address_assignments" edges to the ReceiveAddressAssignment entity.
+func (pouo *PaymentOrderUpdateOne) ClearAddressAssignments() *PaymentOrderUpdateOne {
+	pouo.mutation.ClearAddressAssignments()
+	return pouo
+}
+
+// RemoveAddressAssignmentIDs removes the "address_assignments" edge to ReceiveAddressAssignment entities by IDs.
+func (pouo *PaymentOrderUpdateOne) RemoveAddressAssignmentIDs(ids ...uuid.UUID) *PaymentOrderUpdateOne {
+	pouo.mutation.RemoveAddressAssignmentIDs(ids...)
+	return pouo
+}
+
+// RemoveAddressAssignments removes "address_assignments" edges to ReceiveAddressAssignment entities.
+func (pouo *PaymentOrderUpdateOne) RemoveAddressAssignments(r ...*ReceiveAddressAssignment) *PaymentOrderUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pouo.RemoveAddressAssignmentIDs(ids...)
+}
+
 // Where appends a list predicates to the PaymentOrderUpdate builder.
 func (pouo *PaymentOrderUpdateOne) Where(ps ...predicate.PaymentOrder) *PaymentOrderUpdateOne {
 	pouo.mutation.Where(ps...)
@@ -1766,6 +3089,31 @@ func (pouo *PaymentOrderUpdateOne) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.status": %w`, err)}
 		}
 	}
+	if v, ok := pouo.mutation.SettlementPolicy(); ok {
+		if err := paymentorder.SettlementPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "settlement_policy", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.settlement_policy": %w`, err)}
+		}
+	}
+	if v, ok := pouo.mutation.DepositStatus(); ok {
+		if err := paymentorder.DepositStatusValidator(v); err != nil {
+			return &ValidationError{Name: "deposit_status", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.deposit_status": %w`, err)}
+		}
+	}
+	if v, ok := pouo.mutation.RequiredConfirmations(); ok {
+		if err := paymentorder.RequiredConfirmationsValidator(v); err != nil {
+			return &ValidationError{Name: "required_confirmations", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.required_confirmations": %w`, err)}
+		}
+	}
+	if v, ok := pouo.mutation.FiatCurrency(); ok {
+		if err := paymentorder.FiatCurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "fiat_currency", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.fiat_currency": %w`, err)}
+		}
+	}
+	if v, ok := pouo.mutation.BlockchainService(); ok {
+		if err := paymentorder.BlockchainServiceValidator(v); err != nil {
+			return &ValidationError{Name: "blockchain_service", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.blockchain_service": %w`, err)}
+		}
+	}
 	if pouo.mutation.TokenCleared() && len(pouo.mutation.TokenIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "PaymentOrder.token"`)
 	}
@@ -1822,6 +3170,12 @@ func (pouo *PaymentOrderUpdateOne) sqlSave(ctx context.Context) (_node *PaymentO
 	if value, ok := pouo.mutation.AddedAmountReturned(); ok {
 		_spec.AddField(paymentorder.FieldAmountReturned, field.TypeFloat64, value)
 	}
+	if value, ok := pouo.mutation.AmountOverpaid(); ok {
+		_spec.SetField(paymentorder.FieldAmountOverpaid, field.TypeFloat64, value)
+	}
+	if value, ok := pouo.mutation.AddedAmountOverpaid(); ok {
+		_spec.AddField(paymentorder.FieldAmountOverpaid, field.TypeFloat64, value)
+	}
 	if value, ok := pouo.mutation.PercentSettled(); ok {
 		_spec.SetField(paymentorder.FieldPercentSettled, field.TypeFloat64, value)
 	}
@@ -1918,6 +3272,90 @@ func (pouo *PaymentOrderUpdateOne) sqlSave(ctx context.Context) (_node *PaymentO
 	if value, ok := pouo.mutation.AddedAmountInUsd(); ok {
 		_spec.AddField(paymentorder.FieldAmountInUsd, field.TypeFloat64, value)
 	}
+	if value, ok := pouo.mutation.SettlementPolicy(); ok {
+		_spec.SetField(paymentorder.FieldSettlementPolicy, field.TypeEnum, value)
+	}
+	if value, ok := pouo.mutation.DepositStatus(); ok {
+		_spec.SetField(paymentorder.FieldDepositStatus, field.TypeEnum, value)
+	}
+	if pouo.mutation.DepositStatusCleared() {
+		_spec.ClearField(paymentorder.FieldDepositStatus, field.TypeEnum)
+	}
+	if value, ok := pouo.mutation.DepositFinalizedAt(); ok {
+		_spec.SetField(paymentorder.FieldDepositFinalizedAt, field.TypeTime, value)
+	}
+	if pouo.mutation.DepositFinalizedAtCleared() {
+		_spec.ClearField(paymentorder.FieldDepositFinalizedAt, field.TypeTime)
+	}
+	if value, ok := pouo.mutation.RequiredConfirmations(); ok {
+		_spec.SetField(paymentorder.FieldRequiredConfirmations, field.TypeInt, value)
+	}
+	if value, ok := pouo.mutation.AddedRequiredConfirmations(); ok {
+		_spec.AddField(paymentorder.FieldRequiredConfirmations, field.TypeInt, value)
+	}
+	if value, ok := pouo.mutation.SLABreachedAt(); ok {
+		_spec.SetField(paymentorder.FieldSLABreachedAt, field.TypeTime, value)
+	}
+	if pouo.mutation.SLABreachedAtCleared() {
+		_spec.ClearField(paymentorder.FieldSLABreachedAt, field.TypeTime)
+	}
+	if value, ok := pouo.mutation.ReviewReason(); ok {
+		_spec.SetField(paymentorder.FieldReviewReason, field.TypeString, value)
+	}
+	if pouo.mutation.ReviewReasonCleared() {
+		_spec.ClearField(paymentorder.FieldReviewReason, field.TypeString)
+	}
+	if value, ok := pouo.mutation.QuarantineReason(); ok {
+		_spec.SetField(paymentorder.FieldQuarantineReason, field.TypeString, value)
+	}
+	if pouo.mutation.QuarantineReasonCleared() {
+		_spec.ClearField(paymentorder.FieldQuarantineReason, field.TypeString)
+	}
+	if value, ok := pouo.mutation.FiatAmount(); ok {
+		_spec.SetField(paymentorder.FieldFiatAmount, field.TypeFloat64, value)
+	}
+	if value, ok := pouo.mutation.AddedFiatAmount(); ok {
+		_spec.AddField(paymentorder.FieldFiatAmount, field.TypeFloat64, value)
+	}
+	if pouo.mutation.FiatAmountCleared() {
+		_spec.ClearField(paymentorder.FieldFiatAmount, field.TypeFloat64)
+	}
+	if value, ok := pouo.mutation.FiatCurrency(); ok {
+		_spec.SetField(paymentorder.FieldFiatCurrency, field.TypeString, value)
+	}
+	if pouo.mutation.FiatCurrencyCleared() {
+		_spec.ClearField(paymentorder.FieldFiatCurrency, field.TypeString)
+	}
+	if value, ok := pouo.mutation.RateDriftTolerance(); ok {
+		_spec.SetField(paymentorder.FieldRateDriftTolerance, field.TypeFloat64, value)
+	}
+	if value, ok := pouo.mutation.AddedRateDriftTolerance(); ok {
+		_spec.AddField(paymentorder.FieldRateDriftTolerance, field.TypeFloat64, value)
+	}
+	if value, ok := pouo.mutation.FiatConversion(); ok {
+		_spec.SetField(paymentorder.FieldFiatConversion, field.TypeJSON, value)
+	}
+	if pouo.mutation.FiatConversionCleared() {
+		_spec.ClearField(paymentorder.FieldFiatConversion, field.TypeJSON)
+	}
+	if value, ok := pouo.mutation.RateLockedUntil(); ok {
+		_spec.SetField(paymentorder.FieldRateLockedUntil, field.TypeTime, value)
+	}
+	if pouo.mutation.RateLockedUntilCleared() {
+		_spec.ClearField(paymentorder.FieldRateLockedUntil, field.TypeTime)
+	}
+	if value, ok := pouo.mutation.RateRequote(); ok {
+		_spec.SetField(paymentorder.FieldRateRequote, field.TypeJSON, value)
+	}
+	if pouo.mutation.RateRequoteCleared() {
+		_spec.ClearField(paymentorder.FieldRateRequote, field.TypeJSON)
+	}
+	if value, ok := pouo.mutation.BlockchainService(); ok {
+		_spec.SetField(paymentorder.FieldBlockchainService, field.TypeEnum, value)
+	}
+	if pouo.mutation.BlockchainServiceCleared() {
+		_spec.ClearField(paymentorder.FieldBlockchainService, field.TypeEnum)
+	}
 	if pouo.mutation.SenderProfileCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -2137,6 +3575,244 @@ func (pouo *PaymentOrderUpdateOne) sqlSave(ctx context.Context) (_node *PaymentO
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if pouo.mutation.RefundSweepCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   paymentorder.RefundSweepTable,
+			Columns: []string{paymentorder.RefundSweepColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sweep.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pouo.mutation.RefundSweepIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   paymentorder.RefundSweepTable,
+			Columns: []string{paymentorder.RefundSweepColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sweep.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pouo.mutation.DepositSplitCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentorder.DepositSplitTable,
+			Columns: []string{paymentorder.DepositSplitColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(depositsplit.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pouo.mutation.DepositSplitIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentorder.DepositSplitTable,
+			Columns: []string{paymentorder.DepositSplitColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(depositsplit.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pouo.mutation.LedgerEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.LedgerEntriesTable,
+			Columns: []string{paymentorder.LedgerEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerentry.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pouo.mutation.RemovedLedgerEntriesIDs(); len(nodes) > 0 && !pouo.mutation.LedgerEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.LedgerEntriesTable,
+			Columns: []string{paymentorder.LedgerEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pouo.mutation.LedgerEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.LedgerEntriesTable,
+			Columns: []string{paymentorder.LedgerEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pouo.mutation.ReconciliationDiscrepanciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.ReconciliationDiscrepanciesTable,
+			Columns: []string{paymentorder.ReconciliationDiscrepanciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reconciliationdiscrepancy.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pouo.mutation.RemovedReconciliationDiscrepanciesIDs(); len(nodes) > 0 && !pouo.mutation.ReconciliationDiscrepanciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.ReconciliationDiscrepanciesTable,
+			Columns: []string{paymentorder.ReconciliationDiscrepanciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reconciliationdiscrepancy.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pouo.mutation.ReconciliationDiscrepanciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.ReconciliationDiscrepanciesTable,
+			Columns: []string{paymentorder.ReconciliationDiscrepanciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reconciliationdiscrepancy.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pouo.mutation.UnmatchedDepositsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.UnmatchedDepositsTable,
+			Columns: []string{paymentorder.UnmatchedDepositsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(unmatcheddeposit.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pouo.mutation.RemovedUnmatchedDepositsIDs(); len(nodes) > 0 && !pouo.mutation.UnmatchedDepositsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.UnmatchedDepositsTable,
+			Columns: []string{paymentorder.UnmatchedDepositsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(unmatcheddeposit.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pouo.mutation.UnmatchedDepositsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.UnmatchedDepositsTable,
+			Columns: []string{paymentorder.UnmatchedDepositsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(unmatcheddeposit.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pouo.mutation.AddressAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.AddressAssignmentsTable,
+			Columns: []string{paymentorder.AddressAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receiveaddressassignment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pouo.mutation.RemovedAddressAssignmentsIDs(); len(nodes) > 0 && !pouo.mutation.AddressAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.AddressAssignmentsTable,
+			Columns: []string{paymentorder.AddressAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receiveaddressassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pouo.mutation.AddressAssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.AddressAssignmentsTable,
+			Columns: []string{paymentorder.AddressAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receiveaddressassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &PaymentOrder{config: pouo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -30,6 +30,8 @@ type PaymentWebhook struct {
 	WebhookSecret string `json:"webhook_secret,omitempty"`
 	// CallbackURL holds the value of the "callback_url" field.
 	CallbackURL string `json:"callback_url,omitempty"`
+	// Notify provider delivering the webhook, selects how deliveries are authenticated and parsed
+	Provider paymentwebhook.Provider `json:"provider,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PaymentWebhookQuery when eager-loading is set.
 	Edges                         PaymentWebhookEdges `json:"edges"`
@@ -76,7 +78,7 @@ func (*PaymentWebhook) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case paymentwebhook.FieldWebhookID, paymentwebhook.FieldWebhookSecret, paymentwebhook.FieldCallbackURL:
+		case paymentwebhook.FieldWebhookID, paymentwebhook.FieldWebhookSecret, paymentwebhook.FieldCallbackURL, paymentwebhook.FieldProvider:
 			values[i] = new(sql.NullString)
 		case paymentwebhook.FieldCreatedAt, paymentwebhook.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -137,6 +139,12 @@ func (pw *PaymentWebhook) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pw.CallbackURL = value.String
 			}
+		case paymentwebhook.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				pw.Provider = paymentwebhook.Provider(value.String)
+			}
 		case paymentwebhook.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field network_payment_webhook", value)
@@ -211,6 +219,9 @@ func (pw *PaymentWebhook) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("callback_url=")
 	builder.WriteString(pw.CallbackURL)
+	builder.WriteString(", ")
+	builder.WriteString("provider=")
+	builder.WriteString(fmt.Sprintf("%v", pw.Provider))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,7 @@
 package paymentwebhook
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -25,6 +26,8 @@ const (
 	FieldWebhookSecret = "webhook_secret"
 	// FieldCallbackURL holds the string denoting the callback_url field in the database.
 	FieldCallbackURL = "callback_url"
+	// FieldProvider holds the string denoting the provider field in the database.
+	FieldProvider = "provider"
 	// EdgePaymentOrder holds the string denoting the payment_order edge name in mutations.
 	EdgePaymentOrder = "payment_order"
 	// EdgeNetwork holds the string denoting the network edge name in mutations.
@@ -55,6 +58,7 @@ var Columns = []string{
 	FieldWebhookID,
 	FieldWebhookSecret,
 	FieldCallbackURL,
+	FieldProvider,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "payment_webhooks"
@@ -96,6 +100,34 @@ var (
 	DefaultID func() uuid.UUID
 )
 
+// Provider defines the type for the "provider" enum field.
+type Provider string
+
+// ProviderThirdweb is the default value of the Provider enum.
+const DefaultProvider = ProviderThirdweb
+
+// Provider values.
+const (
+	ProviderThirdweb  Provider = "thirdweb"
+	ProviderAlchemy   Provider = "alchemy"
+	ProviderQuicknode Provider = "quicknode"
+	ProviderMoralis   Provider = "moralis"
+)
+
+func (pr Provider) String() string {
+	return string(pr)
+}
+
+// ProviderValidator is a validator for the "provider" field enum values. It is called by the builders before save.
+func ProviderValidator(pr Provider) error {
+	switch pr {
+	case ProviderThirdweb, ProviderAlchemy, ProviderQuicknode, ProviderMoralis:
+		return nil
+	default:
+		return fmt.Errorf("paymentwebhook: invalid enum value for provider field: %q", pr)
+	}
+}
+
 // OrderOption defines the ordering options for the PaymentWebhook queries.
 type OrderOption func(*sql.Selector)
 
@@ -129,6 +161,11 @@ func ByCallbackURL(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCallbackURL, opts...).ToFunc()
 }
 
+// ByProvider orders the results by the provider field.
+func ByProvider(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProvider, opts...).ToFunc()
+}
+
 // ByPaymentOrderField orders the results by payment_order field.
 func ByPaymentOrderField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
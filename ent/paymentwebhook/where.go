@@ -356,6 +356,26 @@ func CallbackURLContainsFold(v string) predicate.PaymentWebhook {
 	return predicate.PaymentWebhook(sql.FieldContainsFold(FieldCallbackURL, v))
 }
 
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v Provider) predicate.PaymentWebhook {
+	return predicate.PaymentWebhook(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v Provider) predicate.PaymentWebhook {
+	return predicate.PaymentWebhook(sql.FieldNEQ(FieldProvider, v))
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...Provider) predicate.PaymentWebhook {
+	return predicate.PaymentWebhook(sql.FieldIn(FieldProvider, vs...))
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...Provider) predicate.PaymentWebhook {
+	return predicate.PaymentWebhook(sql.FieldNotIn(FieldProvider, vs...))
+}
+
 // HasPaymentOrder applies the HasEdge predicate on the "payment_order" edge.
 func HasPaymentOrder() predicate.PaymentWebhook {
 	return predicate.PaymentWebhook(func(s *sql.Selector) {
@@ -72,6 +72,20 @@ func (pwc *PaymentWebhookCreate) SetCallbackURL(s string) *PaymentWebhookCreate
 	return pwc
 }
 
+// SetProvider sets the "provider" field.
+func (pwc *PaymentWebhookCreate) SetProvider(pa paymentwebhook.Provider) *PaymentWebhookCreate {
+	pwc.mutation.SetProvider(pa)
+	return pwc
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (pwc *PaymentWebhookCreate) SetNillableProvider(pa *paymentwebhook.Provider) *PaymentWebhookCreate {
+	if pa != nil {
+		pwc.SetProvider(*pa)
+	}
+	return pwc
+}
+
 // SetID sets the "id" field.
 func (pwc *PaymentWebhookCreate) SetID(u uuid.UUID) *PaymentWebhookCreate {
 	pwc.mutation.SetID(u)
@@ -167,6 +181,10 @@ func (pwc *PaymentWebhookCreate) defaults() {
 		v := paymentwebhook.DefaultUpdatedAt()
 		pwc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := pwc.mutation.Provider(); !ok {
+		v := paymentwebhook.DefaultProvider
+		pwc.mutation.SetProvider(v)
+	}
 	if _, ok := pwc.mutation.ID(); !ok {
 		v := paymentwebhook.DefaultID()
 		pwc.mutation.SetID(v)
@@ -205,6 +223,14 @@ func (pwc *PaymentWebhookCreate) check() error {
 			return &ValidationError{Name: "callback_url", err: fmt.Errorf(`ent: validator failed for field "PaymentWebhook.callback_url": %w`, err)}
 		}
 	}
+	if _, ok := pwc.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "PaymentWebhook.provider"`)}
+	}
+	if v, ok := pwc.mutation.Provider(); ok {
+		if err := paymentwebhook.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "PaymentWebhook.provider": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -261,6 +287,10 @@ func (pwc *PaymentWebhookCreate) createSpec() (*PaymentWebhook, *sqlgraph.Create
 		_spec.SetField(paymentwebhook.FieldCallbackURL, field.TypeString, value)
 		_node.CallbackURL = value
 	}
+	if value, ok := pwc.mutation.Provider(); ok {
+		_spec.SetField(paymentwebhook.FieldProvider, field.TypeEnum, value)
+		_node.Provider = value
+	}
 	if nodes := pwc.mutation.PaymentOrderIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -395,6 +425,18 @@ func (u *PaymentWebhookUpsert) UpdateCallbackURL() *PaymentWebhookUpsert {
 	return u
 }
 
+// SetProvider sets the "provider" field.
+func (u *PaymentWebhookUpsert) SetProvider(v paymentwebhook.Provider) *PaymentWebhookUpsert {
+	u.Set(paymentwebhook.FieldProvider, v)
+	return u
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *PaymentWebhookUpsert) UpdateProvider() *PaymentWebhookUpsert {
+	u.SetExcluded(paymentwebhook.FieldProvider)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -502,6 +544,20 @@ func (u *PaymentWebhookUpsertOne) UpdateCallbackURL() *PaymentWebhookUpsertOne {
 	})
 }
 
+// SetProvider sets the "provider" field.
+func (u *PaymentWebhookUpsertOne) SetProvider(v paymentwebhook.Provider) *PaymentWebhookUpsertOne {
+	return u.Update(func(s *PaymentWebhookUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *PaymentWebhookUpsertOne) UpdateProvider() *PaymentWebhookUpsertOne {
+	return u.Update(func(s *PaymentWebhookUpsert) {
+		s.UpdateProvider()
+	})
+}
+
 // Exec executes the query.
 func (u *PaymentWebhookUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -776,6 +832,20 @@ func (u *PaymentWebhookUpsertBulk) UpdateCallbackURL() *PaymentWebhookUpsertBulk
 	})
 }
 
+// SetProvider sets the "provider" field.
+func (u *PaymentWebhookUpsertBulk) SetProvider(v paymentwebhook.Provider) *PaymentWebhookUpsertBulk {
+	return u.Update(func(s *PaymentWebhookUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *PaymentWebhookUpsertBulk) UpdateProvider() *PaymentWebhookUpsertBulk {
+	return u.Update(func(s *PaymentWebhookUpsert) {
+		s.UpdateProvider()
+	})
+}
+
 // Exec executes the query.
 func (u *PaymentWebhookUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -79,6 +79,20 @@ func (pwu *PaymentWebhookUpdate) SetNillableCallbackURL(s *string) *PaymentWebho
 	return pwu
 }
 
+// SetProvider sets the "provider" field.
+func (pwu *PaymentWebhookUpdate) SetProvider(pa paymentwebhook.Provider) *PaymentWebhookUpdate {
+	pwu.mutation.SetProvider(pa)
+	return pwu
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (pwu *PaymentWebhookUpdate) SetNillableProvider(pa *paymentwebhook.Provider) *PaymentWebhookUpdate {
+	if pa != nil {
+		pwu.SetProvider(*pa)
+	}
+	return pwu
+}
+
 // SetPaymentOrderID sets the "payment_order" edge to the PaymentOrder entity by ID.
 func (pwu *PaymentWebhookUpdate) SetPaymentOrderID(id uuid.UUID) *PaymentWebhookUpdate {
 	pwu.mutation.SetPaymentOrderID(id)
@@ -187,6 +201,11 @@ func (pwu *PaymentWebhookUpdate) check() error {
 			return &ValidationError{Name: "callback_url", err: fmt.Errorf(`ent: validator failed for field "PaymentWebhook.callback_url": %w`, err)}
 		}
 	}
+	if v, ok := pwu.mutation.Provider(); ok {
+		if err := paymentwebhook.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "PaymentWebhook.provider": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -214,6 +233,9 @@ func (pwu *PaymentWebhookUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if value, ok := pwu.mutation.CallbackURL(); ok {
 		_spec.SetField(paymentwebhook.FieldCallbackURL, field.TypeString, value)
 	}
+	if value, ok := pwu.mutation.Provider(); ok {
+		_spec.SetField(paymentwebhook.FieldProvider, field.TypeEnum, value)
+	}
 	if pwu.mutation.PaymentOrderCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -340,6 +362,20 @@ func (pwuo *PaymentWebhookUpdateOne) SetNillableCallbackURL(s *string) *PaymentW
 	return pwuo
 }
 
+// SetProvider sets the "provider" field.
+func (pwuo *PaymentWebhookUpdateOne) SetProvider(pa paymentwebhook.Provider) *PaymentWebhookUpdateOne {
+	pwuo.mutation.SetProvider(pa)
+	return pwuo
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (pwuo *PaymentWebhookUpdateOne) SetNillableProvider(pa *paymentwebhook.Provider) *PaymentWebhookUpdateOne {
+	if pa != nil {
+		pwuo.SetProvider(*pa)
+	}
+	return pwuo
+}
+
 // SetPaymentOrderID sets the "payment_order" edge to the PaymentOrder entity by ID.
 func (pwuo *PaymentWebhookUpdateOne) SetPaymentOrderID(id uuid.UUID) *PaymentWebhookUpdateOne {
 	pwuo.mutation.SetPaymentOrderID(id)
@@ -461,6 +497,11 @@ func (pwuo *PaymentWebhookUpdateOne) check() error {
 			return &ValidationError{Name: "callback_url", err: fmt.Errorf(`ent: validator failed for field "PaymentWebhook.callback_url": %w`, err)}
 		}
 	}
+	if v, ok := pwuo.mutation.Provider(); ok {
+		if err := paymentwebhook.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "PaymentWebhook.provider": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -505,6 +546,9 @@ func (pwuo *PaymentWebhookUpdateOne) sqlSave(ctx context.Context) (_node *Paymen
 	if value, ok := pwuo.mutation.CallbackURL(); ok {
 		_spec.SetField(paymentwebhook.FieldCallbackURL, field.TypeString, value)
 	}
+	if value, ok := pwuo.mutation.Provider(); ok {
+		_spec.SetField(paymentwebhook.FieldProvider, field.TypeEnum, value)
+	}
 	if pwuo.mutation.PaymentOrderCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -9,9 +9,27 @@ import (
 // APIKey is the predicate function for apikey builders.
 type APIKey func(*sql.Selector)
 
+// AdminAuditLog is the predicate function for adminauditlog builders.
+type AdminAuditLog func(*sql.Selector)
+
 // BeneficialOwner is the predicate function for beneficialowner builders.
 type BeneficialOwner func(*sql.Selector)
 
+// BlockchainRoute is the predicate function for blockchainroute builders.
+type BlockchainRoute func(*sql.Selector)
+
+// DeadLetter is the predicate function for deadletter builders.
+type DeadLetter func(*sql.Selector)
+
+// DenylistedAddress is the predicate function for denylistedaddress builders.
+type DenylistedAddress func(*sql.Selector)
+
+// DepositSplit is the predicate function for depositsplit builders.
+type DepositSplit func(*sql.Selector)
+
+// FeeSchedule is the predicate function for feeschedule builders.
+type FeeSchedule func(*sql.Selector)
+
 // FiatCurrency is the predicate function for fiatcurrency builders.
 type FiatCurrency func(*sql.Selector)
 
@@ -24,18 +42,33 @@ type Institution func(*sql.Selector)
 // KYBProfile is the predicate function for kybprofile builders.
 type KYBProfile func(*sql.Selector)
 
+// LedgerAccount is the predicate function for ledgeraccount builders.
+type LedgerAccount func(*sql.Selector)
+
+// LedgerEntry is the predicate function for ledgerentry builders.
+type LedgerEntry func(*sql.Selector)
+
+// LedgerPosting is the predicate function for ledgerposting builders.
+type LedgerPosting func(*sql.Selector)
+
 // LinkedAddress is the predicate function for linkedaddress builders.
 type LinkedAddress func(*sql.Selector)
 
 // LockOrderFulfillment is the predicate function for lockorderfulfillment builders.
 type LockOrderFulfillment func(*sql.Selector)
 
+// LockOrderReassignment is the predicate function for lockorderreassignment builders.
+type LockOrderReassignment func(*sql.Selector)
+
 // LockPaymentOrder is the predicate function for lockpaymentorder builders.
 type LockPaymentOrder func(*sql.Selector)
 
 // Network is the predicate function for network builders.
 type Network func(*sql.Selector)
 
+// OutboxTransaction is the predicate function for outboxtransaction builders.
+type OutboxTransaction func(*sql.Selector)
+
 // PaymentOrder is the predicate function for paymentorder builders.
 type PaymentOrder func(*sql.Selector)
 
@@ -45,12 +78,18 @@ type PaymentOrderRecipient func(*sql.Selector)
 // PaymentWebhook is the predicate function for paymentwebhook builders.
 type PaymentWebhook func(*sql.Selector)
 
+// ProviderBalanceSnapshot is the predicate function for providerbalancesnapshot builders.
+type ProviderBalanceSnapshot func(*sql.Selector)
+
 // ProviderCurrencies is the predicate function for providercurrencies builders.
 type ProviderCurrencies func(*sql.Selector)
 
 // ProviderOrderToken is the predicate function for providerordertoken builders.
 type ProviderOrderToken func(*sql.Selector)
 
+// ProviderPerformance is the predicate function for providerperformance builders.
+type ProviderPerformance func(*sql.Selector)
+
 // ProviderProfile is the predicate function for providerprofile builders.
 type ProviderProfile func(*sql.Selector)
 
@@ -60,26 +99,50 @@ type ProviderRating func(*sql.Selector)
 // ProvisionBucket is the predicate function for provisionbucket builders.
 type ProvisionBucket func(*sql.Selector)
 
+// RPCEndpoint is the predicate function for rpcendpoint builders.
+type RPCEndpoint func(*sql.Selector)
+
 // ReceiveAddress is the predicate function for receiveaddress builders.
 type ReceiveAddress func(*sql.Selector)
 
+// ReceiveAddressAssignment is the predicate function for receiveaddressassignment builders.
+type ReceiveAddressAssignment func(*sql.Selector)
+
+// ReconciliationDiscrepancy is the predicate function for reconciliationdiscrepancy builders.
+type ReconciliationDiscrepancy func(*sql.Selector)
+
+// ReconciliationReport is the predicate function for reconciliationreport builders.
+type ReconciliationReport func(*sql.Selector)
+
 // SenderOrderToken is the predicate function for senderordertoken builders.
 type SenderOrderToken func(*sql.Selector)
 
 // SenderProfile is the predicate function for senderprofile builders.
 type SenderProfile func(*sql.Selector)
 
+// Sweep is the predicate function for sweep builders.
+type Sweep func(*sql.Selector)
+
 // Token is the predicate function for token builders.
 type Token func(*sql.Selector)
 
 // TransactionLog is the predicate function for transactionlog builders.
 type TransactionLog func(*sql.Selector)
 
+// UnmatchedDeposit is the predicate function for unmatcheddeposit builders.
+type UnmatchedDeposit func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
 
 // VerificationToken is the predicate function for verificationtoken builders.
 type VerificationToken func(*sql.Selector)
 
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
+
+// WebhookDestination is the predicate function for webhookdestination builders.
+type WebhookDestination func(*sql.Selector)
+
 // WebhookRetryAttempt is the predicate function for webhookretryattempt builders.
 type WebhookRetryAttempt func(*sql.Selector)
@@ -0,0 +1,250 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/NEDA-LABS/stablenode/ent/providerbalancesnapshot"
+	"github.com/NEDA-LABS/stablenode/ent/providerprofile"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ProviderBalanceSnapshot is the model entity for the ProviderBalanceSnapshot schema.
+type ProviderBalanceSnapshot struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind providerbalancesnapshot.Kind `json:"kind,omitempty"`
+	// Asset holds the value of the "asset" field.
+	Asset string `json:"asset,omitempty"`
+	// Network holds the value of the "network" field.
+	Network string `json:"network,omitempty"`
+	// Address holds the value of the "address" field.
+	Address string `json:"address,omitempty"`
+	// AvailableBalance holds the value of the "available_balance" field.
+	AvailableBalance decimal.Decimal `json:"available_balance,omitempty"`
+	// TotalBalance holds the value of the "total_balance" field.
+	TotalBalance decimal.Decimal `json:"total_balance,omitempty"`
+	// Threshold holds the value of the "threshold" field.
+	Threshold decimal.Decimal `json:"threshold,omitempty"`
+	// BelowThreshold holds the value of the "below_threshold" field.
+	BelowThreshold bool `json:"below_threshold,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ProviderBalanceSnapshotQuery when eager-loading is set.
+	Edges                              ProviderBalanceSnapshotEdges `json:"edges"`
+	provider_profile_balance_snapshots *string
+	selectValues                       sql.SelectValues
+}
+
+// ProviderBalanceSnapshotEdges holds the relations/edges for other nodes in the graph.
+type ProviderBalanceSnapshotEdges struct {
+	// Provider holds the value of the provider edge.
+	Provider *ProviderProfile `json:"provider,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ProviderOrErr returns the Provider value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProviderBalanceSnapshotEdges) ProviderOrErr() (*ProviderProfile, error) {
+	if e.Provider != nil {
+		return e.Provider, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: providerprofile.Label}
+	}
+	return nil, &NotLoadedError{edge: "provider"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProviderBalanceSnapshot) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case providerbalancesnapshot.FieldAvailableBalance, providerbalancesnapshot.FieldTotalBalance, providerbalancesnapshot.FieldThreshold:
+			values[i] = new(decimal.Decimal)
+		case providerbalancesnapshot.FieldBelowThreshold:
+			values[i] = new(sql.NullBool)
+		case providerbalancesnapshot.FieldKind, providerbalancesnapshot.FieldAsset, providerbalancesnapshot.FieldNetwork, providerbalancesnapshot.FieldAddress:
+			values[i] = new(sql.NullString)
+		case providerbalancesnapshot.FieldCreatedAt, providerbalancesnapshot.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case providerbalancesnapshot.FieldID:
+			values[i] = new(uuid.UUID)
+		case providerbalancesnapshot.ForeignKeys[0]: // provider_profile_balance_snapshots
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProviderBalanceSnapshot fields.
+func (pbs *ProviderBalanceSnapshot) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case providerbalancesnapshot.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				pbs.ID = *value
+			}
+		case providerbalancesnapshot.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pbs.CreatedAt = value.Time
+			}
+		case providerbalancesnapshot.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pbs.UpdatedAt = value.Time
+			}
+		case providerbalancesnapshot.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				pbs.Kind = providerbalancesnapshot.Kind(value.String)
+			}
+		case providerbalancesnapshot.FieldAsset:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field asset", values[i])
+			} else if value.Valid {
+				pbs.Asset = value.String
+			}
+		case providerbalancesnapshot.FieldNetwork:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field network", values[i])
+			} else if value.Valid {
+				pbs.Network = value.String
+			}
+		case providerbalancesnapshot.FieldAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address", values[i])
+			} else if value.Valid {
+				pbs.Address = value.String
+			}
+		case providerbalancesnapshot.FieldAvailableBalance:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field available_balance", values[i])
+			} else if value != nil {
+				pbs.AvailableBalance = *value
+			}
+		case providerbalancesnapshot.FieldTotalBalance:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field total_balance", values[i])
+			} else if value != nil {
+				pbs.TotalBalance = *value
+			}
+		case providerbalancesnapshot.FieldThreshold:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field threshold", values[i])
+			} else if value != nil {
+				pbs.Threshold = *value
+			}
+		case providerbalancesnapshot.FieldBelowThreshold:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field below_threshold", values[i])
+			} else if value.Valid {
+				pbs.BelowThreshold = value.Bool
+			}
+		case providerbalancesnapshot.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider_profile_balance_snapshots", values[i])
+			} else if value.Valid {
+				pbs.provider_profile_balance_snapshots = new(string)
+				*pbs.provider_profile_balance_snapshots = value.String
+			}
+		default:
+			pbs.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ProviderBalanceSnapshot.
+// This includes values selected through modifiers, order, etc.
+func (pbs *ProviderBalanceSnapshot) Value(name string) (ent.Value, error) {
+	return pbs.selectValues.Get(name)
+}
+
+// QueryProvider queries the "provider" edge of the ProviderBalanceSnapshot entity.
+func (pbs *ProviderBalanceSnapshot) QueryProvider() *ProviderProfileQuery {
+	return NewProviderBalanceSnapshotClient(pbs.config).QueryProvider(pbs)
+}
+
+// Update returns a builder for updating this ProviderBalanceSnapshot.
+// Note that you need to call ProviderBalanceSnapshot.Unwrap() before calling this method if this ProviderBalanceSnapshot
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pbs *ProviderBalanceSnapshot) Update() *ProviderBalanceSnapshotUpdateOne {
+	return NewProviderBalanceSnapshotClient(pbs.config).UpdateOne(pbs)
+}
+
+// Unwrap unwraps the ProviderBalanceSnapshot entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pbs *ProviderBalanceSnapshot) Unwrap() *ProviderBalanceSnapshot {
+	_tx, ok := pbs.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProviderBalanceSnapshot is not a transactional entity")
+	}
+	pbs.config.driver = _tx.drv
+	return pbs
+}
+
+// String implements the fmt.Stringer.
+func (pbs *ProviderBalanceSnapshot) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProviderBalanceSnapshot(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pbs.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(pbs.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pbs.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", pbs.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("asset=")
+	builder.WriteString(pbs.Asset)
+	builder.WriteString(", ")
+	builder.WriteString("network=")
+	builder.WriteString(pbs.Network)
+	builder.WriteString(", ")
+	builder.WriteString("address=")
+	builder.WriteString(pbs.Address)
+	builder.WriteString(", ")
+	builder.WriteString("available_balance=")
+	builder.WriteString(fmt.Sprintf("%v", pbs.AvailableBalance))
+	builder.WriteString(", ")
+	builder.WriteString("total_balance=")
+	builder.WriteString(fmt.Sprintf("%v", pbs.TotalBalance))
+	builder.WriteString(", ")
+	builder.WriteString("threshold=")
+	builder.WriteString(fmt.Sprintf("%v", pbs.Threshold))
+	builder.WriteString(", ")
+	builder.WriteString("below_threshold=")
+	builder.WriteString(fmt.Sprintf("%v", pbs.BelowThreshold))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProviderBalanceSnapshots is a parsable slice of ProviderBalanceSnapshot.
+type ProviderBalanceSnapshots []*ProviderBalanceSnapshot
@@ -0,0 +1,194 @@
+// Code generated by ent, DO NOT EDIT.
+
+package providerbalancesnapshot
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the providerbalancesnapshot type in the database.
+	Label = "provider_balance_snapshot"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldAsset holds the string denoting the asset field in the database.
+	FieldAsset = "asset"
+	// FieldNetwork holds the string denoting the network field in the database.
+	FieldNetwork = "network"
+	// FieldAddress holds the string denoting the address field in the database.
+	FieldAddress = "address"
+	// FieldAvailableBalance holds the string denoting the available_balance field in the database.
+	FieldAvailableBalance = "available_balance"
+	// FieldTotalBalance holds the string denoting the total_balance field in the database.
+	FieldTotalBalance = "total_balance"
+	// FieldThreshold holds the string denoting the threshold field in the database.
+	FieldThreshold = "threshold"
+	// FieldBelowThreshold holds the string denoting the below_threshold field in the database.
+	FieldBelowThreshold = "below_threshold"
+	// EdgeProvider holds the string denoting the provider edge name in mutations.
+	EdgeProvider = "provider"
+	// Table holds the table name of the providerbalancesnapshot in the database.
+	Table = "provider_balance_snapshots"
+	// ProviderTable is the table that holds the provider relation/edge.
+	ProviderTable = "provider_balance_snapshots"
+	// ProviderInverseTable is the table name for the ProviderProfile entity.
+	// It exists in this package in order to avoid circular dependency with the "providerprofile" package.
+	ProviderInverseTable = "provider_profiles"
+	// ProviderColumn is the table column denoting the provider relation/edge.
+	ProviderColumn = "provider_profile_balance_snapshots"
+)
+
+// Columns holds all SQL columns for providerbalancesnapshot fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldKind,
+	FieldAsset,
+	FieldNetwork,
+	FieldAddress,
+	FieldAvailableBalance,
+	FieldTotalBalance,
+	FieldThreshold,
+	FieldBelowThreshold,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "provider_balance_snapshots"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"provider_profile_balance_snapshots",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultBelowThreshold holds the default value on creation for the "below_threshold" field.
+	DefaultBelowThreshold bool
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Kind defines the type for the "kind" enum field.
+type Kind string
+
+// Kind values.
+const (
+	KindFiat    Kind = "fiat"
+	KindOnchain Kind = "onchain"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "kind" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindFiat, KindOnchain:
+		return nil
+	default:
+		return fmt.Errorf("providerbalancesnapshot: invalid enum value for kind field: %q", k)
+	}
+}
+
+// OrderOption defines the ordering options for the ProviderBalanceSnapshot queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByAsset orders the results by the asset field.
+func ByAsset(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAsset, opts...).ToFunc()
+}
+
+// ByNetwork orders the results by the network field.
+func ByNetwork(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNetwork, opts...).ToFunc()
+}
+
+// ByAddress orders the results by the address field.
+func ByAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAddress, opts...).ToFunc()
+}
+
+// ByAvailableBalance orders the results by the available_balance field.
+func ByAvailableBalance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAvailableBalance, opts...).ToFunc()
+}
+
+// ByTotalBalance orders the results by the total_balance field.
+func ByTotalBalance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalBalance, opts...).ToFunc()
+}
+
+// ByThreshold orders the results by the threshold field.
+func ByThreshold(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldThreshold, opts...).ToFunc()
+}
+
+// ByBelowThreshold orders the results by the below_threshold field.
+func ByBelowThreshold(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBelowThreshold, opts...).ToFunc()
+}
+
+// ByProviderField orders the results by provider field.
+func ByProviderField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProviderStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newProviderStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProviderInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ProviderTable, ProviderColumn),
+	)
+}
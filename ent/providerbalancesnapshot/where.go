@@ -0,0 +1,586 @@
+// Code generated by ent, DO NOT EDIT.
+
+package providerbalancesnapshot
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Asset applies equality check predicate on the "asset" field. It's identical to AssetEQ.
+func Asset(v string) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldEQ(FieldAsset, v))
+}
+
+// Network applies equality check predicate on the "network" field. It's identical to NetworkEQ.
+func Network(v string) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldEQ(FieldNetwork, v))
+}
+
+// Address applies equality check predicate on the "address" field. It's identical to AddressEQ.
+func Address(v string) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldEQ(FieldAddress, v))
+}
+
+// AvailableBalance applies equality check predicate on the "available_balance" field. It's identical to AvailableBalanceEQ.
+func AvailableBalance(v decimal.Decimal) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldEQ(FieldAvailableBalance, v))
+}
+
+// TotalBalance applies equality check predicate on the "total_balance" field. It's identical to TotalBalanceEQ.
+func TotalBalance(v decimal.Decimal) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldEQ(FieldTotalBalance, v))
+}
+
+// Threshold applies equality check predicate on the "threshold" field. It's identical to ThresholdEQ.
+func Threshold(v decimal.Decimal) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldEQ(FieldThreshold, v))
+}
+
+// BelowThreshold applies equality check predicate on the "below_threshold" field. It's identical to BelowThresholdEQ.
+func BelowThreshold(v bool) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldEQ(FieldBelowThreshold, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// AssetEQ applies the EQ predicate on the "asset" field.
+func AssetEQ(v string) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldEQ(FieldAsset, v))
+}
+
+// AssetNEQ applies the NEQ predicate on the "asset" field.
+func AssetNEQ(v string) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldNEQ(FieldAsset, v))
+}
+
+// AssetIn applies the In predicate on the "asset" field.
+func AssetIn(vs ...string) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldIn(FieldAsset, vs...))
+}
+
+// AssetNotIn applies the NotIn predicate on the "asset" field.
+func AssetNotIn(vs ...string) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldNotIn(FieldAsset, vs...))
+}
+
+// AssetGT applies the GT predicate on the "asset" field.
+func AssetGT(v string) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldGT(FieldAsset, v))
+}
+
+// AssetGTE applies the GTE predicate on the "asset" field.
+func AssetGTE(v string) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldGTE(FieldAsset, v))
+}
+
+// AssetLT applies the LT predicate on the "asset" field.
+func AssetLT(v string) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldLT(FieldAsset, v))
+}
+
+// AssetLTE applies the LTE predicate on the "asset" field.
+func AssetLTE(v string) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldLTE(FieldAsset, v))
+}
+
+// AssetContains applies the Contains predicate on the "asset" field.
+func AssetContains(v string) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldContains(FieldAsset, v))
+}
+
+// AssetHasPrefix applies the HasPrefix predicate on the "asset" field.
+func AssetHasPrefix(v string) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldHasPrefix(FieldAsset, v))
+}
+
+// AssetHasSuffix applies the HasSuffix predicate on the "asset" field.
+func AssetHasSuffix(v string) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldHasSuffix(FieldAsset, v))
+}
+
+// AssetEqualFold applies the EqualFold predicate on the "asset" field.
+func AssetEqualFold(v string) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldEqualFold(FieldAsset, v))
+}
+
+// AssetContainsFold applies the ContainsFold predicate on the "asset" field.
+func AssetContainsFold(v string) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldContainsFold(FieldAsset, v))
+}
+
+// NetworkEQ applies the EQ predicate on the "network" field.
+func NetworkEQ(v string) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldEQ(FieldNetwork, v))
+}
+
+// NetworkNEQ applies the NEQ predicate on the "network" field.
+func NetworkNEQ(v string) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldNEQ(FieldNetwork, v))
+}
+
+// NetworkIn applies the In predicate on the "network" field.
+func NetworkIn(vs ...string) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldIn(FieldNetwork, vs...))
+}
+
+// NetworkNotIn applies the NotIn predicate on the "network" field.
+func NetworkNotIn(vs ...string) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldNotIn(FieldNetwork, vs...))
+}
+
+// NetworkGT applies the GT predicate on the "network" field.
+func NetworkGT(v string) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldGT(FieldNetwork, v))
+}
+
+// NetworkGTE applies the GTE predicate on the "network" field.
+func NetworkGTE(v string) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldGTE(FieldNetwork, v))
+}
+
+// NetworkLT applies the LT predicate on the "network" field.
+func NetworkLT(v string) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldLT(FieldNetwork, v))
+}
+
+// NetworkLTE applies the LTE predicate on the "network" field.
+func NetworkLTE(v string) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldLTE(FieldNetwork, v))
+}
+
+// NetworkContains applies the Contains predicate on the "network" field.
+func NetworkContains(v string) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldContains(FieldNetwork, v))
+}
+
+// NetworkHasPrefix applies the HasPrefix predicate on the "network" field.
+func NetworkHasPrefix(v string) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldHasPrefix(FieldNetwork, v))
+}
+
+// NetworkHasSuffix applies the HasSuffix predicate on the "network" field.
+func NetworkHasSuffix(v string) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldHasSuffix(FieldNetwork, v))
+}
+
+// NetworkIsNil applies the IsNil predicate on the "network" field.
+func NetworkIsNil() predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldIsNull(FieldNetwork))
+}
+
+// NetworkNotNil applies the NotNil predicate on the "network" field.
+func NetworkNotNil() predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldNotNull(FieldNetwork))
+}
+
+// NetworkEqualFold applies the EqualFold predicate on the "network" field.
+func NetworkEqualFold(v string) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldEqualFold(FieldNetwork, v))
+}
+
+// NetworkContainsFold applies the ContainsFold predicate on the "network" field.
+func NetworkContainsFold(v string) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldContainsFold(FieldNetwork, v))
+}
+
+// AddressEQ applies the EQ predicate on the "address" field.
+func AddressEQ(v string) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldEQ(FieldAddress, v))
+}
+
+// AddressNEQ applies the NEQ predicate on the "address" field.
+func AddressNEQ(v string) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldNEQ(FieldAddress, v))
+}
+
+// AddressIn applies the In predicate on the "address" field.
+func AddressIn(vs ...string) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldIn(FieldAddress, vs...))
+}
+
+// AddressNotIn applies the NotIn predicate on the "address" field.
+func AddressNotIn(vs ...string) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldNotIn(FieldAddress, vs...))
+}
+
+// AddressGT applies the GT predicate on the "address" field.
+func AddressGT(v string) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldGT(FieldAddress, v))
+}
+
+// AddressGTE applies the GTE predicate on the "address" field.
+func AddressGTE(v string) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldGTE(FieldAddress, v))
+}
+
+// AddressLT applies the LT predicate on the "address" field.
+func AddressLT(v string) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldLT(FieldAddress, v))
+}
+
+// AddressLTE applies the LTE predicate on the "address" field.
+func AddressLTE(v string) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldLTE(FieldAddress, v))
+}
+
+// AddressContains applies the Contains predicate on the "address" field.
+func AddressContains(v string) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldContains(FieldAddress, v))
+}
+
+// AddressHasPrefix applies the HasPrefix predicate on the "address" field.
+func AddressHasPrefix(v string) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldHasPrefix(FieldAddress, v))
+}
+
+// AddressHasSuffix applies the HasSuffix predicate on the "address" field.
+func AddressHasSuffix(v string) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldHasSuffix(FieldAddress, v))
+}
+
+// AddressIsNil applies the IsNil predicate on the "address" field.
+func AddressIsNil() predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldIsNull(FieldAddress))
+}
+
+// AddressNotNil applies the NotNil predicate on the "address" field.
+func AddressNotNil() predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldNotNull(FieldAddress))
+}
+
+// AddressEqualFold applies the EqualFold predicate on the "address" field.
+func AddressEqualFold(v string) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldEqualFold(FieldAddress, v))
+}
+
+// AddressContainsFold applies the ContainsFold predicate on the "address" field.
+func AddressContainsFold(v string) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldContainsFold(FieldAddress, v))
+}
+
+// AvailableBalanceEQ applies the EQ predicate on the "available_balance" field.
+func AvailableBalanceEQ(v decimal.Decimal) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldEQ(FieldAvailableBalance, v))
+}
+
+// AvailableBalanceNEQ applies the NEQ predicate on the "available_balance" field.
+func AvailableBalanceNEQ(v decimal.Decimal) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldNEQ(FieldAvailableBalance, v))
+}
+
+// AvailableBalanceIn applies the In predicate on the "available_balance" field.
+func AvailableBalanceIn(vs ...decimal.Decimal) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldIn(FieldAvailableBalance, vs...))
+}
+
+// AvailableBalanceNotIn applies the NotIn predicate on the "available_balance" field.
+func AvailableBalanceNotIn(vs ...decimal.Decimal) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldNotIn(FieldAvailableBalance, vs...))
+}
+
+// AvailableBalanceGT applies the GT predicate on the "available_balance" field.
+func AvailableBalanceGT(v decimal.Decimal) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldGT(FieldAvailableBalance, v))
+}
+
+// AvailableBalanceGTE applies the GTE predicate on the "available_balance" field.
+func AvailableBalanceGTE(v decimal.Decimal) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldGTE(FieldAvailableBalance, v))
+}
+
+// AvailableBalanceLT applies the LT predicate on the "available_balance" field.
+func AvailableBalanceLT(v decimal.Decimal) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldLT(FieldAvailableBalance, v))
+}
+
+// AvailableBalanceLTE applies the LTE predicate on the "available_balance" field.
+func AvailableBalanceLTE(v decimal.Decimal) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldLTE(FieldAvailableBalance, v))
+}
+
+// TotalBalanceEQ applies the EQ predicate on the "total_balance" field.
+func TotalBalanceEQ(v decimal.Decimal) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldEQ(FieldTotalBalance, v))
+}
+
+// TotalBalanceNEQ applies the NEQ predicate on the "total_balance" field.
+func TotalBalanceNEQ(v decimal.Decimal) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldNEQ(FieldTotalBalance, v))
+}
+
+// TotalBalanceIn applies the In predicate on the "total_balance" field.
+func TotalBalanceIn(vs ...decimal.Decimal) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldIn(FieldTotalBalance, vs...))
+}
+
+// TotalBalanceNotIn applies the NotIn predicate on the "total_balance" field.
+func TotalBalanceNotIn(vs ...decimal.Decimal) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldNotIn(FieldTotalBalance, vs...))
+}
+
+// TotalBalanceGT applies the GT predicate on the "total_balance" field.
+func TotalBalanceGT(v decimal.Decimal) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldGT(FieldTotalBalance, v))
+}
+
+// TotalBalanceGTE applies the GTE predicate on the "total_balance" field.
+func TotalBalanceGTE(v decimal.Decimal) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldGTE(FieldTotalBalance, v))
+}
+
+// TotalBalanceLT applies the LT predicate on the "total_balance" field.
+func TotalBalanceLT(v decimal.Decimal) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldLT(FieldTotalBalance, v))
+}
+
+// TotalBalanceLTE applies the LTE predicate on the "total_balance" field.
+func TotalBalanceLTE(v decimal.Decimal) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldLTE(FieldTotalBalance, v))
+}
+
+// ThresholdEQ applies the EQ predicate on the "threshold" field.
+func ThresholdEQ(v decimal.Decimal) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldEQ(FieldThreshold, v))
+}
+
+// ThresholdNEQ applies the NEQ predicate on the "threshold" field.
+func ThresholdNEQ(v decimal.Decimal) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldNEQ(FieldThreshold, v))
+}
+
+// ThresholdIn applies the In predicate on the "threshold" field.
+func ThresholdIn(vs ...decimal.Decimal) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldIn(FieldThreshold, vs...))
+}
+
+// ThresholdNotIn applies the NotIn predicate on the "threshold" field.
+func ThresholdNotIn(vs ...decimal.Decimal) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldNotIn(FieldThreshold, vs...))
+}
+
+// ThresholdGT applies the GT predicate on the "threshold" field.
+func ThresholdGT(v decimal.Decimal) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldGT(FieldThreshold, v))
+}
+
+// ThresholdGTE applies the GTE predicate on the "threshold" field.
+func ThresholdGTE(v decimal.Decimal) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldGTE(FieldThreshold, v))
+}
+
+// ThresholdLT applies the LT predicate on the "threshold" field.
+func ThresholdLT(v decimal.Decimal) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldLT(FieldThreshold, v))
+}
+
+// ThresholdLTE applies the LTE predicate on the "threshold" field.
+func ThresholdLTE(v decimal.Decimal) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldLTE(FieldThreshold, v))
+}
+
+// BelowThresholdEQ applies the EQ predicate on the "below_threshold" field.
+func BelowThresholdEQ(v bool) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldEQ(FieldBelowThreshold, v))
+}
+
+// BelowThresholdNEQ applies the NEQ predicate on the "below_threshold" field.
+func BelowThresholdNEQ(v bool) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.FieldNEQ(FieldBelowThreshold, v))
+}
+
+// HasProvider applies the HasEdge predicate on the "provider" edge.
+func HasProvider() predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProviderTable, ProviderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProviderWith applies the HasEdge predicate on the "provider" edge with a given conditions (other predicates).
+func HasProviderWith(preds ...predicate.ProviderProfile) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(func(s *sql.Selector) {
+		step := newProviderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ProviderBalanceSnapshot) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ProviderBalanceSnapshot) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ProviderBalanceSnapshot) predicate.ProviderBalanceSnapshot {
+	return predicate.ProviderBalanceSnapshot(sql.NotPredicates(p))
+}
@@ -0,0 +1,1136 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/providerbalancesnapshot"
+	"github.com/NEDA-LABS/stablenode/ent/providerprofile"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ProviderBalanceSnapshotCreate is the builder for creating a ProviderBalanceSnapshot entity.
+type ProviderBalanceSnapshotCreate struct {
+	config
+	mutation *ProviderBalanceSnapshotMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pbsc *ProviderBalanceSnapshotCreate) SetCreatedAt(t time.Time) *ProviderBalanceSnapshotCreate {
+	pbsc.mutation.SetCreatedAt(t)
+	return pbsc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pbsc *ProviderBalanceSnapshotCreate) SetNillableCreatedAt(t *time.Time) *ProviderBalanceSnapshotCreate {
+	if t != nil {
+		pbsc.SetCreatedAt(*t)
+	}
+	return pbsc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pbsc *ProviderBalanceSnapshotCreate) SetUpdatedAt(t time.Time) *ProviderBalanceSnapshotCreate {
+	pbsc.mutation.SetUpdatedAt(t)
+	return pbsc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (pbsc *ProviderBalanceSnapshotCreate) SetNillableUpdatedAt(t *time.Time) *ProviderBalanceSnapshotCreate {
+	if t != nil {
+		pbsc.SetUpdatedAt(*t)
+	}
+	return pbsc
+}
+
+// SetKind sets the "kind" field.
+func (pbsc *ProviderBalanceSnapshotCreate) SetKind(pr providerbalancesnapshot.Kind) *ProviderBalanceSnapshotCreate {
+	pbsc.mutation.SetKind(pr)
+	return pbsc
+}
+
+// SetAsset sets the "asset" field.
+func (pbsc *ProviderBalanceSnapshotCreate) SetAsset(s string) *ProviderBalanceSnapshotCreate {
+	pbsc.mutation.SetAsset(s)
+	return pbsc
+}
+
+// SetNetwork sets the "network" field.
+func (pbsc *ProviderBalanceSnapshotCreate) SetNetwork(s string) *ProviderBalanceSnapshotCreate {
+	pbsc.mutation.SetNetwork(s)
+	return pbsc
+}
+
+// SetNillableNetwork sets the "network" field if the given value is not nil.
+func (pbsc *ProviderBalanceSnapshotCreate) SetNillableNetwork(s *string) *ProviderBalanceSnapshotCreate {
+	if s != nil {
+		pbsc.SetNetwork(*s)
+	}
+	return pbsc
+}
+
+// SetAddress sets the "address" field.
+func (pbsc *ProviderBalanceSnapshotCreate) SetAddress(s string) *ProviderBalanceSnapshotCreate {
+	pbsc.mutation.SetAddress(s)
+	return pbsc
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (pbsc *ProviderBalanceSnapshotCreate) SetNillableAddress(s *string) *ProviderBalanceSnapshotCreate {
+	if s != nil {
+		pbsc.SetAddress(*s)
+	}
+	return pbsc
+}
+
+// SetAvailableBalance sets the "available_balance" field.
+func (pbsc *ProviderBalanceSnapshotCreate) SetAvailableBalance(d decimal.Decimal) *ProviderBalanceSnapshotCreate {
+	pbsc.mutation.SetAvailableBalance(d)
+	return pbsc
+}
+
+// SetTotalBalance sets the "total_balance" field.
+func (pbsc *ProviderBalanceSnapshotCreate) SetTotalBalance(d decimal.Decimal) *ProviderBalanceSnapshotCreate {
+	pbsc.mutation.SetTotalBalance(d)
+	return pbsc
+}
+
+// SetThreshold sets the "threshold" field.
+func (pbsc *ProviderBalanceSnapshotCreate) SetThreshold(d decimal.Decimal) *ProviderBalanceSnapshotCreate {
+	pbsc.mutation.SetThreshold(d)
+	return pbsc
+}
+
+// SetBelowThreshold sets the "below_threshold" field.
+func (pbsc *ProviderBalanceSnapshotCreate) SetBelowThreshold(b bool) *ProviderBalanceSnapshotCreate {
+	pbsc.mutation.SetBelowThreshold(b)
+	return pbsc
+}
+
+// SetNillableBelowThreshold sets the "below_threshold" field if the given value is not nil.
+func (pbsc *ProviderBalanceSnapshotCreate) SetNillableBelowThreshold(b *bool) *ProviderBalanceSnapshotCreate {
+	if b != nil {
+		pbsc.SetBelowThreshold(*b)
+	}
+	return pbsc
+}
+
+// SetID sets the "id" field.
+func (pbsc *ProviderBalanceSnapshotCreate) SetID(u uuid.UUID) *ProviderBalanceSnapshotCreate {
+	pbsc.mutation.SetID(u)
+	return pbsc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (pbsc *ProviderBalanceSnapshotCreate) SetNillableID(u *uuid.UUID) *ProviderBalanceSnapshotCreate {
+	if u != nil {
+		pbsc.SetID(*u)
+	}
+	return pbsc
+}
+
+// SetProviderID sets the "provider" edge to the ProviderProfile entity by ID.
+func (pbsc *ProviderBalanceSnapshotCreate) SetProviderID(id string) *ProviderBalanceSnapshotCreate {
+	pbsc.mutation.SetProviderID(id)
+	return pbsc
+}
+
+// SetProvider sets the "provider" edge to the ProviderProfile entity.
+func (pbsc *ProviderBalanceSnapshotCreate) SetProvider(p *ProviderProfile) *ProviderBalanceSnapshotCreate {
+	return pbsc.SetProviderID(p.ID)
+}
+
+// Mutation returns the ProviderBalanceSnapshotMutation object of the builder.
+func (pbsc *ProviderBalanceSnapshotCreate) Mutation() *ProviderBalanceSnapshotMutation {
+	return pbsc.mutation
+}
+
+// Save creates the ProviderBalanceSnapshot in the database.
+func (pbsc *ProviderBalanceSnapshotCreate) Save(ctx context.Context) (*ProviderBalanceSnapshot, error) {
+	pbsc.defaults()
+	return withHooks(ctx, pbsc.sqlSave, pbsc.mutation, pbsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pbsc *ProviderBalanceSnapshotCreate) SaveX(ctx context.Context) *ProviderBalanceSnapshot {
+	v, err := pbsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pbsc *ProviderBalanceSnapshotCreate) Exec(ctx context.Context) error {
+	_, err := pbsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pbsc *ProviderBalanceSnapshotCreate) ExecX(ctx context.Context) {
+	if err := pbsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pbsc *ProviderBalanceSnapshotCreate) defaults() {
+	if _, ok := pbsc.mutation.CreatedAt(); !ok {
+		v := providerbalancesnapshot.DefaultCreatedAt()
+		pbsc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pbsc.mutation.UpdatedAt(); !ok {
+		v := providerbalancesnapshot.DefaultUpdatedAt()
+		pbsc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := pbsc.mutation.BelowThreshold(); !ok {
+		v := providerbalancesnapshot.DefaultBelowThreshold
+		pbsc.mutation.SetBelowThreshold(v)
+	}
+	if _, ok := pbsc.mutation.ID(); !ok {
+		v := providerbalancesnapshot.DefaultID()
+		pbsc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pbsc *ProviderBalanceSnapshotCreate) check() error {
+	if _, ok := pbsc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ProviderBalanceSnapshot.created_at"`)}
+	}
+	if _, ok := pbsc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ProviderBalanceSnapshot.updated_at"`)}
+	}
+	if _, ok := pbsc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "ProviderBalanceSnapshot.kind"`)}
+	}
+	if v, ok := pbsc.mutation.Kind(); ok {
+		if err := providerbalancesnapshot.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "ProviderBalanceSnapshot.kind": %w`, err)}
+		}
+	}
+	if _, ok := pbsc.mutation.Asset(); !ok {
+		return &ValidationError{Name: "asset", err: errors.New(`ent: missing required field "ProviderBalanceSnapshot.asset"`)}
+	}
+	if _, ok := pbsc.mutation.AvailableBalance(); !ok {
+		return &ValidationError{Name: "available_balance", err: errors.New(`ent: missing required field "ProviderBalanceSnapshot.available_balance"`)}
+	}
+	if _, ok := pbsc.mutation.TotalBalance(); !ok {
+		return &ValidationError{Name: "total_balance", err: errors.New(`ent: missing required field "ProviderBalanceSnapshot.total_balance"`)}
+	}
+	if _, ok := pbsc.mutation.Threshold(); !ok {
+		return &ValidationError{Name: "threshold", err: errors.New(`ent: missing required field "ProviderBalanceSnapshot.threshold"`)}
+	}
+	if _, ok := pbsc.mutation.BelowThreshold(); !ok {
+		return &ValidationError{Name: "below_threshold", err: errors.New(`ent: missing required field "ProviderBalanceSnapshot.below_threshold"`)}
+	}
+	if len(pbsc.mutation.ProviderIDs()) == 0 {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required edge "ProviderBalanceSnapshot.provider"`)}
+	}
+	return nil
+}
+
+func (pbsc *ProviderBalanceSnapshotCreate) sqlSave(ctx context.Context) (*ProviderBalanceSnapshot, error) {
+	if err := pbsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pbsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pbsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	pbsc.mutation.id = &_node.ID
+	pbsc.mutation.done = true
+	return _node, nil
+}
+
+func (pbsc *ProviderBalanceSnapshotCreate) createSpec() (*ProviderBalanceSnapshot, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProviderBalanceSnapshot{config: pbsc.config}
+		_spec = sqlgraph.NewCreateSpec(providerbalancesnapshot.Table, sqlgraph.NewFieldSpec(providerbalancesnapshot.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = pbsc.conflict
+	if id, ok := pbsc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := pbsc.mutation.CreatedAt(); ok {
+		_spec.SetField(providerbalancesnapshot.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := pbsc.mutation.UpdatedAt(); ok {
+		_spec.SetField(providerbalancesnapshot.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := pbsc.mutation.Kind(); ok {
+		_spec.SetField(providerbalancesnapshot.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := pbsc.mutation.Asset(); ok {
+		_spec.SetField(providerbalancesnapshot.FieldAsset, field.TypeString, value)
+		_node.Asset = value
+	}
+	if value, ok := pbsc.mutation.Network(); ok {
+		_spec.SetField(providerbalancesnapshot.FieldNetwork, field.TypeString, value)
+		_node.Network = value
+	}
+	if value, ok := pbsc.mutation.Address(); ok {
+		_spec.SetField(providerbalancesnapshot.FieldAddress, field.TypeString, value)
+		_node.Address = value
+	}
+	if value, ok := pbsc.mutation.AvailableBalance(); ok {
+		_spec.SetField(providerbalancesnapshot.FieldAvailableBalance, field.TypeFloat64, value)
+		_node.AvailableBalance = value
+	}
+	if value, ok := pbsc.mutation.TotalBalance(); ok {
+		_spec.SetField(providerbalancesnapshot.FieldTotalBalance, field.TypeFloat64, value)
+		_node.TotalBalance = value
+	}
+	if value, ok := pbsc.mutation.Threshold(); ok {
+		_spec.SetField(providerbalancesnapshot.FieldThreshold, field.TypeFloat64, value)
+		_node.Threshold = value
+	}
+	if value, ok := pbsc.mutation.BelowThreshold(); ok {
+		_spec.SetField(providerbalancesnapshot.FieldBelowThreshold, field.TypeBool, value)
+		_node.BelowThreshold = value
+	}
+	if nodes := pbsc.mutation.ProviderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   providerbalancesnapshot.ProviderTable,
+			Columns: []string{providerbalancesnapshot.ProviderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerprofile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.provider_profile_balance_snapshots = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProviderBalanceSnapshot.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProviderBalanceSnapshotUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (pbsc *ProviderBalanceSnapshotCreate) OnConflict(opts ...sql.ConflictOption) *ProviderBalanceSnapshotUpsertOne {
+	pbsc.conflict = opts
+	return &ProviderBalanceSnapshotUpsertOne{
+		create: pbsc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProviderBalanceSnapshot.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pbsc *ProviderBalanceSnapshotCreate) OnConflictColumns(columns ...string) *ProviderBalanceSnapshotUpsertOne {
+	pbsc.conflict = append(pbsc.conflict, sql.ConflictColumns(columns...))
+	return &ProviderBalanceSnapshotUpsertOne{
+		create: pbsc,
+	}
+}
+
+type (
+	// ProviderBalanceSnapshotUpsertOne is the builder for "upsert"-ing
+	//  one ProviderBalanceSnapshot node.
+	ProviderBalanceSnapshotUpsertOne struct {
+		create *ProviderBalanceSnapshotCreate
+	}
+
+	// ProviderBalanceSnapshotUpsert is the "OnConflict" setter.
+	ProviderBalanceSnapshotUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProviderBalanceSnapshotUpsert) SetUpdatedAt(v time.Time) *ProviderBalanceSnapshotUpsert {
+	u.Set(providerbalancesnapshot.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProviderBalanceSnapshotUpsert) UpdateUpdatedAt() *ProviderBalanceSnapshotUpsert {
+	u.SetExcluded(providerbalancesnapshot.FieldUpdatedAt)
+	return u
+}
+
+// SetKind sets the "kind" field.
+func (u *ProviderBalanceSnapshotUpsert) SetKind(v providerbalancesnapshot.Kind) *ProviderBalanceSnapshotUpsert {
+	u.Set(providerbalancesnapshot.FieldKind, v)
+	return u
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *ProviderBalanceSnapshotUpsert) UpdateKind() *ProviderBalanceSnapshotUpsert {
+	u.SetExcluded(providerbalancesnapshot.FieldKind)
+	return u
+}
+
+// SetAsset sets the "asset" field.
+func (u *ProviderBalanceSnapshotUpsert) SetAsset(v string) *ProviderBalanceSnapshotUpsert {
+	u.Set(providerbalancesnapshot.FieldAsset, v)
+	return u
+}
+
+// UpdateAsset sets the "asset" field to the value that was provided on create.
+func (u *ProviderBalanceSnapshotUpsert) UpdateAsset() *ProviderBalanceSnapshotUpsert {
+	u.SetExcluded(providerbalancesnapshot.FieldAsset)
+	return u
+}
+
+// SetNetwork sets the "network" field.
+func (u *ProviderBalanceSnapshotUpsert) SetNetwork(v string) *ProviderBalanceSnapshotUpsert {
+	u.Set(providerbalancesnapshot.FieldNetwork, v)
+	return u
+}
+
+// UpdateNetwork sets the "network" field to the value that was provided on create.
+func (u *ProviderBalanceSnapshotUpsert) UpdateNetwork() *ProviderBalanceSnapshotUpsert {
+	u.SetExcluded(providerbalancesnapshot.FieldNetwork)
+	return u
+}
+
+// ClearNetwork clears the value of the "network" field.
+func (u *ProviderBalanceSnapshotUpsert) ClearNetwork() *ProviderBalanceSnapshotUpsert {
+	u.SetNull(providerbalancesnapshot.FieldNetwork)
+	return u
+}
+
+// SetAddress sets the "address" field.
+func (u *ProviderBalanceSnapshotUpsert) SetAddress(v string) *ProviderBalanceSnapshotUpsert {
+	u.Set(providerbalancesnapshot.FieldAddress, v)
+	return u
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *ProviderBalanceSnapshotUpsert) UpdateAddress() *ProviderBalanceSnapshotUpsert {
+	u.SetExcluded(providerbalancesnapshot.FieldAddress)
+	return u
+}
+
+// ClearAddress clears the value of the "address" field.
+func (u *ProviderBalanceSnapshotUpsert) ClearAddress() *ProviderBalanceSnapshotUpsert {
+	u.SetNull(providerbalancesnapshot.FieldAddress)
+	return u
+}
+
+// SetAvailableBalance sets the "available_balance" field.
+func (u *ProviderBalanceSnapshotUpsert) SetAvailableBalance(v decimal.Decimal) *ProviderBalanceSnapshotUpsert {
+	u.Set(providerbalancesnapshot.FieldAvailableBalance, v)
+	return u
+}
+
+// UpdateAvailableBalance sets the "available_balance" field to the value that was provided on create.
+func (u *ProviderBalanceSnapshotUpsert) UpdateAvailableBalance() *ProviderBalanceSnapshotUpsert {
+	u.SetExcluded(providerbalancesnapshot.FieldAvailableBalance)
+	return u
+}
+
+// AddAvailableBalance adds v to the "available_balance" field.
+func (u *ProviderBalanceSnapshotUpsert) AddAvailableBalance(v decimal.Decimal) *ProviderBalanceSnapshotUpsert {
+	u.Add(providerbalancesnapshot.FieldAvailableBalance, v)
+	return u
+}
+
+// SetTotalBalance sets the "total_balance" field.
+func (u *ProviderBalanceSnapshotUpsert) SetTotalBalance(v decimal.Decimal) *ProviderBalanceSnapshotUpsert {
+	u.Set(providerbalancesnapshot.FieldTotalBalance, v)
+	return u
+}
+
+// UpdateTotalBalance sets the "total_balance" field to the value that was provided on create.
+func (u *ProviderBalanceSnapshotUpsert) UpdateTotalBalance() *ProviderBalanceSnapshotUpsert {
+	u.SetExcluded(providerbalancesnapshot.FieldTotalBalance)
+	return u
+}
+
+// AddTotalBalance adds v to the "total_balance" field.
+func (u *ProviderBalanceSnapshotUpsert) AddTotalBalance(v decimal.Decimal) *ProviderBalanceSnapshotUpsert {
+	u.Add(providerbalancesnapshot.FieldTotalBalance, v)
+	return u
+}
+
+// SetThreshold sets the "threshold" field.
+func (u *ProviderBalanceSnapshotUpsert) SetThreshold(v decimal.Decimal) *ProviderBalanceSnapshotUpsert {
+	u.Set(providerbalancesnapshot.FieldThreshold, v)
+	return u
+}
+
+// UpdateThreshold sets the "threshold" field to the value that was provided on create.
+func (u *ProviderBalanceSnapshotUpsert) UpdateThreshold() *ProviderBalanceSnapshotUpsert {
+	u.SetExcluded(providerbalancesnapshot.FieldThreshold)
+	return u
+}
+
+// AddThreshold adds v to the "threshold" field.
+func (u *ProviderBalanceSnapshotUpsert) AddThreshold(v decimal.Decimal) *ProviderBalanceSnapshotUpsert {
+	u.Add(providerbalancesnapshot.FieldThreshold, v)
+	return u
+}
+
+// SetBelowThreshold sets the "below_threshold" field.
+func (u *ProviderBalanceSnapshotUpsert) SetBelowThreshold(v bool) *ProviderBalanceSnapshotUpsert {
+	u.Set(providerbalancesnapshot.FieldBelowThreshold, v)
+	return u
+}
+
+// UpdateBelowThreshold sets the "below_threshold" field to the value that was provided on create.
+func (u *ProviderBalanceSnapshotUpsert) UpdateBelowThreshold() *ProviderBalanceSnapshotUpsert {
+	u.SetExcluded(providerbalancesnapshot.FieldBelowThreshold)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ProviderBalanceSnapshot.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(providerbalancesnapshot.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProviderBalanceSnapshotUpsertOne) UpdateNewValues() *ProviderBalanceSnapshotUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(providerbalancesnapshot.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(providerbalancesnapshot.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProviderBalanceSnapshot.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ProviderBalanceSnapshotUpsertOne) Ignore() *ProviderBalanceSnapshotUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProviderBalanceSnapshotUpsertOne) DoNothing() *ProviderBalanceSnapshotUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProviderBalanceSnapshotCreate.OnConflict
+// documentation for more info.
+func (u *ProviderBalanceSnapshotUpsertOne) Update(set func(*ProviderBalanceSnapshotUpsert)) *ProviderBalanceSnapshotUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProviderBalanceSnapshotUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProviderBalanceSnapshotUpsertOne) SetUpdatedAt(v time.Time) *ProviderBalanceSnapshotUpsertOne {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProviderBalanceSnapshotUpsertOne) UpdateUpdatedAt() *ProviderBalanceSnapshotUpsertOne {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *ProviderBalanceSnapshotUpsertOne) SetKind(v providerbalancesnapshot.Kind) *ProviderBalanceSnapshotUpsertOne {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *ProviderBalanceSnapshotUpsertOne) UpdateKind() *ProviderBalanceSnapshotUpsertOne {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetAsset sets the "asset" field.
+func (u *ProviderBalanceSnapshotUpsertOne) SetAsset(v string) *ProviderBalanceSnapshotUpsertOne {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.SetAsset(v)
+	})
+}
+
+// UpdateAsset sets the "asset" field to the value that was provided on create.
+func (u *ProviderBalanceSnapshotUpsertOne) UpdateAsset() *ProviderBalanceSnapshotUpsertOne {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.UpdateAsset()
+	})
+}
+
+// SetNetwork sets the "network" field.
+func (u *ProviderBalanceSnapshotUpsertOne) SetNetwork(v string) *ProviderBalanceSnapshotUpsertOne {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.SetNetwork(v)
+	})
+}
+
+// UpdateNetwork sets the "network" field to the value that was provided on create.
+func (u *ProviderBalanceSnapshotUpsertOne) UpdateNetwork() *ProviderBalanceSnapshotUpsertOne {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.UpdateNetwork()
+	})
+}
+
+// ClearNetwork clears the value of the "network" field.
+func (u *ProviderBalanceSnapshotUpsertOne) ClearNetwork() *ProviderBalanceSnapshotUpsertOne {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.ClearNetwork()
+	})
+}
+
+// SetAddress sets the "address" field.
+func (u *ProviderBalanceSnapshotUpsertOne) SetAddress(v string) *ProviderBalanceSnapshotUpsertOne {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.SetAddress(v)
+	})
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *ProviderBalanceSnapshotUpsertOne) UpdateAddress() *ProviderBalanceSnapshotUpsertOne {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.UpdateAddress()
+	})
+}
+
+// ClearAddress clears the value of the "address" field.
+func (u *ProviderBalanceSnapshotUpsertOne) ClearAddress() *ProviderBalanceSnapshotUpsertOne {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.ClearAddress()
+	})
+}
+
+// SetAvailableBalance sets the "available_balance" field.
+func (u *ProviderBalanceSnapshotUpsertOne) SetAvailableBalance(v decimal.Decimal) *ProviderBalanceSnapshotUpsertOne {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.SetAvailableBalance(v)
+	})
+}
+
+// AddAvailableBalance adds v to the "available_balance" field.
+func (u *ProviderBalanceSnapshotUpsertOne) AddAvailableBalance(v decimal.Decimal) *ProviderBalanceSnapshotUpsertOne {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.AddAvailableBalance(v)
+	})
+}
+
+// UpdateAvailableBalance sets the "available_balance" field to the value that was provided on create.
+func (u *ProviderBalanceSnapshotUpsertOne) UpdateAvailableBalance() *ProviderBalanceSnapshotUpsertOne {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.UpdateAvailableBalance()
+	})
+}
+
+// SetTotalBalance sets the "total_balance" field.
+func (u *ProviderBalanceSnapshotUpsertOne) SetTotalBalance(v decimal.Decimal) *ProviderBalanceSnapshotUpsertOne {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.SetTotalBalance(v)
+	})
+}
+
+// AddTotalBalance adds v to the "total_balance" field.
+func (u *ProviderBalanceSnapshotUpsertOne) AddTotalBalance(v decimal.Decimal) *ProviderBalanceSnapshotUpsertOne {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.AddTotalBalance(v)
+	})
+}
+
+// UpdateTotalBalance sets the "total_balance" field to the value that was provided on create.
+func (u *ProviderBalanceSnapshotUpsertOne) UpdateTotalBalance() *ProviderBalanceSnapshotUpsertOne {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.UpdateTotalBalance()
+	})
+}
+
+// SetThreshold sets the "threshold" field.
+func (u *ProviderBalanceSnapshotUpsertOne) SetThreshold(v decimal.Decimal) *ProviderBalanceSnapshotUpsertOne {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.SetThreshold(v)
+	})
+}
+
+// AddThreshold adds v to the "threshold" field.
+func (u *ProviderBalanceSnapshotUpsertOne) AddThreshold(v decimal.Decimal) *ProviderBalanceSnapshotUpsertOne {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.AddThreshold(v)
+	})
+}
+
+// UpdateThreshold sets the "threshold" field to the value that was provided on create.
+func (u *ProviderBalanceSnapshotUpsertOne) UpdateThreshold() *ProviderBalanceSnapshotUpsertOne {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.UpdateThreshold()
+	})
+}
+
+// SetBelowThreshold sets the "below_threshold" field.
+func (u *ProviderBalanceSnapshotUpsertOne) SetBelowThreshold(v bool) *ProviderBalanceSnapshotUpsertOne {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.SetBelowThreshold(v)
+	})
+}
+
+// UpdateBelowThreshold sets the "below_threshold" field to the value that was provided on create.
+func (u *ProviderBalanceSnapshotUpsertOne) UpdateBelowThreshold() *ProviderBalanceSnapshotUpsertOne {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.UpdateBelowThreshold()
+	})
+}
+
+// Exec executes the query.
+func (u *ProviderBalanceSnapshotUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProviderBalanceSnapshotCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProviderBalanceSnapshotUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ProviderBalanceSnapshotUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ProviderBalanceSnapshotUpsertOne.ID is not supported by MySQL driver. Use ProviderBalanceSnapshotUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ProviderBalanceSnapshotUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ProviderBalanceSnapshotCreateBulk is the builder for creating many ProviderBalanceSnapshot entities in bulk.
+type ProviderBalanceSnapshotCreateBulk struct {
+	config
+	err      error
+	builders []*ProviderBalanceSnapshotCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ProviderBalanceSnapshot entities in the database.
+func (pbscb *ProviderBalanceSnapshotCreateBulk) Save(ctx context.Context) ([]*ProviderBalanceSnapshot, error) {
+	if pbscb.err != nil {
+		return nil, pbscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pbscb.builders))
+	nodes := make([]*ProviderBalanceSnapshot, len(pbscb.builders))
+	mutators := make([]Mutator, len(pbscb.builders))
+	for i := range pbscb.builders {
+		func(i int, root context.Context) {
+			builder := pbscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProviderBalanceSnapshotMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pbscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pbscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pbscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pbscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pbscb *ProviderBalanceSnapshotCreateBulk) SaveX(ctx context.Context) []*ProviderBalanceSnapshot {
+	v, err := pbscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pbscb *ProviderBalanceSnapshotCreateBulk) Exec(ctx context.Context) error {
+	_, err := pbscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pbscb *ProviderBalanceSnapshotCreateBulk) ExecX(ctx context.Context) {
+	if err := pbscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProviderBalanceSnapshot.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProviderBalanceSnapshotUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (pbscb *ProviderBalanceSnapshotCreateBulk) OnConflict(opts ...sql.ConflictOption) *ProviderBalanceSnapshotUpsertBulk {
+	pbscb.conflict = opts
+	return &ProviderBalanceSnapshotUpsertBulk{
+		create: pbscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProviderBalanceSnapshot.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pbscb *ProviderBalanceSnapshotCreateBulk) OnConflictColumns(columns ...string) *ProviderBalanceSnapshotUpsertBulk {
+	pbscb.conflict = append(pbscb.conflict, sql.ConflictColumns(columns...))
+	return &ProviderBalanceSnapshotUpsertBulk{
+		create: pbscb,
+	}
+}
+
+// ProviderBalanceSnapshotUpsertBulk is the builder for "upsert"-ing
+// a bulk of ProviderBalanceSnapshot nodes.
+type ProviderBalanceSnapshotUpsertBulk struct {
+	create *ProviderBalanceSnapshotCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ProviderBalanceSnapshot.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(providerbalancesnapshot.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProviderBalanceSnapshotUpsertBulk) UpdateNewValues() *ProviderBalanceSnapshotUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(providerbalancesnapshot.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(providerbalancesnapshot.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProviderBalanceSnapshot.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ProviderBalanceSnapshotUpsertBulk) Ignore() *ProviderBalanceSnapshotUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProviderBalanceSnapshotUpsertBulk) DoNothing() *ProviderBalanceSnapshotUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProviderBalanceSnapshotCreateBulk.OnConflict
+// documentation for more info.
+func (u *ProviderBalanceSnapshotUpsertBulk) Update(set func(*ProviderBalanceSnapshotUpsert)) *ProviderBalanceSnapshotUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProviderBalanceSnapshotUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProviderBalanceSnapshotUpsertBulk) SetUpdatedAt(v time.Time) *ProviderBalanceSnapshotUpsertBulk {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProviderBalanceSnapshotUpsertBulk) UpdateUpdatedAt() *ProviderBalanceSnapshotUpsertBulk {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *ProviderBalanceSnapshotUpsertBulk) SetKind(v providerbalancesnapshot.Kind) *ProviderBalanceSnapshotUpsertBulk {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *ProviderBalanceSnapshotUpsertBulk) UpdateKind() *ProviderBalanceSnapshotUpsertBulk {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetAsset sets the "asset" field.
+func (u *ProviderBalanceSnapshotUpsertBulk) SetAsset(v string) *ProviderBalanceSnapshotUpsertBulk {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.SetAsset(v)
+	})
+}
+
+// UpdateAsset sets the "asset" field to the value that was provided on create.
+func (u *ProviderBalanceSnapshotUpsertBulk) UpdateAsset() *ProviderBalanceSnapshotUpsertBulk {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.UpdateAsset()
+	})
+}
+
+// SetNetwork sets the "network" field.
+func (u *ProviderBalanceSnapshotUpsertBulk) SetNetwork(v string) *ProviderBalanceSnapshotUpsertBulk {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.SetNetwork(v)
+	})
+}
+
+// UpdateNetwork sets the "network" field to the value that was provided on create.
+func (u *ProviderBalanceSnapshotUpsertBulk) UpdateNetwork() *ProviderBalanceSnapshotUpsertBulk {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.UpdateNetwork()
+	})
+}
+
+// ClearNetwork clears the value of the "network" field.
+func (u *ProviderBalanceSnapshotUpsertBulk) ClearNetwork() *ProviderBalanceSnapshotUpsertBulk {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.ClearNetwork()
+	})
+}
+
+// SetAddress sets the "address" field.
+func (u *ProviderBalanceSnapshotUpsertBulk) SetAddress(v string) *ProviderBalanceSnapshotUpsertBulk {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.SetAddress(v)
+	})
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *ProviderBalanceSnapshotUpsertBulk) UpdateAddress() *ProviderBalanceSnapshotUpsertBulk {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.UpdateAddress()
+	})
+}
+
+// ClearAddress clears the value of the "address" field.
+func (u *ProviderBalanceSnapshotUpsertBulk) ClearAddress() *ProviderBalanceSnapshotUpsertBulk {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.ClearAddress()
+	})
+}
+
+// SetAvailableBalance sets the "available_balance" field.
+func (u *ProviderBalanceSnapshotUpsertBulk) SetAvailableBalance(v decimal.Decimal) *ProviderBalanceSnapshotUpsertBulk {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.SetAvailableBalance(v)
+	})
+}
+
+// AddAvailableBalance adds v to the "available_balance" field.
+func (u *ProviderBalanceSnapshotUpsertBulk) AddAvailableBalance(v decimal.Decimal) *ProviderBalanceSnapshotUpsertBulk {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.AddAvailableBalance(v)
+	})
+}
+
+// UpdateAvailableBalance sets the "available_balance" field to the value that was provided on create.
+func (u *ProviderBalanceSnapshotUpsertBulk) UpdateAvailableBalance() *ProviderBalanceSnapshotUpsertBulk {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.UpdateAvailableBalance()
+	})
+}
+
+// SetTotalBalance sets the "total_balance" field.
+func (u *ProviderBalanceSnapshotUpsertBulk) SetTotalBalance(v decimal.Decimal) *ProviderBalanceSnapshotUpsertBulk {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.SetTotalBalance(v)
+	})
+}
+
+// AddTotalBalance adds v to the "total_balance" field.
+func (u *ProviderBalanceSnapshotUpsertBulk) AddTotalBalance(v decimal.Decimal) *ProviderBalanceSnapshotUpsertBulk {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.AddTotalBalance(v)
+	})
+}
+
+// UpdateTotalBalance sets the "total_balance" field to the value that was provided on create.
+func (u *ProviderBalanceSnapshotUpsertBulk) UpdateTotalBalance() *ProviderBalanceSnapshotUpsertBulk {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.UpdateTotalBalance()
+	})
+}
+
+// SetThreshold sets the "threshold" field.
+func (u *ProviderBalanceSnapshotUpsertBulk) SetThreshold(v decimal.Decimal) *ProviderBalanceSnapshotUpsertBulk {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.SetThreshold(v)
+	})
+}
+
+// AddThreshold adds v to the "threshold" field.
+func (u *ProviderBalanceSnapshotUpsertBulk) AddThreshold(v decimal.Decimal) *ProviderBalanceSnapshotUpsertBulk {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.AddThreshold(v)
+	})
+}
+
+// UpdateThreshold sets the "threshold" field to the value that was provided on create.
+func (u *ProviderBalanceSnapshotUpsertBulk) UpdateThreshold() *ProviderBalanceSnapshotUpsertBulk {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.UpdateThreshold()
+	})
+}
+
+// SetBelowThreshold sets the "below_threshold" field.
+func (u *ProviderBalanceSnapshotUpsertBulk) SetBelowThreshold(v bool) *ProviderBalanceSnapshotUpsertBulk {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.SetBelowThreshold(v)
+	})
+}
+
+// UpdateBelowThreshold sets the "below_threshold" field to the value that was provided on create.
+func (u *ProviderBalanceSnapshotUpsertBulk) UpdateBelowThreshold() *ProviderBalanceSnapshotUpsertBulk {
+	return u.Update(func(s *ProviderBalanceSnapshotUpsert) {
+		s.UpdateBelowThreshold()
+	})
+}
+
+// Exec executes the query.
+func (u *ProviderBalanceSnapshotUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ProviderBalanceSnapshotCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProviderBalanceSnapshotCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProviderBalanceSnapshotUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/NEDA-LABS/stablenode/ent/providerbalancesnapshot"
+)
+
+// ProviderBalanceSnapshotDelete is the builder for deleting a ProviderBalanceSnapshot entity.
+type ProviderBalanceSnapshotDelete struct {
+	config
+	hooks    []Hook
+	mutation *ProviderBalanceSnapshotMutation
+}
+
+// Where appends a list predicates to the ProviderBalanceSnapshotDelete builder.
+func (pbsd *ProviderBalanceSnapshotDelete) Where(ps ...predicate.ProviderBalanceSnapshot) *ProviderBalanceSnapshotDelete {
+	pbsd.mutation.Where(ps...)
+	return pbsd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pbsd *ProviderBalanceSnapshotDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, pbsd.sqlExec, pbsd.mutation, pbsd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pbsd *ProviderBalanceSnapshotDelete) ExecX(ctx context.Context) int {
+	n, err := pbsd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pbsd *ProviderBalanceSnapshotDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(providerbalancesnapshot.Table, sqlgraph.NewFieldSpec(providerbalancesnapshot.FieldID, field.TypeUUID))
+	if ps := pbsd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, pbsd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	pbsd.mutation.done = true
+	return affected, err
+}
+
+// ProviderBalanceSnapshotDeleteOne is the builder for deleting a single ProviderBalanceSnapshot entity.
+type ProviderBalanceSnapshotDeleteOne struct {
+	pbsd *ProviderBalanceSnapshotDelete
+}
+
+// Where appends a list predicates to the ProviderBalanceSnapshotDelete builder.
+func (pbsdo *ProviderBalanceSnapshotDeleteOne) Where(ps ...predicate.ProviderBalanceSnapshot) *ProviderBalanceSnapshotDeleteOne {
+	pbsdo.pbsd.mutation.Where(ps...)
+	return pbsdo
+}
+
+// Exec executes the deletion query.
+func (pbsdo *ProviderBalanceSnapshotDeleteOne) Exec(ctx context.Context) error {
+	n, err := pbsdo.pbsd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{providerbalancesnapshot.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pbsdo *ProviderBalanceSnapshotDeleteOne) ExecX(ctx context.Context) {
+	if err := pbsdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,615 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/NEDA-LABS/stablenode/ent/providerbalancesnapshot"
+	"github.com/NEDA-LABS/stablenode/ent/providerprofile"
+	"github.com/google/uuid"
+)
+
+// ProviderBalanceSnapshotQuery is the builder for querying ProviderBalanceSnapshot entities.
+type ProviderBalanceSnapshotQuery struct {
+	config
+	ctx          *QueryContext
+	order        []providerbalancesnapshot.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.ProviderBalanceSnapshot
+	withProvider *ProviderProfileQuery
+	withFKs      bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ProviderBalanceSnapshotQuery builder.
+func (pbsq *ProviderBalanceSnapshotQuery) Where(ps ...predicate.ProviderBalanceSnapshot) *ProviderBalanceSnapshotQuery {
+	pbsq.predicates = append(pbsq.predicates, ps...)
+	return pbsq
+}
+
+// Limit the number of records to be returned by this query.
+func (pbsq *ProviderBalanceSnapshotQuery) Limit(limit int) *ProviderBalanceSnapshotQuery {
+	pbsq.ctx.Limit = &limit
+	return pbsq
+}
+
+// Offset to start from.
+func (pbsq *ProviderBalanceSnapshotQuery) Offset(offset int) *ProviderBalanceSnapshotQuery {
+	pbsq.ctx.Offset = &offset
+	return pbsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (pbsq *ProviderBalanceSnapshotQuery) Unique(unique bool) *ProviderBalanceSnapshotQuery {
+	pbsq.ctx.Unique = &unique
+	return pbsq
+}
+
+// Order specifies how the records should be ordered.
+func (pbsq *ProviderBalanceSnapshotQuery) Order(o ...providerbalancesnapshot.OrderOption) *ProviderBalanceSnapshotQuery {
+	pbsq.order = append(pbsq.order, o...)
+	return pbsq
+}
+
+// QueryProvider chains the current query on the "provider" edge.
+func (pbsq *ProviderBalanceSnapshotQuery) QueryProvider() *ProviderProfileQuery {
+	query := (&ProviderProfileClient{config: pbsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pbsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pbsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerbalancesnapshot.Table, providerbalancesnapshot.FieldID, selector),
+			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, providerbalancesnapshot.ProviderTable, providerbalancesnapshot.ProviderColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pbsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ProviderBalanceSnapshot entity from the query.
+// Returns a *NotFoundError when no ProviderBalanceSnapshot was found.
+func (pbsq *ProviderBalanceSnapshotQuery) First(ctx context.Context) (*ProviderBalanceSnapshot, error) {
+	nodes, err := pbsq.Limit(1).All(setContextOp(ctx, pbsq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{providerbalancesnapshot.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (pbsq *ProviderBalanceSnapshotQuery) FirstX(ctx context.Context) *ProviderBalanceSnapshot {
+	node, err := pbsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ProviderBalanceSnapshot ID from the query.
+// Returns a *NotFoundError when no ProviderBalanceSnapshot ID was found.
+func (pbsq *ProviderBalanceSnapshotQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = pbsq.Limit(1).IDs(setContextOp(ctx, pbsq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{providerbalancesnapshot.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (pbsq *ProviderBalanceSnapshotQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := pbsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ProviderBalanceSnapshot entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ProviderBalanceSnapshot entity is found.
+// Returns a *NotFoundError when no ProviderBalanceSnapshot entities are found.
+func (pbsq *ProviderBalanceSnapshotQuery) Only(ctx context.Context) (*ProviderBalanceSnapshot, error) {
+	nodes, err := pbsq.Limit(2).All(setContextOp(ctx, pbsq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{providerbalancesnapshot.Label}
+	default:
+		return nil, &NotSingularError{providerbalancesnapshot.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (pbsq *ProviderBalanceSnapshotQuery) OnlyX(ctx context.Context) *ProviderBalanceSnapshot {
+	node, err := pbsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ProviderBalanceSnapshot ID in the query.
+// Returns a *NotSingularError when more than one ProviderBalanceSnapshot ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (pbsq *ProviderBalanceSnapshotQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = pbsq.Limit(2).IDs(setContextOp(ctx, pbsq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{providerbalancesnapshot.Label}
+	default:
+		err = &NotSingularError{providerbalancesnapshot.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (pbsq *ProviderBalanceSnapshotQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := pbsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ProviderBalanceSnapshots.
+func (pbsq *ProviderBalanceSnapshotQuery) All(ctx context.Context) ([]*ProviderBalanceSnapshot, error) {
+	ctx = setContextOp(ctx, pbsq.ctx, ent.OpQueryAll)
+	if err := pbsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ProviderBalanceSnapshot, *ProviderBalanceSnapshotQuery]()
+	return withInterceptors[[]*ProviderBalanceSnapshot](ctx, pbsq, qr, pbsq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (pbsq *ProviderBalanceSnapshotQuery) AllX(ctx context.Context) []*ProviderBalanceSnapshot {
+	nodes, err := pbsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ProviderBalanceSnapshot IDs.
+func (pbsq *ProviderBalanceSnapshotQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if pbsq.ctx.Unique == nil && pbsq.path != nil {
+		pbsq.Unique(true)
+	}
+	ctx = setContextOp(ctx, pbsq.ctx, ent.OpQueryIDs)
+	if err = pbsq.Select(providerbalancesnapshot.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (pbsq *ProviderBalanceSnapshotQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := pbsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (pbsq *ProviderBalanceSnapshotQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, pbsq.ctx, ent.OpQueryCount)
+	if err := pbsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, pbsq, querierCount[*ProviderBalanceSnapshotQuery](), pbsq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (pbsq *ProviderBalanceSnapshotQuery) CountX(ctx context.Context) int {
+	count, err := pbsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (pbsq *ProviderBalanceSnapshotQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, pbsq.ctx, ent.OpQueryExist)
+	switch _, err := pbsq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (pbsq *ProviderBalanceSnapshotQuery) ExistX(ctx context.Context) bool {
+	exist, err := pbsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ProviderBalanceSnapshotQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (pbsq *ProviderBalanceSnapshotQuery) Clone() *ProviderBalanceSnapshotQuery {
+	if pbsq == nil {
+		return nil
+	}
+	return &ProviderBalanceSnapshotQuery{
+		config:       pbsq.config,
+		ctx:          pbsq.ctx.Clone(),
+		order:        append([]providerbalancesnapshot.OrderOption{}, pbsq.order...),
+		inters:       append([]Interceptor{}, pbsq.inters...),
+		predicates:   append([]predicate.ProviderBalanceSnapshot{}, pbsq.predicates...),
+		withProvider: pbsq.withProvider.Clone(),
+		// clone intermediate query.
+		sql:  pbsq.sql.Clone(),
+		path: pbsq.path,
+	}
+}
+
+// WithProvider tells the query-builder to eager-load the nodes that are connected to
+// the "provider" edge. The optional arguments are used to configure the query builder of the edge.
+func (pbsq *ProviderBalanceSnapshotQuery) WithProvider(opts ...func(*ProviderProfileQuery)) *ProviderBalanceSnapshotQuery {
+	query := (&ProviderProfileClient{config: pbsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pbsq.withProvider = query
+	return pbsq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ProviderBalanceSnapshot.Query().
+//		GroupBy(providerbalancesnapshot.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (pbsq *ProviderBalanceSnapshotQuery) GroupBy(field string, fields ...string) *ProviderBalanceSnapshotGroupBy {
+	pbsq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ProviderBalanceSnapshotGroupBy{build: pbsq}
+	grbuild.flds = &pbsq.ctx.Fields
+	grbuild.label = providerbalancesnapshot.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.ProviderBalanceSnapshot.Query().
+//		Select(providerbalancesnapshot.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (pbsq *ProviderBalanceSnapshotQuery) Select(fields ...string) *ProviderBalanceSnapshotSelect {
+	pbsq.ctx.Fields = append(pbsq.ctx.Fields, fields...)
+	sbuild := &ProviderBalanceSnapshotSelect{ProviderBalanceSnapshotQuery: pbsq}
+	sbuild.label = providerbalancesnapshot.Label
+	sbuild.flds, sbuild.scan = &pbsq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ProviderBalanceSnapshotSelect configured with the given aggregations.
+func (pbsq *ProviderBalanceSnapshotQuery) Aggregate(fns ...AggregateFunc) *ProviderBalanceSnapshotSelect {
+	return pbsq.Select().Aggregate(fns...)
+}
+
+func (pbsq *ProviderBalanceSnapshotQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range pbsq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, pbsq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range pbsq.ctx.Fields {
+		if !providerbalancesnapshot.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if pbsq.path != nil {
+		prev, err := pbsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		pbsq.sql = prev
+	}
+	return nil
+}
+
+func (pbsq *ProviderBalanceSnapshotQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ProviderBalanceSnapshot, error) {
+	var (
+		nodes       = []*ProviderBalanceSnapshot{}
+		withFKs     = pbsq.withFKs
+		_spec       = pbsq.querySpec()
+		loadedTypes = [1]bool{
+			pbsq.withProvider != nil,
+		}
+	)
+	if pbsq.withProvider != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, providerbalancesnapshot.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ProviderBalanceSnapshot).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ProviderBalanceSnapshot{config: pbsq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, pbsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := pbsq.withProvider; query != nil {
+		if err := pbsq.loadProvider(ctx, query, nodes, nil,
+			func(n *ProviderBalanceSnapshot, e *ProviderProfile) { n.Edges.Provider = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (pbsq *ProviderBalanceSnapshotQuery) loadProvider(ctx context.Context, query *ProviderProfileQuery, nodes []*ProviderBalanceSnapshot, init func(*ProviderBalanceSnapshot), assign func(*ProviderBalanceSnapshot, *ProviderProfile)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*ProviderBalanceSnapshot)
+	for i := range nodes {
+		if nodes[i].provider_profile_balance_snapshots == nil {
+			continue
+		}
+		fk := *nodes[i].provider_profile_balance_snapshots
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(providerprofile.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "provider_profile_balance_snapshots" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (pbsq *ProviderBalanceSnapshotQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := pbsq.querySpec()
+	_spec.Node.Columns = pbsq.ctx.Fields
+	if len(pbsq.ctx.Fields) > 0 {
+		_spec.Unique = pbsq.ctx.Unique != nil && *pbsq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, pbsq.driver, _spec)
+}
+
+func (pbsq *ProviderBalanceSnapshotQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(providerbalancesnapshot.Table, providerbalancesnapshot.Columns, sqlgraph.NewFieldSpec(providerbalancesnapshot.FieldID, field.TypeUUID))
+	_spec.From = pbsq.sql
+	if unique := pbsq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if pbsq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := pbsq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, providerbalancesnapshot.FieldID)
+		for i := range fields {
+			if fields[i] != providerbalancesnapshot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := pbsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := pbsq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := pbsq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := pbsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (pbsq *ProviderBalanceSnapshotQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(pbsq.driver.Dialect())
+	t1 := builder.Table(providerbalancesnapshot.Table)
+	columns := pbsq.ctx.Fields
+	if len(columns) == 0 {
+		columns = providerbalancesnapshot.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if pbsq.sql != nil {
+		selector = pbsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if pbsq.ctx.Unique != nil && *pbsq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range pbsq.predicates {
+		p(selector)
+	}
+	for _, p := range pbsq.order {
+		p(selector)
+	}
+	if offset := pbsq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := pbsq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ProviderBalanceSnapshotGroupBy is the group-by builder for ProviderBalanceSnapshot entities.
+type ProviderBalanceSnapshotGroupBy struct {
+	selector
+	build *ProviderBalanceSnapshotQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pbsgb *ProviderBalanceSnapshotGroupBy) Aggregate(fns ...AggregateFunc) *ProviderBalanceSnapshotGroupBy {
+	pbsgb.fns = append(pbsgb.fns, fns...)
+	return pbsgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pbsgb *ProviderBalanceSnapshotGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pbsgb.build.ctx, ent.OpQueryGroupBy)
+	if err := pbsgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProviderBalanceSnapshotQuery, *ProviderBalanceSnapshotGroupBy](ctx, pbsgb.build, pbsgb, pbsgb.build.inters, v)
+}
+
+func (pbsgb *ProviderBalanceSnapshotGroupBy) sqlScan(ctx context.Context, root *ProviderBalanceSnapshotQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(pbsgb.fns))
+	for _, fn := range pbsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*pbsgb.flds)+len(pbsgb.fns))
+		for _, f := range *pbsgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*pbsgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pbsgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ProviderBalanceSnapshotSelect is the builder for selecting fields of ProviderBalanceSnapshot entities.
+type ProviderBalanceSnapshotSelect struct {
+	*ProviderBalanceSnapshotQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pbss *ProviderBalanceSnapshotSelect) Aggregate(fns ...AggregateFunc) *ProviderBalanceSnapshotSelect {
+	pbss.fns = append(pbss.fns, fns...)
+	return pbss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pbss *ProviderBalanceSnapshotSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pbss.ctx, ent.OpQuerySelect)
+	if err := pbss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProviderBalanceSnapshotQuery, *ProviderBalanceSnapshotSelect](ctx, pbss.ProviderBalanceSnapshotQuery, pbss, pbss.inters, v)
+}
+
+func (pbss *ProviderBalanceSnapshotSelect) sqlScan(ctx context.Context, root *ProviderBalanceSnapshotQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pbss.fns))
+	for _, fn := range pbss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pbss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pbss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,706 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/NEDA-LABS/stablenode/ent/providerbalancesnapshot"
+	"github.com/NEDA-LABS/stablenode/ent/providerprofile"
+	"github.com/shopspring/decimal"
+)
+
+// ProviderBalanceSnapshotUpdate is the builder for updating ProviderBalanceSnapshot entities.
+type ProviderBalanceSnapshotUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProviderBalanceSnapshotMutation
+}
+
+// Where appends a list predicates to the ProviderBalanceSnapshotUpdate builder.
+func (pbsu *ProviderBalanceSnapshotUpdate) Where(ps ...predicate.ProviderBalanceSnapshot) *ProviderBalanceSnapshotUpdate {
+	pbsu.mutation.Where(ps...)
+	return pbsu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pbsu *ProviderBalanceSnapshotUpdate) SetUpdatedAt(t time.Time) *ProviderBalanceSnapshotUpdate {
+	pbsu.mutation.SetUpdatedAt(t)
+	return pbsu
+}
+
+// SetKind sets the "kind" field.
+func (pbsu *ProviderBalanceSnapshotUpdate) SetKind(pr providerbalancesnapshot.Kind) *ProviderBalanceSnapshotUpdate {
+	pbsu.mutation.SetKind(pr)
+	return pbsu
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (pbsu *ProviderBalanceSnapshotUpdate) SetNillableKind(pr *providerbalancesnapshot.Kind) *ProviderBalanceSnapshotUpdate {
+	if pr != nil {
+		pbsu.SetKind(*pr)
+	}
+	return pbsu
+}
+
+// SetAsset sets the "asset" field.
+func (pbsu *ProviderBalanceSnapshotUpdate) SetAsset(s string) *ProviderBalanceSnapshotUpdate {
+	pbsu.mutation.SetAsset(s)
+	return pbsu
+}
+
+// SetNillableAsset sets the "asset" field if the given value is not nil.
+func (pbsu *ProviderBalanceSnapshotUpdate) SetNillableAsset(s *string) *ProviderBalanceSnapshotUpdate {
+	if s != nil {
+		pbsu.SetAsset(*s)
+	}
+	return pbsu
+}
+
+// SetNetwork sets the "network" field.
+func (pbsu *ProviderBalanceSnapshotUpdate) SetNetwork(s string) *ProviderBalanceSnapshotUpdate {
+	pbsu.mutation.SetNetwork(s)
+	return pbsu
+}
+
+// SetNillableNetwork sets the "network" field if the given value is not nil.
+func (pbsu *ProviderBalanceSnapshotUpdate) SetNillableNetwork(s *string) *ProviderBalanceSnapshotUpdate {
+	if s != nil {
+		pbsu.SetNetwork(*s)
+	}
+	return pbsu
+}
+
+// ClearNetwork clears the value of the "network" field.
+func (pbsu *ProviderBalanceSnapshotUpdate) ClearNetwork() *ProviderBalanceSnapshotUpdate {
+	pbsu.mutation.ClearNetwork()
+	return pbsu
+}
+
+// SetAddress sets the "address" field.
+func (pbsu *ProviderBalanceSnapshotUpdate) SetAddress(s string) *ProviderBalanceSnapshotUpdate {
+	pbsu.mutation.SetAddress(s)
+	return pbsu
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (pbsu *ProviderBalanceSnapshotUpdate) SetNillableAddress(s *string) *ProviderBalanceSnapshotUpdate {
+	if s != nil {
+		pbsu.SetAddress(*s)
+	}
+	return pbsu
+}
+
+// ClearAddress clears the value of the "address" field.
+func (pbsu *ProviderBalanceSnapshotUpdate) ClearAddress() *ProviderBalanceSnapshotUpdate {
+	pbsu.mutation.ClearAddress()
+	return pbsu
+}
+
+// SetAvailableBalance sets the "available_balance" field.
+func (pbsu *ProviderBalanceSnapshotUpdate) SetAvailableBalance(d decimal.Decimal) *ProviderBalanceSnapshotUpdate {
+	pbsu.mutation.ResetAvailableBalance()
+	pbsu.mutation.SetAvailableBalance(d)
+	return pbsu
+}
+
+// SetNillableAvailableBalance sets the "available_balance" field if the given value is not nil.
+func (pbsu *ProviderBalanceSnapshotUpdate) SetNillableAvailableBalance(d *decimal.Decimal) *ProviderBalanceSnapshotUpdate {
+	if d != nil {
+		pbsu.SetAvailableBalance(*d)
+	}
+	return pbsu
+}
+
+// AddAvailableBalance adds d to the "available_balance" field.
+func (pbsu *ProviderBalanceSnapshotUpdate) AddAvailableBalance(d decimal.Decimal) *ProviderBalanceSnapshotUpdate {
+	pbsu.mutation.AddAvailableBalance(d)
+	return pbsu
+}
+
+// SetTotalBalance sets the "total_balance" field.
+func (pbsu *ProviderBalanceSnapshotUpdate) SetTotalBalance(d decimal.Decimal) *ProviderBalanceSnapshotUpdate {
+	pbsu.mutation.ResetTotalBalance()
+	pbsu.mutation.SetTotalBalance(d)
+	return pbsu
+}
+
+// SetNillableTotalBalance sets the "total_balance" field if the given value is not nil.
+func (pbsu *ProviderBalanceSnapshotUpdate) SetNillableTotalBalance(d *decimal.Decimal) *ProviderBalanceSnapshotUpdate {
+	if d != nil {
+		pbsu.SetTotalBalance(*d)
+	}
+	return pbsu
+}
+
+// AddTotalBalance adds d to the "total_balance" field.
+func (pbsu *ProviderBalanceSnapshotUpdate) AddTotalBalance(d decimal.Decimal) *ProviderBalanceSnapshotUpdate {
+	pbsu.mutation.AddTotalBalance(d)
+	return pbsu
+}
+
+// SetThreshold sets the "threshold" field.
+func (pbsu *ProviderBalanceSnapshotUpdate) SetThreshold(d decimal.Decimal) *ProviderBalanceSnapshotUpdate {
+	pbsu.mutation.ResetThreshold()
+	pbsu.mutation.SetThreshold(d)
+	return pbsu
+}
+
+// SetNillableThreshold sets the "threshold" field if the given value is not nil.
+func (pbsu *ProviderBalanceSnapshotUpdate) SetNillableThreshold(d *decimal.Decimal) *ProviderBalanceSnapshotUpdate {
+	if d != nil {
+		pbsu.SetThreshold(*d)
+	}
+	return pbsu
+}
+
+// AddThreshold adds d to the "threshold" field.
+func (pbsu *ProviderBalanceSnapshotUpdate) AddThreshold(d decimal.Decimal) *ProviderBalanceSnapshotUpdate {
+	pbsu.mutation.AddThreshold(d)
+	return pbsu
+}
+
+// SetBelowThreshold sets the "below_threshold" field.
+func (pbsu *ProviderBalanceSnapshotUpdate) SetBelowThreshold(b bool) *ProviderBalanceSnapshotUpdate {
+	pbsu.mutation.SetBelowThreshold(b)
+	return pbsu
+}
+
+// SetNillableBelowThreshold sets the "below_threshold" field if the given value is not nil.
+func (pbsu *ProviderBalanceSnapshotUpdate) SetNillableBelowThreshold(b *bool) *ProviderBalanceSnapshotUpdate {
+	if b != nil {
+		pbsu.SetBelowThreshold(*b)
+	}
+	return pbsu
+}
+
+// SetProviderID sets the "provider" edge to the ProviderProfile entity by ID.
+func (pbsu *ProviderBalanceSnapshotUpdate) SetProviderID(id string) *ProviderBalanceSnapshotUpdate {
+	pbsu.mutation.SetProviderID(id)
+	return pbsu
+}
+
+// SetProvider sets the "provider" edge to the ProviderProfile entity.
+func (pbsu *ProviderBalanceSnapshotUpdate) SetProvider(p *ProviderProfile) *ProviderBalanceSnapshotUpdate {
+	return pbsu.SetProviderID(p.ID)
+}
+
+// Mutation returns the ProviderBalanceSnapshotMutation object of the builder.
+func (pbsu *ProviderBalanceSnapshotUpdate) Mutation() *ProviderBalanceSnapshotMutation {
+	return pbsu.mutation
+}
+
+// ClearProvider clears the "provider" edge to the ProviderProfile entity.
+func (pbsu *ProviderBalanceSnapshotUpdate) ClearProvider() *ProviderBalanceSnapshotUpdate {
+	pbsu.mutation.ClearProvider()
+	return pbsu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pbsu *ProviderBalanceSnapshotUpdate) Save(ctx context.Context) (int, error) {
+	pbsu.defaults()
+	return withHooks(ctx, pbsu.sqlSave, pbsu.mutation, pbsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pbsu *ProviderBalanceSnapshotUpdate) SaveX(ctx context.Context) int {
+	affected, err := pbsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pbsu *ProviderBalanceSnapshotUpdate) Exec(ctx context.Context) error {
+	_, err := pbsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pbsu *ProviderBalanceSnapshotUpdate) ExecX(ctx context.Context) {
+	if err := pbsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pbsu *ProviderBalanceSnapshotUpdate) defaults() {
+	if _, ok := pbsu.mutation.UpdatedAt(); !ok {
+		v := providerbalancesnapshot.UpdateDefaultUpdatedAt()
+		pbsu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pbsu *ProviderBalanceSnapshotUpdate) check() error {
+	if v, ok := pbsu.mutation.Kind(); ok {
+		if err := providerbalancesnapshot.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "ProviderBalanceSnapshot.kind": %w`, err)}
+		}
+	}
+	if pbsu.mutation.ProviderCleared() && len(pbsu.mutation.ProviderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProviderBalanceSnapshot.provider"`)
+	}
+	return nil
+}
+
+func (pbsu *ProviderBalanceSnapshotUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pbsu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(providerbalancesnapshot.Table, providerbalancesnapshot.Columns, sqlgraph.NewFieldSpec(providerbalancesnapshot.FieldID, field.TypeUUID))
+	if ps := pbsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pbsu.mutation.UpdatedAt(); ok {
+		_spec.SetField(providerbalancesnapshot.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := pbsu.mutation.Kind(); ok {
+		_spec.SetField(providerbalancesnapshot.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := pbsu.mutation.Asset(); ok {
+		_spec.SetField(providerbalancesnapshot.FieldAsset, field.TypeString, value)
+	}
+	if value, ok := pbsu.mutation.Network(); ok {
+		_spec.SetField(providerbalancesnapshot.FieldNetwork, field.TypeString, value)
+	}
+	if pbsu.mutation.NetworkCleared() {
+		_spec.ClearField(providerbalancesnapshot.FieldNetwork, field.TypeString)
+	}
+	if value, ok := pbsu.mutation.Address(); ok {
+		_spec.SetField(providerbalancesnapshot.FieldAddress, field.TypeString, value)
+	}
+	if pbsu.mutation.AddressCleared() {
+		_spec.ClearField(providerbalancesnapshot.FieldAddress, field.TypeString)
+	}
+	if value, ok := pbsu.mutation.AvailableBalance(); ok {
+		_spec.SetField(providerbalancesnapshot.FieldAvailableBalance, field.TypeFloat64, value)
+	}
+	if value, ok := pbsu.mutation.AddedAvailableBalance(); ok {
+		_spec.AddField(providerbalancesnapshot.FieldAvailableBalance, field.TypeFloat64, value)
+	}
+	if value, ok := pbsu.mutation.TotalBalance(); ok {
+		_spec.SetField(providerbalancesnapshot.FieldTotalBalance, field.TypeFloat64, value)
+	}
+	if value, ok := pbsu.mutation.AddedTotalBalance(); ok {
+		_spec.AddField(providerbalancesnapshot.FieldTotalBalance, field.TypeFloat64, value)
+	}
+	if value, ok := pbsu.mutation.Threshold(); ok {
+		_spec.SetField(providerbalancesnapshot.FieldThreshold, field.TypeFloat64, value)
+	}
+	if value, ok := pbsu.mutation.AddedThreshold(); ok {
+		_spec.AddField(providerbalancesnapshot.FieldThreshold, field.TypeFloat64, value)
+	}
+	if value, ok := pbsu.mutation.BelowThreshold(); ok {
+		_spec.SetField(providerbalancesnapshot.FieldBelowThreshold, field.TypeBool, value)
+	}
+	if pbsu.mutation.ProviderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   providerbalancesnapshot.ProviderTable,
+			Columns: []string{providerbalancesnapshot.ProviderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerprofile.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pbsu.mutation.ProviderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   providerbalancesnapshot.ProviderTable,
+			Columns: []string{providerbalancesnapshot.ProviderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerprofile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pbsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{providerbalancesnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pbsu.mutation.done = true
+	return n, nil
+}
+
+// ProviderBalanceSnapshotUpdateOne is the builder for updating a single ProviderBalanceSnapshot entity.
+type ProviderBalanceSnapshotUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProviderBalanceSnapshotMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pbsuo *ProviderBalanceSnapshotUpdateOne) SetUpdatedAt(t time.Time) *ProviderBalanceSnapshotUpdateOne {
+	pbsuo.mutation.SetUpdatedAt(t)
+	return pbsuo
+}
+
+// SetKind sets the "kind" field.
+func (pbsuo *ProviderBalanceSnapshotUpdateOne) SetKind(pr providerbalancesnapshot.Kind) *ProviderBalanceSnapshotUpdateOne {
+	pbsuo.mutation.SetKind(pr)
+	return pbsuo
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (pbsuo *ProviderBalanceSnapshotUpdateOne) SetNillableKind(pr *providerbalancesnapshot.Kind) *ProviderBalanceSnapshotUpdateOne {
+	if pr != nil {
+		pbsuo.SetKind(*pr)
+	}
+	return pbsuo
+}
+
+// SetAsset sets the "asset" field.
+func (pbsuo *ProviderBalanceSnapshotUpdateOne) SetAsset(s string) *ProviderBalanceSnapshotUpdateOne {
+	pbsuo.mutation.SetAsset(s)
+	return pbsuo
+}
+
+// SetNillableAsset sets the "asset" field if the given value is not nil.
+func (pbsuo *ProviderBalanceSnapshotUpdateOne) SetNillableAsset(s *string) *ProviderBalanceSnapshotUpdateOne {
+	if s != nil {
+		pbsuo.SetAsset(*s)
+	}
+	return pbsuo
+}
+
+// SetNetwork sets the "network" field.
+func (pbsuo *ProviderBalanceSnapshotUpdateOne) SetNetwork(s string) *ProviderBalanceSnapshotUpdateOne {
+	pbsuo.mutation.SetNetwork(s)
+	return pbsuo
+}
+
+// SetNillableNetwork sets the "network" field if the given value is not nil.
+func (pbsuo *ProviderBalanceSnapshotUpdateOne) SetNillableNetwork(s *string) *ProviderBalanceSnapshotUpdateOne {
+	if s != nil {
+		pbsuo.SetNetwork(*s)
+	}
+	return pbsuo
+}
+
+// ClearNetwork clears the value of the "network" field.
+func (pbsuo *ProviderBalanceSnapshotUpdateOne) ClearNetwork() *ProviderBalanceSnapshotUpdateOne {
+	pbsuo.mutation.ClearNetwork()
+	return pbsuo
+}
+
+// SetAddress sets the "address" field.
+func (pbsuo *ProviderBalanceSnapshotUpdateOne) SetAddress(s string) *ProviderBalanceSnapshotUpdateOne {
+	pbsuo.mutation.SetAddress(s)
+	return pbsuo
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (pbsuo *ProviderBalanceSnapshotUpdateOne) SetNillableAddress(s *string) *ProviderBalanceSnapshotUpdateOne {
+	if s != nil {
+		pbsuo.SetAddress(*s)
+	}
+	return pbsuo
+}
+
+// ClearAddress clears the value of the "address" field.
+func (pbsuo *ProviderBalanceSnapshotUpdateOne) ClearAddress() *ProviderBalanceSnapshotUpdateOne {
+	pbsuo.mutation.ClearAddress()
+	return pbsuo
+}
+
+// SetAvailableBalance sets the "available_balance" field.
+func (pbsuo *ProviderBalanceSnapshotUpdateOne) SetAvailableBalance(d decimal.Decimal) *ProviderBalanceSnapshotUpdateOne {
+	pbsuo.mutation.ResetAvailableBalance()
+	pbsuo.mutation.SetAvailableBalance(d)
+	return pbsuo
+}
+
+// SetNillableAvailableBalance sets the "available_balance" field if the given value is not nil.
+func (pbsuo *ProviderBalanceSnapshotUpdateOne) SetNillableAvailableBalance(d *decimal.Decimal) *ProviderBalanceSnapshotUpdateOne {
+	if d != nil {
+		pbsuo.SetAvailableBalance(*d)
+	}
+	return pbsuo
+}
+
+// AddAvailableBalance adds d to the "available_balance" field.
+func (pbsuo *ProviderBalanceSnapshotUpdateOne) AddAvailableBalance(d decimal.Decimal) *ProviderBalanceSnapshotUpdateOne {
+	pbsuo.mutation.AddAvailableBalance(d)
+	return pbsuo
+}
+
+// SetTotalBalance sets the "total_balance" field.
+func (pbsuo *ProviderBalanceSnapshotUpdateOne) SetTotalBalance(d decimal.Decimal) *ProviderBalanceSnapshotUpdateOne {
+	pbsuo.mutation.ResetTotalBalance()
+	pbsuo.mutation.SetTotalBalance(d)
+	return pbsuo
+}
+
+// SetNillableTotalBalance sets the "total_balance" field if the given value is not nil.
+func (pbsuo *ProviderBalanceSnapshotUpdateOne) SetNillableTotalBalance(d *decimal.Decimal) *ProviderBalanceSnapshotUpdateOne {
+	if d != nil {
+		pbsuo.SetTotalBalance(*d)
+	}
+	return pbsuo
+}
+
+// AddTotalBalance adds d to the "total_balance" field.
+func (pbsuo *ProviderBalanceSnapshotUpdateOne) AddTotalBalance(d decimal.Decimal) *ProviderBalanceSnapshotUpdateOne {
+	pbsuo.mutation.AddTotalBalance(d)
+	return pbsuo
+}
+
+// SetThreshold sets the "threshold" field.
+func (pbsuo *ProviderBalanceSnapshotUpdateOne) SetThreshold(d decimal.Decimal) *ProviderBalanceSnapshotUpdateOne {
+	pbsuo.mutation.ResetThreshold()
+	pbsuo.mutation.SetThreshold(d)
+	return pbsuo
+}
+
+// SetNillableThreshold sets the "threshold" field if the given value is not nil.
+func (pbsuo *ProviderBalanceSnapshotUpdateOne) SetNillableThreshold(d *decimal.Decimal) *ProviderBalanceSnapshotUpdateOne {
+	if d != nil {
+		pbsuo.SetThreshold(*d)
+	}
+	return pbsuo
+}
+
+// AddThreshold adds d to the "threshold" field.
+func (pbsuo *ProviderBalanceSnapshotUpdateOne) AddThreshold(d decimal.Decimal) *ProviderBalanceSnapshotUpdateOne {
+	pbsuo.mutation.AddThreshold(d)
+	return pbsuo
+}
+
+// SetBelowThreshold sets the "below_threshold" field.
+func (pbsuo *ProviderBalanceSnapshotUpdateOne) SetBelowThreshold(b bool) *ProviderBalanceSnapshotUpdateOne {
+	pbsuo.mutation.SetBelowThreshold(b)
+	return pbsuo
+}
+
+// SetNillableBelowThreshold sets the "below_threshold" field if the given value is not nil.
+func (pbsuo *ProviderBalanceSnapshotUpdateOne) SetNillableBelowThreshold(b *bool) *ProviderBalanceSnapshotUpdateOne {
+	if b != nil {
+		pbsuo.SetBelowThreshold(*b)
+	}
+	return pbsuo
+}
+
+// SetProviderID sets the "provider" edge to the ProviderProfile entity by ID.
+func (pbsuo *ProviderBalanceSnapshotUpdateOne) SetProviderID(id string) *ProviderBalanceSnapshotUpdateOne {
+	pbsuo.mutation.SetProviderID(id)
+	return pbsuo
+}
+
+// SetProvider sets the "provider" edge to the ProviderProfile entity.
+func (pbsuo *ProviderBalanceSnapshotUpdateOne) SetProvider(p *ProviderProfile) *ProviderBalanceSnapshotUpdateOne {
+	return pbsuo.SetProviderID(p.ID)
+}
+
+// Mutation returns the ProviderBalanceSnapshotMutation object of the builder.
+func (pbsuo *ProviderBalanceSnapshotUpdateOne) Mutation() *ProviderBalanceSnapshotMutation {
+	return pbsuo.mutation
+}
+
+// ClearProvider clears the "provider" edge to the ProviderProfile entity.
+func (pbsuo *ProviderBalanceSnapshotUpdateOne) ClearProvider() *ProviderBalanceSnapshotUpdateOne {
+	pbsuo.mutation.ClearProvider()
+	return pbsuo
+}
+
+// Where appends a list predicates to the ProviderBalanceSnapshotUpdate builder.
+func (pbsuo *ProviderBalanceSnapshotUpdateOne) Where(ps ...predicate.ProviderBalanceSnapshot) *ProviderBalanceSnapshotUpdateOne {
+	pbsuo.mutation.Where(ps...)
+	return pbsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pbsuo *ProviderBalanceSnapshotUpdateOne) Select(field string, fields ...string) *ProviderBalanceSnapshotUpdateOne {
+	pbsuo.fields = append([]string{field}, fields...)
+	return pbsuo
+}
+
+// Save executes the query and returns the updated ProviderBalanceSnapshot entity.
+func (pbsuo *ProviderBalanceSnapshotUpdateOne) Save(ctx context.Context) (*ProviderBalanceSnapshot, error) {
+	pbsuo.defaults()
+	return withHooks(ctx, pbsuo.sqlSave, pbsuo.mutation, pbsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pbsuo *ProviderBalanceSnapshotUpdateOne) SaveX(ctx context.Context) *ProviderBalanceSnapshot {
+	node, err := pbsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pbsuo *ProviderBalanceSnapshotUpdateOne) Exec(ctx context.Context) error {
+	_, err := pbsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pbsuo *ProviderBalanceSnapshotUpdateOne) ExecX(ctx context.Context) {
+	if err := pbsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pbsuo *ProviderBalanceSnapshotUpdateOne) defaults() {
+	if _, ok := pbsuo.mutation.UpdatedAt(); !ok {
+		v := providerbalancesnapshot.UpdateDefaultUpdatedAt()
+		pbsuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pbsuo *ProviderBalanceSnapshotUpdateOne) check() error {
+	if v, ok := pbsuo.mutation.Kind(); ok {
+		if err := providerbalancesnapshot.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "ProviderBalanceSnapshot.kind": %w`, err)}
+		}
+	}
+	if pbsuo.mutation.ProviderCleared() && len(pbsuo.mutation.ProviderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProviderBalanceSnapshot.provider"`)
+	}
+	return nil
+}
+
+func (pbsuo *ProviderBalanceSnapshotUpdateOne) sqlSave(ctx context.Context) (_node *ProviderBalanceSnapshot, err error) {
+	if err := pbsuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(providerbalancesnapshot.Table, providerbalancesnapshot.Columns, sqlgraph.NewFieldSpec(providerbalancesnapshot.FieldID, field.TypeUUID))
+	id, ok := pbsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProviderBalanceSnapshot.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pbsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, providerbalancesnapshot.FieldID)
+		for _, f := range fields {
+			if !providerbalancesnapshot.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != providerbalancesnapshot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pbsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pbsuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(providerbalancesnapshot.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := pbsuo.mutation.Kind(); ok {
+		_spec.SetField(providerbalancesnapshot.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := pbsuo.mutation.Asset(); ok {
+		_spec.SetField(providerbalancesnapshot.FieldAsset, field.TypeString, value)
+	}
+	if value, ok := pbsuo.mutation.Network(); ok {
+		_spec.SetField(providerbalancesnapshot.FieldNetwork, field.TypeString, value)
+	}
+	if pbsuo.mutation.NetworkCleared() {
+		_spec.ClearField(providerbalancesnapshot.FieldNetwork, field.TypeString)
+	}
+	if value, ok := pbsuo.mutation.Address(); ok {
+		_spec.SetField(providerbalancesnapshot.FieldAddress, field.TypeString, value)
+	}
+	if pbsuo.mutation.AddressCleared() {
+		_spec.ClearField(providerbalancesnapshot.FieldAddress, field.TypeString)
+	}
+	if value, ok := pbsuo.mutation.AvailableBalance(); ok {
+		_spec.SetField(providerbalancesnapshot.FieldAvailableBalance, field.TypeFloat64, value)
+	}
+	if value, ok := pbsuo.mutation.AddedAvailableBalance(); ok {
+		_spec.AddField(providerbalancesnapshot.FieldAvailableBalance, field.TypeFloat64, value)
+	}
+	if value, ok := pbsuo.mutation.TotalBalance(); ok {
+		_spec.SetField(providerbalancesnapshot.FieldTotalBalance, field.TypeFloat64, value)
+	}
+	if value, ok := pbsuo.mutation.AddedTotalBalance(); ok {
+		_spec.AddField(providerbalancesnapshot.FieldTotalBalance, field.TypeFloat64, value)
+	}
+	if value, ok := pbsuo.mutation.Threshold(); ok {
+		_spec.SetField(providerbalancesnapshot.FieldThreshold, field.TypeFloat64, value)
+	}
+	if value, ok := pbsuo.mutation.AddedThreshold(); ok {
+		_spec.AddField(providerbalancesnapshot.FieldThreshold, field.TypeFloat64, value)
+	}
+	if value, ok := pbsuo.mutation.BelowThreshold(); ok {
+		_spec.SetField(providerbalancesnapshot.FieldBelowThreshold, field.TypeBool, value)
+	}
+	if pbsuo.mutation.ProviderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   providerbalancesnapshot.ProviderTable,
+			Columns: []string{providerbalancesnapshot.ProviderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerprofile.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pbsuo.mutation.ProviderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   providerbalancesnapshot.ProviderTable,
+			Columns: []string{providerbalancesnapshot.ProviderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerprofile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ProviderBalanceSnapshot{config: pbsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pbsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{providerbalancesnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pbsuo.mutation.done = true
+	return _node, nil
+}
@@ -29,6 +29,8 @@ type ProviderCurrencies struct {
 	ReservedBalance decimal.Decimal `json:"reserved_balance,omitempty"`
 	// IsAvailable holds the value of the "is_available" field.
 	IsAvailable bool `json:"is_available,omitempty"`
+	// LowBalanceSince holds the value of the "low_balance_since" field.
+	LowBalanceSince *time.Time `json:"low_balance_since,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -81,7 +83,7 @@ func (*ProviderCurrencies) scanValues(columns []string) ([]any, error) {
 			values[i] = new(decimal.Decimal)
 		case providercurrencies.FieldIsAvailable:
 			values[i] = new(sql.NullBool)
-		case providercurrencies.FieldUpdatedAt:
+		case providercurrencies.FieldLowBalanceSince, providercurrencies.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case providercurrencies.FieldID:
 			values[i] = new(uuid.UUID)
@@ -134,6 +136,13 @@ func (pc *ProviderCurrencies) assignValues(columns []string, values []any) error
 			} else if value.Valid {
 				pc.IsAvailable = value.Bool
 			}
+		case providercurrencies.FieldLowBalanceSince:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field low_balance_since", values[i])
+			} else if value.Valid {
+				pc.LowBalanceSince = new(time.Time)
+				*pc.LowBalanceSince = value.Time
+			}
 		case providercurrencies.FieldUpdatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
@@ -212,6 +221,11 @@ func (pc *ProviderCurrencies) String() string {
 	builder.WriteString("is_available=")
 	builder.WriteString(fmt.Sprintf("%v", pc.IsAvailable))
 	builder.WriteString(", ")
+	if v := pc.LowBalanceSince; v != nil {
+		builder.WriteString("low_balance_since=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(pc.UpdatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
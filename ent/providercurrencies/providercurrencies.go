@@ -23,6 +23,8 @@ const (
 	FieldReservedBalance = "reserved_balance"
 	// FieldIsAvailable holds the string denoting the is_available field in the database.
 	FieldIsAvailable = "is_available"
+	// FieldLowBalanceSince holds the string denoting the low_balance_since field in the database.
+	FieldLowBalanceSince = "low_balance_since"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
 	// EdgeProvider holds the string denoting the provider edge name in mutations.
@@ -54,6 +56,7 @@ var Columns = []string{
 	FieldTotalBalance,
 	FieldReservedBalance,
 	FieldIsAvailable,
+	FieldLowBalanceSince,
 	FieldUpdatedAt,
 }
 
@@ -118,6 +121,11 @@ func ByIsAvailable(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsAvailable, opts...).ToFunc()
 }
 
+// ByLowBalanceSince orders the results by the low_balance_since field.
+func ByLowBalanceSince(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLowBalanceSince, opts...).ToFunc()
+}
+
 // ByUpdatedAt orders the results by the updated_at field.
 func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
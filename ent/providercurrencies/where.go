@@ -77,6 +77,11 @@ func IsAvailable(v bool) predicate.ProviderCurrencies {
 	return predicate.ProviderCurrencies(sql.FieldEQ(FieldIsAvailable, v))
 }
 
+// LowBalanceSince applies equality check predicate on the "low_balance_since" field. It's identical to LowBalanceSinceEQ.
+func LowBalanceSince(v time.Time) predicate.ProviderCurrencies {
+	return predicate.ProviderCurrencies(sql.FieldEQ(FieldLowBalanceSince, v))
+}
+
 // UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
 func UpdatedAt(v time.Time) predicate.ProviderCurrencies {
 	return predicate.ProviderCurrencies(sql.FieldEQ(FieldUpdatedAt, v))
@@ -212,6 +217,56 @@ func IsAvailableNEQ(v bool) predicate.ProviderCurrencies {
 	return predicate.ProviderCurrencies(sql.FieldNEQ(FieldIsAvailable, v))
 }
 
+// LowBalanceSinceEQ applies the EQ predicate on the "low_balance_since" field.
+func LowBalanceSinceEQ(v time.Time) predicate.ProviderCurrencies {
+	return predicate.ProviderCurrencies(sql.FieldEQ(FieldLowBalanceSince, v))
+}
+
+// LowBalanceSinceNEQ applies the NEQ predicate on the "low_balance_since" field.
+func LowBalanceSinceNEQ(v time.Time) predicate.ProviderCurrencies {
+	return predicate.ProviderCurrencies(sql.FieldNEQ(FieldLowBalanceSince, v))
+}
+
+// LowBalanceSinceIn applies the In predicate on the "low_balance_since" field.
+func LowBalanceSinceIn(vs ...time.Time) predicate.ProviderCurrencies {
+	return predicate.ProviderCurrencies(sql.FieldIn(FieldLowBalanceSince, vs...))
+}
+
+// LowBalanceSinceNotIn applies the NotIn predicate on the "low_balance_since" field.
+func LowBalanceSinceNotIn(vs ...time.Time) predicate.ProviderCurrencies {
+	return predicate.ProviderCurrencies(sql.FieldNotIn(FieldLowBalanceSince, vs...))
+}
+
+// LowBalanceSinceGT applies the GT predicate on the "low_balance_since" field.
+func LowBalanceSinceGT(v time.Time) predicate.ProviderCurrencies {
+	return predicate.ProviderCurrencies(sql.FieldGT(FieldLowBalanceSince, v))
+}
+
+// LowBalanceSinceGTE applies the GTE predicate on the "low_balance_since" field.
+func LowBalanceSinceGTE(v time.Time) predicate.ProviderCurrencies {
+	return predicate.ProviderCurrencies(sql.FieldGTE(FieldLowBalanceSince, v))
+}
+
+// LowBalanceSinceLT applies the LT predicate on the "low_balance_since" field.
+func LowBalanceSinceLT(v time.Time) predicate.ProviderCurrencies {
+	return predicate.ProviderCurrencies(sql.FieldLT(FieldLowBalanceSince, v))
+}
+
+// LowBalanceSinceLTE applies the LTE predicate on the "low_balance_since" field.
+func LowBalanceSinceLTE(v time.Time) predicate.ProviderCurrencies {
+	return predicate.ProviderCurrencies(sql.FieldLTE(FieldLowBalanceSince, v))
+}
+
+// LowBalanceSinceIsNil applies the IsNil predicate on the "low_balance_since" field.
+func LowBalanceSinceIsNil() predicate.ProviderCurrencies {
+	return predicate.ProviderCurrencies(sql.FieldIsNull(FieldLowBalanceSince))
+}
+
+// LowBalanceSinceNotNil applies the NotNil predicate on the "low_balance_since" field.
+func LowBalanceSinceNotNil() predicate.ProviderCurrencies {
+	return predicate.ProviderCurrencies(sql.FieldNotNull(FieldLowBalanceSince))
+}
+
 // UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
 func UpdatedAtEQ(v time.Time) predicate.ProviderCurrencies {
 	return predicate.ProviderCurrencies(sql.FieldEQ(FieldUpdatedAt, v))
@@ -59,6 +59,20 @@ func (pcc *ProviderCurrenciesCreate) SetNillableIsAvailable(b *bool) *ProviderCu
 	return pcc
 }
 
+// SetLowBalanceSince sets the "low_balance_since" field.
+func (pcc *ProviderCurrenciesCreate) SetLowBalanceSince(t time.Time) *ProviderCurrenciesCreate {
+	pcc.mutation.SetLowBalanceSince(t)
+	return pcc
+}
+
+// SetNillableLowBalanceSince sets the "low_balance_since" field if the given value is not nil.
+func (pcc *ProviderCurrenciesCreate) SetNillableLowBalanceSince(t *time.Time) *ProviderCurrenciesCreate {
+	if t != nil {
+		pcc.SetLowBalanceSince(*t)
+	}
+	return pcc
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (pcc *ProviderCurrenciesCreate) SetUpdatedAt(t time.Time) *ProviderCurrenciesCreate {
 	pcc.mutation.SetUpdatedAt(t)
@@ -233,6 +247,10 @@ func (pcc *ProviderCurrenciesCreate) createSpec() (*ProviderCurrencies, *sqlgrap
 		_spec.SetField(providercurrencies.FieldIsAvailable, field.TypeBool, value)
 		_node.IsAvailable = value
 	}
+	if value, ok := pcc.mutation.LowBalanceSince(); ok {
+		_spec.SetField(providercurrencies.FieldLowBalanceSince, field.TypeTime, value)
+		_node.LowBalanceSince = &value
+	}
 	if value, ok := pcc.mutation.UpdatedAt(); ok {
 		_spec.SetField(providercurrencies.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
@@ -389,6 +407,24 @@ func (u *ProviderCurrenciesUpsert) UpdateIsAvailable() *ProviderCurrenciesUpsert
 	return u
 }
 
+// SetLowBalanceSince sets the "low_balance_since" field.
+func (u *ProviderCurrenciesUpsert) SetLowBalanceSince(v time.Time) *ProviderCurrenciesUpsert {
+	u.Set(providercurrencies.FieldLowBalanceSince, v)
+	return u
+}
+
+// UpdateLowBalanceSince sets the "low_balance_since" field to the value that was provided on create.
+func (u *ProviderCurrenciesUpsert) UpdateLowBalanceSince() *ProviderCurrenciesUpsert {
+	u.SetExcluded(providercurrencies.FieldLowBalanceSince)
+	return u
+}
+
+// ClearLowBalanceSince clears the value of the "low_balance_since" field.
+func (u *ProviderCurrenciesUpsert) ClearLowBalanceSince() *ProviderCurrenciesUpsert {
+	u.SetNull(providercurrencies.FieldLowBalanceSince)
+	return u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *ProviderCurrenciesUpsert) SetUpdatedAt(v time.Time) *ProviderCurrenciesUpsert {
 	u.Set(providercurrencies.FieldUpdatedAt, v)
@@ -526,6 +562,27 @@ func (u *ProviderCurrenciesUpsertOne) UpdateIsAvailable() *ProviderCurrenciesUps
 	})
 }
 
+// SetLowBalanceSince sets the "low_balance_since" field.
+func (u *ProviderCurrenciesUpsertOne) SetLowBalanceSince(v time.Time) *ProviderCurrenciesUpsertOne {
+	return u.Update(func(s *ProviderCurrenciesUpsert) {
+		s.SetLowBalanceSince(v)
+	})
+}
+
+// UpdateLowBalanceSince sets the "low_balance_since" field to the value that was provided on create.
+func (u *ProviderCurrenciesUpsertOne) UpdateLowBalanceSince() *ProviderCurrenciesUpsertOne {
+	return u.Update(func(s *ProviderCurrenciesUpsert) {
+		s.UpdateLowBalanceSince()
+	})
+}
+
+// ClearLowBalanceSince clears the value of the "low_balance_since" field.
+func (u *ProviderCurrenciesUpsertOne) ClearLowBalanceSince() *ProviderCurrenciesUpsertOne {
+	return u.Update(func(s *ProviderCurrenciesUpsert) {
+		s.ClearLowBalanceSince()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *ProviderCurrenciesUpsertOne) SetUpdatedAt(v time.Time) *ProviderCurrenciesUpsertOne {
 	return u.Update(func(s *ProviderCurrenciesUpsert) {
@@ -832,6 +889,27 @@ func (u *ProviderCurrenciesUpsertBulk) UpdateIsAvailable() *ProviderCurrenciesUp
 	})
 }
 
+// SetLowBalanceSince sets the "low_balance_since" field.
+func (u *ProviderCurrenciesUpsertBulk) SetLowBalanceSince(v time.Time) *ProviderCurrenciesUpsertBulk {
+	return u.Update(func(s *ProviderCurrenciesUpsert) {
+		s.SetLowBalanceSince(v)
+	})
+}
+
+// UpdateLowBalanceSince sets the "low_balance_since" field to the value that was provided on create.
+func (u *ProviderCurrenciesUpsertBulk) UpdateLowBalanceSince() *ProviderCurrenciesUpsertBulk {
+	return u.Update(func(s *ProviderCurrenciesUpsert) {
+		s.UpdateLowBalanceSince()
+	})
+}
+
+// ClearLowBalanceSince clears the value of the "low_balance_since" field.
+func (u *ProviderCurrenciesUpsertBulk) ClearLowBalanceSince() *ProviderCurrenciesUpsertBulk {
+	return u.Update(func(s *ProviderCurrenciesUpsert) {
+		s.ClearLowBalanceSince()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *ProviderCurrenciesUpsertBulk) SetUpdatedAt(v time.Time) *ProviderCurrenciesUpsertBulk {
 	return u.Update(func(s *ProviderCurrenciesUpsert) {
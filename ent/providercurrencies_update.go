@@ -109,6 +109,26 @@ func (pcu *ProviderCurrenciesUpdate) SetNillableIsAvailable(b *bool) *ProviderCu
 	return pcu
 }
 
+// SetLowBalanceSince sets the "low_balance_since" field.
+func (pcu *ProviderCurrenciesUpdate) SetLowBalanceSince(t time.Time) *ProviderCurrenciesUpdate {
+	pcu.mutation.SetLowBalanceSince(t)
+	return pcu
+}
+
+// SetNillableLowBalanceSince sets the "low_balance_since" field if the given value is not nil.
+func (pcu *ProviderCurrenciesUpdate) SetNillableLowBalanceSince(t *time.Time) *ProviderCurrenciesUpdate {
+	if t != nil {
+		pcu.SetLowBalanceSince(*t)
+	}
+	return pcu
+}
+
+// ClearLowBalanceSince clears the value of the "low_balance_since" field.
+func (pcu *ProviderCurrenciesUpdate) ClearLowBalanceSince() *ProviderCurrenciesUpdate {
+	pcu.mutation.ClearLowBalanceSince()
+	return pcu
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (pcu *ProviderCurrenciesUpdate) SetUpdatedAt(t time.Time) *ProviderCurrenciesUpdate {
 	pcu.mutation.SetUpdatedAt(t)
@@ -234,6 +254,12 @@ func (pcu *ProviderCurrenciesUpdate) sqlSave(ctx context.Context) (n int, err er
 	if value, ok := pcu.mutation.IsAvailable(); ok {
 		_spec.SetField(providercurrencies.FieldIsAvailable, field.TypeBool, value)
 	}
+	if value, ok := pcu.mutation.LowBalanceSince(); ok {
+		_spec.SetField(providercurrencies.FieldLowBalanceSince, field.TypeTime, value)
+	}
+	if pcu.mutation.LowBalanceSinceCleared() {
+		_spec.ClearField(providercurrencies.FieldLowBalanceSince, field.TypeTime)
+	}
 	if value, ok := pcu.mutation.UpdatedAt(); ok {
 		_spec.SetField(providercurrencies.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -392,6 +418,26 @@ func (pcuo *ProviderCurrenciesUpdateOne) SetNillableIsAvailable(b *bool) *Provid
 	return pcuo
 }
 
+// SetLowBalanceSince sets the "low_balance_since" field.
+func (pcuo *ProviderCurrenciesUpdateOne) SetLowBalanceSince(t time.Time) *ProviderCurrenciesUpdateOne {
+	pcuo.mutation.SetLowBalanceSince(t)
+	return pcuo
+}
+
+// SetNillableLowBalanceSince sets the "low_balance_since" field if the given value is not nil.
+func (pcuo *ProviderCurrenciesUpdateOne) SetNillableLowBalanceSince(t *time.Time) *ProviderCurrenciesUpdateOne {
+	if t != nil {
+		pcuo.SetLowBalanceSince(*t)
+	}
+	return pcuo
+}
+
+// ClearLowBalanceSince clears the value of the "low_balance_since" field.
+func (pcuo *ProviderCurrenciesUpdateOne) ClearLowBalanceSince() *ProviderCurrenciesUpdateOne {
+	pcuo.mutation.ClearLowBalanceSince()
+	return pcuo
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (pcuo *ProviderCurrenciesUpdateOne) SetUpdatedAt(t time.Time) *ProviderCurrenciesUpdateOne {
 	pcuo.mutation.SetUpdatedAt(t)
@@ -547,6 +593,12 @@ func (pcuo *ProviderCurrenciesUpdateOne) sqlSave(ctx context.Context) (_node *Pr
 	if value, ok := pcuo.mutation.IsAvailable(); ok {
 		_spec.SetField(providercurrencies.FieldIsAvailable, field.TypeBool, value)
 	}
+	if value, ok := pcuo.mutation.LowBalanceSince(); ok {
+		_spec.SetField(providercurrencies.FieldLowBalanceSince, field.TypeTime, value)
+	}
+	if pcuo.mutation.LowBalanceSinceCleared() {
+		_spec.ClearField(providercurrencies.FieldLowBalanceSince, field.TypeTime)
+	}
 	if value, ok := pcuo.mutation.UpdatedAt(); ok {
 		_spec.SetField(providercurrencies.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -42,6 +42,8 @@ type ProviderOrderToken struct {
 	Address string `json:"address,omitempty"`
 	// Network holds the value of the "network" field.
 	Network string `json:"network,omitempty"`
+	// LowBalanceSince holds the value of the "low_balance_since" field.
+	LowBalanceSince *time.Time `json:"low_balance_since,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ProviderOrderTokenQuery when eager-loading is set.
 	Edges                               ProviderOrderTokenEdges `json:"edges"`
@@ -108,7 +110,7 @@ func (*ProviderOrderToken) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullInt64)
 		case providerordertoken.FieldConversionRateType, providerordertoken.FieldAddress, providerordertoken.FieldNetwork:
 			values[i] = new(sql.NullString)
-		case providerordertoken.FieldCreatedAt, providerordertoken.FieldUpdatedAt:
+		case providerordertoken.FieldCreatedAt, providerordertoken.FieldUpdatedAt, providerordertoken.FieldLowBalanceSince:
 			values[i] = new(sql.NullTime)
 		case providerordertoken.ForeignKeys[0]: // fiat_currency_provider_order_tokens
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
@@ -197,6 +199,13 @@ func (pot *ProviderOrderToken) assignValues(columns []string, values []any) erro
 			} else if value.Valid {
 				pot.Network = value.String
 			}
+		case providerordertoken.FieldLowBalanceSince:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field low_balance_since", values[i])
+			} else if value.Valid {
+				pot.LowBalanceSince = new(time.Time)
+				*pot.LowBalanceSince = value.Time
+			}
 		case providerordertoken.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field fiat_currency_provider_order_tokens", values[i])
@@ -298,6 +307,11 @@ func (pot *ProviderOrderToken) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("network=")
 	builder.WriteString(pot.Network)
+	builder.WriteString(", ")
+	if v := pot.LowBalanceSince; v != nil {
+		builder.WriteString("low_balance_since=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -35,6 +35,8 @@ const (
 	FieldAddress = "address"
 	// FieldNetwork holds the string denoting the network field in the database.
 	FieldNetwork = "network"
+	// FieldLowBalanceSince holds the string denoting the low_balance_since field in the database.
+	FieldLowBalanceSince = "low_balance_since"
 	// EdgeProvider holds the string denoting the provider edge name in mutations.
 	EdgeProvider = "provider"
 	// EdgeToken holds the string denoting the token edge name in mutations.
@@ -79,6 +81,7 @@ var Columns = []string{
 	FieldRateSlippage,
 	FieldAddress,
 	FieldNetwork,
+	FieldLowBalanceSince,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "provider_order_tokens"
@@ -194,6 +197,11 @@ func ByNetwork(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldNetwork, opts...).ToFunc()
 }
 
+// ByLowBalanceSince orders the results by the low_balance_since field.
+func ByLowBalanceSince(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLowBalanceSince, opts...).ToFunc()
+}
+
 // ByProviderField orders the results by provider field.
 func ByProviderField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
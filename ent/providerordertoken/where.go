@@ -101,6 +101,11 @@ func Network(v string) predicate.ProviderOrderToken {
 	return predicate.ProviderOrderToken(sql.FieldEQ(FieldNetwork, v))
 }
 
+// LowBalanceSince applies equality check predicate on the "low_balance_since" field. It's identical to LowBalanceSinceEQ.
+func LowBalanceSince(v time.Time) predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldEQ(FieldLowBalanceSince, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.ProviderOrderToken {
 	return predicate.ProviderOrderToken(sql.FieldEQ(FieldCreatedAt, v))
@@ -541,6 +546,56 @@ func NetworkContainsFold(v string) predicate.ProviderOrderToken {
 	return predicate.ProviderOrderToken(sql.FieldContainsFold(FieldNetwork, v))
 }
 
+// LowBalanceSinceEQ applies the EQ predicate on the "low_balance_since" field.
+func LowBalanceSinceEQ(v time.Time) predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldEQ(FieldLowBalanceSince, v))
+}
+
+// LowBalanceSinceNEQ applies the NEQ predicate on the "low_balance_since" field.
+func LowBalanceSinceNEQ(v time.Time) predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldNEQ(FieldLowBalanceSince, v))
+}
+
+// LowBalanceSinceIn applies the In predicate on the "low_balance_since" field.
+func LowBalanceSinceIn(vs ...time.Time) predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldIn(FieldLowBalanceSince, vs...))
+}
+
+// LowBalanceSinceNotIn applies the NotIn predicate on the "low_balance_since" field.
+func LowBalanceSinceNotIn(vs ...time.Time) predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldNotIn(FieldLowBalanceSince, vs...))
+}
+
+// LowBalanceSinceGT applies the GT predicate on the "low_balance_since" field.
+func LowBalanceSinceGT(v time.Time) predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldGT(FieldLowBalanceSince, v))
+}
+
+// LowBalanceSinceGTE applies the GTE predicate on the "low_balance_since" field.
+func LowBalanceSinceGTE(v time.Time) predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldGTE(FieldLowBalanceSince, v))
+}
+
+// LowBalanceSinceLT applies the LT predicate on the "low_balance_since" field.
+func LowBalanceSinceLT(v time.Time) predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldLT(FieldLowBalanceSince, v))
+}
+
+// LowBalanceSinceLTE applies the LTE predicate on the "low_balance_since" field.
+func LowBalanceSinceLTE(v time.Time) predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldLTE(FieldLowBalanceSince, v))
+}
+
+// LowBalanceSinceIsNil applies the IsNil predicate on the "low_balance_since" field.
+func LowBalanceSinceIsNil() predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldIsNull(FieldLowBalanceSince))
+}
+
+// LowBalanceSinceNotNil applies the NotNil predicate on the "low_balance_since" field.
+func LowBalanceSinceNotNil() predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldNotNull(FieldLowBalanceSince))
+}
+
 // HasProvider applies the HasEdge predicate on the "provider" edge.
 func HasProvider() predicate.ProviderOrderToken {
 	return predicate.ProviderOrderToken(func(s *sql.Selector) {
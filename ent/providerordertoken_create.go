@@ -111,6 +111,20 @@ func (potc *ProviderOrderTokenCreate) SetNetwork(s string) *ProviderOrderTokenCr
 	return potc
 }
 
+// SetLowBalanceSince sets the "low_balance_since" field.
+func (potc *ProviderOrderTokenCreate) SetLowBalanceSince(t time.Time) *ProviderOrderTokenCreate {
+	potc.mutation.SetLowBalanceSince(t)
+	return potc
+}
+
+// SetNillableLowBalanceSince sets the "low_balance_since" field if the given value is not nil.
+func (potc *ProviderOrderTokenCreate) SetNillableLowBalanceSince(t *time.Time) *ProviderOrderTokenCreate {
+	if t != nil {
+		potc.SetLowBalanceSince(*t)
+	}
+	return potc
+}
+
 // SetProviderID sets the "provider" edge to the ProviderProfile entity by ID.
 func (potc *ProviderOrderTokenCreate) SetProviderID(id string) *ProviderOrderTokenCreate {
 	potc.mutation.SetProviderID(id)
@@ -299,6 +313,10 @@ func (potc *ProviderOrderTokenCreate) createSpec() (*ProviderOrderToken, *sqlgra
 		_spec.SetField(providerordertoken.FieldNetwork, field.TypeString, value)
 		_node.Network = value
 	}
+	if value, ok := potc.mutation.LowBalanceSince(); ok {
+		_spec.SetField(providerordertoken.FieldLowBalanceSince, field.TypeTime, value)
+		_node.LowBalanceSince = &value
+	}
 	if nodes := potc.mutation.ProviderIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -546,6 +564,24 @@ func (u *ProviderOrderTokenUpsert) UpdateNetwork() *ProviderOrderTokenUpsert {
 	return u
 }
 
+// SetLowBalanceSince sets the "low_balance_since" field.
+func (u *ProviderOrderTokenUpsert) SetLowBalanceSince(v time.Time) *ProviderOrderTokenUpsert {
+	u.Set(providerordertoken.FieldLowBalanceSince, v)
+	return u
+}
+
+// UpdateLowBalanceSince sets the "low_balance_since" field to the value that was provided on create.
+func (u *ProviderOrderTokenUpsert) UpdateLowBalanceSince() *ProviderOrderTokenUpsert {
+	u.SetExcluded(providerordertoken.FieldLowBalanceSince)
+	return u
+}
+
+// ClearLowBalanceSince clears the value of the "low_balance_since" field.
+func (u *ProviderOrderTokenUpsert) ClearLowBalanceSince() *ProviderOrderTokenUpsert {
+	u.SetNull(providerordertoken.FieldLowBalanceSince)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -759,6 +795,27 @@ func (u *ProviderOrderTokenUpsertOne) UpdateNetwork() *ProviderOrderTokenUpsertO
 	})
 }
 
+// SetLowBalanceSince sets the "low_balance_since" field.
+func (u *ProviderOrderTokenUpsertOne) SetLowBalanceSince(v time.Time) *ProviderOrderTokenUpsertOne {
+	return u.Update(func(s *ProviderOrderTokenUpsert) {
+		s.SetLowBalanceSince(v)
+	})
+}
+
+// UpdateLowBalanceSince sets the "low_balance_since" field to the value that was provided on create.
+func (u *ProviderOrderTokenUpsertOne) UpdateLowBalanceSince() *ProviderOrderTokenUpsertOne {
+	return u.Update(func(s *ProviderOrderTokenUpsert) {
+		s.UpdateLowBalanceSince()
+	})
+}
+
+// ClearLowBalanceSince clears the value of the "low_balance_since" field.
+func (u *ProviderOrderTokenUpsertOne) ClearLowBalanceSince() *ProviderOrderTokenUpsertOne {
+	return u.Update(func(s *ProviderOrderTokenUpsert) {
+		s.ClearLowBalanceSince()
+	})
+}
+
 // Exec executes the query.
 func (u *ProviderOrderTokenUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1138,6 +1195,27 @@ func (u *ProviderOrderTokenUpsertBulk) UpdateNetwork() *ProviderOrderTokenUpsert
 	})
 }
 
+// SetLowBalanceSince sets the "low_balance_since" field.
+func (u *ProviderOrderTokenUpsertBulk) SetLowBalanceSince(v time.Time) *ProviderOrderTokenUpsertBulk {
+	return u.Update(func(s *ProviderOrderTokenUpsert) {
+		s.SetLowBalanceSince(v)
+	})
+}
+
+// UpdateLowBalanceSince sets the "low_balance_since" field to the value that was provided on create.
+func (u *ProviderOrderTokenUpsertBulk) UpdateLowBalanceSince() *ProviderOrderTokenUpsertBulk {
+	return u.Update(func(s *ProviderOrderTokenUpsert) {
+		s.UpdateLowBalanceSince()
+	})
+}
+
+// ClearLowBalanceSince clears the value of the "low_balance_since" field.
+func (u *ProviderOrderTokenUpsertBulk) ClearLowBalanceSince() *ProviderOrderTokenUpsertBulk {
+	return u.Update(func(s *ProviderOrderTokenUpsert) {
+		s.ClearLowBalanceSince()
+	})
+}
+
 // Exec executes the query.
 func (u *ProviderOrderTokenUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
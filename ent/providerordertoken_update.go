@@ -192,6 +192,26 @@ func (potu *ProviderOrderTokenUpdate) SetNillableNetwork(s *string) *ProviderOrd
 	return potu
 }
 
+// SetLowBalanceSince sets the "low_balance_since" field.
+func (potu *ProviderOrderTokenUpdate) SetLowBalanceSince(t time.Time) *ProviderOrderTokenUpdate {
+	potu.mutation.SetLowBalanceSince(t)
+	return potu
+}
+
+// SetNillableLowBalanceSince sets the "low_balance_since" field if the given value is not nil.
+func (potu *ProviderOrderTokenUpdate) SetNillableLowBalanceSince(t *time.Time) *ProviderOrderTokenUpdate {
+	if t != nil {
+		potu.SetLowBalanceSince(*t)
+	}
+	return potu
+}
+
+// ClearLowBalanceSince clears the value of the "low_balance_since" field.
+func (potu *ProviderOrderTokenUpdate) ClearLowBalanceSince() *ProviderOrderTokenUpdate {
+	potu.mutation.ClearLowBalanceSince()
+	return potu
+}
+
 // SetProviderID sets the "provider" edge to the ProviderProfile entity by ID.
 func (potu *ProviderOrderTokenUpdate) SetProviderID(id string) *ProviderOrderTokenUpdate {
 	potu.mutation.SetProviderID(id)
@@ -360,6 +380,12 @@ func (potu *ProviderOrderTokenUpdate) sqlSave(ctx context.Context) (n int, err e
 	if value, ok := potu.mutation.Network(); ok {
 		_spec.SetField(providerordertoken.FieldNetwork, field.TypeString, value)
 	}
+	if value, ok := potu.mutation.LowBalanceSince(); ok {
+		_spec.SetField(providerordertoken.FieldLowBalanceSince, field.TypeTime, value)
+	}
+	if potu.mutation.LowBalanceSinceCleared() {
+		_spec.ClearField(providerordertoken.FieldLowBalanceSince, field.TypeTime)
+	}
 	if potu.mutation.ProviderCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -626,6 +652,26 @@ func (potuo *ProviderOrderTokenUpdateOne) SetNillableNetwork(s *string) *Provide
 	return potuo
 }
 
+// SetLowBalanceSince sets the "low_balance_since" field.
+func (potuo *ProviderOrderTokenUpdateOne) SetLowBalanceSince(t time.Time) *ProviderOrderTokenUpdateOne {
+	potuo.mutation.SetLowBalanceSince(t)
+	return potuo
+}
+
+// SetNillableLowBalanceSince sets the "low_balance_since" field if the given value is not nil.
+func (potuo *ProviderOrderTokenUpdateOne) SetNillableLowBalanceSince(t *time.Time) *ProviderOrderTokenUpdateOne {
+	if t != nil {
+		potuo.SetLowBalanceSince(*t)
+	}
+	return potuo
+}
+
+// ClearLowBalanceSince clears the value of the "low_balance_since" field.
+func (potuo *ProviderOrderTokenUpdateOne) ClearLowBalanceSince() *ProviderOrderTokenUpdateOne {
+	potuo.mutation.ClearLowBalanceSince()
+	return potuo
+}
+
 // SetProviderID sets the "provider" edge to the ProviderProfile entity by ID.
 func (potuo *ProviderOrderTokenUpdateOne) SetProviderID(id string) *ProviderOrderTokenUpdateOne {
 	potuo.mutation.SetProviderID(id)
@@ -824,6 +870,12 @@ func (potuo *ProviderOrderTokenUpdateOne) sqlSave(ctx context.Context) (_node *P
 	if value, ok := potuo.mutation.Network(); ok {
 		_spec.SetField(providerordertoken.FieldNetwork, field.TypeString, value)
 	}
+	if value, ok := potuo.mutation.LowBalanceSince(); ok {
+		_spec.SetField(providerordertoken.FieldLowBalanceSince, field.TypeTime, value)
+	}
+	if potuo.mutation.LowBalanceSinceCleared() {
+		_spec.ClearField(providerordertoken.FieldLowBalanceSince, field.TypeTime)
+	}
 	if potuo.mutation.ProviderCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -0,0 +1,233 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/NEDA-LABS/stablenode/ent/providerperformance"
+	"github.com/NEDA-LABS/stablenode/ent/providerprofile"
+)
+
+// ProviderPerformance is the model entity for the ProviderPerformance schema.
+type ProviderPerformance struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// OrdersSettled holds the value of the "orders_settled" field.
+	OrdersSettled int `json:"orders_settled,omitempty"`
+	// OrdersFailed holds the value of the "orders_failed" field.
+	OrdersFailed int `json:"orders_failed,omitempty"`
+	// OrdersCancelled holds the value of the "orders_cancelled" field.
+	OrdersCancelled int `json:"orders_cancelled,omitempty"`
+	// SuccessRate holds the value of the "success_rate" field.
+	SuccessRate float64 `json:"success_rate,omitempty"`
+	// CancellationRate holds the value of the "cancellation_rate" field.
+	CancellationRate float64 `json:"cancellation_rate,omitempty"`
+	// AvgSettlementSeconds holds the value of the "avg_settlement_seconds" field.
+	AvgSettlementSeconds float64 `json:"avg_settlement_seconds,omitempty"`
+	// Score holds the value of the "score" field.
+	Score float64 `json:"score,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ProviderPerformanceQuery when eager-loading is set.
+	Edges                        ProviderPerformanceEdges `json:"edges"`
+	provider_profile_performance *string
+	selectValues                 sql.SelectValues
+}
+
+// ProviderPerformanceEdges holds the relations/edges for other nodes in the graph.
+type ProviderPerformanceEdges struct {
+	// Provider holds the value of the provider edge.
+	Provider *ProviderProfile `json:"provider,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ProviderOrErr returns the Provider value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProviderPerformanceEdges) ProviderOrErr() (*ProviderProfile, error) {
+	if e.Provider != nil {
+		return e.Provider, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: providerprofile.Label}
+	}
+	return nil, &NotLoadedError{edge: "provider"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProviderPerformance) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case providerperformance.FieldSuccessRate, providerperformance.FieldCancellationRate, providerperformance.FieldAvgSettlementSeconds, providerperformance.FieldScore:
+			values[i] = new(sql.NullFloat64)
+		case providerperformance.FieldID, providerperformance.FieldOrdersSettled, providerperformance.FieldOrdersFailed, providerperformance.FieldOrdersCancelled:
+			values[i] = new(sql.NullInt64)
+		case providerperformance.FieldCreatedAt, providerperformance.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case providerperformance.ForeignKeys[0]: // provider_profile_performance
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProviderPerformance fields.
+func (pp *ProviderPerformance) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case providerperformance.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pp.ID = int(value.Int64)
+		case providerperformance.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pp.CreatedAt = value.Time
+			}
+		case providerperformance.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pp.UpdatedAt = value.Time
+			}
+		case providerperformance.FieldOrdersSettled:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field orders_settled", values[i])
+			} else if value.Valid {
+				pp.OrdersSettled = int(value.Int64)
+			}
+		case providerperformance.FieldOrdersFailed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field orders_failed", values[i])
+			} else if value.Valid {
+				pp.OrdersFailed = int(value.Int64)
+			}
+		case providerperformance.FieldOrdersCancelled:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field orders_cancelled", values[i])
+			} else if value.Valid {
+				pp.OrdersCancelled = int(value.Int64)
+			}
+		case providerperformance.FieldSuccessRate:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field success_rate", values[i])
+			} else if value.Valid {
+				pp.SuccessRate = value.Float64
+			}
+		case providerperformance.FieldCancellationRate:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field cancellation_rate", values[i])
+			} else if value.Valid {
+				pp.CancellationRate = value.Float64
+			}
+		case providerperformance.FieldAvgSettlementSeconds:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field avg_settlement_seconds", values[i])
+			} else if value.Valid {
+				pp.AvgSettlementSeconds = value.Float64
+			}
+		case providerperformance.FieldScore:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field score", values[i])
+			} else if value.Valid {
+				pp.Score = value.Float64
+			}
+		case providerperformance.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider_profile_performance", values[i])
+			} else if value.Valid {
+				pp.provider_profile_performance = new(string)
+				*pp.provider_profile_performance = value.String
+			}
+		default:
+			pp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ProviderPerformance.
+// This includes values selected through modifiers, order, etc.
+func (pp *ProviderPerformance) Value(name string) (ent.Value, error) {
+	return pp.selectValues.Get(name)
+}
+
+// QueryProvider queries the "provider" edge of the ProviderPerformance entity.
+func (pp *ProviderPerformance) QueryProvider() *ProviderProfileQuery {
+	return NewProviderPerformanceClient(pp.config).QueryProvider(pp)
+}
+
+// Update returns a builder for updating this ProviderPerformance.
+// Note that you need to call ProviderPerformance.Unwrap() before calling this method if this ProviderPerformance
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pp *ProviderPerformance) Update() *ProviderPerformanceUpdateOne {
+	return NewProviderPerformanceClient(pp.config).UpdateOne(pp)
+}
+
+// Unwrap unwraps the ProviderPerformance entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pp *ProviderPerformance) Unwrap() *ProviderPerformance {
+	_tx, ok := pp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProviderPerformance is not a transactional entity")
+	}
+	pp.config.driver = _tx.drv
+	return pp
+}
+
+// String implements the fmt.Stringer.
+func (pp *ProviderPerformance) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProviderPerformance(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pp.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(pp.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pp.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("orders_settled=")
+	builder.WriteString(fmt.Sprintf("%v", pp.OrdersSettled))
+	builder.WriteString(", ")
+	builder.WriteString("orders_failed=")
+	builder.WriteString(fmt.Sprintf("%v", pp.OrdersFailed))
+	builder.WriteString(", ")
+	builder.WriteString("orders_cancelled=")
+	builder.WriteString(fmt.Sprintf("%v", pp.OrdersCancelled))
+	builder.WriteString(", ")
+	builder.WriteString("success_rate=")
+	builder.WriteString(fmt.Sprintf("%v", pp.SuccessRate))
+	builder.WriteString(", ")
+	builder.WriteString("cancellation_rate=")
+	builder.WriteString(fmt.Sprintf("%v", pp.CancellationRate))
+	builder.WriteString(", ")
+	builder.WriteString("avg_settlement_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", pp.AvgSettlementSeconds))
+	builder.WriteString(", ")
+	builder.WriteString("score=")
+	builder.WriteString(fmt.Sprintf("%v", pp.Score))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProviderPerformances is a parsable slice of ProviderPerformance.
+type ProviderPerformances []*ProviderPerformance
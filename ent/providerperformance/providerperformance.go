@@ -0,0 +1,171 @@
+// Code generated by ent, DO NOT EDIT.
+
+package providerperformance
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the providerperformance type in the database.
+	Label = "provider_performance"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldOrdersSettled holds the string denoting the orders_settled field in the database.
+	FieldOrdersSettled = "orders_settled"
+	// FieldOrdersFailed holds the string denoting the orders_failed field in the database.
+	FieldOrdersFailed = "orders_failed"
+	// FieldOrdersCancelled holds the string denoting the orders_cancelled field in the database.
+	FieldOrdersCancelled = "orders_cancelled"
+	// FieldSuccessRate holds the string denoting the success_rate field in the database.
+	FieldSuccessRate = "success_rate"
+	// FieldCancellationRate holds the string denoting the cancellation_rate field in the database.
+	FieldCancellationRate = "cancellation_rate"
+	// FieldAvgSettlementSeconds holds the string denoting the avg_settlement_seconds field in the database.
+	FieldAvgSettlementSeconds = "avg_settlement_seconds"
+	// FieldScore holds the string denoting the score field in the database.
+	FieldScore = "score"
+	// EdgeProvider holds the string denoting the provider edge name in mutations.
+	EdgeProvider = "provider"
+	// Table holds the table name of the providerperformance in the database.
+	Table = "provider_performances"
+	// ProviderTable is the table that holds the provider relation/edge.
+	ProviderTable = "provider_performances"
+	// ProviderInverseTable is the table name for the ProviderProfile entity.
+	// It exists in this package in order to avoid circular dependency with the "providerprofile" package.
+	ProviderInverseTable = "provider_profiles"
+	// ProviderColumn is the table column denoting the provider relation/edge.
+	ProviderColumn = "provider_profile_performance"
+)
+
+// Columns holds all SQL columns for providerperformance fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldOrdersSettled,
+	FieldOrdersFailed,
+	FieldOrdersCancelled,
+	FieldSuccessRate,
+	FieldCancellationRate,
+	FieldAvgSettlementSeconds,
+	FieldScore,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "provider_performances"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"provider_profile_performance",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultOrdersSettled holds the default value on creation for the "orders_settled" field.
+	DefaultOrdersSettled int
+	// DefaultOrdersFailed holds the default value on creation for the "orders_failed" field.
+	DefaultOrdersFailed int
+	// DefaultOrdersCancelled holds the default value on creation for the "orders_cancelled" field.
+	DefaultOrdersCancelled int
+	// DefaultSuccessRate holds the default value on creation for the "success_rate" field.
+	DefaultSuccessRate float64
+	// DefaultCancellationRate holds the default value on creation for the "cancellation_rate" field.
+	DefaultCancellationRate float64
+	// DefaultAvgSettlementSeconds holds the default value on creation for the "avg_settlement_seconds" field.
+	DefaultAvgSettlementSeconds float64
+	// DefaultScore holds the default value on creation for the "score" field.
+	DefaultScore float64
+)
+
+// OrderOption defines the ordering options for the ProviderPerformance queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByOrdersSettled orders the results by the orders_settled field.
+func ByOrdersSettled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrdersSettled, opts...).ToFunc()
+}
+
+// ByOrdersFailed orders the results by the orders_failed field.
+func ByOrdersFailed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrdersFailed, opts...).ToFunc()
+}
+
+// ByOrdersCancelled orders the results by the orders_cancelled field.
+func ByOrdersCancelled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrdersCancelled, opts...).ToFunc()
+}
+
+// BySuccessRate orders the results by the success_rate field.
+func BySuccessRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSuccessRate, opts...).ToFunc()
+}
+
+// ByCancellationRate orders the results by the cancellation_rate field.
+func ByCancellationRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCancellationRate, opts...).ToFunc()
+}
+
+// ByAvgSettlementSeconds orders the results by the avg_settlement_seconds field.
+func ByAvgSettlementSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAvgSettlementSeconds, opts...).ToFunc()
+}
+
+// ByScore orders the results by the score field.
+func ByScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScore, opts...).ToFunc()
+}
+
+// ByProviderField orders the results by provider field.
+func ByProviderField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProviderStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newProviderStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProviderInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, ProviderTable, ProviderColumn),
+	)
+}
@@ -0,0 +1,499 @@
+// Code generated by ent, DO NOT EDIT.
+
+package providerperformance
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// OrdersSettled applies equality check predicate on the "orders_settled" field. It's identical to OrdersSettledEQ.
+func OrdersSettled(v int) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldEQ(FieldOrdersSettled, v))
+}
+
+// OrdersFailed applies equality check predicate on the "orders_failed" field. It's identical to OrdersFailedEQ.
+func OrdersFailed(v int) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldEQ(FieldOrdersFailed, v))
+}
+
+// OrdersCancelled applies equality check predicate on the "orders_cancelled" field. It's identical to OrdersCancelledEQ.
+func OrdersCancelled(v int) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldEQ(FieldOrdersCancelled, v))
+}
+
+// SuccessRate applies equality check predicate on the "success_rate" field. It's identical to SuccessRateEQ.
+func SuccessRate(v float64) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldEQ(FieldSuccessRate, v))
+}
+
+// CancellationRate applies equality check predicate on the "cancellation_rate" field. It's identical to CancellationRateEQ.
+func CancellationRate(v float64) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldEQ(FieldCancellationRate, v))
+}
+
+// AvgSettlementSeconds applies equality check predicate on the "avg_settlement_seconds" field. It's identical to AvgSettlementSecondsEQ.
+func AvgSettlementSeconds(v float64) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldEQ(FieldAvgSettlementSeconds, v))
+}
+
+// Score applies equality check predicate on the "score" field. It's identical to ScoreEQ.
+func Score(v float64) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldEQ(FieldScore, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// OrdersSettledEQ applies the EQ predicate on the "orders_settled" field.
+func OrdersSettledEQ(v int) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldEQ(FieldOrdersSettled, v))
+}
+
+// OrdersSettledNEQ applies the NEQ predicate on the "orders_settled" field.
+func OrdersSettledNEQ(v int) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldNEQ(FieldOrdersSettled, v))
+}
+
+// OrdersSettledIn applies the In predicate on the "orders_settled" field.
+func OrdersSettledIn(vs ...int) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldIn(FieldOrdersSettled, vs...))
+}
+
+// OrdersSettledNotIn applies the NotIn predicate on the "orders_settled" field.
+func OrdersSettledNotIn(vs ...int) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldNotIn(FieldOrdersSettled, vs...))
+}
+
+// OrdersSettledGT applies the GT predicate on the "orders_settled" field.
+func OrdersSettledGT(v int) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldGT(FieldOrdersSettled, v))
+}
+
+// OrdersSettledGTE applies the GTE predicate on the "orders_settled" field.
+func OrdersSettledGTE(v int) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldGTE(FieldOrdersSettled, v))
+}
+
+// OrdersSettledLT applies the LT predicate on the "orders_settled" field.
+func OrdersSettledLT(v int) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldLT(FieldOrdersSettled, v))
+}
+
+// OrdersSettledLTE applies the LTE predicate on the "orders_settled" field.
+func OrdersSettledLTE(v int) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldLTE(FieldOrdersSettled, v))
+}
+
+// OrdersFailedEQ applies the EQ predicate on the "orders_failed" field.
+func OrdersFailedEQ(v int) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldEQ(FieldOrdersFailed, v))
+}
+
+// OrdersFailedNEQ applies the NEQ predicate on the "orders_failed" field.
+func OrdersFailedNEQ(v int) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldNEQ(FieldOrdersFailed, v))
+}
+
+// OrdersFailedIn applies the In predicate on the "orders_failed" field.
+func OrdersFailedIn(vs ...int) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldIn(FieldOrdersFailed, vs...))
+}
+
+// OrdersFailedNotIn applies the NotIn predicate on the "orders_failed" field.
+func OrdersFailedNotIn(vs ...int) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldNotIn(FieldOrdersFailed, vs...))
+}
+
+// OrdersFailedGT applies the GT predicate on the "orders_failed" field.
+func OrdersFailedGT(v int) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldGT(FieldOrdersFailed, v))
+}
+
+// OrdersFailedGTE applies the GTE predicate on the "orders_failed" field.
+func OrdersFailedGTE(v int) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldGTE(FieldOrdersFailed, v))
+}
+
+// OrdersFailedLT applies the LT predicate on the "orders_failed" field.
+func OrdersFailedLT(v int) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldLT(FieldOrdersFailed, v))
+}
+
+// OrdersFailedLTE applies the LTE predicate on the "orders_failed" field.
+func OrdersFailedLTE(v int) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldLTE(FieldOrdersFailed, v))
+}
+
+// OrdersCancelledEQ applies the EQ predicate on the "orders_cancelled" field.
+func OrdersCancelledEQ(v int) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldEQ(FieldOrdersCancelled, v))
+}
+
+// OrdersCancelledNEQ applies the NEQ predicate on the "orders_cancelled" field.
+func OrdersCancelledNEQ(v int) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldNEQ(FieldOrdersCancelled, v))
+}
+
+// OrdersCancelledIn applies the In predicate on the "orders_cancelled" field.
+func OrdersCancelledIn(vs ...int) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldIn(FieldOrdersCancelled, vs...))
+}
+
+// OrdersCancelledNotIn applies the NotIn predicate on the "orders_cancelled" field.
+func OrdersCancelledNotIn(vs ...int) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldNotIn(FieldOrdersCancelled, vs...))
+}
+
+// OrdersCancelledGT applies the GT predicate on the "orders_cancelled" field.
+func OrdersCancelledGT(v int) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldGT(FieldOrdersCancelled, v))
+}
+
+// OrdersCancelledGTE applies the GTE predicate on the "orders_cancelled" field.
+func OrdersCancelledGTE(v int) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldGTE(FieldOrdersCancelled, v))
+}
+
+// OrdersCancelledLT applies the LT predicate on the "orders_cancelled" field.
+func OrdersCancelledLT(v int) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldLT(FieldOrdersCancelled, v))
+}
+
+// OrdersCancelledLTE applies the LTE predicate on the "orders_cancelled" field.
+func OrdersCancelledLTE(v int) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldLTE(FieldOrdersCancelled, v))
+}
+
+// SuccessRateEQ applies the EQ predicate on the "success_rate" field.
+func SuccessRateEQ(v float64) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldEQ(FieldSuccessRate, v))
+}
+
+// SuccessRateNEQ applies the NEQ predicate on the "success_rate" field.
+func SuccessRateNEQ(v float64) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldNEQ(FieldSuccessRate, v))
+}
+
+// SuccessRateIn applies the In predicate on the "success_rate" field.
+func SuccessRateIn(vs ...float64) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldIn(FieldSuccessRate, vs...))
+}
+
+// SuccessRateNotIn applies the NotIn predicate on the "success_rate" field.
+func SuccessRateNotIn(vs ...float64) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldNotIn(FieldSuccessRate, vs...))
+}
+
+// SuccessRateGT applies the GT predicate on the "success_rate" field.
+func SuccessRateGT(v float64) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldGT(FieldSuccessRate, v))
+}
+
+// SuccessRateGTE applies the GTE predicate on the "success_rate" field.
+func SuccessRateGTE(v float64) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldGTE(FieldSuccessRate, v))
+}
+
+// SuccessRateLT applies the LT predicate on the "success_rate" field.
+func SuccessRateLT(v float64) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldLT(FieldSuccessRate, v))
+}
+
+// SuccessRateLTE applies the LTE predicate on the "success_rate" field.
+func SuccessRateLTE(v float64) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldLTE(FieldSuccessRate, v))
+}
+
+// CancellationRateEQ applies the EQ predicate on the "cancellation_rate" field.
+func CancellationRateEQ(v float64) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldEQ(FieldCancellationRate, v))
+}
+
+// CancellationRateNEQ applies the NEQ predicate on the "cancellation_rate" field.
+func CancellationRateNEQ(v float64) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldNEQ(FieldCancellationRate, v))
+}
+
+// CancellationRateIn applies the In predicate on the "cancellation_rate" field.
+func CancellationRateIn(vs ...float64) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldIn(FieldCancellationRate, vs...))
+}
+
+// CancellationRateNotIn applies the NotIn predicate on the "cancellation_rate" field.
+func CancellationRateNotIn(vs ...float64) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldNotIn(FieldCancellationRate, vs...))
+}
+
+// CancellationRateGT applies the GT predicate on the "cancellation_rate" field.
+func CancellationRateGT(v float64) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldGT(FieldCancellationRate, v))
+}
+
+// CancellationRateGTE applies the GTE predicate on the "cancellation_rate" field.
+func CancellationRateGTE(v float64) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldGTE(FieldCancellationRate, v))
+}
+
+// CancellationRateLT applies the LT predicate on the "cancellation_rate" field.
+func CancellationRateLT(v float64) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldLT(FieldCancellationRate, v))
+}
+
+// CancellationRateLTE applies the LTE predicate on the "cancellation_rate" field.
+func CancellationRateLTE(v float64) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldLTE(FieldCancellationRate, v))
+}
+
+// AvgSettlementSecondsEQ applies the EQ predicate on the "avg_settlement_seconds" field.
+func AvgSettlementSecondsEQ(v float64) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldEQ(FieldAvgSettlementSeconds, v))
+}
+
+// AvgSettlementSecondsNEQ applies the NEQ predicate on the "avg_settlement_seconds" field.
+func AvgSettlementSecondsNEQ(v float64) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldNEQ(FieldAvgSettlementSeconds, v))
+}
+
+// AvgSettlementSecondsIn applies the In predicate on the "avg_settlement_seconds" field.
+func AvgSettlementSecondsIn(vs ...float64) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldIn(FieldAvgSettlementSeconds, vs...))
+}
+
+// AvgSettlementSecondsNotIn applies the NotIn predicate on the "avg_settlement_seconds" field.
+func AvgSettlementSecondsNotIn(vs ...float64) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldNotIn(FieldAvgSettlementSeconds, vs...))
+}
+
+// AvgSettlementSecondsGT applies the GT predicate on the "avg_settlement_seconds" field.
+func AvgSettlementSecondsGT(v float64) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldGT(FieldAvgSettlementSeconds, v))
+}
+
+// AvgSettlementSecondsGTE applies the GTE predicate on the "avg_settlement_seconds" field.
+func AvgSettlementSecondsGTE(v float64) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldGTE(FieldAvgSettlementSeconds, v))
+}
+
+// AvgSettlementSecondsLT applies the LT predicate on the "avg_settlement_seconds" field.
+func AvgSettlementSecondsLT(v float64) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldLT(FieldAvgSettlementSeconds, v))
+}
+
+// AvgSettlementSecondsLTE applies the LTE predicate on the "avg_settlement_seconds" field.
+func AvgSettlementSecondsLTE(v float64) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldLTE(FieldAvgSettlementSeconds, v))
+}
+
+// ScoreEQ applies the EQ predicate on the "score" field.
+func ScoreEQ(v float64) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldEQ(FieldScore, v))
+}
+
+// ScoreNEQ applies the NEQ predicate on the "score" field.
+func ScoreNEQ(v float64) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldNEQ(FieldScore, v))
+}
+
+// ScoreIn applies the In predicate on the "score" field.
+func ScoreIn(vs ...float64) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldIn(FieldScore, vs...))
+}
+
+// ScoreNotIn applies the NotIn predicate on the "score" field.
+func ScoreNotIn(vs ...float64) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldNotIn(FieldScore, vs...))
+}
+
+// ScoreGT applies the GT predicate on the "score" field.
+func ScoreGT(v float64) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldGT(FieldScore, v))
+}
+
+// ScoreGTE applies the GTE predicate on the "score" field.
+func ScoreGTE(v float64) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldGTE(FieldScore, v))
+}
+
+// ScoreLT applies the LT predicate on the "score" field.
+func ScoreLT(v float64) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldLT(FieldScore, v))
+}
+
+// ScoreLTE applies the LTE predicate on the "score" field.
+func ScoreLTE(v float64) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.FieldLTE(FieldScore, v))
+}
+
+// HasProvider applies the HasEdge predicate on the "provider" edge.
+func HasProvider() predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, ProviderTable, ProviderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProviderWith applies the HasEdge predicate on the "provider" edge with a given conditions (other predicates).
+func HasProviderWith(preds ...predicate.ProviderProfile) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(func(s *sql.Selector) {
+		step := newProviderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ProviderPerformance) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ProviderPerformance) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ProviderPerformance) predicate.ProviderPerformance {
+	return predicate.ProviderPerformance(sql.NotPredicates(p))
+}
@@ -0,0 +1,1137 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/providerperformance"
+	"github.com/NEDA-LABS/stablenode/ent/providerprofile"
+)
+
+// ProviderPerformanceCreate is the builder for creating a ProviderPerformance entity.
+type ProviderPerformanceCreate struct {
+	config
+	mutation *ProviderPerformanceMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ppc *ProviderPerformanceCreate) SetCreatedAt(t time.Time) *ProviderPerformanceCreate {
+	ppc.mutation.SetCreatedAt(t)
+	return ppc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ppc *ProviderPerformanceCreate) SetNillableCreatedAt(t *time.Time) *ProviderPerformanceCreate {
+	if t != nil {
+		ppc.SetCreatedAt(*t)
+	}
+	return ppc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ppc *ProviderPerformanceCreate) SetUpdatedAt(t time.Time) *ProviderPerformanceCreate {
+	ppc.mutation.SetUpdatedAt(t)
+	return ppc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ppc *ProviderPerformanceCreate) SetNillableUpdatedAt(t *time.Time) *ProviderPerformanceCreate {
+	if t != nil {
+		ppc.SetUpdatedAt(*t)
+	}
+	return ppc
+}
+
+// SetOrdersSettled sets the "orders_settled" field.
+func (ppc *ProviderPerformanceCreate) SetOrdersSettled(i int) *ProviderPerformanceCreate {
+	ppc.mutation.SetOrdersSettled(i)
+	return ppc
+}
+
+// SetNillableOrdersSettled sets the "orders_settled" field if the given value is not nil.
+func (ppc *ProviderPerformanceCreate) SetNillableOrdersSettled(i *int) *ProviderPerformanceCreate {
+	if i != nil {
+		ppc.SetOrdersSettled(*i)
+	}
+	return ppc
+}
+
+// SetOrdersFailed sets the "orders_failed" field.
+func (ppc *ProviderPerformanceCreate) SetOrdersFailed(i int) *ProviderPerformanceCreate {
+	ppc.mutation.SetOrdersFailed(i)
+	return ppc
+}
+
+// SetNillableOrdersFailed sets the "orders_failed" field if the given value is not nil.
+func (ppc *ProviderPerformanceCreate) SetNillableOrdersFailed(i *int) *ProviderPerformanceCreate {
+	if i != nil {
+		ppc.SetOrdersFailed(*i)
+	}
+	return ppc
+}
+
+// SetOrdersCancelled sets the "orders_cancelled" field.
+func (ppc *ProviderPerformanceCreate) SetOrdersCancelled(i int) *ProviderPerformanceCreate {
+	ppc.mutation.SetOrdersCancelled(i)
+	return ppc
+}
+
+// SetNillableOrdersCancelled sets the "orders_cancelled" field if the given value is not nil.
+func (ppc *ProviderPerformanceCreate) SetNillableOrdersCancelled(i *int) *ProviderPerformanceCreate {
+	if i != nil {
+		ppc.SetOrdersCancelled(*i)
+	}
+	return ppc
+}
+
+// SetSuccessRate sets the "success_rate" field.
+func (ppc *ProviderPerformanceCreate) SetSuccessRate(f float64) *ProviderPerformanceCreate {
+	ppc.mutation.SetSuccessRate(f)
+	return ppc
+}
+
+// SetNillableSuccessRate sets the "success_rate" field if the given value is not nil.
+func (ppc *ProviderPerformanceCreate) SetNillableSuccessRate(f *float64) *ProviderPerformanceCreate {
+	if f != nil {
+		ppc.SetSuccessRate(*f)
+	}
+	return ppc
+}
+
+// SetCancellationRate sets the "cancellation_rate" field.
+func (ppc *ProviderPerformanceCreate) SetCancellationRate(f float64) *ProviderPerformanceCreate {
+	ppc.mutation.SetCancellationRate(f)
+	return ppc
+}
+
+// SetNillableCancellationRate sets the "cancellation_rate" field if the given value is not nil.
+func (ppc *ProviderPerformanceCreate) SetNillableCancellationRate(f *float64) *ProviderPerformanceCreate {
+	if f != nil {
+		ppc.SetCancellationRate(*f)
+	}
+	return ppc
+}
+
+// SetAvgSettlementSeconds sets the "avg_settlement_seconds" field.
+func (ppc *ProviderPerformanceCreate) SetAvgSettlementSeconds(f float64) *ProviderPerformanceCreate {
+	ppc.mutation.SetAvgSettlementSeconds(f)
+	return ppc
+}
+
+// SetNillableAvgSettlementSeconds sets the "avg_settlement_seconds" field if the given value is not nil.
+func (ppc *ProviderPerformanceCreate) SetNillableAvgSettlementSeconds(f *float64) *ProviderPerformanceCreate {
+	if f != nil {
+		ppc.SetAvgSettlementSeconds(*f)
+	}
+	return ppc
+}
+
+// SetScore sets the "score" field.
+func (ppc *ProviderPerformanceCreate) SetScore(f float64) *ProviderPerformanceCreate {
+	ppc.mutation.SetScore(f)
+	return ppc
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (ppc *ProviderPerformanceCreate) SetNillableScore(f *float64) *ProviderPerformanceCreate {
+	if f != nil {
+		ppc.SetScore(*f)
+	}
+	return ppc
+}
+
+// SetProviderID sets the "provider" edge to the ProviderProfile entity by ID.
+func (ppc *ProviderPerformanceCreate) SetProviderID(id string) *ProviderPerformanceCreate {
+	ppc.mutation.SetProviderID(id)
+	return ppc
+}
+
+// SetProvider sets the "provider" edge to the ProviderProfile entity.
+func (ppc *ProviderPerformanceCreate) SetProvider(p *ProviderProfile) *ProviderPerformanceCreate {
+	return ppc.SetProviderID(p.ID)
+}
+
+// Mutation returns the ProviderPerformanceMutation object of the builder.
+func (ppc *ProviderPerformanceCreate) Mutation() *ProviderPerformanceMutation {
+	return ppc.mutation
+}
+
+// Save creates the ProviderPerformance in the database.
+func (ppc *ProviderPerformanceCreate) Save(ctx context.Context) (*ProviderPerformance, error) {
+	ppc.defaults()
+	return withHooks(ctx, ppc.sqlSave, ppc.mutation, ppc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ppc *ProviderPerformanceCreate) SaveX(ctx context.Context) *ProviderPerformance {
+	v, err := ppc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ppc *ProviderPerformanceCreate) Exec(ctx context.Context) error {
+	_, err := ppc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ppc *ProviderPerformanceCreate) ExecX(ctx context.Context) {
+	if err := ppc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ppc *ProviderPerformanceCreate) defaults() {
+	if _, ok := ppc.mutation.CreatedAt(); !ok {
+		v := providerperformance.DefaultCreatedAt()
+		ppc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ppc.mutation.UpdatedAt(); !ok {
+		v := providerperformance.DefaultUpdatedAt()
+		ppc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := ppc.mutation.OrdersSettled(); !ok {
+		v := providerperformance.DefaultOrdersSettled
+		ppc.mutation.SetOrdersSettled(v)
+	}
+	if _, ok := ppc.mutation.OrdersFailed(); !ok {
+		v := providerperformance.DefaultOrdersFailed
+		ppc.mutation.SetOrdersFailed(v)
+	}
+	if _, ok := ppc.mutation.OrdersCancelled(); !ok {
+		v := providerperformance.DefaultOrdersCancelled
+		ppc.mutation.SetOrdersCancelled(v)
+	}
+	if _, ok := ppc.mutation.SuccessRate(); !ok {
+		v := providerperformance.DefaultSuccessRate
+		ppc.mutation.SetSuccessRate(v)
+	}
+	if _, ok := ppc.mutation.CancellationRate(); !ok {
+		v := providerperformance.DefaultCancellationRate
+		ppc.mutation.SetCancellationRate(v)
+	}
+	if _, ok := ppc.mutation.AvgSettlementSeconds(); !ok {
+		v := providerperformance.DefaultAvgSettlementSeconds
+		ppc.mutation.SetAvgSettlementSeconds(v)
+	}
+	if _, ok := ppc.mutation.Score(); !ok {
+		v := providerperformance.DefaultScore
+		ppc.mutation.SetScore(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ppc *ProviderPerformanceCreate) check() error {
+	if _, ok := ppc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ProviderPerformance.created_at"`)}
+	}
+	if _, ok := ppc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ProviderPerformance.updated_at"`)}
+	}
+	if _, ok := ppc.mutation.OrdersSettled(); !ok {
+		return &ValidationError{Name: "orders_settled", err: errors.New(`ent: missing required field "ProviderPerformance.orders_settled"`)}
+	}
+	if _, ok := ppc.mutation.OrdersFailed(); !ok {
+		return &ValidationError{Name: "orders_failed", err: errors.New(`ent: missing required field "ProviderPerformance.orders_failed"`)}
+	}
+	if _, ok := ppc.mutation.OrdersCancelled(); !ok {
+		return &ValidationError{Name: "orders_cancelled", err: errors.New(`ent: missing required field "ProviderPerformance.orders_cancelled"`)}
+	}
+	if _, ok := ppc.mutation.SuccessRate(); !ok {
+		return &ValidationError{Name: "success_rate", err: errors.New(`ent: missing required field "ProviderPerformance.success_rate"`)}
+	}
+	if _, ok := ppc.mutation.CancellationRate(); !ok {
+		return &ValidationError{Name: "cancellation_rate", err: errors.New(`ent: missing required field "ProviderPerformance.cancellation_rate"`)}
+	}
+	if _, ok := ppc.mutation.AvgSettlementSeconds(); !ok {
+		return &ValidationError{Name: "avg_settlement_seconds", err: errors.New(`ent: missing required field "ProviderPerformance.avg_settlement_seconds"`)}
+	}
+	if _, ok := ppc.mutation.Score(); !ok {
+		return &ValidationError{Name: "score", err: errors.New(`ent: missing required field "ProviderPerformance.score"`)}
+	}
+	if len(ppc.mutation.ProviderIDs()) == 0 {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required edge "ProviderPerformance.provider"`)}
+	}
+	return nil
+}
+
+func (ppc *ProviderPerformanceCreate) sqlSave(ctx context.Context) (*ProviderPerformance, error) {
+	if err := ppc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ppc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ppc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ppc.mutation.id = &_node.ID
+	ppc.mutation.done = true
+	return _node, nil
+}
+
+func (ppc *ProviderPerformanceCreate) createSpec() (*ProviderPerformance, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProviderPerformance{config: ppc.config}
+		_spec = sqlgraph.NewCreateSpec(providerperformance.Table, sqlgraph.NewFieldSpec(providerperformance.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = ppc.conflict
+	if value, ok := ppc.mutation.CreatedAt(); ok {
+		_spec.SetField(providerperformance.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ppc.mutation.UpdatedAt(); ok {
+		_spec.SetField(providerperformance.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := ppc.mutation.OrdersSettled(); ok {
+		_spec.SetField(providerperformance.FieldOrdersSettled, field.TypeInt, value)
+		_node.OrdersSettled = value
+	}
+	if value, ok := ppc.mutation.OrdersFailed(); ok {
+		_spec.SetField(providerperformance.FieldOrdersFailed, field.TypeInt, value)
+		_node.OrdersFailed = value
+	}
+	if value, ok := ppc.mutation.OrdersCancelled(); ok {
+		_spec.SetField(providerperformance.FieldOrdersCancelled, field.TypeInt, value)
+		_node.OrdersCancelled = value
+	}
+	if value, ok := ppc.mutation.SuccessRate(); ok {
+		_spec.SetField(providerperformance.FieldSuccessRate, field.TypeFloat64, value)
+		_node.SuccessRate = value
+	}
+	if value, ok := ppc.mutation.CancellationRate(); ok {
+		_spec.SetField(providerperformance.FieldCancellationRate, field.TypeFloat64, value)
+		_node.CancellationRate = value
+	}
+	if value, ok := ppc.mutation.AvgSettlementSeconds(); ok {
+		_spec.SetField(providerperformance.FieldAvgSettlementSeconds, field.TypeFloat64, value)
+		_node.AvgSettlementSeconds = value
+	}
+	if value, ok := ppc.mutation.Score(); ok {
+		_spec.SetField(providerperformance.FieldScore, field.TypeFloat64, value)
+		_node.Score = value
+	}
+	if nodes := ppc.mutation.ProviderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   providerperformance.ProviderTable,
+			Columns: []string{providerperformance.ProviderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerprofile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.provider_profile_performance = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProviderPerformance.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProviderPerformanceUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (ppc *ProviderPerformanceCreate) OnConflict(opts ...sql.ConflictOption) *ProviderPerformanceUpsertOne {
+	ppc.conflict = opts
+	return &ProviderPerformanceUpsertOne{
+		create: ppc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProviderPerformance.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ppc *ProviderPerformanceCreate) OnConflictColumns(columns ...string) *ProviderPerformanceUpsertOne {
+	ppc.conflict = append(ppc.conflict, sql.ConflictColumns(columns...))
+	return &ProviderPerformanceUpsertOne{
+		create: ppc,
+	}
+}
+
+type (
+	// ProviderPerformanceUpsertOne is the builder for "upsert"-ing
+	//  one ProviderPerformance node.
+	ProviderPerformanceUpsertOne struct {
+		create *ProviderPerformanceCreate
+	}
+
+	// ProviderPerformanceUpsert is the "OnConflict" setter.
+	ProviderPerformanceUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProviderPerformanceUpsert) SetUpdatedAt(v time.Time) *ProviderPerformanceUpsert {
+	u.Set(providerperformance.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProviderPerformanceUpsert) UpdateUpdatedAt() *ProviderPerformanceUpsert {
+	u.SetExcluded(providerperformance.FieldUpdatedAt)
+	return u
+}
+
+// SetOrdersSettled sets the "orders_settled" field.
+func (u *ProviderPerformanceUpsert) SetOrdersSettled(v int) *ProviderPerformanceUpsert {
+	u.Set(providerperformance.FieldOrdersSettled, v)
+	return u
+}
+
+// UpdateOrdersSettled sets the "orders_settled" field to the value that was provided on create.
+func (u *ProviderPerformanceUpsert) UpdateOrdersSettled() *ProviderPerformanceUpsert {
+	u.SetExcluded(providerperformance.FieldOrdersSettled)
+	return u
+}
+
+// AddOrdersSettled adds v to the "orders_settled" field.
+func (u *ProviderPerformanceUpsert) AddOrdersSettled(v int) *ProviderPerformanceUpsert {
+	u.Add(providerperformance.FieldOrdersSettled, v)
+	return u
+}
+
+// SetOrdersFailed sets the "orders_failed" field.
+func (u *ProviderPerformanceUpsert) SetOrdersFailed(v int) *ProviderPerformanceUpsert {
+	u.Set(providerperformance.FieldOrdersFailed, v)
+	return u
+}
+
+// UpdateOrdersFailed sets the "orders_failed" field to the value that was provided on create.
+func (u *ProviderPerformanceUpsert) UpdateOrdersFailed() *ProviderPerformanceUpsert {
+	u.SetExcluded(providerperformance.FieldOrdersFailed)
+	return u
+}
+
+// AddOrdersFailed adds v to the "orders_failed" field.
+func (u *ProviderPerformanceUpsert) AddOrdersFailed(v int) *ProviderPerformanceUpsert {
+	u.Add(providerperformance.FieldOrdersFailed, v)
+	return u
+}
+
+// SetOrdersCancelled sets the "orders_cancelled" field.
+func (u *ProviderPerformanceUpsert) SetOrdersCancelled(v int) *ProviderPerformanceUpsert {
+	u.Set(providerperformance.FieldOrdersCancelled, v)
+	return u
+}
+
+// UpdateOrdersCancelled sets the "orders_cancelled" field to the value that was provided on create.
+func (u *ProviderPerformanceUpsert) UpdateOrdersCancelled() *ProviderPerformanceUpsert {
+	u.SetExcluded(providerperformance.FieldOrdersCancelled)
+	return u
+}
+
+// AddOrdersCancelled adds v to the "orders_cancelled" field.
+func (u *ProviderPerformanceUpsert) AddOrdersCancelled(v int) *ProviderPerformanceUpsert {
+	u.Add(providerperformance.FieldOrdersCancelled, v)
+	return u
+}
+
+// SetSuccessRate sets the "success_rate" field.
+func (u *ProviderPerformanceUpsert) SetSuccessRate(v float64) *ProviderPerformanceUpsert {
+	u.Set(providerperformance.FieldSuccessRate, v)
+	return u
+}
+
+// UpdateSuccessRate sets the "success_rate" field to the value that was provided on create.
+func (u *ProviderPerformanceUpsert) UpdateSuccessRate() *ProviderPerformanceUpsert {
+	u.SetExcluded(providerperformance.FieldSuccessRate)
+	return u
+}
+
+// AddSuccessRate adds v to the "success_rate" field.
+func (u *ProviderPerformanceUpsert) AddSuccessRate(v float64) *ProviderPerformanceUpsert {
+	u.Add(providerperformance.FieldSuccessRate, v)
+	return u
+}
+
+// SetCancellationRate sets the "cancellation_rate" field.
+func (u *ProviderPerformanceUpsert) SetCancellationRate(v float64) *ProviderPerformanceUpsert {
+	u.Set(providerperformance.FieldCancellationRate, v)
+	return u
+}
+
+// UpdateCancellationRate sets the "cancellation_rate" field to the value that was provided on create.
+func (u *ProviderPerformanceUpsert) UpdateCancellationRate() *ProviderPerformanceUpsert {
+	u.SetExcluded(providerperformance.FieldCancellationRate)
+	return u
+}
+
+// AddCancellationRate adds v to the "cancellation_rate" field.
+func (u *ProviderPerformanceUpsert) AddCancellationRate(v float64) *ProviderPerformanceUpsert {
+	u.Add(providerperformance.FieldCancellationRate, v)
+	return u
+}
+
+// SetAvgSettlementSeconds sets the "avg_settlement_seconds" field.
+func (u *ProviderPerformanceUpsert) SetAvgSettlementSeconds(v float64) *ProviderPerformanceUpsert {
+	u.Set(providerperformance.FieldAvgSettlementSeconds, v)
+	return u
+}
+
+// UpdateAvgSettlementSeconds sets the "avg_settlement_seconds" field to the value that was provided on create.
+func (u *ProviderPerformanceUpsert) UpdateAvgSettlementSeconds() *ProviderPerformanceUpsert {
+	u.SetExcluded(providerperformance.FieldAvgSettlementSeconds)
+	return u
+}
+
+// AddAvgSettlementSeconds adds v to the "avg_settlement_seconds" field.
+func (u *ProviderPerformanceUpsert) AddAvgSettlementSeconds(v float64) *ProviderPerformanceUpsert {
+	u.Add(providerperformance.FieldAvgSettlementSeconds, v)
+	return u
+}
+
+// SetScore sets the "score" field.
+func (u *ProviderPerformanceUpsert) SetScore(v float64) *ProviderPerformanceUpsert {
+	u.Set(providerperformance.FieldScore, v)
+	return u
+}
+
+// UpdateScore sets the "score" field to the value that was provided on create.
+func (u *ProviderPerformanceUpsert) UpdateScore() *ProviderPerformanceUpsert {
+	u.SetExcluded(providerperformance.FieldScore)
+	return u
+}
+
+// AddScore adds v to the "score" field.
+func (u *ProviderPerformanceUpsert) AddScore(v float64) *ProviderPerformanceUpsert {
+	u.Add(providerperformance.FieldScore, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ProviderPerformance.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ProviderPerformanceUpsertOne) UpdateNewValues() *ProviderPerformanceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(providerperformance.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProviderPerformance.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ProviderPerformanceUpsertOne) Ignore() *ProviderPerformanceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProviderPerformanceUpsertOne) DoNothing() *ProviderPerformanceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProviderPerformanceCreate.OnConflict
+// documentation for more info.
+func (u *ProviderPerformanceUpsertOne) Update(set func(*ProviderPerformanceUpsert)) *ProviderPerformanceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProviderPerformanceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProviderPerformanceUpsertOne) SetUpdatedAt(v time.Time) *ProviderPerformanceUpsertOne {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProviderPerformanceUpsertOne) UpdateUpdatedAt() *ProviderPerformanceUpsertOne {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetOrdersSettled sets the "orders_settled" field.
+func (u *ProviderPerformanceUpsertOne) SetOrdersSettled(v int) *ProviderPerformanceUpsertOne {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.SetOrdersSettled(v)
+	})
+}
+
+// AddOrdersSettled adds v to the "orders_settled" field.
+func (u *ProviderPerformanceUpsertOne) AddOrdersSettled(v int) *ProviderPerformanceUpsertOne {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.AddOrdersSettled(v)
+	})
+}
+
+// UpdateOrdersSettled sets the "orders_settled" field to the value that was provided on create.
+func (u *ProviderPerformanceUpsertOne) UpdateOrdersSettled() *ProviderPerformanceUpsertOne {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.UpdateOrdersSettled()
+	})
+}
+
+// SetOrdersFailed sets the "orders_failed" field.
+func (u *ProviderPerformanceUpsertOne) SetOrdersFailed(v int) *ProviderPerformanceUpsertOne {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.SetOrdersFailed(v)
+	})
+}
+
+// AddOrdersFailed adds v to the "orders_failed" field.
+func (u *ProviderPerformanceUpsertOne) AddOrdersFailed(v int) *ProviderPerformanceUpsertOne {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.AddOrdersFailed(v)
+	})
+}
+
+// UpdateOrdersFailed sets the "orders_failed" field to the value that was provided on create.
+func (u *ProviderPerformanceUpsertOne) UpdateOrdersFailed() *ProviderPerformanceUpsertOne {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.UpdateOrdersFailed()
+	})
+}
+
+// SetOrdersCancelled sets the "orders_cancelled" field.
+func (u *ProviderPerformanceUpsertOne) SetOrdersCancelled(v int) *ProviderPerformanceUpsertOne {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.SetOrdersCancelled(v)
+	})
+}
+
+// AddOrdersCancelled adds v to the "orders_cancelled" field.
+func (u *ProviderPerformanceUpsertOne) AddOrdersCancelled(v int) *ProviderPerformanceUpsertOne {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.AddOrdersCancelled(v)
+	})
+}
+
+// UpdateOrdersCancelled sets the "orders_cancelled" field to the value that was provided on create.
+func (u *ProviderPerformanceUpsertOne) UpdateOrdersCancelled() *ProviderPerformanceUpsertOne {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.UpdateOrdersCancelled()
+	})
+}
+
+// SetSuccessRate sets the "success_rate" field.
+func (u *ProviderPerformanceUpsertOne) SetSuccessRate(v float64) *ProviderPerformanceUpsertOne {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.SetSuccessRate(v)
+	})
+}
+
+// AddSuccessRate adds v to the "success_rate" field.
+func (u *ProviderPerformanceUpsertOne) AddSuccessRate(v float64) *ProviderPerformanceUpsertOne {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.AddSuccessRate(v)
+	})
+}
+
+// UpdateSuccessRate sets the "success_rate" field to the value that was provided on create.
+func (u *ProviderPerformanceUpsertOne) UpdateSuccessRate() *ProviderPerformanceUpsertOne {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.UpdateSuccessRate()
+	})
+}
+
+// SetCancellationRate sets the "cancellation_rate" field.
+func (u *ProviderPerformanceUpsertOne) SetCancellationRate(v float64) *ProviderPerformanceUpsertOne {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.SetCancellationRate(v)
+	})
+}
+
+// AddCancellationRate adds v to the "cancellation_rate" field.
+func (u *ProviderPerformanceUpsertOne) AddCancellationRate(v float64) *ProviderPerformanceUpsertOne {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.AddCancellationRate(v)
+	})
+}
+
+// UpdateCancellationRate sets the "cancellation_rate" field to the value that was provided on create.
+func (u *ProviderPerformanceUpsertOne) UpdateCancellationRate() *ProviderPerformanceUpsertOne {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.UpdateCancellationRate()
+	})
+}
+
+// SetAvgSettlementSeconds sets the "avg_settlement_seconds" field.
+func (u *ProviderPerformanceUpsertOne) SetAvgSettlementSeconds(v float64) *ProviderPerformanceUpsertOne {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.SetAvgSettlementSeconds(v)
+	})
+}
+
+// AddAvgSettlementSeconds adds v to the "avg_settlement_seconds" field.
+func (u *ProviderPerformanceUpsertOne) AddAvgSettlementSeconds(v float64) *ProviderPerformanceUpsertOne {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.AddAvgSettlementSeconds(v)
+	})
+}
+
+// UpdateAvgSettlementSeconds sets the "avg_settlement_seconds" field to the value that was provided on create.
+func (u *ProviderPerformanceUpsertOne) UpdateAvgSettlementSeconds() *ProviderPerformanceUpsertOne {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.UpdateAvgSettlementSeconds()
+	})
+}
+
+// SetScore sets the "score" field.
+func (u *ProviderPerformanceUpsertOne) SetScore(v float64) *ProviderPerformanceUpsertOne {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.SetScore(v)
+	})
+}
+
+// AddScore adds v to the "score" field.
+func (u *ProviderPerformanceUpsertOne) AddScore(v float64) *ProviderPerformanceUpsertOne {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.AddScore(v)
+	})
+}
+
+// UpdateScore sets the "score" field to the value that was provided on create.
+func (u *ProviderPerformanceUpsertOne) UpdateScore() *ProviderPerformanceUpsertOne {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.UpdateScore()
+	})
+}
+
+// Exec executes the query.
+func (u *ProviderPerformanceUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProviderPerformanceCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProviderPerformanceUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ProviderPerformanceUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ProviderPerformanceUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ProviderPerformanceCreateBulk is the builder for creating many ProviderPerformance entities in bulk.
+type ProviderPerformanceCreateBulk struct {
+	config
+	err      error
+	builders []*ProviderPerformanceCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ProviderPerformance entities in the database.
+func (ppcb *ProviderPerformanceCreateBulk) Save(ctx context.Context) ([]*ProviderPerformance, error) {
+	if ppcb.err != nil {
+		return nil, ppcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ppcb.builders))
+	nodes := make([]*ProviderPerformance, len(ppcb.builders))
+	mutators := make([]Mutator, len(ppcb.builders))
+	for i := range ppcb.builders {
+		func(i int, root context.Context) {
+			builder := ppcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProviderPerformanceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ppcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ppcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ppcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ppcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ppcb *ProviderPerformanceCreateBulk) SaveX(ctx context.Context) []*ProviderPerformance {
+	v, err := ppcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ppcb *ProviderPerformanceCreateBulk) Exec(ctx context.Context) error {
+	_, err := ppcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ppcb *ProviderPerformanceCreateBulk) ExecX(ctx context.Context) {
+	if err := ppcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProviderPerformance.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProviderPerformanceUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (ppcb *ProviderPerformanceCreateBulk) OnConflict(opts ...sql.ConflictOption) *ProviderPerformanceUpsertBulk {
+	ppcb.conflict = opts
+	return &ProviderPerformanceUpsertBulk{
+		create: ppcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProviderPerformance.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ppcb *ProviderPerformanceCreateBulk) OnConflictColumns(columns ...string) *ProviderPerformanceUpsertBulk {
+	ppcb.conflict = append(ppcb.conflict, sql.ConflictColumns(columns...))
+	return &ProviderPerformanceUpsertBulk{
+		create: ppcb,
+	}
+}
+
+// ProviderPerformanceUpsertBulk is the builder for "upsert"-ing
+// a bulk of ProviderPerformance nodes.
+type ProviderPerformanceUpsertBulk struct {
+	create *ProviderPerformanceCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ProviderPerformance.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ProviderPerformanceUpsertBulk) UpdateNewValues() *ProviderPerformanceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(providerperformance.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProviderPerformance.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ProviderPerformanceUpsertBulk) Ignore() *ProviderPerformanceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProviderPerformanceUpsertBulk) DoNothing() *ProviderPerformanceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProviderPerformanceCreateBulk.OnConflict
+// documentation for more info.
+func (u *ProviderPerformanceUpsertBulk) Update(set func(*ProviderPerformanceUpsert)) *ProviderPerformanceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProviderPerformanceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProviderPerformanceUpsertBulk) SetUpdatedAt(v time.Time) *ProviderPerformanceUpsertBulk {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProviderPerformanceUpsertBulk) UpdateUpdatedAt() *ProviderPerformanceUpsertBulk {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetOrdersSettled sets the "orders_settled" field.
+func (u *ProviderPerformanceUpsertBulk) SetOrdersSettled(v int) *ProviderPerformanceUpsertBulk {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.SetOrdersSettled(v)
+	})
+}
+
+// AddOrdersSettled adds v to the "orders_settled" field.
+func (u *ProviderPerformanceUpsertBulk) AddOrdersSettled(v int) *ProviderPerformanceUpsertBulk {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.AddOrdersSettled(v)
+	})
+}
+
+// UpdateOrdersSettled sets the "orders_settled" field to the value that was provided on create.
+func (u *ProviderPerformanceUpsertBulk) UpdateOrdersSettled() *ProviderPerformanceUpsertBulk {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.UpdateOrdersSettled()
+	})
+}
+
+// SetOrdersFailed sets the "orders_failed" field.
+func (u *ProviderPerformanceUpsertBulk) SetOrdersFailed(v int) *ProviderPerformanceUpsertBulk {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.SetOrdersFailed(v)
+	})
+}
+
+// AddOrdersFailed adds v to the "orders_failed" field.
+func (u *ProviderPerformanceUpsertBulk) AddOrdersFailed(v int) *ProviderPerformanceUpsertBulk {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.AddOrdersFailed(v)
+	})
+}
+
+// UpdateOrdersFailed sets the "orders_failed" field to the value that was provided on create.
+func (u *ProviderPerformanceUpsertBulk) UpdateOrdersFailed() *ProviderPerformanceUpsertBulk {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.UpdateOrdersFailed()
+	})
+}
+
+// SetOrdersCancelled sets the "orders_cancelled" field.
+func (u *ProviderPerformanceUpsertBulk) SetOrdersCancelled(v int) *ProviderPerformanceUpsertBulk {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.SetOrdersCancelled(v)
+	})
+}
+
+// AddOrdersCancelled adds v to the "orders_cancelled" field.
+func (u *ProviderPerformanceUpsertBulk) AddOrdersCancelled(v int) *ProviderPerformanceUpsertBulk {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.AddOrdersCancelled(v)
+	})
+}
+
+// UpdateOrdersCancelled sets the "orders_cancelled" field to the value that was provided on create.
+func (u *ProviderPerformanceUpsertBulk) UpdateOrdersCancelled() *ProviderPerformanceUpsertBulk {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.UpdateOrdersCancelled()
+	})
+}
+
+// SetSuccessRate sets the "success_rate" field.
+func (u *ProviderPerformanceUpsertBulk) SetSuccessRate(v float64) *ProviderPerformanceUpsertBulk {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.SetSuccessRate(v)
+	})
+}
+
+// AddSuccessRate adds v to the "success_rate" field.
+func (u *ProviderPerformanceUpsertBulk) AddSuccessRate(v float64) *ProviderPerformanceUpsertBulk {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.AddSuccessRate(v)
+	})
+}
+
+// UpdateSuccessRate sets the "success_rate" field to the value that was provided on create.
+func (u *ProviderPerformanceUpsertBulk) UpdateSuccessRate() *ProviderPerformanceUpsertBulk {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.UpdateSuccessRate()
+	})
+}
+
+// SetCancellationRate sets the "cancellation_rate" field.
+func (u *ProviderPerformanceUpsertBulk) SetCancellationRate(v float64) *ProviderPerformanceUpsertBulk {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.SetCancellationRate(v)
+	})
+}
+
+// AddCancellationRate adds v to the "cancellation_rate" field.
+func (u *ProviderPerformanceUpsertBulk) AddCancellationRate(v float64) *ProviderPerformanceUpsertBulk {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.AddCancellationRate(v)
+	})
+}
+
+// UpdateCancellationRate sets the "cancellation_rate" field to the value that was provided on create.
+func (u *ProviderPerformanceUpsertBulk) UpdateCancellationRate() *ProviderPerformanceUpsertBulk {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.UpdateCancellationRate()
+	})
+}
+
+// SetAvgSettlementSeconds sets the "avg_settlement_seconds" field.
+func (u *ProviderPerformanceUpsertBulk) SetAvgSettlementSeconds(v float64) *ProviderPerformanceUpsertBulk {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.SetAvgSettlementSeconds(v)
+	})
+}
+
+// AddAvgSettlementSeconds adds v to the "avg_settlement_seconds" field.
+func (u *ProviderPerformanceUpsertBulk) AddAvgSettlementSeconds(v float64) *ProviderPerformanceUpsertBulk {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.AddAvgSettlementSeconds(v)
+	})
+}
+
+// UpdateAvgSettlementSeconds sets the "avg_settlement_seconds" field to the value that was provided on create.
+func (u *ProviderPerformanceUpsertBulk) UpdateAvgSettlementSeconds() *ProviderPerformanceUpsertBulk {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.UpdateAvgSettlementSeconds()
+	})
+}
+
+// SetScore sets the "score" field.
+func (u *ProviderPerformanceUpsertBulk) SetScore(v float64) *ProviderPerformanceUpsertBulk {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.SetScore(v)
+	})
+}
+
+// AddScore adds v to the "score" field.
+func (u *ProviderPerformanceUpsertBulk) AddScore(v float64) *ProviderPerformanceUpsertBulk {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.AddScore(v)
+	})
+}
+
+// UpdateScore sets the "score" field to the value that was provided on create.
+func (u *ProviderPerformanceUpsertBulk) UpdateScore() *ProviderPerformanceUpsertBulk {
+	return u.Update(func(s *ProviderPerformanceUpsert) {
+		s.UpdateScore()
+	})
+}
+
+// Exec executes the query.
+func (u *ProviderPerformanceUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ProviderPerformanceCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProviderPerformanceCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProviderPerformanceUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/NEDA-LABS/stablenode/ent/providerperformance"
+)
+
+// ProviderPerformanceDelete is the builder for deleting a ProviderPerformance entity.
+type ProviderPerformanceDelete struct {
+	config
+	hooks    []Hook
+	mutation *ProviderPerformanceMutation
+}
+
+// Where appends a list predicates to the ProviderPerformanceDelete builder.
+func (ppd *ProviderPerformanceDelete) Where(ps ...predicate.ProviderPerformance) *ProviderPerformanceDelete {
+	ppd.mutation.Where(ps...)
+	return ppd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ppd *ProviderPerformanceDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ppd.sqlExec, ppd.mutation, ppd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ppd *ProviderPerformanceDelete) ExecX(ctx context.Context) int {
+	n, err := ppd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ppd *ProviderPerformanceDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(providerperformance.Table, sqlgraph.NewFieldSpec(providerperformance.FieldID, field.TypeInt))
+	if ps := ppd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ppd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ppd.mutation.done = true
+	return affected, err
+}
+
+// ProviderPerformanceDeleteOne is the builder for deleting a single ProviderPerformance entity.
+type ProviderPerformanceDeleteOne struct {
+	ppd *ProviderPerformanceDelete
+}
+
+// Where appends a list predicates to the ProviderPerformanceDelete builder.
+func (ppdo *ProviderPerformanceDeleteOne) Where(ps ...predicate.ProviderPerformance) *ProviderPerformanceDeleteOne {
+	ppdo.ppd.mutation.Where(ps...)
+	return ppdo
+}
+
+// Exec executes the deletion query.
+func (ppdo *ProviderPerformanceDeleteOne) Exec(ctx context.Context) error {
+	n, err := ppdo.ppd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{providerperformance.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ppdo *ProviderPerformanceDeleteOne) ExecX(ctx context.Context) {
+	if err := ppdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
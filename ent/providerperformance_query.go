@@ -0,0 +1,614 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/NEDA-LABS/stablenode/ent/providerperformance"
+	"github.com/NEDA-LABS/stablenode/ent/providerprofile"
+)
+
+// ProviderPerformanceQuery is the builder for querying ProviderPerformance entities.
+type ProviderPerformanceQuery struct {
+	config
+	ctx          *QueryContext
+	order        []providerperformance.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.ProviderPerformance
+	withProvider *ProviderProfileQuery
+	withFKs      bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ProviderPerformanceQuery builder.
+func (ppq *ProviderPerformanceQuery) Where(ps ...predicate.ProviderPerformance) *ProviderPerformanceQuery {
+	ppq.predicates = append(ppq.predicates, ps...)
+	return ppq
+}
+
+// Limit the number of records to be returned by this query.
+func (ppq *ProviderPerformanceQuery) Limit(limit int) *ProviderPerformanceQuery {
+	ppq.ctx.Limit = &limit
+	return ppq
+}
+
+// Offset to start from.
+func (ppq *ProviderPerformanceQuery) Offset(offset int) *ProviderPerformanceQuery {
+	ppq.ctx.Offset = &offset
+	return ppq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ppq *ProviderPerformanceQuery) Unique(unique bool) *ProviderPerformanceQuery {
+	ppq.ctx.Unique = &unique
+	return ppq
+}
+
+// Order specifies how the records should be ordered.
+func (ppq *ProviderPerformanceQuery) Order(o ...providerperformance.OrderOption) *ProviderPerformanceQuery {
+	ppq.order = append(ppq.order, o...)
+	return ppq
+}
+
+// QueryProvider chains the current query on the "provider" edge.
+func (ppq *ProviderPerformanceQuery) QueryProvider() *ProviderProfileQuery {
+	query := (&ProviderProfileClient{config: ppq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ppq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ppq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerperformance.Table, providerperformance.FieldID, selector),
+			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, providerperformance.ProviderTable, providerperformance.ProviderColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ppq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ProviderPerformance entity from the query.
+// Returns a *NotFoundError when no ProviderPerformance was found.
+func (ppq *ProviderPerformanceQuery) First(ctx context.Context) (*ProviderPerformance, error) {
+	nodes, err := ppq.Limit(1).All(setContextOp(ctx, ppq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{providerperformance.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ppq *ProviderPerformanceQuery) FirstX(ctx context.Context) *ProviderPerformance {
+	node, err := ppq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ProviderPerformance ID from the query.
+// Returns a *NotFoundError when no ProviderPerformance ID was found.
+func (ppq *ProviderPerformanceQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ppq.Limit(1).IDs(setContextOp(ctx, ppq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{providerperformance.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ppq *ProviderPerformanceQuery) FirstIDX(ctx context.Context) int {
+	id, err := ppq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ProviderPerformance entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ProviderPerformance entity is found.
+// Returns a *NotFoundError when no ProviderPerformance entities are found.
+func (ppq *ProviderPerformanceQuery) Only(ctx context.Context) (*ProviderPerformance, error) {
+	nodes, err := ppq.Limit(2).All(setContextOp(ctx, ppq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{providerperformance.Label}
+	default:
+		return nil, &NotSingularError{providerperformance.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ppq *ProviderPerformanceQuery) OnlyX(ctx context.Context) *ProviderPerformance {
+	node, err := ppq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ProviderPerformance ID in the query.
+// Returns a *NotSingularError when more than one ProviderPerformance ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ppq *ProviderPerformanceQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ppq.Limit(2).IDs(setContextOp(ctx, ppq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{providerperformance.Label}
+	default:
+		err = &NotSingularError{providerperformance.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ppq *ProviderPerformanceQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ppq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ProviderPerformances.
+func (ppq *ProviderPerformanceQuery) All(ctx context.Context) ([]*ProviderPerformance, error) {
+	ctx = setContextOp(ctx, ppq.ctx, ent.OpQueryAll)
+	if err := ppq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ProviderPerformance, *ProviderPerformanceQuery]()
+	return withInterceptors[[]*ProviderPerformance](ctx, ppq, qr, ppq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ppq *ProviderPerformanceQuery) AllX(ctx context.Context) []*ProviderPerformance {
+	nodes, err := ppq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ProviderPerformance IDs.
+func (ppq *ProviderPerformanceQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if ppq.ctx.Unique == nil && ppq.path != nil {
+		ppq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ppq.ctx, ent.OpQueryIDs)
+	if err = ppq.Select(providerperformance.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ppq *ProviderPerformanceQuery) IDsX(ctx context.Context) []int {
+	ids, err := ppq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ppq *ProviderPerformanceQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ppq.ctx, ent.OpQueryCount)
+	if err := ppq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ppq, querierCount[*ProviderPerformanceQuery](), ppq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ppq *ProviderPerformanceQuery) CountX(ctx context.Context) int {
+	count, err := ppq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ppq *ProviderPerformanceQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ppq.ctx, ent.OpQueryExist)
+	switch _, err := ppq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ppq *ProviderPerformanceQuery) ExistX(ctx context.Context) bool {
+	exist, err := ppq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ProviderPerformanceQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ppq *ProviderPerformanceQuery) Clone() *ProviderPerformanceQuery {
+	if ppq == nil {
+		return nil
+	}
+	return &ProviderPerformanceQuery{
+		config:       ppq.config,
+		ctx:          ppq.ctx.Clone(),
+		order:        append([]providerperformance.OrderOption{}, ppq.order...),
+		inters:       append([]Interceptor{}, ppq.inters...),
+		predicates:   append([]predicate.ProviderPerformance{}, ppq.predicates...),
+		withProvider: ppq.withProvider.Clone(),
+		// clone intermediate query.
+		sql:  ppq.sql.Clone(),
+		path: ppq.path,
+	}
+}
+
+// WithProvider tells the query-builder to eager-load the nodes that are connected to
+// the "provider" edge. The optional arguments are used to configure the query builder of the edge.
+func (ppq *ProviderPerformanceQuery) WithProvider(opts ...func(*ProviderProfileQuery)) *ProviderPerformanceQuery {
+	query := (&ProviderProfileClient{config: ppq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ppq.withProvider = query
+	return ppq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ProviderPerformance.Query().
+//		GroupBy(providerperformance.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ppq *ProviderPerformanceQuery) GroupBy(field string, fields ...string) *ProviderPerformanceGroupBy {
+	ppq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ProviderPerformanceGroupBy{build: ppq}
+	grbuild.flds = &ppq.ctx.Fields
+	grbuild.label = providerperformance.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.ProviderPerformance.Query().
+//		Select(providerperformance.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (ppq *ProviderPerformanceQuery) Select(fields ...string) *ProviderPerformanceSelect {
+	ppq.ctx.Fields = append(ppq.ctx.Fields, fields...)
+	sbuild := &ProviderPerformanceSelect{ProviderPerformanceQuery: ppq}
+	sbuild.label = providerperformance.Label
+	sbuild.flds, sbuild.scan = &ppq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ProviderPerformanceSelect configured with the given aggregations.
+func (ppq *ProviderPerformanceQuery) Aggregate(fns ...AggregateFunc) *ProviderPerformanceSelect {
+	return ppq.Select().Aggregate(fns...)
+}
+
+func (ppq *ProviderPerformanceQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ppq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ppq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ppq.ctx.Fields {
+		if !providerperformance.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ppq.path != nil {
+		prev, err := ppq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ppq.sql = prev
+	}
+	return nil
+}
+
+func (ppq *ProviderPerformanceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ProviderPerformance, error) {
+	var (
+		nodes       = []*ProviderPerformance{}
+		withFKs     = ppq.withFKs
+		_spec       = ppq.querySpec()
+		loadedTypes = [1]bool{
+			ppq.withProvider != nil,
+		}
+	)
+	if ppq.withProvider != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, providerperformance.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ProviderPerformance).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ProviderPerformance{config: ppq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ppq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := ppq.withProvider; query != nil {
+		if err := ppq.loadProvider(ctx, query, nodes, nil,
+			func(n *ProviderPerformance, e *ProviderProfile) { n.Edges.Provider = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (ppq *ProviderPerformanceQuery) loadProvider(ctx context.Context, query *ProviderProfileQuery, nodes []*ProviderPerformance, init func(*ProviderPerformance), assign func(*ProviderPerformance, *ProviderProfile)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*ProviderPerformance)
+	for i := range nodes {
+		if nodes[i].provider_profile_performance == nil {
+			continue
+		}
+		fk := *nodes[i].provider_profile_performance
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(providerprofile.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "provider_profile_performance" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (ppq *ProviderPerformanceQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ppq.querySpec()
+	_spec.Node.Columns = ppq.ctx.Fields
+	if len(ppq.ctx.Fields) > 0 {
+		_spec.Unique = ppq.ctx.Unique != nil && *ppq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ppq.driver, _spec)
+}
+
+func (ppq *ProviderPerformanceQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(providerperformance.Table, providerperformance.Columns, sqlgraph.NewFieldSpec(providerperformance.FieldID, field.TypeInt))
+	_spec.From = ppq.sql
+	if unique := ppq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ppq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ppq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, providerperformance.FieldID)
+		for i := range fields {
+			if fields[i] != providerperformance.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ppq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ppq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ppq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ppq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ppq *ProviderPerformanceQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ppq.driver.Dialect())
+	t1 := builder.Table(providerperformance.Table)
+	columns := ppq.ctx.Fields
+	if len(columns) == 0 {
+		columns = providerperformance.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ppq.sql != nil {
+		selector = ppq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ppq.ctx.Unique != nil && *ppq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range ppq.predicates {
+		p(selector)
+	}
+	for _, p := range ppq.order {
+		p(selector)
+	}
+	if offset := ppq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ppq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ProviderPerformanceGroupBy is the group-by builder for ProviderPerformance entities.
+type ProviderPerformanceGroupBy struct {
+	selector
+	build *ProviderPerformanceQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ppgb *ProviderPerformanceGroupBy) Aggregate(fns ...AggregateFunc) *ProviderPerformanceGroupBy {
+	ppgb.fns = append(ppgb.fns, fns...)
+	return ppgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ppgb *ProviderPerformanceGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ppgb.build.ctx, ent.OpQueryGroupBy)
+	if err := ppgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProviderPerformanceQuery, *ProviderPerformanceGroupBy](ctx, ppgb.build, ppgb, ppgb.build.inters, v)
+}
+
+func (ppgb *ProviderPerformanceGroupBy) sqlScan(ctx context.Context, root *ProviderPerformanceQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ppgb.fns))
+	for _, fn := range ppgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ppgb.flds)+len(ppgb.fns))
+		for _, f := range *ppgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ppgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ppgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ProviderPerformanceSelect is the builder for selecting fields of ProviderPerformance entities.
+type ProviderPerformanceSelect struct {
+	*ProviderPerformanceQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pps *ProviderPerformanceSelect) Aggregate(fns ...AggregateFunc) *ProviderPerformanceSelect {
+	pps.fns = append(pps.fns, fns...)
+	return pps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pps *ProviderPerformanceSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pps.ctx, ent.OpQuerySelect)
+	if err := pps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProviderPerformanceQuery, *ProviderPerformanceSelect](ctx, pps.ProviderPerformanceQuery, pps, pps.inters, v)
+}
+
+func (pps *ProviderPerformanceSelect) sqlScan(ctx context.Context, root *ProviderPerformanceQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pps.fns))
+	for _, fn := range pps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
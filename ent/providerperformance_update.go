@@ -0,0 +1,705 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/NEDA-LABS/stablenode/ent/providerperformance"
+	"github.com/NEDA-LABS/stablenode/ent/providerprofile"
+)
+
+// ProviderPerformanceUpdate is the builder for updating ProviderPerformance entities.
+type ProviderPerformanceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProviderPerformanceMutation
+}
+
+// Where appends a list predicates to the ProviderPerformanceUpdate builder.
+func (ppu *ProviderPerformanceUpdate) Where(ps ...predicate.ProviderPerformance) *ProviderPerformanceUpdate {
+	ppu.mutation.Where(ps...)
+	return ppu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ppu *ProviderPerformanceUpdate) SetUpdatedAt(t time.Time) *ProviderPerformanceUpdate {
+	ppu.mutation.SetUpdatedAt(t)
+	return ppu
+}
+
+// SetOrdersSettled sets the "orders_settled" field.
+func (ppu *ProviderPerformanceUpdate) SetOrdersSettled(i int) *ProviderPerformanceUpdate {
+	ppu.mutation.ResetOrdersSettled()
+	ppu.mutation.SetOrdersSettled(i)
+	return ppu
+}
+
+// SetNillableOrdersSettled sets the "orders_settled" field if the given value is not nil.
+func (ppu *ProviderPerformanceUpdate) SetNillableOrdersSettled(i *int) *ProviderPerformanceUpdate {
+	if i != nil {
+		ppu.SetOrdersSettled(*i)
+	}
+	return ppu
+}
+
+// AddOrdersSettled adds i to the "orders_settled" field.
+func (ppu *ProviderPerformanceUpdate) AddOrdersSettled(i int) *ProviderPerformanceUpdate {
+	ppu.mutation.AddOrdersSettled(i)
+	return ppu
+}
+
+// SetOrdersFailed sets the "orders_failed" field.
+func (ppu *ProviderPerformanceUpdate) SetOrdersFailed(i int) *ProviderPerformanceUpdate {
+	ppu.mutation.ResetOrdersFailed()
+	ppu.mutation.SetOrdersFailed(i)
+	return ppu
+}
+
+// SetNillableOrdersFailed sets the "orders_failed" field if the given value is not nil.
+func (ppu *ProviderPerformanceUpdate) SetNillableOrdersFailed(i *int) *ProviderPerformanceUpdate {
+	if i != nil {
+		ppu.SetOrdersFailed(*i)
+	}
+	return ppu
+}
+
+// AddOrdersFailed adds i to the "orders_failed" field.
+func (ppu *ProviderPerformanceUpdate) AddOrdersFailed(i int) *ProviderPerformanceUpdate {
+	ppu.mutation.AddOrdersFailed(i)
+	return ppu
+}
+
+// SetOrdersCancelled sets the "orders_cancelled" field.
+func (ppu *ProviderPerformanceUpdate) SetOrdersCancelled(i int) *ProviderPerformanceUpdate {
+	ppu.mutation.ResetOrdersCancelled()
+	ppu.mutation.SetOrdersCancelled(i)
+	return ppu
+}
+
+// SetNillableOrdersCancelled sets the "orders_cancelled" field if the given value is not nil.
+func (ppu *ProviderPerformanceUpdate) SetNillableOrdersCancelled(i *int) *ProviderPerformanceUpdate {
+	if i != nil {
+		ppu.SetOrdersCancelled(*i)
+	}
+	return ppu
+}
+
+// AddOrdersCancelled adds i to the "orders_cancelled" field.
+func (ppu *ProviderPerformanceUpdate) AddOrdersCancelled(i int) *ProviderPerformanceUpdate {
+	ppu.mutation.AddOrdersCancelled(i)
+	return ppu
+}
+
+// SetSuccessRate sets the "success_rate" field.
+func (ppu *ProviderPerformanceUpdate) SetSuccessRate(f float64) *ProviderPerformanceUpdate {
+	ppu.mutation.ResetSuccessRate()
+	ppu.mutation.SetSuccessRate(f)
+	return ppu
+}
+
+// SetNillableSuccessRate sets the "success_rate" field if the given value is not nil.
+func (ppu *ProviderPerformanceUpdate) SetNillableSuccessRate(f *float64) *ProviderPerformanceUpdate {
+	if f != nil {
+		ppu.SetSuccessRate(*f)
+	}
+	return ppu
+}
+
+// AddSuccessRate adds f to the "success_rate" field.
+func (ppu *ProviderPerformanceUpdate) AddSuccessRate(f float64) *ProviderPerformanceUpdate {
+	ppu.mutation.AddSuccessRate(f)
+	return ppu
+}
+
+// SetCancellationRate sets the "cancellation_rate" field.
+func (ppu *ProviderPerformanceUpdate) SetCancellationRate(f float64) *ProviderPerformanceUpdate {
+	ppu.mutation.ResetCancellationRate()
+	ppu.mutation.SetCancellationRate(f)
+	return ppu
+}
+
+// SetNillableCancellationRate sets the "cancellation_rate" field if the given value is not nil.
+func (ppu *ProviderPerformanceUpdate) SetNillableCancellationRate(f *float64) *ProviderPerformanceUpdate {
+	if f != nil {
+		ppu.SetCancellationRate(*f)
+	}
+	return ppu
+}
+
+// AddCancellationRate adds f to the "cancellation_rate" field.
+func (ppu *ProviderPerformanceUpdate) AddCancellationRate(f float64) *ProviderPerformanceUpdate {
+	ppu.mutation.AddCancellationRate(f)
+	return ppu
+}
+
+// SetAvgSettlementSeconds sets the "avg_settlement_seconds" field.
+func (ppu *ProviderPerformanceUpdate) SetAvgSettlementSeconds(f float64) *ProviderPerformanceUpdate {
+	ppu.mutation.ResetAvgSettlementSeconds()
+	ppu.mutation.SetAvgSettlementSeconds(f)
+	return ppu
+}
+
+// SetNillableAvgSettlementSeconds sets the "avg_settlement_seconds" field if the given value is not nil.
+func (ppu *ProviderPerformanceUpdate) SetNillableAvgSettlementSeconds(f *float64) *ProviderPerformanceUpdate {
+	if f != nil {
+		ppu.SetAvgSettlementSeconds(*f)
+	}
+	return ppu
+}
+
+// AddAvgSettlementSeconds adds f to the "avg_settlement_seconds" field.
+func (ppu *ProviderPerformanceUpdate) AddAvgSettlementSeconds(f float64) *ProviderPerformanceUpdate {
+	ppu.mutation.AddAvgSettlementSeconds(f)
+	return ppu
+}
+
+// SetScore sets the "score" field.
+func (ppu *ProviderPerformanceUpdate) SetScore(f float64) *ProviderPerformanceUpdate {
+	ppu.mutation.ResetScore()
+	ppu.mutation.SetScore(f)
+	return ppu
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (ppu *ProviderPerformanceUpdate) SetNillableScore(f *float64) *ProviderPerformanceUpdate {
+	if f != nil {
+		ppu.SetScore(*f)
+	}
+	return ppu
+}
+
+// AddScore adds f to the "score" field.
+func (ppu *ProviderPerformanceUpdate) AddScore(f float64) *ProviderPerformanceUpdate {
+	ppu.mutation.AddScore(f)
+	return ppu
+}
+
+// SetProviderID sets the "provider" edge to the ProviderProfile entity by ID.
+func (ppu *ProviderPerformanceUpdate) SetProviderID(id string) *ProviderPerformanceUpdate {
+	ppu.mutation.SetProviderID(id)
+	return ppu
+}
+
+// SetProvider sets the "provider" edge to the ProviderProfile entity.
+func (ppu *ProviderPerformanceUpdate) SetProvider(p *ProviderProfile) *ProviderPerformanceUpdate {
+	return ppu.SetProviderID(p.ID)
+}
+
+// Mutation returns the ProviderPerformanceMutation object of the builder.
+func (ppu *ProviderPerformanceUpdate) Mutation() *ProviderPerformanceMutation {
+	return ppu.mutation
+}
+
+// ClearProvider clears the "provider" edge to the ProviderProfile entity.
+func (ppu *ProviderPerformanceUpdate) ClearProvider() *ProviderPerformanceUpdate {
+	ppu.mutation.ClearProvider()
+	return ppu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ppu *ProviderPerformanceUpdate) Save(ctx context.Context) (int, error) {
+	ppu.defaults()
+	return withHooks(ctx, ppu.sqlSave, ppu.mutation, ppu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ppu *ProviderPerformanceUpdate) SaveX(ctx context.Context) int {
+	affected, err := ppu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ppu *ProviderPerformanceUpdate) Exec(ctx context.Context) error {
+	_, err := ppu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ppu *ProviderPerformanceUpdate) ExecX(ctx context.Context) {
+	if err := ppu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ppu *ProviderPerformanceUpdate) defaults() {
+	if _, ok := ppu.mutation.UpdatedAt(); !ok {
+		v := providerperformance.UpdateDefaultUpdatedAt()
+		ppu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ppu *ProviderPerformanceUpdate) check() error {
+	if ppu.mutation.ProviderCleared() && len(ppu.mutation.ProviderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProviderPerformance.provider"`)
+	}
+	return nil
+}
+
+func (ppu *ProviderPerformanceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ppu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(providerperformance.Table, providerperformance.Columns, sqlgraph.NewFieldSpec(providerperformance.FieldID, field.TypeInt))
+	if ps := ppu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ppu.mutation.UpdatedAt(); ok {
+		_spec.SetField(providerperformance.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ppu.mutation.OrdersSettled(); ok {
+		_spec.SetField(providerperformance.FieldOrdersSettled, field.TypeInt, value)
+	}
+	if value, ok := ppu.mutation.AddedOrdersSettled(); ok {
+		_spec.AddField(providerperformance.FieldOrdersSettled, field.TypeInt, value)
+	}
+	if value, ok := ppu.mutation.OrdersFailed(); ok {
+		_spec.SetField(providerperformance.FieldOrdersFailed, field.TypeInt, value)
+	}
+	if value, ok := ppu.mutation.AddedOrdersFailed(); ok {
+		_spec.AddField(providerperformance.FieldOrdersFailed, field.TypeInt, value)
+	}
+	if value, ok := ppu.mutation.OrdersCancelled(); ok {
+		_spec.SetField(providerperformance.FieldOrdersCancelled, field.TypeInt, value)
+	}
+	if value, ok := ppu.mutation.AddedOrdersCancelled(); ok {
+		_spec.AddField(providerperformance.FieldOrdersCancelled, field.TypeInt, value)
+	}
+	if value, ok := ppu.mutation.SuccessRate(); ok {
+		_spec.SetField(providerperformance.FieldSuccessRate, field.TypeFloat64, value)
+	}
+	if value, ok := ppu.mutation.AddedSuccessRate(); ok {
+		_spec.AddField(providerperformance.FieldSuccessRate, field.TypeFloat64, value)
+	}
+	if value, ok := ppu.mutation.CancellationRate(); ok {
+		_spec.SetField(providerperformance.FieldCancellationRate, field.TypeFloat64, value)
+	}
+	if value, ok := ppu.mutation.AddedCancellationRate(); ok {
+		_spec.AddField(providerperformance.FieldCancellationRate, field.TypeFloat64, value)
+	}
+	if value, ok := ppu.mutation.AvgSettlementSeconds(); ok {
+		_spec.SetField(providerperformance.FieldAvgSettlementSeconds, field.TypeFloat64, value)
+	}
+	if value, ok := ppu.mutation.AddedAvgSettlementSeconds(); ok {
+		_spec.AddField(providerperformance.FieldAvgSettlementSeconds, field.TypeFloat64, value)
+	}
+	if value, ok := ppu.mutation.Score(); ok {
+		_spec.SetField(providerperformance.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := ppu.mutation.AddedScore(); ok {
+		_spec.AddField(providerperformance.FieldScore, field.TypeFloat64, value)
+	}
+	if ppu.mutation.ProviderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   providerperformance.ProviderTable,
+			Columns: []string{providerperformance.ProviderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerprofile.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppu.mutation.ProviderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   providerperformance.ProviderTable,
+			Columns: []string{providerperformance.ProviderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerprofile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ppu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{providerperformance.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ppu.mutation.done = true
+	return n, nil
+}
+
+// ProviderPerformanceUpdateOne is the builder for updating a single ProviderPerformance entity.
+type ProviderPerformanceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProviderPerformanceMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ppuo *ProviderPerformanceUpdateOne) SetUpdatedAt(t time.Time) *ProviderPerformanceUpdateOne {
+	ppuo.mutation.SetUpdatedAt(t)
+	return ppuo
+}
+
+// SetOrdersSettled sets the "orders_settled" field.
+func (ppuo *ProviderPerformanceUpdateOne) SetOrdersSettled(i int) *ProviderPerformanceUpdateOne {
+	ppuo.mutation.ResetOrdersSettled()
+	ppuo.mutation.SetOrdersSettled(i)
+	return ppuo
+}
+
+// SetNillableOrdersSettled sets the "orders_settled" field if the given value is not nil.
+func (ppuo *ProviderPerformanceUpdateOne) SetNillableOrdersSettled(i *int) *ProviderPerformanceUpdateOne {
+	if i != nil {
+		ppuo.SetOrdersSettled(*i)
+	}
+	return ppuo
+}
+
+// AddOrdersSettled adds i to the "orders_settled" field.
+func (ppuo *ProviderPerformanceUpdateOne) AddOrdersSettled(i int) *ProviderPerformanceUpdateOne {
+	ppuo.mutation.AddOrdersSettled(i)
+	return ppuo
+}
+
+// SetOrdersFailed sets the "orders_failed" field.
+func (ppuo *ProviderPerformanceUpdateOne) SetOrdersFailed(i int) *ProviderPerformanceUpdateOne {
+	ppuo.mutation.ResetOrdersFailed()
+	ppuo.mutation.SetOrdersFailed(i)
+	return ppuo
+}
+
+// SetNillableOrdersFailed sets the "orders_failed" field if the given value is not nil.
+func (ppuo *ProviderPerformanceUpdateOne) SetNillableOrdersFailed(i *int) *ProviderPerformanceUpdateOne {
+	if i != nil {
+		ppuo.SetOrdersFailed(*i)
+	}
+	return ppuo
+}
+
+// AddOrdersFailed adds i to the "orders_failed" field.
+func (ppuo *ProviderPerformanceUpdateOne) AddOrdersFailed(i int) *ProviderPerformanceUpdateOne {
+	ppuo.mutation.AddOrdersFailed(i)
+	return ppuo
+}
+
+// SetOrdersCancelled sets the "orders_cancelled" field.
+func (ppuo *ProviderPerformanceUpdateOne) SetOrdersCancelled(i int) *ProviderPerformanceUpdateOne {
+	ppuo.mutation.ResetOrdersCancelled()
+	ppuo.mutation.SetOrdersCancelled(i)
+	return ppuo
+}
+
+// SetNillableOrdersCancelled sets the "orders_cancelled" field if the given value is not nil.
+func (ppuo *ProviderPerformanceUpdateOne) SetNillableOrdersCancelled(i *int) *ProviderPerformanceUpdateOne {
+	if i != nil {
+		ppuo.SetOrdersCancelled(*i)
+	}
+	return ppuo
+}
+
+// AddOrdersCancelled adds i to the "orders_cancelled" field.
+func (ppuo *ProviderPerformanceUpdateOne) AddOrdersCancelled(i int) *ProviderPerformanceUpdateOne {
+	ppuo.mutation.AddOrdersCancelled(i)
+	return ppuo
+}
+
+// SetSuccessRate sets the "success_rate" field.
+func (ppuo *ProviderPerformanceUpdateOne) SetSuccessRate(f float64) *ProviderPerformanceUpdateOne {
+	ppuo.mutation.ResetSuccessRate()
+	ppuo.mutation.SetSuccessRate(f)
+	return ppuo
+}
+
+// SetNillableSuccessRate sets the "success_rate" field if the given value is not nil.
+func (ppuo *ProviderPerformanceUpdateOne) SetNillableSuccessRate(f *float64) *ProviderPerformanceUpdateOne {
+	if f != nil {
+		ppuo.SetSuccessRate(*f)
+	}
+	return ppuo
+}
+
+// AddSuccessRate adds f to the "success_rate" field.
+func (ppuo *ProviderPerformanceUpdateOne) AddSuccessRate(f float64) *ProviderPerformanceUpdateOne {
+	ppuo.mutation.AddSuccessRate(f)
+	return ppuo
+}
+
+// SetCancellationRate sets the "cancellation_rate" field.
+func (ppuo *ProviderPerformanceUpdateOne) SetCancellationRate(f float64) *ProviderPerformanceUpdateOne {
+	ppuo.mutation.ResetCancellationRate()
+	ppuo.mutation.SetCancellationRate(f)
+	return ppuo
+}
+
+// SetNillableCancellationRate sets the "cancellation_rate" field if the given value is not nil.
+func (ppuo *ProviderPerformanceUpdateOne) SetNillableCancellationRate(f *float64) *ProviderPerformanceUpdateOne {
+	if f != nil {
+		ppuo.SetCancellationRate(*f)
+	}
+	return ppuo
+}
+
+// AddCancellationRate adds f to the "cancellation_rate" field.
+func (ppuo *ProviderPerformanceUpdateOne) AddCancellationRate(f float64) *ProviderPerformanceUpdateOne {
+	ppuo.mutation.AddCancellationRate(f)
+	return ppuo
+}
+
+// SetAvgSettlementSeconds sets the "avg_settlement_seconds" field.
+func (ppuo *ProviderPerformanceUpdateOne) SetAvgSettlementSeconds(f float64) *ProviderPerformanceUpdateOne {
+	ppuo.mutation.ResetAvgSettlementSeconds()
+	ppuo.mutation.SetAvgSettlementSeconds(f)
+	return ppuo
+}
+
+// SetNillableAvgSettlementSeconds sets the "avg_settlement_seconds" field if the given value is not nil.
+func (ppuo *ProviderPerformanceUpdateOne) SetNillableAvgSettlementSeconds(f *float64) *ProviderPerformanceUpdateOne {
+	if f != nil {
+		ppuo.SetAvgSettlementSeconds(*f)
+	}
+	return ppuo
+}
+
+// AddAvgSettlementSeconds adds f to the "avg_settlement_seconds" field.
+func (ppuo *ProviderPerformanceUpdateOne) AddAvgSettlementSeconds(f float64) *ProviderPerformanceUpdateOne {
+	ppuo.mutation.AddAvgSettlementSeconds(f)
+	return ppuo
+}
+
+// SetScore sets the "score" field.
+func (ppuo *ProviderPerformanceUpdateOne) SetScore(f float64) *ProviderPerformanceUpdateOne {
+	ppuo.mutation.ResetScore()
+	ppuo.mutation.SetScore(f)
+	return ppuo
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (ppuo *ProviderPerformanceUpdateOne) SetNillableScore(f *float64) *ProviderPerformanceUpdateOne {
+	if f != nil {
+		ppuo.SetScore(*f)
+	}
+	return ppuo
+}
+
+// AddScore adds f to the "score" field.
+func (ppuo *ProviderPerformanceUpdateOne) AddScore(f float64) *ProviderPerformanceUpdateOne {
+	ppuo.mutation.AddScore(f)
+	return ppuo
+}
+
+// SetProviderID sets the "provider" edge to the ProviderProfile entity by ID.
+func (ppuo *ProviderPerformanceUpdateOne) SetProviderID(id string) *ProviderPerformanceUpdateOne {
+	ppuo.mutation.SetProviderID(id)
+	return ppuo
+}
+
+// SetProvider sets the "provider" edge to the ProviderProfile entity.
+func (ppuo *ProviderPerformanceUpdateOne) SetProvider(p *ProviderProfile) *ProviderPerformanceUpdateOne {
+	return ppuo.SetProviderID(p.ID)
+}
+
+// Mutation returns the ProviderPerformanceMutation object of the builder.
+func (ppuo *ProviderPerformanceUpdateOne) Mutation() *ProviderPerformanceMutation {
+	return ppuo.mutation
+}
+
+// ClearProvider clears the "provider" edge to the ProviderProfile entity.
+func (ppuo *ProviderPerformanceUpdateOne) ClearProvider() *ProviderPerformanceUpdateOne {
+	ppuo.mutation.ClearProvider()
+	return ppuo
+}
+
+// Where appends a list predicates to the ProviderPerformanceUpdate builder.
+func (ppuo *ProviderPerformanceUpdateOne) Where(ps ...predicate.ProviderPerformance) *ProviderPerformanceUpdateOne {
+	ppuo.mutation.Where(ps...)
+	return ppuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ppuo *ProviderPerformanceUpdateOne) Select(field string, fields ...string) *ProviderPerformanceUpdateOne {
+	ppuo.fields = append([]string{field}, fields...)
+	return ppuo
+}
+
+// Save executes the query and returns the updated ProviderPerformance entity.
+func (ppuo *ProviderPerformanceUpdateOne) Save(ctx context.Context) (*ProviderPerformance, error) {
+	ppuo.defaults()
+	return withHooks(ctx, ppuo.sqlSave, ppuo.mutation, ppuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ppuo *ProviderPerformanceUpdateOne) SaveX(ctx context.Context) *ProviderPerformance {
+	node, err := ppuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ppuo *ProviderPerformanceUpdateOne) Exec(ctx context.Context) error {
+	_, err := ppuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ppuo *ProviderPerformanceUpdateOne) ExecX(ctx context.Context) {
+	if err := ppuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ppuo *ProviderPerformanceUpdateOne) defaults() {
+	if _, ok := ppuo.mutation.UpdatedAt(); !ok {
+		v := providerperformance.UpdateDefaultUpdatedAt()
+		ppuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ppuo *ProviderPerformanceUpdateOne) check() error {
+	if ppuo.mutation.ProviderCleared() && len(ppuo.mutation.ProviderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProviderPerformance.provider"`)
+	}
+	return nil
+}
+
+func (ppuo *ProviderPerformanceUpdateOne) sqlSave(ctx context.Context) (_node *ProviderPerformance, err error) {
+	if err := ppuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(providerperformance.Table, providerperformance.Columns, sqlgraph.NewFieldSpec(providerperformance.FieldID, field.TypeInt))
+	id, ok := ppuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProviderPerformance.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ppuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, providerperformance.FieldID)
+		for _, f := range fields {
+			if !providerperformance.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != providerperformance.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ppuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ppuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(providerperformance.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ppuo.mutation.OrdersSettled(); ok {
+		_spec.SetField(providerperformance.FieldOrdersSettled, field.TypeInt, value)
+	}
+	if value, ok := ppuo.mutation.AddedOrdersSettled(); ok {
+		_spec.AddField(providerperformance.FieldOrdersSettled, field.TypeInt, value)
+	}
+	if value, ok := ppuo.mutation.OrdersFailed(); ok {
+		_spec.SetField(providerperformance.FieldOrdersFailed, field.TypeInt, value)
+	}
+	if value, ok := ppuo.mutation.AddedOrdersFailed(); ok {
+		_spec.AddField(providerperformance.FieldOrdersFailed, field.TypeInt, value)
+	}
+	if value, ok := ppuo.mutation.OrdersCancelled(); ok {
+		_spec.SetField(providerperformance.FieldOrdersCancelled, field.TypeInt, value)
+	}
+	if value, ok := ppuo.mutation.AddedOrdersCancelled(); ok {
+		_spec.AddField(providerperformance.FieldOrdersCancelled, field.TypeInt, value)
+	}
+	if value, ok := ppuo.mutation.SuccessRate(); ok {
+		_spec.SetField(providerperformance.FieldSuccessRate, field.TypeFloat64, value)
+	}
+	if value, ok := ppuo.mutation.AddedSuccessRate(); ok {
+		_spec.AddField(providerperformance.FieldSuccessRate, field.TypeFloat64, value)
+	}
+	if value, ok := ppuo.mutation.CancellationRate(); ok {
+		_spec.SetField(providerperformance.FieldCancellationRate, field.TypeFloat64, value)
+	}
+	if value, ok := ppuo.mutation.AddedCancellationRate(); ok {
+		_spec.AddField(providerperformance.FieldCancellationRate, field.TypeFloat64, value)
+	}
+	if value, ok := ppuo.mutation.AvgSettlementSeconds(); ok {
+		_spec.SetField(providerperformance.FieldAvgSettlementSeconds, field.TypeFloat64, value)
+	}
+	if value, ok := ppuo.mutation.AddedAvgSettlementSeconds(); ok {
+		_spec.AddField(providerperformance.FieldAvgSettlementSeconds, field.TypeFloat64, value)
+	}
+	if value, ok := ppuo.mutation.Score(); ok {
+		_spec.SetField(providerperformance.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := ppuo.mutation.AddedScore(); ok {
+		_spec.AddField(providerperformance.FieldScore, field.TypeFloat64, value)
+	}
+	if ppuo.mutation.ProviderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   providerperformance.ProviderTable,
+			Columns: []string{providerperformance.ProviderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerprofile.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppuo.mutation.ProviderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   providerperformance.ProviderTable,
+			Columns: []string{providerperformance.ProviderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerprofile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ProviderPerformance{config: ppuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ppuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{providerperformance.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ppuo.mutation.done = true
+	return _node, nil
+}
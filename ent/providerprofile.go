@@ -9,7 +9,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
-	"github.com/NEDA-LABS/stablenode/ent/apikey"
+	"github.com/NEDA-LABS/stablenode/ent/providerperformance"
 	"github.com/NEDA-LABS/stablenode/ent/providerprofile"
 	"github.com/NEDA-LABS/stablenode/ent/providerrating"
 	"github.com/NEDA-LABS/stablenode/ent/user"
@@ -47,7 +47,7 @@ type ProviderProfileEdges struct {
 	// User holds the value of the user edge.
 	User *User `json:"user,omitempty"`
 	// APIKey holds the value of the api_key edge.
-	APIKey *APIKey `json:"api_key,omitempty"`
+	APIKey []*APIKey `json:"api_key,omitempty"`
 	// ProviderCurrencies holds the value of the provider_currencies edge.
 	ProviderCurrencies []*ProviderCurrencies `json:"provider_currencies,omitempty"`
 	// ProvisionBuckets holds the value of the provision_buckets edge.
@@ -58,9 +58,13 @@ type ProviderProfileEdges struct {
 	ProviderRating *ProviderRating `json:"provider_rating,omitempty"`
 	// AssignedOrders holds the value of the assigned_orders edge.
 	AssignedOrders []*LockPaymentOrder `json:"assigned_orders,omitempty"`
+	// BalanceSnapshots holds the value of the balance_snapshots edge.
+	BalanceSnapshots []*ProviderBalanceSnapshot `json:"balance_snapshots,omitempty"`
+	// Performance holds the value of the performance edge.
+	Performance *ProviderPerformance `json:"performance,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [7]bool
+	loadedTypes [9]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -75,12 +79,10 @@ func (e ProviderProfileEdges) UserOrErr() (*User, error) {
 }
 
 // APIKeyOrErr returns the APIKey value or an error if the edge
-// was not loaded in eager-loading, or loaded but was not found.
-func (e ProviderProfileEdges) APIKeyOrErr() (*APIKey, error) {
-	if e.APIKey != nil {
+// was not loaded in eager-loading.
+func (e ProviderProfileEdges) APIKeyOrErr() ([]*APIKey, error) {
+	if e.loadedTypes[1] {
 		return e.APIKey, nil
-	} else if e.loadedTypes[1] {
-		return nil, &NotFoundError{label: apikey.Label}
 	}
 	return nil, &NotLoadedError{edge: "api_key"}
 }
@@ -132,6 +134,26 @@ func (e ProviderProfileEdges) AssignedOrdersOrErr() ([]*LockPaymentOrder, error)
 	return nil, &NotLoadedError{edge: "assigned_orders"}
 }
 
+// BalanceSnapshotsOrErr returns the BalanceSnapshots value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProviderProfileEdges) BalanceSnapshotsOrErr() ([]*ProviderBalanceSnapshot, error) {
+	if e.loadedTypes[7] {
+		return e.BalanceSnapshots, nil
+	}
+	return nil, &NotLoadedError{edge: "balance_snapshots"}
+}
+
+// PerformanceOrErr returns the Performance value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProviderProfileEdges) PerformanceOrErr() (*ProviderPerformance, error) {
+	if e.Performance != nil {
+		return e.Performance, nil
+	} else if e.loadedTypes[8] {
+		return nil, &NotFoundError{label: providerperformance.Label}
+	}
+	return nil, &NotLoadedError{edge: "performance"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*ProviderProfile) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -263,6 +285,16 @@ func (pp *ProviderProfile) QueryAssignedOrders() *LockPaymentOrderQuery {
 	return NewProviderProfileClient(pp.config).QueryAssignedOrders(pp)
 }
 
+// QueryBalanceSnapshots queries the "balance_snapshots" edge of the ProviderProfile entity.
+func (pp *ProviderProfile) QueryBalanceSnapshots() *ProviderBalanceSnapshotQuery {
+	return NewProviderProfileClient(pp.config).QueryBalanceSnapshots(pp)
+}
+
+// QueryPerformance queries the "performance" edge of the ProviderProfile entity.
+func (pp *ProviderProfile) QueryPerformance() *ProviderPerformanceQuery {
+	return NewProviderProfileClient(pp.config).QueryPerformance(pp)
+}
+
 // Update returns a builder for updating this ProviderProfile.
 // Note that you need to call ProviderProfile.Unwrap() before calling this method if this ProviderProfile
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -43,6 +43,10 @@ const (
 	EdgeProviderRating = "provider_rating"
 	// EdgeAssignedOrders holds the string denoting the assigned_orders edge name in mutations.
 	EdgeAssignedOrders = "assigned_orders"
+	// EdgeBalanceSnapshots holds the string denoting the balance_snapshots edge name in mutations.
+	EdgeBalanceSnapshots = "balance_snapshots"
+	// EdgePerformance holds the string denoting the performance edge name in mutations.
+	EdgePerformance = "performance"
 	// Table holds the table name of the providerprofile in the database.
 	Table = "provider_profiles"
 	// UserTable is the table that holds the user relation/edge.
@@ -92,6 +96,20 @@ const (
 	AssignedOrdersInverseTable = "lock_payment_orders"
 	// AssignedOrdersColumn is the table column denoting the assigned_orders relation/edge.
 	AssignedOrdersColumn = "provider_profile_assigned_orders"
+	// BalanceSnapshotsTable is the table that holds the balance_snapshots relation/edge.
+	BalanceSnapshotsTable = "provider_balance_snapshots"
+	// BalanceSnapshotsInverseTable is the table name for the ProviderBalanceSnapshot entity.
+	// It exists in this package in order to avoid circular dependency with the "providerbalancesnapshot" package.
+	BalanceSnapshotsInverseTable = "provider_balance_snapshots"
+	// BalanceSnapshotsColumn is the table column denoting the balance_snapshots relation/edge.
+	BalanceSnapshotsColumn = "provider_profile_balance_snapshots"
+	// PerformanceTable is the table that holds the performance relation/edge.
+	PerformanceTable = "provider_performances"
+	// PerformanceInverseTable is the table name for the ProviderPerformance entity.
+	// It exists in this package in order to avoid circular dependency with the "providerperformance" package.
+	PerformanceInverseTable = "provider_performances"
+	// PerformanceColumn is the table column denoting the performance relation/edge.
+	PerformanceColumn = "provider_profile_performance"
 )
 
 // Columns holds all SQL columns for providerprofile fields.
@@ -250,10 +268,17 @@ func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	}
 }
 
-// ByAPIKeyField orders the results by api_key field.
-func ByAPIKeyField(field string, opts ...sql.OrderTermOption) OrderOption {
+// ByAPIKeyCount orders the results by api_key count.
+func ByAPIKeyCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborTerms(s, newAPIKeyStep(), sql.OrderByField(field, opts...))
+		sqlgraph.OrderByNeighborsCount(s, newAPIKeyStep(), opts...)
+	}
+}
+
+// ByAPIKey orders the results by api_key terms.
+func ByAPIKey(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAPIKeyStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
 
@@ -319,6 +344,27 @@ func ByAssignedOrders(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newAssignedOrdersStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByBalanceSnapshotsCount orders the results by balance_snapshots count.
+func ByBalanceSnapshotsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBalanceSnapshotsStep(), opts...)
+	}
+}
+
+// ByBalanceSnapshots orders the results by balance_snapshots terms.
+func ByBalanceSnapshots(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBalanceSnapshotsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPerformanceField orders the results by performance field.
+func ByPerformanceField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPerformanceStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -330,7 +376,7 @@ func newAPIKeyStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
 		sqlgraph.To(APIKeyInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.O2O, false, APIKeyTable, APIKeyColumn),
+		sqlgraph.Edge(sqlgraph.O2M, false, APIKeyTable, APIKeyColumn),
 	)
 }
 func newProviderCurrenciesStep() *sqlgraph.Step {
@@ -368,3 +414,17 @@ func newAssignedOrdersStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, AssignedOrdersTable, AssignedOrdersColumn),
 	)
 }
+func newBalanceSnapshotsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BalanceSnapshotsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BalanceSnapshotsTable, BalanceSnapshotsColumn),
+	)
+}
+func newPerformanceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PerformanceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, PerformanceTable, PerformanceColumn),
+	)
+}
@@ -368,7 +368,7 @@ func HasAPIKey() predicate.ProviderProfile {
 	return predicate.ProviderProfile(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, APIKeyTable, APIKeyColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, APIKeyTable, APIKeyColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
@@ -501,6 +501,52 @@ func HasAssignedOrdersWith(preds ...predicate.LockPaymentOrder) predicate.Provid
 	})
 }
 
+// HasBalanceSnapshots applies the HasEdge predicate on the "balance_snapshots" edge.
+func HasBalanceSnapshots() predicate.ProviderProfile {
+	return predicate.ProviderProfile(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BalanceSnapshotsTable, BalanceSnapshotsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBalanceSnapshotsWith applies the HasEdge predicate on the "balance_snapshots" edge with a given conditions (other predicates).
+func HasBalanceSnapshotsWith(preds ...predicate.ProviderBalanceSnapshot) predicate.ProviderProfile {
+	return predicate.ProviderProfile(func(s *sql.Selector) {
+		step := newBalanceSnapshotsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPerformance applies the HasEdge predicate on the "performance" edge.
+func HasPerformance() predicate.ProviderProfile {
+	return predicate.ProviderProfile(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, PerformanceTable, PerformanceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPerformanceWith applies the HasEdge predicate on the "performance" edge with a given conditions (other predicates).
+func HasPerformanceWith(preds ...predicate.ProviderPerformance) predicate.ProviderProfile {
+	return predicate.ProviderProfile(func(s *sql.Selector) {
+		step := newPerformanceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.ProviderProfile) predicate.ProviderProfile {
 	return predicate.ProviderProfile(sql.AndPredicates(predicates...))
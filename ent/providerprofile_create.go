@@ -14,8 +14,10 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/NEDA-LABS/stablenode/ent/apikey"
 	"github.com/NEDA-LABS/stablenode/ent/lockpaymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/providerbalancesnapshot"
 	"github.com/NEDA-LABS/stablenode/ent/providercurrencies"
 	"github.com/NEDA-LABS/stablenode/ent/providerordertoken"
+	"github.com/NEDA-LABS/stablenode/ent/providerperformance"
 	"github.com/NEDA-LABS/stablenode/ent/providerprofile"
 	"github.com/NEDA-LABS/stablenode/ent/providerrating"
 	"github.com/NEDA-LABS/stablenode/ent/provisionbucket"
@@ -154,23 +156,19 @@ func (ppc *ProviderProfileCreate) SetUser(u *User) *ProviderProfileCreate {
 	return ppc.SetUserID(u.ID)
 }
 
-// SetAPIKeyID sets the "api_key" edge to the APIKey entity by ID.
-func (ppc *ProviderProfileCreate) SetAPIKeyID(id uuid.UUID) *ProviderProfileCreate {
-	ppc.mutation.SetAPIKeyID(id)
+// AddAPIKeyIDs adds the "api_key" edge to the APIKey entity by IDs.
+func (ppc *ProviderProfileCreate) AddAPIKeyIDs(ids ...uuid.UUID) *ProviderProfileCreate {
+	ppc.mutation.AddAPIKeyIDs(ids...)
 	return ppc
 }
 
-// SetNillableAPIKeyID sets the "api_key" edge to the APIKey entity by ID if the given value is not nil.
-func (ppc *ProviderProfileCreate) SetNillableAPIKeyID(id *uuid.UUID) *ProviderProfileCreate {
-	if id != nil {
-		ppc = ppc.SetAPIKeyID(*id)
+// AddAPIKey adds the "api_key" edges to the APIKey entity.
+func (ppc *ProviderProfileCreate) AddAPIKey(a ...*APIKey) *ProviderProfileCreate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
 	}
-	return ppc
-}
-
-// SetAPIKey sets the "api_key" edge to the APIKey entity.
-func (ppc *ProviderProfileCreate) SetAPIKey(a *APIKey) *ProviderProfileCreate {
-	return ppc.SetAPIKeyID(a.ID)
+	return ppc.AddAPIKeyIDs(ids...)
 }
 
 // AddProviderCurrencyIDs adds the "provider_currencies" edge to the ProviderCurrencies entity by IDs.
@@ -252,6 +250,40 @@ func (ppc *ProviderProfileCreate) AddAssignedOrders(l ...*LockPaymentOrder) *Pro
 	return ppc.AddAssignedOrderIDs(ids...)
 }
 
+// AddBalanceSnapshotIDs adds the "balance_snapshots" edge to the ProviderBalanceSnapshot entity by IDs.
+func (ppc *ProviderProfileCreate) AddBalanceSnapshotIDs(ids ...uuid.UUID) *ProviderProfileCreate {
+	ppc.mutation.AddBalanceSnapshotIDs(ids...)
+	return ppc
+}
+
+// AddBalanceSnapshots adds the "balance_snapshots" edges to the ProviderBalanceSnapshot entity.
+func (ppc *ProviderProfileCreate) AddBalanceSnapshots(p ...*ProviderBalanceSnapshot) *ProviderProfileCreate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ppc.AddBalanceSnapshotIDs(ids...)
+}
+
+// SetPerformanceID sets the "performance" edge to the ProviderPerformance entity by ID.
+func (ppc *ProviderProfileCreate) SetPerformanceID(id int) *ProviderProfileCreate {
+	ppc.mutation.SetPerformanceID(id)
+	return ppc
+}
+
+// SetNillablePerformanceID sets the "performance" edge to the ProviderPerformance entity by ID if the given value is not nil.
+func (ppc *ProviderProfileCreate) SetNillablePerformanceID(id *int) *ProviderProfileCreate {
+	if id != nil {
+		ppc = ppc.SetPerformanceID(*id)
+	}
+	return ppc
+}
+
+// SetPerformance sets the "performance" edge to the ProviderPerformance entity.
+func (ppc *ProviderProfileCreate) SetPerformance(p *ProviderPerformance) *ProviderProfileCreate {
+	return ppc.SetPerformanceID(p.ID)
+}
+
 // Mutation returns the ProviderProfileMutation object of the builder.
 func (ppc *ProviderProfileCreate) Mutation() *ProviderProfileMutation {
 	return ppc.mutation
@@ -431,7 +463,7 @@ func (ppc *ProviderProfileCreate) createSpec() (*ProviderProfile, *sqlgraph.Crea
 	}
 	if nodes := ppc.mutation.APIKeyIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
 			Table:   providerprofile.APIKeyTable,
 			Columns: []string{providerprofile.APIKeyColumn},
@@ -525,6 +557,38 @@ func (ppc *ProviderProfileCreate) createSpec() (*ProviderProfile, *sqlgraph.Crea
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ppc.mutation.BalanceSnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.BalanceSnapshotsTable,
+			Columns: []string{providerprofile.BalanceSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerbalancesnapshot.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ppc.mutation.PerformanceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   providerprofile.PerformanceTable,
+			Columns: []string{providerprofile.PerformanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerperformance.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
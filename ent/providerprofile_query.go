@@ -15,8 +15,10 @@ import (
 	"github.com/NEDA-LABS/stablenode/ent/apikey"
 	"github.com/NEDA-LABS/stablenode/ent/lockpaymentorder"
 	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/NEDA-LABS/stablenode/ent/providerbalancesnapshot"
 	"github.com/NEDA-LABS/stablenode/ent/providercurrencies"
 	"github.com/NEDA-LABS/stablenode/ent/providerordertoken"
+	"github.com/NEDA-LABS/stablenode/ent/providerperformance"
 	"github.com/NEDA-LABS/stablenode/ent/providerprofile"
 	"github.com/NEDA-LABS/stablenode/ent/providerrating"
 	"github.com/NEDA-LABS/stablenode/ent/provisionbucket"
@@ -38,6 +40,8 @@ type ProviderProfileQuery struct {
 	withOrderTokens        *ProviderOrderTokenQuery
 	withProviderRating     *ProviderRatingQuery
 	withAssignedOrders     *LockPaymentOrderQuery
+	withBalanceSnapshots   *ProviderBalanceSnapshotQuery
+	withPerformance        *ProviderPerformanceQuery
 	withFKs                bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -111,7 +115,7 @@ func (ppq *ProviderProfileQuery) QueryAPIKey() *APIKeyQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, selector),
 			sqlgraph.To(apikey.Table, apikey.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, providerprofile.APIKeyTable, providerprofile.APIKeyColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, providerprofile.APIKeyTable, providerprofile.APIKeyColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(ppq.driver.Dialect(), step)
 		return fromU, nil
@@ -229,6 +233,50 @@ func (ppq *ProviderProfileQuery) QueryAssignedOrders() *LockPaymentOrderQuery {
 	return query
 }
 
+// QueryBalanceSnapshots chains the current query on the "balance_snapshots" edge.
+func (ppq *ProviderProfileQuery) QueryBalanceSnapshots() *ProviderBalanceSnapshotQuery {
+	query := (&ProviderBalanceSnapshotClient{config: ppq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ppq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ppq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, selector),
+			sqlgraph.To(providerbalancesnapshot.Table, providerbalancesnapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, providerprofile.BalanceSnapshotsTable, providerprofile.BalanceSnapshotsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ppq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPerformance chains the current query on the "performance" edge.
+func (ppq *ProviderProfileQuery) QueryPerformance() *ProviderPerformanceQuery {
+	query := (&ProviderPerformanceClient{config: ppq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ppq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ppq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, selector),
+			sqlgraph.To(providerperformance.Table, providerperformance.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, providerprofile.PerformanceTable, providerprofile.PerformanceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ppq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first ProviderProfile entity from the query.
 // Returns a *NotFoundError when no ProviderProfile was found.
 func (ppq *ProviderProfileQuery) First(ctx context.Context) (*ProviderProfile, error) {
@@ -428,6 +476,8 @@ func (ppq *ProviderProfileQuery) Clone() *ProviderProfileQuery {
 		withOrderTokens:        ppq.withOrderTokens.Clone(),
 		withProviderRating:     ppq.withProviderRating.Clone(),
 		withAssignedOrders:     ppq.withAssignedOrders.Clone(),
+		withBalanceSnapshots:   ppq.withBalanceSnapshots.Clone(),
+		withPerformance:        ppq.withPerformance.Clone(),
 		// clone intermediate query.
 		sql:  ppq.sql.Clone(),
 		path: ppq.path,
@@ -511,6 +561,28 @@ func (ppq *ProviderProfileQuery) WithAssignedOrders(opts ...func(*LockPaymentOrd
 	return ppq
 }
 
+// WithBalanceSnapshots tells the query-builder to eager-load the nodes that are connected to
+// the "balance_snapshots" edge. The optional arguments are used to configure the query builder of the edge.
+func (ppq *ProviderProfileQuery) WithBalanceSnapshots(opts ...func(*ProviderBalanceSnapshotQuery)) *ProviderProfileQuery {
+	query := (&ProviderBalanceSnapshotClient{config: ppq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ppq.withBalanceSnapshots = query
+	return ppq
+}
+
+// WithPerformance tells the query-builder to eager-load the nodes that are connected to
+// the "performance" edge. The optional arguments are used to configure the query builder of the edge.
+func (ppq *ProviderProfileQuery) WithPerformance(opts ...func(*ProviderPerformanceQuery)) *ProviderProfileQuery {
+	query := (&ProviderPerformanceClient{config: ppq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ppq.withPerformance = query
+	return ppq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -590,7 +662,7 @@ func (ppq *ProviderProfileQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 		nodes       = []*ProviderProfile{}
 		withFKs     = ppq.withFKs
 		_spec       = ppq.querySpec()
-		loadedTypes = [7]bool{
+		loadedTypes = [9]bool{
 			ppq.withUser != nil,
 			ppq.withAPIKey != nil,
 			ppq.withProviderCurrencies != nil,
@@ -598,6 +670,8 @@ func (ppq *ProviderProfileQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 			ppq.withOrderTokens != nil,
 			ppq.withProviderRating != nil,
 			ppq.withAssignedOrders != nil,
+			ppq.withBalanceSnapshots != nil,
+			ppq.withPerformance != nil,
 		}
 	)
 	if ppq.withUser != nil {
@@ -631,8 +705,9 @@ func (ppq *ProviderProfileQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 		}
 	}
 	if query := ppq.withAPIKey; query != nil {
-		if err := ppq.loadAPIKey(ctx, query, nodes, nil,
-			func(n *ProviderProfile, e *APIKey) { n.Edges.APIKey = e }); err != nil {
+		if err := ppq.loadAPIKey(ctx, query, nodes,
+			func(n *ProviderProfile) { n.Edges.APIKey = []*APIKey{} },
+			func(n *ProviderProfile, e *APIKey) { n.Edges.APIKey = append(n.Edges.APIKey, e) }); err != nil {
 			return nil, err
 		}
 	}
@@ -676,6 +751,21 @@ func (ppq *ProviderProfileQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 			return nil, err
 		}
 	}
+	if query := ppq.withBalanceSnapshots; query != nil {
+		if err := ppq.loadBalanceSnapshots(ctx, query, nodes,
+			func(n *ProviderProfile) { n.Edges.BalanceSnapshots = []*ProviderBalanceSnapshot{} },
+			func(n *ProviderProfile, e *ProviderBalanceSnapshot) {
+				n.Edges.BalanceSnapshots = append(n.Edges.BalanceSnapshots, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := ppq.withPerformance; query != nil {
+		if err := ppq.loadPerformance(ctx, query, nodes, nil,
+			func(n *ProviderProfile, e *ProviderPerformance) { n.Edges.Performance = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -717,6 +807,9 @@ func (ppq *ProviderProfileQuery) loadAPIKey(ctx context.Context, query *APIKeyQu
 	for i := range nodes {
 		fks = append(fks, nodes[i].ID)
 		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
 	}
 	query.withFKs = true
 	query.Where(predicate.APIKey(func(s *sql.Selector) {
@@ -921,6 +1014,65 @@ func (ppq *ProviderProfileQuery) loadAssignedOrders(ctx context.Context, query *
 	}
 	return nil
 }
+func (ppq *ProviderProfileQuery) loadBalanceSnapshots(ctx context.Context, query *ProviderBalanceSnapshotQuery, nodes []*ProviderProfile, init func(*ProviderProfile), assign func(*ProviderProfile, *ProviderBalanceSnapshot)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*ProviderProfile)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ProviderBalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(providerprofile.BalanceSnapshotsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.provider_profile_balance_snapshots
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "provider_profile_balance_snapshots" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "provider_profile_balance_snapshots" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (ppq *ProviderProfileQuery) loadPerformance(ctx context.Context, query *ProviderPerformanceQuery, nodes []*ProviderProfile, init func(*ProviderProfile), assign func(*ProviderProfile, *ProviderPerformance)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*ProviderProfile)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	query.withFKs = true
+	query.Where(predicate.ProviderPerformance(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(providerprofile.PerformanceColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.provider_profile_performance
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "provider_profile_performance" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "provider_profile_performance" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (ppq *ProviderProfileQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := ppq.querySpec()
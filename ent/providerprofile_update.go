@@ -14,8 +14,10 @@ import (
 	"github.com/NEDA-LABS/stablenode/ent/apikey"
 	"github.com/NEDA-LABS/stablenode/ent/lockpaymentorder"
 	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/NEDA-LABS/stablenode/ent/providerbalancesnapshot"
 	"github.com/NEDA-LABS/stablenode/ent/providercurrencies"
 	"github.com/NEDA-LABS/stablenode/ent/providerordertoken"
+	"github.com/NEDA-LABS/stablenode/ent/providerperformance"
 	"github.com/NEDA-LABS/stablenode/ent/providerprofile"
 	"github.com/NEDA-LABS/stablenode/ent/providerrating"
 	"github.com/NEDA-LABS/stablenode/ent/provisionbucket"
@@ -137,23 +139,19 @@ func (ppu *ProviderProfileUpdate) SetNillableVisibilityMode(pm *providerprofile.
 	return ppu
 }
 
-// SetAPIKeyID sets the "api_key" edge to the APIKey entity by ID.
-func (ppu *ProviderProfileUpdate) SetAPIKeyID(id uuid.UUID) *ProviderProfileUpdate {
-	ppu.mutation.SetAPIKeyID(id)
+// AddAPIKeyIDs adds the "api_key" edge to the APIKey entity by IDs.
+func (ppu *ProviderProfileUpdate) AddAPIKeyIDs(ids ...uuid.UUID) *ProviderProfileUpdate {
+	ppu.mutation.AddAPIKeyIDs(ids...)
 	return ppu
 }
 
-// SetNillableAPIKeyID sets the "api_key" edge to the APIKey entity by ID if the given value is not nil.
-func (ppu *ProviderProfileUpdate) SetNillableAPIKeyID(id *uuid.UUID) *ProviderProfileUpdate {
-	if id != nil {
-		ppu = ppu.SetAPIKeyID(*id)
+// AddAPIKey adds the "api_key" edges to the APIKey entity.
+func (ppu *ProviderProfileUpdate) AddAPIKey(a ...*APIKey) *ProviderProfileUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
 	}
-	return ppu
-}
-
-// SetAPIKey sets the "api_key" edge to the APIKey entity.
-func (ppu *ProviderProfileUpdate) SetAPIKey(a *APIKey) *ProviderProfileUpdate {
-	return ppu.SetAPIKeyID(a.ID)
+	return ppu.AddAPIKeyIDs(ids...)
 }
 
 // AddProviderCurrencyIDs adds the "provider_currencies" edge to the ProviderCurrencies entity by IDs.
@@ -235,17 +233,66 @@ func (ppu *ProviderProfileUpdate) AddAssignedOrders(l ...*LockPaymentOrder) *Pro
 	return ppu.AddAssignedOrderIDs(ids...)
 }
 
+// AddBalanceSnapshotIDs adds the "balance_snapshots" edge to the ProviderBalanceSnapshot entity by IDs.
+func (ppu *ProviderProfileUpdate) AddBalanceSnapshotIDs(ids ...uuid.UUID) *ProviderProfileUpdate {
+	ppu.mutation.AddBalanceSnapshotIDs(ids...)
+	return ppu
+}
+
+// AddBalanceSnapshots adds the "balance_snapshots" edges to the ProviderBalanceSnapshot entity.
+func (ppu *ProviderProfileUpdate) AddBalanceSnapshots(p ...*ProviderBalanceSnapshot) *ProviderProfileUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ppu.AddBalanceSnapshotIDs(ids...)
+}
+
+// SetPerformanceID sets the "performance" edge to the ProviderPerformance entity by ID.
+func (ppu *ProviderProfileUpdate) SetPerformanceID(id int) *ProviderProfileUpdate {
+	ppu.mutation.SetPerformanceID(id)
+	return ppu
+}
+
+// SetNillablePerformanceID sets the "performance" edge to the ProviderPerformance entity by ID if the given value is not nil.
+func (ppu *ProviderProfileUpdate) SetNillablePerformanceID(id *int) *ProviderProfileUpdate {
+	if id != nil {
+		ppu = ppu.SetPerformanceID(*id)
+	}
+	return ppu
+}
+
+// SetPerformance sets the "performance" edge to the ProviderPerformance entity.
+func (ppu *ProviderProfileUpdate) SetPerformance(p *ProviderPerformance) *ProviderProfileUpdate {
+	return ppu.SetPerformanceID(p.ID)
+}
+
 // Mutation returns the ProviderProfileMutation object of the builder.
 func (ppu *ProviderProfileUpdate) Mutation() *ProviderProfileMutation {
 	return ppu.mutation
 }
 
-// ClearAPIKey clears the "api_key" edge to the APIKey entity.
+// ClearAPIKey clears all "api_key" edges to the APIKey entity.
 func (ppu *ProviderProfileUpdate) ClearAPIKey() *ProviderProfileUpdate {
 	ppu.mutation.ClearAPIKey()
 	return ppu
 }
 
+// RemoveAPIKeyIDs removes the "api_key" edge to APIKey entities by IDs.
+func (ppu *ProviderProfileUpdate) RemoveAPIKeyIDs(ids ...uuid.UUID) *ProviderProfileUpdate {
+	ppu.mutation.RemoveAPIKeyIDs(ids...)
+	return ppu
+}
+
+// RemoveAPIKey removes "api_key" edges to APIKey entities.
+func (ppu *ProviderProfileUpdate) RemoveAPIKey(a ...*APIKey) *ProviderProfileUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ppu.RemoveAPIKeyIDs(ids...)
+}
+
 // ClearProviderCurrencies clears all "provider_currencies" edges to the ProviderCurrencies entity.
 func (ppu *ProviderProfileUpdate) ClearProviderCurrencies() *ProviderProfileUpdate {
 	ppu.mutation.ClearProviderCurrencies()
@@ -336,6 +383,33 @@ func (ppu *ProviderProfileUpdate) RemoveAssignedOrders(l ...*LockPaymentOrder) *
 	return ppu.RemoveAssignedOrderIDs(ids...)
 }
 
+// ClearBalanceSnapshots clears all "balance_snapshots" edges to the ProviderBalanceSnapshot entity.
+func (ppu *ProviderProfileUpdate) ClearBalanceSnapshots() *ProviderProfileUpdate {
+	ppu.mutation.ClearBalanceSnapshots()
+	return ppu
+}
+
+// RemoveBalanceSnapshotIDs removes the "balance_snapshots" edge to ProviderBalanceSnapshot entities by IDs.
+func (ppu *ProviderProfileUpdate) RemoveBalanceSnapshotIDs(ids ...uuid.UUID) *ProviderProfileUpdate {
+	ppu.mutation.RemoveBalanceSnapshotIDs(ids...)
+	return ppu
+}
+
+// RemoveBalanceSnapshots removes "balance_snapshots" edges to ProviderBalanceSnapshot entities.
+func (ppu *ProviderProfileUpdate) RemoveBalanceSnapshots(p ...*ProviderBalanceSnapshot) *ProviderProfileUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ppu.RemoveBalanceSnapshotIDs(ids...)
+}
+
+// ClearPerformance clears the "performance" edge to the ProviderPerformance entity.
+func (ppu *ProviderProfileUpdate) ClearPerformance() *ProviderProfileUpdate {
+	ppu.mutation.ClearPerformance()
+	return ppu
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (ppu *ProviderProfileUpdate) Save(ctx context.Context) (int, error) {
 	ppu.defaults()
@@ -436,7 +510,7 @@ func (ppu *ProviderProfileUpdate) sqlSave(ctx context.Context) (n int, err error
 	}
 	if ppu.mutation.APIKeyCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
 			Table:   providerprofile.APIKeyTable,
 			Columns: []string{providerprofile.APIKeyColumn},
@@ -447,9 +521,25 @@ func (ppu *ProviderProfileUpdate) sqlSave(ctx context.Context) (n int, err error
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
+	if nodes := ppu.mutation.RemovedAPIKeyIDs(); len(nodes) > 0 && !ppu.mutation.APIKeyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.APIKeyTable,
+			Columns: []string{providerprofile.APIKeyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
 	if nodes := ppu.mutation.APIKeyIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
 			Table:   providerprofile.APIKeyTable,
 			Columns: []string{providerprofile.APIKeyColumn},
@@ -672,6 +762,80 @@ func (ppu *ProviderProfileUpdate) sqlSave(ctx context.Context) (n int, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ppu.mutation.BalanceSnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.BalanceSnapshotsTable,
+			Columns: []string{providerprofile.BalanceSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerbalancesnapshot.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppu.mutation.RemovedBalanceSnapshotsIDs(); len(nodes) > 0 && !ppu.mutation.BalanceSnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.BalanceSnapshotsTable,
+			Columns: []string{providerprofile.BalanceSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerbalancesnapshot.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppu.mutation.BalanceSnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.BalanceSnapshotsTable,
+			Columns: []string{providerprofile.BalanceSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerbalancesnapshot.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ppu.mutation.PerformanceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   providerprofile.PerformanceTable,
+			Columns: []string{providerprofile.PerformanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerperformance.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppu.mutation.PerformanceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   providerprofile.PerformanceTable,
+			Columns: []string{providerprofile.PerformanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerperformance.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, ppu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{providerprofile.Label}
@@ -794,23 +958,19 @@ func (ppuo *ProviderProfileUpdateOne) SetNillableVisibilityMode(pm *providerprof
 	return ppuo
 }
 
-// SetAPIKeyID sets the "api_key" edge to the APIKey entity by ID.
-func (ppuo *ProviderProfileUpdateOne) SetAPIKeyID(id uuid.UUID) *ProviderProfileUpdateOne {
-	ppuo.mutation.SetAPIKeyID(id)
+// AddAPIKeyIDs adds the "api_key" edge to the APIKey entity by IDs.
+func (ppuo *ProviderProfileUpdateOne) AddAPIKeyIDs(ids ...uuid.UUID) *ProviderProfileUpdateOne {
+	ppuo.mutation.AddAPIKeyIDs(ids...)
 	return ppuo
 }
 
-// SetNillableAPIKeyID sets the "api_key" edge to the APIKey entity by ID if the given value is not nil.
-func (ppuo *ProviderProfileUpdateOne) SetNillableAPIKeyID(id *uuid.UUID) *ProviderProfileUpdateOne {
-	if id != nil {
-		ppuo = ppuo.SetAPIKeyID(*id)
+// AddAPIKey adds the "api_key" edges to the APIKey entity.
+func (ppuo *ProviderProfileUpdateOne) AddAPIKey(a ...*APIKey) *ProviderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
 	}
-	return ppuo
-}
-
-// SetAPIKey sets the "api_key" edge to the APIKey entity.
-func (ppuo *ProviderProfileUpdateOne) SetAPIKey(a *APIKey) *ProviderProfileUpdateOne {
-	return ppuo.SetAPIKeyID(a.ID)
+	return ppuo.AddAPIKeyIDs(ids...)
 }
 
 // AddProviderCurrencyIDs adds the "provider_currencies" edge to the ProviderCurrencies entity by IDs.
@@ -892,17 +1052,66 @@ func (ppuo *ProviderProfileUpdateOne) AddAssignedOrders(l ...*LockPaymentOrder)
 	return ppuo.AddAssignedOrderIDs(ids...)
 }
 
+// AddBalanceSnapshotIDs adds the "balance_snapshots" edge to the ProviderBalanceSnapshot entity by IDs.
+func (ppuo *ProviderProfileUpdateOne) AddBalanceSnapshotIDs(ids ...uuid.UUID) *ProviderProfileUpdateOne {
+	ppuo.mutation.AddBalanceSnapshotIDs(ids...)
+	return ppuo
+}
+
+// AddBalanceSnapshots adds the "balance_snapshots" edges to the ProviderBalanceSnapshot entity.
+func (ppuo *ProviderProfileUpdateOne) AddBalanceSnapshots(p ...*ProviderBalanceSnapshot) *ProviderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ppuo.AddBalanceSnapshotIDs(ids...)
+}
+
+// SetPerformanceID sets the "performance" edge to the ProviderPerformance entity by ID.
+func (ppuo *ProviderProfileUpdateOne) SetPerformanceID(id int) *ProviderProfileUpdateOne {
+	ppuo.mutation.SetPerformanceID(id)
+	return ppuo
+}
+
+// SetNillablePerformanceID sets the "performance" edge to the ProviderPerformance entity by ID if the given value is not nil.
+func (ppuo *ProviderProfileUpdateOne) SetNillablePerformanceID(id *int) *ProviderProfileUpdateOne {
+	if id != nil {
+		ppuo = ppuo.SetPerformanceID(*id)
+	}
+	return ppuo
+}
+
+// SetPerformance sets the "performance" edge to the ProviderPerformance entity.
+func (ppuo *ProviderProfileUpdateOne) SetPerformance(p *ProviderPerformance) *ProviderProfileUpdateOne {
+	return ppuo.SetPerformanceID(p.ID)
+}
+
 // Mutation returns the ProviderProfileMutation object of the builder.
 func (ppuo *ProviderProfileUpdateOne) Mutation() *ProviderProfileMutation {
 	return ppuo.mutation
 }
 
-// ClearAPIKey clears the "api_key" edge to the APIKey entity.
+// ClearAPIKey clears all "api_key" edges to the APIKey entity.
 func (ppuo *ProviderProfileUpdateOne) ClearAPIKey() *ProviderProfileUpdateOne {
 	ppuo.mutation.ClearAPIKey()
 	return ppuo
 }
 
+// RemoveAPIKeyIDs removes the "api_key" edge to APIKey entities by IDs.
+func (ppuo *ProviderProfileUpdateOne) RemoveAPIKeyIDs(ids ...uuid.UUID) *ProviderProfileUpdateOne {
+	ppuo.mutation.RemoveAPIKeyIDs(ids...)
+	return ppuo
+}
+
+// RemoveAPIKey removes "api_key" edges to APIKey entities.
+func (ppuo *ProviderProfileUpdateOne) RemoveAPIKey(a ...*APIKey) *ProviderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ppuo.RemoveAPIKeyIDs(ids...)
+}
+
 // ClearProviderCurrencies clears all "provider_currencies" edges to the ProviderCurrencies entity.
 func (ppuo *ProviderProfileUpdateOne) ClearProviderCurrencies() *ProviderProfileUpdateOne {
 	ppuo.mutation.ClearProviderCurrencies()
@@ -993,6 +1202,33 @@ func (ppuo *ProviderProfileUpdateOne) RemoveAssignedOrders(l ...*LockPaymentOrde
 	return ppuo.RemoveAssignedOrderIDs(ids...)
 }
 
+// ClearBalanceSnapshots clears all "balance_snapshots" edges to the ProviderBalanceSnapshot entity.
+func (ppuo *ProviderProfileUpdateOne) ClearBalanceSnapshots() *ProviderProfileUpdateOne {
+	ppuo.mutation.ClearBalanceSnapshots()
+	return ppuo
+}
+
+// RemoveBalanceSnapshotIDs removes the "balance_snapshots" edge to ProviderBalanceSnapshot entities by IDs.
+func (ppuo *ProviderProfileUpdateOne) RemoveBalanceSnapshotIDs(ids ...uuid.UUID) *ProviderProfileUpdateOne {
+	ppuo.mutation.RemoveBalanceSnapshotIDs(ids...)
+	return ppuo
+}
+
+// RemoveBalanceSnapshots removes "balance_snapshots" edges to ProviderBalanceSnapshot entities.
+func (ppuo *ProviderProfileUpdateOne) RemoveBalanceSnapshots(p ...*ProviderBalanceSnapshot) *ProviderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ppuo.RemoveBalanceSnapshotIDs(ids...)
+}
+
+// ClearPerformance clears the "performance" edge to the ProviderPerformance entity.
+func (ppuo *ProviderProfileUpdateOne) ClearPerformance() *ProviderProfileUpdateOne {
+	ppuo.mutation.ClearPerformance()
+	return ppuo
+}
+
 // Where appends a list predicates to the ProviderProfileUpdate builder.
 func (ppuo *ProviderProfileUpdateOne) Where(ps ...predicate.ProviderProfile) *ProviderProfileUpdateOne {
 	ppuo.mutation.Where(ps...)
@@ -1123,7 +1359,7 @@ func (ppuo *ProviderProfileUpdateOne) sqlSave(ctx context.Context) (_node *Provi
 	}
 	if ppuo.mutation.APIKeyCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
 			Table:   providerprofile.APIKeyTable,
 			Columns: []string{providerprofile.APIKeyColumn},
@@ -1134,9 +1370,25 @@ func (ppuo *ProviderProfileUpdateOne) sqlSave(ctx context.Context) (_node *Provi
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
+	if nodes := ppuo.mutation.RemovedAPIKeyIDs(); len(nodes) > 0 && !ppuo.mutation.APIKeyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.APIKeyTable,
+			Columns: []string{providerprofile.APIKeyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
 	if nodes := ppuo.mutation.APIKeyIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
 			Table:   providerprofile.APIKeyTable,
 			Columns: []string{providerprofile.APIKeyColumn},
@@ -1359,6 +1611,80 @@ func (ppuo *ProviderProfileUpdateOne) sqlSave(ctx context.Context) (_node *Provi
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ppuo.mutation.BalanceSnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.BalanceSnapshotsTable,
+			Columns: []string{providerprofile.BalanceSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerbalancesnapshot.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppuo.mutation.RemovedBalanceSnapshotsIDs(); len(nodes) > 0 && !ppuo.mutation.BalanceSnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.BalanceSnapshotsTable,
+			Columns: []string{providerprofile.BalanceSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerbalancesnapshot.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppuo.mutation.BalanceSnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.BalanceSnapshotsTable,
+			Columns: []string{providerprofile.BalanceSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerbalancesnapshot.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ppuo.mutation.PerformanceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   providerprofile.PerformanceTable,
+			Columns: []string{providerprofile.PerformanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerperformance.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppuo.mutation.PerformanceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   providerprofile.PerformanceTable,
+			Columns: []string{providerprofile.PerformanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerperformance.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &ProviderProfile{config: ppuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -47,6 +47,14 @@ type ReceiveAddress struct {
 	RecycledAt time.Time `json:"recycled_at,omitempty"`
 	// Number of times address has been reused
 	TimesUsed int `json:"times_used,omitempty"`
+	// Owner the smart account address was computed for
+	OwnerAddress string `json:"owner_address,omitempty"`
+	// Version, factory and packing hash of the code that computed the address
+	GenerationFingerprint string `json:"generation_fingerprint,omitempty"`
+	// How the salt was generated; hkdf salts can be re-derived from the master secret
+	SaltDerivation receiveaddress.SaltDerivation `json:"salt_derivation,omitempty"`
+	// Index an hkdf salt was derived at, unique per chain
+	DerivationIndex *int64 `json:"derivation_index,omitempty"`
 	// LastIndexedBlock holds the value of the "last_indexed_block" field.
 	LastIndexedBlock int64 `json:"last_indexed_block,omitempty"`
 	// LastUsed holds the value of the "last_used" field.
@@ -66,9 +74,11 @@ type ReceiveAddress struct {
 type ReceiveAddressEdges struct {
 	// PaymentOrder holds the value of the payment_order edge.
 	PaymentOrder *PaymentOrder `json:"payment_order,omitempty"`
+	// Assignments holds the value of the assignments edge.
+	Assignments []*ReceiveAddressAssignment `json:"assignments,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // PaymentOrderOrErr returns the PaymentOrder value or an error if the edge
@@ -82,6 +92,15 @@ func (e ReceiveAddressEdges) PaymentOrderOrErr() (*PaymentOrder, error) {
 	return nil, &NotLoadedError{edge: "payment_order"}
 }
 
+// AssignmentsOrErr returns the Assignments value or an error if the edge
+// was not loaded in eager-loading.
+func (e ReceiveAddressEdges) AssignmentsOrErr() ([]*ReceiveAddressAssignment, error) {
+	if e.loadedTypes[1] {
+		return e.Assignments, nil
+	}
+	return nil, &NotLoadedError{edge: "assignments"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*ReceiveAddress) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -91,9 +110,9 @@ func (*ReceiveAddress) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case receiveaddress.FieldIsDeployed:
 			values[i] = new(sql.NullBool)
-		case receiveaddress.FieldID, receiveaddress.FieldDeploymentBlock, receiveaddress.FieldChainID, receiveaddress.FieldTimesUsed, receiveaddress.FieldLastIndexedBlock:
+		case receiveaddress.FieldID, receiveaddress.FieldDeploymentBlock, receiveaddress.FieldChainID, receiveaddress.FieldTimesUsed, receiveaddress.FieldDerivationIndex, receiveaddress.FieldLastIndexedBlock:
 			values[i] = new(sql.NullInt64)
-		case receiveaddress.FieldAddress, receiveaddress.FieldStatus, receiveaddress.FieldDeploymentTxHash, receiveaddress.FieldNetworkIdentifier, receiveaddress.FieldTxHash:
+		case receiveaddress.FieldAddress, receiveaddress.FieldStatus, receiveaddress.FieldDeploymentTxHash, receiveaddress.FieldNetworkIdentifier, receiveaddress.FieldOwnerAddress, receiveaddress.FieldGenerationFingerprint, receiveaddress.FieldSaltDerivation, receiveaddress.FieldTxHash:
 			values[i] = new(sql.NullString)
 		case receiveaddress.FieldCreatedAt, receiveaddress.FieldUpdatedAt, receiveaddress.FieldDeployedAt, receiveaddress.FieldAssignedAt, receiveaddress.FieldRecycledAt, receiveaddress.FieldLastUsed, receiveaddress.FieldValidUntil:
 			values[i] = new(sql.NullTime)
@@ -204,6 +223,31 @@ func (ra *ReceiveAddress) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				ra.TimesUsed = int(value.Int64)
 			}
+		case receiveaddress.FieldOwnerAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_address", values[i])
+			} else if value.Valid {
+				ra.OwnerAddress = value.String
+			}
+		case receiveaddress.FieldGenerationFingerprint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field generation_fingerprint", values[i])
+			} else if value.Valid {
+				ra.GenerationFingerprint = value.String
+			}
+		case receiveaddress.FieldSaltDerivation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field salt_derivation", values[i])
+			} else if value.Valid {
+				ra.SaltDerivation = receiveaddress.SaltDerivation(value.String)
+			}
+		case receiveaddress.FieldDerivationIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field derivation_index", values[i])
+			} else if value.Valid {
+				ra.DerivationIndex = new(int64)
+				*ra.DerivationIndex = value.Int64
+			}
 		case receiveaddress.FieldLastIndexedBlock:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field last_indexed_block", values[i])
@@ -253,6 +297,11 @@ func (ra *ReceiveAddress) QueryPaymentOrder() *PaymentOrderQuery {
 	return NewReceiveAddressClient(ra.config).QueryPaymentOrder(ra)
 }
 
+// QueryAssignments queries the "assignments" edge of the ReceiveAddress entity.
+func (ra *ReceiveAddress) QueryAssignments() *ReceiveAddressAssignmentQuery {
+	return NewReceiveAddressClient(ra.config).QueryAssignments(ra)
+}
+
 // Update returns a builder for updating this ReceiveAddress.
 // Note that you need to call ReceiveAddress.Unwrap() before calling this method if this ReceiveAddress
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -318,6 +367,20 @@ func (ra *ReceiveAddress) String() string {
 	builder.WriteString("times_used=")
 	builder.WriteString(fmt.Sprintf("%v", ra.TimesUsed))
 	builder.WriteString(", ")
+	builder.WriteString("owner_address=")
+	builder.WriteString(ra.OwnerAddress)
+	builder.WriteString(", ")
+	builder.WriteString("generation_fingerprint=")
+	builder.WriteString(ra.GenerationFingerprint)
+	builder.WriteString(", ")
+	builder.WriteString("salt_derivation=")
+	builder.WriteString(fmt.Sprintf("%v", ra.SaltDerivation))
+	builder.WriteString(", ")
+	if v := ra.DerivationIndex; v != nil {
+		builder.WriteString("derivation_index=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("last_indexed_block=")
 	builder.WriteString(fmt.Sprintf("%v", ra.LastIndexedBlock))
 	builder.WriteString(", ")
@@ -43,6 +43,14 @@ const (
 	FieldRecycledAt = "recycled_at"
 	// FieldTimesUsed holds the string denoting the times_used field in the database.
 	FieldTimesUsed = "times_used"
+	// FieldOwnerAddress holds the string denoting the owner_address field in the database.
+	FieldOwnerAddress = "owner_address"
+	// FieldGenerationFingerprint holds the string denoting the generation_fingerprint field in the database.
+	FieldGenerationFingerprint = "generation_fingerprint"
+	// FieldSaltDerivation holds the string denoting the salt_derivation field in the database.
+	FieldSaltDerivation = "salt_derivation"
+	// FieldDerivationIndex holds the string denoting the derivation_index field in the database.
+	FieldDerivationIndex = "derivation_index"
 	// FieldLastIndexedBlock holds the string denoting the last_indexed_block field in the database.
 	FieldLastIndexedBlock = "last_indexed_block"
 	// FieldLastUsed holds the string denoting the last_used field in the database.
@@ -53,6 +61,8 @@ const (
 	FieldValidUntil = "valid_until"
 	// EdgePaymentOrder holds the string denoting the payment_order edge name in mutations.
 	EdgePaymentOrder = "payment_order"
+	// EdgeAssignments holds the string denoting the assignments edge name in mutations.
+	EdgeAssignments = "assignments"
 	// Table holds the table name of the receiveaddress in the database.
 	Table = "receive_addresses"
 	// PaymentOrderTable is the table that holds the payment_order relation/edge.
@@ -62,6 +72,13 @@ const (
 	PaymentOrderInverseTable = "payment_orders"
 	// PaymentOrderColumn is the table column denoting the payment_order relation/edge.
 	PaymentOrderColumn = "payment_order_receive_address"
+	// AssignmentsTable is the table that holds the assignments relation/edge.
+	AssignmentsTable = "receive_address_assignments"
+	// AssignmentsInverseTable is the table name for the ReceiveAddressAssignment entity.
+	// It exists in this package in order to avoid circular dependency with the "receiveaddressassignment" package.
+	AssignmentsInverseTable = "receive_address_assignments"
+	// AssignmentsColumn is the table column denoting the assignments relation/edge.
+	AssignmentsColumn = "receive_address_assignments"
 )
 
 // Columns holds all SQL columns for receiveaddress fields.
@@ -81,6 +98,10 @@ var Columns = []string{
 	FieldAssignedAt,
 	FieldRecycledAt,
 	FieldTimesUsed,
+	FieldOwnerAddress,
+	FieldGenerationFingerprint,
+	FieldSaltDerivation,
+	FieldDerivationIndex,
 	FieldLastIndexedBlock,
 	FieldLastUsed,
 	FieldTxHash,
@@ -156,6 +177,32 @@ func StatusValidator(s Status) error {
 	}
 }
 
+// SaltDerivation defines the type for the "salt_derivation" enum field.
+type SaltDerivation string
+
+// SaltDerivationRandom is the default value of the SaltDerivation enum.
+const DefaultSaltDerivation = SaltDerivationRandom
+
+// SaltDerivation values.
+const (
+	SaltDerivationRandom SaltDerivation = "random"
+	SaltDerivationHkdf   SaltDerivation = "hkdf"
+)
+
+func (sd SaltDerivation) String() string {
+	return string(sd)
+}
+
+// SaltDerivationValidator is a validator for the "salt_derivation" field enum values. It is called by the builders before save.
+func SaltDerivationValidator(sd SaltDerivation) error {
+	switch sd {
+	case SaltDerivationRandom, SaltDerivationHkdf:
+		return nil
+	default:
+		return fmt.Errorf("receiveaddress: invalid enum value for salt_derivation field: %q", sd)
+	}
+}
+
 // OrderOption defines the ordering options for the ReceiveAddress queries.
 type OrderOption func(*sql.Selector)
 
@@ -229,6 +276,26 @@ func ByTimesUsed(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTimesUsed, opts...).ToFunc()
 }
 
+// ByOwnerAddress orders the results by the owner_address field.
+func ByOwnerAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOwnerAddress, opts...).ToFunc()
+}
+
+// ByGenerationFingerprint orders the results by the generation_fingerprint field.
+func ByGenerationFingerprint(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGenerationFingerprint, opts...).ToFunc()
+}
+
+// BySaltDerivation orders the results by the salt_derivation field.
+func BySaltDerivation(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSaltDerivation, opts...).ToFunc()
+}
+
+// ByDerivationIndex orders the results by the derivation_index field.
+func ByDerivationIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDerivationIndex, opts...).ToFunc()
+}
+
 // ByLastIndexedBlock orders the results by the last_indexed_block field.
 func ByLastIndexedBlock(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastIndexedBlock, opts...).ToFunc()
@@ -255,6 +322,20 @@ func ByPaymentOrderField(field string, opts ...sql.OrderTermOption) OrderOption
 		sqlgraph.OrderByNeighborTerms(s, newPaymentOrderStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByAssignmentsCount orders the results by assignments count.
+func ByAssignmentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAssignmentsStep(), opts...)
+	}
+}
+
+// ByAssignments orders the results by assignments terms.
+func ByAssignments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAssignmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newPaymentOrderStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -262,3 +343,10 @@ func newPaymentOrderStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2O, true, PaymentOrderTable, PaymentOrderColumn),
 	)
 }
+func newAssignmentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AssignmentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AssignmentsTable, AssignmentsColumn),
+	)
+}
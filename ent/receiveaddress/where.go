@@ -120,6 +120,21 @@ func TimesUsed(v int) predicate.ReceiveAddress {
 	return predicate.ReceiveAddress(sql.FieldEQ(FieldTimesUsed, v))
 }
 
+// OwnerAddress applies equality check predicate on the "owner_address" field. It's identical to OwnerAddressEQ.
+func OwnerAddress(v string) predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldEQ(FieldOwnerAddress, v))
+}
+
+// GenerationFingerprint applies equality check predicate on the "generation_fingerprint" field. It's identical to GenerationFingerprintEQ.
+func GenerationFingerprint(v string) predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldEQ(FieldGenerationFingerprint, v))
+}
+
+// DerivationIndex applies equality check predicate on the "derivation_index" field. It's identical to DerivationIndexEQ.
+func DerivationIndex(v int64) predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldEQ(FieldDerivationIndex, v))
+}
+
 // LastIndexedBlock applies equality check predicate on the "last_indexed_block" field. It's identical to LastIndexedBlockEQ.
 func LastIndexedBlock(v int64) predicate.ReceiveAddress {
 	return predicate.ReceiveAddress(sql.FieldEQ(FieldLastIndexedBlock, v))
@@ -805,6 +820,226 @@ func TimesUsedLTE(v int) predicate.ReceiveAddress {
 	return predicate.ReceiveAddress(sql.FieldLTE(FieldTimesUsed, v))
 }
 
+// OwnerAddressEQ applies the EQ predicate on the "owner_address" field.
+func OwnerAddressEQ(v string) predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldEQ(FieldOwnerAddress, v))
+}
+
+// OwnerAddressNEQ applies the NEQ predicate on the "owner_address" field.
+func OwnerAddressNEQ(v string) predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldNEQ(FieldOwnerAddress, v))
+}
+
+// OwnerAddressIn applies the In predicate on the "owner_address" field.
+func OwnerAddressIn(vs ...string) predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldIn(FieldOwnerAddress, vs...))
+}
+
+// OwnerAddressNotIn applies the NotIn predicate on the "owner_address" field.
+func OwnerAddressNotIn(vs ...string) predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldNotIn(FieldOwnerAddress, vs...))
+}
+
+// OwnerAddressGT applies the GT predicate on the "owner_address" field.
+func OwnerAddressGT(v string) predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldGT(FieldOwnerAddress, v))
+}
+
+// OwnerAddressGTE applies the GTE predicate on the "owner_address" field.
+func OwnerAddressGTE(v string) predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldGTE(FieldOwnerAddress, v))
+}
+
+// OwnerAddressLT applies the LT predicate on the "owner_address" field.
+func OwnerAddressLT(v string) predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldLT(FieldOwnerAddress, v))
+}
+
+// OwnerAddressLTE applies the LTE predicate on the "owner_address" field.
+func OwnerAddressLTE(v string) predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldLTE(FieldOwnerAddress, v))
+}
+
+// OwnerAddressContains applies the Contains predicate on the "owner_address" field.
+func OwnerAddressContains(v string) predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldContains(FieldOwnerAddress, v))
+}
+
+// OwnerAddressHasPrefix applies the HasPrefix predicate on the "owner_address" field.
+func OwnerAddressHasPrefix(v string) predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldHasPrefix(FieldOwnerAddress, v))
+}
+
+// OwnerAddressHasSuffix applies the HasSuffix predicate on the "owner_address" field.
+func OwnerAddressHasSuffix(v string) predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldHasSuffix(FieldOwnerAddress, v))
+}
+
+// OwnerAddressIsNil applies the IsNil predicate on the "owner_address" field.
+func OwnerAddressIsNil() predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldIsNull(FieldOwnerAddress))
+}
+
+// OwnerAddressNotNil applies the NotNil predicate on the "owner_address" field.
+func OwnerAddressNotNil() predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldNotNull(FieldOwnerAddress))
+}
+
+// OwnerAddressEqualFold applies the EqualFold predicate on the "owner_address" field.
+func OwnerAddressEqualFold(v string) predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldEqualFold(FieldOwnerAddress, v))
+}
+
+// OwnerAddressContainsFold applies the ContainsFold predicate on the "owner_address" field.
+func OwnerAddressContainsFold(v string) predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldContainsFold(FieldOwnerAddress, v))
+}
+
+// GenerationFingerprintEQ applies the EQ predicate on the "generation_fingerprint" field.
+func GenerationFingerprintEQ(v string) predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldEQ(FieldGenerationFingerprint, v))
+}
+
+// GenerationFingerprintNEQ applies the NEQ predicate on the "generation_fingerprint" field.
+func GenerationFingerprintNEQ(v string) predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldNEQ(FieldGenerationFingerprint, v))
+}
+
+// GenerationFingerprintIn applies the In predicate on the "generation_fingerprint" field.
+func GenerationFingerprintIn(vs ...string) predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldIn(FieldGenerationFingerprint, vs...))
+}
+
+// GenerationFingerprintNotIn applies the NotIn predicate on the "generation_fingerprint" field.
+func GenerationFingerprintNotIn(vs ...string) predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldNotIn(FieldGenerationFingerprint, vs...))
+}
+
+// GenerationFingerprintGT applies the GT predicate on the "generation_fingerprint" field.
+func GenerationFingerprintGT(v string) predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldGT(FieldGenerationFingerprint, v))
+}
+
+// GenerationFingerprintGTE applies the GTE predicate on the "generation_fingerprint" field.
+func GenerationFingerprintGTE(v string) predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldGTE(FieldGenerationFingerprint, v))
+}
+
+// GenerationFingerprintLT applies the LT predicate on the "generation_fingerprint" field.
+func GenerationFingerprintLT(v string) predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldLT(FieldGenerationFingerprint, v))
+}
+
+// GenerationFingerprintLTE applies the LTE predicate on the "generation_fingerprint" field.
+func GenerationFingerprintLTE(v string) predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldLTE(FieldGenerationFingerprint, v))
+}
+
+// GenerationFingerprintContains applies the Contains predicate on the "generation_fingerprint" field.
+func GenerationFingerprintContains(v string) predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldContains(FieldGenerationFingerprint, v))
+}
+
+// GenerationFingerprintHasPrefix applies the HasPrefix predicate on the "generation_fingerprint" field.
+func GenerationFingerprintHasPrefix(v string) predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldHasPrefix(FieldGenerationFingerprint, v))
+}
+
+// GenerationFingerprintHasSuffix applies the HasSuffix predicate on the "generation_fingerprint" field.
+func GenerationFingerprintHasSuffix(v string) predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldHasSuffix(FieldGenerationFingerprint, v))
+}
+
+// GenerationFingerprintIsNil applies the IsNil predicate on the "generation_fingerprint" field.
+func GenerationFingerprintIsNil() predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldIsNull(FieldGenerationFingerprint))
+}
+
+// GenerationFingerprintNotNil applies the NotNil predicate on the "generation_fingerprint" field.
+func GenerationFingerprintNotNil() predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldNotNull(FieldGenerationFingerprint))
+}
+
+// GenerationFingerprintEqualFold applies the EqualFold predicate on the "generation_fingerprint" field.
+func GenerationFingerprintEqualFold(v string) predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldEqualFold(FieldGenerationFingerprint, v))
+}
+
+// GenerationFingerprintContainsFold applies the ContainsFold predicate on the "generation_fingerprint" field.
+func GenerationFingerprintContainsFold(v string) predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldContainsFold(FieldGenerationFingerprint, v))
+}
+
+// SaltDerivationEQ applies the EQ predicate on the "salt_derivation" field.
+func SaltDerivationEQ(v SaltDerivation) predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldEQ(FieldSaltDerivation, v))
+}
+
+// SaltDerivationNEQ applies the NEQ predicate on the "salt_derivation" field.
+func SaltDerivationNEQ(v SaltDerivation) predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldNEQ(FieldSaltDerivation, v))
+}
+
+// SaltDerivationIn applies the In predicate on the "salt_derivation" field.
+func SaltDerivationIn(vs ...SaltDerivation) predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldIn(FieldSaltDerivation, vs...))
+}
+
+// SaltDerivationNotIn applies the NotIn predicate on the "salt_derivation" field.
+func SaltDerivationNotIn(vs ...SaltDerivation) predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldNotIn(FieldSaltDerivation, vs...))
+}
+
+// DerivationIndexEQ applies the EQ predicate on the "derivation_index" field.
+func DerivationIndexEQ(v int64) predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldEQ(FieldDerivationIndex, v))
+}
+
+// DerivationIndexNEQ applies the NEQ predicate on the "derivation_index" field.
+func DerivationIndexNEQ(v int64) predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldNEQ(FieldDerivationIndex, v))
+}
+
+// DerivationIndexIn applies the In predicate on the "derivation_index" field.
+func DerivationIndexIn(vs ...int64) predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldIn(FieldDerivationIndex, vs...))
+}
+
+// DerivationIndexNotIn applies the NotIn predicate on the "derivation_index" field.
+func DerivationIndexNotIn(vs ...int64) predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldNotIn(FieldDerivationIndex, vs...))
+}
+
+// DerivationIndexGT applies the GT predicate on the "derivation_index" field.
+func DerivationIndexGT(v int64) predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldGT(FieldDerivationIndex, v))
+}
+
+// DerivationIndexGTE applies the GTE predicate on the "derivation_index" field.
+func DerivationIndexGTE(v int64) predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldGTE(FieldDerivationIndex, v))
+}
+
+// DerivationIndexLT applies the LT predicate on the "derivation_index" field.
+func DerivationIndexLT(v int64) predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldLT(FieldDerivationIndex, v))
+}
+
+// DerivationIndexLTE applies the LTE predicate on the "derivation_index" field.
+func DerivationIndexLTE(v int64) predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldLTE(FieldDerivationIndex, v))
+}
+
+// DerivationIndexIsNil applies the IsNil predicate on the "derivation_index" field.
+func DerivationIndexIsNil() predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldIsNull(FieldDerivationIndex))
+}
+
+// DerivationIndexNotNil applies the NotNil predicate on the "derivation_index" field.
+func DerivationIndexNotNil() predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(sql.FieldNotNull(FieldDerivationIndex))
+}
+
 // LastIndexedBlockEQ applies the EQ predicate on the "last_indexed_block" field.
 func LastIndexedBlockEQ(v int64) predicate.ReceiveAddress {
 	return predicate.ReceiveAddress(sql.FieldEQ(FieldLastIndexedBlock, v))
@@ -1053,6 +1288,29 @@ func HasPaymentOrderWith(preds ...predicate.PaymentOrder) predicate.ReceiveAddre
 	})
 }
 
+// HasAssignments applies the HasEdge predicate on the "assignments" edge.
+func HasAssignments() predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AssignmentsTable, AssignmentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAssignmentsWith applies the HasEdge predicate on the "assignments" edge with a given conditions (other predicates).
+func HasAssignmentsWith(preds ...predicate.ReceiveAddressAssignment) predicate.ReceiveAddress {
+	return predicate.ReceiveAddress(func(s *sql.Selector) {
+		step := newAssignmentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.ReceiveAddress) predicate.ReceiveAddress {
 	return predicate.ReceiveAddress(sql.AndPredicates(predicates...))
@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
 	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddressassignment"
 	"github.com/google/uuid"
 )
 
@@ -204,6 +205,62 @@ func (rac *ReceiveAddressCreate) SetNillableTimesUsed(i *int) *ReceiveAddressCre
 	return rac
 }
 
+// SetOwnerAddress sets the "owner_address" field.
+func (rac *ReceiveAddressCreate) SetOwnerAddress(s string) *ReceiveAddressCreate {
+	rac.mutation.SetOwnerAddress(s)
+	return rac
+}
+
+// SetNillableOwnerAddress sets the "owner_address" field if the given value is not nil.
+func (rac *ReceiveAddressCreate) SetNillableOwnerAddress(s *string) *ReceiveAddressCreate {
+	if s != nil {
+		rac.SetOwnerAddress(*s)
+	}
+	return rac
+}
+
+// SetGenerationFingerprint sets the "generation_fingerprint" field.
+func (rac *ReceiveAddressCreate) SetGenerationFingerprint(s string) *ReceiveAddressCreate {
+	rac.mutation.SetGenerationFingerprint(s)
+	return rac
+}
+
+// SetNillableGenerationFingerprint sets the "generation_fingerprint" field if the given value is not nil.
+func (rac *ReceiveAddressCreate) SetNillableGenerationFingerprint(s *string) *ReceiveAddressCreate {
+	if s != nil {
+		rac.SetGenerationFingerprint(*s)
+	}
+	return rac
+}
+
+// SetSaltDerivation sets the "salt_derivation" field.
+func (rac *ReceiveAddressCreate) SetSaltDerivation(rd receiveaddress.SaltDerivation) *ReceiveAddressCreate {
+	rac.mutation.SetSaltDerivation(rd)
+	return rac
+}
+
+// SetNillableSaltDerivation sets the "salt_derivation" field if the given value is not nil.
+func (rac *ReceiveAddressCreate) SetNillableSaltDerivation(rd *receiveaddress.SaltDerivation) *ReceiveAddressCreate {
+	if rd != nil {
+		rac.SetSaltDerivation(*rd)
+	}
+	return rac
+}
+
+// SetDerivationIndex sets the "derivation_index" field.
+func (rac *ReceiveAddressCreate) SetDerivationIndex(i int64) *ReceiveAddressCreate {
+	rac.mutation.SetDerivationIndex(i)
+	return rac
+}
+
+// SetNillableDerivationIndex sets the "derivation_index" field if the given value is not nil.
+func (rac *ReceiveAddressCreate) SetNillableDerivationIndex(i *int64) *ReceiveAddressCreate {
+	if i != nil {
+		rac.SetDerivationIndex(*i)
+	}
+	return rac
+}
+
 // SetLastIndexedBlock sets the "last_indexed_block" field.
 func (rac *ReceiveAddressCreate) SetLastIndexedBlock(i int64) *ReceiveAddressCreate {
 	rac.mutation.SetLastIndexedBlock(i)
@@ -279,6 +336,21 @@ func (rac *ReceiveAddressCreate) SetPaymentOrder(p *PaymentOrder) *ReceiveAddres
 	return rac.SetPaymentOrderID(p.ID)
 }
 
+// AddAssignmentIDs adds the "assignments" edge to the ReceiveAddressAssignment entity by IDs.
+func (rac *ReceiveAddressCreate) AddAssignmentIDs(ids ...uuid.UUID) *ReceiveAddressCreate {
+	rac.mutation.AddAssignmentIDs(ids...)
+	return rac
+}
+
+// AddAssignments adds the "assignments" edges to the ReceiveAddressAssignment entity.
+func (rac *ReceiveAddressCreate) AddAssignments(r ...*ReceiveAddressAssignment) *ReceiveAddressCreate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rac.AddAssignmentIDs(ids...)
+}
+
 // Mutation returns the ReceiveAddressMutation object of the builder.
 func (rac *ReceiveAddressCreate) Mutation() *ReceiveAddressMutation {
 	return rac.mutation
@@ -334,6 +406,10 @@ func (rac *ReceiveAddressCreate) defaults() {
 		v := receiveaddress.DefaultTimesUsed
 		rac.mutation.SetTimesUsed(v)
 	}
+	if _, ok := rac.mutation.SaltDerivation(); !ok {
+		v := receiveaddress.DefaultSaltDerivation
+		rac.mutation.SetSaltDerivation(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -366,6 +442,14 @@ func (rac *ReceiveAddressCreate) check() error {
 	if _, ok := rac.mutation.TimesUsed(); !ok {
 		return &ValidationError{Name: "times_used", err: errors.New(`ent: missing required field "ReceiveAddress.times_used"`)}
 	}
+	if _, ok := rac.mutation.SaltDerivation(); !ok {
+		return &ValidationError{Name: "salt_derivation", err: errors.New(`ent: missing required field "ReceiveAddress.salt_derivation"`)}
+	}
+	if v, ok := rac.mutation.SaltDerivation(); ok {
+		if err := receiveaddress.SaltDerivationValidator(v); err != nil {
+			return &ValidationError{Name: "salt_derivation", err: fmt.Errorf(`ent: validator failed for field "ReceiveAddress.salt_derivation": %w`, err)}
+		}
+	}
 	if v, ok := rac.mutation.TxHash(); ok {
 		if err := receiveaddress.TxHashValidator(v); err != nil {
 			return &ValidationError{Name: "tx_hash", err: fmt.Errorf(`ent: validator failed for field "ReceiveAddress.tx_hash": %w`, err)}
@@ -454,6 +538,22 @@ func (rac *ReceiveAddressCreate) createSpec() (*ReceiveAddress, *sqlgraph.Create
 		_spec.SetField(receiveaddress.FieldTimesUsed, field.TypeInt, value)
 		_node.TimesUsed = value
 	}
+	if value, ok := rac.mutation.OwnerAddress(); ok {
+		_spec.SetField(receiveaddress.FieldOwnerAddress, field.TypeString, value)
+		_node.OwnerAddress = value
+	}
+	if value, ok := rac.mutation.GenerationFingerprint(); ok {
+		_spec.SetField(receiveaddress.FieldGenerationFingerprint, field.TypeString, value)
+		_node.GenerationFingerprint = value
+	}
+	if value, ok := rac.mutation.SaltDerivation(); ok {
+		_spec.SetField(receiveaddress.FieldSaltDerivation, field.TypeEnum, value)
+		_node.SaltDerivation = value
+	}
+	if value, ok := rac.mutation.DerivationIndex(); ok {
+		_spec.SetField(receiveaddress.FieldDerivationIndex, field.TypeInt64, value)
+		_node.DerivationIndex = &value
+	}
 	if value, ok := rac.mutation.LastIndexedBlock(); ok {
 		_spec.SetField(receiveaddress.FieldLastIndexedBlock, field.TypeInt64, value)
 		_node.LastIndexedBlock = value
@@ -487,6 +587,22 @@ func (rac *ReceiveAddressCreate) createSpec() (*ReceiveAddress, *sqlgraph.Create
 		_node.payment_order_receive_address = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := rac.mutation.AssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   receiveaddress.AssignmentsTable,
+			Columns: []string{receiveaddress.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receiveaddressassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -761,6 +877,78 @@ func (u *ReceiveAddressUpsert) AddTimesUsed(v int) *ReceiveAddressUpsert {
 	return u
 }
 
+// SetOwnerAddress sets the "owner_address" field.
+func (u *ReceiveAddressUpsert) SetOwnerAddress(v string) *ReceiveAddressUpsert {
+	u.Set(receiveaddress.FieldOwnerAddress, v)
+	return u
+}
+
+// UpdateOwnerAddress sets the "owner_address" field to the value that was provided on create.
+func (u *ReceiveAddressUpsert) UpdateOwnerAddress() *ReceiveAddressUpsert {
+	u.SetExcluded(receiveaddress.FieldOwnerAddress)
+	return u
+}
+
+// ClearOwnerAddress clears the value of the "owner_address" field.
+func (u *ReceiveAddressUpsert) ClearOwnerAddress() *ReceiveAddressUpsert {
+	u.SetNull(receiveaddress.FieldOwnerAddress)
+	return u
+}
+
+// SetGenerationFingerprint sets the "generation_fingerprint" field.
+func (u *ReceiveAddressUpsert) SetGenerationFingerprint(v string) *ReceiveAddressUpsert {
+	u.Set(receiveaddress.FieldGenerationFingerprint, v)
+	return u
+}
+
+// UpdateGenerationFingerprint sets the "generation_fingerprint" field to the value that was provided on create.
+func (u *ReceiveAddressUpsert) UpdateGenerationFingerprint() *ReceiveAddressUpsert {
+	u.SetExcluded(receiveaddress.FieldGenerationFingerprint)
+	return u
+}
+
+// ClearGenerationFingerprint clears the value of the "generation_fingerprint" field.
+func (u *ReceiveAddressUpsert) ClearGenerationFingerprint() *ReceiveAddressUpsert {
+	u.SetNull(receiveaddress.FieldGenerationFingerprint)
+	return u
+}
+
+// SetSaltDerivation sets the "salt_derivation" field.
+func (u *ReceiveAddressUpsert) SetSaltDerivation(v receiveaddress.SaltDerivation) *ReceiveAddressUpsert {
+	u.Set(receiveaddress.FieldSaltDerivation, v)
+	return u
+}
+
+// UpdateSaltDerivation sets the "salt_derivation" field to the value that was provided on create.
+func (u *ReceiveAddressUpsert) UpdateSaltDerivation() *ReceiveAddressUpsert {
+	u.SetExcluded(receiveaddress.FieldSaltDerivation)
+	return u
+}
+
+// SetDerivationIndex sets the "derivation_index" field.
+func (u *ReceiveAddressUpsert) SetDerivationIndex(v int64) *ReceiveAddressUpsert {
+	u.Set(receiveaddress.FieldDerivationIndex, v)
+	return u
+}
+
+// UpdateDerivationIndex sets the "derivation_index" field to the value that was provided on create.
+func (u *ReceiveAddressUpsert) UpdateDerivationIndex() *ReceiveAddressUpsert {
+	u.SetExcluded(receiveaddress.FieldDerivationIndex)
+	return u
+}
+
+// AddDerivationIndex adds v to the "derivation_index" field.
+func (u *ReceiveAddressUpsert) AddDerivationIndex(v int64) *ReceiveAddressUpsert {
+	u.Add(receiveaddress.FieldDerivationIndex, v)
+	return u
+}
+
+// ClearDerivationIndex clears the value of the "derivation_index" field.
+func (u *ReceiveAddressUpsert) ClearDerivationIndex() *ReceiveAddressUpsert {
+	u.SetNull(receiveaddress.FieldDerivationIndex)
+	return u
+}
+
 // SetLastIndexedBlock sets the "last_indexed_block" field.
 func (u *ReceiveAddressUpsert) SetLastIndexedBlock(v int64) *ReceiveAddressUpsert {
 	u.Set(receiveaddress.FieldLastIndexedBlock, v)
@@ -1143,6 +1331,90 @@ func (u *ReceiveAddressUpsertOne) UpdateTimesUsed() *ReceiveAddressUpsertOne {
 	})
 }
 
+// SetOwnerAddress sets the "owner_address" field.
+func (u *ReceiveAddressUpsertOne) SetOwnerAddress(v string) *ReceiveAddressUpsertOne {
+	return u.Update(func(s *ReceiveAddressUpsert) {
+		s.SetOwnerAddress(v)
+	})
+}
+
+// UpdateOwnerAddress sets the "owner_address" field to the value that was provided on create.
+func (u *ReceiveAddressUpsertOne) UpdateOwnerAddress() *ReceiveAddressUpsertOne {
+	return u.Update(func(s *ReceiveAddressUpsert) {
+		s.UpdateOwnerAddress()
+	})
+}
+
+// ClearOwnerAddress clears the value of the "owner_address" field.
+func (u *ReceiveAddressUpsertOne) ClearOwnerAddress() *ReceiveAddressUpsertOne {
+	return u.Update(func(s *ReceiveAddressUpsert) {
+		s.ClearOwnerAddress()
+	})
+}
+
+// SetGenerationFingerprint sets the "generation_fingerprint" field.
+func (u *ReceiveAddressUpsertOne) SetGenerationFingerprint(v string) *ReceiveAddressUpsertOne {
+	return u.Update(func(s *ReceiveAddressUpsert) {
+		s.SetGenerationFingerprint(v)
+	})
+}
+
+// UpdateGenerationFingerprint sets the "generation_fingerprint" field to the value that was provided on create.
+func (u *ReceiveAddressUpsertOne) UpdateGenerationFingerprint() *ReceiveAddressUpsertOne {
+	return u.Update(func(s *ReceiveAddressUpsert) {
+		s.UpdateGenerationFingerprint()
+	})
+}
+
+// ClearGenerationFingerprint clears the value of the "generation_fingerprint" field.
+func (u *ReceiveAddressUpsertOne) ClearGenerationFingerprint() *ReceiveAddressUpsertOne {
+	return u.Update(func(s *ReceiveAddressUpsert) {
+		s.ClearGenerationFingerprint()
+	})
+}
+
+// SetSaltDerivation sets the "salt_derivation" field.
+func (u *ReceiveAddressUpsertOne) SetSaltDerivation(v receiveaddress.SaltDerivation) *ReceiveAddressUpsertOne {
+	return u.Update(func(s *ReceiveAddressUpsert) {
+		s.SetSaltDerivation(v)
+	})
+}
+
+// UpdateSaltDerivation sets the "salt_derivation" field to the value that was provided on create.
+func (u *ReceiveAddressUpsertOne) UpdateSaltDerivation() *ReceiveAddressUpsertOne {
+	return u.Update(func(s *ReceiveAddressUpsert) {
+		s.UpdateSaltDerivation()
+	})
+}
+
+// SetDerivationIndex sets the "derivation_index" field.
+func (u *ReceiveAddressUpsertOne) SetDerivationIndex(v int64) *ReceiveAddressUpsertOne {
+	return u.Update(func(s *ReceiveAddressUpsert) {
+		s.SetDerivationIndex(v)
+	})
+}
+
+// AddDerivationIndex adds v to the "derivation_index" field.
+func (u *ReceiveAddressUpsertOne) AddDerivationIndex(v int64) *ReceiveAddressUpsertOne {
+	return u.Update(func(s *ReceiveAddressUpsert) {
+		s.AddDerivationIndex(v)
+	})
+}
+
+// UpdateDerivationIndex sets the "derivation_index" field to the value that was provided on create.
+func (u *ReceiveAddressUpsertOne) UpdateDerivationIndex() *ReceiveAddressUpsertOne {
+	return u.Update(func(s *ReceiveAddressUpsert) {
+		s.UpdateDerivationIndex()
+	})
+}
+
+// ClearDerivationIndex clears the value of the "derivation_index" field.
+func (u *ReceiveAddressUpsertOne) ClearDerivationIndex() *ReceiveAddressUpsertOne {
+	return u.Update(func(s *ReceiveAddressUpsert) {
+		s.ClearDerivationIndex()
+	})
+}
+
 // SetLastIndexedBlock sets the "last_indexed_block" field.
 func (u *ReceiveAddressUpsertOne) SetLastIndexedBlock(v int64) *ReceiveAddressUpsertOne {
 	return u.Update(func(s *ReceiveAddressUpsert) {
@@ -1704,6 +1976,90 @@ func (u *ReceiveAddressUpsertBulk) UpdateTimesUsed() *ReceiveAddressUpsertBulk {
 	})
 }
 
+// SetOwnerAddress sets the "owner_address" field.
+func (u *ReceiveAddressUpsertBulk) SetOwnerAddress(v string) *ReceiveAddressUpsertBulk {
+	return u.Update(func(s *ReceiveAddressUpsert) {
+		s.SetOwnerAddress(v)
+	})
+}
+
+// UpdateOwnerAddress sets the "owner_address" field to the value that was provided on create.
+func (u *ReceiveAddressUpsertBulk) UpdateOwnerAddress() *ReceiveAddressUpsertBulk {
+	return u.Update(func(s *ReceiveAddressUpsert) {
+		s.UpdateOwnerAddress()
+	})
+}
+
+// ClearOwnerAddress clears the value of the "owner_address" field.
+func (u *ReceiveAddressUpsertBulk) ClearOwnerAddress() *ReceiveAddressUpsertBulk {
+	return u.Update(func(s *ReceiveAddressUpsert) {
+		s.ClearOwnerAddress()
+	})
+}
+
+// SetGenerationFingerprint sets the "generation_fingerprint" field.
+func (u *ReceiveAddressUpsertBulk) SetGenerationFingerprint(v string) *ReceiveAddressUpsertBulk {
+	return u.Update(func(s *ReceiveAddressUpsert) {
+		s.SetGenerationFingerprint(v)
+	})
+}
+
+// UpdateGenerationFingerprint sets the "generation_fingerprint" field to the value that was provided on create.
+func (u *ReceiveAddressUpsertBulk) UpdateGenerationFingerprint() *ReceiveAddressUpsertBulk {
+	return u.Update(func(s *ReceiveAddressUpsert) {
+		s.UpdateGenerationFingerprint()
+	})
+}
+
+// ClearGenerationFingerprint clears the value of the "generation_fingerprint" field.
+func (u *ReceiveAddressUpsertBulk) ClearGenerationFingerprint() *ReceiveAddressUpsertBulk {
+	return u.Update(func(s *ReceiveAddressUpsert) {
+		s.ClearGenerationFingerprint()
+	})
+}
+
+// SetSaltDerivation sets the "salt_derivation" field.
+func (u *ReceiveAddressUpsertBulk) SetSaltDerivation(v receiveaddress.SaltDerivation) *ReceiveAddressUpsertBulk {
+	return u.Update(func(s *ReceiveAddressUpsert) {
+		s.SetSaltDerivation(v)
+	})
+}
+
+// UpdateSaltDerivation sets the "salt_derivation" field to the value that was provided on create.
+func (u *ReceiveAddressUpsertBulk) UpdateSaltDerivation() *ReceiveAddressUpsertBulk {
+	return u.Update(func(s *ReceiveAddressUpsert) {
+		s.UpdateSaltDerivation()
+	})
+}
+
+// SetDerivationIndex sets the "derivation_index" field.
+func (u *ReceiveAddressUpsertBulk) SetDerivationIndex(v int64) *ReceiveAddressUpsertBulk {
+	return u.Update(func(s *ReceiveAddressUpsert) {
+		s.SetDerivationIndex(v)
+	})
+}
+
+// AddDerivationIndex adds v to the "derivation_index" field.
+func (u *ReceiveAddressUpsertBulk) AddDerivationIndex(v int64) *ReceiveAddressUpsertBulk {
+	return u.Update(func(s *ReceiveAddressUpsert) {
+		s.AddDerivationIndex(v)
+	})
+}
+
+// UpdateDerivationIndex sets the "derivation_index" field to the value that was provided on create.
+func (u *ReceiveAddressUpsertBulk) UpdateDerivationIndex() *ReceiveAddressUpsertBulk {
+	return u.Update(func(s *ReceiveAddressUpsert) {
+		s.UpdateDerivationIndex()
+	})
+}
+
+// ClearDerivationIndex clears the value of the "derivation_index" field.
+func (u *ReceiveAddressUpsertBulk) ClearDerivationIndex() *ReceiveAddressUpsertBulk {
+	return u.Update(func(s *ReceiveAddressUpsert) {
+		s.ClearDerivationIndex()
+	})
+}
+
 // SetLastIndexedBlock sets the "last_indexed_block" field.
 func (u *ReceiveAddressUpsertBulk) SetLastIndexedBlock(v int64) *ReceiveAddressUpsertBulk {
 	return u.Update(func(s *ReceiveAddressUpsert) {
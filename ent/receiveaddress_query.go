@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
 	"github.com/NEDA-LABS/stablenode/ent/predicate"
 	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddressassignment"
 	"github.com/google/uuid"
 )
 
@@ -25,6 +27,7 @@ type ReceiveAddressQuery struct {
 	inters           []Interceptor
 	predicates       []predicate.ReceiveAddress
 	withPaymentOrder *PaymentOrderQuery
+	withAssignments  *ReceiveAddressAssignmentQuery
 	withFKs          bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -84,6 +87,28 @@ func (raq *ReceiveAddressQuery) QueryPaymentOrder() *PaymentOrderQuery {
 	return query
 }
 
+// QueryAssignments chains the current query on the "assignments" edge.
+func (raq *ReceiveAddressQuery) QueryAssignments() *ReceiveAddressAssignmentQuery {
+	query := (&ReceiveAddressAssignmentClient{config: raq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := raq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := raq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(receiveaddress.Table, receiveaddress.FieldID, selector),
+			sqlgraph.To(receiveaddressassignment.Table, receiveaddressassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, receiveaddress.AssignmentsTable, receiveaddress.AssignmentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(raq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first ReceiveAddress entity from the query.
 // Returns a *NotFoundError when no ReceiveAddress was found.
 func (raq *ReceiveAddressQuery) First(ctx context.Context) (*ReceiveAddress, error) {
@@ -277,6 +302,7 @@ func (raq *ReceiveAddressQuery) Clone() *ReceiveAddressQuery {
 		inters:           append([]Interceptor{}, raq.inters...),
 		predicates:       append([]predicate.ReceiveAddress{}, raq.predicates...),
 		withPaymentOrder: raq.withPaymentOrder.Clone(),
+		withAssignments:  raq.withAssignments.Clone(),
 		// clone intermediate query.
 		sql:  raq.sql.Clone(),
 		path: raq.path,
@@ -294,6 +320,17 @@ func (raq *ReceiveAddressQuery) WithPaymentOrder(opts ...func(*PaymentOrderQuery
 	return raq
 }
 
+// WithAssignments tells the query-builder to eager-load the nodes that are connected to
+// the "assignments" edge. The optional arguments are used to configure the query builder of the edge.
+func (raq *ReceiveAddressQuery) WithAssignments(opts ...func(*ReceiveAddressAssignmentQuery)) *ReceiveAddressQuery {
+	query := (&ReceiveAddressAssignmentClient{config: raq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	raq.withAssignments = query
+	return raq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -373,8 +410,9 @@ func (raq *ReceiveAddressQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 		nodes       = []*ReceiveAddress{}
 		withFKs     = raq.withFKs
 		_spec       = raq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			raq.withPaymentOrder != nil,
+			raq.withAssignments != nil,
 		}
 	)
 	if raq.withPaymentOrder != nil {
@@ -407,6 +445,15 @@ func (raq *ReceiveAddressQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 			return nil, err
 		}
 	}
+	if query := raq.withAssignments; query != nil {
+		if err := raq.loadAssignments(ctx, query, nodes,
+			func(n *ReceiveAddress) { n.Edges.Assignments = []*ReceiveAddressAssignment{} },
+			func(n *ReceiveAddress, e *ReceiveAddressAssignment) {
+				n.Edges.Assignments = append(n.Edges.Assignments, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -442,6 +489,37 @@ func (raq *ReceiveAddressQuery) loadPaymentOrder(ctx context.Context, query *Pay
 	}
 	return nil
 }
+func (raq *ReceiveAddressQuery) loadAssignments(ctx context.Context, query *ReceiveAddressAssignmentQuery, nodes []*ReceiveAddress, init func(*ReceiveAddress), assign func(*ReceiveAddress, *ReceiveAddressAssignment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*ReceiveAddress)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ReceiveAddressAssignment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(receiveaddress.AssignmentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.receive_address_assignments
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "receive_address_assignments" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "receive_address_assignments" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (raq *ReceiveAddressQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := raq.querySpec()
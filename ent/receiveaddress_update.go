@@ -14,6 +14,7 @@ import (
 	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
 	"github.com/NEDA-LABS/stablenode/ent/predicate"
 	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddressassignment"
 	"github.com/google/uuid"
 )
 
@@ -265,6 +266,87 @@ func (rau *ReceiveAddressUpdate) AddTimesUsed(i int) *ReceiveAddressUpdate {
 	return rau
 }
 
+// SetOwnerAddress sets the "owner_address" field.
+func (rau *ReceiveAddressUpdate) SetOwnerAddress(s string) *ReceiveAddressUpdate {
+	rau.mutation.SetOwnerAddress(s)
+	return rau
+}
+
+// SetNillableOwnerAddress sets the "owner_address" field if the given value is not nil.
+func (rau *ReceiveAddressUpdate) SetNillableOwnerAddress(s *string) *ReceiveAddressUpdate {
+	if s != nil {
+		rau.SetOwnerAddress(*s)
+	}
+	return rau
+}
+
+// ClearOwnerAddress clears the value of the "owner_address" field.
+func (rau *ReceiveAddressUpdate) ClearOwnerAddress() *ReceiveAddressUpdate {
+	rau.mutation.ClearOwnerAddress()
+	return rau
+}
+
+// SetGenerationFingerprint sets the "generation_fingerprint" field.
+func (rau *ReceiveAddressUpdate) SetGenerationFingerprint(s string) *ReceiveAddressUpdate {
+	rau.mutation.SetGenerationFingerprint(s)
+	return rau
+}
+
+// SetNillableGenerationFingerprint sets the "generation_fingerprint" field if the given value is not nil.
+func (rau *ReceiveAddressUpdate) SetNillableGenerationFingerprint(s *string) *ReceiveAddressUpdate {
+	if s != nil {
+		rau.SetGenerationFingerprint(*s)
+	}
+	return rau
+}
+
+// ClearGenerationFingerprint clears the value of the "generation_fingerprint" field.
+func (rau *ReceiveAddressUpdate) ClearGenerationFingerprint() *ReceiveAddressUpdate {
+	rau.mutation.ClearGenerationFingerprint()
+	return rau
+}
+
+// SetSaltDerivation sets the "salt_derivation" field.
+func (rau *ReceiveAddressUpdate) SetSaltDerivation(rd receiveaddress.SaltDerivation) *ReceiveAddressUpdate {
+	rau.mutation.SetSaltDerivation(rd)
+	return rau
+}
+
+// SetNillableSaltDerivation sets the "salt_derivation" field if the given value is not nil.
+func (rau *ReceiveAddressUpdate) SetNillableSaltDerivation(rd *receiveaddress.SaltDerivation) *ReceiveAddressUpdate {
+	if rd != nil {
+		rau.SetSaltDerivation(*rd)
+	}
+	return rau
+}
+
+// SetDerivationIndex sets the "derivation_index" field.
+func (rau *ReceiveAddressUpdate) SetDerivationIndex(i int64) *ReceiveAddressUpdate {
+	rau.mutation.ResetDerivationIndex()
+	rau.mutation.SetDerivationIndex(i)
+	return rau
+}
+
+// SetNillableDerivationIndex sets the "derivation_index" field if the given value is not nil.
+func (rau *ReceiveAddressUpdate) SetNillableDerivationIndex(i *int64) *ReceiveAddressUpdate {
+	if i != nil {
+		rau.SetDerivationIndex(*i)
+	}
+	return rau
+}
+
+// AddDerivationIndex adds i to the "derivation_index" field.
+func (rau *ReceiveAddressUpdate) AddDerivationIndex(i int64) *ReceiveAddressUpdate {
+	rau.mutation.AddDerivationIndex(i)
+	return rau
+}
+
+// ClearDerivationIndex clears the value of the "derivation_index" field.
+func (rau *ReceiveAddressUpdate) ClearDerivationIndex() *ReceiveAddressUpdate {
+	rau.mutation.ClearDerivationIndex()
+	return rau
+}
+
 // SetLastIndexedBlock sets the "last_indexed_block" field.
 func (rau *ReceiveAddressUpdate) SetLastIndexedBlock(i int64) *ReceiveAddressUpdate {
 	rau.mutation.ResetLastIndexedBlock()
@@ -371,6 +453,21 @@ func (rau *ReceiveAddressUpdate) SetPaymentOrder(p *PaymentOrder) *ReceiveAddres
 	return rau.SetPaymentOrderID(p.ID)
 }
 
+// AddAssignmentIDs adds the "assignments" edge to the ReceiveAddressAssignment entity by IDs.
+func (rau *ReceiveAddressUpdate) AddAssignmentIDs(ids ...uuid.UUID) *ReceiveAddressUpdate {
+	rau.mutation.AddAssignmentIDs(ids...)
+	return rau
+}
+
+// AddAssignments adds the "assignments" edges to the ReceiveAddressAssignment entity.
+func (rau *ReceiveAddressUpdate) AddAssignments(r ...*ReceiveAddressAssignment) *ReceiveAddressUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rau.AddAssignmentIDs(ids...)
+}
+
 // Mutation returns the ReceiveAddressMutation object of the builder.
 func (rau *ReceiveAddressUpdate) Mutation() *ReceiveAddressMutation {
 	return rau.mutation
@@ -382,6 +479,27 @@ func (rau *ReceiveAddressUpdate) ClearPaymentOrder() *ReceiveAddressUpdate {
 	return rau
 }
 
+// ClearAssignments clears all "assignments" edges to the ReceiveAddressAssignment entity.
+func (rau *ReceiveAddressUpdate) ClearAssignments() *ReceiveAddressUpdate {
+	rau.mutation.ClearAssignments()
+	return rau
+}
+
+// RemoveAssignmentIDs removes the "assignments" edge to ReceiveAddressAssignment entities by IDs.
+func (rau *ReceiveAddressUpdate) RemoveAssignmentIDs(ids ...uuid.UUID) *ReceiveAddressUpdate {
+	rau.mutation.RemoveAssignmentIDs(ids...)
+	return rau
+}
+
+// RemoveAssignments removes "assignments" edges to ReceiveAddressAssignment entities.
+func (rau *ReceiveAddressUpdate) RemoveAssignments(r ...*ReceiveAddressAssignment) *ReceiveAddressUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rau.RemoveAssignmentIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (rau *ReceiveAddressUpdate) Save(ctx context.Context) (int, error) {
 	rau.defaults()
@@ -430,6 +548,11 @@ func (rau *ReceiveAddressUpdate) check() error {
 			return &ValidationError{Name: "deployment_tx_hash", err: fmt.Errorf(`ent: validator failed for field "ReceiveAddress.deployment_tx_hash": %w`, err)}
 		}
 	}
+	if v, ok := rau.mutation.SaltDerivation(); ok {
+		if err := receiveaddress.SaltDerivationValidator(v); err != nil {
+			return &ValidationError{Name: "salt_derivation", err: fmt.Errorf(`ent: validator failed for field "ReceiveAddress.salt_derivation": %w`, err)}
+		}
+	}
 	if v, ok := rau.mutation.TxHash(); ok {
 		if err := receiveaddress.TxHashValidator(v); err != nil {
 			return &ValidationError{Name: "tx_hash", err: fmt.Errorf(`ent: validator failed for field "ReceiveAddress.tx_hash": %w`, err)}
@@ -522,6 +645,30 @@ func (rau *ReceiveAddressUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if value, ok := rau.mutation.AddedTimesUsed(); ok {
 		_spec.AddField(receiveaddress.FieldTimesUsed, field.TypeInt, value)
 	}
+	if value, ok := rau.mutation.OwnerAddress(); ok {
+		_spec.SetField(receiveaddress.FieldOwnerAddress, field.TypeString, value)
+	}
+	if rau.mutation.OwnerAddressCleared() {
+		_spec.ClearField(receiveaddress.FieldOwnerAddress, field.TypeString)
+	}
+	if value, ok := rau.mutation.GenerationFingerprint(); ok {
+		_spec.SetField(receiveaddress.FieldGenerationFingerprint, field.TypeString, value)
+	}
+	if rau.mutation.GenerationFingerprintCleared() {
+		_spec.ClearField(receiveaddress.FieldGenerationFingerprint, field.TypeString)
+	}
+	if value, ok := rau.mutation.SaltDerivation(); ok {
+		_spec.SetField(receiveaddress.FieldSaltDerivation, field.TypeEnum, value)
+	}
+	if value, ok := rau.mutation.DerivationIndex(); ok {
+		_spec.SetField(receiveaddress.FieldDerivationIndex, field.TypeInt64, value)
+	}
+	if value, ok := rau.mutation.AddedDerivationIndex(); ok {
+		_spec.AddField(receiveaddress.FieldDerivationIndex, field.TypeInt64, value)
+	}
+	if rau.mutation.DerivationIndexCleared() {
+		_spec.ClearField(receiveaddress.FieldDerivationIndex, field.TypeInt64)
+	}
 	if value, ok := rau.mutation.LastIndexedBlock(); ok {
 		_spec.SetField(receiveaddress.FieldLastIndexedBlock, field.TypeInt64, value)
 	}
@@ -578,6 +725,51 @@ func (rau *ReceiveAddressUpdate) sqlSave(ctx context.Context) (n int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if rau.mutation.AssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   receiveaddress.AssignmentsTable,
+			Columns: []string{receiveaddress.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receiveaddressassignment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rau.mutation.RemovedAssignmentsIDs(); len(nodes) > 0 && !rau.mutation.AssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   receiveaddress.AssignmentsTable,
+			Columns: []string{receiveaddress.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receiveaddressassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rau.mutation.AssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   receiveaddress.AssignmentsTable,
+			Columns: []string{receiveaddress.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receiveaddressassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, rau.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{receiveaddress.Label}
@@ -833,6 +1025,87 @@ func (rauo *ReceiveAddressUpdateOne) AddTimesUsed(i int) *ReceiveAddressUpdateOn
 	return rauo
 }
 
+// SetOwnerAddress sets the "owner_address" field.
+func (rauo *ReceiveAddressUpdateOne) SetOwnerAddress(s string) *ReceiveAddressUpdateOne {
+	rauo.mutation.SetOwnerAddress(s)
+	return rauo
+}
+
+// SetNillableOwnerAddress sets the "owner_address" field if the given value is not nil.
+func (rauo *ReceiveAddressUpdateOne) SetNillableOwnerAddress(s *string) *ReceiveAddressUpdateOne {
+	if s != nil {
+		rauo.SetOwnerAddress(*s)
+	}
+	return rauo
+}
+
+// ClearOwnerAddress clears the value of the "owner_address" field.
+func (rauo *ReceiveAddressUpdateOne) ClearOwnerAddress() *ReceiveAddressUpdateOne {
+	rauo.mutation.ClearOwnerAddress()
+	return rauo
+}
+
+// SetGenerationFingerprint sets the "generation_fingerprint" field.
+func (rauo *ReceiveAddressUpdateOne) SetGenerationFingerprint(s string) *ReceiveAddressUpdateOne {
+	rauo.mutation.SetGenerationFingerprint(s)
+	return rauo
+}
+
+// SetNillableGenerationFingerprint sets the "generation_fingerprint" field if the given value is not nil.
+func (rauo *ReceiveAddressUpdateOne) SetNillableGenerationFingerprint(s *string) *ReceiveAddressUpdateOne {
+	if s != nil {
+		rauo.SetGenerationFingerprint(*s)
+	}
+	return rauo
+}
+
+// ClearGenerationFingerprint clears the value of the "generation_fingerprint" field.
+func (rauo *ReceiveAddressUpdateOne) ClearGenerationFingerprint() *ReceiveAddressUpdateOne {
+	rauo.mutation.ClearGenerationFingerprint()
+	return rauo
+}
+
+// SetSaltDerivation sets the "salt_derivation" field.
+func (rauo *ReceiveAddressUpdateOne) SetSaltDerivation(rd receiveaddress.SaltDerivation) *ReceiveAddressUpdateOne {
+	rauo.mutation.SetSaltDerivation(rd)
+	return rauo
+}
+
+// SetNillableSaltDerivation sets the "salt_derivation" field if the given value is not nil.
+func (rauo *ReceiveAddressUpdateOne) SetNillableSaltDerivation(rd *receiveaddress.SaltDerivation) *ReceiveAddressUpdateOne {
+	if rd != nil {
+		rauo.SetSaltDerivation(*rd)
+	}
+	return rauo
+}
+
+// SetDerivationIndex sets the "derivation_index" field.
+func (rauo *ReceiveAddressUpdateOne) SetDerivationIndex(i int64) *ReceiveAddressUpdateOne {
+	rauo.mutation.ResetDerivationIndex()
+	rauo.mutation.SetDerivationIndex(i)
+	return rauo
+}
+
+// SetNillableDerivationIndex sets the "derivation_index" field if the given value is not nil.
+func (rauo *ReceiveAddressUpdateOne) SetNillableDerivationIndex(i *int64) *ReceiveAddressUpdateOne {
+	if i != nil {
+		rauo.SetDerivationIndex(*i)
+	}
+	return rauo
+}
+
+// AddDerivationIndex adds i to the "derivation_index" field.
+func (rauo *ReceiveAddressUpdateOne) AddDerivationIndex(i int64) *ReceiveAddressUpdateOne {
+	rauo.mutation.AddDerivationIndex(i)
+	return rauo
+}
+
+// ClearDerivationIndex clears the value of the "derivation_index" field.
+func (rauo *ReceiveAddressUpdateOne) ClearDerivationIndex() *ReceiveAddressUpdateOne {
+	rauo.mutation.ClearDerivationIndex()
+	return rauo
+}
+
 // SetLastIndexedBlock sets the "last_indexed_block" field.
 func (rauo *ReceiveAddressUpdateOne) SetLastIndexedBlock(i int64) *ReceiveAddressUpdateOne {
 	rauo.mutation.ResetLastIndexedBlock()
@@ -939,6 +1212,21 @@ func (rauo *ReceiveAddressUpdateOne) SetPaymentOrder(p *PaymentOrder) *ReceiveAd
 	return rauo.SetPaymentOrderID(p.ID)
 }
 
+// AddAssignmentIDs adds the "assignments" edge to the ReceiveAddressAssignment entity by IDs.
+func (rauo *ReceiveAddressUpdateOne) AddAssignmentIDs(ids ...uuid.UUID) *ReceiveAddressUpdateOne {
+	rauo.mutation.AddAssignmentIDs(ids...)
+	return rauo
+}
+
+// AddAssignments adds the "assignments" edges to the ReceiveAddressAssignment entity.
+func (rauo *ReceiveAddressUpdateOne) AddAssignments(r ...*ReceiveAddressAssignment) *ReceiveAddressUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rauo.AddAssignmentIDs(ids...)
+}
+
 // Mutation returns the ReceiveAddressMutation object of the builder.
 func (rauo *ReceiveAddressUpdateOne) Mutation() *ReceiveAddressMutation {
 	return rauo.mutation
@@ -950,6 +1238,27 @@ func (rauo *ReceiveAddressUpdateOne) ClearPaymentOrder() *ReceiveAddressUpdateOn
 	return rauo
 }
 
+// ClearAssignments clears all "assignments" edges to the ReceiveAddressAssignment entity.
+func (rauo *ReceiveAddressUpdateOne) ClearAssignments() *ReceiveAddressUpdateOne {
+	rauo.mutation.ClearAssignments()
+	return rauo
+}
+
+// RemoveAssignmentIDs removes the "assignments" edge to ReceiveAddressAssignment entities by IDs.
+func (rauo *ReceiveAddressUpdateOne) RemoveAssignmentIDs(ids ...uuid.UUID) *ReceiveAddressUpdateOne {
+	rauo.mutation.RemoveAssignmentIDs(ids...)
+	return rauo
+}
+
+// RemoveAssignments removes "assignments" edges to ReceiveAddressAssignment entities.
+func (rauo *ReceiveAddressUpdateOne) RemoveAssignments(r ...*ReceiveAddressAssignment) *ReceiveAddressUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rauo.RemoveAssignmentIDs(ids...)
+}
+
 // Where appends a list predicates to the ReceiveAddressUpdate builder.
 func (rauo *ReceiveAddressUpdateOne) Where(ps ...predicate.ReceiveAddress) *ReceiveAddressUpdateOne {
 	rauo.mutation.Where(ps...)
@@ -1011,6 +1320,11 @@ func (rauo *ReceiveAddressUpdateOne) check() error {
 			return &ValidationError{Name: "deployment_tx_hash", err: fmt.Errorf(`ent: validator failed for field "ReceiveAddress.deployment_tx_hash": %w`, err)}
 		}
 	}
+	if v, ok := rauo.mutation.SaltDerivation(); ok {
+		if err := receiveaddress.SaltDerivationValidator(v); err != nil {
+			return &ValidationError{Name: "salt_derivation", err: fmt.Errorf(`ent: validator failed for field "ReceiveAddress.salt_derivation": %w`, err)}
+		}
+	}
 	if v, ok := rauo.mutation.TxHash(); ok {
 		if err := receiveaddress.TxHashValidator(v); err != nil {
 			return &ValidationError{Name: "tx_hash", err: fmt.Errorf(`ent: validator failed for field "ReceiveAddress.tx_hash": %w`, err)}
@@ -1120,6 +1434,30 @@ func (rauo *ReceiveAddressUpdateOne) sqlSave(ctx context.Context) (_node *Receiv
 	if value, ok := rauo.mutation.AddedTimesUsed(); ok {
 		_spec.AddField(receiveaddress.FieldTimesUsed, field.TypeInt, value)
 	}
+	if value, ok := rauo.mutation.OwnerAddress(); ok {
+		_spec.SetField(receiveaddress.FieldOwnerAddress, field.TypeString, value)
+	}
+	if rauo.mutation.OwnerAddressCleared() {
+		_spec.ClearField(receiveaddress.FieldOwnerAddress, field.TypeString)
+	}
+	if value, ok := rauo.mutation.GenerationFingerprint(); ok {
+		_spec.SetField(receiveaddress.FieldGenerationFingerprint, field.TypeString, value)
+	}
+	if rauo.mutation.GenerationFingerprintCleared() {
+		_spec.ClearField(receiveaddress.FieldGenerationFingerprint, field.TypeString)
+	}
+	if value, ok := rauo.mutation.SaltDerivation(); ok {
+		_spec.SetField(receiveaddress.FieldSaltDerivation, field.TypeEnum, value)
+	}
+	if value, ok := rauo.mutation.DerivationIndex(); ok {
+		_spec.SetField(receiveaddress.FieldDerivationIndex, field.TypeInt64, value)
+	}
+	if value, ok := rauo.mutation.AddedDerivationIndex(); ok {
+		_spec.AddField(receiveaddress.FieldDerivationIndex, field.TypeInt64, value)
+	}
+	if rauo.mutation.DerivationIndexCleared() {
+		_spec.ClearField(receiveaddress.FieldDerivationIndex, field.TypeInt64)
+	}
 	if value, ok := rauo.mutation.LastIndexedBlock(); ok {
 		_spec.SetField(receiveaddress.FieldLastIndexedBlock, field.TypeInt64, value)
 	}
@@ -1176,6 +1514,51 @@ func (rauo *ReceiveAddressUpdateOne) sqlSave(ctx context.Context) (_node *Receiv
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if rauo.mutation.AssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   receiveaddress.AssignmentsTable,
+			Columns: []string{receiveaddress.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receiveaddressassignment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rauo.mutation.RemovedAssignmentsIDs(); len(nodes) > 0 && !rauo.mutation.AssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   receiveaddress.AssignmentsTable,
+			Columns: []string{receiveaddress.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receiveaddressassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rauo.mutation.AssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   receiveaddress.AssignmentsTable,
+			Columns: []string{receiveaddress.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receiveaddressassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &ReceiveAddress{config: rauo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,233 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddressassignment"
+	"github.com/google/uuid"
+)
+
+// ReceiveAddressAssignment is the model entity for the ReceiveAddressAssignment schema.
+type ReceiveAddressAssignment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Address holds the value of the "address" field.
+	Address string `json:"address,omitempty"`
+	// NetworkIdentifier holds the value of the "network_identifier" field.
+	NetworkIdentifier string `json:"network_identifier,omitempty"`
+	// AssignedAt holds the value of the "assigned_at" field.
+	AssignedAt time.Time `json:"assigned_at,omitempty"`
+	// ReleasedAt holds the value of the "released_at" field.
+	ReleasedAt *time.Time `json:"released_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReceiveAddressAssignmentQuery when eager-loading is set.
+	Edges                             ReceiveAddressAssignmentEdges `json:"edges"`
+	payment_order_address_assignments *uuid.UUID
+	receive_address_assignments       *int
+	selectValues                      sql.SelectValues
+}
+
+// ReceiveAddressAssignmentEdges holds the relations/edges for other nodes in the graph.
+type ReceiveAddressAssignmentEdges struct {
+	// ReceiveAddress holds the value of the receive_address edge.
+	ReceiveAddress *ReceiveAddress `json:"receive_address,omitempty"`
+	// PaymentOrder holds the value of the payment_order edge.
+	PaymentOrder *PaymentOrder `json:"payment_order,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ReceiveAddressOrErr returns the ReceiveAddress value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReceiveAddressAssignmentEdges) ReceiveAddressOrErr() (*ReceiveAddress, error) {
+	if e.ReceiveAddress != nil {
+		return e.ReceiveAddress, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: receiveaddress.Label}
+	}
+	return nil, &NotLoadedError{edge: "receive_address"}
+}
+
+// PaymentOrderOrErr returns the PaymentOrder value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReceiveAddressAssignmentEdges) PaymentOrderOrErr() (*PaymentOrder, error) {
+	if e.PaymentOrder != nil {
+		return e.PaymentOrder, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: paymentorder.Label}
+	}
+	return nil, &NotLoadedError{edge: "payment_order"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ReceiveAddressAssignment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case receiveaddressassignment.FieldAddress, receiveaddressassignment.FieldNetworkIdentifier:
+			values[i] = new(sql.NullString)
+		case receiveaddressassignment.FieldCreatedAt, receiveaddressassignment.FieldUpdatedAt, receiveaddressassignment.FieldAssignedAt, receiveaddressassignment.FieldReleasedAt:
+			values[i] = new(sql.NullTime)
+		case receiveaddressassignment.FieldID:
+			values[i] = new(uuid.UUID)
+		case receiveaddressassignment.ForeignKeys[0]: // payment_order_address_assignments
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case receiveaddressassignment.ForeignKeys[1]: // receive_address_assignments
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ReceiveAddressAssignment fields.
+func (raa *ReceiveAddressAssignment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case receiveaddressassignment.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				raa.ID = *value
+			}
+		case receiveaddressassignment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				raa.CreatedAt = value.Time
+			}
+		case receiveaddressassignment.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				raa.UpdatedAt = value.Time
+			}
+		case receiveaddressassignment.FieldAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address", values[i])
+			} else if value.Valid {
+				raa.Address = value.String
+			}
+		case receiveaddressassignment.FieldNetworkIdentifier:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field network_identifier", values[i])
+			} else if value.Valid {
+				raa.NetworkIdentifier = value.String
+			}
+		case receiveaddressassignment.FieldAssignedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field assigned_at", values[i])
+			} else if value.Valid {
+				raa.AssignedAt = value.Time
+			}
+		case receiveaddressassignment.FieldReleasedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field released_at", values[i])
+			} else if value.Valid {
+				raa.ReleasedAt = new(time.Time)
+				*raa.ReleasedAt = value.Time
+			}
+		case receiveaddressassignment.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_order_address_assignments", values[i])
+			} else if value.Valid {
+				raa.payment_order_address_assignments = new(uuid.UUID)
+				*raa.payment_order_address_assignments = *value.S.(*uuid.UUID)
+			}
+		case receiveaddressassignment.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field receive_address_assignments", value)
+			} else if value.Valid {
+				raa.receive_address_assignments = new(int)
+				*raa.receive_address_assignments = int(value.Int64)
+			}
+		default:
+			raa.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ReceiveAddressAssignment.
+// This includes values selected through modifiers, order, etc.
+func (raa *ReceiveAddressAssignment) Value(name string) (ent.Value, error) {
+	return raa.selectValues.Get(name)
+}
+
+// QueryReceiveAddress queries the "receive_address" edge of the ReceiveAddressAssignment entity.
+func (raa *ReceiveAddressAssignment) QueryReceiveAddress() *ReceiveAddressQuery {
+	return NewReceiveAddressAssignmentClient(raa.config).QueryReceiveAddress(raa)
+}
+
+// QueryPaymentOrder queries the "payment_order" edge of the ReceiveAddressAssignment entity.
+func (raa *ReceiveAddressAssignment) QueryPaymentOrder() *PaymentOrderQuery {
+	return NewReceiveAddressAssignmentClient(raa.config).QueryPaymentOrder(raa)
+}
+
+// Update returns a builder for updating this ReceiveAddressAssignment.
+// Note that you need to call ReceiveAddressAssignment.Unwrap() before calling this method if this ReceiveAddressAssignment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (raa *ReceiveAddressAssignment) Update() *ReceiveAddressAssignmentUpdateOne {
+	return NewReceiveAddressAssignmentClient(raa.config).UpdateOne(raa)
+}
+
+// Unwrap unwraps the ReceiveAddressAssignment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (raa *ReceiveAddressAssignment) Unwrap() *ReceiveAddressAssignment {
+	_tx, ok := raa.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ReceiveAddressAssignment is not a transactional entity")
+	}
+	raa.config.driver = _tx.drv
+	return raa
+}
+
+// String implements the fmt.Stringer.
+func (raa *ReceiveAddressAssignment) String() string {
+	var builder strings.Builder
+	builder.WriteString("ReceiveAddressAssignment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", raa.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(raa.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(raa.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("address=")
+	builder.WriteString(raa.Address)
+	builder.WriteString(", ")
+	builder.WriteString("network_identifier=")
+	builder.WriteString(raa.NetworkIdentifier)
+	builder.WriteString(", ")
+	builder.WriteString("assigned_at=")
+	builder.WriteString(raa.AssignedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := raa.ReleasedAt; v != nil {
+		builder.WriteString("released_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ReceiveAddressAssignments is a parsable slice of ReceiveAddressAssignment.
+type ReceiveAddressAssignments []*ReceiveAddressAssignment
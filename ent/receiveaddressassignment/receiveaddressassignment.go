@@ -0,0 +1,162 @@
+// Code generated by ent, DO NOT EDIT.
+
+package receiveaddressassignment
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the receiveaddressassignment type in the database.
+	Label = "receive_address_assignment"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldAddress holds the string denoting the address field in the database.
+	FieldAddress = "address"
+	// FieldNetworkIdentifier holds the string denoting the network_identifier field in the database.
+	FieldNetworkIdentifier = "network_identifier"
+	// FieldAssignedAt holds the string denoting the assigned_at field in the database.
+	FieldAssignedAt = "assigned_at"
+	// FieldReleasedAt holds the string denoting the released_at field in the database.
+	FieldReleasedAt = "released_at"
+	// EdgeReceiveAddress holds the string denoting the receive_address edge name in mutations.
+	EdgeReceiveAddress = "receive_address"
+	// EdgePaymentOrder holds the string denoting the payment_order edge name in mutations.
+	EdgePaymentOrder = "payment_order"
+	// Table holds the table name of the receiveaddressassignment in the database.
+	Table = "receive_address_assignments"
+	// ReceiveAddressTable is the table that holds the receive_address relation/edge.
+	ReceiveAddressTable = "receive_address_assignments"
+	// ReceiveAddressInverseTable is the table name for the ReceiveAddress entity.
+	// It exists in this package in order to avoid circular dependency with the "receiveaddress" package.
+	ReceiveAddressInverseTable = "receive_addresses"
+	// ReceiveAddressColumn is the table column denoting the receive_address relation/edge.
+	ReceiveAddressColumn = "receive_address_assignments"
+	// PaymentOrderTable is the table that holds the payment_order relation/edge.
+	PaymentOrderTable = "receive_address_assignments"
+	// PaymentOrderInverseTable is the table name for the PaymentOrder entity.
+	// It exists in this package in order to avoid circular dependency with the "paymentorder" package.
+	PaymentOrderInverseTable = "payment_orders"
+	// PaymentOrderColumn is the table column denoting the payment_order relation/edge.
+	PaymentOrderColumn = "payment_order_address_assignments"
+)
+
+// Columns holds all SQL columns for receiveaddressassignment fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldAddress,
+	FieldNetworkIdentifier,
+	FieldAssignedAt,
+	FieldReleasedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "receive_address_assignments"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"payment_order_address_assignments",
+	"receive_address_assignments",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultAssignedAt holds the default value on creation for the "assigned_at" field.
+	DefaultAssignedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the ReceiveAddressAssignment queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByAddress orders the results by the address field.
+func ByAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAddress, opts...).ToFunc()
+}
+
+// ByNetworkIdentifier orders the results by the network_identifier field.
+func ByNetworkIdentifier(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNetworkIdentifier, opts...).ToFunc()
+}
+
+// ByAssignedAt orders the results by the assigned_at field.
+func ByAssignedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAssignedAt, opts...).ToFunc()
+}
+
+// ByReleasedAt orders the results by the released_at field.
+func ByReleasedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReleasedAt, opts...).ToFunc()
+}
+
+// ByReceiveAddressField orders the results by receive_address field.
+func ByReceiveAddressField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReceiveAddressStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPaymentOrderField orders the results by payment_order field.
+func ByPaymentOrderField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPaymentOrderStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newReceiveAddressStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReceiveAddressInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ReceiveAddressTable, ReceiveAddressColumn),
+	)
+}
+func newPaymentOrderStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PaymentOrderInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PaymentOrderTable, PaymentOrderColumn),
+	)
+}
@@ -0,0 +1,458 @@
+// Code generated by ent, DO NOT EDIT.
+
+package receiveaddressassignment
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Address applies equality check predicate on the "address" field. It's identical to AddressEQ.
+func Address(v string) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldEQ(FieldAddress, v))
+}
+
+// NetworkIdentifier applies equality check predicate on the "network_identifier" field. It's identical to NetworkIdentifierEQ.
+func NetworkIdentifier(v string) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldEQ(FieldNetworkIdentifier, v))
+}
+
+// AssignedAt applies equality check predicate on the "assigned_at" field. It's identical to AssignedAtEQ.
+func AssignedAt(v time.Time) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldEQ(FieldAssignedAt, v))
+}
+
+// ReleasedAt applies equality check predicate on the "released_at" field. It's identical to ReleasedAtEQ.
+func ReleasedAt(v time.Time) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldEQ(FieldReleasedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// AddressEQ applies the EQ predicate on the "address" field.
+func AddressEQ(v string) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldEQ(FieldAddress, v))
+}
+
+// AddressNEQ applies the NEQ predicate on the "address" field.
+func AddressNEQ(v string) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldNEQ(FieldAddress, v))
+}
+
+// AddressIn applies the In predicate on the "address" field.
+func AddressIn(vs ...string) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldIn(FieldAddress, vs...))
+}
+
+// AddressNotIn applies the NotIn predicate on the "address" field.
+func AddressNotIn(vs ...string) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldNotIn(FieldAddress, vs...))
+}
+
+// AddressGT applies the GT predicate on the "address" field.
+func AddressGT(v string) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldGT(FieldAddress, v))
+}
+
+// AddressGTE applies the GTE predicate on the "address" field.
+func AddressGTE(v string) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldGTE(FieldAddress, v))
+}
+
+// AddressLT applies the LT predicate on the "address" field.
+func AddressLT(v string) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldLT(FieldAddress, v))
+}
+
+// AddressLTE applies the LTE predicate on the "address" field.
+func AddressLTE(v string) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldLTE(FieldAddress, v))
+}
+
+// AddressContains applies the Contains predicate on the "address" field.
+func AddressContains(v string) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldContains(FieldAddress, v))
+}
+
+// AddressHasPrefix applies the HasPrefix predicate on the "address" field.
+func AddressHasPrefix(v string) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldHasPrefix(FieldAddress, v))
+}
+
+// AddressHasSuffix applies the HasSuffix predicate on the "address" field.
+func AddressHasSuffix(v string) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldHasSuffix(FieldAddress, v))
+}
+
+// AddressEqualFold applies the EqualFold predicate on the "address" field.
+func AddressEqualFold(v string) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldEqualFold(FieldAddress, v))
+}
+
+// AddressContainsFold applies the ContainsFold predicate on the "address" field.
+func AddressContainsFold(v string) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldContainsFold(FieldAddress, v))
+}
+
+// NetworkIdentifierEQ applies the EQ predicate on the "network_identifier" field.
+func NetworkIdentifierEQ(v string) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldEQ(FieldNetworkIdentifier, v))
+}
+
+// NetworkIdentifierNEQ applies the NEQ predicate on the "network_identifier" field.
+func NetworkIdentifierNEQ(v string) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldNEQ(FieldNetworkIdentifier, v))
+}
+
+// NetworkIdentifierIn applies the In predicate on the "network_identifier" field.
+func NetworkIdentifierIn(vs ...string) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldIn(FieldNetworkIdentifier, vs...))
+}
+
+// NetworkIdentifierNotIn applies the NotIn predicate on the "network_identifier" field.
+func NetworkIdentifierNotIn(vs ...string) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldNotIn(FieldNetworkIdentifier, vs...))
+}
+
+// NetworkIdentifierGT applies the GT predicate on the "network_identifier" field.
+func NetworkIdentifierGT(v string) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldGT(FieldNetworkIdentifier, v))
+}
+
+// NetworkIdentifierGTE applies the GTE predicate on the "network_identifier" field.
+func NetworkIdentifierGTE(v string) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldGTE(FieldNetworkIdentifier, v))
+}
+
+// NetworkIdentifierLT applies the LT predicate on the "network_identifier" field.
+func NetworkIdentifierLT(v string) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldLT(FieldNetworkIdentifier, v))
+}
+
+// NetworkIdentifierLTE applies the LTE predicate on the "network_identifier" field.
+func NetworkIdentifierLTE(v string) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldLTE(FieldNetworkIdentifier, v))
+}
+
+// NetworkIdentifierContains applies the Contains predicate on the "network_identifier" field.
+func NetworkIdentifierContains(v string) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldContains(FieldNetworkIdentifier, v))
+}
+
+// NetworkIdentifierHasPrefix applies the HasPrefix predicate on the "network_identifier" field.
+func NetworkIdentifierHasPrefix(v string) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldHasPrefix(FieldNetworkIdentifier, v))
+}
+
+// NetworkIdentifierHasSuffix applies the HasSuffix predicate on the "network_identifier" field.
+func NetworkIdentifierHasSuffix(v string) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldHasSuffix(FieldNetworkIdentifier, v))
+}
+
+// NetworkIdentifierIsNil applies the IsNil predicate on the "network_identifier" field.
+func NetworkIdentifierIsNil() predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldIsNull(FieldNetworkIdentifier))
+}
+
+// NetworkIdentifierNotNil applies the NotNil predicate on the "network_identifier" field.
+func NetworkIdentifierNotNil() predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldNotNull(FieldNetworkIdentifier))
+}
+
+// NetworkIdentifierEqualFold applies the EqualFold predicate on the "network_identifier" field.
+func NetworkIdentifierEqualFold(v string) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldEqualFold(FieldNetworkIdentifier, v))
+}
+
+// NetworkIdentifierContainsFold applies the ContainsFold predicate on the "network_identifier" field.
+func NetworkIdentifierContainsFold(v string) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldContainsFold(FieldNetworkIdentifier, v))
+}
+
+// AssignedAtEQ applies the EQ predicate on the "assigned_at" field.
+func AssignedAtEQ(v time.Time) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldEQ(FieldAssignedAt, v))
+}
+
+// AssignedAtNEQ applies the NEQ predicate on the "assigned_at" field.
+func AssignedAtNEQ(v time.Time) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldNEQ(FieldAssignedAt, v))
+}
+
+// AssignedAtIn applies the In predicate on the "assigned_at" field.
+func AssignedAtIn(vs ...time.Time) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldIn(FieldAssignedAt, vs...))
+}
+
+// AssignedAtNotIn applies the NotIn predicate on the "assigned_at" field.
+func AssignedAtNotIn(vs ...time.Time) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldNotIn(FieldAssignedAt, vs...))
+}
+
+// AssignedAtGT applies the GT predicate on the "assigned_at" field.
+func AssignedAtGT(v time.Time) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldGT(FieldAssignedAt, v))
+}
+
+// AssignedAtGTE applies the GTE predicate on the "assigned_at" field.
+func AssignedAtGTE(v time.Time) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldGTE(FieldAssignedAt, v))
+}
+
+// AssignedAtLT applies the LT predicate on the "assigned_at" field.
+func AssignedAtLT(v time.Time) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldLT(FieldAssignedAt, v))
+}
+
+// AssignedAtLTE applies the LTE predicate on the "assigned_at" field.
+func AssignedAtLTE(v time.Time) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldLTE(FieldAssignedAt, v))
+}
+
+// ReleasedAtEQ applies the EQ predicate on the "released_at" field.
+func ReleasedAtEQ(v time.Time) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldEQ(FieldReleasedAt, v))
+}
+
+// ReleasedAtNEQ applies the NEQ predicate on the "released_at" field.
+func ReleasedAtNEQ(v time.Time) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldNEQ(FieldReleasedAt, v))
+}
+
+// ReleasedAtIn applies the In predicate on the "released_at" field.
+func ReleasedAtIn(vs ...time.Time) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldIn(FieldReleasedAt, vs...))
+}
+
+// ReleasedAtNotIn applies the NotIn predicate on the "released_at" field.
+func ReleasedAtNotIn(vs ...time.Time) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldNotIn(FieldReleasedAt, vs...))
+}
+
+// ReleasedAtGT applies the GT predicate on the "released_at" field.
+func ReleasedAtGT(v time.Time) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldGT(FieldReleasedAt, v))
+}
+
+// ReleasedAtGTE applies the GTE predicate on the "released_at" field.
+func ReleasedAtGTE(v time.Time) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldGTE(FieldReleasedAt, v))
+}
+
+// ReleasedAtLT applies the LT predicate on the "released_at" field.
+func ReleasedAtLT(v time.Time) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldLT(FieldReleasedAt, v))
+}
+
+// ReleasedAtLTE applies the LTE predicate on the "released_at" field.
+func ReleasedAtLTE(v time.Time) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldLTE(FieldReleasedAt, v))
+}
+
+// ReleasedAtIsNil applies the IsNil predicate on the "released_at" field.
+func ReleasedAtIsNil() predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldIsNull(FieldReleasedAt))
+}
+
+// ReleasedAtNotNil applies the NotNil predicate on the "released_at" field.
+func ReleasedAtNotNil() predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.FieldNotNull(FieldReleasedAt))
+}
+
+// HasReceiveAddress applies the HasEdge predicate on the "receive_address" edge.
+func HasReceiveAddress() predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ReceiveAddressTable, ReceiveAddressColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReceiveAddressWith applies the HasEdge predicate on the "receive_address" edge with a given conditions (other predicates).
+func HasReceiveAddressWith(preds ...predicate.ReceiveAddress) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(func(s *sql.Selector) {
+		step := newReceiveAddressStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPaymentOrder applies the HasEdge predicate on the "payment_order" edge.
+func HasPaymentOrder() predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PaymentOrderTable, PaymentOrderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPaymentOrderWith applies the HasEdge predicate on the "payment_order" edge with a given conditions (other predicates).
+func HasPaymentOrderWith(preds ...predicate.PaymentOrder) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(func(s *sql.Selector) {
+		step := newPaymentOrderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ReceiveAddressAssignment) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ReceiveAddressAssignment) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ReceiveAddressAssignment) predicate.ReceiveAddressAssignment {
+	return predicate.ReceiveAddressAssignment(sql.NotPredicates(p))
+}
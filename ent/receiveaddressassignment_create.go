@@ -0,0 +1,861 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddressassignment"
+	"github.com/google/uuid"
+)
+
+// ReceiveAddressAssignmentCreate is the builder for creating a ReceiveAddressAssignment entity.
+type ReceiveAddressAssignmentCreate struct {
+	config
+	mutation *ReceiveAddressAssignmentMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (raac *ReceiveAddressAssignmentCreate) SetCreatedAt(t time.Time) *ReceiveAddressAssignmentCreate {
+	raac.mutation.SetCreatedAt(t)
+	return raac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (raac *ReceiveAddressAssignmentCreate) SetNillableCreatedAt(t *time.Time) *ReceiveAddressAssignmentCreate {
+	if t != nil {
+		raac.SetCreatedAt(*t)
+	}
+	return raac
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (raac *ReceiveAddressAssignmentCreate) SetUpdatedAt(t time.Time) *ReceiveAddressAssignmentCreate {
+	raac.mutation.SetUpdatedAt(t)
+	return raac
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (raac *ReceiveAddressAssignmentCreate) SetNillableUpdatedAt(t *time.Time) *ReceiveAddressAssignmentCreate {
+	if t != nil {
+		raac.SetUpdatedAt(*t)
+	}
+	return raac
+}
+
+// SetAddress sets the "address" field.
+func (raac *ReceiveAddressAssignmentCreate) SetAddress(s string) *ReceiveAddressAssignmentCreate {
+	raac.mutation.SetAddress(s)
+	return raac
+}
+
+// SetNetworkIdentifier sets the "network_identifier" field.
+func (raac *ReceiveAddressAssignmentCreate) SetNetworkIdentifier(s string) *ReceiveAddressAssignmentCreate {
+	raac.mutation.SetNetworkIdentifier(s)
+	return raac
+}
+
+// SetNillableNetworkIdentifier sets the "network_identifier" field if the given value is not nil.
+func (raac *ReceiveAddressAssignmentCreate) SetNillableNetworkIdentifier(s *string) *ReceiveAddressAssignmentCreate {
+	if s != nil {
+		raac.SetNetworkIdentifier(*s)
+	}
+	return raac
+}
+
+// SetAssignedAt sets the "assigned_at" field.
+func (raac *ReceiveAddressAssignmentCreate) SetAssignedAt(t time.Time) *ReceiveAddressAssignmentCreate {
+	raac.mutation.SetAssignedAt(t)
+	return raac
+}
+
+// SetNillableAssignedAt sets the "assigned_at" field if the given value is not nil.
+func (raac *ReceiveAddressAssignmentCreate) SetNillableAssignedAt(t *time.Time) *ReceiveAddressAssignmentCreate {
+	if t != nil {
+		raac.SetAssignedAt(*t)
+	}
+	return raac
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (raac *ReceiveAddressAssignmentCreate) SetReleasedAt(t time.Time) *ReceiveAddressAssignmentCreate {
+	raac.mutation.SetReleasedAt(t)
+	return raac
+}
+
+// SetNillableReleasedAt sets the "released_at" field if the given value is not nil.
+func (raac *ReceiveAddressAssignmentCreate) SetNillableReleasedAt(t *time.Time) *ReceiveAddressAssignmentCreate {
+	if t != nil {
+		raac.SetReleasedAt(*t)
+	}
+	return raac
+}
+
+// SetID sets the "id" field.
+func (raac *ReceiveAddressAssignmentCreate) SetID(u uuid.UUID) *ReceiveAddressAssignmentCreate {
+	raac.mutation.SetID(u)
+	return raac
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (raac *ReceiveAddressAssignmentCreate) SetNillableID(u *uuid.UUID) *ReceiveAddressAssignmentCreate {
+	if u != nil {
+		raac.SetID(*u)
+	}
+	return raac
+}
+
+// SetReceiveAddressID sets the "receive_address" edge to the ReceiveAddress entity by ID.
+func (raac *ReceiveAddressAssignmentCreate) SetReceiveAddressID(id int) *ReceiveAddressAssignmentCreate {
+	raac.mutation.SetReceiveAddressID(id)
+	return raac
+}
+
+// SetNillableReceiveAddressID sets the "receive_address" edge to the ReceiveAddress entity by ID if the given value is not nil.
+func (raac *ReceiveAddressAssignmentCreate) SetNillableReceiveAddressID(id *int) *ReceiveAddressAssignmentCreate {
+	if id != nil {
+		raac = raac.SetReceiveAddressID(*id)
+	}
+	return raac
+}
+
+// SetReceiveAddress sets the "receive_address" edge to the ReceiveAddress entity.
+func (raac *ReceiveAddressAssignmentCreate) SetReceiveAddress(r *ReceiveAddress) *ReceiveAddressAssignmentCreate {
+	return raac.SetReceiveAddressID(r.ID)
+}
+
+// SetPaymentOrderID sets the "payment_order" edge to the PaymentOrder entity by ID.
+func (raac *ReceiveAddressAssignmentCreate) SetPaymentOrderID(id uuid.UUID) *ReceiveAddressAssignmentCreate {
+	raac.mutation.SetPaymentOrderID(id)
+	return raac
+}
+
+// SetPaymentOrder sets the "payment_order" edge to the PaymentOrder entity.
+func (raac *ReceiveAddressAssignmentCreate) SetPaymentOrder(p *PaymentOrder) *ReceiveAddressAssignmentCreate {
+	return raac.SetPaymentOrderID(p.ID)
+}
+
+// Mutation returns the ReceiveAddressAssignmentMutation object of the builder.
+func (raac *ReceiveAddressAssignmentCreate) Mutation() *ReceiveAddressAssignmentMutation {
+	return raac.mutation
+}
+
+// Save creates the ReceiveAddressAssignment in the database.
+func (raac *ReceiveAddressAssignmentCreate) Save(ctx context.Context) (*ReceiveAddressAssignment, error) {
+	raac.defaults()
+	return withHooks(ctx, raac.sqlSave, raac.mutation, raac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (raac *ReceiveAddressAssignmentCreate) SaveX(ctx context.Context) *ReceiveAddressAssignment {
+	v, err := raac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (raac *ReceiveAddressAssignmentCreate) Exec(ctx context.Context) error {
+	_, err := raac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (raac *ReceiveAddressAssignmentCreate) ExecX(ctx context.Context) {
+	if err := raac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (raac *ReceiveAddressAssignmentCreate) defaults() {
+	if _, ok := raac.mutation.CreatedAt(); !ok {
+		v := receiveaddressassignment.DefaultCreatedAt()
+		raac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := raac.mutation.UpdatedAt(); !ok {
+		v := receiveaddressassignment.DefaultUpdatedAt()
+		raac.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := raac.mutation.AssignedAt(); !ok {
+		v := receiveaddressassignment.DefaultAssignedAt()
+		raac.mutation.SetAssignedAt(v)
+	}
+	if _, ok := raac.mutation.ID(); !ok {
+		v := receiveaddressassignment.DefaultID()
+		raac.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (raac *ReceiveAddressAssignmentCreate) check() error {
+	if _, ok := raac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ReceiveAddressAssignment.created_at"`)}
+	}
+	if _, ok := raac.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ReceiveAddressAssignment.updated_at"`)}
+	}
+	if _, ok := raac.mutation.Address(); !ok {
+		return &ValidationError{Name: "address", err: errors.New(`ent: missing required field "ReceiveAddressAssignment.address"`)}
+	}
+	if _, ok := raac.mutation.AssignedAt(); !ok {
+		return &ValidationError{Name: "assigned_at", err: errors.New(`ent: missing required field "ReceiveAddressAssignment.assigned_at"`)}
+	}
+	if len(raac.mutation.PaymentOrderIDs()) == 0 {
+		return &ValidationError{Name: "payment_order", err: errors.New(`ent: missing required edge "ReceiveAddressAssignment.payment_order"`)}
+	}
+	return nil
+}
+
+func (raac *ReceiveAddressAssignmentCreate) sqlSave(ctx context.Context) (*ReceiveAddressAssignment, error) {
+	if err := raac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := raac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, raac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	raac.mutation.id = &_node.ID
+	raac.mutation.done = true
+	return _node, nil
+}
+
+func (raac *ReceiveAddressAssignmentCreate) createSpec() (*ReceiveAddressAssignment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ReceiveAddressAssignment{config: raac.config}
+		_spec = sqlgraph.NewCreateSpec(receiveaddressassignment.Table, sqlgraph.NewFieldSpec(receiveaddressassignment.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = raac.conflict
+	if id, ok := raac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := raac.mutation.CreatedAt(); ok {
+		_spec.SetField(receiveaddressassignment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := raac.mutation.UpdatedAt(); ok {
+		_spec.SetField(receiveaddressassignment.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := raac.mutation.Address(); ok {
+		_spec.SetField(receiveaddressassignment.FieldAddress, field.TypeString, value)
+		_node.Address = value
+	}
+	if value, ok := raac.mutation.NetworkIdentifier(); ok {
+		_spec.SetField(receiveaddressassignment.FieldNetworkIdentifier, field.TypeString, value)
+		_node.NetworkIdentifier = value
+	}
+	if value, ok := raac.mutation.AssignedAt(); ok {
+		_spec.SetField(receiveaddressassignment.FieldAssignedAt, field.TypeTime, value)
+		_node.AssignedAt = value
+	}
+	if value, ok := raac.mutation.ReleasedAt(); ok {
+		_spec.SetField(receiveaddressassignment.FieldReleasedAt, field.TypeTime, value)
+		_node.ReleasedAt = &value
+	}
+	if nodes := raac.mutation.ReceiveAddressIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   receiveaddressassignment.ReceiveAddressTable,
+			Columns: []string{receiveaddressassignment.ReceiveAddressColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receiveaddress.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.receive_address_assignments = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := raac.mutation.PaymentOrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   receiveaddressassignment.PaymentOrderTable,
+			Columns: []string{receiveaddressassignment.PaymentOrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.payment_order_address_assignments = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReceiveAddressAssignment.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReceiveAddressAssignmentUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (raac *ReceiveAddressAssignmentCreate) OnConflict(opts ...sql.ConflictOption) *ReceiveAddressAssignmentUpsertOne {
+	raac.conflict = opts
+	return &ReceiveAddressAssignmentUpsertOne{
+		create: raac,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReceiveAddressAssignment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (raac *ReceiveAddressAssignmentCreate) OnConflictColumns(columns ...string) *ReceiveAddressAssignmentUpsertOne {
+	raac.conflict = append(raac.conflict, sql.ConflictColumns(columns...))
+	return &ReceiveAddressAssignmentUpsertOne{
+		create: raac,
+	}
+}
+
+type (
+	// ReceiveAddressAssignmentUpsertOne is the builder for "upsert"-ing
+	//  one ReceiveAddressAssignment node.
+	ReceiveAddressAssignmentUpsertOne struct {
+		create *ReceiveAddressAssignmentCreate
+	}
+
+	// ReceiveAddressAssignmentUpsert is the "OnConflict" setter.
+	ReceiveAddressAssignmentUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReceiveAddressAssignmentUpsert) SetUpdatedAt(v time.Time) *ReceiveAddressAssignmentUpsert {
+	u.Set(receiveaddressassignment.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReceiveAddressAssignmentUpsert) UpdateUpdatedAt() *ReceiveAddressAssignmentUpsert {
+	u.SetExcluded(receiveaddressassignment.FieldUpdatedAt)
+	return u
+}
+
+// SetAddress sets the "address" field.
+func (u *ReceiveAddressAssignmentUpsert) SetAddress(v string) *ReceiveAddressAssignmentUpsert {
+	u.Set(receiveaddressassignment.FieldAddress, v)
+	return u
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *ReceiveAddressAssignmentUpsert) UpdateAddress() *ReceiveAddressAssignmentUpsert {
+	u.SetExcluded(receiveaddressassignment.FieldAddress)
+	return u
+}
+
+// SetNetworkIdentifier sets the "network_identifier" field.
+func (u *ReceiveAddressAssignmentUpsert) SetNetworkIdentifier(v string) *ReceiveAddressAssignmentUpsert {
+	u.Set(receiveaddressassignment.FieldNetworkIdentifier, v)
+	return u
+}
+
+// UpdateNetworkIdentifier sets the "network_identifier" field to the value that was provided on create.
+func (u *ReceiveAddressAssignmentUpsert) UpdateNetworkIdentifier() *ReceiveAddressAssignmentUpsert {
+	u.SetExcluded(receiveaddressassignment.FieldNetworkIdentifier)
+	return u
+}
+
+// ClearNetworkIdentifier clears the value of the "network_identifier" field.
+func (u *ReceiveAddressAssignmentUpsert) ClearNetworkIdentifier() *ReceiveAddressAssignmentUpsert {
+	u.SetNull(receiveaddressassignment.FieldNetworkIdentifier)
+	return u
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (u *ReceiveAddressAssignmentUpsert) SetReleasedAt(v time.Time) *ReceiveAddressAssignmentUpsert {
+	u.Set(receiveaddressassignment.FieldReleasedAt, v)
+	return u
+}
+
+// UpdateReleasedAt sets the "released_at" field to the value that was provided on create.
+func (u *ReceiveAddressAssignmentUpsert) UpdateReleasedAt() *ReceiveAddressAssignmentUpsert {
+	u.SetExcluded(receiveaddressassignment.FieldReleasedAt)
+	return u
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (u *ReceiveAddressAssignmentUpsert) ClearReleasedAt() *ReceiveAddressAssignmentUpsert {
+	u.SetNull(receiveaddressassignment.FieldReleasedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ReceiveAddressAssignment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(receiveaddressassignment.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReceiveAddressAssignmentUpsertOne) UpdateNewValues() *ReceiveAddressAssignmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(receiveaddressassignment.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(receiveaddressassignment.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.AssignedAt(); exists {
+			s.SetIgnore(receiveaddressassignment.FieldAssignedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReceiveAddressAssignment.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ReceiveAddressAssignmentUpsertOne) Ignore() *ReceiveAddressAssignmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReceiveAddressAssignmentUpsertOne) DoNothing() *ReceiveAddressAssignmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReceiveAddressAssignmentCreate.OnConflict
+// documentation for more info.
+func (u *ReceiveAddressAssignmentUpsertOne) Update(set func(*ReceiveAddressAssignmentUpsert)) *ReceiveAddressAssignmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReceiveAddressAssignmentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReceiveAddressAssignmentUpsertOne) SetUpdatedAt(v time.Time) *ReceiveAddressAssignmentUpsertOne {
+	return u.Update(func(s *ReceiveAddressAssignmentUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReceiveAddressAssignmentUpsertOne) UpdateUpdatedAt() *ReceiveAddressAssignmentUpsertOne {
+	return u.Update(func(s *ReceiveAddressAssignmentUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAddress sets the "address" field.
+func (u *ReceiveAddressAssignmentUpsertOne) SetAddress(v string) *ReceiveAddressAssignmentUpsertOne {
+	return u.Update(func(s *ReceiveAddressAssignmentUpsert) {
+		s.SetAddress(v)
+	})
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *ReceiveAddressAssignmentUpsertOne) UpdateAddress() *ReceiveAddressAssignmentUpsertOne {
+	return u.Update(func(s *ReceiveAddressAssignmentUpsert) {
+		s.UpdateAddress()
+	})
+}
+
+// SetNetworkIdentifier sets the "network_identifier" field.
+func (u *ReceiveAddressAssignmentUpsertOne) SetNetworkIdentifier(v string) *ReceiveAddressAssignmentUpsertOne {
+	return u.Update(func(s *ReceiveAddressAssignmentUpsert) {
+		s.SetNetworkIdentifier(v)
+	})
+}
+
+// UpdateNetworkIdentifier sets the "network_identifier" field to the value that was provided on create.
+func (u *ReceiveAddressAssignmentUpsertOne) UpdateNetworkIdentifier() *ReceiveAddressAssignmentUpsertOne {
+	return u.Update(func(s *ReceiveAddressAssignmentUpsert) {
+		s.UpdateNetworkIdentifier()
+	})
+}
+
+// ClearNetworkIdentifier clears the value of the "network_identifier" field.
+func (u *ReceiveAddressAssignmentUpsertOne) ClearNetworkIdentifier() *ReceiveAddressAssignmentUpsertOne {
+	return u.Update(func(s *ReceiveAddressAssignmentUpsert) {
+		s.ClearNetworkIdentifier()
+	})
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (u *ReceiveAddressAssignmentUpsertOne) SetReleasedAt(v time.Time) *ReceiveAddressAssignmentUpsertOne {
+	return u.Update(func(s *ReceiveAddressAssignmentUpsert) {
+		s.SetReleasedAt(v)
+	})
+}
+
+// UpdateReleasedAt sets the "released_at" field to the value that was provided on create.
+func (u *ReceiveAddressAssignmentUpsertOne) UpdateReleasedAt() *ReceiveAddressAssignmentUpsertOne {
+	return u.Update(func(s *ReceiveAddressAssignmentUpsert) {
+		s.UpdateReleasedAt()
+	})
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (u *ReceiveAddressAssignmentUpsertOne) ClearReleasedAt() *ReceiveAddressAssignmentUpsertOne {
+	return u.Update(func(s *ReceiveAddressAssignmentUpsert) {
+		s.ClearReleasedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ReceiveAddressAssignmentUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReceiveAddressAssignmentCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReceiveAddressAssignmentUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ReceiveAddressAssignmentUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ReceiveAddressAssignmentUpsertOne.ID is not supported by MySQL driver. Use ReceiveAddressAssignmentUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ReceiveAddressAssignmentUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ReceiveAddressAssignmentCreateBulk is the builder for creating many ReceiveAddressAssignment entities in bulk.
+type ReceiveAddressAssignmentCreateBulk struct {
+	config
+	err      error
+	builders []*ReceiveAddressAssignmentCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ReceiveAddressAssignment entities in the database.
+func (raacb *ReceiveAddressAssignmentCreateBulk) Save(ctx context.Context) ([]*ReceiveAddressAssignment, error) {
+	if raacb.err != nil {
+		return nil, raacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(raacb.builders))
+	nodes := make([]*ReceiveAddressAssignment, len(raacb.builders))
+	mutators := make([]Mutator, len(raacb.builders))
+	for i := range raacb.builders {
+		func(i int, root context.Context) {
+			builder := raacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReceiveAddressAssignmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, raacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = raacb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, raacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, raacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (raacb *ReceiveAddressAssignmentCreateBulk) SaveX(ctx context.Context) []*ReceiveAddressAssignment {
+	v, err := raacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (raacb *ReceiveAddressAssignmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := raacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (raacb *ReceiveAddressAssignmentCreateBulk) ExecX(ctx context.Context) {
+	if err := raacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReceiveAddressAssignment.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReceiveAddressAssignmentUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (raacb *ReceiveAddressAssignmentCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReceiveAddressAssignmentUpsertBulk {
+	raacb.conflict = opts
+	return &ReceiveAddressAssignmentUpsertBulk{
+		create: raacb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReceiveAddressAssignment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (raacb *ReceiveAddressAssignmentCreateBulk) OnConflictColumns(columns ...string) *ReceiveAddressAssignmentUpsertBulk {
+	raacb.conflict = append(raacb.conflict, sql.ConflictColumns(columns...))
+	return &ReceiveAddressAssignmentUpsertBulk{
+		create: raacb,
+	}
+}
+
+// ReceiveAddressAssignmentUpsertBulk is the builder for "upsert"-ing
+// a bulk of ReceiveAddressAssignment nodes.
+type ReceiveAddressAssignmentUpsertBulk struct {
+	create *ReceiveAddressAssignmentCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ReceiveAddressAssignment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(receiveaddressassignment.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReceiveAddressAssignmentUpsertBulk) UpdateNewValues() *ReceiveAddressAssignmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(receiveaddressassignment.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(receiveaddressassignment.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.AssignedAt(); exists {
+				s.SetIgnore(receiveaddressassignment.FieldAssignedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReceiveAddressAssignment.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ReceiveAddressAssignmentUpsertBulk) Ignore() *ReceiveAddressAssignmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReceiveAddressAssignmentUpsertBulk) DoNothing() *ReceiveAddressAssignmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReceiveAddressAssignmentCreateBulk.OnConflict
+// documentation for more info.
+func (u *ReceiveAddressAssignmentUpsertBulk) Update(set func(*ReceiveAddressAssignmentUpsert)) *ReceiveAddressAssignmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReceiveAddressAssignmentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReceiveAddressAssignmentUpsertBulk) SetUpdatedAt(v time.Time) *ReceiveAddressAssignmentUpsertBulk {
+	return u.Update(func(s *ReceiveAddressAssignmentUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReceiveAddressAssignmentUpsertBulk) UpdateUpdatedAt() *ReceiveAddressAssignmentUpsertBulk {
+	return u.Update(func(s *ReceiveAddressAssignmentUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAddress sets the "address" field.
+func (u *ReceiveAddressAssignmentUpsertBulk) SetAddress(v string) *ReceiveAddressAssignmentUpsertBulk {
+	return u.Update(func(s *ReceiveAddressAssignmentUpsert) {
+		s.SetAddress(v)
+	})
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *ReceiveAddressAssignmentUpsertBulk) UpdateAddress() *ReceiveAddressAssignmentUpsertBulk {
+	return u.Update(func(s *ReceiveAddressAssignmentUpsert) {
+		s.UpdateAddress()
+	})
+}
+
+// SetNetworkIdentifier sets the "network_identifier" field.
+func (u *ReceiveAddressAssignmentUpsertBulk) SetNetworkIdentifier(v string) *ReceiveAddressAssignmentUpsertBulk {
+	return u.Update(func(s *ReceiveAddressAssignmentUpsert) {
+		s.SetNetworkIdentifier(v)
+	})
+}
+
+// UpdateNetworkIdentifier sets the "network_identifier" field to the value that was provided on create.
+func (u *ReceiveAddressAssignmentUpsertBulk) UpdateNetworkIdentifier() *ReceiveAddressAssignmentUpsertBulk {
+	return u.Update(func(s *ReceiveAddressAssignmentUpsert) {
+		s.UpdateNetworkIdentifier()
+	})
+}
+
+// ClearNetworkIdentifier clears the value of the "network_identifier" field.
+func (u *ReceiveAddressAssignmentUpsertBulk) ClearNetworkIdentifier() *ReceiveAddressAssignmentUpsertBulk {
+	return u.Update(func(s *ReceiveAddressAssignmentUpsert) {
+		s.ClearNetworkIdentifier()
+	})
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (u *ReceiveAddressAssignmentUpsertBulk) SetReleasedAt(v time.Time) *ReceiveAddressAssignmentUpsertBulk {
+	return u.Update(func(s *ReceiveAddressAssignmentUpsert) {
+		s.SetReleasedAt(v)
+	})
+}
+
+// UpdateReleasedAt sets the "released_at" field to the value that was provided on create.
+func (u *ReceiveAddressAssignmentUpsertBulk) UpdateReleasedAt() *ReceiveAddressAssignmentUpsertBulk {
+	return u.Update(func(s *ReceiveAddressAssignmentUpsert) {
+		s.UpdateReleasedAt()
+	})
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (u *ReceiveAddressAssignmentUpsertBulk) ClearReleasedAt() *ReceiveAddressAssignmentUpsertBulk {
+	return u.Update(func(s *ReceiveAddressAssignmentUpsert) {
+		s.ClearReleasedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ReceiveAddressAssignmentUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ReceiveAddressAssignmentCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReceiveAddressAssignmentCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReceiveAddressAssignmentUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddressassignment"
+)
+
+// ReceiveAddressAssignmentDelete is the builder for deleting a ReceiveAddressAssignment entity.
+type ReceiveAddressAssignmentDelete struct {
+	config
+	hooks    []Hook
+	mutation *ReceiveAddressAssignmentMutation
+}
+
+// Where appends a list predicates to the ReceiveAddressAssignmentDelete builder.
+func (raad *ReceiveAddressAssignmentDelete) Where(ps ...predicate.ReceiveAddressAssignment) *ReceiveAddressAssignmentDelete {
+	raad.mutation.Where(ps...)
+	return raad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (raad *ReceiveAddressAssignmentDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, raad.sqlExec, raad.mutation, raad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (raad *ReceiveAddressAssignmentDelete) ExecX(ctx context.Context) int {
+	n, err := raad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (raad *ReceiveAddressAssignmentDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(receiveaddressassignment.Table, sqlgraph.NewFieldSpec(receiveaddressassignment.FieldID, field.TypeUUID))
+	if ps := raad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, raad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	raad.mutation.done = true
+	return affected, err
+}
+
+// ReceiveAddressAssignmentDeleteOne is the builder for deleting a single ReceiveAddressAssignment entity.
+type ReceiveAddressAssignmentDeleteOne struct {
+	raad *ReceiveAddressAssignmentDelete
+}
+
+// Where appends a list predicates to the ReceiveAddressAssignmentDelete builder.
+func (raado *ReceiveAddressAssignmentDeleteOne) Where(ps ...predicate.ReceiveAddressAssignment) *ReceiveAddressAssignmentDeleteOne {
+	raado.raad.mutation.Where(ps...)
+	return raado
+}
+
+// Exec executes the deletion query.
+func (raado *ReceiveAddressAssignmentDeleteOne) Exec(ctx context.Context) error {
+	n, err := raado.raad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{receiveaddressassignment.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (raado *ReceiveAddressAssignmentDeleteOne) ExecX(ctx context.Context) {
+	if err := raado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,690 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddressassignment"
+	"github.com/google/uuid"
+)
+
+// ReceiveAddressAssignmentQuery is the builder for querying ReceiveAddressAssignment entities.
+type ReceiveAddressAssignmentQuery struct {
+	config
+	ctx                *QueryContext
+	order              []receiveaddressassignment.OrderOption
+	inters             []Interceptor
+	predicates         []predicate.ReceiveAddressAssignment
+	withReceiveAddress *ReceiveAddressQuery
+	withPaymentOrder   *PaymentOrderQuery
+	withFKs            bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ReceiveAddressAssignmentQuery builder.
+func (raaq *ReceiveAddressAssignmentQuery) Where(ps ...predicate.ReceiveAddressAssignment) *ReceiveAddressAssignmentQuery {
+	raaq.predicates = append(raaq.predicates, ps...)
+	return raaq
+}
+
+// Limit the number of records to be returned by this query.
+func (raaq *ReceiveAddressAssignmentQuery) Limit(limit int) *ReceiveAddressAssignmentQuery {
+	raaq.ctx.Limit = &limit
+	return raaq
+}
+
+// Offset to start from.
+func (raaq *ReceiveAddressAssignmentQuery) Offset(offset int) *ReceiveAddressAssignmentQuery {
+	raaq.ctx.Offset = &offset
+	return raaq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (raaq *ReceiveAddressAssignmentQuery) Unique(unique bool) *ReceiveAddressAssignmentQuery {
+	raaq.ctx.Unique = &unique
+	return raaq
+}
+
+// Order specifies how the records should be ordered.
+func (raaq *ReceiveAddressAssignmentQuery) Order(o ...receiveaddressassignment.OrderOption) *ReceiveAddressAssignmentQuery {
+	raaq.order = append(raaq.order, o...)
+	return raaq
+}
+
+// QueryReceiveAddress chains the current query on the "receive_address" edge.
+func (raaq *ReceiveAddressAssignmentQuery) QueryReceiveAddress() *ReceiveAddressQuery {
+	query := (&ReceiveAddressClient{config: raaq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := raaq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := raaq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(receiveaddressassignment.Table, receiveaddressassignment.FieldID, selector),
+			sqlgraph.To(receiveaddress.Table, receiveaddress.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, receiveaddressassignment.ReceiveAddressTable, receiveaddressassignment.ReceiveAddressColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(raaq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPaymentOrder chains the current query on the "payment_order" edge.
+func (raaq *ReceiveAddressAssignmentQuery) QueryPaymentOrder() *PaymentOrderQuery {
+	query := (&PaymentOrderClient{config: raaq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := raaq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := raaq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(receiveaddressassignment.Table, receiveaddressassignment.FieldID, selector),
+			sqlgraph.To(paymentorder.Table, paymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, receiveaddressassignment.PaymentOrderTable, receiveaddressassignment.PaymentOrderColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(raaq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ReceiveAddressAssignment entity from the query.
+// Returns a *NotFoundError when no ReceiveAddressAssignment was found.
+func (raaq *ReceiveAddressAssignmentQuery) First(ctx context.Context) (*ReceiveAddressAssignment, error) {
+	nodes, err := raaq.Limit(1).All(setContextOp(ctx, raaq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{receiveaddressassignment.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (raaq *ReceiveAddressAssignmentQuery) FirstX(ctx context.Context) *ReceiveAddressAssignment {
+	node, err := raaq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ReceiveAddressAssignment ID from the query.
+// Returns a *NotFoundError when no ReceiveAddressAssignment ID was found.
+func (raaq *ReceiveAddressAssignmentQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = raaq.Limit(1).IDs(setContextOp(ctx, raaq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{receiveaddressassignment.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (raaq *ReceiveAddressAssignmentQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := raaq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ReceiveAddressAssignment entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ReceiveAddressAssignment entity is found.
+// Returns a *NotFoundError when no ReceiveAddressAssignment entities are found.
+func (raaq *ReceiveAddressAssignmentQuery) Only(ctx context.Context) (*ReceiveAddressAssignment, error) {
+	nodes, err := raaq.Limit(2).All(setContextOp(ctx, raaq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{receiveaddressassignment.Label}
+	default:
+		return nil, &NotSingularError{receiveaddressassignment.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (raaq *ReceiveAddressAssignmentQuery) OnlyX(ctx context.Context) *ReceiveAddressAssignment {
+	node, err := raaq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ReceiveAddressAssignment ID in the query.
+// Returns a *NotSingularError when more than one ReceiveAddressAssignment ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (raaq *ReceiveAddressAssignmentQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = raaq.Limit(2).IDs(setContextOp(ctx, raaq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{receiveaddressassignment.Label}
+	default:
+		err = &NotSingularError{receiveaddressassignment.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (raaq *ReceiveAddressAssignmentQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := raaq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ReceiveAddressAssignments.
+func (raaq *ReceiveAddressAssignmentQuery) All(ctx context.Context) ([]*ReceiveAddressAssignment, error) {
+	ctx = setContextOp(ctx, raaq.ctx, ent.OpQueryAll)
+	if err := raaq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ReceiveAddressAssignment, *ReceiveAddressAssignmentQuery]()
+	return withInterceptors[[]*ReceiveAddressAssignment](ctx, raaq, qr, raaq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (raaq *ReceiveAddressAssignmentQuery) AllX(ctx context.Context) []*ReceiveAddressAssignment {
+	nodes, err := raaq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ReceiveAddressAssignment IDs.
+func (raaq *ReceiveAddressAssignmentQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if raaq.ctx.Unique == nil && raaq.path != nil {
+		raaq.Unique(true)
+	}
+	ctx = setContextOp(ctx, raaq.ctx, ent.OpQueryIDs)
+	if err = raaq.Select(receiveaddressassignment.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (raaq *ReceiveAddressAssignmentQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := raaq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (raaq *ReceiveAddressAssignmentQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, raaq.ctx, ent.OpQueryCount)
+	if err := raaq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, raaq, querierCount[*ReceiveAddressAssignmentQuery](), raaq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (raaq *ReceiveAddressAssignmentQuery) CountX(ctx context.Context) int {
+	count, err := raaq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (raaq *ReceiveAddressAssignmentQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, raaq.ctx, ent.OpQueryExist)
+	switch _, err := raaq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (raaq *ReceiveAddressAssignmentQuery) ExistX(ctx context.Context) bool {
+	exist, err := raaq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ReceiveAddressAssignmentQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (raaq *ReceiveAddressAssignmentQuery) Clone() *ReceiveAddressAssignmentQuery {
+	if raaq == nil {
+		return nil
+	}
+	return &ReceiveAddressAssignmentQuery{
+		config:             raaq.config,
+		ctx:                raaq.ctx.Clone(),
+		order:              append([]receiveaddressassignment.OrderOption{}, raaq.order...),
+		inters:             append([]Interceptor{}, raaq.inters...),
+		predicates:         append([]predicate.ReceiveAddressAssignment{}, raaq.predicates...),
+		withReceiveAddress: raaq.withReceiveAddress.Clone(),
+		withPaymentOrder:   raaq.withPaymentOrder.Clone(),
+		// clone intermediate query.
+		sql:  raaq.sql.Clone(),
+		path: raaq.path,
+	}
+}
+
+// WithReceiveAddress tells the query-builder to eager-load the nodes that are connected to
+// the "receive_address" edge. The optional arguments are used to configure the query builder of the edge.
+func (raaq *ReceiveAddressAssignmentQuery) WithReceiveAddress(opts ...func(*ReceiveAddressQuery)) *ReceiveAddressAssignmentQuery {
+	query := (&ReceiveAddressClient{config: raaq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	raaq.withReceiveAddress = query
+	return raaq
+}
+
+// WithPaymentOrder tells the query-builder to eager-load the nodes that are connected to
+// the "payment_order" edge. The optional arguments are used to configure the query builder of the edge.
+func (raaq *ReceiveAddressAssignmentQuery) WithPaymentOrder(opts ...func(*PaymentOrderQuery)) *ReceiveAddressAssignmentQuery {
+	query := (&PaymentOrderClient{config: raaq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	raaq.withPaymentOrder = query
+	return raaq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ReceiveAddressAssignment.Query().
+//		GroupBy(receiveaddressassignment.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (raaq *ReceiveAddressAssignmentQuery) GroupBy(field string, fields ...string) *ReceiveAddressAssignmentGroupBy {
+	raaq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ReceiveAddressAssignmentGroupBy{build: raaq}
+	grbuild.flds = &raaq.ctx.Fields
+	grbuild.label = receiveaddressassignment.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.ReceiveAddressAssignment.Query().
+//		Select(receiveaddressassignment.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (raaq *ReceiveAddressAssignmentQuery) Select(fields ...string) *ReceiveAddressAssignmentSelect {
+	raaq.ctx.Fields = append(raaq.ctx.Fields, fields...)
+	sbuild := &ReceiveAddressAssignmentSelect{ReceiveAddressAssignmentQuery: raaq}
+	sbuild.label = receiveaddressassignment.Label
+	sbuild.flds, sbuild.scan = &raaq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ReceiveAddressAssignmentSelect configured with the given aggregations.
+func (raaq *ReceiveAddressAssignmentQuery) Aggregate(fns ...AggregateFunc) *ReceiveAddressAssignmentSelect {
+	return raaq.Select().Aggregate(fns...)
+}
+
+func (raaq *ReceiveAddressAssignmentQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range raaq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, raaq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range raaq.ctx.Fields {
+		if !receiveaddressassignment.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if raaq.path != nil {
+		prev, err := raaq.path(ctx)
+		if err != nil {
+			return err
+		}
+		raaq.sql = prev
+	}
+	return nil
+}
+
+func (raaq *ReceiveAddressAssignmentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ReceiveAddressAssignment, error) {
+	var (
+		nodes       = []*ReceiveAddressAssignment{}
+		withFKs     = raaq.withFKs
+		_spec       = raaq.querySpec()
+		loadedTypes = [2]bool{
+			raaq.withReceiveAddress != nil,
+			raaq.withPaymentOrder != nil,
+		}
+	)
+	if raaq.withReceiveAddress != nil || raaq.withPaymentOrder != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, receiveaddressassignment.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ReceiveAddressAssignment).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ReceiveAddressAssignment{config: raaq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, raaq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := raaq.withReceiveAddress; query != nil {
+		if err := raaq.loadReceiveAddress(ctx, query, nodes, nil,
+			func(n *ReceiveAddressAssignment, e *ReceiveAddress) { n.Edges.ReceiveAddress = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := raaq.withPaymentOrder; query != nil {
+		if err := raaq.loadPaymentOrder(ctx, query, nodes, nil,
+			func(n *ReceiveAddressAssignment, e *PaymentOrder) { n.Edges.PaymentOrder = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (raaq *ReceiveAddressAssignmentQuery) loadReceiveAddress(ctx context.Context, query *ReceiveAddressQuery, nodes []*ReceiveAddressAssignment, init func(*ReceiveAddressAssignment), assign func(*ReceiveAddressAssignment, *ReceiveAddress)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ReceiveAddressAssignment)
+	for i := range nodes {
+		if nodes[i].receive_address_assignments == nil {
+			continue
+		}
+		fk := *nodes[i].receive_address_assignments
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(receiveaddress.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "receive_address_assignments" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (raaq *ReceiveAddressAssignmentQuery) loadPaymentOrder(ctx context.Context, query *PaymentOrderQuery, nodes []*ReceiveAddressAssignment, init func(*ReceiveAddressAssignment), assign func(*ReceiveAddressAssignment, *PaymentOrder)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ReceiveAddressAssignment)
+	for i := range nodes {
+		if nodes[i].payment_order_address_assignments == nil {
+			continue
+		}
+		fk := *nodes[i].payment_order_address_assignments
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(paymentorder.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "payment_order_address_assignments" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (raaq *ReceiveAddressAssignmentQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := raaq.querySpec()
+	_spec.Node.Columns = raaq.ctx.Fields
+	if len(raaq.ctx.Fields) > 0 {
+		_spec.Unique = raaq.ctx.Unique != nil && *raaq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, raaq.driver, _spec)
+}
+
+func (raaq *ReceiveAddressAssignmentQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(receiveaddressassignment.Table, receiveaddressassignment.Columns, sqlgraph.NewFieldSpec(receiveaddressassignment.FieldID, field.TypeUUID))
+	_spec.From = raaq.sql
+	if unique := raaq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if raaq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := raaq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, receiveaddressassignment.FieldID)
+		for i := range fields {
+			if fields[i] != receiveaddressassignment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := raaq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := raaq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := raaq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := raaq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (raaq *ReceiveAddressAssignmentQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(raaq.driver.Dialect())
+	t1 := builder.Table(receiveaddressassignment.Table)
+	columns := raaq.ctx.Fields
+	if len(columns) == 0 {
+		columns = receiveaddressassignment.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if raaq.sql != nil {
+		selector = raaq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if raaq.ctx.Unique != nil && *raaq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range raaq.predicates {
+		p(selector)
+	}
+	for _, p := range raaq.order {
+		p(selector)
+	}
+	if offset := raaq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := raaq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ReceiveAddressAssignmentGroupBy is the group-by builder for ReceiveAddressAssignment entities.
+type ReceiveAddressAssignmentGroupBy struct {
+	selector
+	build *ReceiveAddressAssignmentQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (raagb *ReceiveAddressAssignmentGroupBy) Aggregate(fns ...AggregateFunc) *ReceiveAddressAssignmentGroupBy {
+	raagb.fns = append(raagb.fns, fns...)
+	return raagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (raagb *ReceiveAddressAssignmentGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, raagb.build.ctx, ent.OpQueryGroupBy)
+	if err := raagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ReceiveAddressAssignmentQuery, *ReceiveAddressAssignmentGroupBy](ctx, raagb.build, raagb, raagb.build.inters, v)
+}
+
+func (raagb *ReceiveAddressAssignmentGroupBy) sqlScan(ctx context.Context, root *ReceiveAddressAssignmentQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(raagb.fns))
+	for _, fn := range raagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*raagb.flds)+len(raagb.fns))
+		for _, f := range *raagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*raagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := raagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ReceiveAddressAssignmentSelect is the builder for selecting fields of ReceiveAddressAssignment entities.
+type ReceiveAddressAssignmentSelect struct {
+	*ReceiveAddressAssignmentQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (raas *ReceiveAddressAssignmentSelect) Aggregate(fns ...AggregateFunc) *ReceiveAddressAssignmentSelect {
+	raas.fns = append(raas.fns, fns...)
+	return raas
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (raas *ReceiveAddressAssignmentSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, raas.ctx, ent.OpQuerySelect)
+	if err := raas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ReceiveAddressAssignmentQuery, *ReceiveAddressAssignmentSelect](ctx, raas.ReceiveAddressAssignmentQuery, raas, raas.inters, v)
+}
+
+func (raas *ReceiveAddressAssignmentSelect) sqlScan(ctx context.Context, root *ReceiveAddressAssignmentQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(raas.fns))
+	for _, fn := range raas.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*raas.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := raas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,575 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddressassignment"
+	"github.com/google/uuid"
+)
+
+// ReceiveAddressAssignmentUpdate is the builder for updating ReceiveAddressAssignment entities.
+type ReceiveAddressAssignmentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReceiveAddressAssignmentMutation
+}
+
+// Where appends a list predicates to the ReceiveAddressAssignmentUpdate builder.
+func (raau *ReceiveAddressAssignmentUpdate) Where(ps ...predicate.ReceiveAddressAssignment) *ReceiveAddressAssignmentUpdate {
+	raau.mutation.Where(ps...)
+	return raau
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (raau *ReceiveAddressAssignmentUpdate) SetUpdatedAt(t time.Time) *ReceiveAddressAssignmentUpdate {
+	raau.mutation.SetUpdatedAt(t)
+	return raau
+}
+
+// SetAddress sets the "address" field.
+func (raau *ReceiveAddressAssignmentUpdate) SetAddress(s string) *ReceiveAddressAssignmentUpdate {
+	raau.mutation.SetAddress(s)
+	return raau
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (raau *ReceiveAddressAssignmentUpdate) SetNillableAddress(s *string) *ReceiveAddressAssignmentUpdate {
+	if s != nil {
+		raau.SetAddress(*s)
+	}
+	return raau
+}
+
+// SetNetworkIdentifier sets the "network_identifier" field.
+func (raau *ReceiveAddressAssignmentUpdate) SetNetworkIdentifier(s string) *ReceiveAddressAssignmentUpdate {
+	raau.mutation.SetNetworkIdentifier(s)
+	return raau
+}
+
+// SetNillableNetworkIdentifier sets the "network_identifier" field if the given value is not nil.
+func (raau *ReceiveAddressAssignmentUpdate) SetNillableNetworkIdentifier(s *string) *ReceiveAddressAssignmentUpdate {
+	if s != nil {
+		raau.SetNetworkIdentifier(*s)
+	}
+	return raau
+}
+
+// ClearNetworkIdentifier clears the value of the "network_identifier" field.
+func (raau *ReceiveAddressAssignmentUpdate) ClearNetworkIdentifier() *ReceiveAddressAssignmentUpdate {
+	raau.mutation.ClearNetworkIdentifier()
+	return raau
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (raau *ReceiveAddressAssignmentUpdate) SetReleasedAt(t time.Time) *ReceiveAddressAssignmentUpdate {
+	raau.mutation.SetReleasedAt(t)
+	return raau
+}
+
+// SetNillableReleasedAt sets the "released_at" field if the given value is not nil.
+func (raau *ReceiveAddressAssignmentUpdate) SetNillableReleasedAt(t *time.Time) *ReceiveAddressAssignmentUpdate {
+	if t != nil {
+		raau.SetReleasedAt(*t)
+	}
+	return raau
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (raau *ReceiveAddressAssignmentUpdate) ClearReleasedAt() *ReceiveAddressAssignmentUpdate {
+	raau.mutation.ClearReleasedAt()
+	return raau
+}
+
+// SetReceiveAddressID sets the "receive_address" edge to the ReceiveAddress entity by ID.
+func (raau *ReceiveAddressAssignmentUpdate) SetReceiveAddressID(id int) *ReceiveAddressAssignmentUpdate {
+	raau.mutation.SetReceiveAddressID(id)
+	return raau
+}
+
+// SetNillableReceiveAddressID sets the "receive_address" edge to the ReceiveAddress entity by ID if the given value is not nil.
+func (raau *ReceiveAddressAssignmentUpdate) SetNillableReceiveAddressID(id *int) *ReceiveAddressAssignmentUpdate {
+	if id != nil {
+		raau = raau.SetReceiveAddressID(*id)
+	}
+	return raau
+}
+
+// SetReceiveAddress sets the "receive_address" edge to the ReceiveAddress entity.
+func (raau *ReceiveAddressAssignmentUpdate) SetReceiveAddress(r *ReceiveAddress) *ReceiveAddressAssignmentUpdate {
+	return raau.SetReceiveAddressID(r.ID)
+}
+
+// SetPaymentOrderID sets the "payment_order" edge to the PaymentOrder entity by ID.
+func (raau *ReceiveAddressAssignmentUpdate) SetPaymentOrderID(id uuid.UUID) *ReceiveAddressAssignmentUpdate {
+	raau.mutation.SetPaymentOrderID(id)
+	return raau
+}
+
+// SetPaymentOrder sets the "payment_order" edge to the PaymentOrder entity.
+func (raau *ReceiveAddressAssignmentUpdate) SetPaymentOrder(p *PaymentOrder) *ReceiveAddressAssignmentUpdate {
+	return raau.SetPaymentOrderID(p.ID)
+}
+
+// Mutation returns the ReceiveAddressAssignmentMutation object of the builder.
+func (raau *ReceiveAddressAssignmentUpdate) Mutation() *ReceiveAddressAssignmentMutation {
+	return raau.mutation
+}
+
+// ClearReceiveAddress clears the "receive_address" edge to the ReceiveAddress entity.
+func (raau *ReceiveAddressAssignmentUpdate) ClearReceiveAddress() *ReceiveAddressAssignmentUpdate {
+	raau.mutation.ClearReceiveAddress()
+	return raau
+}
+
+// ClearPaymentOrder clears the "payment_order" edge to the PaymentOrder entity.
+func (raau *ReceiveAddressAssignmentUpdate) ClearPaymentOrder() *ReceiveAddressAssignmentUpdate {
+	raau.mutation.ClearPaymentOrder()
+	return raau
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (raau *ReceiveAddressAssignmentUpdate) Save(ctx context.Context) (int, error) {
+	raau.defaults()
+	return withHooks(ctx, raau.sqlSave, raau.mutation, raau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (raau *ReceiveAddressAssignmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := raau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (raau *ReceiveAddressAssignmentUpdate) Exec(ctx context.Context) error {
+	_, err := raau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (raau *ReceiveAddressAssignmentUpdate) ExecX(ctx context.Context) {
+	if err := raau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (raau *ReceiveAddressAssignmentUpdate) defaults() {
+	if _, ok := raau.mutation.UpdatedAt(); !ok {
+		v := receiveaddressassignment.UpdateDefaultUpdatedAt()
+		raau.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (raau *ReceiveAddressAssignmentUpdate) check() error {
+	if raau.mutation.PaymentOrderCleared() && len(raau.mutation.PaymentOrderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReceiveAddressAssignment.payment_order"`)
+	}
+	return nil
+}
+
+func (raau *ReceiveAddressAssignmentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := raau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(receiveaddressassignment.Table, receiveaddressassignment.Columns, sqlgraph.NewFieldSpec(receiveaddressassignment.FieldID, field.TypeUUID))
+	if ps := raau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := raau.mutation.UpdatedAt(); ok {
+		_spec.SetField(receiveaddressassignment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := raau.mutation.Address(); ok {
+		_spec.SetField(receiveaddressassignment.FieldAddress, field.TypeString, value)
+	}
+	if value, ok := raau.mutation.NetworkIdentifier(); ok {
+		_spec.SetField(receiveaddressassignment.FieldNetworkIdentifier, field.TypeString, value)
+	}
+	if raau.mutation.NetworkIdentifierCleared() {
+		_spec.ClearField(receiveaddressassignment.FieldNetworkIdentifier, field.TypeString)
+	}
+	if value, ok := raau.mutation.ReleasedAt(); ok {
+		_spec.SetField(receiveaddressassignment.FieldReleasedAt, field.TypeTime, value)
+	}
+	if raau.mutation.ReleasedAtCleared() {
+		_spec.ClearField(receiveaddressassignment.FieldReleasedAt, field.TypeTime)
+	}
+	if raau.mutation.ReceiveAddressCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   receiveaddressassignment.ReceiveAddressTable,
+			Columns: []string{receiveaddressassignment.ReceiveAddressColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receiveaddress.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := raau.mutation.ReceiveAddressIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   receiveaddressassignment.ReceiveAddressTable,
+			Columns: []string{receiveaddressassignment.ReceiveAddressColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receiveaddress.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if raau.mutation.PaymentOrderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   receiveaddressassignment.PaymentOrderTable,
+			Columns: []string{receiveaddressassignment.PaymentOrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := raau.mutation.PaymentOrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   receiveaddressassignment.PaymentOrderTable,
+			Columns: []string{receiveaddressassignment.PaymentOrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, raau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{receiveaddressassignment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	raau.mutation.done = true
+	return n, nil
+}
+
+// ReceiveAddressAssignmentUpdateOne is the builder for updating a single ReceiveAddressAssignment entity.
+type ReceiveAddressAssignmentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReceiveAddressAssignmentMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (raauo *ReceiveAddressAssignmentUpdateOne) SetUpdatedAt(t time.Time) *ReceiveAddressAssignmentUpdateOne {
+	raauo.mutation.SetUpdatedAt(t)
+	return raauo
+}
+
+// SetAddress sets the "address" field.
+func (raauo *ReceiveAddressAssignmentUpdateOne) SetAddress(s string) *ReceiveAddressAssignmentUpdateOne {
+	raauo.mutation.SetAddress(s)
+	return raauo
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (raauo *ReceiveAddressAssignmentUpdateOne) SetNillableAddress(s *string) *ReceiveAddressAssignmentUpdateOne {
+	if s != nil {
+		raauo.SetAddress(*s)
+	}
+	return raauo
+}
+
+// SetNetworkIdentifier sets the "network_identifier" field.
+func (raauo *ReceiveAddressAssignmentUpdateOne) SetNetworkIdentifier(s string) *ReceiveAddressAssignmentUpdateOne {
+	raauo.mutation.SetNetworkIdentifier(s)
+	return raauo
+}
+
+// SetNillableNetworkIdentifier sets the "network_identifier" field if the given value is not nil.
+func (raauo *ReceiveAddressAssignmentUpdateOne) SetNillableNetworkIdentifier(s *string) *ReceiveAddressAssignmentUpdateOne {
+	if s != nil {
+		raauo.SetNetworkIdentifier(*s)
+	}
+	return raauo
+}
+
+// ClearNetworkIdentifier clears the value of the "network_identifier" field.
+func (raauo *ReceiveAddressAssignmentUpdateOne) ClearNetworkIdentifier() *ReceiveAddressAssignmentUpdateOne {
+	raauo.mutation.ClearNetworkIdentifier()
+	return raauo
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (raauo *ReceiveAddressAssignmentUpdateOne) SetReleasedAt(t time.Time) *ReceiveAddressAssignmentUpdateOne {
+	raauo.mutation.SetReleasedAt(t)
+	return raauo
+}
+
+// SetNillableReleasedAt sets the "released_at" field if the given value is not nil.
+func (raauo *ReceiveAddressAssignmentUpdateOne) SetNillableReleasedAt(t *time.Time) *ReceiveAddressAssignmentUpdateOne {
+	if t != nil {
+		raauo.SetReleasedAt(*t)
+	}
+	return raauo
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (raauo *ReceiveAddressAssignmentUpdateOne) ClearReleasedAt() *ReceiveAddressAssignmentUpdateOne {
+	raauo.mutation.ClearReleasedAt()
+	return raauo
+}
+
+// SetReceiveAddressID sets the "receive_address" edge to the ReceiveAddress entity by ID.
+func (raauo *ReceiveAddressAssignmentUpdateOne) SetReceiveAddressID(id int) *ReceiveAddressAssignmentUpdateOne {
+	raauo.mutation.SetReceiveAddressID(id)
+	return raauo
+}
+
+// SetNillableReceiveAddressID sets the "receive_address" edge to the ReceiveAddress entity by ID if the given value is not nil.
+func (raauo *ReceiveAddressAssignmentUpdateOne) SetNillableReceiveAddressID(id *int) *ReceiveAddressAssignmentUpdateOne {
+	if id != nil {
+		raauo = raauo.SetReceiveAddressID(*id)
+	}
+	return raauo
+}
+
+// SetReceiveAddress sets the "receive_address" edge to the ReceiveAddress entity.
+func (raauo *ReceiveAddressAssignmentUpdateOne) SetReceiveAddress(r *ReceiveAddress) *ReceiveAddressAssignmentUpdateOne {
+	return raauo.SetReceiveAddressID(r.ID)
+}
+
+// SetPaymentOrderID sets the "payment_order" edge to the PaymentOrder entity by ID.
+func (raauo *ReceiveAddressAssignmentUpdateOne) SetPaymentOrderID(id uuid.UUID) *ReceiveAddressAssignmentUpdateOne {
+	raauo.mutation.SetPaymentOrderID(id)
+	return raauo
+}
+
+// SetPaymentOrder sets the "payment_order" edge to the PaymentOrder entity.
+func (raauo *ReceiveAddressAssignmentUpdateOne) SetPaymentOrder(p *PaymentOrder) *ReceiveAddressAssignmentUpdateOne {
+	return raauo.SetPaymentOrderID(p.ID)
+}
+
+// Mutation returns the ReceiveAddressAssignmentMutation object of the builder.
+func (raauo *ReceiveAddressAssignmentUpdateOne) Mutation() *ReceiveAddressAssignmentMutation {
+	return raauo.mutation
+}
+
+// ClearReceiveAddress clears the "receive_address" edge to the ReceiveAddress entity.
+func (raauo *ReceiveAddressAssignmentUpdateOne) ClearReceiveAddress() *ReceiveAddressAssignmentUpdateOne {
+	raauo.mutation.ClearReceiveAddress()
+	return raauo
+}
+
+// ClearPaymentOrder clears the "payment_order" edge to the PaymentOrder entity.
+func (raauo *ReceiveAddressAssignmentUpdateOne) ClearPaymentOrder() *ReceiveAddressAssignmentUpdateOne {
+	raauo.mutation.ClearPaymentOrder()
+	return raauo
+}
+
+// Where appends a list predicates to the ReceiveAddressAssignmentUpdate builder.
+func (raauo *ReceiveAddressAssignmentUpdateOne) Where(ps ...predicate.ReceiveAddressAssignment) *ReceiveAddressAssignmentUpdateOne {
+	raauo.mutation.Where(ps...)
+	return raauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (raauo *ReceiveAddressAssignmentUpdateOne) Select(field string, fields ...string) *ReceiveAddressAssignmentUpdateOne {
+	raauo.fields = append([]string{field}, fields...)
+	return raauo
+}
+
+// Save executes the query and returns the updated ReceiveAddressAssignment entity.
+func (raauo *ReceiveAddressAssignmentUpdateOne) Save(ctx context.Context) (*ReceiveAddressAssignment, error) {
+	raauo.defaults()
+	return withHooks(ctx, raauo.sqlSave, raauo.mutation, raauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (raauo *ReceiveAddressAssignmentUpdateOne) SaveX(ctx context.Context) *ReceiveAddressAssignment {
+	node, err := raauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (raauo *ReceiveAddressAssignmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := raauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (raauo *ReceiveAddressAssignmentUpdateOne) ExecX(ctx context.Context) {
+	if err := raauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (raauo *ReceiveAddressAssignmentUpdateOne) defaults() {
+	if _, ok := raauo.mutation.UpdatedAt(); !ok {
+		v := receiveaddressassignment.UpdateDefaultUpdatedAt()
+		raauo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (raauo *ReceiveAddressAssignmentUpdateOne) check() error {
+	if raauo.mutation.PaymentOrderCleared() && len(raauo.mutation.PaymentOrderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReceiveAddressAssignment.payment_order"`)
+	}
+	return nil
+}
+
+func (raauo *ReceiveAddressAssignmentUpdateOne) sqlSave(ctx context.Context) (_node *ReceiveAddressAssignment, err error) {
+	if err := raauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(receiveaddressassignment.Table, receiveaddressassignment.Columns, sqlgraph.NewFieldSpec(receiveaddressassignment.FieldID, field.TypeUUID))
+	id, ok := raauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ReceiveAddressAssignment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := raauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, receiveaddressassignment.FieldID)
+		for _, f := range fields {
+			if !receiveaddressassignment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != receiveaddressassignment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := raauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := raauo.mutation.UpdatedAt(); ok {
+		_spec.SetField(receiveaddressassignment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := raauo.mutation.Address(); ok {
+		_spec.SetField(receiveaddressassignment.FieldAddress, field.TypeString, value)
+	}
+	if value, ok := raauo.mutation.NetworkIdentifier(); ok {
+		_spec.SetField(receiveaddressassignment.FieldNetworkIdentifier, field.TypeString, value)
+	}
+	if raauo.mutation.NetworkIdentifierCleared() {
+		_spec.ClearField(receiveaddressassignment.FieldNetworkIdentifier, field.TypeString)
+	}
+	if value, ok := raauo.mutation.ReleasedAt(); ok {
+		_spec.SetField(receiveaddressassignment.FieldReleasedAt, field.TypeTime, value)
+	}
+	if raauo.mutation.ReleasedAtCleared() {
+		_spec.ClearField(receiveaddressassignment.FieldReleasedAt, field.TypeTime)
+	}
+	if raauo.mutation.ReceiveAddressCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   receiveaddressassignment.ReceiveAddressTable,
+			Columns: []string{receiveaddressassignment.ReceiveAddressColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receiveaddress.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := raauo.mutation.ReceiveAddressIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   receiveaddressassignment.ReceiveAddressTable,
+			Columns: []string{receiveaddressassignment.ReceiveAddressColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receiveaddress.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if raauo.mutation.PaymentOrderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   receiveaddressassignment.PaymentOrderTable,
+			Columns: []string{receiveaddressassignment.PaymentOrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := raauo.mutation.PaymentOrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   receiveaddressassignment.PaymentOrderTable,
+			Columns: []string{receiveaddressassignment.PaymentOrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ReceiveAddressAssignment{config: raauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, raauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{receiveaddressassignment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	raauo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,254 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/reconciliationdiscrepancy"
+	"github.com/NEDA-LABS/stablenode/ent/reconciliationreport"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ReconciliationDiscrepancy is the model entity for the ReconciliationDiscrepancy schema.
+type ReconciliationDiscrepancy struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Type holds the value of the "type" field.
+	Type reconciliationdiscrepancy.Type `json:"type,omitempty"`
+	// TxHash holds the value of the "tx_hash" field.
+	TxHash string `json:"tx_hash,omitempty"`
+	// Address holds the value of the "address" field.
+	Address string `json:"address,omitempty"`
+	// Token holds the value of the "token" field.
+	Token string `json:"token,omitempty"`
+	// FromAddress holds the value of the "from_address" field.
+	FromAddress string `json:"from_address,omitempty"`
+	// BlockNumber holds the value of the "block_number" field.
+	BlockNumber int64 `json:"block_number,omitempty"`
+	// OnchainAmount holds the value of the "onchain_amount" field.
+	OnchainAmount decimal.Decimal `json:"onchain_amount,omitempty"`
+	// RecordedAmount holds the value of the "recorded_amount" field.
+	RecordedAmount decimal.Decimal `json:"recorded_amount,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReconciliationDiscrepancyQuery when eager-loading is set.
+	Edges                                      ReconciliationDiscrepancyEdges `json:"edges"`
+	payment_order_reconciliation_discrepancies *uuid.UUID
+	reconciliation_report_discrepancies        *uuid.UUID
+	selectValues                               sql.SelectValues
+}
+
+// ReconciliationDiscrepancyEdges holds the relations/edges for other nodes in the graph.
+type ReconciliationDiscrepancyEdges struct {
+	// Report holds the value of the report edge.
+	Report *ReconciliationReport `json:"report,omitempty"`
+	// PaymentOrder holds the value of the payment_order edge.
+	PaymentOrder *PaymentOrder `json:"payment_order,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ReportOrErr returns the Report value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReconciliationDiscrepancyEdges) ReportOrErr() (*ReconciliationReport, error) {
+	if e.Report != nil {
+		return e.Report, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: reconciliationreport.Label}
+	}
+	return nil, &NotLoadedError{edge: "report"}
+}
+
+// PaymentOrderOrErr returns the PaymentOrder value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReconciliationDiscrepancyEdges) PaymentOrderOrErr() (*PaymentOrder, error) {
+	if e.PaymentOrder != nil {
+		return e.PaymentOrder, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: paymentorder.Label}
+	}
+	return nil, &NotLoadedError{edge: "payment_order"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ReconciliationDiscrepancy) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case reconciliationdiscrepancy.FieldOnchainAmount, reconciliationdiscrepancy.FieldRecordedAmount:
+			values[i] = new(decimal.Decimal)
+		case reconciliationdiscrepancy.FieldBlockNumber:
+			values[i] = new(sql.NullInt64)
+		case reconciliationdiscrepancy.FieldType, reconciliationdiscrepancy.FieldTxHash, reconciliationdiscrepancy.FieldAddress, reconciliationdiscrepancy.FieldToken, reconciliationdiscrepancy.FieldFromAddress:
+			values[i] = new(sql.NullString)
+		case reconciliationdiscrepancy.FieldID:
+			values[i] = new(uuid.UUID)
+		case reconciliationdiscrepancy.ForeignKeys[0]: // payment_order_reconciliation_discrepancies
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case reconciliationdiscrepancy.ForeignKeys[1]: // reconciliation_report_discrepancies
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ReconciliationDiscrepancy fields.
+func (rd *ReconciliationDiscrepancy) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case reconciliationdiscrepancy.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				rd.ID = *value
+			}
+		case reconciliationdiscrepancy.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				rd.Type = reconciliationdiscrepancy.Type(value.String)
+			}
+		case reconciliationdiscrepancy.FieldTxHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tx_hash", values[i])
+			} else if value.Valid {
+				rd.TxHash = value.String
+			}
+		case reconciliationdiscrepancy.FieldAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address", values[i])
+			} else if value.Valid {
+				rd.Address = value.String
+			}
+		case reconciliationdiscrepancy.FieldToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token", values[i])
+			} else if value.Valid {
+				rd.Token = value.String
+			}
+		case reconciliationdiscrepancy.FieldFromAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_address", values[i])
+			} else if value.Valid {
+				rd.FromAddress = value.String
+			}
+		case reconciliationdiscrepancy.FieldBlockNumber:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field block_number", values[i])
+			} else if value.Valid {
+				rd.BlockNumber = value.Int64
+			}
+		case reconciliationdiscrepancy.FieldOnchainAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field onchain_amount", values[i])
+			} else if value != nil {
+				rd.OnchainAmount = *value
+			}
+		case reconciliationdiscrepancy.FieldRecordedAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field recorded_amount", values[i])
+			} else if value != nil {
+				rd.RecordedAmount = *value
+			}
+		case reconciliationdiscrepancy.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_order_reconciliation_discrepancies", values[i])
+			} else if value.Valid {
+				rd.payment_order_reconciliation_discrepancies = new(uuid.UUID)
+				*rd.payment_order_reconciliation_discrepancies = *value.S.(*uuid.UUID)
+			}
+		case reconciliationdiscrepancy.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field reconciliation_report_discrepancies", values[i])
+			} else if value.Valid {
+				rd.reconciliation_report_discrepancies = new(uuid.UUID)
+				*rd.reconciliation_report_discrepancies = *value.S.(*uuid.UUID)
+			}
+		default:
+			rd.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ReconciliationDiscrepancy.
+// This includes values selected through modifiers, order, etc.
+func (rd *ReconciliationDiscrepancy) Value(name string) (ent.Value, error) {
+	return rd.selectValues.Get(name)
+}
+
+// QueryReport queries the "report" edge of the ReconciliationDiscrepancy entity.
+func (rd *ReconciliationDiscrepancy) QueryReport() *ReconciliationReportQuery {
+	return NewReconciliationDiscrepancyClient(rd.config).QueryReport(rd)
+}
+
+// QueryPaymentOrder queries the "payment_order" edge of the ReconciliationDiscrepancy entity.
+func (rd *ReconciliationDiscrepancy) QueryPaymentOrder() *PaymentOrderQuery {
+	return NewReconciliationDiscrepancyClient(rd.config).QueryPaymentOrder(rd)
+}
+
+// Update returns a builder for updating this ReconciliationDiscrepancy.
+// Note that you need to call ReconciliationDiscrepancy.Unwrap() before calling this method if this ReconciliationDiscrepancy
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rd *ReconciliationDiscrepancy) Update() *ReconciliationDiscrepancyUpdateOne {
+	return NewReconciliationDiscrepancyClient(rd.config).UpdateOne(rd)
+}
+
+// Unwrap unwraps the ReconciliationDiscrepancy entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rd *ReconciliationDiscrepancy) Unwrap() *ReconciliationDiscrepancy {
+	_tx, ok := rd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ReconciliationDiscrepancy is not a transactional entity")
+	}
+	rd.config.driver = _tx.drv
+	return rd
+}
+
+// String implements the fmt.Stringer.
+func (rd *ReconciliationDiscrepancy) String() string {
+	var builder strings.Builder
+	builder.WriteString("ReconciliationDiscrepancy(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rd.ID))
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", rd.Type))
+	builder.WriteString(", ")
+	builder.WriteString("tx_hash=")
+	builder.WriteString(rd.TxHash)
+	builder.WriteString(", ")
+	builder.WriteString("address=")
+	builder.WriteString(rd.Address)
+	builder.WriteString(", ")
+	builder.WriteString("token=")
+	builder.WriteString(rd.Token)
+	builder.WriteString(", ")
+	builder.WriteString("from_address=")
+	builder.WriteString(rd.FromAddress)
+	builder.WriteString(", ")
+	builder.WriteString("block_number=")
+	builder.WriteString(fmt.Sprintf("%v", rd.BlockNumber))
+	builder.WriteString(", ")
+	builder.WriteString("onchain_amount=")
+	builder.WriteString(fmt.Sprintf("%v", rd.OnchainAmount))
+	builder.WriteString(", ")
+	builder.WriteString("recorded_amount=")
+	builder.WriteString(fmt.Sprintf("%v", rd.RecordedAmount))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ReconciliationDiscrepancies is a parsable slice of ReconciliationDiscrepancy.
+type ReconciliationDiscrepancies []*ReconciliationDiscrepancy
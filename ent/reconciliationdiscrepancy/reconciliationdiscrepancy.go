@@ -0,0 +1,199 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reconciliationdiscrepancy
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the reconciliationdiscrepancy type in the database.
+	Label = "reconciliation_discrepancy"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldTxHash holds the string denoting the tx_hash field in the database.
+	FieldTxHash = "tx_hash"
+	// FieldAddress holds the string denoting the address field in the database.
+	FieldAddress = "address"
+	// FieldToken holds the string denoting the token field in the database.
+	FieldToken = "token"
+	// FieldFromAddress holds the string denoting the from_address field in the database.
+	FieldFromAddress = "from_address"
+	// FieldBlockNumber holds the string denoting the block_number field in the database.
+	FieldBlockNumber = "block_number"
+	// FieldOnchainAmount holds the string denoting the onchain_amount field in the database.
+	FieldOnchainAmount = "onchain_amount"
+	// FieldRecordedAmount holds the string denoting the recorded_amount field in the database.
+	FieldRecordedAmount = "recorded_amount"
+	// EdgeReport holds the string denoting the report edge name in mutations.
+	EdgeReport = "report"
+	// EdgePaymentOrder holds the string denoting the payment_order edge name in mutations.
+	EdgePaymentOrder = "payment_order"
+	// Table holds the table name of the reconciliationdiscrepancy in the database.
+	Table = "reconciliation_discrepancies"
+	// ReportTable is the table that holds the report relation/edge.
+	ReportTable = "reconciliation_discrepancies"
+	// ReportInverseTable is the table name for the ReconciliationReport entity.
+	// It exists in this package in order to avoid circular dependency with the "reconciliationreport" package.
+	ReportInverseTable = "reconciliation_reports"
+	// ReportColumn is the table column denoting the report relation/edge.
+	ReportColumn = "reconciliation_report_discrepancies"
+	// PaymentOrderTable is the table that holds the payment_order relation/edge.
+	PaymentOrderTable = "reconciliation_discrepancies"
+	// PaymentOrderInverseTable is the table name for the PaymentOrder entity.
+	// It exists in this package in order to avoid circular dependency with the "paymentorder" package.
+	PaymentOrderInverseTable = "payment_orders"
+	// PaymentOrderColumn is the table column denoting the payment_order relation/edge.
+	PaymentOrderColumn = "payment_order_reconciliation_discrepancies"
+)
+
+// Columns holds all SQL columns for reconciliationdiscrepancy fields.
+var Columns = []string{
+	FieldID,
+	FieldType,
+	FieldTxHash,
+	FieldAddress,
+	FieldToken,
+	FieldFromAddress,
+	FieldBlockNumber,
+	FieldOnchainAmount,
+	FieldRecordedAmount,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "reconciliation_discrepancies"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"payment_order_reconciliation_discrepancies",
+	"reconciliation_report_discrepancies",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TxHashValidator is a validator for the "tx_hash" field. It is called by the builders before save.
+	TxHashValidator func(string) error
+	// DefaultBlockNumber holds the default value on creation for the "block_number" field.
+	DefaultBlockNumber int64
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Type defines the type for the "type" enum field.
+type Type string
+
+// Type values.
+const (
+	TypeMissingCredit   Type = "missing_credit"
+	TypeUnknownDeposit  Type = "unknown_deposit"
+	TypeAmountMismatch  Type = "amount_mismatch"
+	TypeUnmatchedCredit Type = "unmatched_credit"
+)
+
+func (_type Type) String() string {
+	return string(_type)
+}
+
+// TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
+func TypeValidator(_type Type) error {
+	switch _type {
+	case TypeMissingCredit, TypeUnknownDeposit, TypeAmountMismatch, TypeUnmatchedCredit:
+		return nil
+	default:
+		return fmt.Errorf("reconciliationdiscrepancy: invalid enum value for type field: %q", _type)
+	}
+}
+
+// OrderOption defines the ordering options for the ReconciliationDiscrepancy queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByTxHash orders the results by the tx_hash field.
+func ByTxHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTxHash, opts...).ToFunc()
+}
+
+// ByAddress orders the results by the address field.
+func ByAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAddress, opts...).ToFunc()
+}
+
+// ByToken orders the results by the token field.
+func ByToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToken, opts...).ToFunc()
+}
+
+// ByFromAddress orders the results by the from_address field.
+func ByFromAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFromAddress, opts...).ToFunc()
+}
+
+// ByBlockNumber orders the results by the block_number field.
+func ByBlockNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBlockNumber, opts...).ToFunc()
+}
+
+// ByOnchainAmount orders the results by the onchain_amount field.
+func ByOnchainAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOnchainAmount, opts...).ToFunc()
+}
+
+// ByRecordedAmount orders the results by the recorded_amount field.
+func ByRecordedAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecordedAmount, opts...).ToFunc()
+}
+
+// ByReportField orders the results by report field.
+func ByReportField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReportStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPaymentOrderField orders the results by payment_order field.
+func ByPaymentOrderField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPaymentOrderStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newReportStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReportInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ReportTable, ReportColumn),
+	)
+}
+func newPaymentOrderStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PaymentOrderInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PaymentOrderTable, PaymentOrderColumn),
+	)
+}
@@ -0,0 +1,562 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reconciliationdiscrepancy
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldLTE(FieldID, id))
+}
+
+// TxHash applies equality check predicate on the "tx_hash" field. It's identical to TxHashEQ.
+func TxHash(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldEQ(FieldTxHash, v))
+}
+
+// Address applies equality check predicate on the "address" field. It's identical to AddressEQ.
+func Address(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldEQ(FieldAddress, v))
+}
+
+// Token applies equality check predicate on the "token" field. It's identical to TokenEQ.
+func Token(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldEQ(FieldToken, v))
+}
+
+// FromAddress applies equality check predicate on the "from_address" field. It's identical to FromAddressEQ.
+func FromAddress(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldEQ(FieldFromAddress, v))
+}
+
+// BlockNumber applies equality check predicate on the "block_number" field. It's identical to BlockNumberEQ.
+func BlockNumber(v int64) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldEQ(FieldBlockNumber, v))
+}
+
+// OnchainAmount applies equality check predicate on the "onchain_amount" field. It's identical to OnchainAmountEQ.
+func OnchainAmount(v decimal.Decimal) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldEQ(FieldOnchainAmount, v))
+}
+
+// RecordedAmount applies equality check predicate on the "recorded_amount" field. It's identical to RecordedAmountEQ.
+func RecordedAmount(v decimal.Decimal) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldEQ(FieldRecordedAmount, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v Type) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v Type) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...Type) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...Type) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldNotIn(FieldType, vs...))
+}
+
+// TxHashEQ applies the EQ predicate on the "tx_hash" field.
+func TxHashEQ(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldEQ(FieldTxHash, v))
+}
+
+// TxHashNEQ applies the NEQ predicate on the "tx_hash" field.
+func TxHashNEQ(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldNEQ(FieldTxHash, v))
+}
+
+// TxHashIn applies the In predicate on the "tx_hash" field.
+func TxHashIn(vs ...string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldIn(FieldTxHash, vs...))
+}
+
+// TxHashNotIn applies the NotIn predicate on the "tx_hash" field.
+func TxHashNotIn(vs ...string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldNotIn(FieldTxHash, vs...))
+}
+
+// TxHashGT applies the GT predicate on the "tx_hash" field.
+func TxHashGT(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldGT(FieldTxHash, v))
+}
+
+// TxHashGTE applies the GTE predicate on the "tx_hash" field.
+func TxHashGTE(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldGTE(FieldTxHash, v))
+}
+
+// TxHashLT applies the LT predicate on the "tx_hash" field.
+func TxHashLT(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldLT(FieldTxHash, v))
+}
+
+// TxHashLTE applies the LTE predicate on the "tx_hash" field.
+func TxHashLTE(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldLTE(FieldTxHash, v))
+}
+
+// TxHashContains applies the Contains predicate on the "tx_hash" field.
+func TxHashContains(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldContains(FieldTxHash, v))
+}
+
+// TxHashHasPrefix applies the HasPrefix predicate on the "tx_hash" field.
+func TxHashHasPrefix(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldHasPrefix(FieldTxHash, v))
+}
+
+// TxHashHasSuffix applies the HasSuffix predicate on the "tx_hash" field.
+func TxHashHasSuffix(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldHasSuffix(FieldTxHash, v))
+}
+
+// TxHashEqualFold applies the EqualFold predicate on the "tx_hash" field.
+func TxHashEqualFold(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldEqualFold(FieldTxHash, v))
+}
+
+// TxHashContainsFold applies the ContainsFold predicate on the "tx_hash" field.
+func TxHashContainsFold(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldContainsFold(FieldTxHash, v))
+}
+
+// AddressEQ applies the EQ predicate on the "address" field.
+func AddressEQ(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldEQ(FieldAddress, v))
+}
+
+// AddressNEQ applies the NEQ predicate on the "address" field.
+func AddressNEQ(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldNEQ(FieldAddress, v))
+}
+
+// AddressIn applies the In predicate on the "address" field.
+func AddressIn(vs ...string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldIn(FieldAddress, vs...))
+}
+
+// AddressNotIn applies the NotIn predicate on the "address" field.
+func AddressNotIn(vs ...string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldNotIn(FieldAddress, vs...))
+}
+
+// AddressGT applies the GT predicate on the "address" field.
+func AddressGT(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldGT(FieldAddress, v))
+}
+
+// AddressGTE applies the GTE predicate on the "address" field.
+func AddressGTE(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldGTE(FieldAddress, v))
+}
+
+// AddressLT applies the LT predicate on the "address" field.
+func AddressLT(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldLT(FieldAddress, v))
+}
+
+// AddressLTE applies the LTE predicate on the "address" field.
+func AddressLTE(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldLTE(FieldAddress, v))
+}
+
+// AddressContains applies the Contains predicate on the "address" field.
+func AddressContains(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldContains(FieldAddress, v))
+}
+
+// AddressHasPrefix applies the HasPrefix predicate on the "address" field.
+func AddressHasPrefix(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldHasPrefix(FieldAddress, v))
+}
+
+// AddressHasSuffix applies the HasSuffix predicate on the "address" field.
+func AddressHasSuffix(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldHasSuffix(FieldAddress, v))
+}
+
+// AddressEqualFold applies the EqualFold predicate on the "address" field.
+func AddressEqualFold(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldEqualFold(FieldAddress, v))
+}
+
+// AddressContainsFold applies the ContainsFold predicate on the "address" field.
+func AddressContainsFold(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldContainsFold(FieldAddress, v))
+}
+
+// TokenEQ applies the EQ predicate on the "token" field.
+func TokenEQ(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldEQ(FieldToken, v))
+}
+
+// TokenNEQ applies the NEQ predicate on the "token" field.
+func TokenNEQ(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldNEQ(FieldToken, v))
+}
+
+// TokenIn applies the In predicate on the "token" field.
+func TokenIn(vs ...string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldIn(FieldToken, vs...))
+}
+
+// TokenNotIn applies the NotIn predicate on the "token" field.
+func TokenNotIn(vs ...string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldNotIn(FieldToken, vs...))
+}
+
+// TokenGT applies the GT predicate on the "token" field.
+func TokenGT(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldGT(FieldToken, v))
+}
+
+// TokenGTE applies the GTE predicate on the "token" field.
+func TokenGTE(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldGTE(FieldToken, v))
+}
+
+// TokenLT applies the LT predicate on the "token" field.
+func TokenLT(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldLT(FieldToken, v))
+}
+
+// TokenLTE applies the LTE predicate on the "token" field.
+func TokenLTE(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldLTE(FieldToken, v))
+}
+
+// TokenContains applies the Contains predicate on the "token" field.
+func TokenContains(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldContains(FieldToken, v))
+}
+
+// TokenHasPrefix applies the HasPrefix predicate on the "token" field.
+func TokenHasPrefix(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldHasPrefix(FieldToken, v))
+}
+
+// TokenHasSuffix applies the HasSuffix predicate on the "token" field.
+func TokenHasSuffix(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldHasSuffix(FieldToken, v))
+}
+
+// TokenEqualFold applies the EqualFold predicate on the "token" field.
+func TokenEqualFold(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldEqualFold(FieldToken, v))
+}
+
+// TokenContainsFold applies the ContainsFold predicate on the "token" field.
+func TokenContainsFold(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldContainsFold(FieldToken, v))
+}
+
+// FromAddressEQ applies the EQ predicate on the "from_address" field.
+func FromAddressEQ(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldEQ(FieldFromAddress, v))
+}
+
+// FromAddressNEQ applies the NEQ predicate on the "from_address" field.
+func FromAddressNEQ(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldNEQ(FieldFromAddress, v))
+}
+
+// FromAddressIn applies the In predicate on the "from_address" field.
+func FromAddressIn(vs ...string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldIn(FieldFromAddress, vs...))
+}
+
+// FromAddressNotIn applies the NotIn predicate on the "from_address" field.
+func FromAddressNotIn(vs ...string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldNotIn(FieldFromAddress, vs...))
+}
+
+// FromAddressGT applies the GT predicate on the "from_address" field.
+func FromAddressGT(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldGT(FieldFromAddress, v))
+}
+
+// FromAddressGTE applies the GTE predicate on the "from_address" field.
+func FromAddressGTE(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldGTE(FieldFromAddress, v))
+}
+
+// FromAddressLT applies the LT predicate on the "from_address" field.
+func FromAddressLT(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldLT(FieldFromAddress, v))
+}
+
+// FromAddressLTE applies the LTE predicate on the "from_address" field.
+func FromAddressLTE(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldLTE(FieldFromAddress, v))
+}
+
+// FromAddressContains applies the Contains predicate on the "from_address" field.
+func FromAddressContains(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldContains(FieldFromAddress, v))
+}
+
+// FromAddressHasPrefix applies the HasPrefix predicate on the "from_address" field.
+func FromAddressHasPrefix(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldHasPrefix(FieldFromAddress, v))
+}
+
+// FromAddressHasSuffix applies the HasSuffix predicate on the "from_address" field.
+func FromAddressHasSuffix(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldHasSuffix(FieldFromAddress, v))
+}
+
+// FromAddressIsNil applies the IsNil predicate on the "from_address" field.
+func FromAddressIsNil() predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldIsNull(FieldFromAddress))
+}
+
+// FromAddressNotNil applies the NotNil predicate on the "from_address" field.
+func FromAddressNotNil() predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldNotNull(FieldFromAddress))
+}
+
+// FromAddressEqualFold applies the EqualFold predicate on the "from_address" field.
+func FromAddressEqualFold(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldEqualFold(FieldFromAddress, v))
+}
+
+// FromAddressContainsFold applies the ContainsFold predicate on the "from_address" field.
+func FromAddressContainsFold(v string) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldContainsFold(FieldFromAddress, v))
+}
+
+// BlockNumberEQ applies the EQ predicate on the "block_number" field.
+func BlockNumberEQ(v int64) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldEQ(FieldBlockNumber, v))
+}
+
+// BlockNumberNEQ applies the NEQ predicate on the "block_number" field.
+func BlockNumberNEQ(v int64) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldNEQ(FieldBlockNumber, v))
+}
+
+// BlockNumberIn applies the In predicate on the "block_number" field.
+func BlockNumberIn(vs ...int64) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldIn(FieldBlockNumber, vs...))
+}
+
+// BlockNumberNotIn applies the NotIn predicate on the "block_number" field.
+func BlockNumberNotIn(vs ...int64) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldNotIn(FieldBlockNumber, vs...))
+}
+
+// BlockNumberGT applies the GT predicate on the "block_number" field.
+func BlockNumberGT(v int64) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldGT(FieldBlockNumber, v))
+}
+
+// BlockNumberGTE applies the GTE predicate on the "block_number" field.
+func BlockNumberGTE(v int64) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldGTE(FieldBlockNumber, v))
+}
+
+// BlockNumberLT applies the LT predicate on the "block_number" field.
+func BlockNumberLT(v int64) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldLT(FieldBlockNumber, v))
+}
+
+// BlockNumberLTE applies the LTE predicate on the "block_number" field.
+func BlockNumberLTE(v int64) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldLTE(FieldBlockNumber, v))
+}
+
+// OnchainAmountEQ applies the EQ predicate on the "onchain_amount" field.
+func OnchainAmountEQ(v decimal.Decimal) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldEQ(FieldOnchainAmount, v))
+}
+
+// OnchainAmountNEQ applies the NEQ predicate on the "onchain_amount" field.
+func OnchainAmountNEQ(v decimal.Decimal) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldNEQ(FieldOnchainAmount, v))
+}
+
+// OnchainAmountIn applies the In predicate on the "onchain_amount" field.
+func OnchainAmountIn(vs ...decimal.Decimal) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldIn(FieldOnchainAmount, vs...))
+}
+
+// OnchainAmountNotIn applies the NotIn predicate on the "onchain_amount" field.
+func OnchainAmountNotIn(vs ...decimal.Decimal) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldNotIn(FieldOnchainAmount, vs...))
+}
+
+// OnchainAmountGT applies the GT predicate on the "onchain_amount" field.
+func OnchainAmountGT(v decimal.Decimal) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldGT(FieldOnchainAmount, v))
+}
+
+// OnchainAmountGTE applies the GTE predicate on the "onchain_amount" field.
+func OnchainAmountGTE(v decimal.Decimal) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldGTE(FieldOnchainAmount, v))
+}
+
+// OnchainAmountLT applies the LT predicate on the "onchain_amount" field.
+func OnchainAmountLT(v decimal.Decimal) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldLT(FieldOnchainAmount, v))
+}
+
+// OnchainAmountLTE applies the LTE predicate on the "onchain_amount" field.
+func OnchainAmountLTE(v decimal.Decimal) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldLTE(FieldOnchainAmount, v))
+}
+
+// RecordedAmountEQ applies the EQ predicate on the "recorded_amount" field.
+func RecordedAmountEQ(v decimal.Decimal) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldEQ(FieldRecordedAmount, v))
+}
+
+// RecordedAmountNEQ applies the NEQ predicate on the "recorded_amount" field.
+func RecordedAmountNEQ(v decimal.Decimal) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldNEQ(FieldRecordedAmount, v))
+}
+
+// RecordedAmountIn applies the In predicate on the "recorded_amount" field.
+func RecordedAmountIn(vs ...decimal.Decimal) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldIn(FieldRecordedAmount, vs...))
+}
+
+// RecordedAmountNotIn applies the NotIn predicate on the "recorded_amount" field.
+func RecordedAmountNotIn(vs ...decimal.Decimal) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldNotIn(FieldRecordedAmount, vs...))
+}
+
+// RecordedAmountGT applies the GT predicate on the "recorded_amount" field.
+func RecordedAmountGT(v decimal.Decimal) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldGT(FieldRecordedAmount, v))
+}
+
+// RecordedAmountGTE applies the GTE predicate on the "recorded_amount" field.
+func RecordedAmountGTE(v decimal.Decimal) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldGTE(FieldRecordedAmount, v))
+}
+
+// RecordedAmountLT applies the LT predicate on the "recorded_amount" field.
+func RecordedAmountLT(v decimal.Decimal) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldLT(FieldRecordedAmount, v))
+}
+
+// RecordedAmountLTE applies the LTE predicate on the "recorded_amount" field.
+func RecordedAmountLTE(v decimal.Decimal) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.FieldLTE(FieldRecordedAmount, v))
+}
+
+// HasReport applies the HasEdge predicate on the "report" edge.
+func HasReport() predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ReportTable, ReportColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReportWith applies the HasEdge predicate on the "report" edge with a given conditions (other predicates).
+func HasReportWith(preds ...predicate.ReconciliationReport) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(func(s *sql.Selector) {
+		step := newReportStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPaymentOrder applies the HasEdge predicate on the "payment_order" edge.
+func HasPaymentOrder() predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PaymentOrderTable, PaymentOrderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPaymentOrderWith applies the HasEdge predicate on the "payment_order" edge with a given conditions (other predicates).
+func HasPaymentOrderWith(preds ...predicate.PaymentOrder) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(func(s *sql.Selector) {
+		step := newPaymentOrderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ReconciliationDiscrepancy) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ReconciliationDiscrepancy) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ReconciliationDiscrepancy) predicate.ReconciliationDiscrepancy {
+	return predicate.ReconciliationDiscrepancy(sql.NotPredicates(p))
+}
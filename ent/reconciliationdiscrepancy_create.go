@@ -0,0 +1,1056 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/reconciliationdiscrepancy"
+	"github.com/NEDA-LABS/stablenode/ent/reconciliationreport"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ReconciliationDiscrepancyCreate is the builder for creating a ReconciliationDiscrepancy entity.
+type ReconciliationDiscrepancyCreate struct {
+	config
+	mutation *ReconciliationDiscrepancyMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetType sets the "type" field.
+func (rdc *ReconciliationDiscrepancyCreate) SetType(r reconciliationdiscrepancy.Type) *ReconciliationDiscrepancyCreate {
+	rdc.mutation.SetType(r)
+	return rdc
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (rdc *ReconciliationDiscrepancyCreate) SetTxHash(s string) *ReconciliationDiscrepancyCreate {
+	rdc.mutation.SetTxHash(s)
+	return rdc
+}
+
+// SetAddress sets the "address" field.
+func (rdc *ReconciliationDiscrepancyCreate) SetAddress(s string) *ReconciliationDiscrepancyCreate {
+	rdc.mutation.SetAddress(s)
+	return rdc
+}
+
+// SetToken sets the "token" field.
+func (rdc *ReconciliationDiscrepancyCreate) SetToken(s string) *ReconciliationDiscrepancyCreate {
+	rdc.mutation.SetToken(s)
+	return rdc
+}
+
+// SetFromAddress sets the "from_address" field.
+func (rdc *ReconciliationDiscrepancyCreate) SetFromAddress(s string) *ReconciliationDiscrepancyCreate {
+	rdc.mutation.SetFromAddress(s)
+	return rdc
+}
+
+// SetNillableFromAddress sets the "from_address" field if the given value is not nil.
+func (rdc *ReconciliationDiscrepancyCreate) SetNillableFromAddress(s *string) *ReconciliationDiscrepancyCreate {
+	if s != nil {
+		rdc.SetFromAddress(*s)
+	}
+	return rdc
+}
+
+// SetBlockNumber sets the "block_number" field.
+func (rdc *ReconciliationDiscrepancyCreate) SetBlockNumber(i int64) *ReconciliationDiscrepancyCreate {
+	rdc.mutation.SetBlockNumber(i)
+	return rdc
+}
+
+// SetNillableBlockNumber sets the "block_number" field if the given value is not nil.
+func (rdc *ReconciliationDiscrepancyCreate) SetNillableBlockNumber(i *int64) *ReconciliationDiscrepancyCreate {
+	if i != nil {
+		rdc.SetBlockNumber(*i)
+	}
+	return rdc
+}
+
+// SetOnchainAmount sets the "onchain_amount" field.
+func (rdc *ReconciliationDiscrepancyCreate) SetOnchainAmount(d decimal.Decimal) *ReconciliationDiscrepancyCreate {
+	rdc.mutation.SetOnchainAmount(d)
+	return rdc
+}
+
+// SetRecordedAmount sets the "recorded_amount" field.
+func (rdc *ReconciliationDiscrepancyCreate) SetRecordedAmount(d decimal.Decimal) *ReconciliationDiscrepancyCreate {
+	rdc.mutation.SetRecordedAmount(d)
+	return rdc
+}
+
+// SetID sets the "id" field.
+func (rdc *ReconciliationDiscrepancyCreate) SetID(u uuid.UUID) *ReconciliationDiscrepancyCreate {
+	rdc.mutation.SetID(u)
+	return rdc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (rdc *ReconciliationDiscrepancyCreate) SetNillableID(u *uuid.UUID) *ReconciliationDiscrepancyCreate {
+	if u != nil {
+		rdc.SetID(*u)
+	}
+	return rdc
+}
+
+// SetReportID sets the "report" edge to the ReconciliationReport entity by ID.
+func (rdc *ReconciliationDiscrepancyCreate) SetReportID(id uuid.UUID) *ReconciliationDiscrepancyCreate {
+	rdc.mutation.SetReportID(id)
+	return rdc
+}
+
+// SetReport sets the "report" edge to the ReconciliationReport entity.
+func (rdc *ReconciliationDiscrepancyCreate) SetReport(r *ReconciliationReport) *ReconciliationDiscrepancyCreate {
+	return rdc.SetReportID(r.ID)
+}
+
+// SetPaymentOrderID sets the "payment_order" edge to the PaymentOrder entity by ID.
+func (rdc *ReconciliationDiscrepancyCreate) SetPaymentOrderID(id uuid.UUID) *ReconciliationDiscrepancyCreate {
+	rdc.mutation.SetPaymentOrderID(id)
+	return rdc
+}
+
+// SetNillablePaymentOrderID sets the "payment_order" edge to the PaymentOrder entity by ID if the given value is not nil.
+func (rdc *ReconciliationDiscrepancyCreate) SetNillablePaymentOrderID(id *uuid.UUID) *ReconciliationDiscrepancyCreate {
+	if id != nil {
+		rdc = rdc.SetPaymentOrderID(*id)
+	}
+	return rdc
+}
+
+// SetPaymentOrder sets the "payment_order" edge to the PaymentOrder entity.
+func (rdc *ReconciliationDiscrepancyCreate) SetPaymentOrder(p *PaymentOrder) *ReconciliationDiscrepancyCreate {
+	return rdc.SetPaymentOrderID(p.ID)
+}
+
+// Mutation returns the ReconciliationDiscrepancyMutation object of the builder.
+func (rdc *ReconciliationDiscrepancyCreate) Mutation() *ReconciliationDiscrepancyMutation {
+	return rdc.mutation
+}
+
+// Save creates the ReconciliationDiscrepancy in the database.
+func (rdc *ReconciliationDiscrepancyCreate) Save(ctx context.Context) (*ReconciliationDiscrepancy, error) {
+	rdc.defaults()
+	return withHooks(ctx, rdc.sqlSave, rdc.mutation, rdc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rdc *ReconciliationDiscrepancyCreate) SaveX(ctx context.Context) *ReconciliationDiscrepancy {
+	v, err := rdc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rdc *ReconciliationDiscrepancyCreate) Exec(ctx context.Context) error {
+	_, err := rdc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rdc *ReconciliationDiscrepancyCreate) ExecX(ctx context.Context) {
+	if err := rdc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rdc *ReconciliationDiscrepancyCreate) defaults() {
+	if _, ok := rdc.mutation.BlockNumber(); !ok {
+		v := reconciliationdiscrepancy.DefaultBlockNumber
+		rdc.mutation.SetBlockNumber(v)
+	}
+	if _, ok := rdc.mutation.ID(); !ok {
+		v := reconciliationdiscrepancy.DefaultID()
+		rdc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rdc *ReconciliationDiscrepancyCreate) check() error {
+	if _, ok := rdc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "ReconciliationDiscrepancy.type"`)}
+	}
+	if v, ok := rdc.mutation.GetType(); ok {
+		if err := reconciliationdiscrepancy.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "ReconciliationDiscrepancy.type": %w`, err)}
+		}
+	}
+	if _, ok := rdc.mutation.TxHash(); !ok {
+		return &ValidationError{Name: "tx_hash", err: errors.New(`ent: missing required field "ReconciliationDiscrepancy.tx_hash"`)}
+	}
+	if v, ok := rdc.mutation.TxHash(); ok {
+		if err := reconciliationdiscrepancy.TxHashValidator(v); err != nil {
+			return &ValidationError{Name: "tx_hash", err: fmt.Errorf(`ent: validator failed for field "ReconciliationDiscrepancy.tx_hash": %w`, err)}
+		}
+	}
+	if _, ok := rdc.mutation.Address(); !ok {
+		return &ValidationError{Name: "address", err: errors.New(`ent: missing required field "ReconciliationDiscrepancy.address"`)}
+	}
+	if _, ok := rdc.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "ReconciliationDiscrepancy.token"`)}
+	}
+	if _, ok := rdc.mutation.BlockNumber(); !ok {
+		return &ValidationError{Name: "block_number", err: errors.New(`ent: missing required field "ReconciliationDiscrepancy.block_number"`)}
+	}
+	if _, ok := rdc.mutation.OnchainAmount(); !ok {
+		return &ValidationError{Name: "onchain_amount", err: errors.New(`ent: missing required field "ReconciliationDiscrepancy.onchain_amount"`)}
+	}
+	if _, ok := rdc.mutation.RecordedAmount(); !ok {
+		return &ValidationError{Name: "recorded_amount", err: errors.New(`ent: missing required field "ReconciliationDiscrepancy.recorded_amount"`)}
+	}
+	if len(rdc.mutation.ReportIDs()) == 0 {
+		return &ValidationError{Name: "report", err: errors.New(`ent: missing required edge "ReconciliationDiscrepancy.report"`)}
+	}
+	return nil
+}
+
+func (rdc *ReconciliationDiscrepancyCreate) sqlSave(ctx context.Context) (*ReconciliationDiscrepancy, error) {
+	if err := rdc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rdc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rdc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	rdc.mutation.id = &_node.ID
+	rdc.mutation.done = true
+	return _node, nil
+}
+
+func (rdc *ReconciliationDiscrepancyCreate) createSpec() (*ReconciliationDiscrepancy, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ReconciliationDiscrepancy{config: rdc.config}
+		_spec = sqlgraph.NewCreateSpec(reconciliationdiscrepancy.Table, sqlgraph.NewFieldSpec(reconciliationdiscrepancy.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = rdc.conflict
+	if id, ok := rdc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := rdc.mutation.GetType(); ok {
+		_spec.SetField(reconciliationdiscrepancy.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := rdc.mutation.TxHash(); ok {
+		_spec.SetField(reconciliationdiscrepancy.FieldTxHash, field.TypeString, value)
+		_node.TxHash = value
+	}
+	if value, ok := rdc.mutation.Address(); ok {
+		_spec.SetField(reconciliationdiscrepancy.FieldAddress, field.TypeString, value)
+		_node.Address = value
+	}
+	if value, ok := rdc.mutation.Token(); ok {
+		_spec.SetField(reconciliationdiscrepancy.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := rdc.mutation.FromAddress(); ok {
+		_spec.SetField(reconciliationdiscrepancy.FieldFromAddress, field.TypeString, value)
+		_node.FromAddress = value
+	}
+	if value, ok := rdc.mutation.BlockNumber(); ok {
+		_spec.SetField(reconciliationdiscrepancy.FieldBlockNumber, field.TypeInt64, value)
+		_node.BlockNumber = value
+	}
+	if value, ok := rdc.mutation.OnchainAmount(); ok {
+		_spec.SetField(reconciliationdiscrepancy.FieldOnchainAmount, field.TypeFloat64, value)
+		_node.OnchainAmount = value
+	}
+	if value, ok := rdc.mutation.RecordedAmount(); ok {
+		_spec.SetField(reconciliationdiscrepancy.FieldRecordedAmount, field.TypeFloat64, value)
+		_node.RecordedAmount = value
+	}
+	if nodes := rdc.mutation.ReportIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reconciliationdiscrepancy.ReportTable,
+			Columns: []string{reconciliationdiscrepancy.ReportColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reconciliationreport.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.reconciliation_report_discrepancies = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rdc.mutation.PaymentOrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reconciliationdiscrepancy.PaymentOrderTable,
+			Columns: []string{reconciliationdiscrepancy.PaymentOrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.payment_order_reconciliation_discrepancies = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReconciliationDiscrepancy.Create().
+//		SetType(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReconciliationDiscrepancyUpsert) {
+//			SetType(v+v).
+//		}).
+//		Exec(ctx)
+func (rdc *ReconciliationDiscrepancyCreate) OnConflict(opts ...sql.ConflictOption) *ReconciliationDiscrepancyUpsertOne {
+	rdc.conflict = opts
+	return &ReconciliationDiscrepancyUpsertOne{
+		create: rdc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReconciliationDiscrepancy.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rdc *ReconciliationDiscrepancyCreate) OnConflictColumns(columns ...string) *ReconciliationDiscrepancyUpsertOne {
+	rdc.conflict = append(rdc.conflict, sql.ConflictColumns(columns...))
+	return &ReconciliationDiscrepancyUpsertOne{
+		create: rdc,
+	}
+}
+
+type (
+	// ReconciliationDiscrepancyUpsertOne is the builder for "upsert"-ing
+	//  one ReconciliationDiscrepancy node.
+	ReconciliationDiscrepancyUpsertOne struct {
+		create *ReconciliationDiscrepancyCreate
+	}
+
+	// ReconciliationDiscrepancyUpsert is the "OnConflict" setter.
+	ReconciliationDiscrepancyUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetType sets the "type" field.
+func (u *ReconciliationDiscrepancyUpsert) SetType(v reconciliationdiscrepancy.Type) *ReconciliationDiscrepancyUpsert {
+	u.Set(reconciliationdiscrepancy.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *ReconciliationDiscrepancyUpsert) UpdateType() *ReconciliationDiscrepancyUpsert {
+	u.SetExcluded(reconciliationdiscrepancy.FieldType)
+	return u
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (u *ReconciliationDiscrepancyUpsert) SetTxHash(v string) *ReconciliationDiscrepancyUpsert {
+	u.Set(reconciliationdiscrepancy.FieldTxHash, v)
+	return u
+}
+
+// UpdateTxHash sets the "tx_hash" field to the value that was provided on create.
+func (u *ReconciliationDiscrepancyUpsert) UpdateTxHash() *ReconciliationDiscrepancyUpsert {
+	u.SetExcluded(reconciliationdiscrepancy.FieldTxHash)
+	return u
+}
+
+// SetAddress sets the "address" field.
+func (u *ReconciliationDiscrepancyUpsert) SetAddress(v string) *ReconciliationDiscrepancyUpsert {
+	u.Set(reconciliationdiscrepancy.FieldAddress, v)
+	return u
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *ReconciliationDiscrepancyUpsert) UpdateAddress() *ReconciliationDiscrepancyUpsert {
+	u.SetExcluded(reconciliationdiscrepancy.FieldAddress)
+	return u
+}
+
+// SetToken sets the "token" field.
+func (u *ReconciliationDiscrepancyUpsert) SetToken(v string) *ReconciliationDiscrepancyUpsert {
+	u.Set(reconciliationdiscrepancy.FieldToken, v)
+	return u
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *ReconciliationDiscrepancyUpsert) UpdateToken() *ReconciliationDiscrepancyUpsert {
+	u.SetExcluded(reconciliationdiscrepancy.FieldToken)
+	return u
+}
+
+// SetFromAddress sets the "from_address" field.
+func (u *ReconciliationDiscrepancyUpsert) SetFromAddress(v string) *ReconciliationDiscrepancyUpsert {
+	u.Set(reconciliationdiscrepancy.FieldFromAddress, v)
+	return u
+}
+
+// UpdateFromAddress sets the "from_address" field to the value that was provided on create.
+func (u *ReconciliationDiscrepancyUpsert) UpdateFromAddress() *ReconciliationDiscrepancyUpsert {
+	u.SetExcluded(reconciliationdiscrepancy.FieldFromAddress)
+	return u
+}
+
+// ClearFromAddress clears the value of the "from_address" field.
+func (u *ReconciliationDiscrepancyUpsert) ClearFromAddress() *ReconciliationDiscrepancyUpsert {
+	u.SetNull(reconciliationdiscrepancy.FieldFromAddress)
+	return u
+}
+
+// SetBlockNumber sets the "block_number" field.
+func (u *ReconciliationDiscrepancyUpsert) SetBlockNumber(v int64) *ReconciliationDiscrepancyUpsert {
+	u.Set(reconciliationdiscrepancy.FieldBlockNumber, v)
+	return u
+}
+
+// UpdateBlockNumber sets the "block_number" field to the value that was provided on create.
+func (u *ReconciliationDiscrepancyUpsert) UpdateBlockNumber() *ReconciliationDiscrepancyUpsert {
+	u.SetExcluded(reconciliationdiscrepancy.FieldBlockNumber)
+	return u
+}
+
+// AddBlockNumber adds v to the "block_number" field.
+func (u *ReconciliationDiscrepancyUpsert) AddBlockNumber(v int64) *ReconciliationDiscrepancyUpsert {
+	u.Add(reconciliationdiscrepancy.FieldBlockNumber, v)
+	return u
+}
+
+// SetOnchainAmount sets the "onchain_amount" field.
+func (u *ReconciliationDiscrepancyUpsert) SetOnchainAmount(v decimal.Decimal) *ReconciliationDiscrepancyUpsert {
+	u.Set(reconciliationdiscrepancy.FieldOnchainAmount, v)
+	return u
+}
+
+// UpdateOnchainAmount sets the "onchain_amount" field to the value that was provided on create.
+func (u *ReconciliationDiscrepancyUpsert) UpdateOnchainAmount() *ReconciliationDiscrepancyUpsert {
+	u.SetExcluded(reconciliationdiscrepancy.FieldOnchainAmount)
+	return u
+}
+
+// AddOnchainAmount adds v to the "onchain_amount" field.
+func (u *ReconciliationDiscrepancyUpsert) AddOnchainAmount(v decimal.Decimal) *ReconciliationDiscrepancyUpsert {
+	u.Add(reconciliationdiscrepancy.FieldOnchainAmount, v)
+	return u
+}
+
+// SetRecordedAmount sets the "recorded_amount" field.
+func (u *ReconciliationDiscrepancyUpsert) SetRecordedAmount(v decimal.Decimal) *ReconciliationDiscrepancyUpsert {
+	u.Set(reconciliationdiscrepancy.FieldRecordedAmount, v)
+	return u
+}
+
+// UpdateRecordedAmount sets the "recorded_amount" field to the value that was provided on create.
+func (u *ReconciliationDiscrepancyUpsert) UpdateRecordedAmount() *ReconciliationDiscrepancyUpsert {
+	u.SetExcluded(reconciliationdiscrepancy.FieldRecordedAmount)
+	return u
+}
+
+// AddRecordedAmount adds v to the "recorded_amount" field.
+func (u *ReconciliationDiscrepancyUpsert) AddRecordedAmount(v decimal.Decimal) *ReconciliationDiscrepancyUpsert {
+	u.Add(reconciliationdiscrepancy.FieldRecordedAmount, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ReconciliationDiscrepancy.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(reconciliationdiscrepancy.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReconciliationDiscrepancyUpsertOne) UpdateNewValues() *ReconciliationDiscrepancyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(reconciliationdiscrepancy.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReconciliationDiscrepancy.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ReconciliationDiscrepancyUpsertOne) Ignore() *ReconciliationDiscrepancyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReconciliationDiscrepancyUpsertOne) DoNothing() *ReconciliationDiscrepancyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReconciliationDiscrepancyCreate.OnConflict
+// documentation for more info.
+func (u *ReconciliationDiscrepancyUpsertOne) Update(set func(*ReconciliationDiscrepancyUpsert)) *ReconciliationDiscrepancyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReconciliationDiscrepancyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *ReconciliationDiscrepancyUpsertOne) SetType(v reconciliationdiscrepancy.Type) *ReconciliationDiscrepancyUpsertOne {
+	return u.Update(func(s *ReconciliationDiscrepancyUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *ReconciliationDiscrepancyUpsertOne) UpdateType() *ReconciliationDiscrepancyUpsertOne {
+	return u.Update(func(s *ReconciliationDiscrepancyUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (u *ReconciliationDiscrepancyUpsertOne) SetTxHash(v string) *ReconciliationDiscrepancyUpsertOne {
+	return u.Update(func(s *ReconciliationDiscrepancyUpsert) {
+		s.SetTxHash(v)
+	})
+}
+
+// UpdateTxHash sets the "tx_hash" field to the value that was provided on create.
+func (u *ReconciliationDiscrepancyUpsertOne) UpdateTxHash() *ReconciliationDiscrepancyUpsertOne {
+	return u.Update(func(s *ReconciliationDiscrepancyUpsert) {
+		s.UpdateTxHash()
+	})
+}
+
+// SetAddress sets the "address" field.
+func (u *ReconciliationDiscrepancyUpsertOne) SetAddress(v string) *ReconciliationDiscrepancyUpsertOne {
+	return u.Update(func(s *ReconciliationDiscrepancyUpsert) {
+		s.SetAddress(v)
+	})
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *ReconciliationDiscrepancyUpsertOne) UpdateAddress() *ReconciliationDiscrepancyUpsertOne {
+	return u.Update(func(s *ReconciliationDiscrepancyUpsert) {
+		s.UpdateAddress()
+	})
+}
+
+// SetToken sets the "token" field.
+func (u *ReconciliationDiscrepancyUpsertOne) SetToken(v string) *ReconciliationDiscrepancyUpsertOne {
+	return u.Update(func(s *ReconciliationDiscrepancyUpsert) {
+		s.SetToken(v)
+	})
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *ReconciliationDiscrepancyUpsertOne) UpdateToken() *ReconciliationDiscrepancyUpsertOne {
+	return u.Update(func(s *ReconciliationDiscrepancyUpsert) {
+		s.UpdateToken()
+	})
+}
+
+// SetFromAddress sets the "from_address" field.
+func (u *ReconciliationDiscrepancyUpsertOne) SetFromAddress(v string) *ReconciliationDiscrepancyUpsertOne {
+	return u.Update(func(s *ReconciliationDiscrepancyUpsert) {
+		s.SetFromAddress(v)
+	})
+}
+
+// UpdateFromAddress sets the "from_address" field to the value that was provided on create.
+func (u *ReconciliationDiscrepancyUpsertOne) UpdateFromAddress() *ReconciliationDiscrepancyUpsertOne {
+	return u.Update(func(s *ReconciliationDiscrepancyUpsert) {
+		s.UpdateFromAddress()
+	})
+}
+
+// ClearFromAddress clears the value of the "from_address" field.
+func (u *ReconciliationDiscrepancyUpsertOne) ClearFromAddress() *ReconciliationDiscrepancyUpsertOne {
+	return u.Update(func(s *ReconciliationDiscrepancyUpsert) {
+		s.ClearFromAddress()
+	})
+}
+
+// SetBlockNumber sets the "block_number" field.
+func (u *ReconciliationDiscrepancyUpsertOne) SetBlockNumber(v int64) *ReconciliationDiscrepancyUpsertOne {
+	return u.Update(func(s *ReconciliationDiscrepancyUpsert) {
+		s.SetBlockNumber(v)
+	})
+}
+
+// AddBlockNumber adds v to the "block_number" field.
+func (u *ReconciliationDiscrepancyUpsertOne) AddBlockNumber(v int64) *ReconciliationDiscrepancyUpsertOne {
+	return u.Update(func(s *ReconciliationDiscrepancyUpsert) {
+		s.AddBlockNumber(v)
+	})
+}
+
+// UpdateBlockNumber sets the "block_number" field to the value that was provided on create.
+func (u *ReconciliationDiscrepancyUpsertOne) UpdateBlockNumber() *ReconciliationDiscrepancyUpsertOne {
+	return u.Update(func(s *ReconciliationDiscrepancyUpsert) {
+		s.UpdateBlockNumber()
+	})
+}
+
+// SetOnchainAmount sets the "onchain_amount" field.
+func (u *ReconciliationDiscrepancyUpsertOne) SetOnchainAmount(v decimal.Decimal) *ReconciliationDiscrepancyUpsertOne {
+	return u.Update(func(s *ReconciliationDiscrepancyUpsert) {
+		s.SetOnchainAmount(v)
+	})
+}
+
+// AddOnchainAmount adds v to the "onchain_amount" field.
+func (u *ReconciliationDiscrepancyUpsertOne) AddOnchainAmount(v decimal.Decimal) *ReconciliationDiscrepancyUpsertOne {
+	return u.Update(func(s *ReconciliationDiscrepancyUpsert) {
+		s.AddOnchainAmount(v)
+	})
+}
+
+// UpdateOnchainAmount sets the "onchain_amount" field to the value that was provided on create.
+func (u *ReconciliationDiscrepancyUpsertOne) UpdateOnchainAmount() *ReconciliationDiscrepancyUpsertOne {
+	return u.Update(func(s *ReconciliationDiscrepancyUpsert) {
+		s.UpdateOnchainAmount()
+	})
+}
+
+// SetRecordedAmount sets the "recorded_amount" field.
+func (u *ReconciliationDiscrepancyUpsertOne) SetRecordedAmount(v decimal.Decimal) *ReconciliationDiscrepancyUpsertOne {
+	return u.Update(func(s *ReconciliationDiscrepancyUpsert) {
+		s.SetRecordedAmount(v)
+	})
+}
+
+// AddRecordedAmount adds v to the "recorded_amount" field.
+func (u *ReconciliationDiscrepancyUpsertOne) AddRecordedAmount(v decimal.Decimal) *ReconciliationDiscrepancyUpsertOne {
+	return u.Update(func(s *ReconciliationDiscrepancyUpsert) {
+		s.AddRecordedAmount(v)
+	})
+}
+
+// UpdateRecordedAmount sets the "recorded_amount" field to the value that was provided on create.
+func (u *ReconciliationDiscrepancyUpsertOne) UpdateRecordedAmount() *ReconciliationDiscrepancyUpsertOne {
+	return u.Update(func(s *ReconciliationDiscrepancyUpsert) {
+		s.UpdateRecordedAmount()
+	})
+}
+
+// Exec executes the query.
+func (u *ReconciliationDiscrepancyUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReconciliationDiscrepancyCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReconciliationDiscrepancyUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ReconciliationDiscrepancyUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ReconciliationDiscrepancyUpsertOne.ID is not supported by MySQL driver. Use ReconciliationDiscrepancyUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ReconciliationDiscrepancyUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ReconciliationDiscrepancyCreateBulk is the builder for creating many ReconciliationDiscrepancy entities in bulk.
+type ReconciliationDiscrepancyCreateBulk struct {
+	config
+	err      error
+	builders []*ReconciliationDiscrepancyCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ReconciliationDiscrepancy entities in the database.
+func (rdcb *ReconciliationDiscrepancyCreateBulk) Save(ctx context.Context) ([]*ReconciliationDiscrepancy, error) {
+	if rdcb.err != nil {
+		return nil, rdcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rdcb.builders))
+	nodes := make([]*ReconciliationDiscrepancy, len(rdcb.builders))
+	mutators := make([]Mutator, len(rdcb.builders))
+	for i := range rdcb.builders {
+		func(i int, root context.Context) {
+			builder := rdcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReconciliationDiscrepancyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rdcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rdcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rdcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rdcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rdcb *ReconciliationDiscrepancyCreateBulk) SaveX(ctx context.Context) []*ReconciliationDiscrepancy {
+	v, err := rdcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rdcb *ReconciliationDiscrepancyCreateBulk) Exec(ctx context.Context) error {
+	_, err := rdcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rdcb *ReconciliationDiscrepancyCreateBulk) ExecX(ctx context.Context) {
+	if err := rdcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReconciliationDiscrepancy.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReconciliationDiscrepancyUpsert) {
+//			SetType(v+v).
+//		}).
+//		Exec(ctx)
+func (rdcb *ReconciliationDiscrepancyCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReconciliationDiscrepancyUpsertBulk {
+	rdcb.conflict = opts
+	return &ReconciliationDiscrepancyUpsertBulk{
+		create: rdcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReconciliationDiscrepancy.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rdcb *ReconciliationDiscrepancyCreateBulk) OnConflictColumns(columns ...string) *ReconciliationDiscrepancyUpsertBulk {
+	rdcb.conflict = append(rdcb.conflict, sql.ConflictColumns(columns...))
+	return &ReconciliationDiscrepancyUpsertBulk{
+		create: rdcb,
+	}
+}
+
+// ReconciliationDiscrepancyUpsertBulk is the builder for "upsert"-ing
+// a bulk of ReconciliationDiscrepancy nodes.
+type ReconciliationDiscrepancyUpsertBulk struct {
+	create *ReconciliationDiscrepancyCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ReconciliationDiscrepancy.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(reconciliationdiscrepancy.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReconciliationDiscrepancyUpsertBulk) UpdateNewValues() *ReconciliationDiscrepancyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(reconciliationdiscrepancy.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReconciliationDiscrepancy.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ReconciliationDiscrepancyUpsertBulk) Ignore() *ReconciliationDiscrepancyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReconciliationDiscrepancyUpsertBulk) DoNothing() *ReconciliationDiscrepancyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReconciliationDiscrepancyCreateBulk.OnConflict
+// documentation for more info.
+func (u *ReconciliationDiscrepancyUpsertBulk) Update(set func(*ReconciliationDiscrepancyUpsert)) *ReconciliationDiscrepancyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReconciliationDiscrepancyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *ReconciliationDiscrepancyUpsertBulk) SetType(v reconciliationdiscrepancy.Type) *ReconciliationDiscrepancyUpsertBulk {
+	return u.Update(func(s *ReconciliationDiscrepancyUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *ReconciliationDiscrepancyUpsertBulk) UpdateType() *ReconciliationDiscrepancyUpsertBulk {
+	return u.Update(func(s *ReconciliationDiscrepancyUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (u *ReconciliationDiscrepancyUpsertBulk) SetTxHash(v string) *ReconciliationDiscrepancyUpsertBulk {
+	return u.Update(func(s *ReconciliationDiscrepancyUpsert) {
+		s.SetTxHash(v)
+	})
+}
+
+// UpdateTxHash sets the "tx_hash" field to the value that was provided on create.
+func (u *ReconciliationDiscrepancyUpsertBulk) UpdateTxHash() *ReconciliationDiscrepancyUpsertBulk {
+	return u.Update(func(s *ReconciliationDiscrepancyUpsert) {
+		s.UpdateTxHash()
+	})
+}
+
+// SetAddress sets the "address" field.
+func (u *ReconciliationDiscrepancyUpsertBulk) SetAddress(v string) *ReconciliationDiscrepancyUpsertBulk {
+	return u.Update(func(s *ReconciliationDiscrepancyUpsert) {
+		s.SetAddress(v)
+	})
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *ReconciliationDiscrepancyUpsertBulk) UpdateAddress() *ReconciliationDiscrepancyUpsertBulk {
+	return u.Update(func(s *ReconciliationDiscrepancyUpsert) {
+		s.UpdateAddress()
+	})
+}
+
+// SetToken sets the "token" field.
+func (u *ReconciliationDiscrepancyUpsertBulk) SetToken(v string) *ReconciliationDiscrepancyUpsertBulk {
+	return u.Update(func(s *ReconciliationDiscrepancyUpsert) {
+		s.SetToken(v)
+	})
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *ReconciliationDiscrepancyUpsertBulk) UpdateToken() *ReconciliationDiscrepancyUpsertBulk {
+	return u.Update(func(s *ReconciliationDiscrepancyUpsert) {
+		s.UpdateToken()
+	})
+}
+
+// SetFromAddress sets the "from_address" field.
+func (u *ReconciliationDiscrepancyUpsertBulk) SetFromAddress(v string) *ReconciliationDiscrepancyUpsertBulk {
+	return u.Update(func(s *ReconciliationDiscrepancyUpsert) {
+		s.SetFromAddress(v)
+	})
+}
+
+// UpdateFromAddress sets the "from_address" field to the value that was provided on create.
+func (u *ReconciliationDiscrepancyUpsertBulk) UpdateFromAddress() *ReconciliationDiscrepancyUpsertBulk {
+	return u.Update(func(s *ReconciliationDiscrepancyUpsert) {
+		s.UpdateFromAddress()
+	})
+}
+
+// ClearFromAddress clears the value of the "from_address" field.
+func (u *ReconciliationDiscrepancyUpsertBulk) ClearFromAddress() *ReconciliationDiscrepancyUpsertBulk {
+	return u.Update(func(s *ReconciliationDiscrepancyUpsert) {
+		s.ClearFromAddress()
+	})
+}
+
+// SetBlockNumber sets the "block_number" field.
+func (u *ReconciliationDiscrepancyUpsertBulk) SetBlockNumber(v int64) *ReconciliationDiscrepancyUpsertBulk {
+	return u.Update(func(s *ReconciliationDiscrepancyUpsert) {
+		s.SetBlockNumber(v)
+	})
+}
+
+// AddBlockNumber adds v to the "block_number" field.
+func (u *ReconciliationDiscrepancyUpsertBulk) AddBlockNumber(v int64) *ReconciliationDiscrepancyUpsertBulk {
+	return u.Update(func(s *ReconciliationDiscrepancyUpsert) {
+		s.AddBlockNumber(v)
+	})
+}
+
+// UpdateBlockNumber sets the "block_number" field to the value that was provided on create.
+func (u *ReconciliationDiscrepancyUpsertBulk) UpdateBlockNumber() *ReconciliationDiscrepancyUpsertBulk {
+	return u.Update(func(s *ReconciliationDiscrepancyUpsert) {
+		s.UpdateBlockNumber()
+	})
+}
+
+// SetOnchainAmount sets the "onchain_amount" field.
+func (u *ReconciliationDiscrepancyUpsertBulk) SetOnchainAmount(v decimal.Decimal) *ReconciliationDiscrepancyUpsertBulk {
+	return u.Update(func(s *ReconciliationDiscrepancyUpsert) {
+		s.SetOnchainAmount(v)
+	})
+}
+
+// AddOnchainAmount adds v to the "onchain_amount" field.
+func (u *ReconciliationDiscrepancyUpsertBulk) AddOnchainAmount(v decimal.Decimal) *ReconciliationDiscrepancyUpsertBulk {
+	return u.Update(func(s *ReconciliationDiscrepancyUpsert) {
+		s.AddOnchainAmount(v)
+	})
+}
+
+// UpdateOnchainAmount sets the "onchain_amount" field to the value that was provided on create.
+func (u *ReconciliationDiscrepancyUpsertBulk) UpdateOnchainAmount() *ReconciliationDiscrepancyUpsertBulk {
+	return u.Update(func(s *ReconciliationDiscrepancyUpsert) {
+		s.UpdateOnchainAmount()
+	})
+}
+
+// SetRecordedAmount sets the "recorded_amount" field.
+func (u *ReconciliationDiscrepancyUpsertBulk) SetRecordedAmount(v decimal.Decimal) *ReconciliationDiscrepancyUpsertBulk {
+	return u.Update(func(s *ReconciliationDiscrepancyUpsert) {
+		s.SetRecordedAmount(v)
+	})
+}
+
+// AddRecordedAmount adds v to the "recorded_amount" field.
+func (u *ReconciliationDiscrepancyUpsertBulk) AddRecordedAmount(v decimal.Decimal) *ReconciliationDiscrepancyUpsertBulk {
+	return u.Update(func(s *ReconciliationDiscrepancyUpsert) {
+		s.AddRecordedAmount(v)
+	})
+}
+
+// UpdateRecordedAmount sets the "recorded_amount" field to the value that was provided on create.
+func (u *ReconciliationDiscrepancyUpsertBulk) UpdateRecordedAmount() *ReconciliationDiscrepancyUpsertBulk {
+	return u.Update(func(s *ReconciliationDiscrepancyUpsert) {
+		s.UpdateRecordedAmount()
+	})
+}
+
+// Exec executes the query.
+func (u *ReconciliationDiscrepancyUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ReconciliationDiscrepancyCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReconciliationDiscrepancyCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReconciliationDiscrepancyUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/NEDA-LABS/stablenode/ent/reconciliationdiscrepancy"
+)
+
+// ReconciliationDiscrepancyDelete is the builder for deleting a ReconciliationDiscrepancy entity.
+type ReconciliationDiscrepancyDelete struct {
+	config
+	hooks    []Hook
+	mutation *ReconciliationDiscrepancyMutation
+}
+
+// Where appends a list predicates to the ReconciliationDiscrepancyDelete builder.
+func (rdd *ReconciliationDiscrepancyDelete) Where(ps ...predicate.ReconciliationDiscrepancy) *ReconciliationDiscrepancyDelete {
+	rdd.mutation.Where(ps...)
+	return rdd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rdd *ReconciliationDiscrepancyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rdd.sqlExec, rdd.mutation, rdd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rdd *ReconciliationDiscrepancyDelete) ExecX(ctx context.Context) int {
+	n, err := rdd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rdd *ReconciliationDiscrepancyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(reconciliationdiscrepancy.Table, sqlgraph.NewFieldSpec(reconciliationdiscrepancy.FieldID, field.TypeUUID))
+	if ps := rdd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rdd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rdd.mutation.done = true
+	return affected, err
+}
+
+// ReconciliationDiscrepancyDeleteOne is the builder for deleting a single ReconciliationDiscrepancy entity.
+type ReconciliationDiscrepancyDeleteOne struct {
+	rdd *ReconciliationDiscrepancyDelete
+}
+
+// Where appends a list predicates to the ReconciliationDiscrepancyDelete builder.
+func (rddo *ReconciliationDiscrepancyDeleteOne) Where(ps ...predicate.ReconciliationDiscrepancy) *ReconciliationDiscrepancyDeleteOne {
+	rddo.rdd.mutation.Where(ps...)
+	return rddo
+}
+
+// Exec executes the deletion query.
+func (rddo *ReconciliationDiscrepancyDeleteOne) Exec(ctx context.Context) error {
+	n, err := rddo.rdd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{reconciliationdiscrepancy.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rddo *ReconciliationDiscrepancyDeleteOne) ExecX(ctx context.Context) {
+	if err := rddo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,690 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/NEDA-LABS/stablenode/ent/reconciliationdiscrepancy"
+	"github.com/NEDA-LABS/stablenode/ent/reconciliationreport"
+	"github.com/google/uuid"
+)
+
+// ReconciliationDiscrepancyQuery is the builder for querying ReconciliationDiscrepancy entities.
+type ReconciliationDiscrepancyQuery struct {
+	config
+	ctx              *QueryContext
+	order            []reconciliationdiscrepancy.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.ReconciliationDiscrepancy
+	withReport       *ReconciliationReportQuery
+	withPaymentOrder *PaymentOrderQuery
+	withFKs          bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ReconciliationDiscrepancyQuery builder.
+func (rdq *ReconciliationDiscrepancyQuery) Where(ps ...predicate.ReconciliationDiscrepancy) *ReconciliationDiscrepancyQuery {
+	rdq.predicates = append(rdq.predicates, ps...)
+	return rdq
+}
+
+// Limit the number of records to be returned by this query.
+func (rdq *ReconciliationDiscrepancyQuery) Limit(limit int) *ReconciliationDiscrepancyQuery {
+	rdq.ctx.Limit = &limit
+	return rdq
+}
+
+// Offset to start from.
+func (rdq *ReconciliationDiscrepancyQuery) Offset(offset int) *ReconciliationDiscrepancyQuery {
+	rdq.ctx.Offset = &offset
+	return rdq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rdq *ReconciliationDiscrepancyQuery) Unique(unique bool) *ReconciliationDiscrepancyQuery {
+	rdq.ctx.Unique = &unique
+	return rdq
+}
+
+// Order specifies how the records should be ordered.
+func (rdq *ReconciliationDiscrepancyQuery) Order(o ...reconciliationdiscrepancy.OrderOption) *ReconciliationDiscrepancyQuery {
+	rdq.order = append(rdq.order, o...)
+	return rdq
+}
+
+// QueryReport chains the current query on the "report" edge.
+func (rdq *ReconciliationDiscrepancyQuery) QueryReport() *ReconciliationReportQuery {
+	query := (&ReconciliationReportClient{config: rdq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rdq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rdq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reconciliationdiscrepancy.Table, reconciliationdiscrepancy.FieldID, selector),
+			sqlgraph.To(reconciliationreport.Table, reconciliationreport.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, reconciliationdiscrepancy.ReportTable, reconciliationdiscrepancy.ReportColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rdq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPaymentOrder chains the current query on the "payment_order" edge.
+func (rdq *ReconciliationDiscrepancyQuery) QueryPaymentOrder() *PaymentOrderQuery {
+	query := (&PaymentOrderClient{config: rdq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rdq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rdq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reconciliationdiscrepancy.Table, reconciliationdiscrepancy.FieldID, selector),
+			sqlgraph.To(paymentorder.Table, paymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, reconciliationdiscrepancy.PaymentOrderTable, reconciliationdiscrepancy.PaymentOrderColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rdq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ReconciliationDiscrepancy entity from the query.
+// Returns a *NotFoundError when no ReconciliationDiscrepancy was found.
+func (rdq *ReconciliationDiscrepancyQuery) First(ctx context.Context) (*ReconciliationDiscrepancy, error) {
+	nodes, err := rdq.Limit(1).All(setContextOp(ctx, rdq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{reconciliationdiscrepancy.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rdq *ReconciliationDiscrepancyQuery) FirstX(ctx context.Context) *ReconciliationDiscrepancy {
+	node, err := rdq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ReconciliationDiscrepancy ID from the query.
+// Returns a *NotFoundError when no ReconciliationDiscrepancy ID was found.
+func (rdq *ReconciliationDiscrepancyQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = rdq.Limit(1).IDs(setContextOp(ctx, rdq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{reconciliationdiscrepancy.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rdq *ReconciliationDiscrepancyQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := rdq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ReconciliationDiscrepancy entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ReconciliationDiscrepancy entity is found.
+// Returns a *NotFoundError when no ReconciliationDiscrepancy entities are found.
+func (rdq *ReconciliationDiscrepancyQuery) Only(ctx context.Context) (*ReconciliationDiscrepancy, error) {
+	nodes, err := rdq.Limit(2).All(setContextOp(ctx, rdq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{reconciliationdiscrepancy.Label}
+	default:
+		return nil, &NotSingularError{reconciliationdiscrepancy.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rdq *ReconciliationDiscrepancyQuery) OnlyX(ctx context.Context) *ReconciliationDiscrepancy {
+	node, err := rdq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ReconciliationDiscrepancy ID in the query.
+// Returns a *NotSingularError when more than one ReconciliationDiscrepancy ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rdq *ReconciliationDiscrepancyQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = rdq.Limit(2).IDs(setContextOp(ctx, rdq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{reconciliationdiscrepancy.Label}
+	default:
+		err = &NotSingularError{reconciliationdiscrepancy.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rdq *ReconciliationDiscrepancyQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := rdq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ReconciliationDiscrepancies.
+func (rdq *ReconciliationDiscrepancyQuery) All(ctx context.Context) ([]*ReconciliationDiscrepancy, error) {
+	ctx = setContextOp(ctx, rdq.ctx, ent.OpQueryAll)
+	if err := rdq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ReconciliationDiscrepancy, *ReconciliationDiscrepancyQuery]()
+	return withInterceptors[[]*ReconciliationDiscrepancy](ctx, rdq, qr, rdq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rdq *ReconciliationDiscrepancyQuery) AllX(ctx context.Context) []*ReconciliationDiscrepancy {
+	nodes, err := rdq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ReconciliationDiscrepancy IDs.
+func (rdq *ReconciliationDiscrepancyQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if rdq.ctx.Unique == nil && rdq.path != nil {
+		rdq.Unique(true)
+	}
+	ctx = setContextOp(ctx, rdq.ctx, ent.OpQueryIDs)
+	if err = rdq.Select(reconciliationdiscrepancy.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rdq *ReconciliationDiscrepancyQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := rdq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rdq *ReconciliationDiscrepancyQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, rdq.ctx, ent.OpQueryCount)
+	if err := rdq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, rdq, querierCount[*ReconciliationDiscrepancyQuery](), rdq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rdq *ReconciliationDiscrepancyQuery) CountX(ctx context.Context) int {
+	count, err := rdq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rdq *ReconciliationDiscrepancyQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, rdq.ctx, ent.OpQueryExist)
+	switch _, err := rdq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rdq *ReconciliationDiscrepancyQuery) ExistX(ctx context.Context) bool {
+	exist, err := rdq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ReconciliationDiscrepancyQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rdq *ReconciliationDiscrepancyQuery) Clone() *ReconciliationDiscrepancyQuery {
+	if rdq == nil {
+		return nil
+	}
+	return &ReconciliationDiscrepancyQuery{
+		config:           rdq.config,
+		ctx:              rdq.ctx.Clone(),
+		order:            append([]reconciliationdiscrepancy.OrderOption{}, rdq.order...),
+		inters:           append([]Interceptor{}, rdq.inters...),
+		predicates:       append([]predicate.ReconciliationDiscrepancy{}, rdq.predicates...),
+		withReport:       rdq.withReport.Clone(),
+		withPaymentOrder: rdq.withPaymentOrder.Clone(),
+		// clone intermediate query.
+		sql:  rdq.sql.Clone(),
+		path: rdq.path,
+	}
+}
+
+// WithReport tells the query-builder to eager-load the nodes that are connected to
+// the "report" edge. The optional arguments are used to configure the query builder of the edge.
+func (rdq *ReconciliationDiscrepancyQuery) WithReport(opts ...func(*ReconciliationReportQuery)) *ReconciliationDiscrepancyQuery {
+	query := (&ReconciliationReportClient{config: rdq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rdq.withReport = query
+	return rdq
+}
+
+// WithPaymentOrder tells the query-builder to eager-load the nodes that are connected to
+// the "payment_order" edge. The optional arguments are used to configure the query builder of the edge.
+func (rdq *ReconciliationDiscrepancyQuery) WithPaymentOrder(opts ...func(*PaymentOrderQuery)) *ReconciliationDiscrepancyQuery {
+	query := (&PaymentOrderClient{config: rdq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rdq.withPaymentOrder = query
+	return rdq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Type reconciliationdiscrepancy.Type `json:"type,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ReconciliationDiscrepancy.Query().
+//		GroupBy(reconciliationdiscrepancy.FieldType).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rdq *ReconciliationDiscrepancyQuery) GroupBy(field string, fields ...string) *ReconciliationDiscrepancyGroupBy {
+	rdq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ReconciliationDiscrepancyGroupBy{build: rdq}
+	grbuild.flds = &rdq.ctx.Fields
+	grbuild.label = reconciliationdiscrepancy.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Type reconciliationdiscrepancy.Type `json:"type,omitempty"`
+//	}
+//
+//	client.ReconciliationDiscrepancy.Query().
+//		Select(reconciliationdiscrepancy.FieldType).
+//		Scan(ctx, &v)
+func (rdq *ReconciliationDiscrepancyQuery) Select(fields ...string) *ReconciliationDiscrepancySelect {
+	rdq.ctx.Fields = append(rdq.ctx.Fields, fields...)
+	sbuild := &ReconciliationDiscrepancySelect{ReconciliationDiscrepancyQuery: rdq}
+	sbuild.label = reconciliationdiscrepancy.Label
+	sbuild.flds, sbuild.scan = &rdq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ReconciliationDiscrepancySelect configured with the given aggregations.
+func (rdq *ReconciliationDiscrepancyQuery) Aggregate(fns ...AggregateFunc) *ReconciliationDiscrepancySelect {
+	return rdq.Select().Aggregate(fns...)
+}
+
+func (rdq *ReconciliationDiscrepancyQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range rdq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rdq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range rdq.ctx.Fields {
+		if !reconciliationdiscrepancy.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rdq.path != nil {
+		prev, err := rdq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rdq.sql = prev
+	}
+	return nil
+}
+
+func (rdq *ReconciliationDiscrepancyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ReconciliationDiscrepancy, error) {
+	var (
+		nodes       = []*ReconciliationDiscrepancy{}
+		withFKs     = rdq.withFKs
+		_spec       = rdq.querySpec()
+		loadedTypes = [2]bool{
+			rdq.withReport != nil,
+			rdq.withPaymentOrder != nil,
+		}
+	)
+	if rdq.withReport != nil || rdq.withPaymentOrder != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, reconciliationdiscrepancy.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ReconciliationDiscrepancy).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ReconciliationDiscrepancy{config: rdq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rdq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := rdq.withReport; query != nil {
+		if err := rdq.loadReport(ctx, query, nodes, nil,
+			func(n *ReconciliationDiscrepancy, e *ReconciliationReport) { n.Edges.Report = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := rdq.withPaymentOrder; query != nil {
+		if err := rdq.loadPaymentOrder(ctx, query, nodes, nil,
+			func(n *ReconciliationDiscrepancy, e *PaymentOrder) { n.Edges.PaymentOrder = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (rdq *ReconciliationDiscrepancyQuery) loadReport(ctx context.Context, query *ReconciliationReportQuery, nodes []*ReconciliationDiscrepancy, init func(*ReconciliationDiscrepancy), assign func(*ReconciliationDiscrepancy, *ReconciliationReport)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ReconciliationDiscrepancy)
+	for i := range nodes {
+		if nodes[i].reconciliation_report_discrepancies == nil {
+			continue
+		}
+		fk := *nodes[i].reconciliation_report_discrepancies
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(reconciliationreport.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "reconciliation_report_discrepancies" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (rdq *ReconciliationDiscrepancyQuery) loadPaymentOrder(ctx context.Context, query *PaymentOrderQuery, nodes []*ReconciliationDiscrepancy, init func(*ReconciliationDiscrepancy), assign func(*ReconciliationDiscrepancy, *PaymentOrder)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ReconciliationDiscrepancy)
+	for i := range nodes {
+		if nodes[i].payment_order_reconciliation_discrepancies == nil {
+			continue
+		}
+		fk := *nodes[i].payment_order_reconciliation_discrepancies
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(paymentorder.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "payment_order_reconciliation_discrepancies" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (rdq *ReconciliationDiscrepancyQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rdq.querySpec()
+	_spec.Node.Columns = rdq.ctx.Fields
+	if len(rdq.ctx.Fields) > 0 {
+		_spec.Unique = rdq.ctx.Unique != nil && *rdq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, rdq.driver, _spec)
+}
+
+func (rdq *ReconciliationDiscrepancyQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(reconciliationdiscrepancy.Table, reconciliationdiscrepancy.Columns, sqlgraph.NewFieldSpec(reconciliationdiscrepancy.FieldID, field.TypeUUID))
+	_spec.From = rdq.sql
+	if unique := rdq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if rdq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := rdq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reconciliationdiscrepancy.FieldID)
+		for i := range fields {
+			if fields[i] != reconciliationdiscrepancy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := rdq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rdq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rdq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rdq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rdq *ReconciliationDiscrepancyQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rdq.driver.Dialect())
+	t1 := builder.Table(reconciliationdiscrepancy.Table)
+	columns := rdq.ctx.Fields
+	if len(columns) == 0 {
+		columns = reconciliationdiscrepancy.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rdq.sql != nil {
+		selector = rdq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rdq.ctx.Unique != nil && *rdq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range rdq.predicates {
+		p(selector)
+	}
+	for _, p := range rdq.order {
+		p(selector)
+	}
+	if offset := rdq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rdq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ReconciliationDiscrepancyGroupBy is the group-by builder for ReconciliationDiscrepancy entities.
+type ReconciliationDiscrepancyGroupBy struct {
+	selector
+	build *ReconciliationDiscrepancyQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rdgb *ReconciliationDiscrepancyGroupBy) Aggregate(fns ...AggregateFunc) *ReconciliationDiscrepancyGroupBy {
+	rdgb.fns = append(rdgb.fns, fns...)
+	return rdgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rdgb *ReconciliationDiscrepancyGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rdgb.build.ctx, ent.OpQueryGroupBy)
+	if err := rdgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ReconciliationDiscrepancyQuery, *ReconciliationDiscrepancyGroupBy](ctx, rdgb.build, rdgb, rdgb.build.inters, v)
+}
+
+func (rdgb *ReconciliationDiscrepancyGroupBy) sqlScan(ctx context.Context, root *ReconciliationDiscrepancyQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(rdgb.fns))
+	for _, fn := range rdgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*rdgb.flds)+len(rdgb.fns))
+		for _, f := range *rdgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*rdgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rdgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ReconciliationDiscrepancySelect is the builder for selecting fields of ReconciliationDiscrepancy entities.
+type ReconciliationDiscrepancySelect struct {
+	*ReconciliationDiscrepancyQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (rds *ReconciliationDiscrepancySelect) Aggregate(fns ...AggregateFunc) *ReconciliationDiscrepancySelect {
+	rds.fns = append(rds.fns, fns...)
+	return rds
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rds *ReconciliationDiscrepancySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rds.ctx, ent.OpQuerySelect)
+	if err := rds.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ReconciliationDiscrepancyQuery, *ReconciliationDiscrepancySelect](ctx, rds.ReconciliationDiscrepancyQuery, rds, rds.inters, v)
+}
+
+func (rds *ReconciliationDiscrepancySelect) sqlScan(ctx context.Context, root *ReconciliationDiscrepancyQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(rds.fns))
+	for _, fn := range rds.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*rds.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rds.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
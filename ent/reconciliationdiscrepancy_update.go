@@ -0,0 +1,771 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/NEDA-LABS/stablenode/ent/reconciliationdiscrepancy"
+	"github.com/NEDA-LABS/stablenode/ent/reconciliationreport"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ReconciliationDiscrepancyUpdate is the builder for updating ReconciliationDiscrepancy entities.
+type ReconciliationDiscrepancyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReconciliationDiscrepancyMutation
+}
+
+// Where appends a list predicates to the ReconciliationDiscrepancyUpdate builder.
+func (rdu *ReconciliationDiscrepancyUpdate) Where(ps ...predicate.ReconciliationDiscrepancy) *ReconciliationDiscrepancyUpdate {
+	rdu.mutation.Where(ps...)
+	return rdu
+}
+
+// SetType sets the "type" field.
+func (rdu *ReconciliationDiscrepancyUpdate) SetType(r reconciliationdiscrepancy.Type) *ReconciliationDiscrepancyUpdate {
+	rdu.mutation.SetType(r)
+	return rdu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (rdu *ReconciliationDiscrepancyUpdate) SetNillableType(r *reconciliationdiscrepancy.Type) *ReconciliationDiscrepancyUpdate {
+	if r != nil {
+		rdu.SetType(*r)
+	}
+	return rdu
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (rdu *ReconciliationDiscrepancyUpdate) SetTxHash(s string) *ReconciliationDiscrepancyUpdate {
+	rdu.mutation.SetTxHash(s)
+	return rdu
+}
+
+// SetNillableTxHash sets the "tx_hash" field if the given value is not nil.
+func (rdu *ReconciliationDiscrepancyUpdate) SetNillableTxHash(s *string) *ReconciliationDiscrepancyUpdate {
+	if s != nil {
+		rdu.SetTxHash(*s)
+	}
+	return rdu
+}
+
+// SetAddress sets the "address" field.
+func (rdu *ReconciliationDiscrepancyUpdate) SetAddress(s string) *ReconciliationDiscrepancyUpdate {
+	rdu.mutation.SetAddress(s)
+	return rdu
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (rdu *ReconciliationDiscrepancyUpdate) SetNillableAddress(s *string) *ReconciliationDiscrepancyUpdate {
+	if s != nil {
+		rdu.SetAddress(*s)
+	}
+	return rdu
+}
+
+// SetToken sets the "token" field.
+func (rdu *ReconciliationDiscrepancyUpdate) SetToken(s string) *ReconciliationDiscrepancyUpdate {
+	rdu.mutation.SetToken(s)
+	return rdu
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (rdu *ReconciliationDiscrepancyUpdate) SetNillableToken(s *string) *ReconciliationDiscrepancyUpdate {
+	if s != nil {
+		rdu.SetToken(*s)
+	}
+	return rdu
+}
+
+// SetFromAddress sets the "from_address" field.
+func (rdu *ReconciliationDiscrepancyUpdate) SetFromAddress(s string) *ReconciliationDiscrepancyUpdate {
+	rdu.mutation.SetFromAddress(s)
+	return rdu
+}
+
+// SetNillableFromAddress sets the "from_address" field if the given value is not nil.
+func (rdu *ReconciliationDiscrepancyUpdate) SetNillableFromAddress(s *string) *ReconciliationDiscrepancyUpdate {
+	if s != nil {
+		rdu.SetFromAddress(*s)
+	}
+	return rdu
+}
+
+// ClearFromAddress clears the value of the "from_address" field.
+func (rdu *ReconciliationDiscrepancyUpdate) ClearFromAddress() *ReconciliationDiscrepancyUpdate {
+	rdu.mutation.ClearFromAddress()
+	return rdu
+}
+
+// SetBlockNumber sets the "block_number" field.
+func (rdu *ReconciliationDiscrepancyUpdate) SetBlockNumber(i int64) *ReconciliationDiscrepancyUpdate {
+	rdu.mutation.ResetBlockNumber()
+	rdu.mutation.SetBlockNumber(i)
+	return rdu
+}
+
+// SetNillableBlockNumber sets the "block_number" field if the given value is not nil.
+func (rdu *ReconciliationDiscrepancyUpdate) SetNillableBlockNumber(i *int64) *ReconciliationDiscrepancyUpdate {
+	if i != nil {
+		rdu.SetBlockNumber(*i)
+	}
+	return rdu
+}
+
+// AddBlockNumber adds i to the "block_number" field.
+func (rdu *ReconciliationDiscrepancyUpdate) AddBlockNumber(i int64) *ReconciliationDiscrepancyUpdate {
+	rdu.mutation.AddBlockNumber(i)
+	return rdu
+}
+
+// SetOnchainAmount sets the "onchain_amount" field.
+func (rdu *ReconciliationDiscrepancyUpdate) SetOnchainAmount(d decimal.Decimal) *ReconciliationDiscrepancyUpdate {
+	rdu.mutation.ResetOnchainAmount()
+	rdu.mutation.SetOnchainAmount(d)
+	return rdu
+}
+
+// SetNillableOnchainAmount sets the "onchain_amount" field if the given value is not nil.
+func (rdu *ReconciliationDiscrepancyUpdate) SetNillableOnchainAmount(d *decimal.Decimal) *ReconciliationDiscrepancyUpdate {
+	if d != nil {
+		rdu.SetOnchainAmount(*d)
+	}
+	return rdu
+}
+
+// AddOnchainAmount adds d to the "onchain_amount" field.
+func (rdu *ReconciliationDiscrepancyUpdate) AddOnchainAmount(d decimal.Decimal) *ReconciliationDiscrepancyUpdate {
+	rdu.mutation.AddOnchainAmount(d)
+	return rdu
+}
+
+// SetRecordedAmount sets the "recorded_amount" field.
+func (rdu *ReconciliationDiscrepancyUpdate) SetRecordedAmount(d decimal.Decimal) *ReconciliationDiscrepancyUpdate {
+	rdu.mutation.ResetRecordedAmount()
+	rdu.mutation.SetRecordedAmount(d)
+	return rdu
+}
+
+// SetNillableRecordedAmount sets the "recorded_amount" field if the given value is not nil.
+func (rdu *ReconciliationDiscrepancyUpdate) SetNillableRecordedAmount(d *decimal.Decimal) *ReconciliationDiscrepancyUpdate {
+	if d != nil {
+		rdu.SetRecordedAmount(*d)
+	}
+	return rdu
+}
+
+// AddRecordedAmount adds d to the "recorded_amount" field.
+func (rdu *ReconciliationDiscrepancyUpdate) AddRecordedAmount(d decimal.Decimal) *ReconciliationDiscrepancyUpdate {
+	rdu.mutation.AddRecordedAmount(d)
+	return rdu
+}
+
+// SetReportID sets the "report" edge to the ReconciliationReport entity by ID.
+func (rdu *ReconciliationDiscrepancyUpdate) SetReportID(id uuid.UUID) *ReconciliationDiscrepancyUpdate {
+	rdu.mutation.SetReportID(id)
+	return rdu
+}
+
+// SetReport sets the "report" edge to the ReconciliationReport entity.
+func (rdu *ReconciliationDiscrepancyUpdate) SetReport(r *ReconciliationReport) *ReconciliationDiscrepancyUpdate {
+	return rdu.SetReportID(r.ID)
+}
+
+// SetPaymentOrderID sets the "payment_order" edge to the PaymentOrder entity by ID.
+func (rdu *ReconciliationDiscrepancyUpdate) SetPaymentOrderID(id uuid.UUID) *ReconciliationDiscrepancyUpdate {
+	rdu.mutation.SetPaymentOrderID(id)
+	return rdu
+}
+
+// SetNillablePaymentOrderID sets the "payment_order" edge to the PaymentOrder entity by ID if the given value is not nil.
+func (rdu *ReconciliationDiscrepancyUpdate) SetNillablePaymentOrderID(id *uuid.UUID) *ReconciliationDiscrepancyUpdate {
+	if id != nil {
+		rdu = rdu.SetPaymentOrderID(*id)
+	}
+	return rdu
+}
+
+// SetPaymentOrder sets the "payment_order" edge to the PaymentOrder entity.
+func (rdu *ReconciliationDiscrepancyUpdate) SetPaymentOrder(p *PaymentOrder) *ReconciliationDiscrepancyUpdate {
+	return rdu.SetPaymentOrderID(p.ID)
+}
+
+// Mutation returns the ReconciliationDiscrepancyMutation object of the builder.
+func (rdu *ReconciliationDiscrepancyUpdate) Mutation() *ReconciliationDiscrepancyMutation {
+	return rdu.mutation
+}
+
+// ClearReport clears the "report" edge to the ReconciliationReport entity.
+func (rdu *ReconciliationDiscrepancyUpdate) ClearReport() *ReconciliationDiscrepancyUpdate {
+	rdu.mutation.ClearReport()
+	return rdu
+}
+
+// ClearPaymentOrder clears the "payment_order" edge to the PaymentOrder entity.
+func (rdu *ReconciliationDiscrepancyUpdate) ClearPaymentOrder() *ReconciliationDiscrepancyUpdate {
+	rdu.mutation.ClearPaymentOrder()
+	return rdu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rdu *ReconciliationDiscrepancyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, rdu.sqlSave, rdu.mutation, rdu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rdu *ReconciliationDiscrepancyUpdate) SaveX(ctx context.Context) int {
+	affected, err := rdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rdu *ReconciliationDiscrepancyUpdate) Exec(ctx context.Context) error {
+	_, err := rdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rdu *ReconciliationDiscrepancyUpdate) ExecX(ctx context.Context) {
+	if err := rdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rdu *ReconciliationDiscrepancyUpdate) check() error {
+	if v, ok := rdu.mutation.GetType(); ok {
+		if err := reconciliationdiscrepancy.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "ReconciliationDiscrepancy.type": %w`, err)}
+		}
+	}
+	if v, ok := rdu.mutation.TxHash(); ok {
+		if err := reconciliationdiscrepancy.TxHashValidator(v); err != nil {
+			return &ValidationError{Name: "tx_hash", err: fmt.Errorf(`ent: validator failed for field "ReconciliationDiscrepancy.tx_hash": %w`, err)}
+		}
+	}
+	if rdu.mutation.ReportCleared() && len(rdu.mutation.ReportIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReconciliationDiscrepancy.report"`)
+	}
+	return nil
+}
+
+func (rdu *ReconciliationDiscrepancyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rdu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reconciliationdiscrepancy.Table, reconciliationdiscrepancy.Columns, sqlgraph.NewFieldSpec(reconciliationdiscrepancy.FieldID, field.TypeUUID))
+	if ps := rdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rdu.mutation.GetType(); ok {
+		_spec.SetField(reconciliationdiscrepancy.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := rdu.mutation.TxHash(); ok {
+		_spec.SetField(reconciliationdiscrepancy.FieldTxHash, field.TypeString, value)
+	}
+	if value, ok := rdu.mutation.Address(); ok {
+		_spec.SetField(reconciliationdiscrepancy.FieldAddress, field.TypeString, value)
+	}
+	if value, ok := rdu.mutation.Token(); ok {
+		_spec.SetField(reconciliationdiscrepancy.FieldToken, field.TypeString, value)
+	}
+	if value, ok := rdu.mutation.FromAddress(); ok {
+		_spec.SetField(reconciliationdiscrepancy.FieldFromAddress, field.TypeString, value)
+	}
+	if rdu.mutation.FromAddressCleared() {
+		_spec.ClearField(reconciliationdiscrepancy.FieldFromAddress, field.TypeString)
+	}
+	if value, ok := rdu.mutation.BlockNumber(); ok {
+		_spec.SetField(reconciliationdiscrepancy.FieldBlockNumber, field.TypeInt64, value)
+	}
+	if value, ok := rdu.mutation.AddedBlockNumber(); ok {
+		_spec.AddField(reconciliationdiscrepancy.FieldBlockNumber, field.TypeInt64, value)
+	}
+	if value, ok := rdu.mutation.OnchainAmount(); ok {
+		_spec.SetField(reconciliationdiscrepancy.FieldOnchainAmount, field.TypeFloat64, value)
+	}
+	if value, ok := rdu.mutation.AddedOnchainAmount(); ok {
+		_spec.AddField(reconciliationdiscrepancy.FieldOnchainAmount, field.TypeFloat64, value)
+	}
+	if value, ok := rdu.mutation.RecordedAmount(); ok {
+		_spec.SetField(reconciliationdiscrepancy.FieldRecordedAmount, field.TypeFloat64, value)
+	}
+	if value, ok := rdu.mutation.AddedRecordedAmount(); ok {
+		_spec.AddField(reconciliationdiscrepancy.FieldRecordedAmount, field.TypeFloat64, value)
+	}
+	if rdu.mutation.ReportCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reconciliationdiscrepancy.ReportTable,
+			Columns: []string{reconciliationdiscrepancy.ReportColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reconciliationreport.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rdu.mutation.ReportIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reconciliationdiscrepancy.ReportTable,
+			Columns: []string{reconciliationdiscrepancy.ReportColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reconciliationreport.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if rdu.mutation.PaymentOrderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reconciliationdiscrepancy.PaymentOrderTable,
+			Columns: []string{reconciliationdiscrepancy.PaymentOrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rdu.mutation.PaymentOrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reconciliationdiscrepancy.PaymentOrderTable,
+			Columns: []string{reconciliationdiscrepancy.PaymentOrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reconciliationdiscrepancy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rdu.mutation.done = true
+	return n, nil
+}
+
+// ReconciliationDiscrepancyUpdateOne is the builder for updating a single ReconciliationDiscrepancy entity.
+type ReconciliationDiscrepancyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReconciliationDiscrepancyMutation
+}
+
+// SetType sets the "type" field.
+func (rduo *ReconciliationDiscrepancyUpdateOne) SetType(r reconciliationdiscrepancy.Type) *ReconciliationDiscrepancyUpdateOne {
+	rduo.mutation.SetType(r)
+	return rduo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (rduo *ReconciliationDiscrepancyUpdateOne) SetNillableType(r *reconciliationdiscrepancy.Type) *ReconciliationDiscrepancyUpdateOne {
+	if r != nil {
+		rduo.SetType(*r)
+	}
+	return rduo
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (rduo *ReconciliationDiscrepancyUpdateOne) SetTxHash(s string) *ReconciliationDiscrepancyUpdateOne {
+	rduo.mutation.SetTxHash(s)
+	return rduo
+}
+
+// SetNillableTxHash sets the "tx_hash" field if the given value is not nil.
+func (rduo *ReconciliationDiscrepancyUpdateOne) SetNillableTxHash(s *string) *ReconciliationDiscrepancyUpdateOne {
+	if s != nil {
+		rduo.SetTxHash(*s)
+	}
+	return rduo
+}
+
+// SetAddress sets the "address" field.
+func (rduo *ReconciliationDiscrepancyUpdateOne) SetAddress(s string) *ReconciliationDiscrepancyUpdateOne {
+	rduo.mutation.SetAddress(s)
+	return rduo
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (rduo *ReconciliationDiscrepancyUpdateOne) SetNillableAddress(s *string) *ReconciliationDiscrepancyUpdateOne {
+	if s != nil {
+		rduo.SetAddress(*s)
+	}
+	return rduo
+}
+
+// SetToken sets the "token" field.
+func (rduo *ReconciliationDiscrepancyUpdateOne) SetToken(s string) *ReconciliationDiscrepancyUpdateOne {
+	rduo.mutation.SetToken(s)
+	return rduo
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (rduo *ReconciliationDiscrepancyUpdateOne) SetNillableToken(s *string) *ReconciliationDiscrepancyUpdateOne {
+	if s != nil {
+		rduo.SetToken(*s)
+	}
+	return rduo
+}
+
+// SetFromAddress sets the "from_address" field.
+func (rduo *ReconciliationDiscrepancyUpdateOne) SetFromAddress(s string) *ReconciliationDiscrepancyUpdateOne {
+	rduo.mutation.SetFromAddress(s)
+	return rduo
+}
+
+// SetNillableFromAddress sets the "from_address" field if the given value is not nil.
+func (rduo *ReconciliationDiscrepancyUpdateOne) SetNillableFromAddress(s *string) *ReconciliationDiscrepancyUpdateOne {
+	if s != nil {
+		rduo.SetFromAddress(*s)
+	}
+	return rduo
+}
+
+// ClearFromAddress clears the value of the "from_address" field.
+func (rduo *ReconciliationDiscrepancyUpdateOne) ClearFromAddress() *ReconciliationDiscrepancyUpdateOne {
+	rduo.mutation.ClearFromAddress()
+	return rduo
+}
+
+// SetBlockNumber sets the "block_number" field.
+func (rduo *ReconciliationDiscrepancyUpdateOne) SetBlockNumber(i int64) *ReconciliationDiscrepancyUpdateOne {
+	rduo.mutation.ResetBlockNumber()
+	rduo.mutation.SetBlockNumber(i)
+	return rduo
+}
+
+// SetNillableBlockNumber sets the "block_number" field if the given value is not nil.
+func (rduo *ReconciliationDiscrepancyUpdateOne) SetNillableBlockNumber(i *int64) *ReconciliationDiscrepancyUpdateOne {
+	if i != nil {
+		rduo.SetBlockNumber(*i)
+	}
+	return rduo
+}
+
+// AddBlockNumber adds i to the "block_number" field.
+func (rduo *ReconciliationDiscrepancyUpdateOne) AddBlockNumber(i int64) *ReconciliationDiscrepancyUpdateOne {
+	rduo.mutation.AddBlockNumber(i)
+	return rduo
+}
+
+// SetOnchainAmount sets the "onchain_amount" field.
+func (rduo *ReconciliationDiscrepancyUpdateOne) SetOnchainAmount(d decimal.Decimal) *ReconciliationDiscrepancyUpdateOne {
+	rduo.mutation.ResetOnchainAmount()
+	rduo.mutation.SetOnchainAmount(d)
+	return rduo
+}
+
+// SetNillableOnchainAmount sets the "onchain_amount" field if the given value is not nil.
+func (rduo *ReconciliationDiscrepancyUpdateOne) SetNillableOnchainAmount(d *decimal.Decimal) *ReconciliationDiscrepancyUpdateOne {
+	if d != nil {
+		rduo.SetOnchainAmount(*d)
+	}
+	return rduo
+}
+
+// AddOnchainAmount adds d to the "onchain_amount" field.
+func (rduo *ReconciliationDiscrepancyUpdateOne) AddOnchainAmount(d decimal.Decimal) *ReconciliationDiscrepancyUpdateOne {
+	rduo.mutation.AddOnchainAmount(d)
+	return rduo
+}
+
+// SetRecordedAmount sets the "recorded_amount" field.
+func (rduo *ReconciliationDiscrepancyUpdateOne) SetRecordedAmount(d decimal.Decimal) *ReconciliationDiscrepancyUpdateOne {
+	rduo.mutation.ResetRecordedAmount()
+	rduo.mutation.SetRecordedAmount(d)
+	return rduo
+}
+
+// SetNillableRecordedAmount sets the "recorded_amount" field if the given value is not nil.
+func (rduo *ReconciliationDiscrepancyUpdateOne) SetNillableRecordedAmount(d *decimal.Decimal) *ReconciliationDiscrepancyUpdateOne {
+	if d != nil {
+		rduo.SetRecordedAmount(*d)
+	}
+	return rduo
+}
+
+// AddRecordedAmount adds d to the "recorded_amount" field.
+func (rduo *ReconciliationDiscrepancyUpdateOne) AddRecordedAmount(d decimal.Decimal) *ReconciliationDiscrepancyUpdateOne {
+	rduo.mutation.AddRecordedAmount(d)
+	return rduo
+}
+
+// SetReportID sets the "report" edge to the ReconciliationReport entity by ID.
+func (rduo *ReconciliationDiscrepancyUpdateOne) SetReportID(id uuid.UUID) *ReconciliationDiscrepancyUpdateOne {
+	rduo.mutation.SetReportID(id)
+	return rduo
+}
+
+// SetReport sets the "report" edge to the ReconciliationReport entity.
+func (rduo *ReconciliationDiscrepancyUpdateOne) SetReport(r *ReconciliationReport) *ReconciliationDiscrepancyUpdateOne {
+	return rduo.SetReportID(r.ID)
+}
+
+// SetPaymentOrderID sets the "payment_order" edge to the PaymentOrder entity by ID.
+func (rduo *ReconciliationDiscrepancyUpdateOne) SetPaymentOrderID(id uuid.UUID) *ReconciliationDiscrepancyUpdateOne {
+	rduo.mutation.SetPaymentOrderID(id)
+	return rduo
+}
+
+// SetNillablePaymentOrderID sets the "payment_order" edge to the PaymentOrder entity by ID if the given value is not nil.
+func (rduo *ReconciliationDiscrepancyUpdateOne) SetNillablePaymentOrderID(id *uuid.UUID) *ReconciliationDiscrepancyUpdateOne {
+	if id != nil {
+		rduo = rduo.SetPaymentOrderID(*id)
+	}
+	return rduo
+}
+
+// SetPaymentOrder sets the "payment_order" edge to the PaymentOrder entity.
+func (rduo *ReconciliationDiscrepancyUpdateOne) SetPaymentOrder(p *PaymentOrder) *ReconciliationDiscrepancyUpdateOne {
+	return rduo.SetPaymentOrderID(p.ID)
+}
+
+// Mutation returns the ReconciliationDiscrepancyMutation object of the builder.
+func (rduo *ReconciliationDiscrepancyUpdateOne) Mutation() *ReconciliationDiscrepancyMutation {
+	return rduo.mutation
+}
+
+// ClearReport clears the "report" edge to the ReconciliationReport entity.
+func (rduo *ReconciliationDiscrepancyUpdateOne) ClearReport() *ReconciliationDiscrepancyUpdateOne {
+	rduo.mutation.ClearReport()
+	return rduo
+}
+
+// ClearPaymentOrder clears the "payment_order" edge to the PaymentOrder entity.
+func (rduo *ReconciliationDiscrepancyUpdateOne) ClearPaymentOrder() *ReconciliationDiscrepancyUpdateOne {
+	rduo.mutation.ClearPaymentOrder()
+	return rduo
+}
+
+// Where appends a list predicates to the ReconciliationDiscrepancyUpdate builder.
+func (rduo *ReconciliationDiscrepancyUpdateOne) Where(ps ...predicate.ReconciliationDiscrepancy) *ReconciliationDiscrepancyUpdateOne {
+	rduo.mutation.Where(ps...)
+	return rduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rduo *ReconciliationDiscrepancyUpdateOne) Select(field string, fields ...string) *ReconciliationDiscrepancyUpdateOne {
+	rduo.fields = append([]string{field}, fields...)
+	return rduo
+}
+
+// Save executes the query and returns the updated ReconciliationDiscrepancy entity.
+func (rduo *ReconciliationDiscrepancyUpdateOne) Save(ctx context.Context) (*ReconciliationDiscrepancy, error) {
+	return withHooks(ctx, rduo.sqlSave, rduo.mutation, rduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rduo *ReconciliationDiscrepancyUpdateOne) SaveX(ctx context.Context) *ReconciliationDiscrepancy {
+	node, err := rduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rduo *ReconciliationDiscrepancyUpdateOne) Exec(ctx context.Context) error {
+	_, err := rduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rduo *ReconciliationDiscrepancyUpdateOne) ExecX(ctx context.Context) {
+	if err := rduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rduo *ReconciliationDiscrepancyUpdateOne) check() error {
+	if v, ok := rduo.mutation.GetType(); ok {
+		if err := reconciliationdiscrepancy.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "ReconciliationDiscrepancy.type": %w`, err)}
+		}
+	}
+	if v, ok := rduo.mutation.TxHash(); ok {
+		if err := reconciliationdiscrepancy.TxHashValidator(v); err != nil {
+			return &ValidationError{Name: "tx_hash", err: fmt.Errorf(`ent: validator failed for field "ReconciliationDiscrepancy.tx_hash": %w`, err)}
+		}
+	}
+	if rduo.mutation.ReportCleared() && len(rduo.mutation.ReportIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReconciliationDiscrepancy.report"`)
+	}
+	return nil
+}
+
+func (rduo *ReconciliationDiscrepancyUpdateOne) sqlSave(ctx context.Context) (_node *ReconciliationDiscrepancy, err error) {
+	if err := rduo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reconciliationdiscrepancy.Table, reconciliationdiscrepancy.Columns, sqlgraph.NewFieldSpec(reconciliationdiscrepancy.FieldID, field.TypeUUID))
+	id, ok := rduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ReconciliationDiscrepancy.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reconciliationdiscrepancy.FieldID)
+		for _, f := range fields {
+			if !reconciliationdiscrepancy.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != reconciliationdiscrepancy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rduo.mutation.GetType(); ok {
+		_spec.SetField(reconciliationdiscrepancy.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := rduo.mutation.TxHash(); ok {
+		_spec.SetField(reconciliationdiscrepancy.FieldTxHash, field.TypeString, value)
+	}
+	if value, ok := rduo.mutation.Address(); ok {
+		_spec.SetField(reconciliationdiscrepancy.FieldAddress, field.TypeString, value)
+	}
+	if value, ok := rduo.mutation.Token(); ok {
+		_spec.SetField(reconciliationdiscrepancy.FieldToken, field.TypeString, value)
+	}
+	if value, ok := rduo.mutation.FromAddress(); ok {
+		_spec.SetField(reconciliationdiscrepancy.FieldFromAddress, field.TypeString, value)
+	}
+	if rduo.mutation.FromAddressCleared() {
+		_spec.ClearField(reconciliationdiscrepancy.FieldFromAddress, field.TypeString)
+	}
+	if value, ok := rduo.mutation.BlockNumber(); ok {
+		_spec.SetField(reconciliationdiscrepancy.FieldBlockNumber, field.TypeInt64, value)
+	}
+	if value, ok := rduo.mutation.AddedBlockNumber(); ok {
+		_spec.AddField(reconciliationdiscrepancy.FieldBlockNumber, field.TypeInt64, value)
+	}
+	if value, ok := rduo.mutation.OnchainAmount(); ok {
+		_spec.SetField(reconciliationdiscrepancy.FieldOnchainAmount, field.TypeFloat64, value)
+	}
+	if value, ok := rduo.mutation.AddedOnchainAmount(); ok {
+		_spec.AddField(reconciliationdiscrepancy.FieldOnchainAmount, field.TypeFloat64, value)
+	}
+	if value, ok := rduo.mutation.RecordedAmount(); ok {
+		_spec.SetField(reconciliationdiscrepancy.FieldRecordedAmount, field.TypeFloat64, value)
+	}
+	if value, ok := rduo.mutation.AddedRecordedAmount(); ok {
+		_spec.AddField(reconciliationdiscrepancy.FieldRecordedAmount, field.TypeFloat64, value)
+	}
+	if rduo.mutation.ReportCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reconciliationdiscrepancy.ReportTable,
+			Columns: []string{reconciliationdiscrepancy.ReportColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reconciliationreport.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rduo.mutation.ReportIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reconciliationdiscrepancy.ReportTable,
+			Columns: []string{reconciliationdiscrepancy.ReportColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reconciliationreport.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if rduo.mutation.PaymentOrderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reconciliationdiscrepancy.PaymentOrderTable,
+			Columns: []string{reconciliationdiscrepancy.PaymentOrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rduo.mutation.PaymentOrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reconciliationdiscrepancy.PaymentOrderTable,
+			Columns: []string{reconciliationdiscrepancy.PaymentOrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ReconciliationDiscrepancy{config: rduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reconciliationdiscrepancy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rduo.mutation.done = true
+	return _node, nil
+}
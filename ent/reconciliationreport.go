@@ -0,0 +1,245 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/NEDA-LABS/stablenode/ent/reconciliationreport"
+	"github.com/google/uuid"
+)
+
+// ReconciliationReport is the model entity for the ReconciliationReport schema.
+type ReconciliationReport struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Network holds the value of the "network" field.
+	Network string `json:"network,omitempty"`
+	// Day holds the value of the "day" field.
+	Day time.Time `json:"day,omitempty"`
+	// FromBlock holds the value of the "from_block" field.
+	FromBlock int64 `json:"from_block,omitempty"`
+	// ToBlock holds the value of the "to_block" field.
+	ToBlock int64 `json:"to_block,omitempty"`
+	// Status holds the value of the "status" field.
+	Status reconciliationreport.Status `json:"status,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// AddressesChecked holds the value of the "addresses_checked" field.
+	AddressesChecked int `json:"addresses_checked,omitempty"`
+	// TransfersChecked holds the value of the "transfers_checked" field.
+	TransfersChecked int `json:"transfers_checked,omitempty"`
+	// DiscrepancyCount holds the value of the "discrepancy_count" field.
+	DiscrepancyCount int `json:"discrepancy_count,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReconciliationReportQuery when eager-loading is set.
+	Edges        ReconciliationReportEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ReconciliationReportEdges holds the relations/edges for other nodes in the graph.
+type ReconciliationReportEdges struct {
+	// Discrepancies holds the value of the discrepancies edge.
+	Discrepancies []*ReconciliationDiscrepancy `json:"discrepancies,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// DiscrepanciesOrErr returns the Discrepancies value or an error if the edge
+// was not loaded in eager-loading.
+func (e ReconciliationReportEdges) DiscrepanciesOrErr() ([]*ReconciliationDiscrepancy, error) {
+	if e.loadedTypes[0] {
+		return e.Discrepancies, nil
+	}
+	return nil, &NotLoadedError{edge: "discrepancies"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ReconciliationReport) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case reconciliationreport.FieldFromBlock, reconciliationreport.FieldToBlock, reconciliationreport.FieldAddressesChecked, reconciliationreport.FieldTransfersChecked, reconciliationreport.FieldDiscrepancyCount:
+			values[i] = new(sql.NullInt64)
+		case reconciliationreport.FieldNetwork, reconciliationreport.FieldStatus, reconciliationreport.FieldError:
+			values[i] = new(sql.NullString)
+		case reconciliationreport.FieldCreatedAt, reconciliationreport.FieldUpdatedAt, reconciliationreport.FieldDay:
+			values[i] = new(sql.NullTime)
+		case reconciliationreport.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ReconciliationReport fields.
+func (rr *ReconciliationReport) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case reconciliationreport.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				rr.ID = *value
+			}
+		case reconciliationreport.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				rr.CreatedAt = value.Time
+			}
+		case reconciliationreport.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				rr.UpdatedAt = value.Time
+			}
+		case reconciliationreport.FieldNetwork:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field network", values[i])
+			} else if value.Valid {
+				rr.Network = value.String
+			}
+		case reconciliationreport.FieldDay:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field day", values[i])
+			} else if value.Valid {
+				rr.Day = value.Time
+			}
+		case reconciliationreport.FieldFromBlock:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field from_block", values[i])
+			} else if value.Valid {
+				rr.FromBlock = value.Int64
+			}
+		case reconciliationreport.FieldToBlock:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field to_block", values[i])
+			} else if value.Valid {
+				rr.ToBlock = value.Int64
+			}
+		case reconciliationreport.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				rr.Status = reconciliationreport.Status(value.String)
+			}
+		case reconciliationreport.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				rr.Error = value.String
+			}
+		case reconciliationreport.FieldAddressesChecked:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field addresses_checked", values[i])
+			} else if value.Valid {
+				rr.AddressesChecked = int(value.Int64)
+			}
+		case reconciliationreport.FieldTransfersChecked:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field transfers_checked", values[i])
+			} else if value.Valid {
+				rr.TransfersChecked = int(value.Int64)
+			}
+		case reconciliationreport.FieldDiscrepancyCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field discrepancy_count", values[i])
+			} else if value.Valid {
+				rr.DiscrepancyCount = int(value.Int64)
+			}
+		default:
+			rr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ReconciliationReport.
+// This includes values selected through modifiers, order, etc.
+func (rr *ReconciliationReport) Value(name string) (ent.Value, error) {
+	return rr.selectValues.Get(name)
+}
+
+// QueryDiscrepancies queries the "discrepancies" edge of the ReconciliationReport entity.
+func (rr *ReconciliationReport) QueryDiscrepancies() *ReconciliationDiscrepancyQuery {
+	return NewReconciliationReportClient(rr.config).QueryDiscrepancies(rr)
+}
+
+// Update returns a builder for updating this ReconciliationReport.
+// Note that you need to call ReconciliationReport.Unwrap() before calling this method if this ReconciliationReport
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rr *ReconciliationReport) Update() *ReconciliationReportUpdateOne {
+	return NewReconciliationReportClient(rr.config).UpdateOne(rr)
+}
+
+// Unwrap unwraps the ReconciliationReport entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rr *ReconciliationReport) Unwrap() *ReconciliationReport {
+	_tx, ok := rr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ReconciliationReport is not a transactional entity")
+	}
+	rr.config.driver = _tx.drv
+	return rr
+}
+
+// String implements the fmt.Stringer.
+func (rr *ReconciliationReport) String() string {
+	var builder strings.Builder
+	builder.WriteString("ReconciliationReport(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rr.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(rr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(rr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("network=")
+	builder.WriteString(rr.Network)
+	builder.WriteString(", ")
+	builder.WriteString("day=")
+	builder.WriteString(rr.Day.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("from_block=")
+	builder.WriteString(fmt.Sprintf("%v", rr.FromBlock))
+	builder.WriteString(", ")
+	builder.WriteString("to_block=")
+	builder.WriteString(fmt.Sprintf("%v", rr.ToBlock))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", rr.Status))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(rr.Error)
+	builder.WriteString(", ")
+	builder.WriteString("addresses_checked=")
+	builder.WriteString(fmt.Sprintf("%v", rr.AddressesChecked))
+	builder.WriteString(", ")
+	builder.WriteString("transfers_checked=")
+	builder.WriteString(fmt.Sprintf("%v", rr.TransfersChecked))
+	builder.WriteString(", ")
+	builder.WriteString("discrepancy_count=")
+	builder.WriteString(fmt.Sprintf("%v", rr.DiscrepancyCount))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ReconciliationReports is a parsable slice of ReconciliationReport.
+type ReconciliationReports []*ReconciliationReport
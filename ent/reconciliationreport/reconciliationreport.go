@@ -0,0 +1,206 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reconciliationreport
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the reconciliationreport type in the database.
+	Label = "reconciliation_report"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldNetwork holds the string denoting the network field in the database.
+	FieldNetwork = "network"
+	// FieldDay holds the string denoting the day field in the database.
+	FieldDay = "day"
+	// FieldFromBlock holds the string denoting the from_block field in the database.
+	FieldFromBlock = "from_block"
+	// FieldToBlock holds the string denoting the to_block field in the database.
+	FieldToBlock = "to_block"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldAddressesChecked holds the string denoting the addresses_checked field in the database.
+	FieldAddressesChecked = "addresses_checked"
+	// FieldTransfersChecked holds the string denoting the transfers_checked field in the database.
+	FieldTransfersChecked = "transfers_checked"
+	// FieldDiscrepancyCount holds the string denoting the discrepancy_count field in the database.
+	FieldDiscrepancyCount = "discrepancy_count"
+	// EdgeDiscrepancies holds the string denoting the discrepancies edge name in mutations.
+	EdgeDiscrepancies = "discrepancies"
+	// Table holds the table name of the reconciliationreport in the database.
+	Table = "reconciliation_reports"
+	// DiscrepanciesTable is the table that holds the discrepancies relation/edge.
+	DiscrepanciesTable = "reconciliation_discrepancies"
+	// DiscrepanciesInverseTable is the table name for the ReconciliationDiscrepancy entity.
+	// It exists in this package in order to avoid circular dependency with the "reconciliationdiscrepancy" package.
+	DiscrepanciesInverseTable = "reconciliation_discrepancies"
+	// DiscrepanciesColumn is the table column denoting the discrepancies relation/edge.
+	DiscrepanciesColumn = "reconciliation_report_discrepancies"
+)
+
+// Columns holds all SQL columns for reconciliationreport fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldNetwork,
+	FieldDay,
+	FieldFromBlock,
+	FieldToBlock,
+	FieldStatus,
+	FieldError,
+	FieldAddressesChecked,
+	FieldTransfersChecked,
+	FieldDiscrepancyCount,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultFromBlock holds the default value on creation for the "from_block" field.
+	DefaultFromBlock int64
+	// DefaultToBlock holds the default value on creation for the "to_block" field.
+	DefaultToBlock int64
+	// DefaultAddressesChecked holds the default value on creation for the "addresses_checked" field.
+	DefaultAddressesChecked int
+	// DefaultTransfersChecked holds the default value on creation for the "transfers_checked" field.
+	DefaultTransfersChecked int
+	// DefaultDiscrepancyCount holds the default value on creation for the "discrepancy_count" field.
+	DefaultDiscrepancyCount int
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// Status values.
+const (
+	StatusCompleted Status = "completed"
+	StatusFailed    Status = "failed"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusCompleted, StatusFailed:
+		return nil
+	default:
+		return fmt.Errorf("reconciliationreport: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the ReconciliationReport queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByNetwork orders the results by the network field.
+func ByNetwork(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNetwork, opts...).ToFunc()
+}
+
+// ByDay orders the results by the day field.
+func ByDay(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDay, opts...).ToFunc()
+}
+
+// ByFromBlock orders the results by the from_block field.
+func ByFromBlock(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFromBlock, opts...).ToFunc()
+}
+
+// ByToBlock orders the results by the to_block field.
+func ByToBlock(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToBlock, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
+
+// ByAddressesChecked orders the results by the addresses_checked field.
+func ByAddressesChecked(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAddressesChecked, opts...).ToFunc()
+}
+
+// ByTransfersChecked orders the results by the transfers_checked field.
+func ByTransfersChecked(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTransfersChecked, opts...).ToFunc()
+}
+
+// ByDiscrepancyCount orders the results by the discrepancy_count field.
+func ByDiscrepancyCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiscrepancyCount, opts...).ToFunc()
+}
+
+// ByDiscrepanciesCount orders the results by discrepancies count.
+func ByDiscrepanciesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDiscrepanciesStep(), opts...)
+	}
+}
+
+// ByDiscrepancies orders the results by discrepancies terms.
+func ByDiscrepancies(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDiscrepanciesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newDiscrepanciesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DiscrepanciesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DiscrepanciesTable, DiscrepanciesColumn),
+	)
+}
@@ -0,0 +1,625 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reconciliationreport
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Network applies equality check predicate on the "network" field. It's identical to NetworkEQ.
+func Network(v string) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldEQ(FieldNetwork, v))
+}
+
+// Day applies equality check predicate on the "day" field. It's identical to DayEQ.
+func Day(v time.Time) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldEQ(FieldDay, v))
+}
+
+// FromBlock applies equality check predicate on the "from_block" field. It's identical to FromBlockEQ.
+func FromBlock(v int64) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldEQ(FieldFromBlock, v))
+}
+
+// ToBlock applies equality check predicate on the "to_block" field. It's identical to ToBlockEQ.
+func ToBlock(v int64) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldEQ(FieldToBlock, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldEQ(FieldError, v))
+}
+
+// AddressesChecked applies equality check predicate on the "addresses_checked" field. It's identical to AddressesCheckedEQ.
+func AddressesChecked(v int) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldEQ(FieldAddressesChecked, v))
+}
+
+// TransfersChecked applies equality check predicate on the "transfers_checked" field. It's identical to TransfersCheckedEQ.
+func TransfersChecked(v int) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldEQ(FieldTransfersChecked, v))
+}
+
+// DiscrepancyCount applies equality check predicate on the "discrepancy_count" field. It's identical to DiscrepancyCountEQ.
+func DiscrepancyCount(v int) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldEQ(FieldDiscrepancyCount, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// NetworkEQ applies the EQ predicate on the "network" field.
+func NetworkEQ(v string) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldEQ(FieldNetwork, v))
+}
+
+// NetworkNEQ applies the NEQ predicate on the "network" field.
+func NetworkNEQ(v string) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldNEQ(FieldNetwork, v))
+}
+
+// NetworkIn applies the In predicate on the "network" field.
+func NetworkIn(vs ...string) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldIn(FieldNetwork, vs...))
+}
+
+// NetworkNotIn applies the NotIn predicate on the "network" field.
+func NetworkNotIn(vs ...string) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldNotIn(FieldNetwork, vs...))
+}
+
+// NetworkGT applies the GT predicate on the "network" field.
+func NetworkGT(v string) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldGT(FieldNetwork, v))
+}
+
+// NetworkGTE applies the GTE predicate on the "network" field.
+func NetworkGTE(v string) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldGTE(FieldNetwork, v))
+}
+
+// NetworkLT applies the LT predicate on the "network" field.
+func NetworkLT(v string) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldLT(FieldNetwork, v))
+}
+
+// NetworkLTE applies the LTE predicate on the "network" field.
+func NetworkLTE(v string) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldLTE(FieldNetwork, v))
+}
+
+// NetworkContains applies the Contains predicate on the "network" field.
+func NetworkContains(v string) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldContains(FieldNetwork, v))
+}
+
+// NetworkHasPrefix applies the HasPrefix predicate on the "network" field.
+func NetworkHasPrefix(v string) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldHasPrefix(FieldNetwork, v))
+}
+
+// NetworkHasSuffix applies the HasSuffix predicate on the "network" field.
+func NetworkHasSuffix(v string) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldHasSuffix(FieldNetwork, v))
+}
+
+// NetworkEqualFold applies the EqualFold predicate on the "network" field.
+func NetworkEqualFold(v string) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldEqualFold(FieldNetwork, v))
+}
+
+// NetworkContainsFold applies the ContainsFold predicate on the "network" field.
+func NetworkContainsFold(v string) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldContainsFold(FieldNetwork, v))
+}
+
+// DayEQ applies the EQ predicate on the "day" field.
+func DayEQ(v time.Time) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldEQ(FieldDay, v))
+}
+
+// DayNEQ applies the NEQ predicate on the "day" field.
+func DayNEQ(v time.Time) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldNEQ(FieldDay, v))
+}
+
+// DayIn applies the In predicate on the "day" field.
+func DayIn(vs ...time.Time) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldIn(FieldDay, vs...))
+}
+
+// DayNotIn applies the NotIn predicate on the "day" field.
+func DayNotIn(vs ...time.Time) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldNotIn(FieldDay, vs...))
+}
+
+// DayGT applies the GT predicate on the "day" field.
+func DayGT(v time.Time) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldGT(FieldDay, v))
+}
+
+// DayGTE applies the GTE predicate on the "day" field.
+func DayGTE(v time.Time) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldGTE(FieldDay, v))
+}
+
+// DayLT applies the LT predicate on the "day" field.
+func DayLT(v time.Time) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldLT(FieldDay, v))
+}
+
+// DayLTE applies the LTE predicate on the "day" field.
+func DayLTE(v time.Time) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldLTE(FieldDay, v))
+}
+
+// FromBlockEQ applies the EQ predicate on the "from_block" field.
+func FromBlockEQ(v int64) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldEQ(FieldFromBlock, v))
+}
+
+// FromBlockNEQ applies the NEQ predicate on the "from_block" field.
+func FromBlockNEQ(v int64) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldNEQ(FieldFromBlock, v))
+}
+
+// FromBlockIn applies the In predicate on the "from_block" field.
+func FromBlockIn(vs ...int64) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldIn(FieldFromBlock, vs...))
+}
+
+// FromBlockNotIn applies the NotIn predicate on the "from_block" field.
+func FromBlockNotIn(vs ...int64) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldNotIn(FieldFromBlock, vs...))
+}
+
+// FromBlockGT applies the GT predicate on the "from_block" field.
+func FromBlockGT(v int64) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldGT(FieldFromBlock, v))
+}
+
+// FromBlockGTE applies the GTE predicate on the "from_block" field.
+func FromBlockGTE(v int64) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldGTE(FieldFromBlock, v))
+}
+
+// FromBlockLT applies the LT predicate on the "from_block" field.
+func FromBlockLT(v int64) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldLT(FieldFromBlock, v))
+}
+
+// FromBlockLTE applies the LTE predicate on the "from_block" field.
+func FromBlockLTE(v int64) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldLTE(FieldFromBlock, v))
+}
+
+// ToBlockEQ applies the EQ predicate on the "to_block" field.
+func ToBlockEQ(v int64) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldEQ(FieldToBlock, v))
+}
+
+// ToBlockNEQ applies the NEQ predicate on the "to_block" field.
+func ToBlockNEQ(v int64) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldNEQ(FieldToBlock, v))
+}
+
+// ToBlockIn applies the In predicate on the "to_block" field.
+func ToBlockIn(vs ...int64) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldIn(FieldToBlock, vs...))
+}
+
+// ToBlockNotIn applies the NotIn predicate on the "to_block" field.
+func ToBlockNotIn(vs ...int64) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldNotIn(FieldToBlock, vs...))
+}
+
+// ToBlockGT applies the GT predicate on the "to_block" field.
+func ToBlockGT(v int64) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldGT(FieldToBlock, v))
+}
+
+// ToBlockGTE applies the GTE predicate on the "to_block" field.
+func ToBlockGTE(v int64) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldGTE(FieldToBlock, v))
+}
+
+// ToBlockLT applies the LT predicate on the "to_block" field.
+func ToBlockLT(v int64) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldLT(FieldToBlock, v))
+}
+
+// ToBlockLTE applies the LTE predicate on the "to_block" field.
+func ToBlockLTE(v int64) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldLTE(FieldToBlock, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldContainsFold(FieldError, v))
+}
+
+// AddressesCheckedEQ applies the EQ predicate on the "addresses_checked" field.
+func AddressesCheckedEQ(v int) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldEQ(FieldAddressesChecked, v))
+}
+
+// AddressesCheckedNEQ applies the NEQ predicate on the "addresses_checked" field.
+func AddressesCheckedNEQ(v int) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldNEQ(FieldAddressesChecked, v))
+}
+
+// AddressesCheckedIn applies the In predicate on the "addresses_checked" field.
+func AddressesCheckedIn(vs ...int) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldIn(FieldAddressesChecked, vs...))
+}
+
+// AddressesCheckedNotIn applies the NotIn predicate on the "addresses_checked" field.
+func AddressesCheckedNotIn(vs ...int) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldNotIn(FieldAddressesChecked, vs...))
+}
+
+// AddressesCheckedGT applies the GT predicate on the "addresses_checked" field.
+func AddressesCheckedGT(v int) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldGT(FieldAddressesChecked, v))
+}
+
+// AddressesCheckedGTE applies the GTE predicate on the "addresses_checked" field.
+func AddressesCheckedGTE(v int) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldGTE(FieldAddressesChecked, v))
+}
+
+// AddressesCheckedLT applies the LT predicate on the "addresses_checked" field.
+func AddressesCheckedLT(v int) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldLT(FieldAddressesChecked, v))
+}
+
+// AddressesCheckedLTE applies the LTE predicate on the "addresses_checked" field.
+func AddressesCheckedLTE(v int) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldLTE(FieldAddressesChecked, v))
+}
+
+// TransfersCheckedEQ applies the EQ predicate on the "transfers_checked" field.
+func TransfersCheckedEQ(v int) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldEQ(FieldTransfersChecked, v))
+}
+
+// TransfersCheckedNEQ applies the NEQ predicate on the "transfers_checked" field.
+func TransfersCheckedNEQ(v int) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldNEQ(FieldTransfersChecked, v))
+}
+
+// TransfersCheckedIn applies the In predicate on the "transfers_checked" field.
+func TransfersCheckedIn(vs ...int) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldIn(FieldTransfersChecked, vs...))
+}
+
+// TransfersCheckedNotIn applies the NotIn predicate on the "transfers_checked" field.
+func TransfersCheckedNotIn(vs ...int) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldNotIn(FieldTransfersChecked, vs...))
+}
+
+// TransfersCheckedGT applies the GT predicate on the "transfers_checked" field.
+func TransfersCheckedGT(v int) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldGT(FieldTransfersChecked, v))
+}
+
+// TransfersCheckedGTE applies the GTE predicate on the "transfers_checked" field.
+func TransfersCheckedGTE(v int) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldGTE(FieldTransfersChecked, v))
+}
+
+// TransfersCheckedLT applies the LT predicate on the "transfers_checked" field.
+func TransfersCheckedLT(v int) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldLT(FieldTransfersChecked, v))
+}
+
+// TransfersCheckedLTE applies the LTE predicate on the "transfers_checked" field.
+func TransfersCheckedLTE(v int) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldLTE(FieldTransfersChecked, v))
+}
+
+// DiscrepancyCountEQ applies the EQ predicate on the "discrepancy_count" field.
+func DiscrepancyCountEQ(v int) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldEQ(FieldDiscrepancyCount, v))
+}
+
+// DiscrepancyCountNEQ applies the NEQ predicate on the "discrepancy_count" field.
+func DiscrepancyCountNEQ(v int) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldNEQ(FieldDiscrepancyCount, v))
+}
+
+// DiscrepancyCountIn applies the In predicate on the "discrepancy_count" field.
+func DiscrepancyCountIn(vs ...int) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldIn(FieldDiscrepancyCount, vs...))
+}
+
+// DiscrepancyCountNotIn applies the NotIn predicate on the "discrepancy_count" field.
+func DiscrepancyCountNotIn(vs ...int) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldNotIn(FieldDiscrepancyCount, vs...))
+}
+
+// DiscrepancyCountGT applies the GT predicate on the "discrepancy_count" field.
+func DiscrepancyCountGT(v int) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldGT(FieldDiscrepancyCount, v))
+}
+
+// DiscrepancyCountGTE applies the GTE predicate on the "discrepancy_count" field.
+func DiscrepancyCountGTE(v int) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldGTE(FieldDiscrepancyCount, v))
+}
+
+// DiscrepancyCountLT applies the LT predicate on the "discrepancy_count" field.
+func DiscrepancyCountLT(v int) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldLT(FieldDiscrepancyCount, v))
+}
+
+// DiscrepancyCountLTE applies the LTE predicate on the "discrepancy_count" field.
+func DiscrepancyCountLTE(v int) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.FieldLTE(FieldDiscrepancyCount, v))
+}
+
+// HasDiscrepancies applies the HasEdge predicate on the "discrepancies" edge.
+func HasDiscrepancies() predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DiscrepanciesTable, DiscrepanciesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDiscrepanciesWith applies the HasEdge predicate on the "discrepancies" edge with a given conditions (other predicates).
+func HasDiscrepanciesWith(preds ...predicate.ReconciliationDiscrepancy) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(func(s *sql.Selector) {
+		step := newDiscrepanciesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ReconciliationReport) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ReconciliationReport) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ReconciliationReport) predicate.ReconciliationReport {
+	return predicate.ReconciliationReport(sql.NotPredicates(p))
+}
@@ -0,0 +1,1251 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/reconciliationdiscrepancy"
+	"github.com/NEDA-LABS/stablenode/ent/reconciliationreport"
+	"github.com/google/uuid"
+)
+
+// ReconciliationReportCreate is the builder for creating a ReconciliationReport entity.
+type ReconciliationReportCreate struct {
+	config
+	mutation *ReconciliationReportMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rrc *ReconciliationReportCreate) SetCreatedAt(t time.Time) *ReconciliationReportCreate {
+	rrc.mutation.SetCreatedAt(t)
+	return rrc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rrc *ReconciliationReportCreate) SetNillableCreatedAt(t *time.Time) *ReconciliationReportCreate {
+	if t != nil {
+		rrc.SetCreatedAt(*t)
+	}
+	return rrc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rrc *ReconciliationReportCreate) SetUpdatedAt(t time.Time) *ReconciliationReportCreate {
+	rrc.mutation.SetUpdatedAt(t)
+	return rrc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rrc *ReconciliationReportCreate) SetNillableUpdatedAt(t *time.Time) *ReconciliationReportCreate {
+	if t != nil {
+		rrc.SetUpdatedAt(*t)
+	}
+	return rrc
+}
+
+// SetNetwork sets the "network" field.
+func (rrc *ReconciliationReportCreate) SetNetwork(s string) *ReconciliationReportCreate {
+	rrc.mutation.SetNetwork(s)
+	return rrc
+}
+
+// SetDay sets the "day" field.
+func (rrc *ReconciliationReportCreate) SetDay(t time.Time) *ReconciliationReportCreate {
+	rrc.mutation.SetDay(t)
+	return rrc
+}
+
+// SetFromBlock sets the "from_block" field.
+func (rrc *ReconciliationReportCreate) SetFromBlock(i int64) *ReconciliationReportCreate {
+	rrc.mutation.SetFromBlock(i)
+	return rrc
+}
+
+// SetNillableFromBlock sets the "from_block" field if the given value is not nil.
+func (rrc *ReconciliationReportCreate) SetNillableFromBlock(i *int64) *ReconciliationReportCreate {
+	if i != nil {
+		rrc.SetFromBlock(*i)
+	}
+	return rrc
+}
+
+// SetToBlock sets the "to_block" field.
+func (rrc *ReconciliationReportCreate) SetToBlock(i int64) *ReconciliationReportCreate {
+	rrc.mutation.SetToBlock(i)
+	return rrc
+}
+
+// SetNillableToBlock sets the "to_block" field if the given value is not nil.
+func (rrc *ReconciliationReportCreate) SetNillableToBlock(i *int64) *ReconciliationReportCreate {
+	if i != nil {
+		rrc.SetToBlock(*i)
+	}
+	return rrc
+}
+
+// SetStatus sets the "status" field.
+func (rrc *ReconciliationReportCreate) SetStatus(r reconciliationreport.Status) *ReconciliationReportCreate {
+	rrc.mutation.SetStatus(r)
+	return rrc
+}
+
+// SetError sets the "error" field.
+func (rrc *ReconciliationReportCreate) SetError(s string) *ReconciliationReportCreate {
+	rrc.mutation.SetError(s)
+	return rrc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (rrc *ReconciliationReportCreate) SetNillableError(s *string) *ReconciliationReportCreate {
+	if s != nil {
+		rrc.SetError(*s)
+	}
+	return rrc
+}
+
+// SetAddressesChecked sets the "addresses_checked" field.
+func (rrc *ReconciliationReportCreate) SetAddressesChecked(i int) *ReconciliationReportCreate {
+	rrc.mutation.SetAddressesChecked(i)
+	return rrc
+}
+
+// SetNillableAddressesChecked sets the "addresses_checked" field if the given value is not nil.
+func (rrc *ReconciliationReportCreate) SetNillableAddressesChecked(i *int) *ReconciliationReportCreate {
+	if i != nil {
+		rrc.SetAddressesChecked(*i)
+	}
+	return rrc
+}
+
+// SetTransfersChecked sets the "transfers_checked" field.
+func (rrc *ReconciliationReportCreate) SetTransfersChecked(i int) *ReconciliationReportCreate {
+	rrc.mutation.SetTransfersChecked(i)
+	return rrc
+}
+
+// SetNillableTransfersChecked sets the "transfers_checked" field if the given value is not nil.
+func (rrc *ReconciliationReportCreate) SetNillableTransfersChecked(i *int) *ReconciliationReportCreate {
+	if i != nil {
+		rrc.SetTransfersChecked(*i)
+	}
+	return rrc
+}
+
+// SetDiscrepancyCount sets the "discrepancy_count" field.
+func (rrc *ReconciliationReportCreate) SetDiscrepancyCount(i int) *ReconciliationReportCreate {
+	rrc.mutation.SetDiscrepancyCount(i)
+	return rrc
+}
+
+// SetNillableDiscrepancyCount sets the "discrepancy_count" field if the given value is not nil.
+func (rrc *ReconciliationReportCreate) SetNillableDiscrepancyCount(i *int) *ReconciliationReportCreate {
+	if i != nil {
+		rrc.SetDiscrepancyCount(*i)
+	}
+	return rrc
+}
+
+// SetID sets the "id" field.
+func (rrc *ReconciliationReportCreate) SetID(u uuid.UUID) *ReconciliationReportCreate {
+	rrc.mutation.SetID(u)
+	return rrc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (rrc *ReconciliationReportCreate) SetNillableID(u *uuid.UUID) *ReconciliationReportCreate {
+	if u != nil {
+		rrc.SetID(*u)
+	}
+	return rrc
+}
+
+// AddDiscrepancyIDs adds the "discrepancies" edge to the ReconciliationDiscrepancy entity by IDs.
+func (rrc *ReconciliationReportCreate) AddDiscrepancyIDs(ids ...uuid.UUID) *ReconciliationReportCreate {
+	rrc.mutation.AddDiscrepancyIDs(ids...)
+	return rrc
+}
+
+// AddDiscrepancies adds the "discrepancies" edges to the ReconciliationDiscrepancy entity.
+func (rrc *ReconciliationReportCreate) AddDiscrepancies(r ...*ReconciliationDiscrepancy) *ReconciliationReportCreate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rrc.AddDiscrepancyIDs(ids...)
+}
+
+// Mutation returns the ReconciliationReportMutation object of the builder.
+func (rrc *ReconciliationReportCreate) Mutation() *ReconciliationReportMutation {
+	return rrc.mutation
+}
+
+// Save creates the ReconciliationReport in the database.
+func (rrc *ReconciliationReportCreate) Save(ctx context.Context) (*ReconciliationReport, error) {
+	rrc.defaults()
+	return withHooks(ctx, rrc.sqlSave, rrc.mutation, rrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rrc *ReconciliationReportCreate) SaveX(ctx context.Context) *ReconciliationReport {
+	v, err := rrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rrc *ReconciliationReportCreate) Exec(ctx context.Context) error {
+	_, err := rrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrc *ReconciliationReportCreate) ExecX(ctx context.Context) {
+	if err := rrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rrc *ReconciliationReportCreate) defaults() {
+	if _, ok := rrc.mutation.CreatedAt(); !ok {
+		v := reconciliationreport.DefaultCreatedAt()
+		rrc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rrc.mutation.UpdatedAt(); !ok {
+		v := reconciliationreport.DefaultUpdatedAt()
+		rrc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := rrc.mutation.FromBlock(); !ok {
+		v := reconciliationreport.DefaultFromBlock
+		rrc.mutation.SetFromBlock(v)
+	}
+	if _, ok := rrc.mutation.ToBlock(); !ok {
+		v := reconciliationreport.DefaultToBlock
+		rrc.mutation.SetToBlock(v)
+	}
+	if _, ok := rrc.mutation.AddressesChecked(); !ok {
+		v := reconciliationreport.DefaultAddressesChecked
+		rrc.mutation.SetAddressesChecked(v)
+	}
+	if _, ok := rrc.mutation.TransfersChecked(); !ok {
+		v := reconciliationreport.DefaultTransfersChecked
+		rrc.mutation.SetTransfersChecked(v)
+	}
+	if _, ok := rrc.mutation.DiscrepancyCount(); !ok {
+		v := reconciliationreport.DefaultDiscrepancyCount
+		rrc.mutation.SetDiscrepancyCount(v)
+	}
+	if _, ok := rrc.mutation.ID(); !ok {
+		v := reconciliationreport.DefaultID()
+		rrc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rrc *ReconciliationReportCreate) check() error {
+	if _, ok := rrc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ReconciliationReport.created_at"`)}
+	}
+	if _, ok := rrc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ReconciliationReport.updated_at"`)}
+	}
+	if _, ok := rrc.mutation.Network(); !ok {
+		return &ValidationError{Name: "network", err: errors.New(`ent: missing required field "ReconciliationReport.network"`)}
+	}
+	if _, ok := rrc.mutation.Day(); !ok {
+		return &ValidationError{Name: "day", err: errors.New(`ent: missing required field "ReconciliationReport.day"`)}
+	}
+	if _, ok := rrc.mutation.FromBlock(); !ok {
+		return &ValidationError{Name: "from_block", err: errors.New(`ent: missing required field "ReconciliationReport.from_block"`)}
+	}
+	if _, ok := rrc.mutation.ToBlock(); !ok {
+		return &ValidationError{Name: "to_block", err: errors.New(`ent: missing required field "ReconciliationReport.to_block"`)}
+	}
+	if _, ok := rrc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ReconciliationReport.status"`)}
+	}
+	if v, ok := rrc.mutation.Status(); ok {
+		if err := reconciliationreport.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ReconciliationReport.status": %w`, err)}
+		}
+	}
+	if _, ok := rrc.mutation.AddressesChecked(); !ok {
+		return &ValidationError{Name: "addresses_checked", err: errors.New(`ent: missing required field "ReconciliationReport.addresses_checked"`)}
+	}
+	if _, ok := rrc.mutation.TransfersChecked(); !ok {
+		return &ValidationError{Name: "transfers_checked", err: errors.New(`ent: missing required field "ReconciliationReport.transfers_checked"`)}
+	}
+	if _, ok := rrc.mutation.DiscrepancyCount(); !ok {
+		return &ValidationError{Name: "discrepancy_count", err: errors.New(`ent: missing required field "ReconciliationReport.discrepancy_count"`)}
+	}
+	return nil
+}
+
+func (rrc *ReconciliationReportCreate) sqlSave(ctx context.Context) (*ReconciliationReport, error) {
+	if err := rrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	rrc.mutation.id = &_node.ID
+	rrc.mutation.done = true
+	return _node, nil
+}
+
+func (rrc *ReconciliationReportCreate) createSpec() (*ReconciliationReport, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ReconciliationReport{config: rrc.config}
+		_spec = sqlgraph.NewCreateSpec(reconciliationreport.Table, sqlgraph.NewFieldSpec(reconciliationreport.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = rrc.conflict
+	if id, ok := rrc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := rrc.mutation.CreatedAt(); ok {
+		_spec.SetField(reconciliationreport.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rrc.mutation.UpdatedAt(); ok {
+		_spec.SetField(reconciliationreport.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := rrc.mutation.Network(); ok {
+		_spec.SetField(reconciliationreport.FieldNetwork, field.TypeString, value)
+		_node.Network = value
+	}
+	if value, ok := rrc.mutation.Day(); ok {
+		_spec.SetField(reconciliationreport.FieldDay, field.TypeTime, value)
+		_node.Day = value
+	}
+	if value, ok := rrc.mutation.FromBlock(); ok {
+		_spec.SetField(reconciliationreport.FieldFromBlock, field.TypeInt64, value)
+		_node.FromBlock = value
+	}
+	if value, ok := rrc.mutation.ToBlock(); ok {
+		_spec.SetField(reconciliationreport.FieldToBlock, field.TypeInt64, value)
+		_node.ToBlock = value
+	}
+	if value, ok := rrc.mutation.Status(); ok {
+		_spec.SetField(reconciliationreport.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := rrc.mutation.Error(); ok {
+		_spec.SetField(reconciliationreport.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := rrc.mutation.AddressesChecked(); ok {
+		_spec.SetField(reconciliationreport.FieldAddressesChecked, field.TypeInt, value)
+		_node.AddressesChecked = value
+	}
+	if value, ok := rrc.mutation.TransfersChecked(); ok {
+		_spec.SetField(reconciliationreport.FieldTransfersChecked, field.TypeInt, value)
+		_node.TransfersChecked = value
+	}
+	if value, ok := rrc.mutation.DiscrepancyCount(); ok {
+		_spec.SetField(reconciliationreport.FieldDiscrepancyCount, field.TypeInt, value)
+		_node.DiscrepancyCount = value
+	}
+	if nodes := rrc.mutation.DiscrepanciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reconciliationreport.DiscrepanciesTable,
+			Columns: []string{reconciliationreport.DiscrepanciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reconciliationdiscrepancy.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReconciliationReport.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReconciliationReportUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (rrc *ReconciliationReportCreate) OnConflict(opts ...sql.ConflictOption) *ReconciliationReportUpsertOne {
+	rrc.conflict = opts
+	return &ReconciliationReportUpsertOne{
+		create: rrc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReconciliationReport.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rrc *ReconciliationReportCreate) OnConflictColumns(columns ...string) *ReconciliationReportUpsertOne {
+	rrc.conflict = append(rrc.conflict, sql.ConflictColumns(columns...))
+	return &ReconciliationReportUpsertOne{
+		create: rrc,
+	}
+}
+
+type (
+	// ReconciliationReportUpsertOne is the builder for "upsert"-ing
+	//  one ReconciliationReport node.
+	ReconciliationReportUpsertOne struct {
+		create *ReconciliationReportCreate
+	}
+
+	// ReconciliationReportUpsert is the "OnConflict" setter.
+	ReconciliationReportUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReconciliationReportUpsert) SetUpdatedAt(v time.Time) *ReconciliationReportUpsert {
+	u.Set(reconciliationreport.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReconciliationReportUpsert) UpdateUpdatedAt() *ReconciliationReportUpsert {
+	u.SetExcluded(reconciliationreport.FieldUpdatedAt)
+	return u
+}
+
+// SetNetwork sets the "network" field.
+func (u *ReconciliationReportUpsert) SetNetwork(v string) *ReconciliationReportUpsert {
+	u.Set(reconciliationreport.FieldNetwork, v)
+	return u
+}
+
+// UpdateNetwork sets the "network" field to the value that was provided on create.
+func (u *ReconciliationReportUpsert) UpdateNetwork() *ReconciliationReportUpsert {
+	u.SetExcluded(reconciliationreport.FieldNetwork)
+	return u
+}
+
+// SetDay sets the "day" field.
+func (u *ReconciliationReportUpsert) SetDay(v time.Time) *ReconciliationReportUpsert {
+	u.Set(reconciliationreport.FieldDay, v)
+	return u
+}
+
+// UpdateDay sets the "day" field to the value that was provided on create.
+func (u *ReconciliationReportUpsert) UpdateDay() *ReconciliationReportUpsert {
+	u.SetExcluded(reconciliationreport.FieldDay)
+	return u
+}
+
+// SetFromBlock sets the "from_block" field.
+func (u *ReconciliationReportUpsert) SetFromBlock(v int64) *ReconciliationReportUpsert {
+	u.Set(reconciliationreport.FieldFromBlock, v)
+	return u
+}
+
+// UpdateFromBlock sets the "from_block" field to the value that was provided on create.
+func (u *ReconciliationReportUpsert) UpdateFromBlock() *ReconciliationReportUpsert {
+	u.SetExcluded(reconciliationreport.FieldFromBlock)
+	return u
+}
+
+// AddFromBlock adds v to the "from_block" field.
+func (u *ReconciliationReportUpsert) AddFromBlock(v int64) *ReconciliationReportUpsert {
+	u.Add(reconciliationreport.FieldFromBlock, v)
+	return u
+}
+
+// SetToBlock sets the "to_block" field.
+func (u *ReconciliationReportUpsert) SetToBlock(v int64) *ReconciliationReportUpsert {
+	u.Set(reconciliationreport.FieldToBlock, v)
+	return u
+}
+
+// UpdateToBlock sets the "to_block" field to the value that was provided on create.
+func (u *ReconciliationReportUpsert) UpdateToBlock() *ReconciliationReportUpsert {
+	u.SetExcluded(reconciliationreport.FieldToBlock)
+	return u
+}
+
+// AddToBlock adds v to the "to_block" field.
+func (u *ReconciliationReportUpsert) AddToBlock(v int64) *ReconciliationReportUpsert {
+	u.Add(reconciliationreport.FieldToBlock, v)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ReconciliationReportUpsert) SetStatus(v reconciliationreport.Status) *ReconciliationReportUpsert {
+	u.Set(reconciliationreport.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReconciliationReportUpsert) UpdateStatus() *ReconciliationReportUpsert {
+	u.SetExcluded(reconciliationreport.FieldStatus)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *ReconciliationReportUpsert) SetError(v string) *ReconciliationReportUpsert {
+	u.Set(reconciliationreport.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *ReconciliationReportUpsert) UpdateError() *ReconciliationReportUpsert {
+	u.SetExcluded(reconciliationreport.FieldError)
+	return u
+}
+
+// ClearError clears the value of the "error" field.
+func (u *ReconciliationReportUpsert) ClearError() *ReconciliationReportUpsert {
+	u.SetNull(reconciliationreport.FieldError)
+	return u
+}
+
+// SetAddressesChecked sets the "addresses_checked" field.
+func (u *ReconciliationReportUpsert) SetAddressesChecked(v int) *ReconciliationReportUpsert {
+	u.Set(reconciliationreport.FieldAddressesChecked, v)
+	return u
+}
+
+// UpdateAddressesChecked sets the "addresses_checked" field to the value that was provided on create.
+func (u *ReconciliationReportUpsert) UpdateAddressesChecked() *ReconciliationReportUpsert {
+	u.SetExcluded(reconciliationreport.FieldAddressesChecked)
+	return u
+}
+
+// AddAddressesChecked adds v to the "addresses_checked" field.
+func (u *ReconciliationReportUpsert) AddAddressesChecked(v int) *ReconciliationReportUpsert {
+	u.Add(reconciliationreport.FieldAddressesChecked, v)
+	return u
+}
+
+// SetTransfersChecked sets the "transfers_checked" field.
+func (u *ReconciliationReportUpsert) SetTransfersChecked(v int) *ReconciliationReportUpsert {
+	u.Set(reconciliationreport.FieldTransfersChecked, v)
+	return u
+}
+
+// UpdateTransfersChecked sets the "transfers_checked" field to the value that was provided on create.
+func (u *ReconciliationReportUpsert) UpdateTransfersChecked() *ReconciliationReportUpsert {
+	u.SetExcluded(reconciliationreport.FieldTransfersChecked)
+	return u
+}
+
+// AddTransfersChecked adds v to the "transfers_checked" field.
+func (u *ReconciliationReportUpsert) AddTransfersChecked(v int) *ReconciliationReportUpsert {
+	u.Add(reconciliationreport.FieldTransfersChecked, v)
+	return u
+}
+
+// SetDiscrepancyCount sets the "discrepancy_count" field.
+func (u *ReconciliationReportUpsert) SetDiscrepancyCount(v int) *ReconciliationReportUpsert {
+	u.Set(reconciliationreport.FieldDiscrepancyCount, v)
+	return u
+}
+
+// UpdateDiscrepancyCount sets the "discrepancy_count" field to the value that was provided on create.
+func (u *ReconciliationReportUpsert) UpdateDiscrepancyCount() *ReconciliationReportUpsert {
+	u.SetExcluded(reconciliationreport.FieldDiscrepancyCount)
+	return u
+}
+
+// AddDiscrepancyCount adds v to the "discrepancy_count" field.
+func (u *ReconciliationReportUpsert) AddDiscrepancyCount(v int) *ReconciliationReportUpsert {
+	u.Add(reconciliationreport.FieldDiscrepancyCount, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ReconciliationReport.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(reconciliationreport.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReconciliationReportUpsertOne) UpdateNewValues() *ReconciliationReportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(reconciliationreport.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(reconciliationreport.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReconciliationReport.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ReconciliationReportUpsertOne) Ignore() *ReconciliationReportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReconciliationReportUpsertOne) DoNothing() *ReconciliationReportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReconciliationReportCreate.OnConflict
+// documentation for more info.
+func (u *ReconciliationReportUpsertOne) Update(set func(*ReconciliationReportUpsert)) *ReconciliationReportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReconciliationReportUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReconciliationReportUpsertOne) SetUpdatedAt(v time.Time) *ReconciliationReportUpsertOne {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReconciliationReportUpsertOne) UpdateUpdatedAt() *ReconciliationReportUpsertOne {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetNetwork sets the "network" field.
+func (u *ReconciliationReportUpsertOne) SetNetwork(v string) *ReconciliationReportUpsertOne {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.SetNetwork(v)
+	})
+}
+
+// UpdateNetwork sets the "network" field to the value that was provided on create.
+func (u *ReconciliationReportUpsertOne) UpdateNetwork() *ReconciliationReportUpsertOne {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.UpdateNetwork()
+	})
+}
+
+// SetDay sets the "day" field.
+func (u *ReconciliationReportUpsertOne) SetDay(v time.Time) *ReconciliationReportUpsertOne {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.SetDay(v)
+	})
+}
+
+// UpdateDay sets the "day" field to the value that was provided on create.
+func (u *ReconciliationReportUpsertOne) UpdateDay() *ReconciliationReportUpsertOne {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.UpdateDay()
+	})
+}
+
+// SetFromBlock sets the "from_block" field.
+func (u *ReconciliationReportUpsertOne) SetFromBlock(v int64) *ReconciliationReportUpsertOne {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.SetFromBlock(v)
+	})
+}
+
+// AddFromBlock adds v to the "from_block" field.
+func (u *ReconciliationReportUpsertOne) AddFromBlock(v int64) *ReconciliationReportUpsertOne {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.AddFromBlock(v)
+	})
+}
+
+// UpdateFromBlock sets the "from_block" field to the value that was provided on create.
+func (u *ReconciliationReportUpsertOne) UpdateFromBlock() *ReconciliationReportUpsertOne {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.UpdateFromBlock()
+	})
+}
+
+// SetToBlock sets the "to_block" field.
+func (u *ReconciliationReportUpsertOne) SetToBlock(v int64) *ReconciliationReportUpsertOne {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.SetToBlock(v)
+	})
+}
+
+// AddToBlock adds v to the "to_block" field.
+func (u *ReconciliationReportUpsertOne) AddToBlock(v int64) *ReconciliationReportUpsertOne {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.AddToBlock(v)
+	})
+}
+
+// UpdateToBlock sets the "to_block" field to the value that was provided on create.
+func (u *ReconciliationReportUpsertOne) UpdateToBlock() *ReconciliationReportUpsertOne {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.UpdateToBlock()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ReconciliationReportUpsertOne) SetStatus(v reconciliationreport.Status) *ReconciliationReportUpsertOne {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReconciliationReportUpsertOne) UpdateStatus() *ReconciliationReportUpsertOne {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *ReconciliationReportUpsertOne) SetError(v string) *ReconciliationReportUpsertOne {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *ReconciliationReportUpsertOne) UpdateError() *ReconciliationReportUpsertOne {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *ReconciliationReportUpsertOne) ClearError() *ReconciliationReportUpsertOne {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetAddressesChecked sets the "addresses_checked" field.
+func (u *ReconciliationReportUpsertOne) SetAddressesChecked(v int) *ReconciliationReportUpsertOne {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.SetAddressesChecked(v)
+	})
+}
+
+// AddAddressesChecked adds v to the "addresses_checked" field.
+func (u *ReconciliationReportUpsertOne) AddAddressesChecked(v int) *ReconciliationReportUpsertOne {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.AddAddressesChecked(v)
+	})
+}
+
+// UpdateAddressesChecked sets the "addresses_checked" field to the value that was provided on create.
+func (u *ReconciliationReportUpsertOne) UpdateAddressesChecked() *ReconciliationReportUpsertOne {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.UpdateAddressesChecked()
+	})
+}
+
+// SetTransfersChecked sets the "transfers_checked" field.
+func (u *ReconciliationReportUpsertOne) SetTransfersChecked(v int) *ReconciliationReportUpsertOne {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.SetTransfersChecked(v)
+	})
+}
+
+// AddTransfersChecked adds v to the "transfers_checked" field.
+func (u *ReconciliationReportUpsertOne) AddTransfersChecked(v int) *ReconciliationReportUpsertOne {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.AddTransfersChecked(v)
+	})
+}
+
+// UpdateTransfersChecked sets the "transfers_checked" field to the value that was provided on create.
+func (u *ReconciliationReportUpsertOne) UpdateTransfersChecked() *ReconciliationReportUpsertOne {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.UpdateTransfersChecked()
+	})
+}
+
+// SetDiscrepancyCount sets the "discrepancy_count" field.
+func (u *ReconciliationReportUpsertOne) SetDiscrepancyCount(v int) *ReconciliationReportUpsertOne {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.SetDiscrepancyCount(v)
+	})
+}
+
+// AddDiscrepancyCount adds v to the "discrepancy_count" field.
+func (u *ReconciliationReportUpsertOne) AddDiscrepancyCount(v int) *ReconciliationReportUpsertOne {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.AddDiscrepancyCount(v)
+	})
+}
+
+// UpdateDiscrepancyCount sets the "discrepancy_count" field to the value that was provided on create.
+func (u *ReconciliationReportUpsertOne) UpdateDiscrepancyCount() *ReconciliationReportUpsertOne {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.UpdateDiscrepancyCount()
+	})
+}
+
+// Exec executes the query.
+func (u *ReconciliationReportUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReconciliationReportCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReconciliationReportUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ReconciliationReportUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ReconciliationReportUpsertOne.ID is not supported by MySQL driver. Use ReconciliationReportUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ReconciliationReportUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ReconciliationReportCreateBulk is the builder for creating many ReconciliationReport entities in bulk.
+type ReconciliationReportCreateBulk struct {
+	config
+	err      error
+	builders []*ReconciliationReportCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ReconciliationReport entities in the database.
+func (rrcb *ReconciliationReportCreateBulk) Save(ctx context.Context) ([]*ReconciliationReport, error) {
+	if rrcb.err != nil {
+		return nil, rrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rrcb.builders))
+	nodes := make([]*ReconciliationReport, len(rrcb.builders))
+	mutators := make([]Mutator, len(rrcb.builders))
+	for i := range rrcb.builders {
+		func(i int, root context.Context) {
+			builder := rrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReconciliationReportMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rrcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rrcb *ReconciliationReportCreateBulk) SaveX(ctx context.Context) []*ReconciliationReport {
+	v, err := rrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rrcb *ReconciliationReportCreateBulk) Exec(ctx context.Context) error {
+	_, err := rrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrcb *ReconciliationReportCreateBulk) ExecX(ctx context.Context) {
+	if err := rrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReconciliationReport.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReconciliationReportUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (rrcb *ReconciliationReportCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReconciliationReportUpsertBulk {
+	rrcb.conflict = opts
+	return &ReconciliationReportUpsertBulk{
+		create: rrcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReconciliationReport.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rrcb *ReconciliationReportCreateBulk) OnConflictColumns(columns ...string) *ReconciliationReportUpsertBulk {
+	rrcb.conflict = append(rrcb.conflict, sql.ConflictColumns(columns...))
+	return &ReconciliationReportUpsertBulk{
+		create: rrcb,
+	}
+}
+
+// ReconciliationReportUpsertBulk is the builder for "upsert"-ing
+// a bulk of ReconciliationReport nodes.
+type ReconciliationReportUpsertBulk struct {
+	create *ReconciliationReportCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ReconciliationReport.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(reconciliationreport.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReconciliationReportUpsertBulk) UpdateNewValues() *ReconciliationReportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(reconciliationreport.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(reconciliationreport.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReconciliationReport.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ReconciliationReportUpsertBulk) Ignore() *ReconciliationReportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReconciliationReportUpsertBulk) DoNothing() *ReconciliationReportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReconciliationReportCreateBulk.OnConflict
+// documentation for more info.
+func (u *ReconciliationReportUpsertBulk) Update(set func(*ReconciliationReportUpsert)) *ReconciliationReportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReconciliationReportUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReconciliationReportUpsertBulk) SetUpdatedAt(v time.Time) *ReconciliationReportUpsertBulk {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReconciliationReportUpsertBulk) UpdateUpdatedAt() *ReconciliationReportUpsertBulk {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetNetwork sets the "network" field.
+func (u *ReconciliationReportUpsertBulk) SetNetwork(v string) *ReconciliationReportUpsertBulk {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.SetNetwork(v)
+	})
+}
+
+// UpdateNetwork sets the "network" field to the value that was provided on create.
+func (u *ReconciliationReportUpsertBulk) UpdateNetwork() *ReconciliationReportUpsertBulk {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.UpdateNetwork()
+	})
+}
+
+// SetDay sets the "day" field.
+func (u *ReconciliationReportUpsertBulk) SetDay(v time.Time) *ReconciliationReportUpsertBulk {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.SetDay(v)
+	})
+}
+
+// UpdateDay sets the "day" field to the value that was provided on create.
+func (u *ReconciliationReportUpsertBulk) UpdateDay() *ReconciliationReportUpsertBulk {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.UpdateDay()
+	})
+}
+
+// SetFromBlock sets the "from_block" field.
+func (u *ReconciliationReportUpsertBulk) SetFromBlock(v int64) *ReconciliationReportUpsertBulk {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.SetFromBlock(v)
+	})
+}
+
+// AddFromBlock adds v to the "from_block" field.
+func (u *ReconciliationReportUpsertBulk) AddFromBlock(v int64) *ReconciliationReportUpsertBulk {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.AddFromBlock(v)
+	})
+}
+
+// UpdateFromBlock sets the "from_block" field to the value that was provided on create.
+func (u *ReconciliationReportUpsertBulk) UpdateFromBlock() *ReconciliationReportUpsertBulk {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.UpdateFromBlock()
+	})
+}
+
+// SetToBlock sets the "to_block" field.
+func (u *ReconciliationReportUpsertBulk) SetToBlock(v int64) *ReconciliationReportUpsertBulk {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.SetToBlock(v)
+	})
+}
+
+// AddToBlock adds v to the "to_block" field.
+func (u *ReconciliationReportUpsertBulk) AddToBlock(v int64) *ReconciliationReportUpsertBulk {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.AddToBlock(v)
+	})
+}
+
+// UpdateToBlock sets the "to_block" field to the value that was provided on create.
+func (u *ReconciliationReportUpsertBulk) UpdateToBlock() *ReconciliationReportUpsertBulk {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.UpdateToBlock()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ReconciliationReportUpsertBulk) SetStatus(v reconciliationreport.Status) *ReconciliationReportUpsertBulk {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReconciliationReportUpsertBulk) UpdateStatus() *ReconciliationReportUpsertBulk {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *ReconciliationReportUpsertBulk) SetError(v string) *ReconciliationReportUpsertBulk {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *ReconciliationReportUpsertBulk) UpdateError() *ReconciliationReportUpsertBulk {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *ReconciliationReportUpsertBulk) ClearError() *ReconciliationReportUpsertBulk {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetAddressesChecked sets the "addresses_checked" field.
+func (u *ReconciliationReportUpsertBulk) SetAddressesChecked(v int) *ReconciliationReportUpsertBulk {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.SetAddressesChecked(v)
+	})
+}
+
+// AddAddressesChecked adds v to the "addresses_checked" field.
+func (u *ReconciliationReportUpsertBulk) AddAddressesChecked(v int) *ReconciliationReportUpsertBulk {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.AddAddressesChecked(v)
+	})
+}
+
+// UpdateAddressesChecked sets the "addresses_checked" field to the value that was provided on create.
+func (u *ReconciliationReportUpsertBulk) UpdateAddressesChecked() *ReconciliationReportUpsertBulk {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.UpdateAddressesChecked()
+	})
+}
+
+// SetTransfersChecked sets the "transfers_checked" field.
+func (u *ReconciliationReportUpsertBulk) SetTransfersChecked(v int) *ReconciliationReportUpsertBulk {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.SetTransfersChecked(v)
+	})
+}
+
+// AddTransfersChecked adds v to the "transfers_checked" field.
+func (u *ReconciliationReportUpsertBulk) AddTransfersChecked(v int) *ReconciliationReportUpsertBulk {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.AddTransfersChecked(v)
+	})
+}
+
+// UpdateTransfersChecked sets the "transfers_checked" field to the value that was provided on create.
+func (u *ReconciliationReportUpsertBulk) UpdateTransfersChecked() *ReconciliationReportUpsertBulk {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.UpdateTransfersChecked()
+	})
+}
+
+// SetDiscrepancyCount sets the "discrepancy_count" field.
+func (u *ReconciliationReportUpsertBulk) SetDiscrepancyCount(v int) *ReconciliationReportUpsertBulk {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.SetDiscrepancyCount(v)
+	})
+}
+
+// AddDiscrepancyCount adds v to the "discrepancy_count" field.
+func (u *ReconciliationReportUpsertBulk) AddDiscrepancyCount(v int) *ReconciliationReportUpsertBulk {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.AddDiscrepancyCount(v)
+	})
+}
+
+// UpdateDiscrepancyCount sets the "discrepancy_count" field to the value that was provided on create.
+func (u *ReconciliationReportUpsertBulk) UpdateDiscrepancyCount() *ReconciliationReportUpsertBulk {
+	return u.Update(func(s *ReconciliationReportUpsert) {
+		s.UpdateDiscrepancyCount()
+	})
+}
+
+// Exec executes the query.
+func (u *ReconciliationReportUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ReconciliationReportCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReconciliationReportCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReconciliationReportUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/NEDA-LABS/stablenode/ent/reconciliationreport"
+)
+
+// ReconciliationReportDelete is the builder for deleting a ReconciliationReport entity.
+type ReconciliationReportDelete struct {
+	config
+	hooks    []Hook
+	mutation *ReconciliationReportMutation
+}
+
+// Where appends a list predicates to the ReconciliationReportDelete builder.
+func (rrd *ReconciliationReportDelete) Where(ps ...predicate.ReconciliationReport) *ReconciliationReportDelete {
+	rrd.mutation.Where(ps...)
+	return rrd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rrd *ReconciliationReportDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rrd.sqlExec, rrd.mutation, rrd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrd *ReconciliationReportDelete) ExecX(ctx context.Context) int {
+	n, err := rrd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rrd *ReconciliationReportDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(reconciliationreport.Table, sqlgraph.NewFieldSpec(reconciliationreport.FieldID, field.TypeUUID))
+	if ps := rrd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rrd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rrd.mutation.done = true
+	return affected, err
+}
+
+// ReconciliationReportDeleteOne is the builder for deleting a single ReconciliationReport entity.
+type ReconciliationReportDeleteOne struct {
+	rrd *ReconciliationReportDelete
+}
+
+// Where appends a list predicates to the ReconciliationReportDelete builder.
+func (rrdo *ReconciliationReportDeleteOne) Where(ps ...predicate.ReconciliationReport) *ReconciliationReportDeleteOne {
+	rrdo.rrd.mutation.Where(ps...)
+	return rrdo
+}
+
+// Exec executes the deletion query.
+func (rrdo *ReconciliationReportDeleteOne) Exec(ctx context.Context) error {
+	n, err := rrdo.rrd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{reconciliationreport.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrdo *ReconciliationReportDeleteOne) ExecX(ctx context.Context) {
+	if err := rrdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,610 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/NEDA-LABS/stablenode/ent/reconciliationdiscrepancy"
+	"github.com/NEDA-LABS/stablenode/ent/reconciliationreport"
+	"github.com/google/uuid"
+)
+
+// ReconciliationReportQuery is the builder for querying ReconciliationReport entities.
+type ReconciliationReportQuery struct {
+	config
+	ctx               *QueryContext
+	order             []reconciliationreport.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.ReconciliationReport
+	withDiscrepancies *ReconciliationDiscrepancyQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ReconciliationReportQuery builder.
+func (rrq *ReconciliationReportQuery) Where(ps ...predicate.ReconciliationReport) *ReconciliationReportQuery {
+	rrq.predicates = append(rrq.predicates, ps...)
+	return rrq
+}
+
+// Limit the number of records to be returned by this query.
+func (rrq *ReconciliationReportQuery) Limit(limit int) *ReconciliationReportQuery {
+	rrq.ctx.Limit = &limit
+	return rrq
+}
+
+// Offset to start from.
+func (rrq *ReconciliationReportQuery) Offset(offset int) *ReconciliationReportQuery {
+	rrq.ctx.Offset = &offset
+	return rrq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rrq *ReconciliationReportQuery) Unique(unique bool) *ReconciliationReportQuery {
+	rrq.ctx.Unique = &unique
+	return rrq
+}
+
+// Order specifies how the records should be ordered.
+func (rrq *ReconciliationReportQuery) Order(o ...reconciliationreport.OrderOption) *ReconciliationReportQuery {
+	rrq.order = append(rrq.order, o...)
+	return rrq
+}
+
+// QueryDiscrepancies chains the current query on the "discrepancies" edge.
+func (rrq *ReconciliationReportQuery) QueryDiscrepancies() *ReconciliationDiscrepancyQuery {
+	query := (&ReconciliationDiscrepancyClient{config: rrq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rrq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reconciliationreport.Table, reconciliationreport.FieldID, selector),
+			sqlgraph.To(reconciliationdiscrepancy.Table, reconciliationdiscrepancy.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, reconciliationreport.DiscrepanciesTable, reconciliationreport.DiscrepanciesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rrq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ReconciliationReport entity from the query.
+// Returns a *NotFoundError when no ReconciliationReport was found.
+func (rrq *ReconciliationReportQuery) First(ctx context.Context) (*ReconciliationReport, error) {
+	nodes, err := rrq.Limit(1).All(setContextOp(ctx, rrq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{reconciliationreport.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rrq *ReconciliationReportQuery) FirstX(ctx context.Context) *ReconciliationReport {
+	node, err := rrq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ReconciliationReport ID from the query.
+// Returns a *NotFoundError when no ReconciliationReport ID was found.
+func (rrq *ReconciliationReportQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = rrq.Limit(1).IDs(setContextOp(ctx, rrq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{reconciliationreport.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rrq *ReconciliationReportQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := rrq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ReconciliationReport entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ReconciliationReport entity is found.
+// Returns a *NotFoundError when no ReconciliationReport entities are found.
+func (rrq *ReconciliationReportQuery) Only(ctx context.Context) (*ReconciliationReport, error) {
+	nodes, err := rrq.Limit(2).All(setContextOp(ctx, rrq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{reconciliationreport.Label}
+	default:
+		return nil, &NotSingularError{reconciliationreport.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rrq *ReconciliationReportQuery) OnlyX(ctx context.Context) *ReconciliationReport {
+	node, err := rrq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ReconciliationReport ID in the query.
+// Returns a *NotSingularError when more than one ReconciliationReport ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rrq *ReconciliationReportQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = rrq.Limit(2).IDs(setContextOp(ctx, rrq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{reconciliationreport.Label}
+	default:
+		err = &NotSingularError{reconciliationreport.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rrq *ReconciliationReportQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := rrq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ReconciliationReports.
+func (rrq *ReconciliationReportQuery) All(ctx context.Context) ([]*ReconciliationReport, error) {
+	ctx = setContextOp(ctx, rrq.ctx, ent.OpQueryAll)
+	if err := rrq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ReconciliationReport, *ReconciliationReportQuery]()
+	return withInterceptors[[]*ReconciliationReport](ctx, rrq, qr, rrq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rrq *ReconciliationReportQuery) AllX(ctx context.Context) []*ReconciliationReport {
+	nodes, err := rrq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ReconciliationReport IDs.
+func (rrq *ReconciliationReportQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if rrq.ctx.Unique == nil && rrq.path != nil {
+		rrq.Unique(true)
+	}
+	ctx = setContextOp(ctx, rrq.ctx, ent.OpQueryIDs)
+	if err = rrq.Select(reconciliationreport.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rrq *ReconciliationReportQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := rrq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rrq *ReconciliationReportQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, rrq.ctx, ent.OpQueryCount)
+	if err := rrq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, rrq, querierCount[*ReconciliationReportQuery](), rrq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rrq *ReconciliationReportQuery) CountX(ctx context.Context) int {
+	count, err := rrq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rrq *ReconciliationReportQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, rrq.ctx, ent.OpQueryExist)
+	switch _, err := rrq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rrq *ReconciliationReportQuery) ExistX(ctx context.Context) bool {
+	exist, err := rrq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ReconciliationReportQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rrq *ReconciliationReportQuery) Clone() *ReconciliationReportQuery {
+	if rrq == nil {
+		return nil
+	}
+	return &ReconciliationReportQuery{
+		config:            rrq.config,
+		ctx:               rrq.ctx.Clone(),
+		order:             append([]reconciliationreport.OrderOption{}, rrq.order...),
+		inters:            append([]Interceptor{}, rrq.inters...),
+		predicates:        append([]predicate.ReconciliationReport{}, rrq.predicates...),
+		withDiscrepancies: rrq.withDiscrepancies.Clone(),
+		// clone intermediate query.
+		sql:  rrq.sql.Clone(),
+		path: rrq.path,
+	}
+}
+
+// WithDiscrepancies tells the query-builder to eager-load the nodes that are connected to
+// the "discrepancies" edge. The optional arguments are used to configure the query builder of the edge.
+func (rrq *ReconciliationReportQuery) WithDiscrepancies(opts ...func(*ReconciliationDiscrepancyQuery)) *ReconciliationReportQuery {
+	query := (&ReconciliationDiscrepancyClient{config: rrq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rrq.withDiscrepancies = query
+	return rrq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ReconciliationReport.Query().
+//		GroupBy(reconciliationreport.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rrq *ReconciliationReportQuery) GroupBy(field string, fields ...string) *ReconciliationReportGroupBy {
+	rrq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ReconciliationReportGroupBy{build: rrq}
+	grbuild.flds = &rrq.ctx.Fields
+	grbuild.label = reconciliationreport.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.ReconciliationReport.Query().
+//		Select(reconciliationreport.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (rrq *ReconciliationReportQuery) Select(fields ...string) *ReconciliationReportSelect {
+	rrq.ctx.Fields = append(rrq.ctx.Fields, fields...)
+	sbuild := &ReconciliationReportSelect{ReconciliationReportQuery: rrq}
+	sbuild.label = reconciliationreport.Label
+	sbuild.flds, sbuild.scan = &rrq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ReconciliationReportSelect configured with the given aggregations.
+func (rrq *ReconciliationReportQuery) Aggregate(fns ...AggregateFunc) *ReconciliationReportSelect {
+	return rrq.Select().Aggregate(fns...)
+}
+
+func (rrq *ReconciliationReportQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range rrq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rrq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range rrq.ctx.Fields {
+		if !reconciliationreport.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rrq.path != nil {
+		prev, err := rrq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rrq.sql = prev
+	}
+	return nil
+}
+
+func (rrq *ReconciliationReportQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ReconciliationReport, error) {
+	var (
+		nodes       = []*ReconciliationReport{}
+		_spec       = rrq.querySpec()
+		loadedTypes = [1]bool{
+			rrq.withDiscrepancies != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ReconciliationReport).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ReconciliationReport{config: rrq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rrq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := rrq.withDiscrepancies; query != nil {
+		if err := rrq.loadDiscrepancies(ctx, query, nodes,
+			func(n *ReconciliationReport) { n.Edges.Discrepancies = []*ReconciliationDiscrepancy{} },
+			func(n *ReconciliationReport, e *ReconciliationDiscrepancy) {
+				n.Edges.Discrepancies = append(n.Edges.Discrepancies, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (rrq *ReconciliationReportQuery) loadDiscrepancies(ctx context.Context, query *ReconciliationDiscrepancyQuery, nodes []*ReconciliationReport, init func(*ReconciliationReport), assign func(*ReconciliationReport, *ReconciliationDiscrepancy)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*ReconciliationReport)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ReconciliationDiscrepancy(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(reconciliationreport.DiscrepanciesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.reconciliation_report_discrepancies
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "reconciliation_report_discrepancies" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "reconciliation_report_discrepancies" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (rrq *ReconciliationReportQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rrq.querySpec()
+	_spec.Node.Columns = rrq.ctx.Fields
+	if len(rrq.ctx.Fields) > 0 {
+		_spec.Unique = rrq.ctx.Unique != nil && *rrq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, rrq.driver, _spec)
+}
+
+func (rrq *ReconciliationReportQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(reconciliationreport.Table, reconciliationreport.Columns, sqlgraph.NewFieldSpec(reconciliationreport.FieldID, field.TypeUUID))
+	_spec.From = rrq.sql
+	if unique := rrq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if rrq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := rrq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reconciliationreport.FieldID)
+		for i := range fields {
+			if fields[i] != reconciliationreport.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := rrq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rrq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rrq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rrq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rrq *ReconciliationReportQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rrq.driver.Dialect())
+	t1 := builder.Table(reconciliationreport.Table)
+	columns := rrq.ctx.Fields
+	if len(columns) == 0 {
+		columns = reconciliationreport.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rrq.sql != nil {
+		selector = rrq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rrq.ctx.Unique != nil && *rrq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range rrq.predicates {
+		p(selector)
+	}
+	for _, p := range rrq.order {
+		p(selector)
+	}
+	if offset := rrq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rrq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ReconciliationReportGroupBy is the group-by builder for ReconciliationReport entities.
+type ReconciliationReportGroupBy struct {
+	selector
+	build *ReconciliationReportQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rrgb *ReconciliationReportGroupBy) Aggregate(fns ...AggregateFunc) *ReconciliationReportGroupBy {
+	rrgb.fns = append(rrgb.fns, fns...)
+	return rrgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rrgb *ReconciliationReportGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rrgb.build.ctx, ent.OpQueryGroupBy)
+	if err := rrgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ReconciliationReportQuery, *ReconciliationReportGroupBy](ctx, rrgb.build, rrgb, rrgb.build.inters, v)
+}
+
+func (rrgb *ReconciliationReportGroupBy) sqlScan(ctx context.Context, root *ReconciliationReportQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(rrgb.fns))
+	for _, fn := range rrgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*rrgb.flds)+len(rrgb.fns))
+		for _, f := range *rrgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*rrgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rrgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ReconciliationReportSelect is the builder for selecting fields of ReconciliationReport entities.
+type ReconciliationReportSelect struct {
+	*ReconciliationReportQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (rrs *ReconciliationReportSelect) Aggregate(fns ...AggregateFunc) *ReconciliationReportSelect {
+	rrs.fns = append(rrs.fns, fns...)
+	return rrs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rrs *ReconciliationReportSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rrs.ctx, ent.OpQuerySelect)
+	if err := rrs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ReconciliationReportQuery, *ReconciliationReportSelect](ctx, rrs.ReconciliationReportQuery, rrs, rrs.inters, v)
+}
+
+func (rrs *ReconciliationReportSelect) sqlScan(ctx context.Context, root *ReconciliationReportQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(rrs.fns))
+	for _, fn := range rrs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*rrs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rrs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,826 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/NEDA-LABS/stablenode/ent/reconciliationdiscrepancy"
+	"github.com/NEDA-LABS/stablenode/ent/reconciliationreport"
+	"github.com/google/uuid"
+)
+
+// ReconciliationReportUpdate is the builder for updating ReconciliationReport entities.
+type ReconciliationReportUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReconciliationReportMutation
+}
+
+// Where appends a list predicates to the ReconciliationReportUpdate builder.
+func (rru *ReconciliationReportUpdate) Where(ps ...predicate.ReconciliationReport) *ReconciliationReportUpdate {
+	rru.mutation.Where(ps...)
+	return rru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rru *ReconciliationReportUpdate) SetUpdatedAt(t time.Time) *ReconciliationReportUpdate {
+	rru.mutation.SetUpdatedAt(t)
+	return rru
+}
+
+// SetNetwork sets the "network" field.
+func (rru *ReconciliationReportUpdate) SetNetwork(s string) *ReconciliationReportUpdate {
+	rru.mutation.SetNetwork(s)
+	return rru
+}
+
+// SetNillableNetwork sets the "network" field if the given value is not nil.
+func (rru *ReconciliationReportUpdate) SetNillableNetwork(s *string) *ReconciliationReportUpdate {
+	if s != nil {
+		rru.SetNetwork(*s)
+	}
+	return rru
+}
+
+// SetDay sets the "day" field.
+func (rru *ReconciliationReportUpdate) SetDay(t time.Time) *ReconciliationReportUpdate {
+	rru.mutation.SetDay(t)
+	return rru
+}
+
+// SetNillableDay sets the "day" field if the given value is not nil.
+func (rru *ReconciliationReportUpdate) SetNillableDay(t *time.Time) *ReconciliationReportUpdate {
+	if t != nil {
+		rru.SetDay(*t)
+	}
+	return rru
+}
+
+// SetFromBlock sets the "from_block" field.
+func (rru *ReconciliationReportUpdate) SetFromBlock(i int64) *ReconciliationReportUpdate {
+	rru.mutation.ResetFromBlock()
+	rru.mutation.SetFromBlock(i)
+	return rru
+}
+
+// SetNillableFromBlock sets the "from_block" field if the given value is not nil.
+func (rru *ReconciliationReportUpdate) SetNillableFromBlock(i *int64) *ReconciliationReportUpdate {
+	if i != nil {
+		rru.SetFromBlock(*i)
+	}
+	return rru
+}
+
+// AddFromBlock adds i to the "from_block" field.
+func (rru *ReconciliationReportUpdate) AddFromBlock(i int64) *ReconciliationReportUpdate {
+	rru.mutation.AddFromBlock(i)
+	return rru
+}
+
+// SetToBlock sets the "to_block" field.
+func (rru *ReconciliationReportUpdate) SetToBlock(i int64) *ReconciliationReportUpdate {
+	rru.mutation.ResetToBlock()
+	rru.mutation.SetToBlock(i)
+	return rru
+}
+
+// SetNillableToBlock sets the "to_block" field if the given value is not nil.
+func (rru *ReconciliationReportUpdate) SetNillableToBlock(i *int64) *ReconciliationReportUpdate {
+	if i != nil {
+		rru.SetToBlock(*i)
+	}
+	return rru
+}
+
+// AddToBlock adds i to the "to_block" field.
+func (rru *ReconciliationReportUpdate) AddToBlock(i int64) *ReconciliationReportUpdate {
+	rru.mutation.AddToBlock(i)
+	return rru
+}
+
+// SetStatus sets the "status" field.
+func (rru *ReconciliationReportUpdate) SetStatus(r reconciliationreport.Status) *ReconciliationReportUpdate {
+	rru.mutation.SetStatus(r)
+	return rru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rru *ReconciliationReportUpdate) SetNillableStatus(r *reconciliationreport.Status) *ReconciliationReportUpdate {
+	if r != nil {
+		rru.SetStatus(*r)
+	}
+	return rru
+}
+
+// SetError sets the "error" field.
+func (rru *ReconciliationReportUpdate) SetError(s string) *ReconciliationReportUpdate {
+	rru.mutation.SetError(s)
+	return rru
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (rru *ReconciliationReportUpdate) SetNillableError(s *string) *ReconciliationReportUpdate {
+	if s != nil {
+		rru.SetError(*s)
+	}
+	return rru
+}
+
+// ClearError clears the value of the "error" field.
+func (rru *ReconciliationReportUpdate) ClearError() *ReconciliationReportUpdate {
+	rru.mutation.ClearError()
+	return rru
+}
+
+// SetAddressesChecked sets the "addresses_checked" field.
+func (rru *ReconciliationReportUpdate) SetAddressesChecked(i int) *ReconciliationReportUpdate {
+	rru.mutation.ResetAddressesChecked()
+	rru.mutation.SetAddressesChecked(i)
+	return rru
+}
+
+// SetNillableAddressesChecked sets the "addresses_checked" field if the given value is not nil.
+func (rru *ReconciliationReportUpdate) SetNillableAddressesChecked(i *int) *ReconciliationReportUpdate {
+	if i != nil {
+		rru.SetAddressesChecked(*i)
+	}
+	return rru
+}
+
+// AddAddressesChecked adds i to the "addresses_checked" field.
+func (rru *ReconciliationReportUpdate) AddAddressesChecked(i int) *ReconciliationReportUpdate {
+	rru.mutation.AddAddressesChecked(i)
+	return rru
+}
+
+// SetTransfersChecked sets the "transfers_checked" field.
+func (rru *ReconciliationReportUpdate) SetTransfersChecked(i int) *ReconciliationReportUpdate {
+	rru.mutation.ResetTransfersChecked()
+	rru.mutation.SetTransfersChecked(i)
+	return rru
+}
+
+// SetNillableTransfersChecked sets the "transfers_checked" field if the given value is not nil.
+func (rru *ReconciliationReportUpdate) SetNillableTransfersChecked(i *int) *ReconciliationReportUpdate {
+	if i != nil {
+		rru.SetTransfersChecked(*i)
+	}
+	return rru
+}
+
+// AddTransfersChecked adds i to the "transfers_checked" field.
+func (rru *ReconciliationReportUpdate) AddTransfersChecked(i int) *ReconciliationReportUpdate {
+	rru.mutation.AddTransfersChecked(i)
+	return rru
+}
+
+// SetDiscrepancyCount sets the "discrepancy_count" field.
+func (rru *ReconciliationReportUpdate) SetDiscrepancyCount(i int) *ReconciliationReportUpdate {
+	rru.mutation.ResetDiscrepancyCount()
+	rru.mutation.SetDiscrepancyCount(i)
+	return rru
+}
+
+// SetNillableDiscrepancyCount sets the "discrepancy_count" field if the given value is not nil.
+func (rru *ReconciliationReportUpdate) SetNillableDiscrepancyCount(i *int) *ReconciliationReportUpdate {
+	if i != nil {
+		rru.SetDiscrepancyCount(*i)
+	}
+	return rru
+}
+
+// AddDiscrepancyCount adds i to the "discrepancy_count" field.
+func (rru *ReconciliationReportUpdate) AddDiscrepancyCount(i int) *ReconciliationReportUpdate {
+	rru.mutation.AddDiscrepancyCount(i)
+	return rru
+}
+
+// AddDiscrepancyIDs adds the "discrepancies" edge to the ReconciliationDiscrepancy entity by IDs.
+func (rru *ReconciliationReportUpdate) AddDiscrepancyIDs(ids ...uuid.UUID) *ReconciliationReportUpdate {
+	rru.mutation.AddDiscrepancyIDs(ids...)
+	return rru
+}
+
+// AddDiscrepancies adds the "discrepancies" edges to the ReconciliationDiscrepancy entity.
+func (rru *ReconciliationReportUpdate) AddDiscrepancies(r ...*ReconciliationDiscrepancy) *ReconciliationReportUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rru.AddDiscrepancyIDs(ids...)
+}
+
+// Mutation returns the ReconciliationReportMutation object of the builder.
+func (rru *ReconciliationReportUpdate) Mutation() *ReconciliationReportMutation {
+	return rru.mutation
+}
+
+// ClearDiscrepancies clears all "discrepancies" edges to the ReconciliationDiscrepancy entity.
+func (rru *ReconciliationReportUpdate) ClearDiscrepancies() *ReconciliationReportUpdate {
+	rru.mutation.ClearDiscrepancies()
+	return rru
+}
+
+// RemoveDiscrepancyIDs removes the "discrepancies" edge to ReconciliationDiscrepancy entities by IDs.
+func (rru *ReconciliationReportUpdate) RemoveDiscrepancyIDs(ids ...uuid.UUID) *ReconciliationReportUpdate {
+	rru.mutation.RemoveDiscrepancyIDs(ids...)
+	return rru
+}
+
+// RemoveDiscrepancies removes "discrepancies" edges to ReconciliationDiscrepancy entities.
+func (rru *ReconciliationReportUpdate) RemoveDiscrepancies(r ...*ReconciliationDiscrepancy) *ReconciliationReportUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rru.RemoveDiscrepancyIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rru *ReconciliationReportUpdate) Save(ctx context.Context) (int, error) {
+	rru.defaults()
+	return withHooks(ctx, rru.sqlSave, rru.mutation, rru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rru *ReconciliationReportUpdate) SaveX(ctx context.Context) int {
+	affected, err := rru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rru *ReconciliationReportUpdate) Exec(ctx context.Context) error {
+	_, err := rru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rru *ReconciliationReportUpdate) ExecX(ctx context.Context) {
+	if err := rru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rru *ReconciliationReportUpdate) defaults() {
+	if _, ok := rru.mutation.UpdatedAt(); !ok {
+		v := reconciliationreport.UpdateDefaultUpdatedAt()
+		rru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rru *ReconciliationReportUpdate) check() error {
+	if v, ok := rru.mutation.Status(); ok {
+		if err := reconciliationreport.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ReconciliationReport.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rru *ReconciliationReportUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reconciliationreport.Table, reconciliationreport.Columns, sqlgraph.NewFieldSpec(reconciliationreport.FieldID, field.TypeUUID))
+	if ps := rru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rru.mutation.UpdatedAt(); ok {
+		_spec.SetField(reconciliationreport.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := rru.mutation.Network(); ok {
+		_spec.SetField(reconciliationreport.FieldNetwork, field.TypeString, value)
+	}
+	if value, ok := rru.mutation.Day(); ok {
+		_spec.SetField(reconciliationreport.FieldDay, field.TypeTime, value)
+	}
+	if value, ok := rru.mutation.FromBlock(); ok {
+		_spec.SetField(reconciliationreport.FieldFromBlock, field.TypeInt64, value)
+	}
+	if value, ok := rru.mutation.AddedFromBlock(); ok {
+		_spec.AddField(reconciliationreport.FieldFromBlock, field.TypeInt64, value)
+	}
+	if value, ok := rru.mutation.ToBlock(); ok {
+		_spec.SetField(reconciliationreport.FieldToBlock, field.TypeInt64, value)
+	}
+	if value, ok := rru.mutation.AddedToBlock(); ok {
+		_spec.AddField(reconciliationreport.FieldToBlock, field.TypeInt64, value)
+	}
+	if value, ok := rru.mutation.Status(); ok {
+		_spec.SetField(reconciliationreport.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := rru.mutation.Error(); ok {
+		_spec.SetField(reconciliationreport.FieldError, field.TypeString, value)
+	}
+	if rru.mutation.ErrorCleared() {
+		_spec.ClearField(reconciliationreport.FieldError, field.TypeString)
+	}
+	if value, ok := rru.mutation.AddressesChecked(); ok {
+		_spec.SetField(reconciliationreport.FieldAddressesChecked, field.TypeInt, value)
+	}
+	if value, ok := rru.mutation.AddedAddressesChecked(); ok {
+		_spec.AddField(reconciliationreport.FieldAddressesChecked, field.TypeInt, value)
+	}
+	if value, ok := rru.mutation.TransfersChecked(); ok {
+		_spec.SetField(reconciliationreport.FieldTransfersChecked, field.TypeInt, value)
+	}
+	if value, ok := rru.mutation.AddedTransfersChecked(); ok {
+		_spec.AddField(reconciliationreport.FieldTransfersChecked, field.TypeInt, value)
+	}
+	if value, ok := rru.mutation.DiscrepancyCount(); ok {
+		_spec.SetField(reconciliationreport.FieldDiscrepancyCount, field.TypeInt, value)
+	}
+	if value, ok := rru.mutation.AddedDiscrepancyCount(); ok {
+		_spec.AddField(reconciliationreport.FieldDiscrepancyCount, field.TypeInt, value)
+	}
+	if rru.mutation.DiscrepanciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reconciliationreport.DiscrepanciesTable,
+			Columns: []string{reconciliationreport.DiscrepanciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reconciliationdiscrepancy.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rru.mutation.RemovedDiscrepanciesIDs(); len(nodes) > 0 && !rru.mutation.DiscrepanciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reconciliationreport.DiscrepanciesTable,
+			Columns: []string{reconciliationreport.DiscrepanciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reconciliationdiscrepancy.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rru.mutation.DiscrepanciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reconciliationreport.DiscrepanciesTable,
+			Columns: []string{reconciliationreport.DiscrepanciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reconciliationdiscrepancy.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reconciliationreport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rru.mutation.done = true
+	return n, nil
+}
+
+// ReconciliationReportUpdateOne is the builder for updating a single ReconciliationReport entity.
+type ReconciliationReportUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReconciliationReportMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rruo *ReconciliationReportUpdateOne) SetUpdatedAt(t time.Time) *ReconciliationReportUpdateOne {
+	rruo.mutation.SetUpdatedAt(t)
+	return rruo
+}
+
+// SetNetwork sets the "network" field.
+func (rruo *ReconciliationReportUpdateOne) SetNetwork(s string) *ReconciliationReportUpdateOne {
+	rruo.mutation.SetNetwork(s)
+	return rruo
+}
+
+// SetNillableNetwork sets the "network" field if the given value is not nil.
+func (rruo *ReconciliationReportUpdateOne) SetNillableNetwork(s *string) *ReconciliationReportUpdateOne {
+	if s != nil {
+		rruo.SetNetwork(*s)
+	}
+	return rruo
+}
+
+// SetDay sets the "day" field.
+func (rruo *ReconciliationReportUpdateOne) SetDay(t time.Time) *ReconciliationReportUpdateOne {
+	rruo.mutation.SetDay(t)
+	return rruo
+}
+
+// SetNillableDay sets the "day" field if the given value is not nil.
+func (rruo *ReconciliationReportUpdateOne) SetNillableDay(t *time.Time) *ReconciliationReportUpdateOne {
+	if t != nil {
+		rruo.SetDay(*t)
+	}
+	return rruo
+}
+
+// SetFromBlock sets the "from_block" field.
+func (rruo *ReconciliationReportUpdateOne) SetFromBlock(i int64) *ReconciliationReportUpdateOne {
+	rruo.mutation.ResetFromBlock()
+	rruo.mutation.SetFromBlock(i)
+	return rruo
+}
+
+// SetNillableFromBlock sets the "from_block" field if the given value is not nil.
+func (rruo *ReconciliationReportUpdateOne) SetNillableFromBlock(i *int64) *ReconciliationReportUpdateOne {
+	if i != nil {
+		rruo.SetFromBlock(*i)
+	}
+	return rruo
+}
+
+// AddFromBlock adds i to the "from_block" field.
+func (rruo *ReconciliationReportUpdateOne) AddFromBlock(i int64) *ReconciliationReportUpdateOne {
+	rruo.mutation.AddFromBlock(i)
+	return rruo
+}
+
+// SetToBlock sets the "to_block" field.
+func (rruo *ReconciliationReportUpdateOne) SetToBlock(i int64) *ReconciliationReportUpdateOne {
+	rruo.mutation.ResetToBlock()
+	rruo.mutation.SetToBlock(i)
+	return rruo
+}
+
+// SetNillableToBlock sets the "to_block" field if the given value is not nil.
+func (rruo *ReconciliationReportUpdateOne) SetNillableToBlock(i *int64) *ReconciliationReportUpdateOne {
+	if i != nil {
+		rruo.SetToBlock(*i)
+	}
+	return rruo
+}
+
+// AddToBlock adds i to the "to_block" field.
+func (rruo *ReconciliationReportUpdateOne) AddToBlock(i int64) *ReconciliationReportUpdateOne {
+	rruo.mutation.AddToBlock(i)
+	return rruo
+}
+
+// SetStatus sets the "status" field.
+func (rruo *ReconciliationReportUpdateOne) SetStatus(r reconciliationreport.Status) *ReconciliationReportUpdateOne {
+	rruo.mutation.SetStatus(r)
+	return rruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rruo *ReconciliationReportUpdateOne) SetNillableStatus(r *reconciliationreport.Status) *ReconciliationReportUpdateOne {
+	if r != nil {
+		rruo.SetStatus(*r)
+	}
+	return rruo
+}
+
+// SetError sets the "error" field.
+func (rruo *ReconciliationReportUpdateOne) SetError(s string) *ReconciliationReportUpdateOne {
+	rruo.mutation.SetError(s)
+	return rruo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (rruo *ReconciliationReportUpdateOne) SetNillableError(s *string) *ReconciliationReportUpdateOne {
+	if s != nil {
+		rruo.SetError(*s)
+	}
+	return rruo
+}
+
+// ClearError clears the value of the "error" field.
+func (rruo *ReconciliationReportUpdateOne) ClearError() *ReconciliationReportUpdateOne {
+	rruo.mutation.ClearError()
+	return rruo
+}
+
+// SetAddressesChecked sets the "addresses_checked" field.
+func (rruo *ReconciliationReportUpdateOne) SetAddressesChecked(i int) *ReconciliationReportUpdateOne {
+	rruo.mutation.ResetAddressesChecked()
+	rruo.mutation.SetAddressesChecked(i)
+	return rruo
+}
+
+// SetNillableAddressesChecked sets the "addresses_checked" field if the given value is not nil.
+func (rruo *ReconciliationReportUpdateOne) SetNillableAddressesChecked(i *int) *ReconciliationReportUpdateOne {
+	if i != nil {
+		rruo.SetAddressesChecked(*i)
+	}
+	return rruo
+}
+
+// AddAddressesChecked adds i to the "addresses_checked" field.
+func (rruo *ReconciliationReportUpdateOne) AddAddressesChecked(i int) *ReconciliationReportUpdateOne {
+	rruo.mutation.AddAddressesChecked(i)
+	return rruo
+}
+
+// SetTransfersChecked sets the "transfers_checked" field.
+func (rruo *ReconciliationReportUpdateOne) SetTransfersChecked(i int) *ReconciliationReportUpdateOne {
+	rruo.mutation.ResetTransfersChecked()
+	rruo.mutation.SetTransfersChecked(i)
+	return rruo
+}
+
+// SetNillableTransfersChecked sets the "transfers_checked" field if the given value is not nil.
+func (rruo *ReconciliationReportUpdateOne) SetNillableTransfersChecked(i *int) *ReconciliationReportUpdateOne {
+	if i != nil {
+		rruo.SetTransfersChecked(*i)
+	}
+	return rruo
+}
+
+// AddTransfersChecked adds i to the "transfers_checked" field.
+func (rruo *ReconciliationReportUpdateOne) AddTransfersChecked(i int) *ReconciliationReportUpdateOne {
+	rruo.mutation.AddTransfersChecked(i)
+	return rruo
+}
+
+// SetDiscrepancyCount sets the "discrepancy_count" field.
+func (rruo *ReconciliationReportUpdateOne) SetDiscrepancyCount(i int) *ReconciliationReportUpdateOne {
+	rruo.mutation.ResetDiscrepancyCount()
+	rruo.mutation.SetDiscrepancyCount(i)
+	return rruo
+}
+
+// SetNillableDiscrepancyCount sets the "discrepancy_count" field if the given value is not nil.
+func (rruo *ReconciliationReportUpdateOne) SetNillableDiscrepancyCount(i *int) *ReconciliationReportUpdateOne {
+	if i != nil {
+		rruo.SetDiscrepancyCount(*i)
+	}
+	return rruo
+}
+
+// AddDiscrepancyCount adds i to the "discrepancy_count" field.
+func (rruo *ReconciliationReportUpdateOne) AddDiscrepancyCount(i int) *ReconciliationReportUpdateOne {
+	rruo.mutation.AddDiscrepancyCount(i)
+	return rruo
+}
+
+// AddDiscrepancyIDs adds the "discrepancies" edge to the ReconciliationDiscrepancy entity by IDs.
+func (rruo *ReconciliationReportUpdateOne) AddDiscrepancyIDs(ids ...uuid.UUID) *ReconciliationReportUpdateOne {
+	rruo.mutation.AddDiscrepancyIDs(ids...)
+	return rruo
+}
+
+// AddDiscrepancies adds the "discrepancies" edges to the ReconciliationDiscrepancy entity.
+func (rruo *ReconciliationReportUpdateOne) AddDiscrepancies(r ...*ReconciliationDiscrepancy) *ReconciliationReportUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rruo.AddDiscrepancyIDs(ids...)
+}
+
+// Mutation returns the ReconciliationReportMutation object of the builder.
+func (rruo *ReconciliationReportUpdateOne) Mutation() *ReconciliationReportMutation {
+	return rruo.mutation
+}
+
+// ClearDiscrepancies clears all "discrepancies" edges to the ReconciliationDiscrepancy entity.
+func (rruo *ReconciliationReportUpdateOne) ClearDiscrepancies() *ReconciliationReportUpdateOne {
+	rruo.mutation.ClearDiscrepancies()
+	return rruo
+}
+
+// RemoveDiscrepancyIDs removes the "discrepancies" edge to ReconciliationDiscrepancy entities by IDs.
+func (rruo *ReconciliationReportUpdateOne) RemoveDiscrepancyIDs(ids ...uuid.UUID) *ReconciliationReportUpdateOne {
+	rruo.mutation.RemoveDiscrepancyIDs(ids...)
+	return rruo
+}
+
+// RemoveDiscrepancies removes "discrepancies" edges to ReconciliationDiscrepancy entities.
+func (rruo *ReconciliationReportUpdateOne) RemoveDiscrepancies(r ...*ReconciliationDiscrepancy) *ReconciliationReportUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rruo.RemoveDiscrepancyIDs(ids...)
+}
+
+// Where appends a list predicates to the ReconciliationReportUpdate builder.
+func (rruo *ReconciliationReportUpdateOne) Where(ps ...predicate.ReconciliationReport) *ReconciliationReportUpdateOne {
+	rruo.mutation.Where(ps...)
+	return rruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rruo *ReconciliationReportUpdateOne) Select(field string, fields ...string) *ReconciliationReportUpdateOne {
+	rruo.fields = append([]string{field}, fields...)
+	return rruo
+}
+
+// Save executes the query and returns the updated ReconciliationReport entity.
+func (rruo *ReconciliationReportUpdateOne) Save(ctx context.Context) (*ReconciliationReport, error) {
+	rruo.defaults()
+	return withHooks(ctx, rruo.sqlSave, rruo.mutation, rruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rruo *ReconciliationReportUpdateOne) SaveX(ctx context.Context) *ReconciliationReport {
+	node, err := rruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rruo *ReconciliationReportUpdateOne) Exec(ctx context.Context) error {
+	_, err := rruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rruo *ReconciliationReportUpdateOne) ExecX(ctx context.Context) {
+	if err := rruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rruo *ReconciliationReportUpdateOne) defaults() {
+	if _, ok := rruo.mutation.UpdatedAt(); !ok {
+		v := reconciliationreport.UpdateDefaultUpdatedAt()
+		rruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rruo *ReconciliationReportUpdateOne) check() error {
+	if v, ok := rruo.mutation.Status(); ok {
+		if err := reconciliationreport.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ReconciliationReport.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rruo *ReconciliationReportUpdateOne) sqlSave(ctx context.Context) (_node *ReconciliationReport, err error) {
+	if err := rruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reconciliationreport.Table, reconciliationreport.Columns, sqlgraph.NewFieldSpec(reconciliationreport.FieldID, field.TypeUUID))
+	id, ok := rruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ReconciliationReport.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reconciliationreport.FieldID)
+		for _, f := range fields {
+			if !reconciliationreport.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != reconciliationreport.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(reconciliationreport.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := rruo.mutation.Network(); ok {
+		_spec.SetField(reconciliationreport.FieldNetwork, field.TypeString, value)
+	}
+	if value, ok := rruo.mutation.Day(); ok {
+		_spec.SetField(reconciliationreport.FieldDay, field.TypeTime, value)
+	}
+	if value, ok := rruo.mutation.FromBlock(); ok {
+		_spec.SetField(reconciliationreport.FieldFromBlock, field.TypeInt64, value)
+	}
+	if value, ok := rruo.mutation.AddedFromBlock(); ok {
+		_spec.AddField(reconciliationreport.FieldFromBlock, field.TypeInt64, value)
+	}
+	if value, ok := rruo.mutation.ToBlock(); ok {
+		_spec.SetField(reconciliationreport.FieldToBlock, field.TypeInt64, value)
+	}
+	if value, ok := rruo.mutation.AddedToBlock(); ok {
+		_spec.AddField(reconciliationreport.FieldToBlock, field.TypeInt64, value)
+	}
+	if value, ok := rruo.mutation.Status(); ok {
+		_spec.SetField(reconciliationreport.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := rruo.mutation.Error(); ok {
+		_spec.SetField(reconciliationreport.FieldError, field.TypeString, value)
+	}
+	if rruo.mutation.ErrorCleared() {
+		_spec.ClearField(reconciliationreport.FieldError, field.TypeString)
+	}
+	if value, ok := rruo.mutation.AddressesChecked(); ok {
+		_spec.SetField(reconciliationreport.FieldAddressesChecked, field.TypeInt, value)
+	}
+	if value, ok := rruo.mutation.AddedAddressesChecked(); ok {
+		_spec.AddField(reconciliationreport.FieldAddressesChecked, field.TypeInt, value)
+	}
+	if value, ok := rruo.mutation.TransfersChecked(); ok {
+		_spec.SetField(reconciliationreport.FieldTransfersChecked, field.TypeInt, value)
+	}
+	if value, ok := rruo.mutation.AddedTransfersChecked(); ok {
+		_spec.AddField(reconciliationreport.FieldTransfersChecked, field.TypeInt, value)
+	}
+	if value, ok := rruo.mutation.DiscrepancyCount(); ok {
+		_spec.SetField(reconciliationreport.FieldDiscrepancyCount, field.TypeInt, value)
+	}
+	if value, ok := rruo.mutation.AddedDiscrepancyCount(); ok {
+		_spec.AddField(reconciliationreport.FieldDiscrepancyCount, field.TypeInt, value)
+	}
+	if rruo.mutation.DiscrepanciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reconciliationreport.DiscrepanciesTable,
+			Columns: []string{reconciliationreport.DiscrepanciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reconciliationdiscrepancy.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rruo.mutation.RemovedDiscrepanciesIDs(); len(nodes) > 0 && !rruo.mutation.DiscrepanciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reconciliationreport.DiscrepanciesTable,
+			Columns: []string{reconciliationreport.DiscrepanciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reconciliationdiscrepancy.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rruo.mutation.DiscrepanciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reconciliationreport.DiscrepanciesTable,
+			Columns: []string{reconciliationreport.DiscrepanciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reconciliationdiscrepancy.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ReconciliationReport{config: rruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reconciliationreport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rruo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,191 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/ent/rpcendpoint"
+)
+
+// RPCEndpoint is the model entity for the RPCEndpoint schema.
+type RPCEndpoint struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// URL holds the value of the "url" field.
+	URL string `json:"url,omitempty"`
+	// Fallbacks are tried in ascending priority after the network's rpc_endpoint
+	Priority int `json:"priority,omitempty"`
+	// IsEnabled holds the value of the "is_enabled" field.
+	IsEnabled bool `json:"is_enabled,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RPCEndpointQuery when eager-loading is set.
+	Edges                 RPCEndpointEdges `json:"edges"`
+	network_rpc_endpoints *int
+	selectValues          sql.SelectValues
+}
+
+// RPCEndpointEdges holds the relations/edges for other nodes in the graph.
+type RPCEndpointEdges struct {
+	// Network holds the value of the network edge.
+	Network *Network `json:"network,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// NetworkOrErr returns the Network value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RPCEndpointEdges) NetworkOrErr() (*Network, error) {
+	if e.Network != nil {
+		return e.Network, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: network.Label}
+	}
+	return nil, &NotLoadedError{edge: "network"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RPCEndpoint) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case rpcendpoint.FieldIsEnabled:
+			values[i] = new(sql.NullBool)
+		case rpcendpoint.FieldID, rpcendpoint.FieldPriority:
+			values[i] = new(sql.NullInt64)
+		case rpcendpoint.FieldURL:
+			values[i] = new(sql.NullString)
+		case rpcendpoint.FieldCreatedAt, rpcendpoint.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case rpcendpoint.ForeignKeys[0]: // network_rpc_endpoints
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RPCEndpoint fields.
+func (re *RPCEndpoint) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case rpcendpoint.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			re.ID = int(value.Int64)
+		case rpcendpoint.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				re.CreatedAt = value.Time
+			}
+		case rpcendpoint.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				re.UpdatedAt = value.Time
+			}
+		case rpcendpoint.FieldURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field url", values[i])
+			} else if value.Valid {
+				re.URL = value.String
+			}
+		case rpcendpoint.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				re.Priority = int(value.Int64)
+			}
+		case rpcendpoint.FieldIsEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_enabled", values[i])
+			} else if value.Valid {
+				re.IsEnabled = value.Bool
+			}
+		case rpcendpoint.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field network_rpc_endpoints", value)
+			} else if value.Valid {
+				re.network_rpc_endpoints = new(int)
+				*re.network_rpc_endpoints = int(value.Int64)
+			}
+		default:
+			re.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RPCEndpoint.
+// This includes values selected through modifiers, order, etc.
+func (re *RPCEndpoint) Value(name string) (ent.Value, error) {
+	return re.selectValues.Get(name)
+}
+
+// QueryNetwork queries the "network" edge of the RPCEndpoint entity.
+func (re *RPCEndpoint) QueryNetwork() *NetworkQuery {
+	return NewRPCEndpointClient(re.config).QueryNetwork(re)
+}
+
+// Update returns a builder for updating this RPCEndpoint.
+// Note that you need to call RPCEndpoint.Unwrap() before calling this method if this RPCEndpoint
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (re *RPCEndpoint) Update() *RPCEndpointUpdateOne {
+	return NewRPCEndpointClient(re.config).UpdateOne(re)
+}
+
+// Unwrap unwraps the RPCEndpoint entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (re *RPCEndpoint) Unwrap() *RPCEndpoint {
+	_tx, ok := re.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RPCEndpoint is not a transactional entity")
+	}
+	re.config.driver = _tx.drv
+	return re
+}
+
+// String implements the fmt.Stringer.
+func (re *RPCEndpoint) String() string {
+	var builder strings.Builder
+	builder.WriteString("RPCEndpoint(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", re.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(re.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(re.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("url=")
+	builder.WriteString(re.URL)
+	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", re.Priority))
+	builder.WriteString(", ")
+	builder.WriteString("is_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", re.IsEnabled))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RPCEndpoints is a parsable slice of RPCEndpoint.
+type RPCEndpoints []*RPCEndpoint
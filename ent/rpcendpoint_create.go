@@ -0,0 +1,755 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/ent/rpcendpoint"
+)
+
+// RPCEndpointCreate is the builder for creating a RPCEndpoint entity.
+type RPCEndpointCreate struct {
+	config
+	mutation *RPCEndpointMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rec *RPCEndpointCreate) SetCreatedAt(t time.Time) *RPCEndpointCreate {
+	rec.mutation.SetCreatedAt(t)
+	return rec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rec *RPCEndpointCreate) SetNillableCreatedAt(t *time.Time) *RPCEndpointCreate {
+	if t != nil {
+		rec.SetCreatedAt(*t)
+	}
+	return rec
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rec *RPCEndpointCreate) SetUpdatedAt(t time.Time) *RPCEndpointCreate {
+	rec.mutation.SetUpdatedAt(t)
+	return rec
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rec *RPCEndpointCreate) SetNillableUpdatedAt(t *time.Time) *RPCEndpointCreate {
+	if t != nil {
+		rec.SetUpdatedAt(*t)
+	}
+	return rec
+}
+
+// SetURL sets the "url" field.
+func (rec *RPCEndpointCreate) SetURL(s string) *RPCEndpointCreate {
+	rec.mutation.SetURL(s)
+	return rec
+}
+
+// SetPriority sets the "priority" field.
+func (rec *RPCEndpointCreate) SetPriority(i int) *RPCEndpointCreate {
+	rec.mutation.SetPriority(i)
+	return rec
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (rec *RPCEndpointCreate) SetNillablePriority(i *int) *RPCEndpointCreate {
+	if i != nil {
+		rec.SetPriority(*i)
+	}
+	return rec
+}
+
+// SetIsEnabled sets the "is_enabled" field.
+func (rec *RPCEndpointCreate) SetIsEnabled(b bool) *RPCEndpointCreate {
+	rec.mutation.SetIsEnabled(b)
+	return rec
+}
+
+// SetNillableIsEnabled sets the "is_enabled" field if the given value is not nil.
+func (rec *RPCEndpointCreate) SetNillableIsEnabled(b *bool) *RPCEndpointCreate {
+	if b != nil {
+		rec.SetIsEnabled(*b)
+	}
+	return rec
+}
+
+// SetNetworkID sets the "network" edge to the Network entity by ID.
+func (rec *RPCEndpointCreate) SetNetworkID(id int) *RPCEndpointCreate {
+	rec.mutation.SetNetworkID(id)
+	return rec
+}
+
+// SetNetwork sets the "network" edge to the Network entity.
+func (rec *RPCEndpointCreate) SetNetwork(n *Network) *RPCEndpointCreate {
+	return rec.SetNetworkID(n.ID)
+}
+
+// Mutation returns the RPCEndpointMutation object of the builder.
+func (rec *RPCEndpointCreate) Mutation() *RPCEndpointMutation {
+	return rec.mutation
+}
+
+// Save creates the RPCEndpoint in the database.
+func (rec *RPCEndpointCreate) Save(ctx context.Context) (*RPCEndpoint, error) {
+	rec.defaults()
+	return withHooks(ctx, rec.sqlSave, rec.mutation, rec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rec *RPCEndpointCreate) SaveX(ctx context.Context) *RPCEndpoint {
+	v, err := rec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rec *RPCEndpointCreate) Exec(ctx context.Context) error {
+	_, err := rec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rec *RPCEndpointCreate) ExecX(ctx context.Context) {
+	if err := rec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rec *RPCEndpointCreate) defaults() {
+	if _, ok := rec.mutation.CreatedAt(); !ok {
+		v := rpcendpoint.DefaultCreatedAt()
+		rec.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rec.mutation.UpdatedAt(); !ok {
+		v := rpcendpoint.DefaultUpdatedAt()
+		rec.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := rec.mutation.Priority(); !ok {
+		v := rpcendpoint.DefaultPriority
+		rec.mutation.SetPriority(v)
+	}
+	if _, ok := rec.mutation.IsEnabled(); !ok {
+		v := rpcendpoint.DefaultIsEnabled
+		rec.mutation.SetIsEnabled(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rec *RPCEndpointCreate) check() error {
+	if _, ok := rec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RPCEndpoint.created_at"`)}
+	}
+	if _, ok := rec.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "RPCEndpoint.updated_at"`)}
+	}
+	if _, ok := rec.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`ent: missing required field "RPCEndpoint.url"`)}
+	}
+	if v, ok := rec.mutation.URL(); ok {
+		if err := rpcendpoint.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "RPCEndpoint.url": %w`, err)}
+		}
+	}
+	if _, ok := rec.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "RPCEndpoint.priority"`)}
+	}
+	if v, ok := rec.mutation.Priority(); ok {
+		if err := rpcendpoint.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "RPCEndpoint.priority": %w`, err)}
+		}
+	}
+	if _, ok := rec.mutation.IsEnabled(); !ok {
+		return &ValidationError{Name: "is_enabled", err: errors.New(`ent: missing required field "RPCEndpoint.is_enabled"`)}
+	}
+	if len(rec.mutation.NetworkIDs()) == 0 {
+		return &ValidationError{Name: "network", err: errors.New(`ent: missing required edge "RPCEndpoint.network"`)}
+	}
+	return nil
+}
+
+func (rec *RPCEndpointCreate) sqlSave(ctx context.Context) (*RPCEndpoint, error) {
+	if err := rec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	rec.mutation.id = &_node.ID
+	rec.mutation.done = true
+	return _node, nil
+}
+
+func (rec *RPCEndpointCreate) createSpec() (*RPCEndpoint, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RPCEndpoint{config: rec.config}
+		_spec = sqlgraph.NewCreateSpec(rpcendpoint.Table, sqlgraph.NewFieldSpec(rpcendpoint.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = rec.conflict
+	if value, ok := rec.mutation.CreatedAt(); ok {
+		_spec.SetField(rpcendpoint.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rec.mutation.UpdatedAt(); ok {
+		_spec.SetField(rpcendpoint.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := rec.mutation.URL(); ok {
+		_spec.SetField(rpcendpoint.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := rec.mutation.Priority(); ok {
+		_spec.SetField(rpcendpoint.FieldPriority, field.TypeInt, value)
+		_node.Priority = value
+	}
+	if value, ok := rec.mutation.IsEnabled(); ok {
+		_spec.SetField(rpcendpoint.FieldIsEnabled, field.TypeBool, value)
+		_node.IsEnabled = value
+	}
+	if nodes := rec.mutation.NetworkIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rpcendpoint.NetworkTable,
+			Columns: []string{rpcendpoint.NetworkColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(network.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.network_rpc_endpoints = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RPCEndpoint.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RPCEndpointUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (rec *RPCEndpointCreate) OnConflict(opts ...sql.ConflictOption) *RPCEndpointUpsertOne {
+	rec.conflict = opts
+	return &RPCEndpointUpsertOne{
+		create: rec,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RPCEndpoint.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rec *RPCEndpointCreate) OnConflictColumns(columns ...string) *RPCEndpointUpsertOne {
+	rec.conflict = append(rec.conflict, sql.ConflictColumns(columns...))
+	return &RPCEndpointUpsertOne{
+		create: rec,
+	}
+}
+
+type (
+	// RPCEndpointUpsertOne is the builder for "upsert"-ing
+	//  one RPCEndpoint node.
+	RPCEndpointUpsertOne struct {
+		create *RPCEndpointCreate
+	}
+
+	// RPCEndpointUpsert is the "OnConflict" setter.
+	RPCEndpointUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RPCEndpointUpsert) SetUpdatedAt(v time.Time) *RPCEndpointUpsert {
+	u.Set(rpcendpoint.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RPCEndpointUpsert) UpdateUpdatedAt() *RPCEndpointUpsert {
+	u.SetExcluded(rpcendpoint.FieldUpdatedAt)
+	return u
+}
+
+// SetURL sets the "url" field.
+func (u *RPCEndpointUpsert) SetURL(v string) *RPCEndpointUpsert {
+	u.Set(rpcendpoint.FieldURL, v)
+	return u
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *RPCEndpointUpsert) UpdateURL() *RPCEndpointUpsert {
+	u.SetExcluded(rpcendpoint.FieldURL)
+	return u
+}
+
+// SetPriority sets the "priority" field.
+func (u *RPCEndpointUpsert) SetPriority(v int) *RPCEndpointUpsert {
+	u.Set(rpcendpoint.FieldPriority, v)
+	return u
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *RPCEndpointUpsert) UpdatePriority() *RPCEndpointUpsert {
+	u.SetExcluded(rpcendpoint.FieldPriority)
+	return u
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *RPCEndpointUpsert) AddPriority(v int) *RPCEndpointUpsert {
+	u.Add(rpcendpoint.FieldPriority, v)
+	return u
+}
+
+// SetIsEnabled sets the "is_enabled" field.
+func (u *RPCEndpointUpsert) SetIsEnabled(v bool) *RPCEndpointUpsert {
+	u.Set(rpcendpoint.FieldIsEnabled, v)
+	return u
+}
+
+// UpdateIsEnabled sets the "is_enabled" field to the value that was provided on create.
+func (u *RPCEndpointUpsert) UpdateIsEnabled() *RPCEndpointUpsert {
+	u.SetExcluded(rpcendpoint.FieldIsEnabled)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.RPCEndpoint.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *RPCEndpointUpsertOne) UpdateNewValues() *RPCEndpointUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(rpcendpoint.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RPCEndpoint.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RPCEndpointUpsertOne) Ignore() *RPCEndpointUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RPCEndpointUpsertOne) DoNothing() *RPCEndpointUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RPCEndpointCreate.OnConflict
+// documentation for more info.
+func (u *RPCEndpointUpsertOne) Update(set func(*RPCEndpointUpsert)) *RPCEndpointUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RPCEndpointUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RPCEndpointUpsertOne) SetUpdatedAt(v time.Time) *RPCEndpointUpsertOne {
+	return u.Update(func(s *RPCEndpointUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RPCEndpointUpsertOne) UpdateUpdatedAt() *RPCEndpointUpsertOne {
+	return u.Update(func(s *RPCEndpointUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *RPCEndpointUpsertOne) SetURL(v string) *RPCEndpointUpsertOne {
+	return u.Update(func(s *RPCEndpointUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *RPCEndpointUpsertOne) UpdateURL() *RPCEndpointUpsertOne {
+	return u.Update(func(s *RPCEndpointUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *RPCEndpointUpsertOne) SetPriority(v int) *RPCEndpointUpsertOne {
+	return u.Update(func(s *RPCEndpointUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *RPCEndpointUpsertOne) AddPriority(v int) *RPCEndpointUpsertOne {
+	return u.Update(func(s *RPCEndpointUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *RPCEndpointUpsertOne) UpdatePriority() *RPCEndpointUpsertOne {
+	return u.Update(func(s *RPCEndpointUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetIsEnabled sets the "is_enabled" field.
+func (u *RPCEndpointUpsertOne) SetIsEnabled(v bool) *RPCEndpointUpsertOne {
+	return u.Update(func(s *RPCEndpointUpsert) {
+		s.SetIsEnabled(v)
+	})
+}
+
+// UpdateIsEnabled sets the "is_enabled" field to the value that was provided on create.
+func (u *RPCEndpointUpsertOne) UpdateIsEnabled() *RPCEndpointUpsertOne {
+	return u.Update(func(s *RPCEndpointUpsert) {
+		s.UpdateIsEnabled()
+	})
+}
+
+// Exec executes the query.
+func (u *RPCEndpointUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RPCEndpointCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RPCEndpointUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RPCEndpointUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RPCEndpointUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// RPCEndpointCreateBulk is the builder for creating many RPCEndpoint entities in bulk.
+type RPCEndpointCreateBulk struct {
+	config
+	err      error
+	builders []*RPCEndpointCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the RPCEndpoint entities in the database.
+func (recb *RPCEndpointCreateBulk) Save(ctx context.Context) ([]*RPCEndpoint, error) {
+	if recb.err != nil {
+		return nil, recb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(recb.builders))
+	nodes := make([]*RPCEndpoint, len(recb.builders))
+	mutators := make([]Mutator, len(recb.builders))
+	for i := range recb.builders {
+		func(i int, root context.Context) {
+			builder := recb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RPCEndpointMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, recb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = recb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, recb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, recb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (recb *RPCEndpointCreateBulk) SaveX(ctx context.Context) []*RPCEndpoint {
+	v, err := recb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (recb *RPCEndpointCreateBulk) Exec(ctx context.Context) error {
+	_, err := recb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (recb *RPCEndpointCreateBulk) ExecX(ctx context.Context) {
+	if err := recb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RPCEndpoint.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RPCEndpointUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (recb *RPCEndpointCreateBulk) OnConflict(opts ...sql.ConflictOption) *RPCEndpointUpsertBulk {
+	recb.conflict = opts
+	return &RPCEndpointUpsertBulk{
+		create: recb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RPCEndpoint.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (recb *RPCEndpointCreateBulk) OnConflictColumns(columns ...string) *RPCEndpointUpsertBulk {
+	recb.conflict = append(recb.conflict, sql.ConflictColumns(columns...))
+	return &RPCEndpointUpsertBulk{
+		create: recb,
+	}
+}
+
+// RPCEndpointUpsertBulk is the builder for "upsert"-ing
+// a bulk of RPCEndpoint nodes.
+type RPCEndpointUpsertBulk struct {
+	create *RPCEndpointCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.RPCEndpoint.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *RPCEndpointUpsertBulk) UpdateNewValues() *RPCEndpointUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(rpcendpoint.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RPCEndpoint.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RPCEndpointUpsertBulk) Ignore() *RPCEndpointUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RPCEndpointUpsertBulk) DoNothing() *RPCEndpointUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RPCEndpointCreateBulk.OnConflict
+// documentation for more info.
+func (u *RPCEndpointUpsertBulk) Update(set func(*RPCEndpointUpsert)) *RPCEndpointUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RPCEndpointUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RPCEndpointUpsertBulk) SetUpdatedAt(v time.Time) *RPCEndpointUpsertBulk {
+	return u.Update(func(s *RPCEndpointUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RPCEndpointUpsertBulk) UpdateUpdatedAt() *RPCEndpointUpsertBulk {
+	return u.Update(func(s *RPCEndpointUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *RPCEndpointUpsertBulk) SetURL(v string) *RPCEndpointUpsertBulk {
+	return u.Update(func(s *RPCEndpointUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *RPCEndpointUpsertBulk) UpdateURL() *RPCEndpointUpsertBulk {
+	return u.Update(func(s *RPCEndpointUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *RPCEndpointUpsertBulk) SetPriority(v int) *RPCEndpointUpsertBulk {
+	return u.Update(func(s *RPCEndpointUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *RPCEndpointUpsertBulk) AddPriority(v int) *RPCEndpointUpsertBulk {
+	return u.Update(func(s *RPCEndpointUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *RPCEndpointUpsertBulk) UpdatePriority() *RPCEndpointUpsertBulk {
+	return u.Update(func(s *RPCEndpointUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetIsEnabled sets the "is_enabled" field.
+func (u *RPCEndpointUpsertBulk) SetIsEnabled(v bool) *RPCEndpointUpsertBulk {
+	return u.Update(func(s *RPCEndpointUpsert) {
+		s.SetIsEnabled(v)
+	})
+}
+
+// UpdateIsEnabled sets the "is_enabled" field to the value that was provided on create.
+func (u *RPCEndpointUpsertBulk) UpdateIsEnabled() *RPCEndpointUpsertBulk {
+	return u.Update(func(s *RPCEndpointUpsert) {
+		s.UpdateIsEnabled()
+	})
+}
+
+// Exec executes the query.
+func (u *RPCEndpointUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RPCEndpointCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RPCEndpointCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RPCEndpointUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/NEDA-LABS/stablenode/ent/rpcendpoint"
+)
+
+// RPCEndpointDelete is the builder for deleting a RPCEndpoint entity.
+type RPCEndpointDelete struct {
+	config
+	hooks    []Hook
+	mutation *RPCEndpointMutation
+}
+
+// Where appends a list predicates to the RPCEndpointDelete builder.
+func (red *RPCEndpointDelete) Where(ps ...predicate.RPCEndpoint) *RPCEndpointDelete {
+	red.mutation.Where(ps...)
+	return red
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (red *RPCEndpointDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, red.sqlExec, red.mutation, red.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (red *RPCEndpointDelete) ExecX(ctx context.Context) int {
+	n, err := red.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (red *RPCEndpointDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(rpcendpoint.Table, sqlgraph.NewFieldSpec(rpcendpoint.FieldID, field.TypeInt))
+	if ps := red.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, red.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	red.mutation.done = true
+	return affected, err
+}
+
+// RPCEndpointDeleteOne is the builder for deleting a single RPCEndpoint entity.
+type RPCEndpointDeleteOne struct {
+	red *RPCEndpointDelete
+}
+
+// Where appends a list predicates to the RPCEndpointDelete builder.
+func (redo *RPCEndpointDeleteOne) Where(ps ...predicate.RPCEndpoint) *RPCEndpointDeleteOne {
+	redo.red.mutation.Where(ps...)
+	return redo
+}
+
+// Exec executes the deletion query.
+func (redo *RPCEndpointDeleteOne) Exec(ctx context.Context) error {
+	n, err := redo.red.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{rpcendpoint.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (redo *RPCEndpointDeleteOne) ExecX(ctx context.Context) {
+	if err := redo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
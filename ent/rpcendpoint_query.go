@@ -0,0 +1,614 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/NEDA-LABS/stablenode/ent/rpcendpoint"
+)
+
+// RPCEndpointQuery is the builder for querying RPCEndpoint entities.
+type RPCEndpointQuery struct {
+	config
+	ctx         *QueryContext
+	order       []rpcendpoint.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.RPCEndpoint
+	withNetwork *NetworkQuery
+	withFKs     bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RPCEndpointQuery builder.
+func (req *RPCEndpointQuery) Where(ps ...predicate.RPCEndpoint) *RPCEndpointQuery {
+	req.predicates = append(req.predicates, ps...)
+	return req
+}
+
+// Limit the number of records to be returned by this query.
+func (req *RPCEndpointQuery) Limit(limit int) *RPCEndpointQuery {
+	req.ctx.Limit = &limit
+	return req
+}
+
+// Offset to start from.
+func (req *RPCEndpointQuery) Offset(offset int) *RPCEndpointQuery {
+	req.ctx.Offset = &offset
+	return req
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (req *RPCEndpointQuery) Unique(unique bool) *RPCEndpointQuery {
+	req.ctx.Unique = &unique
+	return req
+}
+
+// Order specifies how the records should be ordered.
+func (req *RPCEndpointQuery) Order(o ...rpcendpoint.OrderOption) *RPCEndpointQuery {
+	req.order = append(req.order, o...)
+	return req
+}
+
+// QueryNetwork chains the current query on the "network" edge.
+func (req *RPCEndpointQuery) QueryNetwork() *NetworkQuery {
+	query := (&NetworkClient{config: req.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := req.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := req.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rpcendpoint.Table, rpcendpoint.FieldID, selector),
+			sqlgraph.To(network.Table, network.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, rpcendpoint.NetworkTable, rpcendpoint.NetworkColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(req.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first RPCEndpoint entity from the query.
+// Returns a *NotFoundError when no RPCEndpoint was found.
+func (req *RPCEndpointQuery) First(ctx context.Context) (*RPCEndpoint, error) {
+	nodes, err := req.Limit(1).All(setContextOp(ctx, req.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{rpcendpoint.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (req *RPCEndpointQuery) FirstX(ctx context.Context) *RPCEndpoint {
+	node, err := req.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first RPCEndpoint ID from the query.
+// Returns a *NotFoundError when no RPCEndpoint ID was found.
+func (req *RPCEndpointQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = req.Limit(1).IDs(setContextOp(ctx, req.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{rpcendpoint.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (req *RPCEndpointQuery) FirstIDX(ctx context.Context) int {
+	id, err := req.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single RPCEndpoint entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one RPCEndpoint entity is found.
+// Returns a *NotFoundError when no RPCEndpoint entities are found.
+func (req *RPCEndpointQuery) Only(ctx context.Context) (*RPCEndpoint, error) {
+	nodes, err := req.Limit(2).All(setContextOp(ctx, req.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{rpcendpoint.Label}
+	default:
+		return nil, &NotSingularError{rpcendpoint.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (req *RPCEndpointQuery) OnlyX(ctx context.Context) *RPCEndpoint {
+	node, err := req.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only RPCEndpoint ID in the query.
+// Returns a *NotSingularError when more than one RPCEndpoint ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (req *RPCEndpointQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = req.Limit(2).IDs(setContextOp(ctx, req.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{rpcendpoint.Label}
+	default:
+		err = &NotSingularError{rpcendpoint.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (req *RPCEndpointQuery) OnlyIDX(ctx context.Context) int {
+	id, err := req.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of RPCEndpoints.
+func (req *RPCEndpointQuery) All(ctx context.Context) ([]*RPCEndpoint, error) {
+	ctx = setContextOp(ctx, req.ctx, ent.OpQueryAll)
+	if err := req.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*RPCEndpoint, *RPCEndpointQuery]()
+	return withInterceptors[[]*RPCEndpoint](ctx, req, qr, req.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (req *RPCEndpointQuery) AllX(ctx context.Context) []*RPCEndpoint {
+	nodes, err := req.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of RPCEndpoint IDs.
+func (req *RPCEndpointQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if req.ctx.Unique == nil && req.path != nil {
+		req.Unique(true)
+	}
+	ctx = setContextOp(ctx, req.ctx, ent.OpQueryIDs)
+	if err = req.Select(rpcendpoint.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (req *RPCEndpointQuery) IDsX(ctx context.Context) []int {
+	ids, err := req.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (req *RPCEndpointQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, req.ctx, ent.OpQueryCount)
+	if err := req.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, req, querierCount[*RPCEndpointQuery](), req.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (req *RPCEndpointQuery) CountX(ctx context.Context) int {
+	count, err := req.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (req *RPCEndpointQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, req.ctx, ent.OpQueryExist)
+	switch _, err := req.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (req *RPCEndpointQuery) ExistX(ctx context.Context) bool {
+	exist, err := req.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RPCEndpointQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (req *RPCEndpointQuery) Clone() *RPCEndpointQuery {
+	if req == nil {
+		return nil
+	}
+	return &RPCEndpointQuery{
+		config:      req.config,
+		ctx:         req.ctx.Clone(),
+		order:       append([]rpcendpoint.OrderOption{}, req.order...),
+		inters:      append([]Interceptor{}, req.inters...),
+		predicates:  append([]predicate.RPCEndpoint{}, req.predicates...),
+		withNetwork: req.withNetwork.Clone(),
+		// clone intermediate query.
+		sql:  req.sql.Clone(),
+		path: req.path,
+	}
+}
+
+// WithNetwork tells the query-builder to eager-load the nodes that are connected to
+// the "network" edge. The optional arguments are used to configure the query builder of the edge.
+func (req *RPCEndpointQuery) WithNetwork(opts ...func(*NetworkQuery)) *RPCEndpointQuery {
+	query := (&NetworkClient{config: req.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	req.withNetwork = query
+	return req
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RPCEndpoint.Query().
+//		GroupBy(rpcendpoint.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (req *RPCEndpointQuery) GroupBy(field string, fields ...string) *RPCEndpointGroupBy {
+	req.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &RPCEndpointGroupBy{build: req}
+	grbuild.flds = &req.ctx.Fields
+	grbuild.label = rpcendpoint.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.RPCEndpoint.Query().
+//		Select(rpcendpoint.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (req *RPCEndpointQuery) Select(fields ...string) *RPCEndpointSelect {
+	req.ctx.Fields = append(req.ctx.Fields, fields...)
+	sbuild := &RPCEndpointSelect{RPCEndpointQuery: req}
+	sbuild.label = rpcendpoint.Label
+	sbuild.flds, sbuild.scan = &req.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a RPCEndpointSelect configured with the given aggregations.
+func (req *RPCEndpointQuery) Aggregate(fns ...AggregateFunc) *RPCEndpointSelect {
+	return req.Select().Aggregate(fns...)
+}
+
+func (req *RPCEndpointQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range req.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, req); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range req.ctx.Fields {
+		if !rpcendpoint.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if req.path != nil {
+		prev, err := req.path(ctx)
+		if err != nil {
+			return err
+		}
+		req.sql = prev
+	}
+	return nil
+}
+
+func (req *RPCEndpointQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*RPCEndpoint, error) {
+	var (
+		nodes       = []*RPCEndpoint{}
+		withFKs     = req.withFKs
+		_spec       = req.querySpec()
+		loadedTypes = [1]bool{
+			req.withNetwork != nil,
+		}
+	)
+	if req.withNetwork != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, rpcendpoint.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*RPCEndpoint).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &RPCEndpoint{config: req.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, req.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := req.withNetwork; query != nil {
+		if err := req.loadNetwork(ctx, query, nodes, nil,
+			func(n *RPCEndpoint, e *Network) { n.Edges.Network = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (req *RPCEndpointQuery) loadNetwork(ctx context.Context, query *NetworkQuery, nodes []*RPCEndpoint, init func(*RPCEndpoint), assign func(*RPCEndpoint, *Network)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*RPCEndpoint)
+	for i := range nodes {
+		if nodes[i].network_rpc_endpoints == nil {
+			continue
+		}
+		fk := *nodes[i].network_rpc_endpoints
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(network.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "network_rpc_endpoints" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (req *RPCEndpointQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := req.querySpec()
+	_spec.Node.Columns = req.ctx.Fields
+	if len(req.ctx.Fields) > 0 {
+		_spec.Unique = req.ctx.Unique != nil && *req.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, req.driver, _spec)
+}
+
+func (req *RPCEndpointQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(rpcendpoint.Table, rpcendpoint.Columns, sqlgraph.NewFieldSpec(rpcendpoint.FieldID, field.TypeInt))
+	_spec.From = req.sql
+	if unique := req.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if req.path != nil {
+		_spec.Unique = true
+	}
+	if fields := req.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, rpcendpoint.FieldID)
+		for i := range fields {
+			if fields[i] != rpcendpoint.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := req.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := req.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := req.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := req.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (req *RPCEndpointQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(req.driver.Dialect())
+	t1 := builder.Table(rpcendpoint.Table)
+	columns := req.ctx.Fields
+	if len(columns) == 0 {
+		columns = rpcendpoint.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if req.sql != nil {
+		selector = req.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if req.ctx.Unique != nil && *req.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range req.predicates {
+		p(selector)
+	}
+	for _, p := range req.order {
+		p(selector)
+	}
+	if offset := req.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := req.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// RPCEndpointGroupBy is the group-by builder for RPCEndpoint entities.
+type RPCEndpointGroupBy struct {
+	selector
+	build *RPCEndpointQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (regb *RPCEndpointGroupBy) Aggregate(fns ...AggregateFunc) *RPCEndpointGroupBy {
+	regb.fns = append(regb.fns, fns...)
+	return regb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (regb *RPCEndpointGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, regb.build.ctx, ent.OpQueryGroupBy)
+	if err := regb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RPCEndpointQuery, *RPCEndpointGroupBy](ctx, regb.build, regb, regb.build.inters, v)
+}
+
+func (regb *RPCEndpointGroupBy) sqlScan(ctx context.Context, root *RPCEndpointQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(regb.fns))
+	for _, fn := range regb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*regb.flds)+len(regb.fns))
+		for _, f := range *regb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*regb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := regb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// RPCEndpointSelect is the builder for selecting fields of RPCEndpoint entities.
+type RPCEndpointSelect struct {
+	*RPCEndpointQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (res *RPCEndpointSelect) Aggregate(fns ...AggregateFunc) *RPCEndpointSelect {
+	res.fns = append(res.fns, fns...)
+	return res
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (res *RPCEndpointSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, res.ctx, ent.OpQuerySelect)
+	if err := res.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RPCEndpointQuery, *RPCEndpointSelect](ctx, res.RPCEndpointQuery, res, res.inters, v)
+}
+
+func (res *RPCEndpointSelect) sqlScan(ctx context.Context, root *RPCEndpointQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(res.fns))
+	for _, fn := range res.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*res.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := res.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,469 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/NEDA-LABS/stablenode/ent/rpcendpoint"
+)
+
+// RPCEndpointUpdate is the builder for updating RPCEndpoint entities.
+type RPCEndpointUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RPCEndpointMutation
+}
+
+// Where appends a list predicates to the RPCEndpointUpdate builder.
+func (reu *RPCEndpointUpdate) Where(ps ...predicate.RPCEndpoint) *RPCEndpointUpdate {
+	reu.mutation.Where(ps...)
+	return reu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (reu *RPCEndpointUpdate) SetUpdatedAt(t time.Time) *RPCEndpointUpdate {
+	reu.mutation.SetUpdatedAt(t)
+	return reu
+}
+
+// SetURL sets the "url" field.
+func (reu *RPCEndpointUpdate) SetURL(s string) *RPCEndpointUpdate {
+	reu.mutation.SetURL(s)
+	return reu
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (reu *RPCEndpointUpdate) SetNillableURL(s *string) *RPCEndpointUpdate {
+	if s != nil {
+		reu.SetURL(*s)
+	}
+	return reu
+}
+
+// SetPriority sets the "priority" field.
+func (reu *RPCEndpointUpdate) SetPriority(i int) *RPCEndpointUpdate {
+	reu.mutation.ResetPriority()
+	reu.mutation.SetPriority(i)
+	return reu
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (reu *RPCEndpointUpdate) SetNillablePriority(i *int) *RPCEndpointUpdate {
+	if i != nil {
+		reu.SetPriority(*i)
+	}
+	return reu
+}
+
+// AddPriority adds i to the "priority" field.
+func (reu *RPCEndpointUpdate) AddPriority(i int) *RPCEndpointUpdate {
+	reu.mutation.AddPriority(i)
+	return reu
+}
+
+// SetIsEnabled sets the "is_enabled" field.
+func (reu *RPCEndpointUpdate) SetIsEnabled(b bool) *RPCEndpointUpdate {
+	reu.mutation.SetIsEnabled(b)
+	return reu
+}
+
+// SetNillableIsEnabled sets the "is_enabled" field if the given value is not nil.
+func (reu *RPCEndpointUpdate) SetNillableIsEnabled(b *bool) *RPCEndpointUpdate {
+	if b != nil {
+		reu.SetIsEnabled(*b)
+	}
+	return reu
+}
+
+// SetNetworkID sets the "network" edge to the Network entity by ID.
+func (reu *RPCEndpointUpdate) SetNetworkID(id int) *RPCEndpointUpdate {
+	reu.mutation.SetNetworkID(id)
+	return reu
+}
+
+// SetNetwork sets the "network" edge to the Network entity.
+func (reu *RPCEndpointUpdate) SetNetwork(n *Network) *RPCEndpointUpdate {
+	return reu.SetNetworkID(n.ID)
+}
+
+// Mutation returns the RPCEndpointMutation object of the builder.
+func (reu *RPCEndpointUpdate) Mutation() *RPCEndpointMutation {
+	return reu.mutation
+}
+
+// ClearNetwork clears the "network" edge to the Network entity.
+func (reu *RPCEndpointUpdate) ClearNetwork() *RPCEndpointUpdate {
+	reu.mutation.ClearNetwork()
+	return reu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (reu *RPCEndpointUpdate) Save(ctx context.Context) (int, error) {
+	reu.defaults()
+	return withHooks(ctx, reu.sqlSave, reu.mutation, reu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (reu *RPCEndpointUpdate) SaveX(ctx context.Context) int {
+	affected, err := reu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (reu *RPCEndpointUpdate) Exec(ctx context.Context) error {
+	_, err := reu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (reu *RPCEndpointUpdate) ExecX(ctx context.Context) {
+	if err := reu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (reu *RPCEndpointUpdate) defaults() {
+	if _, ok := reu.mutation.UpdatedAt(); !ok {
+		v := rpcendpoint.UpdateDefaultUpdatedAt()
+		reu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (reu *RPCEndpointUpdate) check() error {
+	if v, ok := reu.mutation.URL(); ok {
+		if err := rpcendpoint.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "RPCEndpoint.url": %w`, err)}
+		}
+	}
+	if v, ok := reu.mutation.Priority(); ok {
+		if err := rpcendpoint.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "RPCEndpoint.priority": %w`, err)}
+		}
+	}
+	if reu.mutation.NetworkCleared() && len(reu.mutation.NetworkIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RPCEndpoint.network"`)
+	}
+	return nil
+}
+
+func (reu *RPCEndpointUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := reu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rpcendpoint.Table, rpcendpoint.Columns, sqlgraph.NewFieldSpec(rpcendpoint.FieldID, field.TypeInt))
+	if ps := reu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := reu.mutation.UpdatedAt(); ok {
+		_spec.SetField(rpcendpoint.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := reu.mutation.URL(); ok {
+		_spec.SetField(rpcendpoint.FieldURL, field.TypeString, value)
+	}
+	if value, ok := reu.mutation.Priority(); ok {
+		_spec.SetField(rpcendpoint.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := reu.mutation.AddedPriority(); ok {
+		_spec.AddField(rpcendpoint.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := reu.mutation.IsEnabled(); ok {
+		_spec.SetField(rpcendpoint.FieldIsEnabled, field.TypeBool, value)
+	}
+	if reu.mutation.NetworkCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rpcendpoint.NetworkTable,
+			Columns: []string{rpcendpoint.NetworkColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(network.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := reu.mutation.NetworkIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rpcendpoint.NetworkTable,
+			Columns: []string{rpcendpoint.NetworkColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(network.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, reu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rpcendpoint.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	reu.mutation.done = true
+	return n, nil
+}
+
+// RPCEndpointUpdateOne is the builder for updating a single RPCEndpoint entity.
+type RPCEndpointUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RPCEndpointMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (reuo *RPCEndpointUpdateOne) SetUpdatedAt(t time.Time) *RPCEndpointUpdateOne {
+	reuo.mutation.SetUpdatedAt(t)
+	return reuo
+}
+
+// SetURL sets the "url" field.
+func (reuo *RPCEndpointUpdateOne) SetURL(s string) *RPCEndpointUpdateOne {
+	reuo.mutation.SetURL(s)
+	return reuo
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (reuo *RPCEndpointUpdateOne) SetNillableURL(s *string) *RPCEndpointUpdateOne {
+	if s != nil {
+		reuo.SetURL(*s)
+	}
+	return reuo
+}
+
+// SetPriority sets the "priority" field.
+func (reuo *RPCEndpointUpdateOne) SetPriority(i int) *RPCEndpointUpdateOne {
+	reuo.mutation.ResetPriority()
+	reuo.mutation.SetPriority(i)
+	return reuo
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (reuo *RPCEndpointUpdateOne) SetNillablePriority(i *int) *RPCEndpointUpdateOne {
+	if i != nil {
+		reuo.SetPriority(*i)
+	}
+	return reuo
+}
+
+// AddPriority adds i to the "priority" field.
+func (reuo *RPCEndpointUpdateOne) AddPriority(i int) *RPCEndpointUpdateOne {
+	reuo.mutation.AddPriority(i)
+	return reuo
+}
+
+// SetIsEnabled sets the "is_enabled" field.
+func (reuo *RPCEndpointUpdateOne) SetIsEnabled(b bool) *RPCEndpointUpdateOne {
+	reuo.mutation.SetIsEnabled(b)
+	return reuo
+}
+
+// SetNillableIsEnabled sets the "is_enabled" field if the given value is not nil.
+func (reuo *RPCEndpointUpdateOne) SetNillableIsEnabled(b *bool) *RPCEndpointUpdateOne {
+	if b != nil {
+		reuo.SetIsEnabled(*b)
+	}
+	return reuo
+}
+
+// SetNetworkID sets the "network" edge to the Network entity by ID.
+func (reuo *RPCEndpointUpdateOne) SetNetworkID(id int) *RPCEndpointUpdateOne {
+	reuo.mutation.SetNetworkID(id)
+	return reuo
+}
+
+// SetNetwork sets the "network" edge to the Network entity.
+func (reuo *RPCEndpointUpdateOne) SetNetwork(n *Network) *RPCEndpointUpdateOne {
+	return reuo.SetNetworkID(n.ID)
+}
+
+// Mutation returns the RPCEndpointMutation object of the builder.
+func (reuo *RPCEndpointUpdateOne) Mutation() *RPCEndpointMutation {
+	return reuo.mutation
+}
+
+// ClearNetwork clears the "network" edge to the Network entity.
+func (reuo *RPCEndpointUpdateOne) ClearNetwork() *RPCEndpointUpdateOne {
+	reuo.mutation.ClearNetwork()
+	return reuo
+}
+
+// Where appends a list predicates to the RPCEndpointUpdate builder.
+func (reuo *RPCEndpointUpdateOne) Where(ps ...predicate.RPCEndpoint) *RPCEndpointUpdateOne {
+	reuo.mutation.Where(ps...)
+	return reuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (reuo *RPCEndpointUpdateOne) Select(field string, fields ...string) *RPCEndpointUpdateOne {
+	reuo.fields = append([]string{field}, fields...)
+	return reuo
+}
+
+// Save executes the query and returns the updated RPCEndpoint entity.
+func (reuo *RPCEndpointUpdateOne) Save(ctx context.Context) (*RPCEndpoint, error) {
+	reuo.defaults()
+	return withHooks(ctx, reuo.sqlSave, reuo.mutation, reuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (reuo *RPCEndpointUpdateOne) SaveX(ctx context.Context) *RPCEndpoint {
+	node, err := reuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (reuo *RPCEndpointUpdateOne) Exec(ctx context.Context) error {
+	_, err := reuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (reuo *RPCEndpointUpdateOne) ExecX(ctx context.Context) {
+	if err := reuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (reuo *RPCEndpointUpdateOne) defaults() {
+	if _, ok := reuo.mutation.UpdatedAt(); !ok {
+		v := rpcendpoint.UpdateDefaultUpdatedAt()
+		reuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (reuo *RPCEndpointUpdateOne) check() error {
+	if v, ok := reuo.mutation.URL(); ok {
+		if err := rpcendpoint.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "RPCEndpoint.url": %w`, err)}
+		}
+	}
+	if v, ok := reuo.mutation.Priority(); ok {
+		if err := rpcendpoint.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "RPCEndpoint.priority": %w`, err)}
+		}
+	}
+	if reuo.mutation.NetworkCleared() && len(reuo.mutation.NetworkIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RPCEndpoint.network"`)
+	}
+	return nil
+}
+
+func (reuo *RPCEndpointUpdateOne) sqlSave(ctx context.Context) (_node *RPCEndpoint, err error) {
+	if err := reuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rpcendpoint.Table, rpcendpoint.Columns, sqlgraph.NewFieldSpec(rpcendpoint.FieldID, field.TypeInt))
+	id, ok := reuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RPCEndpoint.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := reuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, rpcendpoint.FieldID)
+		for _, f := range fields {
+			if !rpcendpoint.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != rpcendpoint.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := reuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := reuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(rpcendpoint.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := reuo.mutation.URL(); ok {
+		_spec.SetField(rpcendpoint.FieldURL, field.TypeString, value)
+	}
+	if value, ok := reuo.mutation.Priority(); ok {
+		_spec.SetField(rpcendpoint.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := reuo.mutation.AddedPriority(); ok {
+		_spec.AddField(rpcendpoint.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := reuo.mutation.IsEnabled(); ok {
+		_spec.SetField(rpcendpoint.FieldIsEnabled, field.TypeBool, value)
+	}
+	if reuo.mutation.NetworkCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rpcendpoint.NetworkTable,
+			Columns: []string{rpcendpoint.NetworkColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(network.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := reuo.mutation.NetworkIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rpcendpoint.NetworkTable,
+			Columns: []string{rpcendpoint.NetworkColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(network.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &RPCEndpoint{config: reuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, reuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rpcendpoint.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	reuo.mutation.done = true
+	return _node, nil
+}
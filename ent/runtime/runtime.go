@@ -5,33 +5,55 @@ package runtime
 import (
 	"time"
 
+	"github.com/NEDA-LABS/stablenode/ent/adminauditlog"
 	"github.com/NEDA-LABS/stablenode/ent/apikey"
 	"github.com/NEDA-LABS/stablenode/ent/beneficialowner"
+	"github.com/NEDA-LABS/stablenode/ent/blockchainroute"
+	"github.com/NEDA-LABS/stablenode/ent/deadletter"
+	"github.com/NEDA-LABS/stablenode/ent/denylistedaddress"
+	"github.com/NEDA-LABS/stablenode/ent/depositsplit"
+	"github.com/NEDA-LABS/stablenode/ent/feeschedule"
 	"github.com/NEDA-LABS/stablenode/ent/fiatcurrency"
 	"github.com/NEDA-LABS/stablenode/ent/identityverificationrequest"
 	"github.com/NEDA-LABS/stablenode/ent/institution"
 	"github.com/NEDA-LABS/stablenode/ent/kybprofile"
+	"github.com/NEDA-LABS/stablenode/ent/ledgeraccount"
+	"github.com/NEDA-LABS/stablenode/ent/ledgerentry"
+	"github.com/NEDA-LABS/stablenode/ent/ledgerposting"
 	"github.com/NEDA-LABS/stablenode/ent/linkedaddress"
 	"github.com/NEDA-LABS/stablenode/ent/lockorderfulfillment"
+	"github.com/NEDA-LABS/stablenode/ent/lockorderreassignment"
 	"github.com/NEDA-LABS/stablenode/ent/lockpaymentorder"
 	"github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/ent/outboxtransaction"
 	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
 	"github.com/NEDA-LABS/stablenode/ent/paymentwebhook"
+	"github.com/NEDA-LABS/stablenode/ent/providerbalancesnapshot"
 	"github.com/NEDA-LABS/stablenode/ent/providercurrencies"
 	"github.com/NEDA-LABS/stablenode/ent/providerordertoken"
+	"github.com/NEDA-LABS/stablenode/ent/providerperformance"
 	"github.com/NEDA-LABS/stablenode/ent/providerprofile"
 	"github.com/NEDA-LABS/stablenode/ent/providerrating"
 	"github.com/NEDA-LABS/stablenode/ent/provisionbucket"
 	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddressassignment"
+	"github.com/NEDA-LABS/stablenode/ent/reconciliationdiscrepancy"
+	"github.com/NEDA-LABS/stablenode/ent/reconciliationreport"
+	"github.com/NEDA-LABS/stablenode/ent/rpcendpoint"
 	"github.com/NEDA-LABS/stablenode/ent/schema"
 	"github.com/NEDA-LABS/stablenode/ent/senderordertoken"
 	"github.com/NEDA-LABS/stablenode/ent/senderprofile"
+	"github.com/NEDA-LABS/stablenode/ent/sweep"
 	"github.com/NEDA-LABS/stablenode/ent/token"
 	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
+	"github.com/NEDA-LABS/stablenode/ent/unmatcheddeposit"
 	"github.com/NEDA-LABS/stablenode/ent/user"
 	"github.com/NEDA-LABS/stablenode/ent/verificationtoken"
+	"github.com/NEDA-LABS/stablenode/ent/webhookdelivery"
+	"github.com/NEDA-LABS/stablenode/ent/webhookdestination"
 	"github.com/NEDA-LABS/stablenode/ent/webhookretryattempt"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 // The init function reads all schema descriptors with runtime code
@@ -44,10 +66,36 @@ func init() {
 	apikeyDescSecret := apikeyFields[1].Descriptor()
 	// apikey.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
 	apikey.SecretValidator = apikeyDescSecret.Validators[0].(func(string) error)
+	// apikeyDescName is the schema descriptor for name field.
+	apikeyDescName := apikeyFields[2].Descriptor()
+	// apikey.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	apikey.NameValidator = apikeyDescName.Validators[0].(func(string) error)
+	// apikeyDescRateLimit is the schema descriptor for rate_limit field.
+	apikeyDescRateLimit := apikeyFields[4].Descriptor()
+	// apikey.RateLimitValidator is a validator for the "rate_limit" field. It is called by the builders before save.
+	apikey.RateLimitValidator = apikeyDescRateLimit.Validators[0].(func(int) error)
+	// apikeyDescCreatedAt is the schema descriptor for created_at field.
+	apikeyDescCreatedAt := apikeyFields[7].Descriptor()
+	// apikey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apikey.DefaultCreatedAt = apikeyDescCreatedAt.Default.(func() time.Time)
 	// apikeyDescID is the schema descriptor for id field.
 	apikeyDescID := apikeyFields[0].Descriptor()
 	// apikey.DefaultID holds the default value on creation for the id field.
 	apikey.DefaultID = apikeyDescID.Default.(func() uuid.UUID)
+	adminauditlogFields := schema.AdminAuditLog{}.Fields()
+	_ = adminauditlogFields
+	// adminauditlogDescReason is the schema descriptor for reason field.
+	adminauditlogDescReason := adminauditlogFields[4].Descriptor()
+	// adminauditlog.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	adminauditlog.ReasonValidator = adminauditlogDescReason.Validators[0].(func(string) error)
+	// adminauditlogDescCreatedAt is the schema descriptor for created_at field.
+	adminauditlogDescCreatedAt := adminauditlogFields[6].Descriptor()
+	// adminauditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	adminauditlog.DefaultCreatedAt = adminauditlogDescCreatedAt.Default.(func() time.Time)
+	// adminauditlogDescID is the schema descriptor for id field.
+	adminauditlogDescID := adminauditlogFields[0].Descriptor()
+	// adminauditlog.DefaultID holds the default value on creation for the id field.
+	adminauditlog.DefaultID = adminauditlogDescID.Default.(func() uuid.UUID)
 	beneficialownerFields := schema.BeneficialOwner{}.Fields()
 	_ = beneficialownerFields
 	// beneficialownerDescFullName is the schema descriptor for full_name field.
@@ -58,6 +106,191 @@ func init() {
 	beneficialownerDescID := beneficialownerFields[0].Descriptor()
 	// beneficialowner.DefaultID holds the default value on creation for the id field.
 	beneficialowner.DefaultID = beneficialownerDescID.Default.(func() uuid.UUID)
+	blockchainrouteMixin := schema.BlockchainRoute{}.Mixin()
+	blockchainrouteMixinFields0 := blockchainrouteMixin[0].Fields()
+	_ = blockchainrouteMixinFields0
+	blockchainrouteFields := schema.BlockchainRoute{}.Fields()
+	_ = blockchainrouteFields
+	// blockchainrouteDescCreatedAt is the schema descriptor for created_at field.
+	blockchainrouteDescCreatedAt := blockchainrouteMixinFields0[0].Descriptor()
+	// blockchainroute.DefaultCreatedAt holds the default value on creation for the created_at field.
+	blockchainroute.DefaultCreatedAt = blockchainrouteDescCreatedAt.Default.(func() time.Time)
+	// blockchainrouteDescUpdatedAt is the schema descriptor for updated_at field.
+	blockchainrouteDescUpdatedAt := blockchainrouteMixinFields0[1].Descriptor()
+	// blockchainroute.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	blockchainroute.DefaultUpdatedAt = blockchainrouteDescUpdatedAt.Default.(func() time.Time)
+	// blockchainroute.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	blockchainroute.UpdateDefaultUpdatedAt = blockchainrouteDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// blockchainrouteDescToken is the schema descriptor for token field.
+	blockchainrouteDescToken := blockchainrouteFields[2].Descriptor()
+	// blockchainroute.DefaultToken holds the default value on creation for the token field.
+	blockchainroute.DefaultToken = blockchainrouteDescToken.Default.(string)
+	// blockchainroute.TokenValidator is a validator for the "token" field. It is called by the builders before save.
+	blockchainroute.TokenValidator = blockchainrouteDescToken.Validators[0].(func(string) error)
+	// blockchainrouteDescNetwork is the schema descriptor for network field.
+	blockchainrouteDescNetwork := blockchainrouteFields[3].Descriptor()
+	// blockchainroute.DefaultNetwork holds the default value on creation for the network field.
+	blockchainroute.DefaultNetwork = blockchainrouteDescNetwork.Default.(string)
+	// blockchainroute.NetworkValidator is a validator for the "network" field. It is called by the builders before save.
+	blockchainroute.NetworkValidator = blockchainrouteDescNetwork.Validators[0].(func(string) error)
+	// blockchainrouteDescMinAmount is the schema descriptor for min_amount field.
+	blockchainrouteDescMinAmount := blockchainrouteFields[4].Descriptor()
+	// blockchainroute.DefaultMinAmount holds the default value on creation for the min_amount field.
+	blockchainroute.DefaultMinAmount = blockchainrouteDescMinAmount.Default.(func() decimal.Decimal)
+	// blockchainrouteDescPriority is the schema descriptor for priority field.
+	blockchainrouteDescPriority := blockchainrouteFields[6].Descriptor()
+	// blockchainroute.DefaultPriority holds the default value on creation for the priority field.
+	blockchainroute.DefaultPriority = blockchainrouteDescPriority.Default.(int)
+	// blockchainrouteDescIsEnabled is the schema descriptor for is_enabled field.
+	blockchainrouteDescIsEnabled := blockchainrouteFields[7].Descriptor()
+	// blockchainroute.DefaultIsEnabled holds the default value on creation for the is_enabled field.
+	blockchainroute.DefaultIsEnabled = blockchainrouteDescIsEnabled.Default.(bool)
+	// blockchainrouteDescID is the schema descriptor for id field.
+	blockchainrouteDescID := blockchainrouteFields[0].Descriptor()
+	// blockchainroute.DefaultID holds the default value on creation for the id field.
+	blockchainroute.DefaultID = blockchainrouteDescID.Default.(func() uuid.UUID)
+	deadletterMixin := schema.DeadLetter{}.Mixin()
+	deadletterMixinFields0 := deadletterMixin[0].Fields()
+	_ = deadletterMixinFields0
+	deadletterFields := schema.DeadLetter{}.Fields()
+	_ = deadletterFields
+	// deadletterDescCreatedAt is the schema descriptor for created_at field.
+	deadletterDescCreatedAt := deadletterMixinFields0[0].Descriptor()
+	// deadletter.DefaultCreatedAt holds the default value on creation for the created_at field.
+	deadletter.DefaultCreatedAt = deadletterDescCreatedAt.Default.(func() time.Time)
+	// deadletterDescUpdatedAt is the schema descriptor for updated_at field.
+	deadletterDescUpdatedAt := deadletterMixinFields0[1].Descriptor()
+	// deadletter.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	deadletter.DefaultUpdatedAt = deadletterDescUpdatedAt.Default.(func() time.Time)
+	// deadletter.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	deadletter.UpdateDefaultUpdatedAt = deadletterDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// deadletterDescLastError is the schema descriptor for last_error field.
+	deadletterDescLastError := deadletterFields[5].Descriptor()
+	// deadletter.LastErrorValidator is a validator for the "last_error" field. It is called by the builders before save.
+	deadletter.LastErrorValidator = deadletterDescLastError.Validators[0].(func(string) error)
+	// deadletterDescRetryCount is the schema descriptor for retry_count field.
+	deadletterDescRetryCount := deadletterFields[7].Descriptor()
+	// deadletter.DefaultRetryCount holds the default value on creation for the retry_count field.
+	deadletter.DefaultRetryCount = deadletterDescRetryCount.Default.(int)
+	// deadletterDescNextRetryAt is the schema descriptor for next_retry_at field.
+	deadletterDescNextRetryAt := deadletterFields[8].Descriptor()
+	// deadletter.DefaultNextRetryAt holds the default value on creation for the next_retry_at field.
+	deadletter.DefaultNextRetryAt = deadletterDescNextRetryAt.Default.(func() time.Time)
+	// deadletterDescID is the schema descriptor for id field.
+	deadletterDescID := deadletterFields[0].Descriptor()
+	// deadletter.DefaultID holds the default value on creation for the id field.
+	deadletter.DefaultID = deadletterDescID.Default.(func() uuid.UUID)
+	denylistedaddressMixin := schema.DenylistedAddress{}.Mixin()
+	denylistedaddressMixinFields0 := denylistedaddressMixin[0].Fields()
+	_ = denylistedaddressMixinFields0
+	denylistedaddressFields := schema.DenylistedAddress{}.Fields()
+	_ = denylistedaddressFields
+	// denylistedaddressDescCreatedAt is the schema descriptor for created_at field.
+	denylistedaddressDescCreatedAt := denylistedaddressMixinFields0[0].Descriptor()
+	// denylistedaddress.DefaultCreatedAt holds the default value on creation for the created_at field.
+	denylistedaddress.DefaultCreatedAt = denylistedaddressDescCreatedAt.Default.(func() time.Time)
+	// denylistedaddressDescUpdatedAt is the schema descriptor for updated_at field.
+	denylistedaddressDescUpdatedAt := denylistedaddressMixinFields0[1].Descriptor()
+	// denylistedaddress.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	denylistedaddress.DefaultUpdatedAt = denylistedaddressDescUpdatedAt.Default.(func() time.Time)
+	// denylistedaddress.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	denylistedaddress.UpdateDefaultUpdatedAt = denylistedaddressDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// denylistedaddressDescAddress is the schema descriptor for address field.
+	denylistedaddressDescAddress := denylistedaddressFields[1].Descriptor()
+	// denylistedaddress.AddressValidator is a validator for the "address" field. It is called by the builders before save.
+	denylistedaddress.AddressValidator = denylistedaddressDescAddress.Validators[0].(func(string) error)
+	// denylistedaddressDescNetwork is the schema descriptor for network field.
+	denylistedaddressDescNetwork := denylistedaddressFields[2].Descriptor()
+	// denylistedaddress.DefaultNetwork holds the default value on creation for the network field.
+	denylistedaddress.DefaultNetwork = denylistedaddressDescNetwork.Default.(string)
+	// denylistedaddress.NetworkValidator is a validator for the "network" field. It is called by the builders before save.
+	denylistedaddress.NetworkValidator = denylistedaddressDescNetwork.Validators[0].(func(string) error)
+	// denylistedaddressDescReason is the schema descriptor for reason field.
+	denylistedaddressDescReason := denylistedaddressFields[3].Descriptor()
+	// denylistedaddress.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	denylistedaddress.ReasonValidator = denylistedaddressDescReason.Validators[0].(func(string) error)
+	// denylistedaddressDescSource is the schema descriptor for source field.
+	denylistedaddressDescSource := denylistedaddressFields[4].Descriptor()
+	// denylistedaddress.SourceValidator is a validator for the "source" field. It is called by the builders before save.
+	denylistedaddress.SourceValidator = denylistedaddressDescSource.Validators[0].(func(string) error)
+	// denylistedaddressDescID is the schema descriptor for id field.
+	denylistedaddressDescID := denylistedaddressFields[0].Descriptor()
+	// denylistedaddress.DefaultID holds the default value on creation for the id field.
+	denylistedaddress.DefaultID = denylistedaddressDescID.Default.(func() uuid.UUID)
+	depositsplitMixin := schema.DepositSplit{}.Mixin()
+	depositsplitMixinFields0 := depositsplitMixin[0].Fields()
+	_ = depositsplitMixinFields0
+	depositsplitFields := schema.DepositSplit{}.Fields()
+	_ = depositsplitFields
+	// depositsplitDescCreatedAt is the schema descriptor for created_at field.
+	depositsplitDescCreatedAt := depositsplitMixinFields0[0].Descriptor()
+	// depositsplit.DefaultCreatedAt holds the default value on creation for the created_at field.
+	depositsplit.DefaultCreatedAt = depositsplitDescCreatedAt.Default.(func() time.Time)
+	// depositsplitDescUpdatedAt is the schema descriptor for updated_at field.
+	depositsplitDescUpdatedAt := depositsplitMixinFields0[1].Descriptor()
+	// depositsplit.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	depositsplit.DefaultUpdatedAt = depositsplitDescUpdatedAt.Default.(func() time.Time)
+	// depositsplit.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	depositsplit.UpdateDefaultUpdatedAt = depositsplitDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// depositsplitDescTxHash is the schema descriptor for tx_hash field.
+	depositsplitDescTxHash := depositsplitFields[1].Descriptor()
+	// depositsplit.TxHashValidator is a validator for the "tx_hash" field. It is called by the builders before save.
+	depositsplit.TxHashValidator = depositsplitDescTxHash.Validators[0].(func(string) error)
+	// depositsplitDescBlockNumber is the schema descriptor for block_number field.
+	depositsplitDescBlockNumber := depositsplitFields[6].Descriptor()
+	// depositsplit.DefaultBlockNumber holds the default value on creation for the block_number field.
+	depositsplit.DefaultBlockNumber = depositsplitDescBlockNumber.Default.(int64)
+	// depositsplitDescID is the schema descriptor for id field.
+	depositsplitDescID := depositsplitFields[0].Descriptor()
+	// depositsplit.DefaultID holds the default value on creation for the id field.
+	depositsplit.DefaultID = depositsplitDescID.Default.(func() uuid.UUID)
+	feescheduleMixin := schema.FeeSchedule{}.Mixin()
+	feescheduleMixinFields0 := feescheduleMixin[0].Fields()
+	_ = feescheduleMixinFields0
+	feescheduleFields := schema.FeeSchedule{}.Fields()
+	_ = feescheduleFields
+	// feescheduleDescCreatedAt is the schema descriptor for created_at field.
+	feescheduleDescCreatedAt := feescheduleMixinFields0[0].Descriptor()
+	// feeschedule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	feeschedule.DefaultCreatedAt = feescheduleDescCreatedAt.Default.(func() time.Time)
+	// feescheduleDescUpdatedAt is the schema descriptor for updated_at field.
+	feescheduleDescUpdatedAt := feescheduleMixinFields0[1].Descriptor()
+	// feeschedule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	feeschedule.DefaultUpdatedAt = feescheduleDescUpdatedAt.Default.(func() time.Time)
+	// feeschedule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	feeschedule.UpdateDefaultUpdatedAt = feescheduleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// feescheduleDescToken is the schema descriptor for token field.
+	feescheduleDescToken := feescheduleFields[2].Descriptor()
+	// feeschedule.DefaultToken holds the default value on creation for the token field.
+	feeschedule.DefaultToken = feescheduleDescToken.Default.(string)
+	// feeschedule.TokenValidator is a validator for the "token" field. It is called by the builders before save.
+	feeschedule.TokenValidator = feescheduleDescToken.Validators[0].(func(string) error)
+	// feescheduleDescNetwork is the schema descriptor for network field.
+	feescheduleDescNetwork := feescheduleFields[3].Descriptor()
+	// feeschedule.DefaultNetwork holds the default value on creation for the network field.
+	feeschedule.DefaultNetwork = feescheduleDescNetwork.Default.(string)
+	// feeschedule.NetworkValidator is a validator for the "network" field. It is called by the builders before save.
+	feeschedule.NetworkValidator = feescheduleDescNetwork.Validators[0].(func(string) error)
+	// feescheduleDescMinAmount is the schema descriptor for min_amount field.
+	feescheduleDescMinAmount := feescheduleFields[4].Descriptor()
+	// feeschedule.DefaultMinAmount holds the default value on creation for the min_amount field.
+	feeschedule.DefaultMinAmount = feescheduleDescMinAmount.Default.(func() decimal.Decimal)
+	// feescheduleDescPercent is the schema descriptor for percent field.
+	feescheduleDescPercent := feescheduleFields[6].Descriptor()
+	// feeschedule.DefaultPercent holds the default value on creation for the percent field.
+	feeschedule.DefaultPercent = feescheduleDescPercent.Default.(func() decimal.Decimal)
+	// feescheduleDescFlatAmount is the schema descriptor for flat_amount field.
+	feescheduleDescFlatAmount := feescheduleFields[7].Descriptor()
+	// feeschedule.DefaultFlatAmount holds the default value on creation for the flat_amount field.
+	feeschedule.DefaultFlatAmount = feescheduleDescFlatAmount.Default.(func() decimal.Decimal)
+	// feescheduleDescEffectiveFrom is the schema descriptor for effective_from field.
+	feescheduleDescEffectiveFrom := feescheduleFields[8].Descriptor()
+	// feeschedule.DefaultEffectiveFrom holds the default value on creation for the effective_from field.
+	feeschedule.DefaultEffectiveFrom = feescheduleDescEffectiveFrom.Default.(func() time.Time)
+	// feescheduleDescID is the schema descriptor for id field.
+	feescheduleDescID := feescheduleFields[0].Descriptor()
+	// feeschedule.DefaultID holds the default value on creation for the id field.
+	feeschedule.DefaultID = feescheduleDescID.Default.(func() uuid.UUID)
 	fiatcurrencyMixin := schema.FiatCurrency{}.Mixin()
 	fiatcurrencyMixinFields0 := fiatcurrencyMixin[0].Fields()
 	_ = fiatcurrencyMixinFields0
@@ -139,6 +372,49 @@ func init() {
 	kybprofileDescID := kybprofileFields[0].Descriptor()
 	// kybprofile.DefaultID holds the default value on creation for the id field.
 	kybprofile.DefaultID = kybprofileDescID.Default.(func() uuid.UUID)
+	ledgeraccountMixin := schema.LedgerAccount{}.Mixin()
+	ledgeraccountMixinFields0 := ledgeraccountMixin[0].Fields()
+	_ = ledgeraccountMixinFields0
+	ledgeraccountFields := schema.LedgerAccount{}.Fields()
+	_ = ledgeraccountFields
+	// ledgeraccountDescCreatedAt is the schema descriptor for created_at field.
+	ledgeraccountDescCreatedAt := ledgeraccountMixinFields0[0].Descriptor()
+	// ledgeraccount.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ledgeraccount.DefaultCreatedAt = ledgeraccountDescCreatedAt.Default.(func() time.Time)
+	// ledgeraccountDescUpdatedAt is the schema descriptor for updated_at field.
+	ledgeraccountDescUpdatedAt := ledgeraccountMixinFields0[1].Descriptor()
+	// ledgeraccount.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	ledgeraccount.DefaultUpdatedAt = ledgeraccountDescUpdatedAt.Default.(func() time.Time)
+	// ledgeraccount.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	ledgeraccount.UpdateDefaultUpdatedAt = ledgeraccountDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// ledgeraccountDescBalance is the schema descriptor for balance field.
+	ledgeraccountDescBalance := ledgeraccountFields[3].Descriptor()
+	// ledgeraccount.DefaultBalance holds the default value on creation for the balance field.
+	ledgeraccount.DefaultBalance = ledgeraccountDescBalance.Default.(func() decimal.Decimal)
+	// ledgeraccountDescID is the schema descriptor for id field.
+	ledgeraccountDescID := ledgeraccountFields[0].Descriptor()
+	// ledgeraccount.DefaultID holds the default value on creation for the id field.
+	ledgeraccount.DefaultID = ledgeraccountDescID.Default.(func() uuid.UUID)
+	ledgerentryFields := schema.LedgerEntry{}.Fields()
+	_ = ledgerentryFields
+	// ledgerentryDescReference is the schema descriptor for reference field.
+	ledgerentryDescReference := ledgerentryFields[2].Descriptor()
+	// ledgerentry.ReferenceValidator is a validator for the "reference" field. It is called by the builders before save.
+	ledgerentry.ReferenceValidator = ledgerentryDescReference.Validators[0].(func(string) error)
+	// ledgerentryDescCreatedAt is the schema descriptor for created_at field.
+	ledgerentryDescCreatedAt := ledgerentryFields[3].Descriptor()
+	// ledgerentry.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ledgerentry.DefaultCreatedAt = ledgerentryDescCreatedAt.Default.(func() time.Time)
+	// ledgerentryDescID is the schema descriptor for id field.
+	ledgerentryDescID := ledgerentryFields[0].Descriptor()
+	// ledgerentry.DefaultID holds the default value on creation for the id field.
+	ledgerentry.DefaultID = ledgerentryDescID.Default.(func() uuid.UUID)
+	ledgerpostingFields := schema.LedgerPosting{}.Fields()
+	_ = ledgerpostingFields
+	// ledgerpostingDescID is the schema descriptor for id field.
+	ledgerpostingDescID := ledgerpostingFields[0].Descriptor()
+	// ledgerposting.DefaultID holds the default value on creation for the id field.
+	ledgerposting.DefaultID = ledgerpostingDescID.Default.(func() uuid.UUID)
 	linkedaddressMixin := schema.LinkedAddress{}.Mixin()
 	linkedaddressMixinFields0 := linkedaddressMixin[0].Fields()
 	_ = linkedaddressMixinFields0
@@ -158,6 +434,22 @@ func init() {
 	linkedaddressDescTxHash := linkedaddressFields[8].Descriptor()
 	// linkedaddress.TxHashValidator is a validator for the "tx_hash" field. It is called by the builders before save.
 	linkedaddress.TxHashValidator = linkedaddressDescTxHash.Validators[0].(func(string) error)
+	// linkedaddressDescHandle is the schema descriptor for handle field.
+	linkedaddressDescHandle := linkedaddressFields[9].Descriptor()
+	// linkedaddress.HandleValidator is a validator for the "handle" field. It is called by the builders before save.
+	linkedaddress.HandleValidator = linkedaddressDescHandle.Validators[0].(func(string) error)
+	// linkedaddressDescIsActive is the schema descriptor for is_active field.
+	linkedaddressDescIsActive := linkedaddressFields[10].Descriptor()
+	// linkedaddress.DefaultIsActive holds the default value on creation for the is_active field.
+	linkedaddress.DefaultIsActive = linkedaddressDescIsActive.Default.(bool)
+	// linkedaddressDescMaxTransferAmount is the schema descriptor for max_transfer_amount field.
+	linkedaddressDescMaxTransferAmount := linkedaddressFields[13].Descriptor()
+	// linkedaddress.DefaultMaxTransferAmount holds the default value on creation for the max_transfer_amount field.
+	linkedaddress.DefaultMaxTransferAmount = linkedaddressDescMaxTransferAmount.Default.(func() decimal.Decimal)
+	// linkedaddressDescDailyVolumeLimit is the schema descriptor for daily_volume_limit field.
+	linkedaddressDescDailyVolumeLimit := linkedaddressFields[14].Descriptor()
+	// linkedaddress.DefaultDailyVolumeLimit holds the default value on creation for the daily_volume_limit field.
+	linkedaddress.DefaultDailyVolumeLimit = linkedaddressDescDailyVolumeLimit.Default.(func() decimal.Decimal)
 	lockorderfulfillmentMixin := schema.LockOrderFulfillment{}.Mixin()
 	lockorderfulfillmentMixinFields0 := lockorderfulfillmentMixin[0].Fields()
 	_ = lockorderfulfillmentMixinFields0
@@ -177,6 +469,16 @@ func init() {
 	lockorderfulfillmentDescID := lockorderfulfillmentFields[0].Descriptor()
 	// lockorderfulfillment.DefaultID holds the default value on creation for the id field.
 	lockorderfulfillment.DefaultID = lockorderfulfillmentDescID.Default.(func() uuid.UUID)
+	lockorderreassignmentFields := schema.LockOrderReassignment{}.Fields()
+	_ = lockorderreassignmentFields
+	// lockorderreassignmentDescCreatedAt is the schema descriptor for created_at field.
+	lockorderreassignmentDescCreatedAt := lockorderreassignmentFields[3].Descriptor()
+	// lockorderreassignment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	lockorderreassignment.DefaultCreatedAt = lockorderreassignmentDescCreatedAt.Default.(func() time.Time)
+	// lockorderreassignmentDescID is the schema descriptor for id field.
+	lockorderreassignmentDescID := lockorderreassignmentFields[0].Descriptor()
+	// lockorderreassignment.DefaultID holds the default value on creation for the id field.
+	lockorderreassignment.DefaultID = lockorderreassignmentDescID.Default.(func() uuid.UUID)
 	lockpaymentorderMixin := schema.LockPaymentOrder{}.Mixin()
 	lockpaymentorderMixinFields0 := lockpaymentorderMixin[0].Fields()
 	_ = lockpaymentorderMixinFields0
@@ -208,6 +510,10 @@ func init() {
 	lockpaymentorderDescMessageHash := lockpaymentorderFields[17].Descriptor()
 	// lockpaymentorder.MessageHashValidator is a validator for the "message_hash" field. It is called by the builders before save.
 	lockpaymentorder.MessageHashValidator = lockpaymentorderDescMessageHash.Validators[0].(func(string) error)
+	// lockpaymentorderDescSplitIndex is the schema descriptor for split_index field.
+	lockpaymentorderDescSplitIndex := lockpaymentorderFields[22].Descriptor()
+	// lockpaymentorder.DefaultSplitIndex holds the default value on creation for the split_index field.
+	lockpaymentorder.DefaultSplitIndex = lockpaymentorderDescSplitIndex.Default.(int)
 	// lockpaymentorderDescID is the schema descriptor for id field.
 	lockpaymentorderDescID := lockpaymentorderFields[0].Descriptor()
 	// lockpaymentorder.DefaultID holds the default value on creation for the id field.
@@ -228,9 +534,76 @@ func init() {
 	// network.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	network.UpdateDefaultUpdatedAt = networkDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// networkDescGatewayContractAddress is the schema descriptor for gateway_contract_address field.
-	networkDescGatewayContractAddress := networkFields[3].Descriptor()
+	networkDescGatewayContractAddress := networkFields[5].Descriptor()
 	// network.DefaultGatewayContractAddress holds the default value on creation for the gateway_contract_address field.
 	network.DefaultGatewayContractAddress = networkDescGatewayContractAddress.Default.(string)
+	// networkDescFinalityBlocks is the schema descriptor for finality_blocks field.
+	networkDescFinalityBlocks := networkFields[13].Descriptor()
+	// network.DefaultFinalityBlocks holds the default value on creation for the finality_blocks field.
+	network.DefaultFinalityBlocks = networkDescFinalityBlocks.Default.(int)
+	// network.FinalityBlocksValidator is a validator for the "finality_blocks" field. It is called by the builders before save.
+	network.FinalityBlocksValidator = networkDescFinalityBlocks.Validators[0].(func(int) error)
+	// networkDescRequiredConfirmations is the schema descriptor for required_confirmations field.
+	networkDescRequiredConfirmations := networkFields[14].Descriptor()
+	// network.DefaultRequiredConfirmations holds the default value on creation for the required_confirmations field.
+	network.DefaultRequiredConfirmations = networkDescRequiredConfirmations.Default.(int)
+	// network.RequiredConfirmationsValidator is a validator for the "required_confirmations" field. It is called by the builders before save.
+	network.RequiredConfirmationsValidator = networkDescRequiredConfirmations.Validators[0].(func(int) error)
+	// networkDescOrderTTLMinutes is the schema descriptor for order_ttl_minutes field.
+	networkDescOrderTTLMinutes := networkFields[16].Descriptor()
+	// network.OrderTTLMinutesValidator is a validator for the "order_ttl_minutes" field. It is called by the builders before save.
+	network.OrderTTLMinutesValidator = networkDescOrderTTLMinutes.Validators[0].(func(int) error)
+	// networkDescGenesisMismatch is the schema descriptor for genesis_mismatch field.
+	networkDescGenesisMismatch := networkFields[18].Descriptor()
+	// network.DefaultGenesisMismatch holds the default value on creation for the genesis_mismatch field.
+	network.DefaultGenesisMismatch = networkDescGenesisMismatch.Default.(bool)
+	// networkDescIsEnabled is the schema descriptor for is_enabled field.
+	networkDescIsEnabled := networkFields[24].Descriptor()
+	// network.DefaultIsEnabled holds the default value on creation for the is_enabled field.
+	network.DefaultIsEnabled = networkDescIsEnabled.Default.(bool)
+	outboxtransactionMixin := schema.OutboxTransaction{}.Mixin()
+	outboxtransactionMixinFields0 := outboxtransactionMixin[0].Fields()
+	_ = outboxtransactionMixinFields0
+	outboxtransactionFields := schema.OutboxTransaction{}.Fields()
+	_ = outboxtransactionFields
+	// outboxtransactionDescCreatedAt is the schema descriptor for created_at field.
+	outboxtransactionDescCreatedAt := outboxtransactionMixinFields0[0].Descriptor()
+	// outboxtransaction.DefaultCreatedAt holds the default value on creation for the created_at field.
+	outboxtransaction.DefaultCreatedAt = outboxtransactionDescCreatedAt.Default.(func() time.Time)
+	// outboxtransactionDescUpdatedAt is the schema descriptor for updated_at field.
+	outboxtransactionDescUpdatedAt := outboxtransactionMixinFields0[1].Descriptor()
+	// outboxtransaction.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	outboxtransaction.DefaultUpdatedAt = outboxtransactionDescUpdatedAt.Default.(func() time.Time)
+	// outboxtransaction.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	outboxtransaction.UpdateDefaultUpdatedAt = outboxtransactionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// outboxtransactionDescAttempts is the schema descriptor for attempts field.
+	outboxtransactionDescAttempts := outboxtransactionFields[8].Descriptor()
+	// outboxtransaction.DefaultAttempts holds the default value on creation for the attempts field.
+	outboxtransaction.DefaultAttempts = outboxtransactionDescAttempts.Default.(int)
+	// outboxtransactionDescLastError is the schema descriptor for last_error field.
+	outboxtransactionDescLastError := outboxtransactionFields[9].Descriptor()
+	// outboxtransaction.LastErrorValidator is a validator for the "last_error" field. It is called by the builders before save.
+	outboxtransaction.LastErrorValidator = outboxtransactionDescLastError.Validators[0].(func(string) error)
+	// outboxtransactionDescTxHash is the schema descriptor for tx_hash field.
+	outboxtransactionDescTxHash := outboxtransactionFields[11].Descriptor()
+	// outboxtransaction.TxHashValidator is a validator for the "tx_hash" field. It is called by the builders before save.
+	outboxtransaction.TxHashValidator = outboxtransactionDescTxHash.Validators[0].(func(string) error)
+	// outboxtransactionDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	outboxtransactionDescNextAttemptAt := outboxtransactionFields[12].Descriptor()
+	// outboxtransaction.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	outboxtransaction.DefaultNextAttemptAt = outboxtransactionDescNextAttemptAt.Default.(func() time.Time)
+	// outboxtransactionDescGasUsed is the schema descriptor for gas_used field.
+	outboxtransactionDescGasUsed := outboxtransactionFields[14].Descriptor()
+	// outboxtransaction.DefaultGasUsed holds the default value on creation for the gas_used field.
+	outboxtransaction.DefaultGasUsed = outboxtransactionDescGasUsed.Default.(int64)
+	// outboxtransactionDescGasCost is the schema descriptor for gas_cost field.
+	outboxtransactionDescGasCost := outboxtransactionFields[15].Descriptor()
+	// outboxtransaction.DefaultGasCost holds the default value on creation for the gas_cost field.
+	outboxtransaction.DefaultGasCost = outboxtransactionDescGasCost.Default.(func() decimal.Decimal)
+	// outboxtransactionDescID is the schema descriptor for id field.
+	outboxtransactionDescID := outboxtransactionFields[0].Descriptor()
+	// outboxtransaction.DefaultID holds the default value on creation for the id field.
+	outboxtransaction.DefaultID = outboxtransactionDescID.Default.(func() uuid.UUID)
 	paymentorderMixin := schema.PaymentOrder{}.Mixin()
 	paymentorderMixinFields0 := paymentorderMixin[0].Fields()
 	_ = paymentorderMixinFields0
@@ -246,42 +619,60 @@ func init() {
 	paymentorder.DefaultUpdatedAt = paymentorderDescUpdatedAt.Default.(func() time.Time)
 	// paymentorder.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	paymentorder.UpdateDefaultUpdatedAt = paymentorderDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// paymentorderDescAmountOverpaid is the schema descriptor for amount_overpaid field.
+	paymentorderDescAmountOverpaid := paymentorderFields[4].Descriptor()
+	// paymentorder.DefaultAmountOverpaid holds the default value on creation for the amount_overpaid field.
+	paymentorder.DefaultAmountOverpaid = paymentorderDescAmountOverpaid.Default.(func() decimal.Decimal)
 	// paymentorderDescTxHash is the schema descriptor for tx_hash field.
-	paymentorderDescTxHash := paymentorderFields[9].Descriptor()
+	paymentorderDescTxHash := paymentorderFields[10].Descriptor()
 	// paymentorder.TxHashValidator is a validator for the "tx_hash" field. It is called by the builders before save.
 	paymentorder.TxHashValidator = paymentorderDescTxHash.Validators[0].(func(string) error)
 	// paymentorderDescBlockNumber is the schema descriptor for block_number field.
-	paymentorderDescBlockNumber := paymentorderFields[10].Descriptor()
+	paymentorderDescBlockNumber := paymentorderFields[11].Descriptor()
 	// paymentorder.DefaultBlockNumber holds the default value on creation for the block_number field.
 	paymentorder.DefaultBlockNumber = paymentorderDescBlockNumber.Default.(int64)
 	// paymentorderDescFromAddress is the schema descriptor for from_address field.
-	paymentorderDescFromAddress := paymentorderFields[11].Descriptor()
+	paymentorderDescFromAddress := paymentorderFields[12].Descriptor()
 	// paymentorder.FromAddressValidator is a validator for the "from_address" field. It is called by the builders before save.
 	paymentorder.FromAddressValidator = paymentorderDescFromAddress.Validators[0].(func(string) error)
 	// paymentorderDescReturnAddress is the schema descriptor for return_address field.
-	paymentorderDescReturnAddress := paymentorderFields[12].Descriptor()
+	paymentorderDescReturnAddress := paymentorderFields[13].Descriptor()
 	// paymentorder.ReturnAddressValidator is a validator for the "return_address" field. It is called by the builders before save.
 	paymentorder.ReturnAddressValidator = paymentorderDescReturnAddress.Validators[0].(func(string) error)
 	// paymentorderDescReceiveAddressText is the schema descriptor for receive_address_text field.
-	paymentorderDescReceiveAddressText := paymentorderFields[13].Descriptor()
+	paymentorderDescReceiveAddressText := paymentorderFields[14].Descriptor()
 	// paymentorder.ReceiveAddressTextValidator is a validator for the "receive_address_text" field. It is called by the builders before save.
 	paymentorder.ReceiveAddressTextValidator = paymentorderDescReceiveAddressText.Validators[0].(func(string) error)
 	// paymentorderDescFeeAddress is the schema descriptor for fee_address field.
-	paymentorderDescFeeAddress := paymentorderFields[15].Descriptor()
+	paymentorderDescFeeAddress := paymentorderFields[16].Descriptor()
 	// paymentorder.FeeAddressValidator is a validator for the "fee_address" field. It is called by the builders before save.
 	paymentorder.FeeAddressValidator = paymentorderDescFeeAddress.Validators[0].(func(string) error)
 	// paymentorderDescGatewayID is the schema descriptor for gateway_id field.
-	paymentorderDescGatewayID := paymentorderFields[16].Descriptor()
+	paymentorderDescGatewayID := paymentorderFields[17].Descriptor()
 	// paymentorder.GatewayIDValidator is a validator for the "gateway_id" field. It is called by the builders before save.
 	paymentorder.GatewayIDValidator = paymentorderDescGatewayID.Validators[0].(func(string) error)
 	// paymentorderDescMessageHash is the schema descriptor for message_hash field.
-	paymentorderDescMessageHash := paymentorderFields[17].Descriptor()
+	paymentorderDescMessageHash := paymentorderFields[18].Descriptor()
 	// paymentorder.MessageHashValidator is a validator for the "message_hash" field. It is called by the builders before save.
 	paymentorder.MessageHashValidator = paymentorderDescMessageHash.Validators[0].(func(string) error)
 	// paymentorderDescReference is the schema descriptor for reference field.
-	paymentorderDescReference := paymentorderFields[18].Descriptor()
+	paymentorderDescReference := paymentorderFields[19].Descriptor()
 	// paymentorder.ReferenceValidator is a validator for the "reference" field. It is called by the builders before save.
 	paymentorder.ReferenceValidator = paymentorderDescReference.Validators[0].(func(string) error)
+	// paymentorderDescRequiredConfirmations is the schema descriptor for required_confirmations field.
+	paymentorderDescRequiredConfirmations := paymentorderFields[25].Descriptor()
+	// paymentorder.DefaultRequiredConfirmations holds the default value on creation for the required_confirmations field.
+	paymentorder.DefaultRequiredConfirmations = paymentorderDescRequiredConfirmations.Default.(int)
+	// paymentorder.RequiredConfirmationsValidator is a validator for the "required_confirmations" field. It is called by the builders before save.
+	paymentorder.RequiredConfirmationsValidator = paymentorderDescRequiredConfirmations.Validators[0].(func(int) error)
+	// paymentorderDescFiatCurrency is the schema descriptor for fiat_currency field.
+	paymentorderDescFiatCurrency := paymentorderFields[30].Descriptor()
+	// paymentorder.FiatCurrencyValidator is a validator for the "fiat_currency" field. It is called by the builders before save.
+	paymentorder.FiatCurrencyValidator = paymentorderDescFiatCurrency.Validators[0].(func(string) error)
+	// paymentorderDescRateDriftTolerance is the schema descriptor for rate_drift_tolerance field.
+	paymentorderDescRateDriftTolerance := paymentorderFields[31].Descriptor()
+	// paymentorder.DefaultRateDriftTolerance holds the default value on creation for the rate_drift_tolerance field.
+	paymentorder.DefaultRateDriftTolerance = paymentorderDescRateDriftTolerance.Default.(func() decimal.Decimal)
 	// paymentorderDescID is the schema descriptor for id field.
 	paymentorderDescID := paymentorderFields[0].Descriptor()
 	// paymentorder.DefaultID holds the default value on creation for the id field.
@@ -359,6 +750,29 @@ func init() {
 	paymentwebhookDescID := paymentwebhookFields[0].Descriptor()
 	// paymentwebhook.DefaultID holds the default value on creation for the id field.
 	paymentwebhook.DefaultID = paymentwebhookDescID.Default.(func() uuid.UUID)
+	providerbalancesnapshotMixin := schema.ProviderBalanceSnapshot{}.Mixin()
+	providerbalancesnapshotMixinFields0 := providerbalancesnapshotMixin[0].Fields()
+	_ = providerbalancesnapshotMixinFields0
+	providerbalancesnapshotFields := schema.ProviderBalanceSnapshot{}.Fields()
+	_ = providerbalancesnapshotFields
+	// providerbalancesnapshotDescCreatedAt is the schema descriptor for created_at field.
+	providerbalancesnapshotDescCreatedAt := providerbalancesnapshotMixinFields0[0].Descriptor()
+	// providerbalancesnapshot.DefaultCreatedAt holds the default value on creation for the created_at field.
+	providerbalancesnapshot.DefaultCreatedAt = providerbalancesnapshotDescCreatedAt.Default.(func() time.Time)
+	// providerbalancesnapshotDescUpdatedAt is the schema descriptor for updated_at field.
+	providerbalancesnapshotDescUpdatedAt := providerbalancesnapshotMixinFields0[1].Descriptor()
+	// providerbalancesnapshot.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	providerbalancesnapshot.DefaultUpdatedAt = providerbalancesnapshotDescUpdatedAt.Default.(func() time.Time)
+	// providerbalancesnapshot.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	providerbalancesnapshot.UpdateDefaultUpdatedAt = providerbalancesnapshotDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// providerbalancesnapshotDescBelowThreshold is the schema descriptor for below_threshold field.
+	providerbalancesnapshotDescBelowThreshold := providerbalancesnapshotFields[8].Descriptor()
+	// providerbalancesnapshot.DefaultBelowThreshold holds the default value on creation for the below_threshold field.
+	providerbalancesnapshot.DefaultBelowThreshold = providerbalancesnapshotDescBelowThreshold.Default.(bool)
+	// providerbalancesnapshotDescID is the schema descriptor for id field.
+	providerbalancesnapshotDescID := providerbalancesnapshotFields[0].Descriptor()
+	// providerbalancesnapshot.DefaultID holds the default value on creation for the id field.
+	providerbalancesnapshot.DefaultID = providerbalancesnapshotDescID.Default.(func() uuid.UUID)
 	providercurrenciesFields := schema.ProviderCurrencies{}.Fields()
 	_ = providercurrenciesFields
 	// providercurrenciesDescIsAvailable is the schema descriptor for is_available field.
@@ -366,7 +780,7 @@ func init() {
 	// providercurrencies.DefaultIsAvailable holds the default value on creation for the is_available field.
 	providercurrencies.DefaultIsAvailable = providercurrenciesDescIsAvailable.Default.(bool)
 	// providercurrenciesDescUpdatedAt is the schema descriptor for updated_at field.
-	providercurrenciesDescUpdatedAt := providercurrenciesFields[5].Descriptor()
+	providercurrenciesDescUpdatedAt := providercurrenciesFields[6].Descriptor()
 	// providercurrencies.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	providercurrencies.DefaultUpdatedAt = providercurrenciesDescUpdatedAt.Default.(func() time.Time)
 	// providercurrencies.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -390,6 +804,49 @@ func init() {
 	providerordertoken.DefaultUpdatedAt = providerordertokenDescUpdatedAt.Default.(func() time.Time)
 	// providerordertoken.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	providerordertoken.UpdateDefaultUpdatedAt = providerordertokenDescUpdatedAt.UpdateDefault.(func() time.Time)
+	providerperformanceMixin := schema.ProviderPerformance{}.Mixin()
+	providerperformanceMixinFields0 := providerperformanceMixin[0].Fields()
+	_ = providerperformanceMixinFields0
+	providerperformanceFields := schema.ProviderPerformance{}.Fields()
+	_ = providerperformanceFields
+	// providerperformanceDescCreatedAt is the schema descriptor for created_at field.
+	providerperformanceDescCreatedAt := providerperformanceMixinFields0[0].Descriptor()
+	// providerperformance.DefaultCreatedAt holds the default value on creation for the created_at field.
+	providerperformance.DefaultCreatedAt = providerperformanceDescCreatedAt.Default.(func() time.Time)
+	// providerperformanceDescUpdatedAt is the schema descriptor for updated_at field.
+	providerperformanceDescUpdatedAt := providerperformanceMixinFields0[1].Descriptor()
+	// providerperformance.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	providerperformance.DefaultUpdatedAt = providerperformanceDescUpdatedAt.Default.(func() time.Time)
+	// providerperformance.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	providerperformance.UpdateDefaultUpdatedAt = providerperformanceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// providerperformanceDescOrdersSettled is the schema descriptor for orders_settled field.
+	providerperformanceDescOrdersSettled := providerperformanceFields[0].Descriptor()
+	// providerperformance.DefaultOrdersSettled holds the default value on creation for the orders_settled field.
+	providerperformance.DefaultOrdersSettled = providerperformanceDescOrdersSettled.Default.(int)
+	// providerperformanceDescOrdersFailed is the schema descriptor for orders_failed field.
+	providerperformanceDescOrdersFailed := providerperformanceFields[1].Descriptor()
+	// providerperformance.DefaultOrdersFailed holds the default value on creation for the orders_failed field.
+	providerperformance.DefaultOrdersFailed = providerperformanceDescOrdersFailed.Default.(int)
+	// providerperformanceDescOrdersCancelled is the schema descriptor for orders_cancelled field.
+	providerperformanceDescOrdersCancelled := providerperformanceFields[2].Descriptor()
+	// providerperformance.DefaultOrdersCancelled holds the default value on creation for the orders_cancelled field.
+	providerperformance.DefaultOrdersCancelled = providerperformanceDescOrdersCancelled.Default.(int)
+	// providerperformanceDescSuccessRate is the schema descriptor for success_rate field.
+	providerperformanceDescSuccessRate := providerperformanceFields[3].Descriptor()
+	// providerperformance.DefaultSuccessRate holds the default value on creation for the success_rate field.
+	providerperformance.DefaultSuccessRate = providerperformanceDescSuccessRate.Default.(float64)
+	// providerperformanceDescCancellationRate is the schema descriptor for cancellation_rate field.
+	providerperformanceDescCancellationRate := providerperformanceFields[4].Descriptor()
+	// providerperformance.DefaultCancellationRate holds the default value on creation for the cancellation_rate field.
+	providerperformance.DefaultCancellationRate = providerperformanceDescCancellationRate.Default.(float64)
+	// providerperformanceDescAvgSettlementSeconds is the schema descriptor for avg_settlement_seconds field.
+	providerperformanceDescAvgSettlementSeconds := providerperformanceFields[5].Descriptor()
+	// providerperformance.DefaultAvgSettlementSeconds holds the default value on creation for the avg_settlement_seconds field.
+	providerperformance.DefaultAvgSettlementSeconds = providerperformanceDescAvgSettlementSeconds.Default.(float64)
+	// providerperformanceDescScore is the schema descriptor for score field.
+	providerperformanceDescScore := providerperformanceFields[6].Descriptor()
+	// providerperformance.DefaultScore holds the default value on creation for the score field.
+	providerperformance.DefaultScore = providerperformanceDescScore.Default.(float64)
 	providerprofileFields := schema.ProviderProfile{}.Fields()
 	_ = providerprofileFields
 	// providerprofileDescTradingName is the schema descriptor for trading_name field.
@@ -435,6 +892,35 @@ func init() {
 	provisionbucketDescCreatedAt := provisionbucketFields[2].Descriptor()
 	// provisionbucket.DefaultCreatedAt holds the default value on creation for the created_at field.
 	provisionbucket.DefaultCreatedAt = provisionbucketDescCreatedAt.Default.(func() time.Time)
+	rpcendpointMixin := schema.RPCEndpoint{}.Mixin()
+	rpcendpointMixinFields0 := rpcendpointMixin[0].Fields()
+	_ = rpcendpointMixinFields0
+	rpcendpointFields := schema.RPCEndpoint{}.Fields()
+	_ = rpcendpointFields
+	// rpcendpointDescCreatedAt is the schema descriptor for created_at field.
+	rpcendpointDescCreatedAt := rpcendpointMixinFields0[0].Descriptor()
+	// rpcendpoint.DefaultCreatedAt holds the default value on creation for the created_at field.
+	rpcendpoint.DefaultCreatedAt = rpcendpointDescCreatedAt.Default.(func() time.Time)
+	// rpcendpointDescUpdatedAt is the schema descriptor for updated_at field.
+	rpcendpointDescUpdatedAt := rpcendpointMixinFields0[1].Descriptor()
+	// rpcendpoint.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	rpcendpoint.DefaultUpdatedAt = rpcendpointDescUpdatedAt.Default.(func() time.Time)
+	// rpcendpoint.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	rpcendpoint.UpdateDefaultUpdatedAt = rpcendpointDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// rpcendpointDescURL is the schema descriptor for url field.
+	rpcendpointDescURL := rpcendpointFields[0].Descriptor()
+	// rpcendpoint.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	rpcendpoint.URLValidator = rpcendpointDescURL.Validators[0].(func(string) error)
+	// rpcendpointDescPriority is the schema descriptor for priority field.
+	rpcendpointDescPriority := rpcendpointFields[1].Descriptor()
+	// rpcendpoint.DefaultPriority holds the default value on creation for the priority field.
+	rpcendpoint.DefaultPriority = rpcendpointDescPriority.Default.(int)
+	// rpcendpoint.PriorityValidator is a validator for the "priority" field. It is called by the builders before save.
+	rpcendpoint.PriorityValidator = rpcendpointDescPriority.Validators[0].(func(int) error)
+	// rpcendpointDescIsEnabled is the schema descriptor for is_enabled field.
+	rpcendpointDescIsEnabled := rpcendpointFields[2].Descriptor()
+	// rpcendpoint.DefaultIsEnabled holds the default value on creation for the is_enabled field.
+	rpcendpoint.DefaultIsEnabled = rpcendpointDescIsEnabled.Default.(bool)
 	receiveaddressMixin := schema.ReceiveAddress{}.Mixin()
 	receiveaddressMixinFields0 := receiveaddressMixin[0].Fields()
 	_ = receiveaddressMixinFields0
@@ -463,9 +949,85 @@ func init() {
 	// receiveaddress.DefaultTimesUsed holds the default value on creation for the times_used field.
 	receiveaddress.DefaultTimesUsed = receiveaddressDescTimesUsed.Default.(int)
 	// receiveaddressDescTxHash is the schema descriptor for tx_hash field.
-	receiveaddressDescTxHash := receiveaddressFields[14].Descriptor()
+	receiveaddressDescTxHash := receiveaddressFields[18].Descriptor()
 	// receiveaddress.TxHashValidator is a validator for the "tx_hash" field. It is called by the builders before save.
 	receiveaddress.TxHashValidator = receiveaddressDescTxHash.Validators[0].(func(string) error)
+	receiveaddressassignmentMixin := schema.ReceiveAddressAssignment{}.Mixin()
+	receiveaddressassignmentMixinFields0 := receiveaddressassignmentMixin[0].Fields()
+	_ = receiveaddressassignmentMixinFields0
+	receiveaddressassignmentFields := schema.ReceiveAddressAssignment{}.Fields()
+	_ = receiveaddressassignmentFields
+	// receiveaddressassignmentDescCreatedAt is the schema descriptor for created_at field.
+	receiveaddressassignmentDescCreatedAt := receiveaddressassignmentMixinFields0[0].Descriptor()
+	// receiveaddressassignment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	receiveaddressassignment.DefaultCreatedAt = receiveaddressassignmentDescCreatedAt.Default.(func() time.Time)
+	// receiveaddressassignmentDescUpdatedAt is the schema descriptor for updated_at field.
+	receiveaddressassignmentDescUpdatedAt := receiveaddressassignmentMixinFields0[1].Descriptor()
+	// receiveaddressassignment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	receiveaddressassignment.DefaultUpdatedAt = receiveaddressassignmentDescUpdatedAt.Default.(func() time.Time)
+	// receiveaddressassignment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	receiveaddressassignment.UpdateDefaultUpdatedAt = receiveaddressassignmentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// receiveaddressassignmentDescAssignedAt is the schema descriptor for assigned_at field.
+	receiveaddressassignmentDescAssignedAt := receiveaddressassignmentFields[3].Descriptor()
+	// receiveaddressassignment.DefaultAssignedAt holds the default value on creation for the assigned_at field.
+	receiveaddressassignment.DefaultAssignedAt = receiveaddressassignmentDescAssignedAt.Default.(func() time.Time)
+	// receiveaddressassignmentDescID is the schema descriptor for id field.
+	receiveaddressassignmentDescID := receiveaddressassignmentFields[0].Descriptor()
+	// receiveaddressassignment.DefaultID holds the default value on creation for the id field.
+	receiveaddressassignment.DefaultID = receiveaddressassignmentDescID.Default.(func() uuid.UUID)
+	reconciliationdiscrepancyFields := schema.ReconciliationDiscrepancy{}.Fields()
+	_ = reconciliationdiscrepancyFields
+	// reconciliationdiscrepancyDescTxHash is the schema descriptor for tx_hash field.
+	reconciliationdiscrepancyDescTxHash := reconciliationdiscrepancyFields[2].Descriptor()
+	// reconciliationdiscrepancy.TxHashValidator is a validator for the "tx_hash" field. It is called by the builders before save.
+	reconciliationdiscrepancy.TxHashValidator = reconciliationdiscrepancyDescTxHash.Validators[0].(func(string) error)
+	// reconciliationdiscrepancyDescBlockNumber is the schema descriptor for block_number field.
+	reconciliationdiscrepancyDescBlockNumber := reconciliationdiscrepancyFields[6].Descriptor()
+	// reconciliationdiscrepancy.DefaultBlockNumber holds the default value on creation for the block_number field.
+	reconciliationdiscrepancy.DefaultBlockNumber = reconciliationdiscrepancyDescBlockNumber.Default.(int64)
+	// reconciliationdiscrepancyDescID is the schema descriptor for id field.
+	reconciliationdiscrepancyDescID := reconciliationdiscrepancyFields[0].Descriptor()
+	// reconciliationdiscrepancy.DefaultID holds the default value on creation for the id field.
+	reconciliationdiscrepancy.DefaultID = reconciliationdiscrepancyDescID.Default.(func() uuid.UUID)
+	reconciliationreportMixin := schema.ReconciliationReport{}.Mixin()
+	reconciliationreportMixinFields0 := reconciliationreportMixin[0].Fields()
+	_ = reconciliationreportMixinFields0
+	reconciliationreportFields := schema.ReconciliationReport{}.Fields()
+	_ = reconciliationreportFields
+	// reconciliationreportDescCreatedAt is the schema descriptor for created_at field.
+	reconciliationreportDescCreatedAt := reconciliationreportMixinFields0[0].Descriptor()
+	// reconciliationreport.DefaultCreatedAt holds the default value on creation for the created_at field.
+	reconciliationreport.DefaultCreatedAt = reconciliationreportDescCreatedAt.Default.(func() time.Time)
+	// reconciliationreportDescUpdatedAt is the schema descriptor for updated_at field.
+	reconciliationreportDescUpdatedAt := reconciliationreportMixinFields0[1].Descriptor()
+	// reconciliationreport.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	reconciliationreport.DefaultUpdatedAt = reconciliationreportDescUpdatedAt.Default.(func() time.Time)
+	// reconciliationreport.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	reconciliationreport.UpdateDefaultUpdatedAt = reconciliationreportDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// reconciliationreportDescFromBlock is the schema descriptor for from_block field.
+	reconciliationreportDescFromBlock := reconciliationreportFields[3].Descriptor()
+	// reconciliationreport.DefaultFromBlock holds the default value on creation for the from_block field.
+	reconciliationreport.DefaultFromBlock = reconciliationreportDescFromBlock.Default.(int64)
+	// reconciliationreportDescToBlock is the schema descriptor for to_block field.
+	reconciliationreportDescToBlock := reconciliationreportFields[4].Descriptor()
+	// reconciliationreport.DefaultToBlock holds the default value on creation for the to_block field.
+	reconciliationreport.DefaultToBlock = reconciliationreportDescToBlock.Default.(int64)
+	// reconciliationreportDescAddressesChecked is the schema descriptor for addresses_checked field.
+	reconciliationreportDescAddressesChecked := reconciliationreportFields[7].Descriptor()
+	// reconciliationreport.DefaultAddressesChecked holds the default value on creation for the addresses_checked field.
+	reconciliationreport.DefaultAddressesChecked = reconciliationreportDescAddressesChecked.Default.(int)
+	// reconciliationreportDescTransfersChecked is the schema descriptor for transfers_checked field.
+	reconciliationreportDescTransfersChecked := reconciliationreportFields[8].Descriptor()
+	// reconciliationreport.DefaultTransfersChecked holds the default value on creation for the transfers_checked field.
+	reconciliationreport.DefaultTransfersChecked = reconciliationreportDescTransfersChecked.Default.(int)
+	// reconciliationreportDescDiscrepancyCount is the schema descriptor for discrepancy_count field.
+	reconciliationreportDescDiscrepancyCount := reconciliationreportFields[9].Descriptor()
+	// reconciliationreport.DefaultDiscrepancyCount holds the default value on creation for the discrepancy_count field.
+	reconciliationreport.DefaultDiscrepancyCount = reconciliationreportDescDiscrepancyCount.Default.(int)
+	// reconciliationreportDescID is the schema descriptor for id field.
+	reconciliationreportDescID := reconciliationreportFields[0].Descriptor()
+	// reconciliationreport.DefaultID holds the default value on creation for the id field.
+	reconciliationreport.DefaultID = reconciliationreportDescID.Default.(func() uuid.UUID)
 	senderordertokenMixin := schema.SenderOrderToken{}.Mixin()
 	senderordertokenMixinFields0 := senderordertokenMixin[0].Fields()
 	_ = senderordertokenMixinFields0
@@ -492,19 +1054,27 @@ func init() {
 	senderprofileFields := schema.SenderProfile{}.Fields()
 	_ = senderprofileFields
 	// senderprofileDescDomainWhitelist is the schema descriptor for domain_whitelist field.
-	senderprofileDescDomainWhitelist := senderprofileFields[2].Descriptor()
+	senderprofileDescDomainWhitelist := senderprofileFields[4].Descriptor()
 	// senderprofile.DefaultDomainWhitelist holds the default value on creation for the domain_whitelist field.
 	senderprofile.DefaultDomainWhitelist = senderprofileDescDomainWhitelist.Default.([]string)
 	// senderprofileDescIsPartner is the schema descriptor for is_partner field.
-	senderprofileDescIsPartner := senderprofileFields[4].Descriptor()
+	senderprofileDescIsPartner := senderprofileFields[6].Descriptor()
 	// senderprofile.DefaultIsPartner holds the default value on creation for the is_partner field.
 	senderprofile.DefaultIsPartner = senderprofileDescIsPartner.Default.(bool)
 	// senderprofileDescIsActive is the schema descriptor for is_active field.
-	senderprofileDescIsActive := senderprofileFields[5].Descriptor()
+	senderprofileDescIsActive := senderprofileFields[7].Descriptor()
 	// senderprofile.DefaultIsActive holds the default value on creation for the is_active field.
 	senderprofile.DefaultIsActive = senderprofileDescIsActive.Default.(bool)
+	// senderprofileDescOrderTTLMinutes is the schema descriptor for order_ttl_minutes field.
+	senderprofileDescOrderTTLMinutes := senderprofileFields[9].Descriptor()
+	// senderprofile.OrderTTLMinutesValidator is a validator for the "order_ttl_minutes" field. It is called by the builders before save.
+	senderprofile.OrderTTLMinutesValidator = senderprofileDescOrderTTLMinutes.Validators[0].(func(int) error)
+	// senderprofileDescHourlyOrderLimit is the schema descriptor for hourly_order_limit field.
+	senderprofileDescHourlyOrderLimit := senderprofileFields[12].Descriptor()
+	// senderprofile.HourlyOrderLimitValidator is a validator for the "hourly_order_limit" field. It is called by the builders before save.
+	senderprofile.HourlyOrderLimitValidator = senderprofileDescHourlyOrderLimit.Validators[0].(func(int) error)
 	// senderprofileDescUpdatedAt is the schema descriptor for updated_at field.
-	senderprofileDescUpdatedAt := senderprofileFields[6].Descriptor()
+	senderprofileDescUpdatedAt := senderprofileFields[13].Descriptor()
 	// senderprofile.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	senderprofile.DefaultUpdatedAt = senderprofileDescUpdatedAt.Default.(func() time.Time)
 	// senderprofile.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -513,6 +1083,33 @@ func init() {
 	senderprofileDescID := senderprofileFields[0].Descriptor()
 	// senderprofile.DefaultID holds the default value on creation for the id field.
 	senderprofile.DefaultID = senderprofileDescID.Default.(func() uuid.UUID)
+	sweepMixin := schema.Sweep{}.Mixin()
+	sweepMixinFields0 := sweepMixin[0].Fields()
+	_ = sweepMixinFields0
+	sweepFields := schema.Sweep{}.Fields()
+	_ = sweepFields
+	// sweepDescCreatedAt is the schema descriptor for created_at field.
+	sweepDescCreatedAt := sweepMixinFields0[0].Descriptor()
+	// sweep.DefaultCreatedAt holds the default value on creation for the created_at field.
+	sweep.DefaultCreatedAt = sweepDescCreatedAt.Default.(func() time.Time)
+	// sweepDescUpdatedAt is the schema descriptor for updated_at field.
+	sweepDescUpdatedAt := sweepMixinFields0[1].Descriptor()
+	// sweep.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	sweep.DefaultUpdatedAt = sweepDescUpdatedAt.Default.(func() time.Time)
+	// sweep.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	sweep.UpdateDefaultUpdatedAt = sweepDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// sweepDescUserOpHash is the schema descriptor for user_op_hash field.
+	sweepDescUserOpHash := sweepFields[8].Descriptor()
+	// sweep.UserOpHashValidator is a validator for the "user_op_hash" field. It is called by the builders before save.
+	sweep.UserOpHashValidator = sweepDescUserOpHash.Validators[0].(func(string) error)
+	// sweepDescTxHash is the schema descriptor for tx_hash field.
+	sweepDescTxHash := sweepFields[9].Descriptor()
+	// sweep.TxHashValidator is a validator for the "tx_hash" field. It is called by the builders before save.
+	sweep.TxHashValidator = sweepDescTxHash.Validators[0].(func(string) error)
+	// sweepDescID is the schema descriptor for id field.
+	sweepDescID := sweepFields[0].Descriptor()
+	// sweep.DefaultID holds the default value on creation for the id field.
+	sweep.DefaultID = sweepDescID.Default.(func() uuid.UUID)
 	tokenMixin := schema.Token{}.Mixin()
 	tokenMixinFields0 := tokenMixin[0].Fields()
 	_ = tokenMixinFields0
@@ -544,16 +1141,51 @@ func init() {
 	tokenDescBaseCurrency := tokenFields[4].Descriptor()
 	// token.DefaultBaseCurrency holds the default value on creation for the base_currency field.
 	token.DefaultBaseCurrency = tokenDescBaseCurrency.Default.(string)
+	// tokenDescUsdPrice is the schema descriptor for usd_price field.
+	tokenDescUsdPrice := tokenFields[5].Descriptor()
+	// token.DefaultUsdPrice holds the default value on creation for the usd_price field.
+	token.DefaultUsdPrice = tokenDescUsdPrice.Default.(func() decimal.Decimal)
 	transactionlogFields := schema.TransactionLog{}.Fields()
 	_ = transactionlogFields
 	// transactionlogDescCreatedAt is the schema descriptor for created_at field.
 	transactionlogDescCreatedAt := transactionlogFields[6].Descriptor()
 	// transactionlog.DefaultCreatedAt holds the default value on creation for the created_at field.
 	transactionlog.DefaultCreatedAt = transactionlogDescCreatedAt.Default.(func() time.Time)
+	// transactionlogDescSponsored is the schema descriptor for sponsored field.
+	transactionlogDescSponsored := transactionlogFields[9].Descriptor()
+	// transactionlog.DefaultSponsored holds the default value on creation for the sponsored field.
+	transactionlog.DefaultSponsored = transactionlogDescSponsored.Default.(bool)
 	// transactionlogDescID is the schema descriptor for id field.
 	transactionlogDescID := transactionlogFields[0].Descriptor()
 	// transactionlog.DefaultID holds the default value on creation for the id field.
 	transactionlog.DefaultID = transactionlogDescID.Default.(func() uuid.UUID)
+	unmatcheddepositMixin := schema.UnmatchedDeposit{}.Mixin()
+	unmatcheddepositMixinFields0 := unmatcheddepositMixin[0].Fields()
+	_ = unmatcheddepositMixinFields0
+	unmatcheddepositFields := schema.UnmatchedDeposit{}.Fields()
+	_ = unmatcheddepositFields
+	// unmatcheddepositDescCreatedAt is the schema descriptor for created_at field.
+	unmatcheddepositDescCreatedAt := unmatcheddepositMixinFields0[0].Descriptor()
+	// unmatcheddeposit.DefaultCreatedAt holds the default value on creation for the created_at field.
+	unmatcheddeposit.DefaultCreatedAt = unmatcheddepositDescCreatedAt.Default.(func() time.Time)
+	// unmatcheddepositDescUpdatedAt is the schema descriptor for updated_at field.
+	unmatcheddepositDescUpdatedAt := unmatcheddepositMixinFields0[1].Descriptor()
+	// unmatcheddeposit.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	unmatcheddeposit.DefaultUpdatedAt = unmatcheddepositDescUpdatedAt.Default.(func() time.Time)
+	// unmatcheddeposit.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	unmatcheddeposit.UpdateDefaultUpdatedAt = unmatcheddepositDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// unmatcheddepositDescTxHash is the schema descriptor for tx_hash field.
+	unmatcheddepositDescTxHash := unmatcheddepositFields[1].Descriptor()
+	// unmatcheddeposit.TxHashValidator is a validator for the "tx_hash" field. It is called by the builders before save.
+	unmatcheddeposit.TxHashValidator = unmatcheddepositDescTxHash.Validators[0].(func(string) error)
+	// unmatcheddepositDescBlockNumber is the schema descriptor for block_number field.
+	unmatcheddepositDescBlockNumber := unmatcheddepositFields[5].Descriptor()
+	// unmatcheddeposit.DefaultBlockNumber holds the default value on creation for the block_number field.
+	unmatcheddeposit.DefaultBlockNumber = unmatcheddepositDescBlockNumber.Default.(int64)
+	// unmatcheddepositDescID is the schema descriptor for id field.
+	unmatcheddepositDescID := unmatcheddepositFields[0].Descriptor()
+	// unmatcheddeposit.DefaultID holds the default value on creation for the id field.
+	unmatcheddeposit.DefaultID = unmatcheddepositDescID.Default.(func() uuid.UUID)
 	userMixin := schema.User{}.Mixin()
 	userHooks := schema.User{}.Hooks()
 	user.Hooks[0] = userHooks[0]
@@ -616,6 +1248,47 @@ func init() {
 	verificationtokenDescID := verificationtokenFields[0].Descriptor()
 	// verificationtoken.DefaultID holds the default value on creation for the id field.
 	verificationtoken.DefaultID = verificationtokenDescID.Default.(func() uuid.UUID)
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescError is the schema descriptor for error field.
+	webhookdeliveryDescError := webhookdeliveryFields[8].Descriptor()
+	// webhookdelivery.ErrorValidator is a validator for the "error" field. It is called by the builders before save.
+	webhookdelivery.ErrorValidator = webhookdeliveryDescError.Validators[0].(func(string) error)
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryFields[10].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
+	// webhookdeliveryDescID is the schema descriptor for id field.
+	webhookdeliveryDescID := webhookdeliveryFields[0].Descriptor()
+	// webhookdelivery.DefaultID holds the default value on creation for the id field.
+	webhookdelivery.DefaultID = webhookdeliveryDescID.Default.(func() uuid.UUID)
+	webhookdestinationMixin := schema.WebhookDestination{}.Mixin()
+	webhookdestinationMixinFields0 := webhookdestinationMixin[0].Fields()
+	_ = webhookdestinationMixinFields0
+	webhookdestinationFields := schema.WebhookDestination{}.Fields()
+	_ = webhookdestinationFields
+	// webhookdestinationDescCreatedAt is the schema descriptor for created_at field.
+	webhookdestinationDescCreatedAt := webhookdestinationMixinFields0[0].Descriptor()
+	// webhookdestination.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdestination.DefaultCreatedAt = webhookdestinationDescCreatedAt.Default.(func() time.Time)
+	// webhookdestinationDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookdestinationDescUpdatedAt := webhookdestinationMixinFields0[1].Descriptor()
+	// webhookdestination.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhookdestination.DefaultUpdatedAt = webhookdestinationDescUpdatedAt.Default.(func() time.Time)
+	// webhookdestination.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhookdestination.UpdateDefaultUpdatedAt = webhookdestinationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhookdestinationDescDeliveredCount is the schema descriptor for delivered_count field.
+	webhookdestinationDescDeliveredCount := webhookdestinationFields[6].Descriptor()
+	// webhookdestination.DefaultDeliveredCount holds the default value on creation for the delivered_count field.
+	webhookdestination.DefaultDeliveredCount = webhookdestinationDescDeliveredCount.Default.(int)
+	// webhookdestinationDescFailedCount is the schema descriptor for failed_count field.
+	webhookdestinationDescFailedCount := webhookdestinationFields[7].Descriptor()
+	// webhookdestination.DefaultFailedCount holds the default value on creation for the failed_count field.
+	webhookdestination.DefaultFailedCount = webhookdestinationDescFailedCount.Default.(int)
+	// webhookdestinationDescID is the schema descriptor for id field.
+	webhookdestinationDescID := webhookdestinationFields[0].Descriptor()
+	// webhookdestination.DefaultID holds the default value on creation for the id field.
+	webhookdestination.DefaultID = webhookdestinationDescID.Default.(func() uuid.UUID)
 	webhookretryattemptMixin := schema.WebhookRetryAttempt{}.Mixin()
 	webhookretryattemptMixinFields0 := webhookretryattemptMixin[0].Fields()
 	_ = webhookretryattemptMixinFields0
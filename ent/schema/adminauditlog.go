@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// AdminAuditLog holds the schema definition for the AdminAuditLog entity.
+// Every operation performed on an order, deposit or dead letter through the admin API is recorded
+// along with who performed it and why. Entries are never updated.
+type AdminAuditLog struct {
+	ent.Schema
+}
+
+// Fields of the AdminAuditLog.
+func (AdminAuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.Enum("action").
+			Values("force_refund", "requeue", "reassign_provider", "approve_quarantined", "refund_quarantined",
+				"link_unmatched_deposit", "refund_unmatched_deposit", "sweep_unmatched_deposit", "retry_dead_letter").
+			Immutable(),
+		field.String("target_id").Immutable(),
+		field.String("actor").Immutable(),
+		field.String("reason").
+			MaxLen(500).
+			Immutable(),
+		field.JSON("metadata", map[string]interface{}{}).
+			Optional().
+			Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Indexes of the AdminAuditLog.
+func (AdminAuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("target_id"),
+		index.Fields("created_at"),
+	}
+}
@@ -1,6 +1,8 @@
 package schema
 
 import (
+	"time"
+
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
@@ -21,6 +23,30 @@ func (APIKey) Fields() []ent.Field {
 		field.String("secret").
 			NotEmpty().
 			Unique(),
+		field.String("name").
+			MaxLen(100).
+			Optional(),
+		// What the key may be used for. Keys without scopes, like the key created at signup, have
+		// every scope
+		field.Strings("scopes").
+			Optional(),
+		// Requests per minute the key may make; keys without one are only limited per IP
+		field.Int("rate_limit").
+			Positive().
+			Optional().
+			Nillable(),
+		// When the key stops authenticating. Rotated keys keep working until the overlap ends and
+		// revoked keys expire at once
+		field.Time("expires_at").
+			Optional().
+			Nillable(),
+		field.Time("last_used_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Optional().
+			Immutable(),
 	}
 }
 
@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// BlockchainRoute holds the schema definition for the BlockchainRoute entity.
+// A blockchain route sends the transactions of new EVM payment orders through one blockchain
+// service: Alchemy, Thirdweb Engine or the network's own RPC endpoints. It applies to the orders of
+// a sender, token and network, or of every sender, token or network where it has none, whose USD
+// amount falls in its band, while it is enabled.
+type BlockchainRoute struct {
+	ent.Schema
+}
+
+// Mixin of the BlockchainRoute.
+func (BlockchainRoute) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the BlockchainRoute.
+func (BlockchainRoute) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.Enum("service").
+			Values("engine", "alchemy", "rpc"),
+		// Symbol of the token the route applies to; empty for every token
+		field.String("token").
+			MaxLen(20).
+			Default(""),
+		// Identifier of the network the route applies to; empty for every network
+		field.String("network").
+			MaxLen(60).
+			Default(""),
+		// USD amount band of the orders the route applies to, from the minimum up to, excluding, the maximum
+		field.Float("min_amount").
+			GoType(decimal.Decimal{}).
+			DefaultFunc(func() decimal.Decimal { return decimal.Zero }),
+		field.Float("max_amount").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Nillable(),
+		// Breaks ties between routes that apply equally narrowly; the highest priority wins
+		field.Int("priority").
+			Default(0),
+		field.Bool("is_enabled").
+			Default(true),
+	}
+}
+
+// Edges of the BlockchainRoute.
+func (BlockchainRoute) Edges() []ent.Edge {
+	return []ent.Edge{
+		// The sender the route applies to; every sender when unset
+		edge.From("sender_profile", SenderProfile.Type).
+			Ref("blockchain_routes").
+			Unique(),
+	}
+}
+
+// Indexes of the BlockchainRoute.
+func (BlockchainRoute) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("is_enabled"),
+	}
+}
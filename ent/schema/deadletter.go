@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// DeadLetter holds the schema definition for the DeadLetter entity.
+// An indexed event whose processing failed is kept here with what is needed to process it again,
+// instead of only being logged. The retry worker reprocesses pending entries with a backoff until
+// they succeed or run out of retries; exhausted entries are left to ops to retry manually.
+type DeadLetter struct {
+	ent.Schema
+}
+
+// Mixin of the DeadLetter.
+func (DeadLetter) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the DeadLetter.
+func (DeadLetter) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		// The payment order ID for receive address updates, and the gateway ID of the order for
+		// gateway events
+		field.String("order_id").Immutable(),
+		field.Enum("stage").
+			Values("receive_address", "order_created", "order_settled", "order_refunded").
+			Immutable(),
+		field.String("network").Immutable(),
+		// The event and arguments the stage is processed again with
+		field.JSON("payload", map[string]interface{}{}).Immutable(),
+		field.String("last_error").MaxLen(500),
+		field.Enum("status").
+			Values("pending", "resolved", "exhausted").
+			Default("pending"),
+		field.Int("retry_count").Default(0),
+		field.Time("next_retry_at").Default(time.Now),
+		field.Time("resolved_at").Optional(),
+	}
+}
+
+// Indexes of the DeadLetter.
+func (DeadLetter) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "next_retry_at"),
+		index.Fields("order_id", "stage"),
+	}
+}
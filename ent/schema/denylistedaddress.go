@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// DenylistedAddress holds the schema definition for the DenylistedAddress entity.
+// Deposits sent from a denylisted address are quarantined by compliance screening instead of
+// being settled. An entry applies to one network, or to every network when it has none, until it
+// expires.
+type DenylistedAddress struct {
+	ent.Schema
+}
+
+// Mixin of the DenylistedAddress.
+func (DenylistedAddress) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the DenylistedAddress.
+func (DenylistedAddress) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.String("address").
+			MaxLen(60),
+		// Identifier of the network the entry applies to; empty for every network
+		field.String("network").
+			MaxLen(60).
+			Default(""),
+		field.String("reason").
+			MaxLen(500).
+			Optional(),
+		// Where the entry came from, e.g. a sanctions list
+		field.String("source").
+			MaxLen(100).
+			Optional(),
+		field.Time("expires_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the DenylistedAddress.
+func (DenylistedAddress) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("address", "network").Unique(),
+	}
+}
@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// DepositSplit holds the schema definition for the DepositSplit entity.
+// A deposit split is a single transfer whose value matches the sum of several
+// open orders on the same receive address, held for an operator to confirm.
+type DepositSplit struct {
+	ent.Schema
+}
+
+// Mixin of the DepositSplit.
+func (DepositSplit) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the DepositSplit.
+func (DepositSplit) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.String("tx_hash").
+			MaxLen(70).
+			Unique(),
+		field.String("network"),
+		field.String("receive_address"),
+		field.String("from_address"),
+		field.Float("amount").GoType(decimal.Decimal{}),
+		field.Int64("block_number").Default(0),
+		field.Enum("status").
+			Values("pending", "confirmed", "rejected").
+			Default("pending"),
+		field.Time("resolved_at").Optional(),
+	}
+}
+
+// Edges of the DepositSplit.
+func (DepositSplit) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("payment_orders", PaymentOrder.Type),
+	}
+}
+
+// Indexes of the DepositSplit.
+func (DepositSplit) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status"),
+	}
+}
@@ -0,0 +1,81 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// FeeSchedule holds the schema definition for the FeeSchedule entity.
+// A fee schedule sets one fee of new payment orders to a percentage of the order amount plus a flat
+// USD amount. It applies to the orders of a sender, token and network, or of every sender, token or
+// network where it has none, whose USD amount falls in its band, while it is in effect.
+type FeeSchedule struct {
+	ent.Schema
+}
+
+// Mixin of the FeeSchedule.
+func (FeeSchedule) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the FeeSchedule.
+func (FeeSchedule) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.Enum("fee_type").
+			Values("sender", "network", "protocol"),
+		// Symbol of the token the schedule applies to; empty for every token
+		field.String("token").
+			MaxLen(20).
+			Default(""),
+		// Identifier of the network the schedule applies to; empty for every network
+		field.String("network").
+			MaxLen(60).
+			Default(""),
+		// USD amount band of the orders the schedule applies to, from the minimum up to, excluding, the maximum
+		field.Float("min_amount").
+			GoType(decimal.Decimal{}).
+			DefaultFunc(func() decimal.Decimal { return decimal.Zero }),
+		field.Float("max_amount").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Nillable(),
+		field.Float("percent").
+			GoType(decimal.Decimal{}).
+			DefaultFunc(func() decimal.Decimal { return decimal.Zero }),
+		// Flat fee in USD, charged in the order's token
+		field.Float("flat_amount").
+			GoType(decimal.Decimal{}).
+			DefaultFunc(func() decimal.Decimal { return decimal.Zero }),
+		field.Time("effective_from").
+			Default(time.Now),
+		field.Time("effective_until").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the FeeSchedule.
+func (FeeSchedule) Edges() []ent.Edge {
+	return []ent.Edge{
+		// The sender the schedule applies to; every sender when unset
+		edge.From("sender_profile", SenderProfile.Type).
+			Ref("fee_schedules").
+			Unique(),
+	}
+}
+
+// Indexes of the FeeSchedule.
+func (FeeSchedule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("fee_type", "effective_from"),
+	}
+}
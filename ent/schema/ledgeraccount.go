@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// LedgerAccount holds the schema definition for the LedgerAccount entity.
+// A ledger account holds the balance of one token in one part of the aggregator's books. The balance
+// is the sum of the account's postings, where debits are positive and credits negative.
+type LedgerAccount struct {
+	ent.Schema
+}
+
+// Mixin of the LedgerAccount.
+func (LedgerAccount) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the LedgerAccount.
+func (LedgerAccount) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.Enum("name").
+			Values("receive_addresses", "gateway_escrow", "swept", "order_funds", "sender_fees", "network_fees").
+			Immutable(),
+		field.Enum("type").
+			Values("asset", "liability", "revenue").
+			Immutable(),
+		field.Float("balance").
+			GoType(decimal.Decimal{}).
+			DefaultFunc(func() decimal.Decimal { return decimal.Zero }),
+	}
+}
+
+// Edges of the LedgerAccount.
+func (LedgerAccount) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("token", Token.Type).
+			Ref("ledger_accounts").
+			Unique().
+			Required().
+			Immutable(),
+		edge.To("postings", LedgerPosting.Type),
+	}
+}
+
+// Indexes of the LedgerAccount.
+func (LedgerAccount) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name").
+			Edges("token").
+			Unique(),
+	}
+}
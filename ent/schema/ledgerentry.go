@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// LedgerEntry holds the schema definition for the LedgerEntry entity.
+// A ledger entry is a journal entry recording one movement of value between ledger accounts. Its
+// postings sum to zero, and it is never changed once written.
+type LedgerEntry struct {
+	ent.Schema
+}
+
+// Fields of the LedgerEntry.
+func (LedgerEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.Enum("kind").
+			Values("deposit", "deposit_reversal", "order_created", "settlement", "refund", "overpayment_refund", "sweep", "sweep_reversal").
+			Immutable(),
+		// Identifies the movement within its kind, so recording it again is a no-op
+		field.String("reference").
+			MaxLen(150).
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the LedgerEntry.
+func (LedgerEntry) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("payment_order", PaymentOrder.Type).
+			Ref("ledger_entries").
+			Unique().
+			Immutable(),
+		edge.To("postings", LedgerPosting.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the LedgerEntry.
+func (LedgerEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("kind", "reference").
+			Unique(),
+	}
+}
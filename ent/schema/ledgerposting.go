@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// LedgerPosting holds the schema definition for the LedgerPosting entity.
+// A ledger posting is one line of a journal entry: a positive amount debits its account and a
+// negative amount credits it.
+type LedgerPosting struct {
+	ent.Schema
+}
+
+// Fields of the LedgerPosting.
+func (LedgerPosting) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.Float("amount").
+			GoType(decimal.Decimal{}).
+			Immutable(),
+	}
+}
+
+// Edges of the LedgerPosting.
+func (LedgerPosting) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("entry", LedgerEntry.Type).
+			Ref("postings").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("account", LedgerAccount.Type).
+			Ref("postings").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
 )
 
 // LinkedAddress holds the schema definition for the LinkedAddress entity.
@@ -31,12 +32,31 @@ func (LinkedAddress) Fields() []ent.Field {
 		field.JSON("metadata", map[string]interface{}{}).
 			Optional(),
 		field.String("owner_address").
+			Optional().
 			Unique(),
 		field.Int64("last_indexed_block").
 			Optional(),
 		field.String("tx_hash").
 			MaxLen(70).
 			Optional(),
+		field.String("handle").
+			MaxLen(32).
+			Optional().
+			Unique(),
+		field.Bool("is_active").
+			Default(true),
+		field.JSON("allowed_networks", []string{}).
+			Optional(),
+		field.JSON("allowed_tokens", []string{}).
+			Optional(),
+		field.Float("max_transfer_amount").
+			GoType(decimal.Decimal{}).
+			DefaultFunc(func() decimal.Decimal { return decimal.Zero }),
+		field.Float("daily_volume_limit").
+			GoType(decimal.Decimal{}).
+			DefaultFunc(func() decimal.Decimal { return decimal.Zero }),
+		field.String("replaced_by").
+			Optional(),
 	}
 }
 
@@ -45,5 +65,8 @@ func (LinkedAddress) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("payment_orders", PaymentOrder.Type).
 			Annotations(entsql.OnDelete(entsql.SetNull)),
+		edge.From("sender_profile", SenderProfile.Type).
+			Ref("linked_address").
+			Unique(),
 	}
 }
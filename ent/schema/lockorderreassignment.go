@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// LockOrderReassignment holds the schema definition for the LockOrderReassignment entity.
+// A lock order taken away from a provider that accepted it but did not fulfill it in time is
+// recorded along with the provider and why. Entries are never updated.
+type LockOrderReassignment struct {
+	ent.Schema
+}
+
+// Fields of the LockOrderReassignment.
+func (LockOrderReassignment) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.String("previous_provider_id").Immutable(),
+		field.String("reason").Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the LockOrderReassignment.
+func (LockOrderReassignment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("lock_payment_order", LockPaymentOrder.Type).
+			Ref("reassignments").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
@@ -11,6 +11,8 @@ import (
 )
 
 // LockPaymentOrder holds the schema definition for the LockPaymentOrder entity.
+// An order too large for any provision bucket is split: it becomes the parent, with status split,
+// of child orders that each carry a share of its amount and are assigned to providers separately.
 type LockPaymentOrder struct {
 	ent.Schema
 }
@@ -41,7 +43,7 @@ func (LockPaymentOrder) Fields() []ent.Field {
 			MaxLen(70).
 			Optional(),
 		field.Enum("status").
-			Values("pending", "processing", "cancelled", "fulfilled", "validated", "settled", "refunded").
+			Values("pending", "processing", "cancelled", "fulfilled", "validated", "settled", "refunded", "split").
 			Default("pending"),
 		field.Int64("block_number"),
 		field.String("institution"),
@@ -60,6 +62,18 @@ func (LockPaymentOrder) Fields() []ent.Field {
 			Optional(),
 		field.Float("amount_in_usd").
 			GoType(decimal.Decimal{}),
+		// Stage whose SLA timer was breached, set when the order is escalated
+		field.Enum("sla_breached_stage").
+			Values("fulfillment", "settlement").
+			Optional(),
+		field.Time("sla_breached_at").
+			Optional(),
+		// Set when the order needs manual review before it proceeds, e.g. its token depegged in flight
+		field.String("review_reason").
+			Optional(),
+		// Position of a part among the parts of a split order, starting at 1; zero for other orders
+		field.Int("split_index").
+			Default(0),
 	}
 }
 
@@ -79,14 +93,23 @@ func (LockPaymentOrder) Edges() []ent.Edge {
 		edge.To("fulfillments", LockOrderFulfillment.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("transactions", TransactionLog.Type),
+		edge.To("reassignments", LockOrderReassignment.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("children", LockPaymentOrder.Type).
+			From("parent").
+			Unique(),
 	}
 }
 
 // Indexes of the LockPaymentOrder.
 func (LockPaymentOrder) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("gateway_id", "rate", "tx_hash", "block_number", "institution", "account_identifier", "account_name", "memo").
+		index.Fields("gateway_id", "rate", "tx_hash", "block_number", "institution", "account_identifier", "account_name", "memo", "split_index").
 			Edges("token").
 			Unique(),
+		// Cover the order listings of providers, paginated by a cursor on the sort field and ID
+		index.Edges("provider"),
+		index.Fields("created_at", "id").Edges("provider"),
+		index.Fields("updated_at", "id").Edges("provider"),
 	}
 }
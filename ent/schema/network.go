@@ -27,8 +27,21 @@ func (Network) Fields() []ent.Field {
 		// e.g "bnb-smart-chain", "base", "arbitrum-one", "polygon", "ethereum", "ethereum-sepolia", "tron-shasta", "tron"
 		field.String("identifier").
 			Unique(),
+		// Chain family, selects how addresses are generated, validated and monitored
+		field.Enum("network_type").
+			Values("evm", "tron", "solana").
+			Default("evm"),
 		field.String("rpc_endpoint"),
+		// WebSocket RPC endpoint; when set, transfers to monitored addresses are indexed from a log subscription
+		field.String("wss_endpoint").
+			Optional(),
 		field.String("gateway_contract_address").Default(""),
+		// Block explorer base URL, e.g. https://basescan.org
+		field.String("explorer_url").
+			Optional(),
+		// Alchemy network identifier, e.g. BASE_MAINNET; overrides the identifier known for chain_id
+		field.String("alchemy_network_id").
+			Optional(),
 		field.Float("block_time").
 			GoType(decimal.Decimal{}),
 		field.Bool("is_testnet"),
@@ -38,6 +51,52 @@ func (Network) Fields() []ent.Field {
 			Optional(),
 		field.Float("fee").
 			GoType(decimal.Decimal{}),
+		// Blocks a deposit must be buried under before it is considered final
+		// e.g. a challenge period on optimistic rollups; 0 means final on inclusion
+		field.Int("finality_blocks").
+			NonNegative().
+			Default(0),
+		// Confirmations a deposit needs before it is credited; 0 credits deposits on first sight
+		field.Int("required_confirmations").
+			NonNegative().
+			Default(0),
+		// Default for orders that don't choose a settlement policy
+		field.Enum("settlement_policy").
+			Values("soft_confirm", "finality").
+			Default("soft_confirm"),
+		// Minutes an unpaid order stays open; overrides RECEIVE_ADDRESS_VALIDITY
+		field.Int("order_ttl_minutes").
+			Positive().
+			Optional().
+			Nillable(),
+		// Hash of block 0, used to detect chain resets that invalidate indexed state
+		field.String("genesis_hash").
+			Optional(),
+		// Set at startup when the live genesis hash no longer matches; cleared by a network reset
+		field.Bool("genesis_mismatch").
+			Default(false),
+		// Owner of smart accounts created on this network; overrides SMART_ACCOUNT_OWNER_ADDRESS
+		field.String("smart_account_owner_address").
+			Optional(),
+		// Where the owner key is held: env:<VARIABLE> for a hex key in the environment,
+		// aws_kms:<key id> or gcp_kms:<key version name>. Empty uses the SIGNER_BACKEND owner key
+		field.String("smart_account_owner_signer").
+			Optional(),
+		// How deposits to receive addresses are detected; unset follows ENABLE_WEBHOOKS,
+		// ENABLE_WEBSOCKET_INDEXER and ENABLE_POLLING_FALLBACK
+		field.Bool("webhooks_enabled").
+			Optional().
+			Nillable(),
+		field.Bool("websocket_enabled").
+			Optional().
+			Nillable(),
+		field.Bool("polling_enabled").
+			Optional().
+			Nillable(),
+		// Disabled networks take no new orders and get no webhooks or WebSocket subscriptions;
+		// deposits to their open orders are still picked up by polling
+		field.Bool("is_enabled").
+			Default(true),
 	}
 }
 
@@ -48,5 +107,8 @@ func (Network) Edges() []ent.Edge {
 			Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("payment_webhook", PaymentWebhook.Type).
 			Unique(),
+		// Fallback RPC endpoints, used when rpc_endpoint is unhealthy
+		edge.To("rpc_endpoints", RPCEndpoint.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// OutboxTransaction holds the schema definition for the OutboxTransaction entity.
+// Settlements and refunds are persisted here before they are sent on-chain, so a crash between
+// deciding to send a transaction and sending it loses nothing. The outbox worker sends pending
+// transactions and tracks sent ones until they are confirmed.
+type OutboxTransaction struct {
+	ent.Schema
+}
+
+// Mixin of the OutboxTransaction.
+func (OutboxTransaction) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the OutboxTransaction.
+func (OutboxTransaction) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.Enum("kind").
+			Values("settle_order", "refund_order").
+			Immutable(),
+		field.UUID("lock_order_id", uuid.UUID{}).Immutable(),
+		field.String("network").Immutable(),
+		field.Int64("chain_id").Immutable(),
+		field.String("from_address").Immutable(),
+		field.JSON("payload", []map[string]interface{}{}).Immutable(),
+		field.Enum("status").
+			Values("pending", "sent", "confirmed", "failed").
+			Default("pending"),
+		field.Int("attempts").Default(0),
+		field.String("last_error").
+			MaxLen(500).
+			Optional(),
+		// The UserOperation hash or Engine queue ID the active service returned when sending
+		field.String("transaction_id").Optional(),
+		field.String("tx_hash").
+			MaxLen(70).
+			Optional(),
+		// A pending transaction is sent once due; the worker sending it pushes this back so no
+		// other drain picks it up meanwhile
+		field.Time("next_attempt_at").Default(time.Now),
+		field.Time("sent_at").Optional(),
+		// Gas the EntryPoint charged for the transaction's UserOperations, summed over the attempts
+		// that were executed on-chain, including reverted ones. The cost is in the native token
+		field.Int64("gas_used").Default(0),
+		field.Float("gas_cost").
+			GoType(decimal.Decimal{}).
+			DefaultFunc(func() decimal.Decimal { return decimal.Zero }),
+	}
+}
+
+// Indexes of the OutboxTransaction.
+func (OutboxTransaction) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "next_attempt_at"),
+		index.Fields("lock_order_id", "kind"),
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 )
@@ -28,6 +29,10 @@ func (PaymentOrder) Fields() []ent.Field {
 		field.Float("amount").GoType(decimal.Decimal{}),
 		field.Float("amount_paid").GoType(decimal.Decimal{}),
 		field.Float("amount_returned").GoType(decimal.Decimal{}),
+		// Excess paid over the order amount, refunded to the sender when their profile asks for it
+		field.Float("amount_overpaid").
+			GoType(decimal.Decimal{}).
+			DefaultFunc(func() decimal.Decimal { return decimal.Zero }),
 		field.Float("percent_settled").GoType(decimal.Decimal{}),
 		field.Float("sender_fee").GoType(decimal.Decimal{}),
 		field.Float("network_fee").GoType(decimal.Decimal{}),
@@ -59,10 +64,62 @@ func (PaymentOrder) Fields() []ent.Field {
 			MaxLen(70).
 			Optional(),
 		field.Enum("status").
-			Values("initiated", "processing", "pending", "validated", "expired", "settled", "refunded").
+			Values("initiated", "processing", "awaiting_confirmations", "pending", "validated", "expired", "settled", "refunded", "quarantined").
 			Default("initiated"),
 		field.Float("amount_in_usd").
 			GoType(decimal.Decimal{}),
+		// Whether the order is created on-chain once the deposit is seen or once it is final
+		field.Enum("settlement_policy").
+			Values("soft_confirm", "finality").
+			Default("soft_confirm"),
+		field.Enum("deposit_status").
+			Values("soft_confirmed", "finalized").
+			Optional(),
+		field.Time("deposit_finalized_at").
+			Optional(),
+		// Confirmations the deposit needs before the order is created on-chain, taken from the network when paid
+		field.Int("required_confirmations").
+			NonNegative().
+			Default(0),
+		// Set when the payment window SLA is breached and the order is escalated
+		field.Time("sla_breached_at").
+			Optional(),
+		// Set when the order needs manual review before it proceeds, e.g. its token depegged in flight
+		field.String("review_reason").
+			Optional(),
+		// Set when compliance screening flags the sender of the deposit and the order is quarantined.
+		// Orders risk scoring holds for review get it at creation and are quarantined once paid
+		field.String("quarantine_reason").
+			Optional(),
+		// Set for orders denominated in fiat, whose token amount is converted from it when the deposit is seen
+		field.Float("fiat_amount").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Nillable(),
+		field.String("fiat_currency").
+			MaxLen(10).
+			Optional(),
+		// Drift from the locked rate accepted when converting the fiat amount, as a fraction of the rate
+		field.Float("rate_drift_tolerance").
+			GoType(decimal.Decimal{}).
+			DefaultFunc(func() decimal.Decimal { return decimal.Zero }),
+		// The conversion applied to the fiat amount at payment time
+		field.JSON("fiat_conversion", map[string]interface{}{}).
+			Optional(),
+		// The quoted rate is honored for deposits completing the order before this time. Orders paid
+		// later are re-quoted when the market moved beyond the re-quote threshold
+		field.Time("rate_locked_until").
+			Optional(),
+		// The re-quote applied to the rate of an order paid after its rate lock
+		field.JSON("rate_requote", map[string]interface{}{}).
+			Optional(),
+		// Blockchain service sending the order's transactions, chosen by the blockchain routes or the
+		// service holding the receive address. It stays the same after USE_ALCHEMY_SERVICE or the
+		// routes change. Unset on orders older than the field
+		field.Enum("blockchain_service").
+			Values("engine", "alchemy", "rpc").
+			Optional().
+			Nillable(),
 	}
 }
 
@@ -88,5 +145,30 @@ func (PaymentOrder) Edges() []ent.Edge {
 		edge.To("transactions", TransactionLog.Type),
 		edge.To("payment_webhook", PaymentWebhook.Type).
 			Unique(),
+		// Sends a partial payment back to the payer when the order expires
+		edge.To("refund_sweep", Sweep.Type).
+			Unique().
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+		edge.From("deposit_split", DepositSplit.Type).
+			Ref("payment_orders").
+			Unique(),
+		edge.To("ledger_entries", LedgerEntry.Type),
+		edge.To("reconciliation_discrepancies", ReconciliationDiscrepancy.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+		edge.To("unmatched_deposits", UnmatchedDeposit.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+		edge.To("address_assignments", ReceiveAddressAssignment.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the PaymentOrder.
+func (PaymentOrder) Indexes() []ent.Index {
+	return []ent.Index{
+		// Cover the order listings of senders, paginated by a cursor on the sort field and ID
+		index.Edges("sender_profile"),
+		index.Fields("created_at", "id").Edges("sender_profile"),
+		index.Fields("updated_at", "id").Edges("sender_profile"),
+		index.Fields("reference"),
 	}
 }
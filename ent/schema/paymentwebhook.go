@@ -32,6 +32,10 @@ func (PaymentWebhook) Fields() []ent.Field {
 		field.String("callback_url").
 			MaxLen(255).
 			NotEmpty(),
+		field.Enum("provider").
+			Values("thirdweb", "alchemy", "quicknode", "moralis").
+			Default("thirdweb").
+			Comment("Notify provider delivering the webhook, selects how deliveries are authenticated and parsed"),
 	}
 }
 
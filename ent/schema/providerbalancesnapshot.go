@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ProviderBalanceSnapshot holds the schema definition for the ProviderBalanceSnapshot entity.
+// A snapshot records one float of a provider, either the fiat balance of a currency or the
+// on-chain token balance of a settlement address, as seen by the balance monitor.
+type ProviderBalanceSnapshot struct {
+	ent.Schema
+}
+
+// Mixin of the ProviderBalanceSnapshot.
+func (ProviderBalanceSnapshot) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the ProviderBalanceSnapshot.
+func (ProviderBalanceSnapshot) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.Enum("kind").
+			Values("fiat", "onchain"),
+		field.String("asset"),
+		field.String("network").Optional(),
+		field.String("address").Optional(),
+		field.Float("available_balance").GoType(decimal.Decimal{}),
+		field.Float("total_balance").GoType(decimal.Decimal{}),
+		field.Float("threshold").GoType(decimal.Decimal{}),
+		field.Bool("below_threshold").Default(false),
+	}
+}
+
+// Edges of the ProviderBalanceSnapshot.
+func (ProviderBalanceSnapshot) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("provider", ProviderProfile.Type).
+			Ref("balance_snapshots").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the ProviderBalanceSnapshot.
+func (ProviderBalanceSnapshot) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+	}
+}
@@ -29,6 +29,11 @@ func (ProviderCurrencies) Fields() []ent.Field {
 			GoType(decimal.Decimal{}),
 		field.Bool("is_available").
 			Default(true),
+		// Set while the balance is below the monitor's threshold, excluding the provider from the
+		// queue of the currency until replenished
+		field.Time("low_balance_since").
+			Optional().
+			Nillable(),
 		field.Time("updated_at").
 			Default(time.Now).
 			UpdateDefault(time.Now),
@@ -37,6 +37,11 @@ func (ProviderOrderToken) Fields() []ent.Field {
 			GoType(decimal.Decimal{}),
 		field.String("address").Optional(),
 		field.String("network"),
+		// Set while the token balance of the address is below the monitor's threshold, excluding
+		// the token from the provider's queue entries until replenished
+		field.Time("low_balance_since").
+			Optional().
+			Nillable(),
 	}
 }
 
@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// ProviderPerformance holds the schema definition for the ProviderPerformance entity.
+// It tracks how a provider fulfills the lock orders assigned to it; the rates are moving
+// averages so recent orders weigh more than old ones.
+type ProviderPerformance struct {
+	ent.Schema
+}
+
+// Mixin of the ProviderPerformance.
+func (ProviderPerformance) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the ProviderPerformance.
+func (ProviderPerformance) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("orders_settled").Default(0),
+		field.Int("orders_failed").Default(0),
+		field.Int("orders_cancelled").Default(0),
+		field.Float("success_rate").Default(1),
+		field.Float("cancellation_rate").Default(0),
+		field.Float("avg_settlement_seconds").Default(0),
+		field.Float("score").Default(1),
+	}
+}
+
+// Edges of the ProviderPerformance.
+func (ProviderPerformance) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("provider", ProviderProfile.Type).
+			Ref("performance").
+			Required().
+			Unique(),
+	}
+}
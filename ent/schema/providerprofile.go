@@ -49,8 +49,8 @@ func (ProviderProfile) Edges() []ent.Edge {
 			Unique().
 			Required().
 			Immutable(),
+		// A profile has several keys once it creates scoped keys or rotates them
 		edge.To("api_key", APIKey.Type).
-			Unique().
 			Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("provider_currencies", ProviderCurrencies.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
@@ -62,6 +62,11 @@ func (ProviderProfile) Edges() []ent.Edge {
 			Unique(),
 		edge.To("assigned_orders", LockPaymentOrder.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("balance_snapshots", ProviderBalanceSnapshot.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("performance", ProviderPerformance.Type).
+			Unique().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -71,6 +72,22 @@ func (ReceiveAddress) Fields() []ent.Field {
 		field.Int("times_used").
 			Default(0).
 			Comment("Number of times address has been reused"),
+
+		// Address generation
+		field.String("owner_address").
+			Optional().
+			Comment("Owner the smart account address was computed for"),
+		field.String("generation_fingerprint").
+			Optional().
+			Comment("Version, factory and packing hash of the code that computed the address"),
+		field.Enum("salt_derivation").
+			Values("random", "hkdf").
+			Default("random").
+			Comment("How the salt was generated; hkdf salts can be re-derived from the master secret"),
+		field.Int64("derivation_index").
+			Optional().
+			Nillable().
+			Comment("Index an hkdf salt was derived at, unique per chain"),
 		
 		// Existing fields
 		field.Int64("last_indexed_block").Optional(),
@@ -88,6 +105,8 @@ func (ReceiveAddress) Edges() []ent.Edge {
 		edge.From("payment_order", PaymentOrder.Type).
 			Ref("receive_address").
 			Unique(),
+		edge.To("assignments", ReceiveAddressAssignment.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
 	}
 }
 
@@ -102,5 +121,8 @@ func (ReceiveAddress) Indexes() []ent.Index {
 		
 		// Track reuse count for pool maintenance
 		index.Fields("times_used"),
+
+		// One derived salt per chain and index
+		index.Fields("chain_id", "derivation_index").Unique(),
 	}
 }
@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ReceiveAddressAssignment holds the schema definition for the ReceiveAddressAssignment entity.
+// An assignment records the window during which a receive address served an order, so deposits
+// to a recycled pool address are credited to the order it served when the deposit was mined.
+type ReceiveAddressAssignment struct {
+	ent.Schema
+}
+
+// Mixin of the ReceiveAddressAssignment.
+func (ReceiveAddressAssignment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the ReceiveAddressAssignment.
+func (ReceiveAddressAssignment) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.String("address"),
+		field.String("network_identifier").Optional(),
+		field.Time("assigned_at").
+			Immutable().
+			Default(time.Now),
+		field.Time("released_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the ReceiveAddressAssignment.
+func (ReceiveAddressAssignment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("receive_address", ReceiveAddress.Type).
+			Ref("assignments").
+			Unique(),
+		edge.From("payment_order", PaymentOrder.Type).
+			Ref("address_assignments").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the ReceiveAddressAssignment.
+func (ReceiveAddressAssignment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("address", "assigned_at"),
+	}
+}
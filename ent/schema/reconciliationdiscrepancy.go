@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ReconciliationDiscrepancy holds the schema definition for the ReconciliationDiscrepancy entity.
+// A discrepancy is a transfer to a receive address, or a deposit recorded for one, that the two
+// sides of a reconciliation don't agree on.
+type ReconciliationDiscrepancy struct {
+	ent.Schema
+}
+
+// Fields of the ReconciliationDiscrepancy.
+func (ReconciliationDiscrepancy) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.Enum("type").
+			Values("missing_credit", "unknown_deposit", "amount_mismatch", "unmatched_credit"),
+		field.String("tx_hash").MaxLen(70),
+		field.String("address"),
+		field.String("token"),
+		field.String("from_address").Optional(),
+		field.Int64("block_number").Default(0),
+		field.Float("onchain_amount").GoType(decimal.Decimal{}),
+		field.Float("recorded_amount").GoType(decimal.Decimal{}),
+	}
+}
+
+// Edges of the ReconciliationDiscrepancy.
+func (ReconciliationDiscrepancy) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("report", ReconciliationReport.Type).
+			Ref("discrepancies").
+			Unique().
+			Required(),
+		edge.From("payment_order", PaymentOrder.Type).
+			Ref("reconciliation_discrepancies").
+			Unique(),
+	}
+}
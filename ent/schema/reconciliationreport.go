@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ReconciliationReport holds the schema definition for the ReconciliationReport entity.
+// A report compares the transfers to receive addresses mined on a network during a UTC day
+// with the deposits recorded for them.
+type ReconciliationReport struct {
+	ent.Schema
+}
+
+// Mixin of the ReconciliationReport.
+func (ReconciliationReport) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the ReconciliationReport.
+func (ReconciliationReport) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.String("network"),
+		field.Time("day"),
+		field.Int64("from_block").Default(0),
+		field.Int64("to_block").Default(0),
+		field.Enum("status").
+			Values("completed", "failed"),
+		field.String("error").Optional(),
+		field.Int("addresses_checked").Default(0),
+		field.Int("transfers_checked").Default(0),
+		field.Int("discrepancy_count").Default(0),
+	}
+}
+
+// Edges of the ReconciliationReport.
+func (ReconciliationReport) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("discrepancies", ReconciliationDiscrepancy.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the ReconciliationReport.
+func (ReconciliationReport) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("network", "day").Unique(),
+	}
+}
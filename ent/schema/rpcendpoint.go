@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RPCEndpoint holds the schema definition for the RPCEndpoint entity.
+type RPCEndpoint struct {
+	ent.Schema
+}
+
+// Mixin of the RPCEndpoint.
+func (RPCEndpoint) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the RPCEndpoint.
+func (RPCEndpoint) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("url").
+			NotEmpty(),
+		field.Int("priority").
+			NonNegative().
+			Default(0).
+			Comment("Fallbacks are tried in ascending priority after the network's rpc_endpoint"),
+		field.Bool("is_enabled").
+			Default(true),
+	}
+}
+
+// Edges of the RPCEndpoint.
+func (RPCEndpoint) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("network", Network.Type).
+			Ref("rpc_endpoints").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the RPCEndpoint.
+func (RPCEndpoint) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("url").
+			Edges("network").
+			Unique(),
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 // SenderProfile holds the schema definition for the SenderProfile entity.
@@ -21,12 +22,47 @@ func (SenderProfile) Fields() []ent.Field {
 		field.UUID("id", uuid.UUID{}).
 			Default(uuid.New),
 		field.String("webhook_url").Optional(),
+		// Encrypted secret that signs webhook notifications. Senders without one have them signed
+		// with their API key secret
+		field.String("webhook_secret").
+			Optional().
+			Sensitive(),
+		// Encrypted secret the sender signs API key requests with. Senders with one must sign every
+		// API key request, others may not
+		field.String("request_signing_secret").
+			Optional().
+			Sensitive(),
 		field.Strings("domain_whitelist").
 			Default([]string{}),
 		field.String("provider_id").Optional(),
 		field.Bool("is_partner").Default(false),
 		field.Bool("is_active").
 			Default(false),
+		// How deposits above the order amount are handled: the order amount is raised to what was
+		// paid, or the excess is refunded to the payer
+		field.Enum("overpayment_mode").
+			Values("adjust_amount", "refund").
+			Default("adjust_amount"),
+		// Minutes an unpaid order stays open; overrides the network's TTL
+		field.Int("order_ttl_minutes").
+			Positive().
+			Optional().
+			Nillable(),
+		// Largest order the sender may create, in USD
+		field.Float("max_order_amount").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Nillable(),
+		// Largest USD volume of orders the sender may create in a UTC day
+		field.Float("daily_volume_limit").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Nillable(),
+		// Most orders the sender may create in a rolling hour
+		field.Int("hourly_order_limit").
+			Positive().
+			Optional().
+			Nillable(),
 		field.Time("updated_at").
 			Default(time.Now).
 			UpdateDefault(time.Now),
@@ -41,8 +77,8 @@ func (SenderProfile) Edges() []ent.Edge {
 			Unique().
 			Required().
 			Immutable(),
+		// A profile has several keys once it creates scoped keys or rotates them
 		edge.To("api_key", APIKey.Type).
-			Unique().
 			Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("payment_orders", PaymentOrder.Type).
 			Annotations(entsql.OnDelete(entsql.SetNull)),
@@ -50,5 +86,11 @@ func (SenderProfile) Edges() []ent.Edge {
 			Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("linked_address", LinkedAddress.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("webhook_deliveries", WebhookDelivery.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("fee_schedules", FeeSchedule.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("blockchain_routes", BlockchainRoute.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
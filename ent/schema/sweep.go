@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Sweep holds the schema definition for the Sweep entity.
+// A sweep moves tokens out of a receive address. Sweeps above the offline signing
+// threshold are prepared as unsigned UserOperations and held until an air-gapped
+// signer returns the owner signature.
+type Sweep struct {
+	ent.Schema
+}
+
+// Mixin of the Sweep.
+func (Sweep) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the Sweep.
+func (Sweep) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.String("network"),
+		field.Int64("chain_id"),
+		field.String("token_address"),
+		field.String("from_address"),
+		field.String("to_address"),
+		field.Float("amount").GoType(decimal.Decimal{}),
+		field.JSON("user_operation", map[string]interface{}{}).Optional(),
+		field.String("user_op_hash").
+			MaxLen(70).
+			Optional(),
+		field.String("tx_hash").
+			MaxLen(70).
+			Optional(),
+		// Submitted sweeps that refund an order are tracked until confirmed or failed
+		field.Enum("status").
+			Values("awaiting_signature", "submitted", "confirmed", "failed").
+			Default("awaiting_signature"),
+		field.Time("submitted_at").Optional(),
+	}
+}
+
+// Edges of the Sweep.
+func (Sweep) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("unmatched_deposit", UnmatchedDeposit.Type).
+			Unique(),
+	}
+}
+
+// Indexes of the Sweep.
+func (Sweep) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status"),
+	}
+}
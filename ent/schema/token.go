@@ -5,6 +5,7 @@ import (
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
 )
 
 // Token holds the schema definition for the Token entity.
@@ -27,6 +28,14 @@ func (Token) Fields() []ent.Field {
 		field.Int8("decimals"),
 		field.Bool("is_enabled").Default(false),
 		field.String("base_currency").Default("USD"),
+		// Last price against USD seen by the depeg monitor; zero until first checked
+		field.Float("usd_price").
+			GoType(decimal.Decimal{}).
+			DefaultFunc(func() decimal.Decimal { return decimal.Zero }),
+		// Set while the token trades off its peg; new orders in the token are paused until it recovers
+		field.Time("depegged_at").
+			Optional().
+			Nillable(),
 	}
 }
 
@@ -45,5 +54,8 @@ func (Token) Edges() []ent.Edge {
 			Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("provider_order_tokens", ProviderOrderToken.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("ledger_accounts", LedgerAccount.Type),
+		edge.To("unmatched_deposits", UnmatchedDeposit.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
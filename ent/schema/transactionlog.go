@@ -4,8 +4,11 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 // TransactionLog holds the schema definition for the TransactionLog entity.
@@ -21,17 +24,56 @@ func (TransactionLog) Fields() []ent.Field {
 			Immutable(),
 		field.String("gateway_id").Optional(),
 		field.Enum("status").
-			Values("order_initiated", "crypto_deposited", "order_created", "order_processing", "order_fulfilled", "order_validated", "order_settled", "order_refunded", "gas_prefunded", "gateway_approved").
+			Values("order_initiated", "crypto_deposited", "order_created", "order_processing", "order_fulfilled", "order_validated", "order_settled", "order_refunded", "gas_prefunded", "gateway_approved", "overpayment_refunded", "crypto_deposit_reverted", "receive_address_migrated", "user_operation_sent", "permit_deposit_sent").
 			Default("order_initiated").
 			Immutable(),
 		field.String("network").Optional(),
 		field.String("tx_hash").Optional(),
 		field.JSON("metadata", map[string]interface{}{}),
 		field.Time("created_at").Default(time.Now).Immutable(),
+		// Gas accounting of logged UserOperations: what the gas was spent on, the payment order it
+		// was spent for, and whether the Alchemy Gas Manager policy sponsored it. Gas is recorded
+		// once an attempt is mined; the cost is in the native token
+		field.Enum("purpose").
+			Values("deployment", "order_creation", "settlement", "refund", "sweep").
+			Optional().
+			Nillable(),
+		field.UUID("payment_order_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		field.Bool("sponsored").Default(false),
+		field.String("gas_policy_id").Optional(),
+		field.Int64("gas_used").
+			Optional().
+			Nillable(),
+		field.Float("gas_cost").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Nillable(),
+		// The payment order a crypto deposit is credited to, cleared when a reorg reverts the credit
+		field.UUID("deposit_order_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
 	}
 }
 
 // Edges of the TransactionLog.
 func (TransactionLog) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		// A UserOperation resubmitted with bumped fees is replaced by the log of the new attempt
+		edge.To("replaced_by", TransactionLog.Type).
+			Unique().
+			From("replaces").
+			Unique(),
+	}
+}
+
+// Indexes of the TransactionLog.
+func (TransactionLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("payment_order_id"),
+		// A transfer is credited to an order once, however many times it is delivered
+		index.Fields("tx_hash", "deposit_order_id").
+			Unique(),
+	}
 }
@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// UnmatchedDeposit holds the schema definition for the UnmatchedDeposit entity.
+// An unmatched deposit is a transfer to a receive address that no order could be credited with,
+// held until an operator links it to an order, refunds it or sweeps it to the treasury.
+type UnmatchedDeposit struct {
+	ent.Schema
+}
+
+// Mixin of the UnmatchedDeposit.
+func (UnmatchedDeposit) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the UnmatchedDeposit.
+func (UnmatchedDeposit) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.String("tx_hash").MaxLen(70),
+		field.String("receive_address"),
+		field.String("from_address"),
+		field.Float("amount").GoType(decimal.Decimal{}),
+		field.Int64("block_number").Default(0),
+		field.Enum("reason").
+			Values("expired_address", "paid_order", "no_order"),
+		field.Enum("status").
+			Values("pending", "linked", "refunded", "swept").
+			Default("pending"),
+		field.Time("resolved_at").Optional(),
+	}
+}
+
+// Edges of the UnmatchedDeposit.
+func (UnmatchedDeposit) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("token", Token.Type).
+			Ref("unmatched_deposits").
+			Unique().
+			Required(),
+		edge.From("payment_order", PaymentOrder.Type).
+			Ref("unmatched_deposits").
+			Unique(),
+		edge.From("sweep", Sweep.Type).
+			Ref("unmatched_deposit").
+			Unique(),
+	}
+}
+
+// Indexes of the UnmatchedDeposit.
+func (UnmatchedDeposit) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tx_hash", "receive_address").Unique(),
+		index.Fields("status"),
+	}
+}
@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// WebhookDelivery holds the schema definition for the WebhookDelivery entity.
+// Every attempt to deliver a webhook notification to a sender is logged, so senders can
+// see what was sent to them and how their endpoint answered.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+// Fields of the WebhookDelivery.
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		// Identifies the notification across its attempts
+		field.UUID("notification_id", uuid.UUID{}).Immutable(),
+		field.String("event").Immutable(),
+		field.UUID("order_id", uuid.UUID{}).
+			Optional().
+			Immutable(),
+		field.String("webhook_url").Immutable(),
+		field.Int("attempt_number").Immutable(),
+		// A failed attempt is retried; a dead-lettered one failed for the last time
+		field.Enum("status").
+			Values("delivered", "failed", "dead_lettered").
+			Immutable(),
+		field.Int("response_status").
+			Optional().
+			Immutable(),
+		field.String("error").
+			MaxLen(500).
+			Optional().
+			Immutable(),
+		field.Int64("duration_ms").Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the WebhookDelivery.
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("sender_profile", SenderProfile.Type).
+			Ref("webhook_deliveries").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the WebhookDelivery.
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("notification_id"),
+		index.Fields("order_id"),
+		index.Fields("created_at"),
+	}
+}
@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// WebhookDestination holds the schema definition for the WebhookDestination entity.
+// A webhook destination is an endpoint we deliver outbound notifications to, with an
+// optional retry policy overriding the configured defaults and its delivery stats.
+type WebhookDestination struct {
+	ent.Schema
+}
+
+// Mixin of the WebhookDestination.
+func (WebhookDestination) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the WebhookDestination.
+func (WebhookDestination) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.String("url").Unique(),
+		field.Int("max_attempts").
+			Optional().
+			Nillable(),
+		field.Int("backoff_seconds").
+			Optional().
+			Nillable(),
+		field.Float("backoff_multiplier").
+			Optional().
+			Nillable(),
+		field.Int("timeout_seconds").
+			Optional().
+			Nillable(),
+		field.Int("delivered_count").Default(0),
+		field.Int("failed_count").Default(0),
+		field.Time("last_delivered_at").Optional(),
+		field.Time("last_failed_at").Optional(),
+		field.Time("failing_since").
+			Optional().
+			Nillable(),
+		field.Time("disabled_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the WebhookDestination.
+func (WebhookDestination) Edges() []ent.Edge {
+	return nil
+}
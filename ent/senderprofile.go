@@ -10,10 +10,10 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
-	"github.com/NEDA-LABS/stablenode/ent/apikey"
 	"github.com/NEDA-LABS/stablenode/ent/senderprofile"
 	"github.com/NEDA-LABS/stablenode/ent/user"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 // SenderProfile is the model entity for the SenderProfile schema.
@@ -23,6 +23,10 @@ type SenderProfile struct {
 	ID uuid.UUID `json:"id,omitempty"`
 	// WebhookURL holds the value of the "webhook_url" field.
 	WebhookURL string `json:"webhook_url,omitempty"`
+	// WebhookSecret holds the value of the "webhook_secret" field.
+	WebhookSecret string `json:"-"`
+	// RequestSigningSecret holds the value of the "request_signing_secret" field.
+	RequestSigningSecret string `json:"-"`
 	// DomainWhitelist holds the value of the "domain_whitelist" field.
 	DomainWhitelist []string `json:"domain_whitelist,omitempty"`
 	// ProviderID holds the value of the "provider_id" field.
@@ -31,6 +35,16 @@ type SenderProfile struct {
 	IsPartner bool `json:"is_partner,omitempty"`
 	// IsActive holds the value of the "is_active" field.
 	IsActive bool `json:"is_active,omitempty"`
+	// OverpaymentMode holds the value of the "overpayment_mode" field.
+	OverpaymentMode senderprofile.OverpaymentMode `json:"overpayment_mode,omitempty"`
+	// OrderTTLMinutes holds the value of the "order_ttl_minutes" field.
+	OrderTTLMinutes *int `json:"order_ttl_minutes,omitempty"`
+	// MaxOrderAmount holds the value of the "max_order_amount" field.
+	MaxOrderAmount *decimal.Decimal `json:"max_order_amount,omitempty"`
+	// DailyVolumeLimit holds the value of the "daily_volume_limit" field.
+	DailyVolumeLimit *decimal.Decimal `json:"daily_volume_limit,omitempty"`
+	// HourlyOrderLimit holds the value of the "hourly_order_limit" field.
+	HourlyOrderLimit *int `json:"hourly_order_limit,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -45,16 +59,22 @@ type SenderProfileEdges struct {
 	// User holds the value of the user edge.
 	User *User `json:"user,omitempty"`
 	// APIKey holds the value of the api_key edge.
-	APIKey *APIKey `json:"api_key,omitempty"`
+	APIKey []*APIKey `json:"api_key,omitempty"`
 	// PaymentOrders holds the value of the payment_orders edge.
 	PaymentOrders []*PaymentOrder `json:"payment_orders,omitempty"`
 	// OrderTokens holds the value of the order_tokens edge.
 	OrderTokens []*SenderOrderToken `json:"order_tokens,omitempty"`
 	// LinkedAddress holds the value of the linked_address edge.
 	LinkedAddress []*LinkedAddress `json:"linked_address,omitempty"`
+	// WebhookDeliveries holds the value of the webhook_deliveries edge.
+	WebhookDeliveries []*WebhookDelivery `json:"webhook_deliveries,omitempty"`
+	// FeeSchedules holds the value of the fee_schedules edge.
+	FeeSchedules []*FeeSchedule `json:"fee_schedules,omitempty"`
+	// BlockchainRoutes holds the value of the blockchain_routes edge.
+	BlockchainRoutes []*BlockchainRoute `json:"blockchain_routes,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [8]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -69,12 +89,10 @@ func (e SenderProfileEdges) UserOrErr() (*User, error) {
 }
 
 // APIKeyOrErr returns the APIKey value or an error if the edge
-// was not loaded in eager-loading, or loaded but was not found.
-func (e SenderProfileEdges) APIKeyOrErr() (*APIKey, error) {
-	if e.APIKey != nil {
+// was not loaded in eager-loading.
+func (e SenderProfileEdges) APIKeyOrErr() ([]*APIKey, error) {
+	if e.loadedTypes[1] {
 		return e.APIKey, nil
-	} else if e.loadedTypes[1] {
-		return nil, &NotFoundError{label: apikey.Label}
 	}
 	return nil, &NotLoadedError{edge: "api_key"}
 }
@@ -106,16 +124,47 @@ func (e SenderProfileEdges) LinkedAddressOrErr() ([]*LinkedAddress, error) {
 	return nil, &NotLoadedError{edge: "linked_address"}
 }
 
+// WebhookDeliveriesOrErr returns the WebhookDeliveries value or an error if the edge
+// was not loaded in eager-loading.
+func (e SenderProfileEdges) WebhookDeliveriesOrErr() ([]*WebhookDelivery, error) {
+	if e.loadedTypes[5] {
+		return e.WebhookDeliveries, nil
+	}
+	return nil, &NotLoadedError{edge: "webhook_deliveries"}
+}
+
+// FeeSchedulesOrErr returns the FeeSchedules value or an error if the edge
+// was not loaded in eager-loading.
+func (e SenderProfileEdges) FeeSchedulesOrErr() ([]*FeeSchedule, error) {
+	if e.loadedTypes[6] {
+		return e.FeeSchedules, nil
+	}
+	return nil, &NotLoadedError{edge: "fee_schedules"}
+}
+
+// BlockchainRoutesOrErr returns the BlockchainRoutes value or an error if the edge
+// was not loaded in eager-loading.
+func (e SenderProfileEdges) BlockchainRoutesOrErr() ([]*BlockchainRoute, error) {
+	if e.loadedTypes[7] {
+		return e.BlockchainRoutes, nil
+	}
+	return nil, &NotLoadedError{edge: "blockchain_routes"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*SenderProfile) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case senderprofile.FieldMaxOrderAmount, senderprofile.FieldDailyVolumeLimit:
+			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
 		case senderprofile.FieldDomainWhitelist:
 			values[i] = new([]byte)
 		case senderprofile.FieldIsPartner, senderprofile.FieldIsActive:
 			values[i] = new(sql.NullBool)
-		case senderprofile.FieldWebhookURL, senderprofile.FieldProviderID:
+		case senderprofile.FieldOrderTTLMinutes, senderprofile.FieldHourlyOrderLimit:
+			values[i] = new(sql.NullInt64)
+		case senderprofile.FieldWebhookURL, senderprofile.FieldWebhookSecret, senderprofile.FieldRequestSigningSecret, senderprofile.FieldProviderID, senderprofile.FieldOverpaymentMode:
 			values[i] = new(sql.NullString)
 		case senderprofile.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -150,6 +199,18 @@ func (sp *SenderProfile) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				sp.WebhookURL = value.String
 			}
+		case senderprofile.FieldWebhookSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field webhook_secret", values[i])
+			} else if value.Valid {
+				sp.WebhookSecret = value.String
+			}
+		case senderprofile.FieldRequestSigningSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field request_signing_secret", values[i])
+			} else if value.Valid {
+				sp.RequestSigningSecret = value.String
+			}
 		case senderprofile.FieldDomainWhitelist:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field domain_whitelist", values[i])
@@ -176,6 +237,40 @@ func (sp *SenderProfile) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				sp.IsActive = value.Bool
 			}
+		case senderprofile.FieldOverpaymentMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field overpayment_mode", values[i])
+			} else if value.Valid {
+				sp.OverpaymentMode = senderprofile.OverpaymentMode(value.String)
+			}
+		case senderprofile.FieldOrderTTLMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field order_ttl_minutes", values[i])
+			} else if value.Valid {
+				sp.OrderTTLMinutes = new(int)
+				*sp.OrderTTLMinutes = int(value.Int64)
+			}
+		case senderprofile.FieldMaxOrderAmount:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field max_order_amount", values[i])
+			} else if value.Valid {
+				sp.MaxOrderAmount = new(decimal.Decimal)
+				*sp.MaxOrderAmount = *value.S.(*decimal.Decimal)
+			}
+		case senderprofile.FieldDailyVolumeLimit:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field daily_volume_limit", values[i])
+			} else if value.Valid {
+				sp.DailyVolumeLimit = new(decimal.Decimal)
+				*sp.DailyVolumeLimit = *value.S.(*decimal.Decimal)
+			}
+		case senderprofile.FieldHourlyOrderLimit:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field hourly_order_limit", values[i])
+			} else if value.Valid {
+				sp.HourlyOrderLimit = new(int)
+				*sp.HourlyOrderLimit = int(value.Int64)
+			}
 		case senderprofile.FieldUpdatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
@@ -227,6 +322,21 @@ func (sp *SenderProfile) QueryLinkedAddress() *LinkedAddressQuery {
 	return NewSenderProfileClient(sp.config).QueryLinkedAddress(sp)
 }
 
+// QueryWebhookDeliveries queries the "webhook_deliveries" edge of the SenderProfile entity.
+func (sp *SenderProfile) QueryWebhookDeliveries() *WebhookDeliveryQuery {
+	return NewSenderProfileClient(sp.config).QueryWebhookDeliveries(sp)
+}
+
+// QueryFeeSchedules queries the "fee_schedules" edge of the SenderProfile entity.
+func (sp *SenderProfile) QueryFeeSchedules() *FeeScheduleQuery {
+	return NewSenderProfileClient(sp.config).QueryFeeSchedules(sp)
+}
+
+// QueryBlockchainRoutes queries the "blockchain_routes" edge of the SenderProfile entity.
+func (sp *SenderProfile) QueryBlockchainRoutes() *BlockchainRouteQuery {
+	return NewSenderProfileClient(sp.config).QueryBlockchainRoutes(sp)
+}
+
 // Update returns a builder for updating this SenderProfile.
 // Note that you need to call SenderProfile.Unwrap() before calling this method if this SenderProfile
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -253,6 +363,10 @@ func (sp *SenderProfile) String() string {
 	builder.WriteString("webhook_url=")
 	builder.WriteString(sp.WebhookURL)
 	builder.WriteString(", ")
+	builder.WriteString("webhook_secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("request_signing_secret=<sensitive>")
+	builder.WriteString(", ")
 	builder.WriteString("domain_whitelist=")
 	builder.WriteString(fmt.Sprintf("%v", sp.DomainWhitelist))
 	builder.WriteString(", ")
@@ -265,6 +379,29 @@ func (sp *SenderProfile) String() string {
 	builder.WriteString("is_active=")
 	builder.WriteString(fmt.Sprintf("%v", sp.IsActive))
 	builder.WriteString(", ")
+	builder.WriteString("overpayment_mode=")
+	builder.WriteString(fmt.Sprintf("%v", sp.OverpaymentMode))
+	builder.WriteString(", ")
+	if v := sp.OrderTTLMinutes; v != nil {
+		builder.WriteString("order_ttl_minutes=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := sp.MaxOrderAmount; v != nil {
+		builder.WriteString("max_order_amount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := sp.DailyVolumeLimit; v != nil {
+		builder.WriteString("daily_volume_limit=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := sp.HourlyOrderLimit; v != nil {
+		builder.WriteString("hourly_order_limit=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(sp.UpdatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
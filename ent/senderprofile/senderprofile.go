@@ -3,6 +3,7 @@
 package senderprofile
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -17,6 +18,10 @@ const (
 	FieldID = "id"
 	// FieldWebhookURL holds the string denoting the webhook_url field in the database.
 	FieldWebhookURL = "webhook_url"
+	// FieldWebhookSecret holds the string denoting the webhook_secret field in the database.
+	FieldWebhookSecret = "webhook_secret"
+	// FieldRequestSigningSecret holds the string denoting the request_signing_secret field in the database.
+	FieldRequestSigningSecret = "request_signing_secret"
 	// FieldDomainWhitelist holds the string denoting the domain_whitelist field in the database.
 	FieldDomainWhitelist = "domain_whitelist"
 	// FieldProviderID holds the string denoting the provider_id field in the database.
@@ -25,6 +30,16 @@ const (
 	FieldIsPartner = "is_partner"
 	// FieldIsActive holds the string denoting the is_active field in the database.
 	FieldIsActive = "is_active"
+	// FieldOverpaymentMode holds the string denoting the overpayment_mode field in the database.
+	FieldOverpaymentMode = "overpayment_mode"
+	// FieldOrderTTLMinutes holds the string denoting the order_ttl_minutes field in the database.
+	FieldOrderTTLMinutes = "order_ttl_minutes"
+	// FieldMaxOrderAmount holds the string denoting the max_order_amount field in the database.
+	FieldMaxOrderAmount = "max_order_amount"
+	// FieldDailyVolumeLimit holds the string denoting the daily_volume_limit field in the database.
+	FieldDailyVolumeLimit = "daily_volume_limit"
+	// FieldHourlyOrderLimit holds the string denoting the hourly_order_limit field in the database.
+	FieldHourlyOrderLimit = "hourly_order_limit"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
 	// EdgeUser holds the string denoting the user edge name in mutations.
@@ -37,6 +52,12 @@ const (
 	EdgeOrderTokens = "order_tokens"
 	// EdgeLinkedAddress holds the string denoting the linked_address edge name in mutations.
 	EdgeLinkedAddress = "linked_address"
+	// EdgeWebhookDeliveries holds the string denoting the webhook_deliveries edge name in mutations.
+	EdgeWebhookDeliveries = "webhook_deliveries"
+	// EdgeFeeSchedules holds the string denoting the fee_schedules edge name in mutations.
+	EdgeFeeSchedules = "fee_schedules"
+	// EdgeBlockchainRoutes holds the string denoting the blockchain_routes edge name in mutations.
+	EdgeBlockchainRoutes = "blockchain_routes"
 	// Table holds the table name of the senderprofile in the database.
 	Table = "sender_profiles"
 	// UserTable is the table that holds the user relation/edge.
@@ -74,16 +95,44 @@ const (
 	LinkedAddressInverseTable = "linked_addresses"
 	// LinkedAddressColumn is the table column denoting the linked_address relation/edge.
 	LinkedAddressColumn = "sender_profile_linked_address"
+	// WebhookDeliveriesTable is the table that holds the webhook_deliveries relation/edge.
+	WebhookDeliveriesTable = "webhook_deliveries"
+	// WebhookDeliveriesInverseTable is the table name for the WebhookDelivery entity.
+	// It exists in this package in order to avoid circular dependency with the "webhookdelivery" package.
+	WebhookDeliveriesInverseTable = "webhook_deliveries"
+	// WebhookDeliveriesColumn is the table column denoting the webhook_deliveries relation/edge.
+	WebhookDeliveriesColumn = "sender_profile_webhook_deliveries"
+	// FeeSchedulesTable is the table that holds the fee_schedules relation/edge.
+	FeeSchedulesTable = "fee_schedules"
+	// FeeSchedulesInverseTable is the table name for the FeeSchedule entity.
+	// It exists in this package in order to avoid circular dependency with the "feeschedule" package.
+	FeeSchedulesInverseTable = "fee_schedules"
+	// FeeSchedulesColumn is the table column denoting the fee_schedules relation/edge.
+	FeeSchedulesColumn = "sender_profile_fee_schedules"
+	// BlockchainRoutesTable is the table that holds the blockchain_routes relation/edge.
+	BlockchainRoutesTable = "blockchain_routes"
+	// BlockchainRoutesInverseTable is the table name for the BlockchainRoute entity.
+	// It exists in this package in order to avoid circular dependency with the "blockchainroute" package.
+	BlockchainRoutesInverseTable = "blockchain_routes"
+	// BlockchainRoutesColumn is the table column denoting the blockchain_routes relation/edge.
+	BlockchainRoutesColumn = "sender_profile_blockchain_routes"
 )
 
 // Columns holds all SQL columns for senderprofile fields.
 var Columns = []string{
 	FieldID,
 	FieldWebhookURL,
+	FieldWebhookSecret,
+	FieldRequestSigningSecret,
 	FieldDomainWhitelist,
 	FieldProviderID,
 	FieldIsPartner,
 	FieldIsActive,
+	FieldOverpaymentMode,
+	FieldOrderTTLMinutes,
+	FieldMaxOrderAmount,
+	FieldDailyVolumeLimit,
+	FieldHourlyOrderLimit,
 	FieldUpdatedAt,
 }
 
@@ -115,6 +164,10 @@ var (
 	DefaultIsPartner bool
 	// DefaultIsActive holds the default value on creation for the "is_active" field.
 	DefaultIsActive bool
+	// OrderTTLMinutesValidator is a validator for the "order_ttl_minutes" field. It is called by the builders before save.
+	OrderTTLMinutesValidator func(int) error
+	// HourlyOrderLimitValidator is a validator for the "hourly_order_limit" field. It is called by the builders before save.
+	HourlyOrderLimitValidator func(int) error
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
@@ -123,6 +176,32 @@ var (
 	DefaultID func() uuid.UUID
 )
 
+// OverpaymentMode defines the type for the "overpayment_mode" enum field.
+type OverpaymentMode string
+
+// OverpaymentModeAdjustAmount is the default value of the OverpaymentMode enum.
+const DefaultOverpaymentMode = OverpaymentModeAdjustAmount
+
+// OverpaymentMode values.
+const (
+	OverpaymentModeAdjustAmount OverpaymentMode = "adjust_amount"
+	OverpaymentModeRefund       OverpaymentMode = "refund"
+)
+
+func (om OverpaymentMode) String() string {
+	return string(om)
+}
+
+// OverpaymentModeValidator is a validator for the "overpayment_mode" field enum values. It is called by the builders before save.
+func OverpaymentModeValidator(om OverpaymentMode) error {
+	switch om {
+	case OverpaymentModeAdjustAmount, OverpaymentModeRefund:
+		return nil
+	default:
+		return fmt.Errorf("senderprofile: invalid enum value for overpayment_mode field: %q", om)
+	}
+}
+
 // OrderOption defines the ordering options for the SenderProfile queries.
 type OrderOption func(*sql.Selector)
 
@@ -136,6 +215,16 @@ func ByWebhookURL(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldWebhookURL, opts...).ToFunc()
 }
 
+// ByWebhookSecret orders the results by the webhook_secret field.
+func ByWebhookSecret(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWebhookSecret, opts...).ToFunc()
+}
+
+// ByRequestSigningSecret orders the results by the request_signing_secret field.
+func ByRequestSigningSecret(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequestSigningSecret, opts...).ToFunc()
+}
+
 // ByProviderID orders the results by the provider_id field.
 func ByProviderID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldProviderID, opts...).ToFunc()
@@ -151,6 +240,31 @@ func ByIsActive(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsActive, opts...).ToFunc()
 }
 
+// ByOverpaymentMode orders the results by the overpayment_mode field.
+func ByOverpaymentMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOverpaymentMode, opts...).ToFunc()
+}
+
+// ByOrderTTLMinutes orders the results by the order_ttl_minutes field.
+func ByOrderTTLMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderTTLMinutes, opts...).ToFunc()
+}
+
+// ByMaxOrderAmount orders the results by the max_order_amount field.
+func ByMaxOrderAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxOrderAmount, opts...).ToFunc()
+}
+
+// ByDailyVolumeLimit orders the results by the daily_volume_limit field.
+func ByDailyVolumeLimit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDailyVolumeLimit, opts...).ToFunc()
+}
+
+// ByHourlyOrderLimit orders the results by the hourly_order_limit field.
+func ByHourlyOrderLimit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHourlyOrderLimit, opts...).ToFunc()
+}
+
 // ByUpdatedAt orders the results by the updated_at field.
 func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
@@ -163,10 +277,17 @@ func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	}
 }
 
-// ByAPIKeyField orders the results by api_key field.
-func ByAPIKeyField(field string, opts ...sql.OrderTermOption) OrderOption {
+// ByAPIKeyCount orders the results by api_key count.
+func ByAPIKeyCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborTerms(s, newAPIKeyStep(), sql.OrderByField(field, opts...))
+		sqlgraph.OrderByNeighborsCount(s, newAPIKeyStep(), opts...)
+	}
+}
+
+// ByAPIKey orders the results by api_key terms.
+func ByAPIKey(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAPIKeyStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
 
@@ -211,6 +332,48 @@ func ByLinkedAddress(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newLinkedAddressStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByWebhookDeliveriesCount orders the results by webhook_deliveries count.
+func ByWebhookDeliveriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWebhookDeliveriesStep(), opts...)
+	}
+}
+
+// ByWebhookDeliveries orders the results by webhook_deliveries terms.
+func ByWebhookDeliveries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWebhookDeliveriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByFeeSchedulesCount orders the results by fee_schedules count.
+func ByFeeSchedulesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newFeeSchedulesStep(), opts...)
+	}
+}
+
+// ByFeeSchedules orders the results by fee_schedules terms.
+func ByFeeSchedules(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFeeSchedulesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBlockchainRoutesCount orders the results by blockchain_routes count.
+func ByBlockchainRoutesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBlockchainRoutesStep(), opts...)
+	}
+}
+
+// ByBlockchainRoutes orders the results by blockchain_routes terms.
+func ByBlockchainRoutes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBlockchainRoutesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -222,7 +385,7 @@ func newAPIKeyStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
 		sqlgraph.To(APIKeyInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.O2O, false, APIKeyTable, APIKeyColumn),
+		sqlgraph.Edge(sqlgraph.O2M, false, APIKeyTable, APIKeyColumn),
 	)
 }
 func newPaymentOrdersStep() *sqlgraph.Step {
@@ -246,3 +409,24 @@ func newLinkedAddressStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, LinkedAddressTable, LinkedAddressColumn),
 	)
 }
+func newWebhookDeliveriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WebhookDeliveriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WebhookDeliveriesTable, WebhookDeliveriesColumn),
+	)
+}
+func newFeeSchedulesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FeeSchedulesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, FeeSchedulesTable, FeeSchedulesColumn),
+	)
+}
+func newBlockchainRoutesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BlockchainRoutesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BlockchainRoutesTable, BlockchainRoutesColumn),
+	)
+}
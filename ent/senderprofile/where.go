@@ -9,6 +9,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/NEDA-LABS/stablenode/ent/predicate"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 // ID filters vertices based on their ID field.
@@ -61,6 +62,16 @@ func WebhookURL(v string) predicate.SenderProfile {
 	return predicate.SenderProfile(sql.FieldEQ(FieldWebhookURL, v))
 }
 
+// WebhookSecret applies equality check predicate on the "webhook_secret" field. It's identical to WebhookSecretEQ.
+func WebhookSecret(v string) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldEQ(FieldWebhookSecret, v))
+}
+
+// RequestSigningSecret applies equality check predicate on the "request_signing_secret" field. It's identical to RequestSigningSecretEQ.
+func RequestSigningSecret(v string) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldEQ(FieldRequestSigningSecret, v))
+}
+
 // ProviderID applies equality check predicate on the "provider_id" field. It's identical to ProviderIDEQ.
 func ProviderID(v string) predicate.SenderProfile {
 	return predicate.SenderProfile(sql.FieldEQ(FieldProviderID, v))
@@ -76,6 +87,26 @@ func IsActive(v bool) predicate.SenderProfile {
 	return predicate.SenderProfile(sql.FieldEQ(FieldIsActive, v))
 }
 
+// OrderTTLMinutes applies equality check predicate on the "order_ttl_minutes" field. It's identical to OrderTTLMinutesEQ.
+func OrderTTLMinutes(v int) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldEQ(FieldOrderTTLMinutes, v))
+}
+
+// MaxOrderAmount applies equality check predicate on the "max_order_amount" field. It's identical to MaxOrderAmountEQ.
+func MaxOrderAmount(v decimal.Decimal) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldEQ(FieldMaxOrderAmount, v))
+}
+
+// DailyVolumeLimit applies equality check predicate on the "daily_volume_limit" field. It's identical to DailyVolumeLimitEQ.
+func DailyVolumeLimit(v decimal.Decimal) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldEQ(FieldDailyVolumeLimit, v))
+}
+
+// HourlyOrderLimit applies equality check predicate on the "hourly_order_limit" field. It's identical to HourlyOrderLimitEQ.
+func HourlyOrderLimit(v int) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldEQ(FieldHourlyOrderLimit, v))
+}
+
 // UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
 func UpdatedAt(v time.Time) predicate.SenderProfile {
 	return predicate.SenderProfile(sql.FieldEQ(FieldUpdatedAt, v))
@@ -156,6 +187,156 @@ func WebhookURLContainsFold(v string) predicate.SenderProfile {
 	return predicate.SenderProfile(sql.FieldContainsFold(FieldWebhookURL, v))
 }
 
+// WebhookSecretEQ applies the EQ predicate on the "webhook_secret" field.
+func WebhookSecretEQ(v string) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldEQ(FieldWebhookSecret, v))
+}
+
+// WebhookSecretNEQ applies the NEQ predicate on the "webhook_secret" field.
+func WebhookSecretNEQ(v string) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldNEQ(FieldWebhookSecret, v))
+}
+
+// WebhookSecretIn applies the In predicate on the "webhook_secret" field.
+func WebhookSecretIn(vs ...string) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldIn(FieldWebhookSecret, vs...))
+}
+
+// WebhookSecretNotIn applies the NotIn predicate on the "webhook_secret" field.
+func WebhookSecretNotIn(vs ...string) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldNotIn(FieldWebhookSecret, vs...))
+}
+
+// WebhookSecretGT applies the GT predicate on the "webhook_secret" field.
+func WebhookSecretGT(v string) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldGT(FieldWebhookSecret, v))
+}
+
+// WebhookSecretGTE applies the GTE predicate on the "webhook_secret" field.
+func WebhookSecretGTE(v string) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldGTE(FieldWebhookSecret, v))
+}
+
+// WebhookSecretLT applies the LT predicate on the "webhook_secret" field.
+func WebhookSecretLT(v string) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldLT(FieldWebhookSecret, v))
+}
+
+// WebhookSecretLTE applies the LTE predicate on the "webhook_secret" field.
+func WebhookSecretLTE(v string) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldLTE(FieldWebhookSecret, v))
+}
+
+// WebhookSecretContains applies the Contains predicate on the "webhook_secret" field.
+func WebhookSecretContains(v string) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldContains(FieldWebhookSecret, v))
+}
+
+// WebhookSecretHasPrefix applies the HasPrefix predicate on the "webhook_secret" field.
+func WebhookSecretHasPrefix(v string) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldHasPrefix(FieldWebhookSecret, v))
+}
+
+// WebhookSecretHasSuffix applies the HasSuffix predicate on the "webhook_secret" field.
+func WebhookSecretHasSuffix(v string) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldHasSuffix(FieldWebhookSecret, v))
+}
+
+// WebhookSecretIsNil applies the IsNil predicate on the "webhook_secret" field.
+func WebhookSecretIsNil() predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldIsNull(FieldWebhookSecret))
+}
+
+// WebhookSecretNotNil applies the NotNil predicate on the "webhook_secret" field.
+func WebhookSecretNotNil() predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldNotNull(FieldWebhookSecret))
+}
+
+// WebhookSecretEqualFold applies the EqualFold predicate on the "webhook_secret" field.
+func WebhookSecretEqualFold(v string) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldEqualFold(FieldWebhookSecret, v))
+}
+
+// WebhookSecretContainsFold applies the ContainsFold predicate on the "webhook_secret" field.
+func WebhookSecretContainsFold(v string) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldContainsFold(FieldWebhookSecret, v))
+}
+
+// RequestSigningSecretEQ applies the EQ predicate on the "request_signing_secret" field.
+func RequestSigningSecretEQ(v string) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldEQ(FieldRequestSigningSecret, v))
+}
+
+// RequestSigningSecretNEQ applies the NEQ predicate on the "request_signing_secret" field.
+func RequestSigningSecretNEQ(v string) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldNEQ(FieldRequestSigningSecret, v))
+}
+
+// RequestSigningSecretIn applies the In predicate on the "request_signing_secret" field.
+func RequestSigningSecretIn(vs ...string) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldIn(FieldRequestSigningSecret, vs...))
+}
+
+// RequestSigningSecretNotIn applies the NotIn predicate on the "request_signing_secret" field.
+func RequestSigningSecretNotIn(vs ...string) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldNotIn(FieldRequestSigningSecret, vs...))
+}
+
+// RequestSigningSecretGT applies the GT predicate on the "request_signing_secret" field.
+func RequestSigningSecretGT(v string) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldGT(FieldRequestSigningSecret, v))
+}
+
+// RequestSigningSecretGTE applies the GTE predicate on the "request_signing_secret" field.
+func RequestSigningSecretGTE(v string) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldGTE(FieldRequestSigningSecret, v))
+}
+
+// RequestSigningSecretLT applies the LT predicate on the "request_signing_secret" field.
+func RequestSigningSecretLT(v string) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldLT(FieldRequestSigningSecret, v))
+}
+
+// RequestSigningSecretLTE applies the LTE predicate on the "request_signing_secret" field.
+func RequestSigningSecretLTE(v string) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldLTE(FieldRequestSigningSecret, v))
+}
+
+// RequestSigningSecretContains applies the Contains predicate on the "request_signing_secret" field.
+func RequestSigningSecretContains(v string) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldContains(FieldRequestSigningSecret, v))
+}
+
+// RequestSigningSecretHasPrefix applies the HasPrefix predicate on the "request_signing_secret" field.
+func RequestSigningSecretHasPrefix(v string) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldHasPrefix(FieldRequestSigningSecret, v))
+}
+
+// RequestSigningSecretHasSuffix applies the HasSuffix predicate on the "request_signing_secret" field.
+func RequestSigningSecretHasSuffix(v string) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldHasSuffix(FieldRequestSigningSecret, v))
+}
+
+// RequestSigningSecretIsNil applies the IsNil predicate on the "request_signing_secret" field.
+func RequestSigningSecretIsNil() predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldIsNull(FieldRequestSigningSecret))
+}
+
+// RequestSigningSecretNotNil applies the NotNil predicate on the "request_signing_secret" field.
+func RequestSigningSecretNotNil() predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldNotNull(FieldRequestSigningSecret))
+}
+
+// RequestSigningSecretEqualFold applies the EqualFold predicate on the "request_signing_secret" field.
+func RequestSigningSecretEqualFold(v string) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldEqualFold(FieldRequestSigningSecret, v))
+}
+
+// RequestSigningSecretContainsFold applies the ContainsFold predicate on the "request_signing_secret" field.
+func RequestSigningSecretContainsFold(v string) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldContainsFold(FieldRequestSigningSecret, v))
+}
+
 // ProviderIDEQ applies the EQ predicate on the "provider_id" field.
 func ProviderIDEQ(v string) predicate.SenderProfile {
 	return predicate.SenderProfile(sql.FieldEQ(FieldProviderID, v))
@@ -251,6 +432,226 @@ func IsActiveNEQ(v bool) predicate.SenderProfile {
 	return predicate.SenderProfile(sql.FieldNEQ(FieldIsActive, v))
 }
 
+// OverpaymentModeEQ applies the EQ predicate on the "overpayment_mode" field.
+func OverpaymentModeEQ(v OverpaymentMode) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldEQ(FieldOverpaymentMode, v))
+}
+
+// OverpaymentModeNEQ applies the NEQ predicate on the "overpayment_mode" field.
+func OverpaymentModeNEQ(v OverpaymentMode) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldNEQ(FieldOverpaymentMode, v))
+}
+
+// OverpaymentModeIn applies the In predicate on the "overpayment_mode" field.
+func OverpaymentModeIn(vs ...OverpaymentMode) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldIn(FieldOverpaymentMode, vs...))
+}
+
+// OverpaymentModeNotIn applies the NotIn predicate on the "overpayment_mode" field.
+func OverpaymentModeNotIn(vs ...OverpaymentMode) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldNotIn(FieldOverpaymentMode, vs...))
+}
+
+// OrderTTLMinutesEQ applies the EQ predicate on the "order_ttl_minutes" field.
+func OrderTTLMinutesEQ(v int) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldEQ(FieldOrderTTLMinutes, v))
+}
+
+// OrderTTLMinutesNEQ applies the NEQ predicate on the "order_ttl_minutes" field.
+func OrderTTLMinutesNEQ(v int) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldNEQ(FieldOrderTTLMinutes, v))
+}
+
+// OrderTTLMinutesIn applies the In predicate on the "order_ttl_minutes" field.
+func OrderTTLMinutesIn(vs ...int) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldIn(FieldOrderTTLMinutes, vs...))
+}
+
+// OrderTTLMinutesNotIn applies the NotIn predicate on the "order_ttl_minutes" field.
+func OrderTTLMinutesNotIn(vs ...int) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldNotIn(FieldOrderTTLMinutes, vs...))
+}
+
+// OrderTTLMinutesGT applies the GT predicate on the "order_ttl_minutes" field.
+func OrderTTLMinutesGT(v int) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldGT(FieldOrderTTLMinutes, v))
+}
+
+// OrderTTLMinutesGTE applies the GTE predicate on the "order_ttl_minutes" field.
+func OrderTTLMinutesGTE(v int) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldGTE(FieldOrderTTLMinutes, v))
+}
+
+// OrderTTLMinutesLT applies the LT predicate on the "order_ttl_minutes" field.
+func OrderTTLMinutesLT(v int) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldLT(FieldOrderTTLMinutes, v))
+}
+
+// OrderTTLMinutesLTE applies the LTE predicate on the "order_ttl_minutes" field.
+func OrderTTLMinutesLTE(v int) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldLTE(FieldOrderTTLMinutes, v))
+}
+
+// OrderTTLMinutesIsNil applies the IsNil predicate on the "order_ttl_minutes" field.
+func OrderTTLMinutesIsNil() predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldIsNull(FieldOrderTTLMinutes))
+}
+
+// OrderTTLMinutesNotNil applies the NotNil predicate on the "order_ttl_minutes" field.
+func OrderTTLMinutesNotNil() predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldNotNull(FieldOrderTTLMinutes))
+}
+
+// MaxOrderAmountEQ applies the EQ predicate on the "max_order_amount" field.
+func MaxOrderAmountEQ(v decimal.Decimal) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldEQ(FieldMaxOrderAmount, v))
+}
+
+// MaxOrderAmountNEQ applies the NEQ predicate on the "max_order_amount" field.
+func MaxOrderAmountNEQ(v decimal.Decimal) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldNEQ(FieldMaxOrderAmount, v))
+}
+
+// MaxOrderAmountIn applies the In predicate on the "max_order_amount" field.
+func MaxOrderAmountIn(vs ...decimal.Decimal) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldIn(FieldMaxOrderAmount, vs...))
+}
+
+// MaxOrderAmountNotIn applies the NotIn predicate on the "max_order_amount" field.
+func MaxOrderAmountNotIn(vs ...decimal.Decimal) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldNotIn(FieldMaxOrderAmount, vs...))
+}
+
+// MaxOrderAmountGT applies the GT predicate on the "max_order_amount" field.
+func MaxOrderAmountGT(v decimal.Decimal) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldGT(FieldMaxOrderAmount, v))
+}
+
+// MaxOrderAmountGTE applies the GTE predicate on the "max_order_amount" field.
+func MaxOrderAmountGTE(v decimal.Decimal) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldGTE(FieldMaxOrderAmount, v))
+}
+
+// MaxOrderAmountLT applies the LT predicate on the "max_order_amount" field.
+func MaxOrderAmountLT(v decimal.Decimal) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldLT(FieldMaxOrderAmount, v))
+}
+
+// MaxOrderAmountLTE applies the LTE predicate on the "max_order_amount" field.
+func MaxOrderAmountLTE(v decimal.Decimal) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldLTE(FieldMaxOrderAmount, v))
+}
+
+// MaxOrderAmountIsNil applies the IsNil predicate on the "max_order_amount" field.
+func MaxOrderAmountIsNil() predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldIsNull(FieldMaxOrderAmount))
+}
+
+// MaxOrderAmountNotNil applies the NotNil predicate on the "max_order_amount" field.
+func MaxOrderAmountNotNil() predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldNotNull(FieldMaxOrderAmount))
+}
+
+// DailyVolumeLimitEQ applies the EQ predicate on the "daily_volume_limit" field.
+func DailyVolumeLimitEQ(v decimal.Decimal) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldEQ(FieldDailyVolumeLimit, v))
+}
+
+// DailyVolumeLimitNEQ applies the NEQ predicate on the "daily_volume_limit" field.
+func DailyVolumeLimitNEQ(v decimal.Decimal) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldNEQ(FieldDailyVolumeLimit, v))
+}
+
+// DailyVolumeLimitIn applies the In predicate on the "daily_volume_limit" field.
+func DailyVolumeLimitIn(vs ...decimal.Decimal) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldIn(FieldDailyVolumeLimit, vs...))
+}
+
+// DailyVolumeLimitNotIn applies the NotIn predicate on the "daily_volume_limit" field.
+func DailyVolumeLimitNotIn(vs ...decimal.Decimal) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldNotIn(FieldDailyVolumeLimit, vs...))
+}
+
+// DailyVolumeLimitGT applies the GT predicate on the "daily_volume_limit" field.
+func DailyVolumeLimitGT(v decimal.Decimal) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldGT(FieldDailyVolumeLimit, v))
+}
+
+// DailyVolumeLimitGTE applies the GTE predicate on the "daily_volume_limit" field.
+func DailyVolumeLimitGTE(v decimal.Decimal) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldGTE(FieldDailyVolumeLimit, v))
+}
+
+// DailyVolumeLimitLT applies the LT predicate on the "daily_volume_limit" field.
+func DailyVolumeLimitLT(v decimal.Decimal) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldLT(FieldDailyVolumeLimit, v))
+}
+
+// DailyVolumeLimitLTE applies the LTE predicate on the "daily_volume_limit" field.
+func DailyVolumeLimitLTE(v decimal.Decimal) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldLTE(FieldDailyVolumeLimit, v))
+}
+
+// DailyVolumeLimitIsNil applies the IsNil predicate on the "daily_volume_limit" field.
+func DailyVolumeLimitIsNil() predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldIsNull(FieldDailyVolumeLimit))
+}
+
+// DailyVolumeLimitNotNil applies the NotNil predicate on the "daily_volume_limit" field.
+func DailyVolumeLimitNotNil() predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldNotNull(FieldDailyVolumeLimit))
+}
+
+// HourlyOrderLimitEQ applies the EQ predicate on the "hourly_order_limit" field.
+func HourlyOrderLimitEQ(v int) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldEQ(FieldHourlyOrderLimit, v))
+}
+
+// HourlyOrderLimitNEQ applies the NEQ predicate on the "hourly_order_limit" field.
+func HourlyOrderLimitNEQ(v int) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldNEQ(FieldHourlyOrderLimit, v))
+}
+
+// HourlyOrderLimitIn applies the In predicate on the "hourly_order_limit" field.
+func HourlyOrderLimitIn(vs ...int) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldIn(FieldHourlyOrderLimit, vs...))
+}
+
+// HourlyOrderLimitNotIn applies the NotIn predicate on the "hourly_order_limit" field.
+func HourlyOrderLimitNotIn(vs ...int) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldNotIn(FieldHourlyOrderLimit, vs...))
+}
+
+// HourlyOrderLimitGT applies the GT predicate on the "hourly_order_limit" field.
+func HourlyOrderLimitGT(v int) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldGT(FieldHourlyOrderLimit, v))
+}
+
+// HourlyOrderLimitGTE applies the GTE predicate on the "hourly_order_limit" field.
+func HourlyOrderLimitGTE(v int) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldGTE(FieldHourlyOrderLimit, v))
+}
+
+// HourlyOrderLimitLT applies the LT predicate on the "hourly_order_limit" field.
+func HourlyOrderLimitLT(v int) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldLT(FieldHourlyOrderLimit, v))
+}
+
+// HourlyOrderLimitLTE applies the LTE predicate on the "hourly_order_limit" field.
+func HourlyOrderLimitLTE(v int) predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldLTE(FieldHourlyOrderLimit, v))
+}
+
+// HourlyOrderLimitIsNil applies the IsNil predicate on the "hourly_order_limit" field.
+func HourlyOrderLimitIsNil() predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldIsNull(FieldHourlyOrderLimit))
+}
+
+// HourlyOrderLimitNotNil applies the NotNil predicate on the "hourly_order_limit" field.
+func HourlyOrderLimitNotNil() predicate.SenderProfile {
+	return predicate.SenderProfile(sql.FieldNotNull(FieldHourlyOrderLimit))
+}
+
 // UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
 func UpdatedAtEQ(v time.Time) predicate.SenderProfile {
 	return predicate.SenderProfile(sql.FieldEQ(FieldUpdatedAt, v))
@@ -319,7 +720,7 @@ func HasAPIKey() predicate.SenderProfile {
 	return predicate.SenderProfile(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, APIKeyTable, APIKeyColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, APIKeyTable, APIKeyColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
@@ -406,6 +807,75 @@ func HasLinkedAddressWith(preds ...predicate.LinkedAddress) predicate.SenderProf
 	})
 }
 
+// HasWebhookDeliveries applies the HasEdge predicate on the "webhook_deliveries" edge.
+func HasWebhookDeliveries() predicate.SenderProfile {
+	return predicate.SenderProfile(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WebhookDeliveriesTable, WebhookDeliveriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWebhookDeliveriesWith applies the HasEdge predicate on the "webhook_deliveries" edge with a given conditions (other predicates).
+func HasWebhookDeliveriesWith(preds ...predicate.WebhookDelivery) predicate.SenderProfile {
+	return predicate.SenderProfile(func(s *sql.Selector) {
+		step := newWebhookDeliveriesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasFeeSchedules applies the HasEdge predicate on the "fee_schedules" edge.
+func HasFeeSchedules() predicate.SenderProfile {
+	return predicate.SenderProfile(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, FeeSchedulesTable, FeeSchedulesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFeeSchedulesWith applies the HasEdge predicate on the "fee_schedules" edge with a given conditions (other predicates).
+func HasFeeSchedulesWith(preds ...predicate.FeeSchedule) predicate.SenderProfile {
+	return predicate.SenderProfile(func(s *sql.Selector) {
+		step := newFeeSchedulesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBlockchainRoutes applies the HasEdge predicate on the "blockchain_routes" edge.
+func HasBlockchainRoutes() predicate.SenderProfile {
+	return predicate.SenderProfile(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BlockchainRoutesTable, BlockchainRoutesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBlockchainRoutesWith applies the HasEdge predicate on the "blockchain_routes" edge with a given conditions (other predicates).
+func HasBlockchainRoutesWith(preds ...predicate.BlockchainRoute) predicate.SenderProfile {
+	return predicate.SenderProfile(func(s *sql.Selector) {
+		step := newBlockchainRoutesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.SenderProfile) predicate.SenderProfile {
 	return predicate.SenderProfile(sql.AndPredicates(predicates...))
@@ -13,12 +13,16 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/NEDA-LABS/stablenode/ent/apikey"
+	"github.com/NEDA-LABS/stablenode/ent/blockchainroute"
+	"github.com/NEDA-LABS/stablenode/ent/feeschedule"
 	"github.com/NEDA-LABS/stablenode/ent/linkedaddress"
 	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
 	"github.com/NEDA-LABS/stablenode/ent/senderordertoken"
 	"github.com/NEDA-LABS/stablenode/ent/senderprofile"
 	"github.com/NEDA-LABS/stablenode/ent/user"
+	"github.com/NEDA-LABS/stablenode/ent/webhookdelivery"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 // SenderProfileCreate is the builder for creating a SenderProfile entity.
@@ -43,6 +47,34 @@ func (spc *SenderProfileCreate) SetNillableWebhookURL(s *string) *SenderProfileC
 	return spc
 }
 
+// SetWebhookSecret sets the "webhook_secret" field.
+func (spc *SenderProfileCreate) SetWebhookSecret(s string) *SenderProfileCreate {
+	spc.mutation.SetWebhookSecret(s)
+	return spc
+}
+
+// SetNillableWebhookSecret sets the "webhook_secret" field if the given value is not nil.
+func (spc *SenderProfileCreate) SetNillableWebhookSecret(s *string) *SenderProfileCreate {
+	if s != nil {
+		spc.SetWebhookSecret(*s)
+	}
+	return spc
+}
+
+// SetRequestSigningSecret sets the "request_signing_secret" field.
+func (spc *SenderProfileCreate) SetRequestSigningSecret(s string) *SenderProfileCreate {
+	spc.mutation.SetRequestSigningSecret(s)
+	return spc
+}
+
+// SetNillableRequestSigningSecret sets the "request_signing_secret" field if the given value is not nil.
+func (spc *SenderProfileCreate) SetNillableRequestSigningSecret(s *string) *SenderProfileCreate {
+	if s != nil {
+		spc.SetRequestSigningSecret(*s)
+	}
+	return spc
+}
+
 // SetDomainWhitelist sets the "domain_whitelist" field.
 func (spc *SenderProfileCreate) SetDomainWhitelist(s []string) *SenderProfileCreate {
 	spc.mutation.SetDomainWhitelist(s)
@@ -91,6 +123,76 @@ func (spc *SenderProfileCreate) SetNillableIsActive(b *bool) *SenderProfileCreat
 	return spc
 }
 
+// SetOverpaymentMode sets the "overpayment_mode" field.
+func (spc *SenderProfileCreate) SetOverpaymentMode(sm senderprofile.OverpaymentMode) *SenderProfileCreate {
+	spc.mutation.SetOverpaymentMode(sm)
+	return spc
+}
+
+// SetNillableOverpaymentMode sets the "overpayment_mode" field if the given value is not nil.
+func (spc *SenderProfileCreate) SetNillableOverpaymentMode(sm *senderprofile.OverpaymentMode) *SenderProfileCreate {
+	if sm != nil {
+		spc.SetOverpaymentMode(*sm)
+	}
+	return spc
+}
+
+// SetOrderTTLMinutes sets the "order_ttl_minutes" field.
+func (spc *SenderProfileCreate) SetOrderTTLMinutes(i int) *SenderProfileCreate {
+	spc.mutation.SetOrderTTLMinutes(i)
+	return spc
+}
+
+// SetNillableOrderTTLMinutes sets the "order_ttl_minutes" field if the given value is not nil.
+func (spc *SenderProfileCreate) SetNillableOrderTTLMinutes(i *int) *SenderProfileCreate {
+	if i != nil {
+		spc.SetOrderTTLMinutes(*i)
+	}
+	return spc
+}
+
+// SetMaxOrderAmount sets the "max_order_amount" field.
+func (spc *SenderProfileCreate) SetMaxOrderAmount(d decimal.Decimal) *SenderProfileCreate {
+	spc.mutation.SetMaxOrderAmount(d)
+	return spc
+}
+
+// SetNillableMaxOrderAmount sets the "max_order_amount" field if the given value is not nil.
+func (spc *SenderProfileCreate) SetNillableMaxOrderAmount(d *decimal.Decimal) *SenderProfileCreate {
+	if d != nil {
+		spc.SetMaxOrderAmount(*d)
+	}
+	return spc
+}
+
+// SetDailyVolumeLimit sets the "daily_volume_limit" field.
+func (spc *SenderProfileCreate) SetDailyVolumeLimit(d decimal.Decimal) *SenderProfileCreate {
+	spc.mutation.SetDailyVolumeLimit(d)
+	return spc
+}
+
+// SetNillableDailyVolumeLimit sets the "daily_volume_limit" field if the given value is not nil.
+func (spc *SenderProfileCreate) SetNillableDailyVolumeLimit(d *decimal.Decimal) *SenderProfileCreate {
+	if d != nil {
+		spc.SetDailyVolumeLimit(*d)
+	}
+	return spc
+}
+
+// SetHourlyOrderLimit sets the "hourly_order_limit" field.
+func (spc *SenderProfileCreate) SetHourlyOrderLimit(i int) *SenderProfileCreate {
+	spc.mutation.SetHourlyOrderLimit(i)
+	return spc
+}
+
+// SetNillableHourlyOrderLimit sets the "hourly_order_limit" field if the given value is not nil.
+func (spc *SenderProfileCreate) SetNillableHourlyOrderLimit(i *int) *SenderProfileCreate {
+	if i != nil {
+		spc.SetHourlyOrderLimit(*i)
+	}
+	return spc
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (spc *SenderProfileCreate) SetUpdatedAt(t time.Time) *SenderProfileCreate {
 	spc.mutation.SetUpdatedAt(t)
@@ -130,23 +232,19 @@ func (spc *SenderProfileCreate) SetUser(u *User) *SenderProfileCreate {
 	return spc.SetUserID(u.ID)
 }
 
-// SetAPIKeyID sets the "api_key" edge to the APIKey entity by ID.
-func (spc *SenderProfileCreate) SetAPIKeyID(id uuid.UUID) *SenderProfileCreate {
-	spc.mutation.SetAPIKeyID(id)
+// AddAPIKeyIDs adds the "api_key" edge to the APIKey entity by IDs.
+func (spc *SenderProfileCreate) AddAPIKeyIDs(ids ...uuid.UUID) *SenderProfileCreate {
+	spc.mutation.AddAPIKeyIDs(ids...)
 	return spc
 }
 
-// SetNillableAPIKeyID sets the "api_key" edge to the APIKey entity by ID if the given value is not nil.
-func (spc *SenderProfileCreate) SetNillableAPIKeyID(id *uuid.UUID) *SenderProfileCreate {
-	if id != nil {
-		spc = spc.SetAPIKeyID(*id)
+// AddAPIKey adds the "api_key" edges to the APIKey entity.
+func (spc *SenderProfileCreate) AddAPIKey(a ...*APIKey) *SenderProfileCreate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
 	}
-	return spc
-}
-
-// SetAPIKey sets the "api_key" edge to the APIKey entity.
-func (spc *SenderProfileCreate) SetAPIKey(a *APIKey) *SenderProfileCreate {
-	return spc.SetAPIKeyID(a.ID)
+	return spc.AddAPIKeyIDs(ids...)
 }
 
 // AddPaymentOrderIDs adds the "payment_orders" edge to the PaymentOrder entity by IDs.
@@ -194,6 +292,51 @@ func (spc *SenderProfileCreate) AddLinkedAddress(l ...*LinkedAddress) *SenderPro
 	return spc.AddLinkedAddresIDs(ids...)
 }
 
+// AddWebhookDeliveryIDs adds the "webhook_deliveries" edge to the WebhookDelivery entity by IDs.
+func (spc *SenderProfileCreate) AddWebhookDeliveryIDs(ids ...uuid.UUID) *SenderProfileCreate {
+	spc.mutation.AddWebhookDeliveryIDs(ids...)
+	return spc
+}
+
+// AddWebhookDeliveries adds the "webhook_deliveries" edges to the WebhookDelivery entity.
+func (spc *SenderProfileCreate) AddWebhookDeliveries(w ...*WebhookDelivery) *SenderProfileCreate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return spc.AddWebhookDeliveryIDs(ids...)
+}
+
+// AddFeeScheduleIDs adds the "fee_schedules" edge to the FeeSchedule entity by IDs.
+func (spc *SenderProfileCreate) AddFeeScheduleIDs(ids ...uuid.UUID) *SenderProfileCreate {
+	spc.mutation.AddFeeScheduleIDs(ids...)
+	return spc
+}
+
+// AddFeeSchedules adds the "fee_schedules" edges to the FeeSchedule entity.
+func (spc *SenderProfileCreate) AddFeeSchedules(f ...*FeeSchedule) *SenderProfileCreate {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return spc.AddFeeScheduleIDs(ids...)
+}
+
+// AddBlockchainRouteIDs adds the "blockchain_routes" edge to the BlockchainRoute entity by IDs.
+func (spc *SenderProfileCreate) AddBlockchainRouteIDs(ids ...uuid.UUID) *SenderProfileCreate {
+	spc.mutation.AddBlockchainRouteIDs(ids...)
+	return spc
+}
+
+// AddBlockchainRoutes adds the "blockchain_routes" edges to the BlockchainRoute entity.
+func (spc *SenderProfileCreate) AddBlockchainRoutes(b ...*BlockchainRoute) *SenderProfileCreate {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return spc.AddBlockchainRouteIDs(ids...)
+}
+
 // Mutation returns the SenderProfileMutation object of the builder.
 func (spc *SenderProfileCreate) Mutation() *SenderProfileMutation {
 	return spc.mutation
@@ -241,6 +384,10 @@ func (spc *SenderProfileCreate) defaults() {
 		v := senderprofile.DefaultIsActive
 		spc.mutation.SetIsActive(v)
 	}
+	if _, ok := spc.mutation.OverpaymentMode(); !ok {
+		v := senderprofile.DefaultOverpaymentMode
+		spc.mutation.SetOverpaymentMode(v)
+	}
 	if _, ok := spc.mutation.UpdatedAt(); !ok {
 		v := senderprofile.DefaultUpdatedAt()
 		spc.mutation.SetUpdatedAt(v)
@@ -262,6 +409,24 @@ func (spc *SenderProfileCreate) check() error {
 	if _, ok := spc.mutation.IsActive(); !ok {
 		return &ValidationError{Name: "is_active", err: errors.New(`ent: missing required field "SenderProfile.is_active"`)}
 	}
+	if _, ok := spc.mutation.OverpaymentMode(); !ok {
+		return &ValidationError{Name: "overpayment_mode", err: errors.New(`ent: missing required field "SenderProfile.overpayment_mode"`)}
+	}
+	if v, ok := spc.mutation.OverpaymentMode(); ok {
+		if err := senderprofile.OverpaymentModeValidator(v); err != nil {
+			return &ValidationError{Name: "overpayment_mode", err: fmt.Errorf(`ent: validator failed for field "SenderProfile.overpayment_mode": %w`, err)}
+		}
+	}
+	if v, ok := spc.mutation.OrderTTLMinutes(); ok {
+		if err := senderprofile.OrderTTLMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "order_ttl_minutes", err: fmt.Errorf(`ent: validator failed for field "SenderProfile.order_ttl_minutes": %w`, err)}
+		}
+	}
+	if v, ok := spc.mutation.HourlyOrderLimit(); ok {
+		if err := senderprofile.HourlyOrderLimitValidator(v); err != nil {
+			return &ValidationError{Name: "hourly_order_limit", err: fmt.Errorf(`ent: validator failed for field "SenderProfile.hourly_order_limit": %w`, err)}
+		}
+	}
 	if _, ok := spc.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SenderProfile.updated_at"`)}
 	}
@@ -308,6 +473,14 @@ func (spc *SenderProfileCreate) createSpec() (*SenderProfile, *sqlgraph.CreateSp
 		_spec.SetField(senderprofile.FieldWebhookURL, field.TypeString, value)
 		_node.WebhookURL = value
 	}
+	if value, ok := spc.mutation.WebhookSecret(); ok {
+		_spec.SetField(senderprofile.FieldWebhookSecret, field.TypeString, value)
+		_node.WebhookSecret = value
+	}
+	if value, ok := spc.mutation.RequestSigningSecret(); ok {
+		_spec.SetField(senderprofile.FieldRequestSigningSecret, field.TypeString, value)
+		_node.RequestSigningSecret = value
+	}
 	if value, ok := spc.mutation.DomainWhitelist(); ok {
 		_spec.SetField(senderprofile.FieldDomainWhitelist, field.TypeJSON, value)
 		_node.DomainWhitelist = value
@@ -324,6 +497,26 @@ func (spc *SenderProfileCreate) createSpec() (*SenderProfile, *sqlgraph.CreateSp
 		_spec.SetField(senderprofile.FieldIsActive, field.TypeBool, value)
 		_node.IsActive = value
 	}
+	if value, ok := spc.mutation.OverpaymentMode(); ok {
+		_spec.SetField(senderprofile.FieldOverpaymentMode, field.TypeEnum, value)
+		_node.OverpaymentMode = value
+	}
+	if value, ok := spc.mutation.OrderTTLMinutes(); ok {
+		_spec.SetField(senderprofile.FieldOrderTTLMinutes, field.TypeInt, value)
+		_node.OrderTTLMinutes = &value
+	}
+	if value, ok := spc.mutation.MaxOrderAmount(); ok {
+		_spec.SetField(senderprofile.FieldMaxOrderAmount, field.TypeFloat64, value)
+		_node.MaxOrderAmount = &value
+	}
+	if value, ok := spc.mutation.DailyVolumeLimit(); ok {
+		_spec.SetField(senderprofile.FieldDailyVolumeLimit, field.TypeFloat64, value)
+		_node.DailyVolumeLimit = &value
+	}
+	if value, ok := spc.mutation.HourlyOrderLimit(); ok {
+		_spec.SetField(senderprofile.FieldHourlyOrderLimit, field.TypeInt, value)
+		_node.HourlyOrderLimit = &value
+	}
 	if value, ok := spc.mutation.UpdatedAt(); ok {
 		_spec.SetField(senderprofile.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
@@ -347,7 +540,7 @@ func (spc *SenderProfileCreate) createSpec() (*SenderProfile, *sqlgraph.CreateSp
 	}
 	if nodes := spc.mutation.APIKeyIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
 			Table:   senderprofile.APIKeyTable,
 			Columns: []string{senderprofile.APIKeyColumn},
@@ -409,6 +602,54 @@ func (spc *SenderProfileCreate) createSpec() (*SenderProfile, *sqlgraph.CreateSp
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := spc.mutation.WebhookDeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.WebhookDeliveriesTable,
+			Columns: []string{senderprofile.WebhookDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := spc.mutation.FeeSchedulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.FeeSchedulesTable,
+			Columns: []string{senderprofile.FeeSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(feeschedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := spc.mutation.BlockchainRoutesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.BlockchainRoutesTable,
+			Columns: []string{senderprofile.BlockchainRoutesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blockchainroute.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -479,6 +720,42 @@ func (u *SenderProfileUpsert) ClearWebhookURL() *SenderProfileUpsert {
 	return u
 }
 
+// SetWebhookSecret sets the "webhook_secret" field.
+func (u *SenderProfileUpsert) SetWebhookSecret(v string) *SenderProfileUpsert {
+	u.Set(senderprofile.FieldWebhookSecret, v)
+	return u
+}
+
+// UpdateWebhookSecret sets the "webhook_secret" field to the value that was provided on create.
+func (u *SenderProfileUpsert) UpdateWebhookSecret() *SenderProfileUpsert {
+	u.SetExcluded(senderprofile.FieldWebhookSecret)
+	return u
+}
+
+// ClearWebhookSecret clears the value of the "webhook_secret" field.
+func (u *SenderProfileUpsert) ClearWebhookSecret() *SenderProfileUpsert {
+	u.SetNull(senderprofile.FieldWebhookSecret)
+	return u
+}
+
+// SetRequestSigningSecret sets the "request_signing_secret" field.
+func (u *SenderProfileUpsert) SetRequestSigningSecret(v string) *SenderProfileUpsert {
+	u.Set(senderprofile.FieldRequestSigningSecret, v)
+	return u
+}
+
+// UpdateRequestSigningSecret sets the "request_signing_secret" field to the value that was provided on create.
+func (u *SenderProfileUpsert) UpdateRequestSigningSecret() *SenderProfileUpsert {
+	u.SetExcluded(senderprofile.FieldRequestSigningSecret)
+	return u
+}
+
+// ClearRequestSigningSecret clears the value of the "request_signing_secret" field.
+func (u *SenderProfileUpsert) ClearRequestSigningSecret() *SenderProfileUpsert {
+	u.SetNull(senderprofile.FieldRequestSigningSecret)
+	return u
+}
+
 // SetDomainWhitelist sets the "domain_whitelist" field.
 func (u *SenderProfileUpsert) SetDomainWhitelist(v []string) *SenderProfileUpsert {
 	u.Set(senderprofile.FieldDomainWhitelist, v)
@@ -533,6 +810,114 @@ func (u *SenderProfileUpsert) UpdateIsActive() *SenderProfileUpsert {
 	return u
 }
 
+// SetOverpaymentMode sets the "overpayment_mode" field.
+func (u *SenderProfileUpsert) SetOverpaymentMode(v senderprofile.OverpaymentMode) *SenderProfileUpsert {
+	u.Set(senderprofile.FieldOverpaymentMode, v)
+	return u
+}
+
+// UpdateOverpaymentMode sets the "overpayment_mode" field to the value that was provided on create.
+func (u *SenderProfileUpsert) UpdateOverpaymentMode() *SenderProfileUpsert {
+	u.SetExcluded(senderprofile.FieldOverpaymentMode)
+	return u
+}
+
+// SetOrderTTLMinutes sets the "order_ttl_minutes" field.
+func (u *SenderProfileUpsert) SetOrderTTLMinutes(v int) *SenderProfileUpsert {
+	u.Set(senderprofile.FieldOrderTTLMinutes, v)
+	return u
+}
+
+// UpdateOrderTTLMinutes sets the "order_ttl_minutes" field to the value that was provided on create.
+func (u *SenderProfileUpsert) UpdateOrderTTLMinutes() *SenderProfileUpsert {
+	u.SetExcluded(senderprofile.FieldOrderTTLMinutes)
+	return u
+}
+
+// AddOrderTTLMinutes adds v to the "order_ttl_minutes" field.
+func (u *SenderProfileUpsert) AddOrderTTLMinutes(v int) *SenderProfileUpsert {
+	u.Add(senderprofile.FieldOrderTTLMinutes, v)
+	return u
+}
+
+// ClearOrderTTLMinutes clears the value of the "order_ttl_minutes" field.
+func (u *SenderProfileUpsert) ClearOrderTTLMinutes() *SenderProfileUpsert {
+	u.SetNull(senderprofile.FieldOrderTTLMinutes)
+	return u
+}
+
+// SetMaxOrderAmount sets the "max_order_amount" field.
+func (u *SenderProfileUpsert) SetMaxOrderAmount(v decimal.Decimal) *SenderProfileUpsert {
+	u.Set(senderprofile.FieldMaxOrderAmount, v)
+	return u
+}
+
+// UpdateMaxOrderAmount sets the "max_order_amount" field to the value that was provided on create.
+func (u *SenderProfileUpsert) UpdateMaxOrderAmount() *SenderProfileUpsert {
+	u.SetExcluded(senderprofile.FieldMaxOrderAmount)
+	return u
+}
+
+// AddMaxOrderAmount adds v to the "max_order_amount" field.
+func (u *SenderProfileUpsert) AddMaxOrderAmount(v decimal.Decimal) *SenderProfileUpsert {
+	u.Add(senderprofile.FieldMaxOrderAmount, v)
+	return u
+}
+
+// ClearMaxOrderAmount clears the value of the "max_order_amount" field.
+func (u *SenderProfileUpsert) ClearMaxOrderAmount() *SenderProfileUpsert {
+	u.SetNull(senderprofile.FieldMaxOrderAmount)
+	return u
+}
+
+// SetDailyVolumeLimit sets the "daily_volume_limit" field.
+func (u *SenderProfileUpsert) SetDailyVolumeLimit(v decimal.Decimal) *SenderProfileUpsert {
+	u.Set(senderprofile.FieldDailyVolumeLimit, v)
+	return u
+}
+
+// UpdateDailyVolumeLimit sets the "daily_volume_limit" field to the value that was provided on create.
+func (u *SenderProfileUpsert) UpdateDailyVolumeLimit() *SenderProfileUpsert {
+	u.SetExcluded(senderprofile.FieldDailyVolumeLimit)
+	return u
+}
+
+// AddDailyVolumeLimit adds v to the "daily_volume_limit" field.
+func (u *SenderProfileUpsert) AddDailyVolumeLimit(v decimal.Decimal) *SenderProfileUpsert {
+	u.Add(senderprofile.FieldDailyVolumeLimit, v)
+	return u
+}
+
+// ClearDailyVolumeLimit clears the value of the "daily_volume_limit" field.
+func (u *SenderProfileUpsert) ClearDailyVolumeLimit() *SenderProfileUpsert {
+	u.SetNull(senderprofile.FieldDailyVolumeLimit)
+	return u
+}
+
+// SetHourlyOrderLimit sets the "hourly_order_limit" field.
+func (u *SenderProfileUpsert) SetHourlyOrderLimit(v int) *SenderProfileUpsert {
+	u.Set(senderprofile.FieldHourlyOrderLimit, v)
+	return u
+}
+
+// UpdateHourlyOrderLimit sets the "hourly_order_limit" field to the value that was provided on create.
+func (u *SenderProfileUpsert) UpdateHourlyOrderLimit() *SenderProfileUpsert {
+	u.SetExcluded(senderprofile.FieldHourlyOrderLimit)
+	return u
+}
+
+// AddHourlyOrderLimit adds v to the "hourly_order_limit" field.
+func (u *SenderProfileUpsert) AddHourlyOrderLimit(v int) *SenderProfileUpsert {
+	u.Add(senderprofile.FieldHourlyOrderLimit, v)
+	return u
+}
+
+// ClearHourlyOrderLimit clears the value of the "hourly_order_limit" field.
+func (u *SenderProfileUpsert) ClearHourlyOrderLimit() *SenderProfileUpsert {
+	u.SetNull(senderprofile.FieldHourlyOrderLimit)
+	return u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *SenderProfileUpsert) SetUpdatedAt(v time.Time) *SenderProfileUpsert {
 	u.Set(senderprofile.FieldUpdatedAt, v)
@@ -614,6 +999,48 @@ func (u *SenderProfileUpsertOne) ClearWebhookURL() *SenderProfileUpsertOne {
 	})
 }
 
+// SetWebhookSecret sets the "webhook_secret" field.
+func (u *SenderProfileUpsertOne) SetWebhookSecret(v string) *SenderProfileUpsertOne {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.SetWebhookSecret(v)
+	})
+}
+
+// UpdateWebhookSecret sets the "webhook_secret" field to the value that was provided on create.
+func (u *SenderProfileUpsertOne) UpdateWebhookSecret() *SenderProfileUpsertOne {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.UpdateWebhookSecret()
+	})
+}
+
+// ClearWebhookSecret clears the value of the "webhook_secret" field.
+func (u *SenderProfileUpsertOne) ClearWebhookSecret() *SenderProfileUpsertOne {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.ClearWebhookSecret()
+	})
+}
+
+// SetRequestSigningSecret sets the "request_signing_secret" field.
+func (u *SenderProfileUpsertOne) SetRequestSigningSecret(v string) *SenderProfileUpsertOne {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.SetRequestSigningSecret(v)
+	})
+}
+
+// UpdateRequestSigningSecret sets the "request_signing_secret" field to the value that was provided on create.
+func (u *SenderProfileUpsertOne) UpdateRequestSigningSecret() *SenderProfileUpsertOne {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.UpdateRequestSigningSecret()
+	})
+}
+
+// ClearRequestSigningSecret clears the value of the "request_signing_secret" field.
+func (u *SenderProfileUpsertOne) ClearRequestSigningSecret() *SenderProfileUpsertOne {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.ClearRequestSigningSecret()
+	})
+}
+
 // SetDomainWhitelist sets the "domain_whitelist" field.
 func (u *SenderProfileUpsertOne) SetDomainWhitelist(v []string) *SenderProfileUpsertOne {
 	return u.Update(func(s *SenderProfileUpsert) {
@@ -677,6 +1104,132 @@ func (u *SenderProfileUpsertOne) UpdateIsActive() *SenderProfileUpsertOne {
 	})
 }
 
+// SetOverpaymentMode sets the "overpayment_mode" field.
+func (u *SenderProfileUpsertOne) SetOverpaymentMode(v senderprofile.OverpaymentMode) *SenderProfileUpsertOne {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.SetOverpaymentMode(v)
+	})
+}
+
+// UpdateOverpaymentMode sets the "overpayment_mode" field to the value that was provided on create.
+func (u *SenderProfileUpsertOne) UpdateOverpaymentMode() *SenderProfileUpsertOne {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.UpdateOverpaymentMode()
+	})
+}
+
+// SetOrderTTLMinutes sets the "order_ttl_minutes" field.
+func (u *SenderProfileUpsertOne) SetOrderTTLMinutes(v int) *SenderProfileUpsertOne {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.SetOrderTTLMinutes(v)
+	})
+}
+
+// AddOrderTTLMinutes adds v to the "order_ttl_minutes" field.
+func (u *SenderProfileUpsertOne) AddOrderTTLMinutes(v int) *SenderProfileUpsertOne {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.AddOrderTTLMinutes(v)
+	})
+}
+
+// UpdateOrderTTLMinutes sets the "order_ttl_minutes" field to the value that was provided on create.
+func (u *SenderProfileUpsertOne) UpdateOrderTTLMinutes() *SenderProfileUpsertOne {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.UpdateOrderTTLMinutes()
+	})
+}
+
+// ClearOrderTTLMinutes clears the value of the "order_ttl_minutes" field.
+func (u *SenderProfileUpsertOne) ClearOrderTTLMinutes() *SenderProfileUpsertOne {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.ClearOrderTTLMinutes()
+	})
+}
+
+// SetMaxOrderAmount sets the "max_order_amount" field.
+func (u *SenderProfileUpsertOne) SetMaxOrderAmount(v decimal.Decimal) *SenderProfileUpsertOne {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.SetMaxOrderAmount(v)
+	})
+}
+
+// AddMaxOrderAmount adds v to the "max_order_amount" field.
+func (u *SenderProfileUpsertOne) AddMaxOrderAmount(v decimal.Decimal) *SenderProfileUpsertOne {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.AddMaxOrderAmount(v)
+	})
+}
+
+// UpdateMaxOrderAmount sets the "max_order_amount" field to the value that was provided on create.
+func (u *SenderProfileUpsertOne) UpdateMaxOrderAmount() *SenderProfileUpsertOne {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.UpdateMaxOrderAmount()
+	})
+}
+
+// ClearMaxOrderAmount clears the value of the "max_order_amount" field.
+func (u *SenderProfileUpsertOne) ClearMaxOrderAmount() *SenderProfileUpsertOne {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.ClearMaxOrderAmount()
+	})
+}
+
+// SetDailyVolumeLimit sets the "daily_volume_limit" field.
+func (u *SenderProfileUpsertOne) SetDailyVolumeLimit(v decimal.Decimal) *SenderProfileUpsertOne {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.SetDailyVolumeLimit(v)
+	})
+}
+
+// AddDailyVolumeLimit adds v to the "daily_volume_limit" field.
+func (u *SenderProfileUpsertOne) AddDailyVolumeLimit(v decimal.Decimal) *SenderProfileUpsertOne {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.AddDailyVolumeLimit(v)
+	})
+}
+
+// UpdateDailyVolumeLimit sets the "daily_volume_limit" field to the value that was provided on create.
+func (u *SenderProfileUpsertOne) UpdateDailyVolumeLimit() *SenderProfileUpsertOne {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.UpdateDailyVolumeLimit()
+	})
+}
+
+// ClearDailyVolumeLimit clears the value of the "daily_volume_limit" field.
+func (u *SenderProfileUpsertOne) ClearDailyVolumeLimit() *SenderProfileUpsertOne {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.ClearDailyVolumeLimit()
+	})
+}
+
+// SetHourlyOrderLimit sets the "hourly_order_limit" field.
+func (u *SenderProfileUpsertOne) SetHourlyOrderLimit(v int) *SenderProfileUpsertOne {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.SetHourlyOrderLimit(v)
+	})
+}
+
+// AddHourlyOrderLimit adds v to the "hourly_order_limit" field.
+func (u *SenderProfileUpsertOne) AddHourlyOrderLimit(v int) *SenderProfileUpsertOne {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.AddHourlyOrderLimit(v)
+	})
+}
+
+// UpdateHourlyOrderLimit sets the "hourly_order_limit" field to the value that was provided on create.
+func (u *SenderProfileUpsertOne) UpdateHourlyOrderLimit() *SenderProfileUpsertOne {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.UpdateHourlyOrderLimit()
+	})
+}
+
+// ClearHourlyOrderLimit clears the value of the "hourly_order_limit" field.
+func (u *SenderProfileUpsertOne) ClearHourlyOrderLimit() *SenderProfileUpsertOne {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.ClearHourlyOrderLimit()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *SenderProfileUpsertOne) SetUpdatedAt(v time.Time) *SenderProfileUpsertOne {
 	return u.Update(func(s *SenderProfileUpsert) {
@@ -927,6 +1480,48 @@ func (u *SenderProfileUpsertBulk) ClearWebhookURL() *SenderProfileUpsertBulk {
 	})
 }
 
+// SetWebhookSecret sets the "webhook_secret" field.
+func (u *SenderProfileUpsertBulk) SetWebhookSecret(v string) *SenderProfileUpsertBulk {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.SetWebhookSecret(v)
+	})
+}
+
+// UpdateWebhookSecret sets the "webhook_secret" field to the value that was provided on create.
+func (u *SenderProfileUpsertBulk) UpdateWebhookSecret() *SenderProfileUpsertBulk {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.UpdateWebhookSecret()
+	})
+}
+
+// ClearWebhookSecret clears the value of the "webhook_secret" field.
+func (u *SenderProfileUpsertBulk) ClearWebhookSecret() *SenderProfileUpsertBulk {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.ClearWebhookSecret()
+	})
+}
+
+// SetRequestSigningSecret sets the "request_signing_secret" field.
+func (u *SenderProfileUpsertBulk) SetRequestSigningSecret(v string) *SenderProfileUpsertBulk {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.SetRequestSigningSecret(v)
+	})
+}
+
+// UpdateRequestSigningSecret sets the "request_signing_secret" field to the value that was provided on create.
+func (u *SenderProfileUpsertBulk) UpdateRequestSigningSecret() *SenderProfileUpsertBulk {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.UpdateRequestSigningSecret()
+	})
+}
+
+// ClearRequestSigningSecret clears the value of the "request_signing_secret" field.
+func (u *SenderProfileUpsertBulk) ClearRequestSigningSecret() *SenderProfileUpsertBulk {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.ClearRequestSigningSecret()
+	})
+}
+
 // SetDomainWhitelist sets the "domain_whitelist" field.
 func (u *SenderProfileUpsertBulk) SetDomainWhitelist(v []string) *SenderProfileUpsertBulk {
 	return u.Update(func(s *SenderProfileUpsert) {
@@ -990,6 +1585,132 @@ func (u *SenderProfileUpsertBulk) UpdateIsActive() *SenderProfileUpsertBulk {
 	})
 }
 
+// SetOverpaymentMode sets the "overpayment_mode" field.
+func (u *SenderProfileUpsertBulk) SetOverpaymentMode(v senderprofile.OverpaymentMode) *SenderProfileUpsertBulk {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.SetOverpaymentMode(v)
+	})
+}
+
+// UpdateOverpaymentMode sets the "overpayment_mode" field to the value that was provided on create.
+func (u *SenderProfileUpsertBulk) UpdateOverpaymentMode() *SenderProfileUpsertBulk {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.UpdateOverpaymentMode()
+	})
+}
+
+// SetOrderTTLMinutes sets the "order_ttl_minutes" field.
+func (u *SenderProfileUpsertBulk) SetOrderTTLMinutes(v int) *SenderProfileUpsertBulk {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.SetOrderTTLMinutes(v)
+	})
+}
+
+// AddOrderTTLMinutes adds v to the "order_ttl_minutes" field.
+func (u *SenderProfileUpsertBulk) AddOrderTTLMinutes(v int) *SenderProfileUpsertBulk {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.AddOrderTTLMinutes(v)
+	})
+}
+
+// UpdateOrderTTLMinutes sets the "order_ttl_minutes" field to the value that was provided on create.
+func (u *SenderProfileUpsertBulk) UpdateOrderTTLMinutes() *SenderProfileUpsertBulk {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.UpdateOrderTTLMinutes()
+	})
+}
+
+// ClearOrderTTLMinutes clears the value of the "order_ttl_minutes" field.
+func (u *SenderProfileUpsertBulk) ClearOrderTTLMinutes() *SenderProfileUpsertBulk {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.ClearOrderTTLMinutes()
+	})
+}
+
+// SetMaxOrderAmount sets the "max_order_amount" field.
+func (u *SenderProfileUpsertBulk) SetMaxOrderAmount(v decimal.Decimal) *SenderProfileUpsertBulk {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.SetMaxOrderAmount(v)
+	})
+}
+
+// AddMaxOrderAmount adds v to the "max_order_amount" field.
+func (u *SenderProfileUpsertBulk) AddMaxOrderAmount(v decimal.Decimal) *SenderProfileUpsertBulk {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.AddMaxOrderAmount(v)
+	})
+}
+
+// UpdateMaxOrderAmount sets the "max_order_amount" field to the value that was provided on create.
+func (u *SenderProfileUpsertBulk) UpdateMaxOrderAmount() *SenderProfileUpsertBulk {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.UpdateMaxOrderAmount()
+	})
+}
+
+// ClearMaxOrderAmount clears the value of the "max_order_amount" field.
+func (u *SenderProfileUpsertBulk) ClearMaxOrderAmount() *SenderProfileUpsertBulk {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.ClearMaxOrderAmount()
+	})
+}
+
+// SetDailyVolumeLimit sets the "daily_volume_limit" field.
+func (u *SenderProfileUpsertBulk) SetDailyVolumeLimit(v decimal.Decimal) *SenderProfileUpsertBulk {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.SetDailyVolumeLimit(v)
+	})
+}
+
+// AddDailyVolumeLimit adds v to the "daily_volume_limit" field.
+func (u *SenderProfileUpsertBulk) AddDailyVolumeLimit(v decimal.Decimal) *SenderProfileUpsertBulk {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.AddDailyVolumeLimit(v)
+	})
+}
+
+// UpdateDailyVolumeLimit sets the "daily_volume_limit" field to the value that was provided on create.
+func (u *SenderProfileUpsertBulk) UpdateDailyVolumeLimit() *SenderProfileUpsertBulk {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.UpdateDailyVolumeLimit()
+	})
+}
+
+// ClearDailyVolumeLimit clears the value of the "daily_volume_limit" field.
+func (u *SenderProfileUpsertBulk) ClearDailyVolumeLimit() *SenderProfileUpsertBulk {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.ClearDailyVolumeLimit()
+	})
+}
+
+// SetHourlyOrderLimit sets the "hourly_order_limit" field.
+func (u *SenderProfileUpsertBulk) SetHourlyOrderLimit(v int) *SenderProfileUpsertBulk {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.SetHourlyOrderLimit(v)
+	})
+}
+
+// AddHourlyOrderLimit adds v to the "hourly_order_limit" field.
+func (u *SenderProfileUpsertBulk) AddHourlyOrderLimit(v int) *SenderProfileUpsertBulk {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.AddHourlyOrderLimit(v)
+	})
+}
+
+// UpdateHourlyOrderLimit sets the "hourly_order_limit" field to the value that was provided on create.
+func (u *SenderProfileUpsertBulk) UpdateHourlyOrderLimit() *SenderProfileUpsertBulk {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.UpdateHourlyOrderLimit()
+	})
+}
+
+// ClearHourlyOrderLimit clears the value of the "hourly_order_limit" field.
+func (u *SenderProfileUpsertBulk) ClearHourlyOrderLimit() *SenderProfileUpsertBulk {
+	return u.Update(func(s *SenderProfileUpsert) {
+		s.ClearHourlyOrderLimit()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *SenderProfileUpsertBulk) SetUpdatedAt(v time.Time) *SenderProfileUpsertBulk {
 	return u.Update(func(s *SenderProfileUpsert) {
@@ -13,28 +13,34 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/NEDA-LABS/stablenode/ent/apikey"
+	"github.com/NEDA-LABS/stablenode/ent/blockchainroute"
+	"github.com/NEDA-LABS/stablenode/ent/feeschedule"
 	"github.com/NEDA-LABS/stablenode/ent/linkedaddress"
 	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
 	"github.com/NEDA-LABS/stablenode/ent/predicate"
 	"github.com/NEDA-LABS/stablenode/ent/senderordertoken"
 	"github.com/NEDA-LABS/stablenode/ent/senderprofile"
 	"github.com/NEDA-LABS/stablenode/ent/user"
+	"github.com/NEDA-LABS/stablenode/ent/webhookdelivery"
 	"github.com/google/uuid"
 )
 
 // SenderProfileQuery is the builder for querying SenderProfile entities.
 type SenderProfileQuery struct {
 	config
-	ctx               *QueryContext
-	order             []senderprofile.OrderOption
-	inters            []Interceptor
-	predicates        []predicate.SenderProfile
-	withUser          *UserQuery
-	withAPIKey        *APIKeyQuery
-	withPaymentOrders *PaymentOrderQuery
-	withOrderTokens   *SenderOrderTokenQuery
-	withLinkedAddress *LinkedAddressQuery
-	withFKs           bool
+	ctx                   *QueryContext
+	order                 []senderprofile.OrderOption
+	inters                []Interceptor
+	predicates            []predicate.SenderProfile
+	withUser              *UserQuery
+	withAPIKey            *APIKeyQuery
+	withPaymentOrders     *PaymentOrderQuery
+	withOrderTokens       *SenderOrderTokenQuery
+	withLinkedAddress     *LinkedAddressQuery
+	withWebhookDeliveries *WebhookDeliveryQuery
+	withFeeSchedules      *FeeScheduleQuery
+	withBlockchainRoutes  *BlockchainRouteQuery
+	withFKs               bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -107,7 +113,7 @@ func (spq *SenderProfileQuery) QueryAPIKey() *APIKeyQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(senderprofile.Table, senderprofile.FieldID, selector),
 			sqlgraph.To(apikey.Table, apikey.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, senderprofile.APIKeyTable, senderprofile.APIKeyColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, senderprofile.APIKeyTable, senderprofile.APIKeyColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(spq.driver.Dialect(), step)
 		return fromU, nil
@@ -181,6 +187,72 @@ func (spq *SenderProfileQuery) QueryLinkedAddress() *LinkedAddressQuery {
 	return query
 }
 
+// QueryWebhookDeliveries chains the current query on the "webhook_deliveries" edge.
+func (spq *SenderProfileQuery) QueryWebhookDeliveries() *WebhookDeliveryQuery {
+	query := (&WebhookDeliveryClient{config: spq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := spq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := spq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(senderprofile.Table, senderprofile.FieldID, selector),
+			sqlgraph.To(webhookdelivery.Table, webhookdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, senderprofile.WebhookDeliveriesTable, senderprofile.WebhookDeliveriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(spq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryFeeSchedules chains the current query on the "fee_schedules" edge.
+func (spq *SenderProfileQuery) QueryFeeSchedules() *FeeScheduleQuery {
+	query := (&FeeScheduleClient{config: spq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := spq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := spq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(senderprofile.Table, senderprofile.FieldID, selector),
+			sqlgraph.To(feeschedule.Table, feeschedule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, senderprofile.FeeSchedulesTable, senderprofile.FeeSchedulesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(spq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBlockchainRoutes chains the current query on the "blockchain_routes" edge.
+func (spq *SenderProfileQuery) QueryBlockchainRoutes() *BlockchainRouteQuery {
+	query := (&BlockchainRouteClient{config: spq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := spq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := spq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(senderprofile.Table, senderprofile.FieldID, selector),
+			sqlgraph.To(blockchainroute.Table, blockchainroute.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, senderprofile.BlockchainRoutesTable, senderprofile.BlockchainRoutesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(spq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first SenderProfile entity from the query.
 // Returns a *NotFoundError when no SenderProfile was found.
 func (spq *SenderProfileQuery) First(ctx context.Context) (*SenderProfile, error) {
@@ -368,16 +440,19 @@ func (spq *SenderProfileQuery) Clone() *SenderProfileQuery {
 		return nil
 	}
 	return &SenderProfileQuery{
-		config:            spq.config,
-		ctx:               spq.ctx.Clone(),
-		order:             append([]senderprofile.OrderOption{}, spq.order...),
-		inters:            append([]Interceptor{}, spq.inters...),
-		predicates:        append([]predicate.SenderProfile{}, spq.predicates...),
-		withUser:          spq.withUser.Clone(),
-		withAPIKey:        spq.withAPIKey.Clone(),
-		withPaymentOrders: spq.withPaymentOrders.Clone(),
-		withOrderTokens:   spq.withOrderTokens.Clone(),
-		withLinkedAddress: spq.withLinkedAddress.Clone(),
+		config:                spq.config,
+		ctx:                   spq.ctx.Clone(),
+		order:                 append([]senderprofile.OrderOption{}, spq.order...),
+		inters:                append([]Interceptor{}, spq.inters...),
+		predicates:            append([]predicate.SenderProfile{}, spq.predicates...),
+		withUser:              spq.withUser.Clone(),
+		withAPIKey:            spq.withAPIKey.Clone(),
+		withPaymentOrders:     spq.withPaymentOrders.Clone(),
+		withOrderTokens:       spq.withOrderTokens.Clone(),
+		withLinkedAddress:     spq.withLinkedAddress.Clone(),
+		withWebhookDeliveries: spq.withWebhookDeliveries.Clone(),
+		withFeeSchedules:      spq.withFeeSchedules.Clone(),
+		withBlockchainRoutes:  spq.withBlockchainRoutes.Clone(),
 		// clone intermediate query.
 		sql:  spq.sql.Clone(),
 		path: spq.path,
@@ -439,6 +514,39 @@ func (spq *SenderProfileQuery) WithLinkedAddress(opts ...func(*LinkedAddressQuer
 	return spq
 }
 
+// WithWebhookDeliveries tells the query-builder to eager-load the nodes that are connected to
+// the "webhook_deliveries" edge. The optional arguments are used to configure the query builder of the edge.
+func (spq *SenderProfileQuery) WithWebhookDeliveries(opts ...func(*WebhookDeliveryQuery)) *SenderProfileQuery {
+	query := (&WebhookDeliveryClient{config: spq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	spq.withWebhookDeliveries = query
+	return spq
+}
+
+// WithFeeSchedules tells the query-builder to eager-load the nodes that are connected to
+// the "fee_schedules" edge. The optional arguments are used to configure the query builder of the edge.
+func (spq *SenderProfileQuery) WithFeeSchedules(opts ...func(*FeeScheduleQuery)) *SenderProfileQuery {
+	query := (&FeeScheduleClient{config: spq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	spq.withFeeSchedules = query
+	return spq
+}
+
+// WithBlockchainRoutes tells the query-builder to eager-load the nodes that are connected to
+// the "blockchain_routes" edge. The optional arguments are used to configure the query builder of the edge.
+func (spq *SenderProfileQuery) WithBlockchainRoutes(opts ...func(*BlockchainRouteQuery)) *SenderProfileQuery {
+	query := (&BlockchainRouteClient{config: spq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	spq.withBlockchainRoutes = query
+	return spq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -518,12 +626,15 @@ func (spq *SenderProfileQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 		nodes       = []*SenderProfile{}
 		withFKs     = spq.withFKs
 		_spec       = spq.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [8]bool{
 			spq.withUser != nil,
 			spq.withAPIKey != nil,
 			spq.withPaymentOrders != nil,
 			spq.withOrderTokens != nil,
 			spq.withLinkedAddress != nil,
+			spq.withWebhookDeliveries != nil,
+			spq.withFeeSchedules != nil,
+			spq.withBlockchainRoutes != nil,
 		}
 	)
 	if spq.withUser != nil {
@@ -557,8 +668,9 @@ func (spq *SenderProfileQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 		}
 	}
 	if query := spq.withAPIKey; query != nil {
-		if err := spq.loadAPIKey(ctx, query, nodes, nil,
-			func(n *SenderProfile, e *APIKey) { n.Edges.APIKey = e }); err != nil {
+		if err := spq.loadAPIKey(ctx, query, nodes,
+			func(n *SenderProfile) { n.Edges.APIKey = []*APIKey{} },
+			func(n *SenderProfile, e *APIKey) { n.Edges.APIKey = append(n.Edges.APIKey, e) }); err != nil {
 			return nil, err
 		}
 	}
@@ -583,6 +695,31 @@ func (spq *SenderProfileQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 			return nil, err
 		}
 	}
+	if query := spq.withWebhookDeliveries; query != nil {
+		if err := spq.loadWebhookDeliveries(ctx, query, nodes,
+			func(n *SenderProfile) { n.Edges.WebhookDeliveries = []*WebhookDelivery{} },
+			func(n *SenderProfile, e *WebhookDelivery) {
+				n.Edges.WebhookDeliveries = append(n.Edges.WebhookDeliveries, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := spq.withFeeSchedules; query != nil {
+		if err := spq.loadFeeSchedules(ctx, query, nodes,
+			func(n *SenderProfile) { n.Edges.FeeSchedules = []*FeeSchedule{} },
+			func(n *SenderProfile, e *FeeSchedule) { n.Edges.FeeSchedules = append(n.Edges.FeeSchedules, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := spq.withBlockchainRoutes; query != nil {
+		if err := spq.loadBlockchainRoutes(ctx, query, nodes,
+			func(n *SenderProfile) { n.Edges.BlockchainRoutes = []*BlockchainRoute{} },
+			func(n *SenderProfile, e *BlockchainRoute) {
+				n.Edges.BlockchainRoutes = append(n.Edges.BlockchainRoutes, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -624,6 +761,9 @@ func (spq *SenderProfileQuery) loadAPIKey(ctx context.Context, query *APIKeyQuer
 	for i := range nodes {
 		fks = append(fks, nodes[i].ID)
 		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
 	}
 	query.withFKs = true
 	query.Where(predicate.APIKey(func(s *sql.Selector) {
@@ -739,6 +879,99 @@ func (spq *SenderProfileQuery) loadLinkedAddress(ctx context.Context, query *Lin
 	}
 	return nil
 }
+func (spq *SenderProfileQuery) loadWebhookDeliveries(ctx context.Context, query *WebhookDeliveryQuery, nodes []*SenderProfile, init func(*SenderProfile), assign func(*SenderProfile, *WebhookDelivery)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*SenderProfile)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.WebhookDelivery(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(senderprofile.WebhookDeliveriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.sender_profile_webhook_deliveries
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "sender_profile_webhook_deliveries" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "sender_profile_webhook_deliveries" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (spq *SenderProfileQuery) loadFeeSchedules(ctx context.Context, query *FeeScheduleQuery, nodes []*SenderProfile, init func(*SenderProfile), assign func(*SenderProfile, *FeeSchedule)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*SenderProfile)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.FeeSchedule(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(senderprofile.FeeSchedulesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.sender_profile_fee_schedules
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "sender_profile_fee_schedules" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "sender_profile_fee_schedules" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (spq *SenderProfileQuery) loadBlockchainRoutes(ctx context.Context, query *BlockchainRouteQuery, nodes []*SenderProfile, init func(*SenderProfile), assign func(*SenderProfile, *BlockchainRoute)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*SenderProfile)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.BlockchainRoute(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(senderprofile.BlockchainRoutesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.sender_profile_blockchain_routes
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "sender_profile_blockchain_routes" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "sender_profile_blockchain_routes" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (spq *SenderProfileQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := spq.querySpec()
@@ -13,12 +13,16 @@ import (
 	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/NEDA-LABS/stablenode/ent/apikey"
+	"github.com/NEDA-LABS/stablenode/ent/blockchainroute"
+	"github.com/NEDA-LABS/stablenode/ent/feeschedule"
 	"github.com/NEDA-LABS/stablenode/ent/linkedaddress"
 	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
 	"github.com/NEDA-LABS/stablenode/ent/predicate"
 	"github.com/NEDA-LABS/stablenode/ent/senderordertoken"
 	"github.com/NEDA-LABS/stablenode/ent/senderprofile"
+	"github.com/NEDA-LABS/stablenode/ent/webhookdelivery"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 // SenderProfileUpdate is the builder for updating SenderProfile entities.
@@ -54,6 +58,46 @@ func (spu *SenderProfileUpdate) ClearWebhookURL() *SenderProfileUpdate {
 	return spu
 }
 
+// SetWebhookSecret sets the "webhook_secret" field.
+func (spu *SenderProfileUpdate) SetWebhookSecret(s string) *SenderProfileUpdate {
+	spu.mutation.SetWebhookSecret(s)
+	return spu
+}
+
+// SetNillableWebhookSecret sets the "webhook_secret" field if the given value is not nil.
+func (spu *SenderProfileUpdate) SetNillableWebhookSecret(s *string) *SenderProfileUpdate {
+	if s != nil {
+		spu.SetWebhookSecret(*s)
+	}
+	return spu
+}
+
+// ClearWebhookSecret clears the value of the "webhook_secret" field.
+func (spu *SenderProfileUpdate) ClearWebhookSecret() *SenderProfileUpdate {
+	spu.mutation.ClearWebhookSecret()
+	return spu
+}
+
+// SetRequestSigningSecret sets the "request_signing_secret" field.
+func (spu *SenderProfileUpdate) SetRequestSigningSecret(s string) *SenderProfileUpdate {
+	spu.mutation.SetRequestSigningSecret(s)
+	return spu
+}
+
+// SetNillableRequestSigningSecret sets the "request_signing_secret" field if the given value is not nil.
+func (spu *SenderProfileUpdate) SetNillableRequestSigningSecret(s *string) *SenderProfileUpdate {
+	if s != nil {
+		spu.SetRequestSigningSecret(*s)
+	}
+	return spu
+}
+
+// ClearRequestSigningSecret clears the value of the "request_signing_secret" field.
+func (spu *SenderProfileUpdate) ClearRequestSigningSecret() *SenderProfileUpdate {
+	spu.mutation.ClearRequestSigningSecret()
+	return spu
+}
+
 // SetDomainWhitelist sets the "domain_whitelist" field.
 func (spu *SenderProfileUpdate) SetDomainWhitelist(s []string) *SenderProfileUpdate {
 	spu.mutation.SetDomainWhitelist(s)
@@ -114,29 +158,147 @@ func (spu *SenderProfileUpdate) SetNillableIsActive(b *bool) *SenderProfileUpdat
 	return spu
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (spu *SenderProfileUpdate) SetUpdatedAt(t time.Time) *SenderProfileUpdate {
-	spu.mutation.SetUpdatedAt(t)
+// SetOverpaymentMode sets the "overpayment_mode" field.
+func (spu *SenderProfileUpdate) SetOverpaymentMode(sm senderprofile.OverpaymentMode) *SenderProfileUpdate {
+	spu.mutation.SetOverpaymentMode(sm)
+	return spu
+}
+
+// SetNillableOverpaymentMode sets the "overpayment_mode" field if the given value is not nil.
+func (spu *SenderProfileUpdate) SetNillableOverpaymentMode(sm *senderprofile.OverpaymentMode) *SenderProfileUpdate {
+	if sm != nil {
+		spu.SetOverpaymentMode(*sm)
+	}
 	return spu
 }
 
-// SetAPIKeyID sets the "api_key" edge to the APIKey entity by ID.
-func (spu *SenderProfileUpdate) SetAPIKeyID(id uuid.UUID) *SenderProfileUpdate {
-	spu.mutation.SetAPIKeyID(id)
+// SetOrderTTLMinutes sets the "order_ttl_minutes" field.
+func (spu *SenderProfileUpdate) SetOrderTTLMinutes(i int) *SenderProfileUpdate {
+	spu.mutation.ResetOrderTTLMinutes()
+	spu.mutation.SetOrderTTLMinutes(i)
 	return spu
 }
 
-// SetNillableAPIKeyID sets the "api_key" edge to the APIKey entity by ID if the given value is not nil.
-func (spu *SenderProfileUpdate) SetNillableAPIKeyID(id *uuid.UUID) *SenderProfileUpdate {
-	if id != nil {
-		spu = spu.SetAPIKeyID(*id)
+// SetNillableOrderTTLMinutes sets the "order_ttl_minutes" field if the given value is not nil.
+func (spu *SenderProfileUpdate) SetNillableOrderTTLMinutes(i *int) *SenderProfileUpdate {
+	if i != nil {
+		spu.SetOrderTTLMinutes(*i)
 	}
 	return spu
 }
 
-// SetAPIKey sets the "api_key" edge to the APIKey entity.
-func (spu *SenderProfileUpdate) SetAPIKey(a *APIKey) *SenderProfileUpdate {
-	return spu.SetAPIKeyID(a.ID)
+// AddOrderTTLMinutes adds i to the "order_ttl_minutes" field.
+func (spu *SenderProfileUpdate) AddOrderTTLMinutes(i int) *SenderProfileUpdate {
+	spu.mutation.AddOrderTTLMinutes(i)
+	return spu
+}
+
+// ClearOrderTTLMinutes clears the value of the "order_ttl_minutes" field.
+func (spu *SenderProfileUpdate) ClearOrderTTLMinutes() *SenderProfileUpdate {
+	spu.mutation.ClearOrderTTLMinutes()
+	return spu
+}
+
+// SetMaxOrderAmount sets the "max_order_amount" field.
+func (spu *SenderProfileUpdate) SetMaxOrderAmount(d decimal.Decimal) *SenderProfileUpdate {
+	spu.mutation.ResetMaxOrderAmount()
+	spu.mutation.SetMaxOrderAmount(d)
+	return spu
+}
+
+// SetNillableMaxOrderAmount sets the "max_order_amount" field if the given value is not nil.
+func (spu *SenderProfileUpdate) SetNillableMaxOrderAmount(d *decimal.Decimal) *SenderProfileUpdate {
+	if d != nil {
+		spu.SetMaxOrderAmount(*d)
+	}
+	return spu
+}
+
+// AddMaxOrderAmount adds d to the "max_order_amount" field.
+func (spu *SenderProfileUpdate) AddMaxOrderAmount(d decimal.Decimal) *SenderProfileUpdate {
+	spu.mutation.AddMaxOrderAmount(d)
+	return spu
+}
+
+// ClearMaxOrderAmount clears the value of the "max_order_amount" field.
+func (spu *SenderProfileUpdate) ClearMaxOrderAmount() *SenderProfileUpdate {
+	spu.mutation.ClearMaxOrderAmount()
+	return spu
+}
+
+// SetDailyVolumeLimit sets the "daily_volume_limit" field.
+func (spu *SenderProfileUpdate) SetDailyVolumeLimit(d decimal.Decimal) *SenderProfileUpdate {
+	spu.mutation.ResetDailyVolumeLimit()
+	spu.mutation.SetDailyVolumeLimit(d)
+	return spu
+}
+
+// SetNillableDailyVolumeLimit sets the "daily_volume_limit" field if the given value is not nil.
+func (spu *SenderProfileUpdate) SetNillableDailyVolumeLimit(d *decimal.Decimal) *SenderProfileUpdate {
+	if d != nil {
+		spu.SetDailyVolumeLimit(*d)
+	}
+	return spu
+}
+
+// AddDailyVolumeLimit adds d to the "daily_volume_limit" field.
+func (spu *SenderProfileUpdate) AddDailyVolumeLimit(d decimal.Decimal) *SenderProfileUpdate {
+	spu.mutation.AddDailyVolumeLimit(d)
+	return spu
+}
+
+// ClearDailyVolumeLimit clears the value of the "daily_volume_limit" field.
+func (spu *SenderProfileUpdate) ClearDailyVolumeLimit() *SenderProfileUpdate {
+	spu.mutation.ClearDailyVolumeLimit()
+	return spu
+}
+
+// SetHourlyOrderLimit sets the "hourly_order_limit" field.
+func (spu *SenderProfileUpdate) SetHourlyOrderLimit(i int) *SenderProfileUpdate {
+	spu.mutation.ResetHourlyOrderLimit()
+	spu.mutation.SetHourlyOrderLimit(i)
+	return spu
+}
+
+// SetNillableHourlyOrderLimit sets the "hourly_order_limit" field if the given value is not nil.
+func (spu *SenderProfileUpdate) SetNillableHourlyOrderLimit(i *int) *SenderProfileUpdate {
+	if i != nil {
+		spu.SetHourlyOrderLimit(*i)
+	}
+	return spu
+}
+
+// AddHourlyOrderLimit adds i to the "hourly_order_limit" field.
+func (spu *SenderProfileUpdate) AddHourlyOrderLimit(i int) *SenderProfileUpdate {
+	spu.mutation.AddHourlyOrderLimit(i)
+	return spu
+}
+
+// ClearHourlyOrderLimit clears the value of the "hourly_order_limit" field.
+func (spu *SenderProfileUpdate) ClearHourlyOrderLimit() *SenderProfileUpdate {
+	spu.mutation.ClearHourlyOrderLimit()
+	return spu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (spu *SenderProfileUpdate) SetUpdatedAt(t time.Time) *SenderProfileUpdate {
+	spu.mutation.SetUpdatedAt(t)
+	return spu
+}
+
+// AddAPIKeyIDs adds the "api_key" edge to the APIKey entity by IDs.
+func (spu *SenderProfileUpdate) AddAPIKeyIDs(ids ...uuid.UUID) *SenderProfileUpdate {
+	spu.mutation.AddAPIKeyIDs(ids...)
+	return spu
+}
+
+// AddAPIKey adds the "api_key" edges to the APIKey entity.
+func (spu *SenderProfileUpdate) AddAPIKey(a ...*APIKey) *SenderProfileUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return spu.AddAPIKeyIDs(ids...)
 }
 
 // AddPaymentOrderIDs adds the "payment_orders" edge to the PaymentOrder entity by IDs.
@@ -184,17 +346,77 @@ func (spu *SenderProfileUpdate) AddLinkedAddress(l ...*LinkedAddress) *SenderPro
 	return spu.AddLinkedAddresIDs(ids...)
 }
 
+// AddWebhookDeliveryIDs adds the "webhook_deliveries" edge to the WebhookDelivery entity by IDs.
+func (spu *SenderProfileUpdate) AddWebhookDeliveryIDs(ids ...uuid.UUID) *SenderProfileUpdate {
+	spu.mutation.AddWebhookDeliveryIDs(ids...)
+	return spu
+}
+
+// AddWebhookDeliveries adds the "webhook_deliveries" edges to the WebhookDelivery entity.
+func (spu *SenderProfileUpdate) AddWebhookDeliveries(w ...*WebhookDelivery) *SenderProfileUpdate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return spu.AddWebhookDeliveryIDs(ids...)
+}
+
+// AddFeeScheduleIDs adds the "fee_schedules" edge to the FeeSchedule entity by IDs.
+func (spu *SenderProfileUpdate) AddFeeScheduleIDs(ids ...uuid.UUID) *SenderProfileUpdate {
+	spu.mutation.AddFeeScheduleIDs(ids...)
+	return spu
+}
+
+// AddFeeSchedules adds the "fee_schedules" edges to the FeeSchedule entity.
+func (spu *SenderProfileUpdate) AddFeeSchedules(f ...*FeeSchedule) *SenderProfileUpdate {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return spu.AddFeeScheduleIDs(ids...)
+}
+
+// AddBlockchainRouteIDs adds the "blockchain_routes" edge to the BlockchainRoute entity by IDs.
+func (spu *SenderProfileUpdate) AddBlockchainRouteIDs(ids ...uuid.UUID) *SenderProfileUpdate {
+	spu.mutation.AddBlockchainRouteIDs(ids...)
+	return spu
+}
+
+// AddBlockchainRoutes adds the "blockchain_routes" edges to the BlockchainRoute entity.
+func (spu *SenderProfileUpdate) AddBlockchainRoutes(b ...*BlockchainRoute) *SenderProfileUpdate {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return spu.AddBlockchainRouteIDs(ids...)
+}
+
 // Mutation returns the SenderProfileMutation object of the builder.
 func (spu *SenderProfileUpdate) Mutation() *SenderProfileMutation {
 	return spu.mutation
 }
 
-// ClearAPIKey clears the "api_key" edge to the APIKey entity.
+// ClearAPIKey clears all "api_key" edges to the APIKey entity.
 func (spu *SenderProfileUpdate) ClearAPIKey() *SenderProfileUpdate {
 	spu.mutation.ClearAPIKey()
 	return spu
 }
 
+// RemoveAPIKeyIDs removes the "api_key" edge to APIKey entities by IDs.
+func (spu *SenderProfileUpdate) RemoveAPIKeyIDs(ids ...uuid.UUID) *SenderProfileUpdate {
+	spu.mutation.RemoveAPIKeyIDs(ids...)
+	return spu
+}
+
+// RemoveAPIKey removes "api_key" edges to APIKey entities.
+func (spu *SenderProfileUpdate) RemoveAPIKey(a ...*APIKey) *SenderProfileUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return spu.RemoveAPIKeyIDs(ids...)
+}
+
 // ClearPaymentOrders clears all "payment_orders" edges to the PaymentOrder entity.
 func (spu *SenderProfileUpdate) ClearPaymentOrders() *SenderProfileUpdate {
 	spu.mutation.ClearPaymentOrders()
@@ -258,6 +480,69 @@ func (spu *SenderProfileUpdate) RemoveLinkedAddress(l ...*LinkedAddress) *Sender
 	return spu.RemoveLinkedAddresIDs(ids...)
 }
 
+// ClearWebhookDeliveries clears all "webhook_deliveries" edges to the WebhookDelivery entity.
+func (spu *SenderProfileUpdate) ClearWebhookDeliveries() *SenderProfileUpdate {
+	spu.mutation.ClearWebhookDeliveries()
+	return spu
+}
+
+// RemoveWebhookDeliveryIDs removes the "webhook_deliveries" edge to WebhookDelivery entities by IDs.
+func (spu *SenderProfileUpdate) RemoveWebhookDeliveryIDs(ids ...uuid.UUID) *SenderProfileUpdate {
+	spu.mutation.RemoveWebhookDeliveryIDs(ids...)
+	return spu
+}
+
+// RemoveWebhookDeliveries removes "webhook_deliveries" edges to WebhookDelivery entities.
+func (spu *SenderProfileUpdate) RemoveWebhookDeliveries(w ...*WebhookDelivery) *SenderProfileUpdate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return spu.RemoveWebhookDeliveryIDs(ids...)
+}
+
+// ClearFeeSchedules clears all "fee_schedules" edges to the FeeSchedule entity.
+func (spu *SenderProfileUpdate) ClearFeeSchedules() *SenderProfileUpdate {
+	spu.mutation.ClearFeeSchedules()
+	return spu
+}
+
+// RemoveFeeScheduleIDs removes the "fee_schedules" edge to FeeSchedule entities by IDs.
+func (spu *SenderProfileUpdate) RemoveFeeScheduleIDs(ids ...uuid.UUID) *SenderProfileUpdate {
+	spu.mutation.RemoveFeeScheduleIDs(ids...)
+	return spu
+}
+
+// RemoveFeeSchedules removes "fee_schedules" edges to FeeSchedule entities.
+func (spu *SenderProfileUpdate) RemoveFeeSchedules(f ...*FeeSchedule) *SenderProfileUpdate {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return spu.RemoveFeeScheduleIDs(ids...)
+}
+
+// ClearBlockchainRoutes clears all "blockchain_routes" edges to the BlockchainRoute entity.
+func (spu *SenderProfileUpdate) ClearBlockchainRoutes() *SenderProfileUpdate {
+	spu.mutation.ClearBlockchainRoutes()
+	return spu
+}
+
+// RemoveBlockchainRouteIDs removes the "blockchain_routes" edge to BlockchainRoute entities by IDs.
+func (spu *SenderProfileUpdate) RemoveBlockchainRouteIDs(ids ...uuid.UUID) *SenderProfileUpdate {
+	spu.mutation.RemoveBlockchainRouteIDs(ids...)
+	return spu
+}
+
+// RemoveBlockchainRoutes removes "blockchain_routes" edges to BlockchainRoute entities.
+func (spu *SenderProfileUpdate) RemoveBlockchainRoutes(b ...*BlockchainRoute) *SenderProfileUpdate {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return spu.RemoveBlockchainRouteIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (spu *SenderProfileUpdate) Save(ctx context.Context) (int, error) {
 	spu.defaults()
@@ -296,6 +581,21 @@ func (spu *SenderProfileUpdate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (spu *SenderProfileUpdate) check() error {
+	if v, ok := spu.mutation.OverpaymentMode(); ok {
+		if err := senderprofile.OverpaymentModeValidator(v); err != nil {
+			return &ValidationError{Name: "overpayment_mode", err: fmt.Errorf(`ent: validator failed for field "SenderProfile.overpayment_mode": %w`, err)}
+		}
+	}
+	if v, ok := spu.mutation.OrderTTLMinutes(); ok {
+		if err := senderprofile.OrderTTLMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "order_ttl_minutes", err: fmt.Errorf(`ent: validator failed for field "SenderProfile.order_ttl_minutes": %w`, err)}
+		}
+	}
+	if v, ok := spu.mutation.HourlyOrderLimit(); ok {
+		if err := senderprofile.HourlyOrderLimitValidator(v); err != nil {
+			return &ValidationError{Name: "hourly_order_limit", err: fmt.Errorf(`ent: validator failed for field "SenderProfile.hourly_order_limit": %w`, err)}
+		}
+	}
 	if spu.mutation.UserCleared() && len(spu.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "SenderProfile.user"`)
 	}
@@ -320,6 +620,18 @@ func (spu *SenderProfileUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if spu.mutation.WebhookURLCleared() {
 		_spec.ClearField(senderprofile.FieldWebhookURL, field.TypeString)
 	}
+	if value, ok := spu.mutation.WebhookSecret(); ok {
+		_spec.SetField(senderprofile.FieldWebhookSecret, field.TypeString, value)
+	}
+	if spu.mutation.WebhookSecretCleared() {
+		_spec.ClearField(senderprofile.FieldWebhookSecret, field.TypeString)
+	}
+	if value, ok := spu.mutation.RequestSigningSecret(); ok {
+		_spec.SetField(senderprofile.FieldRequestSigningSecret, field.TypeString, value)
+	}
+	if spu.mutation.RequestSigningSecretCleared() {
+		_spec.ClearField(senderprofile.FieldRequestSigningSecret, field.TypeString)
+	}
 	if value, ok := spu.mutation.DomainWhitelist(); ok {
 		_spec.SetField(senderprofile.FieldDomainWhitelist, field.TypeJSON, value)
 	}
@@ -340,12 +652,64 @@ func (spu *SenderProfileUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if value, ok := spu.mutation.IsActive(); ok {
 		_spec.SetField(senderprofile.FieldIsActive, field.TypeBool, value)
 	}
+	if value, ok := spu.mutation.OverpaymentMode(); ok {
+		_spec.SetField(senderprofile.FieldOverpaymentMode, field.TypeEnum, value)
+	}
+	if value, ok := spu.mutation.OrderTTLMinutes(); ok {
+		_spec.SetField(senderprofile.FieldOrderTTLMinutes, field.TypeInt, value)
+	}
+	if value, ok := spu.mutation.AddedOrderTTLMinutes(); ok {
+		_spec.AddField(senderprofile.FieldOrderTTLMinutes, field.TypeInt, value)
+	}
+	if spu.mutation.OrderTTLMinutesCleared() {
+		_spec.ClearField(senderprofile.FieldOrderTTLMinutes, field.TypeInt)
+	}
+	if value, ok := spu.mutation.MaxOrderAmount(); ok {
+		_spec.SetField(senderprofile.FieldMaxOrderAmount, field.TypeFloat64, value)
+	}
+	if value, ok := spu.mutation.AddedMaxOrderAmount(); ok {
+		_spec.AddField(senderprofile.FieldMaxOrderAmount, field.TypeFloat64, value)
+	}
+	if spu.mutation.MaxOrderAmountCleared() {
+		_spec.ClearField(senderprofile.FieldMaxOrderAmount, field.TypeFloat64)
+	}
+	if value, ok := spu.mutation.DailyVolumeLimit(); ok {
+		_spec.SetField(senderprofile.FieldDailyVolumeLimit, field.TypeFloat64, value)
+	}
+	if value, ok := spu.mutation.AddedDailyVolumeLimit(); ok {
+		_spec.AddField(senderprofile.FieldDailyVolumeLimit, field.TypeFloat64, value)
+	}
+	if spu.mutation.DailyVolumeLimitCleared() {
+		_spec.ClearField(senderprofile.FieldDailyVolumeLimit, field.TypeFloat64)
+	}
+	if value, ok := spu.mutation.HourlyOrderLimit(); ok {
+		_spec.SetField(senderprofile.FieldHourlyOrderLimit, field.TypeInt, value)
+	}
+	if value, ok := spu.mutation.AddedHourlyOrderLimit(); ok {
+		_spec.AddField(senderprofile.FieldHourlyOrderLimit, field.TypeInt, value)
+	}
+	if spu.mutation.HourlyOrderLimitCleared() {
+		_spec.ClearField(senderprofile.FieldHourlyOrderLimit, field.TypeInt)
+	}
 	if value, ok := spu.mutation.UpdatedAt(); ok {
 		_spec.SetField(senderprofile.FieldUpdatedAt, field.TypeTime, value)
 	}
 	if spu.mutation.APIKeyCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.APIKeyTable,
+			Columns: []string{senderprofile.APIKeyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spu.mutation.RemovedAPIKeyIDs(); len(nodes) > 0 && !spu.mutation.APIKeyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
 			Table:   senderprofile.APIKeyTable,
 			Columns: []string{senderprofile.APIKeyColumn},
@@ -354,11 +718,14 @@ func (spu *SenderProfileUpdate) sqlSave(ctx context.Context) (n int, err error)
 				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
 			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := spu.mutation.APIKeyIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
 			Table:   senderprofile.APIKeyTable,
 			Columns: []string{senderprofile.APIKeyColumn},
@@ -507,6 +874,141 @@ func (spu *SenderProfileUpdate) sqlSave(ctx context.Context) (n int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if spu.mutation.WebhookDeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.WebhookDeliveriesTable,
+			Columns: []string{senderprofile.WebhookDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spu.mutation.RemovedWebhookDeliveriesIDs(); len(nodes) > 0 && !spu.mutation.WebhookDeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.WebhookDeliveriesTable,
+			Columns: []string{senderprofile.WebhookDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spu.mutation.WebhookDeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.WebhookDeliveriesTable,
+			Columns: []string{senderprofile.WebhookDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if spu.mutation.FeeSchedulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.FeeSchedulesTable,
+			Columns: []string{senderprofile.FeeSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(feeschedule.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spu.mutation.RemovedFeeSchedulesIDs(); len(nodes) > 0 && !spu.mutation.FeeSchedulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.FeeSchedulesTable,
+			Columns: []string{senderprofile.FeeSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(feeschedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spu.mutation.FeeSchedulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.FeeSchedulesTable,
+			Columns: []string{senderprofile.FeeSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(feeschedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if spu.mutation.BlockchainRoutesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.BlockchainRoutesTable,
+			Columns: []string{senderprofile.BlockchainRoutesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blockchainroute.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spu.mutation.RemovedBlockchainRoutesIDs(); len(nodes) > 0 && !spu.mutation.BlockchainRoutesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.BlockchainRoutesTable,
+			Columns: []string{senderprofile.BlockchainRoutesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blockchainroute.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spu.mutation.BlockchainRoutesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.BlockchainRoutesTable,
+			Columns: []string{senderprofile.BlockchainRoutesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blockchainroute.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, spu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{senderprofile.Label}
@@ -536,14 +1038,54 @@ func (spuo *SenderProfileUpdateOne) SetWebhookURL(s string) *SenderProfileUpdate
 // SetNillableWebhookURL sets the "webhook_url" field if the given value is not nil.
 func (spuo *SenderProfileUpdateOne) SetNillableWebhookURL(s *string) *SenderProfileUpdateOne {
 	if s != nil {
-		spuo.SetWebhookURL(*s)
+		spuo.SetWebhookURL(*s)
+	}
+	return spuo
+}
+
+// ClearWebhookURL clears the value of the "webhook_url" field.
+func (spuo *SenderProfileUpdateOne) ClearWebhookURL() *SenderProfileUpdateOne {
+	spuo.mutation.ClearWebhookURL()
+	return spuo
+}
+
+// SetWebhookSecret sets the "webhook_secret" field.
+func (spuo *SenderProfileUpdateOne) SetWebhookSecret(s string) *SenderProfileUpdateOne {
+	spuo.mutation.SetWebhookSecret(s)
+	return spuo
+}
+
+// SetNillableWebhookSecret sets the "webhook_secret" field if the given value is not nil.
+func (spuo *SenderProfileUpdateOne) SetNillableWebhookSecret(s *string) *SenderProfileUpdateOne {
+	if s != nil {
+		spuo.SetWebhookSecret(*s)
+	}
+	return spuo
+}
+
+// ClearWebhookSecret clears the value of the "webhook_secret" field.
+func (spuo *SenderProfileUpdateOne) ClearWebhookSecret() *SenderProfileUpdateOne {
+	spuo.mutation.ClearWebhookSecret()
+	return spuo
+}
+
+// SetRequestSigningSecret sets the "request_signing_secret" field.
+func (spuo *SenderProfileUpdateOne) SetRequestSigningSecret(s string) *SenderProfileUpdateOne {
+	spuo.mutation.SetRequestSigningSecret(s)
+	return spuo
+}
+
+// SetNillableRequestSigningSecret sets the "request_signing_secret" field if the given value is not nil.
+func (spuo *SenderProfileUpdateOne) SetNillableRequestSigningSecret(s *string) *SenderProfileUpdateOne {
+	if s != nil {
+		spuo.SetRequestSigningSecret(*s)
 	}
 	return spuo
 }
 
-// ClearWebhookURL clears the value of the "webhook_url" field.
-func (spuo *SenderProfileUpdateOne) ClearWebhookURL() *SenderProfileUpdateOne {
-	spuo.mutation.ClearWebhookURL()
+// ClearRequestSigningSecret clears the value of the "request_signing_secret" field.
+func (spuo *SenderProfileUpdateOne) ClearRequestSigningSecret() *SenderProfileUpdateOne {
+	spuo.mutation.ClearRequestSigningSecret()
 	return spuo
 }
 
@@ -607,29 +1149,147 @@ func (spuo *SenderProfileUpdateOne) SetNillableIsActive(b *bool) *SenderProfileU
 	return spuo
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (spuo *SenderProfileUpdateOne) SetUpdatedAt(t time.Time) *SenderProfileUpdateOne {
-	spuo.mutation.SetUpdatedAt(t)
+// SetOverpaymentMode sets the "overpayment_mode" field.
+func (spuo *SenderProfileUpdateOne) SetOverpaymentMode(sm senderprofile.OverpaymentMode) *SenderProfileUpdateOne {
+	spuo.mutation.SetOverpaymentMode(sm)
+	return spuo
+}
+
+// SetNillableOverpaymentMode sets the "overpayment_mode" field if the given value is not nil.
+func (spuo *SenderProfileUpdateOne) SetNillableOverpaymentMode(sm *senderprofile.OverpaymentMode) *SenderProfileUpdateOne {
+	if sm != nil {
+		spuo.SetOverpaymentMode(*sm)
+	}
+	return spuo
+}
+
+// SetOrderTTLMinutes sets the "order_ttl_minutes" field.
+func (spuo *SenderProfileUpdateOne) SetOrderTTLMinutes(i int) *SenderProfileUpdateOne {
+	spuo.mutation.ResetOrderTTLMinutes()
+	spuo.mutation.SetOrderTTLMinutes(i)
+	return spuo
+}
+
+// SetNillableOrderTTLMinutes sets the "order_ttl_minutes" field if the given value is not nil.
+func (spuo *SenderProfileUpdateOne) SetNillableOrderTTLMinutes(i *int) *SenderProfileUpdateOne {
+	if i != nil {
+		spuo.SetOrderTTLMinutes(*i)
+	}
+	return spuo
+}
+
+// AddOrderTTLMinutes adds i to the "order_ttl_minutes" field.
+func (spuo *SenderProfileUpdateOne) AddOrderTTLMinutes(i int) *SenderProfileUpdateOne {
+	spuo.mutation.AddOrderTTLMinutes(i)
+	return spuo
+}
+
+// ClearOrderTTLMinutes clears the value of the "order_ttl_minutes" field.
+func (spuo *SenderProfileUpdateOne) ClearOrderTTLMinutes() *SenderProfileUpdateOne {
+	spuo.mutation.ClearOrderTTLMinutes()
+	return spuo
+}
+
+// SetMaxOrderAmount sets the "max_order_amount" field.
+func (spuo *SenderProfileUpdateOne) SetMaxOrderAmount(d decimal.Decimal) *SenderProfileUpdateOne {
+	spuo.mutation.ResetMaxOrderAmount()
+	spuo.mutation.SetMaxOrderAmount(d)
+	return spuo
+}
+
+// SetNillableMaxOrderAmount sets the "max_order_amount" field if the given value is not nil.
+func (spuo *SenderProfileUpdateOne) SetNillableMaxOrderAmount(d *decimal.Decimal) *SenderProfileUpdateOne {
+	if d != nil {
+		spuo.SetMaxOrderAmount(*d)
+	}
+	return spuo
+}
+
+// AddMaxOrderAmount adds d to the "max_order_amount" field.
+func (spuo *SenderProfileUpdateOne) AddMaxOrderAmount(d decimal.Decimal) *SenderProfileUpdateOne {
+	spuo.mutation.AddMaxOrderAmount(d)
+	return spuo
+}
+
+// ClearMaxOrderAmount clears the value of the "max_order_amount" field.
+func (spuo *SenderProfileUpdateOne) ClearMaxOrderAmount() *SenderProfileUpdateOne {
+	spuo.mutation.ClearMaxOrderAmount()
+	return spuo
+}
+
+// SetDailyVolumeLimit sets the "daily_volume_limit" field.
+func (spuo *SenderProfileUpdateOne) SetDailyVolumeLimit(d decimal.Decimal) *SenderProfileUpdateOne {
+	spuo.mutation.ResetDailyVolumeLimit()
+	spuo.mutation.SetDailyVolumeLimit(d)
+	return spuo
+}
+
+// SetNillableDailyVolumeLimit sets the "daily_volume_limit" field if the given value is not nil.
+func (spuo *SenderProfileUpdateOne) SetNillableDailyVolumeLimit(d *decimal.Decimal) *SenderProfileUpdateOne {
+	if d != nil {
+		spuo.SetDailyVolumeLimit(*d)
+	}
+	return spuo
+}
+
+// AddDailyVolumeLimit adds d to the "daily_volume_limit" field.
+func (spuo *SenderProfileUpdateOne) AddDailyVolumeLimit(d decimal.Decimal) *SenderProfileUpdateOne {
+	spuo.mutation.AddDailyVolumeLimit(d)
+	return spuo
+}
+
+// ClearDailyVolumeLimit clears the value of the "daily_volume_limit" field.
+func (spuo *SenderProfileUpdateOne) ClearDailyVolumeLimit() *SenderProfileUpdateOne {
+	spuo.mutation.ClearDailyVolumeLimit()
 	return spuo
 }
 
-// SetAPIKeyID sets the "api_key" edge to the APIKey entity by ID.
-func (spuo *SenderProfileUpdateOne) SetAPIKeyID(id uuid.UUID) *SenderProfileUpdateOne {
-	spuo.mutation.SetAPIKeyID(id)
+// SetHourlyOrderLimit sets the "hourly_order_limit" field.
+func (spuo *SenderProfileUpdateOne) SetHourlyOrderLimit(i int) *SenderProfileUpdateOne {
+	spuo.mutation.ResetHourlyOrderLimit()
+	spuo.mutation.SetHourlyOrderLimit(i)
 	return spuo
 }
 
-// SetNillableAPIKeyID sets the "api_key" edge to the APIKey entity by ID if the given value is not nil.
-func (spuo *SenderProfileUpdateOne) SetNillableAPIKeyID(id *uuid.UUID) *SenderProfileUpdateOne {
-	if id != nil {
-		spuo = spuo.SetAPIKeyID(*id)
+// SetNillableHourlyOrderLimit sets the "hourly_order_limit" field if the given value is not nil.
+func (spuo *SenderProfileUpdateOne) SetNillableHourlyOrderLimit(i *int) *SenderProfileUpdateOne {
+	if i != nil {
+		spuo.SetHourlyOrderLimit(*i)
 	}
 	return spuo
 }
 
-// SetAPIKey sets the "api_key" edge to the APIKey entity.
-func (spuo *SenderProfileUpdateOne) SetAPIKey(a *APIKey) *SenderProfileUpdateOne {
-	return spuo.SetAPIKeyID(a.ID)
+// AddHourlyOrderLimit adds i to the "hourly_order_limit" field.
+func (spuo *SenderProfileUpdateOne) AddHourlyOrderLimit(i int) *SenderProfileUpdateOne {
+	spuo.mutation.AddHourlyOrderLimit(i)
+	return spuo
+}
+
+// ClearHourlyOrderLimit clears the value of the "hourly_order_limit" field.
+func (spuo *SenderProfileUpdateOne) ClearHourlyOrderLimit() *SenderProfileUpdateOne {
+	spuo.mutation.ClearHourlyOrderLimit()
+	return spuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (spuo *SenderProfileUpdateOne) SetUpdatedAt(t time.Time) *SenderProfileUpdateOne {
+	spuo.mutation.SetUpdatedAt(t)
+	return spuo
+}
+
+// AddAPIKeyIDs adds the "api_key" edge to the APIKey entity by IDs.
+func (spuo *SenderProfileUpdateOne) AddAPIKeyIDs(ids ...uuid.UUID) *SenderProfileUpdateOne {
+	spuo.mutation.AddAPIKeyIDs(ids...)
+	return spuo
+}
+
+// AddAPIKey adds the "api_key" edges to the APIKey entity.
+func (spuo *SenderProfileUpdateOne) AddAPIKey(a ...*APIKey) *SenderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return spuo.AddAPIKeyIDs(ids...)
 }
 
 // AddPaymentOrderIDs adds the "payment_orders" edge to the PaymentOrder entity by IDs.
@@ -677,17 +1337,77 @@ func (spuo *SenderProfileUpdateOne) AddLinkedAddress(l ...*LinkedAddress) *Sende
 	return spuo.AddLinkedAddresIDs(ids...)
 }
 
+// AddWebhookDeliveryIDs adds the "webhook_deliveries" edge to the WebhookDelivery entity by IDs.
+func (spuo *SenderProfileUpdateOne) AddWebhookDeliveryIDs(ids ...uuid.UUID) *SenderProfileUpdateOne {
+	spuo.mutation.AddWebhookDeliveryIDs(ids...)
+	return spuo
+}
+
+// AddWebhookDeliveries adds the "webhook_deliveries" edges to the WebhookDelivery entity.
+func (spuo *SenderProfileUpdateOne) AddWebhookDeliveries(w ...*WebhookDelivery) *SenderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return spuo.AddWebhookDeliveryIDs(ids...)
+}
+
+// AddFeeScheduleIDs adds the "fee_schedules" edge to the FeeSchedule entity by IDs.
+func (spuo *SenderProfileUpdateOne) AddFeeScheduleIDs(ids ...uuid.UUID) *SenderProfileUpdateOne {
+	spuo.mutation.AddFeeScheduleIDs(ids...)
+	return spuo
+}
+
+// AddFeeSchedules adds the "fee_schedules" edges to the FeeSchedule entity.
+func (spuo *SenderProfileUpdateOne) AddFeeSchedules(f ...*FeeSchedule) *SenderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return spuo.AddFeeScheduleIDs(ids...)
+}
+
+// AddBlockchainRouteIDs adds the "blockchain_routes" edge to the BlockchainRoute entity by IDs.
+func (spuo *SenderProfileUpdateOne) AddBlockchainRouteIDs(ids ...uuid.UUID) *SenderProfileUpdateOne {
+	spuo.mutation.AddBlockchainRouteIDs(ids...)
+	return spuo
+}
+
+// AddBlockchainRoutes adds the "blockchain_routes" edges to the BlockchainRoute entity.
+func (spuo *SenderProfileUpdateOne) AddBlockchainRoutes(b ...*BlockchainRoute) *SenderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return spuo.AddBlockchainRouteIDs(ids...)
+}
+
 // Mutation returns the SenderProfileMutation object of the builder.
 func (spuo *SenderProfileUpdateOne) Mutation() *SenderProfileMutation {
 	return spuo.mutation
 }
 
-// ClearAPIKey clears the "api_key" edge to the APIKey entity.
+// ClearAPIKey clears all "api_key" edges to the APIKey entity.
 func (spuo *SenderProfileUpdateOne) ClearAPIKey() *SenderProfileUpdateOne {
 	spuo.mutation.ClearAPIKey()
 	return spuo
 }
 
+// RemoveAPIKeyIDs removes the "api_key" edge to APIKey entities by IDs.
+func (spuo *SenderProfileUpdateOne) RemoveAPIKeyIDs(ids ...uuid.UUID) *SenderProfileUpdateOne {
+	spuo.mutation.RemoveAPIKeyIDs(ids...)
+	return spuo
+}
+
+// RemoveAPIKey removes "api_key" edges to APIKey entities.
+func (spuo *SenderProfileUpdateOne) RemoveAPIKey(a ...*APIKey) *SenderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return spuo.RemoveAPIKeyIDs(ids...)
+}
+
 // ClearPaymentOrders clears all "payment_orders" edges to the PaymentOrder entity.
 func (spuo *SenderProfileUpdateOne) ClearPaymentOrders() *SenderProfileUpdateOne {
 	spuo.mutation.ClearPaymentOrders()
@@ -751,6 +1471,69 @@ func (spuo *SenderProfileUpdateOne) RemoveLinkedAddress(l ...*LinkedAddress) *Se
 	return spuo.RemoveLinkedAddresIDs(ids...)
 }
 
+// ClearWebhookDeliveries clears all "webhook_deliveries" edges to the WebhookDelivery entity.
+func (spuo *SenderProfileUpdateOne) ClearWebhookDeliveries() *SenderProfileUpdateOne {
+	spuo.mutation.ClearWebhookDeliveries()
+	return spuo
+}
+
+// RemoveWebhookDeliveryIDs removes the "webhook_deliveries" edge to WebhookDelivery entities by IDs.
+func (spuo *SenderProfileUpdateOne) RemoveWebhookDeliveryIDs(ids ...uuid.UUID) *SenderProfileUpdateOne {
+	spuo.mutation.RemoveWebhookDeliveryIDs(ids...)
+	return spuo
+}
+
+// RemoveWebhookDeliveries removes "webhook_deliveries" edges to WebhookDelivery entities.
+func (spuo *SenderProfileUpdateOne) RemoveWebhookDeliveries(w ...*WebhookDelivery) *SenderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return spuo.RemoveWebhookDeliveryIDs(ids...)
+}
+
+// ClearFeeSchedules clears all "fee_schedules" edges to the FeeSchedule entity.
+func (spuo *SenderProfileUpdateOne) ClearFeeSchedules() *SenderProfileUpdateOne {
+	spuo.mutation.ClearFeeSchedules()
+	return spuo
+}
+
+// RemoveFeeScheduleIDs removes the "fee_schedules" edge to FeeSchedule entities by IDs.
+func (spuo *SenderProfileUpdateOne) RemoveFeeScheduleIDs(ids ...uuid.UUID) *SenderProfileUpdateOne {
+	spuo.mutation.RemoveFeeScheduleIDs(ids...)
+	return spuo
+}
+
+// RemoveFeeSchedules removes "fee_schedules" edges to FeeSchedule entities.
+func (spuo *SenderProfileUpdateOne) RemoveFeeSchedules(f ...*FeeSchedule) *SenderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return spuo.RemoveFeeScheduleIDs(ids...)
+}
+
+// ClearBlockchainRoutes clears all "blockchain_routes" edges to the BlockchainRoute entity.
+func (spuo *SenderProfileUpdateOne) ClearBlockchainRoutes() *SenderProfileUpdateOne {
+	spuo.mutation.ClearBlockchainRoutes()
+	return spuo
+}
+
+// RemoveBlockchainRouteIDs removes the "blockchain_routes" edge to BlockchainRoute entities by IDs.
+func (spuo *SenderProfileUpdateOne) RemoveBlockchainRouteIDs(ids ...uuid.UUID) *SenderProfileUpdateOne {
+	spuo.mutation.RemoveBlockchainRouteIDs(ids...)
+	return spuo
+}
+
+// RemoveBlockchainRoutes removes "blockchain_routes" edges to BlockchainRoute entities.
+func (spuo *SenderProfileUpdateOne) RemoveBlockchainRoutes(b ...*BlockchainRoute) *SenderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return spuo.RemoveBlockchainRouteIDs(ids...)
+}
+
 // Where appends a list predicates to the SenderProfileUpdate builder.
 func (spuo *SenderProfileUpdateOne) Where(ps ...predicate.SenderProfile) *SenderProfileUpdateOne {
 	spuo.mutation.Where(ps...)
@@ -802,6 +1585,21 @@ func (spuo *SenderProfileUpdateOne) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (spuo *SenderProfileUpdateOne) check() error {
+	if v, ok := spuo.mutation.OverpaymentMode(); ok {
+		if err := senderprofile.OverpaymentModeValidator(v); err != nil {
+			return &ValidationError{Name: "overpayment_mode", err: fmt.Errorf(`ent: validator failed for field "SenderProfile.overpayment_mode": %w`, err)}
+		}
+	}
+	if v, ok := spuo.mutation.OrderTTLMinutes(); ok {
+		if err := senderprofile.OrderTTLMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "order_ttl_minutes", err: fmt.Errorf(`ent: validator failed for field "SenderProfile.order_ttl_minutes": %w`, err)}
+		}
+	}
+	if v, ok := spuo.mutation.HourlyOrderLimit(); ok {
+		if err := senderprofile.HourlyOrderLimitValidator(v); err != nil {
+			return &ValidationError{Name: "hourly_order_limit", err: fmt.Errorf(`ent: validator failed for field "SenderProfile.hourly_order_limit": %w`, err)}
+		}
+	}
 	if spuo.mutation.UserCleared() && len(spuo.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "SenderProfile.user"`)
 	}
@@ -843,6 +1641,18 @@ func (spuo *SenderProfileUpdateOne) sqlSave(ctx context.Context) (_node *SenderP
 	if spuo.mutation.WebhookURLCleared() {
 		_spec.ClearField(senderprofile.FieldWebhookURL, field.TypeString)
 	}
+	if value, ok := spuo.mutation.WebhookSecret(); ok {
+		_spec.SetField(senderprofile.FieldWebhookSecret, field.TypeString, value)
+	}
+	if spuo.mutation.WebhookSecretCleared() {
+		_spec.ClearField(senderprofile.FieldWebhookSecret, field.TypeString)
+	}
+	if value, ok := spuo.mutation.RequestSigningSecret(); ok {
+		_spec.SetField(senderprofile.FieldRequestSigningSecret, field.TypeString, value)
+	}
+	if spuo.mutation.RequestSigningSecretCleared() {
+		_spec.ClearField(senderprofile.FieldRequestSigningSecret, field.TypeString)
+	}
 	if value, ok := spuo.mutation.DomainWhitelist(); ok {
 		_spec.SetField(senderprofile.FieldDomainWhitelist, field.TypeJSON, value)
 	}
@@ -863,12 +1673,64 @@ func (spuo *SenderProfileUpdateOne) sqlSave(ctx context.Context) (_node *SenderP
 	if value, ok := spuo.mutation.IsActive(); ok {
 		_spec.SetField(senderprofile.FieldIsActive, field.TypeBool, value)
 	}
+	if value, ok := spuo.mutation.OverpaymentMode(); ok {
+		_spec.SetField(senderprofile.FieldOverpaymentMode, field.TypeEnum, value)
+	}
+	if value, ok := spuo.mutation.OrderTTLMinutes(); ok {
+		_spec.SetField(senderprofile.FieldOrderTTLMinutes, field.TypeInt, value)
+	}
+	if value, ok := spuo.mutation.AddedOrderTTLMinutes(); ok {
+		_spec.AddField(senderprofile.FieldOrderTTLMinutes, field.TypeInt, value)
+	}
+	if spuo.mutation.OrderTTLMinutesCleared() {
+		_spec.ClearField(senderprofile.FieldOrderTTLMinutes, field.TypeInt)
+	}
+	if value, ok := spuo.mutation.MaxOrderAmount(); ok {
+		_spec.SetField(senderprofile.FieldMaxOrderAmount, field.TypeFloat64, value)
+	}
+	if value, ok := spuo.mutation.AddedMaxOrderAmount(); ok {
+		_spec.AddField(senderprofile.FieldMaxOrderAmount, field.TypeFloat64, value)
+	}
+	if spuo.mutation.MaxOrderAmountCleared() {
+		_spec.ClearField(senderprofile.FieldMaxOrderAmount, field.TypeFloat64)
+	}
+	if value, ok := spuo.mutation.DailyVolumeLimit(); ok {
+		_spec.SetField(senderprofile.FieldDailyVolumeLimit, field.TypeFloat64, value)
+	}
+	if value, ok := spuo.mutation.AddedDailyVolumeLimit(); ok {
+		_spec.AddField(senderprofile.FieldDailyVolumeLimit, field.TypeFloat64, value)
+	}
+	if spuo.mutation.DailyVolumeLimitCleared() {
+		_spec.ClearField(senderprofile.FieldDailyVolumeLimit, field.TypeFloat64)
+	}
+	if value, ok := spuo.mutation.HourlyOrderLimit(); ok {
+		_spec.SetField(senderprofile.FieldHourlyOrderLimit, field.TypeInt, value)
+	}
+	if value, ok := spuo.mutation.AddedHourlyOrderLimit(); ok {
+		_spec.AddField(senderprofile.FieldHourlyOrderLimit, field.TypeInt, value)
+	}
+	if spuo.mutation.HourlyOrderLimitCleared() {
+		_spec.ClearField(senderprofile.FieldHourlyOrderLimit, field.TypeInt)
+	}
 	if value, ok := spuo.mutation.UpdatedAt(); ok {
 		_spec.SetField(senderprofile.FieldUpdatedAt, field.TypeTime, value)
 	}
 	if spuo.mutation.APIKeyCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.APIKeyTable,
+			Columns: []string{senderprofile.APIKeyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spuo.mutation.RemovedAPIKeyIDs(); len(nodes) > 0 && !spuo.mutation.APIKeyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
 			Table:   senderprofile.APIKeyTable,
 			Columns: []string{senderprofile.APIKeyColumn},
@@ -877,11 +1739,14 @@ func (spuo *SenderProfileUpdateOne) sqlSave(ctx context.Context) (_node *SenderP
 				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
 			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := spuo.mutation.APIKeyIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
 			Table:   senderprofile.APIKeyTable,
 			Columns: []string{senderprofile.APIKeyColumn},
@@ -1030,6 +1895,141 @@ func (spuo *SenderProfileUpdateOne) sqlSave(ctx context.Context) (_node *SenderP
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if spuo.mutation.WebhookDeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.WebhookDeliveriesTable,
+			Columns: []string{senderprofile.WebhookDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spuo.mutation.RemovedWebhookDeliveriesIDs(); len(nodes) > 0 && !spuo.mutation.WebhookDeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.WebhookDeliveriesTable,
+			Columns: []string{senderprofile.WebhookDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spuo.mutation.WebhookDeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.WebhookDeliveriesTable,
+			Columns: []string{senderprofile.WebhookDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if spuo.mutation.FeeSchedulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.FeeSchedulesTable,
+			Columns: []string{senderprofile.FeeSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(feeschedule.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spuo.mutation.RemovedFeeSchedulesIDs(); len(nodes) > 0 && !spuo.mutation.FeeSchedulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.FeeSchedulesTable,
+			Columns: []string{senderprofile.FeeSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(feeschedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spuo.mutation.FeeSchedulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.FeeSchedulesTable,
+			Columns: []string{senderprofile.FeeSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(feeschedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if spuo.mutation.BlockchainRoutesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.BlockchainRoutesTable,
+			Columns: []string{senderprofile.BlockchainRoutesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blockchainroute.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spuo.mutation.RemovedBlockchainRoutesIDs(); len(nodes) > 0 && !spuo.mutation.BlockchainRoutesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.BlockchainRoutesTable,
+			Columns: []string{senderprofile.BlockchainRoutesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blockchainroute.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spuo.mutation.BlockchainRoutesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.BlockchainRoutesTable,
+			Columns: []string{senderprofile.BlockchainRoutesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blockchainroute.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &SenderProfile{config: spuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,278 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/NEDA-LABS/stablenode/ent/sweep"
+	"github.com/NEDA-LABS/stablenode/ent/unmatcheddeposit"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Sweep is the model entity for the Sweep schema.
+type Sweep struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Network holds the value of the "network" field.
+	Network string `json:"network,omitempty"`
+	// ChainID holds the value of the "chain_id" field.
+	ChainID int64 `json:"chain_id,omitempty"`
+	// TokenAddress holds the value of the "token_address" field.
+	TokenAddress string `json:"token_address,omitempty"`
+	// FromAddress holds the value of the "from_address" field.
+	FromAddress string `json:"from_address,omitempty"`
+	// ToAddress holds the value of the "to_address" field.
+	ToAddress string `json:"to_address,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount decimal.Decimal `json:"amount,omitempty"`
+	// UserOperation holds the value of the "user_operation" field.
+	UserOperation map[string]interface{} `json:"user_operation,omitempty"`
+	// UserOpHash holds the value of the "user_op_hash" field.
+	UserOpHash string `json:"user_op_hash,omitempty"`
+	// TxHash holds the value of the "tx_hash" field.
+	TxHash string `json:"tx_hash,omitempty"`
+	// Status holds the value of the "status" field.
+	Status sweep.Status `json:"status,omitempty"`
+	// SubmittedAt holds the value of the "submitted_at" field.
+	SubmittedAt time.Time `json:"submitted_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SweepQuery when eager-loading is set.
+	Edges        SweepEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SweepEdges holds the relations/edges for other nodes in the graph.
+type SweepEdges struct {
+	// UnmatchedDeposit holds the value of the unmatched_deposit edge.
+	UnmatchedDeposit *UnmatchedDeposit `json:"unmatched_deposit,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UnmatchedDepositOrErr returns the UnmatchedDeposit value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SweepEdges) UnmatchedDepositOrErr() (*UnmatchedDeposit, error) {
+	if e.UnmatchedDeposit != nil {
+		return e.UnmatchedDeposit, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: unmatcheddeposit.Label}
+	}
+	return nil, &NotLoadedError{edge: "unmatched_deposit"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Sweep) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case sweep.FieldUserOperation:
+			values[i] = new([]byte)
+		case sweep.FieldAmount:
+			values[i] = new(decimal.Decimal)
+		case sweep.FieldChainID:
+			values[i] = new(sql.NullInt64)
+		case sweep.FieldNetwork, sweep.FieldTokenAddress, sweep.FieldFromAddress, sweep.FieldToAddress, sweep.FieldUserOpHash, sweep.FieldTxHash, sweep.FieldStatus:
+			values[i] = new(sql.NullString)
+		case sweep.FieldCreatedAt, sweep.FieldUpdatedAt, sweep.FieldSubmittedAt:
+			values[i] = new(sql.NullTime)
+		case sweep.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Sweep fields.
+func (s *Sweep) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case sweep.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				s.ID = *value
+			}
+		case sweep.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				s.CreatedAt = value.Time
+			}
+		case sweep.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				s.UpdatedAt = value.Time
+			}
+		case sweep.FieldNetwork:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field network", values[i])
+			} else if value.Valid {
+				s.Network = value.String
+			}
+		case sweep.FieldChainID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field chain_id", values[i])
+			} else if value.Valid {
+				s.ChainID = value.Int64
+			}
+		case sweep.FieldTokenAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_address", values[i])
+			} else if value.Valid {
+				s.TokenAddress = value.String
+			}
+		case sweep.FieldFromAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_address", values[i])
+			} else if value.Valid {
+				s.FromAddress = value.String
+			}
+		case sweep.FieldToAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to_address", values[i])
+			} else if value.Valid {
+				s.ToAddress = value.String
+			}
+		case sweep.FieldAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				s.Amount = *value
+			}
+		case sweep.FieldUserOperation:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field user_operation", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &s.UserOperation); err != nil {
+					return fmt.Errorf("unmarshal field user_operation: %w", err)
+				}
+			}
+		case sweep.FieldUserOpHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_op_hash", values[i])
+			} else if value.Valid {
+				s.UserOpHash = value.String
+			}
+		case sweep.FieldTxHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tx_hash", values[i])
+			} else if value.Valid {
+				s.TxHash = value.String
+			}
+		case sweep.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				s.Status = sweep.Status(value.String)
+			}
+		case sweep.FieldSubmittedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field submitted_at", values[i])
+			} else if value.Valid {
+				s.SubmittedAt = value.Time
+			}
+		default:
+			s.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Sweep.
+// This includes values selected through modifiers, order, etc.
+func (s *Sweep) Value(name string) (ent.Value, error) {
+	return s.selectValues.Get(name)
+}
+
+// QueryUnmatchedDeposit queries the "unmatched_deposit" edge of the Sweep entity.
+func (s *Sweep) QueryUnmatchedDeposit() *UnmatchedDepositQuery {
+	return NewSweepClient(s.config).QueryUnmatchedDeposit(s)
+}
+
+// Update returns a builder for updating this Sweep.
+// Note that you need to call Sweep.Unwrap() before calling this method if this Sweep
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (s *Sweep) Update() *SweepUpdateOne {
+	return NewSweepClient(s.config).UpdateOne(s)
+}
+
+// Unwrap unwraps the Sweep entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (s *Sweep) Unwrap() *Sweep {
+	_tx, ok := s.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Sweep is not a transactional entity")
+	}
+	s.config.driver = _tx.drv
+	return s
+}
+
+// String implements the fmt.Stringer.
+func (s *Sweep) String() string {
+	var builder strings.Builder
+	builder.WriteString("Sweep(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", s.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(s.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(s.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("network=")
+	builder.WriteString(s.Network)
+	builder.WriteString(", ")
+	builder.WriteString("chain_id=")
+	builder.WriteString(fmt.Sprintf("%v", s.ChainID))
+	builder.WriteString(", ")
+	builder.WriteString("token_address=")
+	builder.WriteString(s.TokenAddress)
+	builder.WriteString(", ")
+	builder.WriteString("from_address=")
+	builder.WriteString(s.FromAddress)
+	builder.WriteString(", ")
+	builder.WriteString("to_address=")
+	builder.WriteString(s.ToAddress)
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", s.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("user_operation=")
+	builder.WriteString(fmt.Sprintf("%v", s.UserOperation))
+	builder.WriteString(", ")
+	builder.WriteString("user_op_hash=")
+	builder.WriteString(s.UserOpHash)
+	builder.WriteString(", ")
+	builder.WriteString("tx_hash=")
+	builder.WriteString(s.TxHash)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", s.Status))
+	builder.WriteString(", ")
+	builder.WriteString("submitted_at=")
+	builder.WriteString(s.SubmittedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Sweeps is a parsable slice of Sweep.
+type Sweeps []*Sweep
@@ -0,0 +1,209 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sweep
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the sweep type in the database.
+	Label = "sweep"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldNetwork holds the string denoting the network field in the database.
+	FieldNetwork = "network"
+	// FieldChainID holds the string denoting the chain_id field in the database.
+	FieldChainID = "chain_id"
+	// FieldTokenAddress holds the string denoting the token_address field in the database.
+	FieldTokenAddress = "token_address"
+	// FieldFromAddress holds the string denoting the from_address field in the database.
+	FieldFromAddress = "from_address"
+	// FieldToAddress holds the string denoting the to_address field in the database.
+	FieldToAddress = "to_address"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldUserOperation holds the string denoting the user_operation field in the database.
+	FieldUserOperation = "user_operation"
+	// FieldUserOpHash holds the string denoting the user_op_hash field in the database.
+	FieldUserOpHash = "user_op_hash"
+	// FieldTxHash holds the string denoting the tx_hash field in the database.
+	FieldTxHash = "tx_hash"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldSubmittedAt holds the string denoting the submitted_at field in the database.
+	FieldSubmittedAt = "submitted_at"
+	// EdgeUnmatchedDeposit holds the string denoting the unmatched_deposit edge name in mutations.
+	EdgeUnmatchedDeposit = "unmatched_deposit"
+	// Table holds the table name of the sweep in the database.
+	Table = "sweeps"
+	// UnmatchedDepositTable is the table that holds the unmatched_deposit relation/edge.
+	UnmatchedDepositTable = "unmatched_deposits"
+	// UnmatchedDepositInverseTable is the table name for the UnmatchedDeposit entity.
+	// It exists in this package in order to avoid circular dependency with the "unmatcheddeposit" package.
+	UnmatchedDepositInverseTable = "unmatched_deposits"
+	// UnmatchedDepositColumn is the table column denoting the unmatched_deposit relation/edge.
+	UnmatchedDepositColumn = "sweep_unmatched_deposit"
+)
+
+// Columns holds all SQL columns for sweep fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldNetwork,
+	FieldChainID,
+	FieldTokenAddress,
+	FieldFromAddress,
+	FieldToAddress,
+	FieldAmount,
+	FieldUserOperation,
+	FieldUserOpHash,
+	FieldTxHash,
+	FieldStatus,
+	FieldSubmittedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// UserOpHashValidator is a validator for the "user_op_hash" field. It is called by the builders before save.
+	UserOpHashValidator func(string) error
+	// TxHashValidator is a validator for the "tx_hash" field. It is called by the builders before save.
+	TxHashValidator func(string) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusAwaitingSignature is the default value of the Status enum.
+const DefaultStatus = StatusAwaitingSignature
+
+// Status values.
+const (
+	StatusAwaitingSignature Status = "awaiting_signature"
+	StatusSubmitted         Status = "submitted"
+	StatusConfirmed         Status = "confirmed"
+	StatusFailed            Status = "failed"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusAwaitingSignature, StatusSubmitted, StatusConfirmed, StatusFailed:
+		return nil
+	default:
+		return fmt.Errorf("sweep: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the Sweep queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByNetwork orders the results by the network field.
+func ByNetwork(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNetwork, opts...).ToFunc()
+}
+
+// ByChainID orders the results by the chain_id field.
+func ByChainID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChainID, opts...).ToFunc()
+}
+
+// ByTokenAddress orders the results by the token_address field.
+func ByTokenAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTokenAddress, opts...).ToFunc()
+}
+
+// ByFromAddress orders the results by the from_address field.
+func ByFromAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFromAddress, opts...).ToFunc()
+}
+
+// ByToAddress orders the results by the to_address field.
+func ByToAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToAddress, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
+
+// ByUserOpHash orders the results by the user_op_hash field.
+func ByUserOpHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserOpHash, opts...).ToFunc()
+}
+
+// ByTxHash orders the results by the tx_hash field.
+func ByTxHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTxHash, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// BySubmittedAt orders the results by the submitted_at field.
+func BySubmittedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubmittedAt, opts...).ToFunc()
+}
+
+// ByUnmatchedDepositField orders the results by unmatched_deposit field.
+func ByUnmatchedDepositField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUnmatchedDepositStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newUnmatchedDepositStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UnmatchedDepositInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, UnmatchedDepositTable, UnmatchedDepositColumn),
+	)
+}
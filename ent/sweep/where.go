@@ -0,0 +1,801 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sweep
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Sweep {
+	return predicate.Sweep(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Sweep {
+	return predicate.Sweep(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Sweep {
+	return predicate.Sweep(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Sweep {
+	return predicate.Sweep(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Sweep {
+	return predicate.Sweep(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Sweep {
+	return predicate.Sweep(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Sweep {
+	return predicate.Sweep(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Sweep {
+	return predicate.Sweep(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Sweep {
+	return predicate.Sweep(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Sweep {
+	return predicate.Sweep(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Sweep {
+	return predicate.Sweep(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Network applies equality check predicate on the "network" field. It's identical to NetworkEQ.
+func Network(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldEQ(FieldNetwork, v))
+}
+
+// ChainID applies equality check predicate on the "chain_id" field. It's identical to ChainIDEQ.
+func ChainID(v int64) predicate.Sweep {
+	return predicate.Sweep(sql.FieldEQ(FieldChainID, v))
+}
+
+// TokenAddress applies equality check predicate on the "token_address" field. It's identical to TokenAddressEQ.
+func TokenAddress(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldEQ(FieldTokenAddress, v))
+}
+
+// FromAddress applies equality check predicate on the "from_address" field. It's identical to FromAddressEQ.
+func FromAddress(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldEQ(FieldFromAddress, v))
+}
+
+// ToAddress applies equality check predicate on the "to_address" field. It's identical to ToAddressEQ.
+func ToAddress(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldEQ(FieldToAddress, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v decimal.Decimal) predicate.Sweep {
+	return predicate.Sweep(sql.FieldEQ(FieldAmount, v))
+}
+
+// UserOpHash applies equality check predicate on the "user_op_hash" field. It's identical to UserOpHashEQ.
+func UserOpHash(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldEQ(FieldUserOpHash, v))
+}
+
+// TxHash applies equality check predicate on the "tx_hash" field. It's identical to TxHashEQ.
+func TxHash(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldEQ(FieldTxHash, v))
+}
+
+// SubmittedAt applies equality check predicate on the "submitted_at" field. It's identical to SubmittedAtEQ.
+func SubmittedAt(v time.Time) predicate.Sweep {
+	return predicate.Sweep(sql.FieldEQ(FieldSubmittedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Sweep {
+	return predicate.Sweep(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Sweep {
+	return predicate.Sweep(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Sweep {
+	return predicate.Sweep(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Sweep {
+	return predicate.Sweep(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Sweep {
+	return predicate.Sweep(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Sweep {
+	return predicate.Sweep(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Sweep {
+	return predicate.Sweep(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Sweep {
+	return predicate.Sweep(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Sweep {
+	return predicate.Sweep(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Sweep {
+	return predicate.Sweep(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Sweep {
+	return predicate.Sweep(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Sweep {
+	return predicate.Sweep(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Sweep {
+	return predicate.Sweep(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Sweep {
+	return predicate.Sweep(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Sweep {
+	return predicate.Sweep(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Sweep {
+	return predicate.Sweep(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// NetworkEQ applies the EQ predicate on the "network" field.
+func NetworkEQ(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldEQ(FieldNetwork, v))
+}
+
+// NetworkNEQ applies the NEQ predicate on the "network" field.
+func NetworkNEQ(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldNEQ(FieldNetwork, v))
+}
+
+// NetworkIn applies the In predicate on the "network" field.
+func NetworkIn(vs ...string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldIn(FieldNetwork, vs...))
+}
+
+// NetworkNotIn applies the NotIn predicate on the "network" field.
+func NetworkNotIn(vs ...string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldNotIn(FieldNetwork, vs...))
+}
+
+// NetworkGT applies the GT predicate on the "network" field.
+func NetworkGT(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldGT(FieldNetwork, v))
+}
+
+// NetworkGTE applies the GTE predicate on the "network" field.
+func NetworkGTE(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldGTE(FieldNetwork, v))
+}
+
+// NetworkLT applies the LT predicate on the "network" field.
+func NetworkLT(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldLT(FieldNetwork, v))
+}
+
+// NetworkLTE applies the LTE predicate on the "network" field.
+func NetworkLTE(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldLTE(FieldNetwork, v))
+}
+
+// NetworkContains applies the Contains predicate on the "network" field.
+func NetworkContains(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldContains(FieldNetwork, v))
+}
+
+// NetworkHasPrefix applies the HasPrefix predicate on the "network" field.
+func NetworkHasPrefix(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldHasPrefix(FieldNetwork, v))
+}
+
+// NetworkHasSuffix applies the HasSuffix predicate on the "network" field.
+func NetworkHasSuffix(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldHasSuffix(FieldNetwork, v))
+}
+
+// NetworkEqualFold applies the EqualFold predicate on the "network" field.
+func NetworkEqualFold(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldEqualFold(FieldNetwork, v))
+}
+
+// NetworkContainsFold applies the ContainsFold predicate on the "network" field.
+func NetworkContainsFold(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldContainsFold(FieldNetwork, v))
+}
+
+// ChainIDEQ applies the EQ predicate on the "chain_id" field.
+func ChainIDEQ(v int64) predicate.Sweep {
+	return predicate.Sweep(sql.FieldEQ(FieldChainID, v))
+}
+
+// ChainIDNEQ applies the NEQ predicate on the "chain_id" field.
+func ChainIDNEQ(v int64) predicate.Sweep {
+	return predicate.Sweep(sql.FieldNEQ(FieldChainID, v))
+}
+
+// ChainIDIn applies the In predicate on the "chain_id" field.
+func ChainIDIn(vs ...int64) predicate.Sweep {
+	return predicate.Sweep(sql.FieldIn(FieldChainID, vs...))
+}
+
+// ChainIDNotIn applies the NotIn predicate on the "chain_id" field.
+func ChainIDNotIn(vs ...int64) predicate.Sweep {
+	return predicate.Sweep(sql.FieldNotIn(FieldChainID, vs...))
+}
+
+// ChainIDGT applies the GT predicate on the "chain_id" field.
+func ChainIDGT(v int64) predicate.Sweep {
+	return predicate.Sweep(sql.FieldGT(FieldChainID, v))
+}
+
+// ChainIDGTE applies the GTE predicate on the "chain_id" field.
+func ChainIDGTE(v int64) predicate.Sweep {
+	return predicate.Sweep(sql.FieldGTE(FieldChainID, v))
+}
+
+// ChainIDLT applies the LT predicate on the "chain_id" field.
+func ChainIDLT(v int64) predicate.Sweep {
+	return predicate.Sweep(sql.FieldLT(FieldChainID, v))
+}
+
+// ChainIDLTE applies the LTE predicate on the "chain_id" field.
+func ChainIDLTE(v int64) predicate.Sweep {
+	return predicate.Sweep(sql.FieldLTE(FieldChainID, v))
+}
+
+// TokenAddressEQ applies the EQ predicate on the "token_address" field.
+func TokenAddressEQ(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldEQ(FieldTokenAddress, v))
+}
+
+// TokenAddressNEQ applies the NEQ predicate on the "token_address" field.
+func TokenAddressNEQ(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldNEQ(FieldTokenAddress, v))
+}
+
+// TokenAddressIn applies the In predicate on the "token_address" field.
+func TokenAddressIn(vs ...string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldIn(FieldTokenAddress, vs...))
+}
+
+// TokenAddressNotIn applies the NotIn predicate on the "token_address" field.
+func TokenAddressNotIn(vs ...string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldNotIn(FieldTokenAddress, vs...))
+}
+
+// TokenAddressGT applies the GT predicate on the "token_address" field.
+func TokenAddressGT(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldGT(FieldTokenAddress, v))
+}
+
+// TokenAddressGTE applies the GTE predicate on the "token_address" field.
+func TokenAddressGTE(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldGTE(FieldTokenAddress, v))
+}
+
+// TokenAddressLT applies the LT predicate on the "token_address" field.
+func TokenAddressLT(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldLT(FieldTokenAddress, v))
+}
+
+// TokenAddressLTE applies the LTE predicate on the "token_address" field.
+func TokenAddressLTE(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldLTE(FieldTokenAddress, v))
+}
+
+// TokenAddressContains applies the Contains predicate on the "token_address" field.
+func TokenAddressContains(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldContains(FieldTokenAddress, v))
+}
+
+// TokenAddressHasPrefix applies the HasPrefix predicate on the "token_address" field.
+func TokenAddressHasPrefix(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldHasPrefix(FieldTokenAddress, v))
+}
+
+// TokenAddressHasSuffix applies the HasSuffix predicate on the "token_address" field.
+func TokenAddressHasSuffix(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldHasSuffix(FieldTokenAddress, v))
+}
+
+// TokenAddressEqualFold applies the EqualFold predicate on the "token_address" field.
+func TokenAddressEqualFold(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldEqualFold(FieldTokenAddress, v))
+}
+
+// TokenAddressContainsFold applies the ContainsFold predicate on the "token_address" field.
+func TokenAddressContainsFold(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldContainsFold(FieldTokenAddress, v))
+}
+
+// FromAddressEQ applies the EQ predicate on the "from_address" field.
+func FromAddressEQ(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldEQ(FieldFromAddress, v))
+}
+
+// FromAddressNEQ applies the NEQ predicate on the "from_address" field.
+func FromAddressNEQ(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldNEQ(FieldFromAddress, v))
+}
+
+// FromAddressIn applies the In predicate on the "from_address" field.
+func FromAddressIn(vs ...string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldIn(FieldFromAddress, vs...))
+}
+
+// FromAddressNotIn applies the NotIn predicate on the "from_address" field.
+func FromAddressNotIn(vs ...string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldNotIn(FieldFromAddress, vs...))
+}
+
+// FromAddressGT applies the GT predicate on the "from_address" field.
+func FromAddressGT(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldGT(FieldFromAddress, v))
+}
+
+// FromAddressGTE applies the GTE predicate on the "from_address" field.
+func FromAddressGTE(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldGTE(FieldFromAddress, v))
+}
+
+// FromAddressLT applies the LT predicate on the "from_address" field.
+func FromAddressLT(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldLT(FieldFromAddress, v))
+}
+
+// FromAddressLTE applies the LTE predicate on the "from_address" field.
+func FromAddressLTE(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldLTE(FieldFromAddress, v))
+}
+
+// FromAddressContains applies the Contains predicate on the "from_address" field.
+func FromAddressContains(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldContains(FieldFromAddress, v))
+}
+
+// FromAddressHasPrefix applies the HasPrefix predicate on the "from_address" field.
+func FromAddressHasPrefix(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldHasPrefix(FieldFromAddress, v))
+}
+
+// FromAddressHasSuffix applies the HasSuffix predicate on the "from_address" field.
+func FromAddressHasSuffix(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldHasSuffix(FieldFromAddress, v))
+}
+
+// FromAddressEqualFold applies the EqualFold predicate on the "from_address" field.
+func FromAddressEqualFold(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldEqualFold(FieldFromAddress, v))
+}
+
+// FromAddressContainsFold applies the ContainsFold predicate on the "from_address" field.
+func FromAddressContainsFold(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldContainsFold(FieldFromAddress, v))
+}
+
+// ToAddressEQ applies the EQ predicate on the "to_address" field.
+func ToAddressEQ(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldEQ(FieldToAddress, v))
+}
+
+// ToAddressNEQ applies the NEQ predicate on the "to_address" field.
+func ToAddressNEQ(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldNEQ(FieldToAddress, v))
+}
+
+// ToAddressIn applies the In predicate on the "to_address" field.
+func ToAddressIn(vs ...string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldIn(FieldToAddress, vs...))
+}
+
+// ToAddressNotIn applies the NotIn predicate on the "to_address" field.
+func ToAddressNotIn(vs ...string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldNotIn(FieldToAddress, vs...))
+}
+
+// ToAddressGT applies the GT predicate on the "to_address" field.
+func ToAddressGT(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldGT(FieldToAddress, v))
+}
+
+// ToAddressGTE applies the GTE predicate on the "to_address" field.
+func ToAddressGTE(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldGTE(FieldToAddress, v))
+}
+
+// ToAddressLT applies the LT predicate on the "to_address" field.
+func ToAddressLT(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldLT(FieldToAddress, v))
+}
+
+// ToAddressLTE applies the LTE predicate on the "to_address" field.
+func ToAddressLTE(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldLTE(FieldToAddress, v))
+}
+
+// ToAddressContains applies the Contains predicate on the "to_address" field.
+func ToAddressContains(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldContains(FieldToAddress, v))
+}
+
+// ToAddressHasPrefix applies the HasPrefix predicate on the "to_address" field.
+func ToAddressHasPrefix(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldHasPrefix(FieldToAddress, v))
+}
+
+// ToAddressHasSuffix applies the HasSuffix predicate on the "to_address" field.
+func ToAddressHasSuffix(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldHasSuffix(FieldToAddress, v))
+}
+
+// ToAddressEqualFold applies the EqualFold predicate on the "to_address" field.
+func ToAddressEqualFold(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldEqualFold(FieldToAddress, v))
+}
+
+// ToAddressContainsFold applies the ContainsFold predicate on the "to_address" field.
+func ToAddressContainsFold(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldContainsFold(FieldToAddress, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v decimal.Decimal) predicate.Sweep {
+	return predicate.Sweep(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v decimal.Decimal) predicate.Sweep {
+	return predicate.Sweep(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...decimal.Decimal) predicate.Sweep {
+	return predicate.Sweep(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...decimal.Decimal) predicate.Sweep {
+	return predicate.Sweep(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v decimal.Decimal) predicate.Sweep {
+	return predicate.Sweep(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v decimal.Decimal) predicate.Sweep {
+	return predicate.Sweep(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v decimal.Decimal) predicate.Sweep {
+	return predicate.Sweep(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v decimal.Decimal) predicate.Sweep {
+	return predicate.Sweep(sql.FieldLTE(FieldAmount, v))
+}
+
+// UserOperationIsNil applies the IsNil predicate on the "user_operation" field.
+func UserOperationIsNil() predicate.Sweep {
+	return predicate.Sweep(sql.FieldIsNull(FieldUserOperation))
+}
+
+// UserOperationNotNil applies the NotNil predicate on the "user_operation" field.
+func UserOperationNotNil() predicate.Sweep {
+	return predicate.Sweep(sql.FieldNotNull(FieldUserOperation))
+}
+
+// UserOpHashEQ applies the EQ predicate on the "user_op_hash" field.
+func UserOpHashEQ(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldEQ(FieldUserOpHash, v))
+}
+
+// UserOpHashNEQ applies the NEQ predicate on the "user_op_hash" field.
+func UserOpHashNEQ(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldNEQ(FieldUserOpHash, v))
+}
+
+// UserOpHashIn applies the In predicate on the "user_op_hash" field.
+func UserOpHashIn(vs ...string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldIn(FieldUserOpHash, vs...))
+}
+
+// UserOpHashNotIn applies the NotIn predicate on the "user_op_hash" field.
+func UserOpHashNotIn(vs ...string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldNotIn(FieldUserOpHash, vs...))
+}
+
+// UserOpHashGT applies the GT predicate on the "user_op_hash" field.
+func UserOpHashGT(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldGT(FieldUserOpHash, v))
+}
+
+// UserOpHashGTE applies the GTE predicate on the "user_op_hash" field.
+func UserOpHashGTE(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldGTE(FieldUserOpHash, v))
+}
+
+// UserOpHashLT applies the LT predicate on the "user_op_hash" field.
+func UserOpHashLT(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldLT(FieldUserOpHash, v))
+}
+
+// UserOpHashLTE applies the LTE predicate on the "user_op_hash" field.
+func UserOpHashLTE(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldLTE(FieldUserOpHash, v))
+}
+
+// UserOpHashContains applies the Contains predicate on the "user_op_hash" field.
+func UserOpHashContains(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldContains(FieldUserOpHash, v))
+}
+
+// UserOpHashHasPrefix applies the HasPrefix predicate on the "user_op_hash" field.
+func UserOpHashHasPrefix(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldHasPrefix(FieldUserOpHash, v))
+}
+
+// UserOpHashHasSuffix applies the HasSuffix predicate on the "user_op_hash" field.
+func UserOpHashHasSuffix(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldHasSuffix(FieldUserOpHash, v))
+}
+
+// UserOpHashIsNil applies the IsNil predicate on the "user_op_hash" field.
+func UserOpHashIsNil() predicate.Sweep {
+	return predicate.Sweep(sql.FieldIsNull(FieldUserOpHash))
+}
+
+// UserOpHashNotNil applies the NotNil predicate on the "user_op_hash" field.
+func UserOpHashNotNil() predicate.Sweep {
+	return predicate.Sweep(sql.FieldNotNull(FieldUserOpHash))
+}
+
+// UserOpHashEqualFold applies the EqualFold predicate on the "user_op_hash" field.
+func UserOpHashEqualFold(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldEqualFold(FieldUserOpHash, v))
+}
+
+// UserOpHashContainsFold applies the ContainsFold predicate on the "user_op_hash" field.
+func UserOpHashContainsFold(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldContainsFold(FieldUserOpHash, v))
+}
+
+// TxHashEQ applies the EQ predicate on the "tx_hash" field.
+func TxHashEQ(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldEQ(FieldTxHash, v))
+}
+
+// TxHashNEQ applies the NEQ predicate on the "tx_hash" field.
+func TxHashNEQ(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldNEQ(FieldTxHash, v))
+}
+
+// TxHashIn applies the In predicate on the "tx_hash" field.
+func TxHashIn(vs ...string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldIn(FieldTxHash, vs...))
+}
+
+// TxHashNotIn applies the NotIn predicate on the "tx_hash" field.
+func TxHashNotIn(vs ...string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldNotIn(FieldTxHash, vs...))
+}
+
+// TxHashGT applies the GT predicate on the "tx_hash" field.
+func TxHashGT(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldGT(FieldTxHash, v))
+}
+
+// TxHashGTE applies the GTE predicate on the "tx_hash" field.
+func TxHashGTE(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldGTE(FieldTxHash, v))
+}
+
+// TxHashLT applies the LT predicate on the "tx_hash" field.
+func TxHashLT(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldLT(FieldTxHash, v))
+}
+
+// TxHashLTE applies the LTE predicate on the "tx_hash" field.
+func TxHashLTE(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldLTE(FieldTxHash, v))
+}
+
+// TxHashContains applies the Contains predicate on the "tx_hash" field.
+func TxHashContains(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldContains(FieldTxHash, v))
+}
+
+// TxHashHasPrefix applies the HasPrefix predicate on the "tx_hash" field.
+func TxHashHasPrefix(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldHasPrefix(FieldTxHash, v))
+}
+
+// TxHashHasSuffix applies the HasSuffix predicate on the "tx_hash" field.
+func TxHashHasSuffix(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldHasSuffix(FieldTxHash, v))
+}
+
+// TxHashIsNil applies the IsNil predicate on the "tx_hash" field.
+func TxHashIsNil() predicate.Sweep {
+	return predicate.Sweep(sql.FieldIsNull(FieldTxHash))
+}
+
+// TxHashNotNil applies the NotNil predicate on the "tx_hash" field.
+func TxHashNotNil() predicate.Sweep {
+	return predicate.Sweep(sql.FieldNotNull(FieldTxHash))
+}
+
+// TxHashEqualFold applies the EqualFold predicate on the "tx_hash" field.
+func TxHashEqualFold(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldEqualFold(FieldTxHash, v))
+}
+
+// TxHashContainsFold applies the ContainsFold predicate on the "tx_hash" field.
+func TxHashContainsFold(v string) predicate.Sweep {
+	return predicate.Sweep(sql.FieldContainsFold(FieldTxHash, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Sweep {
+	return predicate.Sweep(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Sweep {
+	return predicate.Sweep(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Sweep {
+	return predicate.Sweep(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Sweep {
+	return predicate.Sweep(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// SubmittedAtEQ applies the EQ predicate on the "submitted_at" field.
+func SubmittedAtEQ(v time.Time) predicate.Sweep {
+	return predicate.Sweep(sql.FieldEQ(FieldSubmittedAt, v))
+}
+
+// SubmittedAtNEQ applies the NEQ predicate on the "submitted_at" field.
+func SubmittedAtNEQ(v time.Time) predicate.Sweep {
+	return predicate.Sweep(sql.FieldNEQ(FieldSubmittedAt, v))
+}
+
+// SubmittedAtIn applies the In predicate on the "submitted_at" field.
+func SubmittedAtIn(vs ...time.Time) predicate.Sweep {
+	return predicate.Sweep(sql.FieldIn(FieldSubmittedAt, vs...))
+}
+
+// SubmittedAtNotIn applies the NotIn predicate on the "submitted_at" field.
+func SubmittedAtNotIn(vs ...time.Time) predicate.Sweep {
+	return predicate.Sweep(sql.FieldNotIn(FieldSubmittedAt, vs...))
+}
+
+// SubmittedAtGT applies the GT predicate on the "submitted_at" field.
+func SubmittedAtGT(v time.Time) predicate.Sweep {
+	return predicate.Sweep(sql.FieldGT(FieldSubmittedAt, v))
+}
+
+// SubmittedAtGTE applies the GTE predicate on the "submitted_at" field.
+func SubmittedAtGTE(v time.Time) predicate.Sweep {
+	return predicate.Sweep(sql.FieldGTE(FieldSubmittedAt, v))
+}
+
+// SubmittedAtLT applies the LT predicate on the "submitted_at" field.
+func SubmittedAtLT(v time.Time) predicate.Sweep {
+	return predicate.Sweep(sql.FieldLT(FieldSubmittedAt, v))
+}
+
+// SubmittedAtLTE applies the LTE predicate on the "submitted_at" field.
+func SubmittedAtLTE(v time.Time) predicate.Sweep {
+	return predicate.Sweep(sql.FieldLTE(FieldSubmittedAt, v))
+}
+
+// SubmittedAtIsNil applies the IsNil predicate on the "submitted_at" field.
+func SubmittedAtIsNil() predicate.Sweep {
+	return predicate.Sweep(sql.FieldIsNull(FieldSubmittedAt))
+}
+
+// SubmittedAtNotNil applies the NotNil predicate on the "submitted_at" field.
+func SubmittedAtNotNil() predicate.Sweep {
+	return predicate.Sweep(sql.FieldNotNull(FieldSubmittedAt))
+}
+
+// HasUnmatchedDeposit applies the HasEdge predicate on the "unmatched_deposit" edge.
+func HasUnmatchedDeposit() predicate.Sweep {
+	return predicate.Sweep(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, UnmatchedDepositTable, UnmatchedDepositColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUnmatchedDepositWith applies the HasEdge predicate on the "unmatched_deposit" edge with a given conditions (other predicates).
+func HasUnmatchedDepositWith(preds ...predicate.UnmatchedDeposit) predicate.Sweep {
+	return predicate.Sweep(func(s *sql.Selector) {
+		step := newUnmatchedDepositStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Sweep) predicate.Sweep {
+	return predicate.Sweep(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Sweep) predicate.Sweep {
+	return predicate.Sweep(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Sweep) predicate.Sweep {
+	return predicate.Sweep(sql.NotPredicates(p))
+}
@@ -0,0 +1,1331 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/sweep"
+	"github.com/NEDA-LABS/stablenode/ent/unmatcheddeposit"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// SweepCreate is the builder for creating a Sweep entity.
+type SweepCreate struct {
+	config
+	mutation *SweepMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sc *SweepCreate) SetCreatedAt(t time.Time) *SweepCreate {
+	sc.mutation.SetCreatedAt(t)
+	return sc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sc *SweepCreate) SetNillableCreatedAt(t *time.Time) *SweepCreate {
+	if t != nil {
+		sc.SetCreatedAt(*t)
+	}
+	return sc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sc *SweepCreate) SetUpdatedAt(t time.Time) *SweepCreate {
+	sc.mutation.SetUpdatedAt(t)
+	return sc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sc *SweepCreate) SetNillableUpdatedAt(t *time.Time) *SweepCreate {
+	if t != nil {
+		sc.SetUpdatedAt(*t)
+	}
+	return sc
+}
+
+// SetNetwork sets the "network" field.
+func (sc *SweepCreate) SetNetwork(s string) *SweepCreate {
+	sc.mutation.SetNetwork(s)
+	return sc
+}
+
+// SetChainID sets the "chain_id" field.
+func (sc *SweepCreate) SetChainID(i int64) *SweepCreate {
+	sc.mutation.SetChainID(i)
+	return sc
+}
+
+// SetTokenAddress sets the "token_address" field.
+func (sc *SweepCreate) SetTokenAddress(s string) *SweepCreate {
+	sc.mutation.SetTokenAddress(s)
+	return sc
+}
+
+// SetFromAddress sets the "from_address" field.
+func (sc *SweepCreate) SetFromAddress(s string) *SweepCreate {
+	sc.mutation.SetFromAddress(s)
+	return sc
+}
+
+// SetToAddress sets the "to_address" field.
+func (sc *SweepCreate) SetToAddress(s string) *SweepCreate {
+	sc.mutation.SetToAddress(s)
+	return sc
+}
+
+// SetAmount sets the "amount" field.
+func (sc *SweepCreate) SetAmount(d decimal.Decimal) *SweepCreate {
+	sc.mutation.SetAmount(d)
+	return sc
+}
+
+// SetUserOperation sets the "user_operation" field.
+func (sc *SweepCreate) SetUserOperation(m map[string]interface{}) *SweepCreate {
+	sc.mutation.SetUserOperation(m)
+	return sc
+}
+
+// SetUserOpHash sets the "user_op_hash" field.
+func (sc *SweepCreate) SetUserOpHash(s string) *SweepCreate {
+	sc.mutation.SetUserOpHash(s)
+	return sc
+}
+
+// SetNillableUserOpHash sets the "user_op_hash" field if the given value is not nil.
+func (sc *SweepCreate) SetNillableUserOpHash(s *string) *SweepCreate {
+	if s != nil {
+		sc.SetUserOpHash(*s)
+	}
+	return sc
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (sc *SweepCreate) SetTxHash(s string) *SweepCreate {
+	sc.mutation.SetTxHash(s)
+	return sc
+}
+
+// SetNillableTxHash sets the "tx_hash" field if the given value is not nil.
+func (sc *SweepCreate) SetNillableTxHash(s *string) *SweepCreate {
+	if s != nil {
+		sc.SetTxHash(*s)
+	}
+	return sc
+}
+
+// SetStatus sets the "status" field.
+func (sc *SweepCreate) SetStatus(s sweep.Status) *SweepCreate {
+	sc.mutation.SetStatus(s)
+	return sc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sc *SweepCreate) SetNillableStatus(s *sweep.Status) *SweepCreate {
+	if s != nil {
+		sc.SetStatus(*s)
+	}
+	return sc
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (sc *SweepCreate) SetSubmittedAt(t time.Time) *SweepCreate {
+	sc.mutation.SetSubmittedAt(t)
+	return sc
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (sc *SweepCreate) SetNillableSubmittedAt(t *time.Time) *SweepCreate {
+	if t != nil {
+		sc.SetSubmittedAt(*t)
+	}
+	return sc
+}
+
+// SetID sets the "id" field.
+func (sc *SweepCreate) SetID(u uuid.UUID) *SweepCreate {
+	sc.mutation.SetID(u)
+	return sc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (sc *SweepCreate) SetNillableID(u *uuid.UUID) *SweepCreate {
+	if u != nil {
+		sc.SetID(*u)
+	}
+	return sc
+}
+
+// SetUnmatchedDepositID sets the "unmatched_deposit" edge to the UnmatchedDeposit entity by ID.
+func (sc *SweepCreate) SetUnmatchedDepositID(id uuid.UUID) *SweepCreate {
+	sc.mutation.SetUnmatchedDepositID(id)
+	return sc
+}
+
+// SetNillableUnmatchedDepositID sets the "unmatched_deposit" edge to the UnmatchedDeposit entity by ID if the given value is not nil.
+func (sc *SweepCreate) SetNillableUnmatchedDepositID(id *uuid.UUID) *SweepCreate {
+	if id != nil {
+		sc = sc.SetUnmatchedDepositID(*id)
+	}
+	return sc
+}
+
+// SetUnmatchedDeposit sets the "unmatched_deposit" edge to the UnmatchedDeposit entity.
+func (sc *SweepCreate) SetUnmatchedDeposit(u *UnmatchedDeposit) *SweepCreate {
+	return sc.SetUnmatchedDepositID(u.ID)
+}
+
+// Mutation returns the SweepMutation object of the builder.
+func (sc *SweepCreate) Mutation() *SweepMutation {
+	return sc.mutation
+}
+
+// Save creates the Sweep in the database.
+func (sc *SweepCreate) Save(ctx context.Context) (*Sweep, error) {
+	sc.defaults()
+	return withHooks(ctx, sc.sqlSave, sc.mutation, sc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sc *SweepCreate) SaveX(ctx context.Context) *Sweep {
+	v, err := sc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sc *SweepCreate) Exec(ctx context.Context) error {
+	_, err := sc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sc *SweepCreate) ExecX(ctx context.Context) {
+	if err := sc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sc *SweepCreate) defaults() {
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		v := sweep.DefaultCreatedAt()
+		sc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		v := sweep.DefaultUpdatedAt()
+		sc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := sc.mutation.Status(); !ok {
+		v := sweep.DefaultStatus
+		sc.mutation.SetStatus(v)
+	}
+	if _, ok := sc.mutation.ID(); !ok {
+		v := sweep.DefaultID()
+		sc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sc *SweepCreate) check() error {
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Sweep.created_at"`)}
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Sweep.updated_at"`)}
+	}
+	if _, ok := sc.mutation.Network(); !ok {
+		return &ValidationError{Name: "network", err: errors.New(`ent: missing required field "Sweep.network"`)}
+	}
+	if _, ok := sc.mutation.ChainID(); !ok {
+		return &ValidationError{Name: "chain_id", err: errors.New(`ent: missing required field "Sweep.chain_id"`)}
+	}
+	if _, ok := sc.mutation.TokenAddress(); !ok {
+		return &ValidationError{Name: "token_address", err: errors.New(`ent: missing required field "Sweep.token_address"`)}
+	}
+	if _, ok := sc.mutation.FromAddress(); !ok {
+		return &ValidationError{Name: "from_address", err: errors.New(`ent: missing required field "Sweep.from_address"`)}
+	}
+	if _, ok := sc.mutation.ToAddress(); !ok {
+		return &ValidationError{Name: "to_address", err: errors.New(`ent: missing required field "Sweep.to_address"`)}
+	}
+	if _, ok := sc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "Sweep.amount"`)}
+	}
+	if v, ok := sc.mutation.UserOpHash(); ok {
+		if err := sweep.UserOpHashValidator(v); err != nil {
+			return &ValidationError{Name: "user_op_hash", err: fmt.Errorf(`ent: validator failed for field "Sweep.user_op_hash": %w`, err)}
+		}
+	}
+	if v, ok := sc.mutation.TxHash(); ok {
+		if err := sweep.TxHashValidator(v); err != nil {
+			return &ValidationError{Name: "tx_hash", err: fmt.Errorf(`ent: validator failed for field "Sweep.tx_hash": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Sweep.status"`)}
+	}
+	if v, ok := sc.mutation.Status(); ok {
+		if err := sweep.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Sweep.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (sc *SweepCreate) sqlSave(ctx context.Context) (*Sweep, error) {
+	if err := sc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	sc.mutation.id = &_node.ID
+	sc.mutation.done = true
+	return _node, nil
+}
+
+func (sc *SweepCreate) createSpec() (*Sweep, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Sweep{config: sc.config}
+		_spec = sqlgraph.NewCreateSpec(sweep.Table, sqlgraph.NewFieldSpec(sweep.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = sc.conflict
+	if id, ok := sc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := sc.mutation.CreatedAt(); ok {
+		_spec.SetField(sweep.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := sc.mutation.UpdatedAt(); ok {
+		_spec.SetField(sweep.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := sc.mutation.Network(); ok {
+		_spec.SetField(sweep.FieldNetwork, field.TypeString, value)
+		_node.Network = value
+	}
+	if value, ok := sc.mutation.ChainID(); ok {
+		_spec.SetField(sweep.FieldChainID, field.TypeInt64, value)
+		_node.ChainID = value
+	}
+	if value, ok := sc.mutation.TokenAddress(); ok {
+		_spec.SetField(sweep.FieldTokenAddress, field.TypeString, value)
+		_node.TokenAddress = value
+	}
+	if value, ok := sc.mutation.FromAddress(); ok {
+		_spec.SetField(sweep.FieldFromAddress, field.TypeString, value)
+		_node.FromAddress = value
+	}
+	if value, ok := sc.mutation.ToAddress(); ok {
+		_spec.SetField(sweep.FieldToAddress, field.TypeString, value)
+		_node.ToAddress = value
+	}
+	if value, ok := sc.mutation.Amount(); ok {
+		_spec.SetField(sweep.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := sc.mutation.UserOperation(); ok {
+		_spec.SetField(sweep.FieldUserOperation, field.TypeJSON, value)
+		_node.UserOperation = value
+	}
+	if value, ok := sc.mutation.UserOpHash(); ok {
+		_spec.SetField(sweep.FieldUserOpHash, field.TypeString, value)
+		_node.UserOpHash = value
+	}
+	if value, ok := sc.mutation.TxHash(); ok {
+		_spec.SetField(sweep.FieldTxHash, field.TypeString, value)
+		_node.TxHash = value
+	}
+	if value, ok := sc.mutation.Status(); ok {
+		_spec.SetField(sweep.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := sc.mutation.SubmittedAt(); ok {
+		_spec.SetField(sweep.FieldSubmittedAt, field.TypeTime, value)
+		_node.SubmittedAt = value
+	}
+	if nodes := sc.mutation.UnmatchedDepositIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   sweep.UnmatchedDepositTable,
+			Columns: []string{sweep.UnmatchedDepositColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(unmatcheddeposit.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Sweep.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SweepUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (sc *SweepCreate) OnConflict(opts ...sql.ConflictOption) *SweepUpsertOne {
+	sc.conflict = opts
+	return &SweepUpsertOne{
+		create: sc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Sweep.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sc *SweepCreate) OnConflictColumns(columns ...string) *SweepUpsertOne {
+	sc.conflict = append(sc.conflict, sql.ConflictColumns(columns...))
+	return &SweepUpsertOne{
+		create: sc,
+	}
+}
+
+type (
+	// SweepUpsertOne is the builder for "upsert"-ing
+	//  one Sweep node.
+	SweepUpsertOne struct {
+		create *SweepCreate
+	}
+
+	// SweepUpsert is the "OnConflict" setter.
+	SweepUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SweepUpsert) SetUpdatedAt(v time.Time) *SweepUpsert {
+	u.Set(sweep.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SweepUpsert) UpdateUpdatedAt() *SweepUpsert {
+	u.SetExcluded(sweep.FieldUpdatedAt)
+	return u
+}
+
+// SetNetwork sets the "network" field.
+func (u *SweepUpsert) SetNetwork(v string) *SweepUpsert {
+	u.Set(sweep.FieldNetwork, v)
+	return u
+}
+
+// UpdateNetwork sets the "network" field to the value that was provided on create.
+func (u *SweepUpsert) UpdateNetwork() *SweepUpsert {
+	u.SetExcluded(sweep.FieldNetwork)
+	return u
+}
+
+// SetChainID sets the "chain_id" field.
+func (u *SweepUpsert) SetChainID(v int64) *SweepUpsert {
+	u.Set(sweep.FieldChainID, v)
+	return u
+}
+
+// UpdateChainID sets the "chain_id" field to the value that was provided on create.
+func (u *SweepUpsert) UpdateChainID() *SweepUpsert {
+	u.SetExcluded(sweep.FieldChainID)
+	return u
+}
+
+// AddChainID adds v to the "chain_id" field.
+func (u *SweepUpsert) AddChainID(v int64) *SweepUpsert {
+	u.Add(sweep.FieldChainID, v)
+	return u
+}
+
+// SetTokenAddress sets the "token_address" field.
+func (u *SweepUpsert) SetTokenAddress(v string) *SweepUpsert {
+	u.Set(sweep.FieldTokenAddress, v)
+	return u
+}
+
+// UpdateTokenAddress sets the "token_address" field to the value that was provided on create.
+func (u *SweepUpsert) UpdateTokenAddress() *SweepUpsert {
+	u.SetExcluded(sweep.FieldTokenAddress)
+	return u
+}
+
+// SetFromAddress sets the "from_address" field.
+func (u *SweepUpsert) SetFromAddress(v string) *SweepUpsert {
+	u.Set(sweep.FieldFromAddress, v)
+	return u
+}
+
+// UpdateFromAddress sets the "from_address" field to the value that was provided on create.
+func (u *SweepUpsert) UpdateFromAddress() *SweepUpsert {
+	u.SetExcluded(sweep.FieldFromAddress)
+	return u
+}
+
+// SetToAddress sets the "to_address" field.
+func (u *SweepUpsert) SetToAddress(v string) *SweepUpsert {
+	u.Set(sweep.FieldToAddress, v)
+	return u
+}
+
+// UpdateToAddress sets the "to_address" field to the value that was provided on create.
+func (u *SweepUpsert) UpdateToAddress() *SweepUpsert {
+	u.SetExcluded(sweep.FieldToAddress)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *SweepUpsert) SetAmount(v decimal.Decimal) *SweepUpsert {
+	u.Set(sweep.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *SweepUpsert) UpdateAmount() *SweepUpsert {
+	u.SetExcluded(sweep.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *SweepUpsert) AddAmount(v decimal.Decimal) *SweepUpsert {
+	u.Add(sweep.FieldAmount, v)
+	return u
+}
+
+// SetUserOperation sets the "user_operation" field.
+func (u *SweepUpsert) SetUserOperation(v map[string]interface{}) *SweepUpsert {
+	u.Set(sweep.FieldUserOperation, v)
+	return u
+}
+
+// UpdateUserOperation sets the "user_operation" field to the value that was provided on create.
+func (u *SweepUpsert) UpdateUserOperation() *SweepUpsert {
+	u.SetExcluded(sweep.FieldUserOperation)
+	return u
+}
+
+// ClearUserOperation clears the value of the "user_operation" field.
+func (u *SweepUpsert) ClearUserOperation() *SweepUpsert {
+	u.SetNull(sweep.FieldUserOperation)
+	return u
+}
+
+// SetUserOpHash sets the "user_op_hash" field.
+func (u *SweepUpsert) SetUserOpHash(v string) *SweepUpsert {
+	u.Set(sweep.FieldUserOpHash, v)
+	return u
+}
+
+// UpdateUserOpHash sets the "user_op_hash" field to the value that was provided on create.
+func (u *SweepUpsert) UpdateUserOpHash() *SweepUpsert {
+	u.SetExcluded(sweep.FieldUserOpHash)
+	return u
+}
+
+// ClearUserOpHash clears the value of the "user_op_hash" field.
+func (u *SweepUpsert) ClearUserOpHash() *SweepUpsert {
+	u.SetNull(sweep.FieldUserOpHash)
+	return u
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (u *SweepUpsert) SetTxHash(v string) *SweepUpsert {
+	u.Set(sweep.FieldTxHash, v)
+	return u
+}
+
+// UpdateTxHash sets the "tx_hash" field to the value that was provided on create.
+func (u *SweepUpsert) UpdateTxHash() *SweepUpsert {
+	u.SetExcluded(sweep.FieldTxHash)
+	return u
+}
+
+// ClearTxHash clears the value of the "tx_hash" field.
+func (u *SweepUpsert) ClearTxHash() *SweepUpsert {
+	u.SetNull(sweep.FieldTxHash)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *SweepUpsert) SetStatus(v sweep.Status) *SweepUpsert {
+	u.Set(sweep.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SweepUpsert) UpdateStatus() *SweepUpsert {
+	u.SetExcluded(sweep.FieldStatus)
+	return u
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (u *SweepUpsert) SetSubmittedAt(v time.Time) *SweepUpsert {
+	u.Set(sweep.FieldSubmittedAt, v)
+	return u
+}
+
+// UpdateSubmittedAt sets the "submitted_at" field to the value that was provided on create.
+func (u *SweepUpsert) UpdateSubmittedAt() *SweepUpsert {
+	u.SetExcluded(sweep.FieldSubmittedAt)
+	return u
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (u *SweepUpsert) ClearSubmittedAt() *SweepUpsert {
+	u.SetNull(sweep.FieldSubmittedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Sweep.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(sweep.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SweepUpsertOne) UpdateNewValues() *SweepUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(sweep.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(sweep.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Sweep.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SweepUpsertOne) Ignore() *SweepUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SweepUpsertOne) DoNothing() *SweepUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SweepCreate.OnConflict
+// documentation for more info.
+func (u *SweepUpsertOne) Update(set func(*SweepUpsert)) *SweepUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SweepUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SweepUpsertOne) SetUpdatedAt(v time.Time) *SweepUpsertOne {
+	return u.Update(func(s *SweepUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SweepUpsertOne) UpdateUpdatedAt() *SweepUpsertOne {
+	return u.Update(func(s *SweepUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetNetwork sets the "network" field.
+func (u *SweepUpsertOne) SetNetwork(v string) *SweepUpsertOne {
+	return u.Update(func(s *SweepUpsert) {
+		s.SetNetwork(v)
+	})
+}
+
+// UpdateNetwork sets the "network" field to the value that was provided on create.
+func (u *SweepUpsertOne) UpdateNetwork() *SweepUpsertOne {
+	return u.Update(func(s *SweepUpsert) {
+		s.UpdateNetwork()
+	})
+}
+
+// SetChainID sets the "chain_id" field.
+func (u *SweepUpsertOne) SetChainID(v int64) *SweepUpsertOne {
+	return u.Update(func(s *SweepUpsert) {
+		s.SetChainID(v)
+	})
+}
+
+// AddChainID adds v to the "chain_id" field.
+func (u *SweepUpsertOne) AddChainID(v int64) *SweepUpsertOne {
+	return u.Update(func(s *SweepUpsert) {
+		s.AddChainID(v)
+	})
+}
+
+// UpdateChainID sets the "chain_id" field to the value that was provided on create.
+func (u *SweepUpsertOne) UpdateChainID() *SweepUpsertOne {
+	return u.Update(func(s *SweepUpsert) {
+		s.UpdateChainID()
+	})
+}
+
+// SetTokenAddress sets the "token_address" field.
+func (u *SweepUpsertOne) SetTokenAddress(v string) *SweepUpsertOne {
+	return u.Update(func(s *SweepUpsert) {
+		s.SetTokenAddress(v)
+	})
+}
+
+// UpdateTokenAddress sets the "token_address" field to the value that was provided on create.
+func (u *SweepUpsertOne) UpdateTokenAddress() *SweepUpsertOne {
+	return u.Update(func(s *SweepUpsert) {
+		s.UpdateTokenAddress()
+	})
+}
+
+// SetFromAddress sets the "from_address" field.
+func (u *SweepUpsertOne) SetFromAddress(v string) *SweepUpsertOne {
+	return u.Update(func(s *SweepUpsert) {
+		s.SetFromAddress(v)
+	})
+}
+
+// UpdateFromAddress sets the "from_address" field to the value that was provided on create.
+func (u *SweepUpsertOne) UpdateFromAddress() *SweepUpsertOne {
+	return u.Update(func(s *SweepUpsert) {
+		s.UpdateFromAddress()
+	})
+}
+
+// SetToAddress sets the "to_address" field.
+func (u *SweepUpsertOne) SetToAddress(v string) *SweepUpsertOne {
+	return u.Update(func(s *SweepUpsert) {
+		s.SetToAddress(v)
+	})
+}
+
+// UpdateToAddress sets the "to_address" field to the value that was provided on create.
+func (u *SweepUpsertOne) UpdateToAddress() *SweepUpsertOne {
+	return u.Update(func(s *SweepUpsert) {
+		s.UpdateToAddress()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *SweepUpsertOne) SetAmount(v decimal.Decimal) *SweepUpsertOne {
+	return u.Update(func(s *SweepUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *SweepUpsertOne) AddAmount(v decimal.Decimal) *SweepUpsertOne {
+	return u.Update(func(s *SweepUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *SweepUpsertOne) UpdateAmount() *SweepUpsertOne {
+	return u.Update(func(s *SweepUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetUserOperation sets the "user_operation" field.
+func (u *SweepUpsertOne) SetUserOperation(v map[string]interface{}) *SweepUpsertOne {
+	return u.Update(func(s *SweepUpsert) {
+		s.SetUserOperation(v)
+	})
+}
+
+// UpdateUserOperation sets the "user_operation" field to the value that was provided on create.
+func (u *SweepUpsertOne) UpdateUserOperation() *SweepUpsertOne {
+	return u.Update(func(s *SweepUpsert) {
+		s.UpdateUserOperation()
+	})
+}
+
+// ClearUserOperation clears the value of the "user_operation" field.
+func (u *SweepUpsertOne) ClearUserOperation() *SweepUpsertOne {
+	return u.Update(func(s *SweepUpsert) {
+		s.ClearUserOperation()
+	})
+}
+
+// SetUserOpHash sets the "user_op_hash" field.
+func (u *SweepUpsertOne) SetUserOpHash(v string) *SweepUpsertOne {
+	return u.Update(func(s *SweepUpsert) {
+		s.SetUserOpHash(v)
+	})
+}
+
+// UpdateUserOpHash sets the "user_op_hash" field to the value that was provided on create.
+func (u *SweepUpsertOne) UpdateUserOpHash() *SweepUpsertOne {
+	return u.Update(func(s *SweepUpsert) {
+		s.UpdateUserOpHash()
+	})
+}
+
+// ClearUserOpHash clears the value of the "user_op_hash" field.
+func (u *SweepUpsertOne) ClearUserOpHash() *SweepUpsertOne {
+	return u.Update(func(s *SweepUpsert) {
+		s.ClearUserOpHash()
+	})
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (u *SweepUpsertOne) SetTxHash(v string) *SweepUpsertOne {
+	return u.Update(func(s *SweepUpsert) {
+		s.SetTxHash(v)
+	})
+}
+
+// UpdateTxHash sets the "tx_hash" field to the value that was provided on create.
+func (u *SweepUpsertOne) UpdateTxHash() *SweepUpsertOne {
+	return u.Update(func(s *SweepUpsert) {
+		s.UpdateTxHash()
+	})
+}
+
+// ClearTxHash clears the value of the "tx_hash" field.
+func (u *SweepUpsertOne) ClearTxHash() *SweepUpsertOne {
+	return u.Update(func(s *SweepUpsert) {
+		s.ClearTxHash()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *SweepUpsertOne) SetStatus(v sweep.Status) *SweepUpsertOne {
+	return u.Update(func(s *SweepUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SweepUpsertOne) UpdateStatus() *SweepUpsertOne {
+	return u.Update(func(s *SweepUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (u *SweepUpsertOne) SetSubmittedAt(v time.Time) *SweepUpsertOne {
+	return u.Update(func(s *SweepUpsert) {
+		s.SetSubmittedAt(v)
+	})
+}
+
+// UpdateSubmittedAt sets the "submitted_at" field to the value that was provided on create.
+func (u *SweepUpsertOne) UpdateSubmittedAt() *SweepUpsertOne {
+	return u.Update(func(s *SweepUpsert) {
+		s.UpdateSubmittedAt()
+	})
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (u *SweepUpsertOne) ClearSubmittedAt() *SweepUpsertOne {
+	return u.Update(func(s *SweepUpsert) {
+		s.ClearSubmittedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SweepUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SweepCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SweepUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SweepUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: SweepUpsertOne.ID is not supported by MySQL driver. Use SweepUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SweepUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SweepCreateBulk is the builder for creating many Sweep entities in bulk.
+type SweepCreateBulk struct {
+	config
+	err      error
+	builders []*SweepCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Sweep entities in the database.
+func (scb *SweepCreateBulk) Save(ctx context.Context) ([]*Sweep, error) {
+	if scb.err != nil {
+		return nil, scb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
+	nodes := make([]*Sweep, len(scb.builders))
+	mutators := make([]Mutator, len(scb.builders))
+	for i := range scb.builders {
+		func(i int, root context.Context) {
+			builder := scb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SweepMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = scb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scb *SweepCreateBulk) SaveX(ctx context.Context) []*Sweep {
+	v, err := scb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scb *SweepCreateBulk) Exec(ctx context.Context) error {
+	_, err := scb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scb *SweepCreateBulk) ExecX(ctx context.Context) {
+	if err := scb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Sweep.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SweepUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (scb *SweepCreateBulk) OnConflict(opts ...sql.ConflictOption) *SweepUpsertBulk {
+	scb.conflict = opts
+	return &SweepUpsertBulk{
+		create: scb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Sweep.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (scb *SweepCreateBulk) OnConflictColumns(columns ...string) *SweepUpsertBulk {
+	scb.conflict = append(scb.conflict, sql.ConflictColumns(columns...))
+	return &SweepUpsertBulk{
+		create: scb,
+	}
+}
+
+// SweepUpsertBulk is the builder for "upsert"-ing
+// a bulk of Sweep nodes.
+type SweepUpsertBulk struct {
+	create *SweepCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Sweep.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(sweep.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SweepUpsertBulk) UpdateNewValues() *SweepUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(sweep.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(sweep.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Sweep.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SweepUpsertBulk) Ignore() *SweepUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SweepUpsertBulk) DoNothing() *SweepUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SweepCreateBulk.OnConflict
+// documentation for more info.
+func (u *SweepUpsertBulk) Update(set func(*SweepUpsert)) *SweepUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SweepUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SweepUpsertBulk) SetUpdatedAt(v time.Time) *SweepUpsertBulk {
+	return u.Update(func(s *SweepUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SweepUpsertBulk) UpdateUpdatedAt() *SweepUpsertBulk {
+	return u.Update(func(s *SweepUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetNetwork sets the "network" field.
+func (u *SweepUpsertBulk) SetNetwork(v string) *SweepUpsertBulk {
+	return u.Update(func(s *SweepUpsert) {
+		s.SetNetwork(v)
+	})
+}
+
+// UpdateNetwork sets the "network" field to the value that was provided on create.
+func (u *SweepUpsertBulk) UpdateNetwork() *SweepUpsertBulk {
+	return u.Update(func(s *SweepUpsert) {
+		s.UpdateNetwork()
+	})
+}
+
+// SetChainID sets the "chain_id" field.
+func (u *SweepUpsertBulk) SetChainID(v int64) *SweepUpsertBulk {
+	return u.Update(func(s *SweepUpsert) {
+		s.SetChainID(v)
+	})
+}
+
+// AddChainID adds v to the "chain_id" field.
+func (u *SweepUpsertBulk) AddChainID(v int64) *SweepUpsertBulk {
+	return u.Update(func(s *SweepUpsert) {
+		s.AddChainID(v)
+	})
+}
+
+// UpdateChainID sets the "chain_id" field to the value that was provided on create.
+func (u *SweepUpsertBulk) UpdateChainID() *SweepUpsertBulk {
+	return u.Update(func(s *SweepUpsert) {
+		s.UpdateChainID()
+	})
+}
+
+// SetTokenAddress sets the "token_address" field.
+func (u *SweepUpsertBulk) SetTokenAddress(v string) *SweepUpsertBulk {
+	return u.Update(func(s *SweepUpsert) {
+		s.SetTokenAddress(v)
+	})
+}
+
+// UpdateTokenAddress sets the "token_address" field to the value that was provided on create.
+func (u *SweepUpsertBulk) UpdateTokenAddress() *SweepUpsertBulk {
+	return u.Update(func(s *SweepUpsert) {
+		s.UpdateTokenAddress()
+	})
+}
+
+// SetFromAddress sets the "from_address" field.
+func (u *SweepUpsertBulk) SetFromAddress(v string) *SweepUpsertBulk {
+	return u.Update(func(s *SweepUpsert) {
+		s.SetFromAddress(v)
+	})
+}
+
+// UpdateFromAddress sets the "from_address" field to the value that was provided on create.
+func (u *SweepUpsertBulk) UpdateFromAddress() *SweepUpsertBulk {
+	return u.Update(func(s *SweepUpsert) {
+		s.UpdateFromAddress()
+	})
+}
+
+// SetToAddress sets the "to_address" field.
+func (u *SweepUpsertBulk) SetToAddress(v string) *SweepUpsertBulk {
+	return u.Update(func(s *SweepUpsert) {
+		s.SetToAddress(v)
+	})
+}
+
+// UpdateToAddress sets the "to_address" field to the value that was provided on create.
+func (u *SweepUpsertBulk) UpdateToAddress() *SweepUpsertBulk {
+	return u.Update(func(s *SweepUpsert) {
+		s.UpdateToAddress()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *SweepUpsertBulk) SetAmount(v decimal.Decimal) *SweepUpsertBulk {
+	return u.Update(func(s *SweepUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *SweepUpsertBulk) AddAmount(v decimal.Decimal) *SweepUpsertBulk {
+	return u.Update(func(s *SweepUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *SweepUpsertBulk) UpdateAmount() *SweepUpsertBulk {
+	return u.Update(func(s *SweepUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetUserOperation sets the "user_operation" field.
+func (u *SweepUpsertBulk) SetUserOperation(v map[string]interface{}) *SweepUpsertBulk {
+	return u.Update(func(s *SweepUpsert) {
+		s.SetUserOperation(v)
+	})
+}
+
+// UpdateUserOperation sets the "user_operation" field to the value that was provided on create.
+func (u *SweepUpsertBulk) UpdateUserOperation() *SweepUpsertBulk {
+	return u.Update(func(s *SweepUpsert) {
+		s.UpdateUserOperation()
+	})
+}
+
+// ClearUserOperation clears the value of the "user_operation" field.
+func (u *SweepUpsertBulk) ClearUserOperation() *SweepUpsertBulk {
+	return u.Update(func(s *SweepUpsert) {
+		s.ClearUserOperation()
+	})
+}
+
+// SetUserOpHash sets the "user_op_hash" field.
+func (u *SweepUpsertBulk) SetUserOpHash(v string) *SweepUpsertBulk {
+	return u.Update(func(s *SweepUpsert) {
+		s.SetUserOpHash(v)
+	})
+}
+
+// UpdateUserOpHash sets the "user_op_hash" field to the value that was provided on create.
+func (u *SweepUpsertBulk) UpdateUserOpHash() *SweepUpsertBulk {
+	return u.Update(func(s *SweepUpsert) {
+		s.UpdateUserOpHash()
+	})
+}
+
+// ClearUserOpHash clears the value of the "user_op_hash" field.
+func (u *SweepUpsertBulk) ClearUserOpHash() *SweepUpsertBulk {
+	return u.Update(func(s *SweepUpsert) {
+		s.ClearUserOpHash()
+	})
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (u *SweepUpsertBulk) SetTxHash(v string) *SweepUpsertBulk {
+	return u.Update(func(s *SweepUpsert) {
+		s.SetTxHash(v)
+	})
+}
+
+// UpdateTxHash sets the "tx_hash" field to the value that was provided on create.
+func (u *SweepUpsertBulk) UpdateTxHash() *SweepUpsertBulk {
+	return u.Update(func(s *SweepUpsert) {
+		s.UpdateTxHash()
+	})
+}
+
+// ClearTxHash clears the value of the "tx_hash" field.
+func (u *SweepUpsertBulk) ClearTxHash() *SweepUpsertBulk {
+	return u.Update(func(s *SweepUpsert) {
+		s.ClearTxHash()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *SweepUpsertBulk) SetStatus(v sweep.Status) *SweepUpsertBulk {
+	return u.Update(func(s *SweepUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SweepUpsertBulk) UpdateStatus() *SweepUpsertBulk {
+	return u.Update(func(s *SweepUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (u *SweepUpsertBulk) SetSubmittedAt(v time.Time) *SweepUpsertBulk {
+	return u.Update(func(s *SweepUpsert) {
+		s.SetSubmittedAt(v)
+	})
+}
+
+// UpdateSubmittedAt sets the "submitted_at" field to the value that was provided on create.
+func (u *SweepUpsertBulk) UpdateSubmittedAt() *SweepUpsertBulk {
+	return u.Update(func(s *SweepUpsert) {
+		s.UpdateSubmittedAt()
+	})
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (u *SweepUpsertBulk) ClearSubmittedAt() *SweepUpsertBulk {
+	return u.Update(func(s *SweepUpsert) {
+		s.ClearSubmittedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SweepUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SweepCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SweepCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SweepUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
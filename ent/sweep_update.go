@@ -0,0 +1,839 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/NEDA-LABS/stablenode/ent/sweep"
+	"github.com/NEDA-LABS/stablenode/ent/unmatcheddeposit"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// SweepUpdate is the builder for updating Sweep entities.
+type SweepUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SweepMutation
+}
+
+// Where appends a list predicates to the SweepUpdate builder.
+func (su *SweepUpdate) Where(ps ...predicate.Sweep) *SweepUpdate {
+	su.mutation.Where(ps...)
+	return su
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (su *SweepUpdate) SetUpdatedAt(t time.Time) *SweepUpdate {
+	su.mutation.SetUpdatedAt(t)
+	return su
+}
+
+// SetNetwork sets the "network" field.
+func (su *SweepUpdate) SetNetwork(s string) *SweepUpdate {
+	su.mutation.SetNetwork(s)
+	return su
+}
+
+// SetNillableNetwork sets the "network" field if the given value is not nil.
+func (su *SweepUpdate) SetNillableNetwork(s *string) *SweepUpdate {
+	if s != nil {
+		su.SetNetwork(*s)
+	}
+	return su
+}
+
+// SetChainID sets the "chain_id" field.
+func (su *SweepUpdate) SetChainID(i int64) *SweepUpdate {
+	su.mutation.ResetChainID()
+	su.mutation.SetChainID(i)
+	return su
+}
+
+// SetNillableChainID sets the "chain_id" field if the given value is not nil.
+func (su *SweepUpdate) SetNillableChainID(i *int64) *SweepUpdate {
+	if i != nil {
+		su.SetChainID(*i)
+	}
+	return su
+}
+
+// AddChainID adds i to the "chain_id" field.
+func (su *SweepUpdate) AddChainID(i int64) *SweepUpdate {
+	su.mutation.AddChainID(i)
+	return su
+}
+
+// SetTokenAddress sets the "token_address" field.
+func (su *SweepUpdate) SetTokenAddress(s string) *SweepUpdate {
+	su.mutation.SetTokenAddress(s)
+	return su
+}
+
+// SetNillableTokenAddress sets the "token_address" field if the given value is not nil.
+func (su *SweepUpdate) SetNillableTokenAddress(s *string) *SweepUpdate {
+	if s != nil {
+		su.SetTokenAddress(*s)
+	}
+	return su
+}
+
+// SetFromAddress sets the "from_address" field.
+func (su *SweepUpdate) SetFromAddress(s string) *SweepUpdate {
+	su.mutation.SetFromAddress(s)
+	return su
+}
+
+// SetNillableFromAddress sets the "from_address" field if the given value is not nil.
+func (su *SweepUpdate) SetNillableFromAddress(s *string) *SweepUpdate {
+	if s != nil {
+		su.SetFromAddress(*s)
+	}
+	return su
+}
+
+// SetToAddress sets the "to_address" field.
+func (su *SweepUpdate) SetToAddress(s string) *SweepUpdate {
+	su.mutation.SetToAddress(s)
+	return su
+}
+
+// SetNillableToAddress sets the "to_address" field if the given value is not nil.
+func (su *SweepUpdate) SetNillableToAddress(s *string) *SweepUpdate {
+	if s != nil {
+		su.SetToAddress(*s)
+	}
+	return su
+}
+
+// SetAmount sets the "amount" field.
+func (su *SweepUpdate) SetAmount(d decimal.Decimal) *SweepUpdate {
+	su.mutation.ResetAmount()
+	su.mutation.SetAmount(d)
+	return su
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (su *SweepUpdate) SetNillableAmount(d *decimal.Decimal) *SweepUpdate {
+	if d != nil {
+		su.SetAmount(*d)
+	}
+	return su
+}
+
+// AddAmount adds d to the "amount" field.
+func (su *SweepUpdate) AddAmount(d decimal.Decimal) *SweepUpdate {
+	su.mutation.AddAmount(d)
+	return su
+}
+
+// SetUserOperation sets the "user_operation" field.
+func (su *SweepUpdate) SetUserOperation(m map[string]interface{}) *SweepUpdate {
+	su.mutation.SetUserOperation(m)
+	return su
+}
+
+// ClearUserOperation clears the value of the "user_operation" field.
+func (su *SweepUpdate) ClearUserOperation() *SweepUpdate {
+	su.mutation.ClearUserOperation()
+	return su
+}
+
+// SetUserOpHash sets the "user_op_hash" field.
+func (su *SweepUpdate) SetUserOpHash(s string) *SweepUpdate {
+	su.mutation.SetUserOpHash(s)
+	return su
+}
+
+// SetNillableUserOpHash sets the "user_op_hash" field if the given value is not nil.
+func (su *SweepUpdate) SetNillableUserOpHash(s *string) *SweepUpdate {
+	if s != nil {
+		su.SetUserOpHash(*s)
+	}
+	return su
+}
+
+// ClearUserOpHash clears the value of the "user_op_hash" field.
+func (su *SweepUpdate) ClearUserOpHash() *SweepUpdate {
+	su.mutation.ClearUserOpHash()
+	return su
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (su *SweepUpdate) SetTxHash(s string) *SweepUpdate {
+	su.mutation.SetTxHash(s)
+	return su
+}
+
+// SetNillableTxHash sets the "tx_hash" field if the given value is not nil.
+func (su *SweepUpdate) SetNillableTxHash(s *string) *SweepUpdate {
+	if s != nil {
+		su.SetTxHash(*s)
+	}
+	return su
+}
+
+// ClearTxHash clears the value of the "tx_hash" field.
+func (su *SweepUpdate) ClearTxHash() *SweepUpdate {
+	su.mutation.ClearTxHash()
+	return su
+}
+
+// SetStatus sets the "status" field.
+func (su *SweepUpdate) SetStatus(s sweep.Status) *SweepUpdate {
+	su.mutation.SetStatus(s)
+	return su
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (su *SweepUpdate) SetNillableStatus(s *sweep.Status) *SweepUpdate {
+	if s != nil {
+		su.SetStatus(*s)
+	}
+	return su
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (su *SweepUpdate) SetSubmittedAt(t time.Time) *SweepUpdate {
+	su.mutation.SetSubmittedAt(t)
+	return su
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (su *SweepUpdate) SetNillableSubmittedAt(t *time.Time) *SweepUpdate {
+	if t != nil {
+		su.SetSubmittedAt(*t)
+	}
+	return su
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (su *SweepUpdate) ClearSubmittedAt() *SweepUpdate {
+	su.mutation.ClearSubmittedAt()
+	return su
+}
+
+// SetUnmatchedDepositID sets the "unmatched_deposit" edge to the UnmatchedDeposit entity by ID.
+func (su *SweepUpdate) SetUnmatchedDepositID(id uuid.UUID) *SweepUpdate {
+	su.mutation.SetUnmatchedDepositID(id)
+	return su
+}
+
+// SetNillableUnmatchedDepositID sets the "unmatched_deposit" edge to the UnmatchedDeposit entity by ID if the given value is not nil.
+func (su *SweepUpdate) SetNillableUnmatchedDepositID(id *uuid.UUID) *SweepUpdate {
+	if id != nil {
+		su = su.SetUnmatchedDepositID(*id)
+	}
+	return su
+}
+
+// SetUnmatchedDeposit sets the "unmatched_deposit" edge to the UnmatchedDeposit entity.
+func (su *SweepUpdate) SetUnmatchedDeposit(u *UnmatchedDeposit) *SweepUpdate {
+	return su.SetUnmatchedDepositID(u.ID)
+}
+
+// Mutation returns the SweepMutation object of the builder.
+func (su *SweepUpdate) Mutation() *SweepMutation {
+	return su.mutation
+}
+
+// ClearUnmatchedDeposit clears the "unmatched_deposit" edge to the UnmatchedDeposit entity.
+func (su *SweepUpdate) ClearUnmatchedDeposit() *SweepUpdate {
+	su.mutation.ClearUnmatchedDeposit()
+	return su
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (su *SweepUpdate) Save(ctx context.Context) (int, error) {
+	su.defaults()
+	return withHooks(ctx, su.sqlSave, su.mutation, su.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (su *SweepUpdate) SaveX(ctx context.Context) int {
+	affected, err := su.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (su *SweepUpdate) Exec(ctx context.Context) error {
+	_, err := su.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (su *SweepUpdate) ExecX(ctx context.Context) {
+	if err := su.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (su *SweepUpdate) defaults() {
+	if _, ok := su.mutation.UpdatedAt(); !ok {
+		v := sweep.UpdateDefaultUpdatedAt()
+		su.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (su *SweepUpdate) check() error {
+	if v, ok := su.mutation.UserOpHash(); ok {
+		if err := sweep.UserOpHashValidator(v); err != nil {
+			return &ValidationError{Name: "user_op_hash", err: fmt.Errorf(`ent: validator failed for field "Sweep.user_op_hash": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.TxHash(); ok {
+		if err := sweep.TxHashValidator(v); err != nil {
+			return &ValidationError{Name: "tx_hash", err: fmt.Errorf(`ent: validator failed for field "Sweep.tx_hash": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.Status(); ok {
+		if err := sweep.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Sweep.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (su *SweepUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := su.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sweep.Table, sweep.Columns, sqlgraph.NewFieldSpec(sweep.FieldID, field.TypeUUID))
+	if ps := su.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := su.mutation.UpdatedAt(); ok {
+		_spec.SetField(sweep.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := su.mutation.Network(); ok {
+		_spec.SetField(sweep.FieldNetwork, field.TypeString, value)
+	}
+	if value, ok := su.mutation.ChainID(); ok {
+		_spec.SetField(sweep.FieldChainID, field.TypeInt64, value)
+	}
+	if value, ok := su.mutation.AddedChainID(); ok {
+		_spec.AddField(sweep.FieldChainID, field.TypeInt64, value)
+	}
+	if value, ok := su.mutation.TokenAddress(); ok {
+		_spec.SetField(sweep.FieldTokenAddress, field.TypeString, value)
+	}
+	if value, ok := su.mutation.FromAddress(); ok {
+		_spec.SetField(sweep.FieldFromAddress, field.TypeString, value)
+	}
+	if value, ok := su.mutation.ToAddress(); ok {
+		_spec.SetField(sweep.FieldToAddress, field.TypeString, value)
+	}
+	if value, ok := su.mutation.Amount(); ok {
+		_spec.SetField(sweep.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := su.mutation.AddedAmount(); ok {
+		_spec.AddField(sweep.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := su.mutation.UserOperation(); ok {
+		_spec.SetField(sweep.FieldUserOperation, field.TypeJSON, value)
+	}
+	if su.mutation.UserOperationCleared() {
+		_spec.ClearField(sweep.FieldUserOperation, field.TypeJSON)
+	}
+	if value, ok := su.mutation.UserOpHash(); ok {
+		_spec.SetField(sweep.FieldUserOpHash, field.TypeString, value)
+	}
+	if su.mutation.UserOpHashCleared() {
+		_spec.ClearField(sweep.FieldUserOpHash, field.TypeString)
+	}
+	if value, ok := su.mutation.TxHash(); ok {
+		_spec.SetField(sweep.FieldTxHash, field.TypeString, value)
+	}
+	if su.mutation.TxHashCleared() {
+		_spec.ClearField(sweep.FieldTxHash, field.TypeString)
+	}
+	if value, ok := su.mutation.Status(); ok {
+		_spec.SetField(sweep.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := su.mutation.SubmittedAt(); ok {
+		_spec.SetField(sweep.FieldSubmittedAt, field.TypeTime, value)
+	}
+	if su.mutation.SubmittedAtCleared() {
+		_spec.ClearField(sweep.FieldSubmittedAt, field.TypeTime)
+	}
+	if su.mutation.UnmatchedDepositCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   sweep.UnmatchedDepositTable,
+			Columns: []string{sweep.UnmatchedDepositColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(unmatcheddeposit.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.UnmatchedDepositIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   sweep.UnmatchedDepositTable,
+			Columns: []string{sweep.UnmatchedDepositColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(unmatcheddeposit.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sweep.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	su.mutation.done = true
+	return n, nil
+}
+
+// SweepUpdateOne is the builder for updating a single Sweep entity.
+type SweepUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SweepMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (suo *SweepUpdateOne) SetUpdatedAt(t time.Time) *SweepUpdateOne {
+	suo.mutation.SetUpdatedAt(t)
+	return suo
+}
+
+// SetNetwork sets the "network" field.
+func (suo *SweepUpdateOne) SetNetwork(s string) *SweepUpdateOne {
+	suo.mutation.SetNetwork(s)
+	return suo
+}
+
+// SetNillableNetwork sets the "network" field if the given value is not nil.
+func (suo *SweepUpdateOne) SetNillableNetwork(s *string) *SweepUpdateOne {
+	if s != nil {
+		suo.SetNetwork(*s)
+	}
+	return suo
+}
+
+// SetChainID sets the "chain_id" field.
+func (suo *SweepUpdateOne) SetChainID(i int64) *SweepUpdateOne {
+	suo.mutation.ResetChainID()
+	suo.mutation.SetChainID(i)
+	return suo
+}
+
+// SetNillableChainID sets the "chain_id" field if the given value is not nil.
+func (suo *SweepUpdateOne) SetNillableChainID(i *int64) *SweepUpdateOne {
+	if i != nil {
+		suo.SetChainID(*i)
+	}
+	return suo
+}
+
+// AddChainID adds i to the "chain_id" field.
+func (suo *SweepUpdateOne) AddChainID(i int64) *SweepUpdateOne {
+	suo.mutation.AddChainID(i)
+	return suo
+}
+
+// SetTokenAddress sets the "token_address" field.
+func (suo *SweepUpdateOne) SetTokenAddress(s string) *SweepUpdateOne {
+	suo.mutation.SetTokenAddress(s)
+	return suo
+}
+
+// SetNillableTokenAddress sets the "token_address" field if the given value is not nil.
+func (suo *SweepUpdateOne) SetNillableTokenAddress(s *string) *SweepUpdateOne {
+	if s != nil {
+		suo.SetTokenAddress(*s)
+	}
+	return suo
+}
+
+// SetFromAddress sets the "from_address" field.
+func (suo *SweepUpdateOne) SetFromAddress(s string) *SweepUpdateOne {
+	suo.mutation.SetFromAddress(s)
+	return suo
+}
+
+// SetNillableFromAddress sets the "from_address" field if the given value is not nil.
+func (suo *SweepUpdateOne) SetNillableFromAddress(s *string) *SweepUpdateOne {
+	if s != nil {
+		suo.SetFromAddress(*s)
+	}
+	return suo
+}
+
+// SetToAddress sets the "to_address" field.
+func (suo *SweepUpdateOne) SetToAddress(s string) *SweepUpdateOne {
+	suo.mutation.SetToAddress(s)
+	return suo
+}
+
+// SetNillableToAddress sets the "to_address" field if the given value is not nil.
+func (suo *SweepUpdateOne) SetNillableToAddress(s *string) *SweepUpdateOne {
+	if s != nil {
+		suo.SetToAddress(*s)
+	}
+	return suo
+}
+
+// SetAmount sets the "amount" field.
+func (suo *SweepUpdateOne) SetAmount(d decimal.Decimal) *SweepUpdateOne {
+	suo.mutation.ResetAmount()
+	suo.mutation.SetAmount(d)
+	return suo
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (suo *SweepUpdateOne) SetNillableAmount(d *decimal.Decimal) *SweepUpdateOne {
+	if d != nil {
+		suo.SetAmount(*d)
+	}
+	return suo
+}
+
+// AddAmount adds d to the "amount" field.
+func (suo *SweepUpdateOne) AddAmount(d decimal.Decimal) *SweepUpdateOne {
+	suo.mutation.AddAmount(d)
+	return suo
+}
+
+// SetUserOperation sets the "user_operation" field.
+func (suo *SweepUpdateOne) SetUserOperation(m map[string]interface{}) *SweepUpdateOne {
+	suo.mutation.SetUserOperation(m)
+	return suo
+}
+
+// ClearUserOperation clears the value of the "user_operation" field.
+func (suo *SweepUpdateOne) ClearUserOperation() *SweepUpdateOne {
+	suo.mutation.ClearUserOperation()
+	return suo
+}
+
+// SetUserOpHash sets the "user_op_hash" field.
+func (suo *SweepUpdateOne) SetUserOpHash(s string) *SweepUpdateOne {
+	suo.mutation.SetUserOpHash(s)
+	return suo
+}
+
+// SetNillableUserOpHash sets the "user_op_hash" field if the given value is not nil.
+func (suo *SweepUpdateOne) SetNillableUserOpHash(s *string) *SweepUpdateOne {
+	if s != nil {
+		suo.SetUserOpHash(*s)
+	}
+	return suo
+}
+
+// ClearUserOpHash clears the value of the "user_op_hash" field.
+func (suo *SweepUpdateOne) ClearUserOpHash() *SweepUpdateOne {
+	suo.mutation.ClearUserOpHash()
+	return suo
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (suo *SweepUpdateOne) SetTxHash(s string) *SweepUpdateOne {
+	suo.mutation.SetTxHash(s)
+	return suo
+}
+
+// SetNillableTxHash sets the "tx_hash" field if the given value is not nil.
+func (suo *SweepUpdateOne) SetNillableTxHash(s *string) *SweepUpdateOne {
+	if s != nil {
+		suo.SetTxHash(*s)
+	}
+	return suo
+}
+
+// ClearTxHash clears the value of the "tx_hash" field.
+func (suo *SweepUpdateOne) ClearTxHash() *SweepUpdateOne {
+	suo.mutation.ClearTxHash()
+	return suo
+}
+
+// SetStatus sets the "status" field.
+func (suo *SweepUpdateOne) SetStatus(s sweep.Status) *SweepUpdateOne {
+	suo.mutation.SetStatus(s)
+	return suo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (suo *SweepUpdateOne) SetNillableStatus(s *sweep.Status) *SweepUpdateOne {
+	if s != nil {
+		suo.SetStatus(*s)
+	}
+	return suo
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (suo *SweepUpdateOne) SetSubmittedAt(t time.Time) *SweepUpdateOne {
+	suo.mutation.SetSubmittedAt(t)
+	return suo
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (suo *SweepUpdateOne) SetNillableSubmittedAt(t *time.Time) *SweepUpdateOne {
+	if t != nil {
+		suo.SetSubmittedAt(*t)
+	}
+	return suo
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (suo *SweepUpdateOne) ClearSubmittedAt() *SweepUpdateOne {
+	suo.mutation.ClearSubmittedAt()
+	return suo
+}
+
+// SetUnmatchedDepositID sets the "unmatched_deposit" edge to the UnmatchedDeposit entity by ID.
+func (suo *SweepUpdateOne) SetUnmatchedDepositID(id uuid.UUID) *SweepUpdateOne {
+	suo.mutation.SetUnmatchedDepositID(id)
+	return suo
+}
+
+// SetNillableUnmatchedDepositID sets the "unmatched_deposit" edge to the UnmatchedDeposit entity by ID if the given value is not nil.
+func (suo *SweepUpdateOne) SetNillableUnmatchedDepositID(id *uuid.UUID) *SweepUpdateOne {
+	if id != nil {
+		suo = suo.SetUnmatchedDepositID(*id)
+	}
+	return suo
+}
+
+// SetUnmatchedDeposit sets the "unmatched_deposit" edge to the UnmatchedDeposit entity.
+func (suo *SweepUpdateOne) SetUnmatchedDeposit(u *UnmatchedDeposit) *SweepUpdateOne {
+	return suo.SetUnmatchedDepositID(u.ID)
+}
+
+// Mutation returns the SweepMutation object of the builder.
+func (suo *SweepUpdateOne) Mutation() *SweepMutation {
+	return suo.mutation
+}
+
+// ClearUnmatchedDeposit clears the "unmatched_deposit" edge to the UnmatchedDeposit entity.
+func (suo *SweepUpdateOne) ClearUnmatchedDeposit() *SweepUpdateOne {
+	suo.mutation.ClearUnmatchedDeposit()
+	return suo
+}
+
+// Where appends a list predicates to the SweepUpdate builder.
+func (suo *SweepUpdateOne) Where(ps ...predicate.Sweep) *SweepUpdateOne {
+	suo.mutation.Where(ps...)
+	return suo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (suo *SweepUpdateOne) Select(field string, fields ...string) *SweepUpdateOne {
+	suo.fields = append([]string{field}, fields...)
+	return suo
+}
+
+// Save executes the query and returns the updated Sweep entity.
+func (suo *SweepUpdateOne) Save(ctx context.Context) (*Sweep, error) {
+	suo.defaults()
+	return withHooks(ctx, suo.sqlSave, suo.mutation, suo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (suo *SweepUpdateOne) SaveX(ctx context.Context) *Sweep {
+	node, err := suo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (suo *SweepUpdateOne) Exec(ctx context.Context) error {
+	_, err := suo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (suo *SweepUpdateOne) ExecX(ctx context.Context) {
+	if err := suo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (suo *SweepUpdateOne) defaults() {
+	if _, ok := suo.mutation.UpdatedAt(); !ok {
+		v := sweep.UpdateDefaultUpdatedAt()
+		suo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (suo *SweepUpdateOne) check() error {
+	if v, ok := suo.mutation.UserOpHash(); ok {
+		if err := sweep.UserOpHashValidator(v); err != nil {
+			return &ValidationError{Name: "user_op_hash", err: fmt.Errorf(`ent: validator failed for field "Sweep.user_op_hash": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.TxHash(); ok {
+		if err := sweep.TxHashValidator(v); err != nil {
+			return &ValidationError{Name: "tx_hash", err: fmt.Errorf(`ent: validator failed for field "Sweep.tx_hash": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.Status(); ok {
+		if err := sweep.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Sweep.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (suo *SweepUpdateOne) sqlSave(ctx context.Context) (_node *Sweep, err error) {
+	if err := suo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sweep.Table, sweep.Columns, sqlgraph.NewFieldSpec(sweep.FieldID, field.TypeUUID))
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Sweep.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := suo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sweep.FieldID)
+		for _, f := range fields {
+			if !sweep.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != sweep.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := suo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := suo.mutation.UpdatedAt(); ok {
+		_spec.SetField(sweep.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := suo.mutation.Network(); ok {
+		_spec.SetField(sweep.FieldNetwork, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.ChainID(); ok {
+		_spec.SetField(sweep.FieldChainID, field.TypeInt64, value)
+	}
+	if value, ok := suo.mutation.AddedChainID(); ok {
+		_spec.AddField(sweep.FieldChainID, field.TypeInt64, value)
+	}
+	if value, ok := suo.mutation.TokenAddress(); ok {
+		_spec.SetField(sweep.FieldTokenAddress, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.FromAddress(); ok {
+		_spec.SetField(sweep.FieldFromAddress, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.ToAddress(); ok {
+		_spec.SetField(sweep.FieldToAddress, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.Amount(); ok {
+		_spec.SetField(sweep.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := suo.mutation.AddedAmount(); ok {
+		_spec.AddField(sweep.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := suo.mutation.UserOperation(); ok {
+		_spec.SetField(sweep.FieldUserOperation, field.TypeJSON, value)
+	}
+	if suo.mutation.UserOperationCleared() {
+		_spec.ClearField(sweep.FieldUserOperation, field.TypeJSON)
+	}
+	if value, ok := suo.mutation.UserOpHash(); ok {
+		_spec.SetField(sweep.FieldUserOpHash, field.TypeString, value)
+	}
+	if suo.mutation.UserOpHashCleared() {
+		_spec.ClearField(sweep.FieldUserOpHash, field.TypeString)
+	}
+	if value, ok := suo.mutation.TxHash(); ok {
+		_spec.SetField(sweep.FieldTxHash, field.TypeString, value)
+	}
+	if suo.mutation.TxHashCleared() {
+		_spec.ClearField(sweep.FieldTxHash, field.TypeString)
+	}
+	if value, ok := suo.mutation.Status(); ok {
+		_spec.SetField(sweep.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := suo.mutation.SubmittedAt(); ok {
+		_spec.SetField(sweep.FieldSubmittedAt, field.TypeTime, value)
+	}
+	if suo.mutation.SubmittedAtCleared() {
+		_spec.ClearField(sweep.FieldSubmittedAt, field.TypeTime)
+	}
+	if suo.mutation.UnmatchedDepositCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   sweep.UnmatchedDepositTable,
+			Columns: []string{sweep.UnmatchedDepositColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(unmatcheddeposit.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.UnmatchedDepositIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   sweep.UnmatchedDepositTable,
+			Columns: []string{sweep.UnmatchedDepositColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(unmatcheddeposit.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Sweep{config: suo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sweep.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	suo.mutation.done = true
+	return _node, nil
+}
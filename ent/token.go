@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/NEDA-LABS/stablenode/ent/network"
 	"github.com/NEDA-LABS/stablenode/ent/token"
+	"github.com/shopspring/decimal"
 )
 
 // Token is the model entity for the Token schema.
@@ -32,6 +33,10 @@ type Token struct {
 	IsEnabled bool `json:"is_enabled,omitempty"`
 	// BaseCurrency holds the value of the "base_currency" field.
 	BaseCurrency string `json:"base_currency,omitempty"`
+	// UsdPrice holds the value of the "usd_price" field.
+	UsdPrice decimal.Decimal `json:"usd_price,omitempty"`
+	// DepeggedAt holds the value of the "depegged_at" field.
+	DepeggedAt *time.Time `json:"depegged_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TokenQuery when eager-loading is set.
 	Edges          TokenEdges `json:"edges"`
@@ -51,9 +56,13 @@ type TokenEdges struct {
 	SenderOrderTokens []*SenderOrderToken `json:"sender_order_tokens,omitempty"`
 	// ProviderOrderTokens holds the value of the provider_order_tokens edge.
 	ProviderOrderTokens []*ProviderOrderToken `json:"provider_order_tokens,omitempty"`
+	// LedgerAccounts holds the value of the ledger_accounts edge.
+	LedgerAccounts []*LedgerAccount `json:"ledger_accounts,omitempty"`
+	// UnmatchedDeposits holds the value of the unmatched_deposits edge.
+	UnmatchedDeposits []*UnmatchedDeposit `json:"unmatched_deposits,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [7]bool
 }
 
 // NetworkOrErr returns the Network value or an error if the edge
@@ -103,18 +112,38 @@ func (e TokenEdges) ProviderOrderTokensOrErr() ([]*ProviderOrderToken, error) {
 	return nil, &NotLoadedError{edge: "provider_order_tokens"}
 }
 
+// LedgerAccountsOrErr returns the LedgerAccounts value or an error if the edge
+// was not loaded in eager-loading.
+func (e TokenEdges) LedgerAccountsOrErr() ([]*LedgerAccount, error) {
+	if e.loadedTypes[5] {
+		return e.LedgerAccounts, nil
+	}
+	return nil, &NotLoadedError{edge: "ledger_accounts"}
+}
+
+// UnmatchedDepositsOrErr returns the UnmatchedDeposits value or an error if the edge
+// was not loaded in eager-loading.
+func (e TokenEdges) UnmatchedDepositsOrErr() ([]*UnmatchedDeposit, error) {
+	if e.loadedTypes[6] {
+		return e.UnmatchedDeposits, nil
+	}
+	return nil, &NotLoadedError{edge: "unmatched_deposits"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Token) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case token.FieldUsdPrice:
+			values[i] = new(decimal.Decimal)
 		case token.FieldIsEnabled:
 			values[i] = new(sql.NullBool)
 		case token.FieldID, token.FieldDecimals:
 			values[i] = new(sql.NullInt64)
 		case token.FieldSymbol, token.FieldContractAddress, token.FieldBaseCurrency:
 			values[i] = new(sql.NullString)
-		case token.FieldCreatedAt, token.FieldUpdatedAt:
+		case token.FieldCreatedAt, token.FieldUpdatedAt, token.FieldDepeggedAt:
 			values[i] = new(sql.NullTime)
 		case token.ForeignKeys[0]: // network_tokens
 			values[i] = new(sql.NullInt64)
@@ -181,6 +210,19 @@ func (t *Token) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				t.BaseCurrency = value.String
 			}
+		case token.FieldUsdPrice:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field usd_price", values[i])
+			} else if value != nil {
+				t.UsdPrice = *value
+			}
+		case token.FieldDepeggedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field depegged_at", values[i])
+			} else if value.Valid {
+				t.DepeggedAt = new(time.Time)
+				*t.DepeggedAt = value.Time
+			}
 		case token.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field network_tokens", value)
@@ -226,6 +268,16 @@ func (t *Token) QueryProviderOrderTokens() *ProviderOrderTokenQuery {
 	return NewTokenClient(t.config).QueryProviderOrderTokens(t)
 }
 
+// QueryLedgerAccounts queries the "ledger_accounts" edge of the Token entity.
+func (t *Token) QueryLedgerAccounts() *LedgerAccountQuery {
+	return NewTokenClient(t.config).QueryLedgerAccounts(t)
+}
+
+// QueryUnmatchedDeposits queries the "unmatched_deposits" edge of the Token entity.
+func (t *Token) QueryUnmatchedDeposits() *UnmatchedDepositQuery {
+	return NewTokenClient(t.config).QueryUnmatchedDeposits(t)
+}
+
 // Update returns a builder for updating this Token.
 // Note that you need to call Token.Unwrap() before calling this method if this Token
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -269,6 +321,14 @@ func (t *Token) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("base_currency=")
 	builder.WriteString(t.BaseCurrency)
+	builder.WriteString(", ")
+	builder.WriteString("usd_price=")
+	builder.WriteString(fmt.Sprintf("%v", t.UsdPrice))
+	builder.WriteString(", ")
+	if v := t.DepeggedAt; v != nil {
+		builder.WriteString("depegged_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
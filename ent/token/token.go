@@ -7,6 +7,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
 )
 
 const (
@@ -28,6 +29,10 @@ const (
 	FieldIsEnabled = "is_enabled"
 	// FieldBaseCurrency holds the string denoting the base_currency field in the database.
 	FieldBaseCurrency = "base_currency"
+	// FieldUsdPrice holds the string denoting the usd_price field in the database.
+	FieldUsdPrice = "usd_price"
+	// FieldDepeggedAt holds the string denoting the depegged_at field in the database.
+	FieldDepeggedAt = "depegged_at"
 	// EdgeNetwork holds the string denoting the network edge name in mutations.
 	EdgeNetwork = "network"
 	// EdgePaymentOrders holds the string denoting the payment_orders edge name in mutations.
@@ -38,6 +43,10 @@ const (
 	EdgeSenderOrderTokens = "sender_order_tokens"
 	// EdgeProviderOrderTokens holds the string denoting the provider_order_tokens edge name in mutations.
 	EdgeProviderOrderTokens = "provider_order_tokens"
+	// EdgeLedgerAccounts holds the string denoting the ledger_accounts edge name in mutations.
+	EdgeLedgerAccounts = "ledger_accounts"
+	// EdgeUnmatchedDeposits holds the string denoting the unmatched_deposits edge name in mutations.
+	EdgeUnmatchedDeposits = "unmatched_deposits"
 	// Table holds the table name of the token in the database.
 	Table = "tokens"
 	// NetworkTable is the table that holds the network relation/edge.
@@ -75,6 +84,20 @@ const (
 	ProviderOrderTokensInverseTable = "provider_order_tokens"
 	// ProviderOrderTokensColumn is the table column denoting the provider_order_tokens relation/edge.
 	ProviderOrderTokensColumn = "token_provider_order_tokens"
+	// LedgerAccountsTable is the table that holds the ledger_accounts relation/edge.
+	LedgerAccountsTable = "ledger_accounts"
+	// LedgerAccountsInverseTable is the table name for the LedgerAccount entity.
+	// It exists in this package in order to avoid circular dependency with the "ledgeraccount" package.
+	LedgerAccountsInverseTable = "ledger_accounts"
+	// LedgerAccountsColumn is the table column denoting the ledger_accounts relation/edge.
+	LedgerAccountsColumn = "token_ledger_accounts"
+	// UnmatchedDepositsTable is the table that holds the unmatched_deposits relation/edge.
+	UnmatchedDepositsTable = "unmatched_deposits"
+	// UnmatchedDepositsInverseTable is the table name for the UnmatchedDeposit entity.
+	// It exists in this package in order to avoid circular dependency with the "unmatcheddeposit" package.
+	UnmatchedDepositsInverseTable = "unmatched_deposits"
+	// UnmatchedDepositsColumn is the table column denoting the unmatched_deposits relation/edge.
+	UnmatchedDepositsColumn = "token_unmatched_deposits"
 )
 
 // Columns holds all SQL columns for token fields.
@@ -87,6 +110,8 @@ var Columns = []string{
 	FieldDecimals,
 	FieldIsEnabled,
 	FieldBaseCurrency,
+	FieldUsdPrice,
+	FieldDepeggedAt,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "tokens"
@@ -125,6 +150,8 @@ var (
 	DefaultIsEnabled bool
 	// DefaultBaseCurrency holds the default value on creation for the "base_currency" field.
 	DefaultBaseCurrency string
+	// DefaultUsdPrice holds the default value on creation for the "usd_price" field.
+	DefaultUsdPrice func() decimal.Decimal
 )
 
 // OrderOption defines the ordering options for the Token queries.
@@ -170,6 +197,16 @@ func ByBaseCurrency(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldBaseCurrency, opts...).ToFunc()
 }
 
+// ByUsdPrice orders the results by the usd_price field.
+func ByUsdPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUsdPrice, opts...).ToFunc()
+}
+
+// ByDepeggedAt orders the results by the depegged_at field.
+func ByDepeggedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDepeggedAt, opts...).ToFunc()
+}
+
 // ByNetworkField orders the results by network field.
 func ByNetworkField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -232,6 +269,34 @@ func ByProviderOrderTokens(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOpti
 		sqlgraph.OrderByNeighborTerms(s, newProviderOrderTokensStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByLedgerAccountsCount orders the results by ledger_accounts count.
+func ByLedgerAccountsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLedgerAccountsStep(), opts...)
+	}
+}
+
+// ByLedgerAccounts orders the results by ledger_accounts terms.
+func ByLedgerAccounts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLedgerAccountsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByUnmatchedDepositsCount orders the results by unmatched_deposits count.
+func ByUnmatchedDepositsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newUnmatchedDepositsStep(), opts...)
+	}
+}
+
+// ByUnmatchedDeposits orders the results by unmatched_deposits terms.
+func ByUnmatchedDeposits(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUnmatchedDepositsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newNetworkStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -267,3 +332,17 @@ func newProviderOrderTokensStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ProviderOrderTokensTable, ProviderOrderTokensColumn),
 	)
 }
+func newLedgerAccountsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LedgerAccountsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LedgerAccountsTable, LedgerAccountsColumn),
+	)
+}
+func newUnmatchedDepositsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UnmatchedDepositsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, UnmatchedDepositsTable, UnmatchedDepositsColumn),
+	)
+}
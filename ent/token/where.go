@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/shopspring/decimal"
 )
 
 // ID filters vertices based on their ID field.
@@ -90,6 +91,16 @@ func BaseCurrency(v string) predicate.Token {
 	return predicate.Token(sql.FieldEQ(FieldBaseCurrency, v))
 }
 
+// UsdPrice applies equality check predicate on the "usd_price" field. It's identical to UsdPriceEQ.
+func UsdPrice(v decimal.Decimal) predicate.Token {
+	return predicate.Token(sql.FieldEQ(FieldUsdPrice, v))
+}
+
+// DepeggedAt applies equality check predicate on the "depegged_at" field. It's identical to DepeggedAtEQ.
+func DepeggedAt(v time.Time) predicate.Token {
+	return predicate.Token(sql.FieldEQ(FieldDepeggedAt, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Token {
 	return predicate.Token(sql.FieldEQ(FieldCreatedAt, v))
@@ -415,6 +426,96 @@ func BaseCurrencyContainsFold(v string) predicate.Token {
 	return predicate.Token(sql.FieldContainsFold(FieldBaseCurrency, v))
 }
 
+// UsdPriceEQ applies the EQ predicate on the "usd_price" field.
+func UsdPriceEQ(v decimal.Decimal) predicate.Token {
+	return predicate.Token(sql.FieldEQ(FieldUsdPrice, v))
+}
+
+// UsdPriceNEQ applies the NEQ predicate on the "usd_price" field.
+func UsdPriceNEQ(v decimal.Decimal) predicate.Token {
+	return predicate.Token(sql.FieldNEQ(FieldUsdPrice, v))
+}
+
+// UsdPriceIn applies the In predicate on the "usd_price" field.
+func UsdPriceIn(vs ...decimal.Decimal) predicate.Token {
+	return predicate.Token(sql.FieldIn(FieldUsdPrice, vs...))
+}
+
+// UsdPriceNotIn applies the NotIn predicate on the "usd_price" field.
+func UsdPriceNotIn(vs ...decimal.Decimal) predicate.Token {
+	return predicate.Token(sql.FieldNotIn(FieldUsdPrice, vs...))
+}
+
+// UsdPriceGT applies the GT predicate on the "usd_price" field.
+func UsdPriceGT(v decimal.Decimal) predicate.Token {
+	return predicate.Token(sql.FieldGT(FieldUsdPrice, v))
+}
+
+// UsdPriceGTE applies the GTE predicate on the "usd_price" field.
+func UsdPriceGTE(v decimal.Decimal) predicate.Token {
+	return predicate.Token(sql.FieldGTE(FieldUsdPrice, v))
+}
+
+// UsdPriceLT applies the LT predicate on the "usd_price" field.
+func UsdPriceLT(v decimal.Decimal) predicate.Token {
+	return predicate.Token(sql.FieldLT(FieldUsdPrice, v))
+}
+
+// UsdPriceLTE applies the LTE predicate on the "usd_price" field.
+func UsdPriceLTE(v decimal.Decimal) predicate.Token {
+	return predicate.Token(sql.FieldLTE(FieldUsdPrice, v))
+}
+
+// DepeggedAtEQ applies the EQ predicate on the "depegged_at" field.
+func DepeggedAtEQ(v time.Time) predicate.Token {
+	return predicate.Token(sql.FieldEQ(FieldDepeggedAt, v))
+}
+
+// DepeggedAtNEQ applies the NEQ predicate on the "depegged_at" field.
+func DepeggedAtNEQ(v time.Time) predicate.Token {
+	return predicate.Token(sql.FieldNEQ(FieldDepeggedAt, v))
+}
+
+// DepeggedAtIn applies the In predicate on the "depegged_at" field.
+func DepeggedAtIn(vs ...time.Time) predicate.Token {
+	return predicate.Token(sql.FieldIn(FieldDepeggedAt, vs...))
+}
+
+// DepeggedAtNotIn applies the NotIn predicate on the "depegged_at" field.
+func DepeggedAtNotIn(vs ...time.Time) predicate.Token {
+	return predicate.Token(sql.FieldNotIn(FieldDepeggedAt, vs...))
+}
+
+// DepeggedAtGT applies the GT predicate on the "depegged_at" field.
+func DepeggedAtGT(v time.Time) predicate.Token {
+	return predicate.Token(sql.FieldGT(FieldDepeggedAt, v))
+}
+
+// DepeggedAtGTE applies the GTE predicate on the "depegged_at" field.
+func DepeggedAtGTE(v time.Time) predicate.Token {
+	return predicate.Token(sql.FieldGTE(FieldDepeggedAt, v))
+}
+
+// DepeggedAtLT applies the LT predicate on the "depegged_at" field.
+func DepeggedAtLT(v time.Time) predicate.Token {
+	return predicate.Token(sql.FieldLT(FieldDepeggedAt, v))
+}
+
+// DepeggedAtLTE applies the LTE predicate on the "depegged_at" field.
+func DepeggedAtLTE(v time.Time) predicate.Token {
+	return predicate.Token(sql.FieldLTE(FieldDepeggedAt, v))
+}
+
+// DepeggedAtIsNil applies the IsNil predicate on the "depegged_at" field.
+func DepeggedAtIsNil() predicate.Token {
+	return predicate.Token(sql.FieldIsNull(FieldDepeggedAt))
+}
+
+// DepeggedAtNotNil applies the NotNil predicate on the "depegged_at" field.
+func DepeggedAtNotNil() predicate.Token {
+	return predicate.Token(sql.FieldNotNull(FieldDepeggedAt))
+}
+
 // HasNetwork applies the HasEdge predicate on the "network" edge.
 func HasNetwork() predicate.Token {
 	return predicate.Token(func(s *sql.Selector) {
@@ -530,6 +631,52 @@ func HasProviderOrderTokensWith(preds ...predicate.ProviderOrderToken) predicate
 	})
 }
 
+// HasLedgerAccounts applies the HasEdge predicate on the "ledger_accounts" edge.
+func HasLedgerAccounts() predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LedgerAccountsTable, LedgerAccountsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLedgerAccountsWith applies the HasEdge predicate on the "ledger_accounts" edge with a given conditions (other predicates).
+func HasLedgerAccountsWith(preds ...predicate.LedgerAccount) predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		step := newLedgerAccountsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUnmatchedDeposits applies the HasEdge predicate on the "unmatched_deposits" edge.
+func HasUnmatchedDeposits() predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, UnmatchedDepositsTable, UnmatchedDepositsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUnmatchedDepositsWith applies the HasEdge predicate on the "unmatched_deposits" edge with a given conditions (other predicates).
+func HasUnmatchedDepositsWith(preds ...predicate.UnmatchedDeposit) predicate.Token {
+	return predicate.Token(func(s *sql.Selector) {
+		step := newUnmatchedDepositsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Token) predicate.Token {
 	return predicate.Token(sql.AndPredicates(predicates...))
@@ -11,13 +11,16 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/ledgeraccount"
 	"github.com/NEDA-LABS/stablenode/ent/lockpaymentorder"
 	"github.com/NEDA-LABS/stablenode/ent/network"
 	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
 	"github.com/NEDA-LABS/stablenode/ent/providerordertoken"
 	"github.com/NEDA-LABS/stablenode/ent/senderordertoken"
 	"github.com/NEDA-LABS/stablenode/ent/token"
+	"github.com/NEDA-LABS/stablenode/ent/unmatcheddeposit"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 // TokenCreate is the builder for creating a Token entity.
@@ -102,6 +105,34 @@ func (tc *TokenCreate) SetNillableBaseCurrency(s *string) *TokenCreate {
 	return tc
 }
 
+// SetUsdPrice sets the "usd_price" field.
+func (tc *TokenCreate) SetUsdPrice(d decimal.Decimal) *TokenCreate {
+	tc.mutation.SetUsdPrice(d)
+	return tc
+}
+
+// SetNillableUsdPrice sets the "usd_price" field if the given value is not nil.
+func (tc *TokenCreate) SetNillableUsdPrice(d *decimal.Decimal) *TokenCreate {
+	if d != nil {
+		tc.SetUsdPrice(*d)
+	}
+	return tc
+}
+
+// SetDepeggedAt sets the "depegged_at" field.
+func (tc *TokenCreate) SetDepeggedAt(t time.Time) *TokenCreate {
+	tc.mutation.SetDepeggedAt(t)
+	return tc
+}
+
+// SetNillableDepeggedAt sets the "depegged_at" field if the given value is not nil.
+func (tc *TokenCreate) SetNillableDepeggedAt(t *time.Time) *TokenCreate {
+	if t != nil {
+		tc.SetDepeggedAt(*t)
+	}
+	return tc
+}
+
 // SetNetworkID sets the "network" edge to the Network entity by ID.
 func (tc *TokenCreate) SetNetworkID(id int) *TokenCreate {
 	tc.mutation.SetNetworkID(id)
@@ -173,6 +204,36 @@ func (tc *TokenCreate) AddProviderOrderTokens(p ...*ProviderOrderToken) *TokenCr
 	return tc.AddProviderOrderTokenIDs(ids...)
 }
 
+// AddLedgerAccountIDs adds the "ledger_accounts" edge to the LedgerAccount entity by IDs.
+func (tc *TokenCreate) AddLedgerAccountIDs(ids ...uuid.UUID) *TokenCreate {
+	tc.mutation.AddLedgerAccountIDs(ids...)
+	return tc
+}
+
+// AddLedgerAccounts adds the "ledger_accounts" edges to the LedgerAccount entity.
+func (tc *TokenCreate) AddLedgerAccounts(l ...*LedgerAccount) *TokenCreate {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return tc.AddLedgerAccountIDs(ids...)
+}
+
+// AddUnmatchedDepositIDs adds the "unmatched_deposits" edge to the UnmatchedDeposit entity by IDs.
+func (tc *TokenCreate) AddUnmatchedDepositIDs(ids ...uuid.UUID) *TokenCreate {
+	tc.mutation.AddUnmatchedDepositIDs(ids...)
+	return tc
+}
+
+// AddUnmatchedDeposits adds the "unmatched_deposits" edges to the UnmatchedDeposit entity.
+func (tc *TokenCreate) AddUnmatchedDeposits(u ...*UnmatchedDeposit) *TokenCreate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return tc.AddUnmatchedDepositIDs(ids...)
+}
+
 // Mutation returns the TokenMutation object of the builder.
 func (tc *TokenCreate) Mutation() *TokenMutation {
 	return tc.mutation
@@ -224,6 +285,10 @@ func (tc *TokenCreate) defaults() {
 		v := token.DefaultBaseCurrency
 		tc.mutation.SetBaseCurrency(v)
 	}
+	if _, ok := tc.mutation.UsdPrice(); !ok {
+		v := token.DefaultUsdPrice()
+		tc.mutation.SetUsdPrice(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -259,6 +324,9 @@ func (tc *TokenCreate) check() error {
 	if _, ok := tc.mutation.BaseCurrency(); !ok {
 		return &ValidationError{Name: "base_currency", err: errors.New(`ent: missing required field "Token.base_currency"`)}
 	}
+	if _, ok := tc.mutation.UsdPrice(); !ok {
+		return &ValidationError{Name: "usd_price", err: errors.New(`ent: missing required field "Token.usd_price"`)}
+	}
 	if len(tc.mutation.NetworkIDs()) == 0 {
 		return &ValidationError{Name: "network", err: errors.New(`ent: missing required edge "Token.network"`)}
 	}
@@ -317,6 +385,14 @@ func (tc *TokenCreate) createSpec() (*Token, *sqlgraph.CreateSpec) {
 		_spec.SetField(token.FieldBaseCurrency, field.TypeString, value)
 		_node.BaseCurrency = value
 	}
+	if value, ok := tc.mutation.UsdPrice(); ok {
+		_spec.SetField(token.FieldUsdPrice, field.TypeFloat64, value)
+		_node.UsdPrice = value
+	}
+	if value, ok := tc.mutation.DepeggedAt(); ok {
+		_spec.SetField(token.FieldDepeggedAt, field.TypeTime, value)
+		_node.DepeggedAt = &value
+	}
 	if nodes := tc.mutation.NetworkIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -398,6 +474,38 @@ func (tc *TokenCreate) createSpec() (*Token, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := tc.mutation.LedgerAccountsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   token.LedgerAccountsTable,
+			Columns: []string{token.LedgerAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgeraccount.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.UnmatchedDepositsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   token.UnmatchedDepositsTable,
+			Columns: []string{token.UnmatchedDepositsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(unmatcheddeposit.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -528,6 +636,42 @@ func (u *TokenUpsert) UpdateBaseCurrency() *TokenUpsert {
 	return u
 }
 
+// SetUsdPrice sets the "usd_price" field.
+func (u *TokenUpsert) SetUsdPrice(v decimal.Decimal) *TokenUpsert {
+	u.Set(token.FieldUsdPrice, v)
+	return u
+}
+
+// UpdateUsdPrice sets the "usd_price" field to the value that was provided on create.
+func (u *TokenUpsert) UpdateUsdPrice() *TokenUpsert {
+	u.SetExcluded(token.FieldUsdPrice)
+	return u
+}
+
+// AddUsdPrice adds v to the "usd_price" field.
+func (u *TokenUpsert) AddUsdPrice(v decimal.Decimal) *TokenUpsert {
+	u.Add(token.FieldUsdPrice, v)
+	return u
+}
+
+// SetDepeggedAt sets the "depegged_at" field.
+func (u *TokenUpsert) SetDepeggedAt(v time.Time) *TokenUpsert {
+	u.Set(token.FieldDepeggedAt, v)
+	return u
+}
+
+// UpdateDepeggedAt sets the "depegged_at" field to the value that was provided on create.
+func (u *TokenUpsert) UpdateDepeggedAt() *TokenUpsert {
+	u.SetExcluded(token.FieldDepeggedAt)
+	return u
+}
+
+// ClearDepeggedAt clears the value of the "depegged_at" field.
+func (u *TokenUpsert) ClearDepeggedAt() *TokenUpsert {
+	u.SetNull(token.FieldDepeggedAt)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -664,6 +808,48 @@ func (u *TokenUpsertOne) UpdateBaseCurrency() *TokenUpsertOne {
 	})
 }
 
+// SetUsdPrice sets the "usd_price" field.
+func (u *TokenUpsertOne) SetUsdPrice(v decimal.Decimal) *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.SetUsdPrice(v)
+	})
+}
+
+// AddUsdPrice adds v to the "usd_price" field.
+func (u *TokenUpsertOne) AddUsdPrice(v decimal.Decimal) *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.AddUsdPrice(v)
+	})
+}
+
+// UpdateUsdPrice sets the "usd_price" field to the value that was provided on create.
+func (u *TokenUpsertOne) UpdateUsdPrice() *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.UpdateUsdPrice()
+	})
+}
+
+// SetDepeggedAt sets the "depegged_at" field.
+func (u *TokenUpsertOne) SetDepeggedAt(v time.Time) *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.SetDepeggedAt(v)
+	})
+}
+
+// UpdateDepeggedAt sets the "depegged_at" field to the value that was provided on create.
+func (u *TokenUpsertOne) UpdateDepeggedAt() *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.UpdateDepeggedAt()
+	})
+}
+
+// ClearDepeggedAt clears the value of the "depegged_at" field.
+func (u *TokenUpsertOne) ClearDepeggedAt() *TokenUpsertOne {
+	return u.Update(func(s *TokenUpsert) {
+		s.ClearDepeggedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *TokenUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -966,6 +1152,48 @@ func (u *TokenUpsertBulk) UpdateBaseCurrency() *TokenUpsertBulk {
 	})
 }
 
+// SetUsdPrice sets the "usd_price" field.
+func (u *TokenUpsertBulk) SetUsdPrice(v decimal.Decimal) *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.SetUsdPrice(v)
+	})
+}
+
+// AddUsdPrice adds v to the "usd_price" field.
+func (u *TokenUpsertBulk) AddUsdPrice(v decimal.Decimal) *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.AddUsdPrice(v)
+	})
+}
+
+// UpdateUsdPrice sets the "usd_price" field to the value that was provided on create.
+func (u *TokenUpsertBulk) UpdateUsdPrice() *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.UpdateUsdPrice()
+	})
+}
+
+// SetDepeggedAt sets the "depegged_at" field.
+func (u *TokenUpsertBulk) SetDepeggedAt(v time.Time) *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.SetDepeggedAt(v)
+	})
+}
+
+// UpdateDepeggedAt sets the "depegged_at" field to the value that was provided on create.
+func (u *TokenUpsertBulk) UpdateDepeggedAt() *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.UpdateDepeggedAt()
+	})
+}
+
+// ClearDepeggedAt clears the value of the "depegged_at" field.
+func (u *TokenUpsertBulk) ClearDepeggedAt() *TokenUpsertBulk {
+	return u.Update(func(s *TokenUpsert) {
+		s.ClearDepeggedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *TokenUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/ledgeraccount"
 	"github.com/NEDA-LABS/stablenode/ent/lockpaymentorder"
 	"github.com/NEDA-LABS/stablenode/ent/network"
 	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
@@ -19,6 +20,7 @@ import (
 	"github.com/NEDA-LABS/stablenode/ent/providerordertoken"
 	"github.com/NEDA-LABS/stablenode/ent/senderordertoken"
 	"github.com/NEDA-LABS/stablenode/ent/token"
+	"github.com/NEDA-LABS/stablenode/ent/unmatcheddeposit"
 )
 
 // TokenQuery is the builder for querying Token entities.
@@ -33,6 +35,8 @@ type TokenQuery struct {
 	withLockPaymentOrders   *LockPaymentOrderQuery
 	withSenderOrderTokens   *SenderOrderTokenQuery
 	withProviderOrderTokens *ProviderOrderTokenQuery
+	withLedgerAccounts      *LedgerAccountQuery
+	withUnmatchedDeposits   *UnmatchedDepositQuery
 	withFKs                 bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -180,6 +184,50 @@ func (tq *TokenQuery) QueryProviderOrderTokens() *ProviderOrderTokenQuery {
 	return query
 }
 
+// QueryLedgerAccounts chains the current query on the "ledger_accounts" edge.
+func (tq *TokenQuery) QueryLedgerAccounts() *LedgerAccountQuery {
+	query := (&LedgerAccountClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(token.Table, token.FieldID, selector),
+			sqlgraph.To(ledgeraccount.Table, ledgeraccount.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, token.LedgerAccountsTable, token.LedgerAccountsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryUnmatchedDeposits chains the current query on the "unmatched_deposits" edge.
+func (tq *TokenQuery) QueryUnmatchedDeposits() *UnmatchedDepositQuery {
+	query := (&UnmatchedDepositClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(token.Table, token.FieldID, selector),
+			sqlgraph.To(unmatcheddeposit.Table, unmatcheddeposit.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, token.UnmatchedDepositsTable, token.UnmatchedDepositsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Token entity from the query.
 // Returns a *NotFoundError when no Token was found.
 func (tq *TokenQuery) First(ctx context.Context) (*Token, error) {
@@ -377,6 +425,8 @@ func (tq *TokenQuery) Clone() *TokenQuery {
 		withLockPaymentOrders:   tq.withLockPaymentOrders.Clone(),
 		withSenderOrderTokens:   tq.withSenderOrderTokens.Clone(),
 		withProviderOrderTokens: tq.withProviderOrderTokens.Clone(),
+		withLedgerAccounts:      tq.withLedgerAccounts.Clone(),
+		withUnmatchedDeposits:   tq.withUnmatchedDeposits.Clone(),
 		// clone intermediate query.
 		sql:  tq.sql.Clone(),
 		path: tq.path,
@@ -438,6 +488,28 @@ func (tq *TokenQuery) WithProviderOrderTokens(opts ...func(*ProviderOrderTokenQu
 	return tq
 }
 
+// WithLedgerAccounts tells the query-builder to eager-load the nodes that are connected to
+// the "ledger_accounts" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TokenQuery) WithLedgerAccounts(opts ...func(*LedgerAccountQuery)) *TokenQuery {
+	query := (&LedgerAccountClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withLedgerAccounts = query
+	return tq
+}
+
+// WithUnmatchedDeposits tells the query-builder to eager-load the nodes that are connected to
+// the "unmatched_deposits" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TokenQuery) WithUnmatchedDeposits(opts ...func(*UnmatchedDepositQuery)) *TokenQuery {
+	query := (&UnmatchedDepositClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withUnmatchedDeposits = query
+	return tq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -517,12 +589,14 @@ func (tq *TokenQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Token,
 		nodes       = []*Token{}
 		withFKs     = tq.withFKs
 		_spec       = tq.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [7]bool{
 			tq.withNetwork != nil,
 			tq.withPaymentOrders != nil,
 			tq.withLockPaymentOrders != nil,
 			tq.withSenderOrderTokens != nil,
 			tq.withProviderOrderTokens != nil,
+			tq.withLedgerAccounts != nil,
+			tq.withUnmatchedDeposits != nil,
 		}
 	)
 	if tq.withNetwork != nil {
@@ -585,6 +659,20 @@ func (tq *TokenQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Token,
 			return nil, err
 		}
 	}
+	if query := tq.withLedgerAccounts; query != nil {
+		if err := tq.loadLedgerAccounts(ctx, query, nodes,
+			func(n *Token) { n.Edges.LedgerAccounts = []*LedgerAccount{} },
+			func(n *Token, e *LedgerAccount) { n.Edges.LedgerAccounts = append(n.Edges.LedgerAccounts, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withUnmatchedDeposits; query != nil {
+		if err := tq.loadUnmatchedDeposits(ctx, query, nodes,
+			func(n *Token) { n.Edges.UnmatchedDeposits = []*UnmatchedDeposit{} },
+			func(n *Token, e *UnmatchedDeposit) { n.Edges.UnmatchedDeposits = append(n.Edges.UnmatchedDeposits, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -744,6 +832,68 @@ func (tq *TokenQuery) loadProviderOrderTokens(ctx context.Context, query *Provid
 	}
 	return nil
 }
+func (tq *TokenQuery) loadLedgerAccounts(ctx context.Context, query *LedgerAccountQuery, nodes []*Token, init func(*Token), assign func(*Token, *LedgerAccount)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Token)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.LedgerAccount(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(token.LedgerAccountsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.token_ledger_accounts
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "token_ledger_accounts" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "token_ledger_accounts" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (tq *TokenQuery) loadUnmatchedDeposits(ctx context.Context, query *UnmatchedDepositQuery, nodes []*Token, init func(*Token), assign func(*Token, *UnmatchedDeposit)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Token)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.UnmatchedDeposit(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(token.UnmatchedDepositsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.token_unmatched_deposits
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "token_unmatched_deposits" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "token_unmatched_deposits" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (tq *TokenQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := tq.querySpec()
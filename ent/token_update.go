@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/ledgeraccount"
 	"github.com/NEDA-LABS/stablenode/ent/lockpaymentorder"
 	"github.com/NEDA-LABS/stablenode/ent/network"
 	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
@@ -18,7 +19,9 @@ import (
 	"github.com/NEDA-LABS/stablenode/ent/providerordertoken"
 	"github.com/NEDA-LABS/stablenode/ent/senderordertoken"
 	"github.com/NEDA-LABS/stablenode/ent/token"
+	"github.com/NEDA-LABS/stablenode/ent/unmatcheddeposit"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 // TokenUpdate is the builder for updating Token entities.
@@ -117,6 +120,47 @@ func (tu *TokenUpdate) SetNillableBaseCurrency(s *string) *TokenUpdate {
 	return tu
 }
 
+// SetUsdPrice sets the "usd_price" field.
+func (tu *TokenUpdate) SetUsdPrice(d decimal.Decimal) *TokenUpdate {
+	tu.mutation.ResetUsdPrice()
+	tu.mutation.SetUsdPrice(d)
+	return tu
+}
+
+// SetNillableUsdPrice sets the "usd_price" field if the given value is not nil.
+func (tu *TokenUpdate) SetNillableUsdPrice(d *decimal.Decimal) *TokenUpdate {
+	if d != nil {
+		tu.SetUsdPrice(*d)
+	}
+	return tu
+}
+
+// AddUsdPrice adds d to the "usd_price" field.
+func (tu *TokenUpdate) AddUsdPrice(d decimal.Decimal) *TokenUpdate {
+	tu.mutation.AddUsdPrice(d)
+	return tu
+}
+
+// SetDepeggedAt sets the "depegged_at" field.
+func (tu *TokenUpdate) SetDepeggedAt(t time.Time) *TokenUpdate {
+	tu.mutation.SetDepeggedAt(t)
+	return tu
+}
+
+// SetNillableDepeggedAt sets the "depegged_at" field if the given value is not nil.
+func (tu *TokenUpdate) SetNillableDepeggedAt(t *time.Time) *TokenUpdate {
+	if t != nil {
+		tu.SetDepeggedAt(*t)
+	}
+	return tu
+}
+
+// ClearDepeggedAt clears the value of the "depegged_at" field.
+func (tu *TokenUpdate) ClearDepeggedAt() *TokenUpdate {
+	tu.mutation.ClearDepeggedAt()
+	return tu
+}
+
 // SetNetworkID sets the "network" edge to the Network entity by ID.
 func (tu *TokenUpdate) SetNetworkID(id int) *TokenUpdate {
 	tu.mutation.SetNetworkID(id)
@@ -188,6 +232,36 @@ func (tu *TokenUpdate) AddProviderOrderTokens(p ...*ProviderOrderToken) *TokenUp
 	return tu.AddProviderOrderTokenIDs(ids...)
 }
 
+// AddLedgerAccountIDs adds the "ledger_accounts" edge to the LedgerAccount entity by IDs.
+func (tu *TokenUpdate) AddLedgerAccountIDs(ids ...uuid.UUID) *TokenUpdate {
+	tu.mutation.AddLedgerAccountIDs(ids...)
+	return tu
+}
+
+// AddLedgerAccounts adds the "ledger_accounts" edges to the LedgerAccount entity.
+func (tu *TokenUpdate) AddLedgerAccounts(l ...*LedgerAccount) *TokenUpdate {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return tu.AddLedgerAccountIDs(ids...)
+}
+
+// AddUnmatchedDepositIDs adds the "unmatched_deposits" edge to the UnmatchedDeposit entity by IDs.
+func (tu *TokenUpdate) AddUnmatchedDepositIDs(ids ...uuid.UUID) *TokenUpdate {
+	tu.mutation.AddUnmatchedDepositIDs(ids...)
+	return tu
+}
+
+// AddUnmatchedDeposits adds the "unmatched_deposits" edges to the UnmatchedDeposit entity.
+func (tu *TokenUpdate) AddUnmatchedDeposits(u ...*UnmatchedDeposit) *TokenUpdate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return tu.AddUnmatchedDepositIDs(ids...)
+}
+
 // Mutation returns the TokenMutation object of the builder.
 func (tu *TokenUpdate) Mutation() *TokenMutation {
 	return tu.mutation
@@ -283,6 +357,48 @@ func (tu *TokenUpdate) RemoveProviderOrderTokens(p ...*ProviderOrderToken) *Toke
 	return tu.RemoveProviderOrderTokenIDs(ids...)
 }
 
+// ClearLedgerAccounts clears all "ledger_accounts" edges to the LedgerAccount entity.
+func (tu *TokenUpdate) ClearLedgerAccounts() *TokenUpdate {
+	tu.mutation.ClearLedgerAccounts()
+	return tu
+}
+
+// RemoveLedgerAccountIDs removes the "ledger_accounts" edge to LedgerAccount entities by IDs.
+func (tu *TokenUpdate) RemoveLedgerAccountIDs(ids ...uuid.UUID) *TokenUpdate {
+	tu.mutation.RemoveLedgerAccountIDs(ids...)
+	return tu
+}
+
+// RemoveLedgerAccounts removes "ledger_accounts" edges to LedgerAccount entities.
+func (tu *TokenUpdate) RemoveLedgerAccounts(l ...*LedgerAccount) *TokenUpdate {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return tu.RemoveLedgerAccountIDs(ids...)
+}
+
+// ClearUnmatchedDeposits clears all "unmatched_deposits" edges to the UnmatchedDeposit entity.
+func (tu *TokenUpdate) ClearUnmatchedDeposits() *TokenUpdate {
+	tu.mutation.ClearUnmatchedDeposits()
+	return tu
+}
+
+// RemoveUnmatchedDepositIDs removes the "unmatched_deposits" edge to UnmatchedDeposit entities by IDs.
+func (tu *TokenUpdate) RemoveUnmatchedDepositIDs(ids ...uuid.UUID) *TokenUpdate {
+	tu.mutation.RemoveUnmatchedDepositIDs(ids...)
+	return tu
+}
+
+// RemoveUnmatchedDeposits removes "unmatched_deposits" edges to UnmatchedDeposit entities.
+func (tu *TokenUpdate) RemoveUnmatchedDeposits(u ...*UnmatchedDeposit) *TokenUpdate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return tu.RemoveUnmatchedDepositIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (tu *TokenUpdate) Save(ctx context.Context) (int, error) {
 	tu.defaults()
@@ -370,6 +486,18 @@ func (tu *TokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := tu.mutation.BaseCurrency(); ok {
 		_spec.SetField(token.FieldBaseCurrency, field.TypeString, value)
 	}
+	if value, ok := tu.mutation.UsdPrice(); ok {
+		_spec.SetField(token.FieldUsdPrice, field.TypeFloat64, value)
+	}
+	if value, ok := tu.mutation.AddedUsdPrice(); ok {
+		_spec.AddField(token.FieldUsdPrice, field.TypeFloat64, value)
+	}
+	if value, ok := tu.mutation.DepeggedAt(); ok {
+		_spec.SetField(token.FieldDepeggedAt, field.TypeTime, value)
+	}
+	if tu.mutation.DepeggedAtCleared() {
+		_spec.ClearField(token.FieldDepeggedAt, field.TypeTime)
+	}
 	if tu.mutation.NetworkCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -579,6 +707,96 @@ func (tu *TokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tu.mutation.LedgerAccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   token.LedgerAccountsTable,
+			Columns: []string{token.LedgerAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgeraccount.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedLedgerAccountsIDs(); len(nodes) > 0 && !tu.mutation.LedgerAccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   token.LedgerAccountsTable,
+			Columns: []string{token.LedgerAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgeraccount.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.LedgerAccountsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   token.LedgerAccountsTable,
+			Columns: []string{token.LedgerAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgeraccount.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.UnmatchedDepositsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   token.UnmatchedDepositsTable,
+			Columns: []string{token.UnmatchedDepositsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(unmatcheddeposit.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedUnmatchedDepositsIDs(); len(nodes) > 0 && !tu.mutation.UnmatchedDepositsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   token.UnmatchedDepositsTable,
+			Columns: []string{token.UnmatchedDepositsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(unmatcheddeposit.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.UnmatchedDepositsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   token.UnmatchedDepositsTable,
+			Columns: []string{token.UnmatchedDepositsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(unmatcheddeposit.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{token.Label}
@@ -682,6 +900,47 @@ func (tuo *TokenUpdateOne) SetNillableBaseCurrency(s *string) *TokenUpdateOne {
 	return tuo
 }
 
+// SetUsdPrice sets the "usd_price" field.
+func (tuo *TokenUpdateOne) SetUsdPrice(d decimal.Decimal) *TokenUpdateOne {
+	tuo.mutation.ResetUsdPrice()
+	tuo.mutation.SetUsdPrice(d)
+	return tuo
+}
+
+// SetNillableUsdPrice sets the "usd_price" field if the given value is not nil.
+func (tuo *TokenUpdateOne) SetNillableUsdPrice(d *decimal.Decimal) *TokenUpdateOne {
+	if d != nil {
+		tuo.SetUsdPrice(*d)
+	}
+	return tuo
+}
+
+// AddUsdPrice adds d to the "usd_price" field.
+func (tuo *TokenUpdateOne) AddUsdPrice(d decimal.Decimal) *TokenUpdateOne {
+	tuo.mutation.AddUsdPrice(d)
+	return tuo
+}
+
+// SetDepeggedAt sets the "depegged_at" field.
+func (tuo *TokenUpdateOne) SetDepeggedAt(t time.Time) *TokenUpdateOne {
+	tuo.mutation.SetDepeggedAt(t)
+	return tuo
+}
+
+// SetNillableDepeggedAt sets the "depegged_at" field if the given value is not nil.
+func (tuo *TokenUpdateOne) SetNillableDepeggedAt(t *time.Time) *TokenUpdateOne {
+	if t != nil {
+		tuo.SetDepeggedAt(*t)
+	}
+	return tuo
+}
+
+// ClearDepeggedAt clears the value of the "depegged_at" field.
+func (tuo *TokenUpdateOne) ClearDepeggedAt() *TokenUpdateOne {
+	tuo.mutation.ClearDepeggedAt()
+	return tuo
+}
+
 // SetNetworkID sets the "network" edge to the Network entity by ID.
 func (tuo *TokenUpdateOne) SetNetworkID(id int) *TokenUpdateOne {
 	tuo.mutation.SetNetworkID(id)
@@ -753,6 +1012,36 @@ func (tuo *TokenUpdateOne) AddProviderOrderTokens(p ...*ProviderOrderToken) *Tok
 	return tuo.AddProviderOrderTokenIDs(ids...)
 }
 
+// AddLedgerAccountIDs adds the "ledger_accounts" edge to the LedgerAccount entity by IDs.
+func (tuo *TokenUpdateOne) AddLedgerAccountIDs(ids ...uuid.UUID) *TokenUpdateOne {
+	tuo.mutation.AddLedgerAccountIDs(ids...)
+	return tuo
+}
+
+// AddLedgerAccounts adds the "ledger_accounts" edges to the LedgerAccount entity.
+func (tuo *TokenUpdateOne) AddLedgerAccounts(l ...*LedgerAccount) *TokenUpdateOne {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return tuo.AddLedgerAccountIDs(ids...)
+}
+
+// AddUnmatchedDepositIDs adds the "unmatched_deposits" edge to the UnmatchedDeposit entity by IDs.
+func (tuo *TokenUpdateOne) AddUnmatchedDepositIDs(ids ...uuid.UUID) *TokenUpdateOne {
+	tuo.mutation.AddUnmatchedDepositIDs(ids...)
+	return tuo
+}
+
+// AddUnmatchedDeposits adds the "unmatched_deposits" edges to the UnmatchedDeposit entity.
+func (tuo *TokenUpdateOne) AddUnmatchedDeposits(u ...*UnmatchedDeposit) *TokenUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return tuo.AddUnmatchedDepositIDs(ids...)
+}
+
 // Mutation returns the TokenMutation object of the builder.
 func (tuo *TokenUpdateOne) Mutation() *TokenMutation {
 	return tuo.mutation
@@ -848,6 +1137,48 @@ func (tuo *TokenUpdateOne) RemoveProviderOrderTokens(p ...*ProviderOrderToken) *
 	return tuo.RemoveProviderOrderTokenIDs(ids...)
 }
 
+// ClearLedgerAccounts clears all "ledger_accounts" edges to the LedgerAccount entity.
+func (tuo *TokenUpdateOne) ClearLedgerAccounts() *TokenUpdateOne {
+	tuo.mutation.ClearLedgerAccounts()
+	return tuo
+}
+
+// RemoveLedgerAccountIDs removes the "ledger_accounts" edge to LedgerAccount entities by IDs.
+func (tuo *TokenUpdateOne) RemoveLedgerAccountIDs(ids ...uuid.UUID) *TokenUpdateOne {
+	tuo.mutation.RemoveLedgerAccountIDs(ids...)
+	return tuo
+}
+
+// RemoveLedgerAccounts removes "ledger_accounts" edges to LedgerAccount entities.
+func (tuo *TokenUpdateOne) RemoveLedgerAccounts(l ...*LedgerAccount) *TokenUpdateOne {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return tuo.RemoveLedgerAccountIDs(ids...)
+}
+
+// ClearUnmatchedDeposits clears all "unmatched_deposits" edges to the UnmatchedDeposit entity.
+func (tuo *TokenUpdateOne) ClearUnmatchedDeposits() *TokenUpdateOne {
+	tuo.mutation.ClearUnmatchedDeposits()
+	return tuo
+}
+
+// RemoveUnmatchedDepositIDs removes the "unmatched_deposits" edge to UnmatchedDeposit entities by IDs.
+func (tuo *TokenUpdateOne) RemoveUnmatchedDepositIDs(ids ...uuid.UUID) *TokenUpdateOne {
+	tuo.mutation.RemoveUnmatchedDepositIDs(ids...)
+	return tuo
+}
+
+// RemoveUnmatchedDeposits removes "unmatched_deposits" edges to UnmatchedDeposit entities.
+func (tuo *TokenUpdateOne) RemoveUnmatchedDeposits(u ...*UnmatchedDeposit) *TokenUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return tuo.RemoveUnmatchedDepositIDs(ids...)
+}
+
 // Where appends a list predicates to the TokenUpdate builder.
 func (tuo *TokenUpdateOne) Where(ps ...predicate.Token) *TokenUpdateOne {
 	tuo.mutation.Where(ps...)
@@ -965,6 +1296,18 @@ func (tuo *TokenUpdateOne) sqlSave(ctx context.Context) (_node *Token, err error
 	if value, ok := tuo.mutation.BaseCurrency(); ok {
 		_spec.SetField(token.FieldBaseCurrency, field.TypeString, value)
 	}
+	if value, ok := tuo.mutation.UsdPrice(); ok {
+		_spec.SetField(token.FieldUsdPrice, field.TypeFloat64, value)
+	}
+	if value, ok := tuo.mutation.AddedUsdPrice(); ok {
+		_spec.AddField(token.FieldUsdPrice, field.TypeFloat64, value)
+	}
+	if value, ok := tuo.mutation.DepeggedAt(); ok {
+		_spec.SetField(token.FieldDepeggedAt, field.TypeTime, value)
+	}
+	if tuo.mutation.DepeggedAtCleared() {
+		_spec.ClearField(token.FieldDepeggedAt, field.TypeTime)
+	}
 	if tuo.mutation.NetworkCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1174,6 +1517,96 @@ func (tuo *TokenUpdateOne) sqlSave(ctx context.Context) (_node *Token, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tuo.mutation.LedgerAccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   token.LedgerAccountsTable,
+			Columns: []string{token.LedgerAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgeraccount.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedLedgerAccountsIDs(); len(nodes) > 0 && !tuo.mutation.LedgerAccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   token.LedgerAccountsTable,
+			Columns: []string{token.LedgerAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgeraccount.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.LedgerAccountsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   token.LedgerAccountsTable,
+			Columns: []string{token.LedgerAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgeraccount.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.UnmatchedDepositsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   token.UnmatchedDepositsTable,
+			Columns: []string{token.UnmatchedDepositsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(unmatcheddeposit.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedUnmatchedDepositsIDs(); len(nodes) > 0 && !tuo.mutation.UnmatchedDepositsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   token.UnmatchedDepositsTable,
+			Columns: []string{token.UnmatchedDepositsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(unmatcheddeposit.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.UnmatchedDepositsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   token.UnmatchedDepositsTable,
+			Columns: []string{token.UnmatchedDepositsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(unmatcheddeposit.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Token{config: tuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
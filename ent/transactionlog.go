@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 // TransactionLog is the model entity for the TransactionLog schema.
@@ -30,20 +31,79 @@ type TransactionLog struct {
 	// Metadata holds the value of the "metadata" field.
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
-	CreatedAt                       time.Time `json:"created_at,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Purpose holds the value of the "purpose" field.
+	Purpose *transactionlog.Purpose `json:"purpose,omitempty"`
+	// PaymentOrderID holds the value of the "payment_order_id" field.
+	PaymentOrderID *uuid.UUID `json:"payment_order_id,omitempty"`
+	// Sponsored holds the value of the "sponsored" field.
+	Sponsored bool `json:"sponsored,omitempty"`
+	// GasPolicyID holds the value of the "gas_policy_id" field.
+	GasPolicyID string `json:"gas_policy_id,omitempty"`
+	// GasUsed holds the value of the "gas_used" field.
+	GasUsed *int64 `json:"gas_used,omitempty"`
+	// GasCost holds the value of the "gas_cost" field.
+	GasCost *decimal.Decimal `json:"gas_cost,omitempty"`
+	// DepositOrderID holds the value of the "deposit_order_id" field.
+	DepositOrderID *uuid.UUID `json:"deposit_order_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TransactionLogQuery when eager-loading is set.
+	Edges                           TransactionLogEdges `json:"edges"`
 	lock_payment_order_transactions *uuid.UUID
 	payment_order_transactions      *uuid.UUID
+	transaction_log_replaced_by     *uuid.UUID
 	selectValues                    sql.SelectValues
 }
 
+// TransactionLogEdges holds the relations/edges for other nodes in the graph.
+type TransactionLogEdges struct {
+	// Replaces holds the value of the replaces edge.
+	Replaces *TransactionLog `json:"replaces,omitempty"`
+	// ReplacedBy holds the value of the replaced_by edge.
+	ReplacedBy *TransactionLog `json:"replaced_by,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ReplacesOrErr returns the Replaces value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TransactionLogEdges) ReplacesOrErr() (*TransactionLog, error) {
+	if e.Replaces != nil {
+		return e.Replaces, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: transactionlog.Label}
+	}
+	return nil, &NotLoadedError{edge: "replaces"}
+}
+
+// ReplacedByOrErr returns the ReplacedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TransactionLogEdges) ReplacedByOrErr() (*TransactionLog, error) {
+	if e.ReplacedBy != nil {
+		return e.ReplacedBy, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: transactionlog.Label}
+	}
+	return nil, &NotLoadedError{edge: "replaced_by"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*TransactionLog) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case transactionlog.FieldGasCost:
+			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
+		case transactionlog.FieldPaymentOrderID, transactionlog.FieldDepositOrderID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case transactionlog.FieldMetadata:
 			values[i] = new([]byte)
-		case transactionlog.FieldGatewayID, transactionlog.FieldStatus, transactionlog.FieldNetwork, transactionlog.FieldTxHash:
+		case transactionlog.FieldSponsored:
+			values[i] = new(sql.NullBool)
+		case transactionlog.FieldGasUsed:
+			values[i] = new(sql.NullInt64)
+		case transactionlog.FieldGatewayID, transactionlog.FieldStatus, transactionlog.FieldNetwork, transactionlog.FieldTxHash, transactionlog.FieldPurpose, transactionlog.FieldGasPolicyID:
 			values[i] = new(sql.NullString)
 		case transactionlog.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -53,6 +113,8 @@ func (*TransactionLog) scanValues(columns []string) ([]any, error) {
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case transactionlog.ForeignKeys[1]: // payment_order_transactions
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case transactionlog.ForeignKeys[2]: // transaction_log_replaced_by
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -112,6 +174,53 @@ func (tl *TransactionLog) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				tl.CreatedAt = value.Time
 			}
+		case transactionlog.FieldPurpose:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field purpose", values[i])
+			} else if value.Valid {
+				tl.Purpose = new(transactionlog.Purpose)
+				*tl.Purpose = transactionlog.Purpose(value.String)
+			}
+		case transactionlog.FieldPaymentOrderID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_order_id", values[i])
+			} else if value.Valid {
+				tl.PaymentOrderID = new(uuid.UUID)
+				*tl.PaymentOrderID = *value.S.(*uuid.UUID)
+			}
+		case transactionlog.FieldSponsored:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field sponsored", values[i])
+			} else if value.Valid {
+				tl.Sponsored = value.Bool
+			}
+		case transactionlog.FieldGasPolicyID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field gas_policy_id", values[i])
+			} else if value.Valid {
+				tl.GasPolicyID = value.String
+			}
+		case transactionlog.FieldGasUsed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field gas_used", values[i])
+			} else if value.Valid {
+				tl.GasUsed = new(int64)
+				*tl.GasUsed = value.Int64
+			}
+		case transactionlog.FieldGasCost:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field gas_cost", values[i])
+			} else if value.Valid {
+				tl.GasCost = new(decimal.Decimal)
+				*tl.GasCost = *value.S.(*decimal.Decimal)
+			}
+		case transactionlog.FieldDepositOrderID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field deposit_order_id", values[i])
+			} else if value.Valid {
+				tl.DepositOrderID = new(uuid.UUID)
+				*tl.DepositOrderID = *value.S.(*uuid.UUID)
+			}
 		case transactionlog.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field lock_payment_order_transactions", values[i])
@@ -126,6 +235,13 @@ func (tl *TransactionLog) assignValues(columns []string, values []any) error {
 				tl.payment_order_transactions = new(uuid.UUID)
 				*tl.payment_order_transactions = *value.S.(*uuid.UUID)
 			}
+		case transactionlog.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field transaction_log_replaced_by", values[i])
+			} else if value.Valid {
+				tl.transaction_log_replaced_by = new(uuid.UUID)
+				*tl.transaction_log_replaced_by = *value.S.(*uuid.UUID)
+			}
 		default:
 			tl.selectValues.Set(columns[i], values[i])
 		}
@@ -139,6 +255,16 @@ func (tl *TransactionLog) Value(name string) (ent.Value, error) {
 	return tl.selectValues.Get(name)
 }
 
+// QueryReplaces queries the "replaces" edge of the TransactionLog entity.
+func (tl *TransactionLog) QueryReplaces() *TransactionLogQuery {
+	return NewTransactionLogClient(tl.config).QueryReplaces(tl)
+}
+
+// QueryReplacedBy queries the "replaced_by" edge of the TransactionLog entity.
+func (tl *TransactionLog) QueryReplacedBy() *TransactionLogQuery {
+	return NewTransactionLogClient(tl.config).QueryReplacedBy(tl)
+}
+
 // Update returns a builder for updating this TransactionLog.
 // Note that you need to call TransactionLog.Unwrap() before calling this method if this TransactionLog
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -179,6 +305,37 @@ func (tl *TransactionLog) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(tl.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := tl.Purpose; v != nil {
+		builder.WriteString("purpose=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := tl.PaymentOrderID; v != nil {
+		builder.WriteString("payment_order_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("sponsored=")
+	builder.WriteString(fmt.Sprintf("%v", tl.Sponsored))
+	builder.WriteString(", ")
+	builder.WriteString("gas_policy_id=")
+	builder.WriteString(tl.GasPolicyID)
+	builder.WriteString(", ")
+	if v := tl.GasUsed; v != nil {
+		builder.WriteString("gas_used=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := tl.GasCost; v != nil {
+		builder.WriteString("gas_cost=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := tl.DepositOrderID; v != nil {
+		builder.WriteString("deposit_order_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
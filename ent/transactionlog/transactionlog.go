@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
 )
 
@@ -27,8 +28,34 @@ const (
 	FieldMetadata = "metadata"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
+	// FieldPurpose holds the string denoting the purpose field in the database.
+	FieldPurpose = "purpose"
+	// FieldPaymentOrderID holds the string denoting the payment_order_id field in the database.
+	FieldPaymentOrderID = "payment_order_id"
+	// FieldSponsored holds the string denoting the sponsored field in the database.
+	FieldSponsored = "sponsored"
+	// FieldGasPolicyID holds the string denoting the gas_policy_id field in the database.
+	FieldGasPolicyID = "gas_policy_id"
+	// FieldGasUsed holds the string denoting the gas_used field in the database.
+	FieldGasUsed = "gas_used"
+	// FieldGasCost holds the string denoting the gas_cost field in the database.
+	FieldGasCost = "gas_cost"
+	// FieldDepositOrderID holds the string denoting the deposit_order_id field in the database.
+	FieldDepositOrderID = "deposit_order_id"
+	// EdgeReplaces holds the string denoting the replaces edge name in mutations.
+	EdgeReplaces = "replaces"
+	// EdgeReplacedBy holds the string denoting the replaced_by edge name in mutations.
+	EdgeReplacedBy = "replaced_by"
 	// Table holds the table name of the transactionlog in the database.
 	Table = "transaction_logs"
+	// ReplacesTable is the table that holds the replaces relation/edge.
+	ReplacesTable = "transaction_logs"
+	// ReplacesColumn is the table column denoting the replaces relation/edge.
+	ReplacesColumn = "transaction_log_replaced_by"
+	// ReplacedByTable is the table that holds the replaced_by relation/edge.
+	ReplacedByTable = "transaction_logs"
+	// ReplacedByColumn is the table column denoting the replaced_by relation/edge.
+	ReplacedByColumn = "transaction_log_replaced_by"
 )
 
 // Columns holds all SQL columns for transactionlog fields.
@@ -40,6 +67,13 @@ var Columns = []string{
 	FieldTxHash,
 	FieldMetadata,
 	FieldCreatedAt,
+	FieldPurpose,
+	FieldPaymentOrderID,
+	FieldSponsored,
+	FieldGasPolicyID,
+	FieldGasUsed,
+	FieldGasCost,
+	FieldDepositOrderID,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "transaction_logs"
@@ -47,6 +81,7 @@ var Columns = []string{
 var ForeignKeys = []string{
 	"lock_payment_order_transactions",
 	"payment_order_transactions",
+	"transaction_log_replaced_by",
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -67,6 +102,8 @@ func ValidColumn(column string) bool {
 var (
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
+	// DefaultSponsored holds the default value on creation for the "sponsored" field.
+	DefaultSponsored bool
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -79,16 +116,21 @@ const DefaultStatus = StatusOrderInitiated
 
 // Status values.
 const (
-	StatusOrderInitiated  Status = "order_initiated"
-	StatusCryptoDeposited Status = "crypto_deposited"
-	StatusOrderCreated    Status = "order_created"
-	StatusOrderProcessing Status = "order_processing"
-	StatusOrderFulfilled  Status = "order_fulfilled"
-	StatusOrderValidated  Status = "order_validated"
-	StatusOrderSettled    Status = "order_settled"
-	StatusOrderRefunded   Status = "order_refunded"
-	StatusGasPrefunded    Status = "gas_prefunded"
-	StatusGatewayApproved Status = "gateway_approved"
+	StatusOrderInitiated         Status = "order_initiated"
+	StatusCryptoDeposited        Status = "crypto_deposited"
+	StatusOrderCreated           Status = "order_created"
+	StatusOrderProcessing        Status = "order_processing"
+	StatusOrderFulfilled         Status = "order_fulfilled"
+	StatusOrderValidated         Status = "order_validated"
+	StatusOrderSettled           Status = "order_settled"
+	StatusOrderRefunded          Status = "order_refunded"
+	StatusGasPrefunded           Status = "gas_prefunded"
+	StatusGatewayApproved        Status = "gateway_approved"
+	StatusOverpaymentRefunded    Status = "overpayment_refunded"
+	StatusCryptoDepositReverted  Status = "crypto_deposit_reverted"
+	StatusReceiveAddressMigrated Status = "receive_address_migrated"
+	StatusUserOperationSent      Status = "user_operation_sent"
+	StatusPermitDepositSent      Status = "permit_deposit_sent"
 )
 
 func (s Status) String() string {
@@ -98,13 +140,39 @@ func (s Status) String() string {
 // StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
 func StatusValidator(s Status) error {
 	switch s {
-	case StatusOrderInitiated, StatusCryptoDeposited, StatusOrderCreated, StatusOrderProcessing, StatusOrderFulfilled, StatusOrderValidated, StatusOrderSettled, StatusOrderRefunded, StatusGasPrefunded, StatusGatewayApproved:
+	case StatusOrderInitiated, StatusCryptoDeposited, StatusOrderCreated, StatusOrderProcessing, StatusOrderFulfilled, StatusOrderValidated, StatusOrderSettled, StatusOrderRefunded, StatusGasPrefunded, StatusGatewayApproved, StatusOverpaymentRefunded, StatusCryptoDepositReverted, StatusReceiveAddressMigrated, StatusUserOperationSent, StatusPermitDepositSent:
 		return nil
 	default:
 		return fmt.Errorf("transactionlog: invalid enum value for status field: %q", s)
 	}
 }
 
+// Purpose defines the type for the "purpose" enum field.
+type Purpose string
+
+// Purpose values.
+const (
+	PurposeDeployment    Purpose = "deployment"
+	PurposeOrderCreation Purpose = "order_creation"
+	PurposeSettlement    Purpose = "settlement"
+	PurposeRefund        Purpose = "refund"
+	PurposeSweep         Purpose = "sweep"
+)
+
+func (pu Purpose) String() string {
+	return string(pu)
+}
+
+// PurposeValidator is a validator for the "purpose" field enum values. It is called by the builders before save.
+func PurposeValidator(pu Purpose) error {
+	switch pu {
+	case PurposeDeployment, PurposeOrderCreation, PurposeSettlement, PurposeRefund, PurposeSweep:
+		return nil
+	default:
+		return fmt.Errorf("transactionlog: invalid enum value for purpose field: %q", pu)
+	}
+}
+
 // OrderOption defines the ordering options for the TransactionLog queries.
 type OrderOption func(*sql.Selector)
 
@@ -137,3 +205,66 @@ func ByTxHash(opts ...sql.OrderTermOption) OrderOption {
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
 }
+
+// ByPurpose orders the results by the purpose field.
+func ByPurpose(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPurpose, opts...).ToFunc()
+}
+
+// ByPaymentOrderID orders the results by the payment_order_id field.
+func ByPaymentOrderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaymentOrderID, opts...).ToFunc()
+}
+
+// BySponsored orders the results by the sponsored field.
+func BySponsored(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSponsored, opts...).ToFunc()
+}
+
+// ByGasPolicyID orders the results by the gas_policy_id field.
+func ByGasPolicyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGasPolicyID, opts...).ToFunc()
+}
+
+// ByGasUsed orders the results by the gas_used field.
+func ByGasUsed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGasUsed, opts...).ToFunc()
+}
+
+// ByGasCost orders the results by the gas_cost field.
+func ByGasCost(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGasCost, opts...).ToFunc()
+}
+
+// ByDepositOrderID orders the results by the deposit_order_id field.
+func ByDepositOrderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDepositOrderID, opts...).ToFunc()
+}
+
+// ByReplacesField orders the results by replaces field.
+func ByReplacesField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReplacesStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByReplacedByField orders the results by replaced_by field.
+func ByReplacedByField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReplacedByStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newReplacesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, ReplacesTable, ReplacesColumn),
+	)
+}
+func newReplacedByStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, ReplacedByTable, ReplacedByColumn),
+	)
+}
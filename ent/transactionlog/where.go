@@ -6,8 +6,10 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/NEDA-LABS/stablenode/ent/predicate"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 // ID filters vertices based on their ID field.
@@ -75,6 +77,36 @@ func CreatedAt(v time.Time) predicate.TransactionLog {
 	return predicate.TransactionLog(sql.FieldEQ(FieldCreatedAt, v))
 }
 
+// PaymentOrderID applies equality check predicate on the "payment_order_id" field. It's identical to PaymentOrderIDEQ.
+func PaymentOrderID(v uuid.UUID) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldEQ(FieldPaymentOrderID, v))
+}
+
+// Sponsored applies equality check predicate on the "sponsored" field. It's identical to SponsoredEQ.
+func Sponsored(v bool) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldEQ(FieldSponsored, v))
+}
+
+// GasPolicyID applies equality check predicate on the "gas_policy_id" field. It's identical to GasPolicyIDEQ.
+func GasPolicyID(v string) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldEQ(FieldGasPolicyID, v))
+}
+
+// GasUsed applies equality check predicate on the "gas_used" field. It's identical to GasUsedEQ.
+func GasUsed(v int64) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldEQ(FieldGasUsed, v))
+}
+
+// GasCost applies equality check predicate on the "gas_cost" field. It's identical to GasCostEQ.
+func GasCost(v decimal.Decimal) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldEQ(FieldGasCost, v))
+}
+
+// DepositOrderID applies equality check predicate on the "deposit_order_id" field. It's identical to DepositOrderIDEQ.
+func DepositOrderID(v uuid.UUID) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldEQ(FieldDepositOrderID, v))
+}
+
 // GatewayIDEQ applies the EQ predicate on the "gateway_id" field.
 func GatewayIDEQ(v string) predicate.TransactionLog {
 	return predicate.TransactionLog(sql.FieldEQ(FieldGatewayID, v))
@@ -360,6 +392,367 @@ func CreatedAtLTE(v time.Time) predicate.TransactionLog {
 	return predicate.TransactionLog(sql.FieldLTE(FieldCreatedAt, v))
 }
 
+// PurposeEQ applies the EQ predicate on the "purpose" field.
+func PurposeEQ(v Purpose) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldEQ(FieldPurpose, v))
+}
+
+// PurposeNEQ applies the NEQ predicate on the "purpose" field.
+func PurposeNEQ(v Purpose) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldNEQ(FieldPurpose, v))
+}
+
+// PurposeIn applies the In predicate on the "purpose" field.
+func PurposeIn(vs ...Purpose) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldIn(FieldPurpose, vs...))
+}
+
+// PurposeNotIn applies the NotIn predicate on the "purpose" field.
+func PurposeNotIn(vs ...Purpose) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldNotIn(FieldPurpose, vs...))
+}
+
+// PurposeIsNil applies the IsNil predicate on the "purpose" field.
+func PurposeIsNil() predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldIsNull(FieldPurpose))
+}
+
+// PurposeNotNil applies the NotNil predicate on the "purpose" field.
+func PurposeNotNil() predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldNotNull(FieldPurpose))
+}
+
+// PaymentOrderIDEQ applies the EQ predicate on the "payment_order_id" field.
+func PaymentOrderIDEQ(v uuid.UUID) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldEQ(FieldPaymentOrderID, v))
+}
+
+// PaymentOrderIDNEQ applies the NEQ predicate on the "payment_order_id" field.
+func PaymentOrderIDNEQ(v uuid.UUID) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldNEQ(FieldPaymentOrderID, v))
+}
+
+// PaymentOrderIDIn applies the In predicate on the "payment_order_id" field.
+func PaymentOrderIDIn(vs ...uuid.UUID) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldIn(FieldPaymentOrderID, vs...))
+}
+
+// PaymentOrderIDNotIn applies the NotIn predicate on the "payment_order_id" field.
+func PaymentOrderIDNotIn(vs ...uuid.UUID) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldNotIn(FieldPaymentOrderID, vs...))
+}
+
+// PaymentOrderIDGT applies the GT predicate on the "payment_order_id" field.
+func PaymentOrderIDGT(v uuid.UUID) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldGT(FieldPaymentOrderID, v))
+}
+
+// PaymentOrderIDGTE applies the GTE predicate on the "payment_order_id" field.
+func PaymentOrderIDGTE(v uuid.UUID) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldGTE(FieldPaymentOrderID, v))
+}
+
+// PaymentOrderIDLT applies the LT predicate on the "payment_order_id" field.
+func PaymentOrderIDLT(v uuid.UUID) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldLT(FieldPaymentOrderID, v))
+}
+
+// PaymentOrderIDLTE applies the LTE predicate on the "payment_order_id" field.
+func PaymentOrderIDLTE(v uuid.UUID) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldLTE(FieldPaymentOrderID, v))
+}
+
+// PaymentOrderIDIsNil applies the IsNil predicate on the "payment_order_id" field.
+func PaymentOrderIDIsNil() predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldIsNull(FieldPaymentOrderID))
+}
+
+// PaymentOrderIDNotNil applies the NotNil predicate on the "payment_order_id" field.
+func PaymentOrderIDNotNil() predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldNotNull(FieldPaymentOrderID))
+}
+
+// SponsoredEQ applies the EQ predicate on the "sponsored" field.
+func SponsoredEQ(v bool) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldEQ(FieldSponsored, v))
+}
+
+// SponsoredNEQ applies the NEQ predicate on the "sponsored" field.
+func SponsoredNEQ(v bool) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldNEQ(FieldSponsored, v))
+}
+
+// GasPolicyIDEQ applies the EQ predicate on the "gas_policy_id" field.
+func GasPolicyIDEQ(v string) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldEQ(FieldGasPolicyID, v))
+}
+
+// GasPolicyIDNEQ applies the NEQ predicate on the "gas_policy_id" field.
+func GasPolicyIDNEQ(v string) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldNEQ(FieldGasPolicyID, v))
+}
+
+// GasPolicyIDIn applies the In predicate on the "gas_policy_id" field.
+func GasPolicyIDIn(vs ...string) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldIn(FieldGasPolicyID, vs...))
+}
+
+// GasPolicyIDNotIn applies the NotIn predicate on the "gas_policy_id" field.
+func GasPolicyIDNotIn(vs ...string) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldNotIn(FieldGasPolicyID, vs...))
+}
+
+// GasPolicyIDGT applies the GT predicate on the "gas_policy_id" field.
+func GasPolicyIDGT(v string) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldGT(FieldGasPolicyID, v))
+}
+
+// GasPolicyIDGTE applies the GTE predicate on the "gas_policy_id" field.
+func GasPolicyIDGTE(v string) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldGTE(FieldGasPolicyID, v))
+}
+
+// GasPolicyIDLT applies the LT predicate on the "gas_policy_id" field.
+func GasPolicyIDLT(v string) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldLT(FieldGasPolicyID, v))
+}
+
+// GasPolicyIDLTE applies the LTE predicate on the "gas_policy_id" field.
+func GasPolicyIDLTE(v string) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldLTE(FieldGasPolicyID, v))
+}
+
+// GasPolicyIDContains applies the Contains predicate on the "gas_policy_id" field.
+func GasPolicyIDContains(v string) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldContains(FieldGasPolicyID, v))
+}
+
+// GasPolicyIDHasPrefix applies the HasPrefix predicate on the "gas_policy_id" field.
+func GasPolicyIDHasPrefix(v string) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldHasPrefix(FieldGasPolicyID, v))
+}
+
+// GasPolicyIDHasSuffix applies the HasSuffix predicate on the "gas_policy_id" field.
+func GasPolicyIDHasSuffix(v string) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldHasSuffix(FieldGasPolicyID, v))
+}
+
+// GasPolicyIDIsNil applies the IsNil predicate on the "gas_policy_id" field.
+func GasPolicyIDIsNil() predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldIsNull(FieldGasPolicyID))
+}
+
+// GasPolicyIDNotNil applies the NotNil predicate on the "gas_policy_id" field.
+func GasPolicyIDNotNil() predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldNotNull(FieldGasPolicyID))
+}
+
+// GasPolicyIDEqualFold applies the EqualFold predicate on the "gas_policy_id" field.
+func GasPolicyIDEqualFold(v string) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldEqualFold(FieldGasPolicyID, v))
+}
+
+// GasPolicyIDContainsFold applies the ContainsFold predicate on the "gas_policy_id" field.
+func GasPolicyIDContainsFold(v string) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldContainsFold(FieldGasPolicyID, v))
+}
+
+// GasUsedEQ applies the EQ predicate on the "gas_used" field.
+func GasUsedEQ(v int64) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldEQ(FieldGasUsed, v))
+}
+
+// GasUsedNEQ applies the NEQ predicate on the "gas_used" field.
+func GasUsedNEQ(v int64) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldNEQ(FieldGasUsed, v))
+}
+
+// GasUsedIn applies the In predicate on the "gas_used" field.
+func GasUsedIn(vs ...int64) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldIn(FieldGasUsed, vs...))
+}
+
+// GasUsedNotIn applies the NotIn predicate on the "gas_used" field.
+func GasUsedNotIn(vs ...int64) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldNotIn(FieldGasUsed, vs...))
+}
+
+// GasUsedGT applies the GT predicate on the "gas_used" field.
+func GasUsedGT(v int64) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldGT(FieldGasUsed, v))
+}
+
+// GasUsedGTE applies the GTE predicate on the "gas_used" field.
+func GasUsedGTE(v int64) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldGTE(FieldGasUsed, v))
+}
+
+// GasUsedLT applies the LT predicate on the "gas_used" field.
+func GasUsedLT(v int64) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldLT(FieldGasUsed, v))
+}
+
+// GasUsedLTE applies the LTE predicate on the "gas_used" field.
+func GasUsedLTE(v int64) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldLTE(FieldGasUsed, v))
+}
+
+// GasUsedIsNil applies the IsNil predicate on the "gas_used" field.
+func GasUsedIsNil() predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldIsNull(FieldGasUsed))
+}
+
+// GasUsedNotNil applies the NotNil predicate on the "gas_used" field.
+func GasUsedNotNil() predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldNotNull(FieldGasUsed))
+}
+
+// GasCostEQ applies the EQ predicate on the "gas_cost" field.
+func GasCostEQ(v decimal.Decimal) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldEQ(FieldGasCost, v))
+}
+
+// GasCostNEQ applies the NEQ predicate on the "gas_cost" field.
+func GasCostNEQ(v decimal.Decimal) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldNEQ(FieldGasCost, v))
+}
+
+// GasCostIn applies the In predicate on the "gas_cost" field.
+func GasCostIn(vs ...decimal.Decimal) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldIn(FieldGasCost, vs...))
+}
+
+// GasCostNotIn applies the NotIn predicate on the "gas_cost" field.
+func GasCostNotIn(vs ...decimal.Decimal) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldNotIn(FieldGasCost, vs...))
+}
+
+// GasCostGT applies the GT predicate on the "gas_cost" field.
+func GasCostGT(v decimal.Decimal) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldGT(FieldGasCost, v))
+}
+
+// GasCostGTE applies the GTE predicate on the "gas_cost" field.
+func GasCostGTE(v decimal.Decimal) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldGTE(FieldGasCost, v))
+}
+
+// GasCostLT applies the LT predicate on the "gas_cost" field.
+func GasCostLT(v decimal.Decimal) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldLT(FieldGasCost, v))
+}
+
+// GasCostLTE applies the LTE predicate on the "gas_cost" field.
+func GasCostLTE(v decimal.Decimal) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldLTE(FieldGasCost, v))
+}
+
+// GasCostIsNil applies the IsNil predicate on the "gas_cost" field.
+func GasCostIsNil() predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldIsNull(FieldGasCost))
+}
+
+// GasCostNotNil applies the NotNil predicate on the "gas_cost" field.
+func GasCostNotNil() predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldNotNull(FieldGasCost))
+}
+
+// DepositOrderIDEQ applies the EQ predicate on the "deposit_order_id" field.
+func DepositOrderIDEQ(v uuid.UUID) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldEQ(FieldDepositOrderID, v))
+}
+
+// DepositOrderIDNEQ applies the NEQ predicate on the "deposit_order_id" field.
+func DepositOrderIDNEQ(v uuid.UUID) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldNEQ(FieldDepositOrderID, v))
+}
+
+// DepositOrderIDIn applies the In predicate on the "deposit_order_id" field.
+func DepositOrderIDIn(vs ...uuid.UUID) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldIn(FieldDepositOrderID, vs...))
+}
+
+// DepositOrderIDNotIn applies the NotIn predicate on the "deposit_order_id" field.
+func DepositOrderIDNotIn(vs ...uuid.UUID) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldNotIn(FieldDepositOrderID, vs...))
+}
+
+// DepositOrderIDGT applies the GT predicate on the "deposit_order_id" field.
+func DepositOrderIDGT(v uuid.UUID) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldGT(FieldDepositOrderID, v))
+}
+
+// DepositOrderIDGTE applies the GTE predicate on the "deposit_order_id" field.
+func DepositOrderIDGTE(v uuid.UUID) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldGTE(FieldDepositOrderID, v))
+}
+
+// DepositOrderIDLT applies the LT predicate on the "deposit_order_id" field.
+func DepositOrderIDLT(v uuid.UUID) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldLT(FieldDepositOrderID, v))
+}
+
+// DepositOrderIDLTE applies the LTE predicate on the "deposit_order_id" field.
+func DepositOrderIDLTE(v uuid.UUID) predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldLTE(FieldDepositOrderID, v))
+}
+
+// DepositOrderIDIsNil applies the IsNil predicate on the "deposit_order_id" field.
+func DepositOrderIDIsNil() predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldIsNull(FieldDepositOrderID))
+}
+
+// DepositOrderIDNotNil applies the NotNil predicate on the "deposit_order_id" field.
+func DepositOrderIDNotNil() predicate.TransactionLog {
+	return predicate.TransactionLog(sql.FieldNotNull(FieldDepositOrderID))
+}
+
+// HasReplaces applies the HasEdge predicate on the "replaces" edge.
+func HasReplaces() predicate.TransactionLog {
+	return predicate.TransactionLog(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, ReplacesTable, ReplacesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReplacesWith applies the HasEdge predicate on the "replaces" edge with a given conditions (other predicates).
+func HasReplacesWith(preds ...predicate.TransactionLog) predicate.TransactionLog {
+	return predicate.TransactionLog(func(s *sql.Selector) {
+		step := newReplacesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReplacedBy applies the HasEdge predicate on the "replaced_by" edge.
+func HasReplacedBy() predicate.TransactionLog {
+	return predicate.TransactionLog(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, ReplacedByTable, ReplacedByColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReplacedByWith applies the HasEdge predicate on the "replaced_by" edge with a given conditions (other predicates).
+func HasReplacedByWith(preds ...predicate.TransactionLog) predicate.TransactionLog {
+	return predicate.TransactionLog(func(s *sql.Selector) {
+		step := newReplacedByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.TransactionLog) predicate.TransactionLog {
 	return predicate.TransactionLog(sql.AndPredicates(predicates...))
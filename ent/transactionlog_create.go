@@ -14,6 +14,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 // TransactionLogCreate is the builder for creating a TransactionLog entity.
@@ -100,6 +101,104 @@ func (tlc *TransactionLogCreate) SetNillableCreatedAt(t *time.Time) *Transaction
 	return tlc
 }
 
+// SetPurpose sets the "purpose" field.
+func (tlc *TransactionLogCreate) SetPurpose(t transactionlog.Purpose) *TransactionLogCreate {
+	tlc.mutation.SetPurpose(t)
+	return tlc
+}
+
+// SetNillablePurpose sets the "purpose" field if the given value is not nil.
+func (tlc *TransactionLogCreate) SetNillablePurpose(t *transactionlog.Purpose) *TransactionLogCreate {
+	if t != nil {
+		tlc.SetPurpose(*t)
+	}
+	return tlc
+}
+
+// SetPaymentOrderID sets the "payment_order_id" field.
+func (tlc *TransactionLogCreate) SetPaymentOrderID(u uuid.UUID) *TransactionLogCreate {
+	tlc.mutation.SetPaymentOrderID(u)
+	return tlc
+}
+
+// SetNillablePaymentOrderID sets the "payment_order_id" field if the given value is not nil.
+func (tlc *TransactionLogCreate) SetNillablePaymentOrderID(u *uuid.UUID) *TransactionLogCreate {
+	if u != nil {
+		tlc.SetPaymentOrderID(*u)
+	}
+	return tlc
+}
+
+// SetSponsored sets the "sponsored" field.
+func (tlc *TransactionLogCreate) SetSponsored(b bool) *TransactionLogCreate {
+	tlc.mutation.SetSponsored(b)
+	return tlc
+}
+
+// SetNillableSponsored sets the "sponsored" field if the given value is not nil.
+func (tlc *TransactionLogCreate) SetNillableSponsored(b *bool) *TransactionLogCreate {
+	if b != nil {
+		tlc.SetSponsored(*b)
+	}
+	return tlc
+}
+
+// SetGasPolicyID sets the "gas_policy_id" field.
+func (tlc *TransactionLogCreate) SetGasPolicyID(s string) *TransactionLogCreate {
+	tlc.mutation.SetGasPolicyID(s)
+	return tlc
+}
+
+// SetNillableGasPolicyID sets the "gas_policy_id" field if the given value is not nil.
+func (tlc *TransactionLogCreate) SetNillableGasPolicyID(s *string) *TransactionLogCreate {
+	if s != nil {
+		tlc.SetGasPolicyID(*s)
+	}
+	return tlc
+}
+
+// SetGasUsed sets the "gas_used" field.
+func (tlc *TransactionLogCreate) SetGasUsed(i int64) *TransactionLogCreate {
+	tlc.mutation.SetGasUsed(i)
+	return tlc
+}
+
+// SetNillableGasUsed sets the "gas_used" field if the given value is not nil.
+func (tlc *TransactionLogCreate) SetNillableGasUsed(i *int64) *TransactionLogCreate {
+	if i != nil {
+		tlc.SetGasUsed(*i)
+	}
+	return tlc
+}
+
+// SetGasCost sets the "gas_cost" field.
+func (tlc *TransactionLogCreate) SetGasCost(d decimal.Decimal) *TransactionLogCreate {
+	tlc.mutation.SetGasCost(d)
+	return tlc
+}
+
+// SetNillableGasCost sets the "gas_cost" field if the given value is not nil.
+func (tlc *TransactionLogCreate) SetNillableGasCost(d *decimal.Decimal) *TransactionLogCreate {
+	if d != nil {
+		tlc.SetGasCost(*d)
+	}
+	return tlc
+}
+
+// SetDepositOrderID sets the "deposit_order_id" field.
+func (tlc *TransactionLogCreate) SetDepositOrderID(u uuid.UUID) *TransactionLogCreate {
+	tlc.mutation.SetDepositOrderID(u)
+	return tlc
+}
+
+// SetNillableDepositOrderID sets the "deposit_order_id" field if the given value is not nil.
+func (tlc *TransactionLogCreate) SetNillableDepositOrderID(u *uuid.UUID) *TransactionLogCreate {
+	if u != nil {
+		tlc.SetDepositOrderID(*u)
+	}
+	return tlc
+}
+
 // SetID sets the "id" field.
 func (tlc *TransactionLogCreate) SetID(u uuid.UUID) *TransactionLogCreate {
 	tlc.mutation.SetID(u)
@@ -114,6 +213,44 @@ func (tlc *TransactionLogCreate) SetNillableID(u *uuid.UUID) *TransactionLogCrea
 	return tlc
 }
 
+// SetReplacesID sets the "replaces" edge to the TransactionLog entity by ID.
+func (tlc *TransactionLogCreate) SetReplacesID(id uuid.UUID) *TransactionLogCreate {
+	tlc.mutation.SetReplacesID(id)
+	return tlc
+}
+
+// SetNillableReplacesID sets the "replaces" edge to the TransactionLog entity by ID if the given value is not nil.
+func (tlc *TransactionLogCreate) SetNillableReplacesID(id *uuid.UUID) *TransactionLogCreate {
+	if id != nil {
+		tlc = tlc.SetReplacesID(*id)
+	}
+	return tlc
+}
+
+// SetReplaces sets the "replaces" edge to the TransactionLog entity.
+func (tlc *TransactionLogCreate) SetReplaces(t *TransactionLog) *TransactionLogCreate {
+	return tlc.SetReplacesID(t.ID)
+}
+
+// SetReplacedByID sets the "replaced_by" edge to the TransactionLog entity by ID.
+func (tlc *TransactionLogCreate) SetReplacedByID(id uuid.UUID) *TransactionLogCreate {
+	tlc.mutation.SetReplacedByID(id)
+	return tlc
+}
+
+// SetNillableReplacedByID sets the "replaced_by" edge to the TransactionLog entity by ID if the given value is not nil.
+func (tlc *TransactionLogCreate) SetNillableReplacedByID(id *uuid.UUID) *TransactionLogCreate {
+	if id != nil {
+		tlc = tlc.SetReplacedByID(*id)
+	}
+	return tlc
+}
+
+// SetReplacedBy sets the "replaced_by" edge to the TransactionLog entity.
+func (tlc *TransactionLogCreate) SetReplacedBy(t *TransactionLog) *TransactionLogCreate {
+	return tlc.SetReplacedByID(t.ID)
+}
+
 // Mutation returns the TransactionLogMutation object of the builder.
 func (tlc *TransactionLogCreate) Mutation() *TransactionLogMutation {
 	return tlc.mutation
@@ -157,6 +294,10 @@ func (tlc *TransactionLogCreate) defaults() {
 		v := transactionlog.DefaultCreatedAt()
 		tlc.mutation.SetCreatedAt(v)
 	}
+	if _, ok := tlc.mutation.Sponsored(); !ok {
+		v := transactionlog.DefaultSponsored
+		tlc.mutation.SetSponsored(v)
+	}
 	if _, ok := tlc.mutation.ID(); !ok {
 		v := transactionlog.DefaultID()
 		tlc.mutation.SetID(v)
@@ -179,6 +320,14 @@ func (tlc *TransactionLogCreate) check() error {
 	if _, ok := tlc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TransactionLog.created_at"`)}
 	}
+	if v, ok := tlc.mutation.Purpose(); ok {
+		if err := transactionlog.PurposeValidator(v); err != nil {
+			return &ValidationError{Name: "purpose", err: fmt.Errorf(`ent: validator failed for field "TransactionLog.purpose": %w`, err)}
+		}
+	}
+	if _, ok := tlc.mutation.Sponsored(); !ok {
+		return &ValidationError{Name: "sponsored", err: errors.New(`ent: missing required field "TransactionLog.sponsored"`)}
+	}
 	return nil
 }
 
@@ -239,6 +388,67 @@ func (tlc *TransactionLogCreate) createSpec() (*TransactionLog, *sqlgraph.Create
 		_spec.SetField(transactionlog.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
+	if value, ok := tlc.mutation.Purpose(); ok {
+		_spec.SetField(transactionlog.FieldPurpose, field.TypeEnum, value)
+		_node.Purpose = &value
+	}
+	if value, ok := tlc.mutation.PaymentOrderID(); ok {
+		_spec.SetField(transactionlog.FieldPaymentOrderID, field.TypeUUID, value)
+		_node.PaymentOrderID = &value
+	}
+	if value, ok := tlc.mutation.Sponsored(); ok {
+		_spec.SetField(transactionlog.FieldSponsored, field.TypeBool, value)
+		_node.Sponsored = value
+	}
+	if value, ok := tlc.mutation.GasPolicyID(); ok {
+		_spec.SetField(transactionlog.FieldGasPolicyID, field.TypeString, value)
+		_node.GasPolicyID = value
+	}
+	if value, ok := tlc.mutation.GasUsed(); ok {
+		_spec.SetField(transactionlog.FieldGasUsed, field.TypeInt64, value)
+		_node.GasUsed = &value
+	}
+	if value, ok := tlc.mutation.GasCost(); ok {
+		_spec.SetField(transactionlog.FieldGasCost, field.TypeFloat64, value)
+		_node.GasCost = &value
+	}
+	if value, ok := tlc.mutation.DepositOrderID(); ok {
+		_spec.SetField(transactionlog.FieldDepositOrderID, field.TypeUUID, value)
+		_node.DepositOrderID = &value
+	}
+	if nodes := tlc.mutation.ReplacesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   transactionlog.ReplacesTable,
+			Columns: []string{transactionlog.ReplacesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transactionlog.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.transaction_log_replaced_by = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tlc.mutation.ReplacedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   transactionlog.ReplacedByTable,
+			Columns: []string{transactionlog.ReplacedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transactionlog.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -357,6 +567,138 @@ func (u *TransactionLogUpsert) UpdateMetadata() *TransactionLogUpsert {
 	return u
 }
 
+// SetPurpose sets the "purpose" field.
+func (u *TransactionLogUpsert) SetPurpose(v transactionlog.Purpose) *TransactionLogUpsert {
+	u.Set(transactionlog.FieldPurpose, v)
+	return u
+}
+
+// UpdatePurpose sets the "purpose" field to the value that was provided on create.
+func (u *TransactionLogUpsert) UpdatePurpose() *TransactionLogUpsert {
+	u.SetExcluded(transactionlog.FieldPurpose)
+	return u
+}
+
+// ClearPurpose clears the value of the "purpose" field.
+func (u *TransactionLogUpsert) ClearPurpose() *TransactionLogUpsert {
+	u.SetNull(transactionlog.FieldPurpose)
+	return u
+}
+
+// SetPaymentOrderID sets the "payment_order_id" field.
+func (u *TransactionLogUpsert) SetPaymentOrderID(v uuid.UUID) *TransactionLogUpsert {
+	u.Set(transactionlog.FieldPaymentOrderID, v)
+	return u
+}
+
+// UpdatePaymentOrderID sets the "payment_order_id" field to the value that was provided on create.
+func (u *TransactionLogUpsert) UpdatePaymentOrderID() *TransactionLogUpsert {
+	u.SetExcluded(transactionlog.FieldPaymentOrderID)
+	return u
+}
+
+// ClearPaymentOrderID clears the value of the "payment_order_id" field.
+func (u *TransactionLogUpsert) ClearPaymentOrderID() *TransactionLogUpsert {
+	u.SetNull(transactionlog.FieldPaymentOrderID)
+	return u
+}
+
+// SetSponsored sets the "sponsored" field.
+func (u *TransactionLogUpsert) SetSponsored(v bool) *TransactionLogUpsert {
+	u.Set(transactionlog.FieldSponsored, v)
+	return u
+}
+
+// UpdateSponsored sets the "sponsored" field to the value that was provided on create.
+func (u *TransactionLogUpsert) UpdateSponsored() *TransactionLogUpsert {
+	u.SetExcluded(transactionlog.FieldSponsored)
+	return u
+}
+
+// SetGasPolicyID sets the "gas_policy_id" field.
+func (u *TransactionLogUpsert) SetGasPolicyID(v string) *TransactionLogUpsert {
+	u.Set(transactionlog.FieldGasPolicyID, v)
+	return u
+}
+
+// UpdateGasPolicyID sets the "gas_policy_id" field to the value that was provided on create.
+func (u *TransactionLogUpsert) UpdateGasPolicyID() *TransactionLogUpsert {
+	u.SetExcluded(transactionlog.FieldGasPolicyID)
+	return u
+}
+
+// ClearGasPolicyID clears the value of the "gas_policy_id" field.
+func (u *TransactionLogUpsert) ClearGasPolicyID() *TransactionLogUpsert {
+	u.SetNull(transactionlog.FieldGasPolicyID)
+	return u
+}
+
+// SetGasUsed sets the "gas_used" field.
+func (u *TransactionLogUpsert) SetGasUsed(v int64) *TransactionLogUpsert {
+	u.Set(transactionlog.FieldGasUsed, v)
+	return u
+}
+
+// UpdateGasUsed sets the "gas_used" field to the value that was provided on create.
+func (u *TransactionLogUpsert) UpdateGasUsed() *TransactionLogUpsert {
+	u.SetExcluded(transactionlog.FieldGasUsed)
+	return u
+}
+
+// AddGasUsed adds v to the "gas_used" field.
+func (u *TransactionLogUpsert) AddGasUsed(v int64) *TransactionLogUpsert {
+	u.Add(transactionlog.FieldGasUsed, v)
+	return u
+}
+
+// ClearGasUsed clears the value of the "gas_used" field.
+func (u *TransactionLogUpsert) ClearGasUsed() *TransactionLogUpsert {
+	u.SetNull(transactionlog.FieldGasUsed)
+	return u
+}
+
+// SetGasCost sets the "gas_cost" field.
+func (u *TransactionLogUpsert) SetGasCost(v decimal.Decimal) *TransactionLogUpsert {
+	u.Set(transactionlog.FieldGasCost, v)
+	return u
+}
+
+// UpdateGasCost sets the "gas_cost" field to the value that was provided on create.
+func (u *TransactionLogUpsert) UpdateGasCost() *TransactionLogUpsert {
+	u.SetExcluded(transactionlog.FieldGasCost)
+	return u
+}
+
+// AddGasCost adds v to the "gas_cost" field.
+func (u *TransactionLogUpsert) AddGasCost(v decimal.Decimal) *TransactionLogUpsert {
+	u.Add(transactionlog.FieldGasCost, v)
+	return u
+}
+
+// ClearGasCost clears the value of the "gas_cost" field.
+func (u *TransactionLogUpsert) ClearGasCost() *TransactionLogUpsert {
+	u.SetNull(transactionlog.FieldGasCost)
+	return u
+}
+
+// SetDepositOrderID sets the "deposit_order_id" field.
+func (u *TransactionLogUpsert) SetDepositOrderID(v uuid.UUID) *TransactionLogUpsert {
+	u.Set(transactionlog.FieldDepositOrderID, v)
+	return u
+}
+
+// UpdateDepositOrderID sets the "deposit_order_id" field to the value that was provided on create.
+func (u *TransactionLogUpsert) UpdateDepositOrderID() *TransactionLogUpsert {
+	u.SetExcluded(transactionlog.FieldDepositOrderID)
+	return u
+}
+
+// ClearDepositOrderID clears the value of the "deposit_order_id" field.
+func (u *TransactionLogUpsert) ClearDepositOrderID() *TransactionLogUpsert {
+	u.SetNull(transactionlog.FieldDepositOrderID)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -488,6 +830,160 @@ func (u *TransactionLogUpsertOne) UpdateMetadata() *TransactionLogUpsertOne {
 	})
 }
 
+// SetPurpose sets the "purpose" field.
+func (u *TransactionLogUpsertOne) SetPurpose(v transactionlog.Purpose) *TransactionLogUpsertOne {
+	return u.Update(func(s *TransactionLogUpsert) {
+		s.SetPurpose(v)
+	})
+}
+
+// UpdatePurpose sets the "purpose" field to the value that was provided on create.
+func (u *TransactionLogUpsertOne) UpdatePurpose() *TransactionLogUpsertOne {
+	return u.Update(func(s *TransactionLogUpsert) {
+		s.UpdatePurpose()
+	})
+}
+
+// ClearPurpose clears the value of the "purpose" field.
+func (u *TransactionLogUpsertOne) ClearPurpose() *TransactionLogUpsertOne {
+	return u.Update(func(s *TransactionLogUpsert) {
+		s.ClearPurpose()
+	})
+}
+
+// SetPaymentOrderID sets the "payment_order_id" field.
+func (u *TransactionLogUpsertOne) SetPaymentOrderID(v uuid.UUID) *TransactionLogUpsertOne {
+	return u.Update(func(s *TransactionLogUpsert) {
+		s.SetPaymentOrderID(v)
+	})
+}
+
+// UpdatePaymentOrderID sets the "payment_order_id" field to the value that was provided on create.
+func (u *TransactionLogUpsertOne) UpdatePaymentOrderID() *TransactionLogUpsertOne {
+	return u.Update(func(s *TransactionLogUpsert) {
+		s.UpdatePaymentOrderID()
+	})
+}
+
+// ClearPaymentOrderID clears the value of the "payment_order_id" field.
+func (u *TransactionLogUpsertOne) ClearPaymentOrderID() *TransactionLogUpsertOne {
+	return u.Update(func(s *TransactionLogUpsert) {
+		s.ClearPaymentOrderID()
+	})
+}
+
+// SetSponsored sets the "sponsored" field.
+func (u *TransactionLogUpsertOne) SetSponsored(v bool) *TransactionLogUpsertOne {
+	return u.Update(func(s *TransactionLogUpsert) {
+		s.SetSponsored(v)
+	})
+}
+
+// UpdateSponsored sets the "sponsored" field to the value that was provided on create.
+func (u *TransactionLogUpsertOne) UpdateSponsored() *TransactionLogUpsertOne {
+	return u.Update(func(s *TransactionLogUpsert) {
+		s.UpdateSponsored()
+	})
+}
+
+// SetGasPolicyID sets the "gas_policy_id" field.
+func (u *TransactionLogUpsertOne) SetGasPolicyID(v string) *TransactionLogUpsertOne {
+	return u.Update(func(s *TransactionLogUpsert) {
+		s.SetGasPolicyID(v)
+	})
+}
+
+// UpdateGasPolicyID sets the "gas_policy_id" field to the value that was provided on create.
+func (u *TransactionLogUpsertOne) UpdateGasPolicyID() *TransactionLogUpsertOne {
+	return u.Update(func(s *TransactionLogUpsert) {
+		s.UpdateGasPolicyID()
+	})
+}
+
+// ClearGasPolicyID clears the value of the "gas_policy_id" field.
+func (u *TransactionLogUpsertOne) ClearGasPolicyID() *TransactionLogUpsertOne {
+	return u.Update(func(s *TransactionLogUpsert) {
+		s.ClearGasPolicyID()
+	})
+}
+
+// SetGasUsed sets the "gas_used" field.
+func (u *TransactionLogUpsertOne) SetGasUsed(v int64) *TransactionLogUpsertOne {
+	return u.Update(func(s *TransactionLogUpsert) {
+		s.SetGasUsed(v)
+	})
+}
+
+// AddGasUsed adds v to the "gas_used" field.
+func (u *TransactionLogUpsertOne) AddGasUsed(v int64) *TransactionLogUpsertOne {
+	return u.Update(func(s *TransactionLogUpsert) {
+		s.AddGasUsed(v)
+	})
+}
+
+// UpdateGasUsed sets the "gas_used" field to the value that was provided on create.
+func (u *TransactionLogUpsertOne) UpdateGasUsed() *TransactionLogUpsertOne {
+	return u.Update(func(s *TransactionLogUpsert) {
+		s.UpdateGasUsed()
+	})
+}
+
+// ClearGasUsed clears the value of the "gas_used" field.
+func (u *TransactionLogUpsertOne) ClearGasUsed() *TransactionLogUpsertOne {
+	return u.Update(func(s *TransactionLogUpsert) {
+		s.ClearGasUsed()
+	})
+}
+
+// SetGasCost sets the "gas_cost" field.
+func (u *TransactionLogUpsertOne) SetGasCost(v decimal.Decimal) *TransactionLogUpsertOne {
+	return u.Update(func(s *TransactionLogUpsert) {
+		s.SetGasCost(v)
+	})
+}
+
+// AddGasCost adds v to the "gas_cost" field.
+func (u *TransactionLogUpsertOne) AddGasCost(v decimal.Decimal) *TransactionLogUpsertOne {
+	return u.Update(func(s *TransactionLogUpsert) {
+		s.AddGasCost(v)
+	})
+}
+
+// UpdateGasCost sets the "gas_cost" field to the value that was provided on create.
+func (u *TransactionLogUpsertOne) UpdateGasCost() *TransactionLogUpsertOne {
+	return u.Update(func(s *TransactionLogUpsert) {
+		s.UpdateGasCost()
+	})
+}
+
+// ClearGasCost clears the value of the "gas_cost" field.
+func (u *TransactionLogUpsertOne) ClearGasCost() *TransactionLogUpsertOne {
+	return u.Update(func(s *TransactionLogUpsert) {
+		s.ClearGasCost()
+	})
+}
+
+// SetDepositOrderID sets the "deposit_order_id" field.
+func (u *TransactionLogUpsertOne) SetDepositOrderID(v uuid.UUID) *TransactionLogUpsertOne {
+	return u.Update(func(s *TransactionLogUpsert) {
+		s.SetDepositOrderID(v)
+	})
+}
+
+// UpdateDepositOrderID sets the "deposit_order_id" field to the value that was provided on create.
+func (u *TransactionLogUpsertOne) UpdateDepositOrderID() *TransactionLogUpsertOne {
+	return u.Update(func(s *TransactionLogUpsert) {
+		s.UpdateDepositOrderID()
+	})
+}
+
+// ClearDepositOrderID clears the value of the "deposit_order_id" field.
+func (u *TransactionLogUpsertOne) ClearDepositOrderID() *TransactionLogUpsertOne {
+	return u.Update(func(s *TransactionLogUpsert) {
+		s.ClearDepositOrderID()
+	})
+}
+
 // Exec executes the query.
 func (u *TransactionLogUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -786,6 +1282,160 @@ func (u *TransactionLogUpsertBulk) UpdateMetadata() *TransactionLogUpsertBulk {
 	})
 }
 
+// SetPurpose sets the "purpose" field.
+func (u *TransactionLogUpsertBulk) SetPurpose(v transactionlog.Purpose) *TransactionLogUpsertBulk {
+	return u.Update(func(s *TransactionLogUpsert) {
+		s.SetPurpose(v)
+	})
+}
+
+// UpdatePurpose sets the "purpose" field to the value that was provided on create.
+func (u *TransactionLogUpsertBulk) UpdatePurpose() *TransactionLogUpsertBulk {
+	return u.Update(func(s *TransactionLogUpsert) {
+		s.UpdatePurpose()
+	})
+}
+
+// ClearPurpose clears the value of the "purpose" field.
+func (u *TransactionLogUpsertBulk) ClearPurpose() *TransactionLogUpsertBulk {
+	return u.Update(func(s *TransactionLogUpsert) {
+		s.ClearPurpose()
+	})
+}
+
+// SetPaymentOrderID sets the "payment_order_id" field.
+func (u *TransactionLogUpsertBulk) SetPaymentOrderID(v uuid.UUID) *TransactionLogUpsertBulk {
+	return u.Update(func(s *TransactionLogUpsert) {
+		s.SetPaymentOrderID(v)
+	})
+}
+
+// UpdatePaymentOrderID sets the "payment_order_id" field to the value that was provided on create.
+func (u *TransactionLogUpsertBulk) UpdatePaymentOrderID() *TransactionLogUpsertBulk {
+	return u.Update(func(s *TransactionLogUpsert) {
+		s.UpdatePaymentOrderID()
+	})
+}
+
+// ClearPaymentOrderID clears the value of the "payment_order_id" field.
+func (u *TransactionLogUpsertBulk) ClearPaymentOrderID() *TransactionLogUpsertBulk {
+	return u.Update(func(s *TransactionLogUpsert) {
+		s.ClearPaymentOrderID()
+	})
+}
+
+// SetSponsored sets the "sponsored" field.
+func (u *TransactionLogUpsertBulk) SetSponsored(v bool) *TransactionLogUpsertBulk {
+	return u.Update(func(s *TransactionLogUpsert) {
+		s.SetSponsored(v)
+	})
+}
+
+// UpdateSponsored sets the "sponsored" field to the value that was provided on create.
+func (u *TransactionLogUpsertBulk) UpdateSponsored() *TransactionLogUpsertBulk {
+	return u.Update(func(s *TransactionLogUpsert) {
+		s.UpdateSponsored()
+	})
+}
+
+// SetGasPolicyID sets the "gas_policy_id" field.
+func (u *TransactionLogUpsertBulk) SetGasPolicyID(v string) *TransactionLogUpsertBulk {
+	return u.Update(func(s *TransactionLogUpsert) {
+		s.SetGasPolicyID(v)
+	})
+}
+
+// UpdateGasPolicyID sets the "gas_policy_id" field to the value that was provided on create.
+func (u *TransactionLogUpsertBulk) UpdateGasPolicyID() *TransactionLogUpsertBulk {
+	return u.Update(func(s *TransactionLogUpsert) {
+		s.UpdateGasPolicyID()
+	})
+}
+
+// ClearGasPolicyID clears the value of the "gas_policy_id" field.
+func (u *TransactionLogUpsertBulk) ClearGasPolicyID() *TransactionLogUpsertBulk {
+	return u.Update(func(s *TransactionLogUpsert) {
+		s.ClearGasPolicyID()
+	})
+}
+
+// SetGasUsed sets the "gas_used" field.
+func (u *TransactionLogUpsertBulk) SetGasUsed(v int64) *TransactionLogUpsertBulk {
+	return u.Update(func(s *TransactionLogUpsert) {
+		s.SetGasUsed(v)
+	})
+}
+
+// AddGasUsed adds v to the "gas_used" field.
+func (u *TransactionLogUpsertBulk) AddGasUsed(v int64) *TransactionLogUpsertBulk {
+	return u.Update(func(s *TransactionLogUpsert) {
+		s.AddGasUsed(v)
+	})
+}
+
+// UpdateGasUsed sets the "gas_used" field to the value that was provided on create.
+func (u *TransactionLogUpsertBulk) UpdateGasUsed() *TransactionLogUpsertBulk {
+	return u.Update(func(s *TransactionLogUpsert) {
+		s.UpdateGasUsed()
+	})
+}
+
+// ClearGasUsed clears the value of the "gas_used" field.
+func (u *TransactionLogUpsertBulk) ClearGasUsed() *TransactionLogUpsertBulk {
+	return u.Update(func(s *TransactionLogUpsert) {
+		s.ClearGasUsed()
+	})
+}
+
+// SetGasCost sets the "gas_cost" field.
+func (u *TransactionLogUpsertBulk) SetGasCost(v decimal.Decimal) *TransactionLogUpsertBulk {
+	return u.Update(func(s *TransactionLogUpsert) {
+		s.SetGasCost(v)
+	})
+}
+
+// AddGasCost adds v to the "gas_cost" field.
+func (u *TransactionLogUpsertBulk) AddGasCost(v decimal.Decimal) *TransactionLogUpsertBulk {
+	return u.Update(func(s *TransactionLogUpsert) {
+		s.AddGasCost(v)
+	})
+}
+
+// UpdateGasCost sets the "gas_cost" field to the value that was provided on create.
+func (u *TransactionLogUpsertBulk) UpdateGasCost() *TransactionLogUpsertBulk {
+	return u.Update(func(s *TransactionLogUpsert) {
+		s.UpdateGasCost()
+	})
+}
+
+// ClearGasCost clears the value of the "gas_cost" field.
+func (u *TransactionLogUpsertBulk) ClearGasCost() *TransactionLogUpsertBulk {
+	return u.Update(func(s *TransactionLogUpsert) {
+		s.ClearGasCost()
+	})
+}
+
+// SetDepositOrderID sets the "deposit_order_id" field.
+func (u *TransactionLogUpsertBulk) SetDepositOrderID(v uuid.UUID) *TransactionLogUpsertBulk {
+	return u.Update(func(s *TransactionLogUpsert) {
+		s.SetDepositOrderID(v)
+	})
+}
+
+// UpdateDepositOrderID sets the "deposit_order_id" field to the value that was provided on create.
+func (u *TransactionLogUpsertBulk) UpdateDepositOrderID() *TransactionLogUpsertBulk {
+	return u.Update(func(s *TransactionLogUpsert) {
+		s.UpdateDepositOrderID()
+	})
+}
+
+// ClearDepositOrderID clears the value of the "deposit_order_id" field.
+func (u *TransactionLogUpsertBulk) ClearDepositOrderID() *TransactionLogUpsertBulk {
+	return u.Update(func(s *TransactionLogUpsert) {
+		s.ClearDepositOrderID()
+	})
+}
+
 // Exec executes the query.
 func (u *TransactionLogUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -19,11 +20,13 @@ import (
 // TransactionLogQuery is the builder for querying TransactionLog entities.
 type TransactionLogQuery struct {
 	config
-	ctx        *QueryContext
-	order      []transactionlog.OrderOption
-	inters     []Interceptor
-	predicates []predicate.TransactionLog
-	withFKs    bool
+	ctx            *QueryContext
+	order          []transactionlog.OrderOption
+	inters         []Interceptor
+	predicates     []predicate.TransactionLog
+	withReplaces   *TransactionLogQuery
+	withReplacedBy *TransactionLogQuery
+	withFKs        bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -60,6 +63,50 @@ func (tlq *TransactionLogQuery) Order(o ...transactionlog.OrderOption) *Transact
 	return tlq
 }
 
+// QueryReplaces chains the current query on the "replaces" edge.
+func (tlq *TransactionLogQuery) QueryReplaces() *TransactionLogQuery {
+	query := (&TransactionLogClient{config: tlq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tlq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tlq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transactionlog.Table, transactionlog.FieldID, selector),
+			sqlgraph.To(transactionlog.Table, transactionlog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, transactionlog.ReplacesTable, transactionlog.ReplacesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tlq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReplacedBy chains the current query on the "replaced_by" edge.
+func (tlq *TransactionLogQuery) QueryReplacedBy() *TransactionLogQuery {
+	query := (&TransactionLogClient{config: tlq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tlq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tlq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transactionlog.Table, transactionlog.FieldID, selector),
+			sqlgraph.To(transactionlog.Table, transactionlog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, transactionlog.ReplacedByTable, transactionlog.ReplacedByColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tlq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first TransactionLog entity from the query.
 // Returns a *NotFoundError when no TransactionLog was found.
 func (tlq *TransactionLogQuery) First(ctx context.Context) (*TransactionLog, error) {
@@ -247,17 +294,41 @@ func (tlq *TransactionLogQuery) Clone() *TransactionLogQuery {
 		return nil
 	}
 	return &TransactionLogQuery{
-		config:     tlq.config,
-		ctx:        tlq.ctx.Clone(),
-		order:      append([]transactionlog.OrderOption{}, tlq.order...),
-		inters:     append([]Interceptor{}, tlq.inters...),
-		predicates: append([]predicate.TransactionLog{}, tlq.predicates...),
+		config:         tlq.config,
+		ctx:            tlq.ctx.Clone(),
+		order:          append([]transactionlog.OrderOption{}, tlq.order...),
+		inters:         append([]Interceptor{}, tlq.inters...),
+		predicates:     append([]predicate.TransactionLog{}, tlq.predicates...),
+		withReplaces:   tlq.withReplaces.Clone(),
+		withReplacedBy: tlq.withReplacedBy.Clone(),
 		// clone intermediate query.
 		sql:  tlq.sql.Clone(),
 		path: tlq.path,
 	}
 }
 
+// WithReplaces tells the query-builder to eager-load the nodes that are connected to
+// the "replaces" edge. The optional arguments are used to configure the query builder of the edge.
+func (tlq *TransactionLogQuery) WithReplaces(opts ...func(*TransactionLogQuery)) *TransactionLogQuery {
+	query := (&TransactionLogClient{config: tlq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tlq.withReplaces = query
+	return tlq
+}
+
+// WithReplacedBy tells the query-builder to eager-load the nodes that are connected to
+// the "replaced_by" edge. The optional arguments are used to configure the query builder of the edge.
+func (tlq *TransactionLogQuery) WithReplacedBy(opts ...func(*TransactionLogQuery)) *TransactionLogQuery {
+	query := (&TransactionLogClient{config: tlq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tlq.withReplacedBy = query
+	return tlq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -334,10 +405,17 @@ func (tlq *TransactionLogQuery) prepareQuery(ctx context.Context) error {
 
 func (tlq *TransactionLogQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TransactionLog, error) {
 	var (
-		nodes   = []*TransactionLog{}
-		withFKs = tlq.withFKs
-		_spec   = tlq.querySpec()
+		nodes       = []*TransactionLog{}
+		withFKs     = tlq.withFKs
+		_spec       = tlq.querySpec()
+		loadedTypes = [2]bool{
+			tlq.withReplaces != nil,
+			tlq.withReplacedBy != nil,
+		}
 	)
+	if tlq.withReplaces != nil {
+		withFKs = true
+	}
 	if withFKs {
 		_spec.Node.Columns = append(_spec.Node.Columns, transactionlog.ForeignKeys...)
 	}
@@ -347,6 +425,7 @@ func (tlq *TransactionLogQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 	_spec.Assign = func(columns []string, values []any) error {
 		node := &TransactionLog{config: tlq.config}
 		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
 	for i := range hooks {
@@ -358,9 +437,82 @@ func (tlq *TransactionLogQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := tlq.withReplaces; query != nil {
+		if err := tlq.loadReplaces(ctx, query, nodes, nil,
+			func(n *TransactionLog, e *TransactionLog) { n.Edges.Replaces = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tlq.withReplacedBy; query != nil {
+		if err := tlq.loadReplacedBy(ctx, query, nodes, nil,
+			func(n *TransactionLog, e *TransactionLog) { n.Edges.ReplacedBy = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
+func (tlq *TransactionLogQuery) loadReplaces(ctx context.Context, query *TransactionLogQuery, nodes []*TransactionLog, init func(*TransactionLog), assign func(*TransactionLog, *TransactionLog)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*TransactionLog)
+	for i := range nodes {
+		if nodes[i].transaction_log_replaced_by == nil {
+			continue
+		}
+		fk := *nodes[i].transaction_log_replaced_by
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(transactionlog.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "transaction_log_replaced_by" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (tlq *TransactionLogQuery) loadReplacedBy(ctx context.Context, query *TransactionLogQuery, nodes []*TransactionLog, init func(*TransactionLog), assign func(*TransactionLog, *TransactionLog)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*TransactionLog)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	query.withFKs = true
+	query.Where(predicate.TransactionLog(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(transactionlog.ReplacedByColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.transaction_log_replaced_by
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "transaction_log_replaced_by" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "transaction_log_replaced_by" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
 func (tlq *TransactionLogQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := tlq.querySpec()
 	_spec.Node.Columns = tlq.ctx.Fields
@@ -12,6 +12,8 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/NEDA-LABS/stablenode/ent/predicate"
 	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 // TransactionLogUpdate is the builder for updating TransactionLog entities.
@@ -93,11 +95,209 @@ func (tlu *TransactionLogUpdate) SetMetadata(m map[string]interface{}) *Transact
 	return tlu
 }
 
+// SetPurpose sets the "purpose" field.
+func (tlu *TransactionLogUpdate) SetPurpose(t transactionlog.Purpose) *TransactionLogUpdate {
+	tlu.mutation.SetPurpose(t)
+	return tlu
+}
+
+// SetNillablePurpose sets the "purpose" field if the given value is not nil.
+func (tlu *TransactionLogUpdate) SetNillablePurpose(t *transactionlog.Purpose) *TransactionLogUpdate {
+	if t != nil {
+		tlu.SetPurpose(*t)
+	}
+	return tlu
+}
+
+// ClearPurpose clears the value of the "purpose" field.
+func (tlu *TransactionLogUpdate) ClearPurpose() *TransactionLogUpdate {
+	tlu.mutation.ClearPurpose()
+	return tlu
+}
+
+// SetPaymentOrderID sets the "payment_order_id" field.
+func (tlu *TransactionLogUpdate) SetPaymentOrderID(u uuid.UUID) *TransactionLogUpdate {
+	tlu.mutation.SetPaymentOrderID(u)
+	return tlu
+}
+
+// SetNillablePaymentOrderID sets the "payment_order_id" field if the given value is not nil.
+func (tlu *TransactionLogUpdate) SetNillablePaymentOrderID(u *uuid.UUID) *TransactionLogUpdate {
+	if u != nil {
+		tlu.SetPaymentOrderID(*u)
+	}
+	return tlu
+}
+
+// ClearPaymentOrderID clears the value of the "payment_order_id" field.
+func (tlu *TransactionLogUpdate) ClearPaymentOrderID() *TransactionLogUpdate {
+	tlu.mutation.ClearPaymentOrderID()
+	return tlu
+}
+
+// SetSponsored sets the "sponsored" field.
+func (tlu *TransactionLogUpdate) SetSponsored(b bool) *TransactionLogUpdate {
+	tlu.mutation.SetSponsored(b)
+	return tlu
+}
+
+// SetNillableSponsored sets the "sponsored" field if the given value is not nil.
+func (tlu *TransactionLogUpdate) SetNillableSponsored(b *bool) *TransactionLogUpdate {
+	if b != nil {
+		tlu.SetSponsored(*b)
+	}
+	return tlu
+}
+
+// SetGasPolicyID sets the "gas_policy_id" field.
+func (tlu *TransactionLogUpdate) SetGasPolicyID(s string) *TransactionLogUpdate {
+	tlu.mutation.SetGasPolicyID(s)
+	return tlu
+}
+
+// SetNillableGasPolicyID sets the "gas_policy_id" field if the given value is not nil.
+func (tlu *TransactionLogUpdate) SetNillableGasPolicyID(s *string) *TransactionLogUpdate {
+	if s != nil {
+		tlu.SetGasPolicyID(*s)
+	}
+	return tlu
+}
+
+// ClearGasPolicyID clears the value of the "gas_policy_id" field.
+func (tlu *TransactionLogUpdate) ClearGasPolicyID() *TransactionLogUpdate {
+	tlu.mutation.ClearGasPolicyID()
+	return tlu
+}
+
+// SetGasUsed sets the "gas_used" field.
+func (tlu *TransactionLogUpdate) SetGasUsed(i int64) *TransactionLogUpdate {
+	tlu.mutation.ResetGasUsed()
+	tlu.mutation.SetGasUsed(i)
+	return tlu
+}
+
+// SetNillableGasUsed sets the "gas_used" field if the given value is not nil.
+func (tlu *TransactionLogUpdate) SetNillableGasUsed(i *int64) *TransactionLogUpdate {
+	if i != nil {
+		tlu.SetGasUsed(*i)
+	}
+	return tlu
+}
+
+// AddGasUsed adds i to the "gas_used" field.
+func (tlu *TransactionLogUpdate) AddGasUsed(i int64) *TransactionLogUpdate {
+	tlu.mutation.AddGasUsed(i)
+	return tlu
+}
+
+// ClearGasUsed clears the value of the "gas_used" field.
+func (tlu *TransactionLogUpdate) ClearGasUsed() *TransactionLogUpdate {
+	tlu.mutation.ClearGasUsed()
+	return tlu
+}
+
+// SetGasCost sets the "gas_cost" field.
+func (tlu *TransactionLogUpdate) SetGasCost(d decimal.Decimal) *TransactionLogUpdate {
+	tlu.mutation.ResetGasCost()
+	tlu.mutation.SetGasCost(d)
+	return tlu
+}
+
+// SetNillableGasCost sets the "gas_cost" field if the given value is not nil.
+func (tlu *TransactionLogUpdate) SetNillableGasCost(d *decimal.Decimal) *TransactionLogUpdate {
+	if d != nil {
+		tlu.SetGasCost(*d)
+	}
+	return tlu
+}
+
+// AddGasCost adds d to the "gas_cost" field.
+func (tlu *TransactionLogUpdate) AddGasCost(d decimal.Decimal) *TransactionLogUpdate {
+	tlu.mutation.AddGasCost(d)
+	return tlu
+}
+
+// ClearGasCost clears the value of the "gas_cost" field.
+func (tlu *TransactionLogUpdate) ClearGasCost() *TransactionLogUpdate {
+	tlu.mutation.ClearGasCost()
+	return tlu
+}
+
+// SetDepositOrderID sets the "deposit_order_id" field.
+func (tlu *TransactionLogUpdate) SetDepositOrderID(u uuid.UUID) *TransactionLogUpdate {
+	tlu.mutation.SetDepositOrderID(u)
+	return tlu
+}
+
+// SetNillableDepositOrderID sets the "deposit_order_id" field if the given value is not nil.
+func (tlu *TransactionLogUpdate) SetNillableDepositOrderID(u *uuid.UUID) *TransactionLogUpdate {
+	if u != nil {
+		tlu.SetDepositOrderID(*u)
+	}
+	return tlu
+}
+
+// ClearDepositOrderID clears the value of the "deposit_order_id" field.
+func (tlu *TransactionLogUpdate) ClearDepositOrderID() *TransactionLogUpdate {
+	tlu.mutation.ClearDepositOrderID()
+	return tlu
+}
+
+// SetReplacesID sets the "replaces" edge to the TransactionLog entity by ID.
+func (tlu *TransactionLogUpdate) SetReplacesID(id uuid.UUID) *TransactionLogUpdate {
+	tlu.mutation.SetReplacesID(id)
+	return tlu
+}
+
+// SetNillableReplacesID sets the "replaces" edge to the TransactionLog entity by ID if the given value is not nil.
+func (tlu *TransactionLogUpdate) SetNillableReplacesID(id *uuid.UUID) *TransactionLogUpdate {
+	if id != nil {
+		tlu = tlu.SetReplacesID(*id)
+	}
+	return tlu
+}
+
+// SetReplaces sets the "replaces" edge to the TransactionLog entity.
+func (tlu *TransactionLogUpdate) SetReplaces(t *TransactionLog) *TransactionLogUpdate {
+	return tlu.SetReplacesID(t.ID)
+}
+
+// SetReplacedByID sets the "replaced_by" edge to the TransactionLog entity by ID.
+func (tlu *TransactionLogUpdate) SetReplacedByID(id uuid.UUID) *TransactionLogUpdate {
+	tlu.mutation.SetReplacedByID(id)
+	return tlu
+}
+
+// SetNillableReplacedByID sets the "replaced_by" edge to the TransactionLog entity by ID if the given value is not nil.
+func (tlu *TransactionLogUpdate) SetNillableReplacedByID(id *uuid.UUID) *TransactionLogUpdate {
+	if id != nil {
+		tlu = tlu.SetReplacedByID(*id)
+	}
+	return tlu
+}
+
+// SetReplacedBy sets the "replaced_by" edge to the TransactionLog entity.
+func (tlu *TransactionLogUpdate) SetReplacedBy(t *TransactionLog) *TransactionLogUpdate {
+	return tlu.SetReplacedByID(t.ID)
+}
+
 // Mutation returns the TransactionLogMutation object of the builder.
 func (tlu *TransactionLogUpdate) Mutation() *TransactionLogMutation {
 	return tlu.mutation
 }
 
+// ClearReplaces clears the "replaces" edge to the TransactionLog entity.
+func (tlu *TransactionLogUpdate) ClearReplaces() *TransactionLogUpdate {
+	tlu.mutation.ClearReplaces()
+	return tlu
+}
+
+// ClearReplacedBy clears the "replaced_by" edge to the TransactionLog entity.
+func (tlu *TransactionLogUpdate) ClearReplacedBy() *TransactionLogUpdate {
+	tlu.mutation.ClearReplacedBy()
+	return tlu
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (tlu *TransactionLogUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, tlu.sqlSave, tlu.mutation, tlu.hooks)
@@ -125,7 +325,20 @@ func (tlu *TransactionLogUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (tlu *TransactionLogUpdate) check() error {
+	if v, ok := tlu.mutation.Purpose(); ok {
+		if err := transactionlog.PurposeValidator(v); err != nil {
+			return &ValidationError{Name: "purpose", err: fmt.Errorf(`ent: validator failed for field "TransactionLog.purpose": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (tlu *TransactionLogUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tlu.check(); err != nil {
+		return n, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(transactionlog.Table, transactionlog.Columns, sqlgraph.NewFieldSpec(transactionlog.FieldID, field.TypeUUID))
 	if ps := tlu.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -155,6 +368,109 @@ func (tlu *TransactionLogUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if value, ok := tlu.mutation.Metadata(); ok {
 		_spec.SetField(transactionlog.FieldMetadata, field.TypeJSON, value)
 	}
+	if value, ok := tlu.mutation.Purpose(); ok {
+		_spec.SetField(transactionlog.FieldPurpose, field.TypeEnum, value)
+	}
+	if tlu.mutation.PurposeCleared() {
+		_spec.ClearField(transactionlog.FieldPurpose, field.TypeEnum)
+	}
+	if value, ok := tlu.mutation.PaymentOrderID(); ok {
+		_spec.SetField(transactionlog.FieldPaymentOrderID, field.TypeUUID, value)
+	}
+	if tlu.mutation.PaymentOrderIDCleared() {
+		_spec.ClearField(transactionlog.FieldPaymentOrderID, field.TypeUUID)
+	}
+	if value, ok := tlu.mutation.Sponsored(); ok {
+		_spec.SetField(transactionlog.FieldSponsored, field.TypeBool, value)
+	}
+	if value, ok := tlu.mutation.GasPolicyID(); ok {
+		_spec.SetField(transactionlog.FieldGasPolicyID, field.TypeString, value)
+	}
+	if tlu.mutation.GasPolicyIDCleared() {
+		_spec.ClearField(transactionlog.FieldGasPolicyID, field.TypeString)
+	}
+	if value, ok := tlu.mutation.GasUsed(); ok {
+		_spec.SetField(transactionlog.FieldGasUsed, field.TypeInt64, value)
+	}
+	if value, ok := tlu.mutation.AddedGasUsed(); ok {
+		_spec.AddField(transactionlog.FieldGasUsed, field.TypeInt64, value)
+	}
+	if tlu.mutation.GasUsedCleared() {
+		_spec.ClearField(transactionlog.FieldGasUsed, field.TypeInt64)
+	}
+	if value, ok := tlu.mutation.GasCost(); ok {
+		_spec.SetField(transactionlog.FieldGasCost, field.TypeFloat64, value)
+	}
+	if value, ok := tlu.mutation.AddedGasCost(); ok {
+		_spec.AddField(transactionlog.FieldGasCost, field.TypeFloat64, value)
+	}
+	if tlu.mutation.GasCostCleared() {
+		_spec.ClearField(transactionlog.FieldGasCost, field.TypeFloat64)
+	}
+	if value, ok := tlu.mutation.DepositOrderID(); ok {
+		_spec.SetField(transactionlog.FieldDepositOrderID, field.TypeUUID, value)
+	}
+	if tlu.mutation.DepositOrderIDCleared() {
+		_spec.ClearField(transactionlog.FieldDepositOrderID, field.TypeUUID)
+	}
+	if tlu.mutation.ReplacesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   transactionlog.ReplacesTable,
+			Columns: []string{transactionlog.ReplacesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transactionlog.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tlu.mutation.ReplacesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   transactionlog.ReplacesTable,
+			Columns: []string{transactionlog.ReplacesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transactionlog.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tlu.mutation.ReplacedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   transactionlog.ReplacedByTable,
+			Columns: []string{transactionlog.ReplacedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transactionlog.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tlu.mutation.ReplacedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   transactionlog.ReplacedByTable,
+			Columns: []string{transactionlog.ReplacedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transactionlog.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, tlu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{transactionlog.Label}
@@ -241,11 +557,209 @@ func (tluo *TransactionLogUpdateOne) SetMetadata(m map[string]interface{}) *Tran
 	return tluo
 }
 
+// SetPurpose sets the "purpose" field.
+func (tluo *TransactionLogUpdateOne) SetPurpose(t transactionlog.Purpose) *TransactionLogUpdateOne {
+	tluo.mutation.SetPurpose(t)
+	return tluo
+}
+
+// SetNillablePurpose sets the "purpose" field if the given value is not nil.
+func (tluo *TransactionLogUpdateOne) SetNillablePurpose(t *transactionlog.Purpose) *TransactionLogUpdateOne {
+	if t != nil {
+		tluo.SetPurpose(*t)
+	}
+	return tluo
+}
+
+// ClearPurpose clears the value of the "purpose" field.
+func (tluo *TransactionLogUpdateOne) ClearPurpose() *TransactionLogUpdateOne {
+	tluo.mutation.ClearPurpose()
+	return tluo
+}
+
+// SetPaymentOrderID sets the "payment_order_id" field.
+func (tluo *TransactionLogUpdateOne) SetPaymentOrderID(u uuid.UUID) *TransactionLogUpdateOne {
+	tluo.mutation.SetPaymentOrderID(u)
+	return tluo
+}
+
+// SetNillablePaymentOrderID sets the "payment_order_id" field if the given value is not nil.
+func (tluo *TransactionLogUpdateOne) SetNillablePaymentOrderID(u *uuid.UUID) *TransactionLogUpdateOne {
+	if u != nil {
+		tluo.SetPaymentOrderID(*u)
+	}
+	return tluo
+}
+
+// ClearPaymentOrderID clears the value of the "payment_order_id" field.
+func (tluo *TransactionLogUpdateOne) ClearPaymentOrderID() *TransactionLogUpdateOne {
+	tluo.mutation.ClearPaymentOrderID()
+	return tluo
+}
+
+// SetSponsored sets the "sponsored" field.
+func (tluo *TransactionLogUpdateOne) SetSponsored(b bool) *TransactionLogUpdateOne {
+	tluo.mutation.SetSponsored(b)
+	return tluo
+}
+
+// SetNillableSponsored sets the "sponsored" field if the given value is not nil.
+func (tluo *TransactionLogUpdateOne) SetNillableSponsored(b *bool) *TransactionLogUpdateOne {
+	if b != nil {
+		tluo.SetSponsored(*b)
+	}
+	return tluo
+}
+
+// SetGasPolicyID sets the "gas_policy_id" field.
+func (tluo *TransactionLogUpdateOne) SetGasPolicyID(s string) *TransactionLogUpdateOne {
+	tluo.mutation.SetGasPolicyID(s)
+	return tluo
+}
+
+// SetNillableGasPolicyID sets the "gas_policy_id" field if the given value is not nil.
+func (tluo *TransactionLogUpdateOne) SetNillableGasPolicyID(s *string) *TransactionLogUpdateOne {
+	if s != nil {
+		tluo.SetGasPolicyID(*s)
+	}
+	return tluo
+}
+
+// ClearGasPolicyID clears the value of the "gas_policy_id" field.
+func (tluo *TransactionLogUpdateOne) ClearGasPolicyID() *TransactionLogUpdateOne {
+	tluo.mutation.ClearGasPolicyID()
+	return tluo
+}
+
+// SetGasUsed sets the "gas_used" field.
+func (tluo *TransactionLogUpdateOne) SetGasUsed(i int64) *TransactionLogUpdateOne {
+	tluo.mutation.ResetGasUsed()
+	tluo.mutation.SetGasUsed(i)
+	return tluo
+}
+
+// SetNillableGasUsed sets the "gas_used" field if the given value is not nil.
+func (tluo *TransactionLogUpdateOne) SetNillableGasUsed(i *int64) *TransactionLogUpdateOne {
+	if i != nil {
+		tluo.SetGasUsed(*i)
+	}
+	return tluo
+}
+
+// AddGasUsed adds i to the "gas_used" field.
+func (tluo *TransactionLogUpdateOne) AddGasUsed(i int64) *TransactionLogUpdateOne {
+	tluo.mutation.AddGasUsed(i)
+	return tluo
+}
+
+// ClearGasUsed clears the value of the "gas_used" field.
+func (tluo *TransactionLogUpdateOne) ClearGasUsed() *TransactionLogUpdateOne {
+	tluo.mutation.ClearGasUsed()
+	return tluo
+}
+
+// SetGasCost sets the "gas_cost" field.
+func (tluo *TransactionLogUpdateOne) SetGasCost(d decimal.Decimal) *TransactionLogUpdateOne {
+	tluo.mutation.ResetGasCost()
+	tluo.mutation.SetGasCost(d)
+	return tluo
+}
+
+// SetNillableGasCost sets the "gas_cost" field if the given value is not nil.
+func (tluo *TransactionLogUpdateOne) SetNillableGasCost(d *decimal.Decimal) *TransactionLogUpdateOne {
+	if d != nil {
+		tluo.SetGasCost(*d)
+	}
+	return tluo
+}
+
+// AddGasCost adds d to the "gas_cost" field.
+func (tluo *TransactionLogUpdateOne) AddGasCost(d decimal.Decimal) *TransactionLogUpdateOne {
+	tluo.mutation.AddGasCost(d)
+	return tluo
+}
+
+// ClearGasCost clears the value of the "gas_cost" field.
+func (tluo *TransactionLogUpdateOne) ClearGasCost() *TransactionLogUpdateOne {
+	tluo.mutation.ClearGasCost()
+	return tluo
+}
+
+// SetDepositOrderID sets the "deposit_order_id" field.
+func (tluo *TransactionLogUpdateOne) SetDepositOrderID(u uuid.UUID) *TransactionLogUpdateOne {
+	tluo.mutation.SetDepositOrderID(u)
+	return tluo
+}
+
+// SetNillableDepositOrderID sets the "deposit_order_id" field if the given value is not nil.
+func (tluo *TransactionLogUpdateOne) SetNillableDepositOrderID(u *uuid.UUID) *TransactionLogUpdateOne {
+	if u != nil {
+		tluo.SetDepositOrderID(*u)
+	}
+	return tluo
+}
+
+// ClearDepositOrderID clears the value of the "deposit_order_id" field.
+func (tluo *TransactionLogUpdateOne) ClearDepositOrderID() *TransactionLogUpdateOne {
+	tluo.mutation.ClearDepositOrderID()
+	return tluo
+}
+
+// SetReplacesID sets the "replaces" edge to the TransactionLog entity by ID.
+func (tluo *TransactionLogUpdateOne) SetReplacesID(id uuid.UUID) *TransactionLogUpdateOne {
+	tluo.mutation.SetReplacesID(id)
+	return tluo
+}
+
+// SetNillableReplacesID sets the "replaces" edge to the TransactionLog entity by ID if the given value is not nil.
+func (tluo *TransactionLogUpdateOne) SetNillableReplacesID(id *uuid.UUID) *TransactionLogUpdateOne {
+	if id != nil {
+		tluo = tluo.SetReplacesID(*id)
+	}
+	return tluo
+}
+
+// SetReplaces sets the "replaces" edge to the TransactionLog entity.
+func (tluo *TransactionLogUpdateOne) SetReplaces(t *TransactionLog) *TransactionLogUpdateOne {
+	return tluo.SetReplacesID(t.ID)
+}
+
+// SetReplacedByID sets the "replaced_by" edge to the TransactionLog entity by ID.
+func (tluo *TransactionLogUpdateOne) SetReplacedByID(id uuid.UUID) *TransactionLogUpdateOne {
+	tluo.mutation.SetReplacedByID(id)
+	return tluo
+}
+
+// SetNillableReplacedByID sets the "replaced_by" edge to the TransactionLog entity by ID if the given value is not nil.
+func (tluo *TransactionLogUpdateOne) SetNillableReplacedByID(id *uuid.UUID) *TransactionLogUpdateOne {
+	if id != nil {
+		tluo = tluo.SetReplacedByID(*id)
+	}
+	return tluo
+}
+
+// SetReplacedBy sets the "replaced_by" edge to the TransactionLog entity.
+func (tluo *TransactionLogUpdateOne) SetReplacedBy(t *TransactionLog) *TransactionLogUpdateOne {
+	return tluo.SetReplacedByID(t.ID)
+}
+
 // Mutation returns the TransactionLogMutation object of the builder.
 func (tluo *TransactionLogUpdateOne) Mutation() *TransactionLogMutation {
 	return tluo.mutation
 }
 
+// ClearReplaces clears the "replaces" edge to the TransactionLog entity.
+func (tluo *TransactionLogUpdateOne) ClearReplaces() *TransactionLogUpdateOne {
+	tluo.mutation.ClearReplaces()
+	return tluo
+}
+
+// ClearReplacedBy clears the "replaced_by" edge to the TransactionLog entity.
+func (tluo *TransactionLogUpdateOne) ClearReplacedBy() *TransactionLogUpdateOne {
+	tluo.mutation.ClearReplacedBy()
+	return tluo
+}
+
 // Where appends a list predicates to the TransactionLogUpdate builder.
 func (tluo *TransactionLogUpdateOne) Where(ps ...predicate.TransactionLog) *TransactionLogUpdateOne {
 	tluo.mutation.Where(ps...)
@@ -286,7 +800,20 @@ func (tluo *TransactionLogUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (tluo *TransactionLogUpdateOne) check() error {
+	if v, ok := tluo.mutation.Purpose(); ok {
+		if err := transactionlog.PurposeValidator(v); err != nil {
+			return &ValidationError{Name: "purpose", err: fmt.Errorf(`ent: validator failed for field "TransactionLog.purpose": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (tluo *TransactionLogUpdateOne) sqlSave(ctx context.Context) (_node *TransactionLog, err error) {
+	if err := tluo.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(transactionlog.Table, transactionlog.Columns, sqlgraph.NewFieldSpec(transactionlog.FieldID, field.TypeUUID))
 	id, ok := tluo.mutation.ID()
 	if !ok {
@@ -333,6 +860,109 @@ func (tluo *TransactionLogUpdateOne) sqlSave(ctx context.Context) (_node *Transa
 	if value, ok := tluo.mutation.Metadata(); ok {
 		_spec.SetField(transactionlog.FieldMetadata, field.TypeJSON, value)
 	}
+	if value, ok := tluo.mutation.Purpose(); ok {
+		_spec.SetField(transactionlog.FieldPurpose, field.TypeEnum, value)
+	}
+	if tluo.mutation.PurposeCleared() {
+		_spec.ClearField(transactionlog.FieldPurpose, field.TypeEnum)
+	}
+	if value, ok := tluo.mutation.PaymentOrderID(); ok {
+		_spec.SetField(transactionlog.FieldPaymentOrderID, field.TypeUUID, value)
+	}
+	if tluo.mutation.PaymentOrderIDCleared() {
+		_spec.ClearField(transactionlog.FieldPaymentOrderID, field.TypeUUID)
+	}
+	if value, ok := tluo.mutation.Sponsored(); ok {
+		_spec.SetField(transactionlog.FieldSponsored, field.TypeBool, value)
+	}
+	if value, ok := tluo.mutation.GasPolicyID(); ok {
+		_spec.SetField(transactionlog.FieldGasPolicyID, field.TypeString, value)
+	}
+	if tluo.mutation.GasPolicyIDCleared() {
+		_spec.ClearField(transactionlog.FieldGasPolicyID, field.TypeString)
+	}
+	if value, ok := tluo.mutation.GasUsed(); ok {
+		_spec.SetField(transactionlog.FieldGasUsed, field.TypeInt64, value)
+	}
+	if value, ok := tluo.mutation.AddedGasUsed(); ok {
+		_spec.AddField(transactionlog.FieldGasUsed, field.TypeInt64, value)
+	}
+	if tluo.mutation.GasUsedCleared() {
+		_spec.ClearField(transactionlog.FieldGasUsed, field.TypeInt64)
+	}
+	if value, ok := tluo.mutation.GasCost(); ok {
+		_spec.SetField(transactionlog.FieldGasCost, field.TypeFloat64, value)
+	}
+	if value, ok := tluo.mutation.AddedGasCost(); ok {
+		_spec.AddField(transactionlog.FieldGasCost, field.TypeFloat64, value)
+	}
+	if tluo.mutation.GasCostCleared() {
+		_spec.ClearField(transactionlog.FieldGasCost, field.TypeFloat64)
+	}
+	if value, ok := tluo.mutation.DepositOrderID(); ok {
+		_spec.SetField(transactionlog.FieldDepositOrderID, field.TypeUUID, value)
+	}
+	if tluo.mutation.DepositOrderIDCleared() {
+		_spec.ClearField(transactionlog.FieldDepositOrderID, field.TypeUUID)
+	}
+	if tluo.mutation.ReplacesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   transactionlog.ReplacesTable,
+			Columns: []string{transactionlog.ReplacesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transactionlog.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tluo.mutation.ReplacesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   transactionlog.ReplacesTable,
+			Columns: []string{transactionlog.ReplacesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transactionlog.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tluo.mutation.ReplacedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   transactionlog.ReplacedByTable,
+			Columns: []string{transactionlog.ReplacedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transactionlog.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tluo.mutation.ReplacedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   transactionlog.ReplacedByTable,
+			Columns: []string{transactionlog.ReplacedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transactionlog.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &TransactionLog{config: tluo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
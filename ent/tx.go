@@ -14,8 +14,20 @@ type Tx struct {
 	config
 	// APIKey is the client for interacting with the APIKey builders.
 	APIKey *APIKeyClient
+	// AdminAuditLog is the client for interacting with the AdminAuditLog builders.
+	AdminAuditLog *AdminAuditLogClient
 	// BeneficialOwner is the client for interacting with the BeneficialOwner builders.
 	BeneficialOwner *BeneficialOwnerClient
+	// BlockchainRoute is the client for interacting with the BlockchainRoute builders.
+	BlockchainRoute *BlockchainRouteClient
+	// DeadLetter is the client for interacting with the DeadLetter builders.
+	DeadLetter *DeadLetterClient
+	// DenylistedAddress is the client for interacting with the DenylistedAddress builders.
+	DenylistedAddress *DenylistedAddressClient
+	// DepositSplit is the client for interacting with the DepositSplit builders.
+	DepositSplit *DepositSplitClient
+	// FeeSchedule is the client for interacting with the FeeSchedule builders.
+	FeeSchedule *FeeScheduleClient
 	// FiatCurrency is the client for interacting with the FiatCurrency builders.
 	FiatCurrency *FiatCurrencyClient
 	// IdentityVerificationRequest is the client for interacting with the IdentityVerificationRequest builders.
@@ -24,44 +36,74 @@ type Tx struct {
 	Institution *InstitutionClient
 	// KYBProfile is the client for interacting with the KYBProfile builders.
 	KYBProfile *KYBProfileClient
+	// LedgerAccount is the client for interacting with the LedgerAccount builders.
+	LedgerAccount *LedgerAccountClient
+	// LedgerEntry is the client for interacting with the LedgerEntry builders.
+	LedgerEntry *LedgerEntryClient
+	// LedgerPosting is the client for interacting with the LedgerPosting builders.
+	LedgerPosting *LedgerPostingClient
 	// LinkedAddress is the client for interacting with the LinkedAddress builders.
 	LinkedAddress *LinkedAddressClient
 	// LockOrderFulfillment is the client for interacting with the LockOrderFulfillment builders.
 	LockOrderFulfillment *LockOrderFulfillmentClient
+	// LockOrderReassignment is the client for interacting with the LockOrderReassignment builders.
+	LockOrderReassignment *LockOrderReassignmentClient
 	// LockPaymentOrder is the client for interacting with the LockPaymentOrder builders.
 	LockPaymentOrder *LockPaymentOrderClient
 	// Network is the client for interacting with the Network builders.
 	Network *NetworkClient
+	// OutboxTransaction is the client for interacting with the OutboxTransaction builders.
+	OutboxTransaction *OutboxTransactionClient
 	// PaymentOrder is the client for interacting with the PaymentOrder builders.
 	PaymentOrder *PaymentOrderClient
 	// PaymentOrderRecipient is the client for interacting with the PaymentOrderRecipient builders.
 	PaymentOrderRecipient *PaymentOrderRecipientClient
 	// PaymentWebhook is the client for interacting with the PaymentWebhook builders.
 	PaymentWebhook *PaymentWebhookClient
+	// ProviderBalanceSnapshot is the client for interacting with the ProviderBalanceSnapshot builders.
+	ProviderBalanceSnapshot *ProviderBalanceSnapshotClient
 	// ProviderCurrencies is the client for interacting with the ProviderCurrencies builders.
 	ProviderCurrencies *ProviderCurrenciesClient
 	// ProviderOrderToken is the client for interacting with the ProviderOrderToken builders.
 	ProviderOrderToken *ProviderOrderTokenClient
+	// ProviderPerformance is the client for interacting with the ProviderPerformance builders.
+	ProviderPerformance *ProviderPerformanceClient
 	// ProviderProfile is the client for interacting with the ProviderProfile builders.
 	ProviderProfile *ProviderProfileClient
 	// ProviderRating is the client for interacting with the ProviderRating builders.
 	ProviderRating *ProviderRatingClient
 	// ProvisionBucket is the client for interacting with the ProvisionBucket builders.
 	ProvisionBucket *ProvisionBucketClient
+	// RPCEndpoint is the client for interacting with the RPCEndpoint builders.
+	RPCEndpoint *RPCEndpointClient
 	// ReceiveAddress is the client for interacting with the ReceiveAddress builders.
 	ReceiveAddress *ReceiveAddressClient
+	// ReceiveAddressAssignment is the client for interacting with the ReceiveAddressAssignment builders.
+	ReceiveAddressAssignment *ReceiveAddressAssignmentClient
+	// ReconciliationDiscrepancy is the client for interacting with the ReconciliationDiscrepancy builders.
+	ReconciliationDiscrepancy *ReconciliationDiscrepancyClient
+	// ReconciliationReport is the client for interacting with the ReconciliationReport builders.
+	ReconciliationReport *ReconciliationReportClient
 	// SenderOrderToken is the client for interacting with the SenderOrderToken builders.
 	SenderOrderToken *SenderOrderTokenClient
 	// SenderProfile is the client for interacting with the SenderProfile builders.
 	SenderProfile *SenderProfileClient
+	// Sweep is the client for interacting with the Sweep builders.
+	Sweep *SweepClient
 	// Token is the client for interacting with the Token builders.
 	Token *TokenClient
 	// TransactionLog is the client for interacting with the TransactionLog builders.
 	TransactionLog *TransactionLogClient
+	// UnmatchedDeposit is the client for interacting with the UnmatchedDeposit builders.
+	UnmatchedDeposit *UnmatchedDepositClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// VerificationToken is the client for interacting with the VerificationToken builders.
 	VerificationToken *VerificationTokenClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
+	// WebhookDestination is the client for interacting with the WebhookDestination builders.
+	WebhookDestination *WebhookDestinationClient
 	// WebhookRetryAttempt is the client for interacting with the WebhookRetryAttempt builders.
 	WebhookRetryAttempt *WebhookRetryAttemptClient
 
@@ -196,30 +238,51 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.APIKey = NewAPIKeyClient(tx.config)
+	tx.AdminAuditLog = NewAdminAuditLogClient(tx.config)
 	tx.BeneficialOwner = NewBeneficialOwnerClient(tx.config)
+	tx.BlockchainRoute = NewBlockchainRouteClient(tx.config)
+	tx.DeadLetter = NewDeadLetterClient(tx.config)
+	tx.DenylistedAddress = NewDenylistedAddressClient(tx.config)
+	tx.DepositSplit = NewDepositSplitClient(tx.config)
+	tx.FeeSchedule = NewFeeScheduleClient(tx.config)
 	tx.FiatCurrency = NewFiatCurrencyClient(tx.config)
 	tx.IdentityVerificationRequest = NewIdentityVerificationRequestClient(tx.config)
 	tx.Institution = NewInstitutionClient(tx.config)
 	tx.KYBProfile = NewKYBProfileClient(tx.config)
+	tx.LedgerAccount = NewLedgerAccountClient(tx.config)
+	tx.LedgerEntry = NewLedgerEntryClient(tx.config)
+	tx.LedgerPosting = NewLedgerPostingClient(tx.config)
 	tx.LinkedAddress = NewLinkedAddressClient(tx.config)
 	tx.LockOrderFulfillment = NewLockOrderFulfillmentClient(tx.config)
+	tx.LockOrderReassignment = NewLockOrderReassignmentClient(tx.config)
 	tx.LockPaymentOrder = NewLockPaymentOrderClient(tx.config)
 	tx.Network = NewNetworkClient(tx.config)
+	tx.OutboxTransaction = NewOutboxTransactionClient(tx.config)
 	tx.PaymentOrder = NewPaymentOrderClient(tx.config)
 	tx.PaymentOrderRecipient = NewPaymentOrderRecipientClient(tx.config)
 	tx.PaymentWebhook = NewPaymentWebhookClient(tx.config)
+	tx.ProviderBalanceSnapshot = NewProviderBalanceSnapshotClient(tx.config)
 	tx.ProviderCurrencies = NewProviderCurrenciesClient(tx.config)
 	tx.ProviderOrderToken = NewProviderOrderTokenClient(tx.config)
+	tx.ProviderPerformance = NewProviderPerformanceClient(tx.config)
 	tx.ProviderProfile = NewProviderProfileClient(tx.config)
 	tx.ProviderRating = NewProviderRatingClient(tx.config)
 	tx.ProvisionBucket = NewProvisionBucketClient(tx.config)
+	tx.RPCEndpoint = NewRPCEndpointClient(tx.config)
 	tx.ReceiveAddress = NewReceiveAddressClient(tx.config)
+	tx.ReceiveAddressAssignment = NewReceiveAddressAssignmentClient(tx.config)
+	tx.ReconciliationDiscrepancy = NewReconciliationDiscrepancyClient(tx.config)
+	tx.ReconciliationReport = NewReconciliationReportClient(tx.config)
 	tx.SenderOrderToken = NewSenderOrderTokenClient(tx.config)
 	tx.SenderProfile = NewSenderProfileClient(tx.config)
+	tx.Sweep = NewSweepClient(tx.config)
 	tx.Token = NewTokenClient(tx.config)
 	tx.TransactionLog = NewTransactionLogClient(tx.config)
+	tx.UnmatchedDeposit = NewUnmatchedDepositClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 	tx.VerificationToken = NewVerificationTokenClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
+	tx.WebhookDestination = NewWebhookDestinationClient(tx.config)
 	tx.WebhookRetryAttempt = NewWebhookRetryAttemptClient(tx.config)
 }
 
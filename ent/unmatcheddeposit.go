@@ -0,0 +1,308 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/sweep"
+	"github.com/NEDA-LABS/stablenode/ent/token"
+	"github.com/NEDA-LABS/stablenode/ent/unmatcheddeposit"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// UnmatchedDeposit is the model entity for the UnmatchedDeposit schema.
+type UnmatchedDeposit struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// TxHash holds the value of the "tx_hash" field.
+	TxHash string `json:"tx_hash,omitempty"`
+	// ReceiveAddress holds the value of the "receive_address" field.
+	ReceiveAddress string `json:"receive_address,omitempty"`
+	// FromAddress holds the value of the "from_address" field.
+	FromAddress string `json:"from_address,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount decimal.Decimal `json:"amount,omitempty"`
+	// BlockNumber holds the value of the "block_number" field.
+	BlockNumber int64 `json:"block_number,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason unmatcheddeposit.Reason `json:"reason,omitempty"`
+	// Status holds the value of the "status" field.
+	Status unmatcheddeposit.Status `json:"status,omitempty"`
+	// ResolvedAt holds the value of the "resolved_at" field.
+	ResolvedAt time.Time `json:"resolved_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UnmatchedDepositQuery when eager-loading is set.
+	Edges                            UnmatchedDepositEdges `json:"edges"`
+	payment_order_unmatched_deposits *uuid.UUID
+	sweep_unmatched_deposit          *uuid.UUID
+	token_unmatched_deposits         *int
+	selectValues                     sql.SelectValues
+}
+
+// UnmatchedDepositEdges holds the relations/edges for other nodes in the graph.
+type UnmatchedDepositEdges struct {
+	// Token holds the value of the token edge.
+	Token *Token `json:"token,omitempty"`
+	// PaymentOrder holds the value of the payment_order edge.
+	PaymentOrder *PaymentOrder `json:"payment_order,omitempty"`
+	// Sweep holds the value of the sweep edge.
+	Sweep *Sweep `json:"sweep,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// TokenOrErr returns the Token value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UnmatchedDepositEdges) TokenOrErr() (*Token, error) {
+	if e.Token != nil {
+		return e.Token, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: token.Label}
+	}
+	return nil, &NotLoadedError{edge: "token"}
+}
+
+// PaymentOrderOrErr returns the PaymentOrder value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UnmatchedDepositEdges) PaymentOrderOrErr() (*PaymentOrder, error) {
+	if e.PaymentOrder != nil {
+		return e.PaymentOrder, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: paymentorder.Label}
+	}
+	return nil, &NotLoadedError{edge: "payment_order"}
+}
+
+// SweepOrErr returns the Sweep value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UnmatchedDepositEdges) SweepOrErr() (*Sweep, error) {
+	if e.Sweep != nil {
+		return e.Sweep, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: sweep.Label}
+	}
+	return nil, &NotLoadedError{edge: "sweep"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UnmatchedDeposit) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case unmatcheddeposit.FieldAmount:
+			values[i] = new(decimal.Decimal)
+		case unmatcheddeposit.FieldBlockNumber:
+			values[i] = new(sql.NullInt64)
+		case unmatcheddeposit.FieldTxHash, unmatcheddeposit.FieldReceiveAddress, unmatcheddeposit.FieldFromAddress, unmatcheddeposit.FieldReason, unmatcheddeposit.FieldStatus:
+			values[i] = new(sql.NullString)
+		case unmatcheddeposit.FieldCreatedAt, unmatcheddeposit.FieldUpdatedAt, unmatcheddeposit.FieldResolvedAt:
+			values[i] = new(sql.NullTime)
+		case unmatcheddeposit.FieldID:
+			values[i] = new(uuid.UUID)
+		case unmatcheddeposit.ForeignKeys[0]: // payment_order_unmatched_deposits
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case unmatcheddeposit.ForeignKeys[1]: // sweep_unmatched_deposit
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case unmatcheddeposit.ForeignKeys[2]: // token_unmatched_deposits
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UnmatchedDeposit fields.
+func (ud *UnmatchedDeposit) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case unmatcheddeposit.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ud.ID = *value
+			}
+		case unmatcheddeposit.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ud.CreatedAt = value.Time
+			}
+		case unmatcheddeposit.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ud.UpdatedAt = value.Time
+			}
+		case unmatcheddeposit.FieldTxHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tx_hash", values[i])
+			} else if value.Valid {
+				ud.TxHash = value.String
+			}
+		case unmatcheddeposit.FieldReceiveAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field receive_address", values[i])
+			} else if value.Valid {
+				ud.ReceiveAddress = value.String
+			}
+		case unmatcheddeposit.FieldFromAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_address", values[i])
+			} else if value.Valid {
+				ud.FromAddress = value.String
+			}
+		case unmatcheddeposit.FieldAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				ud.Amount = *value
+			}
+		case unmatcheddeposit.FieldBlockNumber:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field block_number", values[i])
+			} else if value.Valid {
+				ud.BlockNumber = value.Int64
+			}
+		case unmatcheddeposit.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				ud.Reason = unmatcheddeposit.Reason(value.String)
+			}
+		case unmatcheddeposit.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ud.Status = unmatcheddeposit.Status(value.String)
+			}
+		case unmatcheddeposit.FieldResolvedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field resolved_at", values[i])
+			} else if value.Valid {
+				ud.ResolvedAt = value.Time
+			}
+		case unmatcheddeposit.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_order_unmatched_deposits", values[i])
+			} else if value.Valid {
+				ud.payment_order_unmatched_deposits = new(uuid.UUID)
+				*ud.payment_order_unmatched_deposits = *value.S.(*uuid.UUID)
+			}
+		case unmatcheddeposit.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field sweep_unmatched_deposit", values[i])
+			} else if value.Valid {
+				ud.sweep_unmatched_deposit = new(uuid.UUID)
+				*ud.sweep_unmatched_deposit = *value.S.(*uuid.UUID)
+			}
+		case unmatcheddeposit.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field token_unmatched_deposits", value)
+			} else if value.Valid {
+				ud.token_unmatched_deposits = new(int)
+				*ud.token_unmatched_deposits = int(value.Int64)
+			}
+		default:
+			ud.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UnmatchedDeposit.
+// This includes values selected through modifiers, order, etc.
+func (ud *UnmatchedDeposit) Value(name string) (ent.Value, error) {
+	return ud.selectValues.Get(name)
+}
+
+// QueryToken queries the "token" edge of the UnmatchedDeposit entity.
+func (ud *UnmatchedDeposit) QueryToken() *TokenQuery {
+	return NewUnmatchedDepositClient(ud.config).QueryToken(ud)
+}
+
+// QueryPaymentOrder queries the "payment_order" edge of the UnmatchedDeposit entity.
+func (ud *UnmatchedDeposit) QueryPaymentOrder() *PaymentOrderQuery {
+	return NewUnmatchedDepositClient(ud.config).QueryPaymentOrder(ud)
+}
+
+// QuerySweep queries the "sweep" edge of the UnmatchedDeposit entity.
+func (ud *UnmatchedDeposit) QuerySweep() *SweepQuery {
+	return NewUnmatchedDepositClient(ud.config).QuerySweep(ud)
+}
+
+// Update returns a builder for updating this UnmatchedDeposit.
+// Note that you need to call UnmatchedDeposit.Unwrap() before calling this method if this UnmatchedDeposit
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ud *UnmatchedDeposit) Update() *UnmatchedDepositUpdateOne {
+	return NewUnmatchedDepositClient(ud.config).UpdateOne(ud)
+}
+
+// Unwrap unwraps the UnmatchedDeposit entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ud *UnmatchedDeposit) Unwrap() *UnmatchedDeposit {
+	_tx, ok := ud.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UnmatchedDeposit is not a transactional entity")
+	}
+	ud.config.driver = _tx.drv
+	return ud
+}
+
+// String implements the fmt.Stringer.
+func (ud *UnmatchedDeposit) String() string {
+	var builder strings.Builder
+	builder.WriteString("UnmatchedDeposit(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ud.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ud.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ud.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("tx_hash=")
+	builder.WriteString(ud.TxHash)
+	builder.WriteString(", ")
+	builder.WriteString("receive_address=")
+	builder.WriteString(ud.ReceiveAddress)
+	builder.WriteString(", ")
+	builder.WriteString("from_address=")
+	builder.WriteString(ud.FromAddress)
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", ud.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("block_number=")
+	builder.WriteString(fmt.Sprintf("%v", ud.BlockNumber))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(fmt.Sprintf("%v", ud.Reason))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", ud.Status))
+	builder.WriteString(", ")
+	builder.WriteString("resolved_at=")
+	builder.WriteString(ud.ResolvedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UnmatchedDeposits is a parsable slice of UnmatchedDeposit.
+type UnmatchedDeposits []*UnmatchedDeposit
@@ -0,0 +1,627 @@
+// Code generated by ent, DO NOT EDIT.
+
+package unmatcheddeposit
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TxHash applies equality check predicate on the "tx_hash" field. It's identical to TxHashEQ.
+func TxHash(v string) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldEQ(FieldTxHash, v))
+}
+
+// ReceiveAddress applies equality check predicate on the "receive_address" field. It's identical to ReceiveAddressEQ.
+func ReceiveAddress(v string) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldEQ(FieldReceiveAddress, v))
+}
+
+// FromAddress applies equality check predicate on the "from_address" field. It's identical to FromAddressEQ.
+func FromAddress(v string) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldEQ(FieldFromAddress, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v decimal.Decimal) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldEQ(FieldAmount, v))
+}
+
+// BlockNumber applies equality check predicate on the "block_number" field. It's identical to BlockNumberEQ.
+func BlockNumber(v int64) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldEQ(FieldBlockNumber, v))
+}
+
+// ResolvedAt applies equality check predicate on the "resolved_at" field. It's identical to ResolvedAtEQ.
+func ResolvedAt(v time.Time) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// TxHashEQ applies the EQ predicate on the "tx_hash" field.
+func TxHashEQ(v string) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldEQ(FieldTxHash, v))
+}
+
+// TxHashNEQ applies the NEQ predicate on the "tx_hash" field.
+func TxHashNEQ(v string) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldNEQ(FieldTxHash, v))
+}
+
+// TxHashIn applies the In predicate on the "tx_hash" field.
+func TxHashIn(vs ...string) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldIn(FieldTxHash, vs...))
+}
+
+// TxHashNotIn applies the NotIn predicate on the "tx_hash" field.
+func TxHashNotIn(vs ...string) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldNotIn(FieldTxHash, vs...))
+}
+
+// TxHashGT applies the GT predicate on the "tx_hash" field.
+func TxHashGT(v string) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldGT(FieldTxHash, v))
+}
+
+// TxHashGTE applies the GTE predicate on the "tx_hash" field.
+func TxHashGTE(v string) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldGTE(FieldTxHash, v))
+}
+
+// TxHashLT applies the LT predicate on the "tx_hash" field.
+func TxHashLT(v string) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldLT(FieldTxHash, v))
+}
+
+// TxHashLTE applies the LTE predicate on the "tx_hash" field.
+func TxHashLTE(v string) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldLTE(FieldTxHash, v))
+}
+
+// TxHashContains applies the Contains predicate on the "tx_hash" field.
+func TxHashContains(v string) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldContains(FieldTxHash, v))
+}
+
+// TxHashHasPrefix applies the HasPrefix predicate on the "tx_hash" field.
+func TxHashHasPrefix(v string) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldHasPrefix(FieldTxHash, v))
+}
+
+// TxHashHasSuffix applies the HasSuffix predicate on the "tx_hash" field.
+func TxHashHasSuffix(v string) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldHasSuffix(FieldTxHash, v))
+}
+
+// TxHashEqualFold applies the EqualFold predicate on the "tx_hash" field.
+func TxHashEqualFold(v string) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldEqualFold(FieldTxHash, v))
+}
+
+// TxHashContainsFold applies the ContainsFold predicate on the "tx_hash" field.
+func TxHashContainsFold(v string) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldContainsFold(FieldTxHash, v))
+}
+
+// ReceiveAddressEQ applies the EQ predicate on the "receive_address" field.
+func ReceiveAddressEQ(v string) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldEQ(FieldReceiveAddress, v))
+}
+
+// ReceiveAddressNEQ applies the NEQ predicate on the "receive_address" field.
+func ReceiveAddressNEQ(v string) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldNEQ(FieldReceiveAddress, v))
+}
+
+// ReceiveAddressIn applies the In predicate on the "receive_address" field.
+func ReceiveAddressIn(vs ...string) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldIn(FieldReceiveAddress, vs...))
+}
+
+// ReceiveAddressNotIn applies the NotIn predicate on the "receive_address" field.
+func ReceiveAddressNotIn(vs ...string) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldNotIn(FieldReceiveAddress, vs...))
+}
+
+// ReceiveAddressGT applies the GT predicate on the "receive_address" field.
+func ReceiveAddressGT(v string) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldGT(FieldReceiveAddress, v))
+}
+
+// ReceiveAddressGTE applies the GTE predicate on the "receive_address" field.
+func ReceiveAddressGTE(v string) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldGTE(FieldReceiveAddress, v))
+}
+
+// ReceiveAddressLT applies the LT predicate on the "receive_address" field.
+func ReceiveAddressLT(v string) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldLT(FieldReceiveAddress, v))
+}
+
+// ReceiveAddressLTE applies the LTE predicate on the "receive_address" field.
+func ReceiveAddressLTE(v string) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldLTE(FieldReceiveAddress, v))
+}
+
+// ReceiveAddressContains applies the Contains predicate on the "receive_address" field.
+func ReceiveAddressContains(v string) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldContains(FieldReceiveAddress, v))
+}
+
+// ReceiveAddressHasPrefix applies the HasPrefix predicate on the "receive_address" field.
+func ReceiveAddressHasPrefix(v string) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldHasPrefix(FieldReceiveAddress, v))
+}
+
+// ReceiveAddressHasSuffix applies the HasSuffix predicate on the "receive_address" field.
+func ReceiveAddressHasSuffix(v string) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldHasSuffix(FieldReceiveAddress, v))
+}
+
+// ReceiveAddressEqualFold applies the EqualFold predicate on the "receive_address" field.
+func ReceiveAddressEqualFold(v string) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldEqualFold(FieldReceiveAddress, v))
+}
+
+// ReceiveAddressContainsFold applies the ContainsFold predicate on the "receive_address" field.
+func ReceiveAddressContainsFold(v string) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldContainsFold(FieldReceiveAddress, v))
+}
+
+// FromAddressEQ applies the EQ predicate on the "from_address" field.
+func FromAddressEQ(v string) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldEQ(FieldFromAddress, v))
+}
+
+// FromAddressNEQ applies the NEQ predicate on the "from_address" field.
+func FromAddressNEQ(v string) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldNEQ(FieldFromAddress, v))
+}
+
+// FromAddressIn applies the In predicate on the "from_address" field.
+func FromAddressIn(vs ...string) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldIn(FieldFromAddress, vs...))
+}
+
+// FromAddressNotIn applies the NotIn predicate on the "from_address" field.
+func FromAddressNotIn(vs ...string) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldNotIn(FieldFromAddress, vs...))
+}
+
+// FromAddressGT applies the GT predicate on the "from_address" field.
+func FromAddressGT(v string) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldGT(FieldFromAddress, v))
+}
+
+// FromAddressGTE applies the GTE predicate on the "from_address" field.
+func FromAddressGTE(v string) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldGTE(FieldFromAddress, v))
+}
+
+// FromAddressLT applies the LT predicate on the "from_address" field.
+func FromAddressLT(v string) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldLT(FieldFromAddress, v))
+}
+
+// FromAddressLTE applies the LTE predicate on the "from_address" field.
+func FromAddressLTE(v string) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldLTE(FieldFromAddress, v))
+}
+
+// FromAddressContains applies the Contains predicate on the "from_address" field.
+func FromAddressContains(v string) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldContains(FieldFromAddress, v))
+}
+
+// FromAddressHasPrefix applies the HasPrefix predicate on the "from_address" field.
+func FromAddressHasPrefix(v string) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldHasPrefix(FieldFromAddress, v))
+}
+
+// FromAddressHasSuffix applies the HasSuffix predicate on the "from_address" field.
+func FromAddressHasSuffix(v string) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldHasSuffix(FieldFromAddress, v))
+}
+
+// FromAddressEqualFold applies the EqualFold predicate on the "from_address" field.
+func FromAddressEqualFold(v string) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldEqualFold(FieldFromAddress, v))
+}
+
+// FromAddressContainsFold applies the ContainsFold predicate on the "from_address" field.
+func FromAddressContainsFold(v string) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldContainsFold(FieldFromAddress, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v decimal.Decimal) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v decimal.Decimal) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...decimal.Decimal) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...decimal.Decimal) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v decimal.Decimal) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v decimal.Decimal) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v decimal.Decimal) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v decimal.Decimal) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldLTE(FieldAmount, v))
+}
+
+// BlockNumberEQ applies the EQ predicate on the "block_number" field.
+func BlockNumberEQ(v int64) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldEQ(FieldBlockNumber, v))
+}
+
+// BlockNumberNEQ applies the NEQ predicate on the "block_number" field.
+func BlockNumberNEQ(v int64) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldNEQ(FieldBlockNumber, v))
+}
+
+// BlockNumberIn applies the In predicate on the "block_number" field.
+func BlockNumberIn(vs ...int64) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldIn(FieldBlockNumber, vs...))
+}
+
+// BlockNumberNotIn applies the NotIn predicate on the "block_number" field.
+func BlockNumberNotIn(vs ...int64) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldNotIn(FieldBlockNumber, vs...))
+}
+
+// BlockNumberGT applies the GT predicate on the "block_number" field.
+func BlockNumberGT(v int64) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldGT(FieldBlockNumber, v))
+}
+
+// BlockNumberGTE applies the GTE predicate on the "block_number" field.
+func BlockNumberGTE(v int64) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldGTE(FieldBlockNumber, v))
+}
+
+// BlockNumberLT applies the LT predicate on the "block_number" field.
+func BlockNumberLT(v int64) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldLT(FieldBlockNumber, v))
+}
+
+// BlockNumberLTE applies the LTE predicate on the "block_number" field.
+func BlockNumberLTE(v int64) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldLTE(FieldBlockNumber, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v Reason) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v Reason) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...Reason) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...Reason) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ResolvedAtEQ applies the EQ predicate on the "resolved_at" field.
+func ResolvedAtEQ(v time.Time) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtNEQ applies the NEQ predicate on the "resolved_at" field.
+func ResolvedAtNEQ(v time.Time) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldNEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtIn applies the In predicate on the "resolved_at" field.
+func ResolvedAtIn(vs ...time.Time) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtNotIn applies the NotIn predicate on the "resolved_at" field.
+func ResolvedAtNotIn(vs ...time.Time) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldNotIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtGT applies the GT predicate on the "resolved_at" field.
+func ResolvedAtGT(v time.Time) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldGT(FieldResolvedAt, v))
+}
+
+// ResolvedAtGTE applies the GTE predicate on the "resolved_at" field.
+func ResolvedAtGTE(v time.Time) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldGTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtLT applies the LT predicate on the "resolved_at" field.
+func ResolvedAtLT(v time.Time) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldLT(FieldResolvedAt, v))
+}
+
+// ResolvedAtLTE applies the LTE predicate on the "resolved_at" field.
+func ResolvedAtLTE(v time.Time) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldLTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtIsNil applies the IsNil predicate on the "resolved_at" field.
+func ResolvedAtIsNil() predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldIsNull(FieldResolvedAt))
+}
+
+// ResolvedAtNotNil applies the NotNil predicate on the "resolved_at" field.
+func ResolvedAtNotNil() predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.FieldNotNull(FieldResolvedAt))
+}
+
+// HasToken applies the HasEdge predicate on the "token" edge.
+func HasToken() predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TokenTable, TokenColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTokenWith applies the HasEdge predicate on the "token" edge with a given conditions (other predicates).
+func HasTokenWith(preds ...predicate.Token) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(func(s *sql.Selector) {
+		step := newTokenStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPaymentOrder applies the HasEdge predicate on the "payment_order" edge.
+func HasPaymentOrder() predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PaymentOrderTable, PaymentOrderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPaymentOrderWith applies the HasEdge predicate on the "payment_order" edge with a given conditions (other predicates).
+func HasPaymentOrderWith(preds ...predicate.PaymentOrder) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(func(s *sql.Selector) {
+		step := newPaymentOrderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSweep applies the HasEdge predicate on the "sweep" edge.
+func HasSweep() predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, SweepTable, SweepColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSweepWith applies the HasEdge predicate on the "sweep" edge with a given conditions (other predicates).
+func HasSweepWith(preds ...predicate.Sweep) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(func(s *sql.Selector) {
+		step := newSweepStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UnmatchedDeposit) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UnmatchedDeposit) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UnmatchedDeposit) predicate.UnmatchedDeposit {
+	return predicate.UnmatchedDeposit(sql.NotPredicates(p))
+}
@@ -0,0 +1,1187 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/sweep"
+	"github.com/NEDA-LABS/stablenode/ent/token"
+	"github.com/NEDA-LABS/stablenode/ent/unmatcheddeposit"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// UnmatchedDepositCreate is the builder for creating a UnmatchedDeposit entity.
+type UnmatchedDepositCreate struct {
+	config
+	mutation *UnmatchedDepositMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (udc *UnmatchedDepositCreate) SetCreatedAt(t time.Time) *UnmatchedDepositCreate {
+	udc.mutation.SetCreatedAt(t)
+	return udc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (udc *UnmatchedDepositCreate) SetNillableCreatedAt(t *time.Time) *UnmatchedDepositCreate {
+	if t != nil {
+		udc.SetCreatedAt(*t)
+	}
+	return udc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (udc *UnmatchedDepositCreate) SetUpdatedAt(t time.Time) *UnmatchedDepositCreate {
+	udc.mutation.SetUpdatedAt(t)
+	return udc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (udc *UnmatchedDepositCreate) SetNillableUpdatedAt(t *time.Time) *UnmatchedDepositCreate {
+	if t != nil {
+		udc.SetUpdatedAt(*t)
+	}
+	return udc
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (udc *UnmatchedDepositCreate) SetTxHash(s string) *UnmatchedDepositCreate {
+	udc.mutation.SetTxHash(s)
+	return udc
+}
+
+// SetReceiveAddress sets the "receive_address" field.
+func (udc *UnmatchedDepositCreate) SetReceiveAddress(s string) *UnmatchedDepositCreate {
+	udc.mutation.SetReceiveAddress(s)
+	return udc
+}
+
+// SetFromAddress sets the "from_address" field.
+func (udc *UnmatchedDepositCreate) SetFromAddress(s string) *UnmatchedDepositCreate {
+	udc.mutation.SetFromAddress(s)
+	return udc
+}
+
+// SetAmount sets the "amount" field.
+func (udc *UnmatchedDepositCreate) SetAmount(d decimal.Decimal) *UnmatchedDepositCreate {
+	udc.mutation.SetAmount(d)
+	return udc
+}
+
+// SetBlockNumber sets the "block_number" field.
+func (udc *UnmatchedDepositCreate) SetBlockNumber(i int64) *UnmatchedDepositCreate {
+	udc.mutation.SetBlockNumber(i)
+	return udc
+}
+
+// SetNillableBlockNumber sets the "block_number" field if the given value is not nil.
+func (udc *UnmatchedDepositCreate) SetNillableBlockNumber(i *int64) *UnmatchedDepositCreate {
+	if i != nil {
+		udc.SetBlockNumber(*i)
+	}
+	return udc
+}
+
+// SetReason sets the "reason" field.
+func (udc *UnmatchedDepositCreate) SetReason(u unmatcheddeposit.Reason) *UnmatchedDepositCreate {
+	udc.mutation.SetReason(u)
+	return udc
+}
+
+// SetStatus sets the "status" field.
+func (udc *UnmatchedDepositCreate) SetStatus(u unmatcheddeposit.Status) *UnmatchedDepositCreate {
+	udc.mutation.SetStatus(u)
+	return udc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (udc *UnmatchedDepositCreate) SetNillableStatus(u *unmatcheddeposit.Status) *UnmatchedDepositCreate {
+	if u != nil {
+		udc.SetStatus(*u)
+	}
+	return udc
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (udc *UnmatchedDepositCreate) SetResolvedAt(t time.Time) *UnmatchedDepositCreate {
+	udc.mutation.SetResolvedAt(t)
+	return udc
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (udc *UnmatchedDepositCreate) SetNillableResolvedAt(t *time.Time) *UnmatchedDepositCreate {
+	if t != nil {
+		udc.SetResolvedAt(*t)
+	}
+	return udc
+}
+
+// SetID sets the "id" field.
+func (udc *UnmatchedDepositCreate) SetID(u uuid.UUID) *UnmatchedDepositCreate {
+	udc.mutation.SetID(u)
+	return udc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (udc *UnmatchedDepositCreate) SetNillableID(u *uuid.UUID) *UnmatchedDepositCreate {
+	if u != nil {
+		udc.SetID(*u)
+	}
+	return udc
+}
+
+// SetTokenID sets the "token" edge to the Token entity by ID.
+func (udc *UnmatchedDepositCreate) SetTokenID(id int) *UnmatchedDepositCreate {
+	udc.mutation.SetTokenID(id)
+	return udc
+}
+
+// SetToken sets the "token" edge to the Token entity.
+func (udc *UnmatchedDepositCreate) SetToken(t *Token) *UnmatchedDepositCreate {
+	return udc.SetTokenID(t.ID)
+}
+
+// SetPaymentOrderID sets the "payment_order" edge to the PaymentOrder entity by ID.
+func (udc *UnmatchedDepositCreate) SetPaymentOrderID(id uuid.UUID) *UnmatchedDepositCreate {
+	udc.mutation.SetPaymentOrderID(id)
+	return udc
+}
+
+// SetNillablePaymentOrderID sets the "payment_order" edge to the PaymentOrder entity by ID if the given value is not nil.
+func (udc *UnmatchedDepositCreate) SetNillablePaymentOrderID(id *uuid.UUID) *UnmatchedDepositCreate {
+	if id != nil {
+		udc = udc.SetPaymentOrderID(*id)
+	}
+	return udc
+}
+
+// SetPaymentOrder sets the "payment_order" edge to the PaymentOrder entity.
+func (udc *UnmatchedDepositCreate) SetPaymentOrder(p *PaymentOrder) *UnmatchedDepositCreate {
+	return udc.SetPaymentOrderID(p.ID)
+}
+
+// SetSweepID sets the "sweep" edge to the Sweep entity by ID.
+func (udc *UnmatchedDepositCreate) SetSweepID(id uuid.UUID) *UnmatchedDepositCreate {
+	udc.mutation.SetSweepID(id)
+	return udc
+}
+
+// SetNillableSweepID sets the "sweep" edge to the Sweep entity by ID if the given value is not nil.
+func (udc *UnmatchedDepositCreate) SetNillableSweepID(id *uuid.UUID) *UnmatchedDepositCreate {
+	if id != nil {
+		udc = udc.SetSweepID(*id)
+	}
+	return udc
+}
+
+// SetSweep sets the "sweep" edge to the Sweep entity.
+func (udc *UnmatchedDepositCreate) SetSweep(s *Sweep) *UnmatchedDepositCreate {
+	return udc.SetSweepID(s.ID)
+}
+
+// Mutation returns the UnmatchedDepositMutation object of the builder.
+func (udc *UnmatchedDepositCreate) Mutation() *UnmatchedDepositMutation {
+	return udc.mutation
+}
+
+// Save creates the UnmatchedDeposit in the database.
+func (udc *UnmatchedDepositCreate) Save(ctx context.Context) (*UnmatchedDeposit, error) {
+	udc.defaults()
+	return withHooks(ctx, udc.sqlSave, udc.mutation, udc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (udc *UnmatchedDepositCreate) SaveX(ctx context.Context) *UnmatchedDeposit {
+	v, err := udc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (udc *UnmatchedDepositCreate) Exec(ctx context.Context) error {
+	_, err := udc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (udc *UnmatchedDepositCreate) ExecX(ctx context.Context) {
+	if err := udc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (udc *UnmatchedDepositCreate) defaults() {
+	if _, ok := udc.mutation.CreatedAt(); !ok {
+		v := unmatcheddeposit.DefaultCreatedAt()
+		udc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := udc.mutation.UpdatedAt(); !ok {
+		v := unmatcheddeposit.DefaultUpdatedAt()
+		udc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := udc.mutation.BlockNumber(); !ok {
+		v := unmatcheddeposit.DefaultBlockNumber
+		udc.mutation.SetBlockNumber(v)
+	}
+	if _, ok := udc.mutation.Status(); !ok {
+		v := unmatcheddeposit.DefaultStatus
+		udc.mutation.SetStatus(v)
+	}
+	if _, ok := udc.mutation.ID(); !ok {
+		v := unmatcheddeposit.DefaultID()
+		udc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (udc *UnmatchedDepositCreate) check() error {
+	if _, ok := udc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UnmatchedDeposit.created_at"`)}
+	}
+	if _, ok := udc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "UnmatchedDeposit.updated_at"`)}
+	}
+	if _, ok := udc.mutation.TxHash(); !ok {
+		return &ValidationError{Name: "tx_hash", err: errors.New(`ent: missing required field "UnmatchedDeposit.tx_hash"`)}
+	}
+	if v, ok := udc.mutation.TxHash(); ok {
+		if err := unmatcheddeposit.TxHashValidator(v); err != nil {
+			return &ValidationError{Name: "tx_hash", err: fmt.Errorf(`ent: validator failed for field "UnmatchedDeposit.tx_hash": %w`, err)}
+		}
+	}
+	if _, ok := udc.mutation.ReceiveAddress(); !ok {
+		return &ValidationError{Name: "receive_address", err: errors.New(`ent: missing required field "UnmatchedDeposit.receive_address"`)}
+	}
+	if _, ok := udc.mutation.FromAddress(); !ok {
+		return &ValidationError{Name: "from_address", err: errors.New(`ent: missing required field "UnmatchedDeposit.from_address"`)}
+	}
+	if _, ok := udc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "UnmatchedDeposit.amount"`)}
+	}
+	if _, ok := udc.mutation.BlockNumber(); !ok {
+		return &ValidationError{Name: "block_number", err: errors.New(`ent: missing required field "UnmatchedDeposit.block_number"`)}
+	}
+	if _, ok := udc.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "UnmatchedDeposit.reason"`)}
+	}
+	if v, ok := udc.mutation.Reason(); ok {
+		if err := unmatcheddeposit.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "UnmatchedDeposit.reason": %w`, err)}
+		}
+	}
+	if _, ok := udc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "UnmatchedDeposit.status"`)}
+	}
+	if v, ok := udc.mutation.Status(); ok {
+		if err := unmatcheddeposit.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "UnmatchedDeposit.status": %w`, err)}
+		}
+	}
+	if len(udc.mutation.TokenIDs()) == 0 {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required edge "UnmatchedDeposit.token"`)}
+	}
+	return nil
+}
+
+func (udc *UnmatchedDepositCreate) sqlSave(ctx context.Context) (*UnmatchedDeposit, error) {
+	if err := udc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := udc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, udc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	udc.mutation.id = &_node.ID
+	udc.mutation.done = true
+	return _node, nil
+}
+
+func (udc *UnmatchedDepositCreate) createSpec() (*UnmatchedDeposit, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UnmatchedDeposit{config: udc.config}
+		_spec = sqlgraph.NewCreateSpec(unmatcheddeposit.Table, sqlgraph.NewFieldSpec(unmatcheddeposit.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = udc.conflict
+	if id, ok := udc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := udc.mutation.CreatedAt(); ok {
+		_spec.SetField(unmatcheddeposit.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := udc.mutation.UpdatedAt(); ok {
+		_spec.SetField(unmatcheddeposit.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := udc.mutation.TxHash(); ok {
+		_spec.SetField(unmatcheddeposit.FieldTxHash, field.TypeString, value)
+		_node.TxHash = value
+	}
+	if value, ok := udc.mutation.ReceiveAddress(); ok {
+		_spec.SetField(unmatcheddeposit.FieldReceiveAddress, field.TypeString, value)
+		_node.ReceiveAddress = value
+	}
+	if value, ok := udc.mutation.FromAddress(); ok {
+		_spec.SetField(unmatcheddeposit.FieldFromAddress, field.TypeString, value)
+		_node.FromAddress = value
+	}
+	if value, ok := udc.mutation.Amount(); ok {
+		_spec.SetField(unmatcheddeposit.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := udc.mutation.BlockNumber(); ok {
+		_spec.SetField(unmatcheddeposit.FieldBlockNumber, field.TypeInt64, value)
+		_node.BlockNumber = value
+	}
+	if value, ok := udc.mutation.Reason(); ok {
+		_spec.SetField(unmatcheddeposit.FieldReason, field.TypeEnum, value)
+		_node.Reason = value
+	}
+	if value, ok := udc.mutation.Status(); ok {
+		_spec.SetField(unmatcheddeposit.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := udc.mutation.ResolvedAt(); ok {
+		_spec.SetField(unmatcheddeposit.FieldResolvedAt, field.TypeTime, value)
+		_node.ResolvedAt = value
+	}
+	if nodes := udc.mutation.TokenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   unmatcheddeposit.TokenTable,
+			Columns: []string{unmatcheddeposit.TokenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(token.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.token_unmatched_deposits = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := udc.mutation.PaymentOrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   unmatcheddeposit.PaymentOrderTable,
+			Columns: []string{unmatcheddeposit.PaymentOrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.payment_order_unmatched_deposits = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := udc.mutation.SweepIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   unmatcheddeposit.SweepTable,
+			Columns: []string{unmatcheddeposit.SweepColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sweep.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.sweep_unmatched_deposit = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UnmatchedDeposit.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UnmatchedDepositUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (udc *UnmatchedDepositCreate) OnConflict(opts ...sql.ConflictOption) *UnmatchedDepositUpsertOne {
+	udc.conflict = opts
+	return &UnmatchedDepositUpsertOne{
+		create: udc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UnmatchedDeposit.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (udc *UnmatchedDepositCreate) OnConflictColumns(columns ...string) *UnmatchedDepositUpsertOne {
+	udc.conflict = append(udc.conflict, sql.ConflictColumns(columns...))
+	return &UnmatchedDepositUpsertOne{
+		create: udc,
+	}
+}
+
+type (
+	// UnmatchedDepositUpsertOne is the builder for "upsert"-ing
+	//  one UnmatchedDeposit node.
+	UnmatchedDepositUpsertOne struct {
+		create *UnmatchedDepositCreate
+	}
+
+	// UnmatchedDepositUpsert is the "OnConflict" setter.
+	UnmatchedDepositUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UnmatchedDepositUpsert) SetUpdatedAt(v time.Time) *UnmatchedDepositUpsert {
+	u.Set(unmatcheddeposit.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UnmatchedDepositUpsert) UpdateUpdatedAt() *UnmatchedDepositUpsert {
+	u.SetExcluded(unmatcheddeposit.FieldUpdatedAt)
+	return u
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (u *UnmatchedDepositUpsert) SetTxHash(v string) *UnmatchedDepositUpsert {
+	u.Set(unmatcheddeposit.FieldTxHash, v)
+	return u
+}
+
+// UpdateTxHash sets the "tx_hash" field to the value that was provided on create.
+func (u *UnmatchedDepositUpsert) UpdateTxHash() *UnmatchedDepositUpsert {
+	u.SetExcluded(unmatcheddeposit.FieldTxHash)
+	return u
+}
+
+// SetReceiveAddress sets the "receive_address" field.
+func (u *UnmatchedDepositUpsert) SetReceiveAddress(v string) *UnmatchedDepositUpsert {
+	u.Set(unmatcheddeposit.FieldReceiveAddress, v)
+	return u
+}
+
+// UpdateReceiveAddress sets the "receive_address" field to the value that was provided on create.
+func (u *UnmatchedDepositUpsert) UpdateReceiveAddress() *UnmatchedDepositUpsert {
+	u.SetExcluded(unmatcheddeposit.FieldReceiveAddress)
+	return u
+}
+
+// SetFromAddress sets the "from_address" field.
+func (u *UnmatchedDepositUpsert) SetFromAddress(v string) *UnmatchedDepositUpsert {
+	u.Set(unmatcheddeposit.FieldFromAddress, v)
+	return u
+}
+
+// UpdateFromAddress sets the "from_address" field to the value that was provided on create.
+func (u *UnmatchedDepositUpsert) UpdateFromAddress() *UnmatchedDepositUpsert {
+	u.SetExcluded(unmatcheddeposit.FieldFromAddress)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *UnmatchedDepositUpsert) SetAmount(v decimal.Decimal) *UnmatchedDepositUpsert {
+	u.Set(unmatcheddeposit.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *UnmatchedDepositUpsert) UpdateAmount() *UnmatchedDepositUpsert {
+	u.SetExcluded(unmatcheddeposit.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *UnmatchedDepositUpsert) AddAmount(v decimal.Decimal) *UnmatchedDepositUpsert {
+	u.Add(unmatcheddeposit.FieldAmount, v)
+	return u
+}
+
+// SetBlockNumber sets the "block_number" field.
+func (u *UnmatchedDepositUpsert) SetBlockNumber(v int64) *UnmatchedDepositUpsert {
+	u.Set(unmatcheddeposit.FieldBlockNumber, v)
+	return u
+}
+
+// UpdateBlockNumber sets the "block_number" field to the value that was provided on create.
+func (u *UnmatchedDepositUpsert) UpdateBlockNumber() *UnmatchedDepositUpsert {
+	u.SetExcluded(unmatcheddeposit.FieldBlockNumber)
+	return u
+}
+
+// AddBlockNumber adds v to the "block_number" field.
+func (u *UnmatchedDepositUpsert) AddBlockNumber(v int64) *UnmatchedDepositUpsert {
+	u.Add(unmatcheddeposit.FieldBlockNumber, v)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *UnmatchedDepositUpsert) SetReason(v unmatcheddeposit.Reason) *UnmatchedDepositUpsert {
+	u.Set(unmatcheddeposit.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *UnmatchedDepositUpsert) UpdateReason() *UnmatchedDepositUpsert {
+	u.SetExcluded(unmatcheddeposit.FieldReason)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *UnmatchedDepositUpsert) SetStatus(v unmatcheddeposit.Status) *UnmatchedDepositUpsert {
+	u.Set(unmatcheddeposit.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *UnmatchedDepositUpsert) UpdateStatus() *UnmatchedDepositUpsert {
+	u.SetExcluded(unmatcheddeposit.FieldStatus)
+	return u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (u *UnmatchedDepositUpsert) SetResolvedAt(v time.Time) *UnmatchedDepositUpsert {
+	u.Set(unmatcheddeposit.FieldResolvedAt, v)
+	return u
+}
+
+// UpdateResolvedAt sets the "resolved_at" field to the value that was provided on create.
+func (u *UnmatchedDepositUpsert) UpdateResolvedAt() *UnmatchedDepositUpsert {
+	u.SetExcluded(unmatcheddeposit.FieldResolvedAt)
+	return u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (u *UnmatchedDepositUpsert) ClearResolvedAt() *UnmatchedDepositUpsert {
+	u.SetNull(unmatcheddeposit.FieldResolvedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.UnmatchedDeposit.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(unmatcheddeposit.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UnmatchedDepositUpsertOne) UpdateNewValues() *UnmatchedDepositUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(unmatcheddeposit.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(unmatcheddeposit.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UnmatchedDeposit.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UnmatchedDepositUpsertOne) Ignore() *UnmatchedDepositUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UnmatchedDepositUpsertOne) DoNothing() *UnmatchedDepositUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UnmatchedDepositCreate.OnConflict
+// documentation for more info.
+func (u *UnmatchedDepositUpsertOne) Update(set func(*UnmatchedDepositUpsert)) *UnmatchedDepositUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UnmatchedDepositUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UnmatchedDepositUpsertOne) SetUpdatedAt(v time.Time) *UnmatchedDepositUpsertOne {
+	return u.Update(func(s *UnmatchedDepositUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UnmatchedDepositUpsertOne) UpdateUpdatedAt() *UnmatchedDepositUpsertOne {
+	return u.Update(func(s *UnmatchedDepositUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (u *UnmatchedDepositUpsertOne) SetTxHash(v string) *UnmatchedDepositUpsertOne {
+	return u.Update(func(s *UnmatchedDepositUpsert) {
+		s.SetTxHash(v)
+	})
+}
+
+// UpdateTxHash sets the "tx_hash" field to the value that was provided on create.
+func (u *UnmatchedDepositUpsertOne) UpdateTxHash() *UnmatchedDepositUpsertOne {
+	return u.Update(func(s *UnmatchedDepositUpsert) {
+		s.UpdateTxHash()
+	})
+}
+
+// SetReceiveAddress sets the "receive_address" field.
+func (u *UnmatchedDepositUpsertOne) SetReceiveAddress(v string) *UnmatchedDepositUpsertOne {
+	return u.Update(func(s *UnmatchedDepositUpsert) {
+		s.SetReceiveAddress(v)
+	})
+}
+
+// UpdateReceiveAddress sets the "receive_address" field to the value that was provided on create.
+func (u *UnmatchedDepositUpsertOne) UpdateReceiveAddress() *UnmatchedDepositUpsertOne {
+	return u.Update(func(s *UnmatchedDepositUpsert) {
+		s.UpdateReceiveAddress()
+	})
+}
+
+// SetFromAddress sets the "from_address" field.
+func (u *UnmatchedDepositUpsertOne) SetFromAddress(v string) *UnmatchedDepositUpsertOne {
+	return u.Update(func(s *UnmatchedDepositUpsert) {
+		s.SetFromAddress(v)
+	})
+}
+
+// UpdateFromAddress sets the "from_address" field to the value that was provided on create.
+func (u *UnmatchedDepositUpsertOne) UpdateFromAddress() *UnmatchedDepositUpsertOne {
+	return u.Update(func(s *UnmatchedDepositUpsert) {
+		s.UpdateFromAddress()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *UnmatchedDepositUpsertOne) SetAmount(v decimal.Decimal) *UnmatchedDepositUpsertOne {
+	return u.Update(func(s *UnmatchedDepositUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *UnmatchedDepositUpsertOne) AddAmount(v decimal.Decimal) *UnmatchedDepositUpsertOne {
+	return u.Update(func(s *UnmatchedDepositUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *UnmatchedDepositUpsertOne) UpdateAmount() *UnmatchedDepositUpsertOne {
+	return u.Update(func(s *UnmatchedDepositUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetBlockNumber sets the "block_number" field.
+func (u *UnmatchedDepositUpsertOne) SetBlockNumber(v int64) *UnmatchedDepositUpsertOne {
+	return u.Update(func(s *UnmatchedDepositUpsert) {
+		s.SetBlockNumber(v)
+	})
+}
+
+// AddBlockNumber adds v to the "block_number" field.
+func (u *UnmatchedDepositUpsertOne) AddBlockNumber(v int64) *UnmatchedDepositUpsertOne {
+	return u.Update(func(s *UnmatchedDepositUpsert) {
+		s.AddBlockNumber(v)
+	})
+}
+
+// UpdateBlockNumber sets the "block_number" field to the value that was provided on create.
+func (u *UnmatchedDepositUpsertOne) UpdateBlockNumber() *UnmatchedDepositUpsertOne {
+	return u.Update(func(s *UnmatchedDepositUpsert) {
+		s.UpdateBlockNumber()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *UnmatchedDepositUpsertOne) SetReason(v unmatcheddeposit.Reason) *UnmatchedDepositUpsertOne {
+	return u.Update(func(s *UnmatchedDepositUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *UnmatchedDepositUpsertOne) UpdateReason() *UnmatchedDepositUpsertOne {
+	return u.Update(func(s *UnmatchedDepositUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *UnmatchedDepositUpsertOne) SetStatus(v unmatcheddeposit.Status) *UnmatchedDepositUpsertOne {
+	return u.Update(func(s *UnmatchedDepositUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *UnmatchedDepositUpsertOne) UpdateStatus() *UnmatchedDepositUpsertOne {
+	return u.Update(func(s *UnmatchedDepositUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (u *UnmatchedDepositUpsertOne) SetResolvedAt(v time.Time) *UnmatchedDepositUpsertOne {
+	return u.Update(func(s *UnmatchedDepositUpsert) {
+		s.SetResolvedAt(v)
+	})
+}
+
+// UpdateResolvedAt sets the "resolved_at" field to the value that was provided on create.
+func (u *UnmatchedDepositUpsertOne) UpdateResolvedAt() *UnmatchedDepositUpsertOne {
+	return u.Update(func(s *UnmatchedDepositUpsert) {
+		s.UpdateResolvedAt()
+	})
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (u *UnmatchedDepositUpsertOne) ClearResolvedAt() *UnmatchedDepositUpsertOne {
+	return u.Update(func(s *UnmatchedDepositUpsert) {
+		s.ClearResolvedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *UnmatchedDepositUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UnmatchedDepositCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UnmatchedDepositUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UnmatchedDepositUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: UnmatchedDepositUpsertOne.ID is not supported by MySQL driver. Use UnmatchedDepositUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UnmatchedDepositUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// UnmatchedDepositCreateBulk is the builder for creating many UnmatchedDeposit entities in bulk.
+type UnmatchedDepositCreateBulk struct {
+	config
+	err      error
+	builders []*UnmatchedDepositCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the UnmatchedDeposit entities in the database.
+func (udcb *UnmatchedDepositCreateBulk) Save(ctx context.Context) ([]*UnmatchedDeposit, error) {
+	if udcb.err != nil {
+		return nil, udcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(udcb.builders))
+	nodes := make([]*UnmatchedDeposit, len(udcb.builders))
+	mutators := make([]Mutator, len(udcb.builders))
+	for i := range udcb.builders {
+		func(i int, root context.Context) {
+			builder := udcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UnmatchedDepositMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, udcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = udcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, udcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, udcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (udcb *UnmatchedDepositCreateBulk) SaveX(ctx context.Context) []*UnmatchedDeposit {
+	v, err := udcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (udcb *UnmatchedDepositCreateBulk) Exec(ctx context.Context) error {
+	_, err := udcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (udcb *UnmatchedDepositCreateBulk) ExecX(ctx context.Context) {
+	if err := udcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UnmatchedDeposit.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UnmatchedDepositUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (udcb *UnmatchedDepositCreateBulk) OnConflict(opts ...sql.ConflictOption) *UnmatchedDepositUpsertBulk {
+	udcb.conflict = opts
+	return &UnmatchedDepositUpsertBulk{
+		create: udcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UnmatchedDeposit.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (udcb *UnmatchedDepositCreateBulk) OnConflictColumns(columns ...string) *UnmatchedDepositUpsertBulk {
+	udcb.conflict = append(udcb.conflict, sql.ConflictColumns(columns...))
+	return &UnmatchedDepositUpsertBulk{
+		create: udcb,
+	}
+}
+
+// UnmatchedDepositUpsertBulk is the builder for "upsert"-ing
+// a bulk of UnmatchedDeposit nodes.
+type UnmatchedDepositUpsertBulk struct {
+	create *UnmatchedDepositCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.UnmatchedDeposit.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(unmatcheddeposit.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UnmatchedDepositUpsertBulk) UpdateNewValues() *UnmatchedDepositUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(unmatcheddeposit.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(unmatcheddeposit.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UnmatchedDeposit.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *UnmatchedDepositUpsertBulk) Ignore() *UnmatchedDepositUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UnmatchedDepositUpsertBulk) DoNothing() *UnmatchedDepositUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UnmatchedDepositCreateBulk.OnConflict
+// documentation for more info.
+func (u *UnmatchedDepositUpsertBulk) Update(set func(*UnmatchedDepositUpsert)) *UnmatchedDepositUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UnmatchedDepositUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UnmatchedDepositUpsertBulk) SetUpdatedAt(v time.Time) *UnmatchedDepositUpsertBulk {
+	return u.Update(func(s *UnmatchedDepositUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UnmatchedDepositUpsertBulk) UpdateUpdatedAt() *UnmatchedDepositUpsertBulk {
+	return u.Update(func(s *UnmatchedDepositUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (u *UnmatchedDepositUpsertBulk) SetTxHash(v string) *UnmatchedDepositUpsertBulk {
+	return u.Update(func(s *UnmatchedDepositUpsert) {
+		s.SetTxHash(v)
+	})
+}
+
+// UpdateTxHash sets the "tx_hash" field to the value that was provided on create.
+func (u *UnmatchedDepositUpsertBulk) UpdateTxHash() *UnmatchedDepositUpsertBulk {
+	return u.Update(func(s *UnmatchedDepositUpsert) {
+		s.UpdateTxHash()
+	})
+}
+
+// SetReceiveAddress sets the "receive_address" field.
+func (u *UnmatchedDepositUpsertBulk) SetReceiveAddress(v string) *UnmatchedDepositUpsertBulk {
+	return u.Update(func(s *UnmatchedDepositUpsert) {
+		s.SetReceiveAddress(v)
+	})
+}
+
+// UpdateReceiveAddress sets the "receive_address" field to the value that was provided on create.
+func (u *UnmatchedDepositUpsertBulk) UpdateReceiveAddress() *UnmatchedDepositUpsertBulk {
+	return u.Update(func(s *UnmatchedDepositUpsert) {
+		s.UpdateReceiveAddress()
+	})
+}
+
+// SetFromAddress sets the "from_address" field.
+func (u *UnmatchedDepositUpsertBulk) SetFromAddress(v string) *UnmatchedDepositUpsertBulk {
+	return u.Update(func(s *UnmatchedDepositUpsert) {
+		s.SetFromAddress(v)
+	})
+}
+
+// UpdateFromAddress sets the "from_address" field to the value that was provided on create.
+func (u *UnmatchedDepositUpsertBulk) UpdateFromAddress() *UnmatchedDepositUpsertBulk {
+	return u.Update(func(s *UnmatchedDepositUpsert) {
+		s.UpdateFromAddress()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *UnmatchedDepositUpsertBulk) SetAmount(v decimal.Decimal) *UnmatchedDepositUpsertBulk {
+	return u.Update(func(s *UnmatchedDepositUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *UnmatchedDepositUpsertBulk) AddAmount(v decimal.Decimal) *UnmatchedDepositUpsertBulk {
+	return u.Update(func(s *UnmatchedDepositUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *UnmatchedDepositUpsertBulk) UpdateAmount() *UnmatchedDepositUpsertBulk {
+	return u.Update(func(s *UnmatchedDepositUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetBlockNumber sets the "block_number" field.
+func (u *UnmatchedDepositUpsertBulk) SetBlockNumber(v int64) *UnmatchedDepositUpsertBulk {
+	return u.Update(func(s *UnmatchedDepositUpsert) {
+		s.SetBlockNumber(v)
+	})
+}
+
+// AddBlockNumber adds v to the "block_number" field.
+func (u *UnmatchedDepositUpsertBulk) AddBlockNumber(v int64) *UnmatchedDepositUpsertBulk {
+	return u.Update(func(s *UnmatchedDepositUpsert) {
+		s.AddBlockNumber(v)
+	})
+}
+
+// UpdateBlockNumber sets the "block_number" field to the value that was provided on create.
+func (u *UnmatchedDepositUpsertBulk) UpdateBlockNumber() *UnmatchedDepositUpsertBulk {
+	return u.Update(func(s *UnmatchedDepositUpsert) {
+		s.UpdateBlockNumber()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *UnmatchedDepositUpsertBulk) SetReason(v unmatcheddeposit.Reason) *UnmatchedDepositUpsertBulk {
+	return u.Update(func(s *UnmatchedDepositUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *UnmatchedDepositUpsertBulk) UpdateReason() *UnmatchedDepositUpsertBulk {
+	return u.Update(func(s *UnmatchedDepositUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *UnmatchedDepositUpsertBulk) SetStatus(v unmatcheddeposit.Status) *UnmatchedDepositUpsertBulk {
+	return u.Update(func(s *UnmatchedDepositUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *UnmatchedDepositUpsertBulk) UpdateStatus() *UnmatchedDepositUpsertBulk {
+	return u.Update(func(s *UnmatchedDepositUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (u *UnmatchedDepositUpsertBulk) SetResolvedAt(v time.Time) *UnmatchedDepositUpsertBulk {
+	return u.Update(func(s *UnmatchedDepositUpsert) {
+		s.SetResolvedAt(v)
+	})
+}
+
+// UpdateResolvedAt sets the "resolved_at" field to the value that was provided on create.
+func (u *UnmatchedDepositUpsertBulk) UpdateResolvedAt() *UnmatchedDepositUpsertBulk {
+	return u.Update(func(s *UnmatchedDepositUpsert) {
+		s.UpdateResolvedAt()
+	})
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (u *UnmatchedDepositUpsertBulk) ClearResolvedAt() *UnmatchedDepositUpsertBulk {
+	return u.Update(func(s *UnmatchedDepositUpsert) {
+		s.ClearResolvedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *UnmatchedDepositUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the UnmatchedDepositCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UnmatchedDepositCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UnmatchedDepositUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
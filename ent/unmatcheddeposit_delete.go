@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/NEDA-LABS/stablenode/ent/unmatcheddeposit"
+)
+
+// UnmatchedDepositDelete is the builder for deleting a UnmatchedDeposit entity.
+type UnmatchedDepositDelete struct {
+	config
+	hooks    []Hook
+	mutation *UnmatchedDepositMutation
+}
+
+// Where appends a list predicates to the UnmatchedDepositDelete builder.
+func (udd *UnmatchedDepositDelete) Where(ps ...predicate.UnmatchedDeposit) *UnmatchedDepositDelete {
+	udd.mutation.Where(ps...)
+	return udd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (udd *UnmatchedDepositDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, udd.sqlExec, udd.mutation, udd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (udd *UnmatchedDepositDelete) ExecX(ctx context.Context) int {
+	n, err := udd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (udd *UnmatchedDepositDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(unmatcheddeposit.Table, sqlgraph.NewFieldSpec(unmatcheddeposit.FieldID, field.TypeUUID))
+	if ps := udd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, udd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	udd.mutation.done = true
+	return affected, err
+}
+
+// UnmatchedDepositDeleteOne is the builder for deleting a single UnmatchedDeposit entity.
+type UnmatchedDepositDeleteOne struct {
+	udd *UnmatchedDepositDelete
+}
+
+// Where appends a list predicates to the UnmatchedDepositDelete builder.
+func (uddo *UnmatchedDepositDeleteOne) Where(ps ...predicate.UnmatchedDeposit) *UnmatchedDepositDeleteOne {
+	uddo.udd.mutation.Where(ps...)
+	return uddo
+}
+
+// Exec executes the deletion query.
+func (uddo *UnmatchedDepositDeleteOne) Exec(ctx context.Context) error {
+	n, err := uddo.udd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{unmatcheddeposit.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uddo *UnmatchedDepositDeleteOne) ExecX(ctx context.Context) {
+	if err := uddo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,765 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/NEDA-LABS/stablenode/ent/sweep"
+	"github.com/NEDA-LABS/stablenode/ent/token"
+	"github.com/NEDA-LABS/stablenode/ent/unmatcheddeposit"
+	"github.com/google/uuid"
+)
+
+// UnmatchedDepositQuery is the builder for querying UnmatchedDeposit entities.
+type UnmatchedDepositQuery struct {
+	config
+	ctx              *QueryContext
+	order            []unmatcheddeposit.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.UnmatchedDeposit
+	withToken        *TokenQuery
+	withPaymentOrder *PaymentOrderQuery
+	withSweep        *SweepQuery
+	withFKs          bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the UnmatchedDepositQuery builder.
+func (udq *UnmatchedDepositQuery) Where(ps ...predicate.UnmatchedDeposit) *UnmatchedDepositQuery {
+	udq.predicates = append(udq.predicates, ps...)
+	return udq
+}
+
+// Limit the number of records to be returned by this query.
+func (udq *UnmatchedDepositQuery) Limit(limit int) *UnmatchedDepositQuery {
+	udq.ctx.Limit = &limit
+	return udq
+}
+
+// Offset to start from.
+func (udq *UnmatchedDepositQuery) Offset(offset int) *UnmatchedDepositQuery {
+	udq.ctx.Offset = &offset
+	return udq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (udq *UnmatchedDepositQuery) Unique(unique bool) *UnmatchedDepositQuery {
+	udq.ctx.Unique = &unique
+	return udq
+}
+
+// Order specifies how the records should be ordered.
+func (udq *UnmatchedDepositQuery) Order(o ...unmatcheddeposit.OrderOption) *UnmatchedDepositQuery {
+	udq.order = append(udq.order, o...)
+	return udq
+}
+
+// QueryToken chains the current query on the "token" edge.
+func (udq *UnmatchedDepositQuery) QueryToken() *TokenQuery {
+	query := (&TokenClient{config: udq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := udq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := udq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(unmatcheddeposit.Table, unmatcheddeposit.FieldID, selector),
+			sqlgraph.To(token.Table, token.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, unmatcheddeposit.TokenTable, unmatcheddeposit.TokenColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(udq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPaymentOrder chains the current query on the "payment_order" edge.
+func (udq *UnmatchedDepositQuery) QueryPaymentOrder() *PaymentOrderQuery {
+	query := (&PaymentOrderClient{config: udq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := udq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := udq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(unmatcheddeposit.Table, unmatcheddeposit.FieldID, selector),
+			sqlgraph.To(paymentorder.Table, paymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, unmatcheddeposit.PaymentOrderTable, unmatcheddeposit.PaymentOrderColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(udq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySweep chains the current query on the "sweep" edge.
+func (udq *UnmatchedDepositQuery) QuerySweep() *SweepQuery {
+	query := (&SweepClient{config: udq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := udq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := udq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(unmatcheddeposit.Table, unmatcheddeposit.FieldID, selector),
+			sqlgraph.To(sweep.Table, sweep.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, unmatcheddeposit.SweepTable, unmatcheddeposit.SweepColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(udq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first UnmatchedDeposit entity from the query.
+// Returns a *NotFoundError when no UnmatchedDeposit was found.
+func (udq *UnmatchedDepositQuery) First(ctx context.Context) (*UnmatchedDeposit, error) {
+	nodes, err := udq.Limit(1).All(setContextOp(ctx, udq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{unmatcheddeposit.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (udq *UnmatchedDepositQuery) FirstX(ctx context.Context) *UnmatchedDeposit {
+	node, err := udq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first UnmatchedDeposit ID from the query.
+// Returns a *NotFoundError when no UnmatchedDeposit ID was found.
+func (udq *UnmatchedDepositQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = udq.Limit(1).IDs(setContextOp(ctx, udq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{unmatcheddeposit.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (udq *UnmatchedDepositQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := udq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single UnmatchedDeposit entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one UnmatchedDeposit entity is found.
+// Returns a *NotFoundError when no UnmatchedDeposit entities are found.
+func (udq *UnmatchedDepositQuery) Only(ctx context.Context) (*UnmatchedDeposit, error) {
+	nodes, err := udq.Limit(2).All(setContextOp(ctx, udq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{unmatcheddeposit.Label}
+	default:
+		return nil, &NotSingularError{unmatcheddeposit.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (udq *UnmatchedDepositQuery) OnlyX(ctx context.Context) *UnmatchedDeposit {
+	node, err := udq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only UnmatchedDeposit ID in the query.
+// Returns a *NotSingularError when more than one UnmatchedDeposit ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (udq *UnmatchedDepositQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = udq.Limit(2).IDs(setContextOp(ctx, udq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{unmatcheddeposit.Label}
+	default:
+		err = &NotSingularError{unmatcheddeposit.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (udq *UnmatchedDepositQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := udq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of UnmatchedDeposits.
+func (udq *UnmatchedDepositQuery) All(ctx context.Context) ([]*UnmatchedDeposit, error) {
+	ctx = setContextOp(ctx, udq.ctx, ent.OpQueryAll)
+	if err := udq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*UnmatchedDeposit, *UnmatchedDepositQuery]()
+	return withInterceptors[[]*UnmatchedDeposit](ctx, udq, qr, udq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (udq *UnmatchedDepositQuery) AllX(ctx context.Context) []*UnmatchedDeposit {
+	nodes, err := udq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of UnmatchedDeposit IDs.
+func (udq *UnmatchedDepositQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if udq.ctx.Unique == nil && udq.path != nil {
+		udq.Unique(true)
+	}
+	ctx = setContextOp(ctx, udq.ctx, ent.OpQueryIDs)
+	if err = udq.Select(unmatcheddeposit.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (udq *UnmatchedDepositQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := udq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (udq *UnmatchedDepositQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, udq.ctx, ent.OpQueryCount)
+	if err := udq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, udq, querierCount[*UnmatchedDepositQuery](), udq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (udq *UnmatchedDepositQuery) CountX(ctx context.Context) int {
+	count, err := udq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (udq *UnmatchedDepositQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, udq.ctx, ent.OpQueryExist)
+	switch _, err := udq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (udq *UnmatchedDepositQuery) ExistX(ctx context.Context) bool {
+	exist, err := udq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the UnmatchedDepositQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (udq *UnmatchedDepositQuery) Clone() *UnmatchedDepositQuery {
+	if udq == nil {
+		return nil
+	}
+	return &UnmatchedDepositQuery{
+		config:           udq.config,
+		ctx:              udq.ctx.Clone(),
+		order:            append([]unmatcheddeposit.OrderOption{}, udq.order...),
+		inters:           append([]Interceptor{}, udq.inters...),
+		predicates:       append([]predicate.UnmatchedDeposit{}, udq.predicates...),
+		withToken:        udq.withToken.Clone(),
+		withPaymentOrder: udq.withPaymentOrder.Clone(),
+		withSweep:        udq.withSweep.Clone(),
+		// clone intermediate query.
+		sql:  udq.sql.Clone(),
+		path: udq.path,
+	}
+}
+
+// WithToken tells the query-builder to eager-load the nodes that are connected to
+// the "token" edge. The optional arguments are used to configure the query builder of the edge.
+func (udq *UnmatchedDepositQuery) WithToken(opts ...func(*TokenQuery)) *UnmatchedDepositQuery {
+	query := (&TokenClient{config: udq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	udq.withToken = query
+	return udq
+}
+
+// WithPaymentOrder tells the query-builder to eager-load the nodes that are connected to
+// the "payment_order" edge. The optional arguments are used to configure the query builder of the edge.
+func (udq *UnmatchedDepositQuery) WithPaymentOrder(opts ...func(*PaymentOrderQuery)) *UnmatchedDepositQuery {
+	query := (&PaymentOrderClient{config: udq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	udq.withPaymentOrder = query
+	return udq
+}
+
+// WithSweep tells the query-builder to eager-load the nodes that are connected to
+// the "sweep" edge. The optional arguments are used to configure the query builder of the edge.
+func (udq *UnmatchedDepositQuery) WithSweep(opts ...func(*SweepQuery)) *UnmatchedDepositQuery {
+	query := (&SweepClient{config: udq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	udq.withSweep = query
+	return udq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.UnmatchedDeposit.Query().
+//		GroupBy(unmatcheddeposit.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (udq *UnmatchedDepositQuery) GroupBy(field string, fields ...string) *UnmatchedDepositGroupBy {
+	udq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &UnmatchedDepositGroupBy{build: udq}
+	grbuild.flds = &udq.ctx.Fields
+	grbuild.label = unmatcheddeposit.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.UnmatchedDeposit.Query().
+//		Select(unmatcheddeposit.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (udq *UnmatchedDepositQuery) Select(fields ...string) *UnmatchedDepositSelect {
+	udq.ctx.Fields = append(udq.ctx.Fields, fields...)
+	sbuild := &UnmatchedDepositSelect{UnmatchedDepositQuery: udq}
+	sbuild.label = unmatcheddeposit.Label
+	sbuild.flds, sbuild.scan = &udq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a UnmatchedDepositSelect configured with the given aggregations.
+func (udq *UnmatchedDepositQuery) Aggregate(fns ...AggregateFunc) *UnmatchedDepositSelect {
+	return udq.Select().Aggregate(fns...)
+}
+
+func (udq *UnmatchedDepositQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range udq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, udq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range udq.ctx.Fields {
+		if !unmatcheddeposit.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if udq.path != nil {
+		prev, err := udq.path(ctx)
+		if err != nil {
+			return err
+		}
+		udq.sql = prev
+	}
+	return nil
+}
+
+func (udq *UnmatchedDepositQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*UnmatchedDeposit, error) {
+	var (
+		nodes       = []*UnmatchedDeposit{}
+		withFKs     = udq.withFKs
+		_spec       = udq.querySpec()
+		loadedTypes = [3]bool{
+			udq.withToken != nil,
+			udq.withPaymentOrder != nil,
+			udq.withSweep != nil,
+		}
+	)
+	if udq.withToken != nil || udq.withPaymentOrder != nil || udq.withSweep != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, unmatcheddeposit.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*UnmatchedDeposit).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &UnmatchedDeposit{config: udq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, udq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := udq.withToken; query != nil {
+		if err := udq.loadToken(ctx, query, nodes, nil,
+			func(n *UnmatchedDeposit, e *Token) { n.Edges.Token = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := udq.withPaymentOrder; query != nil {
+		if err := udq.loadPaymentOrder(ctx, query, nodes, nil,
+			func(n *UnmatchedDeposit, e *PaymentOrder) { n.Edges.PaymentOrder = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := udq.withSweep; query != nil {
+		if err := udq.loadSweep(ctx, query, nodes, nil,
+			func(n *UnmatchedDeposit, e *Sweep) { n.Edges.Sweep = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (udq *UnmatchedDepositQuery) loadToken(ctx context.Context, query *TokenQuery, nodes []*UnmatchedDeposit, init func(*UnmatchedDeposit), assign func(*UnmatchedDeposit, *Token)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*UnmatchedDeposit)
+	for i := range nodes {
+		if nodes[i].token_unmatched_deposits == nil {
+			continue
+		}
+		fk := *nodes[i].token_unmatched_deposits
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(token.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "token_unmatched_deposits" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (udq *UnmatchedDepositQuery) loadPaymentOrder(ctx context.Context, query *PaymentOrderQuery, nodes []*UnmatchedDeposit, init func(*UnmatchedDeposit), assign func(*UnmatchedDeposit, *PaymentOrder)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*UnmatchedDeposit)
+	for i := range nodes {
+		if nodes[i].payment_order_unmatched_deposits == nil {
+			continue
+		}
+		fk := *nodes[i].payment_order_unmatched_deposits
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(paymentorder.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "payment_order_unmatched_deposits" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (udq *UnmatchedDepositQuery) loadSweep(ctx context.Context, query *SweepQuery, nodes []*UnmatchedDeposit, init func(*UnmatchedDeposit), assign func(*UnmatchedDeposit, *Sweep)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*UnmatchedDeposit)
+	for i := range nodes {
+		if nodes[i].sweep_unmatched_deposit == nil {
+			continue
+		}
+		fk := *nodes[i].sweep_unmatched_deposit
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(sweep.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "sweep_unmatched_deposit" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (udq *UnmatchedDepositQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := udq.querySpec()
+	_spec.Node.Columns = udq.ctx.Fields
+	if len(udq.ctx.Fields) > 0 {
+		_spec.Unique = udq.ctx.Unique != nil && *udq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, udq.driver, _spec)
+}
+
+func (udq *UnmatchedDepositQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(unmatcheddeposit.Table, unmatcheddeposit.Columns, sqlgraph.NewFieldSpec(unmatcheddeposit.FieldID, field.TypeUUID))
+	_spec.From = udq.sql
+	if unique := udq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if udq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := udq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, unmatcheddeposit.FieldID)
+		for i := range fields {
+			if fields[i] != unmatcheddeposit.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := udq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := udq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := udq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := udq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (udq *UnmatchedDepositQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(udq.driver.Dialect())
+	t1 := builder.Table(unmatcheddeposit.Table)
+	columns := udq.ctx.Fields
+	if len(columns) == 0 {
+		columns = unmatcheddeposit.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if udq.sql != nil {
+		selector = udq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if udq.ctx.Unique != nil && *udq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range udq.predicates {
+		p(selector)
+	}
+	for _, p := range udq.order {
+		p(selector)
+	}
+	if offset := udq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := udq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// UnmatchedDepositGroupBy is the group-by builder for UnmatchedDeposit entities.
+type UnmatchedDepositGroupBy struct {
+	selector
+	build *UnmatchedDepositQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (udgb *UnmatchedDepositGroupBy) Aggregate(fns ...AggregateFunc) *UnmatchedDepositGroupBy {
+	udgb.fns = append(udgb.fns, fns...)
+	return udgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (udgb *UnmatchedDepositGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, udgb.build.ctx, ent.OpQueryGroupBy)
+	if err := udgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*UnmatchedDepositQuery, *UnmatchedDepositGroupBy](ctx, udgb.build, udgb, udgb.build.inters, v)
+}
+
+func (udgb *UnmatchedDepositGroupBy) sqlScan(ctx context.Context, root *UnmatchedDepositQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(udgb.fns))
+	for _, fn := range udgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*udgb.flds)+len(udgb.fns))
+		for _, f := range *udgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*udgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := udgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// UnmatchedDepositSelect is the builder for selecting fields of UnmatchedDeposit entities.
+type UnmatchedDepositSelect struct {
+	*UnmatchedDepositQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (uds *UnmatchedDepositSelect) Aggregate(fns ...AggregateFunc) *UnmatchedDepositSelect {
+	uds.fns = append(uds.fns, fns...)
+	return uds
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (uds *UnmatchedDepositSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, uds.ctx, ent.OpQuerySelect)
+	if err := uds.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*UnmatchedDepositQuery, *UnmatchedDepositSelect](ctx, uds.UnmatchedDepositQuery, uds, uds.inters, v)
+}
+
+func (uds *UnmatchedDepositSelect) sqlScan(ctx context.Context, root *UnmatchedDepositQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(uds.fns))
+	for _, fn := range uds.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*uds.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := uds.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
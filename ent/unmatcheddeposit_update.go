@@ -0,0 +1,907 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/NEDA-LABS/stablenode/ent/sweep"
+	"github.com/NEDA-LABS/stablenode/ent/token"
+	"github.com/NEDA-LABS/stablenode/ent/unmatcheddeposit"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// UnmatchedDepositUpdate is the builder for updating UnmatchedDeposit entities.
+type UnmatchedDepositUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UnmatchedDepositMutation
+}
+
+// Where appends a list predicates to the UnmatchedDepositUpdate builder.
+func (udu *UnmatchedDepositUpdate) Where(ps ...predicate.UnmatchedDeposit) *UnmatchedDepositUpdate {
+	udu.mutation.Where(ps...)
+	return udu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (udu *UnmatchedDepositUpdate) SetUpdatedAt(t time.Time) *UnmatchedDepositUpdate {
+	udu.mutation.SetUpdatedAt(t)
+	return udu
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (udu *UnmatchedDepositUpdate) SetTxHash(s string) *UnmatchedDepositUpdate {
+	udu.mutation.SetTxHash(s)
+	return udu
+}
+
+// SetNillableTxHash sets the "tx_hash" field if the given value is not nil.
+func (udu *UnmatchedDepositUpdate) SetNillableTxHash(s *string) *UnmatchedDepositUpdate {
+	if s != nil {
+		udu.SetTxHash(*s)
+	}
+	return udu
+}
+
+// SetReceiveAddress sets the "receive_address" field.
+func (udu *UnmatchedDepositUpdate) SetReceiveAddress(s string) *UnmatchedDepositUpdate {
+	udu.mutation.SetReceiveAddress(s)
+	return udu
+}
+
+// SetNillableReceiveAddress sets the "receive_address" field if the given value is not nil.
+func (udu *UnmatchedDepositUpdate) SetNillableReceiveAddress(s *string) *UnmatchedDepositUpdate {
+	if s != nil {
+		udu.SetReceiveAddress(*s)
+	}
+	return udu
+}
+
+// SetFromAddress sets the "from_address" field.
+func (udu *UnmatchedDepositUpdate) SetFromAddress(s string) *UnmatchedDepositUpdate {
+	udu.mutation.SetFromAddress(s)
+	return udu
+}
+
+// SetNillableFromAddress sets the "from_address" field if the given value is not nil.
+func (udu *UnmatchedDepositUpdate) SetNillableFromAddress(s *string) *UnmatchedDepositUpdate {
+	if s != nil {
+		udu.SetFromAddress(*s)
+	}
+	return udu
+}
+
+// SetAmount sets the "amount" field.
+func (udu *UnmatchedDepositUpdate) SetAmount(d decimal.Decimal) *UnmatchedDepositUpdate {
+	udu.mutation.ResetAmount()
+	udu.mutation.SetAmount(d)
+	return udu
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (udu *UnmatchedDepositUpdate) SetNillableAmount(d *decimal.Decimal) *UnmatchedDepositUpdate {
+	if d != nil {
+		udu.SetAmount(*d)
+	}
+	return udu
+}
+
+// AddAmount adds d to the "amount" field.
+func (udu *UnmatchedDepositUpdate) AddAmount(d decimal.Decimal) *UnmatchedDepositUpdate {
+	udu.mutation.AddAmount(d)
+	return udu
+}
+
+// SetBlockNumber sets the "block_number" field.
+func (udu *UnmatchedDepositUpdate) SetBlockNumber(i int64) *UnmatchedDepositUpdate {
+	udu.mutation.ResetBlockNumber()
+	udu.mutation.SetBlockNumber(i)
+	return udu
+}
+
+// SetNillableBlockNumber sets the "block_number" field if the given value is not nil.
+func (udu *UnmatchedDepositUpdate) SetNillableBlockNumber(i *int64) *UnmatchedDepositUpdate {
+	if i != nil {
+		udu.SetBlockNumber(*i)
+	}
+	return udu
+}
+
+// AddBlockNumber adds i to the "block_number" field.
+func (udu *UnmatchedDepositUpdate) AddBlockNumber(i int64) *UnmatchedDepositUpdate {
+	udu.mutation.AddBlockNumber(i)
+	return udu
+}
+
+// SetReason sets the "reason" field.
+func (udu *UnmatchedDepositUpdate) SetReason(u unmatcheddeposit.Reason) *UnmatchedDepositUpdate {
+	udu.mutation.SetReason(u)
+	return udu
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (udu *UnmatchedDepositUpdate) SetNillableReason(u *unmatcheddeposit.Reason) *UnmatchedDepositUpdate {
+	if u != nil {
+		udu.SetReason(*u)
+	}
+	return udu
+}
+
+// SetStatus sets the "status" field.
+func (udu *UnmatchedDepositUpdate) SetStatus(u unmatcheddeposit.Status) *UnmatchedDepositUpdate {
+	udu.mutation.SetStatus(u)
+	return udu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (udu *UnmatchedDepositUpdate) SetNillableStatus(u *unmatcheddeposit.Status) *UnmatchedDepositUpdate {
+	if u != nil {
+		udu.SetStatus(*u)
+	}
+	return udu
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (udu *UnmatchedDepositUpdate) SetResolvedAt(t time.Time) *UnmatchedDepositUpdate {
+	udu.mutation.SetResolvedAt(t)
+	return udu
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (udu *UnmatchedDepositUpdate) SetNillableResolvedAt(t *time.Time) *UnmatchedDepositUpdate {
+	if t != nil {
+		udu.SetResolvedAt(*t)
+	}
+	return udu
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (udu *UnmatchedDepositUpdate) ClearResolvedAt() *UnmatchedDepositUpdate {
+	udu.mutation.ClearResolvedAt()
+	return udu
+}
+
+// SetTokenID sets the "token" edge to the Token entity by ID.
+func (udu *UnmatchedDepositUpdate) SetTokenID(id int) *UnmatchedDepositUpdate {
+	udu.mutation.SetTokenID(id)
+	return udu
+}
+
+// SetToken sets the "token" edge to the Token entity.
+func (udu *UnmatchedDepositUpdate) SetToken(t *Token) *UnmatchedDepositUpdate {
+	return udu.SetTokenID(t.ID)
+}
+
+// SetPaymentOrderID sets the "payment_order" edge to the PaymentOrder entity by ID.
+func (udu *UnmatchedDepositUpdate) SetPaymentOrderID(id uuid.UUID) *UnmatchedDepositUpdate {
+	udu.mutation.SetPaymentOrderID(id)
+	return udu
+}
+
+// SetNillablePaymentOrderID sets the "payment_order" edge to the PaymentOrder entity by ID if the given value is not nil.
+func (udu *UnmatchedDepositUpdate) SetNillablePaymentOrderID(id *uuid.UUID) *UnmatchedDepositUpdate {
+	if id != nil {
+		udu = udu.SetPaymentOrderID(*id)
+	}
+	return udu
+}
+
+// SetPaymentOrder sets the "payment_order" edge to the PaymentOrder entity.
+func (udu *UnmatchedDepositUpdate) SetPaymentOrder(p *PaymentOrder) *UnmatchedDepositUpdate {
+	return udu.SetPaymentOrderID(p.ID)
+}
+
+// SetSweepID sets the "sweep" edge to the Sweep entity by ID.
+func (udu *UnmatchedDepositUpdate) SetSweepID(id uuid.UUID) *UnmatchedDepositUpdate {
+	udu.mutation.SetSweepID(id)
+	return udu
+}
+
+// SetNillableSweepID sets the "sweep" edge to the Sweep entity by ID if the given value is not nil.
+func (udu *UnmatchedDepositUpdate) SetNillableSweepID(id *uuid.UUID) *UnmatchedDepositUpdate {
+	if id != nil {
+		udu = udu.SetSweepID(*id)
+	}
+	return udu
+}
+
+// SetSweep sets the "sweep" edge to the Sweep entity.
+func (udu *UnmatchedDepositUpdate) SetSweep(s *Sweep) *UnmatchedDepositUpdate {
+	return udu.SetSweepID(s.ID)
+}
+
+// Mutation returns the UnmatchedDepositMutation object of the builder.
+func (udu *UnmatchedDepositUpdate) Mutation() *UnmatchedDepositMutation {
+	return udu.mutation
+}
+
+// ClearToken clears the "token" edge to the Token entity.
+func (udu *UnmatchedDepositUpdate) ClearToken() *UnmatchedDepositUpdate {
+	udu.mutation.ClearToken()
+	return udu
+}
+
+// ClearPaymentOrder clears the "payment_order" edge to the PaymentOrder entity.
+func (udu *UnmatchedDepositUpdate) ClearPaymentOrder() *UnmatchedDepositUpdate {
+	udu.mutation.ClearPaymentOrder()
+	return udu
+}
+
+// ClearSweep clears the "sweep" edge to the Sweep entity.
+func (udu *UnmatchedDepositUpdate) ClearSweep() *UnmatchedDepositUpdate {
+	udu.mutation.ClearSweep()
+	return udu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (udu *UnmatchedDepositUpdate) Save(ctx context.Context) (int, error) {
+	udu.defaults()
+	return withHooks(ctx, udu.sqlSave, udu.mutation, udu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (udu *UnmatchedDepositUpdate) SaveX(ctx context.Context) int {
+	affected, err := udu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (udu *UnmatchedDepositUpdate) Exec(ctx context.Context) error {
+	_, err := udu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (udu *UnmatchedDepositUpdate) ExecX(ctx context.Context) {
+	if err := udu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (udu *UnmatchedDepositUpdate) defaults() {
+	if _, ok := udu.mutation.UpdatedAt(); !ok {
+		v := unmatcheddeposit.UpdateDefaultUpdatedAt()
+		udu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (udu *UnmatchedDepositUpdate) check() error {
+	if v, ok := udu.mutation.TxHash(); ok {
+		if err := unmatcheddeposit.TxHashValidator(v); err != nil {
+			return &ValidationError{Name: "tx_hash", err: fmt.Errorf(`ent: validator failed for field "UnmatchedDeposit.tx_hash": %w`, err)}
+		}
+	}
+	if v, ok := udu.mutation.Reason(); ok {
+		if err := unmatcheddeposit.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "UnmatchedDeposit.reason": %w`, err)}
+		}
+	}
+	if v, ok := udu.mutation.Status(); ok {
+		if err := unmatcheddeposit.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "UnmatchedDeposit.status": %w`, err)}
+		}
+	}
+	if udu.mutation.TokenCleared() && len(udu.mutation.TokenIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UnmatchedDeposit.token"`)
+	}
+	return nil
+}
+
+func (udu *UnmatchedDepositUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := udu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(unmatcheddeposit.Table, unmatcheddeposit.Columns, sqlgraph.NewFieldSpec(unmatcheddeposit.FieldID, field.TypeUUID))
+	if ps := udu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := udu.mutation.UpdatedAt(); ok {
+		_spec.SetField(unmatcheddeposit.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := udu.mutation.TxHash(); ok {
+		_spec.SetField(unmatcheddeposit.FieldTxHash, field.TypeString, value)
+	}
+	if value, ok := udu.mutation.ReceiveAddress(); ok {
+		_spec.SetField(unmatcheddeposit.FieldReceiveAddress, field.TypeString, value)
+	}
+	if value, ok := udu.mutation.FromAddress(); ok {
+		_spec.SetField(unmatcheddeposit.FieldFromAddress, field.TypeString, value)
+	}
+	if value, ok := udu.mutation.Amount(); ok {
+		_spec.SetField(unmatcheddeposit.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := udu.mutation.AddedAmount(); ok {
+		_spec.AddField(unmatcheddeposit.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := udu.mutation.BlockNumber(); ok {
+		_spec.SetField(unmatcheddeposit.FieldBlockNumber, field.TypeInt64, value)
+	}
+	if value, ok := udu.mutation.AddedBlockNumber(); ok {
+		_spec.AddField(unmatcheddeposit.FieldBlockNumber, field.TypeInt64, value)
+	}
+	if value, ok := udu.mutation.Reason(); ok {
+		_spec.SetField(unmatcheddeposit.FieldReason, field.TypeEnum, value)
+	}
+	if value, ok := udu.mutation.Status(); ok {
+		_spec.SetField(unmatcheddeposit.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := udu.mutation.ResolvedAt(); ok {
+		_spec.SetField(unmatcheddeposit.FieldResolvedAt, field.TypeTime, value)
+	}
+	if udu.mutation.ResolvedAtCleared() {
+		_spec.ClearField(unmatcheddeposit.FieldResolvedAt, field.TypeTime)
+	}
+	if udu.mutation.TokenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   unmatcheddeposit.TokenTable,
+			Columns: []string{unmatcheddeposit.TokenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(token.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := udu.mutation.TokenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   unmatcheddeposit.TokenTable,
+			Columns: []string{unmatcheddeposit.TokenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(token.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if udu.mutation.PaymentOrderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   unmatcheddeposit.PaymentOrderTable,
+			Columns: []string{unmatcheddeposit.PaymentOrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := udu.mutation.PaymentOrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   unmatcheddeposit.PaymentOrderTable,
+			Columns: []string{unmatcheddeposit.PaymentOrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if udu.mutation.SweepCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   unmatcheddeposit.SweepTable,
+			Columns: []string{unmatcheddeposit.SweepColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sweep.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := udu.mutation.SweepIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   unmatcheddeposit.SweepTable,
+			Columns: []string{unmatcheddeposit.SweepColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sweep.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, udu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{unmatcheddeposit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	udu.mutation.done = true
+	return n, nil
+}
+
+// UnmatchedDepositUpdateOne is the builder for updating a single UnmatchedDeposit entity.
+type UnmatchedDepositUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UnmatchedDepositMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (uduo *UnmatchedDepositUpdateOne) SetUpdatedAt(t time.Time) *UnmatchedDepositUpdateOne {
+	uduo.mutation.SetUpdatedAt(t)
+	return uduo
+}
+
+// SetTxHash sets the "tx_hash" field.
+func (uduo *UnmatchedDepositUpdateOne) SetTxHash(s string) *UnmatchedDepositUpdateOne {
+	uduo.mutation.SetTxHash(s)
+	return uduo
+}
+
+// SetNillableTxHash sets the "tx_hash" field if the given value is not nil.
+func (uduo *UnmatchedDepositUpdateOne) SetNillableTxHash(s *string) *UnmatchedDepositUpdateOne {
+	if s != nil {
+		uduo.SetTxHash(*s)
+	}
+	return uduo
+}
+
+// SetReceiveAddress sets the "receive_address" field.
+func (uduo *UnmatchedDepositUpdateOne) SetReceiveAddress(s string) *UnmatchedDepositUpdateOne {
+	uduo.mutation.SetReceiveAddress(s)
+	return uduo
+}
+
+// SetNillableReceiveAddress sets the "receive_address" field if the given value is not nil.
+func (uduo *UnmatchedDepositUpdateOne) SetNillableReceiveAddress(s *string) *UnmatchedDepositUpdateOne {
+	if s != nil {
+		uduo.SetReceiveAddress(*s)
+	}
+	return uduo
+}
+
+// SetFromAddress sets the "from_address" field.
+func (uduo *UnmatchedDepositUpdateOne) SetFromAddress(s string) *UnmatchedDepositUpdateOne {
+	uduo.mutation.SetFromAddress(s)
+	return uduo
+}
+
+// SetNillableFromAddress sets the "from_address" field if the given value is not nil.
+func (uduo *UnmatchedDepositUpdateOne) SetNillableFromAddress(s *string) *UnmatchedDepositUpdateOne {
+	if s != nil {
+		uduo.SetFromAddress(*s)
+	}
+	return uduo
+}
+
+// SetAmount sets the "amount" field.
+func (uduo *UnmatchedDepositUpdateOne) SetAmount(d decimal.Decimal) *UnmatchedDepositUpdateOne {
+	uduo.mutation.ResetAmount()
+	uduo.mutation.SetAmount(d)
+	return uduo
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (uduo *UnmatchedDepositUpdateOne) SetNillableAmount(d *decimal.Decimal) *UnmatchedDepositUpdateOne {
+	if d != nil {
+		uduo.SetAmount(*d)
+	}
+	return uduo
+}
+
+// AddAmount adds d to the "amount" field.
+func (uduo *UnmatchedDepositUpdateOne) AddAmount(d decimal.Decimal) *UnmatchedDepositUpdateOne {
+	uduo.mutation.AddAmount(d)
+	return uduo
+}
+
+// SetBlockNumber sets the "block_number" field.
+func (uduo *UnmatchedDepositUpdateOne) SetBlockNumber(i int64) *UnmatchedDepositUpdateOne {
+	uduo.mutation.ResetBlockNumber()
+	uduo.mutation.SetBlockNumber(i)
+	return uduo
+}
+
+// SetNillableBlockNumber sets the "block_number" field if the given value is not nil.
+func (uduo *UnmatchedDepositUpdateOne) SetNillableBlockNumber(i *int64) *UnmatchedDepositUpdateOne {
+	if i != nil {
+		uduo.SetBlockNumber(*i)
+	}
+	return uduo
+}
+
+// AddBlockNumber adds i to the "block_number" field.
+func (uduo *UnmatchedDepositUpdateOne) AddBlockNumber(i int64) *UnmatchedDepositUpdateOne {
+	uduo.mutation.AddBlockNumber(i)
+	return uduo
+}
+
+// SetReason sets the "reason" field.
+func (uduo *UnmatchedDepositUpdateOne) SetReason(u unmatcheddeposit.Reason) *UnmatchedDepositUpdateOne {
+	uduo.mutation.SetReason(u)
+	return uduo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (uduo *UnmatchedDepositUpdateOne) SetNillableReason(u *unmatcheddeposit.Reason) *UnmatchedDepositUpdateOne {
+	if u != nil {
+		uduo.SetReason(*u)
+	}
+	return uduo
+}
+
+// SetStatus sets the "status" field.
+func (uduo *UnmatchedDepositUpdateOne) SetStatus(u unmatcheddeposit.Status) *UnmatchedDepositUpdateOne {
+	uduo.mutation.SetStatus(u)
+	return uduo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (uduo *UnmatchedDepositUpdateOne) SetNillableStatus(u *unmatcheddeposit.Status) *UnmatchedDepositUpdateOne {
+	if u != nil {
+		uduo.SetStatus(*u)
+	}
+	return uduo
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (uduo *UnmatchedDepositUpdateOne) SetResolvedAt(t time.Time) *UnmatchedDepositUpdateOne {
+	uduo.mutation.SetResolvedAt(t)
+	return uduo
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (uduo *UnmatchedDepositUpdateOne) SetNillableResolvedAt(t *time.Time) *UnmatchedDepositUpdateOne {
+	if t != nil {
+		uduo.SetResolvedAt(*t)
+	}
+	return uduo
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (uduo *UnmatchedDepositUpdateOne) ClearResolvedAt() *UnmatchedDepositUpdateOne {
+	uduo.mutation.ClearResolvedAt()
+	return uduo
+}
+
+// SetTokenID sets the "token" edge to the Token entity by ID.
+func (uduo *UnmatchedDepositUpdateOne) SetTokenID(id int) *UnmatchedDepositUpdateOne {
+	uduo.mutation.SetTokenID(id)
+	return uduo
+}
+
+// SetToken sets the "token" edge to the Token entity.
+func (uduo *UnmatchedDepositUpdateOne) SetToken(t *Token) *UnmatchedDepositUpdateOne {
+	return uduo.SetTokenID(t.ID)
+}
+
+// SetPaymentOrderID sets the "payment_order" edge to the PaymentOrder entity by ID.
+func (uduo *UnmatchedDepositUpdateOne) SetPaymentOrderID(id uuid.UUID) *UnmatchedDepositUpdateOne {
+	uduo.mutation.SetPaymentOrderID(id)
+	return uduo
+}
+
+// SetNillablePaymentOrderID sets the "payment_order" edge to the PaymentOrder entity by ID if the given value is not nil.
+func (uduo *UnmatchedDepositUpdateOne) SetNillablePaymentOrderID(id *uuid.UUID) *UnmatchedDepositUpdateOne {
+	if id != nil {
+		uduo = uduo.SetPaymentOrderID(*id)
+	}
+	return uduo
+}
+
+// SetPaymentOrder sets the "payment_order" edge to the PaymentOrder entity.
+func (uduo *UnmatchedDepositUpdateOne) SetPaymentOrder(p *PaymentOrder) *UnmatchedDepositUpdateOne {
+	return uduo.SetPaymentOrderID(p.ID)
+}
+
+// SetSweepID sets the "sweep" edge to the Sweep entity by ID.
+func (uduo *UnmatchedDepositUpdateOne) SetSweepID(id uuid.UUID) *UnmatchedDepositUpdateOne {
+	uduo.mutation.SetSweepID(id)
+	return uduo
+}
+
+// SetNillableSweepID sets the "sweep" edge to the Sweep entity by ID if the given value is not nil.
+func (uduo *UnmatchedDepositUpdateOne) SetNillableSweepID(id *uuid.UUID) *UnmatchedDepositUpdateOne {
+	if id != nil {
+		uduo = uduo.SetSweepID(*id)
+	}
+	return uduo
+}
+
+// SetSweep sets the "sweep" edge to the Sweep entity.
+func (uduo *UnmatchedDepositUpdateOne) SetSweep(s *Sweep) *UnmatchedDepositUpdateOne {
+	return uduo.SetSweepID(s.ID)
+}
+
+// Mutation returns the UnmatchedDepositMutation object of the builder.
+func (uduo *UnmatchedDepositUpdateOne) Mutation() *UnmatchedDepositMutation {
+	return uduo.mutation
+}
+
+// ClearToken clears the "token" edge to the Token entity.
+func (uduo *UnmatchedDepositUpdateOne) ClearToken() *UnmatchedDepositUpdateOne {
+	uduo.mutation.ClearToken()
+	return uduo
+}
+
+// ClearPaymentOrder clears the "payment_order" edge to the PaymentOrder entity.
+func (uduo *UnmatchedDepositUpdateOne) ClearPaymentOrder() *UnmatchedDepositUpdateOne {
+	uduo.mutation.ClearPaymentOrder()
+	return uduo
+}
+
+// ClearSweep clears the "sweep" edge to the Sweep entity.
+func (uduo *UnmatchedDepositUpdateOne) ClearSweep() *UnmatchedDepositUpdateOne {
+	uduo.mutation.ClearSweep()
+	return uduo
+}
+
+// Where appends a list predicates to the UnmatchedDepositUpdate builder.
+func (uduo *UnmatchedDepositUpdateOne) Where(ps ...predicate.UnmatchedDeposit) *UnmatchedDepositUpdateOne {
+	uduo.mutation.Where(ps...)
+	return uduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (uduo *UnmatchedDepositUpdateOne) Select(field string, fields ...string) *UnmatchedDepositUpdateOne {
+	uduo.fields = append([]string{field}, fields...)
+	return uduo
+}
+
+// Save executes the query and returns the updated UnmatchedDeposit entity.
+func (uduo *UnmatchedDepositUpdateOne) Save(ctx context.Context) (*UnmatchedDeposit, error) {
+	uduo.defaults()
+	return withHooks(ctx, uduo.sqlSave, uduo.mutation, uduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uduo *UnmatchedDepositUpdateOne) SaveX(ctx context.Context) *UnmatchedDeposit {
+	node, err := uduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (uduo *UnmatchedDepositUpdateOne) Exec(ctx context.Context) error {
+	_, err := uduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uduo *UnmatchedDepositUpdateOne) ExecX(ctx context.Context) {
+	if err := uduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uduo *UnmatchedDepositUpdateOne) defaults() {
+	if _, ok := uduo.mutation.UpdatedAt(); !ok {
+		v := unmatcheddeposit.UpdateDefaultUpdatedAt()
+		uduo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uduo *UnmatchedDepositUpdateOne) check() error {
+	if v, ok := uduo.mutation.TxHash(); ok {
+		if err := unmatcheddeposit.TxHashValidator(v); err != nil {
+			return &ValidationError{Name: "tx_hash", err: fmt.Errorf(`ent: validator failed for field "UnmatchedDeposit.tx_hash": %w`, err)}
+		}
+	}
+	if v, ok := uduo.mutation.Reason(); ok {
+		if err := unmatcheddeposit.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "UnmatchedDeposit.reason": %w`, err)}
+		}
+	}
+	if v, ok := uduo.mutation.Status(); ok {
+		if err := unmatcheddeposit.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "UnmatchedDeposit.status": %w`, err)}
+		}
+	}
+	if uduo.mutation.TokenCleared() && len(uduo.mutation.TokenIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UnmatchedDeposit.token"`)
+	}
+	return nil
+}
+
+func (uduo *UnmatchedDepositUpdateOne) sqlSave(ctx context.Context) (_node *UnmatchedDeposit, err error) {
+	if err := uduo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(unmatcheddeposit.Table, unmatcheddeposit.Columns, sqlgraph.NewFieldSpec(unmatcheddeposit.FieldID, field.TypeUUID))
+	id, ok := uduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UnmatchedDeposit.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := uduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, unmatcheddeposit.FieldID)
+		for _, f := range fields {
+			if !unmatcheddeposit.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != unmatcheddeposit.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := uduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uduo.mutation.UpdatedAt(); ok {
+		_spec.SetField(unmatcheddeposit.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := uduo.mutation.TxHash(); ok {
+		_spec.SetField(unmatcheddeposit.FieldTxHash, field.TypeString, value)
+	}
+	if value, ok := uduo.mutation.ReceiveAddress(); ok {
+		_spec.SetField(unmatcheddeposit.FieldReceiveAddress, field.TypeString, value)
+	}
+	if value, ok := uduo.mutation.FromAddress(); ok {
+		_spec.SetField(unmatcheddeposit.FieldFromAddress, field.TypeString, value)
+	}
+	if value, ok := uduo.mutation.Amount(); ok {
+		_spec.SetField(unmatcheddeposit.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := uduo.mutation.AddedAmount(); ok {
+		_spec.AddField(unmatcheddeposit.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := uduo.mutation.BlockNumber(); ok {
+		_spec.SetField(unmatcheddeposit.FieldBlockNumber, field.TypeInt64, value)
+	}
+	if value, ok := uduo.mutation.AddedBlockNumber(); ok {
+		_spec.AddField(unmatcheddeposit.FieldBlockNumber, field.TypeInt64, value)
+	}
+	if value, ok := uduo.mutation.Reason(); ok {
+		_spec.SetField(unmatcheddeposit.FieldReason, field.TypeEnum, value)
+	}
+	if value, ok := uduo.mutation.Status(); ok {
+		_spec.SetField(unmatcheddeposit.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := uduo.mutation.ResolvedAt(); ok {
+		_spec.SetField(unmatcheddeposit.FieldResolvedAt, field.TypeTime, value)
+	}
+	if uduo.mutation.ResolvedAtCleared() {
+		_spec.ClearField(unmatcheddeposit.FieldResolvedAt, field.TypeTime)
+	}
+	if uduo.mutation.TokenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   unmatcheddeposit.TokenTable,
+			Columns: []string{unmatcheddeposit.TokenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(token.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uduo.mutation.TokenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   unmatcheddeposit.TokenTable,
+			Columns: []string{unmatcheddeposit.TokenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(token.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uduo.mutation.PaymentOrderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   unmatcheddeposit.PaymentOrderTable,
+			Columns: []string{unmatcheddeposit.PaymentOrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uduo.mutation.PaymentOrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   unmatcheddeposit.PaymentOrderTable,
+			Columns: []string{unmatcheddeposit.PaymentOrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uduo.mutation.SweepCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   unmatcheddeposit.SweepTable,
+			Columns: []string{unmatcheddeposit.SweepColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sweep.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uduo.mutation.SweepIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   unmatcheddeposit.SweepTable,
+			Columns: []string{unmatcheddeposit.SweepColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sweep.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &UnmatchedDeposit{config: uduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, uduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{unmatcheddeposit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	uduo.mutation.done = true
+	return _node, nil
+}
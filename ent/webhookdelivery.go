@@ -0,0 +1,247 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/NEDA-LABS/stablenode/ent/senderprofile"
+	"github.com/NEDA-LABS/stablenode/ent/webhookdelivery"
+	"github.com/google/uuid"
+)
+
+// WebhookDelivery is the model entity for the WebhookDelivery schema.
+type WebhookDelivery struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// NotificationID holds the value of the "notification_id" field.
+	NotificationID uuid.UUID `json:"notification_id,omitempty"`
+	// Event holds the value of the "event" field.
+	Event string `json:"event,omitempty"`
+	// OrderID holds the value of the "order_id" field.
+	OrderID uuid.UUID `json:"order_id,omitempty"`
+	// WebhookURL holds the value of the "webhook_url" field.
+	WebhookURL string `json:"webhook_url,omitempty"`
+	// AttemptNumber holds the value of the "attempt_number" field.
+	AttemptNumber int `json:"attempt_number,omitempty"`
+	// Status holds the value of the "status" field.
+	Status webhookdelivery.Status `json:"status,omitempty"`
+	// ResponseStatus holds the value of the "response_status" field.
+	ResponseStatus int `json:"response_status,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// DurationMs holds the value of the "duration_ms" field.
+	DurationMs int64 `json:"duration_ms,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WebhookDeliveryQuery when eager-loading is set.
+	Edges                             WebhookDeliveryEdges `json:"edges"`
+	sender_profile_webhook_deliveries *uuid.UUID
+	selectValues                      sql.SelectValues
+}
+
+// WebhookDeliveryEdges holds the relations/edges for other nodes in the graph.
+type WebhookDeliveryEdges struct {
+	// SenderProfile holds the value of the sender_profile edge.
+	SenderProfile *SenderProfile `json:"sender_profile,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// SenderProfileOrErr returns the SenderProfile value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WebhookDeliveryEdges) SenderProfileOrErr() (*SenderProfile, error) {
+	if e.SenderProfile != nil {
+		return e.SenderProfile, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: senderprofile.Label}
+	}
+	return nil, &NotLoadedError{edge: "sender_profile"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WebhookDelivery) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case webhookdelivery.FieldAttemptNumber, webhookdelivery.FieldResponseStatus, webhookdelivery.FieldDurationMs:
+			values[i] = new(sql.NullInt64)
+		case webhookdelivery.FieldEvent, webhookdelivery.FieldWebhookURL, webhookdelivery.FieldStatus, webhookdelivery.FieldError:
+			values[i] = new(sql.NullString)
+		case webhookdelivery.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case webhookdelivery.FieldID, webhookdelivery.FieldNotificationID, webhookdelivery.FieldOrderID:
+			values[i] = new(uuid.UUID)
+		case webhookdelivery.ForeignKeys[0]: // sender_profile_webhook_deliveries
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WebhookDelivery fields.
+func (wd *WebhookDelivery) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case webhookdelivery.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				wd.ID = *value
+			}
+		case webhookdelivery.FieldNotificationID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field notification_id", values[i])
+			} else if value != nil {
+				wd.NotificationID = *value
+			}
+		case webhookdelivery.FieldEvent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event", values[i])
+			} else if value.Valid {
+				wd.Event = value.String
+			}
+		case webhookdelivery.FieldOrderID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field order_id", values[i])
+			} else if value != nil {
+				wd.OrderID = *value
+			}
+		case webhookdelivery.FieldWebhookURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field webhook_url", values[i])
+			} else if value.Valid {
+				wd.WebhookURL = value.String
+			}
+		case webhookdelivery.FieldAttemptNumber:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempt_number", values[i])
+			} else if value.Valid {
+				wd.AttemptNumber = int(value.Int64)
+			}
+		case webhookdelivery.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				wd.Status = webhookdelivery.Status(value.String)
+			}
+		case webhookdelivery.FieldResponseStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field response_status", values[i])
+			} else if value.Valid {
+				wd.ResponseStatus = int(value.Int64)
+			}
+		case webhookdelivery.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				wd.Error = value.String
+			}
+		case webhookdelivery.FieldDurationMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration_ms", values[i])
+			} else if value.Valid {
+				wd.DurationMs = value.Int64
+			}
+		case webhookdelivery.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				wd.CreatedAt = value.Time
+			}
+		case webhookdelivery.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field sender_profile_webhook_deliveries", values[i])
+			} else if value.Valid {
+				wd.sender_profile_webhook_deliveries = new(uuid.UUID)
+				*wd.sender_profile_webhook_deliveries = *value.S.(*uuid.UUID)
+			}
+		default:
+			wd.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WebhookDelivery.
+// This includes values selected through modifiers, order, etc.
+func (wd *WebhookDelivery) Value(name string) (ent.Value, error) {
+	return wd.selectValues.Get(name)
+}
+
+// QuerySenderProfile queries the "sender_profile" edge of the WebhookDelivery entity.
+func (wd *WebhookDelivery) QuerySenderProfile() *SenderProfileQuery {
+	return NewWebhookDeliveryClient(wd.config).QuerySenderProfile(wd)
+}
+
+// Update returns a builder for updating this WebhookDelivery.
+// Note that you need to call WebhookDelivery.Unwrap() before calling this method if this WebhookDelivery
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (wd *WebhookDelivery) Update() *WebhookDeliveryUpdateOne {
+	return NewWebhookDeliveryClient(wd.config).UpdateOne(wd)
+}
+
+// Unwrap unwraps the WebhookDelivery entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (wd *WebhookDelivery) Unwrap() *WebhookDelivery {
+	_tx, ok := wd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WebhookDelivery is not a transactional entity")
+	}
+	wd.config.driver = _tx.drv
+	return wd
+}
+
+// String implements the fmt.Stringer.
+func (wd *WebhookDelivery) String() string {
+	var builder strings.Builder
+	builder.WriteString("WebhookDelivery(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", wd.ID))
+	builder.WriteString("notification_id=")
+	builder.WriteString(fmt.Sprintf("%v", wd.NotificationID))
+	builder.WriteString(", ")
+	builder.WriteString("event=")
+	builder.WriteString(wd.Event)
+	builder.WriteString(", ")
+	builder.WriteString("order_id=")
+	builder.WriteString(fmt.Sprintf("%v", wd.OrderID))
+	builder.WriteString(", ")
+	builder.WriteString("webhook_url=")
+	builder.WriteString(wd.WebhookURL)
+	builder.WriteString(", ")
+	builder.WriteString("attempt_number=")
+	builder.WriteString(fmt.Sprintf("%v", wd.AttemptNumber))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", wd.Status))
+	builder.WriteString(", ")
+	builder.WriteString("response_status=")
+	builder.WriteString(fmt.Sprintf("%v", wd.ResponseStatus))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(wd.Error)
+	builder.WriteString(", ")
+	builder.WriteString("duration_ms=")
+	builder.WriteString(fmt.Sprintf("%v", wd.DurationMs))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(wd.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WebhookDeliveries is a parsable slice of WebhookDelivery.
+type WebhookDeliveries []*WebhookDelivery
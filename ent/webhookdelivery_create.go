@@ -0,0 +1,715 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/senderprofile"
+	"github.com/NEDA-LABS/stablenode/ent/webhookdelivery"
+	"github.com/google/uuid"
+)
+
+// WebhookDeliveryCreate is the builder for creating a WebhookDelivery entity.
+type WebhookDeliveryCreate struct {
+	config
+	mutation *WebhookDeliveryMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetNotificationID sets the "notification_id" field.
+func (wdc *WebhookDeliveryCreate) SetNotificationID(u uuid.UUID) *WebhookDeliveryCreate {
+	wdc.mutation.SetNotificationID(u)
+	return wdc
+}
+
+// SetEvent sets the "event" field.
+func (wdc *WebhookDeliveryCreate) SetEvent(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetEvent(s)
+	return wdc
+}
+
+// SetOrderID sets the "order_id" field.
+func (wdc *WebhookDeliveryCreate) SetOrderID(u uuid.UUID) *WebhookDeliveryCreate {
+	wdc.mutation.SetOrderID(u)
+	return wdc
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableOrderID(u *uuid.UUID) *WebhookDeliveryCreate {
+	if u != nil {
+		wdc.SetOrderID(*u)
+	}
+	return wdc
+}
+
+// SetWebhookURL sets the "webhook_url" field.
+func (wdc *WebhookDeliveryCreate) SetWebhookURL(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetWebhookURL(s)
+	return wdc
+}
+
+// SetAttemptNumber sets the "attempt_number" field.
+func (wdc *WebhookDeliveryCreate) SetAttemptNumber(i int) *WebhookDeliveryCreate {
+	wdc.mutation.SetAttemptNumber(i)
+	return wdc
+}
+
+// SetStatus sets the "status" field.
+func (wdc *WebhookDeliveryCreate) SetStatus(w webhookdelivery.Status) *WebhookDeliveryCreate {
+	wdc.mutation.SetStatus(w)
+	return wdc
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (wdc *WebhookDeliveryCreate) SetResponseStatus(i int) *WebhookDeliveryCreate {
+	wdc.mutation.SetResponseStatus(i)
+	return wdc
+}
+
+// SetNillableResponseStatus sets the "response_status" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableResponseStatus(i *int) *WebhookDeliveryCreate {
+	if i != nil {
+		wdc.SetResponseStatus(*i)
+	}
+	return wdc
+}
+
+// SetError sets the "error" field.
+func (wdc *WebhookDeliveryCreate) SetError(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetError(s)
+	return wdc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableError(s *string) *WebhookDeliveryCreate {
+	if s != nil {
+		wdc.SetError(*s)
+	}
+	return wdc
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (wdc *WebhookDeliveryCreate) SetDurationMs(i int64) *WebhookDeliveryCreate {
+	wdc.mutation.SetDurationMs(i)
+	return wdc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wdc *WebhookDeliveryCreate) SetCreatedAt(t time.Time) *WebhookDeliveryCreate {
+	wdc.mutation.SetCreatedAt(t)
+	return wdc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableCreatedAt(t *time.Time) *WebhookDeliveryCreate {
+	if t != nil {
+		wdc.SetCreatedAt(*t)
+	}
+	return wdc
+}
+
+// SetID sets the "id" field.
+func (wdc *WebhookDeliveryCreate) SetID(u uuid.UUID) *WebhookDeliveryCreate {
+	wdc.mutation.SetID(u)
+	return wdc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableID(u *uuid.UUID) *WebhookDeliveryCreate {
+	if u != nil {
+		wdc.SetID(*u)
+	}
+	return wdc
+}
+
+// SetSenderProfileID sets the "sender_profile" edge to the SenderProfile entity by ID.
+func (wdc *WebhookDeliveryCreate) SetSenderProfileID(id uuid.UUID) *WebhookDeliveryCreate {
+	wdc.mutation.SetSenderProfileID(id)
+	return wdc
+}
+
+// SetSenderProfile sets the "sender_profile" edge to the SenderProfile entity.
+func (wdc *WebhookDeliveryCreate) SetSenderProfile(s *SenderProfile) *WebhookDeliveryCreate {
+	return wdc.SetSenderProfileID(s.ID)
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (wdc *WebhookDeliveryCreate) Mutation() *WebhookDeliveryMutation {
+	return wdc.mutation
+}
+
+// Save creates the WebhookDelivery in the database.
+func (wdc *WebhookDeliveryCreate) Save(ctx context.Context) (*WebhookDelivery, error) {
+	wdc.defaults()
+	return withHooks(ctx, wdc.sqlSave, wdc.mutation, wdc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wdc *WebhookDeliveryCreate) SaveX(ctx context.Context) *WebhookDelivery {
+	v, err := wdc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wdc *WebhookDeliveryCreate) Exec(ctx context.Context) error {
+	_, err := wdc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdc *WebhookDeliveryCreate) ExecX(ctx context.Context) {
+	if err := wdc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wdc *WebhookDeliveryCreate) defaults() {
+	if _, ok := wdc.mutation.CreatedAt(); !ok {
+		v := webhookdelivery.DefaultCreatedAt()
+		wdc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := wdc.mutation.ID(); !ok {
+		v := webhookdelivery.DefaultID()
+		wdc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wdc *WebhookDeliveryCreate) check() error {
+	if _, ok := wdc.mutation.NotificationID(); !ok {
+		return &ValidationError{Name: "notification_id", err: errors.New(`ent: missing required field "WebhookDelivery.notification_id"`)}
+	}
+	if _, ok := wdc.mutation.Event(); !ok {
+		return &ValidationError{Name: "event", err: errors.New(`ent: missing required field "WebhookDelivery.event"`)}
+	}
+	if _, ok := wdc.mutation.WebhookURL(); !ok {
+		return &ValidationError{Name: "webhook_url", err: errors.New(`ent: missing required field "WebhookDelivery.webhook_url"`)}
+	}
+	if _, ok := wdc.mutation.AttemptNumber(); !ok {
+		return &ValidationError{Name: "attempt_number", err: errors.New(`ent: missing required field "WebhookDelivery.attempt_number"`)}
+	}
+	if _, ok := wdc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "WebhookDelivery.status"`)}
+	}
+	if v, ok := wdc.mutation.Status(); ok {
+		if err := webhookdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WebhookDelivery.status": %w`, err)}
+		}
+	}
+	if v, ok := wdc.mutation.Error(); ok {
+		if err := webhookdelivery.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`ent: validator failed for field "WebhookDelivery.error": %w`, err)}
+		}
+	}
+	if _, ok := wdc.mutation.DurationMs(); !ok {
+		return &ValidationError{Name: "duration_ms", err: errors.New(`ent: missing required field "WebhookDelivery.duration_ms"`)}
+	}
+	if _, ok := wdc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WebhookDelivery.created_at"`)}
+	}
+	if len(wdc.mutation.SenderProfileIDs()) == 0 {
+		return &ValidationError{Name: "sender_profile", err: errors.New(`ent: missing required edge "WebhookDelivery.sender_profile"`)}
+	}
+	return nil
+}
+
+func (wdc *WebhookDeliveryCreate) sqlSave(ctx context.Context) (*WebhookDelivery, error) {
+	if err := wdc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wdc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wdc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	wdc.mutation.id = &_node.ID
+	wdc.mutation.done = true
+	return _node, nil
+}
+
+func (wdc *WebhookDeliveryCreate) createSpec() (*WebhookDelivery, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WebhookDelivery{config: wdc.config}
+		_spec = sqlgraph.NewCreateSpec(webhookdelivery.Table, sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = wdc.conflict
+	if id, ok := wdc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := wdc.mutation.NotificationID(); ok {
+		_spec.SetField(webhookdelivery.FieldNotificationID, field.TypeUUID, value)
+		_node.NotificationID = value
+	}
+	if value, ok := wdc.mutation.Event(); ok {
+		_spec.SetField(webhookdelivery.FieldEvent, field.TypeString, value)
+		_node.Event = value
+	}
+	if value, ok := wdc.mutation.OrderID(); ok {
+		_spec.SetField(webhookdelivery.FieldOrderID, field.TypeUUID, value)
+		_node.OrderID = value
+	}
+	if value, ok := wdc.mutation.WebhookURL(); ok {
+		_spec.SetField(webhookdelivery.FieldWebhookURL, field.TypeString, value)
+		_node.WebhookURL = value
+	}
+	if value, ok := wdc.mutation.AttemptNumber(); ok {
+		_spec.SetField(webhookdelivery.FieldAttemptNumber, field.TypeInt, value)
+		_node.AttemptNumber = value
+	}
+	if value, ok := wdc.mutation.Status(); ok {
+		_spec.SetField(webhookdelivery.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := wdc.mutation.ResponseStatus(); ok {
+		_spec.SetField(webhookdelivery.FieldResponseStatus, field.TypeInt, value)
+		_node.ResponseStatus = value
+	}
+	if value, ok := wdc.mutation.Error(); ok {
+		_spec.SetField(webhookdelivery.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := wdc.mutation.DurationMs(); ok {
+		_spec.SetField(webhookdelivery.FieldDurationMs, field.TypeInt64, value)
+		_node.DurationMs = value
+	}
+	if value, ok := wdc.mutation.CreatedAt(); ok {
+		_spec.SetField(webhookdelivery.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := wdc.mutation.SenderProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.SenderProfileTable,
+			Columns: []string{webhookdelivery.SenderProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderprofile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.sender_profile_webhook_deliveries = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WebhookDelivery.Create().
+//		SetNotificationID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WebhookDeliveryUpsert) {
+//			SetNotificationID(v+v).
+//		}).
+//		Exec(ctx)
+func (wdc *WebhookDeliveryCreate) OnConflict(opts ...sql.ConflictOption) *WebhookDeliveryUpsertOne {
+	wdc.conflict = opts
+	return &WebhookDeliveryUpsertOne{
+		create: wdc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WebhookDelivery.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wdc *WebhookDeliveryCreate) OnConflictColumns(columns ...string) *WebhookDeliveryUpsertOne {
+	wdc.conflict = append(wdc.conflict, sql.ConflictColumns(columns...))
+	return &WebhookDeliveryUpsertOne{
+		create: wdc,
+	}
+}
+
+type (
+	// WebhookDeliveryUpsertOne is the builder for "upsert"-ing
+	//  one WebhookDelivery node.
+	WebhookDeliveryUpsertOne struct {
+		create *WebhookDeliveryCreate
+	}
+
+	// WebhookDeliveryUpsert is the "OnConflict" setter.
+	WebhookDeliveryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.WebhookDelivery.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(webhookdelivery.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WebhookDeliveryUpsertOne) UpdateNewValues() *WebhookDeliveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(webhookdelivery.FieldID)
+		}
+		if _, exists := u.create.mutation.NotificationID(); exists {
+			s.SetIgnore(webhookdelivery.FieldNotificationID)
+		}
+		if _, exists := u.create.mutation.Event(); exists {
+			s.SetIgnore(webhookdelivery.FieldEvent)
+		}
+		if _, exists := u.create.mutation.OrderID(); exists {
+			s.SetIgnore(webhookdelivery.FieldOrderID)
+		}
+		if _, exists := u.create.mutation.WebhookURL(); exists {
+			s.SetIgnore(webhookdelivery.FieldWebhookURL)
+		}
+		if _, exists := u.create.mutation.AttemptNumber(); exists {
+			s.SetIgnore(webhookdelivery.FieldAttemptNumber)
+		}
+		if _, exists := u.create.mutation.Status(); exists {
+			s.SetIgnore(webhookdelivery.FieldStatus)
+		}
+		if _, exists := u.create.mutation.ResponseStatus(); exists {
+			s.SetIgnore(webhookdelivery.FieldResponseStatus)
+		}
+		if _, exists := u.create.mutation.Error(); exists {
+			s.SetIgnore(webhookdelivery.FieldError)
+		}
+		if _, exists := u.create.mutation.DurationMs(); exists {
+			s.SetIgnore(webhookdelivery.FieldDurationMs)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(webhookdelivery.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WebhookDelivery.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *WebhookDeliveryUpsertOne) Ignore() *WebhookDeliveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WebhookDeliveryUpsertOne) DoNothing() *WebhookDeliveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WebhookDeliveryCreate.OnConflict
+// documentation for more info.
+func (u *WebhookDeliveryUpsertOne) Update(set func(*WebhookDeliveryUpsert)) *WebhookDeliveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WebhookDeliveryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *WebhookDeliveryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WebhookDeliveryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WebhookDeliveryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *WebhookDeliveryUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: WebhookDeliveryUpsertOne.ID is not supported by MySQL driver. Use WebhookDeliveryUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *WebhookDeliveryUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// WebhookDeliveryCreateBulk is the builder for creating many WebhookDelivery entities in bulk.
+type WebhookDeliveryCreateBulk struct {
+	config
+	err      error
+	builders []*WebhookDeliveryCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the WebhookDelivery entities in the database.
+func (wdcb *WebhookDeliveryCreateBulk) Save(ctx context.Context) ([]*WebhookDelivery, error) {
+	if wdcb.err != nil {
+		return nil, wdcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wdcb.builders))
+	nodes := make([]*WebhookDelivery, len(wdcb.builders))
+	mutators := make([]Mutator, len(wdcb.builders))
+	for i := range wdcb.builders {
+		func(i int, root context.Context) {
+			builder := wdcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebhookDeliveryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wdcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = wdcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wdcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wdcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wdcb *WebhookDeliveryCreateBulk) SaveX(ctx context.Context) []*WebhookDelivery {
+	v, err := wdcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wdcb *WebhookDeliveryCreateBulk) Exec(ctx context.Context) error {
+	_, err := wdcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdcb *WebhookDeliveryCreateBulk) ExecX(ctx context.Context) {
+	if err := wdcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WebhookDelivery.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WebhookDeliveryUpsert) {
+//			SetNotificationID(v+v).
+//		}).
+//		Exec(ctx)
+func (wdcb *WebhookDeliveryCreateBulk) OnConflict(opts ...sql.ConflictOption) *WebhookDeliveryUpsertBulk {
+	wdcb.conflict = opts
+	return &WebhookDeliveryUpsertBulk{
+		create: wdcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WebhookDelivery.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wdcb *WebhookDeliveryCreateBulk) OnConflictColumns(columns ...string) *WebhookDeliveryUpsertBulk {
+	wdcb.conflict = append(wdcb.conflict, sql.ConflictColumns(columns...))
+	return &WebhookDeliveryUpsertBulk{
+		create: wdcb,
+	}
+}
+
+// WebhookDeliveryUpsertBulk is the builder for "upsert"-ing
+// a bulk of WebhookDelivery nodes.
+type WebhookDeliveryUpsertBulk struct {
+	create *WebhookDeliveryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.WebhookDelivery.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(webhookdelivery.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WebhookDeliveryUpsertBulk) UpdateNewValues() *WebhookDeliveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(webhookdelivery.FieldID)
+			}
+			if _, exists := b.mutation.NotificationID(); exists {
+				s.SetIgnore(webhookdelivery.FieldNotificationID)
+			}
+			if _, exists := b.mutation.Event(); exists {
+				s.SetIgnore(webhookdelivery.FieldEvent)
+			}
+			if _, exists := b.mutation.OrderID(); exists {
+				s.SetIgnore(webhookdelivery.FieldOrderID)
+			}
+			if _, exists := b.mutation.WebhookURL(); exists {
+				s.SetIgnore(webhookdelivery.FieldWebhookURL)
+			}
+			if _, exists := b.mutation.AttemptNumber(); exists {
+				s.SetIgnore(webhookdelivery.FieldAttemptNumber)
+			}
+			if _, exists := b.mutation.Status(); exists {
+				s.SetIgnore(webhookdelivery.FieldStatus)
+			}
+			if _, exists := b.mutation.ResponseStatus(); exists {
+				s.SetIgnore(webhookdelivery.FieldResponseStatus)
+			}
+			if _, exists := b.mutation.Error(); exists {
+				s.SetIgnore(webhookdelivery.FieldError)
+			}
+			if _, exists := b.mutation.DurationMs(); exists {
+				s.SetIgnore(webhookdelivery.FieldDurationMs)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(webhookdelivery.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WebhookDelivery.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *WebhookDeliveryUpsertBulk) Ignore() *WebhookDeliveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WebhookDeliveryUpsertBulk) DoNothing() *WebhookDeliveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WebhookDeliveryCreateBulk.OnConflict
+// documentation for more info.
+func (u *WebhookDeliveryUpsertBulk) Update(set func(*WebhookDeliveryUpsert)) *WebhookDeliveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WebhookDeliveryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *WebhookDeliveryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the WebhookDeliveryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WebhookDeliveryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WebhookDeliveryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,261 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/NEDA-LABS/stablenode/ent/webhookdestination"
+	"github.com/google/uuid"
+)
+
+// WebhookDestination is the model entity for the WebhookDestination schema.
+type WebhookDestination struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// URL holds the value of the "url" field.
+	URL string `json:"url,omitempty"`
+	// MaxAttempts holds the value of the "max_attempts" field.
+	MaxAttempts *int `json:"max_attempts,omitempty"`
+	// BackoffSeconds holds the value of the "backoff_seconds" field.
+	BackoffSeconds *int `json:"backoff_seconds,omitempty"`
+	// BackoffMultiplier holds the value of the "backoff_multiplier" field.
+	BackoffMultiplier *float64 `json:"backoff_multiplier,omitempty"`
+	// TimeoutSeconds holds the value of the "timeout_seconds" field.
+	TimeoutSeconds *int `json:"timeout_seconds,omitempty"`
+	// DeliveredCount holds the value of the "delivered_count" field.
+	DeliveredCount int `json:"delivered_count,omitempty"`
+	// FailedCount holds the value of the "failed_count" field.
+	FailedCount int `json:"failed_count,omitempty"`
+	// LastDeliveredAt holds the value of the "last_delivered_at" field.
+	LastDeliveredAt time.Time `json:"last_delivered_at,omitempty"`
+	// LastFailedAt holds the value of the "last_failed_at" field.
+	LastFailedAt time.Time `json:"last_failed_at,omitempty"`
+	// FailingSince holds the value of the "failing_since" field.
+	FailingSince *time.Time `json:"failing_since,omitempty"`
+	// DisabledAt holds the value of the "disabled_at" field.
+	DisabledAt   *time.Time `json:"disabled_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WebhookDestination) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case webhookdestination.FieldBackoffMultiplier:
+			values[i] = new(sql.NullFloat64)
+		case webhookdestination.FieldMaxAttempts, webhookdestination.FieldBackoffSeconds, webhookdestination.FieldTimeoutSeconds, webhookdestination.FieldDeliveredCount, webhookdestination.FieldFailedCount:
+			values[i] = new(sql.NullInt64)
+		case webhookdestination.FieldURL:
+			values[i] = new(sql.NullString)
+		case webhookdestination.FieldCreatedAt, webhookdestination.FieldUpdatedAt, webhookdestination.FieldLastDeliveredAt, webhookdestination.FieldLastFailedAt, webhookdestination.FieldFailingSince, webhookdestination.FieldDisabledAt:
+			values[i] = new(sql.NullTime)
+		case webhookdestination.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WebhookDestination fields.
+func (wd *WebhookDestination) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case webhookdestination.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				wd.ID = *value
+			}
+		case webhookdestination.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				wd.CreatedAt = value.Time
+			}
+		case webhookdestination.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				wd.UpdatedAt = value.Time
+			}
+		case webhookdestination.FieldURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field url", values[i])
+			} else if value.Valid {
+				wd.URL = value.String
+			}
+		case webhookdestination.FieldMaxAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_attempts", values[i])
+			} else if value.Valid {
+				wd.MaxAttempts = new(int)
+				*wd.MaxAttempts = int(value.Int64)
+			}
+		case webhookdestination.FieldBackoffSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field backoff_seconds", values[i])
+			} else if value.Valid {
+				wd.BackoffSeconds = new(int)
+				*wd.BackoffSeconds = int(value.Int64)
+			}
+		case webhookdestination.FieldBackoffMultiplier:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field backoff_multiplier", values[i])
+			} else if value.Valid {
+				wd.BackoffMultiplier = new(float64)
+				*wd.BackoffMultiplier = value.Float64
+			}
+		case webhookdestination.FieldTimeoutSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field timeout_seconds", values[i])
+			} else if value.Valid {
+				wd.TimeoutSeconds = new(int)
+				*wd.TimeoutSeconds = int(value.Int64)
+			}
+		case webhookdestination.FieldDeliveredCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field delivered_count", values[i])
+			} else if value.Valid {
+				wd.DeliveredCount = int(value.Int64)
+			}
+		case webhookdestination.FieldFailedCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failed_count", values[i])
+			} else if value.Valid {
+				wd.FailedCount = int(value.Int64)
+			}
+		case webhookdestination.FieldLastDeliveredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_delivered_at", values[i])
+			} else if value.Valid {
+				wd.LastDeliveredAt = value.Time
+			}
+		case webhookdestination.FieldLastFailedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_failed_at", values[i])
+			} else if value.Valid {
+				wd.LastFailedAt = value.Time
+			}
+		case webhookdestination.FieldFailingSince:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field failing_since", values[i])
+			} else if value.Valid {
+				wd.FailingSince = new(time.Time)
+				*wd.FailingSince = value.Time
+			}
+		case webhookdestination.FieldDisabledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field disabled_at", values[i])
+			} else if value.Valid {
+				wd.DisabledAt = new(time.Time)
+				*wd.DisabledAt = value.Time
+			}
+		default:
+			wd.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WebhookDestination.
+// This includes values selected through modifiers, order, etc.
+func (wd *WebhookDestination) Value(name string) (ent.Value, error) {
+	return wd.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this WebhookDestination.
+// Note that you need to call WebhookDestination.Unwrap() before calling this method if this WebhookDestination
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (wd *WebhookDestination) Update() *WebhookDestinationUpdateOne {
+	return NewWebhookDestinationClient(wd.config).UpdateOne(wd)
+}
+
+// Unwrap unwraps the WebhookDestination entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (wd *WebhookDestination) Unwrap() *WebhookDestination {
+	_tx, ok := wd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WebhookDestination is not a transactional entity")
+	}
+	wd.config.driver = _tx.drv
+	return wd
+}
+
+// String implements the fmt.Stringer.
+func (wd *WebhookDestination) String() string {
+	var builder strings.Builder
+	builder.WriteString("WebhookDestination(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", wd.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(wd.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(wd.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("url=")
+	builder.WriteString(wd.URL)
+	builder.WriteString(", ")
+	if v := wd.MaxAttempts; v != nil {
+		builder.WriteString("max_attempts=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := wd.BackoffSeconds; v != nil {
+		builder.WriteString("backoff_seconds=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := wd.BackoffMultiplier; v != nil {
+		builder.WriteString("backoff_multiplier=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := wd.TimeoutSeconds; v != nil {
+		builder.WriteString("timeout_seconds=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("delivered_count=")
+	builder.WriteString(fmt.Sprintf("%v", wd.DeliveredCount))
+	builder.WriteString(", ")
+	builder.WriteString("failed_count=")
+	builder.WriteString(fmt.Sprintf("%v", wd.FailedCount))
+	builder.WriteString(", ")
+	builder.WriteString("last_delivered_at=")
+	builder.WriteString(wd.LastDeliveredAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("last_failed_at=")
+	builder.WriteString(wd.LastFailedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := wd.FailingSince; v != nil {
+		builder.WriteString("failing_since=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := wd.DisabledAt; v != nil {
+		builder.WriteString("disabled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WebhookDestinations is a parsable slice of WebhookDestination.
+type WebhookDestinations []*WebhookDestination
@@ -0,0 +1,161 @@
+// Code generated by ent, DO NOT EDIT.
+
+package webhookdestination
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the webhookdestination type in the database.
+	Label = "webhook_destination"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldURL holds the string denoting the url field in the database.
+	FieldURL = "url"
+	// FieldMaxAttempts holds the string denoting the max_attempts field in the database.
+	FieldMaxAttempts = "max_attempts"
+	// FieldBackoffSeconds holds the string denoting the backoff_seconds field in the database.
+	FieldBackoffSeconds = "backoff_seconds"
+	// FieldBackoffMultiplier holds the string denoting the backoff_multiplier field in the database.
+	FieldBackoffMultiplier = "backoff_multiplier"
+	// FieldTimeoutSeconds holds the string denoting the timeout_seconds field in the database.
+	FieldTimeoutSeconds = "timeout_seconds"
+	// FieldDeliveredCount holds the string denoting the delivered_count field in the database.
+	FieldDeliveredCount = "delivered_count"
+	// FieldFailedCount holds the string denoting the failed_count field in the database.
+	FieldFailedCount = "failed_count"
+	// FieldLastDeliveredAt holds the string denoting the last_delivered_at field in the database.
+	FieldLastDeliveredAt = "last_delivered_at"
+	// FieldLastFailedAt holds the string denoting the last_failed_at field in the database.
+	FieldLastFailedAt = "last_failed_at"
+	// FieldFailingSince holds the string denoting the failing_since field in the database.
+	FieldFailingSince = "failing_since"
+	// FieldDisabledAt holds the string denoting the disabled_at field in the database.
+	FieldDisabledAt = "disabled_at"
+	// Table holds the table name of the webhookdestination in the database.
+	Table = "webhook_destinations"
+)
+
+// Columns holds all SQL columns for webhookdestination fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldURL,
+	FieldMaxAttempts,
+	FieldBackoffSeconds,
+	FieldBackoffMultiplier,
+	FieldTimeoutSeconds,
+	FieldDeliveredCount,
+	FieldFailedCount,
+	FieldLastDeliveredAt,
+	FieldLastFailedAt,
+	FieldFailingSince,
+	FieldDisabledAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultDeliveredCount holds the default value on creation for the "delivered_count" field.
+	DefaultDeliveredCount int
+	// DefaultFailedCount holds the default value on creation for the "failed_count" field.
+	DefaultFailedCount int
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the WebhookDestination queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByURL orders the results by the url field.
+func ByURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldURL, opts...).ToFunc()
+}
+
+// ByMaxAttempts orders the results by the max_attempts field.
+func ByMaxAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxAttempts, opts...).ToFunc()
+}
+
+// ByBackoffSeconds orders the results by the backoff_seconds field.
+func ByBackoffSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBackoffSeconds, opts...).ToFunc()
+}
+
+// ByBackoffMultiplier orders the results by the backoff_multiplier field.
+func ByBackoffMultiplier(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBackoffMultiplier, opts...).ToFunc()
+}
+
+// ByTimeoutSeconds orders the results by the timeout_seconds field.
+func ByTimeoutSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimeoutSeconds, opts...).ToFunc()
+}
+
+// ByDeliveredCount orders the results by the delivered_count field.
+func ByDeliveredCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliveredCount, opts...).ToFunc()
+}
+
+// ByFailedCount orders the results by the failed_count field.
+func ByFailedCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFailedCount, opts...).ToFunc()
+}
+
+// ByLastDeliveredAt orders the results by the last_delivered_at field.
+func ByLastDeliveredAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastDeliveredAt, opts...).ToFunc()
+}
+
+// ByLastFailedAt orders the results by the last_failed_at field.
+func ByLastFailedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastFailedAt, opts...).ToFunc()
+}
+
+// ByFailingSince orders the results by the failing_since field.
+func ByFailingSince(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFailingSince, opts...).ToFunc()
+}
+
+// ByDisabledAt orders the results by the disabled_at field.
+func ByDisabledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisabledAt, opts...).ToFunc()
+}
@@ -0,0 +1,761 @@
+// Code generated by ent, DO NOT EDIT.
+
+package webhookdestination
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// URL applies equality check predicate on the "url" field. It's identical to URLEQ.
+func URL(v string) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldEQ(FieldURL, v))
+}
+
+// MaxAttempts applies equality check predicate on the "max_attempts" field. It's identical to MaxAttemptsEQ.
+func MaxAttempts(v int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldEQ(FieldMaxAttempts, v))
+}
+
+// BackoffSeconds applies equality check predicate on the "backoff_seconds" field. It's identical to BackoffSecondsEQ.
+func BackoffSeconds(v int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldEQ(FieldBackoffSeconds, v))
+}
+
+// BackoffMultiplier applies equality check predicate on the "backoff_multiplier" field. It's identical to BackoffMultiplierEQ.
+func BackoffMultiplier(v float64) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldEQ(FieldBackoffMultiplier, v))
+}
+
+// TimeoutSeconds applies equality check predicate on the "timeout_seconds" field. It's identical to TimeoutSecondsEQ.
+func TimeoutSeconds(v int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldEQ(FieldTimeoutSeconds, v))
+}
+
+// DeliveredCount applies equality check predicate on the "delivered_count" field. It's identical to DeliveredCountEQ.
+func DeliveredCount(v int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldEQ(FieldDeliveredCount, v))
+}
+
+// FailedCount applies equality check predicate on the "failed_count" field. It's identical to FailedCountEQ.
+func FailedCount(v int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldEQ(FieldFailedCount, v))
+}
+
+// LastDeliveredAt applies equality check predicate on the "last_delivered_at" field. It's identical to LastDeliveredAtEQ.
+func LastDeliveredAt(v time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldEQ(FieldLastDeliveredAt, v))
+}
+
+// LastFailedAt applies equality check predicate on the "last_failed_at" field. It's identical to LastFailedAtEQ.
+func LastFailedAt(v time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldEQ(FieldLastFailedAt, v))
+}
+
+// FailingSince applies equality check predicate on the "failing_since" field. It's identical to FailingSinceEQ.
+func FailingSince(v time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldEQ(FieldFailingSince, v))
+}
+
+// DisabledAt applies equality check predicate on the "disabled_at" field. It's identical to DisabledAtEQ.
+func DisabledAt(v time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldEQ(FieldDisabledAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// URLEQ applies the EQ predicate on the "url" field.
+func URLEQ(v string) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldEQ(FieldURL, v))
+}
+
+// URLNEQ applies the NEQ predicate on the "url" field.
+func URLNEQ(v string) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldNEQ(FieldURL, v))
+}
+
+// URLIn applies the In predicate on the "url" field.
+func URLIn(vs ...string) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldIn(FieldURL, vs...))
+}
+
+// URLNotIn applies the NotIn predicate on the "url" field.
+func URLNotIn(vs ...string) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldNotIn(FieldURL, vs...))
+}
+
+// URLGT applies the GT predicate on the "url" field.
+func URLGT(v string) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldGT(FieldURL, v))
+}
+
+// URLGTE applies the GTE predicate on the "url" field.
+func URLGTE(v string) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldGTE(FieldURL, v))
+}
+
+// URLLT applies the LT predicate on the "url" field.
+func URLLT(v string) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldLT(FieldURL, v))
+}
+
+// URLLTE applies the LTE predicate on the "url" field.
+func URLLTE(v string) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldLTE(FieldURL, v))
+}
+
+// URLContains applies the Contains predicate on the "url" field.
+func URLContains(v string) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldContains(FieldURL, v))
+}
+
+// URLHasPrefix applies the HasPrefix predicate on the "url" field.
+func URLHasPrefix(v string) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldHasPrefix(FieldURL, v))
+}
+
+// URLHasSuffix applies the HasSuffix predicate on the "url" field.
+func URLHasSuffix(v string) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldHasSuffix(FieldURL, v))
+}
+
+// URLEqualFold applies the EqualFold predicate on the "url" field.
+func URLEqualFold(v string) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldEqualFold(FieldURL, v))
+}
+
+// URLContainsFold applies the ContainsFold predicate on the "url" field.
+func URLContainsFold(v string) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldContainsFold(FieldURL, v))
+}
+
+// MaxAttemptsEQ applies the EQ predicate on the "max_attempts" field.
+func MaxAttemptsEQ(v int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldEQ(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsNEQ applies the NEQ predicate on the "max_attempts" field.
+func MaxAttemptsNEQ(v int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldNEQ(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsIn applies the In predicate on the "max_attempts" field.
+func MaxAttemptsIn(vs ...int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldIn(FieldMaxAttempts, vs...))
+}
+
+// MaxAttemptsNotIn applies the NotIn predicate on the "max_attempts" field.
+func MaxAttemptsNotIn(vs ...int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldNotIn(FieldMaxAttempts, vs...))
+}
+
+// MaxAttemptsGT applies the GT predicate on the "max_attempts" field.
+func MaxAttemptsGT(v int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldGT(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsGTE applies the GTE predicate on the "max_attempts" field.
+func MaxAttemptsGTE(v int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldGTE(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsLT applies the LT predicate on the "max_attempts" field.
+func MaxAttemptsLT(v int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldLT(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsLTE applies the LTE predicate on the "max_attempts" field.
+func MaxAttemptsLTE(v int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldLTE(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsIsNil applies the IsNil predicate on the "max_attempts" field.
+func MaxAttemptsIsNil() predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldIsNull(FieldMaxAttempts))
+}
+
+// MaxAttemptsNotNil applies the NotNil predicate on the "max_attempts" field.
+func MaxAttemptsNotNil() predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldNotNull(FieldMaxAttempts))
+}
+
+// BackoffSecondsEQ applies the EQ predicate on the "backoff_seconds" field.
+func BackoffSecondsEQ(v int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldEQ(FieldBackoffSeconds, v))
+}
+
+// BackoffSecondsNEQ applies the NEQ predicate on the "backoff_seconds" field.
+func BackoffSecondsNEQ(v int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldNEQ(FieldBackoffSeconds, v))
+}
+
+// BackoffSecondsIn applies the In predicate on the "backoff_seconds" field.
+func BackoffSecondsIn(vs ...int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldIn(FieldBackoffSeconds, vs...))
+}
+
+// BackoffSecondsNotIn applies the NotIn predicate on the "backoff_seconds" field.
+func BackoffSecondsNotIn(vs ...int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldNotIn(FieldBackoffSeconds, vs...))
+}
+
+// BackoffSecondsGT applies the GT predicate on the "backoff_seconds" field.
+func BackoffSecondsGT(v int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldGT(FieldBackoffSeconds, v))
+}
+
+// BackoffSecondsGTE applies the GTE predicate on the "backoff_seconds" field.
+func BackoffSecondsGTE(v int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldGTE(FieldBackoffSeconds, v))
+}
+
+// BackoffSecondsLT applies the LT predicate on the "backoff_seconds" field.
+func BackoffSecondsLT(v int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldLT(FieldBackoffSeconds, v))
+}
+
+// BackoffSecondsLTE applies the LTE predicate on the "backoff_seconds" field.
+func BackoffSecondsLTE(v int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldLTE(FieldBackoffSeconds, v))
+}
+
+// BackoffSecondsIsNil applies the IsNil predicate on the "backoff_seconds" field.
+func BackoffSecondsIsNil() predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldIsNull(FieldBackoffSeconds))
+}
+
+// BackoffSecondsNotNil applies the NotNil predicate on the "backoff_seconds" field.
+func BackoffSecondsNotNil() predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldNotNull(FieldBackoffSeconds))
+}
+
+// BackoffMultiplierEQ applies the EQ predicate on the "backoff_multiplier" field.
+func BackoffMultiplierEQ(v float64) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldEQ(FieldBackoffMultiplier, v))
+}
+
+// BackoffMultiplierNEQ applies the NEQ predicate on the "backoff_multiplier" field.
+func BackoffMultiplierNEQ(v float64) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldNEQ(FieldBackoffMultiplier, v))
+}
+
+// BackoffMultiplierIn applies the In predicate on the "backoff_multiplier" field.
+func BackoffMultiplierIn(vs ...float64) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldIn(FieldBackoffMultiplier, vs...))
+}
+
+// BackoffMultiplierNotIn applies the NotIn predicate on the "backoff_multiplier" field.
+func BackoffMultiplierNotIn(vs ...float64) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldNotIn(FieldBackoffMultiplier, vs...))
+}
+
+// BackoffMultiplierGT applies the GT predicate on the "backoff_multiplier" field.
+func BackoffMultiplierGT(v float64) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldGT(FieldBackoffMultiplier, v))
+}
+
+// BackoffMultiplierGTE applies the GTE predicate on the "backoff_multiplier" field.
+func BackoffMultiplierGTE(v float64) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldGTE(FieldBackoffMultiplier, v))
+}
+
+// BackoffMultiplierLT applies the LT predicate on the "backoff_multiplier" field.
+func BackoffMultiplierLT(v float64) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldLT(FieldBackoffMultiplier, v))
+}
+
+// BackoffMultiplierLTE applies the LTE predicate on the "backoff_multiplier" field.
+func BackoffMultiplierLTE(v float64) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldLTE(FieldBackoffMultiplier, v))
+}
+
+// BackoffMultiplierIsNil applies the IsNil predicate on the "backoff_multiplier" field.
+func BackoffMultiplierIsNil() predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldIsNull(FieldBackoffMultiplier))
+}
+
+// BackoffMultiplierNotNil applies the NotNil predicate on the "backoff_multiplier" field.
+func BackoffMultiplierNotNil() predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldNotNull(FieldBackoffMultiplier))
+}
+
+// TimeoutSecondsEQ applies the EQ predicate on the "timeout_seconds" field.
+func TimeoutSecondsEQ(v int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldEQ(FieldTimeoutSeconds, v))
+}
+
+// TimeoutSecondsNEQ applies the NEQ predicate on the "timeout_seconds" field.
+func TimeoutSecondsNEQ(v int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldNEQ(FieldTimeoutSeconds, v))
+}
+
+// TimeoutSecondsIn applies the In predicate on the "timeout_seconds" field.
+func TimeoutSecondsIn(vs ...int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldIn(FieldTimeoutSeconds, vs...))
+}
+
+// TimeoutSecondsNotIn applies the NotIn predicate on the "timeout_seconds" field.
+func TimeoutSecondsNotIn(vs ...int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldNotIn(FieldTimeoutSeconds, vs...))
+}
+
+// TimeoutSecondsGT applies the GT predicate on the "timeout_seconds" field.
+func TimeoutSecondsGT(v int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldGT(FieldTimeoutSeconds, v))
+}
+
+// TimeoutSecondsGTE applies the GTE predicate on the "timeout_seconds" field.
+func TimeoutSecondsGTE(v int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldGTE(FieldTimeoutSeconds, v))
+}
+
+// TimeoutSecondsLT applies the LT predicate on the "timeout_seconds" field.
+func TimeoutSecondsLT(v int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldLT(FieldTimeoutSeconds, v))
+}
+
+// TimeoutSecondsLTE applies the LTE predicate on the "timeout_seconds" field.
+func TimeoutSecondsLTE(v int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldLTE(FieldTimeoutSeconds, v))
+}
+
+// TimeoutSecondsIsNil applies the IsNil predicate on the "timeout_seconds" field.
+func TimeoutSecondsIsNil() predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldIsNull(FieldTimeoutSeconds))
+}
+
+// TimeoutSecondsNotNil applies the NotNil predicate on the "timeout_seconds" field.
+func TimeoutSecondsNotNil() predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldNotNull(FieldTimeoutSeconds))
+}
+
+// DeliveredCountEQ applies the EQ predicate on the "delivered_count" field.
+func DeliveredCountEQ(v int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldEQ(FieldDeliveredCount, v))
+}
+
+// DeliveredCountNEQ applies the NEQ predicate on the "delivered_count" field.
+func DeliveredCountNEQ(v int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldNEQ(FieldDeliveredCount, v))
+}
+
+// DeliveredCountIn applies the In predicate on the "delivered_count" field.
+func DeliveredCountIn(vs ...int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldIn(FieldDeliveredCount, vs...))
+}
+
+// DeliveredCountNotIn applies the NotIn predicate on the "delivered_count" field.
+func DeliveredCountNotIn(vs ...int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldNotIn(FieldDeliveredCount, vs...))
+}
+
+// DeliveredCountGT applies the GT predicate on the "delivered_count" field.
+func DeliveredCountGT(v int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldGT(FieldDeliveredCount, v))
+}
+
+// DeliveredCountGTE applies the GTE predicate on the "delivered_count" field.
+func DeliveredCountGTE(v int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldGTE(FieldDeliveredCount, v))
+}
+
+// DeliveredCountLT applies the LT predicate on the "delivered_count" field.
+func DeliveredCountLT(v int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldLT(FieldDeliveredCount, v))
+}
+
+// DeliveredCountLTE applies the LTE predicate on the "delivered_count" field.
+func DeliveredCountLTE(v int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldLTE(FieldDeliveredCount, v))
+}
+
+// FailedCountEQ applies the EQ predicate on the "failed_count" field.
+func FailedCountEQ(v int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldEQ(FieldFailedCount, v))
+}
+
+// FailedCountNEQ applies the NEQ predicate on the "failed_count" field.
+func FailedCountNEQ(v int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldNEQ(FieldFailedCount, v))
+}
+
+// FailedCountIn applies the In predicate on the "failed_count" field.
+func FailedCountIn(vs ...int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldIn(FieldFailedCount, vs...))
+}
+
+// FailedCountNotIn applies the NotIn predicate on the "failed_count" field.
+func FailedCountNotIn(vs ...int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldNotIn(FieldFailedCount, vs...))
+}
+
+// FailedCountGT applies the GT predicate on the "failed_count" field.
+func FailedCountGT(v int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldGT(FieldFailedCount, v))
+}
+
+// FailedCountGTE applies the GTE predicate on the "failed_count" field.
+func FailedCountGTE(v int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldGTE(FieldFailedCount, v))
+}
+
+// FailedCountLT applies the LT predicate on the "failed_count" field.
+func FailedCountLT(v int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldLT(FieldFailedCount, v))
+}
+
+// FailedCountLTE applies the LTE predicate on the "failed_count" field.
+func FailedCountLTE(v int) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldLTE(FieldFailedCount, v))
+}
+
+// LastDeliveredAtEQ applies the EQ predicate on the "last_delivered_at" field.
+func LastDeliveredAtEQ(v time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldEQ(FieldLastDeliveredAt, v))
+}
+
+// LastDeliveredAtNEQ applies the NEQ predicate on the "last_delivered_at" field.
+func LastDeliveredAtNEQ(v time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldNEQ(FieldLastDeliveredAt, v))
+}
+
+// LastDeliveredAtIn applies the In predicate on the "last_delivered_at" field.
+func LastDeliveredAtIn(vs ...time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldIn(FieldLastDeliveredAt, vs...))
+}
+
+// LastDeliveredAtNotIn applies the NotIn predicate on the "last_delivered_at" field.
+func LastDeliveredAtNotIn(vs ...time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldNotIn(FieldLastDeliveredAt, vs...))
+}
+
+// LastDeliveredAtGT applies the GT predicate on the "last_delivered_at" field.
+func LastDeliveredAtGT(v time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldGT(FieldLastDeliveredAt, v))
+}
+
+// LastDeliveredAtGTE applies the GTE predicate on the "last_delivered_at" field.
+func LastDeliveredAtGTE(v time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldGTE(FieldLastDeliveredAt, v))
+}
+
+// LastDeliveredAtLT applies the LT predicate on the "last_delivered_at" field.
+func LastDeliveredAtLT(v time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldLT(FieldLastDeliveredAt, v))
+}
+
+// LastDeliveredAtLTE applies the LTE predicate on the "last_delivered_at" field.
+func LastDeliveredAtLTE(v time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldLTE(FieldLastDeliveredAt, v))
+}
+
+// LastDeliveredAtIsNil applies the IsNil predicate on the "last_delivered_at" field.
+func LastDeliveredAtIsNil() predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldIsNull(FieldLastDeliveredAt))
+}
+
+// LastDeliveredAtNotNil applies the NotNil predicate on the "last_delivered_at" field.
+func LastDeliveredAtNotNil() predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldNotNull(FieldLastDeliveredAt))
+}
+
+// LastFailedAtEQ applies the EQ predicate on the "last_failed_at" field.
+func LastFailedAtEQ(v time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldEQ(FieldLastFailedAt, v))
+}
+
+// LastFailedAtNEQ applies the NEQ predicate on the "last_failed_at" field.
+func LastFailedAtNEQ(v time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldNEQ(FieldLastFailedAt, v))
+}
+
+// LastFailedAtIn applies the In predicate on the "last_failed_at" field.
+func LastFailedAtIn(vs ...time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldIn(FieldLastFailedAt, vs...))
+}
+
+// LastFailedAtNotIn applies the NotIn predicate on the "last_failed_at" field.
+func LastFailedAtNotIn(vs ...time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldNotIn(FieldLastFailedAt, vs...))
+}
+
+// LastFailedAtGT applies the GT predicate on the "last_failed_at" field.
+func LastFailedAtGT(v time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldGT(FieldLastFailedAt, v))
+}
+
+// LastFailedAtGTE applies the GTE predicate on the "last_failed_at" field.
+func LastFailedAtGTE(v time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldGTE(FieldLastFailedAt, v))
+}
+
+// LastFailedAtLT applies the LT predicate on the "last_failed_at" field.
+func LastFailedAtLT(v time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldLT(FieldLastFailedAt, v))
+}
+
+// LastFailedAtLTE applies the LTE predicate on the "last_failed_at" field.
+func LastFailedAtLTE(v time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldLTE(FieldLastFailedAt, v))
+}
+
+// LastFailedAtIsNil applies the IsNil predicate on the "last_failed_at" field.
+func LastFailedAtIsNil() predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldIsNull(FieldLastFailedAt))
+}
+
+// LastFailedAtNotNil applies the NotNil predicate on the "last_failed_at" field.
+func LastFailedAtNotNil() predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldNotNull(FieldLastFailedAt))
+}
+
+// FailingSinceEQ applies the EQ predicate on the "failing_since" field.
+func FailingSinceEQ(v time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldEQ(FieldFailingSince, v))
+}
+
+// FailingSinceNEQ applies the NEQ predicate on the "failing_since" field.
+func FailingSinceNEQ(v time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldNEQ(FieldFailingSince, v))
+}
+
+// FailingSinceIn applies the In predicate on the "failing_since" field.
+func FailingSinceIn(vs ...time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldIn(FieldFailingSince, vs...))
+}
+
+// FailingSinceNotIn applies the NotIn predicate on the "failing_since" field.
+func FailingSinceNotIn(vs ...time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldNotIn(FieldFailingSince, vs...))
+}
+
+// FailingSinceGT applies the GT predicate on the "failing_since" field.
+func FailingSinceGT(v time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldGT(FieldFailingSince, v))
+}
+
+// FailingSinceGTE applies the GTE predicate on the "failing_since" field.
+func FailingSinceGTE(v time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldGTE(FieldFailingSince, v))
+}
+
+// FailingSinceLT applies the LT predicate on the "failing_since" field.
+func FailingSinceLT(v time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldLT(FieldFailingSince, v))
+}
+
+// FailingSinceLTE applies the LTE predicate on the "failing_since" field.
+func FailingSinceLTE(v time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldLTE(FieldFailingSince, v))
+}
+
+// FailingSinceIsNil applies the IsNil predicate on the "failing_since" field.
+func FailingSinceIsNil() predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldIsNull(FieldFailingSince))
+}
+
+// FailingSinceNotNil applies the NotNil predicate on the "failing_since" field.
+func FailingSinceNotNil() predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldNotNull(FieldFailingSince))
+}
+
+// DisabledAtEQ applies the EQ predicate on the "disabled_at" field.
+func DisabledAtEQ(v time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldEQ(FieldDisabledAt, v))
+}
+
+// DisabledAtNEQ applies the NEQ predicate on the "disabled_at" field.
+func DisabledAtNEQ(v time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldNEQ(FieldDisabledAt, v))
+}
+
+// DisabledAtIn applies the In predicate on the "disabled_at" field.
+func DisabledAtIn(vs ...time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldIn(FieldDisabledAt, vs...))
+}
+
+// DisabledAtNotIn applies the NotIn predicate on the "disabled_at" field.
+func DisabledAtNotIn(vs ...time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldNotIn(FieldDisabledAt, vs...))
+}
+
+// DisabledAtGT applies the GT predicate on the "disabled_at" field.
+func DisabledAtGT(v time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldGT(FieldDisabledAt, v))
+}
+
+// DisabledAtGTE applies the GTE predicate on the "disabled_at" field.
+func DisabledAtGTE(v time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldGTE(FieldDisabledAt, v))
+}
+
+// DisabledAtLT applies the LT predicate on the "disabled_at" field.
+func DisabledAtLT(v time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldLT(FieldDisabledAt, v))
+}
+
+// DisabledAtLTE applies the LTE predicate on the "disabled_at" field.
+func DisabledAtLTE(v time.Time) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldLTE(FieldDisabledAt, v))
+}
+
+// DisabledAtIsNil applies the IsNil predicate on the "disabled_at" field.
+func DisabledAtIsNil() predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldIsNull(FieldDisabledAt))
+}
+
+// DisabledAtNotNil applies the NotNil predicate on the "disabled_at" field.
+func DisabledAtNotNil() predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.FieldNotNull(FieldDisabledAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WebhookDestination) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WebhookDestination) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WebhookDestination) predicate.WebhookDestination {
+	return predicate.WebhookDestination(sql.NotPredicates(p))
+}
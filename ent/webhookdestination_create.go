@@ -0,0 +1,1479 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/webhookdestination"
+	"github.com/google/uuid"
+)
+
+// WebhookDestinationCreate is the builder for creating a WebhookDestination entity.
+type WebhookDestinationCreate struct {
+	config
+	mutation *WebhookDestinationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wdc *WebhookDestinationCreate) SetCreatedAt(t time.Time) *WebhookDestinationCreate {
+	wdc.mutation.SetCreatedAt(t)
+	return wdc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wdc *WebhookDestinationCreate) SetNillableCreatedAt(t *time.Time) *WebhookDestinationCreate {
+	if t != nil {
+		wdc.SetCreatedAt(*t)
+	}
+	return wdc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wdc *WebhookDestinationCreate) SetUpdatedAt(t time.Time) *WebhookDestinationCreate {
+	wdc.mutation.SetUpdatedAt(t)
+	return wdc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (wdc *WebhookDestinationCreate) SetNillableUpdatedAt(t *time.Time) *WebhookDestinationCreate {
+	if t != nil {
+		wdc.SetUpdatedAt(*t)
+	}
+	return wdc
+}
+
+// SetURL sets the "url" field.
+func (wdc *WebhookDestinationCreate) SetURL(s string) *WebhookDestinationCreate {
+	wdc.mutation.SetURL(s)
+	return wdc
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (wdc *WebhookDestinationCreate) SetMaxAttempts(i int) *WebhookDestinationCreate {
+	wdc.mutation.SetMaxAttempts(i)
+	return wdc
+}
+
+// SetNillableMaxAttempts sets the "max_attempts" field if the given value is not nil.
+func (wdc *WebhookDestinationCreate) SetNillableMaxAttempts(i *int) *WebhookDestinationCreate {
+	if i != nil {
+		wdc.SetMaxAttempts(*i)
+	}
+	return wdc
+}
+
+// SetBackoffSeconds sets the "backoff_seconds" field.
+func (wdc *WebhookDestinationCreate) SetBackoffSeconds(i int) *WebhookDestinationCreate {
+	wdc.mutation.SetBackoffSeconds(i)
+	return wdc
+}
+
+// SetNillableBackoffSeconds sets the "backoff_seconds" field if the given value is not nil.
+func (wdc *WebhookDestinationCreate) SetNillableBackoffSeconds(i *int) *WebhookDestinationCreate {
+	if i != nil {
+		wdc.SetBackoffSeconds(*i)
+	}
+	return wdc
+}
+
+// SetBackoffMultiplier sets the "backoff_multiplier" field.
+func (wdc *WebhookDestinationCreate) SetBackoffMultiplier(f float64) *WebhookDestinationCreate {
+	wdc.mutation.SetBackoffMultiplier(f)
+	return wdc
+}
+
+// SetNillableBackoffMultiplier sets the "backoff_multiplier" field if the given value is not nil.
+func (wdc *WebhookDestinationCreate) SetNillableBackoffMultiplier(f *float64) *WebhookDestinationCreate {
+	if f != nil {
+		wdc.SetBackoffMultiplier(*f)
+	}
+	return wdc
+}
+
+// SetTimeoutSeconds sets the "timeout_seconds" field.
+func (wdc *WebhookDestinationCreate) SetTimeoutSeconds(i int) *WebhookDestinationCreate {
+	wdc.mutation.SetTimeoutSeconds(i)
+	return wdc
+}
+
+// SetNillableTimeoutSeconds sets the "timeout_seconds" field if the given value is not nil.
+func (wdc *WebhookDestinationCreate) SetNillableTimeoutSeconds(i *int) *WebhookDestinationCreate {
+	if i != nil {
+		wdc.SetTimeoutSeconds(*i)
+	}
+	return wdc
+}
+
+// SetDeliveredCount sets the "delivered_count" field.
+func (wdc *WebhookDestinationCreate) SetDeliveredCount(i int) *WebhookDestinationCreate {
+	wdc.mutation.SetDeliveredCount(i)
+	return wdc
+}
+
+// SetNillableDeliveredCount sets the "delivered_count" field if the given value is not nil.
+func (wdc *WebhookDestinationCreate) SetNillableDeliveredCount(i *int) *WebhookDestinationCreate {
+	if i != nil {
+		wdc.SetDeliveredCount(*i)
+	}
+	return wdc
+}
+
+// SetFailedCount sets the "failed_count" field.
+func (wdc *WebhookDestinationCreate) SetFailedCount(i int) *WebhookDestinationCreate {
+	wdc.mutation.SetFailedCount(i)
+	return wdc
+}
+
+// SetNillableFailedCount sets the "failed_count" field if the given value is not nil.
+func (wdc *WebhookDestinationCreate) SetNillableFailedCount(i *int) *WebhookDestinationCreate {
+	if i != nil {
+		wdc.SetFailedCount(*i)
+	}
+	return wdc
+}
+
+// SetLastDeliveredAt sets the "last_delivered_at" field.
+func (wdc *WebhookDestinationCreate) SetLastDeliveredAt(t time.Time) *WebhookDestinationCreate {
+	wdc.mutation.SetLastDeliveredAt(t)
+	return wdc
+}
+
+// SetNillableLastDeliveredAt sets the "last_delivered_at" field if the given value is not nil.
+func (wdc *WebhookDestinationCreate) SetNillableLastDeliveredAt(t *time.Time) *WebhookDestinationCreate {
+	if t != nil {
+		wdc.SetLastDeliveredAt(*t)
+	}
+	return wdc
+}
+
+// SetLastFailedAt sets the "last_failed_at" field.
+func (wdc *WebhookDestinationCreate) SetLastFailedAt(t time.Time) *WebhookDestinationCreate {
+	wdc.mutation.SetLastFailedAt(t)
+	return wdc
+}
+
+// SetNillableLastFailedAt sets the "last_failed_at" field if the given value is not nil.
+func (wdc *WebhookDestinationCreate) SetNillableLastFailedAt(t *time.Time) *WebhookDestinationCreate {
+	if t != nil {
+		wdc.SetLastFailedAt(*t)
+	}
+	return wdc
+}
+
+// SetFailingSince sets the "failing_since" field.
+func (wdc *WebhookDestinationCreate) SetFailingSince(t time.Time) *WebhookDestinationCreate {
+	wdc.mutation.SetFailingSince(t)
+	return wdc
+}
+
+// SetNillableFailingSince sets the "failing_since" field if the given value is not nil.
+func (wdc *WebhookDestinationCreate) SetNillableFailingSince(t *time.Time) *WebhookDestinationCreate {
+	if t != nil {
+		wdc.SetFailingSince(*t)
+	}
+	return wdc
+}
+
+// SetDisabledAt sets the "disabled_at" field.
+func (wdc *WebhookDestinationCreate) SetDisabledAt(t time.Time) *WebhookDestinationCreate {
+	wdc.mutation.SetDisabledAt(t)
+	return wdc
+}
+
+// SetNillableDisabledAt sets the "disabled_at" field if the given value is not nil.
+func (wdc *WebhookDestinationCreate) SetNillableDisabledAt(t *time.Time) *WebhookDestinationCreate {
+	if t != nil {
+		wdc.SetDisabledAt(*t)
+	}
+	return wdc
+}
+
+// SetID sets the "id" field.
+func (wdc *WebhookDestinationCreate) SetID(u uuid.UUID) *WebhookDestinationCreate {
+	wdc.mutation.SetID(u)
+	return wdc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (wdc *WebhookDestinationCreate) SetNillableID(u *uuid.UUID) *WebhookDestinationCreate {
+	if u != nil {
+		wdc.SetID(*u)
+	}
+	return wdc
+}
+
+// Mutation returns the WebhookDestinationMutation object of the builder.
+func (wdc *WebhookDestinationCreate) Mutation() *WebhookDestinationMutation {
+	return wdc.mutation
+}
+
+// Save creates the WebhookDestination in the database.
+func (wdc *WebhookDestinationCreate) Save(ctx context.Context) (*WebhookDestination, error) {
+	wdc.defaults()
+	return withHooks(ctx, wdc.sqlSave, wdc.mutation, wdc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wdc *WebhookDestinationCreate) SaveX(ctx context.Context) *WebhookDestination {
+	v, err := wdc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wdc *WebhookDestinationCreate) Exec(ctx context.Context) error {
+	_, err := wdc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdc *WebhookDestinationCreate) ExecX(ctx context.Context) {
+	if err := wdc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wdc *WebhookDestinationCreate) defaults() {
+	if _, ok := wdc.mutation.CreatedAt(); !ok {
+		v := webhookdestination.DefaultCreatedAt()
+		wdc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := wdc.mutation.UpdatedAt(); !ok {
+		v := webhookdestination.DefaultUpdatedAt()
+		wdc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := wdc.mutation.DeliveredCount(); !ok {
+		v := webhookdestination.DefaultDeliveredCount
+		wdc.mutation.SetDeliveredCount(v)
+	}
+	if _, ok := wdc.mutation.FailedCount(); !ok {
+		v := webhookdestination.DefaultFailedCount
+		wdc.mutation.SetFailedCount(v)
+	}
+	if _, ok := wdc.mutation.ID(); !ok {
+		v := webhookdestination.DefaultID()
+		wdc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wdc *WebhookDestinationCreate) check() error {
+	if _, ok := wdc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WebhookDestination.created_at"`)}
+	}
+	if _, ok := wdc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "WebhookDestination.updated_at"`)}
+	}
+	if _, ok := wdc.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`ent: missing required field "WebhookDestination.url"`)}
+	}
+	if _, ok := wdc.mutation.DeliveredCount(); !ok {
+		return &ValidationError{Name: "delivered_count", err: errors.New(`ent: missing required field "WebhookDestination.delivered_count"`)}
+	}
+	if _, ok := wdc.mutation.FailedCount(); !ok {
+		return &ValidationError{Name: "failed_count", err: errors.New(`ent: missing required field "WebhookDestination.failed_count"`)}
+	}
+	return nil
+}
+
+func (wdc *WebhookDestinationCreate) sqlSave(ctx context.Context) (*WebhookDestination, error) {
+	if err := wdc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wdc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wdc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	wdc.mutation.id = &_node.ID
+	wdc.mutation.done = true
+	return _node, nil
+}
+
+func (wdc *WebhookDestinationCreate) createSpec() (*WebhookDestination, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WebhookDestination{config: wdc.config}
+		_spec = sqlgraph.NewCreateSpec(webhookdestination.Table, sqlgraph.NewFieldSpec(webhookdestination.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = wdc.conflict
+	if id, ok := wdc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := wdc.mutation.CreatedAt(); ok {
+		_spec.SetField(webhookdestination.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := wdc.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhookdestination.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := wdc.mutation.URL(); ok {
+		_spec.SetField(webhookdestination.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := wdc.mutation.MaxAttempts(); ok {
+		_spec.SetField(webhookdestination.FieldMaxAttempts, field.TypeInt, value)
+		_node.MaxAttempts = &value
+	}
+	if value, ok := wdc.mutation.BackoffSeconds(); ok {
+		_spec.SetField(webhookdestination.FieldBackoffSeconds, field.TypeInt, value)
+		_node.BackoffSeconds = &value
+	}
+	if value, ok := wdc.mutation.BackoffMultiplier(); ok {
+		_spec.SetField(webhookdestination.FieldBackoffMultiplier, field.TypeFloat64, value)
+		_node.BackoffMultiplier = &value
+	}
+	if value, ok := wdc.mutation.TimeoutSeconds(); ok {
+		_spec.SetField(webhookdestination.FieldTimeoutSeconds, field.TypeInt, value)
+		_node.TimeoutSeconds = &value
+	}
+	if value, ok := wdc.mutation.DeliveredCount(); ok {
+		_spec.SetField(webhookdestination.FieldDeliveredCount, field.TypeInt, value)
+		_node.DeliveredCount = value
+	}
+	if value, ok := wdc.mutation.FailedCount(); ok {
+		_spec.SetField(webhookdestination.FieldFailedCount, field.TypeInt, value)
+		_node.FailedCount = value
+	}
+	if value, ok := wdc.mutation.LastDeliveredAt(); ok {
+		_spec.SetField(webhookdestination.FieldLastDeliveredAt, field.TypeTime, value)
+		_node.LastDeliveredAt = value
+	}
+	if value, ok := wdc.mutation.LastFailedAt(); ok {
+		_spec.SetField(webhookdestination.FieldLastFailedAt, field.TypeTime, value)
+		_node.LastFailedAt = value
+	}
+	if value, ok := wdc.mutation.FailingSince(); ok {
+		_spec.SetField(webhookdestination.FieldFailingSince, field.TypeTime, value)
+		_node.FailingSince = &value
+	}
+	if value, ok := wdc.mutation.DisabledAt(); ok {
+		_spec.SetField(webhookdestination.FieldDisabledAt, field.TypeTime, value)
+		_node.DisabledAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WebhookDestination.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WebhookDestinationUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (wdc *WebhookDestinationCreate) OnConflict(opts ...sql.ConflictOption) *WebhookDestinationUpsertOne {
+	wdc.conflict = opts
+	return &WebhookDestinationUpsertOne{
+		create: wdc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WebhookDestination.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wdc *WebhookDestinationCreate) OnConflictColumns(columns ...string) *WebhookDestinationUpsertOne {
+	wdc.conflict = append(wdc.conflict, sql.ConflictColumns(columns...))
+	return &WebhookDestinationUpsertOne{
+		create: wdc,
+	}
+}
+
+type (
+	// WebhookDestinationUpsertOne is the builder for "upsert"-ing
+	//  one WebhookDestination node.
+	WebhookDestinationUpsertOne struct {
+		create *WebhookDestinationCreate
+	}
+
+	// WebhookDestinationUpsert is the "OnConflict" setter.
+	WebhookDestinationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WebhookDestinationUpsert) SetUpdatedAt(v time.Time) *WebhookDestinationUpsert {
+	u.Set(webhookdestination.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WebhookDestinationUpsert) UpdateUpdatedAt() *WebhookDestinationUpsert {
+	u.SetExcluded(webhookdestination.FieldUpdatedAt)
+	return u
+}
+
+// SetURL sets the "url" field.
+func (u *WebhookDestinationUpsert) SetURL(v string) *WebhookDestinationUpsert {
+	u.Set(webhookdestination.FieldURL, v)
+	return u
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *WebhookDestinationUpsert) UpdateURL() *WebhookDestinationUpsert {
+	u.SetExcluded(webhookdestination.FieldURL)
+	return u
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (u *WebhookDestinationUpsert) SetMaxAttempts(v int) *WebhookDestinationUpsert {
+	u.Set(webhookdestination.FieldMaxAttempts, v)
+	return u
+}
+
+// UpdateMaxAttempts sets the "max_attempts" field to the value that was provided on create.
+func (u *WebhookDestinationUpsert) UpdateMaxAttempts() *WebhookDestinationUpsert {
+	u.SetExcluded(webhookdestination.FieldMaxAttempts)
+	return u
+}
+
+// AddMaxAttempts adds v to the "max_attempts" field.
+func (u *WebhookDestinationUpsert) AddMaxAttempts(v int) *WebhookDestinationUpsert {
+	u.Add(webhookdestination.FieldMaxAttempts, v)
+	return u
+}
+
+// ClearMaxAttempts clears the value of the "max_attempts" field.
+func (u *WebhookDestinationUpsert) ClearMaxAttempts() *WebhookDestinationUpsert {
+	u.SetNull(webhookdestination.FieldMaxAttempts)
+	return u
+}
+
+// SetBackoffSeconds sets the "backoff_seconds" field.
+func (u *WebhookDestinationUpsert) SetBackoffSeconds(v int) *WebhookDestinationUpsert {
+	u.Set(webhookdestination.FieldBackoffSeconds, v)
+	return u
+}
+
+// UpdateBackoffSeconds sets the "backoff_seconds" field to the value that was provided on create.
+func (u *WebhookDestinationUpsert) UpdateBackoffSeconds() *WebhookDestinationUpsert {
+	u.SetExcluded(webhookdestination.FieldBackoffSeconds)
+	return u
+}
+
+// AddBackoffSeconds adds v to the "backoff_seconds" field.
+func (u *WebhookDestinationUpsert) AddBackoffSeconds(v int) *WebhookDestinationUpsert {
+	u.Add(webhookdestination.FieldBackoffSeconds, v)
+	return u
+}
+
+// ClearBackoffSeconds clears the value of the "backoff_seconds" field.
+func (u *WebhookDestinationUpsert) ClearBackoffSeconds() *WebhookDestinationUpsert {
+	u.SetNull(webhookdestination.FieldBackoffSeconds)
+	return u
+}
+
+// SetBackoffMultiplier sets the "backoff_multiplier" field.
+func (u *WebhookDestinationUpsert) SetBackoffMultiplier(v float64) *WebhookDestinationUpsert {
+	u.Set(webhookdestination.FieldBackoffMultiplier, v)
+	return u
+}
+
+// UpdateBackoffMultiplier sets the "backoff_multiplier" field to the value that was provided on create.
+func (u *WebhookDestinationUpsert) UpdateBackoffMultiplier() *WebhookDestinationUpsert {
+	u.SetExcluded(webhookdestination.FieldBackoffMultiplier)
+	return u
+}
+
+// AddBackoffMultiplier adds v to the "backoff_multiplier" field.
+func (u *WebhookDestinationUpsert) AddBackoffMultiplier(v float64) *WebhookDestinationUpsert {
+	u.Add(webhookdestination.FieldBackoffMultiplier, v)
+	return u
+}
+
+// ClearBackoffMultiplier clears the value of the "backoff_multiplier" field.
+func (u *WebhookDestinationUpsert) ClearBackoffMultiplier() *WebhookDestinationUpsert {
+	u.SetNull(webhookdestination.FieldBackoffMultiplier)
+	return u
+}
+
+// SetTimeoutSeconds sets the "timeout_seconds" field.
+func (u *WebhookDestinationUpsert) SetTimeoutSeconds(v int) *WebhookDestinationUpsert {
+	u.Set(webhookdestination.FieldTimeoutSeconds, v)
+	return u
+}
+
+// UpdateTimeoutSeconds sets the "timeout_seconds" field to the value that was provided on create.
+func (u *WebhookDestinationUpsert) UpdateTimeoutSeconds() *WebhookDestinationUpsert {
+	u.SetExcluded(webhookdestination.FieldTimeoutSeconds)
+	return u
+}
+
+// AddTimeoutSeconds adds v to the "timeout_seconds" field.
+func (u *WebhookDestinationUpsert) AddTimeoutSeconds(v int) *WebhookDestinationUpsert {
+	u.Add(webhookdestination.FieldTimeoutSeconds, v)
+	return u
+}
+
+// ClearTimeoutSeconds clears the value of the "timeout_seconds" field.
+func (u *WebhookDestinationUpsert) ClearTimeoutSeconds() *WebhookDestinationUpsert {
+	u.SetNull(webhookdestination.FieldTimeoutSeconds)
+	return u
+}
+
+// SetDeliveredCount sets the "delivered_count" field.
+func (u *WebhookDestinationUpsert) SetDeliveredCount(v int) *WebhookDestinationUpsert {
+	u.Set(webhookdestination.FieldDeliveredCount, v)
+	return u
+}
+
+// UpdateDeliveredCount sets the "delivered_count" field to the value that was provided on create.
+func (u *WebhookDestinationUpsert) UpdateDeliveredCount() *WebhookDestinationUpsert {
+	u.SetExcluded(webhookdestination.FieldDeliveredCount)
+	return u
+}
+
+// AddDeliveredCount adds v to the "delivered_count" field.
+func (u *WebhookDestinationUpsert) AddDeliveredCount(v int) *WebhookDestinationUpsert {
+	u.Add(webhookdestination.FieldDeliveredCount, v)
+	return u
+}
+
+// SetFailedCount sets the "failed_count" field.
+func (u *WebhookDestinationUpsert) SetFailedCount(v int) *WebhookDestinationUpsert {
+	u.Set(webhookdestination.FieldFailedCount, v)
+	return u
+}
+
+// UpdateFailedCount sets the "failed_count" field to the value that was provided on create.
+func (u *WebhookDestinationUpsert) UpdateFailedCount() *WebhookDestinationUpsert {
+	u.SetExcluded(webhookdestination.FieldFailedCount)
+	return u
+}
+
+// AddFailedCount adds v to the "failed_count" field.
+func (u *WebhookDestinationUpsert) AddFailedCount(v int) *WebhookDestinationUpsert {
+	u.Add(webhookdestination.FieldFailedCount, v)
+	return u
+}
+
+// SetLastDeliveredAt sets the "last_delivered_at" field.
+func (u *WebhookDestinationUpsert) SetLastDeliveredAt(v time.Time) *WebhookDestinationUpsert {
+	u.Set(webhookdestination.FieldLastDeliveredAt, v)
+	return u
+}
+
+// UpdateLastDeliveredAt sets the "last_delivered_at" field to the value that was provided on create.
+func (u *WebhookDestinationUpsert) UpdateLastDeliveredAt() *WebhookDestinationUpsert {
+	u.SetExcluded(webhookdestination.FieldLastDeliveredAt)
+	return u
+}
+
+// ClearLastDeliveredAt clears the value of the "last_delivered_at" field.
+func (u *WebhookDestinationUpsert) ClearLastDeliveredAt() *WebhookDestinationUpsert {
+	u.SetNull(webhookdestination.FieldLastDeliveredAt)
+	return u
+}
+
+// SetLastFailedAt sets the "last_failed_at" field.
+func (u *WebhookDestinationUpsert) SetLastFailedAt(v time.Time) *WebhookDestinationUpsert {
+	u.Set(webhookdestination.FieldLastFailedAt, v)
+	return u
+}
+
+// UpdateLastFailedAt sets the "last_failed_at" field to the value that was provided on create.
+func (u *WebhookDestinationUpsert) UpdateLastFailedAt() *WebhookDestinationUpsert {
+	u.SetExcluded(webhookdestination.FieldLastFailedAt)
+	return u
+}
+
+// ClearLastFailedAt clears the value of the "last_failed_at" field.
+func (u *WebhookDestinationUpsert) ClearLastFailedAt() *WebhookDestinationUpsert {
+	u.SetNull(webhookdestination.FieldLastFailedAt)
+	return u
+}
+
+// SetFailingSince sets the "failing_since" field.
+func (u *WebhookDestinationUpsert) SetFailingSince(v time.Time) *WebhookDestinationUpsert {
+	u.Set(webhookdestination.FieldFailingSince, v)
+	return u
+}
+
+// UpdateFailingSince sets the "failing_since" field to the value that was provided on create.
+func (u *WebhookDestinationUpsert) UpdateFailingSince() *WebhookDestinationUpsert {
+	u.SetExcluded(webhookdestination.FieldFailingSince)
+	return u
+}
+
+// ClearFailingSince clears the value of the "failing_since" field.
+func (u *WebhookDestinationUpsert) ClearFailingSince() *WebhookDestinationUpsert {
+	u.SetNull(webhookdestination.FieldFailingSince)
+	return u
+}
+
+// SetDisabledAt sets the "disabled_at" field.
+func (u *WebhookDestinationUpsert) SetDisabledAt(v time.Time) *WebhookDestinationUpsert {
+	u.Set(webhookdestination.FieldDisabledAt, v)
+	return u
+}
+
+// UpdateDisabledAt sets the "disabled_at" field to the value that was provided on create.
+func (u *WebhookDestinationUpsert) UpdateDisabledAt() *WebhookDestinationUpsert {
+	u.SetExcluded(webhookdestination.FieldDisabledAt)
+	return u
+}
+
+// ClearDisabledAt clears the value of the "disabled_at" field.
+func (u *WebhookDestinationUpsert) ClearDisabledAt() *WebhookDestinationUpsert {
+	u.SetNull(webhookdestination.FieldDisabledAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.WebhookDestination.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(webhookdestination.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WebhookDestinationUpsertOne) UpdateNewValues() *WebhookDestinationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(webhookdestination.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(webhookdestination.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WebhookDestination.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *WebhookDestinationUpsertOne) Ignore() *WebhookDestinationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WebhookDestinationUpsertOne) DoNothing() *WebhookDestinationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WebhookDestinationCreate.OnConflict
+// documentation for more info.
+func (u *WebhookDestinationUpsertOne) Update(set func(*WebhookDestinationUpsert)) *WebhookDestinationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WebhookDestinationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WebhookDestinationUpsertOne) SetUpdatedAt(v time.Time) *WebhookDestinationUpsertOne {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WebhookDestinationUpsertOne) UpdateUpdatedAt() *WebhookDestinationUpsertOne {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *WebhookDestinationUpsertOne) SetURL(v string) *WebhookDestinationUpsertOne {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *WebhookDestinationUpsertOne) UpdateURL() *WebhookDestinationUpsertOne {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (u *WebhookDestinationUpsertOne) SetMaxAttempts(v int) *WebhookDestinationUpsertOne {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.SetMaxAttempts(v)
+	})
+}
+
+// AddMaxAttempts adds v to the "max_attempts" field.
+func (u *WebhookDestinationUpsertOne) AddMaxAttempts(v int) *WebhookDestinationUpsertOne {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.AddMaxAttempts(v)
+	})
+}
+
+// UpdateMaxAttempts sets the "max_attempts" field to the value that was provided on create.
+func (u *WebhookDestinationUpsertOne) UpdateMaxAttempts() *WebhookDestinationUpsertOne {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.UpdateMaxAttempts()
+	})
+}
+
+// ClearMaxAttempts clears the value of the "max_attempts" field.
+func (u *WebhookDestinationUpsertOne) ClearMaxAttempts() *WebhookDestinationUpsertOne {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.ClearMaxAttempts()
+	})
+}
+
+// SetBackoffSeconds sets the "backoff_seconds" field.
+func (u *WebhookDestinationUpsertOne) SetBackoffSeconds(v int) *WebhookDestinationUpsertOne {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.SetBackoffSeconds(v)
+	})
+}
+
+// AddBackoffSeconds adds v to the "backoff_seconds" field.
+func (u *WebhookDestinationUpsertOne) AddBackoffSeconds(v int) *WebhookDestinationUpsertOne {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.AddBackoffSeconds(v)
+	})
+}
+
+// UpdateBackoffSeconds sets the "backoff_seconds" field to the value that was provided on create.
+func (u *WebhookDestinationUpsertOne) UpdateBackoffSeconds() *WebhookDestinationUpsertOne {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.UpdateBackoffSeconds()
+	})
+}
+
+// ClearBackoffSeconds clears the value of the "backoff_seconds" field.
+func (u *WebhookDestinationUpsertOne) ClearBackoffSeconds() *WebhookDestinationUpsertOne {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.ClearBackoffSeconds()
+	})
+}
+
+// SetBackoffMultiplier sets the "backoff_multiplier" field.
+func (u *WebhookDestinationUpsertOne) SetBackoffMultiplier(v float64) *WebhookDestinationUpsertOne {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.SetBackoffMultiplier(v)
+	})
+}
+
+// AddBackoffMultiplier adds v to the "backoff_multiplier" field.
+func (u *WebhookDestinationUpsertOne) AddBackoffMultiplier(v float64) *WebhookDestinationUpsertOne {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.AddBackoffMultiplier(v)
+	})
+}
+
+// UpdateBackoffMultiplier sets the "backoff_multiplier" field to the value that was provided on create.
+func (u *WebhookDestinationUpsertOne) UpdateBackoffMultiplier() *WebhookDestinationUpsertOne {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.UpdateBackoffMultiplier()
+	})
+}
+
+// ClearBackoffMultiplier clears the value of the "backoff_multiplier" field.
+func (u *WebhookDestinationUpsertOne) ClearBackoffMultiplier() *WebhookDestinationUpsertOne {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.ClearBackoffMultiplier()
+	})
+}
+
+// SetTimeoutSeconds sets the "timeout_seconds" field.
+func (u *WebhookDestinationUpsertOne) SetTimeoutSeconds(v int) *WebhookDestinationUpsertOne {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.SetTimeoutSeconds(v)
+	})
+}
+
+// AddTimeoutSeconds adds v to the "timeout_seconds" field.
+func (u *WebhookDestinationUpsertOne) AddTimeoutSeconds(v int) *WebhookDestinationUpsertOne {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.AddTimeoutSeconds(v)
+	})
+}
+
+// UpdateTimeoutSeconds sets the "timeout_seconds" field to the value that was provided on create.
+func (u *WebhookDestinationUpsertOne) UpdateTimeoutSeconds() *WebhookDestinationUpsertOne {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.UpdateTimeoutSeconds()
+	})
+}
+
+// ClearTimeoutSeconds clears the value of the "timeout_seconds" field.
+func (u *WebhookDestinationUpsertOne) ClearTimeoutSeconds() *WebhookDestinationUpsertOne {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.ClearTimeoutSeconds()
+	})
+}
+
+// SetDeliveredCount sets the "delivered_count" field.
+func (u *WebhookDestinationUpsertOne) SetDeliveredCount(v int) *WebhookDestinationUpsertOne {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.SetDeliveredCount(v)
+	})
+}
+
+// AddDeliveredCount adds v to the "delivered_count" field.
+func (u *WebhookDestinationUpsertOne) AddDeliveredCount(v int) *WebhookDestinationUpsertOne {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.AddDeliveredCount(v)
+	})
+}
+
+// UpdateDeliveredCount sets the "delivered_count" field to the value that was provided on create.
+func (u *WebhookDestinationUpsertOne) UpdateDeliveredCount() *WebhookDestinationUpsertOne {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.UpdateDeliveredCount()
+	})
+}
+
+// SetFailedCount sets the "failed_count" field.
+func (u *WebhookDestinationUpsertOne) SetFailedCount(v int) *WebhookDestinationUpsertOne {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.SetFailedCount(v)
+	})
+}
+
+// AddFailedCount adds v to the "failed_count" field.
+func (u *WebhookDestinationUpsertOne) AddFailedCount(v int) *WebhookDestinationUpsertOne {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.AddFailedCount(v)
+	})
+}
+
+// UpdateFailedCount sets the "failed_count" field to the value that was provided on create.
+func (u *WebhookDestinationUpsertOne) UpdateFailedCount() *WebhookDestinationUpsertOne {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.UpdateFailedCount()
+	})
+}
+
+// SetLastDeliveredAt sets the "last_delivered_at" field.
+func (u *WebhookDestinationUpsertOne) SetLastDeliveredAt(v time.Time) *WebhookDestinationUpsertOne {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.SetLastDeliveredAt(v)
+	})
+}
+
+// UpdateLastDeliveredAt sets the "last_delivered_at" field to the value that was provided on create.
+func (u *WebhookDestinationUpsertOne) UpdateLastDeliveredAt() *WebhookDestinationUpsertOne {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.UpdateLastDeliveredAt()
+	})
+}
+
+// ClearLastDeliveredAt clears the value of the "last_delivered_at" field.
+func (u *WebhookDestinationUpsertOne) ClearLastDeliveredAt() *WebhookDestinationUpsertOne {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.ClearLastDeliveredAt()
+	})
+}
+
+// SetLastFailedAt sets the "last_failed_at" field.
+func (u *WebhookDestinationUpsertOne) SetLastFailedAt(v time.Time) *WebhookDestinationUpsertOne {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.SetLastFailedAt(v)
+	})
+}
+
+// UpdateLastFailedAt sets the "last_failed_at" field to the value that was provided on create.
+func (u *WebhookDestinationUpsertOne) UpdateLastFailedAt() *WebhookDestinationUpsertOne {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.UpdateLastFailedAt()
+	})
+}
+
+// ClearLastFailedAt clears the value of the "last_failed_at" field.
+func (u *WebhookDestinationUpsertOne) ClearLastFailedAt() *WebhookDestinationUpsertOne {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.ClearLastFailedAt()
+	})
+}
+
+// SetFailingSince sets the "failing_since" field.
+func (u *WebhookDestinationUpsertOne) SetFailingSince(v time.Time) *WebhookDestinationUpsertOne {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.SetFailingSince(v)
+	})
+}
+
+// UpdateFailingSince sets the "failing_since" field to the value that was provided on create.
+func (u *WebhookDestinationUpsertOne) UpdateFailingSince() *WebhookDestinationUpsertOne {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.UpdateFailingSince()
+	})
+}
+
+// ClearFailingSince clears the value of the "failing_since" field.
+func (u *WebhookDestinationUpsertOne) ClearFailingSince() *WebhookDestinationUpsertOne {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.ClearFailingSince()
+	})
+}
+
+// SetDisabledAt sets the "disabled_at" field.
+func (u *WebhookDestinationUpsertOne) SetDisabledAt(v time.Time) *WebhookDestinationUpsertOne {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.SetDisabledAt(v)
+	})
+}
+
+// UpdateDisabledAt sets the "disabled_at" field to the value that was provided on create.
+func (u *WebhookDestinationUpsertOne) UpdateDisabledAt() *WebhookDestinationUpsertOne {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.UpdateDisabledAt()
+	})
+}
+
+// ClearDisabledAt clears the value of the "disabled_at" field.
+func (u *WebhookDestinationUpsertOne) ClearDisabledAt() *WebhookDestinationUpsertOne {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.ClearDisabledAt()
+	})
+}
+
+// Exec executes the query.
+func (u *WebhookDestinationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WebhookDestinationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WebhookDestinationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *WebhookDestinationUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: WebhookDestinationUpsertOne.ID is not supported by MySQL driver. Use WebhookDestinationUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *WebhookDestinationUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// WebhookDestinationCreateBulk is the builder for creating many WebhookDestination entities in bulk.
+type WebhookDestinationCreateBulk struct {
+	config
+	err      error
+	builders []*WebhookDestinationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the WebhookDestination entities in the database.
+func (wdcb *WebhookDestinationCreateBulk) Save(ctx context.Context) ([]*WebhookDestination, error) {
+	if wdcb.err != nil {
+		return nil, wdcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wdcb.builders))
+	nodes := make([]*WebhookDestination, len(wdcb.builders))
+	mutators := make([]Mutator, len(wdcb.builders))
+	for i := range wdcb.builders {
+		func(i int, root context.Context) {
+			builder := wdcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebhookDestinationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wdcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = wdcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wdcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wdcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wdcb *WebhookDestinationCreateBulk) SaveX(ctx context.Context) []*WebhookDestination {
+	v, err := wdcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wdcb *WebhookDestinationCreateBulk) Exec(ctx context.Context) error {
+	_, err := wdcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdcb *WebhookDestinationCreateBulk) ExecX(ctx context.Context) {
+	if err := wdcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WebhookDestination.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WebhookDestinationUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (wdcb *WebhookDestinationCreateBulk) OnConflict(opts ...sql.ConflictOption) *WebhookDestinationUpsertBulk {
+	wdcb.conflict = opts
+	return &WebhookDestinationUpsertBulk{
+		create: wdcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WebhookDestination.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wdcb *WebhookDestinationCreateBulk) OnConflictColumns(columns ...string) *WebhookDestinationUpsertBulk {
+	wdcb.conflict = append(wdcb.conflict, sql.ConflictColumns(columns...))
+	return &WebhookDestinationUpsertBulk{
+		create: wdcb,
+	}
+}
+
+// WebhookDestinationUpsertBulk is the builder for "upsert"-ing
+// a bulk of WebhookDestination nodes.
+type WebhookDestinationUpsertBulk struct {
+	create *WebhookDestinationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.WebhookDestination.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(webhookdestination.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WebhookDestinationUpsertBulk) UpdateNewValues() *WebhookDestinationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(webhookdestination.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(webhookdestination.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WebhookDestination.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *WebhookDestinationUpsertBulk) Ignore() *WebhookDestinationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WebhookDestinationUpsertBulk) DoNothing() *WebhookDestinationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WebhookDestinationCreateBulk.OnConflict
+// documentation for more info.
+func (u *WebhookDestinationUpsertBulk) Update(set func(*WebhookDestinationUpsert)) *WebhookDestinationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WebhookDestinationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WebhookDestinationUpsertBulk) SetUpdatedAt(v time.Time) *WebhookDestinationUpsertBulk {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WebhookDestinationUpsertBulk) UpdateUpdatedAt() *WebhookDestinationUpsertBulk {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *WebhookDestinationUpsertBulk) SetURL(v string) *WebhookDestinationUpsertBulk {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *WebhookDestinationUpsertBulk) UpdateURL() *WebhookDestinationUpsertBulk {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (u *WebhookDestinationUpsertBulk) SetMaxAttempts(v int) *WebhookDestinationUpsertBulk {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.SetMaxAttempts(v)
+	})
+}
+
+// AddMaxAttempts adds v to the "max_attempts" field.
+func (u *WebhookDestinationUpsertBulk) AddMaxAttempts(v int) *WebhookDestinationUpsertBulk {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.AddMaxAttempts(v)
+	})
+}
+
+// UpdateMaxAttempts sets the "max_attempts" field to the value that was provided on create.
+func (u *WebhookDestinationUpsertBulk) UpdateMaxAttempts() *WebhookDestinationUpsertBulk {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.UpdateMaxAttempts()
+	})
+}
+
+// ClearMaxAttempts clears the value of the "max_attempts" field.
+func (u *WebhookDestinationUpsertBulk) ClearMaxAttempts() *WebhookDestinationUpsertBulk {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.ClearMaxAttempts()
+	})
+}
+
+// SetBackoffSeconds sets the "backoff_seconds" field.
+func (u *WebhookDestinationUpsertBulk) SetBackoffSeconds(v int) *WebhookDestinationUpsertBulk {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.SetBackoffSeconds(v)
+	})
+}
+
+// AddBackoffSeconds adds v to the "backoff_seconds" field.
+func (u *WebhookDestinationUpsertBulk) AddBackoffSeconds(v int) *WebhookDestinationUpsertBulk {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.AddBackoffSeconds(v)
+	})
+}
+
+// UpdateBackoffSeconds sets the "backoff_seconds" field to the value that was provided on create.
+func (u *WebhookDestinationUpsertBulk) UpdateBackoffSeconds() *WebhookDestinationUpsertBulk {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.UpdateBackoffSeconds()
+	})
+}
+
+// ClearBackoffSeconds clears the value of the "backoff_seconds" field.
+func (u *WebhookDestinationUpsertBulk) ClearBackoffSeconds() *WebhookDestinationUpsertBulk {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.ClearBackoffSeconds()
+	})
+}
+
+// SetBackoffMultiplier sets the "backoff_multiplier" field.
+func (u *WebhookDestinationUpsertBulk) SetBackoffMultiplier(v float64) *WebhookDestinationUpsertBulk {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.SetBackoffMultiplier(v)
+	})
+}
+
+// AddBackoffMultiplier adds v to the "backoff_multiplier" field.
+func (u *WebhookDestinationUpsertBulk) AddBackoffMultiplier(v float64) *WebhookDestinationUpsertBulk {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.AddBackoffMultiplier(v)
+	})
+}
+
+// UpdateBackoffMultiplier sets the "backoff_multiplier" field to the value that was provided on create.
+func (u *WebhookDestinationUpsertBulk) UpdateBackoffMultiplier() *WebhookDestinationUpsertBulk {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.UpdateBackoffMultiplier()
+	})
+}
+
+// ClearBackoffMultiplier clears the value of the "backoff_multiplier" field.
+func (u *WebhookDestinationUpsertBulk) ClearBackoffMultiplier() *WebhookDestinationUpsertBulk {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.ClearBackoffMultiplier()
+	})
+}
+
+// SetTimeoutSeconds sets the "timeout_seconds" field.
+func (u *WebhookDestinationUpsertBulk) SetTimeoutSeconds(v int) *WebhookDestinationUpsertBulk {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.SetTimeoutSeconds(v)
+	})
+}
+
+// AddTimeoutSeconds adds v to the "timeout_seconds" field.
+func (u *WebhookDestinationUpsertBulk) AddTimeoutSeconds(v int) *WebhookDestinationUpsertBulk {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.AddTimeoutSeconds(v)
+	})
+}
+
+// UpdateTimeoutSeconds sets the "timeout_seconds" field to the value that was provided on create.
+func (u *WebhookDestinationUpsertBulk) UpdateTimeoutSeconds() *WebhookDestinationUpsertBulk {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.UpdateTimeoutSeconds()
+	})
+}
+
+// ClearTimeoutSeconds clears the value of the "timeout_seconds" field.
+func (u *WebhookDestinationUpsertBulk) ClearTimeoutSeconds() *WebhookDestinationUpsertBulk {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.ClearTimeoutSeconds()
+	})
+}
+
+// SetDeliveredCount sets the "delivered_count" field.
+func (u *WebhookDestinationUpsertBulk) SetDeliveredCount(v int) *WebhookDestinationUpsertBulk {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.SetDeliveredCount(v)
+	})
+}
+
+// AddDeliveredCount adds v to the "delivered_count" field.
+func (u *WebhookDestinationUpsertBulk) AddDeliveredCount(v int) *WebhookDestinationUpsertBulk {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.AddDeliveredCount(v)
+	})
+}
+
+// UpdateDeliveredCount sets the "delivered_count" field to the value that was provided on create.
+func (u *WebhookDestinationUpsertBulk) UpdateDeliveredCount() *WebhookDestinationUpsertBulk {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.UpdateDeliveredCount()
+	})
+}
+
+// SetFailedCount sets the "failed_count" field.
+func (u *WebhookDestinationUpsertBulk) SetFailedCount(v int) *WebhookDestinationUpsertBulk {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.SetFailedCount(v)
+	})
+}
+
+// AddFailedCount adds v to the "failed_count" field.
+func (u *WebhookDestinationUpsertBulk) AddFailedCount(v int) *WebhookDestinationUpsertBulk {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.AddFailedCount(v)
+	})
+}
+
+// UpdateFailedCount sets the "failed_count" field to the value that was provided on create.
+func (u *WebhookDestinationUpsertBulk) UpdateFailedCount() *WebhookDestinationUpsertBulk {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.UpdateFailedCount()
+	})
+}
+
+// SetLastDeliveredAt sets the "last_delivered_at" field.
+func (u *WebhookDestinationUpsertBulk) SetLastDeliveredAt(v time.Time) *WebhookDestinationUpsertBulk {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.SetLastDeliveredAt(v)
+	})
+}
+
+// UpdateLastDeliveredAt sets the "last_delivered_at" field to the value that was provided on create.
+func (u *WebhookDestinationUpsertBulk) UpdateLastDeliveredAt() *WebhookDestinationUpsertBulk {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.UpdateLastDeliveredAt()
+	})
+}
+
+// ClearLastDeliveredAt clears the value of the "last_delivered_at" field.
+func (u *WebhookDestinationUpsertBulk) ClearLastDeliveredAt() *WebhookDestinationUpsertBulk {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.ClearLastDeliveredAt()
+	})
+}
+
+// SetLastFailedAt sets the "last_failed_at" field.
+func (u *WebhookDestinationUpsertBulk) SetLastFailedAt(v time.Time) *WebhookDestinationUpsertBulk {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.SetLastFailedAt(v)
+	})
+}
+
+// UpdateLastFailedAt sets the "last_failed_at" field to the value that was provided on create.
+func (u *WebhookDestinationUpsertBulk) UpdateLastFailedAt() *WebhookDestinationUpsertBulk {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.UpdateLastFailedAt()
+	})
+}
+
+// ClearLastFailedAt clears the value of the "last_failed_at" field.
+func (u *WebhookDestinationUpsertBulk) ClearLastFailedAt() *WebhookDestinationUpsertBulk {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.ClearLastFailedAt()
+	})
+}
+
+// SetFailingSince sets the "failing_since" field.
+func (u *WebhookDestinationUpsertBulk) SetFailingSince(v time.Time) *WebhookDestinationUpsertBulk {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.SetFailingSince(v)
+	})
+}
+
+// UpdateFailingSince sets the "failing_since" field to the value that was provided on create.
+func (u *WebhookDestinationUpsertBulk) UpdateFailingSince() *WebhookDestinationUpsertBulk {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.UpdateFailingSince()
+	})
+}
+
+// ClearFailingSince clears the value of the "failing_since" field.
+func (u *WebhookDestinationUpsertBulk) ClearFailingSince() *WebhookDestinationUpsertBulk {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.ClearFailingSince()
+	})
+}
+
+// SetDisabledAt sets the "disabled_at" field.
+func (u *WebhookDestinationUpsertBulk) SetDisabledAt(v time.Time) *WebhookDestinationUpsertBulk {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.SetDisabledAt(v)
+	})
+}
+
+// UpdateDisabledAt sets the "disabled_at" field to the value that was provided on create.
+func (u *WebhookDestinationUpsertBulk) UpdateDisabledAt() *WebhookDestinationUpsertBulk {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.UpdateDisabledAt()
+	})
+}
+
+// ClearDisabledAt clears the value of the "disabled_at" field.
+func (u *WebhookDestinationUpsertBulk) ClearDisabledAt() *WebhookDestinationUpsertBulk {
+	return u.Update(func(s *WebhookDestinationUpsert) {
+		s.ClearDisabledAt()
+	})
+}
+
+// Exec executes the query.
+func (u *WebhookDestinationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the WebhookDestinationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WebhookDestinationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WebhookDestinationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
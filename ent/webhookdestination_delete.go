@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/NEDA-LABS/stablenode/ent/webhookdestination"
+)
+
+// WebhookDestinationDelete is the builder for deleting a WebhookDestination entity.
+type WebhookDestinationDelete struct {
+	config
+	hooks    []Hook
+	mutation *WebhookDestinationMutation
+}
+
+// Where appends a list predicates to the WebhookDestinationDelete builder.
+func (wdd *WebhookDestinationDelete) Where(ps ...predicate.WebhookDestination) *WebhookDestinationDelete {
+	wdd.mutation.Where(ps...)
+	return wdd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (wdd *WebhookDestinationDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, wdd.sqlExec, wdd.mutation, wdd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdd *WebhookDestinationDelete) ExecX(ctx context.Context) int {
+	n, err := wdd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (wdd *WebhookDestinationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(webhookdestination.Table, sqlgraph.NewFieldSpec(webhookdestination.FieldID, field.TypeUUID))
+	if ps := wdd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, wdd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	wdd.mutation.done = true
+	return affected, err
+}
+
+// WebhookDestinationDeleteOne is the builder for deleting a single WebhookDestination entity.
+type WebhookDestinationDeleteOne struct {
+	wdd *WebhookDestinationDelete
+}
+
+// Where appends a list predicates to the WebhookDestinationDelete builder.
+func (wddo *WebhookDestinationDeleteOne) Where(ps ...predicate.WebhookDestination) *WebhookDestinationDeleteOne {
+	wddo.wdd.mutation.Where(ps...)
+	return wddo
+}
+
+// Exec executes the deletion query.
+func (wddo *WebhookDestinationDeleteOne) Exec(ctx context.Context) error {
+	n, err := wddo.wdd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{webhookdestination.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wddo *WebhookDestinationDeleteOne) ExecX(ctx context.Context) {
+	if err := wddo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
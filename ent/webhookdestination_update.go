@@ -0,0 +1,850 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/NEDA-LABS/stablenode/ent/webhookdestination"
+)
+
+// WebhookDestinationUpdate is the builder for updating WebhookDestination entities.
+type WebhookDestinationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WebhookDestinationMutation
+}
+
+// Where appends a list predicates to the WebhookDestinationUpdate builder.
+func (wdu *WebhookDestinationUpdate) Where(ps ...predicate.WebhookDestination) *WebhookDestinationUpdate {
+	wdu.mutation.Where(ps...)
+	return wdu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wdu *WebhookDestinationUpdate) SetUpdatedAt(t time.Time) *WebhookDestinationUpdate {
+	wdu.mutation.SetUpdatedAt(t)
+	return wdu
+}
+
+// SetURL sets the "url" field.
+func (wdu *WebhookDestinationUpdate) SetURL(s string) *WebhookDestinationUpdate {
+	wdu.mutation.SetURL(s)
+	return wdu
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (wdu *WebhookDestinationUpdate) SetNillableURL(s *string) *WebhookDestinationUpdate {
+	if s != nil {
+		wdu.SetURL(*s)
+	}
+	return wdu
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (wdu *WebhookDestinationUpdate) SetMaxAttempts(i int) *WebhookDestinationUpdate {
+	wdu.mutation.ResetMaxAttempts()
+	wdu.mutation.SetMaxAttempts(i)
+	return wdu
+}
+
+// SetNillableMaxAttempts sets the "max_attempts" field if the given value is not nil.
+func (wdu *WebhookDestinationUpdate) SetNillableMaxAttempts(i *int) *WebhookDestinationUpdate {
+	if i != nil {
+		wdu.SetMaxAttempts(*i)
+	}
+	return wdu
+}
+
+// AddMaxAttempts adds i to the "max_attempts" field.
+func (wdu *WebhookDestinationUpdate) AddMaxAttempts(i int) *WebhookDestinationUpdate {
+	wdu.mutation.AddMaxAttempts(i)
+	return wdu
+}
+
+// ClearMaxAttempts clears the value of the "max_attempts" field.
+func (wdu *WebhookDestinationUpdate) ClearMaxAttempts() *WebhookDestinationUpdate {
+	wdu.mutation.ClearMaxAttempts()
+	return wdu
+}
+
+// SetBackoffSeconds sets the "backoff_seconds" field.
+func (wdu *WebhookDestinationUpdate) SetBackoffSeconds(i int) *WebhookDestinationUpdate {
+	wdu.mutation.ResetBackoffSeconds()
+	wdu.mutation.SetBackoffSeconds(i)
+	return wdu
+}
+
+// SetNillableBackoffSeconds sets the "backoff_seconds" field if the given value is not nil.
+func (wdu *WebhookDestinationUpdate) SetNillableBackoffSeconds(i *int) *WebhookDestinationUpdate {
+	if i != nil {
+		wdu.SetBackoffSeconds(*i)
+	}
+	return wdu
+}
+
+// AddBackoffSeconds adds i to the "backoff_seconds" field.
+func (wdu *WebhookDestinationUpdate) AddBackoffSeconds(i int) *WebhookDestinationUpdate {
+	wdu.mutation.AddBackoffSeconds(i)
+	return wdu
+}
+
+// ClearBackoffSeconds clears the value of the "backoff_seconds" field.
+func (wdu *WebhookDestinationUpdate) ClearBackoffSeconds() *WebhookDestinationUpdate {
+	wdu.mutation.ClearBackoffSeconds()
+	return wdu
+}
+
+// SetBackoffMultiplier sets the "backoff_multiplier" field.
+func (wdu *WebhookDestinationUpdate) SetBackoffMultiplier(f float64) *WebhookDestinationUpdate {
+	wdu.mutation.ResetBackoffMultiplier()
+	wdu.mutation.SetBackoffMultiplier(f)
+	return wdu
+}
+
+// SetNillableBackoffMultiplier sets the "backoff_multiplier" field if the given value is not nil.
+func (wdu *WebhookDestinationUpdate) SetNillableBackoffMultiplier(f *float64) *WebhookDestinationUpdate {
+	if f != nil {
+		wdu.SetBackoffMultiplier(*f)
+	}
+	return wdu
+}
+
+// AddBackoffMultiplier adds f to the "backoff_multiplier" field.
+func (wdu *WebhookDestinationUpdate) AddBackoffMultiplier(f float64) *WebhookDestinationUpdate {
+	wdu.mutation.AddBackoffMultiplier(f)
+	return wdu
+}
+
+// ClearBackoffMultiplier clears the value of the "backoff_multiplier" field.
+func (wdu *WebhookDestinationUpdate) ClearBackoffMultiplier() *WebhookDestinationUpdate {
+	wdu.mutation.ClearBackoffMultiplier()
+	return wdu
+}
+
+// SetTimeoutSeconds sets the "timeout_seconds" field.
+func (wdu *WebhookDestinationUpdate) SetTimeoutSeconds(i int) *WebhookDestinationUpdate {
+	wdu.mutation.ResetTimeoutSeconds()
+	wdu.mutation.SetTimeoutSeconds(i)
+	return wdu
+}
+
+// SetNillableTimeoutSeconds sets the "timeout_seconds" field if the given value is not nil.
+func (wdu *WebhookDestinationUpdate) SetNillableTimeoutSeconds(i *int) *WebhookDestinationUpdate {
+	if i != nil {
+		wdu.SetTimeoutSeconds(*i)
+	}
+	return wdu
+}
+
+// AddTimeoutSeconds adds i to the "timeout_seconds" field.
+func (wdu *WebhookDestinationUpdate) AddTimeoutSeconds(i int) *WebhookDestinationUpdate {
+	wdu.mutation.AddTimeoutSeconds(i)
+	return wdu
+}
+
+// ClearTimeoutSeconds clears the value of the "timeout_seconds" field.
+func (wdu *WebhookDestinationUpdate) ClearTimeoutSeconds() *WebhookDestinationUpdate {
+	wdu.mutation.ClearTimeoutSeconds()
+	return wdu
+}
+
+// SetDeliveredCount sets the "delivered_count" field.
+func (wdu *WebhookDestinationUpdate) SetDeliveredCount(i int) *WebhookDestinationUpdate {
+	wdu.mutation.ResetDeliveredCount()
+	wdu.mutation.SetDeliveredCount(i)
+	return wdu
+}
+
+// SetNillableDeliveredCount sets the "delivered_count" field if the given value is not nil.
+func (wdu *WebhookDestinationUpdate) SetNillableDeliveredCount(i *int) *WebhookDestinationUpdate {
+	if i != nil {
+		wdu.SetDeliveredCount(*i)
+	}
+	return wdu
+}
+
+// AddDeliveredCount adds i to the "delivered_count" field.
+func (wdu *WebhookDestinationUpdate) AddDeliveredCount(i int) *WebhookDestinationUpdate {
+	wdu.mutation.AddDeliveredCount(i)
+	return wdu
+}
+
+// SetFailedCount sets the "failed_count" field.
+func (wdu *WebhookDestinationUpdate) SetFailedCount(i int) *WebhookDestinationUpdate {
+	wdu.mutation.ResetFailedCount()
+	wdu.mutation.SetFailedCount(i)
+	return wdu
+}
+
+// SetNillableFailedCount sets the "failed_count" field if the given value is not nil.
+func (wdu *WebhookDestinationUpdate) SetNillableFailedCount(i *int) *WebhookDestinationUpdate {
+	if i != nil {
+		wdu.SetFailedCount(*i)
+	}
+	return wdu
+}
+
+// AddFailedCount adds i to the "failed_count" field.
+func (wdu *WebhookDestinationUpdate) AddFailedCount(i int) *WebhookDestinationUpdate {
+	wdu.mutation.AddFailedCount(i)
+	return wdu
+}
+
+// SetLastDeliveredAt sets the "last_delivered_at" field.
+func (wdu *WebhookDestinationUpdate) SetLastDeliveredAt(t time.Time) *WebhookDestinationUpdate {
+	wdu.mutation.SetLastDeliveredAt(t)
+	return wdu
+}
+
+// SetNillableLastDeliveredAt sets the "last_delivered_at" field if the given value is not nil.
+func (wdu *WebhookDestinationUpdate) SetNillableLastDeliveredAt(t *time.Time) *WebhookDestinationUpdate {
+	if t != nil {
+		wdu.SetLastDeliveredAt(*t)
+	}
+	return wdu
+}
+
+// ClearLastDeliveredAt clears the value of the "last_delivered_at" field.
+func (wdu *WebhookDestinationUpdate) ClearLastDeliveredAt() *WebhookDestinationUpdate {
+	wdu.mutation.ClearLastDeliveredAt()
+	return wdu
+}
+
+// SetLastFailedAt sets the "last_failed_at" field.
+func (wdu *WebhookDestinationUpdate) SetLastFailedAt(t time.Time) *WebhookDestinationUpdate {
+	wdu.mutation.SetLastFailedAt(t)
+	return wdu
+}
+
+// SetNillableLastFailedAt sets the "last_failed_at" field if the given value is not nil.
+func (wdu *WebhookDestinationUpdate) SetNillableLastFailedAt(t *time.Time) *WebhookDestinationUpdate {
+	if t != nil {
+		wdu.SetLastFailedAt(*t)
+	}
+	return wdu
+}
+
+// ClearLastFailedAt clears the value of the "last_failed_at" field.
+func (wdu *WebhookDestinationUpdate) ClearLastFailedAt() *WebhookDestinationUpdate {
+	wdu.mutation.ClearLastFailedAt()
+	return wdu
+}
+
+// SetFailingSince sets the "failing_since" field.
+func (wdu *WebhookDestinationUpdate) SetFailingSince(t time.Time) *WebhookDestinationUpdate {
+	wdu.mutation.SetFailingSince(t)
+	return wdu
+}
+
+// SetNillableFailingSince sets the "failing_since" field if the given value is not nil.
+func (wdu *WebhookDestinationUpdate) SetNillableFailingSince(t *time.Time) *WebhookDestinationUpdate {
+	if t != nil {
+		wdu.SetFailingSince(*t)
+	}
+	return wdu
+}
+
+// ClearFailingSince clears the value of the "failing_since" field.
+func (wdu *WebhookDestinationUpdate) ClearFailingSince() *WebhookDestinationUpdate {
+	wdu.mutation.ClearFailingSince()
+	return wdu
+}
+
+// SetDisabledAt sets the "disabled_at" field.
+func (wdu *WebhookDestinationUpdate) SetDisabledAt(t time.Time) *WebhookDestinationUpdate {
+	wdu.mutation.SetDisabledAt(t)
+	return wdu
+}
+
+// SetNillableDisabledAt sets the "disabled_at" field if the given value is not nil.
+func (wdu *WebhookDestinationUpdate) SetNillableDisabledAt(t *time.Time) *WebhookDestinationUpdate {
+	if t != nil {
+		wdu.SetDisabledAt(*t)
+	}
+	return wdu
+}
+
+// ClearDisabledAt clears the value of the "disabled_at" field.
+func (wdu *WebhookDestinationUpdate) ClearDisabledAt() *WebhookDestinationUpdate {
+	wdu.mutation.ClearDisabledAt()
+	return wdu
+}
+
+// Mutation returns the WebhookDestinationMutation object of the builder.
+func (wdu *WebhookDestinationUpdate) Mutation() *WebhookDestinationMutation {
+	return wdu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wdu *WebhookDestinationUpdate) Save(ctx context.Context) (int, error) {
+	wdu.defaults()
+	return withHooks(ctx, wdu.sqlSave, wdu.mutation, wdu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wdu *WebhookDestinationUpdate) SaveX(ctx context.Context) int {
+	affected, err := wdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wdu *WebhookDestinationUpdate) Exec(ctx context.Context) error {
+	_, err := wdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdu *WebhookDestinationUpdate) ExecX(ctx context.Context) {
+	if err := wdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wdu *WebhookDestinationUpdate) defaults() {
+	if _, ok := wdu.mutation.UpdatedAt(); !ok {
+		v := webhookdestination.UpdateDefaultUpdatedAt()
+		wdu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (wdu *WebhookDestinationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(webhookdestination.Table, webhookdestination.Columns, sqlgraph.NewFieldSpec(webhookdestination.FieldID, field.TypeUUID))
+	if ps := wdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wdu.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhookdestination.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := wdu.mutation.URL(); ok {
+		_spec.SetField(webhookdestination.FieldURL, field.TypeString, value)
+	}
+	if value, ok := wdu.mutation.MaxAttempts(); ok {
+		_spec.SetField(webhookdestination.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := wdu.mutation.AddedMaxAttempts(); ok {
+		_spec.AddField(webhookdestination.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if wdu.mutation.MaxAttemptsCleared() {
+		_spec.ClearField(webhookdestination.FieldMaxAttempts, field.TypeInt)
+	}
+	if value, ok := wdu.mutation.BackoffSeconds(); ok {
+		_spec.SetField(webhookdestination.FieldBackoffSeconds, field.TypeInt, value)
+	}
+	if value, ok := wdu.mutation.AddedBackoffSeconds(); ok {
+		_spec.AddField(webhookdestination.FieldBackoffSeconds, field.TypeInt, value)
+	}
+	if wdu.mutation.BackoffSecondsCleared() {
+		_spec.ClearField(webhookdestination.FieldBackoffSeconds, field.TypeInt)
+	}
+	if value, ok := wdu.mutation.BackoffMultiplier(); ok {
+		_spec.SetField(webhookdestination.FieldBackoffMultiplier, field.TypeFloat64, value)
+	}
+	if value, ok := wdu.mutation.AddedBackoffMultiplier(); ok {
+		_spec.AddField(webhookdestination.FieldBackoffMultiplier, field.TypeFloat64, value)
+	}
+	if wdu.mutation.BackoffMultiplierCleared() {
+		_spec.ClearField(webhookdestination.FieldBackoffMultiplier, field.TypeFloat64)
+	}
+	if value, ok := wdu.mutation.TimeoutSeconds(); ok {
+		_spec.SetField(webhookdestination.FieldTimeoutSeconds, field.TypeInt, value)
+	}
+	if value, ok := wdu.mutation.AddedTimeoutSeconds(); ok {
+		_spec.AddField(webhookdestination.FieldTimeoutSeconds, field.TypeInt, value)
+	}
+	if wdu.mutation.TimeoutSecondsCleared() {
+		_spec.ClearField(webhookdestination.FieldTimeoutSeconds, field.TypeInt)
+	}
+	if value, ok := wdu.mutation.DeliveredCount(); ok {
+		_spec.SetField(webhookdestination.FieldDeliveredCount, field.TypeInt, value)
+	}
+	if value, ok := wdu.mutation.AddedDeliveredCount(); ok {
+		_spec.AddField(webhookdestination.FieldDeliveredCount, field.TypeInt, value)
+	}
+	if value, ok := wdu.mutation.FailedCount(); ok {
+		_spec.SetField(webhookdestination.FieldFailedCount, field.TypeInt, value)
+	}
+	if value, ok := wdu.mutation.AddedFailedCount(); ok {
+		_spec.AddField(webhookdestination.FieldFailedCount, field.TypeInt, value)
+	}
+	if value, ok := wdu.mutation.LastDeliveredAt(); ok {
+		_spec.SetField(webhookdestination.FieldLastDeliveredAt, field.TypeTime, value)
+	}
+	if wdu.mutation.LastDeliveredAtCleared() {
+		_spec.ClearField(webhookdestination.FieldLastDeliveredAt, field.TypeTime)
+	}
+	if value, ok := wdu.mutation.LastFailedAt(); ok {
+		_spec.SetField(webhookdestination.FieldLastFailedAt, field.TypeTime, value)
+	}
+	if wdu.mutation.LastFailedAtCleared() {
+		_spec.ClearField(webhookdestination.FieldLastFailedAt, field.TypeTime)
+	}
+	if value, ok := wdu.mutation.FailingSince(); ok {
+		_spec.SetField(webhookdestination.FieldFailingSince, field.TypeTime, value)
+	}
+	if wdu.mutation.FailingSinceCleared() {
+		_spec.ClearField(webhookdestination.FieldFailingSince, field.TypeTime)
+	}
+	if value, ok := wdu.mutation.DisabledAt(); ok {
+		_spec.SetField(webhookdestination.FieldDisabledAt, field.TypeTime, value)
+	}
+	if wdu.mutation.DisabledAtCleared() {
+		_spec.ClearField(webhookdestination.FieldDisabledAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdestination.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wdu.mutation.done = true
+	return n, nil
+}
+
+// WebhookDestinationUpdateOne is the builder for updating a single WebhookDestination entity.
+type WebhookDestinationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WebhookDestinationMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wduo *WebhookDestinationUpdateOne) SetUpdatedAt(t time.Time) *WebhookDestinationUpdateOne {
+	wduo.mutation.SetUpdatedAt(t)
+	return wduo
+}
+
+// SetURL sets the "url" field.
+func (wduo *WebhookDestinationUpdateOne) SetURL(s string) *WebhookDestinationUpdateOne {
+	wduo.mutation.SetURL(s)
+	return wduo
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (wduo *WebhookDestinationUpdateOne) SetNillableURL(s *string) *WebhookDestinationUpdateOne {
+	if s != nil {
+		wduo.SetURL(*s)
+	}
+	return wduo
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (wduo *WebhookDestinationUpdateOne) SetMaxAttempts(i int) *WebhookDestinationUpdateOne {
+	wduo.mutation.ResetMaxAttempts()
+	wduo.mutation.SetMaxAttempts(i)
+	return wduo
+}
+
+// SetNillableMaxAttempts sets the "max_attempts" field if the given value is not nil.
+func (wduo *WebhookDestinationUpdateOne) SetNillableMaxAttempts(i *int) *WebhookDestinationUpdateOne {
+	if i != nil {
+		wduo.SetMaxAttempts(*i)
+	}
+	return wduo
+}
+
+// AddMaxAttempts adds i to the "max_attempts" field.
+func (wduo *WebhookDestinationUpdateOne) AddMaxAttempts(i int) *WebhookDestinationUpdateOne {
+	wduo.mutation.AddMaxAttempts(i)
+	return wduo
+}
+
+// ClearMaxAttempts clears the value of the "max_attempts" field.
+func (wduo *WebhookDestinationUpdateOne) ClearMaxAttempts() *WebhookDestinationUpdateOne {
+	wduo.mutation.ClearMaxAttempts()
+	return wduo
+}
+
+// SetBackoffSeconds sets the "backoff_seconds" field.
+func (wduo *WebhookDestinationUpdateOne) SetBackoffSeconds(i int) *WebhookDestinationUpdateOne {
+	wduo.mutation.ResetBackoffSeconds()
+	wduo.mutation.SetBackoffSeconds(i)
+	return wduo
+}
+
+// SetNillableBackoffSeconds sets the "backoff_seconds" field if the given value is not nil.
+func (wduo *WebhookDestinationUpdateOne) SetNillableBackoffSeconds(i *int) *WebhookDestinationUpdateOne {
+	if i != nil {
+		wduo.SetBackoffSeconds(*i)
+	}
+	return wduo
+}
+
+// AddBackoffSeconds adds i to the "backoff_seconds" field.
+func (wduo *WebhookDestinationUpdateOne) AddBackoffSeconds(i int) *WebhookDestinationUpdateOne {
+	wduo.mutation.AddBackoffSeconds(i)
+	return wduo
+}
+
+// ClearBackoffSeconds clears the value of the "backoff_seconds" field.
+func (wduo *WebhookDestinationUpdateOne) ClearBackoffSeconds() *WebhookDestinationUpdateOne {
+	wduo.mutation.ClearBackoffSeconds()
+	return wduo
+}
+
+// SetBackoffMultiplier sets the "backoff_multiplier" field.
+func (wduo *WebhookDestinationUpdateOne) SetBackoffMultiplier(f float64) *WebhookDestinationUpdateOne {
+	wduo.mutation.ResetBackoffMultiplier()
+	wduo.mutation.SetBackoffMultiplier(f)
+	return wduo
+}
+
+// SetNillableBackoffMultiplier sets the "backoff_multiplier" field if the given value is not nil.
+func (wduo *WebhookDestinationUpdateOne) SetNillableBackoffMultiplier(f *float64) *WebhookDestinationUpdateOne {
+	if f != nil {
+		wduo.SetBackoffMultiplier(*f)
+	}
+	return wduo
+}
+
+// AddBackoffMultiplier adds f to the "backoff_multiplier" field.
+func (wduo *WebhookDestinationUpdateOne) AddBackoffMultiplier(f float64) *WebhookDestinationUpdateOne {
+	wduo.mutation.AddBackoffMultiplier(f)
+	return wduo
+}
+
+// ClearBackoffMultiplier clears the value of the "backoff_multiplier" field.
+func (wduo *WebhookDestinationUpdateOne) ClearBackoffMultiplier() *WebhookDestinationUpdateOne {
+	wduo.mutation.ClearBackoffMultiplier()
+	return wduo
+}
+
+// SetTimeoutSeconds sets the "timeout_seconds" field.
+func (wduo *WebhookDestinationUpdateOne) SetTimeoutSeconds(i int) *WebhookDestinationUpdateOne {
+	wduo.mutation.ResetTimeoutSeconds()
+	wduo.mutation.SetTimeoutSeconds(i)
+	return wduo
+}
+
+// SetNillableTimeoutSeconds sets the "timeout_seconds" field if the given value is not nil.
+func (wduo *WebhookDestinationUpdateOne) SetNillableTimeoutSeconds(i *int) *WebhookDestinationUpdateOne {
+	if i != nil {
+		wduo.SetTimeoutSeconds(*i)
+	}
+	return wduo
+}
+
+// AddTimeoutSeconds adds i to the "timeout_seconds" field.
+func (wduo *WebhookDestinationUpdateOne) AddTimeoutSeconds(i int) *WebhookDestinationUpdateOne {
+	wduo.mutation.AddTimeoutSeconds(i)
+	return wduo
+}
+
+// ClearTimeoutSeconds clears the value of the "timeout_seconds" field.
+func (wduo *WebhookDestinationUpdateOne) ClearTimeoutSeconds() *WebhookDestinationUpdateOne {
+	wduo.mutation.ClearTimeoutSeconds()
+	return wduo
+}
+
+// SetDeliveredCount sets the "delivered_count" field.
+func (wduo *WebhookDestinationUpdateOne) SetDeliveredCount(i int) *WebhookDestinationUpdateOne {
+	wduo.mutation.ResetDeliveredCount()
+	wduo.mutation.SetDeliveredCount(i)
+	return wduo
+}
+
+// SetNillableDeliveredCount sets the "delivered_count" field if the given value is not nil.
+func (wduo *WebhookDestinationUpdateOne) SetNillableDeliveredCount(i *int) *WebhookDestinationUpdateOne {
+	if i != nil {
+		wduo.SetDeliveredCount(*i)
+	}
+	return wduo
+}
+
+// AddDeliveredCount adds i to the "delivered_count" field.
+func (wduo *WebhookDestinationUpdateOne) AddDeliveredCount(i int) *WebhookDestinationUpdateOne {
+	wduo.mutation.AddDeliveredCount(i)
+	return wduo
+}
+
+// SetFailedCount sets the "failed_count" field.
+func (wduo *WebhookDestinationUpdateOne) SetFailedCount(i int) *WebhookDestinationUpdateOne {
+	wduo.mutation.ResetFailedCount()
+	wduo.mutation.SetFailedCount(i)
+	return wduo
+}
+
+// SetNillableFailedCount sets the "failed_count" field if the given value is not nil.
+func (wduo *WebhookDestinationUpdateOne) SetNillableFailedCount(i *int) *WebhookDestinationUpdateOne {
+	if i != nil {
+		wduo.SetFailedCount(*i)
+	}
+	return wduo
+}
+
+// AddFailedCount adds i to the "failed_count" field.
+func (wduo *WebhookDestinationUpdateOne) AddFailedCount(i int) *WebhookDestinationUpdateOne {
+	wduo.mutation.AddFailedCount(i)
+	return wduo
+}
+
+// SetLastDeliveredAt sets the "last_delivered_at" field.
+func (wduo *WebhookDestinationUpdateOne) SetLastDeliveredAt(t time.Time) *WebhookDestinationUpdateOne {
+	wduo.mutation.SetLastDeliveredAt(t)
+	return wduo
+}
+
+// SetNillableLastDeliveredAt sets the "last_delivered_at" field if the given value is not nil.
+func (wduo *WebhookDestinationUpdateOne) SetNillableLastDeliveredAt(t *time.Time) *WebhookDestinationUpdateOne {
+	if t != nil {
+		wduo.SetLastDeliveredAt(*t)
+	}
+	return wduo
+}
+
+// ClearLastDeliveredAt clears the value of the "last_delivered_at" field.
+func (wduo *WebhookDestinationUpdateOne) ClearLastDeliveredAt() *WebhookDestinationUpdateOne {
+	wduo.mutation.ClearLastDeliveredAt()
+	return wduo
+}
+
+// SetLastFailedAt sets the "last_failed_at" field.
+func (wduo *WebhookDestinationUpdateOne) SetLastFailedAt(t time.Time) *WebhookDestinationUpdateOne {
+	wduo.mutation.SetLastFailedAt(t)
+	return wduo
+}
+
+// SetNillableLastFailedAt sets the "last_failed_at" field if the given value is not nil.
+func (wduo *WebhookDestinationUpdateOne) SetNillableLastFailedAt(t *time.Time) *WebhookDestinationUpdateOne {
+	if t != nil {
+		wduo.SetLastFailedAt(*t)
+	}
+	return wduo
+}
+
+// ClearLastFailedAt clears the value of the "last_failed_at" field.
+func (wduo *WebhookDestinationUpdateOne) ClearLastFailedAt() *WebhookDestinationUpdateOne {
+	wduo.mutation.ClearLastFailedAt()
+	return wduo
+}
+
+// SetFailingSince sets the "failing_since" field.
+func (wduo *WebhookDestinationUpdateOne) SetFailingSince(t time.Time) *WebhookDestinationUpdateOne {
+	wduo.mutation.SetFailingSince(t)
+	return wduo
+}
+
+// SetNillableFailingSince sets the "failing_since" field if the given value is not nil.
+func (wduo *WebhookDestinationUpdateOne) SetNillableFailingSince(t *time.Time) *WebhookDestinationUpdateOne {
+	if t != nil {
+		wduo.SetFailingSince(*t)
+	}
+	return wduo
+}
+
+// ClearFailingSince clears the value of the "failing_since" field.
+func (wduo *WebhookDestinationUpdateOne) ClearFailingSince() *WebhookDestinationUpdateOne {
+	wduo.mutation.ClearFailingSince()
+	return wduo
+}
+
+// SetDisabledAt sets the "disabled_at" field.
+func (wduo *WebhookDestinationUpdateOne) SetDisabledAt(t time.Time) *WebhookDestinationUpdateOne {
+	wduo.mutation.SetDisabledAt(t)
+	return wduo
+}
+
+// SetNillableDisabledAt sets the "disabled_at" field if the given value is not nil.
+func (wduo *WebhookDestinationUpdateOne) SetNillableDisabledAt(t *time.Time) *WebhookDestinationUpdateOne {
+	if t != nil {
+		wduo.SetDisabledAt(*t)
+	}
+	return wduo
+}
+
+// ClearDisabledAt clears the value of the "disabled_at" field.
+func (wduo *WebhookDestinationUpdateOne) ClearDisabledAt() *WebhookDestinationUpdateOne {
+	wduo.mutation.ClearDisabledAt()
+	return wduo
+}
+
+// Mutation returns the WebhookDestinationMutation object of the builder.
+func (wduo *WebhookDestinationUpdateOne) Mutation() *WebhookDestinationMutation {
+	return wduo.mutation
+}
+
+// Where appends a list predicates to the WebhookDestinationUpdate builder.
+func (wduo *WebhookDestinationUpdateOne) Where(ps ...predicate.WebhookDestination) *WebhookDestinationUpdateOne {
+	wduo.mutation.Where(ps...)
+	return wduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wduo *WebhookDestinationUpdateOne) Select(field string, fields ...string) *WebhookDestinationUpdateOne {
+	wduo.fields = append([]string{field}, fields...)
+	return wduo
+}
+
+// Save executes the query and returns the updated WebhookDestination entity.
+func (wduo *WebhookDestinationUpdateOne) Save(ctx context.Context) (*WebhookDestination, error) {
+	wduo.defaults()
+	return withHooks(ctx, wduo.sqlSave, wduo.mutation, wduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wduo *WebhookDestinationUpdateOne) SaveX(ctx context.Context) *WebhookDestination {
+	node, err := wduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wduo *WebhookDestinationUpdateOne) Exec(ctx context.Context) error {
+	_, err := wduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wduo *WebhookDestinationUpdateOne) ExecX(ctx context.Context) {
+	if err := wduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wduo *WebhookDestinationUpdateOne) defaults() {
+	if _, ok := wduo.mutation.UpdatedAt(); !ok {
+		v := webhookdestination.UpdateDefaultUpdatedAt()
+		wduo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (wduo *WebhookDestinationUpdateOne) sqlSave(ctx context.Context) (_node *WebhookDestination, err error) {
+	_spec := sqlgraph.NewUpdateSpec(webhookdestination.Table, webhookdestination.Columns, sqlgraph.NewFieldSpec(webhookdestination.FieldID, field.TypeUUID))
+	id, ok := wduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WebhookDestination.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhookdestination.FieldID)
+		for _, f := range fields {
+			if !webhookdestination.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != webhookdestination.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wduo.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhookdestination.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := wduo.mutation.URL(); ok {
+		_spec.SetField(webhookdestination.FieldURL, field.TypeString, value)
+	}
+	if value, ok := wduo.mutation.MaxAttempts(); ok {
+		_spec.SetField(webhookdestination.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := wduo.mutation.AddedMaxAttempts(); ok {
+		_spec.AddField(webhookdestination.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if wduo.mutation.MaxAttemptsCleared() {
+		_spec.ClearField(webhookdestination.FieldMaxAttempts, field.TypeInt)
+	}
+	if value, ok := wduo.mutation.BackoffSeconds(); ok {
+		_spec.SetField(webhookdestination.FieldBackoffSeconds, field.TypeInt, value)
+	}
+	if value, ok := wduo.mutation.AddedBackoffSeconds(); ok {
+		_spec.AddField(webhookdestination.FieldBackoffSeconds, field.TypeInt, value)
+	}
+	if wduo.mutation.BackoffSecondsCleared() {
+		_spec.ClearField(webhookdestination.FieldBackoffSeconds, field.TypeInt)
+	}
+	if value, ok := wduo.mutation.BackoffMultiplier(); ok {
+		_spec.SetField(webhookdestination.FieldBackoffMultiplier, field.TypeFloat64, value)
+	}
+	if value, ok := wduo.mutation.AddedBackoffMultiplier(); ok {
+		_spec.AddField(webhookdestination.FieldBackoffMultiplier, field.TypeFloat64, value)
+	}
+	if wduo.mutation.BackoffMultiplierCleared() {
+		_spec.ClearField(webhookdestination.FieldBackoffMultiplier, field.TypeFloat64)
+	}
+	if value, ok := wduo.mutation.TimeoutSeconds(); ok {
+		_spec.SetField(webhookdestination.FieldTimeoutSeconds, field.TypeInt, value)
+	}
+	if value, ok := wduo.mutation.AddedTimeoutSeconds(); ok {
+		_spec.AddField(webhookdestination.FieldTimeoutSeconds, field.TypeInt, value)
+	}
+	if wduo.mutation.TimeoutSecondsCleared() {
+		_spec.ClearField(webhookdestination.FieldTimeoutSeconds, field.TypeInt)
+	}
+	if value, ok := wduo.mutation.DeliveredCount(); ok {
+		_spec.SetField(webhookdestination.FieldDeliveredCount, field.TypeInt, value)
+	}
+	if value, ok := wduo.mutation.AddedDeliveredCount(); ok {
+		_spec.AddField(webhookdestination.FieldDeliveredCount, field.TypeInt, value)
+	}
+	if value, ok := wduo.mutation.FailedCount(); ok {
+		_spec.SetField(webhookdestination.FieldFailedCount, field.TypeInt, value)
+	}
+	if value, ok := wduo.mutation.AddedFailedCount(); ok {
+		_spec.AddField(webhookdestination.FieldFailedCount, field.TypeInt, value)
+	}
+	if value, ok := wduo.mutation.LastDeliveredAt(); ok {
+		_spec.SetField(webhookdestination.FieldLastDeliveredAt, field.TypeTime, value)
+	}
+	if wduo.mutation.LastDeliveredAtCleared() {
+		_spec.ClearField(webhookdestination.FieldLastDeliveredAt, field.TypeTime)
+	}
+	if value, ok := wduo.mutation.LastFailedAt(); ok {
+		_spec.SetField(webhookdestination.FieldLastFailedAt, field.TypeTime, value)
+	}
+	if wduo.mutation.LastFailedAtCleared() {
+		_spec.ClearField(webhookdestination.FieldLastFailedAt, field.TypeTime)
+	}
+	if value, ok := wduo.mutation.FailingSince(); ok {
+		_spec.SetField(webhookdestination.FieldFailingSince, field.TypeTime, value)
+	}
+	if wduo.mutation.FailingSinceCleared() {
+		_spec.ClearField(webhookdestination.FieldFailingSince, field.TypeTime)
+	}
+	if value, ok := wduo.mutation.DisabledAt(); ok {
+		_spec.SetField(webhookdestination.FieldDisabledAt, field.TypeTime, value)
+	}
+	if wduo.mutation.DisabledAtCleared() {
+		_spec.ClearField(webhookdestination.FieldDisabledAt, field.TypeTime)
+	}
+	_node = &WebhookDestination{config: wduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdestination.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wduo.mutation.done = true
+	return _node, nil
+}
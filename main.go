@@ -2,20 +2,38 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"net"
+	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	networkent "github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
 	"github.com/NEDA-LABS/stablenode/routers"
 	"github.com/NEDA-LABS/stablenode/services"
+	"github.com/NEDA-LABS/stablenode/services/common"
+	"github.com/NEDA-LABS/stablenode/services/internalapi"
+	orderService "github.com/NEDA-LABS/stablenode/services/order"
 	"github.com/NEDA-LABS/stablenode/storage"
 	"github.com/NEDA-LABS/stablenode/tasks"
+	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/NEDA-LABS/stablenode/utils"
+	"github.com/NEDA-LABS/stablenode/utils/breaker"
+	"github.com/NEDA-LABS/stablenode/utils/lock"
 	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/NEDA-LABS/stablenode/utils/metrics"
+	"github.com/NEDA-LABS/stablenode/utils/shutdown"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/viper"
+	"google.golang.org/grpc"
 )
 
 func main() {
@@ -48,7 +66,12 @@ func main() {
 	if err := storage.DBConnection(DSN); err != nil {
 		logger.Fatalf("database DBConnection: %s", err)
 	}
-	defer storage.GetClient().Close()
+
+	// In-flight work is drained before the database and Redis are closed on shutdown
+	shutdownManager := shutdown.Default()
+	shutdownManager.OnClose("Database connection", func(ctx context.Context) error {
+		return storage.GetClient().Close()
+	})
 
 	// Fix database mishap
 	// err := tasks.FixDatabaseMishap()
@@ -56,31 +79,56 @@ func main() {
 	// 	logger.Errorf("FixDatabaseMishap: %v", err)
 	// }
 
-	// Fetch provider balances
-	err = tasks.FetchProviderBalances()
-	if err != nil {
-		logger.Errorf("Failed to fetch provider balances: %v", err)
-	}
-
 	// Initialize Redis
 	if err := storage.InitializeRedis(); err != nil {
 		log.Println(err)
 		logger.Fatalf("Redis initialization: %v", err)
 	}
+	shutdownManager.OnClose("Redis connection", func(ctx context.Context) error {
+		return storage.RedisClient.Close()
+	})
+
+	// Every enabled network must resolve to an RPC endpoint before anything is sent through it
+	if err := services.ValidateNetworkEndpoints(context.Background()); err != nil {
+		logger.Fatalf("Network configuration: %v", err)
+	}
 
-	// Setup gateway webhooks for all EVM networks
+	// Detect chain resets before webhooks are registered for networks with stale state
+	if err := services.CheckNetworkGenesis(context.Background()); err != nil {
+		logger.Errorf("Failed to check network genesis: %v", err)
+	}
+
+	// Setup gateway webhooks for all EVM networks once SERVER_URL passes the self-check
 	serviceManager := services.NewServiceManager()
 	logger.Infof("Using blockchain service: %s", serviceManager.GetActiveService())
-	
-	// Only create webhooks if using Thirdweb (Alchemy webhooks handled differently)
-	if serviceManager.GetActiveService() == "Thirdweb Engine" {
-		err = serviceManager.GetEngineService().CreateGatewayWebhook()
-		if err != nil {
-			logger.Errorf("Failed to create gateway webhooks: %v", err)
-		}
-	} else {
-		logger.Infof("Alchemy service active - webhook setup handled separately")
+	if provider := serviceManager.GetReadProvider(); provider != nil {
+		logger.Infof("Using blockchain read provider: %s", provider.Name())
 	}
+	go registerWebhooks(serviceManager)
+
+	// Alert when external services go down and recover
+	slackService := services.NewSlackService(config.ServerConfig().SlackWebhookURL)
+	breaker.Default().OnStateChange(func(change breaker.StateChange) {
+		if change.To == breaker.StateHalfOpen {
+			return
+		}
+		details := map[string]string{
+			"Circuit":  change.Name,
+			"Failures": fmt.Sprintf("%d", change.Failures),
+		}
+		if change.LastError != "" {
+			details["Last error"] = change.LastError
+		}
+		go func() {
+			title := fmt.Sprintf("Circuit breaker %s", change.To)
+			if err := slackService.SendAlert(title, details); err != nil {
+				logger.Errorf("Failed to send circuit breaker alert: %v", err)
+			}
+		}()
+	})
+
+	// Report the receive address pool inventory on every metrics scrape
+	metrics.RegisterPoolInventory(storage.PoolInventory)
 
 	// Subscribe to Redis keyspace events
 	tasks.SubscribeToRedisKeyspaceEvents()
@@ -88,54 +136,252 @@ func main() {
 	// Start cron jobs
 	tasks.StartCronJobs()
 
+	// Deliver queued webhook notifications to senders
+	shutdownManager.Go("Webhook notifications", tasks.ProcessWebhookNotifications)
+
+	// Send queued settlements and refunds, resuming those a previous run left unsent
+	shutdownManager.Go("Transaction outbox", tasks.ProcessTransactionOutbox)
+
+	// Each network detects deposits through its own mix of webhooks, WebSocket subscription and
+	// polling; the polling service and WebSocket indexer run when any network uses them
+	pollingEnabled, websocketEnabled := depositDetection(serviceManager)
+
 	// Start polling service if enabled (fallback for webhook failures)
-	var pollingService *services.PollingService
-	if viper.GetBool("ENABLE_POLLING_FALLBACK") {
+	if pollingEnabled {
 		pollingInterval := viper.GetDuration("POLLING_INTERVAL")
 		if pollingInterval == 0 {
 			pollingInterval = 1 * time.Minute // Default: 1 minute
 		}
 
-		pollingService = services.NewPollingService(pollingInterval)
-		
-		// Start in background
-		ctx := context.Background()
-		go pollingService.Start(ctx)
+		// Polled deposits go through the same pipeline as webhook transfer events
+		priorityQueueService := services.NewPriorityQueueService()
+		evmOrderService := orderService.NewOrderEVM()
+		tronOrderService := orderService.NewOrderTron()
+		solanaOrderService := orderService.NewOrderSolana()
+		pollingService := services.NewPollingService(pollingInterval, func(ctx context.Context, token *ent.Token, event *types.TokenTransferEvent) error {
+			addressToEvent := map[string]*types.TokenTransferEvent{event.To: event}
+			if token.Edges.Network.NetworkType == networkent.NetworkTypeSolana {
+				return common.ProcessTransfers(ctx, solanaOrderService, priorityQueueService, []string{event.To}, addressToEvent, token)
+			}
+			if strings.HasPrefix(token.Edges.Network.Identifier, "tron") {
+				return common.ProcessTransfers(ctx, tronOrderService, priorityQueueService, []string{event.To}, addressToEvent, token)
+			}
+			return common.ProcessTransfers(ctx, evmOrderService, priorityQueueService, []string{event.To}, addressToEvent, token)
+		})
+
+		// Start in background; a cycle in flight on shutdown stops with the manager's context
+		shutdownManager.Go("Polling service", pollingService.Start)
 
 		logger.WithFields(logger.Fields{
 			"interval":    pollingInterval,
 			"minOrderAge": viper.GetDuration("POLLING_MIN_AGE"),
 		}).Infof("✅ Polling service started (fallback mode)")
 	} else {
-		logger.Infof("⏭️  Polling service disabled (webhook-only mode)")
+		logger.Infof("⏭️  Polling service disabled (no network polls for deposits)")
+	}
+
+	// Start WebSocket indexer if enabled (real-time deposits on networks with a WSS endpoint). With
+	// ENABLE_WEBSOCKET_INDEXER set it also runs idle, to pick up networks onboarded later
+	if websocketEnabled || config.DepositDetectionConfig().Websocket {
+		priorityQueueService := services.NewPriorityQueueService()
+		websocketIndexer := services.NewWebsocketIndexer(func(ctx context.Context, token *ent.Token, event *types.TokenTransferEvent) error {
+			addressToEvent := map[string]*types.TokenTransferEvent{event.To: event}
+			return common.ProcessTransfers(ctx, orderService.NewOrderEVM(), priorityQueueService, []string{event.To}, addressToEvent, token)
+		})
+		// Subscriptions are held by one elected instance at a time, so a deposit is indexed once
+		shutdownManager.Go("WebSocket indexer", func(ctx context.Context) {
+			lock.Default().Lead(ctx, "websocket-indexer", websocketIndexer.Start)
+		})
+		logger.Infof("✅ WebSocket indexer started")
 	}
 
+	// Start the internal gRPC API for the other deployables if enabled
+	internalAPIConf := config.InternalAPIConfig()
+	if internalAPIConf.Enabled {
+		internalServer, err := internalapi.NewGRPCServer(internalAPIConf)
+		if err != nil {
+			logger.Fatalf("Failed to create internal API server: %v", err)
+		}
+
+		listener, err := net.Listen("tcp", internalAPIConf.ListenAddress)
+		if err != nil {
+			logger.Fatalf("Failed to listen on %s: %v", internalAPIConf.ListenAddress, err)
+		}
+
+		go func() {
+			if err := internalServer.Serve(listener); err != nil {
+				logger.Errorf("Internal API server stopped: %v", err)
+			}
+		}()
+		logger.Infof("Internal API running at %s (mTLS)", internalAPIConf.ListenAddress)
+
+		// Stop internal API after in-flight calls complete
+		shutdownManager.OnStop("Internal API", func(ctx context.Context) error {
+			return gracefulStop(ctx, internalServer)
+		})
+	}
+
+	// Serve Prometheus metrics on an internal listener, so scrapes never reach the public API
+	if conf.MetricsListenAddress != "" {
+		metricsMux := http.NewServeMux()
+		metricsMux.Handle("/metrics", promhttp.Handler())
+		metricsServer := &http.Server{Addr: conf.MetricsListenAddress, Handler: metricsMux}
+
+		go func() {
+			if err := metricsServer.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+				logger.Errorf("Metrics server stopped: %v", err)
+			}
+		}()
+		logger.Infof("Metrics served at %s/metrics", conf.MetricsListenAddress)
+
+		shutdownManager.OnStop("Metrics server", metricsServer.Shutdown)
+	}
+
+	// Run the server
+	router := routers.Routes()
+
+	appServer := fmt.Sprintf("%s:%s", conf.Host, conf.Port)
+	server := &http.Server{Addr: appServer, Handler: router}
+
+	// Shutdown waits for open connections, so end the long-lived order event streams once it begins
+	server.RegisterOnShutdown(utils.CloseOrderEventStreams)
+
+	// Stop accepting requests first and let in-flight handlers, such as webhooks, complete
+	shutdownManager.OnStop("HTTP server", server.Shutdown)
+
 	// Setup graceful shutdown
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
-	
+
 	go func() {
 		sig := <-sigChan
 		logger.Infof("Received signal: %v, shutting down gracefully...", sig)
-		
-		// Stop polling service
-		if pollingService != nil {
-			pollingService.Stop()
-			logger.Infof("Polling service stopped")
-		}
-		
-		// Close database connection
-		storage.GetClient().Close()
-		logger.Infof("Database connection closed")
-		
-		os.Exit(0)
+		_ = shutdownManager.Shutdown()
 	}()
 
-	// Run the server
-	router := routers.Routes()
-
-	appServer := fmt.Sprintf("%s:%s", conf.Host, conf.Port)
 	logger.Infof("Server Running at :%v", appServer)
+	if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		logger.Fatalf("%v", err)
+	}
+
+	// ListenAndServe returns as soon as the shutdown begins, so wait for it to finish
+	if err := shutdownManager.Shutdown(); err != nil {
+		logger.Errorf("Shutdown incomplete: %v", err)
+		os.Exit(1)
+	}
+	logger.Infof("Shutdown complete")
+}
+
+// gracefulStop stops the gRPC server once its in-flight calls complete, or right away once ctx is done
+func gracefulStop(ctx context.Context, server *grpc.Server) error {
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		server.Stop()
+		return ctx.Err()
+	}
+}
+
+// depositDetection logs how each network detects deposits and reports whether any network is polled
+// or indexed over WebSocket. When networks can't be read, the deployment defaults apply
+func depositDetection(serviceManager *services.ServiceManager) (polling bool, websocket bool) {
+	sources, err := serviceManager.DepositSources(context.Background())
+	if err != nil {
+		conf := config.DepositDetectionConfig()
+		logger.Errorf("Failed to read network deposit sources, using deployment defaults: %v", err)
+		return conf.Polling, conf.Websocket
+	}
+
+	for identifier, networkSources := range sources {
+		polling = polling || networkSources.Polling
+		websocket = websocket || networkSources.Websocket
+		logger.WithFields(logger.Fields{
+			"Network":   identifier,
+			"Webhooks":  networkSources.Webhooks,
+			"Websocket": networkSources.Websocket,
+			"Polling":   networkSources.Polling,
+		}).Infof("Deposit detection")
+	}
+
+	return polling, websocket
+}
+
+// registerWebhooks requests the webhook health endpoint through the public SERVER_URL
+// and only registers provider webhooks when it is reachable from the outside
+func registerWebhooks(serviceManager *services.ServiceManager) {
+	conf := config.ServerConfig()
+
+	if conf.WebhookSelfCheck {
+		// The HTTP server starts after this goroutine, so allow it a few attempts
+		err := services.WaitForWebhookURL(context.Background(), 5, 3*time.Second)
+		if err != nil {
+			logger.WithFields(logger.Fields{
+				"ServerURL": conf.ServerURL,
+				"Error":     err.Error(),
+			}).Errorf("🚨 SERVER_URL self-check failed - webhooks will NOT be registered, fix SERVER_URL and restart")
+			services.RecordWebhookRegistration(services.WebhookRegistrationFailed, err)
+			return
+		}
+		logger.Infof("✅ SERVER_URL self-check passed: %s", conf.ServerURL)
+	}
 
-	logger.Fatalf("%v", router.Run(appServer))
+	switch serviceManager.ActiveBlockchainService() {
+	case paymentorder.BlockchainServiceEngine:
+		err := serviceManager.GetEngineService().CreateGatewayWebhook()
+		if err != nil {
+			logger.Errorf("Failed to create gateway webhooks: %v", err)
+		}
+		services.RecordWebhookRegistration(services.WebhookRegistrationRegistered, err)
+	case paymentorder.BlockchainServiceAlchemy:
+		// Webhooks deleted or deactivated at Alchemy stop deposit detection, so check them on every boot
+		err := reconcileAlchemyWebhooks()
+		services.RecordWebhookRegistration(services.WebhookRegistrationRegistered, err)
+	default:
+		logger.Infof("%s service active - no webhooks to register", serviceManager.GetActiveService())
+		services.RecordWebhookRegistration(services.WebhookRegistrationSkipped, nil)
+	}
+}
+
+// reconcileAlchemyWebhooks makes the webhooks registered at Alchemy match the database. One
+// instance reconciles at a time; the others skip it
+func reconcileAlchemyWebhooks() error {
+	ctx := context.Background()
+
+	var report *services.AlchemyWebhookReport
+	ran, err := lock.Default().Run(ctx, "alchemy-webhook-reconciliation", func(ctx context.Context) error {
+		var err error
+		report, err = services.ReconcileAlchemyWebhooks(ctx)
+		return err
+	})
+	if err != nil {
+		logger.Errorf("Failed to reconcile Alchemy webhooks: %v", err)
+		return err
+	}
+	if !ran {
+		logger.Infof("Alchemy webhooks are being reconciled by another instance")
+		return nil
+	}
+
+	logger.WithFields(logger.Fields{
+		"Created":        report.Created,
+		"Activated":      report.Activated,
+		"Pruned":         report.Pruned,
+		"AddressesAdded": report.AddressesAdded,
+		"Failed":         len(report.Errors),
+	}).Infof("Reconciled Alchemy webhooks")
+
+	for _, err := range report.Errors {
+		logger.Errorf("Failed to reconcile Alchemy webhook: %v", err)
+	}
+	if len(report.Errors) > 0 {
+		return fmt.Errorf("%d Alchemy webhooks failed to reconcile", len(report.Errors))
+	}
+	return nil
 }
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/NEDA-LABS/stablenode/pool_management/internal/pool"
+	"github.com/NEDA-LABS/stablenode/storage"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+)
+
+func newCreateCmd() *cobra.Command {
+	var (
+		count    int
+		chainID  int64
+		network  string
+		owner    string
+		rpcURL   string
+		output   string
+		saveToDB bool
+		derive   bool
+		start    int64
+		// counterfactual saves addresses as pool_ready without deploying them
+		counterfactual bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Generate receive addresses using the Light Account factory",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !common.IsHexAddress(owner) {
+				return fmt.Errorf("invalid owner address: %s", owner)
+			}
+
+			ctx := cmd.Context()
+
+			var masterSecret []byte
+			if derive {
+				var err error
+				if masterSecret, err = pool.MasterSecret(); err != nil {
+					return err
+				}
+				if start < 0 && !saveToDB {
+					return fmt.Errorf("--start-index is required with --derive unless --save-db is set")
+				}
+			}
+			if counterfactual && !saveToDB {
+				return fmt.Errorf("--counterfactual requires --save-db")
+			}
+
+			if saveToDB || rpcURL == "" {
+				if err := connect(); err != nil {
+					return err
+				}
+				defer disconnect()
+			}
+
+			client, err := pool.DialNetwork(ctx, network, rpcURL)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			// Catch a refactor of the address computation before generating more addresses with it
+			if storage.Client != nil {
+				if _, err := checkGeneration(ctx, client, network, defaultVerifySample); err != nil {
+					fmt.Printf("⚠ Failed to verify address generation: %v\n", err)
+				}
+			}
+
+			// Derived salts continue after the last index stored for the chain
+			if derive && start < 0 {
+				if start, err = pool.NextDerivationIndex(ctx, chainID); err != nil {
+					return err
+				}
+			}
+
+			fmt.Printf("Creating %d receive addresses for chain %d (%s)\n", count, chainID, network)
+			if derive {
+				fmt.Printf("Deriving salts at indexes %d-%d\n", start, start+int64(count)-1)
+			}
+
+			addresses := make([]pool.AddressInfo, 0, count)
+			for i := 0; i < count; i++ {
+				var info *pool.AddressInfo
+				if derive {
+					info, err = pool.NewDerivedAddressInfo(ctx, client, masterSecret, start+int64(i), owner, chainID, network)
+				} else {
+					info, err = pool.NewAddressInfo(ctx, client, owner, chainID, network)
+				}
+				if err != nil {
+					fmt.Printf("[%d/%d] ✗ Failed to generate address: %v\n", i+1, count, err)
+					continue
+				}
+
+				if saveToDB {
+					save := pool.SaveAddress
+					if counterfactual {
+						save = pool.SaveCounterfactualAddress
+					}
+					if err := save(ctx, info); err != nil {
+						fmt.Printf("[%d/%d] ✗ Failed to save %s: %v\n", i+1, count, info.Address, err)
+						continue
+					}
+				}
+
+				addresses = append(addresses, *info)
+				fmt.Printf("[%d/%d] ✓ %s\n", i+1, count, info.Address)
+			}
+
+			if err := pool.WriteJSON(output, addresses); err != nil {
+				return fmt.Errorf("failed to write %s: %w", output, err)
+			}
+
+			fmt.Println(strings.Repeat("=", 60))
+			fmt.Printf("✓ Created %d addresses, details saved to %s\n", len(addresses), output)
+			if counterfactual {
+				fmt.Println("Addresses are pool_ready and deployed by the first UserOperation sent from them")
+				return nil
+			}
+			fmt.Println("Next steps:")
+			fmt.Printf("  poolctl deploy --input %s --private-key $PRIVATE_KEY\n", output)
+			fmt.Println("  poolctl mark-deployed --input <deployment results file>")
+
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVar(&count, "count", 10, "Number of addresses to create")
+	cmd.Flags().Int64Var(&chainID, "chain-id", 84532, "Chain ID")
+	cmd.Flags().StringVar(&network, "network", "base-sepolia", "Network identifier")
+	cmd.Flags().StringVar(&owner, "owner", pool.DefaultOwnerAddress, "Owner address for the smart accounts")
+	cmd.Flags().StringVar(&rpcURL, "rpc-url", "", "RPC URL (defaults to the network's endpoint in the database)")
+	cmd.Flags().StringVar(&output, "output", "pool_addresses.json", "Output JSON file with address details")
+	cmd.Flags().BoolVar(&saveToDB, "save-db", false, "Save addresses to the database")
+	cmd.Flags().BoolVar(&derive, "derive", false, "Derive salts from SALT_MASTER_SECRET instead of generating random ones")
+	cmd.Flags().BoolVar(&counterfactual, "counterfactual", false, "Save addresses as pool_ready without deploying them (requires --save-db and POOL_COUNTERFACTUAL_ADDRESSES)")
+	cmd.Flags().Int64Var(&start, "start-index", -1, "First derivation index (defaults to the next unused index in the database)")
+
+	return cmd
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/pool_management/internal/pool"
+	"github.com/NEDA-LABS/stablenode/services/gasoracle"
+	"github.com/NEDA-LABS/stablenode/utils/signer"
+	"github.com/spf13/cobra"
+)
+
+func newDeployCmd() *cobra.Command {
+	var (
+		input      string
+		output     string
+		rpcURL     string
+		privateKey string
+		dryRun     bool
+		batchSize  int
+		urgency    string
+		account    string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "deploy",
+		Short: "Deploy generated addresses by calling the factory from an EOA or a smart account",
+		Long: "Deploy generated addresses by calling the factory from an EOA or a smart account.\n\n" +
+			"With --account, each batch of --batch-size addresses is deployed by one UserOperation sent from\n" +
+			"that smart account, which must be deployed and owned by SMART_ACCOUNT_OWNER_PRIVATE_KEY.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			var addresses []pool.AddressInfo
+			if err := pool.ReadJSON(input, &addresses); err != nil {
+				return fmt.Errorf("failed to load %s: %w", input, err)
+			}
+			if len(addresses) == 0 {
+				return fmt.Errorf("no addresses found in %s", input)
+			}
+
+			if privateKey == "" {
+				privateKey = os.Getenv("DEPLOYER_PRIVATE_KEY")
+			}
+			if privateKey == "" && account == "" && config.SignerConfig().Backend == signer.BackendLocal {
+				return fmt.Errorf("--private-key, DEPLOYER_PRIVATE_KEY, --account or a KMS SIGNER_BACKEND is required")
+			}
+
+			// UserOperations are built against the receive address table
+			if rpcURL == "" || account != "" {
+				if err := connect(); err != nil {
+					return err
+				}
+				defer disconnect()
+			}
+
+			gasUrgency, err := gasoracle.ParseUrgency(urgency)
+			if err != nil {
+				return err
+			}
+
+			client, err := pool.DialNetwork(ctx, addresses[0].NetworkID, rpcURL)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			// UserOperations always go out in batches; EOA deployments only with a batch size
+			var deployer *pool.Deployer
+			var batcher batchDeployer
+			if account != "" {
+				if batcher, err = pool.NewUserOpDeployer(ctx, client, account); err != nil {
+					return err
+				}
+				batchSize = max(batchSize, 1)
+			} else {
+				if deployer, err = pool.NewDeployer(ctx, client, privateKey, gasUrgency); err != nil {
+					return err
+				}
+				if batchSize > 1 {
+					batcher = deployer
+				}
+			}
+
+			from := account
+			if deployer != nil {
+				from = deployer.From().Hex()
+			}
+			fmt.Printf("Deploying %d addresses from %s\n", len(addresses), from)
+			if account != "" {
+				fmt.Printf("Batching up to %d deployments per UserOperation\n", batchSize)
+			} else if batchSize > 1 {
+				fmt.Printf("Batching up to %d deployments per Multicall3 transaction\n", batchSize)
+			}
+			if dryRun {
+				fmt.Println("🔍 DRY RUN MODE - No transactions will be sent")
+			}
+
+			results := make([]pool.DeploymentResult, 0, len(addresses))
+			for start := 0; start < len(addresses); start += max(batchSize, 1) {
+				if batcher != nil {
+					end := min(start+batchSize, len(addresses))
+					results = append(results, batcher.DeployBatch(ctx, addresses[start:end], dryRun)...)
+				} else {
+					results = append(results, deployer.Deploy(ctx, addresses[start], dryRun))
+				}
+
+				for i := start; i < len(results); i++ {
+					if results[i].Success {
+						fmt.Printf("[%d/%d] ✓ %s %s\n", i+1, len(addresses), results[i].Address, results[i].TxHash)
+					} else {
+						fmt.Printf("[%d/%d] ✗ %s: %s\n", i+1, len(addresses), results[i].Address, results[i].Error)
+					}
+				}
+			}
+
+			succeeded := 0
+			for _, result := range results {
+				if result.Success {
+					succeeded++
+				}
+			}
+
+			if !dryRun {
+				if err := pool.WriteJSON(output, results); err != nil {
+					return fmt.Errorf("failed to write %s: %w", output, err)
+				}
+			}
+
+			fmt.Println(strings.Repeat("=", 60))
+			fmt.Printf("Deployed: %d, Failed: %d\n", succeeded, len(addresses)-succeeded)
+			if !dryRun {
+				fmt.Printf("✓ Results saved to %s\n", output)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&input, "input", "pool_addresses.json", "Input JSON file created by poolctl create")
+	cmd.Flags().StringVar(&output, "output", "deployment_results.json", "Output JSON file with deployment results")
+	cmd.Flags().StringVar(&rpcURL, "rpc-url", "", "RPC URL (defaults to the network's endpoint in the database)")
+	cmd.Flags().StringVar(&privateKey, "private-key", "", "Deployer private key (defaults to DEPLOYER_PRIVATE_KEY)")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Estimate gas without sending transactions")
+	cmd.Flags().IntVar(&batchSize, "batch-size", 1, "Deployments per Multicall3 transaction or UserOperation (1 sends a transaction per address)")
+	cmd.Flags().StringVar(&account, "account", "", "Deployer smart account sending each batch as one UserOperation instead of EOA transactions")
+	cmd.Flags().StringVar(&urgency, "urgency", "standard", "Gas price urgency: slow, standard or fast")
+
+	return cmd
+}
+
+// batchDeployer deploys a batch of addresses in one transaction
+type batchDeployer interface {
+	DeployBatch(ctx context.Context, infos []pool.AddressInfo, dryRun bool) []pool.DeploymentResult
+}
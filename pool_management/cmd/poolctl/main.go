@@ -0,0 +1,57 @@
+// Command poolctl manages the pool of pre-deployed receive addresses.
+//
+// Subcommands share the CREATE2, config and database helpers in
+// pool_management/internal/pool:
+//
+//	poolctl create         Generate addresses and optionally save them to the database
+//	poolctl deploy         Deploy generated addresses through the factory
+//	poolctl replenish      Derive and batch-deploy addresses until a network's pool reaches a target
+//	poolctl mark-deployed  Mark deployed addresses as pool_ready in the database
+//	poolctl status         Show pool counts per network and status
+//	poolctl recycle        Return completed pool addresses to the pool
+//	poolctl verify         Recompute stored addresses to catch address generation changes
+//	poolctl reconstruct    Re-derive addresses created with --derive from the master secret
+//	poolctl rotate-owner   Transfer deployed pool addresses to a new owner key
+package main
+
+import (
+	"os"
+
+	"github.com/NEDA-LABS/stablenode/pool_management/internal/pool"
+	"github.com/spf13/cobra"
+)
+
+// connect and disconnect open and close the database connection, replaced in tests
+var (
+	connect    = pool.Connect
+	disconnect = pool.Close
+)
+
+func main() {
+	if err := newRootCmd().Execute(); err != nil {
+		os.Exit(1)
+	}
+}
+
+// newRootCmd returns the poolctl command with every subcommand registered
+func newRootCmd() *cobra.Command {
+	rootCmd := &cobra.Command{
+		Use:          "poolctl",
+		Short:        "Receive address pool management",
+		SilenceUsage: true,
+	}
+
+	rootCmd.AddCommand(
+		newCreateCmd(),
+		newDeployCmd(),
+		newReplenishCmd(),
+		newMarkDeployedCmd(),
+		newStatusCmd(),
+		newRecycleCmd(),
+		newVerifyCmd(),
+		newReconstructCmd(),
+		newRotateOwnerCmd(),
+	)
+
+	return rootCmd
+}
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	"github.com/NEDA-LABS/stablenode/pool_management/internal/pool"
+	"github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/test"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+// runPoolctl runs poolctl with the given arguments against the test database
+func runPoolctl(t *testing.T, client *ent.Client, args ...string) error {
+	defaultConnect, defaultDisconnect := connect, disconnect
+	defer func() { connect, disconnect = defaultConnect, defaultDisconnect }()
+	disconnect = func() {}
+	connect = func() error {
+		if client == nil {
+			t.Fatal("command connected to the database")
+		}
+		storage.Client = client
+		return nil
+	}
+
+	cmd := newRootCmd()
+	cmd.SetArgs(args)
+	return cmd.ExecuteContext(context.Background())
+}
+
+// writeResults writes a deployment results file to the test's temporary directory
+func writeResults(t *testing.T, results interface{}) string {
+	filename := filepath.Join(t.TempDir(), "deployment_results.json")
+	assert.NoError(t, pool.WriteJSON(filename, results))
+	return filename
+}
+
+func TestPoolctlArguments(t *testing.T) {
+	viper.Set("SALT_MASTER_SECRET", "")
+
+	tests := []struct {
+		name string
+		args []string
+		err  string
+	}{
+		{"create should reject an invalid owner", []string{"create", "--owner", "0x123"}, "invalid owner address"},
+		{"create should require --save-db with --counterfactual", []string{"create", "--counterfactual"}, "--counterfactual requires --save-db"},
+		{"create should require the master secret with --derive", []string{"create", "--derive"}, "SALT_MASTER_SECRET is not set"},
+		{"replenish should reject an empty batch", []string{"replenish", "--batch-size", "0"}, "--batch-size must be at least 1"},
+		{"replenish should reject an invalid owner", []string{"replenish", "--owner", "owner"}, "invalid owner address"},
+		{"reconstruct should reject an invalid owner", []string{"reconstruct", "--owner", "0x123"}, "invalid owner address"},
+		{"rotate-owner should require a new owner", []string{"rotate-owner"}, "invalid new owner"},
+		{"rotate-owner should reject the zero address", []string{"rotate-owner", "--new-owner", "0x0000000000000000000000000000000000000000"}, "invalid new owner"},
+		{"mark-deployed should reject an unknown status", []string{"mark-deployed", "--status", "deployed"}, "invalid status"},
+		{"mark-deployed should require an input file", []string{"mark-deployed", "--input", "missing.json"}, "failed to load missing.json"},
+		{"deploy should require an input file", []string{"deploy", "--input", "missing.json"}, "failed to load missing.json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := runPoolctl(t, nil, tt.args...)
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+
+	t.Run("mark-deployed should reject a file without successful deployments", func(t *testing.T) {
+		input := writeResults(t, []pool.DeploymentResult{
+			{Address: "0x1111111111111111111111111111111111111111", TxHash: "0xf1", Error: "reverted"},
+		})
+		err := runPoolctl(t, nil, "mark-deployed", "--input", input)
+		assert.ErrorContains(t, err, "no successful deployments found")
+	})
+
+	t.Run("deploy should reject an empty address file", func(t *testing.T) {
+		input := writeResults(t, []pool.AddressInfo{})
+		err := runPoolctl(t, nil, "deploy", "--input", input)
+		assert.ErrorContains(t, err, "no addresses found")
+	})
+}
+
+func TestPoolctlMarkDeployed(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:poolctlmarkdeployed?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+
+	createAddress := func(address string) *ent.ReceiveAddress {
+		return client.ReceiveAddress.
+			Create().
+			SetAddress(address).
+			SetSalt([]byte("encrypted salt")).
+			SetStatus(receiveaddress.StatusUnused).
+			SetNetworkIdentifier("base-sepolia").
+			SetChainID(84532).
+			SaveX(ctx)
+	}
+
+	deployed := createAddress("0x1111111111111111111111111111111111111111")
+	failed := createAddress("0x2222222222222222222222222222222222222222")
+
+	input := writeResults(t, []pool.DeploymentResult{
+		{Address: "0x1111111111111111111111111111111111111111", TxHash: "0xd1", BlockNumber: 42, Success: true},
+		{Address: "0x2222222222222222222222222222222222222222", TxHash: "0xd2", Error: "reverted"},
+		{Address: "0x3333333333333333333333333333333333333333", TxHash: "0xd3", Success: true},
+	})
+
+	t.Run("should not change anything in dry run mode", func(t *testing.T) {
+		assert.NoError(t, runPoolctl(t, nil, "mark-deployed", "--input", input, "--dry-run"))
+
+		address := client.ReceiveAddress.GetX(ctx, deployed.ID)
+		assert.False(t, address.IsDeployed)
+		assert.Equal(t, receiveaddress.StatusUnused, address.Status)
+	})
+
+	t.Run("should mark successful deployments as pool_ready", func(t *testing.T) {
+		assert.NoError(t, runPoolctl(t, client, "mark-deployed", "--input", input))
+
+		address := client.ReceiveAddress.GetX(ctx, deployed.ID)
+		assert.True(t, address.IsDeployed)
+		assert.Equal(t, receiveaddress.StatusPoolReady, address.Status)
+		assert.Equal(t, "0xd1", address.DeploymentTxHash)
+		assert.Equal(t, int64(42), address.DeploymentBlock)
+
+		address = client.ReceiveAddress.GetX(ctx, failed.ID)
+		assert.False(t, address.IsDeployed)
+		assert.Equal(t, receiveaddress.StatusUnused, address.Status)
+
+		assert.Equal(t, 2, client.ReceiveAddress.Query().CountX(ctx))
+	})
+}
+
+func TestPoolctlRecycle(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:poolctlrecycle?mode=memory&_fk=1")
+	defer client.Close()
+	storage.Client = client
+
+	ctx := context.Background()
+
+	token, err := test.CreateERC20Token(nil, map[string]interface{}{
+		"symbol":         "USDC",
+		"identifier":     "base-sepolia",
+		"chainID":        int64(84532),
+		"deployContract": false,
+	})
+	assert.NoError(t, err)
+
+	createAddress := func(address string, network string) *ent.ReceiveAddress {
+		return client.ReceiveAddress.
+			Create().
+			SetAddress(address).
+			SetStatus(receiveaddress.StatusPoolCompleted).
+			SetIsDeployed(true).
+			SetNetworkIdentifier(network).
+			SetChainID(84532).
+			SaveX(ctx)
+	}
+
+	completed := createAddress("0x1111111111111111111111111111111111111111", "base-sepolia")
+	otherNetwork := createAddress("0x2222222222222222222222222222222222222222", "arbitrum-sepolia")
+
+	order, err := test.CreateTestPaymentOrder(nil, token, map[string]interface{}{
+		"receive_address": completed,
+	})
+	assert.NoError(t, err)
+	assignment := client.ReceiveAddressAssignment.
+		Create().
+		SetAddress(completed.Address).
+		SetNetworkIdentifier("base-sepolia").
+		SetReceiveAddress(completed).
+		SetPaymentOrder(order).
+		SaveX(ctx)
+
+	t.Run("should not change anything in dry run mode", func(t *testing.T) {
+		assert.NoError(t, runPoolctl(t, client, "recycle", "--network", "base-sepolia", "--dry-run"))
+
+		address := client.ReceiveAddress.GetX(ctx, completed.ID)
+		assert.Equal(t, receiveaddress.StatusPoolCompleted, address.Status)
+		assert.Nil(t, client.ReceiveAddressAssignment.GetX(ctx, assignment.ID).ReleasedAt)
+	})
+
+	t.Run("should return completed addresses of the network to the pool", func(t *testing.T) {
+		assert.NoError(t, runPoolctl(t, client, "recycle", "--network", "base-sepolia"))
+
+		address := client.ReceiveAddress.GetX(ctx, completed.ID)
+		assert.Equal(t, receiveaddress.StatusPoolReady, address.Status)
+		assert.False(t, address.RecycledAt.IsZero())
+		assert.NotNil(t, client.ReceiveAddressAssignment.GetX(ctx, assignment.ID).ReleasedAt)
+
+		address = client.ReceiveAddress.GetX(ctx, otherNetwork.ID)
+		assert.Equal(t, receiveaddress.StatusPoolCompleted, address.Status)
+	})
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	"github.com/NEDA-LABS/stablenode/pool_management/internal/pool"
+	"github.com/spf13/cobra"
+)
+
+func newMarkDeployedCmd() *cobra.Command {
+	var (
+		input  string
+		status string
+		dryRun bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "mark-deployed",
+		Short: "Mark successfully deployed addresses in the database",
+		Long: "Mark successfully deployed addresses in the database.\n\n" +
+			"Accepts either a deployment results file (poolctl deploy) or an address file (poolctl create).",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			targetStatus := receiveaddress.Status(status)
+			if err := receiveaddress.StatusValidator(targetStatus); err != nil {
+				return fmt.Errorf("invalid status %q: %w", status, err)
+			}
+
+			var results []pool.DeploymentResult
+			if err := pool.ReadJSON(input, &results); err != nil {
+				return fmt.Errorf("failed to load %s: %w", input, err)
+			}
+
+			successful := make([]pool.DeploymentResult, 0, len(results))
+			for _, r := range results {
+				// Address files have no success flag; treat every entry as deployed
+				if r.Success || (r.TxHash == "" && r.Error == "") {
+					successful = append(successful, r)
+				}
+			}
+			if len(successful) == 0 {
+				return fmt.Errorf("no successful deployments found in %s", input)
+			}
+
+			if dryRun {
+				fmt.Println("🔍 DRY RUN MODE - No changes will be made")
+				for _, r := range successful {
+					fmt.Printf("  Would mark %s as %s (tx: %s)\n", r.Address, status, r.TxHash)
+				}
+				return nil
+			}
+
+			if err := connect(); err != nil {
+				return err
+			}
+			defer disconnect()
+
+			updated, skipped, failed := 0, 0, 0
+			for i, r := range successful {
+				n, err := pool.MarkDeployed(ctx, r, targetStatus)
+				switch {
+				case errors.Is(err, pool.ErrAddressNotFound):
+					fmt.Printf("[%d/%d] ⚠️  %s not found in database\n", i+1, len(successful), r.Address)
+					skipped++
+				case err != nil:
+					fmt.Printf("[%d/%d] ✗ %s: %v\n", i+1, len(successful), r.Address, err)
+					failed++
+				case n == 0:
+					fmt.Printf("[%d/%d] ℹ️  %s already marked as %s\n", i+1, len(successful), r.Address, status)
+					skipped++
+				default:
+					fmt.Printf("[%d/%d] ✓ %s updated (%d rows)\n", i+1, len(successful), r.Address, n)
+					updated += n
+				}
+			}
+
+			fmt.Println(strings.Repeat("=", 60))
+			fmt.Printf("Rows updated: %d, Skipped: %d, Errors: %d\n", updated, skipped, failed)
+
+			return printStatus(cmd, "")
+		},
+	}
+
+	cmd.Flags().StringVar(&input, "input", "deployment_results.json", "Deployment results or address JSON file")
+	cmd.Flags().StringVar(&status, "status", string(receiveaddress.StatusPoolReady), "Status to set")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Show what would be updated without making changes")
+
+	return cmd
+}
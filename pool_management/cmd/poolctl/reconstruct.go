@@ -0,0 +1,110 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/NEDA-LABS/stablenode/pool_management/internal/pool"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+)
+
+func newReconstructCmd() *cobra.Command {
+	var (
+		from     int64
+		to       int64
+		chainID  int64
+		network  string
+		owner    string
+		rpcURL   string
+		output   string
+		saveToDB bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "reconstruct",
+		Short: "Re-derive pool addresses from SALT_MASTER_SECRET over an index range",
+		Long: "Re-derive pool addresses from SALT_MASTER_SECRET over an index range.\n\n" +
+			"Only addresses created with --derive can be reconstructed. With --save-db, addresses missing " +
+			"from the database are restored, deployed ones as pool_ready.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !common.IsHexAddress(owner) {
+				return fmt.Errorf("invalid owner address: %s", owner)
+			}
+
+			ctx := cmd.Context()
+
+			masterSecret, err := pool.MasterSecret()
+			if err != nil {
+				return err
+			}
+
+			if saveToDB || rpcURL == "" {
+				if err := connect(); err != nil {
+					return err
+				}
+				defer disconnect()
+			}
+
+			client, err := pool.DialNetwork(ctx, network, rpcURL)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			fmt.Printf("Reconstructing indexes %d-%d for chain %d (%s)\n", from, to, chainID, network)
+
+			addresses, err := pool.Reconstruct(ctx, client, masterSecret, owner, chainID, network, from, to)
+			if err != nil {
+				return err
+			}
+
+			deployed, restored, failed := 0, 0, 0
+			for _, address := range addresses {
+				state := "not deployed"
+				if address.Deployed {
+					state = "deployed"
+					deployed++
+				}
+
+				if saveToDB {
+					ok, err := pool.RestoreAddress(ctx, address)
+					switch {
+					case err != nil:
+						fmt.Printf("[%d] ✗ %s: %v\n", *address.DerivationIndex, address.Address, err)
+						failed++
+						continue
+					case ok:
+						state += ", restored"
+						restored++
+					default:
+						state += ", already stored"
+					}
+				}
+
+				fmt.Printf("[%d] ✓ %s (%s)\n", *address.DerivationIndex, address.Address, state)
+			}
+
+			if err := pool.WriteJSON(output, addresses); err != nil {
+				return fmt.Errorf("failed to write %s: %w", output, err)
+			}
+
+			fmt.Println(strings.Repeat("=", 60))
+			fmt.Printf("Derived: %d, Deployed: %d, Restored: %d, Errors: %d\n", len(addresses), deployed, restored, failed)
+			fmt.Printf("Details saved to %s\n", output)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64Var(&from, "from", 0, "First derivation index")
+	cmd.Flags().Int64Var(&to, "to", 0, "Last derivation index")
+	cmd.Flags().Int64Var(&chainID, "chain-id", 84532, "Chain ID")
+	cmd.Flags().StringVar(&network, "network", "base-sepolia", "Network identifier")
+	cmd.Flags().StringVar(&owner, "owner", pool.DefaultOwnerAddress, "Owner address for the smart accounts")
+	cmd.Flags().StringVar(&rpcURL, "rpc-url", "", "RPC URL (defaults to the network's endpoint in the database)")
+	cmd.Flags().StringVar(&output, "output", "pool_reconstructed.json", "Output JSON file with address details")
+	cmd.Flags().BoolVar(&saveToDB, "save-db", false, "Restore addresses missing from the database")
+
+	return cmd
+}
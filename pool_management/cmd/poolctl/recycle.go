@@ -0,0 +1,44 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/NEDA-LABS/stablenode/pool_management/internal/pool"
+	"github.com/spf13/cobra"
+)
+
+func newRecycleCmd() *cobra.Command {
+	var (
+		network string
+		dryRun  bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "recycle",
+		Short: "Return pool_completed addresses to pool_ready",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := connect(); err != nil {
+				return err
+			}
+			defer disconnect()
+
+			n, err := pool.Recycle(cmd.Context(), network, dryRun)
+			if err != nil {
+				return err
+			}
+
+			if dryRun {
+				fmt.Printf("🔍 DRY RUN MODE - %d addresses would be recycled\n", n)
+				return nil
+			}
+
+			fmt.Printf("✓ Recycled %d addresses\n", n)
+			return printStatus(cmd, network)
+		},
+	}
+
+	cmd.Flags().StringVar(&network, "network", "", "Only recycle addresses on this network identifier")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Show how many addresses would be recycled")
+
+	return cmd
+}
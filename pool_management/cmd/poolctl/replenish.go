@@ -0,0 +1,213 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	"github.com/NEDA-LABS/stablenode/pool_management/internal/pool"
+	"github.com/NEDA-LABS/stablenode/services/gasoracle"
+	"github.com/NEDA-LABS/stablenode/utils/signer"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+)
+
+func newReplenishCmd() *cobra.Command {
+	var (
+		network        string
+		target         int
+		batchSize      int
+		owner          string
+		account        string
+		privateKey     string
+		urgency        string
+		rpcURL         string
+		counterfactual bool
+		dryRun         bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "replenish",
+		Short: "Top the pool of a network up to a target number of pool_ready addresses",
+		Long: "Top the pool of a network up to a target number of pool_ready addresses.\n\n" +
+			"Missing addresses are derived from SALT_MASTER_SECRET at the next unused indexes and deployed in\n" +
+			"batches: one UserOperation per batch from --account (or POOL_DEPLOYER_ACCOUNT), otherwise one\n" +
+			"Multicall3 transaction per batch from the EOA key. Addresses are saved as pool_ready once they\n" +
+			"have code, so a failed batch is derived again at the same indexes on the next run.\n\n" +
+			"With --counterfactual, missing addresses are saved as undeployed pool_ready rows instead, for\n" +
+			"POOL_COUNTERFACTUAL_ADDRESSES; each is deployed by the first UserOperation sent from it.\n" +
+			"Meant to run on a schedule, e.g. from cron.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			if !common.IsHexAddress(owner) {
+				return fmt.Errorf("invalid owner address: %s", owner)
+			}
+			if batchSize < 1 {
+				return fmt.Errorf("--batch-size must be at least 1")
+			}
+
+			if account == "" {
+				account = os.Getenv("POOL_DEPLOYER_ACCOUNT")
+			}
+			if privateKey == "" {
+				privateKey = os.Getenv("DEPLOYER_PRIVATE_KEY")
+			}
+			if account == "" && privateKey == "" && !counterfactual && config.SignerConfig().Backend == signer.BackendLocal {
+				return fmt.Errorf("--account, POOL_DEPLOYER_ACCOUNT, --private-key, DEPLOYER_PRIVATE_KEY or a KMS SIGNER_BACKEND is required")
+			}
+
+			masterSecret, err := pool.MasterSecret()
+			if err != nil {
+				return err
+			}
+
+			if err := connect(); err != nil {
+				return err
+			}
+			defer disconnect()
+
+			ready, err := pool.ReadyCount(ctx, network, counterfactual)
+			if err != nil {
+				return err
+			}
+			if ready >= target {
+				fmt.Printf("✓ %s has %d pool_ready addresses (target %d), nothing to do\n", network, ready, target)
+				return nil
+			}
+			missing := target - ready
+
+			client, err := pool.DialNetwork(ctx, network, rpcURL)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			chainID, err := client.ChainID(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to get chain ID: %w", err)
+			}
+
+			var batcher batchDeployer
+			if counterfactual {
+				batcher = counterfactualBatch{}
+			} else if account != "" {
+				batcher, err = pool.NewUserOpDeployer(ctx, client, account)
+			} else {
+				var gasUrgency gasoracle.Urgency
+				if gasUrgency, err = gasoracle.ParseUrgency(urgency); err != nil {
+					return err
+				}
+				batcher, err = pool.NewDeployer(ctx, client, privateKey, gasUrgency)
+			}
+			if err != nil {
+				return err
+			}
+
+			start, err := pool.NextDerivationIndex(ctx, chainID.Int64())
+			if err != nil {
+				return err
+			}
+
+			action := "deploying"
+			if counterfactual {
+				action = "adding counterfactually"
+			}
+			fmt.Printf("%s has %d pool_ready addresses, %s %d to reach %d\n", network, ready, action, missing, target)
+			fmt.Printf("Deriving salts at indexes %d-%d, up to %d per batch\n", start, start+int64(missing)-1, batchSize)
+			if dryRun {
+				fmt.Println("🔍 DRY RUN MODE - No transactions will be sent")
+			}
+
+			deployed := 0
+			for offset := 0; offset < missing; offset += batchSize {
+				batch := make([]pool.AddressInfo, 0, batchSize)
+				for i := offset; i < min(offset+batchSize, missing); i++ {
+					info, err := pool.NewDerivedAddressInfo(ctx, client, masterSecret, start+int64(i), owner, chainID.Int64(), network)
+					if err != nil {
+						return fmt.Errorf("failed to derive address at index %d: %w", start+int64(i), err)
+					}
+					batch = append(batch, *info)
+				}
+
+				for i, result := range batcher.DeployBatch(ctx, batch, dryRun) {
+					if !result.Success {
+						fmt.Printf("✗ %s: %s\n", result.Address, result.Error)
+						continue
+					}
+					if !dryRun {
+						save := saveReady
+						if counterfactual {
+							save = saveCounterfactual
+						}
+						if err := save(ctx, &batch[i], result); err != nil {
+							fmt.Printf("✗ %s: %v\n", result.Address, err)
+							continue
+						}
+					}
+					deployed++
+					fmt.Printf("✓ %s %s\n", result.Address, result.TxHash)
+				}
+			}
+
+			fmt.Println(strings.Repeat("=", 60))
+			fmt.Printf("Deployed: %d, Failed: %d\n", deployed, missing-deployed)
+			if dryRun {
+				return nil
+			}
+			if err := printStatus(cmd, network); err != nil {
+				return err
+			}
+			if deployed < missing {
+				return fmt.Errorf("%d of %d deployments failed", missing-deployed, missing)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&network, "network", "base-sepolia", "Network identifier")
+	cmd.Flags().IntVar(&target, "target", 50, "Number of pool_ready addresses to keep on the network")
+	cmd.Flags().IntVar(&batchSize, "batch-size", 20, "Deployments per UserOperation or Multicall3 transaction")
+	cmd.Flags().StringVar(&owner, "owner", pool.DefaultOwnerAddress, "Owner address for the smart accounts")
+	cmd.Flags().StringVar(&account, "account", "", "Deployer smart account (defaults to POOL_DEPLOYER_ACCOUNT)")
+	cmd.Flags().StringVar(&privateKey, "private-key", "", "Deployer EOA private key used without a deployer account (defaults to DEPLOYER_PRIVATE_KEY)")
+	cmd.Flags().StringVar(&urgency, "urgency", "standard", "Gas price urgency of EOA transactions: slow, standard or fast")
+	cmd.Flags().StringVar(&rpcURL, "rpc-url", "", "RPC URL (defaults to the network's endpoint in the database)")
+	cmd.Flags().BoolVar(&counterfactual, "counterfactual", false, "Save missing addresses as undeployed pool_ready rows instead of deploying them")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Simulate the batches without sending transactions or saving addresses")
+
+	return cmd
+}
+
+// saveReady saves a deployed address and marks it pool_ready. An address saved by an earlier run
+// whose deployment was not recorded is only marked
+func saveReady(ctx context.Context, info *pool.AddressInfo, result pool.DeploymentResult) error {
+	_, err := pool.MarkDeployed(ctx, result, receiveaddress.StatusPoolReady)
+	if errors.Is(err, pool.ErrAddressNotFound) {
+		if err := pool.SaveAddress(ctx, info); err != nil {
+			return err
+		}
+		_, err = pool.MarkDeployed(ctx, result, receiveaddress.StatusPoolReady)
+	}
+	return err
+}
+
+// saveCounterfactual saves an address as an undeployed pool_ready row
+func saveCounterfactual(ctx context.Context, info *pool.AddressInfo, result pool.DeploymentResult) error {
+	return pool.SaveCounterfactualAddress(ctx, info)
+}
+
+// counterfactualBatch accepts every address of a batch without deploying it
+type counterfactualBatch struct{}
+
+func (counterfactualBatch) DeployBatch(ctx context.Context, infos []pool.AddressInfo, dryRun bool) []pool.DeploymentResult {
+	results := make([]pool.DeploymentResult, len(infos))
+	for i, info := range infos {
+		results[i] = pool.DeploymentResult{Address: info.Address, Success: true}
+	}
+	return results
+}
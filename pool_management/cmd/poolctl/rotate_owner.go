@@ -0,0 +1,159 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/pool_management/internal/pool"
+	"github.com/NEDA-LABS/stablenode/services"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+)
+
+// ownershipMinedTimeout is how long a transferOwnership UserOperation is waited on
+const ownershipMinedTimeout = 2 * time.Minute
+
+func newRotateOwnerCmd() *cobra.Command {
+	var (
+		network  string
+		newOwner string
+		rpcURL   string
+		limit    int
+		dryRun   bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "rotate-owner",
+		Short: "Transfer the deployed pool addresses of a network to a new owner",
+		Long: "Transfer the deployed pool addresses of a network to a new owner.\n\n" +
+			"Each address gets a UserOperation calling transferOwnership on the account, signed with the key of\n" +
+			"its current owner (the network's owner signer or the SIGNER_BACKEND owner key), and its rows record\n" +
+			"the new owner once owner() returns it.\n" +
+			"Accounts are only signed for by the key of their owner, so run it with deposits paused, then point\n" +
+			"the network's smart_account_owner_address and smart_account_owner_signer at the new key (or\n" +
+			"SMART_ACCOUNT_OWNER_ADDRESS and the owner key, once every network is done). Undeployed addresses are derived from their owner and can't be transferred; deploy them\n" +
+			"first. The command is safe to re-run: transferred accounts are only recorded.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			if !common.IsHexAddress(newOwner) || common.HexToAddress(newOwner) == (common.Address{}) {
+				return fmt.Errorf("invalid new owner: %s", newOwner)
+			}
+			target := common.HexToAddress(newOwner)
+
+			if err := connect(); err != nil {
+				return err
+			}
+			defer disconnect()
+
+			client, err := pool.DialNetwork(ctx, network, rpcURL)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			chainID, err := client.ChainID(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to get chain ID: %w", err)
+			}
+
+			deployed, undeployed, err := pool.OwnershipCandidates(ctx, network, target.Hex())
+			if err != nil {
+				return err
+			}
+			if limit > 0 && len(deployed) > limit {
+				deployed = deployed[:limit]
+			}
+
+			fmt.Printf("Transferring %d deployed addresses on %s to %s\n", len(deployed), network, target.Hex())
+			if len(undeployed) > 0 {
+				fmt.Printf("⚠ %d undeployed addresses keep their owner; deploy them and re-run\n", len(undeployed))
+			}
+			if dryRun {
+				fmt.Println("🔍 DRY RUN MODE - No transactions will be sent")
+			}
+
+			alchemy := services.NewAlchemyService()
+			transferred, failed := 0, 0
+			for i, address := range deployed {
+				prefix := fmt.Sprintf("[%d/%d] %s", i+1, len(deployed), address)
+
+				owner, err := pool.AccountOwner(ctx, client, address)
+				if err != nil {
+					fmt.Printf("%s ✗ %v\n", prefix, err)
+					failed++
+					continue
+				}
+
+				switch {
+				case owner == target:
+					// Transferred by an earlier run that didn't record it
+				case !holdsKey(ctx, chainID.Int64(), owner):
+					fmt.Printf("%s ✗ owned by %s, whose key no configured signer holds\n", prefix, owner.Hex())
+					failed++
+					continue
+				case dryRun:
+					fmt.Printf("%s would be transferred\n", prefix)
+					continue
+				default:
+					userOpHash, err := alchemy.TransferAccountOwnership(ctx, chainID.Int64(), address, target.Hex())
+					if err != nil {
+						fmt.Printf("%s ✗ %v\n", prefix, err)
+						failed++
+						continue
+					}
+					if _, err := alchemy.WaitForUserOperationMined(ctx, chainID.Int64(), userOpHash, ownershipMinedTimeout); err != nil {
+						fmt.Printf("%s ✗ user operation %s: %v\n", prefix, userOpHash, err)
+						failed++
+						continue
+					}
+					if owner, err = pool.AccountOwner(ctx, client, address); err != nil {
+						fmt.Printf("%s ✗ %v\n", prefix, err)
+						failed++
+						continue
+					}
+					if owner != target {
+						fmt.Printf("%s ✗ owner is still %s after user operation %s\n", prefix, owner.Hex(), userOpHash)
+						failed++
+						continue
+					}
+				}
+
+				if dryRun {
+					fmt.Printf("%s already owned by %s\n", prefix, target.Hex())
+					continue
+				}
+				if _, err := pool.SetOwner(ctx, network, address, target); err != nil {
+					fmt.Printf("%s ✗ %v\n", prefix, err)
+					failed++
+					continue
+				}
+				transferred++
+				fmt.Printf("%s ✓\n", prefix)
+			}
+
+			fmt.Println(strings.Repeat("=", 60))
+			fmt.Printf("Transferred: %d, Failed: %d, Undeployed: %d\n", transferred, failed, len(undeployed))
+			if failed > 0 {
+				return fmt.Errorf("%d ownership transfers failed", failed)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&network, "network", "base-sepolia", "Network identifier")
+	cmd.Flags().StringVar(&newOwner, "new-owner", "", "Address of the new owner key")
+	cmd.Flags().StringVar(&rpcURL, "rpc-url", "", "RPC URL (defaults to the network's endpoint in the database)")
+	cmd.Flags().IntVar(&limit, "limit", 0, "Maximum number of addresses to transfer (0 transfers all)")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Check current owners without sending transactions")
+
+	return cmd
+}
+
+// holdsKey reports whether a configured signer holds the key of an account owner on a chain
+func holdsKey(ctx context.Context, chainID int64, owner common.Address) bool {
+	_, err := services.OwnerSigner(ctx, chainID, owner.Hex())
+	return err == nil
+}
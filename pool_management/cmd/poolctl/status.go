@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/storage"
+	"github.com/spf13/cobra"
+)
+
+func newStatusCmd() *cobra.Command {
+	var network string
+
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show pool inventory per network",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := connect(); err != nil {
+				return err
+			}
+			defer disconnect()
+
+			return printStatus(cmd, network)
+		},
+	}
+
+	cmd.Flags().StringVar(&network, "network", "", "Only show this network identifier")
+
+	return cmd
+}
+
+// printStatus prints the pool status table for the given network (all networks when empty)
+func printStatus(cmd *cobra.Command, network string) error {
+	statuses, err := storage.PoolStatus(cmd.Context(), network)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("\nReceive Address Pool Status:")
+	fmt.Println(strings.Repeat("-", 96))
+	fmt.Printf("%-20s %-8s %-9s %-11s %-10s %-13s %-9s %s\n",
+		"NETWORK", "READY", "ASSIGNED", "PROCESSING", "COMPLETED", "UNDEPLOYED", "AVG USE", "OLDEST ASSIGNMENT")
+	for _, s := range statuses {
+		oldest := "-"
+		if s.OldestAssignmentAge != nil {
+			oldest = (time.Duration(*s.OldestAssignmentAge) * time.Second).String()
+		}
+		fmt.Printf("%-20s %-8d %-9d %-11d %-10d %-13d %-9.2f %s\n",
+			s.Network, s.PoolReady, s.PoolAssigned, s.PoolProcessing, s.PoolCompleted, s.NotDeployed, s.AverageTimesUsed, oldest)
+	}
+	fmt.Println(strings.Repeat("-", 96))
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/NEDA-LABS/stablenode/pool_management/internal/pool"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/spf13/cobra"
+)
+
+// defaultVerifySample is the number of stored addresses recomputed by the generation check
+const defaultVerifySample = 5
+
+func newVerifyCmd() *cobra.Command {
+	var (
+		network string
+		rpcURL  string
+		sample  int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Check that the current code still computes the stored addresses from their salts",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			if err := connect(); err != nil {
+				return err
+			}
+			defer disconnect()
+
+			client, err := pool.DialNetwork(ctx, network, rpcURL)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			mismatches, err := checkGeneration(ctx, client, network, sample)
+			if err != nil {
+				return err
+			}
+			if mismatches > 0 {
+				return fmt.Errorf("%d stored addresses no longer match the current code", mismatches)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&network, "network", "base-sepolia", "Network identifier")
+	cmd.Flags().StringVar(&rpcURL, "rpc-url", "", "RPC URL (defaults to the network's endpoint in the database)")
+	cmd.Flags().IntVar(&sample, "sample", defaultVerifySample, "Number of most recent addresses to recompute")
+
+	return cmd
+}
+
+// checkGeneration recomputes a sample of stored addresses and prints a warning for each one the
+// current code would compute differently. Returns the number of mismatches
+func checkGeneration(ctx context.Context, client *ethclient.Client, network string, sample int) (int, error) {
+	report, err := pool.VerifyGeneration(ctx, client, network, sample)
+	if err != nil {
+		return 0, err
+	}
+
+	fmt.Printf("Address generation fingerprint: %s\n", report.Fingerprint)
+	if report.Checked == 0 {
+		fmt.Printf("No fingerprinted addresses stored for %s, nothing to verify\n", network)
+		return 0, nil
+	}
+
+	for fingerprint, count := range report.StoredFingerprints {
+		if fingerprint != report.Fingerprint {
+			fmt.Printf("ℹ %d sampled addresses were generated by %s\n", count, fingerprint)
+		}
+	}
+
+	if len(report.Mismatches) == 0 {
+		fmt.Printf("✓ Recomputed %d stored addresses, all match\n", report.Checked)
+		return 0, nil
+	}
+
+	fmt.Println(strings.Repeat("=", 60))
+	fmt.Printf("⚠ WARNING: %d of %d stored addresses differ when recomputed from their salts\n",
+		len(report.Mismatches), report.Checked)
+	for _, mismatch := range report.Mismatches {
+		if mismatch.Error != nil {
+			fmt.Printf("  %s (%s): %v\n", mismatch.StoredAddress, mismatch.Fingerprint, mismatch.Error)
+			continue
+		}
+		fmt.Printf("  %s (%s) now computes to %s\n", mismatch.StoredAddress, mismatch.Fingerprint, mismatch.ComputedAddress)
+	}
+	fmt.Println("The address computation changed since these addresses were generated")
+	fmt.Println(strings.Repeat("=", 60))
+
+	return len(report.Mismatches), nil
+}
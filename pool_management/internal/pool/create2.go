@@ -0,0 +1,198 @@
+package pool
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+const (
+	// FactoryAddress is the Light Account Factory v2.0.0 (EntryPoint v0.7)
+	FactoryAddress = "0x0000000000400CdFef5E2714E63d8040b700BC24"
+
+	// DefaultOwnerAddress is the default owner of pool smart accounts
+	DefaultOwnerAddress = "0xFb84E5503bD20526f2579193411Dd0993d080775"
+
+	// createAccountSelector is the selector for createAccount(address owner, uint256 salt)
+	createAccountSelector = "5fbfb9cf"
+
+	// getAddressSelector is the selector for getAddress(address owner, uint256 salt)
+	getAddressSelector = "8cb84e18"
+
+	// GenerationVersion identifies the address computation logic. Bump it whenever the factory
+	// call encoding changes on purpose, so stored addresses record which code computed them
+	GenerationVersion = 1
+)
+
+// fingerprintOwner and fingerprintSalt are the fixed inputs the packing hash is computed over
+var (
+	fingerprintOwner = "0x1111111111111111111111111111111111111111"
+	fingerprintSalt  = [32]byte{31: 1}
+)
+
+// AddressInfo holds the generated address information
+type AddressInfo struct {
+	Address        string `json:"address"`
+	Salt           string `json:"salt"`
+	OwnerAddress   string `json:"owner_address"`
+	InitCode       string `json:"init_code"`
+	FactoryAddress string `json:"factory_address"`
+	FactoryData    string `json:"factory_data"`
+	NetworkID      string `json:"network_identifier"`
+	ChainID        int64  `json:"chain_id"`
+	DeployCommand  string `json:"deploy_command"`
+	Fingerprint    string `json:"generation_fingerprint"`
+	// SaltDerivation is "hkdf" for salts derived from the master secret at DerivationIndex
+	SaltDerivation  string `json:"salt_derivation,omitempty"`
+	DerivationIndex *int64 `json:"derivation_index,omitempty"`
+}
+
+// GenerateSalt generates a unique 32-byte salt from the current timestamp and random bytes
+func GenerateSalt() ([32]byte, error) {
+	var salt [32]byte
+
+	randomBytes := make([]byte, 32)
+	if _, err := rand.Read(randomBytes); err != nil {
+		return salt, err
+	}
+
+	hash := crypto.Keccak256Hash(
+		[]byte(fmt.Sprintf("%d", time.Now().UnixNano())),
+		randomBytes,
+	)
+
+	copy(salt[:], hash[:])
+	return salt, nil
+}
+
+// FactoryData returns the createAccount(owner, salt) calldata for the factory
+func FactoryData(ownerAddress string, salt [32]byte) string {
+	return "0x" + createAccountSelector + encodeOwnerAndSalt(ownerAddress, salt)
+}
+
+// InitCode returns the v0.6-style initCode (factory address ++ factory data)
+func InitCode(ownerAddress string, salt [32]byte) string {
+	return FactoryAddress + strings.TrimPrefix(FactoryData(ownerAddress, salt), "0x")
+}
+
+// Fingerprint identifies the code computing addresses: its version, the factory and a hash of the
+// getAddress and createAccount calldata it packs for fixed inputs. A refactor that changes the
+// packing changes the fingerprint even when GenerationVersion is not bumped
+func Fingerprint() string {
+	packingHash := crypto.Keccak256(
+		common.FromHex(getAddressCallData(fingerprintOwner, fingerprintSalt)),
+		common.FromHex(InitCode(fingerprintOwner, fingerprintSalt)),
+	)
+	return fmt.Sprintf("v%d:%s:%x", GenerationVersion, strings.ToLower(FactoryAddress), packingHash[:8])
+}
+
+// ComputeAddress asks the factory for the counterfactual address via getAddress(owner, salt)
+// The factory is the source of truth, so this matches the address that will be deployed
+func ComputeAddress(ctx context.Context, client *ethclient.Client, ownerAddress string, salt [32]byte) (common.Address, error) {
+	factory := common.HexToAddress(FactoryAddress)
+	data, err := hex.DecodeString(strings.TrimPrefix(getAddressCallData(ownerAddress, salt), "0x"))
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to encode getAddress call: %w", err)
+	}
+
+	result, err := client.CallContract(ctx, ethereum.CallMsg{
+		To:   &factory,
+		Data: data,
+	}, nil)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to call factory.getAddress: %w", err)
+	}
+
+	if len(result) < 32 {
+		return common.Address{}, fmt.Errorf("invalid response from factory.getAddress: %x", result)
+	}
+
+	return common.BytesToAddress(result[12:32]), nil
+}
+
+// NewAddressInfo generates a fresh salt and resolves the smart account address for it
+func NewAddressInfo(ctx context.Context, client *ethclient.Client, ownerAddress string, chainID int64, networkIdentifier string) (*AddressInfo, error) {
+	salt, err := GenerateSalt()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	return addressInfoForSalt(ctx, client, ownerAddress, chainID, networkIdentifier, salt)
+}
+
+// NewDerivedAddressInfo derives the salt at an index from the master secret and resolves the smart
+// account address for it
+func NewDerivedAddressInfo(ctx context.Context, client *ethclient.Client, masterSecret []byte, index int64, ownerAddress string, chainID int64, networkIdentifier string) (*AddressInfo, error) {
+	salt, err := DeriveSalt(masterSecret, chainID, index)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := addressInfoForSalt(ctx, client, ownerAddress, chainID, networkIdentifier, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	info.SaltDerivation = "hkdf"
+	info.DerivationIndex = &index
+	return info, nil
+}
+
+// addressInfoForSalt resolves the smart account address of a salt
+func addressInfoForSalt(ctx context.Context, client *ethclient.Client, ownerAddress string, chainID int64, networkIdentifier string, salt [32]byte) (*AddressInfo, error) {
+	address, err := ComputeAddress(ctx, client, ownerAddress, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	factoryData := FactoryData(ownerAddress, salt)
+
+	return &AddressInfo{
+		Address:        address.Hex(),
+		Salt:           fmt.Sprintf("0x%064x", salt),
+		OwnerAddress:   ownerAddress,
+		InitCode:       InitCode(ownerAddress, salt),
+		FactoryAddress: FactoryAddress,
+		FactoryData:    factoryData,
+		NetworkID:      networkIdentifier,
+		ChainID:        chainID,
+		DeployCommand: fmt.Sprintf(`cast send %s "%s" --rpc-url %s --private-key $PRIVATE_KEY`,
+			FactoryAddress, factoryData, networkIdentifier),
+		Fingerprint: Fingerprint(),
+	}, nil
+}
+
+// ParseSalt parses a 0x-prefixed 32-byte hex salt
+func ParseSalt(saltHex string) ([32]byte, error) {
+	var salt [32]byte
+
+	saltBytes, err := hex.DecodeString(strings.TrimPrefix(saltHex, "0x"))
+	if err != nil {
+		return salt, fmt.Errorf("invalid salt %s: %w", saltHex, err)
+	}
+	if len(saltBytes) > 32 {
+		return salt, fmt.Errorf("invalid salt %s: longer than 32 bytes", saltHex)
+	}
+
+	copy(salt[32-len(saltBytes):], saltBytes)
+	return salt, nil
+}
+
+// getAddressCallData returns the getAddress(owner, salt) calldata for the factory
+func getAddressCallData(ownerAddress string, salt [32]byte) string {
+	return "0x" + getAddressSelector + encodeOwnerAndSalt(ownerAddress, salt)
+}
+
+// encodeOwnerAndSalt ABI-encodes (address owner, uint256 salt) without the 0x prefix
+func encodeOwnerAndSalt(ownerAddress string, salt [32]byte) string {
+	ownerPadded := common.LeftPadBytes(common.HexToAddress(ownerAddress).Bytes(), 32)
+	return hex.EncodeToString(ownerPadded) + hex.EncodeToString(salt[:])
+}
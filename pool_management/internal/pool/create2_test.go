@@ -0,0 +1,28 @@
+package pool
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFingerprint(t *testing.T) {
+	salt, err := ParseSalt("0x01")
+	assert.NoError(t, err)
+
+	t.Run("should pack the factory calls", func(t *testing.T) {
+		owner := "0x1111111111111111111111111111111111111111"
+		packed := "0000000000000000000000001111111111111111111111111111111111111111" +
+			"0000000000000000000000000000000000000000000000000000000000000001"
+
+		assert.Equal(t, "0x8cb84e18"+packed, getAddressCallData(owner, salt))
+		assert.Equal(t, "0x5fbfb9cf"+packed, FactoryData(owner, salt))
+		assert.Equal(t, FactoryAddress+"5fbfb9cf"+packed, InitCode(owner, salt))
+	})
+
+	// A change here means stored salts may now compute to different addresses. Bump
+	// GenerationVersion if the change is intended and run poolctl verify against every network
+	t.Run("should match the pinned fingerprint", func(t *testing.T) {
+		assert.Equal(t, "v1:0x0000000000400cdfef5e2714e63d8040b700bc24:33358e431337df3f", Fingerprint())
+	})
+}
@@ -0,0 +1,152 @@
+package pool
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"github.com/NEDA-LABS/stablenode/services/gasoracle"
+	"github.com/NEDA-LABS/stablenode/utils/signer"
+)
+
+// Deployer sends factory createAccount transactions from an EOA
+type Deployer struct {
+	client  *ethclient.Client
+	signer  signer.Signer
+	from    common.Address
+	chainID *big.Int
+	// urgency is the gas oracle urgency level transactions are priced at
+	urgency gasoracle.Urgency
+}
+
+// NewDeployer creates a deployer pricing its transactions at the given urgency. It signs with the
+// given hex-encoded private key, or with SIGNER_DEPLOYER_KEY_ID when SIGNER_BACKEND is a KMS
+func NewDeployer(ctx context.Context, client *ethclient.Client, privateKeyHex string, urgency gasoracle.Urgency) (*Deployer, error) {
+	deployerSigner, err := signer.Deployer(ctx, privateKeyHex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid deployer key: %w", err)
+	}
+
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch chain ID: %w", err)
+	}
+
+	return &Deployer{
+		client:  client,
+		signer:  deployerSigner,
+		from:    deployerSigner.Address(),
+		chainID: chainID,
+		urgency: urgency,
+	}, nil
+}
+
+// From returns the deployer's address
+func (d *Deployer) From() common.Address {
+	return d.from
+}
+
+// IsDeployed reports whether the address already has contract code
+func IsDeployed(ctx context.Context, client *ethclient.Client, address string) (bool, error) {
+	code, err := client.CodeAt(ctx, common.HexToAddress(address), nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch code: %w", err)
+	}
+	return len(code) > 0, nil
+}
+
+// Deploy calls the factory with the address' factory data and waits for the receipt
+// Addresses that already have code are reported as successful without sending a transaction
+func (d *Deployer) Deploy(ctx context.Context, info AddressInfo, dryRun bool) DeploymentResult {
+	result := DeploymentResult{Address: info.Address}
+
+	deployed, err := IsDeployed(ctx, d.client, info.Address)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	if deployed {
+		result.Success = true
+		return result
+	}
+
+	factory := common.HexToAddress(info.FactoryAddress)
+	data := common.FromHex(info.FactoryData)
+
+	gasLimit, err := d.client.EstimateGas(ctx, ethereum.CallMsg{
+		From: d.from,
+		To:   &factory,
+		Data: data,
+	})
+	if err != nil {
+		result.Error = fmt.Sprintf("failed to estimate gas: %v", err)
+		return result
+	}
+
+	if dryRun {
+		result.Success = true
+		result.GasUsed = gasLimit
+		return result
+	}
+
+	tx, receipt, err := d.send(ctx, factory, data, gasLimit)
+	if tx != nil {
+		result.TxHash = tx.Hash().Hex()
+	}
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	result.BlockNumber = receipt.BlockNumber.Uint64()
+	result.GasUsed = receipt.GasUsed
+	result.Success = receipt.Status == types.ReceiptStatusSuccessful
+	if !result.Success {
+		result.Error = "transaction reverted"
+	}
+
+	return result
+}
+
+// send signs and sends a transaction from the deployer and waits for its receipt
+func (d *Deployer) send(ctx context.Context, to common.Address, data []byte, gasLimit uint64) (*types.Transaction, *types.Receipt, error) {
+	nonce, err := d.client.PendingNonceAt(ctx, d.from)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch nonce: %w", err)
+	}
+
+	fees, err := gasoracle.Default().SuggestFees(ctx, d.chainID.Int64(), d.client, d.urgency)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to suggest fees: %w", err)
+	}
+
+	tx, err := signer.SignTx(ctx, d.signer, types.NewTx(&types.DynamicFeeTx{
+		ChainID:   d.chainID,
+		Nonce:     nonce,
+		GasTipCap: fees.MaxPriorityFeePerGas,
+		GasFeeCap: fees.MaxFeePerGas,
+		Gas:       gasLimit * 12 / 10, // 20% headroom
+		To:        &to,
+		Data:      data,
+	}), d.chainID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to sign transaction: %w", err)
+	}
+
+	if err := d.client.SendTransaction(ctx, tx); err != nil {
+		return nil, nil, fmt.Errorf("failed to send transaction: %w", err)
+	}
+
+	receipt, err := bind.WaitMined(ctx, d.client, tx)
+	if err != nil {
+		return tx, nil, fmt.Errorf("failed waiting for receipt: %w", err)
+	}
+
+	return tx, receipt, nil
+}
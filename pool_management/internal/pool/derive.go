@@ -0,0 +1,89 @@
+package pool
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	"github.com/NEDA-LABS/stablenode/storage"
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	// saltDerivationInfo prefixes the HKDF info of derived salts. Changing it changes every derived
+	// salt, so a new derivation scheme needs a new prefix rather than an edit
+	saltDerivationInfo = "stablenode/pool-salt/v1"
+
+	// minMasterSecretLength is the minimum master secret length in bytes
+	minMasterSecretLength = 32
+)
+
+// MasterSecret returns the hex-encoded salt master secret set in SALT_MASTER_SECRET
+func MasterSecret() ([]byte, error) {
+	encoded := strings.TrimPrefix(config.CryptoConfig().SaltMasterSecret, "0x")
+	if encoded == "" {
+		return nil, fmt.Errorf("SALT_MASTER_SECRET is not set")
+	}
+
+	secret, err := hex.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("SALT_MASTER_SECRET is not valid hex: %w", err)
+	}
+	if len(secret) < minMasterSecretLength {
+		return nil, fmt.Errorf("SALT_MASTER_SECRET must be at least %d bytes", minMasterSecretLength)
+	}
+
+	return secret, nil
+}
+
+// DeriveSalt derives the salt at an index for a chain with HKDF-SHA256 over the master secret.
+// The chain ID and index are part of the HKDF info, so every (chain, index) pair gets its own salt
+// and the pool can be rebuilt from the secret and the index ranges used
+func DeriveSalt(masterSecret []byte, chainID int64, index int64) ([32]byte, error) {
+	var salt [32]byte
+
+	if len(masterSecret) < minMasterSecretLength {
+		return salt, fmt.Errorf("master secret must be at least %d bytes", minMasterSecretLength)
+	}
+	if index < 0 {
+		return salt, fmt.Errorf("invalid derivation index %d", index)
+	}
+
+	info := make([]byte, 0, len(saltDerivationInfo)+16)
+	info = append(info, saltDerivationInfo...)
+	info = binary.BigEndian.AppendUint64(info, uint64(chainID))
+	info = binary.BigEndian.AppendUint64(info, uint64(index))
+
+	if _, err := io.ReadFull(hkdf.New(sha256.New, masterSecret, nil, info), salt[:]); err != nil {
+		return salt, fmt.Errorf("failed to derive salt: %w", err)
+	}
+
+	return salt, nil
+}
+
+// NextDerivationIndex returns the index after the highest derived salt stored for a chain
+func NextDerivationIndex(ctx context.Context, chainID int64) (int64, error) {
+	last, err := storage.Client.ReceiveAddress.
+		Query().
+		Where(
+			receiveaddress.ChainIDEQ(chainID),
+			receiveaddress.SaltDerivationEQ(receiveaddress.SaltDerivationHkdf),
+			receiveaddress.DerivationIndexNotNil(),
+		).
+		Order(ent.Desc(receiveaddress.FieldDerivationIndex)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return 0, nil
+	} else if err != nil {
+		return 0, fmt.Errorf("failed to fetch last derivation index: %w", err)
+	}
+
+	return *last.DerivationIndex + 1, nil
+}
@@ -0,0 +1,67 @@
+package pool
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeriveSalt(t *testing.T) {
+	secret := bytes.Repeat([]byte{0x42}, 32)
+
+	t.Run("should derive the same salt for the same chain and index", func(t *testing.T) {
+		salt, err := DeriveSalt(secret, 84532, 7)
+		assert.NoError(t, err)
+
+		again, err := DeriveSalt(secret, 84532, 7)
+		assert.NoError(t, err)
+		assert.Equal(t, salt, again)
+	})
+
+	// A change here means pools created with --derive can no longer be reconstructed
+	t.Run("should match the pinned HKDF output", func(t *testing.T) {
+		salt, err := DeriveSalt(secret, 84532, 7)
+		assert.NoError(t, err)
+		assert.Equal(t, "0x1847da28043a73d4ca616211e88fd275c94466b13c5d2e2b3ea737781532fee3", fmt.Sprintf("0x%064x", salt))
+	})
+
+	t.Run("should derive distinct salts per chain and index", func(t *testing.T) {
+		seen := make(map[[32]byte]bool)
+		for _, chainID := range []int64{1, 8453, 84532} {
+			for index := int64(0); index < 50; index++ {
+				salt, err := DeriveSalt(secret, chainID, index)
+				assert.NoError(t, err)
+				assert.False(t, seen[salt], "duplicate salt for chain %d index %d", chainID, index)
+				seen[salt] = true
+			}
+		}
+	})
+
+	t.Run("should reject short secrets and negative indexes", func(t *testing.T) {
+		_, err := DeriveSalt(secret[:16], 84532, 0)
+		assert.Error(t, err)
+
+		_, err = DeriveSalt(secret, 84532, -1)
+		assert.Error(t, err)
+	})
+
+	t.Run("should read the hex master secret from config", func(t *testing.T) {
+		defer viper.Set("SALT_MASTER_SECRET", "")
+
+		viper.Set("SALT_MASTER_SECRET", "0x"+fmt.Sprintf("%x", secret))
+		parsed, err := MasterSecret()
+		assert.NoError(t, err)
+		assert.Equal(t, secret, parsed)
+
+		viper.Set("SALT_MASTER_SECRET", "not-hex")
+		_, err = MasterSecret()
+		assert.Error(t, err)
+
+		viper.Set("SALT_MASTER_SECRET", "")
+		_, err = MasterSecret()
+		assert.Error(t, err)
+	})
+}
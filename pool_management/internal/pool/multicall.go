@@ -0,0 +1,219 @@
+package pool
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// Multicall3Address is the canonical Multicall3 deployment, at the same address on every supported chain
+const Multicall3Address = "0xcA11bde05977b3631167028862bE2a173976CA11"
+
+const multicall3ABI = `[{"inputs":[{"components":[{"name":"target","type":"address"},{"name":"allowFailure","type":"bool"},{"name":"callData","type":"bytes"}],"name":"calls","type":"tuple[]"}],"name":"aggregate3","outputs":[{"components":[{"name":"success","type":"bool"},{"name":"returnData","type":"bytes"}],"name":"returnData","type":"tuple[]"}],"stateMutability":"payable","type":"function"}]`
+
+// multicallCall is a Multicall3 Call3
+type multicallCall struct {
+	Target       common.Address `abi:"target"`
+	AllowFailure bool           `abi:"allowFailure"`
+	CallData     []byte         `abi:"callData"`
+}
+
+// multicallResult is a Multicall3 Result
+type multicallResult struct {
+	Success    bool   `abi:"success"`
+	ReturnData []byte `abi:"returnData"`
+}
+
+var multicall3, _ = abi.JSON(strings.NewReader(multicall3ABI))
+
+// encodeAggregate3 packs the createAccount calls of the addresses into an aggregate3 call. Subcalls
+// may fail individually so one bad entry doesn't revert the whole batch
+func encodeAggregate3(infos []AddressInfo) ([]byte, error) {
+	calls := make([]multicallCall, 0, len(infos))
+	for _, info := range infos {
+		calls = append(calls, multicallCall{
+			Target:       common.HexToAddress(info.FactoryAddress),
+			AllowFailure: true,
+			CallData:     common.FromHex(info.FactoryData),
+		})
+	}
+	return multicall3.Pack("aggregate3", calls)
+}
+
+// decodeAggregate3 unpacks the per-subcall results of an aggregate3 call
+func decodeAggregate3(data []byte) ([]multicallResult, error) {
+	var results []multicallResult
+	if err := multicall3.UnpackIntoInterface(&results, "aggregate3", data); err != nil {
+		return nil, fmt.Errorf("failed to decode aggregate3 results: %w", err)
+	}
+	return results, nil
+}
+
+// subcallError returns why a createAccount subcall didn't deploy the expected address, or "" if it did
+func subcallError(info AddressInfo, result multicallResult) string {
+	if !result.Success {
+		return "createAccount reverted"
+	}
+	if len(result.ReturnData) < 32 {
+		return fmt.Sprintf("invalid createAccount return data: %x", result.ReturnData)
+	}
+	if account := common.BytesToAddress(result.ReturnData[12:32]); !strings.EqualFold(account.Hex(), info.Address) {
+		return fmt.Sprintf("createAccount returned %s", account.Hex())
+	}
+	return ""
+}
+
+// DeployBatch deploys the addresses with one Multicall3 aggregate3 transaction, sharing the base
+// transaction cost across the batch. The batch is simulated first so subcalls that would fail are
+// reported and left out; the rest are checked for code once the transaction is mined. Gas used is
+// split evenly across the deployed addresses. Addresses that already have code are reported as
+// successful without being included
+func (d *Deployer) DeployBatch(ctx context.Context, infos []AddressInfo, dryRun bool) []DeploymentResult {
+	results, included, data := prepareBatch(ctx, d.client, d.from, infos)
+	if len(included) == 0 {
+		return results
+	}
+
+	multicall := common.HexToAddress(Multicall3Address)
+	gasLimit, err := d.client.EstimateGas(ctx, ethereum.CallMsg{From: d.from, To: &multicall, Data: data})
+	if err != nil {
+		return failBatch(results, included, "failed to estimate gas: %v", err)
+	}
+
+	if dryRun {
+		for _, i := range included {
+			results[i].Success = true
+			results[i].GasUsed = gasLimit / uint64(len(included))
+		}
+		return results
+	}
+
+	tx, receipt, err := d.send(ctx, multicall, data, gasLimit)
+	if tx != nil {
+		for _, i := range included {
+			results[i].TxHash = tx.Hash().Hex()
+		}
+	}
+	if err != nil {
+		return failBatch(results, included, "%v", err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return failBatch(results, included, "transaction reverted")
+	}
+
+	return checkBatch(ctx, d.client, infos, results, included, receipt.BlockNumber.Uint64(), receipt.GasUsed)
+}
+
+// prepareBatch checks which addresses still need deploying and simulates their createAccount
+// subcalls as sent from the given address. It returns the results so far, the indexes of the
+// addresses left in the batch and the aggregate3 call data deploying them. Addresses that already
+// have code are reported as successful and subcalls that would fail are reported and left out
+func prepareBatch(ctx context.Context, client *ethclient.Client, from common.Address, infos []AddressInfo) ([]DeploymentResult, []int, []byte) {
+	results := make([]DeploymentResult, len(infos))
+
+	var pending []int
+	for i, info := range infos {
+		results[i].Address = info.Address
+
+		deployed, err := IsDeployed(ctx, client, info.Address)
+		if err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+		if deployed {
+			results[i].Success = true
+			continue
+		}
+		pending = append(pending, i)
+	}
+	if len(pending) == 0 {
+		return results, nil, nil
+	}
+
+	multicall := common.HexToAddress(Multicall3Address)
+	deployed, err := IsDeployed(ctx, client, Multicall3Address)
+	if err != nil {
+		return failBatch(results, pending, "%v", err), nil, nil
+	}
+	if !deployed {
+		return failBatch(results, pending, "Multicall3 is not deployed on this network"), nil, nil
+	}
+
+	// Simulate to drop subcalls that would fail
+	batch := make([]AddressInfo, 0, len(pending))
+	for _, i := range pending {
+		batch = append(batch, infos[i])
+	}
+	data, err := encodeAggregate3(batch)
+	if err != nil {
+		return failBatch(results, pending, "failed to encode batch: %v", err), nil, nil
+	}
+
+	returnData, err := client.CallContract(ctx, ethereum.CallMsg{From: from, To: &multicall, Data: data}, nil)
+	if err != nil {
+		return failBatch(results, pending, "failed to simulate batch: %v", err), nil, nil
+	}
+	simulated, err := decodeAggregate3(returnData)
+	if err != nil {
+		return failBatch(results, pending, "%v", err), nil, nil
+	}
+	if len(simulated) != len(pending) {
+		return failBatch(results, pending, "batch simulation returned %d results for %d calls", len(simulated), len(pending)), nil, nil
+	}
+
+	var included []int
+	batch = batch[:0]
+	for j, i := range pending {
+		if reason := subcallError(infos[i], simulated[j]); reason != "" {
+			results[i].Error = reason
+			continue
+		}
+		included = append(included, i)
+		batch = append(batch, infos[i])
+	}
+	if len(included) == 0 {
+		return results, nil, nil
+	}
+
+	data, err = encodeAggregate3(batch)
+	if err != nil {
+		return failBatch(results, included, "failed to encode batch: %v", err), nil, nil
+	}
+
+	return results, included, data
+}
+
+// failBatch fails the results at the given indexes with the same error
+func failBatch(results []DeploymentResult, indexes []int, format string, args ...interface{}) []DeploymentResult {
+	for _, i := range indexes {
+		results[i].Error = fmt.Sprintf(format, args...)
+	}
+	return results
+}
+
+// checkBatch checks the addresses included in a mined batch for code, splitting the gas used
+// evenly across them
+func checkBatch(ctx context.Context, client *ethclient.Client, infos []AddressInfo, results []DeploymentResult, included []int, blockNumber, gasUsed uint64) []DeploymentResult {
+	for _, i := range included {
+		results[i].BlockNumber = blockNumber
+		results[i].GasUsed = gasUsed / uint64(len(included))
+
+		deployed, err := IsDeployed(ctx, client, infos[i].Address)
+		switch {
+		case err != nil:
+			results[i].Error = err.Error()
+		case !deployed:
+			results[i].Error = "no code after batch transaction"
+		default:
+			results[i].Success = true
+		}
+	}
+
+	return results
+}
@@ -0,0 +1,60 @@
+package pool
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMulticall(t *testing.T) {
+	salt, err := ParseSalt("0x01")
+	assert.NoError(t, err)
+
+	info := AddressInfo{
+		Address:        "0x2222222222222222222222222222222222222222",
+		FactoryAddress: FactoryAddress,
+		FactoryData:    FactoryData("0x1111111111111111111111111111111111111111", salt),
+	}
+
+	t.Run("should pack createAccount subcalls into aggregate3", func(t *testing.T) {
+		data, err := encodeAggregate3([]AddressInfo{info, info})
+		assert.NoError(t, err)
+
+		// aggregate3((address,bool,bytes)[])
+		assert.Equal(t, "82ad56cb", common.Bytes2Hex(data[:4]))
+
+		args, err := multicall3.Methods["aggregate3"].Inputs.Unpack(data[4:])
+		assert.NoError(t, err)
+		calls := args[0].([]struct {
+			Target       common.Address `json:"target"`
+			AllowFailure bool           `json:"allowFailure"`
+			CallData     []uint8        `json:"callData"`
+		})
+		if assert.Len(t, calls, 2) {
+			assert.Equal(t, common.HexToAddress(FactoryAddress), calls[0].Target)
+			assert.True(t, calls[0].AllowFailure)
+			assert.Equal(t, common.FromHex(info.FactoryData), calls[0].CallData)
+		}
+	})
+
+	t.Run("should decode per-subcall results", func(t *testing.T) {
+		returned := common.LeftPadBytes(common.HexToAddress(info.Address).Bytes(), 32)
+		other := common.LeftPadBytes(common.HexToAddress("0x3333333333333333333333333333333333333333").Bytes(), 32)
+
+		packed, err := multicall3.Methods["aggregate3"].Outputs.Pack([]multicallResult{
+			{Success: true, ReturnData: returned},
+			{Success: false, ReturnData: nil},
+			{Success: true, ReturnData: other},
+		})
+		assert.NoError(t, err)
+
+		results, err := decodeAggregate3(packed)
+		assert.NoError(t, err)
+		if assert.Len(t, results, 3) {
+			assert.Empty(t, subcallError(info, results[0]))
+			assert.Equal(t, "createAccount reverted", subcallError(info, results[1]))
+			assert.Equal(t, "createAccount returned 0x3333333333333333333333333333333333333333", subcallError(info, results[2]))
+		}
+	})
+}
@@ -0,0 +1,84 @@
+package pool
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	"github.com/NEDA-LABS/stablenode/storage"
+)
+
+// ownerSelector is the selector for owner()
+const ownerSelector = "8da5cb5b"
+
+// AccountOwner returns the current owner of a deployed Light Account
+func AccountOwner(ctx context.Context, client *ethclient.Client, account string) (common.Address, error) {
+	to := common.HexToAddress(account)
+	result, err := client.CallContract(ctx, ethereum.CallMsg{To: &to, Data: common.Hex2Bytes(ownerSelector)}, nil)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to call owner(): %w", err)
+	}
+	if len(result) < 32 {
+		return common.Address{}, fmt.Errorf("invalid owner() result: %x", result)
+	}
+	return common.BytesToAddress(result[12:32]), nil
+}
+
+// OwnershipCandidates returns the pool addresses of a network whose stored owner isn't newOwner,
+// split into deployed addresses, which can be transferred, and undeployed ones, whose address is
+// derived from their owner and can't be
+func OwnershipCandidates(ctx context.Context, networkIdentifier string, newOwner string) (deployed []string, undeployed []string, err error) {
+	// Only the pool rows carry the salt; order rows share their address
+	rows, err := storage.Client.ReceiveAddress.
+		Query().
+		Where(
+			receiveaddress.NetworkIdentifierEQ(networkIdentifier),
+			receiveaddress.SaltNotNil(),
+			receiveaddress.Or(
+				receiveaddress.OwnerAddressIsNil(),
+				receiveaddress.Not(receiveaddress.OwnerAddressEqualFold(newOwner)),
+			),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch pool addresses: %w", err)
+	}
+
+	seen := make(map[string]bool, len(rows))
+	for _, row := range rows {
+		key := strings.ToLower(row.Address)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		if row.IsDeployed {
+			deployed = append(deployed, row.Address)
+		} else {
+			undeployed = append(undeployed, row.Address)
+		}
+	}
+
+	return deployed, undeployed, nil
+}
+
+// SetOwner records the owner of an address on all its rows of a network
+func SetOwner(ctx context.Context, networkIdentifier string, address string, owner common.Address) (int, error) {
+	updated, err := storage.Client.ReceiveAddress.
+		Update().
+		Where(
+			receiveaddress.AddressEqualFold(address),
+			receiveaddress.NetworkIdentifierEQ(networkIdentifier),
+		).
+		SetOwnerAddress(owner.Hex()).
+		Save(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to update owner of %s: %w", address, err)
+	}
+	return updated, nil
+}
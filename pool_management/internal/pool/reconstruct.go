@@ -0,0 +1,78 @@
+package pool
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	"github.com/NEDA-LABS/stablenode/storage"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// ReconstructedAddress is a pool address re-derived from the master secret
+type ReconstructedAddress struct {
+	AddressInfo
+	Deployed bool `json:"deployed"`
+}
+
+// Reconstruct re-derives the pool addresses of a chain at indexes from through to and checks which
+// of them are deployed
+func Reconstruct(ctx context.Context, client *ethclient.Client, masterSecret []byte, ownerAddress string, chainID int64, networkIdentifier string, from, to int64) ([]ReconstructedAddress, error) {
+	if from < 0 || to < from {
+		return nil, fmt.Errorf("invalid index range %d-%d", from, to)
+	}
+
+	addresses := make([]ReconstructedAddress, 0, to-from+1)
+	for index := from; index <= to; index++ {
+		info, err := NewDerivedAddressInfo(ctx, client, masterSecret, index, ownerAddress, chainID, networkIdentifier)
+		if err != nil {
+			return addresses, fmt.Errorf("index %d: %w", index, err)
+		}
+
+		code, err := client.CodeAt(ctx, common.HexToAddress(info.Address), nil)
+		if err != nil {
+			return addresses, fmt.Errorf("index %d: failed to fetch code of %s: %w", index, info.Address, err)
+		}
+
+		addresses = append(addresses, ReconstructedAddress{AddressInfo: *info, Deployed: len(code) > 0})
+	}
+
+	return addresses, nil
+}
+
+// RestoreAddress stores a reconstructed address missing from the database, as pool_ready when it
+// is deployed. Returns false when the pool row already exists
+func RestoreAddress(ctx context.Context, address ReconstructedAddress) (bool, error) {
+	exists, err := storage.Client.ReceiveAddress.
+		Query().
+		Where(
+			receiveaddress.SaltNotNil(),
+			receiveaddress.Or(
+				receiveaddress.AddressEqualFold(address.Address),
+				receiveaddress.And(
+					receiveaddress.ChainIDEQ(address.ChainID),
+					receiveaddress.DerivationIndexEQ(*address.DerivationIndex),
+				),
+			),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to check for existing row: %w", err)
+	}
+	if exists {
+		return false, nil
+	}
+
+	if err := SaveAddress(ctx, &address.AddressInfo); err != nil {
+		return false, err
+	}
+
+	if address.Deployed {
+		if _, err := MarkDeployed(ctx, DeploymentResult{Address: address.Address}, receiveaddress.StatusPoolReady); err != nil {
+			return true, err
+		}
+	}
+
+	return true, nil
+}
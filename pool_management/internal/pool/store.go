@@ -0,0 +1,250 @@
+package pool
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	networkent "github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddressassignment"
+	"github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils"
+	cryptoUtils "github.com/NEDA-LABS/stablenode/utils/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// ErrAddressNotFound is returned when an address has no receive_addresses rows
+var ErrAddressNotFound = errors.New("address not found in database")
+
+// DeploymentResult is a single entry of a deployment results file
+type DeploymentResult struct {
+	Address     string `json:"address"`
+	TxHash      string `json:"tx_hash"`
+	BlockNumber uint64 `json:"block_number"`
+	Success     bool   `json:"success"`
+	Error       string `json:"error,omitempty"`
+	GasUsed     uint64 `json:"gas_used"`
+}
+
+// Connect opens the database connection using the aggregator's .env configuration
+func Connect() error {
+	if err := storage.DBConnection(config.DBConfig()); err != nil {
+		return fmt.Errorf("failed to initialize storage: %w", err)
+	}
+	return nil
+}
+
+// Close closes the database connection opened by Connect
+func Close() {
+	if storage.Client != nil {
+		storage.Client.Close()
+	}
+}
+
+// DialNetwork returns an RPC client for the given network
+// rpcURL overrides the endpoint stored on the network row when set
+func DialNetwork(ctx context.Context, networkIdentifier, rpcURL string) (*ethclient.Client, error) {
+	if rpcURL == "" {
+		if storage.Client == nil {
+			return nil, fmt.Errorf("no RPC URL provided and database is not connected")
+		}
+
+		network, err := storage.Client.Network.
+			Query().
+			Where(networkent.IdentifierEQ(networkIdentifier)).
+			Only(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch network %s: %w", networkIdentifier, err)
+		}
+		rpcURL = network.RPCEndpoint
+	}
+
+	client, err := ethclient.DialContext(ctx, utils.BuildRPCURL(rpcURL))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to RPC: %w", err)
+	}
+
+	return client, nil
+}
+
+// SaveAddress stores a generated address as an undeployed pool row with an encrypted salt and its
+// derivation metadata
+func SaveAddress(ctx context.Context, info *AddressInfo) error {
+	return saveAddress(ctx, info, receiveaddress.StatusUnused) // Set to pool_ready after deployment
+}
+
+// SaveCounterfactualAddress stores a generated address as an undeployed pool_ready row. With
+// POOL_COUNTERFACTUAL_ADDRESSES enabled it is assigned to orders right away and deployed by the
+// first UserOperation sent from it
+func SaveCounterfactualAddress(ctx context.Context, info *AddressInfo) error {
+	return saveAddress(ctx, info, receiveaddress.StatusPoolReady)
+}
+
+// saveAddress stores a generated undeployed pool row with the given status
+func saveAddress(ctx context.Context, info *AddressInfo, status receiveaddress.Status) error {
+	salt, err := ParseSalt(info.Salt)
+	if err != nil {
+		return err
+	}
+
+	encryptedSalt, err := cryptoUtils.EncryptPlain(salt[:])
+	if err != nil {
+		return fmt.Errorf("failed to encrypt salt: %w", err)
+	}
+
+	create := storage.Client.ReceiveAddress.
+		Create().
+		SetAddress(info.Address).
+		SetSalt(encryptedSalt).
+		SetStatus(status).
+		SetIsDeployed(false).
+		SetChainID(info.ChainID).
+		SetNetworkIdentifier(info.NetworkID).
+		SetTimesUsed(0).
+		SetOwnerAddress(info.OwnerAddress).
+		SetGenerationFingerprint(info.Fingerprint).
+		SetNillableDerivationIndex(info.DerivationIndex)
+	if info.SaltDerivation != "" {
+		create.SetSaltDerivation(receiveaddress.SaltDerivation(info.SaltDerivation))
+	}
+
+	_, err = create.Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to save to database: %w", err)
+	}
+
+	return nil
+}
+
+// MarkDeployed marks every row for a deployed address as deployed with the given status
+// Returns the number of rows updated; rows already deployed are left untouched
+func MarkDeployed(ctx context.Context, result DeploymentResult, status receiveaddress.Status) (int, error) {
+	addresses, err := storage.Client.ReceiveAddress.
+		Query().
+		Where(receiveaddress.AddressEqualFold(result.Address)).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query address: %w", err)
+	}
+
+	if len(addresses) == 0 {
+		return 0, ErrAddressNotFound
+	}
+
+	updated := 0
+	for _, addr := range addresses {
+		if addr.IsDeployed && addr.Status == status {
+			continue
+		}
+
+		update := addr.Update().
+			SetIsDeployed(true).
+			SetStatus(status).
+			SetDeployedAt(time.Now())
+		if result.TxHash != "" {
+			update.SetDeploymentTxHash(result.TxHash)
+		}
+		if result.BlockNumber > 0 {
+			update.SetDeploymentBlock(int64(result.BlockNumber))
+		}
+
+		if _, err := update.Save(ctx); err != nil {
+			return updated, fmt.Errorf("failed to update row %d: %w", addr.ID, err)
+		}
+		updated++
+	}
+
+	return updated, nil
+}
+
+// ReadyCount returns the number of addresses available for assignment on a network. Undeployed
+// pool_ready addresses are only counted when counterfactual is set
+func ReadyCount(ctx context.Context, networkIdentifier string, counterfactual bool) (int, error) {
+	query := storage.Client.ReceiveAddress.
+		Query().
+		Where(
+			receiveaddress.NetworkIdentifierEQ(networkIdentifier),
+			receiveaddress.StatusEQ(receiveaddress.StatusPoolReady),
+		)
+	if !counterfactual {
+		query = query.Where(receiveaddress.IsDeployedEQ(true))
+	}
+	count, err := query.Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count pool_ready addresses: %w", err)
+	}
+	return count, nil
+}
+
+// Recycle returns completed pool addresses to the pool
+// An empty networkIdentifier recycles across all networks
+func Recycle(ctx context.Context, networkIdentifier string, dryRun bool) (int, error) {
+	query := storage.Client.ReceiveAddress.
+		Query().
+		Where(
+			receiveaddress.StatusEQ(receiveaddress.StatusPoolCompleted),
+			receiveaddress.IsDeployedEQ(true),
+		)
+	if networkIdentifier != "" {
+		query = query.Where(receiveaddress.NetworkIdentifierEQ(networkIdentifier))
+	}
+
+	if dryRun {
+		return query.Count(ctx)
+	}
+
+	ids, err := query.IDs(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch completed addresses: %w", err)
+	}
+
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	// Close the windows of the orders the addresses served before they go back to the pool
+	_, err = storage.Client.ReceiveAddressAssignment.
+		Update().
+		Where(
+			receiveaddressassignment.HasReceiveAddressWith(receiveaddress.IDIn(ids...)),
+			receiveaddressassignment.ReleasedAtIsNil(),
+		).
+		SetReleasedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to release address assignments: %w", err)
+	}
+
+	return storage.Client.ReceiveAddress.
+		Update().
+		Where(receiveaddress.IDIn(ids...)).
+		SetStatus(receiveaddress.StatusPoolReady).
+		SetRecycledAt(time.Now()).
+		ClearAssignedAt().
+		Save(ctx)
+}
+
+// WriteJSON writes v as indented JSON to filename
+func WriteJSON(filename string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filename, data, 0644)
+}
+
+// ReadJSON decodes the JSON file at filename into v
+func ReadJSON(filename string, v interface{}) error {
+	file, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return json.NewDecoder(file).Decode(v)
+}
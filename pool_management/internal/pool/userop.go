@@ -0,0 +1,136 @@
+package pool
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"github.com/NEDA-LABS/stablenode/services"
+)
+
+// userOpMinedTimeout is how long a batch UserOperation is waited on before its addresses are
+// reported as failed
+const userOpMinedTimeout = 3 * time.Minute
+
+// UserOpDeployer deploys addresses from an already deployed smart account owned by
+// the smart account owner key. A batch is a single UserOperation whose execute() call runs the
+// factory createAccount calls through Multicall3, so deployments can be sponsored by the paymaster
+// instead of funded from an EOA
+type UserOpDeployer struct {
+	client  *ethclient.Client
+	alchemy *services.AlchemyService
+	account common.Address
+	chainID int64
+}
+
+// NewUserOpDeployer creates a deployer sending UserOperations from the given smart account
+func NewUserOpDeployer(ctx context.Context, client *ethclient.Client, account string) (*UserOpDeployer, error) {
+	if !common.IsHexAddress(account) {
+		return nil, fmt.Errorf("invalid deployer account: %s", account)
+	}
+
+	deployed, err := IsDeployed(ctx, client, account)
+	if err != nil {
+		return nil, err
+	}
+	if !deployed {
+		return nil, fmt.Errorf("deployer account %s is not deployed", account)
+	}
+
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chain ID: %w", err)
+	}
+
+	return &UserOpDeployer{
+		client:  client,
+		alchemy: services.NewAlchemyService(),
+		account: common.HexToAddress(account),
+		chainID: chainID.Int64(),
+	}, nil
+}
+
+// From returns the deployer smart account
+func (d *UserOpDeployer) From() common.Address {
+	return d.account
+}
+
+// DeployBatch deploys the addresses with one UserOperation, simulating the batch from the deployer
+// account first like Deployer.DeployBatch. Addresses that already have code are reported as
+// successful without being included
+func (d *UserOpDeployer) DeployBatch(ctx context.Context, infos []AddressInfo, dryRun bool) []DeploymentResult {
+	results, included, data := prepareBatch(ctx, d.client, d.account, infos)
+	if len(included) == 0 {
+		return results
+	}
+
+	multicall := common.HexToAddress(Multicall3Address)
+	gasLimit, err := d.client.EstimateGas(ctx, ethereum.CallMsg{From: d.account, To: &multicall, Data: data})
+	if err != nil {
+		return failBatch(results, included, "failed to estimate gas: %v", err)
+	}
+
+	if dryRun {
+		for _, i := range included {
+			results[i].Success = true
+			results[i].GasUsed = gasLimit / uint64(len(included))
+		}
+		return results
+	}
+
+	// Leave headroom for execute() on top of the multicall itself
+	txPayload := map[string]interface{}{
+		"to":           Multicall3Address,
+		"data":         hexutil.Encode(data),
+		"value":        "0",
+		"callGasLimit": hexutil.EncodeUint64(gasLimit * 6 / 5),
+	}
+	userOpHash, err := d.alchemy.SendTransactionBatch(ctx, d.chainID, d.account.Hex(), []map[string]interface{}{txPayload})
+	if err != nil {
+		return failBatch(results, included, "failed to send user operation: %v", err)
+	}
+
+	receipt, err := d.alchemy.WaitForUserOperationMined(ctx, d.chainID, userOpHash, userOpMinedTimeout)
+	if err != nil {
+		return failBatch(results, included, "user operation %s: %v", userOpHash, err)
+	}
+
+	txHash, blockNumber, gasUsed := parseUserOpReceipt(receipt)
+	for _, i := range included {
+		results[i].TxHash = txHash
+	}
+	if success, ok := receipt["success"].(bool); ok && !success {
+		return failBatch(results, included, "user operation %s reverted", userOpHash)
+	}
+
+	return checkBatch(ctx, d.client, infos, results, included, blockNumber, gasUsed)
+}
+
+// parseUserOpReceipt reads the bundle transaction hash, block number and gas used of a mined
+// UserOperation from its eth_getUserOperationReceipt result
+func parseUserOpReceipt(receipt map[string]interface{}) (txHash string, blockNumber uint64, gasUsed uint64) {
+	if actualGasUsed, ok := receipt["actualGasUsed"].(string); ok {
+		if value, ok := new(big.Int).SetString(strings.TrimPrefix(actualGasUsed, "0x"), 16); ok {
+			gasUsed = value.Uint64()
+		}
+	}
+
+	tx, _ := receipt["receipt"].(map[string]interface{})
+	if hash, ok := tx["transactionHash"].(string); ok {
+		txHash = hash
+	}
+	if block, ok := tx["blockNumber"].(string); ok {
+		if value, ok := new(big.Int).SetString(strings.TrimPrefix(block, "0x"), 16); ok {
+			blockNumber = value.Uint64()
+		}
+	}
+
+	return txHash, blockNumber, gasUsed
+}
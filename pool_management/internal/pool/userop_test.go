@@ -0,0 +1,30 @@
+package pool
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseUserOpReceipt(t *testing.T) {
+	t.Run("should read the bundle transaction of a mined UserOperation", func(t *testing.T) {
+		txHash, blockNumber, gasUsed := parseUserOpReceipt(map[string]interface{}{
+			"success":       true,
+			"actualGasUsed": "0x4c4b40",
+			"receipt": map[string]interface{}{
+				"transactionHash": "0xabc",
+				"blockNumber":     "0x1e240",
+			},
+		})
+		assert.Equal(t, "0xabc", txHash)
+		assert.Equal(t, uint64(123456), blockNumber)
+		assert.Equal(t, uint64(5000000), gasUsed)
+	})
+
+	t.Run("should tolerate missing fields", func(t *testing.T) {
+		txHash, blockNumber, gasUsed := parseUserOpReceipt(map[string]interface{}{})
+		assert.Empty(t, txHash)
+		assert.Zero(t, blockNumber)
+		assert.Zero(t, gasUsed)
+	})
+}
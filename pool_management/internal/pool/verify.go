@@ -0,0 +1,92 @@
+package pool
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	"github.com/NEDA-LABS/stablenode/storage"
+	cryptoUtils "github.com/NEDA-LABS/stablenode/utils/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// GenerationMismatch is a stored address the current code computes differently from its stored salt
+type GenerationMismatch struct {
+	StoredAddress   string
+	ComputedAddress string
+	Fingerprint     string
+	Error           error
+}
+
+// GenerationReport is the result of recomputing a sample of stored addresses
+type GenerationReport struct {
+	Fingerprint string
+	Checked     int
+	// StoredFingerprints counts the sampled rows by the fingerprint of the code that generated them
+	StoredFingerprints map[string]int
+	Mismatches         []GenerationMismatch
+}
+
+// VerifyGeneration recomputes the most recently generated addresses of a network from their stored
+// owner and salt, catching changes to the address computation that would silently produce
+// different addresses for the same salts
+func VerifyGeneration(ctx context.Context, client *ethclient.Client, networkIdentifier string, sampleSize int) (*GenerationReport, error) {
+	addresses, err := storage.Client.ReceiveAddress.
+		Query().
+		Where(
+			receiveaddress.NetworkIdentifierEQ(networkIdentifier),
+			receiveaddress.GenerationFingerprintNEQ(""),
+			receiveaddress.OwnerAddressNEQ(""),
+		).
+		Order(ent.Desc(receiveaddress.FieldCreatedAt)).
+		Limit(sampleSize).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch generated addresses: %w", err)
+	}
+
+	report := &GenerationReport{
+		Fingerprint:        Fingerprint(),
+		StoredFingerprints: make(map[string]int),
+	}
+
+	for _, address := range addresses {
+		report.Checked++
+		report.StoredFingerprints[address.GenerationFingerprint]++
+
+		computed, err := recomputeAddress(ctx, client, address)
+		if err != nil || !strings.EqualFold(computed, address.Address) {
+			report.Mismatches = append(report.Mismatches, GenerationMismatch{
+				StoredAddress:   address.Address,
+				ComputedAddress: computed,
+				Fingerprint:     address.GenerationFingerprint,
+				Error:           err,
+			})
+		}
+	}
+
+	return report, nil
+}
+
+// recomputeAddress computes the address of a stored row from its owner and salt with the current code
+func recomputeAddress(ctx context.Context, client *ethclient.Client, address *ent.ReceiveAddress) (string, error) {
+	saltBytes, err := cryptoUtils.DecryptPlain(address.Salt)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt salt: %w", err)
+	}
+	if len(saltBytes) != 32 {
+		return "", fmt.Errorf("invalid salt length %d", len(saltBytes))
+	}
+
+	var salt [32]byte
+	copy(salt[:], saltBytes)
+
+	computed, err := ComputeAddress(ctx, client, address.OwnerAddress, salt)
+	if err != nil {
+		return "", err
+	}
+
+	return computed.Hex(), nil
+}
@@ -0,0 +1,643 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.24.4
+// source: proto/internalapi/v1/internal.proto
+
+package internalapiv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type OrderTransition int32
+
+const (
+	OrderTransition_ORDER_TRANSITION_UNSPECIFIED OrderTransition = 0
+	OrderTransition_ORDER_TRANSITION_CREATE      OrderTransition = 1
+	OrderTransition_ORDER_TRANSITION_SETTLE      OrderTransition = 2
+	OrderTransition_ORDER_TRANSITION_REFUND      OrderTransition = 3
+)
+
+// Enum value maps for OrderTransition.
+var (
+	OrderTransition_name = map[int32]string{
+		0: "ORDER_TRANSITION_UNSPECIFIED",
+		1: "ORDER_TRANSITION_CREATE",
+		2: "ORDER_TRANSITION_SETTLE",
+		3: "ORDER_TRANSITION_REFUND",
+	}
+	OrderTransition_value = map[string]int32{
+		"ORDER_TRANSITION_UNSPECIFIED": 0,
+		"ORDER_TRANSITION_CREATE":      1,
+		"ORDER_TRANSITION_SETTLE":      2,
+		"ORDER_TRANSITION_REFUND":      3,
+	}
+)
+
+func (x OrderTransition) Enum() *OrderTransition {
+	p := new(OrderTransition)
+	*p = x
+	return p
+}
+
+func (x OrderTransition) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderTransition) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_internalapi_v1_internal_proto_enumTypes[0].Descriptor()
+}
+
+func (OrderTransition) Type() protoreflect.EnumType {
+	return &file_proto_internalapi_v1_internal_proto_enumTypes[0]
+}
+
+func (x OrderTransition) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderTransition.Descriptor instead.
+func (OrderTransition) EnumDescriptor() ([]byte, []int) {
+	return file_proto_internalapi_v1_internal_proto_rawDescGZIP(), []int{0}
+}
+
+type SubmitDepositEventRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainId      int64  `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	TokenAddress string `protobuf:"bytes,2,opt,name=token_address,json=tokenAddress,proto3" json:"token_address,omitempty"`
+	TxHash       string `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	BlockNumber  int64  `protobuf:"varint,4,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	FromAddress  string `protobuf:"bytes,5,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	ToAddress    string `protobuf:"bytes,6,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// Transfer value in token units as a decimal string, e.g. "10.5"
+	Value string `protobuf:"bytes,7,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *SubmitDepositEventRequest) Reset() {
+	*x = SubmitDepositEventRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_internalapi_v1_internal_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitDepositEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitDepositEventRequest) ProtoMessage() {}
+
+func (x *SubmitDepositEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_internalapi_v1_internal_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitDepositEventRequest.ProtoReflect.Descriptor instead.
+func (*SubmitDepositEventRequest) Descriptor() ([]byte, []int) {
+	return file_proto_internalapi_v1_internal_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SubmitDepositEventRequest) GetChainId() int64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *SubmitDepositEventRequest) GetTokenAddress() string {
+	if x != nil {
+		return x.TokenAddress
+	}
+	return ""
+}
+
+func (x *SubmitDepositEventRequest) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *SubmitDepositEventRequest) GetBlockNumber() int64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *SubmitDepositEventRequest) GetFromAddress() string {
+	if x != nil {
+		return x.FromAddress
+	}
+	return ""
+}
+
+func (x *SubmitDepositEventRequest) GetToAddress() string {
+	if x != nil {
+		return x.ToAddress
+	}
+	return ""
+}
+
+func (x *SubmitDepositEventRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type SubmitDepositEventResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SubmitDepositEventResponse) Reset() {
+	*x = SubmitDepositEventResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_internalapi_v1_internal_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitDepositEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitDepositEventResponse) ProtoMessage() {}
+
+func (x *SubmitDepositEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_internalapi_v1_internal_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitDepositEventResponse.ProtoReflect.Descriptor instead.
+func (*SubmitDepositEventResponse) Descriptor() ([]byte, []int) {
+	return file_proto_internalapi_v1_internal_proto_rawDescGZIP(), []int{1}
+}
+
+type TransitionOrderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Payment order ID for create, lock payment order ID for settle and refund
+	OrderId    string          `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Transition OrderTransition `protobuf:"varint,2,opt,name=transition,proto3,enum=stablenode.internalapi.v1.OrderTransition" json:"transition,omitempty"`
+}
+
+func (x *TransitionOrderRequest) Reset() {
+	*x = TransitionOrderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_internalapi_v1_internal_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransitionOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitionOrderRequest) ProtoMessage() {}
+
+func (x *TransitionOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_internalapi_v1_internal_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitionOrderRequest.ProtoReflect.Descriptor instead.
+func (*TransitionOrderRequest) Descriptor() ([]byte, []int) {
+	return file_proto_internalapi_v1_internal_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TransitionOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *TransitionOrderRequest) GetTransition() OrderTransition {
+	if x != nil {
+		return x.Transition
+	}
+	return OrderTransition_ORDER_TRANSITION_UNSPECIFIED
+}
+
+type TransitionOrderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TransitionOrderResponse) Reset() {
+	*x = TransitionOrderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_internalapi_v1_internal_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransitionOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitionOrderResponse) ProtoMessage() {}
+
+func (x *TransitionOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_internalapi_v1_internal_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitionOrderResponse.ProtoReflect.Descriptor instead.
+func (*TransitionOrderResponse) Descriptor() ([]byte, []int) {
+	return file_proto_internalapi_v1_internal_proto_rawDescGZIP(), []int{3}
+}
+
+type AllocatePoolAddressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Network string `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+}
+
+func (x *AllocatePoolAddressRequest) Reset() {
+	*x = AllocatePoolAddressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_internalapi_v1_internal_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllocatePoolAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllocatePoolAddressRequest) ProtoMessage() {}
+
+func (x *AllocatePoolAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_internalapi_v1_internal_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllocatePoolAddressRequest.ProtoReflect.Descriptor instead.
+func (*AllocatePoolAddressRequest) Descriptor() ([]byte, []int) {
+	return file_proto_internalapi_v1_internal_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AllocatePoolAddressRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+type AllocatePoolAddressResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReceiveAddressId string                 `protobuf:"bytes,1,opt,name=receive_address_id,json=receiveAddressId,proto3" json:"receive_address_id,omitempty"`
+	Address          string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	ValidUntil       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
+}
+
+func (x *AllocatePoolAddressResponse) Reset() {
+	*x = AllocatePoolAddressResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_internalapi_v1_internal_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllocatePoolAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllocatePoolAddressResponse) ProtoMessage() {}
+
+func (x *AllocatePoolAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_internalapi_v1_internal_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllocatePoolAddressResponse.ProtoReflect.Descriptor instead.
+func (*AllocatePoolAddressResponse) Descriptor() ([]byte, []int) {
+	return file_proto_internalapi_v1_internal_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AllocatePoolAddressResponse) GetReceiveAddressId() string {
+	if x != nil {
+		return x.ReceiveAddressId
+	}
+	return ""
+}
+
+func (x *AllocatePoolAddressResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AllocatePoolAddressResponse) GetValidUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return nil
+}
+
+var File_proto_internalapi_v1_internal_proto protoreflect.FileDescriptor
+
+var file_proto_internalapi_v1_internal_proto_rawDesc = []byte{
+	0x0a, 0x23, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x19, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x6e, 0x6f, 0x64,
+	0x65, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xef, 0x01, 0x0a, 0x19, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x44, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x66,
+	0x72, 0x6f, 0x6d, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x74, 0x6f, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0x1c, 0x0a, 0x1a, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x7f, 0x0a, 0x16, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x4a, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x73, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x19, 0x0a, 0x17, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x0a,
+	0x1a, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x22, 0xa2, 0x01, 0x0a, 0x1b, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3b, 0x0a,
+	0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x2a, 0x8a, 0x01, 0x0a, 0x0f, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20,
+	0x0a, 0x1c, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x49, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x1b, 0x0a, 0x17, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x49,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x1b, 0x0a,
+	0x17, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x49, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x4c, 0x45, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x4f, 0x52,
+	0x44, 0x45, 0x52, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52,
+	0x45, 0x46, 0x55, 0x4e, 0x44, 0x10, 0x03, 0x32, 0x96, 0x03, 0x0a, 0x0f, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x81, 0x01, 0x0a, 0x12,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x34, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x6e, 0x6f, 0x64, 0x65, 0x2e,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x78, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x12, 0x31, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x6e, 0x6f, 0x64, 0x65, 0x2e,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x6e, 0x6f,
+	0x64, 0x65, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x84, 0x01, 0x0a, 0x13, 0x41, 0x6c,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x35, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6f,
+	0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x42, 0x44, 0x5a, 0x42, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e,
+	0x45, 0x44, 0x41, 0x2d, 0x4c, 0x41, 0x42, 0x53, 0x2f, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x6e,
+	0x6f, 0x64, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x3b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x61, 0x70, 0x69, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_internalapi_v1_internal_proto_rawDescOnce sync.Once
+	file_proto_internalapi_v1_internal_proto_rawDescData = file_proto_internalapi_v1_internal_proto_rawDesc
+)
+
+func file_proto_internalapi_v1_internal_proto_rawDescGZIP() []byte {
+	file_proto_internalapi_v1_internal_proto_rawDescOnce.Do(func() {
+		file_proto_internalapi_v1_internal_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_internalapi_v1_internal_proto_rawDescData)
+	})
+	return file_proto_internalapi_v1_internal_proto_rawDescData
+}
+
+var file_proto_internalapi_v1_internal_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_internalapi_v1_internal_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_proto_internalapi_v1_internal_proto_goTypes = []interface{}{
+	(OrderTransition)(0),                // 0: stablenode.internalapi.v1.OrderTransition
+	(*SubmitDepositEventRequest)(nil),   // 1: stablenode.internalapi.v1.SubmitDepositEventRequest
+	(*SubmitDepositEventResponse)(nil),  // 2: stablenode.internalapi.v1.SubmitDepositEventResponse
+	(*TransitionOrderRequest)(nil),      // 3: stablenode.internalapi.v1.TransitionOrderRequest
+	(*TransitionOrderResponse)(nil),     // 4: stablenode.internalapi.v1.TransitionOrderResponse
+	(*AllocatePoolAddressRequest)(nil),  // 5: stablenode.internalapi.v1.AllocatePoolAddressRequest
+	(*AllocatePoolAddressResponse)(nil), // 6: stablenode.internalapi.v1.AllocatePoolAddressResponse
+	(*timestamppb.Timestamp)(nil),       // 7: google.protobuf.Timestamp
+}
+var file_proto_internalapi_v1_internal_proto_depIdxs = []int32{
+	0, // 0: stablenode.internalapi.v1.TransitionOrderRequest.transition:type_name -> stablenode.internalapi.v1.OrderTransition
+	7, // 1: stablenode.internalapi.v1.AllocatePoolAddressResponse.valid_until:type_name -> google.protobuf.Timestamp
+	1, // 2: stablenode.internalapi.v1.InternalService.SubmitDepositEvent:input_type -> stablenode.internalapi.v1.SubmitDepositEventRequest
+	3, // 3: stablenode.internalapi.v1.InternalService.TransitionOrder:input_type -> stablenode.internalapi.v1.TransitionOrderRequest
+	5, // 4: stablenode.internalapi.v1.InternalService.AllocatePoolAddress:input_type -> stablenode.internalapi.v1.AllocatePoolAddressRequest
+	2, // 5: stablenode.internalapi.v1.InternalService.SubmitDepositEvent:output_type -> stablenode.internalapi.v1.SubmitDepositEventResponse
+	4, // 6: stablenode.internalapi.v1.InternalService.TransitionOrder:output_type -> stablenode.internalapi.v1.TransitionOrderResponse
+	6, // 7: stablenode.internalapi.v1.InternalService.AllocatePoolAddress:output_type -> stablenode.internalapi.v1.AllocatePoolAddressResponse
+	5, // [5:8] is the sub-list for method output_type
+	2, // [2:5] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_proto_internalapi_v1_internal_proto_init() }
+func file_proto_internalapi_v1_internal_proto_init() {
+	if File_proto_internalapi_v1_internal_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_internalapi_v1_internal_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitDepositEventRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_internalapi_v1_internal_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitDepositEventResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_internalapi_v1_internal_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransitionOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_internalapi_v1_internal_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransitionOrderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_internalapi_v1_internal_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AllocatePoolAddressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_internalapi_v1_internal_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AllocatePoolAddressResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_internalapi_v1_internal_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_internalapi_v1_internal_proto_goTypes,
+		DependencyIndexes: file_proto_internalapi_v1_internal_proto_depIdxs,
+		EnumInfos:         file_proto_internalapi_v1_internal_proto_enumTypes,
+		MessageInfos:      file_proto_internalapi_v1_internal_proto_msgTypes,
+	}.Build()
+	File_proto_internalapi_v1_internal_proto = out.File
+	file_proto_internalapi_v1_internal_proto_rawDesc = nil
+	file_proto_internalapi_v1_internal_proto_goTypes = nil
+	file_proto_internalapi_v1_internal_proto_depIdxs = nil
+}
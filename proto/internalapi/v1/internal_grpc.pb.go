@@ -0,0 +1,189 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.24.4
+// source: proto/internalapi/v1/internal.proto
+
+package internalapiv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	InternalService_SubmitDepositEvent_FullMethodName  = "/stablenode.internalapi.v1.InternalService/SubmitDepositEvent"
+	InternalService_TransitionOrder_FullMethodName     = "/stablenode.internalapi.v1.InternalService/TransitionOrder"
+	InternalService_AllocatePoolAddress_FullMethodName = "/stablenode.internalapi.v1.InternalService/AllocatePoolAddress"
+)
+
+// InternalServiceClient is the client API for InternalService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type InternalServiceClient interface {
+	// SubmitDepositEvent processes a token transfer to a receive address
+	SubmitDepositEvent(ctx context.Context, in *SubmitDepositEventRequest, opts ...grpc.CallOption) (*SubmitDepositEventResponse, error)
+	// TransitionOrder moves a payment order to its next on-chain state
+	TransitionOrder(ctx context.Context, in *TransitionOrderRequest, opts ...grpc.CallOption) (*TransitionOrderResponse, error)
+	// AllocatePoolAddress assigns a pool receive address on a network
+	AllocatePoolAddress(ctx context.Context, in *AllocatePoolAddressRequest, opts ...grpc.CallOption) (*AllocatePoolAddressResponse, error)
+}
+
+type internalServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewInternalServiceClient(cc grpc.ClientConnInterface) InternalServiceClient {
+	return &internalServiceClient{cc}
+}
+
+func (c *internalServiceClient) SubmitDepositEvent(ctx context.Context, in *SubmitDepositEventRequest, opts ...grpc.CallOption) (*SubmitDepositEventResponse, error) {
+	out := new(SubmitDepositEventResponse)
+	err := c.cc.Invoke(ctx, InternalService_SubmitDepositEvent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalServiceClient) TransitionOrder(ctx context.Context, in *TransitionOrderRequest, opts ...grpc.CallOption) (*TransitionOrderResponse, error) {
+	out := new(TransitionOrderResponse)
+	err := c.cc.Invoke(ctx, InternalService_TransitionOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalServiceClient) AllocatePoolAddress(ctx context.Context, in *AllocatePoolAddressRequest, opts ...grpc.CallOption) (*AllocatePoolAddressResponse, error) {
+	out := new(AllocatePoolAddressResponse)
+	err := c.cc.Invoke(ctx, InternalService_AllocatePoolAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// InternalServiceServer is the server API for InternalService service.
+// All implementations must embed UnimplementedInternalServiceServer
+// for forward compatibility
+type InternalServiceServer interface {
+	// SubmitDepositEvent processes a token transfer to a receive address
+	SubmitDepositEvent(context.Context, *SubmitDepositEventRequest) (*SubmitDepositEventResponse, error)
+	// TransitionOrder moves a payment order to its next on-chain state
+	TransitionOrder(context.Context, *TransitionOrderRequest) (*TransitionOrderResponse, error)
+	// AllocatePoolAddress assigns a pool receive address on a network
+	AllocatePoolAddress(context.Context, *AllocatePoolAddressRequest) (*AllocatePoolAddressResponse, error)
+	mustEmbedUnimplementedInternalServiceServer()
+}
+
+// UnimplementedInternalServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedInternalServiceServer struct {
+}
+
+func (UnimplementedInternalServiceServer) SubmitDepositEvent(context.Context, *SubmitDepositEventRequest) (*SubmitDepositEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitDepositEvent not implemented")
+}
+func (UnimplementedInternalServiceServer) TransitionOrder(context.Context, *TransitionOrderRequest) (*TransitionOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransitionOrder not implemented")
+}
+func (UnimplementedInternalServiceServer) AllocatePoolAddress(context.Context, *AllocatePoolAddressRequest) (*AllocatePoolAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllocatePoolAddress not implemented")
+}
+func (UnimplementedInternalServiceServer) mustEmbedUnimplementedInternalServiceServer() {}
+
+// UnsafeInternalServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InternalServiceServer will
+// result in compilation errors.
+type UnsafeInternalServiceServer interface {
+	mustEmbedUnimplementedInternalServiceServer()
+}
+
+func RegisterInternalServiceServer(s grpc.ServiceRegistrar, srv InternalServiceServer) {
+	s.RegisterService(&InternalService_ServiceDesc, srv)
+}
+
+func _InternalService_SubmitDepositEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitDepositEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalServiceServer).SubmitDepositEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InternalService_SubmitDepositEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalServiceServer).SubmitDepositEvent(ctx, req.(*SubmitDepositEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalService_TransitionOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalServiceServer).TransitionOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InternalService_TransitionOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalServiceServer).TransitionOrder(ctx, req.(*TransitionOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalService_AllocatePoolAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllocatePoolAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalServiceServer).AllocatePoolAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InternalService_AllocatePoolAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalServiceServer).AllocatePoolAddress(ctx, req.(*AllocatePoolAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// InternalService_ServiceDesc is the grpc.ServiceDesc for InternalService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var InternalService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "stablenode.internalapi.v1.InternalService",
+	HandlerType: (*InternalServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubmitDepositEvent",
+			Handler:    _InternalService_SubmitDepositEvent_Handler,
+		},
+		{
+			MethodName: "TransitionOrder",
+			Handler:    _InternalService_TransitionOrder_Handler,
+		},
+		{
+			MethodName: "AllocatePoolAddress",
+			Handler:    _InternalService_AllocatePoolAddress_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/internalapi/v1/internal.proto",
+}
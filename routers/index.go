@@ -6,6 +6,8 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/NEDA-LABS/stablenode/controllers"
 	"github.com/NEDA-LABS/stablenode/controllers/accounts"
+	"github.com/NEDA-LABS/stablenode/controllers/admin"
+	"github.com/NEDA-LABS/stablenode/controllers/graphql"
 	"github.com/NEDA-LABS/stablenode/controllers/provider"
 	"github.com/NEDA-LABS/stablenode/controllers/sender"
 	"github.com/NEDA-LABS/stablenode/routers/middleware"
@@ -18,15 +20,19 @@ func RegisterRoutes(route *gin.Engine) {
 	route.NoRoute(func(ctx *gin.Context) {
 		u.APIResponse(ctx, http.StatusNotFound, "error", "Route Not Found", nil)
 	})
-	route.GET("/health", func(ctx *gin.Context) { ctx.JSON(http.StatusOK, gin.H{"live": "ok"}) })
 
 	// Add all routes
 	authRoutes(route)
 	senderRoutes(route)
 	providerRoutes(route)
+	adminRoutes(route)
 
 	ctrl := controllers.NewController()
 
+	// Liveness and readiness probes
+	route.GET("/health", ctrl.Health)
+	route.GET("/ready", ctrl.Ready)
+
 	v1 := route.Group("/v1/")
 
 	v1.GET(
@@ -62,7 +68,16 @@ func RegisterRoutes(route *gin.Engine) {
 	v1.POST("kyc/webhook", ctrl.KYCWebhook)
 
 	// Insight webhook route
-	v1.POST("insight/webhook", ctrl.InsightWebhook)
+	v1.POST("insight/webhook", middleware.WebhookMetricsMiddleware("insight"), ctrl.InsightWebhook)
+
+	// Alchemy Address Activity webhook route
+	v1.POST("alchemy/webhook", middleware.WebhookMetricsMiddleware("alchemy"), ctrl.AlchemyWebhook)
+
+	// Notify provider deposit webhook route, authenticated per webhook record
+	v1.POST("notify/webhook/:webhook_id", middleware.WebhookMetricsMiddleware("notify"), ctrl.NotifyWebhook)
+
+	// Webhook URL self-check route
+	v1.GET("webhook/health", ctrl.WebhookHealth)
 
 	// Linked address routes
 	v1.POST("linked-addresses", middleware.PrivyMiddleware, ctrl.CreateLinkedAddress)
@@ -115,35 +130,202 @@ func authRoutes(route *gin.Engine) {
 		middleware.OnlySenderMiddleware,
 		profileCtrl.UpdateSenderProfile,
 	)
+	v1.POST(
+		"settings/sender/webhook-secret",
+		middleware.OnlyWebMiddleware,
+		middleware.JWTMiddleware,
+		middleware.OnlySenderMiddleware,
+		profileCtrl.RotateWebhookSecret,
+	)
+	v1.POST(
+		"settings/sender/request-signing",
+		middleware.OnlyWebMiddleware,
+		middleware.JWTMiddleware,
+		middleware.OnlySenderMiddleware,
+		profileCtrl.EnableRequestSigning,
+	)
+	v1.DELETE(
+		"settings/sender/request-signing",
+		middleware.OnlyWebMiddleware,
+		middleware.JWTMiddleware,
+		middleware.OnlySenderMiddleware,
+		profileCtrl.DisableRequestSigning,
+	)
+	v1.GET(
+		"settings/sender/api-keys",
+		middleware.OnlyWebMiddleware,
+		middleware.JWTMiddleware,
+		middleware.OnlySenderMiddleware,
+		profileCtrl.ListAPIKeys,
+	)
+	v1.POST(
+		"settings/sender/api-keys",
+		middleware.OnlyWebMiddleware,
+		middleware.JWTMiddleware,
+		middleware.OnlySenderMiddleware,
+		profileCtrl.CreateAPIKey,
+	)
+	v1.POST(
+		"settings/sender/api-keys/:id/rotate",
+		middleware.OnlyWebMiddleware,
+		middleware.JWTMiddleware,
+		middleware.OnlySenderMiddleware,
+		profileCtrl.RotateAPIKey,
+	)
+	v1.DELETE(
+		"settings/sender/api-keys/:id",
+		middleware.OnlyWebMiddleware,
+		middleware.JWTMiddleware,
+		middleware.OnlySenderMiddleware,
+		profileCtrl.RevokeAPIKey,
+	)
+	v1.GET(
+		"settings/provider/api-keys",
+		middleware.OnlyWebMiddleware,
+		middleware.JWTMiddleware,
+		middleware.OnlyProviderMiddleware,
+		profileCtrl.ListAPIKeys,
+	)
+	v1.POST(
+		"settings/provider/api-keys",
+		middleware.OnlyWebMiddleware,
+		middleware.JWTMiddleware,
+		middleware.OnlyProviderMiddleware,
+		profileCtrl.CreateAPIKey,
+	)
+	v1.POST(
+		"settings/provider/api-keys/:id/rotate",
+		middleware.OnlyWebMiddleware,
+		middleware.JWTMiddleware,
+		middleware.OnlyProviderMiddleware,
+		profileCtrl.RotateAPIKey,
+	)
+	v1.DELETE(
+		"settings/provider/api-keys/:id",
+		middleware.OnlyWebMiddleware,
+		middleware.JWTMiddleware,
+		middleware.OnlyProviderMiddleware,
+		profileCtrl.RevokeAPIKey,
+	)
 }
 
 func senderRoutes(route *gin.Engine) {
 	senderCtrl := sender.NewSenderController()
+	graphqlCtrl := graphql.NewGraphQLController()
 
 	v1 := route.Group("/v1/sender/")
 	v1.Use(middleware.DynamicAuthMiddleware)
 	v1.Use(middleware.OnlySenderMiddleware)
+	v1.Use(middleware.RequestSignatureMiddleware)
 
-	v1.POST("orders", senderCtrl.InitiatePaymentOrder)
-	v1.GET("orders/:id", senderCtrl.GetPaymentOrderByID)
-	v1.GET("orders", senderCtrl.GetPaymentOrders)
-	v1.GET("stats", senderCtrl.Stats)
+	v1.POST(
+		"orders",
+		middleware.RequireScope(u.APIKeyScopeCreateOrders),
+		middleware.IdempotencyMiddleware,
+		senderCtrl.InitiatePaymentOrder,
+	)
+	v1.POST(
+		"orders/batch",
+		middleware.RequireScope(u.APIKeyScopeCreateOrders),
+		middleware.IdempotencyMiddleware,
+		senderCtrl.InitiatePaymentOrderBatch,
+	)
+	v1.GET("orders/:id", middleware.RequireScope(u.APIKeyScopeRead), senderCtrl.GetPaymentOrderByID)
+	v1.GET("orders/:id/events", middleware.RequireScope(u.APIKeyScopeRead), senderCtrl.StreamPaymentOrderEvents)
+	v1.POST("orders/:id/extend", middleware.RequireScope(u.APIKeyScopeCreateOrders), senderCtrl.ExtendReceiveAddress)
+	v1.GET("orders/:id/permit", middleware.RequireScope(u.APIKeyScopeRead), senderCtrl.GetPermitDeposit)
+	v1.POST("orders/:id/permit", middleware.RequireScope(u.APIKeyScopeCreateOrders), senderCtrl.SubmitPermitDeposit)
+	v1.GET("orders", middleware.RequireScope(u.APIKeyScopeRead), senderCtrl.GetPaymentOrders)
+	v1.GET("stats", middleware.RequireScope(u.APIKeyScopeRead), senderCtrl.Stats)
+	v1.GET("limits", middleware.RequireScope(u.APIKeyScopeRead), senderCtrl.GetLimits)
+	v1.GET("webhooks/deliveries", middleware.RequireScope(u.APIKeyScopeWebhooksAdmin), senderCtrl.GetWebhookDeliveries)
+	v1.POST("linked-addresses", middleware.RequireScope(u.APIKeyScopeCreateOrders), senderCtrl.CreateLinkedAddress)
+	v1.GET("linked-addresses", middleware.RequireScope(u.APIKeyScopeRead), senderCtrl.GetLinkedAddresses)
+	v1.POST("linked-addresses/:id/rotate", middleware.RequireScope(u.APIKeyScopeCreateOrders), senderCtrl.RotateLinkedAddress)
+	v1.POST("linked-addresses/:id/deactivate", middleware.RequireScope(u.APIKeyScopeCreateOrders), senderCtrl.DeactivateLinkedAddress)
+	v1.POST("graphql", middleware.RequireScope(u.APIKeyScopeRead), graphqlCtrl.SenderQuery)
 }
 
 func providerRoutes(route *gin.Engine) {
 	providerCtrl := provider.NewProviderController()
+	graphqlCtrl := graphql.NewGraphQLController()
 
 	v1 := route.Group("/v1/provider/")
 	v1.Use(middleware.DynamicAuthMiddleware)
 	v1.Use(middleware.OnlyProviderMiddleware)
 
-	v1.GET("orders", providerCtrl.GetLockPaymentOrders)
-	v1.POST("orders/:id/accept", providerCtrl.AcceptOrder)
-	v1.POST("orders/:id/decline", providerCtrl.DeclineOrder)
-	v1.POST("orders/:id/fulfill", providerCtrl.FulfillOrder)
-	v1.POST("orders/:id/cancel", providerCtrl.CancelOrder)
-	v1.POST("balances", providerCtrl.UpdateProviderBalance)
-	v1.GET("rates/:token/:fiat", providerCtrl.GetMarketRate)
-	v1.GET("stats", providerCtrl.Stats)
-	v1.GET("node-info", providerCtrl.NodeInfo)
+	v1.GET("orders", middleware.RequireScope(u.APIKeyScopeRead), providerCtrl.GetLockPaymentOrders)
+	v1.POST("orders/:id/accept", middleware.RequireScope(u.APIKeyScopeFulfillOrders), providerCtrl.AcceptOrder)
+	v1.POST("orders/:id/decline", middleware.RequireScope(u.APIKeyScopeFulfillOrders), providerCtrl.DeclineOrder)
+	v1.POST("orders/:id/fulfill", middleware.RequireScope(u.APIKeyScopeFulfillOrders), providerCtrl.FulfillOrder)
+	v1.POST("orders/:id/cancel", middleware.RequireScope(u.APIKeyScopeFulfillOrders), providerCtrl.CancelOrder)
+	v1.POST("balances", middleware.RequireScope(u.APIKeyScopeFulfillOrders), providerCtrl.UpdateProviderBalance)
+	v1.GET("rates/:token/:fiat", middleware.RequireScope(u.APIKeyScopeRead), providerCtrl.GetMarketRate)
+	v1.GET("stats", middleware.RequireScope(u.APIKeyScopeRead), providerCtrl.Stats)
+	v1.GET("node-info", middleware.RequireScope(u.APIKeyScopeRead), providerCtrl.NodeInfo)
+	v1.POST("graphql", middleware.RequireScope(u.APIKeyScopeRead), graphqlCtrl.ProviderQuery)
+}
+
+func adminRoutes(route *gin.Engine) {
+	adminCtrl := admin.NewAdminController()
+	graphqlCtrl := graphql.NewGraphQLController()
+
+	v1 := route.Group("/v1/admin/")
+	v1.Use(middleware.AdminMiddleware)
+
+	v1.GET("stats", adminCtrl.GetStats)
+	v1.GET("gas-spend", adminCtrl.GetGasSpend)
+	v1.GET("pool/status", adminCtrl.GetPoolStatus)
+	v1.GET("rpc/rate-limits", adminCtrl.GetRPCRateLimits)
+	v1.GET("circuit-breakers", adminCtrl.GetCircuitBreakers)
+	v1.GET("orphans", adminCtrl.GetOrphanStats)
+	v1.GET("deposit-splits", adminCtrl.ListDepositSplits)
+	v1.POST("deposit-splits/:id/confirm", adminCtrl.ConfirmDepositSplit)
+	v1.POST("deposit-splits/:id/reject", adminCtrl.RejectDepositSplit)
+	v1.POST("orders/:id/receive-address", adminCtrl.MigrateReceiveAddress)
+	v1.GET("payment-orders", adminCtrl.ListPaymentOrders)
+	v1.POST("payment-orders/:id/refund", adminCtrl.RefundPaymentOrder)
+	v1.POST("quarantined-orders/:id/approve", adminCtrl.ApproveQuarantinedOrder)
+	v1.POST("quarantined-orders/:id/refund", adminCtrl.RefundQuarantinedOrder)
+	v1.GET("denylisted-addresses", adminCtrl.ListDenylistedAddresses)
+	v1.POST("denylisted-addresses", adminCtrl.DenylistAddress)
+	v1.GET("denylisted-addresses/:id", adminCtrl.GetDenylistedAddress)
+	v1.PATCH("denylisted-addresses/:id", adminCtrl.UpdateDenylistedAddress)
+	v1.DELETE("denylisted-addresses/:id", adminCtrl.RemoveDenylistedAddress)
+	v1.POST("lock-orders/:id/requeue", adminCtrl.RequeueLockOrder)
+	v1.POST("lock-orders/:id/provider", adminCtrl.ReassignLockOrder)
+	v1.GET("audit-logs", adminCtrl.ListAuditLogs)
+	v1.GET("sweeps", adminCtrl.ListSweeps)
+	v1.POST("sweeps", adminCtrl.CreateSweep)
+	v1.GET("sweeps/:id/export", adminCtrl.ExportSweep)
+	v1.POST("sweeps/:id/signature", adminCtrl.SubmitSweepSignature)
+	v1.PATCH("senders/:id/limits", adminCtrl.UpdateSenderLimits)
+	v1.GET("fee-schedules", adminCtrl.ListFeeSchedules)
+	v1.POST("fee-schedules", adminCtrl.CreateFeeSchedule)
+	v1.PATCH("fee-schedules/:id", adminCtrl.UpdateFeeSchedule)
+	v1.GET("blockchain-routes", adminCtrl.ListBlockchainRoutes)
+	v1.POST("blockchain-routes", adminCtrl.CreateBlockchainRoute)
+	v1.PATCH("blockchain-routes/:id", adminCtrl.UpdateBlockchainRoute)
+	v1.DELETE("blockchain-routes/:id", adminCtrl.RemoveBlockchainRoute)
+	v1.GET("tokens", adminCtrl.ListTokens)
+	v1.POST("tokens", adminCtrl.CreateToken)
+	v1.PATCH("tokens/:id", adminCtrl.UpdateToken)
+	v1.GET("networks", adminCtrl.ListNetworks)
+	v1.POST("networks", adminCtrl.CreateNetwork)
+	v1.PATCH("networks/:id", adminCtrl.UpdateNetwork)
+	v1.GET("reconciliation-reports", adminCtrl.ListReconciliationReports)
+	v1.POST("reconciliation-reports", adminCtrl.ReconcileDeposits)
+	v1.GET("reconciliation-reports/:id", adminCtrl.GetReconciliationReport)
+	v1.GET("unmatched-deposits", adminCtrl.ListUnmatchedDeposits)
+	v1.POST("unmatched-deposits/:id/link", adminCtrl.LinkUnmatchedDeposit)
+	v1.POST("unmatched-deposits/:id/refund", adminCtrl.RefundUnmatchedDeposit)
+	v1.POST("unmatched-deposits/:id/sweep", adminCtrl.SweepUnmatchedDeposit)
+	v1.GET("dead-letters", adminCtrl.ListDeadLetters)
+	v1.GET("dead-letters/:id", adminCtrl.GetDeadLetter)
+	v1.POST("dead-letters/:id/retry", adminCtrl.RetryDeadLetter)
+	v1.GET("webhook-destinations", adminCtrl.ListWebhookDestinations)
+	v1.PATCH("webhook-destinations/:id", adminCtrl.UpdateWebhookDestination)
+	v1.GET("webhook-attempts", adminCtrl.ListWebhookAttempts)
+	v1.POST("webhook-attempts/retry", adminCtrl.RetryWebhookAttempts)
+	v1.POST("graphql", graphqlCtrl.AdminQuery)
 }
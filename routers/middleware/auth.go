@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"crypto/hmac"
 	"crypto/sha256"
+	"crypto/subtle"
 	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
@@ -365,6 +366,10 @@ func HMACVerificationMiddleware(c *gin.Context) {
 		return
 	}
 
+	if !authorizeAPIKey(c, apiKey) {
+		return
+	}
+
 	// Remove the timestamp key from the payload
 	delete(payloadData, "timestamp")
 
@@ -437,10 +442,101 @@ func APIKeyMiddleware(c *gin.Context) {
 		return
 	}
 
+	if !authorizeAPIKey(c, apiKeyEnt) {
+		return
+	}
+
 	// Continue to the next middleware
 	c.Next()
 }
 
+// authorizeAPIKey rejects requests made with an expired API key or over the rate limit of the key,
+// and records when the key was last used. The key is set in the context for scope checks
+func authorizeAPIKey(c *gin.Context, apiKey *ent.APIKey) bool {
+	if u.APIKeyExpired(apiKey) {
+		u.APIResponse(c, http.StatusUnauthorized, "error", "API key has expired", nil)
+		c.Abort()
+		return false
+	}
+
+	now := time.Now()
+	if apiKey.RateLimit != nil {
+		// Requests are counted per key in fixed one-minute windows shared by every instance
+		window := now.Truncate(time.Minute)
+		key := fmt.Sprintf("api_key_rate_%s_%d", apiKey.ID, window.Unix())
+		count, err := storage.RedisClient.Incr(c, key).Result()
+		if err != nil {
+			logger.Errorf("error counting API key requests: %v", err)
+		} else {
+			if count == 1 {
+				storage.RedisClient.Expire(c, key, 2*time.Minute)
+			}
+			if count > int64(*apiKey.RateLimit) {
+				u.APIResponse(c, http.StatusTooManyRequests, "error", "Too many requests for this API key", map[string]interface{}{
+					"retry_after": time.Until(window.Add(time.Minute)).Seconds(),
+					"limit":       *apiKey.RateLimit,
+				})
+				c.Abort()
+				return false
+			}
+		}
+	}
+
+	// Last use is recorded at most once a minute to spare the database a write per request
+	if apiKey.LastUsedAt == nil || now.Sub(*apiKey.LastUsedAt) > time.Minute {
+		err := storage.Client.APIKey.
+			UpdateOneID(apiKey.ID).
+			SetLastUsedAt(now).
+			Exec(c)
+		if err != nil {
+			logger.Errorf("error recording API key use: %v", err)
+		}
+	}
+
+	c.Set("api_key", apiKey)
+	return true
+}
+
+// RequireScope is a middleware that checks the API key of the request has a scope. Requests
+// authenticated without an API key, like those of the dashboard, are let through
+func RequireScope(scope string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		apiKey, ok := c.Get("api_key")
+		if ok && !u.APIKeyHasScope(apiKey.(*ent.APIKey), scope) {
+			u.APIResponse(c, http.StatusForbidden, "error", fmt.Sprintf("API key lacks the %s scope", scope), nil)
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// AdminMiddleware is a middleware that authenticates operator requests with the Admin-API-Key header
+func AdminMiddleware(c *gin.Context) {
+	adminKey := config.ServerConfig().AdminAPIKey
+	if adminKey == "" {
+		u.APIResponse(c, http.StatusForbidden, "error", "Admin API is disabled", nil)
+		c.Abort()
+		return
+	}
+
+	apiKey := c.GetHeader("Admin-API-Key")
+	if apiKey == "" {
+		u.APIResponse(c, http.StatusUnauthorized, "error", "Missing Admin-API-Key header", nil)
+		c.Abort()
+		return
+	}
+
+	if subtle.ConstantTimeCompare([]byte(apiKey), []byte(adminKey)) != 1 {
+		u.APIResponse(c, http.StatusUnauthorized, "error", "Invalid admin API key", nil)
+		c.Abort()
+		return
+	}
+
+	c.Next()
+}
+
 // DynamicAuthMiddleware is a middleware that dynamically selects the authentication method
 func DynamicAuthMiddleware(c *gin.Context) {
 	// Check the request headers to determine the desired authentication method
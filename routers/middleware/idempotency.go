@@ -0,0 +1,220 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/storage"
+	u "github.com/NEDA-LABS/stablenode/utils"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+)
+
+// Headers of idempotent requests
+const (
+	IdempotencyKeyHeader     = "Idempotency-Key"
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+)
+
+const (
+	idempotencyKeyMaxLength = 255
+	// idempotencyInProgressTTL is how long the claim of a request on its idempotency key outlives
+	// the last refresh, see refreshIdempotencyClaim
+	idempotencyInProgressTTL = time.Minute
+)
+
+// idempotencyClaimRefreshInterval is how often the claim on an idempotency key is extended while
+// its request is handled
+var idempotencyClaimRefreshInterval = idempotencyInProgressTTL / 3
+
+// idempotentResponse is the snapshot of the response to a request made with an Idempotency-Key.
+// Status is zero while the request is being handled
+type idempotentResponse struct {
+	RequestHash string `json:"requestHash"`
+	Status      int    `json:"status"`
+	Body        string `json:"body,omitempty"`
+}
+
+// responseRecorder keeps a copy of the response body written by the handlers
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *responseRecorder) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *responseRecorder) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
+
+// idempotencyRequestHash identifies the payload of a request, ignoring JSON formatting
+func idempotencyRequestHash(c *gin.Context, body []byte) string {
+	var compacted bytes.Buffer
+	if err := json.Compact(&compacted, body); err == nil {
+		body = compacted.Bytes()
+	}
+
+	hash := sha256.New()
+	hash.Write([]byte(c.Request.Method + " " + c.FullPath() + "\n"))
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// IdempotencyMiddleware makes sender requests with an Idempotency-Key header safe to retry. The
+// response to the first request is kept for the idempotency key TTL and replayed to retries with
+// the same payload. Reusing a key for a different payload is rejected
+func IdempotencyMiddleware(c *gin.Context) {
+	idempotencyKey := c.GetHeader(IdempotencyKeyHeader)
+	if idempotencyKey == "" {
+		c.Next()
+		return
+	}
+	if len(idempotencyKey) > idempotencyKeyMaxLength {
+		u.APIResponse(c, http.StatusBadRequest, "error", "Invalid Idempotency-Key",
+			fmt.Sprintf("Idempotency-Key must be at most %d characters", idempotencyKeyMaxLength))
+		c.Abort()
+		return
+	}
+
+	senderCtx, ok := c.Get("sender")
+	if !ok || senderCtx == nil {
+		c.Next()
+		return
+	}
+	sender := senderCtx.(*ent.SenderProfile)
+
+	body, err := c.GetRawData()
+	if err != nil {
+		u.APIResponse(c, http.StatusInternalServerError, "error", "Failed to read request payload", nil)
+		c.Abort()
+		return
+	}
+	c.Request.Body = io.NopCloser(bytes.NewBuffer(body))
+
+	key := fmt.Sprintf("idempotency_%s_%s", sender.ID, idempotencyKey)
+	requestHash := idempotencyRequestHash(c, body)
+
+	// Claim the key while the request is handled. The claim expires quickly so a request that never
+	// finishes doesn't block retries for the whole TTL, and is refreshed while the handlers run
+	claim, _ := json.Marshal(idempotentResponse{RequestHash: requestHash})
+	claimed, err := storage.RedisClient.SetNX(c, key, claim, idempotencyInProgressTTL).Result()
+	if err != nil {
+		logger.Errorf("error claiming idempotency key: %v", err)
+		u.APIResponse(c, http.StatusInternalServerError, "error", "Failed to process Idempotency-Key", nil)
+		c.Abort()
+		return
+	}
+
+	if !claimed {
+		replayIdempotentResponse(c, key, requestHash)
+		return
+	}
+
+	recorder := &responseRecorder{ResponseWriter: c.Writer}
+	c.Writer = recorder
+	stopRefresh := refreshIdempotencyClaim(key)
+	defer stopRefresh()
+	c.Next()
+	stopRefresh()
+
+	// Server errors and rate limits are transient, so the request may be retried with the same key
+	status := recorder.Status()
+	if status >= http.StatusInternalServerError || status == http.StatusTooManyRequests {
+		if err := storage.RedisClient.Del(c, key).Err(); err != nil {
+			logger.Errorf("error releasing idempotency key: %v", err)
+		}
+		return
+	}
+
+	snapshot, _ := json.Marshal(idempotentResponse{
+		RequestHash: requestHash,
+		Status:      status,
+		Body:        recorder.body.String(),
+	})
+	if err := storage.RedisClient.Set(c, key, snapshot, config.OrderConfig().IdempotencyKeyTTL).Err(); err != nil {
+		logger.Errorf("error saving idempotent response: %v", err)
+	}
+}
+
+// refreshIdempotencyClaim keeps extending the claim on an idempotency key until the returned stop
+// function is first called. Stop returns once refreshing ended, so it can't cut short the TTL of
+// the response saved afterwards
+func refreshIdempotencyClaim(key string) (stop func()) {
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+
+	go func() {
+		defer close(stopped)
+
+		ticker := time.NewTicker(idempotencyClaimRefreshInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := storage.RedisClient.Expire(context.Background(), key, idempotencyInProgressTTL).Err(); err != nil {
+					logger.Errorf("error refreshing idempotency key claim: %v", err)
+				}
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { close(done) })
+		<-stopped
+	}
+}
+
+// replayIdempotentResponse responds to a request with a used Idempotency-Key
+func replayIdempotentResponse(c *gin.Context, key string, requestHash string) {
+	defer c.Abort()
+
+	value, err := storage.RedisClient.Get(c, key).Bytes()
+	if err == redis.Nil {
+		// The request holding the key failed and released it in the meantime, so it can be retried
+		u.APIResponse(c, http.StatusConflict, "error", "A request with this Idempotency-Key was just handled, retry it", nil)
+		return
+	} else if err != nil {
+		logger.Errorf("error fetching idempotent response: %v", err)
+		u.APIResponse(c, http.StatusInternalServerError, "error", "Failed to process Idempotency-Key", nil)
+		return
+	}
+
+	var snapshot idempotentResponse
+	if err := json.Unmarshal(value, &snapshot); err != nil {
+		logger.Errorf("error decoding idempotent response: %v", err)
+		u.APIResponse(c, http.StatusInternalServerError, "error", "Failed to process Idempotency-Key", nil)
+		return
+	}
+
+	if snapshot.RequestHash != requestHash {
+		u.APIResponse(c, http.StatusUnprocessableEntity, "error", "Idempotency-Key was already used with a different payload", nil)
+		return
+	}
+
+	if snapshot.Status == 0 {
+		u.APIResponse(c, http.StatusConflict, "error", "A request with this Idempotency-Key is in progress", nil)
+		return
+	}
+
+	c.Header(IdempotentReplayedHeader, "true")
+	c.Data(snapshot.Status, "application/json; charset=utf-8", []byte(snapshot.Body))
+}
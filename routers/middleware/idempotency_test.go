@@ -0,0 +1,134 @@
+package middleware
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdempotency(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mr, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer mr.Close()
+	db.RedisClient = redis.NewClient(&redis.Options{Addr: mr.Addr()})
+
+	sender := &ent.SenderProfile{ID: uuid.New()}
+	orders := 0
+	status := http.StatusCreated
+
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set("sender", sender)
+		c.Next()
+	})
+	router.POST("/orders", IdempotencyMiddleware, func(c *gin.Context) {
+		orders++
+		c.JSON(status, gin.H{"order": orders})
+	})
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	router.POST("/slow-orders", IdempotencyMiddleware, func(c *gin.Context) {
+		started <- struct{}{}
+		<-release
+		c.JSON(http.StatusCreated, gin.H{"order": "slow"})
+	})
+
+	sendTo := func(path string, key string, body string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest("POST", path, bytes.NewBufferString(body))
+		if key != "" {
+			req.Header.Set(IdempotencyKeyHeader, key)
+		}
+		res := httptest.NewRecorder()
+		router.ServeHTTP(res, req)
+		return res
+	}
+	send := func(key string, body string) *httptest.ResponseRecorder {
+		return sendTo("/orders", key, body)
+	}
+
+	t.Run("replays the response to a retried request", func(t *testing.T) {
+		first := send("key-1", `{"amount": "100"}`)
+		assert.Equal(t, http.StatusCreated, first.Code)
+
+		retry := send("key-1", `{"amount":"100"}`)
+		assert.Equal(t, http.StatusCreated, retry.Code)
+		assert.Equal(t, first.Body.String(), retry.Body.String())
+		assert.Equal(t, "true", retry.Header().Get(IdempotentReplayedHeader))
+		assert.Equal(t, 1, orders)
+	})
+
+	t.Run("rejects a reused key with a different payload", func(t *testing.T) {
+		res := send("key-1", `{"amount":"200"}`)
+		assert.Equal(t, http.StatusUnprocessableEntity, res.Code)
+		assert.Equal(t, 1, orders)
+	})
+
+	t.Run("handles requests without a key every time", func(t *testing.T) {
+		send("", `{"amount":"100"}`)
+		send("", `{"amount":"100"}`)
+		assert.Equal(t, 3, orders)
+	})
+
+	t.Run("lets requests that failed on the server be retried", func(t *testing.T) {
+		status = http.StatusInternalServerError
+		res := send("key-2", `{"amount":"100"}`)
+		assert.Equal(t, http.StatusInternalServerError, res.Code)
+
+		status = http.StatusCreated
+		res = send("key-2", `{"amount":"100"}`)
+		assert.Equal(t, http.StatusCreated, res.Code)
+		assert.Empty(t, res.Header().Get(IdempotentReplayedHeader))
+		assert.Equal(t, 5, orders)
+	})
+
+	t.Run("scopes keys to the sender", func(t *testing.T) {
+		sender = &ent.SenderProfile{ID: uuid.New()}
+		res := send("key-1", `{"amount":"200"}`)
+		assert.Equal(t, http.StatusCreated, res.Code)
+		assert.Equal(t, 6, orders)
+	})
+
+	t.Run("holds the key for requests handled longer than the claim TTL", func(t *testing.T) {
+		defaultInterval := idempotencyClaimRefreshInterval
+		idempotencyClaimRefreshInterval = 10 * time.Millisecond
+		defer func() { idempotencyClaimRefreshInterval = defaultInterval }()
+
+		first := make(chan *httptest.ResponseRecorder)
+		go func() {
+			first <- sendTo("/slow-orders", "key-3", `{"amount":"100"}`)
+		}()
+		<-started
+
+		// miniredis only expires keys when time is fast-forwarded, past the TTL of a claim that is
+		// never refreshed
+		for i := 0; i < 3; i++ {
+			mr.FastForward(idempotencyInProgressTTL * 2 / 3)
+			time.Sleep(50 * time.Millisecond)
+		}
+
+		res := sendTo("/slow-orders", "key-3", `{"amount":"100"}`)
+		assert.Equal(t, http.StatusConflict, res.Code)
+		assert.Contains(t, res.Body.String(), "in progress")
+
+		close(release)
+		assert.Equal(t, http.StatusCreated, (<-first).Code)
+
+		// The saved response keeps its TTL once the claim is no longer refreshed
+		time.Sleep(50 * time.Millisecond)
+		assert.Greater(t, mr.TTL(fmt.Sprintf("idempotency_%s_key-3", sender.ID)), idempotencyInProgressTTL)
+	})
+}
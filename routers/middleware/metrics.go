@@ -0,0 +1,20 @@
+package middleware
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/utils/metrics"
+	"github.com/gin-gonic/gin"
+)
+
+// WebhookMetricsMiddleware records how long deliveries to an inbound webhook route take to process
+func WebhookMetricsMiddleware(webhook string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		start := time.Now()
+		ctx.Next()
+		metrics.WebhookDuration.
+			WithLabelValues(webhook, strconv.Itoa(ctx.Writer.Status())).
+			Observe(time.Since(start).Seconds())
+	}
+}
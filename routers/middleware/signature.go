@@ -0,0 +1,117 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/storage"
+	u "github.com/NEDA-LABS/stablenode/utils"
+	"github.com/NEDA-LABS/stablenode/utils/crypto"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// Headers of signed sender requests
+const (
+	RequestTimestampHeader = "X-Request-Timestamp"
+	RequestSignatureHeader = "X-Request-Signature"
+)
+
+// RequestSignature returns the signature of a sender request: the hex encoded HMAC-SHA256, keyed by
+// the request signing secret, of "{timestamp}.{method}.{request URI}.{body}"
+func RequestSignature(secret string, timestamp string, method string, requestURI string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(fmt.Sprintf("%s.%s.%s.", timestamp, method, requestURI)))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// RequestSignatureMiddleware verifies the signature of requests authenticated with the API-Key
+// header of senders that opted into request signing. Requests must be signed within the replay
+// window, and a signature is only accepted once
+func RequestSignatureMiddleware(c *gin.Context) {
+	senderCtx, ok := c.Get("sender")
+	if !ok || senderCtx == nil || c.GetHeader("API-Key") == "" {
+		c.Next()
+		return
+	}
+	sender := senderCtx.(*ent.SenderProfile)
+	if sender.RequestSigningSecret == "" {
+		c.Next()
+		return
+	}
+
+	timestamp := c.GetHeader(RequestTimestampHeader)
+	signature := c.GetHeader(RequestSignatureHeader)
+	if timestamp == "" || signature == "" {
+		u.APIResponse(c, http.StatusUnauthorized, "error", "Request signature is required",
+			fmt.Sprintf("Expected: %s and %s headers", RequestTimestampHeader, RequestSignatureHeader))
+		c.Abort()
+		return
+	}
+
+	// Requests are only accepted within the replay window, in either direction to allow clock skew
+	unixTimestamp, err := strconv.ParseInt(timestamp, 10, 64)
+	maxAge := config.AuthConfig().RequestSignatureMaxAge
+	if err != nil || time.Since(time.Unix(unixTimestamp, 0)).Abs() > maxAge {
+		u.APIResponse(c, http.StatusUnauthorized, "error", "Invalid request timestamp", nil)
+		c.Abort()
+		return
+	}
+
+	decodedSecret, err := base64.StdEncoding.DecodeString(sender.RequestSigningSecret)
+	if err != nil {
+		logger.Errorf("error decoding request signing secret: %v", err)
+		u.APIResponse(c, http.StatusInternalServerError, "error", "Failed to verify request signature", nil)
+		c.Abort()
+		return
+	}
+	secret, err := crypto.DecryptPlain(decodedSecret)
+	if err != nil {
+		logger.Errorf("error decrypting request signing secret: %v", err)
+		u.APIResponse(c, http.StatusInternalServerError, "error", "Failed to verify request signature", nil)
+		c.Abort()
+		return
+	}
+
+	body, err := c.GetRawData()
+	if err != nil {
+		u.APIResponse(c, http.StatusInternalServerError, "error", "Failed to read request payload", nil)
+		c.Abort()
+		return
+	}
+	c.Request.Body = io.NopCloser(bytes.NewBuffer(body))
+
+	expected := RequestSignature(string(secret), timestamp, c.Request.Method, c.Request.URL.RequestURI(), body)
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		u.APIResponse(c, http.StatusUnauthorized, "error", "Invalid request signature", nil)
+		c.Abort()
+		return
+	}
+
+	// A signature seen within the replay window is a replayed request
+	fresh, err := storage.RedisClient.SetNX(c, fmt.Sprintf("request_signature_%s", signature), sender.ID.String(), 2*maxAge).Result()
+	if err != nil {
+		logger.Errorf("error recording request signature: %v", err)
+		u.APIResponse(c, http.StatusInternalServerError, "error", "Failed to verify request signature", nil)
+		c.Abort()
+		return
+	}
+	if !fresh {
+		u.APIResponse(c, http.StatusUnauthorized, "error", "Request has already been received", nil)
+		c.Abort()
+		return
+	}
+
+	c.Next()
+}
@@ -0,0 +1,103 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/crypto"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestSignature(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mr, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer mr.Close()
+	db.RedisClient = redis.NewClient(&redis.Options{Addr: mr.Addr()})
+
+	secret := "request-signing-secret"
+	encryptedSecret, err := crypto.EncryptPlain([]byte(secret))
+	assert.NoError(t, err)
+
+	signingSender := &ent.SenderProfile{
+		ID:                   uuid.New(),
+		RequestSigningSecret: base64.StdEncoding.EncodeToString(encryptedSecret),
+	}
+	sender := signingSender
+
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set("sender", sender)
+		c.Next()
+	})
+	router.Use(RequestSignatureMiddleware)
+	router.POST("/orders", func(c *gin.Context) {
+		body, _ := c.GetRawData()
+		c.String(http.StatusOK, string(body))
+	})
+
+	send := func(timestamp string, signature string, body string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest("POST", "/orders?page=1", bytes.NewBufferString(body))
+		req.Header.Set("API-Key", uuid.New().String())
+		if timestamp != "" {
+			req.Header.Set(RequestTimestampHeader, timestamp)
+		}
+		if signature != "" {
+			req.Header.Set(RequestSignatureHeader, signature)
+		}
+		res := httptest.NewRecorder()
+		router.ServeHTTP(res, req)
+		return res
+	}
+
+	body := `{"amount":"100"}`
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+
+	t.Run("accepts a signed request and passes the body on", func(t *testing.T) {
+		res := send(now, RequestSignature(secret, now, "POST", "/orders?page=1", []byte(body)), body)
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, body, res.Body.String())
+	})
+
+	t.Run("rejects a replayed request", func(t *testing.T) {
+		res := send(now, RequestSignature(secret, now, "POST", "/orders?page=1", []byte(body)), body)
+		assert.Equal(t, http.StatusUnauthorized, res.Code)
+	})
+
+	t.Run("rejects an unsigned request", func(t *testing.T) {
+		res := send("", "", body)
+		assert.Equal(t, http.StatusUnauthorized, res.Code)
+	})
+
+	t.Run("rejects a tampered body", func(t *testing.T) {
+		signature := RequestSignature(secret, now, "POST", "/orders?page=1", []byte(body))
+		res := send(now, signature, `{"amount":"1000"}`)
+		assert.Equal(t, http.StatusUnauthorized, res.Code)
+	})
+
+	t.Run("rejects a stale timestamp", func(t *testing.T) {
+		stale := strconv.FormatInt(time.Now().Add(-time.Hour).Unix(), 10)
+		res := send(stale, RequestSignature(secret, stale, "POST", "/orders?page=1", []byte(body)), body)
+		assert.Equal(t, http.StatusUnauthorized, res.Code)
+	})
+
+	t.Run("skips senders without request signing", func(t *testing.T) {
+		sender = &ent.SenderProfile{ID: uuid.New()}
+		defer func() { sender = signingSender }()
+
+		res := send("", "", body)
+		assert.Equal(t, http.StatusOK, res.Code)
+	})
+}
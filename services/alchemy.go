@@ -3,11 +3,13 @@ package services
 import (
 	"bytes"
 	"context"
-	"crypto/ecdsa"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"math/big"
+	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
@@ -15,6 +17,7 @@ import (
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	ethereumtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -23,16 +26,24 @@ import (
 	"github.com/NEDA-LABS/stablenode/ent"
 	"github.com/NEDA-LABS/stablenode/ent/network"
 	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	"github.com/NEDA-LABS/stablenode/services/gasoracle"
+	"github.com/NEDA-LABS/stablenode/services/events"
 	"github.com/NEDA-LABS/stablenode/storage"
 	stablenodtypes "github.com/NEDA-LABS/stablenode/types"
 	"github.com/NEDA-LABS/stablenode/utils"
+	"github.com/NEDA-LABS/stablenode/utils/aaerrors"
 	cryptoUtils "github.com/NEDA-LABS/stablenode/utils/crypto"
+	"github.com/NEDA-LABS/stablenode/utils/breaker"
 	"github.com/NEDA-LABS/stablenode/utils/logger"
-	"github.com/spf13/viper"
+	"github.com/NEDA-LABS/stablenode/utils/metrics"
+	"github.com/NEDA-LABS/stablenode/utils/ratelimit"
+	"github.com/NEDA-LABS/stablenode/utils/signer"
 )
 
 // AlchemyService provides functionality for interacting with Alchemy APIs
-// This is an alternative to EngineService for EVM-only operations
+// This is an alternative to EngineService for EVM-only operations.
+// Standard JSON-RPC calls fail over to the network's fallback endpoints through the RPCManager;
+// bundler, paymaster and alchemy_* calls always use the primary Alchemy endpoint
 type AlchemyService struct {
 	config *config.AlchemyConfiguration
 }
@@ -44,6 +55,12 @@ func NewAlchemyService() *AlchemyService {
 	}
 }
 
+// alchemyTransport rate limits calls to the primary Alchemy endpoints and fails them fast while
+// the endpoint's circuit is open, instead of waiting out timeouts against an outage
+func alchemyTransport() http.RoundTripper {
+	return breaker.NewTransport("alchemy", ratelimit.NewTransport())
+}
+
 // CreateSmartAccount creates a new ERC-4337 smart contract account using Alchemy
 // Note: With Alchemy, we don't need to "create" the account via API - we compute it deterministically
 // The account gets deployed automatically when the first transaction is sent to it
@@ -205,6 +222,7 @@ func (s *AlchemyService) computeSmartAccountAddressWithSalt(ownerAddress string,
 	url := fmt.Sprintf("%s/%s", network.RPCEndpoint, s.config.APIKey)
 	res, err := fastshot.NewClient(url).
 		Config().SetTimeout(10 * time.Second).
+		Config().SetCustomTransport(alchemyTransport()).
 		Header().AddAll(map[string]string{
 			"Accept":       "application/json",
 			"Content-Type": "application/json",
@@ -339,6 +357,29 @@ func (s *AlchemyService) SendUserOperation(ctx context.Context, chainID int64, u
 	
 	// Use the network's RPC endpoint and append API key
 	url := fmt.Sprintf("%s/%s", network.RPCEndpoint, s.config.APIKey)
+
+	// Pre-flight: an operation that would revert is rejected here instead of by the bundler.
+	// When the simulation itself can't run, the bundler decides
+	if s.config.SimulateUserOperations {
+		simulation, err := s.simulatePackedUserOperation(ctx, url, packedUserOp)
+		if err != nil {
+			logger.WithFields(logger.Fields{
+				"Error":   fmt.Sprintf("%v", err),
+				"ChainID": chainID,
+				"Sender":  packedUserOp["sender"],
+			}).Warnf("Failed to simulate UserOperation, sending it unsimulated")
+		} else if simulation.Err != nil {
+			metrics.UserOperationFailed(chainID, "simulation", simulation.Err.Reason())
+			logger.WithFields(logger.Fields{
+				"Error":       simulation.Err.Message,
+				"Code":        simulation.Err.Code,
+				"Cause":       simulation.Err.Reason(),
+				"Remediation": simulation.Err.Remediation(),
+				"Sender":      packedUserOp["sender"],
+			}).Error("UserOperation simulation failed")
+			return "", fmt.Errorf("user operation simulation failed: %w", simulation.Err)
+		}
+	}
 	
 	payload := map[string]interface{}{
 		"jsonrpc": "2.0",
@@ -362,10 +403,12 @@ func (s *AlchemyService) SendUserOperation(ctx context.Context, chainID int64, u
 
 	res, err := fastshot.NewClient(url).
 		Config().SetTimeout(30 * time.Second).
+		Config().SetCustomTransport(alchemyTransport()).
 		Header().AddAll(map[string]string{
 			"Accept":       "application/json",
 			"Content-Type": "application/json",
 		}).Build().POST("").
+		Context().Set(ctx).
 		Body().AsJSON(payload).Send()
 	
 	if err != nil {
@@ -392,11 +435,16 @@ func (s *AlchemyService) SendUserOperation(ctx context.Context, chainID int64, u
 	}
 
 	if data["error"] != nil {
-		errorData, _ := json.Marshal(data["error"])
+		aaErr := aaerrors.Parse(data["error"])
+		metrics.UserOperationFailed(chainID, "send", aaErr.Reason())
 		logger.WithFields(logger.Fields{
-			"Error": string(errorData),
+			"Error":       aaErr.Message,
+			"Code":        aaErr.Code,
+			"Cause":       aaErr.Reason(),
+			"Remediation": aaErr.Remediation(),
+			"Data":        string(aaErr.Data),
 		}).Error("Alchemy returned error for UserOperation")
-		return "", fmt.Errorf("user operation failed: %s", string(errorData))
+		return "", fmt.Errorf("user operation failed: %w", aaErr)
 	}
 
 	userOpHash := data["result"].(string)
@@ -416,10 +464,12 @@ func (s *AlchemyService) GetUserOperationReceipt(ctx context.Context, chainID in
 
 	res, err := fastshot.NewClient(url).
 		Config().SetTimeout(30 * time.Second).
+		Config().SetCustomTransport(alchemyTransport()).
 		Header().AddAll(map[string]string{
 			"Accept":       "application/json",
 			"Content-Type": "application/json",
 		}).Build().POST("").
+		Context().Set(ctx).
 		Body().AsJSON(payload).Send()
 	
 	if err != nil {
@@ -469,10 +519,12 @@ func (s *AlchemyService) GetLatestBlock(ctx context.Context, chainID int64) (int
 
 	res, err := fastshot.NewClient(url).
 		Config().SetTimeout(30 * time.Second).
+		Config().SetCustomTransport(alchemyTransport()).
 		Header().AddAll(map[string]string{
 			"Accept":       "application/json",
 			"Content-Type": "application/json",
 		}).Build().POST("").
+		Context().Set(ctx).
 		Body().AsJSON(payload).Send()
 	
 	if err != nil {
@@ -493,8 +545,8 @@ func (s *AlchemyService) GetLatestBlock(ctx context.Context, chainID int64) (int
 	return blockNumber, nil
 }
 
-// GetContractEvents fetches contract events using Alchemy's enhanced APIs
-func (s *AlchemyService) GetContractEvents(ctx context.Context, chainID int64, contractAddress string, fromBlock, toBlock int64, topics []string) ([]interface{}, error) {
+// GetContractEvents fetches contract events using Alchemy's enhanced APIs, decoded through the event registry
+func (s *AlchemyService) GetContractEvents(ctx context.Context, chainID int64, contractAddress string, fromBlock, toBlock int64, topics []string) ([]events.Event, error) {
 	url := fmt.Sprintf("%s/%s", s.config.BaseURL, s.config.APIKey)
 	
 	// Convert block numbers to hex
@@ -525,10 +577,12 @@ func (s *AlchemyService) GetContractEvents(ctx context.Context, chainID int64, c
 
 	res, err := fastshot.NewClient(url).
 		Config().SetTimeout(60 * time.Second).
+		Config().SetCustomTransport(alchemyTransport()).
 		Header().AddAll(map[string]string{
 			"Accept":       "application/json",
 			"Content-Type": "application/json",
 		}).Build().POST("").
+		Context().Set(ctx).
 		Body().AsJSON(payload).Send()
 	
 	if err != nil {
@@ -544,41 +598,22 @@ func (s *AlchemyService) GetContractEvents(ctx context.Context, chainID int64, c
 		return nil, fmt.Errorf("no events found")
 	}
 
-	logs := data["result"].([]interface{})
-	
-	// Convert to the same format as your existing event processing
-	var events []interface{}
-	for _, log := range logs {
-		logMap := log.(map[string]interface{})
-		
-		// Convert hex block number to decimal
-		blockNumberHex := logMap["blockNumber"].(string)
-		blockNumber, _ := strconv.ParseInt(strings.TrimPrefix(blockNumberHex, "0x"), 16, 64)
-		
-		event := map[string]interface{}{
-			"block_number":     float64(blockNumber),
-			"transaction_hash": logMap["transactionHash"].(string),
-			"log_index":        logMap["logIndex"],
-			"address":          logMap["address"].(string),
-			"topics":           logMap["topics"],
-			"data":             logMap["data"].(string),
-			"decoded": map[string]interface{}{
-				"indexed_params":     make(map[string]interface{}),
-				"non_indexed_params": make(map[string]interface{}),
-			},
-		}
-		events = append(events, event)
+	// Logs from eth_getLogs have the JSON encoding of go-ethereum logs
+	encoded, err := json.Marshal(data["result"])
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode logs: %w", err)
+	}
+	var logs []ethereumtypes.Log
+	if err := json.Unmarshal(encoded, &logs); err != nil {
+		return nil, fmt.Errorf("failed to parse logs: %w", err)
 	}
 
-	// Process events to decode them (reuse existing utility)
-	if len(events) > 0 {
-		err = utils.ProcessRPCEventsBySignature(events)
-		if err != nil {
-			return nil, fmt.Errorf("failed to process events: %w", err)
-		}
+	decoded, err := events.Default().DecodeLogs(logs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode events: %w", err)
 	}
 
-	return events, nil
+	return decoded, nil
 }
 
 // EstimateGas estimates gas for a transaction using Alchemy
@@ -606,10 +641,12 @@ func (s *AlchemyService) EstimateGas(ctx context.Context, chainID int64, from, t
 
 	res, err := fastshot.NewClient(url).
 		Config().SetTimeout(30 * time.Second).
+		Config().SetCustomTransport(alchemyTransport()).
 		Header().AddAll(map[string]string{
 			"Accept":       "application/json",
 			"Content-Type": "application/json",
 		}).Build().POST("").
+		Context().Set(ctx).
 		Body().AsJSON(payload).Send()
 	
 	if err != nil {
@@ -632,10 +669,10 @@ func (s *AlchemyService) EstimateGas(ctx context.Context, chainID int64, from, t
 
 // GetSupportedChains returns a list of EVM chains supported by this service
 func (s *AlchemyService) GetSupportedChains(ctx context.Context) ([]*ent.Network, error) {
-	// Fetch only EVM networks (exclude Tron)
+	// Fetch only EVM networks (exclude Tron and Solana)
 	networks, err := storage.Client.Network.
 		Query().
-		Where(network.Not(network.IdentifierHasPrefix("tron"))).
+		Where(network.Not(network.IdentifierHasPrefix("tron")), network.NetworkTypeNEQ(network.NetworkTypeSolana)).
 		All(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch EVM networks: %w", err)
@@ -649,6 +686,7 @@ func (s *AlchemyService) GetSupportedChains(ctx context.Context) ([]*ent.Network
 func (s *AlchemyService) SendTransactionBatch(ctx context.Context, chainID int64, address string, txPayload []map[string]interface{}) (string, error) {
 	// Determine if this is a smart account or EOA
 	// Check database first - if address has a salt OR is_deployed=true, it's a smart account
+	// Pool addresses have a row per order, only the pool row carries the salt
 	isSmartAccount := false
 	receiveAddr, err := storage.Client.ReceiveAddress.
 		Query().
@@ -656,7 +694,11 @@ func (s *AlchemyService) SendTransactionBatch(ctx context.Context, chainID int64
 			receiveaddress.AddressEQ(address),
 			receiveaddress.AddressEQ(strings.ToLower(address)),
 		)).
-		Only(ctx)
+		Where(receiveaddress.Or(
+			receiveaddress.IsDeployedEQ(true),
+			receiveaddress.SaltNotNil(),
+		)).
+		First(ctx)
 	
 	if err == nil {
 		// Check if it's a pool address (is_deployed=true) or has salt
@@ -702,14 +744,45 @@ func (s *AlchemyService) SendTransactionBatch(ctx context.Context, chainID int64
 	return s.sendEOATransactionBatch(ctx, chainID, address, txPayload)
 }
 
+// TransferAccountOwnership transfers a deployed Light Account to a new owner with a UserOperation
+// calling transferOwnership on the account itself, signed by the current owner key. Returns the
+// UserOperation hash; the new owner's key signs for the account once it is mined
+func (s *AlchemyService) TransferAccountOwnership(ctx context.Context, chainID int64, account string, newOwner string) (string, error) {
+	if !common.IsHexAddress(newOwner) || common.HexToAddress(newOwner) == (common.Address{}) {
+		return "", fmt.Errorf("invalid new owner: %s", newOwner)
+	}
+
+	deployed, err := s.isAccountDeployed(ctx, chainID, account)
+	if err != nil {
+		return "", fmt.Errorf("failed to check smart account deployment: %w", err)
+	}
+	if !deployed {
+		// The address of an undeployed account is derived from its owner and can't be transferred
+		return "", fmt.Errorf("smart account %s is not deployed", account)
+	}
+
+	tx := map[string]interface{}{
+		"to":    account,
+		"data":  encodeTransferOwnership(newOwner),
+		"value": "0",
+	}
+
+	logger.WithFields(logger.Fields{
+		"SmartAccount": account,
+		"NewOwner":     newOwner,
+		"ChainID":      chainID,
+	}).Infof("Transferring smart account ownership")
+
+	return s.sendUserOperationBatch(ctx, chainID, account, []map[string]interface{}{tx})
+}
+
+// encodeTransferOwnership encodes transferOwnership(address newOwner): 0xf2fde38b
+func encodeTransferOwnership(newOwner string) string {
+	return "0xf2fde38b" + common.Bytes2Hex(common.LeftPadBytes(common.HexToAddress(newOwner).Bytes(), 32))
+}
+
 // deploySmartAccount deploys a smart account by sending a UserOp with only initCode
 func (s *AlchemyService) deploySmartAccount(ctx context.Context, chainID int64, smartAccountAddress string) error {
-	// Get owner address and salt
-	ownerAddress := viper.GetString("SMART_ACCOUNT_OWNER_ADDRESS")
-	if ownerAddress == "" {
-		return fmt.Errorf("SMART_ACCOUNT_OWNER_ADDRESS not configured")
-	}
-	
 	// Retrieve the salt from database
 	receiveAddr, err := storage.Client.ReceiveAddress.
 		Query().
@@ -719,6 +792,15 @@ func (s *AlchemyService) deploySmartAccount(ctx context.Context, chainID int64,
 	if err != nil {
 		return fmt.Errorf("failed to get receive address for salt: %w", err)
 	}
+
+	// Get owner address - the address was derived from the owner it was generated for
+	ownerAddress := receiveAddr.OwnerAddress
+	if ownerAddress == "" {
+		ownerAddress, err = SmartAccountOwner(ctx, chainID)
+		if err != nil {
+			return err
+		}
+	}
 	
 	if len(receiveAddr.Salt) == 0 {
 		return fmt.Errorf("no salt found for smart account %s - cannot generate initCode", smartAccountAddress)
@@ -743,6 +825,8 @@ func (s *AlchemyService) deploySmartAccount(ctx context.Context, chainID int64,
 		"InitCode":     initCode[:66] + "...", // Log first 66 chars
 	}).Info("Generated initCode for deployment")
 	
+	maxFeePerGas, maxPriorityFeePerGas := s.userOperationFees(ctx, chainID)
+
 	// Create a simple UserOp that just deploys the account (no execution)
 	userOp := map[string]interface{}{
 		"sender":               smartAccountAddress,
@@ -752,8 +836,8 @@ func (s *AlchemyService) deploySmartAccount(ctx context.Context, chainID int64,
 		"callGasLimit":         "0x7530", // 30k gas minimum even for empty callData
 		"verificationGasLimit": "0x493e0", // 300k gas limit for verification (deployment needs more)
 		"preVerificationGas":   "0x10000",  // 65536 gas
-		"maxFeePerGas":         "0x59682f00",
-		"maxPriorityFeePerGas": "0x59682f00",
+		"maxFeePerGas":         maxFeePerGas,
+		"maxPriorityFeePerGas": maxPriorityFeePerGas,
 		"paymasterAndData":     "0x",
 		"signature":            "0x",
 	}
@@ -782,7 +866,7 @@ func (s *AlchemyService) deploySmartAccount(ctx context.Context, chainID int64,
 			"UserOpWithGas": string(minimalJSON),
 		}).Info("Sending UserOp to paymaster for deployment")
 		
-		result, err := s.getPaymasterData(ctx, chainID, minimalUserOp)
+		result, err := s.getPaymasterData(ctx, chainID, minimalUserOp, nil)
 		if err != nil {
 			logger.WithFields(logger.Fields{
 				"Error": err.Error(),
@@ -853,6 +937,26 @@ func (s *AlchemyService) deploySmartAccount(ctx context.Context, chainID int64,
 
 // sendUserOperationBatch sends a batch of transactions as a single user operation (for smart accounts)
 func (s *AlchemyService) sendUserOperationBatch(ctx context.Context, chainID int64, smartAccountAddress string, txPayload []map[string]interface{}) (string, error) {
+	// Accounts with the session key send allowed calls in one executeWithSessionKey UserOperation,
+	// signed without the owner key
+	sessionKey, err := s.sessionKeyFor(ctx, chainID, smartAccountAddress, txPayload)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":        fmt.Sprintf("%v", err),
+			"SmartAccount": smartAccountAddress,
+		}).Warnf("Failed to check session key, signing with the owner key")
+	}
+	if sessionKey != nil {
+		callData, err := encodeExecuteWithSessionKey(txPayload, sessionKey.Address())
+		if err != nil {
+			return "", err
+		}
+		txPayload = []map[string]interface{}{{
+			"callData":     callData,
+			"callGasLimit": fmt.Sprintf("0x%x", 100000*len(txPayload)),
+		}}
+	}
+
 	// For Light Account v2.0.0, executeBatch has issues
 	// Instead, send multiple UserOperations sequentially
 	
@@ -890,25 +994,72 @@ func (s *AlchemyService) sendUserOperationBatch(ctx context.Context, chainID int
 		return lastTxHash, nil
 	}
 	
-	// Single transaction - wrap in execute() function
-	tx := txPayload[0]
-	targetAddress := tx["to"].(string)
-	targetData := tx["data"].(string)
-	value := "0"
-	if v, ok := tx["value"].(string); ok {
-		value = v
+	userOp, err := s.buildUserOperation(ctx, chainID, smartAccountAddress, txPayload[0])
+	if err != nil {
+		return "", err
 	}
-	
-	// Encode execute(address target, uint256 value, bytes calldata data)
-	// Function selector: 0xb61d27f6
-	callData := s.encodeExecuteCallData(targetAddress, value, targetData)
-	
+
+	// Sign the user operation
+	signature, err := s.signUserOperation(ctx, chainID, userOp)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign user operation: %w", err)
+	}
+	userOp["signature"] = signature
+
+	logger.WithFields(logger.Fields{
+		"SmartAccount": smartAccountAddress,
+		"Signature":    signature,
+		"SignatureLength": len(signature),
+	}).Info("UserOperation signed successfully")
+
+	// Send the user operation
+	userOpHash, err := s.SendUserOperation(ctx, chainID, userOp)
+	if err != nil {
+		return "", fmt.Errorf("failed to send batch transaction: %w", err)
+	}
+
 	logger.WithFields(logger.Fields{
+		"ChainID":      chainID,
 		"SmartAccount": smartAccountAddress,
-		"Target": targetAddress,
-		"CallDataLength": len(callData),
-		"TxPayloadCount": len(txPayload),
-	}).Info("Encoded execute() callData for UserOp")
+		"UserOpHash":   userOpHash,
+		"BatchSize":    len(txPayload),
+	}).Infof("Sent transaction batch via Alchemy")
+
+	// Track the operation so it is replaced with higher fees if left unmined
+	if _, err := recordUserOperation(ctx, chainID, userOp, userOpHash, nil); err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":      fmt.Sprintf("%v", err),
+			"UserOpHash": userOpHash,
+		}).Errorf("Failed to log UserOperation for resubmission")
+	}
+
+	return userOpHash, nil
+}
+
+// buildUserOperation builds the unsigned UserOperation executing a single transaction from a smart
+// account, deploying the account first if needed and applying paymaster sponsorship when configured
+func (s *AlchemyService) buildUserOperation(ctx context.Context, chainID int64, smartAccountAddress string, tx map[string]interface{}) (map[string]interface{}, error) {
+	// Session key calls come encoded for executeWithSessionKey; anything else is a single
+	// transaction wrapped in execute()
+	callData, _ := tx["callData"].(string)
+	if callData == "" {
+		targetAddress := tx["to"].(string)
+		targetData := tx["data"].(string)
+		value := "0"
+		if v, ok := tx["value"].(string); ok {
+			value = v
+		}
+		
+		// Encode execute(address target, uint256 value, bytes calldata data)
+		// Function selector: 0xb61d27f6
+		callData = s.encodeExecuteCallData(targetAddress, value, targetData)
+		
+		logger.WithFields(logger.Fields{
+			"SmartAccount": smartAccountAddress,
+			"Target": targetAddress,
+			"CallDataLength": len(callData),
+		}).Info("Encoded execute() callData for UserOp")
+	}
 
 	// Check database to determine if this is a pool address or needs deployment
 	// For pool addresses, there may be multiple rows with the same address
@@ -922,14 +1073,40 @@ func (s *AlchemyService) sendUserOperationBatch(ctx context.Context, chainID int
 		Where(receiveaddress.Or(
 			receiveaddress.StatusEQ(receiveaddress.StatusPoolReady), // Pool master row
 			receiveaddress.IsDeployedEQ(true),                        // Any deployed address
+			receiveaddress.SaltNotNil(),                              // Counterfactual address
 		)).
 		Order(ent.Desc(receiveaddress.FieldIsDeployed)). // Prefer deployed addresses
 		First(ctx) // Use First() instead of Only() to handle multiple rows
 	
+	if ent.IsNotFound(err) {
+		// Accounts outside the receive address table, like the pool deployer account, must
+		// already be deployed
+		deployed, deployErr := s.isAccountDeployed(ctx, chainID, smartAccountAddress)
+		if deployErr != nil {
+			return nil, fmt.Errorf("failed to check smart account deployment: %w", deployErr)
+		}
+		if !deployed {
+			return nil, fmt.Errorf("smart account %s is not deployed and has no receive address", smartAccountAddress)
+		}
+		receiveAddr, err = &ent.ReceiveAddress{Address: smartAccountAddress, IsDeployed: true}, nil
+	}
 	if err != nil {
-		return "", fmt.Errorf("failed to get receive address from database: %w", err)
+		return nil, fmt.Errorf("failed to get receive address from database: %w", err)
 	}
 	
+	// A counterfactual address is deployed by the first UserOperation sent from it, so it may
+	// have code by now
+	if !receiveAddr.IsDeployed && len(receiveAddr.Salt) > 0 {
+		deployed, err := s.isAccountDeployed(ctx, chainID, smartAccountAddress)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check smart account deployment: %w", err)
+		}
+		if deployed {
+			markSmartAccountDeployed(ctx, chainID, smartAccountAddress)
+			receiveAddr.IsDeployed = true
+		}
+	}
+
 	var initCode string
 	var isDeployed bool
 	
@@ -947,14 +1124,17 @@ func (s *AlchemyService) sendUserOperationBatch(ctx context.Context, chainID int
 		isDeployed = false
 		saltBytes, err := cryptoUtils.DecryptPlain(receiveAddr.Salt)
 		if err != nil {
-			return "", fmt.Errorf("failed to decrypt salt: %w", err)
+			return nil, fmt.Errorf("failed to decrypt salt: %w", err)
 		}
 		saltHex := common.Bytes2Hex(saltBytes)
 		
-		// Get owner address
-		ownerAddress := viper.GetString("SMART_ACCOUNT_OWNER_ADDRESS")
+		// Get owner address - the address was derived from the owner it was generated for
+		ownerAddress := receiveAddr.OwnerAddress
 		if ownerAddress == "" {
-			return "", fmt.Errorf("SMART_ACCOUNT_OWNER_ADDRESS not configured")
+			ownerAddress, err = SmartAccountOwner(ctx, chainID)
+			if err != nil {
+				return nil, err
+			}
 		}
 		
 		initCode = s.getSmartAccountInitCode(ownerAddress, saltHex)
@@ -964,7 +1144,7 @@ func (s *AlchemyService) sendUserOperationBatch(ctx context.Context, chainID int
 			"InitCodeLength": len(initCode),
 		}).Info("Non-pool address - will deploy + execute in ONE transaction")
 	} else {
-		return "", fmt.Errorf("no salt found for smart account %s - cannot generate initCode", smartAccountAddress)
+		return nil, fmt.Errorf("no salt found for smart account %s - cannot generate initCode", smartAccountAddress)
 	}
 
 	// Get the nonce for the smart account
@@ -1001,16 +1181,24 @@ func (s *AlchemyService) sendUserOperationBatch(ctx context.Context, chainID int
 		verificationGasLimit = "0x30d40" // 200k gas for verification
 	}
 	
+	maxFeePerGas, maxPriorityFeePerGas := s.userOperationFees(ctx, chainID)
+
+	// Calls heavier than a transfer, like batched deployments, carry their own gas limit
+	callGasLimit := "0x186a0" // 100k gas limit - should be estimated
+	if gasLimit, ok := tx["callGasLimit"].(string); ok && gasLimit != "" {
+		callGasLimit = gasLimit
+	}
+
 	// Build UserOp - only include initCode if account is not deployed
 	userOp := map[string]interface{}{
 		"sender":               smartAccountAddress,
 		"nonce":                nonce,
 		"callData":             callData,
-		"callGasLimit":         "0x186a0", // 100k gas limit - should be estimated
+		"callGasLimit":         callGasLimit,
 		"verificationGasLimit": verificationGasLimit,
 		"preVerificationGas":   "0x10000",  // 65536 gas - increased from 21k to meet Alchemy's minimum
-		"maxFeePerGas":         "0x59682f00", // Should be fetched from gas oracle
-		"maxPriorityFeePerGas": "0x59682f00",
+		"maxFeePerGas":         maxFeePerGas,
+		"maxPriorityFeePerGas": maxPriorityFeePerGas,
 		"paymasterAndData":     "0x", // Empty unless using paymaster
 		"signature":            "0x", // Will be filled by the signer
 	}
@@ -1048,81 +1236,94 @@ func (s *AlchemyService) sendUserOperationBatch(ctx context.Context, chainID int
 			minimalUserOp["initCode"] = userOp["initCode"]
 		}
 		
-		result, err := s.getPaymasterData(ctx, chainID, minimalUserOp)
+		result, err := s.getPaymasterData(ctx, chainID, minimalUserOp, nil)
 		if err != nil {
 			logger.Warnf("Failed to get paymaster data: %v", err)
 		} else {
-			// Apply all gas estimates from the response
-			if callGasLimit, ok := result["callGasLimit"].(string); ok {
-				userOp["callGasLimit"] = callGasLimit
-			}
-			if verificationGasLimit, ok := result["verificationGasLimit"].(string); ok {
-				userOp["verificationGasLimit"] = verificationGasLimit
-			}
-			if preVerificationGas, ok := result["preVerificationGas"].(string); ok {
-				userOp["preVerificationGas"] = preVerificationGas
-			}
-			if maxFeePerGas, ok := result["maxFeePerGas"].(string); ok {
-				userOp["maxFeePerGas"] = maxFeePerGas
-			}
-			if maxPriorityFeePerGas, ok := result["maxPriorityFeePerGas"].(string); ok {
-				userOp["maxPriorityFeePerGas"] = maxPriorityFeePerGas
-			}
-			
-			// For EntryPoint v0.7, store paymaster fields separately
-			// They will be packed into paymasterAndData only for signing
-			if paymaster, ok := result["paymaster"].(string); ok && paymaster != "" {
-				userOp["paymaster"] = paymaster
-				
-				if pvgl, ok := result["paymasterVerificationGasLimit"].(string); ok {
-					userOp["paymasterVerificationGasLimit"] = pvgl
-				}
-				
-				if ppogl, ok := result["paymasterPostOpGasLimit"].(string); ok {
-					userOp["paymasterPostOpGasLimit"] = ppogl
-				}
-				
-				if pmData, ok := result["paymasterData"].(string); ok {
-					userOp["paymasterData"] = pmData
-				}
-				
-				logger.WithFields(logger.Fields{
-					"Paymaster": paymaster,
-					"PaymasterVerificationGasLimit": userOp["paymasterVerificationGasLimit"],
-					"PaymasterPostOpGasLimit": userOp["paymasterPostOpGasLimit"],
-					"PaymasterData": userOp["paymasterData"],
-				}).Info("Stored paymaster fields for v0.7")
-			}
+			applyPaymasterData(userOp, result)
 		}
 	}
 
-	// Sign the user operation
-	signature, err := s.signUserOperation(ctx, chainID, userOp)
-	if err != nil {
-		return "", fmt.Errorf("failed to sign user operation: %w", err)
-	}
-	userOp["signature"] = signature
-
-	logger.WithFields(logger.Fields{
-		"SmartAccount": smartAccountAddress,
-		"Signature":    signature,
-		"SignatureLength": len(signature),
-	}).Info("UserOperation signed successfully")
+	return userOp, nil
+}
 
-	// Send the user operation
-	userOpHash, err := s.SendUserOperation(ctx, chainID, userOp)
+// markSmartAccountDeployed records that a counterfactual address has been deployed on a chain, on
+// its pool and order rows on that chain and on rows of non-pool addresses
+func markSmartAccountDeployed(ctx context.Context, chainID int64, address string) {
+	updated, err := storage.Client.ReceiveAddress.
+		Update().
+		Where(
+			receiveaddress.AddressEqualFold(address),
+			receiveaddress.IsDeployedEQ(false),
+			receiveaddress.Or(
+				receiveaddress.ChainIDEQ(chainID),
+				receiveaddress.NetworkIdentifierIsNil(),
+				receiveaddress.NetworkIdentifierEQ(""),
+			),
+		).
+		SetIsDeployed(true).
+		SetDeployedAt(time.Now()).
+		Save(ctx)
 	if err != nil {
-		return "", fmt.Errorf("failed to send batch transaction: %w", err)
+		logger.WithFields(logger.Fields{
+			"Error":        fmt.Sprintf("%v", err),
+			"SmartAccount": address,
+			"ChainID":      chainID,
+		}).Errorf("Failed to record smart account deployment")
+		return
 	}
 
 	logger.WithFields(logger.Fields{
+		"SmartAccount": address,
 		"ChainID":      chainID,
-		"SmartAccount": smartAccountAddress,
-		"UserOpHash":   userOpHash,
-		"BatchSize":    len(txPayload),
-	}).Infof("Sent transaction batch via Alchemy")
+		"Rows":         updated,
+	}).Infof("Recorded counterfactual smart account deployment")
+}
 
-	return userOpHash, nil
+// applyPaymasterData applies the gas estimates and paymaster fields of a paymaster response to a
+// UserOperation
+func applyPaymasterData(userOp map[string]interface{}, result map[string]interface{}) {
+	// Apply all gas estimates from the response
+	if callGasLimit, ok := result["callGasLimit"].(string); ok {
+		userOp["callGasLimit"] = callGasLimit
+	}
+	if verificationGasLimit, ok := result["verificationGasLimit"].(string); ok {
+		userOp["verificationGasLimit"] = verificationGasLimit
+	}
+	if preVerificationGas, ok := result["preVerificationGas"].(string); ok {
+		userOp["preVerificationGas"] = preVerificationGas
+	}
+	if maxFeePerGas, ok := result["maxFeePerGas"].(string); ok {
+		userOp["maxFeePerGas"] = maxFeePerGas
+	}
+	if maxPriorityFeePerGas, ok := result["maxPriorityFeePerGas"].(string); ok {
+		userOp["maxPriorityFeePerGas"] = maxPriorityFeePerGas
+	}
+	
+	// For EntryPoint v0.7, store paymaster fields separately
+	// They will be packed into paymasterAndData only for signing
+	if paymaster, ok := result["paymaster"].(string); ok && paymaster != "" {
+		userOp["paymaster"] = paymaster
+		
+		if pvgl, ok := result["paymasterVerificationGasLimit"].(string); ok {
+			userOp["paymasterVerificationGasLimit"] = pvgl
+		}
+		
+		if ppogl, ok := result["paymasterPostOpGasLimit"].(string); ok {
+			userOp["paymasterPostOpGasLimit"] = ppogl
+		}
+		
+		if pmData, ok := result["paymasterData"].(string); ok {
+			userOp["paymasterData"] = pmData
+		}
+		
+		logger.WithFields(logger.Fields{
+			"Paymaster": paymaster,
+			"PaymasterVerificationGasLimit": userOp["paymasterVerificationGasLimit"],
+			"PaymasterPostOpGasLimit": userOp["paymasterPostOpGasLimit"],
+			"PaymasterData": userOp["paymasterData"],
+		}).Info("Stored paymaster fields for v0.7")
+	}
 }
 
 // sendEOATransactionBatch sends transactions from an EOA using eth_sendRawTransaction
@@ -1161,6 +1362,11 @@ func (s *AlchemyService) sendEOATransactionBatch(ctx context.Context, chainID in
 	if err != nil {
 		return "", fmt.Errorf("failed to parse private key: %w", err)
 	}
+	eoaSigner := signer.NewLocal(privateKey)
+	if !strings.EqualFold(eoaSigner.Address().Hex(), fromAddress) {
+		// Smart account salts decrypt to a valid key too, of an unrelated address
+		return "", fmt.Errorf("address %s is not an EOA held by the aggregator", fromAddress)
+	}
 
 	logger.WithFields(logger.Fields{
 		"From":      fromAddress,
@@ -1171,7 +1377,7 @@ func (s *AlchemyService) sendEOATransactionBatch(ctx context.Context, chainID in
 	// Send each transaction
 	var lastTxHash string
 	for i, tx := range txPayload {
-		txHash, err := s.sendEOATransaction(ctx, chainID, privateKey, tx)
+		txHash, err := s.sendEOATransaction(ctx, chainID, eoaSigner, tx)
 		if err != nil {
 			return "", fmt.Errorf("failed to send transaction %d: %w", i, err)
 		}
@@ -1294,28 +1500,191 @@ func (s *AlchemyService) encodeBatchCallData(txPayload []map[string]interface{})
 	return "0x" + functionSelector + common.Bytes2Hex(result)
 }
 
-// signUserOperation signs a UserOperation with the owner's private key
+// signUserOperation signs a UserOperation with the key of the sender's owner
 func (s *AlchemyService) signUserOperation(ctx context.Context, chainID int64, userOp map[string]interface{}) (string, error) {
 	logger.WithFields(logger.Fields{
 		"ChainID": chainID,
 		"Sender":  userOp["sender"],
 	}).Info("Starting UserOperation signing")
 	
-	// Get owner private key
-	ownerPrivateKey := viper.GetString("SMART_ACCOUNT_OWNER_PRIVATE_KEY")
-	if ownerPrivateKey == "" {
-		logger.WithFields(logger.Fields{}).Error("SMART_ACCOUNT_OWNER_PRIVATE_KEY not configured")
-		return "", fmt.Errorf("SMART_ACCOUNT_OWNER_PRIVATE_KEY not configured")
+	// Session key UserOperations, including resubmissions, are signed by the session key
+	if sessionKey, ok := sessionKeyOfCallData(userOp["callData"]); ok {
+		return signWithSessionKey(ctx, chainID, userOp, sessionKey)
+	}
+
+	// Get the signer of the sender's owner: the network's owner signer or the SIGNER_BACKEND owner
+	// key. Senders without a recorded owner are signed for by the network's signer when it has one
+	var owner string
+	if sender, ok := userOp["sender"].(string); ok {
+		owned, err := storage.Client.ReceiveAddress.
+			Query().
+			Where(
+				receiveaddress.AddressEqualFold(sender),
+				receiveaddress.OwnerAddressNEQ(""),
+			).
+			First(ctx)
+		if err == nil {
+			owner = owned.OwnerAddress
+		} else if !ent.IsNotFound(err) {
+			return "", fmt.Errorf("failed to get owner of %s: %w", sender, err)
+		}
+	}
+
+	ownerSigner, err := OwnerSigner(ctx, chainID, owner)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error": fmt.Sprintf("%v", err),
+		}).Error("Failed to load smart account owner signer")
+		return "", fmt.Errorf("failed to load owner signer: %w", err)
 	}
 	
-	logger.WithFields(logger.Fields{}).Info("Private key found, proceeding with signing")
-	
-	// Parse private key
-	privateKey, err := crypto.HexToECDSA(strings.TrimPrefix(ownerPrivateKey, "0x"))
+	// For Light Account v2, we need to sign the hash as an Ethereum signed message
+	// This adds the "\x19Ethereum Signed Message:\n32" prefix
+	ethSignedMessageHash := accounts.TextHash(userOperationHash(chainID, userOp).Bytes())
+
+	// Sign the Ethereum signed message hash
+	signature, err := ownerSigner.SignDigest(ctx, ethSignedMessageHash)
 	if err != nil {
-		return "", fmt.Errorf("failed to parse private key: %w", err)
+		return "", fmt.Errorf("failed to sign user operation: %w", err)
 	}
 	
+	finalSignature := typedUserOperationSignature(signature)
+	
+	logger.WithFields(logger.Fields{
+		"SignatureLength": len(finalSignature),
+		"Signature":       finalSignature,
+	}).Info("UserOperation signed successfully")
+	
+	return finalSignature, nil
+}
+
+// typedUserOperationSignature formats an owner signature for Light Account v2.0.0 with EntryPoint v0.7:
+// 0x00 (EOA) || r || s || v (v in {27,28})
+func typedUserOperationSignature(signature []byte) string {
+	sig := make([]byte, len(signature))
+	copy(sig, signature)
+	if sig[64] < 27 {
+		sig[64] += 27
+	}
+
+	// Prepend signature type byte 0x00 for EOA signatures
+	return "0x" + common.Bytes2Hex(append([]byte{0x00}, sig...))
+}
+
+// PrepareUserOperation builds the unsigned UserOperation executing a single transaction from a smart
+// account and returns it with its hash, so the account owner can sign it outside the server
+func (s *AlchemyService) PrepareUserOperation(ctx context.Context, chainID int64, smartAccountAddress string, tx map[string]interface{}) (map[string]interface{}, string, error) {
+	userOp, err := s.buildUserOperation(ctx, chainID, smartAccountAddress, tx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return userOp, userOperationHash(chainID, userOp).Hex(), nil
+}
+
+// resendUserOperation signs and sends a UserOperation replacing one with the same nonce, returning
+// it signed with its hash. A sponsored operation is sponsored again at its new fees, as the
+// paymaster signature covers them
+func (s *AlchemyService) resendUserOperation(ctx context.Context, chainID int64, userOp map[string]interface{}) (map[string]interface{}, string, error) {
+	if _, sponsored := userOp["paymaster"]; sponsored && s.config.GasPolicyID != "" {
+		maxFeePerGas, maxPriorityFeePerGas := userOp["maxFeePerGas"], userOp["maxPriorityFeePerGas"]
+
+		minimalUserOp := map[string]interface{}{
+			"sender":               userOp["sender"],
+			"nonce":                userOp["nonce"],
+			"callData":             userOp["callData"],
+			"callGasLimit":         userOp["callGasLimit"],
+			"verificationGasLimit": userOp["verificationGasLimit"],
+			"preVerificationGas":   userOp["preVerificationGas"],
+			"maxFeePerGas":         maxFeePerGas,
+			"maxPriorityFeePerGas": maxPriorityFeePerGas,
+		}
+		if initCode, ok := userOp["initCode"].(string); ok && initCode != "" && initCode != "0x" {
+			minimalUserOp["initCode"] = initCode
+		}
+
+		result, err := s.getPaymasterData(ctx, chainID, minimalUserOp, map[string]interface{}{
+			"maxFeePerGas":         maxFeePerGas,
+			"maxPriorityFeePerGas": maxPriorityFeePerGas,
+		})
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to sponsor replacement: %w", err)
+		}
+		applyPaymasterData(userOp, result)
+
+		// A replacement paying less than asked would be rejected by the bundler
+		if userOp["maxFeePerGas"] != maxFeePerGas || userOp["maxPriorityFeePerGas"] != maxPriorityFeePerGas {
+			return nil, "", fmt.Errorf("paymaster did not keep the replacement fees")
+		}
+	}
+
+	signature, err := s.signUserOperation(ctx, chainID, userOp)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to sign user operation: %w", err)
+	}
+	userOp["signature"] = signature
+
+	userOpHash, err := s.SendUserOperation(ctx, chainID, userOp)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return userOp, userOpHash, nil
+}
+
+// SubmitSignedUserOperation attaches an externally produced owner signature to a prepared UserOperation
+// and sends it. The signature must be a personal_sign of the UserOp hash by the expected signer
+func (s *AlchemyService) SubmitSignedUserOperation(ctx context.Context, chainID int64, userOp map[string]interface{}, signature string, signer string) (string, error) {
+	typedSignature, err := verifyUserOperationSignature(chainID, userOp, signature, signer)
+	if err != nil {
+		return "", err
+	}
+
+	signed := make(map[string]interface{}, len(userOp))
+	for key, value := range userOp {
+		signed[key] = value
+	}
+	signed["signature"] = typedSignature
+
+	return s.SendUserOperation(ctx, chainID, signed)
+}
+
+// verifyUserOperationSignature checks that signature is a personal_sign of the UserOp hash by signer
+// and returns it formatted for submission
+func verifyUserOperationSignature(chainID int64, userOp map[string]interface{}, signature string, signer string) (string, error) {
+	sig, err := hexutil.Decode(signature)
+	if err != nil {
+		return "", fmt.Errorf("invalid signature: %w", err)
+	}
+
+	// Accept typed signatures as produced by signUserOperation
+	if len(sig) == 66 && sig[0] == 0x00 {
+		sig = sig[1:]
+	}
+	if len(sig) != 65 {
+		return "", fmt.Errorf("invalid signature length %d", len(sig))
+	}
+
+	recoverable := make([]byte, 65)
+	copy(recoverable, sig)
+	if recoverable[64] >= 27 {
+		recoverable[64] -= 27
+	}
+
+	pubKey, err := crypto.SigToPub(accounts.TextHash(userOperationHash(chainID, userOp).Bytes()), recoverable)
+	if err != nil {
+		return "", fmt.Errorf("failed to recover signer: %w", err)
+	}
+	if recovered := crypto.PubkeyToAddress(*pubKey); !strings.EqualFold(recovered.Hex(), signer) {
+		return "", fmt.Errorf("signature is from %s, expected %s", recovered.Hex(), signer)
+	}
+
+	return typedUserOperationSignature(sig), nil
+}
+
+// userOperationHash computes the ERC-4337 v0.7 hash of a UserOperation for the EntryPoint and chain.
+// Light Account owners sign it as an Ethereum signed message (personal_sign)
+func userOperationHash(chainID int64, userOp map[string]interface{}) common.Hash {
 	// Get the UserOp hash from the EntryPoint contract
 	// For ERC-4337, the hash is: keccak256(abi.encode(userOpHash, entryPoint, chainId))
 	entryPoint := common.HexToAddress("0x0000000071727De22E5E9d8baF0edAc6f37da032") // EntryPoint v0.7
@@ -1445,40 +1814,14 @@ func (s *AlchemyService) signUserOperation(ctx context.Context, chainID int64, u
 	
 	finalHash := crypto.Keccak256Hash(finalPacked)
 	
-	// For Light Account v2, we need to sign the hash as an Ethereum signed message
-	// This adds the "\x19Ethereum Signed Message:\n32" prefix
-	ethSignedMessageHash := accounts.TextHash(finalHash.Bytes())
-	
 	logger.WithFields(logger.Fields{
 		"UserOpHash": userOpHash.Hex(),
 		"FinalHash":  finalHash.Hex(),
-		"EthSignedMessageHash": "0x" + common.Bytes2Hex(ethSignedMessageHash),
 		"EntryPoint": entryPoint.Hex(),
 		"ChainID":    chainID,
 	}).Info("Computed UserOp hash for signing")
-	
-	// Sign the Ethereum signed message hash
-	signature, err := crypto.Sign(ethSignedMessageHash, privateKey)
-	if err != nil {
-		return "", fmt.Errorf("failed to sign user operation: %w", err)
-	}
-	
-	// For Light Account v2.0.0 with EntryPoint v0.7:
-	// Typed signature: 0x00 (EOA) || r || s || v (v in {27,28})
-	if signature[64] < 27 {
-		signature[64] += 27
-	}
-	// Prepend signature type byte 0x00 for EOA signatures
-	typedSignature := append([]byte{0x00}, signature...)
-	
-	finalSignature := "0x" + common.Bytes2Hex(typedSignature)
-	
-	logger.WithFields(logger.Fields{
-		"SignatureLength": len(finalSignature),
-		"Signature":       finalSignature,
-	}).Info("UserOperation signed successfully")
-	
-	return finalSignature, nil
+
+	return finalHash
 }
 
 // getMapKeys is a helper function to get all keys from a map
@@ -1491,8 +1834,9 @@ func getMapKeys(m map[string]interface{}) []string {
 }
 
 // getPaymasterData requests paymaster and data from Alchemy Gas Manager
-// Returns the full result including gas estimates and paymasterAndData
-func (s *AlchemyService) getPaymasterData(ctx context.Context, chainID int64, userOp map[string]interface{}) (map[string]interface{}, error) {
+// Returns the full result including gas estimates and paymasterAndData. overrides, when set, fix
+// fields of the result such as maxFeePerGas instead of leaving them to Alchemy's estimates
+func (s *AlchemyService) getPaymasterData(ctx context.Context, chainID int64, userOp map[string]interface{}, overrides map[string]interface{}) (map[string]interface{}, error) {
 	// DEBUG: Log the incoming userOp BEFORE any processing
 	userOpJSON, _ := json.Marshal(userOp)
 	logger.WithFields(logger.Fields{
@@ -1528,19 +1872,22 @@ func (s *AlchemyService) getPaymasterData(ctx context.Context, chainID int64, us
 	// Convert to v0.7 RPC format for paymaster request
 	v07UserOp := s.packUserOperationV07(userOp)
 	
+	params := map[string]interface{}{
+		"policyId":      s.config.GasPolicyID,
+		"entryPoint":    "0x0000000071727De22E5E9d8baF0edAc6f37da032", // EntryPoint v0.7
+		"userOperation": v07UserOp,
+		// Dummy signature for Light Account v2: 0x00 (EOA type) + 65 bytes of dummy signature
+		"dummySignature": "0x00fffffffffffffffffffffffffffffff0000000000000000000000000000000007aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa1c",
+	}
+	if len(overrides) > 0 {
+		params["overrides"] = overrides
+	}
+
 	payload := map[string]interface{}{
 		"jsonrpc": "2.0",
 		"method":  "alchemy_requestGasAndPaymasterAndData",
-		"params": []interface{}{
-			map[string]interface{}{
-				"policyId":      s.config.GasPolicyID,
-				"entryPoint":    "0x0000000071727De22E5E9d8baF0edAc6f37da032", // EntryPoint v0.7
-				"userOperation": v07UserOp,
-				// Dummy signature for Light Account v2: 0x00 (EOA type) + 65 bytes of dummy signature
-				"dummySignature": "0x00fffffffffffffffffffffffffffffff0000000000000000000000000000000007aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa1c",
-			},
-		},
-		"id": 1,
+		"params":  []interface{}{params},
+		"id":      1,
 	}
 
 	// Log the request payload for debugging
@@ -1572,10 +1919,12 @@ func (s *AlchemyService) getPaymasterData(ctx context.Context, chainID int64, us
 
 	res, err := fastshot.NewClient(url).
 		Config().SetTimeout(30 * time.Second).
+		Config().SetCustomTransport(alchemyTransport()).
 		Header().AddAll(map[string]string{
 			"Accept":       "application/json",
 			"Content-Type": "application/json",
 		}).Build().POST("").
+		Context().Set(ctx).
 		Body().AsJSON(payload).Send()
 	
 	if err != nil {
@@ -1602,54 +1951,19 @@ func (s *AlchemyService) getPaymasterData(ctx context.Context, chainID int64, us
 	}
 
 	if data["error"] != nil {
-		errorJSON, _ := json.Marshal(data["error"])
-		
-		// Extract detailed error information
-		errorMap, _ := data["error"].(map[string]interface{})
-		errorCode := ""
-		errorMessage := ""
-		errorData := ""
-		revertData := ""
-		
-		if errorMap != nil {
-			if code, ok := errorMap["code"]; ok {
-				errorCode = fmt.Sprintf("%v", code)
-			}
-			if msg, ok := errorMap["message"]; ok {
-				errorMessage = fmt.Sprintf("%v", msg)
-			}
-			if errData, ok := errorMap["data"].(map[string]interface{}); ok {
-				errorDataJSON, _ := json.Marshal(errData)
-				errorData = string(errorDataJSON)
-				if reason, ok := errData["reason"]; ok {
-					revertData = fmt.Sprintf("%v", reason)
-				}
-			}
-		}
-		
+		aaErr := aaerrors.Parse(data["error"])
+		metrics.UserOperationFailed(chainID, "paymaster", aaErr.Reason())
 		logger.WithFields(logger.Fields{
-			"ErrorFull": string(errorJSON),
-			"ErrorCode": errorCode,
-			"ErrorMessage": errorMessage,
-			"ErrorData": errorData,
-			"RevertData": revertData,
-			"UserOpSender": v07UserOp["sender"],
-			"UserOpNonce": v07UserOp["nonce"],
+			"Error":         aaErr.Message,
+			"Code":          aaErr.Code,
+			"Cause":         aaErr.Reason(),
+			"Remediation":   aaErr.Remediation(),
+			"Data":          string(aaErr.Data),
+			"UserOpSender":  v07UserOp["sender"],
+			"UserOpNonce":   v07UserOp["nonce"],
 			"UserOpFactory": v07UserOp["factory"],
-			"UserOpCallData": fmt.Sprintf("%v", v07UserOp["callData"])[:100] + "...",
-		}).Error("[DEBUG] Paymaster request returned error - AA23 means validation/creation failed")
-		
-		// Provide helpful error context
-		if errorMessage == "AA23 reverted" || revertData == "AA23 reverted" {
-			logger.Errorf("[DEBUG] AA23 Error Analysis:")
-			logger.Errorf("  - Check if smart account exists (if nonce > 0, it should exist)")
-			logger.Errorf("  - Check if factory/factoryData is correct (if nonce = 0)")
-			logger.Errorf("  - Check if owner address in factoryData is correct")
-			logger.Errorf("  - Check if callData is properly encoded")
-			logger.Errorf("  - Check if gas limits are sufficient")
-		}
-		
-		return nil, fmt.Errorf("paymaster request failed: %v", data["error"])
+		}).Error("Paymaster request returned error")
+		return nil, fmt.Errorf("paymaster request failed: %w", aaErr)
 	}
 
 	result := data["result"].(map[string]interface{})
@@ -1873,24 +2187,163 @@ func (s *AlchemyService) DeleteWebhook(ctx context.Context, webhookID string) er
 	return nil
 }
 
-// getAlchemyNetworkID maps chain IDs to Alchemy network identifiers
+// AlchemyWebhook is a webhook registered with Alchemy Notify
+type AlchemyWebhook struct {
+	ID          string `json:"id"`
+	Network     string `json:"network"`
+	WebhookType string `json:"webhook_type"`
+	WebhookURL  string `json:"webhook_url"`
+	IsActive    bool   `json:"is_active"`
+}
+
+// ListWebhooks lists the webhooks registered by the team of the auth token
+func (s *AlchemyService) ListWebhooks(ctx context.Context) ([]AlchemyWebhook, error) {
+	client := fastshot.NewClient("https://dashboard.alchemy.com").
+		Header().Add("X-Alchemy-Token", s.config.AuthToken).
+		Build()
+
+	resp, err := client.GET("/api/team-webhooks").
+		Context().Set(ctx).
+		Send()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhooks: %w", err)
+	}
+	defer resp.RawResponse.Body.Close()
+
+	if resp.StatusCode() != 200 {
+		return nil, fmt.Errorf("failed to list webhooks with status %d", resp.StatusCode())
+	}
+
+	var result struct {
+		Data []AlchemyWebhook `json:"data"`
+	}
+	if err := json.NewDecoder(resp.RawResponse.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to parse webhooks response: %w", err)
+	}
+
+	return result.Data, nil
+}
+
+// ListWebhookAddresses lists the addresses an Address Activity webhook monitors
+func (s *AlchemyService) ListWebhookAddresses(ctx context.Context, webhookID string) ([]string, error) {
+	client := fastshot.NewClient("https://dashboard.alchemy.com").
+		Header().Add("X-Alchemy-Token", s.config.AuthToken).
+		Build()
+
+	var addresses []string
+	after := ""
+	for {
+		path := fmt.Sprintf("/api/webhook-addresses?webhook_id=%s&limit=100", url.QueryEscape(webhookID))
+		if after != "" {
+			path += "&after=" + url.QueryEscape(after)
+		}
+
+		resp, err := client.GET(path).
+			Context().Set(ctx).
+			Send()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list webhook addresses: %w", err)
+		}
+
+		var result struct {
+			Data       []string `json:"data"`
+			Pagination struct {
+				Cursors struct {
+					After string `json:"after"`
+				} `json:"cursors"`
+			} `json:"pagination"`
+		}
+		if resp.StatusCode() != 200 {
+			resp.RawResponse.Body.Close()
+			return nil, fmt.Errorf("failed to list webhook addresses with status %d", resp.StatusCode())
+		}
+		err = json.NewDecoder(resp.RawResponse.Body).Decode(&result)
+		resp.RawResponse.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse webhook addresses response: %w", err)
+		}
+
+		addresses = append(addresses, result.Data...)
+		if result.Pagination.Cursors.After == "" || len(result.Data) == 0 {
+			return addresses, nil
+		}
+		after = result.Pagination.Cursors.After
+	}
+}
+
+// ActivateWebhook re-enables a webhook Alchemy deactivated, for example after failed deliveries
+func (s *AlchemyService) ActivateWebhook(ctx context.Context, webhookID string) error {
+	payload := map[string]interface{}{
+		"webhook_id": webhookID,
+		"is_active":  true,
+	}
+
+	client := fastshot.NewClient("https://dashboard.alchemy.com").
+		Header().Add("X-Alchemy-Token", s.config.AuthToken).
+		Build()
+
+	resp, err := client.PUT("/api/update-webhook").
+		Context().Set(ctx).
+		Header().AddContentType("application/json").
+		Body().AsJSON(payload).
+		Send()
+	if err != nil {
+		return fmt.Errorf("failed to activate webhook: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return fmt.Errorf("failed to activate webhook with status %d", resp.StatusCode())
+	}
+
+	logger.WithFields(logger.Fields{
+		"WebhookID": webhookID,
+	}).Infof("Activated Alchemy webhook")
+
+	return nil
+}
+
+// alchemyNetworks maps chain IDs to Alchemy network identifiers
+var alchemyNetworks = map[int64]string{
+	1:        "ETH_MAINNET",
+	11155111: "ETH_SEPOLIA",
+	137:      "MATIC_MAINNET",
+	80002:    "MATIC_AMOY",
+	42161:    "ARB_MAINNET",
+	421614:   "ARB_SEPOLIA",
+	10:       "OPT_MAINNET",
+	11155420: "OPT_SEPOLIA",
+	8453:     "BASE_MAINNET",
+	84532:    "BASE_SEPOLIA",
+	56:       "BNB_MAINNET",
+	97:       "BNB_TESTNET",
+}
+
+// AlchemyNetworkID returns the Alchemy network identifier of a network: its alchemy_network_id,
+// or the identifier known for its chain ID
+func AlchemyNetworkID(net *ent.Network) (string, bool) {
+	if net.AlchemyNetworkID != "" {
+		return net.AlchemyNetworkID, true
+	}
+
+	networkID, exists := alchemyNetworks[net.ChainID]
+	return networkID, exists
+}
+
+// getAlchemyNetworkID maps chain IDs to Alchemy network identifiers, preferring the
+// alchemy_network_id configured on the network
 func (s *AlchemyService) getAlchemyNetworkID(chainID int64) (string, error) {
-	networkMap := map[int64]string{
-		1:     "ETH_MAINNET",
-		11155111: "ETH_SEPOLIA",
-		137:   "MATIC_MAINNET",
-		80002: "MATIC_AMOY",
-		42161: "ARB_MAINNET",
-		421614: "ARB_SEPOLIA",
-		10:    "OPT_MAINNET",
-		11155420: "OPT_SEPOLIA",
-		8453:  "BASE_MAINNET",
-		84532: "BASE_SEPOLIA",
-		56:    "BNB_MAINNET",
-		97:    "BNB_TESTNET",
-	}
-
-	networkID, exists := networkMap[chainID]
+	if storage.Client != nil {
+		configured, err := storage.Client.Network.
+			Query().
+			Where(network.ChainIDEQ(chainID), network.AlchemyNetworkIDNEQ("")).
+			Select(network.FieldAlchemyNetworkID).
+			Strings(context.Background())
+		if err == nil && len(configured) > 0 {
+			return configured[0], nil
+		}
+	}
+
+	networkID, exists := alchemyNetworks[chainID]
 	if !exists {
 		return "", fmt.Errorf("unsupported chain ID: %d", chainID)
 	}
@@ -1898,6 +2351,29 @@ func (s *AlchemyService) getAlchemyNetworkID(chainID int64) (string, error) {
 	return networkID, nil
 }
 
+// AlchemyNetworkChainID maps an Alchemy network identifier, as sent in webhook payloads, to its chain ID.
+// Networks configured with an alchemy_network_id take precedence over the built-in identifiers
+func AlchemyNetworkChainID(networkID string) (int64, error) {
+	if storage.Client != nil {
+		chainIDs, err := storage.Client.Network.
+			Query().
+			Where(network.AlchemyNetworkIDEqualFold(networkID)).
+			Select(network.FieldChainID).
+			Ints(context.Background())
+		if err == nil && len(chainIDs) > 0 {
+			return int64(chainIDs[0]), nil
+		}
+	}
+
+	for chainID, id := range alchemyNetworks {
+		if strings.EqualFold(id, networkID) {
+			return chainID, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unsupported Alchemy network: %s", networkID)
+}
+
 // getSmartAccountNonce fetches the nonce for a smart account from the EntryPoint contract
 func (s *AlchemyService) getSmartAccountNonce(ctx context.Context, chainID int64, address string) (uint64, error) {
 	// Get network to use chain-specific RPC endpoint
@@ -1940,10 +2416,12 @@ func (s *AlchemyService) getSmartAccountNonce(ctx context.Context, chainID int64
 	
 	res, err := fastshot.NewClient(url).
 		Config().SetTimeout(10 * time.Second).
+		Config().SetCustomTransport(alchemyTransport()).
 		Header().AddAll(map[string]string{
 			"Accept":       "application/json",
 			"Content-Type": "application/json",
 		}).Build().POST("").
+		Context().Set(ctx).
 		Body().AsJSON(payload).Send()
 	
 	if err != nil {
@@ -1972,38 +2450,35 @@ func (s *AlchemyService) getSmartAccountNonce(ctx context.Context, chainID int64
 	return nonce.Uint64(), nil
 }
 
-// isAccountDeployed checks if a smart account has been deployed on-chain
+// isAccountDeployed checks if a smart account has been deployed on-chain, over the RPC endpoints
+// of its network
 func (s *AlchemyService) isAccountDeployed(ctx context.Context, chainID int64, address string) (bool, error) {
-	// Get RPC URL based on chain ID
-	var rpcURL string
-	switch chainID {
-	case 84532: // Base Sepolia
-		rpcURL = "https://sepolia.base.org"
-	case 8453: // Base Mainnet
-		rpcURL = "https://mainnet.base.org"
-	case 11155111: // Ethereum Sepolia
-		rpcURL = "https://sepolia.infura.io/v3/9aa3d95b3bc440fa88ea12eaa4456161"
-	case 1: // Ethereum Mainnet
-		rpcURL = "https://mainnet.infura.io/v3/9aa3d95b3bc440fa88ea12eaa4456161"
-	default:
-		// Try to get from database if available
-		if storage.Client != nil {
-			net, err := storage.Client.Network.
-				Query().
-				Where(network.ChainIDEQ(chainID)).
-				Only(ctx)
-			if err != nil {
-				return false, fmt.Errorf("failed to get network: %w", err)
-			}
-			rpcURL = net.RPCEndpoint
-		} else {
-			return false, fmt.Errorf("unsupported chain ID: %d", chainID)
-		}
+	if storage.Client == nil {
+		return false, fmt.Errorf("no network configured for chain ID %d", chainID)
 	}
 
-	// Use eth_getCode to check if there's contract code at the address
-	url := rpcURL
-	
+	net, err := storage.Client.Network.
+		Query().
+		Where(network.ChainIDEQ(chainID)).
+		Only(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get network for chain %d: %w", chainID, err)
+	}
+
+	var code string
+	err = GetRPCManager().Do(ctx, net, func(endpoint string) (err error) {
+		code, err = s.getCode(endpoint, address)
+		return err
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return s.isDeployedCode(address, chainID, code), nil
+}
+
+// getCode fetches the contract code at an address with eth_getCode
+func (s *AlchemyService) getCode(rpcURL string, address string) (string, error) {
 	payload := map[string]interface{}{
 		"jsonrpc": "2.0",
 		"method":  "eth_getCode",
@@ -2011,33 +2486,39 @@ func (s *AlchemyService) isAccountDeployed(ctx context.Context, chainID int64, a
 		"id":      1,
 	}
 
-	res, err := fastshot.NewClient(url).
+	res, err := fastshot.NewClient(rpcURL).
 		Config().SetTimeout(10 * time.Second).
+		Config().SetCustomTransport(alchemyTransport()).
 		Header().AddAll(map[string]string{
 			"Accept":       "application/json",
 			"Content-Type": "application/json",
 		}).Build().POST("").
 		Body().AsJSON(payload).Send()
-	
+
 	if err != nil {
-		return false, fmt.Errorf("failed to check account deployment: %w", err)
+		return "", fmt.Errorf("failed to check account deployment: %w", err)
 	}
 
 	data, err := utils.ParseJSONResponse(res.RawResponse)
 	if err != nil {
-		return false, fmt.Errorf("failed to parse response: %w", err)
+		return "", EndpointError(fmt.Errorf("failed to parse response: %w", err))
 	}
 
 	if data["error"] != nil {
-		return false, fmt.Errorf("RPC error: %v", data["error"])
+		return "", fmt.Errorf("RPC error: %v", data["error"])
 	}
 
 	// Get the code result
 	code, ok := data["result"].(string)
 	if !ok {
-		return false, fmt.Errorf("invalid response format")
+		return "", EndpointError(fmt.Errorf("invalid response format"))
 	}
 
+	return code, nil
+}
+
+// isDeployedCode reports whether the code fetched at a smart account address means it is deployed
+func (s *AlchemyService) isDeployedCode(address string, chainID int64, code string) bool {
 	// If code is "0x" or empty, the account is not deployed
 	// If code has content, the account is deployed
 	isDeployed := code != "0x" && code != "" && code != "0x0"
@@ -2049,11 +2530,11 @@ func (s *AlchemyService) isAccountDeployed(ctx context.Context, chainID int64, a
 		"IsDeployed": isDeployed,
 	}).Debugf("Checked smart account deployment status")
 
-	return isDeployed, nil
+	return isDeployed
 }
 
 // sendEOATransaction signs and sends a single transaction from an EOA
-func (s *AlchemyService) sendEOATransaction(ctx context.Context, chainID int64, privateKey *ecdsa.PrivateKey, txPayload map[string]interface{}) (string, error) {
+func (s *AlchemyService) sendEOATransaction(ctx context.Context, chainID int64, eoaSigner signer.Signer, txPayload map[string]interface{}) (string, error) {
 	// Get RPC URL
 	net, err := storage.Client.Network.
 		Query().
@@ -2071,35 +2552,68 @@ func (s *AlchemyService) sendEOATransaction(ctx context.Context, chainID int64,
 		value.SetString(txPayload["value"].(string), 0)
 	}
 
-	// Get nonce
-	fromAddress := crypto.PubkeyToAddress(privateKey.PublicKey)
-	nonce, err := s.getNonce(ctx, net.RPCEndpoint, fromAddress.Hex())
-	if err != nil {
-		return "", fmt.Errorf("failed to get nonce: %w", err)
-	}
+	// Get nonce and fees from the same endpoint. Networks without EIP-1559 fees get a legacy
+	// transaction at the current gas price
+	fromAddress := eoaSigner.Address()
+	var nonce uint64
+	var fees *gasoracle.Fees
+	var gasPrice *big.Int
+	err = GetRPCManager().Do(ctx, net, func(endpoint string) (err error) {
+		nonce, err = s.getNonce(ctx, endpoint, fromAddress.Hex())
+		if err != nil {
+			return fmt.Errorf("failed to get nonce: %w", err)
+		}
+
+		fees, err = s.suggestFeesAt(ctx, chainID, endpoint, gasoracle.Default().Urgency())
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, gasoracle.ErrNoFeeMarket) {
+			logger.WithFields(logger.Fields{
+				"Error":   fmt.Sprintf("%v", err),
+				"ChainID": chainID,
+			}).Warnf("Gas oracle has no fee suggestion, using the current gas price")
+		}
 
-	// Get gas price
-	gasPrice, err := s.getGasPrice(ctx, net.RPCEndpoint)
+		gasPrice, err = s.getGasPrice(ctx, endpoint)
+		if err != nil {
+			return fmt.Errorf("failed to get gas price: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return "", fmt.Errorf("failed to get gas price: %w", err)
+		return "", err
 	}
 
 	// Estimate gas limit
 	gasLimit := uint64(300000) // Default gas limit
 
 	// Create transaction
-	tx := types.NewTransaction(
-		nonce,
-		toAddress,
-		value,
-		gasLimit,
-		gasPrice,
-		data,
-	)
+	var tx *types.Transaction
+	if fees != nil {
+		tx = types.NewTx(&types.DynamicFeeTx{
+			ChainID:   big.NewInt(chainID),
+			Nonce:     nonce,
+			GasTipCap: fees.MaxPriorityFeePerGas,
+			GasFeeCap: fees.MaxFeePerGas,
+			Gas:       gasLimit,
+			To:        &toAddress,
+			Value:     value,
+			Data:      data,
+		})
+	} else {
+		tx = types.NewTransaction(
+			nonce,
+			toAddress,
+			value,
+			gasLimit,
+			gasPrice,
+			data,
+		)
+	}
 
 	// Sign transaction
-	signer := types.NewEIP155Signer(big.NewInt(chainID))
-	signedTx, err := types.SignTx(tx, signer, privateKey)
+	signedTx, err := signer.SignTx(ctx, eoaSigner, tx, big.NewInt(chainID))
 	if err != nil {
 		return "", fmt.Errorf("failed to sign transaction: %w", err)
 	}
@@ -2120,28 +2634,39 @@ func (s *AlchemyService) sendEOATransaction(ctx context.Context, chainID int64,
 		"id":      1,
 	}
 
-	res, err := fastshot.NewClient(net.RPCEndpoint).
-		Config().SetTimeout(30 * time.Second).
-		Header().AddAll(map[string]string{
-			"Accept":       "application/json",
-			"Content-Type": "application/json",
-		}).Build().POST("").
-		Body().AsJSON(payload).Send()
+	// Resending the same signed transaction to a fallback endpoint is safe, as it has the same hash
+	var txHash string
+	err = GetRPCManager().Do(ctx, net, func(endpoint string) error {
+		res, err := fastshot.NewClient(endpoint).
+			Config().SetTimeout(30 * time.Second).
+			Config().SetCustomTransport(ratelimit.NewTransport()).
+			Header().AddAll(map[string]string{
+				"Accept":       "application/json",
+				"Content-Type": "application/json",
+			}).Build().POST("").
+			Context().Set(ctx).
+			Body().AsJSON(payload).Send()
 
-	if err != nil {
-		return "", fmt.Errorf("failed to send transaction: %w", err)
-	}
+		if err != nil {
+			return fmt.Errorf("failed to send transaction: %w", err)
+		}
 
-	data2, err := utils.ParseJSONResponse(res.RawResponse)
-	if err != nil {
-		return "", fmt.Errorf("failed to parse response: %w", err)
-	}
+		data2, err := utils.ParseJSONResponse(res.RawResponse)
+		if err != nil {
+			return EndpointError(fmt.Errorf("failed to parse response: %w", err))
+		}
+
+		if data2["error"] != nil {
+			return fmt.Errorf("RPC error: %v", data2["error"])
+		}
 
-	if data2["error"] != nil {
-		return "", fmt.Errorf("RPC error: %v", data2["error"])
+		txHash = data2["result"].(string)
+		return nil
+	})
+	if err != nil {
+		return "", err
 	}
 
-	txHash := data2["result"].(string)
 	return txHash, nil
 }
 
@@ -2156,10 +2681,12 @@ func (s *AlchemyService) getNonce(ctx context.Context, rpcURL, address string) (
 
 	res, err := fastshot.NewClient(rpcURL).
 		Config().SetTimeout(10 * time.Second).
+		Config().SetCustomTransport(alchemyTransport()).
 		Header().AddAll(map[string]string{
 			"Accept":       "application/json",
 			"Content-Type": "application/json",
 		}).Build().POST("").
+		Context().Set(ctx).
 		Body().AsJSON(payload).Send()
 
 	if err != nil {
@@ -2168,7 +2695,7 @@ func (s *AlchemyService) getNonce(ctx context.Context, rpcURL, address string) (
 
 	data, err := utils.ParseJSONResponse(res.RawResponse)
 	if err != nil {
-		return 0, fmt.Errorf("failed to parse response: %w", err)
+		return 0, EndpointError(fmt.Errorf("failed to parse response: %w", err))
 	}
 
 	if data["error"] != nil {
@@ -2184,6 +2711,56 @@ func (s *AlchemyService) getNonce(ctx context.Context, rpcURL, address string) (
 	return nonce, nil
 }
 
+// defaultUserOperationFee is the maxFeePerGas and maxPriorityFeePerGas of UserOperations when the
+// gas oracle has no suggestion (1.5 gwei)
+const defaultUserOperationFee = "0x59682f00"
+
+// userOperationFees returns the maxFeePerGas and maxPriorityFeePerGas of a UserOperation on a
+// network, as hex
+func (s *AlchemyService) userOperationFees(ctx context.Context, chainID int64) (string, string) {
+	fees, err := s.suggestFees(ctx, chainID, gasoracle.Default().Urgency())
+	if err == nil {
+		return hexutil.EncodeBig(fees.MaxFeePerGas), hexutil.EncodeBig(fees.MaxPriorityFeePerGas)
+	}
+
+	logger.WithFields(logger.Fields{
+		"Error":   fmt.Sprintf("%v", err),
+		"ChainID": chainID,
+	}).Warnf("Gas oracle has no fee suggestion, using default UserOperation fees")
+	return defaultUserOperationFee, defaultUserOperationFee
+}
+
+// suggestFees returns the gas oracle's fee suggestion for a network at the given urgency, reading
+// the fee history through the network's RPC endpoints
+func (s *AlchemyService) suggestFees(ctx context.Context, chainID int64, urgency gasoracle.Urgency) (*gasoracle.Fees, error) {
+	net, err := storage.Client.Network.
+		Query().
+		Where(network.ChainIDEQ(chainID)).
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get network for chain %d: %w", chainID, err)
+	}
+
+	var fees *gasoracle.Fees
+	err = GetRPCManager().Do(ctx, net, func(endpoint string) (err error) {
+		fees, err = s.suggestFeesAt(ctx, chainID, endpoint, urgency)
+		return err
+	})
+	return fees, err
+}
+
+// suggestFeesAt returns the gas oracle's fee suggestion for a network at the given urgency, reading
+// the fee history from endpoint when the cached suggestion is stale
+func (s *AlchemyService) suggestFeesAt(ctx context.Context, chainID int64, endpoint string, urgency gasoracle.Urgency) (*gasoracle.Fees, error) {
+	client, err := ratelimit.DialEthClient(ctx, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	return gasoracle.Default().SuggestFees(ctx, chainID, client, urgency)
+}
+
 // getGasPrice gets the current gas price
 func (s *AlchemyService) getGasPrice(ctx context.Context, rpcURL string) (*big.Int, error) {
 	payload := map[string]interface{}{
@@ -2195,10 +2772,12 @@ func (s *AlchemyService) getGasPrice(ctx context.Context, rpcURL string) (*big.I
 
 	res, err := fastshot.NewClient(rpcURL).
 		Config().SetTimeout(10 * time.Second).
+		Config().SetCustomTransport(alchemyTransport()).
 		Header().AddAll(map[string]string{
 			"Accept":       "application/json",
 			"Content-Type": "application/json",
 		}).Build().POST("").
+		Context().Set(ctx).
 		Body().AsJSON(payload).Send()
 
 	if err != nil {
@@ -2207,7 +2786,7 @@ func (s *AlchemyService) getGasPrice(ctx context.Context, rpcURL string) (*big.I
 
 	data, err := utils.ParseJSONResponse(res.RawResponse)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
+		return nil, EndpointError(fmt.Errorf("failed to parse response: %w", err))
 	}
 
 	if data["error"] != nil {
@@ -2260,10 +2839,12 @@ func (s *AlchemyService) GetAddressTransactionHistory(ctx context.Context, chain
 	
 	res, err := fastshot.NewClient(url).
 		Config().SetTimeout(30 * time.Second).
+		Config().SetCustomTransport(alchemyTransport()).
 		Header().AddAll(map[string]string{
 			"Accept":       "application/json",
 			"Content-Type": "application/json",
 		}).Build().POST("").
+		Context().Set(ctx).
 		Body().AsJSON(payload).Send()
 	
 	if err != nil {
@@ -2306,8 +2887,10 @@ func (s *AlchemyService) GetAddressTransactionHistory(ctx context.Context, chain
 	return transactions, nil
 }
 
-// GetContractEventsRPC fetches contract events using RPC
-func (s *AlchemyService) GetContractEventsRPC(ctx context.Context, rpcEndpoint string, contractAddress string, fromBlock int64, toBlock int64, topics []string, txHash string) ([]interface{}, error) {
+// GetContractEventsRPC fetches the events of a contract using RPC, decoded through the event registry.
+// A registered signature as first topic selects the events of its contract, e.g. ERC20 transfers;
+// otherwise the Gateway events are returned
+func (s *AlchemyService) GetContractEventsRPC(ctx context.Context, rpcEndpoint string, contractAddress string, fromBlock int64, toBlock int64, topics []string, txHash string) ([]events.Event, error) {
 	// Build full RPC URL with API key
 	fullRPCURL := utils.BuildRPCURL(rpcEndpoint)
 	
@@ -2317,21 +2900,15 @@ func (s *AlchemyService) GetContractEventsRPC(ctx context.Context, rpcEndpoint s
 		return nil, fmt.Errorf("failed to create RPC client: %w", err)
 	}
 
-	var logs []ethereumtypes.Log
-
-	// Determine which event signatures to filter for based on topics
-	var eventSignatures []string
-	if len(topics) > 0 && topics[0] == utils.TransferEventSignature {
-		eventSignatures = []string{utils.TransferEventSignature}
-	} else {
-		// Default to gateway event signatures
-		eventSignatures = []string{
-			utils.OrderCreatedEventSignature,
-			utils.OrderSettledEventSignature,
-			utils.OrderRefundedEventSignature,
+	registry := events.Default()
+	contract := events.Gateway
+	if len(topics) > 0 {
+		if registered, ok := registry.Contract(common.HexToHash(topics[0])); ok {
+			contract = registered
 		}
 	}
 
+	var logs []ethereumtypes.Log
 	if txHash != "" {
 		// Get specific transaction receipt
 		receipt, err := client.TransactionReceipt(ctx, common.HexToHash(txHash))
@@ -2339,18 +2916,9 @@ func (s *AlchemyService) GetContractEventsRPC(ctx context.Context, rpcEndpoint s
 			return nil, fmt.Errorf("failed to get transaction receipt: %w", err)
 		}
 
-		// Filter logs from receipt
 		for _, log := range receipt.Logs {
 			if log.Address == common.HexToAddress(contractAddress) {
-				if len(log.Topics) > 0 {
-					eventSignature := log.Topics[0].Hex()
-					for _, signature := range eventSignatures {
-						if eventSignature == signature {
-							logs = append(logs, *log)
-							break
-						}
-					}
-				}
+				logs = append(logs, *log)
 			}
 		}
 	} else {
@@ -2373,57 +2941,32 @@ func (s *AlchemyService) GetContractEventsRPC(ctx context.Context, rpcEndpoint s
 			}
 		}
 
-		// Get all logs from the contract
-		allLogs, err := client.FilterLogs(ctx, filterQuery)
+		logs, err = client.FilterLogs(ctx, filterQuery)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get logs: %w", err)
 		}
-
-		// Filter for specified event signatures
-		for _, log := range allLogs {
-			if len(log.Topics) > 0 {
-				eventSignature := log.Topics[0].Hex()
-				for _, signature := range eventSignatures {
-					if eventSignature == signature {
-						logs = append(logs, log)
-						break
-					}
-				}
-			}
-		}
 	}
 
-	// Convert logs to standard format
-	var events []interface{}
+	// Keep the logs of the selected contract's registered events
+	var contractLogs []ethereumtypes.Log
 	for _, log := range logs {
-		event := map[string]interface{}{
-			"block_number":     float64(log.BlockNumber),
-			"transaction_hash": log.TxHash.Hex(),
-			"log_index":        float64(log.Index),
-			"address":          log.Address.Hex(),
-			"topics":           log.Topics,
-			"data":             log.Data,
-			"decoded": map[string]interface{}{
-				"indexed_params":     make(map[string]interface{}),
-				"non_indexed_params": make(map[string]interface{}),
-			},
+		if len(log.Topics) == 0 {
+			continue
 		}
-		events = append(events, event)
-	}
-
-	// Decode events based on their signatures
-	if len(events) > 0 {
-		err = utils.ProcessRPCEventsBySignature(events)
-		if err != nil {
-			return nil, fmt.Errorf("failed to process RPC events: %w", err)
+		if registered, ok := registry.Contract(log.Topics[0]); ok && registered == contract {
+			contractLogs = append(contractLogs, log)
 		}
 	}
 
-	return events, nil
+	decoded, err := registry.DecodeLogs(contractLogs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode events: %w", err)
+	}
+	return decoded, nil
 }
 
 // GetContractEventsWithFallback tries RPC to get contract events
-func (s *AlchemyService) GetContractEventsWithFallback(ctx context.Context, network *ent.Network, contractAddress string, fromBlock int64, toBlock int64, topics []string, txHash string, eventPayload map[string]string) ([]interface{}, error) {
+func (s *AlchemyService) GetContractEventsWithFallback(ctx context.Context, network *ent.Network, contractAddress string, fromBlock int64, toBlock int64, topics []string, txHash string, eventPayload map[string]string) ([]events.Event, error) {
 	logger.WithFields(logger.Fields{
 		"TxHash":          txHash,
 		"ContractAddress": contractAddress,
@@ -2431,8 +2974,12 @@ func (s *AlchemyService) GetContractEventsWithFallback(ctx context.Context, netw
 		"Network":         network.Identifier,
 	}).Debug("GetContractEventsWithFallback called")
 
-	// Use RPC to get contract events
-	events, err := s.GetContractEventsRPC(ctx, network.RPCEndpoint, contractAddress, fromBlock, toBlock, topics, txHash)
+	// Use RPC to get contract events, failing over to the network's fallback endpoints
+	var decoded []events.Event
+	err := GetRPCManager().Do(ctx, network, func(endpoint string) (err error) {
+		decoded, err = s.GetContractEventsRPC(ctx, endpoint, contractAddress, fromBlock, toBlock, topics, txHash)
+		return err
+	})
 	if err != nil {
 		logger.WithFields(logger.Fields{
 			"TxHash":          txHash,
@@ -2445,8 +2992,8 @@ func (s *AlchemyService) GetContractEventsWithFallback(ctx context.Context, netw
 	logger.WithFields(logger.Fields{
 		"TxHash":          txHash,
 		"ContractAddress": contractAddress,
-		"EventsFound":     len(events),
+		"EventsFound":     len(decoded),
 	}).Debug("GetContractEventsWithFallback completed")
 
-	return events, nil
+	return decoded, nil
 }
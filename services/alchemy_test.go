@@ -99,6 +99,21 @@ func TestSmartAccountAddressGeneration(t *testing.T) {
 	t.Logf("Generated smart account address: %s", addr1)
 }
 
+// TestTransferOwnershipEncoding tests the transferOwnership call sent to Light Accounts
+func TestTransferOwnershipEncoding(t *testing.T) {
+	newOwner := "0xFb84E5503bD20526f2579193411Dd0993d080775"
+	expected := "0xf2fde38b000000000000000000000000fb84e5503bd20526f2579193411dd0993d080775"
+
+	if data := encodeTransferOwnership(newOwner); data != expected {
+		t.Errorf("Unexpected transferOwnership call data: %s", data)
+	}
+
+	service := NewAlchemyService()
+	if _, err := service.TransferAccountOwnership(context.Background(), 84532, newOwner, "not an address"); err == nil {
+		t.Errorf("Expected an invalid new owner to be rejected")
+	}
+}
+
 // TestServiceManager tests the service manager functionality
 func TestServiceManager(t *testing.T) {
 	// Test default behavior (should use Thirdweb)
@@ -0,0 +1,284 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	networkent "github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/paymentwebhook"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	tokenent "github.com/NEDA-LABS/stablenode/ent/token"
+	"github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+)
+
+// AlchemyWebhookPath is the path Alchemy Address Activity webhooks deliver deposits to
+const AlchemyWebhookPath = "/v1/alchemy/webhook"
+
+// alchemyWebhookAPI is the part of the Alchemy Notify API the webhook reconciliation works through
+type alchemyWebhookAPI interface {
+	ListWebhooks(ctx context.Context) ([]AlchemyWebhook, error)
+	ListWebhookAddresses(ctx context.Context, webhookID string) ([]string, error)
+	CreateAddressActivityWebhook(ctx context.Context, chainID int64, addresses []string, webhookURL string) (string, string, error)
+	AddAddressesToWebhook(ctx context.Context, webhookID string, addresses []string) error
+	ActivateWebhook(ctx context.Context, webhookID string) error
+	DeleteWebhook(ctx context.Context, webhookID string) error
+}
+
+// AlchemyWebhookReport is the outcome of reconciling the Alchemy webhooks against the database
+type AlchemyWebhookReport struct {
+	// Created counts webhooks created for networks missing one
+	Created int
+	// Activated counts webhooks Alchemy had deactivated
+	Activated int
+	// Pruned counts webhooks deleted because no network expects them
+	Pruned int
+	// AddressesAdded counts receive addresses of open orders added back to their network's webhook
+	AddressesAdded int
+	// Errors holds the failures of individual networks and webhooks
+	Errors []error
+}
+
+// ReconcileAlchemyWebhooks makes the Address Activity webhooks registered at Alchemy match the
+// database. Every enabled EVM network detecting deposits through webhooks gets one webhook delivering to
+// SERVER_URL, recorded as an alchemy payment webhook of the network, and the receive addresses of
+// its open orders are added back to it. Webhooks delivering to SERVER_URL that no network expects
+// are deleted
+func ReconcileAlchemyWebhooks(ctx context.Context) (*AlchemyWebhookReport, error) {
+	callbackURL := config.ServerConfig().ServerURL + AlchemyWebhookPath
+	return reconcileAlchemyWebhooks(ctx, NewAlchemyService(), callbackURL)
+}
+
+func reconcileAlchemyWebhooks(ctx context.Context, api alchemyWebhookAPI, callbackURL string) (*AlchemyWebhookReport, error) {
+	networks, err := storage.Client.Network.
+		Query().
+		Where(
+			networkent.NetworkTypeEQ(networkent.NetworkTypeEvm),
+			networkent.Not(networkent.IdentifierHasPrefix("tron")),
+			networkent.IsEnabledEQ(true),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch networks: %w", err)
+	}
+
+	records, err := storage.Client.PaymentWebhook.
+		Query().
+		Where(
+			paymentwebhook.ProviderEQ(paymentwebhook.ProviderAlchemy),
+			paymentwebhook.HasNetwork(),
+			paymentwebhook.Not(paymentwebhook.HasPaymentOrder()),
+		).
+		WithNetwork().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch alchemy webhooks: %w", err)
+	}
+
+	remoteWebhooks, err := api.ListWebhooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	remote := make(map[string]AlchemyWebhook, len(remoteWebhooks))
+	for _, webhook := range remoteWebhooks {
+		remote[webhook.ID] = webhook
+	}
+
+	report := &AlchemyWebhookReport{}
+	kept := make(map[string]bool)
+
+	// Each network expecting webhooks keeps its first record; further records are duplicates
+	expected := make(map[int]bool)
+	recordOf := make(map[int]*ent.PaymentWebhook)
+	for _, network := range networks {
+		if _, ok := AlchemyNetworkID(network); ok && DepositSourcesFor(network).Webhooks {
+			expected[network.ID] = true
+		}
+	}
+	for _, record := range records {
+		networkID := record.Edges.Network.ID
+		if expected[networkID] && recordOf[networkID] == nil {
+			recordOf[networkID] = record
+			continue
+		}
+		// A duplicate sharing the kept record's webhook only loses its row
+		shared := recordOf[networkID] != nil && recordOf[networkID].WebhookID == record.WebhookID
+		if err := pruneAlchemyWebhook(ctx, api, record, remote, !shared); err != nil {
+			report.Errors = append(report.Errors, err)
+			kept[record.WebhookID] = true
+			continue
+		}
+		report.Pruned++
+	}
+
+	for _, network := range networks {
+		if !expected[network.ID] {
+			continue
+		}
+
+		webhookID, err := reconcileNetworkWebhook(ctx, api, network, recordOf[network.ID], remote, callbackURL, report)
+		if webhookID != "" {
+			kept[webhookID] = true
+		}
+		if err != nil {
+			report.Errors = append(report.Errors, fmt.Errorf("%s: %w", network.Identifier, err))
+		}
+	}
+
+	// Webhooks still registered and delivering to this aggregator that no record keeps were left behind by deleted records
+	// or by a reconciliation that failed to record the webhook it created
+	for _, webhook := range remoteWebhooks {
+		if _, ok := remote[webhook.ID]; !ok || kept[webhook.ID] || webhook.WebhookType != "ADDRESS_ACTIVITY" || webhook.WebhookURL != callbackURL {
+			continue
+		}
+		if err := api.DeleteWebhook(ctx, webhook.ID); err != nil {
+			report.Errors = append(report.Errors, fmt.Errorf("delete orphaned webhook %s: %w", webhook.ID, err))
+			continue
+		}
+		report.Pruned++
+	}
+
+	return report, nil
+}
+
+// reconcileNetworkWebhook makes sure a network has a live webhook delivering to callbackURL and
+// monitoring the receive addresses of its open orders. It returns the ID of the network's webhook
+func reconcileNetworkWebhook(ctx context.Context, api alchemyWebhookAPI, network *ent.Network, record *ent.PaymentWebhook, remote map[string]AlchemyWebhook, callbackURL string, report *AlchemyWebhookReport) (string, error) {
+	// Until a webhook replaces it, the record's webhook is kept
+	recordedID := ""
+	if record != nil {
+		recordedID = record.WebhookID
+	}
+
+	addresses, err := openOrderAddresses(ctx, network)
+	if err != nil {
+		return recordedID, err
+	}
+
+	alchemyNetworkID, _ := AlchemyNetworkID(network)
+	var existing *AlchemyWebhook
+	if record != nil {
+		if webhook, ok := remote[record.WebhookID]; ok && webhook.WebhookURL == callbackURL && webhook.Network == alchemyNetworkID {
+			existing = &webhook
+		}
+	}
+
+	if existing == nil {
+		webhookID, signingKey, err := api.CreateAddressActivityWebhook(ctx, network.ChainID, addresses, callbackURL)
+		if err != nil {
+			return recordedID, fmt.Errorf("create webhook: %w", err)
+		}
+
+		if record == nil {
+			_, err = storage.Client.PaymentWebhook.
+				Create().
+				SetWebhookID(webhookID).
+				SetWebhookSecret(signingKey).
+				SetCallbackURL(callbackURL).
+				SetProvider(paymentwebhook.ProviderAlchemy).
+				SetNetwork(network).
+				Save(ctx)
+		} else {
+			// The record's webhook was deleted at Alchemy or delivers to a previous SERVER_URL
+			if _, ok := remote[record.WebhookID]; ok {
+				if err := api.DeleteWebhook(ctx, record.WebhookID); err != nil {
+					logger.WithFields(logger.Fields{
+						"Error":     fmt.Sprintf("%v", err),
+						"WebhookID": record.WebhookID,
+					}).Warnf("Failed to delete replaced Alchemy webhook")
+				} else {
+					delete(remote, record.WebhookID)
+				}
+			}
+			_, err = record.Update().
+				SetWebhookID(webhookID).
+				SetWebhookSecret(signingKey).
+				SetCallbackURL(callbackURL).
+				Save(ctx)
+		}
+		if err != nil {
+			return webhookID, fmt.Errorf("save webhook %s: %w", webhookID, err)
+		}
+
+		report.Created++
+		report.AddressesAdded += len(addresses)
+		return webhookID, nil
+	}
+
+	if !existing.IsActive {
+		if err := api.ActivateWebhook(ctx, existing.ID); err != nil {
+			return existing.ID, fmt.Errorf("activate webhook %s: %w", existing.ID, err)
+		}
+		report.Activated++
+	}
+
+	if len(addresses) == 0 {
+		return existing.ID, nil
+	}
+
+	monitored, err := api.ListWebhookAddresses(ctx, existing.ID)
+	if err != nil {
+		return existing.ID, fmt.Errorf("list addresses of webhook %s: %w", existing.ID, err)
+	}
+	isMonitored := make(map[string]bool, len(monitored))
+	for _, address := range monitored {
+		isMonitored[strings.ToLower(address)] = true
+	}
+
+	var missing []string
+	for _, address := range addresses {
+		if !isMonitored[strings.ToLower(address)] {
+			missing = append(missing, address)
+		}
+	}
+	if len(missing) == 0 {
+		return existing.ID, nil
+	}
+
+	if err := api.AddAddressesToWebhook(ctx, existing.ID, missing); err != nil {
+		return existing.ID, fmt.Errorf("add addresses to webhook %s: %w", existing.ID, err)
+	}
+	report.AddressesAdded += len(missing)
+
+	return existing.ID, nil
+}
+
+// pruneAlchemyWebhook deletes a webhook record no network expects, along with its webhook at Alchemy
+// unless deleteRemote is false
+func pruneAlchemyWebhook(ctx context.Context, api alchemyWebhookAPI, record *ent.PaymentWebhook, remote map[string]AlchemyWebhook, deleteRemote bool) error {
+	if _, ok := remote[record.WebhookID]; ok && deleteRemote {
+		if err := api.DeleteWebhook(ctx, record.WebhookID); err != nil {
+			return fmt.Errorf("delete webhook %s: %w", record.WebhookID, err)
+		}
+		delete(remote, record.WebhookID)
+	}
+
+	if err := storage.Client.PaymentWebhook.DeleteOne(record).Exec(ctx); err != nil {
+		return fmt.Errorf("delete webhook record %s: %w", record.WebhookID, err)
+	}
+	return nil
+}
+
+// openOrderAddresses returns the receive addresses of the orders on a network still awaiting their deposit
+func openOrderAddresses(ctx context.Context, network *ent.Network) ([]string, error) {
+	addresses, err := storage.Client.ReceiveAddress.
+		Query().
+		Where(
+			receiveaddress.ValidUntilGT(time.Now().Add(-config.OrderConfig().ReceiveAddressGracePeriod)),
+			receiveaddress.HasPaymentOrderWith(
+				paymentorder.StatusEQ(paymentorder.StatusInitiated),
+				paymentorder.HasTokenWith(tokenent.HasNetworkWith(networkent.IDEQ(network.ID))),
+			),
+		).
+		Select(receiveaddress.FieldAddress).
+		Strings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch receive addresses: %w", err)
+	}
+	return addresses, nil
+}
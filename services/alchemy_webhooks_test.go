@@ -0,0 +1,231 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/paymentwebhook"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAlchemyWebhookAPI keeps webhooks in memory in place of Alchemy Notify
+type fakeAlchemyWebhookAPI struct {
+	webhooks  map[string]*AlchemyWebhook
+	addresses map[string][]string
+	deleted   []string
+	created   int
+}
+
+func (f *fakeAlchemyWebhookAPI) ListWebhooks(ctx context.Context) ([]AlchemyWebhook, error) {
+	webhooks := make([]AlchemyWebhook, 0, len(f.webhooks))
+	for _, webhook := range f.webhooks {
+		webhooks = append(webhooks, *webhook)
+	}
+	return webhooks, nil
+}
+
+func (f *fakeAlchemyWebhookAPI) ListWebhookAddresses(ctx context.Context, webhookID string) ([]string, error) {
+	return f.addresses[webhookID], nil
+}
+
+func (f *fakeAlchemyWebhookAPI) CreateAddressActivityWebhook(ctx context.Context, chainID int64, addresses []string, webhookURL string) (string, string, error) {
+	network, err := (&AlchemyService{}).getAlchemyNetworkID(chainID)
+	if err != nil {
+		return "", "", err
+	}
+
+	f.created++
+	webhookID := fmt.Sprintf("wh_created_%d", f.created)
+	f.webhooks[webhookID] = &AlchemyWebhook{
+		ID:          webhookID,
+		Network:     network,
+		WebhookType: "ADDRESS_ACTIVITY",
+		WebhookURL:  webhookURL,
+		IsActive:    true,
+	}
+	f.addresses[webhookID] = addresses
+	return webhookID, "signing_key_" + webhookID, nil
+}
+
+func (f *fakeAlchemyWebhookAPI) AddAddressesToWebhook(ctx context.Context, webhookID string, addresses []string) error {
+	f.addresses[webhookID] = append(f.addresses[webhookID], addresses...)
+	return nil
+}
+
+func (f *fakeAlchemyWebhookAPI) ActivateWebhook(ctx context.Context, webhookID string) error {
+	f.webhooks[webhookID].IsActive = true
+	return nil
+}
+
+func (f *fakeAlchemyWebhookAPI) DeleteWebhook(ctx context.Context, webhookID string) error {
+	delete(f.webhooks, webhookID)
+	f.deleted = append(f.deleted, webhookID)
+	return nil
+}
+
+func TestReconcileAlchemyWebhooks(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:alchemywebhooks?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	ctx := context.Background()
+	callbackURL := "https://api.example.com" + AlchemyWebhookPath
+
+	createNetwork := func(identifier string, chainID int64, webhooksEnabled bool) *ent.Network {
+		network, err := test.CreateTestNetwork(map[string]interface{}{
+			"identifier": identifier,
+			"chainID":    chainID,
+			"networkRPC": "https://rpc.example",
+			"is_testnet": false,
+		})
+		require.NoError(t, err)
+		return network.Update().SetWebhooksEnabled(webhooksEnabled).SaveX(ctx)
+	}
+	base := createNetwork("base", 8453, true)
+	polygon := createNetwork("polygon", 137, false)
+	createNetwork("localnet", 1337, true)
+
+	token, err := test.CreateERC20Token(nil, map[string]interface{}{
+		"symbol":          "USDC",
+		"identifier":      base.Identifier,
+		"chainID":         base.ChainID,
+		"networkRPC":      base.RPCEndpoint,
+		"deployContract":  false,
+		"contractAddress": "0x833589fCD6eDb6E08f4c7C32D4f71b54bdA02913",
+	})
+	require.NoError(t, err)
+
+	createOrder := func(address string, status paymentorder.Status) {
+		receiveAddress := client.ReceiveAddress.
+			Create().
+			SetAddress(address).
+			SetStatus(receiveaddress.StatusUsed).
+			SetValidUntil(time.Now().Add(time.Hour)).
+			SaveX(ctx)
+		_, err := test.CreateTestPaymentOrder(nil, token, map[string]interface{}{
+			"receive_address": receiveAddress,
+			"amount":          10.0,
+			"amount_in_usd":   10.0,
+			"rate":            1500.0,
+			"status":          string(status),
+		})
+		require.NoError(t, err)
+	}
+	createOrder("0x1111111111111111111111111111111111111111", paymentorder.StatusInitiated)
+	createOrder("0x2222222222222222222222222222222222222222", paymentorder.StatusInitiated)
+	createOrder("0x3333333333333333333333333333333333333333", paymentorder.StatusSettled)
+
+	createRecord := func(webhookID string, network *ent.Network) *ent.PaymentWebhook {
+		return client.PaymentWebhook.
+			Create().
+			SetWebhookID(webhookID).
+			SetWebhookSecret("secret").
+			SetCallbackURL(callbackURL).
+			SetProvider(paymentwebhook.ProviderAlchemy).
+			SetNetwork(network).
+			SaveX(ctx)
+	}
+	baseRecord := createRecord("wh_base", base)
+	createRecord("wh_polygon", polygon)
+
+	api := &fakeAlchemyWebhookAPI{
+		webhooks: map[string]*AlchemyWebhook{
+			"wh_base":    {ID: "wh_base", Network: "BASE_MAINNET", WebhookType: "ADDRESS_ACTIVITY", WebhookURL: callbackURL},
+			"wh_polygon": {ID: "wh_polygon", Network: "MATIC_MAINNET", WebhookType: "ADDRESS_ACTIVITY", WebhookURL: callbackURL, IsActive: true},
+			"wh_orphan":  {ID: "wh_orphan", Network: "BASE_MAINNET", WebhookType: "ADDRESS_ACTIVITY", WebhookURL: callbackURL, IsActive: true},
+			"wh_other":   {ID: "wh_other", Network: "BASE_MAINNET", WebhookType: "ADDRESS_ACTIVITY", WebhookURL: "https://other.example.com/hook", IsActive: true},
+		},
+		addresses: map[string][]string{
+			"wh_base": {"0x1111111111111111111111111111111111111111"},
+		},
+	}
+
+	t.Run("repairs webhooks and prunes orphaned ones", func(t *testing.T) {
+		report, err := reconcileAlchemyWebhooks(ctx, api, callbackURL)
+		require.NoError(t, err)
+		assert.Empty(t, report.Errors)
+		assert.Zero(t, report.Created)
+		assert.Equal(t, 1, report.Activated)
+		assert.Equal(t, 1, report.AddressesAdded)
+		assert.Equal(t, 2, report.Pruned)
+
+		assert.True(t, api.webhooks["wh_base"].IsActive)
+		assert.ElementsMatch(t, []string{
+			"0x1111111111111111111111111111111111111111",
+			"0x2222222222222222222222222222222222222222",
+		}, api.addresses["wh_base"])
+		assert.ElementsMatch(t, []string{"wh_polygon", "wh_orphan"}, api.deleted)
+		assert.Contains(t, api.webhooks, "wh_other")
+
+		assert.Equal(t, 1, client.PaymentWebhook.Query().CountX(ctx))
+	})
+
+	t.Run("recreates a webhook deleted at Alchemy", func(t *testing.T) {
+		delete(api.webhooks, "wh_base")
+
+		report, err := reconcileAlchemyWebhooks(ctx, api, callbackURL)
+		require.NoError(t, err)
+		assert.Equal(t, 1, report.Created)
+		assert.Equal(t, 2, report.AddressesAdded)
+
+		record := client.PaymentWebhook.GetX(ctx, baseRecord.ID)
+		assert.Equal(t, "wh_created_1", record.WebhookID)
+		assert.Equal(t, "signing_key_wh_created_1", record.WebhookSecret)
+		assert.Len(t, api.addresses["wh_created_1"], 2)
+
+		report, err = reconcileAlchemyWebhooks(ctx, api, callbackURL)
+		require.NoError(t, err)
+		assert.Zero(t, report.Created+report.Activated+report.Pruned+report.AddressesAdded)
+	})
+
+	t.Run("creates a webhook for a network without one", func(t *testing.T) {
+		client.PaymentWebhook.DeleteOneID(baseRecord.ID).ExecX(ctx)
+
+		report, err := reconcileAlchemyWebhooks(ctx, api, callbackURL)
+		require.NoError(t, err)
+		assert.Equal(t, 1, report.Created)
+		assert.Equal(t, 1, report.Pruned)
+		assert.Contains(t, api.deleted, "wh_created_1")
+
+		record := client.PaymentWebhook.Query().WithNetwork().OnlyX(ctx)
+		assert.Equal(t, "wh_created_2", record.WebhookID)
+		assert.Equal(t, base.ID, record.Edges.Network.ID)
+	})
+
+	t.Run("follows networks onboarded with an Alchemy network identifier", func(t *testing.T) {
+		linea := createNetwork("linea", 59144, true).
+			Update().
+			SetAlchemyNetworkID("LINEA_MAINNET").
+			SaveX(ctx)
+
+		chainID, err := AlchemyNetworkChainID("linea_mainnet")
+		require.NoError(t, err)
+		assert.Equal(t, int64(59144), chainID)
+
+		report, err := reconcileAlchemyWebhooks(ctx, api, callbackURL)
+		require.NoError(t, err)
+		assert.Empty(t, report.Errors)
+		assert.Equal(t, 1, report.Created)
+		assert.Equal(t, "LINEA_MAINNET", api.webhooks["wh_created_3"].Network)
+
+		report, err = reconcileAlchemyWebhooks(ctx, api, callbackURL)
+		require.NoError(t, err)
+		assert.Zero(t, report.Created+report.Activated+report.Pruned+report.AddressesAdded)
+
+		// Disabling the network drops its webhook
+		linea.Update().SetIsEnabled(false).ExecX(ctx)
+		report, err = reconcileAlchemyWebhooks(ctx, api, callbackURL)
+		require.NoError(t, err)
+		assert.Equal(t, 1, report.Pruned)
+		assert.NotContains(t, api.webhooks, "wh_created_3")
+	})
+}
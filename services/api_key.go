@@ -4,10 +4,13 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"time"
 
 	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/apikey"
 	"github.com/NEDA-LABS/stablenode/storage"
 	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/NEDA-LABS/stablenode/utils"
 	"github.com/NEDA-LABS/stablenode/utils/crypto"
 	"github.com/NEDA-LABS/stablenode/utils/token"
 )
@@ -28,15 +31,11 @@ func (s *APIKeyService) GenerateAPIKey(
 	provider *ent.ProviderProfile,
 ) (*ent.APIKey, string, error) {
 	// Generate a new secret key
-	secretKey, err := token.GeneratePrivateKey()
+	secretKey, encodedSecret, err := generateSecret()
 	if err != nil {
-		return nil, "", fmt.Errorf("failed to generate API key: %w", err)
+		return nil, "", err
 	}
 
-	// Encrypt the secret key
-	encryptedSecret, _ := crypto.EncryptPlain([]byte(secretKey))
-	encodedSecret := base64.StdEncoding.EncodeToString(encryptedSecret)
-
 	var apiKey *ent.APIKey
 
 	if sender != nil {
@@ -86,20 +85,20 @@ func (s *APIKeyService) GenerateAPIKey(
 	return apiKey, secretKey, nil
 }
 
-// GetAPIKey gets the API key for a user profile.
+// GetAPIKey gets the primary API key of a user profile.
 func (s *APIKeyService) GetAPIKey(
 	ctx context.Context,
 	sender *ent.SenderProfile,
 	provider *ent.ProviderProfile,
 ) (*types.APIKeyResponse, error) {
-	var apiKey *ent.APIKey
+	apiKeys, err := s.ListAPIKeys(ctx, sender, provider)
+	if err != nil {
+		return nil, err
+	}
 
-	if sender != nil {
-		apiKey, _ = sender.QueryAPIKey().Only(ctx)
-	} else if provider != nil {
-		apiKey, _ = provider.QueryAPIKey().Only(ctx)
-	} else {
-		return nil, fmt.Errorf("profile not provided")
+	apiKey := utils.PrimaryAPIKey(apiKeys)
+	if apiKey == nil {
+		return nil, fmt.Errorf("profile has no API key")
 	}
 
 	// Decrypt the secret key
@@ -111,3 +110,151 @@ func (s *APIKeyService) GetAPIKey(
 		Secret: string(decryptedSecret),
 	}, nil
 }
+
+// ListAPIKeys lists the API keys of a user profile, newest first.
+func (s *APIKeyService) ListAPIKeys(
+	ctx context.Context,
+	sender *ent.SenderProfile,
+	provider *ent.ProviderProfile,
+) ([]*ent.APIKey, error) {
+	var query *ent.APIKeyQuery
+	if sender != nil {
+		query = sender.QueryAPIKey()
+	} else if provider != nil {
+		query = provider.QueryAPIKey()
+	} else {
+		return nil, fmt.Errorf("profile not provided")
+	}
+
+	apiKeys, err := query.
+		Order(ent.Desc(apikey.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch API keys: %w", err)
+	}
+
+	return apiKeys, nil
+}
+
+// CreateScopedAPIKey creates an API key of a user profile limited to the given scopes.
+// The secret is only returned once.
+func (s *APIKeyService) CreateScopedAPIKey(
+	ctx context.Context,
+	sender *ent.SenderProfile,
+	provider *ent.ProviderProfile,
+	payload types.APIKeyPayload,
+) (*ent.APIKey, string, error) {
+	secretKey, encodedSecret, err := generateSecret()
+	if err != nil {
+		return nil, "", err
+	}
+
+	create := storage.Client.APIKey.
+		Create().
+		SetSecret(encodedSecret).
+		SetName(payload.Name).
+		SetScopes(payload.Scopes).
+		SetNillableRateLimit(payload.RateLimit).
+		SetNillableExpiresAt(payload.ExpiresAt)
+	if sender != nil {
+		create.SetSenderProfile(sender)
+	} else if provider != nil {
+		create.SetProviderProfile(provider)
+	} else {
+		return nil, "", fmt.Errorf("profile not provided")
+	}
+
+	apiKey, err := create.Save(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create API key: %w", err)
+	}
+
+	return apiKey, secretKey, nil
+}
+
+// RotateAPIKey replaces an API key with a new one of the same name, scopes and rate limit. The old
+// key keeps working for the given overlap so clients can switch over. The secret is only returned once.
+func (s *APIKeyService) RotateAPIKey(
+	ctx context.Context,
+	apiKey *ent.APIKey,
+	overlap time.Duration,
+) (*ent.APIKey, string, error) {
+	secretKey, encodedSecret, err := generateSecret()
+	if err != nil {
+		return nil, "", err
+	}
+
+	tx, err := storage.Client.Tx(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to rotate API key: %w", err)
+	}
+
+	create := tx.APIKey.
+		Create().
+		SetSecret(encodedSecret).
+		SetName(apiKey.Name).
+		SetNillableRateLimit(apiKey.RateLimit)
+	if len(apiKey.Scopes) > 0 {
+		create.SetScopes(apiKey.Scopes)
+	}
+	if apiKey.Edges.SenderProfile != nil {
+		create.SetSenderProfile(apiKey.Edges.SenderProfile)
+	} else if apiKey.Edges.ProviderProfile != nil {
+		create.SetProviderProfile(apiKey.Edges.ProviderProfile)
+	} else {
+		_ = tx.Rollback()
+		return nil, "", fmt.Errorf("profile not provided")
+	}
+
+	newKey, err := create.Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, "", fmt.Errorf("failed to create API key: %w", err)
+	}
+
+	// Keys already expiring before the overlap ends keep their expiry
+	overlapEnd := time.Now().Add(overlap)
+	if apiKey.ExpiresAt == nil || apiKey.ExpiresAt.After(overlapEnd) {
+		err = tx.APIKey.
+			UpdateOneID(apiKey.ID).
+			SetExpiresAt(overlapEnd).
+			Exec(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, "", fmt.Errorf("failed to expire API key: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, "", fmt.Errorf("failed to rotate API key: %w", err)
+	}
+
+	return newKey.Unwrap(), secretKey, nil
+}
+
+// RevokeAPIKey stops an API key from authenticating at once.
+func (s *APIKeyService) RevokeAPIKey(ctx context.Context, apiKey *ent.APIKey) (*ent.APIKey, error) {
+	apiKey, err := apiKey.Update().
+		SetExpiresAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to revoke API key: %w", err)
+	}
+
+	return apiKey, nil
+}
+
+// generateSecret generates an API key secret, returned as is and encrypted for storage.
+func generateSecret() (string, string, error) {
+	secretKey, err := token.GeneratePrivateKey()
+	if err != nil {
+		return "", "", fmt.Errorf("failed to generate API key: %w", err)
+	}
+
+	encryptedSecret, err := crypto.EncryptPlain([]byte(secretKey))
+	if err != nil {
+		return "", "", fmt.Errorf("failed to encrypt API key: %w", err)
+	}
+
+	return secretKey, base64.StdEncoding.EncodeToString(encryptedSecret), nil
+}
@@ -0,0 +1,98 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/NEDA-LABS/stablenode/ent/apikey"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/NEDA-LABS/stablenode/utils"
+	"github.com/NEDA-LABS/stablenode/utils/test"
+)
+
+func TestAPIKeyRotation(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:api_keys?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	ctx := context.Background()
+	user, err := test.CreateTestUser(map[string]interface{}{
+		"email": "sender@test.com",
+	})
+	assert.NoError(t, err)
+
+	sender, err := test.CreateTestSenderProfile(map[string]interface{}{
+		"user_id": user.ID,
+		"token":   "",
+	})
+	assert.NoError(t, err)
+
+	service := NewAPIKeyService()
+	signupKey, _, err := service.GenerateAPIKey(ctx, nil, sender, nil)
+	assert.NoError(t, err)
+
+	rateLimit := 60
+	scopedKey, secret, err := service.CreateScopedAPIKey(ctx, sender, nil, types.APIKeyPayload{
+		Name:      "reporting",
+		Scopes:    []string{utils.APIKeyScopeRead},
+		RateLimit: &rateLimit,
+	})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, secret)
+
+	t.Run("should keep the signup key as the primary key", func(t *testing.T) {
+		apiKeys, err := service.ListAPIKeys(ctx, sender, nil)
+		assert.NoError(t, err)
+		assert.Len(t, apiKeys, 2)
+		assert.Equal(t, signupKey.ID, utils.PrimaryAPIKey(apiKeys).ID)
+
+		assert.True(t, utils.APIKeyHasScope(scopedKey, utils.APIKeyScopeRead))
+		assert.False(t, utils.APIKeyHasScope(scopedKey, utils.APIKeyScopeCreateOrders))
+		assert.True(t, utils.APIKeyHasScope(signupKey, utils.APIKeyScopeCreateOrders))
+	})
+
+	t.Run("should overlap rotated keys with their replacement", func(t *testing.T) {
+		old := client.APIKey.Query().
+			Where(apikey.IDEQ(scopedKey.ID)).
+			WithSenderProfile().
+			OnlyX(ctx)
+
+		newKey, newSecret, err := service.RotateAPIKey(ctx, old, time.Hour)
+		assert.NoError(t, err)
+		assert.NotEqual(t, secret, newSecret)
+		assert.Equal(t, "reporting", newKey.Name)
+		assert.Equal(t, []string{utils.APIKeyScopeRead}, newKey.Scopes)
+		assert.Equal(t, 60, *newKey.RateLimit)
+		assert.Nil(t, newKey.ExpiresAt)
+
+		old = client.APIKey.GetX(ctx, scopedKey.ID)
+		assert.False(t, utils.APIKeyExpired(old))
+		assert.WithinDuration(t, time.Now().Add(time.Hour), *old.ExpiresAt, time.Minute)
+	})
+
+	t.Run("should switch the primary key once it is rotated", func(t *testing.T) {
+		old := client.APIKey.Query().
+			Where(apikey.IDEQ(signupKey.ID)).
+			WithSenderProfile().
+			OnlyX(ctx)
+
+		newKey, _, err := service.RotateAPIKey(ctx, old, time.Hour)
+		assert.NoError(t, err)
+
+		primary, err := service.GetAPIKey(ctx, sender, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, newKey.ID, primary.ID)
+	})
+
+	t.Run("should expire revoked keys at once", func(t *testing.T) {
+		revoked, err := service.RevokeAPIKey(ctx, scopedKey)
+		assert.NoError(t, err)
+		assert.True(t, utils.APIKeyExpired(revoked))
+	})
+}
@@ -0,0 +1,876 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/services/contracts"
+	"github.com/NEDA-LABS/stablenode/services/events"
+	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/NEDA-LABS/stablenode/utils"
+	"github.com/NEDA-LABS/stablenode/utils/ratelimit"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/shopspring/decimal"
+)
+
+// Multicall3Address is the deterministic Multicall3 deployment address shared by all EVM chains
+const Multicall3Address = "0xcA11bde05977b3631167028862bE2a173976CA11"
+
+// defaultMulticallBatchSize caps the number of balanceOf calls packed into one aggregate3 call
+const defaultMulticallBatchSize = 500
+
+// transferLookbackMargin is the number of extra blocks scanned when searching for token transfers
+const transferLookbackMargin = 50
+
+const multicall3ABI = `[{"inputs":[{"components":[{"internalType":"address","name":"target","type":"address"},{"internalType":"bool","name":"allowFailure","type":"bool"},{"internalType":"bytes","name":"callData","type":"bytes"}],"internalType":"struct Multicall3.Call3[]","name":"calls","type":"tuple[]"}],"name":"aggregate3","outputs":[{"components":[{"internalType":"bool","name":"success","type":"bool"},{"internalType":"bytes","name":"returnData","type":"bytes"}],"internalType":"struct Multicall3.Result[]","name":"returnData","type":"tuple[]"}],"stateMutability":"payable","type":"function"}]`
+
+// errMulticallUnavailable is returned when no Multicall3 contract is deployed on the network
+var errMulticallUnavailable = errors.New("multicall3 is not deployed on this network")
+
+// ErrAssetTransfersUnsupported is returned when the network's RPC endpoint does not serve alchemy_getAssetTransfers
+var ErrAssetTransfersUnsupported = errors.New("asset transfers are only available from Alchemy endpoints")
+
+// multicallCall is a single call in a Multicall3 aggregate3 batch
+type multicallCall struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+// multicallResult is the result of a single call in a Multicall3 aggregate3 batch
+type multicallResult struct {
+	Success    bool
+	ReturnData []byte
+}
+
+// BalanceService reads ERC-20 and native balances of addresses on EVM networks.
+// Token balances for many addresses are batched into Multicall3 aggregate3 calls, falling back to
+// alchemy_getTokenBalances or individual balanceOf calls, and every result is cached for a short TTL
+type BalanceService struct {
+	cache         *BalanceCache
+	clients       map[string]*balanceClient
+	mutex         sync.Mutex
+	batchSize     int
+	batchInterval time.Duration
+	rpcCalls      atomic.Int64
+	rpc           *RPCManager
+}
+
+// balanceClient is an RPC connection to a single network
+type balanceClient struct {
+	rpcClient            *rpc.Client
+	ethClient            *ethclient.Client
+	isAlchemy            bool
+	multicallUnsupported atomic.Bool
+	service              *BalanceService
+}
+
+// BalanceCache caches balance results to reduce RPC calls
+type BalanceCache struct {
+	balances map[string]CachedBalance
+	mutex    sync.RWMutex
+	ttl      time.Duration
+}
+
+// CachedBalance represents a cached balance with timestamp
+type CachedBalance struct {
+	Amount    decimal.Decimal
+	Timestamp time.Time
+}
+
+// alchemyTokenBalances is the result of alchemy_getTokenBalances
+type alchemyTokenBalances struct {
+	Address       string `json:"address"`
+	TokenBalances []struct {
+		ContractAddress string  `json:"contractAddress"`
+		TokenBalance    *string `json:"tokenBalance"`
+		Error           *string `json:"error"`
+	} `json:"tokenBalances"`
+}
+
+// NewBalanceService creates a new balance service. A zero cacheTTL disables caching
+func NewBalanceService(cacheTTL time.Duration) *BalanceService {
+	return &BalanceService{
+		cache: &BalanceCache{
+			balances: make(map[string]CachedBalance),
+			ttl:      cacheTTL,
+		},
+		clients:   make(map[string]*balanceClient),
+		batchSize: defaultMulticallBatchSize,
+		rpc:       GetRPCManager(),
+	}
+}
+
+// SetBatching sets how many balanceOf calls go into one Multicall3 request and the pause
+// between consecutive requests, to stay within RPC provider rate limits
+func (s *BalanceService) SetBatching(batchSize int, batchInterval time.Duration) {
+	if batchSize > 0 {
+		s.batchSize = batchSize
+	}
+	s.batchInterval = batchInterval
+}
+
+// RPCCalls returns the number of RPC requests made for balance lookups
+func (s *BalanceService) RPCCalls() int64 {
+	return s.rpcCalls.Load()
+}
+
+// Close closes all open RPC connections
+func (s *BalanceService) Close() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for endpoint, client := range s.clients {
+		client.rpcClient.Close()
+		delete(s.clients, endpoint)
+	}
+}
+
+// ClearCache drops all cached balances, e.g. after funds have been moved
+func (s *BalanceService) ClearCache() {
+	s.cache.Clear()
+}
+
+// GetTokenBalance returns the balance of a single token held by address
+func (s *BalanceService) GetTokenBalance(ctx context.Context, network *ent.Network, address string, token *ent.Token) (decimal.Decimal, error) {
+	balances, err := s.GetTokenBalances(ctx, network, []string{address}, []*ent.Token{token})
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return balances[address][token.ID], nil
+}
+
+// GetTokenBalances returns the balance of every given token held by each address, keyed by address
+// and then by token ID. Balances are converted to token units using each token's decimals, and
+// native tokens are read as the address's native balance
+func (s *BalanceService) GetTokenBalances(ctx context.Context, network *ent.Network, addresses []string, tokens []*ent.Token) (map[string]map[int]decimal.Decimal, error) {
+	balances := make(map[string]map[int]decimal.Decimal, len(addresses))
+	var missing []balanceQuery
+
+	for _, address := range addresses {
+		balances[address] = make(map[int]decimal.Decimal, len(tokens))
+		for _, token := range tokens {
+			if balance, found := s.cache.Get(balanceCacheKey(network, token.ContractAddress, address)); found {
+				balances[address][token.ID] = balance
+				continue
+			}
+			missing = append(missing, balanceQuery{address: address, token: token})
+		}
+	}
+
+	if len(missing) == 0 {
+		return balances, nil
+	}
+
+	// Native token balances are not ERC-20 balances and are read with eth_getBalance
+	var erc20Queries []balanceQuery
+	for _, query := range missing {
+		if !utils.IsNativeToken(query.token.ContractAddress) {
+			erc20Queries = append(erc20Queries, query)
+			continue
+		}
+
+		var raw *big.Int
+		err := s.withClient(ctx, network, func(client *balanceClient) (err error) {
+			s.rpcCalls.Add(1)
+			raw, err = client.ethClient.BalanceAt(ctx, common.HexToAddress(query.address), nil)
+			return err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch native balance: %w", err)
+		}
+		balance := utils.FromSubunit(raw, query.token.Decimals)
+		balances[query.address][query.token.ID] = balance
+		s.cache.Set(balanceCacheKey(network, query.token.ContractAddress, query.address), balance)
+	}
+
+	if len(erc20Queries) == 0 {
+		return balances, nil
+	}
+
+	var fetched []decimal.Decimal
+	err := s.withClient(ctx, network, func(client *balanceClient) (err error) {
+		fetched, err = client.getTokenBalances(ctx, erc20Queries)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, query := range erc20Queries {
+		balances[query.address][query.token.ID] = fetched[i]
+		s.cache.Set(balanceCacheKey(network, query.token.ContractAddress, query.address), fetched[i])
+	}
+
+	return balances, nil
+}
+
+// GetNativeBalance returns the native currency balance held by address, in whole units
+func (s *BalanceService) GetNativeBalance(ctx context.Context, network *ent.Network, address string) (decimal.Decimal, error) {
+	cacheKey := balanceCacheKey(network, "native", address)
+	if balance, found := s.cache.Get(cacheKey); found {
+		return balance, nil
+	}
+
+	var raw *big.Int
+	err := s.withClient(ctx, network, func(client *balanceClient) (err error) {
+		s.rpcCalls.Add(1)
+		raw, err = client.ethClient.BalanceAt(ctx, common.HexToAddress(address), nil)
+		return err
+	})
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("failed to fetch native balance: %w", err)
+	}
+
+	balance := utils.FromSubunit(raw, 18)
+	s.cache.Set(cacheKey, balance)
+
+	return balance, nil
+}
+
+// GetTokenTransfers returns the token transfers to address mined since the given time, including
+// plain value transfers for native tokens. The starting block is estimated from the network block
+// time, with a margin for drift.
+func (s *BalanceService) GetTokenTransfers(ctx context.Context, network *ent.Network, token *ent.Token, address string, since time.Time) ([]*types.TokenTransferEvent, error) {
+	var transfers []*types.TokenTransferEvent
+	err := s.withClient(ctx, network, func(client *balanceClient) (err error) {
+		transfers, err = client.getTokenTransfers(ctx, network, token, address, since)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transfers, nil
+}
+
+// getTokenTransfers returns the token transfers to address mined since the given time
+func (c *balanceClient) getTokenTransfers(ctx context.Context, network *ent.Network, token *ent.Token, address string, since time.Time) ([]*types.TokenTransferEvent, error) {
+	s := c.service
+
+	s.rpcCalls.Add(1)
+	latestBlock, err := c.ethClient.BlockNumber(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch latest block: %w", err)
+	}
+
+	blockTime := network.BlockTime
+	if !blockTime.IsPositive() {
+		blockTime = decimal.NewFromInt(1)
+	}
+	lookback := decimal.NewFromFloat(time.Since(since).Seconds()).Div(blockTime).Mul(decimal.NewFromFloat(1.2)).IntPart() + transferLookbackMargin
+	fromBlock := int64(latestBlock) - lookback
+	if fromBlock < 0 {
+		fromBlock = 0
+	}
+
+	// Native transfers emit no logs and are found in the transactions themselves
+	if utils.IsNativeToken(token.ContractAddress) {
+		return c.getNativeTransfers(ctx, token, address, fromBlock, int64(latestBlock))
+	}
+
+	s.rpcCalls.Add(1)
+	logs, err := c.ethClient.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: big.NewInt(fromBlock),
+		ToBlock:   new(big.Int).SetUint64(latestBlock),
+		Addresses: []common.Address{common.HexToAddress(token.ContractAddress)},
+		Topics: [][]common.Hash{
+			{events.Topic(events.ERC20, "Transfer")},
+			nil,
+			{common.BytesToHash(common.HexToAddress(address).Bytes())},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch transfer logs: %w", err)
+	}
+
+	transfers := make([]*types.TokenTransferEvent, 0, len(logs))
+	for _, log := range logs {
+		if log.Removed {
+			continue
+		}
+		event, err := events.Default().Decode(log)
+		if err != nil {
+			continue
+		}
+		transfer, ok := event.(*events.Transfer)
+		if !ok {
+			continue
+		}
+		transfers = append(transfers, &types.TokenTransferEvent{
+			BlockNumber: int64(log.BlockNumber),
+			TxHash:      log.TxHash.Hex(),
+			From:        transfer.From.Hex(),
+			To:          transfer.To.Hex(),
+			Value:       utils.FromSubunit(transfer.Value, token.Decimals),
+		})
+	}
+
+	return transfers, nil
+}
+
+// getNativeTransfers returns the plain value transfers to address between two blocks, using
+// alchemy_getAssetTransfers when available and scanning the blocks otherwise
+func (c *balanceClient) getNativeTransfers(ctx context.Context, token *ent.Token, address string, fromBlock, toBlock int64) ([]*types.TokenTransferEvent, error) {
+	if c.isAlchemy {
+		var result struct {
+			Transfers []struct {
+				BlockNum    string `json:"blockNum"`
+				Hash        string `json:"hash"`
+				From        string `json:"from"`
+				To          string `json:"to"`
+				RawContract struct {
+					Value string `json:"value"`
+				} `json:"rawContract"`
+			} `json:"transfers"`
+		}
+
+		c.service.rpcCalls.Add(1)
+		err := c.rpcClient.CallContext(ctx, &result, "alchemy_getAssetTransfers", map[string]interface{}{
+			"fromBlock":        hexutil.EncodeUint64(uint64(fromBlock)),
+			"toBlock":          hexutil.EncodeUint64(uint64(toBlock)),
+			"toAddress":        address,
+			"category":         []string{"external"},
+			"excludeZeroValue": true,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch native transfers: %w", err)
+		}
+
+		transfers := make([]*types.TokenTransferEvent, 0, len(result.Transfers))
+		for _, transfer := range result.Transfers {
+			blockNumber, err := hexutil.DecodeUint64(transfer.BlockNum)
+			if err != nil {
+				return nil, fmt.Errorf("invalid transfer block number %q: %w", transfer.BlockNum, err)
+			}
+			value, err := hexutil.DecodeBig(trimHexZeros(transfer.RawContract.Value))
+			if err != nil {
+				return nil, fmt.Errorf("invalid transfer value %q: %w", transfer.RawContract.Value, err)
+			}
+			transfers = append(transfers, &types.TokenTransferEvent{
+				BlockNumber: int64(blockNumber),
+				TxHash:      transfer.Hash,
+				From:        common.HexToAddress(transfer.From).Hex(),
+				To:          common.HexToAddress(transfer.To).Hex(),
+				Value:       utils.FromSubunit(value, token.Decimals),
+			})
+		}
+		return transfers, nil
+	}
+
+	// Fetch full blocks in batches of the multicall batch size
+	var transfers []*types.TokenTransferEvent
+	for start := fromBlock; start <= toBlock; start += int64(c.service.batchSize) {
+		end := start + int64(c.service.batchSize) - 1
+		if end > toBlock {
+			end = toBlock
+		}
+
+		blocks := make([]struct {
+			Number       hexutil.Uint64 `json:"number"`
+			Transactions []struct {
+				Hash  string          `json:"hash"`
+				From  string          `json:"from"`
+				To    *common.Address `json:"to"`
+				Value *hexutil.Big    `json:"value"`
+			} `json:"transactions"`
+		}, end-start+1)
+		batch := make([]rpc.BatchElem, len(blocks))
+		for i := range batch {
+			batch[i] = rpc.BatchElem{
+				Method: "eth_getBlockByNumber",
+				Args:   []interface{}{hexutil.EncodeUint64(uint64(start + int64(i))), true},
+				Result: &blocks[i],
+			}
+		}
+
+		c.service.rpcCalls.Add(1)
+		if err := c.rpcClient.BatchCallContext(ctx, batch); err != nil {
+			return nil, fmt.Errorf("failed to fetch blocks: %w", err)
+		}
+
+		for i, block := range blocks {
+			if batch[i].Error != nil {
+				return nil, fmt.Errorf("failed to fetch block %d: %w", start+int64(i), batch[i].Error)
+			}
+			for _, tx := range block.Transactions {
+				if tx.To == nil || tx.Value == nil || tx.Value.ToInt().Sign() == 0 || !strings.EqualFold(tx.To.Hex(), address) {
+					continue
+				}
+				transfers = append(transfers, &types.TokenTransferEvent{
+					BlockNumber: int64(block.Number),
+					TxHash:      tx.Hash,
+					From:        common.HexToAddress(tx.From).Hex(),
+					To:          tx.To.Hex(),
+					Value:       utils.FromSubunit(tx.Value.ToInt(), token.Decimals),
+				})
+			}
+		}
+	}
+
+	return transfers, nil
+}
+
+// GetBlockRange returns the first and last blocks mined on the network within [start, end). The
+// chain head must be past end, so the range is complete
+func (s *BalanceService) GetBlockRange(ctx context.Context, network *ent.Network, start, end time.Time) (fromBlock, toBlock int64, err error) {
+	err = s.withClient(ctx, network, func(client *balanceClient) error {
+		s.rpcCalls.Add(1)
+		head, err := client.ethClient.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return fmt.Errorf("failed to fetch chain head: %w", err)
+		}
+		if int64(head.Time) < end.Unix() {
+			return fmt.Errorf("chain head %d is before %s", head.Number, end.Format(time.RFC3339))
+		}
+
+		fromBlock, err = client.firstBlockAt(ctx, start, head.Number.Int64())
+		if err != nil {
+			return err
+		}
+		toBlock, err = client.firstBlockAt(ctx, end, head.Number.Int64())
+		if err != nil {
+			return err
+		}
+		toBlock--
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return fromBlock, toBlock, nil
+}
+
+// firstBlockAt binary searches for the first block up to head mined at or after t
+func (c *balanceClient) firstBlockAt(ctx context.Context, t time.Time, head int64) (int64, error) {
+	low, high := int64(0), head
+	for low < high {
+		mid := low + (high-low)/2
+		c.service.rpcCalls.Add(1)
+		header, err := c.ethClient.HeaderByNumber(ctx, big.NewInt(mid))
+		if err != nil {
+			return 0, fmt.Errorf("failed to fetch block %d: %w", mid, err)
+		}
+		if int64(header.Time) < t.Unix() {
+			low = mid + 1
+		} else {
+			high = mid
+		}
+	}
+
+	return low, nil
+}
+
+// AssetTransfer is a transfer of a token to an address
+type AssetTransfer struct {
+	types.TokenTransferEvent
+	Token *ent.Token
+}
+
+// GetAssetTransfers returns the transfers of the given tokens to address mined between two blocks,
+// with alchemy_getAssetTransfers. Networks served by other providers return ErrAssetTransfersUnsupported
+func (s *BalanceService) GetAssetTransfers(ctx context.Context, network *ent.Network, tokens []*ent.Token, address string, fromBlock, toBlock int64) ([]*AssetTransfer, error) {
+	var transfers []*AssetTransfer
+	err := s.withClient(ctx, network, func(client *balanceClient) (err error) {
+		transfers, err = client.getAssetTransfers(ctx, tokens, address, fromBlock, toBlock)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transfers, nil
+}
+
+// getAssetTransfers pages through alchemy_getAssetTransfers for the transfers of tokens to address
+func (c *balanceClient) getAssetTransfers(ctx context.Context, tokens []*ent.Token, address string, fromBlock, toBlock int64) ([]*AssetTransfer, error) {
+	if !c.isAlchemy {
+		return nil, ErrAssetTransfersUnsupported
+	}
+
+	// Native transfers carry no contract address
+	tokensByContract := make(map[string]*ent.Token, len(tokens))
+	categories := []string{"erc20"}
+	contractAddresses := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		if utils.IsNativeToken(token.ContractAddress) {
+			tokensByContract[""] = token
+			categories = append(categories, "external")
+			continue
+		}
+		tokensByContract[strings.ToLower(token.ContractAddress)] = token
+		contractAddresses = append(contractAddresses, token.ContractAddress)
+	}
+
+	var transfers []*AssetTransfer
+	pageKey := ""
+	for {
+		params := map[string]interface{}{
+			"fromBlock":        hexutil.EncodeUint64(uint64(fromBlock)),
+			"toBlock":          hexutil.EncodeUint64(uint64(toBlock)),
+			"toAddress":        address,
+			"category":         categories,
+			"excludeZeroValue": true,
+			"maxCount":         "0x3e8",
+		}
+		if _, ok := tokensByContract[""]; !ok {
+			params["contractAddresses"] = contractAddresses
+		}
+		if pageKey != "" {
+			params["pageKey"] = pageKey
+		}
+
+		var result struct {
+			Transfers []struct {
+				BlockNum    string `json:"blockNum"`
+				Hash        string `json:"hash"`
+				From        string `json:"from"`
+				To          string `json:"to"`
+				RawContract struct {
+					Value   string  `json:"value"`
+					Address *string `json:"address"`
+				} `json:"rawContract"`
+			} `json:"transfers"`
+			PageKey string `json:"pageKey"`
+		}
+
+		c.service.rpcCalls.Add(1)
+		if err := c.rpcClient.CallContext(ctx, &result, "alchemy_getAssetTransfers", params); err != nil {
+			return nil, fmt.Errorf("failed to fetch asset transfers: %w", err)
+		}
+
+		for _, transfer := range result.Transfers {
+			contract := ""
+			if transfer.RawContract.Address != nil {
+				contract = strings.ToLower(*transfer.RawContract.Address)
+			}
+			token, ok := tokensByContract[contract]
+			if !ok {
+				continue
+			}
+
+			blockNumber, err := hexutil.DecodeUint64(transfer.BlockNum)
+			if err != nil {
+				return nil, fmt.Errorf("invalid transfer block number %q: %w", transfer.BlockNum, err)
+			}
+			value, err := hexutil.DecodeBig(trimHexZeros(transfer.RawContract.Value))
+			if err != nil {
+				return nil, fmt.Errorf("invalid transfer value %q: %w", transfer.RawContract.Value, err)
+			}
+			transfers = append(transfers, &AssetTransfer{
+				TokenTransferEvent: types.TokenTransferEvent{
+					BlockNumber: int64(blockNumber),
+					TxHash:      transfer.Hash,
+					From:        common.HexToAddress(transfer.From).Hex(),
+					To:          common.HexToAddress(transfer.To).Hex(),
+					Value:       utils.FromSubunit(value, token.Decimals),
+				},
+				Token: token,
+			})
+		}
+
+		if result.PageKey == "" {
+			return transfers, nil
+		}
+		pageKey = result.PageKey
+	}
+}
+
+// withClient runs fn with the RPC connection of the network's healthiest endpoint, failing over to
+// its fallback endpoints when the endpoint is unreachable
+func (s *BalanceService) withClient(ctx context.Context, network *ent.Network, fn func(client *balanceClient) error) error {
+	return s.rpc.Do(ctx, network, func(endpoint string) error {
+		client, err := s.client(ctx, endpoint)
+		if err != nil {
+			return EndpointError(err)
+		}
+		return fn(client)
+	})
+}
+
+// client returns the RPC connection for an endpoint, dialing it on first use
+func (s *BalanceService) client(ctx context.Context, endpoint string) (*balanceClient, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if client, ok := s.clients[endpoint]; ok {
+		return client, nil
+	}
+
+	rpcURL := utils.BuildRPCURL(endpoint)
+	rpcClient, err := ratelimit.DialRPC(ctx, rpcURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to RPC: %w", err)
+	}
+
+	client := &balanceClient{
+		rpcClient: rpcClient,
+		ethClient: ethclient.NewClient(rpcClient),
+		isAlchemy: strings.Contains(rpcURL, "alchemy.com"),
+		service:   s,
+	}
+	s.clients[endpoint] = client
+
+	return client, nil
+}
+
+// balanceCacheKey builds the cache key for a balance of an asset held by an address on a network
+func balanceCacheKey(network *ent.Network, asset, address string) string {
+	return fmt.Sprintf("%d:%s:%s", network.ChainID, strings.ToLower(asset), strings.ToLower(address))
+}
+
+// balanceQuery is a single token balance to fetch
+type balanceQuery struct {
+	address string
+	token   *ent.Token
+}
+
+// getTokenBalances fetches the balance for every query, in order. Multicall3 is tried first;
+// networks without it fall back to alchemy_getTokenBalances or one balanceOf call per query
+func (c *balanceClient) getTokenBalances(ctx context.Context, queries []balanceQuery) ([]decimal.Decimal, error) {
+	if !c.multicallUnsupported.Load() {
+		balances, err := c.getMulticallBalances(ctx, queries)
+		if err == nil {
+			return balances, nil
+		}
+		if errors.Is(err, errMulticallUnavailable) {
+			c.multicallUnsupported.Store(true)
+		}
+	}
+
+	balances := make([]decimal.Decimal, len(queries))
+	resolved := make([]bool, len(queries))
+
+	if c.isAlchemy {
+		byAddress := make(map[string][]int)
+		for i, query := range queries {
+			byAddress[query.address] = append(byAddress[query.address], i)
+		}
+
+		for address, indexes := range byAddress {
+			tokens := make([]*ent.Token, len(indexes))
+			for j, i := range indexes {
+				tokens[j] = queries[i].token
+			}
+
+			tokenBalances, err := c.getAlchemyTokenBalances(ctx, address, tokens)
+			if err != nil {
+				continue
+			}
+			for _, i := range indexes {
+				balances[i] = tokenBalances[queries[i].token.ID]
+				resolved[i] = true
+			}
+		}
+	}
+
+	for i, query := range queries {
+		if resolved[i] {
+			continue
+		}
+
+		balance, err := c.getTokenBalance(ctx, query.address, query.token)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: %w", query.address, query.token.Symbol, err)
+		}
+		balances[i] = balance
+	}
+
+	return balances, nil
+}
+
+// getMulticallBalances fetches balances with Multicall3 aggregate3, one request per batch of queries
+func (c *balanceClient) getMulticallBalances(ctx context.Context, queries []balanceQuery) ([]decimal.Decimal, error) {
+	multicallABI, err := abi.JSON(strings.NewReader(multicall3ABI))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse multicall ABI: %w", err)
+	}
+
+	erc20ABI, err := contracts.ERC20TokenMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ERC20 ABI: %w", err)
+	}
+
+	multicallAddress := common.HexToAddress(Multicall3Address)
+	balances := make([]decimal.Decimal, 0, len(queries))
+
+	batchSize := c.service.batchSize
+	for start := 0; start < len(queries); start += batchSize {
+		if start > 0 && c.service.batchInterval > 0 {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(c.service.batchInterval):
+			}
+		}
+
+		end := min(start+batchSize, len(queries))
+		batch := queries[start:end]
+
+		calls := make([]multicallCall, len(batch))
+		for i, query := range batch {
+			callData, err := erc20ABI.Pack("balanceOf", common.HexToAddress(query.address))
+			if err != nil {
+				return nil, fmt.Errorf("failed to pack balanceOf: %w", err)
+			}
+			calls[i] = multicallCall{
+				Target:       common.HexToAddress(query.token.ContractAddress),
+				AllowFailure: true,
+				CallData:     callData,
+			}
+		}
+
+		data, err := multicallABI.Pack("aggregate3", calls)
+		if err != nil {
+			return nil, fmt.Errorf("failed to pack aggregate3: %w", err)
+		}
+
+		c.service.rpcCalls.Add(1)
+		output, err := c.ethClient.CallContract(ctx, ethereum.CallMsg{To: &multicallAddress, Data: data}, nil)
+		if err != nil {
+			return nil, fmt.Errorf("aggregate3: %w", err)
+		}
+		if len(output) == 0 {
+			return nil, errMulticallUnavailable
+		}
+
+		unpacked, err := multicallABI.Unpack("aggregate3", output)
+		if err != nil || len(unpacked) == 0 {
+			return nil, fmt.Errorf("failed to unpack aggregate3 result: %v", err)
+		}
+
+		results := *abi.ConvertType(unpacked[0], new([]multicallResult)).(*[]multicallResult)
+		if len(results) != len(batch) {
+			return nil, fmt.Errorf("aggregate3 returned %d of %d results", len(results), len(batch))
+		}
+
+		for i, result := range results {
+			if !result.Success || len(result.ReturnData) < 32 {
+				return nil, fmt.Errorf("balanceOf %s failed for %s", batch[i].token.Symbol, batch[i].address)
+			}
+			raw := new(big.Int).SetBytes(result.ReturnData[:32])
+			balances = append(balances, utils.FromSubunit(raw, batch[i].token.Decimals))
+		}
+	}
+
+	return balances, nil
+}
+
+// getAlchemyTokenBalances fetches all token balances in a single alchemy_getTokenBalances call
+func (c *balanceClient) getAlchemyTokenBalances(ctx context.Context, address string, tokens []*ent.Token) (map[int]decimal.Decimal, error) {
+	contractAddresses := make([]string, len(tokens))
+	for i, token := range tokens {
+		contractAddresses[i] = token.ContractAddress
+	}
+
+	var result alchemyTokenBalances
+	c.service.rpcCalls.Add(1)
+	if err := c.rpcClient.CallContext(ctx, &result, "alchemy_getTokenBalances", address, contractAddresses); err != nil {
+		return nil, fmt.Errorf("alchemy_getTokenBalances: %w", err)
+	}
+
+	balances := make(map[int]decimal.Decimal, len(tokens))
+	for _, tokenBalance := range result.TokenBalances {
+		if tokenBalance.Error != nil {
+			return nil, fmt.Errorf("alchemy_getTokenBalances %s: %s", tokenBalance.ContractAddress, *tokenBalance.Error)
+		}
+
+		for _, token := range tokens {
+			if !strings.EqualFold(token.ContractAddress, tokenBalance.ContractAddress) {
+				continue
+			}
+
+			raw := big.NewInt(0)
+			if tokenBalance.TokenBalance != nil && *tokenBalance.TokenBalance != "0x" {
+				var err error
+				raw, err = hexutil.DecodeBig(trimHexZeros(*tokenBalance.TokenBalance))
+				if err != nil {
+					return nil, fmt.Errorf("invalid balance for %s: %w", token.Symbol, err)
+				}
+			}
+			balances[token.ID] = utils.FromSubunit(raw, token.Decimals)
+		}
+	}
+
+	if len(balances) != len(tokens) {
+		return nil, fmt.Errorf("alchemy_getTokenBalances returned %d of %d balances", len(balances), len(tokens))
+	}
+
+	return balances, nil
+}
+
+// getTokenBalance fetches a single token balance with an ERC-20 balanceOf call
+func (c *balanceClient) getTokenBalance(ctx context.Context, address string, token *ent.Token) (decimal.Decimal, error) {
+	erc20, err := contracts.NewERC20Token(common.HexToAddress(token.ContractAddress), c.ethClient)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("failed to bind token contract: %w", err)
+	}
+
+	c.service.rpcCalls.Add(1)
+	balance, err := erc20.BalanceOf(&bind.CallOpts{Context: ctx}, common.HexToAddress(address))
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("failed to fetch balance: %w", err)
+	}
+
+	return utils.FromSubunit(balance, token.Decimals), nil
+}
+
+// trimHexZeros strips leading zeros from a 32-byte hex quantity so it can be decoded with hexutil
+func trimHexZeros(hex string) string {
+	trimmed := strings.TrimLeft(strings.TrimPrefix(hex, "0x"), "0")
+	if trimmed == "" {
+		return "0x0"
+	}
+	return "0x" + trimmed
+}
+
+// Balance cache methods
+
+func (c *BalanceCache) Get(key string) (decimal.Decimal, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	cached, exists := c.balances[key]
+	if !exists {
+		return decimal.Zero, false
+	}
+
+	// Check if cache is still valid
+	if time.Since(cached.Timestamp) > c.ttl {
+		return decimal.Zero, false
+	}
+
+	return cached.Amount, true
+}
+
+func (c *BalanceCache) Set(key string, amount decimal.Decimal) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.balances[key] = CachedBalance{
+		Amount:    amount,
+		Timestamp: time.Now(),
+	}
+}
+
+func (c *BalanceCache) Clear() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.balances = make(map[string]CachedBalance)
+}
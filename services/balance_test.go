@@ -0,0 +1,327 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/services/events"
+	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/NEDA-LABS/stablenode/utils"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+// testTransfers are the Transfer logs served by the test RPC server
+var testTransfers = []types.TokenTransferEvent{
+	{BlockNumber: 90, TxHash: "0x00000000000000000000000000000000000000000000000000000000000000a1", From: "0x5555555555555555555555555555555555555555", To: "0x3333333333333333333333333333333333333333"},
+	{BlockNumber: 95, TxHash: "0x00000000000000000000000000000000000000000000000000000000000000a2", From: "0x5555555555555555555555555555555555555555", To: "0x3333333333333333333333333333333333333333"},
+	{BlockNumber: 99, TxHash: "0x00000000000000000000000000000000000000000000000000000000000000a3", From: "0x6666666666666666666666666666666666666666", To: "0x3333333333333333333333333333333333333333"},
+}
+
+type testRPCServer struct {
+	*httptest.Server
+	calls map[string]int
+}
+
+func newTestRPCServer(t *testing.T, alchemySupported, multicallSupported bool) *testRPCServer {
+	multicallABI, err := abi.JSON(strings.NewReader(multicall3ABI))
+	assert.NoError(t, err)
+
+	server := &testRPCServer{calls: make(map[string]int)}
+	server.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage   `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		server.calls[req.Method]++
+
+		var call struct {
+			To    string `json:"to"`
+			Input string `json:"input"`
+			Data  string `json:"data"`
+		}
+		if req.Method == "eth_call" {
+			assert.NoError(t, json.Unmarshal(req.Params[0], &call))
+		}
+
+		switch {
+		case req.Method == "alchemy_getTokenBalances" && alchemySupported:
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":{"address":"0xabc","tokenBalances":[
+				{"contractAddress":"0x1111111111111111111111111111111111111111","tokenBalance":"0x00000000000000000000000000000000000000000000000000000000000f4240","error":null},
+				{"contractAddress":"0x2222222222222222222222222222222222222222","tokenBalance":"0x0000000000000000000000000000000000000000000000000000000000000000","error":null}
+			]}}`, req.ID)
+		case req.Method == "eth_call" && strings.EqualFold(call.To, Multicall3Address):
+			if !multicallSupported {
+				// No contract deployed at the multicall address
+				fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":"0x"}`, req.ID)
+				return
+			}
+
+			input := call.Input
+			if input == "" {
+				input = call.Data
+			}
+			data, err := hexutil.Decode(input)
+			assert.NoError(t, err)
+			args, err := multicallABI.Methods["aggregate3"].Inputs.Unpack(data[4:])
+			assert.NoError(t, err)
+			calls := *abi.ConvertType(args[0], new([]multicallCall)).(*[]multicallCall)
+
+			// 1.5 tokens with 6 decimals for every call
+			results := make([]multicallResult, len(calls))
+			for i := range calls {
+				results[i] = multicallResult{Success: true, ReturnData: common.LeftPadBytes(big.NewInt(1500000).Bytes(), 32)}
+			}
+			output, err := multicallABI.Methods["aggregate3"].Outputs.Pack(results)
+			assert.NoError(t, err)
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":"%s"}`, req.ID, hexutil.Encode(output))
+		case req.Method == "eth_call":
+			// 2.5 tokens with 6 decimals
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":"0x00000000000000000000000000000000000000000000000000000000002625a0"}`, req.ID)
+		case req.Method == "eth_blockNumber":
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":"0x64"}`, req.ID)
+		case req.Method == "eth_getLogs":
+			// Two deposits of 1.5 tokens and a refund from the gateway contract
+			logs := make([]string, 0, len(testTransfers))
+			for i, transfer := range testTransfers {
+				logs = append(logs, fmt.Sprintf(`{"address":"0x1111111111111111111111111111111111111111","topics":["%s","%s","%s"],"data":"%s","blockNumber":"0x%x","transactionHash":"%s","transactionIndex":"0x0","blockHash":"0x%064x","logIndex":"0x%x","removed":false}`,
+					events.Topic(events.ERC20, "Transfer").Hex(),
+					common.BytesToHash(common.HexToAddress(transfer.From).Bytes()).Hex(),
+					common.BytesToHash(common.HexToAddress(transfer.To).Bytes()).Hex(),
+					hexutil.Encode(common.LeftPadBytes(big.NewInt(1500000).Bytes(), 32)),
+					transfer.BlockNumber, transfer.TxHash, transfer.BlockNumber, i))
+			}
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":[%s]}`, req.ID, strings.Join(logs, ","))
+		case req.Method == "alchemy_getAssetTransfers" && alchemySupported:
+			// 0.25 ether
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":{"transfers":[
+				{"blockNum":"0x5f","hash":"0x00000000000000000000000000000000000000000000000000000000000000b1","from":"0x5555555555555555555555555555555555555555","to":"0x3333333333333333333333333333333333333333","category":"external","rawContract":{"value":"0x3782dace9d90000","address":null,"decimal":"0x12"}}
+			]}}`, req.ID)
+		case req.Method == "eth_getBalance":
+			// 0.5 ether
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":"0x6f05b59d3b20000"}`, req.ID)
+		default:
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"error":{"code":-32601,"message":"method not found"}}`, req.ID)
+		}
+	}))
+
+	return server
+}
+
+func TestBalanceService(t *testing.T) {
+	tokens := []*ent.Token{
+		{ID: 1, Symbol: "USDC", ContractAddress: "0x1111111111111111111111111111111111111111", Decimals: 6},
+		{ID: 2, Symbol: "USDT", ContractAddress: "0x2222222222222222222222222222222222222222", Decimals: 6},
+	}
+	addresses := []string{
+		"0x3333333333333333333333333333333333333333",
+		"0x4444444444444444444444444444444444444444",
+	}
+
+	newService := func(t *testing.T, server *testRPCServer, isAlchemy bool, cacheTTL time.Duration) (*BalanceService, *ent.Network) {
+		network := &ent.Network{ChainID: 84532, Identifier: "base-sepolia", RPCEndpoint: server.URL}
+		rpcClient, err := rpc.Dial(server.URL)
+		assert.NoError(t, err)
+
+		service := NewBalanceService(cacheTTL)
+		service.clients[network.RPCEndpoint] = &balanceClient{rpcClient: rpcClient, ethClient: ethclient.NewClient(rpcClient), isAlchemy: isAlchemy, service: service}
+		return service, network
+	}
+
+	t.Run("should batch balances for all addresses with multicall", func(t *testing.T) {
+		server := newTestRPCServer(t, true, true)
+		defer server.Close()
+
+		service, network := newService(t, server, true, 0)
+		defer service.Close()
+
+		balances, err := service.GetTokenBalances(context.Background(), network, addresses, tokens)
+		assert.NoError(t, err)
+		for _, address := range addresses {
+			assert.True(t, balances[address][1].Equal(decimal.NewFromFloat(1.5)))
+			assert.True(t, balances[address][2].Equal(decimal.NewFromFloat(1.5)))
+		}
+		assert.Equal(t, 1, server.calls["eth_call"])
+		assert.Zero(t, server.calls["alchemy_getTokenBalances"])
+	})
+
+	t.Run("should split multicall requests by batch size", func(t *testing.T) {
+		server := newTestRPCServer(t, false, true)
+		defer server.Close()
+
+		service, network := newService(t, server, false, 0)
+		defer service.Close()
+		service.SetBatching(2, time.Millisecond)
+
+		balances, err := service.GetTokenBalances(context.Background(), network, addresses, tokens)
+		assert.NoError(t, err)
+		assert.True(t, balances[addresses[1]][2].Equal(decimal.NewFromFloat(1.5)))
+		assert.Equal(t, 2, server.calls["eth_call"])
+		assert.Equal(t, int64(2), service.RPCCalls())
+	})
+
+	t.Run("should not retry multicall on networks without it", func(t *testing.T) {
+		server := newTestRPCServer(t, false, false)
+		defer server.Close()
+
+		service, network := newService(t, server, false, 0)
+		defer service.Close()
+
+		_, err := service.GetTokenBalances(context.Background(), network, addresses[:1], tokens)
+		assert.NoError(t, err)
+		// One failed aggregate3 call and one balanceOf per token
+		assert.Equal(t, 3, server.calls["eth_call"])
+
+		balances, err := service.GetTokenBalances(context.Background(), network, addresses[:1], tokens)
+		assert.NoError(t, err)
+		assert.True(t, balances[addresses[0]][1].Equal(decimal.NewFromFloat(2.5)))
+		assert.Equal(t, 5, server.calls["eth_call"])
+		assert.Equal(t, int64(5), service.RPCCalls())
+	})
+
+	t.Run("should fall back to alchemy_getTokenBalances without multicall", func(t *testing.T) {
+		server := newTestRPCServer(t, true, false)
+		defer server.Close()
+
+		service, network := newService(t, server, true, 0)
+		defer service.Close()
+
+		balances, err := service.GetTokenBalances(context.Background(), network, []string{"0xabc"}, tokens)
+		assert.NoError(t, err)
+		assert.True(t, balances["0xabc"][1].Equal(decimal.NewFromInt(1)))
+		assert.True(t, balances["0xabc"][2].IsZero())
+	})
+
+	t.Run("should fall back to balanceOf when alchemy_getTokenBalances is unsupported", func(t *testing.T) {
+		server := newTestRPCServer(t, false, false)
+		defer server.Close()
+
+		service, network := newService(t, server, true, 0)
+		defer service.Close()
+
+		balance, err := service.GetTokenBalance(context.Background(), network, addresses[0], tokens[0])
+		assert.NoError(t, err)
+		assert.True(t, balance.Equal(decimal.NewFromFloat(2.5)))
+	})
+
+	t.Run("should serve cached balances without RPC calls", func(t *testing.T) {
+		server := newTestRPCServer(t, false, true)
+		defer server.Close()
+
+		service, network := newService(t, server, false, time.Minute)
+		defer service.Close()
+
+		_, err := service.GetTokenBalances(context.Background(), network, addresses, tokens)
+		assert.NoError(t, err)
+		balances, err := service.GetTokenBalances(context.Background(), network, addresses, tokens)
+		assert.NoError(t, err)
+		assert.True(t, balances[addresses[1]][2].Equal(decimal.NewFromFloat(1.5)))
+		assert.Equal(t, 1, server.calls["eth_call"])
+
+		service.ClearCache()
+		_, err = service.GetTokenBalances(context.Background(), network, addresses, tokens)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, server.calls["eth_call"])
+	})
+
+	t.Run("should fetch native balance", func(t *testing.T) {
+		server := newTestRPCServer(t, false, false)
+		defer server.Close()
+
+		service, network := newService(t, server, false, 0)
+		defer service.Close()
+
+		balance, err := service.GetNativeBalance(context.Background(), network, addresses[0])
+		assert.NoError(t, err)
+		assert.True(t, balance.Equal(decimal.NewFromFloat(0.5)))
+	})
+	t.Run("should find token transfers to an address", func(t *testing.T) {
+		server := newTestRPCServer(t, false, false)
+		defer server.Close()
+
+		service, network := newService(t, server, false, 0)
+		defer service.Close()
+		network.BlockTime = decimal.NewFromInt(2)
+
+		transfers, err := service.GetTokenTransfers(context.Background(), network, tokens[0], addresses[0], time.Now().Add(-time.Minute))
+		assert.NoError(t, err)
+		assert.Len(t, transfers, len(testTransfers))
+		assert.Equal(t, testTransfers[0].TxHash, transfers[0].TxHash)
+		assert.Equal(t, int64(90), transfers[0].BlockNumber)
+		assert.True(t, strings.EqualFold(testTransfers[0].From, transfers[0].From))
+		assert.True(t, strings.EqualFold(addresses[0], transfers[0].To))
+		assert.True(t, transfers[0].Value.Equal(decimal.NewFromFloat(1.5)))
+		assert.Equal(t, int64(2), service.RPCCalls())
+	})
+	t.Run("should read native token balances with eth_getBalance", func(t *testing.T) {
+		server := newTestRPCServer(t, false, true)
+		defer server.Close()
+
+		service, network := newService(t, server, false, 0)
+		defer service.Close()
+
+		native := &ent.Token{ID: 3, Symbol: "ETH", ContractAddress: utils.NativeTokenAddress, Decimals: 18}
+		balances, err := service.GetTokenBalances(context.Background(), network, addresses, append(tokens, native))
+		assert.NoError(t, err)
+		for _, address := range addresses {
+			assert.True(t, balances[address][1].Equal(decimal.NewFromFloat(1.5)))
+			assert.True(t, balances[address][3].Equal(decimal.NewFromFloat(0.5)))
+		}
+		assert.Equal(t, 2, server.calls["eth_getBalance"])
+		assert.Equal(t, 1, server.calls["eth_call"])
+	})
+
+	t.Run("should find native transfers to an address", func(t *testing.T) {
+		server := newTestRPCServer(t, true, false)
+		defer server.Close()
+
+		service, network := newService(t, server, true, 0)
+		defer service.Close()
+
+		native := &ent.Token{ID: 3, Symbol: "ETH", ContractAddress: utils.NativeTokenAddress, Decimals: 18}
+		transfers, err := service.GetTokenTransfers(context.Background(), network, native, addresses[0], time.Now().Add(-time.Minute))
+		assert.NoError(t, err)
+		assert.Len(t, transfers, 1)
+		assert.Equal(t, int64(95), transfers[0].BlockNumber)
+		assert.True(t, strings.EqualFold(addresses[0], transfers[0].To))
+		assert.True(t, transfers[0].Value.Equal(decimal.NewFromFloat(0.25)))
+		assert.Zero(t, server.calls["eth_getLogs"])
+	})
+
+	t.Run("should find the transfers of a block range to an address", func(t *testing.T) {
+		server := newTestRPCServer(t, true, false)
+		defer server.Close()
+
+		service, network := newService(t, server, true, 0)
+		defer service.Close()
+
+		native := &ent.Token{ID: 3, Symbol: "ETH", ContractAddress: utils.NativeTokenAddress, Decimals: 18}
+		transfers, err := service.GetAssetTransfers(context.Background(), network, append(tokens, native), addresses[0], 90, 100)
+		assert.NoError(t, err)
+		assert.Len(t, transfers, 1)
+		assert.Equal(t, native, transfers[0].Token)
+		assert.Equal(t, int64(95), transfers[0].BlockNumber)
+		assert.True(t, transfers[0].Value.Equal(decimal.NewFromFloat(0.25)))
+
+		// Only Alchemy serves asset transfers
+		service, network = newService(t, server, false, 0)
+		defer service.Close()
+		_, err = service.GetAssetTransfers(context.Background(), network, tokens, addresses[0], 90, 100)
+		assert.ErrorIs(t, err, ErrAssetTransfersUnsupported)
+	})
+}
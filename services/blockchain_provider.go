@@ -0,0 +1,276 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/utils"
+	"github.com/NEDA-LABS/stablenode/utils/ratelimit"
+	"github.com/ethereum/go-ethereum"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/shopspring/decimal"
+)
+
+// BlockchainProvider is the read and broadcast surface of an EVM node provider. Unlike the
+// smart account services it needs no bundler or paymaster, so any provider serving standard
+// JSON-RPC can back it
+type BlockchainProvider interface {
+	// Name returns the provider name as set in BLOCKCHAIN_READ_PROVIDER
+	Name() string
+	GetLatestBlock(ctx context.Context, network *ent.Network) (int64, error)
+	GetLogs(ctx context.Context, network *ent.Network, query ethereum.FilterQuery) ([]ethtypes.Log, error)
+	// SendRawTransaction broadcasts a signed transaction and returns its hash
+	SendRawTransaction(ctx context.Context, network *ent.Network, rawTx []byte) (string, error)
+	EstimateGas(ctx context.Context, network *ent.Network, msg ethereum.CallMsg) (uint64, error)
+	// GetTokenBalances returns the balance of every token held by each address, keyed by address and then token ID
+	GetTokenBalances(ctx context.Context, network *ent.Network, addresses []string, tokens []*ent.Token) (map[string]map[int]decimal.Decimal, error)
+}
+
+// infuraNetworks maps chain IDs to Infura network subdomains
+var infuraNetworks = map[int64]string{
+	1:        "mainnet",
+	11155111: "sepolia",
+	137:      "polygon-mainnet",
+	80002:    "polygon-amoy",
+	42161:    "arbitrum-mainnet",
+	421614:   "arbitrum-sepolia",
+	10:       "optimism-mainnet",
+	11155420: "optimism-sepolia",
+	8453:     "base-mainnet",
+	84532:    "base-sepolia",
+	56:       "bsc-mainnet",
+	97:       "bsc-testnet",
+	43114:    "avalanche-mainnet",
+	59144:    "linea-mainnet",
+	59141:    "linea-sepolia",
+	42220:    "celo-mainnet",
+}
+
+// NewBlockchainProvider returns the provider with the given name: alchemy, infura, quicknode, or
+// rpc for the network's own endpoints with failover
+func NewBlockchainProvider(name string, conf *config.BlockchainProviderConfiguration) (BlockchainProvider, error) {
+	switch strings.ToLower(name) {
+	case "alchemy":
+		return newJSONRPCProvider("alchemy", alchemyEndpoint), nil
+	case "infura":
+		if conf.InfuraAPIKey == "" {
+			return nil, fmt.Errorf("INFURA_API_KEY is not set")
+		}
+		return newJSONRPCProvider("infura", infuraEndpoint(conf.InfuraAPIKey)), nil
+	case "quicknode":
+		endpoints, err := parseQuickNodeEndpoints(conf.QuickNodeEndpoints)
+		if err != nil {
+			return nil, err
+		}
+		return newJSONRPCProvider("quicknode", quickNodeEndpoint(endpoints)), nil
+	case "rpc":
+		provider := newJSONRPCProvider("rpc", func(network *ent.Network) (string, error) {
+			return network.RPCEndpoint, nil
+		})
+		provider.failover = true
+		return provider, nil
+	default:
+		return nil, fmt.Errorf("unsupported blockchain provider %q", name)
+	}
+}
+
+// alchemyEndpoint returns the network's endpoint when it is an Alchemy one
+func alchemyEndpoint(network *ent.Network) (string, error) {
+	if !strings.Contains(network.RPCEndpoint, "alchemy.com") {
+		return "", fmt.Errorf("network %s has no Alchemy endpoint", network.Identifier)
+	}
+	return network.RPCEndpoint, nil
+}
+
+// infuraEndpoint builds Infura endpoints from the chain ID
+func infuraEndpoint(apiKey string) func(network *ent.Network) (string, error) {
+	return func(network *ent.Network) (string, error) {
+		subdomain, ok := infuraNetworks[network.ChainID]
+		if !ok {
+			return "", fmt.Errorf("chain %d is not supported by Infura", network.ChainID)
+		}
+		return fmt.Sprintf("https://%s.infura.io/v3/%s", subdomain, apiKey), nil
+	}
+}
+
+// quickNodeEndpoint looks up the configured QuickNode endpoint of the chain
+func quickNodeEndpoint(endpoints map[int64]string) func(network *ent.Network) (string, error) {
+	return func(network *ent.Network) (string, error) {
+		endpoint, ok := endpoints[network.ChainID]
+		if !ok {
+			return "", fmt.Errorf("no QuickNode endpoint configured for chain %d", network.ChainID)
+		}
+		return endpoint, nil
+	}
+}
+
+// parseQuickNodeEndpoints parses chainID=url pairs separated by commas
+func parseQuickNodeEndpoints(raw string) (map[int64]string, error) {
+	endpoints := make(map[int64]string)
+	for _, pair := range strings.Split(raw, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		chainID, endpoint, ok := strings.Cut(pair, "=")
+		if !ok || !strings.HasPrefix(endpoint, "http") {
+			return nil, fmt.Errorf("invalid QUICKNODE_ENDPOINTS entry %q", pair)
+		}
+		id, err := strconv.ParseInt(strings.TrimSpace(chainID), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid chain ID in QUICKNODE_ENDPOINTS entry %q", pair)
+		}
+		endpoints[id] = strings.TrimSpace(endpoint)
+	}
+
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("QUICKNODE_ENDPOINTS is not set")
+	}
+	return endpoints, nil
+}
+
+// jsonRPCProvider implements BlockchainProvider over standard JSON-RPC. Providers differ only in
+// how the endpoint of a network is found
+type jsonRPCProvider struct {
+	name     string
+	endpoint func(network *ent.Network) (string, error)
+	// failover spreads calls over the network's fallback endpoints through the RPC manager
+	failover bool
+	balances *BalanceService
+	clients  map[string]*ethclient.Client
+	mutex    sync.Mutex
+}
+
+func newJSONRPCProvider(name string, endpoint func(network *ent.Network) (string, error)) *jsonRPCProvider {
+	return &jsonRPCProvider{
+		name:     name,
+		endpoint: endpoint,
+		balances: NewBalanceService(30 * time.Second),
+		clients:  make(map[string]*ethclient.Client),
+	}
+}
+
+func (p *jsonRPCProvider) Name() string {
+	return p.name
+}
+
+// do runs fn with a client of the provider's endpoint for the network
+func (p *jsonRPCProvider) do(ctx context.Context, network *ent.Network, fn func(client *ethclient.Client) error) error {
+	if p.failover {
+		return GetRPCManager().Do(ctx, network, func(endpoint string) error {
+			client, err := p.client(ctx, endpoint)
+			if err != nil {
+				return EndpointError(err)
+			}
+			return fn(client)
+		})
+	}
+
+	endpoint, err := p.endpoint(network)
+	if err != nil {
+		return err
+	}
+	client, err := p.client(ctx, endpoint)
+	if err != nil {
+		return err
+	}
+	return fn(client)
+}
+
+// client returns the connection to an endpoint, dialing it on first use
+func (p *jsonRPCProvider) client(ctx context.Context, endpoint string) (*ethclient.Client, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if client, ok := p.clients[endpoint]; ok {
+		return client, nil
+	}
+
+	client, err := ratelimit.DialEthClient(ctx, utils.BuildRPCURL(endpoint))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", p.name, err)
+	}
+	p.clients[endpoint] = client
+
+	return client, nil
+}
+
+func (p *jsonRPCProvider) GetLatestBlock(ctx context.Context, network *ent.Network) (int64, error) {
+	var block uint64
+	err := p.do(ctx, network, func(client *ethclient.Client) (err error) {
+		block, err = client.BlockNumber(ctx)
+		return err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get latest block from %s: %w", p.name, err)
+	}
+	return int64(block), nil
+}
+
+func (p *jsonRPCProvider) GetLogs(ctx context.Context, network *ent.Network, query ethereum.FilterQuery) ([]ethtypes.Log, error) {
+	var logs []ethtypes.Log
+	err := p.do(ctx, network, func(client *ethclient.Client) (err error) {
+		logs, err = client.FilterLogs(ctx, query)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get logs from %s: %w", p.name, err)
+	}
+	return logs, nil
+}
+
+func (p *jsonRPCProvider) SendRawTransaction(ctx context.Context, network *ent.Network, rawTx []byte) (string, error) {
+	tx := new(ethtypes.Transaction)
+	if err := tx.UnmarshalBinary(rawTx); err != nil {
+		return "", fmt.Errorf("invalid raw transaction: %w", err)
+	}
+
+	// Resending the same signed transaction to a fallback endpoint is safe, as it has the same hash
+	err := p.do(ctx, network, func(client *ethclient.Client) error {
+		return client.SendTransaction(ctx, tx)
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to send transaction via %s: %w", p.name, err)
+	}
+	return tx.Hash().Hex(), nil
+}
+
+func (p *jsonRPCProvider) EstimateGas(ctx context.Context, network *ent.Network, msg ethereum.CallMsg) (uint64, error) {
+	var gas uint64
+	err := p.do(ctx, network, func(client *ethclient.Client) (err error) {
+		gas, err = client.EstimateGas(ctx, msg)
+		return err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to estimate gas via %s: %w", p.name, err)
+	}
+	return gas, nil
+}
+
+func (p *jsonRPCProvider) GetTokenBalances(ctx context.Context, network *ent.Network, addresses []string, tokens []*ent.Token) (map[string]map[int]decimal.Decimal, error) {
+	if p.failover {
+		return p.balances.GetTokenBalances(ctx, network, addresses, tokens)
+	}
+
+	endpoint, err := p.endpoint(network)
+	if err != nil {
+		return nil, err
+	}
+
+	// Pin the balance lookup to the provider's endpoint. Without an ID the RPC manager does not
+	// add the network's fallback endpoints
+	pinned := *network
+	pinned.ID = 0
+	pinned.RPCEndpoint = endpoint
+	pinned.Edges = ent.NetworkEdges{}
+
+	return p.balances.GetTokenBalances(ctx, &pinned, addresses, tokens)
+}
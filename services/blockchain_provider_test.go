@@ -0,0 +1,127 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/services/events"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+// newJSONRPCStub serves canned JSON-RPC results by method and records the methods called
+func newJSONRPCStub(results map[string]string, calls *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		*calls = append(*calls, request.Method)
+
+		result, ok := results[request.Method]
+		if !ok {
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"error":{"code":-32601,"message":"method not found"}}`, request.ID)
+			return
+		}
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":%s}`, request.ID, result)
+	}))
+}
+
+func TestBlockchainProvider(t *testing.T) {
+	ctx := context.Background()
+	network := &ent.Network{Identifier: "base-sepolia", ChainID: 84532}
+
+	t.Run("builds Infura endpoints from the chain ID", func(t *testing.T) {
+		endpoint, err := infuraEndpoint("key")(network)
+		assert.NoError(t, err)
+		assert.Equal(t, "https://base-sepolia.infura.io/v3/key", endpoint)
+
+		_, err = infuraEndpoint("key")(&ent.Network{ChainID: 999999})
+		assert.ErrorContains(t, err, "not supported by Infura")
+	})
+
+	t.Run("parses QuickNode endpoints", func(t *testing.T) {
+		endpoints, err := parseQuickNodeEndpoints(" 84532=https://a.quiknode.pro/t1/ , 137=https://b.quiknode.pro/t2/")
+		assert.NoError(t, err)
+		assert.Equal(t, map[int64]string{
+			84532: "https://a.quiknode.pro/t1/",
+			137:   "https://b.quiknode.pro/t2/",
+		}, endpoints)
+
+		_, err = parseQuickNodeEndpoints("base=https://a.quiknode.pro/t1/")
+		assert.ErrorContains(t, err, "invalid chain ID")
+		_, err = parseQuickNodeEndpoints("84532")
+		assert.ErrorContains(t, err, "invalid QUICKNODE_ENDPOINTS entry")
+		_, err = parseQuickNodeEndpoints("")
+		assert.ErrorContains(t, err, "not set")
+	})
+
+	t.Run("validates the provider configuration", func(t *testing.T) {
+		_, err := NewBlockchainProvider("infura", &config.BlockchainProviderConfiguration{})
+		assert.ErrorContains(t, err, "INFURA_API_KEY")
+
+		_, err = NewBlockchainProvider("etherscan", &config.BlockchainProviderConfiguration{})
+		assert.ErrorContains(t, err, "unsupported blockchain provider")
+
+		provider, err := NewBlockchainProvider("QuickNode", &config.BlockchainProviderConfiguration{QuickNodeEndpoints: "84532=https://a.quiknode.pro/t1/"})
+		assert.NoError(t, err)
+		assert.Equal(t, "quicknode", provider.Name())
+
+		_, err = provider.GetLatestBlock(ctx, &ent.Network{ChainID: 1})
+		assert.ErrorContains(t, err, "no QuickNode endpoint configured for chain 1")
+	})
+
+	t.Run("reads through the configured endpoint", func(t *testing.T) {
+		var calls []string
+		contract := "0x036CbD53842c5426634e7929541eC2318f3dCF7e"
+		server := newJSONRPCStub(map[string]string{
+			"eth_blockNumber": `"0x3e8"`,
+			"eth_estimateGas": `"0x5208"`,
+			"eth_getLogs": fmt.Sprintf(`[{"address":"%s","topics":["%s"],"data":"0x","blockNumber":"0x3e7","transactionHash":"0x%064x","transactionIndex":"0x0","blockHash":"0x%064x","logIndex":"0x2","removed":false}]`,
+				contract, events.Topic(events.ERC20, "Transfer").Hex(), 1, 2),
+		}, &calls)
+		defer server.Close()
+
+		provider, err := NewBlockchainProvider("quicknode", &config.BlockchainProviderConfiguration{
+			QuickNodeEndpoints: fmt.Sprintf("84532=%s", server.URL),
+		})
+		assert.NoError(t, err)
+
+		block, err := provider.GetLatestBlock(ctx, network)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1000), block)
+
+		gas, err := provider.EstimateGas(ctx, network, ethereum.CallMsg{To: &common.Address{}})
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(21000), gas)
+
+		logs, err := provider.GetLogs(ctx, network, ethereum.FilterQuery{
+			FromBlock: big.NewInt(990),
+			ToBlock:   big.NewInt(1000),
+			Addresses: []common.Address{common.HexToAddress(contract)},
+		})
+		assert.NoError(t, err)
+		assert.Len(t, logs, 1)
+		assert.Equal(t, uint64(999), logs[0].BlockNumber)
+		assert.Equal(t, uint(2), logs[0].Index)
+
+		assert.Equal(t, []string{"eth_blockNumber", "eth_estimateGas", "eth_getLogs"}, calls)
+	})
+
+	t.Run("rejects malformed raw transactions before sending", func(t *testing.T) {
+		provider, err := NewBlockchainProvider("rpc", &config.BlockchainProviderConfiguration{})
+		assert.NoError(t, err)
+
+		_, err = provider.SendRawTransaction(ctx, network, []byte{0x01, 0x02})
+		assert.ErrorContains(t, err, "invalid raw transaction")
+	})
+}
@@ -0,0 +1,175 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/blockchainroute"
+	"github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+)
+
+// BlockchainRoutesVersionKey is the Redis key bumped whenever the blockchain routes change. Instances
+// watch its keyspace events to drop their cached copy of the routes
+const BlockchainRoutesVersionKey = "blockchain_routes_version"
+
+// BlockchainRouteQuote describes a new EVM payment order to route
+type BlockchainRouteQuote struct {
+	Sender *ent.SenderProfile
+	// Token of the order, loaded with its network
+	Token       *ent.Token
+	AmountInUSD decimal.Decimal
+}
+
+// BlockchainRouter is an in-memory copy of the enabled blockchain routes. It is loaded on first use
+// and reloaded once it is invalidated or older than the configured cache TTL, so route changes apply
+// without a restart
+type BlockchainRouter struct {
+	mu       sync.RWMutex
+	routes   []*ent.BlockchainRoute
+	loadedAt time.Time
+}
+
+var defaultBlockchainRouter = &BlockchainRouter{}
+
+// DefaultBlockchainRouter returns the blockchain router shared by the instance
+func DefaultBlockchainRouter() *BlockchainRouter {
+	return defaultBlockchainRouter
+}
+
+// Route returns the enabled route applying most narrowly to a new order, or nil if none applies. A
+// route for the sender is more specific than one for the token, which is more specific than one for
+// the network; ties go to the highest priority, then to the route created last
+func (r *BlockchainRouter) Route(ctx context.Context, quote BlockchainRouteQuote) (*ent.BlockchainRoute, error) {
+	routes, err := r.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var selected *ent.BlockchainRoute
+	for _, route := range routes {
+		if !routeApplies(route, quote) {
+			continue
+		}
+		if selected == nil || routeOutranks(route, selected) {
+			selected = route
+		}
+	}
+	return selected, nil
+}
+
+// Invalidate drops the cached routes so the next lookup reloads them
+func (r *BlockchainRouter) Invalidate() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.routes = nil
+}
+
+// Watch invalidates the routes on every message received until the channel closes or the context
+// is done. It is fed the keyspace events of BlockchainRoutesVersionKey
+func (r *BlockchainRouter) Watch(ctx context.Context, messages <-chan *redis.Message) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case _, ok := <-messages:
+			if !ok {
+				return
+			}
+			r.Invalidate()
+		}
+	}
+}
+
+// load returns the cached enabled routes, reloading them if they are stale
+func (r *BlockchainRouter) load(ctx context.Context) ([]*ent.BlockchainRoute, error) {
+	ttl := config.BlockchainProviderConfig().RoutesCacheTTL
+
+	r.mu.RLock()
+	routes, loadedAt := r.routes, r.loadedAt
+	r.mu.RUnlock()
+	if routes != nil && time.Since(loadedAt) < ttl {
+		return routes, nil
+	}
+
+	routes, err := storage.Client.BlockchainRoute.
+		Query().
+		Where(blockchainroute.IsEnabledEQ(true)).
+		WithSenderProfile().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("BlockchainRouter.load: %w", err)
+	}
+	if routes == nil {
+		routes = []*ent.BlockchainRoute{}
+	}
+
+	r.mu.Lock()
+	r.routes = routes
+	r.loadedAt = time.Now()
+	r.mu.Unlock()
+
+	return routes, nil
+}
+
+// routeApplies reports whether a route applies to a new order
+func routeApplies(route *ent.BlockchainRoute, quote BlockchainRouteQuote) bool {
+	if route.Edges.SenderProfile != nil && (quote.Sender == nil || route.Edges.SenderProfile.ID != quote.Sender.ID) {
+		return false
+	}
+	if route.Token != "" && route.Token != quote.Token.Symbol {
+		return false
+	}
+	if route.Network != "" && route.Network != quote.Token.Edges.Network.Identifier {
+		return false
+	}
+	if quote.AmountInUSD.LessThan(route.MinAmount) {
+		return false
+	}
+	return route.MaxAmount == nil || quote.AmountInUSD.LessThan(*route.MaxAmount)
+}
+
+// routeOutranks reports whether a route wins over another one applying to the same order
+func routeOutranks(route, other *ent.BlockchainRoute) bool {
+	if routeSpecificity(route) != routeSpecificity(other) {
+		return routeSpecificity(route) > routeSpecificity(other)
+	}
+	if route.Priority != other.Priority {
+		return route.Priority > other.Priority
+	}
+	return route.CreatedAt.After(other.CreatedAt)
+}
+
+// routeSpecificity ranks how narrowly a blockchain route applies
+func routeSpecificity(route *ent.BlockchainRoute) int {
+	specificity := 0
+	if route.Edges.SenderProfile != nil {
+		specificity += 4
+	}
+	if route.Token != "" {
+		specificity += 2
+	}
+	if route.Network != "" {
+		specificity++
+	}
+	return specificity
+}
+
+// NotifyBlockchainRoutesChanged invalidates the blockchain routes of this instance and signals the
+// other instances to invalidate theirs
+func NotifyBlockchainRoutesChanged(ctx context.Context) {
+	defaultBlockchainRouter.Invalidate()
+
+	if err := storage.RedisClient.Incr(ctx, BlockchainRoutesVersionKey).Err(); err != nil {
+		logger.WithFields(logger.Fields{
+			"Error": fmt.Sprintf("%v", err),
+		}).Errorf("Failed to signal blockchain route change")
+	}
+}
@@ -0,0 +1,133 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/blockchainroute"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/test"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockchainRouter(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:blockchainroutes?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+	defer mr.Close()
+	db.RedisClient = redis.NewClient(&redis.Options{Addr: mr.Addr()})
+
+	previousTTL := viper.Get("BLOCKCHAIN_ROUTES_CACHE_TTL")
+	viper.Set("BLOCKCHAIN_ROUTES_CACHE_TTL", 60)
+	defer viper.Set("BLOCKCHAIN_ROUTES_CACHE_TTL", previousTTL)
+
+	ctx := context.Background()
+	user, err := test.CreateTestUser(map[string]interface{}{
+		"firstName": "Route",
+		"email":     "routes@test.com",
+	})
+	require.NoError(t, err)
+	sender, err := test.CreateTestSenderProfile(map[string]interface{}{
+		"user_id": user.ID,
+		"token":   "",
+	})
+	require.NoError(t, err)
+	other := &ent.SenderProfile{ID: user.ID}
+
+	base := &ent.Network{Identifier: "base"}
+	usdc := &ent.Token{Symbol: "USDC"}
+	usdc.Edges.Network = base
+
+	quote := func(sender *ent.SenderProfile, amount int64) BlockchainRouteQuote {
+		return BlockchainRouteQuote{Sender: sender, Token: usdc, AmountInUSD: decimal.NewFromInt(amount)}
+	}
+	route := func(t *testing.T, router *BlockchainRouter, quote BlockchainRouteQuote) blockchainroute.Service {
+		selected, err := router.Route(ctx, quote)
+		require.NoError(t, err)
+		if selected == nil {
+			return ""
+		}
+		return selected.Service
+	}
+
+	router := &BlockchainRouter{}
+
+	t.Run("routes nothing without routes", func(t *testing.T) {
+		assert.Empty(t, route(t, router, quote(sender, 100)))
+	})
+
+	client.BlockchainRoute.
+		Create().
+		SetService(blockchainroute.ServiceAlchemy).
+		SetNetwork("base").
+		SaveX(ctx)
+	client.BlockchainRoute.
+		Create().
+		SetService(blockchainroute.ServiceEngine).
+		SetNetwork("base").
+		SetMinAmount(decimal.NewFromInt(10000)).
+		SetPriority(1).
+		SaveX(ctx)
+	client.BlockchainRoute.
+		Create().
+		SetService(blockchainroute.ServiceRPC).
+		SetToken("USDC").
+		SetMaxAmount(decimal.NewFromInt(50)).
+		SaveX(ctx)
+	client.BlockchainRoute.
+		Create().
+		SetService(blockchainroute.ServiceEngine).
+		SetSenderProfile(sender).
+		SetIsEnabled(false).
+		SaveX(ctx)
+
+	t.Run("keeps the routes cached until they are invalidated", func(t *testing.T) {
+		assert.Empty(t, route(t, router, quote(sender, 100)))
+
+		router.Invalidate()
+		assert.Equal(t, blockchainroute.ServiceAlchemy, route(t, router, quote(sender, 100)))
+	})
+
+	t.Run("picks the most specific route in the amount band", func(t *testing.T) {
+		assert.Equal(t, blockchainroute.ServiceRPC, route(t, router, quote(sender, 20)))
+		assert.Equal(t, blockchainroute.ServiceEngine, route(t, router, quote(sender, 20000)))
+
+		polygon := &ent.Token{Symbol: "USDT"}
+		polygon.Edges.Network = &ent.Network{Identifier: "polygon"}
+		assert.Empty(t, route(t, router, BlockchainRouteQuote{Sender: sender, Token: polygon, AmountInUSD: decimal.NewFromInt(100)}))
+	})
+
+	t.Run("applies sender routes once enabled", func(t *testing.T) {
+		client.BlockchainRoute.
+			Update().
+			Where(blockchainroute.HasSenderProfile()).
+			SetIsEnabled(true).
+			ExecX(ctx)
+		router.Invalidate()
+
+		assert.Equal(t, blockchainroute.ServiceEngine, route(t, router, quote(sender, 20)))
+		assert.Equal(t, blockchainroute.ServiceRPC, route(t, router, quote(other, 20)))
+	})
+
+	t.Run("signals the other instances of a change", func(t *testing.T) {
+		_, err := DefaultBlockchainRouter().Route(ctx, quote(sender, 100))
+		require.NoError(t, err)
+
+		NotifyBlockchainRoutesChanged(ctx)
+		assert.Nil(t, DefaultBlockchainRouter().routes)
+
+		version, err := mr.Get(BlockchainRoutesVersionKey)
+		require.NoError(t, err)
+		assert.Equal(t, "1", version)
+	})
+}
@@ -0,0 +1,175 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	networkent "github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	tokenent "github.com/NEDA-LABS/stablenode/ent/token"
+	"github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+)
+
+// inFlightStatuses are the statuses of orders that still send transactions from their receive address
+var inFlightStatuses = []paymentorder.Status{
+	paymentorder.StatusInitiated,
+	paymentorder.StatusProcessing,
+	paymentorder.StatusAwaitingConfirmations,
+	paymentorder.StatusPending,
+}
+
+// ActiveBlockchainService is the blockchain service of operations no blockchain route or order
+// applies to
+func (sm *ServiceManager) ActiveBlockchainService() paymentorder.BlockchainService {
+	if sm.service == "" {
+		return paymentorder.BlockchainServiceEngine
+	}
+	return sm.service
+}
+
+// Using returns a copy of the ServiceManager sending through service instead of the active one
+func (sm *ServiceManager) Using(service paymentorder.BlockchainService) *ServiceManager {
+	sticky := *sm
+	sticky.service = service
+	return &sticky
+}
+
+// ForOrder returns a ServiceManager sending through the blockchain service of an order, so orders
+// finish on the service that created their receive address after USE_ALCHEMY_SERVICE changes.
+// Orders whose service is unknown use the active one
+func (sm *ServiceManager) ForOrder(order *ent.PaymentOrder) *ServiceManager {
+	if service := OrderBlockchainService(order); service != "" {
+		return sm.Using(service)
+	}
+	return sm
+}
+
+// ForAddress returns a ServiceManager sending through the blockchain service holding a receive
+// address, or the active one for addresses that aren't receive addresses
+func (sm *ServiceManager) ForAddress(ctx context.Context, address string) *ServiceManager {
+	receiveAddress, err := storage.Client.ReceiveAddress.
+		Query().
+		Where(receiveaddress.AddressEqualFold(address)).
+		First(ctx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			logger.WithFields(logger.Fields{
+				"Error":   fmt.Sprintf("%v", err),
+				"Address": address,
+			}).Warnf("Failed to look up receive address, using the active blockchain service")
+		}
+		return sm
+	}
+	return sm.Using(ReceiveAddressBlockchainService(receiveAddress))
+}
+
+// ReceiveAddressBlockchainService is the blockchain service holding an EVM receive address: Alchemy
+// smart accounts keep their CREATE2 salt, or are deployed pool addresses whose order rows carry no
+// salt, while Thirdweb Engine server wallets have neither. EOAs keep their private key as the salt
+// and are sent from by Alchemy like smart accounts
+func ReceiveAddressBlockchainService(receiveAddress *ent.ReceiveAddress) paymentorder.BlockchainService {
+	if len(receiveAddress.Salt) > 0 || receiveAddress.IsDeployed {
+		return paymentorder.BlockchainServiceAlchemy
+	}
+	return paymentorder.BlockchainServiceEngine
+}
+
+// OrderBlockchainService is the blockchain service recorded on an order, or the one holding its
+// receive address when the receive address edge is loaded. It is empty when neither is known
+func OrderBlockchainService(order *ent.PaymentOrder) paymentorder.BlockchainService {
+	if order.BlockchainService != nil {
+		return *order.BlockchainService
+	}
+	if order.Edges.ReceiveAddress != nil {
+		return ReceiveAddressBlockchainService(order.Edges.ReceiveAddress)
+	}
+	return ""
+}
+
+// BlockchainServiceReport summarizes the in-flight EVM orders of each blockchain service
+type BlockchainServiceReport struct {
+	ActiveService paymentorder.BlockchainService
+	Scanned       int
+	// Pending counts in-flight orders by the blockchain service they finish on
+	Pending map[paymentorder.BlockchainService]int
+	// Pinned counts orders whose blockchain service was recorded by this run
+	Pinned int
+	// Unresolved counts orders without a recorded service or receive address; they use the active service
+	Unresolved int
+	Failed     int
+	Errors     []error
+}
+
+// PinOrderBlockchainServices records the blockchain service of in-flight EVM orders that don't have
+// one yet, from their receive address, and reports how many orders are pending on each service. Run
+// it before switching USE_ALCHEMY_SERVICE so orders keep sending through the service that holds their
+// receive address. With dryRun nothing is updated
+func (sm *ServiceManager) PinOrderBlockchainServices(ctx context.Context, batchSize int, dryRun bool) (*BlockchainServiceReport, error) {
+	report := &BlockchainServiceReport{
+		ActiveService: sm.ActiveBlockchainService(),
+		Pending:       make(map[paymentorder.BlockchainService]int),
+	}
+
+	var last *ent.PaymentOrder
+	for {
+		query := storage.Client.PaymentOrder.
+			Query().
+			Where(
+				paymentorder.StatusIn(inFlightStatuses...),
+				paymentorder.HasTokenWith(tokenent.HasNetworkWith(
+					networkent.NetworkTypeEQ(networkent.NetworkTypeEvm),
+					networkent.Not(networkent.IdentifierHasPrefix("tron")),
+				)),
+			).
+			WithReceiveAddress().
+			Order(paymentorder.ByID()).
+			Limit(batchSize)
+		if last != nil {
+			query = query.Where(paymentorder.IDGT(last.ID))
+		}
+
+		orders, err := query.All(ctx)
+		if err != nil {
+			return report, fmt.Errorf("failed to fetch orders: %w", err)
+		}
+		if len(orders) == 0 {
+			return report, nil
+		}
+		last = orders[len(orders)-1]
+
+		for _, order := range orders {
+			report.Scanned++
+
+			service := OrderBlockchainService(order)
+			if service == "" {
+				report.Unresolved++
+				report.Pending[report.ActiveService]++
+				continue
+			}
+			report.Pending[service]++
+			if order.BlockchainService != nil {
+				continue
+			}
+
+			if !dryRun {
+				_, err := storage.Client.PaymentOrder.
+					Update().
+					Where(
+						paymentorder.IDEQ(order.ID),
+						paymentorder.BlockchainServiceIsNil(),
+					).
+					SetBlockchainService(service).
+					Save(ctx)
+				if err != nil {
+					report.Failed++
+					report.Errors = append(report.Errors, fmt.Errorf("order %s: %w", order.ID, err))
+					continue
+				}
+			}
+			report.Pinned++
+		}
+	}
+}
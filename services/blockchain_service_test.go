@@ -0,0 +1,140 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/test"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockchainServiceStickiness(t *testing.T) {
+	engineAddress := &ent.ReceiveAddress{Address: "0x1111111111111111111111111111111111111111"}
+	alchemyAddress := &ent.ReceiveAddress{Address: "0x2222222222222222222222222222222222222222", Salt: []byte{0x01}}
+
+	t.Run("infers the service from the receive address", func(t *testing.T) {
+		assert.Equal(t, paymentorder.BlockchainServiceEngine, ReceiveAddressBlockchainService(engineAddress))
+		assert.Equal(t, paymentorder.BlockchainServiceAlchemy, ReceiveAddressBlockchainService(alchemyAddress))
+		assert.Equal(t, paymentorder.BlockchainServiceAlchemy, ReceiveAddressBlockchainService(&ent.ReceiveAddress{IsDeployed: true}))
+	})
+
+	t.Run("prefers the service recorded on the order", func(t *testing.T) {
+		engine := paymentorder.BlockchainServiceEngine
+		order := &ent.PaymentOrder{BlockchainService: &engine}
+		order.Edges.ReceiveAddress = alchemyAddress
+		assert.Equal(t, paymentorder.BlockchainServiceEngine, OrderBlockchainService(order))
+
+		order = &ent.PaymentOrder{}
+		order.Edges.ReceiveAddress = alchemyAddress
+		assert.Equal(t, paymentorder.BlockchainServiceAlchemy, OrderBlockchainService(order))
+
+		assert.Empty(t, OrderBlockchainService(&ent.PaymentOrder{}))
+	})
+
+	t.Run("routes orders through their own service", func(t *testing.T) {
+		manager := &ServiceManager{service: paymentorder.BlockchainServiceAlchemy}
+		engine := paymentorder.BlockchainServiceEngine
+
+		sticky := manager.ForOrder(&ent.PaymentOrder{BlockchainService: &engine})
+		assert.Equal(t, "Thirdweb Engine", sticky.GetActiveService())
+		assert.Equal(t, "Alchemy", manager.GetActiveService())
+
+		assert.Same(t, manager, manager.ForOrder(&ent.PaymentOrder{}))
+	})
+}
+
+func TestPinOrderBlockchainServices(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:blockchainservice?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	ctx := context.Background()
+	token, err := test.CreateERC20Token(nil, map[string]interface{}{
+		"symbol":          "USDC",
+		"identifier":      "base",
+		"chainID":         int64(8453),
+		"deployContract":  false,
+		"contractAddress": "0x833589fCD6eDb6E08f4c7C32D4f71b54bdA02913",
+	})
+	require.NoError(t, err)
+	network := token.Edges.Network
+
+	createOrder := func(address string, salt []byte, status paymentorder.Status) *ent.PaymentOrder {
+		create := client.PaymentOrder.
+			Create().
+			SetAmount(decimal.NewFromFloat(10)).
+			SetAmountInUsd(decimal.NewFromFloat(10)).
+			SetAmountPaid(decimal.Zero).
+			SetAmountReturned(decimal.Zero).
+			SetPercentSettled(decimal.Zero).
+			SetNetworkFee(network.Fee).
+			SetSenderFee(decimal.Zero).
+			SetProtocolFee(decimal.Zero).
+			SetRate(decimal.NewFromFloat(1500)).
+			SetToken(token).
+			SetFeePercent(decimal.Zero).
+			SetFeeAddress("0x1234567890123456789012345678901234567890").
+			SetReceiveAddressText(address).
+			SetStatus(status)
+		if address != "" {
+			receiveAddress := client.ReceiveAddress.
+				Create().
+				SetAddress(address).
+				SetSalt(salt).
+				SetStatus(receiveaddress.StatusUsed).
+				SaveX(ctx)
+			create = create.SetReceiveAddress(receiveAddress)
+		}
+		return create.SaveX(ctx)
+	}
+
+	engineOrder := createOrder("0x1111111111111111111111111111111111111111", nil, paymentorder.StatusInitiated)
+	alchemyOrder := createOrder("0x2222222222222222222222222222222222222222", []byte{0x01}, paymentorder.StatusPending)
+	createOrder("0x3333333333333333333333333333333333333333", nil, paymentorder.StatusSettled)
+	createOrder("", nil, paymentorder.StatusInitiated)
+
+	manager := &ServiceManager{service: paymentorder.BlockchainServiceAlchemy}
+
+	t.Run("reports without updating in a dry run", func(t *testing.T) {
+		report, err := manager.PinOrderBlockchainServices(ctx, 1, true)
+		require.NoError(t, err)
+		assert.Equal(t, paymentorder.BlockchainServiceAlchemy, report.ActiveService)
+		assert.Equal(t, 3, report.Scanned)
+		assert.Equal(t, 2, report.Pinned)
+		assert.Equal(t, 1, report.Unresolved)
+		assert.Equal(t, map[paymentorder.BlockchainService]int{
+			paymentorder.BlockchainServiceEngine:  1,
+			paymentorder.BlockchainServiceAlchemy: 2,
+		}, report.Pending)
+
+		assert.Nil(t, client.PaymentOrder.GetX(ctx, engineOrder.ID).BlockchainService)
+	})
+
+	t.Run("records the service of in-flight orders", func(t *testing.T) {
+		report, err := manager.PinOrderBlockchainServices(ctx, 500, false)
+		require.NoError(t, err)
+		assert.Equal(t, 2, report.Pinned)
+		assert.Zero(t, report.Failed)
+
+		assert.Equal(t, paymentorder.BlockchainServiceEngine, *client.PaymentOrder.GetX(ctx, engineOrder.ID).BlockchainService)
+		assert.Equal(t, paymentorder.BlockchainServiceAlchemy, *client.PaymentOrder.GetX(ctx, alchemyOrder.ID).BlockchainService)
+
+		report, err = manager.PinOrderBlockchainServices(ctx, 500, false)
+		require.NoError(t, err)
+		assert.Zero(t, report.Pinned)
+		assert.Equal(t, 3, report.Scanned)
+	})
+
+	t.Run("sends from a receive address through the service holding it", func(t *testing.T) {
+		sticky := manager.ForAddress(ctx, "0x1111111111111111111111111111111111111111")
+		assert.Equal(t, "Thirdweb Engine", sticky.GetActiveService())
+		assert.Same(t, manager, manager.ForAddress(ctx, "0x9999999999999999999999999999999999999999"))
+	})
+}
@@ -0,0 +1,334 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	networkent "github.com/NEDA-LABS/stablenode/ent/network"
+	tokenent "github.com/NEDA-LABS/stablenode/ent/token"
+	"github.com/NEDA-LABS/stablenode/services/contracts"
+	"github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/NEDA-LABS/stablenode/utils/ratelimit"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	fastshot "github.com/opus-domini/fast-shot"
+	"github.com/shopspring/decimal"
+)
+
+// CanaryService runs synthetic orders through the public API to verify that
+// order creation, payment detection, bundler, paymaster and settlement all work
+type CanaryService struct {
+	conf         *config.CanaryConfiguration
+	serverURL    string
+	slackService *SlackService
+	pollInterval time.Duration
+}
+
+// CanaryResult describes a single canary run
+type CanaryResult struct {
+	OrderID     string
+	TxHash      string
+	FinalStatus string
+	Stage       string
+	Duration    time.Duration
+	Success     bool
+}
+
+// NewCanaryService creates a new instance of CanaryService
+func NewCanaryService() *CanaryService {
+	serverConf := config.ServerConfig()
+	return &CanaryService{
+		conf:         config.CanaryConfig(),
+		serverURL:    serverConf.ServerURL,
+		slackService: NewSlackService(serverConf.SlackWebhookURL),
+		pollInterval: 15 * time.Second,
+	}
+}
+
+// Run creates a canary order, pays it from the canary wallet and waits for the
+// order to reach the target status within the SLA. Failures are alerted on Slack.
+func (s *CanaryService) Run(ctx context.Context) (*CanaryResult, error) {
+	result := &CanaryResult{}
+	startTime := time.Now()
+
+	err := s.run(ctx, result)
+	result.Duration = time.Since(startTime)
+
+	fields := logger.Fields{
+		"OrderID":  result.OrderID,
+		"TxHash":   result.TxHash,
+		"Network":  s.conf.Network,
+		"Stage":    result.Stage,
+		"Status":   result.FinalStatus,
+		"Duration": result.Duration,
+	}
+
+	if err != nil {
+		fields["Error"] = err.Error()
+		logger.WithFields(fields).Errorf("🐤 Canary order failed")
+
+		alertErr := s.slackService.SendAlert("Canary order failed", map[string]string{
+			"Network":  s.conf.Network,
+			"Token":    s.conf.Token,
+			"Order ID": result.OrderID,
+			"Tx Hash":  result.TxHash,
+			"Stage":    result.Stage,
+			"Status":   result.FinalStatus,
+			"Duration": result.Duration.Round(time.Second).String(),
+			"Error":    err.Error(),
+		})
+		if alertErr != nil {
+			logger.Errorf("Failed to send canary alert: %v", alertErr)
+		}
+		return result, err
+	}
+
+	result.Success = true
+	logger.WithFields(fields).Infof("🐤 Canary order completed within SLA")
+	return result, nil
+}
+
+func (s *CanaryService) run(ctx context.Context, result *CanaryResult) error {
+	if s.conf.SenderAPIKey == "" || s.conf.WalletPrivateKey == "" {
+		result.Stage = "config"
+		return fmt.Errorf("CANARY_SENDER_API_KEY and CANARY_WALLET_PRIVATE_KEY must be set")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.conf.SLA)
+	defer cancel()
+
+	token, err := storage.Client.Token.
+		Query().
+		Where(
+			tokenent.SymbolEQ(s.conf.Token),
+			tokenent.HasNetworkWith(networkent.IdentifierEQ(s.conf.Network)),
+		).
+		WithNetwork().
+		Only(ctx)
+	if err != nil {
+		result.Stage = "config"
+		return fmt.Errorf("failed to fetch canary token: %w", err)
+	}
+
+	result.Stage = "rate"
+	rate, err := s.fetchRate(ctx)
+	if err != nil {
+		return err
+	}
+
+	result.Stage = "create_order"
+	order, err := s.createOrder(ctx, rate)
+	if err != nil {
+		return err
+	}
+	result.OrderID = order["id"].(string)
+
+	receiveAddress, _ := order["receiveAddress"].(string)
+	total := s.conf.Amount
+	for _, key := range []string{"senderFee", "transactionFee"} {
+		if fee, err := decimal.NewFromString(fmt.Sprintf("%v", order[key])); err == nil {
+			total = total.Add(fee)
+		}
+	}
+
+	result.Stage = "payment"
+	txHash, err := s.payOrder(ctx, token, receiveAddress, total)
+	if err != nil {
+		return err
+	}
+	result.TxHash = txHash
+
+	result.Stage = "pipeline"
+	status, err := s.waitForStatus(ctx, result.OrderID)
+	result.FinalStatus = status
+	return err
+}
+
+// fetchRate fetches the current rate for the canary amount
+func (s *CanaryService) fetchRate(ctx context.Context) (decimal.Decimal, error) {
+	res, err := fastshot.NewClient(s.serverURL).
+		Config().SetTimeout(30 * time.Second).
+		Build().GET(fmt.Sprintf("/v1/rates/%s/%s/%s", s.conf.Token, s.conf.Amount.String(), s.conf.Currency)).
+		Context().Set(ctx).
+		Send()
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("failed to fetch rate: %w", err)
+	}
+
+	data, err := utils.ParseJSONResponse(res.RawResponse)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("failed to parse rate response: %w", err)
+	}
+
+	rate, err := decimal.NewFromString(fmt.Sprintf("%v", data["data"]))
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("invalid rate response: %v", data)
+	}
+
+	return rate, nil
+}
+
+// senderHeaders returns the headers authenticating a canary request to the sender API. Requests are
+// signed like any other sender's once a signing secret is configured, see middleware.RequestSignature
+func (s *CanaryService) senderHeaders(method string, path string, body []byte) map[string]string {
+	headers := map[string]string{"API-Key": s.conf.SenderAPIKey}
+	if s.conf.SenderSigningKey == "" {
+		return headers
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(s.conf.SenderSigningKey))
+	mac.Write([]byte(fmt.Sprintf("%s.%s.%s.", timestamp, method, path)))
+	mac.Write(body)
+
+	headers["X-Request-Timestamp"] = timestamp
+	headers["X-Request-Signature"] = hex.EncodeToString(mac.Sum(nil))
+	return headers
+}
+
+// createOrder initiates a canary payment order through the sender API
+func (s *CanaryService) createOrder(ctx context.Context, rate decimal.Decimal) (map[string]interface{}, error) {
+	payload := map[string]interface{}{
+		"amount":  s.conf.Amount,
+		"token":   s.conf.Token,
+		"rate":    rate,
+		"network": s.conf.Network,
+		"recipient": map[string]interface{}{
+			"institution":       s.conf.Institution,
+			"accountIdentifier": s.conf.AccountIdentifier,
+			"accountName":       s.conf.AccountName,
+			"memo":              "Canary order",
+		},
+		"reference": fmt.Sprintf("canary-%d", time.Now().Unix()),
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal order payload: %w", err)
+	}
+
+	path := "/v1/sender/orders"
+	res, err := fastshot.NewClient(s.serverURL).
+		Config().SetTimeout(30 * time.Second).
+		Header().AddAll(s.senderHeaders("POST", path, body)).
+		Header().AddContentType("application/json").
+		Build().POST(path).
+		Context().Set(ctx).
+		Body().AsString(string(body)).
+		Send()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create order: %w", err)
+	}
+
+	data, err := utils.ParseJSONResponse(res.RawResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse order response: %w", err)
+	}
+
+	order, ok := data["data"].(map[string]interface{})
+	if !ok || res.StatusCode() != 201 {
+		return nil, fmt.Errorf("order creation returned %d: %v", res.StatusCode(), data["message"])
+	}
+
+	if _, ok := order["id"].(string); !ok {
+		return nil, fmt.Errorf("order creation response has no id: %v", order)
+	}
+
+	return order, nil
+}
+
+// payOrder transfers the order total from the canary wallet to the receive address
+func (s *CanaryService) payOrder(ctx context.Context, token *ent.Token, receiveAddress string, amount decimal.Decimal) (string, error) {
+	if !common.IsHexAddress(receiveAddress) {
+		return "", fmt.Errorf("invalid receive address %q", receiveAddress)
+	}
+
+	privateKey, err := crypto.HexToECDSA(strings.TrimPrefix(s.conf.WalletPrivateKey, "0x"))
+	if err != nil {
+		return "", fmt.Errorf("invalid canary wallet key: %w", err)
+	}
+
+	client, err := ratelimit.DialEthClient(ctx, utils.BuildRPCURL(token.Edges.Network.RPCEndpoint))
+	if err != nil {
+		return "", fmt.Errorf("failed to connect to RPC: %w", err)
+	}
+	defer client.Close()
+
+	opts, err := bind.NewKeyedTransactorWithChainID(privateKey, big.NewInt(token.Edges.Network.ChainID))
+	if err != nil {
+		return "", fmt.Errorf("failed to create transactor: %w", err)
+	}
+	opts.Context = ctx
+
+	erc20, err := contracts.NewERC20Token(common.HexToAddress(token.ContractAddress), client)
+	if err != nil {
+		return "", fmt.Errorf("failed to bind token contract: %w", err)
+	}
+
+	value := utils.ToSubunit(amount, token.Decimals)
+	tx, err := erc20.Transfer(opts, common.HexToAddress(receiveAddress), value)
+	if err != nil {
+		return "", fmt.Errorf("failed to send payment: %w", err)
+	}
+
+	receipt, err := bind.WaitMined(ctx, client, tx)
+	if err != nil {
+		return tx.Hash().Hex(), fmt.Errorf("failed waiting for payment receipt: %w", err)
+	}
+	if receipt.Status != 1 {
+		return tx.Hash().Hex(), fmt.Errorf("payment transaction reverted")
+	}
+
+	return tx.Hash().Hex(), nil
+}
+
+// waitForStatus polls the order until it reaches the target status or the SLA expires
+func (s *CanaryService) waitForStatus(ctx context.Context, orderID string) (string, error) {
+	status := ""
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	path := "/v1/sender/orders/" + orderID
+	for {
+		res, err := fastshot.NewClient(s.serverURL).
+			Config().SetTimeout(30 * time.Second).
+			Header().AddAll(s.senderHeaders("GET", path, nil)).
+			Build().GET(path).
+			Context().Set(ctx).
+			Send()
+		if err == nil {
+			data, err := utils.ParseJSONResponse(res.RawResponse)
+			if err == nil {
+				if order, ok := data["data"].(map[string]interface{}); ok {
+					status, _ = order["status"].(string)
+				}
+			}
+		}
+
+		switch status {
+		case s.conf.TargetStatus:
+			return status, nil
+		case "refunded", "expired":
+			return status, fmt.Errorf("order ended in %s status", status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return status, fmt.Errorf("order did not reach %s within SLA of %s (last status: %q)", s.conf.TargetStatus, s.conf.SLA, status)
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanaryService(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	serverURL := "https://canary.test"
+	slackWebhookURL := "https://hooks.slack.test/canary"
+
+	newCanary := func(conf config.CanaryConfiguration) *CanaryService {
+		conf.Network = "base-sepolia"
+		conf.Token = "USDC"
+		conf.Amount = decimal.NewFromFloat(0.5)
+		conf.Currency = "NGN"
+		conf.TargetStatus = "settled"
+		if conf.SLA == 0 {
+			conf.SLA = time.Minute
+		}
+		return &CanaryService{
+			conf:         &conf,
+			serverURL:    serverURL,
+			slackService: NewSlackService(slackWebhookURL),
+			pollInterval: 10 * time.Millisecond,
+		}
+	}
+
+	t.Run("createOrder", func(t *testing.T) {
+		t.Run("should sign the request when a signing secret is configured", func(t *testing.T) {
+			httpmock.Reset()
+			httpmock.RegisterResponder("POST", serverURL+"/v1/sender/orders",
+				func(r *http.Request) (*http.Response, error) {
+					body, _ := io.ReadAll(r.Body)
+					timestamp := r.Header.Get("X-Request-Timestamp")
+
+					mac := hmac.New(sha256.New, []byte("signing-secret"))
+					mac.Write([]byte(fmt.Sprintf("%s.POST./v1/sender/orders.", timestamp)))
+					mac.Write(body)
+
+					assert.Equal(t, "canary-key", r.Header.Get("API-Key"))
+					assert.NotEmpty(t, timestamp)
+					assert.Equal(t, hex.EncodeToString(mac.Sum(nil)), r.Header.Get("X-Request-Signature"))
+					assert.Contains(t, string(body), `"reference":"canary-`)
+
+					return httpmock.NewJsonResponse(201, map[string]interface{}{
+						"status": "success",
+						"data":   map[string]interface{}{"id": "order-1", "receiveAddress": "0x1111111111111111111111111111111111111111"},
+					})
+				},
+			)
+
+			canary := newCanary(config.CanaryConfiguration{SenderAPIKey: "canary-key", SenderSigningKey: "signing-secret"})
+			order, err := canary.createOrder(context.Background(), decimal.NewFromInt(1500))
+			assert.NoError(t, err)
+			assert.Equal(t, "order-1", order["id"])
+		})
+
+		t.Run("should only send the API key when signing is not configured", func(t *testing.T) {
+			httpmock.Reset()
+			httpmock.RegisterResponder("POST", serverURL+"/v1/sender/orders",
+				func(r *http.Request) (*http.Response, error) {
+					assert.Equal(t, "canary-key", r.Header.Get("API-Key"))
+					assert.Empty(t, r.Header.Get("X-Request-Signature"))
+					return httpmock.NewJsonResponse(201, map[string]interface{}{
+						"status": "success",
+						"data":   map[string]interface{}{"id": "order-2"},
+					})
+				},
+			)
+
+			canary := newCanary(config.CanaryConfiguration{SenderAPIKey: "canary-key"})
+			_, err := canary.createOrder(context.Background(), decimal.NewFromInt(1500))
+			assert.NoError(t, err)
+		})
+
+		t.Run("should fail when the order is not created", func(t *testing.T) {
+			httpmock.Reset()
+			httpmock.RegisterResponder("POST", serverURL+"/v1/sender/orders",
+				httpmock.NewJsonResponderOrPanic(401, map[string]interface{}{
+					"status":  "error",
+					"message": "Request signature is required",
+				}),
+			)
+
+			canary := newCanary(config.CanaryConfiguration{SenderAPIKey: "canary-key"})
+			_, err := canary.createOrder(context.Background(), decimal.NewFromInt(1500))
+			assert.ErrorContains(t, err, "401")
+		})
+	})
+
+	t.Run("waitForStatus", func(t *testing.T) {
+		respondWithStatuses := func(statuses ...string) *int32 {
+			var polls int32
+			httpmock.Reset()
+			httpmock.RegisterResponder("GET", serverURL+"/v1/sender/orders/order-1",
+				func(r *http.Request) (*http.Response, error) {
+					assert.Equal(t, "canary-key", r.Header.Get("API-Key"))
+					assert.NotEmpty(t, r.Header.Get("X-Request-Signature"))
+
+					poll := int(atomic.AddInt32(&polls, 1)) - 1
+					if poll >= len(statuses) {
+						poll = len(statuses) - 1
+					}
+					return httpmock.NewJsonResponse(200, map[string]interface{}{
+						"status": "success",
+						"data":   map[string]interface{}{"id": "order-1", "status": statuses[poll]},
+					})
+				},
+			)
+			return &polls
+		}
+
+		t.Run("should poll until the order reaches the target status", func(t *testing.T) {
+			polls := respondWithStatuses("pending", "validated", "settled")
+
+			canary := newCanary(config.CanaryConfiguration{SenderAPIKey: "canary-key", SenderSigningKey: "signing-secret"})
+			status, err := canary.waitForStatus(context.Background(), "order-1")
+			assert.NoError(t, err)
+			assert.Equal(t, "settled", status)
+			assert.Equal(t, int32(3), atomic.LoadInt32(polls))
+		})
+
+		t.Run("should fail when the order ends in a terminal status", func(t *testing.T) {
+			respondWithStatuses("pending", "refunded")
+
+			canary := newCanary(config.CanaryConfiguration{SenderAPIKey: "canary-key", SenderSigningKey: "signing-secret"})
+			status, err := canary.waitForStatus(context.Background(), "order-1")
+			assert.ErrorContains(t, err, "order ended in refunded status")
+			assert.Equal(t, "refunded", status)
+		})
+
+		t.Run("should fail when the SLA expires", func(t *testing.T) {
+			respondWithStatuses("pending")
+
+			canary := newCanary(config.CanaryConfiguration{SenderAPIKey: "canary-key", SenderSigningKey: "signing-secret"})
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+
+			status, err := canary.waitForStatus(ctx, "order-1")
+			assert.ErrorContains(t, err, "did not reach settled within SLA")
+			assert.Equal(t, "pending", status)
+		})
+	})
+
+	t.Run("Run", func(t *testing.T) {
+		t.Run("should alert on Slack when the canary fails", func(t *testing.T) {
+			var alert string
+			httpmock.Reset()
+			httpmock.RegisterResponder("POST", slackWebhookURL,
+				func(r *http.Request) (*http.Response, error) {
+					body, _ := io.ReadAll(r.Body)
+					alert = string(body)
+					return httpmock.NewStringResponse(200, "ok"), nil
+				},
+			)
+
+			canary := newCanary(config.CanaryConfiguration{})
+			result, err := canary.Run(context.Background())
+			assert.Error(t, err)
+			assert.False(t, result.Success)
+			assert.Equal(t, "config", result.Stage)
+			assert.Equal(t, 1, httpmock.GetTotalCallCount())
+			assert.Contains(t, alert, "Canary order failed")
+			assert.Contains(t, alert, "CANARY_SENDER_API_KEY")
+		})
+	})
+}
@@ -0,0 +1,355 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/adminauditlog"
+	"github.com/NEDA-LABS/stablenode/ent/lockorderfulfillment"
+	"github.com/NEDA-LABS/stablenode/ent/lockpaymentorder"
+	networkent "github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/providerprofile"
+	"github.com/NEDA-LABS/stablenode/ent/provisionbucket"
+	"github.com/NEDA-LABS/stablenode/services"
+	orderService "github.com/NEDA-LABS/stablenode/services/order"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrOrderNotRefundable is returned when force-refunding an order that has no on-chain order
+	// to refund or has already been settled or refunded
+	ErrOrderNotRefundable = errors.New("order cannot be refunded")
+
+	// ErrOrderNotRequeueable is returned when requeueing or reassigning a lock order that a
+	// provider has already fulfilled, or that is held for manual review
+	ErrOrderNotRequeueable = errors.New("order cannot be requeued")
+
+	// ErrProviderNotEligible is returned when reassigning a lock order to a provider that is
+	// inactive or doesn't serve the order's currency
+	ErrProviderNotEligible = errors.New("provider cannot take the order")
+)
+
+// AdminAction identifies an operation performed on an order through the admin API
+type AdminAction struct {
+	Actor  string
+	Reason string
+}
+
+// ForceRefundOrder refunds the on-chain order of a payment order to its sender right away instead
+// of waiting for the refund timeout. The order is marked refunded once the OrderRefunded event is
+// indexed.
+// Returns the lock order that was refunded.
+func ForceRefundOrder(ctx context.Context, orderID uuid.UUID, action AdminAction) (*ent.LockPaymentOrder, error) {
+	order, err := db.Client.PaymentOrder.
+		Query().
+		Where(paymentorder.IDEQ(orderID)).
+		WithToken(func(tq *ent.TokenQuery) {
+			tq.WithNetwork()
+		}).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if order.GatewayID == "" ||
+		order.Status == paymentorder.StatusSettled ||
+		order.Status == paymentorder.StatusRefunded {
+		return nil, ErrOrderNotRefundable
+	}
+
+	lockOrder, err := db.Client.LockPaymentOrder.
+		Query().
+		Where(
+			lockpaymentorder.GatewayIDEQ(order.GatewayID),
+			lockpaymentorder.StatusNotIn(lockpaymentorder.StatusValidated, lockpaymentorder.StatusSettled, lockpaymentorder.StatusRefunded),
+			lockpaymentorder.Not(lockpaymentorder.HasFulfillmentsWith(
+				lockorderfulfillment.ValidationStatusEQ(lockorderfulfillment.ValidationStatusSuccess),
+			)),
+		).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrOrderNotRefundable
+		}
+		return nil, fmt.Errorf("ForceRefundOrder.fetchLockOrder: %w", err)
+	}
+
+	network := order.Edges.Token.Edges.Network
+	if err := orderServiceForNetwork(network).RefundOrder(ctx, network, order.GatewayID); err != nil {
+		return nil, fmt.Errorf("ForceRefundOrder.RefundOrder: %w", err)
+	}
+
+	err = recordAdminAction(ctx, adminauditlog.ActionForceRefund, order.ID.String(), action, map[string]interface{}{
+		"GatewayID":   order.GatewayID,
+		"LockOrderID": lockOrder.ID.String(),
+		"Network":     network.Identifier,
+		"Status":      string(order.Status),
+	})
+	if err != nil {
+		return lockOrder, err
+	}
+
+	return lockOrder, nil
+}
+
+// RequeueLockOrder takes a lock order stuck with a provider away from it and sends it back to the
+// provision bucket queue. The provider is excluded from the order and any balance reserved for it
+// is released.
+func RequeueLockOrder(ctx context.Context, orderID uuid.UUID, action AdminAction) (*ent.LockPaymentOrder, error) {
+	order, previousProvider, err := releaseLockOrder(ctx, orderID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := services.NewPriorityQueueService().AssignLockPaymentOrder(ctx, lockOrderFields(order, "")); err != nil {
+		return nil, fmt.Errorf("RequeueLockOrder.AssignLockPaymentOrder: %w", err)
+	}
+
+	err = recordAdminAction(ctx, adminauditlog.ActionRequeue, order.ID.String(), action, map[string]interface{}{
+		"GatewayID":        order.GatewayID,
+		"PreviousProvider": previousProvider,
+		"Status":           string(order.Status),
+	})
+	if err != nil {
+		return order, err
+	}
+
+	return order, nil
+}
+
+// ReassignLockOrder takes a lock order away from its current provider and offers it to the given
+// provider, even one the order was declined by or excluded from. The provider has to accept the
+// order request like any other.
+func ReassignLockOrder(ctx context.Context, orderID uuid.UUID, providerID string, action AdminAction) (*ent.LockPaymentOrder, error) {
+	order, err := db.Client.LockPaymentOrder.
+		Query().
+		Where(lockpaymentorder.IDEQ(orderID)).
+		WithProvisionBucket(func(pbq *ent.ProvisionBucketQuery) {
+			pbq.WithCurrency()
+		}).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if order.Edges.ProvisionBucket == nil {
+		return nil, ErrOrderNotRequeueable
+	}
+
+	eligible, err := db.Client.ProviderProfile.
+		Query().
+		Where(
+			providerprofile.IDEQ(providerID),
+			providerprofile.IsActive(true),
+			providerprofile.HasProvisionBucketsWith(provisionbucket.IDEQ(order.Edges.ProvisionBucket.ID)),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("ReassignLockOrder.fetchProvider: %w", err)
+	}
+	if !eligible {
+		return nil, ErrProviderNotEligible
+	}
+
+	order, previousProvider, err := releaseLockOrder(ctx, orderID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// The admin's choice overrides an earlier decline by the provider
+	_, err = db.RedisClient.LRem(ctx, fmt.Sprintf("order_exclude_list_%s", order.ID), 0, providerID).Result()
+	if err != nil {
+		return nil, fmt.Errorf("ReassignLockOrder.excludeList: %w", err)
+	}
+
+	if err := services.NewPriorityQueueService().AssignLockPaymentOrder(ctx, lockOrderFields(order, providerID)); err != nil {
+		return nil, fmt.Errorf("ReassignLockOrder.AssignLockPaymentOrder: %w", err)
+	}
+
+	err = recordAdminAction(ctx, adminauditlog.ActionReassignProvider, order.ID.String(), action, map[string]interface{}{
+		"GatewayID":        order.GatewayID,
+		"PreviousProvider": previousProvider,
+		"Provider":         providerID,
+	})
+	if err != nil {
+		return order, err
+	}
+
+	return order, nil
+}
+
+// releaseLockOrder returns a lock order to pending without a provider, dropping the order request
+// it is waiting on and releasing the balance its provider reserved for it. With exclude, the
+// provider is also kept from being offered the order again.
+// Returns the pending order and the ID of the provider it was taken from, if any.
+func releaseLockOrder(ctx context.Context, orderID uuid.UUID, exclude bool) (*ent.LockPaymentOrder, string, error) {
+	order, err := db.Client.LockPaymentOrder.
+		Query().
+		Where(lockpaymentorder.IDEQ(orderID)).
+		WithProvider().
+		WithFulfillments().
+		WithProvisionBucket(func(pbq *ent.ProvisionBucketQuery) {
+			pbq.WithCurrency()
+		}).
+		Only(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Orders outside a provision bucket only go to the private provider they were created for
+	if order.Edges.ProvisionBucket == nil || order.ReviewReason != "" ||
+		(order.Status != lockpaymentorder.StatusPending &&
+			order.Status != lockpaymentorder.StatusProcessing &&
+			order.Status != lockpaymentorder.StatusCancelled) {
+		return nil, "", ErrOrderNotRequeueable
+	}
+	for _, fulfillment := range order.Edges.Fulfillments {
+		if fulfillment.ValidationStatus != lockorderfulfillment.ValidationStatusFailed {
+			return nil, "", ErrOrderNotRequeueable
+		}
+	}
+
+	// A pending order may be waiting on an order request that never got accepted
+	orderRequest, err := db.RedisClient.HGetAll(ctx, fmt.Sprintf("order_request_%s", order.ID)).Result()
+	if err != nil {
+		return nil, "", fmt.Errorf("releaseLockOrder.orderRequest: %w", err)
+	}
+
+	var previousProvider string
+	if order.Edges.Provider != nil {
+		previousProvider = order.Edges.Provider.ID
+	} else {
+		previousProvider = orderRequest["providerId"]
+	}
+
+	if len(orderRequest) > 0 {
+		if _, err := db.RedisClient.Del(ctx, fmt.Sprintf("order_request_%s", order.ID)).Result(); err != nil {
+			return nil, "", fmt.Errorf("releaseLockOrder.deleteOrderRequest: %w", err)
+		}
+	}
+
+	if exclude && previousProvider != "" {
+		_, err = db.RedisClient.RPush(ctx, fmt.Sprintf("order_exclude_list_%s", order.ID), previousProvider).Result()
+		if err != nil {
+			return nil, "", fmt.Errorf("releaseLockOrder.excludeList: %w", err)
+		}
+	}
+
+	tx, err := db.Client.Tx(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("releaseLockOrder.db: %w", err)
+	}
+
+	// Balance is reserved when an order request is sent and kept once the provider accepts it
+	if previousProvider != "" && (len(orderRequest) > 0 || order.Status == lockpaymentorder.StatusProcessing) {
+		currency := order.Edges.ProvisionBucket.Edges.Currency.Code
+		amount := order.Amount.Mul(order.Rate).RoundBank(0)
+		err = services.NewBalanceManagementService().ReleaseReservedBalance(ctx, previousProvider, currency, amount, tx)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, "", fmt.Errorf("releaseLockOrder.releaseBalance: %w", err)
+		}
+	}
+
+	if len(order.Edges.Fulfillments) > 0 {
+		_, err = tx.LockOrderFulfillment.
+			Delete().
+			Where(lockorderfulfillment.HasOrderWith(lockpaymentorder.IDEQ(order.ID))).
+			Exec(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, "", fmt.Errorf("releaseLockOrder.deleteFulfillments: %w", err)
+		}
+	}
+
+	_, err = tx.LockPaymentOrder.
+		UpdateOneID(order.ID).
+		ClearProvider().
+		SetStatus(lockpaymentorder.StatusPending).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, "", fmt.Errorf("releaseLockOrder.updateOrder: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, "", fmt.Errorf("releaseLockOrder.commit: %w", err)
+	}
+
+	order, err = db.Client.LockPaymentOrder.
+		Query().
+		Where(lockpaymentorder.IDEQ(order.ID)).
+		WithToken(func(tq *ent.TokenQuery) {
+			tq.WithNetwork()
+		}).
+		WithProvisionBucket(func(pbq *ent.ProvisionBucketQuery) {
+			pbq.WithCurrency()
+		}).
+		Only(ctx)
+	if err != nil {
+		return nil, previousProvider, fmt.Errorf("releaseLockOrder.fetchOrder: %w", err)
+	}
+
+	return order, previousProvider, nil
+}
+
+// lockOrderFields builds the fields the priority queue assigns a lock order with
+func lockOrderFields(order *ent.LockPaymentOrder, providerID string) types.LockPaymentOrderFields {
+	return types.LockPaymentOrderFields{
+		ID:                order.ID,
+		Token:             order.Edges.Token,
+		GatewayID:         order.GatewayID,
+		Amount:            order.Amount,
+		Rate:              order.Rate,
+		BlockNumber:       order.BlockNumber,
+		Institution:       order.Institution,
+		AccountIdentifier: order.AccountIdentifier,
+		AccountName:       order.AccountName,
+		ProviderID:        providerID,
+		Memo:              order.Memo,
+		ProvisionBucket:   order.Edges.ProvisionBucket,
+		UpdatedAt:         order.UpdatedAt,
+		CreatedAt:         order.CreatedAt,
+	}
+}
+
+// orderServiceForNetwork returns the order service for the chain family of a network
+func orderServiceForNetwork(network *ent.Network) types.OrderService {
+	if network.NetworkType == networkent.NetworkTypeSolana {
+		return orderService.NewOrderSolana()
+	}
+	if strings.HasPrefix(network.Identifier, "tron") {
+		return orderService.NewOrderTron()
+	}
+	return orderService.NewOrderEVM()
+}
+
+// recordAdminAction writes the audit log entry of an operation performed on an order
+func recordAdminAction(ctx context.Context, kind adminauditlog.Action, targetID string, action AdminAction, metadata map[string]interface{}) error {
+	_, err := db.Client.AdminAuditLog.
+		Create().
+		SetAction(kind).
+		SetTargetID(targetID).
+		SetActor(action.Actor).
+		SetReason(action.Reason).
+		SetMetadata(metadata).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("recordAdminAction: %w", err)
+	}
+
+	logger.WithFields(logger.Fields{
+		"Action":   string(kind),
+		"TargetID": targetID,
+		"Actor":    action.Actor,
+		"Reason":   action.Reason,
+	}).Infof("Admin action performed")
+
+	return nil
+}
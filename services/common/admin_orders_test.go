@@ -0,0 +1,233 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/adminauditlog"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	"github.com/NEDA-LABS/stablenode/ent/lockpaymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/test"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+type adminOrdersFixture struct {
+	token     *ent.Token
+	bucket    *ent.ProvisionBucket
+	providers []*ent.ProviderProfile
+}
+
+// setupAdminOrders creates a provision bucket served by two active providers and an inactive one
+func setupAdminOrders(t *testing.T, ctx context.Context) adminOrdersFixture {
+	token, err := test.CreateERC20Token(nil, map[string]interface{}{
+		"symbol":         "USDC",
+		"identifier":     "base-sepolia",
+		"chainID":        int64(84532),
+		"deployContract": false,
+	})
+	assert.NoError(t, err)
+
+	currency, err := test.CreateTestFiatCurrency(map[string]interface{}{
+		"code":        "KES",
+		"short_name":  "Shilling",
+		"symbol":      "KSh",
+		"name":        "Kenyan Shilling",
+		"market_rate": 130.0,
+	})
+	assert.NoError(t, err)
+
+	bucket, err := db.Client.ProvisionBucket.
+		Create().
+		SetMinAmount(decimal.NewFromFloat(1)).
+		SetMaxAmount(decimal.NewFromFloat(10000)).
+		SetCurrency(currency).
+		Save(ctx)
+	assert.NoError(t, err)
+
+	var providers []*ent.ProviderProfile
+	for i, active := range []bool{true, true, false} {
+		user, err := test.CreateTestUser(map[string]interface{}{
+			"email": fmt.Sprintf("provider%d@test.com", i),
+			"scope": "provider",
+		})
+		assert.NoError(t, err)
+
+		provider, err := test.CreateTestProviderProfile(map[string]interface{}{
+			"user_id":         user.ID,
+			"trading_name":    fmt.Sprintf("Provider %d", i),
+			"currency_id":     currency.ID,
+			"host_identifier": "http://localhost:0",
+		})
+		assert.NoError(t, err)
+
+		provider, err = provider.Update().
+			SetIsActive(active).
+			AddProvisionBuckets(bucket).
+			Save(ctx)
+		assert.NoError(t, err)
+		providers = append(providers, provider)
+	}
+
+	return adminOrdersFixture{token: token, bucket: bucket, providers: providers}
+}
+
+// createAdminLockOrder creates a lock order in the fixture's bucket
+func createAdminLockOrder(t *testing.T, ctx context.Context, fixture adminOrdersFixture, status lockpaymentorder.Status, provider *ent.ProviderProfile) *ent.LockPaymentOrder {
+	create := db.Client.LockPaymentOrder.
+		Create().
+		SetGatewayID(fmt.Sprintf("0x%s", uuid.New().String())).
+		SetAmount(decimal.NewFromFloat(50)).
+		SetAmountInUsd(decimal.NewFromFloat(50)).
+		SetProtocolFee(decimal.Zero).
+		SetRate(decimal.NewFromFloat(130)).
+		SetOrderPercent(decimal.NewFromFloat(100)).
+		SetStatus(status).
+		SetBlockNumber(1).
+		SetInstitution("MPESAKES").
+		SetAccountIdentifier("0700000000").
+		SetAccountName("Test Account").
+		SetToken(fixture.token).
+		SetProvisionBucket(fixture.bucket)
+	if provider != nil {
+		create.SetProvider(provider)
+	}
+	order, err := create.Save(ctx)
+	assert.NoError(t, err)
+	return order
+}
+
+func TestAdminOrderOperations(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:adminorders?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	mr, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer mr.Close()
+	db.RedisClient = redis.NewClient(&redis.Options{Addr: mr.Addr()})
+
+	ctx := context.Background()
+	fixture := setupAdminOrders(t, ctx)
+	action := AdminAction{Actor: "ops@example.com", Reason: "provider unresponsive"}
+
+	t.Run("requeue takes the order from its provider and excludes it", func(t *testing.T) {
+		order := createAdminLockOrder(t, ctx, fixture, lockpaymentorder.StatusCancelled, fixture.providers[0])
+
+		requeued, err := RequeueLockOrder(ctx, order.ID, action)
+		assert.NoError(t, err)
+		assert.Equal(t, lockpaymentorder.StatusPending, requeued.Status)
+
+		hasProvider, err := requeued.QueryProvider().Exist(ctx)
+		assert.NoError(t, err)
+		assert.False(t, hasProvider)
+
+		excluded, err := db.RedisClient.LRange(ctx, fmt.Sprintf("order_exclude_list_%s", order.ID), 0, -1).Result()
+		assert.NoError(t, err)
+		assert.Equal(t, []string{fixture.providers[0].ID}, excluded)
+
+		entry, err := client.AdminAuditLog.
+			Query().
+			Where(adminauditlog.TargetIDEQ(order.ID.String())).
+			Only(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, adminauditlog.ActionRequeue, entry.Action)
+		assert.Equal(t, action.Actor, entry.Actor)
+		assert.Equal(t, action.Reason, entry.Reason)
+		assert.Equal(t, fixture.providers[0].ID, entry.Metadata["PreviousProvider"])
+	})
+
+	t.Run("reassign lifts an earlier exclusion of the chosen provider", func(t *testing.T) {
+		order := createAdminLockOrder(t, ctx, fixture, lockpaymentorder.StatusPending, nil)
+		excludeKey := fmt.Sprintf("order_exclude_list_%s", order.ID)
+		db.RedisClient.RPush(ctx, excludeKey, fixture.providers[1].ID)
+
+		_, err := ReassignLockOrder(ctx, order.ID, fixture.providers[1].ID, action)
+		assert.NoError(t, err)
+
+		excluded, err := db.RedisClient.LRange(ctx, excludeKey, 0, -1).Result()
+		assert.NoError(t, err)
+		assert.NotContains(t, excluded, fixture.providers[1].ID)
+
+		entry, err := client.AdminAuditLog.
+			Query().
+			Where(adminauditlog.TargetIDEQ(order.ID.String())).
+			Only(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, adminauditlog.ActionReassignProvider, entry.Action)
+		assert.Equal(t, fixture.providers[1].ID, entry.Metadata["Provider"])
+	})
+
+	t.Run("reassign rejects inactive providers", func(t *testing.T) {
+		order := createAdminLockOrder(t, ctx, fixture, lockpaymentorder.StatusPending, nil)
+
+		_, err := ReassignLockOrder(ctx, order.ID, fixture.providers[2].ID, action)
+		assert.ErrorIs(t, err, ErrProviderNotEligible)
+	})
+
+	t.Run("fulfilled and settled orders are left alone", func(t *testing.T) {
+		for _, status := range []lockpaymentorder.Status{lockpaymentorder.StatusFulfilled, lockpaymentorder.StatusSettled} {
+			order := createAdminLockOrder(t, ctx, fixture, status, fixture.providers[0])
+
+			_, err := RequeueLockOrder(ctx, order.ID, action)
+			assert.ErrorIs(t, err, ErrOrderNotRequeueable)
+
+			unchanged := client.LockPaymentOrder.GetX(ctx, order.ID)
+			assert.Equal(t, status, unchanged.Status)
+		}
+	})
+
+	t.Run("refund requires an on-chain order", func(t *testing.T) {
+		user, err := test.CreateTestUser(map[string]interface{}{
+			"email": "sender@test.com",
+		})
+		assert.NoError(t, err)
+
+		sender, err := test.CreateTestSenderProfile(map[string]interface{}{
+			"user_id": user.ID,
+			"token":   fixture.token.Symbol,
+		})
+		assert.NoError(t, err)
+
+		order, err := client.PaymentOrder.
+			Create().
+			SetSenderProfile(sender).
+			SetAmount(decimal.NewFromFloat(10)).
+			SetAmountInUsd(decimal.NewFromFloat(10)).
+			SetAmountPaid(decimal.Zero).
+			SetAmountReturned(decimal.Zero).
+			SetPercentSettled(decimal.Zero).
+			SetNetworkFee(decimal.Zero).
+			SetSenderFee(decimal.Zero).
+			SetProtocolFee(decimal.Zero).
+			SetRate(decimal.NewFromFloat(130)).
+			SetToken(fixture.token).
+			SetReceiveAddressText("0x1111111111111111111111111111111111111111").
+			SetFeePercent(decimal.Zero).
+			SetFeeAddress("0x1234567890123456789012345678901234567890").
+			SetStatus(paymentorder.StatusInitiated).
+			Save(ctx)
+		assert.NoError(t, err)
+
+		_, err = ForceRefundOrder(ctx, order.ID, action)
+		assert.ErrorIs(t, err, ErrOrderNotRefundable)
+
+		_, err = ForceRefundOrder(ctx, uuid.New(), action)
+		assert.True(t, ent.IsNotFound(err))
+
+		logged, err := client.AdminAuditLog.
+			Query().
+			Where(adminauditlog.TargetIDEQ(order.ID.String())).
+			Exist(ctx)
+		assert.NoError(t, err)
+		assert.False(t, logged)
+	})
+}
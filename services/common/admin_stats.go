@@ -0,0 +1,267 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	tokenent "github.com/NEDA-LABS/stablenode/ent/token"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/shopspring/decimal"
+)
+
+// Groupings of the admin statistics
+const (
+	StatsGroupByDay     = "day"
+	StatsGroupByNetwork = "network"
+	StatsGroupByToken   = "token"
+)
+
+// StatsMaxRange bounds the date range of the admin statistics
+const StatsMaxRange = 366 * 24 * time.Hour
+
+// orderStats is the aggregate of the payment orders of a group. Amounts are in USD and only count
+// settled orders
+type orderStats struct {
+	Key               string          `json:"key"`
+	Orders            int             `json:"orders"`
+	Settled           int             `json:"settled"`
+	Refunded          int             `json:"refunded"`
+	Expired           int             `json:"expired"`
+	Volume            decimal.Decimal `json:"volume"`
+	SenderFees        decimal.Decimal `json:"sender_fees"`
+	NetworkFees       decimal.Decimal `json:"network_fees"`
+	ProtocolFees      decimal.Decimal `json:"protocol_fees"`
+	SettlementSeconds float64         `json:"settlement_seconds"`
+}
+
+// add folds the aggregate of another group into s
+func (s *orderStats) add(other orderStats) {
+	s.Orders += other.Orders
+	s.Settled += other.Settled
+	s.Refunded += other.Refunded
+	s.Expired += other.Expired
+	s.Volume = s.Volume.Add(other.Volume)
+	s.SenderFees = s.SenderFees.Add(other.SenderFees)
+	s.NetworkFees = s.NetworkFees.Add(other.NetworkFees)
+	s.ProtocolFees = s.ProtocolFees.Add(other.ProtocolFees)
+	s.SettlementSeconds += other.SettlementSeconds
+}
+
+// bucket builds the response for the aggregate. The success rate is the share of orders that ended
+// settled rather than refunded or expired
+func (s *orderStats) bucket(key string) types.AdminStatsBucket {
+	bucket := types.AdminStatsBucket{
+		Key:            key,
+		Volume:         s.Volume.Round(2),
+		Orders:         s.Orders,
+		SettledOrders:  s.Settled,
+		RefundedOrders: s.Refunded,
+		ExpiredOrders:  s.Expired,
+		SenderFees:     s.SenderFees.Round(2),
+		NetworkFees:    s.NetworkFees.Round(2),
+		ProtocolFees:   s.ProtocolFees.Round(2),
+	}
+	if ended := s.Settled + s.Refunded + s.Expired; ended > 0 {
+		bucket.SuccessRate = float64(s.Settled) / float64(ended)
+	}
+	if s.Settled > 0 {
+		bucket.AverageSettlementSeconds = s.SettlementSeconds / float64(s.Settled)
+	}
+	return bucket
+}
+
+// AdminStats aggregates the payment orders created from from until to, in total and grouped by day,
+// network or token. The aggregation runs in the database, and its result is cached for
+// ADMIN_STATS_CACHE_TTL
+func AdminStats(ctx context.Context, from, to time.Time, groupBy string) (*types.AdminStatsResponse, error) {
+	cacheKey := fmt.Sprintf("admin_stats_%s_%d_%d", groupBy, from.Unix(), to.Unix())
+	if db.RedisClient != nil {
+		if data, err := db.RedisClient.Get(ctx, cacheKey).Bytes(); err == nil {
+			var cached types.AdminStatsResponse
+			if json.Unmarshal(data, &cached) == nil {
+				return &cached, nil
+			}
+		}
+	}
+
+	var rows []orderStats
+	err := db.Client.PaymentOrder.
+		Query().
+		Where(
+			paymentorder.CreatedAtGTE(from),
+			paymentorder.CreatedAtLT(to),
+		).
+		Aggregate(
+			orderStatsKey(groupBy),
+			ent.As(ent.Count(), "orders"),
+			sumForStatus(paymentorder.StatusSettled, "settled", sqlConstant("1")),
+			sumForStatus(paymentorder.StatusRefunded, "refunded", sqlConstant("1")),
+			sumForStatus(paymentorder.StatusExpired, "expired", sqlConstant("1")),
+			sumForStatus(paymentorder.StatusSettled, "volume", func(s *sql.Selector) string {
+				return s.C(paymentorder.FieldAmountInUsd)
+			}),
+			sumForStatus(paymentorder.StatusSettled, "sender_fees", usdValue(paymentorder.FieldSenderFee)),
+			sumForStatus(paymentorder.StatusSettled, "network_fees", usdValue(paymentorder.FieldNetworkFee)),
+			sumForStatus(paymentorder.StatusSettled, "protocol_fees", usdValue(paymentorder.FieldProtocolFee)),
+			sumForStatus(paymentorder.StatusSettled, "settlement_seconds", settlementSeconds),
+		).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("AdminStats.aggregate: %w", err)
+	}
+
+	groups, err := groupOrderStats(ctx, rows, from, to, groupBy)
+	if err != nil {
+		return nil, fmt.Errorf("AdminStats.group: %w", err)
+	}
+
+	var totals orderStats
+	response := &types.AdminStatsResponse{
+		From:    from,
+		To:      to,
+		GroupBy: groupBy,
+		Groups:  make([]types.AdminStatsBucket, 0, len(groups)),
+	}
+	keys := make([]string, 0, len(groups))
+	for key := range groups {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		totals.add(*groups[key])
+		response.Groups = append(response.Groups, groups[key].bucket(key))
+	}
+	response.Totals = totals.bucket("")
+
+	if db.RedisClient != nil {
+		data, _ := json.Marshal(response)
+		if err := db.RedisClient.Set(ctx, cacheKey, data, config.ServerConfig().AdminStatsCacheTTL).Err(); err != nil {
+			logger.WithFields(logger.Fields{
+				"Error": err.Error(),
+				"Key":   cacheKey,
+			}).Warnf("Failed to cache admin stats")
+		}
+	}
+
+	return response, nil
+}
+
+// groupOrderStats keys the aggregated rows by day, network or token. Rows are aggregated per token
+// for networks and tokens, so they are merged by network or by token symbol. Days without orders
+// are included so the days of the range are contiguous
+func groupOrderStats(ctx context.Context, rows []orderStats, from, to time.Time, groupBy string) (map[string]*orderStats, error) {
+	groups := make(map[string]*orderStats)
+
+	if groupBy == StatsGroupByDay {
+		for day := from.UTC().Truncate(24 * time.Hour); day.Before(to); day = day.AddDate(0, 0, 1) {
+			groups[day.Format("2006-01-02")] = &orderStats{}
+		}
+		for _, row := range rows {
+			if _, ok := groups[row.Key]; !ok {
+				groups[row.Key] = &orderStats{}
+			}
+			groups[row.Key].add(row)
+		}
+		return groups, nil
+	}
+
+	tokenIDs := make([]int, 0, len(rows))
+	for _, row := range rows {
+		id, err := strconv.Atoi(row.Key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid token ID %q", row.Key)
+		}
+		tokenIDs = append(tokenIDs, id)
+	}
+	tokens, err := db.Client.Token.
+		Query().
+		Where(tokenent.IDIn(tokenIDs...)).
+		WithNetwork().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	keyByTokenID := make(map[string]string, len(tokens))
+	for _, token := range tokens {
+		key := token.Symbol
+		if groupBy == StatsGroupByNetwork {
+			key = token.Edges.Network.Identifier
+		}
+		keyByTokenID[strconv.Itoa(token.ID)] = key
+	}
+
+	for _, row := range rows {
+		key, ok := keyByTokenID[row.Key]
+		if !ok {
+			continue
+		}
+		if _, ok := groups[key]; !ok {
+			groups[key] = &orderStats{}
+		}
+		groups[key].add(row)
+	}
+	return groups, nil
+}
+
+// orderStatsKey selects the group of an order as the key column and groups the aggregation by it:
+// the UTC day the order was created for days, otherwise the order's token
+func orderStatsKey(groupBy string) ent.AggregateFunc {
+	return func(s *sql.Selector) string {
+		key := s.C(paymentorder.TokenColumn)
+		if groupBy == StatsGroupByDay {
+			key = fmt.Sprintf("strftime('%%Y-%%m-%%d', %s)", s.C(paymentorder.FieldCreatedAt))
+			if s.Dialect() == dialect.Postgres {
+				key = fmt.Sprintf("to_char(%s AT TIME ZONE 'UTC', 'YYYY-MM-DD')", s.C(paymentorder.FieldCreatedAt))
+			}
+		}
+		s.GroupBy(key)
+		return sql.As(key, "key")
+	}
+}
+
+// sumForStatus sums an expression over the orders in a status
+func sumForStatus(status paymentorder.Status, alias string, expr func(*sql.Selector) string) ent.AggregateFunc {
+	return func(s *sql.Selector) string {
+		return sql.As(fmt.Sprintf(
+			"COALESCE(SUM(CASE WHEN %s = '%s' THEN %s ELSE 0 END), 0)",
+			s.C(paymentorder.FieldStatus), status, expr(s),
+		), alias)
+	}
+}
+
+// sqlConstant is an expression of a constant
+func sqlConstant(value string) func(*sql.Selector) string {
+	return func(*sql.Selector) string {
+		return value
+	}
+}
+
+// usdValue converts an amount of the order's token to USD at the order's own rate
+func usdValue(field string) func(*sql.Selector) string {
+	return func(s *sql.Selector) string {
+		return fmt.Sprintf(
+			"CASE WHEN %[2]s > 0 THEN %[1]s * %[3]s / %[2]s ELSE 0 END",
+			s.C(field), s.C(paymentorder.FieldAmount), s.C(paymentorder.FieldAmountInUsd),
+		)
+	}
+}
+
+// settlementSeconds is the time an order took from its creation to its settlement. Settled orders
+// no longer change, so they were last updated when they settled
+func settlementSeconds(s *sql.Selector) string {
+	if s.Dialect() == dialect.Postgres {
+		return fmt.Sprintf("EXTRACT(EPOCH FROM (%s - %s))", s.C(paymentorder.FieldUpdatedAt), s.C(paymentorder.FieldCreatedAt))
+	}
+	return fmt.Sprintf("(julianday(%s) - julianday(%s)) * 86400", s.C(paymentorder.FieldUpdatedAt), s.C(paymentorder.FieldCreatedAt))
+}
@@ -0,0 +1,141 @@
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/test"
+	"github.com/alicebob/miniredis/v2"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminStats(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:adminstats?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	mr, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer mr.Close()
+	db.RedisClient = redis.NewClient(&redis.Options{Addr: mr.Addr()})
+
+	ctx := context.Background()
+
+	var tokens []*ent.Token
+	for i, identifier := range []string{"base", "polygon"} {
+		token, err := test.CreateERC20Token(nil, map[string]interface{}{
+			"symbol":         "USDC",
+			"identifier":     identifier,
+			"chainID":        int64(i + 1),
+			"deployContract": false,
+		})
+		assert.NoError(t, err)
+		tokens = append(tokens, token)
+	}
+
+	day := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	createOrder := func(token *ent.Token, status paymentorder.Status, createdAt time.Time, settlement time.Duration) {
+		_, err := client.PaymentOrder.
+			Create().
+			SetAmount(decimal.NewFromFloat(100)).
+			SetAmountInUsd(decimal.NewFromFloat(50)).
+			SetAmountPaid(decimal.NewFromFloat(100)).
+			SetAmountReturned(decimal.Zero).
+			SetPercentSettled(decimal.Zero).
+			SetNetworkFee(decimal.NewFromFloat(2)).
+			SetSenderFee(decimal.NewFromFloat(4)).
+			SetProtocolFee(decimal.NewFromFloat(1)).
+			SetRate(decimal.NewFromFloat(1)).
+			SetToken(token).
+			SetReceiveAddressText("0x1111111111111111111111111111111111111111").
+			SetFeePercent(decimal.Zero).
+			SetFeeAddress("0x1234567890123456789012345678901234567890").
+			SetStatus(status).
+			SetCreatedAt(createdAt).
+			SetUpdatedAt(createdAt.Add(settlement)).
+			Save(ctx)
+		assert.NoError(t, err)
+	}
+
+	createOrder(tokens[0], paymentorder.StatusSettled, day.Add(time.Hour), 2*time.Minute)
+	createOrder(tokens[0], paymentorder.StatusSettled, day.Add(2*time.Hour), 4*time.Minute)
+	createOrder(tokens[1], paymentorder.StatusRefunded, day.Add(3*time.Hour), 0)
+	createOrder(tokens[1], paymentorder.StatusSettled, day.AddDate(0, 0, 2).Add(time.Hour), 6*time.Minute)
+	createOrder(tokens[1], paymentorder.StatusPending, day.AddDate(0, 0, 2).Add(2*time.Hour), 0)
+
+	// Outside the range
+	createOrder(tokens[0], paymentorder.StatusSettled, day.AddDate(0, 0, 5), time.Minute)
+
+	from, to := day, day.AddDate(0, 0, 3)
+
+	t.Run("groups by day", func(t *testing.T) {
+		stats, err := AdminStats(ctx, from, to, StatsGroupByDay)
+		assert.NoError(t, err)
+
+		assert.Equal(t, 5, stats.Totals.Orders)
+		assert.Equal(t, 3, stats.Totals.SettledOrders)
+		assert.Equal(t, 1, stats.Totals.RefundedOrders)
+		assert.Equal(t, 0.75, stats.Totals.SuccessRate)
+		assert.InDelta(t, 240, stats.Totals.AverageSettlementSeconds, 1)
+
+		// Volume and fees of settled orders, converted to USD at half the token amount
+		assert.True(t, stats.Totals.Volume.Equal(decimal.NewFromFloat(150)), stats.Totals.Volume.String())
+		assert.True(t, stats.Totals.SenderFees.Equal(decimal.NewFromFloat(6)), stats.Totals.SenderFees.String())
+		assert.True(t, stats.Totals.NetworkFees.Equal(decimal.NewFromFloat(3)), stats.Totals.NetworkFees.String())
+		assert.True(t, stats.Totals.ProtocolFees.Equal(decimal.NewFromFloat(1.5)), stats.Totals.ProtocolFees.String())
+
+		if assert.Len(t, stats.Groups, 3) {
+			assert.Equal(t, "2024-05-01", stats.Groups[0].Key)
+			assert.Equal(t, 3, stats.Groups[0].Orders)
+			assert.Equal(t, "2024-05-02", stats.Groups[1].Key)
+			assert.Equal(t, 0, stats.Groups[1].Orders)
+			assert.Equal(t, "2024-05-03", stats.Groups[2].Key)
+			assert.Equal(t, 2, stats.Groups[2].Orders)
+		}
+	})
+
+	t.Run("groups by network", func(t *testing.T) {
+		stats, err := AdminStats(ctx, from, to, StatsGroupByNetwork)
+		assert.NoError(t, err)
+
+		if assert.Len(t, stats.Groups, 2) {
+			assert.Equal(t, "base", stats.Groups[0].Key)
+			assert.Equal(t, 2, stats.Groups[0].SettledOrders)
+			assert.Equal(t, 1.0, stats.Groups[0].SuccessRate)
+			assert.Equal(t, "polygon", stats.Groups[1].Key)
+			assert.Equal(t, 3, stats.Groups[1].Orders)
+			assert.Equal(t, 0.5, stats.Groups[1].SuccessRate)
+		}
+	})
+
+	t.Run("groups tokens across networks", func(t *testing.T) {
+		stats, err := AdminStats(ctx, from, to, StatsGroupByToken)
+		assert.NoError(t, err)
+
+		if assert.Len(t, stats.Groups, 1) {
+			assert.Equal(t, "USDC", stats.Groups[0].Key)
+			assert.Equal(t, 5, stats.Groups[0].Orders)
+		}
+	})
+
+	t.Run("caches results", func(t *testing.T) {
+		createOrder(tokens[0], paymentorder.StatusSettled, day.Add(4*time.Hour), time.Minute)
+
+		stats, err := AdminStats(ctx, from, to, StatsGroupByDay)
+		assert.NoError(t, err)
+		assert.Equal(t, 5, stats.Totals.Orders)
+
+		mr.FlushAll()
+		stats, err = AdminStats(ctx, from, to, StatsGroupByDay)
+		assert.NoError(t, err)
+		assert.Equal(t, 6, stats.Totals.Orders)
+	})
+}
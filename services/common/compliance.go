@@ -0,0 +1,205 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/adminauditlog"
+	networkent "github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/services"
+	"github.com/NEDA-LABS/stablenode/services/compliance"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+)
+
+// ErrOrderNotQuarantined is returned when approving or refunding an order compliance screening
+// didn't quarantine
+var ErrOrderNotQuarantined = errors.New("order is not quarantined")
+
+// newScreener returns the screener deposit senders are checked with
+var newScreener = func() compliance.Screener {
+	return compliance.New(config.ComplianceConfig())
+}
+
+// createApprovedOrder creates an order released from quarantine on-chain
+var createApprovedOrder = func(ctx context.Context, network *ent.Network, orderID uuid.UUID) error {
+	return orderServiceForNetwork(network).CreateOrder(ctx, orderID)
+}
+
+// screenDepositSender screens the address a deposit was sent from and returns why the deposit must
+// be quarantined, or an empty reason to let it through. Deposits the screener can't check are
+// quarantined unless screening is configured to fail open
+func screenDepositSender(ctx context.Context, network *ent.Network, address string) string {
+	result, err := newScreener().Screen(ctx, network.Identifier, address)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":   fmt.Sprintf("%v", err),
+			"Address": address,
+			"Network": network.Identifier,
+		}).Errorf("Failed to screen deposit sender")
+		if config.ComplianceConfig().FailOpen {
+			return ""
+		}
+		return "compliance screening unavailable"
+	}
+
+	if !result.Flagged {
+		return ""
+	}
+	return result.Reason
+}
+
+// sendQuarantineAlert logs and alerts an order quarantined by compliance screening
+func sendQuarantineAlert(order *ent.PaymentOrder, network *ent.Network, fromAddress, txHash, reason string) {
+	details := map[string]string{
+		"Order ID": order.ID.String(),
+		"Network":  network.Identifier,
+		"From":     fromAddress,
+		"Tx Hash":  txHash,
+		"Reason":   reason,
+	}
+
+	logger.WithFields(logger.Fields{
+		"OrderID": order.ID.String(),
+		"Network": network.Identifier,
+		"From":    fromAddress,
+		"TxHash":  txHash,
+		"Reason":  reason,
+	}).Warnf("🚨 Deposit quarantined by compliance screening")
+
+	title := fmt.Sprintf("Order %s quarantined - approve or refund it", order.ID)
+	if err := services.NewSlackService(config.ServerConfig().SlackWebhookURL).SendAlert(title, details); err != nil {
+		logger.Errorf("Failed to send quarantine alert: %v", err)
+	}
+}
+
+// ApproveQuarantinedOrder releases an order compliance screening quarantined, as if its deposit had
+// passed screening. A fully paid order is created on-chain, or waits for the confirmations or
+// finality of its deposit first; a partly paid order goes back to awaiting the rest of its payment
+func ApproveQuarantinedOrder(ctx context.Context, orderID uuid.UUID, action AdminAction) (*ent.PaymentOrder, error) {
+	order, err := quarantinedOrder(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	network := order.Edges.Token.Edges.Network
+	receiveAddress := order.Edges.ReceiveAddress
+
+	var status paymentorder.Status
+	createOrder := false
+	switch {
+	case order.Edges.LinkedAddress != nil:
+		// Orders from linked addresses are created on-chain as soon as they are paid
+		status = paymentorder.StatusInitiated
+		createOrder = true
+	case order.DepositStatus == "":
+		status = paymentorder.StatusInitiated
+	case order.RequiredConfirmations > 0:
+		status = paymentorder.StatusAwaitingConfirmations
+	default:
+		status = paymentorder.StatusPending
+		createOrder = !AwaitingDepositFinality(order, network)
+	}
+
+	order, err = order.Update().
+		SetStatus(status).
+		ClearQuarantineReason().
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("ApproveQuarantinedOrder.db: %w", err)
+	}
+
+	// Give the payer a full validity window to send the rest
+	if status == paymentorder.StatusInitiated && receiveAddress != nil && !receiveAddress.ValidUntil.IsZero() {
+		err = receiveAddress.
+			Update().
+			SetValidUntil(time.Now().Add(orderConf.ReceiveAddressValidity)).
+			Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("ApproveQuarantinedOrder.receiveAddress: %w", err)
+		}
+	}
+
+	if createOrder {
+		if err := createApprovedOrder(ctx, network, order.ID); err != nil {
+			return nil, fmt.Errorf("ApproveQuarantinedOrder.CreateOrder: %w", err)
+		}
+	}
+
+	err = recordAdminAction(ctx, adminauditlog.ActionApproveQuarantined, order.ID.String(), action, map[string]interface{}{
+		"Network":    network.Identifier,
+		"From":       order.FromAddress,
+		"AmountPaid": order.AmountPaid.String(),
+		"Status":     string(status),
+	})
+	if err != nil {
+		return order, err
+	}
+
+	return order, nil
+}
+
+// RefundQuarantinedOrder sends the deposit of an order compliance screening quarantined back to the
+// payer. The order is expired, and the partial payment refunds send what was paid to its receive
+// address back. Only EVM orders paid to a receive address are refunded this way
+func RefundQuarantinedOrder(ctx context.Context, orderID uuid.UUID, action AdminAction) (*ent.PaymentOrder, error) {
+	order, err := quarantinedOrder(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	network := order.Edges.Token.Edges.Network
+
+	if order.Edges.ReceiveAddress == nil ||
+		network.NetworkType != networkent.NetworkTypeEvm ||
+		strings.HasPrefix(network.Identifier, "tron") {
+		return nil, ErrOrderNotRefundable
+	}
+
+	order, err = order.Update().
+		SetStatus(paymentorder.StatusExpired).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("RefundQuarantinedOrder.db: %w", err)
+	}
+
+	err = recordAdminAction(ctx, adminauditlog.ActionRefundQuarantined, order.ID.String(), action, map[string]interface{}{
+		"Network":          network.Identifier,
+		"From":             order.FromAddress,
+		"AmountPaid":       order.AmountPaid.String(),
+		"QuarantineReason": order.QuarantineReason,
+	})
+	if err != nil {
+		return order, err
+	}
+
+	return order, nil
+}
+
+// quarantinedOrder fetches an order held by compliance screening
+func quarantinedOrder(ctx context.Context, orderID uuid.UUID) (*ent.PaymentOrder, error) {
+	order, err := db.Client.PaymentOrder.
+		Query().
+		Where(paymentorder.IDEQ(orderID)).
+		WithToken(func(tq *ent.TokenQuery) {
+			tq.WithNetwork()
+		}).
+		WithReceiveAddress().
+		WithLinkedAddress().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if order.Status != paymentorder.StatusQuarantined {
+		return nil, ErrOrderNotQuarantined
+	}
+
+	return order, nil
+}
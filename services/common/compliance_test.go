@@ -0,0 +1,109 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/adminauditlog"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	"github.com/NEDA-LABS/stablenode/services/compliance"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/shopspring/decimal"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDepositQuarantine(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:compliance?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	ctx := context.Background()
+	orders := setupDepositSplit(t, ctx)
+	viper.Set("SLACK_WEBHOOK_URL", "")
+
+	client.DenylistedAddress.Create().
+		SetAddress("0x52908400098527886E0F7030069857D2E4169EE7").
+		SetReason("sanctioned").
+		SaveX(ctx)
+	compliance.DefaultDenylist().Invalidate()
+
+	var created []uuid.UUID
+	createOrder := func(ctx context.Context, orderID uuid.UUID) error {
+		created = append(created, orderID)
+		return nil
+	}
+	defaultCreateApprovedOrder := createApprovedOrder
+	defer func() {
+		createApprovedOrder = defaultCreateApprovedOrder
+	}()
+	createApprovedOrder = func(ctx context.Context, network *ent.Network, orderID uuid.UUID) error {
+		return createOrder(ctx, orderID)
+	}
+
+	action := AdminAction{Actor: "compliance@example.com", Reason: "false positive"}
+
+	deposit := func(order *ent.PaymentOrder, txHash string, value float64) *ent.PaymentOrder {
+		done, err := UpdateReceiveAddressStatus(ctx, order.Edges.ReceiveAddress, order, &types.TokenTransferEvent{
+			BlockNumber: 100,
+			TxHash:      txHash,
+			// Addresses match the denylist regardless of checksum case
+			From:  "0x52908400098527886e0f7030069857d2e4169ee7",
+			To:    splitTestAddress,
+			Value: decimal.NewFromFloat(value),
+		}, createOrder, nil)
+		assert.NoError(t, err)
+		assert.True(t, done)
+
+		return client.PaymentOrder.Query().
+			Where(paymentorder.IDEQ(order.ID)).
+			WithReceiveAddress().
+			OnlyX(ctx)
+	}
+
+	t.Run("should quarantine deposits from flagged senders", func(t *testing.T) {
+		order := deposit(orders[0], "0xd1", 10.5)
+
+		assert.Empty(t, created)
+		assert.Equal(t, paymentorder.StatusQuarantined, order.Status)
+		assert.Equal(t, "address is denylisted: sanctioned", order.QuarantineReason)
+		assert.True(t, order.AmountPaid.Equal(decimal.NewFromFloat(10.5)))
+		assert.Equal(t, "0xd1", order.TxHash)
+		assert.Equal(t, receiveaddress.StatusUsed, order.Edges.ReceiveAddress.Status)
+	})
+
+	t.Run("should create approved orders", func(t *testing.T) {
+		order, err := ApproveQuarantinedOrder(ctx, orders[0].ID, action)
+		assert.NoError(t, err)
+		assert.Equal(t, paymentorder.StatusPending, order.Status)
+		assert.Empty(t, order.QuarantineReason)
+		assert.Equal(t, []uuid.UUID{orders[0].ID}, created)
+
+		entry := client.AdminAuditLog.Query().OnlyX(ctx)
+		assert.Equal(t, adminauditlog.ActionApproveQuarantined, entry.Action)
+		assert.Equal(t, action.Actor, entry.Actor)
+
+		_, err = ApproveQuarantinedOrder(ctx, orders[0].ID, action)
+		assert.ErrorIs(t, err, ErrOrderNotQuarantined)
+	})
+
+	t.Run("should expire refunded orders for the partial payment refunds", func(t *testing.T) {
+		deposit(orders[1], "0xd2", 20.5)
+
+		order, err := RefundQuarantinedOrder(ctx, orders[1].ID, action)
+		assert.NoError(t, err)
+		assert.Equal(t, paymentorder.StatusExpired, order.Status)
+		assert.Len(t, created, 1)
+
+		count := client.AdminAuditLog.Query().
+			Where(adminauditlog.ActionEQ(adminauditlog.ActionRefundQuarantined)).
+			CountX(ctx)
+		assert.Equal(t, 1, count)
+	})
+}
@@ -0,0 +1,369 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
+	"github.com/NEDA-LABS/stablenode/services"
+	"github.com/NEDA-LABS/stablenode/services/ledger"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/NEDA-LABS/stablenode/utils"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+)
+
+// confirmationsClient reads the chain state deposit confirmations are counted against
+type confirmationsClient interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*ethtypes.Header, error)
+	TransactionReceipt(ctx context.Context, txHash ethcommon.Hash) (*ethtypes.Receipt, error)
+}
+
+// dialConfirmationsClient returns a client of a network that fails over between its RPC endpoints
+var dialConfirmationsClient = func(network *ent.Network) (confirmationsClient, error) {
+	return &rpcConfirmationsClient{network: network, clients: make(map[string]types.RPCClient)}, nil
+}
+
+// rpcConfirmationsClient reads chain state through the RPC manager, keeping a client per endpoint
+type rpcConfirmationsClient struct {
+	network *ent.Network
+	clients map[string]types.RPCClient
+}
+
+func (c *rpcConfirmationsClient) do(ctx context.Context, fn func(client types.RPCClient) error) error {
+	return services.GetRPCManager().Do(ctx, c.network, func(endpoint string) error {
+		client, ok := c.clients[endpoint]
+		if !ok {
+			var err error
+			client, err = types.NewEthClient(endpoint)
+			if err != nil {
+				return services.EndpointError(err)
+			}
+			c.clients[endpoint] = client
+		}
+		return fn(client)
+	})
+}
+
+func (c *rpcConfirmationsClient) HeaderByNumber(ctx context.Context, number *big.Int) (header *ethtypes.Header, err error) {
+	err = c.do(ctx, func(client types.RPCClient) error {
+		header, err = client.HeaderByNumber(ctx, number)
+		return err
+	})
+	return header, err
+}
+
+func (c *rpcConfirmationsClient) TransactionReceipt(ctx context.Context, txHash ethcommon.Hash) (receipt *ethtypes.Receipt, err error) {
+	err = c.do(ctx, func(client types.RPCClient) error {
+		receipt, err = client.TransactionReceipt(ctx, txHash)
+		return err
+	})
+	return receipt, err
+}
+
+// ProcessDepositConfirmations moves orders awaiting confirmations on once their deposits are buried under
+// the required depth, creating them on-chain unless they also wait for finality. Deposits that dropped off
+// the canonical chain in a reorg are reverted and the order goes back to awaiting payment
+func ProcessDepositConfirmations(ctx context.Context, createOrder func(ctx context.Context, orderID uuid.UUID) error) error {
+	orders, err := db.Client.PaymentOrder.
+		Query().
+		Where(paymentorder.StatusEQ(paymentorder.StatusAwaitingConfirmations)).
+		WithToken(func(tq *ent.TokenQuery) {
+			tq.WithNetwork()
+		}).
+		WithReceiveAddress().
+		WithTransactions(func(tq *ent.TransactionLogQuery) {
+			tq.Where(transactionlog.StatusIn(transactionlog.StatusCryptoDeposited, transactionlog.StatusCryptoDepositReverted))
+		}).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("ProcessDepositConfirmations.fetchOrders: %w", err)
+	}
+
+	ordersByNetwork := make(map[int][]*ent.PaymentOrder)
+	networks := make(map[int]*ent.Network)
+	for _, order := range orders {
+		network := order.Edges.Token.Edges.Network
+		networks[network.ID] = network
+		ordersByNetwork[network.ID] = append(ordersByNetwork[network.ID], order)
+	}
+
+	for networkID, networkOrders := range ordersByNetwork {
+		network := networks[networkID]
+
+		client, err := dialConfirmationsClient(network)
+		if err != nil {
+			logger.WithFields(logger.Fields{
+				"Error":   fmt.Sprintf("%v", err),
+				"Network": network.Identifier,
+			}).Errorf("ProcessDepositConfirmations.dial")
+			continue
+		}
+
+		header, err := client.HeaderByNumber(ctx, nil)
+		if err != nil {
+			logger.WithFields(logger.Fields{
+				"Error":   fmt.Sprintf("%v", err),
+				"Network": network.Identifier,
+			}).Errorf("ProcessDepositConfirmations.HeaderByNumber")
+			continue
+		}
+
+		for _, order := range networkOrders {
+			err := confirmDeposit(ctx, client, header.Number.Int64(), order, createOrder)
+			if err != nil {
+				logger.WithFields(logger.Fields{
+					"Error":   fmt.Sprintf("%v", err),
+					"OrderID": order.ID.String(),
+					"Network": network.Identifier,
+				}).Errorf("ProcessDepositConfirmations.confirmDeposit")
+			}
+		}
+	}
+
+	return nil
+}
+
+// confirmDeposit checks the deposits of an order against the chain head
+func confirmDeposit(ctx context.Context, client confirmationsClient, head int64, order *ent.PaymentOrder, createOrder func(ctx context.Context, orderID uuid.UUID) error) error {
+	// Confirmations are counted from the latest deposit
+	var dropped []*ent.TransactionLog
+	var depositBlock int64
+	for _, log := range creditedDeposits(order.Edges.Transactions) {
+
+		receipt, err := client.TransactionReceipt(ctx, ethcommon.HexToHash(log.TxHash))
+		if errors.Is(err, ethereum.NotFound) || (err == nil && receipt.Status != ethtypes.ReceiptStatusSuccessful) {
+			dropped = append(dropped, log)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to fetch receipt of %s: %w", log.TxHash, err)
+		}
+
+		if receipt.BlockNumber.Int64() > depositBlock {
+			depositBlock = receipt.BlockNumber.Int64()
+		}
+	}
+
+	if len(dropped) > 0 {
+		return revertDeposits(ctx, order, dropped)
+	}
+
+	if depositBlock == 0 {
+		return nil
+	}
+
+	update := order.Update()
+	if depositBlock != order.BlockNumber {
+		// Re-included in another block, count confirmations from there
+		update.SetBlockNumber(depositBlock)
+	}
+
+	confirmations := head - depositBlock + 1
+	if confirmations < int64(order.RequiredConfirmations) {
+		if depositBlock != order.BlockNumber {
+			if _, err := update.Save(ctx); err != nil {
+				return fmt.Errorf("failed to update block number: %w", err)
+			}
+		}
+		return nil
+	}
+
+	network := order.Edges.Token.Edges.Network
+	order, err := update.
+		SetStatus(paymentorder.StatusPending).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to set order pending: %w", err)
+	}
+
+	logger.WithFields(logger.Fields{
+		"OrderID":       order.ID.String(),
+		"TxHash":        order.TxHash,
+		"Confirmations": confirmations,
+	}).Infof("Deposit confirmed")
+	utils.PublishOrderStatus(ctx, order)
+
+	if AwaitingDepositFinality(order, network) {
+		return nil
+	}
+
+	return createOrder(ctx, order.ID)
+}
+
+// revertDeposits takes back the credit of deposits a reorg dropped from the canonical chain and
+// reopens the order and its receive address for payment
+func revertDeposits(ctx context.Context, order *ent.PaymentOrder, dropped []*ent.TransactionLog) error {
+	network := order.Edges.Token.Edges.Network
+
+	tx, err := db.Client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	reversals := make(map[string]int)
+	for _, log := range order.Edges.Transactions {
+		if log.Status == transactionlog.StatusCryptoDepositReverted {
+			reversals[log.TxHash]++
+		}
+	}
+
+	revertedAmount := decimal.Zero
+	txHashes := make([]string, 0, len(dropped))
+	revertLogs := make([]*ent.TransactionLog, 0, len(dropped))
+	for _, log := range dropped {
+		value := depositValue(log)
+		revertedAmount = revertedAmount.Add(value)
+		txHashes = append(txHashes, log.TxHash)
+
+		revertLog, err := tx.TransactionLog.
+			Create().
+			SetStatus(transactionlog.StatusCryptoDepositReverted).
+			SetTxHash(log.TxHash).
+			SetNetwork(network.Identifier).
+			SetMetadata(map[string]interface{}{
+				"value":  value.String(),
+				"reason": "deposit not found on the canonical chain",
+			}).
+			Save(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("failed to create transaction log: %w", err)
+		}
+		revertLogs = append(revertLogs, revertLog)
+
+		// The deposit is no longer credited to the order, so the transfer is credited again if it is
+		// mined on the canonical chain later
+		if err := tx.TransactionLog.UpdateOneID(log.ID).ClearDepositOrderID().Exec(ctx); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("failed to update transaction log: %w", err)
+		}
+
+		if err := ledger.Record(ctx, tx, ledger.DepositReversal(order, log.TxHash, value, reversals[log.TxHash])); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("failed to book reverted deposit: %w", err)
+		}
+	}
+
+	revertedOrder, err := tx.PaymentOrder.
+		UpdateOneID(order.ID).
+		AddAmountPaid(revertedAmount.Neg()).
+		SetStatus(paymentorder.StatusInitiated).
+		SetTxHash("").
+		SetBlockNumber(0).
+		ClearDepositStatus().
+		ClearDepositFinalizedAt().
+		AddTransactions(revertLogs...).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("failed to revert payment order: %w", err)
+	}
+
+	if receiveAddress := order.Edges.ReceiveAddress; receiveAddress != nil {
+		// Only pool rows carry a network identifier; counterfactual ones are not deployed yet
+		status := receiveaddress.StatusUnused
+		if receiveAddress.NetworkIdentifier != "" {
+			status = receiveaddress.StatusPoolAssigned
+		}
+
+		_, err = tx.ReceiveAddress.
+			UpdateOneID(receiveAddress.ID).
+			SetStatus(status).
+			SetTxHash("").
+			SetValidUntil(time.Now().Add(config.OrderConfig().ReceiveAddressValidity)).
+			Save(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("failed to reopen receive address: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit revert: %w", err)
+	}
+	utils.PublishOrderStatus(ctx, revertedOrder)
+
+	logger.WithFields(logger.Fields{
+		"OrderID":        order.ID.String(),
+		"TxHashes":       txHashes,
+		"RevertedAmount": revertedAmount,
+		"Network":        network.Identifier,
+	}).Errorf("🚨 Deposit dropped by a reorg, credit reverted")
+
+	err = services.NewSlackService(config.ServerConfig().SlackWebhookURL).SendAlert("Deposit reverted by reorg", map[string]string{
+		"Order ID":        order.ID.String(),
+		"Network":         network.Identifier,
+		"Tx Hashes":       fmt.Sprintf("%v", txHashes),
+		"Reverted Amount": revertedAmount.String(),
+	})
+	if err != nil {
+		logger.Errorf("Failed to send reorg alert: %v", err)
+	}
+
+	return nil
+}
+
+// creditedDeposits returns the crypto deposits among logs that are still credited. Each revert of a
+// transfer takes back the earliest of its deposits logged before it, so a transfer re-mined after a
+// reorg dropped it is credited again
+func creditedDeposits(logs []*ent.TransactionLog) []*ent.TransactionLog {
+	sorted := make([]*ent.TransactionLog, len(logs))
+	copy(sorted, logs)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].CreatedAt.Before(sorted[j].CreatedAt)
+	})
+
+	reverted := make(map[*ent.TransactionLog]bool)
+	pending := make(map[string][]*ent.TransactionLog)
+	for _, log := range sorted {
+		switch log.Status {
+		case transactionlog.StatusCryptoDeposited:
+			pending[log.TxHash] = append(pending[log.TxHash], log)
+		case transactionlog.StatusCryptoDepositReverted:
+			if deposits := pending[log.TxHash]; len(deposits) > 0 {
+				reverted[deposits[0]] = true
+				pending[log.TxHash] = deposits[1:]
+			}
+		}
+	}
+
+	var credited []*ent.TransactionLog
+	for _, log := range sorted {
+		if log.Status == transactionlog.StatusCryptoDeposited && !reverted[log] {
+			credited = append(credited, log)
+		}
+	}
+	return credited
+}
+
+// depositValue returns the amount credited by a crypto deposit transaction log
+func depositValue(log *ent.TransactionLog) decimal.Decimal {
+	transactionData, ok := log.Metadata["transactionData"].(map[string]interface{})
+	if !ok {
+		return decimal.Zero
+	}
+	value, ok := transactionData["value"].(string)
+	if !ok {
+		return decimal.Zero
+	}
+	amount, err := decimal.NewFromString(value)
+	if err != nil {
+		return decimal.Zero
+	}
+	return amount
+}
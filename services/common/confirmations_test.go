@@ -0,0 +1,176 @@
+package common
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	"github.com/NEDA-LABS/stablenode/ent/ledgerentry"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/ethereum/go-ethereum"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/shopspring/decimal"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+// stubConfirmationsClient serves a chain head and the blocks deposits are included in
+type stubConfirmationsClient struct {
+	head     int64
+	receipts map[ethcommon.Hash]int64
+}
+
+func (c *stubConfirmationsClient) HeaderByNumber(ctx context.Context, number *big.Int) (*ethtypes.Header, error) {
+	return &ethtypes.Header{Number: big.NewInt(c.head)}, nil
+}
+
+func (c *stubConfirmationsClient) TransactionReceipt(ctx context.Context, txHash ethcommon.Hash) (*ethtypes.Receipt, error) {
+	block, ok := c.receipts[txHash]
+	if !ok {
+		return nil, ethereum.NotFound
+	}
+	return &ethtypes.Receipt{Status: ethtypes.ReceiptStatusSuccessful, BlockNumber: big.NewInt(block)}, nil
+}
+
+func TestDepositConfirmations(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:confirmations?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	viper.Set("SLACK_WEBHOOK_URL", "")
+
+	ctx := context.Background()
+	order := setupDepositSplit(t, ctx)[0]
+	_, err := order.Edges.Token.Edges.Network.Update().SetRequiredConfirmations(3).Save(ctx)
+	assert.NoError(t, err)
+
+	chain := &stubConfirmationsClient{receipts: map[ethcommon.Hash]int64{}}
+	dialConfirmationsClient = func(network *ent.Network) (confirmationsClient, error) {
+		return chain, nil
+	}
+
+	var created []uuid.UUID
+	createOrder := func(ctx context.Context, orderID uuid.UUID) error {
+		created = append(created, orderID)
+		return nil
+	}
+
+	reload := func() *ent.PaymentOrder {
+		return client.PaymentOrder.
+			Query().
+			Where(paymentorder.IDEQ(order.ID)).
+			WithToken(func(tq *ent.TokenQuery) {
+				tq.WithNetwork()
+			}).
+			WithReceiveAddress().
+			WithTransactions().
+			OnlyX(ctx)
+	}
+
+	deposit := func(txHash string) {
+		current := reload()
+		done, err := UpdateReceiveAddressStatus(ctx, current.Edges.ReceiveAddress, current, &types.TokenTransferEvent{
+			BlockNumber: 100,
+			TxHash:      txHash,
+			From:        "0x2222222222222222222222222222222222222222",
+			To:          splitTestAddress,
+			Value:       decimal.NewFromFloat(10.5),
+		}, createOrder, nil)
+		assert.NoError(t, err)
+		assert.True(t, done)
+	}
+
+	t.Run("should hold a paid order until its deposit is confirmed", func(t *testing.T) {
+		deposit("0xd1")
+		chain.receipts[ethcommon.HexToHash("0xd1")] = 100
+
+		updated := reload()
+		assert.Equal(t, paymentorder.StatusAwaitingConfirmations, updated.Status)
+		assert.Equal(t, 3, updated.RequiredConfirmations)
+		assert.Empty(t, created)
+
+		chain.head = 101
+		assert.NoError(t, ProcessDepositConfirmations(ctx, createOrder))
+		assert.Equal(t, paymentorder.StatusAwaitingConfirmations, reload().Status)
+		assert.Empty(t, created)
+	})
+
+	t.Run("should create the order once the deposit is confirmed", func(t *testing.T) {
+		chain.head = 102
+		assert.NoError(t, ProcessDepositConfirmations(ctx, createOrder))
+
+		assert.Equal(t, paymentorder.StatusPending, reload().Status)
+		assert.Equal(t, []uuid.UUID{order.ID}, created)
+	})
+
+	t.Run("should revert a deposit dropped by a reorg", func(t *testing.T) {
+		created = nil
+		_, err := client.PaymentOrder.
+			UpdateOneID(order.ID).
+			SetStatus(paymentorder.StatusInitiated).
+			SetTxHash("").
+			SetAmountPaid(decimal.Zero).
+			Save(ctx)
+		assert.NoError(t, err)
+		_, err = client.ReceiveAddress.
+			UpdateOneID(order.Edges.ReceiveAddress.ID).
+			SetStatus(receiveaddress.StatusPoolAssigned).
+			SetTxHash("").
+			Save(ctx)
+		assert.NoError(t, err)
+
+		deposit("0xd2")
+		assert.Equal(t, paymentorder.StatusAwaitingConfirmations, reload().Status)
+
+		// 0xd2 never made it to the canonical chain
+		chain.head = 110
+		assert.NoError(t, ProcessDepositConfirmations(ctx, createOrder))
+
+		updated := reload()
+		assert.Equal(t, paymentorder.StatusInitiated, updated.Status)
+		assert.Empty(t, updated.TxHash)
+		assert.True(t, updated.AmountPaid.IsZero())
+		assert.Equal(t, receiveaddress.StatusPoolAssigned, updated.Edges.ReceiveAddress.Status)
+		assert.Empty(t, created)
+
+		reverted := client.TransactionLog.
+			Query().
+			Where(transactionlog.StatusEQ(transactionlog.StatusCryptoDepositReverted)).
+			OnlyX(ctx)
+		assert.Equal(t, "0xd2", reverted.TxHash)
+		assert.Equal(t, "10.5", reverted.Metadata["value"])
+	})
+
+	t.Run("should credit a reverted deposit again once it is mined", func(t *testing.T) {
+		deposit("0xd2")
+
+		updated := reload()
+		assert.Equal(t, paymentorder.StatusAwaitingConfirmations, updated.Status)
+		assert.Equal(t, "0xd2", updated.TxHash)
+		assert.True(t, updated.AmountPaid.Equal(decimal.NewFromFloat(10.5)))
+
+		// 0xd2 made it into a later block this time
+		chain.receipts[ethcommon.HexToHash("0xd2")] = 108
+		assert.NoError(t, ProcessDepositConfirmations(ctx, createOrder))
+		assert.Equal(t, paymentorder.StatusPending, reload().Status)
+		assert.Equal(t, []uuid.UUID{order.ID}, created)
+
+		deposits := client.LedgerEntry.
+			Query().
+			Where(
+				ledgerentry.KindEQ(ledgerentry.KindDeposit),
+				ledgerentry.ReferenceHasPrefix("0xd2:"),
+			).
+			CountX(ctx)
+		assert.Equal(t, 2, deposits)
+	})
+}
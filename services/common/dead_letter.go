@@ -0,0 +1,316 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/adminauditlog"
+	"github.com/NEDA-LABS/stablenode/ent/deadletter"
+	networkent "github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/services"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/google/uuid"
+)
+
+// ErrDeadLetterResolved is returned when retrying a dead letter that was already processed
+var ErrDeadLetterResolved = errors.New("dead letter is already resolved")
+
+// deadLetterPayload is what a failed stage is processed again with
+type deadLetterPayload struct {
+	Event       json.RawMessage `json:"event"`
+	MessageHash string          `json:"messageHash,omitempty"`
+}
+
+// processDeadLetter processes the stage of a dead letter again; tests replace it
+var processDeadLetter = func(ctx context.Context, entry *ent.DeadLetter) error {
+	network, err := db.Client.Network.
+		Query().
+		Where(networkent.IdentifierEQ(entry.Network)).
+		Only(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch network: %w", err)
+	}
+
+	raw, err := json.Marshal(entry.Payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode payload: %w", err)
+	}
+	var payload deadLetterPayload
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return fmt.Errorf("failed to decode payload: %w", err)
+	}
+
+	switch entry.Stage {
+	case deadletter.StageReceiveAddress:
+		var event types.TokenTransferEvent
+		if err := json.Unmarshal(payload.Event, &event); err != nil {
+			return fmt.Errorf("failed to decode event: %w", err)
+		}
+
+		orderID, err := uuid.Parse(entry.OrderID)
+		if err != nil {
+			return fmt.Errorf("invalid order ID: %w", err)
+		}
+		order, err := db.Client.PaymentOrder.
+			Query().
+			Where(paymentorder.IDEQ(orderID)).
+			WithToken(func(tq *ent.TokenQuery) {
+				tq.WithNetwork()
+			}).
+			WithReceiveAddress().
+			WithRecipient().
+			WithSenderProfile().
+			Only(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to fetch order: %w", err)
+		}
+
+		// The deposit was credited some other way, e.g. by polling or reconciliation
+		if order.Status != paymentorder.StatusInitiated || order.Edges.ReceiveAddress == nil {
+			return nil
+		}
+
+		_, err = UpdateReceiveAddressStatus(ctx, order.Edges.ReceiveAddress, order, &event, orderServiceForNetwork(network).CreateOrder, services.NewPriorityQueueService().GetProviderRate)
+		return err
+
+	case deadletter.StageOrderCreated:
+		var event types.OrderCreatedEvent
+		if err := json.Unmarshal(payload.Event, &event); err != nil {
+			return fmt.Errorf("failed to decode event: %w", err)
+		}
+
+		err := CreateLockPaymentOrder(ctx, network, &event, orderServiceForNetwork(network).RefundOrder, services.NewPriorityQueueService().AssignLockPaymentOrder)
+		if err != nil && strings.Contains(err.Error(), "duplicate key value violates unique constraint") {
+			return nil
+		}
+		return err
+
+	case deadletter.StageOrderSettled:
+		var event types.OrderSettledEvent
+		if err := json.Unmarshal(payload.Event, &event); err != nil {
+			return fmt.Errorf("failed to decode event: %w", err)
+		}
+		return UpdateOrderStatusSettled(ctx, network, &event, payload.MessageHash)
+
+	case deadletter.StageOrderRefunded:
+		var event types.OrderRefundedEvent
+		if err := json.Unmarshal(payload.Event, &event); err != nil {
+			return fmt.Errorf("failed to decode event: %w", err)
+		}
+		return UpdateOrderStatusRefunded(ctx, network, &event, payload.MessageHash)
+	}
+
+	return fmt.Errorf("unknown stage %s", entry.Stage)
+}
+
+// RecordDeadLetter keeps a failed processing attempt of an indexed event so the retry worker can
+// process it again. event is the event the stage was processing, and messageHash the message hash
+// of the order for gateway events. A stage of an order that already has a pending dead letter only
+// has the error of that dead letter updated. Failures to record are logged, as callers run in
+// goroutines that have no one to return an error to.
+func RecordDeadLetter(ctx context.Context, stage deadletter.Stage, orderID string, network string, event interface{}, messageHash string, cause error) {
+	fields := logger.Fields{
+		"Stage":   stage,
+		"OrderID": orderID,
+		"Network": network,
+		"Cause":   fmt.Sprintf("%v", cause),
+	}
+
+	err := recordDeadLetter(ctx, stage, orderID, network, event, messageHash, cause)
+	if err != nil {
+		fields["Error"] = fmt.Sprintf("%v", err)
+		logger.WithFields(fields).Errorf("Failed to record dead letter")
+		return
+	}
+
+	logger.WithFields(fields).Warnf("Recorded dead letter")
+}
+
+// recordDeadLetter creates the dead letter of a failed stage, or updates the pending one
+func recordDeadLetter(ctx context.Context, stage deadletter.Stage, orderID string, network string, event interface{}, messageHash string, cause error) error {
+	lastError := deadLetterError(cause)
+
+	existing, err := db.Client.DeadLetter.
+		Query().
+		Where(
+			deadletter.OrderIDEQ(orderID),
+			deadletter.StageEQ(stage),
+			deadletter.StatusEQ(deadletter.StatusPending),
+		).
+		First(ctx)
+	if err == nil {
+		return existing.Update().SetLastError(lastError).Exec(ctx)
+	}
+	if !ent.IsNotFound(err) {
+		return fmt.Errorf("failed to fetch dead letter: %w", err)
+	}
+
+	rawEvent, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+	raw, err := json.Marshal(deadLetterPayload{Event: rawEvent, MessageHash: messageHash})
+	if err != nil {
+		return fmt.Errorf("failed to encode payload: %w", err)
+	}
+	var payload map[string]interface{}
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return fmt.Errorf("failed to encode payload: %w", err)
+	}
+
+	return db.Client.DeadLetter.
+		Create().
+		SetOrderID(orderID).
+		SetStage(stage).
+		SetNetwork(network).
+		SetPayload(payload).
+		SetLastError(lastError).
+		SetNextRetryAt(time.Now().Add(config.DeadLetterConfig().RetryDelay)).
+		Exec(ctx)
+}
+
+// RetryDeadLetters processes the pending dead letters that are due again. A failed retry is
+// rescheduled with the delay doubling on each retry, and the dead letter is exhausted after the
+// configured number of retries. Ops are notified of the dead letters exhausted in the run.
+func RetryDeadLetters(ctx context.Context) error {
+	conf := config.DeadLetterConfig()
+	now := time.Now()
+
+	entries, err := db.Client.DeadLetter.
+		Query().
+		Where(
+			deadletter.StatusEQ(deadletter.StatusPending),
+			deadletter.NextRetryAtLTE(now),
+		).
+		Order(ent.Asc(deadletter.FieldNextRetryAt)).
+		Limit(conf.BatchSize).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("RetryDeadLetters.db: %w", err)
+	}
+
+	var exhausted []string
+	for _, entry := range entries {
+		updated, err := retryDeadLetter(ctx, conf, entry)
+		if err != nil {
+			logger.WithFields(logger.Fields{
+				"Error": fmt.Sprintf("%v", err),
+				"ID":    entry.ID,
+			}).Errorf("RetryDeadLetters: Failed to update dead letter")
+			continue
+		}
+		if updated.Status == deadletter.StatusExhausted {
+			exhausted = append(exhausted, updated.ID.String())
+		}
+	}
+
+	if len(exhausted) > 0 {
+		listed := exhausted
+		if len(listed) > maxAlertOrders {
+			listed = listed[:maxAlertOrders]
+		}
+
+		err := services.NewSlackService(config.ServerConfig().SlackWebhookURL).SendAlert("Dead letters exhausted", map[string]string{
+			"Dead Letters": fmt.Sprintf("%d", len(exhausted)),
+			"Retries":      fmt.Sprintf("%d", conf.MaxRetries),
+			"IDs":          strings.Join(listed, ", "),
+		})
+		if err != nil {
+			logger.Errorf("Failed to send dead letter alert: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// RetryDeadLetter processes a dead letter again at the request of an admin, whether it is pending or
+// exhausted. A failed retry of a pending dead letter is rescheduled as by the retry worker, and an
+// exhausted one stays exhausted. The retry is recorded in the audit log.
+func RetryDeadLetter(ctx context.Context, id uuid.UUID, action AdminAction) (*ent.DeadLetter, error) {
+	entry, err := db.Client.DeadLetter.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if entry.Status == deadletter.StatusResolved {
+		return nil, ErrDeadLetterResolved
+	}
+
+	entry, err = retryDeadLetter(ctx, config.DeadLetterConfig(), entry)
+	if err != nil {
+		return nil, fmt.Errorf("RetryDeadLetter: %w", err)
+	}
+
+	err = recordAdminAction(ctx, adminauditlog.ActionRetryDeadLetter, entry.ID.String(), action, map[string]interface{}{
+		"orderId":   entry.OrderID,
+		"stage":     string(entry.Stage),
+		"resolved":  entry.Status == deadletter.StatusResolved,
+		"lastError": entry.LastError,
+	})
+	if err != nil {
+		return entry, err
+	}
+
+	return entry, nil
+}
+
+// retryDeadLetter processes a dead letter again and records the outcome
+func retryDeadLetter(ctx context.Context, conf *config.DeadLetterConfiguration, entry *ent.DeadLetter) (*ent.DeadLetter, error) {
+	retries := entry.RetryCount + 1
+	fields := logger.Fields{
+		"ID":      entry.ID,
+		"Stage":   entry.Stage,
+		"OrderID": entry.OrderID,
+		"Network": entry.Network,
+		"Retries": retries,
+	}
+
+	cause := processDeadLetter(ctx, entry)
+	update := entry.Update().SetRetryCount(retries)
+
+	switch {
+	case cause == nil:
+		update.
+			SetStatus(deadletter.StatusResolved).
+			SetResolvedAt(time.Now())
+		logger.WithFields(fields).Infof("Dead letter resolved")
+
+	case entry.Status == deadletter.StatusExhausted || retries >= conf.MaxRetries:
+		update.
+			SetStatus(deadletter.StatusExhausted).
+			SetLastError(deadLetterError(cause))
+		fields["Error"] = fmt.Sprintf("%v", cause)
+		logger.WithFields(fields).Errorf("Dead letter failed after its last retry")
+
+	default:
+		delay := conf.RetryDelay * time.Duration(1<<(retries-1))
+		if delay > conf.MaxRetryDelay || delay <= 0 {
+			delay = conf.MaxRetryDelay
+		}
+		update.
+			SetLastError(deadLetterError(cause)).
+			SetNextRetryAt(time.Now().Add(delay))
+		fields["Error"] = fmt.Sprintf("%v", cause)
+		logger.WithFields(fields).Warnf("Dead letter failed, retrying")
+	}
+
+	return update.Save(ctx)
+}
+
+// deadLetterError returns the error of a failed stage as stored on its dead letter
+func deadLetterError(err error) string {
+	lastError := fmt.Sprintf("%v", err)
+	if len(lastError) > 500 {
+		lastError = lastError[:500]
+	}
+	return lastError
+}
@@ -0,0 +1,127 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/adminauditlog"
+	"github.com/NEDA-LABS/stablenode/ent/deadletter"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/shopspring/decimal"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeadLetters(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:deadletters?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	viper.Set("DEAD_LETTER_MAX_RETRIES", 3)
+	viper.Set("DEAD_LETTER_RETRY_DELAY", 60)
+	defer viper.Set("DEAD_LETTER_MAX_RETRIES", 8)
+
+	var cause error
+	var processed []string
+	defer func(original func(context.Context, *ent.DeadLetter) error) { processDeadLetter = original }(processDeadLetter)
+	processDeadLetter = func(ctx context.Context, entry *ent.DeadLetter) error {
+		processed = append(processed, entry.OrderID)
+		return cause
+	}
+
+	ctx := context.Background()
+	event := &types.OrderSettledEvent{
+		BlockNumber:       100,
+		TxHash:            "0xsettled",
+		SplitOrderId:      "0xsplit",
+		OrderId:           "0xorder",
+		LiquidityProvider: "provider",
+		SettlePercent:     decimal.NewFromInt(100000),
+	}
+
+	// due makes a dead letter due for its next retry
+	due := func(entry *ent.DeadLetter) {
+		client.DeadLetter.UpdateOne(entry).SetNextRetryAt(time.Now().Add(-time.Second)).ExecX(ctx)
+	}
+
+	t.Run("records a failed stage once per order", func(t *testing.T) {
+		RecordDeadLetter(ctx, deadletter.StageOrderSettled, event.OrderId, "base-sepolia", event, "0xhash", errors.New("rpc timeout"))
+		RecordDeadLetter(ctx, deadletter.StageOrderSettled, event.OrderId, "base-sepolia", event, "0xhash", errors.New("connection reset"))
+
+		entries := client.DeadLetter.Query().AllX(ctx)
+		assert.Len(t, entries, 1)
+		assert.Equal(t, "connection reset", entries[0].LastError)
+		assert.Equal(t, deadletter.StatusPending, entries[0].Status)
+		assert.Equal(t, "0xhash", entries[0].Payload["messageHash"])
+		assert.Equal(t, "0xsettled", entries[0].Payload["event"].(map[string]interface{})["TxHash"])
+		assert.True(t, entries[0].NextRetryAt.After(time.Now().Add(30*time.Second)))
+
+		// Dead letters are not retried before they are due
+		assert.NoError(t, RetryDeadLetters(ctx))
+		assert.Empty(t, processed)
+	})
+
+	t.Run("backs off failed retries until the dead letter is exhausted", func(t *testing.T) {
+		entry := client.DeadLetter.Query().OnlyX(ctx)
+		cause = errors.New("still failing")
+
+		due(entry)
+		assert.NoError(t, RetryDeadLetters(ctx))
+		entry = client.DeadLetter.GetX(ctx, entry.ID)
+		assert.Equal(t, 1, entry.RetryCount)
+		assert.Equal(t, "still failing", entry.LastError)
+		assert.WithinDuration(t, time.Now().Add(time.Minute), entry.NextRetryAt, 5*time.Second)
+
+		due(entry)
+		assert.NoError(t, RetryDeadLetters(ctx))
+		entry = client.DeadLetter.GetX(ctx, entry.ID)
+		assert.Equal(t, 2, entry.RetryCount)
+		assert.WithinDuration(t, time.Now().Add(2*time.Minute), entry.NextRetryAt, 5*time.Second)
+
+		due(entry)
+		assert.NoError(t, RetryDeadLetters(ctx))
+		entry = client.DeadLetter.GetX(ctx, entry.ID)
+		assert.Equal(t, 3, entry.RetryCount)
+		assert.Equal(t, deadletter.StatusExhausted, entry.Status)
+
+		assert.NoError(t, RetryDeadLetters(ctx))
+		assert.Len(t, processed, 3)
+	})
+
+	t.Run("retries exhausted dead letters manually", func(t *testing.T) {
+		entry := client.DeadLetter.Query().OnlyX(ctx)
+		action := AdminAction{Actor: "ops@example.com", Reason: "RPC is back"}
+
+		updated, err := RetryDeadLetter(ctx, entry.ID, action)
+		assert.NoError(t, err)
+		assert.Equal(t, deadletter.StatusExhausted, updated.Status)
+		assert.Equal(t, 4, updated.RetryCount)
+
+		cause = nil
+		updated, err = RetryDeadLetter(ctx, entry.ID, action)
+		assert.NoError(t, err)
+		assert.Equal(t, deadletter.StatusResolved, updated.Status)
+		assert.False(t, updated.ResolvedAt.IsZero())
+
+		_, err = RetryDeadLetter(ctx, entry.ID, action)
+		assert.ErrorIs(t, err, ErrDeadLetterResolved)
+
+		logs := client.AdminAuditLog.Query().AllX(ctx)
+		assert.Len(t, logs, 2)
+		for _, log := range logs {
+			assert.Equal(t, adminauditlog.ActionRetryDeadLetter, log.Action)
+			assert.Equal(t, entry.ID.String(), log.TargetID)
+			assert.Equal(t, "ops@example.com", log.Actor)
+		}
+
+		// A stage that fails again after its dead letter was resolved gets a new one
+		RecordDeadLetter(ctx, deadletter.StageOrderSettled, event.OrderId, "base-sepolia", event, "0xhash", errors.New("rpc timeout"))
+		assert.Equal(t, 2, client.DeadLetter.Query().CountX(ctx))
+	})
+}
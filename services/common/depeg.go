@@ -0,0 +1,237 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	fastshot "github.com/opus-domini/fast-shot"
+	"github.com/shopspring/decimal"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/lockpaymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	tokenent "github.com/NEDA-LABS/stablenode/ent/token"
+	"github.com/NEDA-LABS/stablenode/services"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+)
+
+// MonitorDepegs checks the USD price of each USD stablecoin. A token trading further off its peg than
+// the configured threshold has new orders paused and its in-flight orders flagged for manual review,
+// so providers never settle fiat against a depegged deposit. Orders resume once the price recovers;
+// flagged orders stay flagged until reviewed
+func MonitorDepegs(ctx context.Context) error {
+	depegConf := config.DepegConfig()
+
+	tokens, err := db.Client.Token.
+		Query().
+		Where(
+			tokenent.BaseCurrencyEQ("USD"),
+			tokenent.IsEnabledEQ(true),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("MonitorDepegs.tokens: %w", err)
+	}
+
+	// Token rows share a price across networks
+	tokensBySymbol := make(map[string][]*ent.Token)
+	var priceIDs []string
+	for _, token := range tokens {
+		symbol := strings.ToUpper(token.Symbol)
+		priceID, ok := depegConf.PriceIDs[symbol]
+		if !ok {
+			continue
+		}
+		if _, seen := tokensBySymbol[symbol]; !seen {
+			priceIDs = append(priceIDs, priceID)
+		}
+		tokensBySymbol[symbol] = append(tokensBySymbol[symbol], token)
+	}
+
+	if len(priceIDs) == 0 {
+		return nil
+	}
+
+	prices, err := fetchUSDPrices(depegConf.PriceURL, priceIDs)
+	if err != nil {
+		return fmt.Errorf("MonitorDepegs.prices: %w", err)
+	}
+
+	for symbol, symbolTokens := range tokensBySymbol {
+		price, ok := prices[depegConf.PriceIDs[symbol]]
+		if !ok {
+			logger.Warnf("MonitorDepegs: no price for %s", symbol)
+			continue
+		}
+
+		if err := updatePegStatus(ctx, symbol, symbolTokens, price, depegConf.Threshold); err != nil {
+			logger.WithFields(logger.Fields{
+				"Error":  fmt.Sprintf("%v", err),
+				"Symbol": symbol,
+				"Price":  price,
+			}).Errorf("Failed to update peg status")
+		}
+	}
+
+	return nil
+}
+
+// isDepegged reports whether price deviates from the USD peg by more than threshold percent
+func isDepegged(price, threshold decimal.Decimal) (bool, decimal.Decimal) {
+	deviation := utils.AbsPercentageDeviation(decimal.NewFromInt(1), price)
+	return deviation.GreaterThan(threshold), deviation
+}
+
+// updatePegStatus records the price of the tokens of a symbol, pausing or resuming them when it crosses the threshold
+func updatePegStatus(ctx context.Context, symbol string, tokens []*ent.Token, price, threshold decimal.Decimal) error {
+	depegged, deviation := isDepegged(price, threshold)
+
+	tokenIDs := make([]int, 0, len(tokens))
+	wasDepegged := false
+	for _, token := range tokens {
+		tokenIDs = append(tokenIDs, token.ID)
+		if token.DepeggedAt != nil {
+			wasDepegged = true
+		}
+	}
+
+	update := db.Client.Token.
+		Update().
+		Where(tokenent.IDIn(tokenIDs...)).
+		SetUsdPrice(price)
+	switch {
+	case depegged && !wasDepegged:
+		update.SetDepeggedAt(time.Now())
+	case !depegged && wasDepegged:
+		update.ClearDepeggedAt()
+	}
+	if _, err := update.Save(ctx); err != nil {
+		return fmt.Errorf("failed to update tokens: %w", err)
+	}
+
+	details := map[string]string{
+		"Token":     symbol,
+		"Price":     price.String(),
+		"Deviation": deviation.StringFixed(2) + "%",
+		"Threshold": threshold.String() + "%",
+	}
+
+	switch {
+	case depegged && !wasDepegged:
+		flagged, err := flagInFlightOrders(ctx, tokenIDs, fmt.Sprintf("%s depegged to %s USD", symbol, price))
+		if err != nil {
+			return err
+		}
+		details["Flagged Orders"] = fmt.Sprintf("%d", flagged)
+
+		logger.WithFields(logger.Fields{
+			"Symbol":    symbol,
+			"Price":     price,
+			"Deviation": deviation,
+			"Flagged":   flagged,
+		}).Errorf("🚨 Stablecoin depegged - new orders paused and in-flight orders flagged for review")
+		sendDepegAlert(fmt.Sprintf("%s depegged - new orders paused", symbol), details)
+
+	case !depegged && wasDepegged:
+		logger.WithFields(logger.Fields{
+			"Symbol": symbol,
+			"Price":  price,
+		}).Infof("✅ Stablecoin back on peg - new orders resumed")
+		sendDepegAlert(fmt.Sprintf("%s back on peg - new orders resumed", symbol), details)
+	}
+
+	return nil
+}
+
+// flagInFlightOrders flags the open orders in the given tokens for manual review and returns how many were flagged
+func flagInFlightOrders(ctx context.Context, tokenIDs []int, reason string) (int, error) {
+	paymentOrders, err := db.Client.PaymentOrder.
+		Update().
+		Where(
+			paymentorder.HasTokenWith(tokenent.IDIn(tokenIDs...)),
+			paymentorder.StatusIn(
+				paymentorder.StatusInitiated,
+				paymentorder.StatusProcessing,
+				paymentorder.StatusPending,
+				paymentorder.StatusValidated,
+			),
+			paymentorder.Or(paymentorder.ReviewReasonIsNil(), paymentorder.ReviewReasonEQ("")),
+		).
+		SetReviewReason(reason).
+		Save(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to flag payment orders: %w", err)
+	}
+
+	lockOrders, err := db.Client.LockPaymentOrder.
+		Update().
+		Where(
+			lockpaymentorder.HasTokenWith(tokenent.IDIn(tokenIDs...)),
+			lockpaymentorder.StatusIn(
+				lockpaymentorder.StatusPending,
+				lockpaymentorder.StatusProcessing,
+				lockpaymentorder.StatusFulfilled,
+				lockpaymentorder.StatusValidated,
+			),
+			lockpaymentorder.Or(lockpaymentorder.ReviewReasonIsNil(), lockpaymentorder.ReviewReasonEQ("")),
+		).
+		SetReviewReason(reason).
+		Save(ctx)
+	if err != nil {
+		return paymentOrders, fmt.Errorf("failed to flag lock orders: %w", err)
+	}
+
+	return paymentOrders + lockOrders, nil
+}
+
+// fetchUSDPrices fetches the USD price of each price API ID
+func fetchUSDPrices(priceURL string, priceIDs []string) (map[string]decimal.Decimal, error) {
+	sort.Strings(priceIDs)
+
+	res, err := fastshot.NewClient(priceURL).
+		Config().SetTimeout(30*time.Second).
+		Build().GET("/simple/price").
+		Query().AddParams(map[string]string{
+		"ids":           strings.Join(priceIDs, ","),
+		"vs_currencies": "usd",
+	}).
+		Retry().Set(3, 5*time.Second).
+		Send()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := utils.ParseJSONResponse(res.RawResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	prices := make(map[string]decimal.Decimal, len(priceIDs))
+	for _, priceID := range priceIDs {
+		quote, ok := data[priceID].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		usd, ok := quote["usd"].(float64)
+		if !ok {
+			continue
+		}
+		prices[priceID] = decimal.NewFromFloat(usd)
+	}
+
+	return prices, nil
+}
+
+// sendDepegAlert notifies ops of a peg status change
+func sendDepegAlert(title string, details map[string]string) {
+	slackService := services.NewSlackService(config.ServerConfig().SlackWebhookURL)
+	if err := slackService.SendAlert(title, details); err != nil {
+		logger.Errorf("Failed to send depeg alert: %v", err)
+	}
+}
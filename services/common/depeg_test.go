@@ -0,0 +1,84 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	"github.com/NEDA-LABS/stablenode/ent/lockpaymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/shopspring/decimal"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDepegMonitor(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:depeg?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	ctx := context.Background()
+	orders := setupDepositSplit(t, ctx)
+	token := orders[0].Edges.Token
+	lockOrder := createSLALockOrder(t, ctx, token, nil, lockpaymentorder.StatusPending, orders[0].CreatedAt)
+	settledOrder := createSLALockOrder(t, ctx, token, nil, lockpaymentorder.StatusSettled, orders[0].CreatedAt)
+
+	price := "1.0"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "usd-coin", r.URL.Query().Get("ids"))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"usd-coin":{"usd":%s}}`, price)
+	}))
+	defer server.Close()
+
+	viper.Set("DEPEG_PRICE_URL", server.URL)
+	viper.Set("DEPEG_PRICE_IDS", "USDC:usd-coin")
+	viper.Set("DEPEG_THRESHOLD_PERCENT", 2)
+	viper.Set("SLACK_WEBHOOK_URL", "")
+
+	t.Run("should detect a depeg beyond the threshold", func(t *testing.T) {
+		depegged, _ := isDepegged(decimal.NewFromFloat(0.99), decimal.NewFromInt(2))
+		assert.False(t, depegged)
+		depegged, _ = isDepegged(decimal.NewFromFloat(0.97), decimal.NewFromInt(2))
+		assert.True(t, depegged)
+		depegged, _ = isDepegged(decimal.NewFromFloat(1.03), decimal.NewFromInt(2))
+		assert.True(t, depegged)
+	})
+
+	t.Run("should record the price of a token on peg", func(t *testing.T) {
+		assert.NoError(t, MonitorDepegs(ctx))
+
+		updated := client.Token.GetX(ctx, token.ID)
+		assert.Nil(t, updated.DepeggedAt)
+		assert.True(t, updated.UsdPrice.Equal(decimal.NewFromInt(1)))
+		assert.Empty(t, client.LockPaymentOrder.GetX(ctx, lockOrder.ID).ReviewReason)
+	})
+
+	t.Run("should pause a depegged token and flag its in-flight orders", func(t *testing.T) {
+		price = "0.95"
+		assert.NoError(t, MonitorDepegs(ctx))
+
+		updated := client.Token.GetX(ctx, token.ID)
+		assert.NotNil(t, updated.DepeggedAt)
+		assert.True(t, updated.UsdPrice.Equal(decimal.NewFromFloat(0.95)))
+
+		assert.Equal(t, "USDC depegged to 0.95 USD", client.LockPaymentOrder.GetX(ctx, lockOrder.ID).ReviewReason)
+		assert.Empty(t, client.LockPaymentOrder.GetX(ctx, settledOrder.ID).ReviewReason)
+
+		flagged := client.PaymentOrder.Query().Where(paymentorder.ReviewReasonNEQ("")).CountX(ctx)
+		assert.Equal(t, len(orders), flagged)
+	})
+
+	t.Run("should resume a token back on peg and keep orders flagged", func(t *testing.T) {
+		price = "0.999"
+		assert.NoError(t, MonitorDepegs(ctx))
+
+		assert.Nil(t, client.Token.GetX(ctx, token.ID).DepeggedAt)
+		assert.NotEmpty(t, client.LockPaymentOrder.GetX(ctx, lockOrder.ID).ReviewReason)
+	})
+}
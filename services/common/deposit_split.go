@@ -0,0 +1,281 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/depositsplit"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/services"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ErrDepositSplitResolved is returned when confirming or rejecting a split that is no longer pending
+var ErrDepositSplitResolved = errors.New("deposit split already resolved")
+
+// depositTolerance is the relative difference accepted between a deposit and the amount it should cover
+var depositTolerance = decimal.NewFromFloat(0.01)
+
+// orderDepositTotal returns the amount a sender must deposit to cover an order
+func orderDepositTotal(paymentOrder *ent.PaymentOrder) decimal.Decimal {
+	fees := paymentOrder.NetworkFee.Add(paymentOrder.SenderFee)
+	return paymentOrder.Amount.Add(fees).Round(int32(paymentOrder.Edges.Token.Decimals))
+}
+
+// depositMatches reports whether value is within the deposit tolerance of expected
+func depositMatches(value, expected decimal.Decimal) bool {
+	tolerance := expected.Mul(depositTolerance)
+	return value.GreaterThanOrEqual(expected.Sub(tolerance)) && value.LessThanOrEqual(expected.Add(tolerance))
+}
+
+// DetectDepositSplit checks whether a transfer to an address shared by several open orders pays
+// two orders of the same sender at once. When it does, a pending deposit split is recorded and
+// returned so the orders are held for an operator instead of one of them being overcredited.
+// A nil split means the transfer should be processed normally.
+func DetectDepositSplit(ctx context.Context, orders []*ent.PaymentOrder, event *types.TokenTransferEvent) (*ent.DepositSplit, error) {
+	existing, err := db.Client.DepositSplit.
+		Query().
+		Where(depositsplit.TxHashEQ(event.TxHash)).
+		Only(ctx)
+	if err == nil {
+		if existing.Status == depositsplit.StatusRejected {
+			return nil, nil
+		}
+		return existing, nil
+	} else if !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("DetectDepositSplit.db: %w", err)
+	}
+
+	// A transfer that pays one of the orders is not a split
+	for _, order := range orders {
+		if depositMatches(event.Value, orderDepositTotal(order)) {
+			return nil, nil
+		}
+	}
+
+	candidates := make([]*ent.PaymentOrder, 0, len(orders))
+	for _, order := range orders {
+		if order.Edges.SenderProfile != nil && order.Edges.Token != nil {
+			candidates = append(candidates, order)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].CreatedAt.Before(candidates[j].CreatedAt)
+	})
+
+	for i := 0; i < len(candidates); i++ {
+		for j := i + 1; j < len(candidates); j++ {
+			first, second := candidates[i], candidates[j]
+			if first.Edges.SenderProfile.ID != second.Edges.SenderProfile.ID || first.Edges.Token.ID != second.Edges.Token.ID {
+				continue
+			}
+
+			if !depositMatches(event.Value, orderDepositTotal(first).Add(orderDepositTotal(second))) {
+				continue
+			}
+
+			split, err := db.Client.DepositSplit.
+				Create().
+				SetTxHash(event.TxHash).
+				SetNetwork(first.Edges.Token.Edges.Network.Identifier).
+				SetReceiveAddress(event.To).
+				SetFromAddress(event.From).
+				SetAmount(event.Value).
+				SetBlockNumber(event.BlockNumber).
+				AddPaymentOrders(first, second).
+				Save(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("DetectDepositSplit.create: %w", err)
+			}
+
+			logger.WithFields(logger.Fields{
+				"SplitID":        split.ID,
+				"TxHash":         event.TxHash,
+				"Value":          event.Value,
+				"ReceiveAddress": event.To,
+				"OrderIDs":       []string{first.ID.String(), second.ID.String()},
+			}).Warnf("Deposit matches the sum of two orders, holding for confirmation")
+
+			err = services.NewSlackService(config.ServerConfig().SlackWebhookURL).SendAlert("Deposit split needs confirmation", map[string]string{
+				"Split ID":        split.ID.String(),
+				"Network":         split.Network,
+				"Tx Hash":         event.TxHash,
+				"Amount":          event.Value.String(),
+				"Receive Address": event.To,
+				"From":            services.FormatAddress(services.NewENSService().DisplayName(ctx, first.Edges.Token.Edges.Network, event.From), event.From),
+				"Orders":          fmt.Sprintf("%s, %s", first.ID, second.ID),
+			})
+			if err != nil {
+				logger.Errorf("Failed to send deposit split alert: %v", err)
+			}
+
+			return split, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// fetchPendingDepositSplit fetches a pending split with its orders, oldest order first
+func fetchPendingDepositSplit(ctx context.Context, splitID uuid.UUID) (*ent.DepositSplit, error) {
+	split, err := db.Client.DepositSplit.
+		Query().
+		Where(depositsplit.IDEQ(splitID)).
+		WithPaymentOrders(func(poq *ent.PaymentOrderQuery) {
+			poq.WithToken(func(tq *ent.TokenQuery) {
+				tq.WithNetwork()
+			}).
+				WithReceiveAddress().
+				WithRecipient().
+				WithSenderProfile().
+				Order(ent.Asc(paymentorder.FieldCreatedAt))
+		}).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if split.Status != depositsplit.StatusPending {
+		return nil, ErrDepositSplitResolved
+	}
+
+	for _, order := range split.Edges.PaymentOrders {
+		if order.Status != paymentorder.StatusInitiated || order.TxHash != "" {
+			return nil, fmt.Errorf("order %s is no longer awaiting payment", order.ID)
+		}
+	}
+
+	return split, nil
+}
+
+// claimDepositSplit resolves a pending split with status, failing with ErrDepositSplitResolved
+// when a concurrent confirmation or rejection resolved it first
+func claimDepositSplit(ctx context.Context, splitID uuid.UUID, status depositsplit.Status) (*ent.DepositSplit, error) {
+	claimed, err := db.Client.DepositSplit.
+		Update().
+		Where(
+			depositsplit.IDEQ(splitID),
+			depositsplit.StatusEQ(depositsplit.StatusPending),
+		).
+		SetStatus(status).
+		SetResolvedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if claimed == 0 {
+		return nil, ErrDepositSplitResolved
+	}
+
+	return db.Client.DepositSplit.Get(ctx, splitID)
+}
+
+// ConfirmDepositSplit allocates a split deposit across its orders. Each order is credited with its
+// deposit total and the last order with the rest of the deposit. The allocations are credited like
+// any other deposit, so senders are screened and orders wait for the network's confirmations or
+// finality before they are created on-chain.
+func ConfirmDepositSplit(
+	ctx context.Context,
+	splitID uuid.UUID,
+	createOrder func(ctx context.Context, orderID uuid.UUID) error,
+	getProviderRate func(ctx context.Context, providerProfile *ent.ProviderProfile, tokenSymbol string, currency string) (decimal.Decimal, error),
+) (*ent.DepositSplit, error) {
+	pending, err := fetchPendingDepositSplit(ctx, splitID)
+	if err != nil {
+		return nil, err
+	}
+	orders := pending.Edges.PaymentOrders
+
+	split, err := claimDepositSplit(ctx, splitID, depositsplit.StatusConfirmed)
+	if err != nil {
+		return nil, err
+	}
+
+	var creditErrors []string
+	allocated := decimal.Zero
+	for i, order := range orders {
+		allocation := orderDepositTotal(order)
+		if i == len(orders)-1 {
+			allocation = split.Amount.Sub(allocated)
+		}
+		allocated = allocated.Add(allocation)
+
+		if order.Edges.ReceiveAddress == nil {
+			creditErrors = append(creditErrors, fmt.Sprintf("%s: order has no receive address", order.ID))
+			continue
+		}
+
+		event := &types.TokenTransferEvent{
+			BlockNumber: split.BlockNumber,
+			TxHash:      split.TxHash,
+			From:        split.FromAddress,
+			To:          order.Edges.ReceiveAddress.Address,
+			Value:       allocation,
+		}
+		_, err := creditDeposit(ctx, order.Edges.ReceiveAddress, order, event, split, createOrder, getProviderRate)
+		if err != nil {
+			creditErrors = append(creditErrors, fmt.Sprintf("%s: %v", order.ID, err))
+		}
+	}
+
+	logger.WithFields(logger.Fields{
+		"SplitID": split.ID,
+		"TxHash":  split.TxHash,
+		"Orders":  len(orders),
+	}).Infof("Deposit split confirmed")
+
+	if len(creditErrors) > 0 {
+		return split, fmt.Errorf("ConfirmDepositSplit.credit: %s", strings.Join(creditErrors, "; "))
+	}
+
+	return split, nil
+}
+
+// RejectDepositSplit marks a split as rejected and processes the deposit as a regular payment of
+// the oldest order, which is what would have happened without split detection
+func RejectDepositSplit(
+	ctx context.Context,
+	splitID uuid.UUID,
+	createOrder func(ctx context.Context, orderID uuid.UUID) error,
+	getProviderRate func(ctx context.Context, providerProfile *ent.ProviderProfile, tokenSymbol string, currency string) (decimal.Decimal, error),
+) (*ent.DepositSplit, error) {
+	split, err := fetchPendingDepositSplit(ctx, splitID)
+	if err != nil {
+		return nil, err
+	}
+	orders := split.Edges.PaymentOrders
+
+	split, err = claimDepositSplit(ctx, splitID, depositsplit.StatusRejected)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(orders) == 0 || orders[0].Edges.ReceiveAddress == nil {
+		return split, nil
+	}
+
+	event := &types.TokenTransferEvent{
+		BlockNumber: split.BlockNumber,
+		TxHash:      split.TxHash,
+		From:        split.FromAddress,
+		To:          split.ReceiveAddress,
+		Value:       split.Amount,
+	}
+
+	_, err = UpdateReceiveAddressStatus(ctx, orders[0].Edges.ReceiveAddress, orders[0], event, createOrder, getProviderRate)
+	if err != nil {
+		return split, fmt.Errorf("RejectDepositSplit.UpdateReceiveAddressStatus: %w", err)
+	}
+
+	return split, nil
+}
@@ -0,0 +1,231 @@
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/depositsplit"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/NEDA-LABS/stablenode/utils/test"
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+const splitTestAddress = "0x1111111111111111111111111111111111111111"
+
+// setupDepositSplit creates two orders of one sender and one order of another sender on the same pool address
+func setupDepositSplit(t *testing.T, ctx context.Context) []*ent.PaymentOrder {
+	token, err := test.CreateERC20Token(nil, map[string]interface{}{
+		"symbol":         "USDC",
+		"identifier":     "base-sepolia",
+		"chainID":        int64(84532),
+		"deployContract": false,
+	})
+	assert.NoError(t, err)
+	network := token.Edges.Network.Update().SetFee(decimal.NewFromFloat(0.5)).SaveX(ctx)
+	token.Edges.Network = network
+
+	var senders []*ent.SenderProfile
+	for _, email := range []string{"first@test.com", "second@test.com"} {
+		user, err := test.CreateTestUser(map[string]interface{}{
+			"email": email,
+		})
+		assert.NoError(t, err)
+
+		sender, err := test.CreateTestSenderProfile(map[string]interface{}{
+			"user_id": user.ID,
+			"token":   token.Symbol,
+		})
+		assert.NoError(t, err)
+		senders = append(senders, sender)
+	}
+
+	var orders []*ent.PaymentOrder
+	for _, row := range []struct {
+		amount float64
+		sender *ent.SenderProfile
+	}{
+		{10, senders[0]},
+		{20, senders[0]},
+		{15, senders[1]},
+	} {
+		receiveAddress, err := db.Client.ReceiveAddress.
+			Create().
+			SetAddress(splitTestAddress).
+			SetStatus(receiveaddress.StatusPoolAssigned).
+			SetIsDeployed(true).
+			SetNetworkIdentifier(network.Identifier).
+			SetValidUntil(time.Now().Add(time.Hour)).
+			Save(ctx)
+		assert.NoError(t, err)
+
+		order, err := test.CreateTestPaymentOrder(nil, token, map[string]interface{}{
+			"sender":          row.sender,
+			"receive_address": receiveAddress,
+			"amount":          row.amount,
+			"amount_in_usd":   row.amount,
+			"rate":            1500.0,
+			"status":          "initiated",
+		})
+		assert.NoError(t, err)
+		orders = append(orders, order)
+	}
+
+	loaded, err := db.Client.PaymentOrder.
+		Query().
+		WithToken(func(tq *ent.TokenQuery) {
+			tq.WithNetwork()
+		}).
+		WithReceiveAddress().
+		WithSenderProfile().
+		Order(ent.Asc(paymentorder.FieldCreatedAt)).
+		All(ctx)
+	assert.NoError(t, err)
+
+	return loaded
+}
+
+func TestDepositSplit(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:depositsplit?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	ctx := context.Background()
+	orders := setupDepositSplit(t, ctx)
+
+	transfer := func(txHash string, value float64) *types.TokenTransferEvent {
+		return &types.TokenTransferEvent{
+			BlockNumber: 100,
+			TxHash:      txHash,
+			From:        "0x2222222222222222222222222222222222222222",
+			To:          splitTestAddress,
+			Value:       decimal.NewFromFloat(value),
+		}
+	}
+
+	t.Run("should not hold a deposit that pays a single order", func(t *testing.T) {
+		split, err := DetectDepositSplit(ctx, orders, transfer("0xsingle", 20.5))
+		assert.NoError(t, err)
+		assert.Nil(t, split)
+	})
+
+	t.Run("should not pair orders of different senders", func(t *testing.T) {
+		// 20.5 + 15.5 matches the second sender's order plus one of the first sender's
+		split, err := DetectDepositSplit(ctx, orders, transfer("0xcross", 36))
+		assert.NoError(t, err)
+		assert.Nil(t, split)
+	})
+
+	var split *ent.DepositSplit
+
+	t.Run("should hold a deposit that pays two orders of one sender", func(t *testing.T) {
+		var err error
+		split, err = DetectDepositSplit(ctx, orders, transfer("0xsplit", 31))
+		assert.NoError(t, err)
+		if assert.NotNil(t, split) {
+			assert.Equal(t, depositsplit.StatusPending, split.Status)
+			orderIDs := split.QueryPaymentOrders().IDsX(ctx)
+			assert.ElementsMatch(t, []uuid.UUID{orders[0].ID, orders[1].ID}, orderIDs)
+		}
+
+		// The same transfer indexed again returns the existing split
+		again, err := DetectDepositSplit(ctx, orders, transfer("0xsplit", 31))
+		assert.NoError(t, err)
+		if assert.NotNil(t, again) {
+			assert.Equal(t, split.ID, again.ID)
+		}
+	})
+
+	t.Run("should allocate the deposit across both orders on confirm", func(t *testing.T) {
+		var created []uuid.UUID
+		createOrder := func(ctx context.Context, orderID uuid.UUID) error {
+			created = append(created, orderID)
+			return nil
+		}
+
+		confirmed, err := ConfirmDepositSplit(ctx, split.ID, createOrder, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, depositsplit.StatusConfirmed, confirmed.Status)
+		assert.False(t, confirmed.ResolvedAt.IsZero())
+		assert.ElementsMatch(t, []uuid.UUID{orders[0].ID, orders[1].ID}, created)
+
+		first := client.PaymentOrder.GetX(ctx, orders[0].ID)
+		second := client.PaymentOrder.GetX(ctx, orders[1].ID)
+		assert.True(t, first.AmountPaid.Equal(decimal.NewFromFloat(10.5)))
+		assert.True(t, second.AmountPaid.Equal(decimal.NewFromFloat(20.5)))
+		assert.Equal(t, paymentorder.StatusPending, first.Status)
+		assert.Equal(t, "0xsplit", second.TxHash)
+
+		logs, err := client.TransactionLog.Query().Where(transactionlog.TxHashEQ("0xsplit")).Count(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, logs)
+
+		_, err = ConfirmDepositSplit(ctx, split.ID, createOrder, nil)
+		assert.ErrorIs(t, err, ErrDepositSplitResolved)
+	})
+}
+
+func TestDepositSplitCreditPath(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:depositsplitcredit?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	ctx := context.Background()
+	orders := setupDepositSplit(t, ctx)
+
+	// Deposits on the network are only final under three confirmations
+	network := orders[0].Edges.Token.Edges.Network.Update().SetRequiredConfirmations(3).SaveX(ctx)
+	for _, order := range orders {
+		order.Edges.Token.Edges.Network = network
+	}
+
+	var created []uuid.UUID
+	createOrder := func(ctx context.Context, orderID uuid.UUID) error {
+		created = append(created, orderID)
+		return nil
+	}
+
+	split, err := DetectDepositSplit(ctx, orders, &types.TokenTransferEvent{
+		BlockNumber: 100,
+		TxHash:      "0xsplit",
+		From:        "0x2222222222222222222222222222222222222222",
+		To:          splitTestAddress,
+		Value:       decimal.NewFromFloat(31),
+	})
+	assert.NoError(t, err)
+	if !assert.NotNil(t, split) {
+		return
+	}
+
+	t.Run("should hold confirmed allocations for their confirmations", func(t *testing.T) {
+		_, err := ConfirmDepositSplit(ctx, split.ID, createOrder, nil)
+		assert.NoError(t, err)
+		assert.Empty(t, created)
+
+		for _, order := range orders[:2] {
+			credited := client.PaymentOrder.GetX(ctx, order.ID)
+			assert.Equal(t, paymentorder.StatusAwaitingConfirmations, credited.Status)
+			assert.Equal(t, 3, credited.RequiredConfirmations)
+			assert.Equal(t, "0xsplit", credited.TxHash)
+		}
+
+		logs, err := client.TransactionLog.Query().Where(transactionlog.TxHashEQ("0xsplit")).Count(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, logs)
+	})
+
+	t.Run("should not reject a split that was confirmed", func(t *testing.T) {
+		_, err := claimDepositSplit(ctx, split.ID, depositsplit.StatusRejected)
+		assert.ErrorIs(t, err, ErrDepositSplitResolved)
+		assert.Equal(t, depositsplit.StatusConfirmed, client.DepositSplit.GetX(ctx, split.ID).Status)
+	})
+}
@@ -0,0 +1,138 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/fiatcurrency"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils"
+	"github.com/shopspring/decimal"
+)
+
+// Outcomes of converting the fiat amount of an order against its locked rate band
+const (
+	// fiatConversionWithinBand converts at the current rate, which is within the band
+	fiatConversionWithinBand = "within_band"
+	// fiatConversionCapped converts at the upper edge of the band, the rate having risen above it
+	fiatConversionCapped = "capped"
+	// fiatConversionBelowBand converts at the current rate, the rate having fallen below the band,
+	// and holds the order for review since the sender owes more than the band allowed for
+	fiatConversionBelowBand = "below_band"
+)
+
+// fiatConversion is the conversion of the fiat amount of an order to tokens at payment time
+type fiatConversion struct {
+	FiatAmount   decimal.Decimal
+	FiatCurrency string
+	LockedRate   decimal.Decimal
+	CurrentRate  decimal.Decimal
+	AppliedRate  decimal.Decimal
+	// Drift is the change of the current rate from the locked rate, as a signed fraction
+	Drift        decimal.Decimal
+	Tolerance    decimal.Decimal
+	QuotedAmount decimal.Decimal
+	TokenAmount  decimal.Decimal
+	Outcome      string
+	ConvertedAt  time.Time
+}
+
+// convertFiatAmount converts the fiat amount of an order to tokens at the current rate, bounded by
+// the rate band locked when the order was created
+func convertFiatAmount(order *ent.PaymentOrder, currentRate decimal.Decimal, decimals int32) *fiatConversion {
+	lockedRate := order.Rate
+	tolerance := order.RateDriftTolerance
+	lower := lockedRate.Mul(decimal.NewFromInt(1).Sub(tolerance))
+	upper := lockedRate.Mul(decimal.NewFromInt(1).Add(tolerance))
+
+	conversion := &fiatConversion{
+		FiatAmount:   *order.FiatAmount,
+		FiatCurrency: order.FiatCurrency,
+		LockedRate:   lockedRate,
+		CurrentRate:  currentRate,
+		AppliedRate:  currentRate,
+		Drift:        currentRate.Sub(lockedRate).Div(lockedRate).Round(6),
+		Tolerance:    tolerance,
+		QuotedAmount: order.Amount,
+		Outcome:      fiatConversionWithinBand,
+		ConvertedAt:  time.Now(),
+	}
+
+	switch {
+	case currentRate.GreaterThan(upper):
+		conversion.AppliedRate = upper
+		conversion.Outcome = fiatConversionCapped
+	case currentRate.LessThan(lower):
+		conversion.Outcome = fiatConversionBelowBand
+	}
+
+	conversion.TokenAmount = conversion.FiatAmount.Div(conversion.AppliedRate).Round(decimals)
+	return conversion
+}
+
+// record returns the conversion as stored on the order
+func (c *fiatConversion) record() map[string]interface{} {
+	return map[string]interface{}{
+		"fiatAmount":   c.FiatAmount.String(),
+		"fiatCurrency": c.FiatCurrency,
+		"lockedRate":   c.LockedRate.String(),
+		"currentRate":  c.CurrentRate.String(),
+		"appliedRate":  c.AppliedRate.String(),
+		"drift":        c.Drift.String(),
+		"tolerance":    c.Tolerance.String(),
+		"quotedAmount": c.QuotedAmount.String(),
+		"tokenAmount":  c.TokenAmount.String(),
+		"outcome":      c.Outcome,
+		"convertedAt":  c.ConvertedAt.Format(time.RFC3339),
+	}
+}
+
+// reviewReason returns why the order is held for review, or "" when the conversion is accepted
+func (c *fiatConversion) reviewReason() string {
+	if c.Outcome != fiatConversionBelowBand {
+		return ""
+	}
+	return fmt.Sprintf("Rate drifted %s%% from the locked rate %s, beyond the %s%% band",
+		c.Drift.Mul(decimal.NewFromInt(100)).StringFixed(2), c.LockedRate, c.Tolerance.Mul(decimal.NewFromInt(100)).String())
+}
+
+// applyFiatConversion converts the fiat amount of an order when its first deposit is seen, and
+// updates the amount, rate and fees of the order in memory to the converted ones
+func applyFiatConversion(ctx context.Context, paymentOrder *ent.PaymentOrder) (*fiatConversion, error) {
+	token := paymentOrder.Edges.Token
+
+	currency, err := db.Client.FiatCurrency.
+		Query().
+		Where(fiatcurrency.CodeEQ(paymentOrder.FiatCurrency)).
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch fiat currency %s: %w", paymentOrder.FiatCurrency, err)
+	}
+
+	providerID := ""
+	if paymentOrder.Edges.Recipient != nil {
+		providerID = paymentOrder.Edges.Recipient.ProviderID
+	}
+
+	var currentRate decimal.Decimal
+	err = callWithinBudget(ctx, "ValidateRate", rateFetchBudget, false, func(ctx context.Context) (err error) {
+		currentRate, err = utils.ValidateRate(ctx, token, currency, paymentOrder.Amount, providerID, token.Edges.Network.Identifier)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch rate: %w", err)
+	}
+
+	conversion := convertFiatAmount(paymentOrder, currentRate, int32(token.Decimals))
+
+	if !paymentOrder.Amount.IsZero() {
+		paymentOrder.AmountInUsd = paymentOrder.AmountInUsd.Mul(conversion.TokenAmount).Div(paymentOrder.Amount)
+	}
+	paymentOrder.Amount = conversion.TokenAmount
+	paymentOrder.Rate = conversion.AppliedRate
+	paymentOrder.SenderFee = paymentOrder.FeePercent.Mul(conversion.TokenAmount).Div(decimal.NewFromInt(100)).Round(4)
+
+	return conversion, nil
+}
@@ -0,0 +1,118 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/NEDA-LABS/stablenode/utils/test"
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertFiatAmount(t *testing.T) {
+	fiatAmount := decimal.NewFromInt(150000)
+	order := &ent.PaymentOrder{
+		Amount:             decimal.NewFromInt(100),
+		Rate:               decimal.NewFromInt(1500),
+		FiatAmount:         &fiatAmount,
+		FiatCurrency:       "NGN",
+		RateDriftTolerance: decimal.NewFromFloat(0.02),
+	}
+
+	for _, test := range []struct {
+		name        string
+		currentRate int64
+		appliedRate string
+		tokenAmount string
+		outcome     string
+		review      bool
+	}{
+		{"converts at the current rate within the band", 1485, "1485", "101.010101", fiatConversionWithinBand, false},
+		{"caps the rate at the upper edge of the band", 1600, "1530", "98.039216", fiatConversionCapped, false},
+		{"holds orders for review below the band", 1400, "1400", "107.142857", fiatConversionBelowBand, true},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conversion := convertFiatAmount(order, decimal.NewFromInt(test.currentRate), 6)
+
+			assert.Equal(t, test.appliedRate, conversion.AppliedRate.String())
+			assert.Equal(t, test.tokenAmount, conversion.TokenAmount.String())
+			assert.Equal(t, test.outcome, conversion.Outcome)
+			assert.Equal(t, test.review, conversion.reviewReason() != "")
+			assert.True(t, conversion.QuotedAmount.Equal(order.Amount))
+		})
+	}
+}
+
+func TestFiatOrderPayment(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:fiatorders?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	ctx := context.Background()
+	order := setupDepositSplit(t, ctx)[0]
+
+	// A token based on the order's fiat currency converts at a rate of 1
+	token, err := order.Edges.Token.Update().
+		SetBaseCurrency("NGN").
+		Save(ctx)
+	assert.NoError(t, err)
+	token.Edges.Network = order.Edges.Token.Edges.Network
+
+	_, err = test.CreateTestFiatCurrency(map[string]interface{}{
+		"market_rate": 1500.0,
+	})
+	assert.NoError(t, err)
+
+	fiatAmount := decimal.NewFromInt(10)
+	order, err = order.Update().
+		SetAmount(decimal.NewFromFloat(9.9)).
+		SetRate(decimal.NewFromInt(1)).
+		SetNillableFiatAmount(&fiatAmount).
+		SetFiatCurrency("NGN").
+		SetRateDriftTolerance(decimal.NewFromFloat(0.02)).
+		Save(ctx)
+	assert.NoError(t, err)
+
+	loaded, err := db.Client.PaymentOrder.
+		Query().
+		Where(paymentorder.IDEQ(order.ID)).
+		WithReceiveAddress().
+		WithSenderProfile().
+		Only(ctx)
+	assert.NoError(t, err)
+	loaded.Edges.Token = token
+
+	var created []uuid.UUID
+	createOrder := func(ctx context.Context, orderID uuid.UUID) error {
+		created = append(created, orderID)
+		return nil
+	}
+
+	done, err := UpdateReceiveAddressStatus(ctx, loaded.Edges.ReceiveAddress, loaded, &types.TokenTransferEvent{
+		BlockNumber: 100,
+		TxHash:      "0xf1",
+		From:        "0x2222222222222222222222222222222222222222",
+		To:          splitTestAddress,
+		Value:       decimal.NewFromFloat(10.5),
+	}, createOrder, nil)
+	assert.NoError(t, err)
+	assert.True(t, done)
+	assert.Equal(t, []uuid.UUID{order.ID}, created)
+
+	updated, err := db.Client.PaymentOrder.Get(ctx, order.ID)
+	assert.NoError(t, err)
+	assert.True(t, updated.Amount.Equal(decimal.NewFromInt(10)))
+	assert.True(t, updated.Rate.Equal(decimal.NewFromInt(1)))
+	assert.Equal(t, paymentorder.StatusPending, updated.Status)
+	assert.Equal(t, fiatConversionWithinBand, updated.FiatConversion["outcome"])
+	assert.Equal(t, "9.9", updated.FiatConversion["quotedAmount"])
+	assert.Equal(t, "10", updated.FiatConversion["tokenAmount"])
+	assert.Empty(t, updated.ReviewReason)
+}
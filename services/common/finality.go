@@ -0,0 +1,147 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/google/uuid"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	networkent "github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+)
+
+// ProcessDepositFinality marks soft-confirmed deposits as finalized once they are buried under their
+// network's finality window, and creates the orders that opted to settle on finality. An order whose
+// creation fails goes back to soft-confirmed, so the next run finalizes and creates it again
+func ProcessDepositFinality(ctx context.Context, createOrder func(ctx context.Context, orderID uuid.UUID) error) error {
+	orders, err := db.Client.PaymentOrder.
+		Query().
+		Where(
+			paymentorder.DepositStatusEQ(paymentorder.DepositStatusSoftConfirmed),
+			paymentorder.TxHashNEQ(""),
+		).
+		WithToken(func(tq *ent.TokenQuery) {
+			tq.WithNetwork()
+		}).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("ProcessDepositFinality.fetchOrders: %w", err)
+	}
+
+	ordersByNetwork := make(map[int][]*ent.PaymentOrder)
+	networks := make(map[int]*ent.Network)
+	for _, order := range orders {
+		network := order.Edges.Token.Edges.Network
+		networks[network.ID] = network
+		ordersByNetwork[network.ID] = append(ordersByNetwork[network.ID], order)
+	}
+
+	for networkID, networkOrders := range ordersByNetwork {
+		network := networks[networkID]
+
+		// Tron and Solana deposits are treated as final on inclusion
+		if strings.HasPrefix(network.Identifier, "tron") || network.NetworkType == networkent.NetworkTypeSolana {
+			continue
+		}
+
+		client, err := dialConfirmationsClient(network)
+		if err != nil {
+			logger.WithFields(logger.Fields{
+				"Error":   fmt.Sprintf("%v", err),
+				"Network": network.Identifier,
+			}).Errorf("ProcessDepositFinality.dial")
+			continue
+		}
+
+		header, err := client.HeaderByNumber(ctx, nil)
+		if err != nil {
+			logger.WithFields(logger.Fields{
+				"Error":   fmt.Sprintf("%v", err),
+				"Network": network.Identifier,
+			}).Errorf("ProcessDepositFinality.HeaderByNumber")
+			continue
+		}
+		finalizedBlock := header.Number.Int64() - int64(network.FinalityBlocks)
+
+		for _, order := range networkOrders {
+			if order.BlockNumber > finalizedBlock {
+				continue
+			}
+
+			err := finalizeDeposit(ctx, client, finalizedBlock, order, createOrder)
+			if err != nil {
+				logger.WithFields(logger.Fields{
+					"Error":   fmt.Sprintf("%v", err),
+					"OrderID": order.ID.String(),
+					"TxHash":  order.TxHash,
+					"Network": network.Identifier,
+				}).Errorf("ProcessDepositFinality.finalizeDeposit")
+			}
+		}
+	}
+
+	return nil
+}
+
+// finalizeDeposit finalizes the deposit of an order once it is at or below finalizedBlock on the
+// canonical chain
+func finalizeDeposit(ctx context.Context, client confirmationsClient, finalizedBlock int64, order *ent.PaymentOrder, createOrder func(ctx context.Context, orderID uuid.UUID) error) error {
+	// Make sure the deposit survived any reorg before treating it as final
+	receipt, err := client.TransactionReceipt(ctx, ethcommon.HexToHash(order.TxHash))
+	if err != nil {
+		return fmt.Errorf("soft-confirmed deposit not found on canonical chain: %w", err)
+	}
+	if receipt.Status != ethtypes.ReceiptStatusSuccessful {
+		return fmt.Errorf("soft-confirmed deposit reverted")
+	}
+	if receipt.BlockNumber.Int64() != order.BlockNumber {
+		// Re-included in another block, wait for that block to be final
+		if err := order.Update().SetBlockNumber(receipt.BlockNumber.Int64()).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to update block number: %w", err)
+		}
+		if receipt.BlockNumber.Int64() > finalizedBlock {
+			return nil
+		}
+	}
+
+	// Finalizing claims the order, so concurrent runs never create it twice
+	finalized, err := db.Client.PaymentOrder.
+		Update().
+		Where(
+			paymentorder.IDEQ(order.ID),
+			paymentorder.DepositStatusEQ(paymentorder.DepositStatusSoftConfirmed),
+		).
+		SetDepositStatus(paymentorder.DepositStatusFinalized).
+		SetDepositFinalizedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to finalize deposit: %w", err)
+	}
+	if finalized == 0 {
+		return nil
+	}
+
+	if order.SettlementPolicy != paymentorder.SettlementPolicyFinality || order.Status != paymentorder.StatusPending || order.GatewayID != "" {
+		return nil
+	}
+
+	if err := createOrder(ctx, order.ID); err != nil {
+		if resetErr := db.Client.PaymentOrder.
+			UpdateOneID(order.ID).
+			SetDepositStatus(paymentorder.DepositStatusSoftConfirmed).
+			ClearDepositFinalizedAt().
+			Exec(ctx); resetErr != nil {
+			return fmt.Errorf("failed to create order: %w; failed to reset deposit status: %v", err, resetErr)
+		}
+		return fmt.Errorf("failed to create order: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/test"
+)
+
+func TestDepositFinality(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:finality?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	ctx := context.Background()
+
+	token, err := test.CreateERC20Token(nil, map[string]interface{}{
+		"symbol":         "USDC",
+		"identifier":     "base-sepolia",
+		"chainID":        int64(84532),
+		"deployContract": false,
+	})
+	assert.NoError(t, err)
+	token.Edges.Network = token.Edges.Network.Update().SetFinalityBlocks(5).SaveX(ctx)
+
+	chain := &stubConfirmationsClient{head: 20, receipts: map[ethcommon.Hash]int64{}}
+	defaultDial := dialConfirmationsClient
+	defer func() { dialConfirmationsClient = defaultDial }()
+	dialConfirmationsClient = func(network *ent.Network) (confirmationsClient, error) {
+		return chain, nil
+	}
+
+	var created []uuid.UUID
+	var createErr error
+	createOrder := func(ctx context.Context, orderID uuid.UUID) error {
+		if createErr != nil {
+			return createErr
+		}
+		created = append(created, orderID)
+		return nil
+	}
+
+	createDeposit := func(txHash string, block int64, policy paymentorder.SettlementPolicy) *ent.PaymentOrder {
+		receiveAddress, err := client.ReceiveAddress.
+			Create().
+			SetAddress("0x1111111111111111111111111111111111111111").
+			SetStatus(receiveaddress.StatusUsed).
+			SetValidUntil(time.Now().Add(time.Hour)).
+			Save(ctx)
+		assert.NoError(t, err)
+
+		order, err := test.CreateTestPaymentOrder(nil, token, map[string]interface{}{
+			"receive_address": receiveAddress,
+			"amount":          10.0,
+			"amount_in_usd":   10.0,
+			"amount_paid":     10.0,
+			"rate":            130.0,
+		})
+		assert.NoError(t, err)
+
+		chain.receipts[ethcommon.HexToHash(txHash)] = block
+		return client.PaymentOrder.
+			UpdateOne(order).
+			SetTxHash(txHash).
+			SetBlockNumber(block).
+			SetSettlementPolicy(policy).
+			SetDepositStatus(paymentorder.DepositStatusSoftConfirmed).
+			SaveX(ctx)
+	}
+
+	finalized := createDeposit("0xf1", 10, paymentorder.SettlementPolicyFinality)
+	recent := createDeposit("0xf2", 18, paymentorder.SettlementPolicyFinality)
+	reorged := createDeposit("0xf3", 10, paymentorder.SettlementPolicyFinality)
+	delete(chain.receipts, ethcommon.HexToHash("0xf3"))
+	softConfirm := createDeposit("0xf4", 10, paymentorder.SettlementPolicySoftConfirm)
+
+	t.Run("should hold orders settling on finality until their deposit is final", func(t *testing.T) {
+		network := token.Edges.Network
+		assert.True(t, AwaitingDepositFinality(finalized, network))
+		assert.False(t, AwaitingDepositFinality(softConfirm, network))
+		assert.False(t, AwaitingDepositFinality(finalized, &ent.Network{}))
+	})
+
+	assert.NoError(t, ProcessDepositFinality(ctx, createOrder))
+
+	t.Run("should finalize buried deposits and create orders settling on finality", func(t *testing.T) {
+		order := client.PaymentOrder.GetX(ctx, finalized.ID)
+		assert.Equal(t, paymentorder.DepositStatusFinalized, order.DepositStatus)
+		assert.False(t, order.DepositFinalizedAt.IsZero())
+		assert.Equal(t, []uuid.UUID{finalized.ID}, created)
+		assert.False(t, AwaitingDepositFinality(order, token.Edges.Network))
+	})
+
+	t.Run("should finalize orders settling on soft confirmation without creating them", func(t *testing.T) {
+		order := client.PaymentOrder.GetX(ctx, softConfirm.ID)
+		assert.Equal(t, paymentorder.DepositStatusFinalized, order.DepositStatus)
+		assert.NotContains(t, created, softConfirm.ID)
+	})
+
+	t.Run("should wait for deposits within the finality window or off the canonical chain", func(t *testing.T) {
+		for _, deposit := range []*ent.PaymentOrder{recent, reorged} {
+			order := client.PaymentOrder.GetX(ctx, deposit.ID)
+			assert.Equal(t, paymentorder.DepositStatusSoftConfirmed, order.DepositStatus)
+			assert.NotContains(t, created, deposit.ID)
+		}
+	})
+
+	t.Run("should retry orders whose creation failed", func(t *testing.T) {
+		failing := createDeposit("0xf5", 10, paymentorder.SettlementPolicyFinality)
+
+		createErr = errors.New("paymaster unavailable")
+		assert.NoError(t, ProcessDepositFinality(ctx, createOrder))
+
+		order := client.PaymentOrder.GetX(ctx, failing.ID)
+		assert.Equal(t, paymentorder.DepositStatusSoftConfirmed, order.DepositStatus)
+		assert.True(t, order.DepositFinalizedAt.IsZero())
+		assert.NotContains(t, created, failing.ID)
+
+		createErr = nil
+		assert.NoError(t, ProcessDepositFinality(ctx, createOrder))
+
+		order = client.PaymentOrder.GetX(ctx, failing.ID)
+		assert.Equal(t, paymentorder.DepositStatusFinalized, order.DepositStatus)
+		assert.Contains(t, created, failing.ID)
+		assert.Len(t, created, 2)
+	})
+}
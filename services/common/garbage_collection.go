@@ -0,0 +1,245 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/lockpaymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/senderprofile"
+	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
+	"github.com/NEDA-LABS/stablenode/ent/webhookretryattempt"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+)
+
+// OrphanStats counts the orphaned rows found and deleted by a garbage collection run
+type OrphanStats struct {
+	// TransactionLogs are logs linked to no order, left behind when linking them failed
+	TransactionLogs int `json:"transactionLogs"`
+	// TransactionLogsRetained are unlinked logs kept because their gateway ID or tx hash still matches an order
+	TransactionLogsRetained int `json:"transactionLogsRetained"`
+	TransactionLogsDeleted  int `json:"transactionLogsDeleted"`
+	// WebhookAttempts are retry attempts to webhook URLs no sender uses anymore
+	WebhookAttempts        int       `json:"webhookAttempts"`
+	WebhookAttemptsDeleted int       `json:"webhookAttemptsDeleted"`
+	DryRun                 bool      `json:"dryRun"`
+	StartedAt              time.Time `json:"startedAt"`
+	DurationMs             int64     `json:"durationMs"`
+}
+
+// lastOrphanStats holds the stats of the latest garbage collection run
+var lastOrphanStats atomic.Pointer[OrphanStats]
+
+// LastOrphanStats returns the stats of the latest garbage collection run, or nil if none ran yet
+func LastOrphanStats() *OrphanStats {
+	return lastOrphanStats.Load()
+}
+
+// CollectOrphans deletes transaction logs linked to no order and webhook retry attempts to webhooks
+// that no longer exist. Only rows older than the grace period are collected, so writes still in
+// flight are never raced
+func CollectOrphans(ctx context.Context) (*OrphanStats, error) {
+	gcConf := config.GarbageCollectionConfig()
+	stats := &OrphanStats{
+		DryRun:    gcConf.DryRun,
+		StartedAt: time.Now(),
+	}
+	cutoff := stats.StartedAt.Add(-gcConf.GracePeriod)
+
+	err := collectOrphanedTransactionLogs(ctx, cutoff, gcConf, stats)
+	if err != nil {
+		return nil, fmt.Errorf("CollectOrphans.transactionLogs: %w", err)
+	}
+
+	err = collectOrphanedWebhookAttempts(ctx, cutoff, gcConf, stats)
+	if err != nil {
+		return nil, fmt.Errorf("CollectOrphans.webhookAttempts: %w", err)
+	}
+
+	stats.DurationMs = time.Since(stats.StartedAt).Milliseconds()
+	lastOrphanStats.Store(stats)
+
+	logger.WithFields(logger.Fields{
+		"TransactionLogs":         stats.TransactionLogs,
+		"TransactionLogsRetained": stats.TransactionLogsRetained,
+		"TransactionLogsDeleted":  stats.TransactionLogsDeleted,
+		"WebhookAttempts":         stats.WebhookAttempts,
+		"WebhookAttemptsDeleted":  stats.WebhookAttemptsDeleted,
+		"DryRun":                  stats.DryRun,
+		"DurationMs":              stats.DurationMs,
+	}).Infof("Orphaned rows collected")
+
+	return stats, nil
+}
+
+// collectOrphanedTransactionLogs deletes transaction logs linked to neither a payment order nor a lock order
+func collectOrphanedTransactionLogs(ctx context.Context, cutoff time.Time, gcConf *config.GarbageCollectionConfiguration, stats *OrphanStats) error {
+	after := uuid.Nil
+	for {
+		logs, err := db.Client.TransactionLog.
+			Query().
+			Where(
+				transactionlog.CreatedAtLT(cutoff),
+				transactionlog.IDGT(after),
+				func(s *sql.Selector) {
+					s.Where(sql.And(
+						sql.IsNull(s.C(paymentorder.TransactionsColumn)),
+						sql.IsNull(s.C(lockpaymentorder.TransactionsColumn)),
+					))
+				},
+			).
+			Order(ent.Asc(transactionlog.FieldID)).
+			Limit(gcConf.BatchSize).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to fetch transaction logs: %w", err)
+		}
+		if len(logs) == 0 {
+			return nil
+		}
+		after = logs[len(logs)-1].ID
+
+		referenced, err := referencedByOrders(ctx, logs)
+		if err != nil {
+			return err
+		}
+
+		var orphanIDs []uuid.UUID
+		for _, log := range logs {
+			if referenced[log.GatewayID] || referenced[log.TxHash] {
+				stats.TransactionLogsRetained++
+				continue
+			}
+			orphanIDs = append(orphanIDs, log.ID)
+		}
+		stats.TransactionLogs += len(orphanIDs)
+
+		if !gcConf.DryRun && len(orphanIDs) > 0 {
+			deleted, err := db.Client.TransactionLog.
+				Delete().
+				Where(transactionlog.IDIn(orphanIDs...)).
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to delete transaction logs: %w", err)
+			}
+			stats.TransactionLogsDeleted += deleted
+		}
+
+		if len(logs) < gcConf.BatchSize {
+			return nil
+		}
+	}
+}
+
+// referencedByOrders returns the gateway IDs and tx hashes of the given logs that an order still carries.
+// Such logs may yet be relinked to their order, so they are never collected
+func referencedByOrders(ctx context.Context, logs []*ent.TransactionLog) (map[string]bool, error) {
+	var keys []string
+	for _, log := range logs {
+		if log.GatewayID != "" {
+			keys = append(keys, log.GatewayID)
+		}
+		if log.TxHash != "" {
+			keys = append(keys, log.TxHash)
+		}
+	}
+
+	referenced := make(map[string]bool)
+	if len(keys) == 0 {
+		return referenced, nil
+	}
+
+	paymentOrders, err := db.Client.PaymentOrder.
+		Query().
+		Where(paymentorder.Or(
+			paymentorder.GatewayIDIn(keys...),
+			paymentorder.TxHashIn(keys...),
+		)).
+		Select(paymentorder.FieldGatewayID, paymentorder.FieldTxHash).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch payment orders: %w", err)
+	}
+	for _, order := range paymentOrders {
+		referenced[order.GatewayID] = true
+		referenced[order.TxHash] = true
+	}
+
+	lockOrders, err := db.Client.LockPaymentOrder.
+		Query().
+		Where(lockpaymentorder.Or(
+			lockpaymentorder.GatewayIDIn(keys...),
+			lockpaymentorder.TxHashIn(keys...),
+		)).
+		Select(lockpaymentorder.FieldGatewayID, lockpaymentorder.FieldTxHash).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch lock orders: %w", err)
+	}
+	for _, order := range lockOrders {
+		referenced[order.GatewayID] = true
+		referenced[order.TxHash] = true
+	}
+
+	delete(referenced, "")
+	return referenced, nil
+}
+
+// collectOrphanedWebhookAttempts deletes webhook retry attempts to URLs that no sender has as its webhook anymore,
+// left behind when a sender changed or removed its webhook or was deleted
+func collectOrphanedWebhookAttempts(ctx context.Context, cutoff time.Time, gcConf *config.GarbageCollectionConfiguration, stats *OrphanStats) error {
+	webhookURLs, err := db.Client.SenderProfile.
+		Query().
+		Where(senderprofile.WebhookURLNEQ("")).
+		Unique(true).
+		Select(senderprofile.FieldWebhookURL).
+		Strings(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch sender webhooks: %w", err)
+	}
+
+	after := 0
+	for {
+		attemptIDs, err := db.Client.WebhookRetryAttempt.
+			Query().
+			Where(
+				webhookretryattempt.CreatedAtLT(cutoff),
+				webhookretryattempt.IDGT(after),
+				webhookretryattempt.WebhookURLNotIn(webhookURLs...),
+			).
+			Order(ent.Asc(webhookretryattempt.FieldID)).
+			Limit(gcConf.BatchSize).
+			IDs(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to fetch webhook retry attempts: %w", err)
+		}
+		if len(attemptIDs) == 0 {
+			return nil
+		}
+		after = attemptIDs[len(attemptIDs)-1]
+		stats.WebhookAttempts += len(attemptIDs)
+
+		if !gcConf.DryRun {
+			deleted, err := db.Client.WebhookRetryAttempt.
+				Delete().
+				Where(webhookretryattempt.IDIn(attemptIDs...)).
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to delete webhook retry attempts: %w", err)
+			}
+			stats.WebhookAttemptsDeleted += deleted
+		}
+
+		if len(attemptIDs) < gcConf.BatchSize {
+			return nil
+		}
+	}
+}
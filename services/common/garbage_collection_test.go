@@ -0,0 +1,103 @@
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollectOrphans(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:orphans?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	ctx := context.Background()
+	orders := setupDepositSplit(t, ctx)
+	order, err := orders[0].Update().SetTxHash("0xdeposit").Save(ctx)
+	assert.NoError(t, err)
+
+	viper.Set("ORPHAN_GC_GRACE_PERIOD", 24)
+	viper.Set("ORPHAN_GC_BATCH_SIZE", 2)
+	viper.Set("ORPHAN_GC_DRY_RUN", false)
+
+	old := time.Now().Add(-48 * time.Hour)
+	createLog := func(txHash string, createdAt time.Time) *ent.TransactionLog {
+		log, err := client.TransactionLog.
+			Create().
+			SetStatus(transactionlog.StatusCryptoDeposited).
+			SetTxHash(txHash).
+			SetMetadata(map[string]interface{}{}).
+			SetCreatedAt(createdAt).
+			Save(ctx)
+		assert.NoError(t, err)
+		return log
+	}
+
+	linked := createLog("0xlinked", old)
+	_, err = order.Update().AddTransactions(linked).Save(ctx)
+	assert.NoError(t, err)
+	recent := createLog("0xrecent", time.Now())
+	referenced := createLog("0xdeposit", old)
+	var orphans []*ent.TransactionLog
+	for _, txHash := range []string{"0xorphan1", "0xorphan2", "0xorphan3"} {
+		orphans = append(orphans, createLog(txHash, old))
+	}
+
+	createAttempt := func(webhookURL string, createdAt time.Time) *ent.WebhookRetryAttempt {
+		attempt, err := client.WebhookRetryAttempt.
+			Create().
+			SetAttemptNumber(1).
+			SetPayload(map[string]interface{}{}).
+			SetWebhookURL(webhookURL).
+			SetCreatedAt(createdAt).
+			Save(ctx)
+		assert.NoError(t, err)
+		return attempt
+	}
+
+	liveAttempt := createAttempt("https://example.com/hook", old)
+	recentAttempt := createAttempt("https://example.com/removed", time.Now())
+	orphanAttempt := createAttempt("https://example.com/removed", old)
+
+	t.Run("counts orphans without deleting them in a dry run", func(t *testing.T) {
+		viper.Set("ORPHAN_GC_DRY_RUN", true)
+		defer viper.Set("ORPHAN_GC_DRY_RUN", false)
+
+		stats, err := CollectOrphans(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, stats.TransactionLogs)
+		assert.Equal(t, 1, stats.TransactionLogsRetained)
+		assert.Equal(t, 0, stats.TransactionLogsDeleted)
+		assert.Equal(t, 1, stats.WebhookAttempts)
+		assert.Equal(t, 0, stats.WebhookAttemptsDeleted)
+		assert.Equal(t, 6, client.TransactionLog.Query().CountX(ctx))
+	})
+
+	t.Run("deletes orphans past the grace period", func(t *testing.T) {
+		stats, err := CollectOrphans(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, stats.TransactionLogsDeleted)
+		assert.Equal(t, 1, stats.WebhookAttemptsDeleted)
+		assert.Equal(t, stats, LastOrphanStats())
+
+		for _, log := range orphans {
+			assert.False(t, client.TransactionLog.Query().Where(transactionlog.IDEQ(log.ID)).ExistX(ctx))
+		}
+		for _, log := range []*ent.TransactionLog{linked, recent, referenced} {
+			assert.True(t, client.TransactionLog.Query().Where(transactionlog.IDEQ(log.ID)).ExistX(ctx))
+		}
+
+		assert.NotNil(t, client.WebhookRetryAttempt.GetX(ctx, liveAttempt.ID))
+		assert.NotNil(t, client.WebhookRetryAttempt.GetX(ctx, recentAttempt.ID))
+		_, err = client.WebhookRetryAttempt.Get(ctx, orphanAttempt.ID)
+		assert.True(t, ent.IsNotFound(err))
+	})
+}
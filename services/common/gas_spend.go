@@ -0,0 +1,193 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/shopspring/decimal"
+)
+
+// Groupings of the gas spend report
+const (
+	GasSpendGroupByOrder   = "order"
+	GasSpendGroupByNetwork = "network"
+	GasSpendGroupByPurpose = "purpose"
+	GasSpendGroupByPolicy  = "policy"
+)
+
+// GasSpendUnattributed is the key of the gas spent by UserOperations without an order, purpose or
+// gas policy in the grouping
+const GasSpendUnattributed = "unattributed"
+
+// gasSpendRow is the gas spent by the mined UserOperations of a group on a network
+type gasSpendRow struct {
+	Key                 string          `json:"key"`
+	Network             string          `json:"network"`
+	Operations          int             `json:"operations"`
+	SponsoredOperations int             `json:"sponsored_operations"`
+	GasUsed             int64           `json:"gas_used"`
+	SponsoredGasUsed    int64           `json:"sponsored_gas_used"`
+	GasCost             decimal.Decimal `json:"gas_cost"`
+	SponsoredGasCost    decimal.Decimal `json:"sponsored_gas_cost"`
+}
+
+// add folds the gas spend of another group on the same network into r
+func (r *gasSpendRow) add(other gasSpendRow) {
+	r.Operations += other.Operations
+	r.SponsoredOperations += other.SponsoredOperations
+	r.GasUsed += other.GasUsed
+	r.SponsoredGasUsed += other.SponsoredGasUsed
+	r.GasCost = r.GasCost.Add(other.GasCost)
+	r.SponsoredGasCost = r.SponsoredGasCost.Add(other.SponsoredGasCost)
+}
+
+// bucket builds the response for the gas spend, deriving what the senders paid themselves. Costs
+// are stored as floats, so they are rounded to 12 decimals to drop the error of summing them
+func (r *gasSpendRow) bucket(key string) types.AdminGasSpendBucket {
+	gasCost, sponsoredGasCost := r.GasCost.Round(12), r.SponsoredGasCost.Round(12)
+	return types.AdminGasSpendBucket{
+		Key:                  key,
+		Network:              r.Network,
+		Operations:           r.Operations,
+		SponsoredOperations:  r.SponsoredOperations,
+		SelfFundedOperations: r.Operations - r.SponsoredOperations,
+		GasUsed:              r.GasUsed,
+		SponsoredGasUsed:     r.SponsoredGasUsed,
+		SelfFundedGasUsed:    r.GasUsed - r.SponsoredGasUsed,
+		GasCost:              gasCost,
+		SponsoredGasCost:     sponsoredGasCost,
+		SelfFundedGasCost:    gasCost.Sub(sponsoredGasCost),
+	}
+}
+
+// GasSpend aggregates the gas charged for the mined UserOperations sent from from until to, per
+// network and grouped by payment order, network, purpose or gas policy. Native tokens differ between
+// networks, so every group is split by network and totals are per network. The result is cached
+// for ADMIN_STATS_CACHE_TTL
+func GasSpend(ctx context.Context, from, to time.Time, groupBy string) (*types.AdminGasSpendResponse, error) {
+	cacheKey := fmt.Sprintf("admin_gas_spend_%s_%d_%d", groupBy, from.Unix(), to.Unix())
+	if db.RedisClient != nil {
+		if data, err := db.RedisClient.Get(ctx, cacheKey).Bytes(); err == nil {
+			var cached types.AdminGasSpendResponse
+			if json.Unmarshal(data, &cached) == nil {
+				return &cached, nil
+			}
+		}
+	}
+
+	var rows []gasSpendRow
+	err := db.Client.TransactionLog.
+		Query().
+		Where(
+			transactionlog.StatusEQ(transactionlog.StatusUserOperationSent),
+			transactionlog.GasUsedNotNil(),
+			transactionlog.CreatedAtGTE(from),
+			transactionlog.CreatedAtLT(to),
+		).
+		Aggregate(
+			gasSpendGroup(gasSpendColumn(groupBy), "key"),
+			gasSpendGroup(transactionlog.FieldNetwork, "network"),
+			ent.As(ent.Count(), "operations"),
+			sumIfSponsored("sponsored_operations", sqlConstant("1")),
+			ent.As(ent.Sum(transactionlog.FieldGasUsed), "gas_used"),
+			sumIfSponsored("sponsored_gas_used", func(s *sql.Selector) string {
+				return s.C(transactionlog.FieldGasUsed)
+			}),
+			ent.As(ent.Sum(transactionlog.FieldGasCost), "gas_cost"),
+			sumIfSponsored("sponsored_gas_cost", func(s *sql.Selector) string {
+				return s.C(transactionlog.FieldGasCost)
+			}),
+		).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("GasSpend.aggregate: %w", err)
+	}
+
+	response := &types.AdminGasSpendResponse{
+		From:    from,
+		To:      to,
+		GroupBy: groupBy,
+		Totals:  []types.AdminGasSpendBucket{},
+		Groups:  make([]types.AdminGasSpendBucket, 0, len(rows)),
+	}
+
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].Key != rows[j].Key {
+			return rows[i].Key < rows[j].Key
+		}
+		return rows[i].Network < rows[j].Network
+	})
+	totals := make(map[string]*gasSpendRow)
+	var networks []string
+	for _, row := range rows {
+		key := row.Key
+		if key == "" {
+			key = GasSpendUnattributed
+		}
+		response.Groups = append(response.Groups, row.bucket(key))
+
+		if _, ok := totals[row.Network]; !ok {
+			totals[row.Network] = &gasSpendRow{Network: row.Network}
+			networks = append(networks, row.Network)
+		}
+		totals[row.Network].add(row)
+	}
+	sort.Strings(networks)
+	for _, network := range networks {
+		response.Totals = append(response.Totals, totals[network].bucket(""))
+	}
+
+	if db.RedisClient != nil {
+		data, _ := json.Marshal(response)
+		if err := db.RedisClient.Set(ctx, cacheKey, data, config.ServerConfig().AdminStatsCacheTTL).Err(); err != nil {
+			logger.WithFields(logger.Fields{
+				"Error": err.Error(),
+				"Key":   cacheKey,
+			}).Warnf("Failed to cache gas spend")
+		}
+	}
+
+	return response, nil
+}
+
+// gasSpendColumn is the transaction log column a gas spend grouping keys on
+func gasSpendColumn(groupBy string) string {
+	switch groupBy {
+	case GasSpendGroupByOrder:
+		return transactionlog.FieldPaymentOrderID
+	case GasSpendGroupByPurpose:
+		return transactionlog.FieldPurpose
+	case GasSpendGroupByPolicy:
+		return transactionlog.FieldGasPolicyID
+	}
+	return transactionlog.FieldNetwork
+}
+
+// gasSpendGroup selects a column as text, empty when unset, and groups the aggregation by it
+func gasSpendGroup(column, alias string) ent.AggregateFunc {
+	return func(s *sql.Selector) string {
+		expr := fmt.Sprintf("COALESCE(CAST(%s AS TEXT), '')", s.C(column))
+		s.GroupBy(expr)
+		return sql.As(expr, alias)
+	}
+}
+
+// sumIfSponsored sums an expression over the operations a paymaster paid for
+func sumIfSponsored(alias string, expr func(*sql.Selector) string) ent.AggregateFunc {
+	return func(s *sql.Selector) string {
+		return sql.As(fmt.Sprintf(
+			"COALESCE(SUM(CASE WHEN %s THEN %s ELSE 0 END), 0)",
+			s.C(transactionlog.FieldSponsored), expr(s),
+		), alias)
+	}
+}
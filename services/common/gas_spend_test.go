@@ -0,0 +1,135 @@
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGasSpend(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:gasspend?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	mr, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer mr.Close()
+	db.RedisClient = redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer func() { db.RedisClient = nil }()
+
+	ctx := context.Background()
+	day := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	orderID := uuid.New()
+
+	createLog := func(network string, purpose transactionlog.Purpose, orderID *uuid.UUID, sponsored bool, gasUsed int64, gasCost string, createdAt time.Time) {
+		create := client.TransactionLog.
+			Create().
+			SetStatus(transactionlog.StatusUserOperationSent).
+			SetNetwork(network).
+			SetMetadata(map[string]interface{}{}).
+			SetPurpose(purpose).
+			SetNillablePaymentOrderID(orderID).
+			SetSponsored(sponsored).
+			SetCreatedAt(createdAt)
+		if sponsored {
+			create.SetGasPolicyID("policy-1")
+		}
+		if gasUsed > 0 {
+			create.
+				SetGasUsed(gasUsed).
+				SetGasCost(decimal.RequireFromString(gasCost))
+		}
+		_, err := create.Save(ctx)
+		assert.NoError(t, err)
+	}
+
+	createLog("base", transactionlog.PurposeOrderCreation, &orderID, true, 100000, "0.001", day.Add(time.Hour))
+	createLog("base", transactionlog.PurposeSettlement, &orderID, true, 50000, "0.0005", day.Add(2*time.Hour))
+	createLog("base", transactionlog.PurposeDeployment, nil, false, 300000, "0.003", day.Add(3*time.Hour))
+	createLog("polygon", transactionlog.PurposeSweep, nil, false, 40000, "0.2", day.Add(4*time.Hour))
+
+	// Unmined, and outside the range
+	createLog("base", transactionlog.PurposeRefund, &orderID, true, 0, "", day.Add(5*time.Hour))
+	createLog("base", transactionlog.PurposeRefund, &orderID, true, 60000, "0.0006", day.AddDate(0, 0, 5))
+
+	from, to := day, day.AddDate(0, 0, 3)
+
+	t.Run("totals per network", func(t *testing.T) {
+		spend, err := GasSpend(ctx, from, to, GasSpendGroupByNetwork)
+		assert.NoError(t, err)
+
+		if assert.Len(t, spend.Totals, 2) {
+			base := spend.Totals[0]
+			assert.Equal(t, "base", base.Network)
+			assert.Equal(t, 3, base.Operations)
+			assert.Equal(t, 2, base.SponsoredOperations)
+			assert.Equal(t, 1, base.SelfFundedOperations)
+			assert.Equal(t, int64(450000), base.GasUsed)
+			assert.Equal(t, int64(150000), base.SponsoredGasUsed)
+			assert.True(t, base.SponsoredGasCost.Equal(decimal.RequireFromString("0.0015")), base.SponsoredGasCost.String())
+			assert.True(t, base.SelfFundedGasCost.Equal(decimal.RequireFromString("0.003")), base.SelfFundedGasCost.String())
+
+			assert.Equal(t, "polygon", spend.Totals[1].Network)
+			assert.True(t, spend.Totals[1].GasCost.Equal(decimal.RequireFromString("0.2")), spend.Totals[1].GasCost.String())
+		}
+		if assert.Len(t, spend.Groups, 2) {
+			assert.Equal(t, "base", spend.Groups[0].Key)
+			assert.Equal(t, "polygon", spend.Groups[1].Key)
+		}
+	})
+
+	t.Run("groups by order", func(t *testing.T) {
+		spend, err := GasSpend(ctx, from, to, GasSpendGroupByOrder)
+		assert.NoError(t, err)
+
+		groups := map[string]int64{}
+		for _, group := range spend.Groups {
+			groups[group.Key+"/"+group.Network] = group.GasUsed
+		}
+		assert.Equal(t, map[string]int64{
+			orderID.String() + "/base":        150000,
+			GasSpendUnattributed + "/base":    300000,
+			GasSpendUnattributed + "/polygon": 40000,
+		}, groups)
+	})
+
+	t.Run("groups by purpose and policy", func(t *testing.T) {
+		spend, err := GasSpend(ctx, from, to, GasSpendGroupByPurpose)
+		assert.NoError(t, err)
+		if assert.Len(t, spend.Groups, 4) {
+			assert.Equal(t, "deployment", spend.Groups[0].Key)
+			assert.Equal(t, int64(300000), spend.Groups[0].SelfFundedGasUsed)
+		}
+
+		spend, err = GasSpend(ctx, from, to, GasSpendGroupByPolicy)
+		assert.NoError(t, err)
+		if assert.Len(t, spend.Groups, 3) {
+			assert.Equal(t, GasSpendUnattributed, spend.Groups[0].Key)
+			assert.Equal(t, "policy-1", spend.Groups[2].Key)
+			assert.Equal(t, 2, spend.Groups[2].SponsoredOperations)
+		}
+	})
+
+	t.Run("caches results", func(t *testing.T) {
+		createLog("base", transactionlog.PurposeSweep, nil, false, 10000, "0.0001", day.Add(6*time.Hour))
+
+		spend, err := GasSpend(ctx, from, to, GasSpendGroupByNetwork)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, spend.Totals[0].Operations)
+
+		mr.FlushAll()
+		spend, err = GasSpend(ctx, from, to, GasSpendGroupByNetwork)
+		assert.NoError(t, err)
+		assert.Equal(t, 4, spend.Totals[0].Operations)
+	})
+}
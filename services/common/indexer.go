@@ -2,13 +2,14 @@ package common
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
-	"sync"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/deadletter"
 	"github.com/NEDA-LABS/stablenode/ent/fiatcurrency"
 	"github.com/NEDA-LABS/stablenode/ent/linkedaddress"
 	"github.com/NEDA-LABS/stablenode/ent/lockpaymentorder"
@@ -20,13 +21,14 @@ import (
 	"github.com/NEDA-LABS/stablenode/ent/senderprofile"
 	tokenent "github.com/NEDA-LABS/stablenode/ent/token"
 	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
-	"github.com/NEDA-LABS/stablenode/ent/user"
 	"github.com/NEDA-LABS/stablenode/services"
+	"github.com/NEDA-LABS/stablenode/services/ledger"
 	"github.com/NEDA-LABS/stablenode/storage"
 	db "github.com/NEDA-LABS/stablenode/storage"
 	"github.com/NEDA-LABS/stablenode/types"
 	"github.com/NEDA-LABS/stablenode/utils"
 	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/NEDA-LABS/stablenode/utils/workerpool"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 )
@@ -39,6 +41,10 @@ func ProcessReceiveAddresses(
 	unknownAddresses []string,
 	addressToEvent map[string]*types.TokenTransferEvent,
 ) error {
+	// Keep the latency budget of a webhook delivery, but outlive its cancellation, so deposits are
+	// not dropped halfway when the provider disconnects
+	ctx = context.WithoutCancel(ctx)
+
 	logger.WithFields(logger.Fields{
 		"UnknownAddresses": unknownAddresses,
 		"AddressCount":     len(unknownAddresses),
@@ -60,15 +66,7 @@ func ProcessReceiveAddresses(
 		Query().
 		Where(
 			paymentorder.HasReceiveAddressWith(
-				receiveaddress.Or(
-					receiveaddress.StatusEQ(receiveaddress.StatusUnused),
-					receiveaddress.StatusEQ(receiveaddress.StatusPoolAssigned),
-				),
-				receiveaddress.Or(
-					// Pool addresses may have NULL valid_until
-					receiveaddress.ValidUntilIsNil(),
-					receiveaddress.ValidUntilGT(time.Now()),
-				),
+				acceptsDeposits(),
 				receiveaddress.Or(
 					func(s *sql.Selector) {
 						// Case-insensitive address matching
@@ -94,6 +92,7 @@ func ProcessReceiveAddresses(
 		}).
 		WithReceiveAddress().
 		WithRecipient().
+		WithSenderProfile().
 		All(ctx)
 	if err != nil {
 		logger.WithFields(logger.Fields{
@@ -106,30 +105,81 @@ func ProcessReceiveAddresses(
 		"OrdersFound": len(orders),
 	}).Info("Orders found matching criteria")
 
-	var wg sync.WaitGroup
+	// A recycled address may also hold orders assigned to it after a deposit was mined; only the
+	// orders the address served at the deposit's block time can be credited with it
+	windows, err := assignmentWindows(ctx, storage.Client, orders)
+	if err != nil {
+		return fmt.Errorf("processReceiveAddresses.fetchAssignments: %w", err)
+	}
+	times := depositTimes(ctx, orders, addressToEvent)
+
+	ordersByAddress := make(map[string][]*ent.PaymentOrder)
 	for _, order := range orders {
-		receiveAddress := order.Edges.ReceiveAddress
-		wg.Add(1)
-		go func(order *ent.PaymentOrder, receiveAddress *ent.ReceiveAddress) {
-			defer wg.Done()
-			// Case-insensitive lookup in addressToEvent map
-			var transferEvent *types.TokenTransferEvent
-			var ok bool
-			for addr, event := range addressToEvent {
-				if strings.EqualFold(addr, receiveAddress.Address) {
-					transferEvent = event
-					ok = true
-					break
-				}
+		key := strings.ToLower(order.Edges.ReceiveAddress.Address)
+		ordersByAddress[key] = append(ordersByAddress[key], order)
+	}
+
+	// A single transfer may pay two of a sender's orders sharing the address;
+	// hold those orders for a confirmable split instead of overcrediting one.
+	// Otherwise the transfer is credited to exactly one order
+	var recipients []*ent.PaymentOrder
+	for addr, event := range addressToEvent {
+		key := strings.ToLower(addr)
+		addressOrders := activeOrdersAt(ordersByAddress[key], windows, times[key])
+		if len(addressOrders) == 0 {
+			if len(ordersByAddress[key]) > 0 {
+				logger.WithFields(logger.Fields{
+					"ReceiveAddress": addr,
+					"TxHash":         event.TxHash,
+					"DepositTime":    times[key],
+				}).Warnf("Deposit predates the orders assigned to its receive address")
 			}
-			if !ok {
+			continue
+		}
+
+		if len(addressOrders) >= 2 {
+			split, err := DetectDepositSplit(ctx, addressOrders, event)
+			if err != nil {
 				logger.WithFields(logger.Fields{
-					"ReceiveAddress": receiveAddress.Address,
-					"OrderID":        order.ID.String(),
-				}).Warn("No transfer event found for receive address in addressToEvent map")
-				return
+					"Error":          err.Error(),
+					"ReceiveAddress": addr,
+					"TxHash":         event.TxHash,
+				}).Errorf("Failed to check deposit split")
+			} else if split != nil {
+				continue
 			}
+		}
+
+		recipients = append(recipients, depositRecipient(addressOrders, windows, event))
+	}
 
+	groups := make(map[string]*workerpool.Group)
+	for _, order := range recipients {
+		receiveAddress := order.Edges.ReceiveAddress
+		networkIdentifier := order.Edges.Token.Edges.Network.Identifier
+
+		// Case-insensitive lookup in addressToEvent map
+		var transferEvent *types.TokenTransferEvent
+		for addr, event := range addressToEvent {
+			if strings.EqualFold(addr, receiveAddress.Address) {
+				transferEvent = event
+				break
+			}
+		}
+		if transferEvent == nil {
+			logger.WithFields(logger.Fields{
+				"ReceiveAddress": receiveAddress.Address,
+				"OrderID":        order.ID.String(),
+			}).Warn("No transfer event found for receive address in addressToEvent map")
+			continue
+		}
+
+		group, ok := groups[networkIdentifier]
+		if !ok {
+			group = workerpool.Default().Group(ctx, networkIdentifier)
+			groups[networkIdentifier] = group
+		}
+		err := group.Go(func() {
 			logger.WithFields(logger.Fields{
 				"ReceiveAddress": receiveAddress.Address,
 				"OrderID":        order.ID.String(),
@@ -138,6 +188,14 @@ func ProcessReceiveAddresses(
 			}).Info("Updating receive address status")
 
 			_, err := UpdateReceiveAddressStatus(ctx, order.Edges.ReceiveAddress, order, transferEvent, orderService.CreateOrder, priorityQueueService.GetProviderRate)
+			if errors.Is(err, ErrOverBudget) {
+				logger.WithFields(logger.Fields{
+					"Error":          fmt.Sprintf("%v", err),
+					"OrderID":        order.ID.String(),
+					"ReceiveAddress": receiveAddress.Address,
+				}).Warn("Deferred receive address update that exceeded the webhook latency budget")
+				return
+			}
 			if err != nil {
 				if !strings.Contains(fmt.Sprintf("%v", err), "Duplicate payment order") && !strings.Contains(fmt.Sprintf("%v", err), "Receive address not found") {
 					logger.WithFields(logger.Fields{
@@ -145,6 +203,7 @@ func ProcessReceiveAddresses(
 						"OrderID": order.ID.String(),
 						"ReceiveAddress": receiveAddress.Address,
 					}).Errorf("Failed to update receive address status when indexing ERC20 transfers for %s", order.Edges.Token.Edges.Network.Identifier)
+					RecordDeadLetter(ctx, deadletter.StageReceiveAddress, order.ID.String(), order.Edges.Token.Edges.Network.Identifier, transferEvent, "", err)
 				} else {
 					logger.WithFields(logger.Fields{
 						"Error":   fmt.Sprintf("%v", err),
@@ -158,9 +217,20 @@ func ProcessReceiveAddresses(
 				"ReceiveAddress": receiveAddress.Address,
 				"OrderID":        order.ID.String(),
 			}).Info("Successfully updated receive address status")
-		}(order, receiveAddress)
+		})
+		if err != nil {
+			// Deposits that never reached a worker are retried from the dead letter queue
+			logger.WithFields(logger.Fields{
+				"Error":          fmt.Sprintf("%v", err),
+				"OrderID":        order.ID.String(),
+				"ReceiveAddress": receiveAddress.Address,
+			}).Warn("Failed to dispatch receive address update to an event worker")
+			RecordDeadLetter(ctx, deadletter.StageReceiveAddress, order.ID.String(), networkIdentifier, transferEvent, "", err)
+		}
+	}
+	for _, group := range groups {
+		group.Wait()
 	}
-	wg.Wait()
 	return nil
 }
 
@@ -182,12 +252,11 @@ func ProcessLinkedAddresses(ctx context.Context, orderService types.OrderService
 		return nil
 	}
 
-	var wg sync.WaitGroup
+	// Keep the latency budget of a webhook delivery, but outlive its cancellation
+	ctx = context.WithoutCancel(ctx)
+	group := workerpool.Default().Group(ctx, token.Edges.Network.Identifier)
 	for _, linkedAddress := range linkedAddresses {
-		wg.Add(1)
-		go func(linkedAddress *ent.LinkedAddress) {
-			defer wg.Done()
-			ctx := context.Background()
+		_ = group.Go(func() {
 			transferEvent, ok := addressToEvent[linkedAddress.Address]
 			if !ok {
 				return
@@ -226,8 +295,27 @@ func ProcessLinkedAddresses(ctx context.Context, orderService types.OrderService
 				return
 			}
 
+			// Enforce the restrictions of the linked address
+			if err := CheckLinkedAddressTransfer(ctx, linkedAddress, token, orderAmount); err != nil {
+				logger.WithFields(logger.Fields{
+					"Error":         fmt.Sprintf("%v", err),
+					"LinkedAddress": linkedAddress.Address,
+					"Token":         token.Symbol,
+					"Amount":        orderAmount,
+					"TxHash":        transferEvent.TxHash,
+				}).Warnf("Rejected transfer to linked address when indexing ERC20 transfers for %s", token.Edges.Network.Identifier)
+				return
+			}
+
+			// Senders are screened before an order is created for their transfer
+			quarantineReason := screenDepositSender(ctx, token.Edges.Network, transferEvent.From)
+
 			// Create payment order
-			institution, err := utils.GetInstitutionByCode(ctx, linkedAddress.Institution, true)
+			var institution *ent.Institution
+			err = callWithinBudget(ctx, "GetInstitutionByCode", institutionLookupBudget, false, func(ctx context.Context) (err error) {
+				institution, err = utils.GetInstitutionByCode(ctx, linkedAddress.Institution, true)
+				return err
+			})
 			if err != nil {
 				logger.WithFields(logger.Fields{
 					"Error":                    fmt.Sprintf("%v", err),
@@ -243,7 +331,10 @@ func ProcessLinkedAddresses(ctx context.Context, orderService types.OrderService
 			}
 			var rateResponse decimal.Decimal
 			if !strings.EqualFold(token.BaseCurrency, institution.Edges.FiatCurrency.Code) {
-				rateResponse, err = utils.GetTokenRateFromQueue(token.Symbol, orderAmount, institution.Edges.FiatCurrency.Code, institution.Edges.FiatCurrency.MarketRate)
+				err = callWithinBudget(ctx, "GetTokenRateFromQueue", rateFetchBudget, false, func(ctx context.Context) (err error) {
+					rateResponse, err = utils.GetTokenRateFromQueue(token.Symbol, orderAmount, institution.Edges.FiatCurrency.Code, institution.Edges.FiatCurrency.MarketRate)
+					return err
+				})
 				if err != nil {
 					logger.WithFields(logger.Fields{
 						"Error":                    fmt.Sprintf("%v", err),
@@ -266,7 +357,7 @@ func ProcessLinkedAddresses(ctx context.Context, orderService types.OrderService
 				return
 			}
 
-			order, err := storage.Client.PaymentOrder.
+			orderCreate := tx.PaymentOrder.
 				Create().
 				SetAmount(orderAmount).
 				SetAmountPaid(orderAmount).
@@ -282,8 +373,13 @@ func ProcessLinkedAddresses(ctx context.Context, orderService types.OrderService
 				SetLinkedAddress(linkedAddress).
 				SetReceiveAddressText(linkedAddress.Address).
 				SetFeePercent(decimal.NewFromInt(0)).
-				SetReturnAddress(linkedAddress.Address).
-				Save(ctx)
+				SetReturnAddress(linkedAddress.Address)
+			if quarantineReason != "" {
+				orderCreate = orderCreate.
+					SetStatus(paymentorder.StatusQuarantined).
+					SetQuarantineReason(quarantineReason)
+			}
+			order, err := orderCreate.Save(ctx)
 			if err != nil {
 				logger.WithFields(logger.Fields{
 					"Error":         fmt.Sprintf("%v", err),
@@ -310,6 +406,16 @@ func ProcessLinkedAddresses(ctx context.Context, orderService types.OrderService
 				return
 			}
 
+			order.Edges.Token = token
+			if err := ledger.Record(ctx, tx, ledger.Deposit(order, transferEvent.TxHash, orderAmount)); err != nil {
+				logger.WithFields(logger.Fields{
+					"Error":         fmt.Sprintf("%v", err),
+					"LinkedAddress": linkedAddress.Address,
+				}).Errorf("Failed to book deposit when indexing ERC20 transfers for %s", token.Edges.Network.Identifier)
+				_ = tx.Rollback()
+				return
+			}
+
 			_, err = tx.LinkedAddress.
 				UpdateOneID(linkedAddress.ID).
 				SetTxHash(transferEvent.TxHash).
@@ -332,17 +438,28 @@ func ProcessLinkedAddresses(ctx context.Context, orderService types.OrderService
 				return
 			}
 
-			err = orderService.CreateOrder(ctx, order.ID)
+			if quarantineReason != "" {
+				sendQuarantineAlert(order, token.Edges.Network, transferEvent.From, transferEvent.TxHash, quarantineReason)
+				return
+			}
+
+			err = callWithinBudget(ctx, "CreateOrder", paymasterBudget, true, func(ctx context.Context) error {
+				return orderService.CreateOrder(ctx, order.ID)
+			})
 			if err != nil {
+				if errors.Is(err, ErrOverBudget) {
+					// Order creation carries on in the background
+					return
+				}
 				logger.WithFields(logger.Fields{
 					"Error":   fmt.Sprintf("%v", err),
 					"OrderID": order.ID.String(),
 				}).Errorf("Failed to create order when indexing ERC20 transfers for %s", token.Edges.Network.Identifier)
 				return
 			}
-		}(linkedAddress)
+		})
 	}
-	wg.Wait()
+	group.Wait()
 
 	return nil
 }
@@ -366,6 +483,11 @@ func ProcessTransfers(
 		return err
 	}
 
+	// Hold the transfers no order could be credited with for review
+	if err := RecordUnmatchedDeposits(ctx, addressToEvent, token); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -378,7 +500,7 @@ func ProcessCreatedOrders(
 	orderService types.OrderService,
 	priorityQueueService *services.PriorityQueueService,
 ) error {
-	var wg sync.WaitGroup
+	group := workerpool.Default().Group(ctx, network.Identifier)
 
 	for _, orderId := range orderIds {
 		createdEvent, ok := orderIdToEvent[orderId]
@@ -386,10 +508,7 @@ func ProcessCreatedOrders(
 			continue
 		}
 
-		wg.Add(1)
-		go func(createdEvent *types.OrderCreatedEvent) {
-			defer wg.Done()
-
+		err := group.Go(func() {
 			err := CreateLockPaymentOrder(ctx, network, createdEvent, orderService.RefundOrder, priorityQueueService.AssignLockPaymentOrder)
 			if err != nil {
 				if !strings.Contains(fmt.Sprintf("%v", err), "duplicate key value violates unique constraint") {
@@ -399,12 +518,17 @@ func ProcessCreatedOrders(
 						"TxHash":  createdEvent.TxHash,
 						"Network": network.Identifier,
 					}).Errorf("Failed to create lock payment order when indexing order created events for %s", network.Identifier)
+					RecordDeadLetter(ctx, deadletter.StageOrderCreated, createdEvent.OrderId, network.Identifier, createdEvent, "", err)
 				}
 				return
 			}
-		}(createdEvent)
+		})
+		if err != nil {
+			group.Wait()
+			return fmt.Errorf("ProcessCreatedOrders: %w", err)
+		}
 	}
-	wg.Wait()
+	group.Wait()
 
 	return nil
 }
@@ -444,30 +568,32 @@ func ProcessSettledOrders(ctx context.Context, network *ent.Network, orderIds []
 		"LockOrders": lockOrderDetails,
 	}).Info("Processing settled orders")
 
-	var wg sync.WaitGroup
+	group := workerpool.Default().Group(ctx, network.Identifier)
 	for _, lockOrder := range lockOrders {
 		settledEvent, ok := orderIdToEvent[lockOrder.GatewayID]
 		if !ok {
 			continue
 		}
 
-		wg.Add(1)
-		go func(lo *ent.LockPaymentOrder, se *types.OrderSettledEvent) {
-			defer wg.Done()
-
+		err := group.Go(func() {
 			// Update order status
-			err := UpdateOrderStatusSettled(ctx, network, se, lockOrder.MessageHash)
+			err := UpdateOrderStatusSettled(ctx, network, settledEvent, lockOrder.MessageHash)
 			if err != nil {
 				logger.WithFields(logger.Fields{
 					"Error":   fmt.Sprintf("%v", err),
-					"OrderID": se.OrderId,
-					"TxHash":  se.TxHash,
+					"OrderID": settledEvent.OrderId,
+					"TxHash":  settledEvent.TxHash,
 					"Network": network.Identifier,
 				}).Errorf("Failed to update order status settlement when indexing order settled events for %s", network.Identifier)
+				RecordDeadLetter(ctx, deadletter.StageOrderSettled, settledEvent.OrderId, network.Identifier, settledEvent, lockOrder.MessageHash, err)
 			}
-		}(lockOrder, settledEvent)
+		})
+		if err != nil {
+			group.Wait()
+			return fmt.Errorf("ProcessSettledOrders: %w", err)
+		}
 	}
-	wg.Wait()
+	group.Wait()
 
 	return nil
 }
@@ -494,11 +620,9 @@ func ProcessRefundedOrders(ctx context.Context, network *ent.Network, orderIds [
 		return fmt.Errorf("IndexOrderRefunded.fetchLockOrders: %w", err)
 	}
 
-	var wg sync.WaitGroup
+	group := workerpool.Default().Group(ctx, network.Identifier)
 	for _, lockOrder := range lockOrders {
-		wg.Add(1)
-		go func(lockOrder *ent.LockPaymentOrder) {
-			defer wg.Done()
+		err := group.Go(func() {
 			refundedEvent, ok := orderIdToEvent[lockOrder.GatewayID]
 			if !ok {
 				return
@@ -513,15 +637,27 @@ func ProcessRefundedOrders(ctx context.Context, network *ent.Network, orderIds [
 					"OrderID": refundedEvent.OrderId,
 					"TxHash":  refundedEvent.TxHash,
 				}).Errorf("Failed to update order status refund when indexing order refunded events for %s", lockOrder.Edges.Token.Edges.Network.Identifier)
+				RecordDeadLetter(ctx, deadletter.StageOrderRefunded, refundedEvent.OrderId, lockOrder.Edges.Token.Edges.Network.Identifier, refundedEvent, lockOrder.MessageHash, err)
 			}
-		}(lockOrder)
+		})
+		if err != nil {
+			group.Wait()
+			return fmt.Errorf("ProcessRefundedOrders: %w", err)
+		}
 	}
-	wg.Wait()
+	group.Wait()
 
 	return nil
 }
 
+// errStaleDeposit is returned when another deposit was credited to the order after it was loaded
+var errStaleDeposit = errors.New("order changed while crediting deposit")
+
+// maxDepositAttempts bounds how often a deposit is retried against a reloaded order
+const maxDepositAttempts = 3
+
 // UpdateReceiveAddressStatus updates the status of a receive address based on a transfer event.
+// Deposits credited concurrently to the same order are retried against the order as it stands
 func UpdateReceiveAddressStatus(
 	ctx context.Context,
 	receiveAddress *ent.ReceiveAddress,
@@ -529,14 +665,65 @@ func UpdateReceiveAddressStatus(
 	event *types.TokenTransferEvent,
 	createOrder func(ctx context.Context, orderID uuid.UUID) error,
 	getProviderRate func(ctx context.Context, providerProfile *ent.ProviderProfile, tokenSymbol string, currency string) (decimal.Decimal, error),
+) (done bool, err error) {
+	return creditDeposit(ctx, receiveAddress, paymentOrder, event, nil, createOrder, getProviderRate)
+}
+
+// creditDeposit credits a transfer event, or the allocation of a confirmed split to one of its
+// orders, retrying against the reloaded order while other deposits to it are credited concurrently
+func creditDeposit(
+	ctx context.Context,
+	receiveAddress *ent.ReceiveAddress,
+	paymentOrder *ent.PaymentOrder,
+	event *types.TokenTransferEvent,
+	split *ent.DepositSplit,
+	createOrder func(ctx context.Context, orderID uuid.UUID) error,
+	getProviderRate func(ctx context.Context, providerProfile *ent.ProviderProfile, tokenSymbol string, currency string) (decimal.Decimal, error),
+) (done bool, err error) {
+	for attempt := 1; ; attempt++ {
+		done, err = updateReceiveAddressStatus(ctx, receiveAddress, paymentOrder, event, split, createOrder, getProviderRate)
+		if !errors.Is(err, errStaleDeposit) || attempt == maxDepositAttempts {
+			return done, err
+		}
+
+		paymentOrder, err = db.Client.PaymentOrder.
+			Query().
+			Where(paymentorder.IDEQ(paymentOrder.ID)).
+			WithToken(func(tq *ent.TokenQuery) {
+				tq.WithNetwork()
+			}).
+			WithReceiveAddress().
+			WithRecipient().
+			WithSenderProfile().
+			Only(ctx)
+		if err != nil {
+			return true, fmt.Errorf("UpdateReceiveAddressStatus.db: %v", err)
+		}
+	}
+}
+
+// updateReceiveAddressStatus credits a transfer event to an order, failing with errStaleDeposit
+// when the order was credited with another deposit since it was loaded. The allocations of a split
+// share its transfer, so they are only deduplicated against the order they are credited to
+func updateReceiveAddressStatus(
+	ctx context.Context,
+	receiveAddress *ent.ReceiveAddress,
+	paymentOrder *ent.PaymentOrder,
+	event *types.TokenTransferEvent,
+	split *ent.DepositSplit,
+	createOrder func(ctx context.Context, orderID uuid.UUID) error,
+	getProviderRate func(ctx context.Context, providerProfile *ent.ProviderProfile, tokenSymbol string, currency string) (decimal.Decimal, error),
 ) (done bool, err error) {
 	// Case-insensitive address comparison
 	if strings.EqualFold(event.To, receiveAddress.Address) {
 		// Check for existing address with txHash
-		count, err := db.Client.ReceiveAddress.
+		addressQuery := db.Client.ReceiveAddress.
 			Query().
-			Where(receiveaddress.TxHashEQ(event.TxHash)).
-			Count(ctx)
+			Where(receiveaddress.TxHashEQ(event.TxHash))
+		if split != nil {
+			addressQuery = addressQuery.Where(receiveaddress.IDEQ(receiveAddress.ID))
+		}
+		count, err := addressQuery.Count(ctx)
 		if err != nil {
 			return true, fmt.Errorf("UpdateReceiveAddressStatus.db: %v", err)
 		}
@@ -554,11 +741,34 @@ func UpdateReceiveAddressStatus(
 
 		// Additional check: Look for existing transaction log with this tx_hash
 		// This prevents duplicate processing even if CreateOrder fails
-		existingTxLog, err := db.Client.TransactionLog.
+		txLogQuery := db.Client.TransactionLog.
 			Query().
-			Where(transactionlog.TxHashEQ(event.TxHash)).
-			First(ctx)
-		if err == nil && existingTxLog != nil {
+			Where(transactionlog.TxHashEQ(event.TxHash))
+		if split != nil {
+			txLogQuery = paymentOrder.QueryTransactions().
+				Where(transactionlog.TxHashEQ(event.TxHash))
+		}
+		txLogs, err := txLogQuery.All(ctx)
+		if err != nil {
+			return true, fmt.Errorf("UpdateReceiveAddressStatus.db: %v", err)
+		}
+
+		// Deposits a reorg reverted no longer count, so a transfer mined again later is credited again
+		var existingTxLog *ent.TransactionLog
+		reverted := false
+		for _, log := range txLogs {
+			switch log.Status {
+			case transactionlog.StatusCryptoDeposited:
+			case transactionlog.StatusCryptoDepositReverted:
+				reverted = true
+			default:
+				existingTxLog = log
+			}
+		}
+		if credited := creditedDeposits(txLogs); len(credited) > 0 {
+			existingTxLog = credited[0]
+		}
+		if existingTxLog != nil {
 			// This transaction has already been processed
 			logger.WithFields(logger.Fields{
 				"TxHash":      event.TxHash,
@@ -568,82 +778,178 @@ func UpdateReceiveAddressStatus(
 			return false, nil
 		}
 
+		// Further deposits to a quarantined order stay in the receive address until it is reviewed
+		if paymentOrder.Status == paymentorder.StatusQuarantined {
+			logger.WithFields(logger.Fields{
+				"OrderID": paymentOrder.ID,
+				"TxHash":  event.TxHash,
+				"From":    event.From,
+			}).Warnf("Deposit to quarantined order left in the receive address")
+			return false, nil
+		}
+
+		// Senders are screened before their deposit is credited, and orders risk scoring held for
+		// review are quarantined once paid
+		var quarantineReason string
+		if (paymentOrder.TxHash == "" || paymentOrder.TxHash == event.TxHash) && paymentOrder.Status == paymentorder.StatusInitiated {
+			quarantineReason = paymentOrder.QuarantineReason
+			if quarantineReason == "" {
+				quarantineReason = screenDepositSender(ctx, paymentOrder.Edges.Token.Edges.Network, event.From)
+			}
+		}
+
+		// Orders denominated in fiat get their token amount from the rate current when first paid
+		var conversion *fiatConversion
+		if paymentOrder.FiatAmount != nil && paymentOrder.FiatConversion == nil && paymentOrder.Status == paymentorder.StatusInitiated {
+			conversion, err = applyFiatConversion(ctx, paymentOrder)
+			if err != nil {
+				return true, fmt.Errorf("UpdateReceiveAddressStatus.fiatConversion: %w", err)
+			}
+
+			logger.WithFields(logger.Fields{
+				"OrderID":      paymentOrder.ID,
+				"FiatAmount":   conversion.FiatAmount,
+				"FiatCurrency": conversion.FiatCurrency,
+				"LockedRate":   conversion.LockedRate,
+				"AppliedRate":  conversion.AppliedRate,
+				"TokenAmount":  conversion.TokenAmount,
+				"Outcome":      conversion.Outcome,
+			}).Info("Converted fiat order amount")
+		}
+
 		// This is a transfer to the receive address to create an order on-chain
-		// Compare the transferred value with the expected order amount + fees
+		// Compare the total paid so far, including this transfer, with the expected order amount + fees
 		fees := paymentOrder.NetworkFee.Add(paymentOrder.SenderFee)
 		orderAmountWithFees := paymentOrder.Amount.Add(fees).Round(int32(paymentOrder.Edges.Token.Decimals))
-		transferMatchesOrderAmount := event.Value.Equal(orderAmountWithFees)
+		amountPaid := paymentOrder.AmountPaid.Add(event.Value)
+		transferMatchesOrderAmount := amountPaid.Equal(orderAmountWithFees)
 
-		// Also accept transfers that are close to the expected amount (within 1% tolerance)
+		// Also accept totals that are close to the expected amount (within 1% tolerance)
 		// This handles minor rounding differences
 		tolerancePercent := decimal.NewFromFloat(0.01) // 1%
 		tolerance := orderAmountWithFees.Mul(tolerancePercent)
-		transferWithinTolerance := event.Value.GreaterThanOrEqual(orderAmountWithFees.Sub(tolerance)) &&
-			event.Value.LessThanOrEqual(orderAmountWithFees.Add(tolerance))
+		transferWithinTolerance := amountPaid.GreaterThanOrEqual(orderAmountWithFees.Sub(tolerance)) &&
+			amountPaid.LessThanOrEqual(orderAmountWithFees.Add(tolerance))
 
 		if transferWithinTolerance {
 			transferMatchesOrderAmount = true
 		}
 
+		// Installments below the order total are recorded and the order waits for the rest
+		isPartialPayment := amountPaid.LessThan(orderAmountWithFees.Sub(tolerance))
+
+		// The quoted rate is honored until the rate lock expires. Orders completed later are re-quoted
+		// when the rate moved beyond the threshold, and the sender is notified; fiat orders convert
+		// within their own rate band instead
+		var requote *rateRequote
+		if !isPartialPayment && paymentOrder.FiatAmount == nil && (paymentOrder.TxHash == "" || paymentOrder.TxHash == event.TxHash) && paymentOrder.Status == paymentorder.StatusInitiated {
+			requote, err = requoteRate(ctx, paymentOrder, getProviderRate)
+			if err != nil {
+				return true, fmt.Errorf("UpdateReceiveAddressStatus.requote: %w", err)
+			}
+
+			if requote != nil {
+				logger.WithFields(logger.Fields{
+					"OrderID":     paymentOrder.ID,
+					"QuotedRate":  requote.QuotedRate,
+					"CurrentRate": requote.CurrentRate,
+					"Movement":    requote.Movement,
+					"LockedUntil": requote.LockedUntil,
+				}).Info("Re-quoted order paid after its rate lock")
+			}
+		}
+
 		logger.WithFields(logger.Fields{
 			"paymentOrderID":             paymentOrder.ID,
 			"event":                      event,
 			"fees":                       fees,
 			"amount":                     paymentOrder.Amount,
+			"amountPaid":                 amountPaid,
 			"orderAmountWithFees":        orderAmountWithFees,
 			"transferMatchesOrderAmount": transferMatchesOrderAmount,
+			"isPartialPayment":           isPartialPayment,
 			"receiveAddress":             receiveAddress.Address,
 		}).Info("Processing receive address status")
 
+		// Only orders that are not paid yet take deposits, so a transfer is never credited twice and a
+		// paid order is not created again
+		if paymentOrder.TxHash != "" || paymentOrder.Status != paymentorder.StatusInitiated {
+			logger.WithFields(logger.Fields{
+				"OrderID": paymentOrder.ID,
+				"Status":  paymentOrder.Status,
+				"TxHash":  event.TxHash,
+			}).Info("Payment order already paid, skipping deposit")
+			return false, nil
+		}
+
 		tx, err := db.Client.Tx(ctx)
 		if err != nil {
 			return true, fmt.Errorf("UpdateReceiveAddressStatus.db: %v", err)
 		}
+		defer func() { _ = tx.Rollback() }()
+
+		// Crediting the deposit first locks the order row until commit, so deposits to the same
+		// order are decided one at a time from the total the order holds, not from the snapshot
+		current, err := tx.PaymentOrder.
+			UpdateOneID(paymentOrder.ID).
+			AddAmountPaid(event.Value).
+			Save(ctx)
+		if err != nil {
+			return true, fmt.Errorf("UpdateReceiveAddressStatus.db: %v", err)
+		}
+		if current.TxHash != "" || current.Status != paymentorder.StatusInitiated {
+			logger.WithFields(logger.Fields{
+				"OrderID": paymentOrder.ID,
+				"Status":  current.Status,
+				"TxHash":  event.TxHash,
+			}).Info("Payment order paid concurrently, skipping deposit")
+			return false, nil
+		}
+
+		amountPaid = current.AmountPaid
+		transferMatchesOrderAmount = amountPaid.GreaterThanOrEqual(orderAmountWithFees.Sub(tolerance)) &&
+			amountPaid.LessThanOrEqual(orderAmountWithFees.Add(tolerance))
+		// The fiat conversion and the re-quote were decided from the snapshot; when another deposit
+		// changed either since, the deposit is decided again against the reloaded order
+		if amountPaid.LessThan(orderAmountWithFees.Sub(tolerance)) != isPartialPayment ||
+			(conversion != nil && current.FiatConversion != nil) {
+			return false, errStaleDeposit
+		}
 
 		paymentOrderUpdate := tx.PaymentOrder.Update().Where(paymentorder.IDEQ(paymentOrder.ID))
 		if paymentOrder.ReturnAddress == "" {
 			paymentOrderUpdate = paymentOrderUpdate.SetReturnAddress(event.From)
 		}
+		if conversion != nil {
+			paymentOrderUpdate = paymentOrderUpdate.
+				SetAmount(paymentOrder.Amount).
+				SetRate(paymentOrder.Rate).
+				SetSenderFee(paymentOrder.SenderFee).
+				SetAmountInUsd(paymentOrder.AmountInUsd).
+				SetFiatConversion(conversion.record())
+			if reason := conversion.reviewReason(); reason != "" && paymentOrder.ReviewReason == "" {
+				paymentOrderUpdate = paymentOrderUpdate.SetReviewReason(reason)
+			}
+		}
+		if requote != nil {
+			paymentOrderUpdate = paymentOrderUpdate.
+				SetRate(requote.CurrentRate).
+				SetRateRequote(requote.record())
+		}
 
-		orderRecipient := paymentOrder.Edges.Recipient
-		if !transferMatchesOrderAmount {
-			// Update the order amount to whatever amount was sent to the receive address (minus fees)
-			newOrderAmount := event.Value.Sub(fees.Round(int32(paymentOrder.Edges.Token.Decimals)))
-			// Ensure the new amount is positive
-			if newOrderAmount.GreaterThan(decimal.Zero) {
-				paymentOrderUpdate = paymentOrderUpdate.SetAmount(newOrderAmount.Round(int32(paymentOrder.Edges.Token.Decimals)))
-			} else {
-				// If fees exceed the transfer amount, set amount to the transfer value
-				paymentOrderUpdate = paymentOrderUpdate.SetAmount(event.Value)
-			}
-			// Update the rate with the current rate if order is older than 30 mins for a P2P order from the sender dashboard
-			if strings.HasPrefix(orderRecipient.Memo, "P#P") && orderRecipient.ProviderID != "" && paymentOrder.CreatedAt.Before(time.Now().Add(-30*time.Minute)) {
-				providerProfile, err := db.Client.ProviderProfile.
-					Query().
-					Where(
-						providerprofile.HasUserWith(
-							user.HasSenderProfileWith(
-								senderprofile.HasPaymentOrdersWith(
-									paymentorder.IDEQ(paymentOrder.ID),
-								),
-							),
-						),
-					).
-					Only(ctx)
-				if err != nil {
-					return true, fmt.Errorf("UpdateReceiveAddressStatus.db: %v", err)
-				}
-
-				institution, err := utils.GetInstitutionByCode(ctx, orderRecipient.Institution, true)
-				if err != nil {
-					return true, fmt.Errorf("UpdateReceiveAddressStatus.db: %v", err)
-				}
+		if !transferMatchesOrderAmount && !isPartialPayment {
+			overpaymentMode, err := senderOverpaymentMode(ctx, paymentOrder)
+			if err != nil {
+				return true, fmt.Errorf("UpdateReceiveAddressStatus.db: %v", err)
+			}
 
-				rate, err := getProviderRate(ctx, providerProfile, paymentOrder.Edges.Token.Symbol, institution.Edges.FiatCurrency.Code)
-				if err != nil {
-					return true, fmt.Errorf("UpdateReceiveAddressStatus.db: %v", err)
-				}
-				paymentOrderUpdate = paymentOrderUpdate.SetRate(rate)
+			if overpaymentMode == senderprofile.OverpaymentModeRefund {
+				// Keep the order amount and record the excess, which RefundOverpayments sends back to the payer
+				paymentOrderUpdate = paymentOrderUpdate.SetAmountOverpaid(amountPaid.Sub(orderAmountWithFees))
+			} else {
+				// Overpaid: update the order amount to whatever was sent to the receive address (minus fees)
+				newOrderAmount := amountPaid.Sub(fees.Round(int32(paymentOrder.Edges.Token.Decimals)))
+				paymentOrderUpdate = paymentOrderUpdate.SetAmount(newOrderAmount.Round(int32(paymentOrder.Edges.Token.Decimals)))
 			}
 			transferMatchesOrderAmount = true
 		}
@@ -657,82 +963,166 @@ func UpdateReceiveAddressStatus(
 			"receiveAddress":             receiveAddress.Address,
 		}).Info("Processing receive address status after update")
 
-		// Check if this transaction has already been processed to prevent duplicate amount additions
-		// Only process if order hasn't been paid yet (no tx_hash or empty tx_hash)
-		if (paymentOrder.TxHash == "" || paymentOrder.TxHash == event.TxHash) && paymentOrder.Status == paymentorder.StatusInitiated {
+		logger.WithFields(logger.Fields{
+			"OrderID":     paymentOrder.ID,
+			"TxHash":      event.TxHash,
+			"AmountPaid":  paymentOrder.AmountPaid,
+			"EventValue":  event.Value,
+		}).Info("Creating transaction log for crypto deposit")
+
+		metadata := map[string]interface{}{
+			"transactionData": map[string]interface{}{
+				"from":        event.From,
+				"to":          receiveAddress.Address,
+				"value":       event.Value.String(),
+				"blockNumber": event.BlockNumber,
+			},
+			"amountPaid": amountPaid.String(),
+		}
+		if split != nil {
+			metadata["depositSplitId"] = split.ID.String()
+			metadata["depositSplitAmount"] = split.Amount.String()
+		}
+
+		transactionLog, err := tx.TransactionLog.
+			Create().
+			SetStatus(transactionlog.StatusCryptoDeposited).
+			SetTxHash(event.TxHash).
+			SetDepositOrderID(paymentOrder.ID).
+			SetNetwork(paymentOrder.Edges.Token.Edges.Network.Identifier).
+			SetMetadata(metadata).
+			Save(ctx)
+		if ent.IsConstraintError(err) {
+			// Another delivery of the transfer credited it first
 			logger.WithFields(logger.Fields{
-				"OrderID":     paymentOrder.ID,
-				"TxHash":      event.TxHash,
-				"AmountPaid":  paymentOrder.AmountPaid,
-				"EventValue":  event.Value,
-			}).Info("Creating transaction log for crypto deposit")
+				"TxHash":  event.TxHash,
+				"OrderID": paymentOrder.ID,
+			}).Info("Transaction already processed, skipping duplicate")
+			return false, nil
+		}
+		if err != nil {
+			logger.WithFields(logger.Fields{
+				"OrderID": paymentOrder.ID,
+				"Error":   err.Error(),
+			}).Error("Failed to create transaction log")
+			return true, fmt.Errorf("UpdateReceiveAddressStatus.transactionlog: %v", err)
+		}
 
-			transactionLog, err := tx.TransactionLog.
-				Create().
-				SetStatus(transactionlog.StatusCryptoDeposited).
-				SetTxHash(event.TxHash).
-				SetNetwork(paymentOrder.Edges.Token.Edges.Network.Identifier).
-				SetMetadata(map[string]interface{}{
-					"transactionData": map[string]interface{}{
-						"from":        event.From,
-						"to":          receiveAddress.Address,
-						"value":       event.Value.String(),
-						"blockNumber": event.BlockNumber,
-					},
-				}).
-				Save(ctx)
+		// Transaction log created successfully
+
+		// A transfer credited again after reorgs reverted it is booked apart from its earlier credits
+		reversals := 0
+		if reverted {
+			reversals, err = tx.PaymentOrder.
+				QueryTransactions(current).
+				Where(
+					transactionlog.TxHashEQ(event.TxHash),
+					transactionlog.StatusEQ(transactionlog.StatusCryptoDepositReverted),
+				).
+				Count(ctx)
 			if err != nil {
-				logger.WithFields(logger.Fields{
-					"OrderID": paymentOrder.ID,
-					"Error":   err.Error(),
-				}).Error("Failed to create transaction log")
-				return true, fmt.Errorf("UpdateReceiveAddressStatus.transactionlog: %v", err)
+				return true, fmt.Errorf("UpdateReceiveAddressStatus.db: %v", err)
 			}
+		}
 
-			// Transaction log created successfully
+		if err := ledger.Record(ctx, tx, ledger.Redeposit(paymentOrder, event.TxHash, event.Value, reversals)); err != nil {
+			return true, fmt.Errorf("UpdateReceiveAddressStatus.ledger: %w", err)
+		}
 
-			logger.WithFields(logger.Fields{
-				"OrderID":    paymentOrder.ID,
-				"TxHash":     event.TxHash,
-				"LogID":      transactionLog.ID,
-			}).Info("Transaction log created, updating payment order")
-
-			// FIX: Set amount paid instead of adding to prevent increment issues
-			// Update status to pending when payment is received
-			_, err = paymentOrderUpdate.
-				SetFromAddress(event.From).
+		logger.WithFields(logger.Fields{
+			"OrderID":    paymentOrder.ID,
+			"TxHash":     event.TxHash,
+			"LogID":      transactionLog.ID,
+		}).Info("Transaction log created, updating payment order")
+
+		// Installments were added to the amount paid above; the order stays initiated until fully paid
+		paymentOrderUpdate = paymentOrderUpdate.
+			SetFromAddress(event.From).
+			AddTransactions(transactionLog)
+
+		if !isPartialPayment {
+			// Deposits on networks without a finality window are final on inclusion
+			if paymentOrder.Edges.Token.Edges.Network.FinalityBlocks == 0 {
+				paymentOrderUpdate = paymentOrderUpdate.
+					SetDepositStatus(paymentorder.DepositStatusFinalized).
+					SetDepositFinalizedAt(time.Now())
+			} else {
+				paymentOrderUpdate = paymentOrderUpdate.SetDepositStatus(paymentorder.DepositStatusSoftConfirmed)
+			}
+
+			// Update status to pending when payment is received in full, or hold it
+			// until the deposit is buried under the network's confirmation depth
+			requiredConfirmations := paymentOrder.Edges.Token.Edges.Network.RequiredConfirmations
+			status := paymentorder.StatusPending
+			if requiredConfirmations > 0 {
+				status = paymentorder.StatusAwaitingConfirmations
+			}
+			paymentOrderUpdate = paymentOrderUpdate.
 				SetTxHash(event.TxHash).
 				SetBlockNumber(int64(event.BlockNumber)).
-				SetAmountPaid(event.Value).
-				SetStatus(paymentorder.StatusPending).
-				AddTransactions(transactionLog).
-				Save(ctx)
-			if err != nil {
-				logger.WithFields(logger.Fields{
-					"OrderID": paymentOrder.ID,
-					"Error":   err.Error(),
-				}).Error("Failed to update payment order")
-				return true, fmt.Errorf("UpdateReceiveAddressStatus.db: %v", err)
-			}
+				SetRequiredConfirmations(requiredConfirmations).
+				SetStatus(status)
+		}
 
+		// Flagged deposits are held for review instead of going on to the gateway
+		if quarantineReason != "" {
+			paymentOrderUpdate = paymentOrderUpdate.
+				SetStatus(paymentorder.StatusQuarantined).
+				SetQuarantineReason(quarantineReason)
+		}
+
+		_, err = paymentOrderUpdate.Save(ctx)
+		if err != nil {
 			logger.WithFields(logger.Fields{
 				"OrderID": paymentOrder.ID,
-				"TxHash":  event.TxHash,
-			}).Info("Payment order updated, committing transaction")
+				"Error":   err.Error(),
+			}).Error("Failed to update payment order")
+			return true, fmt.Errorf("UpdateReceiveAddressStatus.db: %v", err)
+		}
 
-			// Commit the transaction
-			if err := tx.Commit(); err != nil {
-				logger.WithFields(logger.Fields{
-					"OrderID": paymentOrder.ID,
-					"Error":   err.Error(),
-				}).Error("Failed to commit transaction")
-				return true, fmt.Errorf("UpdateReceiveAddressStatus.db: %v", err)
-			}
+		logger.WithFields(logger.Fields{
+			"OrderID": paymentOrder.ID,
+			"TxHash":  event.TxHash,
+		}).Info("Payment order updated, committing transaction")
 
+		// Commit the transaction
+		if err := tx.Commit(); err != nil {
 			logger.WithFields(logger.Fields{
 				"OrderID": paymentOrder.ID,
-				"TxHash":  event.TxHash,
-			}).Info("Transaction committed successfully")
+				"Error":   err.Error(),
+			}).Error("Failed to commit transaction")
+			return true, fmt.Errorf("UpdateReceiveAddressStatus.db: %v", err)
+		}
+
+		logger.WithFields(logger.Fields{
+			"OrderID": paymentOrder.ID,
+			"TxHash":  event.TxHash,
+		}).Info("Transaction committed successfully")
+
+		// Let the order's event streams show the detected payment
+		if updatedOrder, err := db.Client.PaymentOrder.Get(ctx, paymentOrder.ID); err == nil {
+			utils.PublishOrderStatus(ctx, updatedOrder)
+		}
+
+		if requote != nil {
+			notifyRateRequote(ctx, paymentOrder.ID)
+		}
+
+		if quarantineReason != "" {
+			if !isPartialPayment {
+				_, err = receiveAddress.
+					Update().
+					SetStatus(receiveaddress.StatusUsed).
+					SetLastUsed(time.Now()).
+					SetTxHash(event.TxHash).
+					SetLastIndexedBlock(int64(event.BlockNumber)).
+					Save(ctx)
+				if err != nil {
+					return true, fmt.Errorf("UpdateReceiveAddressStatus.db: %v", err)
+				}
+			}
+			sendQuarantineAlert(paymentOrder, paymentOrder.Edges.Token.Edges.Network, event.From, event.TxHash, quarantineReason)
+			return true, nil
 		}
 
 		logger.WithFields(logger.Fields{
@@ -745,8 +1135,28 @@ func UpdateReceiveAddressStatus(
 			"receiveAddress":             receiveAddress.Address,
 		}).Info("Processing receive address status after payment order update")
 
-		// FIX: Always call createOrder when payment is received, regardless of amount matching
-		// This ensures the order progresses to the next stage
+		if isPartialPayment && event.Value.GreaterThan(decimal.Zero) {
+			// Give the sender a full validity window to send the rest
+			if !receiveAddress.ValidUntil.IsZero() {
+				_, err = receiveAddress.
+					Update().
+					SetValidUntil(time.Now().Add(orderConf.ReceiveAddressValidity)).
+					Save(ctx)
+				if err != nil {
+					return true, fmt.Errorf("UpdateReceiveAddressStatus.db: %v", err)
+				}
+			}
+
+			logger.WithFields(logger.Fields{
+				"OrderID":             paymentOrder.ID,
+				"TxHash":              event.TxHash,
+				"AmountPaid":          amountPaid,
+				"OrderAmountWithFees": orderAmountWithFees,
+			}).Info("Partial payment received, waiting for the remaining amount")
+			return false, nil
+		}
+
+		// Create the order once the total paid covers the order amount
 		if event.Value.GreaterThan(decimal.Zero) {
 			// Mark receive address as used
 			_, err = receiveAddress.
@@ -760,10 +1170,32 @@ func UpdateReceiveAddressStatus(
 				return true, fmt.Errorf("UpdateReceiveAddressStatus.db: %v", err)
 			}
 
+			// Orders awaiting confirmations are created on-chain by the deposit confirmations task
+			if requiredConfirmations := paymentOrder.Edges.Token.Edges.Network.RequiredConfirmations; requiredConfirmations > 0 {
+				logger.WithFields(logger.Fields{
+					"OrderID":               paymentOrder.ID,
+					"TxHash":                event.TxHash,
+					"RequiredConfirmations": requiredConfirmations,
+				}).Info("Deposit received, waiting for confirmations before creating order")
+				return true, nil
+			}
+
+			if AwaitingDepositFinality(paymentOrder, paymentOrder.Edges.Token.Edges.Network) {
+				logger.WithFields(logger.Fields{
+					"OrderID":        paymentOrder.ID,
+					"TxHash":         event.TxHash,
+					"FinalityBlocks": paymentOrder.Edges.Token.Edges.Network.FinalityBlocks,
+				}).Info("Deposit soft-confirmed, waiting for finality before creating order")
+				return true, nil
+			}
+
 			// Always call createOrder when payment is received
-			err = createOrder(ctx, paymentOrder.ID)
+			// The deposit is already recorded, so order creation over budget finishes in the background
+			err = callWithinBudget(ctx, "CreateOrder", paymasterBudget, true, func(ctx context.Context) error {
+				return createOrder(ctx, paymentOrder.ID)
+			})
 			if err != nil {
-				return true, fmt.Errorf("UpdateReceiveAddressStatus.CreateOrder: %v", err)
+				return true, fmt.Errorf("UpdateReceiveAddressStatus.CreateOrder: %w", err)
 			}
 
 			return true, nil
@@ -778,6 +1210,32 @@ func UpdateReceiveAddressStatus(
 	return false, nil
 }
 
+// senderOverpaymentMode returns how the sender of an order wants overpayments handled
+func senderOverpaymentMode(ctx context.Context, paymentOrder *ent.PaymentOrder) (senderprofile.OverpaymentMode, error) {
+	profile := paymentOrder.Edges.SenderProfile
+	if profile == nil {
+		var err error
+		profile, err = paymentOrder.QuerySenderProfile().Only(ctx)
+		if ent.IsNotFound(err) {
+			return senderprofile.DefaultOverpaymentMode, nil
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return profile.OverpaymentMode, nil
+}
+
+// AwaitingDepositFinality reports whether the order must wait for its deposit to be final before it is created on-chain.
+// Such orders are left out of the confirmation, indexing and watchdog paths that create orders, and are created by
+// ProcessDepositFinality once their deposit is buried under the network's finality window
+func AwaitingDepositFinality(paymentOrder *ent.PaymentOrder, network *ent.Network) bool {
+	return paymentOrder.SettlementPolicy == paymentorder.SettlementPolicyFinality &&
+		network.FinalityBlocks > 0 &&
+		paymentOrder.DepositStatus != paymentorder.DepositStatusFinalized
+}
+
 // GetProviderAddresses gets provider addresses for a given token, network, and currency
 func GetProviderAddresses(ctx context.Context, token *ent.Token, currencyCode string) ([]string, error) {
 	providerOrderTokens, err := storage.Client.ProviderOrderToken.
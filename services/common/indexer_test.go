@@ -0,0 +1,262 @@
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	"github.com/NEDA-LABS/stablenode/ent/senderprofile"
+	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPartialPayments(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:partialpayments?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	ctx := context.Background()
+	order := setupDepositSplit(t, ctx)[0]
+
+	var created []uuid.UUID
+	createOrder := func(ctx context.Context, orderID uuid.UUID) error {
+		created = append(created, orderID)
+		return nil
+	}
+
+	transfer := func(txHash string, value float64) *types.TokenTransferEvent {
+		return &types.TokenTransferEvent{
+			BlockNumber: 100,
+			TxHash:      txHash,
+			From:        "0x2222222222222222222222222222222222222222",
+			To:          splitTestAddress,
+			Value:       decimal.NewFromFloat(value),
+		}
+	}
+
+	reload := func() *ent.PaymentOrder {
+		reloaded, err := db.Client.PaymentOrder.
+			Query().
+			Where(paymentorder.IDEQ(order.ID)).
+			WithToken(func(tq *ent.TokenQuery) {
+				tq.WithNetwork()
+			}).
+			WithReceiveAddress().
+			WithTransactions().
+			Only(ctx)
+		assert.NoError(t, err)
+		return reloaded
+	}
+
+	t.Run("should record an installment without creating the order", func(t *testing.T) {
+		done, err := UpdateReceiveAddressStatus(ctx, order.Edges.ReceiveAddress, order, transfer("0xb1", 4), createOrder, nil)
+		assert.NoError(t, err)
+		assert.False(t, done)
+		assert.Empty(t, created)
+
+		order = reload()
+		assert.True(t, order.AmountPaid.Equal(decimal.NewFromFloat(4)))
+		assert.Equal(t, paymentorder.StatusInitiated, order.Status)
+		assert.Empty(t, order.TxHash)
+		assert.Len(t, order.Edges.Transactions, 1)
+		assert.Equal(t, receiveaddress.StatusPoolAssigned, order.Edges.ReceiveAddress.Status)
+	})
+
+	t.Run("should ignore an installment that was already indexed", func(t *testing.T) {
+		done, err := UpdateReceiveAddressStatus(ctx, order.Edges.ReceiveAddress, order, transfer("0xb1", 4), createOrder, nil)
+		assert.NoError(t, err)
+		assert.False(t, done)
+
+		order = reload()
+		assert.True(t, order.AmountPaid.Equal(decimal.NewFromFloat(4)))
+		assert.Len(t, order.Edges.Transactions, 1)
+	})
+
+	t.Run("should create the order once the total covers the amount", func(t *testing.T) {
+		done, err := UpdateReceiveAddressStatus(ctx, order.Edges.ReceiveAddress, order, transfer("0xb2", 6.5), createOrder, nil)
+		assert.NoError(t, err)
+		assert.True(t, done)
+		assert.Equal(t, []uuid.UUID{order.ID}, created)
+
+		order = reload()
+		assert.True(t, order.AmountPaid.Equal(decimal.NewFromFloat(10.5)))
+		assert.True(t, order.Amount.Equal(decimal.NewFromFloat(10)))
+		assert.Equal(t, paymentorder.StatusPending, order.Status)
+		assert.Equal(t, "0xb2", order.TxHash)
+		assert.Equal(t, receiveaddress.StatusUsed, order.Edges.ReceiveAddress.Status)
+
+		count, err := db.Client.TransactionLog.
+			Query().
+			Where(transactionlog.StatusEQ(transactionlog.StatusCryptoDeposited)).
+			Count(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, count)
+	})
+
+	t.Run("should not credit or create a paid order again", func(t *testing.T) {
+		done, err := UpdateReceiveAddressStatus(ctx, order.Edges.ReceiveAddress, order, transfer("0xb3", 3), createOrder, nil)
+		assert.NoError(t, err)
+		assert.False(t, done)
+		assert.Equal(t, []uuid.UUID{order.ID}, created)
+
+		order = reload()
+		assert.True(t, order.AmountPaid.Equal(decimal.NewFromFloat(10.5)))
+		assert.Equal(t, "0xb2", order.TxHash)
+		assert.Equal(t, "0xb2", order.Edges.ReceiveAddress.TxHash)
+		assert.Len(t, order.Edges.Transactions, 2)
+	})
+}
+
+func TestConcurrentInstallments(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:concurrentinstallments?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	ctx := context.Background()
+	// Both deliveries were loaded before either installment was credited
+	stale := setupDepositSplit(t, ctx)[0]
+
+	var created []uuid.UUID
+	createOrder := func(ctx context.Context, orderID uuid.UUID) error {
+		created = append(created, orderID)
+		return nil
+	}
+
+	transfer := func(txHash string, value float64) *types.TokenTransferEvent {
+		return &types.TokenTransferEvent{
+			BlockNumber: 100,
+			TxHash:      txHash,
+			From:        "0x2222222222222222222222222222222222222222",
+			To:          splitTestAddress,
+			Value:       decimal.NewFromFloat(value),
+		}
+	}
+
+	t.Run("should complete the order from the total it holds", func(t *testing.T) {
+		done, err := UpdateReceiveAddressStatus(ctx, stale.Edges.ReceiveAddress, stale, transfer("0xe1", 4), createOrder, nil)
+		assert.NoError(t, err)
+		assert.False(t, done)
+
+		done, err = UpdateReceiveAddressStatus(ctx, stale.Edges.ReceiveAddress, stale, transfer("0xe2", 6.5), createOrder, nil)
+		assert.NoError(t, err)
+		assert.True(t, done)
+		assert.Equal(t, []uuid.UUID{stale.ID}, created)
+
+		order, err := db.Client.PaymentOrder.Get(ctx, stale.ID)
+		assert.NoError(t, err)
+		assert.True(t, order.AmountPaid.Equal(decimal.NewFromFloat(10.5)))
+		assert.Equal(t, paymentorder.StatusPending, order.Status)
+		assert.Equal(t, "0xe2", order.TxHash)
+	})
+
+	t.Run("should not record the same transfer twice for an order", func(t *testing.T) {
+		_, err := db.Client.TransactionLog.
+			Create().
+			SetStatus(transactionlog.StatusCryptoDeposited).
+			SetTxHash("0xe1").
+			SetDepositOrderID(stale.ID).
+			SetMetadata(map[string]interface{}{}).
+			Save(ctx)
+		assert.True(t, ent.IsConstraintError(err))
+	})
+}
+
+func TestOverpayments(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:overpayments?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	ctx := context.Background()
+	orders := setupDepositSplit(t, ctx)
+
+	createOrder := func(ctx context.Context, orderID uuid.UUID) error {
+		return nil
+	}
+
+	transfer := func(txHash string, value float64) *types.TokenTransferEvent {
+		return &types.TokenTransferEvent{
+			BlockNumber: 100,
+			TxHash:      txHash,
+			From:        "0x2222222222222222222222222222222222222222",
+			To:          splitTestAddress,
+			Value:       decimal.NewFromFloat(value),
+		}
+	}
+
+	t.Run("should raise the order amount by default", func(t *testing.T) {
+		order := orders[1]
+		recipient, err := order.QueryRecipient().
+			OnlyX(ctx).
+			Update().
+			SetAccountName("John Doe").
+			SetMemo("Shopping").
+			Save(ctx)
+		assert.NoError(t, err)
+		order.Edges.Recipient = recipient
+
+		_, err = UpdateReceiveAddressStatus(ctx, order.Edges.ReceiveAddress, order, transfer("0xc1", 25), createOrder, nil)
+		assert.NoError(t, err)
+
+		updated, err := db.Client.PaymentOrder.Get(ctx, order.ID)
+		assert.NoError(t, err)
+		assert.True(t, updated.Amount.Equal(decimal.NewFromFloat(24.5)))
+		assert.True(t, updated.AmountOverpaid.IsZero())
+	})
+
+	t.Run("should record the excess when the sender refunds overpayments", func(t *testing.T) {
+		order := orders[2]
+		sender, err := order.Edges.SenderProfile.Update().
+			SetOverpaymentMode(senderprofile.OverpaymentModeRefund).
+			Save(ctx)
+		assert.NoError(t, err)
+		order.Edges.SenderProfile = sender
+
+		_, err = UpdateReceiveAddressStatus(ctx, order.Edges.ReceiveAddress, order, transfer("0xc2", 20), createOrder, nil)
+		assert.NoError(t, err)
+
+		updated, err := db.Client.PaymentOrder.Get(ctx, order.ID)
+		assert.NoError(t, err)
+		assert.True(t, updated.Amount.Equal(decimal.NewFromFloat(15)))
+		assert.True(t, updated.AmountPaid.Equal(decimal.NewFromFloat(20)))
+		assert.True(t, updated.AmountOverpaid.Equal(decimal.NewFromFloat(4.5)))
+		assert.Equal(t, paymentorder.StatusPending, updated.Status)
+	})
+}
+
+func TestProcessReceiveAddressesOutlivesDelivery(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:processreceiveaddresses?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	orders := setupDepositSplit(t, context.Background())
+	orderService := &stubOrderService{}
+
+	// The webhook delivery is gone by the time its deposits are dispatched to workers
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	event := &types.TokenTransferEvent{
+		BlockNumber:    100,
+		BlockTimestamp: time.Now().Add(time.Minute).Unix(),
+		TxHash:         "0xd1",
+		From:           "0x2222222222222222222222222222222222222222",
+		To:             splitTestAddress,
+		Value:          decimal.NewFromFloat(20.5),
+	}
+	err := ProcessReceiveAddresses(ctx, orderService, nil, []string{splitTestAddress}, map[string]*types.TokenTransferEvent{splitTestAddress: event})
+	assert.NoError(t, err)
+
+	order, err := db.Client.PaymentOrder.Get(context.Background(), orders[1].ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "0xd1", order.TxHash)
+	assert.Equal(t, []uuid.UUID{orders[1].ID}, orderService.created)
+}
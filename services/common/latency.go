@@ -0,0 +1,114 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+)
+
+// ErrOverBudget is returned when a call in a webhook delivery exceeds its latency budget. The
+// deposit is not lost: the reconciliation tasks pick up whatever the delivery left undone
+var ErrOverBudget = errors.New("call exceeded its latency budget")
+
+// latencyBudgetKey is the context key of the latency budget of a webhook delivery
+type latencyBudgetKey struct{}
+
+// latencyBudget is the latency budget of a single webhook delivery
+type latencyBudget struct {
+	config   *config.WebhookLatencyConfiguration
+	deadline time.Time
+}
+
+// WithLatencyBudget marks ctx as a webhook delivery bound by the given budgets, starting now.
+// The context itself gets no deadline, so database work in progress is never cut off; only the
+// external calls run through the budget are bounded
+func WithLatencyBudget(ctx context.Context, conf *config.WebhookLatencyConfiguration) context.Context {
+	return context.WithValue(ctx, latencyBudgetKey{}, &latencyBudget{
+		config:   conf,
+		deadline: time.Now().Add(conf.Handler),
+	})
+}
+
+// BudgetExhausted reports whether the delivery of ctx has no time left for further work
+func BudgetExhausted(ctx context.Context) bool {
+	budget, ok := ctx.Value(latencyBudgetKey{}).(*latencyBudget)
+	return ok && !time.Now().Before(budget.deadline)
+}
+
+// callWithinBudget runs fn under the budget of a call when ctx is a webhook delivery, and directly
+// otherwise. The budget is capped by the time left in the delivery. When it runs out
+// ErrOverBudget is returned; with detach, fn keeps running in the background up to the deferred
+// timeout instead of being cancelled, for work that is unsafe to abandon halfway
+func callWithinBudget(ctx context.Context, call string, budgetOf func(conf *config.WebhookLatencyConfiguration) time.Duration, detach bool, fn func(ctx context.Context) error) error {
+	budget, ok := ctx.Value(latencyBudgetKey{}).(*latencyBudget)
+	if !ok {
+		return fn(ctx)
+	}
+
+	limit := budgetOf(budget.config)
+	if remaining := time.Until(budget.deadline); remaining < limit {
+		limit = remaining
+	}
+
+	var callCtx context.Context
+	var cancel context.CancelFunc
+	if detach {
+		callCtx, cancel = context.WithTimeout(context.WithoutCancel(ctx), budget.config.Deferred)
+	} else {
+		callCtx, cancel = context.WithTimeout(ctx, limit)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		defer cancel()
+		done <- fn(callCtx)
+	}()
+
+	timer := time.NewTimer(limit)
+	defer timer.Stop()
+
+	select {
+	case err := <-done:
+		if !detach && errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+			return fmt.Errorf("%s: %w", call, ErrOverBudget)
+		}
+		return err
+	case <-timer.C:
+		if detach {
+			// Nobody waits for the result anymore, so report failures of the deferred call here
+			go func() {
+				if err := <-done; err != nil {
+					logger.WithFields(logger.Fields{
+						"Error": fmt.Sprintf("%v", err),
+						"Call":  call,
+					}).Errorf("Deferred call failed after exceeding its webhook latency budget")
+				}
+			}()
+		} else {
+			cancel()
+		}
+		logger.WithFields(logger.Fields{
+			"Call":     call,
+			"Budget":   limit.String(),
+			"Detached": detach,
+		}).Warnf("Call exceeded its webhook latency budget, deferring")
+		return fmt.Errorf("%s: %w", call, ErrOverBudget)
+	}
+}
+
+// rateFetchBudget, institutionLookupBudget and paymasterBudget select the budget of a call
+func rateFetchBudget(conf *config.WebhookLatencyConfiguration) time.Duration {
+	return conf.RateFetch
+}
+
+func institutionLookupBudget(conf *config.WebhookLatencyConfiguration) time.Duration {
+	return conf.InstitutionLookup
+}
+
+func paymasterBudget(conf *config.WebhookLatencyConfiguration) time.Duration {
+	return conf.Paymaster
+}
@@ -0,0 +1,98 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCallWithinBudget(t *testing.T) {
+	conf := &config.WebhookLatencyConfiguration{
+		Handler:   time.Second,
+		RateFetch: 50 * time.Millisecond,
+		Paymaster: 50 * time.Millisecond,
+		Deferred:  time.Second,
+	}
+
+	t.Run("runs calls without a budget directly", func(t *testing.T) {
+		err := callWithinBudget(context.Background(), "call", rateFetchBudget, false, func(ctx context.Context) error {
+			time.Sleep(100 * time.Millisecond)
+			_, hasDeadline := ctx.Deadline()
+			assert.False(t, hasDeadline)
+			return nil
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("returns results of calls within budget", func(t *testing.T) {
+		ctx := WithLatencyBudget(context.Background(), conf)
+		failure := errors.New("rate unavailable")
+
+		err := callWithinBudget(ctx, "call", rateFetchBudget, false, func(ctx context.Context) error {
+			return failure
+		})
+		assert.Equal(t, failure, err)
+	})
+
+	t.Run("cancels reads over budget", func(t *testing.T) {
+		ctx := WithLatencyBudget(context.Background(), conf)
+
+		cancelled := make(chan struct{})
+		err := callWithinBudget(ctx, "GetProviderRate", rateFetchBudget, false, func(ctx context.Context) error {
+			<-ctx.Done()
+			close(cancelled)
+			return ctx.Err()
+		})
+		assert.ErrorIs(t, err, ErrOverBudget)
+		assert.ErrorContains(t, err, "GetProviderRate")
+
+		select {
+		case <-cancelled:
+		case <-time.After(time.Second):
+			t.Fatal("the call was not cancelled")
+		}
+	})
+
+	t.Run("lets detached calls over budget finish in the background", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(WithLatencyBudget(context.Background(), conf))
+
+		finished := make(chan error, 1)
+		start := time.Now()
+		err := callWithinBudget(ctx, "CreateOrder", paymasterBudget, true, func(ctx context.Context) error {
+			time.Sleep(150 * time.Millisecond)
+			finished <- ctx.Err()
+			return nil
+		})
+		assert.ErrorIs(t, err, ErrOverBudget)
+		assert.Less(t, time.Since(start), 150*time.Millisecond)
+
+		// The delivery ending does not cancel the deferred call
+		cancel()
+		select {
+		case ctxErr := <-finished:
+			assert.NoError(t, ctxErr)
+		case <-time.After(time.Second):
+			t.Fatal("the detached call did not finish")
+		}
+	})
+
+	t.Run("caps call budgets by the time left in the delivery", func(t *testing.T) {
+		ctx := WithLatencyBudget(context.Background(), &config.WebhookLatencyConfiguration{
+			Handler:   20 * time.Millisecond,
+			RateFetch: time.Second,
+		})
+		assert.False(t, BudgetExhausted(ctx))
+
+		err := callWithinBudget(ctx, "call", rateFetchBudget, false, func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		})
+		assert.ErrorIs(t, err, ErrOverBudget)
+		assert.True(t, BudgetExhausted(ctx))
+		assert.False(t, BudgetExhausted(context.Background()))
+	})
+}
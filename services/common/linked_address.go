@@ -0,0 +1,151 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/linkedaddress"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	tokenent "github.com/NEDA-LABS/stablenode/ent/token"
+	db "github.com/NEDA-LABS/stablenode/storage"
+)
+
+// Errors returned when a linked address is managed or receives a transfer outside its restrictions
+var (
+	ErrLinkedAddressInactive          = errors.New("linked address is deactivated")
+	ErrLinkedAddressNetworkNotAllowed = errors.New("network is not allowed for the linked address")
+	ErrLinkedAddressTokenNotAllowed   = errors.New("token is not allowed for the linked address")
+	ErrLinkedAddressTransferLimit     = errors.New("transfer exceeds the linked address transfer limit")
+	ErrLinkedAddressDailyLimit        = errors.New("transfer exceeds the linked address daily volume limit")
+	ErrLinkedAddressHandleInvalid     = errors.New("handle must be 3 to 32 lowercase letters, digits or hyphens, in dot-separated labels")
+)
+
+// linkedAddressHandlePattern matches ENS-style names: dot-separated labels of letters, digits and
+// hyphens, with no label starting or ending with a hyphen
+var linkedAddressHandlePattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?(\.[a-z0-9]([a-z0-9-]*[a-z0-9])?)*$`)
+
+// NormalizeLinkedAddressHandle lowercases a vanity handle, such as "alice" or "alice.shop", and
+// checks it is a valid name
+func NormalizeLinkedAddressHandle(handle string) (string, error) {
+	handle = strings.ToLower(strings.TrimSpace(handle))
+	if len(handle) < 3 || len(handle) > 32 || !linkedAddressHandlePattern.MatchString(handle) {
+		return "", ErrLinkedAddressHandleInvalid
+	}
+	return handle, nil
+}
+
+// CheckLinkedAddressTransfer checks a transfer of amount in token to a linked address against the
+// restrictions of the address. Transfers it rejects create no payment order; their funds stay in the
+// linked address until swept. Volume limits are in the base currency of the token, and the daily
+// volume covers the orders created from the address in the last 24 hours
+func CheckLinkedAddressTransfer(ctx context.Context, linkedAddress *ent.LinkedAddress, token *ent.Token, amount decimal.Decimal) error {
+	if !linkedAddress.IsActive {
+		return ErrLinkedAddressInactive
+	}
+
+	if len(linkedAddress.AllowedNetworks) > 0 && !containsFold(linkedAddress.AllowedNetworks, token.Edges.Network.Identifier) {
+		return ErrLinkedAddressNetworkNotAllowed
+	}
+
+	if len(linkedAddress.AllowedTokens) > 0 && !containsFold(linkedAddress.AllowedTokens, token.Symbol) {
+		return ErrLinkedAddressTokenNotAllowed
+	}
+
+	if linkedAddress.MaxTransferAmount.IsPositive() && amount.GreaterThan(linkedAddress.MaxTransferAmount) {
+		return ErrLinkedAddressTransferLimit
+	}
+
+	if linkedAddress.DailyVolumeLimit.IsPositive() {
+		orders, err := db.Client.PaymentOrder.
+			Query().
+			Where(
+				paymentorder.HasLinkedAddressWith(linkedaddress.IDEQ(linkedAddress.ID)),
+				paymentorder.HasTokenWith(tokenent.BaseCurrencyEQ(token.BaseCurrency)),
+				paymentorder.CreatedAtGTE(time.Now().Add(-24*time.Hour)),
+			).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("CheckLinkedAddressTransfer.dailyVolume: %w", err)
+		}
+
+		volume := amount
+		for _, order := range orders {
+			volume = volume.Add(order.Amount)
+		}
+		if volume.GreaterThan(linkedAddress.DailyVolumeLimit) {
+			return ErrLinkedAddressDailyLimit
+		}
+	}
+
+	return nil
+}
+
+// RotateLinkedAddress replaces a linked address of a sender with a new address bound to the same
+// recipient, restrictions and handle, and deactivates the old address
+func RotateLinkedAddress(ctx context.Context, sender *ent.SenderProfile, linkedAddress *ent.LinkedAddress, address string, salt []byte) (*ent.LinkedAddress, error) {
+	if !linkedAddress.IsActive {
+		return nil, ErrLinkedAddressInactive
+	}
+
+	tx, err := db.Client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("RotateLinkedAddress.tx: %w", err)
+	}
+
+	// The handle moves to the new address, so it is released first
+	err = tx.LinkedAddress.
+		UpdateOneID(linkedAddress.ID).
+		SetIsActive(false).
+		ClearHandle().
+		SetReplacedBy(address).
+		Exec(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("RotateLinkedAddress.deactivate: %w", err)
+	}
+
+	create := tx.LinkedAddress.
+		Create().
+		SetAddress(address).
+		SetSalt(salt).
+		SetInstitution(linkedAddress.Institution).
+		SetAccountIdentifier(linkedAddress.AccountIdentifier).
+		SetAccountName(linkedAddress.AccountName).
+		SetMetadata(linkedAddress.Metadata).
+		SetAllowedNetworks(linkedAddress.AllowedNetworks).
+		SetAllowedTokens(linkedAddress.AllowedTokens).
+		SetMaxTransferAmount(linkedAddress.MaxTransferAmount).
+		SetDailyVolumeLimit(linkedAddress.DailyVolumeLimit).
+		SetSenderProfile(sender)
+	if linkedAddress.Handle != "" {
+		create.SetHandle(linkedAddress.Handle)
+	}
+	rotated, err := create.Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("RotateLinkedAddress.create: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("RotateLinkedAddress.commit: %w", err)
+	}
+
+	return rotated.Unwrap(), nil
+}
+
+// containsFold reports whether values holds value, ignoring case
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,126 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/test"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLinkedAddress(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:linkedaddress?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	ctx := context.Background()
+
+	token, err := test.CreateERC20Token(nil, map[string]interface{}{
+		"symbol":         "USDC",
+		"identifier":     "base-sepolia",
+		"chainID":        int64(84532),
+		"deployContract": false,
+	})
+	assert.NoError(t, err)
+	network := token.Edges.Network
+
+	user, err := test.CreateTestUser(map[string]interface{}{
+		"email": "sender@test.com",
+	})
+	assert.NoError(t, err)
+
+	sender, err := test.CreateTestSenderProfile(map[string]interface{}{
+		"user_id": user.ID,
+		"token":   token.Symbol,
+	})
+	assert.NoError(t, err)
+
+	createLinkedAddress := func(address string) *ent.LinkedAddress {
+		return client.LinkedAddress.Create().
+			SetAddress(address).
+			SetInstitution("ABNGNGLA").
+			SetAccountIdentifier("0123456789").
+			SetAccountName("Alice").
+			SetHandle("alice.shop").
+			SetAllowedNetworks([]string{"base-sepolia"}).
+			SetAllowedTokens([]string{"usdc"}).
+			SetMaxTransferAmount(decimal.NewFromInt(100)).
+			SetDailyVolumeLimit(decimal.NewFromInt(150)).
+			SetSenderProfile(sender).
+			SaveX(ctx)
+	}
+
+	t.Run("should normalize ENS-style handles", func(t *testing.T) {
+		handle, err := NormalizeLinkedAddressHandle(" Alice.Shop ")
+		assert.NoError(t, err)
+		assert.Equal(t, "alice.shop", handle)
+
+		for _, invalid := range []string{"al", "-alice", "alice-", "alice..shop", "alice_shop", "0123456789012345678901234567890123"} {
+			_, err := NormalizeLinkedAddressHandle(invalid)
+			assert.ErrorIs(t, err, ErrLinkedAddressHandleInvalid, invalid)
+		}
+	})
+
+	t.Run("should enforce chain, token and volume restrictions", func(t *testing.T) {
+		linkedAddress := createLinkedAddress("0x1111111111111111111111111111111111111111")
+		defer client.LinkedAddress.DeleteOne(linkedAddress).ExecX(ctx)
+
+		assert.NoError(t, CheckLinkedAddressTransfer(ctx, linkedAddress, token, decimal.NewFromInt(100)))
+		assert.ErrorIs(t, CheckLinkedAddressTransfer(ctx, linkedAddress, token, decimal.NewFromInt(101)), ErrLinkedAddressTransferLimit)
+
+		otherNetwork := &ent.Token{Symbol: "USDC", BaseCurrency: "USD", Edges: ent.TokenEdges{Network: &ent.Network{Identifier: "arbitrum-one"}}}
+		assert.ErrorIs(t, CheckLinkedAddressTransfer(ctx, linkedAddress, otherNetwork, decimal.NewFromInt(1)), ErrLinkedAddressNetworkNotAllowed)
+
+		otherToken := &ent.Token{Symbol: "USDT", BaseCurrency: "USD", Edges: ent.TokenEdges{Network: network}}
+		assert.ErrorIs(t, CheckLinkedAddressTransfer(ctx, linkedAddress, otherToken, decimal.NewFromInt(1)), ErrLinkedAddressTokenNotAllowed)
+
+		// Orders from the address in the last day count towards the daily volume
+		client.PaymentOrder.Create().
+			SetAmount(decimal.NewFromInt(100)).
+			SetAmountPaid(decimal.NewFromInt(100)).
+			SetAmountReturned(decimal.Zero).
+			SetPercentSettled(decimal.Zero).
+			SetNetworkFee(network.Fee).
+			SetSenderFee(decimal.Zero).
+			SetProtocolFee(decimal.Zero).
+			SetAmountInUsd(decimal.NewFromInt(100)).
+			SetRate(decimal.NewFromInt(1500)).
+			SetToken(token).
+			SetReceiveAddressText(linkedAddress.Address).
+			SetFeePercent(decimal.Zero).
+			SetFeeAddress("0x1234567890123456789012345678901234567890").
+			SetLinkedAddress(linkedAddress).
+			SaveX(ctx)
+		assert.NoError(t, CheckLinkedAddressTransfer(ctx, linkedAddress, token, decimal.NewFromInt(50)))
+		assert.ErrorIs(t, CheckLinkedAddressTransfer(ctx, linkedAddress, token, decimal.NewFromInt(51)), ErrLinkedAddressDailyLimit)
+
+		linkedAddress = linkedAddress.Update().SetIsActive(false).SaveX(ctx)
+		assert.ErrorIs(t, CheckLinkedAddressTransfer(ctx, linkedAddress, token, decimal.NewFromInt(1)), ErrLinkedAddressInactive)
+	})
+
+	t.Run("should rotate to a new address with the same binding and handle", func(t *testing.T) {
+		linkedAddress := createLinkedAddress("0x2222222222222222222222222222222222222222")
+
+		rotated, err := RotateLinkedAddress(ctx, sender, linkedAddress, "0x3333333333333333333333333333333333333333", []byte("salt"))
+		assert.NoError(t, err)
+		assert.True(t, rotated.IsActive)
+		assert.Equal(t, "alice.shop", rotated.Handle)
+		assert.Equal(t, linkedAddress.AccountIdentifier, rotated.AccountIdentifier)
+		assert.Equal(t, []string{"base-sepolia"}, rotated.AllowedNetworks)
+		assert.True(t, rotated.DailyVolumeLimit.Equal(decimal.NewFromInt(150)))
+		assert.Equal(t, sender.ID, rotated.QuerySenderProfile().OnlyIDX(ctx))
+
+		old := client.LinkedAddress.GetX(ctx, linkedAddress.ID)
+		assert.False(t, old.IsActive)
+		assert.Empty(t, old.Handle)
+		assert.Equal(t, rotated.Address, old.ReplacedBy)
+
+		_, err = RotateLinkedAddress(ctx, sender, old, "0x4444444444444444444444444444444444444444", nil)
+		assert.ErrorIs(t, err, ErrLinkedAddressInactive)
+	})
+}
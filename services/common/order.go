@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -26,6 +27,7 @@ import (
 	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
 	"github.com/NEDA-LABS/stablenode/ent/user"
 	svc "github.com/NEDA-LABS/stablenode/services"
+	"github.com/NEDA-LABS/stablenode/services/ledger"
 	db "github.com/NEDA-LABS/stablenode/storage"
 	"github.com/NEDA-LABS/stablenode/types"
 	"github.com/NEDA-LABS/stablenode/utils"
@@ -87,6 +89,7 @@ func CreateLockPaymentOrder(
 				Where(
 					paymentorder.MessageHashEQ(event.MessageHash),
 				).
+				WithToken().
 				Only(ctx)
 			if fetchErr != nil {
 				if ent.IsNotFound(fetchErr) {
@@ -101,15 +104,8 @@ func CreateLockPaymentOrder(
 				return fetchErr
 			}
 
-			// Payment order found, update it
-			_, updateErr := db.Client.PaymentOrder.
-				Update().
-				Where(paymentorder.IDEQ(paymentOrder.ID)).
-				SetTxHash(event.TxHash).
-				SetBlockNumber(int64(event.BlockNumber)).
-				SetGatewayID(event.OrderId).
-				SetStatus(paymentorder.StatusPending).
-				Save(ctx)
+			// Payment order found, update it and book it on the gateway
+			updateErr := updatePaymentOrderGatewayID(ctx, paymentOrder, event)
 			if updateErr != nil {
 				logger.Errorf("Failed to update payment order: %v", updateErr)
 			} else {
@@ -175,13 +171,7 @@ func CreateLockPaymentOrder(
 		return createBasicLockPaymentOrderAndCancel(ctx, event, network, token, recipient, "Institution lookup failed", refundOrder)
 	}
 
-	currency, err := db.Client.FiatCurrency.
-		Query().
-		Where(
-			fiatcurrency.IsEnabledEQ(true),
-			fiatcurrency.CodeEQ(institution.Edges.FiatCurrency.Code),
-		).
-		Only(ctx)
+	currency, err := utils.GetFiatCurrencyByCode(ctx, institution.Edges.FiatCurrency.Code)
 	if err != nil {
 		return createBasicLockPaymentOrderAndCancel(ctx, event, network, token, recipient, "Currency lookup failed", refundOrder)
 	}
@@ -295,14 +285,20 @@ func CreateLockPaymentOrder(
 	}
 
 	if provisionBucket == nil && !isPrivate {
-		// TODO: Activate this when split order is tested and working
-		// Split lock payment order into multiple orders
-		// err = s.splitLockPaymentOrder(
-		// 	ctx, client, lockPaymentOrder, currency,
-		// )
-		// if err != nil {
-		// 	return fmt.Errorf("%s - failed to split lock payment order: %w", lockPaymentOrder.GatewayID, err)
-		// }
+		// Split lock payment order into multiple orders across providers of the largest bucket
+		if orderConf.OrderSplitEnabled && lockPaymentOrder.ProviderID == "" {
+			err = splitLockPaymentOrder(ctx, lockPaymentOrder, currency, refundOrder, assignLockPaymentOrder)
+			if err == nil {
+				err = deleteTransferWebhook(ctx, event.TxHash)
+				if err != nil {
+					logger.Errorf("Failed to delete transfer webhook for lock payment order: %v", err)
+				}
+				return nil
+			}
+			if !errors.Is(err, ErrOrderTooLargeToSplit) {
+				return fmt.Errorf("%s - failed to split lock payment order: %w", lockPaymentOrder.GatewayID, err)
+			}
+		}
 
 		err = HandleCancellation(ctx, nil, &lockPaymentOrder, "Amount is larger than the maximum bucket", refundOrder)
 		if err != nil {
@@ -401,24 +397,12 @@ func CreateLockPaymentOrder(
 		}
 
 		// Check AML compliance
-		if serverConf.Environment == "production" && !strings.HasPrefix(network.Identifier, "tron") {
-			fullRPCURL := utils.BuildRPCURL(network.RPCEndpoint)
-			ok, err := CheckAMLCompliance(fullRPCURL, event.TxHash)
+		if !checkOrderAMLCompliance(network, event.TxHash) {
+			err := HandleCancellation(ctx, orderCreated, nil, "AML compliance check failed", refundOrder)
 			if err != nil {
-				logger.WithFields(logger.Fields{
-					"Error":    fmt.Sprintf("%v", err),
-					"endpoint": fullRPCURL,
-					"TxHash":   event.TxHash,
-				}).Errorf("Failed to check AML Compliance")
-			}
-
-			if !ok && err == nil {
-				err := HandleCancellation(ctx, orderCreated, nil, "AML compliance check failed", refundOrder)
-				if err != nil {
-					return fmt.Errorf("checkAMLCompliance.RefundOrder: %w", err)
-				}
-				return nil
+				return fmt.Errorf("checkAMLCompliance.RefundOrder: %w", err)
 			}
+			return nil
 		}
 
 		// Assign the lock payment order to a provider
@@ -429,6 +413,34 @@ func CreateLockPaymentOrder(
 	return nil
 }
 
+// updatePaymentOrderGatewayID links a payment order to the order created for it on the gateway
+func updatePaymentOrderGatewayID(ctx context.Context, paymentOrder *ent.PaymentOrder, event *types.OrderCreatedEvent) error {
+	tx, err := db.Client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.PaymentOrder.
+		Update().
+		Where(paymentorder.IDEQ(paymentOrder.ID)).
+		SetTxHash(event.TxHash).
+		SetBlockNumber(int64(event.BlockNumber)).
+		SetGatewayID(event.OrderId).
+		SetStatus(paymentorder.StatusPending).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := ledger.Record(ctx, tx, ledger.OrderCreated(paymentOrder)); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
 // UpdateOrderStatusRefunded updates the status of a payment order to refunded
 func UpdateOrderStatusRefunded(ctx context.Context, network *ent.Network, event *types.OrderRefundedEvent, messageHash string) error {
 	// Fetch payment order
@@ -445,6 +457,7 @@ func UpdateOrderStatusRefunded(ctx context.Context, network *ent.Network, event
 		).
 		WithSenderProfile().
 		WithLinkedAddress().
+		WithToken().
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -499,11 +512,12 @@ func UpdateOrderStatusRefunded(ctx context.Context, network *ent.Network, event
 		}
 	}
 
-	// Aggregator side status update
+	// Aggregator side status update; parts of a split order that were settled stay settled
 	lockPaymentOrderUpdate := tx.LockPaymentOrder.
 		Update().
 		Where(
 			lockpaymentorder.GatewayIDEQ(event.OrderId),
+			lockpaymentorder.StatusNEQ(lockpaymentorder.StatusSettled),
 			lockpaymentorder.HasTokenWith(
 				tokenent.HasNetworkWith(
 					networkent.IdentifierEQ(network.Identifier),
@@ -524,11 +538,12 @@ func UpdateOrderStatusRefunded(ctx context.Context, network *ent.Network, event
 	}
 
 	// Release reserved balance for refunded orders
-	// Get the lock payment order to access provider and currency info
-	lockOrder, err := tx.LockPaymentOrder.
+	// Get the lock payment orders, the parts of a split order included, to access provider and currency info
+	lockOrders, err := tx.LockPaymentOrder.
 		Query().
 		Where(
 			lockpaymentorder.GatewayIDEQ(event.OrderId),
+			lockpaymentorder.StatusEQ(lockpaymentorder.StatusRefunded),
 			lockpaymentorder.HasTokenWith(
 				tokenent.HasNetworkWith(
 					networkent.IdentifierEQ(network.Identifier),
@@ -539,8 +554,18 @@ func UpdateOrderStatusRefunded(ctx context.Context, network *ent.Network, event
 		WithProvisionBucket(func(pbq *ent.ProvisionBucketQuery) {
 			pbq.WithCurrency()
 		}).
-		Only(ctx)
-	if err == nil && lockOrder != nil && lockOrder.Edges.Provider != nil && lockOrder.Edges.ProvisionBucket != nil && lockOrder.Edges.ProvisionBucket.Edges.Currency != nil {
+		All(ctx)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":   fmt.Sprintf("%v", err),
+			"OrderID": event.OrderId,
+		}).Errorf("failed to fetch lock payment orders to release reserved balance")
+	}
+	for _, lockOrder := range lockOrders {
+		if lockOrder.Edges.Provider == nil || lockOrder.Edges.ProvisionBucket == nil || lockOrder.Edges.ProvisionBucket.Edges.Currency == nil {
+			continue
+		}
+
 		// Only attempt balance operations if we have the required edge data
 		// Create a new balance service instance for this transaction
 		balanceService := svc.NewBalanceManagementService()
@@ -592,6 +617,10 @@ func UpdateOrderStatusRefunded(ctx context.Context, network *ent.Network, event
 			return fmt.Errorf("UpdateOrderStatusRefunded.sender: %v", err)
 		}
 
+		if err := ledger.Record(ctx, tx, ledger.GatewayRefund(paymentOrder, paymentOrder.PercentSettled)); err != nil {
+			return fmt.Errorf("UpdateOrderStatusRefunded.ledger: %v", err)
+		}
+
 		// Update the local paymentOrder object for webhook
 		paymentOrder.Status = paymentorder.StatusRefunded
 		paymentOrder.TxHash = event.TxHash
@@ -630,6 +659,7 @@ func UpdateOrderStatusSettled(ctx context.Context, network *ent.Network, event *
 			),
 		).
 		WithSenderProfile().
+		WithToken().
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -688,6 +718,18 @@ func UpdateOrderStatusSettled(ctx context.Context, network *ent.Network, event *
 		return fmt.Errorf("UpdateOrderStatusSettled.splitOrderId: %v", err)
 	}
 
+	// A settlement indexed again must not count towards the provider's stats twice
+	lockOrderSettled, err := tx.LockPaymentOrder.
+		Query().
+		Where(
+			lockpaymentorder.IDEQ(splitOrderId),
+			lockpaymentorder.StatusEQ(lockpaymentorder.StatusSettled),
+		).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("UpdateOrderStatusSettled.aggregator: %v", err)
+	}
+
 	lockPaymentOrderUpdate := tx.LockPaymentOrder.
 		Update().
 		Where(
@@ -711,6 +753,12 @@ func UpdateOrderStatusSettled(ctx context.Context, network *ent.Network, event *
 		return fmt.Errorf("UpdateOrderStatusSettled.aggregator: %v", err)
 	}
 
+	if !lockOrderSettled {
+		if err := settleSplitParent(ctx, tx, splitOrderId, event); err != nil {
+			return fmt.Errorf("UpdateOrderStatusSettled.splitParent: %v", err)
+		}
+	}
+
 	// Update provider balance for settled orders
 	// Get the lock payment order to access provider and currency info
 	lockOrder, err := tx.LockPaymentOrder.
@@ -803,6 +851,11 @@ func UpdateOrderStatusSettled(ctx context.Context, network *ent.Network, event *
 			return fmt.Errorf("UpdateOrderStatusSettled.sender: %v", err)
 		}
 
+		err = ledger.Record(ctx, tx, ledger.Settlement(paymentOrder, splitOrderId.String(), paymentOrder.PercentSettled, settledPercent))
+		if err != nil {
+			return fmt.Errorf("UpdateOrderStatusSettled.ledger: %v", err)
+		}
+
 		paymentOrder.BlockNumber = event.BlockNumber
 		paymentOrder.GatewayID = event.OrderId
 		paymentOrder.TxHash = event.TxHash
@@ -850,6 +903,16 @@ func UpdateOrderStatusSettled(ctx context.Context, network *ent.Network, event *
 		return fmt.Errorf("UpdateOrderStatusSettled.sender %v", err)
 	}
 
+	if !lockOrderSettled && lockOrder != nil && lockOrder.Edges.Provider != nil {
+		if err := RecordProviderSettlement(ctx, lockOrder); err != nil {
+			logger.WithFields(logger.Fields{
+				"Error":      fmt.Sprintf("%v", err),
+				"OrderID":    splitOrderId.String(),
+				"ProviderID": lockOrder.Edges.Provider.ID,
+			}).Errorf("failed to record provider settlement")
+		}
+	}
+
 	if paymentOrderExists && paymentOrder.Status != paymentorder.StatusSettled {
 		if settledPercent.GreaterThanOrEqual(decimal.NewFromInt(100)) {
 			paymentOrder.Status = paymentorder.StatusSettled
@@ -897,6 +960,23 @@ func GetProvisionBucket(ctx context.Context, amount decimal.Decimal, currency *e
 			if amount.LessThan(minBucket.MinAmount) {
 				return nil, true, nil
 			}
+
+			// Check if the amount is more than the maximum bucket
+			maxBucket, err := db.Client.ProvisionBucket.
+				Query().
+				Where(
+					provisionbucket.HasCurrencyWith(
+						fiatcurrency.IDEQ(currency.ID),
+					),
+				).
+				Order(ent.Desc(provisionbucket.FieldMaxAmount)).
+				First(ctx)
+			if err != nil {
+				return nil, false, fmt.Errorf("failed to fetch maximum bucket: %w", err)
+			}
+			if amount.GreaterThan(maxBucket.MaxAmount) {
+				return nil, false, nil
+			}
 		}
 		return nil, false, fmt.Errorf("failed to fetch provision bucket: %w", err)
 	}
@@ -995,6 +1075,27 @@ func HandleCancellation(ctx context.Context, createdLockPaymentOrder *ent.LockPa
 	return nil
 }
 
+// checkOrderAMLCompliance reports whether the transaction creating an order passes the AML check. Only
+// production EVM orders are checked, and an order whose check fails to run is let through
+func checkOrderAMLCompliance(network *ent.Network, txHash string) bool {
+	if serverConf.Environment != "production" || strings.HasPrefix(network.Identifier, "tron") {
+		return true
+	}
+
+	fullRPCURL := utils.BuildRPCURL(network.RPCEndpoint)
+	ok, err := CheckAMLCompliance(fullRPCURL, txHash)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":    fmt.Sprintf("%v", err),
+			"endpoint": fullRPCURL,
+			"TxHash":   txHash,
+		}).Errorf("Failed to check AML Compliance")
+		return true
+	}
+
+	return ok
+}
+
 // CheckAMLCompliance checks if a transaction is compliant with AML regulations.
 func CheckAMLCompliance(rpcUrl string, txHash string) (bool, error) {
 	if !strings.Contains(rpcUrl, "shield3") {
@@ -1046,7 +1147,7 @@ func HandleReceiveAddressValidity(ctx context.Context, receiveAddress *ent.Recei
 
 	if receiveAddress.Status != receiveaddress.StatusUsed {
 		validUntilIsFarGone := receiveAddress.ValidUntil.Before(time.Now().Add(-(2 * time.Minute)))
-		isExpired := receiveAddress.ValidUntil.Before(time.Now())
+		isExpired := receiveAddress.ValidUntil.Before(time.Now().Add(-orderConf.ReceiveAddressGracePeriod))
 
 		if validUntilIsFarGone {
 			_, err := receiveAddress.
@@ -1074,6 +1175,7 @@ func HandleReceiveAddressValidity(ctx context.Context, receiveAddress *ent.Recei
 			if err != nil {
 				return fmt.Errorf("HandleReceiveAddressValidity.db: %v", err)
 			}
+			paymentOrder.Status = paymentorder.StatusExpired
 
 			// Send webhook notification for expired payment order
 			// The paymentOrder already has all necessary edges loaded from tasks.go
@@ -1117,7 +1219,7 @@ func deleteTransferWebhook(ctx context.Context, txHash string) error {
 	engineService := svc.NewEngineService()
 
 	// Delete the webhook from thirdweb and our database
-	err = engineService.DeleteWebhookAndRecord(ctx, paymentOrder.Edges.PaymentWebhook.WebhookID)
+	err = engineService.ReleaseOrderWebhook(ctx, paymentOrder.Edges.PaymentWebhook)
 	if err != nil {
 		return fmt.Errorf("failed to delete webhook: %w", err)
 	}
@@ -0,0 +1,199 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorderrecipient"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	svc "github.com/NEDA-LABS/stablenode/services"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/NEDA-LABS/stablenode/utils/metrics"
+)
+
+// orderExpiryBatchSize is the number of orders expired per query
+const orderExpiryBatchSize = 100
+
+// OrderTTL returns how long an unpaid order stays open: the sender's TTL, else the network's,
+// else RECEIVE_ADDRESS_VALIDITY
+func OrderTTL(sender *ent.SenderProfile, network *ent.Network) time.Duration {
+	if sender != nil && sender.OrderTTLMinutes != nil {
+		return time.Duration(*sender.OrderTTLMinutes) * time.Minute
+	}
+	if network != nil && network.OrderTTLMinutes != nil {
+		return time.Duration(*network.OrderTTLMinutes) * time.Minute
+	}
+	return config.OrderConfig().ReceiveAddressValidity
+}
+
+// ExpireOrders expires initiated orders whose receive address validity and grace period have passed
+// without a deposit. Their receive addresses are released, their transfer webhooks removed and their senders
+// notified. Private orders never expire. Returns the number of orders expired
+func ExpireOrders(ctx context.Context) (int, error) {
+	expired := 0
+	for {
+		orders, err := db.Client.PaymentOrder.
+			Query().
+			Where(
+				paymentorder.StatusEQ(paymentorder.StatusInitiated),
+				paymentorder.HasReceiveAddressWith(
+					receiveaddress.StatusIn(receiveaddress.StatusUnused, receiveaddress.StatusPoolAssigned),
+					receiveaddress.ValidUntilNotNil(),
+					receiveaddress.ValidUntilLTE(time.Now().Add(-config.OrderConfig().ReceiveAddressGracePeriod)),
+				),
+				paymentorder.Not(paymentorder.HasRecipientWith(
+					paymentorderrecipient.MemoHasPrefix("P#P"),
+				)),
+			).
+			WithReceiveAddress().
+			WithRecipient().
+			WithSenderProfile().
+			WithPaymentWebhook().
+			WithToken(func(tq *ent.TokenQuery) {
+				tq.WithNetwork()
+			}).
+			Order(ent.Asc(paymentorder.FieldCreatedAt)).
+			Limit(orderExpiryBatchSize).
+			All(ctx)
+		if err != nil {
+			return expired, fmt.Errorf("ExpireOrders.db: %w", err)
+		}
+
+		for _, order := range orders {
+			ok, err := expireOrder(ctx, order)
+			if err != nil {
+				return expired, fmt.Errorf("ExpireOrders: %w", err)
+			}
+			if ok {
+				expired++
+			}
+		}
+
+		if len(orders) < orderExpiryBatchSize {
+			return expired, nil
+		}
+	}
+}
+
+// expireOrder marks an order expired and releases its receive address. An order paid in the
+// meantime is left alone. Returns whether the order was expired
+func expireOrder(ctx context.Context, order *ent.PaymentOrder) (bool, error) {
+	tx, err := db.Client.Tx(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	updated, err := tx.PaymentOrder.
+		Update().
+		Where(
+			paymentorder.IDEQ(order.ID),
+			paymentorder.StatusEQ(paymentorder.StatusInitiated),
+		).
+		SetStatus(paymentorder.StatusExpired).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return false, fmt.Errorf("failed to expire order %s: %w", order.ID, err)
+	}
+	if updated == 0 {
+		_ = tx.Rollback()
+		return false, nil
+	}
+
+	// A partial payment stays in the receive address until it is refunded to the payer
+	holdsPayment := order.AmountPaid.GreaterThan(order.AmountReturned)
+	if err := releaseReceiveAddress(ctx, tx, order.Edges.ReceiveAddress, holdsPayment); err != nil {
+		_ = tx.Rollback()
+		return false, fmt.Errorf("failed to release receive address of order %s: %w", order.ID, err)
+	}
+
+	if err := ReleaseAddressAssignments(ctx, tx.Client(), order.ID); err != nil {
+		_ = tx.Rollback()
+		return false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+	order.Status = paymentorder.StatusExpired
+
+	network := order.Edges.Token.Edges.Network.Identifier
+	metrics.OrdersExpired.WithLabelValues(network).Inc()
+	logger.WithFields(logger.Fields{
+		"OrderID":        order.ID.String(),
+		"ReceiveAddress": order.Edges.ReceiveAddress.Address,
+		"Network":        network,
+	}).Infof("Expired unpaid payment order")
+
+	// Stop monitoring the receive address for the order
+	if order.Edges.PaymentWebhook != nil {
+		err := svc.NewEngineService().ReleaseOrderWebhook(ctx, order.Edges.PaymentWebhook)
+		if err != nil {
+			logger.WithFields(logger.Fields{
+				"Error":     fmt.Sprintf("%v", err),
+				"OrderID":   order.ID.String(),
+				"WebhookID": order.Edges.PaymentWebhook.WebhookID,
+			}).Errorf("Failed to delete transfer webhook of expired order")
+		}
+	}
+
+	if err := utils.SendPaymentOrderWebhook(ctx, order); err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":   fmt.Sprintf("%v", err),
+			"OrderID": order.ID.String(),
+		}).Errorf("Failed to send expired payment order webhook")
+	}
+
+	return true, nil
+}
+
+// releaseReceiveAddress releases the receive address of an expired order. A pool address goes back
+// to pool_ready, unless the pool already holds it, in which case the order's row is only expired. An
+// address holding part of the order's payment is only expired, and recycled once the refund of the
+// payment is confirmed
+func releaseReceiveAddress(ctx context.Context, tx *ent.Tx, address *ent.ReceiveAddress, holdsPayment bool) error {
+	if address.Status != receiveaddress.StatusPoolAssigned || holdsPayment {
+		return tx.ReceiveAddress.
+			UpdateOne(address).
+			SetStatus(receiveaddress.StatusExpired).
+			Exec(ctx)
+	}
+
+	return recycleReceiveAddress(ctx, tx, address)
+}
+
+// recycleReceiveAddress returns the row of a pool address an order held to pool_ready, unless the
+// pool already holds the address, in which case the row is only expired
+func recycleReceiveAddress(ctx context.Context, tx *ent.Tx, address *ent.ReceiveAddress) error {
+	pooled, err := tx.ReceiveAddress.
+		Query().
+		Where(
+			receiveaddress.IDNEQ(address.ID),
+			receiveaddress.AddressEQ(address.Address),
+			receiveaddress.NetworkIdentifierEQ(address.NetworkIdentifier),
+			receiveaddress.StatusEQ(receiveaddress.StatusPoolReady),
+		).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+
+	update := tx.ReceiveAddress.
+		UpdateOne(address).
+		ClearAssignedAt()
+	if pooled {
+		update.SetStatus(receiveaddress.StatusExpired)
+	} else {
+		update.
+			SetStatus(receiveaddress.StatusPoolReady).
+			SetRecycledAt(time.Now())
+	}
+
+	return update.Exec(ctx)
+}
@@ -0,0 +1,140 @@
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/test"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpireOrders(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:orderexpiry?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	ctx := context.Background()
+
+	token, err := test.CreateERC20Token(nil, map[string]interface{}{
+		"symbol":         "USDC",
+		"identifier":     "base-sepolia",
+		"chainID":        int64(84532),
+		"deployContract": false,
+	})
+	assert.NoError(t, err)
+	network := token.Edges.Network
+
+	user, err := test.CreateTestUser(map[string]interface{}{
+		"email": "sender@test.com",
+	})
+	assert.NoError(t, err)
+
+	sender, err := test.CreateTestSenderProfile(map[string]interface{}{
+		"user_id": user.ID,
+		"token":   token.Symbol,
+	})
+	assert.NoError(t, err)
+
+	poolAddress := "0x1111111111111111111111111111111111111111"
+	createAddress := func(address string, status receiveaddress.Status, validUntil time.Time) *ent.ReceiveAddress {
+		receiveAddress, err := client.ReceiveAddress.
+			Create().
+			SetAddress(address).
+			SetStatus(status).
+			SetIsDeployed(true).
+			SetNetworkIdentifier(network.Identifier).
+			SetChainID(network.ChainID).
+			SetAssignedAt(time.Now().Add(-time.Hour)).
+			SetValidUntil(validUntil).
+			Save(ctx)
+		assert.NoError(t, err)
+		return receiveAddress
+	}
+	createOrder := func(receiveAddress *ent.ReceiveAddress, memo string) *ent.PaymentOrder {
+		order, err := test.CreateTestPaymentOrder(nil, token, map[string]interface{}{
+			"sender":          sender,
+			"receive_address": receiveAddress,
+			"amount":          10.0,
+			"amount_in_usd":   10.0,
+			"rate":            130.0,
+			"status":          "initiated",
+			"memo":            memo,
+		})
+		assert.NoError(t, err)
+		return order
+	}
+
+	past := time.Now().Add(-time.Hour)
+	pool := createAddress(poolAddress, receiveaddress.StatusPoolReady, time.Time{})
+	shared := createOrder(createAddress(poolAddress, receiveaddress.StatusPoolAssigned, past), "")
+	legacy := createOrder(createAddress("0x2222222222222222222222222222222222222222", receiveaddress.StatusPoolAssigned, past), "")
+	fresh := createOrder(createAddress("TXYZopYRdj2D9XRtbG411XZZ3kM5VkAeBf", receiveaddress.StatusUnused, past), "")
+	open := createOrder(createAddress(poolAddress, receiveaddress.StatusPoolAssigned, time.Now().Add(time.Hour)), "")
+	private := createOrder(createAddress(poolAddress, receiveaddress.StatusPoolAssigned, past), "P#P private order")
+	lapsed := createOrder(createAddress(poolAddress, receiveaddress.StatusPoolAssigned, time.Now().Add(-time.Minute)), "")
+
+	heldAddress := "0x3333333333333333333333333333333333333333"
+	createAddress(heldAddress, receiveaddress.StatusPoolReady, time.Time{})
+	held := createOrder(createAddress(heldAddress, receiveaddress.StatusPoolAssigned, past), "")
+	held = client.PaymentOrder.UpdateOne(held).SetAmountPaid(decimal.NewFromFloat(4)).SaveX(ctx)
+
+	expired, err := ExpireOrders(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, expired)
+
+	orderStatus := func(order *ent.PaymentOrder) (paymentorder.Status, receiveaddress.Status) {
+		order = client.PaymentOrder.Query().Where(paymentorder.IDEQ(order.ID)).WithReceiveAddress().OnlyX(ctx)
+		return order.Status, order.Edges.ReceiveAddress.Status
+	}
+
+	t.Run("should expire orders sharing a pool address without duplicating it", func(t *testing.T) {
+		status, addressStatus := orderStatus(shared)
+		assert.Equal(t, paymentorder.StatusExpired, status)
+		assert.Equal(t, receiveaddress.StatusExpired, addressStatus)
+		assert.Equal(t, receiveaddress.StatusPoolReady, client.ReceiveAddress.GetX(ctx, pool.ID).Status)
+	})
+
+	t.Run("should return pool addresses the pool lost track of", func(t *testing.T) {
+		status, addressStatus := orderStatus(legacy)
+		assert.Equal(t, paymentorder.StatusExpired, status)
+		assert.Equal(t, receiveaddress.StatusPoolReady, addressStatus)
+	})
+
+	t.Run("should expire orders with their own address", func(t *testing.T) {
+		status, addressStatus := orderStatus(fresh)
+		assert.Equal(t, paymentorder.StatusExpired, status)
+		assert.Equal(t, receiveaddress.StatusExpired, addressStatus)
+	})
+
+	t.Run("should leave open, private and lapsed orders within the grace period alone", func(t *testing.T) {
+		for _, order := range []*ent.PaymentOrder{open, private, lapsed} {
+			status, addressStatus := orderStatus(order)
+			assert.Equal(t, paymentorder.StatusInitiated, status)
+			assert.Equal(t, receiveaddress.StatusPoolAssigned, addressStatus)
+		}
+	})
+
+	t.Run("should hold pool addresses with a partial payment until it is refunded", func(t *testing.T) {
+		status, addressStatus := orderStatus(held)
+		assert.Equal(t, paymentorder.StatusExpired, status)
+		assert.Equal(t, receiveaddress.StatusExpired, addressStatus)
+
+		_, err := db.AssignPoolAddress(ctx, network.Identifier, time.Hour, poolAddress, "0x2222222222222222222222222222222222222222")
+		assert.True(t, ent.IsNotFound(err))
+	})
+
+	t.Run("should prefer the sender's TTL over the network's", func(t *testing.T) {
+		senderTTL, networkTTL := 15, 45
+		assert.Equal(t, 15*time.Minute, OrderTTL(&ent.SenderProfile{OrderTTLMinutes: &senderTTL}, &ent.Network{OrderTTLMinutes: &networkTTL}))
+		assert.Equal(t, 45*time.Minute, OrderTTL(&ent.SenderProfile{}, &ent.Network{OrderTTLMinutes: &networkTTL}))
+		assert.Equal(t, 30*time.Minute, OrderTTL(&ent.SenderProfile{}, &ent.Network{}))
+	})
+}
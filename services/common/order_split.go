@@ -0,0 +1,237 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/fiatcurrency"
+	"github.com/NEDA-LABS/stablenode/ent/lockpaymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/provisionbucket"
+	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+)
+
+// ErrOrderTooLargeToSplit is returned when an order needs more parts than allowed to fit the largest
+// provision bucket of its currency
+var ErrOrderTooLargeToSplit = errors.New("order is too large to split within the maximum number of parts")
+
+// gatewayMaxBPS is the whole of an order in the BPS the gateway settles shares of orders in
+const gatewayMaxBPS = 100000
+
+// splitLockPaymentOrder splits an order larger than the largest provision bucket of its currency into
+// parts that each fit the bucket, and assigns each part to a provider separately. The order is saved
+// with status split as the parent of the parts. The parts share the gateway order on-chain, each
+// settling its percent of it, and the parent is settled once all of them are
+func splitLockPaymentOrder(
+	ctx context.Context,
+	lockPaymentOrder types.LockPaymentOrderFields,
+	currency *ent.FiatCurrency,
+	refundOrder func(context.Context, *ent.Network, string) error,
+	assignLockPaymentOrder func(context.Context, types.LockPaymentOrderFields) error,
+) error {
+	bucket, err := db.Client.ProvisionBucket.
+		Query().
+		Where(provisionbucket.HasCurrencyWith(fiatcurrency.IDEQ(currency.ID))).
+		Order(ent.Desc(provisionbucket.FieldMaxAmount)).
+		WithCurrency().
+		First(ctx)
+	if err != nil {
+		return fmt.Errorf("splitLockPaymentOrder.bucket: %w", err)
+	}
+
+	fiatAmount := lockPaymentOrder.Amount.Mul(lockPaymentOrder.Rate)
+	count := int(fiatAmount.Div(bucket.MaxAmount).Ceil().IntPart())
+	if count > orderConf.OrderSplitMaxParts {
+		return ErrOrderTooLargeToSplit
+	}
+
+	lockPaymentOrder.ProvisionBucket = bucket
+	parts, percents := splitOrderParts(lockPaymentOrder, count)
+
+	tx, err := db.Client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("splitLockPaymentOrder.tx: %w", err)
+	}
+
+	transactionLog, err := tx.TransactionLog.
+		Create().
+		SetStatus(transactionlog.StatusOrderCreated).
+		SetTxHash(lockPaymentOrder.TxHash).
+		SetNetwork(lockPaymentOrder.Network.Identifier).
+		SetGatewayID(lockPaymentOrder.GatewayID).
+		SetMetadata(map[string]interface{}{
+			"Token":     lockPaymentOrder.Token,
+			"GatewayID": lockPaymentOrder.GatewayID,
+			"Amount":    lockPaymentOrder.Amount,
+			"Rate":      lockPaymentOrder.Rate,
+			"Memo":      lockPaymentOrder.Memo,
+			"Metadata":  lockPaymentOrder.Metadata,
+			"Parts":     count,
+		}).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("splitLockPaymentOrder.transactionLog: %w", err)
+	}
+
+	parent, err := lockPaymentOrderCreate(tx, lockPaymentOrder, bucket).
+		SetOrderPercent(decimal.NewFromInt(100)).
+		SetStatus(lockpaymentorder.StatusSplit).
+		AddTransactions(transactionLog).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("splitLockPaymentOrder.parent: %w", err)
+	}
+
+	for i := range parts {
+		part, err := lockPaymentOrderCreate(tx, parts[i], bucket).
+			SetOrderPercent(percents[i]).
+			SetSplitIndex(i + 1).
+			SetParent(parent).
+			Save(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("splitLockPaymentOrder.part: %w", err)
+		}
+		parts[i].ID = part.ID
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("splitLockPaymentOrder.commit: %w", err)
+	}
+	parent = parent.Unwrap()
+
+	logger.WithFields(logger.Fields{
+		"GatewayID": lockPaymentOrder.GatewayID,
+		"Amount":    lockPaymentOrder.Amount.String(),
+		"Parts":     count,
+		"Bucket":    fmt.Sprintf("%s-%s", bucket.MinAmount, bucket.MaxAmount),
+	}).Infof("Split order larger than the maximum bucket across providers")
+
+	if !checkOrderAMLCompliance(lockPaymentOrder.Network, lockPaymentOrder.TxHash) {
+		err := db.Client.LockPaymentOrder.
+			Update().
+			Where(lockpaymentorder.HasParentWith(lockpaymentorder.IDEQ(parent.ID))).
+			SetStatus(lockpaymentorder.StatusCancelled).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("splitLockPaymentOrder.cancelParts: %w", err)
+		}
+		if err := HandleCancellation(ctx, parent, nil, "AML compliance check failed", refundOrder); err != nil {
+			return fmt.Errorf("checkAMLCompliance.RefundOrder: %w", err)
+		}
+		return nil
+	}
+
+	for _, part := range parts {
+		_ = assignLockPaymentOrder(ctx, part)
+	}
+
+	return nil
+}
+
+// splitOrderParts divides an order into count parts of equal shares, and returns the parts with the
+// percent of the order each settles. Shares are whole gateway BPS, and the last part takes what
+// rounding leaves so the parts add up to the order exactly
+func splitOrderParts(order types.LockPaymentOrderFields, count int) ([]types.LockPaymentOrderFields, []decimal.Decimal) {
+	decimals := int32(order.Token.Decimals)
+	shareBPS := int64(gatewayMaxBPS / count)
+	share := decimal.NewFromInt(shareBPS).Div(decimal.NewFromInt(gatewayMaxBPS))
+
+	parts := make([]types.LockPaymentOrderFields, count)
+	percents := make([]decimal.Decimal, count)
+	amountLeft, feeLeft, usdLeft := order.Amount, order.ProtocolFee, order.AmountInUSD
+	percentLeft := decimal.NewFromInt(100)
+	for i := range parts {
+		part := order
+		if i < count-1 {
+			part.Amount = order.Amount.Mul(share).RoundDown(decimals)
+			part.ProtocolFee = order.ProtocolFee.Mul(share).RoundDown(decimals)
+			part.AmountInUSD = order.AmountInUSD.Mul(share).RoundDown(2)
+			percents[i] = share.Mul(decimal.NewFromInt(100))
+		} else {
+			part.Amount = amountLeft
+			part.ProtocolFee = feeLeft
+			part.AmountInUSD = usdLeft
+			percents[i] = percentLeft
+		}
+		amountLeft = amountLeft.Sub(part.Amount)
+		feeLeft = feeLeft.Sub(part.ProtocolFee)
+		usdLeft = usdLeft.Sub(part.AmountInUSD)
+		percentLeft = percentLeft.Sub(percents[i])
+		parts[i] = part
+	}
+
+	return parts, percents
+}
+
+// lockPaymentOrderCreate builds a lock payment order from its fields in a bucket
+func lockPaymentOrderCreate(tx *ent.Tx, order types.LockPaymentOrderFields, bucket *ent.ProvisionBucket) *ent.LockPaymentOrderCreate {
+	return tx.LockPaymentOrder.
+		Create().
+		SetToken(order.Token).
+		SetGatewayID(order.GatewayID).
+		SetAmount(order.Amount).
+		SetRate(order.Rate).
+		SetProtocolFee(order.ProtocolFee).
+		SetAmountInUsd(order.AmountInUSD).
+		SetBlockNumber(order.BlockNumber).
+		SetTxHash(order.TxHash).
+		SetInstitution(order.Institution).
+		SetAccountIdentifier(order.AccountIdentifier).
+		SetAccountName(order.AccountName).
+		SetSender(order.Sender).
+		SetMessageHash(order.MessageHash).
+		SetMemo(order.Memo).
+		SetMetadata(order.Metadata).
+		SetProvisionBucket(bucket)
+}
+
+// settleSplitParent settles the parent of a part of a split order once all of its parts are settled
+func settleSplitParent(ctx context.Context, tx *ent.Tx, partID uuid.UUID, event *types.OrderSettledEvent) error {
+	parent, err := tx.LockPaymentOrder.
+		Query().
+		Where(lockpaymentorder.IDEQ(partID)).
+		QueryParent().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	unsettled, err := tx.LockPaymentOrder.
+		Query().
+		Where(
+			lockpaymentorder.HasParentWith(lockpaymentorder.IDEQ(parent.ID)),
+			lockpaymentorder.StatusNEQ(lockpaymentorder.StatusSettled),
+		).
+		Count(ctx)
+	if err != nil {
+		return err
+	}
+	if unsettled > 0 {
+		logger.WithFields(logger.Fields{
+			"GatewayID":      parent.GatewayID,
+			"PartID":         partID.String(),
+			"UnsettledParts": unsettled,
+		}).Infof("Split order part settled")
+		return nil
+	}
+
+	return tx.LockPaymentOrder.
+		UpdateOne(parent).
+		SetStatus(lockpaymentorder.StatusSettled).
+		SetTxHash(event.TxHash).
+		SetBlockNumber(event.BlockNumber).
+		Exec(ctx)
+}
@@ -0,0 +1,108 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	"github.com/NEDA-LABS/stablenode/ent/lockpaymentorder"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderSplit(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ordersplit?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	ctx := context.Background()
+	fixture := setupAdminOrders(t, ctx)
+	currency := fixture.bucket.QueryCurrency().OnlyX(ctx)
+	network := fixture.token.QueryNetwork().OnlyX(ctx)
+
+	newOrder := func(gatewayID string, amount decimal.Decimal) types.LockPaymentOrderFields {
+		return types.LockPaymentOrderFields{
+			Token:             fixture.token,
+			Network:           network,
+			GatewayID:         gatewayID,
+			Amount:            amount,
+			Rate:              decimal.NewFromInt(130),
+			ProtocolFee:       decimal.NewFromFloat(0.5),
+			AmountInUSD:       amount,
+			BlockNumber:       1,
+			TxHash:            gatewayID,
+			Institution:       "MPESAKES",
+			AccountIdentifier: "0700000000",
+			AccountName:       "Test Account",
+		}
+	}
+
+	t.Run("should divide an order into parts adding up to it", func(t *testing.T) {
+		parts, percents := splitOrderParts(newOrder("0x01", decimal.NewFromInt(200)), 3)
+		assert.Len(t, parts, 3)
+
+		amount, fee, percent := decimal.Zero, decimal.Zero, decimal.Zero
+		for i, part := range parts {
+			amount = amount.Add(part.Amount)
+			fee = fee.Add(part.ProtocolFee)
+			percent = percent.Add(percents[i])
+		}
+		assert.True(t, amount.Equal(decimal.NewFromInt(200)))
+		assert.True(t, fee.Equal(decimal.NewFromFloat(0.5)))
+		assert.True(t, percent.Equal(decimal.NewFromInt(100)))
+		assert.True(t, parts[0].Amount.Equal(decimal.RequireFromString("66.666")))
+		assert.True(t, percents[0].Equal(decimal.RequireFromString("33.333")))
+		assert.True(t, percents[2].Equal(decimal.RequireFromString("33.334")))
+	})
+
+	t.Run("should split an order across the largest bucket and settle the parent with its parts", func(t *testing.T) {
+		var assigned []types.LockPaymentOrderFields
+		assign := func(ctx context.Context, order types.LockPaymentOrderFields) error {
+			assigned = append(assigned, order)
+			return nil
+		}
+
+		// 26000 KES needs three parts of the 10000 KES bucket
+		err := splitLockPaymentOrder(ctx, newOrder("0x02", decimal.NewFromInt(200)), currency, nil, assign)
+		assert.NoError(t, err)
+		assert.Len(t, assigned, 3)
+
+		parent := client.LockPaymentOrder.Query().
+			Where(lockpaymentorder.StatusEQ(lockpaymentorder.StatusSplit)).
+			OnlyX(ctx)
+		parts := parent.QueryChildren().Order(ent.Asc(lockpaymentorder.FieldSplitIndex)).AllX(ctx)
+		assert.Len(t, parts, 3)
+		for i, part := range parts {
+			assert.Equal(t, i+1, part.SplitIndex)
+			assert.Equal(t, lockpaymentorder.StatusPending, part.Status)
+			assert.Equal(t, part.ID, assigned[i].ID)
+			assert.Equal(t, fixture.bucket.ID, assigned[i].ProvisionBucket.ID)
+		}
+
+		settle := func(part *ent.LockPaymentOrder) {
+			tx, err := client.Tx(ctx)
+			assert.NoError(t, err)
+			tx.LockPaymentOrder.UpdateOneID(part.ID).SetStatus(lockpaymentorder.StatusSettled).ExecX(ctx)
+			assert.NoError(t, settleSplitParent(ctx, tx, part.ID, &types.OrderSettledEvent{TxHash: "0xsettle", BlockNumber: 2}))
+			assert.NoError(t, tx.Commit())
+		}
+
+		settle(parts[0])
+		settle(parts[1])
+		assert.Equal(t, lockpaymentorder.StatusSplit, client.LockPaymentOrder.GetX(ctx, parent.ID).Status)
+
+		settle(parts[2])
+		parent = client.LockPaymentOrder.GetX(ctx, parent.ID)
+		assert.Equal(t, lockpaymentorder.StatusSettled, parent.Status)
+		assert.Equal(t, "0xsettle", parent.TxHash)
+	})
+
+	t.Run("should not split an order into more parts than allowed", func(t *testing.T) {
+		err := splitLockPaymentOrder(ctx, newOrder("0x03", decimal.NewFromInt(10000)), currency, nil, nil)
+		assert.ErrorIs(t, err, ErrOrderTooLargeToSplit)
+	})
+}
@@ -0,0 +1,184 @@
+package common
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/ledgerentry"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
+	"github.com/NEDA-LABS/stablenode/services"
+	"github.com/NEDA-LABS/stablenode/services/ledger"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/shopspring/decimal"
+)
+
+// RefundOverpayments sends the recorded excess of overpaid orders back to the payer. An order is refunded
+// once it is created on-chain, so the refund never competes with the order for the receive address funds.
+func RefundOverpayments(ctx context.Context) error {
+	orders, err := db.Client.PaymentOrder.
+		Query().
+		Where(
+			paymentorder.AmountOverpaidGT(decimal.Zero),
+			paymentorder.GatewayIDNEQ(""),
+			paymentorder.Not(paymentorder.HasTransactionsWith(
+				transactionlog.StatusEQ(transactionlog.StatusOverpaymentRefunded),
+			)),
+		).
+		WithToken(func(tq *ent.TokenQuery) {
+			tq.WithNetwork()
+		}).
+		WithReceiveAddress().
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("RefundOverpayments.db: %w", err)
+	}
+
+	for _, order := range orders {
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("RefundOverpayments: %w", err)
+		}
+		if err := refundOverpayment(ctx, order); err != nil {
+			logger.WithFields(logger.Fields{
+				"Error":   fmt.Sprintf("%v", err),
+				"OrderID": order.ID.String(),
+				"Excess":  order.AmountOverpaid,
+			}).Errorf("Failed to refund overpayment")
+		}
+	}
+
+	return nil
+}
+
+// refundOverpayment sweeps the excess of an order from its receive address to the return address,
+// falling back to the address the deposit came from
+func refundOverpayment(ctx context.Context, order *ent.PaymentOrder) error {
+	if order.Edges.ReceiveAddress == nil {
+		return fmt.Errorf("order has no receive address")
+	}
+
+	refundAddress := order.ReturnAddress
+	if refundAddress == "" {
+		refundAddress = order.FromAddress
+	}
+	if refundAddress == "" {
+		return fmt.Errorf("order has no return or from address")
+	}
+
+	// Claim the refund before sweeping, so a failure after the sweep can never pay the excess twice
+	transactionLog, err := claimOverpaymentRefund(ctx, order, refundAddress)
+	if err != nil {
+		return err
+	}
+
+	// High-value refunds wait for the offline signer like any other sweep
+	refundCtx := services.WithGasSpend(ctx, transactionlog.PurposeRefund, &order.ID)
+	sweep, err := services.NewSweepService().CreateSweep(refundCtx, order.Edges.Token, order.Edges.ReceiveAddress.Address, refundAddress, order.AmountOverpaid)
+	if err != nil {
+		// Nothing was swept, so release the claim for the next run
+		if delErr := db.Client.TransactionLog.DeleteOne(transactionLog).Exec(ctx); delErr != nil {
+			logger.WithFields(logger.Fields{
+				"Error":   fmt.Sprintf("%v", delErr),
+				"OrderID": order.ID.String(),
+			}).Errorf("Failed to release overpayment refund claim")
+		}
+		return fmt.Errorf("refundOverpayment.sweep: %w", err)
+	}
+
+	tx, err := db.Client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("refundOverpayment.db: %w", err)
+	}
+
+	metadata := transactionLog.Metadata
+	metadata["SweepID"] = sweep.ID.String()
+	metadata["SweepStatus"] = string(sweep.Status)
+	_, err = tx.TransactionLog.
+		UpdateOne(transactionLog).
+		SetTxHash(sweep.TxHash).
+		SetMetadata(metadata).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("refundOverpayment.transactionLog: %w (sweep %s)", err, sweep.ID)
+	}
+
+	if err := ledger.Record(ctx, tx, ledger.SweepRefund(ledgerentry.KindOverpaymentRefund, order, sweep)); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("refundOverpayment.ledger: %w (sweep %s)", err, sweep.ID)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("refundOverpayment.commit: %w (sweep %s)", err, sweep.ID)
+	}
+
+	logger.WithFields(logger.Fields{
+		"OrderID": order.ID.String(),
+		"Excess":  order.AmountOverpaid,
+		"To":      refundAddress,
+		"SweepID": sweep.ID.String(),
+		"TxHash":  sweep.TxHash,
+	}).Infof("Overpayment refunded")
+
+	return nil
+}
+
+// claimOverpaymentRefund links the overpayment refund log to an order before its excess is swept. The
+// order is re-checked in the same transaction, so a refund already claimed since it was listed is skipped
+func claimOverpaymentRefund(ctx context.Context, order *ent.PaymentOrder, refundAddress string) (*ent.TransactionLog, error) {
+	tx, err := db.Client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("refundOverpayment.db: %w", err)
+	}
+
+	claimed, err := tx.PaymentOrder.
+		Query().
+		Where(
+			paymentorder.IDEQ(order.ID),
+			paymentorder.HasTransactionsWith(
+				transactionlog.StatusEQ(transactionlog.StatusOverpaymentRefunded),
+			),
+		).
+		Exist(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("refundOverpayment.db: %w", err)
+	}
+	if claimed {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("overpayment refund already claimed")
+	}
+
+	transactionLog, err := tx.TransactionLog.
+		Create().
+		SetStatus(transactionlog.StatusOverpaymentRefunded).
+		SetGatewayID(order.GatewayID).
+		SetNetwork(order.Edges.Token.Edges.Network.Identifier).
+		SetMetadata(map[string]interface{}{
+			"Amount": order.AmountOverpaid.String(),
+			"From":   order.Edges.ReceiveAddress.Address,
+			"To":     refundAddress,
+		}).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("refundOverpayment.transactionLog: %w", err)
+	}
+
+	_, err = tx.PaymentOrder.
+		UpdateOne(order).
+		AddTransactions(transactionLog).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("refundOverpayment.db: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("refundOverpayment.commit: %w", err)
+	}
+
+	return transactionLog, nil
+}
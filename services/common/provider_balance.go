@@ -0,0 +1,259 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	networkent "github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/ent/providerbalancesnapshot"
+	"github.com/NEDA-LABS/stablenode/ent/providercurrencies"
+	"github.com/NEDA-LABS/stablenode/ent/providerordertoken"
+	"github.com/NEDA-LABS/stablenode/ent/providerprofile"
+	tokenent "github.com/NEDA-LABS/stablenode/ent/token"
+	"github.com/NEDA-LABS/stablenode/services"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+)
+
+// TokenBalanceReader reads the on-chain token balance of an address
+type TokenBalanceReader interface {
+	GetTokenBalance(ctx context.Context, network *ent.Network, address string, token *ent.Token) (decimal.Decimal, error)
+}
+
+// MonitorProviderBalances snapshots the floats of active providers: the fiat balance of each of their
+// currencies, and the token balance of their settlement addresses on EVM networks. A currency whose
+// available balance dips below its configured threshold takes the provider out of the currency's
+// queue, and a token whose balance dips below its threshold leaves the provider's queue entries, until
+// the balance is replenished. Each crossing is alerted. Snapshots older than the retention are pruned
+func MonitorProviderBalances(ctx context.Context, balances TokenBalanceReader) error {
+	conf := config.ProviderBalanceConfig()
+
+	if err := monitorFiatBalances(ctx, conf.FiatThresholds); err != nil {
+		return fmt.Errorf("MonitorProviderBalances.fiat: %w", err)
+	}
+
+	if err := monitorOnchainBalances(ctx, balances, conf.OnchainThresholds); err != nil {
+		return fmt.Errorf("MonitorProviderBalances.onchain: %w", err)
+	}
+
+	_, err := db.Client.ProviderBalanceSnapshot.
+		Delete().
+		Where(providerbalancesnapshot.CreatedAtLT(time.Now().Add(-conf.SnapshotRetention))).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("MonitorProviderBalances.prune: %w", err)
+	}
+
+	return nil
+}
+
+// monitorFiatBalances snapshots the fiat balances of active providers and applies the thresholds
+func monitorFiatBalances(ctx context.Context, thresholds map[string]decimal.Decimal) error {
+	providerCurrencies, err := db.Client.ProviderCurrencies.
+		Query().
+		Where(providercurrencies.HasProviderWith(providerprofile.IsActiveEQ(true))).
+		WithProvider().
+		WithCurrency().
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, providerCurrency := range providerCurrencies {
+		provider := providerCurrency.Edges.Provider
+		code := providerCurrency.Edges.Currency.Code
+		threshold, ok := thresholds[code]
+		below := ok && providerCurrency.AvailableBalance.LessThan(threshold)
+
+		_, err := db.Client.ProviderBalanceSnapshot.
+			Create().
+			SetProvider(provider).
+			SetKind(providerbalancesnapshot.KindFiat).
+			SetAsset(code).
+			SetAvailableBalance(providerCurrency.AvailableBalance).
+			SetTotalBalance(providerCurrency.TotalBalance).
+			SetThreshold(threshold).
+			SetBelowThreshold(below).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		// Only a crossing of the threshold changes the queue and alerts
+		update := db.Client.ProviderCurrencies.
+			Update().
+			Where(providercurrencies.IDEQ(providerCurrency.ID))
+		if below {
+			update.Where(providercurrencies.LowBalanceSinceIsNil()).SetLowBalanceSince(time.Now())
+		} else {
+			update.Where(providercurrencies.LowBalanceSinceNotNil()).ClearLowBalanceSince()
+		}
+		crossed, err := update.Save(ctx)
+		if err != nil {
+			return err
+		}
+		if crossed == 0 {
+			continue
+		}
+
+		sendProviderBalanceAlert(below, provider, map[string]string{
+			"Currency":          code,
+			"Available Balance": providerCurrency.AvailableBalance.String(),
+			"Threshold":         threshold.String(),
+		})
+	}
+
+	return nil
+}
+
+// monitorOnchainBalances snapshots the token balances of the settlement addresses of active providers
+// and applies the thresholds
+func monitorOnchainBalances(ctx context.Context, balances TokenBalanceReader, thresholds map[string]decimal.Decimal) error {
+	orderTokens, err := db.Client.ProviderOrderToken.
+		Query().
+		Where(
+			providerordertoken.AddressNEQ(""),
+			providerordertoken.HasProviderWith(providerprofile.IsActiveEQ(true)),
+			providerordertoken.HasTokenWith(
+				tokenent.HasNetworkWith(networkent.NetworkTypeEQ(networkent.NetworkTypeEvm)),
+			),
+		).
+		WithProvider().
+		WithToken(func(tq *ent.TokenQuery) {
+			tq.WithNetwork()
+		}).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	// A provider repeats a settlement address for each currency it serves, so each balance is read
+	// and snapshotted once
+	type balanceKey struct {
+		providerID string
+		address    string
+		tokenID    int
+	}
+	var keys []balanceKey
+	var unmonitored []int
+	grouped := make(map[balanceKey][]*ent.ProviderOrderToken)
+	for _, orderToken := range orderTokens {
+		if _, ok := thresholds[strings.ToUpper(orderToken.Edges.Token.Symbol)]; !ok {
+			// A token whose threshold was removed no longer holds the provider back
+			if orderToken.LowBalanceSince != nil {
+				unmonitored = append(unmonitored, orderToken.ID)
+			}
+			continue
+		}
+		key := balanceKey{orderToken.Edges.Provider.ID, orderToken.Address, orderToken.Edges.Token.ID}
+		if _, ok := grouped[key]; !ok {
+			keys = append(keys, key)
+		}
+		grouped[key] = append(grouped[key], orderToken)
+	}
+
+	if len(unmonitored) > 0 {
+		err := db.Client.ProviderOrderToken.
+			Update().
+			Where(providerordertoken.IDIn(unmonitored...)).
+			ClearLowBalanceSince().
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, key := range keys {
+		group := grouped[key]
+		provider := group[0].Edges.Provider
+		token := group[0].Edges.Token
+		threshold := thresholds[strings.ToUpper(token.Symbol)]
+
+		balance, err := balances.GetTokenBalance(ctx, token.Edges.Network, key.address, token)
+		if err != nil {
+			logger.WithFields(logger.Fields{
+				"Error":      fmt.Sprintf("%v", err),
+				"ProviderID": provider.ID,
+				"Address":    key.address,
+				"Token":      token.Symbol,
+				"Network":    token.Edges.Network.Identifier,
+			}).Errorf("Failed to fetch provider token balance")
+			continue
+		}
+		below := balance.LessThan(threshold)
+
+		_, err = db.Client.ProviderBalanceSnapshot.
+			Create().
+			SetProvider(provider).
+			SetKind(providerbalancesnapshot.KindOnchain).
+			SetAsset(token.Symbol).
+			SetNetwork(token.Edges.Network.Identifier).
+			SetAddress(key.address).
+			SetAvailableBalance(balance).
+			SetTotalBalance(balance).
+			SetThreshold(threshold).
+			SetBelowThreshold(below).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		ids := make([]int, len(group))
+		for i, orderToken := range group {
+			ids[i] = orderToken.ID
+		}
+		update := db.Client.ProviderOrderToken.
+			Update().
+			Where(providerordertoken.IDIn(ids...))
+		if below {
+			update.Where(providerordertoken.LowBalanceSinceIsNil()).SetLowBalanceSince(time.Now())
+		} else {
+			update.Where(providerordertoken.LowBalanceSinceNotNil()).ClearLowBalanceSince()
+		}
+		crossed, err := update.Save(ctx)
+		if err != nil {
+			return err
+		}
+		if crossed == 0 {
+			continue
+		}
+
+		sendProviderBalanceAlert(below, provider, map[string]string{
+			"Token":     token.Symbol,
+			"Network":   token.Edges.Network.Identifier,
+			"Address":   key.address,
+			"Balance":   balance.String(),
+			"Threshold": threshold.String(),
+		})
+	}
+
+	return nil
+}
+
+// sendProviderBalanceAlert logs and alerts a provider float crossing its threshold
+func sendProviderBalanceAlert(below bool, provider *ent.ProviderProfile, details map[string]string) {
+	details["Provider"] = fmt.Sprintf("%s (%s)", provider.TradingName, provider.ID)
+
+	fields := logger.Fields{}
+	for key, value := range details {
+		fields[key] = value
+	}
+
+	title := fmt.Sprintf("Provider %s float low - excluded from the queue", provider.ID)
+	if below {
+		logger.WithFields(fields).Errorf("🚨 Provider float below threshold - excluded from the queue until replenished")
+	} else {
+		title = fmt.Sprintf("Provider %s float replenished - back in the queue", provider.ID)
+		logger.WithFields(fields).Infof("✅ Provider float replenished - back in the queue")
+	}
+
+	if err := services.NewSlackService(config.ServerConfig().SlackWebhookURL).SendAlert(title, details); err != nil {
+		logger.Errorf("Failed to send provider balance alert: %v", err)
+	}
+}
@@ -0,0 +1,127 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	"github.com/NEDA-LABS/stablenode/ent/fiatcurrency"
+	"github.com/NEDA-LABS/stablenode/ent/providerbalancesnapshot"
+	"github.com/NEDA-LABS/stablenode/ent/providerordertoken"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/test"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/shopspring/decimal"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+type stubTokenBalances struct {
+	balance decimal.Decimal
+	reads   int
+}
+
+func (s *stubTokenBalances) GetTokenBalance(ctx context.Context, network *ent.Network, address string, token *ent.Token) (decimal.Decimal, error) {
+	s.reads++
+	return s.balance, nil
+}
+
+func TestProviderBalanceMonitor(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:providerbalance?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	ctx := context.Background()
+	fixture := setupAdminOrders(t, ctx)
+	provider := fixture.providers[0]
+	currency := client.FiatCurrency.Query().Where(fiatcurrency.CodeEQ("KES")).OnlyX(ctx)
+
+	providerCurrency := provider.QueryProviderCurrencies().
+		OnlyX(ctx).
+		Update().
+		SetAvailableBalance(decimal.NewFromInt(1000)).
+		SetTotalBalance(decimal.NewFromInt(1000)).
+		SaveX(ctx)
+
+	// The same settlement address serves two currencies
+	ugx, err := test.CreateTestFiatCurrency(map[string]interface{}{
+		"code":        "UGX",
+		"short_name":  "Ugandan Shilling",
+		"symbol":      "USh",
+		"name":        "Ugandan Shilling",
+		"market_rate": 3700.0,
+	})
+	assert.NoError(t, err)
+	for _, orderCurrency := range []*ent.FiatCurrency{currency, ugx} {
+		client.ProviderOrderToken.Create().
+			SetProvider(provider).
+			SetToken(fixture.token).
+			SetCurrency(orderCurrency).
+			SetFixedConversionRate(decimal.Zero).
+			SetFloatingConversionRate(decimal.Zero).
+			SetConversionRateType(providerordertoken.ConversionRateTypeFloating).
+			SetMaxOrderAmount(decimal.NewFromInt(1000)).
+			SetMinOrderAmount(decimal.NewFromInt(1)).
+			SetRateSlippage(decimal.Zero).
+			SetAddress("0x1111111111111111111111111111111111111111").
+			SetNetwork("base-sepolia").
+			SaveX(ctx)
+	}
+
+	viper.Set("SLACK_WEBHOOK_URL", "")
+	viper.Set("PROVIDER_BALANCE_FIAT_THRESHOLDS", "KES:5000")
+	viper.Set("PROVIDER_BALANCE_ONCHAIN_THRESHOLDS", "usdc:100")
+	defer viper.Set("PROVIDER_BALANCE_FIAT_THRESHOLDS", "")
+	defer viper.Set("PROVIDER_BALANCE_ONCHAIN_THRESHOLDS", "")
+
+	balances := &stubTokenBalances{balance: decimal.NewFromInt(50)}
+
+	t.Run("should exclude providers whose floats dip below the thresholds", func(t *testing.T) {
+		assert.NoError(t, MonitorProviderBalances(ctx, balances))
+
+		lowSince := client.ProviderCurrencies.GetX(ctx, providerCurrency.ID).LowBalanceSince
+		assert.NotNil(t, lowSince)
+		for _, orderToken := range client.ProviderOrderToken.Query().AllX(ctx) {
+			assert.NotNil(t, orderToken.LowBalanceSince)
+		}
+		assert.Equal(t, 1, balances.reads)
+
+		snapshots := provider.QueryBalanceSnapshots().AllX(ctx)
+		assert.Len(t, snapshots, 2)
+		for _, snapshot := range snapshots {
+			assert.True(t, snapshot.BelowThreshold)
+		}
+
+		// The provider stays excluded since it first dipped
+		assert.NoError(t, MonitorProviderBalances(ctx, balances))
+		assert.True(t, lowSince.Equal(*client.ProviderCurrencies.GetX(ctx, providerCurrency.ID).LowBalanceSince))
+	})
+
+	t.Run("should include providers again once replenished", func(t *testing.T) {
+		client.ProviderCurrencies.UpdateOneID(providerCurrency.ID).SetAvailableBalance(decimal.NewFromInt(10000)).ExecX(ctx)
+		balances.balance = decimal.NewFromInt(500)
+
+		assert.NoError(t, MonitorProviderBalances(ctx, balances))
+
+		assert.Nil(t, client.ProviderCurrencies.GetX(ctx, providerCurrency.ID).LowBalanceSince)
+		for _, orderToken := range client.ProviderOrderToken.Query().AllX(ctx) {
+			assert.Nil(t, orderToken.LowBalanceSince)
+		}
+
+		latest := client.ProviderBalanceSnapshot.Query().
+			Where(providerbalancesnapshot.KindEQ(providerbalancesnapshot.KindOnchain)).
+			Order(ent.Desc(providerbalancesnapshot.FieldCreatedAt)).
+			FirstX(ctx)
+		assert.False(t, latest.BelowThreshold)
+		assert.True(t, latest.AvailableBalance.Equal(decimal.NewFromInt(500)))
+	})
+
+	t.Run("should prune snapshots past the retention", func(t *testing.T) {
+		viper.Set("PROVIDER_BALANCE_SNAPSHOT_RETENTION", 0)
+		defer viper.Set("PROVIDER_BALANCE_SNAPSHOT_RETENTION", 30)
+
+		assert.NoError(t, MonitorProviderBalances(ctx, balances))
+		assert.Zero(t, client.ProviderBalanceSnapshot.Query().CountX(ctx))
+	})
+}
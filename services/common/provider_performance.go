@@ -0,0 +1,145 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/lockpaymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/providerperformance"
+	"github.com/NEDA-LABS/stablenode/ent/providerprofile"
+	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
+	db "github.com/NEDA-LABS/stablenode/storage"
+)
+
+// providerOutcome is how a provider concluded a lock order assigned to it
+type providerOutcome int
+
+const (
+	providerOutcomeSettled providerOutcome = iota
+	providerOutcomeFailed
+	providerOutcomeCancelled
+)
+
+// RecordProviderSettlement records a lock order settled by its provider. The settlement latency runs
+// from when the provider accepted the order, or from when the order was created if no acceptance was
+// logged
+func RecordProviderSettlement(ctx context.Context, order *ent.LockPaymentOrder) error {
+	acceptedAt := order.CreatedAt
+	accepted, err := db.Client.LockPaymentOrder.
+		Query().
+		Where(lockpaymentorder.IDEQ(order.ID)).
+		QueryTransactions().
+		Where(transactionlog.StatusEQ(transactionlog.StatusOrderProcessing)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("RecordProviderSettlement.acceptance: %w", err)
+	}
+	if accepted != nil {
+		acceptedAt = accepted.CreatedAt
+	}
+
+	if err := recordProviderOutcome(ctx, order.Edges.Provider.ID, providerOutcomeSettled, time.Since(acceptedAt)); err != nil {
+		return fmt.Errorf("RecordProviderSettlement: %w", err)
+	}
+	return nil
+}
+
+// RecordProviderFailure records a lock order whose fulfillment by its provider failed validation
+func RecordProviderFailure(ctx context.Context, providerID string) error {
+	if err := recordProviderOutcome(ctx, providerID, providerOutcomeFailed, 0); err != nil {
+		return fmt.Errorf("RecordProviderFailure: %w", err)
+	}
+	return nil
+}
+
+// RecordProviderCancellation records a lock order cancelled by its provider
+func RecordProviderCancellation(ctx context.Context, providerID string) error {
+	if err := recordProviderOutcome(ctx, providerID, providerOutcomeCancelled, 0); err != nil {
+		return fmt.Errorf("RecordProviderCancellation: %w", err)
+	}
+	return nil
+}
+
+// recordProviderOutcome folds an order outcome into the moving averages of a provider's stats and
+// rescores the provider
+func recordProviderOutcome(ctx context.Context, providerID string, outcome providerOutcome, latency time.Duration) error {
+	conf := config.ProviderPerformanceConfig()
+
+	tx, err := db.Client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	performance, err := tx.ProviderPerformance.
+		Query().
+		Where(providerperformance.HasProviderWith(providerprofile.IDEQ(providerID))).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		performance, err = tx.ProviderPerformance.
+			Create().
+			SetProviderID(providerID).
+			Save(ctx)
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	ema := func(average, value float64) float64 {
+		return average + conf.Smoothing*(value-average)
+	}
+
+	successRate := performance.SuccessRate
+	cancellationRate := performance.CancellationRate
+	avgSettlementSeconds := performance.AvgSettlementSeconds
+	update := tx.ProviderPerformance.UpdateOne(performance)
+
+	switch outcome {
+	case providerOutcomeSettled:
+		successRate = ema(successRate, 1)
+		cancellationRate = ema(cancellationRate, 0)
+		if performance.OrdersSettled == 0 {
+			avgSettlementSeconds = latency.Seconds()
+		} else {
+			avgSettlementSeconds = ema(avgSettlementSeconds, latency.Seconds())
+		}
+		update.AddOrdersSettled(1)
+	case providerOutcomeFailed:
+		successRate = ema(successRate, 0)
+		cancellationRate = ema(cancellationRate, 0)
+		update.AddOrdersFailed(1)
+	case providerOutcomeCancelled:
+		cancellationRate = ema(cancellationRate, 1)
+		update.AddOrdersCancelled(1)
+	}
+
+	err = update.
+		SetSuccessRate(successRate).
+		SetCancellationRate(cancellationRate).
+		SetAvgSettlementSeconds(avgSettlementSeconds).
+		SetScore(providerScore(successRate, cancellationRate, avgSettlementSeconds, conf)).
+		Exec(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// providerScore weights a provider in the priority queues between the minimum score and 1. Failures
+// and cancellations lower it proportionally, and so does an average settlement slower than the target
+func providerScore(successRate, cancellationRate, avgSettlementSeconds float64, conf *config.ProviderPerformanceConfiguration) float64 {
+	score := successRate * (1 - cancellationRate)
+
+	target := conf.TargetSettlement.Seconds()
+	if target > 0 && avgSettlementSeconds > target {
+		score *= target / avgSettlementSeconds
+	}
+
+	return math.Min(math.Max(score, conf.MinScore), 1)
+}
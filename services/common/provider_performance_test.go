@@ -0,0 +1,85 @@
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	"github.com/NEDA-LABS/stablenode/ent/lockpaymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/providerperformance"
+	"github.com/NEDA-LABS/stablenode/ent/providerprofile"
+	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProviderPerformance(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:providerperformance?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	ctx := context.Background()
+	fixture := setupAdminOrders(t, ctx)
+	provider := fixture.providers[0]
+
+	viper.Set("PROVIDER_SCORE_SMOOTHING", 0.5)
+	viper.Set("PROVIDER_SCORE_TARGET_SETTLEMENT", 600)
+	viper.Set("PROVIDER_SCORE_MIN", 0.05)
+	defer viper.Set("PROVIDER_SCORE_SMOOTHING", 0.1)
+
+	performanceOf := func() (float64, float64, float64, float64) {
+		performance := client.ProviderPerformance.
+			Query().
+			Where(providerperformance.HasProviderWith(providerprofile.IDEQ(provider.ID))).
+			OnlyX(ctx)
+		return performance.SuccessRate, performance.CancellationRate, performance.AvgSettlementSeconds, performance.Score
+	}
+
+	t.Run("should time settlements from acceptance", func(t *testing.T) {
+		order := createAdminLockOrder(t, ctx, fixture, lockpaymentorder.StatusSettled, provider)
+		accepted := client.TransactionLog.Create().
+			SetStatus(transactionlog.StatusOrderProcessing).
+			SetMetadata(map[string]interface{}{"ProviderId": provider.ID}).
+			SetCreatedAt(time.Now().Add(-20 * time.Minute)).
+			SaveX(ctx)
+		client.LockPaymentOrder.UpdateOne(order).AddTransactions(accepted).ExecX(ctx)
+		order.Edges.Provider = provider
+
+		assert.NoError(t, RecordProviderSettlement(ctx, order))
+
+		successRate, cancellationRate, avgSettlementSeconds, score := performanceOf()
+		assert.Equal(t, 1.0, successRate)
+		assert.Zero(t, cancellationRate)
+		assert.InDelta(t, 1200, avgSettlementSeconds, 5)
+		// Settling in twice the target halves the score
+		assert.InDelta(t, 0.5, score, 0.01)
+	})
+
+	t.Run("should lower the score of failing and cancelling providers", func(t *testing.T) {
+		assert.NoError(t, RecordProviderFailure(ctx, provider.ID))
+		assert.NoError(t, RecordProviderCancellation(ctx, provider.ID))
+
+		successRate, cancellationRate, avgSettlementSeconds, score := performanceOf()
+		assert.Equal(t, 0.5, successRate)
+		assert.Equal(t, 0.5, cancellationRate)
+		assert.InDelta(t, 1200, avgSettlementSeconds, 5)
+		assert.InDelta(t, 0.125, score, 0.01)
+
+		performance := client.ProviderPerformance.Query().OnlyX(ctx)
+		assert.Equal(t, 1, performance.OrdersSettled)
+		assert.Equal(t, 1, performance.OrdersFailed)
+		assert.Equal(t, 1, performance.OrdersCancelled)
+	})
+
+	t.Run("should floor the score at the minimum", func(t *testing.T) {
+		for i := 0; i < 10; i++ {
+			assert.NoError(t, RecordProviderCancellation(ctx, provider.ID))
+		}
+
+		_, _, _, score := performanceOf()
+		assert.Equal(t, 0.05, score)
+	})
+}
@@ -0,0 +1,154 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/providerprofile"
+	"github.com/NEDA-LABS/stablenode/ent/senderprofile"
+	"github.com/NEDA-LABS/stablenode/ent/user"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// rateRequote is the re-quote of the rate of an order paid after its rate lock expired
+type rateRequote struct {
+	QuotedRate  decimal.Decimal
+	CurrentRate decimal.Decimal
+	// Movement is the change of the current rate from the quoted rate, as a signed percentage
+	Movement    decimal.Decimal
+	Threshold   decimal.Decimal
+	LockedUntil time.Time
+	RequotedAt  time.Time
+}
+
+// record returns the re-quote as stored on the order
+func (r *rateRequote) record() map[string]interface{} {
+	return map[string]interface{}{
+		"quotedRate":  r.QuotedRate.String(),
+		"currentRate": r.CurrentRate.String(),
+		"movement":    r.Movement.String(),
+		"threshold":   r.Threshold.String(),
+		"lockedUntil": r.LockedUntil.Format(time.RFC3339),
+		"requotedAt":  r.RequotedAt.Format(time.RFC3339),
+	}
+}
+
+// rateLockExpiry returns when the quoted rate of an order stops being honored. Orders created
+// before rate locks were recorded are locked for the configured window from their creation
+func rateLockExpiry(paymentOrder *ent.PaymentOrder) time.Time {
+	if !paymentOrder.RateLockedUntil.IsZero() {
+		return paymentOrder.RateLockedUntil
+	}
+	return paymentOrder.CreatedAt.Add(orderConf.RateLockWindow)
+}
+
+// requoteRate compares the quoted rate of an order paid after its rate lock with the current rate.
+// It returns the re-quote to apply when the rate moved more than the re-quote threshold, and nil
+// while the lock holds or the quoted rate still stands
+func requoteRate(
+	ctx context.Context,
+	paymentOrder *ent.PaymentOrder,
+	getProviderRate func(ctx context.Context, providerProfile *ent.ProviderProfile, tokenSymbol string, currency string) (decimal.Decimal, error),
+) (*rateRequote, error) {
+	now := time.Now()
+	lockedUntil := rateLockExpiry(paymentOrder)
+	if now.Before(lockedUntil) || paymentOrder.Rate.IsZero() {
+		return nil, nil
+	}
+
+	recipient := paymentOrder.Edges.Recipient
+	if recipient == nil {
+		return nil, nil
+	}
+
+	var institution *ent.Institution
+	err := callWithinBudget(ctx, "GetInstitutionByCode", institutionLookupBudget, false, func(ctx context.Context) (err error) {
+		institution, err = utils.GetInstitutionByCode(ctx, recipient.Institution, true)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch institution %s: %w", recipient.Institution, err)
+	}
+	token := paymentOrder.Edges.Token
+	currency := institution.Edges.FiatCurrency
+
+	var currentRate decimal.Decimal
+	if strings.HasPrefix(recipient.Memo, "P#P") && recipient.ProviderID != "" {
+		// P2P orders from the sender dashboard are quoted at the rate of the sender's own provider
+		providerProfile, err := db.Client.ProviderProfile.
+			Query().
+			Where(
+				providerprofile.HasUserWith(
+					user.HasSenderProfileWith(
+						senderprofile.HasPaymentOrdersWith(
+							paymentorder.IDEQ(paymentOrder.ID),
+						),
+					),
+				),
+			).
+			Only(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch provider: %w", err)
+		}
+
+		err = callWithinBudget(ctx, "GetProviderRate", rateFetchBudget, false, func(ctx context.Context) (err error) {
+			currentRate, err = getProviderRate(ctx, providerProfile, token.Symbol, currency.Code)
+			return err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch rate: %w", err)
+		}
+	} else {
+		err = callWithinBudget(ctx, "ValidateRate", rateFetchBudget, false, func(ctx context.Context) (err error) {
+			currentRate, err = utils.ValidateRate(ctx, token, currency, paymentOrder.Amount, recipient.ProviderID, token.Edges.Network.Identifier)
+			return err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch rate: %w", err)
+		}
+	}
+
+	movement := currentRate.Sub(paymentOrder.Rate).Div(paymentOrder.Rate).Mul(decimal.NewFromInt(100)).Round(4)
+	if movement.Abs().LessThanOrEqual(orderConf.RateRequoteThreshold) {
+		return nil, nil
+	}
+
+	return &rateRequote{
+		QuotedRate:  paymentOrder.Rate,
+		CurrentRate: currentRate,
+		Movement:    movement,
+		Threshold:   orderConf.RateRequoteThreshold,
+		LockedUntil: lockedUntil,
+		RequotedAt:  now,
+	}, nil
+}
+
+// notifyRateRequote notifies the sender of an order that its rate was re-quoted
+func notifyRateRequote(ctx context.Context, orderID uuid.UUID) {
+	paymentOrder, err := db.Client.PaymentOrder.
+		Query().
+		Where(paymentorder.IDEQ(orderID)).
+		WithSenderProfile().
+		WithRecipient().
+		WithToken(func(tq *ent.TokenQuery) {
+			tq.WithNetwork()
+		}).
+		Only(ctx)
+	if err == nil {
+		err = utils.SendRateRequotedWebhook(ctx, paymentOrder)
+	}
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":   fmt.Sprintf("%v", err),
+			"OrderID": orderID,
+		}).Errorf("Failed to notify sender of rate re-quote")
+	}
+}
@@ -0,0 +1,126 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorderrecipient"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/NEDA-LABS/stablenode/utils/test"
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLock(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ratelock?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	ctx := context.Background()
+	orders := setupDepositSplit(t, ctx)
+
+	currency, err := test.CreateTestFiatCurrency(map[string]interface{}{
+		"market_rate": 1500.0,
+	})
+	assert.NoError(t, err)
+
+	// P2P orders from the sender dashboard are quoted at the rate of the sender's own provider
+	for i, order := range orders {
+		user := order.Edges.SenderProfile.QueryUser().OnlyX(ctx)
+		if !user.QueryProviderProfile().ExistX(ctx) {
+			_, err := test.CreateTestProviderProfile(map[string]interface{}{
+				"user_id":         user.ID,
+				"trading_name":    fmt.Sprintf("Provider %d", i),
+				"currency_id":     currency.ID,
+				"host_identifier": "http://localhost:0",
+			})
+			assert.NoError(t, err)
+		}
+		client.PaymentOrderRecipient.
+			Update().
+			Where(paymentorderrecipient.HasPaymentOrderWith(paymentorder.IDEQ(order.ID))).
+			SetAccountIdentifier("0123456789").
+			SetAccountName("Test Recipient").
+			SetProviderID("provider").
+			SetMemo("P#P payment").
+			ExecX(ctx)
+	}
+
+	currentRate := decimal.NewFromInt(1500)
+	getProviderRate := func(ctx context.Context, providerProfile *ent.ProviderProfile, tokenSymbol string, currency string) (decimal.Decimal, error) {
+		return currentRate, nil
+	}
+	var created []uuid.UUID
+	createOrder := func(ctx context.Context, orderID uuid.UUID) error {
+		created = append(created, orderID)
+		return nil
+	}
+
+	// pay pays an order in full and returns it as updated
+	pay := func(t *testing.T, order *ent.PaymentOrder, txHash string) *ent.PaymentOrder {
+		loaded := client.PaymentOrder.
+			Query().
+			Where(paymentorder.IDEQ(order.ID)).
+			WithToken(func(tq *ent.TokenQuery) {
+				tq.WithNetwork()
+			}).
+			WithReceiveAddress().
+			WithSenderProfile().
+			WithRecipient().
+			OnlyX(ctx)
+
+		done, err := UpdateReceiveAddressStatus(ctx, loaded.Edges.ReceiveAddress, loaded, &types.TokenTransferEvent{
+			BlockNumber: 100,
+			TxHash:      txHash,
+			From:        "0x2222222222222222222222222222222222222222",
+			To:          splitTestAddress,
+			Value:       loaded.Amount.Add(loaded.NetworkFee),
+		}, createOrder, getProviderRate)
+		assert.NoError(t, err)
+		assert.True(t, done)
+		assert.Contains(t, created, order.ID)
+
+		return client.PaymentOrder.GetX(ctx, order.ID)
+	}
+
+	t.Run("honors the quoted rate within the lock", func(t *testing.T) {
+		// Orders without a recorded lock are locked from their creation
+		currentRate = decimal.NewFromInt(1600)
+
+		updated := pay(t, orders[0], "0xr1")
+		assert.True(t, updated.Rate.Equal(decimal.NewFromInt(1500)))
+		assert.Nil(t, updated.RateRequote)
+		assert.Equal(t, paymentorder.StatusPending, updated.Status)
+	})
+
+	t.Run("keeps the quoted rate when the market moved within the threshold", func(t *testing.T) {
+		currentRate = decimal.NewFromInt(1510)
+		client.PaymentOrder.UpdateOne(orders[1]).SetRateLockedUntil(time.Now().Add(-time.Minute)).ExecX(ctx)
+
+		updated := pay(t, orders[1], "0xr2")
+		assert.True(t, updated.Rate.Equal(decimal.NewFromInt(1500)))
+		assert.Nil(t, updated.RateRequote)
+	})
+
+	t.Run("re-quotes orders paid after the lock when the market moved beyond the threshold", func(t *testing.T) {
+		currentRate = decimal.NewFromInt(1400)
+		lockedUntil := time.Now().Add(-time.Minute)
+		client.PaymentOrder.UpdateOne(orders[2]).SetRateLockedUntil(lockedUntil).ExecX(ctx)
+
+		updated := pay(t, orders[2], "0xr3")
+		assert.True(t, updated.Rate.Equal(decimal.NewFromInt(1400)))
+		assert.Equal(t, paymentorder.StatusPending, updated.Status)
+		assert.Equal(t, "1500", updated.RateRequote["quotedRate"])
+		assert.Equal(t, "1400", updated.RateRequote["currentRate"])
+		assert.Equal(t, "-6.6667", updated.RateRequote["movement"])
+		assert.Equal(t, lockedUntil.Format(time.RFC3339), updated.RateRequote["lockedUntil"])
+	})
+}
@@ -0,0 +1,99 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent/lockpaymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/providerprofile"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+)
+
+// ReassignmentReasonFulfillmentTimeout is recorded for orders a provider accepted but did not
+// fulfill within the reassignment timeout
+const ReassignmentReasonFulfillmentTimeout = "fulfillment_timeout"
+
+// ReassignStaleLockOrders takes lock orders away from public providers that accepted them but have not
+// fulfilled them within the reassignment timeout, and offers them to other providers. The provider is
+// excluded from the order, its reserved balance released, and the reassignment recorded. An order is
+// reassigned at most the configured number of times; after that it is left to SLA escalation.
+// Returns the number of orders reassigned.
+func ReassignStaleLockOrders(ctx context.Context, assignLockPaymentOrder func(context.Context, types.LockPaymentOrderFields) error) (int, error) {
+	orderConf := config.OrderConfig()
+
+	orders, err := db.Client.LockPaymentOrder.
+		Query().
+		Where(
+			lockpaymentorder.StatusEQ(lockpaymentorder.StatusProcessing),
+			lockpaymentorder.UpdatedAtLT(time.Now().Add(-orderConf.ReassignmentTimeout)),
+			lockpaymentorder.Not(lockpaymentorder.HasFulfillments()),
+			lockpaymentorder.Or(
+				lockpaymentorder.ReviewReasonIsNil(),
+				lockpaymentorder.ReviewReasonEQ(""),
+			),
+			lockpaymentorder.HasProviderWith(
+				providerprofile.VisibilityModeEQ(providerprofile.VisibilityModePublic),
+			),
+		).
+		WithReassignments().
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("ReassignStaleLockOrders.db: %w", err)
+	}
+
+	reassigned := 0
+	for _, order := range orders {
+		if len(order.Edges.Reassignments) >= orderConf.MaxReassignments {
+			continue
+		}
+
+		released, previousProvider, err := releaseLockOrder(ctx, order.ID, true)
+		if err != nil {
+			logger.WithFields(logger.Fields{
+				"Error":   fmt.Sprintf("%v", err),
+				"OrderID": order.ID.String(),
+			}).Errorf("ReassignStaleLockOrders: Failed to release order")
+			continue
+		}
+
+		_, err = db.Client.LockOrderReassignment.
+			Create().
+			SetLockPaymentOrderID(order.ID).
+			SetPreviousProviderID(previousProvider).
+			SetReason(ReassignmentReasonFulfillmentTimeout).
+			Save(ctx)
+		if err != nil {
+			return reassigned, fmt.Errorf("ReassignStaleLockOrders.record: %w", err)
+		}
+
+		if err := RecordProviderCancellation(ctx, previousProvider); err != nil {
+			logger.WithFields(logger.Fields{
+				"Error":      fmt.Sprintf("%v", err),
+				"OrderID":    order.ID.String(),
+				"ProviderID": previousProvider,
+			}).Errorf("ReassignStaleLockOrders: Failed to record provider cancellation")
+		}
+
+		logger.WithFields(logger.Fields{
+			"OrderID":          order.ID.String(),
+			"GatewayID":        order.GatewayID,
+			"PreviousProvider": previousProvider,
+			"Reassignments":    len(order.Edges.Reassignments) + 1,
+		}).Warnf("Reassigning lock order not fulfilled in time")
+
+		if err := assignLockPaymentOrder(ctx, lockOrderFields(released, "")); err != nil {
+			logger.WithFields(logger.Fields{
+				"Error":     fmt.Sprintf("%v", err),
+				"OrderID":   order.ID.String(),
+				"GatewayID": order.GatewayID,
+			}).Errorf("ReassignStaleLockOrders: Failed to reassign order")
+		}
+		reassigned++
+	}
+
+	return reassigned, nil
+}
@@ -0,0 +1,128 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	"github.com/NEDA-LABS/stablenode/ent/fiatcurrency"
+	"github.com/NEDA-LABS/stablenode/ent/lockpaymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/providercurrencies"
+	"github.com/NEDA-LABS/stablenode/ent/providerprofile"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/alicebob/miniredis/v2"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReassignStaleLockOrders(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:reassignment?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	mr, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer mr.Close()
+	db.RedisClient = redis.NewClient(&redis.Options{Addr: mr.Addr()})
+
+	ctx := context.Background()
+	fixture := setupAdminOrders(t, ctx)
+
+	currency := client.FiatCurrency.Query().Where(fiatcurrency.CodeEQ("KES")).OnlyX(ctx)
+	for _, provider := range fixture.providers[:2] {
+		client.ProviderCurrencies.Update().
+			Where(
+				providercurrencies.HasProviderWith(providerprofile.IDEQ(provider.ID)),
+				providercurrencies.HasCurrencyWith(fiatcurrency.IDEQ(currency.ID)),
+			).
+			SetAvailableBalance(decimal.NewFromInt(100000)).
+			SetTotalBalance(decimal.NewFromInt(120000)).
+			SetReservedBalance(decimal.NewFromInt(20000)).
+			ExecX(ctx)
+	}
+
+	viper.Set("LOCK_ORDER_MAX_REASSIGNMENTS", 2)
+	defer viper.Set("LOCK_ORDER_MAX_REASSIGNMENTS", 3)
+
+	// Orders are accepted long enough ago for the reassignment timeout to have passed
+	staleLockOrder := func(provider *ent.ProviderProfile) *ent.LockPaymentOrder {
+		order := createAdminLockOrder(t, ctx, fixture, lockpaymentorder.StatusProcessing, provider)
+		return client.LockPaymentOrder.UpdateOne(order).SetUpdatedAt(time.Now().Add(-time.Hour)).SaveX(ctx)
+	}
+
+	var assigned []types.LockPaymentOrderFields
+	assign := func(ctx context.Context, order types.LockPaymentOrderFields) error {
+		assigned = append(assigned, order)
+		return nil
+	}
+
+	t.Run("takes orders not fulfilled in time from their provider", func(t *testing.T) {
+		order := staleLockOrder(fixture.providers[0])
+
+		count, err := ReassignStaleLockOrders(ctx, assign)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, count)
+
+		assert.Len(t, assigned, 1)
+		assert.Equal(t, order.ID, assigned[0].ID)
+		assert.Empty(t, assigned[0].ProviderID)
+
+		released := client.LockPaymentOrder.GetX(ctx, order.ID)
+		assert.Equal(t, lockpaymentorder.StatusPending, released.Status)
+
+		excluded, err := db.RedisClient.LRange(ctx, fmt.Sprintf("order_exclude_list_%s", order.ID), 0, -1).Result()
+		assert.NoError(t, err)
+		assert.Equal(t, []string{fixture.providers[0].ID}, excluded)
+
+		balance := client.ProviderCurrencies.Query().
+			Where(providercurrencies.HasProviderWith(providerprofile.IDEQ(fixture.providers[0].ID))).
+			OnlyX(ctx)
+		assert.True(t, balance.ReservedBalance.Equal(decimal.NewFromInt(13500)))
+
+		reassignment := released.QueryReassignments().OnlyX(ctx)
+		assert.Equal(t, fixture.providers[0].ID, reassignment.PreviousProviderID)
+		assert.Equal(t, ReassignmentReasonFulfillmentTimeout, reassignment.Reason)
+	})
+
+	t.Run("stops reassigning after the maximum", func(t *testing.T) {
+		order := staleLockOrder(fixture.providers[1])
+		for i := 0; i < 2; i++ {
+			client.LockOrderReassignment.Create().
+				SetLockPaymentOrder(order).
+				SetPreviousProviderID(fixture.providers[0].ID).
+				SetReason(ReassignmentReasonFulfillmentTimeout).
+				SaveX(ctx)
+		}
+
+		count, err := ReassignStaleLockOrders(ctx, assign)
+		assert.NoError(t, err)
+		assert.Zero(t, count)
+		assert.Equal(t, lockpaymentorder.StatusProcessing, client.LockPaymentOrder.GetX(ctx, order.ID).Status)
+	})
+
+	t.Run("leaves private providers and fulfilled orders alone", func(t *testing.T) {
+		client.LockPaymentOrder.Update().SetStatus(lockpaymentorder.StatusCancelled).ExecX(ctx)
+
+		client.ProviderProfile.UpdateOne(fixture.providers[1]).
+			SetVisibilityMode(providerprofile.VisibilityModePrivate).
+			ExecX(ctx)
+		staleLockOrder(fixture.providers[1])
+
+		fulfilled := staleLockOrder(fixture.providers[0])
+		client.LockOrderFulfillment.Create().
+			SetOrder(fulfilled).
+			SetTxID("0xfulfilled").
+			SaveX(ctx)
+
+		count, err := ReassignStaleLockOrders(ctx, assign)
+		assert.NoError(t, err)
+		assert.Zero(t, count)
+	})
+}
@@ -0,0 +1,218 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	networkent "github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddressassignment"
+	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/google/uuid"
+)
+
+// AssignReceiveAddress records that a receive address serves an order from now on, closing the
+// window of the address the order held before
+func AssignReceiveAddress(ctx context.Context, tx *ent.Tx, order *ent.PaymentOrder, receiveAddress *ent.ReceiveAddress) error {
+	if err := ReleaseAddressAssignments(ctx, tx.Client(), order.ID); err != nil {
+		return err
+	}
+
+	_, err := tx.ReceiveAddressAssignment.
+		Create().
+		SetAddress(receiveAddress.Address).
+		SetNetworkIdentifier(receiveAddress.NetworkIdentifier).
+		SetReceiveAddress(receiveAddress).
+		SetPaymentOrderID(order.ID).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to record assignment of %s: %w", receiveAddress.Address, err)
+	}
+	return nil
+}
+
+// ReleaseAddressAssignments closes the open receive address assignment of an order
+func ReleaseAddressAssignments(ctx context.Context, client *ent.Client, orderID uuid.UUID) error {
+	_, err := client.ReceiveAddressAssignment.
+		Update().
+		Where(
+			receiveaddressassignment.HasPaymentOrderWith(paymentorder.IDEQ(orderID)),
+			receiveaddressassignment.ReleasedAtIsNil(),
+		).
+		SetReleasedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to release address assignments of order %s: %w", orderID, err)
+	}
+	return nil
+}
+
+// assignmentWindow is the period a receive address served an order
+type assignmentWindow struct {
+	from  time.Time
+	until *time.Time
+}
+
+// contains reports whether t falls within the window
+func (w assignmentWindow) contains(t time.Time) bool {
+	return !t.Before(w.from) && (w.until == nil || t.Before(*w.until))
+}
+
+// assignmentWindows returns the windows during which each order held the address it was paid to.
+// Orders created before assignments were recorded hold their address from their creation on
+func assignmentWindows(ctx context.Context, client *ent.Client, orders []*ent.PaymentOrder) (map[uuid.UUID][]assignmentWindow, error) {
+	if len(orders) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]uuid.UUID, len(orders))
+	for i, order := range orders {
+		ids[i] = order.ID
+	}
+
+	assignments, err := client.ReceiveAddressAssignment.
+		Query().
+		Where(receiveaddressassignment.HasPaymentOrderWith(paymentorder.IDIn(ids...))).
+		WithPaymentOrder(func(q *ent.PaymentOrderQuery) {
+			q.Select(paymentorder.FieldID)
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch address assignments: %w", err)
+	}
+
+	windows := make(map[uuid.UUID][]assignmentWindow, len(orders))
+	for _, assignment := range assignments {
+		orderID := assignment.Edges.PaymentOrder.ID
+		windows[orderID] = append(windows[orderID], assignmentWindow{
+			from:  assignment.AssignedAt,
+			until: assignment.ReleasedAt,
+		})
+	}
+	for _, order := range orders {
+		if _, ok := windows[order.ID]; !ok {
+			windows[order.ID] = []assignmentWindow{{from: order.CreatedAt}}
+		}
+	}
+	return windows, nil
+}
+
+// activeOrdersAt keeps the orders whose address assignment was open at the time of a deposit.
+// A window that opened after the deposit belongs to an order the address was recycled to
+func activeOrdersAt(orders []*ent.PaymentOrder, windows map[uuid.UUID][]assignmentWindow, depositTime time.Time) []*ent.PaymentOrder {
+	var active []*ent.PaymentOrder
+	for _, order := range orders {
+		for _, window := range windows[order.ID] {
+			if window.contains(depositTime) {
+				active = append(active, order)
+				break
+			}
+		}
+	}
+	return active
+}
+
+// depositRecipient picks the one order of those sharing an address a deposit is credited to: the
+// first whose outstanding total the deposit matches, otherwise the one assigned the address first
+func depositRecipient(orders []*ent.PaymentOrder, windows map[uuid.UUID][]assignmentWindow, event *types.TokenTransferEvent) *ent.PaymentOrder {
+	if len(orders) == 0 {
+		return nil
+	}
+
+	sorted := make([]*ent.PaymentOrder, len(orders))
+	copy(sorted, orders)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return firstAssigned(windows[sorted[i].ID]).Before(firstAssigned(windows[sorted[j].ID]))
+	})
+
+	for _, order := range sorted {
+		if depositMatches(event.Value, orderDepositTotal(order).Sub(order.AmountPaid)) {
+			return order
+		}
+	}
+	return sorted[0]
+}
+
+// firstAssigned returns the start of the earliest window
+func firstAssigned(windows []assignmentWindow) time.Time {
+	var first time.Time
+	for i, window := range windows {
+		if i == 0 || window.from.Before(first) {
+			first = window.from
+		}
+	}
+	return first
+}
+
+// depositTimes resolves the time each deposit was mined from its event, or from the header of its
+// block on the order's network. Deposits whose block can't be fetched are taken as mined now
+func depositTimes(ctx context.Context, orders []*ent.PaymentOrder, addressToEvent map[string]*types.TokenTransferEvent) map[string]time.Time {
+	networkOf := make(map[string]*ent.Network)
+	for _, order := range orders {
+		networkOf[strings.ToLower(order.Edges.ReceiveAddress.Address)] = order.Edges.Token.Edges.Network
+	}
+
+	times := make(map[string]time.Time, len(addressToEvent))
+	clients := make(map[string]confirmationsClient)
+	blockTimes := make(map[string]time.Time)
+	for addr, event := range addressToEvent {
+		key := strings.ToLower(addr)
+		if event.BlockTimestamp > 0 {
+			times[key] = time.Unix(event.BlockTimestamp, 0)
+			continue
+		}
+
+		network, ok := networkOf[key]
+		if !ok {
+			continue
+		}
+		times[key] = time.Now()
+		// Block headers are only fetched from EVM networks
+		if event.BlockNumber <= 0 || network.NetworkType != networkent.NetworkTypeEvm || strings.HasPrefix(network.Identifier, "tron") {
+			continue
+		}
+
+		blockKey := fmt.Sprintf("%s:%d", network.Identifier, event.BlockNumber)
+		if blockTime, ok := blockTimes[blockKey]; ok {
+			times[key] = blockTime
+			continue
+		}
+
+		client, ok := clients[network.Identifier]
+		if !ok {
+			var err error
+			client, err = dialConfirmationsClient(network)
+			if err != nil {
+				logger.WithFields(logger.Fields{
+					"Error":   fmt.Sprintf("%v", err),
+					"Network": network.Identifier,
+				}).Warnf("Failed to dial network for deposit block times, using the current time")
+				client = nil
+			}
+			clients[network.Identifier] = client
+		}
+		if client == nil {
+			continue
+		}
+
+		header, err := client.HeaderByNumber(ctx, big.NewInt(event.BlockNumber))
+		if err != nil {
+			logger.WithFields(logger.Fields{
+				"Error":       fmt.Sprintf("%v", err),
+				"Network":     network.Identifier,
+				"BlockNumber": event.BlockNumber,
+			}).Warnf("Failed to fetch deposit block time, using the current time")
+			continue
+		}
+		blockTime := time.Unix(int64(header.Time), 0)
+		blockTimes[blockKey] = blockTime
+		times[key] = blockTime
+	}
+	return times
+}
@@ -0,0 +1,143 @@
+package common
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddressassignment"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/ethereum/go-ethereum"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+// stubBlockTimeClient serves the headers of blocks mined at fixed times
+type stubBlockTimeClient struct {
+	times map[int64]time.Time
+	calls int
+}
+
+func (c *stubBlockTimeClient) HeaderByNumber(ctx context.Context, number *big.Int) (*ethtypes.Header, error) {
+	c.calls++
+	return &ethtypes.Header{Number: number, Time: uint64(c.times[number.Int64()].Unix())}, nil
+}
+
+func (c *stubBlockTimeClient) TransactionReceipt(ctx context.Context, txHash ethcommon.Hash) (*ethtypes.Receipt, error) {
+	return nil, ethereum.NotFound
+}
+
+func TestReceiveAddressAssignments(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:addressassignments?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	ctx := context.Background()
+	orders := setupDepositSplit(t, ctx)
+	now := time.Now()
+
+	// The address served the first order an hour and a half ago, was recycled and now serves the
+	// second order. The third order predates assignment records
+	assign := func(order *ent.PaymentOrder, from time.Time, until *time.Time) {
+		client.ReceiveAddressAssignment.
+			Create().
+			SetAddress(splitTestAddress).
+			SetReceiveAddress(order.Edges.ReceiveAddress).
+			SetPaymentOrderID(order.ID).
+			SetAssignedAt(from).
+			SetNillableReleasedAt(until).
+			SaveX(ctx)
+	}
+	released := now.Add(-time.Hour)
+	assign(orders[0], now.Add(-2*time.Hour), &released)
+	assign(orders[1], now.Add(-30*time.Minute), nil)
+
+	windows, err := assignmentWindows(ctx, client, orders)
+	assert.NoError(t, err)
+
+	transfer := func(value float64) *types.TokenTransferEvent {
+		return &types.TokenTransferEvent{
+			BlockNumber: 100,
+			TxHash:      "0xdeposit",
+			To:          splitTestAddress,
+			Value:       decimal.NewFromFloat(value),
+		}
+	}
+
+	t.Run("should only match the orders the address served when the deposit was mined", func(t *testing.T) {
+		active := activeOrdersAt(orders, windows, now.Add(-90*time.Minute))
+		if assert.Len(t, active, 1) {
+			assert.Equal(t, orders[0].ID, active[0].ID)
+		}
+
+		active = activeOrdersAt(orders, windows, now.Add(-10*time.Minute))
+		if assert.Len(t, active, 1) {
+			assert.Equal(t, orders[1].ID, active[0].ID)
+		}
+
+		assert.Empty(t, activeOrdersAt(orders, windows, now.Add(-3*time.Hour)))
+		assert.Len(t, activeOrdersAt(orders, windows, now.Add(time.Hour)), 2)
+	})
+
+	t.Run("should credit a deposit to exactly one order", func(t *testing.T) {
+		active := activeOrdersAt(orders, windows, now.Add(time.Hour))
+
+		// The deposit matches the outstanding total of the later order
+		assert.Equal(t, orders[2].ID, depositRecipient(active, windows, transfer(15.5)).ID)
+
+		// Otherwise the order assigned the address first is credited
+		assert.Equal(t, orders[1].ID, depositRecipient(active, windows, transfer(99)).ID)
+	})
+
+	t.Run("should resolve deposit times from the event or the block header", func(t *testing.T) {
+		mined := now.Add(-20 * time.Minute).Truncate(time.Second)
+		chain := &stubBlockTimeClient{times: map[int64]time.Time{100: mined}}
+		previous := dialConfirmationsClient
+		dialConfirmationsClient = func(network *ent.Network) (confirmationsClient, error) {
+			return chain, nil
+		}
+		defer func() { dialConfirmationsClient = previous }()
+
+		times := depositTimes(ctx, orders, map[string]*types.TokenTransferEvent{splitTestAddress: transfer(10)})
+		assert.True(t, mined.Equal(times[splitTestAddress]))
+		assert.Equal(t, 1, chain.calls)
+
+		event := transfer(10)
+		event.BlockTimestamp = now.Add(-time.Minute).Unix()
+		times = depositTimes(ctx, orders, map[string]*types.TokenTransferEvent{splitTestAddress: event})
+		assert.Equal(t, event.BlockTimestamp, times[splitTestAddress].Unix())
+		assert.Equal(t, 1, chain.calls)
+	})
+
+	t.Run("should close the previous window when an order is reassigned", func(t *testing.T) {
+		newAddress := client.ReceiveAddress.
+			Create().
+			SetAddress("0x3333333333333333333333333333333333333333").
+			SaveX(ctx)
+
+		tx, err := client.Tx(ctx)
+		assert.NoError(t, err)
+		assert.NoError(t, AssignReceiveAddress(ctx, tx, orders[1], newAddress))
+		assert.NoError(t, tx.Commit())
+
+		assignments := client.ReceiveAddressAssignment.
+			Query().
+			Where(receiveaddressassignment.ReleasedAtIsNil()).
+			AllX(ctx)
+		if assert.Len(t, assignments, 1) {
+			assert.Equal(t, newAddress.Address, assignments[0].Address)
+		}
+
+		windows, err := assignmentWindows(ctx, client, orders)
+		assert.NoError(t, err)
+		assert.Len(t, windows[orders[1].ID], 2)
+		assert.Empty(t, activeOrdersAt(orders[:2], windows, now.Add(-3*time.Hour)))
+	})
+}
@@ -0,0 +1,205 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	networkent "github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	"github.com/NEDA-LABS/stablenode/services"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/google/uuid"
+	"github.com/spf13/viper"
+)
+
+var (
+	// ErrOrderNotExtendable is returned when extending the receive address of an order that was
+	// paid, or of a private order, which never expires
+	ErrOrderNotExtendable = errors.New("order is not awaiting payment")
+
+	// ErrReceiveAddressUnavailable is returned when re-activating an expired order whose pool address
+	// was taken out of rotation
+	ErrReceiveAddressUnavailable = errors.New("receive address is no longer available")
+)
+
+// ExtendReceiveAddress keeps the receive address of an unpaid order valid for validity from now.
+// An expired order is re-activated: it is initiated again, its receive address is claimed back for
+// it and the sender is notified. The transfer webhook of the address is registered again when the
+// order has none, as expiry deletes it.
+func ExtendReceiveAddress(ctx context.Context, orderID uuid.UUID, validity time.Duration) (*ent.PaymentOrder, error) {
+	order, err := fetchExtendableOrder(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	receiveAddress := order.Edges.ReceiveAddress
+	switch {
+	case order.Status != paymentorder.StatusInitiated && order.Status != paymentorder.StatusExpired,
+		!order.AmountPaid.IsZero() || order.TxHash != "",
+		receiveAddress == nil || receiveAddress.ValidUntil.IsZero(),
+		order.Edges.Recipient != nil && strings.HasPrefix(order.Edges.Recipient.Memo, "P#P"):
+		return nil, ErrOrderNotExtendable
+	}
+
+	reactivate := order.Status == paymentorder.StatusExpired
+	validUntil := time.Now().Add(validity)
+
+	tx, err := db.Client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("ExtendReceiveAddress.db: %w", err)
+	}
+
+	if reactivate {
+		receiveAddress, err = reclaimReceiveAddress(ctx, tx, receiveAddress, validUntil)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, fmt.Errorf("ExtendReceiveAddress.reclaim: %w", err)
+		}
+
+		_, err = tx.PaymentOrder.
+			Update().
+			Where(
+				paymentorder.IDEQ(order.ID),
+				paymentorder.StatusEQ(paymentorder.StatusExpired),
+			).
+			ClearReceiveAddress().
+			SetReceiveAddress(receiveAddress).
+			SetStatus(paymentorder.StatusInitiated).
+			Save(ctx)
+		if err == nil {
+			err = AssignReceiveAddress(ctx, tx, order, receiveAddress)
+		}
+	} else {
+		_, err = tx.ReceiveAddress.
+			Update().
+			Where(
+				receiveaddress.IDEQ(receiveAddress.ID),
+				receiveaddress.StatusIn(receiveaddress.StatusUnused, receiveaddress.StatusPoolAssigned),
+			).
+			SetValidUntil(validUntil).
+			Save(ctx)
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("ExtendReceiveAddress.update: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("ExtendReceiveAddress.commit: %w", err)
+	}
+
+	network := order.Edges.Token.Edges.Network
+	logger.WithFields(logger.Fields{
+		"OrderID":        order.ID.String(),
+		"Network":        network.Identifier,
+		"ReceiveAddress": receiveAddress.Address,
+		"ValidUntil":     validUntil,
+		"Reactivated":    reactivate,
+	}).Infof("Receive address validity extended")
+
+	var errs []string
+	if order.Edges.PaymentWebhook == nil && usesTransferWebhooks(network) {
+		if err := registerTransferWebhook(ctx, order, receiveAddress.Address); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+
+	order, err = fetchExtendableOrder(ctx, order.ID)
+	if err != nil {
+		return nil, fmt.Errorf("ExtendReceiveAddress.fetchOrder: %w", err)
+	}
+
+	if reactivate {
+		if err := utils.SendPaymentOrderWebhook(ctx, order); err != nil {
+			errs = append(errs, fmt.Sprintf("notify sender: %v", err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return order, fmt.Errorf("ExtendReceiveAddress: %s", strings.Join(errs, "; "))
+	}
+
+	return order, nil
+}
+
+// fetchExtendableOrder fetches an order with the edges extending its receive address needs
+func fetchExtendableOrder(ctx context.Context, orderID uuid.UUID) (*ent.PaymentOrder, error) {
+	return db.Client.PaymentOrder.
+		Query().
+		Where(paymentorder.IDEQ(orderID)).
+		WithToken(func(tq *ent.TokenQuery) {
+			tq.WithNetwork()
+		}).
+		WithReceiveAddress().
+		WithRecipient().
+		WithSenderProfile().
+		WithPaymentWebhook().
+		Only(ctx)
+}
+
+// reclaimReceiveAddress claims the receive address an expired order released back for the order.
+// A pool address must still be in the pool; as the pool may have taken over the order's row, the
+// order gets a new pool_assigned row for it
+func reclaimReceiveAddress(ctx context.Context, tx *ent.Tx, address *ent.ReceiveAddress, validUntil time.Time) (*ent.ReceiveAddress, error) {
+	// Only pool rows carry a network identifier
+	if address.NetworkIdentifier == "" {
+		if address.Status != receiveaddress.StatusExpired {
+			return nil, ErrReceiveAddressUnavailable
+		}
+		return tx.ReceiveAddress.
+			UpdateOne(address).
+			SetStatus(receiveaddress.StatusUnused).
+			SetValidUntil(validUntil).
+			Save(ctx)
+	}
+
+	pooled, err := tx.ReceiveAddress.
+		Query().
+		Where(
+			receiveaddress.AddressEQ(address.Address),
+			receiveaddress.NetworkIdentifierEQ(address.NetworkIdentifier),
+			receiveaddress.StatusEQ(receiveaddress.StatusPoolReady),
+		).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrReceiveAddressUnavailable
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to check pool address: %w", err)
+	}
+
+	if address.Status == receiveaddress.StatusExpired {
+		return tx.ReceiveAddress.
+			UpdateOne(address).
+			SetStatus(receiveaddress.StatusPoolAssigned).
+			SetAssignedAt(time.Now()).
+			SetValidUntil(validUntil).
+			Save(ctx)
+	}
+
+	return tx.ReceiveAddress.
+		Create().
+		SetAddress(address.Address).
+		SetStatus(receiveaddress.StatusPoolAssigned).
+		SetIsDeployed(pooled.IsDeployed).
+		SetNetworkIdentifier(address.NetworkIdentifier).
+		SetChainID(address.ChainID).
+		SetAssignedAt(time.Now()).
+		SetValidUntil(validUntil).
+		Save(ctx)
+}
+
+// usesTransferWebhooks reports whether deposits to receive addresses on a network are detected
+// through thirdweb transfer webhooks
+func usesTransferWebhooks(network *ent.Network) bool {
+	return network.NetworkType != networkent.NetworkTypeSolana &&
+		!strings.HasPrefix(network.Identifier, "tron") &&
+		!viper.GetBool("USE_ALCHEMY_FOR_RECEIVE_ADDRESSES") &&
+		!services.WebhookURLUnreachable()
+}
@@ -0,0 +1,179 @@
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/test"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/shopspring/decimal"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtendReceiveAddress(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:receiveaddressextension?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	// Deposits are not detected through transfer webhooks
+	viper.Set("USE_ALCHEMY_FOR_RECEIVE_ADDRESSES", true)
+	defer viper.Set("USE_ALCHEMY_FOR_RECEIVE_ADDRESSES", false)
+
+	ctx := context.Background()
+
+	token, err := test.CreateERC20Token(nil, map[string]interface{}{
+		"symbol":         "USDC",
+		"identifier":     "base-sepolia",
+		"chainID":        int64(84532),
+		"deployContract": false,
+	})
+	assert.NoError(t, err)
+	network := token.Edges.Network
+
+	user, err := test.CreateTestUser(map[string]interface{}{
+		"email": "sender@test.com",
+	})
+	assert.NoError(t, err)
+
+	sender, err := test.CreateTestSenderProfile(map[string]interface{}{
+		"user_id":     user.ID,
+		"token":       token.Symbol,
+		"webhook_url": "",
+	})
+	assert.NoError(t, err)
+
+	poolAddress := "0x1111111111111111111111111111111111111111"
+	pool := client.ReceiveAddress.
+		Create().
+		SetAddress(poolAddress).
+		SetStatus(receiveaddress.StatusPoolReady).
+		SetIsDeployed(true).
+		SetNetworkIdentifier(network.Identifier).
+		SetChainID(network.ChainID).
+		SaveX(ctx)
+
+	lapsed := time.Now().Add(-time.Minute)
+	createOrder := func(address string, pooled bool, validUntil time.Time, memo string) *ent.PaymentOrder {
+		create := client.ReceiveAddress.
+			Create().
+			SetAddress(address).
+			SetValidUntil(validUntil)
+		if pooled {
+			create.
+				SetStatus(receiveaddress.StatusPoolAssigned).
+				SetIsDeployed(true).
+				SetNetworkIdentifier(network.Identifier).
+				SetChainID(network.ChainID).
+				SetAssignedAt(time.Now().Add(-time.Hour))
+		}
+		receiveAddress := create.SaveX(ctx)
+
+		order, err := test.CreateTestPaymentOrder(nil, token, map[string]interface{}{
+			"sender":          sender,
+			"receive_address": receiveAddress,
+			"amount":          10.0,
+			"amount_in_usd":   10.0,
+			"rate":            130.0,
+			"status":          "initiated",
+			"memo":            memo,
+		})
+		assert.NoError(t, err)
+		return order
+	}
+
+	// expire lets the validity and grace period of an order lapse and expires it
+	expire := func(order *ent.PaymentOrder) {
+		client.ReceiveAddress.
+			Update().
+			Where(receiveaddress.HasPaymentOrderWith(paymentorder.IDEQ(order.ID))).
+			SetValidUntil(time.Now().Add(-time.Hour)).
+			ExecX(ctx)
+		_, err := ExpireOrders(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, paymentorder.StatusExpired, client.PaymentOrder.GetX(ctx, order.ID).Status)
+	}
+
+	t.Run("extends lapsed receive addresses within the grace period", func(t *testing.T) {
+		order := createOrder(poolAddress, true, lapsed, "")
+
+		// The order is still open and its address still accepts deposits
+		expired, err := ExpireOrders(ctx)
+		assert.NoError(t, err)
+		assert.Zero(t, expired)
+		assert.True(t, client.PaymentOrder.Query().Where(paymentorder.HasReceiveAddressWith(acceptsDeposits())).ExistX(ctx))
+
+		extended, err := ExtendReceiveAddress(ctx, order.ID, time.Hour)
+		assert.NoError(t, err)
+		assert.Equal(t, paymentorder.StatusInitiated, extended.Status)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), extended.Edges.ReceiveAddress.ValidUntil, 5*time.Second)
+
+		expire(order)
+	})
+
+	t.Run("re-activates expired orders whose row was expired", func(t *testing.T) {
+		// The first test's order was expired while the pool held its address
+		order := client.PaymentOrder.Query().WithReceiveAddress().FirstX(ctx)
+		assert.Equal(t, receiveaddress.StatusExpired, order.Edges.ReceiveAddress.Status)
+
+		reactivated, err := ExtendReceiveAddress(ctx, order.ID, time.Hour)
+		assert.NoError(t, err)
+		assert.Equal(t, paymentorder.StatusInitiated, reactivated.Status)
+		assert.Equal(t, order.Edges.ReceiveAddress.ID, reactivated.Edges.ReceiveAddress.ID)
+		assert.Equal(t, receiveaddress.StatusPoolAssigned, reactivated.Edges.ReceiveAddress.Status)
+		assert.Equal(t, receiveaddress.StatusPoolReady, client.ReceiveAddress.GetX(ctx, pool.ID).Status)
+	})
+
+	t.Run("re-activates expired orders on a new row when the pool took theirs", func(t *testing.T) {
+		order := createOrder("0x2222222222222222222222222222222222222222", true, lapsed, "")
+		expire(order)
+		released := client.PaymentOrder.QueryReceiveAddress(order).OnlyX(ctx)
+		assert.Equal(t, receiveaddress.StatusPoolReady, released.Status)
+
+		reactivated, err := ExtendReceiveAddress(ctx, order.ID, time.Hour)
+		assert.NoError(t, err)
+		assert.Equal(t, paymentorder.StatusInitiated, reactivated.Status)
+		assert.NotEqual(t, released.ID, reactivated.Edges.ReceiveAddress.ID)
+		assert.Equal(t, released.Address, reactivated.Edges.ReceiveAddress.Address)
+		assert.Equal(t, receiveaddress.StatusPoolAssigned, reactivated.Edges.ReceiveAddress.Status)
+		assert.Equal(t, receiveaddress.StatusPoolReady, client.ReceiveAddress.GetX(ctx, released.ID).Status)
+	})
+
+	t.Run("re-activates expired orders with their own address", func(t *testing.T) {
+		order := createOrder("TXYZopYRdj2D9XRtbG411XZZ3kM5VkAeBf", false, lapsed, "")
+		expire(order)
+
+		reactivated, err := ExtendReceiveAddress(ctx, order.ID, time.Hour)
+		assert.NoError(t, err)
+		assert.Equal(t, paymentorder.StatusInitiated, reactivated.Status)
+		assert.Equal(t, receiveaddress.StatusUnused, reactivated.Edges.ReceiveAddress.Status)
+	})
+
+	t.Run("rejects orders whose pool address was retired", func(t *testing.T) {
+		order := createOrder(poolAddress, true, lapsed, "")
+		expire(order)
+		client.ReceiveAddress.UpdateOne(pool).SetStatus(receiveaddress.StatusExpired).ExecX(ctx)
+		defer client.ReceiveAddress.UpdateOne(pool).SetStatus(receiveaddress.StatusPoolReady).ExecX(ctx)
+
+		_, err := ExtendReceiveAddress(ctx, order.ID, time.Hour)
+		assert.ErrorIs(t, err, ErrReceiveAddressUnavailable)
+		assert.Equal(t, paymentorder.StatusExpired, client.PaymentOrder.GetX(ctx, order.ID).Status)
+	})
+
+	t.Run("rejects paid and private orders", func(t *testing.T) {
+		paid := createOrder(poolAddress, true, lapsed, "")
+		client.PaymentOrder.UpdateOne(paid).SetAmountPaid(decimal.NewFromFloat(10)).SetStatus(paymentorder.StatusPending).ExecX(ctx)
+		private := createOrder(poolAddress, true, time.Time{}, "P#P private order")
+
+		for _, order := range []*ent.PaymentOrder{paid, private} {
+			_, err := ExtendReceiveAddress(ctx, order.ID, time.Hour)
+			assert.ErrorIs(t, err, ErrOrderNotExtendable)
+		}
+	})
+}
@@ -0,0 +1,241 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	networkent "github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
+	"github.com/NEDA-LABS/stablenode/services"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/google/uuid"
+)
+
+// ErrOrderNotMigratable is returned when moving an order that is no longer awaiting its deposit
+var ErrOrderNotMigratable = errors.New("order is not awaiting payment")
+
+// MigrateReceiveAddress moves an initiated order that has not been paid to a fresh receive
+// address, e.g. because its address is compromised. The old address row is expired so deposits
+// to it no longer match the order, the transfer webhook is moved to the new address, and the
+// sender is notified of the new payment address. With retire, a pool address is also taken out
+// of rotation for new orders.
+// Returns the updated order and its previous receive address.
+func MigrateReceiveAddress(ctx context.Context, orderID uuid.UUID, reason string, retire bool) (*ent.PaymentOrder, string, error) {
+	order, err := db.Client.PaymentOrder.
+		Query().
+		Where(paymentorder.IDEQ(orderID)).
+		WithToken(func(tq *ent.TokenQuery) {
+			tq.WithNetwork()
+		}).
+		WithReceiveAddress().
+		WithPaymentWebhook().
+		Only(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if order.Status != paymentorder.StatusInitiated || !order.AmountPaid.IsZero() || order.TxHash != "" {
+		return nil, "", ErrOrderNotMigratable
+	}
+
+	network := order.Edges.Token.Edges.Network
+	previousAddress := order.ReceiveAddressText
+
+	receiveAddress, err := newReceiveAddress(ctx, network, previousAddress)
+	if err != nil {
+		return nil, "", fmt.Errorf("MigrateReceiveAddress.newReceiveAddress: %w", err)
+	}
+
+	// The new address may be held by another blockchain service than the one it replaces
+	var blockchainService *paymentorder.BlockchainService
+	if order.BlockchainService != nil {
+		service := services.ReceiveAddressBlockchainService(receiveAddress)
+		blockchainService = &service
+	}
+
+	tx, err := db.Client.Tx(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("MigrateReceiveAddress.db: %w", err)
+	}
+
+	if order.Edges.ReceiveAddress != nil {
+		_, err = tx.ReceiveAddress.
+			UpdateOneID(order.Edges.ReceiveAddress.ID).
+			SetStatus(receiveaddress.StatusExpired).
+			SetValidUntil(time.Now()).
+			Save(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, "", fmt.Errorf("MigrateReceiveAddress.expireAddress: %w", err)
+		}
+	}
+
+	if retire {
+		_, err = tx.ReceiveAddress.
+			Update().
+			Where(
+				receiveaddress.AddressEQ(previousAddress),
+				receiveaddress.StatusEQ(receiveaddress.StatusPoolReady),
+			).
+			SetStatus(receiveaddress.StatusExpired).
+			Save(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, "", fmt.Errorf("MigrateReceiveAddress.retireAddress: %w", err)
+		}
+	}
+
+	transactionLog, err := tx.TransactionLog.
+		Create().
+		SetStatus(transactionlog.StatusReceiveAddressMigrated).
+		SetNetwork(network.Identifier).
+		SetMetadata(map[string]interface{}{
+			"PreviousReceiveAddress": previousAddress,
+			"ReceiveAddress":         receiveAddress.Address,
+			"Reason":                 reason,
+			"Retired":                retire,
+		}).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, "", fmt.Errorf("MigrateReceiveAddress.transactionlog: %w", err)
+	}
+
+	_, err = tx.PaymentOrder.
+		UpdateOneID(order.ID).
+		ClearReceiveAddress().
+		SetReceiveAddress(receiveAddress).
+		SetReceiveAddressText(receiveAddress.Address).
+		SetNillableBlockchainService(blockchainService).
+		AddTransactions(transactionLog).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, "", fmt.Errorf("MigrateReceiveAddress.updateOrder: %w", err)
+	}
+
+	if err := AssignReceiveAddress(ctx, tx, order, receiveAddress); err != nil {
+		_ = tx.Rollback()
+		return nil, "", fmt.Errorf("MigrateReceiveAddress.assign: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, "", fmt.Errorf("MigrateReceiveAddress.commit: %w", err)
+	}
+
+	logger.WithFields(logger.Fields{
+		"OrderID":                order.ID.String(),
+		"Network":                network.Identifier,
+		"PreviousReceiveAddress": previousAddress,
+		"ReceiveAddress":         receiveAddress.Address,
+		"Reason":                 reason,
+	}).Infof("Payment order moved to a new receive address")
+
+	var errs []string
+	if order.Edges.PaymentWebhook != nil {
+		if err := moveTransferWebhook(ctx, order, receiveAddress.Address); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+
+	order, err = db.Client.PaymentOrder.
+		Query().
+		Where(paymentorder.IDEQ(order.ID)).
+		WithToken(func(tq *ent.TokenQuery) {
+			tq.WithNetwork()
+		}).
+		WithReceiveAddress().
+		WithSenderProfile().
+		Only(ctx)
+	if err != nil {
+		return nil, previousAddress, fmt.Errorf("MigrateReceiveAddress.fetchOrder: %w", err)
+	}
+
+	if err := utils.SendReceiveAddressChangedWebhook(ctx, order); err != nil {
+		errs = append(errs, fmt.Sprintf("notify sender: %v", err))
+	}
+
+	if len(errs) > 0 {
+		return order, previousAddress, fmt.Errorf("MigrateReceiveAddress: %s", strings.Join(errs, "; "))
+	}
+
+	return order, previousAddress, nil
+}
+
+// newReceiveAddress returns a fresh receive address on a network, never the excluded address.
+// Solana and Tron get a new keypair and EVM networks the least-used other pool address
+func newReceiveAddress(ctx context.Context, network *ent.Network, exclude string) (*ent.ReceiveAddress, error) {
+	validity := config.OrderConfig().ReceiveAddressValidity
+
+	isSolana := network.NetworkType == networkent.NetworkTypeSolana
+	if !isSolana && !strings.HasPrefix(network.Identifier, "tron") {
+		return db.AssignPoolAddress(ctx, network.Identifier, validity, exclude)
+	}
+
+	var address string
+	var salt []byte
+	var err error
+	if isSolana {
+		address, salt, err = services.NewReceiveAddressService().CreateSolanaAddress(ctx)
+	} else {
+		address, salt, err = services.NewReceiveAddressService().CreateTronAddress(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return db.Client.ReceiveAddress.
+		Create().
+		SetAddress(address).
+		SetSalt(salt).
+		SetStatus(receiveaddress.StatusUnused).
+		SetValidUntil(time.Now().Add(validity)).
+		Save(ctx)
+}
+
+// moveTransferWebhook replaces the transfer webhook of an order with one watching its new address
+func moveTransferWebhook(ctx context.Context, order *ent.PaymentOrder, address string) error {
+	if err := services.NewEngineService().ReleaseOrderWebhook(ctx, order.Edges.PaymentWebhook); err != nil {
+		return fmt.Errorf("delete transfer webhook: %w", err)
+	}
+
+	return registerTransferWebhook(ctx, order, address)
+}
+
+// registerTransferWebhook creates a transfer webhook watching the receive address of an order
+func registerTransferWebhook(ctx context.Context, order *ent.PaymentOrder, address string) error {
+	token := order.Edges.Token
+
+	webhookID, webhookSecret, err := services.NewEngineService().CreateTransferWebhook(
+		ctx,
+		token.Edges.Network.ChainID,
+		token.ContractAddress,
+		address,
+		order.ID.String(),
+	)
+	if err != nil {
+		return fmt.Errorf("create transfer webhook: %w", err)
+	}
+
+	_, err = db.Client.PaymentWebhook.
+		Create().
+		SetWebhookID(webhookID).
+		SetWebhookSecret(webhookSecret).
+		SetCallbackURL(fmt.Sprintf("%s/v1/insight/webhook", config.ServerConfig().ServerURL)).
+		SetPaymentOrderID(order.ID).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("save transfer webhook: %w", err)
+	}
+
+	return nil
+}
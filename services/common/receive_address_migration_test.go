@@ -0,0 +1,133 @@
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/test"
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	migrationOldAddress = "0x1111111111111111111111111111111111111111"
+	migrationNewAddress = "0x2222222222222222222222222222222222222222"
+)
+
+// setupReceiveAddressMigration creates a two-address pool and an initiated order on the least-used address
+func setupReceiveAddressMigration(t *testing.T, ctx context.Context) *ent.PaymentOrder {
+	token, err := test.CreateERC20Token(nil, map[string]interface{}{
+		"symbol":         "USDC",
+		"identifier":     "base-sepolia",
+		"chainID":        int64(84532),
+		"deployContract": false,
+	})
+	assert.NoError(t, err)
+	network := token.Edges.Network
+
+	user, err := test.CreateTestUser(map[string]interface{}{
+		"email": "migration@test.com",
+	})
+	assert.NoError(t, err)
+
+	sender, err := test.CreateTestSenderProfile(map[string]interface{}{
+		"user_id":     user.ID,
+		"token":       token.Symbol,
+		"webhook_url": "",
+	})
+	assert.NoError(t, err)
+
+	for address, timesUsed := range map[string]int{migrationOldAddress: 1, migrationNewAddress: 5} {
+		_, err := db.Client.ReceiveAddress.
+			Create().
+			SetAddress(address).
+			SetStatus(receiveaddress.StatusPoolReady).
+			SetIsDeployed(true).
+			SetTimesUsed(timesUsed).
+			SetNetworkIdentifier(network.Identifier).
+			SetChainID(network.ChainID).
+			Save(ctx)
+		assert.NoError(t, err)
+	}
+
+	receiveAddress, err := db.AssignPoolAddress(ctx, network.Identifier, time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, migrationOldAddress, receiveAddress.Address)
+
+	order, err := test.CreateTestPaymentOrder(nil, token, map[string]interface{}{
+		"sender":          sender,
+		"receive_address": receiveAddress,
+		"amount":          10.0,
+		"amount_in_usd":   10.0,
+		"rate":            1500.0,
+		"status":          "initiated",
+	})
+	assert.NoError(t, err)
+
+	return order
+}
+
+func TestMigrateReceiveAddress(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:addressmigration?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	ctx := context.Background()
+	order := setupReceiveAddressMigration(t, ctx)
+	oldRow := order.QueryReceiveAddress().OnlyX(ctx)
+
+	t.Run("should move the order to another pool address and expire the old one", func(t *testing.T) {
+		migrated, previous, err := MigrateReceiveAddress(ctx, order.ID, "address leaked", true)
+		assert.NoError(t, err)
+		assert.Equal(t, migrationOldAddress, previous)
+		assert.Equal(t, migrationNewAddress, migrated.ReceiveAddressText)
+		assert.Equal(t, migrationNewAddress, migrated.Edges.ReceiveAddress.Address)
+		assert.Equal(t, receiveaddress.StatusPoolAssigned, migrated.Edges.ReceiveAddress.Status)
+
+		expired := client.ReceiveAddress.GetX(ctx, oldRow.ID)
+		assert.Equal(t, receiveaddress.StatusExpired, expired.Status)
+		assert.True(t, expired.ValidUntil.Before(time.Now().Add(time.Second)))
+
+		retired, err := client.ReceiveAddress.
+			Query().
+			Where(
+				receiveaddress.AddressEQ(migrationOldAddress),
+				receiveaddress.StatusEQ(receiveaddress.StatusPoolReady),
+			).
+			Exist(ctx)
+		assert.NoError(t, err)
+		assert.False(t, retired, "the retired pool address is out of rotation")
+
+		log, err := migrated.QueryTransactions().
+			Where(transactionlog.StatusEQ(transactionlog.StatusReceiveAddressMigrated)).
+			Only(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, "address leaked", log.Metadata["Reason"])
+		assert.Equal(t, migrationOldAddress, log.Metadata["PreviousReceiveAddress"])
+	})
+
+	t.Run("should fail when the pool has no other address", func(t *testing.T) {
+		_, _, err := MigrateReceiveAddress(ctx, order.ID, "again", false)
+		assert.True(t, ent.IsNotFound(err))
+	})
+
+	t.Run("should refuse orders that are already paid", func(t *testing.T) {
+		client.PaymentOrder.UpdateOneID(order.ID).SetAmountPaid(decimal.NewFromFloat(10.5)).ExecX(ctx)
+
+		_, _, err := MigrateReceiveAddress(ctx, order.ID, "late", false)
+		assert.ErrorIs(t, err, ErrOrderNotMigratable)
+	})
+
+	t.Run("should return not found for unknown orders", func(t *testing.T) {
+		_, _, err := MigrateReceiveAddress(ctx, uuid.New(), "missing", false)
+		assert.True(t, ent.IsNotFound(err))
+	})
+}
@@ -0,0 +1,500 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	networkent "github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	"github.com/NEDA-LABS/stablenode/ent/reconciliationdiscrepancy"
+	"github.com/NEDA-LABS/stablenode/ent/reconciliationreport"
+	tokenent "github.com/NEDA-LABS/stablenode/ent/token"
+	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
+	svc "github.com/NEDA-LABS/stablenode/services"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+)
+
+// reconciliationSource reads the transfers to receive addresses from the chain
+type reconciliationSource interface {
+	GetBlockRange(ctx context.Context, network *ent.Network, start, end time.Time) (int64, int64, error)
+	GetAssetTransfers(ctx context.Context, network *ent.Network, tokens []*ent.Token, address string, fromBlock, toBlock int64) ([]*svc.AssetTransfer, error)
+}
+
+// newReconciliationSource returns the chain reader of reconciliations; tests replace it
+var newReconciliationSource = func() reconciliationSource {
+	return svc.NewBalanceService(0)
+}
+
+// reconciledDeposit is the value a transaction moved to a receive address, as seen on one side of a
+// reconciliation
+type reconciledDeposit struct {
+	txHash  string
+	address string
+	token   string
+	from    string
+	block   int64
+	amount  decimal.Decimal
+	orderID *uuid.UUID
+}
+
+// reconciliationMismatch is a deposit the two sides of a reconciliation disagree on
+type reconciliationMismatch struct {
+	kind     reconciliationdiscrepancy.Type
+	deposit  *reconciledDeposit
+	onchain  decimal.Decimal
+	recorded decimal.Decimal
+}
+
+// ReconcileDeposits reconciles the previous UTC day on every network without a completed report for
+// it. A day is reconciled once the reconciliation delay has passed since it ended, so indexing has
+// caught up; failed reports are retried on the next run
+func ReconcileDeposits(ctx context.Context) error {
+	day := time.Now().UTC().Add(-config.ReconciliationConfig().Delay).Truncate(24*time.Hour).AddDate(0, 0, -1)
+
+	isTestnet := false
+	if serverConf := config.ServerConfig(); serverConf.Environment != "production" && serverConf.Environment != "staging" {
+		isTestnet = true
+	}
+
+	networks, err := db.Client.Network.
+		Query().
+		Where(
+			networkent.IsTestnetEQ(isTestnet),
+			networkent.NetworkTypeEQ(networkent.NetworkTypeEvm),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("ReconcileDeposits.networks: %w", err)
+	}
+
+	for _, network := range networks {
+		completed, err := db.Client.ReconciliationReport.
+			Query().
+			Where(
+				reconciliationreport.NetworkEQ(network.Identifier),
+				reconciliationreport.DayEQ(day),
+				reconciliationreport.StatusEQ(reconciliationreport.StatusCompleted),
+			).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("ReconcileDeposits.reports: %w", err)
+		}
+		if completed {
+			continue
+		}
+
+		report, err := ReconcileNetwork(ctx, network, day)
+		if err != nil {
+			logger.WithFields(logger.Fields{
+				"Error":   fmt.Sprintf("%v", err),
+				"Network": network.Identifier,
+				"Day":     day.Format(time.DateOnly),
+			}).Errorf("Failed to save reconciliation report")
+			continue
+		}
+
+		fields := logger.Fields{
+			"Network":       network.Identifier,
+			"Day":           day.Format(time.DateOnly),
+			"Addresses":     report.AddressesChecked,
+			"Transfers":     report.TransfersChecked,
+			"Discrepancies": report.DiscrepancyCount,
+		}
+		if report.Status == reconciliationreport.StatusFailed {
+			fields["Error"] = report.Error
+			logger.WithFields(fields).Errorf("Failed to reconcile deposits")
+			continue
+		}
+		logger.WithFields(fields).Infof("Deposits reconciled")
+
+		if report.DiscrepancyCount > 0 {
+			err = svc.NewSlackService(config.ServerConfig().SlackWebhookURL).SendAlert("Deposit reconciliation discrepancies", map[string]string{
+				"Network":       network.Identifier,
+				"Day":           day.Format(time.DateOnly),
+				"Discrepancies": fmt.Sprintf("%d", report.DiscrepancyCount),
+				"Report ID":     report.ID.String(),
+			})
+			if err != nil {
+				logger.Errorf("Failed to send reconciliation alert: %v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ReconcileNetwork compares the transfers to receive addresses mined on a network during a UTC day with
+// the deposits recorded for them, and stores what they disagree on in a report replacing any earlier
+// report of the day. A reconciliation that can't read the chain is stored as a failed report
+func ReconcileNetwork(ctx context.Context, network *ent.Network, day time.Time) (*ent.ReconciliationReport, error) {
+	report := &ent.ReconciliationReport{
+		Network: network.Identifier,
+		Day:     day.UTC().Truncate(24 * time.Hour),
+		Status:  reconciliationreport.StatusCompleted,
+	}
+
+	mismatches, err := reconcileNetwork(ctx, network, report)
+	if err != nil {
+		report.Status = reconciliationreport.StatusFailed
+		report.Error = err.Error()
+		mismatches = nil
+	}
+
+	return saveReconciliationReport(ctx, report, mismatches)
+}
+
+// reconcileNetwork fills in the block range and counts of a report and returns its mismatches
+func reconcileNetwork(ctx context.Context, network *ent.Network, report *ent.ReconciliationReport) ([]*reconciliationMismatch, error) {
+	source := newReconciliationSource()
+	start := report.Day
+	end := start.Add(24 * time.Hour)
+
+	fromBlock, toBlock, err := source.GetBlockRange(ctx, network, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find the blocks of the day: %w", err)
+	}
+	report.FromBlock, report.ToBlock = fromBlock, toBlock
+
+	tokens, err := db.Client.Token.
+		Query().
+		Where(
+			tokenent.IsEnabledEQ(true),
+			tokenent.HasNetworkWith(networkent.IDEQ(network.ID)),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch tokens: %w", err)
+	}
+
+	recorded, err := recordedDeposits(ctx, network, start, end, fromBlock, toBlock)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch recorded deposits: %w", err)
+	}
+
+	addresses, err := reconciledAddresses(ctx, network, start.Add(-config.ReconciliationConfig().AddressLookback))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch receive addresses: %w", err)
+	}
+	for _, deposit := range recorded {
+		if _, ok := addresses[strings.ToLower(deposit.address)]; !ok {
+			addresses[strings.ToLower(deposit.address)] = deposit.address
+		}
+	}
+	report.AddressesChecked = len(addresses)
+
+	onchain := make(map[string]*reconciledDeposit)
+	for _, address := range addresses {
+		transfers, err := source.GetAssetTransfers(ctx, network, tokens, address, fromBlock, toBlock)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch transfers to %s: %w", address, err)
+		}
+		for _, transfer := range transfers {
+			report.TransfersChecked++
+			key := depositKey(transfer.TxHash, address)
+			if deposit, ok := onchain[key]; ok {
+				deposit.amount = deposit.amount.Add(transfer.Value)
+				continue
+			}
+			onchain[key] = &reconciledDeposit{
+				txHash:  transfer.TxHash,
+				address: address,
+				token:   transfer.Token.Symbol,
+				from:    transfer.From,
+				block:   transfer.BlockNumber,
+				amount:  transfer.Value,
+			}
+		}
+	}
+
+	var mismatches []*reconciliationMismatch
+	for key, transfer := range onchain {
+		deposit, ok := recorded[key]
+		if ok {
+			if !deposit.amount.Equal(transfer.amount) {
+				transfer.orderID = deposit.orderID
+				mismatches = append(mismatches, &reconciliationMismatch{
+					kind:     reconciliationdiscrepancy.TypeAmountMismatch,
+					deposit:  transfer,
+					onchain:  transfer.amount,
+					recorded: deposit.amount,
+				})
+			}
+			continue
+		}
+
+		// A transfer nobody recorded is a missing credit when an order used the address
+		order, err := db.Client.PaymentOrder.
+			Query().
+			Where(
+				paymentorder.ReceiveAddressTextEqualFold(transfer.address),
+				paymentorder.HasTokenWith(tokenent.HasNetworkWith(networkent.IDEQ(network.ID))),
+			).
+			Order(ent.Desc(paymentorder.FieldCreatedAt)).
+			First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to fetch order of %s: %w", transfer.address, err)
+		}
+
+		kind := reconciliationdiscrepancy.TypeUnknownDeposit
+		if order != nil {
+			kind = reconciliationdiscrepancy.TypeMissingCredit
+			transfer.orderID = &order.ID
+		}
+		mismatches = append(mismatches, &reconciliationMismatch{
+			kind:     kind,
+			deposit:  transfer,
+			onchain:  transfer.amount,
+			recorded: decimal.Zero,
+		})
+	}
+
+	// A recorded deposit with no transfer behind it was credited from nothing
+	for key, deposit := range recorded {
+		if _, ok := onchain[key]; !ok {
+			mismatches = append(mismatches, &reconciliationMismatch{
+				kind:     reconciliationdiscrepancy.TypeUnmatchedCredit,
+				deposit:  deposit,
+				onchain:  decimal.Zero,
+				recorded: deposit.amount,
+			})
+		}
+	}
+
+	sort.Slice(mismatches, func(i, j int) bool {
+		if mismatches[i].deposit.block != mismatches[j].deposit.block {
+			return mismatches[i].deposit.block < mismatches[j].deposit.block
+		}
+		return mismatches[i].deposit.txHash < mismatches[j].deposit.txHash
+	})
+
+	return mismatches, nil
+}
+
+// recordedDeposits returns the deposits credited to orders on a network for transfers mined in the
+// block range, keyed by transaction and receive address. Deposits later reverted by a reorg are left
+// out. Deposits recorded without a block number are placed by when they were recorded
+func recordedDeposits(ctx context.Context, network *ent.Network, start, end time.Time, fromBlock, toBlock int64) (map[string]*reconciledDeposit, error) {
+	orders, err := db.Client.PaymentOrder.
+		Query().
+		Where(
+			paymentorder.HasTokenWith(tokenent.HasNetworkWith(networkent.IDEQ(network.ID))),
+			paymentorder.HasTransactionsWith(
+				transactionlog.StatusEQ(transactionlog.StatusCryptoDeposited),
+				transactionlog.CreatedAtGTE(start),
+			),
+		).
+		WithToken().
+		WithTransactions(func(tq *ent.TransactionLogQuery) {
+			tq.Where(transactionlog.StatusIn(transactionlog.StatusCryptoDeposited, transactionlog.StatusCryptoDepositReverted))
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deposits := make(map[string]*reconciledDeposit)
+	add := func(deposit *reconciledDeposit) {
+		key := depositKey(deposit.txHash, deposit.address)
+		if existing, ok := deposits[key]; ok {
+			existing.amount = existing.amount.Add(deposit.amount)
+			return
+		}
+		deposits[key] = deposit
+	}
+
+	for _, order := range orders {
+		for _, log := range creditedDeposits(order.Edges.Transactions) {
+			transactionData, _ := log.Metadata["transactionData"].(map[string]interface{})
+			address, _ := transactionData["to"].(string)
+			if address == "" {
+				address = order.ReceiveAddressText
+			}
+
+			block := metadataInt(transactionData["blockNumber"])
+			if block > 0 && (block < fromBlock || block > toBlock) {
+				continue
+			}
+			if block == 0 && (log.CreatedAt.Before(start) || !log.CreatedAt.Before(end)) {
+				continue
+			}
+
+			// A split deposit credits each of its orders with an allocation of the transfer
+			amount := depositValue(log)
+			if allocated, ok := transactionData["allocated"].(string); ok {
+				amount, _ = decimal.NewFromString(allocated)
+			}
+
+			from, _ := transactionData["from"].(string)
+			orderID := order.ID
+			add(&reconciledDeposit{
+				txHash:  log.TxHash,
+				address: address,
+				token:   order.Edges.Token.Symbol,
+				from:    from,
+				block:   block,
+				amount:  amount,
+				orderID: &orderID,
+			})
+		}
+	}
+
+	// Orders of linked addresses are created by their deposit without a deposit log
+	linkedOrders, err := db.Client.PaymentOrder.
+		Query().
+		Where(
+			paymentorder.HasTokenWith(tokenent.HasNetworkWith(networkent.IDEQ(network.ID))),
+			paymentorder.HasLinkedAddress(),
+			paymentorder.TxHashNEQ(""),
+			paymentorder.BlockNumberGTE(fromBlock),
+			paymentorder.BlockNumberLTE(toBlock),
+		).
+		WithToken().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, order := range linkedOrders {
+		if _, ok := deposits[depositKey(order.TxHash, order.ReceiveAddressText)]; ok {
+			continue
+		}
+		orderID := order.ID
+		add(&reconciledDeposit{
+			txHash:  order.TxHash,
+			address: order.ReceiveAddressText,
+			token:   order.Edges.Token.Symbol,
+			from:    order.FromAddress,
+			block:   order.BlockNumber,
+			amount:  order.AmountPaid,
+			orderID: &orderID,
+		})
+	}
+
+	return deposits, nil
+}
+
+// reconciledAddresses returns the receive addresses of a network updated since the given time, keyed by
+// their lowercase form
+func reconciledAddresses(ctx context.Context, network *ent.Network, since time.Time) (map[string]string, error) {
+	rows, err := db.Client.ReceiveAddress.
+		Query().
+		Where(
+			receiveaddress.Or(
+				receiveaddress.NetworkIdentifierEQ(network.Identifier),
+				receiveaddress.HasPaymentOrderWith(
+					paymentorder.HasTokenWith(tokenent.HasNetworkWith(networkent.IDEQ(network.ID))),
+				),
+			),
+			receiveaddress.UpdatedAtGTE(since),
+		).
+		Select(receiveaddress.FieldAddress).
+		Strings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make(map[string]string, len(rows))
+	for _, address := range rows {
+		addresses[strings.ToLower(address)] = address
+	}
+
+	return addresses, nil
+}
+
+// saveReconciliationReport stores a report and its discrepancies, replacing the earlier report of the
+// same network and day
+func saveReconciliationReport(ctx context.Context, report *ent.ReconciliationReport, mismatches []*reconciliationMismatch) (*ent.ReconciliationReport, error) {
+	tx, err := db.Client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("saveReconciliationReport.db: %w", err)
+	}
+
+	_, err = tx.ReconciliationReport.
+		Delete().
+		Where(
+			reconciliationreport.NetworkEQ(report.Network),
+			reconciliationreport.DayEQ(report.Day),
+		).
+		Exec(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("saveReconciliationReport.delete: %w", err)
+	}
+
+	create := tx.ReconciliationReport.
+		Create().
+		SetNetwork(report.Network).
+		SetDay(report.Day).
+		SetFromBlock(report.FromBlock).
+		SetToBlock(report.ToBlock).
+		SetStatus(report.Status).
+		SetAddressesChecked(report.AddressesChecked).
+		SetTransfersChecked(report.TransfersChecked).
+		SetDiscrepancyCount(len(mismatches))
+	if report.Error != "" {
+		create = create.SetError(report.Error)
+	}
+	saved, err := create.Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("saveReconciliationReport.report: %w", err)
+	}
+
+	builders := make([]*ent.ReconciliationDiscrepancyCreate, 0, len(mismatches))
+	for _, mismatch := range mismatches {
+		builders = append(builders, tx.ReconciliationDiscrepancy.
+			Create().
+			SetReport(saved).
+			SetType(mismatch.kind).
+			SetTxHash(mismatch.deposit.txHash).
+			SetAddress(mismatch.deposit.address).
+			SetToken(mismatch.deposit.token).
+			SetFromAddress(mismatch.deposit.from).
+			SetBlockNumber(mismatch.deposit.block).
+			SetOnchainAmount(mismatch.onchain).
+			SetRecordedAmount(mismatch.recorded).
+			SetNillablePaymentOrderID(mismatch.deposit.orderID))
+	}
+	discrepancies, err := tx.ReconciliationDiscrepancy.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("saveReconciliationReport.discrepancies: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("saveReconciliationReport.commit: %w", err)
+	}
+
+	saved = saved.Unwrap()
+	saved.Edges.Discrepancies = discrepancies
+	return saved, nil
+}
+
+// depositKey identifies the value a transaction moved to an address
+func depositKey(txHash, address string) string {
+	return strings.ToLower(txHash) + ":" + strings.ToLower(address)
+}
+
+// metadataInt reads a number stored in JSON metadata, which comes back as a float once persisted
+func metadataInt(value interface{}) int64 {
+	switch v := value.(type) {
+	case float64:
+		return int64(v)
+	case int64:
+		return v
+	case int:
+		return int64(v)
+	case uint64:
+		return int64(v)
+	}
+	return 0
+}
@@ -0,0 +1,204 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	"github.com/NEDA-LABS/stablenode/ent/reconciliationdiscrepancy"
+	"github.com/NEDA-LABS/stablenode/ent/reconciliationreport"
+	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
+	svc "github.com/NEDA-LABS/stablenode/services"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/NEDA-LABS/stablenode/utils/test"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeReconciliationSource serves canned transfers per receive address
+type fakeReconciliationSource struct {
+	token     *ent.Token
+	transfers map[string][]types.TokenTransferEvent
+	err       error
+}
+
+func (s *fakeReconciliationSource) GetBlockRange(ctx context.Context, network *ent.Network, start, end time.Time) (int64, int64, error) {
+	if s.err != nil {
+		return 0, 0, s.err
+	}
+	return 100, 200, nil
+}
+
+func (s *fakeReconciliationSource) GetAssetTransfers(ctx context.Context, network *ent.Network, tokens []*ent.Token, address string, fromBlock, toBlock int64) ([]*svc.AssetTransfer, error) {
+	var transfers []*svc.AssetTransfer
+	for _, event := range s.transfers[strings.ToLower(address)] {
+		transfers = append(transfers, &svc.AssetTransfer{TokenTransferEvent: event, Token: s.token})
+	}
+	return transfers, nil
+}
+
+func TestReconcileNetwork(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:reconciliation?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	ctx := context.Background()
+
+	token, err := test.CreateERC20Token(nil, map[string]interface{}{
+		"symbol":         "USDC",
+		"identifier":     "base-sepolia",
+		"chainID":        int64(84532),
+		"deployContract": false,
+	})
+	assert.NoError(t, err)
+	network := token.Edges.Network
+
+	addresses := []string{
+		"0x1111111111111111111111111111111111111111", // credited as sent
+		"0x2222222222222222222222222222222222222222", // never credited
+		"0x3333333333333333333333333333333333333333", // no order
+		"0x4444444444444444444444444444444444444444", // credited with the wrong amount
+		"0x5555555555555555555555555555555555555555", // credited without a transfer
+	}
+	orders := make(map[string]*ent.PaymentOrder)
+	for i, address := range addresses {
+		receiveAddress := client.ReceiveAddress.
+			Create().
+			SetAddress(address).
+			SetStatus(receiveaddress.StatusUsed).
+			SetNetworkIdentifier(network.Identifier).
+			SaveX(ctx)
+		if i == 2 {
+			continue
+		}
+		orders[address], err = test.CreateTestPaymentOrder(nil, token, map[string]interface{}{
+			"receive_address": receiveAddress,
+			"amount":          10.0,
+			"amount_in_usd":   10.0,
+			"rate":            1500.0,
+		})
+		assert.NoError(t, err)
+	}
+
+	deposit := func(address, txHash string, value float64) {
+		log := client.TransactionLog.
+			Create().
+			SetStatus(transactionlog.StatusCryptoDeposited).
+			SetTxHash(txHash).
+			SetNetwork(network.Identifier).
+			SetMetadata(map[string]interface{}{
+				"transactionData": map[string]interface{}{
+					"from":        "0x9999999999999999999999999999999999999999",
+					"to":          address,
+					"value":       decimal.NewFromFloat(value).String(),
+					"blockNumber": 150,
+				},
+			}).
+			SaveX(ctx)
+		client.PaymentOrder.UpdateOne(orders[address]).AddTransactions(log).ExecX(ctx)
+	}
+	deposit(addresses[0], "0xaaa1", 10)
+	deposit(addresses[3], "0xaaa4", 5)
+	deposit(addresses[4], "0xaaa5", 10)
+
+	transfer := func(txHash string, block int64, value float64) types.TokenTransferEvent {
+		return types.TokenTransferEvent{
+			BlockNumber: block,
+			TxHash:      txHash,
+			From:        "0x9999999999999999999999999999999999999999",
+			Value:       decimal.NewFromFloat(value),
+		}
+	}
+	source := &fakeReconciliationSource{
+		token: token,
+		transfers: map[string][]types.TokenTransferEvent{
+			addresses[0]: {transfer("0xAAA1", 150, 10)},
+			addresses[1]: {transfer("0xaaa2", 120, 10)},
+			addresses[2]: {transfer("0xaaa3", 130, 7)},
+			addresses[3]: {transfer("0xaaa4", 150, 6)},
+		},
+	}
+	defer func(original func() reconciliationSource) { newReconciliationSource = original }(newReconciliationSource)
+	newReconciliationSource = func() reconciliationSource { return source }
+
+	day := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, -1)
+
+	t.Run("should report what the chain and the recorded deposits disagree on", func(t *testing.T) {
+		report, err := ReconcileNetwork(ctx, network, day)
+		assert.NoError(t, err)
+		assert.Equal(t, reconciliationreport.StatusCompleted, report.Status)
+		assert.Equal(t, int64(100), report.FromBlock)
+		assert.Equal(t, int64(200), report.ToBlock)
+		assert.Equal(t, 5, report.AddressesChecked)
+		assert.Equal(t, 4, report.TransfersChecked)
+		assert.Equal(t, 4, report.DiscrepancyCount)
+
+		discrepancies := client.ReconciliationDiscrepancy.
+			Query().
+			WithPaymentOrder().
+			Order(ent.Asc(reconciliationdiscrepancy.FieldBlockNumber), ent.Asc(reconciliationdiscrepancy.FieldTxHash)).
+			AllX(ctx)
+		assert.Len(t, discrepancies, 4)
+
+		byType := make(map[reconciliationdiscrepancy.Type]*ent.ReconciliationDiscrepancy)
+		for _, discrepancy := range discrepancies {
+			byType[discrepancy.Type] = discrepancy
+		}
+
+		missing := byType[reconciliationdiscrepancy.TypeMissingCredit]
+		assert.Equal(t, "0xaaa2", missing.TxHash)
+		assert.Equal(t, orders[addresses[1]].ID, missing.Edges.PaymentOrder.ID)
+		assert.True(t, missing.OnchainAmount.Equal(decimal.NewFromInt(10)))
+		assert.True(t, missing.RecordedAmount.IsZero())
+
+		unknown := byType[reconciliationdiscrepancy.TypeUnknownDeposit]
+		assert.Equal(t, addresses[2], unknown.Address)
+		assert.Nil(t, unknown.Edges.PaymentOrder)
+		assert.True(t, unknown.OnchainAmount.Equal(decimal.NewFromInt(7)))
+
+		mismatch := byType[reconciliationdiscrepancy.TypeAmountMismatch]
+		assert.Equal(t, orders[addresses[3]].ID, mismatch.Edges.PaymentOrder.ID)
+		assert.True(t, mismatch.OnchainAmount.Equal(decimal.NewFromInt(6)))
+		assert.True(t, mismatch.RecordedAmount.Equal(decimal.NewFromInt(5)))
+
+		unmatched := byType[reconciliationdiscrepancy.TypeUnmatchedCredit]
+		assert.Equal(t, "0xaaa5", unmatched.TxHash)
+		assert.Equal(t, orders[addresses[4]].ID, unmatched.Edges.PaymentOrder.ID)
+		assert.True(t, unmatched.OnchainAmount.IsZero())
+	})
+
+	t.Run("should leave out deposits reverted by a reorg", func(t *testing.T) {
+		log := client.TransactionLog.
+			Create().
+			SetStatus(transactionlog.StatusCryptoDepositReverted).
+			SetTxHash("0xaaa5").
+			SetNetwork(network.Identifier).
+			SetMetadata(map[string]interface{}{}).
+			SaveX(ctx)
+		client.PaymentOrder.UpdateOne(orders[addresses[4]]).AddTransactions(log).ExecX(ctx)
+
+		report, err := ReconcileNetwork(ctx, network, day)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, report.DiscrepancyCount)
+		assert.Len(t, report.Edges.Discrepancies, 3)
+	})
+
+	t.Run("should replace the report of the day with a failed one", func(t *testing.T) {
+		source.err = fmt.Errorf("rpc unavailable")
+		defer func() { source.err = nil }()
+
+		report, err := ReconcileNetwork(ctx, network, day)
+		assert.NoError(t, err)
+		assert.Equal(t, reconciliationreport.StatusFailed, report.Status)
+		assert.Contains(t, report.Error, "rpc unavailable")
+
+		assert.Equal(t, 1, client.ReconciliationReport.Query().CountX(ctx))
+		assert.Zero(t, client.ReconciliationDiscrepancy.Query().CountX(ctx))
+	})
+}
@@ -0,0 +1,600 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/ethereum/go-ethereum"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/shopspring/decimal"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/ledgerentry"
+	networkent "github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	"github.com/NEDA-LABS/stablenode/ent/sweep"
+	tokenent "github.com/NEDA-LABS/stablenode/ent/token"
+	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
+	"github.com/NEDA-LABS/stablenode/services"
+	"github.com/NEDA-LABS/stablenode/services/ledger"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+)
+
+// createRefundSweep sends amount of a token from a receive address back to the payer
+var createRefundSweep = func(ctx context.Context, token *ent.Token, fromAddress, toAddress string, amount decimal.Decimal) (*ent.Sweep, error) {
+	return services.NewSweepService().CreateSweep(ctx, token, fromAddress, toAddress, amount)
+}
+
+// resolveRefundTxHash returns the hash of the transaction that included a submitted sweep, or an
+// empty hash while it is pending
+var resolveRefundTxHash = func(ctx context.Context, sweepEntity *ent.Sweep) (string, error) {
+	status, err := services.NewServiceManager().
+		ForAddress(ctx, sweepEntity.FromAddress).
+		GetTransactionStatus(ctx, sweepEntity.TxHash, sweepEntity.ChainID)
+	if err != nil {
+		return "", err
+	}
+	txHash, _ := status["transactionHash"].(string)
+	return txHash, nil
+}
+
+// RefundPartialPayments sends what was paid toward expired orders back to the payer and moves the
+// orders to refunded once the refund is confirmed. A refund that fails on-chain is sent again.
+// Only EVM orders are refunded; orders that reached the gateway are refunded by it
+func RefundPartialPayments(ctx context.Context) error {
+	if err := reconcilePartialPaymentRefundClaims(ctx); err != nil {
+		return fmt.Errorf("RefundPartialPayments: %w", err)
+	}
+
+	if err := submitPartialPaymentRefunds(ctx); err != nil {
+		return fmt.Errorf("RefundPartialPayments: %w", err)
+	}
+
+	if err := confirmPartialPaymentRefunds(ctx); err != nil {
+		return fmt.Errorf("RefundPartialPayments: %w", err)
+	}
+
+	return nil
+}
+
+// refundClaimGrace is how long a refund claim may go without a linked sweep before it is reconciled
+var refundClaimGrace = 10 * time.Minute
+
+// reconcilePartialPaymentRefundClaims links refund claims left without a sweep, when the sweep was
+// created but linking it to the order failed. A claim whose sweep can't be found may still have been
+// sent, so it is kept to block another refund and raised to ops once
+func reconcilePartialPaymentRefundClaims(ctx context.Context) error {
+	orders, err := db.Client.PaymentOrder.
+		Query().
+		Where(
+			paymentorder.StatusEQ(paymentorder.StatusExpired),
+			paymentorder.Not(paymentorder.HasRefundSweep()),
+			paymentorder.HasTransactionsWith(
+				transactionlog.StatusEQ(transactionlog.StatusOrderRefunded),
+				transactionlog.CreatedAtLT(time.Now().Add(-refundClaimGrace)),
+			),
+		).
+		WithTransactions(func(tq *ent.TransactionLogQuery) {
+			tq.Where(transactionlog.StatusEQ(transactionlog.StatusOrderRefunded))
+		}).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch orphaned refund claims: %w", err)
+	}
+
+	for _, order := range orders {
+		for _, claim := range order.Edges.Transactions {
+			if err := reconcilePartialPaymentRefundClaim(ctx, order, claim); err != nil {
+				logger.WithFields(logger.Fields{
+					"Error":   fmt.Sprintf("%v", err),
+					"OrderID": order.ID.String(),
+					"ClaimID": claim.ID.String(),
+				}).Errorf("Failed to reconcile partial payment refund claim")
+			}
+		}
+	}
+
+	return nil
+}
+
+// reconcilePartialPaymentRefundClaim links the sweep sent for a claim to its order, matching it by
+// addresses and amount among the sweeps created since the claim that no order refunds yet
+func reconcilePartialPaymentRefundClaim(ctx context.Context, order *ent.PaymentOrder, claim *ent.TransactionLog) error {
+	from, _ := claim.Metadata["From"].(string)
+	to, _ := claim.Metadata["To"].(string)
+	amount, err := decimal.NewFromString(fmt.Sprintf("%v", claim.Metadata["Amount"]))
+	if err != nil {
+		return fmt.Errorf("invalid claim amount: %w", err)
+	}
+
+	sweepEntity, err := db.Client.Sweep.
+		Query().
+		Where(
+			sweep.FromAddressEQ(from),
+			sweep.ToAddressEQ(to),
+			sweep.AmountEQ(amount),
+			sweep.CreatedAtGTE(claim.CreatedAt),
+			sweep.StatusNEQ(sweep.StatusFailed),
+			sweep.Not(sweep.HasUnmatchedDeposit()),
+			func(s *sql.Selector) {
+				orders := sql.Table(paymentorder.Table)
+				s.Where(sql.Not(sql.Exists(
+					sql.Select(orders.C(paymentorder.FieldID)).
+						From(orders).
+						Where(sql.ColumnsEQ(orders.C(paymentorder.RefundSweepColumn), s.C(sweep.FieldID))),
+				)))
+			},
+		).
+		Order(ent.Asc(sweep.FieldCreatedAt)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		if orphaned, _ := claim.Metadata["Orphaned"].(bool); orphaned {
+			return nil
+		}
+
+		logger.WithFields(logger.Fields{
+			"OrderID": order.ID.String(),
+			"ClaimID": claim.ID.String(),
+			"Amount":  amount,
+			"To":      to,
+		}).Errorf("Partial payment refund claim has no sweep")
+		err := services.NewSlackService(config.ServerConfig().SlackWebhookURL).SendAlert("Partial payment refund needs review", map[string]string{
+			"Order ID": order.ID.String(),
+			"Amount":   amount.String(),
+			"From":     from,
+			"To":       to,
+			"Action":   "Check whether the refund was sent; delete the order_refunded log to send it again",
+		})
+		if err != nil {
+			return fmt.Errorf("failed to send alert: %w", err)
+		}
+
+		metadata := claim.Metadata
+		metadata["Orphaned"] = true
+		return claim.Update().SetMetadata(metadata).Exec(ctx)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to find sweep: %w", err)
+	}
+
+	tx, err := db.Client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	metadata := claim.Metadata
+	metadata["SweepID"] = sweepEntity.ID.String()
+	if err := tx.TransactionLog.UpdateOne(claim).SetMetadata(metadata).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("failed to link sweep %s: %w", sweepEntity.ID, err)
+	}
+
+	if err := tx.PaymentOrder.UpdateOne(order).SetRefundSweep(sweepEntity).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("failed to link sweep %s: %w", sweepEntity.ID, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to link sweep %s: %w", sweepEntity.ID, err)
+	}
+
+	logger.WithFields(logger.Fields{
+		"OrderID": order.ID.String(),
+		"SweepID": sweepEntity.ID.String(),
+	}).Warnf("Linked orphaned partial payment refund sweep")
+
+	return nil
+}
+
+// submitPartialPaymentRefunds starts the refund of expired orders holding a partial payment that
+// never reached the gateway
+func submitPartialPaymentRefunds(ctx context.Context) error {
+	orders, err := db.Client.PaymentOrder.
+		Query().
+		Where(
+			paymentorder.StatusEQ(paymentorder.StatusExpired),
+			paymentorder.AmountPaidGT(decimal.Zero),
+			paymentorder.Or(paymentorder.GatewayIDIsNil(), paymentorder.GatewayIDEQ("")),
+			paymentorder.Not(paymentorder.HasRefundSweep()),
+			paymentorder.Not(paymentorder.HasTransactionsWith(
+				transactionlog.StatusEQ(transactionlog.StatusOrderRefunded),
+			)),
+			paymentorder.HasTokenWith(tokenent.HasNetworkWith(
+				networkent.NetworkTypeEQ(networkent.NetworkTypeEvm),
+				networkent.Not(networkent.IdentifierHasPrefix("tron")),
+			)),
+		).
+		WithToken(func(tq *ent.TokenQuery) {
+			tq.WithNetwork()
+		}).
+		WithReceiveAddress().
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch orders to refund: %w", err)
+	}
+
+	for _, order := range orders {
+		// A cancelled run may have lost its lock to another instance refunding the same orders
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := submitPartialPaymentRefund(ctx, order); err != nil {
+			logger.WithFields(logger.Fields{
+				"Error":      fmt.Sprintf("%v", err),
+				"OrderID":    order.ID.String(),
+				"AmountPaid": order.AmountPaid,
+			}).Errorf("Failed to refund partial payment")
+		}
+	}
+
+	return nil
+}
+
+// submitPartialPaymentRefund sweeps what is left of an order's payment from its receive address to
+// the return address, falling back to the address the deposit came from
+func submitPartialPaymentRefund(ctx context.Context, order *ent.PaymentOrder) error {
+	if order.Edges.ReceiveAddress == nil {
+		return fmt.Errorf("order has no receive address")
+	}
+
+	amount := order.AmountPaid.Sub(order.AmountReturned)
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	refundAddress := order.ReturnAddress
+	if refundAddress == "" {
+		refundAddress = order.FromAddress
+	}
+	if refundAddress == "" {
+		return fmt.Errorf("order has no return or from address")
+	}
+
+	// Claim the refund before sweeping, so a failure to link the sweep can never send it twice
+	transactionLog, err := claimPartialPaymentRefund(ctx, order, refundAddress, amount)
+	if err != nil {
+		return err
+	}
+
+	refundCtx := services.WithGasSpend(ctx, transactionlog.PurposeRefund, &order.ID)
+	sweepEntity, err := createRefundSweep(refundCtx, order.Edges.Token, order.Edges.ReceiveAddress.Address, refundAddress, amount)
+	if err != nil {
+		// Nothing was swept, so release the claim for the next run
+		if delErr := db.Client.TransactionLog.DeleteOne(transactionLog).Exec(ctx); delErr != nil {
+			logger.WithFields(logger.Fields{
+				"Error":   fmt.Sprintf("%v", delErr),
+				"OrderID": order.ID.String(),
+			}).Errorf("Failed to release partial payment refund claim")
+		}
+		return fmt.Errorf("failed to create sweep: %w", err)
+	}
+
+	tx, err := db.Client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to link sweep: %w", err)
+	}
+
+	metadata := transactionLog.Metadata
+	metadata["SweepID"] = sweepEntity.ID.String()
+	if err := tx.TransactionLog.UpdateOne(transactionLog).SetMetadata(metadata).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("failed to link sweep %s: %w", sweepEntity.ID, err)
+	}
+
+	if err := tx.PaymentOrder.UpdateOne(order).SetRefundSweep(sweepEntity).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("failed to link sweep %s: %w", sweepEntity.ID, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to link sweep %s: %w", sweepEntity.ID, err)
+	}
+
+	logger.WithFields(logger.Fields{
+		"OrderID":     order.ID.String(),
+		"Amount":      amount,
+		"To":          refundAddress,
+		"SweepID":     sweepEntity.ID.String(),
+		"SweepStatus": sweepEntity.Status,
+	}).Infof("Partial payment refund submitted")
+
+	return nil
+}
+
+// claimPartialPaymentRefund links the refund log to an order before its payment is swept back. The
+// order row is locked and re-checked in the same transaction, so a refund claimed by a concurrent
+// run is skipped
+func claimPartialPaymentRefund(ctx context.Context, order *ent.PaymentOrder, refundAddress string, amount decimal.Decimal) (*ent.TransactionLog, error) {
+	tx, err := db.Client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim refund: %w", err)
+	}
+
+	// Updating the order takes its row lock, so a concurrent claim waits here and then sees this one
+	err = tx.PaymentOrder.
+		UpdateOneID(order.ID).
+		Where(paymentorder.StatusEQ(paymentorder.StatusExpired)).
+		SetUpdatedAt(time.Now()).
+		Exec(ctx)
+	if ent.IsNotFound(err) {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("order is no longer expired")
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("failed to claim refund: %w", err)
+	}
+
+	claimed, err := tx.PaymentOrder.
+		Query().
+		Where(
+			paymentorder.IDEQ(order.ID),
+			paymentorder.Or(
+				paymentorder.HasRefundSweep(),
+				paymentorder.HasTransactionsWith(
+					transactionlog.StatusEQ(transactionlog.StatusOrderRefunded),
+				),
+			),
+		).
+		Exist(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("failed to claim refund: %w", err)
+	}
+	if claimed {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("partial payment refund already claimed")
+	}
+
+	transactionLog, err := tx.TransactionLog.
+		Create().
+		SetStatus(transactionlog.StatusOrderRefunded).
+		SetNetwork(order.Edges.Token.Edges.Network.Identifier).
+		SetMetadata(map[string]interface{}{
+			"Amount": amount.String(),
+			"From":   order.Edges.ReceiveAddress.Address,
+			"To":     refundAddress,
+		}).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("failed to claim refund: %w", err)
+	}
+
+	if err := tx.PaymentOrder.UpdateOne(order).AddTransactions(transactionLog).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("failed to claim refund: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to claim refund: %w", err)
+	}
+
+	return transactionLog, nil
+}
+
+// confirmPartialPaymentRefunds moves orders whose refund is buried under the network's required
+// confirmations to refunded
+func confirmPartialPaymentRefunds(ctx context.Context) error {
+	orders, err := db.Client.PaymentOrder.
+		Query().
+		Where(
+			paymentorder.StatusEQ(paymentorder.StatusExpired),
+			paymentorder.HasRefundSweepWith(sweep.StatusEQ(sweep.StatusSubmitted)),
+		).
+		WithRefundSweep().
+		WithToken(func(tq *ent.TokenQuery) {
+			tq.WithNetwork()
+		}).
+		WithReceiveAddress().
+		WithSenderProfile().
+		WithRecipient().
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch refunding orders: %w", err)
+	}
+
+	clients := make(map[int]confirmationsClient)
+	heads := make(map[int]int64)
+	for _, order := range orders {
+		network := order.Edges.Token.Edges.Network
+		client, ok := clients[network.ID]
+		if !ok {
+			client, err = dialConfirmationsClient(network)
+			if err == nil {
+				var header *ethtypes.Header
+				header, err = client.HeaderByNumber(ctx, nil)
+				if err == nil {
+					heads[network.ID] = header.Number.Int64()
+				}
+			}
+			if err != nil {
+				logger.WithFields(logger.Fields{
+					"Error":   fmt.Sprintf("%v", err),
+					"Network": network.Identifier,
+				}).Errorf("Failed to read chain head for refunds")
+				continue
+			}
+			clients[network.ID] = client
+		}
+
+		if err := confirmPartialPaymentRefund(ctx, client, heads[network.ID], order); err != nil {
+			logger.WithFields(logger.Fields{
+				"Error":   fmt.Sprintf("%v", err),
+				"OrderID": order.ID.String(),
+				"SweepID": order.Edges.RefundSweep.ID.String(),
+			}).Errorf("Failed to confirm partial payment refund")
+		}
+	}
+
+	return nil
+}
+
+// confirmPartialPaymentRefund checks the refund of an order against the chain head
+func confirmPartialPaymentRefund(ctx context.Context, client confirmationsClient, head int64, order *ent.PaymentOrder) error {
+	sweepEntity := order.Edges.RefundSweep
+	network := order.Edges.Token.Edges.Network
+
+	txHash, err := resolveRefundTxHash(ctx, sweepEntity)
+	if err != nil {
+		return fmt.Errorf("failed to resolve transaction: %w", err)
+	}
+	if txHash == "" {
+		return nil
+	}
+
+	receipt, err := client.TransactionReceipt(ctx, ethcommon.HexToHash(txHash))
+	if errors.Is(err, ethereum.NotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to fetch receipt of %s: %w", txHash, err)
+	}
+
+	if receipt.Status != ethtypes.ReceiptStatusSuccessful {
+		// Unlink the failed sweep so the refund is sent again
+		tx, err := db.Client.Tx(ctx)
+		if err != nil {
+			return err
+		}
+		if err := tx.Sweep.UpdateOne(sweepEntity).SetStatus(sweep.StatusFailed).Exec(ctx); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("failed to mark sweep failed: %w", err)
+		}
+		if err := tx.PaymentOrder.UpdateOne(order).ClearRefundSweep().Exec(ctx); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("failed to unlink sweep: %w", err)
+		}
+		claims, err := tx.PaymentOrder.QueryTransactions(order).Where(transactionlog.StatusEQ(transactionlog.StatusOrderRefunded)).IDs(ctx)
+		if err == nil {
+			_, err = tx.TransactionLog.Delete().Where(transactionlog.IDIn(claims...)).Exec(ctx)
+		}
+		if err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("failed to release refund claim: %w", err)
+		}
+		if err := ledger.Record(ctx, tx, ledger.SweepReversal(sweepEntity, order.Edges.Token.ID)); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("failed to book reverted sweep: %w", err)
+		}
+		if err := tx.Commit(); err != nil {
+			return err
+		}
+
+		logger.WithFields(logger.Fields{
+			"OrderID": order.ID.String(),
+			"SweepID": sweepEntity.ID.String(),
+			"TxHash":  txHash,
+		}).Warnf("Partial payment refund reverted, sending it again")
+		return nil
+	}
+
+	confirmations := head - receipt.BlockNumber.Int64() + 1
+	if confirmations < int64(network.RequiredConfirmations) {
+		return nil
+	}
+
+	tx, err := db.Client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Sweep.UpdateOne(sweepEntity).SetStatus(sweep.StatusConfirmed).Exec(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("failed to mark sweep confirmed: %w", err)
+	}
+
+	metadata := map[string]interface{}{
+		"SweepID": sweepEntity.ID.String(),
+		"Amount":  sweepEntity.Amount.String(),
+		"From":    sweepEntity.FromAddress,
+		"To":      sweepEntity.ToAddress,
+	}
+
+	// Complete the log the refund was claimed with; refunds claimed before claims existed get a new one
+	claims, err := tx.PaymentOrder.QueryTransactions(order).Where(transactionlog.StatusEQ(transactionlog.StatusOrderRefunded)).IDs(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("failed to fetch refund claim: %w", err)
+	}
+
+	claimedLogs, err := tx.TransactionLog.
+		Update().
+		Where(transactionlog.IDIn(claims...)).
+		SetTxHash(txHash).
+		SetMetadata(metadata).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("failed to update transaction log: %w", err)
+	}
+
+	update := tx.PaymentOrder.
+		UpdateOne(order).
+		SetStatus(paymentorder.StatusRefunded).
+		SetAmountReturned(order.AmountReturned.Add(sweepEntity.Amount))
+	if claimedLogs == 0 {
+		transactionLog, err := tx.TransactionLog.
+			Create().
+			SetStatus(transactionlog.StatusOrderRefunded).
+			SetTxHash(txHash).
+			SetNetwork(network.Identifier).
+			SetMetadata(metadata).
+			Save(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("failed to create transaction log: %w", err)
+		}
+		update.AddTransactions(transactionLog)
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("failed to mark order refunded: %w", err)
+	}
+
+	if err := ledger.Record(ctx, tx, ledger.SweepRefund(ledgerentry.KindRefund, order, sweepEntity)); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("failed to book refund: %w", err)
+	}
+
+	// The pool address held the payment until now; only pool rows carry a network identifier
+	if address := order.Edges.ReceiveAddress; address != nil && address.NetworkIdentifier != "" && address.Status == receiveaddress.StatusExpired {
+		if err := recycleReceiveAddress(ctx, tx, address); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("failed to recycle receive address: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	order.Status = paymentorder.StatusRefunded
+	order.AmountReturned = order.AmountReturned.Add(sweepEntity.Amount)
+
+	logger.WithFields(logger.Fields{
+		"OrderID":       order.ID.String(),
+		"Amount":        sweepEntity.Amount,
+		"To":            sweepEntity.ToAddress,
+		"TxHash":        txHash,
+		"Confirmations": confirmations,
+	}).Infof("Partial payment refunded")
+
+	if err := utils.SendPaymentOrderWebhook(ctx, order); err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":   fmt.Sprintf("%v", err),
+			"OrderID": order.ID.String(),
+		}).Errorf("Failed to send refunded payment order webhook")
+	}
+
+	return nil
+}
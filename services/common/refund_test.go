@@ -0,0 +1,203 @@
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	"github.com/NEDA-LABS/stablenode/ent/sweep"
+	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/test"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/shopspring/decimal"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRefundPartialPayments(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:refunds?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	viper.Set("SLACK_WEBHOOK_URL", "")
+
+	ctx := context.Background()
+
+	token, err := test.CreateERC20Token(nil, map[string]interface{}{
+		"symbol":         "USDC",
+		"identifier":     "base-sepolia",
+		"chainID":        int64(84532),
+		"deployContract": false,
+	})
+	assert.NoError(t, err)
+	token.Edges.Network = token.Edges.Network.Update().SetRequiredConfirmations(3).SaveX(ctx)
+
+	createOrder := func(gatewayID string) *ent.PaymentOrder {
+		receiveAddress, err := client.ReceiveAddress.
+			Create().
+			SetAddress("0x1111111111111111111111111111111111111111").
+			SetStatus(receiveaddress.StatusExpired).
+			SetNetworkIdentifier(token.Edges.Network.Identifier).
+			SetChainID(token.Edges.Network.ChainID).
+			SetValidUntil(time.Now().Add(-time.Hour)).
+			Save(ctx)
+		assert.NoError(t, err)
+
+		order, err := test.CreateTestPaymentOrder(nil, token, map[string]interface{}{
+			"receive_address": receiveAddress,
+			"amount":          10.0,
+			"amount_in_usd":   10.0,
+			"amount_paid":     4.0,
+			"rate":            130.0,
+			"status":          "expired",
+			"return_address":  "0x2222222222222222222222222222222222222222",
+		})
+		assert.NoError(t, err)
+		return client.PaymentOrder.UpdateOne(order).SetGatewayID(gatewayID).SaveX(ctx)
+	}
+
+	refundTxHash := "0x3333333333333333333333333333333333333333333333333333333333333333"
+	chain := &stubConfirmationsClient{head: 11, receipts: map[ethcommon.Hash]int64{}}
+
+	defaultCreateRefundSweep, defaultResolveRefundTxHash, defaultDial := createRefundSweep, resolveRefundTxHash, dialConfirmationsClient
+	defer func() {
+		createRefundSweep, resolveRefundTxHash, dialConfirmationsClient = defaultCreateRefundSweep, defaultResolveRefundTxHash, defaultDial
+	}()
+	createRefundSweep = func(ctx context.Context, token *ent.Token, fromAddress, toAddress string, amount decimal.Decimal) (*ent.Sweep, error) {
+		return client.Sweep.
+			Create().
+			SetNetwork(token.Edges.Network.Identifier).
+			SetChainID(token.Edges.Network.ChainID).
+			SetTokenAddress(token.ContractAddress).
+			SetFromAddress(fromAddress).
+			SetToAddress(toAddress).
+			SetAmount(amount).
+			SetStatus(sweep.StatusSubmitted).
+			SetTxHash("0xuseroperation").
+			Save(ctx)
+	}
+	resolveRefundTxHash = func(ctx context.Context, sweepEntity *ent.Sweep) (string, error) {
+		if _, ok := chain.receipts[ethcommon.HexToHash(refundTxHash)]; !ok {
+			return "", nil
+		}
+		return refundTxHash, nil
+	}
+	dialConfirmationsClient = func(network *ent.Network) (confirmationsClient, error) {
+		return chain, nil
+	}
+
+	partial := createOrder("")
+	onChain := createOrder("0xgateway")
+
+	t.Run("should send partial payments back to the return address", func(t *testing.T) {
+		assert.NoError(t, RefundPartialPayments(ctx))
+
+		refund, err := client.PaymentOrder.QueryRefundSweep(partial).Only(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, partial.ReturnAddress, refund.ToAddress)
+		assert.True(t, decimal.NewFromFloat(4).Equal(refund.Amount))
+
+		hasRefund, err := client.PaymentOrder.QueryRefundSweep(onChain).Exist(ctx)
+		assert.NoError(t, err)
+		assert.False(t, hasRefund)
+	})
+
+	t.Run("should wait for the refund to be confirmed", func(t *testing.T) {
+		chain.receipts[ethcommon.HexToHash(refundTxHash)] = 10
+		assert.NoError(t, RefundPartialPayments(ctx))
+		assert.Equal(t, paymentorder.StatusExpired, client.PaymentOrder.GetX(ctx, partial.ID).Status)
+
+		chain.head = 12
+		assert.NoError(t, RefundPartialPayments(ctx))
+
+		refunded := client.PaymentOrder.GetX(ctx, partial.ID)
+		assert.Equal(t, paymentorder.StatusRefunded, refunded.Status)
+		assert.True(t, decimal.NewFromFloat(4).Equal(refunded.AmountReturned))
+
+		refund := client.PaymentOrder.QueryRefundSweep(refunded).OnlyX(ctx)
+		assert.Equal(t, sweep.StatusConfirmed, refund.Status)
+
+		log, err := client.PaymentOrder.
+			QueryTransactions(refunded).
+			Where(transactionlog.StatusEQ(transactionlog.StatusOrderRefunded)).
+			Only(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, refundTxHash, log.TxHash)
+
+		address := client.PaymentOrder.QueryReceiveAddress(refunded).OnlyX(ctx)
+		assert.Equal(t, receiveaddress.StatusPoolReady, address.Status)
+		assert.Equal(t, receiveaddress.StatusExpired, client.PaymentOrder.QueryReceiveAddress(onChain).OnlyX(ctx).Status)
+	})
+
+	t.Run("should not send a refund again when linking its sweep fails", func(t *testing.T) {
+		orphaned := createOrder("")
+
+		sent := 0
+		createRefundSweep = func(ctx context.Context, token *ent.Token, fromAddress, toAddress string, amount decimal.Decimal) (*ent.Sweep, error) {
+			sent++
+			return &ent.Sweep{ID: uuid.New(), Status: sweep.StatusSubmitted}, nil
+		}
+
+		assert.NoError(t, RefundPartialPayments(ctx))
+		assert.NoError(t, RefundPartialPayments(ctx))
+		assert.Equal(t, 1, sent)
+
+		claim, err := client.PaymentOrder.
+			QueryTransactions(orphaned).
+			Where(transactionlog.StatusEQ(transactionlog.StatusOrderRefunded)).
+			Only(ctx)
+		assert.NoError(t, err)
+
+		// Past the grace period, a claim whose sweep can't be found is raised instead of sent again
+		refundClaimGrace = -time.Minute
+		defer func() { refundClaimGrace = 10 * time.Minute }()
+		assert.NoError(t, RefundPartialPayments(ctx))
+		assert.Equal(t, 1, sent)
+		assert.Equal(t, true, client.TransactionLog.GetX(ctx, claim.ID).Metadata["Orphaned"])
+	})
+
+	t.Run("should link the sweep of an orphaned claim to its order", func(t *testing.T) {
+		orphaned := createOrder("")
+		client.PaymentOrder.UpdateOne(orphaned).SetReturnAddress("0x4444444444444444444444444444444444444444").ExecX(ctx)
+		orphaned = client.PaymentOrder.Query().
+			Where(paymentorder.IDEQ(orphaned.ID)).
+			WithToken(func(tq *ent.TokenQuery) {
+				tq.WithNetwork()
+			}).
+			WithReceiveAddress().
+			OnlyX(ctx)
+
+		claim, err := claimPartialPaymentRefund(ctx, orphaned, orphaned.ReturnAddress, orphaned.AmountPaid)
+		assert.NoError(t, err)
+
+		_, err = claimPartialPaymentRefund(ctx, orphaned, orphaned.ReturnAddress, orphaned.AmountPaid)
+		assert.Error(t, err)
+
+		sent := client.Sweep.
+			Create().
+			SetNetwork(token.Edges.Network.Identifier).
+			SetChainID(token.Edges.Network.ChainID).
+			SetTokenAddress(token.ContractAddress).
+			SetFromAddress(orphaned.Edges.ReceiveAddress.Address).
+			SetToAddress(orphaned.ReturnAddress).
+			SetAmount(orphaned.AmountPaid).
+			SetStatus(sweep.StatusSubmitted).
+			SaveX(ctx)
+
+		refundClaimGrace = -time.Minute
+		defer func() { refundClaimGrace = 10 * time.Minute }()
+		assert.NoError(t, reconcilePartialPaymentRefundClaims(ctx))
+
+		linked, err := client.PaymentOrder.QueryRefundSweep(orphaned).Only(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, sent.ID, linked.ID)
+		assert.Equal(t, sent.ID.String(), client.TransactionLog.GetX(ctx, claim.ID).Metadata["SweepID"])
+	})
+}
@@ -0,0 +1,340 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/lockpaymentorder"
+	networkent "github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	tokenent "github.com/NEDA-LABS/stablenode/ent/token"
+	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
+	"github.com/NEDA-LABS/stablenode/services"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+)
+
+// ReorgMonitor tracks the hashes of recent blocks on each network and reconciles indexed orders when a
+// block they reference is orphaned. Hashes are kept in memory, so tracking restarts from the chain head
+// after a restart
+type ReorgMonitor struct {
+	depth  int64
+	mu     sync.Mutex
+	chains map[int]*trackedChain
+}
+
+// trackedChain holds the recent block hashes of a network
+type trackedChain struct {
+	tip    int64
+	hashes map[int64]ethcommon.Hash
+}
+
+// OrphanedRange is a range of blocks replaced by a reorg
+type OrphanedRange struct {
+	From int64
+	To   int64
+}
+
+// NewReorgMonitor creates a new reorg monitor tracking the configured number of blocks per network
+func NewReorgMonitor() *ReorgMonitor {
+	return &ReorgMonitor{
+		depth:  config.ReorgConfig().Depth,
+		chains: make(map[int]*trackedChain),
+	}
+}
+
+// Check compares the tracked blocks of every EVM network against the chain and reconciles the orders
+// referencing blocks that were orphaned since the last check
+func (m *ReorgMonitor) Check(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	networks, err := db.Client.Network.
+		Query().
+		Where(
+			networkent.GenesisMismatchEQ(false),
+			networkent.Not(networkent.IdentifierHasPrefix("tron")),
+			networkent.NetworkTypeNEQ(networkent.NetworkTypeSolana),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("ReorgMonitor.fetchNetworks: %w", err)
+	}
+
+	for _, network := range networks {
+		client, err := dialConfirmationsClient(network)
+		if err != nil {
+			logger.WithFields(logger.Fields{
+				"Error":   fmt.Sprintf("%v", err),
+				"Network": network.Identifier,
+			}).Errorf("ReorgMonitor.dial")
+			continue
+		}
+
+		orphaned, err := m.track(ctx, client, network)
+		if err != nil {
+			logger.WithFields(logger.Fields{
+				"Error":   fmt.Sprintf("%v", err),
+				"Network": network.Identifier,
+			}).Errorf("ReorgMonitor.track")
+			continue
+		}
+		if orphaned == nil {
+			continue
+		}
+
+		logger.WithFields(logger.Fields{
+			"Network": network.Identifier,
+			"From":    orphaned.From,
+			"To":      orphaned.To,
+		}).Warnf("⚠️ Chain reorg detected, reconciling indexed orders")
+
+		if err := ReconcileOrphanedBlocks(ctx, client, network, *orphaned); err != nil {
+			logger.WithFields(logger.Fields{
+				"Error":   fmt.Sprintf("%v", err),
+				"Network": network.Identifier,
+			}).Errorf("ReorgMonitor.reconcile")
+		}
+	}
+
+	return nil
+}
+
+// track records the blocks added since the last check and returns the range of tracked blocks that
+// are no longer on the canonical chain, if any
+func (m *ReorgMonitor) track(ctx context.Context, client confirmationsClient, network *ent.Network) (*OrphanedRange, error) {
+	head, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch chain head: %w", err)
+	}
+	headNumber := head.Number.Int64()
+
+	chain, ok := m.chains[network.ID]
+	if !ok {
+		m.chains[network.ID] = &trackedChain{
+			tip:    headNumber,
+			hashes: map[int64]ethcommon.Hash{headNumber: head.Hash()},
+		}
+		return nil, nil
+	}
+
+	// Walk back from the tracked tip until a block still matches
+	var orphaned *OrphanedRange
+	forkPoint := chain.tip
+	for forkPoint > 0 {
+		hash, tracked := chain.hashes[forkPoint]
+		if !tracked {
+			break
+		}
+
+		header, err := headerAt(ctx, client, forkPoint, head)
+		if err != nil {
+			return nil, err
+		}
+		if header != nil && header.Hash() == hash {
+			break
+		}
+
+		if orphaned == nil {
+			orphaned = &OrphanedRange{To: chain.tip}
+		}
+		orphaned.From = forkPoint
+		delete(chain.hashes, forkPoint)
+		forkPoint--
+	}
+
+	// Record the blocks after the fork point, up to the tracked depth
+	from := forkPoint + 1
+	if from < headNumber-m.depth+1 {
+		from = headNumber - m.depth + 1
+	}
+	for number := from; number <= headNumber; number++ {
+		header, err := headerAt(ctx, client, number, head)
+		if err != nil {
+			return nil, err
+		}
+		if header != nil {
+			chain.hashes[number] = header.Hash()
+		}
+	}
+	chain.tip = headNumber
+
+	for number := range chain.hashes {
+		if number <= headNumber-m.depth {
+			delete(chain.hashes, number)
+		}
+	}
+
+	return orphaned, nil
+}
+
+// headerAt fetches the header of a block, nil when the chain is now shorter than it
+func headerAt(ctx context.Context, client confirmationsClient, number int64, head *ethtypes.Header) (*ethtypes.Header, error) {
+	if number == head.Number.Int64() {
+		return head, nil
+	}
+	if number > head.Number.Int64() {
+		return nil, nil
+	}
+
+	header, err := client.HeaderByNumber(ctx, big.NewInt(number))
+	if errors.Is(err, ethereum.NotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch block %d: %w", number, err)
+	}
+	return header, nil
+}
+
+// ReconcileOrphanedBlocks re-verifies the orders indexed from an orphaned block range by re-querying
+// their transaction receipts. Transactions re-included in another block have their block number
+// updated; orders whose transactions are gone are flagged for re-validation. Orders still awaiting
+// confirmations are left to the confirmation task, which reverts their deposits
+func ReconcileOrphanedBlocks(ctx context.Context, client confirmationsClient, network *ent.Network, orphaned OrphanedRange) error {
+	paymentOrders, err := db.Client.PaymentOrder.
+		Query().
+		Where(
+			paymentorder.HasTokenWith(tokenent.HasNetworkWith(networkent.IDEQ(network.ID))),
+			paymentorder.BlockNumberGTE(orphaned.From),
+			paymentorder.BlockNumberLTE(orphaned.To),
+			paymentorder.TxHashNEQ(""),
+			paymentorder.StatusNEQ(paymentorder.StatusAwaitingConfirmations),
+		).
+		WithTransactions(func(tq *ent.TransactionLogQuery) {
+			tq.Where(transactionlog.StatusEQ(transactionlog.StatusCryptoDeposited))
+		}).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch payment orders: %w", err)
+	}
+
+	lockOrders, err := db.Client.LockPaymentOrder.
+		Query().
+		Where(
+			lockpaymentorder.HasTokenWith(tokenent.HasNetworkWith(networkent.IDEQ(network.ID))),
+			lockpaymentorder.BlockNumberGTE(orphaned.From),
+			lockpaymentorder.BlockNumberLTE(orphaned.To),
+			lockpaymentorder.TxHashNEQ(""),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch lock orders: %w", err)
+	}
+
+	var flagged []string
+	for _, order := range paymentOrders {
+		txHashes := []string{order.TxHash}
+		for _, log := range order.Edges.Transactions {
+			txHashes = append(txHashes, log.TxHash)
+		}
+
+		block, missing, err := verifyTransactions(ctx, client, txHashes)
+		if err != nil {
+			return err
+		}
+
+		update := order.Update()
+		switch {
+		case len(missing) > 0:
+			if order.ReviewReason == "" {
+				update.SetReviewReason(orphanedReason(missing, orphaned))
+				flagged = append(flagged, order.ID.String())
+			}
+		case block != order.BlockNumber:
+			update.SetBlockNumber(block)
+		default:
+			continue
+		}
+		if _, err := update.Save(ctx); err != nil {
+			return fmt.Errorf("failed to update payment order %s: %w", order.ID, err)
+		}
+	}
+
+	for _, order := range lockOrders {
+		block, missing, err := verifyTransactions(ctx, client, []string{order.TxHash})
+		if err != nil {
+			return err
+		}
+
+		update := order.Update()
+		switch {
+		case len(missing) > 0:
+			if order.ReviewReason == "" {
+				update.SetReviewReason(orphanedReason(missing, orphaned))
+				flagged = append(flagged, order.ID.String())
+			}
+		case block != order.BlockNumber:
+			update.SetBlockNumber(block)
+		default:
+			continue
+		}
+		if _, err := update.Save(ctx); err != nil {
+			return fmt.Errorf("failed to update lock order %s: %w", order.ID, err)
+		}
+	}
+
+	if len(flagged) > 0 {
+		logger.WithFields(logger.Fields{
+			"Network":  network.Identifier,
+			"From":     orphaned.From,
+			"To":       orphaned.To,
+			"OrderIDs": flagged,
+		}).Errorf("🚨 Orders reference transactions orphaned by a reorg, flagged for re-validation")
+
+		err := services.NewSlackService(config.ServerConfig().SlackWebhookURL).SendAlert("Orders orphaned by reorg", map[string]string{
+			"Network": network.Identifier,
+			"Blocks":  fmt.Sprintf("%d-%d", orphaned.From, orphaned.To),
+			"Orders":  fmt.Sprintf("%d", len(flagged)),
+			"IDs":     strings.Join(flagged, ", "),
+		})
+		if err != nil {
+			logger.Errorf("Failed to send reorg alert: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// verifyTransactions re-queries the receipts of transactions and returns the highest block they are
+// now included in and the ones no longer on the canonical chain
+func verifyTransactions(ctx context.Context, client confirmationsClient, txHashes []string) (int64, []string, error) {
+	var block int64
+	var missing []string
+	seen := make(map[string]bool)
+	for _, txHash := range txHashes {
+		if txHash == "" || seen[txHash] {
+			continue
+		}
+		seen[txHash] = true
+
+		receipt, err := client.TransactionReceipt(ctx, ethcommon.HexToHash(txHash))
+		if errors.Is(err, ethereum.NotFound) || (err == nil && receipt.Status != ethtypes.ReceiptStatusSuccessful) {
+			missing = append(missing, txHash)
+			continue
+		}
+		if err != nil {
+			return 0, nil, fmt.Errorf("failed to fetch receipt of %s: %w", txHash, err)
+		}
+
+		if receipt.BlockNumber.Int64() > block {
+			block = receipt.BlockNumber.Int64()
+		}
+	}
+	return block, missing, nil
+}
+
+// orphanedReason is the review reason of an order whose transactions were orphaned
+func orphanedReason(txHashes []string, orphaned OrphanedRange) string {
+	return fmt.Sprintf("transactions %s orphaned by a reorg of blocks %d-%d", strings.Join(txHashes, ", "), orphaned.From, orphaned.To)
+}
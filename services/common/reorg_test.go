@@ -0,0 +1,150 @@
+package common
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	"github.com/NEDA-LABS/stablenode/ent/lockpaymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/ethereum/go-ethereum"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+// stubReorgChain serves headers of a chain whose blocks can be replaced to simulate a reorg
+type stubReorgChain struct {
+	head     int64
+	forks    map[int64]string
+	receipts map[ethcommon.Hash]int64
+}
+
+func (c *stubReorgChain) HeaderByNumber(ctx context.Context, number *big.Int) (*ethtypes.Header, error) {
+	n := c.head
+	if number != nil {
+		n = number.Int64()
+	}
+	if n > c.head {
+		return nil, ethereum.NotFound
+	}
+	return &ethtypes.Header{Number: big.NewInt(n), Extra: []byte(c.forks[n])}, nil
+}
+
+func (c *stubReorgChain) TransactionReceipt(ctx context.Context, txHash ethcommon.Hash) (*ethtypes.Receipt, error) {
+	block, ok := c.receipts[txHash]
+	if !ok {
+		return nil, ethereum.NotFound
+	}
+	return &ethtypes.Receipt{Status: ethtypes.ReceiptStatusSuccessful, BlockNumber: big.NewInt(block)}, nil
+}
+
+func TestReorgMonitor(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:reorg?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	viper.Set("SLACK_WEBHOOK_URL", "")
+
+	ctx := context.Background()
+	orders := setupDepositSplit(t, ctx)
+	network := orders[0].Edges.Token.Edges.Network
+
+	chain := &stubReorgChain{head: 100, forks: map[int64]string{}, receipts: map[ethcommon.Hash]int64{}}
+	dialConfirmationsClient = func(network *ent.Network) (confirmationsClient, error) {
+		return chain, nil
+	}
+
+	monitor := &ReorgMonitor{depth: 10, chains: make(map[int]*trackedChain)}
+
+	t.Run("should track new blocks without a reorg", func(t *testing.T) {
+		orphaned, err := monitor.track(ctx, chain, network)
+		assert.NoError(t, err)
+		assert.Nil(t, orphaned)
+
+		chain.head = 105
+		orphaned, err = monitor.track(ctx, chain, network)
+		assert.NoError(t, err)
+		assert.Nil(t, orphaned)
+		assert.Len(t, monitor.chains[network.ID].hashes, 6)
+	})
+
+	t.Run("should detect orphaned blocks", func(t *testing.T) {
+		chain.forks[104] = "fork"
+		chain.forks[105] = "fork"
+		chain.head = 106
+
+		orphaned, err := monitor.track(ctx, chain, network)
+		assert.NoError(t, err)
+		assert.Equal(t, &OrphanedRange{From: 104, To: 105}, orphaned)
+
+		// The replacement blocks are tracked from now on
+		orphaned, err = monitor.track(ctx, chain, network)
+		assert.NoError(t, err)
+		assert.Nil(t, orphaned)
+	})
+
+	t.Run("should detect a chain that got shorter", func(t *testing.T) {
+		chain.head = 105
+		chain.forks[105] = "shorter"
+
+		orphaned, err := monitor.track(ctx, chain, network)
+		assert.NoError(t, err)
+		assert.Equal(t, &OrphanedRange{From: 105, To: 106}, orphaned)
+	})
+
+	t.Run("should reconcile orders in the orphaned range", func(t *testing.T) {
+		dropped, err := orders[0].Update().
+			SetStatus(paymentorder.StatusPending).
+			SetTxHash("0xa1").
+			SetBlockNumber(104).
+			Save(ctx)
+		assert.NoError(t, err)
+
+		moved, err := orders[1].Update().
+			SetStatus(paymentorder.StatusPending).
+			SetTxHash("0xa2").
+			SetBlockNumber(105).
+			Save(ctx)
+		assert.NoError(t, err)
+		chain.receipts[ethcommon.HexToHash("0xa2")] = 107
+
+		untouched, err := orders[2].Update().
+			SetStatus(paymentorder.StatusPending).
+			SetTxHash("0xa3").
+			SetBlockNumber(90).
+			Save(ctx)
+		assert.NoError(t, err)
+
+		lockOrder := createSLALockOrder(t, ctx, orders[0].Edges.Token, nil, lockpaymentorder.StatusPending, time.Now())
+		lockOrder, err = lockOrder.Update().
+			SetTxHash("0xb1").
+			SetBlockNumber(105).
+			Save(ctx)
+		assert.NoError(t, err)
+
+		err = ReconcileOrphanedBlocks(ctx, chain, network, OrphanedRange{From: 104, To: 105})
+		assert.NoError(t, err)
+
+		dropped = client.PaymentOrder.GetX(ctx, dropped.ID)
+		assert.Contains(t, dropped.ReviewReason, "0xa1")
+		assert.Equal(t, int64(104), dropped.BlockNumber)
+
+		moved = client.PaymentOrder.GetX(ctx, moved.ID)
+		assert.Empty(t, moved.ReviewReason)
+		assert.Equal(t, int64(107), moved.BlockNumber)
+
+		untouched = client.PaymentOrder.GetX(ctx, untouched.ID)
+		assert.Empty(t, untouched.ReviewReason)
+		assert.Equal(t, int64(90), untouched.BlockNumber)
+
+		lockOrder = client.LockPaymentOrder.GetX(ctx, lockOrder.ID)
+		assert.Contains(t, lockOrder.ReviewReason, "0xb1")
+	})
+}
@@ -0,0 +1,265 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/lockorderfulfillment"
+	"github.com/NEDA-LABS/stablenode/ent/lockpaymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/services"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+)
+
+// SLA stages of an order
+const (
+	SLAStagePayment     = "payment"
+	SLAStageFulfillment = "fulfillment"
+	SLAStageSettlement  = "settlement"
+)
+
+// maxAlertOrders is the number of order IDs listed in an SLA breach alert
+const maxAlertOrders = 10
+
+// newOrderSLA builds the SLA state of a stage that started at startedAt
+func newOrderSLA(stage string, startedAt time.Time, window time.Duration, breachedAt time.Time) *types.OrderSLA {
+	sla := &types.OrderSLA{
+		Stage:     stage,
+		StartedAt: startedAt,
+		Deadline:  startedAt.Add(window),
+	}
+	if !breachedAt.IsZero() {
+		sla.Breached = true
+		sla.BreachedAt = &breachedAt
+	} else {
+		sla.Breached = time.Now().After(sla.Deadline)
+	}
+	return sla
+}
+
+// LockPaymentOrderSLA returns the SLA state of a lock order awaiting fulfillment or settlement.
+// Fulfillments must be loaded to time the settlement stage. Nil means no SLA timer is running.
+func LockPaymentOrderSLA(order *ent.LockPaymentOrder) *types.OrderSLA {
+	orderConf := config.OrderConfig()
+
+	breachedAt := func(stage lockpaymentorder.SLABreachedStage) time.Time {
+		if order.SLABreachedStage == stage {
+			return order.SLABreachedAt
+		}
+		return time.Time{}
+	}
+
+	switch order.Status {
+	case lockpaymentorder.StatusPending, lockpaymentorder.StatusProcessing:
+		return newOrderSLA(SLAStageFulfillment, order.CreatedAt, orderConf.FulfillmentSLA, breachedAt(lockpaymentorder.SLABreachedStageFulfillment))
+
+	case lockpaymentorder.StatusFulfilled, lockpaymentorder.StatusValidated:
+		// The settlement timer starts when the provider submits the latest fulfillment
+		startedAt := order.UpdatedAt
+		var latest time.Time
+		for _, fulfillment := range order.Edges.Fulfillments {
+			if fulfillment.CreatedAt.After(latest) {
+				latest = fulfillment.CreatedAt
+			}
+		}
+		if !latest.IsZero() {
+			startedAt = latest
+		}
+		return newOrderSLA(SLAStageSettlement, startedAt, orderConf.SettlementSLA, breachedAt(lockpaymentorder.SLABreachedStageSettlement))
+	}
+
+	return nil
+}
+
+// PaymentOrderSLA returns the SLA state of a payment order. An order awaiting its deposit is in
+// the payment stage; once created on-chain it follows the stage of its lock orders, reporting a
+// breached lock order first. Nil means no SLA timer is running.
+func PaymentOrderSLA(ctx context.Context, paymentOrder *ent.PaymentOrder) (*types.OrderSLA, error) {
+	if paymentOrder.Status == paymentorder.StatusInitiated {
+		return newOrderSLA(SLAStagePayment, paymentOrder.CreatedAt, config.OrderConfig().PaymentSLA, paymentOrder.SLABreachedAt), nil
+	}
+
+	if paymentOrder.GatewayID == "" || paymentOrder.Status == paymentorder.StatusSettled || paymentOrder.Status == paymentorder.StatusRefunded {
+		return nil, nil
+	}
+
+	lockOrders, err := db.Client.LockPaymentOrder.
+		Query().
+		Where(lockpaymentorder.GatewayIDEQ(paymentOrder.GatewayID)).
+		WithFulfillments().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("PaymentOrderSLA.db: %w", err)
+	}
+
+	var current *types.OrderSLA
+	for _, lockOrder := range lockOrders {
+		sla := LockPaymentOrderSLA(lockOrder)
+		if sla == nil {
+			continue
+		}
+		if current == nil || (sla.Breached && !current.Breached) || (sla.Breached == current.Breached && sla.Deadline.Before(current.Deadline)) {
+			current = sla
+		}
+	}
+
+	return current, nil
+}
+
+// sendSLAAlert notifies ops of the orders that breached a stage SLA
+func sendSLAAlert(stage string, window time.Duration, orderIDs []string) {
+	listed := orderIDs
+	if len(listed) > maxAlertOrders {
+		listed = listed[:maxAlertOrders]
+	}
+
+	err := services.NewSlackService(config.ServerConfig().SlackWebhookURL).SendAlert(fmt.Sprintf("Order %s SLA breached", stage), map[string]string{
+		"Stage":  stage,
+		"Window": window.String(),
+		"Orders": fmt.Sprintf("%d", len(orderIDs)),
+		"IDs":    strings.Join(listed, ", "),
+	})
+	if err != nil {
+		logger.Errorf("Failed to send %s SLA alert: %v", stage, err)
+	}
+}
+
+// EscalateOrderSLAs marks orders whose current stage exceeded its SLA window, notifies ops once per
+// stage and moves breached orders ahead in the queues. Lock orders still waiting for a provider
+// are reassigned right away instead of waiting for their order request to expire.
+func EscalateOrderSLAs(ctx context.Context, assignLockPaymentOrder func(ctx context.Context, order types.LockPaymentOrderFields) error) error {
+	orderConf := config.OrderConfig()
+	now := time.Now()
+
+	// Payment window
+	paymentOrders, err := db.Client.PaymentOrder.
+		Query().
+		Where(
+			paymentorder.StatusEQ(paymentorder.StatusInitiated),
+			paymentorder.CreatedAtLT(now.Add(-orderConf.PaymentSLA)),
+			paymentorder.SLABreachedAtIsNil(),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("EscalateOrderSLAs.paymentOrders: %w", err)
+	}
+
+	if len(paymentOrders) > 0 {
+		orderIDs := make([]string, 0, len(paymentOrders))
+		for _, order := range paymentOrders {
+			if _, err := order.Update().SetSLABreachedAt(now).Save(ctx); err != nil {
+				return fmt.Errorf("EscalateOrderSLAs.updatePaymentOrder: %w", err)
+			}
+			orderIDs = append(orderIDs, order.ID.String())
+		}
+		sendSLAAlert(SLAStagePayment, orderConf.PaymentSLA, orderIDs)
+	}
+
+	// Provider fulfillment window
+	fulfillmentOrders, err := db.Client.LockPaymentOrder.
+		Query().
+		Where(
+			lockpaymentorder.StatusIn(lockpaymentorder.StatusPending, lockpaymentorder.StatusProcessing),
+			lockpaymentorder.CreatedAtLT(now.Add(-orderConf.FulfillmentSLA)),
+			lockpaymentorder.Or(
+				lockpaymentorder.SLABreachedStageIsNil(),
+				lockpaymentorder.SLABreachedStageNEQ(lockpaymentorder.SLABreachedStageFulfillment),
+			),
+		).
+		WithToken().
+		WithProvisionBucket(func(pbq *ent.ProvisionBucketQuery) {
+			pbq.WithCurrency()
+		}).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("EscalateOrderSLAs.fulfillmentOrders: %w", err)
+	}
+
+	if len(fulfillmentOrders) > 0 {
+		orderIDs := make([]string, 0, len(fulfillmentOrders))
+		for _, order := range fulfillmentOrders {
+			_, err := order.Update().
+				SetSLABreachedStage(lockpaymentorder.SLABreachedStageFulfillment).
+				SetSLABreachedAt(now).
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("EscalateOrderSLAs.updateFulfillmentOrder: %w", err)
+			}
+			orderIDs = append(orderIDs, order.ID.String())
+
+			if order.Status != lockpaymentorder.StatusPending || order.Edges.ProvisionBucket == nil {
+				continue
+			}
+
+			err = assignLockPaymentOrder(ctx, types.LockPaymentOrderFields{
+				ID:                order.ID,
+				Token:             order.Edges.Token,
+				GatewayID:         order.GatewayID,
+				Amount:            order.Amount,
+				Rate:              order.Rate,
+				BlockNumber:       order.BlockNumber,
+				Institution:       order.Institution,
+				AccountIdentifier: order.AccountIdentifier,
+				AccountName:       order.AccountName,
+				Memo:              order.Memo,
+				ProvisionBucket:   order.Edges.ProvisionBucket,
+				UpdatedAt:         order.UpdatedAt,
+			})
+			if err != nil {
+				logger.WithFields(logger.Fields{
+					"Error":     fmt.Sprintf("%v", err),
+					"OrderID":   order.ID.String(),
+					"GatewayID": order.GatewayID,
+				}).Errorf("EscalateOrderSLAs: Failed to reassign order")
+			}
+		}
+		sendSLAAlert(SLAStageFulfillment, orderConf.FulfillmentSLA, orderIDs)
+	}
+
+	// Settlement window, timed from the latest fulfillment
+	settlementOrders, err := db.Client.LockPaymentOrder.
+		Query().
+		Where(
+			lockpaymentorder.StatusIn(lockpaymentorder.StatusFulfilled, lockpaymentorder.StatusValidated),
+			lockpaymentorder.HasFulfillmentsWith(
+				lockorderfulfillment.CreatedAtLT(now.Add(-orderConf.SettlementSLA)),
+			),
+			lockpaymentorder.Or(
+				lockpaymentorder.SLABreachedStageIsNil(),
+				lockpaymentorder.SLABreachedStageNEQ(lockpaymentorder.SLABreachedStageSettlement),
+			),
+		).
+		WithFulfillments().
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("EscalateOrderSLAs.settlementOrders: %w", err)
+	}
+
+	orderIDs := make([]string, 0, len(settlementOrders))
+	for _, order := range settlementOrders {
+		if sla := LockPaymentOrderSLA(order); sla == nil || !sla.Breached {
+			continue
+		}
+
+		// Stuck settlements are retried in order of breach
+		_, err := order.Update().
+			SetSLABreachedStage(lockpaymentorder.SLABreachedStageSettlement).
+			SetSLABreachedAt(now).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("EscalateOrderSLAs.updateSettlementOrder: %w", err)
+		}
+		orderIDs = append(orderIDs, order.ID.String())
+	}
+	if len(orderIDs) > 0 {
+		sendSLAAlert(SLAStageSettlement, orderConf.SettlementSLA, orderIDs)
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	"github.com/NEDA-LABS/stablenode/ent/lockpaymentorder"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/NEDA-LABS/stablenode/utils/test"
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+// createSLALockOrder creates a lock order in the given status, created at createdAt
+func createSLALockOrder(t *testing.T, ctx context.Context, token *ent.Token, bucket *ent.ProvisionBucket, status lockpaymentorder.Status, createdAt time.Time) *ent.LockPaymentOrder {
+	create := db.Client.LockPaymentOrder.
+		Create().
+		SetGatewayID("0x" + uuid.NewString()).
+		SetAmount(decimal.NewFromInt(10)).
+		SetProtocolFee(decimal.Zero).
+		SetRate(decimal.NewFromInt(1500)).
+		SetOrderPercent(decimal.NewFromInt(100)).
+		SetAmountInUsd(decimal.NewFromInt(10)).
+		SetBlockNumber(100).
+		SetInstitution("ABNGNGLA").
+		SetAccountIdentifier("1234567890").
+		SetAccountName("Test Account").
+		SetStatus(status).
+		SetToken(token).
+		SetCreatedAt(createdAt)
+	if bucket != nil {
+		create = create.SetProvisionBucket(bucket)
+	}
+
+	order, err := create.Save(ctx)
+	assert.NoError(t, err)
+	return order
+}
+
+func TestOrderSLA(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ordersla?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	ctx := context.Background()
+	orders := setupDepositSplit(t, ctx)
+	token := orders[0].Edges.Token
+
+	// An order past its payment window next to the orders that were just created
+	stale, err := client.PaymentOrder.
+		Create().
+		SetSenderProfile(orders[0].Edges.SenderProfile).
+		SetAmount(decimal.NewFromInt(10)).
+		SetAmountInUsd(decimal.NewFromInt(10)).
+		SetAmountPaid(decimal.Zero).
+		SetAmountReturned(decimal.Zero).
+		SetPercentSettled(decimal.Zero).
+		SetNetworkFee(decimal.Zero).
+		SetSenderFee(decimal.Zero).
+		SetProtocolFee(decimal.Zero).
+		SetRate(decimal.NewFromInt(1500)).
+		SetToken(token).
+		SetReceiveAddressText(splitTestAddress).
+		SetFeePercent(decimal.Zero).
+		SetFeeAddress("0x1234567890123456789012345678901234567890").
+		SetCreatedAt(time.Now().Add(-time.Hour)).
+		Save(ctx)
+	assert.NoError(t, err)
+
+	currency, err := test.CreateTestFiatCurrency(map[string]interface{}{
+		"market_rate": 1500.0,
+	})
+	assert.NoError(t, err)
+
+	bucket, err := client.ProvisionBucket.
+		Create().
+		SetMinAmount(decimal.NewFromInt(1)).
+		SetMaxAmount(decimal.NewFromInt(100000)).
+		SetCurrency(currency).
+		Save(ctx)
+	assert.NoError(t, err)
+
+	unassigned := createSLALockOrder(t, ctx, token, bucket, lockpaymentorder.StatusPending, time.Now().Add(-time.Hour))
+	processing := createSLALockOrder(t, ctx, token, bucket, lockpaymentorder.StatusProcessing, time.Now())
+	validated := createSLALockOrder(t, ctx, token, bucket, lockpaymentorder.StatusValidated, time.Now().Add(-2*time.Hour))
+	_, err = client.LockOrderFulfillment.
+		Create().
+		SetTxID("0xfulfillment").
+		SetPsp("test").
+		SetOrder(validated).
+		SetCreatedAt(time.Now().Add(-time.Hour)).
+		Save(ctx)
+	assert.NoError(t, err)
+
+	t.Run("should report the stage timer of an order", func(t *testing.T) {
+		sla, err := PaymentOrderSLA(ctx, orders[1])
+		assert.NoError(t, err)
+		if assert.NotNil(t, sla) {
+			assert.Equal(t, SLAStagePayment, sla.Stage)
+			assert.False(t, sla.Breached)
+		}
+
+		sla = LockPaymentOrderSLA(client.LockPaymentOrder.Query().Where(lockpaymentorder.IDEQ(validated.ID)).WithFulfillments().OnlyX(ctx))
+		if assert.NotNil(t, sla) {
+			assert.Equal(t, SLAStageSettlement, sla.Stage)
+			assert.True(t, sla.Breached)
+			assert.Nil(t, sla.BreachedAt)
+		}
+	})
+
+	t.Run("should escalate breached orders once", func(t *testing.T) {
+		var reassigned []uuid.UUID
+		assign := func(ctx context.Context, order types.LockPaymentOrderFields) error {
+			reassigned = append(reassigned, order.ID)
+			return nil
+		}
+
+		assert.NoError(t, EscalateOrderSLAs(ctx, assign))
+		assert.Equal(t, []uuid.UUID{unassigned.ID}, reassigned)
+
+		assert.False(t, client.PaymentOrder.GetX(ctx, stale.ID).SLABreachedAt.IsZero())
+		assert.True(t, client.PaymentOrder.GetX(ctx, orders[1].ID).SLABreachedAt.IsZero())
+
+		assert.Equal(t, lockpaymentorder.SLABreachedStageFulfillment, client.LockPaymentOrder.GetX(ctx, unassigned.ID).SLABreachedStage)
+		assert.Empty(t, client.LockPaymentOrder.GetX(ctx, processing.ID).SLABreachedStage)
+		assert.Equal(t, lockpaymentorder.SLABreachedStageSettlement, client.LockPaymentOrder.GetX(ctx, validated.ID).SLABreachedStage)
+
+		// Already escalated orders are not escalated again
+		assert.NoError(t, EscalateOrderSLAs(ctx, assign))
+		assert.Len(t, reassigned, 1)
+	})
+
+	t.Run("should expose the recorded breach", func(t *testing.T) {
+		sla, err := PaymentOrderSLA(ctx, client.PaymentOrder.GetX(ctx, stale.ID))
+		assert.NoError(t, err)
+		if assert.NotNil(t, sla) {
+			assert.True(t, sla.Breached)
+			assert.NotNil(t, sla.BreachedAt)
+		}
+	})
+}
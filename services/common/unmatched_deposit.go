@@ -0,0 +1,493 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/adminauditlog"
+	"github.com/NEDA-LABS/stablenode/ent/depositsplit"
+	"github.com/NEDA-LABS/stablenode/ent/linkedaddress"
+	networkent "github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	tokenent "github.com/NEDA-LABS/stablenode/ent/token"
+	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
+	"github.com/NEDA-LABS/stablenode/ent/unmatcheddeposit"
+	"github.com/NEDA-LABS/stablenode/services"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrDepositResolved is returned when acting on an unmatched deposit that was already linked,
+	// refunded or swept
+	ErrDepositResolved = errors.New("deposit is already resolved")
+
+	// ErrOrderNotLinkable is returned when linking an unmatched deposit to an order of another token
+	// or receive address, or to an order that was already paid
+	ErrOrderNotLinkable = errors.New("order cannot be credited with the deposit")
+
+	// ErrDepositNotSweepable is returned when refunding or sweeping an unmatched deposit that isn't
+	// held by an EVM receive address, or sweeping one with no treasury address configured
+	ErrDepositNotSweepable = errors.New("deposit cannot be swept")
+)
+
+// acceptsDeposits matches the receive addresses whose order can still be paid, including those
+// within the grace period after their validity lapsed
+func acceptsDeposits() predicate.ReceiveAddress {
+	return receiveaddress.And(
+		receiveaddress.StatusIn(receiveaddress.StatusUnused, receiveaddress.StatusPoolAssigned),
+		receiveaddress.Or(
+			// Pool addresses may have NULL valid_until
+			receiveaddress.ValidUntilIsNil(),
+			receiveaddress.ValidUntilGT(time.Now().Add(-config.OrderConfig().ReceiveAddressGracePeriod)),
+		),
+	)
+}
+
+// RecordUnmatchedDeposits holds the transfers to receive addresses that no order can be credited
+// with, such as a transfer to an expired address or a second transfer to a paid one. Transfers to
+// addresses with an order awaiting payment are left to the indexer, as are transfers to linked
+// addresses and to addresses that aren't ours
+func RecordUnmatchedDeposits(ctx context.Context, addressToEvent map[string]*types.TokenTransferEvent, token *ent.Token) error {
+	network := token.Edges.Network
+
+	for address, event := range addressToEvent {
+		reason, err := unmatchedDepositReason(ctx, network, address, event)
+		if err != nil {
+			return fmt.Errorf("RecordUnmatchedDeposits: %w", err)
+		}
+		if reason == "" {
+			continue
+		}
+
+		exists, err := db.Client.UnmatchedDeposit.
+			Query().
+			Where(
+				unmatcheddeposit.TxHashEQ(event.TxHash),
+				unmatcheddeposit.ReceiveAddressEqualFold(address),
+			).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("RecordUnmatchedDeposits.db: %w", err)
+		}
+		if exists {
+			continue
+		}
+
+		deposit, err := db.Client.UnmatchedDeposit.
+			Create().
+			SetTxHash(event.TxHash).
+			SetReceiveAddress(address).
+			SetFromAddress(event.From).
+			SetAmount(event.Value).
+			SetBlockNumber(event.BlockNumber).
+			SetReason(reason).
+			SetTokenID(token.ID).
+			Save(ctx)
+		if err != nil {
+			// Another instance indexed the same transfer
+			if ent.IsConstraintError(err) {
+				continue
+			}
+			return fmt.Errorf("RecordUnmatchedDeposits.create: %w", err)
+		}
+
+		logger.WithFields(logger.Fields{
+			"DepositID":      deposit.ID.String(),
+			"TxHash":         event.TxHash,
+			"ReceiveAddress": address,
+			"From":           event.From,
+			"Amount":         event.Value.String(),
+			"Token":          token.Symbol,
+			"Network":        network.Identifier,
+			"Reason":         string(reason),
+		}).Warnf("Deposit no order can be credited with held for review")
+
+		err = services.NewSlackService(config.ServerConfig().SlackWebhookURL).SendAlert("Unmatched deposit", map[string]string{
+			"Deposit ID":      deposit.ID.String(),
+			"Network":         network.Identifier,
+			"Receive Address": address,
+			"Amount":          fmt.Sprintf("%s %s", event.Value.String(), token.Symbol),
+			"Tx Hash":         event.TxHash,
+			"Reason":          string(reason),
+		})
+		if err != nil {
+			logger.Errorf("Failed to send unmatched deposit alert: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// unmatchedDepositReason returns why no order can be credited with a transfer to an address, or an
+// empty reason if an order can be or already was
+func unmatchedDepositReason(ctx context.Context, network *ent.Network, address string, event *types.TokenTransferEvent) (unmatcheddeposit.Reason, error) {
+	awaitingPayment, err := db.Client.PaymentOrder.
+		Query().
+		Where(
+			paymentorder.HasReceiveAddressWith(
+				receiveaddress.AddressEqualFold(address),
+				acceptsDeposits(),
+			),
+			paymentorder.StatusEQ(paymentorder.StatusInitiated),
+			paymentorder.Or(
+				paymentorder.TxHashIsNil(),
+				paymentorder.TxHashEQ(""),
+			),
+		).
+		Exist(ctx)
+	if err != nil || awaitingPayment {
+		return "", err
+	}
+
+	linked, err := db.Client.LinkedAddress.
+		Query().
+		Where(linkedaddress.AddressEqualFold(address)).
+		Exist(ctx)
+	if err != nil || linked {
+		return "", err
+	}
+
+	ours, err := db.Client.ReceiveAddress.
+		Query().
+		Where(receiveaddress.AddressEqualFold(address)).
+		Exist(ctx)
+	if err != nil || !ours {
+		return "", err
+	}
+
+	// Transfers that were credited, or are held as a deposit split, are accounted for
+	credited, err := db.Client.TransactionLog.
+		Query().
+		Where(transactionlog.TxHashEQ(event.TxHash)).
+		Exist(ctx)
+	if err != nil || credited {
+		return "", err
+	}
+	credited, err = db.Client.PaymentOrder.
+		Query().
+		Where(paymentorder.TxHashEQ(event.TxHash)).
+		Exist(ctx)
+	if err != nil || credited {
+		return "", err
+	}
+	credited, err = db.Client.DepositSplit.
+		Query().
+		Where(depositsplit.TxHashEQ(event.TxHash)).
+		Exist(ctx)
+	if err != nil || credited {
+		return "", err
+	}
+
+	order, err := db.Client.PaymentOrder.
+		Query().
+		Where(
+			paymentorder.ReceiveAddressTextEqualFold(address),
+			paymentorder.HasTokenWith(tokenent.HasNetworkWith(networkent.IDEQ(network.ID))),
+		).
+		Order(ent.Desc(paymentorder.FieldCreatedAt)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return unmatcheddeposit.ReasonNoOrder, nil
+		}
+		return "", err
+	}
+
+	switch {
+	case order.Status == paymentorder.StatusQuarantined:
+		// Further deposits to a quarantined order are reviewed with it
+		return "", nil
+	case order.Status == paymentorder.StatusExpired, order.Status == paymentorder.StatusInitiated:
+		// An order still initiated no longer accepts deposits once its address lapsed
+		return unmatcheddeposit.ReasonExpiredAddress, nil
+	default:
+		return unmatcheddeposit.ReasonPaidOrder, nil
+	}
+}
+
+// LinkUnmatchedDeposit credits an unmatched deposit to an order of the same token and receive address
+// that hasn't been paid in full, as if the indexer had matched it. An expired order is opened again
+// for the payment first, and expired again if it isn't credited with it
+func LinkUnmatchedDeposit(ctx context.Context, depositID uuid.UUID, orderID uuid.UUID, action AdminAction) (*ent.UnmatchedDeposit, error) {
+	deposit, err := unmatchedDeposit(ctx, depositID)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := db.Client.PaymentOrder.
+		Query().
+		Where(paymentorder.IDEQ(orderID)).
+		WithToken(func(tq *ent.TokenQuery) {
+			tq.WithNetwork()
+		}).
+		WithReceiveAddress().
+		WithRecipient().
+		WithSenderProfile().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	receiveAddress := order.Edges.ReceiveAddress
+	if order.Edges.Token.ID != deposit.Edges.Token.ID ||
+		receiveAddress == nil ||
+		!strings.EqualFold(receiveAddress.Address, deposit.ReceiveAddress) ||
+		(order.Status != paymentorder.StatusInitiated && order.Status != paymentorder.StatusExpired) ||
+		order.GatewayID != "" ||
+		order.TxHash != "" {
+		return nil, ErrOrderNotLinkable
+	}
+
+	// The partial payment of an expired order may already be on its way back to the payer
+	refunding, err := order.QueryRefundSweep().Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("LinkUnmatchedDeposit.db: %w", err)
+	}
+	if refunding {
+		return nil, ErrOrderNotLinkable
+	}
+
+	// Claim the deposit, so it isn't credited, refunded or swept twice
+	if err := claimUnmatchedDeposit(ctx, deposit, unmatcheddeposit.StatusLinked); err != nil {
+		return nil, err
+	}
+	expiredAddress := *receiveAddress
+	reopened := false
+	release := func() {
+		if err := deposit.Update().SetStatus(unmatcheddeposit.StatusPending).Exec(ctx); err != nil {
+			logger.Errorf("Failed to release unmatched deposit %s: %v", deposit.ID, err)
+		}
+		if !reopened {
+			return
+		}
+
+		// Expire the order again unless crediting got as far as paying it
+		err := db.Client.PaymentOrder.
+			Update().
+			Where(
+				paymentorder.IDEQ(order.ID),
+				paymentorder.StatusEQ(paymentorder.StatusInitiated),
+				paymentorder.Or(
+					paymentorder.TxHashIsNil(),
+					paymentorder.TxHashEQ(""),
+				),
+			).
+			SetStatus(paymentorder.StatusExpired).
+			Exec(ctx)
+		if err != nil {
+			logger.Errorf("Failed to expire order %s again: %v", order.ID, err)
+		}
+
+		update := db.Client.ReceiveAddress.
+			Update().
+			Where(
+				receiveaddress.IDEQ(expiredAddress.ID),
+				receiveaddress.StatusEQ(receiveAddress.Status),
+			).
+			SetStatus(expiredAddress.Status)
+		if !expiredAddress.ValidUntil.IsZero() {
+			update = update.SetValidUntil(expiredAddress.ValidUntil)
+		}
+		if err := update.Exec(ctx); err != nil {
+			logger.Errorf("Failed to expire receive address %s again: %v", expiredAddress.Address, err)
+		}
+	}
+
+	if order.Status == paymentorder.StatusExpired {
+		err = order.Update().
+			SetStatus(paymentorder.StatusInitiated).
+			Exec(ctx)
+		if err != nil {
+			release()
+			return nil, fmt.Errorf("LinkUnmatchedDeposit.order: %w", err)
+		}
+		order.Status = paymentorder.StatusInitiated
+		reopened = true
+
+		update := receiveAddress.Update()
+		if receiveAddress.Status == receiveaddress.StatusExpired {
+			update = update.SetStatus(receiveaddress.StatusUnused)
+		}
+		if !receiveAddress.ValidUntil.IsZero() {
+			update = update.SetValidUntil(time.Now().Add(orderConf.ReceiveAddressValidity))
+		}
+		receiveAddress, err = update.Save(ctx)
+		if err != nil {
+			release()
+			return nil, fmt.Errorf("LinkUnmatchedDeposit.receiveAddress: %w", err)
+		}
+	}
+
+	network := order.Edges.Token.Edges.Network
+	event := &types.TokenTransferEvent{
+		BlockNumber: deposit.BlockNumber,
+		TxHash:      deposit.TxHash,
+		From:        deposit.FromAddress,
+		To:          receiveAddress.Address,
+		Value:       deposit.Amount,
+	}
+	createOrder := func(ctx context.Context, orderID uuid.UUID) error {
+		return createApprovedOrder(ctx, network, orderID)
+	}
+	_, err = UpdateReceiveAddressStatus(ctx, receiveAddress, order, event, createOrder, services.NewPriorityQueueService().GetProviderRate)
+	if err != nil {
+		release()
+		return nil, fmt.Errorf("LinkUnmatchedDeposit.UpdateReceiveAddressStatus: %w", err)
+	}
+
+	// A deposit the order wasn't credited with, e.g. one screening quarantined, stays unmatched
+	credited, err := db.Client.PaymentOrder.
+		Query().
+		Where(
+			paymentorder.IDEQ(order.ID),
+			paymentorder.Or(
+				paymentorder.TxHashEQ(deposit.TxHash),
+				paymentorder.HasTransactionsWith(transactionlog.TxHashEQ(deposit.TxHash)),
+			),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("LinkUnmatchedDeposit.db: %w", err)
+	}
+	if !credited {
+		release()
+		return nil, ErrOrderNotLinkable
+	}
+
+	deposit, err = deposit.Update().
+		SetPaymentOrderID(order.ID).
+		SetResolvedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("LinkUnmatchedDeposit.db: %w", err)
+	}
+
+	err = recordAdminAction(ctx, adminauditlog.ActionLinkUnmatchedDeposit, deposit.ID.String(), action, map[string]interface{}{
+		"OrderID": order.ID.String(),
+		"TxHash":  deposit.TxHash,
+		"Amount":  deposit.Amount.String(),
+		"Network": network.Identifier,
+	})
+	if err != nil {
+		return deposit, err
+	}
+
+	return deposit, nil
+}
+
+// RefundUnmatchedDeposit sweeps an unmatched deposit back to the address it came from
+func RefundUnmatchedDeposit(ctx context.Context, depositID uuid.UUID, action AdminAction) (*ent.UnmatchedDeposit, error) {
+	deposit, err := unmatchedDeposit(ctx, depositID)
+	if err != nil {
+		return nil, err
+	}
+
+	return sweepUnmatchedDeposit(ctx, deposit, deposit.FromAddress, unmatcheddeposit.StatusRefunded, adminauditlog.ActionRefundUnmatchedDeposit, action)
+}
+
+// SweepUnmatchedDeposit sweeps an unmatched deposit to the treasury
+func SweepUnmatchedDeposit(ctx context.Context, depositID uuid.UUID, action AdminAction) (*ent.UnmatchedDeposit, error) {
+	deposit, err := unmatchedDeposit(ctx, depositID)
+	if err != nil {
+		return nil, err
+	}
+
+	treasuryAddress := config.SweepConfig().TreasuryAddress
+	if treasuryAddress == "" {
+		return nil, ErrDepositNotSweepable
+	}
+
+	return sweepUnmatchedDeposit(ctx, deposit, treasuryAddress, unmatcheddeposit.StatusSwept, adminauditlog.ActionSweepUnmatchedDeposit, action)
+}
+
+// sweepUnmatchedDeposit sends an unmatched deposit out of its receive address to toAddress and
+// resolves it with status. Only deposits to EVM receive addresses can be swept
+func sweepUnmatchedDeposit(ctx context.Context, deposit *ent.UnmatchedDeposit, toAddress string, status unmatcheddeposit.Status, kind adminauditlog.Action, action AdminAction) (*ent.UnmatchedDeposit, error) {
+	token := deposit.Edges.Token
+	network := token.Edges.Network
+	if network.NetworkType != networkent.NetworkTypeEvm || strings.HasPrefix(network.Identifier, "tron") {
+		return nil, ErrDepositNotSweepable
+	}
+
+	if err := claimUnmatchedDeposit(ctx, deposit, status); err != nil {
+		return nil, err
+	}
+
+	sweep, err := createRefundSweep(services.WithGasSpend(ctx, transactionlog.PurposeRefund, nil), token, deposit.ReceiveAddress, toAddress, deposit.Amount)
+	if err != nil {
+		if err := deposit.Update().SetStatus(unmatcheddeposit.StatusPending).Exec(ctx); err != nil {
+			logger.Errorf("Failed to release unmatched deposit %s: %v", deposit.ID, err)
+		}
+		return nil, fmt.Errorf("sweepUnmatchedDeposit.sweep: %w", err)
+	}
+
+	deposit, err = deposit.Update().
+		SetSweep(sweep).
+		SetResolvedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("sweepUnmatchedDeposit.db: %w", err)
+	}
+
+	err = recordAdminAction(ctx, kind, deposit.ID.String(), action, map[string]interface{}{
+		"SweepID": sweep.ID.String(),
+		"To":      toAddress,
+		"TxHash":  deposit.TxHash,
+		"Amount":  deposit.Amount.String(),
+		"Network": network.Identifier,
+	})
+	if err != nil {
+		return deposit, err
+	}
+
+	return deposit, nil
+}
+
+// unmatchedDeposit fetches an unmatched deposit that is still pending, with its token and network
+func unmatchedDeposit(ctx context.Context, depositID uuid.UUID) (*ent.UnmatchedDeposit, error) {
+	deposit, err := db.Client.UnmatchedDeposit.
+		Query().
+		Where(unmatcheddeposit.IDEQ(depositID)).
+		WithToken(func(tq *ent.TokenQuery) {
+			tq.WithNetwork()
+		}).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if deposit.Status != unmatcheddeposit.StatusPending {
+		return nil, ErrDepositResolved
+	}
+
+	return deposit, nil
+}
+
+// claimUnmatchedDeposit moves a pending deposit to status, failing if another operation resolved it first
+func claimUnmatchedDeposit(ctx context.Context, deposit *ent.UnmatchedDeposit, status unmatcheddeposit.Status) error {
+	claimed, err := db.Client.UnmatchedDeposit.
+		Update().
+		Where(
+			unmatcheddeposit.IDEQ(deposit.ID),
+			unmatcheddeposit.StatusEQ(unmatcheddeposit.StatusPending),
+		).
+		SetStatus(status).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("claimUnmatchedDeposit: %w", err)
+	}
+	if claimed == 0 {
+		return ErrDepositResolved
+	}
+	return nil
+}
@@ -0,0 +1,243 @@
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/adminauditlog"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	"github.com/NEDA-LABS/stablenode/ent/sweep"
+	"github.com/NEDA-LABS/stablenode/ent/unmatcheddeposit"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/shopspring/decimal"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnmatchedDeposits(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:unmatcheddeposit?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	ctx := context.Background()
+	viper.Set("SLACK_WEBHOOK_URL", "")
+
+	// The pool address of the split orders awaits payment
+	orders := setupDepositSplit(t, ctx)
+	token := orders[0].Edges.Token
+	sender := orders[0].Edges.SenderProfile
+
+	const (
+		expiredAddress = "0xAaAaAaAaAaAaAaAaAaAaAaAaAaAaAaAaAaAaAaAa"
+		paidAddress    = "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"
+		orphanAddress  = "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC"
+	)
+
+	createOrder := func(address string, addressStatus receiveaddress.Status, status paymentorder.Status, txHash string) *ent.PaymentOrder {
+		receiveAddress := client.ReceiveAddress.
+			Create().
+			SetAddress(address).
+			SetStatus(addressStatus).
+			SetValidUntil(time.Now().Add(-time.Hour)).
+			SaveX(ctx)
+		return client.PaymentOrder.
+			Create().
+			SetSenderProfile(sender).
+			SetAmount(decimal.NewFromInt(10)).
+			SetAmountInUsd(decimal.NewFromInt(10)).
+			SetAmountPaid(decimal.Zero).
+			SetAmountReturned(decimal.Zero).
+			SetPercentSettled(decimal.Zero).
+			SetNetworkFee(decimal.NewFromFloat(0.5)).
+			SetSenderFee(decimal.Zero).
+			SetProtocolFee(decimal.Zero).
+			SetRate(decimal.NewFromFloat(1500)).
+			SetToken(token).
+			SetReceiveAddress(receiveAddress).
+			SetReceiveAddressText(address).
+			SetFeePercent(decimal.Zero).
+			SetFeeAddress("0x1234567890123456789012345678901234567890").
+			SetStatus(status).
+			SetTxHash(txHash).
+			SaveX(ctx)
+	}
+	expiredOrder := createOrder(expiredAddress, receiveaddress.StatusExpired, paymentorder.StatusExpired, "")
+	paidOrder := createOrder(paidAddress, receiveaddress.StatusUsed, paymentorder.StatusPending, "0xpaid")
+	client.ReceiveAddress.
+		Create().
+		SetAddress(orphanAddress).
+		SetStatus(receiveaddress.StatusUsed).
+		SaveX(ctx)
+
+	transfer := func(txHash, to string, value float64) *types.TokenTransferEvent {
+		return &types.TokenTransferEvent{
+			BlockNumber: 100,
+			TxHash:      txHash,
+			From:        "0x2222222222222222222222222222222222222222",
+			To:          to,
+			Value:       decimal.NewFromFloat(value),
+		}
+	}
+
+	var created []uuid.UUID
+	defaultCreateApprovedOrder := createApprovedOrder
+	defaultCreateRefundSweep := createRefundSweep
+	defer func() {
+		createApprovedOrder = defaultCreateApprovedOrder
+		createRefundSweep = defaultCreateRefundSweep
+	}()
+	createApprovedOrder = func(ctx context.Context, network *ent.Network, orderID uuid.UUID) error {
+		created = append(created, orderID)
+		return nil
+	}
+	createRefundSweep = func(ctx context.Context, token *ent.Token, fromAddress, toAddress string, amount decimal.Decimal) (*ent.Sweep, error) {
+		return client.Sweep.
+			Create().
+			SetNetwork(token.Edges.Network.Identifier).
+			SetChainID(token.Edges.Network.ChainID).
+			SetTokenAddress(token.ContractAddress).
+			SetFromAddress(fromAddress).
+			SetToAddress(toAddress).
+			SetAmount(amount).
+			SetStatus(sweep.StatusSubmitted).
+			SetTxHash("0xuseroperation").
+			Save(ctx)
+	}
+
+	deposits := make(map[unmatcheddeposit.Reason]*ent.UnmatchedDeposit)
+	action := AdminAction{Actor: "ops@example.com", Reason: "payer contacted support"}
+
+	t.Run("should hold transfers no order can be credited with", func(t *testing.T) {
+		addressToEvent := map[string]*types.TokenTransferEvent{
+			splitTestAddress: transfer("0xd1", splitTestAddress, 10),
+			expiredAddress:   transfer("0xd2", expiredAddress, 10.5),
+			paidAddress:      transfer("0xd3", paidAddress, 5),
+			orphanAddress:    transfer("0xd4", orphanAddress, 7),
+			// Not one of our receive addresses
+			"0x9999999999999999999999999999999999999999": transfer("0xd5", "0x9999999999999999999999999999999999999999", 1),
+		}
+
+		// Transfers seen again are held once
+		assert.NoError(t, RecordUnmatchedDeposits(ctx, addressToEvent, token))
+		assert.NoError(t, RecordUnmatchedDeposits(ctx, addressToEvent, token))
+
+		all := client.UnmatchedDeposit.Query().AllX(ctx)
+		assert.Len(t, all, 3)
+		for _, deposit := range all {
+			deposits[deposit.Reason] = deposit
+			assert.Equal(t, unmatcheddeposit.StatusPending, deposit.Status)
+		}
+		assert.Equal(t, "0xd2", deposits[unmatcheddeposit.ReasonExpiredAddress].TxHash)
+		assert.Equal(t, "0xd3", deposits[unmatcheddeposit.ReasonPaidOrder].TxHash)
+		assert.Equal(t, "0xd4", deposits[unmatcheddeposit.ReasonNoOrder].TxHash)
+		assert.True(t, deposits[unmatcheddeposit.ReasonNoOrder].Amount.Equal(decimal.NewFromInt(7)))
+	})
+
+	t.Run("should skip transfers that were credited", func(t *testing.T) {
+		err := RecordUnmatchedDeposits(ctx, map[string]*types.TokenTransferEvent{
+			paidAddress: transfer("0xpaid", paidAddress, 10.5),
+		}, token)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, client.UnmatchedDeposit.Query().CountX(ctx))
+	})
+
+	t.Run("should only link a deposit to an unpaid order on its address", func(t *testing.T) {
+		_, err := LinkUnmatchedDeposit(ctx, deposits[unmatcheddeposit.ReasonPaidOrder].ID, paidOrder.ID, action)
+		assert.ErrorIs(t, err, ErrOrderNotLinkable)
+
+		_, err = LinkUnmatchedDeposit(ctx, deposits[unmatcheddeposit.ReasonExpiredAddress].ID, orders[0].ID, action)
+		assert.ErrorIs(t, err, ErrOrderNotLinkable)
+
+		deposit := client.UnmatchedDeposit.GetX(ctx, deposits[unmatcheddeposit.ReasonPaidOrder].ID)
+		assert.Equal(t, unmatcheddeposit.StatusPending, deposit.Status)
+	})
+
+	t.Run("should credit a linked deposit to an expired order", func(t *testing.T) {
+		deposit, err := LinkUnmatchedDeposit(ctx, deposits[unmatcheddeposit.ReasonExpiredAddress].ID, expiredOrder.ID, action)
+		assert.NoError(t, err)
+		assert.Equal(t, unmatcheddeposit.StatusLinked, deposit.Status)
+		assert.False(t, deposit.ResolvedAt.IsZero())
+		assert.Equal(t, expiredOrder.ID, deposit.QueryPaymentOrder().OnlyIDX(ctx))
+
+		order := client.PaymentOrder.GetX(ctx, expiredOrder.ID)
+		assert.Equal(t, paymentorder.StatusPending, order.Status)
+		assert.True(t, order.AmountPaid.Equal(decimal.NewFromFloat(10.5)))
+		assert.Equal(t, []uuid.UUID{expiredOrder.ID}, created)
+
+		entry := client.AdminAuditLog.Query().OnlyX(ctx)
+		assert.Equal(t, adminauditlog.ActionLinkUnmatchedDeposit, entry.Action)
+		assert.Equal(t, deposit.ID.String(), entry.TargetID)
+
+		_, err = LinkUnmatchedDeposit(ctx, deposit.ID, expiredOrder.ID, action)
+		assert.ErrorIs(t, err, ErrDepositResolved)
+	})
+
+	t.Run("should expire a reopened order again when the deposit isn't credited", func(t *testing.T) {
+		const address = "0xdDdDddDdDdddDDddDDddDDDDdDdDDdDDdDDDDDDd"
+		order := createOrder(address, receiveaddress.StatusExpired, paymentorder.StatusExpired, "")
+		deposit := client.UnmatchedDeposit.
+			Create().
+			SetTxHash("0xd6").
+			SetReceiveAddress(address).
+			SetFromAddress("0x2222222222222222222222222222222222222222").
+			SetAmount(decimal.NewFromInt(10)).
+			SetReason(unmatcheddeposit.ReasonExpiredAddress).
+			SetToken(token).
+			SaveX(ctx)
+
+		// The transfer was already processed for another order, so the indexer skips it
+		client.TransactionLog.
+			Create().
+			SetStatus("order_created").
+			SetTxHash("0xd6").
+			SetMetadata(map[string]interface{}{}).
+			SaveX(ctx)
+
+		_, err := LinkUnmatchedDeposit(ctx, deposit.ID, order.ID, action)
+		assert.ErrorIs(t, err, ErrOrderNotLinkable)
+
+		assert.Equal(t, unmatcheddeposit.StatusPending, client.UnmatchedDeposit.GetX(ctx, deposit.ID).Status)
+		assert.Equal(t, paymentorder.StatusExpired, client.PaymentOrder.GetX(ctx, order.ID).Status)
+
+		receiveAddress := client.PaymentOrder.QueryReceiveAddress(order).OnlyX(ctx)
+		assert.Equal(t, receiveaddress.StatusExpired, receiveAddress.Status)
+		assert.True(t, receiveAddress.ValidUntil.Before(time.Now()))
+	})
+
+	t.Run("should refund a deposit to its sender", func(t *testing.T) {
+		deposit, err := RefundUnmatchedDeposit(ctx, deposits[unmatcheddeposit.ReasonNoOrder].ID, action)
+		assert.NoError(t, err)
+		assert.Equal(t, unmatcheddeposit.StatusRefunded, deposit.Status)
+
+		sweepEntity := deposit.QuerySweep().OnlyX(ctx)
+		assert.Equal(t, orphanAddress, sweepEntity.FromAddress)
+		assert.Equal(t, "0x2222222222222222222222222222222222222222", sweepEntity.ToAddress)
+		assert.True(t, sweepEntity.Amount.Equal(decimal.NewFromInt(7)))
+
+		_, err = SweepUnmatchedDeposit(ctx, deposit.ID, action)
+		assert.ErrorIs(t, err, ErrDepositResolved)
+	})
+
+	t.Run("should sweep a deposit to the treasury", func(t *testing.T) {
+		id := deposits[unmatcheddeposit.ReasonPaidOrder].ID
+
+		viper.Set("SWEEP_TREASURY_ADDRESS", "")
+		_, err := SweepUnmatchedDeposit(ctx, id, action)
+		assert.ErrorIs(t, err, ErrDepositNotSweepable)
+
+		viper.Set("SWEEP_TREASURY_ADDRESS", "0x7777777777777777777777777777777777777777")
+		defer viper.Set("SWEEP_TREASURY_ADDRESS", "")
+
+		deposit, err := SweepUnmatchedDeposit(ctx, id, action)
+		assert.NoError(t, err)
+		assert.Equal(t, unmatcheddeposit.StatusSwept, deposit.Status)
+		assert.Equal(t, "0x7777777777777777777777777777777777777777", deposit.QuerySweep().OnlyX(ctx).ToAddress)
+	})
+}
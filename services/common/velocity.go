@@ -0,0 +1,228 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+)
+
+// Limits of a sender a new order can exceed
+const (
+	SenderLimitMaxOrderAmount   = "max_order_amount"
+	SenderLimitDailyVolume      = "daily_volume_limit"
+	SenderLimitHourlyOrderCount = "hourly_order_limit"
+)
+
+// SenderLimitError is returned when a new order would take its sender over one of its limits
+type SenderLimitError struct {
+	Limit   string
+	Message string
+}
+
+func (e *SenderLimitError) Error() string {
+	return e.Message
+}
+
+// SenderUsage is the recent order activity of a sender, counted in Redis
+type SenderUsage struct {
+	// DailyVolume is the USD volume of the orders created in the current UTC day
+	DailyVolume decimal.Decimal
+	// HourlyOrders is the number of orders created in the last hour
+	HourlyOrders int
+	// BurstOrders is the number of orders created within the burst window
+	BurstOrders int
+}
+
+// RiskAssessment is the risk score of a new order. Orders scored 1 or more are held for manual
+// review, with the reason given
+type RiskAssessment struct {
+	Score  float64
+	Reason string
+}
+
+// ScoreOrderRisk scores a new order of a sender from its recent activity. It defaults to scoring
+// bursts of orders and can be replaced to plug in another risk model
+var ScoreOrderRisk = func(ctx context.Context, sender *ent.SenderProfile, usage SenderUsage, amountInUSD decimal.Decimal) RiskAssessment {
+	riskConf := config.RiskConfig()
+	if riskConf.BurstOrders <= 0 {
+		return RiskAssessment{}
+	}
+
+	// The new order counts towards the burst
+	orders := usage.BurstOrders + 1
+	assessment := RiskAssessment{Score: float64(orders) / float64(riskConf.BurstOrders)}
+	if assessment.Score >= 1 {
+		assessment.Reason = fmt.Sprintf("burst of %d orders within %s", orders, riskConf.BurstWindow)
+	}
+	return assessment
+}
+
+// senderOrdersKey is the sorted set of the recent orders of a sender, scored by creation time in ms
+func senderOrdersKey(senderID uuid.UUID) string {
+	return fmt.Sprintf("sender_orders_%s", senderID)
+}
+
+// senderDailyVolumeKey is the USD volume of the orders of a sender in a UTC day
+func senderDailyVolumeKey(senderID uuid.UUID, day time.Time) string {
+	return fmt.Sprintf("sender_daily_volume_%s_%s", senderID, day.UTC().Format("2006-01-02"))
+}
+
+// senderOrdersRetention is how long the recent orders of a sender are kept
+func senderOrdersRetention() time.Duration {
+	if burstWindow := config.RiskConfig().BurstWindow; burstWindow > time.Hour {
+		return burstWindow
+	}
+	return time.Hour
+}
+
+// GetSenderUsage returns the recent order activity of a sender
+func GetSenderUsage(ctx context.Context, senderID uuid.UUID) (SenderUsage, error) {
+	now := time.Now()
+	ordersKey := senderOrdersKey(senderID)
+	since := func(d time.Duration) string {
+		return strconv.FormatInt(now.Add(-d).UnixMilli(), 10)
+	}
+
+	pipe := db.RedisClient.Pipeline()
+	volume := pipe.Get(ctx, senderDailyVolumeKey(senderID, now))
+	hourly := pipe.ZCount(ctx, ordersKey, since(time.Hour), "+inf")
+	burst := pipe.ZCount(ctx, ordersKey, since(config.RiskConfig().BurstWindow), "+inf")
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return SenderUsage{}, fmt.Errorf("GetSenderUsage: %w", err)
+	}
+
+	usage := SenderUsage{
+		DailyVolume:  decimal.Zero,
+		HourlyOrders: int(hourly.Val()),
+		BurstOrders:  int(burst.Val()),
+	}
+	if volume.Err() == nil {
+		dailyVolume, err := decimal.NewFromString(volume.Val())
+		if err != nil {
+			return SenderUsage{}, fmt.Errorf("GetSenderUsage.volume: %w", err)
+		}
+		usage.DailyVolume = dailyVolume
+	}
+
+	return usage, nil
+}
+
+// CheckSenderVelocity checks a new order of a sender against the limits of the sender, returning a
+// *SenderLimitError for an order over a limit. An order within the limits is scored for risk, and
+// the reason to hold it for manual review is returned for an anomalous one
+func CheckSenderVelocity(ctx context.Context, sender *ent.SenderProfile, amountInUSD decimal.Decimal) (string, error) {
+	if err := checkMaxOrderAmount(sender, amountInUSD); err != nil {
+		return "", err
+	}
+
+	reviewReasons, limitErrs, err := CheckSenderBatchVelocity(ctx, sender, []decimal.Decimal{amountInUSD})
+	if err != nil {
+		return "", err
+	}
+	if limitErrs[0] != nil {
+		return "", limitErrs[0]
+	}
+	return reviewReasons[0], nil
+}
+
+// CheckSenderBatchVelocity checks a batch of new orders of a sender against the limits of the
+// sender, in order, counting each order within the limits towards the limits of the next ones. The
+// *SenderLimitError of every order over a limit is returned at its index, as is the reason to hold
+// an anomalous order for manual review
+func CheckSenderBatchVelocity(ctx context.Context, sender *ent.SenderProfile, amountsInUSD []decimal.Decimal) ([]string, []*SenderLimitError, error) {
+	usage, err := GetSenderUsage(ctx, sender.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reviewReasons := make([]string, len(amountsInUSD))
+	limitErrs := make([]*SenderLimitError, len(amountsInUSD))
+	for i, amountInUSD := range amountsInUSD {
+		if limitErrs[i] = checkMaxOrderAmount(sender, amountInUSD); limitErrs[i] != nil {
+			continue
+		}
+
+		if sender.DailyVolumeLimit != nil && usage.DailyVolume.Add(amountInUSD).GreaterThan(*sender.DailyVolumeLimit) {
+			limitErrs[i] = &SenderLimitError{
+				Limit:   SenderLimitDailyVolume,
+				Message: fmt.Sprintf("Daily volume limit of %s USD reached", sender.DailyVolumeLimit.Round(2)),
+			}
+			continue
+		}
+
+		if sender.HourlyOrderLimit != nil && usage.HourlyOrders >= *sender.HourlyOrderLimit {
+			limitErrs[i] = &SenderLimitError{
+				Limit:   SenderLimitHourlyOrderCount,
+				Message: fmt.Sprintf("Hourly limit of %d orders reached", *sender.HourlyOrderLimit),
+			}
+			continue
+		}
+
+		if assessment := ScoreOrderRisk(ctx, sender, usage, amountInUSD); assessment.Score >= 1 {
+			reviewReasons[i] = assessment.Reason
+		}
+
+		usage.DailyVolume = usage.DailyVolume.Add(amountInUSD)
+		usage.HourlyOrders++
+		usage.BurstOrders++
+	}
+
+	return reviewReasons, limitErrs, nil
+}
+
+// checkMaxOrderAmount checks a new order of a sender against the maximum order amount of the sender
+func checkMaxOrderAmount(sender *ent.SenderProfile, amountInUSD decimal.Decimal) *SenderLimitError {
+	if sender.MaxOrderAmount != nil && amountInUSD.GreaterThan(*sender.MaxOrderAmount) {
+		return &SenderLimitError{
+			Limit:   SenderLimitMaxOrderAmount,
+			Message: fmt.Sprintf("Order amount of %s USD is above the maximum of %s USD", amountInUSD.Round(2), sender.MaxOrderAmount.Round(2)),
+		}
+	}
+	return nil
+}
+
+// RecordSenderOrder counts a new order of a sender towards its limits
+func RecordSenderOrder(ctx context.Context, senderID uuid.UUID, orderID uuid.UUID, amountInUSD decimal.Decimal) error {
+	now := time.Now()
+	ordersKey := senderOrdersKey(senderID)
+	volumeKey := senderDailyVolumeKey(senderID, now)
+	retention := senderOrdersRetention()
+
+	pipe := db.RedisClient.TxPipeline()
+	pipe.ZAdd(ctx, ordersKey, redis.Z{Score: float64(now.UnixMilli()), Member: orderID.String()})
+	pipe.ZRemRangeByScore(ctx, ordersKey, "-inf", strconv.FormatInt(now.Add(-retention).UnixMilli(), 10))
+	pipe.Expire(ctx, ordersKey, retention)
+	pipe.IncrByFloat(ctx, volumeKey, amountInUSD.InexactFloat64())
+	pipe.Expire(ctx, volumeKey, 48*time.Hour)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("RecordSenderOrder: %w", err)
+	}
+
+	return nil
+}
+
+// SenderLimits returns the limits of a sender with its usage of them
+func SenderLimits(ctx context.Context, sender *ent.SenderProfile) (*types.SenderLimitsResponse, error) {
+	usage, err := GetSenderUsage(ctx, sender.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.SenderLimitsResponse{
+		MaxOrderAmount:   sender.MaxOrderAmount,
+		DailyVolumeLimit: sender.DailyVolumeLimit,
+		HourlyOrderLimit: sender.HourlyOrderLimit,
+		DailyVolume:      usage.DailyVolume,
+		HourlyOrders:     usage.HourlyOrders,
+	}, nil
+}
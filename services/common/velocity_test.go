@@ -0,0 +1,133 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSenderVelocity(t *testing.T) {
+	mr, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer mr.Close()
+	db.RedisClient = redis.NewClient(&redis.Options{Addr: mr.Addr()})
+
+	viper.Set("RISK_BURST_ORDERS", 4)
+	defer viper.Set("RISK_BURST_ORDERS", 20)
+
+	ctx := context.Background()
+	maxOrderAmount := decimal.NewFromInt(1000)
+	dailyVolumeLimit := decimal.NewFromInt(2500)
+	hourlyOrderLimit := 3
+	sender := &ent.SenderProfile{
+		ID:               uuid.New(),
+		MaxOrderAmount:   &maxOrderAmount,
+		DailyVolumeLimit: &dailyVolumeLimit,
+		HourlyOrderLimit: &hourlyOrderLimit,
+	}
+
+	limitOf := func(err error) string {
+		limitErr, ok := err.(*SenderLimitError)
+		if !ok {
+			return ""
+		}
+		return limitErr.Limit
+	}
+
+	t.Run("should reject orders above the maximum amount", func(t *testing.T) {
+		_, err := CheckSenderVelocity(ctx, sender, decimal.NewFromInt(1001))
+		assert.Equal(t, SenderLimitMaxOrderAmount, limitOf(err))
+	})
+
+	t.Run("should count orders towards the limits", func(t *testing.T) {
+		for _, amount := range []int64{1000, 1000} {
+			reason, err := CheckSenderVelocity(ctx, sender, decimal.NewFromInt(amount))
+			assert.NoError(t, err)
+			assert.Empty(t, reason)
+			assert.NoError(t, RecordSenderOrder(ctx, sender.ID, uuid.New(), decimal.NewFromInt(amount)))
+		}
+
+		usage, err := GetSenderUsage(ctx, sender.ID)
+		assert.NoError(t, err)
+		assert.True(t, usage.DailyVolume.Equal(decimal.NewFromInt(2000)))
+		assert.Equal(t, 2, usage.HourlyOrders)
+		assert.Equal(t, 2, usage.BurstOrders)
+	})
+
+	t.Run("should reject orders over the daily volume", func(t *testing.T) {
+		_, err := CheckSenderVelocity(ctx, sender, decimal.NewFromInt(600))
+		assert.Equal(t, SenderLimitDailyVolume, limitOf(err))
+	})
+
+	t.Run("should reject orders over the hourly count", func(t *testing.T) {
+		assert.NoError(t, RecordSenderOrder(ctx, sender.ID, uuid.New(), decimal.NewFromInt(100)))
+
+		_, err := CheckSenderVelocity(ctx, sender, decimal.NewFromInt(100))
+		assert.Equal(t, SenderLimitHourlyOrderCount, limitOf(err))
+	})
+
+	t.Run("should hold bursts of orders for review", func(t *testing.T) {
+		sender.HourlyOrderLimit = nil
+		sender.DailyVolumeLimit = nil
+
+		reason, err := CheckSenderVelocity(ctx, sender, decimal.NewFromInt(100))
+		assert.NoError(t, err)
+		assert.Equal(t, "burst of 4 orders within 10m0s", reason)
+
+		limits, err := SenderLimits(ctx, sender)
+		assert.NoError(t, err)
+		assert.True(t, limits.MaxOrderAmount.Equal(maxOrderAmount))
+		assert.Nil(t, limits.HourlyOrderLimit)
+		assert.Equal(t, 3, limits.HourlyOrders)
+	})
+}
+
+func TestSenderBatchVelocity(t *testing.T) {
+	mr, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer mr.Close()
+	db.RedisClient = redis.NewClient(&redis.Options{Addr: mr.Addr()})
+
+	viper.Set("RISK_BURST_ORDERS", 3)
+	defer viper.Set("RISK_BURST_ORDERS", 20)
+
+	maxOrderAmount := decimal.NewFromInt(1000)
+	dailyVolumeLimit := decimal.NewFromInt(2500)
+	hourlyOrderLimit := 3
+	sender := &ent.SenderProfile{
+		ID:               uuid.New(),
+		MaxOrderAmount:   &maxOrderAmount,
+		DailyVolumeLimit: &dailyVolumeLimit,
+		HourlyOrderLimit: &hourlyOrderLimit,
+	}
+
+	amounts := []decimal.Decimal{
+		decimal.NewFromInt(1000),
+		decimal.NewFromInt(2000),
+		decimal.NewFromInt(1000),
+		decimal.NewFromInt(600),
+		decimal.NewFromInt(400),
+		decimal.NewFromInt(100),
+	}
+	reviewReasons, limitErrs, err := CheckSenderBatchVelocity(context.Background(), sender, amounts)
+	assert.NoError(t, err)
+
+	limits := make([]string, len(limitErrs))
+	for i, limitErr := range limitErrs {
+		if limitErr != nil {
+			limits[i] = limitErr.Limit
+		}
+	}
+
+	// Orders over a limit don't count towards the limits of the next ones
+	assert.Equal(t, []string{"", SenderLimitMaxOrderAmount, "", SenderLimitDailyVolume, "", SenderLimitHourlyOrderCount}, limits)
+	assert.Equal(t, []string{"", "", "", "", "burst of 3 orders within 10m0s", ""}, reviewReasons)
+}
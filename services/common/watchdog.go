@@ -0,0 +1,322 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/lockorderfulfillment"
+	"github.com/NEDA-LABS/stablenode/ent/lockpaymentorder"
+	networkent "github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/services"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/google/uuid"
+)
+
+// Watchdog states of a stuck order
+const (
+	// WatchdogStatePending is a paid payment order not yet created on-chain
+	WatchdogStatePending = "pending"
+	// WatchdogStateUnassigned is a lock order no provider accepted
+	WatchdogStateUnassigned = "unassigned"
+	// WatchdogStateProcessing is a lock order a provider accepted but did not fulfill
+	WatchdogStateProcessing = "processing"
+	// WatchdogStateValidated is a validated lock order not yet settled on-chain
+	WatchdogStateValidated = "validated"
+)
+
+// watchdogEscalationTTL is how long an escalated order is kept from being escalated again
+const watchdogEscalationTTL = 24 * time.Hour
+
+// errDepositMissing is returned when the receive address of a pending order no longer holds its deposit
+var errDepositMissing = errors.New("receive address does not hold the deposit")
+
+// watchdogOrderService returns the order service remediations are submitted with; tests replace it
+var watchdogOrderService = orderServiceForNetwork
+
+// stuckOrder is an order found stuck in a watchdog state, with the remediation of that state
+type stuckOrder struct {
+	id        uuid.UUID
+	remediate func(ctx context.Context) error
+}
+
+// watchdogEscalation is a stuck order the watchdog gave up remediating
+type watchdogEscalation struct {
+	orderID string
+	reason  string
+}
+
+// WatchStuckOrders finds orders stuck in an intermediate state for longer than the threshold of the
+// state, and remediates them: pending payment orders are created on-chain again once their deposit
+// is found at the receive address, unassigned lock orders are offered to providers again, lock
+// orders held by an unresponsive provider are taken from it and requeued, and validated lock orders
+// have their settlement resubmitted. A remediation is attempted at most once per threshold. Orders
+// whose remediation fails, or that are still stuck after the configured number of attempts, are
+// escalated to ops once per state.
+func WatchStuckOrders(ctx context.Context, balances TokenBalanceReader, assignLockPaymentOrder func(ctx context.Context, order types.LockPaymentOrderFields) error) error {
+	conf := config.WatchdogConfig()
+	now := time.Now()
+
+	// Paid orders waiting to be created on-chain
+	paymentOrders, err := db.Client.PaymentOrder.
+		Query().
+		Where(
+			paymentorder.StatusEQ(paymentorder.StatusPending),
+			paymentorder.Or(
+				paymentorder.GatewayIDIsNil(),
+				paymentorder.GatewayIDEQ(""),
+			),
+			paymentorder.Or(
+				paymentorder.ReviewReasonIsNil(),
+				paymentorder.ReviewReasonEQ(""),
+			),
+			paymentorder.UpdatedAtLT(now.Add(-conf.PendingThreshold)),
+		).
+		WithToken(func(tq *ent.TokenQuery) {
+			tq.WithNetwork()
+		}).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("WatchStuckOrders.pendingOrders: %w", err)
+	}
+
+	stuck := make([]stuckOrder, 0, len(paymentOrders))
+	for _, order := range paymentOrders {
+		if AwaitingDepositFinality(order, order.Edges.Token.Edges.Network) {
+			continue
+		}
+		stuck = append(stuck, stuckOrder{
+			id: order.ID,
+			remediate: func(ctx context.Context) error {
+				return resubmitOrderCreation(ctx, balances, order)
+			},
+		})
+	}
+	watchState(ctx, conf, WatchdogStatePending, conf.PendingThreshold, stuck)
+
+	// Lock orders waiting for a provider
+	unassignedOrders, err := db.Client.LockPaymentOrder.
+		Query().
+		Where(
+			lockpaymentorder.StatusEQ(lockpaymentorder.StatusPending),
+			lockpaymentorder.Or(
+				lockpaymentorder.ReviewReasonIsNil(),
+				lockpaymentorder.ReviewReasonEQ(""),
+			),
+			lockpaymentorder.UpdatedAtLT(now.Add(-conf.UnassignedThreshold)),
+		).
+		WithToken(func(tq *ent.TokenQuery) {
+			tq.WithNetwork()
+		}).
+		WithProvisionBucket(func(pbq *ent.ProvisionBucketQuery) {
+			pbq.WithCurrency()
+		}).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("WatchStuckOrders.unassignedOrders: %w", err)
+	}
+
+	stuck = make([]stuckOrder, 0, len(unassignedOrders))
+	for _, order := range unassignedOrders {
+		stuck = append(stuck, stuckOrder{
+			id: order.ID,
+			remediate: func(ctx context.Context) error {
+				// Orders outside a provision bucket only go to the private provider they were created for
+				if order.Edges.ProvisionBucket == nil {
+					return ErrOrderNotRequeueable
+				}
+				return assignLockPaymentOrder(ctx, lockOrderFields(order, ""))
+			},
+		})
+	}
+	watchState(ctx, conf, WatchdogStateUnassigned, conf.UnassignedThreshold, stuck)
+
+	// Lock orders accepted but never fulfilled
+	processingOrders, err := db.Client.LockPaymentOrder.
+		Query().
+		Where(
+			lockpaymentorder.StatusEQ(lockpaymentorder.StatusProcessing),
+			lockpaymentorder.Not(lockpaymentorder.HasFulfillments()),
+			lockpaymentorder.Or(
+				lockpaymentorder.ReviewReasonIsNil(),
+				lockpaymentorder.ReviewReasonEQ(""),
+			),
+			lockpaymentorder.UpdatedAtLT(now.Add(-conf.ProcessingThreshold)),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("WatchStuckOrders.processingOrders: %w", err)
+	}
+
+	stuck = make([]stuckOrder, 0, len(processingOrders))
+	for _, order := range processingOrders {
+		stuck = append(stuck, stuckOrder{
+			id: order.ID,
+			remediate: func(ctx context.Context) error {
+				released, _, err := releaseLockOrder(ctx, order.ID, true)
+				if err != nil {
+					return err
+				}
+				return assignLockPaymentOrder(ctx, lockOrderFields(released, ""))
+			},
+		})
+	}
+	watchState(ctx, conf, WatchdogStateProcessing, conf.ProcessingThreshold, stuck)
+
+	// Validated lock orders waiting to be settled
+	validatedOrders, err := db.Client.LockPaymentOrder.
+		Query().
+		Where(
+			lockpaymentorder.StatusEQ(lockpaymentorder.StatusValidated),
+			lockpaymentorder.HasFulfillmentsWith(
+				lockorderfulfillment.ValidationStatusEQ(lockorderfulfillment.ValidationStatusSuccess),
+			),
+			lockpaymentorder.UpdatedAtLT(now.Add(-conf.ValidatedThreshold)),
+		).
+		WithToken(func(tq *ent.TokenQuery) {
+			tq.WithNetwork()
+		}).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("WatchStuckOrders.validatedOrders: %w", err)
+	}
+
+	stuck = make([]stuckOrder, 0, len(validatedOrders))
+	for _, order := range validatedOrders {
+		stuck = append(stuck, stuckOrder{
+			id: order.ID,
+			remediate: func(ctx context.Context) error {
+				return watchdogOrderService(order.Edges.Token.Edges.Network).SettleOrder(ctx, order.ID)
+			},
+		})
+	}
+	watchState(ctx, conf, WatchdogStateValidated, conf.ValidatedThreshold, stuck)
+
+	return nil
+}
+
+// resubmitOrderCreation re-polls the receive address of a pending payment order and, if it still
+// holds the deposit, submits the on-chain creation of the order again. Balances are only re-polled
+// on EVM networks
+func resubmitOrderCreation(ctx context.Context, balances TokenBalanceReader, order *ent.PaymentOrder) error {
+	network := order.Edges.Token.Edges.Network
+
+	if network.NetworkType == networkent.NetworkTypeEvm && !strings.HasPrefix(network.Identifier, "tron") {
+		balance, err := balances.GetTokenBalance(ctx, network, order.ReceiveAddressText, order.Edges.Token)
+		if err != nil {
+			return fmt.Errorf("failed to fetch balance: %w", err)
+		}
+
+		required := order.Amount.Add(order.NetworkFee).Add(order.SenderFee)
+		if balance.LessThan(required) {
+			return fmt.Errorf("%w: holds %s of %s", errDepositMissing, balance, required)
+		}
+	}
+
+	return watchdogOrderService(network).CreateOrder(ctx, order.ID)
+}
+
+// watchState remediates the orders stuck in a state and escalates the ones it gives up on
+func watchState(ctx context.Context, conf *config.WatchdogConfiguration, state string, threshold time.Duration, orders []stuckOrder) {
+	var escalations []watchdogEscalation
+
+	for _, order := range orders {
+		attemptsKey := fmt.Sprintf("watchdog_attempts_%s_%s", state, order.id)
+		escalatedKey := fmt.Sprintf("watchdog_escalated_%s_%s", state, order.id)
+
+		// Remediations of an order are spaced by the threshold of its state
+		due, err := db.RedisClient.SetNX(ctx, fmt.Sprintf("watchdog_cooldown_%s_%s", state, order.id), 1, threshold).Result()
+		if err != nil {
+			logger.WithFields(logger.Fields{
+				"Error":   fmt.Sprintf("%v", err),
+				"OrderID": order.id.String(),
+				"State":   state,
+			}).Errorf("WatchStuckOrders: Failed to check remediation cooldown")
+			continue
+		}
+		if !due {
+			continue
+		}
+
+		attempts, err := db.RedisClient.Incr(ctx, attemptsKey).Result()
+		if err == nil {
+			err = db.RedisClient.Expire(ctx, attemptsKey, watchdogEscalationTTL).Err()
+		}
+		if err != nil {
+			logger.WithFields(logger.Fields{
+				"Error":   fmt.Sprintf("%v", err),
+				"OrderID": order.id.String(),
+				"State":   state,
+			}).Errorf("WatchStuckOrders: Failed to count remediation attempts")
+			continue
+		}
+
+		var reason string
+		if attempts > int64(conf.MaxAttempts) {
+			reason = fmt.Sprintf("still stuck after %d remediations", conf.MaxAttempts)
+		} else if err := order.remediate(ctx); err != nil {
+			reason = err.Error()
+			logger.WithFields(logger.Fields{
+				"Error":    reason,
+				"OrderID":  order.id.String(),
+				"State":    state,
+				"Attempts": attempts,
+			}).Errorf("WatchStuckOrders: Failed to remediate stuck order")
+		} else {
+			logger.WithFields(logger.Fields{
+				"OrderID":  order.id.String(),
+				"State":    state,
+				"Attempts": attempts,
+			}).Warnf("Remediated stuck order")
+			continue
+		}
+
+		escalate, err := db.RedisClient.SetNX(ctx, escalatedKey, reason, watchdogEscalationTTL).Result()
+		if err != nil {
+			logger.WithFields(logger.Fields{
+				"Error":   fmt.Sprintf("%v", err),
+				"OrderID": order.id.String(),
+				"State":   state,
+			}).Errorf("WatchStuckOrders: Failed to record escalation")
+			continue
+		}
+		if escalate {
+			escalations = append(escalations, watchdogEscalation{orderID: order.id.String(), reason: reason})
+		}
+	}
+
+	if len(escalations) > 0 {
+		sendWatchdogAlert(state, threshold, escalations)
+	}
+}
+
+// sendWatchdogAlert notifies ops of the orders stuck in a state that could not be remediated
+func sendWatchdogAlert(state string, threshold time.Duration, escalations []watchdogEscalation) {
+	listed := escalations
+	if len(listed) > maxAlertOrders {
+		listed = listed[:maxAlertOrders]
+	}
+
+	orderIDs := make([]string, 0, len(listed))
+	for _, escalation := range listed {
+		orderIDs = append(orderIDs, escalation.orderID)
+	}
+
+	err := services.NewSlackService(config.ServerConfig().SlackWebhookURL).SendAlert(fmt.Sprintf("Orders stuck %s", state), map[string]string{
+		"State":     state,
+		"Threshold": threshold.String(),
+		"Orders":    fmt.Sprintf("%d", len(escalations)),
+		"IDs":       strings.Join(orderIDs, ", "),
+		"Reason":    escalations[0].reason,
+	})
+	if err != nil {
+		logger.Errorf("Failed to send stuck %s orders alert: %v", state, err)
+	}
+}
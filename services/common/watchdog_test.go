@@ -0,0 +1,173 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	"github.com/NEDA-LABS/stablenode/ent/fiatcurrency"
+	"github.com/NEDA-LABS/stablenode/ent/lockpaymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/providercurrencies"
+	"github.com/NEDA-LABS/stablenode/ent/providerprofile"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+// stubOrderService records the orders submitted for creation and settlement
+type stubOrderService struct {
+	created []uuid.UUID
+	settled []uuid.UUID
+}
+
+func (s *stubOrderService) CreateOrder(ctx context.Context, orderID uuid.UUID) error {
+	s.created = append(s.created, orderID)
+	return nil
+}
+
+func (s *stubOrderService) RefundOrder(ctx context.Context, network *ent.Network, orderID string) error {
+	return nil
+}
+
+func (s *stubOrderService) SettleOrder(ctx context.Context, orderID uuid.UUID) error {
+	s.settled = append(s.settled, orderID)
+	return nil
+}
+
+func TestWatchStuckOrders(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:watchdog?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	mr, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer mr.Close()
+	db.RedisClient = redis.NewClient(&redis.Options{Addr: mr.Addr()})
+
+	orderService := &stubOrderService{}
+	defer func(original func(*ent.Network) types.OrderService) { watchdogOrderService = original }(watchdogOrderService)
+	watchdogOrderService = func(network *ent.Network) types.OrderService {
+		return orderService
+	}
+
+	ctx := context.Background()
+	fixture := setupAdminOrders(t, ctx)
+
+	currency := client.FiatCurrency.Query().Where(fiatcurrency.CodeEQ("KES")).OnlyX(ctx)
+	client.ProviderCurrencies.Update().
+		Where(
+			providercurrencies.HasProviderWith(providerprofile.IDEQ(fixture.providers[0].ID)),
+			providercurrencies.HasCurrencyWith(fiatcurrency.IDEQ(currency.ID)),
+		).
+		SetAvailableBalance(decimal.NewFromInt(100000)).
+		SetTotalBalance(decimal.NewFromInt(120000)).
+		SetReservedBalance(decimal.NewFromInt(20000)).
+		ExecX(ctx)
+
+	stuckSince := time.Now().Add(-2 * time.Hour)
+	lockOrder := func(status lockpaymentorder.Status, provider *ent.ProviderProfile, updatedAt time.Time) *ent.LockPaymentOrder {
+		order := createAdminLockOrder(t, ctx, fixture, status, provider)
+		return client.LockPaymentOrder.UpdateOne(order).SetUpdatedAt(updatedAt).SaveX(ctx)
+	}
+
+	pending := client.PaymentOrder.
+		Create().
+		SetAmount(decimal.NewFromInt(50)).
+		SetAmountInUsd(decimal.NewFromInt(50)).
+		SetAmountPaid(decimal.NewFromInt(50)).
+		SetAmountReturned(decimal.Zero).
+		SetPercentSettled(decimal.Zero).
+		SetNetworkFee(decimal.Zero).
+		SetSenderFee(decimal.Zero).
+		SetProtocolFee(decimal.Zero).
+		SetRate(decimal.NewFromInt(130)).
+		SetToken(fixture.token).
+		SetReceiveAddressText("0x3333333333333333333333333333333333333333").
+		SetFeePercent(decimal.Zero).
+		SetFeeAddress("0x1234567890123456789012345678901234567890").
+		SetStatus(paymentorder.StatusPending).
+		SetUpdatedAt(stuckSince).
+		SaveX(ctx)
+	unassigned := lockOrder(lockpaymentorder.StatusPending, nil, stuckSince)
+	processing := lockOrder(lockpaymentorder.StatusProcessing, fixture.providers[0], stuckSince)
+	validated := createAdminLockOrder(t, ctx, fixture, lockpaymentorder.StatusValidated, fixture.providers[1])
+	client.LockOrderFulfillment.
+		Create().
+		SetTxID("0xfulfillment").
+		SetPsp("test").
+		SetValidationStatus("success").
+		SetOrder(validated).
+		SaveX(ctx)
+	client.LockPaymentOrder.UpdateOne(validated).SetUpdatedAt(stuckSince).ExecX(ctx)
+
+	// Orders that only just entered their state are left alone
+	fresh := lockOrder(lockpaymentorder.StatusProcessing, fixture.providers[0], time.Now())
+
+	balances := &stubTokenBalances{balance: decimal.NewFromInt(50)}
+	var assigned []uuid.UUID
+	assign := func(ctx context.Context, order types.LockPaymentOrderFields) error {
+		assigned = append(assigned, order.ID)
+		return nil
+	}
+
+	escalation := func(state string, orderID uuid.UUID) string {
+		reason, _ := db.RedisClient.Get(ctx, fmt.Sprintf("watchdog_escalated_%s_%s", state, orderID)).Result()
+		return reason
+	}
+
+	t.Run("remediates orders stuck past the threshold of their state", func(t *testing.T) {
+		assert.NoError(t, WatchStuckOrders(ctx, balances, assign))
+
+		assert.Equal(t, []uuid.UUID{pending.ID}, orderService.created)
+		assert.Equal(t, 1, balances.reads)
+		assert.ElementsMatch(t, []uuid.UUID{unassigned.ID, processing.ID}, assigned)
+		assert.Equal(t, []uuid.UUID{validated.ID}, orderService.settled)
+
+		released := client.LockPaymentOrder.GetX(ctx, processing.ID)
+		assert.Equal(t, lockpaymentorder.StatusPending, released.Status)
+		excluded, err := db.RedisClient.LRange(ctx, fmt.Sprintf("order_exclude_list_%s", processing.ID), 0, -1).Result()
+		assert.NoError(t, err)
+		assert.Equal(t, []string{fixture.providers[0].ID}, excluded)
+
+		assert.Equal(t, lockpaymentorder.StatusProcessing, client.LockPaymentOrder.GetX(ctx, fresh.ID).Status)
+	})
+
+	t.Run("waits out the threshold between remediations", func(t *testing.T) {
+		assert.NoError(t, WatchStuckOrders(ctx, balances, assign))
+
+		assert.Len(t, orderService.created, 1)
+		assert.Len(t, assigned, 2)
+		assert.Len(t, orderService.settled, 1)
+	})
+
+	t.Run("escalates orders whose deposit is gone", func(t *testing.T) {
+		mr.FastForward(time.Hour)
+		balances.balance = decimal.Zero
+
+		assert.NoError(t, WatchStuckOrders(ctx, balances, assign))
+		assert.Len(t, orderService.created, 1)
+		assert.Contains(t, escalation(WatchdogStatePending, pending.ID), errDepositMissing.Error())
+		assert.Len(t, orderService.settled, 2)
+	})
+
+	t.Run("escalates orders still stuck after the last remediation", func(t *testing.T) {
+		mr.FastForward(time.Hour)
+		assert.NoError(t, WatchStuckOrders(ctx, balances, assign))
+		assert.Len(t, orderService.settled, 3)
+		assert.Empty(t, escalation(WatchdogStateValidated, validated.ID))
+
+		mr.FastForward(time.Hour)
+		assert.NoError(t, WatchStuckOrders(ctx, balances, assign))
+		assert.Len(t, orderService.settled, 3)
+		assert.Equal(t, "still stuck after 3 remediations", escalation(WatchdogStateValidated, validated.ID))
+	})
+}
@@ -0,0 +1,145 @@
+package compliance
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	fastshot "github.com/opus-domini/fast-shot"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/utils"
+)
+
+// Result is the outcome of screening an address
+type Result struct {
+	Flagged bool
+	// Reason says why the address was flagged
+	Reason string
+}
+
+// Screener screens the address a deposit was sent from
+type Screener interface {
+	Screen(ctx context.Context, network string, address string) (Result, error)
+}
+
+// New returns the screener of the configured provider. The denylist is checked by every provider
+// but "none"
+func New(conf *config.ComplianceConfiguration) Screener {
+	switch strings.ToLower(conf.Provider) {
+	case "none", "":
+		return screeners{}
+	case "http":
+		return screeners{&DenylistScreener{}, NewRiskAPIScreener(conf)}
+	default:
+		return screeners{&DenylistScreener{}}
+	}
+}
+
+// screeners flags an address any of its screeners flags, checking them in order
+type screeners []Screener
+
+// Screen screens an address with each screener until one flags it
+func (s screeners) Screen(ctx context.Context, network string, address string) (Result, error) {
+	for _, screener := range s {
+		result, err := screener.Screen(ctx, network, address)
+		if err != nil || result.Flagged {
+			return result, err
+		}
+	}
+	return Result{}, nil
+}
+
+// DenylistScreener flags addresses in the denylisted addresses table
+type DenylistScreener struct{}
+
+// Screen flags an address denylisted on the network, checking the cached denylist
+func (s *DenylistScreener) Screen(ctx context.Context, network string, address string) (Result, error) {
+	entry, err := DefaultDenylist().Lookup(ctx, network, address)
+	if err != nil {
+		return Result{}, fmt.Errorf("DenylistScreener.Screen: %w", err)
+	}
+	if entry == nil {
+		return Result{}, nil
+	}
+
+	reason := "address is denylisted"
+	if entry.Reason != "" {
+		reason = fmt.Sprintf("%s: %s", reason, entry.Reason)
+	}
+	return Result{Flagged: true, Reason: reason}, nil
+}
+
+// riskLevels are the risk levels of the risk API, in increasing order
+var riskLevels = []string{"low", "medium", "high", "severe"}
+
+// RiskAPIScreener flags addresses a Chainalysis- or TRM-style risk API rates at or above the
+// configured risk level. The API is called with GET {url}/{address}, authenticated by the Token
+// header, and answers with the "risk" level of the address and a "riskReason"
+type RiskAPIScreener struct {
+	url       string
+	apiKey    string
+	threshold int
+	timeout   time.Duration
+}
+
+// NewRiskAPIScreener creates a screener asking the configured risk API
+func NewRiskAPIScreener(conf *config.ComplianceConfiguration) *RiskAPIScreener {
+	return &RiskAPIScreener{
+		url:       strings.TrimSuffix(conf.APIURL, "/"),
+		apiKey:    conf.APIKey,
+		threshold: riskLevel(conf.RiskThreshold),
+		timeout:   conf.Timeout,
+	}
+}
+
+// Screen asks the risk API for the risk of an address
+func (s *RiskAPIScreener) Screen(ctx context.Context, network string, address string) (Result, error) {
+	if s.url == "" {
+		return Result{}, fmt.Errorf("RiskAPIScreener.Screen: no risk API URL configured")
+	}
+
+	res, err := fastshot.NewClient(s.url).
+		Config().SetTimeout(s.timeout).
+		Header().AddAll(map[string]string{
+		"Token":  s.apiKey,
+		"Accept": "application/json",
+	}).
+		Build().GET("/" + address).
+		Context().Set(ctx).
+		Send()
+	if err != nil {
+		return Result{}, fmt.Errorf("RiskAPIScreener.Screen: %w", err)
+	}
+
+	data, err := utils.ParseJSONResponse(res.RawResponse)
+	if err != nil {
+		return Result{}, fmt.Errorf("RiskAPIScreener.Screen: %w", err)
+	}
+
+	risk, _ := data["risk"].(string)
+	if risk == "" {
+		return Result{}, fmt.Errorf("RiskAPIScreener.Screen: response has no risk level")
+	}
+	if riskLevel(risk) < s.threshold {
+		return Result{}, nil
+	}
+
+	reason := fmt.Sprintf("%s risk", strings.ToLower(risk))
+	if riskReason, ok := data["riskReason"].(string); ok && riskReason != "" {
+		reason = fmt.Sprintf("%s: %s", reason, riskReason)
+	}
+	return Result{Flagged: true, Reason: reason}, nil
+}
+
+// riskLevel returns the position of a risk level among the levels, treating unknown levels as
+// the highest
+func riskLevel(risk string) int {
+	for i, level := range riskLevels {
+		if strings.EqualFold(level, risk) {
+			return i
+		}
+	}
+	return len(riskLevels) - 1
+}
@@ -0,0 +1,52 @@
+package compliance
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRiskAPIScreener(t *testing.T) {
+	risks := map[string]string{
+		"/0xsevere": `{"risk": "Severe", "riskReason": "Sanctioned entity"}`,
+		"/0xmedium": `{"risk": "Medium"}`,
+		"/0xnorisk": `{}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "secret", r.Header.Get("Token"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(risks[r.URL.Path]))
+	}))
+	defer server.Close()
+
+	screener := NewRiskAPIScreener(&config.ComplianceConfiguration{
+		APIURL:        server.URL,
+		APIKey:        "secret",
+		RiskThreshold: "high",
+		Timeout:       5 * time.Second,
+	})
+	ctx := context.Background()
+
+	t.Run("should flag addresses at or above the threshold", func(t *testing.T) {
+		result, err := screener.Screen(ctx, "base", "0xsevere")
+		assert.NoError(t, err)
+		assert.True(t, result.Flagged)
+		assert.Equal(t, "severe risk: Sanctioned entity", result.Reason)
+	})
+
+	t.Run("should let addresses below the threshold through", func(t *testing.T) {
+		result, err := screener.Screen(ctx, "base", "0xmedium")
+		assert.NoError(t, err)
+		assert.False(t, result.Flagged)
+	})
+
+	t.Run("should fail on responses without a risk level", func(t *testing.T) {
+		_, err := screener.Screen(ctx, "base", "0xnorisk")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,122 @@
+package compliance
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+)
+
+// DenylistVersionKey is the Redis key bumped whenever the denylist changes. Instances watch its
+// keyspace events to drop their cached copy of the denylist
+const DenylistVersionKey = "denylist_version"
+
+// Denylist is an in-memory copy of the denylisted addresses table. It is loaded on first use and
+// reloaded once it is invalidated or older than the configured cache TTL
+type Denylist struct {
+	mu       sync.RWMutex
+	entries  map[string][]*ent.DenylistedAddress
+	loadedAt time.Time
+}
+
+var defaultDenylist = &Denylist{}
+
+// DefaultDenylist returns the denylist shared by the instance
+func DefaultDenylist() *Denylist {
+	return defaultDenylist
+}
+
+// Lookup returns the entry denylisting an address on a network, or nil if it isn't denylisted.
+// Addresses are matched ignoring case since EVM addresses are checksummed, entries without a
+// network apply to every network, and expired entries are ignored
+func (d *Denylist) Lookup(ctx context.Context, network string, address string) (*ent.DenylistedAddress, error) {
+	entries, err := d.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	for _, entry := range entries[strings.ToLower(address)] {
+		if entry.Network != "" && entry.Network != network {
+			continue
+		}
+		if entry.ExpiresAt != nil && !entry.ExpiresAt.After(now) {
+			continue
+		}
+		return entry, nil
+	}
+	return nil, nil
+}
+
+// Invalidate drops the cached denylist so the next lookup reloads it
+func (d *Denylist) Invalidate() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.entries = nil
+}
+
+// Watch invalidates the denylist on every message received until the channel closes or the context
+// is done. It is fed the keyspace events of DenylistVersionKey
+func (d *Denylist) Watch(ctx context.Context, messages <-chan *redis.Message) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case _, ok := <-messages:
+			if !ok {
+				return
+			}
+			d.Invalidate()
+		}
+	}
+}
+
+// load returns the cached entries by lowercased address, reloading them if they are stale
+func (d *Denylist) load(ctx context.Context) (map[string][]*ent.DenylistedAddress, error) {
+	ttl := config.ComplianceConfig().DenylistCacheTTL
+
+	d.mu.RLock()
+	entries, loadedAt := d.entries, d.loadedAt
+	d.mu.RUnlock()
+	if entries != nil && time.Since(loadedAt) < ttl {
+		return entries, nil
+	}
+
+	all, err := db.Client.DenylistedAddress.Query().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Denylist.load: %w", err)
+	}
+
+	entries = make(map[string][]*ent.DenylistedAddress, len(all))
+	for _, entry := range all {
+		address := strings.ToLower(entry.Address)
+		entries[address] = append(entries[address], entry)
+	}
+
+	d.mu.Lock()
+	d.entries = entries
+	d.loadedAt = time.Now()
+	d.mu.Unlock()
+
+	return entries, nil
+}
+
+// NotifyDenylistChanged invalidates the denylist of this instance and signals the other instances
+// to invalidate theirs
+func NotifyDenylistChanged(ctx context.Context) {
+	defaultDenylist.Invalidate()
+
+	if err := db.RedisClient.Incr(ctx, DenylistVersionKey).Err(); err != nil {
+		logger.WithFields(logger.Fields{
+			"Error": fmt.Sprintf("%v", err),
+		}).Errorf("Failed to signal denylist change")
+	}
+}
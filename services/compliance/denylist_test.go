@@ -0,0 +1,102 @@
+package compliance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/alicebob/miniredis/v2"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDenylist(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:denylist?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	mr, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer mr.Close()
+	db.RedisClient = redis.NewClient(&redis.Options{Addr: mr.Addr()})
+
+	ctx := context.Background()
+	denylist := DefaultDenylist()
+	denylist.Invalidate()
+
+	client.DenylistedAddress.Create().
+		SetAddress("0x52908400098527886E0F7030069857D2E4169EE7").
+		SetReason("sanctioned").
+		SaveX(ctx)
+	client.DenylistedAddress.Create().
+		SetAddress("0xbase").
+		SetNetwork("base").
+		SaveX(ctx)
+	client.DenylistedAddress.Create().
+		SetAddress("0xexpired").
+		SetExpiresAt(time.Now().Add(-time.Minute)).
+		SaveX(ctx)
+
+	t.Run("should match addresses ignoring case", func(t *testing.T) {
+		entry, err := denylist.Lookup(ctx, "base", "0x52908400098527886e0f7030069857d2e4169ee7")
+		assert.NoError(t, err)
+		assert.NotNil(t, entry)
+		assert.Equal(t, "sanctioned", entry.Reason)
+	})
+
+	t.Run("should only match entries of the network", func(t *testing.T) {
+		entry, err := denylist.Lookup(ctx, "base", "0xbase")
+		assert.NoError(t, err)
+		assert.NotNil(t, entry)
+
+		entry, err = denylist.Lookup(ctx, "arbitrum-one", "0xbase")
+		assert.NoError(t, err)
+		assert.Nil(t, entry)
+	})
+
+	t.Run("should ignore expired entries", func(t *testing.T) {
+		entry, err := denylist.Lookup(ctx, "base", "0xexpired")
+		assert.NoError(t, err)
+		assert.Nil(t, entry)
+	})
+
+	t.Run("should serve lookups from the cache until it is invalidated", func(t *testing.T) {
+		client.DenylistedAddress.Create().
+			SetAddress("0xnew").
+			SaveX(ctx)
+
+		entry, err := denylist.Lookup(ctx, "base", "0xnew")
+		assert.NoError(t, err)
+		assert.Nil(t, entry)
+
+		NotifyDenylistChanged(ctx)
+		version, err := db.RedisClient.Get(ctx, DenylistVersionKey).Result()
+		assert.NoError(t, err)
+		assert.Equal(t, "1", version)
+
+		entry, err = denylist.Lookup(ctx, "base", "0xnew")
+		assert.NoError(t, err)
+		assert.NotNil(t, entry)
+	})
+
+	t.Run("should invalidate on watched events", func(t *testing.T) {
+		client.DenylistedAddress.Delete().ExecX(ctx)
+
+		messages := make(chan *redis.Message)
+		done := make(chan struct{})
+		go func() {
+			denylist.Watch(ctx, messages)
+			close(done)
+		}()
+		messages <- &redis.Message{Channel: "__keyspace@0__:" + DenylistVersionKey, Payload: "incrby"}
+		close(messages)
+		<-done
+
+		entry, err := denylist.Lookup(ctx, "base", "0xnew")
+		assert.NoError(t, err)
+		assert.Nil(t, entry)
+	})
+}
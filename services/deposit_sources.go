@@ -0,0 +1,69 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	networkent "github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/storage"
+)
+
+// DepositSources are the ways deposits to the receive addresses of a network are detected
+type DepositSources struct {
+	Webhooks  bool
+	Websocket bool
+	Polling   bool
+}
+
+// IsGatewayRefund reports whether a transfer to a receive address came from the gateway contract.
+// Those are refunds of orders, not deposits, so no deposit source credits them
+func IsGatewayRefund(network *ent.Network, from string) bool {
+	return strings.EqualFold(from, network.GatewayContractAddress)
+}
+
+// DepositSourcesFor returns how deposits on a network are detected: its webhooks_enabled,
+// websocket_enabled and polling_enabled when set, the deployment defaults otherwise. The WebSocket
+// subscription also needs an EVM network with a WSS endpoint
+func DepositSourcesFor(network *ent.Network) DepositSources {
+	conf := config.DepositDetectionConfig()
+	sources := DepositSources{
+		Webhooks:  conf.Webhooks,
+		Websocket: conf.Websocket,
+		Polling:   conf.Polling,
+	}
+
+	if network.WebhooksEnabled != nil {
+		sources.Webhooks = *network.WebhooksEnabled
+	}
+	if network.WebsocketEnabled != nil {
+		sources.Websocket = *network.WebsocketEnabled
+	}
+	if network.PollingEnabled != nil {
+		sources.Polling = *network.PollingEnabled
+	}
+
+	if network.WssEndpoint == "" ||
+		network.NetworkType != networkent.NetworkTypeEvm ||
+		strings.HasPrefix(network.Identifier, "tron") {
+		sources.Websocket = false
+	}
+
+	return sources
+}
+
+// DepositSources returns the deposit sources of every network, by network identifier
+func (sm *ServiceManager) DepositSources(ctx context.Context) (map[string]DepositSources, error) {
+	networks, err := storage.Client.Network.Query().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch networks: %w", err)
+	}
+
+	sources := make(map[string]DepositSources, len(networks))
+	for _, network := range networks {
+		sources[network.Identifier] = DepositSourcesFor(network)
+	}
+	return sources, nil
+}
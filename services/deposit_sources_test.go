@@ -0,0 +1,96 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	networkent "github.com/NEDA-LABS/stablenode/ent/network"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/test"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDepositSources(t *testing.T) {
+	for key, value := range map[string]interface{}{
+		"ENABLE_WEBHOOKS":          true,
+		"ENABLE_WEBSOCKET_INDEXER": true,
+		"ENABLE_POLLING_FALLBACK":  false,
+	} {
+		previous := viper.Get(key)
+		viper.Set(key, value)
+		defer viper.Set(key, previous)
+	}
+
+	enabled, disabled := true, false
+
+	t.Run("follows the deployment defaults", func(t *testing.T) {
+		sources := DepositSourcesFor(&ent.Network{
+			Identifier:  "base",
+			NetworkType: networkent.NetworkTypeEvm,
+			WssEndpoint: "wss://base.example",
+		})
+		assert.Equal(t, DepositSources{Webhooks: true, Websocket: true, Polling: false}, sources)
+	})
+
+	t.Run("applies the network's own settings", func(t *testing.T) {
+		sources := DepositSourcesFor(&ent.Network{
+			Identifier:       "base",
+			NetworkType:      networkent.NetworkTypeEvm,
+			WssEndpoint:      "wss://base.example",
+			WebhooksEnabled:  &disabled,
+			WebsocketEnabled: &disabled,
+			PollingEnabled:   &enabled,
+		})
+		assert.Equal(t, DepositSources{Webhooks: false, Websocket: false, Polling: true}, sources)
+	})
+
+	t.Run("only subscribes to EVM networks with a WSS endpoint", func(t *testing.T) {
+		assert.False(t, DepositSourcesFor(&ent.Network{
+			Identifier:       "arbitrum-one",
+			NetworkType:      networkent.NetworkTypeEvm,
+			WebsocketEnabled: &enabled,
+		}).Websocket)
+		assert.False(t, DepositSourcesFor(&ent.Network{
+			Identifier:       "solana",
+			NetworkType:      networkent.NetworkTypeSolana,
+			WssEndpoint:      "wss://solana.example",
+			WebsocketEnabled: &enabled,
+		}).Websocket)
+	})
+
+	t.Run("resolves every network", func(t *testing.T) {
+		client := enttest.Open(t, "sqlite3", "file:depositsources?mode=memory&_fk=1")
+		defer client.Close()
+		db.Client = client
+
+		ctx := context.Background()
+		for _, network := range []struct {
+			identifier string
+			chainID    int64
+			polling    *bool
+		}{
+			{"base", 8453, nil},
+			{"polygon", 137, &enabled},
+		} {
+			created, err := test.CreateTestNetwork(map[string]interface{}{
+				"identifier": network.identifier,
+				"chainID":    network.chainID,
+				"networkRPC": "https://rpc.example",
+				"is_testnet": false,
+			})
+			require.NoError(t, err)
+			created.Update().SetNillablePollingEnabled(network.polling).ExecX(ctx)
+		}
+
+		sources, err := (&ServiceManager{}).DepositSources(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]DepositSources{
+			"base":    {Webhooks: true},
+			"polygon": {Webhooks: true, Polling: true},
+		}, sources)
+	})
+}
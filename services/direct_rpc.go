@@ -0,0 +1,93 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// directTransactionStatus gets the status of a transaction sent over the network's RPC endpoints, in
+// the format of the other services: PENDING until it is mined, then CONFIRMED or FAILED
+func (sm *ServiceManager) directTransactionStatus(ctx context.Context, txHash string, chainID int64) (map[string]interface{}, error) {
+	network, err := sm.networkByChainID(ctx, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	var receipt *ethtypes.Receipt
+	err = GetRPCManager().Do(ctx, network, func(endpoint string) error {
+		client, err := ethclient.DialContext(ctx, endpoint)
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		receipt, err = client.TransactionReceipt(ctx, common.HexToHash(txHash))
+		return err
+	})
+	if errors.Is(err, ethereum.NotFound) {
+		return map[string]interface{}{
+			"id": txHash,
+			"executionResult": map[string]interface{}{
+				"status": "PENDING",
+				"error":  nil,
+			},
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction receipt: %w", err)
+	}
+
+	result := map[string]interface{}{
+		"status": "CONFIRMED",
+		"error":  nil,
+	}
+	if receipt.Status == ethtypes.ReceiptStatusFailed {
+		result = map[string]interface{}{
+			"status": "FAILED",
+			"error":  "transaction reverted",
+		}
+	}
+
+	return map[string]interface{}{
+		"id":              txHash,
+		"transactionHash": receipt.TxHash.Hex(),
+		"blockNumber":     receipt.BlockNumber.Int64(),
+		"executionResult": result,
+	}, nil
+}
+
+// waitForDirectTransaction waits for a transaction sent over the network's RPC endpoints to be mined
+func (sm *ServiceManager) waitForDirectTransaction(ctx context.Context, txHash string, chainID int64, timeout time.Duration) (map[string]interface{}, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		status, err := sm.directTransactionStatus(ctx, txHash, chainID)
+		if err != nil {
+			return nil, err
+		}
+
+		result := status["executionResult"].(map[string]interface{})
+		switch result["status"] {
+		case "CONFIRMED":
+			return status, nil
+		case "FAILED":
+			return nil, fmt.Errorf("transaction failed: %v", result["error"])
+		}
+
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("transaction mining timeout after %v", timeout)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(time.Second):
+		}
+	}
+}
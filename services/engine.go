@@ -3,8 +3,10 @@ package services
 import (
 	"context"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"math/big"
+	"net/http"
 	"regexp"
 	"strconv"
 	"strings"
@@ -18,13 +20,16 @@ import (
 	"github.com/NEDA-LABS/stablenode/ent"
 	networkent "github.com/NEDA-LABS/stablenode/ent/network"
 	"github.com/NEDA-LABS/stablenode/ent/paymentwebhook"
+	"github.com/NEDA-LABS/stablenode/services/events"
 	"github.com/NEDA-LABS/stablenode/storage"
 	types "github.com/NEDA-LABS/stablenode/types"
 	"github.com/NEDA-LABS/stablenode/utils"
+	"github.com/NEDA-LABS/stablenode/utils/breaker"
 	"github.com/NEDA-LABS/stablenode/utils/logger"
 )
 
-// EngineService provides functionality for interacting with the engine/thirdweb API
+// EngineService provides functionality for interacting with the engine/thirdweb API.
+// Calls go through a circuit breaker per host, so an Engine or Insight outage fails them fast
 type EngineService struct {
 	config *config.EngineConfiguration
 }
@@ -40,6 +45,7 @@ func NewEngineService() *EngineService {
 func (s *EngineService) CreateServerWallet(ctx context.Context, label string) (string, error) {
 	res, err := fastshot.NewClient(s.config.BaseURL).
 		Config().SetTimeout(30 * time.Second).
+		Config().SetCustomTransport(breaker.NewTransport("engine", http.DefaultTransport)).
 		Header().AddAll(map[string]string{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
@@ -67,6 +73,7 @@ func (s *EngineService) GetLatestBlock(ctx context.Context, chainID int64) (int6
 		// Try ThirdWeb first for all networks
 		res, err := fastshot.NewClient(fmt.Sprintf("https://%d.insight.thirdweb.com", chainID)).
 			Config().SetTimeout(60 * time.Second).
+			Config().SetCustomTransport(breaker.NewTransport("engine", http.DefaultTransport)).
 			Header().AddAll(map[string]string{
 			"Content-Type": "application/json",
 			"X-Secret-Key": s.config.ThirdwebSecretKey,
@@ -126,6 +133,7 @@ func (s *EngineService) GetLatestBlock(ctx context.Context, chainID int64) (int6
 func (s *EngineService) GetContractEvents(ctx context.Context, chainID int64, contractAddress string, payload map[string]string) ([]interface{}, error) {
 	res, err := fastshot.NewClient(fmt.Sprintf("https://%d.insight.thirdweb.com", chainID)).
 		Config().SetTimeout(60 * time.Second).
+		Config().SetCustomTransport(breaker.NewTransport("engine", http.DefaultTransport)).
 		Header().AddAll(map[string]string{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
@@ -148,10 +156,32 @@ func (s *EngineService) GetContractEvents(ctx context.Context, chainID int64, co
 	return data["data"].([]interface{}), nil
 }
 
+// decodeInsightEvents decodes the events returned by Thirdweb Insight from their raw logs, through the
+// event registry like the logs fetched over RPC. Events the registry doesn't know are skipped
+func decodeInsightEvents(insightEvents []interface{}) ([]events.Event, error) {
+	encoded, err := json.Marshal(insightEvents)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode Insight events: %w", err)
+	}
+
+	var eventData []types.ThirdwebEventData
+	if err := json.Unmarshal(encoded, &eventData); err != nil {
+		return nil, fmt.Errorf("failed to parse Insight events: %w", err)
+	}
+
+	logs := make([]ethereumtypes.Log, 0, len(eventData))
+	for _, data := range eventData {
+		logs = append(logs, data.Log())
+	}
+
+	return events.Default().DecodeLogs(logs)
+}
+
 // SendTransactionBatch sends a batch of transactions
 func (s *EngineService) SendTransactionBatch(ctx context.Context, chainID int64, address string, txPayload []map[string]interface{}) (queueID string, err error) {
 	res, err := fastshot.NewClient(s.config.BaseURL).
 		Config().SetTimeout(30 * time.Second).
+		Config().SetCustomTransport(breaker.NewTransport("engine", http.DefaultTransport)).
 		Header().AddAll(map[string]string{
 		"Accept":               "application/json",
 		"Content-Type":         "application/json",
@@ -184,6 +214,7 @@ func (s *EngineService) SendTransactionBatch(ctx context.Context, chainID int64,
 func (s *EngineService) GetTransactionStatus(ctx context.Context, queueId string) (result map[string]interface{}, err error) {
 	res, err := fastshot.NewClient(s.config.BaseURL).
 		Config().SetTimeout(60 * time.Second).
+		Config().SetCustomTransport(breaker.NewTransport("engine", http.DefaultTransport)).
 		Header().AddAll(map[string]string{
 		"Accept":               "application/json",
 		"Content-Type":         "application/json",
@@ -278,6 +309,19 @@ func (s *EngineService) WaitForTransactionMined(ctx context.Context, queueId str
 
 // CreateTransferWebhook creates webhooks to listen to transfer events to a specific address on a specific chain
 func (s *EngineService) CreateTransferWebhook(ctx context.Context, chainID int64, contractAddress string, toAddress string, orderID string) (string, string, error) {
+	return s.createTransferWebhook(ctx, chainID, contractAddress, toAddress, orderID)
+}
+
+// CreateBatchTransferWebhook creates a single webhook listening to transfer events to any of the given
+// addresses on a chain, so a batch of orders is registered in one call. Its payment webhook records
+// share the webhook ID, so they are released with ReleaseOrderWebhook
+func (s *EngineService) CreateBatchTransferWebhook(ctx context.Context, chainID int64, contractAddress string, toAddresses []string, name string) (string, string, error) {
+	return s.createTransferWebhook(ctx, chainID, contractAddress, toAddresses, name)
+}
+
+// createTransferWebhook creates a webhook listening to transfer events of a token to the given address
+// or addresses
+func (s *EngineService) createTransferWebhook(ctx context.Context, chainID int64, contractAddress string, to interface{}, name string) (string, string, error) {
 	// Check if this is BNB Smart Chain (chain ID 56) or Lisk (chain ID 1135) - not supported by Thirdweb Insight
 	if chainID == 56 || chainID == 1135 {
 		return "", "", fmt.Errorf("webhook creation not supported for BNB Smart Chain (chain ID 56) or Lisk (chain ID 1135) via Thirdweb API")
@@ -286,7 +330,7 @@ func (s *EngineService) CreateTransferWebhook(ctx context.Context, chainID int64
 	webhookCallbackURL := fmt.Sprintf("%s/v1/insight/webhook", config.ServerConfig().ServerURL)
 
 	webhookPayload := map[string]interface{}{
-		"name":        orderID,
+		"name":        name,
 		"webhook_url": webhookCallbackURL,
 		"filters": map[string]interface{}{
 			"v1.events": map[string]interface{}{
@@ -297,7 +341,7 @@ func (s *EngineService) CreateTransferWebhook(ctx context.Context, chainID int64
 						"sig_hash": "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef", // Transfer event signature
 						"abi":      "{\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"}",
 						"params": map[string]interface{}{
-							"to": toAddress, // Filter for transfers to the specific address
+							"to": to, // Filter for transfers to the specific address or addresses
 						},
 					},
 				},
@@ -307,6 +351,7 @@ func (s *EngineService) CreateTransferWebhook(ctx context.Context, chainID int64
 
 	res, err := fastshot.NewClient(fmt.Sprintf("https://%d.insight.thirdweb.com", chainID)).
 		Config().SetTimeout(30 * time.Second).
+		Config().SetCustomTransport(breaker.NewTransport("engine", http.DefaultTransport)).
 		Header().AddAll(map[string]string{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
@@ -345,6 +390,7 @@ func (s *EngineService) CreateTransferWebhook(ctx context.Context, chainID int64
 func (s *EngineService) DeleteWebhook(ctx context.Context, webhookID string) error {
 	res, err := fastshot.NewClient("https://insight.thirdweb.com").
 		Config().SetTimeout(30 * time.Second).
+		Config().SetCustomTransport(breaker.NewTransport("engine", http.DefaultTransport)).
 		Header().AddAll(map[string]string{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
@@ -385,6 +431,31 @@ func (s *EngineService) DeleteWebhookAndRecord(ctx context.Context, webhookID st
 	return nil
 }
 
+// ReleaseOrderWebhook removes the payment webhook record of an order, and deletes the webhook from
+// thirdweb once no other order shares it
+func (s *EngineService) ReleaseOrderWebhook(ctx context.Context, webhook *ent.PaymentWebhook) error {
+	shared, err := storage.Client.PaymentWebhook.
+		Query().
+		Where(
+			paymentwebhook.WebhookIDEQ(webhook.WebhookID),
+			paymentwebhook.IDNEQ(webhook.ID),
+		).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check webhook usage: %w", err)
+	}
+
+	if !shared {
+		return s.DeleteWebhookAndRecord(ctx, webhook.WebhookID)
+	}
+
+	if err := storage.Client.PaymentWebhook.DeleteOne(webhook).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete payment webhook record: %w", err)
+	}
+
+	return nil
+}
+
 // WebhookInfo represents a webhook from thirdweb API
 type WebhookInfo struct {
 	ID            string                 `json:"id"`
@@ -413,6 +484,7 @@ type WebhookListResponse struct {
 func (s *EngineService) GetWebhookByID(ctx context.Context, webhookID string, chainID int64) (*WebhookInfo, error) {
 	res, err := fastshot.NewClient(fmt.Sprintf("https://%d.insight.thirdweb.com", chainID)).
 		Config().SetTimeout(60 * time.Second).
+		Config().SetCustomTransport(breaker.NewTransport("engine", http.DefaultTransport)).
 		Header().AddAll(map[string]string{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
@@ -456,6 +528,7 @@ func (s *EngineService) GetWebhookByID(ctx context.Context, webhookID string, ch
 func (s *EngineService) UpdateWebhook(ctx context.Context, webhookID string, webhookPayload map[string]interface{}) error {
 	res, err := fastshot.NewClient("https://insight.thirdweb.com").
 		Config().SetTimeout(30 * time.Second).
+		Config().SetCustomTransport(breaker.NewTransport("engine", http.DefaultTransport)).
 		Header().AddAll(map[string]string{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
@@ -494,12 +567,25 @@ func (s *EngineService) CreateGatewayWebhook() error {
 	// Fetch networks for the current environment
 	networks, err := storage.Client.Network.
 		Query().
-		Where(networkent.ChainIDNotIn(56, 1135)).
+		Where(
+			networkent.ChainIDNotIn(56, 1135),
+			networkent.GenesisMismatchEQ(false),
+			networkent.IsEnabledEQ(true),
+		).
 		All(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to fetch networks: %w", err)
 	}
 
+	// Networks with webhooks disabled are left to the gateway event indexer
+	withWebhooks := networks[:0]
+	for _, network := range networks {
+		if DepositSourcesFor(network).Webhooks {
+			withWebhooks = append(withWebhooks, network)
+		}
+	}
+	networks = withWebhooks
+
 	// Event signatures for gateway contract events (using hash-like signatures from EVM indexer)
 	eventSignatures := []map[string]interface{}{
 		{
@@ -684,6 +770,7 @@ func (s *EngineService) CreateGatewayWebhook() error {
 
 	res, err := fastshot.NewClient("https://insight.thirdweb.com").
 		Config().SetTimeout(30 * time.Second).
+		Config().SetCustomTransport(breaker.NewTransport("engine", http.DefaultTransport)).
 		Header().AddAll(map[string]string{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
@@ -758,8 +845,9 @@ func (s *EngineService) CreateGatewayWebhook() error {
 }
 
 // GetContractEventsRPC fetches contract events using RPC for networks not supported by Thirdweb Insight
-// It fetches all events and filters for specified event signatures (gateway events or transfer events)
-func (s *EngineService) GetContractEventsRPC(ctx context.Context, rpcEndpoint string, contractAddress string, fromBlock int64, toBlock int64, topics []string, txHash string) ([]interface{}, error) {
+// It keeps the events the registry has for the contract of the first topic's signature (transfer events),
+// or the Gateway events by default, decoded into typed events
+func (s *EngineService) GetContractEventsRPC(ctx context.Context, rpcEndpoint string, contractAddress string, fromBlock int64, toBlock int64, topics []string, txHash string) ([]events.Event, error) {
 	// Build full RPC URL with API key from environment
 	fullRPCURL := utils.BuildRPCURL(rpcEndpoint)
 	
@@ -771,19 +859,21 @@ func (s *EngineService) GetContractEventsRPC(ctx context.Context, rpcEndpoint st
 
 	var logs []ethereumtypes.Log
 
-	// Determine which event signatures to filter for based on topics
-	var eventSignatures []string
-	if len(topics) > 0 && topics[0] == utils.TransferEventSignature {
-		// If transfer event signature is provided, filter for transfer events
-		eventSignatures = []string{utils.TransferEventSignature}
-	} else {
-		// Default to gateway event signatures
-		eventSignatures = []string{
-			utils.OrderCreatedEventSignature,
-			utils.OrderSettledEventSignature,
-			utils.OrderRefundedEventSignature,
+	// Determine which contract's events to filter for based on topics
+	registry := events.Default()
+	contract := events.Gateway
+	if len(topics) > 0 {
+		if registered, ok := registry.Contract(common.HexToHash(topics[0])); ok {
+			contract = registered
 		}
 	}
+	isContractEvent := func(log *ethereumtypes.Log) bool {
+		if len(log.Topics) == 0 {
+			return false
+		}
+		registered, ok := registry.Contract(log.Topics[0])
+		return ok && registered == contract
+	}
 
 	if txHash != "" {
 		// If transaction hash is provided, get the specific transaction receipt
@@ -792,27 +882,18 @@ func (s *EngineService) GetContractEventsRPC(ctx context.Context, rpcEndpoint st
 			return nil, fmt.Errorf("failed to get transaction receipt: %w", err)
 		}
 
-		// Filter logs from the receipt that match the specified event signatures
+		// Filter logs from the receipt that match the contract's events
 		for _, log := range receipt.Logs {
-			if log.Address == common.HexToAddress(contractAddress) {
-				// Check if this log matches any of our event signatures
-				if len(log.Topics) > 0 {
-					eventSignature := log.Topics[0].Hex()
-					for _, signature := range eventSignatures {
-						if eventSignature == signature {
-							logs = append(logs, *log)
-							break
-						}
-					}
-				}
+			if log.Address == common.HexToAddress(contractAddress) && isContractEvent(log) {
+				logs = append(logs, *log)
 			}
 		}
 	} else {
 		if fromBlock == 0 || toBlock == 0 {
 			return nil, fmt.Errorf("fromBlock and toBlock must be provided")
-		} else if fromBlock-toBlock > 100 && eventSignatures[0] == utils.TransferEventSignature {
+		} else if fromBlock-toBlock > 100 && contract == events.ERC20 {
 			return nil, fmt.Errorf("fromBlock and toBlock must be within 100 blocks for transfer events")
-		} else if fromBlock-toBlock > 1000 && eventSignatures[0] != utils.TransferEventSignature {
+		} else if fromBlock-toBlock > 1000 && contract != events.ERC20 {
 			return nil, fmt.Errorf("fromBlock and toBlock must be within 1000 blocks for gateway events")
 		}
 
@@ -837,47 +918,20 @@ func (s *EngineService) GetContractEventsRPC(ctx context.Context, rpcEndpoint st
 			return nil, fmt.Errorf("failed to get logs: %w", err2)
 		}
 
-		// Filter for the specified event signatures
+		// Filter for the contract's events
 		for _, log := range allLogs {
-			if len(log.Topics) > 0 {
-				eventSignature := log.Topics[0].Hex()
-				for _, signature := range eventSignatures {
-					if eventSignature == signature {
-						logs = append(logs, log)
-						break
-					}
-				}
+			if isContractEvent(&log) {
+				logs = append(logs, log)
 			}
 		}
 	}
 
-	// Convert logs to the same format as Thirdweb Insight
-	var events []interface{}
-	for _, log := range logs {
-		event := map[string]interface{}{
-			"block_number":     float64(log.BlockNumber),
-			"transaction_hash": log.TxHash.Hex(),
-			"log_index":        float64(log.Index),
-			"address":          log.Address.Hex(),
-			"topics":           log.Topics,
-			"data":             log.Data,
-			"decoded": map[string]interface{}{
-				"indexed_params":     make(map[string]interface{}),
-				"non_indexed_params": make(map[string]interface{}),
-			},
-		}
-		events = append(events, event)
-	}
-
-	// Decode events based on their signatures
-	if len(events) > 0 {
-		err = utils.ProcessRPCEventsBySignature(events)
-		if err != nil {
-			return nil, fmt.Errorf("failed to process RPC events: %w", err)
-		}
+	decoded, err := registry.DecodeLogs(logs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode events: %w", err)
 	}
 
-	return events, nil
+	return decoded, nil
 }
 
 // GetAddressTransactionHistory fetches transaction history for any address from thirdweb insight API
@@ -902,6 +956,7 @@ func (s *EngineService) GetAddressTransactionHistory(ctx context.Context, chainI
 
 	res, err := fastshot.NewClient(fmt.Sprintf("https://%d.insight.thirdweb.com", chainID)).
 		Config().SetTimeout(60 * time.Second).
+		Config().SetCustomTransport(breaker.NewTransport("engine", http.DefaultTransport)).
 		Header().AddAll(map[string]string{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
@@ -932,18 +987,25 @@ func (s *EngineService) GetAddressTransactionHistory(ctx context.Context, chainI
 }
 
 // GetContractEventsWithFallback tries RPC first and falls back to ThirdWeb if RPC fails
-func (s *EngineService) GetContractEventsWithFallback(ctx context.Context, network *ent.Network, contractAddress string, fromBlock int64, toBlock int64, topics []string, txHash string, eventPayload map[string]string) ([]interface{}, error) {
-	// Try RPC first (BuildRPCURL is called inside GetContractEventsRPC)
-	events, rpcErr := s.GetContractEventsRPC(ctx, network.RPCEndpoint, contractAddress, fromBlock, toBlock, topics, txHash)
+func (s *EngineService) GetContractEventsWithFallback(ctx context.Context, network *ent.Network, contractAddress string, fromBlock int64, toBlock int64, topics []string, txHash string, eventPayload map[string]string) ([]events.Event, error) {
+	// Try RPC first, over the network's fallback endpoints (BuildRPCURL is called inside GetContractEventsRPC)
+	var decoded []events.Event
+	rpcErr := GetRPCManager().Do(ctx, network, func(endpoint string) (err error) {
+		decoded, err = s.GetContractEventsRPC(ctx, endpoint, contractAddress, fromBlock, toBlock, topics, txHash)
+		return err
+	})
 	if rpcErr == nil {
-		return events, nil
+		return decoded, nil
 	}
 
 	// If RPC fails, try ThirdWeb (except for BSC and Lisk)
 	if network.ChainID != 56 && network.ChainID != 1135 {
-		events, thirdwebErr := s.GetContractEvents(ctx, network.ChainID, contractAddress, eventPayload)
+		insightEvents, thirdwebErr := s.GetContractEvents(ctx, network.ChainID, contractAddress, eventPayload)
+		if thirdwebErr == nil {
+			decoded, thirdwebErr = decodeInsightEvents(insightEvents)
+		}
 		if thirdwebErr == nil {
-			return events, nil
+			return decoded, nil
 		}
 		logger.WithFields(logger.Fields{
 			"Network":       network.Identifier,
@@ -3,6 +3,9 @@ package services
 import (
 	"encoding/json"
 	"testing"
+
+	"github.com/NEDA-LABS/stablenode/services/events"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 func TestParseUserOpErrorJSON(t *testing.T) {
@@ -75,3 +78,42 @@ func TestParseUserOpErrorJSON(t *testing.T) {
 		t.Errorf("Expected %s, got %s", expected2, result2)
 	}
 }
+
+func TestDecodeInsightEvents(t *testing.T) {
+	transferTopic := events.Topic(events.ERC20, "Transfer").Hex()
+	insightJSON := `[
+		{"block_number": 100, "transaction_hash": "0x01", "log_index": 2, "address": "0x036CbD53842c5426634e7929541eC2318f3dCF7e",
+			"topics": ["` + transferTopic + `", "0x0000000000000000000000005555555555555555555555555555555555555555", "0x0000000000000000000000003333333333333333333333333333333333333333"],
+			"data": "0x00000000000000000000000000000000000000000000000000000000000f4240"},
+		{"block_number": 100, "transaction_hash": "0x01", "log_index": 3, "address": "0x036CbD53842c5426634e7929541eC2318f3dCF7e",
+			"topics": ["0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925"], "data": "0x"}
+	]`
+
+	var insightEvents []interface{}
+	if err := json.Unmarshal([]byte(insightJSON), &insightEvents); err != nil {
+		t.Fatalf("Failed to parse Insight events: %v", err)
+	}
+
+	decoded, err := decodeInsightEvents(insightEvents)
+	if err != nil {
+		t.Fatalf("Failed to decode Insight events: %v", err)
+	}
+	// The Approval event isn't registered and is skipped
+	if len(decoded) != 1 {
+		t.Fatalf("Expected 1 event, got %d", len(decoded))
+	}
+
+	transfer, ok := decoded[0].(*events.Transfer)
+	if !ok {
+		t.Fatalf("Expected a Transfer event, got %T", decoded[0])
+	}
+	if transfer.To != common.HexToAddress("0x3333333333333333333333333333333333333333") {
+		t.Errorf("Expected the recipient 0x3333333333333333333333333333333333333333, got %s", transfer.To.Hex())
+	}
+	if transfer.Value.Int64() != 1000000 {
+		t.Errorf("Expected a value of 1000000, got %s", transfer.Value)
+	}
+	if transfer.Log().BlockNumber != 100 || transfer.Log().Index != 2 {
+		t.Errorf("Expected log 2 of block 100, got log %d of block %d", transfer.Log().Index, transfer.Log().BlockNumber)
+	}
+}
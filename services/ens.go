@@ -0,0 +1,201 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	networkent "github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+)
+
+var (
+	ensResolverSelector = crypto.Keccak256([]byte("resolver(bytes32)"))[:4]
+	ensNameSelector     = crypto.Keccak256([]byte("name(bytes32)"))[:4]
+	ensAddrSelector     = crypto.Keccak256([]byte("addr(bytes32)"))[:4]
+)
+
+// ensCaller makes the read-only calls ENS resolution needs
+type ensCaller interface {
+	CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+	Close()
+}
+
+// ENSService resolves the primary ENS names of sender addresses so investigations don't start from raw hex
+type ENSService struct {
+	conf *config.ENSConfiguration
+	dial func(ctx context.Context) (ensCaller, error)
+}
+
+// NewENSService creates a new instance of ENSService
+func NewENSService() *ENSService {
+	conf := config.ENSConfig()
+	return &ENSService{
+		conf: conf,
+		dial: func(ctx context.Context) (ensCaller, error) {
+			rpcURL := conf.RPCURL
+			if rpcURL == "" {
+				mainnet, err := storage.Client.Network.
+					Query().
+					Where(networkent.ChainIDEQ(1)).
+					Only(ctx)
+				if err != nil {
+					return nil, fmt.Errorf("no Ethereum mainnet endpoint: %w", err)
+				}
+				rpcURL = utils.BuildRPCURL(mainnet.RPCEndpoint)
+			}
+			return ethclient.DialContext(ctx, rpcURL)
+		},
+	}
+}
+
+// DisplayName returns the ENS name of an address seen on a network, or "" when resolution is
+// disabled, the network is a testnet or the address has no verified primary name
+func (s *ENSService) DisplayName(ctx context.Context, network *ent.Network, address string) string {
+	if !s.conf.Enabled || network == nil || network.IsTestnet {
+		return ""
+	}
+
+	name, err := s.LookupAddress(ctx, address)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":   fmt.Sprintf("%v", err),
+			"Address": address,
+		}).Warnf("Failed to resolve ENS name")
+		return ""
+	}
+
+	return name
+}
+
+// LookupAddress returns the primary ENS name of an address. The reverse record only counts when the
+// name resolves back to the address, so anyone can't claim a name for an address they don't own.
+// Results, including addresses without a name, are cached for ENS_CACHE_TTL
+func (s *ENSService) LookupAddress(ctx context.Context, address string) (string, error) {
+	if !common.IsHexAddress(address) {
+		return "", nil
+	}
+	addr := common.HexToAddress(address)
+	cacheKey := "ens_name_" + strings.ToLower(addr.Hex())
+
+	if storage.RedisClient != nil {
+		name, err := storage.RedisClient.Get(ctx, cacheKey).Result()
+		if err == nil {
+			return name, nil
+		}
+		if err != redis.Nil {
+			logger.Warnf("Failed to read cached ENS name of %s: %v", address, err)
+		}
+	}
+
+	client, err := s.dial(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to connect to mainnet: %w", err)
+	}
+	defer client.Close()
+
+	name, err := s.reverseResolve(ctx, client, addr)
+	if err != nil {
+		return "", err
+	}
+
+	if storage.RedisClient != nil {
+		if err := storage.RedisClient.Set(ctx, cacheKey, name, s.conf.CacheTTL).Err(); err != nil {
+			logger.Warnf("Failed to cache ENS name of %s: %v", address, err)
+		}
+	}
+
+	return name, nil
+}
+
+// reverseResolve reads the reverse record of an address and verifies it against the forward record
+func (s *ENSService) reverseResolve(ctx context.Context, client ensCaller, addr common.Address) (string, error) {
+	reverseNode := ensNamehash(strings.ToLower(addr.Hex()[2:]) + ".addr.reverse")
+
+	resolver, err := s.resolver(ctx, client, reverseNode)
+	if err != nil || resolver == (common.Address{}) {
+		return "", err
+	}
+
+	result, err := ensCall(ctx, client, resolver, ensNameSelector, reverseNode)
+	if err != nil {
+		return "", fmt.Errorf("failed to read reverse record: %w", err)
+	}
+	stringType, _ := abi.NewType("string", "", nil)
+	values, err := abi.Arguments{{Type: stringType}}.Unpack(result)
+	if err != nil || len(values) == 0 {
+		return "", nil
+	}
+	name, _ := values[0].(string)
+	if name == "" {
+		return "", nil
+	}
+
+	node := ensNamehash(name)
+	forwardResolver, err := s.resolver(ctx, client, node)
+	if err != nil || forwardResolver == (common.Address{}) {
+		return "", err
+	}
+
+	result, err = ensCall(ctx, client, forwardResolver, ensAddrSelector, node)
+	if err != nil {
+		return "", fmt.Errorf("failed to read forward record: %w", err)
+	}
+	if len(result) < 32 || common.BytesToAddress(result[:32]) != addr {
+		return "", nil
+	}
+
+	return name, nil
+}
+
+// resolver returns the resolver of an ENS node from the registry
+func (s *ENSService) resolver(ctx context.Context, client ensCaller, node common.Hash) (common.Address, error) {
+	result, err := ensCall(ctx, client, common.HexToAddress(s.conf.RegistryAddress), ensResolverSelector, node)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to read resolver: %w", err)
+	}
+	if len(result) < 32 {
+		return common.Address{}, nil
+	}
+	return common.BytesToAddress(result[:32]), nil
+}
+
+// ensCall calls a single bytes32 argument ENS method
+func ensCall(ctx context.Context, client ensCaller, to common.Address, selector []byte, node common.Hash) ([]byte, error) {
+	data := append(append([]byte{}, selector...), node.Bytes()...)
+	return client.CallContract(ctx, ethereum.CallMsg{To: &to, Data: data}, nil)
+}
+
+// ensNamehash computes the EIP-137 namehash of a name
+func ensNamehash(name string) common.Hash {
+	var node common.Hash
+	if name == "" {
+		return node
+	}
+
+	labels := strings.Split(strings.ToLower(name), ".")
+	for i := len(labels) - 1; i >= 0; i-- {
+		node = crypto.Keccak256Hash(node.Bytes(), crypto.Keccak256([]byte(labels[i])))
+	}
+	return node
+}
+
+// FormatAddress shows an address with its ENS name when it has one
+func FormatAddress(name, address string) string {
+	if name == "" {
+		return address
+	}
+	return fmt.Sprintf("%s (%s)", name, address)
+}
@@ -0,0 +1,117 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+// stubENS serves registry and resolver calls from in-memory records
+type stubENS struct {
+	registry  common.Address
+	resolvers map[common.Hash]common.Address
+	names     map[common.Hash]string
+	addrs     map[common.Hash]common.Address
+	calls     int
+}
+
+func (s *stubENS) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	s.calls++
+	selector, node := call.Data[:4], common.BytesToHash(call.Data[4:])
+
+	switch {
+	case *call.To == s.registry && bytes.Equal(selector, ensResolverSelector):
+		return common.LeftPadBytes(s.resolvers[node].Bytes(), 32), nil
+	case bytes.Equal(selector, ensNameSelector):
+		stringType, _ := abi.NewType("string", "", nil)
+		return abi.Arguments{{Type: stringType}}.Pack(s.names[node])
+	case bytes.Equal(selector, ensAddrSelector):
+		return common.LeftPadBytes(s.addrs[node].Bytes(), 32), nil
+	}
+	return nil, nil
+}
+
+func (s *stubENS) Close() {}
+
+func TestENSService(t *testing.T) {
+	owner := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	impostor := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	resolver := common.HexToAddress("0x3333333333333333333333333333333333333333")
+
+	ownerReverse := ensNamehash("1111111111111111111111111111111111111111.addr.reverse")
+	impostorReverse := ensNamehash("2222222222222222222222222222222222222222.addr.reverse")
+	name := ensNamehash("alice.eth")
+
+	conf := &config.ENSConfiguration{
+		Enabled:         true,
+		RegistryAddress: "0x00000000000C2E074eC69A0dFb2997BA6C7d2e1e",
+	}
+	stub := &stubENS{
+		registry: common.HexToAddress(conf.RegistryAddress),
+		resolvers: map[common.Hash]common.Address{
+			ownerReverse:    resolver,
+			impostorReverse: resolver,
+			name:            resolver,
+		},
+		names: map[common.Hash]string{
+			ownerReverse:    "alice.eth",
+			impostorReverse: "alice.eth",
+		},
+		addrs: map[common.Hash]common.Address{
+			name: owner,
+		},
+	}
+	service := &ENSService{
+		conf: conf,
+		dial: func(ctx context.Context) (ensCaller, error) {
+			return stub, nil
+		},
+	}
+	ctx := context.Background()
+
+	t.Run("namehash matches EIP-137", func(t *testing.T) {
+		assert.Equal(t, common.Hash{}, ensNamehash(""))
+		assert.Equal(t, "0x93cdeb708b7545dc668eb9280176169d1c33cfd8ed6f04690a0bcc88a93fc4ae", ensNamehash("eth").Hex())
+		assert.Equal(t, "0xde9b09fd7c5f901e23a3f19fecc54828e9c848539801e86591bd9801b019f84f", ensNamehash("foo.eth").Hex())
+	})
+
+	t.Run("resolves a verified primary name", func(t *testing.T) {
+		resolved, err := service.LookupAddress(ctx, owner.Hex())
+		assert.NoError(t, err)
+		assert.Equal(t, "alice.eth", resolved)
+	})
+
+	t.Run("ignores a reverse record that doesn't resolve back", func(t *testing.T) {
+		resolved, err := service.LookupAddress(ctx, impostor.Hex())
+		assert.NoError(t, err)
+		assert.Empty(t, resolved)
+	})
+
+	t.Run("returns no name without a reverse record", func(t *testing.T) {
+		resolved, err := service.LookupAddress(ctx, "0x4444444444444444444444444444444444444444")
+		assert.NoError(t, err)
+		assert.Empty(t, resolved)
+	})
+
+	t.Run("skips testnets and non-EVM addresses", func(t *testing.T) {
+		stub.calls = 0
+		assert.Empty(t, service.DisplayName(ctx, &ent.Network{IsTestnet: true}, owner.Hex()))
+		assert.Empty(t, service.DisplayName(ctx, &ent.Network{}, "TXYZopYRdj2D9XRtbG411XZZ3kM5VkAeBf"))
+		assert.Zero(t, stub.calls)
+
+		assert.Equal(t, "alice.eth", service.DisplayName(ctx, &ent.Network{}, owner.Hex()))
+	})
+
+	t.Run("formats addresses with their name", func(t *testing.T) {
+		assert.Equal(t, "alice.eth (0x1111111111111111111111111111111111111111)", FormatAddress("alice.eth", owner.Hex()))
+		assert.Equal(t, owner.Hex(), FormatAddress("", owner.Hex()))
+	})
+}
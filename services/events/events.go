@@ -0,0 +1,78 @@
+package events
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Event is a contract event decoded from a log. Fields are named after the ABI inputs, the way
+// the abigen bindings name them, so logs unpack into them directly
+type Event interface {
+	// Log returns the log the event was decoded from
+	Log() types.Log
+}
+
+// Transfer is the Transfer event of an ERC20 token
+type Transfer struct {
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+	Raw   types.Log
+}
+
+// Log returns the log the event was decoded from
+func (e *Transfer) Log() types.Log { return e.Raw }
+
+// OrderCreated is the OrderCreated event of the Gateway contract. Rate carries two decimals
+type OrderCreated struct {
+	Sender      common.Address
+	Token       common.Address
+	Amount      *big.Int
+	ProtocolFee *big.Int
+	OrderId     [32]byte
+	Rate        *big.Int
+	MessageHash string
+	Raw         types.Log
+}
+
+// Log returns the log the event was decoded from
+func (e *OrderCreated) Log() types.Log { return e.Raw }
+
+// OrderSettled is the OrderSettled event of the Gateway contract
+type OrderSettled struct {
+	SplitOrderId      [32]byte
+	OrderId           [32]byte
+	LiquidityProvider common.Address
+	SettlePercent     *big.Int
+	Raw               types.Log
+}
+
+// Log returns the log the event was decoded from
+func (e *OrderSettled) Log() types.Log { return e.Raw }
+
+// OrderRefunded is the OrderRefunded event of the Gateway contract
+type OrderRefunded struct {
+	Fee     *big.Int
+	OrderId [32]byte
+	Raw     types.Log
+}
+
+// Log returns the log the event was decoded from
+func (e *OrderRefunded) Log() types.Log { return e.Raw }
+
+// UserOperation is the UserOperationEvent of the ERC-4337 EntryPoint contract
+type UserOperation struct {
+	UserOpHash    [32]byte
+	Sender        common.Address
+	Paymaster     common.Address
+	Nonce         *big.Int
+	Success       bool
+	ActualGasCost *big.Int
+	ActualGasUsed *big.Int
+	Raw           types.Log
+}
+
+// Log returns the log the event was decoded from
+func (e *UserOperation) Log() types.Log { return e.Raw }
@@ -0,0 +1,226 @@
+package events
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/NEDA-LABS/stablenode/services/contracts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Contracts whose events are registered by default
+const (
+	Gateway    = "Gateway"
+	ERC20      = "ERC20"
+	EntryPoint = "EntryPoint"
+)
+
+// ErrUnknownEvent is returned for logs whose signature no contract registered
+var ErrUnknownEvent = errors.New("unknown event")
+
+// Constructor returns the empty typed event a log is decoded into, holding the raw log
+type Constructor func(raw types.Log) Event
+
+// registration is an event of a contract ABI and the typed event it decodes into
+type registration struct {
+	contract string
+	abi      *abi.ABI
+	event    abi.Event
+	indexed  abi.Arguments
+	newEvent Constructor
+}
+
+// Registry decodes logs into typed events by their signature, the first topic of the log
+type Registry struct {
+	mu     sync.RWMutex
+	events map[common.Hash]*registration
+}
+
+// NewRegistry returns an empty registry
+func NewRegistry() *Registry {
+	return &Registry{events: make(map[common.Hash]*registration)}
+}
+
+// Register adds the events of a contract ABI that have a constructor, keyed by event name. Events
+// of the ABI without a constructor are not decoded
+func (r *Registry) Register(contract string, contractABI *abi.ABI, constructors map[string]Constructor) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for name, newEvent := range constructors {
+		event, ok := contractABI.Events[name]
+		if !ok {
+			return fmt.Errorf("%s ABI has no %s event", contract, name)
+		}
+		if existing, ok := r.events[event.ID]; ok && existing.contract != contract {
+			return fmt.Errorf("%s.%s has the signature of %s.%s", contract, name, existing.contract, existing.event.Name)
+		}
+
+		var indexed abi.Arguments
+		for _, input := range event.Inputs {
+			if input.Indexed {
+				indexed = append(indexed, input)
+			}
+		}
+		r.events[event.ID] = &registration{
+			contract: contract,
+			abi:      contractABI,
+			event:    event,
+			indexed:  indexed,
+			newEvent: newEvent,
+		}
+	}
+	return nil
+}
+
+// Topic returns the signature of a registered event
+func (r *Registry) Topic(contract, event string) (common.Hash, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for id, reg := range r.events {
+		if reg.contract == contract && reg.event.Name == event {
+			return id, true
+		}
+	}
+	return common.Hash{}, false
+}
+
+// Topics returns the signatures of the registered events of a contract, in a stable order
+func (r *Registry) Topics(contract string) []common.Hash {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var topics []common.Hash
+	for id, reg := range r.events {
+		if reg.contract == contract {
+			topics = append(topics, id)
+		}
+	}
+	sort.Slice(topics, func(i, j int) bool { return topics[i].Cmp(topics[j]) < 0 })
+	return topics
+}
+
+// Contract returns the contract that registered an event signature
+func (r *Registry) Contract(topic common.Hash) (string, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	reg, ok := r.events[topic]
+	if !ok {
+		return "", false
+	}
+	return reg.contract, true
+}
+
+// Name returns the name of the event registered for a signature
+func (r *Registry) Name(topic common.Hash) (string, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	reg, ok := r.events[topic]
+	if !ok {
+		return "", false
+	}
+	return reg.event.Name, true
+}
+
+// Decode unpacks a log into the typed event registered for its signature
+func (r *Registry) Decode(log types.Log) (Event, error) {
+	if len(log.Topics) == 0 {
+		return nil, ErrUnknownEvent
+	}
+
+	r.mu.RLock()
+	reg, ok := r.events[log.Topics[0]]
+	r.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownEvent, log.Topics[0].Hex())
+	}
+
+	// Logs sharing a signature with a different indexing, e.g. ERC721 transfers, don't decode
+	if len(log.Topics)-1 != len(reg.indexed) {
+		return nil, fmt.Errorf("invalid %s event: expected %d topics, got %d", reg.event.Name, len(reg.indexed)+1, len(log.Topics))
+	}
+
+	// Every non-indexed input takes at least one word of data, so a short log would leave fields
+	// of the event nil
+	nonIndexed := len(reg.event.Inputs) - len(reg.indexed)
+	if len(log.Data) < nonIndexed*32 {
+		return nil, fmt.Errorf("invalid %s event: expected at least %d bytes of data, got %d", reg.event.Name, nonIndexed*32, len(log.Data))
+	}
+
+	event := reg.newEvent(log)
+	if nonIndexed > 0 {
+		if err := reg.abi.UnpackIntoInterface(event, reg.event.Name, log.Data); err != nil {
+			return nil, fmt.Errorf("invalid %s event data: %w", reg.event.Name, err)
+		}
+	}
+	if err := abi.ParseTopics(event, reg.indexed, log.Topics[1:]); err != nil {
+		return nil, fmt.Errorf("invalid %s event topics: %w", reg.event.Name, err)
+	}
+	return event, nil
+}
+
+// DecodeLogs decodes the logs with a registered signature and skips the others
+func (r *Registry) DecodeLogs(logs []types.Log) ([]Event, error) {
+	var decoded []Event
+	for _, log := range logs {
+		event, err := r.Decode(log)
+		if errors.Is(err, ErrUnknownEvent) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("log %d of %s: %w", log.Index, log.TxHash.Hex(), err)
+		}
+		decoded = append(decoded, event)
+	}
+	return decoded, nil
+}
+
+var (
+	defaultRegistry     *Registry
+	defaultRegistryOnce sync.Once
+)
+
+// Default returns the registry of the Gateway, ERC20 and EntryPoint events the indexers consume
+func Default() *Registry {
+	defaultRegistryOnce.Do(func() {
+		registry := NewRegistry()
+		mustRegister(registry, Gateway, contracts.GatewayMetaData, map[string]Constructor{
+			"OrderCreated":  func(raw types.Log) Event { return &OrderCreated{Raw: raw} },
+			"OrderSettled":  func(raw types.Log) Event { return &OrderSettled{Raw: raw} },
+			"OrderRefunded": func(raw types.Log) Event { return &OrderRefunded{Raw: raw} },
+		})
+		mustRegister(registry, ERC20, contracts.ERC20TokenMetaData, map[string]Constructor{
+			"Transfer": func(raw types.Log) Event { return &Transfer{Raw: raw} },
+		})
+		mustRegister(registry, EntryPoint, contracts.EntryPointMetaData, map[string]Constructor{
+			"UserOperationEvent": func(raw types.Log) Event { return &UserOperation{Raw: raw} },
+		})
+		defaultRegistry = registry
+	})
+	return defaultRegistry
+}
+
+// mustRegister registers the events of a generated binding, whose ABI is known to be valid
+func mustRegister(registry *Registry, contract string, metadata *bind.MetaData, constructors map[string]Constructor) {
+	contractABI, err := metadata.GetAbi()
+	if err != nil {
+		panic(fmt.Sprintf("events: %s ABI: %v", contract, err))
+	}
+	if err := registry.Register(contract, contractABI, constructors); err != nil {
+		panic(fmt.Sprintf("events: %v", err))
+	}
+}
+
+// Topic returns the signature of an event of the default registry
+func Topic(contract, event string) common.Hash {
+	topic, _ := Default().Topic(contract, event)
+	return topic
+}
@@ -0,0 +1,141 @@
+package events
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/NEDA-LABS/stablenode/services/contracts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// packLog builds the log a contract emits for an event, from the values of its inputs in ABI order
+func packLog(t *testing.T, metadata interface{ GetAbi() (*abi.ABI, error) }, name string, values ...interface{}) types.Log {
+	contractABI, err := metadata.GetAbi()
+	require.NoError(t, err)
+	event := contractABI.Events[name]
+
+	log := types.Log{Topics: []common.Hash{event.ID}, BlockNumber: 100, Index: 3, TxHash: common.HexToHash("0xabc")}
+	var data []interface{}
+	for i, input := range event.Inputs {
+		if !input.Indexed {
+			data = append(data, values[i])
+			continue
+		}
+		topics, err := abi.MakeTopics([]interface{}{values[i]})
+		require.NoError(t, err)
+		log.Topics = append(log.Topics, topics[0][0])
+	}
+	log.Data, err = event.Inputs.NonIndexed().Pack(data...)
+	require.NoError(t, err)
+	return log
+}
+
+func TestRegistry(t *testing.T) {
+	registry := Default()
+	sender := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	token := common.HexToAddress("0x833589fCD6eDb6E08f4c7C32D4f71b54bdA02913")
+	orderID := common.HexToHash("0x01")
+
+	t.Run("registers the signatures of the contracts", func(t *testing.T) {
+		assert.Equal(t, "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef", Topic(ERC20, "Transfer").Hex())
+		assert.Equal(t, "0x40ccd1ceb111a3c186ef9911e1b876dc1f789ed331b86097b3b8851055b6a137", Topic(Gateway, "OrderCreated").Hex())
+		assert.Equal(t, "0x98ece21e01a01cbe1d1c0dad3b053c8fbd368f99be78be958fcf1d1d13fd249a", Topic(Gateway, "OrderSettled").Hex())
+		assert.Equal(t, "0x0736fe428e1747ca8d387c2e6fa1a31a0cde62d3a167c40a46ade59a3cdc828e", Topic(Gateway, "OrderRefunded").Hex())
+		assert.Len(t, registry.Topics(Gateway), 3)
+
+		contract, ok := registry.Contract(Topic(ERC20, "Transfer"))
+		assert.True(t, ok)
+		assert.Equal(t, ERC20, contract)
+
+		name, ok := registry.Name(Topic(Gateway, "OrderSettled"))
+		assert.True(t, ok)
+		assert.Equal(t, "OrderSettled", name)
+	})
+
+	t.Run("decodes gateway events", func(t *testing.T) {
+		log := packLog(t, contracts.GatewayMetaData, "OrderCreated", sender, token, big.NewInt(5000000), big.NewInt(25000), orderID, big.NewInt(150050), "QmMessage")
+		event, err := registry.Decode(log)
+		require.NoError(t, err)
+
+		created, ok := event.(*OrderCreated)
+		require.True(t, ok)
+		assert.Equal(t, sender, created.Sender)
+		assert.Equal(t, token, created.Token)
+		assert.Equal(t, int64(5000000), created.Amount.Int64())
+		assert.Equal(t, int64(25000), created.ProtocolFee.Int64())
+		assert.Equal(t, [32]byte(orderID), created.OrderId)
+		assert.Equal(t, int64(150050), created.Rate.Int64())
+		assert.Equal(t, "QmMessage", created.MessageHash)
+		assert.Equal(t, uint64(100), created.Log().BlockNumber)
+
+		log = packLog(t, contracts.GatewayMetaData, "OrderSettled", common.HexToHash("0x02"), orderID, sender, big.NewInt(100000))
+		event, err = registry.Decode(log)
+		require.NoError(t, err)
+		settled := event.(*OrderSettled)
+		assert.Equal(t, [32]byte(common.HexToHash("0x02")), settled.SplitOrderId)
+		assert.Equal(t, sender, settled.LiquidityProvider)
+		assert.Equal(t, int64(100000), settled.SettlePercent.Int64())
+
+		log = packLog(t, contracts.GatewayMetaData, "OrderRefunded", big.NewInt(300), orderID)
+		event, err = registry.Decode(log)
+		require.NoError(t, err)
+		assert.Equal(t, int64(300), event.(*OrderRefunded).Fee.Int64())
+	})
+
+	t.Run("decodes token and entry point events", func(t *testing.T) {
+		log := packLog(t, contracts.ERC20TokenMetaData, "Transfer", sender, token, big.NewInt(42))
+		event, err := registry.Decode(log)
+		require.NoError(t, err)
+		assert.Equal(t, &Transfer{From: sender, To: token, Value: big.NewInt(42), Raw: log}, event)
+
+		log = packLog(t, contracts.EntryPointMetaData, "UserOperationEvent", orderID, sender, common.Address{}, big.NewInt(7), true, big.NewInt(1000), big.NewInt(21000))
+		event, err = registry.Decode(log)
+		require.NoError(t, err)
+		op := event.(*UserOperation)
+		assert.True(t, op.Success)
+		assert.Equal(t, sender, op.Sender)
+		assert.Equal(t, int64(21000), op.ActualGasUsed.Int64())
+	})
+
+	t.Run("rejects unknown and malformed logs", func(t *testing.T) {
+		approval := packLog(t, contracts.ERC20TokenMetaData, "Approval", sender, token, big.NewInt(1))
+		_, err := registry.Decode(approval)
+		assert.ErrorIs(t, err, ErrUnknownEvent)
+
+		// An ERC721 transfer indexes the token ID instead of carrying a value
+		nft := packLog(t, contracts.ERC20TokenMetaData, "Transfer", sender, token, big.NewInt(1))
+		nft.Topics = append(nft.Topics, common.BigToHash(big.NewInt(9)))
+		nft.Data = nil
+		_, err = registry.Decode(nft)
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, ErrUnknownEvent)
+
+		// A log with the signature and topics of a transfer but without its value
+		empty := packLog(t, contracts.ERC20TokenMetaData, "Transfer", sender, token, big.NewInt(1))
+		empty.Data = nil
+		_, err = registry.Decode(empty)
+		assert.ErrorContains(t, err, "expected at least 32 bytes of data")
+
+		transfer := packLog(t, contracts.ERC20TokenMetaData, "Transfer", sender, token, big.NewInt(42))
+		decoded, err := registry.DecodeLogs([]types.Log{approval, transfer})
+		require.NoError(t, err)
+		assert.Len(t, decoded, 1)
+
+		_, err = registry.DecodeLogs([]types.Log{transfer, nft})
+		assert.Error(t, err)
+	})
+
+	t.Run("refuses events whose signature another contract registered", func(t *testing.T) {
+		erc20, err := contracts.ERC20TokenMetaData.GetAbi()
+		require.NoError(t, err)
+		err = registry.Register("USDT", erc20, map[string]Constructor{
+			"Transfer": func(raw types.Log) Event { return &Transfer{Raw: raw} },
+		})
+		assert.Error(t, err)
+		assert.Error(t, NewRegistry().Register(ERC20, erc20, map[string]Constructor{"Deposit": nil}))
+	})
+}
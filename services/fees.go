@@ -0,0 +1,138 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/feeschedule"
+	"github.com/NEDA-LABS/stablenode/ent/senderprofile"
+	"github.com/NEDA-LABS/stablenode/storage"
+	"github.com/shopspring/decimal"
+)
+
+// FeeQuote describes a new payment order to compute the fees of
+type FeeQuote struct {
+	Sender *ent.SenderProfile
+	// Token of the order, loaded with its network
+	Token       *ent.Token
+	Amount      decimal.Decimal
+	AmountInUSD decimal.Decimal
+	// SenderFeePercent is the sender fee of the order when no schedule sets it
+	SenderFeePercent decimal.Decimal
+	// SenderFeeChosen is set when the sender chose the fee of the order itself, which schedules
+	// don't override
+	SenderFeeChosen bool
+}
+
+// OrderFees are the fees of a new payment order, in its token
+type OrderFees struct {
+	SenderFee decimal.Decimal
+	// SenderFeePercent is the sender fee as a percentage of the order amount
+	SenderFeePercent decimal.Decimal
+	NetworkFee       decimal.Decimal
+	ProtocolFee      decimal.Decimal
+}
+
+// FeeService computes the fees of new payment orders from the fee schedules in effect
+type FeeService struct{}
+
+// NewFeeService creates a new instance of FeeService
+func NewFeeService() *FeeService {
+	return &FeeService{}
+}
+
+// ComputeFees computes the fees of a new payment order. Each fee is set by the most specific schedule
+// in effect that applies to the order. A schedule for the sender is more specific than one for the
+// token, which is more specific than one for the network; ties go to the schedule that took effect
+// last. Fees no schedule applies to keep their defaults: the sender's fee percent for the token, the
+// network's fee and no protocol fee
+func (s *FeeService) ComputeFees(ctx context.Context, quote FeeQuote) (*OrderFees, error) {
+	now := time.Now()
+	schedules, err := storage.Client.FeeSchedule.
+		Query().
+		Where(
+			feeschedule.EffectiveFromLTE(now),
+			feeschedule.Or(
+				feeschedule.EffectiveUntilIsNil(),
+				feeschedule.EffectiveUntilGT(now),
+			),
+			feeschedule.TokenIn("", quote.Token.Symbol),
+			feeschedule.NetworkIn("", quote.Token.Edges.Network.Identifier),
+			feeschedule.Or(
+				feeschedule.Not(feeschedule.HasSenderProfile()),
+				feeschedule.HasSenderProfileWith(senderprofile.IDEQ(quote.Sender.ID)),
+			),
+			feeschedule.MinAmountLTE(quote.AmountInUSD),
+			feeschedule.Or(
+				feeschedule.MaxAmountIsNil(),
+				feeschedule.MaxAmountGT(quote.AmountInUSD),
+			),
+		).
+		WithSenderProfile().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("ComputeFees.schedules: %w", err)
+	}
+
+	selected := make(map[feeschedule.FeeType]*ent.FeeSchedule)
+	for _, schedule := range schedules {
+		current, ok := selected[schedule.FeeType]
+		if !ok || scheduleSpecificity(schedule) > scheduleSpecificity(current) ||
+			(scheduleSpecificity(schedule) == scheduleSpecificity(current) && schedule.EffectiveFrom.After(current.EffectiveFrom)) {
+			selected[schedule.FeeType] = schedule
+		}
+	}
+
+	fees := &OrderFees{
+		SenderFee:        quote.SenderFeePercent.Mul(quote.Amount).Div(decimal.NewFromInt(100)).Round(4),
+		SenderFeePercent: quote.SenderFeePercent,
+		NetworkFee:       quote.Token.Edges.Network.Fee,
+		ProtocolFee:      decimal.Zero,
+	}
+
+	if schedule, ok := selected[feeschedule.FeeTypeSender]; ok && !quote.SenderFeeChosen {
+		fees.SenderFee = scheduledFee(schedule, quote)
+		fees.SenderFeePercent = decimal.Zero
+		if quote.Amount.IsPositive() {
+			fees.SenderFeePercent = fees.SenderFee.Mul(decimal.NewFromInt(100)).DivRound(quote.Amount, 8)
+		}
+	}
+	if schedule, ok := selected[feeschedule.FeeTypeNetwork]; ok {
+		fees.NetworkFee = scheduledFee(schedule, quote)
+	}
+	if schedule, ok := selected[feeschedule.FeeTypeProtocol]; ok {
+		fees.ProtocolFee = scheduledFee(schedule, quote)
+	}
+
+	return fees, nil
+}
+
+// scheduleSpecificity ranks how narrowly a fee schedule applies
+func scheduleSpecificity(schedule *ent.FeeSchedule) int {
+	specificity := 0
+	if schedule.Edges.SenderProfile != nil {
+		specificity += 4
+	}
+	if schedule.Token != "" {
+		specificity += 2
+	}
+	if schedule.Network != "" {
+		specificity++
+	}
+	return specificity
+}
+
+// scheduledFee computes the fee a schedule sets for an order. The flat USD amount is converted to the
+// order's token at the order's own rate
+func scheduledFee(schedule *ent.FeeSchedule, quote FeeQuote) decimal.Decimal {
+	fee := schedule.Percent.Mul(quote.Amount).Div(decimal.NewFromInt(100))
+
+	flat := schedule.FlatAmount
+	if quote.AmountInUSD.IsPositive() {
+		flat = flat.Mul(quote.Amount).Div(quote.AmountInUSD)
+	}
+
+	return fee.Add(flat).Round(4)
+}
@@ -0,0 +1,172 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	"github.com/NEDA-LABS/stablenode/ent/feeschedule"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/test"
+)
+
+func TestComputeFees(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:fees?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	ctx := context.Background()
+	token, err := test.CreateERC20Token(nil, map[string]interface{}{
+		"symbol":         "USDC",
+		"identifier":     "base",
+		"chainID":        int64(8453),
+		"deployContract": false,
+	})
+	assert.NoError(t, err)
+	token.Edges.Network = token.Edges.Network.Update().SetFee(decimal.NewFromFloat(0.5)).SaveX(ctx)
+
+	user, err := test.CreateTestUser(map[string]interface{}{
+		"email": "sender@test.com",
+	})
+	assert.NoError(t, err)
+
+	sender, err := test.CreateTestSenderProfile(map[string]interface{}{
+		"user_id": user.ID,
+		"token":   token.Symbol,
+	})
+	assert.NoError(t, err)
+
+	service := NewFeeService()
+	quote := FeeQuote{
+		Sender:           sender,
+		Token:            token,
+		Amount:           decimal.NewFromInt(1000),
+		AmountInUSD:      decimal.NewFromInt(1000),
+		SenderFeePercent: decimal.NewFromInt(1),
+	}
+
+	t.Run("should keep the defaults without schedules", func(t *testing.T) {
+		fees, err := service.ComputeFees(ctx, quote)
+		assert.NoError(t, err)
+		assert.True(t, fees.SenderFee.Equal(decimal.NewFromInt(10)), fees.SenderFee.String())
+		assert.True(t, fees.SenderFeePercent.Equal(decimal.NewFromInt(1)))
+		assert.True(t, fees.NetworkFee.Equal(decimal.NewFromFloat(0.5)))
+		assert.True(t, fees.ProtocolFee.IsZero())
+	})
+
+	// Protocol fee tiers for every sender: 0.5% below 500 USD, 0.3% plus 1 USD from 500 USD
+	client.FeeSchedule.
+		Create().
+		SetFeeType(feeschedule.FeeTypeProtocol).
+		SetMaxAmount(decimal.NewFromInt(500)).
+		SetPercent(decimal.NewFromFloat(0.5)).
+		SetEffectiveFrom(time.Now().Add(-time.Hour)).
+		SaveX(ctx)
+	client.FeeSchedule.
+		Create().
+		SetFeeType(feeschedule.FeeTypeProtocol).
+		SetMinAmount(decimal.NewFromInt(500)).
+		SetPercent(decimal.NewFromFloat(0.3)).
+		SetFlatAmount(decimal.NewFromInt(1)).
+		SetEffectiveFrom(time.Now().Add(-time.Hour)).
+		SaveX(ctx)
+
+	t.Run("should apply the volume band of the order", func(t *testing.T) {
+		fees, err := service.ComputeFees(ctx, quote)
+		assert.NoError(t, err)
+		assert.True(t, fees.ProtocolFee.Equal(decimal.NewFromInt(4)), fees.ProtocolFee.String())
+
+		small := quote
+		small.Amount, small.AmountInUSD = decimal.NewFromInt(100), decimal.NewFromInt(100)
+		fees, err = service.ComputeFees(ctx, small)
+		assert.NoError(t, err)
+		assert.True(t, fees.ProtocolFee.Equal(decimal.NewFromFloat(0.5)), fees.ProtocolFee.String())
+	})
+
+	t.Run("should convert flat fees to the order's token", func(t *testing.T) {
+		// 1 USD is 2 tokens at this order's rate
+		converted := quote
+		converted.Amount = decimal.NewFromInt(2000)
+		fees, err := service.ComputeFees(ctx, converted)
+		assert.NoError(t, err)
+		assert.True(t, fees.ProtocolFee.Equal(decimal.NewFromInt(8)), fees.ProtocolFee.String())
+	})
+
+	// Network fee for the token, and a lower one for the token on this network
+	client.FeeSchedule.
+		Create().
+		SetFeeType(feeschedule.FeeTypeNetwork).
+		SetToken("USDC").
+		SetFlatAmount(decimal.NewFromInt(2)).
+		SetEffectiveFrom(time.Now().Add(-time.Hour)).
+		SaveX(ctx)
+	client.FeeSchedule.
+		Create().
+		SetFeeType(feeschedule.FeeTypeNetwork).
+		SetToken("USDC").
+		SetNetwork("base").
+		SetFlatAmount(decimal.NewFromFloat(0.25)).
+		SetEffectiveFrom(time.Now().Add(-time.Hour)).
+		SaveX(ctx)
+	// Scheduled for later
+	client.FeeSchedule.
+		Create().
+		SetFeeType(feeschedule.FeeTypeNetwork).
+		SetToken("USDC").
+		SetNetwork("base").
+		SetFlatAmount(decimal.NewFromInt(3)).
+		SetEffectiveFrom(time.Now().Add(time.Hour)).
+		SaveX(ctx)
+
+	t.Run("should apply the most specific schedule in effect", func(t *testing.T) {
+		fees, err := service.ComputeFees(ctx, quote)
+		assert.NoError(t, err)
+		assert.True(t, fees.NetworkFee.Equal(decimal.NewFromFloat(0.25)), fees.NetworkFee.String())
+	})
+
+	// Sender fee for this sender, replacing an older one that has ended
+	client.FeeSchedule.
+		Create().
+		SetFeeType(feeschedule.FeeTypeSender).
+		SetSenderProfile(sender).
+		SetPercent(decimal.NewFromInt(5)).
+		SetEffectiveFrom(time.Now().Add(-2 * time.Hour)).
+		SetEffectiveUntil(time.Now().Add(-time.Hour)).
+		SaveX(ctx)
+	client.FeeSchedule.
+		Create().
+		SetFeeType(feeschedule.FeeTypeSender).
+		SetSenderProfile(sender).
+		SetPercent(decimal.NewFromInt(2)).
+		SetEffectiveFrom(time.Now().Add(-time.Hour)).
+		SaveX(ctx)
+
+	t.Run("should apply the sender's schedule", func(t *testing.T) {
+		fees, err := service.ComputeFees(ctx, quote)
+		assert.NoError(t, err)
+		assert.True(t, fees.SenderFee.Equal(decimal.NewFromInt(20)), fees.SenderFee.String())
+		assert.True(t, fees.SenderFeePercent.Equal(decimal.NewFromInt(2)), fees.SenderFeePercent.String())
+
+		other := quote
+		other.Sender = &ent.SenderProfile{ID: uuid.New()}
+		fees, err = service.ComputeFees(ctx, other)
+		assert.NoError(t, err)
+		assert.True(t, fees.SenderFee.Equal(decimal.NewFromInt(10)), fees.SenderFee.String())
+	})
+
+	t.Run("should keep the sender fee chosen for the order", func(t *testing.T) {
+		chosen := quote
+		chosen.SenderFeePercent = decimal.NewFromFloat(1.5)
+		chosen.SenderFeeChosen = true
+		fees, err := service.ComputeFees(ctx, chosen)
+		assert.NoError(t, err)
+		assert.True(t, fees.SenderFee.Equal(decimal.NewFromInt(15)), fees.SenderFee.String())
+	})
+}
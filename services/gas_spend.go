@@ -0,0 +1,145 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/lockpaymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/outboxtransaction"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
+	"github.com/NEDA-LABS/stablenode/services/events"
+	"github.com/NEDA-LABS/stablenode/storage"
+)
+
+// gasSpend is what the UserOperations sent with a context spend gas on
+type gasSpend struct {
+	purpose        transactionlog.Purpose
+	paymentOrderID *uuid.UUID
+}
+
+// gasSpendKey is the context key of the gas spend of UserOperations
+type gasSpendKey struct{}
+
+// WithGasSpend attributes the gas of the UserOperations sent with ctx to a purpose and, when
+// orderID is not nil, to a payment order
+func WithGasSpend(ctx context.Context, purpose transactionlog.Purpose, orderID *uuid.UUID) context.Context {
+	return context.WithValue(ctx, gasSpendKey{}, gasSpend{purpose: purpose, paymentOrderID: orderID})
+}
+
+// gasSpendFrom returns the gas spend of a UserOperation sent with ctx. Operations carrying initCode
+// deploy their account, which is most of their gas, so they count as deployments
+func gasSpendFrom(ctx context.Context, userOp map[string]interface{}) (gasSpend, bool) {
+	spend, ok := ctx.Value(gasSpendKey{}).(gasSpend)
+	if initCode, _ := userOp["initCode"].(string); initCode != "" && initCode != "0x" {
+		spend.purpose = transactionlog.PurposeDeployment
+		return spend, true
+	}
+	return spend, ok
+}
+
+// outboxGasSpend attributes the gas of an outbox transaction to the payment order its lock order was
+// created from. Lock orders share the gateway ID of their payment order
+func outboxGasSpend(ctx context.Context, transaction *ent.OutboxTransaction) context.Context {
+	purpose := transactionlog.PurposeSettlement
+	if transaction.Kind == outboxtransaction.KindRefundOrder {
+		purpose = transactionlog.PurposeRefund
+	}
+
+	var orderID *uuid.UUID
+	lockOrder, err := storage.Client.LockPaymentOrder.
+		Query().
+		Where(lockpaymentorder.IDEQ(transaction.LockOrderID)).
+		Only(ctx)
+	if err == nil {
+		order, err := storage.Client.PaymentOrder.
+			Query().
+			Where(paymentorder.GatewayIDEQ(lockOrder.GatewayID)).
+			First(ctx)
+		if err == nil {
+			orderID = &order.ID
+		}
+	}
+
+	return WithGasSpend(ctx, purpose, orderID)
+}
+
+// isSponsored reports whether a paymaster pays for a UserOperation
+func isSponsored(userOp map[string]interface{}) bool {
+	paymaster, _ := userOp["paymaster"].(string)
+	if paymaster == "" {
+		paymasterAndData, _ := userOp["paymasterAndData"].(string)
+		paymaster = strings.TrimPrefix(paymasterAndData, "0x")
+	}
+	return paymaster != "" && paymaster != "0x"
+}
+
+// setUserOperationGas sets the gas the EntryPoint charged for a mined attempt of a UserOperation.
+// paymaster is the paymaster that paid for it, the zero address when the sender paid, or empty when
+// unknown
+func setUserOperationGas(update *ent.TransactionLogUpdateOne, gasUsed, gasCost *big.Int, paymaster string) *ent.TransactionLogUpdateOne {
+	update.
+		SetGasUsed(gasUsed.Int64()).
+		SetGasCost(decimal.NewFromBigInt(gasCost, -18))
+	if paymaster != "" {
+		update.SetSponsored(strings.Trim(strings.TrimPrefix(strings.ToLower(paymaster), "0x"), "0") != "")
+	}
+	return update
+}
+
+// receiptGas reads the gas used and cost of a UserOperation receipt, which are hex quantities
+func receiptGas(receipt map[string]interface{}) (gasUsed, gasCost *big.Int, err error) {
+	gasUsed, err = hexutil.DecodeBig(fmt.Sprintf("%v", receipt["actualGasUsed"]))
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid actualGasUsed: %w", err)
+	}
+	gasCost, err = hexutil.DecodeBig(fmt.Sprintf("%v", receipt["actualGasCost"]))
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid actualGasCost: %w", err)
+	}
+	return gasUsed, gasCost, nil
+}
+
+// recordUserOperationEventGas records an executed UserOperation and the gas it was charged on the
+// log of its latest attempt, like markUserOperationMined does from its receipt
+func recordUserOperationEventGas(ctx context.Context, event *events.UserOperation) error {
+	userOpHash := common.Hash(event.UserOpHash).Hex()
+	latest, err := userOperationLog(ctx, userOpHash)
+	if err != nil {
+		return fmt.Errorf("failed to fetch UserOperation log: %w", err)
+	}
+	for {
+		replacement, err := latest.QueryReplacedBy().Only(ctx)
+		if ent.IsNotFound(err) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to fetch replacement: %w", err)
+		}
+		latest = replacement
+	}
+
+	metadata := make(map[string]interface{}, len(latest.Metadata)+2)
+	for key, value := range latest.Metadata {
+		metadata[key] = value
+	}
+	metadata["MinedUserOpHash"] = userOpHash
+	metadata["Success"] = event.Success
+
+	update := latest.Update().
+		SetTxHash(event.Raw.TxHash.Hex()).
+		SetMetadata(metadata)
+	_, err = setUserOperationGas(update, event.ActualGasUsed, event.ActualGasCost, event.Paymaster.Hex()).Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to record executed UserOperation: %w", err)
+	}
+	return nil
+}
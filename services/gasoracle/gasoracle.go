@@ -0,0 +1,196 @@
+package gasoracle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/ethereum/go-ethereum"
+)
+
+// Urgency is how quickly a transaction should be included, i.e. which percentile of recent
+// priority fees it pays
+type Urgency string
+
+const (
+	UrgencySlow     Urgency = "slow"
+	UrgencyStandard Urgency = "standard"
+	UrgencyFast     Urgency = "fast"
+)
+
+// urgencies are the urgency levels suggested for, in increasing order of priority fee
+var urgencies = []Urgency{UrgencySlow, UrgencyStandard, UrgencyFast}
+
+// ErrNoFeeMarket is returned for networks without an EIP-1559 base fee
+var ErrNoFeeMarket = errors.New("network has no EIP-1559 base fee")
+
+// Client is the part of an Ethereum client fees are read from; *ethclient.Client implements it
+type Client interface {
+	FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error)
+}
+
+// Fees is an EIP-1559 fee suggestion, in wei
+type Fees struct {
+	BaseFee              *big.Int
+	MaxPriorityFeePerGas *big.Int
+	MaxFeePerGas         *big.Int
+}
+
+// copy returns a copy of the fees the caller may modify
+func (f *Fees) copy() *Fees {
+	return &Fees{
+		BaseFee:              new(big.Int).Set(f.BaseFee),
+		MaxPriorityFeePerGas: new(big.Int).Set(f.MaxPriorityFeePerGas),
+		MaxFeePerGas:         new(big.Int).Set(f.MaxFeePerGas),
+	}
+}
+
+// cachedFees are the fee suggestions of a network for every urgency level
+type cachedFees struct {
+	fees      map[Urgency]*Fees
+	fetchedAt time.Time
+}
+
+// Oracle suggests EIP-1559 fees from the base fee of the next block and the priority fees paid in
+// recent blocks (eth_feeHistory). Suggestions are cached per network for a few seconds, so
+// transactions built together share a single fee history call
+type Oracle struct {
+	config *config.GasOracleConfiguration
+	mutex  sync.Mutex
+	cache  map[int64]cachedFees
+	now    func() time.Time
+}
+
+var (
+	defaultOracle     *Oracle
+	defaultOracleOnce sync.Once
+)
+
+// New creates a new gas oracle
+func New(conf *config.GasOracleConfiguration) *Oracle {
+	return &Oracle{
+		config: conf,
+		cache:  make(map[int64]cachedFees),
+		now:    time.Now,
+	}
+}
+
+// Default returns the process-wide gas oracle, so all services share its cache
+func Default() *Oracle {
+	defaultOracleOnce.Do(func() {
+		defaultOracle = New(config.GasOracleConfig())
+	})
+	return defaultOracle
+}
+
+// ParseUrgency parses an urgency level
+func ParseUrgency(s string) (Urgency, error) {
+	for _, urgency := range urgencies {
+		if string(urgency) == s {
+			return urgency, nil
+		}
+	}
+	return "", fmt.Errorf("unknown urgency %q, expected slow, standard or fast", s)
+}
+
+// Urgency returns the configured urgency level, standard if it is not a valid one
+func (o *Oracle) Urgency() Urgency {
+	urgency, err := ParseUrgency(o.config.Urgency)
+	if err != nil {
+		return UrgencyStandard
+	}
+	return urgency
+}
+
+// SuggestFees returns the fees of a transaction on a network at the given urgency, reading the fee
+// history through client when the cached suggestion is stale
+func (o *Oracle) SuggestFees(ctx context.Context, chainID int64, client Client, urgency Urgency) (*Fees, error) {
+	if _, err := ParseUrgency(string(urgency)); err != nil {
+		return nil, err
+	}
+
+	o.mutex.Lock()
+	cached, ok := o.cache[chainID]
+	o.mutex.Unlock()
+	if ok && o.now().Sub(cached.fetchedAt) < o.config.CacheTTL {
+		return cached.fees[urgency].copy(), nil
+	}
+
+	fees, err := o.fetch(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	o.mutex.Lock()
+	o.cache[chainID] = cachedFees{fees: fees, fetchedAt: o.now()}
+	o.mutex.Unlock()
+
+	return fees[urgency].copy(), nil
+}
+
+// fetch computes the fees of every urgency level from the fee history of recent blocks
+func (o *Oracle) fetch(ctx context.Context, client Client) (map[Urgency]*Fees, error) {
+	// eth_feeHistory requires increasing percentiles
+	percentiles := make([]float64, 0, len(urgencies))
+	for _, urgency := range urgencies {
+		percentiles = append(percentiles, o.config.Percentiles[string(urgency)])
+	}
+	sort.Float64s(percentiles)
+
+	history, err := client.FeeHistory(ctx, o.config.FeeHistoryBlocks, nil, percentiles)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch fee history: %w", err)
+	}
+
+	// The last base fee is the one of the next block
+	if len(history.BaseFee) == 0 || history.BaseFee[len(history.BaseFee)-1] == nil || history.BaseFee[len(history.BaseFee)-1].Sign() == 0 {
+		return nil, ErrNoFeeMarket
+	}
+	baseFee := history.BaseFee[len(history.BaseFee)-1]
+
+	// maxFeePerGas = baseFee * multiplier + priority fee, with the multiplier in hundredths
+	maxBaseFee := new(big.Int).Mul(baseFee, big.NewInt(int64(o.config.BaseFeeMultiplier*100)))
+	maxBaseFee.Div(maxBaseFee, big.NewInt(100))
+
+	fees := make(map[Urgency]*Fees, len(urgencies))
+	for _, urgency := range urgencies {
+		index := sort.SearchFloat64s(percentiles, o.config.Percentiles[string(urgency)])
+		tip := medianReward(history, index)
+		if tip == nil || tip.Cmp(o.config.MinPriorityFee) < 0 {
+			tip = new(big.Int).Set(o.config.MinPriorityFee)
+		}
+
+		fees[urgency] = &Fees{
+			BaseFee:              new(big.Int).Set(baseFee),
+			MaxPriorityFeePerGas: tip,
+			MaxFeePerGas:         new(big.Int).Add(maxBaseFee, tip),
+		}
+	}
+
+	return fees, nil
+}
+
+// medianReward returns the median priority fee at a percentile across the sampled blocks. Empty
+// blocks are skipped, as they report no priority fees; nil when all blocks are empty
+func medianReward(history *ethereum.FeeHistory, index int) *big.Int {
+	rewards := make([]*big.Int, 0, len(history.Reward))
+	for i, blockRewards := range history.Reward {
+		if i < len(history.GasUsedRatio) && history.GasUsedRatio[i] == 0 {
+			continue
+		}
+		if index < len(blockRewards) && blockRewards[index] != nil {
+			rewards = append(rewards, blockRewards[index])
+		}
+	}
+	if len(rewards) == 0 {
+		return nil
+	}
+
+	sort.Slice(rewards, func(i, j int) bool { return rewards[i].Cmp(rewards[j]) < 0 })
+	return new(big.Int).Set(rewards[len(rewards)/2])
+}
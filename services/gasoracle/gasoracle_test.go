@@ -0,0 +1,132 @@
+package gasoracle
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/ethereum/go-ethereum"
+	"github.com/stretchr/testify/assert"
+)
+
+// stubClient serves a fixed fee history and counts the calls made
+type stubClient struct {
+	history     *ethereum.FeeHistory
+	calls       int
+	percentiles []float64
+}
+
+func (c *stubClient) FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error) {
+	c.calls++
+	c.percentiles = rewardPercentiles
+	return c.history, nil
+}
+
+// rewards builds the slow, standard and fast priority fees of a block
+func rewards(slow, standard, fast int64) []*big.Int {
+	return []*big.Int{big.NewInt(slow), big.NewInt(standard), big.NewInt(fast)}
+}
+
+func TestOracle(t *testing.T) {
+	conf := &config.GasOracleConfiguration{
+		FeeHistoryBlocks:  4,
+		CacheTTL:          10 * time.Second,
+		Percentiles:       map[string]float64{"slow": 10, "standard": 50, "fast": 90},
+		BaseFeeMultiplier: 2,
+		MinPriorityFee:    big.NewInt(5),
+		Urgency:           "fast",
+	}
+	ctx := context.Background()
+
+	client := &stubClient{history: &ethereum.FeeHistory{
+		BaseFee:      []*big.Int{big.NewInt(80), big.NewInt(90), big.NewInt(95), big.NewInt(100), big.NewInt(110)},
+		GasUsedRatio: []float64{0.5, 0, 0.7, 0.4},
+		Reward: [][]*big.Int{
+			rewards(10, 20, 30),
+			rewards(0, 0, 0),
+			rewards(2, 40, 60),
+			rewards(12, 30, 90),
+		},
+	}}
+
+	t.Run("suggests the next base fee with the median priority fee of each urgency", func(t *testing.T) {
+		oracle := New(conf)
+
+		fees, err := oracle.SuggestFees(ctx, 8453, client, UrgencyStandard)
+		assert.NoError(t, err)
+		assert.Equal(t, []float64{10, 50, 90}, client.percentiles)
+		assert.Equal(t, int64(110), fees.BaseFee.Int64())
+		assert.Equal(t, int64(30), fees.MaxPriorityFeePerGas.Int64())
+		assert.Equal(t, int64(250), fees.MaxFeePerGas.Int64())
+
+		fees, err = oracle.SuggestFees(ctx, 8453, client, UrgencyFast)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(60), fees.MaxPriorityFeePerGas.Int64())
+		assert.Equal(t, int64(280), fees.MaxFeePerGas.Int64())
+	})
+
+	t.Run("never suggests less than the minimum priority fee", func(t *testing.T) {
+		fees, err := New(conf).SuggestFees(ctx, 8453, client, UrgencySlow)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(10), fees.MaxPriorityFeePerGas.Int64())
+
+		empty := &stubClient{history: &ethereum.FeeHistory{
+			BaseFee:      []*big.Int{big.NewInt(100), big.NewInt(100)},
+			GasUsedRatio: []float64{0},
+			Reward:       [][]*big.Int{rewards(0, 0, 0)},
+		}}
+		fees, err = New(conf).SuggestFees(ctx, 8453, empty, UrgencyFast)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), fees.MaxPriorityFeePerGas.Int64())
+		assert.Equal(t, int64(205), fees.MaxFeePerGas.Int64())
+	})
+
+	t.Run("caches suggestions per network", func(t *testing.T) {
+		oracle := New(conf)
+		now := time.Now()
+		oracle.now = func() time.Time { return now }
+		client.calls = 0
+
+		for _, urgency := range []Urgency{UrgencySlow, UrgencyStandard, UrgencyFast} {
+			_, err := oracle.SuggestFees(ctx, 8453, client, urgency)
+			assert.NoError(t, err)
+		}
+		assert.Equal(t, 1, client.calls)
+
+		// Callers may modify the fees they get
+		fees, _ := oracle.SuggestFees(ctx, 8453, client, UrgencyStandard)
+		fees.MaxFeePerGas.SetInt64(0)
+		fees, _ = oracle.SuggestFees(ctx, 8453, client, UrgencyStandard)
+		assert.Equal(t, int64(250), fees.MaxFeePerGas.Int64())
+
+		_, err := oracle.SuggestFees(ctx, 84532, client, UrgencyStandard)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, client.calls)
+
+		now = now.Add(conf.CacheTTL)
+		_, err = oracle.SuggestFees(ctx, 8453, client, UrgencyStandard)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, client.calls)
+	})
+
+	t.Run("rejects networks without a base fee", func(t *testing.T) {
+		legacy := &stubClient{history: &ethereum.FeeHistory{
+			BaseFee: []*big.Int{big.NewInt(0), big.NewInt(0)},
+			Reward:  [][]*big.Int{rewards(1, 2, 3)},
+		}}
+		_, err := New(conf).SuggestFees(ctx, 56, legacy, UrgencyStandard)
+		assert.ErrorIs(t, err, ErrNoFeeMarket)
+	})
+
+	t.Run("parses urgency levels", func(t *testing.T) {
+		assert.Equal(t, UrgencyFast, New(conf).Urgency())
+		assert.Equal(t, UrgencyStandard, New(&config.GasOracleConfiguration{Urgency: "urgent"}).Urgency())
+
+		_, err := ParseUrgency("urgent")
+		assert.Error(t, err)
+		_, err = New(conf).SuggestFees(ctx, 8453, client, "urgent")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,278 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	networkent "github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/paymentwebhook"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	tokenent "github.com/NEDA-LABS/stablenode/ent/token"
+	"github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// NetworkResetReport describes the chain state a network reset clears
+type NetworkResetReport struct {
+	Network            string
+	StoredGenesisHash  string
+	LiveGenesisHash    string
+	IndexCheckpoints   int
+	PaymentWebhooks    int
+	OrderWebhooks      int
+	PoolDeployments    int
+	WebhooksRecreated  bool
+	WebhookRecreateErr error
+	Applied            bool
+}
+
+// FetchGenesisHash returns the hash of block 0 on the network's chain
+func FetchGenesisHash(ctx context.Context, network *ent.Network) (string, error) {
+	client, err := ethclient.DialContext(ctx, utils.BuildRPCURL(network.RPCEndpoint))
+	if err != nil {
+		return "", fmt.Errorf("failed to connect to RPC: %w", err)
+	}
+	defer client.Close()
+
+	header, err := client.HeaderByNumber(ctx, big.NewInt(0))
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch genesis block: %w", err)
+	}
+
+	return header.Hash().Hex(), nil
+}
+
+// CheckNetworkGenesis compares each EVM network's live genesis hash with the recorded one.
+// Networks seen for the first time have their hash recorded; networks whose chain was reset
+// are flagged so webhook processing ignores them until an operator runs the network reset
+func CheckNetworkGenesis(ctx context.Context) error {
+	networks, err := storage.Client.Network.
+		Query().
+		Where(networkent.Not(networkent.IdentifierHasPrefix("tron")), networkent.NetworkTypeNEQ(networkent.NetworkTypeSolana)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch networks: %w", err)
+	}
+
+	slackService := NewSlackService(config.ServerConfig().SlackWebhookURL)
+
+	for _, network := range networks {
+		rpcCtx, cancel := context.WithTimeout(ctx, 15*time.Second)
+		liveHash, err := FetchGenesisHash(rpcCtx, network)
+		cancel()
+		if err != nil {
+			logger.WithFields(logger.Fields{
+				"Network": network.Identifier,
+				"Error":   err.Error(),
+			}).Warnf("Failed to check network genesis")
+			continue
+		}
+
+		switch {
+		case network.GenesisHash == "":
+			_, err = network.Update().
+				SetGenesisHash(liveHash).
+				Save(ctx)
+			if err != nil {
+				logger.Errorf("Failed to record genesis hash for %s: %v", network.Identifier, err)
+			}
+
+		case !strings.EqualFold(network.GenesisHash, liveHash):
+			if !network.GenesisMismatch {
+				_, err = network.Update().
+					SetGenesisMismatch(true).
+					Save(ctx)
+				if err != nil {
+					logger.Errorf("Failed to flag genesis mismatch for %s: %v", network.Identifier, err)
+				}
+			}
+
+			logger.WithFields(logger.Fields{
+				"Network":    network.Identifier,
+				"ChainID":    network.ChainID,
+				"StoredHash": network.GenesisHash,
+				"LiveHash":   liveHash,
+			}).Errorf("🚨 Network genesis changed - webhooks for this network are ignored until it is reset with: go run cmd/reset_network/main.go --network %s", network.Identifier)
+
+			err = slackService.SendAlert("Network genesis changed", map[string]string{
+				"Network":     network.Identifier,
+				"Chain ID":    fmt.Sprintf("%d", network.ChainID),
+				"Stored Hash": network.GenesisHash,
+				"Live Hash":   liveHash,
+				"Action":      fmt.Sprintf("go run cmd/reset_network/main.go --network %s", network.Identifier),
+			})
+			if err != nil {
+				logger.Errorf("Failed to send genesis alert: %v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ResetNetworkChainState clears state tied to a network's previous chain history after a reset:
+// receive address index checkpoints, webhook registrations and pool deployments. The live genesis
+// hash is recorded and the network is unflagged. With dryRun only the report is returned
+func ResetNetworkChainState(ctx context.Context, network *ent.Network, dryRun bool) (*NetworkResetReport, error) {
+	liveHash, err := FetchGenesisHash(ctx, network)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &NetworkResetReport{
+		Network:           network.Identifier,
+		StoredGenesisHash: network.GenesisHash,
+		LiveGenesisHash:   liveHash,
+	}
+
+	networkAddresses := receiveaddress.Or(
+		receiveaddress.NetworkIdentifierEQ(network.Identifier),
+		receiveaddress.HasPaymentOrderWith(
+			paymentorder.HasTokenWith(tokenent.HasNetworkWith(networkent.IDEQ(network.ID))),
+		),
+	)
+
+	report.IndexCheckpoints, err = storage.Client.ReceiveAddress.
+		Query().
+		Where(networkAddresses, receiveaddress.LastIndexedBlockNotNil()).
+		Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count index checkpoints: %w", err)
+	}
+
+	report.PoolDeployments, err = storage.Client.ReceiveAddress.
+		Query().
+		Where(receiveaddress.NetworkIdentifierEQ(network.Identifier), receiveaddress.IsDeployedEQ(true)).
+		Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count pool deployments: %w", err)
+	}
+
+	report.PaymentWebhooks, err = storage.Client.PaymentWebhook.
+		Query().
+		Where(paymentwebhook.HasNetworkWith(networkent.IDEQ(network.ID))).
+		Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count payment webhooks: %w", err)
+	}
+
+	orderWebhooks, err := storage.Client.PaymentWebhook.
+		Query().
+		Where(paymentwebhook.HasPaymentOrderWith(
+			paymentorder.HasTokenWith(tokenent.HasNetworkWith(networkent.IDEQ(network.ID))),
+		)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch order webhooks: %w", err)
+	}
+	report.OrderWebhooks = len(orderWebhooks)
+
+	if dryRun {
+		return report, nil
+	}
+
+	tx, err := storage.Client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Index checkpoints reference blocks of the old chain
+	_, err = tx.ReceiveAddress.
+		Update().
+		Where(networkAddresses, receiveaddress.LastIndexedBlockNotNil()).
+		ClearLastIndexedBlock().
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("failed to clear index checkpoints: %w", err)
+	}
+
+	// Smart accounts deployed on the old chain no longer exist; pool addresses must be redeployed
+	_, err = tx.ReceiveAddress.
+		Update().
+		Where(
+			receiveaddress.NetworkIdentifierEQ(network.Identifier),
+			receiveaddress.IsDeployedEQ(true),
+			receiveaddress.StatusIn(receiveaddress.StatusPoolReady, receiveaddress.StatusPoolCompleted),
+		).
+		SetStatus(receiveaddress.StatusUnused).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("failed to return pool addresses for redeployment: %w", err)
+	}
+
+	_, err = tx.ReceiveAddress.
+		Update().
+		Where(receiveaddress.NetworkIdentifierEQ(network.Identifier), receiveaddress.IsDeployedEQ(true)).
+		SetIsDeployed(false).
+		ClearDeploymentBlock().
+		ClearDeploymentTxHash().
+		ClearDeployedAt().
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("failed to invalidate pool deployments: %w", err)
+	}
+
+	_, err = tx.PaymentWebhook.
+		Delete().
+		Where(paymentwebhook.HasNetworkWith(networkent.IDEQ(network.ID))).
+		Exec(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("failed to delete payment webhooks: %w", err)
+	}
+
+	_, err = tx.Network.
+		UpdateOne(network).
+		SetGenesisHash(liveHash).
+		SetGenesisMismatch(false).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("failed to update network genesis: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit network reset: %w", err)
+	}
+	report.Applied = true
+
+	// Per-order transfer webhooks watch the old chain; drop them along with their records
+	engineService := NewEngineService()
+	for _, webhook := range orderWebhooks {
+		if err := engineService.DeleteWebhookAndRecord(ctx, webhook.WebhookID); err != nil {
+			logger.WithFields(logger.Fields{
+				"Network":   network.Identifier,
+				"WebhookID": webhook.WebhookID,
+				"Error":     err.Error(),
+			}).Warnf("Failed to delete order webhook during network reset")
+		}
+	}
+
+	// Register the gateway webhook again now that the network is no longer flagged
+	if NewServiceManager().GetActiveService() == "Thirdweb Engine" {
+		report.WebhookRecreateErr = engineService.CreateGatewayWebhook()
+		report.WebhooksRecreated = report.WebhookRecreateErr == nil
+	}
+
+	logger.WithFields(logger.Fields{
+		"Network":          network.Identifier,
+		"GenesisHash":      liveHash,
+		"IndexCheckpoints": report.IndexCheckpoints,
+		"PoolDeployments":  report.PoolDeployments,
+		"PaymentWebhooks":  report.PaymentWebhooks,
+		"OrderWebhooks":    report.OrderWebhooks,
+	}).Infof("Network chain state reset")
+
+	return report, nil
+}
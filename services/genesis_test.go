@@ -0,0 +1,151 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	"github.com/NEDA-LABS/stablenode/ent/migrate"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/test"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+// newGenesisRPCServer serves a genesis block whose hash depends on extraData
+func newGenesisRPCServer(t *testing.T, extraData *string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		if req.Method != "eth_getBlockByNumber" {
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"error":{"code":-32601,"message":"method not found"}}`, req.ID)
+			return
+		}
+
+		zeroHash := "0x0000000000000000000000000000000000000000000000000000000000000000"
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":{
+			"parentHash":"%s","sha3Uncles":"%s","miner":"0x0000000000000000000000000000000000000000",
+			"stateRoot":"%s","transactionsRoot":"%s","receiptsRoot":"%s",
+			"logsBloom":"0x%0512x","difficulty":"0x1","number":"0x0","gasLimit":"0x1c9c380","gasUsed":"0x0",
+			"timestamp":"0x0","extraData":"%s","mixHash":"%s","nonce":"0x0000000000000000"
+		}}`, req.ID, zeroHash, zeroHash, zeroHash, zeroHash, zeroHash, 0, *extraData, zeroHash)
+	}))
+}
+
+func TestNetworkGenesis(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:genesis?mode=memory&_fk=1")
+	defer client.Close()
+
+	if err := client.Schema.Create(context.Background(), migrate.WithGlobalUniqueID(true)); err != nil {
+		t.Fatal(err)
+	}
+	db.Client = client
+
+	viper.Set("USE_ALCHEMY_SERVICE", true)
+	defer viper.Set("USE_ALCHEMY_SERVICE", false)
+
+	extraData := "0x01"
+	server := newGenesisRPCServer(t, &extraData)
+	defer server.Close()
+
+	ctx := context.Background()
+	network, err := test.CreateTestNetwork(map[string]interface{}{
+		"identifier": "base-sepolia",
+		"chainID":    int64(84532),
+		"networkRPC": server.URL,
+	})
+	assert.NoError(t, err)
+
+	_, err = client.ReceiveAddress.
+		Create().
+		SetAddress("0x1111111111111111111111111111111111111111").
+		SetNetworkIdentifier(network.Identifier).
+		SetStatus(receiveaddress.StatusPoolReady).
+		SetIsDeployed(true).
+		SetDeploymentBlock(100).
+		SetDeployedAt(time.Now()).
+		SetLastIndexedBlock(120).
+		Save(ctx)
+	assert.NoError(t, err)
+
+	_, err = client.PaymentWebhook.
+		Create().
+		SetWebhookID("gateway-webhook").
+		SetWebhookSecret("secret").
+		SetCallbackURL("https://api.example.com/v1/insight/webhook").
+		SetNetwork(network).
+		Save(ctx)
+	assert.NoError(t, err)
+
+	var originalHash string
+
+	t.Run("should record the genesis hash of a new network", func(t *testing.T) {
+		assert.NoError(t, CheckNetworkGenesis(ctx))
+
+		network = client.Network.GetX(ctx, network.ID)
+		assert.NotEmpty(t, network.GenesisHash)
+		assert.False(t, network.GenesisMismatch)
+		originalHash = network.GenesisHash
+	})
+
+	t.Run("should flag a network whose genesis changed", func(t *testing.T) {
+		extraData = "0x02"
+		assert.NoError(t, CheckNetworkGenesis(ctx))
+
+		network = client.Network.GetX(ctx, network.ID)
+		assert.Equal(t, originalHash, network.GenesisHash)
+		assert.True(t, network.GenesisMismatch)
+	})
+
+	t.Run("should report without changing anything on a dry run", func(t *testing.T) {
+		report, err := ResetNetworkChainState(ctx, network, true)
+		assert.NoError(t, err)
+		assert.False(t, report.Applied)
+		assert.Equal(t, originalHash, report.StoredGenesisHash)
+		assert.NotEqual(t, originalHash, report.LiveGenesisHash)
+		assert.Equal(t, 1, report.IndexCheckpoints)
+		assert.Equal(t, 1, report.PoolDeployments)
+		assert.Equal(t, 1, report.PaymentWebhooks)
+
+		assert.True(t, client.Network.GetX(ctx, network.ID).GenesisMismatch)
+		assert.Equal(t, 1, client.PaymentWebhook.Query().CountX(ctx))
+	})
+
+	t.Run("should clear stale chain state on reset", func(t *testing.T) {
+		report, err := ResetNetworkChainState(ctx, network, false)
+		assert.NoError(t, err)
+		assert.True(t, report.Applied)
+
+		network = client.Network.GetX(ctx, network.ID)
+		assert.Equal(t, report.LiveGenesisHash, network.GenesisHash)
+		assert.False(t, network.GenesisMismatch)
+
+		address, err := client.ReceiveAddress.Query().Only(ctx)
+		assert.NoError(t, err)
+		assert.False(t, address.IsDeployed)
+		assert.Equal(t, receiveaddress.StatusUnused, address.Status)
+		assert.Zero(t, address.LastIndexedBlock)
+		assert.Zero(t, address.DeploymentBlock)
+		assert.True(t, address.DeployedAt.IsZero())
+
+		count, err := client.PaymentWebhook.Query().Count(ctx)
+		assert.NoError(t, err)
+		assert.Zero(t, count)
+	})
+
+	t.Run("should not flag the network after reset", func(t *testing.T) {
+		assert.NoError(t, CheckNetworkGenesis(ctx))
+		assert.False(t, client.Network.GetX(ctx, network.ID).GenesisMismatch)
+	})
+}
@@ -0,0 +1,238 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/services"
+	"github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+)
+
+// Statuses of a check and of a report
+const (
+	StatusOK       = "ok"
+	StatusDegraded = "degraded"
+	StatusDown     = "down"
+)
+
+// BlockchainService is the blockchain service whose health readiness reports
+type BlockchainService interface {
+	IsHealthy(ctx context.Context) bool
+	GetActiveService() string
+}
+
+// cronHeartbeat is the time in Unix nanoseconds the cron scheduler last ticked a job, or 0 if it
+// has not started in this process
+var cronHeartbeat atomic.Int64
+
+// RecordCronTick records that the cron scheduler ticked a job
+func RecordCronTick() {
+	cronHeartbeat.Store(time.Now().UnixNano())
+}
+
+// check is a named subsystem check. A critical check that is down takes the instance out of
+// service; other checks can only degrade it
+type check struct {
+	name     string
+	critical bool
+	run      func(ctx context.Context) types.HealthCheckResult
+}
+
+// Checker reports on the subsystems the aggregator depends on
+type Checker struct {
+	blockchain BlockchainService
+}
+
+// NewChecker creates a checker reporting on the health of a blockchain service
+func NewChecker(blockchain BlockchainService) *Checker {
+	return &Checker{blockchain: blockchain}
+}
+
+// Live reports whether the process is working. Only a stalled cron scheduler fails it, since
+// restarting the process is the only remedy
+func (c *Checker) Live(ctx context.Context) types.HealthReport {
+	return c.report(ctx, []check{
+		{name: "cron", critical: true, run: c.checkCron},
+	})
+}
+
+// Ready reports whether the instance can serve traffic. It is down when the database, Redis or the
+// blockchain service is, and degraded when webhooks aren't registered, a receive address pool is
+// running low or the cron scheduler has stalled
+func (c *Checker) Ready(ctx context.Context) types.HealthReport {
+	return c.report(ctx, []check{
+		{name: "database", critical: true, run: c.checkDatabase},
+		{name: "redis", critical: true, run: c.checkRedis},
+		{name: "blockchain", critical: true, run: c.checkBlockchain},
+		{name: "webhooks", run: c.checkWebhooks},
+		{name: "pool", run: c.checkPool},
+		{name: "cron", run: c.checkCron},
+	})
+}
+
+// report runs the checks concurrently, each bounded by the configured timeout
+func (c *Checker) report(ctx context.Context, checks []check) types.HealthReport {
+	timeout := config.HealthConfig().CheckTimeout
+
+	results := make([]types.HealthCheckResult, len(checks))
+	var wg sync.WaitGroup
+	for i, chk := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			checkCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			results[i] = chk.run(checkCtx)
+			results[i].Critical = chk.critical
+		}()
+	}
+	wg.Wait()
+
+	report := types.HealthReport{
+		Status: StatusOK,
+		Checks: make(map[string]types.HealthCheckResult, len(checks)),
+	}
+	for i, chk := range checks {
+		result := results[i]
+		report.Checks[chk.name] = result
+
+		switch {
+		case result.Status == StatusOK:
+		case chk.critical && result.Status == StatusDown:
+			report.Status = StatusDown
+		case report.Status == StatusOK:
+			report.Status = StatusDegraded
+		}
+	}
+	return report
+}
+
+// checkDatabase pings the database
+func (c *Checker) checkDatabase(ctx context.Context) types.HealthCheckResult {
+	if storage.DB == nil {
+		return failed(StatusDown, errors.New("database is not connected"))
+	}
+	if err := storage.DB.PingContext(ctx); err != nil {
+		return failed(StatusDown, err)
+	}
+	return types.HealthCheckResult{Status: StatusOK}
+}
+
+// checkRedis pings Redis
+func (c *Checker) checkRedis(ctx context.Context) types.HealthCheckResult {
+	if storage.RedisClient == nil {
+		return failed(StatusDown, errors.New("redis is not connected"))
+	}
+	if err := storage.RedisClient.Ping(ctx).Err(); err != nil {
+		return failed(StatusDown, err)
+	}
+	return types.HealthCheckResult{Status: StatusOK}
+}
+
+// checkBlockchain checks the active blockchain service
+func (c *Checker) checkBlockchain(ctx context.Context) types.HealthCheckResult {
+	detail := map[string]interface{}{
+		"service": c.blockchain.GetActiveService(),
+	}
+	if !c.blockchain.IsHealthy(ctx) {
+		return types.HealthCheckResult{
+			Status: StatusDown,
+			Error:  "blockchain service is unhealthy",
+			Detail: detail,
+		}
+	}
+	return types.HealthCheckResult{Status: StatusOK, Detail: detail}
+}
+
+// checkWebhooks checks the SERVER_URL self-check and the gateway webhook registration
+func (c *Checker) checkWebhooks(ctx context.Context) types.HealthCheckResult {
+	registration := services.WebhookRegistrationStatus()
+	result := types.HealthCheckResult{
+		Status: StatusOK,
+		Detail: map[string]interface{}{
+			"registration": registration.Status,
+		},
+	}
+
+	switch {
+	case services.WebhookURLUnreachable():
+		result.Status = StatusDegraded
+		result.Error = "SERVER_URL failed the self-check"
+	case registration.Status == services.WebhookRegistrationFailed:
+		result.Status = StatusDegraded
+		result.Error = registration.Error
+	}
+	return result
+}
+
+// checkPool checks that every network keeps enough pool_ready receive addresses
+func (c *Checker) checkPool(ctx context.Context) types.HealthCheckResult {
+	minReady := config.HealthConfig().PoolMinReady
+	if minReady <= 0 {
+		return types.HealthCheckResult{Status: StatusOK}
+	}
+
+	statuses, err := storage.PoolStatus(ctx, "")
+	if err != nil {
+		return failed(StatusDegraded, err)
+	}
+
+	ready := make(map[string]int, len(statuses))
+	var low []string
+	for _, status := range statuses {
+		ready[status.Network] = status.PoolReady
+		if status.PoolReady < minReady {
+			low = append(low, status.Network)
+		}
+	}
+
+	result := types.HealthCheckResult{
+		Status: StatusOK,
+		Detail: map[string]interface{}{
+			"minReady": minReady,
+			"ready":    ready,
+		},
+	}
+	if len(low) > 0 {
+		result.Status = StatusDegraded
+		result.Error = fmt.Sprintf("pool_ready addresses below %d on %v", minReady, low)
+	}
+	return result
+}
+
+// checkCron checks that the cron scheduler ticked a job recently
+func (c *Checker) checkCron(ctx context.Context) types.HealthCheckResult {
+	heartbeat := cronHeartbeat.Load()
+	if heartbeat == 0 {
+		return types.HealthCheckResult{
+			Status: StatusOK,
+			Detail: map[string]interface{}{"running": false},
+		}
+	}
+
+	since := time.Since(time.Unix(0, heartbeat))
+	result := types.HealthCheckResult{
+		Status: StatusOK,
+		Detail: map[string]interface{}{
+			"running":            true,
+			"lastTickAgoSeconds": int64(since.Seconds()),
+		},
+	}
+	if staleAfter := config.HealthConfig().CronStaleAfter; since > staleAfter {
+		result.Status = StatusDown
+		result.Error = fmt.Sprintf("no cron job ticked in %v", since.Truncate(time.Second))
+	}
+	return result
+}
+
+// failed is the result of a check that returned an error
+func failed(status string, err error) types.HealthCheckResult {
+	return types.HealthCheckResult{Status: status, Error: err.Error()}
+}
@@ -0,0 +1,137 @@
+package health
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	"github.com/NEDA-LABS/stablenode/services"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/alicebob/miniredis/v2"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/redis/go-redis/v9"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockBlockchainService struct {
+	healthy bool
+}
+
+func (m *mockBlockchainService) IsHealthy(ctx context.Context) bool {
+	return m.healthy
+}
+
+func (m *mockBlockchainService) GetActiveService() string {
+	return "Alchemy"
+}
+
+func TestChecker(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:health?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	sqlDB, err := sql.Open("sqlite3", "file:healthping?mode=memory")
+	require.NoError(t, err)
+	defer sqlDB.Close()
+	db.DB = sqlDB
+
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+	defer mr.Close()
+	db.RedisClient = redis.NewClient(&redis.Options{Addr: mr.Addr()})
+
+	viper.Set("HEALTH_POOL_MIN_READY", 2)
+	defer viper.Set("HEALTH_POOL_MIN_READY", nil)
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		client.ReceiveAddress.
+			Create().
+			SetAddress(fmt.Sprintf("0x%040d", i)).
+			SetStatus(receiveaddress.StatusPoolReady).
+			SetIsDeployed(true).
+			SetNetworkIdentifier("base").
+			SetChainID(8453).
+			SaveX(ctx)
+	}
+
+	blockchain := &mockBlockchainService{healthy: true}
+	checker := NewChecker(blockchain)
+	services.RecordWebhookRegistration(services.WebhookRegistrationSkipped, nil)
+
+	t.Run("is ready when every subsystem is healthy", func(t *testing.T) {
+		report := checker.Ready(ctx)
+		assert.Equal(t, StatusOK, report.Status, report.Checks)
+		assert.Len(t, report.Checks, 6)
+		assert.True(t, report.Checks["database"].Critical)
+		assert.False(t, report.Checks["pool"].Critical)
+	})
+
+	t.Run("degrades when a pool runs low", func(t *testing.T) {
+		client.ReceiveAddress.
+			Update().
+			Where(receiveaddress.AddressEQ(fmt.Sprintf("0x%040d", 0))).
+			SetStatus(receiveaddress.StatusPoolAssigned).
+			ExecX(ctx)
+
+		report := checker.Ready(ctx)
+		assert.Equal(t, StatusDegraded, report.Status)
+		assert.Equal(t, StatusDegraded, report.Checks["pool"].Status)
+		assert.Contains(t, report.Checks["pool"].Error, "base")
+	})
+
+	t.Run("degrades when webhook registration failed", func(t *testing.T) {
+		viper.Set("HEALTH_POOL_MIN_READY", 0)
+		defer viper.Set("HEALTH_POOL_MIN_READY", 2)
+		services.RecordWebhookRegistration(services.WebhookRegistrationRegistered, errors.New("engine unavailable"))
+		defer services.RecordWebhookRegistration(services.WebhookRegistrationSkipped, nil)
+
+		report := checker.Ready(ctx)
+		assert.Equal(t, StatusDegraded, report.Status)
+		assert.Equal(t, "engine unavailable", report.Checks["webhooks"].Error)
+	})
+
+	t.Run("is down when the blockchain service is unhealthy", func(t *testing.T) {
+		blockchain.healthy = false
+		defer func() { blockchain.healthy = true }()
+
+		report := checker.Ready(ctx)
+		assert.Equal(t, StatusDown, report.Status)
+		assert.Equal(t, StatusDown, report.Checks["blockchain"].Status)
+	})
+
+	t.Run("is down when Redis is unreachable", func(t *testing.T) {
+		previous := db.RedisClient
+		db.RedisClient = redis.NewClient(&redis.Options{Addr: "127.0.0.1:1"})
+		defer func() { db.RedisClient = previous }()
+
+		report := checker.Ready(ctx)
+		assert.Equal(t, StatusDown, report.Status)
+		assert.NotEmpty(t, report.Checks["redis"].Error)
+	})
+
+	t.Run("fails liveness once the cron scheduler stalls", func(t *testing.T) {
+		defer cronHeartbeat.Store(0)
+
+		assert.Equal(t, StatusOK, checker.Live(ctx).Status)
+
+		RecordCronTick()
+		assert.Equal(t, StatusOK, checker.Live(ctx).Status)
+
+		cronHeartbeat.Store(time.Now().Add(-time.Hour).UnixNano())
+		assert.Equal(t, StatusDown, checker.Live(ctx).Status)
+
+		viper.Set("HEALTH_POOL_MIN_READY", 0)
+		defer viper.Set("HEALTH_POOL_MIN_READY", 2)
+		report := checker.Ready(ctx)
+		assert.Equal(t, StatusDegraded, report.Status)
+		assert.Equal(t, StatusDown, report.Checks["cron"].Status)
+	})
+}
@@ -9,10 +9,11 @@ import (
 	"github.com/NEDA-LABS/stablenode/ent"
 	"github.com/NEDA-LABS/stablenode/services"
 	"github.com/NEDA-LABS/stablenode/services/common"
+	"github.com/NEDA-LABS/stablenode/services/events"
 	"github.com/NEDA-LABS/stablenode/services/order"
 	"github.com/NEDA-LABS/stablenode/types"
-	"github.com/NEDA-LABS/stablenode/utils"
 	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/NEDA-LABS/stablenode/utils/metrics"
 	"github.com/shopspring/decimal"
 )
 
@@ -92,7 +93,7 @@ func (s *IndexerEVM) indexReceiveAddressByTransaction(ctx context.Context, token
 		token.ContractAddress,
 		0,
 		0,
-		[]string{utils.TransferEventSignature}, // Include transfer event signature
+		[]string{events.Topic(events.ERC20, "Transfer").Hex()}, // Include transfer event signature
 		txHash,
 		map[string]string{
 			"filter_transaction_hash": txHash,
@@ -117,7 +118,7 @@ func (s *IndexerEVM) indexReceiveAddressByTransaction(ctx context.Context, token
 		token.Edges.Network.GatewayContractAddress,
 		0,
 		0,
-		[]string{utils.OrderCreatedEventSignature},
+		[]string{events.Topic(events.Gateway, "OrderCreated").Hex()},
 		txHash,
 		map[string]string{
 			"filter_transaction_hash": txHash,
@@ -142,75 +143,22 @@ func (s *IndexerEVM) indexReceiveAddressByTransaction(ctx context.Context, token
 		"TransferEvents": len(transferEvents),
 	}).Info("Starting to process transfer events")
 
-	for i, event := range transferEvents {
-		eventMap := event.(map[string]interface{})
-		decoded, ok := eventMap["decoded"].(map[string]interface{})
-		if !ok || decoded == nil {
-			logger.WithFields(logger.Fields{
-				"TxHash":     txHash,
-				"EventIndex": i,
-			}).Warn("Transfer event missing decoded data")
-			continue
-		}
-		// Safely extract indexed_params and non_indexed_params
-		indexedParams, ok := decoded["indexed_params"].(map[string]interface{})
-		if !ok || indexedParams == nil {
-			continue
-		}
-		nonIndexedParams, ok := decoded["non_indexed_params"].(map[string]interface{})
-		if !ok || nonIndexedParams == nil {
-			continue
-		}
-		// Safely extract transfer data
-		fromStr, ok := indexedParams["from"].(string)
-		if !ok || fromStr == "" {
-			continue
-		}
-		fromAddress := strings.ToLower(ethcommon.HexToAddress(fromStr).Hex())
-
-		toStr, ok := indexedParams["to"].(string)
-		if !ok || toStr == "" {
-			continue
-		}
-		toAddress := strings.ToLower(ethcommon.HexToAddress(toStr).Hex())
-
-		valueStr, ok := nonIndexedParams["value"].(string)
-		if !ok || valueStr == "" {
+	for _, event := range transferEvents {
+		transfer, ok := event.(*events.Transfer)
+		if !ok {
 			continue
 		}
+		fromAddress := strings.ToLower(transfer.From.Hex())
+		toAddress := strings.ToLower(transfer.To.Hex())
 
 		// Skip if transfer is from gateway contract
 		if strings.EqualFold(fromAddress, token.Edges.Network.GatewayContractAddress) {
 			continue
 		}
 
-		// Parse transfer value
-		transferValue, err := decimal.NewFromString(valueStr)
-		if err != nil {
-			logger.Errorf("Error parsing transfer value for token %s: %v", token.Symbol, err)
-			continue
-		}
-
-		// Safely extract block_number and transaction_hash
-		blockNumberRaw, ok := eventMap["block_number"].(float64)
-		if !ok {
-			continue
-		}
-		blockNumber := int64(blockNumberRaw)
-
-		txHashFromEvent, ok := eventMap["transaction_hash"].(string)
-		if !ok || txHashFromEvent == "" {
-			continue
-		}
-
-		// Create transfer event
-		transferEvent := &types.TokenTransferEvent{
-			BlockNumber: blockNumber,
-			TxHash:      txHashFromEvent,
-			From:        fromAddress,
-			To:          toAddress,
-			Value:       transferValue.Div(decimal.NewFromInt(10).Pow(decimal.NewFromInt(int64(token.Decimals)))),
-		}
+		transferEvent := tokenTransferEvent(transfer, token.Decimals)
+		blockNumber := transferEvent.BlockNumber
+		txHashFromEvent := transferEvent.TxHash
 
 		logger.WithFields(logger.Fields{
 			"TxHash":      txHashFromEvent,
@@ -239,6 +187,7 @@ func (s *IndexerEVM) indexReceiveAddressByTransaction(ctx context.Context, token
 			"TxHash": txHashFromEvent,
 			"To":     toAddress,
 		}).Info("Successfully processed transfer event")
+		metrics.PaymentDetected(metrics.SourceIndexer, token.Edges.Network.Identifier)
 
 		// Increment transfer count for successful processing
 		eventCounts.Transfer++
@@ -248,99 +197,9 @@ func (s *IndexerEVM) indexReceiveAddressByTransaction(ctx context.Context, token
 	orderCreatedEvents := []*types.OrderCreatedEvent{}
 
 	for _, event := range gatewayEvents {
-		eventMap := event.(map[string]interface{})
-		decoded, ok := eventMap["decoded"].(map[string]interface{})
-		if !ok || decoded == nil {
-			continue
-		}
-		eventParams := decoded
-		if eventParams["non_indexed_params"] == nil {
-			continue
-		}
-
-		// Safely extract block_number and transaction_hash
-		blockNumberRaw, ok := eventMap["block_number"].(float64)
-		if !ok {
-			continue
-		}
-		blockNumber := int64(blockNumberRaw)
-
-		txHash, ok := eventMap["transaction_hash"].(string)
-		if !ok || txHash == "" {
-			continue
-		}
-
-		// Safely extract indexed_params and non_indexed_params
-		indexedParams, ok := eventParams["indexed_params"].(map[string]interface{})
-		if !ok || indexedParams == nil {
-			continue
-		}
-
-		nonIndexedParams, ok := eventParams["non_indexed_params"].(map[string]interface{})
-		if !ok || nonIndexedParams == nil {
-			continue
-		}
-
-		// Safely extract required fields
-		amountStr, ok := indexedParams["amount"].(string)
-		if !ok || amountStr == "" {
-			continue
+		if created, ok := event.(*events.OrderCreated); ok {
+			orderCreatedEvents = append(orderCreatedEvents, orderCreatedEvent(created))
 		}
-		orderAmount, err := decimal.NewFromString(amountStr)
-		if err != nil {
-			continue
-		}
-
-		protocolFeeStr, ok := nonIndexedParams["protocolFee"].(string)
-		if !ok || protocolFeeStr == "" {
-			continue
-		}
-		protocolFee, err := decimal.NewFromString(protocolFeeStr)
-		if err != nil {
-			continue
-		}
-
-		rateStr, ok := nonIndexedParams["rate"].(string)
-		if !ok || rateStr == "" {
-			continue
-		}
-		rate, err := decimal.NewFromString(rateStr)
-		if err != nil {
-			continue
-		}
-
-		tokenStr, ok := indexedParams["token"].(string)
-		if !ok || tokenStr == "" {
-			continue
-		}
-
-		orderIdStr, ok := nonIndexedParams["orderId"].(string)
-		if !ok || orderIdStr == "" {
-			continue
-		}
-
-		messageHashStr, ok := nonIndexedParams["messageHash"].(string)
-		if !ok || messageHashStr == "" {
-			continue
-		}
-
-		senderStr, ok := indexedParams["sender"].(string)
-		if !ok || senderStr == "" {
-			continue
-		}
-
-		createdEvent := &types.OrderCreatedEvent{
-			BlockNumber: blockNumber,
-			TxHash:      txHash,
-			Token:       ethcommon.HexToAddress(tokenStr).Hex(),
-			Amount:      orderAmount,
-			ProtocolFee: protocolFee,
-			OrderId:     orderIdStr,
-			Rate:        rate.Div(decimal.NewFromInt(100)),
-			MessageHash: messageHashStr,
-			Sender:      ethcommon.HexToAddress(senderStr).Hex(),
-		}
-		orderCreatedEvents = append(orderCreatedEvents, createdEvent)
 	}
 
 	// Process OrderCreated events
@@ -611,7 +470,7 @@ func (s *IndexerEVM) indexGatewayByTransaction(ctx context.Context, network *ent
 		"decode":                  "true",
 	}
 
-	events, err := s.alchemyService.GetContractEventsWithFallback(
+	gatewayEvents, err := s.alchemyService.GetContractEventsWithFallback(
 		ctx,
 		network,
 		network.GatewayContractAddress,
@@ -633,196 +492,23 @@ func (s *IndexerEVM) indexGatewayByTransaction(ctx context.Context, network *ent
 	orderSettledEvents := []*types.OrderSettledEvent{}
 	orderRefundedEvents := []*types.OrderRefundedEvent{}
 
-	for _, event := range events {
-		eventMap := event.(map[string]interface{})
-		decoded, ok := eventMap["decoded"].(map[string]interface{})
-		if !ok || decoded == nil {
-			continue
-		}
-		eventParams := decoded
-		if eventParams["non_indexed_params"] == nil {
-			continue
-		}
-
-		// Get event name from the first topic (event signature)
-		topicsInterface := eventMap["topics"]
-		var eventSignature string
-
-		// Handle both []string and []interface{} cases
-		switch topics := topicsInterface.(type) {
-		case []string:
-			if len(topics) == 0 {
-				continue
-			}
-			eventSignature = topics[0]
-		case []interface{}:
-			if len(topics) == 0 {
-				continue
-			}
-			if topicStr, ok := topics[0].(string); ok {
-				eventSignature = topicStr
-			} else {
-				continue
-			}
-		default:
-			logger.Warnf("Unknown topics type: %T", topicsInterface)
-			continue
-		}
-
+	for _, event := range gatewayEvents {
 		if network.ChainID != 56 && network.ChainID != 1135 {
 			// Log the event signature being processed
 			logger.WithFields(logger.Fields{
-				"EventSignature": eventSignature,
+				"EventSignature": event.Log().Topics[0].Hex(),
 				"TxHash":         txHash,
-				"BlockNumber":    int64(eventMap["block_number"].(float64)),
+				"BlockNumber":    event.Log().BlockNumber,
 			}).Infof("Processing event signature")
 		}
 
-		// Safely extract block_number and transaction_hash
-		blockNumberRaw, ok := eventMap["block_number"].(float64)
-		if !ok {
-			continue
-		}
-		blockNumber := int64(blockNumberRaw)
-
-		txHashFromEvent, ok := eventMap["transaction_hash"].(string)
-		if !ok || txHashFromEvent == "" {
-			continue
-		}
-
-		// Safely extract indexed_params and non_indexed_params
-		indexedParams, ok := eventParams["indexed_params"].(map[string]interface{})
-		if !ok || indexedParams == nil {
-			continue
-		}
-
-		nonIndexedParams, ok := eventParams["non_indexed_params"].(map[string]interface{})
-		if !ok || nonIndexedParams == nil {
-			continue
-		}
-
-		switch eventSignature {
-		case utils.OrderCreatedEventSignature:
-			// Safely extract required fields for OrderCreated
-			amountStr, ok := indexedParams["amount"].(string)
-			if !ok || amountStr == "" {
-				continue
-			}
-			orderAmount, err := decimal.NewFromString(amountStr)
-			if err != nil {
-				continue
-			}
-
-			protocolFeeStr, ok := nonIndexedParams["protocolFee"].(string)
-			if !ok || protocolFeeStr == "" {
-				continue
-			}
-			protocolFee, err := decimal.NewFromString(protocolFeeStr)
-			if err != nil {
-				continue
-			}
-
-			rateStr, ok := nonIndexedParams["rate"].(string)
-			if !ok || rateStr == "" {
-				continue
-			}
-			rate, err := decimal.NewFromString(rateStr)
-			if err != nil {
-				continue
-			}
-
-			tokenStr, ok := indexedParams["token"].(string)
-			if !ok || tokenStr == "" {
-				continue
-			}
-
-			orderIdStr, ok := nonIndexedParams["orderId"].(string)
-			if !ok || orderIdStr == "" {
-				continue
-			}
-
-			messageHashStr, ok := nonIndexedParams["messageHash"].(string)
-			if !ok || messageHashStr == "" {
-				continue
-			}
-
-			senderStr, ok := indexedParams["sender"].(string)
-			if !ok || senderStr == "" {
-				continue
-			}
-
-			createdEvent := &types.OrderCreatedEvent{
-				BlockNumber: blockNumber,
-				TxHash:      txHashFromEvent,
-				Token:       ethcommon.HexToAddress(tokenStr).Hex(),
-				Amount:      orderAmount,
-				ProtocolFee: protocolFee,
-				OrderId:     orderIdStr,
-				Rate:        rate.Div(decimal.NewFromInt(100)),
-				MessageHash: messageHashStr,
-				Sender:      ethcommon.HexToAddress(senderStr).Hex(),
-			}
-			orderCreatedEvents = append(orderCreatedEvents, createdEvent)
-
-		case utils.OrderSettledEventSignature:
-			// Safely extract required fields for OrderSettled
-			settlePercentStr, ok := nonIndexedParams["settlePercent"].(string)
-			if !ok || settlePercentStr == "" {
-				continue
-			}
-			settlePercent, err := decimal.NewFromString(settlePercentStr)
-			if err != nil {
-				continue
-			}
-
-			splitOrderIdStr, ok := nonIndexedParams["splitOrderId"].(string)
-			if !ok || splitOrderIdStr == "" {
-				continue
-			}
-
-			orderIdStr, ok := indexedParams["orderId"].(string)
-			if !ok || orderIdStr == "" {
-				continue
-			}
-
-			liquidityProviderStr, ok := indexedParams["liquidityProvider"].(string)
-			if !ok || liquidityProviderStr == "" {
-				continue
-			}
-
-			settledEvent := &types.OrderSettledEvent{
-				BlockNumber:       blockNumber,
-				TxHash:            txHashFromEvent,
-				SplitOrderId:      splitOrderIdStr,
-				OrderId:           orderIdStr,
-				LiquidityProvider: ethcommon.HexToAddress(liquidityProviderStr).Hex(),
-				SettlePercent:     settlePercent,
-			}
-			orderSettledEvents = append(orderSettledEvents, settledEvent)
-
-		case utils.OrderRefundedEventSignature:
-			// Safely extract required fields for OrderRefunded
-			feeStr, ok := nonIndexedParams["fee"].(string)
-			if !ok || feeStr == "" {
-				continue
-			}
-			fee, err := decimal.NewFromString(feeStr)
-			if err != nil {
-				continue
-			}
-
-			orderIdStr, ok := indexedParams["orderId"].(string)
-			if !ok || orderIdStr == "" {
-				continue
-			}
-
-			refundedEvent := &types.OrderRefundedEvent{
-				BlockNumber: blockNumber,
-				TxHash:      txHashFromEvent,
-				OrderId:     orderIdStr,
-				Fee:         fee,
-			}
-			orderRefundedEvents = append(orderRefundedEvents, refundedEvent)
+		switch event := event.(type) {
+		case *events.OrderCreated:
+			orderCreatedEvents = append(orderCreatedEvents, orderCreatedEvent(event))
+		case *events.OrderSettled:
+			orderSettledEvents = append(orderSettledEvents, orderSettledEvent(event))
+		case *events.OrderRefunded:
+			orderRefundedEvents = append(orderRefundedEvents, orderRefundedEvent(event))
 		}
 	}
 
@@ -891,13 +577,13 @@ func (s *IndexerEVM) indexProviderAddressByTransaction(ctx context.Context, netw
 	eventCounts := &types.EventCounts{}
 
 	// Get OrderSettled events for this transaction
-	events, err := s.alchemyService.GetContractEventsWithFallback(
+	settledEvents, err := s.alchemyService.GetContractEventsWithFallback(
 		ctx,
 		network,
 		network.GatewayContractAddress,
 		0,
 		0,
-		[]string{utils.OrderSettledEventSignature},
+		[]string{events.Topic(events.Gateway, "OrderSettled").Hex()},
 		txHash,
 		map[string]string{
 			"filter_transaction_hash": txHash,
@@ -916,81 +602,17 @@ func (s *IndexerEVM) indexProviderAddressByTransaction(ctx context.Context, netw
 	// Process OrderSettled events for the specific provider address
 	orderSettledEvents := []*types.OrderSettledEvent{}
 
-	for _, event := range events {
-		eventMap := event.(map[string]interface{})
-		decoded, ok := eventMap["decoded"].(map[string]interface{})
-		if !ok || decoded == nil {
-			continue
-		}
-		eventParams := decoded
-		if eventParams["non_indexed_params"] == nil {
-			continue
-		}
-
-		// Check if indexed_params exists and is not nil before accessing it
-		indexedParams, ok := eventParams["indexed_params"].(map[string]interface{})
-		if !ok || indexedParams == nil {
-			continue
-		}
-
-		// Check if this event is from the provider address we're looking for
-		liquidityProvider, ok := indexedParams["liquidityProvider"].(string)
-		if !ok || liquidityProvider == "" {
-			continue
-		}
-		liquidityProvider = ethcommon.HexToAddress(liquidityProvider).Hex()
-		if !strings.EqualFold(liquidityProvider, providerAddress) {
-			continue
-		}
-
-		// Safely extract block_number and transaction_hash
-		blockNumberRaw, ok := eventMap["block_number"].(float64)
+	for _, event := range settledEvents {
+		settled, ok := event.(*events.OrderSettled)
 		if !ok {
 			continue
 		}
-		blockNumber := int64(blockNumberRaw)
-
-		txHash, ok := eventMap["transaction_hash"].(string)
-		if !ok || txHash == "" {
-			continue
-		}
-
-		// Safely extract non_indexed_params
-		nonIndexedParams, ok := eventParams["non_indexed_params"].(map[string]interface{})
-		if !ok || nonIndexedParams == nil {
-			continue
-		}
-
-		// Safely extract required fields
-		settlePercentStr, ok := nonIndexedParams["settlePercent"].(string)
-		if !ok || settlePercentStr == "" {
-			continue
-		}
-
-		settlePercent, err := decimal.NewFromString(settlePercentStr)
-		if err != nil {
-			continue
-		}
-
-		splitOrderId, ok := nonIndexedParams["splitOrderId"].(string)
-		if !ok || splitOrderId == "" {
-			continue
-		}
 
-		orderId, ok := indexedParams["orderId"].(string)
-		if !ok || orderId == "" {
+		// Check if this event is from the provider address we're looking for
+		if !strings.EqualFold(settled.LiquidityProvider.Hex(), providerAddress) {
 			continue
 		}
-
-		settledEvent := &types.OrderSettledEvent{
-			BlockNumber:       blockNumber,
-			TxHash:            txHash,
-			SplitOrderId:      splitOrderId,
-			OrderId:           orderId,
-			LiquidityProvider: liquidityProvider,
-			SettlePercent:     settlePercent,
-		}
-		orderSettledEvents = append(orderSettledEvents, settledEvent)
+		orderSettledEvents = append(orderSettledEvents, orderSettledEvent(settled))
 	}
 
 	// Process OrderSettled events
@@ -1071,3 +693,52 @@ func (s *IndexerEVM) indexProviderAddressByAddress(ctx context.Context, network
 
 	return nil
 }
+
+// tokenTransferEvent converts a decoded Transfer into the token transfer the order processors
+// consume, in units of the token
+func tokenTransferEvent(event *events.Transfer, decimals int8) *types.TokenTransferEvent {
+	return &types.TokenTransferEvent{
+		BlockNumber: int64(event.Raw.BlockNumber),
+		TxHash:      event.Raw.TxHash.Hex(),
+		From:        strings.ToLower(event.From.Hex()),
+		To:          strings.ToLower(event.To.Hex()),
+		Value:       decimal.NewFromBigInt(event.Value, -int32(decimals)),
+	}
+}
+
+// orderCreatedEvent converts a decoded OrderCreated into the event the order processors consume
+func orderCreatedEvent(event *events.OrderCreated) *types.OrderCreatedEvent {
+	return &types.OrderCreatedEvent{
+		BlockNumber: int64(event.Raw.BlockNumber),
+		TxHash:      event.Raw.TxHash.Hex(),
+		Token:       event.Token.Hex(),
+		Amount:      decimal.NewFromBigInt(event.Amount, 0),
+		ProtocolFee: decimal.NewFromBigInt(event.ProtocolFee, 0),
+		OrderId:     ethcommon.Hash(event.OrderId).Hex(),
+		Rate:        decimal.NewFromBigInt(event.Rate, -2),
+		MessageHash: event.MessageHash,
+		Sender:      event.Sender.Hex(),
+	}
+}
+
+// orderSettledEvent converts a decoded OrderSettled into the event the order processors consume
+func orderSettledEvent(event *events.OrderSettled) *types.OrderSettledEvent {
+	return &types.OrderSettledEvent{
+		BlockNumber:       int64(event.Raw.BlockNumber),
+		TxHash:            event.Raw.TxHash.Hex(),
+		SplitOrderId:      ethcommon.Hash(event.SplitOrderId).Hex(),
+		OrderId:           ethcommon.Hash(event.OrderId).Hex(),
+		LiquidityProvider: event.LiquidityProvider.Hex(),
+		SettlePercent:     decimal.NewFromBigInt(event.SettlePercent, 0),
+	}
+}
+
+// orderRefundedEvent converts a decoded OrderRefunded into the event the order processors consume
+func orderRefundedEvent(event *events.OrderRefunded) *types.OrderRefundedEvent {
+	return &types.OrderRefundedEvent{
+		BlockNumber: int64(event.Raw.BlockNumber),
+		TxHash:      event.Raw.TxHash.Hex(),
+		Fee:         decimal.NewFromBigInt(event.Fee, 0),
+		OrderId:     ethcommon.Hash(event.OrderId).Hex(),
+	}
+}
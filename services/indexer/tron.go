@@ -9,21 +9,31 @@ import (
 	"strings"
 	"time"
 
+	ethcommon "github.com/ethereum/go-ethereum/common"
 	fastshot "github.com/opus-domini/fast-shot"
 	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
 	"github.com/NEDA-LABS/stablenode/services"
 	"github.com/NEDA-LABS/stablenode/services/common"
 	"github.com/NEDA-LABS/stablenode/services/contracts"
+	"github.com/NEDA-LABS/stablenode/services/events"
 	"github.com/NEDA-LABS/stablenode/services/order"
+	db "github.com/NEDA-LABS/stablenode/storage"
 	"github.com/NEDA-LABS/stablenode/types"
 	"github.com/NEDA-LABS/stablenode/utils"
 	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/NEDA-LABS/stablenode/utils/metrics"
 )
 
+// tronTransferLookback is how far back transfers are searched for addresses that aren't pool receive addresses
+const tronTransferLookback = 24 * time.Hour
+
 // IndexerTron performs blockchain to database extract, transform, load (ETL) operations.
 type IndexerTron struct {
 	priorityQueue *services.PriorityQueueService
 	order         types.OrderService
+	tron          *services.TronService
 }
 
 // NewIndexerTron creates a new instance of IndexerTron.
@@ -34,6 +44,7 @@ func NewIndexerTron() types.Indexer {
 	return &IndexerTron{
 		priorityQueue: priorityQueue,
 		order:         orderService,
+		tron:          services.NewServiceManager().GetTronService(),
 	}
 }
 
@@ -90,10 +101,10 @@ func (s *IndexerTron) indexReceiveAddressByTransaction(ctx context.Context, toke
 	// Process transfer events from this transaction
 	for _, event := range data["log"].([]interface{}) {
 		eventData := event.(map[string]interface{})
-		eventSignature := eventData["topics"].([]interface{})[0].(string)
+		eventSignature := ethcommon.HexToHash(eventData["topics"].([]interface{})[0].(string))
 
 		// Check if this is a transfer event for this token contract
-		if eventSignature == utils.TransferEventSignature && eventData["address"].(string) == token.ContractAddress {
+		if eventSignature == events.Topic(events.ERC20, "Transfer") && eventData["address"].(string) == token.ContractAddress {
 			// Extract transfer data
 			fromAddress := utils.ParseTopicToTronAddress(eventData["topics"].([]interface{})[1].(string))
 			toAddress := utils.ParseTopicToTronAddress(eventData["topics"].([]interface{})[2].(string))
@@ -131,6 +142,7 @@ func (s *IndexerTron) indexReceiveAddressByTransaction(ctx context.Context, toke
 				logger.Errorf("Error processing transfer for token %s: %v", token.Symbol, err)
 				continue
 			}
+			metrics.PaymentDetected(metrics.SourceIndexer, token.Edges.Network.Identifier)
 		}
 	}
 
@@ -139,19 +151,72 @@ func (s *IndexerTron) indexReceiveAddressByTransaction(ctx context.Context, toke
 
 // indexReceiveAddressByUserAddress processes user's transaction history for receive address transfers
 func (s *IndexerTron) indexReceiveAddressByUserAddress(ctx context.Context, token *ent.Token, userAddress string) error {
-	// For Tron, we need to implement a different approach since we don't have user transaction history
-	// This would require implementing a way to get user's transaction history from Tron network
-	// For now, we'll log that this is not implemented
-	logger.Infof("User address indexing not implemented for Tron network: %s", userAddress)
-	return nil
+	return s.indexReceiveAddressTransfers(ctx, token, userAddress, 0, 0)
 }
 
 // indexReceiveAddressByUserAddressInRange processes user's transaction history within a block range for receive address transfers
 func (s *IndexerTron) indexReceiveAddressByUserAddressInRange(ctx context.Context, token *ent.Token, userAddress string, fromBlock int64, toBlock int64) error {
-	// For Tron, we need to implement a different approach since we don't have user transaction history
-	// This would require implementing a way to get user's transaction history from Tron network
-	// For now, we'll log that this is not implemented
-	logger.Infof("User address indexing with block range not implemented for Tron network: %s (%d-%d)", userAddress, fromBlock, toBlock)
+	return s.indexReceiveAddressTransfers(ctx, token, userAddress, fromBlock, toBlock)
+}
+
+// indexReceiveAddressTransfers fetches the TRC-20 transfers to an address from TronGrid and processes the
+// ones not indexed yet. Receive addresses are searched from when they were assigned, so deposits made
+// to a recycled address for an earlier order aren't picked up again. A zero block bound is open
+func (s *IndexerTron) indexReceiveAddressTransfers(ctx context.Context, token *ent.Token, address string, fromBlock int64, toBlock int64) error {
+	since := time.Now().Add(-tronTransferLookback)
+	receiveAddress, err := db.Client.ReceiveAddress.
+		Query().
+		Where(receiveaddress.AddressEQ(address)).
+		Order(ent.Desc(receiveaddress.FieldCreatedAt)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("error fetching receive address %s: %w", address, err)
+	}
+	if receiveAddress != nil {
+		since = receiveAddress.CreatedAt
+		if receiveAddress.AssignedAt.After(since) {
+			since = receiveAddress.AssignedAt
+		}
+	}
+
+	transfers, err := s.tron.GetTokenTransfers(ctx, token.Edges.Network, token, address, since)
+	if err != nil {
+		return fmt.Errorf("error getting transfers for token %s: %w", token.Symbol, err)
+	}
+
+	for _, transfer := range transfers {
+		if (fromBlock > 0 && transfer.BlockNumber < fromBlock) || (toBlock > 0 && transfer.BlockNumber > toBlock) {
+			continue
+		}
+
+		// Skip if transfer is from gateway contract
+		if strings.EqualFold(transfer.From, token.Edges.Network.GatewayContractAddress) {
+			continue
+		}
+
+		indexed, err := db.Client.TransactionLog.
+			Query().
+			Where(transactionlog.TxHashEQ(transfer.TxHash)).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("error checking transaction log for %s: %w", transfer.TxHash, err)
+		}
+		if indexed {
+			continue
+		}
+
+		addressToEvent := map[string]*types.TokenTransferEvent{
+			address: transfer,
+		}
+
+		err = common.ProcessTransfers(ctx, s.order, s.priorityQueue, []string{address}, addressToEvent, token)
+		if err != nil {
+			logger.Errorf("Error processing transfer for token %s: %v", token.Symbol, err)
+			continue
+		}
+		metrics.PaymentDetected(metrics.SourceIndexer, token.Edges.Network.Identifier)
+	}
+
 	return nil
 }
 
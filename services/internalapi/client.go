@@ -0,0 +1,39 @@
+package internalapi
+
+import (
+	"fmt"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	internalapiv1 "github.com/NEDA-LABS/stablenode/proto/internalapi/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// Client calls the internal gRPC API of the aggregator from another deployable
+type Client struct {
+	internalapiv1.InternalServiceClient
+	conn *grpc.ClientConn
+}
+
+// NewClient connects to the internal gRPC API at the configured target over mTLS
+func NewClient(conf *config.InternalAPIConfiguration) (*Client, error) {
+	tlsConfig, err := ClientTLSConfig(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := grpc.Dial(conf.Target, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to internal API at %s: %w", conf.Target, err)
+	}
+
+	return &Client{
+		InternalServiceClient: internalapiv1.NewInternalServiceClient(conn),
+		conn:                  conn,
+	}, nil
+}
+
+// Close closes the connection to the internal API
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
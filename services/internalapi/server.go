@@ -0,0 +1,232 @@
+package internalapi
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/lockpaymentorder"
+	networkent "github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	tokenent "github.com/NEDA-LABS/stablenode/ent/token"
+	internalapiv1 "github.com/NEDA-LABS/stablenode/proto/internalapi/v1"
+	"github.com/NEDA-LABS/stablenode/services"
+	"github.com/NEDA-LABS/stablenode/services/common"
+	orderService "github.com/NEDA-LABS/stablenode/services/order"
+	"github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/NEDA-LABS/stablenode/utils/metrics"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Server implements the internal gRPC API on top of the aggregator services
+type Server struct {
+	internalapiv1.UnimplementedInternalServiceServer
+	priorityQueueService *services.PriorityQueueService
+}
+
+// NewServer creates a new internal API server
+func NewServer() *Server {
+	return &Server{
+		priorityQueueService: services.NewPriorityQueueService(),
+	}
+}
+
+// NewGRPCServer creates a gRPC server with mTLS that serves the internal API
+func NewGRPCServer(conf *config.InternalAPIConfiguration) (*grpc.Server, error) {
+	tlsConfig, err := ServerTLSConfig(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	server := grpc.NewServer(
+		grpc.Creds(credentials.NewTLS(tlsConfig)),
+		grpc.UnaryInterceptor(logRequests),
+	)
+	internalapiv1.RegisterInternalServiceServer(server, NewServer())
+
+	return server, nil
+}
+
+// logRequests logs every call with the common name of the calling deployable
+func logRequests(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	startTime := time.Now()
+	resp, err := handler(ctx, req)
+
+	caller := ""
+	if p, ok := peer.FromContext(ctx); ok {
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(tlsInfo.State.PeerCertificates) > 0 {
+			caller = tlsInfo.State.PeerCertificates[0].Subject.CommonName
+		}
+	}
+
+	fields := logger.WithFields(logger.Fields{
+		"Method":   info.FullMethod,
+		"Caller":   caller,
+		"Code":     status.Code(err).String(),
+		"Duration": time.Since(startTime),
+	})
+	if err != nil {
+		fields.Errorf("Internal API call failed: %v", err)
+	} else {
+		fields.Infof("Internal API call")
+	}
+
+	return resp, err
+}
+
+// orderServiceFor returns the order service for the chain family of a network
+func orderServiceFor(network *ent.Network) types.OrderService {
+	if network.NetworkType == networkent.NetworkTypeSolana {
+		return orderService.NewOrderSolana()
+	}
+	if strings.HasPrefix(network.Identifier, "tron") {
+		return orderService.NewOrderTron()
+	}
+	return orderService.NewOrderEVM()
+}
+
+// SubmitDepositEvent processes a token transfer to a receive address
+func (s *Server) SubmitDepositEvent(ctx context.Context, req *internalapiv1.SubmitDepositEventRequest) (*internalapiv1.SubmitDepositEventResponse, error) {
+	if req.TxHash == "" || req.ToAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "tx_hash and to_address are required")
+	}
+
+	value, err := decimal.NewFromString(req.Value)
+	if err != nil || !value.IsPositive() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid value %q", req.Value)
+	}
+
+	token, err := storage.Client.Token.
+		Query().
+		Where(
+			tokenent.ContractAddressEqualFold(req.TokenAddress),
+			tokenent.HasNetworkWith(
+				networkent.ChainIDEQ(req.ChainId),
+				networkent.GenesisMismatchEQ(false),
+			),
+		).
+		WithNetwork().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "token %s not found on chain %d", req.TokenAddress, req.ChainId)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to fetch token: %v", err)
+	}
+
+	if services.IsGatewayRefund(token.Edges.Network, req.FromAddress) {
+		return &internalapiv1.SubmitDepositEventResponse{}, nil
+	}
+
+	addressToEvent := map[string]*types.TokenTransferEvent{
+		req.ToAddress: {
+			BlockNumber: req.BlockNumber,
+			TxHash:      req.TxHash,
+			From:        req.FromAddress,
+			To:          req.ToAddress,
+			Value:       value,
+		},
+	}
+
+	err = common.ProcessTransfers(ctx, orderServiceFor(token.Edges.Network), s.priorityQueueService, []string{req.ToAddress}, addressToEvent, token)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to process transfer: %v", err)
+	}
+	metrics.PaymentDetected(metrics.SourceInternalAPI, token.Edges.Network.Identifier)
+
+	return &internalapiv1.SubmitDepositEventResponse{}, nil
+}
+
+// TransitionOrder creates a payment order on-chain, or settles or refunds a lock payment order
+func (s *Server) TransitionOrder(ctx context.Context, req *internalapiv1.TransitionOrderRequest) (*internalapiv1.TransitionOrderResponse, error) {
+	orderID, err := uuid.Parse(req.OrderId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid order_id %q", req.OrderId)
+	}
+
+	switch req.Transition {
+	case internalapiv1.OrderTransition_ORDER_TRANSITION_CREATE:
+		order, err := storage.Client.PaymentOrder.
+			Query().
+			Where(paymentorder.IDEQ(orderID)).
+			WithToken(func(tq *ent.TokenQuery) {
+				tq.WithNetwork()
+			}).
+			Only(ctx)
+		if err != nil {
+			return nil, orderError(err)
+		}
+
+		if err := orderServiceFor(order.Edges.Token.Edges.Network).CreateOrder(ctx, order.ID); err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "failed to create order: %v", err)
+		}
+
+	case internalapiv1.OrderTransition_ORDER_TRANSITION_SETTLE, internalapiv1.OrderTransition_ORDER_TRANSITION_REFUND:
+		order, err := storage.Client.LockPaymentOrder.
+			Query().
+			Where(lockpaymentorder.IDEQ(orderID)).
+			WithToken(func(tq *ent.TokenQuery) {
+				tq.WithNetwork()
+			}).
+			Only(ctx)
+		if err != nil {
+			return nil, orderError(err)
+		}
+
+		service := orderServiceFor(order.Edges.Token.Edges.Network)
+		if req.Transition == internalapiv1.OrderTransition_ORDER_TRANSITION_SETTLE {
+			err = service.SettleOrder(ctx, order.ID)
+		} else {
+			err = service.RefundOrder(ctx, order.Edges.Token.Edges.Network, order.GatewayID)
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "failed to %s order: %v", strings.ToLower(strings.TrimPrefix(req.Transition.String(), "ORDER_TRANSITION_")), err)
+		}
+
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported transition %s", req.Transition)
+	}
+
+	return &internalapiv1.TransitionOrderResponse{}, nil
+}
+
+// orderError maps an order lookup error to a gRPC status
+func orderError(err error) error {
+	if ent.IsNotFound(err) {
+		return status.Error(codes.NotFound, "order not found")
+	}
+	return status.Errorf(codes.Internal, "failed to fetch order: %v", err)
+}
+
+// AllocatePoolAddress assigns a pool receive address on a network
+func (s *Server) AllocatePoolAddress(ctx context.Context, req *internalapiv1.AllocatePoolAddressRequest) (*internalapiv1.AllocatePoolAddressResponse, error) {
+	if req.Network == "" {
+		return nil, status.Error(codes.InvalidArgument, "network is required")
+	}
+
+	receiveAddress, err := storage.AssignPoolAddress(ctx, req.Network, config.OrderConfig().ReceiveAddressValidity)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, status.Errorf(codes.ResourceExhausted, "no pool addresses available on %s", req.Network)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to assign pool address: %v", err)
+	}
+
+	return &internalapiv1.AllocatePoolAddressResponse{
+		ReceiveAddressId: strconv.Itoa(receiveAddress.ID),
+		Address:          receiveAddress.Address,
+		ValidUntil:       timestamppb.New(receiveAddress.ValidUntil),
+	}, nil
+}
@@ -0,0 +1,171 @@
+package internalapi
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	internalapiv1 "github.com/NEDA-LABS/stablenode/proto/internalapi/v1"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+)
+
+// writeCertificate writes a PEM certificate and key signed by parent (self-signed when parent is nil)
+func writeCertificate(t *testing.T, dir, name string, template *x509.Certificate, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	assert.NoError(t, err)
+	certificate, err := x509.ParseCertificate(der)
+	assert.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, name+".crt"), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, name+".key"), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600))
+
+	return certificate, key
+}
+
+// setupCertificates creates an internal CA with a server and a client certificate
+func setupCertificates(t *testing.T) (server, client *config.InternalAPIConfiguration) {
+	dir := t.TempDir()
+	notAfter := time.Now().Add(time.Hour)
+
+	ca, caKey := writeCertificate(t, dir, "ca", &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "stablenode-internal-ca"},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              notAfter,
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}, nil, nil)
+
+	writeCertificate(t, dir, "server", &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "stablenode-aggregator"},
+		DNSNames:     []string{"stablenode-aggregator"},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}, ca, caKey)
+
+	writeCertificate(t, dir, "client", &x509.Certificate{
+		SerialNumber: big.NewInt(3),
+		Subject:      pkix.Name{CommonName: "webhook-ingester"},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, ca, caKey)
+
+	server = &config.InternalAPIConfiguration{
+		CACertFile: filepath.Join(dir, "ca.crt"),
+		CertFile:   filepath.Join(dir, "server.crt"),
+		KeyFile:    filepath.Join(dir, "server.key"),
+	}
+	client = &config.InternalAPIConfiguration{
+		ServerName: "stablenode-aggregator",
+		CACertFile: filepath.Join(dir, "ca.crt"),
+		CertFile:   filepath.Join(dir, "client.crt"),
+		KeyFile:    filepath.Join(dir, "client.key"),
+	}
+	return server, client
+}
+
+func TestInternalAPI(t *testing.T) {
+	entClient := enttest.Open(t, "sqlite3", "file:internalapi?mode=memory&_fk=1")
+	defer entClient.Close()
+	db.Client = entClient
+
+	ctx := context.Background()
+	_, err := entClient.ReceiveAddress.
+		Create().
+		SetAddress("0x1111111111111111111111111111111111111111").
+		SetNetworkIdentifier("base-sepolia").
+		SetStatus(receiveaddress.StatusPoolReady).
+		SetIsDeployed(true).
+		Save(ctx)
+	assert.NoError(t, err)
+
+	serverConf, clientConf := setupCertificates(t)
+
+	server, err := NewGRPCServer(serverConf)
+	assert.NoError(t, err)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	clientConf.Target = listener.Addr().String()
+	client, err := NewClient(clientConf)
+	assert.NoError(t, err)
+	defer client.Close()
+
+	t.Run("should allocate a pool address", func(t *testing.T) {
+		resp, err := client.AllocatePoolAddress(ctx, &internalapiv1.AllocatePoolAddressRequest{Network: "base-sepolia"})
+		assert.NoError(t, err)
+		assert.Equal(t, "0x1111111111111111111111111111111111111111", resp.Address)
+		assert.True(t, resp.ValidUntil.AsTime().After(time.Now()))
+
+		assigned, err := entClient.ReceiveAddress.Query().Where(receiveaddress.StatusEQ(receiveaddress.StatusPoolAssigned)).Count(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, assigned)
+	})
+
+	t.Run("should report an empty pool", func(t *testing.T) {
+		_, err := client.AllocatePoolAddress(ctx, &internalapiv1.AllocatePoolAddressRequest{Network: "polygon"})
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+
+	t.Run("should reject invalid requests", func(t *testing.T) {
+		_, err := client.TransitionOrder(ctx, &internalapiv1.TransitionOrderRequest{OrderId: "not-a-uuid"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = client.SubmitDepositEvent(ctx, &internalapiv1.SubmitDepositEventRequest{TxHash: "0xabc", ToAddress: "0x1", Value: "-1"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("should reject clients without a certificate", func(t *testing.T) {
+		caPEM, err := os.ReadFile(clientConf.CACertFile)
+		assert.NoError(t, err)
+		caPool := x509.NewCertPool()
+		caPool.AppendCertsFromPEM(caPEM)
+
+		conn, err := grpc.Dial(clientConf.Target, grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
+			RootCAs:    caPool,
+			ServerName: clientConf.ServerName,
+			MinVersion: tls.VersionTLS13,
+		})))
+		assert.NoError(t, err)
+		defer conn.Close()
+
+		_, err = internalapiv1.NewInternalServiceClient(conn).AllocatePoolAddress(ctx, &internalapiv1.AllocatePoolAddressRequest{Network: "base-sepolia"})
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	})
+}
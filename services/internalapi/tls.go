@@ -0,0 +1,65 @@
+package internalapi
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"github.com/NEDA-LABS/stablenode/config"
+)
+
+// loadCertificates loads the certificate of this deployable and the pool of the internal CA
+func loadCertificates(conf *config.InternalAPIConfiguration) (tls.Certificate, *x509.CertPool, error) {
+	if conf.CACertFile == "" || conf.CertFile == "" || conf.KeyFile == "" {
+		return tls.Certificate{}, nil, fmt.Errorf("INTERNAL_API_CA_CERT_FILE, INTERNAL_API_CERT_FILE and INTERNAL_API_KEY_FILE are required for mTLS")
+	}
+
+	certificate, err := tls.LoadX509KeyPair(conf.CertFile, conf.KeyFile)
+	if err != nil {
+		return tls.Certificate{}, nil, fmt.Errorf("failed to load certificate: %w", err)
+	}
+
+	caPEM, err := os.ReadFile(conf.CACertFile)
+	if err != nil {
+		return tls.Certificate{}, nil, fmt.Errorf("failed to read CA certificate: %w", err)
+	}
+
+	caPool := x509.NewCertPool()
+	if !caPool.AppendCertsFromPEM(caPEM) {
+		return tls.Certificate{}, nil, fmt.Errorf("no certificates found in %s", conf.CACertFile)
+	}
+
+	return certificate, caPool, nil
+}
+
+// ServerTLSConfig returns a TLS config that only accepts clients with a certificate signed by the internal CA
+func ServerTLSConfig(conf *config.InternalAPIConfiguration) (*tls.Config, error) {
+	certificate, caPool, err := loadCertificates(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		ClientCAs:    caPool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS13,
+	}, nil
+}
+
+// ClientTLSConfig returns a TLS config that presents this deployable's certificate and
+// only trusts servers with a certificate signed by the internal CA
+func ClientTLSConfig(conf *config.InternalAPIConfiguration) (*tls.Config, error) {
+	certificate, caPool, err := loadCertificates(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		RootCAs:      caPool,
+		ServerName:   conf.ServerName,
+		MinVersion:   tls.VersionTLS13,
+	}, nil
+}
@@ -0,0 +1,149 @@
+package ledger
+
+import (
+	"fmt"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/ledgerentry"
+	"github.com/shopspring/decimal"
+)
+
+// The entries below book the movements of a payment order, which must be loaded with its token
+
+var hundred = decimal.NewFromInt(100)
+
+// Deposit books a deposit to the receive address of an order as owed to the order. A transfer can
+// pay several orders, so deposits are referenced by both
+func Deposit(order *ent.PaymentOrder, txHash string, value decimal.Decimal) Entry {
+	return Redeposit(order, txHash, value, 0)
+}
+
+// Redeposit books a deposit credited again after reorgs reverted it the given number of times. Each
+// credit of the transfer after the first is referenced by the reversals before it
+func Redeposit(order *ent.PaymentOrder, txHash string, value decimal.Decimal, reversals int) Entry {
+	reference := fmt.Sprintf("%s:%s", txHash, order.ID)
+	if reversals > 0 {
+		reference = fmt.Sprintf("%s:%d", reference, reversals)
+	}
+	return Entry{
+		Kind:           ledgerentry.KindDeposit,
+		Reference:      reference,
+		TokenID:        order.Edges.Token.ID,
+		PaymentOrderID: order.ID,
+		Postings: []Posting{
+			{Account: AccountReceiveAddresses, Amount: value},
+			{Account: AccountOrderFunds, Amount: value.Neg()},
+		},
+	}
+}
+
+// DepositReversal takes back a deposit a reorg dropped from the canonical chain, after the given
+// number of earlier reversals of the transfer
+func DepositReversal(order *ent.PaymentOrder, txHash string, value decimal.Decimal, reversals int) Entry {
+	entry := Redeposit(order, txHash, value.Neg(), reversals)
+	entry.Kind = ledgerentry.KindDepositReversal
+	entry.Follows = ledgerentry.KindDeposit
+	return entry
+}
+
+// OrderCreated books the order amount and sender fee moving from the receive address into the
+// gateway. The sender fee becomes owed to the sender. What the payment leaves in the receive address,
+// besides an overpayment to refund, is earned as the network fee
+func OrderCreated(order *ent.PaymentOrder) Entry {
+	escrowed := order.Amount.Add(order.SenderFee)
+	networkFee := order.AmountPaid.Sub(escrowed).Sub(order.AmountOverpaid)
+	if networkFee.IsNegative() {
+		networkFee = decimal.Zero
+	}
+	return Entry{
+		Kind:           ledgerentry.KindOrderCreated,
+		Reference:      order.ID.String(),
+		TokenID:        order.Edges.Token.ID,
+		PaymentOrderID: order.ID,
+		Follows:        ledgerentry.KindDeposit,
+		Postings: []Posting{
+			{Account: AccountGatewayEscrow, Amount: escrowed},
+			{Account: AccountReceiveAddresses, Amount: escrowed.Neg()},
+			{Account: AccountOrderFunds, Amount: order.SenderFee.Add(networkFee)},
+			{Account: AccountSenderFees, Amount: order.SenderFee.Neg()},
+			{Account: AccountNetworkFees, Amount: networkFee.Neg()},
+		},
+	}
+}
+
+// Settlement books the gateway paying out the share of an order settled by a lock order, as the
+// settled percentage of the order went from fromPercent to toPercent
+func Settlement(order *ent.PaymentOrder, lockOrderID string, fromPercent, toPercent decimal.Decimal) Entry {
+	amount := percentDelta(order.Amount, fromPercent, toPercent)
+	senderFee := percentDelta(order.SenderFee, fromPercent, toPercent)
+	return Entry{
+		Kind:           ledgerentry.KindSettlement,
+		Reference:      lockOrderID,
+		TokenID:        order.Edges.Token.ID,
+		PaymentOrderID: order.ID,
+		Follows:        ledgerentry.KindOrderCreated,
+		Postings: []Posting{
+			{Account: AccountOrderFunds, Amount: amount},
+			{Account: AccountSenderFees, Amount: senderFee},
+			{Account: AccountGatewayEscrow, Amount: amount.Add(senderFee).Neg()},
+		},
+	}
+}
+
+// GatewayRefund books the gateway returning the unsettled share of an order to its sender
+func GatewayRefund(order *ent.PaymentOrder, settledPercent decimal.Decimal) Entry {
+	entry := Settlement(order, order.ID.String(), settledPercent, hundred)
+	entry.Kind = ledgerentry.KindRefund
+	return entry
+}
+
+// SweepRefund books a sweep of a receive address that returned funds of an order to the payer, as a
+// refund of a partial payment or of an overpayment
+func SweepRefund(kind ledgerentry.Kind, order *ent.PaymentOrder, sweep *ent.Sweep) Entry {
+	return Entry{
+		Kind:           kind,
+		Reference:      sweep.ID.String(),
+		TokenID:        order.Edges.Token.ID,
+		PaymentOrderID: order.ID,
+		Follows:        ledgerentry.KindDeposit,
+		Postings: []Posting{
+			{Account: AccountOrderFunds, Amount: sweep.Amount},
+			{Account: AccountSwept, Amount: sweep.Amount.Neg()},
+		},
+	}
+}
+
+// Sweep books tokens sent out of a receive address
+func Sweep(sweep *ent.Sweep, tokenID int) Entry {
+	return Entry{
+		Kind:      ledgerentry.KindSweep,
+		Reference: sweep.ID.String(),
+		TokenID:   tokenID,
+		Postings: []Posting{
+			{Account: AccountSwept, Amount: sweep.Amount},
+			{Account: AccountReceiveAddresses, Amount: sweep.Amount.Neg()},
+		},
+	}
+}
+
+// SweepReversal takes back a sweep whose transaction failed, leaving the tokens in the receive address
+func SweepReversal(sweep *ent.Sweep, tokenID int) Entry {
+	entry := Sweep(sweep, tokenID)
+	entry.Kind = ledgerentry.KindSweepReversal
+	for i := range entry.Postings {
+		entry.Postings[i].Amount = entry.Postings[i].Amount.Neg()
+	}
+	return entry
+}
+
+// percentDelta is the share of value between two settled percentages. Shares are taken as differences
+// of cumulative amounts, so the shares of a fully settled order add up to value exactly
+func percentDelta(value, fromPercent, toPercent decimal.Decimal) decimal.Decimal {
+	if toPercent.GreaterThan(hundred) {
+		toPercent = hundred
+	}
+	if !toPercent.GreaterThan(fromPercent) {
+		return decimal.Zero
+	}
+	return value.Mul(toPercent).Div(hundred).Sub(value.Mul(fromPercent).Div(hundred))
+}
@@ -0,0 +1,210 @@
+package ledger
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/ledgeraccount"
+	"github.com/NEDA-LABS/stablenode/ent/ledgerentry"
+	"github.com/NEDA-LABS/stablenode/ent/ledgerposting"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/storage"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// tolerance absorbs the rounding of percentage shares when balances are compared
+var tolerance = decimal.New(1, -6)
+
+// Invariants checked against the ledger
+const (
+	// CheckTrialBalance is the accounts of a token summing to zero
+	CheckTrialBalance = "trial_balance"
+	// CheckAccountPostings is the balance of an account equalling the sum of its postings
+	CheckAccountPostings = "account_postings"
+	// CheckOrderDeposits is the deposits booked for an order equalling its amount paid
+	CheckOrderDeposits = "order_deposits"
+	// CheckGatewayEscrow is the escrow of a token equalling the unsettled share of its orders on the gateway
+	CheckGatewayEscrow = "gateway_escrow"
+)
+
+// Discrepancy is an invariant the ledger breaks
+type Discrepancy struct {
+	Check          string          `json:"check"`
+	TokenID        int             `json:"tokenId"`
+	Account        string          `json:"account,omitempty"`
+	PaymentOrderID *uuid.UUID      `json:"paymentOrderId,omitempty"`
+	Expected       decimal.Decimal `json:"expected"`
+	Actual         decimal.Decimal `json:"actual"`
+}
+
+// Report is the result of checking the invariants of the ledger
+type Report struct {
+	Discrepancies []Discrepancy `json:"discrepancies"`
+	CheckedAt     time.Time     `json:"checkedAt"`
+	DurationMs    int64         `json:"durationMs"`
+}
+
+// lastReport holds the report of the latest check
+var lastReport atomic.Pointer[Report]
+
+// LastReport returns the report of the latest check, or nil if none ran yet
+func LastReport() *Report {
+	return lastReport.Load()
+}
+
+// CheckInvariants verifies the ledger against itself and against the orders it books: every token's
+// accounts balance, every account matches its postings, every order on the books has its amount paid
+// booked as deposits, and the gateway escrow of every token holds the unsettled share of its orders
+func CheckInvariants(ctx context.Context) (*Report, error) {
+	report := &Report{
+		Discrepancies: []Discrepancy{},
+		CheckedAt:     time.Now(),
+	}
+
+	accounts, err := storage.Client.LedgerAccount.
+		Query().
+		WithToken().
+		WithPostings().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("CheckInvariants.accounts: %w", err)
+	}
+
+	trialBalances := make(map[int]decimal.Decimal)
+	escrows := make(map[int]decimal.Decimal)
+	for _, account := range accounts {
+		tokenID := account.Edges.Token.ID
+		trialBalances[tokenID] = trialBalances[tokenID].Add(account.Balance)
+		if account.Name == AccountGatewayEscrow {
+			escrows[tokenID] = account.Balance
+		}
+
+		posted := decimal.Zero
+		for _, posting := range account.Edges.Postings {
+			posted = posted.Add(posting.Amount)
+		}
+		if !posted.Equal(account.Balance) {
+			report.Discrepancies = append(report.Discrepancies, Discrepancy{
+				Check:    CheckAccountPostings,
+				TokenID:  tokenID,
+				Account:  string(account.Name),
+				Expected: posted,
+				Actual:   account.Balance,
+			})
+		}
+	}
+	for tokenID, balance := range trialBalances {
+		if !balance.IsZero() {
+			report.Discrepancies = append(report.Discrepancies, Discrepancy{
+				Check:    CheckTrialBalance,
+				TokenID:  tokenID,
+				Expected: decimal.Zero,
+				Actual:   balance,
+			})
+		}
+	}
+
+	if err := checkOrderDeposits(ctx, report); err != nil {
+		return nil, fmt.Errorf("CheckInvariants.deposits: %w", err)
+	}
+
+	if err := checkGatewayEscrow(ctx, escrows, report); err != nil {
+		return nil, fmt.Errorf("CheckInvariants.escrow: %w", err)
+	}
+
+	report.DurationMs = time.Since(report.CheckedAt).Milliseconds()
+	lastReport.Store(report)
+
+	return report, nil
+}
+
+// checkOrderDeposits compares the deposits booked for each order on the books with its amount paid
+func checkOrderDeposits(ctx context.Context, report *Report) error {
+	entries, err := storage.Client.LedgerEntry.
+		Query().
+		Where(ledgerentry.KindIn(ledgerentry.KindDeposit, ledgerentry.KindDepositReversal)).
+		WithPaymentOrder(func(poq *ent.PaymentOrderQuery) {
+			poq.WithToken()
+		}).
+		WithPostings(func(lpq *ent.LedgerPostingQuery) {
+			lpq.Where(ledgerposting.HasAccountWith(ledgeraccount.NameEQ(AccountReceiveAddresses)))
+		}).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	orders := make(map[uuid.UUID]*ent.PaymentOrder)
+	deposited := make(map[uuid.UUID]decimal.Decimal)
+	for _, entry := range entries {
+		order := entry.Edges.PaymentOrder
+		if order == nil {
+			continue
+		}
+		orders[order.ID] = order
+		for _, posting := range entry.Edges.Postings {
+			deposited[order.ID] = deposited[order.ID].Add(posting.Amount)
+		}
+	}
+
+	for id, order := range orders {
+		if deposited[id].Sub(order.AmountPaid).Abs().GreaterThan(tolerance) {
+			orderID := id
+			report.Discrepancies = append(report.Discrepancies, Discrepancy{
+				Check:          CheckOrderDeposits,
+				TokenID:        order.Edges.Token.ID,
+				PaymentOrderID: &orderID,
+				Expected:       order.AmountPaid,
+				Actual:         deposited[id],
+			})
+		}
+	}
+
+	return nil
+}
+
+// checkGatewayEscrow compares the escrow of each token with the unsettled share of its orders that were
+// created on the gateway and not refunded
+func checkGatewayEscrow(ctx context.Context, escrows map[int]decimal.Decimal, report *Report) error {
+	orders, err := storage.Client.PaymentOrder.
+		Query().
+		Where(
+			paymentorder.HasLedgerEntriesWith(ledgerentry.KindEQ(ledgerentry.KindOrderCreated)),
+			paymentorder.Not(paymentorder.HasLedgerEntriesWith(ledgerentry.KindEQ(ledgerentry.KindRefund))),
+			paymentorder.PercentSettledLT(hundred),
+		).
+		WithToken().
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	expected := make(map[int]decimal.Decimal)
+	for tokenID := range escrows {
+		expected[tokenID] = decimal.Zero
+	}
+	for _, order := range orders {
+		tokenID := order.Edges.Token.ID
+		unsettled := order.Amount.Sub(percentDelta(order.Amount, decimal.Zero, order.PercentSettled)).
+			Add(order.SenderFee.Sub(percentDelta(order.SenderFee, decimal.Zero, order.PercentSettled)))
+		expected[tokenID] = expected[tokenID].Add(unsettled)
+	}
+
+	for tokenID, amount := range expected {
+		if escrows[tokenID].Sub(amount).Abs().GreaterThan(tolerance) {
+			report.Discrepancies = append(report.Discrepancies, Discrepancy{
+				Check:    CheckGatewayEscrow,
+				TokenID:  tokenID,
+				Account:  string(AccountGatewayEscrow),
+				Expected: amount,
+				Actual:   escrows[tokenID],
+			})
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,176 @@
+// Package ledger keeps the double-entry books of the value the aggregator moves. Every deposit,
+// gateway order, settlement, fee, refund and sweep is recorded as a journal entry, written in the
+// same transaction as the order update it books.
+package ledger
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/ledgeraccount"
+	"github.com/NEDA-LABS/stablenode/ent/ledgerentry"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	tokenent "github.com/NEDA-LABS/stablenode/ent/token"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Accounts of the ledger. Each token has its own set of accounts
+const (
+	// AccountReceiveAddresses holds the tokens in receive addresses
+	AccountReceiveAddresses = ledgeraccount.NameReceiveAddresses
+	// AccountGatewayEscrow holds the tokens in orders on the gateway contract
+	AccountGatewayEscrow = ledgeraccount.NameGatewayEscrow
+	// AccountSwept holds the tokens swept out of receive addresses and not yet booked as a refund
+	AccountSwept = ledgeraccount.NameSwept
+	// AccountOrderFunds is owed to the orders that were paid, until they are settled or refunded
+	AccountOrderFunds = ledgeraccount.NameOrderFunds
+	// AccountSenderFees is owed to senders as the fees of their orders on the gateway
+	AccountSenderFees = ledgeraccount.NameSenderFees
+	// AccountNetworkFees is earned from the network fees of orders created on the gateway
+	AccountNetworkFees = ledgeraccount.NameNetworkFees
+)
+
+// accountTypes classifies the accounts of the ledger
+var accountTypes = map[ledgeraccount.Name]ledgeraccount.Type{
+	AccountReceiveAddresses: ledgeraccount.TypeAsset,
+	AccountGatewayEscrow:    ledgeraccount.TypeAsset,
+	AccountSwept:            ledgeraccount.TypeAsset,
+	AccountOrderFunds:       ledgeraccount.TypeLiability,
+	AccountSenderFees:       ledgeraccount.TypeLiability,
+	AccountNetworkFees:      ledgeraccount.TypeRevenue,
+}
+
+// Posting is a line of a journal entry. A positive amount debits the account and a negative amount
+// credits it
+type Posting struct {
+	Account ledgeraccount.Name
+	Amount  decimal.Decimal
+}
+
+// Entry is a journal entry moving value of a token between the accounts of the ledger
+type Entry struct {
+	Kind ledgerentry.Kind
+	// Reference identifies the movement within its kind
+	Reference string
+	TokenID   int
+	// PaymentOrderID is the order the movement belongs to, if any
+	PaymentOrderID uuid.UUID
+	// Follows is the kind of entry the order must already have on the books. Orders that were paid
+	// before the ledger started are left off it
+	Follows  ledgerentry.Kind
+	Postings []Posting
+}
+
+// Record writes a journal entry in tx and adds its postings to the balances of their accounts,
+// creating the accounts of the token as they are first used. An entry that was already recorded is
+// skipped, so callers can record movements they may see again, and so is an entry of an order without
+// the entry it follows. Zero postings are left out, and an entry with none left is not written
+func Record(ctx context.Context, tx *ent.Tx, entry Entry) error {
+	postings := make([]Posting, 0, len(entry.Postings))
+	total := decimal.Zero
+	for _, posting := range entry.Postings {
+		if posting.Amount.IsZero() {
+			continue
+		}
+		postings = append(postings, posting)
+		total = total.Add(posting.Amount)
+	}
+	if !total.IsZero() {
+		return fmt.Errorf("ledger.Record: %s entry %s does not balance by %s", entry.Kind, entry.Reference, total)
+	}
+	if len(postings) == 0 {
+		return nil
+	}
+
+	exists, err := tx.LedgerEntry.
+		Query().
+		Where(
+			ledgerentry.KindEQ(entry.Kind),
+			ledgerentry.ReferenceEQ(entry.Reference),
+		).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("ledger.Record: %w", err)
+	}
+	if exists {
+		return nil
+	}
+
+	if entry.Follows != "" && entry.PaymentOrderID != uuid.Nil {
+		booked, err := tx.LedgerEntry.
+			Query().
+			Where(
+				ledgerentry.KindEQ(entry.Follows),
+				ledgerentry.HasPaymentOrderWith(paymentorder.IDEQ(entry.PaymentOrderID)),
+			).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("ledger.Record: %w", err)
+		}
+		if !booked {
+			return nil
+		}
+	}
+
+	create := tx.LedgerEntry.
+		Create().
+		SetKind(entry.Kind).
+		SetReference(entry.Reference)
+	if entry.PaymentOrderID != uuid.Nil {
+		create.SetPaymentOrderID(entry.PaymentOrderID)
+	}
+	journalEntry, err := create.Save(ctx)
+	if err != nil {
+		return fmt.Errorf("ledger.Record.entry: %w", err)
+	}
+
+	for _, posting := range postings {
+		err := tx.LedgerAccount.
+			Create().
+			SetName(posting.Account).
+			SetType(accountTypes[posting.Account]).
+			SetTokenID(entry.TokenID).
+			OnConflict(
+				sql.ConflictColumns(ledgeraccount.FieldName, ledgeraccount.TokenColumn),
+			).
+			Ignore().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("ledger.Record.account: %w", err)
+		}
+
+		accountID, err := tx.LedgerAccount.
+			Query().
+			Where(
+				ledgeraccount.NameEQ(posting.Account),
+				ledgeraccount.HasTokenWith(tokenent.IDEQ(entry.TokenID)),
+			).
+			OnlyID(ctx)
+		if err != nil {
+			return fmt.Errorf("ledger.Record.account: %w", err)
+		}
+
+		err = tx.LedgerAccount.
+			UpdateOneID(accountID).
+			AddBalance(posting.Amount).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("ledger.Record.balance: %w", err)
+		}
+
+		err = tx.LedgerPosting.
+			Create().
+			SetEntry(journalEntry).
+			SetAccountID(accountID).
+			SetAmount(posting.Amount).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("ledger.Record.posting: %w", err)
+		}
+	}
+
+	return nil
+}
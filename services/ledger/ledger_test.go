@@ -0,0 +1,165 @@
+package ledger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	"github.com/NEDA-LABS/stablenode/ent/ledgeraccount"
+	"github.com/NEDA-LABS/stablenode/ent/ledgerentry"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	tokenent "github.com/NEDA-LABS/stablenode/ent/token"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/test"
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLedger(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ledger?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	ctx := context.Background()
+
+	token, err := test.CreateERC20Token(nil, map[string]interface{}{
+		"symbol":         "USDC",
+		"identifier":     "base-sepolia",
+		"chainID":        int64(84532),
+		"deployContract": false,
+	})
+	assert.NoError(t, err)
+
+	createOrder := func(amountPaid decimal.Decimal) *ent.PaymentOrder {
+		order := client.PaymentOrder.
+			Create().
+			SetAmount(decimal.NewFromInt(100)).
+			SetAmountInUsd(decimal.NewFromInt(100)).
+			SetAmountPaid(amountPaid).
+			SetAmountReturned(decimal.Zero).
+			SetPercentSettled(decimal.Zero).
+			SetNetworkFee(decimal.NewFromFloat(0.5)).
+			SetSenderFee(decimal.NewFromInt(1)).
+			SetProtocolFee(decimal.Zero).
+			SetRate(decimal.NewFromInt(1500)).
+			SetToken(token).
+			SetReceiveAddressText("0x1111111111111111111111111111111111111111").
+			SetFeePercent(decimal.NewFromInt(1)).
+			SetStatus(paymentorder.StatusPending).
+			SaveX(ctx)
+		order.Edges.Token = token
+		return order
+	}
+
+	record := func(entry Entry) error {
+		tx, err := client.Tx(ctx)
+		assert.NoError(t, err)
+		if err := Record(ctx, tx, entry); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+		return tx.Commit()
+	}
+
+	balance := func(name ledgeraccount.Name) decimal.Decimal {
+		account, err := client.LedgerAccount.
+			Query().
+			Where(
+				ledgeraccount.NameEQ(name),
+				ledgeraccount.HasTokenWith(tokenent.IDEQ(token.ID)),
+			).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			return decimal.Zero
+		}
+		assert.NoError(t, err)
+		return account.Balance
+	}
+
+	order := createOrder(decimal.NewFromFloat(101.5))
+
+	t.Run("should reject an entry that does not balance", func(t *testing.T) {
+		err := record(Entry{
+			Kind:      ledgerentry.KindDeposit,
+			Reference: "unbalanced",
+			TokenID:   token.ID,
+			Postings: []Posting{
+				{Account: AccountReceiveAddresses, Amount: decimal.NewFromInt(1)},
+			},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("should skip entries of orders paid before the ledger started", func(t *testing.T) {
+		assert.NoError(t, record(OrderCreated(order)))
+		count, err := client.LedgerEntry.Query().Count(ctx)
+		assert.NoError(t, err)
+		assert.Zero(t, count)
+	})
+
+	t.Run("should book a deposit once", func(t *testing.T) {
+		assert.NoError(t, record(Deposit(order, "0xdeposit", order.AmountPaid)))
+		assert.NoError(t, record(Deposit(order, "0xdeposit", order.AmountPaid)))
+
+		assert.True(t, balance(AccountReceiveAddresses).Equal(decimal.NewFromFloat(101.5)))
+		assert.True(t, balance(AccountOrderFunds).Equal(decimal.NewFromFloat(-101.5)))
+	})
+
+	t.Run("should move the order into the gateway", func(t *testing.T) {
+		assert.NoError(t, record(OrderCreated(order)))
+
+		assert.True(t, balance(AccountGatewayEscrow).Equal(decimal.NewFromInt(101)))
+		assert.True(t, balance(AccountReceiveAddresses).Equal(decimal.NewFromFloat(0.5)))
+		assert.True(t, balance(AccountSenderFees).Equal(decimal.NewFromInt(-1)))
+		assert.True(t, balance(AccountNetworkFees).Equal(decimal.NewFromFloat(-0.5)))
+		assert.True(t, balance(AccountOrderFunds).Equal(decimal.NewFromInt(-100)))
+
+		report, err := CheckInvariants(ctx)
+		assert.NoError(t, err)
+		assert.Empty(t, report.Discrepancies)
+	})
+
+	t.Run("should pay out settlements from the escrow", func(t *testing.T) {
+		assert.NoError(t, record(Settlement(order, uuid.New().String(), decimal.Zero, decimal.NewFromInt(40))))
+		client.PaymentOrder.UpdateOne(order).SetPercentSettled(decimal.NewFromInt(40)).ExecX(ctx)
+
+		assert.True(t, balance(AccountGatewayEscrow).Equal(decimal.NewFromFloat(60.6)))
+		report, err := CheckInvariants(ctx)
+		assert.NoError(t, err)
+		assert.Empty(t, report.Discrepancies)
+
+		assert.NoError(t, record(Settlement(order, uuid.New().String(), decimal.NewFromInt(40), decimal.NewFromInt(100))))
+		client.PaymentOrder.UpdateOne(order).SetPercentSettled(decimal.NewFromInt(100)).SetStatus(paymentorder.StatusSettled).ExecX(ctx)
+
+		assert.True(t, balance(AccountGatewayEscrow).IsZero())
+		assert.True(t, balance(AccountOrderFunds).IsZero())
+		assert.True(t, balance(AccountSenderFees).IsZero())
+	})
+
+	t.Run("should return the unsettled share of a refunded order", func(t *testing.T) {
+		refunded := createOrder(decimal.NewFromFloat(101.5))
+		assert.NoError(t, record(Deposit(refunded, "0xrefunded", refunded.AmountPaid)))
+		assert.NoError(t, record(OrderCreated(refunded)))
+		assert.NoError(t, record(GatewayRefund(refunded, decimal.Zero)))
+
+		assert.True(t, balance(AccountGatewayEscrow).IsZero())
+		report, err := CheckInvariants(ctx)
+		assert.NoError(t, err)
+		assert.Empty(t, report.Discrepancies)
+	})
+
+	t.Run("should report deposits that don't match the order", func(t *testing.T) {
+		client.PaymentOrder.UpdateOne(order).SetAmountPaid(decimal.NewFromInt(50)).ExecX(ctx)
+
+		report, err := CheckInvariants(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, report.Discrepancies, 1)
+		assert.Equal(t, CheckOrderDeposits, report.Discrepancies[0].Check)
+		assert.Equal(t, order.ID, *report.Discrepancies[0].PaymentOrderID)
+		assert.True(t, report.Discrepancies[0].Actual.Equal(decimal.NewFromFloat(101.5)))
+		assert.Equal(t, report, LastReport())
+	})
+}
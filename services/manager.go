@@ -2,10 +2,21 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math/big"
 	"time"
 
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	networkent "github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/services/events"
+	"github.com/NEDA-LABS/stablenode/storage"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/spf13/viper"
+	"github.com/NEDA-LABS/stablenode/utils/breaker"
 	"github.com/NEDA-LABS/stablenode/utils/logger"
 )
 
@@ -13,26 +24,77 @@ import (
 type ServiceManager struct {
 	engineService  *EngineService
 	alchemyService *AlchemyService
-	useAlchemy     bool
+	tronService    *TronService
+	solanaService  *SolanaService
+	// service sends transactions and serves reads: engine, alchemy, or rpc for EOAs signing locally
+	// and the network's own endpoints. Engine when unset
+	service paymentorder.BlockchainService
+	// readProvider serves block and log reads when BLOCKCHAIN_READ_PROVIDER is set
+	readProvider BlockchainProvider
+	// failoverProvider serves block and log reads while the active service's circuit is open
+	failoverProvider BlockchainProvider
 }
 
 // NewServiceManager creates a new service manager
 func NewServiceManager() *ServiceManager {
 	return &ServiceManager{
-		engineService:  NewEngineService(),
-		alchemyService: NewAlchemyService(),
-		useAlchemy:     viper.GetBool("USE_ALCHEMY_SERVICE"),
+		engineService:    NewEngineService(),
+		alchemyService:   NewAlchemyService(),
+		tronService:      NewTronService(),
+		solanaService:    NewSolanaService(),
+		service:          defaultBlockchainService(),
+		readProvider:     newReadProvider(),
+		failoverProvider: newFailoverProvider(),
 	}
 }
 
-// CreateServerWallet creates a smart contract account using the active service
-// Returns: address, encryptedSalt (nil for Thirdweb), error
+// defaultBlockchainService is the blockchain service of operations no blockchain route applies to
+func defaultBlockchainService() paymentorder.BlockchainService {
+	if viper.GetBool("USE_ALCHEMY_SERVICE") {
+		return paymentorder.BlockchainServiceAlchemy
+	}
+	return paymentorder.BlockchainServiceEngine
+}
+
+// newReadProvider creates the configured read provider, or nil to read through the active service
+func newReadProvider() BlockchainProvider {
+	conf := config.BlockchainProviderConfig()
+	if conf.ReadProvider == "" {
+		return nil
+	}
+
+	provider, err := NewBlockchainProvider(conf.ReadProvider, conf)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":    fmt.Sprintf("%v", err),
+			"Provider": conf.ReadProvider,
+		}).Errorf("Failed to create read provider, reading through the active service")
+		return nil
+	}
+
+	return provider
+}
+
+// newFailoverProvider creates the provider reading from the networks' own RPC endpoints, which
+// needs no configuration and cannot fail to be created
+func newFailoverProvider() BlockchainProvider {
+	provider, _ := NewBlockchainProvider("rpc", config.BlockchainProviderConfig())
+	return provider
+}
+
+// CreateServerWallet creates a smart contract account using the active service, or an EOA for
+// direct RPC transactions
+// Returns: address, encryptedSalt (nil for Thirdweb, the encrypted private key for an EOA), error
 func (sm *ServiceManager) CreateServerWallet(ctx context.Context, label string, chainID int64, ownerAddress string) (string, []byte, error) {
-	if sm.useAlchemy {
+	switch sm.service {
+	case paymentorder.BlockchainServiceAlchemy:
 		logger.Infof("Creating smart account via Alchemy for chain %d", chainID)
 		return sm.alchemyService.CreateSmartAccount(ctx, chainID, ownerAddress)
+	case paymentorder.BlockchainServiceRPC:
+		logger.Infof("Creating EOA for direct RPC transactions")
+		return GenerateEVMAddress()
 	}
-	
+
 	logger.Infof("Creating server wallet via Thirdweb Engine")
 	address, err := sm.engineService.CreateServerWallet(ctx, label)
 	return address, nil, err
@@ -40,15 +102,24 @@ func (sm *ServiceManager) CreateServerWallet(ctx context.Context, label string,
 
 // SendTransactionBatch sends a batch of transactions using the active service
 func (sm *ServiceManager) SendTransactionBatch(ctx context.Context, chainID int64, address string, txPayload []map[string]interface{}) (string, error) {
-	if sm.useAlchemy {
+	switch sm.service {
+	case paymentorder.BlockchainServiceAlchemy:
 		logger.WithFields(logger.Fields{
 			"ChainID":   chainID,
 			"Address":   address,
 			"BatchSize": len(txPayload),
 		}).Infof("Sending transaction batch via Alchemy")
 		return sm.alchemyService.SendTransactionBatch(ctx, chainID, address, txPayload)
+	case paymentorder.BlockchainServiceRPC:
+		logger.WithFields(logger.Fields{
+			"ChainID":   chainID,
+			"Address":   address,
+			"BatchSize": len(txPayload),
+		}).Infof("Sending transaction batch via direct RPC")
+		return sm.alchemyService.sendEOATransactionBatch(ctx, chainID, address, txPayload)
 	}
-	
+
+
 	logger.WithFields(logger.Fields{
 		"ChainID":   chainID,
 		"Address":   address,
@@ -59,34 +130,111 @@ func (sm *ServiceManager) SendTransactionBatch(ctx context.Context, chainID int6
 
 // GetTransactionStatus gets transaction status using the active service
 func (sm *ServiceManager) GetTransactionStatus(ctx context.Context, transactionID string, chainID int64) (map[string]interface{}, error) {
-	if sm.useAlchemy {
+	switch sm.service {
+	case paymentorder.BlockchainServiceAlchemy:
 		return sm.alchemyService.GetTransactionStatus(ctx, transactionID, chainID)
+	case paymentorder.BlockchainServiceRPC:
+		return sm.directTransactionStatus(ctx, transactionID, chainID)
 	}
-	
+
+
 	return sm.engineService.GetTransactionStatus(ctx, transactionID)
 }
 
 // WaitForTransactionMined waits for transaction to be mined using the active service
 func (sm *ServiceManager) WaitForTransactionMined(ctx context.Context, transactionID string, chainID int64, timeout time.Duration) (map[string]interface{}, error) {
-	if sm.useAlchemy {
+	switch sm.service {
+	case paymentorder.BlockchainServiceAlchemy:
 		return sm.alchemyService.WaitForUserOperationMined(ctx, chainID, transactionID, timeout)
+	case paymentorder.BlockchainServiceRPC:
+		return sm.waitForDirectTransaction(ctx, transactionID, chainID, timeout)
 	}
-	
+
+
 	return sm.engineService.WaitForTransactionMined(ctx, transactionID, timeout)
 }
 
-// GetLatestBlock gets the latest block using the active service
+// GetLatestBlock gets the latest block using the active service, failing over to the network's
+// RPC endpoints while the service's circuit is open
 func (sm *ServiceManager) GetLatestBlock(ctx context.Context, chainID int64) (int64, error) {
-	if sm.useAlchemy {
-		return sm.alchemyService.GetLatestBlock(ctx, chainID)
+	if sm.readProvider != nil {
+		return sm.latestBlockFrom(ctx, sm.readProvider, chainID)
 	}
-	
-	return sm.engineService.GetLatestBlock(ctx, chainID)
+
+	var blockNumber int64
+	var err error
+	switch sm.service {
+	case paymentorder.BlockchainServiceAlchemy:
+		blockNumber, err = sm.alchemyService.GetLatestBlock(ctx, chainID)
+	case paymentorder.BlockchainServiceRPC:
+		return sm.latestBlockFrom(ctx, sm.failoverProvider, chainID)
+	default:
+		blockNumber, err = sm.engineService.GetLatestBlock(ctx, chainID)
+	}
+	if errors.Is(err, breaker.ErrOpen) {
+		sm.logFailover(chainID, err)
+		return sm.latestBlockFrom(ctx, sm.failoverProvider, chainID)
+	}
+
+	return blockNumber, err
+}
+
+// latestBlockFrom gets the latest block of a chain from a provider
+func (sm *ServiceManager) latestBlockFrom(ctx context.Context, provider BlockchainProvider, chainID int64) (int64, error) {
+	network, err := sm.networkByChainID(ctx, chainID)
+	if err != nil {
+		return 0, err
+	}
+	return provider.GetLatestBlock(ctx, network)
+}
+
+// GetContractEvents gets contract events using the active service, failing over to the network's
+// RPC endpoints while the service's circuit is open
+func (sm *ServiceManager) GetContractEvents(ctx context.Context, chainID int64, contractAddress string, fromBlock, toBlock int64, topics []string) ([]events.Event, error) {
+	if sm.readProvider != nil {
+		return sm.contractEventsFrom(ctx, sm.readProvider, chainID, contractAddress, fromBlock, toBlock, topics)
+	}
+	if sm.service == paymentorder.BlockchainServiceRPC {
+		return sm.contractEventsFrom(ctx, sm.failoverProvider, chainID, contractAddress, fromBlock, toBlock, topics)
+	}
+
+	decoded, err := sm.activeContractEvents(ctx, chainID, contractAddress, fromBlock, toBlock, topics)
+	if errors.Is(err, breaker.ErrOpen) {
+		sm.logFailover(chainID, err)
+		return sm.contractEventsFrom(ctx, sm.failoverProvider, chainID, contractAddress, fromBlock, toBlock, topics)
+	}
+
+	return decoded, err
+}
+
+// contractEventsFrom gets contract events of a chain from a provider
+func (sm *ServiceManager) contractEventsFrom(ctx context.Context, provider BlockchainProvider, chainID int64, contractAddress string, fromBlock, toBlock int64, topics []string) ([]events.Event, error) {
+	network, err := sm.networkByChainID(ctx, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	query := ethereum.FilterQuery{
+		FromBlock: big.NewInt(fromBlock),
+		ToBlock:   big.NewInt(toBlock),
+		Addresses: []common.Address{common.HexToAddress(contractAddress)},
+	}
+	for _, topic := range topics {
+		if topic != "" {
+			query.Topics = append(query.Topics, []common.Hash{common.HexToHash(topic)})
+		}
+	}
+
+	logs, err := provider.GetLogs(ctx, network, query)
+	if err != nil {
+		return nil, err
+	}
+	return events.Default().DecodeLogs(logs)
 }
 
-// GetContractEvents gets contract events using the active service
-func (sm *ServiceManager) GetContractEvents(ctx context.Context, chainID int64, contractAddress string, fromBlock, toBlock int64, topics []string) ([]interface{}, error) {
-	if sm.useAlchemy {
+// activeContractEvents gets contract events using the active service
+func (sm *ServiceManager) activeContractEvents(ctx context.Context, chainID int64, contractAddress string, fromBlock, toBlock int64, topics []string) ([]events.Event, error) {
+	if sm.service == paymentorder.BlockchainServiceAlchemy {
 		return sm.alchemyService.GetContractEvents(ctx, chainID, contractAddress, fromBlock, toBlock, topics)
 	}
 	
@@ -103,15 +251,33 @@ func (sm *ServiceManager) GetContractEvents(ctx context.Context, chainID int64,
 		}
 	}
 	
-	return sm.engineService.GetContractEvents(ctx, chainID, contractAddress, payload)
+	insightEvents, err := sm.engineService.GetContractEvents(ctx, chainID, contractAddress, payload)
+	if err != nil {
+		return nil, err
+	}
+	return decodeInsightEvents(insightEvents)
+}
+
+// logFailover logs a read failing over to the network's RPC endpoints
+func (sm *ServiceManager) logFailover(chainID int64, err error) {
+	logger.WithFields(logger.Fields{
+		"Error":   fmt.Sprintf("%v", err),
+		"ChainID": chainID,
+		"Service": sm.GetActiveService(),
+	}).Warnf("Active service circuit is open, reading from the network's RPC endpoints")
 }
 
 // IsHealthy checks if the active service is healthy
 func (sm *ServiceManager) IsHealthy(ctx context.Context) bool {
-	if sm.useAlchemy {
+	switch sm.service {
+	case paymentorder.BlockchainServiceAlchemy:
 		return sm.alchemyService.IsHealthy(ctx)
+	case paymentorder.BlockchainServiceRPC:
+		// The RPCManager health-checks the networks' endpoints and fails over between them
+		return true
 	}
-	
+
+
 	// For Thirdweb, we'll do a simple latest block check
 	_, err := sm.engineService.GetLatestBlock(ctx, 1) // Ethereum mainnet
 	return err == nil
@@ -119,21 +285,45 @@ func (sm *ServiceManager) IsHealthy(ctx context.Context) bool {
 
 // GetActiveService returns the name of the currently active service
 func (sm *ServiceManager) GetActiveService() string {
-	if sm.useAlchemy {
+	switch sm.service {
+	case paymentorder.BlockchainServiceAlchemy:
 		return "Alchemy"
+	case paymentorder.BlockchainServiceRPC:
+		return "Direct RPC"
 	}
 	return "Thirdweb Engine"
 }
 
+// GetReadProvider returns the provider serving block and log reads, or nil when reads go through
+// the active service
+func (sm *ServiceManager) GetReadProvider() BlockchainProvider {
+	return sm.readProvider
+}
+
+// networkByChainID fetches the EVM network of a chain for the read provider
+func (sm *ServiceManager) networkByChainID(ctx context.Context, chainID int64) (*ent.Network, error) {
+	network, err := storage.Client.Network.
+		Query().
+		Where(
+			networkent.ChainIDEQ(chainID),
+			networkent.NetworkTypeEQ(networkent.NetworkTypeEvm),
+		).
+		First(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch network for chain %d: %w", chainID, err)
+	}
+	return network, nil
+}
+
 // SwitchToAlchemy switches to using Alchemy service
 func (sm *ServiceManager) SwitchToAlchemy() {
-	sm.useAlchemy = true
+	sm.service = paymentorder.BlockchainServiceAlchemy
 	logger.Infof("Switched to Alchemy service")
 }
 
 // SwitchToThirdweb switches to using Thirdweb Engine service
 func (sm *ServiceManager) SwitchToThirdweb() {
-	sm.useAlchemy = false
+	sm.service = paymentorder.BlockchainServiceEngine
 	logger.Infof("Switched to Thirdweb Engine service")
 }
 
@@ -146,3 +336,13 @@ func (sm *ServiceManager) GetEngineService() *EngineService {
 func (sm *ServiceManager) GetAlchemyService() *AlchemyService {
 	return sm.alchemyService
 }
+
+// GetTronService returns the Tron service, used for Tron receive addresses regardless of the active service
+func (sm *ServiceManager) GetTronService() *TronService {
+	return sm.tronService
+}
+
+// GetSolanaService returns the Solana service, used for Solana receive addresses regardless of the active service
+func (sm *ServiceManager) GetSolanaService() *SolanaService {
+	return sm.solanaService
+}
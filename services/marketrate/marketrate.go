@@ -0,0 +1,276 @@
+package marketrate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// ErrUnsupportedCurrency is returned by sources that do not quote a currency
+	ErrUnsupportedCurrency = errors.New("currency not supported by source")
+	// ErrNoMarketRate is returned when too few sources agree on the rate of a currency
+	ErrNoMarketRate = errors.New("not enough sources agree on a market rate")
+	// ErrRateDeviation is returned for rates too far off the market rate
+	ErrRateDeviation = errors.New("rate deviates from the market rate")
+)
+
+// Source is a venue the USDT rate of fiat currencies is read from
+type Source interface {
+	Name() string
+	// Rate returns the price of one USDT in the fiat currency
+	Rate(ctx context.Context, currency string) (decimal.Decimal, error)
+}
+
+// Quote is the market rate of a fiat currency and the source rates it was computed from
+type Quote struct {
+	Currency string                     `json:"currency"`
+	Rate     decimal.Decimal            `json:"rate"`
+	Sources  map[string]decimal.Decimal `json:"sources"`
+	// Outliers are the source rates left out of the median
+	Outliers  map[string]decimal.Decimal `json:"outliers,omitempty"`
+	FetchedAt time.Time                  `json:"fetchedAt"`
+}
+
+// Service computes the market rate of fiat currencies as the median of several sources, leaving out
+// sources too far off the others. Rates are cached in Redis, so every instance checks provider rates
+// against the same quote
+type Service struct {
+	config  *config.MarketRateConfiguration
+	sources []Source
+	now     func() time.Time
+}
+
+var (
+	defaultService     *Service
+	defaultServiceOnce sync.Once
+)
+
+// New creates a new market rate service reading from the given sources
+func New(conf *config.MarketRateConfiguration, sources ...Source) *Service {
+	return &Service{
+		config:  conf,
+		sources: sources,
+		now:     time.Now,
+	}
+}
+
+// Default returns the process-wide market rate service, reading from the configured sources
+func Default() *Service {
+	defaultServiceOnce.Do(func() {
+		conf := config.MarketRateConfig()
+
+		var sources []Source
+		for _, name := range conf.Sources {
+			source, err := NewSource(name, conf)
+			if err != nil {
+				logger.Warnf("MarketRate: %v", err)
+				continue
+			}
+			sources = append(sources, source)
+		}
+
+		defaultService = New(conf, sources...)
+	})
+	return defaultService
+}
+
+// Rate returns the market rate of a currency, fetching it from the sources when it is not cached
+func (s *Service) Rate(ctx context.Context, currency string) (*Quote, error) {
+	currency = strings.ToUpper(currency)
+
+	if quote := s.Cached(ctx, currency); quote != nil {
+		return quote, nil
+	}
+
+	return s.Refresh(ctx, currency)
+}
+
+// Refresh fetches the market rate of a currency from the sources and caches it
+func (s *Service) Refresh(ctx context.Context, currency string) (*Quote, error) {
+	currency = strings.ToUpper(currency)
+
+	quote, err := s.fetch(ctx, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	if storage.RedisClient != nil {
+		data, err := json.Marshal(quote)
+		if err == nil {
+			err = storage.RedisClient.Set(ctx, cacheKey(currency), data, s.config.CacheTTL).Err()
+		}
+		if err != nil {
+			logger.WithFields(logger.Fields{
+				"Error":    err.Error(),
+				"Currency": currency,
+			}).Warnf("Failed to cache market rate")
+		}
+	}
+
+	return quote, nil
+}
+
+// Cached returns the cached market rate of a currency, nil if there is none
+func (s *Service) Cached(ctx context.Context, currency string) *Quote {
+	if storage.RedisClient == nil {
+		return nil
+	}
+
+	data, err := storage.RedisClient.Get(ctx, cacheKey(strings.ToUpper(currency))).Bytes()
+	if err != nil {
+		return nil
+	}
+
+	var quote Quote
+	if json.Unmarshal(data, &quote) != nil {
+		return nil
+	}
+
+	return &quote
+}
+
+// ReferenceRate returns the cached quote provider rates of a currency are checked against. It never
+// reaches out to the sources, as it is on the path of order creation; nil when checks are disabled
+// or no rate is cached, in which case provider rates go unchecked
+func (s *Service) ReferenceRate(ctx context.Context, currency string) *Quote {
+	if !s.config.CheckEnabled {
+		return nil
+	}
+	return s.Cached(ctx, currency)
+}
+
+// CheckRate returns ErrRateDeviation if rate is more than the allowed deviation off the quote; a nil
+// quote accepts any rate
+func (s *Service) CheckRate(quote *Quote, rate decimal.Decimal) error {
+	if quote == nil {
+		return nil
+	}
+
+	deviation := percentDeviation(quote.Rate, rate)
+	if deviation.GreaterThan(s.config.MaxDeviation) {
+		return fmt.Errorf("%w: %s is %s%% off %s %s", ErrRateDeviation, rate, deviation.StringFixed(2), quote.Rate, quote.Currency)
+	}
+
+	return nil
+}
+
+// fetch reads the rate of a currency from every source and computes its median, leaving out
+// sources deviating from the median of all sources by more than the outlier threshold
+func (s *Service) fetch(ctx context.Context, currency string) (*Quote, error) {
+	var (
+		mutex sync.Mutex
+		wg    sync.WaitGroup
+		rates = make(map[string]decimal.Decimal, len(s.sources))
+	)
+	for _, source := range s.sources {
+		wg.Add(1)
+		go func(source Source) {
+			defer wg.Done()
+
+			rate, err := source.Rate(ctx, currency)
+			if err != nil {
+				if !errors.Is(err, ErrUnsupportedCurrency) {
+					logger.WithFields(logger.Fields{
+						"Error":    err.Error(),
+						"Source":   source.Name(),
+						"Currency": currency,
+					}).Warnf("Failed to fetch market rate")
+				}
+				return
+			}
+			if !rate.IsPositive() {
+				return
+			}
+
+			mutex.Lock()
+			rates[source.Name()] = rate
+			mutex.Unlock()
+		}(source)
+	}
+	wg.Wait()
+
+	minSources := s.config.MinSources
+	if minSources < 1 {
+		minSources = 1
+	}
+	if len(rates) < minSources {
+		return nil, fmt.Errorf("%w: %s quoted by %d of %d sources", ErrNoMarketRate, currency, len(rates), minSources)
+	}
+
+	all := make([]decimal.Decimal, 0, len(rates))
+	for _, rate := range rates {
+		all = append(all, rate)
+	}
+	center := median(all)
+
+	quote := &Quote{
+		Currency:  currency,
+		Sources:   make(map[string]decimal.Decimal, len(rates)),
+		FetchedAt: s.now(),
+	}
+	var kept []decimal.Decimal
+	for name, rate := range rates {
+		if percentDeviation(center, rate).GreaterThan(s.config.OutlierThreshold) {
+			if quote.Outliers == nil {
+				quote.Outliers = make(map[string]decimal.Decimal)
+			}
+			quote.Outliers[name] = rate
+			continue
+		}
+		quote.Sources[name] = rate
+		kept = append(kept, rate)
+	}
+	if len(kept) < minSources {
+		return nil, fmt.Errorf("%w: %d of %d sources within %s%% of the median of %s", ErrNoMarketRate, len(kept), minSources, s.config.OutlierThreshold, currency)
+	}
+	quote.Rate = median(kept)
+
+	if len(quote.Outliers) > 0 {
+		logger.WithFields(logger.Fields{
+			"Currency": currency,
+			"Rate":     quote.Rate,
+			"Outliers": quote.Outliers,
+		}).Warnf("MarketRate: left out outlying sources")
+	}
+
+	return quote, nil
+}
+
+// cacheKey is the Redis key the market rate of a currency is cached at
+func cacheKey(currency string) string {
+	return "market_rate_" + currency
+}
+
+// median returns the median of rates, sorting them in place
+func median(rates []decimal.Decimal) decimal.Decimal {
+	if len(rates) == 0 {
+		return decimal.Zero
+	}
+
+	sort.Slice(rates, func(i, j int) bool { return rates[i].LessThan(rates[j]) })
+
+	middle := len(rates) / 2
+	if len(rates)%2 == 0 {
+		return rates[middle-1].Add(rates[middle]).Div(decimal.NewFromInt(2))
+	}
+	return rates[middle]
+}
+
+// percentDeviation returns how far, in percent, value is off reference
+func percentDeviation(reference, value decimal.Decimal) decimal.Decimal {
+	if reference.IsZero() {
+		return decimal.Zero
+	}
+	return value.Sub(reference).Div(reference).Mul(decimal.NewFromInt(100)).Abs()
+}
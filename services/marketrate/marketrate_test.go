@@ -0,0 +1,174 @@
+package marketrate
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+// stubSource serves a fixed rate and counts the calls made
+type stubSource struct {
+	name  string
+	rate  decimal.Decimal
+	err   error
+	calls int
+}
+
+func (s *stubSource) Name() string {
+	return s.name
+}
+
+func (s *stubSource) Rate(ctx context.Context, currency string) (decimal.Decimal, error) {
+	s.calls++
+	return s.rate, s.err
+}
+
+func TestService(t *testing.T) {
+	mr, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer mr.Close()
+	db.RedisClient = redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer func() { db.RedisClient = nil }()
+
+	conf := &config.MarketRateConfiguration{
+		MinSources:       2,
+		OutlierThreshold: decimal.NewFromInt(3),
+		CacheTTL:         5 * time.Minute,
+		CheckEnabled:     true,
+		MaxDeviation:     decimal.NewFromInt(5),
+	}
+	ctx := context.Background()
+
+	binance := &stubSource{name: "binance", rate: decimal.NewFromInt(1600)}
+	quidax := &stubSource{name: "quidax", rate: decimal.NewFromInt(1580)}
+	coingecko := &stubSource{name: "coingecko", rate: decimal.NewFromInt(1590)}
+	fixings := &stubSource{name: "fixings", rate: decimal.NewFromInt(1450)}
+
+	t.Run("takes the median of the sources without outliers", func(t *testing.T) {
+		quote, err := New(conf, binance, quidax, coingecko, fixings).Rate(ctx, "ngn")
+		assert.NoError(t, err)
+		assert.Equal(t, "NGN", quote.Currency)
+		assert.True(t, quote.Rate.Equal(decimal.NewFromInt(1590)))
+		assert.Len(t, quote.Sources, 3)
+		assert.True(t, quote.Outliers["fixings"].Equal(decimal.NewFromInt(1450)))
+	})
+
+	t.Run("serves cached rates until they are refreshed", func(t *testing.T) {
+		service := New(conf, binance, quidax, coingecko, fixings)
+		calls := binance.calls
+
+		quote, err := service.Rate(ctx, "NGN")
+		assert.NoError(t, err)
+		assert.True(t, quote.Rate.Equal(decimal.NewFromInt(1590)))
+		assert.Equal(t, calls, binance.calls)
+
+		fixings.rate = decimal.NewFromInt(1610)
+		defer func() { fixings.rate = decimal.NewFromInt(1450) }()
+		quote, err = service.Refresh(ctx, "NGN")
+		assert.NoError(t, err)
+		assert.True(t, quote.Rate.Equal(decimal.NewFromInt(1595)))
+		assert.Empty(t, quote.Outliers)
+		assert.Equal(t, calls+1, binance.calls)
+		assert.True(t, service.Cached(ctx, "NGN").Rate.Equal(decimal.NewFromInt(1595)))
+	})
+
+	t.Run("fails when too few sources agree", func(t *testing.T) {
+		failing := &stubSource{name: "coingecko", err: fmt.Errorf("rate limited")}
+		unsupported := &stubSource{name: "quidax", err: ErrUnsupportedCurrency}
+
+		_, err := New(conf, binance, failing, unsupported).Refresh(ctx, "KES")
+		assert.ErrorIs(t, err, ErrNoMarketRate)
+
+		// Two sources agreeing with each other but not with the third
+		_, err = New(conf, binance, fixings, &stubSource{name: "coingecko", rate: decimal.NewFromInt(1300)}).Refresh(ctx, "KES")
+		assert.ErrorIs(t, err, ErrNoMarketRate)
+		assert.Nil(t, New(conf).Cached(ctx, "KES"))
+	})
+
+	t.Run("rejects rates beyond the allowed deviation", func(t *testing.T) {
+		service := New(conf)
+		quote := service.ReferenceRate(ctx, "NGN")
+		assert.NotNil(t, quote)
+
+		assert.NoError(t, service.CheckRate(quote, decimal.NewFromInt(1650)))
+		assert.ErrorIs(t, service.CheckRate(quote, decimal.NewFromInt(1700)), ErrRateDeviation)
+		assert.ErrorIs(t, service.CheckRate(quote, decimal.NewFromInt(1500)), ErrRateDeviation)
+
+		// Without a cached rate, or with checks disabled, every rate is accepted
+		assert.Nil(t, service.ReferenceRate(ctx, "GHS"))
+		assert.NoError(t, service.CheckRate(nil, decimal.NewFromInt(1)))
+
+		disabled := *conf
+		disabled.CheckEnabled = false
+		assert.Nil(t, New(&disabled).ReferenceRate(ctx, "NGN"))
+	})
+}
+
+func TestSources(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/bapi/c2c/v2/friendly/c2c/adv/search":
+			fmt.Fprint(w, `{"data": [{"adv": {"price": "129.10"}}, {"adv": {"price": "129.50"}}, {"adv": {"price": "130.00"}}]}`)
+		case "/api/v1/markets/tickers/usdtngn":
+			fmt.Fprint(w, `{"data": {"ticker": {"buy": "0.0", "last": "1590.0", "high": "1600.0", "low": "1560.0"}}}`)
+		case "/simple/price":
+			if r.URL.Query().Get("vs_currencies") == "brl" {
+				fmt.Fprint(w, `{"tether": {"brl": 5.41}}`)
+				return
+			}
+			fmt.Fprint(w, `{"tether": {}}`)
+		case "/latest/USD":
+			fmt.Fprint(w, `{"result": "success", "rates": {"KES": 129.25, "NGN": 1585.5}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	ctx := context.Background()
+
+	t.Run("binance takes the median advert price", func(t *testing.T) {
+		rate, err := (&BinanceP2PSource{url: server.URL}).Rate(ctx, "KES")
+		assert.NoError(t, err)
+		assert.True(t, rate.Equal(decimal.NewFromFloat(129.5)))
+	})
+
+	t.Run("quidax falls back to the lower of the midpoint and last price without buyers", func(t *testing.T) {
+		rate, err := (&QuidaxSource{url: server.URL}).Rate(ctx, "NGN")
+		assert.NoError(t, err)
+		assert.True(t, rate.Equal(decimal.NewFromInt(1580)))
+
+		_, err = (&QuidaxSource{url: server.URL}).Rate(ctx, "KES")
+		assert.ErrorIs(t, err, ErrUnsupportedCurrency)
+	})
+
+	t.Run("coingecko reads the tether price", func(t *testing.T) {
+		rate, err := (&CoinGeckoSource{url: server.URL}).Rate(ctx, "BRL")
+		assert.NoError(t, err)
+		assert.True(t, rate.Equal(decimal.NewFromFloat(5.41)))
+
+		_, err = (&CoinGeckoSource{url: server.URL}).Rate(ctx, "XOF")
+		assert.ErrorIs(t, err, ErrUnsupportedCurrency)
+	})
+
+	t.Run("fixings read the USD reference rate", func(t *testing.T) {
+		rate, err := (&FixingsSource{url: server.URL + "/latest/USD"}).Rate(ctx, "NGN")
+		assert.NoError(t, err)
+		assert.True(t, rate.Equal(decimal.NewFromFloat(1585.5)))
+
+		_, err = (&FixingsSource{url: server.URL + "/latest/USD"}).Rate(ctx, "UGX")
+		assert.ErrorIs(t, err, ErrUnsupportedCurrency)
+
+		_, err = (&FixingsSource{url: server.URL + "/missing"}).Rate(ctx, "NGN")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,240 @@
+package marketrate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	fastshot "github.com/opus-domini/fast-shot"
+	"github.com/shopspring/decimal"
+)
+
+// sourceTimeout bounds each request made to a source
+const sourceTimeout = 15 * time.Second
+
+// NewSource creates the source of the given name
+func NewSource(name string, conf *config.MarketRateConfiguration) (Source, error) {
+	switch name {
+	case "binance":
+		return &BinanceP2PSource{url: "https://p2p.binance.com"}, nil
+	case "quidax":
+		return &QuidaxSource{url: "https://app.quidax.io"}, nil
+	case "coingecko":
+		return &CoinGeckoSource{url: conf.CoinGeckoURL}, nil
+	case "fixings":
+		return &FixingsSource{url: conf.FixingsURL}, nil
+	default:
+		return nil, fmt.Errorf("unknown market rate source %q", name)
+	}
+}
+
+// BinanceP2PSource reads the median price of the USDT sell adverts on Binance P2P
+type BinanceP2PSource struct {
+	url string
+}
+
+// Name returns the name of the source
+func (s *BinanceP2PSource) Name() string {
+	return "binance"
+}
+
+// Rate returns the median price of the first page of USDT sell adverts in the currency
+func (s *BinanceP2PSource) Rate(ctx context.Context, currency string) (decimal.Decimal, error) {
+	res, err := fastshot.NewClient(s.url).
+		Config().SetTimeout(sourceTimeout).
+		Header().Add("Content-Type", "application/json").
+		Build().POST("/bapi/c2c/v2/friendly/c2c/adv/search").
+		Context().Set(ctx).
+		Retry().Set(2, 2*time.Second).
+		Body().AsJSON(map[string]interface{}{
+		"asset":     "USDT",
+		"fiat":      currency,
+		"tradeType": "SELL",
+		"page":      1,
+		"rows":      20,
+	}).
+		Send()
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	var body struct {
+		Data []struct {
+			Adv struct {
+				Price string `json:"price"`
+			} `json:"adv"`
+		} `json:"data"`
+	}
+	if err := decodeResponse(res.RawResponse, &body); err != nil {
+		return decimal.Zero, err
+	}
+
+	var prices []decimal.Decimal
+	for _, item := range body.Data {
+		price, err := decimal.NewFromString(item.Adv.Price)
+		if err != nil {
+			continue
+		}
+		prices = append(prices, price)
+	}
+	if len(prices) == 0 {
+		return decimal.Zero, ErrUnsupportedCurrency
+	}
+
+	return median(prices), nil
+}
+
+// QuidaxSource reads the USDT ticker of the Quidax exchange, which only quotes NGN
+type QuidaxSource struct {
+	url string
+}
+
+// Name returns the name of the source
+func (s *QuidaxSource) Name() string {
+	return "quidax"
+}
+
+// Rate returns the buy price of the USDT ticker in the currency. Without buyers, it is the lower of
+// the last price and the midpoint of the day's range
+func (s *QuidaxSource) Rate(ctx context.Context, currency string) (decimal.Decimal, error) {
+	if currency != "NGN" {
+		return decimal.Zero, ErrUnsupportedCurrency
+	}
+
+	res, err := fastshot.NewClient(s.url).
+		Config().SetTimeout(sourceTimeout).
+		Build().GET(fmt.Sprintf("/api/v1/markets/tickers/usdt%s", strings.ToLower(currency))).
+		Context().Set(ctx).
+		Retry().Set(2, 2*time.Second).
+		Send()
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	var body struct {
+		Data struct {
+			Ticker struct {
+				Buy  decimal.Decimal `json:"buy"`
+				Last decimal.Decimal `json:"last"`
+				High decimal.Decimal `json:"high"`
+				Low  decimal.Decimal `json:"low"`
+			} `json:"ticker"`
+		} `json:"data"`
+	}
+	if err := decodeResponse(res.RawResponse, &body); err != nil {
+		return decimal.Zero, err
+	}
+
+	ticker := body.Data.Ticker
+	if ticker.Buy.IsPositive() {
+		return ticker.Buy, nil
+	}
+
+	midpoint := ticker.High.Add(ticker.Low).Div(decimal.NewFromInt(2))
+	if midpoint.LessThan(ticker.Last) {
+		return midpoint, nil
+	}
+	return ticker.Last, nil
+}
+
+// CoinGeckoSource reads the aggregated USDT price of the CoinGecko API
+type CoinGeckoSource struct {
+	url string
+}
+
+// Name returns the name of the source
+func (s *CoinGeckoSource) Name() string {
+	return "coingecko"
+}
+
+// Rate returns the price of USDT in the currency
+func (s *CoinGeckoSource) Rate(ctx context.Context, currency string) (decimal.Decimal, error) {
+	vsCurrency := strings.ToLower(currency)
+
+	res, err := fastshot.NewClient(s.url).
+		Config().SetTimeout(sourceTimeout).
+		Build().GET("/simple/price").
+		Query().AddParams(map[string]string{
+		"ids":           "tether",
+		"vs_currencies": vsCurrency,
+	}).
+		Context().Set(ctx).
+		Retry().Set(2, 2*time.Second).
+		Send()
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	var body map[string]map[string]decimal.Decimal
+	if err := decodeResponse(res.RawResponse, &body); err != nil {
+		return decimal.Zero, err
+	}
+
+	price, ok := body["tether"][vsCurrency]
+	if !ok {
+		return decimal.Zero, ErrUnsupportedCurrency
+	}
+	return price, nil
+}
+
+// FixingsSource reads official USD reference rates, e.g. the fixings published by central banks,
+// from an API serving them as {"rates": {"<currency>": <rate>}}. USDT is taken at par with USD
+type FixingsSource struct {
+	url string
+}
+
+// Name returns the name of the source
+func (s *FixingsSource) Name() string {
+	return "fixings"
+}
+
+// Rate returns the USD reference rate of the currency
+func (s *FixingsSource) Rate(ctx context.Context, currency string) (decimal.Decimal, error) {
+	res, err := fastshot.NewClient(s.url).
+		Config().SetTimeout(sourceTimeout).
+		Build().GET("").
+		Context().Set(ctx).
+		Retry().Set(2, 2*time.Second).
+		Send()
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	var body struct {
+		Rates map[string]decimal.Decimal `json:"rates"`
+	}
+	if err := decodeResponse(res.RawResponse, &body); err != nil {
+		return decimal.Zero, err
+	}
+
+	rate, ok := body.Rates[currency]
+	if !ok {
+		return decimal.Zero, ErrUnsupportedCurrency
+	}
+	return rate, nil
+}
+
+// decodeResponse decodes a JSON response body into v, failing on error statuses
+func decodeResponse(res *http.Response, v interface{}) error {
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode >= 400 {
+		return fmt.Errorf("HTTP %d: %s", res.StatusCode, body)
+	}
+
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return nil
+}
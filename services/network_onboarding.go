@@ -0,0 +1,37 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/utils/lock"
+)
+
+// SyncNetworkWebhooks brings the webhooks of the active blockchain service in line with a network
+// that was onboarded, enabled, disabled or reconfigured. WebSocket subscriptions follow on the
+// indexer's next refresh
+func (sm *ServiceManager) SyncNetworkWebhooks(ctx context.Context, network *ent.Network) error {
+	switch sm.ActiveBlockchainService() {
+	case paymentorder.BlockchainServiceEngine:
+		// The gateway webhook covers every enabled network and is extended with new chains
+		if !network.IsEnabled || !DepositSourcesFor(network).Webhooks {
+			return nil
+		}
+		return sm.GetEngineService().CreateGatewayWebhook()
+	case paymentorder.BlockchainServiceAlchemy:
+		// Reconciliation creates the webhook of an enabled network and prunes the one of a disabled network
+		var report *AlchemyWebhookReport
+		ran, err := lock.Default().Run(ctx, "alchemy-webhook-reconciliation", func(ctx context.Context) error {
+			var err error
+			report, err = ReconcileAlchemyWebhooks(ctx)
+			return err
+		})
+		if err != nil || !ran {
+			return err
+		}
+		return errors.Join(report.Errors...)
+	}
+	return nil
+}
@@ -21,11 +21,13 @@ import (
 	"github.com/NEDA-LABS/stablenode/ent/institution"
 	"github.com/NEDA-LABS/stablenode/ent/lockorderfulfillment"
 	"github.com/NEDA-LABS/stablenode/ent/lockpaymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/outboxtransaction"
 	networkent "github.com/NEDA-LABS/stablenode/ent/network"
 	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
 	"github.com/NEDA-LABS/stablenode/ent/providerordertoken"
 	"github.com/NEDA-LABS/stablenode/ent/providerprofile"
 	tokenent "github.com/NEDA-LABS/stablenode/ent/token"
+	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
 	"github.com/NEDA-LABS/stablenode/types"
 	"github.com/NEDA-LABS/stablenode/utils"
 	cryptoUtils "github.com/NEDA-LABS/stablenode/utils/crypto"
@@ -158,39 +160,53 @@ func (s *OrderEVM) CreateOrder(ctx context.Context, orderID uuid.UUID) error {
 		return fmt.Errorf("%s - CreateOrder.createOrderCallData: %w", orderIDPrefix, err)
 	}
 
-	// Create approve data for gateway contract
-	approveGatewayData, err := s.approveCallData(
-		ethcommon.HexToAddress(order.Edges.Token.Edges.Network.GatewayContractAddress),
-		utils.ToSubunit(order.Amount.Add(order.SenderFee), order.Edges.Token.Decimals),
-	)
-	if err != nil {
-		return fmt.Errorf("%s - CreateOrder.approveCallData: %w", orderIDPrefix, err)
-	}
-
-	// Convert to hex string properly
-	approveDataHex := "0x" + ethcommon.Bytes2Hex(approveGatewayData)
-	
-	logger.WithFields(logger.Fields{
-		"OrderID": orderID,
-		"ApproveDataLength": len(approveGatewayData),
-		"ApproveDataHex": approveDataHex,
-	}).Info("Created approve calldata")
-
-	// Create order
-	txPayload := []map[string]interface{}{
-		{
-			"to":    order.Edges.Token.ContractAddress,
-			"data":  approveDataHex,
-			"value": "0",
-		},
-		{
-			"to":    order.Edges.Token.Edges.Network.GatewayContractAddress,
-			"data":  fmt.Sprintf("0x%x", createOrderData),
-			"value": "0",
-		},
-	}
-
-	_, err = s.serviceManager.SendTransactionBatch(ctx, order.Edges.Token.Edges.Network.ChainID, address, txPayload)
+	var txPayload []map[string]interface{}
+	if utils.IsNativeToken(order.Edges.Token.ContractAddress) {
+		// Native deposits need no approval and are sent along with the createOrder call
+		txPayload = []map[string]interface{}{
+			{
+				"to":    order.Edges.Token.Edges.Network.GatewayContractAddress,
+				"data":  fmt.Sprintf("0x%x", createOrderData),
+				"value": fmt.Sprintf("0x%x", utils.ToSubunit(order.Amount.Add(order.SenderFee), order.Edges.Token.Decimals)),
+			},
+		}
+	} else {
+		// Create approve data for gateway contract
+		approveGatewayData, err := s.approveCallData(
+			ethcommon.HexToAddress(order.Edges.Token.Edges.Network.GatewayContractAddress),
+			utils.ToSubunit(order.Amount.Add(order.SenderFee), order.Edges.Token.Decimals),
+		)
+		if err != nil {
+			return fmt.Errorf("%s - CreateOrder.approveCallData: %w", orderIDPrefix, err)
+		}
+
+		// Convert to hex string properly
+		approveDataHex := "0x" + ethcommon.Bytes2Hex(approveGatewayData)
+
+		logger.WithFields(logger.Fields{
+			"OrderID":           orderID,
+			"ApproveDataLength": len(approveGatewayData),
+			"ApproveDataHex":    approveDataHex,
+		}).Info("Created approve calldata")
+
+		// Create order
+		txPayload = []map[string]interface{}{
+			{
+				"to":    order.Edges.Token.ContractAddress,
+				"data":  approveDataHex,
+				"value": "0",
+			},
+			{
+				"to":    order.Edges.Token.Edges.Network.GatewayContractAddress,
+				"data":  fmt.Sprintf("0x%x", createOrderData),
+				"value": "0",
+			},
+		}
+	}
+
+	// The order is created on-chain by the service holding its receive address
+	ctx = services.WithGasSpend(ctx, transactionlog.PurposeOrderCreation, &order.ID)
+	_, err = s.serviceManager.ForOrder(order).SendTransactionBatch(ctx, order.Edges.Token.Edges.Network.ChainID, address, txPayload)
 	if err != nil {
 		return fmt.Errorf("%s - CreateOrder.sendTransactionBatch: %w", orderIDPrefix, err)
 	}
@@ -216,28 +232,40 @@ func (s *OrderEVM) RefundOrder(ctx context.Context, network *ent.Network, orderI
 		WithToken(func(tq *ent.TokenQuery) {
 			tq.WithNetwork()
 		}).
+		WithParent().
 		First(ctx)
 	if err != nil {
 		return fmt.Errorf("%s - RefundOrder.fetchLockOrder: %w", orderIDPrefix, err)
 	}
 
-	// Create refundOrder data
 	fee := utils.ToSubunit(decimal.NewFromInt(0), lockOrder.Edges.Token.Decimals)
+
+	// Check the order on-chain so a refund bound to revert is never submitted
+	gateway, err := NewGateway(ctx, lockOrder.Edges.Token.Edges.Network)
+	if err != nil {
+		return fmt.Errorf("%s - RefundOrder.gateway: %w", orderIDPrefix, err)
+	}
+	err = gateway.PreflightRefund(ctx, lockOrder.GatewayID, gatewayAmount(lockOrder), fee)
+	if err != nil {
+		return fmt.Errorf("%s - RefundOrder.preflight: %w", orderIDPrefix, err)
+	}
+
+	// Create refundOrder data
 	refundOrderData, err := s.refundCallData(fee, lockOrder.GatewayID)
 	if err != nil {
 		return fmt.Errorf("%s - RefundOrder.refundCallData: %w", orderIDPrefix, err)
 	}
 
-	// Refund order
+	// Queue the refund for the outbox worker to send
 	txPayload := map[string]interface{}{
 		"to":    lockOrder.Edges.Token.Edges.Network.GatewayContractAddress,
 		"data":  fmt.Sprintf("0x%x", refundOrderData),
 		"value": "0",
 	}
 
-	_, err = s.serviceManager.SendTransactionBatch(ctx, lockOrder.Edges.Token.Edges.Network.ChainID, cryptoConf.AggregatorSmartAccount, []map[string]interface{}{txPayload})
+	_, err = services.EnqueueTransaction(ctx, outboxtransaction.KindRefundOrder, lockOrder.ID, lockOrder.Edges.Token.Edges.Network, cryptoConf.AggregatorSmartAccount, []map[string]interface{}{txPayload})
 	if err != nil {
-		return fmt.Errorf("%s - RefundOrder.sendTransaction: %w", orderIDPrefix, err)
+		return fmt.Errorf("%s - RefundOrder.enqueueTransaction: %w", orderIDPrefix, err)
 	}
 
 	return nil
@@ -263,27 +291,38 @@ func (s *OrderEVM) SettleOrder(ctx context.Context, orderID uuid.UUID) error {
 			tq.WithNetwork()
 		}).
 		WithProvider().
+		WithParent().
 		Only(ctx)
 	if err != nil {
 		return fmt.Errorf("%s - SettleOrder.fetchOrder: %w", orderIDPrefix, err)
 	}
 
+	// Check the order on-chain so a settlement bound to revert is never submitted
+	gateway, err := NewGateway(ctx, order.Edges.Token.Edges.Network)
+	if err != nil {
+		return fmt.Errorf("%s - SettleOrder.gateway: %w", orderIDPrefix, err)
+	}
+	err = gateway.PreflightSettle(ctx, order.GatewayID, gatewayAmount(order), settlePercentBPS(order))
+	if err != nil {
+		return fmt.Errorf("%s - SettleOrder.preflight: %w", orderIDPrefix, err)
+	}
+
 	// Create settleOrder data
 	settleOrderData, err := s.settleCallData(ctx, order)
 	if err != nil {
 		return fmt.Errorf("%s - SettleOrder.settleCallData: %w", orderIDPrefix, err)
 	}
 
-	// Settle order
+	// Queue the settlement for the outbox worker to send
 	txPayload := map[string]interface{}{
 		"to":    order.Edges.Token.Edges.Network.GatewayContractAddress,
 		"data":  fmt.Sprintf("0x%x", settleOrderData),
 		"value": "0",
 	}
 
-	_, err = s.serviceManager.SendTransactionBatch(ctx, order.Edges.Token.Edges.Network.ChainID, cryptoConf.AggregatorSmartAccount, []map[string]interface{}{txPayload})
+	_, err = services.EnqueueTransaction(ctx, outboxtransaction.KindSettleOrder, order.ID, order.Edges.Token.Edges.Network, cryptoConf.AggregatorSmartAccount, []map[string]interface{}{txPayload})
 	if err != nil {
-		return fmt.Errorf("%s - SettleOrder.sendTransaction: %w", orderIDPrefix, err)
+		return fmt.Errorf("%s - SettleOrder.enqueueTransaction: %w", orderIDPrefix, err)
 	}
 
 	return nil
@@ -376,10 +415,6 @@ func (s *OrderEVM) settleCallData(ctx context.Context, order *ent.LockPaymentOrd
 		return nil, fmt.Errorf("failed to fetch provider order token: %w", err)
 	}
 
-	orderPercent, _ := order.OrderPercent.
-		Mul(decimal.NewFromInt(1000)). // convert percent to BPS
-		Float64()
-
 	orderID, err := hex.DecodeString(order.GatewayID[2:])
 	if err != nil {
 		return nil, fmt.Errorf("failed to decode orderID: %w", err)
@@ -393,7 +428,7 @@ func (s *OrderEVM) settleCallData(ctx context.Context, order *ent.LockPaymentOrd
 		utils.StringToByte32(splitOrderID),
 		utils.StringToByte32(string(orderID)),
 		ethcommon.HexToAddress(token.Address),
-		uint64(orderPercent),
+		settlePercentBPS(order),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to pack settle ABI: %w", err)
@@ -402,6 +437,24 @@ func (s *OrderEVM) settleCallData(ctx context.Context, order *ent.LockPaymentOrd
 	return data, nil
 }
 
+// settlePercentBPS returns the share of its gateway order a lock order settles, in gateway BPS
+func settlePercentBPS(order *ent.LockPaymentOrder) uint64 {
+	orderPercent, _ := order.OrderPercent.
+		Mul(decimal.NewFromInt(1000)). // convert percent to BPS
+		Float64()
+	return uint64(orderPercent)
+}
+
+// gatewayAmount returns the amount of a lock order in token subunits, as the gateway records it. A part
+// of a split order shares the gateway order of its parent, so the amount is the parent's
+func gatewayAmount(order *ent.LockPaymentOrder) *big.Int {
+	amount := order.Amount
+	if order.Edges.Parent != nil {
+		amount = order.Edges.Parent.Amount
+	}
+	return utils.ToSubunit(amount.Round(int32(order.Edges.Token.Decimals)), order.Edges.Token.Decimals)
+}
+
 // refundCallData creates the data for the refund method
 func (s *OrderEVM) refundCallData(fee *big.Int, orderId string) ([]byte, error) {
 	gatewayABI, err := abi.JSON(strings.NewReader(contracts.GatewayMetaData.ABI))
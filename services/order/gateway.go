@@ -0,0 +1,103 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/services/contracts"
+	"github.com/NEDA-LABS/stablenode/utils"
+	"github.com/NEDA-LABS/stablenode/utils/ratelimit"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// Preflight errors for gateway calls that would revert on-chain
+var (
+	ErrGatewayOrderNotFound         = errors.New("order does not exist on the gateway")
+	ErrGatewayOrderFulfilled        = errors.New("order is already settled on the gateway")
+	ErrGatewayOrderRefunded         = errors.New("order is already refunded on the gateway")
+	ErrGatewayAmountMismatch        = errors.New("order amount does not match the gateway")
+	ErrGatewaySettlePercentExceeded = errors.New("settle percent exceeds what is left of the order on the gateway")
+	ErrGatewayFeeExceedsProtocolFee = errors.New("refund fee exceeds the protocol fee of the order on the gateway")
+)
+
+// GatewayOrderReader reads the state of orders from a gateway contract
+type GatewayOrderReader interface {
+	GetOrderInfo(opts *bind.CallOpts, orderId [32]byte) (contracts.IGatewayOrder, error)
+}
+
+// Gateway checks settle and refund calls against the on-chain order state before they are submitted,
+// so calls bound to revert fail fast with a typed error instead of burning gas
+type Gateway struct {
+	reader GatewayOrderReader
+}
+
+// NewGateway creates a Gateway reading from the gateway contract of a network
+func NewGateway(ctx context.Context, network *ent.Network) (*Gateway, error) {
+	client, err := ratelimit.DialEthClient(ctx, utils.BuildRPCURL(network.RPCEndpoint))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", network.Identifier, err)
+	}
+
+	caller, err := contracts.NewGatewayCaller(ethcommon.HexToAddress(network.GatewayContractAddress), client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind gateway contract: %w", err)
+	}
+
+	return &Gateway{reader: caller}, nil
+}
+
+// orderInfo returns the on-chain state of an order that is still open on the gateway
+func (g *Gateway) orderInfo(ctx context.Context, gatewayID string, amount *big.Int) (contracts.IGatewayOrder, error) {
+	order, err := g.reader.GetOrderInfo(&bind.CallOpts{Context: ctx}, ethcommon.HexToHash(gatewayID))
+	if err != nil {
+		return order, fmt.Errorf("failed to fetch gateway order: %w", err)
+	}
+
+	// Unknown order IDs read back as an empty order
+	if order.Sender == (ethcommon.Address{}) {
+		return order, ErrGatewayOrderNotFound
+	}
+	if order.IsFulfilled {
+		return order, ErrGatewayOrderFulfilled
+	}
+	if order.IsRefunded {
+		return order, ErrGatewayOrderRefunded
+	}
+	if order.Amount == nil || order.Amount.Cmp(amount) != 0 {
+		return order, fmt.Errorf("%w: expected %s, gateway has %s", ErrGatewayAmountMismatch, amount, order.Amount)
+	}
+
+	return order, nil
+}
+
+// PreflightSettle checks that a share of settlePercent BPS of an order of amount can be settled
+func (g *Gateway) PreflightSettle(ctx context.Context, gatewayID string, amount *big.Int, settlePercent uint64) error {
+	order, err := g.orderInfo(ctx, gatewayID, amount)
+	if err != nil {
+		return err
+	}
+
+	if order.CurrentBPS == nil || order.CurrentBPS.Cmp(new(big.Int).SetUint64(settlePercent)) < 0 {
+		return fmt.Errorf("%w: settling %d BPS, gateway has %s left", ErrGatewaySettlePercentExceeded, settlePercent, order.CurrentBPS)
+	}
+
+	return nil
+}
+
+// PreflightRefund checks that an order of amount can be refunded, keeping fee
+func (g *Gateway) PreflightRefund(ctx context.Context, gatewayID string, amount *big.Int, fee *big.Int) error {
+	order, err := g.orderInfo(ctx, gatewayID, amount)
+	if err != nil {
+		return err
+	}
+
+	if order.ProtocolFee == nil || order.ProtocolFee.Cmp(fee) < 0 {
+		return fmt.Errorf("%w: fee %s, protocol fee %s", ErrGatewayFeeExceedsProtocolFee, fee, order.ProtocolFee)
+	}
+
+	return nil
+}
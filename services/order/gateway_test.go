@@ -0,0 +1,77 @@
+package order
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/NEDA-LABS/stablenode/services/contracts"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+type stubGatewayReader struct {
+	order contracts.IGatewayOrder
+}
+
+func (r *stubGatewayReader) GetOrderInfo(opts *bind.CallOpts, orderId [32]byte) (contracts.IGatewayOrder, error) {
+	return r.order, nil
+}
+
+func TestGatewayPreflight(t *testing.T) {
+	ctx := context.Background()
+	gatewayID := "0x" + ethcommon.Bytes2Hex(make([]byte, 32))
+	amount := big.NewInt(12500000)
+
+	openOrder := func() contracts.IGatewayOrder {
+		return contracts.IGatewayOrder{
+			Sender:      ethcommon.HexToAddress("0x3333333333333333333333333333333333333333"),
+			Amount:      big.NewInt(12500000),
+			CurrentBPS:  big.NewInt(100000),
+			ProtocolFee: big.NewInt(0),
+		}
+	}
+
+	t.Run("should pass an open order", func(t *testing.T) {
+		gateway := &Gateway{reader: &stubGatewayReader{order: openOrder()}}
+		assert.NoError(t, gateway.PreflightSettle(ctx, gatewayID, amount, 100000))
+		assert.NoError(t, gateway.PreflightRefund(ctx, gatewayID, amount, big.NewInt(0)))
+	})
+
+	t.Run("should reject an unknown order", func(t *testing.T) {
+		gateway := &Gateway{reader: &stubGatewayReader{}}
+		assert.ErrorIs(t, gateway.PreflightSettle(ctx, gatewayID, amount, 100000), ErrGatewayOrderNotFound)
+	})
+
+	t.Run("should reject a settled order", func(t *testing.T) {
+		order := openOrder()
+		order.IsFulfilled = true
+		gateway := &Gateway{reader: &stubGatewayReader{order: order}}
+		assert.ErrorIs(t, gateway.PreflightRefund(ctx, gatewayID, amount, big.NewInt(0)), ErrGatewayOrderFulfilled)
+	})
+
+	t.Run("should reject a refunded order", func(t *testing.T) {
+		order := openOrder()
+		order.IsRefunded = true
+		gateway := &Gateway{reader: &stubGatewayReader{order: order}}
+		assert.ErrorIs(t, gateway.PreflightSettle(ctx, gatewayID, amount, 100000), ErrGatewayOrderRefunded)
+	})
+
+	t.Run("should reject a mismatched amount", func(t *testing.T) {
+		gateway := &Gateway{reader: &stubGatewayReader{order: openOrder()}}
+		assert.ErrorIs(t, gateway.PreflightSettle(ctx, gatewayID, big.NewInt(12000000), 100000), ErrGatewayAmountMismatch)
+	})
+
+	t.Run("should reject settling more than is left", func(t *testing.T) {
+		order := openOrder()
+		order.CurrentBPS = big.NewInt(50000)
+		gateway := &Gateway{reader: &stubGatewayReader{order: order}}
+		assert.ErrorIs(t, gateway.PreflightSettle(ctx, gatewayID, amount, 100000), ErrGatewaySettlePercentExceeded)
+	})
+
+	t.Run("should reject a refund fee above the protocol fee", func(t *testing.T) {
+		gateway := &Gateway{reader: &stubGatewayReader{order: openOrder()}}
+		assert.ErrorIs(t, gateway.PreflightRefund(ctx, gatewayID, amount, big.NewInt(1)), ErrGatewayFeeExceedsProtocolFee)
+	})
+}
@@ -0,0 +1,382 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	networkent "github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
+	"github.com/NEDA-LABS/stablenode/services"
+	"github.com/NEDA-LABS/stablenode/services/contracts"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/NEDA-LABS/stablenode/utils"
+	"github.com/NEDA-LABS/stablenode/utils/lock"
+	"github.com/NEDA-LABS/stablenode/utils/ratelimit"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/shopspring/decimal"
+)
+
+// Permit deposit errors for deposits that cannot be pulled from the payer with a permit
+var (
+	ErrPermitNetworkUnsupported  = errors.New("permit deposits are only supported on EVM networks")
+	ErrPermitTokenUnsupported    = errors.New("token does not support EIP-2612 permits")
+	ErrPermitOrderNotPayable     = errors.New("order is not awaiting a deposit")
+	ErrPermitDeadlineTooSoon     = errors.New("permit deadline has passed or is too close to be mined")
+	ErrPermitInvalidSignature    = errors.New("permit is not signed by its owner for the amount due")
+	ErrPermitInsufficientBalance = errors.New("owner balance is below the amount due")
+	ErrPermitDepositPending      = errors.New("a permit deposit for this order is still pending")
+)
+
+// permitABI holds the EIP-2612 methods of a token
+const permitABI = `[{"inputs":[],"name":"DOMAIN_SEPARATOR","outputs":[{"internalType":"bytes32","name":"","type":"bytes32"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"owner","type":"address"}],"name":"nonces","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"owner","type":"address"},{"internalType":"address","name":"spender","type":"address"},{"internalType":"uint256","name":"value","type":"uint256"},{"internalType":"uint256","name":"deadline","type":"uint256"},{"internalType":"uint8","name":"v","type":"uint8"},{"internalType":"bytes32","name":"r","type":"bytes32"},{"internalType":"bytes32","name":"s","type":"bytes32"}],"name":"permit","outputs":[],"stateMutability":"nonpayable","type":"function"}]`
+
+// permitTypeHash is the EIP-712 type hash of an EIP-2612 permit
+var permitTypeHash = crypto.Keccak256Hash([]byte("Permit(address owner,address spender,uint256 value,uint256 nonce,uint256 deadline)"))
+
+// permitDeadlineMargin is how long a permit must stay valid after it is submitted, so its
+// UserOperation can be mined before the deadline
+const permitDeadlineMargin = 5 * time.Minute
+
+// PermitTokenReader reads the permit state of a token
+type PermitTokenReader interface {
+	DomainSeparator(ctx context.Context) ([32]byte, error)
+	Nonce(ctx context.Context, owner ethcommon.Address) (*big.Int, error)
+	BalanceOf(ctx context.Context, owner ethcommon.Address) (*big.Int, error)
+}
+
+// permitToken reads the permit state of a token contract
+type permitToken struct {
+	permit *bind.BoundContract
+	erc20  *contracts.ERC20TokenCaller
+}
+
+// NewPermitToken creates a PermitTokenReader for a token contract on a network
+func NewPermitToken(ctx context.Context, network *ent.Network, tokenAddress string) (PermitTokenReader, error) {
+	client, err := ratelimit.DialEthClient(ctx, utils.BuildRPCURL(network.RPCEndpoint))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", network.Identifier, err)
+	}
+
+	parsed, err := abi.JSON(strings.NewReader(permitABI))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse permit ABI: %w", err)
+	}
+
+	address := ethcommon.HexToAddress(tokenAddress)
+	erc20, err := contracts.NewERC20TokenCaller(address, client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind token contract: %w", err)
+	}
+
+	return &permitToken{
+		permit: bind.NewBoundContract(address, parsed, client, nil, nil),
+		erc20:  erc20,
+	}, nil
+}
+
+// DomainSeparator returns the EIP-712 domain separator of the token
+func (t *permitToken) DomainSeparator(ctx context.Context) ([32]byte, error) {
+	var out []interface{}
+	if err := t.permit.Call(&bind.CallOpts{Context: ctx}, &out, "DOMAIN_SEPARATOR"); err != nil {
+		return [32]byte{}, err
+	}
+	return *abi.ConvertType(out[0], new([32]byte)).(*[32]byte), nil
+}
+
+// Nonce returns the next permit nonce of owner
+func (t *permitToken) Nonce(ctx context.Context, owner ethcommon.Address) (*big.Int, error) {
+	var out []interface{}
+	if err := t.permit.Call(&bind.CallOpts{Context: ctx}, &out, "nonces", owner); err != nil {
+		return nil, err
+	}
+	return *abi.ConvertType(out[0], new(*big.Int)).(**big.Int), nil
+}
+
+// BalanceOf returns the token balance of owner
+func (t *permitToken) BalanceOf(ctx context.Context, owner ethcommon.Address) (*big.Int, error) {
+	return t.erc20.BalanceOf(&bind.CallOpts{Context: ctx}, owner)
+}
+
+// transactionBatchSender sends a batch of calls from a smart account as one UserOperation
+type transactionBatchSender interface {
+	SendTransactionBatch(ctx context.Context, chainID int64, address string, txPayload []map[string]interface{}) (string, error)
+}
+
+// PermitDeposit pays the deposit of an order on behalf of a payer holding no gas. The payer signs an
+// EIP-2612 permit letting the aggregator smart account spend the amount due, and the aggregator pulls
+// it from the payer into the order's receive address in a sponsored UserOperation. The deposit is then
+// picked up like any transfer to the receive address
+type PermitDeposit struct {
+	tokens  func(ctx context.Context, network *ent.Network, tokenAddress string) (PermitTokenReader, error)
+	sender  transactionBatchSender
+	spender string
+	now     func() time.Time
+	// locker serializes the submissions of an order across aggregator instances
+	locker *lock.Locker
+}
+
+// NewPermitDeposit creates a new instance of PermitDeposit
+func NewPermitDeposit() *PermitDeposit {
+	return &PermitDeposit{
+		tokens:  NewPermitToken,
+		sender:  services.NewServiceManager(),
+		spender: cryptoConf.AggregatorSmartAccount,
+		now:     time.Now,
+		locker:  lock.Default(),
+	}
+}
+
+// permitRequest is a permit deposit being checked against the order and the token
+type permitRequest struct {
+	token           PermitTokenReader
+	owner           ethcommon.Address
+	spender         ethcommon.Address
+	value           *big.Int
+	nonce           *big.Int
+	domainSeparator [32]byte
+}
+
+// prepare checks that an order awaits a deposit the owner can permit on-chain, and reads what the
+// owner has to sign. The order must be loaded with its token and network
+func (p *PermitDeposit) prepare(ctx context.Context, order *ent.PaymentOrder, owner string) (*permitRequest, error) {
+	network := order.Edges.Token.Edges.Network
+	if network.NetworkType == networkent.NetworkTypeSolana || strings.HasPrefix(network.Identifier, "tron") {
+		return nil, ErrPermitNetworkUnsupported
+	}
+	if order.Status != paymentorder.StatusInitiated || order.ReceiveAddressText == "" {
+		return nil, ErrPermitOrderNotPayable
+	}
+	if !ethcommon.IsHexAddress(owner) {
+		return nil, fmt.Errorf("%w: invalid owner address", ErrPermitInvalidSignature)
+	}
+
+	due := amountDue(order)
+	if !due.IsPositive() {
+		return nil, ErrPermitOrderNotPayable
+	}
+
+	token, err := p.tokens(ctx, network, order.Edges.Token.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// Tokens without EIP-2612 have no domain separator to sign against
+	domainSeparator, err := token.DomainSeparator(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrPermitTokenUnsupported, err)
+	}
+
+	request := &permitRequest{
+		token:           token,
+		owner:           ethcommon.HexToAddress(owner),
+		spender:         ethcommon.HexToAddress(p.spender),
+		value:           utils.ToSubunit(due, order.Edges.Token.Decimals),
+		domainSeparator: domainSeparator,
+	}
+
+	request.nonce, err = token.Nonce(ctx, request.owner)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrPermitTokenUnsupported, err)
+	}
+
+	return request, nil
+}
+
+// amountDue returns what is left to pay of an order, fees included
+func amountDue(order *ent.PaymentOrder) decimal.Decimal {
+	total := order.Amount.Add(order.NetworkFee).Add(order.SenderFee).Round(int32(order.Edges.Token.Decimals))
+	return total.Sub(order.AmountPaid)
+}
+
+// digest returns the EIP-712 hash of the permit the owner signs
+func (r *permitRequest) digest(deadline *big.Int) []byte {
+	structHash := crypto.Keccak256(
+		permitTypeHash.Bytes(),
+		ethcommon.LeftPadBytes(r.owner.Bytes(), 32),
+		ethcommon.LeftPadBytes(r.spender.Bytes(), 32),
+		ethcommon.LeftPadBytes(r.value.Bytes(), 32),
+		ethcommon.LeftPadBytes(r.nonce.Bytes(), 32),
+		ethcommon.LeftPadBytes(deadline.Bytes(), 32),
+	)
+	return crypto.Keccak256([]byte("\x19\x01"), r.domainSeparator[:], structHash)
+}
+
+// Params returns the permit the owner has to sign to pay the deposit of an order
+func (p *PermitDeposit) Params(ctx context.Context, order *ent.PaymentOrder, owner string) (*types.PermitDepositParamsResponse, error) {
+	request, err := p.prepare(ctx, order, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.PermitDepositParamsResponse{
+		TokenAddress:    order.Edges.Token.ContractAddress,
+		ChainID:         order.Edges.Token.Edges.Network.ChainID,
+		Owner:           request.owner.Hex(),
+		Spender:         request.spender.Hex(),
+		Value:           request.value.String(),
+		Nonce:           request.nonce.String(),
+		DomainSeparator: ethcommon.Bytes2Hex(request.domainSeparator[:]),
+		MinDeadline:     p.now().Add(permitDeadlineMargin).Unix(),
+	}, nil
+}
+
+// Submit pulls the deposit of an order from the owner of a signed permit into the order's receive
+// address. Only one permit deposit of an order is pending at a time; another is accepted once the
+// deadline of the previous one has passed without the order being paid
+func (p *PermitDeposit) Submit(ctx context.Context, order *ent.PaymentOrder, payload *types.PermitDepositPayload) (*types.PermitDepositResponse, error) {
+	now := p.now()
+	if time.Unix(payload.Deadline, 0).Before(now.Add(permitDeadlineMargin)) {
+		return nil, ErrPermitDeadlineTooSoon
+	}
+
+	// The order is locked from the check for a pending deposit until the new one is recorded, so
+	// concurrent submissions cannot both pull the amount due from the owner
+	var response *types.PermitDepositResponse
+	ran, err := p.locker.Run(ctx, "permit:"+order.ID.String(), func(ctx context.Context) error {
+		var err error
+		response, err = p.submit(ctx, order, payload, now)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !ran {
+		return nil, ErrPermitDepositPending
+	}
+
+	return response, nil
+}
+
+// submit sends the permit deposit of an order unless another one is still pending. The caller
+// holds the permit lock of the order
+func (p *PermitDeposit) submit(ctx context.Context, order *ent.PaymentOrder, payload *types.PermitDepositPayload, now time.Time) (*types.PermitDepositResponse, error) {
+	pending, err := order.QueryTransactions().
+		Where(transactionlog.StatusEQ(transactionlog.StatusPermitDepositSent)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch pending permit deposits: %w", err)
+	}
+	for _, log := range pending {
+		if deadline, ok := log.Metadata["Deadline"].(float64); ok && now.Unix() <= int64(deadline) {
+			return nil, ErrPermitDepositPending
+		}
+	}
+
+	request, err := p.prepare(ctx, order, payload.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	// The signature must recover to the owner for exactly the amount due
+	signature := ethcommon.FromHex(payload.Signature)
+	if len(signature) != 65 {
+		return nil, fmt.Errorf("%w: signature must be 65 bytes", ErrPermitInvalidSignature)
+	}
+	v := signature[64]
+	if v >= 27 {
+		v -= 27
+	}
+	if v > 1 {
+		return nil, fmt.Errorf("%w: invalid recovery id", ErrPermitInvalidSignature)
+	}
+	recoverable := append(append([]byte{}, signature[:64]...), v)
+
+	deadline := big.NewInt(payload.Deadline)
+	publicKey, err := crypto.SigToPub(request.digest(deadline), recoverable)
+	if err != nil || crypto.PubkeyToAddress(*publicKey) != request.owner {
+		return nil, ErrPermitInvalidSignature
+	}
+
+	balance, err := request.token.BalanceOf(ctx, request.owner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch owner balance: %w", err)
+	}
+	if balance.Cmp(request.value) < 0 {
+		return nil, ErrPermitInsufficientBalance
+	}
+
+	txPayload, err := permitDepositPayload(order, request, deadline, v+27, signature)
+	if err != nil {
+		return nil, err
+	}
+
+	network := order.Edges.Token.Edges.Network
+	transactionID, err := p.sender.SendTransactionBatch(ctx, network.ChainID, request.spender.Hex(), txPayload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send permit deposit: %w", err)
+	}
+
+	transactionLog, err := db.Client.TransactionLog.
+		Create().
+		SetStatus(transactionlog.StatusPermitDepositSent).
+		SetNetwork(network.Identifier).
+		SetMetadata(map[string]interface{}{
+			"TransactionID": transactionID,
+			"Owner":         request.owner.Hex(),
+			"Value":         request.value.String(),
+			"Nonce":         request.nonce.String(),
+			"Deadline":      payload.Deadline,
+		}).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to log permit deposit: %w", err)
+	}
+	if err := order.Update().AddTransactions(transactionLog).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to link permit deposit: %w", err)
+	}
+
+	return &types.PermitDepositResponse{
+		TransactionID: transactionID,
+		Owner:         request.owner.Hex(),
+		Value:         request.value.String(),
+	}, nil
+}
+
+// permitDepositPayload batches the permit with the transfer of the amount due from the owner to the
+// receive address, so the allowance is never left unspent
+func permitDepositPayload(order *ent.PaymentOrder, request *permitRequest, deadline *big.Int, v uint8, signature []byte) ([]map[string]interface{}, error) {
+	parsedPermitABI, err := abi.JSON(strings.NewReader(permitABI))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse permit ABI: %w", err)
+	}
+	permitData, err := parsedPermitABI.Pack("permit",
+		request.owner, request.spender, request.value, deadline, v,
+		[32]byte(signature[:32]), [32]byte(signature[32:64]),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack permit ABI: %w", err)
+	}
+
+	erc20ABI, err := contracts.ERC20TokenMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse erc20 ABI: %w", err)
+	}
+	transferData, err := erc20ABI.Pack("transferFrom", request.owner, ethcommon.HexToAddress(order.ReceiveAddressText), request.value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack transferFrom ABI: %w", err)
+	}
+
+	tokenAddress := order.Edges.Token.ContractAddress
+	return []map[string]interface{}{
+		{
+			"to":    tokenAddress,
+			"data":  fmt.Sprintf("0x%x", permitData),
+			"value": "0",
+		},
+		{
+			"to":    tokenAddress,
+			"data":  fmt.Sprintf("0x%x", transferData),
+			"value": "0",
+		},
+	}, nil
+}
@@ -0,0 +1,301 @@
+package order
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
+	"github.com/NEDA-LABS/stablenode/services/contracts"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/NEDA-LABS/stablenode/utils/lock"
+	"github.com/NEDA-LABS/stablenode/utils/test"
+)
+
+type stubPermitToken struct {
+	domainSeparator [32]byte
+	unsupported     bool
+	balance         *big.Int
+}
+
+func (t *stubPermitToken) DomainSeparator(ctx context.Context) ([32]byte, error) {
+	if t.unsupported {
+		return [32]byte{}, fmt.Errorf("execution reverted")
+	}
+	return t.domainSeparator, nil
+}
+
+func (t *stubPermitToken) Nonce(ctx context.Context, owner ethcommon.Address) (*big.Int, error) {
+	return big.NewInt(3), nil
+}
+
+func (t *stubPermitToken) BalanceOf(ctx context.Context, owner ethcommon.Address) (*big.Int, error) {
+	return t.balance, nil
+}
+
+type stubBatchSender struct {
+	from    string
+	batches [][]map[string]interface{}
+}
+
+func (s *stubBatchSender) SendTransactionBatch(ctx context.Context, chainID int64, address string, txPayload []map[string]interface{}) (string, error) {
+	s.from = address
+	s.batches = append(s.batches, txPayload)
+	return fmt.Sprintf("0xop%d", len(s.batches)), nil
+}
+
+func TestPermitDeposit(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:permit?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	mr, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer mr.Close()
+	redisClient := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer redisClient.Close()
+	locker := lock.New(&config.DistributedLockConfiguration{Enabled: true, TTL: time.Minute}, redisClient)
+
+	ctx := context.Background()
+	now := time.Now()
+	spender := "0x5555555555555555555555555555555555555555"
+	tokenAddress := "0x036CbD53842c5426634e7929541eC2318f3dCF7e"
+
+	token, err := test.CreateERC20Token(nil, map[string]interface{}{
+		"symbol":          "USDC",
+		"identifier":      "base-sepolia",
+		"chainID":         int64(84532),
+		"deployContract":  false,
+		"contractAddress": tokenAddress,
+	})
+	assert.NoError(t, err)
+	network := token.Edges.Network.Update().SetFee(decimal.NewFromFloat(0.5)).SaveX(ctx)
+
+	createOrder := func() *ent.PaymentOrder {
+		order := client.PaymentOrder.Create().
+			SetAmount(decimal.NewFromFloat(10)).
+			SetAmountInUsd(decimal.NewFromFloat(10)).
+			SetAmountPaid(decimal.Zero).
+			SetAmountReturned(decimal.Zero).
+			SetPercentSettled(decimal.Zero).
+			SetNetworkFee(network.Fee).
+			SetSenderFee(decimal.NewFromFloat(0.25)).
+			SetProtocolFee(decimal.Zero).
+			SetRate(decimal.NewFromFloat(1500)).
+			SetToken(token).
+			SetReceiveAddressText("0x6666666666666666666666666666666666666666").
+			SetFeePercent(decimal.Zero).
+			SetFeeAddress("0x1234567890123456789012345678901234567890").
+			SetStatus(paymentorder.StatusInitiated).
+			SaveX(ctx)
+		return client.PaymentOrder.Query().
+			Where(paymentorder.IDEQ(order.ID)).
+			WithToken(func(tq *ent.TokenQuery) {
+				tq.WithNetwork()
+			}).
+			OnlyX(ctx)
+	}
+
+	// The permit is hashed here with the EIP-712 implementation of go-ethereum, independently
+	// of the digest the deposit checks signatures against
+	typedData := func(owner ethcommon.Address, value *big.Int, deadline int64) apitypes.TypedData {
+		return apitypes.TypedData{
+			Types: apitypes.Types{
+				"EIP712Domain": {
+					{Name: "name", Type: "string"},
+					{Name: "version", Type: "string"},
+					{Name: "chainId", Type: "uint256"},
+					{Name: "verifyingContract", Type: "address"},
+				},
+				"Permit": {
+					{Name: "owner", Type: "address"},
+					{Name: "spender", Type: "address"},
+					{Name: "value", Type: "uint256"},
+					{Name: "nonce", Type: "uint256"},
+					{Name: "deadline", Type: "uint256"},
+				},
+			},
+			PrimaryType: "Permit",
+			Domain: apitypes.TypedDataDomain{
+				Name:              "USDC",
+				Version:           "2",
+				ChainId:           math.NewHexOrDecimal256(84532),
+				VerifyingContract: tokenAddress,
+			},
+			Message: apitypes.TypedDataMessage{
+				"owner":    owner.Hex(),
+				"spender":  spender,
+				"value":    value.String(),
+				"nonce":    "3",
+				"deadline": fmt.Sprintf("%d", deadline),
+			},
+		}
+	}
+	domain := typedData(ethcommon.Address{}, big.NewInt(0), 0)
+	domainSeparator, err := domain.HashStruct("EIP712Domain", domain.Domain.Map())
+	assert.NoError(t, err)
+
+	sign := func(key *ecdsa.PrivateKey, value *big.Int, deadline int64) string {
+		digest, _, err := apitypes.TypedDataAndHash(typedData(crypto.PubkeyToAddress(key.PublicKey), value, deadline))
+		assert.NoError(t, err)
+		signature, err := crypto.Sign(digest, key)
+		assert.NoError(t, err)
+		signature[64] += 27
+		return hexutil.Encode(signature)
+	}
+
+	payer, _ := crypto.GenerateKey()
+	owner := crypto.PubkeyToAddress(payer.PublicKey)
+	// 10 + 0.5 network fee + 0.25 sender fee
+	due := big.NewInt(10750000)
+
+	tokenReader := &stubPermitToken{balance: big.NewInt(20000000)}
+	copy(tokenReader.domainSeparator[:], domainSeparator)
+	sender := &stubBatchSender{}
+	deposit := &PermitDeposit{
+		tokens: func(ctx context.Context, network *ent.Network, tokenAddress string) (PermitTokenReader, error) {
+			return tokenReader, nil
+		},
+		sender:  sender,
+		spender: spender,
+		now:     func() time.Time { return now },
+		locker:  locker,
+	}
+	deadline := now.Add(time.Hour).Unix()
+
+	t.Run("should return the permit to sign for the amount due", func(t *testing.T) {
+		params, err := deposit.Params(ctx, createOrder(), owner.Hex())
+		assert.NoError(t, err)
+		assert.Equal(t, due.String(), params.Value)
+		assert.Equal(t, "3", params.Nonce)
+		assert.Equal(t, ethcommon.HexToAddress(spender).Hex(), params.Spender)
+		assert.Equal(t, int64(84532), params.ChainID)
+	})
+
+	t.Run("should pull the amount due into the receive address", func(t *testing.T) {
+		order := createOrder()
+		response, err := deposit.Submit(ctx, order, &types.PermitDepositPayload{
+			Owner:     owner.Hex(),
+			Deadline:  deadline,
+			Signature: sign(payer, due, deadline),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "0xop1", response.TransactionID)
+		assert.Equal(t, due.String(), response.Value)
+
+		assert.Equal(t, ethcommon.HexToAddress(spender).Hex(), sender.from)
+		assert.Len(t, sender.batches[0], 2)
+		for _, call := range sender.batches[0] {
+			assert.Equal(t, tokenAddress, call["to"])
+		}
+
+		erc20ABI, err := contracts.ERC20TokenMetaData.GetAbi()
+		assert.NoError(t, err)
+		transfer, err := erc20ABI.Methods["transferFrom"].Inputs.Unpack(hexutil.MustDecode(sender.batches[0][1]["data"].(string))[4:])
+		assert.NoError(t, err)
+		assert.Equal(t, owner, transfer[0])
+		assert.Equal(t, ethcommon.HexToAddress(order.ReceiveAddressText), transfer[1])
+		assert.Equal(t, due, transfer[2])
+
+		logs := order.QueryTransactions().Where(transactionlog.StatusEQ(transactionlog.StatusPermitDepositSent)).AllX(ctx)
+		assert.Len(t, logs, 1)
+		assert.Equal(t, "0xop1", logs[0].Metadata["TransactionID"])
+
+		// Another permit waits until the deadline of the pending one has passed
+		_, err = deposit.Submit(ctx, order, &types.PermitDepositPayload{
+			Owner:     owner.Hex(),
+			Deadline:  deadline,
+			Signature: sign(payer, due, deadline),
+		})
+		assert.ErrorIs(t, err, ErrPermitDepositPending)
+	})
+
+	t.Run("should reject permits while another submission of the order is in flight", func(t *testing.T) {
+		order := createOrder()
+		held, err := locker.Acquire(ctx, "permit:"+order.ID.String())
+		assert.NoError(t, err)
+		defer held.Unlock(ctx)
+
+		batches := len(sender.batches)
+		_, err = deposit.Submit(ctx, order, &types.PermitDepositPayload{
+			Owner:     owner.Hex(),
+			Deadline:  deadline,
+			Signature: sign(payer, due, deadline),
+		})
+		assert.ErrorIs(t, err, ErrPermitDepositPending)
+		assert.Len(t, sender.batches, batches)
+	})
+
+	t.Run("should reject permits not signed by the owner for the amount due", func(t *testing.T) {
+		other, _ := crypto.GenerateKey()
+		_, err := deposit.Submit(ctx, createOrder(), &types.PermitDepositPayload{
+			Owner:     owner.Hex(),
+			Deadline:  deadline,
+			Signature: sign(other, due, deadline),
+		})
+		assert.ErrorIs(t, err, ErrPermitInvalidSignature)
+
+		_, err = deposit.Submit(ctx, createOrder(), &types.PermitDepositPayload{
+			Owner:     owner.Hex(),
+			Deadline:  deadline,
+			Signature: sign(payer, big.NewInt(1), deadline),
+		})
+		assert.ErrorIs(t, err, ErrPermitInvalidSignature)
+	})
+
+	t.Run("should reject permits expiring before they can be mined", func(t *testing.T) {
+		soon := now.Add(time.Minute).Unix()
+		_, err := deposit.Submit(ctx, createOrder(), &types.PermitDepositPayload{
+			Owner:     owner.Hex(),
+			Deadline:  soon,
+			Signature: sign(payer, due, soon),
+		})
+		assert.ErrorIs(t, err, ErrPermitDeadlineTooSoon)
+	})
+
+	t.Run("should reject owners short of the amount due", func(t *testing.T) {
+		tokenReader.balance = big.NewInt(1000000)
+		defer func() { tokenReader.balance = big.NewInt(20000000) }()
+
+		_, err := deposit.Submit(ctx, createOrder(), &types.PermitDepositPayload{
+			Owner:     owner.Hex(),
+			Deadline:  deadline,
+			Signature: sign(payer, due, deadline),
+		})
+		assert.ErrorIs(t, err, ErrPermitInsufficientBalance)
+	})
+
+	t.Run("should reject tokens without permits", func(t *testing.T) {
+		tokenReader.unsupported = true
+		defer func() { tokenReader.unsupported = false }()
+
+		_, err := deposit.Params(ctx, createOrder(), owner.Hex())
+		assert.ErrorIs(t, err, ErrPermitTokenUnsupported)
+	})
+
+	t.Run("should reject orders no longer awaiting a deposit", func(t *testing.T) {
+		order := createOrder()
+		order.Status = paymentorder.StatusPending
+		_, err := deposit.Params(ctx, order, owner.Hex())
+		assert.ErrorIs(t, err, ErrPermitOrderNotPayable)
+	})
+}
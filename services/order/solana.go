@@ -0,0 +1,85 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/services"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/google/uuid"
+)
+
+// solanaReviewReason is the review reason of Solana orders awaiting manual settlement
+const solanaReviewReason = "Solana deposit received; no Solana gateway program, settle manually"
+
+// OrderSolana handles payment orders paid on Solana. There is no gateway program on Solana, so
+// instead of creating the order on-chain a funded order is flagged for manual settlement
+type OrderSolana struct{}
+
+// NewOrderSolana creates a new instance of OrderSolana.
+func NewOrderSolana() types.OrderService {
+	return &OrderSolana{}
+}
+
+// CreateOrder flags a funded Solana payment order for manual settlement and alerts ops.
+func (s *OrderSolana) CreateOrder(ctx context.Context, orderID uuid.UUID) error {
+	orderIDPrefix := strings.Split(orderID.String(), "-")[0]
+
+	order, err := db.Client.PaymentOrder.
+		Query().
+		Where(paymentorder.IDEQ(orderID)).
+		WithToken(func(tq *ent.TokenQuery) {
+			tq.WithNetwork()
+		}).
+		WithReceiveAddress().
+		Only(ctx)
+	if err != nil {
+		return fmt.Errorf("%s - Solana.CreateOrder.fetchOrder: %w", orderIDPrefix, err)
+	}
+
+	// Already flagged by an earlier deposit event
+	if order.ReviewReason != "" {
+		return nil
+	}
+
+	_, err = order.Update().
+		SetReviewReason(solanaReviewReason).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("%s - Solana.CreateOrder.flagOrder: %w", orderIDPrefix, err)
+	}
+
+	receiveAddress := ""
+	if order.Edges.ReceiveAddress != nil {
+		receiveAddress = order.Edges.ReceiveAddress.Address
+	}
+
+	err = services.NewSlackService(serverConf.SlackWebhookURL).SendAlert("Solana order needs manual settlement", map[string]string{
+		"Order ID":        order.ID.String(),
+		"Network":         order.Edges.Token.Edges.Network.Identifier,
+		"Token":           order.Edges.Token.Symbol,
+		"Amount":          order.AmountPaid.String(),
+		"Receive Address": receiveAddress,
+		"Tx Hash":         order.TxHash,
+	})
+	if err != nil {
+		logger.Errorf("%s - Solana.CreateOrder.SendAlert: %v", orderIDPrefix, err)
+	}
+
+	return nil
+}
+
+// RefundOrder is not supported on Solana, refunds are sent manually.
+func (s *OrderSolana) RefundOrder(ctx context.Context, network *ent.Network, orderID string) error {
+	return fmt.Errorf("refunds are not supported on %s", network.Identifier)
+}
+
+// SettleOrder is not supported on Solana, settlements are sent manually.
+func (s *OrderSolana) SettleOrder(ctx context.Context, orderID uuid.UUID) error {
+	return fmt.Errorf("settlements are not supported on Solana")
+}
@@ -0,0 +1,320 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/outboxtransaction"
+	"github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+)
+
+// transactionSender sends transaction batches and reads their status; ServiceManager implements it
+type transactionSender interface {
+	SendTransactionBatch(ctx context.Context, chainID int64, address string, txPayload []map[string]interface{}) (string, error)
+	GetTransactionStatus(ctx context.Context, transactionID string, chainID int64) (map[string]interface{}, error)
+}
+
+// EnqueueTransaction persists a settlement or refund of a lock order for the outbox worker to send.
+// A transaction of the same kind still pending or sent for the order is returned instead of
+// queueing another one
+func EnqueueTransaction(ctx context.Context, kind outboxtransaction.Kind, lockOrderID uuid.UUID, network *ent.Network, fromAddress string, txPayload []map[string]interface{}) (*ent.OutboxTransaction, error) {
+	existing, err := storage.Client.OutboxTransaction.
+		Query().
+		Where(
+			outboxtransaction.LockOrderIDEQ(lockOrderID),
+			outboxtransaction.KindEQ(kind),
+			outboxtransaction.StatusIn(outboxtransaction.StatusPending, outboxtransaction.StatusSent),
+		).
+		First(ctx)
+	if err == nil {
+		return existing, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to fetch queued transaction: %w", err)
+	}
+
+	transaction, err := storage.Client.OutboxTransaction.
+		Create().
+		SetKind(kind).
+		SetLockOrderID(lockOrderID).
+		SetNetwork(network.Identifier).
+		SetChainID(network.ChainID).
+		SetFromAddress(fromAddress).
+		SetPayload(txPayload).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to queue transaction: %w", err)
+	}
+
+	return transaction, nil
+}
+
+// OutboxWorker sends the transactions queued in the outbox and tracks them until confirmed
+type OutboxWorker struct {
+	config *config.OutboxConfiguration
+	sender transactionSender
+	// logs reads the EntryPoint events that confirm sent UserOperations; without it only the
+	// active service's status is checked
+	logs logReader
+	now  func() time.Time
+}
+
+// NewOutboxWorker creates a new outbox worker sending through the active service
+func NewOutboxWorker() *OutboxWorker {
+	return &OutboxWorker{
+		config: config.OutboxConfig(),
+		sender: NewServiceManager(),
+		logs:   newFailoverProvider(),
+		now:    time.Now,
+	}
+}
+
+// Resume makes the transactions left pending by a previous run due right away, including those it
+// claimed but may not have sent before stopping. It is called once on startup, before the first
+// drain, and assumes a single aggregator instance sends from the outbox
+func (w *OutboxWorker) Resume(ctx context.Context) (int, error) {
+	resumed, err := storage.Client.OutboxTransaction.
+		Update().
+		Where(
+			outboxtransaction.StatusEQ(outboxtransaction.StatusPending),
+			outboxtransaction.NextAttemptAtGT(w.now()),
+		).
+		SetNextAttemptAt(w.now()).
+		Save(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("OutboxWorker.Resume: %w", err)
+	}
+
+	return resumed, nil
+}
+
+// Drain sends the pending transactions that are due, oldest first, with at most NetworkConcurrency
+// sends in flight on each network
+func (w *OutboxWorker) Drain(ctx context.Context) error {
+	due, err := storage.Client.OutboxTransaction.
+		Query().
+		Where(
+			outboxtransaction.StatusEQ(outboxtransaction.StatusPending),
+			outboxtransaction.NextAttemptAtLTE(w.now()),
+		).
+		Order(ent.Asc(outboxtransaction.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("OutboxWorker.Drain: %w", err)
+	}
+
+	byNetwork := make(map[int64][]*ent.OutboxTransaction)
+	for _, transaction := range due {
+		byNetwork[transaction.ChainID] = append(byNetwork[transaction.ChainID], transaction)
+	}
+
+	concurrency := w.config.NetworkConcurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var wg sync.WaitGroup
+	for _, transactions := range byNetwork {
+		slots := make(chan struct{}, concurrency)
+		for _, transaction := range transactions {
+			wg.Add(1)
+			slots <- struct{}{}
+			go func(transaction *ent.OutboxTransaction) {
+				defer wg.Done()
+				defer func() { <-slots }()
+				w.send(ctx, transaction)
+			}(transaction)
+		}
+	}
+	wg.Wait()
+
+	return nil
+}
+
+// send claims a pending transaction and sends it, scheduling a retry when sending fails
+func (w *OutboxWorker) send(ctx context.Context, transaction *ent.OutboxTransaction) {
+	// Claim the transaction so a concurrent drain does not send it too
+	claimed, err := storage.Client.OutboxTransaction.
+		Update().
+		Where(
+			outboxtransaction.IDEQ(transaction.ID),
+			outboxtransaction.StatusEQ(outboxtransaction.StatusPending),
+			outboxtransaction.NextAttemptAtLTE(w.now()),
+		).
+		SetNextAttemptAt(w.now().Add(w.config.ClaimTimeout)).
+		Save(ctx)
+	if err != nil || claimed == 0 {
+		return
+	}
+
+	transactionID, err := w.sender.SendTransactionBatch(outboxGasSpend(ctx, transaction), transaction.ChainID, transaction.FromAddress, transaction.Payload)
+	if err != nil {
+		w.retry(ctx, transaction, transaction.Attempts+1, err)
+		return
+	}
+
+	_, err = transaction.Update().
+		SetStatus(outboxtransaction.StatusSent).
+		SetTransactionID(transactionID).
+		SetSentAt(w.now()).
+		SetAttempts(transaction.Attempts + 1).
+		ClearLastError().
+		Save(ctx)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":         fmt.Sprintf("%v", err),
+			"ID":            transaction.ID,
+			"TransactionID": transactionID,
+		}).Errorf("Failed to mark outbox transaction as sent")
+		return
+	}
+
+	logger.WithFields(logger.Fields{
+		"ID":            transaction.ID,
+		"Kind":          transaction.Kind,
+		"LockOrderID":   transaction.LockOrderID,
+		"Network":       transaction.Network,
+		"TransactionID": transactionID,
+	}).Infof("Sent outbox transaction")
+}
+
+// retry schedules another attempt at a transaction that failed to send or failed on-chain, with
+// the delay doubling on each attempt, and fails it after MaxAttempts. attempts counts the sends
+// made so far, including the one that failed
+func (w *OutboxWorker) retry(ctx context.Context, transaction *ent.OutboxTransaction, attempts int, cause error) {
+	lastError := cause.Error()
+	if len(lastError) > 500 {
+		lastError = lastError[:500]
+	}
+
+	update := transaction.Update().
+		SetAttempts(attempts).
+		SetLastError(lastError).
+		ClearTransactionID().
+		ClearSentAt()
+
+	fields := logger.Fields{
+		"Error":       lastError,
+		"ID":          transaction.ID,
+		"Kind":        transaction.Kind,
+		"LockOrderID": transaction.LockOrderID,
+		"Network":     transaction.Network,
+		"Attempts":    attempts,
+	}
+
+	if attempts >= w.config.MaxAttempts {
+		update.SetStatus(outboxtransaction.StatusFailed)
+		logger.WithFields(fields).Errorf("Outbox transaction failed after its last attempt")
+	} else {
+		update.
+			SetStatus(outboxtransaction.StatusPending).
+			SetNextAttemptAt(w.now().Add(w.config.RetryDelay * time.Duration(1<<(attempts-1))))
+		logger.WithFields(fields).Warnf("Outbox transaction failed, retrying")
+	}
+
+	if _, err := update.Save(ctx); err != nil {
+		logger.WithFields(logger.Fields{
+			"Error": fmt.Sprintf("%v", err),
+			"ID":    transaction.ID,
+		}).Errorf("Failed to schedule outbox transaction retry")
+	}
+}
+
+// Confirm checks the sent transactions, marking those mined as confirmed. UserOperations are
+// confirmed from the EntryPoint's UserOperationEvent logs, which also give the gas they used, and
+// otherwise from the active service's status. Transactions that reverted on-chain or that the
+// active service reports as failed are retried; those unconfirmed after ConfirmTimeout are failed
+func (w *OutboxWorker) Confirm(ctx context.Context) error {
+	sent, err := storage.Client.OutboxTransaction.
+		Query().
+		Where(outboxtransaction.StatusEQ(outboxtransaction.StatusSent)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("OutboxWorker.Confirm: %w", err)
+	}
+
+	executed := w.executedUserOperations(ctx, sent)
+
+	for _, transaction := range sent {
+		if event, ok := executed[transaction.ID]; ok {
+			w.recordUserOperationEvent(ctx, transaction, event)
+			continue
+		}
+
+		txHash, err := w.minedTxHash(ctx, transaction)
+		if err != nil {
+			w.retry(ctx, transaction, transaction.Attempts, err)
+			continue
+		}
+
+		if txHash != "" {
+			_, err = transaction.Update().
+				SetStatus(outboxtransaction.StatusConfirmed).
+				SetTxHash(txHash).
+				Save(ctx)
+			if err != nil {
+				logger.WithFields(logger.Fields{
+					"Error":  fmt.Sprintf("%v", err),
+					"ID":     transaction.ID,
+					"TxHash": txHash,
+				}).Errorf("Failed to mark outbox transaction as confirmed")
+			}
+			continue
+		}
+
+		if w.now().Sub(transaction.SentAt) >= w.config.ConfirmTimeout {
+			_, err = transaction.Update().
+				SetStatus(outboxtransaction.StatusFailed).
+				SetLastError(fmt.Sprintf("not confirmed within %s", w.config.ConfirmTimeout)).
+				Save(ctx)
+			if err != nil {
+				logger.WithFields(logger.Fields{
+					"Error": fmt.Sprintf("%v", err),
+					"ID":    transaction.ID,
+				}).Errorf("Failed to fail unconfirmed outbox transaction")
+				continue
+			}
+			logger.WithFields(logger.Fields{
+				"ID":            transaction.ID,
+				"Kind":          transaction.Kind,
+				"LockOrderID":   transaction.LockOrderID,
+				"TransactionID": transaction.TransactionID,
+			}).Errorf("Outbox transaction not confirmed in time")
+		}
+	}
+
+	return nil
+}
+
+// minedTxHash returns the hash of the transaction that included a sent transaction, an empty hash
+// while it is pending, or an error when the active service reports it failed
+func (w *OutboxWorker) minedTxHash(ctx context.Context, transaction *ent.OutboxTransaction) (string, error) {
+	status, err := w.sender.GetTransactionStatus(ctx, transaction.TransactionID, transaction.ChainID)
+	if err != nil {
+		// A UserOperation left unmined may have been replaced by one paying higher fees
+		txHash, logErr := minedUserOperationTxHash(ctx, transaction.TransactionID)
+		if logErr != nil {
+			return "", nil
+		}
+		return txHash, nil
+	}
+
+	result, _ := status["executionResult"].(map[string]interface{})
+	switch result["status"] {
+	case "FAILED":
+		return "", fmt.Errorf("transaction failed: %v", result["error"])
+	case "CONFIRMED":
+	default:
+		return "", nil
+	}
+
+	txHash, _ := status["transactionHash"].(string)
+	return txHash, nil
+}
@@ -0,0 +1,365 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	"github.com/NEDA-LABS/stablenode/ent/outboxtransaction"
+	"github.com/NEDA-LABS/stablenode/services/contracts"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/test"
+)
+
+// stubTransactionSender fails sends to the addresses in failing, reports the statuses in statuses
+// and records the most sends it saw in flight on each chain
+type stubTransactionSender struct {
+	mu       sync.Mutex
+	failing  map[string]bool
+	statuses map[string]map[string]interface{}
+	sent     []string
+	inFlight map[int64]int
+	maxSeen  map[int64]int
+}
+
+func (s *stubTransactionSender) SendTransactionBatch(ctx context.Context, chainID int64, address string, txPayload []map[string]interface{}) (string, error) {
+	s.mu.Lock()
+	s.inFlight[chainID]++
+	if s.inFlight[chainID] > s.maxSeen[chainID] {
+		s.maxSeen[chainID] = s.inFlight[chainID]
+	}
+	s.mu.Unlock()
+
+	time.Sleep(10 * time.Millisecond)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.inFlight[chainID]--
+	if s.failing[address] {
+		return "", fmt.Errorf("bundler unavailable")
+	}
+	s.sent = append(s.sent, address)
+	return fmt.Sprintf("0xop%d", len(s.sent)), nil
+}
+
+func (s *stubTransactionSender) GetTransactionStatus(ctx context.Context, transactionID string, chainID int64) (map[string]interface{}, error) {
+	status, ok := s.statuses[transactionID]
+	if !ok {
+		return nil, fmt.Errorf("user operation not found or not mined yet")
+	}
+	return status, nil
+}
+
+func TestOutboxWorker(t *testing.T) {
+	// Drains send concurrently, so every connection must see the same in-memory database
+	client := enttest.Open(t, "sqlite3", "file:outbox?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	ctx := context.Background()
+	// Queued transactions are due from the time they are created
+	now := time.Now().Add(time.Second)
+	sender := &stubTransactionSender{
+		failing:  map[string]bool{},
+		statuses: map[string]map[string]interface{}{},
+		inFlight: map[int64]int{},
+		maxSeen:  map[int64]int{},
+	}
+	worker := &OutboxWorker{
+		config: &config.OutboxConfiguration{
+			NetworkConcurrency: 2,
+			MaxAttempts:        2,
+			RetryDelay:         time.Minute,
+			ClaimTimeout:       5 * time.Minute,
+			ConfirmTimeout:     30 * time.Minute,
+		},
+		sender: sender,
+		now:    func() time.Time { return now },
+	}
+
+	base := &ent.Network{Identifier: "base", ChainID: 8453}
+	polygon := &ent.Network{Identifier: "polygon", ChainID: 137}
+	payload := []map[string]interface{}{{"to": "0xgateway", "data": "0x", "value": "0"}}
+
+	t.Run("should queue one transaction per order and kind", func(t *testing.T) {
+		orderID := uuid.New()
+		first, err := EnqueueTransaction(ctx, outboxtransaction.KindSettleOrder, orderID, base, "0xaccount", payload)
+		assert.NoError(t, err)
+		again, err := EnqueueTransaction(ctx, outboxtransaction.KindSettleOrder, orderID, base, "0xaccount", payload)
+		assert.NoError(t, err)
+		assert.Equal(t, first.ID, again.ID)
+
+		refund, err := EnqueueTransaction(ctx, outboxtransaction.KindRefundOrder, orderID, base, "0xaccount", payload)
+		assert.NoError(t, err)
+		assert.NotEqual(t, first.ID, refund.ID)
+		assert.Equal(t, outboxtransaction.StatusPending, refund.Status)
+		assert.Equal(t, int64(8453), refund.ChainID)
+	})
+
+	t.Run("should send due transactions within the network concurrency", func(t *testing.T) {
+		for i := 0; i < 4; i++ {
+			_, err := EnqueueTransaction(ctx, outboxtransaction.KindSettleOrder, uuid.New(), polygon, "0xaccount", payload)
+			assert.NoError(t, err)
+		}
+
+		assert.NoError(t, worker.Drain(ctx))
+		assert.Len(t, sender.sent, 6)
+		assert.Equal(t, 2, sender.maxSeen[137])
+
+		sent := client.OutboxTransaction.Query().Where(outboxtransaction.StatusEQ(outboxtransaction.StatusSent)).AllX(ctx)
+		assert.Len(t, sent, 6)
+		for _, transaction := range sent {
+			assert.NotEmpty(t, transaction.TransactionID)
+			assert.Equal(t, 1, transaction.Attempts)
+		}
+	})
+
+	t.Run("should retry failed sends with backoff until the last attempt", func(t *testing.T) {
+		sender.failing["0xbroken"] = true
+		transaction, err := EnqueueTransaction(ctx, outboxtransaction.KindRefundOrder, uuid.New(), base, "0xbroken", payload)
+		assert.NoError(t, err)
+
+		assert.NoError(t, worker.Drain(ctx))
+		transaction = client.OutboxTransaction.GetX(ctx, transaction.ID)
+		assert.Equal(t, outboxtransaction.StatusPending, transaction.Status)
+		assert.Equal(t, 1, transaction.Attempts)
+		assert.Equal(t, "bundler unavailable", transaction.LastError)
+		assert.WithinDuration(t, now.Add(time.Minute), transaction.NextAttemptAt, time.Second)
+
+		// Not due yet
+		assert.NoError(t, worker.Drain(ctx))
+		assert.Equal(t, 1, client.OutboxTransaction.GetX(ctx, transaction.ID).Attempts)
+
+		now = now.Add(2 * time.Minute)
+		assert.NoError(t, worker.Drain(ctx))
+		transaction = client.OutboxTransaction.GetX(ctx, transaction.ID)
+		assert.Equal(t, outboxtransaction.StatusFailed, transaction.Status)
+		assert.Equal(t, 2, transaction.Attempts)
+	})
+
+	t.Run("should resume transactions claimed by a stopped run", func(t *testing.T) {
+		transaction, err := EnqueueTransaction(ctx, outboxtransaction.KindSettleOrder, uuid.New(), base, "0xaccount", payload)
+		assert.NoError(t, err)
+		transaction = transaction.Update().SetNextAttemptAt(now.Add(5 * time.Minute)).SaveX(ctx)
+
+		assert.NoError(t, worker.Drain(ctx))
+		assert.Equal(t, outboxtransaction.StatusPending, client.OutboxTransaction.GetX(ctx, transaction.ID).Status)
+
+		resumed, err := worker.Resume(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, resumed)
+
+		assert.NoError(t, worker.Drain(ctx))
+		assert.Equal(t, outboxtransaction.StatusSent, client.OutboxTransaction.GetX(ctx, transaction.ID).Status)
+	})
+
+	t.Run("should confirm mined transactions and retry failed ones", func(t *testing.T) {
+		sent := client.OutboxTransaction.Query().
+			Where(outboxtransaction.StatusEQ(outboxtransaction.StatusSent)).
+			Order(ent.Asc(outboxtransaction.FieldTransactionID)).
+			AllX(ctx)
+		assert.Len(t, sent, 7)
+
+		sender.statuses[sent[0].TransactionID] = map[string]interface{}{
+			"transactionHash": "0xmined",
+			"executionResult": map[string]interface{}{"status": "CONFIRMED"},
+		}
+		sender.statuses[sent[1].TransactionID] = map[string]interface{}{
+			"transactionHash": nil,
+			"executionResult": map[string]interface{}{"status": "FAILED", "error": "execution reverted"},
+		}
+		sender.statuses[sent[2].TransactionID] = map[string]interface{}{
+			"transactionHash": "0xqueued",
+			"executionResult": map[string]interface{}{"status": "SUBMITTED"},
+		}
+
+		assert.NoError(t, worker.Confirm(ctx))
+
+		confirmed := client.OutboxTransaction.GetX(ctx, sent[0].ID)
+		assert.Equal(t, outboxtransaction.StatusConfirmed, confirmed.Status)
+		assert.Equal(t, "0xmined", confirmed.TxHash)
+
+		reverted := client.OutboxTransaction.GetX(ctx, sent[1].ID)
+		assert.Equal(t, outboxtransaction.StatusPending, reverted.Status)
+		assert.Equal(t, 1, reverted.Attempts)
+		assert.Empty(t, reverted.TransactionID)
+		assert.Contains(t, reverted.LastError, "execution reverted")
+
+		assert.Equal(t, outboxtransaction.StatusSent, client.OutboxTransaction.GetX(ctx, sent[2].ID).Status)
+	})
+
+	t.Run("should fail transactions left unconfirmed", func(t *testing.T) {
+		now = now.Add(31 * time.Minute)
+		assert.NoError(t, worker.Confirm(ctx))
+
+		assert.Equal(t, 0, client.OutboxTransaction.Query().Where(outboxtransaction.StatusEQ(outboxtransaction.StatusSent)).CountX(ctx))
+		failed := client.OutboxTransaction.Query().Where(outboxtransaction.StatusEQ(outboxtransaction.StatusFailed)).AllX(ctx)
+		assert.Len(t, failed, 6)
+	})
+}
+
+// stubLogReader serves the EntryPoint logs whose UserOperation hash the query asks for
+type stubLogReader struct {
+	latest  int64
+	logs    []ethtypes.Log
+	queries []ethereum.FilterQuery
+}
+
+func (r *stubLogReader) GetLatestBlock(ctx context.Context, network *ent.Network) (int64, error) {
+	return r.latest, nil
+}
+
+func (r *stubLogReader) GetLogs(ctx context.Context, network *ent.Network, query ethereum.FilterQuery) ([]ethtypes.Log, error) {
+	r.queries = append(r.queries, query)
+	var logs []ethtypes.Log
+	for _, log := range r.logs {
+		for _, hash := range query.Topics[1] {
+			if log.Topics[1] == hash {
+				logs = append(logs, log)
+			}
+		}
+	}
+	return logs, nil
+}
+
+// userOperationEventLog builds the UserOperationEvent log the EntryPoint emits for an operation
+func userOperationEventLog(t *testing.T, userOpHash string, success bool, gasUsed, gasCost int64) ethtypes.Log {
+	entryPoint, err := contracts.EntryPointMetaData.GetAbi()
+	require.NoError(t, err)
+	event := entryPoint.Events["UserOperationEvent"]
+
+	data, err := event.Inputs.NonIndexed().Pack(big.NewInt(1), success, big.NewInt(gasCost), big.NewInt(gasUsed))
+	require.NoError(t, err)
+	sender, err := abi.MakeTopics([]interface{}{common.HexToAddress("0xaccount")}, []interface{}{common.Address{}})
+	require.NoError(t, err)
+
+	return ethtypes.Log{
+		Address: entryPointV07,
+		Topics:  []common.Hash{event.ID, common.HexToHash(userOpHash), sender[0][0], sender[1][0]},
+		Data:    data,
+		TxHash:  common.HexToHash(fmt.Sprintf("0x%x", gasUsed)),
+	}
+}
+
+func TestOutboxUserOperationEvents(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:outboxevents?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	ctx := context.Background()
+	now := time.Now()
+	network, err := test.CreateTestNetwork(map[string]interface{}{
+		"identifier": "base",
+		"chainID":    int64(8453),
+		"networkRPC": "https://rpc.example",
+		"is_testnet": false,
+	})
+	require.NoError(t, err)
+
+	hash := func(i int) string { return common.BigToHash(big.NewInt(int64(i))).Hex() }
+	sent := func(transactionID string) *ent.OutboxTransaction {
+		transaction, err := EnqueueTransaction(ctx, outboxtransaction.KindSettleOrder, uuid.New(), network, "0xaccount", nil)
+		require.NoError(t, err)
+		return transaction.Update().
+			SetStatus(outboxtransaction.StatusSent).
+			SetTransactionID(transactionID).
+			SetSentAt(now).
+			SetAttempts(1).
+			SaveX(ctx)
+	}
+
+	succeeded := sent(hash(1))
+	reverted := sent(hash(2))
+	replaced := sent(hash(3))
+	pending := sent(hash(5))
+	queued := sent("9b3c5a6e-engine-queue-id")
+
+	// The UserOperation of the third transaction was replaced, and the replacement was mined
+	original, err := recordUserOperation(ctx, 8453, map[string]interface{}{}, hash(3), nil)
+	require.NoError(t, err)
+	_, err = recordUserOperation(ctx, 8453, map[string]interface{}{}, hash(4), original)
+	require.NoError(t, err)
+
+	reader := &stubLogReader{
+		latest: 1000,
+		logs: []ethtypes.Log{
+			userOperationEventLog(t, hash(1), true, 90000, 45000000000000),
+			userOperationEventLog(t, hash(2), false, 60000, 30000000000000),
+			userOperationEventLog(t, hash(4), true, 80000, 40000000000000),
+		},
+	}
+	worker := &OutboxWorker{
+		config: &config.OutboxConfiguration{
+			MaxAttempts:         3,
+			RetryDelay:          time.Minute,
+			ConfirmTimeout:      30 * time.Minute,
+			EventLookbackBlocks: 200,
+		},
+		sender: &stubTransactionSender{statuses: map[string]map[string]interface{}{}},
+		logs:   reader,
+		now:    func() time.Time { return now },
+	}
+
+	require.NoError(t, worker.Confirm(ctx))
+
+	t.Run("should search the recent EntryPoint logs of the sent UserOperations", func(t *testing.T) {
+		require.Len(t, reader.queries, 1)
+		query := reader.queries[0]
+		assert.Equal(t, int64(800), query.FromBlock.Int64())
+		assert.Equal(t, []common.Address{entryPointV07}, query.Addresses)
+		assert.Len(t, query.Topics[1], 5)
+	})
+
+	t.Run("should confirm succeeded UserOperations with their gas", func(t *testing.T) {
+		confirmed := client.OutboxTransaction.GetX(ctx, succeeded.ID)
+		assert.Equal(t, outboxtransaction.StatusConfirmed, confirmed.Status)
+		assert.Equal(t, common.HexToHash("0x15f90").Hex(), confirmed.TxHash)
+		assert.Equal(t, int64(90000), confirmed.GasUsed)
+		assert.True(t, decimal.RequireFromString("0.000045").Equal(confirmed.GasCost))
+
+		confirmed = client.OutboxTransaction.GetX(ctx, replaced.ID)
+		assert.Equal(t, outboxtransaction.StatusConfirmed, confirmed.Status)
+		assert.Equal(t, int64(80000), confirmed.GasUsed)
+	})
+
+	t.Run("should record the gas on the log of the latest attempt", func(t *testing.T) {
+		latest, err := userOperationLog(ctx, hash(4))
+		require.NoError(t, err)
+		assert.Equal(t, common.HexToHash("0x13880").Hex(), latest.TxHash)
+		assert.Equal(t, hash(4), latest.Metadata["MinedUserOpHash"])
+		assert.Equal(t, int64(80000), *latest.GasUsed)
+		assert.True(t, decimal.RequireFromString("0.00004").Equal(*latest.GasCost))
+		assert.False(t, latest.Sponsored)
+		assert.Nil(t, client.TransactionLog.GetX(ctx, original.ID).GasUsed)
+	})
+
+	t.Run("should retry reverted UserOperations and keep their gas", func(t *testing.T) {
+		retried := client.OutboxTransaction.GetX(ctx, reverted.ID)
+		assert.Equal(t, outboxtransaction.StatusPending, retried.Status)
+		assert.Empty(t, retried.TransactionID)
+		assert.Contains(t, retried.LastError, "reverted")
+		assert.Equal(t, int64(60000), retried.GasUsed)
+		assert.True(t, decimal.RequireFromString("0.00003").Equal(retried.GasCost))
+	})
+
+	t.Run("should leave unexecuted UserOperations and Engine transactions sent", func(t *testing.T) {
+		assert.Equal(t, outboxtransaction.StatusSent, client.OutboxTransaction.GetX(ctx, pending.ID).Status)
+		assert.Equal(t, outboxtransaction.StatusSent, client.OutboxTransaction.GetX(ctx, queued.ID).Status)
+		assert.Zero(t, client.OutboxTransaction.GetX(ctx, pending.ID).GasUsed)
+	})
+}
@@ -3,21 +3,26 @@ package services
 import (
 	"context"
 	"fmt"
-	"math/big"
+	"strings"
 	"sync"
 	"time"
 
-	"github.com/ethereum/go-ethereum"
-	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 	"github.com/spf13/viper"
 
+	"github.com/NEDA-LABS/stablenode/config"
 	"github.com/NEDA-LABS/stablenode/ent"
+	networkent "github.com/NEDA-LABS/stablenode/ent/network"
 	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
 	"github.com/NEDA-LABS/stablenode/storage"
-	"github.com/NEDA-LABS/stablenode/utils"
+	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/NEDA-LABS/stablenode/utils/breaker"
+	"github.com/NEDA-LABS/stablenode/utils/lock"
 	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/NEDA-LABS/stablenode/utils/metrics"
+	"github.com/NEDA-LABS/stablenode/utils/ratelimit"
 )
 
 // PollingService handles periodic balance checking for receive addresses
@@ -25,10 +30,34 @@ import (
 type PollingService struct {
 	interval       time.Duration
 	minOrderAge    time.Duration // Only poll orders older than this
+	tiers          []PollingTier
+	lastPolled     map[uuid.UUID]time.Time
 	stopChan       chan bool
 	metrics        *PollingMetrics
 	metricsMutex   sync.RWMutex
-	balanceCache   *BalanceCache
+	balanceService *BalanceService
+	tronService    *TronService
+	solanaService  *SolanaService
+	handleTransfer TransferHandler
+}
+
+// depositSource reads the balances and incoming transfers of receive addresses on a network
+type depositSource interface {
+	GetTokenBalances(ctx context.Context, network *ent.Network, addresses []string, tokens []*ent.Token) (map[string]map[int]decimal.Decimal, error)
+	GetTokenTransfers(ctx context.Context, network *ent.Network, token *ent.Token, address string, since time.Time) ([]*types.TokenTransferEvent, error)
+	RPCCalls() int64
+}
+
+// TransferHandler processes a token transfer to a receive address, e.g. through the same
+// pipeline as webhook deposit events
+type TransferHandler func(ctx context.Context, token *ent.Token, event *types.TokenTransferEvent) error
+
+// PollingTier sets how often orders are polled while their age is below MaxAge.
+// The last tier has no MaxAge and applies to all older orders.
+type PollingTier struct {
+	Name     string
+	MaxAge   time.Duration
+	Interval time.Duration
 }
 
 // PollingMetrics tracks polling service performance
@@ -37,25 +66,15 @@ type PollingMetrics struct {
 	PaymentsDetected  int64
 	RPCCallsMade      int64
 	ErrorsEncountered int64
+	CyclesShed        int64
 	LastRunTime       time.Time
 	AverageCheckTime  time.Duration
+	TierOrders        map[string]int
+	TierChecks        map[string]int64
 }
 
-// BalanceCache caches balance results to reduce RPC calls
-type BalanceCache struct {
-	balances map[string]CachedBalance
-	mutex    sync.RWMutex
-	ttl      time.Duration
-}
-
-// CachedBalance represents a cached balance with timestamp
-type CachedBalance struct {
-	Amount    decimal.Decimal
-	Timestamp time.Time
-}
-
-// NewPollingService creates a new polling service
-func NewPollingService(interval time.Duration) *PollingService {
+// NewPollingService creates a new polling service. Deposits found by polling are passed to handleTransfer
+func NewPollingService(interval time.Duration, handleTransfer TransferHandler) *PollingService {
 	minOrderAge := viper.GetDuration("POLLING_MIN_AGE")
 	if minOrderAge == 0 {
 		minOrderAge = 5 * time.Minute // Default: only poll orders > 5 minutes old
@@ -66,17 +85,70 @@ func NewPollingService(interval time.Duration) *PollingService {
 		cacheTTL = 30 * time.Second // Default: cache for 30 seconds
 	}
 
+	balanceService := NewBalanceService(cacheTTL)
+	balanceService.SetBatching(viper.GetInt("POLLING_MULTICALL_BATCH_SIZE"), viper.GetDuration("POLLING_MULTICALL_INTERVAL"))
+
+	// Young orders are most likely to receive a payment, so they are polled more often
+	tiers := []PollingTier{
+		{
+			Name:     "fresh",
+			MaxAge:   durationOrDefault("POLLING_TIER_FRESH_MAX_AGE", 10*time.Minute),
+			Interval: durationOrDefault("POLLING_TIER_FRESH_INTERVAL", 30*time.Second),
+		},
+		{
+			Name:     "recent",
+			MaxAge:   durationOrDefault("POLLING_TIER_RECENT_MAX_AGE", 60*time.Minute),
+			Interval: durationOrDefault("POLLING_TIER_RECENT_INTERVAL", 2*time.Minute),
+		},
+		{
+			Name:     "stale",
+			Interval: durationOrDefault("POLLING_TIER_STALE_INTERVAL", 10*time.Minute),
+		},
+	}
+
+	// The loop must tick at least as often as the shortest tier interval
+	for _, tier := range tiers {
+		if tier.Interval < interval {
+			interval = tier.Interval
+		}
+	}
+
 	return &PollingService{
 		interval:    interval,
 		minOrderAge: minOrderAge,
+		tiers:       tiers,
+		lastPolled:  make(map[uuid.UUID]time.Time),
 		stopChan:    make(chan bool),
 		metrics: &PollingMetrics{
 			LastRunTime: time.Now(),
+			TierOrders:  make(map[string]int),
+			TierChecks:  make(map[string]int64),
 		},
-		balanceCache: &BalanceCache{
-			balances: make(map[string]CachedBalance),
-			ttl:      cacheTTL,
-		},
+		balanceService: balanceService,
+		tronService:    NewTronService(),
+		solanaService:  NewSolanaService(),
+		handleTransfer: handleTransfer,
+	}
+}
+
+// durationOrDefault reads a duration from config, falling back to def when unset
+func durationOrDefault(key string, def time.Duration) time.Duration {
+	if value := viper.GetDuration(key); value > 0 {
+		return value
+	}
+	return def
+}
+
+// sourceFor returns the deposit source of a network: TronGrid for Tron, Solana RPC for Solana and
+// EVM RPC otherwise
+func (s *PollingService) sourceFor(network *ent.Network) depositSource {
+	switch {
+	case network.NetworkType == networkent.NetworkTypeSolana:
+		return s.solanaService
+	case network.NetworkType == networkent.NetworkTypeTron || strings.HasPrefix(network.Identifier, "tron"):
+		return s.tronService
+	default:
+		return s.balanceService
 	}
 }
 
@@ -84,6 +156,7 @@ func NewPollingService(interval time.Duration) *PollingService {
 func (s *PollingService) Start(ctx context.Context) {
 	ticker := time.NewTicker(s.interval)
 	defer ticker.Stop()
+	defer s.balanceService.Close()
 
 	// Start metrics reporting
 	go s.reportMetrics()
@@ -91,15 +164,16 @@ func (s *PollingService) Start(ctx context.Context) {
 	logger.WithFields(logger.Fields{
 		"interval":    s.interval,
 		"minOrderAge": s.minOrderAge,
+		"tiers":       s.tiers,
 	}).Infof("Starting polling service (fallback mode)")
 
 	// Run immediately on start
-	s.pollPendingOrders(ctx)
+	s.pollExclusively(ctx)
 
 	for {
 		select {
 		case <-ticker.C:
-			s.pollPendingOrders(ctx)
+			s.pollExclusively(ctx)
 		case <-s.stopChan:
 			logger.Infof("Stopping polling service")
 			return
@@ -115,15 +189,45 @@ func (s *PollingService) Stop() {
 	close(s.stopChan)
 }
 
+// pollExclusively runs a polling cycle unless another aggregator instance is running one, so
+// pending orders are not polled once per instance
+func (s *PollingService) pollExclusively(ctx context.Context) {
+	_, err := lock.Default().Run(ctx, "polling", func(ctx context.Context) error {
+		s.pollPendingOrders(ctx)
+		return nil
+	})
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error": fmt.Sprintf("%v", err),
+		}).Errorf("Failed to take polling lock")
+	}
+}
+
 // pollPendingOrders checks all pending orders for payments
 func (s *PollingService) pollPendingOrders(ctx context.Context) {
+	ctx = ratelimit.WithPriority(ctx, ratelimit.PriorityPolling)
 	startTime := time.Now()
 
+	// While an RPC provider's budget runs low, polling yields it to webhooks and settlements
+	if ratelimit.Default().Shedding() {
+		s.metricsMutex.Lock()
+		s.metrics.CyclesShed++
+		s.metricsMutex.Unlock()
+		logger.Debugf("Polling cycle skipped, RPC budget reserved for webhooks and settlements")
+		return
+	}
+
 	// Only poll orders that:
 	// 1. Are in 'initiated' status
 	// 2. Are older than minOrderAge (webhook should have fired by then)
 	// 3. Have a receive address
-	cutoffTime := time.Now().Add(-s.minOrderAge)
+	// While a webhook provider's circuit is open its webhooks are unlikely to arrive, so orders of
+	// any age are polled
+	minOrderAge := s.minOrderAge
+	if breaker.Default().AnyOpen("alchemy") || breaker.Default().AnyOpen("engine") {
+		minOrderAge = 0
+	}
+	cutoffTime := time.Now().Add(-minOrderAge)
 
 	orders, err := storage.Client.PaymentOrder.
 		Query().
@@ -144,6 +248,7 @@ func (s *PollingService) pollPendingOrders(ctx context.Context) {
 		return
 	}
 
+	orders = s.selectDueOrders(pollableOrders(orders), time.Now())
 	if len(orders) == 0 {
 		logger.Debugf("No pending orders to poll")
 		return
@@ -151,7 +256,7 @@ func (s *PollingService) pollPendingOrders(ctx context.Context) {
 
 	logger.WithFields(logger.Fields{
 		"count":      len(orders),
-		"minAge":     s.minOrderAge,
+		"minAge":     minOrderAge,
 		"cutoffTime": cutoffTime,
 	}).Infof("Polling pending orders (fallback mode)")
 
@@ -173,6 +278,63 @@ func (s *PollingService) pollPendingOrders(ctx context.Context) {
 	}).Infof("Polling cycle completed")
 }
 
+// pollableOrders drops the orders on networks with polling disabled
+func pollableOrders(orders []*ent.PaymentOrder) []*ent.PaymentOrder {
+	pollable := make([]*ent.PaymentOrder, 0, len(orders))
+	for _, order := range orders {
+		if DepositSourcesFor(order.Edges.Token.Edges.Network).Polling {
+			pollable = append(pollable, order)
+		}
+	}
+	return pollable
+}
+
+// tierFor returns the polling tier for an order of the given age
+func (s *PollingService) tierFor(age time.Duration) PollingTier {
+	for _, tier := range s.tiers {
+		if tier.MaxAge == 0 || age < tier.MaxAge {
+			return tier
+		}
+	}
+	return s.tiers[len(s.tiers)-1]
+}
+
+// selectDueOrders returns the orders whose tier interval has elapsed since they were last polled
+// and records the tier distribution. Orders no longer pending are dropped from the poll history.
+func (s *PollingService) selectDueOrders(orders []*ent.PaymentOrder, now time.Time) []*ent.PaymentOrder {
+	// Ticks never line up exactly with tier intervals, so allow half a tick of slack
+	slack := s.interval / 2
+
+	due := make([]*ent.PaymentOrder, 0, len(orders))
+	lastPolled := make(map[uuid.UUID]time.Time, len(orders))
+	tierOrders := make(map[string]int, len(s.tiers))
+	tierChecks := make(map[string]int64, len(s.tiers))
+
+	for _, order := range orders {
+		tier := s.tierFor(now.Sub(order.CreatedAt))
+		tierOrders[tier.Name]++
+
+		if polledAt, ok := s.lastPolled[order.ID]; ok && now.Sub(polledAt)+slack < tier.Interval {
+			lastPolled[order.ID] = polledAt
+			continue
+		}
+
+		lastPolled[order.ID] = now
+		tierChecks[tier.Name]++
+		due = append(due, order)
+	}
+	s.lastPolled = lastPolled
+
+	s.metricsMutex.Lock()
+	s.metrics.TierOrders = tierOrders
+	for name, count := range tierChecks {
+		s.metrics.TierChecks[name] += count
+	}
+	s.metricsMutex.Unlock()
+
+	return due
+}
+
 // groupOrdersByNetwork groups orders by network for efficient batch processing
 func (s *PollingService) groupOrdersByNetwork(orders []*ent.PaymentOrder) map[int64][]*ent.PaymentOrder {
 	grouped := make(map[int64][]*ent.PaymentOrder)
@@ -185,7 +347,7 @@ func (s *PollingService) groupOrdersByNetwork(orders []*ent.PaymentOrder) map[in
 	return grouped
 }
 
-// pollNetworkOrders polls all orders for a specific network
+// pollNetworkOrders polls all orders for a specific network, fetching their balances in one batch
 func (s *PollingService) pollNetworkOrders(ctx context.Context, orders []*ent.PaymentOrder) {
 	if len(orders) == 0 {
 		return
@@ -198,197 +360,167 @@ func (s *PollingService) pollNetworkOrders(ctx context.Context, orders []*ent.Pa
 		"count":   len(orders),
 	}).Debugf("Polling network orders")
 
+	var activeOrders []*ent.PaymentOrder
+	var addresses []string
+	var tokens []*ent.Token
+	seenAddresses := make(map[string]bool)
+	seenTokens := make(map[int]bool)
+
 	for _, order := range orders {
-		s.checkOrderPayment(ctx, order)
-	}
-}
+		receiveAddr := order.Edges.ReceiveAddress
 
-// checkOrderPayment checks if payment has been received for an order
-func (s *PollingService) checkOrderPayment(ctx context.Context, order *ent.PaymentOrder) {
-	receiveAddr := order.Edges.ReceiveAddress
-	token := order.Edges.Token
-	network := token.Edges.Network
+		// Check if receive address is expired, past its grace period
+		if time.Now().After(receiveAddr.ValidUntil.Add(config.OrderConfig().ReceiveAddressGracePeriod)) {
+			logger.WithFields(logger.Fields{
+				"OrderID": order.ID,
+				"Address": receiveAddr.Address,
+			}).Debugf("Receive address expired, skipping")
+			continue
+		}
 
-	// Check if receive address is expired
-	if time.Now().After(receiveAddr.ValidUntil) {
-		logger.WithFields(logger.Fields{
-			"OrderID": order.ID,
-			"Address": receiveAddr.Address,
-		}).Debugf("Receive address expired, skipping")
-		return
+		activeOrders = append(activeOrders, order)
+		if !seenAddresses[receiveAddr.Address] {
+			seenAddresses[receiveAddr.Address] = true
+			addresses = append(addresses, receiveAddr.Address)
+		}
+		if !seenTokens[order.Edges.Token.ID] {
+			seenTokens[order.Edges.Token.ID] = true
+			tokens = append(tokens, order.Edges.Token)
+		}
 	}
 
-	// Check cache first
-	cacheKey := fmt.Sprintf("%d:%s:%s", network.ChainID, token.ContractAddress, receiveAddr.Address)
-	if cachedBalance, found := s.balanceCache.Get(cacheKey); found {
-		s.processBalance(ctx, order, cachedBalance)
+	if len(activeOrders) == 0 {
 		return
 	}
 
-	// Get balance from blockchain
-	balance, err := s.getTokenBalance(ctx, network.RPCEndpoint, receiveAddr.Address, token.ContractAddress, int(token.Decimals))
+	// Get balances from blockchain, in Multicall3 batches on EVM networks (cached results are served without an RPC call)
+	source := s.sourceFor(network)
+	rpcCallsBefore := source.RPCCalls()
+	balances, err := source.GetTokenBalances(ctx, network, addresses, tokens)
+	s.addRPCCalls(source.RPCCalls() - rpcCallsBefore)
 	if err != nil {
 		logger.WithFields(logger.Fields{
-			"OrderID": order.ID,
-			"Address": receiveAddr.Address,
+			"Network": network.Identifier,
+			"Count":   len(activeOrders),
 			"Error":   err,
-		}).Errorf("Failed to get balance")
+		}).Errorf("Failed to get balances")
 		s.incrementErrors()
 		return
 	}
 
-	s.incrementRPCCalls()
-
-	// Cache the result
-	s.balanceCache.Set(cacheKey, balance)
-
-	// Process the balance
-	s.processBalance(ctx, order, balance)
-}
-
-// processBalance processes the balance and updates order if needed
-func (s *PollingService) processBalance(ctx context.Context, order *ent.PaymentOrder, balance decimal.Decimal) {
-	// Check if balance has changed
-	if balance.GreaterThan(order.AmountPaid) {
-		logger.WithFields(logger.Fields{
-			"OrderID":     order.ID,
-			"Address":     order.Edges.ReceiveAddress.Address,
-			"OldBalance":  order.AmountPaid,
-			"NewBalance":  balance,
-			"Method":      "polling_fallback",
-		}).Infof("💰 Payment detected via polling fallback")
-
-		// Update order
-		err := s.updateOrderPayment(ctx, order, balance)
-		if err != nil {
-			logger.Errorf("Failed to update order payment: %v", err)
-			s.incrementErrors()
-		} else {
-			s.incrementPaymentsDetected()
+	// Orders sharing a receive address are settled by one pass over its transfers
+	processed := make(map[string]bool)
+	for _, order := range activeOrders {
+		key := fmt.Sprintf("%s:%d", order.Edges.ReceiveAddress.Address, order.Edges.Token.ID)
+		if processed[key] {
+			continue
 		}
-	}
-}
-
-// getTokenBalance gets the ERC-20 token balance for an address
-func (s *PollingService) getTokenBalance(ctx context.Context, rpcURL, address, tokenContract string, decimals int) (decimal.Decimal, error) {
-	// Build full RPC URL with API key from environment
-	fullRPCURL := utils.BuildRPCURL(rpcURL)
-	
-	// Connect to RPC
-	client, err := ethclient.Dial(fullRPCURL)
-	if err != nil {
-		return decimal.Zero, fmt.Errorf("failed to connect to RPC: %w", err)
-	}
-	defer client.Close()
-
-	// ERC-20 balanceOf function signature: balanceOf(address) returns (uint256)
-	// Function selector: 0x70a08231
-	addressBytes := common.HexToAddress(address)
-	data := common.Hex2Bytes("70a08231" + "000000000000000000000000" + addressBytes.Hex()[2:])
 
-	// Prepare call message
-	contractAddr := common.HexToAddress(tokenContract)
-	msg := ethereum.CallMsg{
-		To:   &contractAddr,
-		Data: data,
+		balance := balances[order.Edges.ReceiveAddress.Address][order.Edges.Token.ID]
+		processed[key] = s.processBalance(ctx, order, balance)
 	}
-
-	// Call contract
-	result, err := client.CallContract(ctx, msg, nil)
-	if err != nil {
-		return decimal.Zero, fmt.Errorf("failed to call contract: %w", err)
-	}
-
-	// Parse result
-	balance := new(big.Int).SetBytes(result)
-	balanceDecimal := decimal.NewFromBigInt(balance, 0)
-
-	// Convert to human-readable amount
-	divisor := decimal.NewFromInt(10).Pow(decimal.NewFromInt(int64(decimals)))
-	humanBalance := balanceDecimal.Div(divisor)
-
-	return humanBalance, nil
 }
 
-// updateOrderPayment updates the order with the new payment amount
-func (s *PollingService) updateOrderPayment(ctx context.Context, order *ent.PaymentOrder, amount decimal.Decimal) error {
-	// Update amount_paid
-	_, err := order.Update().
-		SetAmountPaid(amount).
-		Save(ctx)
+// processBalance hands the deposits behind a balance increase to the transfer handler and
+// reports whether the receive address was processed
+func (s *PollingService) processBalance(ctx context.Context, order *ent.PaymentOrder, balance decimal.Decimal) bool {
+	if !balance.GreaterThan(order.AmountPaid) {
+		return false
+	}
 
+	logger.WithFields(logger.Fields{
+		"OrderID":    order.ID,
+		"Address":    order.Edges.ReceiveAddress.Address,
+		"OldBalance": order.AmountPaid,
+		"NewBalance": balance,
+		"Method":     "polling_fallback",
+	}).Infof("💰 Payment detected via polling fallback")
+
+	found, err := s.processDeposits(ctx, order)
 	if err != nil {
-		return fmt.Errorf("failed to update order: %w", err)
+		logger.WithFields(logger.Fields{
+			"OrderID": order.ID,
+			"Address": order.Edges.ReceiveAddress.Address,
+			"Error":   err,
+		}).Errorf("Failed to process polled payment")
+		s.incrementErrors()
+		return false
 	}
 
-	// Check if payment is sufficient
-	totalRequired := order.Amount.Add(order.SenderFee).Add(order.NetworkFee).Add(order.ProtocolFee)
-
-	if amount.GreaterThanOrEqual(totalRequired) {
-		logger.WithFields(logger.Fields{
-			"OrderID":        order.ID,
-			"AmountPaid":     amount,
-			"AmountRequired": totalRequired,
-			"Status":         "sufficient",
-		}).Infof("✅ Payment sufficient, order ready for fulfillment")
-
-		// TODO: Trigger order fulfillment
-		// This should call the same logic as webhook handler
-		// For now, the database trigger will handle status change
-	} else {
+	if found == 0 {
 		logger.WithFields(logger.Fields{
-			"OrderID":        order.ID,
-			"AmountPaid":     amount,
-			"AmountRequired": totalRequired,
-			"Shortfall":      totalRequired.Sub(amount),
-		}).Warnf("⚠️  Payment insufficient, waiting for more")
+			"OrderID": order.ID,
+			"Address": order.Edges.ReceiveAddress.Address,
+			"Balance": balance,
+		}).Warnf("⚠️  Balance increased but no new transfer was found, retrying next cycle")
+		return true
 	}
 
-	return nil
+	s.incrementPaymentsDetected()
+	metrics.PaymentDetected(metrics.SourcePolling, order.Edges.Token.Edges.Network.Identifier)
+	return true
 }
 
-// Balance cache methods
+// processDeposits finds the token transfers to the order's receive address since it was created
+// and runs each new one through the transfer handler, the same pipeline as webhook events.
+// Returns the number of new transfers processed.
+func (s *PollingService) processDeposits(ctx context.Context, order *ent.PaymentOrder) (int, error) {
+	if s.handleTransfer == nil {
+		return 0, fmt.Errorf("no transfer handler configured")
+	}
 
-func (c *BalanceCache) Get(key string) (decimal.Decimal, bool) {
-	c.mutex.RLock()
-	defer c.mutex.RUnlock()
+	token := order.Edges.Token
+	network := token.Edges.Network
+	address := order.Edges.ReceiveAddress.Address
 
-	cached, exists := c.balances[key]
-	if !exists {
-		return decimal.Zero, false
+	source := s.sourceFor(network)
+	rpcCallsBefore := source.RPCCalls()
+	transfers, err := source.GetTokenTransfers(ctx, network, token, address, order.CreatedAt)
+	s.addRPCCalls(source.RPCCalls() - rpcCallsBefore)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch transfers: %w", err)
 	}
 
-	// Check if cache is still valid
-	if time.Since(cached.Timestamp) > c.ttl {
-		return decimal.Zero, false
-	}
+	found := 0
+	for _, transfer := range transfers {
+		if IsGatewayRefund(network, transfer.From) {
+			continue
+		}
 
-	return cached.Amount, true
-}
+		// Skip transfers already indexed through a webhook or an earlier poll
+		indexed, err := storage.Client.TransactionLog.
+			Query().
+			Where(transactionlog.TxHashEQ(transfer.TxHash)).
+			Exist(ctx)
+		if err != nil {
+			return found, fmt.Errorf("failed to check transaction log: %w", err)
+		}
+		if indexed {
+			continue
+		}
 
-func (c *BalanceCache) Set(key string, amount decimal.Decimal) {
-	c.mutex.Lock()
-	defer c.mutex.Unlock()
+		transfer.To = address
+		if err := s.handleTransfer(ctx, token, transfer); err != nil {
+			return found, fmt.Errorf("failed to process transfer %s: %w", transfer.TxHash, err)
+		}
+		found++
 
-	c.balances[key] = CachedBalance{
-		Amount:    amount,
-		Timestamp: time.Now(),
+		logger.WithFields(logger.Fields{
+			"OrderID": order.ID,
+			"TxHash":  transfer.TxHash,
+			"Amount":  transfer.Value,
+		}).Infof("✅ Deposit processed via polling fallback")
 	}
-}
-
-func (c *BalanceCache) Clear() {
-	c.mutex.Lock()
-	defer c.mutex.Unlock()
 
-	c.balances = make(map[string]CachedBalance)
+	return found, nil
 }
 
 // Metrics methods
 
-func (s *PollingService) incrementRPCCalls() {
+func (s *PollingService) addRPCCalls(count int64) {
 	s.metricsMutex.Lock()
 	defer s.metricsMutex.Unlock()
-	s.metrics.RPCCallsMade++
+	s.metrics.RPCCallsMade += count
 }
 
 func (s *PollingService) incrementPaymentsDetected() {
@@ -415,7 +547,17 @@ func (s *PollingService) updateMetrics(ordersChecked int, duration time.Duration
 func (s *PollingService) GetMetrics() PollingMetrics {
 	s.metricsMutex.RLock()
 	defer s.metricsMutex.RUnlock()
-	return *s.metrics
+
+	metrics := *s.metrics
+	metrics.TierOrders = make(map[string]int, len(s.metrics.TierOrders))
+	for name, count := range s.metrics.TierOrders {
+		metrics.TierOrders[name] = count
+	}
+	metrics.TierChecks = make(map[string]int64, len(s.metrics.TierChecks))
+	for name, count := range s.metrics.TierChecks {
+		metrics.TierChecks[name] = count
+	}
+	return metrics
 }
 
 // reportMetrics logs metrics periodically
@@ -432,8 +574,11 @@ func (s *PollingService) reportMetrics() {
 				"payments_detected":  metrics.PaymentsDetected,
 				"rpc_calls":          metrics.RPCCallsMade,
 				"errors":             metrics.ErrorsEncountered,
+				"cycles_shed":        metrics.CyclesShed,
 				"avg_check_time":     metrics.AverageCheckTime,
 				"last_run":           metrics.LastRunTime,
+				"tier_orders":        metrics.TierOrders,
+				"tier_checks":        metrics.TierChecks,
 			}).Infof("📊 Polling service metrics")
 		case <-s.stopChan:
 			return
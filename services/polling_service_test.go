@@ -0,0 +1,134 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	"github.com/NEDA-LABS/stablenode/ent/migrate"
+	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPollingTiers(t *testing.T) {
+	service := NewPollingService(time.Minute, nil)
+	defer service.balanceService.Close()
+
+	now := time.Now()
+	fresh := &ent.PaymentOrder{ID: uuid.New(), CreatedAt: now.Add(-6 * time.Minute)}
+	recent := &ent.PaymentOrder{ID: uuid.New(), CreatedAt: now.Add(-30 * time.Minute)}
+	stale := &ent.PaymentOrder{ID: uuid.New(), CreatedAt: now.Add(-3 * time.Hour)}
+	orders := []*ent.PaymentOrder{fresh, recent, stale}
+
+	t.Run("should tick at the shortest tier interval", func(t *testing.T) {
+		assert.Equal(t, 30*time.Second, service.interval)
+	})
+
+	t.Run("should assign orders to tiers by age", func(t *testing.T) {
+		assert.Equal(t, "fresh", service.tierFor(now.Sub(fresh.CreatedAt)).Name)
+		assert.Equal(t, "recent", service.tierFor(now.Sub(recent.CreatedAt)).Name)
+		assert.Equal(t, "stale", service.tierFor(now.Sub(stale.CreatedAt)).Name)
+	})
+
+	t.Run("should poll orders only when their tier is due", func(t *testing.T) {
+		assert.Len(t, service.selectDueOrders(orders, now), 3)
+
+		due := service.selectDueOrders(orders, now.Add(30*time.Second))
+		assert.Equal(t, []*ent.PaymentOrder{fresh}, due)
+
+		due = service.selectDueOrders(orders, now.Add(2*time.Minute))
+		assert.ElementsMatch(t, []*ent.PaymentOrder{fresh, recent}, due)
+
+		// The fresh order has aged into the recent tier by now
+		due = service.selectDueOrders(orders, now.Add(10*time.Minute))
+		assert.Len(t, due, 3)
+	})
+
+	t.Run("should report the tier distribution", func(t *testing.T) {
+		metrics := service.GetMetrics()
+		assert.Equal(t, map[string]int{"recent": 2, "stale": 1}, metrics.TierOrders)
+		assert.Equal(t, map[string]int64{"fresh": 3, "recent": 4, "stale": 2}, metrics.TierChecks)
+	})
+
+	t.Run("should forget orders that are no longer pending", func(t *testing.T) {
+		service.selectDueOrders([]*ent.PaymentOrder{fresh}, now.Add(11*time.Minute))
+		assert.Len(t, service.lastPolled, 1)
+	})
+}
+
+func TestPollingDeposits(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:polling?mode=memory&_fk=1")
+	defer client.Close()
+
+	if err := client.Schema.Create(context.Background(), migrate.WithGlobalUniqueID(true)); err != nil {
+		t.Fatal(err)
+	}
+	db.Client = client
+
+	server := newTestRPCServer(t, false, false)
+	defer server.Close()
+
+	var handled []*types.TokenTransferEvent
+	service := NewPollingService(time.Minute, func(ctx context.Context, token *ent.Token, event *types.TokenTransferEvent) error {
+		handled = append(handled, event)
+		return nil
+	})
+	defer service.balanceService.Close()
+
+	network := &ent.Network{
+		ChainID:                84532,
+		Identifier:             "base-sepolia",
+		RPCEndpoint:            server.URL,
+		BlockTime:              decimal.NewFromInt(2),
+		GatewayContractAddress: testTransfers[2].From,
+	}
+	rpcClient, err := rpc.Dial(server.URL)
+	assert.NoError(t, err)
+	service.balanceService.clients[network.RPCEndpoint] = &balanceClient{rpcClient: rpcClient, ethClient: ethclient.NewClient(rpcClient), service: service.balanceService}
+
+	token := &ent.Token{ID: 1, ContractAddress: "0x1111111111111111111111111111111111111111", Decimals: 6}
+	token.Edges.Network = network
+	order := &ent.PaymentOrder{ID: uuid.New(), CreatedAt: time.Now().Add(-6 * time.Minute)}
+	order.Edges.Token = token
+	order.Edges.ReceiveAddress = &ent.ReceiveAddress{Address: testTransfers[0].To, ValidUntil: time.Now().Add(time.Hour)}
+
+	// The second deposit was already indexed through a webhook
+	_, err = client.TransactionLog.
+		Create().
+		SetStatus(transactionlog.StatusCryptoDeposited).
+		SetTxHash(testTransfers[1].TxHash).
+		SetMetadata(map[string]interface{}{}).
+		Save(context.Background())
+	assert.NoError(t, err)
+
+	t.Run("should ignore balances that did not increase", func(t *testing.T) {
+		order.AmountPaid = decimal.NewFromFloat(1.5)
+		assert.False(t, service.processBalance(context.Background(), order, decimal.NewFromFloat(1.5)))
+		assert.Empty(t, handled)
+		assert.Zero(t, server.calls["eth_getLogs"])
+	})
+
+	t.Run("should hand new deposits to the transfer handler", func(t *testing.T) {
+		assert.True(t, service.processBalance(context.Background(), order, decimal.NewFromFloat(3)))
+
+		// Indexed deposits and gateway refunds are skipped
+		assert.Len(t, handled, 1)
+		assert.Equal(t, testTransfers[0].TxHash, handled[0].TxHash)
+		assert.Equal(t, order.Edges.ReceiveAddress.Address, handled[0].To)
+		assert.True(t, handled[0].Value.Equal(decimal.NewFromFloat(1.5)))
+
+		metrics := service.GetMetrics()
+		assert.Equal(t, int64(1), metrics.PaymentsDetected)
+		assert.Equal(t, int64(2), metrics.RPCCallsMade)
+		assert.Zero(t, metrics.ErrorsEncountered)
+	})
+}
@@ -3,19 +3,24 @@ package services
 import (
 	"context"
 	"fmt"
+	"math"
 	"math/rand"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/NEDA-LABS/stablenode/config"
 	"github.com/NEDA-LABS/stablenode/ent"
 	"github.com/NEDA-LABS/stablenode/ent/fiatcurrency"
+	"github.com/NEDA-LABS/stablenode/ent/lockpaymentorder"
 	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
 	"github.com/NEDA-LABS/stablenode/ent/providercurrencies"
 	"github.com/NEDA-LABS/stablenode/ent/providerordertoken"
+	"github.com/NEDA-LABS/stablenode/ent/providerperformance"
 	"github.com/NEDA-LABS/stablenode/ent/providerprofile"
 	"github.com/NEDA-LABS/stablenode/ent/token"
 	"github.com/NEDA-LABS/stablenode/ent/user"
+	"github.com/NEDA-LABS/stablenode/services/marketrate"
 	"github.com/NEDA-LABS/stablenode/storage"
 	"github.com/NEDA-LABS/stablenode/types"
 	"github.com/NEDA-LABS/stablenode/utils"
@@ -76,7 +81,7 @@ func (s *PriorityQueueService) GetProvisionBuckets(ctx context.Context) ([]*ent.
 					providercurrencies.HasCurrencyWith(fiatcurrency.IDEQ(bucket.Edges.Currency.ID)),
 					providercurrencies.AvailableBalanceGT(bucket.MinAmount),
 					providercurrencies.IsAvailableEQ(true),
-					// TODO: add check to enforce critical balance threshold in the future
+					providercurrencies.LowBalanceSinceIsNil(),
 				),
 			).
 			All(ctx)
@@ -141,6 +146,43 @@ func (s *PriorityQueueService) deleteQueue(ctx context.Context, key string) erro
 	return nil
 }
 
+// weightedShuffle randomizes the order of providers so that each is drawn ahead of the rest with a
+// probability proportional to its performance score. Providers without stats score 1
+func (s *PriorityQueueService) weightedShuffle(ctx context.Context, providers []*ent.ProviderProfile) {
+	ids := make([]string, len(providers))
+	for i, provider := range providers {
+		ids[i] = provider.ID
+	}
+
+	scores := make(map[string]float64, len(providers))
+	performances, err := storage.Client.ProviderPerformance.
+		Query().
+		Where(providerperformance.HasProviderWith(providerprofile.IDIn(ids...))).
+		WithProvider().
+		All(ctx)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error": fmt.Sprintf("%v", err),
+		}).Errorf("failed to fetch provider performance, shuffling providers uniformly")
+	}
+	for _, performance := range performances {
+		scores[performance.Edges.Provider.ID] = performance.Score
+	}
+
+	// Sort by a random key of u^(1/score), which orders providers as weighted draws without replacement
+	keys := make(map[string]float64, len(providers))
+	for _, provider := range providers {
+		score, ok := scores[provider.ID]
+		if !ok || score <= 0 {
+			score = 1
+		}
+		keys[provider.ID] = math.Pow(rand.Float64(), 1/score)
+	}
+	sort.SliceStable(providers, func(i, j int) bool {
+		return keys[providers[i].ID] > keys[providers[j].ID]
+	})
+}
+
 // CreatePriorityQueueForBucket creates a priority queue for a bucket and saves it to redis
 func (s *PriorityQueueService) CreatePriorityQueueForBucket(ctx context.Context, bucket *ent.ProvisionBucket) {
 	// Create a slice to store the provider profiles sorted by trust score
@@ -151,10 +193,14 @@ func (s *PriorityQueueService) CreatePriorityQueueForBucket(ctx context.Context,
 	// 	return trustScoreI > trustScoreJ // Sort in descending order
 	// })
 
-	// Randomize the order of providers
-	rand.Shuffle(len(providers), func(i, j int) {
-		providers[i], providers[j] = providers[j], providers[i]
-	})
+	// Randomize the order of providers, favouring those that fulfill orders reliably and fast
+	if config.ProviderPerformanceConfig().WeightingEnabled {
+		s.weightedShuffle(ctx, providers)
+	} else {
+		rand.Shuffle(len(providers), func(i, j int) {
+			providers[i], providers[j] = providers[j], providers[i]
+		})
+	}
 
 	redisKey := fmt.Sprintf("bucket_%s_%s_%s", bucket.Edges.Currency.Code, bucket.MinAmount, bucket.MaxAmount)
 	prevRedisKey := redisKey + "_prev"
@@ -204,6 +250,10 @@ func (s *PriorityQueueService) CreatePriorityQueueForBucket(ctx context.Context,
 		}).Errorf("failed to delete existing circular queue")
 	}
 
+	// Provider rates are also checked against the market rate of the sources
+	marketRates := marketrate.Default()
+	referenceRate := marketRates.ReferenceRate(ctx, bucket.Edges.Currency.Code)
+
 	// TODO: add also the checks for all the currencies that a provider has
 
 	for _, provider := range providers {
@@ -219,6 +269,7 @@ func (s *PriorityQueueService) CreatePriorityQueueForBucket(ctx context.Context,
 				providerordertoken.HasProviderWith(providerprofile.IDEQ(provider.ID)),
 				providerordertoken.HasCurrencyWith(fiatcurrency.CodeEQ(bucket.Edges.Currency.Code)),
 				providerordertoken.AddressNEQ(""),
+				providerordertoken.LowBalanceSinceIsNil(),
 			).
 			WithToken().
 			All(ctx)
@@ -267,6 +318,18 @@ func (s *PriorityQueueService) CreatePriorityQueueForBucket(ctx context.Context,
 				continue
 			}
 
+			if !isLocalStablecoin {
+				if err := marketRates.CheckRate(referenceRate, rate); err != nil {
+					logger.WithFields(logger.Fields{
+						"Error":      fmt.Sprintf("%v", err),
+						"ProviderID": provider.ID,
+						"Token":      orderToken.Edges.Token.Symbol,
+						"Currency":   bucket.Edges.Currency.Code,
+					}).Warnf("provider rate deviates from the market rate")
+					continue
+				}
+			}
+
 			// Serialize the provider ID, token, rate, min and max order amount into a single string
 			data := fmt.Sprintf("%s:%s:%s:%s:%s", provider.ID, orderToken.Edges.Token.Symbol, rate, orderToken.MinOrderAmount, orderToken.MaxOrderAmount)
 
@@ -287,6 +350,24 @@ func (s *PriorityQueueService) CreatePriorityQueueForBucket(ctx context.Context,
 func (s *PriorityQueueService) AssignLockPaymentOrder(ctx context.Context, order types.LockPaymentOrderFields) error {
 	orderIDPrefix := strings.Split(order.ID.String(), "-")[0]
 
+	// Orders flagged for manual review are held back from providers until cleared
+	held, err := storage.Client.LockPaymentOrder.
+		Query().
+		Where(
+			lockpaymentorder.IDEQ(order.ID),
+			lockpaymentorder.ReviewReasonNEQ(""),
+		).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("%s - failed to check review hold: %w", orderIDPrefix, err)
+	}
+	if held {
+		logger.WithFields(logger.Fields{
+			"OrderID": order.ID.String(),
+		}).Warnf("Order is held for manual review, not assigning")
+		return nil
+	}
+
 	excludeList, err := storage.RedisClient.LRange(ctx, fmt.Sprintf("order_exclude_list_%s", order.ID), 0, -1).Result()
 	if err != nil {
 		logger.WithFields(logger.Fields{
@@ -594,6 +675,7 @@ func (s *PriorityQueueService) matchRate(ctx context.Context, redisKey string, o
 					providerprofile.HasProviderCurrenciesWith(
 						providercurrencies.HasCurrencyWith(fiatcurrency.CodeEQ(bucketCurrency.Code)),
 						providercurrencies.IsAvailableEQ(true),
+						providercurrencies.LowBalanceSinceIsNil(),
 					),
 				),
 				providerordertoken.HasTokenWith(token.IDEQ(order.Token.ID)),
@@ -601,6 +683,7 @@ func (s *PriorityQueueService) matchRate(ctx context.Context, redisKey string, o
 					fiatcurrency.CodeEQ(bucketCurrency.Code),
 				),
 				providerordertoken.AddressNEQ(""),
+				providerordertoken.LowBalanceSinceIsNil(),
 			).
 			First(ctx)
 		if err != nil {
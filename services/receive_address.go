@@ -6,6 +6,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
 	cryptoUtils "github.com/NEDA-LABS/stablenode/utils/crypto"
 	"github.com/NEDA-LABS/stablenode/utils/logger"
 	tronWallet "github.com/paycrest/tron-wallet"
@@ -47,10 +48,10 @@ func (s *ReceiveAddressService) CreateSmartAddress(ctx context.Context, label st
 				chainID = 84532 // Base Sepolia
 			}
 
-			// Get owner address (the account that will control all receive addresses)
-			ownerAddress := viper.GetString("SMART_ACCOUNT_OWNER_ADDRESS")
-			if ownerAddress == "" {
-				return "", nil, fmt.Errorf("SMART_ACCOUNT_OWNER_ADDRESS not configured")
+			// Get owner address (the account that will control the network's receive addresses)
+			ownerAddress, err := SmartAccountOwner(ctx, chainID)
+			if err != nil {
+				return "", nil, err
 			}
 
 			// Create smart account via Alchemy
@@ -84,6 +85,27 @@ func (s *ReceiveAddressService) CreateSmartAddress(ctx context.Context, label st
 // CreateEVMAddress generates a new EOA (Externally Owned Account) for EVM chains
 // Returns the address and encrypted private key (to be stored in salt field)
 func (s *ReceiveAddressService) CreateEVMAddress(ctx context.Context) (string, []byte, error) {
+	return GenerateEVMAddress()
+}
+
+// CreateRoutedAddress creates the receive address of an order routed to a blockchain service: a
+// Thirdweb Engine server wallet, or an EOA sending through the network's own RPC endpoints. Orders
+// routed to Alchemy use pool addresses instead
+func (s *ReceiveAddressService) CreateRoutedAddress(ctx context.Context, service paymentorder.BlockchainService, label string) (string, []byte, error) {
+	switch service {
+	case paymentorder.BlockchainServiceEngine:
+		address, err := s.engineService.CreateServerWallet(ctx, label)
+		return address, nil, err
+	case paymentorder.BlockchainServiceRPC:
+		return GenerateEVMAddress()
+	default:
+		return "", nil, fmt.Errorf("blockchain service %s has no routed receive addresses", service)
+	}
+}
+
+// GenerateEVMAddress generates a new EOA and returns its address with the private key encrypted,
+// to be stored as the receive address salt
+func GenerateEVMAddress() (string, []byte, error) {
 	// Generate a new Ethereum private key
 	privateKey, err := crypto.GenerateKey()
 	if err != nil {
@@ -130,3 +152,9 @@ func (s *ReceiveAddressService) CreateTronAddress(ctx context.Context) (string,
 
 	return wallet.AddressBase58, privateKeyEncrypted, nil
 }
+
+// CreateSolanaAddress generates a new Solana keypair for an order's receive address
+// Returns: address, encrypted private key, error
+func (s *ReceiveAddressService) CreateSolanaAddress(ctx context.Context) (string, []byte, error) {
+	return GenerateSolanaAddress()
+}
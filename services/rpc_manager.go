@@ -0,0 +1,410 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	networkent "github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/ent/rpcendpoint"
+	"github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// rpcEndpointCacheTTL is how long the fallback endpoints of a network are cached
+const rpcEndpointCacheTTL = time.Minute
+
+// rpcLimitExceededCode is the JSON-RPC error code providers return when rate limiting a request
+const rpcLimitExceededCode = -32005
+
+// endpointError marks an error as caused by the endpoint rather than by the request
+type endpointError struct {
+	err error
+}
+
+func (e *endpointError) Error() string { return e.err.Error() }
+func (e *endpointError) Unwrap() error { return e.err }
+
+// EndpointError wraps an error so RPCManager.Do fails over to the next endpoint, for endpoint
+// failures that are not transport errors, e.g. a malformed response
+func EndpointError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &endpointError{err: err}
+}
+
+// endpointHealth is the health of a single RPC endpoint
+type endpointHealth struct {
+	failures         int
+	blacklistedUntil time.Time
+	lastError        string
+}
+
+// cachedEndpoints are the fallback endpoints of a network
+type cachedEndpoints struct {
+	urls      []string
+	fetchedAt time.Time
+}
+
+// RPCManager spreads RPC calls of a network over its primary endpoint and fallbacks.
+// Failing endpoints are blacklisted with exponential backoff and calls fail over to the next
+// healthy endpoint; a periodic health check also catches endpoints that stop following the chain
+type RPCManager struct {
+	config    *config.RPCConfiguration
+	mutex     sync.Mutex
+	health    map[string]*endpointHealth
+	fallbacks map[int]cachedEndpoints
+	now       func() time.Time
+}
+
+var (
+	rpcManager     *RPCManager
+	rpcManagerOnce sync.Once
+)
+
+// NewRPCManager creates a new RPC manager
+func NewRPCManager(conf *config.RPCConfiguration) *RPCManager {
+	return &RPCManager{
+		config:    conf,
+		health:    make(map[string]*endpointHealth),
+		fallbacks: make(map[int]cachedEndpoints),
+		now:       time.Now,
+	}
+}
+
+// GetRPCManager returns the process-wide RPC manager, so endpoint health is shared by all services
+func GetRPCManager() *RPCManager {
+	rpcManagerOnce.Do(func() {
+		rpcManager = NewRPCManager(config.RPCConfig())
+	})
+	return rpcManager
+}
+
+// Endpoints returns the network's primary RPC endpoint followed by its enabled fallbacks in
+// priority order
+func (m *RPCManager) Endpoints(ctx context.Context, network *ent.Network) []string {
+	endpoints := []string{network.RPCEndpoint}
+	seen := map[string]bool{network.RPCEndpoint: true}
+
+	for _, fallback := range m.fallbackURLs(ctx, network) {
+		if fallback == "" || seen[fallback] {
+			continue
+		}
+		seen[fallback] = true
+		endpoints = append(endpoints, fallback)
+	}
+
+	return endpoints
+}
+
+// fallbackURLs returns the fallback endpoint URLs of a network, from the loaded edge when present
+func (m *RPCManager) fallbackURLs(ctx context.Context, network *ent.Network) []string {
+	if _, err := network.Edges.RPCEndpointsOrErr(); err == nil || len(network.Edges.RPCEndpoints) > 0 {
+		sorted := make([]*ent.RPCEndpoint, 0, len(network.Edges.RPCEndpoints))
+		for _, endpoint := range network.Edges.RPCEndpoints {
+			if endpoint.IsEnabled {
+				sorted = append(sorted, endpoint)
+			}
+		}
+		sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Priority < sorted[j].Priority })
+
+		urls := make([]string, len(sorted))
+		for i, endpoint := range sorted {
+			urls[i] = endpoint.URL
+		}
+		return urls
+	}
+
+	if storage.Client == nil || network.ID == 0 {
+		return nil
+	}
+
+	m.mutex.Lock()
+	cached, ok := m.fallbacks[network.ID]
+	m.mutex.Unlock()
+	if ok && m.now().Sub(cached.fetchedAt) < rpcEndpointCacheTTL {
+		return cached.urls
+	}
+
+	fallbacks, err := storage.Client.RPCEndpoint.
+		Query().
+		Where(
+			rpcendpoint.HasNetworkWith(networkent.IDEQ(network.ID)),
+			rpcendpoint.IsEnabled(true),
+		).
+		Order(ent.Asc(rpcendpoint.FieldPriority)).
+		All(ctx)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":   fmt.Sprintf("%v", err),
+			"Network": network.Identifier,
+		}).Errorf("RPCManager: failed to fetch fallback endpoints")
+		return cached.urls
+	}
+
+	urls := make([]string, len(fallbacks))
+	for i, endpoint := range fallbacks {
+		urls[i] = endpoint.URL
+	}
+
+	m.mutex.Lock()
+	m.fallbacks[network.ID] = cachedEndpoints{urls: urls, fetchedAt: m.now()}
+	m.mutex.Unlock()
+
+	return urls
+}
+
+// ordered returns the endpoints with healthy ones first, keeping priority order within each group.
+// Blacklisted endpoints are kept as a last resort rather than failing outright
+func (m *RPCManager) ordered(endpoints []string) []string {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	now := m.now()
+	healthy := make([]string, 0, len(endpoints))
+	var blacklisted []string
+	for _, endpoint := range endpoints {
+		if health, ok := m.health[endpoint]; ok && now.Before(health.blacklistedUntil) {
+			blacklisted = append(blacklisted, endpoint)
+			continue
+		}
+		healthy = append(healthy, endpoint)
+	}
+
+	return append(healthy, blacklisted...)
+}
+
+// Do calls fn with the network's endpoints in order until one succeeds. fn receives the full URL
+// of each endpoint, with the provider API key added by utils.BuildRPCURL. Endpoint failures
+// blacklist the endpoint and move on to the next one; any other error is returned as is
+func (m *RPCManager) Do(ctx context.Context, network *ent.Network, fn func(endpoint string) error) error {
+	endpoints := m.ordered(m.Endpoints(ctx, network))
+
+	var lastErr error
+	for _, endpoint := range endpoints {
+		err := fn(utils.BuildRPCURL(endpoint))
+		if err == nil {
+			m.MarkSuccess(endpoint)
+			return nil
+		}
+		if !isEndpointFailure(ctx, err) {
+			return err
+		}
+
+		m.MarkFailure(endpoint, err)
+		lastErr = err
+
+		if len(endpoints) > 1 {
+			logger.WithFields(logger.Fields{
+				"Error":    fmt.Sprintf("%v", err),
+				"Network":  network.Identifier,
+				"Endpoint": endpointHost(endpoint),
+			}).Warnf("RPCManager: endpoint failed, failing over")
+		}
+	}
+
+	if len(endpoints) == 1 {
+		return lastErr
+	}
+	return fmt.Errorf("all %d RPC endpoints of %s failed: %w", len(endpoints), network.Identifier, lastErr)
+}
+
+// MarkSuccess clears the failures of an endpoint
+func (m *RPCManager) MarkSuccess(endpoint string) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	delete(m.health, endpoint)
+}
+
+// MarkFailure records a failure of an endpoint and blacklists it for base·2^(failures-1), capped
+// at the configured maximum
+func (m *RPCManager) MarkFailure(endpoint string, err error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	health, ok := m.health[endpoint]
+	if !ok {
+		health = &endpointHealth{}
+		m.health[endpoint] = health
+	}
+	health.failures++
+	health.lastError = err.Error()
+
+	backoff := m.config.BlacklistBase
+	for i := 1; i < health.failures && backoff < m.config.BlacklistMax; i++ {
+		backoff *= 2
+	}
+	if backoff > m.config.BlacklistMax {
+		backoff = m.config.BlacklistMax
+	}
+	health.blacklistedUntil = m.now().Add(backoff)
+}
+
+// IsBlacklisted reports whether an endpoint is currently skipped in favour of healthy ones
+func (m *RPCManager) IsBlacklisted(endpoint string) bool {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	health, ok := m.health[endpoint]
+	return ok && m.now().Before(health.blacklistedUntil)
+}
+
+// CheckHealth polls eth_blockNumber on every endpoint of the given EVM networks. Endpoints that
+// fail, or trail the highest block seen on their network by more than the configured lag, are
+// blacklisted; the rest are cleared
+func (m *RPCManager) CheckHealth(ctx context.Context, networks []*ent.Network) {
+	for _, network := range networks {
+		endpoints := m.Endpoints(ctx, network)
+		heights := make(map[string]uint64, len(endpoints))
+		var best uint64
+
+		for _, endpoint := range endpoints {
+			height, err := m.blockNumber(ctx, endpoint)
+			if err != nil {
+				m.MarkFailure(endpoint, err)
+				logger.WithFields(logger.Fields{
+					"Error":    fmt.Sprintf("%v", err),
+					"Network":  network.Identifier,
+					"Endpoint": endpointHost(endpoint),
+				}).Warnf("RPCManager: health check failed")
+				continue
+			}
+			heights[endpoint] = height
+			if height > best {
+				best = height
+			}
+		}
+
+		for endpoint, height := range heights {
+			if lag := int64(best - height); lag > m.config.MaxBlockLag {
+				m.MarkFailure(endpoint, fmt.Errorf("endpoint is %d blocks behind", lag))
+				logger.WithFields(logger.Fields{
+					"Network":  network.Identifier,
+					"Endpoint": endpointHost(endpoint),
+					"Lag":      lag,
+				}).Warnf("RPCManager: endpoint is lagging")
+				continue
+			}
+			m.MarkSuccess(endpoint)
+		}
+	}
+}
+
+// blockNumber fetches the latest block number of a single endpoint
+func (m *RPCManager) blockNumber(ctx context.Context, endpoint string) (uint64, error) {
+	ctx, cancel := context.WithTimeout(ctx, m.config.HealthCheckTimeout)
+	defer cancel()
+
+	client, err := rpc.DialContext(ctx, utils.BuildRPCURL(endpoint))
+	if err != nil {
+		return 0, err
+	}
+	defer client.Close()
+
+	var result string
+	if err := client.CallContext(ctx, &result, "eth_blockNumber"); err != nil {
+		return 0, err
+	}
+
+	var height uint64
+	if _, err := fmt.Sscanf(result, "0x%x", &height); err != nil {
+		return 0, fmt.Errorf("invalid block number %q", result)
+	}
+
+	return height, nil
+}
+
+// endpointHost returns the host of an endpoint for logging, as endpoint paths often carry API keys
+func endpointHost(endpoint string) string {
+	parsed, err := url.Parse(endpoint)
+	if err != nil || parsed.Host == "" {
+		return "invalid endpoint"
+	}
+	return parsed.Host
+}
+
+// isEndpointFailure reports whether an error means the endpoint is unusable, as opposed to the
+// request itself failing, e.g. a reverted call or a cancelled context
+func isEndpointFailure(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	var endpointErr *endpointError
+	if errors.As(err, &endpointErr) {
+		return true
+	}
+
+	var httpErr rpc.HTTPError
+	if errors.As(err, &httpErr) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		return rpcErr.ErrorCode() == rpcLimitExceededCode
+	}
+
+	return false
+}
+
+// ValidateNetworkEndpoints checks that the RPC and WebSocket endpoints of every enabled network
+// resolve to usable URLs, so a network missing its provider API key fails startup instead of its
+// first deposit or transaction. Fallback endpoints that don't resolve are only logged, as the
+// network is still reachable without them
+func ValidateNetworkEndpoints(ctx context.Context) error {
+	networks, err := storage.Client.Network.
+		Query().
+		Where(networkent.IsEnabledEQ(true)).
+		WithRPCEndpoints(func(q *ent.RPCEndpointQuery) {
+			q.Where(rpcendpoint.IsEnabled(true))
+		}).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch networks: %w", err)
+	}
+
+	var unresolvable []string
+	for _, network := range networks {
+		if _, err := utils.ResolveRPCURL(network.RPCEndpoint); err != nil {
+			unresolvable = append(unresolvable, fmt.Sprintf("%s rpc_endpoint: %v", network.Identifier, err))
+		}
+		if network.WssEndpoint != "" {
+			if _, err := utils.ResolveRPCURL(network.WssEndpoint); err != nil {
+				unresolvable = append(unresolvable, fmt.Sprintf("%s wss_endpoint: %v", network.Identifier, err))
+			}
+		}
+
+		for _, fallback := range network.Edges.RPCEndpoints {
+			if _, err := utils.ResolveRPCURL(fallback.URL); err != nil {
+				logger.WithFields(logger.Fields{
+					"Error":      fmt.Sprintf("%v", err),
+					"Network":    network.Identifier,
+					"EndpointID": fallback.ID,
+				}).Warnf("RPCManager: fallback endpoint does not resolve")
+			}
+		}
+	}
+
+	if len(unresolvable) > 0 {
+		return fmt.Errorf("unresolvable networks: %s", strings.Join(unresolvable, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,211 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/test"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestRPCManager creates an RPC manager with a controllable clock
+func newTestRPCManager(now *time.Time) *RPCManager {
+	manager := NewRPCManager(&config.RPCConfiguration{
+		HealthCheckTimeout: 5 * time.Second,
+		BlacklistBase:      10 * time.Second,
+		BlacklistMax:       60 * time.Second,
+		MaxBlockLag:        5,
+	})
+	manager.now = func() time.Time { return *now }
+	return manager
+}
+
+// newBlockNumberServer serves eth_blockNumber at a fixed height, or HTTP 503 when height is negative
+func newBlockNumberServer(height int64) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if height < 0 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":"0x%x"}`, height)
+	}))
+}
+
+func TestRPCManager(t *testing.T) {
+	now := time.Now()
+	ctx := context.Background()
+
+	network := &ent.Network{
+		Identifier:  "base-sepolia",
+		RPCEndpoint: "https://primary.example",
+		Edges: ent.NetworkEdges{
+			RPCEndpoints: []*ent.RPCEndpoint{
+				{URL: "https://third.example", Priority: 2, IsEnabled: true},
+				{URL: "https://disabled.example", Priority: 0, IsEnabled: false},
+				{URL: "https://second.example", Priority: 1, IsEnabled: true},
+				{URL: "https://primary.example", Priority: 3, IsEnabled: true},
+			},
+		},
+	}
+
+	t.Run("orders the primary endpoint before enabled fallbacks by priority", func(t *testing.T) {
+		manager := newTestRPCManager(&now)
+		assert.Equal(t, []string{"https://primary.example", "https://second.example", "https://third.example"}, manager.Endpoints(ctx, network))
+	})
+
+	t.Run("fails over on endpoint failures and blacklists the failing endpoint", func(t *testing.T) {
+		manager := newTestRPCManager(&now)
+
+		var tried []string
+		err := manager.Do(ctx, network, func(endpoint string) error {
+			tried = append(tried, endpoint)
+			if endpoint == "https://primary.example" {
+				return rpc.HTTPError{StatusCode: http.StatusBadGateway}
+			}
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"https://primary.example", "https://second.example"}, tried)
+		assert.True(t, manager.IsBlacklisted("https://primary.example"))
+
+		// The blacklisted primary is only tried after the healthy fallbacks
+		tried = nil
+		err = manager.Do(ctx, network, func(endpoint string) error {
+			tried = append(tried, endpoint)
+			return EndpointError(errors.New("bad response"))
+		})
+		assert.ErrorContains(t, err, "all 3 RPC endpoints of base-sepolia failed")
+		assert.Equal(t, []string{"https://second.example", "https://third.example", "https://primary.example"}, tried)
+	})
+
+	t.Run("returns request errors without failing over", func(t *testing.T) {
+		manager := newTestRPCManager(&now)
+		reverted := errors.New("execution reverted")
+
+		calls := 0
+		err := manager.Do(ctx, network, func(endpoint string) error {
+			calls++
+			return reverted
+		})
+		assert.Equal(t, reverted, err)
+		assert.Equal(t, 1, calls)
+		assert.False(t, manager.IsBlacklisted("https://primary.example"))
+	})
+
+	t.Run("returns the error unwrapped when there is no fallback", func(t *testing.T) {
+		manager := newTestRPCManager(&now)
+		failure := EndpointError(errors.New("bad response"))
+
+		err := manager.Do(ctx, &ent.Network{Identifier: "base", RPCEndpoint: "https://only.example"}, func(endpoint string) error {
+			return failure
+		})
+		assert.Equal(t, failure, err)
+	})
+
+	t.Run("backs off exponentially up to the maximum and clears on success", func(t *testing.T) {
+		clock := now
+		manager := newTestRPCManager(&clock)
+		endpoint := "https://flaky.example"
+
+		for _, backoff := range []time.Duration{10, 20, 40, 60, 60} {
+			manager.MarkFailure(endpoint, errors.New("timeout"))
+			clock = clock.Add(backoff*time.Second - time.Millisecond)
+			assert.True(t, manager.IsBlacklisted(endpoint))
+			clock = clock.Add(time.Millisecond)
+			assert.False(t, manager.IsBlacklisted(endpoint))
+		}
+
+		manager.MarkFailure(endpoint, errors.New("timeout"))
+		manager.MarkSuccess(endpoint)
+		assert.False(t, manager.IsBlacklisted(endpoint))
+	})
+
+	t.Run("classifies endpoint failures", func(t *testing.T) {
+		assert.True(t, isEndpointFailure(ctx, rpc.HTTPError{StatusCode: http.StatusTooManyRequests}))
+		assert.True(t, isEndpointFailure(ctx, fmt.Errorf("failed to get logs: %w", EndpointError(errors.New("bad")))))
+		assert.False(t, isEndpointFailure(ctx, errors.New("execution reverted")))
+
+		cancelled, cancel := context.WithCancel(ctx)
+		cancel()
+		assert.False(t, isEndpointFailure(cancelled, rpc.HTTPError{StatusCode: http.StatusBadGateway}))
+	})
+
+	t.Run("blacklists failing and lagging endpoints in health checks", func(t *testing.T) {
+		manager := newTestRPCManager(&now)
+
+		current := newBlockNumberServer(1000)
+		defer current.Close()
+		lagging := newBlockNumberServer(990)
+		defer lagging.Close()
+		down := newBlockNumberServer(-1)
+		defer down.Close()
+
+		manager.MarkFailure(current.URL, errors.New("timeout"))
+		manager.CheckHealth(ctx, []*ent.Network{{
+			Identifier:  "base",
+			RPCEndpoint: current.URL,
+			Edges: ent.NetworkEdges{
+				RPCEndpoints: []*ent.RPCEndpoint{
+					{URL: lagging.URL, IsEnabled: true},
+					{URL: down.URL, Priority: 1, IsEnabled: true},
+				},
+			},
+		}})
+
+		assert.False(t, manager.IsBlacklisted(current.URL))
+		assert.True(t, manager.IsBlacklisted(lagging.URL))
+		assert.True(t, manager.IsBlacklisted(down.URL))
+	})
+}
+
+func TestValidateNetworkEndpoints(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:networkendpoints?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	ctx := context.Background()
+	viper.Set("ALCHEMY_API_KEY", "")
+
+	createNetwork := func(identifier string, chainID int64, rpcEndpoint string, enabled bool) *ent.Network {
+		network, err := test.CreateTestNetwork(map[string]interface{}{
+			"identifier": identifier,
+			"chainID":    chainID,
+			"networkRPC": rpcEndpoint,
+			"fee":        0.0,
+			"is_enabled": enabled,
+		})
+		assert.NoError(t, err)
+		return network
+	}
+	base := createNetwork("base-sepolia", 84532, "https://sepolia.base.org", true)
+	client.RPCEndpoint.
+		Create().
+		SetURL("https://eth-sepolia.g.alchemy.com/v2").
+		SetNetwork(base).
+		SaveX(ctx)
+
+	// A disabled network isn't validated, and a fallback that doesn't resolve only warns
+	arbitrum := createNetwork("arbitrum-sepolia", 421614, "https://arb-sepolia.g.alchemy.com/v2", false)
+	assert.NoError(t, ValidateNetworkEndpoints(ctx))
+
+	arbitrum.Update().SetIsEnabled(true).ExecX(ctx)
+	err := ValidateNetworkEndpoints(ctx)
+	assert.ErrorContains(t, err, "arbitrum-sepolia rpc_endpoint")
+	assert.ErrorContains(t, err, "ALCHEMY_API_KEY")
+	assert.NotContains(t, err.Error(), "base-sepolia")
+
+	viper.Set("ALCHEMY_API_KEY", "key")
+	defer viper.Set("ALCHEMY_API_KEY", "")
+	assert.NoError(t, ValidateNetworkEndpoints(ctx))
+}
@@ -0,0 +1,247 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/NEDA-LABS/stablenode/utils/signer"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// sessionKeyABI holds the session key plugin functions: executeWithSessionKey is installed on the
+// account, isSessionKeyOf is called on the plugin
+const sessionKeyABI = `[
+	{"name":"executeWithSessionKey","type":"function","inputs":[
+		{"name":"calls","type":"tuple[]","components":[
+			{"name":"target","type":"address"},
+			{"name":"value","type":"uint256"},
+			{"name":"data","type":"bytes"}
+		]},
+		{"name":"sessionKey","type":"address"}
+	],"outputs":[{"name":"","type":"bytes[]"}]},
+	{"name":"isSessionKeyOf","type":"function","stateMutability":"view","inputs":[
+		{"name":"account","type":"address"},
+		{"name":"sessionKey","type":"address"}
+	],"outputs":[{"name":"","type":"bool"}]}
+]`
+
+var sessionKeyContract = func() abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(sessionKeyABI))
+	if err != nil {
+		panic(fmt.Sprintf("invalid session key ABI: %v", err))
+	}
+	return parsed
+}()
+
+// ERC-20 selectors a session key may call on any token, for whitelisted counterparts
+var (
+	erc20TransferSelector = common.FromHex("0xa9059cbb")
+	erc20ApproveSelector  = common.FromHex("0x095ea7b3")
+)
+
+// sessionKeyCall is a call of executeWithSessionKey
+type sessionKeyCall struct {
+	Target common.Address
+	Value  *big.Int
+	Data   []byte
+}
+
+// sessionKeyRegistration caches whether an account has a session key
+type sessionKeyRegistration struct {
+	registered bool
+	expiresAt  time.Time
+}
+
+var sessionKeyRegistrations sync.Map
+
+// sessionKeyFor returns the session key the calls can be sent from an account with, or nil when they
+// must be signed by the owner: session keys are disabled, a call isn't allowed for them, or the account
+// doesn't have the session key
+func (s *AlchemyService) sessionKeyFor(ctx context.Context, chainID int64, account string, txPayload []map[string]interface{}) (signer.Signer, error) {
+	conf := config.SessionKeyConfig()
+	if conf.Signer == "" {
+		return nil, nil
+	}
+	if !common.IsHexAddress(conf.PluginAddress) {
+		return nil, fmt.Errorf("SESSION_KEY_PLUGIN_ADDRESS not configured")
+	}
+
+	net, err := storage.Client.Network.
+		Query().
+		Where(network.ChainIDEQ(chainID)).
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get network: %w", err)
+	}
+
+	allowed := make(map[common.Address]bool, len(conf.AllowedDestinations)+1)
+	for _, destination := range conf.AllowedDestinations {
+		allowed[common.HexToAddress(destination)] = true
+	}
+	if common.IsHexAddress(net.GatewayContractAddress) {
+		allowed[common.HexToAddress(net.GatewayContractAddress)] = true
+	}
+	for _, tx := range txPayload {
+		if err := sessionKeyAllows(tx, allowed); err != nil {
+			logger.WithFields(logger.Fields{
+				"SmartAccount": account,
+				"Reason":       err.Error(),
+			}).Infof("Calls are not allowed for the session key, signing with the owner key")
+			return nil, nil
+		}
+	}
+
+	sessionKey, err := signer.FromReference(ctx, conf.Signer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load session key: %w", err)
+	}
+
+	cacheKey := fmt.Sprintf("%d:%s:%s", chainID, strings.ToLower(account), sessionKey.Address().Hex())
+	if cached, ok := sessionKeyRegistrations.Load(cacheKey); ok && time.Now().Before(cached.(sessionKeyRegistration).expiresAt) {
+		if cached.(sessionKeyRegistration).registered {
+			return sessionKey, nil
+		}
+		return nil, nil
+	}
+
+	data, err := sessionKeyContract.Pack("isSessionKeyOf", common.HexToAddress(account), sessionKey.Address())
+	if err != nil {
+		return nil, err
+	}
+	plugin := common.HexToAddress(conf.PluginAddress)
+	var result []byte
+	err = GetRPCManager().Do(ctx, net, func(endpoint string) error {
+		client, err := ethclient.DialContext(ctx, endpoint)
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		result, err = client.CallContract(ctx, ethereum.CallMsg{To: &plugin, Data: data}, nil)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to check session key of %s: %w", account, err)
+	}
+
+	// Accounts without the plugin revert or return nothing
+	registered := len(result) == 32 && result[31] == 1
+	sessionKeyRegistrations.Store(cacheKey, sessionKeyRegistration{
+		registered: registered,
+		expiresAt:  time.Now().Add(conf.CacheTTL),
+	})
+	if !registered {
+		return nil, nil
+	}
+	return sessionKey, nil
+}
+
+// sessionKeyAllows returns why a call can't be made with the session key, if it can't. Allowed calls
+// are ERC-20 transfers to and approvals of whitelisted destinations, and calls to whitelisted
+// contracts like the gateway. None may carry native value
+func sessionKeyAllows(tx map[string]interface{}, allowed map[common.Address]bool) error {
+	to, _ := tx["to"].(string)
+	if !common.IsHexAddress(to) {
+		return fmt.Errorf("invalid call target %q", to)
+	}
+	if value, ok := tx["value"].(string); ok && value != "" && value != "0" && value != "0x0" {
+		return fmt.Errorf("call to %s carries native value", to)
+	}
+
+	if allowed[common.HexToAddress(to)] {
+		return nil
+	}
+
+	data, _ := tx["data"].(string)
+	callData := common.FromHex(data)
+	if len(callData) == 4+64 && (bytes.Equal(callData[:4], erc20TransferSelector) || bytes.Equal(callData[:4], erc20ApproveSelector)) {
+		counterpart := common.BytesToAddress(callData[4:36])
+		if allowed[counterpart] {
+			return nil
+		}
+		return fmt.Errorf("%s is not a whitelisted destination", counterpart.Hex())
+	}
+
+	return fmt.Errorf("call to %s is not an ERC-20 transfer or approval", to)
+}
+
+// encodeExecuteWithSessionKey encodes the calls for executeWithSessionKey
+func encodeExecuteWithSessionKey(txPayload []map[string]interface{}, sessionKey common.Address) (string, error) {
+	calls := make([]sessionKeyCall, 0, len(txPayload))
+	for _, tx := range txPayload {
+		value := big.NewInt(0)
+		if v, ok := tx["value"].(string); ok && v != "" && v != "0" {
+			value.SetString(strings.TrimPrefix(v, "0x"), 16)
+		}
+		data, _ := tx["data"].(string)
+		calls = append(calls, sessionKeyCall{
+			Target: common.HexToAddress(tx["to"].(string)),
+			Value:  value,
+			Data:   common.FromHex(data),
+		})
+	}
+
+	callData, err := sessionKeyContract.Pack("executeWithSessionKey", calls, sessionKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode session key calls: %w", err)
+	}
+	return "0x" + common.Bytes2Hex(callData), nil
+}
+
+// sessionKeyOfCallData returns the session key an executeWithSessionKey callData is sent with
+func sessionKeyOfCallData(callData interface{}) (common.Address, bool) {
+	encoded, ok := callData.(string)
+	if !ok {
+		return common.Address{}, false
+	}
+	data := common.FromHex(encoded)
+	method := sessionKeyContract.Methods["executeWithSessionKey"]
+	if len(data) < 4 || !bytes.Equal(data[:4], method.ID) {
+		return common.Address{}, false
+	}
+
+	args, err := method.Inputs.Unpack(data[4:])
+	if err != nil || len(args) != 2 {
+		return common.Address{}, false
+	}
+	sessionKey, ok := args[1].(common.Address)
+	return sessionKey, ok
+}
+
+// signWithSessionKey signs a UserOperation with the session key. The plugin recovers the key from
+// the Ethereum signed message of the UserOperation hash, without a signature type byte
+func signWithSessionKey(ctx context.Context, chainID int64, userOp map[string]interface{}, sessionKey common.Address) (string, error) {
+	conf := config.SessionKeyConfig()
+	if conf.Signer == "" {
+		return "", fmt.Errorf("user operation is sent with session key %s but SESSION_KEY_SIGNER is not configured", sessionKey.Hex())
+	}
+
+	sessionSigner, err := signer.FromReference(ctx, conf.Signer)
+	if err != nil {
+		return "", fmt.Errorf("failed to load session key: %w", err)
+	}
+	if sessionSigner.Address() != sessionKey {
+		return "", fmt.Errorf("user operation is sent with session key %s, not the configured %s", sessionKey.Hex(), sessionSigner.Address().Hex())
+	}
+
+	signature, err := sessionSigner.SignDigest(ctx, accounts.TextHash(userOperationHash(chainID, userOp).Bytes()))
+	if err != nil {
+		return "", fmt.Errorf("failed to sign with session key: %w", err)
+	}
+	signature[64] += 27
+
+	return "0x" + common.Bytes2Hex(signature), nil
+}
@@ -0,0 +1,180 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/test"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	sessionKeyGateway  = "0x30F6A8457F8E42371E204a9c103f2Bd42341dD0F"
+	sessionKeyTreasury = "0x2222222222222222222222222222222222222222"
+	sessionKeyToken    = "0x036CbD53842c5426634e7929541eC2318f3dCF7e"
+	sessionKeyAccount  = "0x3333333333333333333333333333333333333333"
+)
+
+// erc20Call encodes an ERC-20 call with an address and amount
+func erc20Call(selector string, counterpart string) string {
+	return selector + common.Bytes2Hex(common.LeftPadBytes(common.HexToAddress(counterpart).Bytes(), 32)) +
+		common.Bytes2Hex(common.LeftPadBytes(common.Big1.Bytes(), 32))
+}
+
+func TestSessionKeyAllows(t *testing.T) {
+	allowed := map[common.Address]bool{
+		common.HexToAddress(sessionKeyGateway):  true,
+		common.HexToAddress(sessionKeyTreasury): true,
+	}
+
+	tests := []struct {
+		name    string
+		tx      map[string]interface{}
+		allowed bool
+	}{
+		{"transfer to a whitelisted destination", map[string]interface{}{"to": sessionKeyToken, "data": erc20Call("0xa9059cbb", sessionKeyTreasury)}, true},
+		{"approval of the gateway", map[string]interface{}{"to": sessionKeyToken, "data": erc20Call("0x095ea7b3", sessionKeyGateway), "value": "0"}, true},
+		{"call to a whitelisted contract", map[string]interface{}{"to": sessionKeyGateway, "data": "0xdeadbeef"}, true},
+		{"transfer elsewhere", map[string]interface{}{"to": sessionKeyToken, "data": erc20Call("0xa9059cbb", sessionKeyAccount)}, false},
+		{"other token calls", map[string]interface{}{"to": sessionKeyToken, "data": erc20Call("0x23b872dd", sessionKeyTreasury)}, false},
+		{"native value", map[string]interface{}{"to": sessionKeyTreasury, "data": "0x", "value": "0x1"}, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := sessionKeyAllows(test.tx, allowed)
+			assert.Equal(t, test.allowed, err == nil, "%v", err)
+		})
+	}
+}
+
+func TestSessionKeyUserOperation(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:sessionkey?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	ctx := context.Background()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	sessionKey := crypto.PubkeyToAddress(key.PublicKey)
+
+	registered := true
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "eth_call", req.Method)
+		calls++
+
+		result := "0x" + fmt.Sprintf("%064x", 0)
+		if registered {
+			result = "0x" + fmt.Sprintf("%064x", 1)
+		}
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":"%s"}`, req.ID, result)
+	}))
+	defer server.Close()
+
+	network, err := test.CreateTestNetwork(map[string]interface{}{
+		"identifier": "base-sepolia",
+		"chainID":    int64(84532),
+		"networkRPC": server.URL,
+	})
+	require.NoError(t, err)
+	network.Update().SetGatewayContractAddress(sessionKeyGateway).ExecX(ctx)
+
+	for key, value := range map[string]interface{}{
+		"SESSION_KEY_SIGNER":               "env:TEST_SESSION_PRIVATE_KEY",
+		"TEST_SESSION_PRIVATE_KEY":         common.Bytes2Hex(crypto.FromECDSA(key)),
+		"SESSION_KEY_PLUGIN_ADDRESS":       "0x4444444444444444444444444444444444444444",
+		"SESSION_KEY_ALLOWED_DESTINATIONS": sessionKeyTreasury,
+	} {
+		previous := viper.Get(key)
+		viper.Set(key, value)
+		defer viper.Set(key, previous)
+	}
+
+	s := NewAlchemyService()
+	settlement := []map[string]interface{}{
+		{"to": sessionKeyToken, "data": erc20Call("0x095ea7b3", sessionKeyGateway)},
+		{"to": sessionKeyGateway, "data": "0xdeadbeef"},
+	}
+
+	t.Run("uses the session key for allowed calls of registered accounts", func(t *testing.T) {
+		found, err := s.sessionKeyFor(ctx, 84532, sessionKeyAccount, settlement)
+		require.NoError(t, err)
+		require.NotNil(t, found)
+		assert.Equal(t, sessionKey, found.Address())
+
+		// Registrations are cached
+		_, err = s.sessionKeyFor(ctx, 84532, sessionKeyAccount, settlement)
+		require.NoError(t, err)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("falls back to the owner for other calls and accounts", func(t *testing.T) {
+		found, err := s.sessionKeyFor(ctx, 84532, sessionKeyAccount, []map[string]interface{}{
+			{"to": sessionKeyToken, "data": erc20Call("0xa9059cbb", "0x5555555555555555555555555555555555555555")},
+		})
+		require.NoError(t, err)
+		assert.Nil(t, found)
+
+		registered = false
+		found, err = s.sessionKeyFor(ctx, 84532, "0x6666666666666666666666666666666666666666", settlement)
+		require.NoError(t, err)
+		assert.Nil(t, found)
+	})
+
+	t.Run("signs executeWithSessionKey operations with the session key", func(t *testing.T) {
+		callData, err := encodeExecuteWithSessionKey(settlement, sessionKey)
+		require.NoError(t, err)
+
+		encodedKey, ok := sessionKeyOfCallData(callData)
+		require.True(t, ok)
+		assert.Equal(t, sessionKey, encodedKey)
+
+		_, ok = sessionKeyOfCallData(s.encodeExecuteCallData(sessionKeyGateway, "0", "0xdeadbeef"))
+		assert.False(t, ok)
+
+		userOp := map[string]interface{}{
+			"sender":               sessionKeyAccount,
+			"nonce":                "0x1",
+			"callData":             callData,
+			"callGasLimit":         "0x30d40",
+			"verificationGasLimit": "0x30d40",
+			"preVerificationGas":   "0x10000",
+			"maxFeePerGas":         "0x1",
+			"maxPriorityFeePerGas": "0x1",
+			"paymasterAndData":     "0x",
+		}
+		signature, err := s.signUserOperation(ctx, 84532, userOp)
+		require.NoError(t, err)
+
+		raw := common.FromHex(signature)
+		require.Len(t, raw, 65)
+		raw[64] -= 27
+		publicKey, err := crypto.SigToPub(accounts.TextHash(userOperationHash(84532, userOp).Bytes()), raw)
+		require.NoError(t, err)
+		assert.Equal(t, sessionKey, crypto.PubkeyToAddress(*publicKey))
+
+		other, err := encodeExecuteWithSessionKey(settlement, common.HexToAddress(sessionKeyTreasury))
+		require.NoError(t, err)
+		userOp["callData"] = other
+		_, err = s.signUserOperation(ctx, 84532, userOp)
+		assert.Error(t, err)
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sort"
 	"strings"
 
 	"github.com/NEDA-LABS/stablenode/ent"
@@ -255,3 +256,53 @@ func (s *SlackService) SendSubmissionNotification(firstName, email, submissionID
 	}
 	return nil
 }
+
+// SendAlert sends an operational alert with a title and key/value details
+func (s *SlackService) SendAlert(title string, details map[string]string) error {
+	if s.SlackWebhookURL == "" {
+		logger.Warnf("Slack webhook URL not set, skipping alert: %s", title)
+		return nil
+	}
+
+	keys := make([]string, 0, len(details))
+	for key := range details {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	blocks := []map[string]interface{}{
+		{
+			"type": "section",
+			"text": map[string]interface{}{
+				"type": "mrkdwn",
+				"text": fmt.Sprintf(":rotating_light: *%s*", title),
+			},
+		},
+	}
+	for _, key := range keys {
+		blocks = append(blocks, map[string]interface{}{
+			"type": "section",
+			"text": map[string]interface{}{
+				"type": "mrkdwn",
+				"text": fmt.Sprintf("*%s:* %s", key, details[key]),
+			},
+		})
+	}
+
+	jsonPayload, err := json.Marshal(map[string]interface{}{"blocks": blocks})
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %v", err)
+	}
+
+	resp, err := http.Post(s.SlackWebhookURL, "application/json", bytes.NewBuffer(jsonPayload))
+	if err != nil {
+		logger.Errorf("Failed to send Slack alert: %v", err)
+		return fmt.Errorf("failed to send Slack alert: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("slack alert failed with status: %d", resp.StatusCode)
+	}
+	return nil
+}
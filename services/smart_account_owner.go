@@ -0,0 +1,95 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/signer"
+	"github.com/spf13/viper"
+)
+
+// ownerNetwork returns the network of a chain, or nil when the chain has no network
+func ownerNetwork(ctx context.Context, chainID int64) (*ent.Network, error) {
+	net, err := storage.Client.Network.
+		Query().
+		Where(network.ChainIDEQ(chainID)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get network: %w", err)
+	}
+	return net, nil
+}
+
+// SmartAccountOwner returns the owner new smart accounts on a chain are created for: the network's
+// smart_account_owner_address, the address of its owner signer, or SMART_ACCOUNT_OWNER_ADDRESS
+func SmartAccountOwner(ctx context.Context, chainID int64) (string, error) {
+	net, err := ownerNetwork(ctx, chainID)
+	if err != nil {
+		return "", err
+	}
+
+	if net != nil && net.SmartAccountOwnerAddress != "" {
+		return net.SmartAccountOwnerAddress, nil
+	}
+	if net != nil && net.SmartAccountOwnerSigner != "" {
+		ownerSigner, err := signer.FromReference(ctx, net.SmartAccountOwnerSigner)
+		if err != nil {
+			return "", fmt.Errorf("failed to load owner signer of %s: %w", net.Identifier, err)
+		}
+		return ownerSigner.Address().Hex(), nil
+	}
+
+	owner := viper.GetString("SMART_ACCOUNT_OWNER_ADDRESS")
+	if owner == "" {
+		return "", fmt.Errorf("SMART_ACCOUNT_OWNER_ADDRESS not configured")
+	}
+	return owner, nil
+}
+
+// OwnerSigner returns the signer holding the key of a smart account owner on a chain: the network's
+// smart_account_owner_signer, or the SIGNER_BACKEND owner key when the account is owned by it. With
+// an empty owner the network's signer is preferred
+func OwnerSigner(ctx context.Context, chainID int64, owner string) (signer.Signer, error) {
+	net, err := ownerNetwork(ctx, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]func() (signer.Signer, error), 0, 2)
+	if net != nil && net.SmartAccountOwnerSigner != "" {
+		candidates = append(candidates, func() (signer.Signer, error) {
+			return signer.FromReference(ctx, net.SmartAccountOwnerSigner)
+		})
+	}
+	candidates = append(candidates, func() (signer.Signer, error) {
+		return signer.Owner(ctx)
+	})
+
+	var errs []error
+	for _, load := range candidates {
+		ownerSigner, err := load()
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if owner == "" || strings.EqualFold(ownerSigner.Address().Hex(), owner) {
+			return ownerSigner, nil
+		}
+	}
+
+	if owner == "" {
+		return nil, fmt.Errorf("no owner signer configured for chain %d: %w", chainID, errors.Join(errs...))
+	}
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("no configured signer holds the key of owner %s on chain %d: %w", owner, chainID, errors.Join(errs...))
+	}
+	return nil, fmt.Errorf("no configured signer holds the key of owner %s on chain %d", owner, chainID)
+}
@@ -0,0 +1,95 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/test"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSmartAccountOwner(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:smartaccountowner?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	ctx := context.Background()
+
+	globalKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	testnetKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	globalOwner := crypto.PubkeyToAddress(globalKey.PublicKey).Hex()
+	testnetOwner := crypto.PubkeyToAddress(testnetKey.PublicKey).Hex()
+
+	for key, value := range map[string]interface{}{
+		"SIGNER_BACKEND":                  "local",
+		"SMART_ACCOUNT_OWNER_ADDRESS":     globalOwner,
+		"SMART_ACCOUNT_OWNER_PRIVATE_KEY": common.Bytes2Hex(crypto.FromECDSA(globalKey)),
+		"SEPOLIA_OWNER_PRIVATE_KEY":       common.Bytes2Hex(crypto.FromECDSA(testnetKey)),
+	} {
+		previous := viper.Get(key)
+		viper.Set(key, value)
+		defer viper.Set(key, previous)
+	}
+
+	_, err = test.CreateTestNetwork(map[string]interface{}{
+		"identifier": "base",
+		"chainID":    int64(8453),
+		"networkRPC": "http://localhost",
+		"is_testnet": false,
+	})
+	require.NoError(t, err)
+
+	testnet, err := test.CreateTestNetwork(map[string]interface{}{
+		"identifier": "base-sepolia",
+		"chainID":    int64(84532),
+		"networkRPC": "http://localhost",
+	})
+	require.NoError(t, err)
+	testnet.Update().SetSmartAccountOwnerSigner("env:SEPOLIA_OWNER_PRIVATE_KEY").ExecX(ctx)
+
+	t.Run("networks without an owner use the global owner", func(t *testing.T) {
+		owner, err := SmartAccountOwner(ctx, 8453)
+		require.NoError(t, err)
+		assert.Equal(t, globalOwner, owner)
+
+		ownerSigner, err := OwnerSigner(ctx, 8453, "")
+		require.NoError(t, err)
+		assert.Equal(t, globalOwner, ownerSigner.Address().Hex())
+
+		_, err = OwnerSigner(ctx, 8453, testnetOwner)
+		assert.Error(t, err, "the testnet key must not sign for other networks")
+	})
+
+	t.Run("networks with an owner signer use its key", func(t *testing.T) {
+		owner, err := SmartAccountOwner(ctx, 84532)
+		require.NoError(t, err)
+		assert.Equal(t, testnetOwner, owner)
+
+		ownerSigner, err := OwnerSigner(ctx, 84532, "")
+		require.NoError(t, err)
+		assert.Equal(t, testnetOwner, ownerSigner.Address().Hex())
+
+		// Accounts created for the global owner before the network had its own key
+		ownerSigner, err = OwnerSigner(ctx, 84532, globalOwner)
+		require.NoError(t, err)
+		assert.Equal(t, globalOwner, ownerSigner.Address().Hex())
+	})
+
+	t.Run("an explicit owner address wins", func(t *testing.T) {
+		client.Network.Update().SetSmartAccountOwnerAddress("0x1111111111111111111111111111111111111111").ExecX(ctx)
+		defer client.Network.Update().ClearSmartAccountOwnerAddress().ExecX(ctx)
+
+		owner, err := SmartAccountOwner(ctx, 84532)
+		require.NoError(t, err)
+		assert.Equal(t, "0x1111111111111111111111111111111111111111", owner)
+	})
+}
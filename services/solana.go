@@ -0,0 +1,316 @@
+package services
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+	"sync/atomic"
+	"time"
+
+	"filippo.io/edwards25519"
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/NEDA-LABS/stablenode/utils"
+	cryptoUtils "github.com/NEDA-LABS/stablenode/utils/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/mr-tron/base58"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// SolanaTokenProgramID is the SPL Token program
+	SolanaTokenProgramID = "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA"
+
+	// SolanaAssociatedTokenProgramID is the Associated Token Account program
+	SolanaAssociatedTokenProgramID = "ATokenGPvbdGVxr1b2hvZbsiqW5xWH25efTNsLJA8knL"
+)
+
+// solanaNativeDecimals is the number of decimals of SOL (lamports)
+const solanaNativeDecimals = 9
+
+// solanaSignaturesPageSize is the number of signatures requested per getSignaturesForAddress page
+const solanaSignaturesPageSize = 1000
+
+// solanaTokenBalance is an entry of a transaction's pre- or post-token balances
+type solanaTokenBalance struct {
+	AccountIndex  int    `json:"accountIndex"`
+	Mint          string `json:"mint"`
+	Owner         string `json:"owner"`
+	UITokenAmount struct {
+		Amount string `json:"amount"`
+	} `json:"uiTokenAmount"`
+}
+
+// solanaTransaction is the part of a getTransaction result used to find deposits
+type solanaTransaction struct {
+	Slot int64 `json:"slot"`
+	Meta *struct {
+		Err               interface{}          `json:"err"`
+		PreTokenBalances  []solanaTokenBalance `json:"preTokenBalances"`
+		PostTokenBalances []solanaTokenBalance `json:"postTokenBalances"`
+	} `json:"meta"`
+}
+
+// SolanaService monitors SPL token deposits to Solana receive addresses over JSON-RPC. Each order gets
+// a fresh keypair as its receive address; payers send the token to the associated token account
+// (ATA) of that address for the token's mint, which is where deposits are read from
+type SolanaService struct {
+	rpcCalls atomic.Int64
+}
+
+// NewSolanaService creates a new instance of SolanaService
+func NewSolanaService() *SolanaService {
+	return &SolanaService{}
+}
+
+// RPCCalls returns the number of RPC requests made
+func (s *SolanaService) RPCCalls() int64 {
+	return s.rpcCalls.Load()
+}
+
+// GenerateSolanaAddress generates a new Solana keypair and returns its address with the private key
+// encrypted for storage, as base58 of the 64-byte secret key used by Solana wallets
+func GenerateSolanaAddress() (string, []byte, error) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to generate keypair: %w", err)
+	}
+
+	privateKeyEncrypted, err := cryptoUtils.EncryptPlain([]byte(base58.Encode(privateKey)))
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to encrypt private key: %w", err)
+	}
+
+	return base58.Encode(publicKey), privateKeyEncrypted, nil
+}
+
+// AssociatedTokenAddress derives the associated token account of an owner for an SPL token mint
+func AssociatedTokenAddress(owner, mint string) (string, error) {
+	var seeds [][]byte
+	for _, address := range []string{owner, SolanaTokenProgramID, mint} {
+		decoded, err := base58.Decode(address)
+		if err != nil || len(decoded) != 32 {
+			return "", fmt.Errorf("invalid Solana address %s", address)
+		}
+		seeds = append(seeds, decoded)
+	}
+
+	programID, _ := base58.Decode(SolanaAssociatedTokenProgramID)
+	address, err := findProgramAddress(seeds, programID)
+	if err != nil {
+		return "", err
+	}
+	return base58.Encode(address), nil
+}
+
+// findProgramAddress finds the program derived address of seeds, the first hash that isn't a valid
+// ed25519 point, trying bump seeds from 255 down
+func findProgramAddress(seeds [][]byte, programID []byte) ([]byte, error) {
+	for bump := 255; bump >= 0; bump-- {
+		h := sha256.New()
+		for _, seed := range seeds {
+			h.Write(seed)
+		}
+		h.Write([]byte{byte(bump)})
+		h.Write(programID)
+		h.Write([]byte("ProgramDerivedAddress"))
+		address := h.Sum(nil)
+
+		if _, err := new(edwards25519.Point).SetBytes(address); err != nil {
+			return address, nil
+		}
+	}
+	return nil, fmt.Errorf("no program derived address found")
+}
+
+// call makes a Solana JSON-RPC request to the network
+func (s *SolanaService) call(ctx context.Context, network *ent.Network, result interface{}, method string, args ...interface{}) error {
+	client, err := rpc.DialContext(ctx, network.RPCEndpoint)
+	if err != nil {
+		return fmt.Errorf("failed to connect to %s: %w", network.Identifier, err)
+	}
+	defer client.Close()
+
+	s.rpcCalls.Add(1)
+	return client.CallContext(ctx, result, method, args...)
+}
+
+// GetTokenBalances returns the balance of every given token held by each address, keyed by address
+// and then by token ID. SPL balances add up all token accounts of the owner for the mint; native
+// tokens are read as the SOL balance
+func (s *SolanaService) GetTokenBalances(ctx context.Context, network *ent.Network, addresses []string, tokens []*ent.Token) (map[string]map[int]decimal.Decimal, error) {
+	balances := make(map[string]map[int]decimal.Decimal, len(addresses))
+
+	for _, address := range addresses {
+		balances[address] = make(map[int]decimal.Decimal, len(tokens))
+
+		for _, token := range tokens {
+			if utils.IsNativeToken(token.ContractAddress) {
+				var result struct {
+					Value uint64 `json:"value"`
+				}
+				if err := s.call(ctx, network, &result, "getBalance", address, map[string]string{"commitment": "confirmed"}); err != nil {
+					return nil, fmt.Errorf("failed to fetch SOL balance of %s: %w", address, err)
+				}
+				balances[address][token.ID] = utils.FromSubunit(new(big.Int).SetUint64(result.Value), solanaNativeDecimals)
+				continue
+			}
+
+			var result struct {
+				Value []struct {
+					Account struct {
+						Data struct {
+							Parsed struct {
+								Info struct {
+									TokenAmount struct {
+										Amount string `json:"amount"`
+									} `json:"tokenAmount"`
+								} `json:"info"`
+							} `json:"parsed"`
+						} `json:"data"`
+					} `json:"account"`
+				} `json:"value"`
+			}
+			err := s.call(ctx, network, &result, "getTokenAccountsByOwner", address,
+				map[string]string{"mint": token.ContractAddress},
+				map[string]string{"encoding": "jsonParsed", "commitment": "confirmed"},
+			)
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch %s accounts of %s: %w", token.Symbol, address, err)
+			}
+
+			total := new(big.Int)
+			for _, account := range result.Value {
+				amount, ok := new(big.Int).SetString(account.Account.Data.Parsed.Info.TokenAmount.Amount, 10)
+				if !ok {
+					return nil, fmt.Errorf("invalid %s balance of %s", token.Symbol, address)
+				}
+				total.Add(total, amount)
+			}
+			balances[address][token.ID] = utils.FromSubunit(total, token.Decimals)
+		}
+	}
+
+	return balances, nil
+}
+
+// GetTokenTransfers returns the SPL token deposits to address confirmed since the given time, read
+// from the transactions touching its associated token account. A deposit is a transaction that
+// raised the address's balance of the mint; the payer is the owner whose balance of the mint fell.
+// Block numbers are slots and transaction hashes are signatures
+func (s *SolanaService) GetTokenTransfers(ctx context.Context, network *ent.Network, token *ent.Token, address string, since time.Time) ([]*types.TokenTransferEvent, error) {
+	if utils.IsNativeToken(token.ContractAddress) {
+		return nil, fmt.Errorf("native SOL transfers are not supported")
+	}
+
+	ata, err := AssociatedTokenAddress(address, token.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// Signatures come newest first, page back until they predate since
+	var signatures []string
+	before := ""
+	for {
+		options := map[string]interface{}{"limit": solanaSignaturesPageSize, "commitment": "confirmed"}
+		if before != "" {
+			options["before"] = before
+		}
+
+		var page []struct {
+			Signature string      `json:"signature"`
+			Err       interface{} `json:"err"`
+			BlockTime *int64      `json:"blockTime"`
+		}
+		if err := s.call(ctx, network, &page, "getSignaturesForAddress", ata, options); err != nil {
+			return nil, fmt.Errorf("failed to fetch signatures of %s: %w", ata, err)
+		}
+
+		done := len(page) < solanaSignaturesPageSize
+		for _, entry := range page {
+			if entry.BlockTime != nil && time.Unix(*entry.BlockTime, 0).Before(since) {
+				done = true
+				break
+			}
+			if entry.Err == nil {
+				signatures = append(signatures, entry.Signature)
+			}
+		}
+		if done || len(page) == 0 {
+			break
+		}
+		before = page[len(page)-1].Signature
+	}
+
+	transfers := make([]*types.TokenTransferEvent, 0, len(signatures))
+	for i := len(signatures) - 1; i >= 0; i-- {
+		var tx *solanaTransaction
+		err := s.call(ctx, network, &tx, "getTransaction", signatures[i], map[string]interface{}{
+			"encoding":                       "jsonParsed",
+			"commitment":                     "confirmed",
+			"maxSupportedTransactionVersion": 0,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch transaction %s: %w", signatures[i], err)
+		}
+		if tx == nil || tx.Meta == nil || tx.Meta.Err != nil {
+			continue
+		}
+
+		received, from := solanaBalanceChange(tx, token.ContractAddress, address)
+		if received.Sign() <= 0 {
+			continue
+		}
+
+		transfers = append(transfers, &types.TokenTransferEvent{
+			BlockNumber: tx.Slot,
+			TxHash:      signatures[i],
+			From:        from,
+			To:          address,
+			Value:       utils.FromSubunit(received, token.Decimals),
+		})
+	}
+
+	return transfers, nil
+}
+
+// solanaBalanceChange returns how much a transaction raised the balance of owner in mint, and the
+// owner whose balance of the mint fell the most
+func solanaBalanceChange(tx *solanaTransaction, mint, owner string) (*big.Int, string) {
+	changes := make(map[string]*big.Int)
+	apply := func(balances []solanaTokenBalance, sign int64) {
+		for _, balance := range balances {
+			if balance.Mint != mint {
+				continue
+			}
+			amount, ok := new(big.Int).SetString(balance.UITokenAmount.Amount, 10)
+			if !ok {
+				continue
+			}
+			if changes[balance.Owner] == nil {
+				changes[balance.Owner] = new(big.Int)
+			}
+			changes[balance.Owner].Add(changes[balance.Owner], amount.Mul(amount, big.NewInt(sign)))
+		}
+	}
+	apply(tx.Meta.PreTokenBalances, -1)
+	apply(tx.Meta.PostTokenBalances, 1)
+
+	received := new(big.Int)
+	if change, ok := changes[owner]; ok {
+		received = change
+	}
+
+	var from string
+	largest := new(big.Int)
+	for account, change := range changes {
+		if account != owner && change.Cmp(largest) < 0 {
+			largest, from = change, account
+		}
+	}
+
+	return received, from
+}
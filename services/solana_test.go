@@ -0,0 +1,160 @@
+package services
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"filippo.io/edwards25519"
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/utils"
+	cryptoUtils "github.com/NEDA-LABS/stablenode/utils/crypto"
+	"github.com/mr-tron/base58"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testSolanaOwner = "9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM"
+	testSolanaPayer = "4Nd1mBQtrMJVYVfKf2PJy9NZUZdTAsp7D4xWLs4gDB4T"
+	testSolanaUSDC  = "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v"
+)
+
+// solanaRPCRequest is a JSON-RPC request received by the stub node
+type solanaRPCRequest struct {
+	ID     json.RawMessage   `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+}
+
+func TestSolanaAddresses(t *testing.T) {
+	t.Run("derives an off-curve associated token account per mint", func(t *testing.T) {
+		ata, err := AssociatedTokenAddress(testSolanaOwner, testSolanaUSDC)
+		assert.NoError(t, err)
+		assert.True(t, utils.IsValidSolanaAddress(ata))
+
+		again, err := AssociatedTokenAddress(testSolanaOwner, testSolanaUSDC)
+		assert.NoError(t, err)
+		assert.Equal(t, ata, again)
+
+		decoded, _ := base58.Decode(ata)
+		_, err = new(edwards25519.Point).SetBytes(decoded)
+		assert.Error(t, err, "program derived addresses must not be valid ed25519 points")
+
+		other, err := AssociatedTokenAddress(testSolanaOwner, testSolanaPayer)
+		assert.NoError(t, err)
+		assert.NotEqual(t, ata, other)
+
+		_, err = AssociatedTokenAddress("0x5555555555555555555555555555555555555555", testSolanaUSDC)
+		assert.Error(t, err)
+	})
+
+	t.Run("generates a keypair with an encrypted secret key", func(t *testing.T) {
+		address, encrypted, err := GenerateSolanaAddress()
+		assert.NoError(t, err)
+		assert.True(t, utils.IsValidSolanaAddress(address))
+
+		decrypted, err := cryptoUtils.DecryptPlain(encrypted)
+		assert.NoError(t, err)
+		secret, err := base58.Decode(string(decrypted))
+		assert.NoError(t, err)
+		assert.Len(t, secret, ed25519.PrivateKeySize)
+		assert.Equal(t, address, base58.Encode(ed25519.PrivateKey(secret).Public().(ed25519.PublicKey)))
+	})
+}
+
+func TestSolanaService(t *testing.T) {
+	ata, err := AssociatedTokenAddress(testSolanaOwner, testSolanaUSDC)
+	assert.NoError(t, err)
+
+	now := time.Now().Unix()
+	var fetched []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req solanaRPCRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		var result interface{}
+		switch req.Method {
+		case "getBalance":
+			result = map[string]interface{}{"value": 2500000000}
+		case "getTokenAccountsByOwner":
+			var filter map[string]string
+			assert.NoError(t, json.Unmarshal(req.Params[1], &filter))
+			assert.Equal(t, testSolanaUSDC, filter["mint"])
+
+			account := func(amount string) map[string]interface{} {
+				return map[string]interface{}{"account": map[string]interface{}{"data": map[string]interface{}{
+					"parsed": map[string]interface{}{"info": map[string]interface{}{
+						"tokenAmount": map[string]string{"amount": amount},
+					}},
+				}}}
+			}
+			result = map[string]interface{}{"value": []interface{}{account("1500000"), account("500000")}}
+		case "getSignaturesForAddress":
+			var address string
+			assert.NoError(t, json.Unmarshal(req.Params[0], &address))
+			assert.Equal(t, ata, address)
+
+			result = []map[string]interface{}{
+				{"signature": "sig3", "err": nil, "blockTime": now},
+				{"signature": "sig2", "err": map[string]interface{}{"InstructionError": []interface{}{0, "Custom"}}, "blockTime": now - 60},
+				{"signature": "sig1", "err": nil, "blockTime": now - 86400},
+			}
+		case "getTransaction":
+			var signature string
+			assert.NoError(t, json.Unmarshal(req.Params[0], &signature))
+			fetched = append(fetched, signature)
+
+			balance := func(owner, amount string) map[string]interface{} {
+				return map[string]interface{}{"mint": testSolanaUSDC, "owner": owner, "uiTokenAmount": map[string]string{"amount": amount}}
+			}
+			result = map[string]interface{}{
+				"slot": 250000000,
+				"meta": map[string]interface{}{
+					"err":               nil,
+					"preTokenBalances":  []interface{}{balance(testSolanaPayer, "5000000")},
+					"postTokenBalances": []interface{}{balance(testSolanaPayer, "4000000"), balance(testSolanaOwner, "1000000")},
+				},
+			}
+		default:
+			t.Errorf("unexpected method %s", req.Method)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "result": result})
+	}))
+	defer server.Close()
+
+	network := &ent.Network{Identifier: "solana-devnet", RPCEndpoint: server.URL}
+	usdc := &ent.Token{ID: 1, Symbol: "USDC", ContractAddress: testSolanaUSDC, Decimals: 6}
+	sol := &ent.Token{ID: 2, Symbol: "SOL", ContractAddress: utils.NativeTokenAddress, Decimals: 9}
+	service := NewSolanaService()
+
+	t.Run("sums token accounts and reads native balances", func(t *testing.T) {
+		balances, err := service.GetTokenBalances(context.Background(), network, []string{testSolanaOwner}, []*ent.Token{usdc, sol})
+		assert.NoError(t, err)
+		assert.True(t, balances[testSolanaOwner][usdc.ID].Equal(decimal.NewFromInt(2)))
+		assert.True(t, balances[testSolanaOwner][sol.ID].Equal(decimal.NewFromFloat(2.5)))
+	})
+
+	t.Run("reads deposits from the associated token account", func(t *testing.T) {
+		transfers, err := service.GetTokenTransfers(context.Background(), network, usdc, testSolanaOwner, time.Now().Add(-time.Hour))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"sig3"}, fetched, "failed and older transactions are skipped")
+		assert.Len(t, transfers, 1)
+		assert.Equal(t, "sig3", transfers[0].TxHash)
+		assert.Equal(t, int64(250000000), transfers[0].BlockNumber)
+		assert.Equal(t, testSolanaPayer, transfers[0].From)
+		assert.Equal(t, testSolanaOwner, transfers[0].To)
+		assert.True(t, transfers[0].Value.Equal(decimal.NewFromInt(1)))
+
+		_, err = service.GetTokenTransfers(context.Background(), network, sol, testSolanaOwner, time.Now())
+		assert.Error(t, err)
+	})
+
+	assert.Equal(t, int64(4), service.RPCCalls())
+}
@@ -0,0 +1,220 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	networkent "github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/ent/sweep"
+	tokenent "github.com/NEDA-LABS/stablenode/ent/token"
+	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
+	"github.com/NEDA-LABS/stablenode/services/contracts"
+	"github.com/NEDA-LABS/stablenode/services/ledger"
+	"github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/NEDA-LABS/stablenode/utils"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ErrSweepNotAwaitingSignature is returned when a signature is imported for a sweep that was already submitted
+var ErrSweepNotAwaitingSignature = errors.New("sweep is not awaiting a signature")
+
+// SweepService moves funds out of receive addresses. Sweeps below the offline signing threshold are
+// signed and sent right away; larger sweeps are prepared for an air-gapped signer
+type SweepService struct {
+	alchemyService *AlchemyService
+	serviceManager *ServiceManager
+}
+
+// NewSweepService creates a new instance of SweepService
+func NewSweepService() *SweepService {
+	return &SweepService{
+		alchemyService: NewAlchemyService(),
+		serviceManager: NewServiceManager(),
+	}
+}
+
+// CreateSweep sweeps amount of a token from a receive address to toAddress.
+// The token must be loaded with its network
+func (s *SweepService) CreateSweep(ctx context.Context, token *ent.Token, fromAddress, toAddress string, amount decimal.Decimal) (*ent.Sweep, error) {
+	sweepConf := config.SweepConfig()
+	network := token.Edges.Network
+
+	tx, err := sweepTransaction(token, toAddress, amount)
+	if err != nil {
+		return nil, fmt.Errorf("CreateSweep.sweepTransaction: %w", err)
+	}
+
+	create := func(client *ent.SweepClient) *ent.SweepCreate {
+		return client.
+			Create().
+			SetNetwork(network.Identifier).
+			SetChainID(network.ChainID).
+			SetTokenAddress(token.ContractAddress).
+			SetFromAddress(fromAddress).
+			SetToAddress(toAddress).
+			SetAmount(amount)
+	}
+
+	if amount.LessThan(sweepConf.OfflineSigningThreshold) {
+		if _, ok := ctx.Value(gasSpendKey{}).(gasSpend); !ok {
+			ctx = WithGasSpend(ctx, transactionlog.PurposeSweep, nil)
+		}
+		txHash, err := s.serviceManager.ForAddress(ctx, fromAddress).SendTransactionBatch(ctx, network.ChainID, fromAddress, []map[string]interface{}{tx})
+		if err != nil {
+			return nil, fmt.Errorf("CreateSweep.sendTransaction: %w", err)
+		}
+
+		return saveSubmittedSweep(ctx, token.ID, func(tx *ent.Tx) (*ent.Sweep, error) {
+			return create(tx.Sweep).
+				SetStatus(sweep.StatusSubmitted).
+				SetTxHash(txHash).
+				SetSubmittedAt(time.Now()).
+				Save(ctx)
+		})
+	}
+
+	// High-value sweeps are never signed with a key held by the server
+	if sweepConf.OfflineSignerAddress == "" {
+		return nil, fmt.Errorf("CreateSweep: SWEEP_OFFLINE_SIGNER_ADDRESS not configured")
+	}
+
+	userOp, userOpHash, err := s.alchemyService.PrepareUserOperation(ctx, network.ChainID, fromAddress, tx)
+	if err != nil {
+		return nil, fmt.Errorf("CreateSweep.prepareUserOperation: %w", err)
+	}
+
+	sweepEntity, err := create(storage.Client.Sweep).
+		SetUserOperation(userOp).
+		SetUserOpHash(userOpHash).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("CreateSweep.save: %w", err)
+	}
+
+	logger.WithFields(logger.Fields{
+		"SweepID":    sweepEntity.ID,
+		"From":       fromAddress,
+		"Amount":     amount,
+		"UserOpHash": userOpHash,
+	}).Infof("Sweep prepared for offline signing")
+
+	return sweepEntity, nil
+}
+
+// ExportSweep returns the unsigned UserOperation of a sweep for the air-gapped signer
+func (s *SweepService) ExportSweep(sweepEntity *ent.Sweep) (*types.SweepExport, error) {
+	if sweepEntity.Status != sweep.StatusAwaitingSignature {
+		return nil, ErrSweepNotAwaitingSignature
+	}
+
+	return &types.SweepExport{
+		SweepID:       sweepEntity.ID,
+		ChainID:       sweepEntity.ChainID,
+		TokenAddress:  sweepEntity.TokenAddress,
+		FromAddress:   sweepEntity.FromAddress,
+		ToAddress:     sweepEntity.ToAddress,
+		Amount:        sweepEntity.Amount,
+		Signer:        config.SweepConfig().OfflineSignerAddress,
+		UserOperation: sweepEntity.UserOperation,
+		UserOpHash:    sweepEntity.UserOpHash,
+	}, nil
+}
+
+// SubmitSweepSignature imports the signature returned by the air-gapped signer and sends the sweep
+func (s *SweepService) SubmitSweepSignature(ctx context.Context, sweepID uuid.UUID, signature string) (*ent.Sweep, error) {
+	sweepEntity, err := storage.Client.Sweep.Get(ctx, sweepID)
+	if err != nil {
+		return nil, err
+	}
+
+	if sweepEntity.Status != sweep.StatusAwaitingSignature {
+		return nil, ErrSweepNotAwaitingSignature
+	}
+
+	tokenID, err := storage.Client.Token.
+		Query().
+		Where(
+			tokenent.ContractAddressEQ(sweepEntity.TokenAddress),
+			tokenent.HasNetworkWith(networkent.IdentifierEQ(sweepEntity.Network)),
+		).
+		OnlyID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("SubmitSweepSignature.token: %w", err)
+	}
+
+	txHash, err := s.alchemyService.SubmitSignedUserOperation(ctx, sweepEntity.ChainID, sweepEntity.UserOperation, signature, config.SweepConfig().OfflineSignerAddress)
+	if err != nil {
+		return nil, fmt.Errorf("SubmitSweepSignature.submit: %w", err)
+	}
+
+	return saveSubmittedSweep(ctx, tokenID, func(tx *ent.Tx) (*ent.Sweep, error) {
+		return tx.Sweep.
+			UpdateOne(sweepEntity).
+			SetStatus(sweep.StatusSubmitted).
+			SetTxHash(txHash).
+			SetSubmittedAt(time.Now()).
+			Save(ctx)
+	})
+}
+
+// saveSubmittedSweep saves a sweep that was sent on-chain and books the tokens leaving the receive address
+func saveSubmittedSweep(ctx context.Context, tokenID int, save func(tx *ent.Tx) (*ent.Sweep, error)) (*ent.Sweep, error) {
+	tx, err := storage.Client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("saveSubmittedSweep.db: %w", err)
+	}
+
+	sweepEntity, err := save(tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("saveSubmittedSweep.save: %w", err)
+	}
+
+	if err := ledger.Record(ctx, tx, ledger.Sweep(sweepEntity, tokenID)); err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("saveSubmittedSweep.ledger: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("saveSubmittedSweep.commit: %w", err)
+	}
+
+	return sweepEntity.Unwrap(), nil
+}
+
+// sweepTransaction builds the transfer of amount to toAddress, as a plain value transfer for native tokens
+func sweepTransaction(token *ent.Token, toAddress string, amount decimal.Decimal) (map[string]interface{}, error) {
+	value := utils.ToSubunit(amount, token.Decimals)
+
+	if utils.IsNativeToken(token.ContractAddress) {
+		return map[string]interface{}{
+			"to":    toAddress,
+			"data":  "0x",
+			"value": fmt.Sprintf("0x%x", value),
+		}, nil
+	}
+
+	erc20ABI, err := contracts.ERC20TokenMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ERC20 ABI: %w", err)
+	}
+
+	data, err := erc20ABI.Pack("transfer", common.HexToAddress(toAddress), value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack transfer ABI: %w", err)
+	}
+
+	return map[string]interface{}{
+		"to":    token.ContractAddress,
+		"data":  fmt.Sprintf("0x%x", data),
+		"value": "0",
+	}, nil
+}
@@ -0,0 +1,92 @@
+package services
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/utils"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSweepSigning(t *testing.T) {
+	userOp := map[string]interface{}{
+		"sender":               "0x3333333333333333333333333333333333333333",
+		"nonce":                "0x1",
+		"callData":             "0xb61d27f6",
+		"callGasLimit":         "0x186a0",
+		"verificationGasLimit": "0x186a0",
+		"preVerificationGas":   "0xc350",
+		"maxFeePerGas":         "0x3b9aca00",
+		"maxPriorityFeePerGas": "0x3b9aca00",
+	}
+
+	key, err := crypto.GenerateKey()
+	assert.NoError(t, err)
+	signer := crypto.PubkeyToAddress(key.PublicKey).Hex()
+
+	sig, err := crypto.Sign(accounts.TextHash(userOperationHash(84532, userOp).Bytes()), key)
+	assert.NoError(t, err)
+
+	t.Run("should accept a signature from the offline signer", func(t *testing.T) {
+		typed, err := verifyUserOperationSignature(84532, userOp, hexutil.Encode(sig), signer)
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(typed, "0x00"))
+		assert.Equal(t, typedUserOperationSignature(sig), typed)
+	})
+
+	t.Run("should accept a typed signature", func(t *testing.T) {
+		typed, err := verifyUserOperationSignature(84532, userOp, typedUserOperationSignature(sig), strings.ToLower(signer))
+		assert.NoError(t, err)
+		assert.Equal(t, typedUserOperationSignature(sig), typed)
+	})
+
+	t.Run("should reject a signature from another key", func(t *testing.T) {
+		_, err := verifyUserOperationSignature(84532, userOp, hexutil.Encode(sig), "0x5555555555555555555555555555555555555555")
+		assert.Error(t, err)
+	})
+
+	t.Run("should reject a signature over another chain", func(t *testing.T) {
+		_, err := verifyUserOperationSignature(8453, userOp, hexutil.Encode(sig), signer)
+		assert.Error(t, err)
+	})
+
+	t.Run("should reject a malformed signature", func(t *testing.T) {
+		_, err := verifyUserOperationSignature(84532, userOp, "0x1234", signer)
+		assert.Error(t, err)
+	})
+}
+
+func TestSweepTransaction(t *testing.T) {
+	to := "0x5555555555555555555555555555555555555555"
+
+	t.Run("should transfer ERC20 tokens", func(t *testing.T) {
+		token := &ent.Token{ContractAddress: "0x4444444444444444444444444444444444444444", Decimals: 6}
+
+		tx, err := sweepTransaction(token, to, decimal.NewFromFloat(12.5))
+		assert.NoError(t, err)
+		assert.Equal(t, token.ContractAddress, tx["to"])
+		assert.Equal(t, "0", tx["value"])
+
+		data := common.FromHex(tx["data"].(string))
+		assert.Equal(t, "a9059cbb", common.Bytes2Hex(data[:4]))
+		assert.Equal(t, common.HexToAddress(to), common.BytesToAddress(data[4:36]))
+		assert.Equal(t, int64(12500000), new(big.Int).SetBytes(data[36:68]).Int64())
+	})
+
+	t.Run("should transfer native tokens by value", func(t *testing.T) {
+		token := &ent.Token{ContractAddress: utils.NativeTokenAddress, Decimals: 18}
+
+		tx, err := sweepTransaction(token, to, decimal.NewFromFloat(0.25))
+		assert.NoError(t, err)
+		assert.Equal(t, to, tx["to"])
+		assert.Equal(t, "0x", tx["data"])
+		assert.Equal(t, "0x3782dace9d90000", tx["value"])
+	})
+}
@@ -0,0 +1,73 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	networkent "github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/services/contracts"
+	"github.com/NEDA-LABS/stablenode/utils"
+	"github.com/NEDA-LABS/stablenode/utils/ratelimit"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	// ErrTokenMetadataMismatch is returned when a token's symbol or decimals differ from its contract's
+	ErrTokenMetadataMismatch = errors.New("token metadata does not match the contract")
+	// ErrTokenContractUnreadable is returned when the token contract can't be called
+	ErrTokenContractUnreadable = errors.New("token contract could not be read")
+)
+
+// dialTokenContractCaller connects to the RPC endpoint of a network for read-only contract calls
+var dialTokenContractCaller = func(ctx context.Context, network *ent.Network) (bind.ContractCaller, error) {
+	return ratelimit.DialEthClient(ctx, utils.BuildRPCURL(network.RPCEndpoint))
+}
+
+// VerifyTokenMetadata checks the symbol and decimals of a token against its ERC20 contract through
+// eth_call. Native tokens and tokens on Tron and Solana have no ERC20 contract to check
+func VerifyTokenMetadata(ctx context.Context, network *ent.Network, contractAddress, symbol string, decimals int8) error {
+	if utils.IsNativeToken(contractAddress) || network.NetworkType != networkent.NetworkTypeEvm || strings.HasPrefix(network.Identifier, "tron") {
+		return nil
+	}
+
+	if !common.IsHexAddress(contractAddress) {
+		return fmt.Errorf("%w: %s is not a contract address", ErrTokenMetadataMismatch, contractAddress)
+	}
+
+	caller, err := dialTokenContractCaller(ctx, network)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrTokenContractUnreadable, err)
+	}
+
+	erc20, err := contracts.NewERC20TokenCaller(common.HexToAddress(contractAddress), caller)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrTokenContractUnreadable, err)
+	}
+
+	opts := &bind.CallOpts{Context: ctx}
+	onchainDecimals, err := erc20.Decimals(opts)
+	if err != nil {
+		return fmt.Errorf("%w: decimals: %v", ErrTokenContractUnreadable, err)
+	}
+	onchainSymbol, err := erc20.Symbol(opts)
+	if err != nil {
+		return fmt.Errorf("%w: symbol: %v", ErrTokenContractUnreadable, err)
+	}
+
+	var mismatches []string
+	if int(onchainDecimals) != int(decimals) {
+		mismatches = append(mismatches, fmt.Sprintf("contract has %d decimals", onchainDecimals))
+	}
+	if !strings.EqualFold(onchainSymbol, symbol) {
+		mismatches = append(mismatches, fmt.Sprintf("contract symbol is %s", onchainSymbol))
+	}
+	if len(mismatches) > 0 {
+		return fmt.Errorf("%w: %s", ErrTokenMetadataMismatch, strings.Join(mismatches, ", "))
+	}
+
+	return nil
+}
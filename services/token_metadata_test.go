@@ -0,0 +1,93 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	networkent "github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/services/contracts"
+	"github.com/NEDA-LABS/stablenode/utils"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubTokenContract answers the decimals and symbol calls of an ERC20 contract
+type stubTokenContract struct {
+	symbol   string
+	decimals uint8
+	err      error
+	calls    int
+}
+
+func (c *stubTokenContract) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return []byte{0x60}, nil
+}
+
+func (c *stubTokenContract) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	c.calls++
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	erc20, err := contracts.ERC20TokenMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	method, err := erc20.MethodById(call.Data[:4])
+	if err != nil {
+		return nil, err
+	}
+	switch method.Name {
+	case "decimals":
+		return method.Outputs.Pack(c.decimals)
+	case "symbol":
+		return method.Outputs.Pack(c.symbol)
+	}
+	return nil, errors.New("unexpected call")
+}
+
+func TestVerifyTokenMetadata(t *testing.T) {
+	ctx := context.Background()
+	contract := &stubTokenContract{symbol: "USDC", decimals: 6}
+	previous := dialTokenContractCaller
+	dialTokenContractCaller = func(ctx context.Context, network *ent.Network) (bind.ContractCaller, error) {
+		return contract, nil
+	}
+	defer func() { dialTokenContractCaller = previous }()
+
+	network := &ent.Network{Identifier: "base", NetworkType: networkent.NetworkTypeEvm}
+	usdc := "0x833589fCD6eDb6E08f4c7C32D4f71b54bdA02913"
+
+	t.Run("accepts metadata matching the contract", func(t *testing.T) {
+		require.NoError(t, VerifyTokenMetadata(ctx, network, usdc, "usdc", 6))
+	})
+
+	t.Run("rejects wrong decimals or symbol", func(t *testing.T) {
+		err := VerifyTokenMetadata(ctx, network, usdc, "USDT", 18)
+		assert.ErrorIs(t, err, ErrTokenMetadataMismatch)
+		assert.Contains(t, err.Error(), "contract has 6 decimals")
+		assert.Contains(t, err.Error(), "contract symbol is USDC")
+
+		assert.ErrorIs(t, VerifyTokenMetadata(ctx, network, "not-an-address", "USDC", 6), ErrTokenMetadataMismatch)
+	})
+
+	t.Run("reports contracts that can't be read", func(t *testing.T) {
+		contract.err = errors.New("execution reverted")
+		defer func() { contract.err = nil }()
+
+		assert.ErrorIs(t, VerifyTokenMetadata(ctx, network, usdc, "USDC", 6), ErrTokenContractUnreadable)
+	})
+
+	t.Run("skips tokens without an ERC20 contract", func(t *testing.T) {
+		calls := contract.calls
+		assert.NoError(t, VerifyTokenMetadata(ctx, network, utils.NativeTokenAddress, "ETH", 18))
+		assert.NoError(t, VerifyTokenMetadata(ctx, &ent.Network{Identifier: "tron-shasta", NetworkType: networkent.NetworkTypeEvm}, "TXYZopYRdj2D9XRtbG411XZZ3kM5VkAeBf", "USDT", 6))
+		assert.Equal(t, calls, contract.calls)
+	})
+}
@@ -0,0 +1,275 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/NEDA-LABS/stablenode/utils"
+	cryptoUtils "github.com/NEDA-LABS/stablenode/utils/crypto"
+	fastshot "github.com/opus-domini/fast-shot"
+	tronWallet "github.com/paycrest/tron-wallet"
+	tronEnums "github.com/paycrest/tron-wallet/enums"
+	"github.com/shopspring/decimal"
+)
+
+// tronTransferFeeLimit is the energy fee limit, in sun, of an outgoing TRC-20 transfer
+const tronTransferFeeLimit = 30000000
+
+// tronPageSize is the number of records requested per TronGrid page
+const tronPageSize = 200
+
+// tronAccount is an account returned by TronGrid /v1/accounts/{address}
+type tronAccount struct {
+	Balance int64               `json:"balance"`
+	TRC20   []map[string]string `json:"trc20"`
+}
+
+// tronTRC20Transfer is a transfer returned by TronGrid /v1/accounts/{address}/transactions/trc20
+type tronTRC20Transfer struct {
+	TransactionID string `json:"transaction_id"`
+	From          string `json:"from"`
+	To            string `json:"to"`
+	Type          string `json:"type"`
+	Value         string `json:"value"`
+	TokenInfo     struct {
+		Address string `json:"address"`
+	} `json:"token_info"`
+}
+
+// TronService reads TRX and TRC-20 balances and transfers of Tron receive addresses through the
+// TronGrid HTTP API of each network's RPC endpoint, and sends transfers out of them with their
+// stored private keys
+type TronService struct {
+	node     tronEnums.Node
+	apiKey   string
+	rpcCalls atomic.Int64
+}
+
+// NewTronService creates a new instance of TronService
+func NewTronService() *TronService {
+	node := tronEnums.SHASTA_NODE
+	if config.ServerConfig().Environment == "production" {
+		node = tronEnums.MAIN_NODE
+	}
+
+	return &TronService{
+		node:   node,
+		apiKey: config.OrderConfig().TronProApiKey,
+	}
+}
+
+// RPCCalls returns the number of TronGrid requests made
+func (s *TronService) RPCCalls() int64 {
+	return s.rpcCalls.Load()
+}
+
+// headers returns the headers of a TronGrid request
+func (s *TronService) headers() map[string]string {
+	headers := map[string]string{"Accept": "application/json"}
+	if s.apiKey != "" {
+		headers["TRON-PRO-API-KEY"] = s.apiKey
+	}
+	return headers
+}
+
+// get makes a TronGrid GET request and decodes the response into result
+func (s *TronService) get(network *ent.Network, path string, params map[string]string, result interface{}) error {
+	s.rpcCalls.Add(1)
+	res, err := fastshot.NewClient(network.RPCEndpoint).
+		Config().SetTimeout(15 * time.Second).
+		Header().AddAll(s.headers()).
+		Build().GET(path).
+		Query().AddParams(params).
+		Send()
+	if err != nil {
+		return err
+	}
+	defer res.RawResponse.Body.Close()
+
+	if res.RawResponse.StatusCode >= 400 {
+		return fmt.Errorf("TronGrid returned status %d", res.RawResponse.StatusCode)
+	}
+
+	return json.NewDecoder(res.RawResponse.Body).Decode(result)
+}
+
+// GetTokenBalances returns the balance of every given token held by each address, keyed by address
+// and then by token ID. Native tokens are read as the TRX balance. Accounts that were never
+// activated have no balances
+func (s *TronService) GetTokenBalances(ctx context.Context, network *ent.Network, addresses []string, tokens []*ent.Token) (map[string]map[int]decimal.Decimal, error) {
+	balances := make(map[string]map[int]decimal.Decimal, len(addresses))
+
+	for _, address := range addresses {
+		balances[address] = make(map[int]decimal.Decimal, len(tokens))
+
+		var response struct {
+			Data []tronAccount `json:"data"`
+		}
+		if err := s.get(network, "/v1/accounts/"+address, nil, &response); err != nil {
+			return nil, fmt.Errorf("failed to fetch account %s: %w", address, err)
+		}
+
+		for _, token := range tokens {
+			balances[address][token.ID] = decimal.Zero
+		}
+		if len(response.Data) == 0 {
+			continue
+		}
+		account := response.Data[0]
+
+		for _, token := range tokens {
+			if utils.IsNativeToken(token.ContractAddress) {
+				balances[address][token.ID] = utils.FromSubunit(big.NewInt(account.Balance), 6)
+				continue
+			}
+
+			for _, holding := range account.TRC20 {
+				raw, ok := holding[token.ContractAddress]
+				if !ok {
+					continue
+				}
+				amount, ok := new(big.Int).SetString(raw, 10)
+				if !ok {
+					return nil, fmt.Errorf("invalid %s balance %q of %s", token.Symbol, raw, address)
+				}
+				balances[address][token.ID] = utils.FromSubunit(amount, token.Decimals)
+			}
+		}
+	}
+
+	return balances, nil
+}
+
+// GetTokenTransfers returns the TRC-20 transfers to address confirmed since the given time. TronGrid
+// doesn't report block numbers in transfer history, so they are read from the transaction info
+func (s *TronService) GetTokenTransfers(ctx context.Context, network *ent.Network, token *ent.Token, address string, since time.Time) ([]*types.TokenTransferEvent, error) {
+	if utils.IsNativeToken(token.ContractAddress) {
+		return nil, fmt.Errorf("native TRX transfers are not supported")
+	}
+
+	params := map[string]string{
+		"only_to":          "true",
+		"only_confirmed":   "true",
+		"contract_address": token.ContractAddress,
+		"min_timestamp":    strconv.FormatInt(since.UnixMilli(), 10),
+		"order_by":         "block_timestamp,asc",
+		"limit":            strconv.Itoa(tronPageSize),
+	}
+
+	var transfers []*types.TokenTransferEvent
+	for {
+		var response struct {
+			Data []tronTRC20Transfer `json:"data"`
+			Meta struct {
+				Fingerprint string `json:"fingerprint"`
+			} `json:"meta"`
+		}
+		if err := s.get(network, "/v1/accounts/"+address+"/transactions/trc20", params, &response); err != nil {
+			return nil, fmt.Errorf("failed to fetch transfers of %s: %w", address, err)
+		}
+
+		for _, transfer := range response.Data {
+			if transfer.Type != "Transfer" || transfer.To != address ||
+				!strings.EqualFold(transfer.TokenInfo.Address, token.ContractAddress) {
+				continue
+			}
+
+			value, ok := new(big.Int).SetString(transfer.Value, 10)
+			if !ok {
+				return nil, fmt.Errorf("invalid transfer value %q in %s", transfer.Value, transfer.TransactionID)
+			}
+
+			blockNumber, err := s.blockNumber(network, transfer.TransactionID)
+			if err != nil {
+				return nil, err
+			}
+
+			transfers = append(transfers, &types.TokenTransferEvent{
+				BlockNumber: blockNumber,
+				TxHash:      transfer.TransactionID,
+				From:        transfer.From,
+				To:          transfer.To,
+				Value:       utils.FromSubunit(value, token.Decimals),
+			})
+		}
+
+		if response.Meta.Fingerprint == "" || len(response.Data) < tronPageSize {
+			break
+		}
+		params["fingerprint"] = response.Meta.Fingerprint
+	}
+
+	return transfers, nil
+}
+
+// blockNumber returns the block a transaction was included in
+func (s *TronService) blockNumber(network *ent.Network, txID string) (int64, error) {
+	s.rpcCalls.Add(1)
+	res, err := fastshot.NewClient(network.RPCEndpoint).
+		Config().SetTimeout(15 * time.Second).
+		Header().AddAll(s.headers()).
+		Build().POST("/wallet/gettransactioninfobyid").
+		Body().AsJSON(map[string]interface{}{"value": txID}).
+		Send()
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch transaction %s: %w", txID, err)
+	}
+	defer res.RawResponse.Body.Close()
+
+	var info struct {
+		BlockNumber int64 `json:"blockNumber"`
+	}
+	if err := json.NewDecoder(res.RawResponse.Body).Decode(&info); err != nil {
+		return 0, fmt.Errorf("failed to decode transaction %s: %w", txID, err)
+	}
+	return info.BlockNumber, nil
+}
+
+// Transfer sends amount of a TRC-20 token, or TRX for native tokens, from a Tron receive address to
+// toAddress, signing with the address's stored private key. Returns the transaction ID
+func (s *TronService) Transfer(ctx context.Context, receiveAddress *ent.ReceiveAddress, token *ent.Token, toAddress string, amount decimal.Decimal) (string, error) {
+	if !utils.IsValidTronAddress(toAddress) {
+		return "", fmt.Errorf("invalid Tron address %s", toAddress)
+	}
+
+	privateKey, err := cryptoUtils.DecryptPlain(receiveAddress.Salt)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt private key of %s: %w", receiveAddress.Address, err)
+	}
+
+	wallet, err := tronWallet.CreateTronWallet(s.node, string(privateKey))
+	if err != nil {
+		return "", fmt.Errorf("failed to load wallet of %s: %w", receiveAddress.Address, err)
+	}
+	if wallet.AddressBase58 != receiveAddress.Address {
+		return "", fmt.Errorf("stored key doesn't match receive address %s", receiveAddress.Address)
+	}
+
+	if utils.IsNativeToken(token.ContractAddress) {
+		txID, err := wallet.Transfer(toAddress, utils.ToSubunit(amount, 6).Int64())
+		if err != nil {
+			return "", fmt.Errorf("failed to transfer TRX from %s: %w", receiveAddress.Address, err)
+		}
+		return txID, nil
+	}
+
+	txID, err := wallet.TransferTRC20(
+		&tronWallet.Token{ContractAddress: tronEnums.ContractAddress(token.ContractAddress)},
+		toAddress,
+		utils.ToSubunit(amount, token.Decimals).Int64(),
+		tronTransferFeeLimit,
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to transfer %s from %s: %w", token.Symbol, receiveAddress.Address, err)
+	}
+	return txID, nil
+}
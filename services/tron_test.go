@@ -0,0 +1,116 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/utils"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testTronAddress = "TXYZopYRdj2D9XRtbG411XZZ3kM5VkAeBf"
+	testTronUSDT    = "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t"
+)
+
+func TestTronService(t *testing.T) {
+	var minTimestamps []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "test-key", r.Header.Get("TRON-PRO-API-KEY"))
+
+		var response interface{}
+		switch r.URL.Path {
+		case "/v1/accounts/" + testTronAddress:
+			response = map[string]interface{}{
+				"data": []map[string]interface{}{{
+					"balance": 2500000,
+					"trc20":   []map[string]string{{testTronUSDT: "12500000"}},
+				}},
+			}
+		case "/v1/accounts/TUnactivatedAddress":
+			response = map[string]interface{}{"data": []interface{}{}}
+		case "/v1/accounts/" + testTronAddress + "/transactions/trc20":
+			assert.Equal(t, "true", r.URL.Query().Get("only_to"))
+			assert.Equal(t, testTronUSDT, r.URL.Query().Get("contract_address"))
+			minTimestamps = append(minTimestamps, r.URL.Query().Get("min_timestamp"))
+
+			response = map[string]interface{}{
+				"data": []map[string]interface{}{
+					{
+						"transaction_id": "a1",
+						"from":           "TSender1111111111111111111111111",
+						"to":             testTronAddress,
+						"type":           "Transfer",
+						"value":          "10000000",
+						"token_info":     map[string]string{"address": testTronUSDT},
+					},
+					{
+						"transaction_id": "a2",
+						"from":           "TSender1111111111111111111111111",
+						"to":             testTronAddress,
+						"type":           "Approval",
+						"value":          "5000000",
+						"token_info":     map[string]string{"address": testTronUSDT},
+					},
+				},
+				"meta": map[string]interface{}{},
+			}
+		case "/wallet/gettransactioninfobyid":
+			var body map[string]string
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "a1", body["value"])
+			response = map[string]interface{}{"id": "a1", "blockNumber": 5120}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		assert.NoError(t, json.NewEncoder(w).Encode(response))
+	}))
+	defer server.Close()
+
+	service := &TronService{apiKey: "test-key"}
+	network := &ent.Network{Identifier: "tron-shasta", RPCEndpoint: server.URL}
+	usdt := &ent.Token{ID: 1, Symbol: "USDT", ContractAddress: testTronUSDT, Decimals: 6}
+	trx := &ent.Token{ID: 2, Symbol: "TRX", ContractAddress: utils.NativeTokenAddress, Decimals: 6}
+	ctx := context.Background()
+
+	t.Run("reads TRC-20 and TRX balances", func(t *testing.T) {
+		balances, err := service.GetTokenBalances(ctx, network, []string{testTronAddress, "TUnactivatedAddress"}, []*ent.Token{usdt, trx})
+		assert.NoError(t, err)
+		assert.True(t, balances[testTronAddress][usdt.ID].Equal(decimal.NewFromFloat(12.5)))
+		assert.True(t, balances[testTronAddress][trx.ID].Equal(decimal.NewFromFloat(2.5)))
+		assert.True(t, balances["TUnactivatedAddress"][usdt.ID].IsZero())
+		assert.Equal(t, int64(2), service.RPCCalls())
+	})
+
+	t.Run("finds TRC-20 transfers with their block numbers", func(t *testing.T) {
+		since := time.UnixMilli(1700000000000)
+		transfers, err := service.GetTokenTransfers(ctx, network, usdt, testTronAddress, since)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"1700000000000"}, minTimestamps)
+
+		assert.Len(t, transfers, 1)
+		assert.Equal(t, "a1", transfers[0].TxHash)
+		assert.Equal(t, int64(5120), transfers[0].BlockNumber)
+		assert.Equal(t, testTronAddress, transfers[0].To)
+		assert.True(t, transfers[0].Value.Equal(decimal.NewFromInt(10)))
+	})
+
+	t.Run("rejects native transfer history", func(t *testing.T) {
+		_, err := service.GetTokenTransfers(ctx, network, trx, testTronAddress, time.Now())
+		assert.Error(t, err)
+	})
+
+	t.Run("rejects transfers to invalid addresses", func(t *testing.T) {
+		_, err := service.Transfer(ctx, &ent.ReceiveAddress{Address: testTronAddress}, usdt, "0x1111111111111111111111111111111111111111", decimal.NewFromInt(1))
+		assert.Error(t, err)
+	})
+}
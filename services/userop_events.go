@@ -0,0 +1,193 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	networkent "github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/ent/outboxtransaction"
+	"github.com/NEDA-LABS/stablenode/services/events"
+	"github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+)
+
+// entryPointV07 is the ERC-4337 EntryPoint the aggregator's UserOperations are sent through
+var entryPointV07 = common.HexToAddress("0x0000000071727De22E5E9d8baF0edAc6f37da032")
+
+// logReader reads blocks and event logs of a network; BlockchainProvider implements it
+type logReader interface {
+	GetLatestBlock(ctx context.Context, network *ent.Network) (int64, error)
+	GetLogs(ctx context.Context, network *ent.Network, query ethereum.FilterQuery) ([]ethtypes.Log, error)
+}
+
+// userOperationEvents returns the UserOperationEvent logs the EntryPoint emitted in the last
+// lookback blocks for any of the given UserOperations, keyed by lowercase UserOperation hash
+func userOperationEvents(ctx context.Context, reader logReader, network *ent.Network, userOpHashes []string, lookback int64) (map[string]*events.UserOperation, error) {
+	if len(userOpHashes) == 0 {
+		return nil, nil
+	}
+
+	latest, err := reader.GetLatestBlock(ctx, network)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest block: %w", err)
+	}
+	fromBlock := latest - lookback
+	if fromBlock < 0 {
+		fromBlock = 0
+	}
+
+	hashes := make([]common.Hash, len(userOpHashes))
+	for i, hash := range userOpHashes {
+		hashes[i] = common.HexToHash(hash)
+	}
+
+	logs, err := reader.GetLogs(ctx, network, ethereum.FilterQuery{
+		FromBlock: big.NewInt(fromBlock),
+		ToBlock:   big.NewInt(latest),
+		Addresses: []common.Address{entryPointV07},
+		Topics:    [][]common.Hash{{events.Topic(events.EntryPoint, "UserOperationEvent")}, hashes},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get UserOperation events: %w", err)
+	}
+
+	decoded, err := events.Default().DecodeLogs(logs)
+	if err != nil {
+		return nil, err
+	}
+
+	found := make(map[string]*events.UserOperation, len(decoded))
+	for _, event := range decoded {
+		if op, ok := event.(*events.UserOperation); ok {
+			found[strings.ToLower(common.Hash(op.UserOpHash).Hex())] = op
+		}
+	}
+	return found, nil
+}
+
+// isUserOperationHash reports whether a transaction ID is a UserOperation hash rather than an
+// Engine queue ID
+func isUserOperationHash(transactionID string) bool {
+	return len(transactionID) == 66 && strings.HasPrefix(transactionID, "0x")
+}
+
+// executedUserOperations finds the sent transactions whose UserOperation, or a replacement of it,
+// the EntryPoint executed. Networks whose events can't be read are left to the active service's
+// status checks
+func (w *OutboxWorker) executedUserOperations(ctx context.Context, sent []*ent.OutboxTransaction) map[uuid.UUID]*events.UserOperation {
+	if w.logs == nil {
+		return nil
+	}
+
+	byNetwork := make(map[string][]*ent.OutboxTransaction)
+	for _, transaction := range sent {
+		if isUserOperationHash(transaction.TransactionID) {
+			byNetwork[transaction.Network] = append(byNetwork[transaction.Network], transaction)
+		}
+	}
+
+	executed := make(map[uuid.UUID]*events.UserOperation)
+	for identifier, transactions := range byNetwork {
+		network, err := storage.Client.Network.
+			Query().
+			Where(networkent.IdentifierEQ(identifier)).
+			Only(ctx)
+		if err != nil {
+			logger.WithFields(logger.Fields{
+				"Error":   fmt.Sprintf("%v", err),
+				"Network": identifier,
+			}).Warnf("Failed to fetch network of outbox transactions")
+			continue
+		}
+
+		attemptOf := make(map[string]uuid.UUID)
+		var hashes []string
+		for _, transaction := range transactions {
+			attempts, err := userOperationAttempts(ctx, transaction.TransactionID)
+			if err != nil {
+				attempts = []string{transaction.TransactionID}
+			}
+			for _, hash := range attempts {
+				attemptOf[strings.ToLower(hash)] = transaction.ID
+				hashes = append(hashes, hash)
+			}
+		}
+
+		found, err := userOperationEvents(ctx, w.logs, network, hashes, w.config.EventLookbackBlocks)
+		if err != nil {
+			logger.WithFields(logger.Fields{
+				"Error":   fmt.Sprintf("%v", err),
+				"Network": identifier,
+			}).Warnf("Failed to read UserOperation events of outbox transactions")
+			continue
+		}
+
+		// Attempts share a nonce, so at most one of them succeeds
+		for hash, event := range found {
+			id := attemptOf[hash]
+			if previous, ok := executed[id]; !ok || !previous.Success {
+				executed[id] = event
+			}
+		}
+	}
+
+	return executed
+}
+
+// recordUserOperationEvent records the gas an executed UserOperation used on its transaction. A
+// successful operation confirms the transaction; a reverted one is retried like a failed send
+func (w *OutboxWorker) recordUserOperationEvent(ctx context.Context, transaction *ent.OutboxTransaction, event *events.UserOperation) {
+	gasCost := decimal.NewFromBigInt(event.ActualGasCost, -18)
+	update := transaction.Update().
+		SetGasUsed(transaction.GasUsed + event.ActualGasUsed.Int64()).
+		SetGasCost(transaction.GasCost.Add(gasCost))
+	if event.Success {
+		update.
+			SetStatus(outboxtransaction.StatusConfirmed).
+			SetTxHash(event.Raw.TxHash.Hex())
+	}
+
+	updated, err := update.Save(ctx)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":  fmt.Sprintf("%v", err),
+			"ID":     transaction.ID,
+			"TxHash": event.Raw.TxHash.Hex(),
+		}).Errorf("Failed to record UserOperation event of outbox transaction")
+		return
+	}
+
+	if err := recordUserOperationEventGas(ctx, event); err != nil && !ent.IsNotFound(err) {
+		logger.WithFields(logger.Fields{
+			"Error":      fmt.Sprintf("%v", err),
+			"UserOpHash": common.Hash(event.UserOpHash).Hex(),
+		}).Warnf("Failed to record gas of executed UserOperation on its transaction log")
+	}
+
+	fields := logger.Fields{
+		"ID":          transaction.ID,
+		"Kind":        transaction.Kind,
+		"LockOrderID": transaction.LockOrderID,
+		"Network":     transaction.Network,
+		"UserOpHash":  common.Hash(event.UserOpHash).Hex(),
+		"TxHash":      event.Raw.TxHash.Hex(),
+		"GasUsed":     event.ActualGasUsed.String(),
+		"GasCost":     gasCost.String(),
+	}
+	if event.Success {
+		logger.WithFields(fields).Infof("Outbox transaction confirmed by its UserOperation event")
+		return
+	}
+
+	logger.WithFields(fields).Warnf("Outbox transaction UserOperation reverted on-chain")
+	w.retry(ctx, updated, updated.Attempts, fmt.Errorf("UserOperation %s reverted in transaction %s", common.Hash(event.UserOpHash).Hex(), event.Raw.TxHash.Hex()))
+}
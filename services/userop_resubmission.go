@@ -0,0 +1,364 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
+	"github.com/NEDA-LABS/stablenode/services/gasoracle"
+	"github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/aaerrors"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/NEDA-LABS/stablenode/utils/metrics"
+)
+
+// userOperationSender sends UserOperations and reads their receipts; AlchemyService implements it
+type userOperationSender interface {
+	GetUserOperationReceipt(ctx context.Context, chainID int64, userOpHash string) (map[string]interface{}, error)
+	suggestFees(ctx context.Context, chainID int64, urgency gasoracle.Urgency) (*gasoracle.Fees, error)
+	resendUserOperation(ctx context.Context, chainID int64, userOp map[string]interface{}) (map[string]interface{}, string, error)
+}
+
+// UserOperationResubmitter replaces UserOperations left unmined with copies paying higher fees, so
+// settlements and refunds are not dropped during gas spikes. Every submission is logged as a
+// user_operation_sent transaction log, linked to the log of the attempt replacing it
+type UserOperationResubmitter struct {
+	config *config.UserOperationResubmissionConfiguration
+	sender userOperationSender
+	now    func() time.Time
+}
+
+// NewUserOperationResubmitter creates a new UserOperation resubmitter
+func NewUserOperationResubmitter() *UserOperationResubmitter {
+	return &UserOperationResubmitter{
+		config: config.UserOperationResubmissionConfig(),
+		sender: NewAlchemyService(),
+		now:    time.Now,
+	}
+}
+
+// ResubmitStale checks the UserOperations awaiting inclusion. Mined operations get the hash of the
+// transaction that included them; operations unmined for StaleAfter are replaced, up to MaxAttempts.
+// Operations are given up on TrackFor after their last attempt
+func (r *UserOperationResubmitter) ResubmitStale(ctx context.Context) error {
+	pending, err := storage.Client.TransactionLog.
+		Query().
+		Where(
+			transactionlog.StatusEQ(transactionlog.StatusUserOperationSent),
+			transactionlog.Or(transactionlog.TxHashIsNil(), transactionlog.TxHashEQ("")),
+			transactionlog.Not(transactionlog.HasReplacedBy()),
+			transactionlog.CreatedAtGT(r.now().Add(-r.config.TrackFor)),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("ResubmitStale.fetchPending: %w", err)
+	}
+
+	for _, log := range pending {
+		// Stop resubmitting once the run is cancelled
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := r.check(ctx, log); err != nil {
+			logger.WithFields(logger.Fields{
+				"Error":      fmt.Sprintf("%v", err),
+				"UserOpHash": log.Metadata["UserOpHash"],
+				"Attempt":    log.Metadata["Attempt"],
+			}).Errorf("Failed to resubmit UserOperation")
+		}
+	}
+
+	return nil
+}
+
+// check records a mined UserOperation or replaces it once stale. latest is the log of the
+// operation's latest attempt
+func (r *UserOperationResubmitter) check(ctx context.Context, latest *ent.TransactionLog) error {
+	chainID := int64(metadataNumber(latest.Metadata["ChainID"]))
+
+	// Any earlier attempt may have been mined instead of its replacement
+	for attempt := latest; attempt != nil; {
+		userOpHash, _ := attempt.Metadata["UserOpHash"].(string)
+		receipt, err := r.sender.GetUserOperationReceipt(ctx, chainID, userOpHash)
+		if err == nil && receipt != nil {
+			return markUserOperationMined(ctx, latest, userOpHash, receipt)
+		}
+
+		attempt, err = attempt.QueryReplaces().Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return fmt.Errorf("failed to fetch replaced attempt: %w", err)
+		}
+	}
+
+	if r.now().Sub(latest.CreatedAt) < r.config.StaleAfter {
+		return nil
+	}
+
+	attempts := int(metadataNumber(latest.Metadata["Attempt"]))
+	if attempts >= r.config.MaxAttempts {
+		logger.WithFields(logger.Fields{
+			"UserOpHash": latest.Metadata["UserOpHash"],
+			"ChainID":    chainID,
+			"Attempts":   attempts,
+		}).Warnf("UserOperation still unmined after its last resubmission")
+		return nil
+	}
+
+	return r.replace(ctx, latest, chainID)
+}
+
+// replace sends a copy of a stale UserOperation with fees raised by at least FeeBumpPercent, or to
+// the gas oracle's fast suggestion when that is higher
+func (r *UserOperationResubmitter) replace(ctx context.Context, latest *ent.TransactionLog, chainID int64) error {
+	stale, ok := latest.Metadata["UserOperation"].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("transaction log %s has no UserOperation", latest.ID)
+	}
+
+	maxFeePerGas, err := hexutil.DecodeBig(fmt.Sprintf("%v", stale["maxFeePerGas"]))
+	if err != nil {
+		return fmt.Errorf("invalid maxFeePerGas: %w", err)
+	}
+	maxPriorityFeePerGas, err := hexutil.DecodeBig(fmt.Sprintf("%v", stale["maxPriorityFeePerGas"]))
+	if err != nil {
+		return fmt.Errorf("invalid maxPriorityFeePerGas: %w", err)
+	}
+
+	maxFeePerGas = bumpFee(maxFeePerGas, r.config.FeeBumpPercent)
+	maxPriorityFeePerGas = bumpFee(maxPriorityFeePerGas, r.config.FeeBumpPercent)
+	if fees, err := r.sender.suggestFees(ctx, chainID, gasoracle.UrgencyFast); err == nil {
+		if fees.MaxFeePerGas.Cmp(maxFeePerGas) > 0 {
+			maxFeePerGas = fees.MaxFeePerGas
+		}
+		if fees.MaxPriorityFeePerGas.Cmp(maxPriorityFeePerGas) > 0 {
+			maxPriorityFeePerGas = fees.MaxPriorityFeePerGas
+		}
+	}
+
+	replacement := make(map[string]interface{}, len(stale))
+	for key, value := range stale {
+		replacement[key] = value
+	}
+	replacement["maxFeePerGas"] = hexutil.EncodeBig(maxFeePerGas)
+	replacement["maxPriorityFeePerGas"] = hexutil.EncodeBig(maxPriorityFeePerGas)
+	replacement["signature"] = "0x"
+
+	signed, userOpHash, err := r.sender.resendUserOperation(ctx, chainID, replacement)
+	if errors.Is(err, aaerrors.ErrInvalidNonce) {
+		// The nonce was used, so an earlier attempt was mined and its receipt isn't indexed yet
+		logger.WithFields(logger.Fields{
+			"UserOpHash": latest.Metadata["UserOpHash"],
+			"ChainID":    chainID,
+		}).Infof("UserOperation nonce already used, waiting for the mined attempt's receipt")
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to send replacement: %w", err)
+	}
+	metrics.UserOperationResubmitted(chainID)
+
+	replacementLog, err := recordUserOperation(ctx, chainID, signed, userOpHash, latest)
+	if err != nil {
+		return fmt.Errorf("failed to log replacement %s: %w", userOpHash, err)
+	}
+
+	logger.WithFields(logger.Fields{
+		"ChainID":              chainID,
+		"ReplacedUserOpHash":   latest.Metadata["UserOpHash"],
+		"UserOpHash":           userOpHash,
+		"Attempt":              replacementLog.Metadata["Attempt"],
+		"MaxFeePerGas":         maxFeePerGas.String(),
+		"MaxPriorityFeePerGas": maxPriorityFeePerGas.String(),
+	}).Infof("Resubmitted stale UserOperation with bumped fees")
+
+	return nil
+}
+
+// recordUserOperation logs a UserOperation sent on a chain so it can be replaced if left unmined,
+// with what its gas is spent on. replaces is the log of the attempt it replaces, nil for a first
+// submission
+func recordUserOperation(ctx context.Context, chainID int64, userOp map[string]interface{}, userOpHash string, replaces *ent.TransactionLog) (*ent.TransactionLog, error) {
+	attempt := 1
+	if replaces != nil {
+		attempt = int(metadataNumber(replaces.Metadata["Attempt"])) + 1
+	}
+
+	create := storage.Client.TransactionLog.
+		Create().
+		SetStatus(transactionlog.StatusUserOperationSent).
+		SetMetadata(map[string]interface{}{
+			"UserOpHash":    userOpHash,
+			"ChainID":       chainID,
+			"Sender":        userOp["sender"],
+			"Nonce":         userOp["nonce"],
+			"Attempt":       attempt,
+			"UserOperation": userOp,
+		})
+
+	net, err := storage.Client.Network.
+		Query().
+		Where(network.ChainIDEQ(chainID)).
+		Only(ctx)
+	if err == nil {
+		create.SetNetwork(net.Identifier)
+	}
+
+	// Replacements spend gas on what the operation they replace was sent for
+	sponsored := isSponsored(userOp)
+	create.SetSponsored(sponsored)
+	if replaces != nil {
+		create.
+			SetReplaces(replaces).
+			SetNillablePurpose(replaces.Purpose).
+			SetNillablePaymentOrderID(replaces.PaymentOrderID).
+			SetGasPolicyID(replaces.GasPolicyID)
+	} else {
+		if spend, ok := gasSpendFrom(ctx, userOp); ok {
+			create.
+				SetPurpose(spend.purpose).
+				SetNillablePaymentOrderID(spend.paymentOrderID)
+		}
+		if sponsored {
+			create.SetGasPolicyID(config.AlchemyConfig().GasPolicyID)
+		}
+	}
+
+	return create.Save(ctx)
+}
+
+// markUserOperationMined records the transaction that included an attempt of a UserOperation, and
+// the gas it was charged, on the log of its latest attempt
+func markUserOperationMined(ctx context.Context, latest *ent.TransactionLog, userOpHash string, receipt map[string]interface{}) error {
+	txHash, _ := receipt["transactionHash"].(string)
+	if inner, ok := receipt["receipt"].(map[string]interface{}); ok {
+		if hash, ok := inner["transactionHash"].(string); ok {
+			txHash = hash
+		}
+	}
+
+	metadata := make(map[string]interface{}, len(latest.Metadata)+2)
+	for key, value := range latest.Metadata {
+		metadata[key] = value
+	}
+	metadata["MinedUserOpHash"] = userOpHash
+	metadata["Success"] = receipt["success"]
+
+	update := latest.Update().
+		SetTxHash(txHash).
+		SetMetadata(metadata)
+	if gasUsed, gasCost, err := receiptGas(receipt); err == nil {
+		paymaster, _ := receipt["paymaster"].(string)
+		setUserOperationGas(update, gasUsed, gasCost, paymaster)
+	} else {
+		logger.WithFields(logger.Fields{
+			"Error":      fmt.Sprintf("%v", err),
+			"UserOpHash": userOpHash,
+		}).Warnf("Failed to read gas of mined UserOperation")
+	}
+
+	_, err := update.Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to record mined UserOperation: %w", err)
+	}
+
+	if userOpHash != latest.Metadata["UserOpHash"] {
+		logger.WithFields(logger.Fields{
+			"UserOpHash":      latest.Metadata["UserOpHash"],
+			"MinedUserOpHash": userOpHash,
+			"TransactionHash": txHash,
+		}).Infof("Earlier attempt of resubmitted UserOperation was mined")
+	}
+
+	return nil
+}
+
+// bumpFee raises a fee by percent, rounding up
+func bumpFee(fee *big.Int, percent int64) *big.Int {
+	bumped := new(big.Int).Mul(fee, big.NewInt(100+percent))
+	bumped.Add(bumped, big.NewInt(99))
+	return bumped.Div(bumped, big.NewInt(100))
+}
+
+// metadataNumber reads a number from transaction log metadata, which comes back from the
+// database as float64
+func metadataNumber(value interface{}) float64 {
+	switch v := value.(type) {
+	case float64:
+		return v
+	case int:
+		return float64(v)
+	case int64:
+		return float64(v)
+	}
+	return 0
+}
+
+// userOperationLog returns the log of the attempt of a UserOperation with the given hash
+func userOperationLog(ctx context.Context, userOpHash string) (*ent.TransactionLog, error) {
+	return storage.Client.TransactionLog.
+		Query().
+		Where(
+			transactionlog.StatusEQ(transactionlog.StatusUserOperationSent),
+			func(s *sql.Selector) {
+				s.Where(sqljson.ValueEQ(transactionlog.FieldMetadata, userOpHash, sqljson.Path("UserOpHash")))
+			},
+		).
+		Only(ctx)
+}
+
+// minedUserOperationTxHash returns the hash of the transaction that included a UserOperation or
+// any of its replacements, or an empty hash while none is mined
+func minedUserOperationTxHash(ctx context.Context, userOpHash string) (string, error) {
+	log, err := userOperationLog(ctx, userOpHash)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch UserOperation log: %w", err)
+	}
+
+	// The mined transaction is recorded on the log of the latest attempt
+	for {
+		replacement, err := log.QueryReplacedBy().Only(ctx)
+		if ent.IsNotFound(err) {
+			return log.TxHash, nil
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to fetch replacement: %w", err)
+		}
+		log = replacement
+	}
+}
+
+// userOperationAttempts returns the hash of a UserOperation followed by the hashes of the
+// replacements sent for it. A UserOperation that was never logged has no known replacements
+func userOperationAttempts(ctx context.Context, userOpHash string) ([]string, error) {
+	hashes := []string{userOpHash}
+
+	log, err := userOperationLog(ctx, userOpHash)
+	if ent.IsNotFound(err) {
+		return hashes, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch UserOperation log: %w", err)
+	}
+
+	for {
+		log, err = log.QueryReplacedBy().Only(ctx)
+		if ent.IsNotFound(err) {
+			return hashes, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch replacement: %w", err)
+		}
+		if hash, ok := log.Metadata["UserOpHash"].(string); ok {
+			hashes = append(hashes, hash)
+		}
+	}
+}
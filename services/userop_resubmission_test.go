@@ -0,0 +1,171 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
+	"github.com/NEDA-LABS/stablenode/services/gasoracle"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/aaerrors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// stubBundler mines the UserOperations in mined and records the replacements sent to it, or rejects
+// them with err
+type stubBundler struct {
+	mined map[string]string
+	fees  *gasoracle.Fees
+	sent  []map[string]interface{}
+	err   error
+}
+
+func (b *stubBundler) GetUserOperationReceipt(ctx context.Context, chainID int64, userOpHash string) (map[string]interface{}, error) {
+	txHash, ok := b.mined[userOpHash]
+	if !ok {
+		return nil, fmt.Errorf("user operation not found or not mined yet")
+	}
+	return map[string]interface{}{
+		"userOpHash":    userOpHash,
+		"success":       true,
+		"actualGasUsed": "0x30d40",          // 200000
+		"actualGasCost": "0x2386f26fc10000", // 0.01 ETH
+		"paymaster":     "0x2cc0c7981D846b9F2a16276556f6e8cb52BfB633",
+		"receipt":       map[string]interface{}{"transactionHash": txHash},
+	}, nil
+}
+
+func (b *stubBundler) suggestFees(ctx context.Context, chainID int64, urgency gasoracle.Urgency) (*gasoracle.Fees, error) {
+	if b.fees == nil {
+		return nil, gasoracle.ErrNoFeeMarket
+	}
+	return b.fees, nil
+}
+
+func (b *stubBundler) resendUserOperation(ctx context.Context, chainID int64, userOp map[string]interface{}) (map[string]interface{}, string, error) {
+	if b.err != nil {
+		return nil, "", b.err
+	}
+	b.sent = append(b.sent, userOp)
+	userOp["signature"] = "0xsigned"
+	return userOp, fmt.Sprintf("0xreplacement%d", len(b.sent)), nil
+}
+
+func TestUserOperationResubmitter(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:userops?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	ctx := context.Background()
+	now := time.Now()
+	bundler := &stubBundler{mined: map[string]string{}}
+	resubmitter := &UserOperationResubmitter{
+		config: &config.UserOperationResubmissionConfiguration{
+			StaleAfter:     2 * time.Minute,
+			FeeBumpPercent: 15,
+			MaxAttempts:    3,
+			TrackFor:       time.Hour,
+		},
+		sender: bundler,
+		now:    func() time.Time { return now },
+	}
+
+	userOp := map[string]interface{}{
+		"sender":               "0x3333333333333333333333333333333333333333",
+		"nonce":                "0x7",
+		"callData":             "0xb61d27f6",
+		"maxFeePerGas":         "0x3b9aca00", // 1 gwei
+		"maxPriorityFeePerGas": "0x5f5e100",  // 0.1 gwei
+		"signature":            "0xoriginal",
+		"paymaster":            "0x2cc0c7981D846b9F2a16276556f6e8cb52BfB633",
+	}
+	orderID := uuid.New()
+	first, err := recordUserOperation(WithGasSpend(ctx, transactionlog.PurposeSettlement, &orderID), 84532, userOp, "0xoriginal", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, transactionlog.PurposeSettlement, *first.Purpose)
+	assert.True(t, first.Sponsored)
+
+	latest := func() *ent.TransactionLog {
+		return client.TransactionLog.
+			Query().
+			Where(
+				transactionlog.StatusEQ(transactionlog.StatusUserOperationSent),
+				transactionlog.Not(transactionlog.HasReplacedBy()),
+			).
+			OnlyX(ctx)
+	}
+
+	t.Run("should leave operations alone until they are stale", func(t *testing.T) {
+		assert.NoError(t, resubmitter.ResubmitStale(ctx))
+		assert.Empty(t, bundler.sent)
+	})
+
+	t.Run("should replace stale operations with bumped fees", func(t *testing.T) {
+		now = now.Add(3 * time.Minute)
+		assert.NoError(t, resubmitter.ResubmitStale(ctx))
+		assert.Len(t, bundler.sent, 1)
+
+		replacement := bundler.sent[0]
+		assert.Equal(t, "0x7", replacement["nonce"])
+		assert.Equal(t, "0x448b9b80", replacement["maxFeePerGas"])        // 1.15 gwei
+		assert.Equal(t, "0x6dac2c0", replacement["maxPriorityFeePerGas"]) // 0.115 gwei
+
+		log := latest()
+		assert.Equal(t, "0xreplacement1", log.Metadata["UserOpHash"])
+		assert.Equal(t, float64(2), log.Metadata["Attempt"])
+		assert.Equal(t, first.ID, log.QueryReplaces().OnlyX(ctx).ID)
+
+		// Replacements spend gas on what the original was sent for
+		assert.Equal(t, transactionlog.PurposeSettlement, *log.Purpose)
+		assert.Equal(t, orderID, *log.PaymentOrderID)
+		assert.True(t, log.Sponsored)
+		assert.Nil(t, log.GasUsed)
+	})
+
+	t.Run("should wait for the receipt when the nonce was already used", func(t *testing.T) {
+		bundler.err = fmt.Errorf("user operation failed: %w", aaerrors.Parse(map[string]interface{}{"message": "AA25 invalid account nonce"}))
+		defer func() { bundler.err = nil }()
+		now = now.Add(3 * time.Minute)
+
+		assert.NoError(t, resubmitter.ResubmitStale(ctx))
+		assert.Len(t, bundler.sent, 1)
+		assert.Equal(t, "0xreplacement1", latest().Metadata["UserOpHash"])
+	})
+
+	t.Run("should pay the oracle's fast fees when they are higher", func(t *testing.T) {
+		bundler.fees = &gasoracle.Fees{MaxFeePerGas: big.NewInt(3000000000), MaxPriorityFeePerGas: big.NewInt(1)}
+		now = now.Add(3 * time.Minute)
+
+		assert.NoError(t, resubmitter.ResubmitStale(ctx))
+		assert.Len(t, bundler.sent, 2)
+		assert.Equal(t, "0xb2d05e00", bundler.sent[1]["maxFeePerGas"])        // 3 gwei
+		assert.Equal(t, "0x7e1f990", bundler.sent[1]["maxPriorityFeePerGas"]) // 0.13225 gwei
+	})
+
+	t.Run("should stop after the last attempt", func(t *testing.T) {
+		now = now.Add(3 * time.Minute)
+
+		assert.NoError(t, resubmitter.ResubmitStale(ctx))
+		assert.Len(t, bundler.sent, 2)
+	})
+
+	t.Run("should record the transaction of whichever attempt was mined", func(t *testing.T) {
+		bundler.mined["0xreplacement1"] = "0xbundle"
+		assert.NoError(t, resubmitter.ResubmitStale(ctx))
+
+		log := latest()
+		assert.Equal(t, "0xbundle", log.TxHash)
+		assert.Equal(t, "0xreplacement1", log.Metadata["MinedUserOpHash"])
+		assert.Equal(t, "0xreplacement2", log.Metadata["UserOpHash"])
+		assert.Equal(t, int64(200000), *log.GasUsed)
+		assert.Equal(t, "0.01", log.GasCost.String())
+		assert.True(t, log.Sponsored)
+	})
+}
@@ -0,0 +1,155 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils"
+	"github.com/NEDA-LABS/stablenode/utils/aaerrors"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	fastshot "github.com/opus-domini/fast-shot"
+)
+
+// entryPointErrorsABI holds the errors the v0.7 EntryPoint reverts with when an operation fails validation
+const entryPointErrorsABI = `[
+	{"type":"error","name":"FailedOp","inputs":[
+		{"name":"opIndex","type":"uint256"},
+		{"name":"reason","type":"string"}
+	]},
+	{"type":"error","name":"FailedOpWithRevert","inputs":[
+		{"name":"opIndex","type":"uint256"},
+		{"name":"reason","type":"string"},
+		{"name":"inner","type":"bytes"}
+	]}
+]`
+
+var entryPointErrors = func() abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(entryPointErrorsABI))
+	if err != nil {
+		panic(fmt.Sprintf("invalid EntryPoint errors ABI: %v", err))
+	}
+	return parsed
+}()
+
+// UserOperationSimulation is the outcome of simulating a UserOperation against the chain's current state
+type UserOperationSimulation struct {
+	// Changes are the asset transfers and approvals the operation would make
+	Changes []interface{}
+	// Err is why the operation would fail, nil when it would succeed
+	Err *aaerrors.Error
+}
+
+// SimulateUserOperation runs a signed UserOperation through the EntryPoint's validation and execution
+// without submitting it, using alchemy_simulateUserOperationAssetChanges. A failing operation is
+// reported in the simulation's Err; the returned error is set when the simulation itself couldn't run
+func (s *AlchemyService) SimulateUserOperation(ctx context.Context, chainID int64, userOp map[string]interface{}) (*UserOperationSimulation, error) {
+	network, err := storage.Client.Network.
+		Query().
+		Where(network.ChainIDEQ(chainID)).
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get network for chain %d: %w", chainID, err)
+	}
+
+	return s.simulatePackedUserOperation(ctx, fmt.Sprintf("%s/%s", network.RPCEndpoint, s.config.APIKey), s.packUserOperationV07(userOp))
+}
+
+// simulatePackedUserOperation simulates a UserOperation already converted to the v0.7 RPC format
+func (s *AlchemyService) simulatePackedUserOperation(ctx context.Context, url string, packedUserOp map[string]interface{}) (*UserOperationSimulation, error) {
+	payload := map[string]interface{}{
+		"jsonrpc": "2.0",
+		"method":  "alchemy_simulateUserOperationAssetChanges",
+		"params": []interface{}{
+			packedUserOp,
+			"0x0000000071727De22E5E9d8baF0edAc6f37da032", // EntryPoint v0.7
+		},
+		"id": 1,
+	}
+
+	res, err := fastshot.NewClient(url).
+		Config().SetTimeout(30 * time.Second).
+		Config().SetCustomTransport(alchemyTransport()).
+		Header().AddAll(map[string]string{
+			"Accept":       "application/json",
+			"Content-Type": "application/json",
+		}).Build().POST("").
+		Context().Set(ctx).
+		Body().AsJSON(payload).Send()
+	if err != nil {
+		return nil, fmt.Errorf("failed to simulate user operation: %w", err)
+	}
+
+	data, err := utils.ParseJSONResponse(res.RawResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse simulation response: %w", err)
+	}
+
+	// A JSON-RPC error means the operation was rejected before it could be simulated, e.g. for a
+	// malformed field; anything but a recognized EntryPoint failure is left to the bundler to judge
+	if data["error"] != nil {
+		aaErr := aaerrors.Parse(data["error"])
+		if aaErr.Code == "" {
+			return nil, fmt.Errorf("simulation request failed: %w", aaErr)
+		}
+		return &UserOperationSimulation{Err: aaErr}, nil
+	}
+
+	result, ok := data["result"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected simulation result: %v", data["result"])
+	}
+
+	simulation := &UserOperationSimulation{}
+	simulation.Changes, _ = result["changes"].([]interface{})
+
+	if failure, ok := result["error"].(map[string]interface{}); ok {
+		message, _ := failure["message"].(string)
+		revertReason, _ := failure["revertReason"].(string)
+		revertReason = decodeRevertReason(revertReason)
+		if message == "" {
+			message = revertReason
+		} else if revertReason != "" && revertReason != message {
+			message = fmt.Sprintf("%s: %s", message, revertReason)
+		}
+		simulation.Err = aaerrors.Parse(message)
+	}
+
+	return simulation, nil
+}
+
+// decodeRevertReason decodes the EntryPoint's FailedOp errors and Solidity's Error(string) and
+// Panic(uint256) from hex revert data. Anything else is returned unchanged
+func decodeRevertReason(revert string) string {
+	data, err := hexutil.Decode(revert)
+	if err != nil || len(data) < 4 {
+		return revert
+	}
+
+	for _, failure := range entryPointErrors.Errors {
+		if !bytes.Equal(data[:4], failure.ID[:4]) {
+			continue
+		}
+		values, err := failure.Inputs.Unpack(data[4:])
+		if err != nil {
+			return revert
+		}
+		reason := values[1].(string)
+		if len(values) > 2 {
+			if inner := values[2].([]byte); len(inner) > 0 {
+				reason = fmt.Sprintf("%s (%s)", reason, decodeRevertReason(hexutil.Encode(inner)))
+			}
+		}
+		return reason
+	}
+
+	if reason, err := abi.UnpackRevert(data); err == nil {
+		return strings.TrimSpace(reason)
+	}
+	return revert
+}
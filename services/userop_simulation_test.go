@@ -0,0 +1,138 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/aaerrors"
+	"github.com/NEDA-LABS/stablenode/utils/test"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// packRevert encodes the EntryPoint error name with args as revert data
+func packRevert(t *testing.T, name string, args ...interface{}) string {
+	failure := entryPointErrors.Errors[name]
+	packed, err := failure.Inputs.Pack(args...)
+	require.NoError(t, err)
+	return hexutil.Encode(append(failure.ID[:4], packed...))
+}
+
+func TestDecodeRevertReason(t *testing.T) {
+	stringType, err := abi.NewType("string", "", nil)
+	require.NoError(t, err)
+	reason, err := abi.Arguments{{Type: stringType}}.Pack("ERC20: transfer amount exceeds balance")
+	require.NoError(t, err)
+	inner := append(hexutil.MustDecode("0x08c379a0"), reason...)
+
+	assert.Equal(t, "AA23 reverted", decodeRevertReason(packRevert(t, "FailedOp", big.NewInt(0), "AA23 reverted")))
+	assert.Equal(t, "AA23 reverted (ERC20: transfer amount exceeds balance)",
+		decodeRevertReason(packRevert(t, "FailedOpWithRevert", big.NewInt(0), "AA23 reverted", inner)))
+	assert.Equal(t, "ERC20: transfer amount exceeds balance", decodeRevertReason(hexutil.Encode(inner)))
+	assert.Equal(t, "AA21 didn't pay prefund", decodeRevertReason("AA21 didn't pay prefund"))
+	assert.Equal(t, "0xdeadbeef", decodeRevertReason("0xdeadbeef"))
+}
+
+func TestSimulateUserOperation(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:simulation?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	ctx := context.Background()
+
+	var simulationResult string
+	var methods []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		methods = append(methods, req.Method)
+
+		w.Header().Set("Content-Type", "application/json")
+		switch req.Method {
+		case "alchemy_simulateUserOperationAssetChanges":
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,%s}`, req.ID, simulationResult)
+		case "eth_sendUserOperation":
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":"0xuserop"}`, req.ID)
+		}
+	}))
+	defer server.Close()
+
+	_, err := test.CreateTestNetwork(map[string]interface{}{
+		"identifier": "base-sepolia",
+		"chainID":    int64(84532),
+		"networkRPC": server.URL,
+	})
+	require.NoError(t, err)
+
+	s := &AlchemyService{config: &config.AlchemyConfiguration{APIKey: "key", SimulateUserOperations: true}}
+	userOp := map[string]interface{}{
+		"sender":    "0x3333333333333333333333333333333333333333",
+		"nonce":     "0x0",
+		"callData":  "0xb61d27f6",
+		"signature": "0xsigned",
+	}
+
+	t.Run("reports the asset changes of a passing operation", func(t *testing.T) {
+		simulationResult = `"result":{"changes":[{"assetType":"ERC20","changeType":"TRANSFER"}],"error":null}`
+
+		simulation, err := s.SimulateUserOperation(ctx, 84532, userOp)
+		require.NoError(t, err)
+		assert.Nil(t, simulation.Err)
+		assert.Len(t, simulation.Changes, 1)
+	})
+
+	t.Run("decodes the revert reason of a failing operation", func(t *testing.T) {
+		simulationResult = fmt.Sprintf(`"result":{"changes":[],"error":{"revertReason":"%s"}}`,
+			packRevert(t, "FailedOp", big.NewInt(0), "AA25 invalid account nonce"))
+
+		simulation, err := s.SimulateUserOperation(ctx, 84532, userOp)
+		require.NoError(t, err)
+		require.NotNil(t, simulation.Err)
+		assert.ErrorIs(t, simulation.Err, aaerrors.ErrInvalidNonce)
+		assert.Equal(t, "AA25 invalid account nonce", simulation.Err.Message)
+	})
+
+	t.Run("fails when the simulation can't run", func(t *testing.T) {
+		simulationResult = `"error":{"code":-32601,"message":"method not supported on this network"}`
+
+		_, err := s.SimulateUserOperation(ctx, 84532, userOp)
+		assert.Error(t, err)
+	})
+
+	t.Run("rejects failing operations before sending them", func(t *testing.T) {
+		methods = nil
+		simulationResult = `"result":{"changes":[],"error":{"message":"AA21 didn't pay prefund"}}`
+
+		_, err := s.SendUserOperation(ctx, 84532, userOp)
+		assert.ErrorIs(t, err, aaerrors.ErrInsufficientPrefund)
+		assert.Equal(t, []string{"alchemy_simulateUserOperationAssetChanges"}, methods)
+	})
+
+	t.Run("sends operations that pass or can't be simulated", func(t *testing.T) {
+		for _, result := range []string{
+			`"result":{"changes":[],"error":null}`,
+			`"error":{"code":-32601,"message":"method not supported on this network"}`,
+		} {
+			methods = nil
+			simulationResult = result
+
+			userOpHash, err := s.SendUserOperation(ctx, 84532, userOp)
+			require.NoError(t, err)
+			assert.Equal(t, "0xuserop", userOpHash)
+			assert.Equal(t, []string{"alchemy_simulateUserOperationAssetChanges", "eth_sendUserOperation"}, methods)
+		}
+	})
+}
@@ -0,0 +1,204 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/utils"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+	fastshot "github.com/opus-domini/fast-shot"
+)
+
+// WebhookHealthPath is the path probed through SERVER_URL by the startup self-check
+const WebhookHealthPath = "/v1/webhook/health"
+
+const (
+	webhookURLUnknown int32 = iota
+	webhookURLReachable
+	webhookURLUnreachable
+)
+
+// Outcomes of the gateway webhook registration
+const (
+	WebhookRegistrationPending    = "pending"
+	WebhookRegistrationRegistered = "registered"
+	WebhookRegistrationSkipped    = "skipped"
+	WebhookRegistrationFailed     = "failed"
+)
+
+// WebhookRegistration is the outcome of registering the gateway webhooks at startup
+type WebhookRegistration struct {
+	Status string
+	Error  string
+}
+
+var (
+	webhookURLStatus    atomic.Int32
+	webhookRegistration atomic.Pointer[WebhookRegistration]
+)
+
+// webhookHealthTokenDomain prefixes the message of the health token, so the token served publicly
+// is never a MAC SECRET produces for another purpose
+const webhookHealthTokenDomain = "webhook-health:"
+
+// WebhookHealthToken returns the token served on WebhookHealthPath. It is derived from SERVER_URL
+// and SECRET, so every instance behind SERVER_URL serves the same token and another host can't
+// serve it
+func WebhookHealthToken() string {
+	return webhookHealthToken(config.AuthConfig().Secret, config.ServerConfig().ServerURL)
+}
+
+// webhookHealthToken is the hex HMAC-SHA256 of the domain prefixed serverURL keyed with secret
+func webhookHealthToken(secret, serverURL string) string {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(webhookHealthTokenDomain + serverURL))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// WebhookURLUnreachable reports whether the self-check ran and failed
+// Before the check has run, webhook registration is allowed
+func WebhookURLUnreachable() bool {
+	return webhookURLStatus.Load() == webhookURLUnreachable
+}
+
+// RecordWebhookRegistration records the outcome of registering the gateway webhooks. A nil error
+// records status as is; an error marks the registration failed
+func RecordWebhookRegistration(status string, err error) {
+	registration := &WebhookRegistration{Status: status}
+	if err != nil {
+		registration.Status = WebhookRegistrationFailed
+		registration.Error = err.Error()
+	}
+	webhookRegistration.Store(registration)
+}
+
+// WebhookRegistrationStatus returns the outcome of registering the gateway webhooks, which is
+// pending until the startup registration has run
+func WebhookRegistrationStatus() WebhookRegistration {
+	if registration := webhookRegistration.Load(); registration != nil {
+		return *registration
+	}
+	return WebhookRegistration{Status: WebhookRegistrationPending}
+}
+
+// ValidateServerURL checks that SERVER_URL is an absolute URL that external providers can call
+func ValidateServerURL(serverURL string, environment string) error {
+	if serverURL == "" {
+		return fmt.Errorf("SERVER_URL not configured in environment")
+	}
+
+	parsed, err := url.Parse(serverURL)
+	if err != nil {
+		return fmt.Errorf("SERVER_URL is not a valid URL: %w", err)
+	}
+
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return fmt.Errorf("SERVER_URL must use http or https, got %q", parsed.Scheme)
+	}
+
+	if parsed.Host == "" {
+		return fmt.Errorf("SERVER_URL has no host")
+	}
+
+	if strings.HasSuffix(parsed.Path, "/") {
+		return fmt.Errorf("SERVER_URL must not end with a trailing slash")
+	}
+
+	if environment == "local" {
+		return nil
+	}
+
+	if parsed.Scheme != "https" {
+		return fmt.Errorf("SERVER_URL must use https in %s", environment)
+	}
+
+	host := parsed.Hostname()
+	if host == "localhost" {
+		return fmt.Errorf("SERVER_URL points to localhost")
+	}
+	if ip := net.ParseIP(host); ip != nil && (ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified()) {
+		return fmt.Errorf("SERVER_URL points to a non-public address %s", host)
+	}
+
+	return nil
+}
+
+// CheckWebhookURL validates SERVER_URL and requests WebhookHealthPath through it,
+// expecting this deployment's health token back. The result gates webhook registration.
+func CheckWebhookURL(ctx context.Context) error {
+	serverConf := config.ServerConfig()
+
+	err := checkWebhookURL(ctx, serverConf.ServerURL, serverConf.Environment)
+	if err != nil {
+		webhookURLStatus.Store(webhookURLUnreachable)
+		return err
+	}
+
+	webhookURLStatus.Store(webhookURLReachable)
+	return nil
+}
+
+func checkWebhookURL(ctx context.Context, serverURL, environment string) error {
+	if err := ValidateServerURL(serverURL, environment); err != nil {
+		return err
+	}
+
+	res, err := fastshot.NewClient(serverURL).
+		Config().SetTimeout(10 * time.Second).
+		Build().GET(WebhookHealthPath).
+		Context().Set(ctx).
+		Send()
+	if err != nil {
+		return fmt.Errorf("webhook URL %s%s is not reachable: %w", serverURL, WebhookHealthPath, err)
+	}
+
+	data, err := utils.ParseJSONResponse(res.RawResponse)
+	if err != nil {
+		return fmt.Errorf("webhook URL %s%s returned an invalid response: %w", serverURL, WebhookHealthPath, err)
+	}
+
+	body, ok := data["data"].(map[string]interface{})
+	if !ok || body["token"] != WebhookHealthToken() {
+		return fmt.Errorf("webhook URL %s%s is served by a different host", serverURL, WebhookHealthPath)
+	}
+
+	return nil
+}
+
+// WaitForWebhookURL retries the self-check until it succeeds or attempts run out
+// Used at startup, where the HTTP server may not be listening yet; only the final
+// outcome is recorded so early attempts don't block webhook registration
+func WaitForWebhookURL(ctx context.Context, attempts int, interval time.Duration) error {
+	serverConf := config.ServerConfig()
+
+	var err error
+	for i := 0; i < attempts; i++ {
+		if err = checkWebhookURL(ctx, serverConf.ServerURL, serverConf.Environment); err == nil {
+			webhookURLStatus.Store(webhookURLReachable)
+			return nil
+		}
+
+		logger.WithFields(logger.Fields{
+			"Attempt": i + 1,
+			"Error":   err.Error(),
+		}).Warnf("Webhook URL self-check failed, retrying")
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+
+	webhookURLStatus.Store(webhookURLUnreachable)
+	return err
+}
@@ -0,0 +1,91 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookURLSelfCheck(t *testing.T) {
+	t.Run("ValidateServerURL", func(t *testing.T) {
+		t.Run("should reject an empty SERVER_URL", func(t *testing.T) {
+			assert.Error(t, ValidateServerURL("", "local"))
+		})
+
+		t.Run("should reject a relative SERVER_URL", func(t *testing.T) {
+			assert.Error(t, ValidateServerURL("api.example.com", "production"))
+		})
+
+		t.Run("should reject a trailing slash", func(t *testing.T) {
+			assert.Error(t, ValidateServerURL("https://api.example.com/", "production"))
+		})
+
+		t.Run("should allow localhost in local environment", func(t *testing.T) {
+			assert.NoError(t, ValidateServerURL("http://localhost:8000", "local"))
+		})
+
+		t.Run("should reject localhost and private addresses outside local", func(t *testing.T) {
+			assert.Error(t, ValidateServerURL("https://localhost:8000", "staging"))
+			assert.Error(t, ValidateServerURL("https://10.0.0.4", "production"))
+			assert.Error(t, ValidateServerURL("http://api.example.com", "production"))
+		})
+
+		t.Run("should accept a public https URL", func(t *testing.T) {
+			assert.NoError(t, ValidateServerURL("https://api.example.com", "production"))
+		})
+	})
+
+	t.Run("webhookHealthToken", func(t *testing.T) {
+		t.Run("should be shared by instances with the same SERVER_URL and SECRET", func(t *testing.T) {
+			assert.Equal(t, webhookHealthToken("secret", "https://api.example.com"), webhookHealthToken("secret", "https://api.example.com"))
+		})
+
+		t.Run("should differ for another SERVER_URL or SECRET", func(t *testing.T) {
+			token := webhookHealthToken("secret", "https://api.example.com")
+			assert.NotEqual(t, token, webhookHealthToken("secret", "https://other.example.com"))
+			assert.NotEqual(t, token, webhookHealthToken("other", "https://api.example.com"))
+		})
+
+		t.Run("should not be the plain HMAC of SERVER_URL with SECRET", func(t *testing.T) {
+			h := hmac.New(sha256.New, []byte("secret"))
+			h.Write([]byte("https://api.example.com"))
+			assert.NotEqual(t, hex.EncodeToString(h.Sum(nil)), webhookHealthToken("secret", "https://api.example.com"))
+		})
+	})
+
+	t.Run("checkWebhookURL", func(t *testing.T) {
+		t.Run("should pass when the health token matches", func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, WebhookHealthPath, r.URL.Path)
+				fmt.Fprintf(w, `{"status":"success","message":"OK","data":{"token":"%s"}}`, WebhookHealthToken())
+			}))
+			defer server.Close()
+
+			assert.NoError(t, checkWebhookURL(context.Background(), server.URL, "local"))
+		})
+
+		t.Run("should fail when another host answers", func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, `{"status":"success","message":"OK","data":{"token":"someone-else"}}`)
+			}))
+			defer server.Close()
+
+			assert.Error(t, checkWebhookURL(context.Background(), server.URL, "local"))
+		})
+
+		t.Run("should fail when nothing is listening", func(t *testing.T) {
+			server := httptest.NewServer(http.NotFoundHandler())
+			url := server.URL
+			server.Close()
+
+			assert.Error(t, checkWebhookURL(context.Background(), url, "local"))
+		})
+	})
+}
@@ -0,0 +1,283 @@
+package services
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/NEDA-LABS/stablenode/ent/paymentwebhook"
+	"github.com/NEDA-LABS/stablenode/services/events"
+	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/NEDA-LABS/stablenode/utils"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/shopspring/decimal"
+)
+
+// ErrWebhookUnauthorized is returned when a webhook delivery fails authentication
+var ErrWebhookUnauthorized = errors.New("invalid webhook credentials")
+
+// InboundWebhookProvider authenticates the deposit webhooks of a Notify provider and normalizes
+// their payloads into deposit events
+type InboundWebhookProvider interface {
+	// Authenticate checks a delivery against the secret of its webhook record
+	Authenticate(header http.Header, rawBody []byte, secret string) error
+	// ParseDeposits returns the transfers reported by a delivery
+	ParseDeposits(rawBody []byte) ([]types.InboundDeposit, error)
+}
+
+// NewInboundWebhookProvider returns the webhook handling of a Notify provider. tokenContracts are the
+// token contracts of the webhook's network; providers delivering raw logs only read the logs they emit
+func NewInboundWebhookProvider(provider paymentwebhook.Provider, tokenContracts []string) (InboundWebhookProvider, error) {
+	switch provider {
+	case paymentwebhook.ProviderAlchemy:
+		return alchemyWebhookProvider{}, nil
+	case paymentwebhook.ProviderQuicknode:
+		contracts := make(map[common.Address]bool, len(tokenContracts))
+		for _, address := range tokenContracts {
+			contracts[common.HexToAddress(address)] = true
+		}
+		return quickNodeWebhookProvider{tokenContracts: contracts}, nil
+	case paymentwebhook.ProviderMoralis:
+		return moralisWebhookProvider{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported webhook provider %q", provider)
+	}
+}
+
+// alchemyWebhookProvider handles Alchemy Address Activity webhooks, signed with an HMAC-SHA256 of the
+// body in the X-Alchemy-Signature header
+type alchemyWebhookProvider struct{}
+
+func (alchemyWebhookProvider) Authenticate(header http.Header, rawBody []byte, secret string) error {
+	signature := header.Get("X-Alchemy-Signature")
+	if signature == "" {
+		return ErrWebhookUnauthorized
+	}
+
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write(rawBody)
+	if !hmac.Equal([]byte(hex.EncodeToString(h.Sum(nil))), []byte(signature)) {
+		return ErrWebhookUnauthorized
+	}
+	return nil
+}
+
+func (alchemyWebhookProvider) ParseDeposits(rawBody []byte) ([]types.InboundDeposit, error) {
+	var payload types.AlchemyWebhookPayload
+	if err := json.Unmarshal(rawBody, &payload); err != nil {
+		return nil, fmt.Errorf("invalid Alchemy payload: %w", err)
+	}
+
+	chainID, err := AlchemyNetworkChainID(payload.Event.Network)
+	if err != nil {
+		return nil, err
+	}
+
+	deposits := make([]types.InboundDeposit, 0, len(payload.Event.Activity))
+	for _, activity := range payload.Event.Activity {
+		var contractAddress string
+		switch activity.Category {
+		case "external":
+			contractAddress = utils.NativeTokenAddress
+		case "token", "erc20":
+			contractAddress = activity.RawContract.Address
+		default:
+			continue
+		}
+
+		deposits = append(deposits, types.InboundDeposit{
+			ChainID:         chainID,
+			ContractAddress: contractAddress,
+			TxHash:          activity.Hash,
+			BlockNumber:     utils.HexToDecimal(activity.BlockNum).IntPart(),
+			From:            activity.FromAddress,
+			To:              activity.ToAddress,
+			RawValue:        utils.HexToDecimal(activity.RawContract.RawValue),
+		})
+	}
+	return deposits, nil
+}
+
+// quickNodeWebhookProvider handles QuickNode Streams deliveries, authenticated with a bearer token set
+// as a custom header on the stream. The stream filter returns the matching transaction receipts,
+// whose ERC-20 Transfer logs are the deposits. The chain is the webhook record's network
+type quickNodeWebhookProvider struct {
+	tokenContracts map[common.Address]bool
+}
+
+// quickNodeReceipt is a transaction receipt in a QuickNode Streams delivery
+type quickNodeReceipt struct {
+	TransactionHash string `json:"transactionHash"`
+	BlockNumber     string `json:"blockNumber"`
+	Status          string `json:"status"`
+	Logs            []struct {
+		Address string   `json:"address"`
+		Topics  []string `json:"topics"`
+		Data    string   `json:"data"`
+		Removed bool     `json:"removed"`
+	} `json:"logs"`
+}
+
+func (quickNodeWebhookProvider) Authenticate(header http.Header, rawBody []byte, secret string) error {
+	token, ok := strings.CutPrefix(header.Get("Authorization"), "Bearer ")
+	if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(secret)) != 1 {
+		return ErrWebhookUnauthorized
+	}
+	return nil
+}
+
+func (p quickNodeWebhookProvider) ParseDeposits(rawBody []byte) ([]types.InboundDeposit, error) {
+	var receipts []quickNodeReceipt
+	if err := json.Unmarshal(rawBody, &receipts); err != nil {
+		var payload struct {
+			MatchingReceipts []quickNodeReceipt `json:"matchingReceipts"`
+		}
+		if err := json.Unmarshal(rawBody, &payload); err != nil {
+			return nil, fmt.Errorf("invalid QuickNode payload: %w", err)
+		}
+		receipts = payload.MatchingReceipts
+	}
+
+	var deposits []types.InboundDeposit
+	for _, receipt := range receipts {
+		if receipt.Status != "" && receipt.Status != "0x1" {
+			continue
+		}
+
+		for _, log := range receipt.Logs {
+			// Any contract can emit a Transfer-shaped log, so only those of our tokens are deposits
+			if log.Removed || !p.tokenContracts[common.HexToAddress(log.Address)] {
+				continue
+			}
+
+			topics := make([]common.Hash, len(log.Topics))
+			for i, topic := range log.Topics {
+				topics[i] = common.HexToHash(topic)
+			}
+			event, err := events.Default().Decode(ethtypes.Log{
+				Address: common.HexToAddress(log.Address),
+				Topics:  topics,
+				Data:    common.FromHex(log.Data),
+			})
+			if err != nil {
+				continue
+			}
+			transfer, ok := event.(*events.Transfer)
+			if !ok {
+				continue
+			}
+
+			deposits = append(deposits, types.InboundDeposit{
+				ContractAddress: log.Address,
+				TxHash:          receipt.TransactionHash,
+				BlockNumber:     utils.HexToDecimal(receipt.BlockNumber).IntPart(),
+				From:            transfer.From.Hex(),
+				To:              transfer.To.Hex(),
+				RawValue:        decimal.NewFromBigInt(transfer.Value, 0),
+			})
+		}
+	}
+	return deposits, nil
+}
+
+// moralisWebhookProvider handles Moralis Streams deliveries, signed with the keccak256 of the body
+// followed by the stream secret in the X-Signature header. Moralis delivers every block twice, once
+// unconfirmed and again once confirmed; only the first delivery is used, as with the other providers
+type moralisWebhookProvider struct{}
+
+// moralisPayload is a Moralis Streams delivery
+type moralisPayload struct {
+	Confirmed bool   `json:"confirmed"`
+	ChainID   string `json:"chainId"`
+	Block     struct {
+		Number string `json:"number"`
+	} `json:"block"`
+	Txs []struct {
+		Hash        string `json:"hash"`
+		FromAddress string `json:"fromAddress"`
+		ToAddress   string `json:"toAddress"`
+		Value       string `json:"value"`
+	} `json:"txs"`
+	ERC20Transfers []struct {
+		TransactionHash string `json:"transactionHash"`
+		Contract        string `json:"contract"`
+		From            string `json:"from"`
+		To              string `json:"to"`
+		Value           string `json:"value"`
+	} `json:"erc20Transfers"`
+}
+
+func (moralisWebhookProvider) Authenticate(header http.Header, rawBody []byte, secret string) error {
+	signature := strings.ToLower(header.Get("X-Signature"))
+	if signature == "" {
+		return ErrWebhookUnauthorized
+	}
+
+	expected := "0x" + hex.EncodeToString(crypto.Keccak256(rawBody, []byte(secret)))
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return ErrWebhookUnauthorized
+	}
+	return nil
+}
+
+func (moralisWebhookProvider) ParseDeposits(rawBody []byte) ([]types.InboundDeposit, error) {
+	var payload moralisPayload
+	if err := json.Unmarshal(rawBody, &payload); err != nil {
+		return nil, fmt.Errorf("invalid Moralis payload: %w", err)
+	}
+
+	// The test delivery sent when a stream is created has no block
+	if payload.Confirmed || payload.Block.Number == "" {
+		return nil, nil
+	}
+
+	chainID := utils.HexToDecimal(payload.ChainID).IntPart()
+	blockNumber, err := decimal.NewFromString(payload.Block.Number)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Moralis block number %q", payload.Block.Number)
+	}
+
+	var deposits []types.InboundDeposit
+	for _, transfer := range payload.ERC20Transfers {
+		value, err := decimal.NewFromString(transfer.Value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Moralis transfer value %q", transfer.Value)
+		}
+
+		deposits = append(deposits, types.InboundDeposit{
+			ChainID:         chainID,
+			ContractAddress: transfer.Contract,
+			TxHash:          transfer.TransactionHash,
+			BlockNumber:     blockNumber.IntPart(),
+			From:            transfer.From,
+			To:              transfer.To,
+			RawValue:        value,
+		})
+	}
+
+	for _, tx := range payload.Txs {
+		value, err := decimal.NewFromString(tx.Value)
+		if err != nil || !value.IsPositive() {
+			continue
+		}
+
+		deposits = append(deposits, types.InboundDeposit{
+			ChainID:         chainID,
+			ContractAddress: utils.NativeTokenAddress,
+			TxHash:          tx.Hash,
+			BlockNumber:     blockNumber.IntPart(),
+			From:            tx.FromAddress,
+			To:              tx.ToAddress,
+			RawValue:        value,
+		})
+	}
+	return deposits, nil
+}
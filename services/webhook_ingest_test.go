@@ -0,0 +1,122 @@
+package services
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"testing"
+
+	"github.com/NEDA-LABS/stablenode/ent/paymentwebhook"
+	"github.com/NEDA-LABS/stablenode/services/events"
+	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/NEDA-LABS/stablenode/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInboundWebhookProviders(t *testing.T) {
+	const secret = "whsec_test"
+
+	t.Run("alchemy verifies the body HMAC and parses activity", func(t *testing.T) {
+		provider, err := NewInboundWebhookProvider(paymentwebhook.ProviderAlchemy, nil)
+		assert.NoError(t, err)
+
+		body := []byte(`{"webhookId":"wh_1","event":{"network":"BASE_SEPOLIA","activity":[
+			{"blockNum":"0x10","hash":"0xa1","fromAddress":"0x5555555555555555555555555555555555555555","toAddress":"0x3333333333333333333333333333333333333333","category":"token","rawContract":{"rawValue":"0x0f4240","address":"0x036CbD53842c5426634e7929541eC2318f3dCF7e"}},
+			{"blockNum":"0x10","hash":"0xa2","fromAddress":"0x5555555555555555555555555555555555555555","toAddress":"0x3333333333333333333333333333333333333333","category":"external","rawContract":{"rawValue":"0x01"}},
+			{"blockNum":"0x10","hash":"0xa3","category":"erc721"}
+		]}}`)
+
+		h := hmac.New(sha256.New, []byte(secret))
+		h.Write(body)
+		header := http.Header{}
+		header.Set("X-Alchemy-Signature", hex.EncodeToString(h.Sum(nil)))
+		assert.NoError(t, provider.Authenticate(header, body, secret))
+		assert.ErrorIs(t, provider.Authenticate(header, body, "other"), ErrWebhookUnauthorized)
+		assert.ErrorIs(t, provider.Authenticate(http.Header{}, body, secret), ErrWebhookUnauthorized)
+
+		deposits, err := provider.ParseDeposits(body)
+		assert.NoError(t, err)
+		assert.Len(t, deposits, 2)
+		assert.Equal(t, int64(84532), deposits[0].ChainID)
+		assert.Equal(t, int64(16), deposits[0].BlockNumber)
+		assert.True(t, deposits[0].RawValue.Equal(decimal.NewFromInt(1000000)))
+		assert.Equal(t, utils.NativeTokenAddress, deposits[1].ContractAddress)
+	})
+
+	t.Run("quicknode checks the bearer token and decodes Transfer logs", func(t *testing.T) {
+		provider, err := NewInboundWebhookProvider(paymentwebhook.ProviderQuicknode, []string{"0x036cbd53842c5426634e7929541ec2318f3dcf7e"})
+		assert.NoError(t, err)
+
+		header := http.Header{}
+		header.Set("Authorization", "Bearer "+secret)
+		assert.NoError(t, provider.Authenticate(header, nil, secret))
+		header.Set("Authorization", "Bearer wrong")
+		assert.ErrorIs(t, provider.Authenticate(header, nil, secret), ErrWebhookUnauthorized)
+		header.Set("Authorization", secret)
+		assert.ErrorIs(t, provider.Authenticate(header, nil, secret), ErrWebhookUnauthorized)
+
+		body := []byte(`{"matchingReceipts":[
+			{"transactionHash":"0xb1","blockNumber":"0x20","status":"0x1","logs":[
+				{"address":"0x036CbD53842c5426634e7929541eC2318f3dCF7e","topics":["` + events.Topic(events.ERC20, "Transfer").Hex() + `","0x0000000000000000000000005555555555555555555555555555555555555555","0x0000000000000000000000003333333333333333333333333333333333333333"],"data":"0x00000000000000000000000000000000000000000000000000000000000f4240"},
+				{"address":"0x036CbD53842c5426634e7929541eC2318f3dCF7e","topics":["0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925"],"data":"0x"},
+				{"address":"0x036CbD53842c5426634e7929541eC2318f3dCF7e","topics":["` + events.Topic(events.ERC20, "Transfer").Hex() + `","0x0000000000000000000000005555555555555555555555555555555555555555","0x0000000000000000000000003333333333333333333333333333333333333333"],"data":"0x"},
+				{"address":"0x9999999999999999999999999999999999999999","topics":["` + events.Topic(events.ERC20, "Transfer").Hex() + `","0x0000000000000000000000005555555555555555555555555555555555555555","0x0000000000000000000000003333333333333333333333333333333333333333"],"data":"0x00000000000000000000000000000000000000000000000000000000000f4240"}
+			]},
+			{"transactionHash":"0xb2","blockNumber":"0x21","status":"0x0","logs":[
+				{"address":"0x036CbD53842c5426634e7929541eC2318f3dCF7e","topics":["` + events.Topic(events.ERC20, "Transfer").Hex() + `","0x0000000000000000000000005555555555555555555555555555555555555555","0x0000000000000000000000003333333333333333333333333333333333333333"],"data":"0x01"}
+			]}
+		]}`)
+
+		deposits, err := provider.ParseDeposits(body)
+		assert.NoError(t, err)
+		assert.Equal(t, []types.InboundDeposit{{
+			ContractAddress: "0x036CbD53842c5426634e7929541eC2318f3dCF7e",
+			TxHash:          "0xb1",
+			BlockNumber:     32,
+			From:            "0x5555555555555555555555555555555555555555",
+			To:              "0x3333333333333333333333333333333333333333",
+			RawValue:        decimal.NewFromInt(1000000),
+		}}, deposits)
+	})
+
+	t.Run("moralis verifies the keccak signature and skips confirmed deliveries", func(t *testing.T) {
+		provider, err := NewInboundWebhookProvider(paymentwebhook.ProviderMoralis, nil)
+		assert.NoError(t, err)
+
+		body := []byte(`{"confirmed":false,"chainId":"0x14a34","block":{"number":"48"},
+			"erc20Transfers":[{"transactionHash":"0xc1","contract":"0x036cbd53842c5426634e7929541ec2318f3dcf7e","from":"0x5555555555555555555555555555555555555555","to":"0x3333333333333333333333333333333333333333","value":"2500000"}],
+			"txs":[{"hash":"0xc1","fromAddress":"0x5555555555555555555555555555555555555555","toAddress":"0x036cbd53842c5426634e7929541ec2318f3dcf7e","value":"0"},
+				{"hash":"0xc2","fromAddress":"0x5555555555555555555555555555555555555555","toAddress":"0x3333333333333333333333333333333333333333","value":"1000000000000000"}]}`)
+
+		header := http.Header{}
+		header.Set("X-Signature", "0x"+hex.EncodeToString(crypto.Keccak256(body, []byte(secret))))
+		assert.NoError(t, provider.Authenticate(header, body, secret))
+		assert.ErrorIs(t, provider.Authenticate(header, append(body, ' '), secret), ErrWebhookUnauthorized)
+
+		deposits, err := provider.ParseDeposits(body)
+		assert.NoError(t, err)
+		assert.Len(t, deposits, 2)
+		assert.Equal(t, int64(84532), deposits[0].ChainID)
+		assert.Equal(t, int64(48), deposits[0].BlockNumber)
+		assert.True(t, deposits[0].RawValue.Equal(decimal.NewFromInt(2500000)))
+		assert.Equal(t, utils.NativeTokenAddress, deposits[1].ContractAddress)
+		assert.Equal(t, "0xc2", deposits[1].TxHash)
+
+		deposits, err = provider.ParseDeposits([]byte(`{"confirmed":true,"chainId":"0x14a34","block":{"number":"48"},"erc20Transfers":[{"transactionHash":"0xc1","value":"1"}]}`))
+		assert.NoError(t, err)
+		assert.Empty(t, deposits)
+
+		// Test delivery sent when the stream is created
+		deposits, err = provider.ParseDeposits([]byte(`{"confirmed":false,"chainId":"","block":{"number":""}}`))
+		assert.NoError(t, err)
+		assert.Empty(t, deposits)
+	})
+
+	t.Run("rejects providers without inbound deposit handling", func(t *testing.T) {
+		_, err := NewInboundWebhookProvider(paymentwebhook.ProviderThirdweb, nil)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,377 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/linkedaddress"
+	networkent "github.com/NEDA-LABS/stablenode/ent/network"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	tokenent "github.com/NEDA-LABS/stablenode/ent/token"
+	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
+	"github.com/NEDA-LABS/stablenode/services/events"
+	"github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/NEDA-LABS/stablenode/utils"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/NEDA-LABS/stablenode/utils/metrics"
+)
+
+// WebsocketIndexer indexes token transfers to monitored addresses from an eth_subscribe("logs")
+// subscription on each network with a WebSocket endpoint, for sub-second payment detection
+// without provider webhooks or polling
+type WebsocketIndexer struct {
+	refreshInterval time.Duration
+	reconnectDelay  time.Duration
+	handleTransfer  TransferHandler
+	stopChan        chan bool
+}
+
+// monitoredSet is the subscription filter of a network: its tokens and the addresses watched for deposits
+type monitoredSet struct {
+	tokens    map[string]*ent.Token // by lowercased contract address
+	addresses map[string]string     // lowercased address to the address as stored
+}
+
+// NewWebsocketIndexer creates a new WebSocket indexer. Transfers are passed to handleTransfer
+func NewWebsocketIndexer(handleTransfer TransferHandler) *WebsocketIndexer {
+	return &WebsocketIndexer{
+		// New orders are only watched once the subscription is refreshed
+		refreshInterval: durationOrDefault("WEBSOCKET_INDEXER_REFRESH_INTERVAL", 30*time.Second),
+		reconnectDelay:  durationOrDefault("WEBSOCKET_INDEXER_RECONNECT_DELAY", 5*time.Second),
+		handleTransfer:  handleTransfer,
+		stopChan:        make(chan bool),
+	}
+}
+
+// Start subscribes to every enabled network with a WebSocket endpoint and WebSocket indexing
+// enabled until the indexer is stopped. Networks are rescanned on every refresh, so networks
+// created, enabled or reconfigured while running are picked up without a restart
+func (s *WebsocketIndexer) Start(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	watching := make(map[int]*watchedNetwork)
+	refresh := time.NewTicker(s.refreshInterval)
+	defer refresh.Stop()
+
+	for {
+		if err := s.syncNetworks(ctx, watching); err != nil {
+			logger.Errorf("Failed to fetch networks for WebSocket indexer: %v", err)
+		}
+
+		select {
+		case <-refresh.C:
+		case <-s.stopChan:
+			logger.Infof("Stopping WebSocket indexer")
+			return
+		case <-ctx.Done():
+			logger.Infof("Context cancelled, stopping WebSocket indexer")
+			return
+		}
+	}
+}
+
+// watchedNetwork is a network the indexer holds a subscription on
+type watchedNetwork struct {
+	wssEndpoint string
+	cancel      context.CancelFunc
+}
+
+// syncNetworks starts watching the networks that should be indexed and stops watching the ones that
+// no longer should be. A network whose WebSocket endpoint changed is resubscribed
+func (s *WebsocketIndexer) syncNetworks(ctx context.Context, watching map[int]*watchedNetwork) error {
+	networks, err := storage.Client.Network.
+		Query().
+		Where(
+			networkent.WssEndpointNEQ(""),
+			networkent.GenesisMismatchEQ(false),
+			networkent.IsEnabledEQ(true),
+			networkent.Not(networkent.IdentifierHasPrefix("tron")),
+			networkent.NetworkTypeNEQ(networkent.NetworkTypeSolana),
+		).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	wanted := make(map[int]*ent.Network, len(networks))
+	for _, network := range networks {
+		if DepositSourcesFor(network).Websocket {
+			wanted[network.ID] = network
+		}
+	}
+
+	for id, watched := range watching {
+		if network, ok := wanted[id]; !ok || network.WssEndpoint != watched.wssEndpoint {
+			logger.WithFields(logger.Fields{
+				"NetworkID": id,
+			}).Infof("Stopping WebSocket transfer indexer")
+			watched.cancel()
+			delete(watching, id)
+		}
+	}
+
+	for id, network := range wanted {
+		if _, ok := watching[id]; ok {
+			continue
+		}
+		networkCtx, cancel := context.WithCancel(ctx)
+		watching[id] = &watchedNetwork{wssEndpoint: network.WssEndpoint, cancel: cancel}
+		go s.watchNetwork(networkCtx, network)
+	}
+	return nil
+}
+
+// Stop stops the WebSocket indexer
+func (s *WebsocketIndexer) Stop() {
+	close(s.stopChan)
+}
+
+// watchNetwork keeps a subscription open on a network, reconnecting after failures
+func (s *WebsocketIndexer) watchNetwork(ctx context.Context, network *ent.Network) {
+	logger.WithFields(logger.Fields{
+		"Network": network.Identifier,
+	}).Infof("Starting WebSocket transfer indexer")
+
+	for {
+		err := s.subscribe(ctx, network)
+		if ctx.Err() != nil {
+			return
+		}
+
+		logger.WithFields(logger.Fields{
+			"Network": network.Identifier,
+			"Error":   fmt.Sprintf("%v", err),
+			"Retry":   s.reconnectDelay,
+		}).Warnf("WebSocket subscription dropped, reconnecting")
+
+		select {
+		case <-time.After(s.reconnectDelay):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// subscribe streams Transfer logs to the monitored addresses of a network, refreshing the
+// filter as orders come and go. Transfers sent while the filter is swapped are picked up
+// by the polling fallback
+func (s *WebsocketIndexer) subscribe(ctx context.Context, network *ent.Network) error {
+	client, err := ethclient.DialContext(ctx, network.WssEndpoint)
+	if err != nil {
+		return fmt.Errorf("failed to connect to WebSocket endpoint: %w", err)
+	}
+	defer client.Close()
+
+	for {
+		monitored, err := s.monitoredAddresses(ctx, network)
+		if err != nil {
+			return err
+		}
+
+		if len(monitored.tokens) == 0 || len(monitored.addresses) == 0 {
+			select {
+			case <-time.After(s.refreshInterval):
+				continue
+			case <-ctx.Done():
+				return nil
+			}
+		}
+
+		logs := make(chan ethtypes.Log)
+		sub, err := client.SubscribeFilterLogs(ctx, transferFilter(monitored), logs)
+		if err != nil {
+			return fmt.Errorf("failed to subscribe to logs: %w", err)
+		}
+
+		refresh := time.NewTimer(s.refreshInterval)
+
+	stream:
+		for {
+			select {
+			case log := <-logs:
+				s.handleLog(ctx, network, monitored, log)
+			case err := <-sub.Err():
+				refresh.Stop()
+				return err
+			case <-refresh.C:
+				sub.Unsubscribe()
+				break stream
+			case <-ctx.Done():
+				refresh.Stop()
+				sub.Unsubscribe()
+				return nil
+			}
+		}
+	}
+}
+
+// monitoredAddresses returns the tokens of a network and the addresses awaiting deposits on it:
+// receive addresses of open orders and linked addresses
+func (s *WebsocketIndexer) monitoredAddresses(ctx context.Context, network *ent.Network) (*monitoredSet, error) {
+	monitored := &monitoredSet{
+		tokens:    make(map[string]*ent.Token),
+		addresses: make(map[string]string),
+	}
+
+	tokens, err := storage.Client.Token.
+		Query().
+		Where(
+			tokenent.IsEnabledEQ(true),
+			tokenent.HasNetworkWith(networkent.IDEQ(network.ID)),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch tokens: %w", err)
+	}
+	for _, token := range tokens {
+		// Native transfers emit no logs
+		if utils.IsNativeToken(token.ContractAddress) {
+			continue
+		}
+		token.Edges.Network = network
+		monitored.tokens[strings.ToLower(token.ContractAddress)] = token
+	}
+
+	receiveAddresses, err := storage.Client.ReceiveAddress.
+		Query().
+		Where(
+			receiveaddress.ValidUntilGT(time.Now().Add(-config.OrderConfig().ReceiveAddressGracePeriod)),
+			receiveaddress.HasPaymentOrderWith(
+				paymentorder.StatusEQ(paymentorder.StatusInitiated),
+				paymentorder.HasTokenWith(tokenent.HasNetworkWith(networkent.IDEQ(network.ID))),
+			),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch receive addresses: %w", err)
+	}
+	for _, receiveAddress := range receiveAddresses {
+		monitored.addresses[strings.ToLower(receiveAddress.Address)] = receiveAddress.Address
+	}
+
+	linkedAddresses, err := storage.Client.LinkedAddress.
+		Query().
+		Select(linkedaddress.FieldAddress).
+		Strings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch linked addresses: %w", err)
+	}
+	for _, address := range linkedAddresses {
+		if common.IsHexAddress(address) {
+			monitored.addresses[strings.ToLower(address)] = address
+		}
+	}
+
+	return monitored, nil
+}
+
+// transferFilter returns the log filter for Transfer events of the monitored tokens to the monitored addresses
+func transferFilter(monitored *monitoredSet) ethereum.FilterQuery {
+	contracts := make([]common.Address, 0, len(monitored.tokens))
+	for contractAddress := range monitored.tokens {
+		contracts = append(contracts, common.HexToAddress(contractAddress))
+	}
+
+	recipients := make([]common.Hash, 0, len(monitored.addresses))
+	for address := range monitored.addresses {
+		recipients = append(recipients, common.BytesToHash(common.HexToAddress(address).Bytes()))
+	}
+
+	return ethereum.FilterQuery{
+		Addresses: contracts,
+		Topics: [][]common.Hash{
+			{events.Topic(events.ERC20, "Transfer")},
+			nil,
+			recipients,
+		},
+	}
+}
+
+// transferEvent decodes a Transfer log of a monitored token to a monitored address
+func transferEvent(monitored *monitoredSet, log ethtypes.Log) (*ent.Token, *types.TokenTransferEvent, bool) {
+	if log.Removed {
+		return nil, nil, false
+	}
+
+	token, ok := monitored.tokens[strings.ToLower(log.Address.Hex())]
+	if !ok {
+		return nil, nil, false
+	}
+
+	event, err := events.Default().Decode(log)
+	if err != nil {
+		return nil, nil, false
+	}
+	transfer, ok := event.(*events.Transfer)
+	if !ok {
+		return nil, nil, false
+	}
+
+	to, ok := monitored.addresses[strings.ToLower(transfer.To.Hex())]
+	if !ok {
+		return nil, nil, false
+	}
+
+	return token, &types.TokenTransferEvent{
+		BlockNumber: int64(log.BlockNumber),
+		TxHash:      log.TxHash.Hex(),
+		From:        transfer.From.Hex(),
+		To:          to,
+		Value:       utils.FromSubunit(transfer.Value, token.Decimals),
+	}, true
+}
+
+// handleLog runs a Transfer log through the transfer handler, the same pipeline as webhook events
+func (s *WebsocketIndexer) handleLog(ctx context.Context, network *ent.Network, monitored *monitoredSet, log ethtypes.Log) {
+	token, event, ok := transferEvent(monitored, log)
+	if !ok {
+		return
+	}
+
+	if IsGatewayRefund(network, event.From) {
+		return
+	}
+
+	// Skip transfers already indexed through a webhook or a poll
+	indexed, err := storage.Client.TransactionLog.
+		Query().
+		Where(transactionlog.TxHashEQ(event.TxHash)).
+		Exist(ctx)
+	if err != nil {
+		logger.Errorf("Failed to check transaction log for %s: %v", event.TxHash, err)
+		return
+	}
+	if indexed {
+		return
+	}
+
+	if err := s.handleTransfer(ctx, token, event); err != nil {
+		logger.WithFields(logger.Fields{
+			"Network": network.Identifier,
+			"TxHash":  event.TxHash,
+			"To":      event.To,
+			"Error":   fmt.Sprintf("%v", err),
+		}).Errorf("Failed to process WebSocket transfer")
+		return
+	}
+	metrics.PaymentDetected(metrics.SourceWebsocket, network.Identifier)
+
+	logger.WithFields(logger.Fields{
+		"Network": network.Identifier,
+		"TxHash":  event.TxHash,
+		"To":      event.To,
+		"Amount":  event.Value,
+	}).Infof("✅ Deposit processed via WebSocket indexer")
+}
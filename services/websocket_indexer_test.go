@@ -0,0 +1,133 @@
+package services
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	"github.com/NEDA-LABS/stablenode/services/events"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/test"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebsocketIndexer(t *testing.T) {
+	token := &ent.Token{ContractAddress: "0x4444444444444444444444444444444444444444", Decimals: 6}
+	receiveAddress := "0xAbCdEf0123456789aBcDeF0123456789AbCdEf01"
+	sender := common.HexToAddress("0x5555555555555555555555555555555555555555")
+
+	monitored := &monitoredSet{
+		tokens:    map[string]*ent.Token{token.ContractAddress: token},
+		addresses: map[string]string{"0xabcdef0123456789abcdef0123456789abcdef01": receiveAddress},
+	}
+
+	transferLog := func(contract string, to string) ethtypes.Log {
+		return ethtypes.Log{
+			Address: common.HexToAddress(contract),
+			Topics: []common.Hash{
+				events.Topic(events.ERC20, "Transfer"),
+				common.BytesToHash(sender.Bytes()),
+				common.BytesToHash(common.HexToAddress(to).Bytes()),
+			},
+			Data:        common.LeftPadBytes(big.NewInt(12500000).Bytes(), 32),
+			BlockNumber: 100,
+			TxHash:      common.HexToHash("0x01"),
+		}
+	}
+
+	t.Run("should filter Transfer logs of monitored tokens to monitored addresses", func(t *testing.T) {
+		query := transferFilter(monitored)
+		assert.Equal(t, []common.Address{common.HexToAddress(token.ContractAddress)}, query.Addresses)
+		assert.Equal(t, events.Topic(events.ERC20, "Transfer"), query.Topics[0][0])
+		assert.Nil(t, query.Topics[1])
+		assert.Equal(t, common.BytesToHash(common.HexToAddress(receiveAddress).Bytes()), query.Topics[2][0])
+	})
+
+	t.Run("should decode a transfer to the address as stored", func(t *testing.T) {
+		eventToken, event, ok := transferEvent(monitored, transferLog(token.ContractAddress, receiveAddress))
+		assert.True(t, ok)
+		assert.Equal(t, token, eventToken)
+		assert.Equal(t, receiveAddress, event.To)
+		assert.Equal(t, sender.Hex(), event.From)
+		assert.Equal(t, int64(100), event.BlockNumber)
+		assert.True(t, event.Value.Equal(decimal.NewFromFloat(12.5)))
+	})
+
+	t.Run("should skip transfers of other tokens", func(t *testing.T) {
+		_, _, ok := transferEvent(monitored, transferLog("0x6666666666666666666666666666666666666666", receiveAddress))
+		assert.False(t, ok)
+	})
+
+	t.Run("should skip transfers to other addresses", func(t *testing.T) {
+		_, _, ok := transferEvent(monitored, transferLog(token.ContractAddress, "0x7777777777777777777777777777777777777777"))
+		assert.False(t, ok)
+	})
+
+	t.Run("should skip removed logs", func(t *testing.T) {
+		log := transferLog(token.ContractAddress, receiveAddress)
+		log.Removed = true
+		_, _, ok := transferEvent(monitored, log)
+		assert.False(t, ok)
+	})
+}
+
+func TestWebsocketIndexerNetworks(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:websocketindexer?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	createNetwork := func(identifier string, chainID int64, enabled bool) *ent.Network {
+		network, err := test.CreateTestNetwork(map[string]interface{}{
+			"identifier": identifier,
+			"chainID":    chainID,
+			"networkRPC": "http://127.0.0.1:1",
+			"fee":        0.0,
+			"is_enabled": enabled,
+		})
+		assert.NoError(t, err)
+		return network.Update().
+			SetWssEndpoint("ws://127.0.0.1:1").
+			SetWebsocketEnabled(true).
+			SaveX(ctx)
+	}
+	base := createNetwork("base-sepolia", 84532, true)
+
+	indexer := NewWebsocketIndexer(nil)
+	indexer.reconnectDelay = time.Hour
+	watching := make(map[int]*watchedNetwork)
+
+	assert.NoError(t, indexer.syncNetworks(ctx, watching))
+	assert.Len(t, watching, 1)
+	assert.Contains(t, watching, base.ID)
+
+	t.Run("should pick up networks once enabled", func(t *testing.T) {
+		linea := createNetwork("linea-sepolia", 59141, false)
+		assert.NoError(t, indexer.syncNetworks(ctx, watching))
+		assert.NotContains(t, watching, linea.ID)
+
+		linea.Update().SetIsEnabled(true).ExecX(ctx)
+		assert.NoError(t, indexer.syncNetworks(ctx, watching))
+		assert.Contains(t, watching, linea.ID)
+	})
+
+	t.Run("should resubscribe when the endpoint changes and stop once disabled", func(t *testing.T) {
+		watched := watching[base.ID]
+		base.Update().SetWssEndpoint("ws://127.0.0.1:2").ExecX(ctx)
+		assert.NoError(t, indexer.syncNetworks(ctx, watching))
+		assert.NotSame(t, watched, watching[base.ID])
+		assert.Equal(t, "ws://127.0.0.1:2", watching[base.ID].wssEndpoint)
+
+		base.Update().SetIsEnabled(false).ExecX(ctx)
+		assert.NoError(t, indexer.syncNetworks(ctx, watching))
+		assert.NotContains(t, watching, base.ID)
+	})
+}
@@ -0,0 +1,102 @@
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/redis/go-redis/v9"
+)
+
+// lookupCacheVersionKey holds the generation of the lookup cache
+// Bumping it invalidates every cached lookup at once without scanning keys
+const lookupCacheVersionKey = "lookup_cache_version"
+
+// lookupCacheKey builds a cache key scoped to the current cache generation
+func lookupCacheKey(ctx context.Context, parts ...string) (string, error) {
+	version, err := RedisClient.Get(ctx, lookupCacheVersionKey).Int64()
+	if err != nil && err != redis.Nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("lookup_cache_%d_%s", version, strings.Join(parts, "_")), nil
+}
+
+// GetCachedLookup decodes a cached lookup into v and reports whether it was found
+// Always a miss when Redis is not initialized
+func GetCachedLookup(ctx context.Context, v interface{}, parts ...string) bool {
+	if RedisClient == nil {
+		return false
+	}
+
+	key, err := lookupCacheKey(ctx, parts...)
+	if err != nil {
+		return false
+	}
+
+	data, err := RedisClient.Get(ctx, key).Bytes()
+	if err != nil {
+		return false
+	}
+
+	return json.Unmarshal(data, v) == nil
+}
+
+// SetCachedLookup caches v for LOOKUP_CACHE_TTL; failures are logged and otherwise ignored
+func SetCachedLookup(ctx context.Context, v interface{}, parts ...string) {
+	if RedisClient == nil {
+		return
+	}
+
+	key, err := lookupCacheKey(ctx, parts...)
+	if err != nil {
+		return
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return
+	}
+
+	if err := RedisClient.Set(ctx, key, data, config.RedisConfig().LookupCacheTTL).Err(); err != nil {
+		logger.WithFields(logger.Fields{
+			"Error": err.Error(),
+			"Key":   key,
+		}).Warnf("Failed to cache lookup")
+	}
+}
+
+// InvalidateLookupCache drops every cached institution, fiat currency and token lookup
+func InvalidateLookupCache(ctx context.Context) {
+	if RedisClient == nil {
+		return
+	}
+
+	if err := RedisClient.Incr(ctx, lookupCacheVersionKey).Err(); err != nil {
+		logger.Errorf("Failed to invalidate lookup cache: %v", err)
+	}
+}
+
+// invalidateLookupCacheHook invalidates the lookup cache after a successful mutation
+func invalidateLookupCacheHook(next ent.Mutator) ent.Mutator {
+	return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		value, err := next.Mutate(ctx, m)
+		if err == nil {
+			InvalidateLookupCache(ctx)
+		}
+		return value, err
+	})
+}
+
+// RegisterLookupCacheHooks keeps cached lookups consistent with institution, fiat currency, token
+// and network writes, including market rate updates and tokens being enabled, disabled or depegged
+func RegisterLookupCacheHooks(client *ent.Client) {
+	client.Institution.Use(invalidateLookupCacheHook)
+	client.FiatCurrency.Use(invalidateLookupCacheHook)
+	client.Token.Use(invalidateLookupCacheHook)
+	client.Network.Use(invalidateLookupCacheHook)
+}
@@ -64,6 +64,8 @@ func DBConnection(DSN string) error {
 		}
 	}
 
+	RegisterLookupCacheHooks(client)
+
 	Client = client
 
 	return nil
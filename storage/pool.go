@@ -0,0 +1,303 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/ent/predicate"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+)
+
+// ErrPoolEmpty is returned when a network has no assignable pool addresses
+var ErrPoolEmpty = errors.New("no pool addresses available")
+
+// PoolStatus returns the receive address pool inventory per network
+// An empty networkIdentifier returns every network that has pool addresses
+func PoolStatus(ctx context.Context, networkIdentifier string) ([]types.PoolNetworkStatus, error) {
+	query := Client.ReceiveAddress.
+		Query().
+		Where(receiveaddress.NetworkIdentifierNEQ(""))
+	if networkIdentifier != "" {
+		query = query.Where(receiveaddress.NetworkIdentifierEQ(networkIdentifier))
+	}
+
+	var counts []struct {
+		NetworkIdentifier string `json:"network_identifier"`
+		Status            string `json:"status"`
+		IsDeployed        bool   `json:"is_deployed"`
+		Count             int    `json:"count"`
+	}
+	err := query.Clone().
+		GroupBy(
+			receiveaddress.FieldNetworkIdentifier,
+			receiveaddress.FieldStatus,
+			receiveaddress.FieldIsDeployed,
+		).
+		Aggregate(ent.Count()).
+		Scan(ctx, &counts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count pool addresses: %w", err)
+	}
+
+	statuses := make(map[string]*types.PoolNetworkStatus)
+	for _, c := range counts {
+		status, ok := statuses[c.NetworkIdentifier]
+		if !ok {
+			status = &types.PoolNetworkStatus{Network: c.NetworkIdentifier}
+			statuses[c.NetworkIdentifier] = status
+		}
+
+		if !c.IsDeployed {
+			status.NotDeployed += c.Count
+			continue
+		}
+
+		switch receiveaddress.Status(c.Status) {
+		case receiveaddress.StatusPoolReady:
+			status.PoolReady += c.Count
+		case receiveaddress.StatusPoolAssigned:
+			status.PoolAssigned += c.Count
+		case receiveaddress.StatusPoolProcessing:
+			status.PoolProcessing += c.Count
+		case receiveaddress.StatusPoolCompleted:
+			status.PoolCompleted += c.Count
+		}
+	}
+
+	// Usage is tracked on the pool master rows; per-order rows always have times_used = 0
+	var usage []struct {
+		NetworkIdentifier string  `json:"network_identifier"`
+		Mean              float64 `json:"mean"`
+	}
+	err = query.Clone().
+		Where(
+			receiveaddress.StatusEQ(receiveaddress.StatusPoolReady),
+			receiveaddress.IsDeployedEQ(true),
+		).
+		GroupBy(receiveaddress.FieldNetworkIdentifier).
+		Aggregate(ent.As(ent.Mean(receiveaddress.FieldTimesUsed), "mean")).
+		Scan(ctx, &usage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to average pool address usage: %w", err)
+	}
+
+	for _, u := range usage {
+		if status, ok := statuses[u.NetworkIdentifier]; ok {
+			status.AverageTimesUsed = u.Mean
+		}
+	}
+
+	result := make([]types.PoolNetworkStatus, 0, len(statuses))
+	for network, status := range statuses {
+		if status.PoolAssigned > 0 {
+			oldest, err := Client.ReceiveAddress.
+				Query().
+				Where(
+					receiveaddress.NetworkIdentifierEQ(network),
+					receiveaddress.StatusEQ(receiveaddress.StatusPoolAssigned),
+					receiveaddress.AssignedAtNotNil(),
+				).
+				Order(ent.Asc(receiveaddress.FieldAssignedAt)).
+				First(ctx)
+			if err != nil && !ent.IsNotFound(err) {
+				return nil, fmt.Errorf("failed to fetch oldest assignment for %s: %w", network, err)
+			}
+			if oldest != nil {
+				age := int64(time.Since(oldest.AssignedAt).Seconds())
+				status.OldestAssignmentAge = &age
+			}
+		}
+
+		result = append(result, *status)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Network < result[j].Network
+	})
+
+	return result, nil
+}
+
+// assignable matches the pool rows that can be assigned to orders: deployed ones, or with
+// counterfactual addresses enabled, any with a salt to deploy them from when first used
+func assignable() predicate.ReceiveAddress {
+	if config.PoolConfig().CounterfactualAddresses {
+		return receiveaddress.Or(
+			receiveaddress.IsDeployedEQ(true),
+			receiveaddress.SaltNotNil(),
+		)
+	}
+	return receiveaddress.IsDeployedEQ(true)
+}
+
+// notHoldingRefunds leaves out the pool addresses still holding a payment toward an expired order
+// that was not refunded yet, so no new order is settled out of funds that belong to its payer
+func notHoldingRefunds() predicate.ReceiveAddress {
+	return func(s *sql.Selector) {
+		held := sql.Table(receiveaddress.Table).As("held")
+		orders := sql.Table(paymentorder.Table)
+		s.Where(sql.Not(sql.Exists(
+			sql.Select(held.C(receiveaddress.FieldID)).
+				From(held).
+				Join(orders).
+				On(held.C(receiveaddress.PaymentOrderColumn), orders.C(paymentorder.FieldID)).
+				Where(sql.And(
+					sql.ColumnsEQ(held.C(receiveaddress.FieldAddress), s.C(receiveaddress.FieldAddress)),
+					sql.ColumnsEQ(held.C(receiveaddress.FieldNetworkIdentifier), s.C(receiveaddress.FieldNetworkIdentifier)),
+					sql.EQ(orders.C(paymentorder.FieldStatus), paymentorder.StatusExpired.String()),
+					sql.ColumnsGT(orders.C(paymentorder.FieldAmountPaid), orders.C(paymentorder.FieldAmountReturned)),
+				)),
+		)))
+	}
+}
+
+// AssignPoolAddress assigns the least-used assignable pool address on a network to a new order.
+// Pool addresses are shared by concurrent orders, so a new pool_assigned row is created for the
+// order and the pool row only tracks usage. Addresses in exclude are never assigned. Returns a not
+// found error when the pool is empty.
+func AssignPoolAddress(ctx context.Context, networkIdentifier string, validity time.Duration, exclude ...string) (*ent.ReceiveAddress, error) {
+	poolAddress, err := Client.ReceiveAddress.
+		Query().
+		Where(
+			receiveaddress.StatusEQ(receiveaddress.StatusPoolReady),
+			assignable(),
+			notHoldingRefunds(),
+			receiveaddress.NetworkIdentifierEQ(networkIdentifier),
+			receiveaddress.AddressNotIn(exclude...),
+		).
+		Order(ent.Asc(receiveaddress.FieldTimesUsed)).
+		First(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.WithFields(logger.Fields{
+		"address":    poolAddress.Address,
+		"network":    networkIdentifier,
+		"pool_id":    poolAddress.ID,
+		"times_used": poolAddress.TimesUsed,
+	}).Infof("Using pool address - creating new row for order")
+
+	receiveAddress, err := Client.ReceiveAddress.
+		Create().
+		SetAddress(poolAddress.Address).
+		SetStatus(receiveaddress.StatusPoolAssigned).
+		SetIsDeployed(poolAddress.IsDeployed).
+		SetNetworkIdentifier(poolAddress.NetworkIdentifier).
+		SetChainID(poolAddress.ChainID).
+		SetAssignedAt(time.Now()).
+		SetValidUntil(time.Now().Add(validity)).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create receive address row for pool address %s: %w", poolAddress.Address, err)
+	}
+
+	_, err = Client.ReceiveAddress.
+		UpdateOne(poolAddress).
+		SetTimesUsed(poolAddress.TimesUsed + 1).
+		SetLastUsed(time.Now()).
+		Save(ctx)
+	if err != nil {
+		// Don't fail the assignment, the counter only balances usage across the pool
+		logger.WithFields(logger.Fields{
+			"error":   err,
+			"pool_id": poolAddress.ID,
+		}).Warnf("Failed to update pool address usage counter")
+	}
+
+	return receiveAddress, nil
+}
+
+// AssignPoolAddresses assigns pool addresses on a network to count new orders at once, through the
+// given client so the assignment can be part of a transaction. The orders are spread round-robin
+// over the least-used assignable pool addresses, and a pool_assigned row is created for every order.
+// Returns ErrPoolEmpty when the network has no pool addresses.
+func AssignPoolAddresses(ctx context.Context, client *ent.Client, networkIdentifier string, validity time.Duration, count int) ([]*ent.ReceiveAddress, error) {
+	poolAddresses, err := client.ReceiveAddress.
+		Query().
+		Where(
+			receiveaddress.StatusEQ(receiveaddress.StatusPoolReady),
+			assignable(),
+			notHoldingRefunds(),
+			receiveaddress.NetworkIdentifierEQ(networkIdentifier),
+		).
+		Order(ent.Asc(receiveaddress.FieldTimesUsed)).
+		Limit(count).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch pool addresses: %w", err)
+	}
+	if len(poolAddresses) == 0 {
+		return nil, ErrPoolEmpty
+	}
+
+	now := time.Now()
+	uses := make([]int, len(poolAddresses))
+	builders := make([]*ent.ReceiveAddressCreate, count)
+	for i := range builders {
+		poolAddress := poolAddresses[i%len(poolAddresses)]
+		uses[i%len(poolAddresses)]++
+		builders[i] = client.ReceiveAddress.
+			Create().
+			SetAddress(poolAddress.Address).
+			SetStatus(receiveaddress.StatusPoolAssigned).
+			SetIsDeployed(poolAddress.IsDeployed).
+			SetNetworkIdentifier(poolAddress.NetworkIdentifier).
+			SetChainID(poolAddress.ChainID).
+			SetAssignedAt(now).
+			SetValidUntil(now.Add(validity))
+	}
+
+	receiveAddresses, err := client.ReceiveAddress.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create receive address rows on %s: %w", networkIdentifier, err)
+	}
+
+	for i, poolAddress := range poolAddresses {
+		_, err = client.ReceiveAddress.
+			UpdateOne(poolAddress).
+			AddTimesUsed(uses[i]).
+			SetLastUsed(now).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update usage of pool address %s: %w", poolAddress.Address, err)
+		}
+	}
+
+	logger.WithFields(logger.Fields{
+		"network":        networkIdentifier,
+		"orders":         count,
+		"pool_addresses": len(poolAddresses),
+	}).Infof("Assigned pool addresses to a batch of orders")
+
+	return receiveAddresses, nil
+}
+
+// PoolInventory returns the number of receive addresses per network and pool status
+func PoolInventory(ctx context.Context) (map[string]map[string]int, error) {
+	statuses, err := PoolStatus(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	inventory := make(map[string]map[string]int, len(statuses))
+	for _, status := range statuses {
+		inventory[status.Network] = map[string]int{
+			string(receiveaddress.StatusPoolReady):      status.PoolReady,
+			string(receiveaddress.StatusPoolAssigned):   status.PoolAssigned,
+			string(receiveaddress.StatusPoolProcessing): status.PoolProcessing,
+			string(receiveaddress.StatusPoolCompleted):  status.PoolCompleted,
+			"not_deployed": status.NotDeployed,
+		}
+	}
+	return inventory, nil
+}
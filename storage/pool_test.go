@@ -0,0 +1,72 @@
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	"github.com/NEDA-LABS/stablenode/ent/receiveaddress"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssignPoolAddress(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:assignpooladdress?mode=memory&_fk=1")
+	defer client.Close()
+	Client = client
+
+	ctx := context.Background()
+
+	counterfactual := client.ReceiveAddress.
+		Create().
+		SetAddress("0x1111111111111111111111111111111111111111").
+		SetSalt([]byte("encrypted salt")).
+		SetStatus(receiveaddress.StatusPoolReady).
+		SetNetworkIdentifier("base-sepolia").
+		SetChainID(84532).
+		SaveX(ctx)
+
+	t.Run("should not assign undeployed addresses by default", func(t *testing.T) {
+		_, err := AssignPoolAddress(ctx, "base-sepolia", time.Hour)
+		assert.Error(t, err)
+
+		_, err = AssignPoolAddresses(ctx, client, "base-sepolia", time.Hour, 2)
+		assert.ErrorIs(t, err, ErrPoolEmpty)
+	})
+
+	t.Run("should assign counterfactual addresses when enabled", func(t *testing.T) {
+		viper.Set("POOL_COUNTERFACTUAL_ADDRESSES", true)
+		defer viper.Set("POOL_COUNTERFACTUAL_ADDRESSES", false)
+
+		assigned, err := AssignPoolAddress(ctx, "base-sepolia", time.Hour)
+		assert.NoError(t, err)
+		assert.Equal(t, counterfactual.Address, assigned.Address)
+		assert.Equal(t, receiveaddress.StatusPoolAssigned, assigned.Status)
+		assert.False(t, assigned.IsDeployed)
+
+		batch, err := AssignPoolAddresses(ctx, client, "base-sepolia", time.Hour, 2)
+		assert.NoError(t, err)
+		if assert.Len(t, batch, 2) {
+			assert.False(t, batch[0].IsDeployed)
+		}
+		assert.Equal(t, 3, client.ReceiveAddress.GetX(ctx, counterfactual.ID).TimesUsed)
+	})
+
+	t.Run("should never assign pool rows without a salt before deployment", func(t *testing.T) {
+		viper.Set("POOL_COUNTERFACTUAL_ADDRESSES", true)
+		defer viper.Set("POOL_COUNTERFACTUAL_ADDRESSES", false)
+
+		client.ReceiveAddress.
+			Create().
+			SetAddress("0x2222222222222222222222222222222222222222").
+			SetStatus(receiveaddress.StatusPoolReady).
+			SetNetworkIdentifier("base-sepolia").
+			SetChainID(84532).
+			SaveX(ctx)
+
+		_, err := AssignPoolAddress(ctx, "base-sepolia", time.Hour, counterfactual.Address)
+		assert.Error(t, err)
+	})
+}
@@ -5,7 +5,6 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
-	"math"
 	"strings"
 	"sync"
 	"time"
@@ -29,17 +28,25 @@ import (
 	"github.com/NEDA-LABS/stablenode/ent/senderprofile"
 	tokenent "github.com/NEDA-LABS/stablenode/ent/token"
 	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
+	"github.com/NEDA-LABS/stablenode/ent/webhookdestination"
 	"github.com/NEDA-LABS/stablenode/ent/webhookretryattempt"
 	"github.com/NEDA-LABS/stablenode/services"
 	"github.com/NEDA-LABS/stablenode/services/common"
+	"github.com/NEDA-LABS/stablenode/services/compliance"
 	"github.com/NEDA-LABS/stablenode/services/email"
+	"github.com/NEDA-LABS/stablenode/services/health"
 	"github.com/NEDA-LABS/stablenode/services/indexer"
+	"github.com/NEDA-LABS/stablenode/services/ledger"
+	"github.com/NEDA-LABS/stablenode/services/marketrate"
 	orderService "github.com/NEDA-LABS/stablenode/services/order"
 	"github.com/NEDA-LABS/stablenode/storage"
 	"github.com/NEDA-LABS/stablenode/types"
 	"github.com/NEDA-LABS/stablenode/utils"
 	cryptoUtils "github.com/NEDA-LABS/stablenode/utils/crypto"
+	"github.com/NEDA-LABS/stablenode/utils/lock"
 	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/NEDA-LABS/stablenode/utils/ratelimit"
+	"github.com/NEDA-LABS/stablenode/utils/shutdown"
 	tokenUtils "github.com/NEDA-LABS/stablenode/utils/token"
 	"github.com/redis/go-redis/v9"
 	"github.com/shopspring/decimal"
@@ -50,9 +57,7 @@ var serverConf = config.ServerConfig()
 
 // RetryStaleUserOperations retries stale user operations
 // TODO: Fetch failed orders from a separate db table and process them
-func RetryStaleUserOperations() error {
-	ctx := context.Background()
-
+func RetryStaleUserOperations(ctx context.Context) error {
 	var wg sync.WaitGroup
 
 	// Create initiated orders
@@ -89,7 +94,9 @@ func RetryStaleUserOperations() error {
 			orderAmountWithFees := order.Amount.Add(order.NetworkFee).Add(order.SenderFee)
 			if order.AmountPaid.GreaterThanOrEqual(orderAmountWithFees) {
 				var service types.OrderService
-				if strings.HasPrefix(order.Edges.Token.Edges.Network.Identifier, "tron") {
+				if order.Edges.Token.Edges.Network.NetworkType == networkent.NetworkTypeSolana {
+					service = orderService.NewOrderSolana()
+				} else if strings.HasPrefix(order.Edges.Token.Edges.Network.Identifier, "tron") {
 					service = orderService.NewOrderTron()
 				} else {
 					service = orderService.NewOrderEVM()
@@ -274,12 +281,26 @@ func RetryStaleUserOperations() error {
 	// 	}
 	// }(ctx)
 
+	// The retries run under the job's lock, so they stop with it
+	wg.Wait()
 	return nil
 }
 
+// rpcBudgetLow reports whether a polling or backfill task should skip its run, leaving the RPC
+// budget to webhooks and settlements until providers have headroom again
+func rpcBudgetLow(task string) bool {
+	if !ratelimit.Default().Shedding() {
+		return false
+	}
+	logger.Debugf("%s skipped, RPC budget reserved for webhooks and settlements", task)
+	return true
+}
+
 // TaskIndexBlockchainEvents indexes transfer events for all enabled tokens
-func TaskIndexBlockchainEvents() error {
-	ctx := context.Background()
+func TaskIndexBlockchainEvents(ctx context.Context) error {
+	if rpcBudgetLow("TaskIndexBlockchainEvents") {
+		return nil
+	}
 
 	// Fetch networks
 	isTestnet := false
@@ -295,6 +316,7 @@ func TaskIndexBlockchainEvents() error {
 			// 	networkent.IdentifierEQ("lisk"),
 			// ),
 			networkent.Not(networkent.IdentifierHasPrefix("tron")),
+			networkent.NetworkTypeNEQ(networkent.NetworkTypeSolana),
 		).
 		All(ctx)
 	if err != nil {
@@ -305,7 +327,7 @@ func TaskIndexBlockchainEvents() error {
 	for _, network := range networks {
 		go func(network *ent.Network) {
 			// Create a new context for this network's operations
-			ctx := context.Background()
+			ctx := ratelimit.WithPriority(context.Background(), ratelimit.PriorityPolling)
 			var indexerInstance types.Indexer
 
 			if strings.HasPrefix(network.Identifier, "tron") {
@@ -460,10 +482,9 @@ func reassignCancelledOrder(ctx context.Context, order *ent.LockPaymentOrder, fu
 }
 
 // SyncLockOrderFulfillments syncs lock order fulfillments
-func SyncLockOrderFulfillments() {
+func SyncLockOrderFulfillments(ctx context.Context) {
 	// ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	// defer cancel()
-	ctx := context.Background()
 
 	// Query unvalidated lock orders.
 	lockOrders, err := storage.Client.LockPaymentOrder.
@@ -523,6 +544,14 @@ func SyncLockOrderFulfillments() {
 		if order.Edges.Provider == nil {
 			continue
 		}
+		apiKey := utils.PrimaryAPIKey(order.Edges.Provider.Edges.APIKey)
+		if apiKey == nil {
+			logger.WithFields(logger.Fields{
+				"OrderID":    order.ID.String(),
+				"ProviderID": order.Edges.Provider.ID,
+			}).Errorf("SyncLockOrderFulfillments: provider has no API key")
+			continue
+		}
 		if len(order.Edges.Fulfillments) == 0 {
 			if order.Status == lockpaymentorder.StatusCancelled {
 				reassignCancelledOrder(ctx, order, nil)
@@ -530,7 +559,7 @@ func SyncLockOrderFulfillments() {
 			}
 
 			// Compute HMAC
-			decodedSecret, err := base64.StdEncoding.DecodeString(order.Edges.Provider.Edges.APIKey.Secret)
+			decodedSecret, err := base64.StdEncoding.DecodeString(apiKey.Secret)
 			if err != nil {
 				logger.WithFields(logger.Fields{
 					"Error":      fmt.Sprintf("%v", err),
@@ -678,7 +707,7 @@ func SyncLockOrderFulfillments() {
 			for _, fulfillment := range order.Edges.Fulfillments {
 				if fulfillment.ValidationStatus == lockorderfulfillment.ValidationStatusPending {
 					// Compute HMAC
-					decodedSecret, err := base64.StdEncoding.DecodeString(order.Edges.Provider.Edges.APIKey.Secret)
+					decodedSecret, err := base64.StdEncoding.DecodeString(apiKey.Secret)
 					if err != nil {
 						logger.WithFields(logger.Fields{
 							"Error":      fmt.Sprintf("%v", err),
@@ -831,47 +860,68 @@ func ReassignStaleOrderRequest(ctx context.Context, orderRequestChan <-chan *red
 			continue
 		}
 
-		// Get the order from the database
-		order, err := storage.Client.LockPaymentOrder.
-			Query().
-			Where(
-				lockpaymentorder.IDEQ(orderUUID),
-			).
-			WithProvisionBucket().
-			Only(ctx)
+		done, ok := shutdown.Default().Track()
+		if !ok {
+			return
+		}
+
+		// Every aggregator instance receives the keyspace event, but only one reassigns the order
+		_, err = lock.Default().Run(ctx, "reassign:"+orderID, func(ctx context.Context) error {
+			reassignStaleOrder(ctx, orderUUID)
+			return nil
+		})
 		if err != nil {
 			logger.WithFields(logger.Fields{
 				"Error":   fmt.Sprintf("%v", err),
-				"OrderID": order.ID.String(),
-				"UUID":    orderUUID,
-			}).Errorf("ReassignStaleOrderRequest: Failed to get order from database")
-			continue
+				"OrderID": orderID,
+			}).Errorf("ReassignStaleOrderRequest: Failed to take reassignment lock")
 		}
+		done()
+	}
+}
 
-		orderFields := types.LockPaymentOrderFields{
-			ID:                order.ID,
-			GatewayID:         order.GatewayID,
-			Amount:            order.Amount,
-			Rate:              order.Rate,
-			BlockNumber:       order.BlockNumber,
-			Institution:       order.Institution,
-			AccountIdentifier: order.AccountIdentifier,
-			AccountName:       order.AccountName,
-			Memo:              order.Memo,
-			ProvisionBucket:   order.Edges.ProvisionBucket,
-		}
+// reassignStaleOrder assigns a lock order whose order request went stale to another provider
+func reassignStaleOrder(ctx context.Context, orderUUID uuid.UUID) {
+	// Get the order from the database
+	order, err := storage.Client.LockPaymentOrder.
+		Query().
+		Where(
+			lockpaymentorder.IDEQ(orderUUID),
+		).
+		WithProvisionBucket().
+		Only(ctx)
+	if err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":   fmt.Sprintf("%v", err),
+			"OrderID": order.ID.String(),
+			"UUID":    orderUUID,
+		}).Errorf("ReassignStaleOrderRequest: Failed to get order from database")
+		return
+	}
 
-		// Assign the order to a provider
-		err = services.NewPriorityQueueService().AssignLockPaymentOrder(ctx, orderFields)
-		if err != nil {
-			// logger.Errorf("ReassignStaleOrderRequest.AssignLockPaymentOrder: %v", err)
-			logger.WithFields(logger.Fields{
-				"Error":     fmt.Sprintf("%v", err),
-				"OrderID":   order.ID.String(),
-				"UUID":      orderUUID,
-				"GatewayID": order.GatewayID,
-			}).Errorf("ReassignStaleOrderRequest: Failed to assign order to provider")
-		}
+	orderFields := types.LockPaymentOrderFields{
+		ID:                order.ID,
+		GatewayID:         order.GatewayID,
+		Amount:            order.Amount,
+		Rate:              order.Rate,
+		BlockNumber:       order.BlockNumber,
+		Institution:       order.Institution,
+		AccountIdentifier: order.AccountIdentifier,
+		AccountName:       order.AccountName,
+		Memo:              order.Memo,
+		ProvisionBucket:   order.Edges.ProvisionBucket,
+	}
+
+	// Assign the order to a provider
+	err = services.NewPriorityQueueService().AssignLockPaymentOrder(ctx, orderFields)
+	if err != nil {
+		// logger.Errorf("ReassignStaleOrderRequest.AssignLockPaymentOrder: %v", err)
+		logger.WithFields(logger.Fields{
+			"Error":     fmt.Sprintf("%v", err),
+			"OrderID":   order.ID.String(),
+			"UUID":      orderUUID,
+			"GatewayID": order.GatewayID,
+		}).Errorf("ReassignStaleOrderRequest: Failed to assign order to provider")
 	}
 }
 
@@ -893,45 +943,12 @@ func ReassignStaleOrderRequest(ctx context.Context, orderRequestChan <-chan *red
 // 	return nil
 // }
 
-// HandleReceiveAddressValidity handles receive address validity
-func HandleReceiveAddressValidity() error {
-	ctx := context.Background()
-
-	// Fetch expired receive addresses that are due for validity check
-	addresses, err := storage.Client.ReceiveAddress.
-		Query().
-		Where(
-			receiveaddress.ValidUntilLTE(time.Now()),
-			receiveaddress.Or(
-				receiveaddress.StatusNEQ(receiveaddress.StatusUsed),
-				receiveaddress.And(
-					receiveaddress.StatusEQ(receiveaddress.StatusUsed),
-					receiveaddress.HasPaymentOrderWith(
-						paymentorder.StatusEQ(paymentorder.StatusInitiated),
-					),
-				),
-			),
-			receiveaddress.HasPaymentOrder(),
-		).
-		WithPaymentOrder(func(po *ent.PaymentOrderQuery) {
-			po.WithToken(func(tq *ent.TokenQuery) {
-				tq.WithNetwork()
-			})
-			po.WithRecipient()
-			po.WithSenderProfile()
-		}).
-		All(ctx)
+// ExpireOrders expires unpaid orders past their TTL and releases their receive addresses
+func ExpireOrders(ctx context.Context) error {
+	_, err := common.ExpireOrders(ctx)
 	if err != nil {
-		return fmt.Errorf("HandleReceiveAddressValidity: %w", err)
-	}
-
-	for _, address := range addresses {
-		err := common.HandleReceiveAddressValidity(ctx, address, address.Edges.PaymentOrder)
-		if err != nil {
-			continue
-		}
+		return fmt.Errorf("ExpireOrders: %w", err)
 	}
-
 	return nil
 }
 
@@ -950,138 +967,64 @@ func SubscribeToRedisKeyspaceEvents() {
 	orderRequestChan := orderRequest.Channel()
 
 	go ReassignStaleOrderRequest(ctx, orderRequestChan)
-}
-
-// fetchExternalRate fetches the external rate for a fiat currency
-func fetchExternalRate(currency string) (decimal.Decimal, error) {
-	currency = strings.ToUpper(currency)
-	supportedCurrencies := []string{"KES", "NGN", "GHS", "TZS", "UGX", "XOF", "BRL"}
-	isSupported := false
-	for _, supported := range supportedCurrencies {
-		if currency == supported {
-			isSupported = true
-			break
-		}
-	}
-	if !isSupported {
-		return decimal.Zero, fmt.Errorf("ComputeMarketRate: currency not supported")
-	}
-
-	// Fetch rates from third-party APIs
-	var price decimal.Decimal
-	if currency == "NGN" {
-		res, err := fastshot.NewClient("https://app.quidax.io").
-			Config().SetTimeout(30*time.Second).
-			Build().GET(fmt.Sprintf("/api/v1/markets/tickers/usdt%s", strings.ToLower(currency))).
-			Retry().Set(3, 5*time.Second).
-			Send()
-		if err != nil {
-			return decimal.Zero, fmt.Errorf("ComputeMarketRate: %w", err)
-		}
 
-		data, err := utils.ParseJSONResponse(res.RawResponse)
-		if err != nil {
-			return decimal.Zero, fmt.Errorf("ComputeMarketRate: %w %v", err, data)
-		}
-
-		// Try to use 'buy' price first, fall back to alternatives if buy is zero
-		buyPriceStr := data["data"].(map[string]interface{})["ticker"].(map[string]interface{})["buy"].(string)
-		lastPriceStr := data["data"].(map[string]interface{})["ticker"].(map[string]interface{})["last"].(string)
-		highPriceStr := data["data"].(map[string]interface{})["ticker"].(map[string]interface{})["high"].(string)
-		lowPriceStr := data["data"].(map[string]interface{})["ticker"].(map[string]interface{})["low"].(string)
-
-		var priceStr string
-		if buyPriceStr == "0.0" || buyPriceStr == "0" {
-			// Calculate midpoint between high and low
-			highPrice, err := decimal.NewFromString(highPriceStr)
-			if err != nil {
-				return decimal.Zero, fmt.Errorf("ComputeMarketRate: failed to parse high price: %w", err)
-			}
-			lowPrice, err := decimal.NewFromString(lowPriceStr)
-			if err != nil {
-				return decimal.Zero, fmt.Errorf("ComputeMarketRate: failed to parse low price: %w", err)
-			}
-
-			midpoint := highPrice.Add(lowPrice).Div(decimal.NewFromInt(2))
+	// Drop the cached denylist whenever an instance changes it
+	denylist := storage.RedisClient.PSubscribe(
+		ctx,
+		fmt.Sprintf("__keyspace@0__:%s", compliance.DenylistVersionKey),
+	)
 
-			// Parse last price for comparison
-			lastPrice, err := decimal.NewFromString(lastPriceStr)
-			if err != nil {
-				return decimal.Zero, fmt.Errorf("ComputeMarketRate: failed to parse last price: %w", err)
-			}
+	go compliance.DefaultDenylist().Watch(ctx, denylist.Channel())
 
-			// Use the lower value between midpoint and last price
-			if midpoint.LessThan(lastPrice) {
-				priceStr = midpoint.String()
-			} else {
-				priceStr = lastPrice.String()
-			}
-		} else {
-			// Use 'buy' price when available
-			priceStr = buyPriceStr
-		}
+	// Drop the cached blockchain routes whenever an instance changes them
+	blockchainRoutes := storage.RedisClient.PSubscribe(
+		ctx,
+		fmt.Sprintf("__keyspace@0__:%s", services.BlockchainRoutesVersionKey),
+	)
 
-		price, err = decimal.NewFromString(priceStr)
-		if err != nil {
-			return decimal.Zero, fmt.Errorf("ComputeMarketRate: %w", err)
-		}
-	} else {
-		res, err := fastshot.NewClient("https://p2p.binance.com").
-			Config().SetTimeout(30*time.Second).
-			Header().Add("Content-Type", "application/json").
-			Build().POST("/bapi/c2c/v2/friendly/c2c/adv/search").
-			Retry().Set(3, 5*time.Second).
-			Body().AsJSON(map[string]interface{}{
-			"asset":     "USDT",
-			"fiat":      currency,
-			"tradeType": "SELL",
-			"page":      1,
-			"rows":      20,
-		}).
-			Send()
-		if err != nil {
-			return decimal.Zero, fmt.Errorf("ComputeMarketRate: %w", err)
-		}
+	go services.DefaultBlockchainRouter().Watch(ctx, blockchainRoutes.Channel())
+}
 
-		resData, err := utils.ParseJSONResponse(res.RawResponse)
-		if err != nil {
-			return decimal.Zero, fmt.Errorf("ComputeMarketRate: %w", err)
-		}
+// fetchExternalRate fetches the market rate of a fiat currency from the market rate sources
+func fetchExternalRate(currency string) (decimal.Decimal, error) {
+	quote, err := marketrate.Default().Rate(context.Background(), currency)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("ComputeMarketRate: %w", err)
+	}
 
-		// Access the data array
-		data, ok := resData["data"].([]interface{})
-		if !ok || len(data) == 0 {
-			return decimal.Zero, fmt.Errorf("ComputeMarketRate: No data in the response")
-		}
+	return quote.Rate, nil
+}
 
-		// Loop through the data array and extract prices
-		var prices []decimal.Decimal
-		for _, item := range data {
-			adv, ok := item.(map[string]interface{})["adv"].(map[string]interface{})
-			if !ok {
-				continue
-			}
+// RefreshMarketRates refreshes the cached market rates of enabled fiat currencies, which provider
+// rates are checked against
+func RefreshMarketRates(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
 
-			price, err := decimal.NewFromString(adv["price"].(string))
-			if err != nil {
-				continue
-			}
+	currencies, err := storage.Client.FiatCurrency.
+		Query().
+		Where(fiatcurrency.IsEnabledEQ(true)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("RefreshMarketRates: %w", err)
+	}
 
-			prices = append(prices, price)
+	for _, currency := range currencies {
+		if _, err := marketrate.Default().Refresh(ctx, currency.Code); err != nil {
+			logger.WithFields(logger.Fields{
+				"Error":    fmt.Sprintf("%v", err),
+				"Currency": currency.Code,
+			}).Warnf("Failed to refresh market rate")
 		}
-
-		// Calculate and return the median
-		price = utils.Median(prices)
 	}
 
-	return price, nil
+	return nil
 }
 
 // ComputeMarketRate computes the market price for fiat currencies
-func ComputeMarketRate() error {
+func ComputeMarketRate(ctx context.Context) error {
 	// ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	// defer cancel()
-	ctx := context.Background()
 
 	// Fetch all fiat currencies
 	currencies, err := storage.Client.FiatCurrency.
@@ -1145,10 +1088,9 @@ func ComputeMarketRate() error {
 }
 
 // Retry failed webhook notifications
-func RetryFailedWebhookNotifications() error {
+func RetryFailedWebhookNotifications(ctx context.Context) error {
 	// ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
 	// defer cancel()
-	ctx := context.Background()
 
 	// Fetch failed webhook notifications that are due for retry
 	attempts, err := storage.Client.WebhookRetryAttempt.
@@ -1162,25 +1104,38 @@ func RetryFailedWebhookNotifications() error {
 		return fmt.Errorf("RetryFailedWebhookNotifications: %w", err)
 	}
 
-	baseDelay := 2 * time.Minute
-	maxCumulativeTime := 24 * time.Hour
+	webhookConf := config.WebhookConfig()
 
 	for _, attempt := range attempts {
+		destination, err := utils.GetWebhookDestination(ctx, attempt.WebhookURL)
+		if err != nil {
+			return fmt.Errorf("RetryFailedWebhookNotifications: %w", err)
+		}
+
+		// Notifications to disabled destinations wait until an operator re-enables them
+		if destination.DisabledAt != nil {
+			continue
+		}
+		policy := utils.WebhookPolicy(destination)
+
 		// Send the webhook notification
 		body, err := fastshot.NewClient(attempt.WebhookURL).
-			Config().SetTimeout(30*time.Second).
+			Config().SetTimeout(policy.Timeout).
 			Header().Add("X-Paycrest-Signature", attempt.Signature).
 			Build().POST("").
 			Body().AsJSON(attempt.Payload).
 			Send()
 
-		if err != nil || (body.StatusCode() >= 205) {
+		delivered := err == nil && body.StatusCode() < 205
+		if err := utils.RecordWebhookDelivery(ctx, destination, delivered); err != nil {
+			return fmt.Errorf("RetryFailedWebhookNotifications: %w", err)
+		}
+
+		if !delivered {
 			// Webhook notification failed
 			// Update attempt with next retry time
 			attemptNumber := attempt.AttemptNumber + 1
-			delay := baseDelay * time.Duration(math.Pow(2, float64(attemptNumber-1)))
-
-			nextRetryTime := time.Now().Add(delay)
+			nextRetryTime := time.Now().Add(utils.WebhookRetryDelay(policy, attemptNumber))
 
 			attemptUpdate := attempt.Update()
 
@@ -1188,29 +1143,15 @@ func RetryFailedWebhookNotifications() error {
 				AddAttemptNumber(1).
 				SetNextRetryTime(nextRetryTime)
 
-			// Set status to expired if cumulative time is greater than 24 hours
-			if nextRetryTime.Sub(attempt.CreatedAt.Add(-baseDelay)) > maxCumulativeTime {
+			// Set status to expired once attempts run out or cumulative time exceeds the retry window
+			if attemptNumber >= policy.MaxAttempts || nextRetryTime.Sub(attempt.CreatedAt.Add(-policy.Backoff)) > webhookConf.MaxRetryDuration {
 				attemptUpdate.SetStatus(webhookretryattempt.StatusExpired)
 				uid, err := uuid.Parse(attempt.Payload["data"].(map[string]interface{})["senderId"].(string))
 				if err != nil {
 					return fmt.Errorf("RetryFailedWebhookNotifications.FailedExtraction: %w", err)
 				}
-				profile, err := storage.Client.SenderProfile.
-					Query().
-					Where(
-						senderprofile.IDEQ(uid),
-					).
-					WithUser().
-					Only(ctx)
-				if err != nil {
-					return fmt.Errorf("RetryFailedWebhookNotifications.CouldNotFetchProfile: %w", err)
-				}
-
-				emailService := email.NewEmailServiceWithProviders()
-				_, err = emailService.SendWebhookFailureEmail(ctx, profile.Edges.User.Email, profile.Edges.User.FirstName)
-
-				if err != nil {
-					return fmt.Errorf("RetryFailedWebhookNotifications.SendWebhookFailureEmail: %w", err)
+				if err := sendWebhookFailureEmail(ctx, uid); err != nil {
+					return fmt.Errorf("RetryFailedWebhookNotifications: %w", err)
 				}
 			}
 
@@ -1231,12 +1172,209 @@ func RetryFailedWebhookNotifications() error {
 		}
 	}
 
+	return DisableFailingWebhookDestinations(ctx)
+}
+
+// sendWebhookFailureEmail tells a sender that a webhook notification could not be delivered
+func sendWebhookFailureEmail(ctx context.Context, senderID uuid.UUID) error {
+	profile, err := storage.Client.SenderProfile.
+		Query().
+		Where(
+			senderprofile.IDEQ(senderID),
+		).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		return fmt.Errorf("CouldNotFetchProfile: %w", err)
+	}
+
+	emailService := email.NewEmailServiceWithProviders()
+	_, err = emailService.SendWebhookFailureEmail(ctx, profile.Edges.User.Email, profile.Edges.User.FirstName)
+	if err != nil {
+		return fmt.Errorf("SendWebhookFailureEmail: %w", err)
+	}
+
+	return nil
+}
+
+// ProcessWebhookNotifications delivers queued webhook notifications to senders until ctx is done,
+// requeueing notifications as their retries fall due. It returns once the deliveries in flight
+// when ctx is done have finished
+func ProcessWebhookNotifications(ctx context.Context) {
+	var workers sync.WaitGroup
+	for i := 0; i < config.WebhookConfig().QueueWorkers; i++ {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			deliverWebhookNotifications(ctx)
+		}()
+	}
+
+	ticker := time.NewTicker(5 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			workers.Wait()
+			return
+		case <-ticker.C:
+			if _, err := utils.PromoteDueWebhookNotifications(ctx); err != nil {
+				logger.WithFields(logger.Fields{
+					"Error": fmt.Sprintf("%v", err),
+				}).Errorf("Failed to requeue webhook notifications due for retry")
+			}
+		}
+	}
+}
+
+// ProcessTransactionOutbox sends the settlements and refunds queued in the outbox and tracks them
+// until confirmed, until ctx is done. Each pass runs on one aggregator instance at a time, and the
+// first pass an instance runs resumes transactions left unsent by a stopped run. A pass in flight
+// when ctx is done is finished, so no transaction is left claimed but unsent
+func ProcessTransactionOutbox(ctx context.Context) {
+	worker := services.NewOutboxWorker()
+	resumed := false
+
+	ticker := time.NewTicker(config.OutboxConfig().PollInterval)
+	defer ticker.Stop()
+
+	for {
+		_, err := lock.Default().Run(context.WithoutCancel(ctx), "transaction-outbox", func(ctx context.Context) error {
+			// Claims are only taken under the lock, so while it is held any claim left is stale
+			if !resumed {
+				count, err := worker.Resume(ctx)
+				if err != nil {
+					logger.WithFields(logger.Fields{
+						"Error": fmt.Sprintf("%v", err),
+					}).Errorf("Failed to resume outbox transactions")
+				} else {
+					resumed = true
+					if count > 0 {
+						logger.WithFields(logger.Fields{
+							"Count": count,
+						}).Infof("Resuming outbox transactions left unsent")
+					}
+				}
+			}
+
+			if err := worker.Drain(ctx); err != nil {
+				logger.WithFields(logger.Fields{
+					"Error": fmt.Sprintf("%v", err),
+				}).Errorf("Failed to drain transaction outbox")
+			}
+			if err := worker.Confirm(ctx); err != nil {
+				logger.WithFields(logger.Fields{
+					"Error": fmt.Sprintf("%v", err),
+				}).Errorf("Failed to confirm outbox transactions")
+			}
+			return nil
+		})
+		if err != nil {
+			logger.WithFields(logger.Fields{
+				"Error": fmt.Sprintf("%v", err),
+			}).Errorf("Failed to take transaction outbox lock")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// deliverWebhookNotifications takes webhook notifications off the queue and delivers them
+func deliverWebhookNotifications(ctx context.Context) {
+	for ctx.Err() == nil {
+		notification, err := utils.NextWebhookNotification(ctx, 5*time.Second)
+		if err != nil {
+			if ctx.Err() == nil {
+				logger.WithFields(logger.Fields{
+					"Error": fmt.Sprintf("%v", err),
+				}).Errorf("Failed to fetch webhook notification")
+				time.Sleep(time.Second)
+			}
+			continue
+		}
+		if notification == nil {
+			continue
+		}
+
+		// A notification taken off the queue is delivered and its outcome recorded even when
+		// shutting down, so it is neither lost nor delivered twice
+		deliverCtx := context.WithoutCancel(ctx)
+		deadLettered, err := utils.DeliverWebhookNotification(deliverCtx, notification)
+		if err != nil {
+			logger.WithFields(logger.Fields{
+				"Error":          fmt.Sprintf("%v", err),
+				"NotificationID": notification.ID,
+				"OrderID":        notification.OrderID,
+				"Event":          notification.Event,
+				"Attempts":       notification.Attempts,
+			}).Warnf("Failed to deliver webhook notification")
+		}
+		if deadLettered {
+			if err := sendWebhookFailureEmail(deliverCtx, notification.SenderID); err != nil {
+				logger.WithFields(logger.Fields{
+					"Error":    fmt.Sprintf("%v", err),
+					"SenderID": notification.SenderID,
+				}).Errorf("Failed to send webhook failure email")
+			}
+		}
+	}
+}
+
+// DisableFailingWebhookDestinations disables webhook destinations that have been failing continuously
+// for longer than the configured window and alerts ops
+func DisableFailingWebhookDestinations(ctx context.Context) error {
+	webhookConf := config.WebhookConfig()
+	now := time.Now()
+
+	destinations, err := storage.Client.WebhookDestination.
+		Query().
+		Where(
+			webhookdestination.DisabledAtIsNil(),
+			webhookdestination.FailingSinceLT(now.Add(-webhookConf.DisableAfter)),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("DisableFailingWebhookDestinations: %w", err)
+	}
+
+	for _, destination := range destinations {
+		_, err := destination.Update().
+			SetDisabledAt(now).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("DisableFailingWebhookDestinations: %w", err)
+		}
+
+		logger.WithFields(logger.Fields{
+			"WebhookURL":   destination.URL,
+			"FailingSince": destination.FailingSince,
+			"FailedCount":  destination.FailedCount,
+		}).Warnf("Webhook destination disabled after continuous failures")
+
+		err = services.NewSlackService(serverConf.SlackWebhookURL).SendAlert("Webhook destination disabled", map[string]string{
+			"URL":           destination.URL,
+			"Failing Since": destination.FailingSince.Format(time.RFC3339),
+			"Failed":        fmt.Sprintf("%d", destination.FailedCount),
+			"Delivered":     fmt.Sprintf("%d", destination.DeliveredCount),
+		})
+		if err != nil {
+			logger.Errorf("Failed to send webhook destination alert: %v", err)
+		}
+	}
+
 	return nil
 }
 
 // ResolvePaymentOrderMishaps resolves payment order mishaps across all networks
-func ResolvePaymentOrderMishaps() error {
-	ctx := context.Background()
+func ResolvePaymentOrderMishaps(ctx context.Context) error {
+	if rpcBudgetLow("ResolvePaymentOrderMishaps") {
+		return nil
+	}
 
 	// Fetch networks
 	isTestnet := false
@@ -1254,8 +1392,8 @@ func ResolvePaymentOrderMishaps() error {
 
 	// Process each network in parallel (EVM only)
 	for i, network := range networks {
-		// Skip Tron networks
-		if strings.HasPrefix(network.Identifier, "tron") {
+		// Skip Tron and Solana networks
+		if strings.HasPrefix(network.Identifier, "tron") || network.NetworkType == networkent.NetworkTypeSolana {
 			continue
 		}
 
@@ -1266,7 +1404,7 @@ func ResolvePaymentOrderMishaps() error {
 		}
 
 		go func(network *ent.Network) {
-			ctx := context.Background()
+			ctx := ratelimit.WithPriority(context.Background(), ratelimit.PriorityBackfill)
 
 			// Only resolve missed Transfer and OrderCreated events
 			resolveMissedEvents(ctx, network)
@@ -1277,8 +1415,10 @@ func ResolvePaymentOrderMishaps() error {
 }
 
 // IndexGatewayEvents indexes all gateway events for missed OrderCreated, OrderRefunded, and OrderSettled events
-func IndexGatewayEvents() error {
-	ctx := context.Background()
+func IndexGatewayEvents(ctx context.Context) error {
+	if rpcBudgetLow("IndexGatewayEvents") {
+		return nil
+	}
 
 	// Fetch networks
 	isTestnet := false
@@ -1296,8 +1436,8 @@ func IndexGatewayEvents() error {
 
 	// Process each network in parallel (EVM only)
 	for i, network := range networks {
-		// Skip Tron networks
-		if strings.HasPrefix(network.Identifier, "tron") {
+		// Skip Tron and Solana networks
+		if strings.HasPrefix(network.Identifier, "tron") || network.NetworkType == networkent.NetworkTypeSolana {
 			continue
 		}
 
@@ -1308,7 +1448,7 @@ func IndexGatewayEvents() error {
 		}
 
 		go func(network *ent.Network) {
-			ctx := context.Background()
+			ctx := ratelimit.WithPriority(context.Background(), ratelimit.PriorityBackfill)
 
 			// Index gateway events by fetching last 20 transactions of the gateway contract
 			indexerInstance, indexerErr := indexer.NewIndexerEVM()
@@ -1413,8 +1553,7 @@ func resolveMissedEvents(ctx context.Context, network *ent.Network) {
 }
 
 // ProcessStuckValidatedOrders processes orders stuck on validated status by indexing provider addresses
-func ProcessStuckValidatedOrders() error {
-	ctx := context.Background()
+func ProcessStuckValidatedOrders(ctx context.Context) error {
 
 	// Get all networks
 	networks, err := storage.Client.Network.Query().All(ctx)
@@ -1429,6 +1568,9 @@ func ProcessStuckValidatedOrders() error {
 		}
 
 		go func(network *ent.Network) {
+			// Indexing outlives the run, so it does not stop with the job's lock
+			ctx := context.WithoutCancel(ctx)
+
 			// Get stuck validated orders for this network
 			lockOrders, err := storage.Client.LockPaymentOrder.
 				Query().
@@ -1449,6 +1591,8 @@ func ProcessStuckValidatedOrders() error {
 				WithProvisionBucket(func(pb *ent.ProvisionBucketQuery) {
 					pb.WithCurrency()
 				}).
+				// Orders that breached their settlement SLA are processed first
+				Order(lockpaymentorder.BySLABreachedAt(sql.OrderNullsLast())).
 				All(ctx)
 			if err != nil {
 				logger.WithFields(logger.Fields{
@@ -1618,6 +1762,23 @@ func FetchProviderBalances() error {
 	return nil
 }
 
+// MonitorProviderBalances refreshes provider balances, then snapshots them and alerts on floats below
+// their thresholds, excluding those providers from the queue until replenished
+func MonitorProviderBalances(ctx context.Context) error {
+	if err := FetchProviderBalances(); err != nil {
+		logger.Errorf("MonitorProviderBalances: %v", err)
+	}
+
+	balanceService := services.NewBalanceService(0)
+	defer balanceService.Close()
+
+	err := common.MonitorProviderBalances(ctx, balanceService)
+	if err != nil {
+		return fmt.Errorf("MonitorProviderBalances: %w", err)
+	}
+	return nil
+}
+
 // fetchProviderBalances fetches balances for a specific provider
 func fetchProviderBalances(providerID string) (map[string]*types.ProviderBalance, error) {
 	// Get provider with host identifier
@@ -1753,13 +1914,239 @@ func updateProviderBalance(providerID, currency string, balance *types.ProviderB
 	return nil
 }
 
+// ProcessDepositFinality finalizes soft-confirmed deposits and creates orders that settle on finality
+func ProcessDepositFinality(ctx context.Context) error {
+	err := common.ProcessDepositFinality(ctx, orderService.NewOrderEVM().CreateOrder)
+	if err != nil {
+		return fmt.Errorf("ProcessDepositFinality: %w", err)
+	}
+	return nil
+}
+
+// ProcessDepositConfirmations creates orders whose deposits reached the required confirmations
+func ProcessDepositConfirmations(ctx context.Context) error {
+	err := common.ProcessDepositConfirmations(ctx, orderService.NewOrderEVM().CreateOrder)
+	if err != nil {
+		return fmt.Errorf("ProcessDepositConfirmations: %w", err)
+	}
+	return nil
+}
+
+// reorgMonitor keeps the block hashes it tracks between runs
+var reorgMonitor *common.ReorgMonitor
+
+// MonitorReorgs reconciles orders indexed from blocks orphaned by a chain reorg
+func MonitorReorgs(ctx context.Context) error {
+	err := reorgMonitor.Check(ctx)
+	if err != nil {
+		return fmt.Errorf("MonitorReorgs: %w", err)
+	}
+	return nil
+}
+
+// RunCanaryOrder places a small synthetic order end-to-end and alerts if it misses the SLA
+func RunCanaryOrder(ctx context.Context) error {
+	_, err := services.NewCanaryService().Run(ctx)
+	if err != nil {
+		return fmt.Errorf("RunCanaryOrder: %w", err)
+	}
+	return nil
+}
+
+// EscalateOrderSLAs escalates orders that breached the SLA of their current stage
+func EscalateOrderSLAs(ctx context.Context) error {
+	err := common.EscalateOrderSLAs(ctx, services.NewPriorityQueueService().AssignLockPaymentOrder)
+	if err != nil {
+		return fmt.Errorf("EscalateOrderSLAs: %w", err)
+	}
+	return nil
+}
+
+// ReassignStaleLockOrders reassigns lock orders providers accepted but did not fulfill in time
+func ReassignStaleLockOrders(ctx context.Context) error {
+	count, err := common.ReassignStaleLockOrders(ctx, services.NewPriorityQueueService().AssignLockPaymentOrder)
+	if err != nil {
+		return fmt.Errorf("ReassignStaleLockOrders: %w", err)
+	}
+	if count > 0 {
+		logger.WithFields(logger.Fields{
+			"Count": count,
+		}).Infof("Reassigned stale lock orders")
+	}
+	return nil
+}
+
+// WatchStuckOrders remediates orders stuck in an intermediate state and escalates those it cannot
+func WatchStuckOrders(ctx context.Context) error {
+	balanceService := services.NewBalanceService(0)
+	defer balanceService.Close()
+
+	err := common.WatchStuckOrders(ctx, balanceService, services.NewPriorityQueueService().AssignLockPaymentOrder)
+	if err != nil {
+		return fmt.Errorf("WatchStuckOrders: %w", err)
+	}
+	return nil
+}
+
+// RetryDeadLetters processes the failed stages of indexed events that are due for a retry
+func RetryDeadLetters(ctx context.Context) error {
+	err := common.RetryDeadLetters(ctx)
+	if err != nil {
+		return fmt.Errorf("RetryDeadLetters: %w", err)
+	}
+	return nil
+}
+
+// RefundOverpayments refunds the excess of overpaid orders whose sender opted for refunds
+func RefundOverpayments(ctx context.Context) error {
+	err := common.RefundOverpayments(ctx)
+	if err != nil {
+		return fmt.Errorf("RefundOverpayments: %w", err)
+	}
+	return nil
+}
+
+// RefundPartialPayments refunds partial payments held by expired orders
+func RefundPartialPayments(ctx context.Context) error {
+	err := common.RefundPartialPayments(ctx)
+	if err != nil {
+		return fmt.Errorf("RefundPartialPayments: %w", err)
+	}
+	return nil
+}
+
+// ResubmitStaleUserOperations replaces UserOperations left unmined with higher fees
+func ResubmitStaleUserOperations(ctx context.Context) error {
+	err := services.NewUserOperationResubmitter().ResubmitStale(ctx)
+	if err != nil {
+		return fmt.Errorf("ResubmitStaleUserOperations: %w", err)
+	}
+	return nil
+}
+
+// MonitorDepegs pauses new orders in stablecoins that trade off their peg
+func MonitorDepegs(ctx context.Context) error {
+	err := common.MonitorDepegs(ctx)
+	if err != nil {
+		return fmt.Errorf("MonitorDepegs: %w", err)
+	}
+	return nil
+}
+
+// ReconcileDeposits reconciles the deposits of each network on the previous day against the chain
+func ReconcileDeposits(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Minute)
+	defer cancel()
+
+	if err := common.ReconcileDeposits(ctx); err != nil {
+		return fmt.Errorf("ReconcileDeposits: %w", err)
+	}
+	return nil
+}
+
+// CheckLedger verifies the invariants of the ledger and alerts on the discrepancies found
+func CheckLedger(ctx context.Context) error {
+	report, err := ledger.CheckInvariants(ctx)
+	if err != nil {
+		return fmt.Errorf("CheckLedger: %w", err)
+	}
+
+	for _, discrepancy := range report.Discrepancies {
+		fields := logger.Fields{
+			"Check":    discrepancy.Check,
+			"TokenID":  discrepancy.TokenID,
+			"Account":  discrepancy.Account,
+			"Expected": discrepancy.Expected.String(),
+			"Actual":   discrepancy.Actual.String(),
+		}
+		if discrepancy.PaymentOrderID != nil {
+			fields["OrderID"] = discrepancy.PaymentOrderID.String()
+		}
+		logger.WithFields(fields).Errorf("Ledger invariant broken")
+	}
+
+	if len(report.Discrepancies) > 0 {
+		err = services.NewSlackService(config.ServerConfig().SlackWebhookURL).SendAlert("Ledger invariants broken", map[string]string{
+			"Discrepancies": fmt.Sprintf("%d", len(report.Discrepancies)),
+			"First Check":   report.Discrepancies[0].Check,
+			"Checked At":    report.CheckedAt.Format(time.RFC3339),
+		})
+		if err != nil {
+			logger.Errorf("Failed to send ledger alert: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// CollectOrphans deletes transaction logs and webhook retry attempts orphaned by failed partial writes
+func CollectOrphans(ctx context.Context) error {
+	_, err := common.CollectOrphans(ctx)
+	if err != nil {
+		return fmt.Errorf("CollectOrphans: %w", err)
+	}
+	return nil
+}
+
+// CheckRPCEndpoints health-checks the RPC endpoints of EVM networks so failing and lagging ones
+// are blacklisted before calls reach them
+func CheckRPCEndpoints() error {
+	ctx := context.Background()
+
+	networks, err := storage.Client.Network.
+		Query().
+		Where(
+			networkent.Not(networkent.IdentifierHasPrefix("tron")),
+			networkent.NetworkTypeNEQ(networkent.NetworkTypeSolana),
+		).
+		WithRPCEndpoints().
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("CheckRPCEndpoints: %w", err)
+	}
+
+	services.GetRPCManager().CheckHealth(ctx, networks)
+	return nil
+}
+
+// exclusive wraps a cron job so that only one aggregator instance runs each tick of it. Instances
+// finding the job's lock held skip the tick. A running tick holds up the shutdown until it is done,
+// and no tick starts once the shutdown has begun. The job's context is cancelled if the lock is lost,
+// so it stops before another instance takes the lock over. Every tick records the scheduler's
+// heartbeat for the liveness endpoint
+func exclusive(name string, job func(ctx context.Context) error) func() error {
+	return func() error {
+		health.RecordCronTick()
+
+		done, ok := shutdown.Default().Track()
+		if !ok {
+			return nil
+		}
+		defer done()
+
+		var jobErr error
+		_, err := lock.Default().Run(context.Background(), "cron:"+name, func(ctx context.Context) error {
+			jobErr = job(ctx)
+			return nil
+		})
+		if err != nil {
+			logger.WithFields(logger.Fields{
+				"Error": fmt.Sprintf("%v", err),
+				"Job":   name,
+			}).Errorf("Failed to take cron job lock")
+			return err
+		}
+		return jobErr
+	}
+}
+
 // StartCronJobs starts cron jobs
 func StartCronJobs() {
 	// Use the system's local timezone instead of hardcoded UTC to prevent timezone conflicts
 	scheduler := gocron.NewScheduler(time.Local)
 	priorityQueue := services.NewPriorityQueueService()
 
-	err := ComputeMarketRate()
+	err := ComputeMarketRate(context.Background())
 	if err != nil {
 		logger.Errorf("StartCronJobs for ComputeMarketRate: %v", err)
 	}
@@ -1772,65 +2159,210 @@ func StartCronJobs() {
 	}
 
 	// Compute market rate every 9 minutes
-	_, err = scheduler.Every(9).Minutes().Do(ComputeMarketRate)
+	_, err = scheduler.Every(9).Minutes().Do(exclusive("ComputeMarketRate", ComputeMarketRate))
 	if err != nil {
 		logger.Errorf("StartCronJobs for ComputeMarketRate: %v", err)
 	}
 
 	// Refresh provision bucket priority queues every X minutes
-	_, err = scheduler.Every(orderConf.BucketQueueRebuildInterval).Minutes().Do(priorityQueue.ProcessBucketQueues)
+	_, err = scheduler.Every(orderConf.BucketQueueRebuildInterval).Minutes().Do(exclusive("ProcessBucketQueues", func(ctx context.Context) error {
+		return priorityQueue.ProcessBucketQueues()
+	}))
 	if err != nil {
 		logger.Errorf("StartCronJobs for ProcessBucketQueues: %v", err)
 	}
 
 	// Retry failed webhook notifications every 13 minutes
-	_, err = scheduler.Every(13).Minutes().Do(RetryFailedWebhookNotifications)
+	_, err = scheduler.Every(13).Minutes().Do(exclusive("RetryFailedWebhookNotifications", RetryFailedWebhookNotifications))
 	if err != nil {
 		logger.Errorf("StartCronJobs for RetryFailedWebhookNotifications: %v", err)
 	}
 
 	// Sync lock order fulfillments every 32 seconds
-	_, err = scheduler.Every(32).Seconds().Do(SyncLockOrderFulfillments)
+	_, err = scheduler.Every(32).Seconds().Do(exclusive("SyncLockOrderFulfillments", func(ctx context.Context) error {
+		SyncLockOrderFulfillments(ctx)
+		return nil
+	}))
 	if err != nil {
 		logger.Errorf("StartCronJobs for SyncLockOrderFulfillments: %v", err)
 	}
 
-	// Handle receive address validity every 6 minutes
-	_, err = scheduler.Every(6).Minutes().Do(HandleReceiveAddressValidity)
+	// Expire unpaid orders past their TTL every minute
+	_, err = scheduler.Every(1).Minutes().SingletonMode().Do(exclusive("ExpireOrders", ExpireOrders))
 	if err != nil {
-		logger.Errorf("StartCronJobs for HandleReceiveAddressValidity: %v", err)
+		logger.Errorf("StartCronJobs for ExpireOrders: %v", err)
 	}
 
 	// Retry stale user operations every 60 seconds
-	_, err = scheduler.Every(60).Seconds().Do(RetryStaleUserOperations)
+	_, err = scheduler.Every(60).Seconds().Do(exclusive("RetryStaleUserOperations", RetryStaleUserOperations))
 	if err != nil {
 		logger.Errorf("StartCronJobs for RetryStaleUserOperations: %v", err)
 	}
 
 	// Resolve payment order mishaps every 14 seconds
-	_, err = scheduler.Every(14).Seconds().Do(ResolvePaymentOrderMishaps)
+	_, err = scheduler.Every(14).Seconds().Do(exclusive("ResolvePaymentOrderMishaps", ResolvePaymentOrderMishaps))
 	if err != nil {
 		logger.Errorf("StartCronJobs for ResolvePaymentOrderMishaps: %v", err)
 	}
 
 	// Index gateway events every 6 minutes
-	_, err = scheduler.Every(6).Minutes().Do(IndexGatewayEvents)
+	_, err = scheduler.Every(6).Minutes().Do(exclusive("IndexGatewayEvents", IndexGatewayEvents))
 	if err != nil {
 		logger.Errorf("StartCronJobs for IndexGatewayEvents: %v", err)
 	}
 
 	// Process stuck validated orders every 12 minutes
-	_, err = scheduler.Every(12).Minutes().Do(ProcessStuckValidatedOrders)
+	_, err = scheduler.Every(12).Minutes().Do(exclusive("ProcessStuckValidatedOrders", ProcessStuckValidatedOrders))
 	if err != nil {
 		logger.Errorf("StartCronJobs for ProcessStuckValidatedOrders: %v", err)
 	}
 
 	// Index blockchain events every 4 seconds
-	_, err = scheduler.Every(4).Seconds().Do(TaskIndexBlockchainEvents)
+	_, err = scheduler.Every(4).Seconds().Do(exclusive("TaskIndexBlockchainEvents", TaskIndexBlockchainEvents))
 	if err != nil {
 		logger.Errorf("StartCronJobs for IndexBlockchainEvents: %v", err)
 	}
 
-	// Start scheduler
+	// Finalize soft-confirmed deposits every 30 seconds
+	_, err = scheduler.Every(30).Seconds().SingletonMode().Do(exclusive("ProcessDepositFinality", ProcessDepositFinality))
+	if err != nil {
+		logger.Errorf("StartCronJobs for ProcessDepositFinality: %v", err)
+	}
+
+	// Confirm deposits awaiting confirmations every 15 seconds
+	_, err = scheduler.Every(15).Seconds().SingletonMode().Do(exclusive("ProcessDepositConfirmations", ProcessDepositConfirmations))
+	if err != nil {
+		logger.Errorf("StartCronJobs for ProcessDepositConfirmations: %v", err)
+	}
+
+	// Check recent blocks for reorgs every X seconds
+	reorgConf := config.ReorgConfig()
+	if reorgConf.Enabled {
+		reorgMonitor = common.NewReorgMonitor()
+		_, err = scheduler.Every(reorgConf.Interval).SingletonMode().Do(exclusive("MonitorReorgs", MonitorReorgs))
+		if err != nil {
+			logger.Errorf("StartCronJobs for MonitorReorgs: %v", err)
+		}
+	}
+
+	// Escalate SLA breaches every minute
+	_, err = scheduler.Every(1).Minutes().SingletonMode().Do(exclusive("EscalateOrderSLAs", EscalateOrderSLAs))
+	if err != nil {
+		logger.Errorf("StartCronJobs for EscalateOrderSLAs: %v", err)
+	}
+
+	// Reassign lock orders not fulfilled in time every minute
+	_, err = scheduler.Every(1).Minutes().SingletonMode().Do(exclusive("ReassignStaleLockOrders", ReassignStaleLockOrders))
+	if err != nil {
+		logger.Errorf("StartCronJobs for ReassignStaleLockOrders: %v", err)
+	}
+
+	// Refund overpayments every 2 minutes; singleton mode so an excess is never swept twice
+	_, err = scheduler.Every(2).Minutes().SingletonMode().Do(exclusive("RefundOverpayments", RefundOverpayments))
+	if err != nil {
+		logger.Errorf("StartCronJobs for RefundOverpayments: %v", err)
+	}
+
+	// Refund partial payments of expired orders every 2 minutes; singleton mode so a payment is never swept twice
+	_, err = scheduler.Every(2).Minutes().SingletonMode().Do(exclusive("RefundPartialPayments", RefundPartialPayments))
+	if err != nil {
+		logger.Errorf("StartCronJobs for RefundPartialPayments: %v", err)
+	}
+
+	_, err = scheduler.Every(1).Minutes().SingletonMode().Do(exclusive("ResubmitStaleUserOperations", ResubmitStaleUserOperations))
+	if err != nil {
+		logger.Errorf("StartCronJobs for ResubmitStaleUserOperations: %v", err)
+	}
+
+	// Monitor provider balances every X minutes, starting now
+	_, err = scheduler.Every(config.ProviderBalanceConfig().Interval).SingletonMode().Do(exclusive("MonitorProviderBalances", MonitorProviderBalances))
+	if err != nil {
+		logger.Errorf("StartCronJobs for MonitorProviderBalances: %v", err)
+	}
+
+	// Check stablecoin pegs every X minutes
+	depegConf := config.DepegConfig()
+	if depegConf.Enabled {
+		_, err = scheduler.Every(depegConf.Interval).SingletonMode().Do(exclusive("MonitorDepegs", MonitorDepegs))
+		if err != nil {
+			logger.Errorf("StartCronJobs for MonitorDepegs: %v", err)
+		}
+	}
+
+	// Run a canary order every X minutes; singleton mode so a slow run is never overlapped
+	canaryConf := config.CanaryConfig()
+	if canaryConf.Enabled {
+		_, err = scheduler.Every(canaryConf.Interval).SingletonMode().Do(exclusive("RunCanaryOrder", RunCanaryOrder))
+		if err != nil {
+			logger.Errorf("StartCronJobs for RunCanaryOrder: %v", err)
+		}
+	}
+
+	// Collect orphaned rows every X hours
+	gcConf := config.GarbageCollectionConfig()
+	if gcConf.Enabled {
+		_, err = scheduler.Every(gcConf.Interval).SingletonMode().Do(exclusive("CollectOrphans", CollectOrphans))
+		if err != nil {
+			logger.Errorf("StartCronJobs for CollectOrphans: %v", err)
+		}
+	}
+
+	// Check the ledger invariants every X minutes
+	ledgerConf := config.LedgerConfig()
+	if ledgerConf.CheckEnabled {
+		_, err = scheduler.Every(ledgerConf.CheckInterval).SingletonMode().Do(exclusive("CheckLedger", CheckLedger))
+		if err != nil {
+			logger.Errorf("StartCronJobs for CheckLedger: %v", err)
+		}
+	}
+
+	// Refresh the market rates provider rates are checked against every X minutes
+	marketRateConf := config.MarketRateConfig()
+	if marketRateConf.CheckEnabled {
+		_, err = scheduler.Every(marketRateConf.RefreshInterval).SingletonMode().Do(exclusive("RefreshMarketRates", RefreshMarketRates))
+		if err != nil {
+			logger.Errorf("StartCronJobs for RefreshMarketRates: %v", err)
+		}
+	}
+
+	// Remediate stuck orders every X minutes
+	watchdogConf := config.WatchdogConfig()
+	if watchdogConf.Enabled {
+		_, err = scheduler.Every(watchdogConf.Interval).SingletonMode().Do(exclusive("WatchStuckOrders", WatchStuckOrders))
+		if err != nil {
+			logger.Errorf("StartCronJobs for WatchStuckOrders: %v", err)
+		}
+	}
+
+	// Retry dead letters every X minutes
+	_, err = scheduler.Every(config.DeadLetterConfig().RetryInterval).SingletonMode().Do(exclusive("RetryDeadLetters", RetryDeadLetters))
+	if err != nil {
+		logger.Errorf("StartCronJobs for RetryDeadLetters: %v", err)
+	}
+
+	// Reconcile the previous day's deposits every X minutes, until every network has a completed report
+	reconciliationConf := config.ReconciliationConfig()
+	if reconciliationConf.Enabled {
+		_, err = scheduler.Every(reconciliationConf.Interval).SingletonMode().Do(exclusive("ReconcileDeposits", ReconcileDeposits))
+		if err != nil {
+			logger.Errorf("StartCronJobs for ReconcileDeposits: %v", err)
+		}
+	}
+
+	// Health-check RPC endpoints every X seconds; not exclusive, as every instance keeps its own
+	// view of endpoint health
+	_, err = scheduler.Every(config.RPCConfig().HealthCheckInterval).SingletonMode().Do(CheckRPCEndpoints)
+	if err != nil {
+		logger.Errorf("StartCronJobs for CheckRPCEndpoints: %v", err)
+	}
+
+	// Stop scheduling ticks on shutdown; those already running are drained through exclusive
+	shutdown.Default().OnStop("Cron jobs", func(ctx context.Context) error {
+		scheduler.Clear()
+		return nil
+	})
+
+	// Start scheduler; liveness measures how long it has gone without a tick from now on
+	health.RecordCronTick()
 	scheduler.StartAsync()
 }
@@ -159,7 +159,7 @@ func TestTasks(t *testing.T) {
 				return resp, nil
 			},
 		)
-		err := RetryFailedWebhookNotifications()
+		err := RetryFailedWebhookNotifications(context.Background())
 		assert.NoError(t, err)
 		hook, err := db.Client.WebhookRetryAttempt.
 			Query().
@@ -176,3 +176,16 @@ func TestTasks(t *testing.T) {
 		assert.Equal(t, value, decimal.Zero)
 	})
 }
+
+func TestExclusive(t *testing.T) {
+	var jobCtx context.Context
+	err := exclusive("TestJob", func(ctx context.Context) error {
+		jobCtx = ctx
+		assert.NoError(t, ctx.Err())
+		return fmt.Errorf("job failed")
+	})()
+	assert.EqualError(t, err, "job failed")
+
+	// The job runs on the lock's context, which ends with the tick
+	assert.ErrorIs(t, jobCtx.Err(), context.Canceled)
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"context"
+	"encoding/json"
 	"math/big"
 	"time"
 
@@ -19,6 +20,7 @@ import (
 	"github.com/NEDA-LABS/stablenode/ent/providerprofile"
 	"github.com/NEDA-LABS/stablenode/ent/transactionlog"
 	"github.com/NEDA-LABS/stablenode/ent/user"
+	"github.com/NEDA-LABS/stablenode/utils/ratelimit"
 	"github.com/shopspring/decimal"
 )
 
@@ -49,7 +51,7 @@ func (e *ethRPC) Commit() common.Hash {
 // Helper function to create client
 func NewEthClient(endpoint string) (RPCClient, error) {
 
-	ethClient, err := ethclient.Dial(endpoint)
+	ethClient, err := ratelimit.DialEthClient(context.Background(), endpoint)
 	if err != nil {
 		return nil, err
 	}
@@ -59,11 +61,12 @@ func NewEthClient(endpoint string) (RPCClient, error) {
 
 // TokenTransferEvent represents a token transfer event.
 type TokenTransferEvent struct {
-	BlockNumber int64
-	TxHash      string
-	From        string
-	To          string
-	Value       decimal.Decimal
+	BlockNumber    int64
+	BlockTimestamp int64 // Unix seconds the block was mined, or 0 when the source doesn't report it
+	TxHash         string
+	From           string
+	To             string
+	Value          decimal.Decimal
 }
 
 // OrderCreatedEvent represents an order created event.
@@ -246,6 +249,7 @@ type SenderProfilePayload struct {
 	WebhookURL      string                    `json:"webhookURL"`
 	DomainWhitelist []string                  `json:"domainWhitelist"`
 	Tokens          []SenderOrderTokenPayload `json:"tokens"`
+	OverpaymentMode string                    `json:"overpaymentMode" binding:"omitempty,oneof=adjust_amount refund"`
 }
 
 // ProviderOrderTokenPayload defines the provider setting for a token
@@ -306,6 +310,7 @@ type SenderProfileResponse struct {
 	Email                 string                     `json:"email"`
 	WebhookURL            string                     `json:"webhookUrl"`
 	DomainWhitelist       []string                   `json:"domainWhitelist"`
+	OverpaymentMode       string                     `json:"overpaymentMode"`
 	Tokens                []SenderOrderTokenResponse `json:"tokens"`
 	APIKey                APIKeyResponse             `json:"apiKey"`
 	ProviderID            string                     `json:"providerId"`
@@ -313,6 +318,7 @@ type SenderProfileResponse struct {
 	IsActive              bool                       `json:"isActive"`
 	KYBVerificationStatus user.KybVerificationStatus `json:"kybVerificationStatus"`
 	KYBRejectionComment   *string                    `json:"kybRejectionComment,omitempty"`
+	RequestSigningEnabled bool                       `json:"requestSigningEnabled"`
 }
 
 // RefreshResponse is the response for the refresh endpoint
@@ -326,6 +332,29 @@ type APIKeyResponse struct {
 	Secret string    `json:"secret"`
 }
 
+// APIKeyPayload is the payload for creating a scoped API key
+type APIKeyPayload struct {
+	Name   string   `json:"name" binding:"max=100"`
+	Scopes []string `json:"scopes" binding:"required,min=1,dive,oneof=read create_orders fulfill_orders webhooks_admin"`
+	// RateLimit is the number of requests per minute the key may make
+	RateLimit *int       `json:"rateLimit" binding:"omitempty,gt=0"`
+	ExpiresAt *time.Time `json:"expiresAt"`
+}
+
+// APIKeyDetailsResponse is an API key of a profile. The secret is only returned when the key is
+// created or rotated
+type APIKeyDetailsResponse struct {
+	ID         uuid.UUID  `json:"id"`
+	Name       string     `json:"name"`
+	Scopes     []string   `json:"scopes"`
+	RateLimit  *int       `json:"rateLimit,omitempty"`
+	ExpiresAt  *time.Time `json:"expiresAt,omitempty"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	Primary    bool       `json:"primary"`
+	Secret     string     `json:"secret,omitempty"`
+}
+
 // ERC20Transfer is the Transfer event of an ERC20 smart contract
 type ERC20Transfer struct {
 	From  common.Address
@@ -388,6 +417,7 @@ type LockPaymentOrderResponse struct {
 	CreatedAt           time.Time               `json:"createdAt"`
 	Transactions        []TransactionLog        `json:"transactionLogs"`
 	CancellationReasons []string                `json:"cancellationReasons"`
+	SLA                 *OrderSLA               `json:"sla,omitempty"`
 }
 
 type LockPaymentOrderTxReceipt struct {
@@ -429,56 +459,812 @@ type PaymentOrderRecipient struct {
 	Nonce             string                 `json:"nonce"`
 }
 
-// NewPaymentOrderPayload is the payload for the create payment order endpoint
+// NewPaymentOrderPayload is the payload for the create payment order endpoint.
+// Orders are denominated either in tokens with Amount, or in fiat with FiatAmount and FiatCurrency,
+// in which case the token amount is quoted at creation and converted when the order is paid, and
+// Rate defaults to the achievable one
 type NewPaymentOrderPayload struct {
-	Amount        decimal.Decimal       `json:"amount" binding:"required"`
-	Token         string                `json:"token" binding:"required"`
-	Rate          decimal.Decimal       `json:"rate" binding:"required"`
-	Network       string                `json:"network" binding:"required"`
-	Recipient     PaymentOrderRecipient `json:"recipient" binding:"required"`
-	Reference     string                `json:"reference"`
-	ReturnAddress string                `json:"returnAddress"`
-	FeePercent    decimal.Decimal       `json:"feePercent"`
-	FeeAddress    string                `json:"feeAddress"`
+	Amount           decimal.Decimal       `json:"amount" binding:"required_without=FiatAmount"`
+	FiatAmount       decimal.Decimal       `json:"fiatAmount"`
+	FiatCurrency     string                `json:"fiatCurrency" binding:"required_with=FiatAmount"`
+	Token            string                `json:"token" binding:"required"`
+	Rate             decimal.Decimal       `json:"rate" binding:"required_without=FiatAmount"`
+	Network          string                `json:"network" binding:"required"`
+	Recipient        PaymentOrderRecipient `json:"recipient" binding:"required"`
+	Reference        string                `json:"reference"`
+	ReturnAddress    string                `json:"returnAddress"`
+	FeePercent       decimal.Decimal       `json:"feePercent"`
+	FeeAddress       string                `json:"feeAddress"`
+	SettlementPolicy string                `json:"settlementPolicy" binding:"omitempty,oneof=soft_confirm finality"`
 }
 
 // ReceiveAddressResponse is the response type for a receive address
 type ReceiveAddressResponse struct {
+	ID               uuid.UUID                     `json:"id"`
+	Amount           decimal.Decimal               `json:"amount"`
+	Token            string                        `json:"token"`
+	Network          string                        `json:"network"`
+	ReceiveAddress   string                        `json:"receiveAddress"`
+	ValidUntil       time.Time                     `json:"validUntil"`
+	SenderFee        decimal.Decimal               `json:"senderFee"`
+	TransactionFee   decimal.Decimal               `json:"transactionFee"`
+	Reference        string                        `json:"reference"`
+	SettlementPolicy paymentorder.SettlementPolicy `json:"settlementPolicy"`
+	FiatAmount       *decimal.Decimal              `json:"fiatAmount,omitempty"`
+	FiatCurrency     string                        `json:"fiatCurrency,omitempty"`
+	// RateLockedUntil is when the quoted rate stops being honored; orders paid later may be re-quoted
+	RateLockedUntil time.Time `json:"rateLockedUntil"`
+}
+
+// ExtendReceiveAddressPayload is the payload for extending the validity of an order's receive address
+type ExtendReceiveAddressPayload struct {
+	// ValidFor is how many minutes from now the receive address stays valid; defaults to the order's TTL
+	ValidFor int `json:"validFor" binding:"omitempty,min=1"`
+}
+
+// NewPaymentOrderBatchPayload is the payload for creating many payment orders at once. Each order
+// is a NewPaymentOrderPayload, validated on its own
+type NewPaymentOrderBatchPayload struct {
+	Orders []json.RawMessage `json:"orders" binding:"required,min=1"`
+}
+
+// PaymentOrderBatchResult is the result of an order of a batch, at the index it was sent at
+type PaymentOrderBatchResult struct {
+	Index  int                     `json:"index"`
+	Status string                  `json:"status"`
+	Order  *ReceiveAddressResponse `json:"order,omitempty"`
+	Error  *PaymentOrderBatchError `json:"error,omitempty"`
+}
+
+// PaymentOrderBatchError is why an order of a batch was rejected
+type PaymentOrderBatchError struct {
+	Message string      `json:"message"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+// PaymentOrderBatchResponse is the response for a batch of payment orders
+type PaymentOrderBatchResponse struct {
+	Created int                       `json:"created"`
+	Failed  int                       `json:"failed"`
+	Results []PaymentOrderBatchResult `json:"results"`
+}
+
+// PoolNetworkStatus is the receive address pool inventory of a network
+type PoolNetworkStatus struct {
+	Network             string  `json:"network"`
+	PoolReady           int     `json:"poolReady"`
+	PoolAssigned        int     `json:"poolAssigned"`
+	PoolProcessing      int     `json:"poolProcessing"`
+	PoolCompleted       int     `json:"poolCompleted"`
+	NotDeployed         int     `json:"notDeployed"`
+	AverageTimesUsed    float64 `json:"averageTimesUsed"`
+	OldestAssignmentAge *int64  `json:"oldestAssignmentAgeSeconds"`
+}
+
+// HealthCheckResult is the outcome of one subsystem check of the liveness or readiness endpoint
+type HealthCheckResult struct {
+	Status   string      `json:"status"`
+	Critical bool        `json:"critical"`
+	Error    string      `json:"error,omitempty"`
+	Detail   interface{} `json:"detail,omitempty"`
+}
+
+// HealthReport is the response of the liveness and readiness endpoints
+type HealthReport struct {
+	Status string                       `json:"status"`
+	Checks map[string]HealthCheckResult `json:"checks"`
+}
+
+// DepositSplitResponse is a deposit held for allocation across several orders
+type DepositSplitResponse struct {
+	ID              uuid.UUID       `json:"id"`
+	TxHash          string          `json:"txHash"`
+	Network         string          `json:"network"`
+	ReceiveAddress  string          `json:"receiveAddress"`
+	FromAddress     string          `json:"fromAddress"`
+	FromAddressName string          `json:"fromAddressName,omitempty"` // ENS name of the sender on mainnet networks
+	Amount          decimal.Decimal `json:"amount"`
+	Status          string          `json:"status"`
+	OrderIDs        []uuid.UUID     `json:"orderIds"`
+	CreatedAt       time.Time       `json:"createdAt"`
+	ResolvedAt      *time.Time      `json:"resolvedAt,omitempty"`
+}
+
+// CreateSweepPayload is the payload for sweeping tokens out of a receive address
+type CreateSweepPayload struct {
+	Network     string          `json:"network" binding:"required"`
+	Token       string          `json:"token" binding:"required"`
+	FromAddress string          `json:"fromAddress" binding:"required"`
+	ToAddress   string          `json:"toAddress" binding:"required"`
+	Amount      decimal.Decimal `json:"amount" binding:"required"`
+}
+
+// SubmitSweepSignaturePayload is the payload for importing the offline signature of a sweep
+type SubmitSweepSignaturePayload struct {
+	Signature string `json:"signature" binding:"required"`
+}
+
+// SweepResponse is a sweep of tokens out of a receive address
+type SweepResponse struct {
+	ID           uuid.UUID       `json:"id"`
+	Network      string          `json:"network"`
+	TokenAddress string          `json:"tokenAddress"`
+	FromAddress  string          `json:"fromAddress"`
+	ToAddress    string          `json:"toAddress"`
+	Amount       decimal.Decimal `json:"amount"`
+	Status       string          `json:"status"`
+	UserOpHash   string          `json:"userOpHash,omitempty"`
+	TxHash       string          `json:"txHash,omitempty"`
+	CreatedAt    time.Time       `json:"createdAt"`
+	SubmittedAt  *time.Time      `json:"submittedAt,omitempty"`
+}
+
+// PermitDepositPayload is the payload for paying the deposit of an order with a signed EIP-2612 permit
+type PermitDepositPayload struct {
+	Owner string `json:"owner" binding:"required"`
+	// Deadline is the unix time the permit expires at
+	Deadline int64 `json:"deadline" binding:"required"`
+	// Signature is the 65 byte EIP-712 signature of the permit by its owner
+	Signature string `json:"signature" binding:"required"`
+}
+
+// PermitDepositParamsResponse is the permit an owner signs to pay the deposit of an order
+type PermitDepositParamsResponse struct {
+	TokenAddress    string `json:"tokenAddress"`
+	ChainID         int64  `json:"chainId"`
+	Owner           string `json:"owner"`
+	Spender         string `json:"spender"`
+	Value           string `json:"value"`
+	Nonce           string `json:"nonce"`
+	DomainSeparator string `json:"domainSeparator"`
+	MinDeadline     int64  `json:"minDeadline"`
+}
+
+// PermitDepositResponse is a permit deposit pulled from its owner into the receive address of an order
+type PermitDepositResponse struct {
+	TransactionID string `json:"transactionId"`
+	Owner         string `json:"owner"`
+	Value         string `json:"value"`
+}
+
+// MigrateReceiveAddressPayload is the payload for moving an unpaid order to a new receive address
+type MigrateReceiveAddressPayload struct {
+	Reason string `json:"reason" binding:"required"`
+	// RetireAddress also takes the old pool address out of rotation for new orders
+	RetireAddress bool `json:"retireAddress"`
+}
+
+// ReceiveAddressMigrationResponse is an order moved to a new receive address
+type ReceiveAddressMigrationResponse struct {
+	OrderID                uuid.UUID `json:"orderId"`
+	Network                string    `json:"network"`
+	PreviousReceiveAddress string    `json:"previousReceiveAddress"`
+	ReceiveAddress         string    `json:"receiveAddress"`
+	ValidUntil             time.Time `json:"validUntil"`
+}
+
+// SweepExport is an unsigned sweep handed to the air-gapped signer. The signer checks the
+// transfer details against the UserOperation and personal_signs UserOpHash
+type SweepExport struct {
+	SweepID       uuid.UUID              `json:"sweepId"`
+	ChainID       int64                  `json:"chainId"`
+	TokenAddress  string                 `json:"tokenAddress"`
+	FromAddress   string                 `json:"fromAddress"`
+	ToAddress     string                 `json:"toAddress"`
+	Amount        decimal.Decimal        `json:"amount"`
+	Signer        string                 `json:"signer"`
+	UserOperation map[string]interface{} `json:"userOperation"`
+	UserOpHash    string                 `json:"userOpHash"`
+}
+
+// WebhookPolicy is the retry policy of an outbound webhook destination
+type WebhookPolicy struct {
+	MaxAttempts       int           `json:"maxAttempts"`
+	Backoff           time.Duration `json:"-"`
+	BackoffMultiplier float64       `json:"backoffMultiplier"`
+	Timeout           time.Duration `json:"-"`
+}
+
+// UpdateWebhookDestinationPayload is the payload for overriding the retry policy of a webhook destination.
+// Omitted fields are left unchanged and zero resets a field to the configured default
+type UpdateWebhookDestinationPayload struct {
+	MaxAttempts       *int     `json:"maxAttempts" binding:"omitempty,gte=0"`
+	BackoffSeconds    *int     `json:"backoffSeconds" binding:"omitempty,gte=0"`
+	BackoffMultiplier *float64 `json:"backoffMultiplier" binding:"omitempty,gte=0"`
+	TimeoutSeconds    *int     `json:"timeoutSeconds" binding:"omitempty,gte=0"`
+	Enabled           *bool    `json:"enabled"`
+}
+
+// WebhookDestinationResponse is an outbound webhook destination with its effective policy and delivery stats
+type WebhookDestinationResponse struct {
+	ID                uuid.UUID  `json:"id"`
+	URL               string     `json:"url"`
+	MaxAttempts       int        `json:"maxAttempts"`
+	BackoffSeconds    int        `json:"backoffSeconds"`
+	BackoffMultiplier float64    `json:"backoffMultiplier"`
+	TimeoutSeconds    int        `json:"timeoutSeconds"`
+	DeliveredCount    int        `json:"deliveredCount"`
+	FailedCount       int        `json:"failedCount"`
+	LastDeliveredAt   *time.Time `json:"lastDeliveredAt,omitempty"`
+	LastFailedAt      *time.Time `json:"lastFailedAt,omitempty"`
+	FailingSince      *time.Time `json:"failingSince,omitempty"`
+	Enabled           bool       `json:"enabled"`
+	DisabledAt        *time.Time `json:"disabledAt,omitempty"`
+}
+
+// WebhookAttemptFilter selects failed outbound webhook notifications. An empty status matches both
+// notifications still being retried and expired ones
+type WebhookAttemptFilter struct {
+	Status     string     `json:"status" form:"status" binding:"omitempty,oneof=failed expired"`
+	WebhookURL string     `json:"webhookUrl" form:"webhookUrl"`
+	Event      string     `json:"event" form:"event"`
+	Since      *time.Time `json:"since" form:"since" time_format:"2006-01-02T15:04:05Z07:00"`
+	Until      *time.Time `json:"until" form:"until" time_format:"2006-01-02T15:04:05Z07:00"`
+}
+
+// WebhookAttemptResponse is a failed outbound webhook notification
+type WebhookAttemptResponse struct {
+	ID            int       `json:"id"`
+	WebhookURL    string    `json:"webhookUrl"`
+	Event         string    `json:"event"`
+	OrderID       string    `json:"orderId,omitempty"`
+	AttemptNumber int       `json:"attemptNumber"`
+	Status        string    `json:"status"`
+	NextRetryTime time.Time `json:"nextRetryTime"`
+	CreatedAt     time.Time `json:"createdAt"`
+	UpdatedAt     time.Time `json:"updatedAt"`
+}
+
+// RetryWebhookAttemptsResponse is the result of a bulk webhook retry
+type RetryWebhookAttemptsResponse struct {
+	Retried int `json:"retried"`
+}
+
+// WebhookNotification is a signed webhook notification queued for delivery to a sender. Body holds
+// the exact bytes that were signed
+type WebhookNotification struct {
+	ID         uuid.UUID       `json:"id"`
+	SenderID   uuid.UUID       `json:"senderId"`
+	OrderID    uuid.UUID       `json:"orderId"`
+	Event      string          `json:"event"`
+	WebhookURL string          `json:"webhookUrl"`
+	Body       json.RawMessage `json:"body"`
+	Signature  string          `json:"signature"`
+	Attempts   int             `json:"attempts"`
+	CreatedAt  time.Time       `json:"createdAt"`
+}
+
+// WebhookDeliveryResponse is an attempt to deliver a webhook notification to a sender
+type WebhookDeliveryResponse struct {
+	ID             uuid.UUID  `json:"id"`
+	NotificationID uuid.UUID  `json:"notificationId"`
+	Event          string     `json:"event"`
+	OrderID        *uuid.UUID `json:"orderId,omitempty"`
+	WebhookURL     string     `json:"webhookUrl"`
+	AttemptNumber  int        `json:"attemptNumber"`
+	Status         string     `json:"status"`
+	ResponseStatus int        `json:"responseStatus,omitempty"`
+	Error          string     `json:"error,omitempty"`
+	DurationMs     int64      `json:"durationMs"`
+	CreatedAt      time.Time  `json:"createdAt"`
+}
+
+// WebhookDeliveryList is a page of webhook delivery attempts
+type WebhookDeliveryList struct {
+	TotalRecords int                       `json:"total"`
+	Page         int                       `json:"page"`
+	PageSize     int                       `json:"pageSize"`
+	Deliveries   []WebhookDeliveryResponse `json:"deliveries"`
+}
+
+// WebhookSecretResponse is a newly generated webhook signing secret, shown only once
+type WebhookSecretResponse struct {
+	Secret string `json:"secret"`
+}
+
+// RequestSigningSecretResponse is a newly generated request signing secret, shown only once
+type RequestSigningSecretResponse struct {
+	Secret string `json:"secret"`
+	// MaxAge is how many seconds a signed request is accepted for
+	MaxAge int64 `json:"maxAge"`
+}
+
+// AdminPaymentOrderFilter selects payment orders in the admin API. Ages are durations such as 30m
+// or 24h measured from when the order was created
+type AdminPaymentOrderFilter struct {
+	Status  string        `form:"status"`
+	Network string        `form:"network"`
+	MinAge  time.Duration `form:"minAge"`
+	MaxAge  time.Duration `form:"maxAge"`
+}
+
+// AdminPaymentOrderResponse is a payment order in the admin API, with the lock order created for it
+type AdminPaymentOrderResponse struct {
+	ID               uuid.UUID               `json:"id"`
+	Status           string                  `json:"status"`
+	Network          string                  `json:"network"`
+	Token            string                  `json:"token"`
+	Amount           decimal.Decimal         `json:"amount"`
+	AmountPaid       decimal.Decimal         `json:"amountPaid"`
+	GatewayID        string                  `json:"gatewayId"`
+	TxHash           string                  `json:"txHash"`
+	ReceiveAddress   string                  `json:"receiveAddress"`
+	Reference        string                  `json:"reference"`
+	QuarantineReason string                  `json:"quarantineReason,omitempty"`
+	LockOrder        *AdminLockOrderResponse `json:"lockOrder,omitempty"`
+	CreatedAt        time.Time               `json:"createdAt"`
+	UpdatedAt        time.Time               `json:"updatedAt"`
+}
+
+// AdminLockOrderResponse is the state of a lock order in the admin API
+type AdminLockOrderResponse struct {
+	ID                uuid.UUID                    `json:"id"`
+	Status            string                       `json:"status"`
+	ProviderID        string                       `json:"providerId,omitempty"`
+	CancellationCount int                          `json:"cancellationCount"`
+	Reassignments     []AdminLockOrderReassignment `json:"reassignments,omitempty"`
+	UpdatedAt         time.Time                    `json:"updatedAt"`
+}
+
+// AdminLockOrderReassignment is a reassignment of a lock order away from a provider that did not
+// fulfill it in time
+type AdminLockOrderReassignment struct {
+	PreviousProviderID string    `json:"previousProviderId"`
+	Reason             string    `json:"reason"`
+	CreatedAt          time.Time `json:"createdAt"`
+}
+
+// AdminPaymentOrderList is a page of payment orders in the admin API
+type AdminPaymentOrderList struct {
+	TotalRecords int                         `json:"total"`
+	Page         int                         `json:"page"`
+	PageSize     int                         `json:"pageSize"`
+	Orders       []AdminPaymentOrderResponse `json:"orders"`
+}
+
+// AdminStatsBucket is the activity of the payment orders created in a day, on a network or in a token.
+// Amounts are in USD and only count settled orders
+type AdminStatsBucket struct {
+	Key                      string          `json:"key,omitempty"`
+	Volume                   decimal.Decimal `json:"volume"`
+	Orders                   int             `json:"orders"`
+	SettledOrders            int             `json:"settledOrders"`
+	RefundedOrders           int             `json:"refundedOrders"`
+	ExpiredOrders            int             `json:"expiredOrders"`
+	SuccessRate              float64         `json:"successRate"`
+	AverageSettlementSeconds float64         `json:"averageSettlementSeconds"`
+	SenderFees               decimal.Decimal `json:"senderFees"`
+	NetworkFees              decimal.Decimal `json:"networkFees"`
+	ProtocolFees             decimal.Decimal `json:"protocolFees"`
+}
+
+// AdminStatsResponse is the activity of the payment orders created over a date range, in total and
+// grouped by day, network or token
+type AdminStatsResponse struct {
+	From    time.Time          `json:"from"`
+	To      time.Time          `json:"to"`
+	GroupBy string             `json:"groupBy"`
+	Totals  AdminStatsBucket   `json:"totals"`
+	Groups  []AdminStatsBucket `json:"groups"`
+}
+
+// AdminGasSpendBucket is the gas mined UserOperations of a group spent on a network, split by whether
+// the Gas Manager policy sponsored it or the sender paid. Costs are in the network's native token
+type AdminGasSpendBucket struct {
+	Key                  string          `json:"key,omitempty"`
+	Network              string          `json:"network"`
+	Operations           int             `json:"operations"`
+	SponsoredOperations  int             `json:"sponsoredOperations"`
+	SelfFundedOperations int             `json:"selfFundedOperations"`
+	GasUsed              int64           `json:"gasUsed"`
+	SponsoredGasUsed     int64           `json:"sponsoredGasUsed"`
+	SelfFundedGasUsed    int64           `json:"selfFundedGasUsed"`
+	GasCost              decimal.Decimal `json:"gasCost"`
+	SponsoredGasCost     decimal.Decimal `json:"sponsoredGasCost"`
+	SelfFundedGasCost    decimal.Decimal `json:"selfFundedGasCost"`
+}
+
+// AdminGasSpendResponse is the gas spent by the UserOperations sent over a date range, in total per
+// network and grouped by payment order, network, purpose or gas policy
+type AdminGasSpendResponse struct {
+	From    time.Time             `json:"from"`
+	To      time.Time             `json:"to"`
+	GroupBy string                `json:"groupBy"`
+	Totals  []AdminGasSpendBucket `json:"totals"`
+	Groups  []AdminGasSpendBucket `json:"groups"`
+}
+
+// AdminOrderActionPayload is the payload of an operation performed on an order through the admin API.
+// The admin API key is shared, so the actor names who performed it in the audit log
+type AdminOrderActionPayload struct {
+	Actor  string `json:"actor" binding:"required"`
+	Reason string `json:"reason" binding:"required,max=500"`
+}
+
+// ReassignProviderPayload is the payload for offering a lock order to a specific provider
+type ReassignProviderPayload struct {
+	AdminOrderActionPayload
+	ProviderID string `json:"providerId" binding:"required"`
+}
+
+// SenderLimitsPayload is the payload for setting the limits of a sender. Omitted fields are left
+// unchanged and zero removes a limit
+type SenderLimitsPayload struct {
+	MaxOrderAmount   *decimal.Decimal `json:"maxOrderAmount"`
+	DailyVolumeLimit *decimal.Decimal `json:"dailyVolumeLimit"`
+	HourlyOrderLimit *int             `json:"hourlyOrderLimit" binding:"omitempty,gte=0"`
+}
+
+// DenylistedAddressPayload is the payload for denylisting the sender of deposits. An entry without a
+// network applies to every network, and one without an expiry never expires
+type DenylistedAddressPayload struct {
+	Address   string     `json:"address" binding:"required,max=60"`
+	Network   string     `json:"network" binding:"max=60"`
+	Reason    string     `json:"reason" binding:"max=500"`
+	Source    string     `json:"source" binding:"max=100"`
+	ExpiresAt *time.Time `json:"expiresAt"`
+}
+
+// UpdateDenylistedAddressPayload is the payload for updating a denylisted address. Omitted fields
+// are left unchanged
+type UpdateDenylistedAddressPayload struct {
+	Network   *string    `json:"network" binding:"omitempty,max=60"`
+	Reason    *string    `json:"reason" binding:"omitempty,max=500"`
+	Source    *string    `json:"source" binding:"omitempty,max=100"`
+	ExpiresAt *time.Time `json:"expiresAt"`
+	// ClearExpiry makes the entry never expire
+	ClearExpiry bool `json:"clearExpiry"`
+}
+
+// DenylistedAddressResponse is an address deposits are quarantined from
+type DenylistedAddressResponse struct {
+	ID        uuid.UUID  `json:"id"`
+	Address   string     `json:"address"`
+	Network   string     `json:"network"`
+	Reason    string     `json:"reason"`
+	Source    string     `json:"source"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	CreatedAt time.Time  `json:"createdAt"`
+	UpdatedAt time.Time  `json:"updatedAt"`
+}
+
+// FeeSchedulePayload is the payload for creating a fee schedule. A schedule without a sender, token or
+// network applies to every sender, token or network, one without a maximum amount has no upper
+// bound, and one without an end date stays in effect. Amounts are in USD
+type FeeSchedulePayload struct {
+	FeeType        string           `json:"feeType" binding:"required,oneof=sender network protocol"`
+	SenderID       *uuid.UUID       `json:"senderId"`
+	Token          string           `json:"token" binding:"max=20"`
+	Network        string           `json:"network" binding:"max=60"`
+	MinAmount      decimal.Decimal  `json:"minAmount"`
+	MaxAmount      *decimal.Decimal `json:"maxAmount"`
+	Percent        decimal.Decimal  `json:"percent"`
+	FlatAmount     decimal.Decimal  `json:"flatAmount"`
+	EffectiveFrom  *time.Time       `json:"effectiveFrom"`
+	EffectiveUntil *time.Time       `json:"effectiveUntil"`
+}
+
+// UpdateFeeSchedulePayload is the payload for changing when a fee schedule is in effect. Omitted fields
+// are left unchanged
+type UpdateFeeSchedulePayload struct {
+	EffectiveFrom  *time.Time `json:"effectiveFrom"`
+	EffectiveUntil *time.Time `json:"effectiveUntil"`
+	// ClearEffectiveUntil keeps the schedule in effect indefinitely
+	ClearEffectiveUntil bool `json:"clearEffectiveUntil"`
+}
+
+// FeeScheduleResponse is a rule setting one fee of new payment orders
+type FeeScheduleResponse struct {
+	ID             uuid.UUID        `json:"id"`
+	FeeType        string           `json:"feeType"`
+	SenderID       *uuid.UUID       `json:"senderId,omitempty"`
+	Token          string           `json:"token"`
+	Network        string           `json:"network"`
+	MinAmount      decimal.Decimal  `json:"minAmount"`
+	MaxAmount      *decimal.Decimal `json:"maxAmount,omitempty"`
+	Percent        decimal.Decimal  `json:"percent"`
+	FlatAmount     decimal.Decimal  `json:"flatAmount"`
+	EffectiveFrom  time.Time        `json:"effectiveFrom"`
+	EffectiveUntil *time.Time       `json:"effectiveUntil,omitempty"`
+	CreatedAt      time.Time        `json:"createdAt"`
+	UpdatedAt      time.Time        `json:"updatedAt"`
+}
+
+// BlockchainRoutePayload is the payload for creating a blockchain route. A route without a sender,
+// token or network applies to every sender, token or network, and one without a maximum amount has
+// no upper bound. Amounts are in USD
+type BlockchainRoutePayload struct {
+	Service   string           `json:"service" binding:"required,oneof=engine alchemy rpc"`
+	SenderID  *uuid.UUID       `json:"senderId"`
+	Token     string           `json:"token" binding:"max=20"`
+	Network   string           `json:"network" binding:"max=60"`
+	MinAmount decimal.Decimal  `json:"minAmount"`
+	MaxAmount *decimal.Decimal `json:"maxAmount"`
+	Priority  int              `json:"priority"`
+	IsEnabled *bool            `json:"isEnabled"`
+}
+
+// UpdateBlockchainRoutePayload is the payload for changing the service, priority or state of a
+// blockchain route. Omitted fields are left unchanged
+type UpdateBlockchainRoutePayload struct {
+	Service   *string `json:"service" binding:"omitempty,oneof=engine alchemy rpc"`
+	Priority  *int    `json:"priority"`
+	IsEnabled *bool   `json:"isEnabled"`
+}
+
+// BlockchainRouteResponse is a rule choosing the blockchain service of new EVM payment orders
+type BlockchainRouteResponse struct {
+	ID        uuid.UUID        `json:"id"`
+	Service   string           `json:"service"`
+	SenderID  *uuid.UUID       `json:"senderId,omitempty"`
+	Token     string           `json:"token"`
+	Network   string           `json:"network"`
+	MinAmount decimal.Decimal  `json:"minAmount"`
+	MaxAmount *decimal.Decimal `json:"maxAmount,omitempty"`
+	Priority  int              `json:"priority"`
+	IsEnabled bool             `json:"isEnabled"`
+	CreatedAt time.Time        `json:"createdAt"`
+	UpdatedAt time.Time        `json:"updatedAt"`
+}
+
+// TokenPayload is the payload for registering a token on a network. Its symbol and decimals must
+// match the token contract
+type TokenPayload struct {
+	Network         string `json:"network" binding:"required,max=60"`
+	Symbol          string `json:"symbol" binding:"required,max=10"`
+	ContractAddress string `json:"contractAddress" binding:"required,max=60"`
+	Decimals        *int8  `json:"decimals" binding:"required,min=0,max=36"`
+	BaseCurrency    string `json:"baseCurrency" binding:"max=10"`
+	IsEnabled       *bool  `json:"isEnabled"`
+}
+
+// UpdateTokenPayload is the payload for enabling, disabling or changing the base currency of a
+// token. Omitted fields are left unchanged
+type UpdateTokenPayload struct {
+	IsEnabled    *bool   `json:"isEnabled"`
+	BaseCurrency *string `json:"baseCurrency" binding:"omitempty,max=10"`
+}
+
+// TokenResponse is a token orders can be paid in once enabled
+type TokenResponse struct {
+	ID              int        `json:"id"`
+	Symbol          string     `json:"symbol"`
+	ContractAddress string     `json:"contractAddress"`
+	Decimals        int8       `json:"decimals"`
+	BaseCurrency    string     `json:"baseCurrency"`
+	Network         string     `json:"network"`
+	IsEnabled       bool       `json:"isEnabled"`
+	DepeggedAt      *time.Time `json:"depeggedAt,omitempty"`
+	CreatedAt       time.Time  `json:"createdAt"`
+	UpdatedAt       time.Time  `json:"updatedAt"`
+}
+
+// NetworkPayload is the payload for onboarding a network. Networks are created disabled unless
+// isEnabled is set; fallbackRpcEndpoints are tried in order when rpcEndpoint is unhealthy
+type NetworkPayload struct {
+	Identifier             string          `json:"identifier" binding:"required,max=60"`
+	ChainID                int64           `json:"chainId" binding:"required"`
+	NetworkType            string          `json:"networkType" binding:"omitempty,oneof=evm tron solana"`
+	RPCEndpoint            string          `json:"rpcEndpoint" binding:"required,max=255"`
+	FallbackRPCEndpoints   []string        `json:"fallbackRpcEndpoints" binding:"max=10,dive,required,max=255"`
+	WssEndpoint            string          `json:"wssEndpoint" binding:"max=255"`
+	ExplorerURL            string          `json:"explorerUrl" binding:"omitempty,url,max=255"`
+	AlchemyNetworkID       string          `json:"alchemyNetworkId" binding:"max=60"`
+	GatewayContractAddress string          `json:"gatewayContractAddress" binding:"max=60"`
+	BlockTime              decimal.Decimal `json:"blockTime" binding:"required"`
+	Fee                    decimal.Decimal `json:"fee"`
+	IsTestnet              bool            `json:"isTestnet"`
+	RequiredConfirmations  int             `json:"requiredConfirmations" binding:"min=0"`
+	FinalityBlocks         int             `json:"finalityBlocks" binding:"min=0"`
+	WebhooksEnabled        *bool           `json:"webhooksEnabled"`
+	WebsocketEnabled       *bool           `json:"websocketEnabled"`
+	PollingEnabled         *bool           `json:"pollingEnabled"`
+	IsEnabled              *bool           `json:"isEnabled"`
+}
+
+// UpdateNetworkPayload is the payload for enabling, disabling or reconfiguring a network. Omitted
+// fields are left unchanged
+type UpdateNetworkPayload struct {
+	RPCEndpoint            *string `json:"rpcEndpoint" binding:"omitempty,max=255"`
+	WssEndpoint            *string `json:"wssEndpoint" binding:"omitempty,max=255"`
+	ExplorerURL            *string `json:"explorerUrl" binding:"omitempty,max=255"`
+	AlchemyNetworkID       *string `json:"alchemyNetworkId" binding:"omitempty,max=60"`
+	GatewayContractAddress *string `json:"gatewayContractAddress" binding:"omitempty,max=60"`
+	RequiredConfirmations  *int    `json:"requiredConfirmations" binding:"omitempty,min=0"`
+	FinalityBlocks         *int    `json:"finalityBlocks" binding:"omitempty,min=0"`
+	WebhooksEnabled        *bool   `json:"webhooksEnabled"`
+	WebsocketEnabled       *bool   `json:"websocketEnabled"`
+	PollingEnabled         *bool   `json:"pollingEnabled"`
+	IsEnabled              *bool   `json:"isEnabled"`
+}
+
+// NetworkResponse is a network orders can be created on once enabled
+type NetworkResponse struct {
+	ID                     int             `json:"id"`
+	Identifier             string          `json:"identifier"`
+	ChainID                int64           `json:"chainId"`
+	NetworkType            string          `json:"networkType"`
+	RPCEndpoint            string          `json:"rpcEndpoint"`
+	FallbackRPCEndpoints   []string        `json:"fallbackRpcEndpoints"`
+	WssEndpoint            string          `json:"wssEndpoint,omitempty"`
+	ExplorerURL            string          `json:"explorerUrl,omitempty"`
+	AlchemyNetworkID       string          `json:"alchemyNetworkId,omitempty"`
+	GatewayContractAddress string          `json:"gatewayContractAddress"`
+	BlockTime              decimal.Decimal `json:"blockTime"`
+	Fee                    decimal.Decimal `json:"fee"`
+	IsTestnet              bool            `json:"isTestnet"`
+	RequiredConfirmations  int             `json:"requiredConfirmations"`
+	FinalityBlocks         int             `json:"finalityBlocks"`
+	WebhooksEnabled        bool            `json:"webhooksEnabled"`
+	WebsocketEnabled       bool            `json:"websocketEnabled"`
+	PollingEnabled         bool            `json:"pollingEnabled"`
+	IsEnabled              bool            `json:"isEnabled"`
+	CreatedAt              time.Time       `json:"createdAt"`
+	UpdatedAt              time.Time       `json:"updatedAt"`
+}
+
+// AdminAuditLogResponse is an operation performed on an order through the admin API
+type AdminAuditLogResponse struct {
+	ID        uuid.UUID              `json:"id"`
+	Action    string                 `json:"action"`
+	TargetID  string                 `json:"targetId"`
+	Actor     string                 `json:"actor"`
+	Reason    string                 `json:"reason"`
+	Metadata  map[string]interface{} `json:"metadata"`
+	CreatedAt time.Time              `json:"createdAt"`
+}
+
+// AdminAuditLogList is a page of operations performed through the admin API
+type AdminAuditLogList struct {
+	TotalRecords int                     `json:"total"`
+	Page         int                     `json:"page"`
+	PageSize     int                     `json:"pageSize"`
+	Logs         []AdminAuditLogResponse `json:"logs"`
+}
+
+// ReconcilePayload is the payload for reconciling the deposits of a network on a UTC day
+type ReconcilePayload struct {
+	Network string `json:"network" binding:"required"`
+	Date    string `json:"date" binding:"required"` // YYYY-MM-DD
+}
+
+// ReconciliationDiscrepancyResponse is a deposit a reconciliation found the chain and the records
+// disagree on
+type ReconciliationDiscrepancyResponse struct {
 	ID             uuid.UUID       `json:"id"`
-	Amount         decimal.Decimal `json:"amount"`
+	Type           string          `json:"type"`
+	TxHash         string          `json:"txHash"`
+	Address        string          `json:"address"`
 	Token          string          `json:"token"`
+	FromAddress    string          `json:"fromAddress,omitempty"`
+	BlockNumber    int64           `json:"blockNumber"`
+	OnchainAmount  decimal.Decimal `json:"onchainAmount"`
+	RecordedAmount decimal.Decimal `json:"recordedAmount"`
+	OrderID        *uuid.UUID      `json:"orderId,omitempty"`
+}
+
+// ReconciliationReportResponse is the reconciliation of the deposits of a network on a UTC day
+type ReconciliationReportResponse struct {
+	ID               uuid.UUID                           `json:"id"`
+	Network          string                              `json:"network"`
+	Date             string                              `json:"date"`
+	FromBlock        int64                               `json:"fromBlock"`
+	ToBlock          int64                               `json:"toBlock"`
+	Status           string                              `json:"status"`
+	Error            string                              `json:"error,omitempty"`
+	AddressesChecked int                                 `json:"addressesChecked"`
+	TransfersChecked int                                 `json:"transfersChecked"`
+	DiscrepancyCount int                                 `json:"discrepancyCount"`
+	Discrepancies    []ReconciliationDiscrepancyResponse `json:"discrepancies,omitempty"`
+	CreatedAt        time.Time                           `json:"createdAt"`
+}
+
+// ReconciliationReportList is a page of reconciliation reports
+type ReconciliationReportList struct {
+	TotalRecords int                            `json:"total"`
+	Page         int                            `json:"page"`
+	PageSize     int                            `json:"pageSize"`
+	Reports      []ReconciliationReportResponse `json:"reports"`
+}
+
+// LinkUnmatchedDepositPayload is the payload for crediting an unmatched deposit to an order
+type LinkUnmatchedDepositPayload struct {
+	AdminOrderActionPayload
+	OrderID string `json:"orderId" binding:"required"`
+}
+
+// UnmatchedDepositResponse is a transfer to a receive address that no order was credited with
+type UnmatchedDepositResponse struct {
+	ID             uuid.UUID       `json:"id"`
 	Network        string          `json:"network"`
+	Token          string          `json:"token"`
+	TxHash         string          `json:"txHash"`
 	ReceiveAddress string          `json:"receiveAddress"`
-	ValidUntil     time.Time       `json:"validUntil"`
-	SenderFee      decimal.Decimal `json:"senderFee"`
-	TransactionFee decimal.Decimal `json:"transactionFee"`
-	Reference      string          `json:"reference"`
+	FromAddress    string          `json:"fromAddress"`
+	Amount         decimal.Decimal `json:"amount"`
+	BlockNumber    int64           `json:"blockNumber"`
+	Reason         string          `json:"reason"`
+	Status         string          `json:"status"`
+	OrderID        *uuid.UUID      `json:"orderId,omitempty"`
+	Sweep          *SweepResponse  `json:"sweep,omitempty"`
+	CreatedAt      time.Time       `json:"createdAt"`
+	ResolvedAt     *time.Time      `json:"resolvedAt,omitempty"`
+}
+
+// UnmatchedDepositList is a page of unmatched deposits
+type UnmatchedDepositList struct {
+	TotalRecords int                        `json:"total"`
+	Page         int                        `json:"page"`
+	PageSize     int                        `json:"pageSize"`
+	Deposits     []UnmatchedDepositResponse `json:"deposits"`
+}
+
+// DeadLetterResponse is a failed processing attempt of an indexed event kept for retries
+type DeadLetterResponse struct {
+	ID          uuid.UUID              `json:"id"`
+	OrderID     string                 `json:"orderId"`
+	Stage       string                 `json:"stage"`
+	Network     string                 `json:"network"`
+	Payload     map[string]interface{} `json:"payload"`
+	LastError   string                 `json:"lastError"`
+	Status      string                 `json:"status"`
+	RetryCount  int                    `json:"retryCount"`
+	NextRetryAt *time.Time             `json:"nextRetryAt,omitempty"`
+	CreatedAt   time.Time              `json:"createdAt"`
+	UpdatedAt   time.Time              `json:"updatedAt"`
+	ResolvedAt  *time.Time             `json:"resolvedAt,omitempty"`
+}
+
+// DeadLetterList is a page of dead letters
+type DeadLetterList struct {
+	TotalRecords int                  `json:"total"`
+	Page         int                  `json:"page"`
+	PageSize     int                  `json:"pageSize"`
+	DeadLetters  []DeadLetterResponse `json:"deadLetters"`
 }
 
 // PaymentOrderResponse is the response type for a payment order
 type PaymentOrderResponse struct {
-	ID             uuid.UUID             `json:"id"`
-	Amount         decimal.Decimal       `json:"amount"`
-	AmountInUSD    decimal.Decimal       `json:"amountInUSD"`
-	AmountPaid     decimal.Decimal       `json:"amountPaid"`
-	AmountReturned decimal.Decimal       `json:"amountReturned"`
-	Token          string                `json:"token"`
-	SenderFee      decimal.Decimal       `json:"senderFee"`
-	TransactionFee decimal.Decimal       `json:"transactionFee"`
-	Rate           decimal.Decimal       `json:"rate"`
-	Network        string                `json:"network"`
-	GatewayID      string                `json:"gatewayId"`
-	Recipient      PaymentOrderRecipient `json:"recipient"`
-	FromAddress    string                `json:"fromAddress"`
-	ReturnAddress  string                `json:"returnAddress"`
-	ReceiveAddress string                `json:"receiveAddress"`
-	FeeAddress     string                `json:"feeAddress"`
-	Reference      string                `json:"reference"`
-	CreatedAt      time.Time             `json:"createdAt"`
-	UpdatedAt      time.Time             `json:"updatedAt"`
-	TxHash         string                `json:"txHash"`
-	Status         paymentorder.Status   `json:"status"`
-	Transactions   []TransactionLog      `json:"transactionLogs"`
+	ID                 uuid.UUID                     `json:"id"`
+	Amount             decimal.Decimal               `json:"amount"`
+	AmountInUSD        decimal.Decimal               `json:"amountInUSD"`
+	AmountPaid         decimal.Decimal               `json:"amountPaid"`
+	AmountReturned     decimal.Decimal               `json:"amountReturned"`
+	Token              string                        `json:"token"`
+	SenderFee          decimal.Decimal               `json:"senderFee"`
+	TransactionFee     decimal.Decimal               `json:"transactionFee"`
+	Rate               decimal.Decimal               `json:"rate"`
+	Network            string                        `json:"network"`
+	GatewayID          string                        `json:"gatewayId"`
+	Recipient          PaymentOrderRecipient         `json:"recipient"`
+	FromAddress        string                        `json:"fromAddress"`
+	ReturnAddress      string                        `json:"returnAddress"`
+	ReceiveAddress     string                        `json:"receiveAddress"`
+	FeeAddress         string                        `json:"feeAddress"`
+	Reference          string                        `json:"reference"`
+	CreatedAt          time.Time                     `json:"createdAt"`
+	UpdatedAt          time.Time                     `json:"updatedAt"`
+	TxHash             string                        `json:"txHash"`
+	Status             paymentorder.Status           `json:"status"`
+	Transactions       []TransactionLog              `json:"transactionLogs"`
+	SettlementPolicy   paymentorder.SettlementPolicy `json:"settlementPolicy"`
+	DepositStatus      paymentorder.DepositStatus    `json:"depositStatus,omitempty"`
+	DepositFinalizedAt *time.Time                    `json:"depositFinalizedAt,omitempty"`
+	SLA                *OrderSLA                     `json:"sla,omitempty"`
+	FiatAmount         *decimal.Decimal              `json:"fiatAmount,omitempty"`
+	FiatCurrency       string                        `json:"fiatCurrency,omitempty"`
+	FiatConversion     map[string]interface{}        `json:"fiatConversion,omitempty"`
+	RateLockedUntil    *time.Time                    `json:"rateLockedUntil,omitempty"`
+	RateRequote        map[string]interface{}        `json:"rateRequote,omitempty"`
+}
+
+// OrderSLA is the SLA timer of the stage an order is currently in
+type OrderSLA struct {
+	Stage      string     `json:"stage"`
+	StartedAt  time.Time  `json:"startedAt"`
+	Deadline   time.Time  `json:"deadline"`
+	Breached   bool       `json:"breached"`
+	BreachedAt *time.Time `json:"breachedAt,omitempty"`
 }
 
 // PaymentOrderWebhookData is the data type for a payment order webhook
@@ -498,6 +1284,7 @@ type PaymentOrderWebhookData struct {
 	Recipient      PaymentOrderRecipient `json:"recipient"`
 	FromAddress    string                `json:"fromAddress"`
 	ReturnAddress  string                `json:"returnAddress"`
+	ReceiveAddress string                `json:"receiveAddress"`
 	Reference      string                `json:"reference"`
 	UpdatedAt      time.Time             `json:"updatedAt"`
 	CreatedAt      time.Time             `json:"createdAt"`
@@ -511,6 +1298,16 @@ type PaymentOrderWebhookPayload struct {
 	Data  PaymentOrderWebhookData `json:"data"`
 }
 
+// OrderStatusEvent is a status change of a payment order, streamed to the sender as a Server-Sent Event
+type OrderStatusEvent struct {
+	OrderID        uuid.UUID           `json:"orderId"`
+	Status         paymentorder.Status `json:"status"`
+	AmountPaid     decimal.Decimal     `json:"amountPaid"`
+	PercentSettled decimal.Decimal     `json:"percentSettled"`
+	TxHash         string              `json:"txHash,omitempty"`
+	UpdatedAt      time.Time           `json:"updatedAt"`
+}
+
 // ConfirmEmailPayload is the payload for the confirmEmail endpoint
 type ConfirmEmailPayload struct {
 	Token string `json:"token" binding:"required"`
@@ -590,6 +1387,7 @@ type ProviderLockOrderList struct {
 	TotalRecords int                        `json:"total"`
 	Page         int                        `json:"page"`
 	PageSize     int                        `json:"pageSize"`
+	NextCursor   string                     `json:"nextCursor,omitempty"`
 	Orders       []LockPaymentOrderResponse `json:"orders"`
 }
 
@@ -598,6 +1396,7 @@ type SenderPaymentOrderList struct {
 	TotalRecords int                    `json:"total"`
 	Page         int                    `json:"page"`
 	PageSize     int                    `json:"pageSize"`
+	NextCursor   string                 `json:"nextCursor,omitempty"`
 	Orders       []PaymentOrderResponse `json:"orders"`
 }
 
@@ -614,6 +1413,16 @@ type SenderStatsResponse struct {
 	TotalFeeEarnings decimal.Decimal `json:"totalFeeEarnings"`
 }
 
+// SenderLimitsResponse is the response for the sender limits endpoint. Limits that aren't set are
+// omitted, and amounts are in USD
+type SenderLimitsResponse struct {
+	MaxOrderAmount   *decimal.Decimal `json:"maxOrderAmount,omitempty"`
+	DailyVolumeLimit *decimal.Decimal `json:"dailyVolumeLimit,omitempty"`
+	HourlyOrderLimit *int             `json:"hourlyOrderLimit,omitempty"`
+	DailyVolume      decimal.Decimal  `json:"dailyVolume"`
+	HourlyOrders     int              `json:"hourlyOrders"`
+}
+
 // ProviderStatsResponse is the response for the provider stats endpoint
 type ProviderStatsResponse struct {
 	TotalOrders       int             `json:"totalOrders"`
@@ -647,6 +1456,7 @@ type NewLinkedAddressResponse struct {
 // LinkedAddressResponse is the response for a linked address
 type LinkedAddressResponse struct {
 	LinkedAddress     string `json:"linkedAddress"`
+	Handle            string `json:"handle,omitempty"`
 	Currency          string `json:"currency"`
 	Institution       string `json:"institution"`
 	AccountIdentifier string `json:"accountIdentifier"`
@@ -687,6 +1497,45 @@ type LinkedAddressTransactionList struct {
 	Transactions []LinkedAddressTransaction `json:"transactions"`
 }
 
+// SenderLinkedAddressPayload is the payload for a sender to create a linked address
+type SenderLinkedAddressPayload struct {
+	Institution       string                 `json:"institution" binding:"required"`
+	AccountIdentifier string                 `json:"accountIdentifier" binding:"required"`
+	AccountName       string                 `json:"accountName" binding:"required"`
+	Metadata          map[string]interface{} `json:"metadata"`
+	Handle            string                 `json:"handle"`
+	Networks          []string               `json:"networks"`
+	Tokens            []string               `json:"tokens"`
+	MaxTransferAmount decimal.Decimal        `json:"maxTransferAmount"`
+	DailyVolumeLimit  decimal.Decimal        `json:"dailyVolumeLimit"`
+}
+
+// SenderLinkedAddressResponse is the response for a linked address managed by a sender
+type SenderLinkedAddressResponse struct {
+	ID                int             `json:"id"`
+	LinkedAddress     string          `json:"linkedAddress"`
+	Handle            string          `json:"handle,omitempty"`
+	Institution       string          `json:"institution"`
+	AccountIdentifier string          `json:"accountIdentifier"`
+	AccountName       string          `json:"accountName"`
+	Networks          []string        `json:"networks"`
+	Tokens            []string        `json:"tokens"`
+	MaxTransferAmount decimal.Decimal `json:"maxTransferAmount"`
+	DailyVolumeLimit  decimal.Decimal `json:"dailyVolumeLimit"`
+	IsActive          bool            `json:"isActive"`
+	ReplacedBy        string          `json:"replacedBy,omitempty"`
+	CreatedAt         time.Time       `json:"createdAt"`
+	UpdatedAt         time.Time       `json:"updatedAt"`
+}
+
+// SenderLinkedAddressList is the struct for a list of linked addresses managed by a sender
+type SenderLinkedAddressList struct {
+	TotalRecords    int                           `json:"total"`
+	Page            int                           `json:"page"`
+	PageSize        int                           `json:"pageSize"`
+	LinkedAddresses []SenderLinkedAddressResponse `json:"linkedAddresses"`
+}
+
 // SupportedTokenResponse represents the structure for supported tokens
 type SupportedTokenResponse struct {
 	Symbol          string `json:"symbol"`
@@ -776,6 +1625,25 @@ type ThirdwebEventData struct {
 	Decoded          ThirdwebDecodedEvent `json:"decoded"`
 }
 
+// Log returns the raw log the event was indexed from
+func (d ThirdwebEventData) Log() types.Log {
+	topics := make([]common.Hash, len(d.Topics))
+	for i, topic := range d.Topics {
+		topics[i] = common.HexToHash(topic)
+	}
+
+	return types.Log{
+		Address:     common.HexToAddress(d.Address),
+		Topics:      topics,
+		Data:        common.FromHex(d.Data),
+		BlockNumber: uint64(d.BlockNumber),
+		TxHash:      common.HexToHash(d.TransactionHash),
+		TxIndex:     uint(d.TransactionIndex),
+		BlockHash:   common.HexToHash(d.BlockHash),
+		Index:       uint(d.LogIndex),
+	}
+}
+
 // ThirdwebDecodedEvent represents the decoded event parameters
 type ThirdwebDecodedEvent struct {
 	Name             string                 `json:"name"`
@@ -783,6 +1651,52 @@ type ThirdwebDecodedEvent struct {
 	NonIndexedParams map[string]interface{} `json:"non_indexed_params"`
 }
 
+// AlchemyWebhookPayload represents an Alchemy Address Activity webhook payload
+type AlchemyWebhookPayload struct {
+	WebhookID string              `json:"webhookId"`
+	ID        string              `json:"id"`
+	CreatedAt string              `json:"createdAt"`
+	Type      string              `json:"type"`
+	Event     AlchemyWebhookEvent `json:"event"`
+}
+
+// AlchemyWebhookEvent represents the activity of an Alchemy Address Activity webhook
+type AlchemyWebhookEvent struct {
+	Network  string            `json:"network"`
+	Activity []AlchemyActivity `json:"activity"`
+}
+
+// AlchemyActivity represents a single transfer to or from a watched address.
+// The category is "external" for native value transfers and "token" or "erc20" for ERC-20 transfers
+type AlchemyActivity struct {
+	BlockNum    string             `json:"blockNum"`
+	Hash        string             `json:"hash"`
+	FromAddress string             `json:"fromAddress"`
+	ToAddress   string             `json:"toAddress"`
+	Asset       string             `json:"asset"`
+	Category    string             `json:"category"`
+	RawContract AlchemyRawContract `json:"rawContract"`
+}
+
+// AlchemyRawContract represents the raw transfer value of an Alchemy activity
+type AlchemyRawContract struct {
+	RawValue string `json:"rawValue"`
+	Address  string `json:"address"`
+	Decimals int    `json:"decimals"`
+}
+
+// InboundDeposit is a transfer to a watched address reported by a Notify provider webhook, normalized
+// from the provider's payload. ChainID is 0 when the payload doesn't name the chain
+type InboundDeposit struct {
+	ChainID         int64
+	ContractAddress string
+	TxHash          string
+	BlockNumber     int64
+	From            string
+	To              string
+	RawValue        decimal.Decimal
+}
+
 // WebhookSignatureVerification represents the result of signature verification
 type WebhookSignatureVerification struct {
 	IsValid   bool
@@ -822,3 +1736,10 @@ type ProviderInfoResponse struct {
 		} `json:"totalBalances"`
 	} `json:"data"`
 }
+
+// GraphQLPayload is a GraphQL query request
+type GraphQLPayload struct {
+	Query         string                 `json:"query" binding:"required"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
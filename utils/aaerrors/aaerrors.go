@@ -0,0 +1,314 @@
+// Package aaerrors maps ERC-4337 bundler and paymaster failures to typed errors.
+//
+// Bundlers report a rejected UserOperation as a JSON-RPC error whose message or data carries the
+// EntryPoint's AAxx revert code, e.g. "AA21 didn't pay prefund". Parse turns such an error into an
+// *Error wrapping one of the sentinel causes below, so callers can branch with errors.Is and
+// metrics can break failures down by Reason.
+package aaerrors
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Causes of a rejected UserOperation
+var (
+	// ErrAccountNotDeployed is returned for a sender with no code and no initCode (AA20)
+	ErrAccountNotDeployed = errors.New("smart account not deployed")
+	// ErrAccountCreation is returned when the factory fails to deploy the sender (AA10-AA15)
+	ErrAccountCreation = errors.New("smart account creation failed")
+	// ErrInsufficientPrefund is returned when the sender can't pay for the operation's gas (AA21)
+	ErrInsufficientPrefund = errors.New("insufficient prefund")
+	// ErrExpired is returned for an operation outside its validity window (AA22, AA32)
+	ErrExpired = errors.New("user operation expired or not due")
+	// ErrAccountValidation is returned when the account's validateUserOp reverts (AA23)
+	ErrAccountValidation = errors.New("account validation reverted")
+	// ErrInvalidSignature is returned when the account rejects the signature (AA24)
+	ErrInvalidSignature = errors.New("invalid signature")
+	// ErrInvalidNonce is returned for a nonce the account already used or doesn't expect (AA25)
+	ErrInvalidNonce = errors.New("invalid nonce")
+	// ErrGasLimitTooLow is returned when validation or execution runs out of the gas allotted (AA26, AA36, AA40, AA41, AA51, AA95)
+	ErrGasLimitTooLow = errors.New("gas limit too low")
+	// ErrPaymasterRejected is returned when the paymaster or its sponsorship policy refuses the operation (AA30, AA33, AA34)
+	ErrPaymasterRejected = errors.New("paymaster rejected user operation")
+	// ErrPaymasterDeposit is returned when the paymaster's EntryPoint deposit can't cover the operation (AA31)
+	ErrPaymasterDeposit = errors.New("paymaster deposit too low")
+	// ErrFeeTooLow is returned when the operation's gas fees are below what the bundler accepts
+	ErrFeeTooLow = errors.New("gas fee too low")
+	// ErrBannedOpcode is returned when validation uses opcodes or storage forbidden by ERC-7562
+	ErrBannedOpcode = errors.New("banned opcode or storage access")
+	// ErrThrottled is returned when an entity of the operation is throttled, banned or under-staked
+	ErrThrottled = errors.New("entity throttled or banned")
+	// ErrInvalidUserOperation is returned for malformed operations, e.g. invalid paymasterAndData (AA93, AA94)
+	ErrInvalidUserOperation = errors.New("invalid user operation")
+	// ErrUnknown is returned for failures that match no other cause
+	ErrUnknown = errors.New("unknown bundler error")
+)
+
+// cause describes a sentinel cause for metrics and operators
+type cause struct {
+	reason      string
+	remediation string
+	retryable   bool
+}
+
+var causes = map[error]cause{
+	ErrAccountNotDeployed: {
+		reason:      "account_not_deployed",
+		remediation: "include factory and factoryData so the first UserOperation deploys the account",
+	},
+	ErrAccountCreation: {
+		reason:      "account_creation_failed",
+		remediation: "check the factory address, the owner and salt in factoryData, and the verification gas limit",
+	},
+	ErrInsufficientPrefund: {
+		reason:      "insufficient_prefund",
+		remediation: "fund the account's EntryPoint deposit or sponsor the operation with a paymaster",
+		retryable:   true,
+	},
+	ErrExpired: {
+		reason:      "expired",
+		remediation: "rebuild the operation with a current validity window",
+	},
+	ErrAccountValidation: {
+		reason:      "account_validation_reverted",
+		remediation: "check the account is deployed with the expected owner and that callData is encoded for it",
+	},
+	ErrInvalidSignature: {
+		reason:      "invalid_signature",
+		remediation: "sign the userOpHash with the account's owner or session key for this chain and EntryPoint",
+	},
+	ErrInvalidNonce: {
+		reason:      "invalid_nonce",
+		remediation: "fetch the account's current nonce from the EntryPoint; an operation with this nonce may already be mined",
+	},
+	ErrGasLimitTooLow: {
+		reason:      "gas_limit_too_low",
+		remediation: "re-estimate gas limits or raise verificationGasLimit and callGasLimit",
+		retryable:   true,
+	},
+	ErrPaymasterRejected: {
+		reason:      "paymaster_rejected",
+		remediation: "check the gas policy allows this sender, chain and spend, and that the paymaster is deployed",
+	},
+	ErrPaymasterDeposit: {
+		reason:      "paymaster_deposit",
+		remediation: "top up the paymaster's EntryPoint deposit or the gas policy's balance",
+		retryable:   true,
+	},
+	ErrFeeTooLow: {
+		reason:      "fee_too_low",
+		remediation: "raise maxFeePerGas and maxPriorityFeePerGas to the bundler's current minimum",
+		retryable:   true,
+	},
+	ErrBannedOpcode: {
+		reason:      "banned_opcode",
+		remediation: "the account, factory or paymaster breaks ERC-7562 validation rules; it can't be bundled as is",
+	},
+	ErrThrottled: {
+		reason:      "throttled",
+		remediation: "wait for the bundler's reputation window to pass, or check the paymaster's stake",
+		retryable:   true,
+	},
+	ErrInvalidUserOperation: {
+		reason:      "invalid_user_operation",
+		remediation: "check the operation's fields against the EntryPoint version it is sent to",
+	},
+	ErrUnknown: {
+		reason:      "unknown",
+		remediation: "inspect the bundler's raw error",
+	},
+}
+
+// entryPointCodes maps the EntryPoint's AAxx revert codes to causes
+var entryPointCodes = map[string]error{
+	"AA10": ErrAccountCreation,
+	"AA13": ErrAccountCreation,
+	"AA14": ErrAccountCreation,
+	"AA15": ErrAccountCreation,
+	"AA20": ErrAccountNotDeployed,
+	"AA21": ErrInsufficientPrefund,
+	"AA22": ErrExpired,
+	"AA23": ErrAccountValidation,
+	"AA24": ErrInvalidSignature,
+	"AA25": ErrInvalidNonce,
+	"AA26": ErrGasLimitTooLow,
+	"AA30": ErrPaymasterRejected,
+	"AA31": ErrPaymasterDeposit,
+	"AA32": ErrExpired,
+	"AA33": ErrPaymasterRejected,
+	"AA34": ErrPaymasterRejected,
+	"AA36": ErrGasLimitTooLow,
+	"AA40": ErrGasLimitTooLow,
+	"AA41": ErrGasLimitTooLow,
+	"AA51": ErrGasLimitTooLow,
+	"AA93": ErrInvalidUserOperation,
+	"AA94": ErrInvalidUserOperation,
+	"AA95": ErrGasLimitTooLow,
+}
+
+// rpcCodes maps the JSON-RPC error codes of ERC-7769 to causes, for errors without an AAxx code
+var rpcCodes = map[int]error{
+	-32500: ErrAccountValidation,
+	-32501: ErrPaymasterRejected,
+	-32502: ErrBannedOpcode,
+	-32503: ErrExpired,
+	-32504: ErrThrottled,
+	-32505: ErrThrottled,
+	-32507: ErrInvalidSignature,
+}
+
+// messagePatterns map bundler messages without a code to causes, checked in order
+var messagePatterns = []struct {
+	pattern string
+	cause   error
+}{
+	{"replacement underpriced", ErrFeeTooLow},
+	{"fee too low", ErrFeeTooLow},
+	{"maxfeepergas", ErrFeeTooLow},
+	{"maxpriorityfeepergas", ErrFeeTooLow},
+	{"invalid nonce", ErrInvalidNonce},
+	{"nonce too low", ErrInvalidNonce},
+	{"policy", ErrPaymasterRejected},
+	{"sponsorship", ErrPaymasterRejected},
+	{"preverificationgas", ErrGasLimitTooLow},
+	{"out of gas", ErrGasLimitTooLow},
+}
+
+var entryPointCodePattern = regexp.MustCompile(`\bAA\d\d\b`)
+
+// Error is a rejected UserOperation
+type Error struct {
+	// Cause is one of the sentinel causes of this package
+	Cause error
+	// Code is the EntryPoint's AAxx code, empty when the bundler didn't report one
+	Code string
+	// RPCCode is the JSON-RPC error code, 0 when there was none
+	RPCCode int
+	// Message is the bundler's error message
+	Message string
+	// Data is the raw data of the JSON-RPC error, if any
+	Data json.RawMessage
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Cause, e.Message)
+}
+
+// Unwrap returns the sentinel cause so errors.Is matches it
+func (e *Error) Unwrap() error {
+	return e.Cause
+}
+
+// Reason is the cause as a short label for metrics and logs
+func (e *Error) Reason() string {
+	return causes[e.Cause].reason
+}
+
+// Remediation suggests what to change before sending the operation again
+func (e *Error) Remediation() string {
+	return causes[e.Cause].remediation
+}
+
+// Retryable reports whether the same operation may succeed later or with adjusted gas, without
+// changes to the account, its owner or its calls
+func (e *Error) Retryable() bool {
+	return causes[e.Cause].retryable
+}
+
+// Parse maps the "error" member of a bundler or paymaster JSON-RPC response to an *Error. errorObject
+// is either the decoded JSON value or its raw bytes
+func Parse(errorObject interface{}) *Error {
+	var raw struct {
+		Code    int             `json:"code"`
+		Message string          `json:"message"`
+		Data    json.RawMessage `json:"data"`
+	}
+
+	var body []byte
+	switch v := errorObject.(type) {
+	case []byte:
+		body = v
+	case json.RawMessage:
+		body = v
+	case string:
+		raw.Message = v
+	default:
+		body, _ = json.Marshal(v)
+	}
+	if body != nil && json.Unmarshal(body, &raw) != nil {
+		raw.Message = string(body)
+	}
+
+	return classify(raw.Code, raw.Message, raw.Data)
+}
+
+// FromRPC maps an error returned by go-ethereum's rpc client to an *Error. Transport failures and
+// errors without a JSON-RPC code are returned unchanged
+func FromRPC(err error) error {
+	var rpcErr rpc.Error
+	if err == nil || !errors.As(err, &rpcErr) {
+		return err
+	}
+
+	var data json.RawMessage
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) && dataErr.ErrorData() != nil {
+		data, _ = json.Marshal(dataErr.ErrorData())
+	}
+
+	return classify(rpcErr.ErrorCode(), rpcErr.Error(), data)
+}
+
+// Reason returns the metrics label of err's cause, "unknown" when err wasn't mapped by this package
+func Reason(err error) string {
+	var aaErr *Error
+	if errors.As(err, &aaErr) {
+		return aaErr.Reason()
+	}
+	return causes[ErrUnknown].reason
+}
+
+// classify picks the cause of an error, preferring the EntryPoint's code over the JSON-RPC code,
+// and both over the message
+func classify(rpcCode int, message string, data json.RawMessage) *Error {
+	e := &Error{
+		Cause:   ErrUnknown,
+		RPCCode: rpcCode,
+		Message: message,
+		Data:    data,
+	}
+
+	for _, text := range []string{message, string(data)} {
+		for _, code := range entryPointCodePattern.FindAllString(text, -1) {
+			if cause, ok := entryPointCodes[code]; ok {
+				e.Code, e.Cause = code, cause
+				return e
+			}
+		}
+	}
+
+	if cause, ok := rpcCodes[rpcCode]; ok {
+		e.Cause = cause
+		return e
+	}
+
+	lower := strings.ToLower(message)
+	for _, p := range messagePatterns {
+		if strings.Contains(lower, p.pattern) {
+			e.Cause = p.cause
+			return e
+		}
+	}
+
+	if e.Message == "" {
+		e.Message = "bundler error " + strconv.Itoa(rpcCode)
+	}
+	return e
+}
@@ -0,0 +1,94 @@
+package aaerrors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// rpcError mimics the errors returned by go-ethereum's rpc client for JSON-RPC error responses
+type rpcError struct {
+	code    int
+	message string
+	data    interface{}
+}
+
+func (e *rpcError) Error() string          { return e.message }
+func (e *rpcError) ErrorCode() int         { return e.code }
+func (e *rpcError) ErrorData() interface{} { return e.data }
+
+func TestParse(t *testing.T) {
+	t.Run("maps EntryPoint codes in the message", func(t *testing.T) {
+		err := Parse(map[string]interface{}{
+			"code":    -32500,
+			"message": "AA21 didn't pay prefund",
+		})
+
+		assert.ErrorIs(t, err, ErrInsufficientPrefund)
+		assert.Equal(t, "AA21", err.Code)
+		assert.Equal(t, -32500, err.RPCCode)
+		assert.Equal(t, "insufficient_prefund", err.Reason())
+		assert.True(t, err.Retryable())
+		assert.NotEmpty(t, err.Remediation())
+		assert.Equal(t, "insufficient prefund: AA21 didn't pay prefund", err.Error())
+	})
+
+	t.Run("maps EntryPoint codes in the revert reason", func(t *testing.T) {
+		err := Parse([]byte(`{"code":-32603,"message":"execution reverted","data":{"reason":"AA13 initCode failed or OOG"}}`))
+
+		assert.ErrorIs(t, err, ErrAccountCreation)
+		assert.Equal(t, "AA13", err.Code)
+		assert.JSONEq(t, `{"reason":"AA13 initCode failed or OOG"}`, string(err.Data))
+	})
+
+	t.Run("prefers the EntryPoint code over the JSON-RPC code", func(t *testing.T) {
+		err := Parse(map[string]interface{}{"code": -32501, "message": "AA31 paymaster deposit too low"})
+		assert.ErrorIs(t, err, ErrPaymasterDeposit)
+
+		err = Parse(map[string]interface{}{"code": -32501, "message": "paymaster validation failed"})
+		assert.ErrorIs(t, err, ErrPaymasterRejected)
+		assert.Empty(t, err.Code)
+	})
+
+	t.Run("maps known messages without codes", func(t *testing.T) {
+		assert.ErrorIs(t, Parse(map[string]interface{}{"code": -32602, "message": "maxFeePerGas must be at least 1000000"}), ErrFeeTooLow)
+		assert.ErrorIs(t, Parse(map[string]interface{}{"code": -32600, "message": "User operation is not sponsored by the gas policy"}), ErrPaymasterRejected)
+	})
+
+	t.Run("falls back to unknown", func(t *testing.T) {
+		err := Parse(map[string]interface{}{"code": -32000, "message": "internal error"})
+		assert.ErrorIs(t, err, ErrUnknown)
+		assert.Equal(t, "unknown", err.Reason())
+		assert.False(t, err.Retryable())
+
+		err = Parse([]byte("upstream timeout"))
+		assert.ErrorIs(t, err, ErrUnknown)
+		assert.Equal(t, "upstream timeout", err.Message)
+	})
+}
+
+func TestFromRPC(t *testing.T) {
+	t.Run("maps JSON-RPC errors", func(t *testing.T) {
+		err := FromRPC(fmt.Errorf("send: %w", &rpcError{code: -32500, message: "validation failed", data: "AA25 invalid account nonce"}))
+
+		assert.ErrorIs(t, err, ErrInvalidNonce)
+		assert.Equal(t, "invalid_nonce", Reason(err))
+	})
+
+	t.Run("leaves other errors unchanged", func(t *testing.T) {
+		transport := errors.New("connection refused")
+
+		assert.Equal(t, transport, FromRPC(transport))
+		assert.Nil(t, FromRPC(nil))
+		assert.Equal(t, "unknown", Reason(transport))
+	})
+
+	t.Run("matches through wrapping", func(t *testing.T) {
+		err := fmt.Errorf("failed to send batch transaction: %w", Parse(map[string]interface{}{"message": "AA24 signature error"}))
+
+		assert.True(t, errors.Is(err, ErrInvalidSignature))
+		assert.Equal(t, "invalid_signature", Reason(err))
+	})
+}
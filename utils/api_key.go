@@ -0,0 +1,53 @@
+package utils
+
+import (
+	"slices"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+)
+
+// Scopes of API keys
+const (
+	// APIKeyScopeRead allows fetching orders, stats and other resources
+	APIKeyScopeRead = "read"
+	// APIKeyScopeCreateOrders allows senders to create orders and linked addresses
+	APIKeyScopeCreateOrders = "create_orders"
+	// APIKeyScopeFulfillOrders allows providers to accept, fulfill and cancel orders and report balances
+	APIKeyScopeFulfillOrders = "fulfill_orders"
+	// APIKeyScopeWebhooksAdmin allows inspecting webhook deliveries
+	APIKeyScopeWebhooksAdmin = "webhooks_admin"
+)
+
+// APIKeyScopes are the scopes an API key can be given
+var APIKeyScopes = []string{
+	APIKeyScopeRead,
+	APIKeyScopeCreateOrders,
+	APIKeyScopeFulfillOrders,
+	APIKeyScopeWebhooksAdmin,
+}
+
+// APIKeyHasScope reports whether an API key has a scope. Keys without scopes have every scope
+func APIKeyHasScope(apiKey *ent.APIKey, scope string) bool {
+	return len(apiKey.Scopes) == 0 || slices.Contains(apiKey.Scopes, scope)
+}
+
+// APIKeyExpired reports whether an API key has expired or was revoked
+func APIKeyExpired(apiKey *ent.APIKey) bool {
+	return apiKey.ExpiresAt != nil && !apiKey.ExpiresAt.After(time.Now())
+}
+
+// PrimaryAPIKey returns the key that signs the requests and webhooks sent to a profile: the newest
+// unexpired key with every scope. It is the key created at signup until that key is rotated
+func PrimaryAPIKey(apiKeys []*ent.APIKey) *ent.APIKey {
+	var primary *ent.APIKey
+	for _, apiKey := range apiKeys {
+		if len(apiKey.Scopes) > 0 || APIKeyExpired(apiKey) {
+			continue
+		}
+		if primary == nil || apiKey.CreatedAt.After(primary.CreatedAt) {
+			primary = apiKey
+		}
+	}
+	return primary
+}
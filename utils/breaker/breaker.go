@@ -0,0 +1,369 @@
+package breaker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/NEDA-LABS/stablenode/utils/ratelimit"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// ErrOpen is returned instead of calling a service whose circuit is open
+var ErrOpen = errors.New("circuit breaker is open")
+
+// State is the state of a circuit
+type State int
+
+const (
+	// StateClosed lets calls through and counts consecutive failures
+	StateClosed State = iota
+	// StateOpen fails calls fast until the open timeout passes
+	StateOpen
+	// StateHalfOpen lets a limited number of probe calls through to test whether the service recovered
+	StateHalfOpen
+)
+
+// String returns the name of the state used in stats and events
+func (s State) String() string {
+	switch s {
+	case StateClosed:
+		return "closed"
+	case StateOpen:
+		return "open"
+	case StateHalfOpen:
+		return "half_open"
+	default:
+		return "unknown"
+	}
+}
+
+// StateChange is emitted whenever a circuit changes state
+type StateChange struct {
+	Name      string
+	From      State
+	To        State
+	Failures  int
+	LastError string
+}
+
+// Stats are the metrics of a circuit
+type Stats struct {
+	State     string     `json:"state"`
+	Failures  int        `json:"failures"`
+	OpenedAt  *time.Time `json:"openedAt,omitempty"`
+	LastError string     `json:"lastError,omitempty"`
+	// Rejected counts calls failed fast while the circuit was open
+	Rejected int64 `json:"rejected"`
+}
+
+// Breaker is the circuit of a single service host
+type Breaker struct {
+	name     string
+	config   *config.CircuitBreakerConfiguration
+	registry *Registry
+
+	mutex      sync.Mutex
+	state      State
+	generation int
+	failures   int
+	successes  int
+	probes     int
+	openedAt   time.Time
+	lastError  string
+	rejected   int64
+}
+
+// Allow reserves a call through the circuit, returning ErrOpen when the call must fail fast.
+// The returned function reports the outcome of the call; errors caused by the caller cancelling
+// the call count as neither success nor failure
+func (b *Breaker) Allow() (func(err error), error) {
+	b.mutex.Lock()
+
+	if b.state == StateOpen && !b.registry.now().Before(b.openedAt.Add(b.config.OpenTimeout)) {
+		b.transition(StateHalfOpen)
+	}
+
+	switch {
+	case b.state == StateOpen,
+		b.state == StateHalfOpen && b.probes >= b.probeLimit():
+		b.rejected++
+		b.mutex.Unlock()
+		return nil, fmt.Errorf("%s: %w", b.name, ErrOpen)
+	case b.state == StateHalfOpen:
+		b.probes++
+	}
+
+	generation := b.generation
+	b.mutex.Unlock()
+
+	return func(err error) { b.report(generation, err) }, nil
+}
+
+// Execute runs fn through the circuit
+func (b *Breaker) Execute(fn func() error) error {
+	done, err := b.Allow()
+	if err != nil {
+		return err
+	}
+
+	err = fn()
+	done(err)
+	return err
+}
+
+// State returns the current state of the circuit
+func (b *Breaker) State() State {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.state
+}
+
+// report records the outcome of a call allowed in the given generation of the circuit.
+// Outcomes of calls started before the last state change are ignored
+func (b *Breaker) report(generation int, err error) {
+	b.mutex.Lock()
+
+	if generation != b.generation {
+		b.mutex.Unlock()
+		return
+	}
+
+	if b.state == StateHalfOpen {
+		b.probes--
+	}
+
+	switch {
+	case errors.Is(err, context.Canceled):
+	case err != nil:
+		b.failures++
+		b.lastError = err.Error()
+		if b.state == StateHalfOpen || b.failures >= b.config.FailureThreshold {
+			b.openedAt = b.registry.now()
+			b.transition(StateOpen)
+		}
+	case b.state == StateHalfOpen:
+		b.successes++
+		if b.successes >= b.probeLimit() {
+			b.transition(StateClosed)
+		}
+	default:
+		b.failures = 0
+	}
+
+	b.mutex.Unlock()
+}
+
+// transition moves the circuit to a new state and emits the change. Must be called with the
+// mutex held
+func (b *Breaker) transition(to State) {
+	change := StateChange{
+		Name:      b.name,
+		From:      b.state,
+		To:        to,
+		Failures:  b.failures,
+		LastError: b.lastError,
+	}
+
+	b.state = to
+	b.generation++
+	b.probes = 0
+	b.successes = 0
+	if to == StateClosed {
+		b.failures = 0
+		b.lastError = ""
+	}
+
+	b.registry.emit(change)
+}
+
+// probeLimit returns how many probes the half-open circuit lets through
+func (b *Breaker) probeLimit() int {
+	if b.config.HalfOpenProbes < 1 {
+		return 1
+	}
+	return b.config.HalfOpenProbes
+}
+
+func (b *Breaker) stats() Stats {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	stats := Stats{
+		State:     b.state.String(),
+		Failures:  b.failures,
+		LastError: b.lastError,
+		Rejected:  b.rejected,
+	}
+	if b.state != StateClosed {
+		openedAt := b.openedAt
+		stats.OpenedAt = &openedAt
+	}
+	return stats
+}
+
+// Registry holds the circuits of all service hosts and notifies listeners of their state changes
+type Registry struct {
+	config    *config.CircuitBreakerConfiguration
+	mutex     sync.Mutex
+	breakers  map[string]*Breaker
+	listeners []func(change StateChange)
+	now       func() time.Time
+}
+
+var (
+	defaultRegistry     *Registry
+	defaultRegistryOnce sync.Once
+)
+
+// NewRegistry creates a new registry
+func NewRegistry(conf *config.CircuitBreakerConfiguration) *Registry {
+	return &Registry{
+		config:   conf,
+		breakers: make(map[string]*Breaker),
+		now:      time.Now,
+	}
+}
+
+// Default returns the process-wide registry, so all clients of a service host share its circuit
+func Default() *Registry {
+	defaultRegistryOnce.Do(func() {
+		defaultRegistry = NewRegistry(config.CircuitBreakerConfig())
+	})
+	return defaultRegistry
+}
+
+// Get returns the circuit with the given name, creating it closed
+func (r *Registry) Get(name string) *Breaker {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if breaker, ok := r.breakers[name]; ok {
+		return breaker
+	}
+
+	breaker := &Breaker{
+		name:     name,
+		config:   r.config,
+		registry: r,
+	}
+	r.breakers[name] = breaker
+	return breaker
+}
+
+// OnStateChange adds a listener called on every state change. Listeners run while the circuit
+// is locked, so they must not block or call into it
+func (r *Registry) OnStateChange(listener func(change StateChange)) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.listeners = append(r.listeners, listener)
+}
+
+// AnyOpen reports whether the circuit of any host of a service is not closed
+func (r *Registry) AnyOpen(service string) bool {
+	r.mutex.Lock()
+	breakers := make([]*Breaker, 0, len(r.breakers))
+	for name, breaker := range r.breakers {
+		if strings.HasPrefix(name, service+":") {
+			breakers = append(breakers, breaker)
+		}
+	}
+	r.mutex.Unlock()
+
+	for _, breaker := range breakers {
+		if breaker.State() != StateClosed {
+			return true
+		}
+	}
+	return false
+}
+
+// Stats returns the metrics of every circuit that has been called
+func (r *Registry) Stats() map[string]Stats {
+	r.mutex.Lock()
+	breakers := make(map[string]*Breaker, len(r.breakers))
+	for name, breaker := range r.breakers {
+		breakers[name] = breaker
+	}
+	r.mutex.Unlock()
+
+	stats := make(map[string]Stats, len(breakers))
+	for name, breaker := range breakers {
+		stats[name] = breaker.stats()
+	}
+	return stats
+}
+
+// emit logs a state change and passes it to the listeners
+func (r *Registry) emit(change StateChange) {
+	fields := logger.Fields{
+		"Circuit":  change.Name,
+		"From":     change.From.String(),
+		"To":       change.To.String(),
+		"Failures": change.Failures,
+	}
+	if change.LastError != "" {
+		fields["LastError"] = change.LastError
+	}
+	if change.To == StateOpen {
+		logger.WithFields(fields).Warnf("Circuit breaker opened, failing calls fast")
+	} else {
+		logger.WithFields(fields).Infof("Circuit breaker changed state")
+	}
+
+	r.mutex.Lock()
+	listeners := r.listeners
+	r.mutex.Unlock()
+
+	for _, listener := range listeners {
+		listener(change)
+	}
+}
+
+// Transport wraps an HTTP transport in the circuits of a service, one per host. Transport errors,
+// 5xx responses and 429 responses count as failures
+type Transport struct {
+	Base     http.RoundTripper
+	Service  string
+	Registry *Registry
+}
+
+// NewTransport returns a transport using the process-wide circuits of a service
+func NewTransport(service string, base http.RoundTripper) *Transport {
+	return &Transport{Base: base, Service: service, Registry: Default()}
+}
+
+// RoundTrip sends the request unless the circuit of its host is open
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	done, err := t.Registry.Get(t.Service + ":" + req.URL.Host).Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := t.Base.RoundTrip(req)
+	switch {
+	case err != nil:
+		done(err)
+	case res.StatusCode >= http.StatusInternalServerError || res.StatusCode == http.StatusTooManyRequests:
+		done(fmt.Errorf("HTTP %d", res.StatusCode))
+	default:
+		done(nil)
+	}
+	return res, err
+}
+
+// DialRPC connects to an RPC endpoint of a service with HTTP calls going through its circuits
+// and the process-wide rate limiter. WebSocket endpoints are not wrapped
+func DialRPC(ctx context.Context, service, endpoint string) (*rpc.Client, error) {
+	if !strings.HasPrefix(endpoint, "http") {
+		return ratelimit.DialRPC(ctx, endpoint)
+	}
+	client := &http.Client{Transport: NewTransport(service, ratelimit.NewTransport())}
+	return rpc.DialOptions(ctx, endpoint, rpc.WithHTTPClient(client))
+}
@@ -0,0 +1,152 @@
+package breaker
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestRegistry() (*Registry, *time.Time) {
+	registry := NewRegistry(&config.CircuitBreakerConfiguration{
+		FailureThreshold: 3,
+		OpenTimeout:      30 * time.Second,
+		HalfOpenProbes:   2,
+	})
+	now := time.Now()
+	registry.now = func() time.Time { return now }
+	return registry, &now
+}
+
+func TestBreaker(t *testing.T) {
+	failure := errors.New("connection refused")
+
+	t.Run("opens after consecutive failures and fails fast", func(t *testing.T) {
+		registry, _ := newTestRegistry()
+		var changes []StateChange
+		registry.OnStateChange(func(change StateChange) { changes = append(changes, change) })
+		b := registry.Get("alchemy:base")
+
+		// A success in between resets the count
+		assert.Equal(t, failure, b.Execute(func() error { return failure }))
+		assert.Equal(t, failure, b.Execute(func() error { return failure }))
+		assert.NoError(t, b.Execute(func() error { return nil }))
+		assert.Equal(t, StateClosed, b.State())
+
+		for i := 0; i < 3; i++ {
+			assert.Equal(t, failure, b.Execute(func() error { return failure }))
+		}
+		assert.Equal(t, StateOpen, b.State())
+
+		called := false
+		err := b.Execute(func() error { called = true; return nil })
+		assert.ErrorIs(t, err, ErrOpen)
+		assert.False(t, called)
+
+		assert.Equal(t, []StateChange{{
+			Name:      "alchemy:base",
+			From:      StateClosed,
+			To:        StateOpen,
+			Failures:  3,
+			LastError: failure.Error(),
+		}}, changes)
+
+		stats := registry.Stats()["alchemy:base"]
+		assert.Equal(t, "open", stats.State)
+		assert.Equal(t, int64(1), stats.Rejected)
+		assert.NotNil(t, stats.OpenedAt)
+		assert.True(t, registry.AnyOpen("alchemy"))
+		assert.False(t, registry.AnyOpen("engine"))
+	})
+
+	t.Run("closes after the half-open probes succeed", func(t *testing.T) {
+		registry, now := newTestRegistry()
+		b := registry.Get("engine:insight")
+		for i := 0; i < 3; i++ {
+			_ = b.Execute(func() error { return failure })
+		}
+
+		*now = now.Add(30 * time.Second)
+
+		// Only as many probes as configured are let through at once
+		first, err := b.Allow()
+		assert.NoError(t, err)
+		second, err := b.Allow()
+		assert.NoError(t, err)
+		_, err = b.Allow()
+		assert.ErrorIs(t, err, ErrOpen)
+		assert.Equal(t, StateHalfOpen, b.State())
+
+		first(nil)
+		assert.Equal(t, StateHalfOpen, b.State())
+		second(nil)
+		assert.Equal(t, StateClosed, b.State())
+		assert.Equal(t, 0, registry.Stats()["engine:insight"].Failures)
+	})
+
+	t.Run("reopens when a probe fails", func(t *testing.T) {
+		registry, now := newTestRegistry()
+		b := registry.Get("paymaster:base")
+		for i := 0; i < 3; i++ {
+			_ = b.Execute(func() error { return failure })
+		}
+
+		*now = now.Add(30 * time.Second)
+		assert.Equal(t, failure, b.Execute(func() error { return failure }))
+		assert.Equal(t, StateOpen, b.State())
+
+		// The open timeout starts over
+		*now = now.Add(29 * time.Second)
+		assert.ErrorIs(t, b.Execute(func() error { return nil }), ErrOpen)
+	})
+
+	t.Run("ignores cancelled calls and outcomes from before a state change", func(t *testing.T) {
+		registry, _ := newTestRegistry()
+		b := registry.Get("alchemy:base")
+
+		for i := 0; i < 5; i++ {
+			_ = b.Execute(func() error { return context.Canceled })
+		}
+		assert.Equal(t, StateClosed, b.State())
+
+		slow, err := b.Allow()
+		assert.NoError(t, err)
+		for i := 0; i < 3; i++ {
+			_ = b.Execute(func() error { return failure })
+		}
+
+		// A call started while closed does not close the open circuit by succeeding
+		slow(nil)
+		assert.Equal(t, StateOpen, b.State())
+	})
+}
+
+func TestTransport(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	registry, _ := newTestRegistry()
+	client := &http.Client{Transport: &Transport{Base: http.DefaultTransport, Service: "engine", Registry: registry}}
+
+	for i := 0; i < 3; i++ {
+		res, err := client.Get(server.URL)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadGateway, res.StatusCode)
+		res.Body.Close()
+	}
+
+	_, err := client.Get(server.URL)
+	assert.ErrorIs(t, err, ErrOpen)
+	assert.Equal(t, int32(3), requests.Load())
+	assert.True(t, registry.AnyOpen("engine"))
+}
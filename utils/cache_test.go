@@ -0,0 +1,64 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	"github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/test"
+	"github.com/alicebob/miniredis/v2"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupCache(t *testing.T) {
+	ctx := context.Background()
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+	storage.RegisterLookupCacheHooks(client)
+	storage.Client = client
+
+	mr, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer mr.Close()
+
+	storage.RedisClient = redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer func() { storage.RedisClient = nil }()
+
+	// The default test currency is NGN at 950, served by Access Bank
+	currency, err := test.CreateTestFiatCurrency(nil)
+	assert.NoError(t, err)
+
+	t.Run("should cache institution lookups with their fiat currency", func(t *testing.T) {
+		result, err := GetInstitutionByCode(ctx, "ABNGNGLA", true)
+		assert.NoError(t, err)
+		assert.Equal(t, "NGN", result.Edges.FiatCurrency.Code)
+
+		var cached ent.Institution
+		assert.True(t, storage.GetCachedLookup(ctx, &cached, "institution", "ABNGNGLA", "true"))
+		assert.Equal(t, result.Name, cached.Name)
+		assert.True(t, cached.Edges.FiatCurrency.MarketRate.Equal(decimal.NewFromInt(950)))
+	})
+
+	t.Run("should invalidate cached lookups when the market rate changes", func(t *testing.T) {
+		rate, err := GetFiatCurrencyByCode(ctx, "NGN")
+		assert.NoError(t, err)
+		assert.True(t, rate.MarketRate.Equal(decimal.NewFromInt(950)))
+
+		_, err = client.FiatCurrency.UpdateOne(currency).SetMarketRate(decimal.NewFromInt(1500)).Save(ctx)
+		assert.NoError(t, err)
+
+		rate, err = GetFiatCurrencyByCode(ctx, "NGN")
+		assert.NoError(t, err)
+		assert.True(t, rate.MarketRate.Equal(decimal.NewFromInt(1500)))
+
+		result, err := GetInstitutionByCode(ctx, "ABNGNGLA", true)
+		assert.NoError(t, err)
+		assert.True(t, result.Edges.FiatCurrency.MarketRate.Equal(decimal.NewFromInt(1500)))
+	})
+}
@@ -34,9 +34,52 @@ func CheckPasswordHash(password, hash string) bool {
 	return err == nil
 }
 
-// EncryptPlain encrypts plaintext using AES encryption algorithm with Galois Counter Mode
+// EncryptPlain encrypts plaintext using AES encryption algorithm with Galois Counter Mode. With
+// ENCRYPTION_KEY_ID set, the ciphertext is an envelope carrying the ID of the key
 func EncryptPlain(plaintext []byte) ([]byte, error) {
-	block, err := aes.NewCipher([]byte(authConf.Secret))
+	keyID := cryptoConf.EncryptionKeyID
+	if keyID == "" {
+		return sealAESGCM([]byte(authConf.Secret), plaintext, nil)
+	}
+	if len(keyID) > 255 {
+		return nil, fmt.Errorf("encryption key ID %q is longer than 255 bytes", keyID)
+	}
+
+	key, ok := cryptoConf.EncryptionKeys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("encryption key %q is not in ENCRYPTION_KEYS", keyID)
+	}
+	return sealAESGCM([]byte(key), plaintext, envelopeHeader(keyID))
+}
+
+// DecryptPlain decrypts ciphertext using AES encryption algorithm with Galois Counter Mode, with the
+// key named by its envelope or with SECRET for unversioned ciphertexts
+func DecryptPlain(ciphertext []byte) ([]byte, error) {
+	keyID, body, ok := parseEnvelope(ciphertext)
+	if !ok {
+		return openAESGCM([]byte(authConf.Secret), ciphertext)
+	}
+
+	key, known := cryptoConf.EncryptionKeys[keyID]
+	if known {
+		if plaintext, err := openAESGCM([]byte(key), body); err == nil {
+			return plaintext, nil
+		}
+	}
+
+	// An unversioned ciphertext whose nonce happens to start like an envelope
+	if plaintext, err := openAESGCM([]byte(authConf.Secret), ciphertext); err == nil {
+		return plaintext, nil
+	}
+	if !known {
+		return nil, fmt.Errorf("encryption key %q is not in ENCRYPTION_KEYS", keyID)
+	}
+	return nil, fmt.Errorf("failed to decrypt with encryption key %q", keyID)
+}
+
+// sealAESGCM encrypts plaintext and returns header || nonce || ciphertext
+func sealAESGCM(key []byte, plaintext []byte, header []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
 	if err != nil {
 		return nil, err
 	}
@@ -53,16 +96,14 @@ func EncryptPlain(plaintext []byte) ([]byte, error) {
 		return nil, err
 	}
 
-	// Encrypt and append nonce
-	ciphertext := gcm.Seal(nonce, nonce, plaintext, nil)
-
-	return ciphertext, nil
+	// Encrypt and append to the header and nonce
+	out := append(append(make([]byte, 0, len(header)+len(nonce)+len(plaintext)+gcm.Overhead()), header...), nonce...)
+	return gcm.Seal(out, nonce, plaintext, nil), nil
 }
 
-// DecryptPlain decrypts ciphertext using AES encryption algorithm with Galois Counter Mode
-func DecryptPlain(ciphertext []byte) ([]byte, error) {
-
-	block, err := aes.NewCipher([]byte(authConf.Secret))
+// openAESGCM decrypts nonce || ciphertext
+func openAESGCM(key []byte, ciphertext []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
 	if err != nil {
 		return nil, err
 	}
@@ -73,16 +114,15 @@ func DecryptPlain(ciphertext []byte) ([]byte, error) {
 		return nil, err
 	}
 
+	if len(ciphertext) < gcm.NonceSize() {
+		return nil, fmt.Errorf("ciphertext too short")
+	}
+
 	// Parse nonce from ciphertext
 	nonce, ciphertext := ciphertext[:gcm.NonceSize()], ciphertext[gcm.NonceSize():]
 
 	// Decrypt and return plaintext
-	plaintext, err := gcm.Open(nil, nonce, ciphertext, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return plaintext, nil
+	return gcm.Open(nil, nonce, ciphertext, nil)
 }
 
 // EncryptJSON encrypts JSON serializable data using AES encryption algorithm with Galois Counter Mode
@@ -0,0 +1,65 @@
+package crypto
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// envelopeMagic starts ciphertexts that carry the ID of their encryption key:
+// magic || len(keyID) || keyID || nonce || ciphertext. Ciphertexts without it are unversioned and
+// were encrypted with SECRET
+var envelopeMagic = []byte{0x00, 'E', 'K', 'V', '1'}
+
+// envelopeHeader returns the header of an envelope encrypted under keyID
+func envelopeHeader(keyID string) []byte {
+	header := make([]byte, 0, len(envelopeMagic)+1+len(keyID))
+	header = append(header, envelopeMagic...)
+	header = append(header, byte(len(keyID)))
+	return append(header, keyID...)
+}
+
+// parseEnvelope splits an envelope into its key ID and nonce || ciphertext
+func parseEnvelope(ciphertext []byte) (keyID string, body []byte, ok bool) {
+	if !bytes.HasPrefix(ciphertext, envelopeMagic) || len(ciphertext) <= len(envelopeMagic) {
+		return "", nil, false
+	}
+
+	idLen := int(ciphertext[len(envelopeMagic)])
+	start := len(envelopeMagic) + 1
+	if idLen == 0 || len(ciphertext) < start+idLen {
+		return "", nil, false
+	}
+
+	return string(ciphertext[start : start+idLen]), ciphertext[start+idLen:], true
+}
+
+// KeyID returns the ID of the key a ciphertext was encrypted under, or "" for unversioned
+// ciphertexts encrypted with SECRET
+func KeyID(ciphertext []byte) string {
+	keyID, _, _ := parseEnvelope(ciphertext)
+	return keyID
+}
+
+// ActiveKeyID returns the ID of the key EncryptPlain encrypts under, or "" for SECRET
+func ActiveKeyID() string {
+	return cryptoConf.EncryptionKeyID
+}
+
+// NeedsReencryption reports whether a ciphertext is under another key than the active one
+func NeedsReencryption(ciphertext []byte) bool {
+	return KeyID(ciphertext) != ActiveKeyID()
+}
+
+// Reencrypt decrypts a ciphertext and encrypts it again under the active key
+func Reencrypt(ciphertext []byte) ([]byte, error) {
+	plaintext, err := DecryptPlain(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+
+	reencrypted, err := EncryptPlain(plaintext)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt under key %q: %w", ActiveKeyID(), err)
+	}
+	return reencrypted, nil
+}
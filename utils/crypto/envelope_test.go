@@ -0,0 +1,91 @@
+package crypto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptionEnvelope(t *testing.T) {
+	secret, keyID, keys := authConf.Secret, cryptoConf.EncryptionKeyID, cryptoConf.EncryptionKeys
+	defer func() {
+		authConf.Secret, cryptoConf.EncryptionKeyID, cryptoConf.EncryptionKeys = secret, keyID, keys
+	}()
+
+	authConf.Secret = "0123456789abcdef0123456789abcdef"
+	cryptoConf.EncryptionKeyID = ""
+	cryptoConf.EncryptionKeys = map[string]string{
+		"2024": "abcdefghijklmnopqrstuvwxyz012345",
+		"2025": "ABCDEFGHIJKLMNOPQRSTUVWXYZ012345",
+	}
+
+	unversioned, err := EncryptPlain([]byte("salt"))
+	require.NoError(t, err)
+	assert.Equal(t, "", KeyID(unversioned))
+
+	t.Run("encrypts under the active key", func(t *testing.T) {
+		cryptoConf.EncryptionKeyID = "2024"
+		defer func() { cryptoConf.EncryptionKeyID = "" }()
+
+		ciphertext, err := EncryptPlain([]byte("salt"))
+		require.NoError(t, err)
+		assert.Equal(t, "2024", KeyID(ciphertext))
+		assert.False(t, NeedsReencryption(ciphertext))
+		assert.True(t, NeedsReencryption(unversioned))
+
+		plaintext, err := DecryptPlain(ciphertext)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("salt"), plaintext)
+	})
+
+	t.Run("decrypts with every listed key and SECRET", func(t *testing.T) {
+		cryptoConf.EncryptionKeyID = "2024"
+		old, err := EncryptPlain([]byte("old"))
+		require.NoError(t, err)
+
+		cryptoConf.EncryptionKeyID = "2025"
+		defer func() { cryptoConf.EncryptionKeyID = "" }()
+
+		plaintext, err := DecryptPlain(old)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("old"), plaintext)
+
+		plaintext, err = DecryptPlain(unversioned)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("salt"), plaintext)
+
+		reencrypted, err := Reencrypt(old)
+		require.NoError(t, err)
+		assert.Equal(t, "2025", KeyID(reencrypted))
+		plaintext, err = DecryptPlain(reencrypted)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("old"), plaintext)
+	})
+
+	t.Run("fails for removed keys", func(t *testing.T) {
+		cryptoConf.EncryptionKeyID = "2024"
+		ciphertext, err := EncryptPlain([]byte("salt"))
+		cryptoConf.EncryptionKeyID = ""
+		require.NoError(t, err)
+
+		delete(cryptoConf.EncryptionKeys, "2024")
+		defer func() { cryptoConf.EncryptionKeys["2024"] = "abcdefghijklmnopqrstuvwxyz012345" }()
+
+		_, err = DecryptPlain(ciphertext)
+		assert.ErrorContains(t, err, `"2024"`)
+	})
+
+	t.Run("rejects an active key that isn't listed", func(t *testing.T) {
+		cryptoConf.EncryptionKeyID = "missing"
+		defer func() { cryptoConf.EncryptionKeyID = "" }()
+
+		_, err := EncryptPlain([]byte("salt"))
+		assert.Error(t, err)
+	})
+
+	t.Run("rejects truncated ciphertexts", func(t *testing.T) {
+		_, err := DecryptPlain([]byte{1, 2, 3})
+		assert.Error(t, err)
+	})
+}
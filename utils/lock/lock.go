@@ -0,0 +1,234 @@
+package lock
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+)
+
+// ErrNotAcquired is returned when a lock is held by another instance
+var ErrNotAcquired = errors.New("lock held by another instance")
+
+// keyPrefix namespaces lock keys in Redis
+const keyPrefix = "lock:"
+
+var (
+	// releaseScript deletes a lock only while it is still held with the caller's token
+	releaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`)
+
+	// extendScript renews a lock only while it is still held with the caller's token
+	extendScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0`)
+)
+
+// Locker hands out locks coordinating aggregator instances through Redis. While disabled, every
+// lock is granted without touching Redis, for deployments running a single instance
+type Locker struct {
+	conf *config.DistributedLockConfiguration
+	// client is the Redis client locks are taken on; nil uses storage.RedisClient
+	client *redis.Client
+	owner  string
+}
+
+var (
+	defaultLocker     *Locker
+	defaultLockerOnce sync.Once
+)
+
+// New creates a locker taking locks on client
+func New(conf *config.DistributedLockConfiguration, client *redis.Client) *Locker {
+	hostname, _ := os.Hostname()
+	return &Locker{
+		conf:   conf,
+		client: client,
+		owner:  fmt.Sprintf("%s:%d", hostname, os.Getpid()),
+	}
+}
+
+// Default returns the process-wide locker, taking locks on the shared Redis client
+func Default() *Locker {
+	defaultLockerOnce.Do(func() {
+		defaultLocker = New(config.DistributedLockConfig(), nil)
+	})
+	return defaultLocker
+}
+
+// redis returns the client locks are taken on
+func (l *Locker) redis() *redis.Client {
+	if l.client != nil {
+		return l.client
+	}
+	return storage.RedisClient
+}
+
+// Lock is a held lock, renewed in the background until unlocked
+type Lock struct {
+	locker *Locker
+	key    string
+	token  string
+	// lost is closed when the lock could not be renewed and may be held by another instance
+	lost chan struct{}
+	stop chan struct{}
+	once sync.Once
+}
+
+// Acquire takes the named lock, failing with ErrNotAcquired if another instance holds it. The
+// lock is renewed every third of its TTL until Unlock, so it outlives slow work but expires soon
+// after the instance holding it stops
+func (l *Locker) Acquire(ctx context.Context, name string) (*Lock, error) {
+	held := &Lock{
+		locker: l,
+		key:    keyPrefix + name,
+		lost:   make(chan struct{}),
+		stop:   make(chan struct{}),
+	}
+	if !l.conf.Enabled {
+		return held, nil
+	}
+
+	nonce := make([]byte, 8)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate lock token: %w", err)
+	}
+	held.token = l.owner + ":" + hex.EncodeToString(nonce)
+
+	acquired, err := l.redis().SetNX(ctx, held.key, held.token, l.conf.TTL).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire lock %s: %w", name, err)
+	}
+	if !acquired {
+		return nil, ErrNotAcquired
+	}
+
+	go held.renew()
+	return held, nil
+}
+
+// renew extends the lock every third of its TTL until it is unlocked or lost
+func (h *Lock) renew() {
+	ttl := h.locker.conf.TTL
+	ticker := time.NewTicker(ttl / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-h.stop:
+			return
+		case <-ticker.C:
+			extended, err := extendScript.Run(context.Background(), h.locker.redis(), []string{h.key}, h.token, ttl.Milliseconds()).Int()
+			if err == nil && extended == 1 {
+				continue
+			}
+			logger.WithFields(logger.Fields{
+				"Error": fmt.Sprintf("%v", err),
+				"Lock":  h.key,
+			}).Warnf("Lost distributed lock")
+			close(h.lost)
+			return
+		}
+	}
+}
+
+// Lost is closed when the lock could not be renewed, after which another instance may take it
+func (h *Lock) Lost() <-chan struct{} {
+	return h.lost
+}
+
+// Unlock releases the lock if this instance still holds it
+func (h *Lock) Unlock(ctx context.Context) error {
+	var err error
+	h.once.Do(func() {
+		close(h.stop)
+		if !h.locker.conf.Enabled {
+			return
+		}
+		err = releaseScript.Run(ctx, h.locker.redis(), []string{h.key}, h.token).Err()
+	})
+	if err != nil {
+		return fmt.Errorf("failed to release lock %s: %w", h.key, err)
+	}
+	return nil
+}
+
+// Run runs fn while holding the named lock and reports whether it ran. It does not run fn while
+// another instance holds the lock. The ctx passed to fn is cancelled if the lock is lost
+func (l *Locker) Run(ctx context.Context, name string, fn func(ctx context.Context) error) (bool, error) {
+	held, err := l.Acquire(ctx, name)
+	if errors.Is(err, ErrNotAcquired) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if err := held.Unlock(context.WithoutCancel(ctx)); err != nil {
+			logger.WithFields(logger.Fields{
+				"Error": fmt.Sprintf("%v", err),
+				"Lock":  name,
+			}).Warnf("Failed to release distributed lock")
+		}
+	}()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-held.Lost():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	return true, fn(ctx)
+}
+
+// Lead runs fn on one instance at a time, electing this instance leader once the named lock is
+// free. Instances that are not leader retry every half TTL. If leadership is lost, the ctx passed
+// to fn is cancelled and leadership is sought again. Lead returns when ctx is done or fn returns
+// while this instance is still leader
+func (l *Locker) Lead(ctx context.Context, name string, fn func(ctx context.Context)) {
+	retry := l.conf.TTL / 2
+	for ctx.Err() == nil {
+		lost := false
+		ran, err := l.Run(ctx, name, func(leaderCtx context.Context) error {
+			logger.WithFields(logger.Fields{
+				"Lock": name,
+			}).Infof("Elected leader")
+			fn(leaderCtx)
+			lost = leaderCtx.Err() != nil && ctx.Err() == nil
+			return nil
+		})
+		if err != nil {
+			logger.WithFields(logger.Fields{
+				"Error": fmt.Sprintf("%v", err),
+				"Lock":  name,
+			}).Errorf("Failed to seek leadership")
+		}
+		if ran && !lost {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(retry):
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package lock
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/NEDA-LABS/stablenode/config"
+)
+
+func TestLocker(t *testing.T) {
+	mr, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer mr.Close()
+
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer client.Close()
+
+	conf := &config.DistributedLockConfiguration{Enabled: true, TTL: 300 * time.Millisecond}
+	ctx := context.Background()
+
+	// Two lockers stand in for two aggregator instances
+	first := New(conf, client)
+	second := New(conf, client)
+
+	t.Run("grants a lock to one instance at a time", func(t *testing.T) {
+		held, err := first.Acquire(ctx, "cron:ExpireOrders")
+		assert.NoError(t, err)
+
+		_, err = second.Acquire(ctx, "cron:ExpireOrders")
+		assert.ErrorIs(t, err, ErrNotAcquired)
+
+		// Renewal keeps the lock past its TTL
+		time.Sleep(2 * conf.TTL)
+		_, err = second.Acquire(ctx, "cron:ExpireOrders")
+		assert.ErrorIs(t, err, ErrNotAcquired)
+
+		assert.NoError(t, held.Unlock(ctx))
+		held, err = second.Acquire(ctx, "cron:ExpireOrders")
+		assert.NoError(t, err)
+		assert.NoError(t, held.Unlock(ctx))
+	})
+
+	t.Run("never releases a lock taken over by another instance", func(t *testing.T) {
+		stale, err := first.Acquire(ctx, "polling")
+		assert.NoError(t, err)
+
+		// The first instance stalls and its lock expires
+		mr.Del(keyPrefix + "polling")
+		current, err := second.Acquire(ctx, "polling")
+		assert.NoError(t, err)
+
+		select {
+		case <-stale.Lost():
+		case <-time.After(conf.TTL):
+			t.Fatal("stale lock was not reported lost")
+		}
+		assert.NoError(t, stale.Unlock(ctx))
+		assert.True(t, mr.Exists(keyPrefix+"polling"))
+
+		assert.NoError(t, current.Unlock(ctx))
+		assert.False(t, mr.Exists(keyPrefix+"polling"))
+	})
+
+	t.Run("runs work only where the lock is free", func(t *testing.T) {
+		release := make(chan struct{})
+		started := make(chan struct{})
+		go func() {
+			_, _ = first.Run(ctx, "transaction-outbox", func(ctx context.Context) error {
+				close(started)
+				<-release
+				return nil
+			})
+		}()
+		<-started
+
+		ran, err := second.Run(ctx, "transaction-outbox", func(ctx context.Context) error { return nil })
+		assert.NoError(t, err)
+		assert.False(t, ran)
+
+		close(release)
+		assert.Eventually(t, func() bool {
+			ran, err = second.Run(ctx, "transaction-outbox", func(ctx context.Context) error { return nil })
+			return err == nil && ran
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("cancels work once the lock is lost", func(t *testing.T) {
+		ran, err := first.Run(ctx, "cron:IndexGatewayEvents", func(ctx context.Context) error {
+			mr.Set(keyPrefix+"cron:IndexGatewayEvents", "another-instance")
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(2 * conf.TTL):
+				return nil
+			}
+		})
+		assert.True(t, ran)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("fails leadership over when the leader stops", func(t *testing.T) {
+		leaderCtx, stopLeader := context.WithCancel(ctx)
+		elected := make(chan string, 2)
+		lead := func(locker *Locker, ctx context.Context, name string) {
+			locker.Lead(ctx, "websocket-indexer", func(ctx context.Context) {
+				elected <- name
+				<-ctx.Done()
+			})
+		}
+
+		go lead(first, leaderCtx, "first")
+		assert.Equal(t, "first", <-elected)
+
+		followerCtx, stopFollower := context.WithCancel(ctx)
+		defer stopFollower()
+		go lead(second, followerCtx, "second")
+
+		select {
+		case name := <-elected:
+			t.Fatalf("%s elected while the first instance leads", name)
+		case <-time.After(conf.TTL):
+		}
+
+		stopLeader()
+		select {
+		case name := <-elected:
+			assert.Equal(t, "second", name)
+		case <-time.After(2 * conf.TTL):
+			t.Fatal("no leader elected after the leader stopped")
+		}
+	})
+
+	t.Run("grants every lock while disabled", func(t *testing.T) {
+		disabled := New(&config.DistributedLockConfiguration{TTL: conf.TTL}, nil)
+		held, err := disabled.Acquire(ctx, "cron:ExpireOrders")
+		assert.NoError(t, err)
+		_, err = disabled.Acquire(ctx, "cron:ExpireOrders")
+		assert.NoError(t, err)
+		assert.NoError(t, held.Unlock(ctx))
+	})
+}
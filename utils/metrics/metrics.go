@@ -0,0 +1,188 @@
+package metrics
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const namespace = "aggregator"
+
+// Sources a payment can be detected through
+const (
+	SourceWebhook     = "webhook"
+	SourcePolling     = "polling"
+	SourceWebsocket   = "websocket"
+	SourceIndexer     = "indexer"
+	SourceInternalAPI = "internal_api"
+)
+
+var (
+	// OrdersCreated counts payment orders initiated by senders
+	OrdersCreated = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "orders_created_total",
+		Help:      "Payment orders initiated by senders.",
+	}, []string{"network", "token"})
+
+	// OrdersExpired counts payment orders expired unpaid
+	OrdersExpired = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "orders_expired_total",
+		Help:      "Payment orders expired without being paid.",
+	}, []string{"network"})
+
+	// PaymentsDetected counts deposits to receive addresses handed to order processing, by how they were detected
+	PaymentsDetected = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "payments_detected_total",
+		Help:      "Deposits to receive addresses detected, by detection source.",
+	}, []string{"source", "network"})
+
+	// UserOperations counts UserOperations sent to bundlers, by whether they were submitted or failed,
+	// and replacements of stale ones as resubmitted
+	UserOperations = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "user_operations_total",
+		Help:      "UserOperations sent to bundlers, by result.",
+	}, []string{"chain_id", "result"})
+
+	// UserOperationFailures counts UserOperations rejected by bundlers or refused sponsorship by
+	// paymasters, by the stage that failed and the cause parsed from the error
+	UserOperationFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "user_operation_failures_total",
+		Help:      "UserOperations rejected by bundlers or paymasters, by stage and cause.",
+	}, []string{"chain_id", "stage", "cause"})
+
+	// RPCRequestDuration observes the latency of outbound RPC calls per provider, excluding time
+	// spent waiting for the provider's rate limit
+	RPCRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "rpc_request_duration_seconds",
+		Help:      "Latency of outbound RPC calls per provider.",
+		Buckets:   []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+	}, []string{"provider", "outcome"})
+
+	// RPCShedding is 1 while polling and backfill calls to a provider are shed to keep its budget
+	// for webhook verification and settlements
+	RPCShedding = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "rpc_shedding",
+		Help:      "Whether polling and backfill calls to a provider are shed.",
+	}, []string{"provider"})
+
+	// EventWorkersBusy is how many workers of a network's event pool are processing an event
+	EventWorkersBusy = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "event_workers_busy",
+		Help:      "Busy workers of each network's event processing pool.",
+	}, []string{"network"})
+
+	// EventWorkerPanics counts events whose processing panicked, recovered by the worker pool
+	EventWorkerPanics = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "event_worker_panics_total",
+		Help:      "Panics recovered while processing events, per network.",
+	}, []string{"network"})
+
+	// WebhookDuration observes how long inbound webhook deliveries take to process
+	WebhookDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "webhook_processing_duration_seconds",
+		Help:      "Processing time of inbound webhook deliveries.",
+		Buckets:   []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+	}, []string{"webhook", "status"})
+)
+
+// PaymentDetected counts a deposit detected on a network through the given source
+func PaymentDetected(source, network string) {
+	PaymentsDetected.WithLabelValues(source, network).Inc()
+}
+
+// UserOperationSent counts a UserOperation sent on a chain, as failed when err is set
+func UserOperationSent(chainID int64, err error) {
+	result := "submitted"
+	if err != nil {
+		result = "failed"
+	}
+	UserOperations.WithLabelValues(strconv.FormatInt(chainID, 10), result).Inc()
+}
+
+// UserOperationResubmitted counts a stale UserOperation replaced with higher fees on a chain
+func UserOperationResubmitted(chainID int64) {
+	UserOperations.WithLabelValues(strconv.FormatInt(chainID, 10), "resubmitted").Inc()
+}
+
+// UserOperationFailed counts a UserOperation rejected on a chain at stage ("paymaster", "simulation" or "send")
+// because of cause
+func UserOperationFailed(chainID int64, stage, cause string) {
+	UserOperationFailures.WithLabelValues(strconv.FormatInt(chainID, 10), stage, cause).Inc()
+}
+
+// ObserveRPCRequest records the latency of an RPC call to a provider that started at start
+func ObserveRPCRequest(provider string, start time.Time, err error) {
+	outcome := "success"
+	if err != nil {
+		outcome = "error"
+	}
+	RPCRequestDuration.WithLabelValues(provider, outcome).Observe(time.Since(start).Seconds())
+}
+
+// PoolInventoryFunc returns the number of receive addresses per network and pool status
+type PoolInventoryFunc func(ctx context.Context) (map[string]map[string]int, error)
+
+// poolCollector reports the receive address pool inventory, read from the database when scraped
+type poolCollector struct {
+	inventory PoolInventoryFunc
+	desc      *prometheus.Desc
+	errors    prometheus.Counter
+}
+
+// RegisterPoolInventory reports the pool inventory returned by inventory on every scrape
+func RegisterPoolInventory(inventory PoolInventoryFunc) {
+	prometheus.MustRegister(newPoolCollector(inventory))
+}
+
+func newPoolCollector(inventory PoolInventoryFunc) *poolCollector {
+	return &poolCollector{
+		inventory: inventory,
+		desc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "pool", "addresses"),
+			"Receive addresses in the pool per network and status.",
+			[]string{"network", "status"}, nil,
+		),
+		errors: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "pool",
+			Name:      "inventory_errors_total",
+			Help:      "Scrapes that failed to read the pool inventory.",
+		}),
+	}
+}
+
+// Describe implements prometheus.Collector
+func (c *poolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+	c.errors.Describe(ch)
+}
+
+// Collect implements prometheus.Collector
+func (c *poolCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	inventory, err := c.inventory(ctx)
+	if err != nil {
+		c.errors.Inc()
+	}
+	for network, statuses := range inventory {
+		for status, count := range statuses {
+			ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, float64(count), network, status)
+		}
+	}
+	c.errors.Collect(ch)
+}
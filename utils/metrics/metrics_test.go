@@ -0,0 +1,76 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetrics(t *testing.T) {
+	t.Run("counts detected payments by source", func(t *testing.T) {
+		PaymentDetected(SourceWebhook, "base")
+		PaymentDetected(SourceWebhook, "base")
+		PaymentDetected(SourcePolling, "base")
+
+		assert.Equal(t, float64(2), testutil.ToFloat64(PaymentsDetected.WithLabelValues(SourceWebhook, "base")))
+		assert.Equal(t, float64(1), testutil.ToFloat64(PaymentsDetected.WithLabelValues(SourcePolling, "base")))
+	})
+
+	t.Run("counts user operations by result", func(t *testing.T) {
+		UserOperationSent(8453, nil)
+		UserOperationSent(8453, errors.New("AA21 didn't pay prefund"))
+
+		assert.Equal(t, float64(1), testutil.ToFloat64(UserOperations.WithLabelValues("8453", "submitted")))
+		assert.Equal(t, float64(1), testutil.ToFloat64(UserOperations.WithLabelValues("8453", "failed")))
+	})
+
+	t.Run("counts user operation failures by stage and cause", func(t *testing.T) {
+		UserOperationFailed(8453, "paymaster", "paymaster_rejected")
+		UserOperationFailed(8453, "send", "insufficient_prefund")
+		UserOperationFailed(8453, "send", "insufficient_prefund")
+
+		assert.Equal(t, float64(1), testutil.ToFloat64(UserOperationFailures.WithLabelValues("8453", "paymaster", "paymaster_rejected")))
+		assert.Equal(t, float64(2), testutil.ToFloat64(UserOperationFailures.WithLabelValues("8453", "send", "insufficient_prefund")))
+	})
+
+	t.Run("observes rpc latency per provider", func(t *testing.T) {
+		ObserveRPCRequest("alchemy", time.Now().Add(-200*time.Millisecond), nil)
+		ObserveRPCRequest("infura", time.Now(), errors.New("connection reset"))
+
+		assert.Equal(t, 2, testutil.CollectAndCount(RPCRequestDuration))
+	})
+
+	t.Run("reports the pool inventory when scraped", func(t *testing.T) {
+		fail := false
+		collector := newPoolCollector(func(ctx context.Context) (map[string]map[string]int, error) {
+			if fail {
+				return nil, errors.New("connection refused")
+			}
+			return map[string]map[string]int{
+				"base":    {"pool_ready": 12, "pool_assigned": 3},
+				"polygon": {"pool_ready": 0},
+			}, nil
+		})
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(collector)
+
+		expected := `
+			# HELP aggregator_pool_addresses Receive addresses in the pool per network and status.
+			# TYPE aggregator_pool_addresses gauge
+			aggregator_pool_addresses{network="base",status="pool_assigned"} 3
+			aggregator_pool_addresses{network="base",status="pool_ready"} 12
+			aggregator_pool_addresses{network="polygon",status="pool_ready"} 0
+		`
+		assert.NoError(t, testutil.GatherAndCompare(registry, strings.NewReader(expected), "aggregator_pool_addresses"))
+
+		fail = true
+		assert.Equal(t, 1, testutil.CollectAndCount(collector, "aggregator_pool_addresses", "aggregator_pool_inventory_errors_total"))
+		assert.Equal(t, float64(1), testutil.ToFloat64(collector.errors))
+	})
+}
@@ -0,0 +1,94 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	orderEventStreamsClosing   = make(chan struct{})
+	closeOrderEventStreamsOnce sync.Once
+)
+
+// orderEventsChannel is the Redis pub/sub channel of the status changes of a payment order
+func orderEventsChannel(orderID uuid.UUID) string {
+	return fmt.Sprintf("order_events_%s", orderID)
+}
+
+// NewOrderStatusEvent builds the status event of a payment order
+func NewOrderStatusEvent(paymentOrder *ent.PaymentOrder) types.OrderStatusEvent {
+	return types.OrderStatusEvent{
+		OrderID:        paymentOrder.ID,
+		Status:         paymentOrder.Status,
+		AmountPaid:     paymentOrder.AmountPaid,
+		PercentSettled: paymentOrder.PercentSettled,
+		TxHash:         paymentOrder.TxHash,
+		UpdatedAt:      paymentOrder.UpdatedAt,
+	}
+}
+
+// PublishOrderStatus publishes the current status of a payment order to the streams following it.
+// Publishing is best effort, so failures are logged rather than failing the status change
+func PublishOrderStatus(ctx context.Context, paymentOrder *ent.PaymentOrder) {
+	if storage.RedisClient == nil {
+		return
+	}
+
+	data, err := json.Marshal(NewOrderStatusEvent(paymentOrder))
+	if err != nil {
+		logger.Errorf("PublishOrderStatus.marshal: %v", err)
+		return
+	}
+
+	if err := storage.RedisClient.Publish(ctx, orderEventsChannel(paymentOrder.ID), data).Err(); err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":   fmt.Sprintf("%v", err),
+			"OrderID": paymentOrder.ID.String(),
+		}).Errorf("PublishOrderStatus.redis")
+	}
+}
+
+// SubscribeOrderEvents subscribes to the status changes of a payment order. The subscription is
+// active once this returns, so changes published afterwards are not missed
+func SubscribeOrderEvents(ctx context.Context, orderID uuid.UUID) (*redis.PubSub, error) {
+	pubsub := storage.RedisClient.Subscribe(ctx, orderEventsChannel(orderID))
+	if _, err := pubsub.Receive(ctx); err != nil {
+		_ = pubsub.Close()
+		return nil, fmt.Errorf("SubscribeOrderEvents.redis: %w", err)
+	}
+	return pubsub, nil
+}
+
+// IsFinalOrderEvent reports whether a payment order can no longer change status after an event.
+// Expired orders holding a partial payment are still refunded
+func IsFinalOrderEvent(event types.OrderStatusEvent) bool {
+	switch event.Status {
+	case paymentorder.StatusSettled, paymentorder.StatusRefunded:
+		return true
+	case paymentorder.StatusExpired:
+		return event.AmountPaid.IsZero()
+	}
+	return false
+}
+
+// CloseOrderEventStreams ends the open order event streams, so they don't hold up a server shutdown
+func CloseOrderEventStreams() {
+	closeOrderEventStreamsOnce.Do(func() {
+		close(orderEventStreamsClosing)
+	})
+}
+
+// OrderEventStreamsClosing is closed once the open order event streams must end
+func OrderEventStreamsClosing() <-chan struct{} {
+	return orderEventStreamsClosing
+}
@@ -0,0 +1,70 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/paymentorder"
+	"github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderEvents(t *testing.T) {
+	mr, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer mr.Close()
+	storage.RedisClient = redis.NewClient(&redis.Options{Addr: mr.Addr()})
+
+	ctx := context.Background()
+
+	t.Run("delivers status changes to subscribers of the order", func(t *testing.T) {
+		order := &ent.PaymentOrder{
+			ID:         uuid.New(),
+			Status:     paymentorder.StatusPending,
+			AmountPaid: decimal.NewFromInt(100),
+			TxHash:     "0xabc",
+			UpdatedAt:  time.Now().UTC(),
+		}
+		other := &ent.PaymentOrder{ID: uuid.New(), Status: paymentorder.StatusExpired}
+
+		pubsub, err := SubscribeOrderEvents(ctx, order.ID)
+		assert.NoError(t, err)
+		defer pubsub.Close()
+
+		PublishOrderStatus(ctx, other)
+		PublishOrderStatus(ctx, order)
+
+		select {
+		case message := <-pubsub.Channel():
+			var event types.OrderStatusEvent
+			assert.NoError(t, json.Unmarshal([]byte(message.Payload), &event))
+			assert.Equal(t, order.ID, event.OrderID)
+			assert.Equal(t, paymentorder.StatusPending, event.Status)
+			assert.True(t, event.AmountPaid.Equal(order.AmountPaid))
+			assert.Equal(t, "0xabc", event.TxHash)
+		case <-time.After(time.Second):
+			t.Fatal("no order event received")
+		}
+	})
+
+	t.Run("final events", func(t *testing.T) {
+		assert.True(t, IsFinalOrderEvent(types.OrderStatusEvent{Status: paymentorder.StatusSettled}))
+		assert.True(t, IsFinalOrderEvent(types.OrderStatusEvent{Status: paymentorder.StatusRefunded}))
+		assert.True(t, IsFinalOrderEvent(types.OrderStatusEvent{Status: paymentorder.StatusExpired}))
+		assert.False(t, IsFinalOrderEvent(types.OrderStatusEvent{Status: paymentorder.StatusValidated}))
+
+		// Partial payments of expired orders are still refunded
+		assert.False(t, IsFinalOrderEvent(types.OrderStatusEvent{
+			Status:     paymentorder.StatusExpired,
+			AmountPaid: decimal.NewFromInt(5),
+		}))
+	})
+}
@@ -0,0 +1,199 @@
+package utils
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Sort fields of order listings
+const (
+	SortByCreatedAt = "created_at"
+	SortByUpdatedAt = "updated_at"
+	SortByAmount    = "amount"
+)
+
+// MaxPageSize is the largest page an order listing returns
+const MaxPageSize = 100
+
+// Cursor is the position of the last item of a page in a listing sorted by a field, with ties
+// broken by ID. Clients pass it back opaquely to fetch the next page
+type Cursor struct {
+	Value string    `json:"v"`
+	ID    uuid.UUID `json:"id"`
+}
+
+// EncodeCursor encodes the cursor of an item from its sort value and ID
+func EncodeCursor(value interface{}, id uuid.UUID) string {
+	cursor := Cursor{ID: id}
+	switch v := value.(type) {
+	case time.Time:
+		cursor.Value = v.UTC().Format(time.RFC3339Nano)
+	case decimal.Decimal:
+		cursor.Value = v.String()
+	default:
+		cursor.Value = fmt.Sprintf("%v", v)
+	}
+
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor decodes a cursor passed by a client
+func DecodeCursor(cursor string) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+
+	var decoded Cursor
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded.ID == uuid.Nil {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+	return &decoded, nil
+}
+
+// OrderListQuery is the pagination, filtering and sorting of an order listing, from its query params:
+//   - cursor, or page for offset pagination, and pageSize of at most MaxPageSize
+//   - sortBy, one of created_at (the default), updated_at and amount, and ordering, asc or desc
+//   - status, a comma separated list of statuses
+//   - token, network and reference
+//   - from and to, RFC 3339 times or dates, bounding the creation time
+type OrderListQuery struct {
+	Page       int
+	Offset     int
+	PageSize   int
+	Cursor     *Cursor
+	SortBy     string
+	Descending bool
+	Statuses   []string
+	Token      string
+	Network    string
+	Reference  string
+	From       *time.Time
+	To         *time.Time
+
+	// cursorValue is the sort value of the cursor
+	cursorValue interface{}
+}
+
+// ParseOrderListQuery parses the query params of an order listing
+func ParseOrderListQuery(ctx *gin.Context) (*OrderListQuery, error) {
+	page, offset, pageSize := Paginate(ctx)
+	if pageSize > MaxPageSize {
+		pageSize = MaxPageSize
+		offset = (page - 1) * pageSize
+	}
+
+	query := &OrderListQuery{
+		Page:       page,
+		Offset:     offset,
+		PageSize:   pageSize,
+		SortBy:     SortByCreatedAt,
+		Descending: ctx.Query("ordering") != "asc",
+		Token:      ctx.Query("token"),
+		Network:    ctx.Query("network"),
+		Reference:  ctx.Query("reference"),
+	}
+
+	if sortBy := ctx.Query("sortBy"); sortBy != "" {
+		switch sortBy {
+		case SortByCreatedAt, SortByUpdatedAt, SortByAmount:
+			query.SortBy = sortBy
+		default:
+			return nil, fmt.Errorf("sortBy must be one of %s, %s and %s", SortByCreatedAt, SortByUpdatedAt, SortByAmount)
+		}
+	}
+
+	if cursor := ctx.Query("cursor"); cursor != "" {
+		decoded, err := DecodeCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		// The cursor must come from a listing sorted by the same field
+		switch query.SortBy {
+		case SortByCreatedAt, SortByUpdatedAt:
+			query.cursorValue, err = time.Parse(time.RFC3339Nano, decoded.Value)
+		case SortByAmount:
+			// Token amounts carry more digits than a float holds, so they are compared exactly
+			query.cursorValue, err = decimal.NewFromString(decoded.Value)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid cursor")
+		}
+		query.Cursor = decoded
+	}
+
+	if statuses := ctx.Query("status"); statuses != "" {
+		for _, status := range strings.Split(statuses, ",") {
+			if status = strings.TrimSpace(status); status != "" {
+				query.Statuses = append(query.Statuses, status)
+			}
+		}
+	}
+
+	for param, bound := range map[string]**time.Time{"from": &query.From, "to": &query.To} {
+		value := ctx.Query(param)
+		if value == "" {
+			continue
+		}
+		parsed, err := ParseTimeBound(value, param == "to")
+		if err != nil {
+			return nil, fmt.Errorf("%s must be an RFC 3339 time or a date", param)
+		}
+		*bound = &parsed
+	}
+
+	return query, nil
+}
+
+// ParseTimeBound parses an RFC 3339 time or a date. A date given as an upper bound includes the
+// whole day
+func ParseTimeBound(value string, upper bool) (time.Time, error) {
+	if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+		return parsed, nil
+	}
+
+	parsed, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if upper {
+		parsed = parsed.AddDate(0, 0, 1)
+	}
+	return parsed, nil
+}
+
+// Order sorts a listing by the sort field, with ties broken by ID
+func (q *OrderListQuery) Order() func(*sql.Selector) {
+	if q.Descending {
+		return func(s *sql.Selector) {
+			s.OrderBy(sql.Desc(s.C(q.SortBy)), sql.Desc(s.C("id")))
+		}
+	}
+	return func(s *sql.Selector) {
+		s.OrderBy(sql.Asc(s.C(q.SortBy)), sql.Asc(s.C("id")))
+	}
+}
+
+// AfterCursor selects the items of a listing after the cursor
+func (q *OrderListQuery) AfterCursor() func(*sql.Selector) {
+	compare := sql.GT
+	if q.Descending {
+		compare = sql.LT
+	}
+	return func(s *sql.Selector) {
+		s.Where(sql.Or(
+			compare(s.C(q.SortBy), q.cursorValue),
+			sql.And(sql.EQ(s.C(q.SortBy), q.cursorValue), compare(s.C("id"), q.Cursor.ID)),
+		))
+	}
+}
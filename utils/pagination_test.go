@@ -0,0 +1,97 @@
+package utils
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderListQuery(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	parse := func(query string) (*OrderListQuery, error) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request, _ = http.NewRequest("GET", "/orders?"+query, nil)
+		return ParseOrderListQuery(ctx)
+	}
+
+	t.Run("cursors round trip", func(t *testing.T) {
+		id := uuid.New()
+		createdAt := time.Date(2024, 5, 1, 10, 30, 0, 123456789, time.UTC)
+
+		cursor, err := DecodeCursor(EncodeCursor(createdAt, id))
+		assert.NoError(t, err)
+		assert.Equal(t, id, cursor.ID)
+		assert.Equal(t, "2024-05-01T10:30:00.123456789Z", cursor.Value)
+
+		cursor, err = DecodeCursor(EncodeCursor(decimal.NewFromFloat(12.5), id))
+		assert.NoError(t, err)
+		assert.Equal(t, "12.5", cursor.Value)
+
+		_, err = DecodeCursor("not-a-cursor")
+		assert.Error(t, err)
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		query, err := parse("")
+		assert.NoError(t, err)
+		assert.Equal(t, SortByCreatedAt, query.SortBy)
+		assert.True(t, query.Descending)
+		assert.Nil(t, query.Cursor)
+		assert.Nil(t, query.From)
+		assert.Nil(t, query.To)
+	})
+
+	t.Run("caps the page size", func(t *testing.T) {
+		query, err := parse("page=3&pageSize=500")
+		assert.NoError(t, err)
+		assert.Equal(t, MaxPageSize, query.PageSize)
+		assert.Equal(t, 2*MaxPageSize, query.Offset)
+	})
+
+	t.Run("parses filters", func(t *testing.T) {
+		query, err := parse("status=pending, validated&token=USDC&network=base&reference=ref-1&from=2024-05-01&to=2024-05-31&ordering=asc&sortBy=amount")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"pending", "validated"}, query.Statuses)
+		assert.Equal(t, "USDC", query.Token)
+		assert.Equal(t, "base", query.Network)
+		assert.Equal(t, "ref-1", query.Reference)
+		assert.Equal(t, SortByAmount, query.SortBy)
+		assert.False(t, query.Descending)
+		assert.Equal(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), *query.From)
+		assert.Equal(t, time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), *query.To)
+	})
+
+	t.Run("parses cursors of the sort field", func(t *testing.T) {
+		id := uuid.New()
+		query, err := parse("cursor=" + EncodeCursor(time.Now(), id))
+		assert.NoError(t, err)
+		assert.Equal(t, id, query.Cursor.ID)
+
+		_, err = parse("sortBy=amount&cursor=" + EncodeCursor(time.Now(), id))
+		assert.Error(t, err)
+
+		// Amounts beyond the precision of a float are kept exact
+		amount := decimal.RequireFromString("123456789012345678.123456789012345678")
+		query, err = parse("sortBy=amount&cursor=" + EncodeCursor(amount, id))
+		assert.NoError(t, err)
+		assert.True(t, amount.Equal(query.cursorValue.(decimal.Decimal)))
+	})
+
+	t.Run("rejects invalid params", func(t *testing.T) {
+		_, err := parse("sortBy=reference")
+		assert.Error(t, err)
+
+		_, err = parse("from=yesterday")
+		assert.Error(t, err)
+
+		_, err = parse("cursor=abc")
+		assert.Error(t, err)
+	})
+}
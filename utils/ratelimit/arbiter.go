@@ -0,0 +1,123 @@
+package ratelimit
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/NEDA-LABS/stablenode/utils/metrics"
+)
+
+// demandWindow is the time constant of the moving average of the calls made to a provider
+const demandWindow = 10 * time.Second
+
+// ErrShedding is returned for polling and backfill calls to a provider whose remaining budget is
+// kept for webhook verification and settlements
+var ErrShedding = errors.New("rpc budget reserved for webhooks and settlements")
+
+// shed reports whether polling and backfill calls to a provider are shed. A provider starts
+// shedding once its demand reaches the high watermark of its limit or it answers 429, and stops
+// once demand falls below the low watermark and the 429 cooldown is over
+func (l *Limiter) shed(provider string, bucket *tokenBucket) bool {
+	if l.config.ShedHighWatermark <= 0 {
+		return false
+	}
+
+	utilization, throttled := bucket.pressure(l.now())
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	wasShedding := l.shedding[provider]
+	shedding := throttled ||
+		utilization >= l.config.ShedHighWatermark ||
+		(wasShedding && utilization >= l.config.ShedLowWatermark)
+	if shedding == wasShedding {
+		return shedding
+	}
+
+	l.shedding[provider] = shedding
+	fields := logger.Fields{
+		"Provider":    provider,
+		"Utilization": math.Round(utilization*100) / 100,
+		"Throttled":   throttled,
+	}
+	if shedding {
+		metrics.RPCShedding.WithLabelValues(provider).Set(1)
+		logger.WithFields(fields).Warnf("RPC budget running low, shedding polling and backfill calls")
+	} else {
+		metrics.RPCShedding.WithLabelValues(provider).Set(0)
+		logger.WithFields(fields).Infof("RPC budget recovered, resuming polling and backfill calls")
+	}
+	return shedding
+}
+
+// Shedding reports whether any provider is shedding polling and backfill calls. Pollers and
+// backfills skip their runs while it does, resuming once every provider has headroom again
+func (l *Limiter) Shedding() bool {
+	l.mutex.Lock()
+	buckets := make(map[string]*tokenBucket, len(l.buckets))
+	for provider, bucket := range l.buckets {
+		if bucket != nil {
+			buckets[provider] = bucket
+		}
+	}
+	l.mutex.Unlock()
+
+	shedding := false
+	for provider, bucket := range buckets {
+		// Every provider is evaluated so each records its recovery
+		if l.shed(provider, bucket) {
+			shedding = true
+		}
+	}
+	return shedding
+}
+
+// ReportThrottled records that the provider of the endpoint answered 429 Too Many Requests, which
+// means its budget ran out regardless of our own accounting
+func (l *Limiter) ReportThrottled(endpoint string) {
+	bucket := l.bucket(Provider(endpoint))
+	if bucket == nil {
+		return
+	}
+	bucket.throttle(l.now().Add(l.config.ShedCooldown))
+}
+
+// recordDemand adds a call to the moving average of the calls made to the bucket's provider
+func (b *tokenBucket) recordDemand(now time.Time) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.demand = b.decayedDemand(now) + 1/demandWindow.Seconds()
+	b.demandAt = now
+}
+
+// decayedDemand returns the moving average of calls per second at now
+func (b *tokenBucket) decayedDemand(now time.Time) float64 {
+	elapsed := now.Sub(b.demandAt)
+	if elapsed <= 0 {
+		return b.demand
+	}
+	return b.demand * math.Exp(-elapsed.Seconds()/demandWindow.Seconds())
+}
+
+// throttle marks the bucket's provider as out of budget until the given time
+func (b *tokenBucket) throttle(until time.Time) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if until.After(b.throttledUntil) {
+		b.throttledUntil = until
+	}
+}
+
+// pressure returns the demand on the bucket's provider as a share of its limit, and whether the
+// provider recently answered 429
+func (b *tokenBucket) pressure(now time.Time) (float64, bool) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	return b.decayedDemand(now) / b.rate, now.Before(b.throttledUntil)
+}
@@ -0,0 +1,392 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/utils/metrics"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Priority is the class of an outbound RPC call. When a provider is throttled, queued calls are
+// released in priority order, so background work never delays order flows
+type Priority int
+
+const (
+	// PriorityWebhookVerification is for calls made while answering a deposit webhook delivery
+	PriorityWebhookVerification Priority = iota
+	// PrioritySettlement is for calls creating, settling or refunding orders. Untagged calls get it
+	PrioritySettlement
+	// PriorityPolling is for periodic indexing and fallback polling
+	PriorityPolling
+	// PriorityBackfill is for reindexing and recovery of missed events
+	PriorityBackfill
+
+	priorityCount
+)
+
+// String returns the name of the priority class used in stats
+func (p Priority) String() string {
+	switch p {
+	case PriorityWebhookVerification:
+		return "webhook_verification"
+	case PrioritySettlement:
+		return "settlement"
+	case PriorityPolling:
+		return "polling"
+	case PriorityBackfill:
+		return "backfill"
+	default:
+		return "unknown"
+	}
+}
+
+// priorityKey is the context key of the priority of outbound calls
+type priorityKey struct{}
+
+// WithPriority sets the priority of the outbound RPC calls made with ctx
+func WithPriority(ctx context.Context, priority Priority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, priority)
+}
+
+// PriorityFrom returns the priority of the outbound RPC calls made with ctx
+func PriorityFrom(ctx context.Context) Priority {
+	if priority, ok := ctx.Value(priorityKey{}).(Priority); ok && priority >= 0 && priority < priorityCount {
+		return priority
+	}
+	return PrioritySettlement
+}
+
+// Provider returns the provider an endpoint belongs to, which is what limits are applied per.
+// Self-hosted and other endpoints are limited per host
+func Provider(endpoint string) string {
+	parsed, err := url.Parse(endpoint)
+	if err != nil || parsed.Host == "" {
+		return "unknown"
+	}
+
+	host := strings.ToLower(parsed.Hostname())
+	switch {
+	case strings.HasSuffix(host, "alchemy.com"):
+		return "alchemy"
+	case strings.HasSuffix(host, "infura.io"):
+		return "infura"
+	case strings.HasSuffix(host, "quiknode.pro"):
+		return "quicknode"
+	default:
+		return host
+	}
+}
+
+// ProviderStats are the rate limiting metrics of a provider
+type ProviderStats struct {
+	// Rate is the configured limit in requests per second, 0 when unlimited
+	Rate float64 `json:"rate"`
+	// Allowed counts calls let through, Throttled those among them that had to queue first
+	Allowed   int64 `json:"allowed"`
+	Throttled int64 `json:"throttled"`
+	// Dropped counts calls whose context ended while queued
+	Dropped int64 `json:"dropped"`
+	// Queued is the number of calls currently waiting, by priority class
+	Queued map[string]int `json:"queued"`
+	// Utilization is the recent demand as a share of the limit
+	Utilization float64 `json:"utilization"`
+	// Shedding is whether polling and backfill calls are currently shed
+	Shedding bool `json:"shedding"`
+}
+
+// Limiter applies a token bucket per provider to outbound RPC calls
+type Limiter struct {
+	config  *config.RPCRateLimitConfiguration
+	mutex   sync.Mutex
+	buckets map[string]*tokenBucket
+	// shedding records which providers are shedding polling and backfill calls
+	shedding map[string]bool
+	now      func() time.Time
+}
+
+var (
+	defaultLimiter     *Limiter
+	defaultLimiterOnce sync.Once
+)
+
+// New creates a new limiter
+func New(conf *config.RPCRateLimitConfiguration) *Limiter {
+	return &Limiter{
+		config:   conf,
+		buckets:  make(map[string]*tokenBucket),
+		shedding: make(map[string]bool),
+		now:      time.Now,
+	}
+}
+
+// Default returns the process-wide limiter, so all clients of a provider share its limit
+func Default() *Limiter {
+	defaultLimiterOnce.Do(func() {
+		defaultLimiter = New(config.RPCRateLimitConfig())
+	})
+	return defaultLimiter
+}
+
+// Wait blocks until a call to the endpoint may be made within its provider's limit, or ctx ends.
+// Polling and backfill calls fail with ErrShedding while their provider's budget runs low
+func (l *Limiter) Wait(ctx context.Context, endpoint string) error {
+	provider := Provider(endpoint)
+	bucket := l.bucket(provider)
+	if bucket == nil {
+		return nil
+	}
+
+	priority := PriorityFrom(ctx)
+	if priority >= PriorityPolling && l.shed(provider, bucket) {
+		return ErrShedding
+	}
+
+	bucket.recordDemand(l.now())
+	return bucket.wait(ctx, priority)
+}
+
+// bucket returns the token bucket of a provider, or nil when the provider is unlimited
+func (l *Limiter) bucket(provider string) *tokenBucket {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if bucket, ok := l.buckets[provider]; ok {
+		return bucket
+	}
+
+	rate := l.config.Default
+	if providerRate, ok := l.config.Providers[provider]; ok {
+		rate = providerRate
+	}
+	if rate <= 0 {
+		l.buckets[provider] = nil
+		return nil
+	}
+
+	bucket := newTokenBucket(rate, l.config.Burst)
+	l.buckets[provider] = bucket
+	return bucket
+}
+
+// Stats returns the metrics of every limited provider that has been called
+func (l *Limiter) Stats() map[string]ProviderStats {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := l.now()
+	stats := make(map[string]ProviderStats, len(l.buckets))
+	for provider, bucket := range l.buckets {
+		if bucket != nil {
+			providerStats := bucket.stats()
+			providerStats.Utilization, _ = bucket.pressure(now)
+			providerStats.Shedding = l.shedding[provider]
+			stats[provider] = providerStats
+		}
+	}
+	return stats
+}
+
+// Transport wraps an HTTP transport so requests wait for their provider's limit
+type Transport struct {
+	Base    http.RoundTripper
+	Limiter *Limiter
+}
+
+// NewTransport returns a transport limited by the process-wide limiter
+func NewTransport() *Transport {
+	return &Transport{Base: http.DefaultTransport, Limiter: Default()}
+}
+
+// RoundTrip waits for the request's provider limit before sending it, and records its latency
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.Limiter.Wait(req.Context(), req.URL.String()); err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	res, err := t.Base.RoundTrip(req)
+	metrics.ObserveRPCRequest(Provider(req.URL.String()), start, err)
+	if err == nil && res.StatusCode == http.StatusTooManyRequests {
+		t.Limiter.ReportThrottled(req.URL.String())
+	}
+	return res, err
+}
+
+// DialRPC connects to an RPC endpoint with HTTP calls limited by the process-wide limiter.
+// WebSocket endpoints keep a single connection and are not limited
+func DialRPC(ctx context.Context, endpoint string) (*rpc.Client, error) {
+	if !strings.HasPrefix(endpoint, "http") {
+		return rpc.DialContext(ctx, endpoint)
+	}
+	return rpc.DialOptions(ctx, endpoint, rpc.WithHTTPClient(&http.Client{Transport: NewTransport()}))
+}
+
+// DialEthClient is DialRPC for an Ethereum client
+func DialEthClient(ctx context.Context, endpoint string) (*ethclient.Client, error) {
+	client, err := DialRPC(ctx, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	return ethclient.NewClient(client), nil
+}
+
+// tokenBucket is a token bucket whose waiters are released in priority order, first in first out
+// within a priority class
+type tokenBucket struct {
+	mutex     sync.Mutex
+	rate      float64
+	burst     float64
+	tokens    float64
+	updatedAt time.Time
+	waiters   [priorityCount][]chan struct{}
+	timer     *time.Timer
+
+	allowed   int64
+	throttled int64
+	dropped   int64
+
+	// demand is the moving average of calls per second as of demandAt
+	demand         float64
+	demandAt       time.Time
+	throttledUntil time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucket{
+		rate:      rate,
+		burst:     float64(burst),
+		tokens:    float64(burst),
+		updatedAt: time.Now(),
+	}
+}
+
+// wait takes a token, queueing behind waiters of the same or higher priority when there is none
+func (b *tokenBucket) wait(ctx context.Context, priority Priority) error {
+	b.mutex.Lock()
+	b.refill()
+	if b.tokens >= 1 && b.queued() == 0 {
+		b.tokens--
+		b.allowed++
+		b.mutex.Unlock()
+		return nil
+	}
+
+	ready := make(chan struct{})
+	b.waiters[priority] = append(b.waiters[priority], ready)
+	b.throttled++
+	b.schedule()
+	b.mutex.Unlock()
+
+	select {
+	case <-ready:
+		return nil
+	case <-ctx.Done():
+		b.mutex.Lock()
+		defer b.mutex.Unlock()
+
+		if b.remove(priority, ready) {
+			b.throttled--
+			b.dropped++
+			return ctx.Err()
+		}
+
+		// Released at the same time the context ended; hand the token to the next waiter
+		b.allowed--
+		b.dropped++
+		b.throttled--
+		b.tokens++
+		b.release()
+		return ctx.Err()
+	}
+}
+
+// refill adds the tokens accrued since the last update, up to the burst size
+func (b *tokenBucket) refill() {
+	now := time.Now()
+	b.tokens += now.Sub(b.updatedAt).Seconds() * b.rate
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+	b.updatedAt = now
+}
+
+// queued returns the number of waiters across all priority classes
+func (b *tokenBucket) queued() int {
+	count := 0
+	for _, waiters := range b.waiters {
+		count += len(waiters)
+	}
+	return count
+}
+
+// schedule arms the timer releasing waiters once the next token accrues
+func (b *tokenBucket) schedule() {
+	if b.timer != nil || b.queued() == 0 {
+		return
+	}
+
+	delay := time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+	if delay < 0 {
+		delay = 0
+	}
+	b.timer = time.AfterFunc(delay, func() {
+		b.mutex.Lock()
+		defer b.mutex.Unlock()
+
+		b.timer = nil
+		b.refill()
+		b.release()
+	})
+}
+
+// release hands the available tokens to waiters in priority order and re-arms the timer
+func (b *tokenBucket) release() {
+	for priority := range b.waiters {
+		for b.tokens >= 1 && len(b.waiters[priority]) > 0 {
+			close(b.waiters[priority][0])
+			b.waiters[priority] = b.waiters[priority][1:]
+			b.tokens--
+			b.allowed++
+		}
+	}
+	b.schedule()
+}
+
+// remove drops a waiter from its queue, reporting whether it was still waiting
+func (b *tokenBucket) remove(priority Priority, ready chan struct{}) bool {
+	for i, waiter := range b.waiters[priority] {
+		if waiter == ready {
+			b.waiters[priority] = append(b.waiters[priority][:i], b.waiters[priority][i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (b *tokenBucket) stats() ProviderStats {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	queued := make(map[string]int, priorityCount)
+	for priority, waiters := range b.waiters {
+		queued[Priority(priority).String()] = len(waiters)
+	}
+
+	return ProviderStats{
+		Rate:      b.rate,
+		Allowed:   b.allowed,
+		Throttled: b.throttled,
+		Dropped:   b.dropped,
+		Queued:    queued,
+	}
+}
@@ -0,0 +1,178 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/stretchr/testify/assert"
+)
+
+// waitQueued waits until the provider has the given number of queued calls
+func waitQueued(t *testing.T, limiter *Limiter, provider string, count int) {
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		queued := 0
+		for _, n := range limiter.Stats()[provider].Queued {
+			queued += n
+		}
+		if queued == count {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("expected %d queued calls", count)
+}
+
+func TestLimiter(t *testing.T) {
+	endpoint := "https://base-mainnet.g.alchemy.com/v2/key"
+
+	t.Run("groups endpoints by provider", func(t *testing.T) {
+		assert.Equal(t, "alchemy", Provider(endpoint))
+		assert.Equal(t, "infura", Provider("https://base-mainnet.infura.io/v3/key"))
+		assert.Equal(t, "quicknode", Provider("https://xyz.base-mainnet.quiknode.pro/token/"))
+		assert.Equal(t, "rpc.example.com", Provider("https://RPC.example.com:8545"))
+		assert.Equal(t, "unknown", Provider("not a url"))
+	})
+
+	t.Run("defaults untagged calls to settlement priority", func(t *testing.T) {
+		assert.Equal(t, PrioritySettlement, PriorityFrom(context.Background()))
+		assert.Equal(t, PriorityBackfill, PriorityFrom(WithPriority(context.Background(), PriorityBackfill)))
+	})
+
+	t.Run("leaves providers without a limit unlimited", func(t *testing.T) {
+		limiter := New(&config.RPCRateLimitConfiguration{Providers: map[string]float64{"alchemy": 0}, Burst: 1})
+
+		for i := 0; i < 100; i++ {
+			assert.NoError(t, limiter.Wait(context.Background(), endpoint))
+		}
+		assert.Empty(t, limiter.Stats())
+	})
+
+	t.Run("releases queued calls in priority order", func(t *testing.T) {
+		limiter := New(&config.RPCRateLimitConfiguration{Providers: map[string]float64{"alchemy": 20}, Burst: 1})
+		assert.NoError(t, limiter.Wait(context.Background(), endpoint))
+
+		var mutex sync.Mutex
+		var released []Priority
+		var wg sync.WaitGroup
+		for i, priority := range []Priority{PriorityBackfill, PriorityPolling, PriorityWebhookVerification} {
+			wg.Add(1)
+			go func(priority Priority) {
+				defer wg.Done()
+				assert.NoError(t, limiter.Wait(WithPriority(context.Background(), priority), endpoint))
+				mutex.Lock()
+				released = append(released, priority)
+				mutex.Unlock()
+			}(priority)
+			waitQueued(t, limiter, "alchemy", i+1)
+		}
+		wg.Wait()
+
+		assert.Equal(t, []Priority{PriorityWebhookVerification, PriorityPolling, PriorityBackfill}, released)
+
+		stats := limiter.Stats()["alchemy"]
+		assert.Equal(t, int64(4), stats.Allowed)
+		assert.Equal(t, int64(3), stats.Throttled)
+		assert.Equal(t, 0, stats.Queued["backfill"])
+	})
+
+	t.Run("drops queued calls whose context ends", func(t *testing.T) {
+		limiter := New(&config.RPCRateLimitConfiguration{Providers: map[string]float64{"alchemy": 0.1}, Burst: 1})
+		assert.NoError(t, limiter.Wait(context.Background(), endpoint))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		assert.ErrorIs(t, limiter.Wait(ctx, endpoint), context.DeadlineExceeded)
+
+		stats := limiter.Stats()["alchemy"]
+		assert.Equal(t, int64(1), stats.Allowed)
+		assert.Equal(t, int64(0), stats.Throttled)
+		assert.Equal(t, int64(1), stats.Dropped)
+		assert.Equal(t, 0, stats.Queued["settlement"])
+	})
+
+	t.Run("limits requests sent through the transport", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		limiter := New(&config.RPCRateLimitConfiguration{Default: 50, Burst: 1})
+		client := &http.Client{Transport: &Transport{Base: http.DefaultTransport, Limiter: limiter}}
+
+		start := time.Now()
+		for i := 0; i < 3; i++ {
+			res, err := client.Get(server.URL)
+			assert.NoError(t, err)
+			res.Body.Close()
+		}
+		assert.GreaterOrEqual(t, time.Since(start), 35*time.Millisecond)
+		assert.Equal(t, int64(3), limiter.Stats()[Provider(server.URL)].Allowed)
+	})
+
+	t.Run("sheds polling and backfill calls while demand is near the limit", func(t *testing.T) {
+		limiter := New(&config.RPCRateLimitConfiguration{
+			Providers:         map[string]float64{"alchemy": 5},
+			Burst:             100,
+			ShedHighWatermark: 0.8,
+			ShedLowWatermark:  0.5,
+			ShedCooldown:      30 * time.Second,
+		})
+		now := time.Now()
+		limiter.now = func() time.Time { return now }
+		polling := WithPriority(context.Background(), PriorityPolling)
+
+		for i := 0; i < 38; i++ {
+			assert.NoError(t, limiter.Wait(context.Background(), endpoint))
+		}
+		assert.NoError(t, limiter.Wait(polling, endpoint))
+		assert.False(t, limiter.Shedding())
+
+		// Demand passes 80% of the limit
+		assert.NoError(t, limiter.Wait(context.Background(), endpoint))
+		assert.NoError(t, limiter.Wait(context.Background(), endpoint))
+		assert.True(t, limiter.Shedding())
+		assert.ErrorIs(t, limiter.Wait(polling, endpoint), ErrShedding)
+		assert.ErrorIs(t, limiter.Wait(WithPriority(context.Background(), PriorityBackfill), endpoint), ErrShedding)
+		assert.NoError(t, limiter.Wait(WithPriority(context.Background(), PriorityWebhookVerification), endpoint))
+		assert.True(t, limiter.Stats()["alchemy"].Shedding)
+
+		// Shedding continues until demand falls below the low watermark
+		now = now.Add(2 * time.Second)
+		assert.True(t, limiter.Shedding())
+		now = now.Add(4 * time.Second)
+		assert.False(t, limiter.Shedding())
+		assert.NoError(t, limiter.Wait(polling, endpoint))
+	})
+
+	t.Run("sheds calls after the provider answers 429", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer server.Close()
+
+		limiter := New(&config.RPCRateLimitConfiguration{
+			Default:           50,
+			Burst:             10,
+			ShedHighWatermark: 0.8,
+			ShedLowWatermark:  0.5,
+			ShedCooldown:      30 * time.Second,
+		})
+		now := time.Now()
+		limiter.now = func() time.Time { return now }
+		client := &http.Client{Transport: &Transport{Base: http.DefaultTransport, Limiter: limiter}}
+
+		res, err := client.Get(server.URL)
+		assert.NoError(t, err)
+		res.Body.Close()
+		assert.True(t, limiter.Shedding())
+
+		now = now.Add(31 * time.Second)
+		assert.False(t, limiter.Shedding())
+	})
+}
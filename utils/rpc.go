@@ -2,6 +2,7 @@ package utils
 
 import (
 	"fmt"
+	"net/url"
 	"strings"
 	
 	"github.com/spf13/viper"
@@ -42,6 +43,29 @@ func BuildRPCURL(baseURL string) string {
 	return baseURL
 }
 
+// ResolveRPCURL returns the full URL of an RPC endpoint like BuildRPCURL, or an error when the
+// endpoint is not an http(s) or ws(s) URL or needs a provider API key that is not configured
+func ResolveRPCURL(baseURL string) (string, error) {
+	parsed, err := url.Parse(baseURL)
+	if err != nil || parsed.Host == "" {
+		return "", fmt.Errorf("invalid RPC endpoint")
+	}
+	switch parsed.Scheme {
+	case "http", "https", "ws", "wss":
+	default:
+		return "", fmt.Errorf("unsupported RPC endpoint scheme %q", parsed.Scheme)
+	}
+
+	if strings.Contains(parsed.Host, "alchemy.com") && strings.Count(baseURL, "/") <= 3 && GetAlchemyAPIKey() == "" {
+		return "", fmt.Errorf("%s needs ALCHEMY_API_KEY", parsed.Host)
+	}
+	if strings.Contains(baseURL, "YOUR_INFURA_KEY") && GetInfuraAPIKey() == "" {
+		return "", fmt.Errorf("%s needs INFURA_API_KEY", parsed.Host)
+	}
+
+	return BuildRPCURL(baseURL), nil
+}
+
 // GetAlchemyAPIKey returns the Alchemy API key from environment
 func GetAlchemyAPIKey() string {
 	return viper.GetString("ALCHEMY_API_KEY")
@@ -0,0 +1,185 @@
+package shutdown
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+)
+
+// ErrTimeout is returned by Shutdown when in-flight work did not finish within the timeout
+var ErrTimeout = errors.New("in-flight work did not finish before the shutdown timeout")
+
+// hook is a named step of the shutdown
+type hook struct {
+	name string
+	fn   func(ctx context.Context) error
+}
+
+// Manager coordinates a graceful shutdown. Shutdown first runs the stop hooks, which stop intake
+// such as the HTTP server, then cancels the context workers run under and waits for in-flight work
+// to drain, and finally runs the close hooks, which release shared resources such as the database.
+// The whole shutdown is bounded by a timeout
+type Manager struct {
+	timeout time.Duration
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu         sync.Mutex
+	stopping   bool
+	work       sync.WaitGroup
+	stopHooks  []hook
+	closeHooks []hook
+
+	once sync.Once
+	err  error
+	done chan struct{}
+}
+
+var (
+	defaultManager     *Manager
+	defaultManagerOnce sync.Once
+)
+
+// New creates a manager bounding the shutdown by timeout
+func New(timeout time.Duration) *Manager {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Manager{
+		timeout: timeout,
+		ctx:     ctx,
+		cancel:  cancel,
+		done:    make(chan struct{}),
+	}
+}
+
+// Default returns the process-wide manager
+func Default() *Manager {
+	defaultManagerOnce.Do(func() {
+		defaultManager = New(config.ShutdownConfig().Timeout)
+	})
+	return defaultManager
+}
+
+// Context returns the context workers run under. It is cancelled once the stop hooks have run
+func (m *Manager) Context() context.Context {
+	return m.ctx
+}
+
+// Track registers a unit of in-flight work, such as a cron job iteration, that Shutdown waits
+// for. It returns false once the shutdown has started, in which case the work must not start;
+// otherwise done must be called when the work finishes
+func (m *Manager) Track() (done func(), ok bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.stopping {
+		return nil, false
+	}
+	m.work.Add(1)
+
+	var once sync.Once
+	return func() { once.Do(m.work.Done) }, true
+}
+
+// Go runs a long-lived worker that Shutdown waits for. The worker is passed the manager's context
+// and must return once it is done. Workers started after the shutdown began are not run
+func (m *Manager) Go(name string, worker func(ctx context.Context)) {
+	done, ok := m.Track()
+	if !ok {
+		logger.WithFields(logger.Fields{
+			"Worker": name,
+		}).Warnf("Shutting down, worker not started")
+		return
+	}
+
+	go func() {
+		defer done()
+		worker(m.ctx)
+	}()
+}
+
+// OnStop registers a hook run at the start of the shutdown, in registration order, to stop
+// taking new work. The hook should return once work it already accepted is done
+func (m *Manager) OnStop(name string, fn func(ctx context.Context) error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.stopHooks = append(m.stopHooks, hook{name: name, fn: fn})
+}
+
+// OnClose registers a hook run once in-flight work has drained, in reverse registration order,
+// to release a resource the work depended on
+func (m *Manager) OnClose(name string, fn func(ctx context.Context) error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.closeHooks = append(m.closeHooks, hook{name: name, fn: fn})
+}
+
+// Shutdown stops the process gracefully and returns once it is safe to exit. Only the first call
+// shuts down; later calls wait for it and return its result
+func (m *Manager) Shutdown() error {
+	m.once.Do(func() {
+		defer close(m.done)
+		m.err = m.shutdown()
+	})
+	<-m.done
+	return m.err
+}
+
+func (m *Manager) shutdown() error {
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	defer cancel()
+
+	m.mu.Lock()
+	m.stopping = true
+	stopHooks := m.stopHooks
+	closeHooks := m.closeHooks
+	m.mu.Unlock()
+
+	var errs []error
+	run := func(h hook) {
+		if err := h.fn(ctx); err != nil {
+			logger.WithFields(logger.Fields{
+				"Error": fmt.Sprintf("%v", err),
+				"Step":  h.name,
+			}).Errorf("Shutdown step failed")
+			errs = append(errs, fmt.Errorf("%s: %w", h.name, err))
+			return
+		}
+		logger.Infof("%s stopped", h.name)
+	}
+
+	for _, h := range stopHooks {
+		run(h)
+	}
+
+	// Workers stop taking new work, and finish what they hold within the timeout
+	m.cancel()
+	drained := make(chan struct{})
+	go func() {
+		m.work.Wait()
+		close(drained)
+	}()
+	select {
+	case <-drained:
+		logger.Infof("In-flight work drained")
+	case <-ctx.Done():
+		logger.WithFields(logger.Fields{
+			"Timeout": m.timeout,
+		}).Warnf("Shutdown timed out with work still in flight")
+		errs = append(errs, ErrTimeout)
+	}
+
+	// Close hooks run even after a timeout; work cut off by it is resumed on the next start
+	ctx, cancelClose := context.WithTimeout(context.Background(), m.timeout)
+	defer cancelClose()
+	for i := len(closeHooks) - 1; i >= 0; i-- {
+		run(closeHooks[i])
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,77 @@
+package shutdown
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestManager(t *testing.T) {
+	t.Run("drains in-flight work between stop and close hooks", func(t *testing.T) {
+		manager := New(time.Second)
+		var steps []string
+
+		manager.OnStop("HTTP server", func(ctx context.Context) error {
+			steps = append(steps, "stop HTTP server")
+			return nil
+		})
+		manager.OnClose("Database connection", func(ctx context.Context) error {
+			steps = append(steps, "close database")
+			return nil
+		})
+		manager.OnClose("Redis connection", func(ctx context.Context) error {
+			steps = append(steps, "close redis")
+			return nil
+		})
+
+		manager.Go("Transaction outbox", func(ctx context.Context) {
+			<-ctx.Done()
+			// Finish the pass in flight after the shutdown began
+			time.Sleep(10 * time.Millisecond)
+			steps = append(steps, "finish outbox pass")
+		})
+
+		cronTick, ok := manager.Track()
+		assert.True(t, ok)
+		go func() {
+			time.Sleep(20 * time.Millisecond)
+			cronTick()
+		}()
+
+		assert.NoError(t, manager.Shutdown())
+		assert.Equal(t, []string{"stop HTTP server", "finish outbox pass", "close redis", "close database"}, steps)
+	})
+
+	t.Run("starts no work once shutting down", func(t *testing.T) {
+		manager := New(time.Second)
+		assert.NoError(t, manager.Shutdown())
+
+		_, ok := manager.Track()
+		assert.False(t, ok)
+
+		started := false
+		manager.Go("Polling service", func(ctx context.Context) { started = true })
+		assert.False(t, started)
+
+		// Later calls return the result of the first
+		assert.NoError(t, manager.Shutdown())
+	})
+
+	t.Run("closes resources after the timeout", func(t *testing.T) {
+		manager := New(20 * time.Millisecond)
+		closed := false
+		manager.OnClose("Database connection", func(ctx context.Context) error {
+			closed = true
+			return nil
+		})
+
+		stuck, ok := manager.Track()
+		assert.True(t, ok)
+		defer stuck()
+
+		assert.ErrorIs(t, manager.Shutdown(), ErrTimeout)
+		assert.True(t, closed)
+	})
+}
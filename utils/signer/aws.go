@@ -0,0 +1,193 @@
+package signer
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// awsKMSSigner signs with an ECC_SECG_P256K1 key in AWS KMS through its JSON API, authenticating
+// requests with Signature Version 4
+type awsKMSSigner struct {
+	conf       *config.SignerConfiguration
+	keyID      string
+	endpoint   string
+	address    common.Address
+	httpClient *http.Client
+}
+
+// NewAWSKMS returns a signer for an AWS KMS key, fetching its public key to derive the address
+func NewAWSKMS(ctx context.Context, conf *config.SignerConfiguration, keyID string) (Signer, error) {
+	if conf.AWSRegion == "" || conf.AWSAccessKeyID == "" || conf.AWSSecretAccessKey == "" {
+		return nil, fmt.Errorf("AWS_REGION, AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY are required for AWS KMS")
+	}
+
+	endpoint := conf.AWSEndpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://kms.%s.amazonaws.com", conf.AWSRegion)
+	}
+
+	s := &awsKMSSigner{
+		conf:       conf,
+		keyID:      keyID,
+		endpoint:   strings.TrimSuffix(endpoint, "/"),
+		httpClient: &http.Client{Timeout: conf.Timeout},
+	}
+
+	var response struct {
+		PublicKey string `json:"PublicKey"`
+	}
+	if err := s.call(ctx, "GetPublicKey", map[string]interface{}{"KeyId": keyID}, &response); err != nil {
+		return nil, fmt.Errorf("failed to get public key of %s: %w", keyID, err)
+	}
+	der, err := base64.StdEncoding.DecodeString(response.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode public key of %s: %w", keyID, err)
+	}
+	publicKey, err := parsePublicKey(der)
+	if err != nil {
+		return nil, err
+	}
+	s.address = crypto.PubkeyToAddress(*publicKey)
+
+	return s, nil
+}
+
+func (s *awsKMSSigner) Address() common.Address {
+	return s.address
+}
+
+func (s *awsKMSSigner) SignDigest(ctx context.Context, digest []byte) ([]byte, error) {
+	var response struct {
+		Signature string `json:"Signature"`
+	}
+	err := s.call(ctx, "Sign", map[string]interface{}{
+		"KeyId":            s.keyID,
+		"Message":          base64.StdEncoding.EncodeToString(digest),
+		"MessageType":      "DIGEST",
+		"SigningAlgorithm": "ECDSA_SHA_256",
+	}, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign with %s: %w", s.keyID, err)
+	}
+
+	der, err := base64.StdEncoding.DecodeString(response.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode signature: %w", err)
+	}
+	return recoverableSignature(digest, der, s.address)
+}
+
+// call sends a signed request for a KMS action and decodes its JSON response into out
+func (s *awsKMSSigner) call(ctx context.Context, action string, body interface{}, out interface{}) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint+"/", bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "TrentService."+action)
+	s.sign(req, payload, time.Now().UTC())
+
+	res, err := s.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned %d: %s", action, res.StatusCode, strings.TrimSpace(string(data)))
+	}
+	return json.Unmarshal(data, out)
+}
+
+// sign adds the Signature Version 4 headers for the kms service to a request
+func (s *awsKMSSigner) sign(req *http.Request, payload []byte, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+
+	host := req.URL.Host
+	if u, err := url.Parse(s.endpoint); err == nil && u.Host != "" {
+		host = u.Host
+	}
+	req.Host = host
+	req.Header.Set("X-Amz-Date", amzDate)
+	if s.conf.AWSSessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", s.conf.AWSSessionToken)
+	}
+
+	// Headers must be listed in lowercase sorted order
+	headers := []string{"content-type", "host", "x-amz-date"}
+	if s.conf.AWSSessionToken != "" {
+		headers = append(headers, "x-amz-security-token")
+	}
+	headers = append(headers, "x-amz-target")
+
+	var canonicalHeaders strings.Builder
+	for _, name := range headers {
+		value := req.Header.Get(name)
+		if name == "host" {
+			value = host
+		}
+		canonicalHeaders.WriteString(name + ":" + strings.TrimSpace(value) + "\n")
+	}
+	signedHeaders := strings.Join(headers, ";")
+
+	payloadHash := sha256.Sum256(payload)
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		"/",
+		"",
+		canonicalHeaders.String(),
+		signedHeaders,
+		hex.EncodeToString(payloadHash[:]),
+	}, "\n")
+
+	scope := fmt.Sprintf("%s/%s/kms/aws4_request", date, s.conf.AWSRegion)
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		hex.EncodeToString(requestHash[:]),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.conf.AWSSecretAccessKey), date)
+	key = hmacSHA256(key, s.conf.AWSRegion)
+	key = hmacSHA256(key, "kms")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.conf.AWSAccessKeyID, scope, signedHeaders, signature,
+	))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
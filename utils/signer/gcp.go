@@ -0,0 +1,169 @@
+package signer
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// gcpMetadataTokenURL returns access tokens of the service account attached to the instance
+const gcpMetadataTokenURL = "http://metadata.google.internal/computeMetadata/v1/instance/service-accounts/default/token"
+
+// gcpKMSSigner signs with an EC_SIGN_SECP256K1_SHA256 key version in Google Cloud KMS through its
+// REST API
+type gcpKMSSigner struct {
+	conf       *config.SignerConfiguration
+	keyName    string
+	address    common.Address
+	httpClient *http.Client
+
+	mu          sync.Mutex
+	token       string
+	tokenExpiry time.Time
+}
+
+// NewGCPKMS returns a signer for a Google Cloud KMS crypto key version, fetching its public key to
+// derive the address. keyName is the full resource name:
+// projects/*/locations/*/keyRings/*/cryptoKeys/*/cryptoKeyVersions/*
+func NewGCPKMS(ctx context.Context, conf *config.SignerConfiguration, keyName string) (Signer, error) {
+	s := &gcpKMSSigner{
+		conf:       conf,
+		keyName:    strings.TrimPrefix(keyName, "/"),
+		httpClient: &http.Client{Timeout: conf.Timeout},
+	}
+
+	var response struct {
+		Pem string `json:"pem"`
+	}
+	if err := s.call(ctx, http.MethodGet, "/publicKey", nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to get public key of %s: %w", keyName, err)
+	}
+	block, _ := pem.Decode([]byte(response.Pem))
+	if block == nil {
+		return nil, fmt.Errorf("failed to decode public key of %s", keyName)
+	}
+	publicKey, err := parsePublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	s.address = crypto.PubkeyToAddress(*publicKey)
+
+	return s, nil
+}
+
+func (s *gcpKMSSigner) Address() common.Address {
+	return s.address
+}
+
+func (s *gcpKMSSigner) SignDigest(ctx context.Context, digest []byte) ([]byte, error) {
+	var response struct {
+		Signature string `json:"signature"`
+	}
+	err := s.call(ctx, http.MethodPost, ":asymmetricSign", map[string]interface{}{
+		"digest": map[string]string{"sha256": base64.StdEncoding.EncodeToString(digest)},
+	}, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign with %s: %w", s.keyName, err)
+	}
+
+	der, err := base64.StdEncoding.DecodeString(response.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode signature: %w", err)
+	}
+	return recoverableSignature(digest, der, s.address)
+}
+
+// call sends a request for a method of the key version and decodes its JSON response into out
+func (s *gcpKMSSigner) call(ctx context.Context, method string, suffix string, body interface{}, out interface{}) error {
+	token, err := s.accessToken(ctx)
+	if err != nil {
+		return err
+	}
+
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	endpoint := strings.TrimSuffix(s.conf.GCPEndpoint, "/") + "/v1/" + s.keyName + suffix
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := s.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned %d: %s", suffix, res.StatusCode, strings.TrimSpace(string(data)))
+	}
+	return json.Unmarshal(data, out)
+}
+
+// accessToken returns GCP_ACCESS_TOKEN when set, otherwise a token of the instance service account
+// from the metadata server, cached until shortly before it expires
+func (s *gcpKMSSigner) accessToken(ctx context.Context) (string, error) {
+	if s.conf.GCPAccessToken != "" {
+		return s.conf.GCPAccessToken, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token != "" && time.Now().Before(s.tokenExpiry) {
+		return s.token, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, gcpMetadataTokenURL, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Metadata-Flavor", "Google")
+
+	res, err := s.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to get access token from metadata server: %w", err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("metadata server returned %d", res.StatusCode)
+	}
+
+	var response struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return "", fmt.Errorf("failed to decode access token: %w", err)
+	}
+
+	s.token = response.AccessToken
+	s.tokenExpiry = time.Now().Add(time.Duration(response.ExpiresIn)*time.Second - time.Minute)
+	return s.token, nil
+}
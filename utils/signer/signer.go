@@ -0,0 +1,206 @@
+// Package signer signs Ethereum digests with secp256k1 keys held locally or in a cloud KMS, so the
+// smart account owner and deployer keys don't have to be kept in the environment
+package signer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/spf13/viper"
+)
+
+// Signer backends selectable with SIGNER_BACKEND
+const (
+	BackendLocal  = "local"
+	BackendAWSKMS = "aws_kms"
+	BackendGCPKMS = "gcp_kms"
+)
+
+// Signer signs 32-byte digests with a secp256k1 key
+type Signer interface {
+	// Address returns the Ethereum address of the key
+	Address() common.Address
+	// SignDigest returns a [R || S || V] signature of the digest with V in {0, 1}, like crypto.Sign
+	SignDigest(ctx context.Context, digest []byte) ([]byte, error)
+}
+
+// Owner returns the signer of the smart account owner key
+func Owner(ctx context.Context) (Signer, error) {
+	conf := config.SignerConfig()
+	if conf.Backend == BackendLocal {
+		privateKey := viper.GetString("SMART_ACCOUNT_OWNER_PRIVATE_KEY")
+		if privateKey == "" {
+			return nil, fmt.Errorf("SMART_ACCOUNT_OWNER_PRIVATE_KEY not configured")
+		}
+		return NewLocalFromHex(privateKey)
+	}
+	return New(ctx, conf, conf.OwnerKeyID)
+}
+
+// Deployer returns the signer of the deployer EOA. privateKeyHex is only used by the local backend
+func Deployer(ctx context.Context, privateKeyHex string) (Signer, error) {
+	conf := config.SignerConfig()
+	if conf.Backend == BackendLocal {
+		if privateKeyHex == "" {
+			return nil, fmt.Errorf("deployer private key not configured")
+		}
+		return NewLocalFromHex(privateKeyHex)
+	}
+	return New(ctx, conf, conf.DeployerKeyID)
+}
+
+// New returns a KMS signer for a key of the configured backend
+func New(ctx context.Context, conf *config.SignerConfiguration, keyID string) (Signer, error) {
+	if keyID == "" {
+		return nil, fmt.Errorf("no KMS key configured for signer backend %s", conf.Backend)
+	}
+
+	switch conf.Backend {
+	case BackendAWSKMS:
+		return NewAWSKMS(ctx, conf, keyID)
+	case BackendGCPKMS:
+		return NewGCPKMS(ctx, conf, keyID)
+	default:
+		return nil, fmt.Errorf("unsupported signer backend: %s", conf.Backend)
+	}
+}
+
+// references caches signers resolved by FromReference, so KMS public keys are fetched once
+var references sync.Map
+
+// FromReference returns the signer a reference points at: env:<VARIABLE> for a hex private key in
+// the environment, aws_kms:<key id> or gcp_kms:<key version name>. References are stored in the
+// database in place of keys, e.g. for per-network owner keys
+func FromReference(ctx context.Context, reference string) (Signer, error) {
+	if cached, ok := references.Load(reference); ok {
+		return cached.(Signer), nil
+	}
+
+	kind, value, ok := strings.Cut(reference, ":")
+	if !ok || value == "" {
+		return nil, fmt.Errorf("invalid signer reference %q", reference)
+	}
+
+	var s Signer
+	var err error
+	switch kind {
+	case "env":
+		privateKey := viper.GetString(value)
+		if privateKey == "" {
+			return nil, fmt.Errorf("%s not configured", value)
+		}
+		s, err = NewLocalFromHex(privateKey)
+	case BackendAWSKMS:
+		s, err = NewAWSKMS(ctx, config.SignerConfig(), value)
+	case BackendGCPKMS:
+		s, err = NewGCPKMS(ctx, config.SignerConfig(), value)
+	default:
+		return nil, fmt.Errorf("unsupported signer reference %q", reference)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	references.Store(reference, s)
+	return s, nil
+}
+
+// localSigner signs with a private key held in memory
+type localSigner struct {
+	key     *ecdsa.PrivateKey
+	address common.Address
+}
+
+// NewLocal returns a signer for a private key held in memory
+func NewLocal(key *ecdsa.PrivateKey) Signer {
+	return &localSigner{key: key, address: crypto.PubkeyToAddress(key.PublicKey)}
+}
+
+// NewLocalFromHex returns a signer for a hex encoded private key, with or without 0x prefix
+func NewLocalFromHex(privateKeyHex string) (Signer, error) {
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(privateKeyHex, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+	return NewLocal(key), nil
+}
+
+func (s *localSigner) Address() common.Address {
+	return s.address
+}
+
+func (s *localSigner) SignDigest(ctx context.Context, digest []byte) ([]byte, error) {
+	return crypto.Sign(digest, s.key)
+}
+
+// SignTx signs a transaction for a chain
+func SignTx(ctx context.Context, s Signer, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	txSigner := types.LatestSignerForChainID(chainID)
+	signature, err := s.SignDigest(ctx, txSigner.Hash(tx).Bytes())
+	if err != nil {
+		return nil, err
+	}
+	return tx.WithSignature(txSigner, signature)
+}
+
+// secp256k1HalfN is half the order of secp256k1; Ethereum rejects signatures with a larger S
+var secp256k1HalfN = new(big.Int).Rsh(crypto.S256().Params().N, 1)
+
+// recoverableSignature converts an ASN.1 DER signature returned by a KMS to [R || S || V], taking the
+// low S form and finding the recovery ID that yields the address of the key
+func recoverableSignature(digest []byte, der []byte, address common.Address) ([]byte, error) {
+	var parsed struct {
+		R, S *big.Int
+	}
+	if _, err := asn1.Unmarshal(der, &parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse signature: %w", err)
+	}
+	if parsed.R == nil || parsed.S == nil || parsed.R.Sign() <= 0 || parsed.S.Sign() <= 0 {
+		return nil, fmt.Errorf("invalid signature")
+	}
+
+	s := parsed.S
+	if s.Cmp(secp256k1HalfN) > 0 {
+		s = new(big.Int).Sub(crypto.S256().Params().N, s)
+	}
+
+	signature := make([]byte, crypto.SignatureLength)
+	parsed.R.FillBytes(signature[:32])
+	s.FillBytes(signature[32:64])
+	for v := byte(0); v < 2; v++ {
+		signature[64] = v
+		publicKey, err := crypto.SigToPub(digest, signature)
+		if err == nil && crypto.PubkeyToAddress(*publicKey) == address {
+			return signature, nil
+		}
+	}
+
+	return nil, fmt.Errorf("signature does not recover to %s", address.Hex())
+}
+
+// parsePublicKey parses an ASN.1 DER SubjectPublicKeyInfo holding a secp256k1 key
+func parsePublicKey(der []byte) (*ecdsa.PublicKey, error) {
+	var spki struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+	if _, err := asn1.Unmarshal(der, &spki); err != nil {
+		return nil, fmt.Errorf("failed to parse public key: %w", err)
+	}
+
+	publicKey, err := crypto.UnmarshalPubkey(spki.PublicKey.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("public key is not a secp256k1 key: %w", err)
+	}
+	return publicKey, nil
+}
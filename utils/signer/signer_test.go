@@ -0,0 +1,223 @@
+package signer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// marshalPublicKey encodes a secp256k1 public key as a DER SubjectPublicKeyInfo, like KMS returns it
+func marshalPublicKey(t *testing.T, key *ecdsa.PublicKey) []byte {
+	der, err := asn1.Marshal(struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}{
+		Algorithm: pkix.AlgorithmIdentifier{
+			Algorithm:  asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1},
+			Parameters: asn1.RawValue{FullBytes: mustMarshal(t, asn1.ObjectIdentifier{1, 3, 132, 0, 10})},
+		},
+		PublicKey: asn1.BitString{Bytes: crypto.FromECDSAPub(key), BitLength: 65 * 8},
+	})
+	require.NoError(t, err)
+	return der
+}
+
+// signDER signs a digest and encodes it as a DER signature with a high S value, which KMS may return
+func signDER(t *testing.T, key *ecdsa.PrivateKey, digest []byte) []byte {
+	signature, err := crypto.Sign(digest, key)
+	require.NoError(t, err)
+
+	s := new(big.Int).SetBytes(signature[32:64])
+	return mustMarshal(t, struct{ R, S *big.Int }{
+		R: new(big.Int).SetBytes(signature[:32]),
+		S: new(big.Int).Sub(crypto.S256().Params().N, s),
+	})
+}
+
+func mustMarshal(t *testing.T, value interface{}) []byte {
+	der, err := asn1.Marshal(value)
+	require.NoError(t, err)
+	return der
+}
+
+// assertRecovers checks that a signer's signature is low S and recovers to its address
+func assertRecovers(t *testing.T, s Signer, digest []byte) {
+	signature, err := s.SignDigest(context.Background(), digest)
+	require.NoError(t, err)
+	require.Len(t, signature, crypto.SignatureLength)
+	assert.LessOrEqual(t, new(big.Int).SetBytes(signature[32:64]).Cmp(secp256k1HalfN), 0)
+
+	publicKey, err := crypto.SigToPub(digest, signature)
+	require.NoError(t, err)
+	assert.Equal(t, s.Address(), crypto.PubkeyToAddress(*publicKey))
+}
+
+func TestLocalSigner(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	s, err := NewLocalFromHex("0x" + common.Bytes2Hex(crypto.FromECDSA(key)))
+	require.NoError(t, err)
+	assert.Equal(t, crypto.PubkeyToAddress(key.PublicKey), s.Address())
+	assertRecovers(t, s, crypto.Keccak256([]byte("digest")))
+
+	t.Run("signs transactions", func(t *testing.T) {
+		to := common.HexToAddress("0x1111111111111111111111111111111111111111")
+		tx := types.NewTx(&types.DynamicFeeTx{
+			ChainID:   big.NewInt(84532),
+			Gas:       21000,
+			GasTipCap: big.NewInt(1),
+			GasFeeCap: big.NewInt(2),
+			To:        &to,
+			Value:     big.NewInt(0),
+		})
+
+		signed, err := SignTx(context.Background(), s, tx, big.NewInt(84532))
+		require.NoError(t, err)
+		from, err := types.Sender(types.LatestSignerForChainID(big.NewInt(84532)), signed)
+		require.NoError(t, err)
+		assert.Equal(t, s.Address(), from)
+	})
+
+	_, err = NewLocalFromHex("not a key")
+	assert.Error(t, err)
+}
+
+func TestFromReference(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	viper.Set("TESTNET_OWNER_PRIVATE_KEY", common.Bytes2Hex(crypto.FromECDSA(key)))
+	defer viper.Set("TESTNET_OWNER_PRIVATE_KEY", "")
+
+	s, err := FromReference(context.Background(), "env:TESTNET_OWNER_PRIVATE_KEY")
+	require.NoError(t, err)
+	assert.Equal(t, crypto.PubkeyToAddress(key.PublicKey), s.Address())
+
+	for _, reference := range []string{"", "env:", "env:MISSING_OWNER_PRIVATE_KEY", "vault:owner"} {
+		_, err := FromReference(context.Background(), reference)
+		assert.Error(t, err, reference)
+	}
+}
+
+func TestRecoverableSignature(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	digest := crypto.Keccak256([]byte("digest"))
+
+	signature, err := recoverableSignature(digest, signDER(t, key, digest), address)
+	require.NoError(t, err)
+	expected, err := crypto.Sign(digest, key)
+	require.NoError(t, err)
+	assert.Equal(t, expected, signature)
+
+	_, err = recoverableSignature(digest, signDER(t, key, digest), common.HexToAddress("0x1111111111111111111111111111111111111111"))
+	assert.Error(t, err)
+
+	_, err = recoverableSignature(digest, []byte("not der"), address)
+	assert.Error(t, err)
+}
+
+func TestAWSKMSSigner(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.True(t, strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKID/"))
+		assert.Contains(t, r.Header.Get("Authorization"), "/us-east-1/kms/aws4_request")
+
+		var body map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "alias/owner", body["KeyId"])
+
+		switch r.Header.Get("X-Amz-Target") {
+		case "TrentService.GetPublicKey":
+			json.NewEncoder(w).Encode(map[string]string{
+				"PublicKey": base64.StdEncoding.EncodeToString(marshalPublicKey(t, &key.PublicKey)),
+			})
+		case "TrentService.Sign":
+			assert.Equal(t, "DIGEST", body["MessageType"])
+			digest, err := base64.StdEncoding.DecodeString(body["Message"])
+			require.NoError(t, err)
+			json.NewEncoder(w).Encode(map[string]string{
+				"Signature": base64.StdEncoding.EncodeToString(signDER(t, key, digest)),
+			})
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	s, err := NewAWSKMS(context.Background(), &config.SignerConfiguration{
+		Backend:            BackendAWSKMS,
+		Timeout:            5 * time.Second,
+		AWSRegion:          "us-east-1",
+		AWSAccessKeyID:     "AKID",
+		AWSSecretAccessKey: "secret",
+		AWSEndpoint:        server.URL,
+	}, "alias/owner")
+	require.NoError(t, err)
+	assert.Equal(t, crypto.PubkeyToAddress(key.PublicKey), s.Address())
+	assertRecovers(t, s, crypto.Keccak256([]byte("digest")))
+}
+
+func TestGCPKMSSigner(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	keyName := "projects/p/locations/global/keyRings/r/cryptoKeys/owner/cryptoKeyVersions/1"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+
+		switch r.URL.Path {
+		case "/v1/" + keyName + "/publicKey":
+			json.NewEncoder(w).Encode(map[string]string{
+				"pem": string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: marshalPublicKey(t, &key.PublicKey)})),
+			})
+		case "/v1/" + keyName + ":asymmetricSign":
+			var body struct {
+				Digest struct {
+					Sha256 string `json:"sha256"`
+				} `json:"digest"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			digest, err := base64.StdEncoding.DecodeString(body.Digest.Sha256)
+			require.NoError(t, err)
+			json.NewEncoder(w).Encode(map[string]string{
+				"signature": base64.StdEncoding.EncodeToString(signDER(t, key, digest)),
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	s, err := NewGCPKMS(context.Background(), &config.SignerConfiguration{
+		Backend:        BackendGCPKMS,
+		Timeout:        5 * time.Second,
+		GCPAccessToken: "token",
+		GCPEndpoint:    server.URL,
+	}, keyName)
+	require.NoError(t, err)
+	assert.Equal(t, crypto.PubkeyToAddress(key.PublicKey), s.Address())
+	assertRecovers(t, s, crypto.Keccak256([]byte("digest")))
+}
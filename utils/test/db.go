@@ -61,18 +61,52 @@ func CreateTestUser(overrides map[string]interface{}) (*ent.User, error) {
 	return user, err
 }
 
+// CreateTestNetwork creates a test network with default or custom values
+func CreateTestNetwork(overrides map[string]interface{}) (*ent.Network, error) {
+
+	// Default payload
+	payload := map[string]interface{}{
+		"identifier": "localhost",
+		"chainID":    int64(1337),
+		"networkRPC": "ws://localhost:8545",
+		"fee":        0.1,
+		"is_testnet": true,
+		"is_enabled": true,
+	}
+
+	// Apply overrides
+	for key, value := range overrides {
+		payload[key] = value
+	}
+
+	// Create Network
+	network, err := db.Client.Network.
+		Create().
+		SetIdentifier(payload["identifier"].(string)).
+		SetChainID(payload["chainID"].(int64)).
+		SetRPCEndpoint(payload["networkRPC"].(string)).
+		SetBlockTime(decimal.NewFromFloat(3.0)).
+		SetFee(decimal.NewFromFloat(payload["fee"].(float64))).
+		SetIsTestnet(payload["is_testnet"].(bool)).
+		SetIsEnabled(payload["is_enabled"].(bool)).
+		Save(context.Background())
+
+	return network, err
+}
+
 // CreateERC20Token creates a test token with default or custom values
 func CreateERC20Token(client types.RPCClient, overrides map[string]interface{}) (*ent.Token, error) {
 
 	// Default payload
 	payload := map[string]interface{}{
-		"symbol":         "TST",
-		"decimals":       6,
-		"networkRPC":     "ws://localhost:8545",
-		"is_enabled":     true,
-		"identifier":     "localhost",
-		"chainID":        int64(1337),
-		"deployContract": true,
+		"symbol":          "TST",
+		"decimals":        6,
+		"networkRPC":      "ws://localhost:8545",
+		"is_enabled":      true,
+		"identifier":      "localhost",
+		"chainID":         int64(1337),
+		"deployContract":  true,
+		"contractAddress": "0xd4E96eF8eee8678dBFf4d535E033Ed1a4F7605b7",
 	}
 
 	var contractAddress string
@@ -90,7 +124,7 @@ func CreateERC20Token(client types.RPCClient, overrides map[string]interface{})
 		}
 		contractAddress = deployedTokenAddress.Hex()
 	} else {
-		contractAddress = "0xd4E96eF8eee8678dBFf4d535E033Ed1a4F7605b7"
+		contractAddress = payload["contractAddress"].(string)
 	}
 
 	// Create Network
@@ -272,6 +306,7 @@ func CreateTestPaymentOrder(client types.RPCClient, token *ent.Token, overrides
 	payload := map[string]interface{}{
 		"amount":             100.50,
 		"amount_in_usd":      100.50,
+		"amount_paid":        0.0,
 		"rate":               750.0,
 		"status":             "pending",
 		"fee_percent":        0.0,
@@ -289,37 +324,39 @@ func CreateTestPaymentOrder(client types.RPCClient, token *ent.Token, overrides
 		payload[key] = value
 	}
 
-	// Create smart address
-	address, salt, err := CreateSmartAddress(
-		context.Background(), client)
-	if err != nil {
-		return nil, err
-	}
+	// Use the given receive address, or deploy a fresh smart address
+	receiveAddress, ok := payload["receive_address"].(*ent.ReceiveAddress)
+	if !ok {
+		address, salt, err := CreateSmartAddress(
+			context.Background(), client)
+		if err != nil {
+			return nil, err
+		}
 
-	// Create receive address
-	receiveAddress, err := db.Client.ReceiveAddress.
-		Create().
-		SetAddress(address).
-		SetSalt(salt).
-		SetStatus(receiveaddress.StatusUnused).
-		SetValidUntil(time.Now().Add(time.Millisecond * 5)).
-		Save(context.Background())
-	if err != nil {
-		return nil, err
-	}
+		receiveAddress, err = db.Client.ReceiveAddress.
+			Create().
+			SetAddress(address).
+			SetSalt(salt).
+			SetStatus(receiveaddress.StatusUnused).
+			SetValidUntil(time.Now().Add(time.Millisecond * 5)).
+			Save(context.Background())
+		if err != nil {
+			return nil, err
+		}
 
-	time.Sleep(time.Second)
+		time.Sleep(time.Second)
+	}
 
 	// Create payment order
-	paymentOrder, err := db.Client.PaymentOrder.
+	orderCreate := db.Client.PaymentOrder.
 		Create().
-		SetSenderProfile(overrides["sender"].(*ent.SenderProfile)).
 		SetAmount(decimal.NewFromFloat(payload["amount"].(float64))).
 		SetAmountInUsd(decimal.NewFromFloat(payload["amount_in_usd"].(float64))).
-		SetAmountPaid(decimal.NewFromInt(0)).
+		SetAmountPaid(decimal.NewFromFloat(payload["amount_paid"].(float64))).
 		SetAmountReturned(decimal.NewFromInt(0)).
 		SetPercentSettled(decimal.NewFromInt(0)).
 		SetNetworkFee(token.Edges.Network.Fee).
+		SetProtocolFee(decimal.NewFromInt(0)).
 		SetSenderFee(decimal.NewFromFloat(payload["fee_percent"].(float64)).Mul(decimal.NewFromFloat(payload["amount"].(float64))).Div(decimal.NewFromFloat(payload["rate"].(float64))).Round(int32(token.Decimals))).
 		SetToken(token).
 		SetRate(decimal.NewFromFloat(payload["rate"].(float64))).
@@ -328,8 +365,11 @@ func CreateTestPaymentOrder(client types.RPCClient, token *ent.Token, overrides
 		SetFeePercent(decimal.NewFromFloat(payload["fee_percent"].(float64))).
 		SetFeeAddress(payload["fee_address"].(string)).
 		SetReturnAddress(payload["return_address"].(string)).
-		SetStatus(paymentorder.Status(payload["status"].(string))).
-		Save(context.Background())
+		SetStatus(paymentorder.Status(payload["status"].(string)))
+	if sender, ok := payload["sender"].(*ent.SenderProfile); ok {
+		orderCreate.SetSenderProfile(sender)
+	}
+	paymentOrder, err := orderCreate.Save(context.Background())
 	if err != nil {
 		return nil, err
 	}
@@ -400,14 +440,19 @@ func CreateTestSenderProfile(overrides map[string]interface{}) (*ent.SenderProfi
 		payload[key] = value
 	}
 
-	_token, err := db.Client.Token.
-		Query().
-		Where(
-			token.SymbolEQ(payload["token"].(string)),
-		).
-		Only(context.Background())
-	if err != nil {
-		return nil, err
+	// An empty token creates a sender without order token settings
+	var _token *ent.Token
+	var err error
+	if payload["token"].(string) != "" {
+		_token, err = db.Client.Token.
+			Query().
+			Where(
+				token.SymbolEQ(payload["token"].(string)),
+			).
+			Only(context.Background())
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	feePercent, _ := decimal.NewFromString(payload["fee_percent"].(string))
@@ -419,8 +464,8 @@ func CreateTestSenderProfile(overrides map[string]interface{}) (*ent.SenderProfi
 		SetDomainWhitelist(payload["domain_whitelist"].([]string)).
 		SetUserID(payload["user_id"].(uuid.UUID)).
 		Save(context.Background())
-	if err != nil {
-		return nil, err
+	if err != nil || _token == nil {
+		return profile, err
 	}
 
 	_, err = db.Client.SenderOrderToken.
@@ -22,9 +22,14 @@ import (
 	"github.com/NEDA-LABS/stablenode/config"
 	"github.com/NEDA-LABS/stablenode/ent/network"
 	"github.com/NEDA-LABS/stablenode/services/contracts"
+	"github.com/NEDA-LABS/stablenode/services/gasoracle"
 	"github.com/NEDA-LABS/stablenode/storage"
 	"github.com/NEDA-LABS/stablenode/types"
 	cryptoUtils "github.com/NEDA-LABS/stablenode/utils/crypto"
+	"github.com/NEDA-LABS/stablenode/utils/aaerrors"
+	"github.com/NEDA-LABS/stablenode/utils/breaker"
+	"github.com/NEDA-LABS/stablenode/utils/metrics"
+	"github.com/NEDA-LABS/stablenode/utils/ratelimit"
 	"github.com/stackup-wallet/stackup-bundler/pkg/userop"
 )
 
@@ -112,7 +117,7 @@ func SponsorUserOperation(userOp *userop.UserOperation, mode string, token strin
 		return fmt.Errorf("failed to get endpoints for chain ID %d: %w", chainId, err)
 	}
 
-	client, err := rpc.Dial(paymasterUrl)
+	client, err := breaker.DialRPC(context.Background(), "paymaster", paymasterUrl)
 	if err != nil {
 		return fmt.Errorf("failed to connect to RPC client: %w", err)
 	}
@@ -193,7 +198,7 @@ func SponsorUserOperation(userOp *userop.UserOperation, mode string, token strin
 		}
 
 		httpClient := &http.Client{
-			Transport: &http.Transport{},
+			Transport: breaker.NewTransport("paymaster", &http.Transport{}),
 		}
 		header := http.Header{}
 		header.Set("x-secret-key", engineConf.ThirdwebSecretKey)
@@ -272,7 +277,7 @@ func SendUserOperation(userOp *userop.UserOperation, chainId int64) (string, str
 		return "", "", 0, fmt.Errorf("failed to get endpoints for chain ID %d: %w", chainId, err)
 	}
 
-	client, err := rpc.Dial(bundlerUrl)
+	client, err := ratelimit.DialRPC(context.Background(), bundlerUrl)
 	if err != nil {
 		return "", "", 0, fmt.Errorf("failed to connect to RPC client: %w", err)
 	}
@@ -320,7 +325,10 @@ func SendUserOperation(userOp *userop.UserOperation, chainId int64) (string, str
 
 	var result json.RawMessage
 	err = client.Call(&result, method, requestParams...)
+	metrics.UserOperationSent(chainId, err)
 	if err != nil {
+		err = aaerrors.FromRPC(err)
+		metrics.UserOperationFailed(chainId, "send", aaerrors.Reason(err))
 		op, _ := userOp.MarshalJSON()
 		return "", "", 0, fmt.Errorf("RPC error: %w\nUser Operation: %s", err, string(op))
 	}
@@ -387,7 +395,7 @@ func GetUserOperationByReceipt(userOpHash string, chainId int64) (map[string]int
 			rpc.WithHeaders(header),
 		)
 	} else {
-		client, err = rpc.Dial(bundlerUrl)
+		client, err = ratelimit.DialRPC(context.Background(), bundlerUrl)
 	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to RPC client: %w", err)
@@ -506,7 +514,7 @@ func GetPaymasterAccount(chainId int64) (string, error) {
 		return "0x00000f7365ca6c59a2c93719ad53d567ed49c14c", nil
 	}
 
-	client, err := rpc.Dial(paymasterUrl)
+	client, err := breaker.DialRPC(context.Background(), "paymaster", paymasterUrl)
 	if err != nil {
 		return "", fmt.Errorf("failed to connect to RPC client: %w", err)
 	}
@@ -557,7 +565,7 @@ func GetUserOperationStatus(userOpHash string, chainId int64) (bool, error) {
 			rpc.WithHeaders(header),
 		)
 	} else {
-		client, err = rpc.Dial(bundlerUrl)
+		client, err = ratelimit.DialRPC(context.Background(), bundlerUrl)
 	}
 	if err != nil {
 		return false, fmt.Errorf("failed to connect to RPC client: %w", err)
@@ -599,8 +607,25 @@ func createAccountCallData(owner common.Address, salt *big.Int) ([]byte, error)
 	return calldata, nil
 }
 
-// eip1559GasPrice computes the EIP1559 gas price
+// feeHistoryClient is an RPC client the gas oracle can read fee history through
+type feeHistoryClient interface {
+	gasoracle.Client
+	ChainID(ctx context.Context) (*big.Int, error)
+}
+
+// eip1559GasPrice computes the EIP1559 gas price, from the gas oracle when the client can read fee
+// history and from the latest header otherwise
 func eip1559GasPrice(ctx context.Context, client types.RPCClient) (maxFeePerGas, maxPriorityFeePerGas *big.Int, err error) {
+	if feeClient, ok := client.(feeHistoryClient); ok {
+		if chainID, err := feeClient.ChainID(ctx); err == nil {
+			oracle := gasoracle.Default()
+			fees, err := oracle.SuggestFees(ctx, chainID.Int64(), feeClient, oracle.Urgency())
+			if err == nil {
+				return fees.MaxFeePerGas, fees.MaxPriorityFeePerGas, nil
+			}
+		}
+	}
+
 	latestHeader, err := client.HeaderByNumber(ctx, nil)
 	if err != nil {
 		return nil, nil, err
@@ -646,7 +671,7 @@ func getStandardGasPrices(chainId int64) (*big.Int, *big.Int, error) {
 	}
 
 	httpClient := &http.Client{
-		Transport: &http.Transport{},
+		Transport: breaker.NewTransport("paymaster", &http.Transport{}),
 	}
 	header := http.Header{}
 	header.Set("x-secret-key", engineConf.ThirdwebSecretKey)
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/shopspring/decimal"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -87,4 +88,35 @@ func TestUtils(t *testing.T) {
 		assert := assert.New(t)
 		assert.True(median.Equal(decimal.NewFromInt(2)), "Median calculation is incorrect")
 	})
+
+	t.Run("IsValidSolanaAddress", func(t *testing.T) {
+		assert.True(t, IsValidSolanaAddress("EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v"))
+		assert.True(t, IsValidSolanaAddress("11111111111111111111111111111111"))
+		assert.False(t, IsValidSolanaAddress("0x036CbD53842c5426634e7929541eC2318f3dCF7e"))
+		assert.False(t, IsValidSolanaAddress("EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1vEPj"))
+		assert.False(t, IsValidSolanaAddress("0PjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v"))
+		assert.False(t, IsValidSolanaAddress(""))
+	})
+
+	t.Run("ResolveRPCURL", func(t *testing.T) {
+		viper.Set("ALCHEMY_API_KEY", "")
+		defer viper.Set("ALCHEMY_API_KEY", "")
+
+		endpoint, err := ResolveRPCURL("https://sepolia.base.org")
+		assert.NoError(t, err)
+		assert.Equal(t, "https://sepolia.base.org", endpoint)
+
+		_, err = ResolveRPCURL("https://base-sepolia.g.alchemy.com/v2")
+		assert.ErrorContains(t, err, "ALCHEMY_API_KEY")
+
+		viper.Set("ALCHEMY_API_KEY", "key")
+		endpoint, err = ResolveRPCURL("https://base-sepolia.g.alchemy.com/v2")
+		assert.NoError(t, err)
+		assert.Equal(t, "https://base-sepolia.g.alchemy.com/v2/key", endpoint)
+
+		for _, invalid := range []string{"", "sepolia.base.org", "ftp://sepolia.base.org"} {
+			_, err = ResolveRPCURL(invalid)
+			assert.Error(t, err, invalid)
+		}
+	})
 }
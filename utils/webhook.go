@@ -0,0 +1,110 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/webhookdestination"
+	"github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+)
+
+// GetWebhookDestination returns the destination of a webhook URL, registering it on first delivery
+func GetWebhookDestination(ctx context.Context, webhookURL string) (*ent.WebhookDestination, error) {
+	destination, err := storage.Client.WebhookDestination.
+		Query().
+		Where(webhookdestination.URLEQ(webhookURL)).
+		Only(ctx)
+	if err == nil {
+		return destination, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("GetWebhookDestination.db: %w", err)
+	}
+
+	destination, err = storage.Client.WebhookDestination.
+		Create().
+		SetURL(webhookURL).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		// Registered by a concurrent delivery
+		return storage.Client.WebhookDestination.
+			Query().
+			Where(webhookdestination.URLEQ(webhookURL)).
+			Only(ctx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("GetWebhookDestination.create: %w", err)
+	}
+
+	return destination, nil
+}
+
+// WebhookPolicy returns the retry policy of a destination, its overrides applied over the configured defaults
+func WebhookPolicy(destination *ent.WebhookDestination) types.WebhookPolicy {
+	webhookConf := config.WebhookConfig()
+
+	policy := types.WebhookPolicy{
+		MaxAttempts:       webhookConf.MaxAttempts,
+		Backoff:           webhookConf.Backoff,
+		BackoffMultiplier: webhookConf.BackoffMultiplier,
+		Timeout:           webhookConf.Timeout,
+	}
+	if destination == nil {
+		return policy
+	}
+
+	if destination.MaxAttempts != nil && *destination.MaxAttempts > 0 {
+		policy.MaxAttempts = *destination.MaxAttempts
+	}
+	if destination.BackoffSeconds != nil && *destination.BackoffSeconds > 0 {
+		policy.Backoff = time.Duration(*destination.BackoffSeconds) * time.Second
+	}
+	if destination.BackoffMultiplier != nil && *destination.BackoffMultiplier > 0 {
+		policy.BackoffMultiplier = *destination.BackoffMultiplier
+	}
+	if destination.TimeoutSeconds != nil && *destination.TimeoutSeconds > 0 {
+		policy.Timeout = time.Duration(*destination.TimeoutSeconds) * time.Second
+	}
+
+	return policy
+}
+
+// WebhookRetryDelay returns the delay before retrying a notification whose delivery failed attemptNumber times
+func WebhookRetryDelay(policy types.WebhookPolicy, attemptNumber int) time.Duration {
+	if attemptNumber < 1 {
+		attemptNumber = 1
+	}
+	return time.Duration(float64(policy.Backoff) * math.Pow(policy.BackoffMultiplier, float64(attemptNumber-1)))
+}
+
+// RecordWebhookDelivery updates the delivery stats of a destination. A destination is failing from
+// its first failed delivery until the next successful one
+func RecordWebhookDelivery(ctx context.Context, destination *ent.WebhookDestination, delivered bool) error {
+	now := time.Now()
+	update := storage.Client.WebhookDestination.UpdateOneID(destination.ID)
+
+	if delivered {
+		update.
+			AddDeliveredCount(1).
+			SetLastDeliveredAt(now).
+			ClearFailingSince()
+	} else {
+		update.
+			AddFailedCount(1).
+			SetLastFailedAt(now)
+		if destination.FailingSince == nil {
+			update.SetFailingSince(now)
+		}
+	}
+
+	if _, err := update.Save(ctx); err != nil {
+		return fmt.Errorf("RecordWebhookDelivery.db: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,264 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/webhookdelivery"
+	"github.com/NEDA-LABS/stablenode/ent/webhookretryattempt"
+	"github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/google/uuid"
+	fastshot "github.com/opus-domini/fast-shot"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// webhookQueueKey is the Redis list of webhook notifications due for delivery
+	webhookQueueKey = "webhook_notifications"
+
+	// webhookRetryKey is the Redis sorted set of webhook notifications waiting to be retried,
+	// scored by when they are due
+	webhookRetryKey = "webhook_notifications_retry"
+)
+
+// EnqueueWebhookNotification queues a signed webhook notification for delivery
+func EnqueueWebhookNotification(ctx context.Context, notification *types.WebhookNotification) error {
+	data, err := json.Marshal(notification)
+	if err != nil {
+		return fmt.Errorf("EnqueueWebhookNotification.marshal: %w", err)
+	}
+
+	if err := storage.RedisClient.RPush(ctx, webhookQueueKey, data).Err(); err != nil {
+		return fmt.Errorf("EnqueueWebhookNotification.redis: %w", err)
+	}
+
+	return nil
+}
+
+// NextWebhookNotification waits up to timeout for a webhook notification due for delivery.
+// Returns nil when none is due
+func NextWebhookNotification(ctx context.Context, timeout time.Duration) (*types.WebhookNotification, error) {
+	result, err := storage.RedisClient.BLPop(ctx, timeout, webhookQueueKey).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("NextWebhookNotification.redis: %w", err)
+	}
+
+	var notification types.WebhookNotification
+	if err := json.Unmarshal([]byte(result[1]), &notification); err != nil {
+		return nil, fmt.Errorf("NextWebhookNotification.unmarshal: %w", err)
+	}
+
+	return &notification, nil
+}
+
+// PromoteDueWebhookNotifications moves webhook notifications whose retry is due back to the
+// delivery queue. Returns the number of notifications moved
+func PromoteDueWebhookNotifications(ctx context.Context) (int, error) {
+	due, err := storage.RedisClient.ZRangeByScore(ctx, webhookRetryKey, &redis.ZRangeBy{
+		Min: "-inf",
+		Max: strconv.FormatInt(time.Now().Unix(), 10),
+	}).Result()
+	if err != nil {
+		return 0, fmt.Errorf("PromoteDueWebhookNotifications.redis: %w", err)
+	}
+
+	promoted := 0
+	for _, member := range due {
+		// Only the instance that removes a notification from the retry set requeues it
+		removed, err := storage.RedisClient.ZRem(ctx, webhookRetryKey, member).Result()
+		if err != nil {
+			return promoted, fmt.Errorf("PromoteDueWebhookNotifications.redis: %w", err)
+		}
+		if removed == 0 {
+			continue
+		}
+
+		if err := storage.RedisClient.RPush(ctx, webhookQueueKey, member).Err(); err != nil {
+			return promoted, fmt.Errorf("PromoteDueWebhookNotifications.redis: %w", err)
+		}
+		promoted++
+	}
+
+	return promoted, nil
+}
+
+// DeliverWebhookNotification sends a webhook notification to its sender and logs the attempt.
+// A failed delivery is retried with exponential backoff per the destination's policy; once its
+// attempts or retry window run out, the notification is dead-lettered as an expired webhook
+// retry attempt, which operators can retry. Notifications to disabled destinations are dropped.
+// Returns whether the notification was dead-lettered and the delivery error, if any
+func DeliverWebhookNotification(ctx context.Context, notification *types.WebhookNotification) (bool, error) {
+	destination, err := GetWebhookDestination(ctx, notification.WebhookURL)
+	if err != nil {
+		return false, err
+	}
+	if destination.DisabledAt != nil {
+		logger.WithFields(logger.Fields{
+			"OrderID":    notification.OrderID,
+			"WebhookURL": notification.WebhookURL,
+			"Event":      notification.Event,
+		}).Warnf("Webhook destination disabled, skipping notification")
+		return false, nil
+	}
+	policy := WebhookPolicy(destination)
+
+	start := time.Now()
+	res, err := fastshot.NewClient(notification.WebhookURL).
+		Config().SetTimeout(policy.Timeout).
+		Header().Add("X-Paycrest-Signature", notification.Signature).
+		Header().Add("Content-Type", "application/json").
+		Build().POST("").
+		Body().AsReader(bytes.NewReader(notification.Body)).
+		Send()
+	duration := time.Since(start)
+	if res.RawResponse != nil {
+		_ = res.RawResponse.Body.Close()
+	}
+	if err == nil && res.StatusCode() >= 205 {
+		err = fmt.Errorf("webhook returned status %d", res.StatusCode())
+	}
+	delivered := err == nil
+
+	if recordErr := RecordWebhookDelivery(ctx, destination, delivered); recordErr != nil {
+		logger.WithFields(logger.Fields{
+			"Error":      recordErr.Error(),
+			"WebhookURL": notification.WebhookURL,
+		}).Errorf("Failed to record webhook delivery")
+	}
+
+	notification.Attempts++
+	status := webhookdelivery.StatusDelivered
+	var nextRetryTime time.Time
+	if !delivered {
+		nextRetryTime = time.Now().Add(WebhookRetryDelay(policy, notification.Attempts))
+		status = webhookdelivery.StatusFailed
+		if notification.Attempts >= policy.MaxAttempts ||
+			nextRetryTime.Sub(notification.CreatedAt) > config.WebhookConfig().MaxRetryDuration {
+			status = webhookdelivery.StatusDeadLettered
+		}
+	}
+
+	logWebhookDelivery(ctx, notification, status, res.StatusCode(), duration, err)
+
+	switch status {
+	case webhookdelivery.StatusDelivered:
+		return false, nil
+	case webhookdelivery.StatusDeadLettered:
+		if deadLetterErr := deadLetterWebhookNotification(ctx, notification); deadLetterErr != nil {
+			return true, errors.Join(err, deadLetterErr)
+		}
+		return true, err
+	}
+
+	data, marshalErr := json.Marshal(notification)
+	if marshalErr != nil {
+		return false, errors.Join(err, fmt.Errorf("DeliverWebhookNotification.marshal: %w", marshalErr))
+	}
+	scheduleErr := storage.RedisClient.ZAdd(ctx, webhookRetryKey, redis.Z{
+		Score:  float64(nextRetryTime.Unix()),
+		Member: data,
+	}).Err()
+	if scheduleErr != nil {
+		return false, errors.Join(err, fmt.Errorf("DeliverWebhookNotification.schedule: %w", scheduleErr))
+	}
+
+	return false, err
+}
+
+// logWebhookDelivery records an attempt to deliver a webhook notification for its sender to query
+func logWebhookDelivery(ctx context.Context, notification *types.WebhookNotification, status webhookdelivery.Status, responseStatus int, duration time.Duration, deliveryErr error) {
+	create := storage.Client.WebhookDelivery.
+		Create().
+		SetNotificationID(notification.ID).
+		SetEvent(notification.Event).
+		SetWebhookURL(notification.WebhookURL).
+		SetAttemptNumber(notification.Attempts).
+		SetStatus(status).
+		SetDurationMs(duration.Milliseconds()).
+		SetSenderProfileID(notification.SenderID)
+	if notification.OrderID != uuid.Nil {
+		create.SetOrderID(notification.OrderID)
+	}
+	if responseStatus != 0 {
+		create.SetResponseStatus(responseStatus)
+	}
+	if deliveryErr != nil {
+		message := deliveryErr.Error()
+		if len(message) > 500 {
+			message = message[:500]
+		}
+		create.SetError(message)
+	}
+
+	if _, err := create.Save(ctx); err != nil {
+		logger.WithFields(logger.Fields{
+			"Error":          err.Error(),
+			"NotificationID": notification.ID,
+		}).Errorf("Failed to log webhook delivery")
+	}
+}
+
+// deadLetterWebhookNotification keeps a notification that failed for the last time as an expired
+// webhook retry attempt
+func deadLetterWebhookNotification(ctx context.Context, notification *types.WebhookNotification) error {
+	var payload map[string]interface{}
+	if err := json.Unmarshal(notification.Body, &payload); err != nil {
+		return fmt.Errorf("deadLetterWebhookNotification.unmarshal: %w", err)
+	}
+
+	_, err := storage.Client.WebhookRetryAttempt.
+		Create().
+		SetAttemptNumber(notification.Attempts).
+		SetNextRetryTime(time.Now()).
+		SetPayload(payload).
+		SetSignature(notification.Signature).
+		SetWebhookURL(notification.WebhookURL).
+		SetStatus(webhookretryattempt.StatusExpired).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("deadLetterWebhookNotification.db: %w", err)
+	}
+
+	logger.WithFields(logger.Fields{
+		"NotificationID": notification.ID,
+		"OrderID":        notification.OrderID,
+		"WebhookURL":     notification.WebhookURL,
+		"Event":          notification.Event,
+		"Attempts":       notification.Attempts,
+	}).Warnf("Webhook notification dead-lettered")
+
+	return nil
+}
+
+// WebhookDeliveryResponse builds the response for a webhook delivery attempt
+func WebhookDeliveryResponse(delivery *ent.WebhookDelivery) types.WebhookDeliveryResponse {
+	response := types.WebhookDeliveryResponse{
+		ID:             delivery.ID,
+		NotificationID: delivery.NotificationID,
+		Event:          delivery.Event,
+		WebhookURL:     delivery.WebhookURL,
+		AttemptNumber:  delivery.AttemptNumber,
+		Status:         string(delivery.Status),
+		ResponseStatus: delivery.ResponseStatus,
+		Error:          delivery.Error,
+		DurationMs:     delivery.DurationMs,
+		CreatedAt:      delivery.CreatedAt,
+	}
+	if delivery.OrderID != uuid.Nil {
+		orderID := delivery.OrderID
+		response.OrderID = &orderID
+	}
+	return response
+}
@@ -0,0 +1,170 @@
+package utils
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	"github.com/NEDA-LABS/stablenode/ent/webhookdelivery"
+	"github.com/NEDA-LABS/stablenode/ent/webhookretryattempt"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+	cryptoUtils "github.com/NEDA-LABS/stablenode/utils/crypto"
+	"github.com/NEDA-LABS/stablenode/utils/test"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookQueue(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:webhookqueue?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	mr, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer mr.Close()
+	db.RedisClient = redis.NewClient(&redis.Options{Addr: mr.Addr()})
+
+	ctx := context.Background()
+
+	user, err := test.CreateTestUser(map[string]interface{}{
+		"email": "sender@test.com",
+	})
+	assert.NoError(t, err)
+
+	sender, err := test.CreateTestSenderProfile(map[string]interface{}{
+		"user_id": user.ID,
+		"token":   "",
+	})
+	assert.NoError(t, err)
+
+	// The endpoint answers with the next queued status and keeps what it received
+	var statusCode atomic.Int32
+	var received atomic.Value
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received.Store([]string{string(body), r.Header.Get("X-Paycrest-Signature")})
+		w.WriteHeader(int(statusCode.Load()))
+	}))
+	defer server.Close()
+
+	newNotification := func() *types.WebhookNotification {
+		return &types.WebhookNotification{
+			ID:         uuid.New(),
+			SenderID:   sender.ID,
+			OrderID:    uuid.New(),
+			Event:      "payment_order.settled",
+			WebhookURL: server.URL,
+			Body:       []byte(`{"event":"payment_order.settled","data":{"senderId":"` + sender.ID.String() + `"}}`),
+			Signature:  "signature",
+			CreatedAt:  time.Now(),
+		}
+	}
+
+	t.Run("should deliver queued notifications as signed", func(t *testing.T) {
+		statusCode.Store(http.StatusOK)
+		sent := newNotification()
+		assert.NoError(t, EnqueueWebhookNotification(ctx, sent))
+
+		notification, err := NextWebhookNotification(ctx, time.Second)
+		assert.NoError(t, err)
+		assert.Equal(t, sent.ID, notification.ID)
+
+		deadLettered, err := DeliverWebhookNotification(ctx, notification)
+		assert.NoError(t, err)
+		assert.False(t, deadLettered)
+		assert.Equal(t, []string{string(sent.Body), sent.Signature}, received.Load())
+
+		delivery, err := client.WebhookDelivery.
+			Query().
+			Where(webhookdelivery.NotificationIDEQ(sent.ID)).
+			Only(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, webhookdelivery.StatusDelivered, delivery.Status)
+		assert.Equal(t, http.StatusOK, delivery.ResponseStatus)
+		assert.Equal(t, sent.OrderID, delivery.OrderID)
+		assert.Equal(t, 1, delivery.AttemptNumber)
+	})
+
+	t.Run("should return nil when nothing is queued", func(t *testing.T) {
+		notification, err := NextWebhookNotification(ctx, time.Second)
+		assert.NoError(t, err)
+		assert.Nil(t, notification)
+	})
+
+	t.Run("should retry failed notifications and dead-letter them once attempts run out", func(t *testing.T) {
+		statusCode.Store(http.StatusInternalServerError)
+		destination, err := GetWebhookDestination(ctx, server.URL)
+		assert.NoError(t, err)
+		_, err = destination.Update().SetMaxAttempts(2).Save(ctx)
+		assert.NoError(t, err)
+
+		sent := newNotification()
+		deadLettered, err := DeliverWebhookNotification(ctx, sent)
+		assert.Error(t, err)
+		assert.False(t, deadLettered)
+
+		// Make the scheduled retry due now
+		scheduled, err := db.RedisClient.ZRange(ctx, webhookRetryKey, 0, -1).Result()
+		assert.NoError(t, err)
+		assert.Len(t, scheduled, 1)
+		db.RedisClient.ZAdd(ctx, webhookRetryKey, redis.Z{Score: 0, Member: scheduled[0]})
+
+		promoted, err := PromoteDueWebhookNotifications(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, promoted)
+
+		retried, err := NextWebhookNotification(ctx, time.Second)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, retried.Attempts)
+
+		deadLettered, err = DeliverWebhookNotification(ctx, retried)
+		assert.Error(t, err)
+		assert.True(t, deadLettered)
+
+		statuses, err := client.WebhookDelivery.
+			Query().
+			Where(webhookdelivery.NotificationIDEQ(sent.ID)).
+			Order(webhookdelivery.ByAttemptNumber()).
+			Select(webhookdelivery.FieldStatus).
+			Strings(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"failed", "dead_lettered"}, statuses)
+
+		// The dead letter can be retried like any expired webhook retry attempt
+		attempt, err := client.WebhookRetryAttempt.
+			Query().
+			Where(webhookretryattempt.WebhookURLEQ(server.URL)).
+			Only(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, webhookretryattempt.StatusExpired, attempt.Status)
+		assert.Equal(t, 2, attempt.AttemptNumber)
+		assert.Equal(t, sender.ID.String(), attempt.Payload["data"].(map[string]interface{})["senderId"])
+
+		remaining, err := db.RedisClient.ZCard(ctx, webhookRetryKey).Result()
+		assert.NoError(t, err)
+		assert.Zero(t, remaining)
+	})
+
+	t.Run("should sign with the sender's webhook secret when set", func(t *testing.T) {
+		encrypted, err := cryptoUtils.EncryptPlain([]byte("webhook-secret"))
+		assert.NoError(t, err)
+		sender, err := sender.Update().
+			SetWebhookSecret(base64.StdEncoding.EncodeToString(encrypted)).
+			Save(ctx)
+		assert.NoError(t, err)
+
+		secret, err := webhookSigningSecret(ctx, sender)
+		assert.NoError(t, err)
+		assert.Equal(t, "webhook-secret", secret)
+	})
+}
@@ -0,0 +1,81 @@
+package utils
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/ent"
+	"github.com/NEDA-LABS/stablenode/ent/enttest"
+	db "github.com/NEDA-LABS/stablenode/storage"
+	"github.com/NEDA-LABS/stablenode/types"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookDestinations(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:webhookdestinations?mode=memory&_fk=1")
+	defer client.Close()
+	db.Client = client
+
+	ctx := context.Background()
+	webhookURL := "https://example.com/hook"
+
+	t.Run("should register a destination on first delivery", func(t *testing.T) {
+		first, err := GetWebhookDestination(ctx, webhookURL)
+		assert.NoError(t, err)
+
+		second, err := GetWebhookDestination(ctx, webhookURL)
+		assert.NoError(t, err)
+		assert.Equal(t, first.ID, second.ID)
+	})
+
+	t.Run("should apply destination overrides over the defaults", func(t *testing.T) {
+		defaults := WebhookPolicy(&ent.WebhookDestination{})
+		assert.Equal(t, 10, defaults.MaxAttempts)
+		assert.Equal(t, 2*time.Minute, defaults.Backoff)
+		assert.Equal(t, 30*time.Second, defaults.Timeout)
+
+		maxAttempts, backoff, timeout := 3, 30, 5
+		policy := WebhookPolicy(&ent.WebhookDestination{
+			MaxAttempts:    &maxAttempts,
+			BackoffSeconds: &backoff,
+			TimeoutSeconds: &timeout,
+		})
+		assert.Equal(t, 3, policy.MaxAttempts)
+		assert.Equal(t, 30*time.Second, policy.Backoff)
+		assert.Equal(t, defaults.BackoffMultiplier, policy.BackoffMultiplier)
+		assert.Equal(t, 5*time.Second, policy.Timeout)
+	})
+
+	t.Run("should back off between retries", func(t *testing.T) {
+		policy := types.WebhookPolicy{Backoff: time.Minute, BackoffMultiplier: 3}
+		assert.Equal(t, time.Minute, WebhookRetryDelay(policy, 1))
+		assert.Equal(t, 3*time.Minute, WebhookRetryDelay(policy, 2))
+		assert.Equal(t, 9*time.Minute, WebhookRetryDelay(policy, 3))
+	})
+
+	t.Run("should track continuous failures until a delivery succeeds", func(t *testing.T) {
+		destination, err := GetWebhookDestination(ctx, webhookURL)
+		assert.NoError(t, err)
+
+		assert.NoError(t, RecordWebhookDelivery(ctx, destination, false))
+		destination, err = GetWebhookDestination(ctx, webhookURL)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, destination.FailedCount)
+		assert.NotNil(t, destination.FailingSince)
+		failingSince := *destination.FailingSince
+
+		assert.NoError(t, RecordWebhookDelivery(ctx, destination, false))
+		destination, err = GetWebhookDestination(ctx, webhookURL)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, destination.FailedCount)
+		assert.True(t, failingSince.Equal(*destination.FailingSince))
+
+		assert.NoError(t, RecordWebhookDelivery(ctx, destination, true))
+		destination, err = GetWebhookDestination(ctx, webhookURL)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, destination.DeliveredCount)
+		assert.Nil(t, destination.FailingSince)
+	})
+}
@@ -0,0 +1,124 @@
+package workerpool
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"sync"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/NEDA-LABS/stablenode/utils/logger"
+	"github.com/NEDA-LABS/stablenode/utils/metrics"
+)
+
+// Pool bounds how many events of each network are processed at once. A network's workers are
+// shared by every caller, so webhooks, polling and indexing running together cannot exceed them;
+// a caller submitting to a busy network waits for a worker instead of starting another goroutine
+type Pool struct {
+	conf *config.EventWorkerConfiguration
+
+	mu      sync.Mutex
+	workers map[string]chan struct{}
+}
+
+var (
+	defaultPool     *Pool
+	defaultPoolOnce sync.Once
+)
+
+// New creates a pool with the configured worker count per network
+func New(conf *config.EventWorkerConfiguration) *Pool {
+	return &Pool{
+		conf:    conf,
+		workers: make(map[string]chan struct{}),
+	}
+}
+
+// Default returns the process-wide pool, so all event processing of a network shares its workers
+func Default() *Pool {
+	defaultPoolOnce.Do(func() {
+		defaultPool = New(config.EventWorkerConfig())
+	})
+	return defaultPool
+}
+
+// Size returns how many events of a network are processed at once
+func (p *Pool) Size(network string) int {
+	if size, ok := p.conf.Overrides[network]; ok {
+		return size
+	}
+	if p.conf.Size < 1 {
+		return 1
+	}
+	return p.conf.Size
+}
+
+// network returns the worker slots of a network, creating them on first use
+func (p *Pool) network(network string) chan struct{} {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	workers, ok := p.workers[network]
+	if !ok {
+		workers = make(chan struct{}, p.Size(network))
+		p.workers[network] = workers
+	}
+	return workers
+}
+
+// Group runs the tasks of one batch of a network's events on the pool
+type Group struct {
+	ctx     context.Context
+	network string
+	workers chan struct{}
+	wg      sync.WaitGroup
+}
+
+// Group starts a batch of tasks for a network. Tasks stop being started once ctx is done
+func (p *Pool) Group(ctx context.Context, network string) *Group {
+	return &Group{
+		ctx:     ctx,
+		network: network,
+		workers: p.network(network),
+	}
+}
+
+// Go runs task on a worker of the group's network, waiting for one to be free. It returns the
+// context error without running task if ctx is done first. A panicking task is logged and
+// counted instead of crashing the process
+func (g *Group) Go(task func()) error {
+	select {
+	case g.workers <- struct{}{}:
+	case <-g.ctx.Done():
+		return g.ctx.Err()
+	}
+
+	metrics.EventWorkersBusy.WithLabelValues(g.network).Inc()
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		defer func() {
+			<-g.workers
+			metrics.EventWorkersBusy.WithLabelValues(g.network).Dec()
+		}()
+		defer func() {
+			if r := recover(); r != nil {
+				metrics.EventWorkerPanics.WithLabelValues(g.network).Inc()
+				logger.WithFields(logger.Fields{
+					"Error":   fmt.Sprintf("%v", r),
+					"Network": g.network,
+					"Stack":   string(debug.Stack()),
+				}).Errorf("Recovered from panic while processing event")
+			}
+		}()
+
+		task()
+	}()
+
+	return nil
+}
+
+// Wait blocks until every task started in the group is done
+func (g *Group) Wait() {
+	g.wg.Wait()
+}
@@ -0,0 +1,85 @@
+package workerpool
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/NEDA-LABS/stablenode/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPool(t *testing.T) {
+	conf := &config.EventWorkerConfiguration{
+		Size:      2,
+		Overrides: map[string]int{"tron-mainnet": 1},
+	}
+
+	t.Run("bounds the events of a network processed at once across groups", func(t *testing.T) {
+		pool := New(conf)
+		var busy, maxBusy int32
+		task := func() {
+			n := atomic.AddInt32(&busy, 1)
+			for {
+				seen := atomic.LoadInt32(&maxBusy)
+				if n <= seen || atomic.CompareAndSwapInt32(&maxBusy, seen, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			atomic.AddInt32(&busy, -1)
+		}
+
+		var wg sync.WaitGroup
+		for i := 0; i < 3; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				group := pool.Group(context.Background(), "base")
+				for j := 0; j < 5; j++ {
+					assert.NoError(t, group.Go(task))
+				}
+				group.Wait()
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, int32(2), maxBusy)
+	})
+
+	t.Run("sizes networks by their override", func(t *testing.T) {
+		pool := New(conf)
+		assert.Equal(t, 1, pool.Size("tron-mainnet"))
+		assert.Equal(t, 2, pool.Size("base"))
+		assert.Equal(t, 1, New(&config.EventWorkerConfiguration{}).Size("base"))
+	})
+
+	t.Run("recovers panicking tasks and frees their worker", func(t *testing.T) {
+		pool := New(conf)
+		group := pool.Group(context.Background(), "tron-mainnet")
+
+		assert.NoError(t, group.Go(func() { panic("nil event") }))
+		ran := false
+		assert.NoError(t, group.Go(func() { ran = true }))
+		group.Wait()
+
+		assert.True(t, ran)
+	})
+
+	t.Run("stops waiting for a worker once the context is done", func(t *testing.T) {
+		pool := New(conf)
+		release := make(chan struct{})
+		busy := pool.Group(context.Background(), "tron-mainnet")
+		assert.NoError(t, busy.Go(func() { <-release }))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		err := pool.Group(ctx, "tron-mainnet").Go(func() {})
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+		close(release)
+		busy.Wait()
+	})
+}